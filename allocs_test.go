@@ -0,0 +1,51 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// allocBudgets caps the heap allocations of parsing and marshaling each
+// sample, set about 5% above the counts measured when they were introduced
+// (ParseDDEX and Marshal of 1 Audio.xml took 14869 and 1789; the MEAD award
+// sample took 695 to parse once its titles were wrapped in Title elements).
+// encoding/xml allocates per token, so the counts move with the input rather
+// than the machine. Raise a budget only when the extra work is
+// intended, e.g. a new custom UnmarshalXML, and note the new measurement.
+var allocBudgets = []struct {
+	name    string
+	path    string
+	parse   float64 // ParseDDEX
+	marshal float64 // Marshal of the parsed message
+}{
+	{"ERN 4.3", filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml"), 15600, 1900},
+	{"ERN 3.8.3", filepath.Join("testdata", "ernv383", "new_release_example.xml"), 2350, 400},
+	{"MEAD", filepath.Join("testdata", "meadv11", "mead_award_example.xml"), 730, 370},
+	{"PIE", filepath.Join("testdata", "piev10", "pie_award_example.xml"), 670, 100},
+}
+
+// TestAllocationBudget guards the parsing hot path against allocation
+// regressions that timing benchmarks are too noisy to catch
+func TestAllocationBudget(t *testing.T) {
+	for _, tc := range allocBudgets {
+		t.Run(tc.name, func(t *testing.T) {
+			xmlData, err := os.ReadFile(tc.path)
+			if err != nil {
+				t.Skipf("Sample file not found: %s", tc.path)
+			}
+			msg, err := ParseDDEX(xmlData)
+			if err != nil {
+				t.Fatalf("Failed to parse: %v", err)
+			}
+
+			if allocs := testing.AllocsPerRun(10, func() { ParseDDEX(xmlData) }); allocs > tc.parse {
+				t.Errorf("ParseDDEX allocated %.0f times, over the budget of %.0f", allocs, tc.parse)
+			}
+
+			if allocs := testing.AllocsPerRun(10, func() { Marshal(msg, MarshalOptions{}) }); allocs > tc.marshal {
+				t.Errorf("Marshal allocated %.0f times, over the budget of %.0f", allocs, tc.marshal)
+			}
+		})
+	}
+}
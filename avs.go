@@ -0,0 +1,144 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	avsv20200108 "github.com/alecsavvy/ddex-go/gen/ddex/avs/v20200108"
+	avsvlatest "github.com/alecsavvy/ddex-go/gen/ddex/avs/vlatest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ErrUnknownAVSVersion is returned by ValidateAVSConsistency when a message
+// declares an AvsVersionId the library has no value sets for
+var ErrUnknownAVSVersion = errors.New("unknown AVS version")
+
+// ErrAVSVersionSubstituted is wrapped by the warning ValidateAVSConsistency
+// returns first when a message declares an AvsVersionId older than any the
+// library has value sets for, and its values were checked against the
+// nearest newer set instead. Filter it with errors.Is to keep only the
+// violations.
+var ErrAVSVersionSubstituted = errors.New("AVS version not compiled in, checked against a newer one")
+
+// AVSVersionLatest is the AvsVersionId of the AllowedValueSets in
+// gen/ddex/avs/vlatest, which the ERN 4, MEAD and PIE packages use
+const AVSVersionLatest = "9"
+
+// avsVersion is the generated code for one version of the AllowedValueSets
+type avsVersion struct {
+	pkg   protoreflect.FullName
+	parse func(enum, s string) (int32, bool)
+}
+
+// avsVersions maps each supported AvsVersionId to its generated value sets
+var avsVersions = map[string]avsVersion{
+	AVSVersionLatest: {pkg: "ddex.avs.vlatest", parse: avsvlatest.ParseEnumString},
+}
+
+// AVSValues returns the values of the named allowed value set (e.g.
+// ReleaseType) in AVSVersionLatest in schema order, for populating pickers
+// or validating input. It returns nil for an unknown set; use AVSValuesFor
+// for the sets of a specific ERN version.
+func AVSValues(setName string) []string {
+	return avsvlatest.Values(setName)
+}
+
+// AVSValuesFor returns the values of the named allowed value set as used by
+// an ERN version: AVS 20200108 for ERN 3.8.3 and AVSVersionLatest for ERN 4.
+// It returns nil for an unknown set or version.
+func AVSValuesFor(version ERNVersion, setName string) []string {
+	switch version {
+	case ERNv383:
+		return avsv20200108.Values(setName)
+	case ERNv43, ERNv432:
+		return avsvlatest.Values(setName)
+	default:
+		return nil
+	}
+}
+
+// ValidateAVSConsistency checks that every AVS-typed value in msg (release
+// types, territory codes, roles, ...) is allowed by the AllowedValueSets
+// version the message declares in AvsVersionId, returning every value that
+// is not. Only AVSVersionLatest is bundled, so values mixed in from another
+// AVS version are only caught in messages declaring the latest one. An
+// older AvsVersionId (many samples declare 3 or 5) is checked against the
+// latest value sets instead, after a leading warning wrapping
+// ErrAVSVersionSubstituted, so values that version allowed but the latest
+// dropped are reported too; any other AvsVersionId yields a single error
+// wrapping ErrUnknownAVSVersion.
+func ValidateAVSConsistency(msg proto.Message) []error {
+	if msg == nil {
+		return []error{&ValidationError{Path: "", Message: "message is nil"}}
+	}
+
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("avs_version_id")
+	if fd == nil {
+		return []error{fmt.Errorf("%s has no AvsVersionId", m.Descriptor().Name())}
+	}
+	version := m.Get(fd).String()
+	if version == "" {
+		return []error{&ValidationError{Path: "AvsVersionId", Message: "required attribute is missing"}}
+	}
+	var errs []error
+	avs, ok := avsVersions[version]
+	if !ok {
+		nearest, found := nearestAVSVersion(version)
+		if !found {
+			return []error{fmt.Errorf("%w %q", ErrUnknownAVSVersion, version)}
+		}
+		errs = append(errs, fmt.Errorf("%w: declared %q, using AVS %s", ErrAVSVersionSubstituted, version, nearest))
+		avs, version = avsVersions[nearest], nearest
+	}
+
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() != protoreflect.StringKind || v.String() == "" {
+			return nil
+		}
+		set := xmlFields(parent)[fd.Number()].AVS
+		if set == "" {
+			return nil
+		}
+
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(avs.pkg.Append(protoreflect.Name(set)))
+		if _, ok := desc.(protoreflect.EnumDescriptor); err != nil || !ok {
+			errs = append(errs, &ValidationError{
+				Path:    path,
+				Message: fmt.Sprintf("AVS %s has no %s value set", version, set),
+			})
+			return nil
+		}
+		if _, ok := avs.parse(set, v.String()); !ok {
+			errs = append(errs, &ValidationError{
+				Path:    path,
+				Message: fmt.Sprintf("%q is not a %s value in AVS %s", v.String(), set, version),
+			})
+		}
+		return nil
+	})
+
+	return errs
+}
+
+// nearestAVSVersion returns the oldest known AvsVersionId newer than the
+// numeric version, reporting false for a version that is not a number or
+// is newer than every known one
+func nearestAVSVersion(version string) (string, bool) {
+	n, err := strconv.Atoi(version)
+	if err != nil {
+		return "", false
+	}
+
+	nearest, found := 0, false
+	for known := range avsVersions {
+		k, err := strconv.Atoi(known)
+		if err == nil && k > n && (!found || k < nearest) {
+			nearest, found = k, true
+		}
+	}
+	return strconv.Itoa(nearest), found
+}
@@ -0,0 +1,124 @@
+package ddex
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	avsvlatest "github.com/alecsavvy/ddex-go/gen/ddex/avs/vlatest"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+)
+
+// TestValidateAVSConsistency validates AVS value checks against the declared AvsVersionId
+func TestValidateAVSConsistency(t *testing.T) {
+	samplePath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+
+	t.Run("Consistent Sample", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, samplePath)
+		msg.AvsVersionId = "9"
+
+		if errs := ValidateAVSConsistency(msg); len(errs) != 0 {
+			t.Errorf("Expected no violations, got %v", errs)
+		}
+	})
+
+	t.Run("Value Absent From Declared Version", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, samplePath)
+		msg.AvsVersionId = "9"
+		// TrackRelease was dropped from the ERN 4 release types
+		msg.ReleaseList.Release.ReleaseType[0].Value = "TrackRelease"
+
+		errs := ValidateAVSConsistency(msg)
+		if len(errs) != 1 {
+			t.Fatalf("Expected 1 violation, got %v", errs)
+		}
+		var verr *ValidationError
+		if !errors.As(errs[0], &verr) || verr.Path != "ReleaseList.Release.ReleaseType[0]" {
+			t.Errorf("Unexpected violation: %v", errs[0])
+		}
+		if !strings.Contains(verr.Message, "ReleaseTypeERN4") {
+			t.Errorf("Expected violation to name the value set, got %q", verr.Message)
+		}
+	})
+
+	t.Run("Unknown Version", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, samplePath)
+		msg.AvsVersionId = "99"
+
+		errs := ValidateAVSConsistency(msg)
+		if len(errs) != 1 || !errors.Is(errs[0], ErrUnknownAVSVersion) {
+			t.Errorf("Expected ErrUnknownAVSVersion, got %v", errs)
+		}
+	})
+
+	t.Run("Older Version", func(t *testing.T) {
+		// The sample declares AvsVersionId 3, which has no compiled value sets
+		msg := loadNewReleaseMessage(t, samplePath)
+		if msg.AvsVersionId != "3" {
+			t.Fatalf("Expected the sample to declare AVS 3, got %q", msg.AvsVersionId)
+		}
+
+		errs := ValidateAVSConsistency(msg)
+		if len(errs) != 1 || !errors.Is(errs[0], ErrAVSVersionSubstituted) {
+			t.Fatalf("Expected only the substitution warning, got %v", errs)
+		}
+		if !strings.Contains(errs[0].Error(), `"3"`) || !strings.Contains(errs[0].Error(), "AVS "+AVSVersionLatest) {
+			t.Errorf("Expected the warning to name both versions, got %q", errs[0])
+		}
+
+		msg.ReleaseList.Release.ReleaseType[0].Value = "TrackRelease"
+		if errs := ValidateAVSConsistency(msg); len(errs) != 2 {
+			t.Errorf("Expected the warning and 1 violation, got %v", errs)
+		}
+	})
+
+	t.Run("Missing Version", func(t *testing.T) {
+		if errs := ValidateAVSConsistency(&meadv11.MeadMessage{}); len(errs) != 1 {
+			t.Errorf("Expected 1 error for missing AvsVersionId, got %v", errs)
+		}
+	})
+}
+
+// TestAVSValues validates the allowed value set lookups against the
+// generated constants and the parsers ValidateAVSConsistency relies on
+func TestAVSValues(t *testing.T) {
+	t.Run("Latest", func(t *testing.T) {
+		values := AVSValues("ReleaseType")
+		if len(values) == 0 || values[0] != avsvlatest.ReleaseTypeAlbum {
+			t.Fatalf("ReleaseType values = %v, want Album first", values)
+		}
+		for _, value := range values {
+			if _, ok := avsvlatest.ParseReleaseTypeString(value); !ok {
+				t.Errorf("%q does not parse as a ReleaseType", value)
+			}
+		}
+
+		values[0] = "Changed"
+		if got := AVSValues("ReleaseType")[0]; got != avsvlatest.ReleaseTypeAlbum {
+			t.Errorf("Modifying the result changed the set: first value %q", got)
+		}
+	})
+
+	t.Run("Per ERN Version", func(t *testing.T) {
+		if !slices.Contains(AVSValuesFor(ERNv383, "ResourceContributorRole"), "Producer") {
+			t.Error("ERN 3.8.3 ResourceContributorRole should allow Producer")
+		}
+		if slices.Contains(AVSValuesFor(ERNv432, "ResourceContributorRole"), "Producer") {
+			t.Error("ERN 4.3.2 ResourceContributorRole should not allow Producer")
+		}
+		if got, want := AVSValuesFor(ERNv43, "ReleaseType"), AVSValues("ReleaseType"); !slices.Equal(got, want) {
+			t.Errorf("ERN 4.3 ReleaseType differs from the latest AVS")
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		if got := AVSValues("NoSuchSet"); got != nil {
+			t.Errorf("Unknown set = %v, want nil", got)
+		}
+		if got := AVSValuesFor("99", "ReleaseType"); got != nil {
+			t.Errorf("Unknown version = %v, want nil", got)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// Canonicalize parses any supported DDEX message and re-emits it with
+// two-space indentation, sorted attributes and namespace declarations first,
+// so semantically equal documents produce byte-identical output. Empty
+// elements are written <X></X> as Marshal writes them, whether the input had
+// <X/> or <X></X>, and whitespace-only text such as <X> </X> is kept verbatim
+// rather than being taken for indentation.
+func Canonicalize(xmlData []byte) ([]byte, error) {
+	start, err := detectRootElement(xmlData)
+	if err != nil {
+		return nil, err
+	}
+	rootName, _, _ := DefaultRegistry.resolve(start)
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaled, err := xml.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(marshaled); err != nil {
+		return nil, fmt.Errorf("failed to read marshaled XML: %w", err)
+	}
+
+	if root := doc.Root(); root != nil {
+		restoreRootPrefix(root, rootName.Space)
+		sortAttrs(root)
+	}
+	indent := etree.NewIndentSettings()
+	indent.Spaces = 2
+	indent.PreserveLeafWhitespace = true
+	doc.IndentWithSettings(indent)
+	doc.WriteSettings.CanonicalEndTags = true
+
+	out, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// restoreRootPrefix puts the root element back into its namespace, since the
+// generated marshalers emit it unprefixed alongside the xmlns declaration
+func restoreRootPrefix(root *etree.Element, namespace string) {
+	if root.Space != "" {
+		return
+	}
+	for _, attr := range root.Attr {
+		if attr.Space == "xmlns" && attr.Value == namespace {
+			root.Space = attr.Key
+			return
+		}
+	}
+}
+
+// sortAttrs orders attributes of e and its descendants, namespace
+// declarations first and then by full key
+func sortAttrs(e *etree.Element) {
+	slices.SortStableFunc(e.Attr, func(a, b etree.Attr) int {
+		if aNS, bNS := isNamespaceDecl(a), isNamespaceDecl(b); aNS != bNS {
+			if aNS {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.FullKey(), b.FullKey())
+	})
+
+	for _, child := range e.ChildElements() {
+		sortAttrs(child)
+	}
+}
+
+func isNamespaceDecl(a etree.Attr) bool {
+	return a.Space == "xmlns" || (a.Space == "" && a.Key == "xmlns")
+}
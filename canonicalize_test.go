@@ -0,0 +1,66 @@
+package ddex
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/beevik/etree"
+)
+
+// TestCanonicalize validates that reformatted copies of a document canonicalize identically
+func TestCanonicalize(t *testing.T) {
+	testCases := []struct {
+		name    string
+		xmlPath string
+	}{
+		{"ERN Simple Audio", filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")},
+		{"MEAD Award", filepath.Join("testdata", "meadv11", "mead_award_example.xml")},
+		{"PIE Award", filepath.Join("testdata", "piev10", "pie_award_example.xml")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original, err := os.ReadFile(tc.xmlPath)
+			if err != nil {
+				t.Skipf("Sample file not found: %s", tc.xmlPath)
+			}
+
+			// Strip indentation and reverse attribute order to get a semantically equal variant
+			doc := etree.NewDocument()
+			if err := doc.ReadFromBytes(original); err != nil {
+				t.Fatalf("Failed to read %s: %v", tc.xmlPath, err)
+			}
+			for _, e := range append(doc.FindElements("//*"), doc.Root()) {
+				slices.Reverse(e.Attr)
+			}
+			doc.Indent(etree.NoIndent)
+			variant, err := doc.WriteToBytes()
+			if err != nil {
+				t.Fatalf("Failed to write variant: %v", err)
+			}
+
+			canonical, err := Canonicalize(original)
+			if err != nil {
+				t.Fatalf("Failed to canonicalize original: %v", err)
+			}
+			canonicalVariant, err := Canonicalize(variant)
+			if err != nil {
+				t.Fatalf("Failed to canonicalize variant: %v", err)
+			}
+			if !bytes.Equal(canonical, canonicalVariant) {
+				t.Error("Canonical forms of equivalent documents differ")
+			}
+
+			again, err := Canonicalize(canonical)
+			if err != nil {
+				t.Fatalf("Failed to canonicalize canonical output: %v", err)
+			}
+			if !bytes.Equal(canonical, again) {
+				t.Error("Canonicalize is not idempotent")
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package ddex
+
+import (
+	"path/filepath"
+	"testing"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestClone validates that generated Clone methods copy deeply, so mutating
+// a clone leaves the original untouched
+func TestClone(t *testing.T) {
+	msg := loadNewReleaseMessage(t, filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml"))
+	original := proto.Clone(msg)
+
+	clone := msg.Clone()
+	if !proto.Equal(clone, msg) {
+		t.Fatal("Clone differs from the original")
+	}
+
+	clone.MessageHeader.MessageId = "changed"
+	clone.ReleaseList.Release.DisplayTitleText[0].Value = "Changed Title"
+	clone.ReleaseList.Release.ReleaseType = append(clone.ReleaseList.Release.ReleaseType, &ernv432.ReleaseTypeForReleaseNotification{Value: "Single"})
+	clone.ReleaseList.TrackRelease = nil
+	clone.ResourceList.SoundRecording[0].SoundRecordingEdition[0].ResourceId[0].ISRC = proto.String("XX0000000000")
+
+	if !proto.Equal(msg, original) {
+		t.Error("Mutating the clone changed the original")
+	}
+	if msg.ReleaseList.Release.DisplayTitleText[0].Value == "Changed Title" {
+		t.Error("Clone shares ReleaseList.Release.DisplayTitleText with the original")
+	}
+
+	t.Run("Nested", func(t *testing.T) {
+		release := msg.ReleaseList.Release.Clone()
+		release.ReleaseId.GRid = proto.String("changed")
+		if msg.ReleaseList.Release.ReleaseId.GetGRid() == "changed" {
+			t.Error("Clone of a nested message shares its ReleaseId")
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		var release *NewReleaseMessageV432
+		if release.Clone() != nil {
+			t.Error("Expected nil clone of a nil message")
+		}
+	})
+}
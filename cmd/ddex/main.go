@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/alecsavvy/ddex-go"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "validate":
+		if len(os.Args) != 3 {
+			usage()
+			os.Exit(2)
+		}
+		os.Exit(validate(os.Stdout, os.Stderr, os.Args[2]))
+	case "supported":
+		if len(os.Args) != 2 {
+			usage()
+			os.Exit(2)
+		}
+		os.Exit(supported(os.Stdout, os.Stderr))
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: ddex <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+	fmt.Fprintln(os.Stderr, "  validate <file>   Parse a DDEX XML file and report missing required fields, bad identifiers and dangling references")
+	fmt.Fprintln(os.Stderr, "  supported         List the supported messages and versions as JSON")
+}
+
+// supported prints the manifest of supported messages, returning the process exit code
+func supported(stdout, stderr io.Writer) int {
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(ddex.Supported()); err != nil {
+		fmt.Fprintf(stderr, "Failed to write manifest: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// validate parses and checks a single file, returning the process exit code
+func validate(stdout, stderr io.Writer, filePath string) int {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to read file: %v\n", err)
+		return 1
+	}
+
+	msg, err := ddex.ParseDDEX(data)
+	if err != nil {
+		fmt.Fprintf(stdout, "❌ %s: %v\n", filePath, err)
+		return 1
+	}
+
+	kind := msg.ProtoReflect().Descriptor().FullName()
+	errs := ddex.Validate(msg)
+	var refErr *ddex.ReferenceError
+	if errors.As(ddex.CheckReferences(msg), &refErr) {
+		for _, ref := range refErr.Dangling {
+			errs = append(errs, &ddex.ValidationError{
+				Path:    ref.Path,
+				Message: fmt.Sprintf("%s %s is not declared in this message", ref.Kind, ref.Value),
+			})
+		}
+	}
+	if len(errs) == 0 {
+		fmt.Fprintf(stdout, "✓ %s: valid %s\n", filePath, kind)
+		return 0
+	}
+
+	fmt.Fprintf(stdout, "❌ %s: %d problem(s) in %s\n", filePath, len(errs), kind)
+	for _, err := range errs {
+		fmt.Fprintf(stdout, "  - %v\n", err)
+	}
+	return 1
+}
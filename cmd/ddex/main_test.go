@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// samplePath is a valid ERN 4.3 sample shared with the library tests
+var samplePath = filepath.Join("..", "..", "testdata", "ernv432", "Samples43", "1 Audio.xml")
+
+// TestValidate checks the exit code and report of the validate command
+func TestValidate(t *testing.T) {
+	xmlData, err := os.ReadFile(samplePath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", samplePath)
+	}
+
+	run := func(t *testing.T, path string) (int, string, string) {
+		t.Helper()
+		var stdout, stderr bytes.Buffer
+		code := validate(&stdout, &stderr, path)
+		return code, stdout.String(), stderr.String()
+	}
+	writeFile := func(t *testing.T, data string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "message.xml")
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+		return path
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		code, stdout, _ := run(t, samplePath)
+		if code != 0 {
+			t.Errorf("Expected exit code 0, got %d: %s", code, stdout)
+		}
+		if want := "✓ " + samplePath + ": valid ddex.ern.v43.NewReleaseMessage\n"; stdout != want {
+			t.Errorf("Expected %q, got %q", want, stdout)
+		}
+	})
+
+	t.Run("Missing Required Elements", func(t *testing.T) {
+		data := string(xmlData)
+		// Drop the ReleaseList and the DealList referring to it
+		start, end := strings.Index(data, "<ReleaseList>"), strings.Index(data, "</DealList>")
+		path := writeFile(t, data[:start]+data[end+len("</DealList>"):])
+
+		code, stdout, _ := run(t, path)
+		if code != 1 {
+			t.Errorf("Expected exit code 1, got %d", code)
+		}
+		want := "❌ " + path + ": 1 problem(s) in ddex.ern.v43.NewReleaseMessage\n" +
+			"  - ReleaseList: required element is missing\n"
+		if stdout != want {
+			t.Errorf("Expected %q, got %q", want, stdout)
+		}
+	})
+
+	t.Run("Dangling Reference", func(t *testing.T) {
+		path := writeFile(t, strings.Replace(string(xmlData), "<DealReleaseReference>R0</DealReleaseReference>", "<DealReleaseReference>R99</DealReleaseReference>", 1))
+
+		code, stdout, _ := run(t, path)
+		if code != 1 {
+			t.Errorf("Expected exit code 1, got %d", code)
+		}
+		if !strings.Contains(stdout, "R99 is not declared in this message") {
+			t.Errorf("Expected the dangling reference to be reported, got %q", stdout)
+		}
+	})
+
+	t.Run("Unparseable", func(t *testing.T) {
+		path := writeFile(t, "<Catalog/>")
+
+		code, stdout, _ := run(t, path)
+		if code != 1 || !strings.HasPrefix(stdout, "❌ "+path+": ") {
+			t.Errorf("Expected exit code 1 and a failure line, got %d: %q", code, stdout)
+		}
+	})
+
+	t.Run("Missing File", func(t *testing.T) {
+		code, stdout, stderr := run(t, filepath.Join(t.TempDir(), "missing.xml"))
+		if code != 1 || stdout != "" || !strings.HasPrefix(stderr, "Failed to read file: ") {
+			t.Errorf("Expected exit code 1 and a read error, got %d: %q %q", code, stdout, stderr)
+		}
+	})
+}
+
+// TestSupported checks the supported command prints the manifest as JSON
+func TestSupported(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := supported(&stdout, &stderr); code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr.String())
+	}
+
+	var manifest []map[string]any
+	if err := json.Unmarshal(stdout.Bytes(), &manifest); err != nil {
+		t.Fatalf("Failed to decode manifest: %v", err)
+	}
+	if len(manifest) == 0 {
+		t.Error("Expected supported messages in the manifest")
+	}
+}
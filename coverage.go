@@ -0,0 +1,102 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// Coverage reports which element and attribute paths of a document survive a
+// parse and marshal round-trip. Paths look like /Root/Child@Attribute, with
+// repeated elements collapsed into a single path.
+type Coverage struct {
+	Total     int
+	Covered   []string
+	Uncovered []string
+}
+
+// Percent returns the share of paths preserved, 100 for a document with none
+func (c *Coverage) Percent() float64 {
+	if c.Total == 0 {
+		return 100
+	}
+	return float64(len(c.Covered)) / float64(c.Total) * 100
+}
+
+// CoverageReport parses any supported message, marshals it back to XML and
+// reports the source paths the generated structs drop, so fields missing
+// from the schema conversion can be found across a corpus
+func CoverageReport(xmlData []byte) (*Coverage, error) {
+	originalDoc := etree.NewDocument()
+	if err := originalDoc.ReadFromBytes(xmlData); err != nil {
+		return nil, fmt.Errorf("failed to read original XML: %w", err)
+	}
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaledXML, err := xml.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	marshaledDoc := etree.NewDocument()
+	if err := marshaledDoc.ReadFromBytes(marshaledXML); err != nil {
+		return nil, fmt.Errorf("failed to read marshaled XML: %w", err)
+	}
+
+	marshaledPaths := make(map[string]bool)
+	for _, p := range collectAllPaths(marshaledDoc.Root(), "") {
+		marshaledPaths[p] = true
+	}
+
+	coverage := &Coverage{Covered: []string{}, Uncovered: []string{}}
+	seen := make(map[string]bool)
+	for _, p := range collectAllPaths(originalDoc.Root(), "") {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+
+		if marshaledPaths[p] {
+			coverage.Covered = append(coverage.Covered, p)
+		} else {
+			coverage.Uncovered = append(coverage.Uncovered, p)
+		}
+	}
+	coverage.Total = len(seen)
+	sort.Strings(coverage.Covered)
+	sort.Strings(coverage.Uncovered)
+
+	return coverage, nil
+}
+
+// collectAllPaths collects the element and attribute paths in the XML, one
+// per occurrence. Namespace prefixes and declarations are ignored.
+func collectAllPaths(elem *etree.Element, parentPath string) []string {
+	if elem == nil {
+		return []string{}
+	}
+
+	currentPath := parentPath + "/" + elem.Tag
+	paths := []string{currentPath}
+
+	// Add attribute paths
+	for _, attr := range elem.Attr {
+		if !strings.HasPrefix(attr.Key, "xmlns") && attr.Space != "xmlns" {
+			paths = append(paths, currentPath+"@"+attr.Key)
+		}
+	}
+
+	// Recursively collect from children
+	for _, child := range elem.ChildElements() {
+		paths = append(paths, collectAllPaths(child, currentPath)...)
+	}
+
+	return paths
+}
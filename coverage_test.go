@@ -0,0 +1,175 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestCoverageReport validates path coverage across all message kinds
+func TestCoverageReport(t *testing.T) {
+	testCases := []struct {
+		name     string
+		xmlPath  string
+		complete bool // known to round-trip without dropping paths
+	}{
+		{"ERN", filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml"), true},
+		{"ERN Rich Header", filepath.Join("testdata", "ernv432", "rich_header_example.xml"), true},
+		{"MEAD", filepath.Join("testdata", "meadv11", "mead_award_example.xml"), true},
+		{"MEAD Release Information", filepath.Join("testdata", "meadv11", "mead_release_information_example.xml"), true},
+		{"PIE", filepath.Join("testdata", "piev10", "pie_award_example.xml"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			xmlData, err := os.ReadFile(tc.xmlPath)
+			if err != nil {
+				t.Skipf("Sample file not found: %s", tc.xmlPath)
+			}
+
+			coverage, err := CoverageReport(xmlData)
+			if err != nil {
+				t.Fatalf("CoverageReport failed: %v", err)
+			}
+
+			if coverage.Total == 0 {
+				t.Fatal("Expected paths to be collected")
+			}
+			if n := len(coverage.Covered) + len(coverage.Uncovered); n != coverage.Total {
+				t.Errorf("Covered and uncovered paths sum to %d, want %d", n, coverage.Total)
+			}
+			if len(coverage.Uncovered) > 0 {
+				if tc.complete {
+					t.Errorf("Expected full coverage, %.1f%% with uncovered %v", coverage.Percent(), coverage.Uncovered)
+				} else {
+					t.Logf("Coverage %.1f%%, uncovered %v", coverage.Percent(), coverage.Uncovered)
+				}
+			}
+		})
+	}
+
+	t.Run("Header Blocks", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "rich_header_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		header := msg.(*ernv432.PurgeReleaseMessage).GetMessageHeader()
+
+		if got := header.GetSentOnBehalfOf().GetPartyId(); got != "PADPIDA2007050903U" {
+			t.Errorf("SentOnBehalfOf = %q", got)
+		}
+		if got := header.GetSentAsRequestedBy().GetPartyId(); got != "PADPIDA2007050902U" {
+			t.Errorf("SentAsRequestedBy = %q", got)
+		}
+		events := header.GetMessageAuditTrail().GetMessageAuditTrailEvent()
+		if len(events) != 2 || events[1].GetDateTime() != "2024-03-01T11:30:00Z" {
+			t.Errorf("Expected 2 audit trail events, got %v", events)
+		}
+		if got := header.GetMessageSender().GetPartyName().GetAbbreviatedName(); got != "WMG" {
+			t.Errorf("Sender AbbreviatedName = %q", got)
+		}
+	})
+
+	t.Run("MEAD Enrichment", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "meadv11", "mead_release_information_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		mead := msg.(*meadv11.MeadMessage)
+
+		// Coverage alone would count elements kept as raw xs:any content
+		_ = Walk(mead, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+			if fd.Name() == "any_element" {
+				t.Errorf("%s kept as extension content instead of typed fields", path)
+			}
+			return nil
+		})
+
+		release := mead.GetReleaseInformationList().GetReleaseInformation()[0]
+		if moods := release.GetMood(); len(moods) != 2 || moods[0].GetValue().GetValue() != "Chill" || moods[0].GetMoodType() != "LyricsAndMelody" {
+			t.Errorf("Expected 2 moods starting with Chill, got %v", moods)
+		}
+		if got := release.GetTheme()[0].GetValue().GetValue(); got != "Adoration" {
+			t.Errorf("Theme = %q", got)
+		}
+		if got := release.GetHistoricChartingInformation()[0].GetTopPosition(); got != 1 {
+			t.Errorf("TopPosition = %d", got)
+		}
+		if got := release.GetPriorityPeriodEndDate(); got != "2022-12-31" {
+			t.Errorf("PriorityPeriodEndDate = %q", got)
+		}
+
+		resource := mead.GetResourceInformationList().GetResourceInformation()[0]
+		if got := resource.GetTempo()[0].GetValue(); got != "Andante" {
+			t.Errorf("Tempo = %q", got)
+		}
+		if instruments := resource.GetInstrumentUsed(); len(instruments) != 2 || !instruments[0].GetIsFeatured() || instruments[0].GetValue().GetValue() != "Piano" {
+			t.Errorf("Expected a featured piano and a bass, got %v", instruments)
+		}
+	})
+
+	t.Run("MEAD Display Titles", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "meadv11", "mead_award_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		mead := msg.(*meadv11.MeadMessage)
+		if err := Validate(mead); err != nil {
+			t.Errorf("Validate failed: %v", err)
+		}
+
+		titles := mead.GetReleaseInformationList().GetReleaseInformation()[0].GetReleaseSummary().GetDisplayTitle()
+		want := [][2]string{
+			{"Come Away With Me", ""},
+			{"Come Away With Me (Deluxe Edition)", "Deluxe Edition"},
+			{"Come Away With Me (20th Anniversary Edition)", "20th Anniversary Edition"},
+		}
+		if len(titles) != len(want) {
+			t.Fatalf("Expected %d display titles, got %d", len(want), len(titles))
+		}
+		for i, title := range titles {
+			var subTitle string
+			if subTitles := title.GetSubTitle(); len(subTitles) > 0 {
+				subTitle = subTitles[0].GetTitle()
+			}
+			if got := [2]string{title.GetTitleText().GetTitle(), subTitle}; got != want[i] {
+				t.Errorf("DisplayTitle[%d] = %q, want %q", i, got, want[i])
+			}
+		}
+	})
+
+	t.Run("Dropped Paths", func(t *testing.T) {
+		coverage, err := CoverageReport([]byte(`<ern:PurgeReleaseMessage xmlns:ern="http://ddex.net/xml/ern/432"><Unknown Flag="1"/></ern:PurgeReleaseMessage>`))
+		if err != nil {
+			t.Fatalf("CoverageReport failed: %v", err)
+		}
+
+		want := []string{"/PurgeReleaseMessage/Unknown", "/PurgeReleaseMessage/Unknown@Flag"}
+		if len(coverage.Uncovered) != len(want) || coverage.Uncovered[0] != want[0] || coverage.Uncovered[1] != want[1] {
+			t.Errorf("Expected uncovered %v, got %v", want, coverage.Uncovered)
+		}
+	})
+
+	if _, err := CoverageReport([]byte("<Catalog/>")); err == nil {
+		t.Error("Expected error for unsupported message")
+	}
+}
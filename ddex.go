@@ -1,6 +1,7 @@
 package ddex
 
 import (
+	"bytes"
 	"encoding/xml"
 	"fmt"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
 	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
 	piev10 "github.com/alecsavvy/ddex-go/gen/ddex/pie/v10"
+	"google.golang.org/protobuf/proto"
 )
 
 // Versioned type aliases for discoverability of pure XML types
@@ -22,6 +24,7 @@ type (
 	// ERN v3.8.3 - Main message types
 	NewReleaseMessageV383   = ernv383.NewReleaseMessage
 	PurgeReleaseMessageV383 = ernv383.PurgeReleaseMessage
+	CatalogListMessageV383  = ernv383.CatalogListMessage
 
 	// ERN v4.3.2 - Main message types
 	NewReleaseMessageV432   = ernv432.NewReleaseMessage
@@ -44,97 +47,260 @@ const (
 	ERNv432 ERNVersion = "432"
 )
 
-// ERNMessage represents any ERN message type
+// Header is the message header of any root message that carries one, read
+// the same way whatever the standard or version. Every generated root with a
+// MessageHeader implements it, so logging and routing can be written once.
+type Header interface {
+	GetMessageId() string
+	GetMessageSender() string // PartyId of the MessageSender
+	GetCreatedDateTime() string
+}
+
+// Compile-time checks that each non-ERN root with a MessageHeader implements Header
+var (
+	_ Header = (*meadv11.MeadMessage)(nil)
+	_ Header = (*piev10.PieMessage)(nil)
+	_ Header = (*piev10.PieRequestMessage)(nil)
+)
+
+// ERNMessage represents any ERN message type. Every root message of every
+// ERN version implements it, so code reading the envelope can be written
+// once across versions; type switch on the concrete message for the rest.
 type ERNMessage interface {
-	// All ERN messages can be marshaled to XML
+	proto.Message
 	xml.Marshaler
+	xml.Unmarshaler
+	Header
+
+	GetLanguageAndScriptCode() string
+	GetXmlnsErn() string
+	GetXsiSchemaLocation() string
 }
 
-// DetectERNVersion detects the ERN version from XML content
+// Compile-time checks that each ERN root message implements ERNMessage
+var (
+	_ ERNMessage = (*ernv383.NewReleaseMessage)(nil)
+	_ ERNMessage = (*ernv383.PurgeReleaseMessage)(nil)
+	_ ERNMessage = (*ernv383.CatalogListMessage)(nil)
+	_ ERNMessage = (*ernv43.NewReleaseMessage)(nil)
+	_ ERNMessage = (*ernv43.PurgeReleaseMessage)(nil)
+	_ ERNMessage = (*ernv432.NewReleaseMessage)(nil)
+	_ ERNMessage = (*ernv432.PurgeReleaseMessage)(nil)
+)
+
+// namespaceDeclPattern matches the URI of each namespace declaration
+var namespaceDeclPattern = regexp.MustCompile(`xmlns(?::[\w.-]+)?\s*=\s*["']([^"']*)["']`)
+
+// ernVersionPattern matches the version of a normalized ERN namespace, e.g.
+// 432 in http://ddex.net/xml/ern/432, also written v4.3.2 by some tools
+var ernVersionPattern = regexp.MustCompile(`^` + regexp.QuoteMeta(ernNamespacePrefix) + `v?(\d+(?:\.\d+)*)$`)
+
+// DetectERNVersion detects the ERN version from XML content. Namespaces are
+// normalized first, so near misses seen in the wild (a trailing slash, https,
+// www.ddex.net or ddexnet.net) route to the version they name.
 func DetectERNVersion(xmlData []byte) (ERNVersion, error) {
-	xmlStr := string(xmlData)
+	return detectERNVersion(DefaultRegistry, xmlData)
+}
+
+// detectERNVersion implements DetectERNVersion against registry
+func detectERNVersion(registry *Registry, xmlData []byte) (ERNVersion, error) {
+	for _, m := range namespaceDeclPattern.FindAllSubmatch(xmlData, -1) {
+		matches := ernVersionPattern.FindStringSubmatch(normalizeDDEXNamespace(string(m[1])))
+		if matches == nil {
+			continue
+		}
+
+		version := strings.ReplaceAll(matches[1], ".", "")
+		if !registry.HasNamespace(ernNamespacePrefix + version) {
+			return "", fmt.Errorf("unsupported ERN version: %s", version)
+		}
+		return ERNVersion(version), nil
+	}
+
+	return "", fmt.Errorf("could not detect ERN version from XML")
+}
 
-	// Look for ERN namespace patterns
-	ernPattern := regexp.MustCompile(`xmlns(?::\w+)?="http://ddex\.net/xml/ern/v?(\d+(?:\.\d+)*)`)
-	matches := ernPattern.FindStringSubmatch(xmlStr)
+// rootERNVersion returns the ERN version of the namespace the root element
+// is in, or failing that the first ERN namespace it declares
+func rootERNVersion(start xml.StartElement) (ERNVersion, bool) {
+	namespaces := []string{start.Name.Space}
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+			namespaces = append(namespaces, attr.Value)
+		}
+	}
 
-	if len(matches) < 2 {
-		return "", fmt.Errorf("could not detect ERN version from XML")
+	for _, namespace := range namespaces {
+		if matches := ernVersionPattern.FindStringSubmatch(normalizeDDEXNamespace(namespace)); matches != nil {
+			return ERNVersion(strings.ReplaceAll(matches[1], ".", "")), true
+		}
 	}
+	return "", false
+}
 
-	version := strings.ReplaceAll(matches[1], ".", "")
+// normalizeDDEXNamespace canonicalizes the scheme and host of a DDEX
+// namespace URI and strips trailing slashes. Other URIs are returned as is.
+func normalizeDDEXNamespace(namespace string) string {
+	namespace = strings.TrimSpace(namespace)
+	scheme, rest, ok := strings.Cut(namespace, "://")
+	if !ok || !strings.EqualFold(scheme, "http") && !strings.EqualFold(scheme, "https") {
+		return namespace
+	}
 
-	switch version {
-	case "43":
-		return ERNv43, nil
-	case "383":
-		return ERNv383, nil
-	case "432":
-		return ERNv432, nil
+	host, path, _ := strings.Cut(rest, "/")
+	switch strings.ToLower(host) {
+	case "ddex.net", "www.ddex.net", "ddexnet.net", "www.ddexnet.net", "ddexnet":
 	default:
-		return "", fmt.Errorf("unsupported ERN version: %s", version)
+		return namespace
+	}
+	return "http://ddex.net/" + strings.TrimRight(path, "/")
+}
+
+// ParseERN automatically detects version and parses ERN XML to appropriate message type.
+// The concrete type follows the root element, e.g. *PurgeReleaseMessageV432
+// for a purge, so callers type switch on the result. Malformed XML fails
+// with a *ParseError locating the problem. Gzipped input is decompressed first.
+func ParseERN(xmlData []byte) (msg ERNMessage, version ERNVersion, err error) {
+	var root xml.Name
+	if done := observeParse("ParseERN", len(xmlData)); done != nil {
+		defer func() { done(root, err) }()
 	}
+	msg, version, root, err = parseERN(DefaultRegistry, xmlData)
+	return msg, version, err
 }
 
-// ParseERN automatically detects version and parses ERN XML to appropriate message type
-func ParseERN(xmlData []byte) (ERNMessage, ERNVersion, error) {
-	version, err := DetectERNVersion(xmlData)
+// parseERN implements ParseERN against registry, also returning the root
+// element it resolved
+func parseERN(registry *Registry, xmlData []byte) (ERNMessage, ERNVersion, xml.Name, error) {
+	xmlData, err := decompress(xmlData)
+	if err != nil {
+		return nil, "", xml.Name{}, err
+	}
+
+	version, err := detectERNVersion(registry, xmlData)
 	if err != nil {
-		return nil, "", err
+		return nil, "", xml.Name{}, err
 	}
 
-	message, err := ParseERNWithVersion(xmlData, version)
-	return message, version, err
+	message, root, err := parseERNWithVersion(registry, xmlData, version)
+	return message, version, root, err
 }
 
 // ParseERNWithVersion parses ERN XML to specific version message type
-func ParseERNWithVersion(xmlData []byte, version ERNVersion) (ERNMessage, error) {
-	xmlStr := string(xmlData)
-
-	// Determine message type (handle both namespaced and non-namespaced forms)
-	if strings.Contains(xmlStr, "NewReleaseMessage") {
-		return parseNewReleaseMessage(xmlData, version)
-	} else if strings.Contains(xmlStr, "PurgeReleaseMessage") {
-		return parsePurgeReleaseMessage(xmlData, version)
-	}
-
-	return nil, fmt.Errorf("unknown ERN message type")
-}
-
-func parseNewReleaseMessage(xmlData []byte, version ERNVersion) (ERNMessage, error) {
-	switch version {
-	case ERNv43:
-		var msg NewReleaseMessageV43
-		err := xml.Unmarshal(xmlData, &msg)
-		return &msg, err
-	case ERNv383:
-		var msg NewReleaseMessageV383
-		err := xml.Unmarshal(xmlData, &msg)
-		return &msg, err
-	case ERNv432:
-		var msg NewReleaseMessageV432
-		err := xml.Unmarshal(xmlData, &msg)
-		return &msg, err
-	default:
-		return nil, fmt.Errorf("unsupported ERN version: %s", version)
-	}
-}
-
-func parsePurgeReleaseMessage(xmlData []byte, version ERNVersion) (ERNMessage, error) {
-	switch version {
-	case ERNv43:
-		var msg PurgeReleaseMessageV43
-		err := xml.Unmarshal(xmlData, &msg)
-		return &msg, err
-	case ERNv383:
-		var msg PurgeReleaseMessageV383
-		err := xml.Unmarshal(xmlData, &msg)
-		return &msg, err
-	case ERNv432:
-		var msg PurgeReleaseMessageV432
-		err := xml.Unmarshal(xmlData, &msg)
-		return &msg, err
-	default:
-		return nil, fmt.Errorf("unsupported ERN version: %s", version)
+func ParseERNWithVersion(xmlData []byte, version ERNVersion) (msg ERNMessage, err error) {
+	var root xml.Name
+	if done := observeParse("ParseERNWithVersion", len(xmlData)); done != nil {
+		defer func() { done(root, err) }()
+	}
+	msg, root, err = parseERNWithVersion(DefaultRegistry, xmlData, version)
+	return msg, err
+}
+
+// parseERNWithVersion implements ParseERNWithVersion against registry, also
+// returning the root element it resolved
+func parseERNWithVersion(registry *Registry, xmlData []byte, version ERNVersion) (ERNMessage, xml.Name, error) {
+	xmlData, err := decompress(xmlData)
+	if err != nil {
+		return nil, xml.Name{}, err
+	}
+
+	decoder := newDecoder(xmlData)
+
+	start, err := nextStartElement(decoder)
+	if err != nil {
+		return nil, xml.Name{}, newParseError(decoder, xmlData, err)
+	}
+	return decodeERN(registry, decoder, start, xmlData, version)
+}
+
+// decodeERN decodes the ERN message of the given version whose root element
+// decoder has just read as start
+func decodeERN(registry *Registry, decoder *xml.Decoder, start xml.StartElement, xmlData []byte, version ERNVersion) (ERNMessage, xml.Name, error) {
+	root := xml.Name{Space: ernNamespacePrefix + string(version), Local: start.Name.Local}
+	factory, ok := registry.Lookup(root.Space, root.Local)
+	if !ok {
+		if !registry.HasNamespace(root.Space) {
+			return nil, root, fmt.Errorf("unsupported ERN version: %s", version)
+		}
+		return nil, root, fmt.Errorf("unknown ERN message type: %s", start.Name.Local)
+	}
+
+	msg, ok := factory().(ERNMessage)
+	if !ok {
+		return nil, root, fmt.Errorf("registered ERN %s %s does not implement ERNMessage", version, start.Name.Local)
+	}
+	if err := decoder.DecodeElement(msg, &start); err != nil {
+		return msg, root, newParseError(decoder, xmlData, err)
+	}
+	return msg, root, nil
+}
+
+// ernNamespacePrefix is shared by the namespaces of all ERN versions
+const ernNamespacePrefix = "http://ddex.net/xml/ern/"
+
+// ParseDDEX detects the message kind from the root element and parses any
+// message registered with DefaultRegistry to the appropriate type. Malformed
+// XML fails with a *ParseError locating the problem. Gzipped input is
+// decompressed first.
+func ParseDDEX(xmlData []byte) (msg proto.Message, err error) {
+	var root xml.Name
+	if done := observeParse("ParseDDEX", len(xmlData)); done != nil {
+		defer func() { done(root, err) }()
+	}
+	msg, root, err = parseDDEX(DefaultRegistry, xmlData)
+	return msg, err
+}
+
+// parseDDEX implements ParseDDEX against registry, also returning the root
+// element it resolved
+func parseDDEX(registry *Registry, xmlData []byte) (proto.Message, xml.Name, error) {
+	xmlData, err := decompress(xmlData)
+	if err != nil {
+		return nil, xml.Name{}, err
+	}
+
+	decoder := newDecoder(xmlData)
+
+	start, err := nextStartElement(decoder)
+	if err != nil {
+		return nil, xml.Name{}, newParseError(decoder, xmlData, err)
+	}
+
+	root, factory, ok := registry.resolve(start)
+	if !ok {
+		return nil, root, fmt.Errorf("unsupported DDEX message: %s (namespace %q)", root.Local, root.Space)
+	}
+
+	// Decode the body with the same decoder rather than re-scanning the document
+	msg := factory()
+	if err := decoder.DecodeElement(msg, &start); err != nil {
+		return nil, root, newParseError(decoder, xmlData, err)
+	}
+	return msg, root, nil
+}
+
+// newDecoder returns a decoder over xmlData. xml.Decoder can't be reset,
+// so each parse makes one and decodes the body on the decoder that found
+// the root rather than scanning the document twice.
+func newDecoder(xmlData []byte) *xml.Decoder {
+	return xml.NewDecoder(bytes.NewReader(xmlData))
+}
+
+// detectRootElement returns the start element of the document's root
+func detectRootElement(xmlData []byte) (xml.StartElement, error) {
+	decoder := newDecoder(xmlData)
+	return nextStartElement(decoder)
+}
+
+// nextStartElement reads tokens until the first start element
+func nextStartElement(decoder *xml.Decoder) (xml.StartElement, error) {
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return xml.StartElement{}, fmt.Errorf("could not find root element: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start, nil
+		}
 	}
 }
@@ -1,13 +1,19 @@
 package ddex
 
 import (
+	"bytes"
 	"encoding/xml"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
 	// Proto-generated implementations
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
 	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
 	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
 	piev10 "github.com/alecsavvy/ddex-go/gen/ddex/pie/v10"
@@ -28,7 +34,8 @@ var (
 	}
 
 	meadTestFiles = map[string]string{
-		"Award Example": "mead_award_example.xml",
+		"Award Example":               "mead_award_example.xml",
+		"Release Information Example": "mead_release_information_example.xml",
 	}
 
 	pieTestFiles = map[string]string{
@@ -62,6 +69,47 @@ func TestDDEXConformance(t *testing.T) {
 		}
 	})
 
+	// Test ERN roots other than NewReleaseMessage, routed by ParseERN
+	t.Run("ERN Catalog List", func(t *testing.T) {
+		t.Parallel()
+
+		xmlPath := filepath.Join("testdata", "ernv383", "catalog_list_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		msg, version, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", xmlPath, err)
+		}
+		catalog, ok := msg.(*CatalogListMessageV383)
+		if !ok || version != ERNv383 {
+			t.Fatalf("Expected ERN 383 *CatalogListMessageV383, got %s %T", version, msg)
+		}
+		if catalog.GetMessageId() != "C83814162" || catalog.GetPublicationDate() != "2024-03-01T00:00:00Z" {
+			t.Errorf("Unexpected header: %q, %q", catalog.GetMessageId(), catalog.GetPublicationDate())
+		}
+		items := catalog.GetCatalogItem()
+		if len(items) != 2 {
+			t.Fatalf("Expected 2 catalog items, got %d", len(items))
+		}
+		if got := items[0].GetReleaseId()[0].GetGRid(); got != "A10302B0001234567X" {
+			t.Errorf("GRid = %q", got)
+		}
+		if got := len(items[1].GetTerritoryCode()); got != 2 {
+			t.Errorf("Expected 2 territory codes, got %d", got)
+		}
+
+		result, err := RoundTripReport(xmlData)
+		if err != nil {
+			t.Fatalf("RoundTripReport failed: %v", err)
+		}
+		if !result.Success {
+			t.Errorf("Round-trip lost data: missing %v %v, mismatched %v", result.MissingElements, result.MissingAttributes, result.ValueMismatches)
+		}
+	})
+
 	// Test MEAD messages
 	t.Run("MEAD", func(t *testing.T) {
 		t.Parallel()
@@ -154,7 +202,7 @@ func TestFieldCompleteness(t *testing.T) {
 				}
 
 				if msg.ReleaseList != nil {
-					releaseCount := countERNReleases(msg.ReleaseList)
+					releaseCount := Stats(&msg).Releases
 					if releaseCount == 0 {
 						t.Error("ReleaseList contains no releases")
 					} else {
@@ -246,11 +294,7 @@ func TestFieldCompleteness(t *testing.T) {
 					} else {
 						t.Logf("✓ Found %d party(ies) in %s", partyCount, filename)
 
-						// Count awards
-						totalAwards := 0
-						for _, party := range msg.PartyList.Party {
-							totalAwards += len(party.Award)
-						}
+						totalAwards := Stats(&msg).Awards
 						if totalAwards > 0 {
 							t.Logf("✓ Found %d total award(s) across all parties", totalAwards)
 						}
@@ -279,6 +323,409 @@ func TestXMLTagsEffectiveness(t *testing.T) {
 	})
 }
 
+// TestRootConstructors validates that generated constructors pre-populate namespace fields
+func TestRootConstructors(t *testing.T) {
+	t.Run("ERN", func(t *testing.T) {
+		msg := ernv432.NewNewReleaseMessage()
+		if msg.XmlnsErn != ernv432.Namespace {
+			t.Errorf("XmlnsErn = %q, want %q", msg.XmlnsErn, ernv432.Namespace)
+		}
+		if msg.XmlnsXsi != ernv432.NamespaceXSI {
+			t.Errorf("XmlnsXsi = %q, want %q", msg.XmlnsXsi, ernv432.NamespaceXSI)
+		}
+		if msg.XsiSchemaLocation != ernv432.SchemaLocation {
+			t.Errorf("XsiSchemaLocation = %q, want %q", msg.XsiSchemaLocation, ernv432.SchemaLocation)
+		}
+
+		data, err := xml.Marshal(msg)
+		if err != nil {
+			t.Fatalf("Failed to marshal: %v", err)
+		}
+		if !strings.Contains(string(data), `xmlns:ern="`+ernv432.Namespace+`"`) {
+			t.Errorf("Marshaled XML missing ERN namespace declaration: %s", data)
+		}
+	})
+
+	t.Run("MEAD", func(t *testing.T) {
+		msg := meadv11.NewMeadMessage()
+		if msg.XmlnsMead != meadv11.Namespace {
+			t.Errorf("XmlnsMead = %q, want %q", msg.XmlnsMead, meadv11.Namespace)
+		}
+	})
+
+	t.Run("PIE", func(t *testing.T) {
+		for _, ns := range []string{piev10.NewPieMessage().XmlnsPie, piev10.NewPieRequestMessage().XmlnsPie} {
+			if ns != piev10.Namespace {
+				t.Errorf("XmlnsPie = %q, want %q", ns, piev10.Namespace)
+			}
+		}
+	})
+
+	t.Run("Marshal Defaults", func(t *testing.T) {
+		// MarshalXML fills in namespace fields left empty by a zero-value root
+		testCases := []struct {
+			msg                               any
+			prefix, namespace, schemaLocation string
+		}{
+			{&meadv11.MeadMessage{}, "mead", meadv11.Namespace, meadv11.SchemaLocation},
+			{&piev10.PieMessage{}, "pie", piev10.Namespace, piev10.SchemaLocation},
+			{&piev10.PieRequestMessage{}, "pie", piev10.Namespace, piev10.SchemaLocation},
+		}
+		for _, tc := range testCases {
+			data, err := xml.Marshal(tc.msg)
+			if err != nil {
+				t.Fatalf("Failed to marshal %T: %v", tc.msg, err)
+			}
+			for _, want := range []string{
+				`xmlns:` + tc.prefix + `="` + tc.namespace + `"`,
+				`xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`,
+				`xsi:schemaLocation="` + tc.schemaLocation + `"`,
+			} {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("%T: marshaled XML missing %s: %s", tc.msg, want, data)
+				}
+			}
+		}
+	})
+}
+
+// TestMessageFieldPresence validates that every message-typed field is generated as a
+// pointer, so an absent element stays nil instead of decoding to a zero struct
+func TestMessageFieldPresence(t *testing.T) {
+	t.Run("Pointer Fields", func(t *testing.T) {
+		checked := 0
+		protoregistry.GlobalTypes.RangeMessages(func(mt protoreflect.MessageType) bool {
+			desc := mt.Descriptor()
+			if !strings.HasPrefix(string(desc.FullName()), "ddex.") {
+				return true
+			}
+
+			goType := reflect.TypeOf(mt.Zero().Interface()).Elem()
+			fields := desc.Fields()
+			for i := 0; i < fields.Len(); i++ {
+				fd := fields.Get(i)
+				if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+					continue
+				}
+
+				var field reflect.StructField
+				ok := false
+				for j := 0; j < goType.NumField() && !ok; j++ {
+					if strings.Contains(goType.Field(j).Tag.Get("protobuf"), ",name="+string(fd.Name())+",") {
+						field, ok = goType.Field(j), true
+					}
+				}
+				if !ok {
+					t.Errorf("%s: no Go field for %s", desc.FullName(), fd.Name())
+					continue
+				}
+				if field.Type.Kind() != reflect.Ptr {
+					t.Errorf("%s.%s is %s, want pointer", goType.Name(), field.Name, field.Type)
+				}
+				checked++
+			}
+			return true
+		})
+		t.Logf("✓ Checked %d message-typed fields", checked)
+	})
+
+	t.Run("Absent Nested Message", func(t *testing.T) {
+		xmlData := []byte(`<ern:PurgeReleaseMessage xmlns:ern="` + ernv432.Namespace + `">
+  <MessageHeader>
+    <MessageId>1</MessageId>
+  </MessageHeader>
+</ern:PurgeReleaseMessage>`)
+
+		var msg ernv432.PurgeReleaseMessage
+		if err := xml.Unmarshal(xmlData, &msg); err != nil {
+			t.Fatalf("Failed to unmarshal: %v", err)
+		}
+		if msg.MessageHeader == nil {
+			t.Fatal("MessageHeader should be populated")
+		}
+		if msg.MessageHeader.MessageSender != nil {
+			t.Errorf("Absent MessageSender decoded to %+v, want nil", msg.MessageHeader.MessageSender)
+		}
+		if msg.PurgedRelease != nil {
+			t.Errorf("Absent PurgedRelease decoded to %+v, want nil", msg.PurgedRelease)
+		}
+	})
+}
+
+// TestRootElementEnforcement validates that typed unmarshaling rejects documents with a different root
+func TestRootElementEnforcement(t *testing.T) {
+	t.Run("Purge Into NewRelease", func(t *testing.T) {
+		purge := ernv432.NewPurgeReleaseMessage()
+		purge.MessageHeader = &ernv432.MessageHeader{MessageId: "1"}
+		xmlData, err := xml.Marshal(purge)
+		if err != nil {
+			t.Fatalf("Failed to marshal: %v", err)
+		}
+
+		var msg ernv432.NewReleaseMessage
+		err = xml.Unmarshal(xmlData, &msg)
+		if err == nil {
+			t.Fatal("Expected error unmarshaling PurgeReleaseMessage into NewReleaseMessage")
+		}
+		if !strings.Contains(err.Error(), "expected root element NewReleaseMessage, got PurgeReleaseMessage") {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if msg.MessageHeader != nil {
+			t.Error("MessageHeader should not be populated from the wrong root")
+		}
+	})
+
+	t.Run("Unrelated XML", func(t *testing.T) {
+		xmlData := []byte(`<Catalog><MessageHeader><MessageId>1</MessageId></MessageHeader></Catalog>`)
+
+		var msg meadv11.MeadMessage
+		if err := xml.Unmarshal(xmlData, &msg); err == nil {
+			t.Error("Expected error unmarshaling unrelated XML into MeadMessage")
+		}
+	})
+
+	t.Run("Matching Root", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "piev10", "pie_award_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		var msg piev10.PieMessage
+		if err := xml.Unmarshal(xmlData, &msg); err != nil {
+			t.Errorf("Failed to unmarshal matching root: %v", err)
+		}
+	})
+}
+
+// TestPackageUnmarshal validates the generated per-package Unmarshal helpers
+func TestPackageUnmarshal(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	t.Run("Typed Root", func(t *testing.T) {
+		xmlData := retargetERN432(xmlData)
+		msg, err := ernv432.UnmarshalNewReleaseMessage(xmlData)
+		if err != nil {
+			t.Fatalf("UnmarshalNewReleaseMessage failed: %v", err)
+		}
+		if msg.MessageHeader == nil || msg.MessageHeader.MessageId == "" {
+			t.Error("MessageHeader not populated")
+		}
+
+		if _, err := ernv432.UnmarshalPurgeReleaseMessage(xmlData); err == nil {
+			t.Error("Expected error decoding NewReleaseMessage as PurgeReleaseMessage")
+		}
+	})
+
+	t.Run("Any Root", func(t *testing.T) {
+		purgePath := filepath.Join("testdata", "ernv432", "purge_release_example.xml")
+		purgeData, err := os.ReadFile(purgePath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", purgePath)
+		}
+
+		msg, err := ernv432.Unmarshal(purgeData)
+		if err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if _, ok := msg.(*ernv432.PurgeReleaseMessage); !ok {
+			t.Errorf("Expected *PurgeReleaseMessage, got %T", msg)
+		}
+
+		if _, err := ernv432.Unmarshal([]byte(`<ern:Catalog xmlns:ern="` + ernv432.Namespace + `"/>`)); err == nil {
+			t.Error("Expected error for unknown root element")
+		}
+	})
+
+	t.Run("Wrong Namespace", func(t *testing.T) {
+		// The sample is an ERN 4.3 message, so its root is not in the 4.3.2 namespace
+		if _, err := ernv432.Unmarshal(xmlData); err == nil {
+			t.Error("Expected error for a root element outside Namespace")
+		}
+		if _, err := ernv432.Unmarshal([]byte("<PurgeReleaseMessage/>")); err == nil {
+			t.Error("Expected error for a root element without a namespace")
+		}
+		if _, err := ernv432.UnmarshalNewReleaseMessage(xmlData); err == nil {
+			t.Error("Expected error from the typed helper for a root element outside Namespace")
+		}
+		if _, err := ernv432.UnmarshalPurgeReleaseMessage([]byte("<PurgeReleaseMessage/>")); err == nil {
+			t.Error("Expected error from the typed helper for a root element without a namespace")
+		}
+	})
+
+	t.Run("Latin-1", func(t *testing.T) {
+		xmlData := []byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?><ern:PurgeReleaseMessage xmlns:ern=\"" + ernv432.Namespace + "\"><MessageHeader><MessageId>Caf\xe9</MessageId></MessageHeader></ern:PurgeReleaseMessage>")
+
+		msg, err := ernv432.UnmarshalPurgeReleaseMessage(xmlData)
+		if err != nil {
+			t.Fatalf("UnmarshalPurgeReleaseMessage failed: %v", err)
+		}
+		if got := msg.MessageHeader.MessageId; got != "Café" {
+			t.Errorf("Expected MessageId Café, got %q", got)
+		}
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		if _, err := ernv432.Unmarshal([]byte(`<ern:NewReleaseMessage xmlns:ern="` + ernv432.Namespace + `"><MessageHeader></ern:NewReleaseMessage>`)); err == nil {
+			t.Error("Expected error for malformed XML")
+		}
+	})
+}
+
+// TestDetectERNVersion validates version detection across namespace variants seen in the wild
+func TestDetectERNVersion(t *testing.T) {
+	document := func(namespace string) []byte {
+		return []byte(`<?xml version="1.0"?><ern:NewReleaseMessage xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:ern="` + namespace + `"><MessageHeader><MessageId>1</MessageId></MessageHeader></ern:NewReleaseMessage>`)
+	}
+
+	t.Run("Variants", func(t *testing.T) {
+		testCases := map[string]ERNVersion{
+			"http://ddex.net/xml/ern/432":        ERNv432,
+			"http://ddex.net/xml/ern/432/":       ERNv432,
+			"https://ddex.net/xml/ern/432":       ERNv432,
+			"HTTP://DDEX.NET/xml/ern/43":         ERNv43,
+			"http://www.ddex.net/xml/ern/383":    ERNv383,
+			"http://ddexnet.net/xml/ern/43//":    ERNv43,
+			"http://ddexnet/xml/ern/432":         ERNv432,
+			"https://www.ddex.net/xml/ern/v4.3/": ERNv43,
+		}
+		for namespace, want := range testCases {
+			version, err := DetectERNVersion(document(namespace))
+			if err != nil || version != want {
+				t.Errorf("DetectERNVersion(%s) = %q, %v, want %q", namespace, version, err, want)
+			}
+		}
+	})
+
+	t.Run("Parse Variant", func(t *testing.T) {
+		msg, version, err := ParseERN(document("https://ddex.net/xml/ern/432/"))
+		if err != nil {
+			t.Fatalf("ParseERN failed: %v", err)
+		}
+		release, ok := msg.(*ernv432.NewReleaseMessage)
+		if !ok || version != ERNv432 {
+			t.Fatalf("ParseERN = %T, %q, want ERN 432", msg, version)
+		}
+		if release.GetMessageHeader().GetMessageId() != "1" {
+			t.Errorf("MessageId = %q, want 1", release.GetMessageHeader().GetMessageId())
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		testCases := []string{
+			"http://ddex.net/xml/ern/999",
+			"http://example.com/xml/ern/432",
+			"http://ddex.net/xml/mead/11",
+			"http://ddex.net/xml/ern/latest",
+		}
+		for _, namespace := range testCases {
+			if version, err := DetectERNVersion(document(namespace)); err == nil {
+				t.Errorf("DetectERNVersion(%s) = %q, expected error", namespace, version)
+			}
+		}
+	})
+}
+
+// TestParseERN validates that ParseERN returns the concrete type matching the root element
+func TestParseERN(t *testing.T) {
+	t.Run("New Release", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		msg, _, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("ParseERN failed: %v", err)
+		}
+		if _, ok := msg.(*NewReleaseMessageV43); !ok {
+			t.Errorf("Expected *NewReleaseMessageV43, got %T", msg)
+		}
+	})
+
+	t.Run("Purge Release", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "purge_release_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		msg, version, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("ParseERN failed: %v", err)
+		}
+		purge, ok := msg.(*PurgeReleaseMessageV432)
+		if !ok || version != ERNv432 {
+			t.Fatalf("ParseERN = %T, %q, want *PurgeReleaseMessageV432", msg, version)
+		}
+		if got := purge.GetPurgedRelease().GetReleaseId().GetGRid(); got != "A10302B0001234567X" {
+			t.Errorf("GRid = %q, want A10302B0001234567X", got)
+		}
+		if got := purge.GetMessageHeader().GetMessageId(); got != "P83814162" {
+			t.Errorf("MessageId = %q, want P83814162", got)
+		}
+	})
+}
+
+// TestHeader validates that the message header reads the same across standards and versions
+func TestHeader(t *testing.T) {
+	testCases := map[string]struct {
+		xmlPath string
+		want    [3]string // MessageId, sender PartyId, MessageCreatedDateTime
+	}{
+		"ERN":  {filepath.Join("testdata", "ernv432", "purge_release_example.xml"), [3]string{"P83814162", "PADPIDA2007050901U", "2024-03-01T12:00:00Z"}},
+		"MEAD": {filepath.Join("testdata", "meadv11", "mead_award_example.xml"), [3]string{"5678", "PADPIDA1234567890", "2022-10-11T15:19:00+01:00"}},
+		"PIE":  {filepath.Join("testdata", "piev10", "pie_award_example.xml"), [3]string{"1234", "PADPIDA1234567890", "2022-10-11T15:19:00+01:00"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			xmlData, err := os.ReadFile(tc.xmlPath)
+			if err != nil {
+				t.Skipf("Sample file not found: %s", tc.xmlPath)
+			}
+
+			msg, err := ParseDDEX(xmlData)
+			if err != nil {
+				t.Fatalf("Failed to parse: %v", err)
+			}
+			header, ok := msg.(Header)
+			if !ok {
+				t.Fatalf("%T does not implement Header", msg)
+			}
+
+			got := [3]string{header.GetMessageId(), header.GetMessageSender(), header.GetCreatedDateTime()}
+			if got != tc.want {
+				t.Errorf("Header = %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Repeated Sender PartyId", func(t *testing.T) {
+		msg := &ernv383.NewReleaseMessage{
+			MessageHeader: &ernv383.MessageHeader{
+				MessageSender: &ernv383.MessagingParty{PartyId: []*ernv383.PartyId{{Value: "PADPIDA1"}, {Value: "PADPIDA2"}}},
+			},
+		}
+		var header Header = msg
+		if got := header.GetMessageSender(); got != "PADPIDA1" {
+			t.Errorf("GetMessageSender() = %q, want PADPIDA1", got)
+		}
+	})
+
+	t.Run("Missing Header", func(t *testing.T) {
+		var header Header = &ernv432.NewReleaseMessage{}
+		if header.GetMessageId() != "" || header.GetMessageSender() != "" || header.GetCreatedDateTime() != "" {
+			t.Error("Expected empty values without a MessageHeader")
+		}
+	})
+}
+
 // Benchmark tests
 func BenchmarkDDEX(b *testing.B) {
 	b.Run("ERN", func(b *testing.B) {
@@ -378,6 +825,47 @@ func BenchmarkDDEX(b *testing.B) {
 	})
 }
 
+// BenchmarkParseDDEXParallel compares ParseDDEX, which decodes the body on
+// the decoder that found the root, against naive per-call decoding that
+// scans the root with one decoder and unmarshals with another
+func BenchmarkParseDDEXParallel(b *testing.B) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		b.Skip("Sample file not found")
+	}
+
+	b.Run("Two Decoders", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				start, err := nextStartElement(xml.NewDecoder(bytes.NewReader(xmlData)))
+				if err != nil {
+					b.Error(err)
+					return
+				}
+				_, factory, _ := DefaultRegistry.resolve(start)
+				if err := xml.Unmarshal(xmlData, factory()); err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		})
+	})
+
+	b.Run("ParseDDEX", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := ParseDDEX(xmlData); err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		})
+	})
+}
+
 // Helper functions
 
 type fieldCheck struct {
@@ -437,7 +925,7 @@ func validateERNStructure(t *testing.T, msg *ernv432.NewReleaseMessage, filename
 		return
 	}
 
-	releaseCount := countERNReleases(msg.ReleaseList)
+	releaseCount := Stats(msg).Releases
 	if releaseCount == 0 {
 		t.Errorf("No releases found in %s", filename)
 	}
@@ -484,15 +972,3 @@ func validatePIEStructure(t *testing.T, msg *piev10.PieMessage, filename string)
 		t.Errorf("No parties found in %s", filename)
 	}
 }
-
-// Utility functions
-
-func countERNReleases(releaseList *ernv432.ReleaseList) int {
-	count := 0
-	if releaseList.Release != nil {
-		count++
-	}
-	count += len(releaseList.TrackRelease)
-	count += len(releaseList.ClipRelease)
-	return count
-}
@@ -0,0 +1,143 @@
+package ddex
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Delivery is an indexed ZIP batch of DDEX messages and their assets
+type Delivery struct {
+	// ManifestName and Manifest hold the batch manifest entry and its raw XML,
+	// both empty for flat batches without one
+	ManifestName string
+	Manifest     []byte
+
+	// Messages holds every parsed message in archive order
+	Messages []DeliveryMessage
+
+	// Files lists all non-XML entries in the archive
+	Files []string
+}
+
+// DeliveryMessage is a single parsed message within a Delivery
+type DeliveryMessage struct {
+	Name    string
+	Message proto.Message
+}
+
+// OpenDelivery indexes a ZIP delivery batch, parsing every XML entry with
+// ParseDDEX. A ManifestMessage or BatchComplete entry is kept as the
+// manifest; in a flat batch every .xml entry is treated as a standalone message.
+func OpenDelivery(r io.ReaderAt, size int64) (*Delivery, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open delivery: %w", err)
+	}
+
+	delivery := &Delivery{}
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() {
+			continue
+		}
+		if !strings.EqualFold(path.Ext(entry.Name), ".xml") {
+			delivery.Files = append(delivery.Files, entry.Name)
+			continue
+		}
+
+		data, err := readZipEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		if isManifest(entry.Name, data) {
+			if delivery.Manifest != nil {
+				return nil, fmt.Errorf("delivery has multiple manifests: %s and %s", delivery.ManifestName, entry.Name)
+			}
+			delivery.ManifestName = entry.Name
+			delivery.Manifest = data
+			continue
+		}
+
+		msg, err := ParseDDEX(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", entry.Name, err)
+		}
+		delivery.Messages = append(delivery.Messages, DeliveryMessage{Name: entry.Name, Message: msg})
+	}
+
+	return delivery, nil
+}
+
+// MediaFiles returns the sorted archive paths of every file referenced by the
+// delivery's messages, resolved relative to the referencing message
+func (d *Delivery) MediaFiles() []string {
+	seen := make(map[string]bool)
+
+	for _, m := range d.Messages {
+		dir := path.Dir(m.Name)
+		_ = Walk(m.Message, func(p string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+			if fd.Kind() != protoreflect.MessageKind || fd.Message().Name() != "File" {
+				return nil
+			}
+
+			if ref := fileReference(v.Message()); ref != "" && !strings.Contains(ref, "://") {
+				seen[path.Join(dir, ref)] = true
+			}
+			return SkipChildren
+		})
+	}
+
+	files := make([]string, 0, len(seen))
+	for f := range seen {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// fileReference extracts the referenced path from an ERN 4 style File (URI)
+// or an ERN 3 style File (FilePath and FileName)
+func fileReference(file protoreflect.Message) string {
+	fields := file.Descriptor().Fields()
+	if uri := fields.ByName("u_r_i"); uri != nil && file.Has(uri) {
+		return file.Get(uri).String()
+	}
+
+	name := fields.ByName("file_name")
+	if name == nil || !file.Has(name) {
+		return ""
+	}
+	if dir := fields.ByName("file_path"); dir != nil && file.Has(dir) {
+		return path.Join(file.Get(dir).String(), file.Get(name).String())
+	}
+	return file.Get(name).String()
+}
+
+func readZipEntry(entry *zip.File) ([]byte, error) {
+	rc, err := entry.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", entry.Name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", entry.Name, err)
+	}
+	return data, nil
+}
+
+func isManifest(name string, data []byte) bool {
+	if strings.HasPrefix(path.Base(name), "BatchComplete") {
+		return true
+	}
+	root, err := detectRootElement(data)
+	return err == nil && root.Name.Local == "ManifestMessage"
+}
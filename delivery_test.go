@@ -0,0 +1,97 @@
+package ddex
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// buildZip returns an in-memory ZIP archive holding the given entries
+func buildZip(t *testing.T, entries map[string][]byte) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, data := range entries {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+		if _, err := f.Write(data); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to close archive: %v", err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+// TestOpenDelivery validates indexing of batch and flat ZIP deliveries
+func TestOpenDelivery(t *testing.T) {
+	ernData, err := os.ReadFile(filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml"))
+	if err != nil {
+		t.Skip("Sample file not found")
+	}
+	meadData, err := os.ReadFile(filepath.Join("testdata", "meadv11", "mead_award_example.xml"))
+	if err != nil {
+		t.Skip("Sample file not found")
+	}
+
+	t.Run("Batch With Manifest", func(t *testing.T) {
+		r := buildZip(t, map[string][]byte{
+			"20240101/BatchComplete_20240101.xml":                               []byte(`<BatchComplete/>`),
+			"20240101/190295810726/190295810726.xml":                            ernData,
+			"20240101/190295810726/resources/190295810726_00001_LL.flac":        []byte("audio"),
+			"20240101/190295810726/resources/190295810726_T0_001_IMG_FRONT.jpg": []byte("image"),
+		})
+
+		delivery, err := OpenDelivery(r, r.Size())
+		if err != nil {
+			t.Fatalf("Failed to open delivery: %v", err)
+		}
+		if delivery.ManifestName != "20240101/BatchComplete_20240101.xml" {
+			t.Errorf("ManifestName = %q", delivery.ManifestName)
+		}
+		if len(delivery.Messages) != 1 {
+			t.Fatalf("Expected 1 message, got %d", len(delivery.Messages))
+		}
+		if len(delivery.Files) != 2 {
+			t.Errorf("Expected 2 media entries, got %v", delivery.Files)
+		}
+
+		want := []string{"20240101/190295810726/resources/190295810726_00001_LL.flac"}
+		if media := delivery.MediaFiles(); !reflect.DeepEqual(media, want) {
+			t.Errorf("MediaFiles = %v, want %v", media, want)
+		}
+	})
+
+	t.Run("Flat Batch", func(t *testing.T) {
+		r := buildZip(t, map[string][]byte{
+			"release.xml": ernData,
+			"award.xml":   meadData,
+		})
+
+		delivery, err := OpenDelivery(r, r.Size())
+		if err != nil {
+			t.Fatalf("Failed to open delivery: %v", err)
+		}
+		if delivery.Manifest != nil {
+			t.Error("Flat batch should have no manifest")
+		}
+
+		if len(delivery.Messages) != 2 {
+			t.Errorf("Expected 2 messages, got %d", len(delivery.Messages))
+		}
+	})
+
+	t.Run("Invalid XML", func(t *testing.T) {
+		r := buildZip(t, map[string][]byte{"broken.xml": []byte("<not-ddex/>")})
+		if _, err := OpenDelivery(r, r.Size()); err == nil {
+			t.Error("Expected error for non-DDEX XML entry")
+		}
+	})
+}
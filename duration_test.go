@@ -0,0 +1,112 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+)
+
+// TestDuration checks the generated time.Duration accessors for xs:duration fields
+func TestDuration(t *testing.T) {
+	t.Run("Parse", func(t *testing.T) {
+		tests := []struct {
+			value string
+			want  time.Duration
+		}{
+			{"PT3M45S", 3*time.Minute + 45*time.Second},
+			{"PT1H2M3.5S", time.Hour + 2*time.Minute + 3500*time.Millisecond},
+			{"P0DT0H3M45S", 3*time.Minute + 45*time.Second},
+			{"PT45S", 45 * time.Second},
+			{"P1DT2H", 26 * time.Hour},
+			{"PT0.000000001S", time.Nanosecond},
+			{"-PT10S", -10 * time.Second},
+			{"P0Y0M1D", 24 * time.Hour},
+		}
+		for _, tt := range tests {
+			sr := &ernv43.SoundRecording{Duration: tt.value}
+			got, err := sr.DurationValue()
+			if err != nil {
+				t.Errorf("%s: %v", tt.value, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%s = %v, want %v", tt.value, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, value := range []string{"", "P", "PT", "P1Y", "P2M", "3:45", "PT3M45", "PT1.S", "PT9999999999999H"} {
+			sr := &ernv43.SoundRecording{Duration: value}
+			if d, err := sr.DurationValue(); err == nil {
+				t.Errorf("%q parsed as %v, expected an error", value, d)
+			}
+		}
+	})
+
+	t.Run("Round Trip", func(t *testing.T) {
+		tests := []struct {
+			d    time.Duration
+			want string
+		}{
+			{3*time.Minute + 45*time.Second, "PT3M45S"},
+			{time.Hour + 2*time.Minute + 3500*time.Millisecond, "PT1H2M3.5S"},
+			{2 * time.Hour, "PT2H"},
+			{0, "PT0S"},
+			{250 * time.Millisecond, "PT0.25S"},
+			{-90 * time.Second, "-PT1M30S"},
+		}
+		for _, tt := range tests {
+			sr := &ernv43.SoundRecording{}
+			sr.SetDurationValue(tt.d)
+			if sr.Duration != tt.want {
+				t.Errorf("SetDurationValue(%v) = %s, want %s", tt.d, sr.Duration, tt.want)
+			}
+			if got, err := sr.DurationValue(); err != nil || got != tt.d {
+				t.Errorf("%s parsed back as %v, %v", sr.Duration, got, err)
+			}
+		}
+	})
+
+	t.Run("Optional Field", func(t *testing.T) {
+		chapter := &ernv43.Chapter{}
+		if d, err := chapter.StartTimeValue(); err == nil {
+			t.Errorf("unset StartTime parsed as %v, expected an error", d)
+		}
+		chapter.SetStartTimeValue(90 * time.Second)
+		if chapter.StartTime == nil || *chapter.StartTime != "PT1M30S" {
+			t.Errorf("StartTime = %v, want PT1M30S", chapter.StartTime)
+		}
+	})
+
+	t.Run("Sample File", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, _, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		release, ok := msg.(*ernv43.NewReleaseMessage)
+		if !ok {
+			t.Fatalf("Expected *ernv43.NewReleaseMessage, got %T", msg)
+		}
+
+		recordings := release.GetResourceList().GetSoundRecording()
+		if len(recordings) == 0 {
+			t.Fatal("Expected sound recordings")
+		}
+		got, err := recordings[0].DurationValue()
+		if err != nil {
+			t.Fatalf("DurationValue: %v", err)
+		}
+		if want := 2*time.Minute + 28*time.Second; got != want {
+			t.Errorf("Duration = %v, want %v", got, want)
+		}
+	})
+}
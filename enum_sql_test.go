@@ -0,0 +1,61 @@
+package ddex
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	avs "github.com/alecsavvy/ddex-go/gen/ddex/avs/vlatest"
+)
+
+// Compile-time checks that generated enums plug into database/sql
+var (
+	_ sql.Scanner   = (*avs.ParentalWarningType)(nil)
+	_ driver.Valuer = avs.ParentalWarningType(0)
+)
+
+// TestEnumSQL validates scanning DDEX strings into enums and valuing them back
+func TestEnumSQL(t *testing.T) {
+	t.Run("Round Trip", func(t *testing.T) {
+		for _, src := range []any{"NotExplicit", []byte("NotExplicit")} {
+			var warning avs.ParentalWarningType
+			if err := warning.Scan(src); err != nil {
+				t.Fatalf("Scan(%v) failed: %v", src, err)
+			}
+			if warning != avs.ParentalWarningType_PARENTAL_WARNING_TYPE_NOTEXPLICIT {
+				t.Errorf("Scan(%v) = %v, want NOTEXPLICIT", src, warning)
+			}
+
+			value, err := warning.Value()
+			if err != nil {
+				t.Fatalf("Value failed: %v", err)
+			}
+			if value != "NotExplicit" || value != warning.XMLString() {
+				t.Errorf("Value = %v, want %q", value, "NotExplicit")
+			}
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		warning := avs.ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICIT
+		if err := warning.Scan(nil); err != nil {
+			t.Fatalf("Scan(nil) failed: %v", err)
+		}
+		if warning != avs.ParentalWarningType_PARENTAL_WARNING_TYPE_UNSPECIFIED {
+			t.Errorf("Scan(nil) = %v, want UNSPECIFIED", warning)
+		}
+		if value, err := warning.Value(); err != nil || value != nil {
+			t.Errorf("Value of UNSPECIFIED = %v, %v, want nil", value, err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var warning avs.ParentalWarningType
+		if err := warning.Scan("NotAWarning"); err == nil {
+			t.Error("Expected error scanning an unknown value")
+		}
+		if err := warning.Scan(42); err == nil {
+			t.Error("Expected error scanning a non-string")
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package ddex
+
+import (
+	"testing"
+
+	avs "github.com/alecsavvy/ddex-go/gen/ddex/avs/vlatest"
+)
+
+// TestEnumParse validates the generated parsers for known and unknown values
+func TestEnumParse(t *testing.T) {
+	t.Run("Known", func(t *testing.T) {
+		for _, s := range []string{"NotExplicit", "NOTEXPLICIT", "notexplicit"} {
+			warning, ok := avs.ParseParentalWarningTypeString(s)
+			if !ok || warning != avs.ParentalWarningType_PARENTAL_WARNING_TYPE_NOTEXPLICIT {
+				t.Errorf("ParseParentalWarningTypeString(%q) = %v, %v, want NOTEXPLICIT", s, warning, ok)
+			}
+			if got := avs.MustParseParentalWarningTypeString(s); got != warning {
+				t.Errorf("MustParseParentalWarningTypeString(%q) = %v, want %v", s, got, warning)
+			}
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		warning, ok := avs.ParseParentalWarningTypeString("SomewhatExplicit")
+		if ok {
+			t.Error("Expected ok = false for an unknown value")
+		}
+		if warning != avs.ParentalWarningType_PARENTAL_WARNING_TYPE_UNSPECIFIED || warning.XMLString() != "" {
+			t.Errorf("Unknown value parsed to %v, want UNSPECIFIED", warning)
+		}
+
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected MustParseParentalWarningTypeString to panic on an unknown value")
+			}
+		}()
+		avs.MustParseParentalWarningTypeString("SomewhatExplicit")
+	})
+
+	t.Run("Unspecified Is Not Parsed", func(t *testing.T) {
+		if _, ok := avs.ParseParentalWarningTypeString("PARENTAL_WARNING_TYPE_UNSPECIFIED"); ok {
+			t.Error("Expected the UNSPECIFIED value name not to parse")
+		}
+		if _, ok := avs.ParseParentalWarningTypeString(""); ok {
+			t.Error("Expected an empty string not to parse")
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"testing"
+
+	avs "github.com/alecsavvy/ddex-go/gen/ddex/avs/vlatest"
+)
+
+// Compile-time checks that generated enums plug into encoding/xml
+var (
+	_ xml.Marshaler       = avs.ParentalWarningType(0)
+	_ xml.MarshalerAttr   = avs.ParentalWarningType(0)
+	_ xml.Unmarshaler     = (*avs.ParentalWarningType)(nil)
+	_ xml.UnmarshalerAttr = (*avs.ParentalWarningType)(nil)
+)
+
+// enumHolder carries an enum as an element, an attribute and a list
+type enumHolder struct {
+	XMLName  xml.Name                  `xml:"Holder"`
+	Type     avs.ParentalWarningType   `xml:"Type,attr"`
+	Warning  avs.ParentalWarningType   `xml:"ParentalWarningType"`
+	Warnings []avs.ParentalWarningType `xml:"Warning"`
+}
+
+// TestEnumXML validates that enums marshal as their DDEX string values and
+// that UNSPECIFIED produces no element or attribute
+func TestEnumXML(t *testing.T) {
+	t.Run("Unspecified Is Absent", func(t *testing.T) {
+		out, err := xml.Marshal(enumHolder{})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if string(out) != "<Holder></Holder>" {
+			t.Errorf("Expected no enum element or attribute, got %s", out)
+		}
+	})
+
+	t.Run("Round Trip", func(t *testing.T) {
+		in := enumHolder{
+			Type:     avs.ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICIT,
+			Warning:  avs.ParentalWarningType_PARENTAL_WARNING_TYPE_NOTEXPLICIT,
+			Warnings: []avs.ParentalWarningType{avs.ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICIT, 0},
+		}
+		out, err := xml.Marshal(in)
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		want := `<Holder Type="Explicit"><ParentalWarningType>NotExplicit</ParentalWarningType><Warning>Explicit</Warning></Holder>`
+		if string(out) != want {
+			t.Errorf("Marshal = %s, want %s", out, want)
+		}
+
+		var got enumHolder
+		if err := xml.Unmarshal(out, &got); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if got.Type != in.Type || got.Warning != in.Warning || len(got.Warnings) != 1 || got.Warnings[0] != in.Warnings[0] {
+			t.Errorf("Unmarshal = %+v, want %+v without the unspecified entry", got, in)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var got enumHolder
+		if err := xml.Unmarshal([]byte(`<Holder><ParentalWarningType>SomewhatExplicit</ParentalWarningType></Holder>`), &got); err == nil {
+			t.Error("Expected an error for an unknown value")
+		}
+		if _, err := xml.Marshal(enumHolder{Warning: 9999}); err == nil {
+			t.Error("Expected an error marshaling an undefined value")
+		}
+	})
+}
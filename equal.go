@@ -0,0 +1,36 @@
+package ddex
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EqualIgnoringNamespaces reports whether a and b hold the same content,
+// disregarding the xmlns:* and xsi:schemaLocation attributes and the root
+// prefix that only record how a document was serialized. Every other field,
+// including unset versus empty optional fields, must match.
+func EqualIgnoringNamespaces(a, b proto.Message) bool {
+	return proto.Equal(withoutNamespaceFields(a), withoutNamespaceFields(b))
+}
+
+// withoutNamespaceFields returns a copy of msg with its namespace
+// bookkeeping fields cleared
+func withoutNamespaceFields(msg proto.Message) proto.Message {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return msg
+	}
+	msg = proto.Clone(msg)
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		field := xmlFields(parent)[fd.Number()]
+		if field.Attr && (strings.HasPrefix(field.Name, "xmlns") || field.Name == "xsi:schemaLocation") {
+			parent.Clear(fd)
+		}
+		return nil
+	})
+	if fd := msg.ProtoReflect().Descriptor().Fields().ByName("root_prefix"); fd != nil {
+		msg.ProtoReflect().Clear(fd)
+	}
+	return msg
+}
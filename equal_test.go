@@ -0,0 +1,103 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+)
+
+// TestEqualIgnoringNamespaces validates that round-tripped samples compare
+// equal field by field and that content changes are still detected
+func TestEqualIgnoringNamespaces(t *testing.T) {
+	t.Run("Round Trip", func(t *testing.T) {
+		samples := []string{
+			filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml"),
+			filepath.Join("testdata", "ernv432", "Samples43", "2 Video.xml"),
+			filepath.Join("testdata", "ernv432", "Samples43", "3 MixedMedia.xml"),
+			filepath.Join("testdata", "ernv432", "Samples43", "8 DjMix.xml"),
+			filepath.Join("testdata", "ernv432", "purge_release_example.xml"),
+			filepath.Join("testdata", "meadv11", "mead_award_example.xml"),
+			filepath.Join("testdata", "piev10", "pie_award_example.xml"),
+		}
+		for _, xmlPath := range samples {
+			t.Run(filepath.Base(xmlPath), func(t *testing.T) {
+				xmlData, err := os.ReadFile(xmlPath)
+				if err != nil {
+					t.Skipf("Sample file not found: %s", xmlPath)
+				}
+
+				original, err := ParseDDEX(xmlData)
+				if err != nil {
+					t.Fatalf("Failed to parse: %v", err)
+				}
+				marshaled, err := xml.Marshal(original)
+				if err != nil {
+					t.Fatalf("Failed to marshal: %v", err)
+				}
+				parsed, err := ParseDDEX(marshaled)
+				if err != nil {
+					t.Fatalf("Failed to parse marshaled XML: %v", err)
+				}
+
+				if !EqualIgnoringNamespaces(original, parsed) {
+					t.Error("Expected round-tripped message to equal the original")
+				}
+			})
+		}
+	})
+
+	t.Run("Namespace Fields Ignored", func(t *testing.T) {
+		a := &ernv43.NewReleaseMessage{
+			XmlnsErn:          "http://ddex.net/xml/ern/43",
+			XmlnsXsi:          "http://www.w3.org/2001/XMLSchema-instance",
+			XsiSchemaLocation: "http://ddex.net/xml/ern/43 http://ddex.net/xml/ern/43/release-notification.xsd",
+			ReleaseList:       &ernv43.ReleaseList{},
+		}
+		b := &ernv43.NewReleaseMessage{ReleaseList: &ernv43.ReleaseList{}}
+
+		if !EqualIgnoringNamespaces(a, b) {
+			t.Error("Expected messages differing only in namespace fields to be equal")
+		}
+		if a.XmlnsErn == "" {
+			t.Error("EqualIgnoringNamespaces modified its argument")
+		}
+	})
+
+	t.Run("Content Differences", func(t *testing.T) {
+		a := &ernv43.NewReleaseMessage{
+			ResourceList: &ernv43.ResourceList{
+				SoundRecording: []*ernv43.SoundRecording{{ResourceReference: "A1"}},
+			},
+		}
+
+		b := a.Clone()
+		b.ResourceList.SoundRecording[0].ResourceReference = "A2"
+		if EqualIgnoringNamespaces(a, b) {
+			t.Error("Expected a changed value to be detected")
+		}
+
+		c := a.Clone()
+		c.ResourceList.SoundRecording = append(c.ResourceList.SoundRecording, &ernv43.SoundRecording{})
+		if EqualIgnoringNamespaces(a, c) {
+			t.Error("Expected an added element to be detected")
+		}
+
+		d := a.Clone()
+		d.AvsVersionId = "5"
+		if EqualIgnoringNamespaces(a, d) {
+			t.Error("Expected a changed non-namespace attribute to be detected")
+		}
+	})
+
+	t.Run("Nil Messages", func(t *testing.T) {
+		if !EqualIgnoringNamespaces(nil, nil) {
+			t.Error("Expected nil messages to be equal")
+		}
+		if EqualIgnoringNamespaces(&ernv43.NewReleaseMessage{}, nil) {
+			t.Error("Expected a message and nil to differ")
+		}
+	})
+}
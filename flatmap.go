@@ -0,0 +1,35 @@
+package ddex
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ToFlatMap flattens the populated scalar fields of msg into dot-path keys as
+// produced by Walk (e.g. "MessageHeader.MessageId"), for feeding templating engines.
+// Enums are rendered by their value name.
+func ToFlatMap(msg proto.Message) map[string]string {
+	flat := make(map[string]string)
+
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		switch fd.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			return nil
+		case protoreflect.EnumKind:
+			if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+				flat[path] = string(ev.Name())
+			} else {
+				flat[path] = fmt.Sprint(v.Enum())
+			}
+		case protoreflect.BytesKind:
+			flat[path] = string(v.Bytes())
+		default:
+			flat[path] = v.String()
+		}
+		return nil
+	})
+
+	return flat
+}
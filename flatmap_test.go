@@ -0,0 +1,36 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestToFlatMap validates that populated fields are exported under their dot-path keys
+func TestToFlatMap(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse %s: %v", xmlPath, err)
+	}
+
+	flat := ToFlatMap(msg)
+	expected := map[string]string{
+		"MessageHeader.MessageId":                          "W83814161",
+		"MessageHeader.MessageSender.PartyName.FullName":   "Warner Music Group",
+		"MessageHeader.MessageRecipient[0].PartyId":        "PADPIDA2007050901U",
+		"ResourceList.SoundRecording[0].ResourceReference": "A1",
+	}
+	for key, want := range expected {
+		if got, ok := flat[key]; !ok {
+			t.Errorf("Missing key %s", key)
+		} else if got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+}
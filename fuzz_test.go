@@ -0,0 +1,40 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// FuzzParse feeds arbitrary bytes to the parse functions, which ingest
+// untrusted partner data: each must return a message or an error, never panic
+func FuzzParse(f *testing.F) {
+	paths, _ := filepath.Glob(filepath.Join("testdata", "*", "*.xml"))
+	samples, _ := filepath.Glob(filepath.Join("testdata", "ernv432", "Samples43", "*.xml"))
+	for _, path := range append(paths, samples...) {
+		xmlData, err := os.ReadFile(path)
+		if err != nil {
+			f.Fatalf("Failed to read %s: %v", path, err)
+		}
+		f.Add(xmlData)
+	}
+	for _, seed := range []string{
+		``,
+		`<`,
+		`<ern:NewReleaseMessage xmlns:ern="http://ddex.net/xml/ern/43">`,
+		`<ern:NewReleaseMessage xmlns:ern="http://ddex.net/xml/ern/">`,
+		`<NewReleaseMessage xmlns="https://www.ddex.net/xml/ern/4.3/"><MessageHeader/></NewReleaseMessage>`,
+		`<ern:PurgeReleaseMessage xmlns:ern="http://ddex.net/xml/ern/432"><Any xmlns="x"><a/></Any></ern:PurgeReleaseMessage>`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, xmlData []byte) {
+		if msg, err := ParseDDEX(xmlData); err == nil && msg == nil {
+			t.Error("ParseDDEX returned neither a message nor an error")
+		}
+		if msg, _, err := ParseERN(xmlData); err == nil && msg == nil {
+			t.Error("ParseERN returned neither a message nor an error")
+		}
+	})
+}
@@ -1,3 +1,6 @@
+// Generated by xsd2proto devel on 2026-10-15
+// Schema: avs_20200108.xsd (sha256 bf2876ac02c6)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.9
@@ -24,8 +27,10 @@ const (
 type AccessLimitation int32
 
 const (
-	AccessLimitation_ACCESS_LIMITATION_UNSPECIFIED       AccessLimitation = 0
-	AccessLimitation_ACCESS_LIMITATION_NOLIMITATION      AccessLimitation = 1
+	AccessLimitation_ACCESS_LIMITATION_UNSPECIFIED AccessLimitation = 0
+	// @xml: "NoLimitation"
+	AccessLimitation_ACCESS_LIMITATION_NOLIMITATION AccessLimitation = 1
+	// @xml: "PrivateAccessOnly"
 	AccessLimitation_ACCESS_LIMITATION_PRIVATEACCESSONLY AccessLimitation = 2
 )
 
@@ -73,12 +78,17 @@ func (AccessLimitation) EnumDescriptor() ([]byte, []int) {
 type AdministratingRecordCompanyRole int32
 
 const (
-	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_UNSPECIFIED                   AdministratingRecordCompanyRole = 0
+	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_UNSPECIFIED AdministratingRecordCompanyRole = 0
+	// @xml: "DesignatedDsrMessageRecipient"
 	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_DESIGNATEDDSRMESSAGERECIPIENT AdministratingRecordCompanyRole = 1
-	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_RIGHTSADMINISTRATOR           AdministratingRecordCompanyRole = 2
-	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_ROYALTYADMINISTRATOR          AdministratingRecordCompanyRole = 3
-	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_UNKNOWN                       AdministratingRecordCompanyRole = 4
-	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_USERDEFINED                   AdministratingRecordCompanyRole = 5
+	// @xml: "RightsAdministrator"
+	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_RIGHTSADMINISTRATOR AdministratingRecordCompanyRole = 2
+	// @xml: "RoyaltyAdministrator"
+	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_ROYALTYADMINISTRATOR AdministratingRecordCompanyRole = 3
+	// @xml: "Unknown"
+	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_UNKNOWN AdministratingRecordCompanyRole = 4
+	// @xml: "UserDefined"
+	AdministratingRecordCompanyRole_ADMINISTRATING_RECORD_COMPANY_ROLE_USERDEFINED AdministratingRecordCompanyRole = 5
 )
 
 // Enum value maps for AdministratingRecordCompanyRole.
@@ -132,540 +142,1074 @@ type AllTerritoryCode int32
 
 const (
 	AllTerritoryCode_ALL_TERRITORY_CODE_UNSPECIFIED AllTerritoryCode = 0
-	AllTerritoryCode_ALL_TERRITORY_CODE_AD          AllTerritoryCode = 1
-	AllTerritoryCode_ALL_TERRITORY_CODE_AE          AllTerritoryCode = 2
-	AllTerritoryCode_ALL_TERRITORY_CODE_AF          AllTerritoryCode = 3
-	AllTerritoryCode_ALL_TERRITORY_CODE_AG          AllTerritoryCode = 4
-	AllTerritoryCode_ALL_TERRITORY_CODE_AI          AllTerritoryCode = 5
-	AllTerritoryCode_ALL_TERRITORY_CODE_AL          AllTerritoryCode = 6
-	AllTerritoryCode_ALL_TERRITORY_CODE_AM          AllTerritoryCode = 7
-	AllTerritoryCode_ALL_TERRITORY_CODE_AN          AllTerritoryCode = 8
-	AllTerritoryCode_ALL_TERRITORY_CODE_AO          AllTerritoryCode = 9
-	AllTerritoryCode_ALL_TERRITORY_CODE_AQ          AllTerritoryCode = 10
-	AllTerritoryCode_ALL_TERRITORY_CODE_AR          AllTerritoryCode = 11
-	AllTerritoryCode_ALL_TERRITORY_CODE_AS          AllTerritoryCode = 12
-	AllTerritoryCode_ALL_TERRITORY_CODE_AT          AllTerritoryCode = 13
-	AllTerritoryCode_ALL_TERRITORY_CODE_AU          AllTerritoryCode = 14
-	AllTerritoryCode_ALL_TERRITORY_CODE_AW          AllTerritoryCode = 15
-	AllTerritoryCode_ALL_TERRITORY_CODE_AX          AllTerritoryCode = 16
-	AllTerritoryCode_ALL_TERRITORY_CODE_AZ          AllTerritoryCode = 17
-	AllTerritoryCode_ALL_TERRITORY_CODE_BA          AllTerritoryCode = 18
-	AllTerritoryCode_ALL_TERRITORY_CODE_BB          AllTerritoryCode = 19
-	AllTerritoryCode_ALL_TERRITORY_CODE_BD          AllTerritoryCode = 20
-	AllTerritoryCode_ALL_TERRITORY_CODE_BE          AllTerritoryCode = 21
-	AllTerritoryCode_ALL_TERRITORY_CODE_BF          AllTerritoryCode = 22
-	AllTerritoryCode_ALL_TERRITORY_CODE_BG          AllTerritoryCode = 23
-	AllTerritoryCode_ALL_TERRITORY_CODE_BH          AllTerritoryCode = 24
-	AllTerritoryCode_ALL_TERRITORY_CODE_BI          AllTerritoryCode = 25
-	AllTerritoryCode_ALL_TERRITORY_CODE_BJ          AllTerritoryCode = 26
-	AllTerritoryCode_ALL_TERRITORY_CODE_BL          AllTerritoryCode = 27
-	AllTerritoryCode_ALL_TERRITORY_CODE_BM          AllTerritoryCode = 28
-	AllTerritoryCode_ALL_TERRITORY_CODE_BN          AllTerritoryCode = 29
-	AllTerritoryCode_ALL_TERRITORY_CODE_BO          AllTerritoryCode = 30
-	AllTerritoryCode_ALL_TERRITORY_CODE_BQ          AllTerritoryCode = 31
-	AllTerritoryCode_ALL_TERRITORY_CODE_BR          AllTerritoryCode = 32
-	AllTerritoryCode_ALL_TERRITORY_CODE_BS          AllTerritoryCode = 33
-	AllTerritoryCode_ALL_TERRITORY_CODE_BT          AllTerritoryCode = 34
-	AllTerritoryCode_ALL_TERRITORY_CODE_BV          AllTerritoryCode = 35
-	AllTerritoryCode_ALL_TERRITORY_CODE_BW          AllTerritoryCode = 36
-	AllTerritoryCode_ALL_TERRITORY_CODE_BY          AllTerritoryCode = 37
-	AllTerritoryCode_ALL_TERRITORY_CODE_BZ          AllTerritoryCode = 38
-	AllTerritoryCode_ALL_TERRITORY_CODE_CA          AllTerritoryCode = 39
-	AllTerritoryCode_ALL_TERRITORY_CODE_CC          AllTerritoryCode = 40
-	AllTerritoryCode_ALL_TERRITORY_CODE_CD          AllTerritoryCode = 41
-	AllTerritoryCode_ALL_TERRITORY_CODE_CF          AllTerritoryCode = 42
-	AllTerritoryCode_ALL_TERRITORY_CODE_CG          AllTerritoryCode = 43
-	AllTerritoryCode_ALL_TERRITORY_CODE_CH          AllTerritoryCode = 44
-	AllTerritoryCode_ALL_TERRITORY_CODE_CI          AllTerritoryCode = 45
-	AllTerritoryCode_ALL_TERRITORY_CODE_CK          AllTerritoryCode = 46
-	AllTerritoryCode_ALL_TERRITORY_CODE_CL          AllTerritoryCode = 47
-	AllTerritoryCode_ALL_TERRITORY_CODE_CM          AllTerritoryCode = 48
-	AllTerritoryCode_ALL_TERRITORY_CODE_CN          AllTerritoryCode = 49
-	AllTerritoryCode_ALL_TERRITORY_CODE_CO          AllTerritoryCode = 50
-	AllTerritoryCode_ALL_TERRITORY_CODE_CR          AllTerritoryCode = 51
-	AllTerritoryCode_ALL_TERRITORY_CODE_CS          AllTerritoryCode = 52
-	AllTerritoryCode_ALL_TERRITORY_CODE_CU          AllTerritoryCode = 53
-	AllTerritoryCode_ALL_TERRITORY_CODE_CV          AllTerritoryCode = 54
-	AllTerritoryCode_ALL_TERRITORY_CODE_CW          AllTerritoryCode = 55
-	AllTerritoryCode_ALL_TERRITORY_CODE_CX          AllTerritoryCode = 56
-	AllTerritoryCode_ALL_TERRITORY_CODE_CY          AllTerritoryCode = 57
-	AllTerritoryCode_ALL_TERRITORY_CODE_CZ          AllTerritoryCode = 58
-	AllTerritoryCode_ALL_TERRITORY_CODE_DE          AllTerritoryCode = 59
-	AllTerritoryCode_ALL_TERRITORY_CODE_DJ          AllTerritoryCode = 60
-	AllTerritoryCode_ALL_TERRITORY_CODE_DK          AllTerritoryCode = 61
-	AllTerritoryCode_ALL_TERRITORY_CODE_DM          AllTerritoryCode = 62
-	AllTerritoryCode_ALL_TERRITORY_CODE_DO          AllTerritoryCode = 63
-	AllTerritoryCode_ALL_TERRITORY_CODE_DZ          AllTerritoryCode = 64
-	AllTerritoryCode_ALL_TERRITORY_CODE_EC          AllTerritoryCode = 65
-	AllTerritoryCode_ALL_TERRITORY_CODE_EE          AllTerritoryCode = 66
-	AllTerritoryCode_ALL_TERRITORY_CODE_EG          AllTerritoryCode = 67
-	AllTerritoryCode_ALL_TERRITORY_CODE_EH          AllTerritoryCode = 68
-	AllTerritoryCode_ALL_TERRITORY_CODE_ER          AllTerritoryCode = 69
-	AllTerritoryCode_ALL_TERRITORY_CODE_ES          AllTerritoryCode = 70
-	AllTerritoryCode_ALL_TERRITORY_CODE_ES_CE       AllTerritoryCode = 71
-	AllTerritoryCode_ALL_TERRITORY_CODE_ES_CN       AllTerritoryCode = 72
-	AllTerritoryCode_ALL_TERRITORY_CODE_ES_ML       AllTerritoryCode = 73
-	AllTerritoryCode_ALL_TERRITORY_CODE_ET          AllTerritoryCode = 74
-	AllTerritoryCode_ALL_TERRITORY_CODE_FI          AllTerritoryCode = 75
-	AllTerritoryCode_ALL_TERRITORY_CODE_FJ          AllTerritoryCode = 76
-	AllTerritoryCode_ALL_TERRITORY_CODE_FK          AllTerritoryCode = 77
-	AllTerritoryCode_ALL_TERRITORY_CODE_FM          AllTerritoryCode = 78
-	AllTerritoryCode_ALL_TERRITORY_CODE_FO          AllTerritoryCode = 79
-	AllTerritoryCode_ALL_TERRITORY_CODE_FR          AllTerritoryCode = 80
-	AllTerritoryCode_ALL_TERRITORY_CODE_GA          AllTerritoryCode = 81
-	AllTerritoryCode_ALL_TERRITORY_CODE_GB          AllTerritoryCode = 82
-	AllTerritoryCode_ALL_TERRITORY_CODE_GD          AllTerritoryCode = 83
-	AllTerritoryCode_ALL_TERRITORY_CODE_GE          AllTerritoryCode = 84
-	AllTerritoryCode_ALL_TERRITORY_CODE_GF          AllTerritoryCode = 85
-	AllTerritoryCode_ALL_TERRITORY_CODE_GG          AllTerritoryCode = 86
-	AllTerritoryCode_ALL_TERRITORY_CODE_GH          AllTerritoryCode = 87
-	AllTerritoryCode_ALL_TERRITORY_CODE_GI          AllTerritoryCode = 88
-	AllTerritoryCode_ALL_TERRITORY_CODE_GL          AllTerritoryCode = 89
-	AllTerritoryCode_ALL_TERRITORY_CODE_GM          AllTerritoryCode = 90
-	AllTerritoryCode_ALL_TERRITORY_CODE_GN          AllTerritoryCode = 91
-	AllTerritoryCode_ALL_TERRITORY_CODE_GP          AllTerritoryCode = 92
-	AllTerritoryCode_ALL_TERRITORY_CODE_GQ          AllTerritoryCode = 93
-	AllTerritoryCode_ALL_TERRITORY_CODE_GR          AllTerritoryCode = 94
-	AllTerritoryCode_ALL_TERRITORY_CODE_GS          AllTerritoryCode = 95
-	AllTerritoryCode_ALL_TERRITORY_CODE_GT          AllTerritoryCode = 96
-	AllTerritoryCode_ALL_TERRITORY_CODE_GU          AllTerritoryCode = 97
-	AllTerritoryCode_ALL_TERRITORY_CODE_GW          AllTerritoryCode = 98
-	AllTerritoryCode_ALL_TERRITORY_CODE_GY          AllTerritoryCode = 99
-	AllTerritoryCode_ALL_TERRITORY_CODE_HK          AllTerritoryCode = 100
-	AllTerritoryCode_ALL_TERRITORY_CODE_HM          AllTerritoryCode = 101
-	AllTerritoryCode_ALL_TERRITORY_CODE_HN          AllTerritoryCode = 102
-	AllTerritoryCode_ALL_TERRITORY_CODE_HR          AllTerritoryCode = 103
-	AllTerritoryCode_ALL_TERRITORY_CODE_HT          AllTerritoryCode = 104
-	AllTerritoryCode_ALL_TERRITORY_CODE_HU          AllTerritoryCode = 105
-	AllTerritoryCode_ALL_TERRITORY_CODE_ID          AllTerritoryCode = 106
-	AllTerritoryCode_ALL_TERRITORY_CODE_IE          AllTerritoryCode = 107
-	AllTerritoryCode_ALL_TERRITORY_CODE_IL          AllTerritoryCode = 108
-	AllTerritoryCode_ALL_TERRITORY_CODE_IM          AllTerritoryCode = 109
-	AllTerritoryCode_ALL_TERRITORY_CODE_IN          AllTerritoryCode = 110
-	AllTerritoryCode_ALL_TERRITORY_CODE_IO          AllTerritoryCode = 111
-	AllTerritoryCode_ALL_TERRITORY_CODE_IQ          AllTerritoryCode = 112
-	AllTerritoryCode_ALL_TERRITORY_CODE_IR          AllTerritoryCode = 113
-	AllTerritoryCode_ALL_TERRITORY_CODE_IS          AllTerritoryCode = 114
-	AllTerritoryCode_ALL_TERRITORY_CODE_IT          AllTerritoryCode = 115
-	AllTerritoryCode_ALL_TERRITORY_CODE_JE          AllTerritoryCode = 116
-	AllTerritoryCode_ALL_TERRITORY_CODE_JM          AllTerritoryCode = 117
-	AllTerritoryCode_ALL_TERRITORY_CODE_JO          AllTerritoryCode = 118
-	AllTerritoryCode_ALL_TERRITORY_CODE_JP          AllTerritoryCode = 119
-	AllTerritoryCode_ALL_TERRITORY_CODE_KE          AllTerritoryCode = 120
-	AllTerritoryCode_ALL_TERRITORY_CODE_KG          AllTerritoryCode = 121
-	AllTerritoryCode_ALL_TERRITORY_CODE_KH          AllTerritoryCode = 122
-	AllTerritoryCode_ALL_TERRITORY_CODE_KI          AllTerritoryCode = 123
-	AllTerritoryCode_ALL_TERRITORY_CODE_KM          AllTerritoryCode = 124
-	AllTerritoryCode_ALL_TERRITORY_CODE_KN          AllTerritoryCode = 125
-	AllTerritoryCode_ALL_TERRITORY_CODE_KP          AllTerritoryCode = 126
-	AllTerritoryCode_ALL_TERRITORY_CODE_KR          AllTerritoryCode = 127
-	AllTerritoryCode_ALL_TERRITORY_CODE_KW          AllTerritoryCode = 128
-	AllTerritoryCode_ALL_TERRITORY_CODE_KY          AllTerritoryCode = 129
-	AllTerritoryCode_ALL_TERRITORY_CODE_KZ          AllTerritoryCode = 130
-	AllTerritoryCode_ALL_TERRITORY_CODE_LA          AllTerritoryCode = 131
-	AllTerritoryCode_ALL_TERRITORY_CODE_LB          AllTerritoryCode = 132
-	AllTerritoryCode_ALL_TERRITORY_CODE_LC          AllTerritoryCode = 133
-	AllTerritoryCode_ALL_TERRITORY_CODE_LI          AllTerritoryCode = 134
-	AllTerritoryCode_ALL_TERRITORY_CODE_LK          AllTerritoryCode = 135
-	AllTerritoryCode_ALL_TERRITORY_CODE_LR          AllTerritoryCode = 136
-	AllTerritoryCode_ALL_TERRITORY_CODE_LS          AllTerritoryCode = 137
-	AllTerritoryCode_ALL_TERRITORY_CODE_LT          AllTerritoryCode = 138
-	AllTerritoryCode_ALL_TERRITORY_CODE_LU          AllTerritoryCode = 139
-	AllTerritoryCode_ALL_TERRITORY_CODE_LV          AllTerritoryCode = 140
-	AllTerritoryCode_ALL_TERRITORY_CODE_LY          AllTerritoryCode = 141
-	AllTerritoryCode_ALL_TERRITORY_CODE_MA          AllTerritoryCode = 142
-	AllTerritoryCode_ALL_TERRITORY_CODE_MC          AllTerritoryCode = 143
-	AllTerritoryCode_ALL_TERRITORY_CODE_MD          AllTerritoryCode = 144
-	AllTerritoryCode_ALL_TERRITORY_CODE_ME          AllTerritoryCode = 145
-	AllTerritoryCode_ALL_TERRITORY_CODE_MF          AllTerritoryCode = 146
-	AllTerritoryCode_ALL_TERRITORY_CODE_MG          AllTerritoryCode = 147
-	AllTerritoryCode_ALL_TERRITORY_CODE_MH          AllTerritoryCode = 148
-	AllTerritoryCode_ALL_TERRITORY_CODE_MK          AllTerritoryCode = 149
-	AllTerritoryCode_ALL_TERRITORY_CODE_ML          AllTerritoryCode = 150
-	AllTerritoryCode_ALL_TERRITORY_CODE_MM          AllTerritoryCode = 151
-	AllTerritoryCode_ALL_TERRITORY_CODE_MN          AllTerritoryCode = 152
-	AllTerritoryCode_ALL_TERRITORY_CODE_MO          AllTerritoryCode = 153
-	AllTerritoryCode_ALL_TERRITORY_CODE_MP          AllTerritoryCode = 154
-	AllTerritoryCode_ALL_TERRITORY_CODE_MQ          AllTerritoryCode = 155
-	AllTerritoryCode_ALL_TERRITORY_CODE_MR          AllTerritoryCode = 156
-	AllTerritoryCode_ALL_TERRITORY_CODE_MS          AllTerritoryCode = 157
-	AllTerritoryCode_ALL_TERRITORY_CODE_MT          AllTerritoryCode = 158
-	AllTerritoryCode_ALL_TERRITORY_CODE_MU          AllTerritoryCode = 159
-	AllTerritoryCode_ALL_TERRITORY_CODE_MV          AllTerritoryCode = 160
-	AllTerritoryCode_ALL_TERRITORY_CODE_MW          AllTerritoryCode = 161
-	AllTerritoryCode_ALL_TERRITORY_CODE_MX          AllTerritoryCode = 162
-	AllTerritoryCode_ALL_TERRITORY_CODE_MY          AllTerritoryCode = 163
-	AllTerritoryCode_ALL_TERRITORY_CODE_MZ          AllTerritoryCode = 164
-	AllTerritoryCode_ALL_TERRITORY_CODE_NA          AllTerritoryCode = 165
-	AllTerritoryCode_ALL_TERRITORY_CODE_NC          AllTerritoryCode = 166
-	AllTerritoryCode_ALL_TERRITORY_CODE_NE          AllTerritoryCode = 167
-	AllTerritoryCode_ALL_TERRITORY_CODE_NF          AllTerritoryCode = 168
-	AllTerritoryCode_ALL_TERRITORY_CODE_NG          AllTerritoryCode = 169
-	AllTerritoryCode_ALL_TERRITORY_CODE_NI          AllTerritoryCode = 170
-	AllTerritoryCode_ALL_TERRITORY_CODE_NL          AllTerritoryCode = 171
-	AllTerritoryCode_ALL_TERRITORY_CODE_NO          AllTerritoryCode = 172
-	AllTerritoryCode_ALL_TERRITORY_CODE_NP          AllTerritoryCode = 173
-	AllTerritoryCode_ALL_TERRITORY_CODE_NR          AllTerritoryCode = 174
-	AllTerritoryCode_ALL_TERRITORY_CODE_NU          AllTerritoryCode = 175
-	AllTerritoryCode_ALL_TERRITORY_CODE_NZ          AllTerritoryCode = 176
-	AllTerritoryCode_ALL_TERRITORY_CODE_OM          AllTerritoryCode = 177
-	AllTerritoryCode_ALL_TERRITORY_CODE_PA          AllTerritoryCode = 178
-	AllTerritoryCode_ALL_TERRITORY_CODE_PE          AllTerritoryCode = 179
-	AllTerritoryCode_ALL_TERRITORY_CODE_PF          AllTerritoryCode = 180
-	AllTerritoryCode_ALL_TERRITORY_CODE_PG          AllTerritoryCode = 181
-	AllTerritoryCode_ALL_TERRITORY_CODE_PH          AllTerritoryCode = 182
-	AllTerritoryCode_ALL_TERRITORY_CODE_PK          AllTerritoryCode = 183
-	AllTerritoryCode_ALL_TERRITORY_CODE_PL          AllTerritoryCode = 184
-	AllTerritoryCode_ALL_TERRITORY_CODE_PM          AllTerritoryCode = 185
-	AllTerritoryCode_ALL_TERRITORY_CODE_PN          AllTerritoryCode = 186
-	AllTerritoryCode_ALL_TERRITORY_CODE_PR          AllTerritoryCode = 187
-	AllTerritoryCode_ALL_TERRITORY_CODE_PS          AllTerritoryCode = 188
-	AllTerritoryCode_ALL_TERRITORY_CODE_PT          AllTerritoryCode = 189
-	AllTerritoryCode_ALL_TERRITORY_CODE_PW          AllTerritoryCode = 190
-	AllTerritoryCode_ALL_TERRITORY_CODE_PY          AllTerritoryCode = 191
-	AllTerritoryCode_ALL_TERRITORY_CODE_QA          AllTerritoryCode = 192
-	AllTerritoryCode_ALL_TERRITORY_CODE_RE          AllTerritoryCode = 193
-	AllTerritoryCode_ALL_TERRITORY_CODE_RO          AllTerritoryCode = 194
-	AllTerritoryCode_ALL_TERRITORY_CODE_RS          AllTerritoryCode = 195
-	AllTerritoryCode_ALL_TERRITORY_CODE_RU          AllTerritoryCode = 196
-	AllTerritoryCode_ALL_TERRITORY_CODE_RW          AllTerritoryCode = 197
-	AllTerritoryCode_ALL_TERRITORY_CODE_SA          AllTerritoryCode = 198
-	AllTerritoryCode_ALL_TERRITORY_CODE_SB          AllTerritoryCode = 199
-	AllTerritoryCode_ALL_TERRITORY_CODE_SC          AllTerritoryCode = 200
-	AllTerritoryCode_ALL_TERRITORY_CODE_SD          AllTerritoryCode = 201
-	AllTerritoryCode_ALL_TERRITORY_CODE_SE          AllTerritoryCode = 202
-	AllTerritoryCode_ALL_TERRITORY_CODE_SG          AllTerritoryCode = 203
-	AllTerritoryCode_ALL_TERRITORY_CODE_SH          AllTerritoryCode = 204
-	AllTerritoryCode_ALL_TERRITORY_CODE_SI          AllTerritoryCode = 205
-	AllTerritoryCode_ALL_TERRITORY_CODE_SJ          AllTerritoryCode = 206
-	AllTerritoryCode_ALL_TERRITORY_CODE_SK          AllTerritoryCode = 207
-	AllTerritoryCode_ALL_TERRITORY_CODE_SL          AllTerritoryCode = 208
-	AllTerritoryCode_ALL_TERRITORY_CODE_SM          AllTerritoryCode = 209
-	AllTerritoryCode_ALL_TERRITORY_CODE_SN          AllTerritoryCode = 210
-	AllTerritoryCode_ALL_TERRITORY_CODE_SO          AllTerritoryCode = 211
-	AllTerritoryCode_ALL_TERRITORY_CODE_SR          AllTerritoryCode = 212
-	AllTerritoryCode_ALL_TERRITORY_CODE_SS          AllTerritoryCode = 213
-	AllTerritoryCode_ALL_TERRITORY_CODE_ST          AllTerritoryCode = 214
-	AllTerritoryCode_ALL_TERRITORY_CODE_SV          AllTerritoryCode = 215
-	AllTerritoryCode_ALL_TERRITORY_CODE_SX          AllTerritoryCode = 216
-	AllTerritoryCode_ALL_TERRITORY_CODE_SY          AllTerritoryCode = 217
-	AllTerritoryCode_ALL_TERRITORY_CODE_SZ          AllTerritoryCode = 218
-	AllTerritoryCode_ALL_TERRITORY_CODE_TC          AllTerritoryCode = 219
-	AllTerritoryCode_ALL_TERRITORY_CODE_TD          AllTerritoryCode = 220
-	AllTerritoryCode_ALL_TERRITORY_CODE_TF          AllTerritoryCode = 221
-	AllTerritoryCode_ALL_TERRITORY_CODE_TG          AllTerritoryCode = 222
-	AllTerritoryCode_ALL_TERRITORY_CODE_TH          AllTerritoryCode = 223
-	AllTerritoryCode_ALL_TERRITORY_CODE_TJ          AllTerritoryCode = 224
-	AllTerritoryCode_ALL_TERRITORY_CODE_TK          AllTerritoryCode = 225
-	AllTerritoryCode_ALL_TERRITORY_CODE_TL          AllTerritoryCode = 226
-	AllTerritoryCode_ALL_TERRITORY_CODE_TM          AllTerritoryCode = 227
-	AllTerritoryCode_ALL_TERRITORY_CODE_TN          AllTerritoryCode = 228
-	AllTerritoryCode_ALL_TERRITORY_CODE_TO          AllTerritoryCode = 229
-	AllTerritoryCode_ALL_TERRITORY_CODE_TR          AllTerritoryCode = 230
-	AllTerritoryCode_ALL_TERRITORY_CODE_TT          AllTerritoryCode = 231
-	AllTerritoryCode_ALL_TERRITORY_CODE_TV          AllTerritoryCode = 232
-	AllTerritoryCode_ALL_TERRITORY_CODE_TW          AllTerritoryCode = 233
-	AllTerritoryCode_ALL_TERRITORY_CODE_TZ          AllTerritoryCode = 234
-	AllTerritoryCode_ALL_TERRITORY_CODE_UA          AllTerritoryCode = 235
-	AllTerritoryCode_ALL_TERRITORY_CODE_UG          AllTerritoryCode = 236
-	AllTerritoryCode_ALL_TERRITORY_CODE_UM          AllTerritoryCode = 237
-	AllTerritoryCode_ALL_TERRITORY_CODE_US          AllTerritoryCode = 238
-	AllTerritoryCode_ALL_TERRITORY_CODE_UY          AllTerritoryCode = 239
-	AllTerritoryCode_ALL_TERRITORY_CODE_UZ          AllTerritoryCode = 240
-	AllTerritoryCode_ALL_TERRITORY_CODE_VA          AllTerritoryCode = 241
-	AllTerritoryCode_ALL_TERRITORY_CODE_VC          AllTerritoryCode = 242
-	AllTerritoryCode_ALL_TERRITORY_CODE_VE          AllTerritoryCode = 243
-	AllTerritoryCode_ALL_TERRITORY_CODE_VG          AllTerritoryCode = 244
-	AllTerritoryCode_ALL_TERRITORY_CODE_VI          AllTerritoryCode = 245
-	AllTerritoryCode_ALL_TERRITORY_CODE_VN          AllTerritoryCode = 246
-	AllTerritoryCode_ALL_TERRITORY_CODE_VU          AllTerritoryCode = 247
-	AllTerritoryCode_ALL_TERRITORY_CODE_WF          AllTerritoryCode = 248
-	AllTerritoryCode_ALL_TERRITORY_CODE_WS          AllTerritoryCode = 249
-	AllTerritoryCode_ALL_TERRITORY_CODE_YE          AllTerritoryCode = 250
-	AllTerritoryCode_ALL_TERRITORY_CODE_YT          AllTerritoryCode = 251
-	AllTerritoryCode_ALL_TERRITORY_CODE_ZA          AllTerritoryCode = 252
-	AllTerritoryCode_ALL_TERRITORY_CODE_ZM          AllTerritoryCode = 253
-	AllTerritoryCode_ALL_TERRITORY_CODE_ZW          AllTerritoryCode = 254
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_4         AllTerritoryCode = 255
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_8         AllTerritoryCode = 256
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_12        AllTerritoryCode = 257
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_20        AllTerritoryCode = 258
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_24        AllTerritoryCode = 259
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_28        AllTerritoryCode = 260
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_31        AllTerritoryCode = 261
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_32        AllTerritoryCode = 262
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_36        AllTerritoryCode = 263
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_40        AllTerritoryCode = 264
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_44        AllTerritoryCode = 265
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_48        AllTerritoryCode = 266
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_50        AllTerritoryCode = 267
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_51        AllTerritoryCode = 268
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_52        AllTerritoryCode = 269
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_56        AllTerritoryCode = 270
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_64        AllTerritoryCode = 271
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_68        AllTerritoryCode = 272
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_70        AllTerritoryCode = 273
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_72        AllTerritoryCode = 274
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_76        AllTerritoryCode = 275
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_84        AllTerritoryCode = 276
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_90        AllTerritoryCode = 277
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_96        AllTerritoryCode = 278
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_100       AllTerritoryCode = 279
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_104       AllTerritoryCode = 280
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_108       AllTerritoryCode = 281
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_112       AllTerritoryCode = 282
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_116       AllTerritoryCode = 283
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_120       AllTerritoryCode = 284
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_124       AllTerritoryCode = 285
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_132       AllTerritoryCode = 286
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_140       AllTerritoryCode = 287
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_144       AllTerritoryCode = 288
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_148       AllTerritoryCode = 289
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_152       AllTerritoryCode = 290
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_156       AllTerritoryCode = 291
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_158       AllTerritoryCode = 292
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_170       AllTerritoryCode = 293
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_174       AllTerritoryCode = 294
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_178       AllTerritoryCode = 295
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_180       AllTerritoryCode = 296
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_188       AllTerritoryCode = 297
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_191       AllTerritoryCode = 298
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_192       AllTerritoryCode = 299
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_196       AllTerritoryCode = 300
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_200       AllTerritoryCode = 301
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_203       AllTerritoryCode = 302
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_204       AllTerritoryCode = 303
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_208       AllTerritoryCode = 304
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_212       AllTerritoryCode = 305
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_214       AllTerritoryCode = 306
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_218       AllTerritoryCode = 307
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_222       AllTerritoryCode = 308
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_226       AllTerritoryCode = 309
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_230       AllTerritoryCode = 310
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_231       AllTerritoryCode = 311
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_232       AllTerritoryCode = 312
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_233       AllTerritoryCode = 313
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_242       AllTerritoryCode = 314
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_246       AllTerritoryCode = 315
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_250       AllTerritoryCode = 316
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_258       AllTerritoryCode = 317
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_262       AllTerritoryCode = 318
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_266       AllTerritoryCode = 319
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_268       AllTerritoryCode = 320
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_270       AllTerritoryCode = 321
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_276       AllTerritoryCode = 322
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_278       AllTerritoryCode = 323
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_280       AllTerritoryCode = 324
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_288       AllTerritoryCode = 325
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_296       AllTerritoryCode = 326
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_300       AllTerritoryCode = 327
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_308       AllTerritoryCode = 328
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_320       AllTerritoryCode = 329
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_324       AllTerritoryCode = 330
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_328       AllTerritoryCode = 331
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_332       AllTerritoryCode = 332
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_336       AllTerritoryCode = 333
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_340       AllTerritoryCode = 334
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_344       AllTerritoryCode = 335
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_348       AllTerritoryCode = 336
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_352       AllTerritoryCode = 337
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_356       AllTerritoryCode = 338
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_360       AllTerritoryCode = 339
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_364       AllTerritoryCode = 340
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_368       AllTerritoryCode = 341
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_372       AllTerritoryCode = 342
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_376       AllTerritoryCode = 343
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_380       AllTerritoryCode = 344
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_384       AllTerritoryCode = 345
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_388       AllTerritoryCode = 346
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_392       AllTerritoryCode = 347
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_398       AllTerritoryCode = 348
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_400       AllTerritoryCode = 349
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_404       AllTerritoryCode = 350
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_408       AllTerritoryCode = 351
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_410       AllTerritoryCode = 352
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_414       AllTerritoryCode = 353
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_417       AllTerritoryCode = 354
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_418       AllTerritoryCode = 355
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_422       AllTerritoryCode = 356
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_426       AllTerritoryCode = 357
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_428       AllTerritoryCode = 358
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_430       AllTerritoryCode = 359
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_434       AllTerritoryCode = 360
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_438       AllTerritoryCode = 361
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_440       AllTerritoryCode = 362
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_442       AllTerritoryCode = 363
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_446       AllTerritoryCode = 364
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_450       AllTerritoryCode = 365
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_454       AllTerritoryCode = 366
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_458       AllTerritoryCode = 367
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_462       AllTerritoryCode = 368
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_466       AllTerritoryCode = 369
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_470       AllTerritoryCode = 370
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_478       AllTerritoryCode = 371
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_480       AllTerritoryCode = 372
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_484       AllTerritoryCode = 373
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_492       AllTerritoryCode = 374
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_496       AllTerritoryCode = 375
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_498       AllTerritoryCode = 376
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_499       AllTerritoryCode = 377
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_504       AllTerritoryCode = 378
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_508       AllTerritoryCode = 379
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_512       AllTerritoryCode = 380
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_516       AllTerritoryCode = 381
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_520       AllTerritoryCode = 382
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_524       AllTerritoryCode = 383
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_528       AllTerritoryCode = 384
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_540       AllTerritoryCode = 385
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_548       AllTerritoryCode = 386
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_554       AllTerritoryCode = 387
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_558       AllTerritoryCode = 388
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_562       AllTerritoryCode = 389
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_566       AllTerritoryCode = 390
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_578       AllTerritoryCode = 391
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_583       AllTerritoryCode = 392
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_584       AllTerritoryCode = 393
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_585       AllTerritoryCode = 394
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_586       AllTerritoryCode = 395
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_591       AllTerritoryCode = 396
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_598       AllTerritoryCode = 397
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_600       AllTerritoryCode = 398
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_604       AllTerritoryCode = 399
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_608       AllTerritoryCode = 400
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_616       AllTerritoryCode = 401
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_620       AllTerritoryCode = 402
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_624       AllTerritoryCode = 403
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_626       AllTerritoryCode = 404
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_630       AllTerritoryCode = 405
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_634       AllTerritoryCode = 406
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_642       AllTerritoryCode = 407
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_643       AllTerritoryCode = 408
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_646       AllTerritoryCode = 409
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_659       AllTerritoryCode = 410
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_662       AllTerritoryCode = 411
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_670       AllTerritoryCode = 412
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_674       AllTerritoryCode = 413
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_678       AllTerritoryCode = 414
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_682       AllTerritoryCode = 415
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_686       AllTerritoryCode = 416
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_688       AllTerritoryCode = 417
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_690       AllTerritoryCode = 418
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_694       AllTerritoryCode = 419
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_702       AllTerritoryCode = 420
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_703       AllTerritoryCode = 421
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_704       AllTerritoryCode = 422
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_705       AllTerritoryCode = 423
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_706       AllTerritoryCode = 424
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_710       AllTerritoryCode = 425
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_716       AllTerritoryCode = 426
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_720       AllTerritoryCode = 427
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_724       AllTerritoryCode = 428
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_728       AllTerritoryCode = 429
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_729       AllTerritoryCode = 430
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_732       AllTerritoryCode = 431
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_736       AllTerritoryCode = 432
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_740       AllTerritoryCode = 433
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_748       AllTerritoryCode = 434
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_752       AllTerritoryCode = 435
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_756       AllTerritoryCode = 436
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_760       AllTerritoryCode = 437
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_762       AllTerritoryCode = 438
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_764       AllTerritoryCode = 439
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_768       AllTerritoryCode = 440
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_776       AllTerritoryCode = 441
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_780       AllTerritoryCode = 442
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_784       AllTerritoryCode = 443
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_788       AllTerritoryCode = 444
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_792       AllTerritoryCode = 445
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_795       AllTerritoryCode = 446
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_798       AllTerritoryCode = 447
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_800       AllTerritoryCode = 448
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_804       AllTerritoryCode = 449
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_807       AllTerritoryCode = 450
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_810       AllTerritoryCode = 451
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_818       AllTerritoryCode = 452
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_826       AllTerritoryCode = 453
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_834       AllTerritoryCode = 454
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_840       AllTerritoryCode = 455
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_854       AllTerritoryCode = 456
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_858       AllTerritoryCode = 457
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_860       AllTerritoryCode = 458
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_862       AllTerritoryCode = 459
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_882       AllTerritoryCode = 460
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_886       AllTerritoryCode = 461
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_887       AllTerritoryCode = 462
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_890       AllTerritoryCode = 463
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_891       AllTerritoryCode = 464
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_894       AllTerritoryCode = 465
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2100      AllTerritoryCode = 466
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2101      AllTerritoryCode = 467
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2102      AllTerritoryCode = 468
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2103      AllTerritoryCode = 469
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2104      AllTerritoryCode = 470
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2105      AllTerritoryCode = 471
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2106      AllTerritoryCode = 472
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2107      AllTerritoryCode = 473
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2108      AllTerritoryCode = 474
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2109      AllTerritoryCode = 475
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2110      AllTerritoryCode = 476
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2111      AllTerritoryCode = 477
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2112      AllTerritoryCode = 478
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2113      AllTerritoryCode = 479
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2114      AllTerritoryCode = 480
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2115      AllTerritoryCode = 481
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2116      AllTerritoryCode = 482
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2117      AllTerritoryCode = 483
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2118      AllTerritoryCode = 484
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2119      AllTerritoryCode = 485
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2120      AllTerritoryCode = 486
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2121      AllTerritoryCode = 487
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2122      AllTerritoryCode = 488
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2123      AllTerritoryCode = 489
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2124      AllTerritoryCode = 490
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2125      AllTerritoryCode = 491
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2126      AllTerritoryCode = 492
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2127      AllTerritoryCode = 493
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2128      AllTerritoryCode = 494
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2129      AllTerritoryCode = 495
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2130      AllTerritoryCode = 496
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2131      AllTerritoryCode = 497
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2132      AllTerritoryCode = 498
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2133      AllTerritoryCode = 499
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2134      AllTerritoryCode = 500
-	AllTerritoryCode_ALL_TERRITORY_CODE_E_2136      AllTerritoryCode = 501
-	AllTerritoryCode_ALL_TERRITORY_CODE_XK          AllTerritoryCode = 502
-	AllTerritoryCode_ALL_TERRITORY_CODE_WORLDWIDE   AllTerritoryCode = 503
-	AllTerritoryCode_ALL_TERRITORY_CODE_AIDJ        AllTerritoryCode = 504
-	AllTerritoryCode_ALL_TERRITORY_CODE_ANHH        AllTerritoryCode = 505
-	AllTerritoryCode_ALL_TERRITORY_CODE_BQAQ        AllTerritoryCode = 506
-	AllTerritoryCode_ALL_TERRITORY_CODE_BUMM        AllTerritoryCode = 507
-	AllTerritoryCode_ALL_TERRITORY_CODE_BYAA        AllTerritoryCode = 508
-	AllTerritoryCode_ALL_TERRITORY_CODE_CSHH        AllTerritoryCode = 509
-	AllTerritoryCode_ALL_TERRITORY_CODE_CSXX        AllTerritoryCode = 510
-	AllTerritoryCode_ALL_TERRITORY_CODE_CTKI        AllTerritoryCode = 511
-	AllTerritoryCode_ALL_TERRITORY_CODE_DDDE        AllTerritoryCode = 512
-	AllTerritoryCode_ALL_TERRITORY_CODE_DYBJ        AllTerritoryCode = 513
-	AllTerritoryCode_ALL_TERRITORY_CODE_FQHH        AllTerritoryCode = 514
-	AllTerritoryCode_ALL_TERRITORY_CODE_FXFR        AllTerritoryCode = 515
-	AllTerritoryCode_ALL_TERRITORY_CODE_GEHH        AllTerritoryCode = 516
-	AllTerritoryCode_ALL_TERRITORY_CODE_HVBF        AllTerritoryCode = 517
-	AllTerritoryCode_ALL_TERRITORY_CODE_JTUM        AllTerritoryCode = 518
-	AllTerritoryCode_ALL_TERRITORY_CODE_MIUM        AllTerritoryCode = 519
-	AllTerritoryCode_ALL_TERRITORY_CODE_NHVU        AllTerritoryCode = 520
-	AllTerritoryCode_ALL_TERRITORY_CODE_NQAQ        AllTerritoryCode = 521
-	AllTerritoryCode_ALL_TERRITORY_CODE_NTHH        AllTerritoryCode = 522
-	AllTerritoryCode_ALL_TERRITORY_CODE_PCHH        AllTerritoryCode = 523
-	AllTerritoryCode_ALL_TERRITORY_CODE_PUUM        AllTerritoryCode = 524
-	AllTerritoryCode_ALL_TERRITORY_CODE_PZPA        AllTerritoryCode = 525
-	AllTerritoryCode_ALL_TERRITORY_CODE_RHZW        AllTerritoryCode = 526
-	AllTerritoryCode_ALL_TERRITORY_CODE_SKIN        AllTerritoryCode = 527
-	AllTerritoryCode_ALL_TERRITORY_CODE_SUHH        AllTerritoryCode = 528
-	AllTerritoryCode_ALL_TERRITORY_CODE_TPTL        AllTerritoryCode = 529
-	AllTerritoryCode_ALL_TERRITORY_CODE_VDVN        AllTerritoryCode = 530
-	AllTerritoryCode_ALL_TERRITORY_CODE_WKUM        AllTerritoryCode = 531
-	AllTerritoryCode_ALL_TERRITORY_CODE_YDYE        AllTerritoryCode = 532
-	AllTerritoryCode_ALL_TERRITORY_CODE_YUCS        AllTerritoryCode = 533
-	AllTerritoryCode_ALL_TERRITORY_CODE_ZRCD        AllTerritoryCode = 534
+	// @xml: "AD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AD AllTerritoryCode = 1
+	// @xml: "AE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AE AllTerritoryCode = 2
+	// @xml: "AF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AF AllTerritoryCode = 3
+	// @xml: "AG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AG AllTerritoryCode = 4
+	// @xml: "AI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AI AllTerritoryCode = 5
+	// @xml: "AL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AL AllTerritoryCode = 6
+	// @xml: "AM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AM AllTerritoryCode = 7
+	// @xml: "AN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AN AllTerritoryCode = 8
+	// @xml: "AO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AO AllTerritoryCode = 9
+	// @xml: "AQ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AQ AllTerritoryCode = 10
+	// @xml: "AR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AR AllTerritoryCode = 11
+	// @xml: "AS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AS AllTerritoryCode = 12
+	// @xml: "AT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AT AllTerritoryCode = 13
+	// @xml: "AU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AU AllTerritoryCode = 14
+	// @xml: "AW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AW AllTerritoryCode = 15
+	// @xml: "AX"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AX AllTerritoryCode = 16
+	// @xml: "AZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AZ AllTerritoryCode = 17
+	// @xml: "BA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BA AllTerritoryCode = 18
+	// @xml: "BB"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BB AllTerritoryCode = 19
+	// @xml: "BD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BD AllTerritoryCode = 20
+	// @xml: "BE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BE AllTerritoryCode = 21
+	// @xml: "BF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BF AllTerritoryCode = 22
+	// @xml: "BG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BG AllTerritoryCode = 23
+	// @xml: "BH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BH AllTerritoryCode = 24
+	// @xml: "BI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BI AllTerritoryCode = 25
+	// @xml: "BJ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BJ AllTerritoryCode = 26
+	// @xml: "BL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BL AllTerritoryCode = 27
+	// @xml: "BM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BM AllTerritoryCode = 28
+	// @xml: "BN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BN AllTerritoryCode = 29
+	// @xml: "BO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BO AllTerritoryCode = 30
+	// @xml: "BQ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BQ AllTerritoryCode = 31
+	// @xml: "BR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BR AllTerritoryCode = 32
+	// @xml: "BS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BS AllTerritoryCode = 33
+	// @xml: "BT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BT AllTerritoryCode = 34
+	// @xml: "BV"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BV AllTerritoryCode = 35
+	// @xml: "BW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BW AllTerritoryCode = 36
+	// @xml: "BY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BY AllTerritoryCode = 37
+	// @xml: "BZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BZ AllTerritoryCode = 38
+	// @xml: "CA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CA AllTerritoryCode = 39
+	// @xml: "CC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CC AllTerritoryCode = 40
+	// @xml: "CD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CD AllTerritoryCode = 41
+	// @xml: "CF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CF AllTerritoryCode = 42
+	// @xml: "CG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CG AllTerritoryCode = 43
+	// @xml: "CH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CH AllTerritoryCode = 44
+	// @xml: "CI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CI AllTerritoryCode = 45
+	// @xml: "CK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CK AllTerritoryCode = 46
+	// @xml: "CL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CL AllTerritoryCode = 47
+	// @xml: "CM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CM AllTerritoryCode = 48
+	// @xml: "CN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CN AllTerritoryCode = 49
+	// @xml: "CO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CO AllTerritoryCode = 50
+	// @xml: "CR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CR AllTerritoryCode = 51
+	// @xml: "CS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CS AllTerritoryCode = 52
+	// @xml: "CU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CU AllTerritoryCode = 53
+	// @xml: "CV"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CV AllTerritoryCode = 54
+	// @xml: "CW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CW AllTerritoryCode = 55
+	// @xml: "CX"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CX AllTerritoryCode = 56
+	// @xml: "CY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CY AllTerritoryCode = 57
+	// @xml: "CZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CZ AllTerritoryCode = 58
+	// @xml: "DE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DE AllTerritoryCode = 59
+	// @xml: "DJ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DJ AllTerritoryCode = 60
+	// @xml: "DK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DK AllTerritoryCode = 61
+	// @xml: "DM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DM AllTerritoryCode = 62
+	// @xml: "DO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DO AllTerritoryCode = 63
+	// @xml: "DZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DZ AllTerritoryCode = 64
+	// @xml: "EC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_EC AllTerritoryCode = 65
+	// @xml: "EE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_EE AllTerritoryCode = 66
+	// @xml: "EG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_EG AllTerritoryCode = 67
+	// @xml: "EH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_EH AllTerritoryCode = 68
+	// @xml: "ER"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ER AllTerritoryCode = 69
+	// @xml: "ES"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ES AllTerritoryCode = 70
+	// @xml: "ES-CE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ES_CE AllTerritoryCode = 71
+	// @xml: "ES-CN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ES_CN AllTerritoryCode = 72
+	// @xml: "ES-ML"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ES_ML AllTerritoryCode = 73
+	// @xml: "ET"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ET AllTerritoryCode = 74
+	// @xml: "FI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FI AllTerritoryCode = 75
+	// @xml: "FJ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FJ AllTerritoryCode = 76
+	// @xml: "FK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FK AllTerritoryCode = 77
+	// @xml: "FM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FM AllTerritoryCode = 78
+	// @xml: "FO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FO AllTerritoryCode = 79
+	// @xml: "FR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FR AllTerritoryCode = 80
+	// @xml: "GA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GA AllTerritoryCode = 81
+	// @xml: "GB"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GB AllTerritoryCode = 82
+	// @xml: "GD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GD AllTerritoryCode = 83
+	// @xml: "GE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GE AllTerritoryCode = 84
+	// @xml: "GF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GF AllTerritoryCode = 85
+	// @xml: "GG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GG AllTerritoryCode = 86
+	// @xml: "GH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GH AllTerritoryCode = 87
+	// @xml: "GI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GI AllTerritoryCode = 88
+	// @xml: "GL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GL AllTerritoryCode = 89
+	// @xml: "GM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GM AllTerritoryCode = 90
+	// @xml: "GN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GN AllTerritoryCode = 91
+	// @xml: "GP"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GP AllTerritoryCode = 92
+	// @xml: "GQ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GQ AllTerritoryCode = 93
+	// @xml: "GR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GR AllTerritoryCode = 94
+	// @xml: "GS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GS AllTerritoryCode = 95
+	// @xml: "GT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GT AllTerritoryCode = 96
+	// @xml: "GU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GU AllTerritoryCode = 97
+	// @xml: "GW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GW AllTerritoryCode = 98
+	// @xml: "GY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GY AllTerritoryCode = 99
+	// @xml: "HK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_HK AllTerritoryCode = 100
+	// @xml: "HM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_HM AllTerritoryCode = 101
+	// @xml: "HN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_HN AllTerritoryCode = 102
+	// @xml: "HR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_HR AllTerritoryCode = 103
+	// @xml: "HT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_HT AllTerritoryCode = 104
+	// @xml: "HU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_HU AllTerritoryCode = 105
+	// @xml: "ID"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ID AllTerritoryCode = 106
+	// @xml: "IE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IE AllTerritoryCode = 107
+	// @xml: "IL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IL AllTerritoryCode = 108
+	// @xml: "IM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IM AllTerritoryCode = 109
+	// @xml: "IN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IN AllTerritoryCode = 110
+	// @xml: "IO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IO AllTerritoryCode = 111
+	// @xml: "IQ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IQ AllTerritoryCode = 112
+	// @xml: "IR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IR AllTerritoryCode = 113
+	// @xml: "IS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IS AllTerritoryCode = 114
+	// @xml: "IT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_IT AllTerritoryCode = 115
+	// @xml: "JE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_JE AllTerritoryCode = 116
+	// @xml: "JM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_JM AllTerritoryCode = 117
+	// @xml: "JO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_JO AllTerritoryCode = 118
+	// @xml: "JP"
+	AllTerritoryCode_ALL_TERRITORY_CODE_JP AllTerritoryCode = 119
+	// @xml: "KE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KE AllTerritoryCode = 120
+	// @xml: "KG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KG AllTerritoryCode = 121
+	// @xml: "KH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KH AllTerritoryCode = 122
+	// @xml: "KI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KI AllTerritoryCode = 123
+	// @xml: "KM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KM AllTerritoryCode = 124
+	// @xml: "KN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KN AllTerritoryCode = 125
+	// @xml: "KP"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KP AllTerritoryCode = 126
+	// @xml: "KR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KR AllTerritoryCode = 127
+	// @xml: "KW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KW AllTerritoryCode = 128
+	// @xml: "KY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KY AllTerritoryCode = 129
+	// @xml: "KZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_KZ AllTerritoryCode = 130
+	// @xml: "LA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LA AllTerritoryCode = 131
+	// @xml: "LB"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LB AllTerritoryCode = 132
+	// @xml: "LC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LC AllTerritoryCode = 133
+	// @xml: "LI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LI AllTerritoryCode = 134
+	// @xml: "LK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LK AllTerritoryCode = 135
+	// @xml: "LR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LR AllTerritoryCode = 136
+	// @xml: "LS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LS AllTerritoryCode = 137
+	// @xml: "LT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LT AllTerritoryCode = 138
+	// @xml: "LU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LU AllTerritoryCode = 139
+	// @xml: "LV"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LV AllTerritoryCode = 140
+	// @xml: "LY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_LY AllTerritoryCode = 141
+	// @xml: "MA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MA AllTerritoryCode = 142
+	// @xml: "MC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MC AllTerritoryCode = 143
+	// @xml: "MD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MD AllTerritoryCode = 144
+	// @xml: "ME"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ME AllTerritoryCode = 145
+	// @xml: "MF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MF AllTerritoryCode = 146
+	// @xml: "MG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MG AllTerritoryCode = 147
+	// @xml: "MH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MH AllTerritoryCode = 148
+	// @xml: "MK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MK AllTerritoryCode = 149
+	// @xml: "ML"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ML AllTerritoryCode = 150
+	// @xml: "MM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MM AllTerritoryCode = 151
+	// @xml: "MN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MN AllTerritoryCode = 152
+	// @xml: "MO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MO AllTerritoryCode = 153
+	// @xml: "MP"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MP AllTerritoryCode = 154
+	// @xml: "MQ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MQ AllTerritoryCode = 155
+	// @xml: "MR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MR AllTerritoryCode = 156
+	// @xml: "MS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MS AllTerritoryCode = 157
+	// @xml: "MT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MT AllTerritoryCode = 158
+	// @xml: "MU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MU AllTerritoryCode = 159
+	// @xml: "MV"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MV AllTerritoryCode = 160
+	// @xml: "MW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MW AllTerritoryCode = 161
+	// @xml: "MX"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MX AllTerritoryCode = 162
+	// @xml: "MY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MY AllTerritoryCode = 163
+	// @xml: "MZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MZ AllTerritoryCode = 164
+	// @xml: "NA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NA AllTerritoryCode = 165
+	// @xml: "NC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NC AllTerritoryCode = 166
+	// @xml: "NE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NE AllTerritoryCode = 167
+	// @xml: "NF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NF AllTerritoryCode = 168
+	// @xml: "NG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NG AllTerritoryCode = 169
+	// @xml: "NI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NI AllTerritoryCode = 170
+	// @xml: "NL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NL AllTerritoryCode = 171
+	// @xml: "NO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NO AllTerritoryCode = 172
+	// @xml: "NP"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NP AllTerritoryCode = 173
+	// @xml: "NR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NR AllTerritoryCode = 174
+	// @xml: "NU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NU AllTerritoryCode = 175
+	// @xml: "NZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NZ AllTerritoryCode = 176
+	// @xml: "OM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_OM AllTerritoryCode = 177
+	// @xml: "PA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PA AllTerritoryCode = 178
+	// @xml: "PE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PE AllTerritoryCode = 179
+	// @xml: "PF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PF AllTerritoryCode = 180
+	// @xml: "PG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PG AllTerritoryCode = 181
+	// @xml: "PH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PH AllTerritoryCode = 182
+	// @xml: "PK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PK AllTerritoryCode = 183
+	// @xml: "PL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PL AllTerritoryCode = 184
+	// @xml: "PM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PM AllTerritoryCode = 185
+	// @xml: "PN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PN AllTerritoryCode = 186
+	// @xml: "PR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PR AllTerritoryCode = 187
+	// @xml: "PS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PS AllTerritoryCode = 188
+	// @xml: "PT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PT AllTerritoryCode = 189
+	// @xml: "PW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PW AllTerritoryCode = 190
+	// @xml: "PY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PY AllTerritoryCode = 191
+	// @xml: "QA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_QA AllTerritoryCode = 192
+	// @xml: "RE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_RE AllTerritoryCode = 193
+	// @xml: "RO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_RO AllTerritoryCode = 194
+	// @xml: "RS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_RS AllTerritoryCode = 195
+	// @xml: "RU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_RU AllTerritoryCode = 196
+	// @xml: "RW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_RW AllTerritoryCode = 197
+	// @xml: "SA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SA AllTerritoryCode = 198
+	// @xml: "SB"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SB AllTerritoryCode = 199
+	// @xml: "SC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SC AllTerritoryCode = 200
+	// @xml: "SD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SD AllTerritoryCode = 201
+	// @xml: "SE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SE AllTerritoryCode = 202
+	// @xml: "SG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SG AllTerritoryCode = 203
+	// @xml: "SH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SH AllTerritoryCode = 204
+	// @xml: "SI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SI AllTerritoryCode = 205
+	// @xml: "SJ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SJ AllTerritoryCode = 206
+	// @xml: "SK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SK AllTerritoryCode = 207
+	// @xml: "SL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SL AllTerritoryCode = 208
+	// @xml: "SM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SM AllTerritoryCode = 209
+	// @xml: "SN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SN AllTerritoryCode = 210
+	// @xml: "SO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SO AllTerritoryCode = 211
+	// @xml: "SR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SR AllTerritoryCode = 212
+	// @xml: "SS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SS AllTerritoryCode = 213
+	// @xml: "ST"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ST AllTerritoryCode = 214
+	// @xml: "SV"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SV AllTerritoryCode = 215
+	// @xml: "SX"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SX AllTerritoryCode = 216
+	// @xml: "SY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SY AllTerritoryCode = 217
+	// @xml: "SZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SZ AllTerritoryCode = 218
+	// @xml: "TC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TC AllTerritoryCode = 219
+	// @xml: "TD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TD AllTerritoryCode = 220
+	// @xml: "TF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TF AllTerritoryCode = 221
+	// @xml: "TG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TG AllTerritoryCode = 222
+	// @xml: "TH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TH AllTerritoryCode = 223
+	// @xml: "TJ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TJ AllTerritoryCode = 224
+	// @xml: "TK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TK AllTerritoryCode = 225
+	// @xml: "TL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TL AllTerritoryCode = 226
+	// @xml: "TM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TM AllTerritoryCode = 227
+	// @xml: "TN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TN AllTerritoryCode = 228
+	// @xml: "TO"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TO AllTerritoryCode = 229
+	// @xml: "TR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TR AllTerritoryCode = 230
+	// @xml: "TT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TT AllTerritoryCode = 231
+	// @xml: "TV"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TV AllTerritoryCode = 232
+	// @xml: "TW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TW AllTerritoryCode = 233
+	// @xml: "TZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TZ AllTerritoryCode = 234
+	// @xml: "UA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_UA AllTerritoryCode = 235
+	// @xml: "UG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_UG AllTerritoryCode = 236
+	// @xml: "UM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_UM AllTerritoryCode = 237
+	// @xml: "US"
+	AllTerritoryCode_ALL_TERRITORY_CODE_US AllTerritoryCode = 238
+	// @xml: "UY"
+	AllTerritoryCode_ALL_TERRITORY_CODE_UY AllTerritoryCode = 239
+	// @xml: "UZ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_UZ AllTerritoryCode = 240
+	// @xml: "VA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VA AllTerritoryCode = 241
+	// @xml: "VC"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VC AllTerritoryCode = 242
+	// @xml: "VE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VE AllTerritoryCode = 243
+	// @xml: "VG"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VG AllTerritoryCode = 244
+	// @xml: "VI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VI AllTerritoryCode = 245
+	// @xml: "VN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VN AllTerritoryCode = 246
+	// @xml: "VU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VU AllTerritoryCode = 247
+	// @xml: "WF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_WF AllTerritoryCode = 248
+	// @xml: "WS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_WS AllTerritoryCode = 249
+	// @xml: "YE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_YE AllTerritoryCode = 250
+	// @xml: "YT"
+	AllTerritoryCode_ALL_TERRITORY_CODE_YT AllTerritoryCode = 251
+	// @xml: "ZA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ZA AllTerritoryCode = 252
+	// @xml: "ZM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ZM AllTerritoryCode = 253
+	// @xml: "ZW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ZW AllTerritoryCode = 254
+	// @xml: "4"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_4 AllTerritoryCode = 255
+	// @xml: "8"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_8 AllTerritoryCode = 256
+	// @xml: "12"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_12 AllTerritoryCode = 257
+	// @xml: "20"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_20 AllTerritoryCode = 258
+	// @xml: "24"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_24 AllTerritoryCode = 259
+	// @xml: "28"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_28 AllTerritoryCode = 260
+	// @xml: "31"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_31 AllTerritoryCode = 261
+	// @xml: "32"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_32 AllTerritoryCode = 262
+	// @xml: "36"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_36 AllTerritoryCode = 263
+	// @xml: "40"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_40 AllTerritoryCode = 264
+	// @xml: "44"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_44 AllTerritoryCode = 265
+	// @xml: "48"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_48 AllTerritoryCode = 266
+	// @xml: "50"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_50 AllTerritoryCode = 267
+	// @xml: "51"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_51 AllTerritoryCode = 268
+	// @xml: "52"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_52 AllTerritoryCode = 269
+	// @xml: "56"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_56 AllTerritoryCode = 270
+	// @xml: "64"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_64 AllTerritoryCode = 271
+	// @xml: "68"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_68 AllTerritoryCode = 272
+	// @xml: "70"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_70 AllTerritoryCode = 273
+	// @xml: "72"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_72 AllTerritoryCode = 274
+	// @xml: "76"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_76 AllTerritoryCode = 275
+	// @xml: "84"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_84 AllTerritoryCode = 276
+	// @xml: "90"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_90 AllTerritoryCode = 277
+	// @xml: "96"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_96 AllTerritoryCode = 278
+	// @xml: "100"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_100 AllTerritoryCode = 279
+	// @xml: "104"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_104 AllTerritoryCode = 280
+	// @xml: "108"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_108 AllTerritoryCode = 281
+	// @xml: "112"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_112 AllTerritoryCode = 282
+	// @xml: "116"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_116 AllTerritoryCode = 283
+	// @xml: "120"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_120 AllTerritoryCode = 284
+	// @xml: "124"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_124 AllTerritoryCode = 285
+	// @xml: "132"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_132 AllTerritoryCode = 286
+	// @xml: "140"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_140 AllTerritoryCode = 287
+	// @xml: "144"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_144 AllTerritoryCode = 288
+	// @xml: "148"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_148 AllTerritoryCode = 289
+	// @xml: "152"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_152 AllTerritoryCode = 290
+	// @xml: "156"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_156 AllTerritoryCode = 291
+	// @xml: "158"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_158 AllTerritoryCode = 292
+	// @xml: "170"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_170 AllTerritoryCode = 293
+	// @xml: "174"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_174 AllTerritoryCode = 294
+	// @xml: "178"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_178 AllTerritoryCode = 295
+	// @xml: "180"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_180 AllTerritoryCode = 296
+	// @xml: "188"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_188 AllTerritoryCode = 297
+	// @xml: "191"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_191 AllTerritoryCode = 298
+	// @xml: "192"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_192 AllTerritoryCode = 299
+	// @xml: "196"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_196 AllTerritoryCode = 300
+	// @xml: "200"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_200 AllTerritoryCode = 301
+	// @xml: "203"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_203 AllTerritoryCode = 302
+	// @xml: "204"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_204 AllTerritoryCode = 303
+	// @xml: "208"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_208 AllTerritoryCode = 304
+	// @xml: "212"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_212 AllTerritoryCode = 305
+	// @xml: "214"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_214 AllTerritoryCode = 306
+	// @xml: "218"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_218 AllTerritoryCode = 307
+	// @xml: "222"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_222 AllTerritoryCode = 308
+	// @xml: "226"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_226 AllTerritoryCode = 309
+	// @xml: "230"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_230 AllTerritoryCode = 310
+	// @xml: "231"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_231 AllTerritoryCode = 311
+	// @xml: "232"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_232 AllTerritoryCode = 312
+	// @xml: "233"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_233 AllTerritoryCode = 313
+	// @xml: "242"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_242 AllTerritoryCode = 314
+	// @xml: "246"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_246 AllTerritoryCode = 315
+	// @xml: "250"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_250 AllTerritoryCode = 316
+	// @xml: "258"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_258 AllTerritoryCode = 317
+	// @xml: "262"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_262 AllTerritoryCode = 318
+	// @xml: "266"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_266 AllTerritoryCode = 319
+	// @xml: "268"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_268 AllTerritoryCode = 320
+	// @xml: "270"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_270 AllTerritoryCode = 321
+	// @xml: "276"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_276 AllTerritoryCode = 322
+	// @xml: "278"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_278 AllTerritoryCode = 323
+	// @xml: "280"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_280 AllTerritoryCode = 324
+	// @xml: "288"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_288 AllTerritoryCode = 325
+	// @xml: "296"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_296 AllTerritoryCode = 326
+	// @xml: "300"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_300 AllTerritoryCode = 327
+	// @xml: "308"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_308 AllTerritoryCode = 328
+	// @xml: "320"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_320 AllTerritoryCode = 329
+	// @xml: "324"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_324 AllTerritoryCode = 330
+	// @xml: "328"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_328 AllTerritoryCode = 331
+	// @xml: "332"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_332 AllTerritoryCode = 332
+	// @xml: "336"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_336 AllTerritoryCode = 333
+	// @xml: "340"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_340 AllTerritoryCode = 334
+	// @xml: "344"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_344 AllTerritoryCode = 335
+	// @xml: "348"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_348 AllTerritoryCode = 336
+	// @xml: "352"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_352 AllTerritoryCode = 337
+	// @xml: "356"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_356 AllTerritoryCode = 338
+	// @xml: "360"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_360 AllTerritoryCode = 339
+	// @xml: "364"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_364 AllTerritoryCode = 340
+	// @xml: "368"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_368 AllTerritoryCode = 341
+	// @xml: "372"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_372 AllTerritoryCode = 342
+	// @xml: "376"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_376 AllTerritoryCode = 343
+	// @xml: "380"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_380 AllTerritoryCode = 344
+	// @xml: "384"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_384 AllTerritoryCode = 345
+	// @xml: "388"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_388 AllTerritoryCode = 346
+	// @xml: "392"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_392 AllTerritoryCode = 347
+	// @xml: "398"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_398 AllTerritoryCode = 348
+	// @xml: "400"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_400 AllTerritoryCode = 349
+	// @xml: "404"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_404 AllTerritoryCode = 350
+	// @xml: "408"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_408 AllTerritoryCode = 351
+	// @xml: "410"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_410 AllTerritoryCode = 352
+	// @xml: "414"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_414 AllTerritoryCode = 353
+	// @xml: "417"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_417 AllTerritoryCode = 354
+	// @xml: "418"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_418 AllTerritoryCode = 355
+	// @xml: "422"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_422 AllTerritoryCode = 356
+	// @xml: "426"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_426 AllTerritoryCode = 357
+	// @xml: "428"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_428 AllTerritoryCode = 358
+	// @xml: "430"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_430 AllTerritoryCode = 359
+	// @xml: "434"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_434 AllTerritoryCode = 360
+	// @xml: "438"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_438 AllTerritoryCode = 361
+	// @xml: "440"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_440 AllTerritoryCode = 362
+	// @xml: "442"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_442 AllTerritoryCode = 363
+	// @xml: "446"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_446 AllTerritoryCode = 364
+	// @xml: "450"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_450 AllTerritoryCode = 365
+	// @xml: "454"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_454 AllTerritoryCode = 366
+	// @xml: "458"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_458 AllTerritoryCode = 367
+	// @xml: "462"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_462 AllTerritoryCode = 368
+	// @xml: "466"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_466 AllTerritoryCode = 369
+	// @xml: "470"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_470 AllTerritoryCode = 370
+	// @xml: "478"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_478 AllTerritoryCode = 371
+	// @xml: "480"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_480 AllTerritoryCode = 372
+	// @xml: "484"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_484 AllTerritoryCode = 373
+	// @xml: "492"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_492 AllTerritoryCode = 374
+	// @xml: "496"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_496 AllTerritoryCode = 375
+	// @xml: "498"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_498 AllTerritoryCode = 376
+	// @xml: "499"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_499 AllTerritoryCode = 377
+	// @xml: "504"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_504 AllTerritoryCode = 378
+	// @xml: "508"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_508 AllTerritoryCode = 379
+	// @xml: "512"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_512 AllTerritoryCode = 380
+	// @xml: "516"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_516 AllTerritoryCode = 381
+	// @xml: "520"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_520 AllTerritoryCode = 382
+	// @xml: "524"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_524 AllTerritoryCode = 383
+	// @xml: "528"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_528 AllTerritoryCode = 384
+	// @xml: "540"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_540 AllTerritoryCode = 385
+	// @xml: "548"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_548 AllTerritoryCode = 386
+	// @xml: "554"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_554 AllTerritoryCode = 387
+	// @xml: "558"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_558 AllTerritoryCode = 388
+	// @xml: "562"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_562 AllTerritoryCode = 389
+	// @xml: "566"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_566 AllTerritoryCode = 390
+	// @xml: "578"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_578 AllTerritoryCode = 391
+	// @xml: "583"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_583 AllTerritoryCode = 392
+	// @xml: "584"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_584 AllTerritoryCode = 393
+	// @xml: "585"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_585 AllTerritoryCode = 394
+	// @xml: "586"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_586 AllTerritoryCode = 395
+	// @xml: "591"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_591 AllTerritoryCode = 396
+	// @xml: "598"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_598 AllTerritoryCode = 397
+	// @xml: "600"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_600 AllTerritoryCode = 398
+	// @xml: "604"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_604 AllTerritoryCode = 399
+	// @xml: "608"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_608 AllTerritoryCode = 400
+	// @xml: "616"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_616 AllTerritoryCode = 401
+	// @xml: "620"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_620 AllTerritoryCode = 402
+	// @xml: "624"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_624 AllTerritoryCode = 403
+	// @xml: "626"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_626 AllTerritoryCode = 404
+	// @xml: "630"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_630 AllTerritoryCode = 405
+	// @xml: "634"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_634 AllTerritoryCode = 406
+	// @xml: "642"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_642 AllTerritoryCode = 407
+	// @xml: "643"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_643 AllTerritoryCode = 408
+	// @xml: "646"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_646 AllTerritoryCode = 409
+	// @xml: "659"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_659 AllTerritoryCode = 410
+	// @xml: "662"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_662 AllTerritoryCode = 411
+	// @xml: "670"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_670 AllTerritoryCode = 412
+	// @xml: "674"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_674 AllTerritoryCode = 413
+	// @xml: "678"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_678 AllTerritoryCode = 414
+	// @xml: "682"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_682 AllTerritoryCode = 415
+	// @xml: "686"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_686 AllTerritoryCode = 416
+	// @xml: "688"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_688 AllTerritoryCode = 417
+	// @xml: "690"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_690 AllTerritoryCode = 418
+	// @xml: "694"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_694 AllTerritoryCode = 419
+	// @xml: "702"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_702 AllTerritoryCode = 420
+	// @xml: "703"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_703 AllTerritoryCode = 421
+	// @xml: "704"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_704 AllTerritoryCode = 422
+	// @xml: "705"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_705 AllTerritoryCode = 423
+	// @xml: "706"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_706 AllTerritoryCode = 424
+	// @xml: "710"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_710 AllTerritoryCode = 425
+	// @xml: "716"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_716 AllTerritoryCode = 426
+	// @xml: "720"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_720 AllTerritoryCode = 427
+	// @xml: "724"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_724 AllTerritoryCode = 428
+	// @xml: "728"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_728 AllTerritoryCode = 429
+	// @xml: "729"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_729 AllTerritoryCode = 430
+	// @xml: "732"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_732 AllTerritoryCode = 431
+	// @xml: "736"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_736 AllTerritoryCode = 432
+	// @xml: "740"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_740 AllTerritoryCode = 433
+	// @xml: "748"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_748 AllTerritoryCode = 434
+	// @xml: "752"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_752 AllTerritoryCode = 435
+	// @xml: "756"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_756 AllTerritoryCode = 436
+	// @xml: "760"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_760 AllTerritoryCode = 437
+	// @xml: "762"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_762 AllTerritoryCode = 438
+	// @xml: "764"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_764 AllTerritoryCode = 439
+	// @xml: "768"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_768 AllTerritoryCode = 440
+	// @xml: "776"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_776 AllTerritoryCode = 441
+	// @xml: "780"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_780 AllTerritoryCode = 442
+	// @xml: "784"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_784 AllTerritoryCode = 443
+	// @xml: "788"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_788 AllTerritoryCode = 444
+	// @xml: "792"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_792 AllTerritoryCode = 445
+	// @xml: "795"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_795 AllTerritoryCode = 446
+	// @xml: "798"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_798 AllTerritoryCode = 447
+	// @xml: "800"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_800 AllTerritoryCode = 448
+	// @xml: "804"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_804 AllTerritoryCode = 449
+	// @xml: "807"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_807 AllTerritoryCode = 450
+	// @xml: "810"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_810 AllTerritoryCode = 451
+	// @xml: "818"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_818 AllTerritoryCode = 452
+	// @xml: "826"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_826 AllTerritoryCode = 453
+	// @xml: "834"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_834 AllTerritoryCode = 454
+	// @xml: "840"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_840 AllTerritoryCode = 455
+	// @xml: "854"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_854 AllTerritoryCode = 456
+	// @xml: "858"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_858 AllTerritoryCode = 457
+	// @xml: "860"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_860 AllTerritoryCode = 458
+	// @xml: "862"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_862 AllTerritoryCode = 459
+	// @xml: "882"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_882 AllTerritoryCode = 460
+	// @xml: "886"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_886 AllTerritoryCode = 461
+	// @xml: "887"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_887 AllTerritoryCode = 462
+	// @xml: "890"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_890 AllTerritoryCode = 463
+	// @xml: "891"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_891 AllTerritoryCode = 464
+	// @xml: "894"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_894 AllTerritoryCode = 465
+	// @xml: "2100"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2100 AllTerritoryCode = 466
+	// @xml: "2101"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2101 AllTerritoryCode = 467
+	// @xml: "2102"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2102 AllTerritoryCode = 468
+	// @xml: "2103"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2103 AllTerritoryCode = 469
+	// @xml: "2104"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2104 AllTerritoryCode = 470
+	// @xml: "2105"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2105 AllTerritoryCode = 471
+	// @xml: "2106"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2106 AllTerritoryCode = 472
+	// @xml: "2107"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2107 AllTerritoryCode = 473
+	// @xml: "2108"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2108 AllTerritoryCode = 474
+	// @xml: "2109"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2109 AllTerritoryCode = 475
+	// @xml: "2110"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2110 AllTerritoryCode = 476
+	// @xml: "2111"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2111 AllTerritoryCode = 477
+	// @xml: "2112"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2112 AllTerritoryCode = 478
+	// @xml: "2113"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2113 AllTerritoryCode = 479
+	// @xml: "2114"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2114 AllTerritoryCode = 480
+	// @xml: "2115"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2115 AllTerritoryCode = 481
+	// @xml: "2116"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2116 AllTerritoryCode = 482
+	// @xml: "2117"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2117 AllTerritoryCode = 483
+	// @xml: "2118"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2118 AllTerritoryCode = 484
+	// @xml: "2119"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2119 AllTerritoryCode = 485
+	// @xml: "2120"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2120 AllTerritoryCode = 486
+	// @xml: "2121"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2121 AllTerritoryCode = 487
+	// @xml: "2122"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2122 AllTerritoryCode = 488
+	// @xml: "2123"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2123 AllTerritoryCode = 489
+	// @xml: "2124"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2124 AllTerritoryCode = 490
+	// @xml: "2125"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2125 AllTerritoryCode = 491
+	// @xml: "2126"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2126 AllTerritoryCode = 492
+	// @xml: "2127"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2127 AllTerritoryCode = 493
+	// @xml: "2128"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2128 AllTerritoryCode = 494
+	// @xml: "2129"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2129 AllTerritoryCode = 495
+	// @xml: "2130"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2130 AllTerritoryCode = 496
+	// @xml: "2131"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2131 AllTerritoryCode = 497
+	// @xml: "2132"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2132 AllTerritoryCode = 498
+	// @xml: "2133"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2133 AllTerritoryCode = 499
+	// @xml: "2134"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2134 AllTerritoryCode = 500
+	// @xml: "2136"
+	AllTerritoryCode_ALL_TERRITORY_CODE_E_2136 AllTerritoryCode = 501
+	// @xml: "XK"
+	AllTerritoryCode_ALL_TERRITORY_CODE_XK AllTerritoryCode = 502
+	// @xml: "Worldwide"
+	AllTerritoryCode_ALL_TERRITORY_CODE_WORLDWIDE AllTerritoryCode = 503
+	// @xml: "AIDJ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_AIDJ AllTerritoryCode = 504
+	// @xml: "ANHH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ANHH AllTerritoryCode = 505
+	// @xml: "BQAQ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BQAQ AllTerritoryCode = 506
+	// @xml: "BUMM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BUMM AllTerritoryCode = 507
+	// @xml: "BYAA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_BYAA AllTerritoryCode = 508
+	// @xml: "CSHH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CSHH AllTerritoryCode = 509
+	// @xml: "CSXX"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CSXX AllTerritoryCode = 510
+	// @xml: "CTKI"
+	AllTerritoryCode_ALL_TERRITORY_CODE_CTKI AllTerritoryCode = 511
+	// @xml: "DDDE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DDDE AllTerritoryCode = 512
+	// @xml: "DYBJ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_DYBJ AllTerritoryCode = 513
+	// @xml: "FQHH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FQHH AllTerritoryCode = 514
+	// @xml: "FXFR"
+	AllTerritoryCode_ALL_TERRITORY_CODE_FXFR AllTerritoryCode = 515
+	// @xml: "GEHH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_GEHH AllTerritoryCode = 516
+	// @xml: "HVBF"
+	AllTerritoryCode_ALL_TERRITORY_CODE_HVBF AllTerritoryCode = 517
+	// @xml: "JTUM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_JTUM AllTerritoryCode = 518
+	// @xml: "MIUM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_MIUM AllTerritoryCode = 519
+	// @xml: "NHVU"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NHVU AllTerritoryCode = 520
+	// @xml: "NQAQ"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NQAQ AllTerritoryCode = 521
+	// @xml: "NTHH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_NTHH AllTerritoryCode = 522
+	// @xml: "PCHH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PCHH AllTerritoryCode = 523
+	// @xml: "PUUM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PUUM AllTerritoryCode = 524
+	// @xml: "PZPA"
+	AllTerritoryCode_ALL_TERRITORY_CODE_PZPA AllTerritoryCode = 525
+	// @xml: "RHZW"
+	AllTerritoryCode_ALL_TERRITORY_CODE_RHZW AllTerritoryCode = 526
+	// @xml: "SKIN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SKIN AllTerritoryCode = 527
+	// @xml: "SUHH"
+	AllTerritoryCode_ALL_TERRITORY_CODE_SUHH AllTerritoryCode = 528
+	// @xml: "TPTL"
+	AllTerritoryCode_ALL_TERRITORY_CODE_TPTL AllTerritoryCode = 529
+	// @xml: "VDVN"
+	AllTerritoryCode_ALL_TERRITORY_CODE_VDVN AllTerritoryCode = 530
+	// @xml: "WKUM"
+	AllTerritoryCode_ALL_TERRITORY_CODE_WKUM AllTerritoryCode = 531
+	// @xml: "YDYE"
+	AllTerritoryCode_ALL_TERRITORY_CODE_YDYE AllTerritoryCode = 532
+	// @xml: "YUCS"
+	AllTerritoryCode_ALL_TERRITORY_CODE_YUCS AllTerritoryCode = 533
+	// @xml: "ZRCD"
+	AllTerritoryCode_ALL_TERRITORY_CODE_ZRCD AllTerritoryCode = 534
 )
 
 // Enum value maps for AllTerritoryCode.
@@ -1776,65 +2320,123 @@ func (AllTerritoryCode) EnumDescriptor() ([]byte, []int) {
 type ArtistRole int32
 
 const (
-	ArtistRole_ARTIST_ROLE_UNSPECIFIED            ArtistRole = 0
-	ArtistRole_ARTIST_ROLE_ACTOR                  ArtistRole = 1
-	ArtistRole_ARTIST_ROLE_ADAPTER                ArtistRole = 2
-	ArtistRole_ARTIST_ROLE_ARCHITECT              ArtistRole = 3
-	ArtistRole_ARTIST_ROLE_ARRANGER               ArtistRole = 4
-	ArtistRole_ARTIST_ROLE_ARTIST                 ArtistRole = 5
-	ArtistRole_ARTIST_ROLE_ASSOCIATEDPERFORMER    ArtistRole = 6
-	ArtistRole_ARTIST_ROLE_AUTHOR                 ArtistRole = 7
-	ArtistRole_ARTIST_ROLE_BAND                   ArtistRole = 8
-	ArtistRole_ARTIST_ROLE_CARTOONIST             ArtistRole = 9
-	ArtistRole_ARTIST_ROLE_CHOIR                  ArtistRole = 10
-	ArtistRole_ARTIST_ROLE_CHOREOGRAPHER          ArtistRole = 11
-	ArtistRole_ARTIST_ROLE_COMPOSER               ArtistRole = 12
-	ArtistRole_ARTIST_ROLE_COMPOSERLYRICIST       ArtistRole = 13
+	ArtistRole_ARTIST_ROLE_UNSPECIFIED ArtistRole = 0
+	// @xml: "Actor"
+	ArtistRole_ARTIST_ROLE_ACTOR ArtistRole = 1
+	// @xml: "Adapter"
+	ArtistRole_ARTIST_ROLE_ADAPTER ArtistRole = 2
+	// @xml: "Architect"
+	ArtistRole_ARTIST_ROLE_ARCHITECT ArtistRole = 3
+	// @xml: "Arranger"
+	ArtistRole_ARTIST_ROLE_ARRANGER ArtistRole = 4
+	// @xml: "Artist"
+	ArtistRole_ARTIST_ROLE_ARTIST ArtistRole = 5
+	// @xml: "AssociatedPerformer"
+	ArtistRole_ARTIST_ROLE_ASSOCIATEDPERFORMER ArtistRole = 6
+	// @xml: "Author"
+	ArtistRole_ARTIST_ROLE_AUTHOR ArtistRole = 7
+	// @xml: "Band"
+	ArtistRole_ARTIST_ROLE_BAND ArtistRole = 8
+	// @xml: "Cartoonist"
+	ArtistRole_ARTIST_ROLE_CARTOONIST ArtistRole = 9
+	// @xml: "Choir"
+	ArtistRole_ARTIST_ROLE_CHOIR ArtistRole = 10
+	// @xml: "Choreographer"
+	ArtistRole_ARTIST_ROLE_CHOREOGRAPHER ArtistRole = 11
+	// @xml: "Composer"
+	ArtistRole_ARTIST_ROLE_COMPOSER ArtistRole = 12
+	// @xml: "ComposerLyricist"
+	ArtistRole_ARTIST_ROLE_COMPOSERLYRICIST ArtistRole = 13
+	// @xml: "ComputerGraphicCreator"
 	ArtistRole_ARTIST_ROLE_COMPUTERGRAPHICCREATOR ArtistRole = 14
-	ArtistRole_ARTIST_ROLE_CONDUCTOR              ArtistRole = 15
-	ArtistRole_ARTIST_ROLE_CONTRIBUTOR            ArtistRole = 16
-	ArtistRole_ARTIST_ROLE_DANCER                 ArtistRole = 17
-	ArtistRole_ARTIST_ROLE_DESIGNER               ArtistRole = 18
-	ArtistRole_ARTIST_ROLE_DIRECTOR               ArtistRole = 19
-	ArtistRole_ARTIST_ROLE_ENSEMBLE               ArtistRole = 20
-	ArtistRole_ARTIST_ROLE_FEATUREDARTIST         ArtistRole = 21
-	ArtistRole_ARTIST_ROLE_FILMDIRECTOR           ArtistRole = 22
-	ArtistRole_ARTIST_ROLE_GRAPHICARTIST          ArtistRole = 23
-	ArtistRole_ARTIST_ROLE_GRAPHICDESIGNER        ArtistRole = 24
-	ArtistRole_ARTIST_ROLE_JOURNALIST             ArtistRole = 25
-	ArtistRole_ARTIST_ROLE_LIBRETTIST             ArtistRole = 26
-	ArtistRole_ARTIST_ROLE_LYRICIST               ArtistRole = 27
-	ArtistRole_ARTIST_ROLE_MAINARTIST             ArtistRole = 28
-	ArtistRole_ARTIST_ROLE_NARRATOR               ArtistRole = 29
-	ArtistRole_ARTIST_ROLE_NONLYRICAUTHOR         ArtistRole = 30
-	ArtistRole_ARTIST_ROLE_ORCHESTRA              ArtistRole = 31
-	ArtistRole_ARTIST_ROLE_ORIGINALPUBLISHER      ArtistRole = 32
-	ArtistRole_ARTIST_ROLE_PAINTER                ArtistRole = 33
-	ArtistRole_ARTIST_ROLE_PHOTOGRAPHER           ArtistRole = 34
-	ArtistRole_ARTIST_ROLE_PHOTOGRAPHYDIRECTOR    ArtistRole = 35
-	ArtistRole_ARTIST_ROLE_PLAYWRIGHT             ArtistRole = 36
-	ArtistRole_ARTIST_ROLE_PRIMARYMUSICIAN        ArtistRole = 37
-	ArtistRole_ARTIST_ROLE_PRODUCER               ArtistRole = 38
-	ArtistRole_ARTIST_ROLE_PROGRAMMER             ArtistRole = 39
-	ArtistRole_ARTIST_ROLE_SCREENPLAYAUTHOR       ArtistRole = 40
-	ArtistRole_ARTIST_ROLE_SOLOIST                ArtistRole = 41
-	ArtistRole_ARTIST_ROLE_STUDIOMUSICIAN         ArtistRole = 42
-	ArtistRole_ARTIST_ROLE_STUDIOPERSONNEL        ArtistRole = 43
-	ArtistRole_ARTIST_ROLE_SUBARRANGER            ArtistRole = 44
-	ArtistRole_ARTIST_ROLE_TRANSLATOR             ArtistRole = 45
-	ArtistRole_ARTIST_ROLE_UNKNOWN                ArtistRole = 46
-	ArtistRole_ARTIST_ROLE_USERDEFINED            ArtistRole = 47
-	ArtistRole_ARTIST_ROLE_ARTCOPYIST             ArtistRole = 48
-	ArtistRole_ARTIST_ROLE_CALLIGRAPHER           ArtistRole = 49
-	ArtistRole_ARTIST_ROLE_CARTOGRAPHER           ArtistRole = 50
-	ArtistRole_ARTIST_ROLE_COMPUTERPROGRAMMER     ArtistRole = 51
-	ArtistRole_ARTIST_ROLE_DELINEATOR             ArtistRole = 52
-	ArtistRole_ARTIST_ROLE_DRAUGHTSMAN            ArtistRole = 53
-	ArtistRole_ARTIST_ROLE_FACSIMILIST            ArtistRole = 54
-	ArtistRole_ARTIST_ROLE_ILLUSTRATOR            ArtistRole = 55
-	ArtistRole_ARTIST_ROLE_MUSICCOPYIST           ArtistRole = 56
-	ArtistRole_ARTIST_ROLE_NOTSPECIFIED           ArtistRole = 57
-	ArtistRole_ARTIST_ROLE_TYPEDESIGNER           ArtistRole = 58
+	// @xml: "Conductor"
+	ArtistRole_ARTIST_ROLE_CONDUCTOR ArtistRole = 15
+	// @xml: "Contributor"
+	ArtistRole_ARTIST_ROLE_CONTRIBUTOR ArtistRole = 16
+	// @xml: "Dancer"
+	ArtistRole_ARTIST_ROLE_DANCER ArtistRole = 17
+	// @xml: "Designer"
+	ArtistRole_ARTIST_ROLE_DESIGNER ArtistRole = 18
+	// @xml: "Director"
+	ArtistRole_ARTIST_ROLE_DIRECTOR ArtistRole = 19
+	// @xml: "Ensemble"
+	ArtistRole_ARTIST_ROLE_ENSEMBLE ArtistRole = 20
+	// @xml: "FeaturedArtist"
+	ArtistRole_ARTIST_ROLE_FEATUREDARTIST ArtistRole = 21
+	// @xml: "FilmDirector"
+	ArtistRole_ARTIST_ROLE_FILMDIRECTOR ArtistRole = 22
+	// @xml: "GraphicArtist"
+	ArtistRole_ARTIST_ROLE_GRAPHICARTIST ArtistRole = 23
+	// @xml: "GraphicDesigner"
+	ArtistRole_ARTIST_ROLE_GRAPHICDESIGNER ArtistRole = 24
+	// @xml: "Journalist"
+	ArtistRole_ARTIST_ROLE_JOURNALIST ArtistRole = 25
+	// @xml: "Librettist"
+	ArtistRole_ARTIST_ROLE_LIBRETTIST ArtistRole = 26
+	// @xml: "Lyricist"
+	ArtistRole_ARTIST_ROLE_LYRICIST ArtistRole = 27
+	// @xml: "MainArtist"
+	ArtistRole_ARTIST_ROLE_MAINARTIST ArtistRole = 28
+	// @xml: "Narrator"
+	ArtistRole_ARTIST_ROLE_NARRATOR ArtistRole = 29
+	// @xml: "NonLyricAuthor"
+	ArtistRole_ARTIST_ROLE_NONLYRICAUTHOR ArtistRole = 30
+	// @xml: "Orchestra"
+	ArtistRole_ARTIST_ROLE_ORCHESTRA ArtistRole = 31
+	// @xml: "OriginalPublisher"
+	ArtistRole_ARTIST_ROLE_ORIGINALPUBLISHER ArtistRole = 32
+	// @xml: "Painter"
+	ArtistRole_ARTIST_ROLE_PAINTER ArtistRole = 33
+	// @xml: "Photographer"
+	ArtistRole_ARTIST_ROLE_PHOTOGRAPHER ArtistRole = 34
+	// @xml: "PhotographyDirector"
+	ArtistRole_ARTIST_ROLE_PHOTOGRAPHYDIRECTOR ArtistRole = 35
+	// @xml: "Playwright"
+	ArtistRole_ARTIST_ROLE_PLAYWRIGHT ArtistRole = 36
+	// @xml: "PrimaryMusician"
+	ArtistRole_ARTIST_ROLE_PRIMARYMUSICIAN ArtistRole = 37
+	// @xml: "Producer"
+	ArtistRole_ARTIST_ROLE_PRODUCER ArtistRole = 38
+	// @xml: "Programmer"
+	ArtistRole_ARTIST_ROLE_PROGRAMMER ArtistRole = 39
+	// @xml: "ScreenplayAuthor"
+	ArtistRole_ARTIST_ROLE_SCREENPLAYAUTHOR ArtistRole = 40
+	// @xml: "Soloist"
+	ArtistRole_ARTIST_ROLE_SOLOIST ArtistRole = 41
+	// @xml: "StudioMusician"
+	ArtistRole_ARTIST_ROLE_STUDIOMUSICIAN ArtistRole = 42
+	// @xml: "StudioPersonnel"
+	ArtistRole_ARTIST_ROLE_STUDIOPERSONNEL ArtistRole = 43
+	// @xml: "SubArranger"
+	ArtistRole_ARTIST_ROLE_SUBARRANGER ArtistRole = 44
+	// @xml: "Translator"
+	ArtistRole_ARTIST_ROLE_TRANSLATOR ArtistRole = 45
+	// @xml: "Unknown"
+	ArtistRole_ARTIST_ROLE_UNKNOWN ArtistRole = 46
+	// @xml: "UserDefined"
+	ArtistRole_ARTIST_ROLE_USERDEFINED ArtistRole = 47
+	// @xml: "ArtCopyist"
+	ArtistRole_ARTIST_ROLE_ARTCOPYIST ArtistRole = 48
+	// @xml: "Calligrapher"
+	ArtistRole_ARTIST_ROLE_CALLIGRAPHER ArtistRole = 49
+	// @xml: "Cartographer"
+	ArtistRole_ARTIST_ROLE_CARTOGRAPHER ArtistRole = 50
+	// @xml: "ComputerProgrammer"
+	ArtistRole_ARTIST_ROLE_COMPUTERPROGRAMMER ArtistRole = 51
+	// @xml: "Delineator"
+	ArtistRole_ARTIST_ROLE_DELINEATOR ArtistRole = 52
+	// @xml: "Draughtsman"
+	ArtistRole_ARTIST_ROLE_DRAUGHTSMAN ArtistRole = 53
+	// @xml: "Facsimilist"
+	ArtistRole_ARTIST_ROLE_FACSIMILIST ArtistRole = 54
+	// @xml: "Illustrator"
+	ArtistRole_ARTIST_ROLE_ILLUSTRATOR ArtistRole = 55
+	// @xml: "MusicCopyist"
+	ArtistRole_ARTIST_ROLE_MUSICCOPYIST ArtistRole = 56
+	// @xml: "NotSpecified"
+	ArtistRole_ARTIST_ROLE_NOTSPECIFIED ArtistRole = 57
+	// @xml: "TypeDesigner"
+	ArtistRole_ARTIST_ROLE_TYPEDESIGNER ArtistRole = 58
 )
 
 // Enum value maps for ArtistRole.
@@ -1994,28 +2596,50 @@ type AudioCodecType int32
 
 const (
 	AudioCodecType_AUDIO_CODEC_TYPE_UNSPECIFIED AudioCodecType = 0
-	AudioCodecType_AUDIO_CODEC_TYPE_AAC         AudioCodecType = 1
-	AudioCodecType_AUDIO_CODEC_TYPE_ADPCM       AudioCodecType = 2
-	AudioCodecType_AUDIO_CODEC_TYPE_ALAW        AudioCodecType = 3
-	AudioCodecType_AUDIO_CODEC_TYPE_AMR_NB      AudioCodecType = 4
-	AudioCodecType_AUDIO_CODEC_TYPE_AMR_WB      AudioCodecType = 5
-	AudioCodecType_AUDIO_CODEC_TYPE_FLAC        AudioCodecType = 6
-	AudioCodecType_AUDIO_CODEC_TYPE_MP2         AudioCodecType = 7
-	AudioCodecType_AUDIO_CODEC_TYPE_MP3         AudioCodecType = 8
-	AudioCodecType_AUDIO_CODEC_TYPE_MULAW       AudioCodecType = 9
-	AudioCodecType_AUDIO_CODEC_TYPE_PCM         AudioCodecType = 10
-	AudioCodecType_AUDIO_CODEC_TYPE_PDM         AudioCodecType = 11
-	AudioCodecType_AUDIO_CODEC_TYPE_QCELP       AudioCodecType = 12
-	AudioCodecType_AUDIO_CODEC_TYPE_REALAUDIO   AudioCodecType = 13
-	AudioCodecType_AUDIO_CODEC_TYPE_SHOCKWAVE   AudioCodecType = 14
-	AudioCodecType_AUDIO_CODEC_TYPE_UNKNOWN     AudioCodecType = 15
+	// @xml: "AAC"
+	AudioCodecType_AUDIO_CODEC_TYPE_AAC AudioCodecType = 1
+	// @xml: "ADPCM"
+	AudioCodecType_AUDIO_CODEC_TYPE_ADPCM AudioCodecType = 2
+	// @xml: "ALaw"
+	AudioCodecType_AUDIO_CODEC_TYPE_ALAW AudioCodecType = 3
+	// @xml: "AMR-NB"
+	AudioCodecType_AUDIO_CODEC_TYPE_AMR_NB AudioCodecType = 4
+	// @xml: "AMR-WB"
+	AudioCodecType_AUDIO_CODEC_TYPE_AMR_WB AudioCodecType = 5
+	// @xml: "FLAC"
+	AudioCodecType_AUDIO_CODEC_TYPE_FLAC AudioCodecType = 6
+	// @xml: "MP2"
+	AudioCodecType_AUDIO_CODEC_TYPE_MP2 AudioCodecType = 7
+	// @xml: "MP3"
+	AudioCodecType_AUDIO_CODEC_TYPE_MP3 AudioCodecType = 8
+	// @xml: "MuLaw"
+	AudioCodecType_AUDIO_CODEC_TYPE_MULAW AudioCodecType = 9
+	// @xml: "PCM"
+	AudioCodecType_AUDIO_CODEC_TYPE_PCM AudioCodecType = 10
+	// @xml: "PDM"
+	AudioCodecType_AUDIO_CODEC_TYPE_PDM AudioCodecType = 11
+	// @xml: "QCELP"
+	AudioCodecType_AUDIO_CODEC_TYPE_QCELP AudioCodecType = 12
+	// @xml: "RealAudio"
+	AudioCodecType_AUDIO_CODEC_TYPE_REALAUDIO AudioCodecType = 13
+	// @xml: "Shockwave"
+	AudioCodecType_AUDIO_CODEC_TYPE_SHOCKWAVE AudioCodecType = 14
+	// @xml: "Unknown"
+	AudioCodecType_AUDIO_CODEC_TYPE_UNKNOWN AudioCodecType = 15
+	// @xml: "UserDefined"
 	AudioCodecType_AUDIO_CODEC_TYPE_USERDEFINED AudioCodecType = 16
-	AudioCodecType_AUDIO_CODEC_TYPE_VORBIS      AudioCodecType = 17
-	AudioCodecType_AUDIO_CODEC_TYPE_WMA         AudioCodecType = 18
-	AudioCodecType_AUDIO_CODEC_TYPE_AMR         AudioCodecType = 19
-	AudioCodecType_AUDIO_CODEC_TYPE_ATMOS       AudioCodecType = 20
-	AudioCodecType_AUDIO_CODEC_TYPE_MP          AudioCodecType = 21
-	AudioCodecType_AUDIO_CODEC_TYPE_MQA         AudioCodecType = 22
+	// @xml: "Vorbis"
+	AudioCodecType_AUDIO_CODEC_TYPE_VORBIS AudioCodecType = 17
+	// @xml: "WMA"
+	AudioCodecType_AUDIO_CODEC_TYPE_WMA AudioCodecType = 18
+	// @xml: "AMR"
+	AudioCodecType_AUDIO_CODEC_TYPE_AMR AudioCodecType = 19
+	// @xml: "Atmos"
+	AudioCodecType_AUDIO_CODEC_TYPE_ATMOS AudioCodecType = 20
+	// @xml: "MP"
+	AudioCodecType_AUDIO_CODEC_TYPE_MP AudioCodecType = 21
+	// @xml: "MQA"
+	AudioCodecType_AUDIO_CODEC_TYPE_MQA AudioCodecType = 22
 )
 
 // Enum value maps for AudioCodecType.
@@ -2103,8 +2727,10 @@ type BinaryDataType int32
 
 const (
 	BinaryDataType_BINARY_DATA_TYPE_UNSPECIFIED BinaryDataType = 0
-	BinaryDataType_BINARY_DATA_TYPE_BINARY64    BinaryDataType = 1
-	BinaryDataType_BINARY_DATA_TYPE_HEXBINARY   BinaryDataType = 2
+	// @xml: "Binary64"
+	BinaryDataType_BINARY_DATA_TYPE_BINARY64 BinaryDataType = 1
+	// @xml: "HexBinary"
+	BinaryDataType_BINARY_DATA_TYPE_HEXBINARY BinaryDataType = 2
 )
 
 // Enum value maps for BinaryDataType.
@@ -2151,14 +2777,21 @@ func (BinaryDataType) EnumDescriptor() ([]byte, []int) {
 type BusinessContributorRole int32
 
 const (
-	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_UNSPECIFIED          BusinessContributorRole = 0
-	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_CONTRIBUTOR          BusinessContributorRole = 1
-	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_MUSICPUBLISHER       BusinessContributorRole = 2
-	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER    BusinessContributorRole = 3
-	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_SUBPUBLISHER         BusinessContributorRole = 4
+	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_UNSPECIFIED BusinessContributorRole = 0
+	// @xml: "Contributor"
+	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_CONTRIBUTOR BusinessContributorRole = 1
+	// @xml: "MusicPublisher"
+	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_MUSICPUBLISHER BusinessContributorRole = 2
+	// @xml: "OriginalPublisher"
+	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER BusinessContributorRole = 3
+	// @xml: "SubPublisher"
+	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_SUBPUBLISHER BusinessContributorRole = 4
+	// @xml: "SubstitutedPublisher"
 	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_SUBSTITUTEDPUBLISHER BusinessContributorRole = 5
-	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_UNKNOWN              BusinessContributorRole = 6
-	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_USERDEFINED          BusinessContributorRole = 7
+	// @xml: "Unknown"
+	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_UNKNOWN BusinessContributorRole = 6
+	// @xml: "UserDefined"
+	BusinessContributorRole_BUSINESS_CONTRIBUTOR_ROLE_USERDEFINED BusinessContributorRole = 7
 )
 
 // Enum value maps for BusinessContributorRole.
@@ -2215,119 +2848,231 @@ func (BusinessContributorRole) EnumDescriptor() ([]byte, []int) {
 type CarrierType int32
 
 const (
-	CarrierType_CARRIER_TYPE_UNSPECIFIED                  CarrierType = 0
-	CarrierType_CARRIER_TYPE_E_12INCHDISCOSINGLEREMIX     CarrierType = 1
-	CarrierType_CARRIER_TYPE_E_33RPM10INCHLP              CarrierType = 2
-	CarrierType_CARRIER_TYPE_E_33RPM10INCHSINGLE          CarrierType = 3
-	CarrierType_CARRIER_TYPE_E_33RPM12INCHLP              CarrierType = 4
-	CarrierType_CARRIER_TYPE_E_33RPM12INCHLP20TRACKS      CarrierType = 5
-	CarrierType_CARRIER_TYPE_E_33RPM12INCHMAXISINGLE      CarrierType = 6
-	CarrierType_CARRIER_TYPE_E_33RPM12INCHSINGLE          CarrierType = 7
-	CarrierType_CARRIER_TYPE_E_33RPM7INCHLP               CarrierType = 8
-	CarrierType_CARRIER_TYPE_E_33RPM7INCHSINGLE           CarrierType = 9
-	CarrierType_CARRIER_TYPE_E_45RPM10INCHLP              CarrierType = 10
-	CarrierType_CARRIER_TYPE_E_45RPM10INCHMAXISINGLE      CarrierType = 11
-	CarrierType_CARRIER_TYPE_E_45RPM10INCHSINGLE          CarrierType = 12
-	CarrierType_CARRIER_TYPE_E_45RPM12INCHLP              CarrierType = 13
-	CarrierType_CARRIER_TYPE_E_45RPM12INCHMAXISINGLE      CarrierType = 14
-	CarrierType_CARRIER_TYPE_E_45RPM12INCHSINGLE          CarrierType = 15
-	CarrierType_CARRIER_TYPE_E_45RPM7INCHEP               CarrierType = 16
-	CarrierType_CARRIER_TYPE_E_45RPM7INCHSINGLE           CarrierType = 17
-	CarrierType_CARRIER_TYPE_E_7INCHMAXISINGLEREMIX       CarrierType = 18
-	CarrierType_CARRIER_TYPE_BLURAY                       CarrierType = 19
-	CarrierType_CARRIER_TYPE_CD                           CarrierType = 20
-	CarrierType_CARRIER_TYPE_CDCOMPILATION                CarrierType = 21
-	CarrierType_CARRIER_TYPE_CDEP                         CarrierType = 22
-	CarrierType_CARRIER_TYPE_CDEPENHANCED                 CarrierType = 23
-	CarrierType_CARRIER_TYPE_CDEXTRACOMPILATION           CarrierType = 24
-	CarrierType_CARRIER_TYPE_CDEXTRAEP                    CarrierType = 25
-	CarrierType_CARRIER_TYPE_CDEXTRALP                    CarrierType = 26
-	CarrierType_CARRIER_TYPE_CDEXTRAMAXIREMIX             CarrierType = 27
-	CarrierType_CARRIER_TYPE_CDEXTRAMAXISINGLE            CarrierType = 28
-	CarrierType_CARRIER_TYPE_CDEXTRASINGLE                CarrierType = 29
-	CarrierType_CARRIER_TYPE_CDEXTRASINGLE2TRACKS         CarrierType = 30
-	CarrierType_CARRIER_TYPE_CDLP                         CarrierType = 31
-	CarrierType_CARRIER_TYPE_CDLP5INCH                    CarrierType = 32
-	CarrierType_CARRIER_TYPE_CDLPENHANCED                 CarrierType = 33
-	CarrierType_CARRIER_TYPE_CDLPPLUSCDVIDEO              CarrierType = 34
-	CarrierType_CARRIER_TYPE_CDLPPLUSDVDAUDIO             CarrierType = 35
-	CarrierType_CARRIER_TYPE_CDLPPLUSDVDVIDEO             CarrierType = 36
-	CarrierType_CARRIER_TYPE_CDLPPLUSWEB                  CarrierType = 37
-	CarrierType_CARRIER_TYPE_CDMAXISINGLE                 CarrierType = 38
-	CarrierType_CARRIER_TYPE_CDMAXISINGLE3INCH            CarrierType = 39
-	CarrierType_CARRIER_TYPE_CDMAXISINGLEENHANCED         CarrierType = 40
-	CarrierType_CARRIER_TYPE_CDMAXISINGLEREMIX            CarrierType = 41
-	CarrierType_CARRIER_TYPE_CDPLUSCDBONUS                CarrierType = 42
-	CarrierType_CARRIER_TYPE_CDPLUSDVDBONUS               CarrierType = 43
-	CarrierType_CARRIER_TYPE_CDROM                        CarrierType = 44
-	CarrierType_CARRIER_TYPE_CDSINGLE                     CarrierType = 45
-	CarrierType_CARRIER_TYPE_CDSINGLE3INCH                CarrierType = 46
-	CarrierType_CARRIER_TYPE_CDSINGLE5INCH                CarrierType = 47
-	CarrierType_CARRIER_TYPE_CDVIDEO5LPNTSC               CarrierType = 48
-	CarrierType_CARRIER_TYPE_CDVIDEO5LPPAL                CarrierType = 49
-	CarrierType_CARRIER_TYPE_CDVIDEOAUDIOCOMPATIBLE       CarrierType = 50
-	CarrierType_CARRIER_TYPE_COMBIPACK                    CarrierType = 51
-	CarrierType_CARRIER_TYPE_DCC                          CarrierType = 52
-	CarrierType_CARRIER_TYPE_DCCCOMPILATION               CarrierType = 53
-	CarrierType_CARRIER_TYPE_DUALDISC                     CarrierType = 54
-	CarrierType_CARRIER_TYPE_DVD                          CarrierType = 55
-	CarrierType_CARRIER_TYPE_DVDAUDIO                     CarrierType = 56
-	CarrierType_CARRIER_TYPE_DVDAUDIO5MAXISINGLE          CarrierType = 57
-	CarrierType_CARRIER_TYPE_DVDAUDIOLP                   CarrierType = 58
-	CarrierType_CARRIER_TYPE_DVDAUDIOSINGLE               CarrierType = 59
-	CarrierType_CARRIER_TYPE_DVDROM                       CarrierType = 60
-	CarrierType_CARRIER_TYPE_DVDSINGLE                    CarrierType = 61
-	CarrierType_CARRIER_TYPE_DVDVIDEO                     CarrierType = 62
-	CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLENTSC      CarrierType = 63
-	CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLEPAL       CarrierType = 64
-	CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLENTSC          CarrierType = 65
-	CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLEPAL           CarrierType = 66
-	CarrierType_CARRIER_TYPE_DVDVIDEOLPNTSC               CarrierType = 67
-	CarrierType_CARRIER_TYPE_DVDVIDEOLPPAL                CarrierType = 68
+	CarrierType_CARRIER_TYPE_UNSPECIFIED CarrierType = 0
+	// @xml: "12InchDiscoSingleRemix"
+	CarrierType_CARRIER_TYPE_E_12INCHDISCOSINGLEREMIX CarrierType = 1
+	// @xml: "33rpm10InchLP"
+	CarrierType_CARRIER_TYPE_E_33RPM10INCHLP CarrierType = 2
+	// @xml: "33rpm10InchSingle"
+	CarrierType_CARRIER_TYPE_E_33RPM10INCHSINGLE CarrierType = 3
+	// @xml: "33rpm12InchLP"
+	CarrierType_CARRIER_TYPE_E_33RPM12INCHLP CarrierType = 4
+	// @xml: "33rpm12InchLp20Tracks"
+	CarrierType_CARRIER_TYPE_E_33RPM12INCHLP20TRACKS CarrierType = 5
+	// @xml: "33rpm12InchMaxiSingle"
+	CarrierType_CARRIER_TYPE_E_33RPM12INCHMAXISINGLE CarrierType = 6
+	// @xml: "33rpm12InchSingle"
+	CarrierType_CARRIER_TYPE_E_33RPM12INCHSINGLE CarrierType = 7
+	// @xml: "33rpm7InchLP"
+	CarrierType_CARRIER_TYPE_E_33RPM7INCHLP CarrierType = 8
+	// @xml: "33rpm7InchSingle"
+	CarrierType_CARRIER_TYPE_E_33RPM7INCHSINGLE CarrierType = 9
+	// @xml: "45rpm10InchLP"
+	CarrierType_CARRIER_TYPE_E_45RPM10INCHLP CarrierType = 10
+	// @xml: "45rpm10InchMaxiSingle"
+	CarrierType_CARRIER_TYPE_E_45RPM10INCHMAXISINGLE CarrierType = 11
+	// @xml: "45rpm10InchSingle"
+	CarrierType_CARRIER_TYPE_E_45RPM10INCHSINGLE CarrierType = 12
+	// @xml: "45rpm12InchLP"
+	CarrierType_CARRIER_TYPE_E_45RPM12INCHLP CarrierType = 13
+	// @xml: "45rpm12InchMaxiSingle"
+	CarrierType_CARRIER_TYPE_E_45RPM12INCHMAXISINGLE CarrierType = 14
+	// @xml: "45rpm12InchSingle"
+	CarrierType_CARRIER_TYPE_E_45RPM12INCHSINGLE CarrierType = 15
+	// @xml: "45rpm7InchEP"
+	CarrierType_CARRIER_TYPE_E_45RPM7INCHEP CarrierType = 16
+	// @xml: "45rpm7InchSingle"
+	CarrierType_CARRIER_TYPE_E_45RPM7INCHSINGLE CarrierType = 17
+	// @xml: "7InchMaxiSingleRemix"
+	CarrierType_CARRIER_TYPE_E_7INCHMAXISINGLEREMIX CarrierType = 18
+	// @xml: "BluRay"
+	CarrierType_CARRIER_TYPE_BLURAY CarrierType = 19
+	// @xml: "CD"
+	CarrierType_CARRIER_TYPE_CD CarrierType = 20
+	// @xml: "CdCompilation"
+	CarrierType_CARRIER_TYPE_CDCOMPILATION CarrierType = 21
+	// @xml: "CdEp"
+	CarrierType_CARRIER_TYPE_CDEP CarrierType = 22
+	// @xml: "CdEpEnhanced"
+	CarrierType_CARRIER_TYPE_CDEPENHANCED CarrierType = 23
+	// @xml: "CdExtraCompilation"
+	CarrierType_CARRIER_TYPE_CDEXTRACOMPILATION CarrierType = 24
+	// @xml: "CdExtraEP"
+	CarrierType_CARRIER_TYPE_CDEXTRAEP CarrierType = 25
+	// @xml: "CdExtraLP"
+	CarrierType_CARRIER_TYPE_CDEXTRALP CarrierType = 26
+	// @xml: "CdExtraMaxiRemix"
+	CarrierType_CARRIER_TYPE_CDEXTRAMAXIREMIX CarrierType = 27
+	// @xml: "CdExtraMaxiSingle"
+	CarrierType_CARRIER_TYPE_CDEXTRAMAXISINGLE CarrierType = 28
+	// @xml: "CdExtraSingle"
+	CarrierType_CARRIER_TYPE_CDEXTRASINGLE CarrierType = 29
+	// @xml: "CdExtraSingle2Tracks"
+	CarrierType_CARRIER_TYPE_CDEXTRASINGLE2TRACKS CarrierType = 30
+	// @xml: "CdLp"
+	CarrierType_CARRIER_TYPE_CDLP CarrierType = 31
+	// @xml: "CdLp5Inch"
+	CarrierType_CARRIER_TYPE_CDLP5INCH CarrierType = 32
+	// @xml: "CdLpEnhanced"
+	CarrierType_CARRIER_TYPE_CDLPENHANCED CarrierType = 33
+	// @xml: "CdLpPlusCdVideo"
+	CarrierType_CARRIER_TYPE_CDLPPLUSCDVIDEO CarrierType = 34
+	// @xml: "CdLpPlusDvdAudio"
+	CarrierType_CARRIER_TYPE_CDLPPLUSDVDAUDIO CarrierType = 35
+	// @xml: "CdLpPlusDvdVideo"
+	CarrierType_CARRIER_TYPE_CDLPPLUSDVDVIDEO CarrierType = 36
+	// @xml: "CdLpPlusWeb"
+	CarrierType_CARRIER_TYPE_CDLPPLUSWEB CarrierType = 37
+	// @xml: "CdMaxiSingle"
+	CarrierType_CARRIER_TYPE_CDMAXISINGLE CarrierType = 38
+	// @xml: "CdMaxiSingle3Inch"
+	CarrierType_CARRIER_TYPE_CDMAXISINGLE3INCH CarrierType = 39
+	// @xml: "CdMaxiSingleEnhanced"
+	CarrierType_CARRIER_TYPE_CDMAXISINGLEENHANCED CarrierType = 40
+	// @xml: "CdMaxiSingleRemix"
+	CarrierType_CARRIER_TYPE_CDMAXISINGLEREMIX CarrierType = 41
+	// @xml: "CdPlusCdBonus"
+	CarrierType_CARRIER_TYPE_CDPLUSCDBONUS CarrierType = 42
+	// @xml: "CdPlusDvdBonus"
+	CarrierType_CARRIER_TYPE_CDPLUSDVDBONUS CarrierType = 43
+	// @xml: "CdRom"
+	CarrierType_CARRIER_TYPE_CDROM CarrierType = 44
+	// @xml: "CdSingle"
+	CarrierType_CARRIER_TYPE_CDSINGLE CarrierType = 45
+	// @xml: "CdSingle3Inch"
+	CarrierType_CARRIER_TYPE_CDSINGLE3INCH CarrierType = 46
+	// @xml: "CdSingle5Inch"
+	CarrierType_CARRIER_TYPE_CDSINGLE5INCH CarrierType = 47
+	// @xml: "CdVideo5LpNTSC"
+	CarrierType_CARRIER_TYPE_CDVIDEO5LPNTSC CarrierType = 48
+	// @xml: "CdVideo5LpPAL"
+	CarrierType_CARRIER_TYPE_CDVIDEO5LPPAL CarrierType = 49
+	// @xml: "CdVideoAudioCompatible"
+	CarrierType_CARRIER_TYPE_CDVIDEOAUDIOCOMPATIBLE CarrierType = 50
+	// @xml: "CombiPack"
+	CarrierType_CARRIER_TYPE_COMBIPACK CarrierType = 51
+	// @xml: "DCC"
+	CarrierType_CARRIER_TYPE_DCC CarrierType = 52
+	// @xml: "DccCompilation"
+	CarrierType_CARRIER_TYPE_DCCCOMPILATION CarrierType = 53
+	// @xml: "DualDisc"
+	CarrierType_CARRIER_TYPE_DUALDISC CarrierType = 54
+	// @xml: "DVD"
+	CarrierType_CARRIER_TYPE_DVD CarrierType = 55
+	// @xml: "DvdAudio"
+	CarrierType_CARRIER_TYPE_DVDAUDIO CarrierType = 56
+	// @xml: "DvdAudio5MaxiSingle"
+	CarrierType_CARRIER_TYPE_DVDAUDIO5MAXISINGLE CarrierType = 57
+	// @xml: "DvdAudioLP"
+	CarrierType_CARRIER_TYPE_DVDAUDIOLP CarrierType = 58
+	// @xml: "DvdAudioSingle"
+	CarrierType_CARRIER_TYPE_DVDAUDIOSINGLE CarrierType = 59
+	// @xml: "DvdRom"
+	CarrierType_CARRIER_TYPE_DVDROM CarrierType = 60
+	// @xml: "DvdSingle"
+	CarrierType_CARRIER_TYPE_DVDSINGLE CarrierType = 61
+	// @xml: "DvdVideo"
+	CarrierType_CARRIER_TYPE_DVDVIDEO CarrierType = 62
+	// @xml: "DvdVideo5MaxiSingleNTSC"
+	CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLENTSC CarrierType = 63
+	// @xml: "DvdVideo5MaxiSinglePAL"
+	CarrierType_CARRIER_TYPE_DVDVIDEO5MAXISINGLEPAL CarrierType = 64
+	// @xml: "DvdVideo5SingleNTSC"
+	CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLENTSC CarrierType = 65
+	// @xml: "DvdVideo5SinglePAL"
+	CarrierType_CARRIER_TYPE_DVDVIDEO5SINGLEPAL CarrierType = 66
+	// @xml: "DvdVideoLpNTSC"
+	CarrierType_CARRIER_TYPE_DVDVIDEOLPNTSC CarrierType = 67
+	// @xml: "DvdVideoLpPAL"
+	CarrierType_CARRIER_TYPE_DVDVIDEOLPPAL CarrierType = 68
+	// @xml: "DvdVideoLpPlusCdLpOrCdSingle"
 	CarrierType_CARRIER_TYPE_DVDVIDEOLPPLUSCDLPORCDSINGLE CarrierType = 69
-	CarrierType_CARRIER_TYPE_FANPACK                      CarrierType = 70
-	CarrierType_CARRIER_TYPE_HDDVDVIDEOLP                 CarrierType = 71
-	CarrierType_CARRIER_TYPE_LASERDISCLP12INCHNTSC        CarrierType = 72
-	CarrierType_CARRIER_TYPE_LPCOMPIDENTICALTOCDCOMP      CarrierType = 73
-	CarrierType_CARRIER_TYPE_LPCOMPILATION                CarrierType = 74
-	CarrierType_CARRIER_TYPE_LPIDENTICALTOCD              CarrierType = 75
-	CarrierType_CARRIER_TYPE_MC                           CarrierType = 76
-	CarrierType_CARRIER_TYPE_MCCOMPIDENTICALTOCDCOMP      CarrierType = 77
-	CarrierType_CARRIER_TYPE_MCCOMPILATION                CarrierType = 78
-	CarrierType_CARRIER_TYPE_MCDOUBLELP                   CarrierType = 79
-	CarrierType_CARRIER_TYPE_MCEP                         CarrierType = 80
-	CarrierType_CARRIER_TYPE_MCIDENTICALTOCD              CarrierType = 81
-	CarrierType_CARRIER_TYPE_MCLP                         CarrierType = 82
-	CarrierType_CARRIER_TYPE_MCMAXISINGLE                 CarrierType = 83
-	CarrierType_CARRIER_TYPE_MCREMIX                      CarrierType = 84
-	CarrierType_CARRIER_TYPE_MCSINGLE                     CarrierType = 85
-	CarrierType_CARRIER_TYPE_MCSINGLEIDENTICALTOCDS       CarrierType = 86
-	CarrierType_CARRIER_TYPE_MEMORYDEVICEAUDIOLP          CarrierType = 87
-	CarrierType_CARRIER_TYPE_MEMORYDEVICEMIXLP            CarrierType = 88
-	CarrierType_CARRIER_TYPE_MEMORYDEVICEVIDEOLP          CarrierType = 89
-	CarrierType_CARRIER_TYPE_MERCHANDISE                  CarrierType = 90
-	CarrierType_CARRIER_TYPE_MINIDISC                     CarrierType = 91
-	CarrierType_CARRIER_TYPE_MINIDISCCOMPILATION          CarrierType = 92
-	CarrierType_CARRIER_TYPE_MINIDISCEP                   CarrierType = 93
-	CarrierType_CARRIER_TYPE_MINIDISCMAXIREMIX            CarrierType = 94
-	CarrierType_CARRIER_TYPE_MINIDISCSINGLEMAXISINGLE     CarrierType = 95
-	CarrierType_CARRIER_TYPE_PREPAIDCARD                  CarrierType = 96
-	CarrierType_CARRIER_TYPE_SACD                         CarrierType = 97
-	CarrierType_CARRIER_TYPE_SACDCOMPILATION              CarrierType = 98
-	CarrierType_CARRIER_TYPE_SACDLPSTEREO                 CarrierType = 99
-	CarrierType_CARRIER_TYPE_SACDLPSTEREOCDAUDIO          CarrierType = 100
-	CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUND         CarrierType = 101
-	CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUNDCDAUDIO  CarrierType = 102
-	CarrierType_CARRIER_TYPE_SACDLPSURROUNDCDAUDIO        CarrierType = 103
-	CarrierType_CARRIER_TYPE_SACDPLUSDVDVIDEO             CarrierType = 104
-	CarrierType_CARRIER_TYPE_USERDEFINED                  CarrierType = 105
-	CarrierType_CARRIER_TYPE_VHSNTSC                      CarrierType = 106
-	CarrierType_CARRIER_TYPE_VHSPAL                       CarrierType = 107
-	CarrierType_CARRIER_TYPE_VHSPLUSCDLP                  CarrierType = 108
-	CarrierType_CARRIER_TYPE_VHSSECAM                     CarrierType = 109
-	CarrierType_CARRIER_TYPE_FILESYSTEM                   CarrierType = 110
-	CarrierType_CARRIER_TYPE_MEMORYDEVICE                 CarrierType = 111
-	CarrierType_CARRIER_TYPE_ONLINESYSTEM                 CarrierType = 112
+	// @xml: "FanPack"
+	CarrierType_CARRIER_TYPE_FANPACK CarrierType = 70
+	// @xml: "HdDvdVideoLp"
+	CarrierType_CARRIER_TYPE_HDDVDVIDEOLP CarrierType = 71
+	// @xml: "LaserDiscLp12InchNTSC"
+	CarrierType_CARRIER_TYPE_LASERDISCLP12INCHNTSC CarrierType = 72
+	// @xml: "LpCompIdenticalToCdComp"
+	CarrierType_CARRIER_TYPE_LPCOMPIDENTICALTOCDCOMP CarrierType = 73
+	// @xml: "LpCompilation"
+	CarrierType_CARRIER_TYPE_LPCOMPILATION CarrierType = 74
+	// @xml: "LpIdenticalToCD"
+	CarrierType_CARRIER_TYPE_LPIDENTICALTOCD CarrierType = 75
+	// @xml: "MC"
+	CarrierType_CARRIER_TYPE_MC CarrierType = 76
+	// @xml: "McCompIdenticalToCdComp"
+	CarrierType_CARRIER_TYPE_MCCOMPIDENTICALTOCDCOMP CarrierType = 77
+	// @xml: "McCompilation"
+	CarrierType_CARRIER_TYPE_MCCOMPILATION CarrierType = 78
+	// @xml: "McDoubleLP"
+	CarrierType_CARRIER_TYPE_MCDOUBLELP CarrierType = 79
+	// @xml: "McEP"
+	CarrierType_CARRIER_TYPE_MCEP CarrierType = 80
+	// @xml: "McIdenticalToCD"
+	CarrierType_CARRIER_TYPE_MCIDENTICALTOCD CarrierType = 81
+	// @xml: "McLP"
+	CarrierType_CARRIER_TYPE_MCLP CarrierType = 82
+	// @xml: "McMaxiSingle"
+	CarrierType_CARRIER_TYPE_MCMAXISINGLE CarrierType = 83
+	// @xml: "McRemix"
+	CarrierType_CARRIER_TYPE_MCREMIX CarrierType = 84
+	// @xml: "McSingle"
+	CarrierType_CARRIER_TYPE_MCSINGLE CarrierType = 85
+	// @xml: "McSingleIdenticalToCDS"
+	CarrierType_CARRIER_TYPE_MCSINGLEIDENTICALTOCDS CarrierType = 86
+	// @xml: "MemoryDeviceAudioLP"
+	CarrierType_CARRIER_TYPE_MEMORYDEVICEAUDIOLP CarrierType = 87
+	// @xml: "MemoryDeviceMixLP"
+	CarrierType_CARRIER_TYPE_MEMORYDEVICEMIXLP CarrierType = 88
+	// @xml: "MemoryDeviceVideoLP"
+	CarrierType_CARRIER_TYPE_MEMORYDEVICEVIDEOLP CarrierType = 89
+	// @xml: "Merchandise"
+	CarrierType_CARRIER_TYPE_MERCHANDISE CarrierType = 90
+	// @xml: "MiniDisc"
+	CarrierType_CARRIER_TYPE_MINIDISC CarrierType = 91
+	// @xml: "MiniDiscCompilation"
+	CarrierType_CARRIER_TYPE_MINIDISCCOMPILATION CarrierType = 92
+	// @xml: "MiniDiscEP"
+	CarrierType_CARRIER_TYPE_MINIDISCEP CarrierType = 93
+	// @xml: "MiniDiscMaxiRemix"
+	CarrierType_CARRIER_TYPE_MINIDISCMAXIREMIX CarrierType = 94
+	// @xml: "MiniDiscSingleMaxiSingle"
+	CarrierType_CARRIER_TYPE_MINIDISCSINGLEMAXISINGLE CarrierType = 95
+	// @xml: "PrePaidCard"
+	CarrierType_CARRIER_TYPE_PREPAIDCARD CarrierType = 96
+	// @xml: "SACD"
+	CarrierType_CARRIER_TYPE_SACD CarrierType = 97
+	// @xml: "SacdCompilation"
+	CarrierType_CARRIER_TYPE_SACDCOMPILATION CarrierType = 98
+	// @xml: "SacdLpStereo"
+	CarrierType_CARRIER_TYPE_SACDLPSTEREO CarrierType = 99
+	// @xml: "SacdLpStereoCdAudio"
+	CarrierType_CARRIER_TYPE_SACDLPSTEREOCDAUDIO CarrierType = 100
+	// @xml: "SacdLpStereoSurround"
+	CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUND CarrierType = 101
+	// @xml: "SacdLpStereoSurroundCdAudio"
+	CarrierType_CARRIER_TYPE_SACDLPSTEREOSURROUNDCDAUDIO CarrierType = 102
+	// @xml: "SacdLpSurroundCdAudio"
+	CarrierType_CARRIER_TYPE_SACDLPSURROUNDCDAUDIO CarrierType = 103
+	// @xml: "SacdPlusDvdVideo"
+	CarrierType_CARRIER_TYPE_SACDPLUSDVDVIDEO CarrierType = 104
+	// @xml: "UserDefined"
+	CarrierType_CARRIER_TYPE_USERDEFINED CarrierType = 105
+	// @xml: "VhsNTSC"
+	CarrierType_CARRIER_TYPE_VHSNTSC CarrierType = 106
+	// @xml: "VhsPAL"
+	CarrierType_CARRIER_TYPE_VHSPAL CarrierType = 107
+	// @xml: "VhsPlusCdLp"
+	CarrierType_CARRIER_TYPE_VHSPLUSCDLP CarrierType = 108
+	// @xml: "VhsSECAM"
+	CarrierType_CARRIER_TYPE_VHSSECAM CarrierType = 109
+	// @xml: "FileSystem"
+	CarrierType_CARRIER_TYPE_FILESYSTEM CarrierType = 110
+	// @xml: "MemoryDevice"
+	CarrierType_CARRIER_TYPE_MEMORYDEVICE CarrierType = 111
+	// @xml: "OnlineSystem"
+	CarrierType_CARRIER_TYPE_ONLINESYSTEM CarrierType = 112
 )
 
 // Enum value maps for CarrierType.
@@ -2594,15 +3339,23 @@ func (CarrierType) EnumDescriptor() ([]byte, []int) {
 type CdProtectionType int32
 
 const (
-	CdProtectionType_CD_PROTECTION_TYPE_UNSPECIFIED  CdProtectionType = 0
-	CdProtectionType_CD_PROTECTION_TYPE_CDS100       CdProtectionType = 1
-	CdProtectionType_CD_PROTECTION_TYPE_CDS200       CdProtectionType = 2
-	CdProtectionType_CD_PROTECTION_TYPE_CDS300       CdProtectionType = 3
-	CdProtectionType_CD_PROTECTION_TYPE_KEY2AUDIO    CdProtectionType = 4
-	CdProtectionType_CD_PROTECTION_TYPE_MEDIAMAXCD3  CdProtectionType = 5
+	CdProtectionType_CD_PROTECTION_TYPE_UNSPECIFIED CdProtectionType = 0
+	// @xml: "CDS100"
+	CdProtectionType_CD_PROTECTION_TYPE_CDS100 CdProtectionType = 1
+	// @xml: "CDS200"
+	CdProtectionType_CD_PROTECTION_TYPE_CDS200 CdProtectionType = 2
+	// @xml: "CDS300"
+	CdProtectionType_CD_PROTECTION_TYPE_CDS300 CdProtectionType = 3
+	// @xml: "Key2Audio"
+	CdProtectionType_CD_PROTECTION_TYPE_KEY2AUDIO CdProtectionType = 4
+	// @xml: "MediaMaxCD3"
+	CdProtectionType_CD_PROTECTION_TYPE_MEDIAMAXCD3 CdProtectionType = 5
+	// @xml: "NotProtected"
 	CdProtectionType_CD_PROTECTION_TYPE_NOTPROTECTED CdProtectionType = 6
-	CdProtectionType_CD_PROTECTION_TYPE_UNKNOWN      CdProtectionType = 7
-	CdProtectionType_CD_PROTECTION_TYPE_USERDEFINED  CdProtectionType = 8
+	// @xml: "Unknown"
+	CdProtectionType_CD_PROTECTION_TYPE_UNKNOWN CdProtectionType = 7
+	// @xml: "UserDefined"
+	CdProtectionType_CD_PROTECTION_TYPE_USERDEFINED CdProtectionType = 8
 )
 
 // Enum value maps for CdProtectionType.
@@ -2661,9 +3414,12 @@ func (CdProtectionType) EnumDescriptor() ([]byte, []int) {
 type CharacterType int32
 
 const (
-	CharacterType_CHARACTER_TYPE_UNSPECIFIED         CharacterType = 0
-	CharacterType_CHARACTER_TYPE_MAINCHARACTER       CharacterType = 1
-	CharacterType_CHARACTER_TYPE_OTHERCHARACTER      CharacterType = 2
+	CharacterType_CHARACTER_TYPE_UNSPECIFIED CharacterType = 0
+	// @xml: "MainCharacter"
+	CharacterType_CHARACTER_TYPE_MAINCHARACTER CharacterType = 1
+	// @xml: "OtherCharacter"
+	CharacterType_CHARACTER_TYPE_OTHERCHARACTER CharacterType = 2
+	// @xml: "SupportingCharacter"
 	CharacterType_CHARACTER_TYPE_SUPPORTINGCHARACTER CharacterType = 3
 )
 
@@ -2714,8 +3470,10 @@ type CodingType int32
 
 const (
 	CodingType_CODING_TYPE_UNSPECIFIED CodingType = 0
-	CodingType_CODING_TYPE_LOSSLESS    CodingType = 1
-	CodingType_CODING_TYPE_LOSSY       CodingType = 2
+	// @xml: "Lossless"
+	CodingType_CODING_TYPE_LOSSLESS CodingType = 1
+	// @xml: "Lossy"
+	CodingType_CODING_TYPE_LOSSY CodingType = 2
 )
 
 // Enum value maps for CodingType.
@@ -2762,15 +3520,23 @@ func (CodingType) EnumDescriptor() ([]byte, []int) {
 type CollectionType int32
 
 const (
-	CollectionType_COLLECTION_TYPE_UNSPECIFIED      CollectionType = 0
-	CollectionType_COLLECTION_TYPE_AUDIOCHAPTER     CollectionType = 1
-	CollectionType_COLLECTION_TYPE_EPISODE          CollectionType = 2
-	CollectionType_COLLECTION_TYPE_FILMBUNDLE       CollectionType = 3
-	CollectionType_COLLECTION_TYPE_MEDLEYSEGMENT    CollectionType = 4
+	CollectionType_COLLECTION_TYPE_UNSPECIFIED CollectionType = 0
+	// @xml: "AudioChapter"
+	CollectionType_COLLECTION_TYPE_AUDIOCHAPTER CollectionType = 1
+	// @xml: "Episode"
+	CollectionType_COLLECTION_TYPE_EPISODE CollectionType = 2
+	// @xml: "FilmBundle"
+	CollectionType_COLLECTION_TYPE_FILMBUNDLE CollectionType = 3
+	// @xml: "MedleySegment"
+	CollectionType_COLLECTION_TYPE_MEDLEYSEGMENT CollectionType = 4
+	// @xml: "PotpourriSegment"
 	CollectionType_COLLECTION_TYPE_POTPOURRISEGMENT CollectionType = 5
-	CollectionType_COLLECTION_TYPE_SEASON           CollectionType = 6
-	CollectionType_COLLECTION_TYPE_SERIES           CollectionType = 7
-	CollectionType_COLLECTION_TYPE_VIDEOCHAPTER     CollectionType = 8
+	// @xml: "Season"
+	CollectionType_COLLECTION_TYPE_SEASON CollectionType = 6
+	// @xml: "Series"
+	CollectionType_COLLECTION_TYPE_SERIES CollectionType = 7
+	// @xml: "VideoChapter"
+	CollectionType_COLLECTION_TYPE_VIDEOCHAPTER CollectionType = 8
 )
 
 // Enum value maps for CollectionType.
@@ -2829,17 +3595,27 @@ func (CollectionType) EnumDescriptor() ([]byte, []int) {
 type CommercialModelType int32
 
 const (
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_UNSPECIFIED                 CommercialModelType = 0
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_UNSPECIFIED CommercialModelType = 0
+	// @xml: "AdvertisementSupportedModel"
 	CommercialModelType_COMMERCIAL_MODEL_TYPE_ADVERTISEMENTSUPPORTEDMODEL CommercialModelType = 1
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_ASPERCONTRACT               CommercialModelType = 2
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_DEVICEFEEMODEL              CommercialModelType = 3
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_FREEOFCHARGEMODEL           CommercialModelType = 4
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_PAYASYOUGOMODEL             CommercialModelType = 5
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_PERFORMANCEROYALTIESMODEL   CommercialModelType = 6
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_RIGHTSCLAIMMODEL            CommercialModelType = 7
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_SUBSCRIPTIONMODEL           CommercialModelType = 8
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_UNKNOWN                     CommercialModelType = 9
-	CommercialModelType_COMMERCIAL_MODEL_TYPE_USERDEFINED                 CommercialModelType = 10
+	// @xml: "AsPerContract"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_ASPERCONTRACT CommercialModelType = 2
+	// @xml: "DeviceFeeModel"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_DEVICEFEEMODEL CommercialModelType = 3
+	// @xml: "FreeOfChargeModel"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_FREEOFCHARGEMODEL CommercialModelType = 4
+	// @xml: "PayAsYouGoModel"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_PAYASYOUGOMODEL CommercialModelType = 5
+	// @xml: "PerformanceRoyaltiesModel"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_PERFORMANCEROYALTIESMODEL CommercialModelType = 6
+	// @xml: "RightsClaimModel"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_RIGHTSCLAIMMODEL CommercialModelType = 7
+	// @xml: "SubscriptionModel"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_SUBSCRIPTIONMODEL CommercialModelType = 8
+	// @xml: "Unknown"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_UNKNOWN CommercialModelType = 9
+	// @xml: "UserDefined"
+	CommercialModelType_COMMERCIAL_MODEL_TYPE_USERDEFINED CommercialModelType = 10
 )
 
 // Enum value maps for CommercialModelType.
@@ -2902,10 +3678,13 @@ func (CommercialModelType) EnumDescriptor() ([]byte, []int) {
 type CompilationType int32
 
 const (
-	CompilationType_COMPILATION_TYPE_UNSPECIFIED            CompilationType = 0
-	CompilationType_COMPILATION_TYPE_INTERNALCOMPILATION    CompilationType = 1
+	CompilationType_COMPILATION_TYPE_UNSPECIFIED CompilationType = 0
+	// @xml: "InternalCompilation"
+	CompilationType_COMPILATION_TYPE_INTERNALCOMPILATION CompilationType = 1
+	// @xml: "NonInternalCompilation"
 	CompilationType_COMPILATION_TYPE_NONINTERNALCOMPILATION CompilationType = 2
-	CompilationType_COMPILATION_TYPE_NOTCOMPILED            CompilationType = 3
+	// @xml: "NotCompiled"
+	CompilationType_COMPILATION_TYPE_NOTCOMPILED CompilationType = 3
 )
 
 // Enum value maps for CompilationType.
@@ -2955,15 +3734,24 @@ type ContainerFormat int32
 
 const (
 	ContainerFormat_CONTAINER_FORMAT_UNSPECIFIED ContainerFormat = 0
-	ContainerFormat_CONTAINER_FORMAT_AIFF        ContainerFormat = 1
-	ContainerFormat_CONTAINER_FORMAT_AVI         ContainerFormat = 2
-	ContainerFormat_CONTAINER_FORMAT_MP4         ContainerFormat = 3
-	ContainerFormat_CONTAINER_FORMAT_OGG         ContainerFormat = 4
-	ContainerFormat_CONTAINER_FORMAT_QUICKTIME   ContainerFormat = 5
-	ContainerFormat_CONTAINER_FORMAT_REALMEDIA   ContainerFormat = 6
-	ContainerFormat_CONTAINER_FORMAT_RMF         ContainerFormat = 7
+	// @xml: "AIFF"
+	ContainerFormat_CONTAINER_FORMAT_AIFF ContainerFormat = 1
+	// @xml: "AVI"
+	ContainerFormat_CONTAINER_FORMAT_AVI ContainerFormat = 2
+	// @xml: "MP4"
+	ContainerFormat_CONTAINER_FORMAT_MP4 ContainerFormat = 3
+	// @xml: "Ogg"
+	ContainerFormat_CONTAINER_FORMAT_OGG ContainerFormat = 4
+	// @xml: "QuickTime"
+	ContainerFormat_CONTAINER_FORMAT_QUICKTIME ContainerFormat = 5
+	// @xml: "RealMedia"
+	ContainerFormat_CONTAINER_FORMAT_REALMEDIA ContainerFormat = 6
+	// @xml: "RMF"
+	ContainerFormat_CONTAINER_FORMAT_RMF ContainerFormat = 7
+	// @xml: "UserDefined"
 	ContainerFormat_CONTAINER_FORMAT_USERDEFINED ContainerFormat = 8
-	ContainerFormat_CONTAINER_FORMAT_WAV         ContainerFormat = 9
+	// @xml: "WAV"
+	ContainerFormat_CONTAINER_FORMAT_WAV ContainerFormat = 9
 )
 
 // Enum value maps for ContainerFormat.
@@ -3025,9 +3813,12 @@ type CreationType int32
 
 const (
 	CreationType_CREATION_TYPE_UNSPECIFIED CreationType = 0
+	// @xml: "MusicalWork"
 	CreationType_CREATION_TYPE_MUSICALWORK CreationType = 1
-	CreationType_CREATION_TYPE_RELEASE     CreationType = 2
-	CreationType_CREATION_TYPE_RESOURCE    CreationType = 3
+	// @xml: "Release"
+	CreationType_CREATION_TYPE_RELEASE CreationType = 2
+	// @xml: "Resource"
+	CreationType_CREATION_TYPE_RESOURCE CreationType = 3
 )
 
 // Enum value maps for CreationType.
@@ -3076,19 +3867,31 @@ func (CreationType) EnumDescriptor() ([]byte, []int) {
 type CreativeContributorRole int32
 
 const (
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_UNSPECIFIED         CreativeContributorRole = 0
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ADAPTER             CreativeContributorRole = 1
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ARRANGER            CreativeContributorRole = 2
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_UNSPECIFIED CreativeContributorRole = 0
+	// @xml: "Adapter"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ADAPTER CreativeContributorRole = 1
+	// @xml: "Arranger"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ARRANGER CreativeContributorRole = 2
+	// @xml: "AssociatedPerformer"
 	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER CreativeContributorRole = 3
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_AUTHOR              CreativeContributorRole = 4
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSER            CreativeContributorRole = 5
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSERLYRICIST    CreativeContributorRole = 6
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LIBRETTIST          CreativeContributorRole = 7
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LYRICIST            CreativeContributorRole = 8
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_NONLYRICAUTHOR      CreativeContributorRole = 9
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBARRANGER         CreativeContributorRole = 10
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBLYRICIST         CreativeContributorRole = 11
-	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_TRANSLATOR          CreativeContributorRole = 12
+	// @xml: "Author"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_AUTHOR CreativeContributorRole = 4
+	// @xml: "Composer"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSER CreativeContributorRole = 5
+	// @xml: "ComposerLyricist"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_COMPOSERLYRICIST CreativeContributorRole = 6
+	// @xml: "Librettist"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LIBRETTIST CreativeContributorRole = 7
+	// @xml: "Lyricist"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_LYRICIST CreativeContributorRole = 8
+	// @xml: "NonLyricAuthor"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_NONLYRICAUTHOR CreativeContributorRole = 9
+	// @xml: "SubArranger"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBARRANGER CreativeContributorRole = 10
+	// @xml: "SubLyricist"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_SUBLYRICIST CreativeContributorRole = 11
+	// @xml: "Translator"
+	CreativeContributorRole_CREATIVE_CONTRIBUTOR_ROLE_TRANSLATOR CreativeContributorRole = 12
 )
 
 // Enum value maps for CreativeContributorRole.
@@ -3155,12 +3958,17 @@ func (CreativeContributorRole) EnumDescriptor() ([]byte, []int) {
 type CueOrigin int32
 
 const (
-	CueOrigin_CUE_ORIGIN_UNSPECIFIED                CueOrigin = 0
-	CueOrigin_CUE_ORIGIN_LIBRARYMUSIC               CueOrigin = 1
-	CueOrigin_CUE_ORIGIN_PREEXISTINGMUSIC           CueOrigin = 2
+	CueOrigin_CUE_ORIGIN_UNSPECIFIED CueOrigin = 0
+	// @xml: "LibraryMusic"
+	CueOrigin_CUE_ORIGIN_LIBRARYMUSIC CueOrigin = 1
+	// @xml: "PreexistingMusic"
+	CueOrigin_CUE_ORIGIN_PREEXISTINGMUSIC CueOrigin = 2
+	// @xml: "SpeciallyCommissionedMusic"
 	CueOrigin_CUE_ORIGIN_SPECIALLYCOMMISSIONEDMUSIC CueOrigin = 3
-	CueOrigin_CUE_ORIGIN_UNKNOWN                    CueOrigin = 4
-	CueOrigin_CUE_ORIGIN_USERDEFINED                CueOrigin = 5
+	// @xml: "Unknown"
+	CueOrigin_CUE_ORIGIN_UNKNOWN CueOrigin = 4
+	// @xml: "UserDefined"
+	CueOrigin_CUE_ORIGIN_USERDEFINED CueOrigin = 5
 )
 
 // Enum value maps for CueOrigin.
@@ -3213,12 +4021,17 @@ func (CueOrigin) EnumDescriptor() ([]byte, []int) {
 type CueSheetType int32
 
 const (
-	CueSheetType_CUE_SHEET_TYPE_UNSPECIFIED        CueSheetType = 0
-	CueSheetType_CUE_SHEET_TYPE_AVERAGECUESHEET    CueSheetType = 1
-	CueSheetType_CUE_SHEET_TYPE_COMPOSITECUESHEET  CueSheetType = 2
-	CueSheetType_CUE_SHEET_TYPE_STANDARDCUESHEET   CueSheetType = 3
+	CueSheetType_CUE_SHEET_TYPE_UNSPECIFIED CueSheetType = 0
+	// @xml: "AverageCueSheet"
+	CueSheetType_CUE_SHEET_TYPE_AVERAGECUESHEET CueSheetType = 1
+	// @xml: "CompositeCueSheet"
+	CueSheetType_CUE_SHEET_TYPE_COMPOSITECUESHEET CueSheetType = 2
+	// @xml: "StandardCueSheet"
+	CueSheetType_CUE_SHEET_TYPE_STANDARDCUESHEET CueSheetType = 3
+	// @xml: "SummarisedCueSheet"
 	CueSheetType_CUE_SHEET_TYPE_SUMMARISEDCUESHEET CueSheetType = 4
-	CueSheetType_CUE_SHEET_TYPE_SURROGATECUESHEET  CueSheetType = 5
+	// @xml: "SurrogateCueSheet"
+	CueSheetType_CUE_SHEET_TYPE_SURROGATECUESHEET CueSheetType = 5
 )
 
 // Enum value maps for CueSheetType.
@@ -3271,17 +4084,27 @@ func (CueSheetType) EnumDescriptor() ([]byte, []int) {
 type CueUseType int32
 
 const (
-	CueUseType_CUE_USE_TYPE_UNSPECIFIED                 CueUseType = 0
-	CueUseType_CUE_USE_TYPE_AUDIOLOGO                   CueUseType = 1
-	CueUseType_CUE_USE_TYPE_BACKGROUND                  CueUseType = 2
-	CueUseType_CUE_USE_TYPE_BUMPER                      CueUseType = 3
-	CueUseType_CUE_USE_TYPE_ESSENTIALPART               CueUseType = 4
-	CueUseType_CUE_USE_TYPE_FILMTHEME                   CueUseType = 5
+	CueUseType_CUE_USE_TYPE_UNSPECIFIED CueUseType = 0
+	// @xml: "AudioLogo"
+	CueUseType_CUE_USE_TYPE_AUDIOLOGO CueUseType = 1
+	// @xml: "Background"
+	CueUseType_CUE_USE_TYPE_BACKGROUND CueUseType = 2
+	// @xml: "Bumper"
+	CueUseType_CUE_USE_TYPE_BUMPER CueUseType = 3
+	// @xml: "EssentialPart"
+	CueUseType_CUE_USE_TYPE_ESSENTIALPART CueUseType = 4
+	// @xml: "FilmTheme"
+	CueUseType_CUE_USE_TYPE_FILMTHEME CueUseType = 5
+	// @xml: "IndistinguishableBackground"
 	CueUseType_CUE_USE_TYPE_INDISTINGUISHABLEBACKGROUND CueUseType = 6
-	CueUseType_CUE_USE_TYPE_ONSCREENMUSIC               CueUseType = 7
-	CueUseType_CUE_USE_TYPE_ROLLEDUPCUE                 CueUseType = 8
-	CueUseType_CUE_USE_TYPE_THEME                       CueUseType = 9
-	CueUseType_CUE_USE_TYPE_USERDEFINED                 CueUseType = 10
+	// @xml: "OnScreenMusic"
+	CueUseType_CUE_USE_TYPE_ONSCREENMUSIC CueUseType = 7
+	// @xml: "RolledUpCue"
+	CueUseType_CUE_USE_TYPE_ROLLEDUPCUE CueUseType = 8
+	// @xml: "Theme"
+	CueUseType_CUE_USE_TYPE_THEME CueUseType = 9
+	// @xml: "UserDefined"
+	CueUseType_CUE_USE_TYPE_USERDEFINED CueUseType = 10
 )
 
 // Enum value maps for CueUseType.
@@ -3345,179 +4168,352 @@ type CurrencyCode int32
 
 const (
 	CurrencyCode_CURRENCY_CODE_UNSPECIFIED CurrencyCode = 0
-	CurrencyCode_CURRENCY_CODE_AED         CurrencyCode = 1
-	CurrencyCode_CURRENCY_CODE_AFN         CurrencyCode = 2
-	CurrencyCode_CURRENCY_CODE_ALL         CurrencyCode = 3
-	CurrencyCode_CURRENCY_CODE_AMD         CurrencyCode = 4
-	CurrencyCode_CURRENCY_CODE_ANG         CurrencyCode = 5
-	CurrencyCode_CURRENCY_CODE_AOA         CurrencyCode = 6
-	CurrencyCode_CURRENCY_CODE_ARS         CurrencyCode = 7
-	CurrencyCode_CURRENCY_CODE_AUD         CurrencyCode = 8
-	CurrencyCode_CURRENCY_CODE_AWG         CurrencyCode = 9
-	CurrencyCode_CURRENCY_CODE_AZN         CurrencyCode = 10
-	CurrencyCode_CURRENCY_CODE_BAM         CurrencyCode = 11
-	CurrencyCode_CURRENCY_CODE_BBD         CurrencyCode = 12
-	CurrencyCode_CURRENCY_CODE_BDT         CurrencyCode = 13
-	CurrencyCode_CURRENCY_CODE_BGN         CurrencyCode = 14
-	CurrencyCode_CURRENCY_CODE_BHD         CurrencyCode = 15
-	CurrencyCode_CURRENCY_CODE_BIF         CurrencyCode = 16
-	CurrencyCode_CURRENCY_CODE_BMD         CurrencyCode = 17
-	CurrencyCode_CURRENCY_CODE_BND         CurrencyCode = 18
-	CurrencyCode_CURRENCY_CODE_BOB         CurrencyCode = 19
-	CurrencyCode_CURRENCY_CODE_BOV         CurrencyCode = 20
-	CurrencyCode_CURRENCY_CODE_BRL         CurrencyCode = 21
-	CurrencyCode_CURRENCY_CODE_BSD         CurrencyCode = 22
-	CurrencyCode_CURRENCY_CODE_BTN         CurrencyCode = 23
-	CurrencyCode_CURRENCY_CODE_BWP         CurrencyCode = 24
-	CurrencyCode_CURRENCY_CODE_BYR         CurrencyCode = 25
-	CurrencyCode_CURRENCY_CODE_BZD         CurrencyCode = 26
-	CurrencyCode_CURRENCY_CODE_CAD         CurrencyCode = 27
-	CurrencyCode_CURRENCY_CODE_CDF         CurrencyCode = 28
-	CurrencyCode_CURRENCY_CODE_CHF         CurrencyCode = 29
-	CurrencyCode_CURRENCY_CODE_CLF         CurrencyCode = 30
-	CurrencyCode_CURRENCY_CODE_CLP         CurrencyCode = 31
-	CurrencyCode_CURRENCY_CODE_CNY         CurrencyCode = 32
-	CurrencyCode_CURRENCY_CODE_COP         CurrencyCode = 33
-	CurrencyCode_CURRENCY_CODE_COU         CurrencyCode = 34
-	CurrencyCode_CURRENCY_CODE_CRC         CurrencyCode = 35
-	CurrencyCode_CURRENCY_CODE_CUC         CurrencyCode = 36
-	CurrencyCode_CURRENCY_CODE_CUP         CurrencyCode = 37
-	CurrencyCode_CURRENCY_CODE_CVE         CurrencyCode = 38
-	CurrencyCode_CURRENCY_CODE_CZK         CurrencyCode = 39
-	CurrencyCode_CURRENCY_CODE_DJF         CurrencyCode = 40
-	CurrencyCode_CURRENCY_CODE_DKK         CurrencyCode = 41
-	CurrencyCode_CURRENCY_CODE_DOP         CurrencyCode = 42
-	CurrencyCode_CURRENCY_CODE_DZD         CurrencyCode = 43
-	CurrencyCode_CURRENCY_CODE_EGP         CurrencyCode = 44
-	CurrencyCode_CURRENCY_CODE_ERN         CurrencyCode = 45
-	CurrencyCode_CURRENCY_CODE_ETB         CurrencyCode = 46
-	CurrencyCode_CURRENCY_CODE_EUR         CurrencyCode = 47
-	CurrencyCode_CURRENCY_CODE_FJD         CurrencyCode = 48
-	CurrencyCode_CURRENCY_CODE_FKP         CurrencyCode = 49
-	CurrencyCode_CURRENCY_CODE_GBP         CurrencyCode = 50
-	CurrencyCode_CURRENCY_CODE_GEL         CurrencyCode = 51
-	CurrencyCode_CURRENCY_CODE_GHS         CurrencyCode = 52
-	CurrencyCode_CURRENCY_CODE_GIP         CurrencyCode = 53
-	CurrencyCode_CURRENCY_CODE_GMD         CurrencyCode = 54
-	CurrencyCode_CURRENCY_CODE_GNF         CurrencyCode = 55
-	CurrencyCode_CURRENCY_CODE_GTQ         CurrencyCode = 56
-	CurrencyCode_CURRENCY_CODE_GYD         CurrencyCode = 57
-	CurrencyCode_CURRENCY_CODE_HKD         CurrencyCode = 58
-	CurrencyCode_CURRENCY_CODE_HNL         CurrencyCode = 59
-	CurrencyCode_CURRENCY_CODE_HRK         CurrencyCode = 60
-	CurrencyCode_CURRENCY_CODE_HTG         CurrencyCode = 61
-	CurrencyCode_CURRENCY_CODE_HUF         CurrencyCode = 62
-	CurrencyCode_CURRENCY_CODE_IDR         CurrencyCode = 63
-	CurrencyCode_CURRENCY_CODE_ILS         CurrencyCode = 64
-	CurrencyCode_CURRENCY_CODE_INR         CurrencyCode = 65
-	CurrencyCode_CURRENCY_CODE_IQD         CurrencyCode = 66
-	CurrencyCode_CURRENCY_CODE_IRR         CurrencyCode = 67
-	CurrencyCode_CURRENCY_CODE_ISK         CurrencyCode = 68
-	CurrencyCode_CURRENCY_CODE_JMD         CurrencyCode = 69
-	CurrencyCode_CURRENCY_CODE_JOD         CurrencyCode = 70
-	CurrencyCode_CURRENCY_CODE_JPY         CurrencyCode = 71
-	CurrencyCode_CURRENCY_CODE_KES         CurrencyCode = 72
-	CurrencyCode_CURRENCY_CODE_KGS         CurrencyCode = 73
-	CurrencyCode_CURRENCY_CODE_KHR         CurrencyCode = 74
-	CurrencyCode_CURRENCY_CODE_KMF         CurrencyCode = 75
-	CurrencyCode_CURRENCY_CODE_KPW         CurrencyCode = 76
-	CurrencyCode_CURRENCY_CODE_KRW         CurrencyCode = 77
-	CurrencyCode_CURRENCY_CODE_KWD         CurrencyCode = 78
-	CurrencyCode_CURRENCY_CODE_KYD         CurrencyCode = 79
-	CurrencyCode_CURRENCY_CODE_KZT         CurrencyCode = 80
-	CurrencyCode_CURRENCY_CODE_LAK         CurrencyCode = 81
-	CurrencyCode_CURRENCY_CODE_LBP         CurrencyCode = 82
-	CurrencyCode_CURRENCY_CODE_LKR         CurrencyCode = 83
-	CurrencyCode_CURRENCY_CODE_LRD         CurrencyCode = 84
-	CurrencyCode_CURRENCY_CODE_LSL         CurrencyCode = 85
-	CurrencyCode_CURRENCY_CODE_LYD         CurrencyCode = 86
-	CurrencyCode_CURRENCY_CODE_MAD         CurrencyCode = 87
-	CurrencyCode_CURRENCY_CODE_MDL         CurrencyCode = 88
-	CurrencyCode_CURRENCY_CODE_MGA         CurrencyCode = 89
-	CurrencyCode_CURRENCY_CODE_MKD         CurrencyCode = 90
-	CurrencyCode_CURRENCY_CODE_MMK         CurrencyCode = 91
-	CurrencyCode_CURRENCY_CODE_MNT         CurrencyCode = 92
-	CurrencyCode_CURRENCY_CODE_MOP         CurrencyCode = 93
-	CurrencyCode_CURRENCY_CODE_MRU         CurrencyCode = 94
-	CurrencyCode_CURRENCY_CODE_MUR         CurrencyCode = 95
-	CurrencyCode_CURRENCY_CODE_MVR         CurrencyCode = 96
-	CurrencyCode_CURRENCY_CODE_MWK         CurrencyCode = 97
-	CurrencyCode_CURRENCY_CODE_MXN         CurrencyCode = 98
-	CurrencyCode_CURRENCY_CODE_MXV         CurrencyCode = 99
-	CurrencyCode_CURRENCY_CODE_MYR         CurrencyCode = 100
-	CurrencyCode_CURRENCY_CODE_MZN         CurrencyCode = 101
-	CurrencyCode_CURRENCY_CODE_NAD         CurrencyCode = 102
-	CurrencyCode_CURRENCY_CODE_NGN         CurrencyCode = 103
-	CurrencyCode_CURRENCY_CODE_NIO         CurrencyCode = 104
-	CurrencyCode_CURRENCY_CODE_NOK         CurrencyCode = 105
-	CurrencyCode_CURRENCY_CODE_NPR         CurrencyCode = 106
-	CurrencyCode_CURRENCY_CODE_NZD         CurrencyCode = 107
-	CurrencyCode_CURRENCY_CODE_OMR         CurrencyCode = 108
-	CurrencyCode_CURRENCY_CODE_PAB         CurrencyCode = 109
-	CurrencyCode_CURRENCY_CODE_PEN         CurrencyCode = 110
-	CurrencyCode_CURRENCY_CODE_PGK         CurrencyCode = 111
-	CurrencyCode_CURRENCY_CODE_PHP         CurrencyCode = 112
-	CurrencyCode_CURRENCY_CODE_PKR         CurrencyCode = 113
-	CurrencyCode_CURRENCY_CODE_PLN         CurrencyCode = 114
-	CurrencyCode_CURRENCY_CODE_PYG         CurrencyCode = 115
-	CurrencyCode_CURRENCY_CODE_QAR         CurrencyCode = 116
-	CurrencyCode_CURRENCY_CODE_RON         CurrencyCode = 117
-	CurrencyCode_CURRENCY_CODE_RSD         CurrencyCode = 118
-	CurrencyCode_CURRENCY_CODE_RUB         CurrencyCode = 119
-	CurrencyCode_CURRENCY_CODE_RWF         CurrencyCode = 120
-	CurrencyCode_CURRENCY_CODE_SAR         CurrencyCode = 121
-	CurrencyCode_CURRENCY_CODE_SBD         CurrencyCode = 122
-	CurrencyCode_CURRENCY_CODE_SCR         CurrencyCode = 123
-	CurrencyCode_CURRENCY_CODE_SDG         CurrencyCode = 124
-	CurrencyCode_CURRENCY_CODE_SEK         CurrencyCode = 125
-	CurrencyCode_CURRENCY_CODE_SGD         CurrencyCode = 126
-	CurrencyCode_CURRENCY_CODE_SHP         CurrencyCode = 127
-	CurrencyCode_CURRENCY_CODE_SLL         CurrencyCode = 128
-	CurrencyCode_CURRENCY_CODE_SOS         CurrencyCode = 129
-	CurrencyCode_CURRENCY_CODE_SRD         CurrencyCode = 130
-	CurrencyCode_CURRENCY_CODE_SSP         CurrencyCode = 131
-	CurrencyCode_CURRENCY_CODE_STN         CurrencyCode = 132
-	CurrencyCode_CURRENCY_CODE_SVC         CurrencyCode = 133
-	CurrencyCode_CURRENCY_CODE_SYP         CurrencyCode = 134
-	CurrencyCode_CURRENCY_CODE_SZL         CurrencyCode = 135
-	CurrencyCode_CURRENCY_CODE_THB         CurrencyCode = 136
-	CurrencyCode_CURRENCY_CODE_TJS         CurrencyCode = 137
-	CurrencyCode_CURRENCY_CODE_TMT         CurrencyCode = 138
-	CurrencyCode_CURRENCY_CODE_TND         CurrencyCode = 139
-	CurrencyCode_CURRENCY_CODE_TOP         CurrencyCode = 140
-	CurrencyCode_CURRENCY_CODE_TRY         CurrencyCode = 141
-	CurrencyCode_CURRENCY_CODE_TTD         CurrencyCode = 142
-	CurrencyCode_CURRENCY_CODE_TWD         CurrencyCode = 143
-	CurrencyCode_CURRENCY_CODE_TZS         CurrencyCode = 144
-	CurrencyCode_CURRENCY_CODE_UAH         CurrencyCode = 145
-	CurrencyCode_CURRENCY_CODE_UGX         CurrencyCode = 146
-	CurrencyCode_CURRENCY_CODE_USD         CurrencyCode = 147
-	CurrencyCode_CURRENCY_CODE_UYI         CurrencyCode = 148
-	CurrencyCode_CURRENCY_CODE_UYU         CurrencyCode = 149
-	CurrencyCode_CURRENCY_CODE_UZS         CurrencyCode = 150
-	CurrencyCode_CURRENCY_CODE_VES         CurrencyCode = 151
-	CurrencyCode_CURRENCY_CODE_VND         CurrencyCode = 152
-	CurrencyCode_CURRENCY_CODE_VUV         CurrencyCode = 153
-	CurrencyCode_CURRENCY_CODE_WST         CurrencyCode = 154
-	CurrencyCode_CURRENCY_CODE_XAF         CurrencyCode = 155
-	CurrencyCode_CURRENCY_CODE_XCD         CurrencyCode = 156
-	CurrencyCode_CURRENCY_CODE_XOF         CurrencyCode = 157
-	CurrencyCode_CURRENCY_CODE_XPF         CurrencyCode = 158
-	CurrencyCode_CURRENCY_CODE_YER         CurrencyCode = 159
-	CurrencyCode_CURRENCY_CODE_ZAR         CurrencyCode = 160
-	CurrencyCode_CURRENCY_CODE_ZMW         CurrencyCode = 161
-	CurrencyCode_CURRENCY_CODE_ZWL         CurrencyCode = 162
-	CurrencyCode_CURRENCY_CODE_CYP         CurrencyCode = 163
-	CurrencyCode_CURRENCY_CODE_EEK         CurrencyCode = 164
-	CurrencyCode_CURRENCY_CODE_LTL         CurrencyCode = 165
-	CurrencyCode_CURRENCY_CODE_LVL         CurrencyCode = 166
-	CurrencyCode_CURRENCY_CODE_MTL         CurrencyCode = 167
-	CurrencyCode_CURRENCY_CODE_MRO         CurrencyCode = 168
-	CurrencyCode_CURRENCY_CODE_ROL         CurrencyCode = 169
-	CurrencyCode_CURRENCY_CODE_SIT         CurrencyCode = 170
-	CurrencyCode_CURRENCY_CODE_SKK         CurrencyCode = 171
-	CurrencyCode_CURRENCY_CODE_STD         CurrencyCode = 172
-	CurrencyCode_CURRENCY_CODE_VEF         CurrencyCode = 173
+	// @xml: "AED"
+	CurrencyCode_CURRENCY_CODE_AED CurrencyCode = 1
+	// @xml: "AFN"
+	CurrencyCode_CURRENCY_CODE_AFN CurrencyCode = 2
+	// @xml: "ALL"
+	CurrencyCode_CURRENCY_CODE_ALL CurrencyCode = 3
+	// @xml: "AMD"
+	CurrencyCode_CURRENCY_CODE_AMD CurrencyCode = 4
+	// @xml: "ANG"
+	CurrencyCode_CURRENCY_CODE_ANG CurrencyCode = 5
+	// @xml: "AOA"
+	CurrencyCode_CURRENCY_CODE_AOA CurrencyCode = 6
+	// @xml: "ARS"
+	CurrencyCode_CURRENCY_CODE_ARS CurrencyCode = 7
+	// @xml: "AUD"
+	CurrencyCode_CURRENCY_CODE_AUD CurrencyCode = 8
+	// @xml: "AWG"
+	CurrencyCode_CURRENCY_CODE_AWG CurrencyCode = 9
+	// @xml: "AZN"
+	CurrencyCode_CURRENCY_CODE_AZN CurrencyCode = 10
+	// @xml: "BAM"
+	CurrencyCode_CURRENCY_CODE_BAM CurrencyCode = 11
+	// @xml: "BBD"
+	CurrencyCode_CURRENCY_CODE_BBD CurrencyCode = 12
+	// @xml: "BDT"
+	CurrencyCode_CURRENCY_CODE_BDT CurrencyCode = 13
+	// @xml: "BGN"
+	CurrencyCode_CURRENCY_CODE_BGN CurrencyCode = 14
+	// @xml: "BHD"
+	CurrencyCode_CURRENCY_CODE_BHD CurrencyCode = 15
+	// @xml: "BIF"
+	CurrencyCode_CURRENCY_CODE_BIF CurrencyCode = 16
+	// @xml: "BMD"
+	CurrencyCode_CURRENCY_CODE_BMD CurrencyCode = 17
+	// @xml: "BND"
+	CurrencyCode_CURRENCY_CODE_BND CurrencyCode = 18
+	// @xml: "BOB"
+	CurrencyCode_CURRENCY_CODE_BOB CurrencyCode = 19
+	// @xml: "BOV"
+	CurrencyCode_CURRENCY_CODE_BOV CurrencyCode = 20
+	// @xml: "BRL"
+	CurrencyCode_CURRENCY_CODE_BRL CurrencyCode = 21
+	// @xml: "BSD"
+	CurrencyCode_CURRENCY_CODE_BSD CurrencyCode = 22
+	// @xml: "BTN"
+	CurrencyCode_CURRENCY_CODE_BTN CurrencyCode = 23
+	// @xml: "BWP"
+	CurrencyCode_CURRENCY_CODE_BWP CurrencyCode = 24
+	// @xml: "BYR"
+	CurrencyCode_CURRENCY_CODE_BYR CurrencyCode = 25
+	// @xml: "BZD"
+	CurrencyCode_CURRENCY_CODE_BZD CurrencyCode = 26
+	// @xml: "CAD"
+	CurrencyCode_CURRENCY_CODE_CAD CurrencyCode = 27
+	// @xml: "CDF"
+	CurrencyCode_CURRENCY_CODE_CDF CurrencyCode = 28
+	// @xml: "CHF"
+	CurrencyCode_CURRENCY_CODE_CHF CurrencyCode = 29
+	// @xml: "CLF"
+	CurrencyCode_CURRENCY_CODE_CLF CurrencyCode = 30
+	// @xml: "CLP"
+	CurrencyCode_CURRENCY_CODE_CLP CurrencyCode = 31
+	// @xml: "CNY"
+	CurrencyCode_CURRENCY_CODE_CNY CurrencyCode = 32
+	// @xml: "COP"
+	CurrencyCode_CURRENCY_CODE_COP CurrencyCode = 33
+	// @xml: "COU"
+	CurrencyCode_CURRENCY_CODE_COU CurrencyCode = 34
+	// @xml: "CRC"
+	CurrencyCode_CURRENCY_CODE_CRC CurrencyCode = 35
+	// @xml: "CUC"
+	CurrencyCode_CURRENCY_CODE_CUC CurrencyCode = 36
+	// @xml: "CUP"
+	CurrencyCode_CURRENCY_CODE_CUP CurrencyCode = 37
+	// @xml: "CVE"
+	CurrencyCode_CURRENCY_CODE_CVE CurrencyCode = 38
+	// @xml: "CZK"
+	CurrencyCode_CURRENCY_CODE_CZK CurrencyCode = 39
+	// @xml: "DJF"
+	CurrencyCode_CURRENCY_CODE_DJF CurrencyCode = 40
+	// @xml: "DKK"
+	CurrencyCode_CURRENCY_CODE_DKK CurrencyCode = 41
+	// @xml: "DOP"
+	CurrencyCode_CURRENCY_CODE_DOP CurrencyCode = 42
+	// @xml: "DZD"
+	CurrencyCode_CURRENCY_CODE_DZD CurrencyCode = 43
+	// @xml: "EGP"
+	CurrencyCode_CURRENCY_CODE_EGP CurrencyCode = 44
+	// @xml: "ERN"
+	CurrencyCode_CURRENCY_CODE_ERN CurrencyCode = 45
+	// @xml: "ETB"
+	CurrencyCode_CURRENCY_CODE_ETB CurrencyCode = 46
+	// @xml: "EUR"
+	CurrencyCode_CURRENCY_CODE_EUR CurrencyCode = 47
+	// @xml: "FJD"
+	CurrencyCode_CURRENCY_CODE_FJD CurrencyCode = 48
+	// @xml: "FKP"
+	CurrencyCode_CURRENCY_CODE_FKP CurrencyCode = 49
+	// @xml: "GBP"
+	CurrencyCode_CURRENCY_CODE_GBP CurrencyCode = 50
+	// @xml: "GEL"
+	CurrencyCode_CURRENCY_CODE_GEL CurrencyCode = 51
+	// @xml: "GHS"
+	CurrencyCode_CURRENCY_CODE_GHS CurrencyCode = 52
+	// @xml: "GIP"
+	CurrencyCode_CURRENCY_CODE_GIP CurrencyCode = 53
+	// @xml: "GMD"
+	CurrencyCode_CURRENCY_CODE_GMD CurrencyCode = 54
+	// @xml: "GNF"
+	CurrencyCode_CURRENCY_CODE_GNF CurrencyCode = 55
+	// @xml: "GTQ"
+	CurrencyCode_CURRENCY_CODE_GTQ CurrencyCode = 56
+	// @xml: "GYD"
+	CurrencyCode_CURRENCY_CODE_GYD CurrencyCode = 57
+	// @xml: "HKD"
+	CurrencyCode_CURRENCY_CODE_HKD CurrencyCode = 58
+	// @xml: "HNL"
+	CurrencyCode_CURRENCY_CODE_HNL CurrencyCode = 59
+	// @xml: "HRK"
+	CurrencyCode_CURRENCY_CODE_HRK CurrencyCode = 60
+	// @xml: "HTG"
+	CurrencyCode_CURRENCY_CODE_HTG CurrencyCode = 61
+	// @xml: "HUF"
+	CurrencyCode_CURRENCY_CODE_HUF CurrencyCode = 62
+	// @xml: "IDR"
+	CurrencyCode_CURRENCY_CODE_IDR CurrencyCode = 63
+	// @xml: "ILS"
+	CurrencyCode_CURRENCY_CODE_ILS CurrencyCode = 64
+	// @xml: "INR"
+	CurrencyCode_CURRENCY_CODE_INR CurrencyCode = 65
+	// @xml: "IQD"
+	CurrencyCode_CURRENCY_CODE_IQD CurrencyCode = 66
+	// @xml: "IRR"
+	CurrencyCode_CURRENCY_CODE_IRR CurrencyCode = 67
+	// @xml: "ISK"
+	CurrencyCode_CURRENCY_CODE_ISK CurrencyCode = 68
+	// @xml: "JMD"
+	CurrencyCode_CURRENCY_CODE_JMD CurrencyCode = 69
+	// @xml: "JOD"
+	CurrencyCode_CURRENCY_CODE_JOD CurrencyCode = 70
+	// @xml: "JPY"
+	CurrencyCode_CURRENCY_CODE_JPY CurrencyCode = 71
+	// @xml: "KES"
+	CurrencyCode_CURRENCY_CODE_KES CurrencyCode = 72
+	// @xml: "KGS"
+	CurrencyCode_CURRENCY_CODE_KGS CurrencyCode = 73
+	// @xml: "KHR"
+	CurrencyCode_CURRENCY_CODE_KHR CurrencyCode = 74
+	// @xml: "KMF"
+	CurrencyCode_CURRENCY_CODE_KMF CurrencyCode = 75
+	// @xml: "KPW"
+	CurrencyCode_CURRENCY_CODE_KPW CurrencyCode = 76
+	// @xml: "KRW"
+	CurrencyCode_CURRENCY_CODE_KRW CurrencyCode = 77
+	// @xml: "KWD"
+	CurrencyCode_CURRENCY_CODE_KWD CurrencyCode = 78
+	// @xml: "KYD"
+	CurrencyCode_CURRENCY_CODE_KYD CurrencyCode = 79
+	// @xml: "KZT"
+	CurrencyCode_CURRENCY_CODE_KZT CurrencyCode = 80
+	// @xml: "LAK"
+	CurrencyCode_CURRENCY_CODE_LAK CurrencyCode = 81
+	// @xml: "LBP"
+	CurrencyCode_CURRENCY_CODE_LBP CurrencyCode = 82
+	// @xml: "LKR"
+	CurrencyCode_CURRENCY_CODE_LKR CurrencyCode = 83
+	// @xml: "LRD"
+	CurrencyCode_CURRENCY_CODE_LRD CurrencyCode = 84
+	// @xml: "LSL"
+	CurrencyCode_CURRENCY_CODE_LSL CurrencyCode = 85
+	// @xml: "LYD"
+	CurrencyCode_CURRENCY_CODE_LYD CurrencyCode = 86
+	// @xml: "MAD"
+	CurrencyCode_CURRENCY_CODE_MAD CurrencyCode = 87
+	// @xml: "MDL"
+	CurrencyCode_CURRENCY_CODE_MDL CurrencyCode = 88
+	// @xml: "MGA"
+	CurrencyCode_CURRENCY_CODE_MGA CurrencyCode = 89
+	// @xml: "MKD"
+	CurrencyCode_CURRENCY_CODE_MKD CurrencyCode = 90
+	// @xml: "MMK"
+	CurrencyCode_CURRENCY_CODE_MMK CurrencyCode = 91
+	// @xml: "MNT"
+	CurrencyCode_CURRENCY_CODE_MNT CurrencyCode = 92
+	// @xml: "MOP"
+	CurrencyCode_CURRENCY_CODE_MOP CurrencyCode = 93
+	// @xml: "MRU"
+	CurrencyCode_CURRENCY_CODE_MRU CurrencyCode = 94
+	// @xml: "MUR"
+	CurrencyCode_CURRENCY_CODE_MUR CurrencyCode = 95
+	// @xml: "MVR"
+	CurrencyCode_CURRENCY_CODE_MVR CurrencyCode = 96
+	// @xml: "MWK"
+	CurrencyCode_CURRENCY_CODE_MWK CurrencyCode = 97
+	// @xml: "MXN"
+	CurrencyCode_CURRENCY_CODE_MXN CurrencyCode = 98
+	// @xml: "MXV"
+	CurrencyCode_CURRENCY_CODE_MXV CurrencyCode = 99
+	// @xml: "MYR"
+	CurrencyCode_CURRENCY_CODE_MYR CurrencyCode = 100
+	// @xml: "MZN"
+	CurrencyCode_CURRENCY_CODE_MZN CurrencyCode = 101
+	// @xml: "NAD"
+	CurrencyCode_CURRENCY_CODE_NAD CurrencyCode = 102
+	// @xml: "NGN"
+	CurrencyCode_CURRENCY_CODE_NGN CurrencyCode = 103
+	// @xml: "NIO"
+	CurrencyCode_CURRENCY_CODE_NIO CurrencyCode = 104
+	// @xml: "NOK"
+	CurrencyCode_CURRENCY_CODE_NOK CurrencyCode = 105
+	// @xml: "NPR"
+	CurrencyCode_CURRENCY_CODE_NPR CurrencyCode = 106
+	// @xml: "NZD"
+	CurrencyCode_CURRENCY_CODE_NZD CurrencyCode = 107
+	// @xml: "OMR"
+	CurrencyCode_CURRENCY_CODE_OMR CurrencyCode = 108
+	// @xml: "PAB"
+	CurrencyCode_CURRENCY_CODE_PAB CurrencyCode = 109
+	// @xml: "PEN"
+	CurrencyCode_CURRENCY_CODE_PEN CurrencyCode = 110
+	// @xml: "PGK"
+	CurrencyCode_CURRENCY_CODE_PGK CurrencyCode = 111
+	// @xml: "PHP"
+	CurrencyCode_CURRENCY_CODE_PHP CurrencyCode = 112
+	// @xml: "PKR"
+	CurrencyCode_CURRENCY_CODE_PKR CurrencyCode = 113
+	// @xml: "PLN"
+	CurrencyCode_CURRENCY_CODE_PLN CurrencyCode = 114
+	// @xml: "PYG"
+	CurrencyCode_CURRENCY_CODE_PYG CurrencyCode = 115
+	// @xml: "QAR"
+	CurrencyCode_CURRENCY_CODE_QAR CurrencyCode = 116
+	// @xml: "RON"
+	CurrencyCode_CURRENCY_CODE_RON CurrencyCode = 117
+	// @xml: "RSD"
+	CurrencyCode_CURRENCY_CODE_RSD CurrencyCode = 118
+	// @xml: "RUB"
+	CurrencyCode_CURRENCY_CODE_RUB CurrencyCode = 119
+	// @xml: "RWF"
+	CurrencyCode_CURRENCY_CODE_RWF CurrencyCode = 120
+	// @xml: "SAR"
+	CurrencyCode_CURRENCY_CODE_SAR CurrencyCode = 121
+	// @xml: "SBD"
+	CurrencyCode_CURRENCY_CODE_SBD CurrencyCode = 122
+	// @xml: "SCR"
+	CurrencyCode_CURRENCY_CODE_SCR CurrencyCode = 123
+	// @xml: "SDG"
+	CurrencyCode_CURRENCY_CODE_SDG CurrencyCode = 124
+	// @xml: "SEK"
+	CurrencyCode_CURRENCY_CODE_SEK CurrencyCode = 125
+	// @xml: "SGD"
+	CurrencyCode_CURRENCY_CODE_SGD CurrencyCode = 126
+	// @xml: "SHP"
+	CurrencyCode_CURRENCY_CODE_SHP CurrencyCode = 127
+	// @xml: "SLL"
+	CurrencyCode_CURRENCY_CODE_SLL CurrencyCode = 128
+	// @xml: "SOS"
+	CurrencyCode_CURRENCY_CODE_SOS CurrencyCode = 129
+	// @xml: "SRD"
+	CurrencyCode_CURRENCY_CODE_SRD CurrencyCode = 130
+	// @xml: "SSP"
+	CurrencyCode_CURRENCY_CODE_SSP CurrencyCode = 131
+	// @xml: "STN"
+	CurrencyCode_CURRENCY_CODE_STN CurrencyCode = 132
+	// @xml: "SVC"
+	CurrencyCode_CURRENCY_CODE_SVC CurrencyCode = 133
+	// @xml: "SYP"
+	CurrencyCode_CURRENCY_CODE_SYP CurrencyCode = 134
+	// @xml: "SZL"
+	CurrencyCode_CURRENCY_CODE_SZL CurrencyCode = 135
+	// @xml: "THB"
+	CurrencyCode_CURRENCY_CODE_THB CurrencyCode = 136
+	// @xml: "TJS"
+	CurrencyCode_CURRENCY_CODE_TJS CurrencyCode = 137
+	// @xml: "TMT"
+	CurrencyCode_CURRENCY_CODE_TMT CurrencyCode = 138
+	// @xml: "TND"
+	CurrencyCode_CURRENCY_CODE_TND CurrencyCode = 139
+	// @xml: "TOP"
+	CurrencyCode_CURRENCY_CODE_TOP CurrencyCode = 140
+	// @xml: "TRY"
+	CurrencyCode_CURRENCY_CODE_TRY CurrencyCode = 141
+	// @xml: "TTD"
+	CurrencyCode_CURRENCY_CODE_TTD CurrencyCode = 142
+	// @xml: "TWD"
+	CurrencyCode_CURRENCY_CODE_TWD CurrencyCode = 143
+	// @xml: "TZS"
+	CurrencyCode_CURRENCY_CODE_TZS CurrencyCode = 144
+	// @xml: "UAH"
+	CurrencyCode_CURRENCY_CODE_UAH CurrencyCode = 145
+	// @xml: "UGX"
+	CurrencyCode_CURRENCY_CODE_UGX CurrencyCode = 146
+	// @xml: "USD"
+	CurrencyCode_CURRENCY_CODE_USD CurrencyCode = 147
+	// @xml: "UYI"
+	CurrencyCode_CURRENCY_CODE_UYI CurrencyCode = 148
+	// @xml: "UYU"
+	CurrencyCode_CURRENCY_CODE_UYU CurrencyCode = 149
+	// @xml: "UZS"
+	CurrencyCode_CURRENCY_CODE_UZS CurrencyCode = 150
+	// @xml: "VES"
+	CurrencyCode_CURRENCY_CODE_VES CurrencyCode = 151
+	// @xml: "VND"
+	CurrencyCode_CURRENCY_CODE_VND CurrencyCode = 152
+	// @xml: "VUV"
+	CurrencyCode_CURRENCY_CODE_VUV CurrencyCode = 153
+	// @xml: "WST"
+	CurrencyCode_CURRENCY_CODE_WST CurrencyCode = 154
+	// @xml: "XAF"
+	CurrencyCode_CURRENCY_CODE_XAF CurrencyCode = 155
+	// @xml: "XCD"
+	CurrencyCode_CURRENCY_CODE_XCD CurrencyCode = 156
+	// @xml: "XOF"
+	CurrencyCode_CURRENCY_CODE_XOF CurrencyCode = 157
+	// @xml: "XPF"
+	CurrencyCode_CURRENCY_CODE_XPF CurrencyCode = 158
+	// @xml: "YER"
+	CurrencyCode_CURRENCY_CODE_YER CurrencyCode = 159
+	// @xml: "ZAR"
+	CurrencyCode_CURRENCY_CODE_ZAR CurrencyCode = 160
+	// @xml: "ZMW"
+	CurrencyCode_CURRENCY_CODE_ZMW CurrencyCode = 161
+	// @xml: "ZWL"
+	CurrencyCode_CURRENCY_CODE_ZWL CurrencyCode = 162
+	// @xml: "CYP"
+	CurrencyCode_CURRENCY_CODE_CYP CurrencyCode = 163
+	// @xml: "EEK"
+	CurrencyCode_CURRENCY_CODE_EEK CurrencyCode = 164
+	// @xml: "LTL"
+	CurrencyCode_CURRENCY_CODE_LTL CurrencyCode = 165
+	// @xml: "LVL"
+	CurrencyCode_CURRENCY_CODE_LVL CurrencyCode = 166
+	// @xml: "MTL"
+	CurrencyCode_CURRENCY_CODE_MTL CurrencyCode = 167
+	// @xml: "MRO"
+	CurrencyCode_CURRENCY_CODE_MRO CurrencyCode = 168
+	// @xml: "ROL"
+	CurrencyCode_CURRENCY_CODE_ROL CurrencyCode = 169
+	// @xml: "SIT"
+	CurrencyCode_CURRENCY_CODE_SIT CurrencyCode = 170
+	// @xml: "SKK"
+	CurrencyCode_CURRENCY_CODE_SKK CurrencyCode = 171
+	// @xml: "STD"
+	CurrencyCode_CURRENCY_CODE_STD CurrencyCode = 172
+	// @xml: "VEF"
+	CurrencyCode_CURRENCY_CODE_VEF CurrencyCode = 173
 )
 
 // Enum value maps for CurrencyCode.
@@ -3907,509 +4903,1012 @@ type CurrentTerritoryCode int32
 
 const (
 	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UNSPECIFIED CurrentTerritoryCode = 0
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD          CurrentTerritoryCode = 1
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AE          CurrentTerritoryCode = 2
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AF          CurrentTerritoryCode = 3
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AG          CurrentTerritoryCode = 4
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AI          CurrentTerritoryCode = 5
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AL          CurrentTerritoryCode = 6
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AM          CurrentTerritoryCode = 7
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AN          CurrentTerritoryCode = 8
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AO          CurrentTerritoryCode = 9
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AQ          CurrentTerritoryCode = 10
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AR          CurrentTerritoryCode = 11
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AS          CurrentTerritoryCode = 12
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AT          CurrentTerritoryCode = 13
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AU          CurrentTerritoryCode = 14
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AW          CurrentTerritoryCode = 15
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AX          CurrentTerritoryCode = 16
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AZ          CurrentTerritoryCode = 17
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BA          CurrentTerritoryCode = 18
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BB          CurrentTerritoryCode = 19
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BD          CurrentTerritoryCode = 20
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BE          CurrentTerritoryCode = 21
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BF          CurrentTerritoryCode = 22
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BG          CurrentTerritoryCode = 23
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BH          CurrentTerritoryCode = 24
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BI          CurrentTerritoryCode = 25
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BJ          CurrentTerritoryCode = 26
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BL          CurrentTerritoryCode = 27
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BM          CurrentTerritoryCode = 28
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BN          CurrentTerritoryCode = 29
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BO          CurrentTerritoryCode = 30
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BQ          CurrentTerritoryCode = 31
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BR          CurrentTerritoryCode = 32
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BS          CurrentTerritoryCode = 33
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BT          CurrentTerritoryCode = 34
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BV          CurrentTerritoryCode = 35
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BW          CurrentTerritoryCode = 36
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BY          CurrentTerritoryCode = 37
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BZ          CurrentTerritoryCode = 38
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CA          CurrentTerritoryCode = 39
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CC          CurrentTerritoryCode = 40
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CD          CurrentTerritoryCode = 41
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CF          CurrentTerritoryCode = 42
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CG          CurrentTerritoryCode = 43
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CH          CurrentTerritoryCode = 44
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CI          CurrentTerritoryCode = 45
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CK          CurrentTerritoryCode = 46
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CL          CurrentTerritoryCode = 47
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CM          CurrentTerritoryCode = 48
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CN          CurrentTerritoryCode = 49
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CO          CurrentTerritoryCode = 50
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CR          CurrentTerritoryCode = 51
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CS          CurrentTerritoryCode = 52
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CU          CurrentTerritoryCode = 53
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CV          CurrentTerritoryCode = 54
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CW          CurrentTerritoryCode = 55
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CX          CurrentTerritoryCode = 56
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CY          CurrentTerritoryCode = 57
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CZ          CurrentTerritoryCode = 58
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DE          CurrentTerritoryCode = 59
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DJ          CurrentTerritoryCode = 60
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DK          CurrentTerritoryCode = 61
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DM          CurrentTerritoryCode = 62
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DO          CurrentTerritoryCode = 63
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DZ          CurrentTerritoryCode = 64
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EC          CurrentTerritoryCode = 65
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EE          CurrentTerritoryCode = 66
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EG          CurrentTerritoryCode = 67
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EH          CurrentTerritoryCode = 68
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ER          CurrentTerritoryCode = 69
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES          CurrentTerritoryCode = 70
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CE       CurrentTerritoryCode = 71
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CN       CurrentTerritoryCode = 72
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_ML       CurrentTerritoryCode = 73
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ET          CurrentTerritoryCode = 74
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FI          CurrentTerritoryCode = 75
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FJ          CurrentTerritoryCode = 76
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FK          CurrentTerritoryCode = 77
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FM          CurrentTerritoryCode = 78
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FO          CurrentTerritoryCode = 79
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FR          CurrentTerritoryCode = 80
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GA          CurrentTerritoryCode = 81
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GB          CurrentTerritoryCode = 82
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GD          CurrentTerritoryCode = 83
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GE          CurrentTerritoryCode = 84
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GF          CurrentTerritoryCode = 85
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GG          CurrentTerritoryCode = 86
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GH          CurrentTerritoryCode = 87
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GI          CurrentTerritoryCode = 88
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GL          CurrentTerritoryCode = 89
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GM          CurrentTerritoryCode = 90
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GN          CurrentTerritoryCode = 91
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GP          CurrentTerritoryCode = 92
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GQ          CurrentTerritoryCode = 93
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GR          CurrentTerritoryCode = 94
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GS          CurrentTerritoryCode = 95
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GT          CurrentTerritoryCode = 96
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GU          CurrentTerritoryCode = 97
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GW          CurrentTerritoryCode = 98
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GY          CurrentTerritoryCode = 99
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HK          CurrentTerritoryCode = 100
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HM          CurrentTerritoryCode = 101
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HN          CurrentTerritoryCode = 102
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HR          CurrentTerritoryCode = 103
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HT          CurrentTerritoryCode = 104
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HU          CurrentTerritoryCode = 105
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ID          CurrentTerritoryCode = 106
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IE          CurrentTerritoryCode = 107
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IL          CurrentTerritoryCode = 108
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IM          CurrentTerritoryCode = 109
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IN          CurrentTerritoryCode = 110
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IO          CurrentTerritoryCode = 111
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IQ          CurrentTerritoryCode = 112
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IR          CurrentTerritoryCode = 113
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IS          CurrentTerritoryCode = 114
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IT          CurrentTerritoryCode = 115
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JE          CurrentTerritoryCode = 116
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JM          CurrentTerritoryCode = 117
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JO          CurrentTerritoryCode = 118
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JP          CurrentTerritoryCode = 119
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KE          CurrentTerritoryCode = 120
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KG          CurrentTerritoryCode = 121
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KH          CurrentTerritoryCode = 122
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KI          CurrentTerritoryCode = 123
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KM          CurrentTerritoryCode = 124
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KN          CurrentTerritoryCode = 125
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KP          CurrentTerritoryCode = 126
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KR          CurrentTerritoryCode = 127
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KW          CurrentTerritoryCode = 128
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KY          CurrentTerritoryCode = 129
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KZ          CurrentTerritoryCode = 130
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LA          CurrentTerritoryCode = 131
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LB          CurrentTerritoryCode = 132
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LC          CurrentTerritoryCode = 133
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LI          CurrentTerritoryCode = 134
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LK          CurrentTerritoryCode = 135
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LR          CurrentTerritoryCode = 136
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LS          CurrentTerritoryCode = 137
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LT          CurrentTerritoryCode = 138
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LU          CurrentTerritoryCode = 139
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LV          CurrentTerritoryCode = 140
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LY          CurrentTerritoryCode = 141
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MA          CurrentTerritoryCode = 142
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MC          CurrentTerritoryCode = 143
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MD          CurrentTerritoryCode = 144
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ME          CurrentTerritoryCode = 145
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MF          CurrentTerritoryCode = 146
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MG          CurrentTerritoryCode = 147
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MH          CurrentTerritoryCode = 148
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MK          CurrentTerritoryCode = 149
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ML          CurrentTerritoryCode = 150
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MM          CurrentTerritoryCode = 151
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MN          CurrentTerritoryCode = 152
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MO          CurrentTerritoryCode = 153
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MP          CurrentTerritoryCode = 154
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MQ          CurrentTerritoryCode = 155
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MR          CurrentTerritoryCode = 156
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MS          CurrentTerritoryCode = 157
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MT          CurrentTerritoryCode = 158
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MU          CurrentTerritoryCode = 159
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MV          CurrentTerritoryCode = 160
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MW          CurrentTerritoryCode = 161
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MX          CurrentTerritoryCode = 162
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MY          CurrentTerritoryCode = 163
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MZ          CurrentTerritoryCode = 164
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NA          CurrentTerritoryCode = 165
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NC          CurrentTerritoryCode = 166
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NE          CurrentTerritoryCode = 167
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NF          CurrentTerritoryCode = 168
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NG          CurrentTerritoryCode = 169
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NI          CurrentTerritoryCode = 170
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NL          CurrentTerritoryCode = 171
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NO          CurrentTerritoryCode = 172
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NP          CurrentTerritoryCode = 173
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NR          CurrentTerritoryCode = 174
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NU          CurrentTerritoryCode = 175
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NZ          CurrentTerritoryCode = 176
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_OM          CurrentTerritoryCode = 177
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PA          CurrentTerritoryCode = 178
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PE          CurrentTerritoryCode = 179
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PF          CurrentTerritoryCode = 180
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PG          CurrentTerritoryCode = 181
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PH          CurrentTerritoryCode = 182
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PK          CurrentTerritoryCode = 183
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PL          CurrentTerritoryCode = 184
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PM          CurrentTerritoryCode = 185
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PN          CurrentTerritoryCode = 186
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PR          CurrentTerritoryCode = 187
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PS          CurrentTerritoryCode = 188
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PT          CurrentTerritoryCode = 189
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PW          CurrentTerritoryCode = 190
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PY          CurrentTerritoryCode = 191
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_QA          CurrentTerritoryCode = 192
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RE          CurrentTerritoryCode = 193
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RO          CurrentTerritoryCode = 194
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RS          CurrentTerritoryCode = 195
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RU          CurrentTerritoryCode = 196
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RW          CurrentTerritoryCode = 197
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SA          CurrentTerritoryCode = 198
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SB          CurrentTerritoryCode = 199
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SC          CurrentTerritoryCode = 200
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SD          CurrentTerritoryCode = 201
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SE          CurrentTerritoryCode = 202
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SG          CurrentTerritoryCode = 203
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SH          CurrentTerritoryCode = 204
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SI          CurrentTerritoryCode = 205
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SJ          CurrentTerritoryCode = 206
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SK          CurrentTerritoryCode = 207
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SL          CurrentTerritoryCode = 208
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SM          CurrentTerritoryCode = 209
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SN          CurrentTerritoryCode = 210
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SO          CurrentTerritoryCode = 211
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SR          CurrentTerritoryCode = 212
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SS          CurrentTerritoryCode = 213
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ST          CurrentTerritoryCode = 214
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SV          CurrentTerritoryCode = 215
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SX          CurrentTerritoryCode = 216
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SY          CurrentTerritoryCode = 217
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SZ          CurrentTerritoryCode = 218
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TC          CurrentTerritoryCode = 219
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TD          CurrentTerritoryCode = 220
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TF          CurrentTerritoryCode = 221
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TG          CurrentTerritoryCode = 222
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TH          CurrentTerritoryCode = 223
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TJ          CurrentTerritoryCode = 224
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TK          CurrentTerritoryCode = 225
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TL          CurrentTerritoryCode = 226
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TM          CurrentTerritoryCode = 227
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TN          CurrentTerritoryCode = 228
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TO          CurrentTerritoryCode = 229
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TR          CurrentTerritoryCode = 230
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TT          CurrentTerritoryCode = 231
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TV          CurrentTerritoryCode = 232
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TW          CurrentTerritoryCode = 233
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TZ          CurrentTerritoryCode = 234
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UA          CurrentTerritoryCode = 235
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UG          CurrentTerritoryCode = 236
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UM          CurrentTerritoryCode = 237
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_US          CurrentTerritoryCode = 238
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UY          CurrentTerritoryCode = 239
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UZ          CurrentTerritoryCode = 240
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VA          CurrentTerritoryCode = 241
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VC          CurrentTerritoryCode = 242
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VE          CurrentTerritoryCode = 243
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VG          CurrentTerritoryCode = 244
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VI          CurrentTerritoryCode = 245
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VN          CurrentTerritoryCode = 246
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VU          CurrentTerritoryCode = 247
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WF          CurrentTerritoryCode = 248
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WS          CurrentTerritoryCode = 249
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YE          CurrentTerritoryCode = 250
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YT          CurrentTerritoryCode = 251
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZA          CurrentTerritoryCode = 252
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZM          CurrentTerritoryCode = 253
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZW          CurrentTerritoryCode = 254
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_4         CurrentTerritoryCode = 255
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_8         CurrentTerritoryCode = 256
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_12        CurrentTerritoryCode = 257
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_20        CurrentTerritoryCode = 258
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_24        CurrentTerritoryCode = 259
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_28        CurrentTerritoryCode = 260
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_31        CurrentTerritoryCode = 261
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_32        CurrentTerritoryCode = 262
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_36        CurrentTerritoryCode = 263
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_40        CurrentTerritoryCode = 264
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_44        CurrentTerritoryCode = 265
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_48        CurrentTerritoryCode = 266
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_50        CurrentTerritoryCode = 267
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_51        CurrentTerritoryCode = 268
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_52        CurrentTerritoryCode = 269
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_56        CurrentTerritoryCode = 270
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_64        CurrentTerritoryCode = 271
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_68        CurrentTerritoryCode = 272
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_70        CurrentTerritoryCode = 273
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_72        CurrentTerritoryCode = 274
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_76        CurrentTerritoryCode = 275
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_84        CurrentTerritoryCode = 276
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_90        CurrentTerritoryCode = 277
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_96        CurrentTerritoryCode = 278
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_100       CurrentTerritoryCode = 279
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_104       CurrentTerritoryCode = 280
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_108       CurrentTerritoryCode = 281
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_112       CurrentTerritoryCode = 282
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_116       CurrentTerritoryCode = 283
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_120       CurrentTerritoryCode = 284
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_124       CurrentTerritoryCode = 285
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_132       CurrentTerritoryCode = 286
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_140       CurrentTerritoryCode = 287
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_144       CurrentTerritoryCode = 288
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_148       CurrentTerritoryCode = 289
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_152       CurrentTerritoryCode = 290
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_156       CurrentTerritoryCode = 291
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_158       CurrentTerritoryCode = 292
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_170       CurrentTerritoryCode = 293
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_174       CurrentTerritoryCode = 294
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_178       CurrentTerritoryCode = 295
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_180       CurrentTerritoryCode = 296
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_188       CurrentTerritoryCode = 297
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_191       CurrentTerritoryCode = 298
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_192       CurrentTerritoryCode = 299
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_196       CurrentTerritoryCode = 300
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_200       CurrentTerritoryCode = 301
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_203       CurrentTerritoryCode = 302
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_204       CurrentTerritoryCode = 303
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_208       CurrentTerritoryCode = 304
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_212       CurrentTerritoryCode = 305
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_214       CurrentTerritoryCode = 306
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_218       CurrentTerritoryCode = 307
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_222       CurrentTerritoryCode = 308
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_226       CurrentTerritoryCode = 309
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_230       CurrentTerritoryCode = 310
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_231       CurrentTerritoryCode = 311
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_232       CurrentTerritoryCode = 312
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_233       CurrentTerritoryCode = 313
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_242       CurrentTerritoryCode = 314
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_246       CurrentTerritoryCode = 315
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_250       CurrentTerritoryCode = 316
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_258       CurrentTerritoryCode = 317
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_262       CurrentTerritoryCode = 318
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_266       CurrentTerritoryCode = 319
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_268       CurrentTerritoryCode = 320
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_270       CurrentTerritoryCode = 321
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_276       CurrentTerritoryCode = 322
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_278       CurrentTerritoryCode = 323
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_280       CurrentTerritoryCode = 324
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_288       CurrentTerritoryCode = 325
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_296       CurrentTerritoryCode = 326
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_300       CurrentTerritoryCode = 327
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_308       CurrentTerritoryCode = 328
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_320       CurrentTerritoryCode = 329
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_324       CurrentTerritoryCode = 330
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_328       CurrentTerritoryCode = 331
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_332       CurrentTerritoryCode = 332
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_336       CurrentTerritoryCode = 333
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_340       CurrentTerritoryCode = 334
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_344       CurrentTerritoryCode = 335
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_348       CurrentTerritoryCode = 336
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_352       CurrentTerritoryCode = 337
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_356       CurrentTerritoryCode = 338
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_360       CurrentTerritoryCode = 339
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_364       CurrentTerritoryCode = 340
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_368       CurrentTerritoryCode = 341
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_372       CurrentTerritoryCode = 342
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_376       CurrentTerritoryCode = 343
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_380       CurrentTerritoryCode = 344
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_384       CurrentTerritoryCode = 345
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_388       CurrentTerritoryCode = 346
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_392       CurrentTerritoryCode = 347
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_398       CurrentTerritoryCode = 348
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_400       CurrentTerritoryCode = 349
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_404       CurrentTerritoryCode = 350
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_408       CurrentTerritoryCode = 351
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_410       CurrentTerritoryCode = 352
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_414       CurrentTerritoryCode = 353
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_417       CurrentTerritoryCode = 354
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_418       CurrentTerritoryCode = 355
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_422       CurrentTerritoryCode = 356
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_426       CurrentTerritoryCode = 357
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_428       CurrentTerritoryCode = 358
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_430       CurrentTerritoryCode = 359
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_434       CurrentTerritoryCode = 360
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_438       CurrentTerritoryCode = 361
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_440       CurrentTerritoryCode = 362
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_442       CurrentTerritoryCode = 363
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_446       CurrentTerritoryCode = 364
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_450       CurrentTerritoryCode = 365
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_454       CurrentTerritoryCode = 366
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_458       CurrentTerritoryCode = 367
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_462       CurrentTerritoryCode = 368
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_466       CurrentTerritoryCode = 369
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_470       CurrentTerritoryCode = 370
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_478       CurrentTerritoryCode = 371
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_480       CurrentTerritoryCode = 372
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_484       CurrentTerritoryCode = 373
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_492       CurrentTerritoryCode = 374
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_496       CurrentTerritoryCode = 375
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_498       CurrentTerritoryCode = 376
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_499       CurrentTerritoryCode = 377
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_504       CurrentTerritoryCode = 378
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_508       CurrentTerritoryCode = 379
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_512       CurrentTerritoryCode = 380
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_516       CurrentTerritoryCode = 381
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_520       CurrentTerritoryCode = 382
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_524       CurrentTerritoryCode = 383
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_528       CurrentTerritoryCode = 384
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_540       CurrentTerritoryCode = 385
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_548       CurrentTerritoryCode = 386
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_554       CurrentTerritoryCode = 387
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_558       CurrentTerritoryCode = 388
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_562       CurrentTerritoryCode = 389
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_566       CurrentTerritoryCode = 390
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_578       CurrentTerritoryCode = 391
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_583       CurrentTerritoryCode = 392
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_584       CurrentTerritoryCode = 393
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_585       CurrentTerritoryCode = 394
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_586       CurrentTerritoryCode = 395
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_591       CurrentTerritoryCode = 396
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_598       CurrentTerritoryCode = 397
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_600       CurrentTerritoryCode = 398
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_604       CurrentTerritoryCode = 399
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_608       CurrentTerritoryCode = 400
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_616       CurrentTerritoryCode = 401
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_620       CurrentTerritoryCode = 402
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_624       CurrentTerritoryCode = 403
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_626       CurrentTerritoryCode = 404
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_630       CurrentTerritoryCode = 405
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_634       CurrentTerritoryCode = 406
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_642       CurrentTerritoryCode = 407
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_643       CurrentTerritoryCode = 408
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_646       CurrentTerritoryCode = 409
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_659       CurrentTerritoryCode = 410
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_662       CurrentTerritoryCode = 411
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_670       CurrentTerritoryCode = 412
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_674       CurrentTerritoryCode = 413
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_678       CurrentTerritoryCode = 414
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_682       CurrentTerritoryCode = 415
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_686       CurrentTerritoryCode = 416
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_688       CurrentTerritoryCode = 417
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_690       CurrentTerritoryCode = 418
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_694       CurrentTerritoryCode = 419
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_702       CurrentTerritoryCode = 420
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_703       CurrentTerritoryCode = 421
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_704       CurrentTerritoryCode = 422
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_705       CurrentTerritoryCode = 423
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_706       CurrentTerritoryCode = 424
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_710       CurrentTerritoryCode = 425
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_716       CurrentTerritoryCode = 426
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_720       CurrentTerritoryCode = 427
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_724       CurrentTerritoryCode = 428
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_728       CurrentTerritoryCode = 429
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_729       CurrentTerritoryCode = 430
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_732       CurrentTerritoryCode = 431
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_736       CurrentTerritoryCode = 432
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_740       CurrentTerritoryCode = 433
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_748       CurrentTerritoryCode = 434
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_752       CurrentTerritoryCode = 435
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_756       CurrentTerritoryCode = 436
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_760       CurrentTerritoryCode = 437
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_762       CurrentTerritoryCode = 438
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_764       CurrentTerritoryCode = 439
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_768       CurrentTerritoryCode = 440
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_776       CurrentTerritoryCode = 441
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_780       CurrentTerritoryCode = 442
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_784       CurrentTerritoryCode = 443
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_788       CurrentTerritoryCode = 444
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_792       CurrentTerritoryCode = 445
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_795       CurrentTerritoryCode = 446
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_798       CurrentTerritoryCode = 447
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_800       CurrentTerritoryCode = 448
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_804       CurrentTerritoryCode = 449
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_807       CurrentTerritoryCode = 450
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_810       CurrentTerritoryCode = 451
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_818       CurrentTerritoryCode = 452
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_826       CurrentTerritoryCode = 453
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_834       CurrentTerritoryCode = 454
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_840       CurrentTerritoryCode = 455
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_854       CurrentTerritoryCode = 456
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_858       CurrentTerritoryCode = 457
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_860       CurrentTerritoryCode = 458
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_862       CurrentTerritoryCode = 459
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_882       CurrentTerritoryCode = 460
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_886       CurrentTerritoryCode = 461
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_887       CurrentTerritoryCode = 462
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_890       CurrentTerritoryCode = 463
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_891       CurrentTerritoryCode = 464
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_894       CurrentTerritoryCode = 465
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2100      CurrentTerritoryCode = 466
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2101      CurrentTerritoryCode = 467
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2102      CurrentTerritoryCode = 468
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2103      CurrentTerritoryCode = 469
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2104      CurrentTerritoryCode = 470
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2105      CurrentTerritoryCode = 471
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2106      CurrentTerritoryCode = 472
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2107      CurrentTerritoryCode = 473
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2108      CurrentTerritoryCode = 474
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2109      CurrentTerritoryCode = 475
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2110      CurrentTerritoryCode = 476
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2111      CurrentTerritoryCode = 477
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2112      CurrentTerritoryCode = 478
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2113      CurrentTerritoryCode = 479
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2114      CurrentTerritoryCode = 480
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2115      CurrentTerritoryCode = 481
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2116      CurrentTerritoryCode = 482
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2117      CurrentTerritoryCode = 483
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2118      CurrentTerritoryCode = 484
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2119      CurrentTerritoryCode = 485
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2120      CurrentTerritoryCode = 486
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2121      CurrentTerritoryCode = 487
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2122      CurrentTerritoryCode = 488
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2123      CurrentTerritoryCode = 489
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2124      CurrentTerritoryCode = 490
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2125      CurrentTerritoryCode = 491
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2126      CurrentTerritoryCode = 492
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2127      CurrentTerritoryCode = 493
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2128      CurrentTerritoryCode = 494
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2129      CurrentTerritoryCode = 495
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2130      CurrentTerritoryCode = 496
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2131      CurrentTerritoryCode = 497
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2132      CurrentTerritoryCode = 498
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2133      CurrentTerritoryCode = 499
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2134      CurrentTerritoryCode = 500
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2136      CurrentTerritoryCode = 501
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_XK          CurrentTerritoryCode = 502
-	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WORLDWIDE   CurrentTerritoryCode = 503
+	// @xml: "AD"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AD CurrentTerritoryCode = 1
+	// @xml: "AE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AE CurrentTerritoryCode = 2
+	// @xml: "AF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AF CurrentTerritoryCode = 3
+	// @xml: "AG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AG CurrentTerritoryCode = 4
+	// @xml: "AI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AI CurrentTerritoryCode = 5
+	// @xml: "AL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AL CurrentTerritoryCode = 6
+	// @xml: "AM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AM CurrentTerritoryCode = 7
+	// @xml: "AN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AN CurrentTerritoryCode = 8
+	// @xml: "AO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AO CurrentTerritoryCode = 9
+	// @xml: "AQ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AQ CurrentTerritoryCode = 10
+	// @xml: "AR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AR CurrentTerritoryCode = 11
+	// @xml: "AS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AS CurrentTerritoryCode = 12
+	// @xml: "AT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AT CurrentTerritoryCode = 13
+	// @xml: "AU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AU CurrentTerritoryCode = 14
+	// @xml: "AW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AW CurrentTerritoryCode = 15
+	// @xml: "AX"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AX CurrentTerritoryCode = 16
+	// @xml: "AZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_AZ CurrentTerritoryCode = 17
+	// @xml: "BA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BA CurrentTerritoryCode = 18
+	// @xml: "BB"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BB CurrentTerritoryCode = 19
+	// @xml: "BD"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BD CurrentTerritoryCode = 20
+	// @xml: "BE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BE CurrentTerritoryCode = 21
+	// @xml: "BF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BF CurrentTerritoryCode = 22
+	// @xml: "BG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BG CurrentTerritoryCode = 23
+	// @xml: "BH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BH CurrentTerritoryCode = 24
+	// @xml: "BI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BI CurrentTerritoryCode = 25
+	// @xml: "BJ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BJ CurrentTerritoryCode = 26
+	// @xml: "BL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BL CurrentTerritoryCode = 27
+	// @xml: "BM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BM CurrentTerritoryCode = 28
+	// @xml: "BN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BN CurrentTerritoryCode = 29
+	// @xml: "BO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BO CurrentTerritoryCode = 30
+	// @xml: "BQ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BQ CurrentTerritoryCode = 31
+	// @xml: "BR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BR CurrentTerritoryCode = 32
+	// @xml: "BS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BS CurrentTerritoryCode = 33
+	// @xml: "BT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BT CurrentTerritoryCode = 34
+	// @xml: "BV"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BV CurrentTerritoryCode = 35
+	// @xml: "BW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BW CurrentTerritoryCode = 36
+	// @xml: "BY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BY CurrentTerritoryCode = 37
+	// @xml: "BZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_BZ CurrentTerritoryCode = 38
+	// @xml: "CA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CA CurrentTerritoryCode = 39
+	// @xml: "CC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CC CurrentTerritoryCode = 40
+	// @xml: "CD"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CD CurrentTerritoryCode = 41
+	// @xml: "CF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CF CurrentTerritoryCode = 42
+	// @xml: "CG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CG CurrentTerritoryCode = 43
+	// @xml: "CH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CH CurrentTerritoryCode = 44
+	// @xml: "CI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CI CurrentTerritoryCode = 45
+	// @xml: "CK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CK CurrentTerritoryCode = 46
+	// @xml: "CL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CL CurrentTerritoryCode = 47
+	// @xml: "CM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CM CurrentTerritoryCode = 48
+	// @xml: "CN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CN CurrentTerritoryCode = 49
+	// @xml: "CO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CO CurrentTerritoryCode = 50
+	// @xml: "CR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CR CurrentTerritoryCode = 51
+	// @xml: "CS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CS CurrentTerritoryCode = 52
+	// @xml: "CU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CU CurrentTerritoryCode = 53
+	// @xml: "CV"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CV CurrentTerritoryCode = 54
+	// @xml: "CW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CW CurrentTerritoryCode = 55
+	// @xml: "CX"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CX CurrentTerritoryCode = 56
+	// @xml: "CY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CY CurrentTerritoryCode = 57
+	// @xml: "CZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_CZ CurrentTerritoryCode = 58
+	// @xml: "DE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DE CurrentTerritoryCode = 59
+	// @xml: "DJ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DJ CurrentTerritoryCode = 60
+	// @xml: "DK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DK CurrentTerritoryCode = 61
+	// @xml: "DM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DM CurrentTerritoryCode = 62
+	// @xml: "DO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DO CurrentTerritoryCode = 63
+	// @xml: "DZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_DZ CurrentTerritoryCode = 64
+	// @xml: "EC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EC CurrentTerritoryCode = 65
+	// @xml: "EE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EE CurrentTerritoryCode = 66
+	// @xml: "EG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EG CurrentTerritoryCode = 67
+	// @xml: "EH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_EH CurrentTerritoryCode = 68
+	// @xml: "ER"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ER CurrentTerritoryCode = 69
+	// @xml: "ES"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES CurrentTerritoryCode = 70
+	// @xml: "ES-CE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CE CurrentTerritoryCode = 71
+	// @xml: "ES-CN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_CN CurrentTerritoryCode = 72
+	// @xml: "ES-ML"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ES_ML CurrentTerritoryCode = 73
+	// @xml: "ET"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ET CurrentTerritoryCode = 74
+	// @xml: "FI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FI CurrentTerritoryCode = 75
+	// @xml: "FJ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FJ CurrentTerritoryCode = 76
+	// @xml: "FK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FK CurrentTerritoryCode = 77
+	// @xml: "FM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FM CurrentTerritoryCode = 78
+	// @xml: "FO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FO CurrentTerritoryCode = 79
+	// @xml: "FR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_FR CurrentTerritoryCode = 80
+	// @xml: "GA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GA CurrentTerritoryCode = 81
+	// @xml: "GB"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GB CurrentTerritoryCode = 82
+	// @xml: "GD"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GD CurrentTerritoryCode = 83
+	// @xml: "GE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GE CurrentTerritoryCode = 84
+	// @xml: "GF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GF CurrentTerritoryCode = 85
+	// @xml: "GG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GG CurrentTerritoryCode = 86
+	// @xml: "GH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GH CurrentTerritoryCode = 87
+	// @xml: "GI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GI CurrentTerritoryCode = 88
+	// @xml: "GL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GL CurrentTerritoryCode = 89
+	// @xml: "GM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GM CurrentTerritoryCode = 90
+	// @xml: "GN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GN CurrentTerritoryCode = 91
+	// @xml: "GP"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GP CurrentTerritoryCode = 92
+	// @xml: "GQ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GQ CurrentTerritoryCode = 93
+	// @xml: "GR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GR CurrentTerritoryCode = 94
+	// @xml: "GS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GS CurrentTerritoryCode = 95
+	// @xml: "GT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GT CurrentTerritoryCode = 96
+	// @xml: "GU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GU CurrentTerritoryCode = 97
+	// @xml: "GW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GW CurrentTerritoryCode = 98
+	// @xml: "GY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_GY CurrentTerritoryCode = 99
+	// @xml: "HK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HK CurrentTerritoryCode = 100
+	// @xml: "HM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HM CurrentTerritoryCode = 101
+	// @xml: "HN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HN CurrentTerritoryCode = 102
+	// @xml: "HR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HR CurrentTerritoryCode = 103
+	// @xml: "HT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HT CurrentTerritoryCode = 104
+	// @xml: "HU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_HU CurrentTerritoryCode = 105
+	// @xml: "ID"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ID CurrentTerritoryCode = 106
+	// @xml: "IE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IE CurrentTerritoryCode = 107
+	// @xml: "IL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IL CurrentTerritoryCode = 108
+	// @xml: "IM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IM CurrentTerritoryCode = 109
+	// @xml: "IN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IN CurrentTerritoryCode = 110
+	// @xml: "IO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IO CurrentTerritoryCode = 111
+	// @xml: "IQ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IQ CurrentTerritoryCode = 112
+	// @xml: "IR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IR CurrentTerritoryCode = 113
+	// @xml: "IS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IS CurrentTerritoryCode = 114
+	// @xml: "IT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_IT CurrentTerritoryCode = 115
+	// @xml: "JE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JE CurrentTerritoryCode = 116
+	// @xml: "JM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JM CurrentTerritoryCode = 117
+	// @xml: "JO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JO CurrentTerritoryCode = 118
+	// @xml: "JP"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_JP CurrentTerritoryCode = 119
+	// @xml: "KE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KE CurrentTerritoryCode = 120
+	// @xml: "KG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KG CurrentTerritoryCode = 121
+	// @xml: "KH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KH CurrentTerritoryCode = 122
+	// @xml: "KI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KI CurrentTerritoryCode = 123
+	// @xml: "KM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KM CurrentTerritoryCode = 124
+	// @xml: "KN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KN CurrentTerritoryCode = 125
+	// @xml: "KP"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KP CurrentTerritoryCode = 126
+	// @xml: "KR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KR CurrentTerritoryCode = 127
+	// @xml: "KW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KW CurrentTerritoryCode = 128
+	// @xml: "KY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KY CurrentTerritoryCode = 129
+	// @xml: "KZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_KZ CurrentTerritoryCode = 130
+	// @xml: "LA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LA CurrentTerritoryCode = 131
+	// @xml: "LB"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LB CurrentTerritoryCode = 132
+	// @xml: "LC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LC CurrentTerritoryCode = 133
+	// @xml: "LI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LI CurrentTerritoryCode = 134
+	// @xml: "LK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LK CurrentTerritoryCode = 135
+	// @xml: "LR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LR CurrentTerritoryCode = 136
+	// @xml: "LS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LS CurrentTerritoryCode = 137
+	// @xml: "LT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LT CurrentTerritoryCode = 138
+	// @xml: "LU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LU CurrentTerritoryCode = 139
+	// @xml: "LV"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LV CurrentTerritoryCode = 140
+	// @xml: "LY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_LY CurrentTerritoryCode = 141
+	// @xml: "MA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MA CurrentTerritoryCode = 142
+	// @xml: "MC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MC CurrentTerritoryCode = 143
+	// @xml: "MD"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MD CurrentTerritoryCode = 144
+	// @xml: "ME"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ME CurrentTerritoryCode = 145
+	// @xml: "MF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MF CurrentTerritoryCode = 146
+	// @xml: "MG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MG CurrentTerritoryCode = 147
+	// @xml: "MH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MH CurrentTerritoryCode = 148
+	// @xml: "MK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MK CurrentTerritoryCode = 149
+	// @xml: "ML"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ML CurrentTerritoryCode = 150
+	// @xml: "MM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MM CurrentTerritoryCode = 151
+	// @xml: "MN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MN CurrentTerritoryCode = 152
+	// @xml: "MO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MO CurrentTerritoryCode = 153
+	// @xml: "MP"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MP CurrentTerritoryCode = 154
+	// @xml: "MQ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MQ CurrentTerritoryCode = 155
+	// @xml: "MR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MR CurrentTerritoryCode = 156
+	// @xml: "MS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MS CurrentTerritoryCode = 157
+	// @xml: "MT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MT CurrentTerritoryCode = 158
+	// @xml: "MU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MU CurrentTerritoryCode = 159
+	// @xml: "MV"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MV CurrentTerritoryCode = 160
+	// @xml: "MW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MW CurrentTerritoryCode = 161
+	// @xml: "MX"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MX CurrentTerritoryCode = 162
+	// @xml: "MY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MY CurrentTerritoryCode = 163
+	// @xml: "MZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_MZ CurrentTerritoryCode = 164
+	// @xml: "NA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NA CurrentTerritoryCode = 165
+	// @xml: "NC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NC CurrentTerritoryCode = 166
+	// @xml: "NE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NE CurrentTerritoryCode = 167
+	// @xml: "NF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NF CurrentTerritoryCode = 168
+	// @xml: "NG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NG CurrentTerritoryCode = 169
+	// @xml: "NI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NI CurrentTerritoryCode = 170
+	// @xml: "NL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NL CurrentTerritoryCode = 171
+	// @xml: "NO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NO CurrentTerritoryCode = 172
+	// @xml: "NP"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NP CurrentTerritoryCode = 173
+	// @xml: "NR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NR CurrentTerritoryCode = 174
+	// @xml: "NU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NU CurrentTerritoryCode = 175
+	// @xml: "NZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_NZ CurrentTerritoryCode = 176
+	// @xml: "OM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_OM CurrentTerritoryCode = 177
+	// @xml: "PA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PA CurrentTerritoryCode = 178
+	// @xml: "PE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PE CurrentTerritoryCode = 179
+	// @xml: "PF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PF CurrentTerritoryCode = 180
+	// @xml: "PG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PG CurrentTerritoryCode = 181
+	// @xml: "PH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PH CurrentTerritoryCode = 182
+	// @xml: "PK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PK CurrentTerritoryCode = 183
+	// @xml: "PL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PL CurrentTerritoryCode = 184
+	// @xml: "PM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PM CurrentTerritoryCode = 185
+	// @xml: "PN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PN CurrentTerritoryCode = 186
+	// @xml: "PR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PR CurrentTerritoryCode = 187
+	// @xml: "PS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PS CurrentTerritoryCode = 188
+	// @xml: "PT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PT CurrentTerritoryCode = 189
+	// @xml: "PW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PW CurrentTerritoryCode = 190
+	// @xml: "PY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_PY CurrentTerritoryCode = 191
+	// @xml: "QA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_QA CurrentTerritoryCode = 192
+	// @xml: "RE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RE CurrentTerritoryCode = 193
+	// @xml: "RO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RO CurrentTerritoryCode = 194
+	// @xml: "RS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RS CurrentTerritoryCode = 195
+	// @xml: "RU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RU CurrentTerritoryCode = 196
+	// @xml: "RW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_RW CurrentTerritoryCode = 197
+	// @xml: "SA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SA CurrentTerritoryCode = 198
+	// @xml: "SB"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SB CurrentTerritoryCode = 199
+	// @xml: "SC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SC CurrentTerritoryCode = 200
+	// @xml: "SD"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SD CurrentTerritoryCode = 201
+	// @xml: "SE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SE CurrentTerritoryCode = 202
+	// @xml: "SG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SG CurrentTerritoryCode = 203
+	// @xml: "SH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SH CurrentTerritoryCode = 204
+	// @xml: "SI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SI CurrentTerritoryCode = 205
+	// @xml: "SJ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SJ CurrentTerritoryCode = 206
+	// @xml: "SK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SK CurrentTerritoryCode = 207
+	// @xml: "SL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SL CurrentTerritoryCode = 208
+	// @xml: "SM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SM CurrentTerritoryCode = 209
+	// @xml: "SN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SN CurrentTerritoryCode = 210
+	// @xml: "SO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SO CurrentTerritoryCode = 211
+	// @xml: "SR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SR CurrentTerritoryCode = 212
+	// @xml: "SS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SS CurrentTerritoryCode = 213
+	// @xml: "ST"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ST CurrentTerritoryCode = 214
+	// @xml: "SV"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SV CurrentTerritoryCode = 215
+	// @xml: "SX"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SX CurrentTerritoryCode = 216
+	// @xml: "SY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SY CurrentTerritoryCode = 217
+	// @xml: "SZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_SZ CurrentTerritoryCode = 218
+	// @xml: "TC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TC CurrentTerritoryCode = 219
+	// @xml: "TD"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TD CurrentTerritoryCode = 220
+	// @xml: "TF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TF CurrentTerritoryCode = 221
+	// @xml: "TG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TG CurrentTerritoryCode = 222
+	// @xml: "TH"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TH CurrentTerritoryCode = 223
+	// @xml: "TJ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TJ CurrentTerritoryCode = 224
+	// @xml: "TK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TK CurrentTerritoryCode = 225
+	// @xml: "TL"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TL CurrentTerritoryCode = 226
+	// @xml: "TM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TM CurrentTerritoryCode = 227
+	// @xml: "TN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TN CurrentTerritoryCode = 228
+	// @xml: "TO"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TO CurrentTerritoryCode = 229
+	// @xml: "TR"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TR CurrentTerritoryCode = 230
+	// @xml: "TT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TT CurrentTerritoryCode = 231
+	// @xml: "TV"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TV CurrentTerritoryCode = 232
+	// @xml: "TW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TW CurrentTerritoryCode = 233
+	// @xml: "TZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_TZ CurrentTerritoryCode = 234
+	// @xml: "UA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UA CurrentTerritoryCode = 235
+	// @xml: "UG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UG CurrentTerritoryCode = 236
+	// @xml: "UM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UM CurrentTerritoryCode = 237
+	// @xml: "US"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_US CurrentTerritoryCode = 238
+	// @xml: "UY"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UY CurrentTerritoryCode = 239
+	// @xml: "UZ"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_UZ CurrentTerritoryCode = 240
+	// @xml: "VA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VA CurrentTerritoryCode = 241
+	// @xml: "VC"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VC CurrentTerritoryCode = 242
+	// @xml: "VE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VE CurrentTerritoryCode = 243
+	// @xml: "VG"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VG CurrentTerritoryCode = 244
+	// @xml: "VI"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VI CurrentTerritoryCode = 245
+	// @xml: "VN"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VN CurrentTerritoryCode = 246
+	// @xml: "VU"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_VU CurrentTerritoryCode = 247
+	// @xml: "WF"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WF CurrentTerritoryCode = 248
+	// @xml: "WS"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WS CurrentTerritoryCode = 249
+	// @xml: "YE"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YE CurrentTerritoryCode = 250
+	// @xml: "YT"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_YT CurrentTerritoryCode = 251
+	// @xml: "ZA"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZA CurrentTerritoryCode = 252
+	// @xml: "ZM"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZM CurrentTerritoryCode = 253
+	// @xml: "ZW"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_ZW CurrentTerritoryCode = 254
+	// @xml: "4"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_4 CurrentTerritoryCode = 255
+	// @xml: "8"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_8 CurrentTerritoryCode = 256
+	// @xml: "12"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_12 CurrentTerritoryCode = 257
+	// @xml: "20"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_20 CurrentTerritoryCode = 258
+	// @xml: "24"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_24 CurrentTerritoryCode = 259
+	// @xml: "28"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_28 CurrentTerritoryCode = 260
+	// @xml: "31"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_31 CurrentTerritoryCode = 261
+	// @xml: "32"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_32 CurrentTerritoryCode = 262
+	// @xml: "36"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_36 CurrentTerritoryCode = 263
+	// @xml: "40"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_40 CurrentTerritoryCode = 264
+	// @xml: "44"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_44 CurrentTerritoryCode = 265
+	// @xml: "48"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_48 CurrentTerritoryCode = 266
+	// @xml: "50"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_50 CurrentTerritoryCode = 267
+	// @xml: "51"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_51 CurrentTerritoryCode = 268
+	// @xml: "52"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_52 CurrentTerritoryCode = 269
+	// @xml: "56"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_56 CurrentTerritoryCode = 270
+	// @xml: "64"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_64 CurrentTerritoryCode = 271
+	// @xml: "68"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_68 CurrentTerritoryCode = 272
+	// @xml: "70"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_70 CurrentTerritoryCode = 273
+	// @xml: "72"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_72 CurrentTerritoryCode = 274
+	// @xml: "76"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_76 CurrentTerritoryCode = 275
+	// @xml: "84"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_84 CurrentTerritoryCode = 276
+	// @xml: "90"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_90 CurrentTerritoryCode = 277
+	// @xml: "96"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_96 CurrentTerritoryCode = 278
+	// @xml: "100"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_100 CurrentTerritoryCode = 279
+	// @xml: "104"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_104 CurrentTerritoryCode = 280
+	// @xml: "108"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_108 CurrentTerritoryCode = 281
+	// @xml: "112"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_112 CurrentTerritoryCode = 282
+	// @xml: "116"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_116 CurrentTerritoryCode = 283
+	// @xml: "120"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_120 CurrentTerritoryCode = 284
+	// @xml: "124"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_124 CurrentTerritoryCode = 285
+	// @xml: "132"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_132 CurrentTerritoryCode = 286
+	// @xml: "140"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_140 CurrentTerritoryCode = 287
+	// @xml: "144"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_144 CurrentTerritoryCode = 288
+	// @xml: "148"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_148 CurrentTerritoryCode = 289
+	// @xml: "152"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_152 CurrentTerritoryCode = 290
+	// @xml: "156"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_156 CurrentTerritoryCode = 291
+	// @xml: "158"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_158 CurrentTerritoryCode = 292
+	// @xml: "170"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_170 CurrentTerritoryCode = 293
+	// @xml: "174"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_174 CurrentTerritoryCode = 294
+	// @xml: "178"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_178 CurrentTerritoryCode = 295
+	// @xml: "180"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_180 CurrentTerritoryCode = 296
+	// @xml: "188"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_188 CurrentTerritoryCode = 297
+	// @xml: "191"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_191 CurrentTerritoryCode = 298
+	// @xml: "192"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_192 CurrentTerritoryCode = 299
+	// @xml: "196"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_196 CurrentTerritoryCode = 300
+	// @xml: "200"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_200 CurrentTerritoryCode = 301
+	// @xml: "203"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_203 CurrentTerritoryCode = 302
+	// @xml: "204"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_204 CurrentTerritoryCode = 303
+	// @xml: "208"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_208 CurrentTerritoryCode = 304
+	// @xml: "212"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_212 CurrentTerritoryCode = 305
+	// @xml: "214"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_214 CurrentTerritoryCode = 306
+	// @xml: "218"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_218 CurrentTerritoryCode = 307
+	// @xml: "222"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_222 CurrentTerritoryCode = 308
+	// @xml: "226"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_226 CurrentTerritoryCode = 309
+	// @xml: "230"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_230 CurrentTerritoryCode = 310
+	// @xml: "231"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_231 CurrentTerritoryCode = 311
+	// @xml: "232"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_232 CurrentTerritoryCode = 312
+	// @xml: "233"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_233 CurrentTerritoryCode = 313
+	// @xml: "242"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_242 CurrentTerritoryCode = 314
+	// @xml: "246"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_246 CurrentTerritoryCode = 315
+	// @xml: "250"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_250 CurrentTerritoryCode = 316
+	// @xml: "258"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_258 CurrentTerritoryCode = 317
+	// @xml: "262"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_262 CurrentTerritoryCode = 318
+	// @xml: "266"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_266 CurrentTerritoryCode = 319
+	// @xml: "268"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_268 CurrentTerritoryCode = 320
+	// @xml: "270"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_270 CurrentTerritoryCode = 321
+	// @xml: "276"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_276 CurrentTerritoryCode = 322
+	// @xml: "278"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_278 CurrentTerritoryCode = 323
+	// @xml: "280"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_280 CurrentTerritoryCode = 324
+	// @xml: "288"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_288 CurrentTerritoryCode = 325
+	// @xml: "296"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_296 CurrentTerritoryCode = 326
+	// @xml: "300"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_300 CurrentTerritoryCode = 327
+	// @xml: "308"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_308 CurrentTerritoryCode = 328
+	// @xml: "320"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_320 CurrentTerritoryCode = 329
+	// @xml: "324"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_324 CurrentTerritoryCode = 330
+	// @xml: "328"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_328 CurrentTerritoryCode = 331
+	// @xml: "332"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_332 CurrentTerritoryCode = 332
+	// @xml: "336"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_336 CurrentTerritoryCode = 333
+	// @xml: "340"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_340 CurrentTerritoryCode = 334
+	// @xml: "344"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_344 CurrentTerritoryCode = 335
+	// @xml: "348"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_348 CurrentTerritoryCode = 336
+	// @xml: "352"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_352 CurrentTerritoryCode = 337
+	// @xml: "356"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_356 CurrentTerritoryCode = 338
+	// @xml: "360"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_360 CurrentTerritoryCode = 339
+	// @xml: "364"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_364 CurrentTerritoryCode = 340
+	// @xml: "368"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_368 CurrentTerritoryCode = 341
+	// @xml: "372"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_372 CurrentTerritoryCode = 342
+	// @xml: "376"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_376 CurrentTerritoryCode = 343
+	// @xml: "380"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_380 CurrentTerritoryCode = 344
+	// @xml: "384"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_384 CurrentTerritoryCode = 345
+	// @xml: "388"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_388 CurrentTerritoryCode = 346
+	// @xml: "392"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_392 CurrentTerritoryCode = 347
+	// @xml: "398"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_398 CurrentTerritoryCode = 348
+	// @xml: "400"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_400 CurrentTerritoryCode = 349
+	// @xml: "404"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_404 CurrentTerritoryCode = 350
+	// @xml: "408"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_408 CurrentTerritoryCode = 351
+	// @xml: "410"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_410 CurrentTerritoryCode = 352
+	// @xml: "414"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_414 CurrentTerritoryCode = 353
+	// @xml: "417"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_417 CurrentTerritoryCode = 354
+	// @xml: "418"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_418 CurrentTerritoryCode = 355
+	// @xml: "422"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_422 CurrentTerritoryCode = 356
+	// @xml: "426"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_426 CurrentTerritoryCode = 357
+	// @xml: "428"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_428 CurrentTerritoryCode = 358
+	// @xml: "430"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_430 CurrentTerritoryCode = 359
+	// @xml: "434"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_434 CurrentTerritoryCode = 360
+	// @xml: "438"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_438 CurrentTerritoryCode = 361
+	// @xml: "440"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_440 CurrentTerritoryCode = 362
+	// @xml: "442"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_442 CurrentTerritoryCode = 363
+	// @xml: "446"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_446 CurrentTerritoryCode = 364
+	// @xml: "450"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_450 CurrentTerritoryCode = 365
+	// @xml: "454"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_454 CurrentTerritoryCode = 366
+	// @xml: "458"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_458 CurrentTerritoryCode = 367
+	// @xml: "462"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_462 CurrentTerritoryCode = 368
+	// @xml: "466"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_466 CurrentTerritoryCode = 369
+	// @xml: "470"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_470 CurrentTerritoryCode = 370
+	// @xml: "478"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_478 CurrentTerritoryCode = 371
+	// @xml: "480"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_480 CurrentTerritoryCode = 372
+	// @xml: "484"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_484 CurrentTerritoryCode = 373
+	// @xml: "492"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_492 CurrentTerritoryCode = 374
+	// @xml: "496"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_496 CurrentTerritoryCode = 375
+	// @xml: "498"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_498 CurrentTerritoryCode = 376
+	// @xml: "499"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_499 CurrentTerritoryCode = 377
+	// @xml: "504"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_504 CurrentTerritoryCode = 378
+	// @xml: "508"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_508 CurrentTerritoryCode = 379
+	// @xml: "512"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_512 CurrentTerritoryCode = 380
+	// @xml: "516"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_516 CurrentTerritoryCode = 381
+	// @xml: "520"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_520 CurrentTerritoryCode = 382
+	// @xml: "524"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_524 CurrentTerritoryCode = 383
+	// @xml: "528"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_528 CurrentTerritoryCode = 384
+	// @xml: "540"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_540 CurrentTerritoryCode = 385
+	// @xml: "548"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_548 CurrentTerritoryCode = 386
+	// @xml: "554"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_554 CurrentTerritoryCode = 387
+	// @xml: "558"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_558 CurrentTerritoryCode = 388
+	// @xml: "562"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_562 CurrentTerritoryCode = 389
+	// @xml: "566"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_566 CurrentTerritoryCode = 390
+	// @xml: "578"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_578 CurrentTerritoryCode = 391
+	// @xml: "583"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_583 CurrentTerritoryCode = 392
+	// @xml: "584"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_584 CurrentTerritoryCode = 393
+	// @xml: "585"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_585 CurrentTerritoryCode = 394
+	// @xml: "586"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_586 CurrentTerritoryCode = 395
+	// @xml: "591"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_591 CurrentTerritoryCode = 396
+	// @xml: "598"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_598 CurrentTerritoryCode = 397
+	// @xml: "600"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_600 CurrentTerritoryCode = 398
+	// @xml: "604"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_604 CurrentTerritoryCode = 399
+	// @xml: "608"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_608 CurrentTerritoryCode = 400
+	// @xml: "616"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_616 CurrentTerritoryCode = 401
+	// @xml: "620"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_620 CurrentTerritoryCode = 402
+	// @xml: "624"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_624 CurrentTerritoryCode = 403
+	// @xml: "626"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_626 CurrentTerritoryCode = 404
+	// @xml: "630"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_630 CurrentTerritoryCode = 405
+	// @xml: "634"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_634 CurrentTerritoryCode = 406
+	// @xml: "642"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_642 CurrentTerritoryCode = 407
+	// @xml: "643"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_643 CurrentTerritoryCode = 408
+	// @xml: "646"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_646 CurrentTerritoryCode = 409
+	// @xml: "659"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_659 CurrentTerritoryCode = 410
+	// @xml: "662"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_662 CurrentTerritoryCode = 411
+	// @xml: "670"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_670 CurrentTerritoryCode = 412
+	// @xml: "674"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_674 CurrentTerritoryCode = 413
+	// @xml: "678"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_678 CurrentTerritoryCode = 414
+	// @xml: "682"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_682 CurrentTerritoryCode = 415
+	// @xml: "686"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_686 CurrentTerritoryCode = 416
+	// @xml: "688"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_688 CurrentTerritoryCode = 417
+	// @xml: "690"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_690 CurrentTerritoryCode = 418
+	// @xml: "694"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_694 CurrentTerritoryCode = 419
+	// @xml: "702"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_702 CurrentTerritoryCode = 420
+	// @xml: "703"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_703 CurrentTerritoryCode = 421
+	// @xml: "704"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_704 CurrentTerritoryCode = 422
+	// @xml: "705"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_705 CurrentTerritoryCode = 423
+	// @xml: "706"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_706 CurrentTerritoryCode = 424
+	// @xml: "710"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_710 CurrentTerritoryCode = 425
+	// @xml: "716"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_716 CurrentTerritoryCode = 426
+	// @xml: "720"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_720 CurrentTerritoryCode = 427
+	// @xml: "724"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_724 CurrentTerritoryCode = 428
+	// @xml: "728"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_728 CurrentTerritoryCode = 429
+	// @xml: "729"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_729 CurrentTerritoryCode = 430
+	// @xml: "732"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_732 CurrentTerritoryCode = 431
+	// @xml: "736"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_736 CurrentTerritoryCode = 432
+	// @xml: "740"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_740 CurrentTerritoryCode = 433
+	// @xml: "748"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_748 CurrentTerritoryCode = 434
+	// @xml: "752"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_752 CurrentTerritoryCode = 435
+	// @xml: "756"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_756 CurrentTerritoryCode = 436
+	// @xml: "760"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_760 CurrentTerritoryCode = 437
+	// @xml: "762"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_762 CurrentTerritoryCode = 438
+	// @xml: "764"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_764 CurrentTerritoryCode = 439
+	// @xml: "768"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_768 CurrentTerritoryCode = 440
+	// @xml: "776"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_776 CurrentTerritoryCode = 441
+	// @xml: "780"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_780 CurrentTerritoryCode = 442
+	// @xml: "784"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_784 CurrentTerritoryCode = 443
+	// @xml: "788"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_788 CurrentTerritoryCode = 444
+	// @xml: "792"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_792 CurrentTerritoryCode = 445
+	// @xml: "795"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_795 CurrentTerritoryCode = 446
+	// @xml: "798"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_798 CurrentTerritoryCode = 447
+	// @xml: "800"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_800 CurrentTerritoryCode = 448
+	// @xml: "804"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_804 CurrentTerritoryCode = 449
+	// @xml: "807"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_807 CurrentTerritoryCode = 450
+	// @xml: "810"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_810 CurrentTerritoryCode = 451
+	// @xml: "818"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_818 CurrentTerritoryCode = 452
+	// @xml: "826"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_826 CurrentTerritoryCode = 453
+	// @xml: "834"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_834 CurrentTerritoryCode = 454
+	// @xml: "840"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_840 CurrentTerritoryCode = 455
+	// @xml: "854"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_854 CurrentTerritoryCode = 456
+	// @xml: "858"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_858 CurrentTerritoryCode = 457
+	// @xml: "860"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_860 CurrentTerritoryCode = 458
+	// @xml: "862"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_862 CurrentTerritoryCode = 459
+	// @xml: "882"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_882 CurrentTerritoryCode = 460
+	// @xml: "886"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_886 CurrentTerritoryCode = 461
+	// @xml: "887"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_887 CurrentTerritoryCode = 462
+	// @xml: "890"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_890 CurrentTerritoryCode = 463
+	// @xml: "891"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_891 CurrentTerritoryCode = 464
+	// @xml: "894"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_894 CurrentTerritoryCode = 465
+	// @xml: "2100"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2100 CurrentTerritoryCode = 466
+	// @xml: "2101"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2101 CurrentTerritoryCode = 467
+	// @xml: "2102"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2102 CurrentTerritoryCode = 468
+	// @xml: "2103"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2103 CurrentTerritoryCode = 469
+	// @xml: "2104"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2104 CurrentTerritoryCode = 470
+	// @xml: "2105"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2105 CurrentTerritoryCode = 471
+	// @xml: "2106"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2106 CurrentTerritoryCode = 472
+	// @xml: "2107"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2107 CurrentTerritoryCode = 473
+	// @xml: "2108"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2108 CurrentTerritoryCode = 474
+	// @xml: "2109"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2109 CurrentTerritoryCode = 475
+	// @xml: "2110"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2110 CurrentTerritoryCode = 476
+	// @xml: "2111"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2111 CurrentTerritoryCode = 477
+	// @xml: "2112"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2112 CurrentTerritoryCode = 478
+	// @xml: "2113"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2113 CurrentTerritoryCode = 479
+	// @xml: "2114"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2114 CurrentTerritoryCode = 480
+	// @xml: "2115"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2115 CurrentTerritoryCode = 481
+	// @xml: "2116"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2116 CurrentTerritoryCode = 482
+	// @xml: "2117"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2117 CurrentTerritoryCode = 483
+	// @xml: "2118"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2118 CurrentTerritoryCode = 484
+	// @xml: "2119"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2119 CurrentTerritoryCode = 485
+	// @xml: "2120"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2120 CurrentTerritoryCode = 486
+	// @xml: "2121"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2121 CurrentTerritoryCode = 487
+	// @xml: "2122"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2122 CurrentTerritoryCode = 488
+	// @xml: "2123"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2123 CurrentTerritoryCode = 489
+	// @xml: "2124"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2124 CurrentTerritoryCode = 490
+	// @xml: "2125"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2125 CurrentTerritoryCode = 491
+	// @xml: "2126"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2126 CurrentTerritoryCode = 492
+	// @xml: "2127"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2127 CurrentTerritoryCode = 493
+	// @xml: "2128"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2128 CurrentTerritoryCode = 494
+	// @xml: "2129"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2129 CurrentTerritoryCode = 495
+	// @xml: "2130"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2130 CurrentTerritoryCode = 496
+	// @xml: "2131"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2131 CurrentTerritoryCode = 497
+	// @xml: "2132"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2132 CurrentTerritoryCode = 498
+	// @xml: "2133"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2133 CurrentTerritoryCode = 499
+	// @xml: "2134"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2134 CurrentTerritoryCode = 500
+	// @xml: "2136"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_E_2136 CurrentTerritoryCode = 501
+	// @xml: "XK"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_XK CurrentTerritoryCode = 502
+	// @xml: "Worldwide"
+	CurrentTerritoryCode_CURRENT_TERRITORY_CODE_WORLDWIDE CurrentTerritoryCode = 503
 )
 
 // Enum value maps for CurrentTerritoryCode.
@@ -5458,13 +6957,19 @@ func (CurrentTerritoryCode) EnumDescriptor() ([]byte, []int) {
 type DataMismatchResponseType int32
 
 const (
-	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_UNSPECIFIED                         DataMismatchResponseType = 0
-	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_ADDITIONALINFORMATIONONLY           DataMismatchResponseType = 1
-	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHCONFIRMATION            DataMismatchResponseType = 2
-	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHOUTOFSCOPE              DataMismatchResponseType = 3
+	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_UNSPECIFIED DataMismatchResponseType = 0
+	// @xml: "AdditionalInformationOnly"
+	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_ADDITIONALINFORMATIONONLY DataMismatchResponseType = 1
+	// @xml: "DataMismatchConfirmation"
+	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHCONFIRMATION DataMismatchResponseType = 2
+	// @xml: "DataMismatchOutOfScope"
+	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHOUTOFSCOPE DataMismatchResponseType = 3
+	// @xml: "DataMismatchRaisedCommercialDispute"
 	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_DATAMISMATCHRAISEDCOMMERCIALDISPUTE DataMismatchResponseType = 4
-	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_NOREACTION                          DataMismatchResponseType = 5
-	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_USERDEFINED                         DataMismatchResponseType = 6
+	// @xml: "NoReaction"
+	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_NOREACTION DataMismatchResponseType = 5
+	// @xml: "UserDefined"
+	DataMismatchResponseType_DATA_MISMATCH_RESPONSE_TYPE_USERDEFINED DataMismatchResponseType = 6
 )
 
 // Enum value maps for DataMismatchResponseType.
@@ -5519,12 +7024,17 @@ func (DataMismatchResponseType) EnumDescriptor() ([]byte, []int) {
 type DataMismatchStatus int32
 
 const (
-	DataMismatchStatus_DATA_MISMATCH_STATUS_UNSPECIFIED               DataMismatchStatus = 0
+	DataMismatchStatus_DATA_MISMATCH_STATUS_UNSPECIFIED DataMismatchStatus = 0
+	// @xml: "AdditionalInformationOnly"
 	DataMismatchStatus_DATA_MISMATCH_STATUS_ADDITIONALINFORMATIONONLY DataMismatchStatus = 1
-	DataMismatchStatus_DATA_MISMATCH_STATUS_CORRECTED                 DataMismatchStatus = 2
-	DataMismatchStatus_DATA_MISMATCH_STATUS_FATAL                     DataMismatchStatus = 3
-	DataMismatchStatus_DATA_MISMATCH_STATUS_NOTCORRECTED              DataMismatchStatus = 4
-	DataMismatchStatus_DATA_MISMATCH_STATUS_USERDEFINED               DataMismatchStatus = 5
+	// @xml: "Corrected"
+	DataMismatchStatus_DATA_MISMATCH_STATUS_CORRECTED DataMismatchStatus = 2
+	// @xml: "Fatal"
+	DataMismatchStatus_DATA_MISMATCH_STATUS_FATAL DataMismatchStatus = 3
+	// @xml: "NotCorrected"
+	DataMismatchStatus_DATA_MISMATCH_STATUS_NOTCORRECTED DataMismatchStatus = 4
+	// @xml: "UserDefined"
+	DataMismatchStatus_DATA_MISMATCH_STATUS_USERDEFINED DataMismatchStatus = 5
 )
 
 // Enum value maps for DataMismatchStatus.
@@ -5577,28 +7087,49 @@ func (DataMismatchStatus) EnumDescriptor() ([]byte, []int) {
 type DataMismatchType int32
 
 const (
-	DataMismatchType_DATA_MISMATCH_TYPE_UNSPECIFIED                                         DataMismatchType = 0
-	DataMismatchType_DATA_MISMATCH_TYPE_ADDITIONALINFORMATIONONLY                           DataMismatchType = 1
-	DataMismatchType_DATA_MISMATCH_TYPE_CHOREOGRAPHYCONFLICT                                DataMismatchType = 2
-	DataMismatchType_DATA_MISMATCH_TYPE_CONTRADICTORYDATA                                   DataMismatchType = 3
-	DataMismatchType_DATA_MISMATCH_TYPE_DUPLICATEDDATA                                      DataMismatchType = 4
-	DataMismatchType_DATA_MISMATCH_TYPE_IDENTIFIERSYNTAXMISMATCH                            DataMismatchType = 5
-	DataMismatchType_DATA_MISMATCH_TYPE_MATHEMATICALINCONSISTENCY                           DataMismatchType = 6
-	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGCONTRACTUALLYMANDATORYINFORMATION            DataMismatchType = 7
-	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGMANDATORYINFORMATION                         DataMismatchType = 8
-	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDMUSICALWORKINFORMATION             DataMismatchType = 9
-	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRELEASEINFORMATION                 DataMismatchType = 10
-	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRESOURCEINFORMATION                DataMismatchType = 11
+	DataMismatchType_DATA_MISMATCH_TYPE_UNSPECIFIED DataMismatchType = 0
+	// @xml: "AdditionalInformationOnly"
+	DataMismatchType_DATA_MISMATCH_TYPE_ADDITIONALINFORMATIONONLY DataMismatchType = 1
+	// @xml: "ChoreographyConflict"
+	DataMismatchType_DATA_MISMATCH_TYPE_CHOREOGRAPHYCONFLICT DataMismatchType = 2
+	// @xml: "ContradictoryData"
+	DataMismatchType_DATA_MISMATCH_TYPE_CONTRADICTORYDATA DataMismatchType = 3
+	// @xml: "DuplicatedData"
+	DataMismatchType_DATA_MISMATCH_TYPE_DUPLICATEDDATA DataMismatchType = 4
+	// @xml: "IdentifierSyntaxMismatch"
+	DataMismatchType_DATA_MISMATCH_TYPE_IDENTIFIERSYNTAXMISMATCH DataMismatchType = 5
+	// @xml: "MathematicalInconsistency"
+	DataMismatchType_DATA_MISMATCH_TYPE_MATHEMATICALINCONSISTENCY DataMismatchType = 6
+	// @xml: "MissingContractuallyMandatoryInformation"
+	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGCONTRACTUALLYMANDATORYINFORMATION DataMismatchType = 7
+	// @xml: "MissingMandatoryInformation"
+	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGMANDATORYINFORMATION DataMismatchType = 8
+	// @xml: "MissingReferencedMusicalWorkInformation"
+	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDMUSICALWORKINFORMATION DataMismatchType = 9
+	// @xml: "MissingReferencedReleaseInformation"
+	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRELEASEINFORMATION DataMismatchType = 10
+	// @xml: "MissingReferencedResourceInformation"
+	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDRESOURCEINFORMATION DataMismatchType = 11
+	// @xml: "MissingReferencedTechnicalResourceDetailInformation"
 	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGREFERENCEDTECHNICALRESOURCEDETAILINFORMATION DataMismatchType = 12
-	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGRESOURCEFILE                                 DataMismatchType = 13
-	DataMismatchType_DATA_MISMATCH_TYPE_TYPOGRAPHICMISMATCH                                 DataMismatchType = 14
-	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDALLOWEDVALUE                              DataMismatchType = 15
-	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGEINTERMEDIARY                       DataMismatchType = 16
-	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGERECIPIENT                          DataMismatchType = 17
-	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGESENDER                             DataMismatchType = 18
-	DataMismatchType_DATA_MISMATCH_TYPE_USERDEFINED                                         DataMismatchType = 19
-	DataMismatchType_DATA_MISMATCH_TYPE_XMLFORMATERROR                                      DataMismatchType = 20
-	DataMismatchType_DATA_MISMATCH_TYPE_XMLRANGEERROR                                       DataMismatchType = 21
+	// @xml: "MissingResourceFile"
+	DataMismatchType_DATA_MISMATCH_TYPE_MISSINGRESOURCEFILE DataMismatchType = 13
+	// @xml: "TypographicMismatch"
+	DataMismatchType_DATA_MISMATCH_TYPE_TYPOGRAPHICMISMATCH DataMismatchType = 14
+	// @xml: "UnexpectedAllowedValue"
+	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDALLOWEDVALUE DataMismatchType = 15
+	// @xml: "UnexpectedMessageIntermediary"
+	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGEINTERMEDIARY DataMismatchType = 16
+	// @xml: "UnexpectedMessageRecipient"
+	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGERECIPIENT DataMismatchType = 17
+	// @xml: "UnexpectedMessageSender"
+	DataMismatchType_DATA_MISMATCH_TYPE_UNEXPECTEDMESSAGESENDER DataMismatchType = 18
+	// @xml: "UserDefined"
+	DataMismatchType_DATA_MISMATCH_TYPE_USERDEFINED DataMismatchType = 19
+	// @xml: "XmlFormatError"
+	DataMismatchType_DATA_MISMATCH_TYPE_XMLFORMATERROR DataMismatchType = 20
+	// @xml: "XmlRangeError"
+	DataMismatchType_DATA_MISMATCH_TYPE_XMLRANGEERROR DataMismatchType = 21
 )
 
 // Enum value maps for DataMismatchType.
@@ -5684,8 +7215,10 @@ type DdexTerritoryCode int32
 
 const (
 	DdexTerritoryCode_DDEX_TERRITORY_CODE_UNSPECIFIED DdexTerritoryCode = 0
-	DdexTerritoryCode_DDEX_TERRITORY_CODE_XK          DdexTerritoryCode = 1
-	DdexTerritoryCode_DDEX_TERRITORY_CODE_WORLDWIDE   DdexTerritoryCode = 2
+	// @xml: "XK"
+	DdexTerritoryCode_DDEX_TERRITORY_CODE_XK DdexTerritoryCode = 1
+	// @xml: "Worldwide"
+	DdexTerritoryCode_DDEX_TERRITORY_CODE_WORLDWIDE DdexTerritoryCode = 2
 )
 
 // Enum value maps for DdexTerritoryCode.
@@ -5732,10 +7265,13 @@ func (DdexTerritoryCode) EnumDescriptor() ([]byte, []int) {
 type DeductionRateType int32
 
 const (
-	DeductionRateType_DEDUCTION_RATE_TYPE_UNSPECIFIED    DeductionRateType = 0
-	DeductionRateType_DEDUCTION_RATE_TYPE_PENNYRATE      DeductionRateType = 1
+	DeductionRateType_DEDUCTION_RATE_TYPE_UNSPECIFIED DeductionRateType = 0
+	// @xml: "PennyRate"
+	DeductionRateType_DEDUCTION_RATE_TYPE_PENNYRATE DeductionRateType = 1
+	// @xml: "PercentageRate"
 	DeductionRateType_DEDUCTION_RATE_TYPE_PERCENTAGERATE DeductionRateType = 2
-	DeductionRateType_DEDUCTION_RATE_TYPE_USERDEFINED    DeductionRateType = 3
+	// @xml: "UserDefined"
+	DeductionRateType_DEDUCTION_RATE_TYPE_USERDEFINED DeductionRateType = 3
 )
 
 // Enum value maps for DeductionRateType.
@@ -5784,11 +7320,15 @@ func (DeductionRateType) EnumDescriptor() ([]byte, []int) {
 type DeliveryActionType int32
 
 const (
-	DeliveryActionType_DELIVERY_ACTION_TYPE_UNSPECIFIED                       DeliveryActionType = 0
-	DeliveryActionType_DELIVERY_ACTION_TYPE_CHANGEDELIVERYLIMITS              DeliveryActionType = 1
-	DeliveryActionType_DELIVERY_ACTION_TYPE_RESTARTDELIVERYWITHLIMITS         DeliveryActionType = 2
+	DeliveryActionType_DELIVERY_ACTION_TYPE_UNSPECIFIED DeliveryActionType = 0
+	// @xml: "ChangeDeliveryLimits"
+	DeliveryActionType_DELIVERY_ACTION_TYPE_CHANGEDELIVERYLIMITS DeliveryActionType = 1
+	// @xml: "RestartDeliveryWithLimits"
+	DeliveryActionType_DELIVERY_ACTION_TYPE_RESTARTDELIVERYWITHLIMITS DeliveryActionType = 2
+	// @xml: "RestartDeliveryWithPreviousLimits"
 	DeliveryActionType_DELIVERY_ACTION_TYPE_RESTARTDELIVERYWITHPREVIOUSLIMITS DeliveryActionType = 3
-	DeliveryActionType_DELIVERY_ACTION_TYPE_STOPDELIVERY                      DeliveryActionType = 4
+	// @xml: "StopDelivery"
+	DeliveryActionType_DELIVERY_ACTION_TYPE_STOPDELIVERY DeliveryActionType = 4
 )
 
 // Enum value maps for DeliveryActionType.
@@ -5839,10 +7379,13 @@ func (DeliveryActionType) EnumDescriptor() ([]byte, []int) {
 type DeliveryMessageType int32
 
 const (
-	DeliveryMessageType_DELIVERY_MESSAGE_TYPE_UNSPECIFIED       DeliveryMessageType = 0
+	DeliveryMessageType_DELIVERY_MESSAGE_TYPE_UNSPECIFIED DeliveryMessageType = 0
+	// @xml: "NewReleaseMessage"
 	DeliveryMessageType_DELIVERY_MESSAGE_TYPE_NEWRELEASEMESSAGE DeliveryMessageType = 1
-	DeliveryMessageType_DELIVERY_MESSAGE_TYPE_NONDDEXMESSAGE    DeliveryMessageType = 2
-	DeliveryMessageType_DELIVERY_MESSAGE_TYPE_UNKNOWN           DeliveryMessageType = 3
+	// @xml: "NonDdexMessage"
+	DeliveryMessageType_DELIVERY_MESSAGE_TYPE_NONDDEXMESSAGE DeliveryMessageType = 2
+	// @xml: "Unknown"
+	DeliveryMessageType_DELIVERY_MESSAGE_TYPE_UNKNOWN DeliveryMessageType = 3
 )
 
 // Enum value maps for DeliveryMessageType.
@@ -5892,17 +7435,28 @@ type DeprecatedCurrencyCode int32
 
 const (
 	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_UNSPECIFIED DeprecatedCurrencyCode = 0
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_CYP         DeprecatedCurrencyCode = 1
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_EEK         DeprecatedCurrencyCode = 2
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MTL         DeprecatedCurrencyCode = 3
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_ROL         DeprecatedCurrencyCode = 4
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SIT         DeprecatedCurrencyCode = 5
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SKK         DeprecatedCurrencyCode = 6
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LTL         DeprecatedCurrencyCode = 7
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LVL         DeprecatedCurrencyCode = 8
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MRO         DeprecatedCurrencyCode = 9
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_STD         DeprecatedCurrencyCode = 10
-	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_VEF         DeprecatedCurrencyCode = 11
+	// @xml: "CYP"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_CYP DeprecatedCurrencyCode = 1
+	// @xml: "EEK"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_EEK DeprecatedCurrencyCode = 2
+	// @xml: "MTL"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MTL DeprecatedCurrencyCode = 3
+	// @xml: "ROL"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_ROL DeprecatedCurrencyCode = 4
+	// @xml: "SIT"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SIT DeprecatedCurrencyCode = 5
+	// @xml: "SKK"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_SKK DeprecatedCurrencyCode = 6
+	// @xml: "LTL"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LTL DeprecatedCurrencyCode = 7
+	// @xml: "LVL"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_LVL DeprecatedCurrencyCode = 8
+	// @xml: "MRO"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_MRO DeprecatedCurrencyCode = 9
+	// @xml: "STD"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_STD DeprecatedCurrencyCode = 10
+	// @xml: "VEF"
+	DeprecatedCurrencyCode_DEPRECATED_CURRENCY_CODE_VEF DeprecatedCurrencyCode = 11
 )
 
 // Enum value maps for DeprecatedCurrencyCode.
@@ -5968,37 +7522,68 @@ type DeprecatedIsoTerritoryCode int32
 
 const (
 	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_UNSPECIFIED DeprecatedIsoTerritoryCode = 0
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_AIDJ        DeprecatedIsoTerritoryCode = 1
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ANHH        DeprecatedIsoTerritoryCode = 2
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BQAQ        DeprecatedIsoTerritoryCode = 3
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BUMM        DeprecatedIsoTerritoryCode = 4
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BYAA        DeprecatedIsoTerritoryCode = 5
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSHH        DeprecatedIsoTerritoryCode = 6
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSXX        DeprecatedIsoTerritoryCode = 7
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CTKI        DeprecatedIsoTerritoryCode = 8
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DDDE        DeprecatedIsoTerritoryCode = 9
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DYBJ        DeprecatedIsoTerritoryCode = 10
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FQHH        DeprecatedIsoTerritoryCode = 11
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FXFR        DeprecatedIsoTerritoryCode = 12
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_GEHH        DeprecatedIsoTerritoryCode = 13
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_HVBF        DeprecatedIsoTerritoryCode = 14
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_JTUM        DeprecatedIsoTerritoryCode = 15
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_MIUM        DeprecatedIsoTerritoryCode = 16
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NHVU        DeprecatedIsoTerritoryCode = 17
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NQAQ        DeprecatedIsoTerritoryCode = 18
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NTHH        DeprecatedIsoTerritoryCode = 19
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PCHH        DeprecatedIsoTerritoryCode = 20
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PUUM        DeprecatedIsoTerritoryCode = 21
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PZPA        DeprecatedIsoTerritoryCode = 22
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_RHZW        DeprecatedIsoTerritoryCode = 23
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SKIN        DeprecatedIsoTerritoryCode = 24
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SUHH        DeprecatedIsoTerritoryCode = 25
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_TPTL        DeprecatedIsoTerritoryCode = 26
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_VDVN        DeprecatedIsoTerritoryCode = 27
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_WKUM        DeprecatedIsoTerritoryCode = 28
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YDYE        DeprecatedIsoTerritoryCode = 29
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YUCS        DeprecatedIsoTerritoryCode = 30
-	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ZRCD        DeprecatedIsoTerritoryCode = 31
+	// @xml: "AIDJ"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_AIDJ DeprecatedIsoTerritoryCode = 1
+	// @xml: "ANHH"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ANHH DeprecatedIsoTerritoryCode = 2
+	// @xml: "BQAQ"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BQAQ DeprecatedIsoTerritoryCode = 3
+	// @xml: "BUMM"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BUMM DeprecatedIsoTerritoryCode = 4
+	// @xml: "BYAA"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_BYAA DeprecatedIsoTerritoryCode = 5
+	// @xml: "CSHH"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSHH DeprecatedIsoTerritoryCode = 6
+	// @xml: "CSXX"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CSXX DeprecatedIsoTerritoryCode = 7
+	// @xml: "CTKI"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_CTKI DeprecatedIsoTerritoryCode = 8
+	// @xml: "DDDE"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DDDE DeprecatedIsoTerritoryCode = 9
+	// @xml: "DYBJ"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_DYBJ DeprecatedIsoTerritoryCode = 10
+	// @xml: "FQHH"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FQHH DeprecatedIsoTerritoryCode = 11
+	// @xml: "FXFR"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_FXFR DeprecatedIsoTerritoryCode = 12
+	// @xml: "GEHH"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_GEHH DeprecatedIsoTerritoryCode = 13
+	// @xml: "HVBF"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_HVBF DeprecatedIsoTerritoryCode = 14
+	// @xml: "JTUM"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_JTUM DeprecatedIsoTerritoryCode = 15
+	// @xml: "MIUM"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_MIUM DeprecatedIsoTerritoryCode = 16
+	// @xml: "NHVU"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NHVU DeprecatedIsoTerritoryCode = 17
+	// @xml: "NQAQ"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NQAQ DeprecatedIsoTerritoryCode = 18
+	// @xml: "NTHH"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_NTHH DeprecatedIsoTerritoryCode = 19
+	// @xml: "PCHH"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PCHH DeprecatedIsoTerritoryCode = 20
+	// @xml: "PUUM"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PUUM DeprecatedIsoTerritoryCode = 21
+	// @xml: "PZPA"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_PZPA DeprecatedIsoTerritoryCode = 22
+	// @xml: "RHZW"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_RHZW DeprecatedIsoTerritoryCode = 23
+	// @xml: "SKIN"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SKIN DeprecatedIsoTerritoryCode = 24
+	// @xml: "SUHH"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_SUHH DeprecatedIsoTerritoryCode = 25
+	// @xml: "TPTL"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_TPTL DeprecatedIsoTerritoryCode = 26
+	// @xml: "VDVN"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_VDVN DeprecatedIsoTerritoryCode = 27
+	// @xml: "WKUM"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_WKUM DeprecatedIsoTerritoryCode = 28
+	// @xml: "YDYE"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YDYE DeprecatedIsoTerritoryCode = 29
+	// @xml: "YUCS"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_YUCS DeprecatedIsoTerritoryCode = 30
+	// @xml: "ZRCD"
+	DeprecatedIsoTerritoryCode_DEPRECATED_ISO_TERRITORY_CODE_ZRCD DeprecatedIsoTerritoryCode = 31
 )
 
 // Enum value maps for DeprecatedIsoTerritoryCode.
@@ -6104,10 +7689,14 @@ type DigitizationMode int32
 
 const (
 	DigitizationMode_DIGITIZATION_MODE_UNSPECIFIED DigitizationMode = 0
-	DigitizationMode_DIGITIZATION_MODE_AAD         DigitizationMode = 1
-	DigitizationMode_DIGITIZATION_MODE_ADD         DigitizationMode = 2
-	DigitizationMode_DIGITIZATION_MODE_DDD         DigitizationMode = 3
-	DigitizationMode_DIGITIZATION_MODE_UNKNOWN     DigitizationMode = 4
+	// @xml: "AAD"
+	DigitizationMode_DIGITIZATION_MODE_AAD DigitizationMode = 1
+	// @xml: "ADD"
+	DigitizationMode_DIGITIZATION_MODE_ADD DigitizationMode = 2
+	// @xml: "DDD"
+	DigitizationMode_DIGITIZATION_MODE_DDD DigitizationMode = 3
+	// @xml: "Unknown"
+	DigitizationMode_DIGITIZATION_MODE_UNKNOWN DigitizationMode = 4
 )
 
 // Enum value maps for DigitizationMode.
@@ -6158,11 +7747,15 @@ func (DigitizationMode) EnumDescriptor() ([]byte, []int) {
 type DisputeReason int32
 
 const (
-	DisputeReason_DISPUTE_REASON_UNSPECIFIED              DisputeReason = 0
-	DisputeReason_DISPUTE_REASON_MISSINGINFORMATION       DisputeReason = 1
+	DisputeReason_DISPUTE_REASON_UNSPECIFIED DisputeReason = 0
+	// @xml: "MissingInformation"
+	DisputeReason_DISPUTE_REASON_MISSINGINFORMATION DisputeReason = 1
+	// @xml: "NotPartOfCatalogTransfer"
 	DisputeReason_DISPUTE_REASON_NOTPARTOFCATALOGTRANSFER DisputeReason = 2
-	DisputeReason_DISPUTE_REASON_MORERESEARCHNEEDED       DisputeReason = 3
-	DisputeReason_DISPUTE_REASON_USERDEFINED              DisputeReason = 4
+	// @xml: "MoreResearchNeeded"
+	DisputeReason_DISPUTE_REASON_MORERESEARCHNEEDED DisputeReason = 3
+	// @xml: "UserDefined"
+	DisputeReason_DISPUTE_REASON_USERDEFINED DisputeReason = 4
 )
 
 // Enum value maps for DisputeReason.
@@ -6213,23 +7806,39 @@ func (DisputeReason) EnumDescriptor() ([]byte, []int) {
 type DistributionChannelType int32
 
 const (
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_UNSPECIFIED       DistributionChannelType = 0
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ASPERCONTRACT     DistributionChannelType = 1
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_BROADCAST         DistributionChannelType = 2
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_CABLE             DistributionChannelType = 3
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_INTERNET          DistributionChannelType = 4
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_UNSPECIFIED DistributionChannelType = 0
+	// @xml: "AsPerContract"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ASPERCONTRACT DistributionChannelType = 1
+	// @xml: "Broadcast"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_BROADCAST DistributionChannelType = 2
+	// @xml: "Cable"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_CABLE DistributionChannelType = 3
+	// @xml: "Internet"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_INTERNET DistributionChannelType = 4
+	// @xml: "InternetAndMobile"
 	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_INTERNETANDMOBILE DistributionChannelType = 5
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_IPTV              DistributionChannelType = 6
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_MOBILETELEPHONE   DistributionChannelType = 7
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_NARROWCAST        DistributionChannelType = 8
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ONDEMANDSTREAM    DistributionChannelType = 9
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PEERTOPEER        DistributionChannelType = 10
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PHYSICAL          DistributionChannelType = 11
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SATELLITE         DistributionChannelType = 12
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SIMULCAST         DistributionChannelType = 13
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_UNKNOWN           DistributionChannelType = 14
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_USERDEFINED       DistributionChannelType = 15
-	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_WEBCAST           DistributionChannelType = 16
+	// @xml: "IPTV"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_IPTV DistributionChannelType = 6
+	// @xml: "MobileTelephone"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_MOBILETELEPHONE DistributionChannelType = 7
+	// @xml: "Narrowcast"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_NARROWCAST DistributionChannelType = 8
+	// @xml: "OnDemandStream"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_ONDEMANDSTREAM DistributionChannelType = 9
+	// @xml: "PeerToPeer"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PEERTOPEER DistributionChannelType = 10
+	// @xml: "Physical"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_PHYSICAL DistributionChannelType = 11
+	// @xml: "Satellite"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SATELLITE DistributionChannelType = 12
+	// @xml: "Simulcast"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_SIMULCAST DistributionChannelType = 13
+	// @xml: "Unknown"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_UNKNOWN DistributionChannelType = 14
+	// @xml: "UserDefined"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_USERDEFINED DistributionChannelType = 15
+	// @xml: "Webcast"
+	DistributionChannelType_DISTRIBUTION_CHANNEL_TYPE_WEBCAST DistributionChannelType = 16
 )
 
 // Enum value maps for DistributionChannelType.
@@ -6305,9 +7914,12 @@ type DpidStatus int32
 
 const (
 	DpidStatus_DPID_STATUS_UNSPECIFIED DpidStatus = 0
-	DpidStatus_DPID_STATUS_ACTIVE      DpidStatus = 1
-	DpidStatus_DPID_STATUS_DELETED     DpidStatus = 2
-	DpidStatus_DPID_STATUS_REPLACED    DpidStatus = 3
+	// @xml: "Active"
+	DpidStatus_DPID_STATUS_ACTIVE DpidStatus = 1
+	// @xml: "Deleted"
+	DpidStatus_DPID_STATUS_DELETED DpidStatus = 2
+	// @xml: "Replaced"
+	DpidStatus_DPID_STATUS_REPLACED DpidStatus = 3
 )
 
 // Enum value maps for DpidStatus.
@@ -6356,8 +7968,10 @@ func (DpidStatus) EnumDescriptor() ([]byte, []int) {
 type DrmEnforcementType int32
 
 const (
-	DrmEnforcementType_DRM_ENFORCEMENT_TYPE_UNSPECIFIED    DrmEnforcementType = 0
-	DrmEnforcementType_DRM_ENFORCEMENT_TYPE_DRMENFORCED    DrmEnforcementType = 1
+	DrmEnforcementType_DRM_ENFORCEMENT_TYPE_UNSPECIFIED DrmEnforcementType = 0
+	// @xml: "DrmEnforced"
+	DrmEnforcementType_DRM_ENFORCEMENT_TYPE_DRMENFORCED DrmEnforcementType = 1
+	// @xml: "NotDrmEnforced"
 	DrmEnforcementType_DRM_ENFORCEMENT_TYPE_NOTDRMENFORCED DrmEnforcementType = 2
 )
 
@@ -6405,12 +8019,18 @@ func (DrmEnforcementType) EnumDescriptor() ([]byte, []int) {
 type DrmPlatformType int32
 
 const (
-	DrmPlatformType_DRM_PLATFORM_TYPE_UNSPECIFIED     DrmPlatformType = 0
-	DrmPlatformType_DRM_PLATFORM_TYPE_E_3DAY          DrmPlatformType = 1
-	DrmPlatformType_DRM_PLATFORM_TYPE_FAIRPLAY        DrmPlatformType = 2
-	DrmPlatformType_DRM_PLATFORM_TYPE_OMA             DrmPlatformType = 3
-	DrmPlatformType_DRM_PLATFORM_TYPE_UNKNOWN         DrmPlatformType = 4
-	DrmPlatformType_DRM_PLATFORM_TYPE_USERDEFINED     DrmPlatformType = 5
+	DrmPlatformType_DRM_PLATFORM_TYPE_UNSPECIFIED DrmPlatformType = 0
+	// @xml: "3Day"
+	DrmPlatformType_DRM_PLATFORM_TYPE_E_3DAY DrmPlatformType = 1
+	// @xml: "Fairplay"
+	DrmPlatformType_DRM_PLATFORM_TYPE_FAIRPLAY DrmPlatformType = 2
+	// @xml: "OMA"
+	DrmPlatformType_DRM_PLATFORM_TYPE_OMA DrmPlatformType = 3
+	// @xml: "Unknown"
+	DrmPlatformType_DRM_PLATFORM_TYPE_UNKNOWN DrmPlatformType = 4
+	// @xml: "UserDefined"
+	DrmPlatformType_DRM_PLATFORM_TYPE_USERDEFINED DrmPlatformType = 5
+	// @xml: "WindowsMediaDRM"
 	DrmPlatformType_DRM_PLATFORM_TYPE_WINDOWSMEDIADRM DrmPlatformType = 6
 )
 
@@ -6466,9 +8086,11 @@ func (DrmPlatformType) EnumDescriptor() ([]byte, []int) {
 type DsrMessageType int32
 
 const (
-	DsrMessageType_DSR_MESSAGE_TYPE_UNSPECIFIED                       DsrMessageType = 0
+	DsrMessageType_DSR_MESSAGE_TYPE_UNSPECIFIED DsrMessageType = 0
+	// @xml: "SalesReportToRecordCompanyMessage"
 	DsrMessageType_DSR_MESSAGE_TYPE_SALESREPORTTORECORDCOMPANYMESSAGE DsrMessageType = 1
-	DsrMessageType_DSR_MESSAGE_TYPE_SALESREPORTTOSOCIETYMESSAGE       DsrMessageType = 2
+	// @xml: "SalesReportToSocietyMessage"
+	DsrMessageType_DSR_MESSAGE_TYPE_SALESREPORTTOSOCIETYMESSAGE DsrMessageType = 2
 )
 
 // Enum value maps for DsrMessageType.
@@ -6515,13 +8137,20 @@ func (DsrMessageType) EnumDescriptor() ([]byte, []int) {
 type EquipmentType int32
 
 const (
-	EquipmentType_EQUIPMENT_TYPE_UNSPECIFIED       EquipmentType = 0
-	EquipmentType_EQUIPMENT_TYPE_COMPUTER          EquipmentType = 1
-	EquipmentType_EQUIPMENT_TYPE_MICROPHONE        EquipmentType = 2
-	EquipmentType_EQUIPMENT_TYPE_RECORDER          EquipmentType = 3
-	EquipmentType_EQUIPMENT_TYPE_SIGNALPROCESSOR   EquipmentType = 4
-	EquipmentType_EQUIPMENT_TYPE_SOFTWARE          EquipmentType = 5
-	EquipmentType_EQUIPMENT_TYPE_LOUDSPEAKER       EquipmentType = 6
+	EquipmentType_EQUIPMENT_TYPE_UNSPECIFIED EquipmentType = 0
+	// @xml: "Computer"
+	EquipmentType_EQUIPMENT_TYPE_COMPUTER EquipmentType = 1
+	// @xml: "Microphone"
+	EquipmentType_EQUIPMENT_TYPE_MICROPHONE EquipmentType = 2
+	// @xml: "Recorder"
+	EquipmentType_EQUIPMENT_TYPE_RECORDER EquipmentType = 3
+	// @xml: "SignalProcessor"
+	EquipmentType_EQUIPMENT_TYPE_SIGNALPROCESSOR EquipmentType = 4
+	// @xml: "Software"
+	EquipmentType_EQUIPMENT_TYPE_SOFTWARE EquipmentType = 5
+	// @xml: "Loudspeaker"
+	EquipmentType_EQUIPMENT_TYPE_LOUDSPEAKER EquipmentType = 6
+	// @xml: "MusicalInstrument"
 	EquipmentType_EQUIPMENT_TYPE_MUSICALINSTRUMENT EquipmentType = 7
 )
 
@@ -6579,7 +8208,8 @@ func (EquipmentType) EnumDescriptor() ([]byte, []int) {
 type ErnMessageType int32
 
 const (
-	ErnMessageType_ERN_MESSAGE_TYPE_UNSPECIFIED       ErnMessageType = 0
+	ErnMessageType_ERN_MESSAGE_TYPE_UNSPECIFIED ErnMessageType = 0
+	// @xml: "NewReleaseMessage"
 	ErnMessageType_ERN_MESSAGE_TYPE_NEWRELEASEMESSAGE ErnMessageType = 1
 )
 
@@ -6625,28 +8255,49 @@ func (ErnMessageType) EnumDescriptor() ([]byte, []int) {
 type ErncFileStatus int32
 
 const (
-	ErncFileStatus_ERNC_FILE_STATUS_UNSPECIFIED                             ErncFileStatus = 0
-	ErncFileStatus_ERNC_FILE_STATUS_ARTISTROLEUNKNOWN                       ErncFileStatus = 1
-	ErncFileStatus_ERNC_FILE_STATUS_COMMERCIALRELEASEDATEINVALID            ErncFileStatus = 2
-	ErncFileStatus_ERNC_FILE_STATUS_CONFLICTINGAVAILABILITYPERIODS          ErncFileStatus = 3
-	ErncFileStatus_ERNC_FILE_STATUS_DUPLICATEDPUBLISHERNAMES                ErncFileStatus = 4
-	ErncFileStatus_ERNC_FILE_STATUS_ERNMISSING                              ErncFileStatus = 5
-	ErncFileStatus_ERNC_FILE_STATUS_FILEOK                                  ErncFileStatus = 6
-	ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERINVALID                       ErncFileStatus = 7
-	ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERSYNTAXINVALID                 ErncFileStatus = 8
-	ErncFileStatus_ERNC_FILE_STATUS_INTERNALERROR                           ErncFileStatus = 9
-	ErncFileStatus_ERNC_FILE_STATUS_METADATAMISSING                         ErncFileStatus = 10
-	ErncFileStatus_ERNC_FILE_STATUS_NEWRELEASEMESSAGEINVALID                ErncFileStatus = 11
-	ErncFileStatus_ERNC_FILE_STATUS_NODEALFORTRACKRELEASE                   ErncFileStatus = 12
-	ErncFileStatus_ERNC_FILE_STATUS_NODEALINNEWRELEASEMESSAGE               ErncFileStatus = 13
+	ErncFileStatus_ERNC_FILE_STATUS_UNSPECIFIED ErncFileStatus = 0
+	// @xml: "ArtistRoleUnknown"
+	ErncFileStatus_ERNC_FILE_STATUS_ARTISTROLEUNKNOWN ErncFileStatus = 1
+	// @xml: "CommercialReleaseDateInvalid"
+	ErncFileStatus_ERNC_FILE_STATUS_COMMERCIALRELEASEDATEINVALID ErncFileStatus = 2
+	// @xml: "ConflictingAvailabilityPeriods"
+	ErncFileStatus_ERNC_FILE_STATUS_CONFLICTINGAVAILABILITYPERIODS ErncFileStatus = 3
+	// @xml: "DuplicatedPublisherNames"
+	ErncFileStatus_ERNC_FILE_STATUS_DUPLICATEDPUBLISHERNAMES ErncFileStatus = 4
+	// @xml: "ErnMissing"
+	ErncFileStatus_ERNC_FILE_STATUS_ERNMISSING ErncFileStatus = 5
+	// @xml: "FileOK"
+	ErncFileStatus_ERNC_FILE_STATUS_FILEOK ErncFileStatus = 6
+	// @xml: "IdentifierInvalid"
+	ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERINVALID ErncFileStatus = 7
+	// @xml: "IdentifierSyntaxInvalid"
+	ErncFileStatus_ERNC_FILE_STATUS_IDENTIFIERSYNTAXINVALID ErncFileStatus = 8
+	// @xml: "InternalError"
+	ErncFileStatus_ERNC_FILE_STATUS_INTERNALERROR ErncFileStatus = 9
+	// @xml: "MetadataMissing"
+	ErncFileStatus_ERNC_FILE_STATUS_METADATAMISSING ErncFileStatus = 10
+	// @xml: "NewReleaseMessageInvalid"
+	ErncFileStatus_ERNC_FILE_STATUS_NEWRELEASEMESSAGEINVALID ErncFileStatus = 11
+	// @xml: "NoDealForTrackRelease"
+	ErncFileStatus_ERNC_FILE_STATUS_NODEALFORTRACKRELEASE ErncFileStatus = 12
+	// @xml: "NoDealInNewReleaseMessage"
+	ErncFileStatus_ERNC_FILE_STATUS_NODEALINNEWRELEASEMESSAGE ErncFileStatus = 13
+	// @xml: "OriginalReleaseDateLaterThanReleaseDate"
 	ErncFileStatus_ERNC_FILE_STATUS_ORIGINALRELEASEDATELATERTHANRELEASEDATE ErncFileStatus = 14
-	ErncFileStatus_ERNC_FILE_STATUS_PRIMARYARTISTNAMEMISSING                ErncFileStatus = 15
-	ErncFileStatus_ERNC_FILE_STATUS_RESOURCECORRUPT                         ErncFileStatus = 16
-	ErncFileStatus_ERNC_FILE_STATUS_RESOURCEMISSING                         ErncFileStatus = 17
-	ErncFileStatus_ERNC_FILE_STATUS_RESOURCENOTMEETINGSPECIFICATIONS        ErncFileStatus = 18
-	ErncFileStatus_ERNC_FILE_STATUS_SIGNATUREORHASHSUMWRONGORMISSING        ErncFileStatus = 19
-	ErncFileStatus_ERNC_FILE_STATUS_UNSUPPORTEDUSAGE                        ErncFileStatus = 20
-	ErncFileStatus_ERNC_FILE_STATUS_USERDEFINED                             ErncFileStatus = 21
+	// @xml: "PrimaryArtistNameMissing"
+	ErncFileStatus_ERNC_FILE_STATUS_PRIMARYARTISTNAMEMISSING ErncFileStatus = 15
+	// @xml: "ResourceCorrupt"
+	ErncFileStatus_ERNC_FILE_STATUS_RESOURCECORRUPT ErncFileStatus = 16
+	// @xml: "ResourceMissing"
+	ErncFileStatus_ERNC_FILE_STATUS_RESOURCEMISSING ErncFileStatus = 17
+	// @xml: "ResourceNotMeetingSpecifications"
+	ErncFileStatus_ERNC_FILE_STATUS_RESOURCENOTMEETINGSPECIFICATIONS ErncFileStatus = 18
+	// @xml: "SignatureOrHashSumWrongOrMissing"
+	ErncFileStatus_ERNC_FILE_STATUS_SIGNATUREORHASHSUMWRONGORMISSING ErncFileStatus = 19
+	// @xml: "UnsupportedUsage"
+	ErncFileStatus_ERNC_FILE_STATUS_UNSUPPORTEDUSAGE ErncFileStatus = 20
+	// @xml: "UserDefined"
+	ErncFileStatus_ERNC_FILE_STATUS_USERDEFINED ErncFileStatus = 21
 )
 
 // Enum value maps for ErncFileStatus.
@@ -6731,12 +8382,17 @@ func (ErncFileStatus) EnumDescriptor() ([]byte, []int) {
 type ErncProposedActionType int32
 
 const (
-	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_UNSPECIFIED                 ErncProposedActionType = 0
-	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLONLY               ErncProposedActionType = 1
-	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLANDRESOURCES       ErncProposedActionType = 2
-	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_USERDEFINED                 ErncProposedActionType = 3
+	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_UNSPECIFIED ErncProposedActionType = 0
+	// @xml: "ResendXmlOnly"
+	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLONLY ErncProposedActionType = 1
+	// @xml: "ResendXmlAndResources"
+	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_RESENDXMLANDRESOURCES ErncProposedActionType = 2
+	// @xml: "UserDefined"
+	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_USERDEFINED ErncProposedActionType = 3
+	// @xml: "DoNotResendAffectedResource"
 	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_DONOTRESENDAFFECTEDRESOURCE ErncProposedActionType = 4
-	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_DONOTRESENDRELEASE          ErncProposedActionType = 5
+	// @xml: "DoNotResendRelease"
+	ErncProposedActionType_ERNC_PROPOSED_ACTION_TYPE_DONOTRESENDRELEASE ErncProposedActionType = 5
 )
 
 // Enum value maps for ErncProposedActionType.
@@ -6790,7 +8446,9 @@ type ExpressionType int32
 
 const (
 	ExpressionType_EXPRESSION_TYPE_UNSPECIFIED ExpressionType = 0
+	// @xml: "Informative"
 	ExpressionType_EXPRESSION_TYPE_INFORMATIVE ExpressionType = 1
+	// @xml: "Instructive"
 	ExpressionType_EXPRESSION_TYPE_INSTRUCTIVE ExpressionType = 2
 )
 
@@ -6838,14 +8496,21 @@ func (ExpressionType) EnumDescriptor() ([]byte, []int) {
 type ExternallyLinkedResourceType int32
 
 const (
-	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_UNSPECIFIED            ExternallyLinkedResourceType = 0
-	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_ADDITIONALMETADATA     ExternallyLinkedResourceType = 1
-	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_LOGO                   ExternallyLinkedResourceType = 2
-	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALIMAGE       ExternallyLinkedResourceType = 3
+	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_UNSPECIFIED ExternallyLinkedResourceType = 0
+	// @xml: "AdditionalMetadata"
+	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_ADDITIONALMETADATA ExternallyLinkedResourceType = 1
+	// @xml: "Logo"
+	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_LOGO ExternallyLinkedResourceType = 2
+	// @xml: "PromotionalImage"
+	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALIMAGE ExternallyLinkedResourceType = 3
+	// @xml: "PromotionalInformation"
 	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALINFORMATION ExternallyLinkedResourceType = 4
-	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALITEM        ExternallyLinkedResourceType = 5
-	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_UNKNOWN                ExternallyLinkedResourceType = 6
-	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_USERDEFINED            ExternallyLinkedResourceType = 7
+	// @xml: "PromotionalItem"
+	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_PROMOTIONALITEM ExternallyLinkedResourceType = 5
+	// @xml: "Unknown"
+	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_UNKNOWN ExternallyLinkedResourceType = 6
+	// @xml: "UserDefined"
+	ExternallyLinkedResourceType_EXTERNALLY_LINKED_RESOURCE_TYPE_USERDEFINED ExternallyLinkedResourceType = 7
 )
 
 // Enum value maps for ExternallyLinkedResourceType.
@@ -6902,10 +8567,14 @@ func (ExternallyLinkedResourceType) EnumDescriptor() ([]byte, []int) {
 type FileStatus int32
 
 const (
-	FileStatus_FILE_STATUS_UNSPECIFIED    FileStatus = 0
-	FileStatus_FILE_STATUS_FILEMISSING    FileStatus = 1
-	FileStatus_FILE_STATUS_FILEOK         FileStatus = 2
-	FileStatus_FILE_STATUS_HASHSUMWRONG   FileStatus = 3
+	FileStatus_FILE_STATUS_UNSPECIFIED FileStatus = 0
+	// @xml: "FileMissing"
+	FileStatus_FILE_STATUS_FILEMISSING FileStatus = 1
+	// @xml: "FileOK"
+	FileStatus_FILE_STATUS_FILEOK FileStatus = 2
+	// @xml: "HashSumWrong"
+	FileStatus_FILE_STATUS_HASHSUMWRONG FileStatus = 3
+	// @xml: "SignatureWrong"
 	FileStatus_FILE_STATUS_SIGNATUREWRONG FileStatus = 4
 )
 
@@ -6958,6 +8627,7 @@ type FingerprintAlgorithmType int32
 
 const (
 	FingerprintAlgorithmType_FINGERPRINT_ALGORITHM_TYPE_UNSPECIFIED FingerprintAlgorithmType = 0
+	// @xml: "UserDefined"
 	FingerprintAlgorithmType_FINGERPRINT_ALGORITHM_TYPE_USERDEFINED FingerprintAlgorithmType = 1
 )
 
@@ -7003,8 +8673,10 @@ func (FingerprintAlgorithmType) EnumDescriptor() ([]byte, []int) {
 type GoverningAgreementType int32
 
 const (
-	GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_UNSPECIFIED                GoverningAgreementType = 0
-	GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_USERDEFINED                GoverningAgreementType = 1
+	GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_UNSPECIFIED GoverningAgreementType = 0
+	// @xml: "UserDefined"
+	GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_USERDEFINED GoverningAgreementType = 1
+	// @xml: "SessionMusicUnionAgreement"
 	GoverningAgreementType_GOVERNING_AGREEMENT_TYPE_SESSIONMUSICUNIONAGREEMENT GoverningAgreementType = 2
 )
 
@@ -7053,22 +8725,38 @@ type HashSumAlgorithmType int32
 
 const (
 	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_UNSPECIFIED HashSumAlgorithmType = 0
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4         HashSumAlgorithmType = 1
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD5         HashSumAlgorithmType = 2
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA         HashSumAlgorithmType = 3
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA1        HashSumAlgorithmType = 4
+	// @xml: "MD4"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4 HashSumAlgorithmType = 1
+	// @xml: "MD5"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD5 HashSumAlgorithmType = 2
+	// @xml: "SHA"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA HashSumAlgorithmType = 3
+	// @xml: "SHA1"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA1 HashSumAlgorithmType = 4
+	// @xml: "UserDefined"
 	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_USERDEFINED HashSumAlgorithmType = 5
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_CRC32       HashSumAlgorithmType = 6
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD2         HashSumAlgorithmType = 7
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4_MLNET   HashSumAlgorithmType = 8
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MDC2        HashSumAlgorithmType = 9
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_RMD160      HashSumAlgorithmType = 10
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA2        HashSumAlgorithmType = 11
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_224     HashSumAlgorithmType = 12
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_256     HashSumAlgorithmType = 13
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA3        HashSumAlgorithmType = 14
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_384     HashSumAlgorithmType = 15
-	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_512     HashSumAlgorithmType = 16
+	// @xml: "CRC32"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_CRC32 HashSumAlgorithmType = 6
+	// @xml: "MD2"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD2 HashSumAlgorithmType = 7
+	// @xml: "MD4(MLNET)"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MD4_MLNET HashSumAlgorithmType = 8
+	// @xml: "MDC2"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_MDC2 HashSumAlgorithmType = 9
+	// @xml: "RMD160"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_RMD160 HashSumAlgorithmType = 10
+	// @xml: "SHA2"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA2 HashSumAlgorithmType = 11
+	// @xml: "SHA-224"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_224 HashSumAlgorithmType = 12
+	// @xml: "SHA-256"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_256 HashSumAlgorithmType = 13
+	// @xml: "SHA3"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA3 HashSumAlgorithmType = 14
+	// @xml: "SHA-384"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_384 HashSumAlgorithmType = 15
+	// @xml: "SHA-512"
+	HashSumAlgorithmType_HASH_SUM_ALGORITHM_TYPE_SHA_512 HashSumAlgorithmType = 16
 )
 
 // Enum value maps for HashSumAlgorithmType.
@@ -7144,12 +8832,19 @@ type ImageCodecType int32
 
 const (
 	ImageCodecType_IMAGE_CODEC_TYPE_UNSPECIFIED ImageCodecType = 0
-	ImageCodecType_IMAGE_CODEC_TYPE_GIF         ImageCodecType = 1
-	ImageCodecType_IMAGE_CODEC_TYPE_JPEG        ImageCodecType = 2
-	ImageCodecType_IMAGE_CODEC_TYPE_JPEG2000    ImageCodecType = 3
-	ImageCodecType_IMAGE_CODEC_TYPE_PNG         ImageCodecType = 4
-	ImageCodecType_IMAGE_CODEC_TYPE_TIFF        ImageCodecType = 5
-	ImageCodecType_IMAGE_CODEC_TYPE_UNKNOWN     ImageCodecType = 6
+	// @xml: "GIF"
+	ImageCodecType_IMAGE_CODEC_TYPE_GIF ImageCodecType = 1
+	// @xml: "JPEG"
+	ImageCodecType_IMAGE_CODEC_TYPE_JPEG ImageCodecType = 2
+	// @xml: "JPEG2000"
+	ImageCodecType_IMAGE_CODEC_TYPE_JPEG2000 ImageCodecType = 3
+	// @xml: "PNG"
+	ImageCodecType_IMAGE_CODEC_TYPE_PNG ImageCodecType = 4
+	// @xml: "TIFF"
+	ImageCodecType_IMAGE_CODEC_TYPE_TIFF ImageCodecType = 5
+	// @xml: "Unknown"
+	ImageCodecType_IMAGE_CODEC_TYPE_UNKNOWN ImageCodecType = 6
+	// @xml: "UserDefined"
 	ImageCodecType_IMAGE_CODEC_TYPE_USERDEFINED ImageCodecType = 7
 )
 
@@ -7207,22 +8902,37 @@ func (ImageCodecType) EnumDescriptor() ([]byte, []int) {
 type ImageType int32
 
 const (
-	ImageType_IMAGE_TYPE_UNSPECIFIED        ImageType = 0
-	ImageType_IMAGE_TYPE_BACKCOVERIMAGE     ImageType = 1
-	ImageType_IMAGE_TYPE_BOOKLETBACKIMAGE   ImageType = 2
-	ImageType_IMAGE_TYPE_BOOKLETFRONTIMAGE  ImageType = 3
-	ImageType_IMAGE_TYPE_DOCUMENTIMAGE      ImageType = 4
-	ImageType_IMAGE_TYPE_FRONTCOVERIMAGE    ImageType = 5
-	ImageType_IMAGE_TYPE_ICON               ImageType = 6
-	ImageType_IMAGE_TYPE_LOGO               ImageType = 7
-	ImageType_IMAGE_TYPE_PHOTOGRAPH         ImageType = 8
-	ImageType_IMAGE_TYPE_POSTER             ImageType = 9
-	ImageType_IMAGE_TYPE_TRAYIMAGE          ImageType = 10
-	ImageType_IMAGE_TYPE_UNKNOWN            ImageType = 11
-	ImageType_IMAGE_TYPE_USERDEFINED        ImageType = 12
+	ImageType_IMAGE_TYPE_UNSPECIFIED ImageType = 0
+	// @xml: "BackCoverImage"
+	ImageType_IMAGE_TYPE_BACKCOVERIMAGE ImageType = 1
+	// @xml: "BookletBackImage"
+	ImageType_IMAGE_TYPE_BOOKLETBACKIMAGE ImageType = 2
+	// @xml: "BookletFrontImage"
+	ImageType_IMAGE_TYPE_BOOKLETFRONTIMAGE ImageType = 3
+	// @xml: "DocumentImage"
+	ImageType_IMAGE_TYPE_DOCUMENTIMAGE ImageType = 4
+	// @xml: "FrontCoverImage"
+	ImageType_IMAGE_TYPE_FRONTCOVERIMAGE ImageType = 5
+	// @xml: "Icon"
+	ImageType_IMAGE_TYPE_ICON ImageType = 6
+	// @xml: "Logo"
+	ImageType_IMAGE_TYPE_LOGO ImageType = 7
+	// @xml: "Photograph"
+	ImageType_IMAGE_TYPE_PHOTOGRAPH ImageType = 8
+	// @xml: "Poster"
+	ImageType_IMAGE_TYPE_POSTER ImageType = 9
+	// @xml: "TrayImage"
+	ImageType_IMAGE_TYPE_TRAYIMAGE ImageType = 10
+	// @xml: "Unknown"
+	ImageType_IMAGE_TYPE_UNKNOWN ImageType = 11
+	// @xml: "UserDefined"
+	ImageType_IMAGE_TYPE_USERDEFINED ImageType = 12
+	// @xml: "VideoScreenCapture"
 	ImageType_IMAGE_TYPE_VIDEOSCREENCAPTURE ImageType = 13
-	ImageType_IMAGE_TYPE_WALLPAPER          ImageType = 14
-	ImageType_IMAGE_TYPE_PORTRAIT           ImageType = 15
+	// @xml: "Wallpaper"
+	ImageType_IMAGE_TYPE_WALLPAPER ImageType = 14
+	// @xml: "Portrait"
+	ImageType_IMAGE_TYPE_PORTRAIT ImageType = 15
 )
 
 // Enum value maps for ImageType.
@@ -7295,8 +9005,10 @@ func (ImageType) EnumDescriptor() ([]byte, []int) {
 type InvoiceAvailabilityStatus int32
 
 const (
-	InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_UNSPECIFIED         InvoiceAvailabilityStatus = 0
-	InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_INVOICEAVAILABLE    InvoiceAvailabilityStatus = 1
+	InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_UNSPECIFIED InvoiceAvailabilityStatus = 0
+	// @xml: "InvoiceAvailable"
+	InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_INVOICEAVAILABLE InvoiceAvailabilityStatus = 1
+	// @xml: "InvoiceNotAvailable"
 	InvoiceAvailabilityStatus_INVOICE_AVAILABILITY_STATUS_INVOICENOTAVAILABLE InvoiceAvailabilityStatus = 2
 )
 
@@ -7345,175 +9057,344 @@ type IsoCurrencyCode int32
 
 const (
 	IsoCurrencyCode_ISO_CURRENCY_CODE_UNSPECIFIED IsoCurrencyCode = 0
-	IsoCurrencyCode_ISO_CURRENCY_CODE_AED         IsoCurrencyCode = 1
-	IsoCurrencyCode_ISO_CURRENCY_CODE_AFN         IsoCurrencyCode = 2
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ALL         IsoCurrencyCode = 3
-	IsoCurrencyCode_ISO_CURRENCY_CODE_AMD         IsoCurrencyCode = 4
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ANG         IsoCurrencyCode = 5
-	IsoCurrencyCode_ISO_CURRENCY_CODE_AOA         IsoCurrencyCode = 6
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ARS         IsoCurrencyCode = 7
-	IsoCurrencyCode_ISO_CURRENCY_CODE_AUD         IsoCurrencyCode = 8
-	IsoCurrencyCode_ISO_CURRENCY_CODE_AWG         IsoCurrencyCode = 9
-	IsoCurrencyCode_ISO_CURRENCY_CODE_AZN         IsoCurrencyCode = 10
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BAM         IsoCurrencyCode = 11
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BBD         IsoCurrencyCode = 12
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BDT         IsoCurrencyCode = 13
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BGN         IsoCurrencyCode = 14
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BHD         IsoCurrencyCode = 15
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BIF         IsoCurrencyCode = 16
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BMD         IsoCurrencyCode = 17
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BND         IsoCurrencyCode = 18
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BOB         IsoCurrencyCode = 19
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BOV         IsoCurrencyCode = 20
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BRL         IsoCurrencyCode = 21
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BSD         IsoCurrencyCode = 22
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BTN         IsoCurrencyCode = 23
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BWP         IsoCurrencyCode = 24
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BYR         IsoCurrencyCode = 25
-	IsoCurrencyCode_ISO_CURRENCY_CODE_BZD         IsoCurrencyCode = 26
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CAD         IsoCurrencyCode = 27
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CDF         IsoCurrencyCode = 28
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CHF         IsoCurrencyCode = 29
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CLF         IsoCurrencyCode = 30
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CLP         IsoCurrencyCode = 31
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CNY         IsoCurrencyCode = 32
-	IsoCurrencyCode_ISO_CURRENCY_CODE_COP         IsoCurrencyCode = 33
-	IsoCurrencyCode_ISO_CURRENCY_CODE_COU         IsoCurrencyCode = 34
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CRC         IsoCurrencyCode = 35
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CUC         IsoCurrencyCode = 36
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CUP         IsoCurrencyCode = 37
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CVE         IsoCurrencyCode = 38
-	IsoCurrencyCode_ISO_CURRENCY_CODE_CZK         IsoCurrencyCode = 39
-	IsoCurrencyCode_ISO_CURRENCY_CODE_DJF         IsoCurrencyCode = 40
-	IsoCurrencyCode_ISO_CURRENCY_CODE_DKK         IsoCurrencyCode = 41
-	IsoCurrencyCode_ISO_CURRENCY_CODE_DOP         IsoCurrencyCode = 42
-	IsoCurrencyCode_ISO_CURRENCY_CODE_DZD         IsoCurrencyCode = 43
-	IsoCurrencyCode_ISO_CURRENCY_CODE_EGP         IsoCurrencyCode = 44
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ERN         IsoCurrencyCode = 45
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ETB         IsoCurrencyCode = 46
-	IsoCurrencyCode_ISO_CURRENCY_CODE_EUR         IsoCurrencyCode = 47
-	IsoCurrencyCode_ISO_CURRENCY_CODE_FJD         IsoCurrencyCode = 48
-	IsoCurrencyCode_ISO_CURRENCY_CODE_FKP         IsoCurrencyCode = 49
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GBP         IsoCurrencyCode = 50
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GEL         IsoCurrencyCode = 51
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GHS         IsoCurrencyCode = 52
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GIP         IsoCurrencyCode = 53
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GMD         IsoCurrencyCode = 54
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GNF         IsoCurrencyCode = 55
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GTQ         IsoCurrencyCode = 56
-	IsoCurrencyCode_ISO_CURRENCY_CODE_GYD         IsoCurrencyCode = 57
-	IsoCurrencyCode_ISO_CURRENCY_CODE_HKD         IsoCurrencyCode = 58
-	IsoCurrencyCode_ISO_CURRENCY_CODE_HNL         IsoCurrencyCode = 59
-	IsoCurrencyCode_ISO_CURRENCY_CODE_HRK         IsoCurrencyCode = 60
-	IsoCurrencyCode_ISO_CURRENCY_CODE_HTG         IsoCurrencyCode = 61
-	IsoCurrencyCode_ISO_CURRENCY_CODE_HUF         IsoCurrencyCode = 62
-	IsoCurrencyCode_ISO_CURRENCY_CODE_IDR         IsoCurrencyCode = 63
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ILS         IsoCurrencyCode = 64
-	IsoCurrencyCode_ISO_CURRENCY_CODE_INR         IsoCurrencyCode = 65
-	IsoCurrencyCode_ISO_CURRENCY_CODE_IQD         IsoCurrencyCode = 66
-	IsoCurrencyCode_ISO_CURRENCY_CODE_IRR         IsoCurrencyCode = 67
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ISK         IsoCurrencyCode = 68
-	IsoCurrencyCode_ISO_CURRENCY_CODE_JMD         IsoCurrencyCode = 69
-	IsoCurrencyCode_ISO_CURRENCY_CODE_JOD         IsoCurrencyCode = 70
-	IsoCurrencyCode_ISO_CURRENCY_CODE_JPY         IsoCurrencyCode = 71
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KES         IsoCurrencyCode = 72
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KGS         IsoCurrencyCode = 73
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KHR         IsoCurrencyCode = 74
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KMF         IsoCurrencyCode = 75
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KPW         IsoCurrencyCode = 76
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KRW         IsoCurrencyCode = 77
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KWD         IsoCurrencyCode = 78
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KYD         IsoCurrencyCode = 79
-	IsoCurrencyCode_ISO_CURRENCY_CODE_KZT         IsoCurrencyCode = 80
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LAK         IsoCurrencyCode = 81
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LBP         IsoCurrencyCode = 82
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LKR         IsoCurrencyCode = 83
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LRD         IsoCurrencyCode = 84
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LSL         IsoCurrencyCode = 85
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LTL         IsoCurrencyCode = 86
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LVL         IsoCurrencyCode = 87
-	IsoCurrencyCode_ISO_CURRENCY_CODE_LYD         IsoCurrencyCode = 88
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MAD         IsoCurrencyCode = 89
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MDL         IsoCurrencyCode = 90
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MGA         IsoCurrencyCode = 91
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MKD         IsoCurrencyCode = 92
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MMK         IsoCurrencyCode = 93
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MNT         IsoCurrencyCode = 94
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MOP         IsoCurrencyCode = 95
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MRO         IsoCurrencyCode = 96
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MUR         IsoCurrencyCode = 97
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MVR         IsoCurrencyCode = 98
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MWK         IsoCurrencyCode = 99
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MXN         IsoCurrencyCode = 100
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MXV         IsoCurrencyCode = 101
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MYR         IsoCurrencyCode = 102
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MZM         IsoCurrencyCode = 103
-	IsoCurrencyCode_ISO_CURRENCY_CODE_NAD         IsoCurrencyCode = 104
-	IsoCurrencyCode_ISO_CURRENCY_CODE_NGN         IsoCurrencyCode = 105
-	IsoCurrencyCode_ISO_CURRENCY_CODE_NIO         IsoCurrencyCode = 106
-	IsoCurrencyCode_ISO_CURRENCY_CODE_NOK         IsoCurrencyCode = 107
-	IsoCurrencyCode_ISO_CURRENCY_CODE_NPR         IsoCurrencyCode = 108
-	IsoCurrencyCode_ISO_CURRENCY_CODE_NZD         IsoCurrencyCode = 109
-	IsoCurrencyCode_ISO_CURRENCY_CODE_OMR         IsoCurrencyCode = 110
-	IsoCurrencyCode_ISO_CURRENCY_CODE_PAB         IsoCurrencyCode = 111
-	IsoCurrencyCode_ISO_CURRENCY_CODE_PEN         IsoCurrencyCode = 112
-	IsoCurrencyCode_ISO_CURRENCY_CODE_PGK         IsoCurrencyCode = 113
-	IsoCurrencyCode_ISO_CURRENCY_CODE_PHP         IsoCurrencyCode = 114
-	IsoCurrencyCode_ISO_CURRENCY_CODE_PKR         IsoCurrencyCode = 115
-	IsoCurrencyCode_ISO_CURRENCY_CODE_PLN         IsoCurrencyCode = 116
-	IsoCurrencyCode_ISO_CURRENCY_CODE_PYG         IsoCurrencyCode = 117
-	IsoCurrencyCode_ISO_CURRENCY_CODE_QAR         IsoCurrencyCode = 118
-	IsoCurrencyCode_ISO_CURRENCY_CODE_RON         IsoCurrencyCode = 119
-	IsoCurrencyCode_ISO_CURRENCY_CODE_RSD         IsoCurrencyCode = 120
-	IsoCurrencyCode_ISO_CURRENCY_CODE_RUB         IsoCurrencyCode = 121
-	IsoCurrencyCode_ISO_CURRENCY_CODE_RWF         IsoCurrencyCode = 122
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SAR         IsoCurrencyCode = 123
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SBD         IsoCurrencyCode = 124
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SCR         IsoCurrencyCode = 125
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SDG         IsoCurrencyCode = 126
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SEK         IsoCurrencyCode = 127
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SGD         IsoCurrencyCode = 128
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SHP         IsoCurrencyCode = 129
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SLL         IsoCurrencyCode = 130
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SOS         IsoCurrencyCode = 131
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SRD         IsoCurrencyCode = 132
-	IsoCurrencyCode_ISO_CURRENCY_CODE_STD         IsoCurrencyCode = 133
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SVC         IsoCurrencyCode = 134
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SYP         IsoCurrencyCode = 135
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SZL         IsoCurrencyCode = 136
-	IsoCurrencyCode_ISO_CURRENCY_CODE_THB         IsoCurrencyCode = 137
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TJS         IsoCurrencyCode = 138
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TMT         IsoCurrencyCode = 139
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TND         IsoCurrencyCode = 140
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TOP         IsoCurrencyCode = 141
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TRY         IsoCurrencyCode = 142
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TTD         IsoCurrencyCode = 143
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TWD         IsoCurrencyCode = 144
-	IsoCurrencyCode_ISO_CURRENCY_CODE_TZS         IsoCurrencyCode = 145
-	IsoCurrencyCode_ISO_CURRENCY_CODE_UAH         IsoCurrencyCode = 146
-	IsoCurrencyCode_ISO_CURRENCY_CODE_UGX         IsoCurrencyCode = 147
-	IsoCurrencyCode_ISO_CURRENCY_CODE_USD         IsoCurrencyCode = 148
-	IsoCurrencyCode_ISO_CURRENCY_CODE_UYI         IsoCurrencyCode = 149
-	IsoCurrencyCode_ISO_CURRENCY_CODE_UYU         IsoCurrencyCode = 150
-	IsoCurrencyCode_ISO_CURRENCY_CODE_UZS         IsoCurrencyCode = 151
-	IsoCurrencyCode_ISO_CURRENCY_CODE_VEF         IsoCurrencyCode = 152
-	IsoCurrencyCode_ISO_CURRENCY_CODE_VND         IsoCurrencyCode = 153
-	IsoCurrencyCode_ISO_CURRENCY_CODE_VUV         IsoCurrencyCode = 154
-	IsoCurrencyCode_ISO_CURRENCY_CODE_WST         IsoCurrencyCode = 155
-	IsoCurrencyCode_ISO_CURRENCY_CODE_XAF         IsoCurrencyCode = 156
-	IsoCurrencyCode_ISO_CURRENCY_CODE_XCD         IsoCurrencyCode = 157
-	IsoCurrencyCode_ISO_CURRENCY_CODE_XOF         IsoCurrencyCode = 158
-	IsoCurrencyCode_ISO_CURRENCY_CODE_XPF         IsoCurrencyCode = 159
-	IsoCurrencyCode_ISO_CURRENCY_CODE_YER         IsoCurrencyCode = 160
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ZAR         IsoCurrencyCode = 161
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ZMK         IsoCurrencyCode = 162
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ZWL         IsoCurrencyCode = 163
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MRU         IsoCurrencyCode = 164
-	IsoCurrencyCode_ISO_CURRENCY_CODE_MZN         IsoCurrencyCode = 165
-	IsoCurrencyCode_ISO_CURRENCY_CODE_SSP         IsoCurrencyCode = 166
-	IsoCurrencyCode_ISO_CURRENCY_CODE_STN         IsoCurrencyCode = 167
-	IsoCurrencyCode_ISO_CURRENCY_CODE_VES         IsoCurrencyCode = 168
-	IsoCurrencyCode_ISO_CURRENCY_CODE_ZMW         IsoCurrencyCode = 169
+	// @xml: "AED"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_AED IsoCurrencyCode = 1
+	// @xml: "AFN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_AFN IsoCurrencyCode = 2
+	// @xml: "ALL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ALL IsoCurrencyCode = 3
+	// @xml: "AMD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_AMD IsoCurrencyCode = 4
+	// @xml: "ANG"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ANG IsoCurrencyCode = 5
+	// @xml: "AOA"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_AOA IsoCurrencyCode = 6
+	// @xml: "ARS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ARS IsoCurrencyCode = 7
+	// @xml: "AUD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_AUD IsoCurrencyCode = 8
+	// @xml: "AWG"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_AWG IsoCurrencyCode = 9
+	// @xml: "AZN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_AZN IsoCurrencyCode = 10
+	// @xml: "BAM"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BAM IsoCurrencyCode = 11
+	// @xml: "BBD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BBD IsoCurrencyCode = 12
+	// @xml: "BDT"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BDT IsoCurrencyCode = 13
+	// @xml: "BGN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BGN IsoCurrencyCode = 14
+	// @xml: "BHD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BHD IsoCurrencyCode = 15
+	// @xml: "BIF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BIF IsoCurrencyCode = 16
+	// @xml: "BMD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BMD IsoCurrencyCode = 17
+	// @xml: "BND"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BND IsoCurrencyCode = 18
+	// @xml: "BOB"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BOB IsoCurrencyCode = 19
+	// @xml: "BOV"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BOV IsoCurrencyCode = 20
+	// @xml: "BRL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BRL IsoCurrencyCode = 21
+	// @xml: "BSD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BSD IsoCurrencyCode = 22
+	// @xml: "BTN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BTN IsoCurrencyCode = 23
+	// @xml: "BWP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BWP IsoCurrencyCode = 24
+	// @xml: "BYR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BYR IsoCurrencyCode = 25
+	// @xml: "BZD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_BZD IsoCurrencyCode = 26
+	// @xml: "CAD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CAD IsoCurrencyCode = 27
+	// @xml: "CDF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CDF IsoCurrencyCode = 28
+	// @xml: "CHF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CHF IsoCurrencyCode = 29
+	// @xml: "CLF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CLF IsoCurrencyCode = 30
+	// @xml: "CLP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CLP IsoCurrencyCode = 31
+	// @xml: "CNY"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CNY IsoCurrencyCode = 32
+	// @xml: "COP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_COP IsoCurrencyCode = 33
+	// @xml: "COU"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_COU IsoCurrencyCode = 34
+	// @xml: "CRC"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CRC IsoCurrencyCode = 35
+	// @xml: "CUC"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CUC IsoCurrencyCode = 36
+	// @xml: "CUP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CUP IsoCurrencyCode = 37
+	// @xml: "CVE"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CVE IsoCurrencyCode = 38
+	// @xml: "CZK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_CZK IsoCurrencyCode = 39
+	// @xml: "DJF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_DJF IsoCurrencyCode = 40
+	// @xml: "DKK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_DKK IsoCurrencyCode = 41
+	// @xml: "DOP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_DOP IsoCurrencyCode = 42
+	// @xml: "DZD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_DZD IsoCurrencyCode = 43
+	// @xml: "EGP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_EGP IsoCurrencyCode = 44
+	// @xml: "ERN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ERN IsoCurrencyCode = 45
+	// @xml: "ETB"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ETB IsoCurrencyCode = 46
+	// @xml: "EUR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_EUR IsoCurrencyCode = 47
+	// @xml: "FJD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_FJD IsoCurrencyCode = 48
+	// @xml: "FKP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_FKP IsoCurrencyCode = 49
+	// @xml: "GBP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GBP IsoCurrencyCode = 50
+	// @xml: "GEL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GEL IsoCurrencyCode = 51
+	// @xml: "GHS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GHS IsoCurrencyCode = 52
+	// @xml: "GIP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GIP IsoCurrencyCode = 53
+	// @xml: "GMD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GMD IsoCurrencyCode = 54
+	// @xml: "GNF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GNF IsoCurrencyCode = 55
+	// @xml: "GTQ"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GTQ IsoCurrencyCode = 56
+	// @xml: "GYD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_GYD IsoCurrencyCode = 57
+	// @xml: "HKD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_HKD IsoCurrencyCode = 58
+	// @xml: "HNL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_HNL IsoCurrencyCode = 59
+	// @xml: "HRK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_HRK IsoCurrencyCode = 60
+	// @xml: "HTG"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_HTG IsoCurrencyCode = 61
+	// @xml: "HUF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_HUF IsoCurrencyCode = 62
+	// @xml: "IDR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_IDR IsoCurrencyCode = 63
+	// @xml: "ILS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ILS IsoCurrencyCode = 64
+	// @xml: "INR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_INR IsoCurrencyCode = 65
+	// @xml: "IQD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_IQD IsoCurrencyCode = 66
+	// @xml: "IRR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_IRR IsoCurrencyCode = 67
+	// @xml: "ISK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ISK IsoCurrencyCode = 68
+	// @xml: "JMD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_JMD IsoCurrencyCode = 69
+	// @xml: "JOD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_JOD IsoCurrencyCode = 70
+	// @xml: "JPY"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_JPY IsoCurrencyCode = 71
+	// @xml: "KES"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KES IsoCurrencyCode = 72
+	// @xml: "KGS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KGS IsoCurrencyCode = 73
+	// @xml: "KHR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KHR IsoCurrencyCode = 74
+	// @xml: "KMF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KMF IsoCurrencyCode = 75
+	// @xml: "KPW"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KPW IsoCurrencyCode = 76
+	// @xml: "KRW"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KRW IsoCurrencyCode = 77
+	// @xml: "KWD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KWD IsoCurrencyCode = 78
+	// @xml: "KYD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KYD IsoCurrencyCode = 79
+	// @xml: "KZT"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_KZT IsoCurrencyCode = 80
+	// @xml: "LAK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LAK IsoCurrencyCode = 81
+	// @xml: "LBP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LBP IsoCurrencyCode = 82
+	// @xml: "LKR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LKR IsoCurrencyCode = 83
+	// @xml: "LRD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LRD IsoCurrencyCode = 84
+	// @xml: "LSL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LSL IsoCurrencyCode = 85
+	// @xml: "LTL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LTL IsoCurrencyCode = 86
+	// @xml: "LVL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LVL IsoCurrencyCode = 87
+	// @xml: "LYD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_LYD IsoCurrencyCode = 88
+	// @xml: "MAD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MAD IsoCurrencyCode = 89
+	// @xml: "MDL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MDL IsoCurrencyCode = 90
+	// @xml: "MGA"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MGA IsoCurrencyCode = 91
+	// @xml: "MKD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MKD IsoCurrencyCode = 92
+	// @xml: "MMK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MMK IsoCurrencyCode = 93
+	// @xml: "MNT"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MNT IsoCurrencyCode = 94
+	// @xml: "MOP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MOP IsoCurrencyCode = 95
+	// @xml: "MRO"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MRO IsoCurrencyCode = 96
+	// @xml: "MUR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MUR IsoCurrencyCode = 97
+	// @xml: "MVR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MVR IsoCurrencyCode = 98
+	// @xml: "MWK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MWK IsoCurrencyCode = 99
+	// @xml: "MXN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MXN IsoCurrencyCode = 100
+	// @xml: "MXV"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MXV IsoCurrencyCode = 101
+	// @xml: "MYR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MYR IsoCurrencyCode = 102
+	// @xml: "MZM"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MZM IsoCurrencyCode = 103
+	// @xml: "NAD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_NAD IsoCurrencyCode = 104
+	// @xml: "NGN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_NGN IsoCurrencyCode = 105
+	// @xml: "NIO"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_NIO IsoCurrencyCode = 106
+	// @xml: "NOK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_NOK IsoCurrencyCode = 107
+	// @xml: "NPR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_NPR IsoCurrencyCode = 108
+	// @xml: "NZD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_NZD IsoCurrencyCode = 109
+	// @xml: "OMR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_OMR IsoCurrencyCode = 110
+	// @xml: "PAB"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_PAB IsoCurrencyCode = 111
+	// @xml: "PEN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_PEN IsoCurrencyCode = 112
+	// @xml: "PGK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_PGK IsoCurrencyCode = 113
+	// @xml: "PHP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_PHP IsoCurrencyCode = 114
+	// @xml: "PKR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_PKR IsoCurrencyCode = 115
+	// @xml: "PLN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_PLN IsoCurrencyCode = 116
+	// @xml: "PYG"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_PYG IsoCurrencyCode = 117
+	// @xml: "QAR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_QAR IsoCurrencyCode = 118
+	// @xml: "RON"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_RON IsoCurrencyCode = 119
+	// @xml: "RSD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_RSD IsoCurrencyCode = 120
+	// @xml: "RUB"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_RUB IsoCurrencyCode = 121
+	// @xml: "RWF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_RWF IsoCurrencyCode = 122
+	// @xml: "SAR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SAR IsoCurrencyCode = 123
+	// @xml: "SBD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SBD IsoCurrencyCode = 124
+	// @xml: "SCR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SCR IsoCurrencyCode = 125
+	// @xml: "SDG"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SDG IsoCurrencyCode = 126
+	// @xml: "SEK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SEK IsoCurrencyCode = 127
+	// @xml: "SGD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SGD IsoCurrencyCode = 128
+	// @xml: "SHP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SHP IsoCurrencyCode = 129
+	// @xml: "SLL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SLL IsoCurrencyCode = 130
+	// @xml: "SOS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SOS IsoCurrencyCode = 131
+	// @xml: "SRD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SRD IsoCurrencyCode = 132
+	// @xml: "STD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_STD IsoCurrencyCode = 133
+	// @xml: "SVC"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SVC IsoCurrencyCode = 134
+	// @xml: "SYP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SYP IsoCurrencyCode = 135
+	// @xml: "SZL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SZL IsoCurrencyCode = 136
+	// @xml: "THB"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_THB IsoCurrencyCode = 137
+	// @xml: "TJS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TJS IsoCurrencyCode = 138
+	// @xml: "TMT"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TMT IsoCurrencyCode = 139
+	// @xml: "TND"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TND IsoCurrencyCode = 140
+	// @xml: "TOP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TOP IsoCurrencyCode = 141
+	// @xml: "TRY"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TRY IsoCurrencyCode = 142
+	// @xml: "TTD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TTD IsoCurrencyCode = 143
+	// @xml: "TWD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TWD IsoCurrencyCode = 144
+	// @xml: "TZS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_TZS IsoCurrencyCode = 145
+	// @xml: "UAH"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_UAH IsoCurrencyCode = 146
+	// @xml: "UGX"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_UGX IsoCurrencyCode = 147
+	// @xml: "USD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_USD IsoCurrencyCode = 148
+	// @xml: "UYI"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_UYI IsoCurrencyCode = 149
+	// @xml: "UYU"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_UYU IsoCurrencyCode = 150
+	// @xml: "UZS"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_UZS IsoCurrencyCode = 151
+	// @xml: "VEF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_VEF IsoCurrencyCode = 152
+	// @xml: "VND"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_VND IsoCurrencyCode = 153
+	// @xml: "VUV"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_VUV IsoCurrencyCode = 154
+	// @xml: "WST"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_WST IsoCurrencyCode = 155
+	// @xml: "XAF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_XAF IsoCurrencyCode = 156
+	// @xml: "XCD"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_XCD IsoCurrencyCode = 157
+	// @xml: "XOF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_XOF IsoCurrencyCode = 158
+	// @xml: "XPF"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_XPF IsoCurrencyCode = 159
+	// @xml: "YER"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_YER IsoCurrencyCode = 160
+	// @xml: "ZAR"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ZAR IsoCurrencyCode = 161
+	// @xml: "ZMK"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ZMK IsoCurrencyCode = 162
+	// @xml: "ZWL"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ZWL IsoCurrencyCode = 163
+	// @xml: "MRU"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MRU IsoCurrencyCode = 164
+	// @xml: "MZN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_MZN IsoCurrencyCode = 165
+	// @xml: "SSP"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_SSP IsoCurrencyCode = 166
+	// @xml: "STN"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_STN IsoCurrencyCode = 167
+	// @xml: "VES"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_VES IsoCurrencyCode = 168
+	// @xml: "ZMW"
+	IsoCurrencyCode_ISO_CURRENCY_CODE_ZMW IsoCurrencyCode = 169
 )
 
 // Enum value maps for IsoCurrencyCode.
@@ -7895,702 +9776,1398 @@ type IsoLanguageCode int32
 
 const (
 	IsoLanguageCode_ISO_LANGUAGE_CODE_UNSPECIFIED IsoLanguageCode = 0
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RAJ         IsoLanguageCode = 1
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BHO         IsoLanguageCode = 2
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AA          IsoLanguageCode = 3
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AB          IsoLanguageCode = 4
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AE          IsoLanguageCode = 5
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AF          IsoLanguageCode = 6
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AK          IsoLanguageCode = 7
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AM          IsoLanguageCode = 8
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AN          IsoLanguageCode = 9
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AR          IsoLanguageCode = 10
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AS          IsoLanguageCode = 11
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AV          IsoLanguageCode = 12
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AY          IsoLanguageCode = 13
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AZ          IsoLanguageCode = 14
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BA          IsoLanguageCode = 15
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BE          IsoLanguageCode = 16
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BG          IsoLanguageCode = 17
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BH          IsoLanguageCode = 18
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BI          IsoLanguageCode = 19
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BM          IsoLanguageCode = 20
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BN          IsoLanguageCode = 21
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BO          IsoLanguageCode = 22
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BR          IsoLanguageCode = 23
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BS          IsoLanguageCode = 24
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CA          IsoLanguageCode = 25
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CE          IsoLanguageCode = 26
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CH          IsoLanguageCode = 27
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CO          IsoLanguageCode = 28
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CR          IsoLanguageCode = 29
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CS          IsoLanguageCode = 30
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CU          IsoLanguageCode = 31
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CV          IsoLanguageCode = 32
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CY          IsoLanguageCode = 33
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DA          IsoLanguageCode = 34
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DE          IsoLanguageCode = 35
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DV          IsoLanguageCode = 36
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DZ          IsoLanguageCode = 37
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EE          IsoLanguageCode = 38
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EL          IsoLanguageCode = 39
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EN          IsoLanguageCode = 40
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EO          IsoLanguageCode = 41
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ES          IsoLanguageCode = 42
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ET          IsoLanguageCode = 43
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EU          IsoLanguageCode = 44
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FA          IsoLanguageCode = 45
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FF          IsoLanguageCode = 46
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FI          IsoLanguageCode = 47
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FJ          IsoLanguageCode = 48
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FO          IsoLanguageCode = 49
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FR          IsoLanguageCode = 50
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FY          IsoLanguageCode = 51
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GA          IsoLanguageCode = 52
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GD          IsoLanguageCode = 53
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GL          IsoLanguageCode = 54
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GN          IsoLanguageCode = 55
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GU          IsoLanguageCode = 56
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GV          IsoLanguageCode = 57
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HA          IsoLanguageCode = 58
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HE          IsoLanguageCode = 59
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HI          IsoLanguageCode = 60
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HO          IsoLanguageCode = 61
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HR          IsoLanguageCode = 62
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HT          IsoLanguageCode = 63
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HU          IsoLanguageCode = 64
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HY          IsoLanguageCode = 65
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HZ          IsoLanguageCode = 66
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IA          IsoLanguageCode = 67
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ID          IsoLanguageCode = 68
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IE          IsoLanguageCode = 69
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IG          IsoLanguageCode = 70
-	IsoLanguageCode_ISO_LANGUAGE_CODE_II          IsoLanguageCode = 71
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IK          IsoLanguageCode = 72
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IO          IsoLanguageCode = 73
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IS          IsoLanguageCode = 74
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IT          IsoLanguageCode = 75
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IU          IsoLanguageCode = 76
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JA          IsoLanguageCode = 77
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JV          IsoLanguageCode = 78
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KA          IsoLanguageCode = 79
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KG          IsoLanguageCode = 80
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KI          IsoLanguageCode = 81
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KJ          IsoLanguageCode = 82
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KK          IsoLanguageCode = 83
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KL          IsoLanguageCode = 84
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KM          IsoLanguageCode = 85
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KN          IsoLanguageCode = 86
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KO          IsoLanguageCode = 87
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KR          IsoLanguageCode = 88
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KS          IsoLanguageCode = 89
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KU          IsoLanguageCode = 90
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KV          IsoLanguageCode = 91
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KW          IsoLanguageCode = 92
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KY          IsoLanguageCode = 93
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LA          IsoLanguageCode = 94
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LB          IsoLanguageCode = 95
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LG          IsoLanguageCode = 96
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LI          IsoLanguageCode = 97
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LN          IsoLanguageCode = 98
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LO          IsoLanguageCode = 99
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LT          IsoLanguageCode = 100
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LU          IsoLanguageCode = 101
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LV          IsoLanguageCode = 102
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MG          IsoLanguageCode = 103
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MH          IsoLanguageCode = 104
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MI          IsoLanguageCode = 105
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MK          IsoLanguageCode = 106
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ML          IsoLanguageCode = 107
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MN          IsoLanguageCode = 108
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MO          IsoLanguageCode = 109
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MR          IsoLanguageCode = 110
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MS          IsoLanguageCode = 111
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MT          IsoLanguageCode = 112
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MY          IsoLanguageCode = 113
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NA          IsoLanguageCode = 114
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NB          IsoLanguageCode = 115
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ND          IsoLanguageCode = 116
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NE          IsoLanguageCode = 117
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NG          IsoLanguageCode = 118
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NL          IsoLanguageCode = 119
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NN          IsoLanguageCode = 120
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NO          IsoLanguageCode = 121
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NR          IsoLanguageCode = 122
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NV          IsoLanguageCode = 123
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NY          IsoLanguageCode = 124
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OC          IsoLanguageCode = 125
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OJ          IsoLanguageCode = 126
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OM          IsoLanguageCode = 127
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OR          IsoLanguageCode = 128
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OS          IsoLanguageCode = 129
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PA          IsoLanguageCode = 130
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PI          IsoLanguageCode = 131
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PL          IsoLanguageCode = 132
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PS          IsoLanguageCode = 133
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PT          IsoLanguageCode = 134
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QU          IsoLanguageCode = 135
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RM          IsoLanguageCode = 136
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RN          IsoLanguageCode = 137
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RO          IsoLanguageCode = 138
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RU          IsoLanguageCode = 139
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RW          IsoLanguageCode = 140
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SA          IsoLanguageCode = 141
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SC          IsoLanguageCode = 142
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SD          IsoLanguageCode = 143
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SE          IsoLanguageCode = 144
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SG          IsoLanguageCode = 145
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SI          IsoLanguageCode = 146
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SK          IsoLanguageCode = 147
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SL          IsoLanguageCode = 148
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SM          IsoLanguageCode = 149
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SN          IsoLanguageCode = 150
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SO          IsoLanguageCode = 151
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SQ          IsoLanguageCode = 152
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SR          IsoLanguageCode = 153
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SS          IsoLanguageCode = 154
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ST          IsoLanguageCode = 155
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SU          IsoLanguageCode = 156
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SV          IsoLanguageCode = 157
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SW          IsoLanguageCode = 158
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TA          IsoLanguageCode = 159
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TE          IsoLanguageCode = 160
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TG          IsoLanguageCode = 161
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TH          IsoLanguageCode = 162
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TI          IsoLanguageCode = 163
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TK          IsoLanguageCode = 164
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TL          IsoLanguageCode = 165
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TN          IsoLanguageCode = 166
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TO          IsoLanguageCode = 167
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TR          IsoLanguageCode = 168
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TS          IsoLanguageCode = 169
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TT          IsoLanguageCode = 170
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TW          IsoLanguageCode = 171
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TY          IsoLanguageCode = 172
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UG          IsoLanguageCode = 173
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UK          IsoLanguageCode = 174
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UR          IsoLanguageCode = 175
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UZ          IsoLanguageCode = 176
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VE          IsoLanguageCode = 177
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VI          IsoLanguageCode = 178
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VO          IsoLanguageCode = 179
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WA          IsoLanguageCode = 180
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WO          IsoLanguageCode = 181
-	IsoLanguageCode_ISO_LANGUAGE_CODE_XH          IsoLanguageCode = 182
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YI          IsoLanguageCode = 183
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YO          IsoLanguageCode = 184
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZA          IsoLanguageCode = 185
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZH          IsoLanguageCode = 186
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZU          IsoLanguageCode = 187
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BGC         IsoLanguageCode = 188
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQA         IsoLanguageCode = 189
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQB         IsoLanguageCode = 190
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQC         IsoLanguageCode = 191
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQD         IsoLanguageCode = 192
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQE         IsoLanguageCode = 193
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQF         IsoLanguageCode = 194
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQG         IsoLanguageCode = 195
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQH         IsoLanguageCode = 196
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQI         IsoLanguageCode = 197
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QQJ         IsoLanguageCode = 198
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AAR         IsoLanguageCode = 199
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ABK         IsoLanguageCode = 200
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AVE         IsoLanguageCode = 201
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AFR         IsoLanguageCode = 202
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AKA         IsoLanguageCode = 203
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AMH         IsoLanguageCode = 204
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ARG         IsoLanguageCode = 205
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ARA         IsoLanguageCode = 206
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ASM         IsoLanguageCode = 207
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AVA         IsoLanguageCode = 208
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AYM         IsoLanguageCode = 209
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AZE         IsoLanguageCode = 210
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAK         IsoLanguageCode = 211
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BEL         IsoLanguageCode = 212
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BUL         IsoLanguageCode = 213
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BIH         IsoLanguageCode = 214
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BIS         IsoLanguageCode = 215
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAM         IsoLanguageCode = 216
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BEN         IsoLanguageCode = 217
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BOD         IsoLanguageCode = 218
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BRE         IsoLanguageCode = 219
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BOS         IsoLanguageCode = 220
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CAT         IsoLanguageCode = 221
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHE         IsoLanguageCode = 222
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHA         IsoLanguageCode = 223
-	IsoLanguageCode_ISO_LANGUAGE_CODE_COS         IsoLanguageCode = 224
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CRE         IsoLanguageCode = 225
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CES         IsoLanguageCode = 226
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHU         IsoLanguageCode = 227
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHV         IsoLanguageCode = 228
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CYM         IsoLanguageCode = 229
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DAN         IsoLanguageCode = 230
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DEU         IsoLanguageCode = 231
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DIV         IsoLanguageCode = 232
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DZO         IsoLanguageCode = 233
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EWE         IsoLanguageCode = 234
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ELL         IsoLanguageCode = 235
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ENG         IsoLanguageCode = 236
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EPO         IsoLanguageCode = 237
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SPA         IsoLanguageCode = 238
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EST         IsoLanguageCode = 239
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EUS         IsoLanguageCode = 240
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FAS         IsoLanguageCode = 241
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FUL         IsoLanguageCode = 242
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FIN         IsoLanguageCode = 243
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FIJ         IsoLanguageCode = 244
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FAO         IsoLanguageCode = 245
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FRA         IsoLanguageCode = 246
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FRY         IsoLanguageCode = 247
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GLE         IsoLanguageCode = 248
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GLA         IsoLanguageCode = 249
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GLG         IsoLanguageCode = 250
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GRN         IsoLanguageCode = 251
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GUJ         IsoLanguageCode = 252
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GLV         IsoLanguageCode = 253
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HAU         IsoLanguageCode = 254
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HEB         IsoLanguageCode = 255
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HIN         IsoLanguageCode = 256
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HMO         IsoLanguageCode = 257
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HRV         IsoLanguageCode = 258
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HAT         IsoLanguageCode = 259
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HUN         IsoLanguageCode = 260
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HYE         IsoLanguageCode = 261
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HER         IsoLanguageCode = 262
-	IsoLanguageCode_ISO_LANGUAGE_CODE_INA         IsoLanguageCode = 263
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IND         IsoLanguageCode = 264
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ILE         IsoLanguageCode = 265
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IBO         IsoLanguageCode = 266
-	IsoLanguageCode_ISO_LANGUAGE_CODE_III         IsoLanguageCode = 267
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IPK         IsoLanguageCode = 268
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IDO         IsoLanguageCode = 269
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ISL         IsoLanguageCode = 270
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ITA         IsoLanguageCode = 271
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IKU         IsoLanguageCode = 272
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JPN         IsoLanguageCode = 273
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JAV         IsoLanguageCode = 274
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAT         IsoLanguageCode = 275
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KON         IsoLanguageCode = 276
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KIK         IsoLanguageCode = 277
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KUA         IsoLanguageCode = 278
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAZ         IsoLanguageCode = 279
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAL         IsoLanguageCode = 280
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KHM         IsoLanguageCode = 281
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAN         IsoLanguageCode = 282
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KOR         IsoLanguageCode = 283
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAU         IsoLanguageCode = 284
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAS         IsoLanguageCode = 285
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KUR         IsoLanguageCode = 286
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KOM         IsoLanguageCode = 287
-	IsoLanguageCode_ISO_LANGUAGE_CODE_COR         IsoLanguageCode = 288
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KIR         IsoLanguageCode = 289
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LAT         IsoLanguageCode = 290
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LTZ         IsoLanguageCode = 291
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LUG         IsoLanguageCode = 292
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LIM         IsoLanguageCode = 293
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LIN         IsoLanguageCode = 294
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LAO         IsoLanguageCode = 295
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LIT         IsoLanguageCode = 296
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LUB         IsoLanguageCode = 297
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LAV         IsoLanguageCode = 298
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MLG         IsoLanguageCode = 299
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAH         IsoLanguageCode = 300
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MRI         IsoLanguageCode = 301
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MKD         IsoLanguageCode = 302
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAL         IsoLanguageCode = 303
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MON         IsoLanguageCode = 304
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAR         IsoLanguageCode = 305
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MSA         IsoLanguageCode = 306
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MLT         IsoLanguageCode = 307
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MYA         IsoLanguageCode = 308
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NAU         IsoLanguageCode = 309
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NOB         IsoLanguageCode = 310
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NDE         IsoLanguageCode = 311
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NEP         IsoLanguageCode = 312
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NDO         IsoLanguageCode = 313
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NLD         IsoLanguageCode = 314
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NNO         IsoLanguageCode = 315
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NOR         IsoLanguageCode = 316
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NBL         IsoLanguageCode = 317
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NAV         IsoLanguageCode = 318
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NYA         IsoLanguageCode = 319
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OCI         IsoLanguageCode = 320
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OJI         IsoLanguageCode = 321
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ORM         IsoLanguageCode = 322
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ORI         IsoLanguageCode = 323
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OSS         IsoLanguageCode = 324
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PAN         IsoLanguageCode = 325
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PLI         IsoLanguageCode = 326
-	IsoLanguageCode_ISO_LANGUAGE_CODE_POL         IsoLanguageCode = 327
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PUS         IsoLanguageCode = 328
-	IsoLanguageCode_ISO_LANGUAGE_CODE_POR         IsoLanguageCode = 329
-	IsoLanguageCode_ISO_LANGUAGE_CODE_QUE         IsoLanguageCode = 330
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ROH         IsoLanguageCode = 331
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RUN         IsoLanguageCode = 332
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RON         IsoLanguageCode = 333
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RUS         IsoLanguageCode = 334
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KIN         IsoLanguageCode = 335
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAN         IsoLanguageCode = 336
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SRD         IsoLanguageCode = 337
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SND         IsoLanguageCode = 338
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SME         IsoLanguageCode = 339
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAG         IsoLanguageCode = 340
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SIN         IsoLanguageCode = 341
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SLK         IsoLanguageCode = 342
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SLV         IsoLanguageCode = 343
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SMO         IsoLanguageCode = 344
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SNA         IsoLanguageCode = 345
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SOM         IsoLanguageCode = 346
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SQI         IsoLanguageCode = 347
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SRP         IsoLanguageCode = 348
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SSW         IsoLanguageCode = 349
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SOT         IsoLanguageCode = 350
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SUN         IsoLanguageCode = 351
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SWE         IsoLanguageCode = 352
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SWA         IsoLanguageCode = 353
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TAM         IsoLanguageCode = 354
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TEL         IsoLanguageCode = 355
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TGK         IsoLanguageCode = 356
-	IsoLanguageCode_ISO_LANGUAGE_CODE_THA         IsoLanguageCode = 357
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TIR         IsoLanguageCode = 358
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TUK         IsoLanguageCode = 359
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TGL         IsoLanguageCode = 360
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TSN         IsoLanguageCode = 361
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TON         IsoLanguageCode = 362
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TUR         IsoLanguageCode = 363
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TSO         IsoLanguageCode = 364
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TAT         IsoLanguageCode = 365
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TWI         IsoLanguageCode = 366
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TAH         IsoLanguageCode = 367
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UIG         IsoLanguageCode = 368
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UKR         IsoLanguageCode = 369
-	IsoLanguageCode_ISO_LANGUAGE_CODE_URD         IsoLanguageCode = 370
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UZB         IsoLanguageCode = 371
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VEN         IsoLanguageCode = 372
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VIE         IsoLanguageCode = 373
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VOL         IsoLanguageCode = 374
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WLN         IsoLanguageCode = 375
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WOL         IsoLanguageCode = 376
-	IsoLanguageCode_ISO_LANGUAGE_CODE_XHO         IsoLanguageCode = 377
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YID         IsoLanguageCode = 378
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YOR         IsoLanguageCode = 379
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZHA         IsoLanguageCode = 380
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZHO         IsoLanguageCode = 381
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZUL         IsoLanguageCode = 382
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ACE         IsoLanguageCode = 383
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ACH         IsoLanguageCode = 384
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ADA         IsoLanguageCode = 385
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ADY         IsoLanguageCode = 386
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AFA         IsoLanguageCode = 387
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AFH         IsoLanguageCode = 388
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AIN         IsoLanguageCode = 389
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AKK         IsoLanguageCode = 390
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ALE         IsoLanguageCode = 391
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ALG         IsoLanguageCode = 392
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ALT         IsoLanguageCode = 393
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ANG         IsoLanguageCode = 394
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ANP         IsoLanguageCode = 395
-	IsoLanguageCode_ISO_LANGUAGE_CODE_APA         IsoLanguageCode = 396
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ARC         IsoLanguageCode = 397
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ARN         IsoLanguageCode = 398
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ARP         IsoLanguageCode = 399
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ART         IsoLanguageCode = 400
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ARW         IsoLanguageCode = 401
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AST         IsoLanguageCode = 402
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ATH         IsoLanguageCode = 403
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AUS         IsoLanguageCode = 404
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AWA         IsoLanguageCode = 405
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAD         IsoLanguageCode = 406
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAI         IsoLanguageCode = 407
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAL         IsoLanguageCode = 408
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAN         IsoLanguageCode = 409
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAS         IsoLanguageCode = 410
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BAT         IsoLanguageCode = 411
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BEJ         IsoLanguageCode = 412
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BEM         IsoLanguageCode = 413
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BER         IsoLanguageCode = 414
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BIK         IsoLanguageCode = 415
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BIN         IsoLanguageCode = 416
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BLA         IsoLanguageCode = 417
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BNT         IsoLanguageCode = 418
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BRA         IsoLanguageCode = 419
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BTK         IsoLanguageCode = 420
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BUA         IsoLanguageCode = 421
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BUG         IsoLanguageCode = 422
-	IsoLanguageCode_ISO_LANGUAGE_CODE_BYN         IsoLanguageCode = 423
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CAD         IsoLanguageCode = 424
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CAI         IsoLanguageCode = 425
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CAR         IsoLanguageCode = 426
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CAU         IsoLanguageCode = 427
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CEB         IsoLanguageCode = 428
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CEL         IsoLanguageCode = 429
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHB         IsoLanguageCode = 430
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHG         IsoLanguageCode = 431
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHK         IsoLanguageCode = 432
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHM         IsoLanguageCode = 433
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHN         IsoLanguageCode = 434
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHO         IsoLanguageCode = 435
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHP         IsoLanguageCode = 436
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHR         IsoLanguageCode = 437
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CHY         IsoLanguageCode = 438
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CMC         IsoLanguageCode = 439
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CNR         IsoLanguageCode = 440
-	IsoLanguageCode_ISO_LANGUAGE_CODE_COP         IsoLanguageCode = 441
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CPE         IsoLanguageCode = 442
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CPF         IsoLanguageCode = 443
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CPP         IsoLanguageCode = 444
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CRH         IsoLanguageCode = 445
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CRP         IsoLanguageCode = 446
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CSB         IsoLanguageCode = 447
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CUS         IsoLanguageCode = 448
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DAK         IsoLanguageCode = 449
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DAR         IsoLanguageCode = 450
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DAY         IsoLanguageCode = 451
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DEL         IsoLanguageCode = 452
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DEN         IsoLanguageCode = 453
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DGR         IsoLanguageCode = 454
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DIN         IsoLanguageCode = 455
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DOI         IsoLanguageCode = 456
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DRA         IsoLanguageCode = 457
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DSB         IsoLanguageCode = 458
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DUA         IsoLanguageCode = 459
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DUM         IsoLanguageCode = 460
-	IsoLanguageCode_ISO_LANGUAGE_CODE_DYU         IsoLanguageCode = 461
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EFI         IsoLanguageCode = 462
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EGY         IsoLanguageCode = 463
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EKA         IsoLanguageCode = 464
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ELX         IsoLanguageCode = 465
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ENM         IsoLanguageCode = 466
-	IsoLanguageCode_ISO_LANGUAGE_CODE_EWO         IsoLanguageCode = 467
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FAN         IsoLanguageCode = 468
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FAT         IsoLanguageCode = 469
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FIL         IsoLanguageCode = 470
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FIU         IsoLanguageCode = 471
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FON         IsoLanguageCode = 472
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FRM         IsoLanguageCode = 473
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FRO         IsoLanguageCode = 474
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FRR         IsoLanguageCode = 475
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FRS         IsoLanguageCode = 476
-	IsoLanguageCode_ISO_LANGUAGE_CODE_FUR         IsoLanguageCode = 477
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GAA         IsoLanguageCode = 478
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GAY         IsoLanguageCode = 479
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GBA         IsoLanguageCode = 480
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GEM         IsoLanguageCode = 481
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GEZ         IsoLanguageCode = 482
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GIL         IsoLanguageCode = 483
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GMH         IsoLanguageCode = 484
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GOH         IsoLanguageCode = 485
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GON         IsoLanguageCode = 486
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GOR         IsoLanguageCode = 487
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GOT         IsoLanguageCode = 488
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GRB         IsoLanguageCode = 489
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GRC         IsoLanguageCode = 490
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GSW         IsoLanguageCode = 491
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GWI         IsoLanguageCode = 492
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HAI         IsoLanguageCode = 493
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HAW         IsoLanguageCode = 494
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HIL         IsoLanguageCode = 495
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HIM         IsoLanguageCode = 496
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HIT         IsoLanguageCode = 497
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HMN         IsoLanguageCode = 498
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HSB         IsoLanguageCode = 499
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HUP         IsoLanguageCode = 500
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IBA         IsoLanguageCode = 501
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IJO         IsoLanguageCode = 502
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ILO         IsoLanguageCode = 503
-	IsoLanguageCode_ISO_LANGUAGE_CODE_INC         IsoLanguageCode = 504
-	IsoLanguageCode_ISO_LANGUAGE_CODE_INE         IsoLanguageCode = 505
-	IsoLanguageCode_ISO_LANGUAGE_CODE_INH         IsoLanguageCode = 506
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IRA         IsoLanguageCode = 507
-	IsoLanguageCode_ISO_LANGUAGE_CODE_IRO         IsoLanguageCode = 508
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JBO         IsoLanguageCode = 509
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JPR         IsoLanguageCode = 510
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JRB         IsoLanguageCode = 511
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAA         IsoLanguageCode = 512
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAB         IsoLanguageCode = 513
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAC         IsoLanguageCode = 514
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAM         IsoLanguageCode = 515
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAR         IsoLanguageCode = 516
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KAW         IsoLanguageCode = 517
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KBD         IsoLanguageCode = 518
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KHA         IsoLanguageCode = 519
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KHI         IsoLanguageCode = 520
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KHO         IsoLanguageCode = 521
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KMB         IsoLanguageCode = 522
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KOK         IsoLanguageCode = 523
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KOS         IsoLanguageCode = 524
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KPE         IsoLanguageCode = 525
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KRC         IsoLanguageCode = 526
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KRL         IsoLanguageCode = 527
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KRO         IsoLanguageCode = 528
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KRU         IsoLanguageCode = 529
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KUM         IsoLanguageCode = 530
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KUT         IsoLanguageCode = 531
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LAD         IsoLanguageCode = 532
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LAH         IsoLanguageCode = 533
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LAM         IsoLanguageCode = 534
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LEZ         IsoLanguageCode = 535
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LOL         IsoLanguageCode = 536
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LOZ         IsoLanguageCode = 537
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LUA         IsoLanguageCode = 538
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LUI         IsoLanguageCode = 539
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LUN         IsoLanguageCode = 540
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LUO         IsoLanguageCode = 541
-	IsoLanguageCode_ISO_LANGUAGE_CODE_LUS         IsoLanguageCode = 542
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAD         IsoLanguageCode = 543
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAG         IsoLanguageCode = 544
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAI         IsoLanguageCode = 545
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAK         IsoLanguageCode = 546
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAN         IsoLanguageCode = 547
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAP         IsoLanguageCode = 548
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MAS         IsoLanguageCode = 549
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MDF         IsoLanguageCode = 550
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MDR         IsoLanguageCode = 551
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MEN         IsoLanguageCode = 552
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MGA         IsoLanguageCode = 553
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MIC         IsoLanguageCode = 554
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MIN         IsoLanguageCode = 555
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MIS         IsoLanguageCode = 556
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MKH         IsoLanguageCode = 557
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MNC         IsoLanguageCode = 558
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MNI         IsoLanguageCode = 559
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MNO         IsoLanguageCode = 560
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MOH         IsoLanguageCode = 561
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MOS         IsoLanguageCode = 562
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MUL         IsoLanguageCode = 563
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MUN         IsoLanguageCode = 564
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MUS         IsoLanguageCode = 565
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MWL         IsoLanguageCode = 566
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MWR         IsoLanguageCode = 567
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MYN         IsoLanguageCode = 568
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MYV         IsoLanguageCode = 569
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NAH         IsoLanguageCode = 570
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NAI         IsoLanguageCode = 571
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NAP         IsoLanguageCode = 572
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NDS         IsoLanguageCode = 573
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NEW         IsoLanguageCode = 574
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NIA         IsoLanguageCode = 575
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NIC         IsoLanguageCode = 576
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NIU         IsoLanguageCode = 577
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NOG         IsoLanguageCode = 578
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NON         IsoLanguageCode = 579
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NQO         IsoLanguageCode = 580
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NSO         IsoLanguageCode = 581
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NUB         IsoLanguageCode = 582
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NWC         IsoLanguageCode = 583
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NYM         IsoLanguageCode = 584
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NYN         IsoLanguageCode = 585
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NYO         IsoLanguageCode = 586
-	IsoLanguageCode_ISO_LANGUAGE_CODE_NZI         IsoLanguageCode = 587
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OSA         IsoLanguageCode = 588
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OTA         IsoLanguageCode = 589
-	IsoLanguageCode_ISO_LANGUAGE_CODE_OTO         IsoLanguageCode = 590
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PAA         IsoLanguageCode = 591
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PAG         IsoLanguageCode = 592
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PAL         IsoLanguageCode = 593
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PAM         IsoLanguageCode = 594
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PAP         IsoLanguageCode = 595
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PAU         IsoLanguageCode = 596
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PEO         IsoLanguageCode = 597
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PHI         IsoLanguageCode = 598
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PHN         IsoLanguageCode = 599
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PON         IsoLanguageCode = 600
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PRA         IsoLanguageCode = 601
-	IsoLanguageCode_ISO_LANGUAGE_CODE_PRO         IsoLanguageCode = 602
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RAP         IsoLanguageCode = 603
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RAR         IsoLanguageCode = 604
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ROA         IsoLanguageCode = 605
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ROM         IsoLanguageCode = 606
-	IsoLanguageCode_ISO_LANGUAGE_CODE_RUP         IsoLanguageCode = 607
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAD         IsoLanguageCode = 608
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAH         IsoLanguageCode = 609
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAI         IsoLanguageCode = 610
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAL         IsoLanguageCode = 611
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAM         IsoLanguageCode = 612
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAS         IsoLanguageCode = 613
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SAT         IsoLanguageCode = 614
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SCN         IsoLanguageCode = 615
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SCO         IsoLanguageCode = 616
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SEL         IsoLanguageCode = 617
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SEM         IsoLanguageCode = 618
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SGA         IsoLanguageCode = 619
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SGN         IsoLanguageCode = 620
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SHN         IsoLanguageCode = 621
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SID         IsoLanguageCode = 622
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SIO         IsoLanguageCode = 623
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SIT         IsoLanguageCode = 624
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SLA         IsoLanguageCode = 625
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SMA         IsoLanguageCode = 626
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SMI         IsoLanguageCode = 627
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SMJ         IsoLanguageCode = 628
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SMN         IsoLanguageCode = 629
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SMS         IsoLanguageCode = 630
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SNK         IsoLanguageCode = 631
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SOG         IsoLanguageCode = 632
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SON         IsoLanguageCode = 633
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SRN         IsoLanguageCode = 634
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SRR         IsoLanguageCode = 635
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SSA         IsoLanguageCode = 636
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SUK         IsoLanguageCode = 637
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SUS         IsoLanguageCode = 638
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SUX         IsoLanguageCode = 639
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SYC         IsoLanguageCode = 640
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SYR         IsoLanguageCode = 641
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TAI         IsoLanguageCode = 642
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TEM         IsoLanguageCode = 643
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TER         IsoLanguageCode = 644
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TET         IsoLanguageCode = 645
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TIG         IsoLanguageCode = 646
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TIV         IsoLanguageCode = 647
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TKL         IsoLanguageCode = 648
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TLH         IsoLanguageCode = 649
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TLI         IsoLanguageCode = 650
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TMH         IsoLanguageCode = 651
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TOG         IsoLanguageCode = 652
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TPI         IsoLanguageCode = 653
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TSI         IsoLanguageCode = 654
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TUM         IsoLanguageCode = 655
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TUP         IsoLanguageCode = 656
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TUT         IsoLanguageCode = 657
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TVL         IsoLanguageCode = 658
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TYV         IsoLanguageCode = 659
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UDM         IsoLanguageCode = 660
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UGA         IsoLanguageCode = 661
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UMB         IsoLanguageCode = 662
-	IsoLanguageCode_ISO_LANGUAGE_CODE_UND         IsoLanguageCode = 663
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VAI         IsoLanguageCode = 664
-	IsoLanguageCode_ISO_LANGUAGE_CODE_VOT         IsoLanguageCode = 665
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WAK         IsoLanguageCode = 666
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WAL         IsoLanguageCode = 667
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WAR         IsoLanguageCode = 668
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WAS         IsoLanguageCode = 669
-	IsoLanguageCode_ISO_LANGUAGE_CODE_WEN         IsoLanguageCode = 670
-	IsoLanguageCode_ISO_LANGUAGE_CODE_XAL         IsoLanguageCode = 671
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YAO         IsoLanguageCode = 672
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YAP         IsoLanguageCode = 673
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YPK         IsoLanguageCode = 674
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZAP         IsoLanguageCode = 675
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZBL         IsoLanguageCode = 676
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZEN         IsoLanguageCode = 677
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZGH         IsoLanguageCode = 678
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZND         IsoLanguageCode = 679
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZUN         IsoLanguageCode = 680
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZXX         IsoLanguageCode = 681
-	IsoLanguageCode_ISO_LANGUAGE_CODE_ZZA         IsoLanguageCode = 682
-	IsoLanguageCode_ISO_LANGUAGE_CODE_AAA         IsoLanguageCode = 683
-	IsoLanguageCode_ISO_LANGUAGE_CODE_HNE         IsoLanguageCode = 684
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GBM         IsoLanguageCode = 685
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KHW         IsoLanguageCode = 686
-	IsoLanguageCode_ISO_LANGUAGE_CODE_CMN         IsoLanguageCode = 687
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SCK         IsoLanguageCode = 688
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SPV         IsoLanguageCode = 689
-	IsoLanguageCode_ISO_LANGUAGE_CODE_SCL         IsoLanguageCode = 690
-	IsoLanguageCode_ISO_LANGUAGE_CODE_YUE         IsoLanguageCode = 691
-	IsoLanguageCode_ISO_LANGUAGE_CODE_TCY         IsoLanguageCode = 692
-	IsoLanguageCode_ISO_LANGUAGE_CODE_KEY         IsoLanguageCode = 693
-	IsoLanguageCode_ISO_LANGUAGE_CODE_GCF         IsoLanguageCode = 694
-	IsoLanguageCode_ISO_LANGUAGE_CODE_JAM         IsoLanguageCode = 695
-	IsoLanguageCode_ISO_LANGUAGE_CODE_MCM         IsoLanguageCode = 696
+	// @xml: "raj"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RAJ IsoLanguageCode = 1
+	// @xml: "bho"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BHO IsoLanguageCode = 2
+	// @xml: "aa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AA IsoLanguageCode = 3
+	// @xml: "ab"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AB IsoLanguageCode = 4
+	// @xml: "ae"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AE IsoLanguageCode = 5
+	// @xml: "af"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AF IsoLanguageCode = 6
+	// @xml: "ak"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AK IsoLanguageCode = 7
+	// @xml: "am"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AM IsoLanguageCode = 8
+	// @xml: "an"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AN IsoLanguageCode = 9
+	// @xml: "ar"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AR IsoLanguageCode = 10
+	// @xml: "as"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AS IsoLanguageCode = 11
+	// @xml: "av"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AV IsoLanguageCode = 12
+	// @xml: "ay"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AY IsoLanguageCode = 13
+	// @xml: "az"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AZ IsoLanguageCode = 14
+	// @xml: "ba"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BA IsoLanguageCode = 15
+	// @xml: "be"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BE IsoLanguageCode = 16
+	// @xml: "bg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BG IsoLanguageCode = 17
+	// @xml: "bh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BH IsoLanguageCode = 18
+	// @xml: "bi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BI IsoLanguageCode = 19
+	// @xml: "bm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BM IsoLanguageCode = 20
+	// @xml: "bn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BN IsoLanguageCode = 21
+	// @xml: "bo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BO IsoLanguageCode = 22
+	// @xml: "br"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BR IsoLanguageCode = 23
+	// @xml: "bs"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BS IsoLanguageCode = 24
+	// @xml: "ca"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CA IsoLanguageCode = 25
+	// @xml: "ce"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CE IsoLanguageCode = 26
+	// @xml: "ch"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CH IsoLanguageCode = 27
+	// @xml: "co"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CO IsoLanguageCode = 28
+	// @xml: "cr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CR IsoLanguageCode = 29
+	// @xml: "cs"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CS IsoLanguageCode = 30
+	// @xml: "cu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CU IsoLanguageCode = 31
+	// @xml: "cv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CV IsoLanguageCode = 32
+	// @xml: "cy"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CY IsoLanguageCode = 33
+	// @xml: "da"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DA IsoLanguageCode = 34
+	// @xml: "de"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DE IsoLanguageCode = 35
+	// @xml: "dv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DV IsoLanguageCode = 36
+	// @xml: "dz"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DZ IsoLanguageCode = 37
+	// @xml: "ee"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EE IsoLanguageCode = 38
+	// @xml: "el"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EL IsoLanguageCode = 39
+	// @xml: "en"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EN IsoLanguageCode = 40
+	// @xml: "eo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EO IsoLanguageCode = 41
+	// @xml: "es"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ES IsoLanguageCode = 42
+	// @xml: "et"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ET IsoLanguageCode = 43
+	// @xml: "eu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EU IsoLanguageCode = 44
+	// @xml: "fa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FA IsoLanguageCode = 45
+	// @xml: "ff"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FF IsoLanguageCode = 46
+	// @xml: "fi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FI IsoLanguageCode = 47
+	// @xml: "fj"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FJ IsoLanguageCode = 48
+	// @xml: "fo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FO IsoLanguageCode = 49
+	// @xml: "fr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FR IsoLanguageCode = 50
+	// @xml: "fy"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FY IsoLanguageCode = 51
+	// @xml: "ga"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GA IsoLanguageCode = 52
+	// @xml: "gd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GD IsoLanguageCode = 53
+	// @xml: "gl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GL IsoLanguageCode = 54
+	// @xml: "gn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GN IsoLanguageCode = 55
+	// @xml: "gu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GU IsoLanguageCode = 56
+	// @xml: "gv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GV IsoLanguageCode = 57
+	// @xml: "ha"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HA IsoLanguageCode = 58
+	// @xml: "he"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HE IsoLanguageCode = 59
+	// @xml: "hi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HI IsoLanguageCode = 60
+	// @xml: "ho"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HO IsoLanguageCode = 61
+	// @xml: "hr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HR IsoLanguageCode = 62
+	// @xml: "ht"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HT IsoLanguageCode = 63
+	// @xml: "hu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HU IsoLanguageCode = 64
+	// @xml: "hy"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HY IsoLanguageCode = 65
+	// @xml: "hz"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HZ IsoLanguageCode = 66
+	// @xml: "ia"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IA IsoLanguageCode = 67
+	// @xml: "id"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ID IsoLanguageCode = 68
+	// @xml: "ie"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IE IsoLanguageCode = 69
+	// @xml: "ig"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IG IsoLanguageCode = 70
+	// @xml: "ii"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_II IsoLanguageCode = 71
+	// @xml: "ik"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IK IsoLanguageCode = 72
+	// @xml: "io"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IO IsoLanguageCode = 73
+	// @xml: "is"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IS IsoLanguageCode = 74
+	// @xml: "it"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IT IsoLanguageCode = 75
+	// @xml: "iu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IU IsoLanguageCode = 76
+	// @xml: "ja"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JA IsoLanguageCode = 77
+	// @xml: "jv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JV IsoLanguageCode = 78
+	// @xml: "ka"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KA IsoLanguageCode = 79
+	// @xml: "kg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KG IsoLanguageCode = 80
+	// @xml: "ki"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KI IsoLanguageCode = 81
+	// @xml: "kj"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KJ IsoLanguageCode = 82
+	// @xml: "kk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KK IsoLanguageCode = 83
+	// @xml: "kl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KL IsoLanguageCode = 84
+	// @xml: "km"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KM IsoLanguageCode = 85
+	// @xml: "kn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KN IsoLanguageCode = 86
+	// @xml: "ko"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KO IsoLanguageCode = 87
+	// @xml: "kr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KR IsoLanguageCode = 88
+	// @xml: "ks"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KS IsoLanguageCode = 89
+	// @xml: "ku"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KU IsoLanguageCode = 90
+	// @xml: "kv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KV IsoLanguageCode = 91
+	// @xml: "kw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KW IsoLanguageCode = 92
+	// @xml: "ky"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KY IsoLanguageCode = 93
+	// @xml: "la"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LA IsoLanguageCode = 94
+	// @xml: "lb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LB IsoLanguageCode = 95
+	// @xml: "lg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LG IsoLanguageCode = 96
+	// @xml: "li"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LI IsoLanguageCode = 97
+	// @xml: "ln"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LN IsoLanguageCode = 98
+	// @xml: "lo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LO IsoLanguageCode = 99
+	// @xml: "lt"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LT IsoLanguageCode = 100
+	// @xml: "lu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LU IsoLanguageCode = 101
+	// @xml: "lv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LV IsoLanguageCode = 102
+	// @xml: "mg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MG IsoLanguageCode = 103
+	// @xml: "mh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MH IsoLanguageCode = 104
+	// @xml: "mi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MI IsoLanguageCode = 105
+	// @xml: "mk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MK IsoLanguageCode = 106
+	// @xml: "ml"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ML IsoLanguageCode = 107
+	// @xml: "mn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MN IsoLanguageCode = 108
+	// @xml: "mo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MO IsoLanguageCode = 109
+	// @xml: "mr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MR IsoLanguageCode = 110
+	// @xml: "ms"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MS IsoLanguageCode = 111
+	// @xml: "mt"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MT IsoLanguageCode = 112
+	// @xml: "my"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MY IsoLanguageCode = 113
+	// @xml: "na"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NA IsoLanguageCode = 114
+	// @xml: "nb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NB IsoLanguageCode = 115
+	// @xml: "nd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ND IsoLanguageCode = 116
+	// @xml: "ne"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NE IsoLanguageCode = 117
+	// @xml: "ng"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NG IsoLanguageCode = 118
+	// @xml: "nl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NL IsoLanguageCode = 119
+	// @xml: "nn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NN IsoLanguageCode = 120
+	// @xml: "no"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NO IsoLanguageCode = 121
+	// @xml: "nr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NR IsoLanguageCode = 122
+	// @xml: "nv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NV IsoLanguageCode = 123
+	// @xml: "ny"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NY IsoLanguageCode = 124
+	// @xml: "oc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OC IsoLanguageCode = 125
+	// @xml: "oj"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OJ IsoLanguageCode = 126
+	// @xml: "om"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OM IsoLanguageCode = 127
+	// @xml: "or"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OR IsoLanguageCode = 128
+	// @xml: "os"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OS IsoLanguageCode = 129
+	// @xml: "pa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PA IsoLanguageCode = 130
+	// @xml: "pi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PI IsoLanguageCode = 131
+	// @xml: "pl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PL IsoLanguageCode = 132
+	// @xml: "ps"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PS IsoLanguageCode = 133
+	// @xml: "pt"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PT IsoLanguageCode = 134
+	// @xml: "qu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QU IsoLanguageCode = 135
+	// @xml: "rm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RM IsoLanguageCode = 136
+	// @xml: "rn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RN IsoLanguageCode = 137
+	// @xml: "ro"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RO IsoLanguageCode = 138
+	// @xml: "ru"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RU IsoLanguageCode = 139
+	// @xml: "rw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RW IsoLanguageCode = 140
+	// @xml: "sa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SA IsoLanguageCode = 141
+	// @xml: "sc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SC IsoLanguageCode = 142
+	// @xml: "sd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SD IsoLanguageCode = 143
+	// @xml: "se"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SE IsoLanguageCode = 144
+	// @xml: "sg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SG IsoLanguageCode = 145
+	// @xml: "si"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SI IsoLanguageCode = 146
+	// @xml: "sk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SK IsoLanguageCode = 147
+	// @xml: "sl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SL IsoLanguageCode = 148
+	// @xml: "sm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SM IsoLanguageCode = 149
+	// @xml: "sn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SN IsoLanguageCode = 150
+	// @xml: "so"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SO IsoLanguageCode = 151
+	// @xml: "sq"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SQ IsoLanguageCode = 152
+	// @xml: "sr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SR IsoLanguageCode = 153
+	// @xml: "ss"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SS IsoLanguageCode = 154
+	// @xml: "st"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ST IsoLanguageCode = 155
+	// @xml: "su"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SU IsoLanguageCode = 156
+	// @xml: "sv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SV IsoLanguageCode = 157
+	// @xml: "sw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SW IsoLanguageCode = 158
+	// @xml: "ta"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TA IsoLanguageCode = 159
+	// @xml: "te"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TE IsoLanguageCode = 160
+	// @xml: "tg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TG IsoLanguageCode = 161
+	// @xml: "th"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TH IsoLanguageCode = 162
+	// @xml: "ti"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TI IsoLanguageCode = 163
+	// @xml: "tk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TK IsoLanguageCode = 164
+	// @xml: "tl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TL IsoLanguageCode = 165
+	// @xml: "tn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TN IsoLanguageCode = 166
+	// @xml: "to"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TO IsoLanguageCode = 167
+	// @xml: "tr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TR IsoLanguageCode = 168
+	// @xml: "ts"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TS IsoLanguageCode = 169
+	// @xml: "tt"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TT IsoLanguageCode = 170
+	// @xml: "tw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TW IsoLanguageCode = 171
+	// @xml: "ty"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TY IsoLanguageCode = 172
+	// @xml: "ug"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UG IsoLanguageCode = 173
+	// @xml: "uk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UK IsoLanguageCode = 174
+	// @xml: "ur"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UR IsoLanguageCode = 175
+	// @xml: "uz"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UZ IsoLanguageCode = 176
+	// @xml: "ve"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VE IsoLanguageCode = 177
+	// @xml: "vi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VI IsoLanguageCode = 178
+	// @xml: "vo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VO IsoLanguageCode = 179
+	// @xml: "wa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WA IsoLanguageCode = 180
+	// @xml: "wo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WO IsoLanguageCode = 181
+	// @xml: "xh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_XH IsoLanguageCode = 182
+	// @xml: "yi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YI IsoLanguageCode = 183
+	// @xml: "yo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YO IsoLanguageCode = 184
+	// @xml: "za"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZA IsoLanguageCode = 185
+	// @xml: "zh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZH IsoLanguageCode = 186
+	// @xml: "zu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZU IsoLanguageCode = 187
+	// @xml: "bgc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BGC IsoLanguageCode = 188
+	// @xml: "qqa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQA IsoLanguageCode = 189
+	// @xml: "qqb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQB IsoLanguageCode = 190
+	// @xml: "qqc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQC IsoLanguageCode = 191
+	// @xml: "qqd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQD IsoLanguageCode = 192
+	// @xml: "qqe"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQE IsoLanguageCode = 193
+	// @xml: "qqf"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQF IsoLanguageCode = 194
+	// @xml: "qqg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQG IsoLanguageCode = 195
+	// @xml: "qqh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQH IsoLanguageCode = 196
+	// @xml: "qqi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQI IsoLanguageCode = 197
+	// @xml: "qqj"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QQJ IsoLanguageCode = 198
+	// @xml: "aar"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AAR IsoLanguageCode = 199
+	// @xml: "abk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ABK IsoLanguageCode = 200
+	// @xml: "ave"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AVE IsoLanguageCode = 201
+	// @xml: "afr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AFR IsoLanguageCode = 202
+	// @xml: "aka"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AKA IsoLanguageCode = 203
+	// @xml: "amh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AMH IsoLanguageCode = 204
+	// @xml: "arg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ARG IsoLanguageCode = 205
+	// @xml: "ara"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ARA IsoLanguageCode = 206
+	// @xml: "asm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ASM IsoLanguageCode = 207
+	// @xml: "ava"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AVA IsoLanguageCode = 208
+	// @xml: "aym"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AYM IsoLanguageCode = 209
+	// @xml: "aze"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AZE IsoLanguageCode = 210
+	// @xml: "bak"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAK IsoLanguageCode = 211
+	// @xml: "bel"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BEL IsoLanguageCode = 212
+	// @xml: "bul"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BUL IsoLanguageCode = 213
+	// @xml: "bih"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BIH IsoLanguageCode = 214
+	// @xml: "bis"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BIS IsoLanguageCode = 215
+	// @xml: "bam"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAM IsoLanguageCode = 216
+	// @xml: "ben"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BEN IsoLanguageCode = 217
+	// @xml: "bod"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BOD IsoLanguageCode = 218
+	// @xml: "bre"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BRE IsoLanguageCode = 219
+	// @xml: "bos"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BOS IsoLanguageCode = 220
+	// @xml: "cat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CAT IsoLanguageCode = 221
+	// @xml: "che"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHE IsoLanguageCode = 222
+	// @xml: "cha"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHA IsoLanguageCode = 223
+	// @xml: "cos"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_COS IsoLanguageCode = 224
+	// @xml: "cre"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CRE IsoLanguageCode = 225
+	// @xml: "ces"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CES IsoLanguageCode = 226
+	// @xml: "chu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHU IsoLanguageCode = 227
+	// @xml: "chv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHV IsoLanguageCode = 228
+	// @xml: "cym"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CYM IsoLanguageCode = 229
+	// @xml: "dan"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DAN IsoLanguageCode = 230
+	// @xml: "deu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DEU IsoLanguageCode = 231
+	// @xml: "div"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DIV IsoLanguageCode = 232
+	// @xml: "dzo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DZO IsoLanguageCode = 233
+	// @xml: "ewe"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EWE IsoLanguageCode = 234
+	// @xml: "ell"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ELL IsoLanguageCode = 235
+	// @xml: "eng"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ENG IsoLanguageCode = 236
+	// @xml: "epo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EPO IsoLanguageCode = 237
+	// @xml: "spa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SPA IsoLanguageCode = 238
+	// @xml: "est"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EST IsoLanguageCode = 239
+	// @xml: "eus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EUS IsoLanguageCode = 240
+	// @xml: "fas"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FAS IsoLanguageCode = 241
+	// @xml: "ful"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FUL IsoLanguageCode = 242
+	// @xml: "fin"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FIN IsoLanguageCode = 243
+	// @xml: "fij"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FIJ IsoLanguageCode = 244
+	// @xml: "fao"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FAO IsoLanguageCode = 245
+	// @xml: "fra"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FRA IsoLanguageCode = 246
+	// @xml: "fry"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FRY IsoLanguageCode = 247
+	// @xml: "gle"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GLE IsoLanguageCode = 248
+	// @xml: "gla"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GLA IsoLanguageCode = 249
+	// @xml: "glg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GLG IsoLanguageCode = 250
+	// @xml: "grn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GRN IsoLanguageCode = 251
+	// @xml: "guj"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GUJ IsoLanguageCode = 252
+	// @xml: "glv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GLV IsoLanguageCode = 253
+	// @xml: "hau"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HAU IsoLanguageCode = 254
+	// @xml: "heb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HEB IsoLanguageCode = 255
+	// @xml: "hin"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HIN IsoLanguageCode = 256
+	// @xml: "hmo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HMO IsoLanguageCode = 257
+	// @xml: "hrv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HRV IsoLanguageCode = 258
+	// @xml: "hat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HAT IsoLanguageCode = 259
+	// @xml: "hun"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HUN IsoLanguageCode = 260
+	// @xml: "hye"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HYE IsoLanguageCode = 261
+	// @xml: "her"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HER IsoLanguageCode = 262
+	// @xml: "ina"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_INA IsoLanguageCode = 263
+	// @xml: "ind"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IND IsoLanguageCode = 264
+	// @xml: "ile"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ILE IsoLanguageCode = 265
+	// @xml: "ibo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IBO IsoLanguageCode = 266
+	// @xml: "iii"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_III IsoLanguageCode = 267
+	// @xml: "ipk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IPK IsoLanguageCode = 268
+	// @xml: "ido"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IDO IsoLanguageCode = 269
+	// @xml: "isl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ISL IsoLanguageCode = 270
+	// @xml: "ita"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ITA IsoLanguageCode = 271
+	// @xml: "iku"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IKU IsoLanguageCode = 272
+	// @xml: "jpn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JPN IsoLanguageCode = 273
+	// @xml: "jav"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JAV IsoLanguageCode = 274
+	// @xml: "kat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAT IsoLanguageCode = 275
+	// @xml: "kon"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KON IsoLanguageCode = 276
+	// @xml: "kik"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KIK IsoLanguageCode = 277
+	// @xml: "kua"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KUA IsoLanguageCode = 278
+	// @xml: "kaz"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAZ IsoLanguageCode = 279
+	// @xml: "kal"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAL IsoLanguageCode = 280
+	// @xml: "khm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KHM IsoLanguageCode = 281
+	// @xml: "kan"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAN IsoLanguageCode = 282
+	// @xml: "kor"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KOR IsoLanguageCode = 283
+	// @xml: "kau"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAU IsoLanguageCode = 284
+	// @xml: "kas"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAS IsoLanguageCode = 285
+	// @xml: "kur"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KUR IsoLanguageCode = 286
+	// @xml: "kom"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KOM IsoLanguageCode = 287
+	// @xml: "cor"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_COR IsoLanguageCode = 288
+	// @xml: "kir"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KIR IsoLanguageCode = 289
+	// @xml: "lat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LAT IsoLanguageCode = 290
+	// @xml: "ltz"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LTZ IsoLanguageCode = 291
+	// @xml: "lug"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LUG IsoLanguageCode = 292
+	// @xml: "lim"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LIM IsoLanguageCode = 293
+	// @xml: "lin"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LIN IsoLanguageCode = 294
+	// @xml: "lao"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LAO IsoLanguageCode = 295
+	// @xml: "lit"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LIT IsoLanguageCode = 296
+	// @xml: "lub"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LUB IsoLanguageCode = 297
+	// @xml: "lav"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LAV IsoLanguageCode = 298
+	// @xml: "mlg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MLG IsoLanguageCode = 299
+	// @xml: "mah"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAH IsoLanguageCode = 300
+	// @xml: "mri"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MRI IsoLanguageCode = 301
+	// @xml: "mkd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MKD IsoLanguageCode = 302
+	// @xml: "mal"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAL IsoLanguageCode = 303
+	// @xml: "mon"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MON IsoLanguageCode = 304
+	// @xml: "mar"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAR IsoLanguageCode = 305
+	// @xml: "msa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MSA IsoLanguageCode = 306
+	// @xml: "mlt"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MLT IsoLanguageCode = 307
+	// @xml: "mya"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MYA IsoLanguageCode = 308
+	// @xml: "nau"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NAU IsoLanguageCode = 309
+	// @xml: "nob"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NOB IsoLanguageCode = 310
+	// @xml: "nde"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NDE IsoLanguageCode = 311
+	// @xml: "nep"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NEP IsoLanguageCode = 312
+	// @xml: "ndo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NDO IsoLanguageCode = 313
+	// @xml: "nld"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NLD IsoLanguageCode = 314
+	// @xml: "nno"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NNO IsoLanguageCode = 315
+	// @xml: "nor"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NOR IsoLanguageCode = 316
+	// @xml: "nbl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NBL IsoLanguageCode = 317
+	// @xml: "nav"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NAV IsoLanguageCode = 318
+	// @xml: "nya"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NYA IsoLanguageCode = 319
+	// @xml: "oci"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OCI IsoLanguageCode = 320
+	// @xml: "oji"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OJI IsoLanguageCode = 321
+	// @xml: "orm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ORM IsoLanguageCode = 322
+	// @xml: "ori"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ORI IsoLanguageCode = 323
+	// @xml: "oss"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OSS IsoLanguageCode = 324
+	// @xml: "pan"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PAN IsoLanguageCode = 325
+	// @xml: "pli"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PLI IsoLanguageCode = 326
+	// @xml: "pol"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_POL IsoLanguageCode = 327
+	// @xml: "pus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PUS IsoLanguageCode = 328
+	// @xml: "por"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_POR IsoLanguageCode = 329
+	// @xml: "que"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_QUE IsoLanguageCode = 330
+	// @xml: "roh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ROH IsoLanguageCode = 331
+	// @xml: "run"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RUN IsoLanguageCode = 332
+	// @xml: "ron"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RON IsoLanguageCode = 333
+	// @xml: "rus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RUS IsoLanguageCode = 334
+	// @xml: "kin"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KIN IsoLanguageCode = 335
+	// @xml: "san"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAN IsoLanguageCode = 336
+	// @xml: "srd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SRD IsoLanguageCode = 337
+	// @xml: "snd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SND IsoLanguageCode = 338
+	// @xml: "sme"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SME IsoLanguageCode = 339
+	// @xml: "sag"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAG IsoLanguageCode = 340
+	// @xml: "sin"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SIN IsoLanguageCode = 341
+	// @xml: "slk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SLK IsoLanguageCode = 342
+	// @xml: "slv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SLV IsoLanguageCode = 343
+	// @xml: "smo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SMO IsoLanguageCode = 344
+	// @xml: "sna"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SNA IsoLanguageCode = 345
+	// @xml: "som"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SOM IsoLanguageCode = 346
+	// @xml: "sqi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SQI IsoLanguageCode = 347
+	// @xml: "srp"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SRP IsoLanguageCode = 348
+	// @xml: "ssw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SSW IsoLanguageCode = 349
+	// @xml: "sot"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SOT IsoLanguageCode = 350
+	// @xml: "sun"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SUN IsoLanguageCode = 351
+	// @xml: "swe"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SWE IsoLanguageCode = 352
+	// @xml: "swa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SWA IsoLanguageCode = 353
+	// @xml: "tam"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TAM IsoLanguageCode = 354
+	// @xml: "tel"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TEL IsoLanguageCode = 355
+	// @xml: "tgk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TGK IsoLanguageCode = 356
+	// @xml: "tha"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_THA IsoLanguageCode = 357
+	// @xml: "tir"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TIR IsoLanguageCode = 358
+	// @xml: "tuk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TUK IsoLanguageCode = 359
+	// @xml: "tgl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TGL IsoLanguageCode = 360
+	// @xml: "tsn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TSN IsoLanguageCode = 361
+	// @xml: "ton"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TON IsoLanguageCode = 362
+	// @xml: "tur"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TUR IsoLanguageCode = 363
+	// @xml: "tso"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TSO IsoLanguageCode = 364
+	// @xml: "tat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TAT IsoLanguageCode = 365
+	// @xml: "twi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TWI IsoLanguageCode = 366
+	// @xml: "tah"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TAH IsoLanguageCode = 367
+	// @xml: "uig"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UIG IsoLanguageCode = 368
+	// @xml: "ukr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UKR IsoLanguageCode = 369
+	// @xml: "urd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_URD IsoLanguageCode = 370
+	// @xml: "uzb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UZB IsoLanguageCode = 371
+	// @xml: "ven"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VEN IsoLanguageCode = 372
+	// @xml: "vie"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VIE IsoLanguageCode = 373
+	// @xml: "vol"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VOL IsoLanguageCode = 374
+	// @xml: "wln"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WLN IsoLanguageCode = 375
+	// @xml: "wol"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WOL IsoLanguageCode = 376
+	// @xml: "xho"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_XHO IsoLanguageCode = 377
+	// @xml: "yid"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YID IsoLanguageCode = 378
+	// @xml: "yor"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YOR IsoLanguageCode = 379
+	// @xml: "zha"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZHA IsoLanguageCode = 380
+	// @xml: "zho"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZHO IsoLanguageCode = 381
+	// @xml: "zul"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZUL IsoLanguageCode = 382
+	// @xml: "ace"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ACE IsoLanguageCode = 383
+	// @xml: "ach"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ACH IsoLanguageCode = 384
+	// @xml: "ada"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ADA IsoLanguageCode = 385
+	// @xml: "ady"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ADY IsoLanguageCode = 386
+	// @xml: "afa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AFA IsoLanguageCode = 387
+	// @xml: "afh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AFH IsoLanguageCode = 388
+	// @xml: "ain"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AIN IsoLanguageCode = 389
+	// @xml: "akk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AKK IsoLanguageCode = 390
+	// @xml: "ale"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ALE IsoLanguageCode = 391
+	// @xml: "alg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ALG IsoLanguageCode = 392
+	// @xml: "alt"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ALT IsoLanguageCode = 393
+	// @xml: "ang"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ANG IsoLanguageCode = 394
+	// @xml: "anp"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ANP IsoLanguageCode = 395
+	// @xml: "apa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_APA IsoLanguageCode = 396
+	// @xml: "arc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ARC IsoLanguageCode = 397
+	// @xml: "arn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ARN IsoLanguageCode = 398
+	// @xml: "arp"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ARP IsoLanguageCode = 399
+	// @xml: "art"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ART IsoLanguageCode = 400
+	// @xml: "arw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ARW IsoLanguageCode = 401
+	// @xml: "ast"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AST IsoLanguageCode = 402
+	// @xml: "ath"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ATH IsoLanguageCode = 403
+	// @xml: "aus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AUS IsoLanguageCode = 404
+	// @xml: "awa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AWA IsoLanguageCode = 405
+	// @xml: "bad"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAD IsoLanguageCode = 406
+	// @xml: "bai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAI IsoLanguageCode = 407
+	// @xml: "bal"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAL IsoLanguageCode = 408
+	// @xml: "ban"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAN IsoLanguageCode = 409
+	// @xml: "bas"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAS IsoLanguageCode = 410
+	// @xml: "bat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BAT IsoLanguageCode = 411
+	// @xml: "bej"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BEJ IsoLanguageCode = 412
+	// @xml: "bem"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BEM IsoLanguageCode = 413
+	// @xml: "ber"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BER IsoLanguageCode = 414
+	// @xml: "bik"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BIK IsoLanguageCode = 415
+	// @xml: "bin"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BIN IsoLanguageCode = 416
+	// @xml: "bla"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BLA IsoLanguageCode = 417
+	// @xml: "bnt"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BNT IsoLanguageCode = 418
+	// @xml: "bra"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BRA IsoLanguageCode = 419
+	// @xml: "btk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BTK IsoLanguageCode = 420
+	// @xml: "bua"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BUA IsoLanguageCode = 421
+	// @xml: "bug"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BUG IsoLanguageCode = 422
+	// @xml: "byn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_BYN IsoLanguageCode = 423
+	// @xml: "cad"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CAD IsoLanguageCode = 424
+	// @xml: "cai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CAI IsoLanguageCode = 425
+	// @xml: "car"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CAR IsoLanguageCode = 426
+	// @xml: "cau"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CAU IsoLanguageCode = 427
+	// @xml: "ceb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CEB IsoLanguageCode = 428
+	// @xml: "cel"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CEL IsoLanguageCode = 429
+	// @xml: "chb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHB IsoLanguageCode = 430
+	// @xml: "chg"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHG IsoLanguageCode = 431
+	// @xml: "chk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHK IsoLanguageCode = 432
+	// @xml: "chm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHM IsoLanguageCode = 433
+	// @xml: "chn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHN IsoLanguageCode = 434
+	// @xml: "cho"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHO IsoLanguageCode = 435
+	// @xml: "chp"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHP IsoLanguageCode = 436
+	// @xml: "chr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHR IsoLanguageCode = 437
+	// @xml: "chy"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CHY IsoLanguageCode = 438
+	// @xml: "cmc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CMC IsoLanguageCode = 439
+	// @xml: "cnr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CNR IsoLanguageCode = 440
+	// @xml: "cop"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_COP IsoLanguageCode = 441
+	// @xml: "cpe"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CPE IsoLanguageCode = 442
+	// @xml: "cpf"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CPF IsoLanguageCode = 443
+	// @xml: "cpp"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CPP IsoLanguageCode = 444
+	// @xml: "crh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CRH IsoLanguageCode = 445
+	// @xml: "crp"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CRP IsoLanguageCode = 446
+	// @xml: "csb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CSB IsoLanguageCode = 447
+	// @xml: "cus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CUS IsoLanguageCode = 448
+	// @xml: "dak"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DAK IsoLanguageCode = 449
+	// @xml: "dar"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DAR IsoLanguageCode = 450
+	// @xml: "day"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DAY IsoLanguageCode = 451
+	// @xml: "del"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DEL IsoLanguageCode = 452
+	// @xml: "den"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DEN IsoLanguageCode = 453
+	// @xml: "dgr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DGR IsoLanguageCode = 454
+	// @xml: "din"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DIN IsoLanguageCode = 455
+	// @xml: "doi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DOI IsoLanguageCode = 456
+	// @xml: "dra"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DRA IsoLanguageCode = 457
+	// @xml: "dsb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DSB IsoLanguageCode = 458
+	// @xml: "dua"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DUA IsoLanguageCode = 459
+	// @xml: "dum"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DUM IsoLanguageCode = 460
+	// @xml: "dyu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_DYU IsoLanguageCode = 461
+	// @xml: "efi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EFI IsoLanguageCode = 462
+	// @xml: "egy"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EGY IsoLanguageCode = 463
+	// @xml: "eka"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EKA IsoLanguageCode = 464
+	// @xml: "elx"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ELX IsoLanguageCode = 465
+	// @xml: "enm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ENM IsoLanguageCode = 466
+	// @xml: "ewo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_EWO IsoLanguageCode = 467
+	// @xml: "fan"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FAN IsoLanguageCode = 468
+	// @xml: "fat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FAT IsoLanguageCode = 469
+	// @xml: "fil"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FIL IsoLanguageCode = 470
+	// @xml: "fiu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FIU IsoLanguageCode = 471
+	// @xml: "fon"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FON IsoLanguageCode = 472
+	// @xml: "frm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FRM IsoLanguageCode = 473
+	// @xml: "fro"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FRO IsoLanguageCode = 474
+	// @xml: "frr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FRR IsoLanguageCode = 475
+	// @xml: "frs"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FRS IsoLanguageCode = 476
+	// @xml: "fur"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_FUR IsoLanguageCode = 477
+	// @xml: "gaa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GAA IsoLanguageCode = 478
+	// @xml: "gay"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GAY IsoLanguageCode = 479
+	// @xml: "gba"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GBA IsoLanguageCode = 480
+	// @xml: "gem"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GEM IsoLanguageCode = 481
+	// @xml: "gez"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GEZ IsoLanguageCode = 482
+	// @xml: "gil"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GIL IsoLanguageCode = 483
+	// @xml: "gmh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GMH IsoLanguageCode = 484
+	// @xml: "goh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GOH IsoLanguageCode = 485
+	// @xml: "gon"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GON IsoLanguageCode = 486
+	// @xml: "gor"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GOR IsoLanguageCode = 487
+	// @xml: "got"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GOT IsoLanguageCode = 488
+	// @xml: "grb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GRB IsoLanguageCode = 489
+	// @xml: "grc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GRC IsoLanguageCode = 490
+	// @xml: "gsw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GSW IsoLanguageCode = 491
+	// @xml: "gwi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GWI IsoLanguageCode = 492
+	// @xml: "hai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HAI IsoLanguageCode = 493
+	// @xml: "haw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HAW IsoLanguageCode = 494
+	// @xml: "hil"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HIL IsoLanguageCode = 495
+	// @xml: "him"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HIM IsoLanguageCode = 496
+	// @xml: "hit"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HIT IsoLanguageCode = 497
+	// @xml: "hmn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HMN IsoLanguageCode = 498
+	// @xml: "hsb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HSB IsoLanguageCode = 499
+	// @xml: "hup"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HUP IsoLanguageCode = 500
+	// @xml: "iba"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IBA IsoLanguageCode = 501
+	// @xml: "ijo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IJO IsoLanguageCode = 502
+	// @xml: "ilo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ILO IsoLanguageCode = 503
+	// @xml: "inc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_INC IsoLanguageCode = 504
+	// @xml: "ine"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_INE IsoLanguageCode = 505
+	// @xml: "inh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_INH IsoLanguageCode = 506
+	// @xml: "ira"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IRA IsoLanguageCode = 507
+	// @xml: "iro"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_IRO IsoLanguageCode = 508
+	// @xml: "jbo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JBO IsoLanguageCode = 509
+	// @xml: "jpr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JPR IsoLanguageCode = 510
+	// @xml: "jrb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JRB IsoLanguageCode = 511
+	// @xml: "kaa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAA IsoLanguageCode = 512
+	// @xml: "kab"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAB IsoLanguageCode = 513
+	// @xml: "kac"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAC IsoLanguageCode = 514
+	// @xml: "kam"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAM IsoLanguageCode = 515
+	// @xml: "kar"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAR IsoLanguageCode = 516
+	// @xml: "kaw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KAW IsoLanguageCode = 517
+	// @xml: "kbd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KBD IsoLanguageCode = 518
+	// @xml: "kha"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KHA IsoLanguageCode = 519
+	// @xml: "khi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KHI IsoLanguageCode = 520
+	// @xml: "kho"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KHO IsoLanguageCode = 521
+	// @xml: "kmb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KMB IsoLanguageCode = 522
+	// @xml: "kok"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KOK IsoLanguageCode = 523
+	// @xml: "kos"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KOS IsoLanguageCode = 524
+	// @xml: "kpe"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KPE IsoLanguageCode = 525
+	// @xml: "krc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KRC IsoLanguageCode = 526
+	// @xml: "krl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KRL IsoLanguageCode = 527
+	// @xml: "kro"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KRO IsoLanguageCode = 528
+	// @xml: "kru"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KRU IsoLanguageCode = 529
+	// @xml: "kum"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KUM IsoLanguageCode = 530
+	// @xml: "kut"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KUT IsoLanguageCode = 531
+	// @xml: "lad"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LAD IsoLanguageCode = 532
+	// @xml: "lah"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LAH IsoLanguageCode = 533
+	// @xml: "lam"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LAM IsoLanguageCode = 534
+	// @xml: "lez"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LEZ IsoLanguageCode = 535
+	// @xml: "lol"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LOL IsoLanguageCode = 536
+	// @xml: "loz"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LOZ IsoLanguageCode = 537
+	// @xml: "lua"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LUA IsoLanguageCode = 538
+	// @xml: "lui"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LUI IsoLanguageCode = 539
+	// @xml: "lun"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LUN IsoLanguageCode = 540
+	// @xml: "luo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LUO IsoLanguageCode = 541
+	// @xml: "lus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_LUS IsoLanguageCode = 542
+	// @xml: "mad"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAD IsoLanguageCode = 543
+	// @xml: "mag"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAG IsoLanguageCode = 544
+	// @xml: "mai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAI IsoLanguageCode = 545
+	// @xml: "mak"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAK IsoLanguageCode = 546
+	// @xml: "man"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAN IsoLanguageCode = 547
+	// @xml: "map"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAP IsoLanguageCode = 548
+	// @xml: "mas"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MAS IsoLanguageCode = 549
+	// @xml: "mdf"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MDF IsoLanguageCode = 550
+	// @xml: "mdr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MDR IsoLanguageCode = 551
+	// @xml: "men"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MEN IsoLanguageCode = 552
+	// @xml: "mga"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MGA IsoLanguageCode = 553
+	// @xml: "mic"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MIC IsoLanguageCode = 554
+	// @xml: "min"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MIN IsoLanguageCode = 555
+	// @xml: "mis"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MIS IsoLanguageCode = 556
+	// @xml: "mkh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MKH IsoLanguageCode = 557
+	// @xml: "mnc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MNC IsoLanguageCode = 558
+	// @xml: "mni"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MNI IsoLanguageCode = 559
+	// @xml: "mno"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MNO IsoLanguageCode = 560
+	// @xml: "moh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MOH IsoLanguageCode = 561
+	// @xml: "mos"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MOS IsoLanguageCode = 562
+	// @xml: "mul"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MUL IsoLanguageCode = 563
+	// @xml: "mun"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MUN IsoLanguageCode = 564
+	// @xml: "mus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MUS IsoLanguageCode = 565
+	// @xml: "mwl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MWL IsoLanguageCode = 566
+	// @xml: "mwr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MWR IsoLanguageCode = 567
+	// @xml: "myn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MYN IsoLanguageCode = 568
+	// @xml: "myv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MYV IsoLanguageCode = 569
+	// @xml: "nah"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NAH IsoLanguageCode = 570
+	// @xml: "nai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NAI IsoLanguageCode = 571
+	// @xml: "nap"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NAP IsoLanguageCode = 572
+	// @xml: "nds"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NDS IsoLanguageCode = 573
+	// @xml: "new"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NEW IsoLanguageCode = 574
+	// @xml: "nia"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NIA IsoLanguageCode = 575
+	// @xml: "nic"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NIC IsoLanguageCode = 576
+	// @xml: "niu"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NIU IsoLanguageCode = 577
+	// @xml: "nog"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NOG IsoLanguageCode = 578
+	// @xml: "non"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NON IsoLanguageCode = 579
+	// @xml: "nqo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NQO IsoLanguageCode = 580
+	// @xml: "nso"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NSO IsoLanguageCode = 581
+	// @xml: "nub"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NUB IsoLanguageCode = 582
+	// @xml: "nwc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NWC IsoLanguageCode = 583
+	// @xml: "nym"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NYM IsoLanguageCode = 584
+	// @xml: "nyn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NYN IsoLanguageCode = 585
+	// @xml: "nyo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NYO IsoLanguageCode = 586
+	// @xml: "nzi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_NZI IsoLanguageCode = 587
+	// @xml: "osa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OSA IsoLanguageCode = 588
+	// @xml: "ota"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OTA IsoLanguageCode = 589
+	// @xml: "oto"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_OTO IsoLanguageCode = 590
+	// @xml: "paa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PAA IsoLanguageCode = 591
+	// @xml: "pag"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PAG IsoLanguageCode = 592
+	// @xml: "pal"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PAL IsoLanguageCode = 593
+	// @xml: "pam"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PAM IsoLanguageCode = 594
+	// @xml: "pap"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PAP IsoLanguageCode = 595
+	// @xml: "pau"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PAU IsoLanguageCode = 596
+	// @xml: "peo"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PEO IsoLanguageCode = 597
+	// @xml: "phi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PHI IsoLanguageCode = 598
+	// @xml: "phn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PHN IsoLanguageCode = 599
+	// @xml: "pon"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PON IsoLanguageCode = 600
+	// @xml: "pra"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PRA IsoLanguageCode = 601
+	// @xml: "pro"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_PRO IsoLanguageCode = 602
+	// @xml: "rap"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RAP IsoLanguageCode = 603
+	// @xml: "rar"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RAR IsoLanguageCode = 604
+	// @xml: "roa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ROA IsoLanguageCode = 605
+	// @xml: "rom"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ROM IsoLanguageCode = 606
+	// @xml: "rup"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_RUP IsoLanguageCode = 607
+	// @xml: "sad"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAD IsoLanguageCode = 608
+	// @xml: "sah"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAH IsoLanguageCode = 609
+	// @xml: "sai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAI IsoLanguageCode = 610
+	// @xml: "sal"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAL IsoLanguageCode = 611
+	// @xml: "sam"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAM IsoLanguageCode = 612
+	// @xml: "sas"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAS IsoLanguageCode = 613
+	// @xml: "sat"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SAT IsoLanguageCode = 614
+	// @xml: "scn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SCN IsoLanguageCode = 615
+	// @xml: "sco"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SCO IsoLanguageCode = 616
+	// @xml: "sel"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SEL IsoLanguageCode = 617
+	// @xml: "sem"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SEM IsoLanguageCode = 618
+	// @xml: "sga"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SGA IsoLanguageCode = 619
+	// @xml: "sgn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SGN IsoLanguageCode = 620
+	// @xml: "shn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SHN IsoLanguageCode = 621
+	// @xml: "sid"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SID IsoLanguageCode = 622
+	// @xml: "sio"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SIO IsoLanguageCode = 623
+	// @xml: "sit"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SIT IsoLanguageCode = 624
+	// @xml: "sla"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SLA IsoLanguageCode = 625
+	// @xml: "sma"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SMA IsoLanguageCode = 626
+	// @xml: "smi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SMI IsoLanguageCode = 627
+	// @xml: "smj"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SMJ IsoLanguageCode = 628
+	// @xml: "smn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SMN IsoLanguageCode = 629
+	// @xml: "sms"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SMS IsoLanguageCode = 630
+	// @xml: "snk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SNK IsoLanguageCode = 631
+	// @xml: "sog"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SOG IsoLanguageCode = 632
+	// @xml: "son"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SON IsoLanguageCode = 633
+	// @xml: "srn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SRN IsoLanguageCode = 634
+	// @xml: "srr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SRR IsoLanguageCode = 635
+	// @xml: "ssa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SSA IsoLanguageCode = 636
+	// @xml: "suk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SUK IsoLanguageCode = 637
+	// @xml: "sus"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SUS IsoLanguageCode = 638
+	// @xml: "sux"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SUX IsoLanguageCode = 639
+	// @xml: "syc"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SYC IsoLanguageCode = 640
+	// @xml: "syr"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SYR IsoLanguageCode = 641
+	// @xml: "tai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TAI IsoLanguageCode = 642
+	// @xml: "tem"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TEM IsoLanguageCode = 643
+	// @xml: "ter"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TER IsoLanguageCode = 644
+	// @xml: "tet"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TET IsoLanguageCode = 645
+	// @xml: "tig"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TIG IsoLanguageCode = 646
+	// @xml: "tiv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TIV IsoLanguageCode = 647
+	// @xml: "tkl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TKL IsoLanguageCode = 648
+	// @xml: "tlh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TLH IsoLanguageCode = 649
+	// @xml: "tli"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TLI IsoLanguageCode = 650
+	// @xml: "tmh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TMH IsoLanguageCode = 651
+	// @xml: "tog"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TOG IsoLanguageCode = 652
+	// @xml: "tpi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TPI IsoLanguageCode = 653
+	// @xml: "tsi"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TSI IsoLanguageCode = 654
+	// @xml: "tum"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TUM IsoLanguageCode = 655
+	// @xml: "tup"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TUP IsoLanguageCode = 656
+	// @xml: "tut"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TUT IsoLanguageCode = 657
+	// @xml: "tvl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TVL IsoLanguageCode = 658
+	// @xml: "tyv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TYV IsoLanguageCode = 659
+	// @xml: "udm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UDM IsoLanguageCode = 660
+	// @xml: "uga"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UGA IsoLanguageCode = 661
+	// @xml: "umb"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UMB IsoLanguageCode = 662
+	// @xml: "und"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_UND IsoLanguageCode = 663
+	// @xml: "vai"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VAI IsoLanguageCode = 664
+	// @xml: "vot"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_VOT IsoLanguageCode = 665
+	// @xml: "wak"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WAK IsoLanguageCode = 666
+	// @xml: "wal"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WAL IsoLanguageCode = 667
+	// @xml: "war"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WAR IsoLanguageCode = 668
+	// @xml: "was"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WAS IsoLanguageCode = 669
+	// @xml: "wen"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_WEN IsoLanguageCode = 670
+	// @xml: "xal"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_XAL IsoLanguageCode = 671
+	// @xml: "yao"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YAO IsoLanguageCode = 672
+	// @xml: "yap"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YAP IsoLanguageCode = 673
+	// @xml: "ypk"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YPK IsoLanguageCode = 674
+	// @xml: "zap"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZAP IsoLanguageCode = 675
+	// @xml: "zbl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZBL IsoLanguageCode = 676
+	// @xml: "zen"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZEN IsoLanguageCode = 677
+	// @xml: "zgh"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZGH IsoLanguageCode = 678
+	// @xml: "znd"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZND IsoLanguageCode = 679
+	// @xml: "zun"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZUN IsoLanguageCode = 680
+	// @xml: "zxx"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZXX IsoLanguageCode = 681
+	// @xml: "zza"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_ZZA IsoLanguageCode = 682
+	// @xml: "aaa"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_AAA IsoLanguageCode = 683
+	// @xml: "hne"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_HNE IsoLanguageCode = 684
+	// @xml: "gbm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GBM IsoLanguageCode = 685
+	// @xml: "khw"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KHW IsoLanguageCode = 686
+	// @xml: "cmn"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_CMN IsoLanguageCode = 687
+	// @xml: "sck"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SCK IsoLanguageCode = 688
+	// @xml: "spv"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SPV IsoLanguageCode = 689
+	// @xml: "scl"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_SCL IsoLanguageCode = 690
+	// @xml: "yue"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_YUE IsoLanguageCode = 691
+	// @xml: "tcy"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_TCY IsoLanguageCode = 692
+	// @xml: "key"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_KEY IsoLanguageCode = 693
+	// @xml: "gcf"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_GCF IsoLanguageCode = 694
+	// @xml: "jam"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_JAM IsoLanguageCode = 695
+	// @xml: "mcm"
+	IsoLanguageCode_ISO_LANGUAGE_CODE_MCM IsoLanguageCode = 696
 )
 
 // Enum value maps for IsoLanguageCode.
@@ -10026,260 +12603,514 @@ type IsoTerritoryCode int32
 
 const (
 	IsoTerritoryCode_ISO_TERRITORY_CODE_UNSPECIFIED IsoTerritoryCode = 0
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AD          IsoTerritoryCode = 1
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AE          IsoTerritoryCode = 2
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AF          IsoTerritoryCode = 3
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AG          IsoTerritoryCode = 4
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AI          IsoTerritoryCode = 5
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AL          IsoTerritoryCode = 6
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AM          IsoTerritoryCode = 7
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AN          IsoTerritoryCode = 8
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AO          IsoTerritoryCode = 9
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AQ          IsoTerritoryCode = 10
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AR          IsoTerritoryCode = 11
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AS          IsoTerritoryCode = 12
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AT          IsoTerritoryCode = 13
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AU          IsoTerritoryCode = 14
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AW          IsoTerritoryCode = 15
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AX          IsoTerritoryCode = 16
-	IsoTerritoryCode_ISO_TERRITORY_CODE_AZ          IsoTerritoryCode = 17
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BA          IsoTerritoryCode = 18
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BB          IsoTerritoryCode = 19
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BD          IsoTerritoryCode = 20
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BE          IsoTerritoryCode = 21
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BF          IsoTerritoryCode = 22
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BG          IsoTerritoryCode = 23
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BH          IsoTerritoryCode = 24
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BI          IsoTerritoryCode = 25
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BJ          IsoTerritoryCode = 26
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BL          IsoTerritoryCode = 27
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BM          IsoTerritoryCode = 28
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BN          IsoTerritoryCode = 29
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BO          IsoTerritoryCode = 30
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BQ          IsoTerritoryCode = 31
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BR          IsoTerritoryCode = 32
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BS          IsoTerritoryCode = 33
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BT          IsoTerritoryCode = 34
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BV          IsoTerritoryCode = 35
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BW          IsoTerritoryCode = 36
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BY          IsoTerritoryCode = 37
-	IsoTerritoryCode_ISO_TERRITORY_CODE_BZ          IsoTerritoryCode = 38
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CA          IsoTerritoryCode = 39
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CC          IsoTerritoryCode = 40
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CD          IsoTerritoryCode = 41
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CF          IsoTerritoryCode = 42
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CG          IsoTerritoryCode = 43
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CH          IsoTerritoryCode = 44
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CI          IsoTerritoryCode = 45
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CK          IsoTerritoryCode = 46
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CL          IsoTerritoryCode = 47
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CM          IsoTerritoryCode = 48
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CN          IsoTerritoryCode = 49
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CO          IsoTerritoryCode = 50
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CR          IsoTerritoryCode = 51
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CS          IsoTerritoryCode = 52
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CU          IsoTerritoryCode = 53
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CV          IsoTerritoryCode = 54
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CW          IsoTerritoryCode = 55
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CX          IsoTerritoryCode = 56
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CY          IsoTerritoryCode = 57
-	IsoTerritoryCode_ISO_TERRITORY_CODE_CZ          IsoTerritoryCode = 58
-	IsoTerritoryCode_ISO_TERRITORY_CODE_DE          IsoTerritoryCode = 59
-	IsoTerritoryCode_ISO_TERRITORY_CODE_DJ          IsoTerritoryCode = 60
-	IsoTerritoryCode_ISO_TERRITORY_CODE_DK          IsoTerritoryCode = 61
-	IsoTerritoryCode_ISO_TERRITORY_CODE_DM          IsoTerritoryCode = 62
-	IsoTerritoryCode_ISO_TERRITORY_CODE_DO          IsoTerritoryCode = 63
-	IsoTerritoryCode_ISO_TERRITORY_CODE_DZ          IsoTerritoryCode = 64
-	IsoTerritoryCode_ISO_TERRITORY_CODE_EC          IsoTerritoryCode = 65
-	IsoTerritoryCode_ISO_TERRITORY_CODE_EE          IsoTerritoryCode = 66
-	IsoTerritoryCode_ISO_TERRITORY_CODE_EG          IsoTerritoryCode = 67
-	IsoTerritoryCode_ISO_TERRITORY_CODE_EH          IsoTerritoryCode = 68
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ER          IsoTerritoryCode = 69
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ES          IsoTerritoryCode = 70
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CE       IsoTerritoryCode = 71
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CN       IsoTerritoryCode = 72
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ES_ML       IsoTerritoryCode = 73
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ET          IsoTerritoryCode = 74
-	IsoTerritoryCode_ISO_TERRITORY_CODE_FI          IsoTerritoryCode = 75
-	IsoTerritoryCode_ISO_TERRITORY_CODE_FJ          IsoTerritoryCode = 76
-	IsoTerritoryCode_ISO_TERRITORY_CODE_FK          IsoTerritoryCode = 77
-	IsoTerritoryCode_ISO_TERRITORY_CODE_FM          IsoTerritoryCode = 78
-	IsoTerritoryCode_ISO_TERRITORY_CODE_FO          IsoTerritoryCode = 79
-	IsoTerritoryCode_ISO_TERRITORY_CODE_FR          IsoTerritoryCode = 80
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GA          IsoTerritoryCode = 81
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GB          IsoTerritoryCode = 82
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GD          IsoTerritoryCode = 83
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GE          IsoTerritoryCode = 84
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GF          IsoTerritoryCode = 85
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GG          IsoTerritoryCode = 86
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GH          IsoTerritoryCode = 87
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GI          IsoTerritoryCode = 88
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GL          IsoTerritoryCode = 89
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GM          IsoTerritoryCode = 90
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GN          IsoTerritoryCode = 91
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GP          IsoTerritoryCode = 92
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GQ          IsoTerritoryCode = 93
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GR          IsoTerritoryCode = 94
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GS          IsoTerritoryCode = 95
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GT          IsoTerritoryCode = 96
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GU          IsoTerritoryCode = 97
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GW          IsoTerritoryCode = 98
-	IsoTerritoryCode_ISO_TERRITORY_CODE_GY          IsoTerritoryCode = 99
-	IsoTerritoryCode_ISO_TERRITORY_CODE_HK          IsoTerritoryCode = 100
-	IsoTerritoryCode_ISO_TERRITORY_CODE_HM          IsoTerritoryCode = 101
-	IsoTerritoryCode_ISO_TERRITORY_CODE_HN          IsoTerritoryCode = 102
-	IsoTerritoryCode_ISO_TERRITORY_CODE_HR          IsoTerritoryCode = 103
-	IsoTerritoryCode_ISO_TERRITORY_CODE_HT          IsoTerritoryCode = 104
-	IsoTerritoryCode_ISO_TERRITORY_CODE_HU          IsoTerritoryCode = 105
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ID          IsoTerritoryCode = 106
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IE          IsoTerritoryCode = 107
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IL          IsoTerritoryCode = 108
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IM          IsoTerritoryCode = 109
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IN          IsoTerritoryCode = 110
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IO          IsoTerritoryCode = 111
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IQ          IsoTerritoryCode = 112
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IR          IsoTerritoryCode = 113
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IS          IsoTerritoryCode = 114
-	IsoTerritoryCode_ISO_TERRITORY_CODE_IT          IsoTerritoryCode = 115
-	IsoTerritoryCode_ISO_TERRITORY_CODE_JE          IsoTerritoryCode = 116
-	IsoTerritoryCode_ISO_TERRITORY_CODE_JM          IsoTerritoryCode = 117
-	IsoTerritoryCode_ISO_TERRITORY_CODE_JO          IsoTerritoryCode = 118
-	IsoTerritoryCode_ISO_TERRITORY_CODE_JP          IsoTerritoryCode = 119
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KE          IsoTerritoryCode = 120
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KG          IsoTerritoryCode = 121
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KH          IsoTerritoryCode = 122
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KI          IsoTerritoryCode = 123
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KM          IsoTerritoryCode = 124
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KN          IsoTerritoryCode = 125
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KP          IsoTerritoryCode = 126
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KR          IsoTerritoryCode = 127
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KW          IsoTerritoryCode = 128
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KY          IsoTerritoryCode = 129
-	IsoTerritoryCode_ISO_TERRITORY_CODE_KZ          IsoTerritoryCode = 130
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LA          IsoTerritoryCode = 131
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LB          IsoTerritoryCode = 132
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LC          IsoTerritoryCode = 133
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LI          IsoTerritoryCode = 134
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LK          IsoTerritoryCode = 135
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LR          IsoTerritoryCode = 136
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LS          IsoTerritoryCode = 137
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LT          IsoTerritoryCode = 138
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LU          IsoTerritoryCode = 139
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LV          IsoTerritoryCode = 140
-	IsoTerritoryCode_ISO_TERRITORY_CODE_LY          IsoTerritoryCode = 141
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MA          IsoTerritoryCode = 142
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MC          IsoTerritoryCode = 143
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MD          IsoTerritoryCode = 144
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ME          IsoTerritoryCode = 145
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MF          IsoTerritoryCode = 146
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MG          IsoTerritoryCode = 147
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MH          IsoTerritoryCode = 148
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MK          IsoTerritoryCode = 149
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ML          IsoTerritoryCode = 150
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MM          IsoTerritoryCode = 151
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MN          IsoTerritoryCode = 152
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MO          IsoTerritoryCode = 153
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MP          IsoTerritoryCode = 154
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MQ          IsoTerritoryCode = 155
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MR          IsoTerritoryCode = 156
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MS          IsoTerritoryCode = 157
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MT          IsoTerritoryCode = 158
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MU          IsoTerritoryCode = 159
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MV          IsoTerritoryCode = 160
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MW          IsoTerritoryCode = 161
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MX          IsoTerritoryCode = 162
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MY          IsoTerritoryCode = 163
-	IsoTerritoryCode_ISO_TERRITORY_CODE_MZ          IsoTerritoryCode = 164
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NA          IsoTerritoryCode = 165
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NC          IsoTerritoryCode = 166
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NE          IsoTerritoryCode = 167
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NF          IsoTerritoryCode = 168
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NG          IsoTerritoryCode = 169
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NI          IsoTerritoryCode = 170
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NL          IsoTerritoryCode = 171
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NO          IsoTerritoryCode = 172
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NP          IsoTerritoryCode = 173
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NR          IsoTerritoryCode = 174
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NU          IsoTerritoryCode = 175
-	IsoTerritoryCode_ISO_TERRITORY_CODE_NZ          IsoTerritoryCode = 176
-	IsoTerritoryCode_ISO_TERRITORY_CODE_OM          IsoTerritoryCode = 177
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PA          IsoTerritoryCode = 178
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PE          IsoTerritoryCode = 179
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PF          IsoTerritoryCode = 180
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PG          IsoTerritoryCode = 181
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PH          IsoTerritoryCode = 182
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PK          IsoTerritoryCode = 183
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PL          IsoTerritoryCode = 184
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PM          IsoTerritoryCode = 185
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PN          IsoTerritoryCode = 186
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PR          IsoTerritoryCode = 187
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PS          IsoTerritoryCode = 188
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PT          IsoTerritoryCode = 189
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PW          IsoTerritoryCode = 190
-	IsoTerritoryCode_ISO_TERRITORY_CODE_PY          IsoTerritoryCode = 191
-	IsoTerritoryCode_ISO_TERRITORY_CODE_QA          IsoTerritoryCode = 192
-	IsoTerritoryCode_ISO_TERRITORY_CODE_RE          IsoTerritoryCode = 193
-	IsoTerritoryCode_ISO_TERRITORY_CODE_RO          IsoTerritoryCode = 194
-	IsoTerritoryCode_ISO_TERRITORY_CODE_RS          IsoTerritoryCode = 195
-	IsoTerritoryCode_ISO_TERRITORY_CODE_RU          IsoTerritoryCode = 196
-	IsoTerritoryCode_ISO_TERRITORY_CODE_RW          IsoTerritoryCode = 197
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SA          IsoTerritoryCode = 198
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SB          IsoTerritoryCode = 199
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SC          IsoTerritoryCode = 200
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SD          IsoTerritoryCode = 201
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SE          IsoTerritoryCode = 202
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SG          IsoTerritoryCode = 203
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SH          IsoTerritoryCode = 204
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SI          IsoTerritoryCode = 205
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SJ          IsoTerritoryCode = 206
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SK          IsoTerritoryCode = 207
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SL          IsoTerritoryCode = 208
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SM          IsoTerritoryCode = 209
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SN          IsoTerritoryCode = 210
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SO          IsoTerritoryCode = 211
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SR          IsoTerritoryCode = 212
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SS          IsoTerritoryCode = 213
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ST          IsoTerritoryCode = 214
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SV          IsoTerritoryCode = 215
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SX          IsoTerritoryCode = 216
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SY          IsoTerritoryCode = 217
-	IsoTerritoryCode_ISO_TERRITORY_CODE_SZ          IsoTerritoryCode = 218
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TC          IsoTerritoryCode = 219
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TD          IsoTerritoryCode = 220
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TF          IsoTerritoryCode = 221
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TG          IsoTerritoryCode = 222
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TH          IsoTerritoryCode = 223
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TJ          IsoTerritoryCode = 224
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TK          IsoTerritoryCode = 225
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TL          IsoTerritoryCode = 226
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TM          IsoTerritoryCode = 227
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TN          IsoTerritoryCode = 228
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TO          IsoTerritoryCode = 229
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TR          IsoTerritoryCode = 230
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TT          IsoTerritoryCode = 231
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TV          IsoTerritoryCode = 232
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TW          IsoTerritoryCode = 233
-	IsoTerritoryCode_ISO_TERRITORY_CODE_TZ          IsoTerritoryCode = 234
-	IsoTerritoryCode_ISO_TERRITORY_CODE_UA          IsoTerritoryCode = 235
-	IsoTerritoryCode_ISO_TERRITORY_CODE_UG          IsoTerritoryCode = 236
-	IsoTerritoryCode_ISO_TERRITORY_CODE_UM          IsoTerritoryCode = 237
-	IsoTerritoryCode_ISO_TERRITORY_CODE_US          IsoTerritoryCode = 238
-	IsoTerritoryCode_ISO_TERRITORY_CODE_UY          IsoTerritoryCode = 239
-	IsoTerritoryCode_ISO_TERRITORY_CODE_UZ          IsoTerritoryCode = 240
-	IsoTerritoryCode_ISO_TERRITORY_CODE_VA          IsoTerritoryCode = 241
-	IsoTerritoryCode_ISO_TERRITORY_CODE_VC          IsoTerritoryCode = 242
-	IsoTerritoryCode_ISO_TERRITORY_CODE_VE          IsoTerritoryCode = 243
-	IsoTerritoryCode_ISO_TERRITORY_CODE_VG          IsoTerritoryCode = 244
-	IsoTerritoryCode_ISO_TERRITORY_CODE_VI          IsoTerritoryCode = 245
-	IsoTerritoryCode_ISO_TERRITORY_CODE_VN          IsoTerritoryCode = 246
-	IsoTerritoryCode_ISO_TERRITORY_CODE_VU          IsoTerritoryCode = 247
-	IsoTerritoryCode_ISO_TERRITORY_CODE_WF          IsoTerritoryCode = 248
-	IsoTerritoryCode_ISO_TERRITORY_CODE_WS          IsoTerritoryCode = 249
-	IsoTerritoryCode_ISO_TERRITORY_CODE_YE          IsoTerritoryCode = 250
-	IsoTerritoryCode_ISO_TERRITORY_CODE_YT          IsoTerritoryCode = 251
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ZA          IsoTerritoryCode = 252
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ZM          IsoTerritoryCode = 253
-	IsoTerritoryCode_ISO_TERRITORY_CODE_ZW          IsoTerritoryCode = 254
+	// @xml: "AD"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AD IsoTerritoryCode = 1
+	// @xml: "AE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AE IsoTerritoryCode = 2
+	// @xml: "AF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AF IsoTerritoryCode = 3
+	// @xml: "AG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AG IsoTerritoryCode = 4
+	// @xml: "AI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AI IsoTerritoryCode = 5
+	// @xml: "AL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AL IsoTerritoryCode = 6
+	// @xml: "AM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AM IsoTerritoryCode = 7
+	// @xml: "AN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AN IsoTerritoryCode = 8
+	// @xml: "AO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AO IsoTerritoryCode = 9
+	// @xml: "AQ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AQ IsoTerritoryCode = 10
+	// @xml: "AR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AR IsoTerritoryCode = 11
+	// @xml: "AS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AS IsoTerritoryCode = 12
+	// @xml: "AT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AT IsoTerritoryCode = 13
+	// @xml: "AU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AU IsoTerritoryCode = 14
+	// @xml: "AW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AW IsoTerritoryCode = 15
+	// @xml: "AX"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AX IsoTerritoryCode = 16
+	// @xml: "AZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_AZ IsoTerritoryCode = 17
+	// @xml: "BA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BA IsoTerritoryCode = 18
+	// @xml: "BB"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BB IsoTerritoryCode = 19
+	// @xml: "BD"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BD IsoTerritoryCode = 20
+	// @xml: "BE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BE IsoTerritoryCode = 21
+	// @xml: "BF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BF IsoTerritoryCode = 22
+	// @xml: "BG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BG IsoTerritoryCode = 23
+	// @xml: "BH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BH IsoTerritoryCode = 24
+	// @xml: "BI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BI IsoTerritoryCode = 25
+	// @xml: "BJ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BJ IsoTerritoryCode = 26
+	// @xml: "BL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BL IsoTerritoryCode = 27
+	// @xml: "BM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BM IsoTerritoryCode = 28
+	// @xml: "BN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BN IsoTerritoryCode = 29
+	// @xml: "BO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BO IsoTerritoryCode = 30
+	// @xml: "BQ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BQ IsoTerritoryCode = 31
+	// @xml: "BR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BR IsoTerritoryCode = 32
+	// @xml: "BS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BS IsoTerritoryCode = 33
+	// @xml: "BT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BT IsoTerritoryCode = 34
+	// @xml: "BV"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BV IsoTerritoryCode = 35
+	// @xml: "BW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BW IsoTerritoryCode = 36
+	// @xml: "BY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BY IsoTerritoryCode = 37
+	// @xml: "BZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_BZ IsoTerritoryCode = 38
+	// @xml: "CA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CA IsoTerritoryCode = 39
+	// @xml: "CC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CC IsoTerritoryCode = 40
+	// @xml: "CD"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CD IsoTerritoryCode = 41
+	// @xml: "CF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CF IsoTerritoryCode = 42
+	// @xml: "CG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CG IsoTerritoryCode = 43
+	// @xml: "CH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CH IsoTerritoryCode = 44
+	// @xml: "CI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CI IsoTerritoryCode = 45
+	// @xml: "CK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CK IsoTerritoryCode = 46
+	// @xml: "CL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CL IsoTerritoryCode = 47
+	// @xml: "CM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CM IsoTerritoryCode = 48
+	// @xml: "CN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CN IsoTerritoryCode = 49
+	// @xml: "CO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CO IsoTerritoryCode = 50
+	// @xml: "CR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CR IsoTerritoryCode = 51
+	// @xml: "CS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CS IsoTerritoryCode = 52
+	// @xml: "CU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CU IsoTerritoryCode = 53
+	// @xml: "CV"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CV IsoTerritoryCode = 54
+	// @xml: "CW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CW IsoTerritoryCode = 55
+	// @xml: "CX"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CX IsoTerritoryCode = 56
+	// @xml: "CY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CY IsoTerritoryCode = 57
+	// @xml: "CZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_CZ IsoTerritoryCode = 58
+	// @xml: "DE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_DE IsoTerritoryCode = 59
+	// @xml: "DJ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_DJ IsoTerritoryCode = 60
+	// @xml: "DK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_DK IsoTerritoryCode = 61
+	// @xml: "DM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_DM IsoTerritoryCode = 62
+	// @xml: "DO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_DO IsoTerritoryCode = 63
+	// @xml: "DZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_DZ IsoTerritoryCode = 64
+	// @xml: "EC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_EC IsoTerritoryCode = 65
+	// @xml: "EE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_EE IsoTerritoryCode = 66
+	// @xml: "EG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_EG IsoTerritoryCode = 67
+	// @xml: "EH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_EH IsoTerritoryCode = 68
+	// @xml: "ER"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ER IsoTerritoryCode = 69
+	// @xml: "ES"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ES IsoTerritoryCode = 70
+	// @xml: "ES-CE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CE IsoTerritoryCode = 71
+	// @xml: "ES-CN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ES_CN IsoTerritoryCode = 72
+	// @xml: "ES-ML"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ES_ML IsoTerritoryCode = 73
+	// @xml: "ET"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ET IsoTerritoryCode = 74
+	// @xml: "FI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_FI IsoTerritoryCode = 75
+	// @xml: "FJ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_FJ IsoTerritoryCode = 76
+	// @xml: "FK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_FK IsoTerritoryCode = 77
+	// @xml: "FM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_FM IsoTerritoryCode = 78
+	// @xml: "FO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_FO IsoTerritoryCode = 79
+	// @xml: "FR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_FR IsoTerritoryCode = 80
+	// @xml: "GA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GA IsoTerritoryCode = 81
+	// @xml: "GB"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GB IsoTerritoryCode = 82
+	// @xml: "GD"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GD IsoTerritoryCode = 83
+	// @xml: "GE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GE IsoTerritoryCode = 84
+	// @xml: "GF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GF IsoTerritoryCode = 85
+	// @xml: "GG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GG IsoTerritoryCode = 86
+	// @xml: "GH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GH IsoTerritoryCode = 87
+	// @xml: "GI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GI IsoTerritoryCode = 88
+	// @xml: "GL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GL IsoTerritoryCode = 89
+	// @xml: "GM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GM IsoTerritoryCode = 90
+	// @xml: "GN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GN IsoTerritoryCode = 91
+	// @xml: "GP"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GP IsoTerritoryCode = 92
+	// @xml: "GQ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GQ IsoTerritoryCode = 93
+	// @xml: "GR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GR IsoTerritoryCode = 94
+	// @xml: "GS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GS IsoTerritoryCode = 95
+	// @xml: "GT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GT IsoTerritoryCode = 96
+	// @xml: "GU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GU IsoTerritoryCode = 97
+	// @xml: "GW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GW IsoTerritoryCode = 98
+	// @xml: "GY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_GY IsoTerritoryCode = 99
+	// @xml: "HK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_HK IsoTerritoryCode = 100
+	// @xml: "HM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_HM IsoTerritoryCode = 101
+	// @xml: "HN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_HN IsoTerritoryCode = 102
+	// @xml: "HR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_HR IsoTerritoryCode = 103
+	// @xml: "HT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_HT IsoTerritoryCode = 104
+	// @xml: "HU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_HU IsoTerritoryCode = 105
+	// @xml: "ID"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ID IsoTerritoryCode = 106
+	// @xml: "IE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IE IsoTerritoryCode = 107
+	// @xml: "IL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IL IsoTerritoryCode = 108
+	// @xml: "IM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IM IsoTerritoryCode = 109
+	// @xml: "IN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IN IsoTerritoryCode = 110
+	// @xml: "IO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IO IsoTerritoryCode = 111
+	// @xml: "IQ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IQ IsoTerritoryCode = 112
+	// @xml: "IR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IR IsoTerritoryCode = 113
+	// @xml: "IS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IS IsoTerritoryCode = 114
+	// @xml: "IT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_IT IsoTerritoryCode = 115
+	// @xml: "JE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_JE IsoTerritoryCode = 116
+	// @xml: "JM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_JM IsoTerritoryCode = 117
+	// @xml: "JO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_JO IsoTerritoryCode = 118
+	// @xml: "JP"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_JP IsoTerritoryCode = 119
+	// @xml: "KE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KE IsoTerritoryCode = 120
+	// @xml: "KG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KG IsoTerritoryCode = 121
+	// @xml: "KH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KH IsoTerritoryCode = 122
+	// @xml: "KI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KI IsoTerritoryCode = 123
+	// @xml: "KM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KM IsoTerritoryCode = 124
+	// @xml: "KN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KN IsoTerritoryCode = 125
+	// @xml: "KP"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KP IsoTerritoryCode = 126
+	// @xml: "KR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KR IsoTerritoryCode = 127
+	// @xml: "KW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KW IsoTerritoryCode = 128
+	// @xml: "KY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KY IsoTerritoryCode = 129
+	// @xml: "KZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_KZ IsoTerritoryCode = 130
+	// @xml: "LA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LA IsoTerritoryCode = 131
+	// @xml: "LB"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LB IsoTerritoryCode = 132
+	// @xml: "LC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LC IsoTerritoryCode = 133
+	// @xml: "LI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LI IsoTerritoryCode = 134
+	// @xml: "LK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LK IsoTerritoryCode = 135
+	// @xml: "LR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LR IsoTerritoryCode = 136
+	// @xml: "LS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LS IsoTerritoryCode = 137
+	// @xml: "LT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LT IsoTerritoryCode = 138
+	// @xml: "LU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LU IsoTerritoryCode = 139
+	// @xml: "LV"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LV IsoTerritoryCode = 140
+	// @xml: "LY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_LY IsoTerritoryCode = 141
+	// @xml: "MA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MA IsoTerritoryCode = 142
+	// @xml: "MC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MC IsoTerritoryCode = 143
+	// @xml: "MD"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MD IsoTerritoryCode = 144
+	// @xml: "ME"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ME IsoTerritoryCode = 145
+	// @xml: "MF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MF IsoTerritoryCode = 146
+	// @xml: "MG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MG IsoTerritoryCode = 147
+	// @xml: "MH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MH IsoTerritoryCode = 148
+	// @xml: "MK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MK IsoTerritoryCode = 149
+	// @xml: "ML"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ML IsoTerritoryCode = 150
+	// @xml: "MM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MM IsoTerritoryCode = 151
+	// @xml: "MN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MN IsoTerritoryCode = 152
+	// @xml: "MO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MO IsoTerritoryCode = 153
+	// @xml: "MP"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MP IsoTerritoryCode = 154
+	// @xml: "MQ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MQ IsoTerritoryCode = 155
+	// @xml: "MR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MR IsoTerritoryCode = 156
+	// @xml: "MS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MS IsoTerritoryCode = 157
+	// @xml: "MT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MT IsoTerritoryCode = 158
+	// @xml: "MU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MU IsoTerritoryCode = 159
+	// @xml: "MV"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MV IsoTerritoryCode = 160
+	// @xml: "MW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MW IsoTerritoryCode = 161
+	// @xml: "MX"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MX IsoTerritoryCode = 162
+	// @xml: "MY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MY IsoTerritoryCode = 163
+	// @xml: "MZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_MZ IsoTerritoryCode = 164
+	// @xml: "NA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NA IsoTerritoryCode = 165
+	// @xml: "NC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NC IsoTerritoryCode = 166
+	// @xml: "NE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NE IsoTerritoryCode = 167
+	// @xml: "NF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NF IsoTerritoryCode = 168
+	// @xml: "NG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NG IsoTerritoryCode = 169
+	// @xml: "NI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NI IsoTerritoryCode = 170
+	// @xml: "NL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NL IsoTerritoryCode = 171
+	// @xml: "NO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NO IsoTerritoryCode = 172
+	// @xml: "NP"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NP IsoTerritoryCode = 173
+	// @xml: "NR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NR IsoTerritoryCode = 174
+	// @xml: "NU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NU IsoTerritoryCode = 175
+	// @xml: "NZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_NZ IsoTerritoryCode = 176
+	// @xml: "OM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_OM IsoTerritoryCode = 177
+	// @xml: "PA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PA IsoTerritoryCode = 178
+	// @xml: "PE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PE IsoTerritoryCode = 179
+	// @xml: "PF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PF IsoTerritoryCode = 180
+	// @xml: "PG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PG IsoTerritoryCode = 181
+	// @xml: "PH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PH IsoTerritoryCode = 182
+	// @xml: "PK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PK IsoTerritoryCode = 183
+	// @xml: "PL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PL IsoTerritoryCode = 184
+	// @xml: "PM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PM IsoTerritoryCode = 185
+	// @xml: "PN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PN IsoTerritoryCode = 186
+	// @xml: "PR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PR IsoTerritoryCode = 187
+	// @xml: "PS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PS IsoTerritoryCode = 188
+	// @xml: "PT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PT IsoTerritoryCode = 189
+	// @xml: "PW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PW IsoTerritoryCode = 190
+	// @xml: "PY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_PY IsoTerritoryCode = 191
+	// @xml: "QA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_QA IsoTerritoryCode = 192
+	// @xml: "RE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_RE IsoTerritoryCode = 193
+	// @xml: "RO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_RO IsoTerritoryCode = 194
+	// @xml: "RS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_RS IsoTerritoryCode = 195
+	// @xml: "RU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_RU IsoTerritoryCode = 196
+	// @xml: "RW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_RW IsoTerritoryCode = 197
+	// @xml: "SA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SA IsoTerritoryCode = 198
+	// @xml: "SB"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SB IsoTerritoryCode = 199
+	// @xml: "SC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SC IsoTerritoryCode = 200
+	// @xml: "SD"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SD IsoTerritoryCode = 201
+	// @xml: "SE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SE IsoTerritoryCode = 202
+	// @xml: "SG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SG IsoTerritoryCode = 203
+	// @xml: "SH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SH IsoTerritoryCode = 204
+	// @xml: "SI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SI IsoTerritoryCode = 205
+	// @xml: "SJ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SJ IsoTerritoryCode = 206
+	// @xml: "SK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SK IsoTerritoryCode = 207
+	// @xml: "SL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SL IsoTerritoryCode = 208
+	// @xml: "SM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SM IsoTerritoryCode = 209
+	// @xml: "SN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SN IsoTerritoryCode = 210
+	// @xml: "SO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SO IsoTerritoryCode = 211
+	// @xml: "SR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SR IsoTerritoryCode = 212
+	// @xml: "SS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SS IsoTerritoryCode = 213
+	// @xml: "ST"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ST IsoTerritoryCode = 214
+	// @xml: "SV"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SV IsoTerritoryCode = 215
+	// @xml: "SX"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SX IsoTerritoryCode = 216
+	// @xml: "SY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SY IsoTerritoryCode = 217
+	// @xml: "SZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_SZ IsoTerritoryCode = 218
+	// @xml: "TC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TC IsoTerritoryCode = 219
+	// @xml: "TD"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TD IsoTerritoryCode = 220
+	// @xml: "TF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TF IsoTerritoryCode = 221
+	// @xml: "TG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TG IsoTerritoryCode = 222
+	// @xml: "TH"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TH IsoTerritoryCode = 223
+	// @xml: "TJ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TJ IsoTerritoryCode = 224
+	// @xml: "TK"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TK IsoTerritoryCode = 225
+	// @xml: "TL"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TL IsoTerritoryCode = 226
+	// @xml: "TM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TM IsoTerritoryCode = 227
+	// @xml: "TN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TN IsoTerritoryCode = 228
+	// @xml: "TO"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TO IsoTerritoryCode = 229
+	// @xml: "TR"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TR IsoTerritoryCode = 230
+	// @xml: "TT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TT IsoTerritoryCode = 231
+	// @xml: "TV"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TV IsoTerritoryCode = 232
+	// @xml: "TW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TW IsoTerritoryCode = 233
+	// @xml: "TZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_TZ IsoTerritoryCode = 234
+	// @xml: "UA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_UA IsoTerritoryCode = 235
+	// @xml: "UG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_UG IsoTerritoryCode = 236
+	// @xml: "UM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_UM IsoTerritoryCode = 237
+	// @xml: "US"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_US IsoTerritoryCode = 238
+	// @xml: "UY"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_UY IsoTerritoryCode = 239
+	// @xml: "UZ"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_UZ IsoTerritoryCode = 240
+	// @xml: "VA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_VA IsoTerritoryCode = 241
+	// @xml: "VC"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_VC IsoTerritoryCode = 242
+	// @xml: "VE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_VE IsoTerritoryCode = 243
+	// @xml: "VG"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_VG IsoTerritoryCode = 244
+	// @xml: "VI"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_VI IsoTerritoryCode = 245
+	// @xml: "VN"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_VN IsoTerritoryCode = 246
+	// @xml: "VU"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_VU IsoTerritoryCode = 247
+	// @xml: "WF"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_WF IsoTerritoryCode = 248
+	// @xml: "WS"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_WS IsoTerritoryCode = 249
+	// @xml: "YE"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_YE IsoTerritoryCode = 250
+	// @xml: "YT"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_YT IsoTerritoryCode = 251
+	// @xml: "ZA"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ZA IsoTerritoryCode = 252
+	// @xml: "ZM"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ZM IsoTerritoryCode = 253
+	// @xml: "ZW"
+	IsoTerritoryCode_ISO_TERRITORY_CODE_ZW IsoTerritoryCode = 254
 )
 
 // Enum value maps for IsoTerritoryCode.
@@ -10830,9 +13661,11 @@ func (IsoTerritoryCode) EnumDescriptor() ([]byte, []int) {
 type LabelNameType int32
 
 const (
-	LabelNameType_LABEL_NAME_TYPE_UNSPECIFIED      LabelNameType = 0
+	LabelNameType_LABEL_NAME_TYPE_UNSPECIFIED LabelNameType = 0
+	// @xml: "DisplayLabelName"
 	LabelNameType_LABEL_NAME_TYPE_DISPLAYLABELNAME LabelNameType = 1
-	LabelNameType_LABEL_NAME_TYPE_USERDEFINED      LabelNameType = 2
+	// @xml: "UserDefined"
+	LabelNameType_LABEL_NAME_TYPE_USERDEFINED LabelNameType = 2
 )
 
 // Enum value maps for LabelNameType.
@@ -10879,47 +13712,87 @@ func (LabelNameType) EnumDescriptor() ([]byte, []int) {
 type LicenseOrClaimRefusalReason int32
 
 const (
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_UNSPECIFIED                                   LicenseOrClaimRefusalReason = 0
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_AGREEMENTOFADDITIONALPROVISIONSREQUIRED       LicenseOrClaimRefusalReason = 1
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFADVANCEPAYMENTREQUIRED            LicenseOrClaimRefusalReason = 2
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFGUARANTEEREQUIRED                 LicenseOrClaimRefusalReason = 3
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFLICENSEEREQUIRED                  LicenseOrClaimRefusalReason = 4
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFMOSTFAVOREDNATIONCLAUSEREQUIRED   LicenseOrClaimRefusalReason = 5
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFNUMBEROFRESOURCESREQUIRED         LicenseOrClaimRefusalReason = 6
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPLAYINGTIMEREQUIRED               LicenseOrClaimRefusalReason = 7
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERINFORMATIONREQUIRED      LicenseOrClaimRefusalReason = 8
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERPERCENTAGEREQUIRED       LicenseOrClaimRefusalReason = 9
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRATEREQUIRED                      LicenseOrClaimRefusalReason = 10
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_UNSPECIFIED LicenseOrClaimRefusalReason = 0
+	// @xml: "AgreementOfAdditionalProvisionsRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_AGREEMENTOFADDITIONALPROVISIONSREQUIRED LicenseOrClaimRefusalReason = 1
+	// @xml: "CorrectionOfAdvancePaymentRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFADVANCEPAYMENTREQUIRED LicenseOrClaimRefusalReason = 2
+	// @xml: "CorrectionOfGuaranteeRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFGUARANTEEREQUIRED LicenseOrClaimRefusalReason = 3
+	// @xml: "CorrectionOfLicenseeRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFLICENSEEREQUIRED LicenseOrClaimRefusalReason = 4
+	// @xml: "CorrectionOfMostFavoredNationClauseRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFMOSTFAVOREDNATIONCLAUSEREQUIRED LicenseOrClaimRefusalReason = 5
+	// @xml: "CorrectionOfNumberOfResourcesRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFNUMBEROFRESOURCESREQUIRED LicenseOrClaimRefusalReason = 6
+	// @xml: "CorrectionOfPlayingTimeRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPLAYINGTIMEREQUIRED LicenseOrClaimRefusalReason = 7
+	// @xml: "CorrectionOfPublisherInformationRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERINFORMATIONREQUIRED LicenseOrClaimRefusalReason = 8
+	// @xml: "CorrectionOfPublisherPercentageRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFPUBLISHERPERCENTAGEREQUIRED LicenseOrClaimRefusalReason = 9
+	// @xml: "CorrectionOfRateRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRATEREQUIRED LicenseOrClaimRefusalReason = 10
+	// @xml: "CorrectionOfReleaseCreatorInformationRequired"
 	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASECREATORINFORMATIONREQUIRED LicenseOrClaimRefusalReason = 11
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASEDATEREQUIRED               LicenseOrClaimRefusalReason = 12
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASETITLEREQUIRED              LicenseOrClaimRefusalReason = 13
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKCONTRIBUTORREQUIRED           LicenseOrClaimRefusalReason = 14
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKTITLEREQUIRED                 LicenseOrClaimRefusalReason = 15
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DEALEXPIRED                                   LicenseOrClaimRefusalReason = 16
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIFFERENTWORK                                 LicenseOrClaimRefusalReason = 17
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIRECTLICENSE                                 LicenseOrClaimRefusalReason = 18
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATELICENSE                              LicenseOrClaimRefusalReason = 19
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATEREQUEST                              LicenseOrClaimRefusalReason = 20
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_IMPORTLICENSEEXISTS                           LicenseOrClaimRefusalReason = 21
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCLAIM                                LicenseOrClaimRefusalReason = 22
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCONTROLLEDCOMPOSITIONRATE            LicenseOrClaimRefusalReason = 23
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INHOUSELICENSEEXISTS                          LicenseOrClaimRefusalReason = 24
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INSUFFICIENTINFORMATION                       LicenseOrClaimRefusalReason = 25
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_LICENSEENOTAUTHORIZED                         LicenseOrClaimRefusalReason = 26
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_MEDLEYREQUEST                                 LicenseOrClaimRefusalReason = 27
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOOPTIN                                       LicenseOrClaimRefusalReason = 28
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOPUBLISHERCLAIM                              LicenseOrClaimRefusalReason = 29
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_OWNERSHIPUNCONFIRMED                          LicenseOrClaimRefusalReason = 30
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PRODUCTUNAVAILABLE                            LicenseOrClaimRefusalReason = 31
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PUBLISHERNOTREPRESENTED                       LicenseOrClaimRefusalReason = 32
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELEASEWITHDRAWN                              LicenseOrClaimRefusalReason = 33
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELINQUISHEDCLAIM                             LicenseOrClaimRefusalReason = 34
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_USERDEFINED                                   LicenseOrClaimRefusalReason = 35
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKDELETEDFROMRELEASE                        LicenseOrClaimRefusalReason = 36
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINCORRECTLYIDENTIFIED                     LicenseOrClaimRefusalReason = 37
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINPUBLICDOMAIN                            LicenseOrClaimRefusalReason = 38
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKNOTUSED                                   LicenseOrClaimRefusalReason = 39
-	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKUNKNOWN                                   LicenseOrClaimRefusalReason = 40
+	// @xml: "CorrectionOfReleaseDateRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASEDATEREQUIRED LicenseOrClaimRefusalReason = 12
+	// @xml: "CorrectionOfReleaseTitleRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFRELEASETITLEREQUIRED LicenseOrClaimRefusalReason = 13
+	// @xml: "CorrectionOfWorkContributorRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKCONTRIBUTORREQUIRED LicenseOrClaimRefusalReason = 14
+	// @xml: "CorrectionOfWorkTitleRequired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_CORRECTIONOFWORKTITLEREQUIRED LicenseOrClaimRefusalReason = 15
+	// @xml: "DealExpired"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DEALEXPIRED LicenseOrClaimRefusalReason = 16
+	// @xml: "DifferentWork"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIFFERENTWORK LicenseOrClaimRefusalReason = 17
+	// @xml: "DirectLicense"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DIRECTLICENSE LicenseOrClaimRefusalReason = 18
+	// @xml: "DuplicateLicense"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATELICENSE LicenseOrClaimRefusalReason = 19
+	// @xml: "DuplicateRequest"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_DUPLICATEREQUEST LicenseOrClaimRefusalReason = 20
+	// @xml: "ImportLicenseExists"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_IMPORTLICENSEEXISTS LicenseOrClaimRefusalReason = 21
+	// @xml: "IncorrectClaim"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCLAIM LicenseOrClaimRefusalReason = 22
+	// @xml: "IncorrectControlledCompositionRate"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INCORRECTCONTROLLEDCOMPOSITIONRATE LicenseOrClaimRefusalReason = 23
+	// @xml: "InHouseLicenseExists"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INHOUSELICENSEEXISTS LicenseOrClaimRefusalReason = 24
+	// @xml: "InsufficientInformation"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_INSUFFICIENTINFORMATION LicenseOrClaimRefusalReason = 25
+	// @xml: "LicenseeNotAuthorized"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_LICENSEENOTAUTHORIZED LicenseOrClaimRefusalReason = 26
+	// @xml: "MedleyRequest"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_MEDLEYREQUEST LicenseOrClaimRefusalReason = 27
+	// @xml: "NoOptIn"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOOPTIN LicenseOrClaimRefusalReason = 28
+	// @xml: "NoPublisherClaim"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_NOPUBLISHERCLAIM LicenseOrClaimRefusalReason = 29
+	// @xml: "OwnershipUnconfirmed"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_OWNERSHIPUNCONFIRMED LicenseOrClaimRefusalReason = 30
+	// @xml: "ProductUnavailable"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PRODUCTUNAVAILABLE LicenseOrClaimRefusalReason = 31
+	// @xml: "PublisherNotRepresented"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_PUBLISHERNOTREPRESENTED LicenseOrClaimRefusalReason = 32
+	// @xml: "ReleaseWithdrawn"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELEASEWITHDRAWN LicenseOrClaimRefusalReason = 33
+	// @xml: "RelinquishedClaim"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_RELINQUISHEDCLAIM LicenseOrClaimRefusalReason = 34
+	// @xml: "UserDefined"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_USERDEFINED LicenseOrClaimRefusalReason = 35
+	// @xml: "WorkDeletedFromRelease"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKDELETEDFROMRELEASE LicenseOrClaimRefusalReason = 36
+	// @xml: "WorkIncorrectlyIdentified"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINCORRECTLYIDENTIFIED LicenseOrClaimRefusalReason = 37
+	// @xml: "WorkInPublicDomain"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKINPUBLICDOMAIN LicenseOrClaimRefusalReason = 38
+	// @xml: "WorkNotUsed"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKNOTUSED LicenseOrClaimRefusalReason = 39
+	// @xml: "WorkUnknown"
+	LicenseOrClaimRefusalReason_LICENSE_OR_CLAIM_REFUSAL_REASON_WORKUNKNOWN LicenseOrClaimRefusalReason = 40
 )
 
 // Enum value maps for LicenseOrClaimRefusalReason.
@@ -11042,10 +13915,13 @@ func (LicenseOrClaimRefusalReason) EnumDescriptor() ([]byte, []int) {
 type LicenseOrClaimRequestUpdateReason int32
 
 const (
-	LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_UNSPECIFIED                              LicenseOrClaimRequestUpdateReason = 0
-	LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_ADDITIONALINFORMATIONPROVIDED            LicenseOrClaimRequestUpdateReason = 1
+	LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_UNSPECIFIED LicenseOrClaimRequestUpdateReason = 0
+	// @xml: "AdditionalInformationProvided"
+	LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_ADDITIONALINFORMATIONPROVIDED LicenseOrClaimRequestUpdateReason = 1
+	// @xml: "AdditionalInformationProvidedAsRequested"
 	LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_ADDITIONALINFORMATIONPROVIDEDASREQUESTED LicenseOrClaimRequestUpdateReason = 2
-	LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_USERDEFINED                              LicenseOrClaimRequestUpdateReason = 3
+	// @xml: "UserDefined"
+	LicenseOrClaimRequestUpdateReason_LICENSE_OR_CLAIM_REQUEST_UPDATE_REASON_USERDEFINED LicenseOrClaimRequestUpdateReason = 3
 )
 
 // Enum value maps for LicenseOrClaimRequestUpdateReason.
@@ -11094,13 +13970,19 @@ func (LicenseOrClaimRequestUpdateReason) EnumDescriptor() ([]byte, []int) {
 type LicenseOrClaimUpdateReason int32
 
 const (
-	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_UNSPECIFIED               LicenseOrClaimUpdateReason = 0
-	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWLICENSEISSUED          LicenseOrClaimUpdateReason = 1
-	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWRIGHTSHAREIDENTIFIED   LicenseOrClaimUpdateReason = 2
+	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_UNSPECIFIED LicenseOrClaimUpdateReason = 0
+	// @xml: "NewLicenseIssued"
+	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWLICENSEISSUED LicenseOrClaimUpdateReason = 1
+	// @xml: "NewRightShareIdentified"
+	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWRIGHTSHAREIDENTIFIED LicenseOrClaimUpdateReason = 2
+	// @xml: "NewRightsholderIdentified"
 	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWRIGHTSHOLDERIDENTIFIED LicenseOrClaimUpdateReason = 3
-	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWWORKIDENTIFIED         LicenseOrClaimUpdateReason = 4
-	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_REVOKED                   LicenseOrClaimUpdateReason = 5
-	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_USERDEFINED               LicenseOrClaimUpdateReason = 6
+	// @xml: "NewWorkIdentified"
+	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_NEWWORKIDENTIFIED LicenseOrClaimUpdateReason = 4
+	// @xml: "Revoked"
+	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_REVOKED LicenseOrClaimUpdateReason = 5
+	// @xml: "UserDefined"
+	LicenseOrClaimUpdateReason_LICENSE_OR_CLAIM_UPDATE_REASON_USERDEFINED LicenseOrClaimUpdateReason = 6
 )
 
 // Enum value maps for LicenseOrClaimUpdateReason.
@@ -11155,19 +14037,31 @@ func (LicenseOrClaimUpdateReason) EnumDescriptor() ([]byte, []int) {
 type LicenseRejectionReason int32
 
 const (
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_UNSPECIFIED                    LicenseRejectionReason = 0
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_DISAGREEMENTOVERROYALTIES      LicenseRejectionReason = 1
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_UNSPECIFIED LicenseRejectionReason = 0
+	// @xml: "DisagreementOverRoyalties"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_DISAGREEMENTOVERROYALTIES LicenseRejectionReason = 1
+	// @xml: "DisagreementOverScopeOfLicense"
 	LicenseRejectionReason_LICENSE_REJECTION_REASON_DISAGREEMENTOVERSCOPEOFLICENSE LicenseRejectionReason = 2
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEEXISTS                  LicenseRejectionReason = 3
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSENOTNEEDED               LicenseRejectionReason = 4
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_WRONGADDRESSEE                 LicenseRejectionReason = 5
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_USERDEFINED                    LicenseRejectionReason = 6
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKINPUBLICDOMAIN             LicenseRejectionReason = 7
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_DUPLICATELICENSEREQUESTNUMBER  LicenseRejectionReason = 8
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEBLOCKED                 LicenseRejectionReason = 9
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_REFERENCEDDOCUMENTMISSING      LicenseRejectionReason = 10
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_SHARESPLITSDIFFER              LicenseRejectionReason = 11
-	LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKUSEDMULTIPLETIMES          LicenseRejectionReason = 12
+	// @xml: "LicenseExists"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEEXISTS LicenseRejectionReason = 3
+	// @xml: "LicenseNotNeeded"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSENOTNEEDED LicenseRejectionReason = 4
+	// @xml: "WrongAddressee"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_WRONGADDRESSEE LicenseRejectionReason = 5
+	// @xml: "UserDefined"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_USERDEFINED LicenseRejectionReason = 6
+	// @xml: "WorkInPublicDomain"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKINPUBLICDOMAIN LicenseRejectionReason = 7
+	// @xml: "DuplicateLicenseRequestNumber"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_DUPLICATELICENSEREQUESTNUMBER LicenseRejectionReason = 8
+	// @xml: "LicenseBlocked"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_LICENSEBLOCKED LicenseRejectionReason = 9
+	// @xml: "ReferencedDocumentMissing"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_REFERENCEDDOCUMENTMISSING LicenseRejectionReason = 10
+	// @xml: "ShareSplitsDiffer"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_SHARESPLITSDIFFER LicenseRejectionReason = 11
+	// @xml: "WorkUsedMultipleTimes"
+	LicenseRejectionReason_LICENSE_REJECTION_REASON_WORKUSEDMULTIPLETIMES LicenseRejectionReason = 12
 )
 
 // Enum value maps for LicenseRejectionReason.
@@ -11235,9 +14129,12 @@ type LicenseStatus int32
 
 const (
 	LicenseStatus_LICENSE_STATUS_UNSPECIFIED LicenseStatus = 0
-	LicenseStatus_LICENSE_STATUS_ACTIVE      LicenseStatus = 1
-	LicenseStatus_LICENSE_STATUS_PENDING     LicenseStatus = 2
-	LicenseStatus_LICENSE_STATUS_REVOKED     LicenseStatus = 3
+	// @xml: "Active"
+	LicenseStatus_LICENSE_STATUS_ACTIVE LicenseStatus = 1
+	// @xml: "Pending"
+	LicenseStatus_LICENSE_STATUS_PENDING LicenseStatus = 2
+	// @xml: "Revoked"
+	LicenseStatus_LICENSE_STATUS_REVOKED LicenseStatus = 3
 )
 
 // Enum value maps for LicenseStatus.
@@ -11286,9 +14183,12 @@ func (LicenseStatus) EnumDescriptor() ([]byte, []int) {
 type LicensingProcessStatus int32
 
 const (
-	LicensingProcessStatus_LICENSING_PROCESS_STATUS_UNSPECIFIED                    LicensingProcessStatus = 0
-	LicensingProcessStatus_LICENSING_PROCESS_STATUS_PENDING                        LicensingProcessStatus = 1
-	LicensingProcessStatus_LICENSING_PROCESS_STATUS_PROCESSED                      LicensingProcessStatus = 2
+	LicensingProcessStatus_LICENSING_PROCESS_STATUS_UNSPECIFIED LicensingProcessStatus = 0
+	// @xml: "Pending"
+	LicensingProcessStatus_LICENSING_PROCESS_STATUS_PENDING LicensingProcessStatus = 1
+	// @xml: "Processed"
+	LicensingProcessStatus_LICENSING_PROCESS_STATUS_PROCESSED LicensingProcessStatus = 2
+	// @xml: "ThirdPartyInformationRequested"
 	LicensingProcessStatus_LICENSING_PROCESS_STATUS_THIRDPARTYINFORMATIONREQUESTED LicensingProcessStatus = 3
 )
 
@@ -11339,7 +14239,8 @@ type LodFileStatus int32
 
 const (
 	LodFileStatus_LOD_FILE_STATUS_UNSPECIFIED LodFileStatus = 0
-	LodFileStatus_LOD_FILE_STATUS_FILEOK      LodFileStatus = 1
+	// @xml: "FileOK"
+	LodFileStatus_LOD_FILE_STATUS_FILEOK LodFileStatus = 1
 )
 
 // Enum value maps for LodFileStatus.
@@ -11384,7 +14285,8 @@ func (LodFileStatus) EnumDescriptor() ([]byte, []int) {
 type LodProposedActionType int32
 
 const (
-	LodProposedActionType_LOD_PROPOSED_ACTION_TYPE_UNSPECIFIED   LodProposedActionType = 0
+	LodProposedActionType_LOD_PROPOSED_ACTION_TYPE_UNSPECIFIED LodProposedActionType = 0
+	// @xml: "ResendXmlOnly"
 	LodProposedActionType_LOD_PROPOSED_ACTION_TYPE_RESENDXMLONLY LodProposedActionType = 1
 )
 
@@ -11430,9 +14332,12 @@ func (LodProposedActionType) EnumDescriptor() ([]byte, []int) {
 type MembershipType int32
 
 const (
-	MembershipType_MEMBERSHIP_TYPE_UNSPECIFIED     MembershipType = 0
-	MembershipType_MEMBERSHIP_TYPE_NATIONALMEMBER  MembershipType = 1
-	MembershipType_MEMBERSHIP_TYPE_REGIONALMEMBER  MembershipType = 2
+	MembershipType_MEMBERSHIP_TYPE_UNSPECIFIED MembershipType = 0
+	// @xml: "NationalMember"
+	MembershipType_MEMBERSHIP_TYPE_NATIONALMEMBER MembershipType = 1
+	// @xml: "RegionalMember"
+	MembershipType_MEMBERSHIP_TYPE_REGIONALMEMBER MembershipType = 2
+	// @xml: "WorldwideMember"
 	MembershipType_MEMBERSHIP_TYPE_WORLDWIDEMEMBER MembershipType = 3
 )
 
@@ -11482,13 +14387,19 @@ func (MembershipType) EnumDescriptor() ([]byte, []int) {
 type MessageActionType int32
 
 const (
-	MessageActionType_MESSAGE_ACTION_TYPE_UNSPECIFIED          MessageActionType = 0
-	MessageActionType_MESSAGE_ACTION_TYPE_BACKCATALOGDELIVERY  MessageActionType = 1
+	MessageActionType_MESSAGE_ACTION_TYPE_UNSPECIFIED MessageActionType = 0
+	// @xml: "BackCatalogDelivery"
+	MessageActionType_MESSAGE_ACTION_TYPE_BACKCATALOGDELIVERY MessageActionType = 1
+	// @xml: "HighPriorityDelivery"
 	MessageActionType_MESSAGE_ACTION_TYPE_HIGHPRIORITYDELIVERY MessageActionType = 2
-	MessageActionType_MESSAGE_ACTION_TYPE_NEWRELEASEDELIVERY   MessageActionType = 3
-	MessageActionType_MESSAGE_ACTION_TYPE_REDELIVERY           MessageActionType = 4
-	MessageActionType_MESSAGE_ACTION_TYPE_TAKEDOWN             MessageActionType = 5
-	MessageActionType_MESSAGE_ACTION_TYPE_USERDEFINED          MessageActionType = 6
+	// @xml: "NewReleaseDelivery"
+	MessageActionType_MESSAGE_ACTION_TYPE_NEWRELEASEDELIVERY MessageActionType = 3
+	// @xml: "ReDelivery"
+	MessageActionType_MESSAGE_ACTION_TYPE_REDELIVERY MessageActionType = 4
+	// @xml: "TakeDown"
+	MessageActionType_MESSAGE_ACTION_TYPE_TAKEDOWN MessageActionType = 5
+	// @xml: "UserDefined"
+	MessageActionType_MESSAGE_ACTION_TYPE_USERDEFINED MessageActionType = 6
 )
 
 // Enum value maps for MessageActionType.
@@ -11543,10 +14454,13 @@ func (MessageActionType) EnumDescriptor() ([]byte, []int) {
 type MessageContentRevenueType int32
 
 const (
-	MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_UNSPECIFIED             MessageContentRevenueType = 0
+	MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_UNSPECIFIED MessageContentRevenueType = 0
+	// @xml: "NonTransactionalRevenue"
 	MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_NONTRANSACTIONALREVENUE MessageContentRevenueType = 1
-	MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_TRANSACTIONALREVENUE    MessageContentRevenueType = 2
-	MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_USERDEFINED             MessageContentRevenueType = 3
+	// @xml: "TransactionalRevenue"
+	MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_TRANSACTIONALREVENUE MessageContentRevenueType = 2
+	// @xml: "UserDefined"
+	MessageContentRevenueType_MESSAGE_CONTENT_REVENUE_TYPE_USERDEFINED MessageContentRevenueType = 3
 )
 
 // Enum value maps for MessageContentRevenueType.
@@ -11595,12 +14509,18 @@ func (MessageContentRevenueType) EnumDescriptor() ([]byte, []int) {
 type MessageContextType int32
 
 const (
-	MessageContextType_MESSAGE_CONTEXT_TYPE_UNSPECIFIED                                                    MessageContextType = 0
-	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINIDENTIFICATIONCYCLE            MessageContextType = 1
-	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINIDENTIFICATIONCYCLE       MessageContextType = 2
-	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINCONFIRMATIONCYCLE              MessageContextType = 3
-	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINCONFIRMATIONCYCLE         MessageContextType = 4
-	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODMESSAGE      MessageContextType = 5
+	MessageContextType_MESSAGE_CONTEXT_TYPE_UNSPECIFIED MessageContextType = 0
+	// @xml: "MusicalWorkClaimRequestMessageInIdentificationCycle"
+	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINIDENTIFICATIONCYCLE MessageContextType = 1
+	// @xml: "MusicalWorkClaimNotificationMessageInIdentificationCycle"
+	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINIDENTIFICATIONCYCLE MessageContextType = 2
+	// @xml: "MusicalWorkClaimRequestMessageInConfirmationCycle"
+	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMREQUESTMESSAGEINCONFIRMATIONCYCLE MessageContextType = 3
+	// @xml: "MusicalWorkClaimNotificationMessageInConfirmationCycle"
+	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINCONFIRMATIONCYCLE MessageContextType = 4
+	// @xml: "MusicalWorkClaimNotificationMessageInLoCCycleAsLoDMessage"
+	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODMESSAGE MessageContextType = 5
+	// @xml: "MusicalWorkClaimNotificationMessageInLoCCycleAsLoDConfirmation"
 	MessageContextType_MESSAGE_CONTEXT_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGEINLOCCYCLEASLODCONFIRMATION MessageContextType = 6
 )
 
@@ -11657,7 +14577,9 @@ type MessageControlType int32
 
 const (
 	MessageControlType_MESSAGE_CONTROL_TYPE_UNSPECIFIED MessageControlType = 0
+	// @xml: "LiveMessage"
 	MessageControlType_MESSAGE_CONTROL_TYPE_LIVEMESSAGE MessageControlType = 1
+	// @xml: "TestMessage"
 	MessageControlType_MESSAGE_CONTROL_TYPE_TESTMESSAGE MessageControlType = 2
 )
 
@@ -11705,11 +14627,15 @@ func (MessageControlType) EnumDescriptor() ([]byte, []int) {
 type MidiType int32
 
 const (
-	MidiType_MIDI_TYPE_UNSPECIFIED    MidiType = 0
+	MidiType_MIDI_TYPE_UNSPECIFIED MidiType = 0
+	// @xml: "MonophonicMidi"
 	MidiType_MIDI_TYPE_MONOPHONICMIDI MidiType = 1
+	// @xml: "PolyphonicMidi"
 	MidiType_MIDI_TYPE_POLYPHONICMIDI MidiType = 2
-	MidiType_MIDI_TYPE_UNKNOWN        MidiType = 3
-	MidiType_MIDI_TYPE_USERDEFINED    MidiType = 4
+	// @xml: "Unknown"
+	MidiType_MIDI_TYPE_UNKNOWN MidiType = 3
+	// @xml: "UserDefined"
+	MidiType_MIDI_TYPE_USERDEFINED MidiType = 4
 )
 
 // Enum value maps for MidiType.
@@ -11760,12 +14686,17 @@ func (MidiType) EnumDescriptor() ([]byte, []int) {
 type MlcMessageType int32
 
 const (
-	MlcMessageType_MLC_MESSAGE_TYPE_UNSPECIFIED                                   MlcMessageType = 0
+	MlcMessageType_MLC_MESSAGE_TYPE_UNSPECIFIED MlcMessageType = 0
+	// @xml: "DeclarationOfSoundRecordingRightsClaimMessage"
 	MlcMessageType_MLC_MESSAGE_TYPE_DECLARATIONOFSOUNDRECORDINGRIGHTSCLAIMMESSAGE MlcMessageType = 1
-	MlcMessageType_MLC_MESSAGE_TYPE_REQUESTSOUNDRECORDINGINFORMATIONMESSAGE       MlcMessageType = 2
-	MlcMessageType_MLC_MESSAGE_TYPE_REVOKESOUNDRECORDINGRIGHTSCLAIMMESSAGE        MlcMessageType = 3
-	MlcMessageType_MLC_MESSAGE_TYPE_SALESREPORTMESSAGE                            MlcMessageType = 4
-	MlcMessageType_MLC_MESSAGE_TYPE_DECLARATIONOFREVENUEMESSAGE                   MlcMessageType = 5
+	// @xml: "RequestSoundRecordingInformationMessage"
+	MlcMessageType_MLC_MESSAGE_TYPE_REQUESTSOUNDRECORDINGINFORMATIONMESSAGE MlcMessageType = 2
+	// @xml: "RevokeSoundRecordingRightsClaimMessage"
+	MlcMessageType_MLC_MESSAGE_TYPE_REVOKESOUNDRECORDINGRIGHTSCLAIMMESSAGE MlcMessageType = 3
+	// @xml: "SalesReportMessage"
+	MlcMessageType_MLC_MESSAGE_TYPE_SALESREPORTMESSAGE MlcMessageType = 4
+	// @xml: "DeclarationOfRevenueMessage"
+	MlcMessageType_MLC_MESSAGE_TYPE_DECLARATIONOFREVENUEMESSAGE MlcMessageType = 5
 )
 
 // Enum value maps for MlcMessageType.
@@ -11818,55 +14749,103 @@ func (MlcMessageType) EnumDescriptor() ([]byte, []int) {
 type MusicalWorkContributorRole int32
 
 const (
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_UNSPECIFIED                  MusicalWorkContributorRole = 0
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ADAPTER                      MusicalWorkContributorRole = 1
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARCHITECT                    MusicalWorkContributorRole = 2
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARRANGER                     MusicalWorkContributorRole = 3
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOR                       MusicalWorkContributorRole = 4
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHORINQUOTATIONS           MusicalWorkContributorRole = 5
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOROFAFTERWORD            MusicalWorkContributorRole = 6
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPILER                     MusicalWorkContributorRole = 7
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSER                     MusicalWorkContributorRole = 8
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSERLYRICIST             MusicalWorkContributorRole = 9
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONCEPTOR                    MusicalWorkContributorRole = 10
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CREATOR                      MusicalWorkContributorRole = 11
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DIALOGUEAUTHOR               MusicalWorkContributorRole = 12
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DISSERTANT                   MusicalWorkContributorRole = 13
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ENGRAVER                     MusicalWorkContributorRole = 14
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ETCHER                       MusicalWorkContributorRole = 15
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_JOURNALIST                   MusicalWorkContributorRole = 16
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LANDSCAPEARCHITECT           MusicalWorkContributorRole = 17
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LIBRETTIST                   MusicalWorkContributorRole = 18
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LITHOGRAPHER                 MusicalWorkContributorRole = 19
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LYRICIST                     MusicalWorkContributorRole = 20
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_METALENGRAVER                MusicalWorkContributorRole = 21
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NONLYRICAUTHOR               MusicalWorkContributorRole = 22
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLATEMAKER                   MusicalWorkContributorRole = 23
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLAYWRIGHT                   MusicalWorkContributorRole = 24
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REPORTER                     MusicalWorkContributorRole = 25
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REVIEWER                     MusicalWorkContributorRole = 26
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_RUBRICATOR                   MusicalWorkContributorRole = 27
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCREENPLAYAUTHOR             MusicalWorkContributorRole = 28
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCULPTOR                     MusicalWorkContributorRole = 29
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBARRANGER                  MusicalWorkContributorRole = 30
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBLYRICIST                  MusicalWorkContributorRole = 31
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_TRANSLATOR                   MusicalWorkContributorRole = 32
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODCUTTER                   MusicalWorkContributorRole = 33
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODENGRAVER                 MusicalWorkContributorRole = 34
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_UNSPECIFIED MusicalWorkContributorRole = 0
+	// @xml: "Adapter"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ADAPTER MusicalWorkContributorRole = 1
+	// @xml: "Architect"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARCHITECT MusicalWorkContributorRole = 2
+	// @xml: "Arranger"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ARRANGER MusicalWorkContributorRole = 3
+	// @xml: "Author"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOR MusicalWorkContributorRole = 4
+	// @xml: "AuthorInQuotations"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHORINQUOTATIONS MusicalWorkContributorRole = 5
+	// @xml: "AuthorOfAfterword"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_AUTHOROFAFTERWORD MusicalWorkContributorRole = 6
+	// @xml: "Compiler"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPILER MusicalWorkContributorRole = 7
+	// @xml: "Composer"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSER MusicalWorkContributorRole = 8
+	// @xml: "ComposerLyricist"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COMPOSERLYRICIST MusicalWorkContributorRole = 9
+	// @xml: "Conceptor"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONCEPTOR MusicalWorkContributorRole = 10
+	// @xml: "Creator"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CREATOR MusicalWorkContributorRole = 11
+	// @xml: "DialogueAuthor"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DIALOGUEAUTHOR MusicalWorkContributorRole = 12
+	// @xml: "Dissertant"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_DISSERTANT MusicalWorkContributorRole = 13
+	// @xml: "Engraver"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ENGRAVER MusicalWorkContributorRole = 14
+	// @xml: "Etcher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ETCHER MusicalWorkContributorRole = 15
+	// @xml: "Journalist"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_JOURNALIST MusicalWorkContributorRole = 16
+	// @xml: "LandscapeArchitect"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LANDSCAPEARCHITECT MusicalWorkContributorRole = 17
+	// @xml: "Librettist"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LIBRETTIST MusicalWorkContributorRole = 18
+	// @xml: "Lithographer"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LITHOGRAPHER MusicalWorkContributorRole = 19
+	// @xml: "Lyricist"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_LYRICIST MusicalWorkContributorRole = 20
+	// @xml: "MetalEngraver"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_METALENGRAVER MusicalWorkContributorRole = 21
+	// @xml: "NonLyricAuthor"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NONLYRICAUTHOR MusicalWorkContributorRole = 22
+	// @xml: "PlateMaker"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLATEMAKER MusicalWorkContributorRole = 23
+	// @xml: "Playwright"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PLAYWRIGHT MusicalWorkContributorRole = 24
+	// @xml: "Reporter"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REPORTER MusicalWorkContributorRole = 25
+	// @xml: "Reviewer"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_REVIEWER MusicalWorkContributorRole = 26
+	// @xml: "Rubricator"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_RUBRICATOR MusicalWorkContributorRole = 27
+	// @xml: "ScreenplayAuthor"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCREENPLAYAUTHOR MusicalWorkContributorRole = 28
+	// @xml: "Sculptor"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SCULPTOR MusicalWorkContributorRole = 29
+	// @xml: "SubArranger"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBARRANGER MusicalWorkContributorRole = 30
+	// @xml: "SubLyricist"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBLYRICIST MusicalWorkContributorRole = 31
+	// @xml: "Translator"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_TRANSLATOR MusicalWorkContributorRole = 32
+	// @xml: "Woodcutter"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODCUTTER MusicalWorkContributorRole = 33
+	// @xml: "WoodEngraver"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WOODENGRAVER MusicalWorkContributorRole = 34
+	// @xml: "WriterOfAccompanyingMaterial"
 	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_WRITEROFACCOMPANYINGMATERIAL MusicalWorkContributorRole = 35
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_BOOKPUBLISHER                MusicalWorkContributorRole = 36
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTCLAIMANT            MusicalWorkContributorRole = 37
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTHOLDER              MusicalWorkContributorRole = 38
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_MUSICPUBLISHER               MusicalWorkContributorRole = 39
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NEWSPAPERPUBLISHER           MusicalWorkContributorRole = 40
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER            MusicalWorkContributorRole = 41
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PERIODICALPUBLISHER          MusicalWorkContributorRole = 42
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBPUBLISHER                 MusicalWorkContributorRole = 43
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBSTITUTEDPUBLISHER         MusicalWorkContributorRole = 44
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_UNKNOWN                      MusicalWorkContributorRole = 45
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_USERDEFINED                  MusicalWorkContributorRole = 46
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER          MusicalWorkContributorRole = 47
-	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONTRIBUTOR                  MusicalWorkContributorRole = 48
+	// @xml: "BookPublisher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_BOOKPUBLISHER MusicalWorkContributorRole = 36
+	// @xml: "CopyrightClaimant"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTCLAIMANT MusicalWorkContributorRole = 37
+	// @xml: "CopyrightHolder"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_COPYRIGHTHOLDER MusicalWorkContributorRole = 38
+	// @xml: "MusicPublisher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_MUSICPUBLISHER MusicalWorkContributorRole = 39
+	// @xml: "NewspaperPublisher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_NEWSPAPERPUBLISHER MusicalWorkContributorRole = 40
+	// @xml: "OriginalPublisher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ORIGINALPUBLISHER MusicalWorkContributorRole = 41
+	// @xml: "PeriodicalPublisher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_PERIODICALPUBLISHER MusicalWorkContributorRole = 42
+	// @xml: "SubPublisher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBPUBLISHER MusicalWorkContributorRole = 43
+	// @xml: "SubstitutedPublisher"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_SUBSTITUTEDPUBLISHER MusicalWorkContributorRole = 44
+	// @xml: "Unknown"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_UNKNOWN MusicalWorkContributorRole = 45
+	// @xml: "UserDefined"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_USERDEFINED MusicalWorkContributorRole = 46
+	// @xml: "AssociatedPerformer"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER MusicalWorkContributorRole = 47
+	// @xml: "Contributor"
+	MusicalWorkContributorRole_MUSICAL_WORK_CONTRIBUTOR_ROLE_CONTRIBUTOR MusicalWorkContributorRole = 48
 )
 
 // Enum value maps for MusicalWorkContributorRole.
@@ -12005,12 +14984,17 @@ func (MusicalWorkContributorRole) EnumDescriptor() ([]byte, []int) {
 type MusicalWorkRightsClaimType int32
 
 const (
-	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_UNSPECIFIED      MusicalWorkRightsClaimType = 0
+	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_UNSPECIFIED MusicalWorkRightsClaimType = 0
+	// @xml: "CopyrightControl"
 	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_COPYRIGHTCONTROL MusicalWorkRightsClaimType = 1
-	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_NONMEMBERCLAIM   MusicalWorkRightsClaimType = 2
-	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_PUBLICDOMAIN     MusicalWorkRightsClaimType = 3
-	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_SOCIETYCLAIM     MusicalWorkRightsClaimType = 4
-	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_UNKNOWN          MusicalWorkRightsClaimType = 5
+	// @xml: "NonMemberClaim"
+	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_NONMEMBERCLAIM MusicalWorkRightsClaimType = 2
+	// @xml: "PublicDomain"
+	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_PUBLICDOMAIN MusicalWorkRightsClaimType = 3
+	// @xml: "SocietyClaim"
+	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_SOCIETYCLAIM MusicalWorkRightsClaimType = 4
+	// @xml: "Unknown"
+	MusicalWorkRightsClaimType_MUSICAL_WORK_RIGHTS_CLAIM_TYPE_UNKNOWN MusicalWorkRightsClaimType = 5
 )
 
 // Enum value maps for MusicalWorkRightsClaimType.
@@ -12063,36 +15047,65 @@ func (MusicalWorkRightsClaimType) EnumDescriptor() ([]byte, []int) {
 type MusicalWorkType int32
 
 const (
-	MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIED                   MusicalWorkType = 0
-	MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINORIGINALLANGUAGE     MusicalWorkType = 1
-	MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINSTRUMENTALWORK       MusicalWorkType = 2
-	MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDWITHNEWLYRICS          MusicalWorkType = 3
-	MusicalWorkType_MUSICAL_WORK_TYPE_ARRANGEDWITHNEWMUSIC          MusicalWorkType = 4
-	MusicalWorkType_MUSICAL_WORK_TYPE_COMPOSITEMUSICALWORK          MusicalWorkType = 5
-	MusicalWorkType_MUSICAL_WORK_TYPE_DRAMATICOMUSICALWORK          MusicalWorkType = 6
-	MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREMOVAL                  MusicalWorkType = 7
-	MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREPLACEMENT              MusicalWorkType = 8
-	MusicalWorkType_MUSICAL_WORK_TYPE_LYRICTRANSLATION              MusicalWorkType = 9
-	MusicalWorkType_MUSICAL_WORK_TYPE_MASHUP                        MusicalWorkType = 10
-	MusicalWorkType_MUSICAL_WORK_TYPE_MEDLEY                        MusicalWorkType = 11
-	MusicalWorkType_MUSICAL_WORK_TYPE_MULTIMEDIAPRODUCTIONWORK      MusicalWorkType = 12
-	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKMOVEMENT           MusicalWorkType = 13
-	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKWITHSAMPLES        MusicalWorkType = 14
-	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENT              MusicalWorkType = 15
-	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENTOFTEXT        MusicalWorkType = 16
-	MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALLYRICSARRANGEMENT     MusicalWorkType = 17
-	MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICADAPTATION       MusicalWorkType = 18
-	MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICALWORK           MusicalWorkType = 19
-	MusicalWorkType_MUSICAL_WORK_TYPE_POTPOURRI                     MusicalWorkType = 20
-	MusicalWorkType_MUSICAL_WORK_TYPE_PRODUCTIONMUSICLIBRARYWORK    MusicalWorkType = 21
-	MusicalWorkType_MUSICAL_WORK_TYPE_RADIOPRODUCTIONWORK           MusicalWorkType = 22
-	MusicalWorkType_MUSICAL_WORK_TYPE_THEATERPRODUCTIONWORK         MusicalWorkType = 23
-	MusicalWorkType_MUSICAL_WORK_TYPE_TVPRODUCTIONWORK              MusicalWorkType = 24
-	MusicalWorkType_MUSICAL_WORK_TYPE_UNKNOWN                       MusicalWorkType = 25
-	MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIEDARRANGEMENT        MusicalWorkType = 26
+	MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIED MusicalWorkType = 0
+	// @xml: "AdaptedInOriginalLanguage"
+	MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINORIGINALLANGUAGE MusicalWorkType = 1
+	// @xml: "AdaptedInstrumentalWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDINSTRUMENTALWORK MusicalWorkType = 2
+	// @xml: "AdaptedWithNewLyrics"
+	MusicalWorkType_MUSICAL_WORK_TYPE_ADAPTEDWITHNEWLYRICS MusicalWorkType = 3
+	// @xml: "ArrangedWithNewMusic"
+	MusicalWorkType_MUSICAL_WORK_TYPE_ARRANGEDWITHNEWMUSIC MusicalWorkType = 4
+	// @xml: "CompositeMusicalWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_COMPOSITEMUSICALWORK MusicalWorkType = 5
+	// @xml: "DramaticoMusicalWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_DRAMATICOMUSICALWORK MusicalWorkType = 6
+	// @xml: "LyricRemoval"
+	MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREMOVAL MusicalWorkType = 7
+	// @xml: "LyricReplacement"
+	MusicalWorkType_MUSICAL_WORK_TYPE_LYRICREPLACEMENT MusicalWorkType = 8
+	// @xml: "LyricTranslation"
+	MusicalWorkType_MUSICAL_WORK_TYPE_LYRICTRANSLATION MusicalWorkType = 9
+	// @xml: "Mashup"
+	MusicalWorkType_MUSICAL_WORK_TYPE_MASHUP MusicalWorkType = 10
+	// @xml: "Medley"
+	MusicalWorkType_MUSICAL_WORK_TYPE_MEDLEY MusicalWorkType = 11
+	// @xml: "MultimediaProductionWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_MULTIMEDIAPRODUCTIONWORK MusicalWorkType = 12
+	// @xml: "MusicalWorkMovement"
+	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKMOVEMENT MusicalWorkType = 13
+	// @xml: "MusicalWorkWithSamples"
+	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICALWORKWITHSAMPLES MusicalWorkType = 14
+	// @xml: "MusicArrangement"
+	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENT MusicalWorkType = 15
+	// @xml: "MusicArrangementOfText"
+	MusicalWorkType_MUSICAL_WORK_TYPE_MUSICARRANGEMENTOFTEXT MusicalWorkType = 16
+	// @xml: "OriginalLyricsArrangement"
+	MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALLYRICSARRANGEMENT MusicalWorkType = 17
+	// @xml: "OriginalMusicAdaptation"
+	MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICADAPTATION MusicalWorkType = 18
+	// @xml: "OriginalMusicalWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_ORIGINALMUSICALWORK MusicalWorkType = 19
+	// @xml: "Potpourri"
+	MusicalWorkType_MUSICAL_WORK_TYPE_POTPOURRI MusicalWorkType = 20
+	// @xml: "ProductionMusicLibraryWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_PRODUCTIONMUSICLIBRARYWORK MusicalWorkType = 21
+	// @xml: "RadioProductionWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_RADIOPRODUCTIONWORK MusicalWorkType = 22
+	// @xml: "TheaterProductionWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_THEATERPRODUCTIONWORK MusicalWorkType = 23
+	// @xml: "TvProductionWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_TVPRODUCTIONWORK MusicalWorkType = 24
+	// @xml: "Unknown"
+	MusicalWorkType_MUSICAL_WORK_TYPE_UNKNOWN MusicalWorkType = 25
+	// @xml: "UnspecifiedArrangement"
+	MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIEDARRANGEMENT MusicalWorkType = 26
+	// @xml: "UnspecifiedMusicalWorkExcerpt"
 	MusicalWorkType_MUSICAL_WORK_TYPE_UNSPECIFIEDMUSICALWORKEXCERPT MusicalWorkType = 27
-	MusicalWorkType_MUSICAL_WORK_TYPE_USERDEFINED                   MusicalWorkType = 28
-	MusicalWorkType_MUSICAL_WORK_TYPE_VIDEOPRODUCTIONWORK           MusicalWorkType = 29
+	// @xml: "UserDefined"
+	MusicalWorkType_MUSICAL_WORK_TYPE_USERDEFINED MusicalWorkType = 28
+	// @xml: "VideoProductionWork"
+	MusicalWorkType_MUSICAL_WORK_TYPE_VIDEOPRODUCTIONWORK MusicalWorkType = 29
 )
 
 // Enum value maps for MusicalWorkType.
@@ -12193,14 +15206,21 @@ func (MusicalWorkType) EnumDescriptor() ([]byte, []int) {
 type MwlCaCMessageInBatchType int32
 
 const (
-	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_UNSPECIFIED                        MwlCaCMessageInBatchType = 0
-	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMREQUESTMESSAGE       MwlCaCMessageInBatchType = 1
-	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMMESSAGE              MwlCaCMessageInBatchType = 2
+	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_UNSPECIFIED MwlCaCMessageInBatchType = 0
+	// @xml: "LicenseOrClaimRequestMessage"
+	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMREQUESTMESSAGE MwlCaCMessageInBatchType = 1
+	// @xml: "LicenseOrClaimMessage"
+	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMMESSAGE MwlCaCMessageInBatchType = 2
+	// @xml: "LicensingInformationRequestMessage"
 	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSINGINFORMATIONREQUESTMESSAGE MwlCaCMessageInBatchType = 3
-	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMCONFIRMATIONMESSAGE  MwlCaCMessageInBatchType = 4
-	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_NEWRELEASEMESSAGE                  MwlCaCMessageInBatchType = 5
-	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_CONTRACTDELIVERYMESSAGE            MwlCaCMessageInBatchType = 6
-	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_PRODUCTDELETIONMESSAGE             MwlCaCMessageInBatchType = 7
+	// @xml: "LicenseOrClaimConfirmationMessage"
+	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_LICENSEORCLAIMCONFIRMATIONMESSAGE MwlCaCMessageInBatchType = 4
+	// @xml: "NewReleaseMessage"
+	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_NEWRELEASEMESSAGE MwlCaCMessageInBatchType = 5
+	// @xml: "ContractDeliveryMessage"
+	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_CONTRACTDELIVERYMESSAGE MwlCaCMessageInBatchType = 6
+	// @xml: "ProductDeletionMessage"
+	MwlCaCMessageInBatchType_MWL_CA_C_MESSAGE_IN_BATCH_TYPE_PRODUCTDELETIONMESSAGE MwlCaCMessageInBatchType = 7
 )
 
 // Enum value maps for MwlCaCMessageInBatchType.
@@ -12257,12 +15277,17 @@ func (MwlCaCMessageInBatchType) EnumDescriptor() ([]byte, []int) {
 type MwnMessageType int32
 
 const (
-	MwnMessageType_MWN_MESSAGE_TYPE_UNSPECIFIED                                 MwnMessageType = 0
-	MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGE         MwnMessageType = 1
+	MwnMessageType_MWN_MESSAGE_TYPE_UNSPECIFIED MwnMessageType = 0
+	// @xml: "MusicalWorkClaimNotificationMessage"
+	MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMNOTIFICATIONMESSAGE MwnMessageType = 1
+	// @xml: "MusicalWorkClaimConflictNotificationMessage"
 	MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMCONFLICTNOTIFICATIONMESSAGE MwnMessageType = 2
-	MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMREQUESTMESSAGE              MwnMessageType = 3
-	MwnMessageType_MWN_MESSAGE_TYPE_FTPACKNOWLEDGEMENTMESSAGE                   MwnMessageType = 4
-	MwnMessageType_MWN_MESSAGE_TYPE_MANIFESTMESSAGE                             MwnMessageType = 5
+	// @xml: "MusicalWorkClaimRequestMessage"
+	MwnMessageType_MWN_MESSAGE_TYPE_MUSICALWORKCLAIMREQUESTMESSAGE MwnMessageType = 3
+	// @xml: "FtpAcknowledgementMessage"
+	MwnMessageType_MWN_MESSAGE_TYPE_FTPACKNOWLEDGEMENTMESSAGE MwnMessageType = 4
+	// @xml: "ManifestMessage"
+	MwnMessageType_MWN_MESSAGE_TYPE_MANIFESTMESSAGE MwnMessageType = 5
 )
 
 // Enum value maps for MwnMessageType.
@@ -12315,9 +15340,11 @@ func (MwnMessageType) EnumDescriptor() ([]byte, []int) {
 type NewReleaseMessageStatus int32
 
 const (
-	NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_UNSPECIFIED                  NewReleaseMessageStatus = 0
+	NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_UNSPECIFIED NewReleaseMessageStatus = 0
+	// @xml: "NewReleaseMessageNotProvided"
 	NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_NEWRELEASEMESSAGENOTPROVIDED NewReleaseMessageStatus = 1
-	NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_NEWRELEASEMESSAGEPROVIDED    NewReleaseMessageStatus = 2
+	// @xml: "NewReleaseMessageProvided"
+	NewReleaseMessageStatus_NEW_RELEASE_MESSAGE_STATUS_NEWRELEASEMESSAGEPROVIDED NewReleaseMessageStatus = 2
 )
 
 // Enum value maps for NewReleaseMessageStatus.
@@ -12365,10 +15392,14 @@ type OperatingSystemType int32
 
 const (
 	OperatingSystemType_OPERATING_SYSTEM_TYPE_UNSPECIFIED OperatingSystemType = 0
-	OperatingSystemType_OPERATING_SYSTEM_TYPE_MACOS       OperatingSystemType = 1
-	OperatingSystemType_OPERATING_SYSTEM_TYPE_MSWINDOWS   OperatingSystemType = 2
-	OperatingSystemType_OPERATING_SYSTEM_TYPE_SYMBIAN     OperatingSystemType = 3
-	OperatingSystemType_OPERATING_SYSTEM_TYPE_UNKNOWN     OperatingSystemType = 4
+	// @xml: "MacOS"
+	OperatingSystemType_OPERATING_SYSTEM_TYPE_MACOS OperatingSystemType = 1
+	// @xml: "MsWindows"
+	OperatingSystemType_OPERATING_SYSTEM_TYPE_MSWINDOWS OperatingSystemType = 2
+	// @xml: "Symbian"
+	OperatingSystemType_OPERATING_SYSTEM_TYPE_SYMBIAN OperatingSystemType = 3
+	// @xml: "Unknown"
+	OperatingSystemType_OPERATING_SYSTEM_TYPE_UNKNOWN OperatingSystemType = 4
 )
 
 // Enum value maps for OperatingSystemType.
@@ -12419,17 +15450,27 @@ func (OperatingSystemType) EnumDescriptor() ([]byte, []int) {
 type OrderType int32
 
 const (
-	OrderType_ORDER_TYPE_UNSPECIFIED       OrderType = 0
-	OrderType_ORDER_TYPE_BACKCATALOGORDER  OrderType = 1
-	OrderType_ORDER_TYPE_EXPRESSORDER      OrderType = 2
-	OrderType_ORDER_TYPE_HARDDISKORDER     OrderType = 3
+	OrderType_ORDER_TYPE_UNSPECIFIED OrderType = 0
+	// @xml: "BackCatalogOrder"
+	OrderType_ORDER_TYPE_BACKCATALOGORDER OrderType = 1
+	// @xml: "ExpressOrder"
+	OrderType_ORDER_TYPE_EXPRESSORDER OrderType = 2
+	// @xml: "HardDiskOrder"
+	OrderType_ORDER_TYPE_HARDDISKORDER OrderType = 3
+	// @xml: "MetadataOnlyOrder"
 	OrderType_ORDER_TYPE_METADATAONLYORDER OrderType = 4
-	OrderType_ORDER_TYPE_NEWRELEASEORDER   OrderType = 5
+	// @xml: "NewReleaseOrder"
+	OrderType_ORDER_TYPE_NEWRELEASEORDER OrderType = 5
+	// @xml: "OffCycleRushOrder"
 	OrderType_ORDER_TYPE_OFFCYCLERUSHORDER OrderType = 6
-	OrderType_ORDER_TYPE_PREORDER          OrderType = 7
-	OrderType_ORDER_TYPE_REDELIVERYORDER   OrderType = 8
-	OrderType_ORDER_TYPE_TAKEDOWNORDER     OrderType = 9
-	OrderType_ORDER_TYPE_USERDEFINED       OrderType = 10
+	// @xml: "PreOrder"
+	OrderType_ORDER_TYPE_PREORDER OrderType = 7
+	// @xml: "ReDeliveryOrder"
+	OrderType_ORDER_TYPE_REDELIVERYORDER OrderType = 8
+	// @xml: "TakeDownOrder"
+	OrderType_ORDER_TYPE_TAKEDOWNORDER OrderType = 9
+	// @xml: "UserDefined"
+	OrderType_ORDER_TYPE_USERDEFINED OrderType = 10
 )
 
 // Enum value maps for OrderType.
@@ -12492,8 +15533,10 @@ func (OrderType) EnumDescriptor() ([]byte, []int) {
 type PLineType int32
 
 const (
-	PLineType_P_LINE_TYPE_UNSPECIFIED      PLineType = 0
-	PLineType_P_LINE_TYPE_ORIGINALPLINE    PLineType = 1
+	PLineType_P_LINE_TYPE_UNSPECIFIED PLineType = 0
+	// @xml: "OriginalPLine"
+	PLineType_P_LINE_TYPE_ORIGINALPLINE PLineType = 1
+	// @xml: "RemasteringPLine"
 	PLineType_P_LINE_TYPE_REMASTERINGPLINE PLineType = 2
 )
 
@@ -12541,13 +15584,19 @@ func (PLineType) EnumDescriptor() ([]byte, []int) {
 type ParentalWarningType int32
 
 const (
-	ParentalWarningType_PARENTAL_WARNING_TYPE_UNSPECIFIED           ParentalWarningType = 0
-	ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICIT              ParentalWarningType = 1
+	ParentalWarningType_PARENTAL_WARNING_TYPE_UNSPECIFIED ParentalWarningType = 0
+	// @xml: "Explicit"
+	ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICIT ParentalWarningType = 1
+	// @xml: "ExplicitContentEdited"
 	ParentalWarningType_PARENTAL_WARNING_TYPE_EXPLICITCONTENTEDITED ParentalWarningType = 2
-	ParentalWarningType_PARENTAL_WARNING_TYPE_NOADVICEAVAILABLE     ParentalWarningType = 3
-	ParentalWarningType_PARENTAL_WARNING_TYPE_NOTEXPLICIT           ParentalWarningType = 4
-	ParentalWarningType_PARENTAL_WARNING_TYPE_UNKNOWN               ParentalWarningType = 5
-	ParentalWarningType_PARENTAL_WARNING_TYPE_USERDEFINED           ParentalWarningType = 6
+	// @xml: "NoAdviceAvailable"
+	ParentalWarningType_PARENTAL_WARNING_TYPE_NOADVICEAVAILABLE ParentalWarningType = 3
+	// @xml: "NotExplicit"
+	ParentalWarningType_PARENTAL_WARNING_TYPE_NOTEXPLICIT ParentalWarningType = 4
+	// @xml: "Unknown"
+	ParentalWarningType_PARENTAL_WARNING_TYPE_UNKNOWN ParentalWarningType = 5
+	// @xml: "UserDefined"
+	ParentalWarningType_PARENTAL_WARNING_TYPE_USERDEFINED ParentalWarningType = 6
 )
 
 // Enum value maps for ParentalWarningType.
@@ -12602,12 +15651,18 @@ func (ParentalWarningType) EnumDescriptor() ([]byte, []int) {
 type PercentageType int32
 
 const (
-	PercentageType_PERCENTAGE_TYPE_UNSPECIFIED                      PercentageType = 0
-	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFFREEGOODSPERMITTED   PercentageType = 1
-	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFGROSSREVENUE         PercentageType = 2
-	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETREVENUE           PercentageType = 3
-	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETSALES             PercentageType = 4
-	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFPRICECONSUMERPAID    PercentageType = 5
+	PercentageType_PERCENTAGE_TYPE_UNSPECIFIED PercentageType = 0
+	// @xml: "PercentageOfFreeGoodsPermitted"
+	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFFREEGOODSPERMITTED PercentageType = 1
+	// @xml: "PercentageOfGrossRevenue"
+	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFGROSSREVENUE PercentageType = 2
+	// @xml: "PercentageOfNetRevenue"
+	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETREVENUE PercentageType = 3
+	// @xml: "PercentageOfNetSales"
+	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFNETSALES PercentageType = 4
+	// @xml: "PercentageOfPriceConsumerPaid"
+	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFPRICECONSUMERPAID PercentageType = 5
+	// @xml: "PercentageOfStatutoryRoyaltyRate"
 	PercentageType_PERCENTAGE_TYPE_PERCENTAGEOFSTATUTORYROYALTYRATE PercentageType = 6
 )
 
@@ -12663,10 +15718,13 @@ func (PercentageType) EnumDescriptor() ([]byte, []int) {
 type PriceInformationType int32
 
 const (
-	PriceInformationType_PRICE_INFORMATION_TYPE_UNSPECIFIED         PriceInformationType = 0
+	PriceInformationType_PRICE_INFORMATION_TYPE_UNSPECIFIED PriceInformationType = 0
+	// @xml: "StandardRetailPrice"
 	PriceInformationType_PRICE_INFORMATION_TYPE_STANDARDRETAILPRICE PriceInformationType = 1
-	PriceInformationType_PRICE_INFORMATION_TYPE_PREORDERPRICE       PriceInformationType = 2
-	PriceInformationType_PRICE_INFORMATION_TYPE_USERDEFINED         PriceInformationType = 3
+	// @xml: "PreOrderPrice"
+	PriceInformationType_PRICE_INFORMATION_TYPE_PREORDERPRICE PriceInformationType = 2
+	// @xml: "UserDefined"
+	PriceInformationType_PRICE_INFORMATION_TYPE_USERDEFINED PriceInformationType = 3
 )
 
 // Enum value maps for PriceInformationType.
@@ -12716,9 +15774,12 @@ type Priority int32
 
 const (
 	Priority_PRIORITY_UNSPECIFIED Priority = 0
-	Priority_PRIORITY_HIGH        Priority = 1
-	Priority_PRIORITY_LOW         Priority = 2
-	Priority_PRIORITY_NORMAL      Priority = 3
+	// @xml: "High"
+	Priority_PRIORITY_HIGH Priority = 1
+	// @xml: "Low"
+	Priority_PRIORITY_LOW Priority = 2
+	// @xml: "Normal"
+	Priority_PRIORITY_NORMAL Priority = 3
 )
 
 // Enum value maps for Priority.
@@ -12767,13 +15828,19 @@ func (Priority) EnumDescriptor() ([]byte, []int) {
 type ProductType int32
 
 const (
-	ProductType_PRODUCT_TYPE_UNSPECIFIED             ProductType = 0
-	ProductType_PRODUCT_TYPE_AUDIOPRODUCT            ProductType = 1
-	ProductType_PRODUCT_TYPE_GRAPHICSPRODUCT         ProductType = 2
+	ProductType_PRODUCT_TYPE_UNSPECIFIED ProductType = 0
+	// @xml: "AudioProduct"
+	ProductType_PRODUCT_TYPE_AUDIOPRODUCT ProductType = 1
+	// @xml: "GraphicsProduct"
+	ProductType_PRODUCT_TYPE_GRAPHICSPRODUCT ProductType = 2
+	// @xml: "MixedMediaBundleProduct"
 	ProductType_PRODUCT_TYPE_MIXEDMEDIABUNDLEPRODUCT ProductType = 3
-	ProductType_PRODUCT_TYPE_MOBILEPRODUCT           ProductType = 4
-	ProductType_PRODUCT_TYPE_USERDEFINED             ProductType = 5
-	ProductType_PRODUCT_TYPE_VIDEOPRODUCT            ProductType = 6
+	// @xml: "MobileProduct"
+	ProductType_PRODUCT_TYPE_MOBILEPRODUCT ProductType = 4
+	// @xml: "UserDefined"
+	ProductType_PRODUCT_TYPE_USERDEFINED ProductType = 5
+	// @xml: "VideoProduct"
+	ProductType_PRODUCT_TYPE_VIDEOPRODUCT ProductType = 6
 )
 
 // Enum value maps for ProductType.
@@ -12828,14 +15895,21 @@ func (ProductType) EnumDescriptor() ([]byte, []int) {
 type Purpose int32
 
 const (
-	Purpose_PURPOSE_UNSPECIFIED         Purpose = 0
-	Purpose_PURPOSE_BACKGROUNDMUSIC     Purpose = 1
+	Purpose_PURPOSE_UNSPECIFIED Purpose = 0
+	// @xml: "BackgroundMusic"
+	Purpose_PURPOSE_BACKGROUNDMUSIC Purpose = 1
+	// @xml: "ChannelTrailerMusic"
 	Purpose_PURPOSE_CHANNELTRAILERMUSIC Purpose = 2
-	Purpose_PURPOSE_EXTRACT             Purpose = 3
-	Purpose_PURPOSE_FILMTRAILERMUSIC    Purpose = 4
-	Purpose_PURPOSE_FOREGROUNDMUSIC     Purpose = 5
-	Purpose_PURPOSE_TRAILERMUSIC        Purpose = 6
-	Purpose_PURPOSE_USERDEFINED         Purpose = 7
+	// @xml: "Extract"
+	Purpose_PURPOSE_EXTRACT Purpose = 3
+	// @xml: "FilmTrailerMusic"
+	Purpose_PURPOSE_FILMTRAILERMUSIC Purpose = 4
+	// @xml: "ForegroundMusic"
+	Purpose_PURPOSE_FOREGROUNDMUSIC Purpose = 5
+	// @xml: "TrailerMusic"
+	Purpose_PURPOSE_TRAILERMUSIC Purpose = 6
+	// @xml: "UserDefined"
+	Purpose_PURPOSE_USERDEFINED Purpose = 7
 )
 
 // Enum value maps for Purpose.
@@ -12892,11 +15966,15 @@ func (Purpose) EnumDescriptor() ([]byte, []int) {
 type RateModificationType int32
 
 const (
-	RateModificationType_RATE_MODIFICATION_TYPE_UNSPECIFIED           RateModificationType = 0
+	RateModificationType_RATE_MODIFICATION_TYPE_UNSPECIFIED RateModificationType = 0
+	// @xml: "MultipleDiscProvision"
 	RateModificationType_RATE_MODIFICATION_TYPE_MULTIPLEDISCPROVISION RateModificationType = 1
-	RateModificationType_RATE_MODIFICATION_TYPE_OTHERPROVISION        RateModificationType = 2
-	RateModificationType_RATE_MODIFICATION_TYPE_SALESVOLUMEPROVISION  RateModificationType = 3
-	RateModificationType_RATE_MODIFICATION_TYPE_VIDEOPROVISION        RateModificationType = 4
+	// @xml: "OtherProvision"
+	RateModificationType_RATE_MODIFICATION_TYPE_OTHERPROVISION RateModificationType = 2
+	// @xml: "SalesVolumeProvision"
+	RateModificationType_RATE_MODIFICATION_TYPE_SALESVOLUMEPROVISION RateModificationType = 3
+	// @xml: "VideoProvision"
+	RateModificationType_RATE_MODIFICATION_TYPE_VIDEOPROVISION RateModificationType = 4
 )
 
 // Enum value maps for RateModificationType.
@@ -12947,95 +16025,183 @@ func (RateModificationType) EnumDescriptor() ([]byte, []int) {
 type RatingAgency int32
 
 const (
-	RatingAgency_RATING_AGENCY_UNSPECIFIED   RatingAgency = 0
-	RatingAgency_RATING_AGENCY_AFR           RatingAgency = 1
-	RatingAgency_RATING_AGENCY_BBFC          RatingAgency = 2
-	RatingAgency_RATING_AGENCY_BFCO          RatingAgency = 3
-	RatingAgency_RATING_AGENCY_BFSC          RatingAgency = 4
-	RatingAgency_RATING_AGENCY_BMUKK         RatingAgency = 5
-	RatingAgency_RATING_AGENCY_CBFC          RatingAgency = 6
-	RatingAgency_RATING_AGENCY_CCC           RatingAgency = 7
-	RatingAgency_RATING_AGENCY_CCE           RatingAgency = 8
-	RatingAgency_RATING_AGENCY_CHVRS         RatingAgency = 9
-	RatingAgency_RATING_AGENCY_CNC           RatingAgency = 10
-	RatingAgency_RATING_AGENCY_DJCTQ         RatingAgency = 11
-	RatingAgency_RATING_AGENCY_EIRIN         RatingAgency = 12
-	RatingAgency_RATING_AGENCY_FCB           RatingAgency = 13
-	RatingAgency_RATING_AGENCY_FILMTILSYNET  RatingAgency = 14
-	RatingAgency_RATING_AGENCY_FPB           RatingAgency = 15
-	RatingAgency_RATING_AGENCY_FSK           RatingAgency = 16
-	RatingAgency_RATING_AGENCY_IFCO          RatingAgency = 17
-	RatingAgency_RATING_AGENCY_INCAA         RatingAgency = 18
-	RatingAgency_RATING_AGENCY_KMRB          RatingAgency = 19
-	RatingAgency_RATING_AGENCY_KR            RatingAgency = 20
-	RatingAgency_RATING_AGENCY_KRRIT         RatingAgency = 21
-	RatingAgency_RATING_AGENCY_LSF           RatingAgency = 22
-	RatingAgency_RATING_AGENCY_MBU           RatingAgency = 23
-	RatingAgency_RATING_AGENCY_MDA           RatingAgency = 24
-	RatingAgency_RATING_AGENCY_MDCB          RatingAgency = 25
-	RatingAgency_RATING_AGENCY_MFCB          RatingAgency = 26
-	RatingAgency_RATING_AGENCY_MIC           RatingAgency = 27
-	RatingAgency_RATING_AGENCY_MPAA          RatingAgency = 28
-	RatingAgency_RATING_AGENCY_MTRCB         RatingAgency = 29
-	RatingAgency_RATING_AGENCY_NBC           RatingAgency = 30
-	RatingAgency_RATING_AGENCY_NFVCB         RatingAgency = 31
-	RatingAgency_RATING_AGENCY_NICAM         RatingAgency = 32
-	RatingAgency_RATING_AGENCY_NKC           RatingAgency = 33
-	RatingAgency_RATING_AGENCY_OFLC          RatingAgency = 34
-	RatingAgency_RATING_AGENCY_OFLC_NZ       RatingAgency = 35
-	RatingAgency_RATING_AGENCY_OFRB          RatingAgency = 36
-	RatingAgency_RATING_AGENCY_RDCQ          RatingAgency = 37
-	RatingAgency_RATING_AGENCY_RTC           RatingAgency = 38
-	RatingAgency_RATING_AGENCY_SBB           RatingAgency = 39
-	RatingAgency_RATING_AGENCY_SMAIS         RatingAgency = 40
-	RatingAgency_RATING_AGENCY_SPIO_JK       RatingAgency = 41
-	RatingAgency_RATING_AGENCY_TELA          RatingAgency = 42
-	RatingAgency_RATING_AGENCY_USERDEFINED   RatingAgency = 43
-	RatingAgency_RATING_AGENCY_VET           RatingAgency = 44
-	RatingAgency_RATING_AGENCY_ACMA          RatingAgency = 45
-	RatingAgency_RATING_AGENCY_AGCOM         RatingAgency = 46
-	RatingAgency_RATING_AGENCY_ANATEL        RatingAgency = 47
-	RatingAgency_RATING_AGENCY_BFVC          RatingAgency = 48
-	RatingAgency_RATING_AGENCY_CBSC          RatingAgency = 49
-	RatingAgency_RATING_AGENCY_CBSC_F        RatingAgency = 50
-	RatingAgency_RATING_AGENCY_CICF          RatingAgency = 51
-	RatingAgency_RATING_AGENCY_CNA           RatingAgency = 52
-	RatingAgency_RATING_AGENCY_CPBC          RatingAgency = 53
-	RatingAgency_RATING_AGENCY_CSA           RatingAgency = 54
-	RatingAgency_RATING_AGENCY_CSCF          RatingAgency = 55
-	RatingAgency_RATING_AGENCY_ESRB          RatingAgency = 56
-	RatingAgency_RATING_AGENCY_FAB           RatingAgency = 57
-	RatingAgency_RATING_AGENCY_FCO           RatingAgency = 58
-	RatingAgency_RATING_AGENCY_FILM_CH       RatingAgency = 59
-	RatingAgency_RATING_AGENCY_FILM_CZ       RatingAgency = 60
-	RatingAgency_RATING_AGENCY_FILM_EG       RatingAgency = 61
-	RatingAgency_RATING_AGENCY_FILM_EE       RatingAgency = 62
-	RatingAgency_RATING_AGENCY_FILM_GR       RatingAgency = 63
-	RatingAgency_RATING_AGENCY_FILM_PE       RatingAgency = 64
-	RatingAgency_RATING_AGENCY_FILM_SK       RatingAgency = 65
-	RatingAgency_RATING_AGENCY_FRB           RatingAgency = 66
-	RatingAgency_RATING_AGENCY_ICAA          RatingAgency = 67
-	RatingAgency_RATING_AGENCY_IFCOF         RatingAgency = 68
-	RatingAgency_RATING_AGENCY_KFCB          RatingAgency = 69
-	RatingAgency_RATING_AGENCY_KIJKWIJZER    RatingAgency = 70
-	RatingAgency_RATING_AGENCY_MBACT         RatingAgency = 71
-	RatingAgency_RATING_AGENCY_MCCAA         RatingAgency = 72
+	RatingAgency_RATING_AGENCY_UNSPECIFIED RatingAgency = 0
+	// @xml: "AFR"
+	RatingAgency_RATING_AGENCY_AFR RatingAgency = 1
+	// @xml: "BBFC"
+	RatingAgency_RATING_AGENCY_BBFC RatingAgency = 2
+	// @xml: "BFCO"
+	RatingAgency_RATING_AGENCY_BFCO RatingAgency = 3
+	// @xml: "BFSC"
+	RatingAgency_RATING_AGENCY_BFSC RatingAgency = 4
+	// @xml: "BMUKK"
+	RatingAgency_RATING_AGENCY_BMUKK RatingAgency = 5
+	// @xml: "CBFC"
+	RatingAgency_RATING_AGENCY_CBFC RatingAgency = 6
+	// @xml: "CCC"
+	RatingAgency_RATING_AGENCY_CCC RatingAgency = 7
+	// @xml: "CCE"
+	RatingAgency_RATING_AGENCY_CCE RatingAgency = 8
+	// @xml: "CHVRS"
+	RatingAgency_RATING_AGENCY_CHVRS RatingAgency = 9
+	// @xml: "CNC"
+	RatingAgency_RATING_AGENCY_CNC RatingAgency = 10
+	// @xml: "DJCTQ"
+	RatingAgency_RATING_AGENCY_DJCTQ RatingAgency = 11
+	// @xml: "Eirin"
+	RatingAgency_RATING_AGENCY_EIRIN RatingAgency = 12
+	// @xml: "FCB"
+	RatingAgency_RATING_AGENCY_FCB RatingAgency = 13
+	// @xml: "Filmtilsynet"
+	RatingAgency_RATING_AGENCY_FILMTILSYNET RatingAgency = 14
+	// @xml: "FPB"
+	RatingAgency_RATING_AGENCY_FPB RatingAgency = 15
+	// @xml: "FSK"
+	RatingAgency_RATING_AGENCY_FSK RatingAgency = 16
+	// @xml: "IFCO"
+	RatingAgency_RATING_AGENCY_IFCO RatingAgency = 17
+	// @xml: "INCAA"
+	RatingAgency_RATING_AGENCY_INCAA RatingAgency = 18
+	// @xml: "KMRB"
+	RatingAgency_RATING_AGENCY_KMRB RatingAgency = 19
+	// @xml: "KR"
+	RatingAgency_RATING_AGENCY_KR RatingAgency = 20
+	// @xml: "KRRIT"
+	RatingAgency_RATING_AGENCY_KRRIT RatingAgency = 21
+	// @xml: "LSF"
+	RatingAgency_RATING_AGENCY_LSF RatingAgency = 22
+	// @xml: "MBU"
+	RatingAgency_RATING_AGENCY_MBU RatingAgency = 23
+	// @xml: "MDA"
+	RatingAgency_RATING_AGENCY_MDA RatingAgency = 24
+	// @xml: "MDCB"
+	RatingAgency_RATING_AGENCY_MDCB RatingAgency = 25
+	// @xml: "MFCB"
+	RatingAgency_RATING_AGENCY_MFCB RatingAgency = 26
+	// @xml: "MIC"
+	RatingAgency_RATING_AGENCY_MIC RatingAgency = 27
+	// @xml: "MPAA"
+	RatingAgency_RATING_AGENCY_MPAA RatingAgency = 28
+	// @xml: "MTRCB"
+	RatingAgency_RATING_AGENCY_MTRCB RatingAgency = 29
+	// @xml: "NBC"
+	RatingAgency_RATING_AGENCY_NBC RatingAgency = 30
+	// @xml: "NFVCB"
+	RatingAgency_RATING_AGENCY_NFVCB RatingAgency = 31
+	// @xml: "NICAM"
+	RatingAgency_RATING_AGENCY_NICAM RatingAgency = 32
+	// @xml: "NKC"
+	RatingAgency_RATING_AGENCY_NKC RatingAgency = 33
+	// @xml: "OFLC"
+	RatingAgency_RATING_AGENCY_OFLC RatingAgency = 34
+	// @xml: "OFLC-NZ"
+	RatingAgency_RATING_AGENCY_OFLC_NZ RatingAgency = 35
+	// @xml: "OFRB"
+	RatingAgency_RATING_AGENCY_OFRB RatingAgency = 36
+	// @xml: "RDCQ"
+	RatingAgency_RATING_AGENCY_RDCQ RatingAgency = 37
+	// @xml: "RTC"
+	RatingAgency_RATING_AGENCY_RTC RatingAgency = 38
+	// @xml: "SBB"
+	RatingAgency_RATING_AGENCY_SBB RatingAgency = 39
+	// @xml: "Smais"
+	RatingAgency_RATING_AGENCY_SMAIS RatingAgency = 40
+	// @xml: "SPIO-JK"
+	RatingAgency_RATING_AGENCY_SPIO_JK RatingAgency = 41
+	// @xml: "TELA"
+	RatingAgency_RATING_AGENCY_TELA RatingAgency = 42
+	// @xml: "UserDefined"
+	RatingAgency_RATING_AGENCY_USERDEFINED RatingAgency = 43
+	// @xml: "VET"
+	RatingAgency_RATING_AGENCY_VET RatingAgency = 44
+	// @xml: "ACMA"
+	RatingAgency_RATING_AGENCY_ACMA RatingAgency = 45
+	// @xml: "AGCOM"
+	RatingAgency_RATING_AGENCY_AGCOM RatingAgency = 46
+	// @xml: "ANATEL"
+	RatingAgency_RATING_AGENCY_ANATEL RatingAgency = 47
+	// @xml: "BFVC"
+	RatingAgency_RATING_AGENCY_BFVC RatingAgency = 48
+	// @xml: "CBSC"
+	RatingAgency_RATING_AGENCY_CBSC RatingAgency = 49
+	// @xml: "CBSC-F"
+	RatingAgency_RATING_AGENCY_CBSC_F RatingAgency = 50
+	// @xml: "CICF"
+	RatingAgency_RATING_AGENCY_CICF RatingAgency = 51
+	// @xml: "CNA"
+	RatingAgency_RATING_AGENCY_CNA RatingAgency = 52
+	// @xml: "CPBC"
+	RatingAgency_RATING_AGENCY_CPBC RatingAgency = 53
+	// @xml: "CSA"
+	RatingAgency_RATING_AGENCY_CSA RatingAgency = 54
+	// @xml: "CSCF"
+	RatingAgency_RATING_AGENCY_CSCF RatingAgency = 55
+	// @xml: "ESRB"
+	RatingAgency_RATING_AGENCY_ESRB RatingAgency = 56
+	// @xml: "FAB"
+	RatingAgency_RATING_AGENCY_FAB RatingAgency = 57
+	// @xml: "FCO"
+	RatingAgency_RATING_AGENCY_FCO RatingAgency = 58
+	// @xml: "FILM-CH"
+	RatingAgency_RATING_AGENCY_FILM_CH RatingAgency = 59
+	// @xml: "FILM-CZ"
+	RatingAgency_RATING_AGENCY_FILM_CZ RatingAgency = 60
+	// @xml: "FILM-EG"
+	RatingAgency_RATING_AGENCY_FILM_EG RatingAgency = 61
+	// @xml: "FILM-EE"
+	RatingAgency_RATING_AGENCY_FILM_EE RatingAgency = 62
+	// @xml: "FILM-GR"
+	RatingAgency_RATING_AGENCY_FILM_GR RatingAgency = 63
+	// @xml: "FILM-PE"
+	RatingAgency_RATING_AGENCY_FILM_PE RatingAgency = 64
+	// @xml: "FILM-SK"
+	RatingAgency_RATING_AGENCY_FILM_SK RatingAgency = 65
+	// @xml: "FRB"
+	RatingAgency_RATING_AGENCY_FRB RatingAgency = 66
+	// @xml: "ICAA"
+	RatingAgency_RATING_AGENCY_ICAA RatingAgency = 67
+	// @xml: "IFCOF"
+	RatingAgency_RATING_AGENCY_IFCOF RatingAgency = 68
+	// @xml: "KFCB"
+	RatingAgency_RATING_AGENCY_KFCB RatingAgency = 69
+	// @xml: "Kijkwijzer"
+	RatingAgency_RATING_AGENCY_KIJKWIJZER RatingAgency = 70
+	// @xml: "MBACT"
+	RatingAgency_RATING_AGENCY_MBACT RatingAgency = 71
+	// @xml: "MCCAA"
+	RatingAgency_RATING_AGENCY_MCCAA RatingAgency = 72
+	// @xml: "Medietilsynet"
 	RatingAgency_RATING_AGENCY_MEDIETILSYNET RatingAgency = 73
-	RatingAgency_RATING_AGENCY_MEKU          RatingAgency = 74
-	RatingAgency_RATING_AGENCY_MKRF          RatingAgency = 75
-	RatingAgency_RATING_AGENCY_MOC           RatingAgency = 76
-	RatingAgency_RATING_AGENCY_MOC_TW        RatingAgency = 77
-	RatingAgency_RATING_AGENCY_MPAAT         RatingAgency = 78
-	RatingAgency_RATING_AGENCY_NCS           RatingAgency = 79
-	RatingAgency_RATING_AGENCY_NFRC          RatingAgency = 80
-	RatingAgency_RATING_AGENCY_PEGI          RatingAgency = 81
-	RatingAgency_RATING_AGENCY_RCNOF         RatingAgency = 82
-	RatingAgency_RATING_AGENCY_RIAA          RatingAgency = 83
-	RatingAgency_RATING_AGENCY_RTE           RatingAgency = 84
-	RatingAgency_RATING_AGENCY_SIBCI         RatingAgency = 85
-	RatingAgency_RATING_AGENCY_SM_SA         RatingAgency = 86
-	RatingAgency_RATING_AGENCY_USFA          RatingAgency = 87
-	RatingAgency_RATING_AGENCY_TVPG          RatingAgency = 88
+	// @xml: "MEKU"
+	RatingAgency_RATING_AGENCY_MEKU RatingAgency = 74
+	// @xml: "MKRF"
+	RatingAgency_RATING_AGENCY_MKRF RatingAgency = 75
+	// @xml: "MOC"
+	RatingAgency_RATING_AGENCY_MOC RatingAgency = 76
+	// @xml: "MOC-TW"
+	RatingAgency_RATING_AGENCY_MOC_TW RatingAgency = 77
+	// @xml: "MPAAT"
+	RatingAgency_RATING_AGENCY_MPAAT RatingAgency = 78
+	// @xml: "NCS"
+	RatingAgency_RATING_AGENCY_NCS RatingAgency = 79
+	// @xml: "NFRC"
+	RatingAgency_RATING_AGENCY_NFRC RatingAgency = 80
+	// @xml: "PEGI"
+	RatingAgency_RATING_AGENCY_PEGI RatingAgency = 81
+	// @xml: "RCNOF"
+	RatingAgency_RATING_AGENCY_RCNOF RatingAgency = 82
+	// @xml: "RIAA"
+	RatingAgency_RATING_AGENCY_RIAA RatingAgency = 83
+	// @xml: "RTE"
+	RatingAgency_RATING_AGENCY_RTE RatingAgency = 84
+	// @xml: "SiBCI"
+	RatingAgency_RATING_AGENCY_SIBCI RatingAgency = 85
+	// @xml: "SM-SA"
+	RatingAgency_RATING_AGENCY_SM_SA RatingAgency = 86
+	// @xml: "USFA"
+	RatingAgency_RATING_AGENCY_USFA RatingAgency = 87
+	// @xml: "TVPG"
+	RatingAgency_RATING_AGENCY_TVPG RatingAgency = 88
 )
 
 // Enum value maps for RatingAgency.
@@ -13254,10 +16420,13 @@ func (RatingAgency) EnumDescriptor() ([]byte, []int) {
 type ReasonType int32
 
 const (
-	ReasonType_REASON_TYPE_UNSPECIFIED      ReasonType = 0
-	ReasonType_REASON_TYPE_CHARTREPORTING   ReasonType = 1
+	ReasonType_REASON_TYPE_UNSPECIFIED ReasonType = 0
+	// @xml: "ChartReporting"
+	ReasonType_REASON_TYPE_CHARTREPORTING ReasonType = 1
+	// @xml: "RoyaltyReporting"
 	ReasonType_REASON_TYPE_ROYALTYREPORTING ReasonType = 2
-	ReasonType_REASON_TYPE_USERDEFINED      ReasonType = 3
+	// @xml: "UserDefined"
+	ReasonType_REASON_TYPE_USERDEFINED ReasonType = 3
 )
 
 // Enum value maps for ReasonType.
@@ -13306,10 +16475,13 @@ func (ReasonType) EnumDescriptor() ([]byte, []int) {
 type RecipientRevenueType int32
 
 const (
-	RecipientRevenueType_RECIPIENT_REVENUE_TYPE_UNSPECIFIED                 RecipientRevenueType = 0
+	RecipientRevenueType_RECIPIENT_REVENUE_TYPE_UNSPECIFIED RecipientRevenueType = 0
+	// @xml: "PerformerAndProducerRevenue"
 	RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PERFORMERANDPRODUCERREVENUE RecipientRevenueType = 1
-	RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PERFORMERREVENUE            RecipientRevenueType = 2
-	RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PRODUCERREVENUE             RecipientRevenueType = 3
+	// @xml: "PerformerRevenue"
+	RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PERFORMERREVENUE RecipientRevenueType = 2
+	// @xml: "ProducerRevenue"
+	RecipientRevenueType_RECIPIENT_REVENUE_TYPE_PRODUCERREVENUE RecipientRevenueType = 3
 )
 
 // Enum value maps for RecipientRevenueType.
@@ -13358,17 +16530,27 @@ func (RecipientRevenueType) EnumDescriptor() ([]byte, []int) {
 type RecordingMode int32
 
 const (
-	RecordingMode_RECORDING_MODE_UNSPECIFIED       RecordingMode = 0
-	RecordingMode_RECORDING_MODE_MONO              RecordingMode = 1
+	RecordingMode_RECORDING_MODE_UNSPECIFIED RecordingMode = 0
+	// @xml: "Mono"
+	RecordingMode_RECORDING_MODE_MONO RecordingMode = 1
+	// @xml: "MultichannelAudio"
 	RecordingMode_RECORDING_MODE_MULTICHANNELAUDIO RecordingMode = 2
-	RecordingMode_RECORDING_MODE_STEREO            RecordingMode = 3
-	RecordingMode_RECORDING_MODE_UNKNOWN           RecordingMode = 4
-	RecordingMode_RECORDING_MODE_BINAURALAUDIO     RecordingMode = 5
-	RecordingMode_RECORDING_MODE_LCR               RecordingMode = 6
-	RecordingMode_RECORDING_MODE_MULTITRACK        RecordingMode = 7
-	RecordingMode_RECORDING_MODE_QUAD              RecordingMode = 8
-	RecordingMode_RECORDING_MODE_STEMS             RecordingMode = 9
-	RecordingMode_RECORDING_MODE_SURROUNDSOUND     RecordingMode = 10
+	// @xml: "Stereo"
+	RecordingMode_RECORDING_MODE_STEREO RecordingMode = 3
+	// @xml: "Unknown"
+	RecordingMode_RECORDING_MODE_UNKNOWN RecordingMode = 4
+	// @xml: "BinauralAudio"
+	RecordingMode_RECORDING_MODE_BINAURALAUDIO RecordingMode = 5
+	// @xml: "LCR"
+	RecordingMode_RECORDING_MODE_LCR RecordingMode = 6
+	// @xml: "MultiTrack"
+	RecordingMode_RECORDING_MODE_MULTITRACK RecordingMode = 7
+	// @xml: "Quad"
+	RecordingMode_RECORDING_MODE_QUAD RecordingMode = 8
+	// @xml: "Stems"
+	RecordingMode_RECORDING_MODE_STEMS RecordingMode = 9
+	// @xml: "SurroundSound"
+	RecordingMode_RECORDING_MODE_SURROUNDSOUND RecordingMode = 10
 )
 
 // Enum value maps for RecordingMode.
@@ -13431,12 +16613,17 @@ func (RecordingMode) EnumDescriptor() ([]byte, []int) {
 type RedeliveryReasonType int32
 
 const (
-	RedeliveryReasonType_REDELIVERY_REASON_TYPE_UNSPECIFIED                         RedeliveryReasonType = 0
-	RedeliveryReasonType_REDELIVERY_REASON_TYPE_BINARYCORRUPTED                     RedeliveryReasonType = 1
-	RedeliveryReasonType_REDELIVERY_REASON_TYPE_METADATAINADEQUATE                  RedeliveryReasonType = 2
-	RedeliveryReasonType_REDELIVERY_REASON_TYPE_PACKAGEINCOMPLETE                   RedeliveryReasonType = 3
+	RedeliveryReasonType_REDELIVERY_REASON_TYPE_UNSPECIFIED RedeliveryReasonType = 0
+	// @xml: "BinaryCorrupted"
+	RedeliveryReasonType_REDELIVERY_REASON_TYPE_BINARYCORRUPTED RedeliveryReasonType = 1
+	// @xml: "MetadataInadequate"
+	RedeliveryReasonType_REDELIVERY_REASON_TYPE_METADATAINADEQUATE RedeliveryReasonType = 2
+	// @xml: "PackageIncomplete"
+	RedeliveryReasonType_REDELIVERY_REASON_TYPE_PACKAGEINCOMPLETE RedeliveryReasonType = 3
+	// @xml: "ProcessingErrorAtReleaseDistributor"
 	RedeliveryReasonType_REDELIVERY_REASON_TYPE_PROCESSINGERRORATRELEASEDISTRIBUTOR RedeliveryReasonType = 4
-	RedeliveryReasonType_REDELIVERY_REASON_TYPE_USERDEFINED                         RedeliveryReasonType = 5
+	// @xml: "UserDefined"
+	RedeliveryReasonType_REDELIVERY_REASON_TYPE_USERDEFINED RedeliveryReasonType = 5
 )
 
 // Enum value maps for RedeliveryReasonType.
@@ -13490,8 +16677,10 @@ type ReferenceUnit int32
 
 const (
 	ReferenceUnit_REFERENCE_UNIT_UNSPECIFIED ReferenceUnit = 0
-	ReferenceUnit_REFERENCE_UNIT_PERLICENSE  ReferenceUnit = 1
-	ReferenceUnit_REFERENCE_UNIT_PERUSE      ReferenceUnit = 2
+	// @xml: "PerLicense"
+	ReferenceUnit_REFERENCE_UNIT_PERLICENSE ReferenceUnit = 1
+	// @xml: "PerUse"
+	ReferenceUnit_REFERENCE_UNIT_PERUSE ReferenceUnit = 2
 )
 
 // Enum value maps for ReferenceUnit.
@@ -13538,13 +16727,19 @@ func (ReferenceUnit) EnumDescriptor() ([]byte, []int) {
 type RelationalRelator int32
 
 const (
-	RelationalRelator_RELATIONAL_RELATOR_UNSPECIFIED       RelationalRelator = 0
-	RelationalRelator_RELATIONAL_RELATOR_EQUALTO           RelationalRelator = 1
-	RelationalRelator_RELATIONAL_RELATOR_LESSTHAN          RelationalRelator = 2
+	RelationalRelator_RELATIONAL_RELATOR_UNSPECIFIED RelationalRelator = 0
+	// @xml: "EqualTo"
+	RelationalRelator_RELATIONAL_RELATOR_EQUALTO RelationalRelator = 1
+	// @xml: "LessThan"
+	RelationalRelator_RELATIONAL_RELATOR_LESSTHAN RelationalRelator = 2
+	// @xml: "LessThanOrEqualTo"
 	RelationalRelator_RELATIONAL_RELATOR_LESSTHANOREQUALTO RelationalRelator = 3
-	RelationalRelator_RELATIONAL_RELATOR_MORETHAN          RelationalRelator = 4
+	// @xml: "MoreThan"
+	RelationalRelator_RELATIONAL_RELATOR_MORETHAN RelationalRelator = 4
+	// @xml: "MoreThanOrEqualTo"
 	RelationalRelator_RELATIONAL_RELATOR_MORETHANOREQUALTO RelationalRelator = 5
-	RelationalRelator_RELATIONAL_RELATOR_NOTEQUALTO        RelationalRelator = 6
+	// @xml: "NotEqualTo"
+	RelationalRelator_RELATIONAL_RELATOR_NOTEQUALTO RelationalRelator = 6
 )
 
 // Enum value maps for RelationalRelator.
@@ -13599,13 +16794,19 @@ func (RelationalRelator) EnumDescriptor() ([]byte, []int) {
 type ReleaseAvailabilityStatus int32
 
 const (
-	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_UNSPECIFIED            ReleaseAvailabilityStatus = 0
-	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_AVAILABLEFORDSP        ReleaseAvailabilityStatus = 1
-	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTAVAILABLEFORDSP     ReleaseAvailabilityStatus = 2
-	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTCLEAREDFORDSP       ReleaseAvailabilityStatus = 3
+	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_UNSPECIFIED ReleaseAvailabilityStatus = 0
+	// @xml: "AvailableForDSP"
+	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_AVAILABLEFORDSP ReleaseAvailabilityStatus = 1
+	// @xml: "NotAvailableForDSP"
+	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTAVAILABLEFORDSP ReleaseAvailabilityStatus = 2
+	// @xml: "NotClearedForDSP"
+	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTCLEAREDFORDSP ReleaseAvailabilityStatus = 3
+	// @xml: "NotClearedForTerritory"
 	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTCLEAREDFORTERRITORY ReleaseAvailabilityStatus = 4
-	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTYETPREPARED         ReleaseAvailabilityStatus = 5
-	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_USERDEFINED            ReleaseAvailabilityStatus = 6
+	// @xml: "NotYetPrepared"
+	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_NOTYETPREPARED ReleaseAvailabilityStatus = 5
+	// @xml: "UserDefined"
+	ReleaseAvailabilityStatus_RELEASE_AVAILABILITY_STATUS_USERDEFINED ReleaseAvailabilityStatus = 6
 )
 
 // Enum value maps for ReleaseAvailabilityStatus.
@@ -13660,27 +16861,47 @@ func (ReleaseAvailabilityStatus) EnumDescriptor() ([]byte, []int) {
 type ReleaseRelationshipType int32
 
 const (
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_UNSPECIFIED                   ReleaseRelationshipType = 0
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMENSEMBLE         ReleaseRelationshipType = 1
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMSAMEENSEMBLE     ReleaseRelationshipType = 2
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASENSEMBLEWITHARTIST         ReleaseRelationshipType = 3
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMEARTIST                 ReleaseRelationshipType = 4
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMERECORDINGPROJECT       ReleaseRelationshipType = 5
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSIMILARCONTENT             ReleaseRelationshipType = 6
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_UNSPECIFIED ReleaseRelationshipType = 0
+	// @xml: "HasArtistFromEnsemble"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMENSEMBLE ReleaseRelationshipType = 1
+	// @xml: "HasArtistFromSameEnsemble"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASARTISTFROMSAMEENSEMBLE ReleaseRelationshipType = 2
+	// @xml: "HasEnsembleWithArtist"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASENSEMBLEWITHARTIST ReleaseRelationshipType = 3
+	// @xml: "HasSameArtist"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMEARTIST ReleaseRelationshipType = 4
+	// @xml: "HasSameRecordingProject"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSAMERECORDINGPROJECT ReleaseRelationshipType = 5
+	// @xml: "HasSimilarContent"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASSIMILARCONTENT ReleaseRelationshipType = 6
+	// @xml: "IsDigitalEquivalentToPhysical"
 	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISDIGITALEQUIVALENTTOPHYSICAL ReleaseRelationshipType = 7
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOAUDIO           ReleaseRelationshipType = 8
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOVIDEO           ReleaseRelationshipType = 9
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEXTENDEDFROMALBUM           ReleaseRelationshipType = 10
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMAUDIO                   ReleaseRelationshipType = 11
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMVIDEO                   ReleaseRelationshipType = 12
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISPARENTRELEASE               ReleaseRelationshipType = 13
+	// @xml: "IsEquivalentToAudio"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOAUDIO ReleaseRelationshipType = 8
+	// @xml: "IsEquivalentToVideo"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEQUIVALENTTOVIDEO ReleaseRelationshipType = 9
+	// @xml: "IsExtendedFromAlbum"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISEXTENDEDFROMALBUM ReleaseRelationshipType = 10
+	// @xml: "IsFromAudio"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMAUDIO ReleaseRelationshipType = 11
+	// @xml: "IsFromVideo"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISFROMVIDEO ReleaseRelationshipType = 12
+	// @xml: "IsParentRelease"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISPARENTRELEASE ReleaseRelationshipType = 13
+	// @xml: "IsPhysicalEquivalentToDigital"
 	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISPHYSICALEQUIVALENTTODIGITAL ReleaseRelationshipType = 14
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISRELEASEFROMRELEASE          ReleaseRelationshipType = 15
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISSHORTENEDFROMALBUM          ReleaseRelationshipType = 16
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_UNKNOWN                       ReleaseRelationshipType = 17
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_USERDEFINED                   ReleaseRelationshipType = 18
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISDIFFERENTENCODING           ReleaseRelationshipType = 19
-	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASCONTENTFROM                ReleaseRelationshipType = 20
+	// @xml: "IsReleaseFromRelease"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISRELEASEFROMRELEASE ReleaseRelationshipType = 15
+	// @xml: "IsShortenedFromAlbum"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISSHORTENEDFROMALBUM ReleaseRelationshipType = 16
+	// @xml: "Unknown"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_UNKNOWN ReleaseRelationshipType = 17
+	// @xml: "UserDefined"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_USERDEFINED ReleaseRelationshipType = 18
+	// @xml: "IsDifferentEncoding"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_ISDIFFERENTENCODING ReleaseRelationshipType = 19
+	// @xml: "HasContentFrom"
+	ReleaseRelationshipType_RELEASE_RELATIONSHIP_TYPE_HASCONTENTFROM ReleaseRelationshipType = 20
 )
 
 // Enum value maps for ReleaseRelationshipType.
@@ -13763,8 +16984,10 @@ func (ReleaseRelationshipType) EnumDescriptor() ([]byte, []int) {
 type ReleaseResourceType int32
 
 const (
-	ReleaseResourceType_RELEASE_RESOURCE_TYPE_UNSPECIFIED       ReleaseResourceType = 0
-	ReleaseResourceType_RELEASE_RESOURCE_TYPE_PRIMARYRESOURCE   ReleaseResourceType = 1
+	ReleaseResourceType_RELEASE_RESOURCE_TYPE_UNSPECIFIED ReleaseResourceType = 0
+	// @xml: "PrimaryResource"
+	ReleaseResourceType_RELEASE_RESOURCE_TYPE_PRIMARYRESOURCE ReleaseResourceType = 1
+	// @xml: "SecondaryResource"
 	ReleaseResourceType_RELEASE_RESOURCE_TYPE_SECONDARYRESOURCE ReleaseResourceType = 2
 )
 
@@ -13812,89 +17035,171 @@ func (ReleaseResourceType) EnumDescriptor() ([]byte, []int) {
 type ReleaseType int32
 
 const (
-	ReleaseType_RELEASE_TYPE_UNSPECIFIED                         ReleaseType = 0
-	ReleaseType_RELEASE_TYPE_ADVERTISEMENTVIDEO                  ReleaseType = 1
-	ReleaseType_RELEASE_TYPE_ALBUM                               ReleaseType = 2
-	ReleaseType_RELEASE_TYPE_ALERTTONERELEASE                    ReleaseType = 3
-	ReleaseType_RELEASE_TYPE_ANIMATION                           ReleaseType = 4
-	ReleaseType_RELEASE_TYPE_ASPERCONTRACT                       ReleaseType = 5
-	ReleaseType_RELEASE_TYPE_AUDIOCLIPRELEASE                    ReleaseType = 6
-	ReleaseType_RELEASE_TYPE_BACKCOVERIMAGERELEASE               ReleaseType = 7
-	ReleaseType_RELEASE_TYPE_BOOKLETBACKIMAGERELEASE             ReleaseType = 8
-	ReleaseType_RELEASE_TYPE_BOOKLETFRONTIMAGERELEASE            ReleaseType = 9
-	ReleaseType_RELEASE_TYPE_BOOKLETRELEASE                      ReleaseType = 10
-	ReleaseType_RELEASE_TYPE_BUNDLE                              ReleaseType = 11
-	ReleaseType_RELEASE_TYPE_CLASSICALALBUM                      ReleaseType = 12
-	ReleaseType_RELEASE_TYPE_CONCERTVIDEO                        ReleaseType = 13
-	ReleaseType_RELEASE_TYPE_CORPORATEFILM                       ReleaseType = 14
-	ReleaseType_RELEASE_TYPE_DIGITALBOXSETRELEASE                ReleaseType = 15
-	ReleaseType_RELEASE_TYPE_DOCUMENTARY                         ReleaseType = 16
-	ReleaseType_RELEASE_TYPE_DOCUMENTIMAGERELEASE                ReleaseType = 17
-	ReleaseType_RELEASE_TYPE_EBOOKRELEASE                        ReleaseType = 18
-	ReleaseType_RELEASE_TYPE_EP                                  ReleaseType = 19
-	ReleaseType_RELEASE_TYPE_EPISODE                             ReleaseType = 20
-	ReleaseType_RELEASE_TYPE_FEATUREFILM                         ReleaseType = 21
-	ReleaseType_RELEASE_TYPE_FILMBUNDLE                          ReleaseType = 22
-	ReleaseType_RELEASE_TYPE_FRONTCOVERIMAGERELEASE              ReleaseType = 23
-	ReleaseType_RELEASE_TYPE_ICONRELEASE                         ReleaseType = 24
-	ReleaseType_RELEASE_TYPE_INFOMERCIALVIDEO                    ReleaseType = 25
-	ReleaseType_RELEASE_TYPE_INTERACTIVEBOOKLETRELEASE           ReleaseType = 26
-	ReleaseType_RELEASE_TYPE_KARAOKERELEASE                      ReleaseType = 27
-	ReleaseType_RELEASE_TYPE_LIVEEVENTVIDEO                      ReleaseType = 28
-	ReleaseType_RELEASE_TYPE_LOGORELEASE                         ReleaseType = 29
-	ReleaseType_RELEASE_TYPE_LONGFORMMUSICALWORKVIDEORELEASE     ReleaseType = 30
-	ReleaseType_RELEASE_TYPE_LONGFORMNONMUSICALWORKVIDEORELEASE  ReleaseType = 31
-	ReleaseType_RELEASE_TYPE_LYRICSHEETRELEASE                   ReleaseType = 32
-	ReleaseType_RELEASE_TYPE_MULTIMEDIAALBUM                     ReleaseType = 33
-	ReleaseType_RELEASE_TYPE_MULTIMEDIASINGLE                    ReleaseType = 34
-	ReleaseType_RELEASE_TYPE_MUSICALWORKBASEDGAMERELEASE         ReleaseType = 35
-	ReleaseType_RELEASE_TYPE_MUSICALWORKCLIPRELEASE              ReleaseType = 36
-	ReleaseType_RELEASE_TYPE_MUSICALWORKREADALONGVIDEORELEASE    ReleaseType = 37
-	ReleaseType_RELEASE_TYPE_MUSICALWORKTRAILERRELEASE           ReleaseType = 38
-	ReleaseType_RELEASE_TYPE_MUSICALWORKVIDEOCHAPTERRELEASE      ReleaseType = 39
-	ReleaseType_RELEASE_TYPE_NEWS                                ReleaseType = 40
-	ReleaseType_RELEASE_TYPE_NONMUSICALWORKBASEDGAMERELEASE      ReleaseType = 41
-	ReleaseType_RELEASE_TYPE_NONMUSICALWORKCLIPRELEASE           ReleaseType = 42
+	ReleaseType_RELEASE_TYPE_UNSPECIFIED ReleaseType = 0
+	// @xml: "AdvertisementVideo"
+	ReleaseType_RELEASE_TYPE_ADVERTISEMENTVIDEO ReleaseType = 1
+	// @xml: "Album"
+	ReleaseType_RELEASE_TYPE_ALBUM ReleaseType = 2
+	// @xml: "AlertToneRelease"
+	ReleaseType_RELEASE_TYPE_ALERTTONERELEASE ReleaseType = 3
+	// @xml: "Animation"
+	ReleaseType_RELEASE_TYPE_ANIMATION ReleaseType = 4
+	// @xml: "AsPerContract"
+	ReleaseType_RELEASE_TYPE_ASPERCONTRACT ReleaseType = 5
+	// @xml: "AudioClipRelease"
+	ReleaseType_RELEASE_TYPE_AUDIOCLIPRELEASE ReleaseType = 6
+	// @xml: "BackCoverImageRelease"
+	ReleaseType_RELEASE_TYPE_BACKCOVERIMAGERELEASE ReleaseType = 7
+	// @xml: "BookletBackImageRelease"
+	ReleaseType_RELEASE_TYPE_BOOKLETBACKIMAGERELEASE ReleaseType = 8
+	// @xml: "BookletFrontImageRelease"
+	ReleaseType_RELEASE_TYPE_BOOKLETFRONTIMAGERELEASE ReleaseType = 9
+	// @xml: "BookletRelease"
+	ReleaseType_RELEASE_TYPE_BOOKLETRELEASE ReleaseType = 10
+	// @xml: "Bundle"
+	ReleaseType_RELEASE_TYPE_BUNDLE ReleaseType = 11
+	// @xml: "ClassicalAlbum"
+	ReleaseType_RELEASE_TYPE_CLASSICALALBUM ReleaseType = 12
+	// @xml: "ConcertVideo"
+	ReleaseType_RELEASE_TYPE_CONCERTVIDEO ReleaseType = 13
+	// @xml: "CorporateFilm"
+	ReleaseType_RELEASE_TYPE_CORPORATEFILM ReleaseType = 14
+	// @xml: "DigitalBoxSetRelease"
+	ReleaseType_RELEASE_TYPE_DIGITALBOXSETRELEASE ReleaseType = 15
+	// @xml: "Documentary"
+	ReleaseType_RELEASE_TYPE_DOCUMENTARY ReleaseType = 16
+	// @xml: "DocumentImageRelease"
+	ReleaseType_RELEASE_TYPE_DOCUMENTIMAGERELEASE ReleaseType = 17
+	// @xml: "EBookRelease"
+	ReleaseType_RELEASE_TYPE_EBOOKRELEASE ReleaseType = 18
+	// @xml: "EP"
+	ReleaseType_RELEASE_TYPE_EP ReleaseType = 19
+	// @xml: "Episode"
+	ReleaseType_RELEASE_TYPE_EPISODE ReleaseType = 20
+	// @xml: "FeatureFilm"
+	ReleaseType_RELEASE_TYPE_FEATUREFILM ReleaseType = 21
+	// @xml: "FilmBundle"
+	ReleaseType_RELEASE_TYPE_FILMBUNDLE ReleaseType = 22
+	// @xml: "FrontCoverImageRelease"
+	ReleaseType_RELEASE_TYPE_FRONTCOVERIMAGERELEASE ReleaseType = 23
+	// @xml: "IconRelease"
+	ReleaseType_RELEASE_TYPE_ICONRELEASE ReleaseType = 24
+	// @xml: "InfomercialVideo"
+	ReleaseType_RELEASE_TYPE_INFOMERCIALVIDEO ReleaseType = 25
+	// @xml: "InteractiveBookletRelease"
+	ReleaseType_RELEASE_TYPE_INTERACTIVEBOOKLETRELEASE ReleaseType = 26
+	// @xml: "KaraokeRelease"
+	ReleaseType_RELEASE_TYPE_KARAOKERELEASE ReleaseType = 27
+	// @xml: "LiveEventVideo"
+	ReleaseType_RELEASE_TYPE_LIVEEVENTVIDEO ReleaseType = 28
+	// @xml: "LogoRelease"
+	ReleaseType_RELEASE_TYPE_LOGORELEASE ReleaseType = 29
+	// @xml: "LongFormMusicalWorkVideoRelease"
+	ReleaseType_RELEASE_TYPE_LONGFORMMUSICALWORKVIDEORELEASE ReleaseType = 30
+	// @xml: "LongFormNonMusicalWorkVideoRelease"
+	ReleaseType_RELEASE_TYPE_LONGFORMNONMUSICALWORKVIDEORELEASE ReleaseType = 31
+	// @xml: "LyricSheetRelease"
+	ReleaseType_RELEASE_TYPE_LYRICSHEETRELEASE ReleaseType = 32
+	// @xml: "MultimediaAlbum"
+	ReleaseType_RELEASE_TYPE_MULTIMEDIAALBUM ReleaseType = 33
+	// @xml: "MultimediaSingle"
+	ReleaseType_RELEASE_TYPE_MULTIMEDIASINGLE ReleaseType = 34
+	// @xml: "MusicalWorkBasedGameRelease"
+	ReleaseType_RELEASE_TYPE_MUSICALWORKBASEDGAMERELEASE ReleaseType = 35
+	// @xml: "MusicalWorkClipRelease"
+	ReleaseType_RELEASE_TYPE_MUSICALWORKCLIPRELEASE ReleaseType = 36
+	// @xml: "MusicalWorkReadalongVideoRelease"
+	ReleaseType_RELEASE_TYPE_MUSICALWORKREADALONGVIDEORELEASE ReleaseType = 37
+	// @xml: "MusicalWorkTrailerRelease"
+	ReleaseType_RELEASE_TYPE_MUSICALWORKTRAILERRELEASE ReleaseType = 38
+	// @xml: "MusicalWorkVideoChapterRelease"
+	ReleaseType_RELEASE_TYPE_MUSICALWORKVIDEOCHAPTERRELEASE ReleaseType = 39
+	// @xml: "News"
+	ReleaseType_RELEASE_TYPE_NEWS ReleaseType = 40
+	// @xml: "NonMusicalWorkBasedGameRelease"
+	ReleaseType_RELEASE_TYPE_NONMUSICALWORKBASEDGAMERELEASE ReleaseType = 41
+	// @xml: "NonMusicalWorkClipRelease"
+	ReleaseType_RELEASE_TYPE_NONMUSICALWORKCLIPRELEASE ReleaseType = 42
+	// @xml: "NonMusicalWorkReadalongVideoRelease"
 	ReleaseType_RELEASE_TYPE_NONMUSICALWORKREADALONGVIDEORELEASE ReleaseType = 43
-	ReleaseType_RELEASE_TYPE_NONMUSICALWORKTRAILERRELEASE        ReleaseType = 44
-	ReleaseType_RELEASE_TYPE_NONMUSICALWORKVIDEOCHAPTERRELEASE   ReleaseType = 45
-	ReleaseType_RELEASE_TYPE_NONSERIALAUDIOVISUALRECORDING       ReleaseType = 46
-	ReleaseType_RELEASE_TYPE_PHOTOGRAPHRELEASE                   ReleaseType = 47
-	ReleaseType_RELEASE_TYPE_RINGBACKTONERELEASE                 ReleaseType = 48
-	ReleaseType_RELEASE_TYPE_RINGTONERELEASE                     ReleaseType = 49
-	ReleaseType_RELEASE_TYPE_SCREENSAVERRELEASE                  ReleaseType = 50
-	ReleaseType_RELEASE_TYPE_SEASON                              ReleaseType = 51
-	ReleaseType_RELEASE_TYPE_SERIES                              ReleaseType = 52
-	ReleaseType_RELEASE_TYPE_SHEETMUSICRELEASE                   ReleaseType = 53
-	ReleaseType_RELEASE_TYPE_SHORTFORMMUSICALWORKVIDEORELEASE    ReleaseType = 54
+	// @xml: "NonMusicalWorkTrailerRelease"
+	ReleaseType_RELEASE_TYPE_NONMUSICALWORKTRAILERRELEASE ReleaseType = 44
+	// @xml: "NonMusicalWorkVideoChapterRelease"
+	ReleaseType_RELEASE_TYPE_NONMUSICALWORKVIDEOCHAPTERRELEASE ReleaseType = 45
+	// @xml: "NonSerialAudioVisualRecording"
+	ReleaseType_RELEASE_TYPE_NONSERIALAUDIOVISUALRECORDING ReleaseType = 46
+	// @xml: "PhotographRelease"
+	ReleaseType_RELEASE_TYPE_PHOTOGRAPHRELEASE ReleaseType = 47
+	// @xml: "RingbackToneRelease"
+	ReleaseType_RELEASE_TYPE_RINGBACKTONERELEASE ReleaseType = 48
+	// @xml: "RingtoneRelease"
+	ReleaseType_RELEASE_TYPE_RINGTONERELEASE ReleaseType = 49
+	// @xml: "ScreensaverRelease"
+	ReleaseType_RELEASE_TYPE_SCREENSAVERRELEASE ReleaseType = 50
+	// @xml: "Season"
+	ReleaseType_RELEASE_TYPE_SEASON ReleaseType = 51
+	// @xml: "Series"
+	ReleaseType_RELEASE_TYPE_SERIES ReleaseType = 52
+	// @xml: "SheetMusicRelease"
+	ReleaseType_RELEASE_TYPE_SHEETMUSICRELEASE ReleaseType = 53
+	// @xml: "ShortFormMusicalWorkVideoRelease"
+	ReleaseType_RELEASE_TYPE_SHORTFORMMUSICALWORKVIDEORELEASE ReleaseType = 54
+	// @xml: "ShortFormNonMusicalWorkVideoRelease"
 	ReleaseType_RELEASE_TYPE_SHORTFORMNONMUSICALWORKVIDEORELEASE ReleaseType = 55
-	ReleaseType_RELEASE_TYPE_SINGLE                              ReleaseType = 56
-	ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASE               ReleaseType = 57
-	ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASEWITHCOVERART   ReleaseType = 58
-	ReleaseType_RELEASE_TYPE_TRACKRELEASE                        ReleaseType = 59
-	ReleaseType_RELEASE_TYPE_TRAILERVIDEO                        ReleaseType = 60
-	ReleaseType_RELEASE_TYPE_TRAYIMAGERELEASE                    ReleaseType = 61
-	ReleaseType_RELEASE_TYPE_UNKNOWN                             ReleaseType = 62
-	ReleaseType_RELEASE_TYPE_USERDEFINED                         ReleaseType = 63
-	ReleaseType_RELEASE_TYPE_VIDEOALBUM                          ReleaseType = 64
-	ReleaseType_RELEASE_TYPE_VIDEOCHAPTERRELEASE                 ReleaseType = 65
-	ReleaseType_RELEASE_TYPE_VIDEOCLIPRELEASE                    ReleaseType = 66
-	ReleaseType_RELEASE_TYPE_VIDEOSCREENCAPTURERELEASE           ReleaseType = 67
-	ReleaseType_RELEASE_TYPE_VIDEOSINGLE                         ReleaseType = 68
-	ReleaseType_RELEASE_TYPE_VIDEOTRACKRELEASE                   ReleaseType = 69
-	ReleaseType_RELEASE_TYPE_WALLPAPERRELEASE                    ReleaseType = 70
-	ReleaseType_RELEASE_TYPE_AUDIOBOOKRELEASE                    ReleaseType = 71
-	ReleaseType_RELEASE_TYPE_AUDIODRAMARELEASE                   ReleaseType = 72
-	ReleaseType_RELEASE_TYPE_CLASSICALDIGITALBOXEDSET            ReleaseType = 73
-	ReleaseType_RELEASE_TYPE_CLASSICALMULTIMEDIAALBUM            ReleaseType = 74
-	ReleaseType_RELEASE_TYPE_DJMIX                               ReleaseType = 75
-	ReleaseType_RELEASE_TYPE_DRAMA                               ReleaseType = 76
-	ReleaseType_RELEASE_TYPE_DRAMATICOMUSICALVIDEORELEASE        ReleaseType = 77
-	ReleaseType_RELEASE_TYPE_MULTIMEDIADIGITALBOXEDSET           ReleaseType = 78
-	ReleaseType_RELEASE_TYPE_PLAYLIST                            ReleaseType = 79
-	ReleaseType_RELEASE_TYPE_SHORTFILM                           ReleaseType = 80
-	ReleaseType_RELEASE_TYPE_STEMBUNDLE                          ReleaseType = 81
-	ReleaseType_RELEASE_TYPE_VIDEOMASTERTONERELEASE              ReleaseType = 82
+	// @xml: "Single"
+	ReleaseType_RELEASE_TYPE_SINGLE ReleaseType = 56
+	// @xml: "SingleResourceRelease"
+	ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASE ReleaseType = 57
+	// @xml: "SingleResourceReleaseWithCoverArt"
+	ReleaseType_RELEASE_TYPE_SINGLERESOURCERELEASEWITHCOVERART ReleaseType = 58
+	// @xml: "TrackRelease"
+	ReleaseType_RELEASE_TYPE_TRACKRELEASE ReleaseType = 59
+	// @xml: "TrailerVideo"
+	ReleaseType_RELEASE_TYPE_TRAILERVIDEO ReleaseType = 60
+	// @xml: "TrayImageRelease"
+	ReleaseType_RELEASE_TYPE_TRAYIMAGERELEASE ReleaseType = 61
+	// @xml: "Unknown"
+	ReleaseType_RELEASE_TYPE_UNKNOWN ReleaseType = 62
+	// @xml: "UserDefined"
+	ReleaseType_RELEASE_TYPE_USERDEFINED ReleaseType = 63
+	// @xml: "VideoAlbum"
+	ReleaseType_RELEASE_TYPE_VIDEOALBUM ReleaseType = 64
+	// @xml: "VideoChapterRelease"
+	ReleaseType_RELEASE_TYPE_VIDEOCHAPTERRELEASE ReleaseType = 65
+	// @xml: "VideoClipRelease"
+	ReleaseType_RELEASE_TYPE_VIDEOCLIPRELEASE ReleaseType = 66
+	// @xml: "VideoScreenCaptureRelease"
+	ReleaseType_RELEASE_TYPE_VIDEOSCREENCAPTURERELEASE ReleaseType = 67
+	// @xml: "VideoSingle"
+	ReleaseType_RELEASE_TYPE_VIDEOSINGLE ReleaseType = 68
+	// @xml: "VideoTrackRelease"
+	ReleaseType_RELEASE_TYPE_VIDEOTRACKRELEASE ReleaseType = 69
+	// @xml: "WallpaperRelease"
+	ReleaseType_RELEASE_TYPE_WALLPAPERRELEASE ReleaseType = 70
+	// @xml: "AudioBookRelease"
+	ReleaseType_RELEASE_TYPE_AUDIOBOOKRELEASE ReleaseType = 71
+	// @xml: "AudioDramaRelease"
+	ReleaseType_RELEASE_TYPE_AUDIODRAMARELEASE ReleaseType = 72
+	// @xml: "ClassicalDigitalBoxedSet"
+	ReleaseType_RELEASE_TYPE_CLASSICALDIGITALBOXEDSET ReleaseType = 73
+	// @xml: "ClassicalMultimediaAlbum"
+	ReleaseType_RELEASE_TYPE_CLASSICALMULTIMEDIAALBUM ReleaseType = 74
+	// @xml: "DjMix"
+	ReleaseType_RELEASE_TYPE_DJMIX ReleaseType = 75
+	// @xml: "Drama"
+	ReleaseType_RELEASE_TYPE_DRAMA ReleaseType = 76
+	// @xml: "DramaticoMusicalVideoRelease"
+	ReleaseType_RELEASE_TYPE_DRAMATICOMUSICALVIDEORELEASE ReleaseType = 77
+	// @xml: "MultimediaDigitalBoxedSet"
+	ReleaseType_RELEASE_TYPE_MULTIMEDIADIGITALBOXEDSET ReleaseType = 78
+	// @xml: "PlayList"
+	ReleaseType_RELEASE_TYPE_PLAYLIST ReleaseType = 79
+	// @xml: "ShortFilm"
+	ReleaseType_RELEASE_TYPE_SHORTFILM ReleaseType = 80
+	// @xml: "StemBundle"
+	ReleaseType_RELEASE_TYPE_STEMBUNDLE ReleaseType = 81
+	// @xml: "VideoMastertoneRelease"
+	ReleaseType_RELEASE_TYPE_VIDEOMASTERTONERELEASE ReleaseType = 82
 )
 
 // Enum value maps for ReleaseType.
@@ -14102,13 +17407,20 @@ type ReportFormat int32
 
 const (
 	ReportFormat_REPORT_FORMAT_UNSPECIFIED ReportFormat = 0
-	ReportFormat_REPORT_FORMAT_ASCII       ReportFormat = 1
-	ReportFormat_REPORT_FORMAT_CSV         ReportFormat = 2
-	ReportFormat_REPORT_FORMAT_EXCEL2000   ReportFormat = 3
-	ReportFormat_REPORT_FORMAT_EXCEL2007   ReportFormat = 4
-	ReportFormat_REPORT_FORMAT_EXCEL2010   ReportFormat = 5
+	// @xml: "ASCII"
+	ReportFormat_REPORT_FORMAT_ASCII ReportFormat = 1
+	// @xml: "CSV"
+	ReportFormat_REPORT_FORMAT_CSV ReportFormat = 2
+	// @xml: "Excel2000"
+	ReportFormat_REPORT_FORMAT_EXCEL2000 ReportFormat = 3
+	// @xml: "Excel2007"
+	ReportFormat_REPORT_FORMAT_EXCEL2007 ReportFormat = 4
+	// @xml: "Excel2010"
+	ReportFormat_REPORT_FORMAT_EXCEL2010 ReportFormat = 5
+	// @xml: "UserDefined"
 	ReportFormat_REPORT_FORMAT_USERDEFINED ReportFormat = 6
-	ReportFormat_REPORT_FORMAT_XML         ReportFormat = 7
+	// @xml: "XML"
+	ReportFormat_REPORT_FORMAT_XML ReportFormat = 7
 )
 
 // Enum value maps for ReportFormat.
@@ -14165,20 +17477,33 @@ func (ReportFormat) EnumDescriptor() ([]byte, []int) {
 type ReportType int32
 
 const (
-	ReportType_REPORT_TYPE_UNSPECIFIED                                       ReportType = 0
-	ReportType_REPORT_TYPE_DELIVERYFREQUENCYREQUESTCALL                      ReportType = 1
+	ReportType_REPORT_TYPE_UNSPECIFIED ReportType = 0
+	// @xml: "DeliveryFrequencyRequestCall"
+	ReportType_REPORT_TYPE_DELIVERYFREQUENCYREQUESTCALL ReportType = 1
+	// @xml: "InformationAboutDeliveredAndAvailableReleasesCall"
 	ReportType_REPORT_TYPE_INFORMATIONABOUTDELIVEREDANDAVAILABLERELEASESCALL ReportType = 2
-	ReportType_REPORT_TYPE_ORDEREDRELEASESINQUEUEREQUESTCALL                 ReportType = 3
-	ReportType_REPORT_TYPE_REDELIVERYREQUESTCALL                             ReportType = 4
-	ReportType_REPORT_TYPE_RELEASEAVAILABILITYCALL                           ReportType = 5
-	ReportType_REPORT_TYPE_RELEASEAVAILABILITYREQUESTCALL                    ReportType = 6
-	ReportType_REPORT_TYPE_RELEASESTATUSINFORMATIONCALL                      ReportType = 7
-	ReportType_REPORT_TYPE_RELEASESTATUSREQUESTCALL                          ReportType = 8
-	ReportType_REPORT_TYPE_RELEASESUPPLYCHAINREQUESTCALL                     ReportType = 9
-	ReportType_REPORT_TYPE_REPORTDELIVERYCALL                                ReportType = 10
-	ReportType_REPORT_TYPE_REPORTREQUESTCALL                                 ReportType = 11
-	ReportType_REPORT_TYPE_SUPPLYCHAINSTATUSCALL                             ReportType = 12
-	ReportType_REPORT_TYPE_USERDEFINED                                       ReportType = 13
+	// @xml: "OrderedReleasesInQueueRequestCall"
+	ReportType_REPORT_TYPE_ORDEREDRELEASESINQUEUEREQUESTCALL ReportType = 3
+	// @xml: "RedeliveryRequestCall"
+	ReportType_REPORT_TYPE_REDELIVERYREQUESTCALL ReportType = 4
+	// @xml: "ReleaseAvailabilityCall"
+	ReportType_REPORT_TYPE_RELEASEAVAILABILITYCALL ReportType = 5
+	// @xml: "ReleaseAvailabilityRequestCall"
+	ReportType_REPORT_TYPE_RELEASEAVAILABILITYREQUESTCALL ReportType = 6
+	// @xml: "ReleaseStatusInformationCall"
+	ReportType_REPORT_TYPE_RELEASESTATUSINFORMATIONCALL ReportType = 7
+	// @xml: "ReleaseStatusRequestCall"
+	ReportType_REPORT_TYPE_RELEASESTATUSREQUESTCALL ReportType = 8
+	// @xml: "ReleaseSupplyChainRequestCall"
+	ReportType_REPORT_TYPE_RELEASESUPPLYCHAINREQUESTCALL ReportType = 9
+	// @xml: "ReportDeliveryCall"
+	ReportType_REPORT_TYPE_REPORTDELIVERYCALL ReportType = 10
+	// @xml: "ReportRequestCall"
+	ReportType_REPORT_TYPE_REPORTREQUESTCALL ReportType = 11
+	// @xml: "SupplyChainStatusCall"
+	ReportType_REPORT_TYPE_SUPPLYCHAINSTATUSCALL ReportType = 12
+	// @xml: "UserDefined"
+	ReportType_REPORT_TYPE_USERDEFINED ReportType = 13
 )
 
 // Enum value maps for ReportType.
@@ -14247,19 +17572,31 @@ func (ReportType) EnumDescriptor() ([]byte, []int) {
 type RequestReason int32
 
 const (
-	RequestReason_REQUEST_REASON_UNSPECIFIED              RequestReason = 0
-	RequestReason_REQUEST_REASON_USERDEFINED              RequestReason = 1
+	RequestReason_REQUEST_REASON_UNSPECIFIED RequestReason = 0
+	// @xml: "UserDefined"
+	RequestReason_REQUEST_REASON_USERDEFINED RequestReason = 1
+	// @xml: "DisputeResolutionRequest"
 	RequestReason_REQUEST_REASON_DISPUTERESOLUTIONREQUEST RequestReason = 2
-	RequestReason_REQUEST_REASON_GENERALREQUEST           RequestReason = 3
-	RequestReason_REQUEST_REASON_PUBLISHERADDITION        RequestReason = 4
-	RequestReason_REQUEST_REASON_PUBLISHERCHANGE          RequestReason = 5
-	RequestReason_REQUEST_REASON_PUBLISHERREMOVAL         RequestReason = 6
-	RequestReason_REQUEST_REASON_RECALL                   RequestReason = 7
-	RequestReason_REQUEST_REASON_RELEASELISTUPDATE        RequestReason = 8
-	RequestReason_REQUEST_REASON_SPECIFICREQUEST          RequestReason = 9
-	RequestReason_REQUEST_REASON_WRITERADDITION           RequestReason = 10
-	RequestReason_REQUEST_REASON_WRITERCHANGE             RequestReason = 11
-	RequestReason_REQUEST_REASON_WRITERREMOVAL            RequestReason = 12
+	// @xml: "GeneralRequest"
+	RequestReason_REQUEST_REASON_GENERALREQUEST RequestReason = 3
+	// @xml: "PublisherAddition"
+	RequestReason_REQUEST_REASON_PUBLISHERADDITION RequestReason = 4
+	// @xml: "PublisherChange"
+	RequestReason_REQUEST_REASON_PUBLISHERCHANGE RequestReason = 5
+	// @xml: "PublisherRemoval"
+	RequestReason_REQUEST_REASON_PUBLISHERREMOVAL RequestReason = 6
+	// @xml: "Recall"
+	RequestReason_REQUEST_REASON_RECALL RequestReason = 7
+	// @xml: "ReleaseListUpdate"
+	RequestReason_REQUEST_REASON_RELEASELISTUPDATE RequestReason = 8
+	// @xml: "SpecificRequest"
+	RequestReason_REQUEST_REASON_SPECIFICREQUEST RequestReason = 9
+	// @xml: "WriterAddition"
+	RequestReason_REQUEST_REASON_WRITERADDITION RequestReason = 10
+	// @xml: "WriterChange"
+	RequestReason_REQUEST_REASON_WRITERCHANGE RequestReason = 11
+	// @xml: "WriterRemoval"
+	RequestReason_REQUEST_REASON_WRITERREMOVAL RequestReason = 12
 )
 
 // Enum value maps for RequestReason.
@@ -14326,12 +17663,17 @@ func (RequestReason) EnumDescriptor() ([]byte, []int) {
 type RequestedActionType int32
 
 const (
-	RequestedActionType_REQUESTED_ACTION_TYPE_UNSPECIFIED               RequestedActionType = 0
+	RequestedActionType_REQUESTED_ACTION_TYPE_UNSPECIFIED RequestedActionType = 0
+	// @xml: "AdditionalInformationOnly"
 	RequestedActionType_REQUESTED_ACTION_TYPE_ADDITIONALINFORMATIONONLY RequestedActionType = 1
-	RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDINFORM          RequestedActionType = 2
-	RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDRESEND          RequestedActionType = 3
-	RequestedActionType_REQUESTED_ACTION_TYPE_NOACTION                  RequestedActionType = 4
-	RequestedActionType_REQUESTED_ACTION_TYPE_USERDEFINED               RequestedActionType = 5
+	// @xml: "CorrectAndInform"
+	RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDINFORM RequestedActionType = 2
+	// @xml: "CorrectAndResend"
+	RequestedActionType_REQUESTED_ACTION_TYPE_CORRECTANDRESEND RequestedActionType = 3
+	// @xml: "NoAction"
+	RequestedActionType_REQUESTED_ACTION_TYPE_NOACTION RequestedActionType = 4
+	// @xml: "UserDefined"
+	RequestedActionType_REQUESTED_ACTION_TYPE_USERDEFINED RequestedActionType = 5
 )
 
 // Enum value maps for RequestedActionType.
@@ -14384,277 +17726,547 @@ func (RequestedActionType) EnumDescriptor() ([]byte, []int) {
 type ResourceContributorRole int32
 
 const (
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_UNSPECIFIED                        ResourceContributorRole = 0
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACCOMPANYIST                       ResourceContributorRole = 1
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACTOR                              ResourceContributorRole = 2
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALENGINEER                 ResourceContributorRole = 3
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALMIXINGENGINEER           ResourceContributorRole = 4
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPERFORMER                ResourceContributorRole = 5
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPROGRAMMINGENGINEER      ResourceContributorRole = 6
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALSTUDIOPRODUCER           ResourceContributorRole = 7
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANCHORPERSON                       ResourceContributorRole = 8
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMALTRAINER                      ResourceContributorRole = 9
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMATOR                           ResourceContributorRole = 10
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOTATOR                          ResourceContributorRole = 11
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOUNCER                          ResourceContributorRole = 12
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRADMINISTRATOR                 ResourceContributorRole = 13
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRCOORDINATOR                   ResourceContributorRole = 14
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARMOURER                           ResourceContributorRole = 15
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTCOPYIST                         ResourceContributorRole = 16
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTDIRECTOR                        ResourceContributorRole = 17
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTIST                             ResourceContributorRole = 18
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTBACKGROUNDVOCALENGINEER      ResourceContributorRole = 19
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALENGINEER                ResourceContributorRole = 20
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALSECONDENGINEER          ResourceContributorRole = 21
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCAMERAOPERATOR            ResourceContributorRole = 22
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCHIEFLIGHTINGTECHNICIAN   ResourceContributorRole = 23
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCONDUCTOR                 ResourceContributorRole = 24
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTDIRECTOR                  ResourceContributorRole = 25
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTEDITOR                    ResourceContributorRole = 26
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTENGINEER                  ResourceContributorRole = 27
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTPRODUCER                  ResourceContributorRole = 28
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTVISUALEDITOR              ResourceContributorRole = 29
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER                ResourceContributorRole = 30
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEPRODUCER                  ResourceContributorRole = 31
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AURALTRAINER                       ResourceContributorRole = 32
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BACKGROUNDVOCALIST                 ResourceContributorRole = 33
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BALANCEENGINEER                    ResourceContributorRole = 34
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BANDLEADER                         ResourceContributorRole = 35
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDER                             ResourceContributorRole = 36
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDINGDESIGNER                    ResourceContributorRole = 37
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKDESIGNER                       ResourceContributorRole = 38
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKJACKDESIGNER                   ResourceContributorRole = 39
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPLATEDESIGNER                  ResourceContributorRole = 40
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPRODUCER                       ResourceContributorRole = 41
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTASSISTANT                 ResourceContributorRole = 42
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTJOURNALIST                ResourceContributorRole = 43
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CALLIGRAPHER                       ResourceContributorRole = 44
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAMERAOPERATOR                     ResourceContributorRole = 45
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARPENTER                          ResourceContributorRole = 46
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOGRAPHER                       ResourceContributorRole = 47
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOONIST                         ResourceContributorRole = 48
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CASTINGDIRECTOR                    ResourceContributorRole = 49
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAUSEUR                            ResourceContributorRole = 50
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CENSOR                             ResourceContributorRole = 51
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHIEFLIGHTINGTECHNICIAN            ResourceContributorRole = 52
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIR                              ResourceContributorRole = 53
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIRMEMBER                        ResourceContributorRole = 54
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOREOGRAPHER                      ResourceContributorRole = 55
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHORUSMASTER                       ResourceContributorRole = 56
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CIRCUSARTIST                       ResourceContributorRole = 57
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLAPPERLOADER                      ResourceContributorRole = 58
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLUBDJ                             ResourceContributorRole = 59
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CODIRECTOR                         ResourceContributorRole = 60
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COEXECUTIVEPRODUCER                ResourceContributorRole = 61
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COLORSEPARATOR                     ResourceContributorRole = 62
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMEDIAN                           ResourceContributorRole = 63
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXER                            ResourceContributorRole = 64
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXINGENGINEER                   ResourceContributorRole = 65
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMENTATOR                        ResourceContributorRole = 66
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMISSIONINGBROADCASTER           ResourceContributorRole = 67
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPILATIONPRODUCER                ResourceContributorRole = 68
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERGRAPHICCREATOR             ResourceContributorRole = 69
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERPROGRAMMER                 ResourceContributorRole = 70
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONCERTMASTER                      ResourceContributorRole = 71
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONDUCTOR                          ResourceContributorRole = 72
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONSULTANT                         ResourceContributorRole = 73
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTINUITYCHECKER                  ResourceContributorRole = 74
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRACTOR                         ResourceContributorRole = 75
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COPRODUCER                         ResourceContributorRole = 76
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CORRESPONDENT                      ResourceContributorRole = 77
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COSTUMEDESIGNER                    ResourceContributorRole = 78
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COVERDESIGNER                      ResourceContributorRole = 79
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DANCER                             ResourceContributorRole = 80
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DELINEATOR                         ResourceContributorRole = 81
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DESIGNER                           ResourceContributorRole = 82
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUECOACH                      ResourceContributorRole = 83
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUEDIRECTOR                   ResourceContributorRole = 84
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALAUDIOWORKSTATIONENGINEER    ResourceContributorRole = 85
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGENGINEER             ResourceContributorRole = 86
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGSECONDENGINEER       ResourceContributorRole = 87
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTOR                           ResourceContributorRole = 88
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTSTREAMDIGITALENGINEER        ResourceContributorRole = 89
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DISTRIBUTIONCOMPANY                ResourceContributorRole = 90
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DJ                                 ResourceContributorRole = 91
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRAUGHTSMAN                        ResourceContributorRole = 92
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRESSER                            ResourceContributorRole = 93
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DUBBER                             ResourceContributorRole = 94
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOR                             ResourceContributorRole = 95
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITORINCHIEF                      ResourceContributorRole = 96
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOROFTHEDAY                     ResourceContributorRole = 97
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENCODER                            ResourceContributorRole = 98
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENGINEER                           ResourceContributorRole = 99
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENSEMBLE                           ResourceContributorRole = 100
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXECUTIVEPRODUCER                  ResourceContributorRole = 101
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXPERT                             ResourceContributorRole = 102
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FACSIMILIST                        ResourceContributorRole = 103
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FIGHTDIRECTOR                      ResourceContributorRole = 104
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDIRECTOR                       ResourceContributorRole = 105
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDISTRIBUTOR                    ResourceContributorRole = 106
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMEDITOR                         ResourceContributorRole = 107
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMPRODUCER                       ResourceContributorRole = 108
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMSOUNDENGINEER                  ResourceContributorRole = 109
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FLOORMANAGER                       ResourceContributorRole = 110
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOCUSPULLER                        ResourceContributorRole = 111
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYARTIST                        ResourceContributorRole = 112
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYEDITOR                        ResourceContributorRole = 113
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYMIXER                         ResourceContributorRole = 114
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICARTIST                      ResourceContributorRole = 115
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICASSISTANT                   ResourceContributorRole = 116
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICDESIGNER                    ResourceContributorRole = 117
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GREENSMAN                          ResourceContributorRole = 118
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRIP                               ResourceContributorRole = 119
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GUESTCONDUCTOR                     ResourceContributorRole = 120
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GROUPMEMBER                        ResourceContributorRole = 121
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_HAIRDRESSER                        ResourceContributorRole = 122
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ILLUSTRATOR                        ResourceContributorRole = 123
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INITIALPRODUCER                    ResourceContributorRole = 124
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWEDGUEST                   ResourceContributorRole = 125
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWER                        ResourceContributorRole = 126
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYCHARACTER                       ResourceContributorRole = 127
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYGRIP                            ResourceContributorRole = 128
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYTALENT                          ResourceContributorRole = 129
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADMAN                            ResourceContributorRole = 130
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADPERFORMER                      ResourceContributorRole = 131
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADVOCALIST                       ResourceContributorRole = 132
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGDIRECTOR                   ResourceContributorRole = 133
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGTECHNICIAN                 ResourceContributorRole = 134
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LOCATIONMANAGER                    ResourceContributorRole = 135
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAKEUPARTIST                       ResourceContributorRole = 136
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MANUFACTURER                       ResourceContributorRole = 137
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGENGINEER                  ResourceContributorRole = 138
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGSECONDENGINEER            ResourceContributorRole = 139
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MATTEARTIST                        ResourceContributorRole = 140
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXER                              ResourceContributorRole = 141
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGENGINEER                     ResourceContributorRole = 142
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGSECONDENGINEER               ResourceContributorRole = 143
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICARRANGER                      ResourceContributorRole = 144
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICCOPYIST                       ResourceContributorRole = 145
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICDIRECTOR                      ResourceContributorRole = 146
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICGROUP                         ResourceContributorRole = 147
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICIAN                           ResourceContributorRole = 148
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NARRATOR                           ResourceContributorRole = 149
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSPRODUCER                       ResourceContributorRole = 150
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSREADER                         ResourceContributorRole = 151
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NOTSPECIFIED                       ResourceContributorRole = 152
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRA                          ResourceContributorRole = 153
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRAMEMBER                    ResourceContributorRole = 154
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORIGINALARTIST                     ResourceContributorRole = 155
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBENGINEER                    ResourceContributorRole = 156
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBSECONDENGINEER              ResourceContributorRole = 157
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PAINTER                            ResourceContributorRole = 158
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PERFORMER                          ResourceContributorRole = 159
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHER                       ResourceContributorRole = 160
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHYDIRECTOR                ResourceContributorRole = 161
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PLAYBACKSINGER                     ResourceContributorRole = 162
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_POSTPRODUCER                       ResourceContributorRole = 163
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTION                      ResourceContributorRole = 164
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONENGINEER              ResourceContributorRole = 165
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONSECONDENGINEER        ResourceContributorRole = 166
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRIMARYMUSICIAN                    ResourceContributorRole = 167
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONASSISTANT                ResourceContributorRole = 168
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOMPANY                  ResourceContributorRole = 169
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOORDINATOR              ResourceContributorRole = 170
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONDEPARTMENT               ResourceContributorRole = 171
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONMANAGER                  ResourceContributorRole = 172
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONSECRETARY                ResourceContributorRole = 173
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROJECTENGINEER                    ResourceContributorRole = 174
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMER                         ResourceContributorRole = 175
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMINGENGINEER                ResourceContributorRole = 176
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMPRODUCER                    ResourceContributorRole = 177
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROPERTYMANAGER                    ResourceContributorRole = 178
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUBLISHINGDIRECTOR                 ResourceContributorRole = 179
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUPPETEER                          ResourceContributorRole = 180
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PYROTECHNICIAN                     ResourceContributorRole = 181
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGENGINEER                  ResourceContributorRole = 182
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGSECONDENGINEER            ResourceContributorRole = 183
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REDACTOR                           ResourceContributorRole = 184
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REISSUEPRODUCER                    ResourceContributorRole = 185
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXEDARTIST                      ResourceContributorRole = 186
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXER                            ResourceContributorRole = 187
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGENGINEER                   ResourceContributorRole = 188
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGSECONDENGINEER             ResourceContributorRole = 189
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REPETITEUR                         ResourceContributorRole = 190
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHER                         ResourceContributorRole = 191
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMHEAD                   ResourceContributorRole = 192
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMMEMBER                 ResourceContributorRole = 193
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESTAGER                           ResourceContributorRole = 194
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGGER                             ResourceContributorRole = 195
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGHTSCONTROLLERONPRODUCT          ResourceContributorRole = 196
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RUNNER                             ResourceContributorRole = 197
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCENICOPERATIVE                    ResourceContributorRole = 198
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCIENTIFICADVISOR                  ResourceContributorRole = 199
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCRIPTSUPERVISOR                   ResourceContributorRole = 200
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTCAMERAOPERATOR      ResourceContributorRole = 201
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTDIRECTOR            ResourceContributorRole = 202
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDCONDUCTOR                    ResourceContributorRole = 203
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDENGINEER                     ResourceContributorRole = 204
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDUNITDIRECTOR                 ResourceContributorRole = 205
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SERIESPRODUCER                     ResourceContributorRole = 206
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDESIGNER                        ResourceContributorRole = 207
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDRESSER                         ResourceContributorRole = 208
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SIGNLANGUAGEINTERPRETER            ResourceContributorRole = 209
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOLOIST                            ResourceContributorRole = 210
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDDESIGNER                      ResourceContributorRole = 211
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDMIXER                         ResourceContributorRole = 212
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDRECORDIST                     ResourceContributorRole = 213
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDSUPERVISOR                    ResourceContributorRole = 214
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPEAKER                            ResourceContributorRole = 215
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPECIALEFFECTSTECHNICIAN           ResourceContributorRole = 216
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPONSOR                            ResourceContributorRole = 217
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEASSISTANTENGINEER             ResourceContributorRole = 218
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEDIRECTOR                      ResourceContributorRole = 219
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEENGINEER                      ResourceContributorRole = 220
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STORYTELLER                        ResourceContributorRole = 221
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGENGINEER                     ResourceContributorRole = 222
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGPRODUCER                     ResourceContributorRole = 223
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGSDIRECTOR                    ResourceContributorRole = 224
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOCONDUCTOR                    ResourceContributorRole = 225
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOMUSICIAN                     ResourceContributorRole = 226
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPERSONNEL                    ResourceContributorRole = 227
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPRODUCER                     ResourceContributorRole = 228
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUNTS                             ResourceContributorRole = 229
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESEDITOR                    ResourceContributorRole = 230
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESTRANSLATOR                ResourceContributorRole = 231
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUPPORTINGACTOR                    ResourceContributorRole = 232
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGENGINEER             ResourceContributorRole = 233
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGSECONDENGINEER       ResourceContributorRole = 234
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TAPEOPERATOR                       ResourceContributorRole = 235
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TECHNICALDIRECTOR                  ResourceContributorRole = 236
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TONMEISTER                         ResourceContributorRole = 237
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGENGINEER                   ResourceContributorRole = 238
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGSECONDENGINEER             ResourceContributorRole = 239
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSFERSANDSAFETIESENGINEER       ResourceContributorRole = 240
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_UNSPECIFIED ResourceContributorRole = 0
+	// @xml: "Accompanyist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACCOMPANYIST ResourceContributorRole = 1
+	// @xml: "Actor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ACTOR ResourceContributorRole = 2
+	// @xml: "AdditionalEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALENGINEER ResourceContributorRole = 3
+	// @xml: "AdditionalMixingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALMIXINGENGINEER ResourceContributorRole = 4
+	// @xml: "AdditionalPerformer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPERFORMER ResourceContributorRole = 5
+	// @xml: "AdditionalProgrammingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALPROGRAMMINGENGINEER ResourceContributorRole = 6
+	// @xml: "AdditionalStudioProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ADDITIONALSTUDIOPRODUCER ResourceContributorRole = 7
+	// @xml: "AnchorPerson"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANCHORPERSON ResourceContributorRole = 8
+	// @xml: "AnimalTrainer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMALTRAINER ResourceContributorRole = 9
+	// @xml: "Animator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANIMATOR ResourceContributorRole = 10
+	// @xml: "Annotator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOTATOR ResourceContributorRole = 11
+	// @xml: "Announcer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ANNOUNCER ResourceContributorRole = 12
+	// @xml: "AAndRAdministrator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRADMINISTRATOR ResourceContributorRole = 13
+	// @xml: "AAndRCoordinator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AANDRCOORDINATOR ResourceContributorRole = 14
+	// @xml: "Armourer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARMOURER ResourceContributorRole = 15
+	// @xml: "ArtCopyist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTCOPYIST ResourceContributorRole = 16
+	// @xml: "ArtDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTDIRECTOR ResourceContributorRole = 17
+	// @xml: "Artist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTIST ResourceContributorRole = 18
+	// @xml: "ArtistBackgroundVocalEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTBACKGROUNDVOCALENGINEER ResourceContributorRole = 19
+	// @xml: "ArtistVocalEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALENGINEER ResourceContributorRole = 20
+	// @xml: "ArtistVocalSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ARTISTVOCALSECONDENGINEER ResourceContributorRole = 21
+	// @xml: "AssistantCameraOperator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCAMERAOPERATOR ResourceContributorRole = 22
+	// @xml: "AssistantChiefLightingTechnician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCHIEFLIGHTINGTECHNICIAN ResourceContributorRole = 23
+	// @xml: "AssistantConductor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTCONDUCTOR ResourceContributorRole = 24
+	// @xml: "AssistantDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTDIRECTOR ResourceContributorRole = 25
+	// @xml: "AssistantEditor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTEDITOR ResourceContributorRole = 26
+	// @xml: "AssistantEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTENGINEER ResourceContributorRole = 27
+	// @xml: "AssistantProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTPRODUCER ResourceContributorRole = 28
+	// @xml: "AssistantVisualEditor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSISTANTVISUALEDITOR ResourceContributorRole = 29
+	// @xml: "AssociatedPerformer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEDPERFORMER ResourceContributorRole = 30
+	// @xml: "AssociateProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ASSOCIATEPRODUCER ResourceContributorRole = 31
+	// @xml: "AuralTrainer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_AURALTRAINER ResourceContributorRole = 32
+	// @xml: "BackgroundVocalist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BACKGROUNDVOCALIST ResourceContributorRole = 33
+	// @xml: "BalanceEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BALANCEENGINEER ResourceContributorRole = 34
+	// @xml: "BandLeader"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BANDLEADER ResourceContributorRole = 35
+	// @xml: "Binder"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDER ResourceContributorRole = 36
+	// @xml: "BindingDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BINDINGDESIGNER ResourceContributorRole = 37
+	// @xml: "BookDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKDESIGNER ResourceContributorRole = 38
+	// @xml: "BookjackDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKJACKDESIGNER ResourceContributorRole = 39
+	// @xml: "BookplateDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPLATEDESIGNER ResourceContributorRole = 40
+	// @xml: "BookProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BOOKPRODUCER ResourceContributorRole = 41
+	// @xml: "BroadcastAssistant"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTASSISTANT ResourceContributorRole = 42
+	// @xml: "BroadcastJournalist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BROADCASTJOURNALIST ResourceContributorRole = 43
+	// @xml: "Calligrapher"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CALLIGRAPHER ResourceContributorRole = 44
+	// @xml: "CameraOperator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAMERAOPERATOR ResourceContributorRole = 45
+	// @xml: "Carpenter"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARPENTER ResourceContributorRole = 46
+	// @xml: "Cartographer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOGRAPHER ResourceContributorRole = 47
+	// @xml: "Cartoonist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CARTOONIST ResourceContributorRole = 48
+	// @xml: "CastingDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CASTINGDIRECTOR ResourceContributorRole = 49
+	// @xml: "Causeur"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CAUSEUR ResourceContributorRole = 50
+	// @xml: "Censor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CENSOR ResourceContributorRole = 51
+	// @xml: "ChiefLightingTechnician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHIEFLIGHTINGTECHNICIAN ResourceContributorRole = 52
+	// @xml: "Choir"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIR ResourceContributorRole = 53
+	// @xml: "ChoirMember"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOIRMEMBER ResourceContributorRole = 54
+	// @xml: "Choreographer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHOREOGRAPHER ResourceContributorRole = 55
+	// @xml: "ChorusMaster"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CHORUSMASTER ResourceContributorRole = 56
+	// @xml: "CircusArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CIRCUSARTIST ResourceContributorRole = 57
+	// @xml: "ClapperLoader"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLAPPERLOADER ResourceContributorRole = 58
+	// @xml: "ClubDJ"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CLUBDJ ResourceContributorRole = 59
+	// @xml: "CoDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CODIRECTOR ResourceContributorRole = 60
+	// @xml: "CoExecutiveProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COEXECUTIVEPRODUCER ResourceContributorRole = 61
+	// @xml: "ColorSeparator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COLORSEPARATOR ResourceContributorRole = 62
+	// @xml: "Comedian"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMEDIAN ResourceContributorRole = 63
+	// @xml: "CoMixer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXER ResourceContributorRole = 64
+	// @xml: "CoMixingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMIXINGENGINEER ResourceContributorRole = 65
+	// @xml: "Commentator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMENTATOR ResourceContributorRole = 66
+	// @xml: "CommissioningBroadcaster"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMMISSIONINGBROADCASTER ResourceContributorRole = 67
+	// @xml: "CompilationProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPILATIONPRODUCER ResourceContributorRole = 68
+	// @xml: "ComputerGraphicCreator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERGRAPHICCREATOR ResourceContributorRole = 69
+	// @xml: "ComputerProgrammer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COMPUTERPROGRAMMER ResourceContributorRole = 70
+	// @xml: "ConcertMaster"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONCERTMASTER ResourceContributorRole = 71
+	// @xml: "Conductor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONDUCTOR ResourceContributorRole = 72
+	// @xml: "Consultant"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONSULTANT ResourceContributorRole = 73
+	// @xml: "ContinuityChecker"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTINUITYCHECKER ResourceContributorRole = 74
+	// @xml: "Contractor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRACTOR ResourceContributorRole = 75
+	// @xml: "CoProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COPRODUCER ResourceContributorRole = 76
+	// @xml: "Correspondent"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CORRESPONDENT ResourceContributorRole = 77
+	// @xml: "CostumeDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COSTUMEDESIGNER ResourceContributorRole = 78
+	// @xml: "CoverDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_COVERDESIGNER ResourceContributorRole = 79
+	// @xml: "Dancer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DANCER ResourceContributorRole = 80
+	// @xml: "Delineator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DELINEATOR ResourceContributorRole = 81
+	// @xml: "Designer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DESIGNER ResourceContributorRole = 82
+	// @xml: "DialogueCoach"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUECOACH ResourceContributorRole = 83
+	// @xml: "DialogueDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIALOGUEDIRECTOR ResourceContributorRole = 84
+	// @xml: "DigitalAudioWorkstationEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALAUDIOWORKSTATIONENGINEER ResourceContributorRole = 85
+	// @xml: "DigitalEditingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGENGINEER ResourceContributorRole = 86
+	// @xml: "DigitalEditingSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIGITALEDITINGSECONDENGINEER ResourceContributorRole = 87
+	// @xml: "Director"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTOR ResourceContributorRole = 88
+	// @xml: "DirectStreamDigitalEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DIRECTSTREAMDIGITALENGINEER ResourceContributorRole = 89
+	// @xml: "DistributionCompany"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DISTRIBUTIONCOMPANY ResourceContributorRole = 90
+	// @xml: "DJ"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DJ ResourceContributorRole = 91
+	// @xml: "Draughtsman"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRAUGHTSMAN ResourceContributorRole = 92
+	// @xml: "Dresser"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DRESSER ResourceContributorRole = 93
+	// @xml: "Dubber"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_DUBBER ResourceContributorRole = 94
+	// @xml: "Editor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOR ResourceContributorRole = 95
+	// @xml: "EditorInChief"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITORINCHIEF ResourceContributorRole = 96
+	// @xml: "EditorOfTheDay"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EDITOROFTHEDAY ResourceContributorRole = 97
+	// @xml: "Encoder"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENCODER ResourceContributorRole = 98
+	// @xml: "Engineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENGINEER ResourceContributorRole = 99
+	// @xml: "Ensemble"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ENSEMBLE ResourceContributorRole = 100
+	// @xml: "ExecutiveProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXECUTIVEPRODUCER ResourceContributorRole = 101
+	// @xml: "Expert"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_EXPERT ResourceContributorRole = 102
+	// @xml: "Facsimilist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FACSIMILIST ResourceContributorRole = 103
+	// @xml: "FightDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FIGHTDIRECTOR ResourceContributorRole = 104
+	// @xml: "FilmDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDIRECTOR ResourceContributorRole = 105
+	// @xml: "FilmDistributor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMDISTRIBUTOR ResourceContributorRole = 106
+	// @xml: "FilmEditor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMEDITOR ResourceContributorRole = 107
+	// @xml: "FilmProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMPRODUCER ResourceContributorRole = 108
+	// @xml: "FilmSoundEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FILMSOUNDENGINEER ResourceContributorRole = 109
+	// @xml: "FloorManager"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FLOORMANAGER ResourceContributorRole = 110
+	// @xml: "FocusPuller"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOCUSPULLER ResourceContributorRole = 111
+	// @xml: "FoleyArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYARTIST ResourceContributorRole = 112
+	// @xml: "FoleyEditor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYEDITOR ResourceContributorRole = 113
+	// @xml: "FoleyMixer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FOLEYMIXER ResourceContributorRole = 114
+	// @xml: "GraphicArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICARTIST ResourceContributorRole = 115
+	// @xml: "GraphicAssistant"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICASSISTANT ResourceContributorRole = 116
+	// @xml: "GraphicDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRAPHICDESIGNER ResourceContributorRole = 117
+	// @xml: "Greensman"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GREENSMAN ResourceContributorRole = 118
+	// @xml: "Grip"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GRIP ResourceContributorRole = 119
+	// @xml: "GuestConductor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GUESTCONDUCTOR ResourceContributorRole = 120
+	// @xml: "GroupMember"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_GROUPMEMBER ResourceContributorRole = 121
+	// @xml: "Hairdresser"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_HAIRDRESSER ResourceContributorRole = 122
+	// @xml: "Illustrator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ILLUSTRATOR ResourceContributorRole = 123
+	// @xml: "InitialProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INITIALPRODUCER ResourceContributorRole = 124
+	// @xml: "InterviewedGuest"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWEDGUEST ResourceContributorRole = 125
+	// @xml: "Interviewer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_INTERVIEWER ResourceContributorRole = 126
+	// @xml: "KeyCharacter"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYCHARACTER ResourceContributorRole = 127
+	// @xml: "KeyGrip"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYGRIP ResourceContributorRole = 128
+	// @xml: "KeyTalent"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_KEYTALENT ResourceContributorRole = 129
+	// @xml: "Leadman"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADMAN ResourceContributorRole = 130
+	// @xml: "LeadPerformer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADPERFORMER ResourceContributorRole = 131
+	// @xml: "LeadVocalist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LEADVOCALIST ResourceContributorRole = 132
+	// @xml: "LightingDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGDIRECTOR ResourceContributorRole = 133
+	// @xml: "LightingTechnician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LIGHTINGTECHNICIAN ResourceContributorRole = 134
+	// @xml: "LocationManager"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_LOCATIONMANAGER ResourceContributorRole = 135
+	// @xml: "MakeUpArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAKEUPARTIST ResourceContributorRole = 136
+	// @xml: "Manufacturer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MANUFACTURER ResourceContributorRole = 137
+	// @xml: "MasteringEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGENGINEER ResourceContributorRole = 138
+	// @xml: "MasteringSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MASTERINGSECONDENGINEER ResourceContributorRole = 139
+	// @xml: "MatteArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MATTEARTIST ResourceContributorRole = 140
+	// @xml: "Mixer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXER ResourceContributorRole = 141
+	// @xml: "MixingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGENGINEER ResourceContributorRole = 142
+	// @xml: "MixingSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MIXINGSECONDENGINEER ResourceContributorRole = 143
+	// @xml: "MusicArranger"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICARRANGER ResourceContributorRole = 144
+	// @xml: "MusicCopyist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICCOPYIST ResourceContributorRole = 145
+	// @xml: "MusicDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICDIRECTOR ResourceContributorRole = 146
+	// @xml: "MusicGroup"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICGROUP ResourceContributorRole = 147
+	// @xml: "Musician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MUSICIAN ResourceContributorRole = 148
+	// @xml: "Narrator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NARRATOR ResourceContributorRole = 149
+	// @xml: "NewsProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSPRODUCER ResourceContributorRole = 150
+	// @xml: "NewsReader"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NEWSREADER ResourceContributorRole = 151
+	// @xml: "NotSpecified"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_NOTSPECIFIED ResourceContributorRole = 152
+	// @xml: "Orchestra"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRA ResourceContributorRole = 153
+	// @xml: "OrchestraMember"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORCHESTRAMEMBER ResourceContributorRole = 154
+	// @xml: "OriginalArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_ORIGINALARTIST ResourceContributorRole = 155
+	// @xml: "OverdubEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBENGINEER ResourceContributorRole = 156
+	// @xml: "OverdubSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_OVERDUBSECONDENGINEER ResourceContributorRole = 157
+	// @xml: "Painter"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PAINTER ResourceContributorRole = 158
+	// @xml: "Performer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PERFORMER ResourceContributorRole = 159
+	// @xml: "Photographer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHER ResourceContributorRole = 160
+	// @xml: "PhotographyDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PHOTOGRAPHYDIRECTOR ResourceContributorRole = 161
+	// @xml: "PlaybackSinger"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PLAYBACKSINGER ResourceContributorRole = 162
+	// @xml: "PostProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_POSTPRODUCER ResourceContributorRole = 163
+	// @xml: "PreProduction"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTION ResourceContributorRole = 164
+	// @xml: "PreProductionEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONENGINEER ResourceContributorRole = 165
+	// @xml: "PreProductionSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PREPRODUCTIONSECONDENGINEER ResourceContributorRole = 166
+	// @xml: "PrimaryMusician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRIMARYMUSICIAN ResourceContributorRole = 167
+	// @xml: "ProductionAssistant"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONASSISTANT ResourceContributorRole = 168
+	// @xml: "ProductionCompany"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOMPANY ResourceContributorRole = 169
+	// @xml: "ProductionCoordinator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONCOORDINATOR ResourceContributorRole = 170
+	// @xml: "ProductionDepartment"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONDEPARTMENT ResourceContributorRole = 171
+	// @xml: "ProductionManager"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONMANAGER ResourceContributorRole = 172
+	// @xml: "ProductionSecretary"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCTIONSECRETARY ResourceContributorRole = 173
+	// @xml: "ProjectEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROJECTENGINEER ResourceContributorRole = 174
+	// @xml: "Programmer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMER ResourceContributorRole = 175
+	// @xml: "ProgrammingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMMINGENGINEER ResourceContributorRole = 176
+	// @xml: "ProgramProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROGRAMPRODUCER ResourceContributorRole = 177
+	// @xml: "PropertyManager"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PROPERTYMANAGER ResourceContributorRole = 178
+	// @xml: "PublishingDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUBLISHINGDIRECTOR ResourceContributorRole = 179
+	// @xml: "Puppeteer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PUPPETEER ResourceContributorRole = 180
+	// @xml: "Pyrotechnician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PYROTECHNICIAN ResourceContributorRole = 181
+	// @xml: "RecordingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGENGINEER ResourceContributorRole = 182
+	// @xml: "RecordingSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RECORDINGSECONDENGINEER ResourceContributorRole = 183
+	// @xml: "Redactor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REDACTOR ResourceContributorRole = 184
+	// @xml: "ReissueProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REISSUEPRODUCER ResourceContributorRole = 185
+	// @xml: "RemixedArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXEDARTIST ResourceContributorRole = 186
+	// @xml: "Remixer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXER ResourceContributorRole = 187
+	// @xml: "RemixingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGENGINEER ResourceContributorRole = 188
+	// @xml: "RemixingSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REMIXINGSECONDENGINEER ResourceContributorRole = 189
+	// @xml: "Repetiteur"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_REPETITEUR ResourceContributorRole = 190
+	// @xml: "Researcher"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHER ResourceContributorRole = 191
+	// @xml: "ResearchTeamHead"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMHEAD ResourceContributorRole = 192
+	// @xml: "ResearchTeamMember"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESEARCHTEAMMEMBER ResourceContributorRole = 193
+	// @xml: "Restager"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RESTAGER ResourceContributorRole = 194
+	// @xml: "Rigger"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGGER ResourceContributorRole = 195
+	// @xml: "RightsControllerOnProduct"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RIGHTSCONTROLLERONPRODUCT ResourceContributorRole = 196
+	// @xml: "Runner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_RUNNER ResourceContributorRole = 197
+	// @xml: "ScenicOperative"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCENICOPERATIVE ResourceContributorRole = 198
+	// @xml: "ScientificAdvisor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCIENTIFICADVISOR ResourceContributorRole = 199
+	// @xml: "ScriptSupervisor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SCRIPTSUPERVISOR ResourceContributorRole = 200
+	// @xml: "SecondAssistantCameraOperator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTCAMERAOPERATOR ResourceContributorRole = 201
+	// @xml: "SecondAssistantDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDASSISTANTDIRECTOR ResourceContributorRole = 202
+	// @xml: "SecondConductor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDCONDUCTOR ResourceContributorRole = 203
+	// @xml: "SecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDENGINEER ResourceContributorRole = 204
+	// @xml: "SecondUnitDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SECONDUNITDIRECTOR ResourceContributorRole = 205
+	// @xml: "SeriesProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SERIESPRODUCER ResourceContributorRole = 206
+	// @xml: "SetDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDESIGNER ResourceContributorRole = 207
+	// @xml: "SetDresser"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SETDRESSER ResourceContributorRole = 208
+	// @xml: "SignLanguageInterpreter"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SIGNLANGUAGEINTERPRETER ResourceContributorRole = 209
+	// @xml: "Soloist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOLOIST ResourceContributorRole = 210
+	// @xml: "SoundDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDDESIGNER ResourceContributorRole = 211
+	// @xml: "SoundMixer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDMIXER ResourceContributorRole = 212
+	// @xml: "SoundRecordist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDRECORDIST ResourceContributorRole = 213
+	// @xml: "SoundSupervisor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SOUNDSUPERVISOR ResourceContributorRole = 214
+	// @xml: "Speaker"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPEAKER ResourceContributorRole = 215
+	// @xml: "SpecialEffectsTechnician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPECIALEFFECTSTECHNICIAN ResourceContributorRole = 216
+	// @xml: "Sponsor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SPONSOR ResourceContributorRole = 217
+	// @xml: "StageAssistantEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEASSISTANTENGINEER ResourceContributorRole = 218
+	// @xml: "StageDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEDIRECTOR ResourceContributorRole = 219
+	// @xml: "StageEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STAGEENGINEER ResourceContributorRole = 220
+	// @xml: "StoryTeller"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STORYTELLER ResourceContributorRole = 221
+	// @xml: "StringEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGENGINEER ResourceContributorRole = 222
+	// @xml: "StringProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGPRODUCER ResourceContributorRole = 223
+	// @xml: "StringsDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STRINGSDIRECTOR ResourceContributorRole = 224
+	// @xml: "StudioConductor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOCONDUCTOR ResourceContributorRole = 225
+	// @xml: "StudioMusician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOMUSICIAN ResourceContributorRole = 226
+	// @xml: "StudioPersonnel"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPERSONNEL ResourceContributorRole = 227
+	// @xml: "StudioProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUDIOPRODUCER ResourceContributorRole = 228
+	// @xml: "Stunts"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_STUNTS ResourceContributorRole = 229
+	// @xml: "SubtitlesEditor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESEDITOR ResourceContributorRole = 230
+	// @xml: "SubtitlesTranslator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUBTITLESTRANSLATOR ResourceContributorRole = 231
+	// @xml: "SupportingActor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SUPPORTINGACTOR ResourceContributorRole = 232
+	// @xml: "SurroundMixingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGENGINEER ResourceContributorRole = 233
+	// @xml: "SurroundMixingSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_SURROUNDMIXINGSECONDENGINEER ResourceContributorRole = 234
+	// @xml: "TapeOperator"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TAPEOPERATOR ResourceContributorRole = 235
+	// @xml: "TechnicalDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TECHNICALDIRECTOR ResourceContributorRole = 236
+	// @xml: "Tonmeister"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TONMEISTER ResourceContributorRole = 237
+	// @xml: "TrackingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGENGINEER ResourceContributorRole = 238
+	// @xml: "TrackingSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRACKINGSECONDENGINEER ResourceContributorRole = 239
+	// @xml: "TransfersAndSafetiesEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSFERSANDSAFETIESENGINEER ResourceContributorRole = 240
+	// @xml: "TransfersAndSafetiesSecondEngineer"
 	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSFERSANDSAFETIESSECONDENGINEER ResourceContributorRole = 241
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSPORTATIONMANAGER              ResourceContributorRole = 242
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TREATMENT_PROGRAMPROPOSAL          ResourceContributorRole = 243
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TYPEDESIGNER                       ResourceContributorRole = 244
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_UNKNOWN                            ResourceContributorRole = 245
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_USERDEFINED                        ResourceContributorRole = 246
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEODIRECTOR                      ResourceContributorRole = 247
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOGRAPHER                       ResourceContributorRole = 248
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOMUSICALDIRECTOR               ResourceContributorRole = 249
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOPRODUCER                      ResourceContributorRole = 250
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISIONMIXER                        ResourceContributorRole = 251
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEDITOR                       ResourceContributorRole = 252
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEFFECTSTECHNICIAN            ResourceContributorRole = 253
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALARRANGER                      ResourceContributorRole = 254
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGENGINEER               ResourceContributorRole = 255
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGSECONDENGINEER         ResourceContributorRole = 256
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALENGINEER                      ResourceContributorRole = 257
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALIST                           ResourceContributorRole = 258
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALSECONDENGINEER                ResourceContributorRole = 259
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALPRODUCER                      ResourceContributorRole = 260
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOICEACTOR                         ResourceContributorRole = 261
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_WARDROBE                           ResourceContributorRole = 262
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BAND                               ResourceContributorRole = 263
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRIBUTOR                        ResourceContributorRole = 264
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FEATUREDARTIST                     ResourceContributorRole = 265
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAINARTIST                         ResourceContributorRole = 266
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MEMBER                             ResourceContributorRole = 267
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCER                           ResourceContributorRole = 268
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_IMMERSIVEMIXINGENGINEER            ResourceContributorRole = 269
-	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRESENTER                          ResourceContributorRole = 270
+	// @xml: "TransportationManager"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TRANSPORTATIONMANAGER ResourceContributorRole = 242
+	// @xml: "Treatment/ProgramProposal"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TREATMENT_PROGRAMPROPOSAL ResourceContributorRole = 243
+	// @xml: "TypeDesigner"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_TYPEDESIGNER ResourceContributorRole = 244
+	// @xml: "Unknown"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_UNKNOWN ResourceContributorRole = 245
+	// @xml: "UserDefined"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_USERDEFINED ResourceContributorRole = 246
+	// @xml: "VideoDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEODIRECTOR ResourceContributorRole = 247
+	// @xml: "Videographer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOGRAPHER ResourceContributorRole = 248
+	// @xml: "VideoMusicalDirector"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOMUSICALDIRECTOR ResourceContributorRole = 249
+	// @xml: "VideoProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VIDEOPRODUCER ResourceContributorRole = 250
+	// @xml: "VisionMixer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISIONMIXER ResourceContributorRole = 251
+	// @xml: "VisualEditor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEDITOR ResourceContributorRole = 252
+	// @xml: "VisualEffectsTechnician"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VISUALEFFECTSTECHNICIAN ResourceContributorRole = 253
+	// @xml: "VocalArranger"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALARRANGER ResourceContributorRole = 254
+	// @xml: "VocalEditingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGENGINEER ResourceContributorRole = 255
+	// @xml: "VocalEditingSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALEDITINGSECONDENGINEER ResourceContributorRole = 256
+	// @xml: "VocalEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALENGINEER ResourceContributorRole = 257
+	// @xml: "Vocalist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALIST ResourceContributorRole = 258
+	// @xml: "VocalSecondEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALSECONDENGINEER ResourceContributorRole = 259
+	// @xml: "VocalProducer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOCALPRODUCER ResourceContributorRole = 260
+	// @xml: "VoiceActor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_VOICEACTOR ResourceContributorRole = 261
+	// @xml: "Wardrobe"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_WARDROBE ResourceContributorRole = 262
+	// @xml: "Band"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_BAND ResourceContributorRole = 263
+	// @xml: "Contributor"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_CONTRIBUTOR ResourceContributorRole = 264
+	// @xml: "FeaturedArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_FEATUREDARTIST ResourceContributorRole = 265
+	// @xml: "MainArtist"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MAINARTIST ResourceContributorRole = 266
+	// @xml: "Member"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_MEMBER ResourceContributorRole = 267
+	// @xml: "Producer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRODUCER ResourceContributorRole = 268
+	// @xml: "ImmersiveMixingEngineer"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_IMMERSIVEMIXINGENGINEER ResourceContributorRole = 269
+	// @xml: "Presenter"
+	ResourceContributorRole_RESOURCE_CONTRIBUTOR_ROLE_PRESENTER ResourceContributorRole = 270
 )
 
 // Enum value maps for ResourceContributorRole.
@@ -15237,11 +18849,15 @@ func (ResourceContributorRole) EnumDescriptor() ([]byte, []int) {
 type ResourceOmissionReason int32
 
 const (
-	ResourceOmissionReason_RESOURCE_OMISSION_REASON_UNSPECIFIED            ResourceOmissionReason = 0
+	ResourceOmissionReason_RESOURCE_OMISSION_REASON_UNSPECIFIED ResourceOmissionReason = 0
+	// @xml: "PassportServiceRelease"
 	ResourceOmissionReason_RESOURCE_OMISSION_REASON_PASSPORTSERVICERELEASE ResourceOmissionReason = 1
-	ResourceOmissionReason_RESOURCE_OMISSION_REASON_PRERELEASE             ResourceOmissionReason = 2
-	ResourceOmissionReason_RESOURCE_OMISSION_REASON_USERDEFINED            ResourceOmissionReason = 3
-	ResourceOmissionReason_RESOURCE_OMISSION_REASON_VIRTUALRELEASE         ResourceOmissionReason = 4
+	// @xml: "PreRelease"
+	ResourceOmissionReason_RESOURCE_OMISSION_REASON_PRERELEASE ResourceOmissionReason = 2
+	// @xml: "UserDefined"
+	ResourceOmissionReason_RESOURCE_OMISSION_REASON_USERDEFINED ResourceOmissionReason = 3
+	// @xml: "VirtualRelease"
+	ResourceOmissionReason_RESOURCE_OMISSION_REASON_VIRTUALRELEASE ResourceOmissionReason = 4
 )
 
 // Enum value maps for ResourceOmissionReason.
@@ -15292,15 +18908,23 @@ func (ResourceOmissionReason) EnumDescriptor() ([]byte, []int) {
 type ResourceType int32
 
 const (
-	ResourceType_RESOURCE_TYPE_UNSPECIFIED         ResourceType = 0
-	ResourceType_RESOURCE_TYPE_IMAGE               ResourceType = 1
-	ResourceType_RESOURCE_TYPE_MIDI                ResourceType = 2
-	ResourceType_RESOURCE_TYPE_SHEETMUSIC          ResourceType = 3
-	ResourceType_RESOURCE_TYPE_SOFTWARE            ResourceType = 4
-	ResourceType_RESOURCE_TYPE_SOUNDRECORDING      ResourceType = 5
-	ResourceType_RESOURCE_TYPE_TEXT                ResourceType = 6
+	ResourceType_RESOURCE_TYPE_UNSPECIFIED ResourceType = 0
+	// @xml: "Image"
+	ResourceType_RESOURCE_TYPE_IMAGE ResourceType = 1
+	// @xml: "MIDI"
+	ResourceType_RESOURCE_TYPE_MIDI ResourceType = 2
+	// @xml: "SheetMusic"
+	ResourceType_RESOURCE_TYPE_SHEETMUSIC ResourceType = 3
+	// @xml: "Software"
+	ResourceType_RESOURCE_TYPE_SOFTWARE ResourceType = 4
+	// @xml: "SoundRecording"
+	ResourceType_RESOURCE_TYPE_SOUNDRECORDING ResourceType = 5
+	// @xml: "Text"
+	ResourceType_RESOURCE_TYPE_TEXT ResourceType = 6
+	// @xml: "UserDefinedResource"
 	ResourceType_RESOURCE_TYPE_USERDEFINEDRESOURCE ResourceType = 7
-	ResourceType_RESOURCE_TYPE_VIDEO               ResourceType = 8
+	// @xml: "Video"
+	ResourceType_RESOURCE_TYPE_VIDEO ResourceType = 8
 )
 
 // Enum value maps for ResourceType.
@@ -15359,10 +18983,13 @@ func (ResourceType) EnumDescriptor() ([]byte, []int) {
 type RevenueSourceType int32
 
 const (
-	RevenueSourceType_REVENUE_SOURCE_TYPE_UNSPECIFIED      RevenueSourceType = 0
+	RevenueSourceType_REVENUE_SOURCE_TYPE_UNSPECIFIED RevenueSourceType = 0
+	// @xml: "FinancialRevenue"
 	RevenueSourceType_REVENUE_SOURCE_TYPE_FINANCIALREVENUE RevenueSourceType = 1
+	// @xml: "IndemnityRevenue"
 	RevenueSourceType_REVENUE_SOURCE_TYPE_INDEMNITYREVENUE RevenueSourceType = 2
-	RevenueSourceType_REVENUE_SOURCE_TYPE_ROYALTYREVENUE   RevenueSourceType = 3
+	// @xml: "RoyaltyRevenue"
+	RevenueSourceType_REVENUE_SOURCE_TYPE_ROYALTYREVENUE RevenueSourceType = 3
 )
 
 // Enum value maps for RevenueSourceType.
@@ -15411,11 +19038,15 @@ func (RevenueSourceType) EnumDescriptor() ([]byte, []int) {
 type RightShareType int32
 
 const (
-	RightShareType_RIGHT_SHARE_TYPE_UNSPECIFIED                RightShareType = 0
+	RightShareType_RIGHT_SHARE_TYPE_UNSPECIFIED RightShareType = 0
+	// @xml: "MusicalWorkManuscriptShare"
 	RightShareType_RIGHT_SHARE_TYPE_MUSICALWORKMANUSCRIPTSHARE RightShareType = 1
+	// @xml: "MusicalWorkCollectionShare"
 	RightShareType_RIGHT_SHARE_TYPE_MUSICALWORKCOLLECTIONSHARE RightShareType = 2
-	RightShareType_RIGHT_SHARE_TYPE_ORIGINALPUBLISHERSHARE     RightShareType = 3
-	RightShareType_RIGHT_SHARE_TYPE_LICENSINGSHARE             RightShareType = 4
+	// @xml: "OriginalPublisherShare"
+	RightShareType_RIGHT_SHARE_TYPE_ORIGINALPUBLISHERSHARE RightShareType = 3
+	// @xml: "LicensingShare"
+	RightShareType_RIGHT_SHARE_TYPE_LICENSINGSHARE RightShareType = 4
 )
 
 // Enum value maps for RightShareType.
@@ -15467,9 +19098,12 @@ type RightsClaimPolicyType int32
 
 const (
 	RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_UNSPECIFIED RightsClaimPolicyType = 0
+	// @xml: "ReportUsage"
 	RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_REPORTUSAGE RightsClaimPolicyType = 1
+	// @xml: "BlockAccess"
 	RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_BLOCKACCESS RightsClaimPolicyType = 2
-	RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_MONETIZE    RightsClaimPolicyType = 3
+	// @xml: "Monetize"
+	RightsClaimPolicyType_RIGHTS_CLAIM_POLICY_TYPE_MONETIZE RightsClaimPolicyType = 3
 )
 
 // Enum value maps for RightsClaimPolicyType.
@@ -15518,13 +19152,19 @@ func (RightsClaimPolicyType) EnumDescriptor() ([]byte, []int) {
 type RightsControllerRole int32
 
 const (
-	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_UNSPECIFIED                 RightsControllerRole = 0
+	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_UNSPECIFIED RightsControllerRole = 0
+	// @xml: "AdministratingRecordCompany"
 	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_ADMINISTRATINGRECORDCOMPANY RightsControllerRole = 1
-	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSADMINISTRATOR         RightsControllerRole = 2
-	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSCONTROLLER            RightsControllerRole = 3
-	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_ROYALTYADMINISTRATOR        RightsControllerRole = 4
-	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_UNKNOWN                     RightsControllerRole = 5
-	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_LOCALPAYEE                  RightsControllerRole = 6
+	// @xml: "RightsAdministrator"
+	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSADMINISTRATOR RightsControllerRole = 2
+	// @xml: "RightsController"
+	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_RIGHTSCONTROLLER RightsControllerRole = 3
+	// @xml: "RoyaltyAdministrator"
+	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_ROYALTYADMINISTRATOR RightsControllerRole = 4
+	// @xml: "Unknown"
+	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_UNKNOWN RightsControllerRole = 5
+	// @xml: "LocalPayee"
+	RightsControllerRole_RIGHTS_CONTROLLER_ROLE_LOCALPAYEE RightsControllerRole = 6
 )
 
 // Enum value maps for RightsControllerRole.
@@ -15579,9 +19219,12 @@ func (RightsControllerRole) EnumDescriptor() ([]byte, []int) {
 type RightsControllerType int32
 
 const (
-	RightsControllerType_RIGHTS_CONTROLLER_TYPE_UNSPECIFIED       RightsControllerType = 0
-	RightsControllerType_RIGHTS_CONTROLLER_TYPE_ORIGINALOWNER     RightsControllerType = 1
-	RightsControllerType_RIGHTS_CONTROLLER_TYPE_SUCCESSORINTITLE  RightsControllerType = 2
+	RightsControllerType_RIGHTS_CONTROLLER_TYPE_UNSPECIFIED RightsControllerType = 0
+	// @xml: "OriginalOwner"
+	RightsControllerType_RIGHTS_CONTROLLER_TYPE_ORIGINALOWNER RightsControllerType = 1
+	// @xml: "SuccessorInTitle"
+	RightsControllerType_RIGHTS_CONTROLLER_TYPE_SUCCESSORINTITLE RightsControllerType = 2
+	// @xml: "ExclusiveLicensee"
 	RightsControllerType_RIGHTS_CONTROLLER_TYPE_EXCLUSIVELICENSEE RightsControllerType = 3
 )
 
@@ -15631,14 +19274,21 @@ func (RightsControllerType) EnumDescriptor() ([]byte, []int) {
 type RightsCoverage int32
 
 const (
-	RightsCoverage_RIGHTS_COVERAGE_UNSPECIFIED          RightsCoverage = 0
-	RightsCoverage_RIGHTS_COVERAGE_MAKEAVAILABLERIGHT   RightsCoverage = 1
-	RightsCoverage_RIGHTS_COVERAGE_MECHANICALRIGHT      RightsCoverage = 2
-	RightsCoverage_RIGHTS_COVERAGE_PERFORMINGRIGHT      RightsCoverage = 3
-	RightsCoverage_RIGHTS_COVERAGE_PRINTRIGHT           RightsCoverage = 4
-	RightsCoverage_RIGHTS_COVERAGE_REPRODUCTIONRIGHT    RightsCoverage = 5
+	RightsCoverage_RIGHTS_COVERAGE_UNSPECIFIED RightsCoverage = 0
+	// @xml: "MakeAvailableRight"
+	RightsCoverage_RIGHTS_COVERAGE_MAKEAVAILABLERIGHT RightsCoverage = 1
+	// @xml: "MechanicalRight"
+	RightsCoverage_RIGHTS_COVERAGE_MECHANICALRIGHT RightsCoverage = 2
+	// @xml: "PerformingRight"
+	RightsCoverage_RIGHTS_COVERAGE_PERFORMINGRIGHT RightsCoverage = 3
+	// @xml: "PrintRight"
+	RightsCoverage_RIGHTS_COVERAGE_PRINTRIGHT RightsCoverage = 4
+	// @xml: "ReproductionRight"
+	RightsCoverage_RIGHTS_COVERAGE_REPRODUCTIONRIGHT RightsCoverage = 5
+	// @xml: "SynchronizationRight"
 	RightsCoverage_RIGHTS_COVERAGE_SYNCHRONIZATIONRIGHT RightsCoverage = 6
-	RightsCoverage_RIGHTS_COVERAGE_USERDEFINED          RightsCoverage = 7
+	// @xml: "UserDefined"
+	RightsCoverage_RIGHTS_COVERAGE_USERDEFINED RightsCoverage = 7
 )
 
 // Enum value maps for RightsCoverage.
@@ -15695,17 +19345,27 @@ func (RightsCoverage) EnumDescriptor() ([]byte, []int) {
 type RoyaltyRateCalculationType int32
 
 const (
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_UNSPECIFIED                      RoyaltyRateCalculationType = 0
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_BUDGETROYALTYRATE                RoyaltyRateCalculationType = 1
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_UNSPECIFIED RoyaltyRateCalculationType = 0
+	// @xml: "BudgetRoyaltyRate"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_BUDGETROYALTYRATE RoyaltyRateCalculationType = 1
+	// @xml: "ControlledCompositionRoyaltyRate"
 	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_CONTROLLEDCOMPOSITIONROYALTYRATE RoyaltyRateCalculationType = 2
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_CONTROLLEDSHAREROYALTYRATE       RoyaltyRateCalculationType = 3
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_MINIMUMSTATUTORYROYALTYRATE      RoyaltyRateCalculationType = 4
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_NEGOTIATEDROYALTYRATE            RoyaltyRateCalculationType = 5
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDROYALTYRATE               RoyaltyRateCalculationType = 6
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDSTATUTORYROYALTYRATE      RoyaltyRateCalculationType = 7
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_STATUTORYROYALTYRATE             RoyaltyRateCalculationType = 8
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_PPD                              RoyaltyRateCalculationType = 9
-	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_RETAILPRICE                      RoyaltyRateCalculationType = 10
+	// @xml: "ControlledShareRoyaltyRate"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_CONTROLLEDSHAREROYALTYRATE RoyaltyRateCalculationType = 3
+	// @xml: "MinimumStatutoryRoyaltyRate"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_MINIMUMSTATUTORYROYALTYRATE RoyaltyRateCalculationType = 4
+	// @xml: "NegotiatedRoyaltyRate"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_NEGOTIATEDROYALTYRATE RoyaltyRateCalculationType = 5
+	// @xml: "ReducedRoyaltyRate"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDROYALTYRATE RoyaltyRateCalculationType = 6
+	// @xml: "ReducedStatutoryRoyaltyRate"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_REDUCEDSTATUTORYROYALTYRATE RoyaltyRateCalculationType = 7
+	// @xml: "StatutoryRoyaltyRate"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_STATUTORYROYALTYRATE RoyaltyRateCalculationType = 8
+	// @xml: "PPD"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_PPD RoyaltyRateCalculationType = 9
+	// @xml: "RetailPrice"
+	RoyaltyRateCalculationType_ROYALTY_RATE_CALCULATION_TYPE_RETAILPRICE RoyaltyRateCalculationType = 10
 )
 
 // Enum value maps for RoyaltyRateCalculationType.
@@ -15768,10 +19428,13 @@ func (RoyaltyRateCalculationType) EnumDescriptor() ([]byte, []int) {
 type RoyaltyRateType int32
 
 const (
-	RoyaltyRateType_ROYALTY_RATE_TYPE_UNSPECIFIED           RoyaltyRateType = 0
-	RoyaltyRateType_ROYALTY_RATE_TYPE_PENNYRATE             RoyaltyRateType = 1
+	RoyaltyRateType_ROYALTY_RATE_TYPE_UNSPECIFIED RoyaltyRateType = 0
+	// @xml: "PennyRate"
+	RoyaltyRateType_ROYALTY_RATE_TYPE_PENNYRATE RoyaltyRateType = 1
+	// @xml: "PercentageRoyaltyRate"
 	RoyaltyRateType_ROYALTY_RATE_TYPE_PERCENTAGEROYALTYRATE RoyaltyRateType = 2
-	RoyaltyRateType_ROYALTY_RATE_TYPE_USERDEFINED           RoyaltyRateType = 3
+	// @xml: "UserDefined"
+	RoyaltyRateType_ROYALTY_RATE_TYPE_USERDEFINED RoyaltyRateType = 3
 )
 
 // Enum value maps for RoyaltyRateType.
@@ -15820,8 +19483,10 @@ func (RoyaltyRateType) EnumDescriptor() ([]byte, []int) {
 type SalesReportAvailabilityStatus int32
 
 const (
-	SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_UNSPECIFIED             SalesReportAvailabilityStatus = 0
-	SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_SALESREPORTAVAILABLE    SalesReportAvailabilityStatus = 1
+	SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_UNSPECIFIED SalesReportAvailabilityStatus = 0
+	// @xml: "SalesReportAvailable"
+	SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_SALESREPORTAVAILABLE SalesReportAvailabilityStatus = 1
+	// @xml: "SalesReportNotAvailable"
 	SalesReportAvailabilityStatus_SALES_REPORT_AVAILABILITY_STATUS_SALESREPORTNOTAVAILABLE SalesReportAvailabilityStatus = 2
 )
 
@@ -15870,9 +19535,12 @@ type Sex int32
 
 const (
 	Sex_SEX_UNSPECIFIED Sex = 0
-	Sex_SEX_FEMALE      Sex = 1
-	Sex_SEX_MALE        Sex = 2
-	Sex_SEX_UNKNOWN     Sex = 3
+	// @xml: "Female"
+	Sex_SEX_FEMALE Sex = 1
+	// @xml: "Male"
+	Sex_SEX_MALE Sex = 2
+	// @xml: "Unknown"
+	Sex_SEX_UNKNOWN Sex = 3
 )
 
 // Enum value maps for Sex.
@@ -15921,13 +19589,19 @@ func (Sex) EnumDescriptor() ([]byte, []int) {
 type SoftwareType int32
 
 const (
-	SoftwareType_SOFTWARE_TYPE_UNSPECIFIED             SoftwareType = 0
-	SoftwareType_SOFTWARE_TYPE_INTERACTIVEBOOKLET      SoftwareType = 1
-	SoftwareType_SOFTWARE_TYPE_MUSICALWORKBASEDGAME    SoftwareType = 2
+	SoftwareType_SOFTWARE_TYPE_UNSPECIFIED SoftwareType = 0
+	// @xml: "InteractiveBooklet"
+	SoftwareType_SOFTWARE_TYPE_INTERACTIVEBOOKLET SoftwareType = 1
+	// @xml: "MusicalWorkBasedGame"
+	SoftwareType_SOFTWARE_TYPE_MUSICALWORKBASEDGAME SoftwareType = 2
+	// @xml: "NonMusicalWorkBasedGame"
 	SoftwareType_SOFTWARE_TYPE_NONMUSICALWORKBASEDGAME SoftwareType = 3
-	SoftwareType_SOFTWARE_TYPE_SCREENSAVER             SoftwareType = 4
-	SoftwareType_SOFTWARE_TYPE_UNKNOWN                 SoftwareType = 5
-	SoftwareType_SOFTWARE_TYPE_USERDEFINED             SoftwareType = 6
+	// @xml: "Screensaver"
+	SoftwareType_SOFTWARE_TYPE_SCREENSAVER SoftwareType = 4
+	// @xml: "Unknown"
+	SoftwareType_SOFTWARE_TYPE_UNKNOWN SoftwareType = 5
+	// @xml: "UserDefined"
+	SoftwareType_SOFTWARE_TYPE_USERDEFINED SoftwareType = 6
 )
 
 // Enum value maps for SoftwareType.
@@ -15982,12 +19656,17 @@ func (SoftwareType) EnumDescriptor() ([]byte, []int) {
 type SoundProcessorType int32
 
 const (
-	SoundProcessorType_SOUND_PROCESSOR_TYPE_UNSPECIFIED   SoundProcessorType = 0
+	SoundProcessorType_SOUND_PROCESSOR_TYPE_UNSPECIFIED SoundProcessorType = 0
+	// @xml: "MidiProcessor"
 	SoundProcessorType_SOUND_PROCESSOR_TYPE_MIDIPROCESSOR SoundProcessorType = 1
-	SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA2      SoundProcessorType = 2
-	SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA3      SoundProcessorType = 3
-	SoundProcessorType_SOUND_PROCESSOR_TYPE_UNKNOWN       SoundProcessorType = 4
-	SoundProcessorType_SOUND_PROCESSOR_TYPE_USERDEFINED   SoundProcessorType = 5
+	// @xml: "SMAF-MA2"
+	SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA2 SoundProcessorType = 2
+	// @xml: "SMAF-MA3"
+	SoundProcessorType_SOUND_PROCESSOR_TYPE_SMAF_MA3 SoundProcessorType = 3
+	// @xml: "Unknown"
+	SoundProcessorType_SOUND_PROCESSOR_TYPE_UNKNOWN SoundProcessorType = 4
+	// @xml: "UserDefined"
+	SoundProcessorType_SOUND_PROCESSOR_TYPE_USERDEFINED SoundProcessorType = 5
 )
 
 // Enum value maps for SoundProcessorType.
@@ -16040,15 +19719,23 @@ func (SoundProcessorType) EnumDescriptor() ([]byte, []int) {
 type SoundRecordingType int32
 
 const (
-	SoundRecordingType_SOUND_RECORDING_TYPE_UNSPECIFIED                           SoundRecordingType = 0
-	SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKREADALONGSOUNDRECORDING    SoundRecordingType = 1
-	SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKSOUNDRECORDING             SoundRecordingType = 2
+	SoundRecordingType_SOUND_RECORDING_TYPE_UNSPECIFIED SoundRecordingType = 0
+	// @xml: "MusicalWorkReadalongSoundRecording"
+	SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKREADALONGSOUNDRECORDING SoundRecordingType = 1
+	// @xml: "MusicalWorkSoundRecording"
+	SoundRecordingType_SOUND_RECORDING_TYPE_MUSICALWORKSOUNDRECORDING SoundRecordingType = 2
+	// @xml: "NonMusicalWorkReadalongSoundRecording"
 	SoundRecordingType_SOUND_RECORDING_TYPE_NONMUSICALWORKREADALONGSOUNDRECORDING SoundRecordingType = 3
-	SoundRecordingType_SOUND_RECORDING_TYPE_NONMUSICALWORKSOUNDRECORDING          SoundRecordingType = 4
-	SoundRecordingType_SOUND_RECORDING_TYPE_SPOKENWORDSOUNDRECORDING              SoundRecordingType = 5
-	SoundRecordingType_SOUND_RECORDING_TYPE_UNKNOWN                               SoundRecordingType = 6
-	SoundRecordingType_SOUND_RECORDING_TYPE_USERDEFINED                           SoundRecordingType = 7
-	SoundRecordingType_SOUND_RECORDING_TYPE_AUDIOSTEM                             SoundRecordingType = 8
+	// @xml: "NonMusicalWorkSoundRecording"
+	SoundRecordingType_SOUND_RECORDING_TYPE_NONMUSICALWORKSOUNDRECORDING SoundRecordingType = 4
+	// @xml: "SpokenWordSoundRecording"
+	SoundRecordingType_SOUND_RECORDING_TYPE_SPOKENWORDSOUNDRECORDING SoundRecordingType = 5
+	// @xml: "Unknown"
+	SoundRecordingType_SOUND_RECORDING_TYPE_UNKNOWN SoundRecordingType = 6
+	// @xml: "UserDefined"
+	SoundRecordingType_SOUND_RECORDING_TYPE_USERDEFINED SoundRecordingType = 7
+	// @xml: "AudioStem"
+	SoundRecordingType_SOUND_RECORDING_TYPE_AUDIOSTEM SoundRecordingType = 8
 )
 
 // Enum value maps for SoundRecordingType.
@@ -16107,21 +19794,35 @@ func (SoundRecordingType) EnumDescriptor() ([]byte, []int) {
 type SupplyChainStatus int32
 
 const (
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_UNSPECIFIED                                  SupplyChainStatus = 0
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_DELIVEREDTORELEASEDISTRIBUTOR                SupplyChainStatus = 1
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_INDELIVERYTORELEASEDISTRIBUTOR               SupplyChainStatus = 2
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_UNSPECIFIED SupplyChainStatus = 0
+	// @xml: "DeliveredToReleaseDistributor"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_DELIVEREDTORELEASEDISTRIBUTOR SupplyChainStatus = 1
+	// @xml: "InDeliveryToReleaseDistributor"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_INDELIVERYTORELEASEDISTRIBUTOR SupplyChainStatus = 2
+	// @xml: "InPreparationForDeliveryToReleaseDistributor"
 	SupplyChainStatus_SUPPLY_CHAIN_STATUS_INPREPARATIONFORDELIVERYTORELEASEDISTRIBUTOR SupplyChainStatus = 3
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_ORDERPLACEDFORRELEASEDISTRIBUTOR             SupplyChainStatus = 4
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASECREATOR              SupplyChainStatus = 5
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASEDISTRIBUTOR          SupplyChainStatus = 6
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEMADEAVAILABLETOCONSUMERS              SupplyChainStatus = 7
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASENOTAVAILABLE                          SupplyChainStatus = 8
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASERECEIVEDBYRELEASEDISTRIBUTOR          SupplyChainStatus = 9
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASESTAGEDFORPUBLICATION                  SupplyChainStatus = 10
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_SUCCESSFULLYINGESTEDBYRELEASEDISTRIBUTOR     SupplyChainStatus = 11
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_USERDEFINED                                  SupplyChainStatus = 12
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEVIOLATESTERMSOFSERVICE                SupplyChainStatus = 13
-	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RIGHTSCONFLICT                               SupplyChainStatus = 14
+	// @xml: "OrderPlacedForReleaseDistributor"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_ORDERPLACEDFORRELEASEDISTRIBUTOR SupplyChainStatus = 4
+	// @xml: "ProcessingErrorAtReleaseCreator"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASECREATOR SupplyChainStatus = 5
+	// @xml: "ProcessingErrorAtReleaseDistributor"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_PROCESSINGERRORATRELEASEDISTRIBUTOR SupplyChainStatus = 6
+	// @xml: "ReleaseMadeAvailableToConsumers"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEMADEAVAILABLETOCONSUMERS SupplyChainStatus = 7
+	// @xml: "ReleaseNotAvailable"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASENOTAVAILABLE SupplyChainStatus = 8
+	// @xml: "ReleaseReceivedByReleaseDistributor"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASERECEIVEDBYRELEASEDISTRIBUTOR SupplyChainStatus = 9
+	// @xml: "ReleaseStagedForPublication"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASESTAGEDFORPUBLICATION SupplyChainStatus = 10
+	// @xml: "SuccessfullyIngestedByReleaseDistributor"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_SUCCESSFULLYINGESTEDBYRELEASEDISTRIBUTOR SupplyChainStatus = 11
+	// @xml: "UserDefined"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_USERDEFINED SupplyChainStatus = 12
+	// @xml: "ReleaseViolatesTermsOfService"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RELEASEVIOLATESTERMSOFSERVICE SupplyChainStatus = 13
+	// @xml: "RightsConflict"
+	SupplyChainStatus_SUPPLY_CHAIN_STATUS_RIGHTSCONFLICT SupplyChainStatus = 14
 )
 
 // Enum value maps for SupplyChainStatus.
@@ -16192,13 +19893,19 @@ func (SupplyChainStatus) EnumDescriptor() ([]byte, []int) {
 type TaxScope int32
 
 const (
-	TaxScope_TAX_SCOPE_UNSPECIFIED   TaxScope = 0
-	TaxScope_TAX_SCOPE_COMBINEDTAX   TaxScope = 1
-	TaxScope_TAX_SCOPE_FEDERALTAX    TaxScope = 2
-	TaxScope_TAX_SCOPE_LOCALTAX      TaxScope = 3
+	TaxScope_TAX_SCOPE_UNSPECIFIED TaxScope = 0
+	// @xml: "CombinedTax"
+	TaxScope_TAX_SCOPE_COMBINEDTAX TaxScope = 1
+	// @xml: "FederalTax"
+	TaxScope_TAX_SCOPE_FEDERALTAX TaxScope = 2
+	// @xml: "LocalTax"
+	TaxScope_TAX_SCOPE_LOCALTAX TaxScope = 3
+	// @xml: "ProvincialTax"
 	TaxScope_TAX_SCOPE_PROVINCIALTAX TaxScope = 4
-	TaxScope_TAX_SCOPE_STATETAX      TaxScope = 5
-	TaxScope_TAX_SCOPE_USERDEFINED   TaxScope = 6
+	// @xml: "StateTax"
+	TaxScope_TAX_SCOPE_STATETAX TaxScope = 5
+	// @xml: "UserDefined"
+	TaxScope_TAX_SCOPE_USERDEFINED TaxScope = 6
 )
 
 // Enum value maps for TaxScope.
@@ -16254,10 +19961,15 @@ type TaxType int32
 
 const (
 	TaxType_TAX_TYPE_UNSPECIFIED TaxType = 0
+	// @xml: "CombinedTax"
 	TaxType_TAX_TYPE_COMBINEDTAX TaxType = 1
-	TaxType_TAX_TYPE_SALESTAX    TaxType = 2
-	TaxType_TAX_TYPE_SERVICETAX  TaxType = 3
-	TaxType_TAX_TYPE_SOURCETAX   TaxType = 4
+	// @xml: "SalesTax"
+	TaxType_TAX_TYPE_SALESTAX TaxType = 2
+	// @xml: "ServiceTax"
+	TaxType_TAX_TYPE_SERVICETAX TaxType = 3
+	// @xml: "SourceTax"
+	TaxType_TAX_TYPE_SOURCETAX TaxType = 4
+	// @xml: "UserDefined"
 	TaxType_TAX_TYPE_USERDEFINED TaxType = 5
 )
 
@@ -16312,8 +20024,10 @@ type TerritoryCodeType int32
 
 const (
 	TerritoryCodeType_TERRITORY_CODE_TYPE_UNSPECIFIED TerritoryCodeType = 0
-	TerritoryCodeType_TERRITORY_CODE_TYPE_ISO         TerritoryCodeType = 1
-	TerritoryCodeType_TERRITORY_CODE_TYPE_TIS         TerritoryCodeType = 2
+	// @xml: "ISO"
+	TerritoryCodeType_TERRITORY_CODE_TYPE_ISO TerritoryCodeType = 1
+	// @xml: "TIS"
+	TerritoryCodeType_TERRITORY_CODE_TYPE_TIS TerritoryCodeType = 2
 )
 
 // Enum value maps for TerritoryCodeType.
@@ -16360,10 +20074,13 @@ func (TerritoryCodeType) EnumDescriptor() ([]byte, []int) {
 type TerritoryCodeTypeIncludingDeprecatedCodes int32
 
 const (
-	TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_UNSPECIFIED   TerritoryCodeTypeIncludingDeprecatedCodes = 0
+	TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_UNSPECIFIED TerritoryCodeTypeIncludingDeprecatedCodes = 0
+	// @xml: "DeprecatedISO"
 	TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_DEPRECATEDISO TerritoryCodeTypeIncludingDeprecatedCodes = 1
-	TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_ISO           TerritoryCodeTypeIncludingDeprecatedCodes = 2
-	TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_TIS           TerritoryCodeTypeIncludingDeprecatedCodes = 3
+	// @xml: "ISO"
+	TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_ISO TerritoryCodeTypeIncludingDeprecatedCodes = 2
+	// @xml: "TIS"
+	TerritoryCodeTypeIncludingDeprecatedCodes_TERRITORY_CODE_TYPE_INCLUDING_DEPRECATED_CODES_TIS TerritoryCodeTypeIncludingDeprecatedCodes = 3
 )
 
 // Enum value maps for TerritoryCodeTypeIncludingDeprecatedCodes.
@@ -16412,30 +20129,53 @@ func (TerritoryCodeTypeIncludingDeprecatedCodes) EnumDescriptor() ([]byte, []int
 type TextCodecType int32
 
 const (
-	TextCodecType_TEXT_CODEC_TYPE_UNSPECIFIED         TextCodecType = 0
-	TextCodecType_TEXT_CODEC_TYPE_ASCII               TextCodecType = 1
-	TextCodecType_TEXT_CODEC_TYPE_EBU_TT              TextCodecType = 2
-	TextCodecType_TEXT_CODEC_TYPE_HTML                TextCodecType = 3
-	TextCodecType_TEXT_CODEC_TYPE_OOXML               TextCodecType = 4
-	TextCodecType_TEXT_CODEC_TYPE_PDF                 TextCodecType = 5
-	TextCodecType_TEXT_CODEC_TYPE_POSTSCRIPT          TextCodecType = 6
-	TextCodecType_TEXT_CODEC_TYPE_RTF                 TextCodecType = 7
-	TextCodecType_TEXT_CODEC_TYPE_SRT                 TextCodecType = 8
-	TextCodecType_TEXT_CODEC_TYPE_TTML                TextCodecType = 9
-	TextCodecType_TEXT_CODEC_TYPE_UNKNOWN             TextCodecType = 10
-	TextCodecType_TEXT_CODEC_TYPE_USERDEFINED         TextCodecType = 11
-	TextCodecType_TEXT_CODEC_TYPE_VTT                 TextCodecType = 12
+	TextCodecType_TEXT_CODEC_TYPE_UNSPECIFIED TextCodecType = 0
+	// @xml: "ASCII"
+	TextCodecType_TEXT_CODEC_TYPE_ASCII TextCodecType = 1
+	// @xml: "EBU-TT"
+	TextCodecType_TEXT_CODEC_TYPE_EBU_TT TextCodecType = 2
+	// @xml: "HTML"
+	TextCodecType_TEXT_CODEC_TYPE_HTML TextCodecType = 3
+	// @xml: "OOXML"
+	TextCodecType_TEXT_CODEC_TYPE_OOXML TextCodecType = 4
+	// @xml: "PDF"
+	TextCodecType_TEXT_CODEC_TYPE_PDF TextCodecType = 5
+	// @xml: "PostScript"
+	TextCodecType_TEXT_CODEC_TYPE_POSTSCRIPT TextCodecType = 6
+	// @xml: "RTF"
+	TextCodecType_TEXT_CODEC_TYPE_RTF TextCodecType = 7
+	// @xml: "SRT"
+	TextCodecType_TEXT_CODEC_TYPE_SRT TextCodecType = 8
+	// @xml: "TTML"
+	TextCodecType_TEXT_CODEC_TYPE_TTML TextCodecType = 9
+	// @xml: "Unknown"
+	TextCodecType_TEXT_CODEC_TYPE_UNKNOWN TextCodecType = 10
+	// @xml: "UserDefined"
+	TextCodecType_TEXT_CODEC_TYPE_USERDEFINED TextCodecType = 11
+	// @xml: "VTT"
+	TextCodecType_TEXT_CODEC_TYPE_VTT TextCodecType = 12
+	// @xml: "AsciiOrIso8859nText"
 	TextCodecType_TEXT_CODEC_TYPE_ASCIIORISO8859NTEXT TextCodecType = 13
-	TextCodecType_TEXT_CODEC_TYPE_ENHANCEDLRC         TextCodecType = 14
-	TextCodecType_TEXT_CODEC_TYPE_EPUB                TextCodecType = 15
-	TextCodecType_TEXT_CODEC_TYPE_LRC                 TextCodecType = 16
-	TextCodecType_TEXT_CODEC_TYPE_MICROSOFTWORD       TextCodecType = 17
-	TextCodecType_TEXT_CODEC_TYPE_OPENDOCUMENTTEXT    TextCodecType = 18
-	TextCodecType_TEXT_CODEC_TYPE_SIMPLELRC           TextCodecType = 19
-	TextCodecType_TEXT_CODEC_TYPE_UTF8TEXT            TextCodecType = 20
-	TextCodecType_TEXT_CODEC_TYPE_WINDOWSTEXT         TextCodecType = 21
-	TextCodecType_TEXT_CODEC_TYPE_XHTML               TextCodecType = 22
-	TextCodecType_TEXT_CODEC_TYPE_XML                 TextCodecType = 23
+	// @xml: "EnhancedLRC"
+	TextCodecType_TEXT_CODEC_TYPE_ENHANCEDLRC TextCodecType = 14
+	// @xml: "EPUB"
+	TextCodecType_TEXT_CODEC_TYPE_EPUB TextCodecType = 15
+	// @xml: "LRC"
+	TextCodecType_TEXT_CODEC_TYPE_LRC TextCodecType = 16
+	// @xml: "MicrosoftWord"
+	TextCodecType_TEXT_CODEC_TYPE_MICROSOFTWORD TextCodecType = 17
+	// @xml: "OpenDocumentText"
+	TextCodecType_TEXT_CODEC_TYPE_OPENDOCUMENTTEXT TextCodecType = 18
+	// @xml: "SimpleLRC"
+	TextCodecType_TEXT_CODEC_TYPE_SIMPLELRC TextCodecType = 19
+	// @xml: "UTF8Text"
+	TextCodecType_TEXT_CODEC_TYPE_UTF8TEXT TextCodecType = 20
+	// @xml: "WindowsText"
+	TextCodecType_TEXT_CODEC_TYPE_WINDOWSTEXT TextCodecType = 21
+	// @xml: "XHTML"
+	TextCodecType_TEXT_CODEC_TYPE_XHTML TextCodecType = 22
+	// @xml: "XML"
+	TextCodecType_TEXT_CODEC_TYPE_XML TextCodecType = 23
 )
 
 // Enum value maps for TextCodecType.
@@ -16524,15 +20264,23 @@ func (TextCodecType) EnumDescriptor() ([]byte, []int) {
 type TextType int32
 
 const (
-	TextType_TEXT_TYPE_UNSPECIFIED           TextType = 0
-	TextType_TEXT_TYPE_CAPTION               TextType = 1
-	TextType_TEXT_TYPE_EBOOK                 TextType = 2
-	TextType_TEXT_TYPE_LINERNOTES            TextType = 3
-	TextType_TEXT_TYPE_LYRICTEXT             TextType = 4
+	TextType_TEXT_TYPE_UNSPECIFIED TextType = 0
+	// @xml: "Caption"
+	TextType_TEXT_TYPE_CAPTION TextType = 1
+	// @xml: "EBook"
+	TextType_TEXT_TYPE_EBOOK TextType = 2
+	// @xml: "LinerNotes"
+	TextType_TEXT_TYPE_LINERNOTES TextType = 3
+	// @xml: "LyricText"
+	TextType_TEXT_TYPE_LYRICTEXT TextType = 4
+	// @xml: "NonInteractiveBooklet"
 	TextType_TEXT_TYPE_NONINTERACTIVEBOOKLET TextType = 5
-	TextType_TEXT_TYPE_TEXTDOCUMENT          TextType = 6
-	TextType_TEXT_TYPE_UNKNOWN               TextType = 7
-	TextType_TEXT_TYPE_USERDEFINED           TextType = 8
+	// @xml: "TextDocument"
+	TextType_TEXT_TYPE_TEXTDOCUMENT TextType = 6
+	// @xml: "Unknown"
+	TextType_TEXT_TYPE_UNKNOWN TextType = 7
+	// @xml: "UserDefined"
+	TextType_TEXT_TYPE_USERDEFINED TextType = 8
 )
 
 // Enum value maps for TextType.
@@ -16591,13 +20339,19 @@ func (TextType) EnumDescriptor() ([]byte, []int) {
 type ThemeType int32
 
 const (
-	ThemeType_THEME_TYPE_UNSPECIFIED  ThemeType = 0
+	ThemeType_THEME_TYPE_UNSPECIFIED ThemeType = 0
+	// @xml: "ClosingTheme"
 	ThemeType_THEME_TYPE_CLOSINGTHEME ThemeType = 1
-	ThemeType_THEME_TYPE_MAINTHEME    ThemeType = 2
+	// @xml: "MainTheme"
+	ThemeType_THEME_TYPE_MAINTHEME ThemeType = 2
+	// @xml: "OpeningTheme"
 	ThemeType_THEME_TYPE_OPENINGTHEME ThemeType = 3
+	// @xml: "SegmentTheme"
 	ThemeType_THEME_TYPE_SEGMENTTHEME ThemeType = 4
-	ThemeType_THEME_TYPE_TITLETHEME   ThemeType = 5
-	ThemeType_THEME_TYPE_USERDEFINED  ThemeType = 6
+	// @xml: "TitleTheme"
+	ThemeType_THEME_TYPE_TITLETHEME ThemeType = 5
+	// @xml: "UserDefined"
+	ThemeType_THEME_TYPE_USERDEFINED ThemeType = 6
 )
 
 // Enum value maps for ThemeType.
@@ -16653,253 +20407,500 @@ type TisTerritoryCode int32
 
 const (
 	TisTerritoryCode_TIS_TERRITORY_CODE_UNSPECIFIED TisTerritoryCode = 0
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_4         TisTerritoryCode = 1
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_8         TisTerritoryCode = 2
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_12        TisTerritoryCode = 3
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_20        TisTerritoryCode = 4
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_24        TisTerritoryCode = 5
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_28        TisTerritoryCode = 6
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_31        TisTerritoryCode = 7
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_32        TisTerritoryCode = 8
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_36        TisTerritoryCode = 9
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_40        TisTerritoryCode = 10
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_44        TisTerritoryCode = 11
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_48        TisTerritoryCode = 12
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_50        TisTerritoryCode = 13
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_51        TisTerritoryCode = 14
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_52        TisTerritoryCode = 15
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_56        TisTerritoryCode = 16
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_64        TisTerritoryCode = 17
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_68        TisTerritoryCode = 18
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_70        TisTerritoryCode = 19
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_72        TisTerritoryCode = 20
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_76        TisTerritoryCode = 21
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_84        TisTerritoryCode = 22
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_90        TisTerritoryCode = 23
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_96        TisTerritoryCode = 24
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_100       TisTerritoryCode = 25
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_104       TisTerritoryCode = 26
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_108       TisTerritoryCode = 27
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_112       TisTerritoryCode = 28
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_116       TisTerritoryCode = 29
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_120       TisTerritoryCode = 30
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_124       TisTerritoryCode = 31
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_132       TisTerritoryCode = 32
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_140       TisTerritoryCode = 33
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_144       TisTerritoryCode = 34
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_148       TisTerritoryCode = 35
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_152       TisTerritoryCode = 36
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_156       TisTerritoryCode = 37
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_158       TisTerritoryCode = 38
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_170       TisTerritoryCode = 39
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_174       TisTerritoryCode = 40
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_178       TisTerritoryCode = 41
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_180       TisTerritoryCode = 42
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_188       TisTerritoryCode = 43
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_191       TisTerritoryCode = 44
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_192       TisTerritoryCode = 45
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_196       TisTerritoryCode = 46
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_200       TisTerritoryCode = 47
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_203       TisTerritoryCode = 48
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_204       TisTerritoryCode = 49
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_208       TisTerritoryCode = 50
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_212       TisTerritoryCode = 51
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_214       TisTerritoryCode = 52
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_218       TisTerritoryCode = 53
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_222       TisTerritoryCode = 54
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_226       TisTerritoryCode = 55
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_230       TisTerritoryCode = 56
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_231       TisTerritoryCode = 57
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_232       TisTerritoryCode = 58
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_233       TisTerritoryCode = 59
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_242       TisTerritoryCode = 60
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_246       TisTerritoryCode = 61
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_250       TisTerritoryCode = 62
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_258       TisTerritoryCode = 63
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_262       TisTerritoryCode = 64
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_266       TisTerritoryCode = 65
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_268       TisTerritoryCode = 66
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_270       TisTerritoryCode = 67
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_276       TisTerritoryCode = 68
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_278       TisTerritoryCode = 69
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_280       TisTerritoryCode = 70
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_288       TisTerritoryCode = 71
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_296       TisTerritoryCode = 72
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_300       TisTerritoryCode = 73
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_308       TisTerritoryCode = 74
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_320       TisTerritoryCode = 75
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_324       TisTerritoryCode = 76
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_328       TisTerritoryCode = 77
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_332       TisTerritoryCode = 78
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_336       TisTerritoryCode = 79
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_340       TisTerritoryCode = 80
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_344       TisTerritoryCode = 81
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_348       TisTerritoryCode = 82
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_352       TisTerritoryCode = 83
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_356       TisTerritoryCode = 84
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_360       TisTerritoryCode = 85
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_364       TisTerritoryCode = 86
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_368       TisTerritoryCode = 87
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_372       TisTerritoryCode = 88
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_376       TisTerritoryCode = 89
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_380       TisTerritoryCode = 90
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_384       TisTerritoryCode = 91
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_388       TisTerritoryCode = 92
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_392       TisTerritoryCode = 93
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_398       TisTerritoryCode = 94
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_400       TisTerritoryCode = 95
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_404       TisTerritoryCode = 96
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_408       TisTerritoryCode = 97
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_410       TisTerritoryCode = 98
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_414       TisTerritoryCode = 99
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_417       TisTerritoryCode = 100
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_418       TisTerritoryCode = 101
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_422       TisTerritoryCode = 102
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_426       TisTerritoryCode = 103
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_428       TisTerritoryCode = 104
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_430       TisTerritoryCode = 105
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_434       TisTerritoryCode = 106
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_438       TisTerritoryCode = 107
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_440       TisTerritoryCode = 108
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_442       TisTerritoryCode = 109
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_450       TisTerritoryCode = 110
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_454       TisTerritoryCode = 111
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_458       TisTerritoryCode = 112
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_462       TisTerritoryCode = 113
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_466       TisTerritoryCode = 114
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_470       TisTerritoryCode = 115
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_478       TisTerritoryCode = 116
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_480       TisTerritoryCode = 117
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_484       TisTerritoryCode = 118
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_492       TisTerritoryCode = 119
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_496       TisTerritoryCode = 120
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_498       TisTerritoryCode = 121
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_499       TisTerritoryCode = 122
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_504       TisTerritoryCode = 123
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_508       TisTerritoryCode = 124
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_512       TisTerritoryCode = 125
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_516       TisTerritoryCode = 126
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_520       TisTerritoryCode = 127
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_524       TisTerritoryCode = 128
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_528       TisTerritoryCode = 129
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_540       TisTerritoryCode = 130
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_548       TisTerritoryCode = 131
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_554       TisTerritoryCode = 132
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_558       TisTerritoryCode = 133
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_562       TisTerritoryCode = 134
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_566       TisTerritoryCode = 135
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_578       TisTerritoryCode = 136
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_583       TisTerritoryCode = 137
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_584       TisTerritoryCode = 138
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_585       TisTerritoryCode = 139
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_586       TisTerritoryCode = 140
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_591       TisTerritoryCode = 141
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_598       TisTerritoryCode = 142
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_600       TisTerritoryCode = 143
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_604       TisTerritoryCode = 144
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_608       TisTerritoryCode = 145
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_616       TisTerritoryCode = 146
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_620       TisTerritoryCode = 147
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_624       TisTerritoryCode = 148
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_626       TisTerritoryCode = 149
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_630       TisTerritoryCode = 150
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_634       TisTerritoryCode = 151
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_642       TisTerritoryCode = 152
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_643       TisTerritoryCode = 153
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_646       TisTerritoryCode = 154
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_659       TisTerritoryCode = 155
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_662       TisTerritoryCode = 156
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_670       TisTerritoryCode = 157
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_674       TisTerritoryCode = 158
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_678       TisTerritoryCode = 159
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_682       TisTerritoryCode = 160
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_686       TisTerritoryCode = 161
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_688       TisTerritoryCode = 162
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_690       TisTerritoryCode = 163
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_694       TisTerritoryCode = 164
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_702       TisTerritoryCode = 165
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_703       TisTerritoryCode = 166
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_704       TisTerritoryCode = 167
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_705       TisTerritoryCode = 168
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_706       TisTerritoryCode = 169
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_710       TisTerritoryCode = 170
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_716       TisTerritoryCode = 171
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_720       TisTerritoryCode = 172
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_724       TisTerritoryCode = 173
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_728       TisTerritoryCode = 174
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_729       TisTerritoryCode = 175
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_732       TisTerritoryCode = 176
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_736       TisTerritoryCode = 177
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_740       TisTerritoryCode = 178
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_748       TisTerritoryCode = 179
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_752       TisTerritoryCode = 180
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_756       TisTerritoryCode = 181
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_760       TisTerritoryCode = 182
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_762       TisTerritoryCode = 183
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_764       TisTerritoryCode = 184
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_768       TisTerritoryCode = 185
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_776       TisTerritoryCode = 186
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_780       TisTerritoryCode = 187
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_784       TisTerritoryCode = 188
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_788       TisTerritoryCode = 189
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_792       TisTerritoryCode = 190
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_795       TisTerritoryCode = 191
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_798       TisTerritoryCode = 192
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_800       TisTerritoryCode = 193
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_804       TisTerritoryCode = 194
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_807       TisTerritoryCode = 195
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_810       TisTerritoryCode = 196
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_818       TisTerritoryCode = 197
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_826       TisTerritoryCode = 198
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_834       TisTerritoryCode = 199
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_840       TisTerritoryCode = 200
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_854       TisTerritoryCode = 201
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_858       TisTerritoryCode = 202
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_860       TisTerritoryCode = 203
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_862       TisTerritoryCode = 204
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_882       TisTerritoryCode = 205
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_886       TisTerritoryCode = 206
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_887       TisTerritoryCode = 207
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_890       TisTerritoryCode = 208
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_891       TisTerritoryCode = 209
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_894       TisTerritoryCode = 210
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2100      TisTerritoryCode = 211
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2101      TisTerritoryCode = 212
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2102      TisTerritoryCode = 213
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2103      TisTerritoryCode = 214
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2104      TisTerritoryCode = 215
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2105      TisTerritoryCode = 216
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2106      TisTerritoryCode = 217
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2107      TisTerritoryCode = 218
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2108      TisTerritoryCode = 219
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2109      TisTerritoryCode = 220
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2110      TisTerritoryCode = 221
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2111      TisTerritoryCode = 222
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2112      TisTerritoryCode = 223
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2113      TisTerritoryCode = 224
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2114      TisTerritoryCode = 225
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2115      TisTerritoryCode = 226
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2116      TisTerritoryCode = 227
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2117      TisTerritoryCode = 228
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2118      TisTerritoryCode = 229
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2119      TisTerritoryCode = 230
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2120      TisTerritoryCode = 231
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2121      TisTerritoryCode = 232
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2122      TisTerritoryCode = 233
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2123      TisTerritoryCode = 234
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2124      TisTerritoryCode = 235
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2125      TisTerritoryCode = 236
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2126      TisTerritoryCode = 237
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2127      TisTerritoryCode = 238
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2128      TisTerritoryCode = 239
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2129      TisTerritoryCode = 240
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2130      TisTerritoryCode = 241
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2131      TisTerritoryCode = 242
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2132      TisTerritoryCode = 243
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2133      TisTerritoryCode = 244
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2134      TisTerritoryCode = 245
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_2136      TisTerritoryCode = 246
-	TisTerritoryCode_TIS_TERRITORY_CODE_E_446       TisTerritoryCode = 247
+	// @xml: "4"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_4 TisTerritoryCode = 1
+	// @xml: "8"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_8 TisTerritoryCode = 2
+	// @xml: "12"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_12 TisTerritoryCode = 3
+	// @xml: "20"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_20 TisTerritoryCode = 4
+	// @xml: "24"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_24 TisTerritoryCode = 5
+	// @xml: "28"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_28 TisTerritoryCode = 6
+	// @xml: "31"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_31 TisTerritoryCode = 7
+	// @xml: "32"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_32 TisTerritoryCode = 8
+	// @xml: "36"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_36 TisTerritoryCode = 9
+	// @xml: "40"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_40 TisTerritoryCode = 10
+	// @xml: "44"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_44 TisTerritoryCode = 11
+	// @xml: "48"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_48 TisTerritoryCode = 12
+	// @xml: "50"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_50 TisTerritoryCode = 13
+	// @xml: "51"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_51 TisTerritoryCode = 14
+	// @xml: "52"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_52 TisTerritoryCode = 15
+	// @xml: "56"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_56 TisTerritoryCode = 16
+	// @xml: "64"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_64 TisTerritoryCode = 17
+	// @xml: "68"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_68 TisTerritoryCode = 18
+	// @xml: "70"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_70 TisTerritoryCode = 19
+	// @xml: "72"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_72 TisTerritoryCode = 20
+	// @xml: "76"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_76 TisTerritoryCode = 21
+	// @xml: "84"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_84 TisTerritoryCode = 22
+	// @xml: "90"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_90 TisTerritoryCode = 23
+	// @xml: "96"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_96 TisTerritoryCode = 24
+	// @xml: "100"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_100 TisTerritoryCode = 25
+	// @xml: "104"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_104 TisTerritoryCode = 26
+	// @xml: "108"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_108 TisTerritoryCode = 27
+	// @xml: "112"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_112 TisTerritoryCode = 28
+	// @xml: "116"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_116 TisTerritoryCode = 29
+	// @xml: "120"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_120 TisTerritoryCode = 30
+	// @xml: "124"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_124 TisTerritoryCode = 31
+	// @xml: "132"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_132 TisTerritoryCode = 32
+	// @xml: "140"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_140 TisTerritoryCode = 33
+	// @xml: "144"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_144 TisTerritoryCode = 34
+	// @xml: "148"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_148 TisTerritoryCode = 35
+	// @xml: "152"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_152 TisTerritoryCode = 36
+	// @xml: "156"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_156 TisTerritoryCode = 37
+	// @xml: "158"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_158 TisTerritoryCode = 38
+	// @xml: "170"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_170 TisTerritoryCode = 39
+	// @xml: "174"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_174 TisTerritoryCode = 40
+	// @xml: "178"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_178 TisTerritoryCode = 41
+	// @xml: "180"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_180 TisTerritoryCode = 42
+	// @xml: "188"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_188 TisTerritoryCode = 43
+	// @xml: "191"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_191 TisTerritoryCode = 44
+	// @xml: "192"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_192 TisTerritoryCode = 45
+	// @xml: "196"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_196 TisTerritoryCode = 46
+	// @xml: "200"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_200 TisTerritoryCode = 47
+	// @xml: "203"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_203 TisTerritoryCode = 48
+	// @xml: "204"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_204 TisTerritoryCode = 49
+	// @xml: "208"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_208 TisTerritoryCode = 50
+	// @xml: "212"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_212 TisTerritoryCode = 51
+	// @xml: "214"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_214 TisTerritoryCode = 52
+	// @xml: "218"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_218 TisTerritoryCode = 53
+	// @xml: "222"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_222 TisTerritoryCode = 54
+	// @xml: "226"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_226 TisTerritoryCode = 55
+	// @xml: "230"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_230 TisTerritoryCode = 56
+	// @xml: "231"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_231 TisTerritoryCode = 57
+	// @xml: "232"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_232 TisTerritoryCode = 58
+	// @xml: "233"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_233 TisTerritoryCode = 59
+	// @xml: "242"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_242 TisTerritoryCode = 60
+	// @xml: "246"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_246 TisTerritoryCode = 61
+	// @xml: "250"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_250 TisTerritoryCode = 62
+	// @xml: "258"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_258 TisTerritoryCode = 63
+	// @xml: "262"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_262 TisTerritoryCode = 64
+	// @xml: "266"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_266 TisTerritoryCode = 65
+	// @xml: "268"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_268 TisTerritoryCode = 66
+	// @xml: "270"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_270 TisTerritoryCode = 67
+	// @xml: "276"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_276 TisTerritoryCode = 68
+	// @xml: "278"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_278 TisTerritoryCode = 69
+	// @xml: "280"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_280 TisTerritoryCode = 70
+	// @xml: "288"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_288 TisTerritoryCode = 71
+	// @xml: "296"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_296 TisTerritoryCode = 72
+	// @xml: "300"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_300 TisTerritoryCode = 73
+	// @xml: "308"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_308 TisTerritoryCode = 74
+	// @xml: "320"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_320 TisTerritoryCode = 75
+	// @xml: "324"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_324 TisTerritoryCode = 76
+	// @xml: "328"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_328 TisTerritoryCode = 77
+	// @xml: "332"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_332 TisTerritoryCode = 78
+	// @xml: "336"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_336 TisTerritoryCode = 79
+	// @xml: "340"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_340 TisTerritoryCode = 80
+	// @xml: "344"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_344 TisTerritoryCode = 81
+	// @xml: "348"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_348 TisTerritoryCode = 82
+	// @xml: "352"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_352 TisTerritoryCode = 83
+	// @xml: "356"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_356 TisTerritoryCode = 84
+	// @xml: "360"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_360 TisTerritoryCode = 85
+	// @xml: "364"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_364 TisTerritoryCode = 86
+	// @xml: "368"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_368 TisTerritoryCode = 87
+	// @xml: "372"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_372 TisTerritoryCode = 88
+	// @xml: "376"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_376 TisTerritoryCode = 89
+	// @xml: "380"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_380 TisTerritoryCode = 90
+	// @xml: "384"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_384 TisTerritoryCode = 91
+	// @xml: "388"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_388 TisTerritoryCode = 92
+	// @xml: "392"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_392 TisTerritoryCode = 93
+	// @xml: "398"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_398 TisTerritoryCode = 94
+	// @xml: "400"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_400 TisTerritoryCode = 95
+	// @xml: "404"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_404 TisTerritoryCode = 96
+	// @xml: "408"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_408 TisTerritoryCode = 97
+	// @xml: "410"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_410 TisTerritoryCode = 98
+	// @xml: "414"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_414 TisTerritoryCode = 99
+	// @xml: "417"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_417 TisTerritoryCode = 100
+	// @xml: "418"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_418 TisTerritoryCode = 101
+	// @xml: "422"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_422 TisTerritoryCode = 102
+	// @xml: "426"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_426 TisTerritoryCode = 103
+	// @xml: "428"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_428 TisTerritoryCode = 104
+	// @xml: "430"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_430 TisTerritoryCode = 105
+	// @xml: "434"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_434 TisTerritoryCode = 106
+	// @xml: "438"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_438 TisTerritoryCode = 107
+	// @xml: "440"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_440 TisTerritoryCode = 108
+	// @xml: "442"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_442 TisTerritoryCode = 109
+	// @xml: "450"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_450 TisTerritoryCode = 110
+	// @xml: "454"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_454 TisTerritoryCode = 111
+	// @xml: "458"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_458 TisTerritoryCode = 112
+	// @xml: "462"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_462 TisTerritoryCode = 113
+	// @xml: "466"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_466 TisTerritoryCode = 114
+	// @xml: "470"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_470 TisTerritoryCode = 115
+	// @xml: "478"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_478 TisTerritoryCode = 116
+	// @xml: "480"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_480 TisTerritoryCode = 117
+	// @xml: "484"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_484 TisTerritoryCode = 118
+	// @xml: "492"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_492 TisTerritoryCode = 119
+	// @xml: "496"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_496 TisTerritoryCode = 120
+	// @xml: "498"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_498 TisTerritoryCode = 121
+	// @xml: "499"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_499 TisTerritoryCode = 122
+	// @xml: "504"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_504 TisTerritoryCode = 123
+	// @xml: "508"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_508 TisTerritoryCode = 124
+	// @xml: "512"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_512 TisTerritoryCode = 125
+	// @xml: "516"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_516 TisTerritoryCode = 126
+	// @xml: "520"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_520 TisTerritoryCode = 127
+	// @xml: "524"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_524 TisTerritoryCode = 128
+	// @xml: "528"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_528 TisTerritoryCode = 129
+	// @xml: "540"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_540 TisTerritoryCode = 130
+	// @xml: "548"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_548 TisTerritoryCode = 131
+	// @xml: "554"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_554 TisTerritoryCode = 132
+	// @xml: "558"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_558 TisTerritoryCode = 133
+	// @xml: "562"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_562 TisTerritoryCode = 134
+	// @xml: "566"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_566 TisTerritoryCode = 135
+	// @xml: "578"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_578 TisTerritoryCode = 136
+	// @xml: "583"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_583 TisTerritoryCode = 137
+	// @xml: "584"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_584 TisTerritoryCode = 138
+	// @xml: "585"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_585 TisTerritoryCode = 139
+	// @xml: "586"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_586 TisTerritoryCode = 140
+	// @xml: "591"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_591 TisTerritoryCode = 141
+	// @xml: "598"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_598 TisTerritoryCode = 142
+	// @xml: "600"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_600 TisTerritoryCode = 143
+	// @xml: "604"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_604 TisTerritoryCode = 144
+	// @xml: "608"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_608 TisTerritoryCode = 145
+	// @xml: "616"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_616 TisTerritoryCode = 146
+	// @xml: "620"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_620 TisTerritoryCode = 147
+	// @xml: "624"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_624 TisTerritoryCode = 148
+	// @xml: "626"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_626 TisTerritoryCode = 149
+	// @xml: "630"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_630 TisTerritoryCode = 150
+	// @xml: "634"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_634 TisTerritoryCode = 151
+	// @xml: "642"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_642 TisTerritoryCode = 152
+	// @xml: "643"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_643 TisTerritoryCode = 153
+	// @xml: "646"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_646 TisTerritoryCode = 154
+	// @xml: "659"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_659 TisTerritoryCode = 155
+	// @xml: "662"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_662 TisTerritoryCode = 156
+	// @xml: "670"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_670 TisTerritoryCode = 157
+	// @xml: "674"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_674 TisTerritoryCode = 158
+	// @xml: "678"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_678 TisTerritoryCode = 159
+	// @xml: "682"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_682 TisTerritoryCode = 160
+	// @xml: "686"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_686 TisTerritoryCode = 161
+	// @xml: "688"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_688 TisTerritoryCode = 162
+	// @xml: "690"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_690 TisTerritoryCode = 163
+	// @xml: "694"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_694 TisTerritoryCode = 164
+	// @xml: "702"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_702 TisTerritoryCode = 165
+	// @xml: "703"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_703 TisTerritoryCode = 166
+	// @xml: "704"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_704 TisTerritoryCode = 167
+	// @xml: "705"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_705 TisTerritoryCode = 168
+	// @xml: "706"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_706 TisTerritoryCode = 169
+	// @xml: "710"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_710 TisTerritoryCode = 170
+	// @xml: "716"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_716 TisTerritoryCode = 171
+	// @xml: "720"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_720 TisTerritoryCode = 172
+	// @xml: "724"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_724 TisTerritoryCode = 173
+	// @xml: "728"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_728 TisTerritoryCode = 174
+	// @xml: "729"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_729 TisTerritoryCode = 175
+	// @xml: "732"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_732 TisTerritoryCode = 176
+	// @xml: "736"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_736 TisTerritoryCode = 177
+	// @xml: "740"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_740 TisTerritoryCode = 178
+	// @xml: "748"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_748 TisTerritoryCode = 179
+	// @xml: "752"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_752 TisTerritoryCode = 180
+	// @xml: "756"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_756 TisTerritoryCode = 181
+	// @xml: "760"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_760 TisTerritoryCode = 182
+	// @xml: "762"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_762 TisTerritoryCode = 183
+	// @xml: "764"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_764 TisTerritoryCode = 184
+	// @xml: "768"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_768 TisTerritoryCode = 185
+	// @xml: "776"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_776 TisTerritoryCode = 186
+	// @xml: "780"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_780 TisTerritoryCode = 187
+	// @xml: "784"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_784 TisTerritoryCode = 188
+	// @xml: "788"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_788 TisTerritoryCode = 189
+	// @xml: "792"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_792 TisTerritoryCode = 190
+	// @xml: "795"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_795 TisTerritoryCode = 191
+	// @xml: "798"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_798 TisTerritoryCode = 192
+	// @xml: "800"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_800 TisTerritoryCode = 193
+	// @xml: "804"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_804 TisTerritoryCode = 194
+	// @xml: "807"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_807 TisTerritoryCode = 195
+	// @xml: "810"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_810 TisTerritoryCode = 196
+	// @xml: "818"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_818 TisTerritoryCode = 197
+	// @xml: "826"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_826 TisTerritoryCode = 198
+	// @xml: "834"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_834 TisTerritoryCode = 199
+	// @xml: "840"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_840 TisTerritoryCode = 200
+	// @xml: "854"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_854 TisTerritoryCode = 201
+	// @xml: "858"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_858 TisTerritoryCode = 202
+	// @xml: "860"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_860 TisTerritoryCode = 203
+	// @xml: "862"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_862 TisTerritoryCode = 204
+	// @xml: "882"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_882 TisTerritoryCode = 205
+	// @xml: "886"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_886 TisTerritoryCode = 206
+	// @xml: "887"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_887 TisTerritoryCode = 207
+	// @xml: "890"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_890 TisTerritoryCode = 208
+	// @xml: "891"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_891 TisTerritoryCode = 209
+	// @xml: "894"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_894 TisTerritoryCode = 210
+	// @xml: "2100"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2100 TisTerritoryCode = 211
+	// @xml: "2101"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2101 TisTerritoryCode = 212
+	// @xml: "2102"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2102 TisTerritoryCode = 213
+	// @xml: "2103"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2103 TisTerritoryCode = 214
+	// @xml: "2104"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2104 TisTerritoryCode = 215
+	// @xml: "2105"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2105 TisTerritoryCode = 216
+	// @xml: "2106"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2106 TisTerritoryCode = 217
+	// @xml: "2107"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2107 TisTerritoryCode = 218
+	// @xml: "2108"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2108 TisTerritoryCode = 219
+	// @xml: "2109"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2109 TisTerritoryCode = 220
+	// @xml: "2110"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2110 TisTerritoryCode = 221
+	// @xml: "2111"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2111 TisTerritoryCode = 222
+	// @xml: "2112"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2112 TisTerritoryCode = 223
+	// @xml: "2113"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2113 TisTerritoryCode = 224
+	// @xml: "2114"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2114 TisTerritoryCode = 225
+	// @xml: "2115"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2115 TisTerritoryCode = 226
+	// @xml: "2116"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2116 TisTerritoryCode = 227
+	// @xml: "2117"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2117 TisTerritoryCode = 228
+	// @xml: "2118"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2118 TisTerritoryCode = 229
+	// @xml: "2119"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2119 TisTerritoryCode = 230
+	// @xml: "2120"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2120 TisTerritoryCode = 231
+	// @xml: "2121"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2121 TisTerritoryCode = 232
+	// @xml: "2122"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2122 TisTerritoryCode = 233
+	// @xml: "2123"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2123 TisTerritoryCode = 234
+	// @xml: "2124"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2124 TisTerritoryCode = 235
+	// @xml: "2125"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2125 TisTerritoryCode = 236
+	// @xml: "2126"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2126 TisTerritoryCode = 237
+	// @xml: "2127"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2127 TisTerritoryCode = 238
+	// @xml: "2128"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2128 TisTerritoryCode = 239
+	// @xml: "2129"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2129 TisTerritoryCode = 240
+	// @xml: "2130"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2130 TisTerritoryCode = 241
+	// @xml: "2131"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2131 TisTerritoryCode = 242
+	// @xml: "2132"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2132 TisTerritoryCode = 243
+	// @xml: "2133"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2133 TisTerritoryCode = 244
+	// @xml: "2134"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2134 TisTerritoryCode = 245
+	// @xml: "2136"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_2136 TisTerritoryCode = 246
+	// @xml: "446"
+	TisTerritoryCode_TIS_TERRITORY_CODE_E_446 TisTerritoryCode = 247
 )
 
 // Enum value maps for TisTerritoryCode.
@@ -17436,24 +21437,41 @@ func (TisTerritoryCode) EnumDescriptor() ([]byte, []int) {
 type TitleType int32
 
 const (
-	TitleType_TITLE_TYPE_UNSPECIFIED             TitleType = 0
+	TitleType_TITLE_TYPE_UNSPECIFIED TitleType = 0
+	// @xml: "AbbreviatedDisplayTitle"
 	TitleType_TITLE_TYPE_ABBREVIATEDDISPLAYTITLE TitleType = 1
-	TitleType_TITLE_TYPE_ALTERNATIVETITLE        TitleType = 2
-	TitleType_TITLE_TYPE_DISPLAYTITLE            TitleType = 3
-	TitleType_TITLE_TYPE_FIRSTLINEOFTEXT         TitleType = 4
-	TitleType_TITLE_TYPE_FORMALTITLE             TitleType = 5
-	TitleType_TITLE_TYPE_GROUPINGTITLE           TitleType = 6
-	TitleType_TITLE_TYPE_INCORRECTTITLE          TitleType = 7
-	TitleType_TITLE_TYPE_MISSPELLEDTITLE         TitleType = 8
-	TitleType_TITLE_TYPE_ORIGINALTITLE           TitleType = 9
-	TitleType_TITLE_TYPE_SEARCHTITLE             TitleType = 10
-	TitleType_TITLE_TYPE_SORTINGTITLE            TitleType = 11
-	TitleType_TITLE_TYPE_TITLEASPART             TitleType = 12
+	// @xml: "AlternativeTitle"
+	TitleType_TITLE_TYPE_ALTERNATIVETITLE TitleType = 2
+	// @xml: "DisplayTitle"
+	TitleType_TITLE_TYPE_DISPLAYTITLE TitleType = 3
+	// @xml: "FirstLineOfText"
+	TitleType_TITLE_TYPE_FIRSTLINEOFTEXT TitleType = 4
+	// @xml: "FormalTitle"
+	TitleType_TITLE_TYPE_FORMALTITLE TitleType = 5
+	// @xml: "GroupingTitle"
+	TitleType_TITLE_TYPE_GROUPINGTITLE TitleType = 6
+	// @xml: "IncorrectTitle"
+	TitleType_TITLE_TYPE_INCORRECTTITLE TitleType = 7
+	// @xml: "MisspelledTitle"
+	TitleType_TITLE_TYPE_MISSPELLEDTITLE TitleType = 8
+	// @xml: "OriginalTitle"
+	TitleType_TITLE_TYPE_ORIGINALTITLE TitleType = 9
+	// @xml: "SearchTitle"
+	TitleType_TITLE_TYPE_SEARCHTITLE TitleType = 10
+	// @xml: "SortingTitle"
+	TitleType_TITLE_TYPE_SORTINGTITLE TitleType = 11
+	// @xml: "TitleAsPart"
+	TitleType_TITLE_TYPE_TITLEASPART TitleType = 12
+	// @xml: "TitleWithoutPunctuation"
 	TitleType_TITLE_TYPE_TITLEWITHOUTPUNCTUATION TitleType = 13
-	TitleType_TITLE_TYPE_TRANSLATEDTITLE         TitleType = 14
-	TitleType_TITLE_TYPE_UNKNOWN                 TitleType = 15
-	TitleType_TITLE_TYPE_USERDEFINED             TitleType = 16
-	TitleType_TITLE_TYPE_MUSICALWORKTITLE        TitleType = 17
+	// @xml: "TranslatedTitle"
+	TitleType_TITLE_TYPE_TRANSLATEDTITLE TitleType = 14
+	// @xml: "Unknown"
+	TitleType_TITLE_TYPE_UNKNOWN TitleType = 15
+	// @xml: "UserDefined"
+	TitleType_TITLE_TYPE_USERDEFINED TitleType = 16
+	// @xml: "MusicalWorkTitle"
+	TitleType_TITLE_TYPE_MUSICALWORKTITLE TitleType = 17
 )
 
 // Enum value maps for TitleType.
@@ -17531,10 +21549,14 @@ type UnitOfBitRate int32
 
 const (
 	UnitOfBitRate_UNIT_OF_BIT_RATE_UNSPECIFIED UnitOfBitRate = 0
-	UnitOfBitRate_UNIT_OF_BIT_RATE_BPS         UnitOfBitRate = 1
-	UnitOfBitRate_UNIT_OF_BIT_RATE_GBPS        UnitOfBitRate = 2
-	UnitOfBitRate_UNIT_OF_BIT_RATE_KBPS        UnitOfBitRate = 3
-	UnitOfBitRate_UNIT_OF_BIT_RATE_MBPS        UnitOfBitRate = 4
+	// @xml: "bps"
+	UnitOfBitRate_UNIT_OF_BIT_RATE_BPS UnitOfBitRate = 1
+	// @xml: "Gbps"
+	UnitOfBitRate_UNIT_OF_BIT_RATE_GBPS UnitOfBitRate = 2
+	// @xml: "kbps"
+	UnitOfBitRate_UNIT_OF_BIT_RATE_KBPS UnitOfBitRate = 3
+	// @xml: "Mbps"
+	UnitOfBitRate_UNIT_OF_BIT_RATE_MBPS UnitOfBitRate = 4
 )
 
 // Enum value maps for UnitOfBitRate.
@@ -17586,11 +21608,16 @@ type UnitOfConditionValue int32
 
 const (
 	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_UNSPECIFIED UnitOfConditionValue = 0
+	// @xml: "Millisecond"
 	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_MILLISECOND UnitOfConditionValue = 1
-	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_MINUTE      UnitOfConditionValue = 2
-	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PERCENT     UnitOfConditionValue = 3
-	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PIXEL       UnitOfConditionValue = 4
-	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_SECOND      UnitOfConditionValue = 5
+	// @xml: "Minute"
+	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_MINUTE UnitOfConditionValue = 2
+	// @xml: "Percent"
+	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PERCENT UnitOfConditionValue = 3
+	// @xml: "Pixel"
+	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_PIXEL UnitOfConditionValue = 4
+	// @xml: "Second"
+	UnitOfConditionValue_UNIT_OF_CONDITION_VALUE_SECOND UnitOfConditionValue = 5
 )
 
 // Enum value maps for UnitOfConditionValue.
@@ -17643,12 +21670,17 @@ func (UnitOfConditionValue) EnumDescriptor() ([]byte, []int) {
 type UnitOfExtent int32
 
 const (
-	UnitOfExtent_UNIT_OF_EXTENT_UNSPECIFIED     UnitOfExtent = 0
-	UnitOfExtent_UNIT_OF_EXTENT_CM              UnitOfExtent = 1
-	UnitOfExtent_UNIT_OF_EXTENT_INCH            UnitOfExtent = 2
-	UnitOfExtent_UNIT_OF_EXTENT_MM              UnitOfExtent = 3
+	UnitOfExtent_UNIT_OF_EXTENT_UNSPECIFIED UnitOfExtent = 0
+	// @xml: "cm"
+	UnitOfExtent_UNIT_OF_EXTENT_CM UnitOfExtent = 1
+	// @xml: "Inch"
+	UnitOfExtent_UNIT_OF_EXTENT_INCH UnitOfExtent = 2
+	// @xml: "mm"
+	UnitOfExtent_UNIT_OF_EXTENT_MM UnitOfExtent = 3
+	// @xml: "PercentOfScreen"
 	UnitOfExtent_UNIT_OF_EXTENT_PERCENTOFSCREEN UnitOfExtent = 4
-	UnitOfExtent_UNIT_OF_EXTENT_PIXEL           UnitOfExtent = 5
+	// @xml: "Pixel"
+	UnitOfExtent_UNIT_OF_EXTENT_PIXEL UnitOfExtent = 5
 )
 
 // Enum value maps for UnitOfExtent.
@@ -17701,8 +21733,10 @@ func (UnitOfExtent) EnumDescriptor() ([]byte, []int) {
 type UnitOfFrameRate int32
 
 const (
-	UnitOfFrameRate_UNIT_OF_FRAME_RATE_UNSPECIFIED       UnitOfFrameRate = 0
-	UnitOfFrameRate_UNIT_OF_FRAME_RATE_HZ_INTERLACED     UnitOfFrameRate = 1
+	UnitOfFrameRate_UNIT_OF_FRAME_RATE_UNSPECIFIED UnitOfFrameRate = 0
+	// @xml: "Hz(interlaced)"
+	UnitOfFrameRate_UNIT_OF_FRAME_RATE_HZ_INTERLACED UnitOfFrameRate = 1
+	// @xml: "Hz(non-interlaced)"
 	UnitOfFrameRate_UNIT_OF_FRAME_RATE_HZ_NON_INTERLACED UnitOfFrameRate = 2
 )
 
@@ -17751,10 +21785,14 @@ type UnitOfFrequency int32
 
 const (
 	UnitOfFrequency_UNIT_OF_FREQUENCY_UNSPECIFIED UnitOfFrequency = 0
-	UnitOfFrequency_UNIT_OF_FREQUENCY_GHZ         UnitOfFrequency = 1
-	UnitOfFrequency_UNIT_OF_FREQUENCY_HZ          UnitOfFrequency = 2
-	UnitOfFrequency_UNIT_OF_FREQUENCY_KHZ         UnitOfFrequency = 3
-	UnitOfFrequency_UNIT_OF_FREQUENCY_MHZ         UnitOfFrequency = 4
+	// @xml: "GHz"
+	UnitOfFrequency_UNIT_OF_FREQUENCY_GHZ UnitOfFrequency = 1
+	// @xml: "Hz"
+	UnitOfFrequency_UNIT_OF_FREQUENCY_HZ UnitOfFrequency = 2
+	// @xml: "kHz"
+	UnitOfFrequency_UNIT_OF_FREQUENCY_KHZ UnitOfFrequency = 3
+	// @xml: "MHz"
+	UnitOfFrequency_UNIT_OF_FREQUENCY_MHZ UnitOfFrequency = 4
 )
 
 // Enum value maps for UnitOfFrequency.
@@ -17805,9 +21843,11 @@ func (UnitOfFrequency) EnumDescriptor() ([]byte, []int) {
 type UpdateIndicator int32
 
 const (
-	UpdateIndicator_UPDATE_INDICATOR_UNSPECIFIED     UpdateIndicator = 0
+	UpdateIndicator_UPDATE_INDICATOR_UNSPECIFIED UpdateIndicator = 0
+	// @xml: "OriginalMessage"
 	UpdateIndicator_UPDATE_INDICATOR_ORIGINALMESSAGE UpdateIndicator = 1
-	UpdateIndicator_UPDATE_INDICATOR_UPDATEMESSAGE   UpdateIndicator = 2
+	// @xml: "UpdateMessage"
+	UpdateIndicator_UPDATE_INDICATOR_UPDATEMESSAGE UpdateIndicator = 2
 )
 
 // Enum value maps for UpdateIndicator.
@@ -17854,64 +21894,121 @@ func (UpdateIndicator) EnumDescriptor() ([]byte, []int) {
 type UseType int32
 
 const (
-	UseType_USE_TYPE_UNSPECIFIED                    UseType = 0
-	UseType_USE_TYPE_ASPERCONTRACT                  UseType = 1
-	UseType_USE_TYPE_BROADCAST                      UseType = 2
-	UseType_USE_TYPE_CONDITIONALDOWNLOAD            UseType = 3
-	UseType_USE_TYPE_CONTENTINFLUENCEDSTREAM        UseType = 4
-	UseType_USE_TYPE_DISPLAY                        UseType = 5
-	UseType_USE_TYPE_DOWNLOAD                       UseType = 6
-	UseType_USE_TYPE_DUBFORADVERTISEMENT            UseType = 7
-	UseType_USE_TYPE_DUBFORLIVEPERFORMANCE          UseType = 8
-	UseType_USE_TYPE_DUBFORMOVIES                   UseType = 9
-	UseType_USE_TYPE_DUBFORMUSICONHOLD              UseType = 10
-	UseType_USE_TYPE_DUBFORPUBLICPERFORMANCE        UseType = 11
-	UseType_USE_TYPE_DUBFORRADIO                    UseType = 12
-	UseType_USE_TYPE_DUBFORTV                       UseType = 13
-	UseType_USE_TYPE_EXTRACTFORINTERNET             UseType = 14
-	UseType_USE_TYPE_KIOSKDOWNLOAD                  UseType = 15
-	UseType_USE_TYPE_NARROWCAST                     UseType = 16
-	UseType_USE_TYPE_NONINTERACTIVESTREAM           UseType = 17
-	UseType_USE_TYPE_ONDEMANDSTREAM                 UseType = 18
-	UseType_USE_TYPE_PERFORMASMUSICONHOLD           UseType = 19
-	UseType_USE_TYPE_PERFORMINLIVEPERFORMANCE       UseType = 20
-	UseType_USE_TYPE_PERFORMINPUBLIC                UseType = 21
-	UseType_USE_TYPE_PERMANENTDOWNLOAD              UseType = 22
-	UseType_USE_TYPE_PLAYBACK                       UseType = 23
-	UseType_USE_TYPE_PLAYINPUBLIC                   UseType = 24
-	UseType_USE_TYPE_PODCAST                        UseType = 25
-	UseType_USE_TYPE_PRINT                          UseType = 26
-	UseType_USE_TYPE_PRIVATECOPY                    UseType = 27
-	UseType_USE_TYPE_PURCHASEASPHYSICALPRODUCT      UseType = 28
-	UseType_USE_TYPE_RENT                           UseType = 29
-	UseType_USE_TYPE_SIMULCAST                      UseType = 30
-	UseType_USE_TYPE_STREAM                         UseType = 31
-	UseType_USE_TYPE_TETHEREDDOWNLOAD               UseType = 32
-	UseType_USE_TYPE_TIMEINFLUENCEDSTREAM           UseType = 33
-	UseType_USE_TYPE_UNKNOWN                        UseType = 34
-	UseType_USE_TYPE_USEASALERTTONE                 UseType = 35
-	UseType_USE_TYPE_USEASDEVICE                    UseType = 36
-	UseType_USE_TYPE_USEASKARAOKE                   UseType = 37
-	UseType_USE_TYPE_USEASRINGBACKTONE              UseType = 38
-	UseType_USE_TYPE_USEASRINGBACKTUNE              UseType = 39
-	UseType_USE_TYPE_USEASRINGTONE                  UseType = 40
-	UseType_USE_TYPE_USEASRINGTUNE                  UseType = 41
-	UseType_USE_TYPE_USEASSCREENSAVER               UseType = 42
-	UseType_USE_TYPE_USEASVOICEMAIL                 UseType = 43
-	UseType_USE_TYPE_USEASWALLPAPER                 UseType = 44
-	UseType_USE_TYPE_USEFORIDENTIFICATION           UseType = 45
-	UseType_USE_TYPE_USEINMOBILEPHONEMESSAGING      UseType = 46
-	UseType_USE_TYPE_USEINPHONELISTENING            UseType = 47
-	UseType_USE_TYPE_USERDEFINED                    UseType = 48
+	UseType_USE_TYPE_UNSPECIFIED UseType = 0
+	// @xml: "AsPerContract"
+	UseType_USE_TYPE_ASPERCONTRACT UseType = 1
+	// @xml: "Broadcast"
+	UseType_USE_TYPE_BROADCAST UseType = 2
+	// @xml: "ConditionalDownload"
+	UseType_USE_TYPE_CONDITIONALDOWNLOAD UseType = 3
+	// @xml: "ContentInfluencedStream"
+	UseType_USE_TYPE_CONTENTINFLUENCEDSTREAM UseType = 4
+	// @xml: "Display"
+	UseType_USE_TYPE_DISPLAY UseType = 5
+	// @xml: "Download"
+	UseType_USE_TYPE_DOWNLOAD UseType = 6
+	// @xml: "DubForAdvertisement"
+	UseType_USE_TYPE_DUBFORADVERTISEMENT UseType = 7
+	// @xml: "DubForLivePerformance"
+	UseType_USE_TYPE_DUBFORLIVEPERFORMANCE UseType = 8
+	// @xml: "DubForMovies"
+	UseType_USE_TYPE_DUBFORMOVIES UseType = 9
+	// @xml: "DubForMusicOnHold"
+	UseType_USE_TYPE_DUBFORMUSICONHOLD UseType = 10
+	// @xml: "DubForPublicPerformance"
+	UseType_USE_TYPE_DUBFORPUBLICPERFORMANCE UseType = 11
+	// @xml: "DubForRadio"
+	UseType_USE_TYPE_DUBFORRADIO UseType = 12
+	// @xml: "DubForTV"
+	UseType_USE_TYPE_DUBFORTV UseType = 13
+	// @xml: "ExtractForInternet"
+	UseType_USE_TYPE_EXTRACTFORINTERNET UseType = 14
+	// @xml: "KioskDownload"
+	UseType_USE_TYPE_KIOSKDOWNLOAD UseType = 15
+	// @xml: "Narrowcast"
+	UseType_USE_TYPE_NARROWCAST UseType = 16
+	// @xml: "NonInteractiveStream"
+	UseType_USE_TYPE_NONINTERACTIVESTREAM UseType = 17
+	// @xml: "OnDemandStream"
+	UseType_USE_TYPE_ONDEMANDSTREAM UseType = 18
+	// @xml: "PerformAsMusicOnHold"
+	UseType_USE_TYPE_PERFORMASMUSICONHOLD UseType = 19
+	// @xml: "PerformInLivePerformance"
+	UseType_USE_TYPE_PERFORMINLIVEPERFORMANCE UseType = 20
+	// @xml: "PerformInPublic"
+	UseType_USE_TYPE_PERFORMINPUBLIC UseType = 21
+	// @xml: "PermanentDownload"
+	UseType_USE_TYPE_PERMANENTDOWNLOAD UseType = 22
+	// @xml: "Playback"
+	UseType_USE_TYPE_PLAYBACK UseType = 23
+	// @xml: "PlayInPublic"
+	UseType_USE_TYPE_PLAYINPUBLIC UseType = 24
+	// @xml: "Podcast"
+	UseType_USE_TYPE_PODCAST UseType = 25
+	// @xml: "Print"
+	UseType_USE_TYPE_PRINT UseType = 26
+	// @xml: "PrivateCopy"
+	UseType_USE_TYPE_PRIVATECOPY UseType = 27
+	// @xml: "PurchaseAsPhysicalProduct"
+	UseType_USE_TYPE_PURCHASEASPHYSICALPRODUCT UseType = 28
+	// @xml: "Rent"
+	UseType_USE_TYPE_RENT UseType = 29
+	// @xml: "Simulcast"
+	UseType_USE_TYPE_SIMULCAST UseType = 30
+	// @xml: "Stream"
+	UseType_USE_TYPE_STREAM UseType = 31
+	// @xml: "TetheredDownload"
+	UseType_USE_TYPE_TETHEREDDOWNLOAD UseType = 32
+	// @xml: "TimeInfluencedStream"
+	UseType_USE_TYPE_TIMEINFLUENCEDSTREAM UseType = 33
+	// @xml: "Unknown"
+	UseType_USE_TYPE_UNKNOWN UseType = 34
+	// @xml: "UseAsAlertTone"
+	UseType_USE_TYPE_USEASALERTTONE UseType = 35
+	// @xml: "UseAsDevice"
+	UseType_USE_TYPE_USEASDEVICE UseType = 36
+	// @xml: "UseAsKaraoke"
+	UseType_USE_TYPE_USEASKARAOKE UseType = 37
+	// @xml: "UseAsRingbackTone"
+	UseType_USE_TYPE_USEASRINGBACKTONE UseType = 38
+	// @xml: "UseAsRingbackTune"
+	UseType_USE_TYPE_USEASRINGBACKTUNE UseType = 39
+	// @xml: "UseAsRingtone"
+	UseType_USE_TYPE_USEASRINGTONE UseType = 40
+	// @xml: "UseAsRingtune"
+	UseType_USE_TYPE_USEASRINGTUNE UseType = 41
+	// @xml: "UseAsScreensaver"
+	UseType_USE_TYPE_USEASSCREENSAVER UseType = 42
+	// @xml: "UseAsVoiceMail"
+	UseType_USE_TYPE_USEASVOICEMAIL UseType = 43
+	// @xml: "UseAsWallpaper"
+	UseType_USE_TYPE_USEASWALLPAPER UseType = 44
+	// @xml: "UseForIdentification"
+	UseType_USE_TYPE_USEFORIDENTIFICATION UseType = 45
+	// @xml: "UseInMobilePhoneMessaging"
+	UseType_USE_TYPE_USEINMOBILEPHONEMESSAGING UseType = 46
+	// @xml: "UseInPhoneListening"
+	UseType_USE_TYPE_USEINPHONELISTENING UseType = 47
+	// @xml: "UserDefined"
+	UseType_USE_TYPE_USERDEFINED UseType = 48
+	// @xml: "UserMakeAvailableLabelProvided"
 	UseType_USE_TYPE_USERMAKEAVAILABLELABELPROVIDED UseType = 49
-	UseType_USE_TYPE_USERMAKEAVAILABLEUSERPROVIDED  UseType = 50
-	UseType_USE_TYPE_WEBCAST                        UseType = 51
-	UseType_USE_TYPE_CABLE                          UseType = 52
-	UseType_USE_TYPE_DUB                            UseType = 53
-	UseType_USE_TYPE_DUBFORONDEMANDSTREAMING        UseType = 54
-	UseType_USE_TYPE_PERFORM                        UseType = 55
-	UseType_USE_TYPE_USE                            UseType = 56
-	UseType_USE_TYPE_USEFORGENERATIVEAI             UseType = 57
+	// @xml: "UserMakeAvailableUserProvided"
+	UseType_USE_TYPE_USERMAKEAVAILABLEUSERPROVIDED UseType = 50
+	// @xml: "Webcast"
+	UseType_USE_TYPE_WEBCAST UseType = 51
+	// @xml: "Cable"
+	UseType_USE_TYPE_CABLE UseType = 52
+	// @xml: "Dub"
+	UseType_USE_TYPE_DUB UseType = 53
+	// @xml: "DubForOnDemandStreaming"
+	UseType_USE_TYPE_DUBFORONDEMANDSTREAMING UseType = 54
+	// @xml: "Perform"
+	UseType_USE_TYPE_PERFORM UseType = 55
+	// @xml: "Use"
+	UseType_USE_TYPE_USE UseType = 56
+	// @xml: "UseForGenerativeAI"
+	UseType_USE_TYPE_USEFORGENERATIVEAI UseType = 57
 )
 
 // Enum value maps for UseType.
@@ -18068,21 +22165,35 @@ func (UseType) EnumDescriptor() ([]byte, []int) {
 type UserInterfaceType int32
 
 const (
-	UserInterfaceType_USER_INTERFACE_TYPE_UNSPECIFIED          UserInterfaceType = 0
-	UserInterfaceType_USER_INTERFACE_TYPE_ASPERCONTRACT        UserInterfaceType = 1
-	UserInterfaceType_USER_INTERFACE_TYPE_CONNECTEDDEVICE      UserInterfaceType = 2
-	UserInterfaceType_USER_INTERFACE_TYPE_GAMECONSOLE          UserInterfaceType = 3
-	UserInterfaceType_USER_INTERFACE_TYPE_JUKEBOX              UserInterfaceType = 4
-	UserInterfaceType_USER_INTERFACE_TYPE_KARAOKEMACHINE       UserInterfaceType = 5
-	UserInterfaceType_USER_INTERFACE_TYPE_KIOSK                UserInterfaceType = 6
-	UserInterfaceType_USER_INTERFACE_TYPE_LOCALSTORAGEJUKEBOX  UserInterfaceType = 7
-	UserInterfaceType_USER_INTERFACE_TYPE_PERSONALCOMPUTER     UserInterfaceType = 8
-	UserInterfaceType_USER_INTERFACE_TYPE_PHYSICALMEDIAWRITER  UserInterfaceType = 9
-	UserInterfaceType_USER_INTERFACE_TYPE_PORTABLEDEVICE       UserInterfaceType = 10
+	UserInterfaceType_USER_INTERFACE_TYPE_UNSPECIFIED UserInterfaceType = 0
+	// @xml: "AsPerContract"
+	UserInterfaceType_USER_INTERFACE_TYPE_ASPERCONTRACT UserInterfaceType = 1
+	// @xml: "ConnectedDevice"
+	UserInterfaceType_USER_INTERFACE_TYPE_CONNECTEDDEVICE UserInterfaceType = 2
+	// @xml: "GameConsole"
+	UserInterfaceType_USER_INTERFACE_TYPE_GAMECONSOLE UserInterfaceType = 3
+	// @xml: "Jukebox"
+	UserInterfaceType_USER_INTERFACE_TYPE_JUKEBOX UserInterfaceType = 4
+	// @xml: "KaraokeMachine"
+	UserInterfaceType_USER_INTERFACE_TYPE_KARAOKEMACHINE UserInterfaceType = 5
+	// @xml: "Kiosk"
+	UserInterfaceType_USER_INTERFACE_TYPE_KIOSK UserInterfaceType = 6
+	// @xml: "LocalStorageJukebox"
+	UserInterfaceType_USER_INTERFACE_TYPE_LOCALSTORAGEJUKEBOX UserInterfaceType = 7
+	// @xml: "PersonalComputer"
+	UserInterfaceType_USER_INTERFACE_TYPE_PERSONALCOMPUTER UserInterfaceType = 8
+	// @xml: "PhysicalMediaWriter"
+	UserInterfaceType_USER_INTERFACE_TYPE_PHYSICALMEDIAWRITER UserInterfaceType = 9
+	// @xml: "PortableDevice"
+	UserInterfaceType_USER_INTERFACE_TYPE_PORTABLEDEVICE UserInterfaceType = 10
+	// @xml: "RemoteStorageJukebox"
 	UserInterfaceType_USER_INTERFACE_TYPE_REMOTESTORAGEJUKEBOX UserInterfaceType = 11
-	UserInterfaceType_USER_INTERFACE_TYPE_UNKNOWN              UserInterfaceType = 12
-	UserInterfaceType_USER_INTERFACE_TYPE_USERDEFINED          UserInterfaceType = 13
-	UserInterfaceType_USER_INTERFACE_TYPE_SMARTSPEAKERS        UserInterfaceType = 14
+	// @xml: "Unknown"
+	UserInterfaceType_USER_INTERFACE_TYPE_UNKNOWN UserInterfaceType = 12
+	// @xml: "UserDefined"
+	UserInterfaceType_USER_INTERFACE_TYPE_USERDEFINED UserInterfaceType = 13
+	// @xml: "SmartSpeakers"
+	UserInterfaceType_USER_INTERFACE_TYPE_SMARTSPEAKERS UserInterfaceType = 14
 )
 
 // Enum value maps for UserInterfaceType.
@@ -18154,9 +22265,12 @@ type ValueType int32
 
 const (
 	ValueType_VALUE_TYPE_UNSPECIFIED ValueType = 0
-	ValueType_VALUE_TYPE_CALCULATED  ValueType = 1
-	ValueType_VALUE_TYPE_MAXIMUM     ValueType = 2
-	ValueType_VALUE_TYPE_MINIMUM     ValueType = 3
+	// @xml: "Calculated"
+	ValueType_VALUE_TYPE_CALCULATED ValueType = 1
+	// @xml: "Maximum"
+	ValueType_VALUE_TYPE_MAXIMUM ValueType = 2
+	// @xml: "Minimum"
+	ValueType_VALUE_TYPE_MINIMUM ValueType = 3
 )
 
 // Enum value maps for ValueType.
@@ -18206,18 +22320,30 @@ type VideoCodecType int32
 
 const (
 	VideoCodecType_VIDEO_CODEC_TYPE_UNSPECIFIED VideoCodecType = 0
-	VideoCodecType_VIDEO_CODEC_TYPE_AVC         VideoCodecType = 1
-	VideoCodecType_VIDEO_CODEC_TYPE_H_261       VideoCodecType = 2
-	VideoCodecType_VIDEO_CODEC_TYPE_H_263       VideoCodecType = 3
-	VideoCodecType_VIDEO_CODEC_TYPE_MPEG_1      VideoCodecType = 4
-	VideoCodecType_VIDEO_CODEC_TYPE_MPEG_2      VideoCodecType = 5
-	VideoCodecType_VIDEO_CODEC_TYPE_MPEG_4      VideoCodecType = 6
-	VideoCodecType_VIDEO_CODEC_TYPE_QUICKTIME   VideoCodecType = 7
-	VideoCodecType_VIDEO_CODEC_TYPE_REALVIDEO   VideoCodecType = 8
-	VideoCodecType_VIDEO_CODEC_TYPE_SHOCKWAVE   VideoCodecType = 9
-	VideoCodecType_VIDEO_CODEC_TYPE_UNKNOWN     VideoCodecType = 10
+	// @xml: "AVC"
+	VideoCodecType_VIDEO_CODEC_TYPE_AVC VideoCodecType = 1
+	// @xml: "H.261"
+	VideoCodecType_VIDEO_CODEC_TYPE_H_261 VideoCodecType = 2
+	// @xml: "H.263"
+	VideoCodecType_VIDEO_CODEC_TYPE_H_263 VideoCodecType = 3
+	// @xml: "MPEG-1"
+	VideoCodecType_VIDEO_CODEC_TYPE_MPEG_1 VideoCodecType = 4
+	// @xml: "MPEG-2"
+	VideoCodecType_VIDEO_CODEC_TYPE_MPEG_2 VideoCodecType = 5
+	// @xml: "MPEG-4"
+	VideoCodecType_VIDEO_CODEC_TYPE_MPEG_4 VideoCodecType = 6
+	// @xml: "QuickTime"
+	VideoCodecType_VIDEO_CODEC_TYPE_QUICKTIME VideoCodecType = 7
+	// @xml: "RealVideo"
+	VideoCodecType_VIDEO_CODEC_TYPE_REALVIDEO VideoCodecType = 8
+	// @xml: "Shockwave"
+	VideoCodecType_VIDEO_CODEC_TYPE_SHOCKWAVE VideoCodecType = 9
+	// @xml: "Unknown"
+	VideoCodecType_VIDEO_CODEC_TYPE_UNKNOWN VideoCodecType = 10
+	// @xml: "UserDefined"
 	VideoCodecType_VIDEO_CODEC_TYPE_USERDEFINED VideoCodecType = 11
-	VideoCodecType_VIDEO_CODEC_TYPE_WMV         VideoCodecType = 12
+	// @xml: "WMV"
+	VideoCodecType_VIDEO_CODEC_TYPE_WMV VideoCodecType = 12
 )
 
 // Enum value maps for VideoCodecType.
@@ -18284,9 +22410,12 @@ func (VideoCodecType) EnumDescriptor() ([]byte, []int) {
 type VideoContentType int32
 
 const (
-	VideoContentType_VIDEO_CONTENT_TYPE_UNSPECIFIED            VideoContentType = 0
-	VideoContentType_VIDEO_CONTENT_TYPE_ACTEDVIDEO             VideoContentType = 1
-	VideoContentType_VIDEO_CONTENT_TYPE_ANIMATION              VideoContentType = 2
+	VideoContentType_VIDEO_CONTENT_TYPE_UNSPECIFIED VideoContentType = 0
+	// @xml: "ActedVideo"
+	VideoContentType_VIDEO_CONTENT_TYPE_ACTEDVIDEO VideoContentType = 1
+	// @xml: "Animation"
+	VideoContentType_VIDEO_CONTENT_TYPE_ANIMATION VideoContentType = 2
+	// @xml: "AnimationAndActedVideo"
 	VideoContentType_VIDEO_CONTENT_TYPE_ANIMATIONANDACTEDVIDEO VideoContentType = 3
 )
 
@@ -18336,10 +22465,13 @@ func (VideoContentType) EnumDescriptor() ([]byte, []int) {
 type VideoDefinitionType int32
 
 const (
-	VideoDefinitionType_VIDEO_DEFINITION_TYPE_UNSPECIFIED        VideoDefinitionType = 0
-	VideoDefinitionType_VIDEO_DEFINITION_TYPE_HIGHDEFINITION     VideoDefinitionType = 1
+	VideoDefinitionType_VIDEO_DEFINITION_TYPE_UNSPECIFIED VideoDefinitionType = 0
+	// @xml: "HighDefinition"
+	VideoDefinitionType_VIDEO_DEFINITION_TYPE_HIGHDEFINITION VideoDefinitionType = 1
+	// @xml: "StandardDefinition"
 	VideoDefinitionType_VIDEO_DEFINITION_TYPE_STANDARDDEFINITION VideoDefinitionType = 2
-	VideoDefinitionType_VIDEO_DEFINITION_TYPE_USERDEFINED        VideoDefinitionType = 3
+	// @xml: "UserDefined"
+	VideoDefinitionType_VIDEO_DEFINITION_TYPE_USERDEFINED VideoDefinitionType = 3
 )
 
 // Enum value maps for VideoDefinitionType.
@@ -18388,76 +22520,145 @@ func (VideoDefinitionType) EnumDescriptor() ([]byte, []int) {
 type VideoType int32
 
 const (
-	VideoType_VIDEO_TYPE_UNSPECIFIED                   VideoType = 0
-	VideoType_VIDEO_TYPE_ADVERTISEMENTVIDEO            VideoType = 1
-	VideoType_VIDEO_TYPE_ANIMATION                     VideoType = 2
-	VideoType_VIDEO_TYPE_BEHINDTHESCENES               VideoType = 3
-	VideoType_VIDEO_TYPE_CONCERTCLIP                   VideoType = 4
-	VideoType_VIDEO_TYPE_CONCERTVIDEO                  VideoType = 5
-	VideoType_VIDEO_TYPE_CORPORATEFILM                 VideoType = 6
-	VideoType_VIDEO_TYPE_CREDITS                       VideoType = 7
-	VideoType_VIDEO_TYPE_DOCUMENTARY                   VideoType = 8
-	VideoType_VIDEO_TYPE_EDUCATIONALVIDEO              VideoType = 9
-	VideoType_VIDEO_TYPE_EPISODE                       VideoType = 10
-	VideoType_VIDEO_TYPE_FEATUREFILM                   VideoType = 11
-	VideoType_VIDEO_TYPE_INFOMERCIALVIDEO              VideoType = 12
-	VideoType_VIDEO_TYPE_INTERVIEW                     VideoType = 13
-	VideoType_VIDEO_TYPE_KARAOKE                       VideoType = 14
-	VideoType_VIDEO_TYPE_LIVEEVENTVIDEO                VideoType = 15
-	VideoType_VIDEO_TYPE_LONGFORMMUSICALWORKVIDEO      VideoType = 16
-	VideoType_VIDEO_TYPE_LONGFORMNONMUSICALWORKVIDEO   VideoType = 17
-	VideoType_VIDEO_TYPE_LYRICVIDEO                    VideoType = 18
-	VideoType_VIDEO_TYPE_MENU                          VideoType = 19
-	VideoType_VIDEO_TYPE_MULTIMEDIAVIDEO               VideoType = 20
-	VideoType_VIDEO_TYPE_MUSICALWORKCLIP               VideoType = 21
-	VideoType_VIDEO_TYPE_MUSICALWORKREADALONGVIDEO     VideoType = 22
-	VideoType_VIDEO_TYPE_MUSICALWORKTRAILER            VideoType = 23
-	VideoType_VIDEO_TYPE_MUSICALWORKVIDEOCHAPTER       VideoType = 24
-	VideoType_VIDEO_TYPE_NEWS                          VideoType = 25
-	VideoType_VIDEO_TYPE_NONMUSICALWORKCLIP            VideoType = 26
-	VideoType_VIDEO_TYPE_NONMUSICALWORKREADALONGVIDEO  VideoType = 27
-	VideoType_VIDEO_TYPE_NONMUSICALWORKTRAILER         VideoType = 28
-	VideoType_VIDEO_TYPE_NONMUSICALWORKVIDEOCHAPTER    VideoType = 29
+	VideoType_VIDEO_TYPE_UNSPECIFIED VideoType = 0
+	// @xml: "AdvertisementVideo"
+	VideoType_VIDEO_TYPE_ADVERTISEMENTVIDEO VideoType = 1
+	// @xml: "Animation"
+	VideoType_VIDEO_TYPE_ANIMATION VideoType = 2
+	// @xml: "BehindTheScenes"
+	VideoType_VIDEO_TYPE_BEHINDTHESCENES VideoType = 3
+	// @xml: "ConcertClip"
+	VideoType_VIDEO_TYPE_CONCERTCLIP VideoType = 4
+	// @xml: "ConcertVideo"
+	VideoType_VIDEO_TYPE_CONCERTVIDEO VideoType = 5
+	// @xml: "CorporateFilm"
+	VideoType_VIDEO_TYPE_CORPORATEFILM VideoType = 6
+	// @xml: "Credits"
+	VideoType_VIDEO_TYPE_CREDITS VideoType = 7
+	// @xml: "Documentary"
+	VideoType_VIDEO_TYPE_DOCUMENTARY VideoType = 8
+	// @xml: "EducationalVideo"
+	VideoType_VIDEO_TYPE_EDUCATIONALVIDEO VideoType = 9
+	// @xml: "Episode"
+	VideoType_VIDEO_TYPE_EPISODE VideoType = 10
+	// @xml: "FeatureFilm"
+	VideoType_VIDEO_TYPE_FEATUREFILM VideoType = 11
+	// @xml: "InfomercialVideo"
+	VideoType_VIDEO_TYPE_INFOMERCIALVIDEO VideoType = 12
+	// @xml: "Interview"
+	VideoType_VIDEO_TYPE_INTERVIEW VideoType = 13
+	// @xml: "Karaoke"
+	VideoType_VIDEO_TYPE_KARAOKE VideoType = 14
+	// @xml: "LiveEventVideo"
+	VideoType_VIDEO_TYPE_LIVEEVENTVIDEO VideoType = 15
+	// @xml: "LongFormMusicalWorkVideo"
+	VideoType_VIDEO_TYPE_LONGFORMMUSICALWORKVIDEO VideoType = 16
+	// @xml: "LongFormNonMusicalWorkVideo"
+	VideoType_VIDEO_TYPE_LONGFORMNONMUSICALWORKVIDEO VideoType = 17
+	// @xml: "LyricVideo"
+	VideoType_VIDEO_TYPE_LYRICVIDEO VideoType = 18
+	// @xml: "Menu"
+	VideoType_VIDEO_TYPE_MENU VideoType = 19
+	// @xml: "MultimediaVideo"
+	VideoType_VIDEO_TYPE_MULTIMEDIAVIDEO VideoType = 20
+	// @xml: "MusicalWorkClip"
+	VideoType_VIDEO_TYPE_MUSICALWORKCLIP VideoType = 21
+	// @xml: "MusicalWorkReadalongVideo"
+	VideoType_VIDEO_TYPE_MUSICALWORKREADALONGVIDEO VideoType = 22
+	// @xml: "MusicalWorkTrailer"
+	VideoType_VIDEO_TYPE_MUSICALWORKTRAILER VideoType = 23
+	// @xml: "MusicalWorkVideoChapter"
+	VideoType_VIDEO_TYPE_MUSICALWORKVIDEOCHAPTER VideoType = 24
+	// @xml: "News"
+	VideoType_VIDEO_TYPE_NEWS VideoType = 25
+	// @xml: "NonMusicalWorkClip"
+	VideoType_VIDEO_TYPE_NONMUSICALWORKCLIP VideoType = 26
+	// @xml: "NonMusicalWorkReadalongVideo"
+	VideoType_VIDEO_TYPE_NONMUSICALWORKREADALONGVIDEO VideoType = 27
+	// @xml: "NonMusicalWorkTrailer"
+	VideoType_VIDEO_TYPE_NONMUSICALWORKTRAILER VideoType = 28
+	// @xml: "NonMusicalWorkVideoChapter"
+	VideoType_VIDEO_TYPE_NONMUSICALWORKVIDEOCHAPTER VideoType = 29
+	// @xml: "NonSerialAudioVisualRecording"
 	VideoType_VIDEO_TYPE_NONSERIALAUDIOVISUALRECORDING VideoType = 30
-	VideoType_VIDEO_TYPE_OPERAVIDEO                    VideoType = 31
-	VideoType_VIDEO_TYPE_PERFORMANCE                   VideoType = 32
-	VideoType_VIDEO_TYPE_SEASON                        VideoType = 33
-	VideoType_VIDEO_TYPE_SERIES                        VideoType = 34
-	VideoType_VIDEO_TYPE_SHORTFILM                     VideoType = 35
-	VideoType_VIDEO_TYPE_SHORTFORMMUSICALWORKVIDEO     VideoType = 36
-	VideoType_VIDEO_TYPE_SHORTFORMNONMUSICALWORKVIDEO  VideoType = 37
-	VideoType_VIDEO_TYPE_SPECIALEVENT                  VideoType = 38
-	VideoType_VIDEO_TYPE_SPORT                         VideoType = 39
-	VideoType_VIDEO_TYPE_THEATRICALWORKVIDEO           VideoType = 40
-	VideoType_VIDEO_TYPE_TRAILERVIDEO                  VideoType = 41
-	VideoType_VIDEO_TYPE_TVFILM                        VideoType = 42
-	VideoType_VIDEO_TYPE_TVSHOWVIDEO                   VideoType = 43
-	VideoType_VIDEO_TYPE_UNKNOWN                       VideoType = 44
-	VideoType_VIDEO_TYPE_USERDEFINED                   VideoType = 45
-	VideoType_VIDEO_TYPE_VIDEOCHAPTER                  VideoType = 46
-	VideoType_VIDEO_TYPE_VIDEOSTEM                     VideoType = 47
-	VideoType_VIDEO_TYPE_ADULTCONTENT                  VideoType = 48
-	VideoType_VIDEO_TYPE_ADVICEMAGAZINE                VideoType = 49
-	VideoType_VIDEO_TYPE_BALLETVIDEO                   VideoType = 50
-	VideoType_VIDEO_TYPE_BLACKANDWHITEVIDEO            VideoType = 51
-	VideoType_VIDEO_TYPE_CHILDRENSFILM                 VideoType = 52
-	VideoType_VIDEO_TYPE_COLORIZEDVIDEO                VideoType = 53
-	VideoType_VIDEO_TYPE_COLUMNVIDEO                   VideoType = 54
-	VideoType_VIDEO_TYPE_FICTION                       VideoType = 55
-	VideoType_VIDEO_TYPE_MAGAZINE                      VideoType = 56
-	VideoType_VIDEO_TYPE_READALONGVIDEO                VideoType = 57
-	VideoType_VIDEO_TYPE_REALITYTVSHOWVIDEO            VideoType = 58
-	VideoType_VIDEO_TYPE_SERIALAUDIOVISUALRECORDING    VideoType = 59
-	VideoType_VIDEO_TYPE_SILENTVIDEO                   VideoType = 60
-	VideoType_VIDEO_TYPE_SKETCHVIDEO                   VideoType = 61
-	VideoType_VIDEO_TYPE_SOAPSITCOM                    VideoType = 62
-	VideoType_VIDEO_TYPE_TVPROGRAM                     VideoType = 63
-	VideoType_VIDEO_TYPE_VIDEOCLIP                     VideoType = 64
-	VideoType_VIDEO_TYPE_VIDEOREPORT                   VideoType = 65
-	VideoType_VIDEO_TYPE_DRAMA                         VideoType = 66
-	VideoType_VIDEO_TYPE_DRAMATICOMUSICALVIDEO         VideoType = 67
-	VideoType_VIDEO_TYPE_INTERACTIVERESOURCE           VideoType = 68
-	VideoType_VIDEO_TYPE_WEBRESOURCE                   VideoType = 69
+	// @xml: "OperaVideo"
+	VideoType_VIDEO_TYPE_OPERAVIDEO VideoType = 31
+	// @xml: "Performance"
+	VideoType_VIDEO_TYPE_PERFORMANCE VideoType = 32
+	// @xml: "Season"
+	VideoType_VIDEO_TYPE_SEASON VideoType = 33
+	// @xml: "Series"
+	VideoType_VIDEO_TYPE_SERIES VideoType = 34
+	// @xml: "ShortFilm"
+	VideoType_VIDEO_TYPE_SHORTFILM VideoType = 35
+	// @xml: "ShortFormMusicalWorkVideo"
+	VideoType_VIDEO_TYPE_SHORTFORMMUSICALWORKVIDEO VideoType = 36
+	// @xml: "ShortFormNonMusicalWorkVideo"
+	VideoType_VIDEO_TYPE_SHORTFORMNONMUSICALWORKVIDEO VideoType = 37
+	// @xml: "SpecialEvent"
+	VideoType_VIDEO_TYPE_SPECIALEVENT VideoType = 38
+	// @xml: "Sport"
+	VideoType_VIDEO_TYPE_SPORT VideoType = 39
+	// @xml: "TheatricalWorkVideo"
+	VideoType_VIDEO_TYPE_THEATRICALWORKVIDEO VideoType = 40
+	// @xml: "TrailerVideo"
+	VideoType_VIDEO_TYPE_TRAILERVIDEO VideoType = 41
+	// @xml: "TvFilm"
+	VideoType_VIDEO_TYPE_TVFILM VideoType = 42
+	// @xml: "TvShowVideo"
+	VideoType_VIDEO_TYPE_TVSHOWVIDEO VideoType = 43
+	// @xml: "Unknown"
+	VideoType_VIDEO_TYPE_UNKNOWN VideoType = 44
+	// @xml: "UserDefined"
+	VideoType_VIDEO_TYPE_USERDEFINED VideoType = 45
+	// @xml: "VideoChapter"
+	VideoType_VIDEO_TYPE_VIDEOCHAPTER VideoType = 46
+	// @xml: "VideoStem"
+	VideoType_VIDEO_TYPE_VIDEOSTEM VideoType = 47
+	// @xml: "AdultContent"
+	VideoType_VIDEO_TYPE_ADULTCONTENT VideoType = 48
+	// @xml: "AdviceMagazine"
+	VideoType_VIDEO_TYPE_ADVICEMAGAZINE VideoType = 49
+	// @xml: "BalletVideo"
+	VideoType_VIDEO_TYPE_BALLETVIDEO VideoType = 50
+	// @xml: "BlackAndWhiteVideo"
+	VideoType_VIDEO_TYPE_BLACKANDWHITEVIDEO VideoType = 51
+	// @xml: "ChildrensFilm"
+	VideoType_VIDEO_TYPE_CHILDRENSFILM VideoType = 52
+	// @xml: "ColorizedVideo"
+	VideoType_VIDEO_TYPE_COLORIZEDVIDEO VideoType = 53
+	// @xml: "ColumnVideo"
+	VideoType_VIDEO_TYPE_COLUMNVIDEO VideoType = 54
+	// @xml: "Fiction"
+	VideoType_VIDEO_TYPE_FICTION VideoType = 55
+	// @xml: "Magazine"
+	VideoType_VIDEO_TYPE_MAGAZINE VideoType = 56
+	// @xml: "ReadalongVideo"
+	VideoType_VIDEO_TYPE_READALONGVIDEO VideoType = 57
+	// @xml: "RealityTvShowVideo"
+	VideoType_VIDEO_TYPE_REALITYTVSHOWVIDEO VideoType = 58
+	// @xml: "SerialAudioVisualRecording"
+	VideoType_VIDEO_TYPE_SERIALAUDIOVISUALRECORDING VideoType = 59
+	// @xml: "SilentVideo"
+	VideoType_VIDEO_TYPE_SILENTVIDEO VideoType = 60
+	// @xml: "SketchVideo"
+	VideoType_VIDEO_TYPE_SKETCHVIDEO VideoType = 61
+	// @xml: "SoapSitcom"
+	VideoType_VIDEO_TYPE_SOAPSITCOM VideoType = 62
+	// @xml: "TvProgram"
+	VideoType_VIDEO_TYPE_TVPROGRAM VideoType = 63
+	// @xml: "VideoClip"
+	VideoType_VIDEO_TYPE_VIDEOCLIP VideoType = 64
+	// @xml: "VideoReport"
+	VideoType_VIDEO_TYPE_VIDEOREPORT VideoType = 65
+	// @xml: "Drama"
+	VideoType_VIDEO_TYPE_DRAMA VideoType = 66
+	// @xml: "DramaticoMusicalVideo"
+	VideoType_VIDEO_TYPE_DRAMATICOMUSICALVIDEO VideoType = 67
+	// @xml: "InteractiveResource"
+	VideoType_VIDEO_TYPE_INTERACTIVERESOURCE VideoType = 68
+	// @xml: "WebResource"
+	VideoType_VIDEO_TYPE_WEBRESOURCE VideoType = 69
 )
 
 // Enum value maps for VideoType.
@@ -18639,9 +22840,12 @@ type VisualPerceptionType int32
 
 const (
 	VisualPerceptionType_VISUAL_PERCEPTION_TYPE_UNSPECIFIED VisualPerceptionType = 0
-	VisualPerceptionType_VISUAL_PERCEPTION_TYPE_BACKGROUND  VisualPerceptionType = 1
+	// @xml: "Background"
+	VisualPerceptionType_VISUAL_PERCEPTION_TYPE_BACKGROUND VisualPerceptionType = 1
+	// @xml: "UserDefined"
 	VisualPerceptionType_VISUAL_PERCEPTION_TYPE_USERDEFINED VisualPerceptionType = 2
-	VisualPerceptionType_VISUAL_PERCEPTION_TYPE_VISUAL      VisualPerceptionType = 3
+	// @xml: "Visual"
+	VisualPerceptionType_VISUAL_PERCEPTION_TYPE_VISUAL VisualPerceptionType = 3
 )
 
 // Enum value maps for VisualPerceptionType.
@@ -18690,10 +22894,13 @@ func (VisualPerceptionType) EnumDescriptor() ([]byte, []int) {
 type VocalType int32
 
 const (
-	VocalType_VOCAL_TYPE_UNSPECIFIED  VocalType = 0
+	VocalType_VOCAL_TYPE_UNSPECIFIED VocalType = 0
+	// @xml: "Instrumental"
 	VocalType_VOCAL_TYPE_INSTRUMENTAL VocalType = 1
-	VocalType_VOCAL_TYPE_USERDEFINED  VocalType = 2
-	VocalType_VOCAL_TYPE_VOCAL        VocalType = 3
+	// @xml: "UserDefined"
+	VocalType_VOCAL_TYPE_USERDEFINED VocalType = 2
+	// @xml: "Vocal"
+	VocalType_VOCAL_TYPE_VOCAL VocalType = 3
 )
 
 // Enum value maps for VocalType.
@@ -18742,11 +22949,15 @@ func (VocalType) EnumDescriptor() ([]byte, []int) {
 type WsMessageStatus int32
 
 const (
-	WsMessageStatus_WS_MESSAGE_STATUS_UNSPECIFIED                     WsMessageStatus = 0
-	WsMessageStatus_WS_MESSAGE_STATUS_BACKENDPROCESSINGERROR          WsMessageStatus = 1
-	WsMessageStatus_WS_MESSAGE_STATUS_NOVALIDMESSAGERECEIVED          WsMessageStatus = 2
+	WsMessageStatus_WS_MESSAGE_STATUS_UNSPECIFIED WsMessageStatus = 0
+	// @xml: "BackendProcessingError"
+	WsMessageStatus_WS_MESSAGE_STATUS_BACKENDPROCESSINGERROR WsMessageStatus = 1
+	// @xml: "NoValidMessageReceived"
+	WsMessageStatus_WS_MESSAGE_STATUS_NOVALIDMESSAGERECEIVED WsMessageStatus = 2
+	// @xml: "ValidMessageQueuedForProcessing"
 	WsMessageStatus_WS_MESSAGE_STATUS_VALIDMESSAGEQUEUEDFORPROCESSING WsMessageStatus = 3
-	WsMessageStatus_WS_MESSAGE_STATUS_VALIDMESSAGERECEIVED            WsMessageStatus = 4
+	// @xml: "ValidMessageReceived"
+	WsMessageStatus_WS_MESSAGE_STATUS_VALIDMESSAGERECEIVED WsMessageStatus = 4
 )
 
 // Enum value maps for WsMessageStatus.
@@ -18798,260 +23009,514 @@ type TerritoryCode int32
 
 const (
 	TerritoryCode_TERRITORY_CODE_UNSPECIFIED TerritoryCode = 0
-	TerritoryCode_TERRITORY_CODE_AD          TerritoryCode = 1
-	TerritoryCode_TERRITORY_CODE_AE          TerritoryCode = 2
-	TerritoryCode_TERRITORY_CODE_AF          TerritoryCode = 3
-	TerritoryCode_TERRITORY_CODE_AG          TerritoryCode = 4
-	TerritoryCode_TERRITORY_CODE_AI          TerritoryCode = 5
-	TerritoryCode_TERRITORY_CODE_AL          TerritoryCode = 6
-	TerritoryCode_TERRITORY_CODE_AM          TerritoryCode = 7
-	TerritoryCode_TERRITORY_CODE_AN          TerritoryCode = 8
-	TerritoryCode_TERRITORY_CODE_AO          TerritoryCode = 9
-	TerritoryCode_TERRITORY_CODE_AQ          TerritoryCode = 10
-	TerritoryCode_TERRITORY_CODE_AR          TerritoryCode = 11
-	TerritoryCode_TERRITORY_CODE_AS          TerritoryCode = 12
-	TerritoryCode_TERRITORY_CODE_AT          TerritoryCode = 13
-	TerritoryCode_TERRITORY_CODE_AU          TerritoryCode = 14
-	TerritoryCode_TERRITORY_CODE_AW          TerritoryCode = 15
-	TerritoryCode_TERRITORY_CODE_AX          TerritoryCode = 16
-	TerritoryCode_TERRITORY_CODE_AZ          TerritoryCode = 17
-	TerritoryCode_TERRITORY_CODE_BA          TerritoryCode = 18
-	TerritoryCode_TERRITORY_CODE_BB          TerritoryCode = 19
-	TerritoryCode_TERRITORY_CODE_BD          TerritoryCode = 20
-	TerritoryCode_TERRITORY_CODE_BE          TerritoryCode = 21
-	TerritoryCode_TERRITORY_CODE_BF          TerritoryCode = 22
-	TerritoryCode_TERRITORY_CODE_BG          TerritoryCode = 23
-	TerritoryCode_TERRITORY_CODE_BH          TerritoryCode = 24
-	TerritoryCode_TERRITORY_CODE_BI          TerritoryCode = 25
-	TerritoryCode_TERRITORY_CODE_BJ          TerritoryCode = 26
-	TerritoryCode_TERRITORY_CODE_BL          TerritoryCode = 27
-	TerritoryCode_TERRITORY_CODE_BM          TerritoryCode = 28
-	TerritoryCode_TERRITORY_CODE_BN          TerritoryCode = 29
-	TerritoryCode_TERRITORY_CODE_BO          TerritoryCode = 30
-	TerritoryCode_TERRITORY_CODE_BQ          TerritoryCode = 31
-	TerritoryCode_TERRITORY_CODE_BR          TerritoryCode = 32
-	TerritoryCode_TERRITORY_CODE_BS          TerritoryCode = 33
-	TerritoryCode_TERRITORY_CODE_BT          TerritoryCode = 34
-	TerritoryCode_TERRITORY_CODE_BV          TerritoryCode = 35
-	TerritoryCode_TERRITORY_CODE_BW          TerritoryCode = 36
-	TerritoryCode_TERRITORY_CODE_BY          TerritoryCode = 37
-	TerritoryCode_TERRITORY_CODE_BZ          TerritoryCode = 38
-	TerritoryCode_TERRITORY_CODE_CA          TerritoryCode = 39
-	TerritoryCode_TERRITORY_CODE_CC          TerritoryCode = 40
-	TerritoryCode_TERRITORY_CODE_CD          TerritoryCode = 41
-	TerritoryCode_TERRITORY_CODE_CF          TerritoryCode = 42
-	TerritoryCode_TERRITORY_CODE_CG          TerritoryCode = 43
-	TerritoryCode_TERRITORY_CODE_CH          TerritoryCode = 44
-	TerritoryCode_TERRITORY_CODE_CI          TerritoryCode = 45
-	TerritoryCode_TERRITORY_CODE_CK          TerritoryCode = 46
-	TerritoryCode_TERRITORY_CODE_CL          TerritoryCode = 47
-	TerritoryCode_TERRITORY_CODE_CM          TerritoryCode = 48
-	TerritoryCode_TERRITORY_CODE_CN          TerritoryCode = 49
-	TerritoryCode_TERRITORY_CODE_CO          TerritoryCode = 50
-	TerritoryCode_TERRITORY_CODE_CR          TerritoryCode = 51
-	TerritoryCode_TERRITORY_CODE_CS          TerritoryCode = 52
-	TerritoryCode_TERRITORY_CODE_CU          TerritoryCode = 53
-	TerritoryCode_TERRITORY_CODE_CV          TerritoryCode = 54
-	TerritoryCode_TERRITORY_CODE_CW          TerritoryCode = 55
-	TerritoryCode_TERRITORY_CODE_CX          TerritoryCode = 56
-	TerritoryCode_TERRITORY_CODE_CY          TerritoryCode = 57
-	TerritoryCode_TERRITORY_CODE_CZ          TerritoryCode = 58
-	TerritoryCode_TERRITORY_CODE_DE          TerritoryCode = 59
-	TerritoryCode_TERRITORY_CODE_DJ          TerritoryCode = 60
-	TerritoryCode_TERRITORY_CODE_DK          TerritoryCode = 61
-	TerritoryCode_TERRITORY_CODE_DM          TerritoryCode = 62
-	TerritoryCode_TERRITORY_CODE_DO          TerritoryCode = 63
-	TerritoryCode_TERRITORY_CODE_DZ          TerritoryCode = 64
-	TerritoryCode_TERRITORY_CODE_EC          TerritoryCode = 65
-	TerritoryCode_TERRITORY_CODE_EE          TerritoryCode = 66
-	TerritoryCode_TERRITORY_CODE_EG          TerritoryCode = 67
-	TerritoryCode_TERRITORY_CODE_EH          TerritoryCode = 68
-	TerritoryCode_TERRITORY_CODE_ER          TerritoryCode = 69
-	TerritoryCode_TERRITORY_CODE_ES          TerritoryCode = 70
-	TerritoryCode_TERRITORY_CODE_ES_CE       TerritoryCode = 71
-	TerritoryCode_TERRITORY_CODE_ES_CN       TerritoryCode = 72
-	TerritoryCode_TERRITORY_CODE_ES_ML       TerritoryCode = 73
-	TerritoryCode_TERRITORY_CODE_ET          TerritoryCode = 74
-	TerritoryCode_TERRITORY_CODE_FI          TerritoryCode = 75
-	TerritoryCode_TERRITORY_CODE_FJ          TerritoryCode = 76
-	TerritoryCode_TERRITORY_CODE_FK          TerritoryCode = 77
-	TerritoryCode_TERRITORY_CODE_FM          TerritoryCode = 78
-	TerritoryCode_TERRITORY_CODE_FO          TerritoryCode = 79
-	TerritoryCode_TERRITORY_CODE_FR          TerritoryCode = 80
-	TerritoryCode_TERRITORY_CODE_GA          TerritoryCode = 81
-	TerritoryCode_TERRITORY_CODE_GB          TerritoryCode = 82
-	TerritoryCode_TERRITORY_CODE_GD          TerritoryCode = 83
-	TerritoryCode_TERRITORY_CODE_GE          TerritoryCode = 84
-	TerritoryCode_TERRITORY_CODE_GF          TerritoryCode = 85
-	TerritoryCode_TERRITORY_CODE_GG          TerritoryCode = 86
-	TerritoryCode_TERRITORY_CODE_GH          TerritoryCode = 87
-	TerritoryCode_TERRITORY_CODE_GI          TerritoryCode = 88
-	TerritoryCode_TERRITORY_CODE_GL          TerritoryCode = 89
-	TerritoryCode_TERRITORY_CODE_GM          TerritoryCode = 90
-	TerritoryCode_TERRITORY_CODE_GN          TerritoryCode = 91
-	TerritoryCode_TERRITORY_CODE_GP          TerritoryCode = 92
-	TerritoryCode_TERRITORY_CODE_GQ          TerritoryCode = 93
-	TerritoryCode_TERRITORY_CODE_GR          TerritoryCode = 94
-	TerritoryCode_TERRITORY_CODE_GS          TerritoryCode = 95
-	TerritoryCode_TERRITORY_CODE_GT          TerritoryCode = 96
-	TerritoryCode_TERRITORY_CODE_GU          TerritoryCode = 97
-	TerritoryCode_TERRITORY_CODE_GW          TerritoryCode = 98
-	TerritoryCode_TERRITORY_CODE_GY          TerritoryCode = 99
-	TerritoryCode_TERRITORY_CODE_HK          TerritoryCode = 100
-	TerritoryCode_TERRITORY_CODE_HM          TerritoryCode = 101
-	TerritoryCode_TERRITORY_CODE_HN          TerritoryCode = 102
-	TerritoryCode_TERRITORY_CODE_HR          TerritoryCode = 103
-	TerritoryCode_TERRITORY_CODE_HT          TerritoryCode = 104
-	TerritoryCode_TERRITORY_CODE_HU          TerritoryCode = 105
-	TerritoryCode_TERRITORY_CODE_ID          TerritoryCode = 106
-	TerritoryCode_TERRITORY_CODE_IE          TerritoryCode = 107
-	TerritoryCode_TERRITORY_CODE_IL          TerritoryCode = 108
-	TerritoryCode_TERRITORY_CODE_IM          TerritoryCode = 109
-	TerritoryCode_TERRITORY_CODE_IN          TerritoryCode = 110
-	TerritoryCode_TERRITORY_CODE_IO          TerritoryCode = 111
-	TerritoryCode_TERRITORY_CODE_IQ          TerritoryCode = 112
-	TerritoryCode_TERRITORY_CODE_IR          TerritoryCode = 113
-	TerritoryCode_TERRITORY_CODE_IS          TerritoryCode = 114
-	TerritoryCode_TERRITORY_CODE_IT          TerritoryCode = 115
-	TerritoryCode_TERRITORY_CODE_JE          TerritoryCode = 116
-	TerritoryCode_TERRITORY_CODE_JM          TerritoryCode = 117
-	TerritoryCode_TERRITORY_CODE_JO          TerritoryCode = 118
-	TerritoryCode_TERRITORY_CODE_JP          TerritoryCode = 119
-	TerritoryCode_TERRITORY_CODE_KE          TerritoryCode = 120
-	TerritoryCode_TERRITORY_CODE_KG          TerritoryCode = 121
-	TerritoryCode_TERRITORY_CODE_KH          TerritoryCode = 122
-	TerritoryCode_TERRITORY_CODE_KI          TerritoryCode = 123
-	TerritoryCode_TERRITORY_CODE_KM          TerritoryCode = 124
-	TerritoryCode_TERRITORY_CODE_KN          TerritoryCode = 125
-	TerritoryCode_TERRITORY_CODE_KP          TerritoryCode = 126
-	TerritoryCode_TERRITORY_CODE_KR          TerritoryCode = 127
-	TerritoryCode_TERRITORY_CODE_KW          TerritoryCode = 128
-	TerritoryCode_TERRITORY_CODE_KY          TerritoryCode = 129
-	TerritoryCode_TERRITORY_CODE_KZ          TerritoryCode = 130
-	TerritoryCode_TERRITORY_CODE_LA          TerritoryCode = 131
-	TerritoryCode_TERRITORY_CODE_LB          TerritoryCode = 132
-	TerritoryCode_TERRITORY_CODE_LC          TerritoryCode = 133
-	TerritoryCode_TERRITORY_CODE_LI          TerritoryCode = 134
-	TerritoryCode_TERRITORY_CODE_LK          TerritoryCode = 135
-	TerritoryCode_TERRITORY_CODE_LR          TerritoryCode = 136
-	TerritoryCode_TERRITORY_CODE_LS          TerritoryCode = 137
-	TerritoryCode_TERRITORY_CODE_LT          TerritoryCode = 138
-	TerritoryCode_TERRITORY_CODE_LU          TerritoryCode = 139
-	TerritoryCode_TERRITORY_CODE_LV          TerritoryCode = 140
-	TerritoryCode_TERRITORY_CODE_LY          TerritoryCode = 141
-	TerritoryCode_TERRITORY_CODE_MA          TerritoryCode = 142
-	TerritoryCode_TERRITORY_CODE_MC          TerritoryCode = 143
-	TerritoryCode_TERRITORY_CODE_MD          TerritoryCode = 144
-	TerritoryCode_TERRITORY_CODE_ME          TerritoryCode = 145
-	TerritoryCode_TERRITORY_CODE_MF          TerritoryCode = 146
-	TerritoryCode_TERRITORY_CODE_MG          TerritoryCode = 147
-	TerritoryCode_TERRITORY_CODE_MH          TerritoryCode = 148
-	TerritoryCode_TERRITORY_CODE_MK          TerritoryCode = 149
-	TerritoryCode_TERRITORY_CODE_ML          TerritoryCode = 150
-	TerritoryCode_TERRITORY_CODE_MM          TerritoryCode = 151
-	TerritoryCode_TERRITORY_CODE_MN          TerritoryCode = 152
-	TerritoryCode_TERRITORY_CODE_MO          TerritoryCode = 153
-	TerritoryCode_TERRITORY_CODE_MP          TerritoryCode = 154
-	TerritoryCode_TERRITORY_CODE_MQ          TerritoryCode = 155
-	TerritoryCode_TERRITORY_CODE_MR          TerritoryCode = 156
-	TerritoryCode_TERRITORY_CODE_MS          TerritoryCode = 157
-	TerritoryCode_TERRITORY_CODE_MT          TerritoryCode = 158
-	TerritoryCode_TERRITORY_CODE_MU          TerritoryCode = 159
-	TerritoryCode_TERRITORY_CODE_MV          TerritoryCode = 160
-	TerritoryCode_TERRITORY_CODE_MW          TerritoryCode = 161
-	TerritoryCode_TERRITORY_CODE_MX          TerritoryCode = 162
-	TerritoryCode_TERRITORY_CODE_MY          TerritoryCode = 163
-	TerritoryCode_TERRITORY_CODE_MZ          TerritoryCode = 164
-	TerritoryCode_TERRITORY_CODE_NA          TerritoryCode = 165
-	TerritoryCode_TERRITORY_CODE_NC          TerritoryCode = 166
-	TerritoryCode_TERRITORY_CODE_NE          TerritoryCode = 167
-	TerritoryCode_TERRITORY_CODE_NF          TerritoryCode = 168
-	TerritoryCode_TERRITORY_CODE_NG          TerritoryCode = 169
-	TerritoryCode_TERRITORY_CODE_NI          TerritoryCode = 170
-	TerritoryCode_TERRITORY_CODE_NL          TerritoryCode = 171
-	TerritoryCode_TERRITORY_CODE_NO          TerritoryCode = 172
-	TerritoryCode_TERRITORY_CODE_NP          TerritoryCode = 173
-	TerritoryCode_TERRITORY_CODE_NR          TerritoryCode = 174
-	TerritoryCode_TERRITORY_CODE_NU          TerritoryCode = 175
-	TerritoryCode_TERRITORY_CODE_NZ          TerritoryCode = 176
-	TerritoryCode_TERRITORY_CODE_OM          TerritoryCode = 177
-	TerritoryCode_TERRITORY_CODE_PA          TerritoryCode = 178
-	TerritoryCode_TERRITORY_CODE_PE          TerritoryCode = 179
-	TerritoryCode_TERRITORY_CODE_PF          TerritoryCode = 180
-	TerritoryCode_TERRITORY_CODE_PG          TerritoryCode = 181
-	TerritoryCode_TERRITORY_CODE_PH          TerritoryCode = 182
-	TerritoryCode_TERRITORY_CODE_PK          TerritoryCode = 183
-	TerritoryCode_TERRITORY_CODE_PL          TerritoryCode = 184
-	TerritoryCode_TERRITORY_CODE_PM          TerritoryCode = 185
-	TerritoryCode_TERRITORY_CODE_PN          TerritoryCode = 186
-	TerritoryCode_TERRITORY_CODE_PR          TerritoryCode = 187
-	TerritoryCode_TERRITORY_CODE_PS          TerritoryCode = 188
-	TerritoryCode_TERRITORY_CODE_PT          TerritoryCode = 189
-	TerritoryCode_TERRITORY_CODE_PW          TerritoryCode = 190
-	TerritoryCode_TERRITORY_CODE_PY          TerritoryCode = 191
-	TerritoryCode_TERRITORY_CODE_QA          TerritoryCode = 192
-	TerritoryCode_TERRITORY_CODE_RE          TerritoryCode = 193
-	TerritoryCode_TERRITORY_CODE_RO          TerritoryCode = 194
-	TerritoryCode_TERRITORY_CODE_RS          TerritoryCode = 195
-	TerritoryCode_TERRITORY_CODE_RU          TerritoryCode = 196
-	TerritoryCode_TERRITORY_CODE_RW          TerritoryCode = 197
-	TerritoryCode_TERRITORY_CODE_SA          TerritoryCode = 198
-	TerritoryCode_TERRITORY_CODE_SB          TerritoryCode = 199
-	TerritoryCode_TERRITORY_CODE_SC          TerritoryCode = 200
-	TerritoryCode_TERRITORY_CODE_SD          TerritoryCode = 201
-	TerritoryCode_TERRITORY_CODE_SE          TerritoryCode = 202
-	TerritoryCode_TERRITORY_CODE_SG          TerritoryCode = 203
-	TerritoryCode_TERRITORY_CODE_SH          TerritoryCode = 204
-	TerritoryCode_TERRITORY_CODE_SI          TerritoryCode = 205
-	TerritoryCode_TERRITORY_CODE_SJ          TerritoryCode = 206
-	TerritoryCode_TERRITORY_CODE_SK          TerritoryCode = 207
-	TerritoryCode_TERRITORY_CODE_SL          TerritoryCode = 208
-	TerritoryCode_TERRITORY_CODE_SM          TerritoryCode = 209
-	TerritoryCode_TERRITORY_CODE_SN          TerritoryCode = 210
-	TerritoryCode_TERRITORY_CODE_SO          TerritoryCode = 211
-	TerritoryCode_TERRITORY_CODE_SR          TerritoryCode = 212
-	TerritoryCode_TERRITORY_CODE_SS          TerritoryCode = 213
-	TerritoryCode_TERRITORY_CODE_ST          TerritoryCode = 214
-	TerritoryCode_TERRITORY_CODE_SV          TerritoryCode = 215
-	TerritoryCode_TERRITORY_CODE_SX          TerritoryCode = 216
-	TerritoryCode_TERRITORY_CODE_SY          TerritoryCode = 217
-	TerritoryCode_TERRITORY_CODE_SZ          TerritoryCode = 218
-	TerritoryCode_TERRITORY_CODE_TC          TerritoryCode = 219
-	TerritoryCode_TERRITORY_CODE_TD          TerritoryCode = 220
-	TerritoryCode_TERRITORY_CODE_TF          TerritoryCode = 221
-	TerritoryCode_TERRITORY_CODE_TG          TerritoryCode = 222
-	TerritoryCode_TERRITORY_CODE_TH          TerritoryCode = 223
-	TerritoryCode_TERRITORY_CODE_TJ          TerritoryCode = 224
-	TerritoryCode_TERRITORY_CODE_TK          TerritoryCode = 225
-	TerritoryCode_TERRITORY_CODE_TL          TerritoryCode = 226
-	TerritoryCode_TERRITORY_CODE_TM          TerritoryCode = 227
-	TerritoryCode_TERRITORY_CODE_TN          TerritoryCode = 228
-	TerritoryCode_TERRITORY_CODE_TO          TerritoryCode = 229
-	TerritoryCode_TERRITORY_CODE_TR          TerritoryCode = 230
-	TerritoryCode_TERRITORY_CODE_TT          TerritoryCode = 231
-	TerritoryCode_TERRITORY_CODE_TV          TerritoryCode = 232
-	TerritoryCode_TERRITORY_CODE_TW          TerritoryCode = 233
-	TerritoryCode_TERRITORY_CODE_TZ          TerritoryCode = 234
-	TerritoryCode_TERRITORY_CODE_UA          TerritoryCode = 235
-	TerritoryCode_TERRITORY_CODE_UG          TerritoryCode = 236
-	TerritoryCode_TERRITORY_CODE_UM          TerritoryCode = 237
-	TerritoryCode_TERRITORY_CODE_US          TerritoryCode = 238
-	TerritoryCode_TERRITORY_CODE_UY          TerritoryCode = 239
-	TerritoryCode_TERRITORY_CODE_UZ          TerritoryCode = 240
-	TerritoryCode_TERRITORY_CODE_VA          TerritoryCode = 241
-	TerritoryCode_TERRITORY_CODE_VC          TerritoryCode = 242
-	TerritoryCode_TERRITORY_CODE_VE          TerritoryCode = 243
-	TerritoryCode_TERRITORY_CODE_VG          TerritoryCode = 244
-	TerritoryCode_TERRITORY_CODE_VI          TerritoryCode = 245
-	TerritoryCode_TERRITORY_CODE_VN          TerritoryCode = 246
-	TerritoryCode_TERRITORY_CODE_VU          TerritoryCode = 247
-	TerritoryCode_TERRITORY_CODE_WF          TerritoryCode = 248
-	TerritoryCode_TERRITORY_CODE_WS          TerritoryCode = 249
-	TerritoryCode_TERRITORY_CODE_YE          TerritoryCode = 250
-	TerritoryCode_TERRITORY_CODE_YT          TerritoryCode = 251
-	TerritoryCode_TERRITORY_CODE_ZA          TerritoryCode = 252
-	TerritoryCode_TERRITORY_CODE_ZM          TerritoryCode = 253
-	TerritoryCode_TERRITORY_CODE_ZW          TerritoryCode = 254
+	// @xml: "AD"
+	TerritoryCode_TERRITORY_CODE_AD TerritoryCode = 1
+	// @xml: "AE"
+	TerritoryCode_TERRITORY_CODE_AE TerritoryCode = 2
+	// @xml: "AF"
+	TerritoryCode_TERRITORY_CODE_AF TerritoryCode = 3
+	// @xml: "AG"
+	TerritoryCode_TERRITORY_CODE_AG TerritoryCode = 4
+	// @xml: "AI"
+	TerritoryCode_TERRITORY_CODE_AI TerritoryCode = 5
+	// @xml: "AL"
+	TerritoryCode_TERRITORY_CODE_AL TerritoryCode = 6
+	// @xml: "AM"
+	TerritoryCode_TERRITORY_CODE_AM TerritoryCode = 7
+	// @xml: "AN"
+	TerritoryCode_TERRITORY_CODE_AN TerritoryCode = 8
+	// @xml: "AO"
+	TerritoryCode_TERRITORY_CODE_AO TerritoryCode = 9
+	// @xml: "AQ"
+	TerritoryCode_TERRITORY_CODE_AQ TerritoryCode = 10
+	// @xml: "AR"
+	TerritoryCode_TERRITORY_CODE_AR TerritoryCode = 11
+	// @xml: "AS"
+	TerritoryCode_TERRITORY_CODE_AS TerritoryCode = 12
+	// @xml: "AT"
+	TerritoryCode_TERRITORY_CODE_AT TerritoryCode = 13
+	// @xml: "AU"
+	TerritoryCode_TERRITORY_CODE_AU TerritoryCode = 14
+	// @xml: "AW"
+	TerritoryCode_TERRITORY_CODE_AW TerritoryCode = 15
+	// @xml: "AX"
+	TerritoryCode_TERRITORY_CODE_AX TerritoryCode = 16
+	// @xml: "AZ"
+	TerritoryCode_TERRITORY_CODE_AZ TerritoryCode = 17
+	// @xml: "BA"
+	TerritoryCode_TERRITORY_CODE_BA TerritoryCode = 18
+	// @xml: "BB"
+	TerritoryCode_TERRITORY_CODE_BB TerritoryCode = 19
+	// @xml: "BD"
+	TerritoryCode_TERRITORY_CODE_BD TerritoryCode = 20
+	// @xml: "BE"
+	TerritoryCode_TERRITORY_CODE_BE TerritoryCode = 21
+	// @xml: "BF"
+	TerritoryCode_TERRITORY_CODE_BF TerritoryCode = 22
+	// @xml: "BG"
+	TerritoryCode_TERRITORY_CODE_BG TerritoryCode = 23
+	// @xml: "BH"
+	TerritoryCode_TERRITORY_CODE_BH TerritoryCode = 24
+	// @xml: "BI"
+	TerritoryCode_TERRITORY_CODE_BI TerritoryCode = 25
+	// @xml: "BJ"
+	TerritoryCode_TERRITORY_CODE_BJ TerritoryCode = 26
+	// @xml: "BL"
+	TerritoryCode_TERRITORY_CODE_BL TerritoryCode = 27
+	// @xml: "BM"
+	TerritoryCode_TERRITORY_CODE_BM TerritoryCode = 28
+	// @xml: "BN"
+	TerritoryCode_TERRITORY_CODE_BN TerritoryCode = 29
+	// @xml: "BO"
+	TerritoryCode_TERRITORY_CODE_BO TerritoryCode = 30
+	// @xml: "BQ"
+	TerritoryCode_TERRITORY_CODE_BQ TerritoryCode = 31
+	// @xml: "BR"
+	TerritoryCode_TERRITORY_CODE_BR TerritoryCode = 32
+	// @xml: "BS"
+	TerritoryCode_TERRITORY_CODE_BS TerritoryCode = 33
+	// @xml: "BT"
+	TerritoryCode_TERRITORY_CODE_BT TerritoryCode = 34
+	// @xml: "BV"
+	TerritoryCode_TERRITORY_CODE_BV TerritoryCode = 35
+	// @xml: "BW"
+	TerritoryCode_TERRITORY_CODE_BW TerritoryCode = 36
+	// @xml: "BY"
+	TerritoryCode_TERRITORY_CODE_BY TerritoryCode = 37
+	// @xml: "BZ"
+	TerritoryCode_TERRITORY_CODE_BZ TerritoryCode = 38
+	// @xml: "CA"
+	TerritoryCode_TERRITORY_CODE_CA TerritoryCode = 39
+	// @xml: "CC"
+	TerritoryCode_TERRITORY_CODE_CC TerritoryCode = 40
+	// @xml: "CD"
+	TerritoryCode_TERRITORY_CODE_CD TerritoryCode = 41
+	// @xml: "CF"
+	TerritoryCode_TERRITORY_CODE_CF TerritoryCode = 42
+	// @xml: "CG"
+	TerritoryCode_TERRITORY_CODE_CG TerritoryCode = 43
+	// @xml: "CH"
+	TerritoryCode_TERRITORY_CODE_CH TerritoryCode = 44
+	// @xml: "CI"
+	TerritoryCode_TERRITORY_CODE_CI TerritoryCode = 45
+	// @xml: "CK"
+	TerritoryCode_TERRITORY_CODE_CK TerritoryCode = 46
+	// @xml: "CL"
+	TerritoryCode_TERRITORY_CODE_CL TerritoryCode = 47
+	// @xml: "CM"
+	TerritoryCode_TERRITORY_CODE_CM TerritoryCode = 48
+	// @xml: "CN"
+	TerritoryCode_TERRITORY_CODE_CN TerritoryCode = 49
+	// @xml: "CO"
+	TerritoryCode_TERRITORY_CODE_CO TerritoryCode = 50
+	// @xml: "CR"
+	TerritoryCode_TERRITORY_CODE_CR TerritoryCode = 51
+	// @xml: "CS"
+	TerritoryCode_TERRITORY_CODE_CS TerritoryCode = 52
+	// @xml: "CU"
+	TerritoryCode_TERRITORY_CODE_CU TerritoryCode = 53
+	// @xml: "CV"
+	TerritoryCode_TERRITORY_CODE_CV TerritoryCode = 54
+	// @xml: "CW"
+	TerritoryCode_TERRITORY_CODE_CW TerritoryCode = 55
+	// @xml: "CX"
+	TerritoryCode_TERRITORY_CODE_CX TerritoryCode = 56
+	// @xml: "CY"
+	TerritoryCode_TERRITORY_CODE_CY TerritoryCode = 57
+	// @xml: "CZ"
+	TerritoryCode_TERRITORY_CODE_CZ TerritoryCode = 58
+	// @xml: "DE"
+	TerritoryCode_TERRITORY_CODE_DE TerritoryCode = 59
+	// @xml: "DJ"
+	TerritoryCode_TERRITORY_CODE_DJ TerritoryCode = 60
+	// @xml: "DK"
+	TerritoryCode_TERRITORY_CODE_DK TerritoryCode = 61
+	// @xml: "DM"
+	TerritoryCode_TERRITORY_CODE_DM TerritoryCode = 62
+	// @xml: "DO"
+	TerritoryCode_TERRITORY_CODE_DO TerritoryCode = 63
+	// @xml: "DZ"
+	TerritoryCode_TERRITORY_CODE_DZ TerritoryCode = 64
+	// @xml: "EC"
+	TerritoryCode_TERRITORY_CODE_EC TerritoryCode = 65
+	// @xml: "EE"
+	TerritoryCode_TERRITORY_CODE_EE TerritoryCode = 66
+	// @xml: "EG"
+	TerritoryCode_TERRITORY_CODE_EG TerritoryCode = 67
+	// @xml: "EH"
+	TerritoryCode_TERRITORY_CODE_EH TerritoryCode = 68
+	// @xml: "ER"
+	TerritoryCode_TERRITORY_CODE_ER TerritoryCode = 69
+	// @xml: "ES"
+	TerritoryCode_TERRITORY_CODE_ES TerritoryCode = 70
+	// @xml: "ES-CE"
+	TerritoryCode_TERRITORY_CODE_ES_CE TerritoryCode = 71
+	// @xml: "ES-CN"
+	TerritoryCode_TERRITORY_CODE_ES_CN TerritoryCode = 72
+	// @xml: "ES-ML"
+	TerritoryCode_TERRITORY_CODE_ES_ML TerritoryCode = 73
+	// @xml: "ET"
+	TerritoryCode_TERRITORY_CODE_ET TerritoryCode = 74
+	// @xml: "FI"
+	TerritoryCode_TERRITORY_CODE_FI TerritoryCode = 75
+	// @xml: "FJ"
+	TerritoryCode_TERRITORY_CODE_FJ TerritoryCode = 76
+	// @xml: "FK"
+	TerritoryCode_TERRITORY_CODE_FK TerritoryCode = 77
+	// @xml: "FM"
+	TerritoryCode_TERRITORY_CODE_FM TerritoryCode = 78
+	// @xml: "FO"
+	TerritoryCode_TERRITORY_CODE_FO TerritoryCode = 79
+	// @xml: "FR"
+	TerritoryCode_TERRITORY_CODE_FR TerritoryCode = 80
+	// @xml: "GA"
+	TerritoryCode_TERRITORY_CODE_GA TerritoryCode = 81
+	// @xml: "GB"
+	TerritoryCode_TERRITORY_CODE_GB TerritoryCode = 82
+	// @xml: "GD"
+	TerritoryCode_TERRITORY_CODE_GD TerritoryCode = 83
+	// @xml: "GE"
+	TerritoryCode_TERRITORY_CODE_GE TerritoryCode = 84
+	// @xml: "GF"
+	TerritoryCode_TERRITORY_CODE_GF TerritoryCode = 85
+	// @xml: "GG"
+	TerritoryCode_TERRITORY_CODE_GG TerritoryCode = 86
+	// @xml: "GH"
+	TerritoryCode_TERRITORY_CODE_GH TerritoryCode = 87
+	// @xml: "GI"
+	TerritoryCode_TERRITORY_CODE_GI TerritoryCode = 88
+	// @xml: "GL"
+	TerritoryCode_TERRITORY_CODE_GL TerritoryCode = 89
+	// @xml: "GM"
+	TerritoryCode_TERRITORY_CODE_GM TerritoryCode = 90
+	// @xml: "GN"
+	TerritoryCode_TERRITORY_CODE_GN TerritoryCode = 91
+	// @xml: "GP"
+	TerritoryCode_TERRITORY_CODE_GP TerritoryCode = 92
+	// @xml: "GQ"
+	TerritoryCode_TERRITORY_CODE_GQ TerritoryCode = 93
+	// @xml: "GR"
+	TerritoryCode_TERRITORY_CODE_GR TerritoryCode = 94
+	// @xml: "GS"
+	TerritoryCode_TERRITORY_CODE_GS TerritoryCode = 95
+	// @xml: "GT"
+	TerritoryCode_TERRITORY_CODE_GT TerritoryCode = 96
+	// @xml: "GU"
+	TerritoryCode_TERRITORY_CODE_GU TerritoryCode = 97
+	// @xml: "GW"
+	TerritoryCode_TERRITORY_CODE_GW TerritoryCode = 98
+	// @xml: "GY"
+	TerritoryCode_TERRITORY_CODE_GY TerritoryCode = 99
+	// @xml: "HK"
+	TerritoryCode_TERRITORY_CODE_HK TerritoryCode = 100
+	// @xml: "HM"
+	TerritoryCode_TERRITORY_CODE_HM TerritoryCode = 101
+	// @xml: "HN"
+	TerritoryCode_TERRITORY_CODE_HN TerritoryCode = 102
+	// @xml: "HR"
+	TerritoryCode_TERRITORY_CODE_HR TerritoryCode = 103
+	// @xml: "HT"
+	TerritoryCode_TERRITORY_CODE_HT TerritoryCode = 104
+	// @xml: "HU"
+	TerritoryCode_TERRITORY_CODE_HU TerritoryCode = 105
+	// @xml: "ID"
+	TerritoryCode_TERRITORY_CODE_ID TerritoryCode = 106
+	// @xml: "IE"
+	TerritoryCode_TERRITORY_CODE_IE TerritoryCode = 107
+	// @xml: "IL"
+	TerritoryCode_TERRITORY_CODE_IL TerritoryCode = 108
+	// @xml: "IM"
+	TerritoryCode_TERRITORY_CODE_IM TerritoryCode = 109
+	// @xml: "IN"
+	TerritoryCode_TERRITORY_CODE_IN TerritoryCode = 110
+	// @xml: "IO"
+	TerritoryCode_TERRITORY_CODE_IO TerritoryCode = 111
+	// @xml: "IQ"
+	TerritoryCode_TERRITORY_CODE_IQ TerritoryCode = 112
+	// @xml: "IR"
+	TerritoryCode_TERRITORY_CODE_IR TerritoryCode = 113
+	// @xml: "IS"
+	TerritoryCode_TERRITORY_CODE_IS TerritoryCode = 114
+	// @xml: "IT"
+	TerritoryCode_TERRITORY_CODE_IT TerritoryCode = 115
+	// @xml: "JE"
+	TerritoryCode_TERRITORY_CODE_JE TerritoryCode = 116
+	// @xml: "JM"
+	TerritoryCode_TERRITORY_CODE_JM TerritoryCode = 117
+	// @xml: "JO"
+	TerritoryCode_TERRITORY_CODE_JO TerritoryCode = 118
+	// @xml: "JP"
+	TerritoryCode_TERRITORY_CODE_JP TerritoryCode = 119
+	// @xml: "KE"
+	TerritoryCode_TERRITORY_CODE_KE TerritoryCode = 120
+	// @xml: "KG"
+	TerritoryCode_TERRITORY_CODE_KG TerritoryCode = 121
+	// @xml: "KH"
+	TerritoryCode_TERRITORY_CODE_KH TerritoryCode = 122
+	// @xml: "KI"
+	TerritoryCode_TERRITORY_CODE_KI TerritoryCode = 123
+	// @xml: "KM"
+	TerritoryCode_TERRITORY_CODE_KM TerritoryCode = 124
+	// @xml: "KN"
+	TerritoryCode_TERRITORY_CODE_KN TerritoryCode = 125
+	// @xml: "KP"
+	TerritoryCode_TERRITORY_CODE_KP TerritoryCode = 126
+	// @xml: "KR"
+	TerritoryCode_TERRITORY_CODE_KR TerritoryCode = 127
+	// @xml: "KW"
+	TerritoryCode_TERRITORY_CODE_KW TerritoryCode = 128
+	// @xml: "KY"
+	TerritoryCode_TERRITORY_CODE_KY TerritoryCode = 129
+	// @xml: "KZ"
+	TerritoryCode_TERRITORY_CODE_KZ TerritoryCode = 130
+	// @xml: "LA"
+	TerritoryCode_TERRITORY_CODE_LA TerritoryCode = 131
+	// @xml: "LB"
+	TerritoryCode_TERRITORY_CODE_LB TerritoryCode = 132
+	// @xml: "LC"
+	TerritoryCode_TERRITORY_CODE_LC TerritoryCode = 133
+	// @xml: "LI"
+	TerritoryCode_TERRITORY_CODE_LI TerritoryCode = 134
+	// @xml: "LK"
+	TerritoryCode_TERRITORY_CODE_LK TerritoryCode = 135
+	// @xml: "LR"
+	TerritoryCode_TERRITORY_CODE_LR TerritoryCode = 136
+	// @xml: "LS"
+	TerritoryCode_TERRITORY_CODE_LS TerritoryCode = 137
+	// @xml: "LT"
+	TerritoryCode_TERRITORY_CODE_LT TerritoryCode = 138
+	// @xml: "LU"
+	TerritoryCode_TERRITORY_CODE_LU TerritoryCode = 139
+	// @xml: "LV"
+	TerritoryCode_TERRITORY_CODE_LV TerritoryCode = 140
+	// @xml: "LY"
+	TerritoryCode_TERRITORY_CODE_LY TerritoryCode = 141
+	// @xml: "MA"
+	TerritoryCode_TERRITORY_CODE_MA TerritoryCode = 142
+	// @xml: "MC"
+	TerritoryCode_TERRITORY_CODE_MC TerritoryCode = 143
+	// @xml: "MD"
+	TerritoryCode_TERRITORY_CODE_MD TerritoryCode = 144
+	// @xml: "ME"
+	TerritoryCode_TERRITORY_CODE_ME TerritoryCode = 145
+	// @xml: "MF"
+	TerritoryCode_TERRITORY_CODE_MF TerritoryCode = 146
+	// @xml: "MG"
+	TerritoryCode_TERRITORY_CODE_MG TerritoryCode = 147
+	// @xml: "MH"
+	TerritoryCode_TERRITORY_CODE_MH TerritoryCode = 148
+	// @xml: "MK"
+	TerritoryCode_TERRITORY_CODE_MK TerritoryCode = 149
+	// @xml: "ML"
+	TerritoryCode_TERRITORY_CODE_ML TerritoryCode = 150
+	// @xml: "MM"
+	TerritoryCode_TERRITORY_CODE_MM TerritoryCode = 151
+	// @xml: "MN"
+	TerritoryCode_TERRITORY_CODE_MN TerritoryCode = 152
+	// @xml: "MO"
+	TerritoryCode_TERRITORY_CODE_MO TerritoryCode = 153
+	// @xml: "MP"
+	TerritoryCode_TERRITORY_CODE_MP TerritoryCode = 154
+	// @xml: "MQ"
+	TerritoryCode_TERRITORY_CODE_MQ TerritoryCode = 155
+	// @xml: "MR"
+	TerritoryCode_TERRITORY_CODE_MR TerritoryCode = 156
+	// @xml: "MS"
+	TerritoryCode_TERRITORY_CODE_MS TerritoryCode = 157
+	// @xml: "MT"
+	TerritoryCode_TERRITORY_CODE_MT TerritoryCode = 158
+	// @xml: "MU"
+	TerritoryCode_TERRITORY_CODE_MU TerritoryCode = 159
+	// @xml: "MV"
+	TerritoryCode_TERRITORY_CODE_MV TerritoryCode = 160
+	// @xml: "MW"
+	TerritoryCode_TERRITORY_CODE_MW TerritoryCode = 161
+	// @xml: "MX"
+	TerritoryCode_TERRITORY_CODE_MX TerritoryCode = 162
+	// @xml: "MY"
+	TerritoryCode_TERRITORY_CODE_MY TerritoryCode = 163
+	// @xml: "MZ"
+	TerritoryCode_TERRITORY_CODE_MZ TerritoryCode = 164
+	// @xml: "NA"
+	TerritoryCode_TERRITORY_CODE_NA TerritoryCode = 165
+	// @xml: "NC"
+	TerritoryCode_TERRITORY_CODE_NC TerritoryCode = 166
+	// @xml: "NE"
+	TerritoryCode_TERRITORY_CODE_NE TerritoryCode = 167
+	// @xml: "NF"
+	TerritoryCode_TERRITORY_CODE_NF TerritoryCode = 168
+	// @xml: "NG"
+	TerritoryCode_TERRITORY_CODE_NG TerritoryCode = 169
+	// @xml: "NI"
+	TerritoryCode_TERRITORY_CODE_NI TerritoryCode = 170
+	// @xml: "NL"
+	TerritoryCode_TERRITORY_CODE_NL TerritoryCode = 171
+	// @xml: "NO"
+	TerritoryCode_TERRITORY_CODE_NO TerritoryCode = 172
+	// @xml: "NP"
+	TerritoryCode_TERRITORY_CODE_NP TerritoryCode = 173
+	// @xml: "NR"
+	TerritoryCode_TERRITORY_CODE_NR TerritoryCode = 174
+	// @xml: "NU"
+	TerritoryCode_TERRITORY_CODE_NU TerritoryCode = 175
+	// @xml: "NZ"
+	TerritoryCode_TERRITORY_CODE_NZ TerritoryCode = 176
+	// @xml: "OM"
+	TerritoryCode_TERRITORY_CODE_OM TerritoryCode = 177
+	// @xml: "PA"
+	TerritoryCode_TERRITORY_CODE_PA TerritoryCode = 178
+	// @xml: "PE"
+	TerritoryCode_TERRITORY_CODE_PE TerritoryCode = 179
+	// @xml: "PF"
+	TerritoryCode_TERRITORY_CODE_PF TerritoryCode = 180
+	// @xml: "PG"
+	TerritoryCode_TERRITORY_CODE_PG TerritoryCode = 181
+	// @xml: "PH"
+	TerritoryCode_TERRITORY_CODE_PH TerritoryCode = 182
+	// @xml: "PK"
+	TerritoryCode_TERRITORY_CODE_PK TerritoryCode = 183
+	// @xml: "PL"
+	TerritoryCode_TERRITORY_CODE_PL TerritoryCode = 184
+	// @xml: "PM"
+	TerritoryCode_TERRITORY_CODE_PM TerritoryCode = 185
+	// @xml: "PN"
+	TerritoryCode_TERRITORY_CODE_PN TerritoryCode = 186
+	// @xml: "PR"
+	TerritoryCode_TERRITORY_CODE_PR TerritoryCode = 187
+	// @xml: "PS"
+	TerritoryCode_TERRITORY_CODE_PS TerritoryCode = 188
+	// @xml: "PT"
+	TerritoryCode_TERRITORY_CODE_PT TerritoryCode = 189
+	// @xml: "PW"
+	TerritoryCode_TERRITORY_CODE_PW TerritoryCode = 190
+	// @xml: "PY"
+	TerritoryCode_TERRITORY_CODE_PY TerritoryCode = 191
+	// @xml: "QA"
+	TerritoryCode_TERRITORY_CODE_QA TerritoryCode = 192
+	// @xml: "RE"
+	TerritoryCode_TERRITORY_CODE_RE TerritoryCode = 193
+	// @xml: "RO"
+	TerritoryCode_TERRITORY_CODE_RO TerritoryCode = 194
+	// @xml: "RS"
+	TerritoryCode_TERRITORY_CODE_RS TerritoryCode = 195
+	// @xml: "RU"
+	TerritoryCode_TERRITORY_CODE_RU TerritoryCode = 196
+	// @xml: "RW"
+	TerritoryCode_TERRITORY_CODE_RW TerritoryCode = 197
+	// @xml: "SA"
+	TerritoryCode_TERRITORY_CODE_SA TerritoryCode = 198
+	// @xml: "SB"
+	TerritoryCode_TERRITORY_CODE_SB TerritoryCode = 199
+	// @xml: "SC"
+	TerritoryCode_TERRITORY_CODE_SC TerritoryCode = 200
+	// @xml: "SD"
+	TerritoryCode_TERRITORY_CODE_SD TerritoryCode = 201
+	// @xml: "SE"
+	TerritoryCode_TERRITORY_CODE_SE TerritoryCode = 202
+	// @xml: "SG"
+	TerritoryCode_TERRITORY_CODE_SG TerritoryCode = 203
+	// @xml: "SH"
+	TerritoryCode_TERRITORY_CODE_SH TerritoryCode = 204
+	// @xml: "SI"
+	TerritoryCode_TERRITORY_CODE_SI TerritoryCode = 205
+	// @xml: "SJ"
+	TerritoryCode_TERRITORY_CODE_SJ TerritoryCode = 206
+	// @xml: "SK"
+	TerritoryCode_TERRITORY_CODE_SK TerritoryCode = 207
+	// @xml: "SL"
+	TerritoryCode_TERRITORY_CODE_SL TerritoryCode = 208
+	// @xml: "SM"
+	TerritoryCode_TERRITORY_CODE_SM TerritoryCode = 209
+	// @xml: "SN"
+	TerritoryCode_TERRITORY_CODE_SN TerritoryCode = 210
+	// @xml: "SO"
+	TerritoryCode_TERRITORY_CODE_SO TerritoryCode = 211
+	// @xml: "SR"
+	TerritoryCode_TERRITORY_CODE_SR TerritoryCode = 212
+	// @xml: "SS"
+	TerritoryCode_TERRITORY_CODE_SS TerritoryCode = 213
+	// @xml: "ST"
+	TerritoryCode_TERRITORY_CODE_ST TerritoryCode = 214
+	// @xml: "SV"
+	TerritoryCode_TERRITORY_CODE_SV TerritoryCode = 215
+	// @xml: "SX"
+	TerritoryCode_TERRITORY_CODE_SX TerritoryCode = 216
+	// @xml: "SY"
+	TerritoryCode_TERRITORY_CODE_SY TerritoryCode = 217
+	// @xml: "SZ"
+	TerritoryCode_TERRITORY_CODE_SZ TerritoryCode = 218
+	// @xml: "TC"
+	TerritoryCode_TERRITORY_CODE_TC TerritoryCode = 219
+	// @xml: "TD"
+	TerritoryCode_TERRITORY_CODE_TD TerritoryCode = 220
+	// @xml: "TF"
+	TerritoryCode_TERRITORY_CODE_TF TerritoryCode = 221
+	// @xml: "TG"
+	TerritoryCode_TERRITORY_CODE_TG TerritoryCode = 222
+	// @xml: "TH"
+	TerritoryCode_TERRITORY_CODE_TH TerritoryCode = 223
+	// @xml: "TJ"
+	TerritoryCode_TERRITORY_CODE_TJ TerritoryCode = 224
+	// @xml: "TK"
+	TerritoryCode_TERRITORY_CODE_TK TerritoryCode = 225
+	// @xml: "TL"
+	TerritoryCode_TERRITORY_CODE_TL TerritoryCode = 226
+	// @xml: "TM"
+	TerritoryCode_TERRITORY_CODE_TM TerritoryCode = 227
+	// @xml: "TN"
+	TerritoryCode_TERRITORY_CODE_TN TerritoryCode = 228
+	// @xml: "TO"
+	TerritoryCode_TERRITORY_CODE_TO TerritoryCode = 229
+	// @xml: "TR"
+	TerritoryCode_TERRITORY_CODE_TR TerritoryCode = 230
+	// @xml: "TT"
+	TerritoryCode_TERRITORY_CODE_TT TerritoryCode = 231
+	// @xml: "TV"
+	TerritoryCode_TERRITORY_CODE_TV TerritoryCode = 232
+	// @xml: "TW"
+	TerritoryCode_TERRITORY_CODE_TW TerritoryCode = 233
+	// @xml: "TZ"
+	TerritoryCode_TERRITORY_CODE_TZ TerritoryCode = 234
+	// @xml: "UA"
+	TerritoryCode_TERRITORY_CODE_UA TerritoryCode = 235
+	// @xml: "UG"
+	TerritoryCode_TERRITORY_CODE_UG TerritoryCode = 236
+	// @xml: "UM"
+	TerritoryCode_TERRITORY_CODE_UM TerritoryCode = 237
+	// @xml: "US"
+	TerritoryCode_TERRITORY_CODE_US TerritoryCode = 238
+	// @xml: "UY"
+	TerritoryCode_TERRITORY_CODE_UY TerritoryCode = 239
+	// @xml: "UZ"
+	TerritoryCode_TERRITORY_CODE_UZ TerritoryCode = 240
+	// @xml: "VA"
+	TerritoryCode_TERRITORY_CODE_VA TerritoryCode = 241
+	// @xml: "VC"
+	TerritoryCode_TERRITORY_CODE_VC TerritoryCode = 242
+	// @xml: "VE"
+	TerritoryCode_TERRITORY_CODE_VE TerritoryCode = 243
+	// @xml: "VG"
+	TerritoryCode_TERRITORY_CODE_VG TerritoryCode = 244
+	// @xml: "VI"
+	TerritoryCode_TERRITORY_CODE_VI TerritoryCode = 245
+	// @xml: "VN"
+	TerritoryCode_TERRITORY_CODE_VN TerritoryCode = 246
+	// @xml: "VU"
+	TerritoryCode_TERRITORY_CODE_VU TerritoryCode = 247
+	// @xml: "WF"
+	TerritoryCode_TERRITORY_CODE_WF TerritoryCode = 248
+	// @xml: "WS"
+	TerritoryCode_TERRITORY_CODE_WS TerritoryCode = 249
+	// @xml: "YE"
+	TerritoryCode_TERRITORY_CODE_YE TerritoryCode = 250
+	// @xml: "YT"
+	TerritoryCode_TERRITORY_CODE_YT TerritoryCode = 251
+	// @xml: "ZA"
+	TerritoryCode_TERRITORY_CODE_ZA TerritoryCode = 252
+	// @xml: "ZM"
+	TerritoryCode_TERRITORY_CODE_ZM TerritoryCode = 253
+	// @xml: "ZW"
+	TerritoryCode_TERRITORY_CODE_ZW TerritoryCode = 254
 )
 
 // Enum value maps for TerritoryCode.
@@ -19602,9 +24067,11 @@ func (TerritoryCode) EnumDescriptor() ([]byte, []int) {
 type ReferenceCreation int32
 
 const (
-	ReferenceCreation_REFERENCE_CREATION_UNSPECIFIED       ReferenceCreation = 0
+	ReferenceCreation_REFERENCE_CREATION_UNSPECIFIED ReferenceCreation = 0
+	// @xml: "ReferenceResource"
 	ReferenceCreation_REFERENCE_CREATION_REFERENCERESOURCE ReferenceCreation = 1
-	ReferenceCreation_REFERENCE_CREATION_CONSUMERRESOURCE  ReferenceCreation = 2
+	// @xml: "ConsumerResource"
+	ReferenceCreation_REFERENCE_CREATION_CONSUMERRESOURCE ReferenceCreation = 2
 )
 
 // Enum value maps for ReferenceCreation.
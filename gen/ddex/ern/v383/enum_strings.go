@@ -1,12 +1,21 @@
 // Code generated by generate-go-extensions. DO NOT EDIT.
+// Source: v383.pb.go, from protos generated by xsd2proto devel on 2026-10-15
+// Schema: ernv383/release-notification.xsd (sha256 991d6bfe5fc3)
 
 package v383
 
-import "strings"
+import (
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
 
 // XMLString returns the XML string representation of DdexCCurrentTerritoryCode
 func (e DdexCCurrentTerritoryCode) XMLString() string {
 	switch e {
+	case DdexCCurrentTerritoryCode_DDEX_C_CURRENT_TERRITORY_CODE_IDENTIFIERTYPE:
+		return "IdentifierType"
 	default:
 		return ""
 	}
@@ -16,7 +25,113 @@ func (e DdexCCurrentTerritoryCode) XMLString() string {
 func ParseDdexCCurrentTerritoryCodeString(s string) (DdexCCurrentTerritoryCode, bool) {
 	s = strings.ToUpper(s)
 	switch s {
+	case "IDENTIFIERTYPE":
+		return DdexCCurrentTerritoryCode_DDEX_C_CURRENT_TERRITORY_CODE_IDENTIFIERTYPE, true
 	default:
 		return DdexCCurrentTerritoryCode(0), false
 	}
 }
+
+// MustParseDdexCCurrentTerritoryCodeString is like ParseDdexCCurrentTerritoryCodeString but panics if s is not a DdexCCurrentTerritoryCode value
+func MustParseDdexCCurrentTerritoryCodeString(s string) DdexCCurrentTerritoryCode {
+	e, ok := ParseDdexCCurrentTerritoryCodeString(s)
+	if !ok {
+		panic(fmt.Sprintf("invalid DdexCCurrentTerritoryCode value %q", s))
+	}
+	return e
+}
+
+// Scan implements sql.Scanner for DdexCCurrentTerritoryCode, accepting the DDEX string value
+func (e *DdexCCurrentTerritoryCode) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*e = DdexCCurrentTerritoryCode(0)
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into DdexCCurrentTerritoryCode", src)
+	}
+
+	parsed, ok := ParseDdexCCurrentTerritoryCodeString(s)
+	if !ok {
+		return fmt.Errorf("invalid DdexCCurrentTerritoryCode value %q", s)
+	}
+	*e = parsed
+	return nil
+}
+
+// Value implements driver.Valuer for DdexCCurrentTerritoryCode, storing the DDEX string value or NULL when unspecified
+func (e DdexCCurrentTerritoryCode) Value() (driver.Value, error) {
+	if e == 0 {
+		return nil, nil
+	}
+	s := e.XMLString()
+	if s == "" {
+		return nil, fmt.Errorf("invalid DdexCCurrentTerritoryCode value %d", int32(e))
+	}
+	return s, nil
+}
+
+// MarshalXML implements xml.Marshaler for DdexCCurrentTerritoryCode, writing the DDEX string value and no element when unspecified
+func (e DdexCCurrentTerritoryCode) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if e == 0 {
+		return nil
+	}
+	s := e.XMLString()
+	if s == "" {
+		return fmt.Errorf("invalid DdexCCurrentTerritoryCode value %d", int32(e))
+	}
+	return enc.EncodeElement(s, start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr for DdexCCurrentTerritoryCode, writing the DDEX string value and no attribute when unspecified
+func (e DdexCCurrentTerritoryCode) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if e == 0 {
+		return xml.Attr{}, nil
+	}
+	s := e.XMLString()
+	if s == "" {
+		return xml.Attr{}, fmt.Errorf("invalid DdexCCurrentTerritoryCode value %d", int32(e))
+	}
+	return xml.Attr{Name: name, Value: s}, nil
+}
+
+// UnmarshalXML implements xml.Unmarshaler for DdexCCurrentTerritoryCode, accepting the DDEX string value
+func (e *DdexCCurrentTerritoryCode) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := dec.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return e.UnmarshalXMLAttr(xml.Attr{Name: start.Name, Value: s})
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr for DdexCCurrentTerritoryCode, accepting the DDEX string value
+func (e *DdexCCurrentTerritoryCode) UnmarshalXMLAttr(attr xml.Attr) error {
+	s := strings.TrimSpace(attr.Value)
+	if s == "" {
+		*e = DdexCCurrentTerritoryCode(0)
+		return nil
+	}
+	parsed, ok := ParseDdexCCurrentTerritoryCodeString(s)
+	if !ok {
+		return fmt.Errorf("invalid DdexCCurrentTerritoryCode value %q", s)
+	}
+	*e = parsed
+	return nil
+}
+
+// ParseEnumString parses a string value of the named enum (case-insensitive),
+// reporting false for an unknown enum or value
+func ParseEnumString(enum, s string) (int32, bool) {
+	switch enum {
+	case "DdexCCurrentTerritoryCode":
+		v, ok := ParseDdexCCurrentTerritoryCodeString(s)
+		return int32(v), ok
+	default:
+		return 0, false
+	}
+}
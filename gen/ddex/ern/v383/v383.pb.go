@@ -1,3 +1,6 @@
+// Generated by xsd2proto devel on 2026-10-15
+// Schema: ernv383/release-notification.xsd (sha256 991d6bfe5fc3)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.9
@@ -25,7 +28,8 @@ const (
 type DdexCCurrentTerritoryCode int32
 
 const (
-	DdexCCurrentTerritoryCode_DDEX_C_CURRENT_TERRITORY_CODE_UNSPECIFIED    DdexCCurrentTerritoryCode = 0
+	DdexCCurrentTerritoryCode_DDEX_C_CURRENT_TERRITORY_CODE_UNSPECIFIED DdexCCurrentTerritoryCode = 0
+	// @xml: "IdentifierType"
 	DdexCCurrentTerritoryCode_DDEX_C_CURRENT_TERRITORY_CODE_IDENTIFIERTYPE DdexCCurrentTerritoryCode = 1
 )
 
@@ -72,10 +76,10 @@ type NewReleaseMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageHeader"
 	MessageHeader *MessageHeader `protobuf:"bytes,1,opt,name=message_header,json=messageHeader,proto3" json:"message_header,omitempty" xml:"MessageHeader"`
-	// @gotags: xml:"UpdateIndicator"
-	UpdateIndicator string `protobuf:"bytes,2,opt,name=update_indicator,json=updateIndicator,proto3" json:"update_indicator,omitempty" xml:"UpdateIndicator"`
+	// @gotags: xml:"UpdateIndicator" avs:"UpdateIndicator"
+	UpdateIndicator *string `protobuf:"bytes,2,opt,name=update_indicator,json=updateIndicator,proto3,oneof" json:"update_indicator,omitempty" xml:"UpdateIndicator" avs:"UpdateIndicator"`
 	// @gotags: xml:"IsBackfill"
-	IsBackfill bool `protobuf:"varint,3,opt,name=is_backfill,json=isBackfill,proto3" json:"is_backfill,omitempty" xml:"IsBackfill"`
+	IsBackfill *bool `protobuf:"varint,3,opt,name=is_backfill,json=isBackfill,proto3,oneof" json:"is_backfill,omitempty" xml:"IsBackfill"`
 	// @gotags: xml:"CatalogTransfer"
 	CatalogTransfer *CatalogTransfer `protobuf:"bytes,4,opt,name=catalog_transfer,json=catalogTransfer,proto3" json:"catalog_transfer,omitempty" xml:"CatalogTransfer"`
 	// @gotags: xml:"WorkList"
@@ -93,19 +97,26 @@ type NewReleaseMessage struct {
 	// @gotags: xml:"MessageSchemaVersionId,attr"
 	MessageSchemaVersionId string `protobuf:"bytes,11,opt,name=message_schema_version_id,json=messageSchemaVersionId,proto3" json:"message_schema_version_id,omitempty" xml:"MessageSchemaVersionId,attr"`
 	// @gotags: xml:"BusinessProfileVersionId,attr"
-	BusinessProfileVersionId string `protobuf:"bytes,12,opt,name=business_profile_version_id,json=businessProfileVersionId,proto3" json:"business_profile_version_id,omitempty" xml:"BusinessProfileVersionId,attr"`
+	BusinessProfileVersionId *string `protobuf:"bytes,12,opt,name=business_profile_version_id,json=businessProfileVersionId,proto3,oneof" json:"business_profile_version_id,omitempty" xml:"BusinessProfileVersionId,attr"`
 	// @gotags: xml:"ReleaseProfileVersionId,attr"
-	ReleaseProfileVersionId string `protobuf:"bytes,13,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr"`
+	ReleaseProfileVersionId *string `protobuf:"bytes,13,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3,oneof" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:ern,attr"
-	XmlnsErn string `protobuf:"bytes,15,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"xmlns:ern,attr,omitempty"
+	XmlnsErn string `protobuf:"bytes,15,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,16,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,17,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,18,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NewReleaseMessage) Reset() {
@@ -146,15 +157,15 @@ func (x *NewReleaseMessage) GetMessageHeader() *MessageHeader {
 }
 
 func (x *NewReleaseMessage) GetUpdateIndicator() string {
-	if x != nil {
-		return x.UpdateIndicator
+	if x != nil && x.UpdateIndicator != nil {
+		return *x.UpdateIndicator
 	}
 	return ""
 }
 
 func (x *NewReleaseMessage) GetIsBackfill() bool {
-	if x != nil {
-		return x.IsBackfill
+	if x != nil && x.IsBackfill != nil {
+		return *x.IsBackfill
 	}
 	return false
 }
@@ -216,22 +227,22 @@ func (x *NewReleaseMessage) GetMessageSchemaVersionId() string {
 }
 
 func (x *NewReleaseMessage) GetBusinessProfileVersionId() string {
-	if x != nil {
-		return x.BusinessProfileVersionId
+	if x != nil && x.BusinessProfileVersionId != nil {
+		return *x.BusinessProfileVersionId
 	}
 	return ""
 }
 
 func (x *NewReleaseMessage) GetReleaseProfileVersionId() string {
-	if x != nil {
-		return x.ReleaseProfileVersionId
+	if x != nil && x.ReleaseProfileVersionId != nil {
+		return *x.ReleaseProfileVersionId
 	}
 	return ""
 }
 
 func (x *NewReleaseMessage) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -257,6 +268,13 @@ func (x *NewReleaseMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *NewReleaseMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type CatalogListMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageHeader"
@@ -268,19 +286,26 @@ type CatalogListMessage struct {
 	// @gotags: xml:"MessageSchemaVersionId,attr"
 	MessageSchemaVersionId string `protobuf:"bytes,4,opt,name=message_schema_version_id,json=messageSchemaVersionId,proto3" json:"message_schema_version_id,omitempty" xml:"MessageSchemaVersionId,attr"`
 	// @gotags: xml:"BusinessProfileVersionId,attr"
-	BusinessProfileVersionId string `protobuf:"bytes,5,opt,name=business_profile_version_id,json=businessProfileVersionId,proto3" json:"business_profile_version_id,omitempty" xml:"BusinessProfileVersionId,attr"`
+	BusinessProfileVersionId *string `protobuf:"bytes,5,opt,name=business_profile_version_id,json=businessProfileVersionId,proto3,oneof" json:"business_profile_version_id,omitempty" xml:"BusinessProfileVersionId,attr"`
 	// @gotags: xml:"ReleaseProfileVersionId,attr"
-	ReleaseProfileVersionId string `protobuf:"bytes,6,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr"`
+	ReleaseProfileVersionId *string `protobuf:"bytes,6,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3,oneof" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:ern,attr"
-	XmlnsErn string `protobuf:"bytes,8,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"xmlns:ern,attr,omitempty"
+	XmlnsErn string `protobuf:"bytes,8,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,9,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,10,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,11,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CatalogListMessage) Reset() {
@@ -342,22 +367,22 @@ func (x *CatalogListMessage) GetMessageSchemaVersionId() string {
 }
 
 func (x *CatalogListMessage) GetBusinessProfileVersionId() string {
-	if x != nil {
-		return x.BusinessProfileVersionId
+	if x != nil && x.BusinessProfileVersionId != nil {
+		return *x.BusinessProfileVersionId
 	}
 	return ""
 }
 
 func (x *CatalogListMessage) GetReleaseProfileVersionId() string {
-	if x != nil {
-		return x.ReleaseProfileVersionId
+	if x != nil && x.ReleaseProfileVersionId != nil {
+		return *x.ReleaseProfileVersionId
 	}
 	return ""
 }
 
 func (x *CatalogListMessage) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -383,6 +408,13 @@ func (x *CatalogListMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *CatalogListMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type PurgeReleaseMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageHeader"
@@ -392,15 +424,22 @@ type PurgeReleaseMessage struct {
 	// @gotags: xml:"MessageSchemaVersionId,attr"
 	MessageSchemaVersionId string `protobuf:"bytes,3,opt,name=message_schema_version_id,json=messageSchemaVersionId,proto3" json:"message_schema_version_id,omitempty" xml:"MessageSchemaVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:ern,attr"
-	XmlnsErn string `protobuf:"bytes,5,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"xmlns:ern,attr,omitempty"
+	XmlnsErn string `protobuf:"bytes,5,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,6,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,7,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,8,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PurgeReleaseMessage) Reset() {
@@ -455,8 +494,8 @@ func (x *PurgeReleaseMessage) GetMessageSchemaVersionId() string {
 }
 
 func (x *PurgeReleaseMessage) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -482,6 +521,13 @@ func (x *PurgeReleaseMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *PurgeReleaseMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type CatalogItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"TerritoryCode"
@@ -670,16 +716,16 @@ type CatalogTransfer struct {
 	EffectiveTransferDate *EventDate `protobuf:"bytes,2,opt,name=effective_transfer_date,json=effectiveTransferDate,proto3" json:"effective_transfer_date,omitempty" xml:"EffectiveTransferDate"`
 	// @gotags: xml:"CatalogReleaseReferenceList"
 	CatalogReleaseReferenceList *CatalogReleaseReferenceList `protobuf:"bytes,3,opt,name=catalog_release_reference_list,json=catalogReleaseReferenceList,proto3" json:"catalog_release_reference_list,omitempty" xml:"CatalogReleaseReferenceList"`
-	// @gotags: xml:"TransferringFrom"
-	TransferringFrom *PartyDescriptor `protobuf:"bytes,4,opt,name=transferring_from,json=transferringFrom,proto3" json:"transferring_from,omitempty" xml:"TransferringFrom"`
-	// @gotags: xml:"TransferringTo"
-	TransferringTo *PartyDescriptor `protobuf:"bytes,5,opt,name=transferring_to,json=transferringTo,proto3" json:"transferring_to,omitempty" xml:"TransferringTo"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*AllTerritoryCode `protobuf:"bytes,6,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*AllTerritoryCode `protobuf:"bytes,7,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// @gotags: xml:"TransferringFrom"
+	TransferringFrom *PartyDescriptor `protobuf:"bytes,4,opt,name=transferring_from,json=transferringFrom,proto3" json:"transferring_from,omitempty" xml:"TransferringFrom"`
+	// @gotags: xml:"TransferringTo"
+	TransferringTo *PartyDescriptor `protobuf:"bytes,5,opt,name=transferring_to,json=transferringTo,proto3" json:"transferring_to,omitempty" xml:"TransferringTo"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CatalogTransfer) Reset() {
@@ -733,30 +779,30 @@ func (x *CatalogTransfer) GetCatalogReleaseReferenceList() *CatalogReleaseRefere
 	return nil
 }
 
-func (x *CatalogTransfer) GetTransferringFrom() *PartyDescriptor {
+func (x *CatalogTransfer) GetTerritoryCode() []*AllTerritoryCode {
 	if x != nil {
-		return x.TransferringFrom
+		return x.TerritoryCode
 	}
 	return nil
 }
 
-func (x *CatalogTransfer) GetTransferringTo() *PartyDescriptor {
+func (x *CatalogTransfer) GetExcludedTerritoryCode() []*AllTerritoryCode {
 	if x != nil {
-		return x.TransferringTo
+		return x.ExcludedTerritoryCode
 	}
 	return nil
 }
 
-func (x *CatalogTransfer) GetTerritoryCode() []*AllTerritoryCode {
+func (x *CatalogTransfer) GetTransferringFrom() *PartyDescriptor {
 	if x != nil {
-		return x.TerritoryCode
+		return x.TransferringFrom
 	}
 	return nil
 }
 
-func (x *CatalogTransfer) GetExcludedTerritoryCode() []*AllTerritoryCode {
+func (x *CatalogTransfer) GetTransferringTo() *PartyDescriptor {
 	if x != nil {
-		return x.ExcludedTerritoryCode
+		return x.TransferringTo
 	}
 	return nil
 }
@@ -770,11 +816,11 @@ type Collection struct {
 	// @gotags: xml:"CollectionReference"
 	CollectionReference string `protobuf:"bytes,3,opt,name=collection_reference,json=collectionReference,proto3" json:"collection_reference,omitempty" xml:"CollectionReference"`
 	// @gotags: xml:"EquivalentReleaseReference"
-	EquivalentReleaseReference string `protobuf:"bytes,4,opt,name=equivalent_release_reference,json=equivalentReleaseReference,proto3" json:"equivalent_release_reference,omitempty" xml:"EquivalentReleaseReference"`
+	EquivalentReleaseReference *string `protobuf:"bytes,4,opt,name=equivalent_release_reference,json=equivalentReleaseReference,proto3,oneof" json:"equivalent_release_reference,omitempty" xml:"EquivalentReleaseReference"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,5,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"Contributor"
 	Contributor []*DetailedResourceContributor `protobuf:"bytes,7,rep,name=contributor,proto3" json:"contributor,omitempty" xml:"Contributor"`
 	// @gotags: xml:"Character"
@@ -782,19 +828,19 @@ type Collection struct {
 	// @gotags: xml:"CollectionCollectionReferenceList"
 	CollectionCollectionReferenceList *CollectionCollectionReferenceList `protobuf:"bytes,9,opt,name=collection_collection_reference_list,json=collectionCollectionReferenceList,proto3" json:"collection_collection_reference_list,omitempty" xml:"CollectionCollectionReferenceList"`
 	// @gotags: xml:"IsComplete"
-	IsComplete bool `protobuf:"varint,10,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty" xml:"IsComplete"`
+	IsComplete *bool `protobuf:"varint,10,opt,name=is_complete,json=isComplete,proto3,oneof" json:"is_complete,omitempty" xml:"IsComplete"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,11,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"DurationOfMusicalContent"
-	DurationOfMusicalContent string `protobuf:"bytes,12,opt,name=duration_of_musical_content,json=durationOfMusicalContent,proto3" json:"duration_of_musical_content,omitempty" xml:"DurationOfMusicalContent"`
+	DurationOfMusicalContent *string `protobuf:"bytes,12,opt,name=duration_of_musical_content,json=durationOfMusicalContent,proto3,oneof" json:"duration_of_musical_content,omitempty" xml:"DurationOfMusicalContent"`
 	// @gotags: xml:"CreationDate"
 	CreationDate *EventDate `protobuf:"bytes,13,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty" xml:"CreationDate"`
 	// @gotags: xml:"ReleaseDate"
 	ReleaseDate *EventDate `protobuf:"bytes,14,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty" xml:"ReleaseDate"`
 	// @gotags: xml:"OriginalReleaseDate"
 	OriginalReleaseDate *EventDate `protobuf:"bytes,15,opt,name=original_release_date,json=originalReleaseDate,proto3" json:"original_release_date,omitempty" xml:"OriginalReleaseDate"`
-	// @gotags: xml:"OriginalLanguage"
-	OriginalLanguage string `protobuf:"bytes,16,opt,name=original_language,json=originalLanguage,proto3" json:"original_language,omitempty" xml:"OriginalLanguage"`
+	// @gotags: xml:"OriginalLanguage" avs:"IsoLanguageCode"
+	OriginalLanguage *string `protobuf:"bytes,16,opt,name=original_language,json=originalLanguage,proto3,oneof" json:"original_language,omitempty" xml:"OriginalLanguage" avs:"IsoLanguageCode"`
 	// @gotags: xml:"CollectionDetailsByTerritory"
 	CollectionDetailsByTerritory []*CollectionDetailsByTerritory `protobuf:"bytes,17,rep,name=collection_details_by_territory,json=collectionDetailsByTerritory,proto3" json:"collection_details_by_territory,omitempty" xml:"CollectionDetailsByTerritory"`
 	// @gotags: xml:"CollectionResourceReferenceList"
@@ -802,13 +848,13 @@ type Collection struct {
 	// @gotags: xml:"CollectionWorkReferenceList"
 	CollectionWorkReferenceList *CollectionWorkReferenceList `protobuf:"bytes,19,opt,name=collection_work_reference_list,json=collectionWorkReferenceList,proto3" json:"collection_work_reference_list,omitempty" xml:"CollectionWorkReferenceList"`
 	// @gotags: xml:"RepresentativeImageReference"
-	RepresentativeImageReference string `protobuf:"bytes,20,opt,name=representative_image_reference,json=representativeImageReference,proto3" json:"representative_image_reference,omitempty" xml:"RepresentativeImageReference"`
+	RepresentativeImageReference *string `protobuf:"bytes,20,opt,name=representative_image_reference,json=representativeImageReference,proto3,oneof" json:"representative_image_reference,omitempty" xml:"RepresentativeImageReference"`
 	// @gotags: xml:"PLine"
 	PLine []*PLine `protobuf:"bytes,21,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
 	// @gotags: xml:"CLine"
 	CLine []*CLine `protobuf:"bytes,22,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty" xml:"CLine"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,23,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,23,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -865,8 +911,8 @@ func (x *Collection) GetCollectionReference() string {
 }
 
 func (x *Collection) GetEquivalentReleaseReference() string {
-	if x != nil {
-		return x.EquivalentReleaseReference
+	if x != nil && x.EquivalentReleaseReference != nil {
+		return *x.EquivalentReleaseReference
 	}
 	return ""
 }
@@ -879,8 +925,8 @@ func (x *Collection) GetTitle() []*Title {
 }
 
 func (x *Collection) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -907,22 +953,22 @@ func (x *Collection) GetCollectionCollectionReferenceList() *CollectionCollectio
 }
 
 func (x *Collection) GetIsComplete() bool {
-	if x != nil {
-		return x.IsComplete
+	if x != nil && x.IsComplete != nil {
+		return *x.IsComplete
 	}
 	return false
 }
 
 func (x *Collection) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *Collection) GetDurationOfMusicalContent() string {
-	if x != nil {
-		return x.DurationOfMusicalContent
+	if x != nil && x.DurationOfMusicalContent != nil {
+		return *x.DurationOfMusicalContent
 	}
 	return ""
 }
@@ -949,8 +995,8 @@ func (x *Collection) GetOriginalReleaseDate() *EventDate {
 }
 
 func (x *Collection) GetOriginalLanguage() string {
-	if x != nil {
-		return x.OriginalLanguage
+	if x != nil && x.OriginalLanguage != nil {
+		return *x.OriginalLanguage
 	}
 	return ""
 }
@@ -977,8 +1023,8 @@ func (x *Collection) GetCollectionWorkReferenceList() *CollectionWorkReferenceLi
 }
 
 func (x *Collection) GetRepresentativeImageReference() string {
-	if x != nil {
-		return x.RepresentativeImageReference
+	if x != nil && x.RepresentativeImageReference != nil {
+		return *x.RepresentativeImageReference
 	}
 	return ""
 }
@@ -998,28 +1044,28 @@ func (x *Collection) GetCLine() []*CLine {
 }
 
 func (x *Collection) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type CollectionDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,5,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,6,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"Contributor"
 	Contributor []*DetailedResourceContributor `protobuf:"bytes,2,rep,name=contributor,proto3" json:"contributor,omitempty" xml:"Contributor"`
 	// @gotags: xml:"IsComplete"
-	IsComplete bool `protobuf:"varint,3,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty" xml:"IsComplete"`
+	IsComplete *bool `protobuf:"varint,3,opt,name=is_complete,json=isComplete,proto3,oneof" json:"is_complete,omitempty" xml:"IsComplete"`
 	// @gotags: xml:"Character"
-	Character []*Character `protobuf:"bytes,4,rep,name=character,proto3" json:"character,omitempty" xml:"Character"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,5,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,6,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	Character     []*Character `protobuf:"bytes,4,rep,name=character,proto3" json:"character,omitempty" xml:"Character"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CollectionDetailsByTerritory) Reset() {
@@ -1052,44 +1098,44 @@ func (*CollectionDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CollectionDetailsByTerritory) GetTitle() []*Title {
+func (x *CollectionDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.Title
+		return x.TerritoryCode
 	}
 	return nil
 }
 
-func (x *CollectionDetailsByTerritory) GetContributor() []*DetailedResourceContributor {
+func (x *CollectionDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.Contributor
+		return x.ExcludedTerritoryCode
 	}
 	return nil
 }
 
-func (x *CollectionDetailsByTerritory) GetIsComplete() bool {
+func (x *CollectionDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
-		return x.IsComplete
+		return x.Title
 	}
-	return false
+	return nil
 }
 
-func (x *CollectionDetailsByTerritory) GetCharacter() []*Character {
+func (x *CollectionDetailsByTerritory) GetContributor() []*DetailedResourceContributor {
 	if x != nil {
-		return x.Character
+		return x.Contributor
 	}
 	return nil
 }
 
-func (x *CollectionDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
+func (x *CollectionDetailsByTerritory) GetIsComplete() bool {
+	if x != nil && x.IsComplete != nil {
+		return *x.IsComplete
 	}
-	return nil
+	return false
 }
 
-func (x *CollectionDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+func (x *CollectionDetailsByTerritory) GetCharacter() []*Character {
 	if x != nil {
-		return x.ExcludedTerritoryCode
+		return x.Character
 	}
 	return nil
 }
@@ -1099,7 +1145,7 @@ type CollectionList struct {
 	// @gotags: xml:"Collection"
 	Collection []*Collection `protobuf:"bytes,1,rep,name=collection,proto3" json:"collection,omitempty" xml:"Collection"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -1142,8 +1188,8 @@ func (x *CollectionList) GetCollection() []*Collection {
 }
 
 func (x *CollectionList) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -1151,11 +1197,11 @@ func (x *CollectionList) GetLanguageAndScriptCode() string {
 type CollectionResourceReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"CollectionResourceReference"
 	CollectionResourceReference string `protobuf:"bytes,2,opt,name=collection_resource_reference,json=collectionResourceReference,proto3" json:"collection_resource_reference,omitempty" xml:"CollectionResourceReference"`
 	// @gotags: xml:"Duration"
-	Duration      string `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration      *string `protobuf:"bytes,3,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1191,8 +1237,8 @@ func (*CollectionResourceReference) Descriptor() ([]byte, []int) {
 }
 
 func (x *CollectionResourceReference) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -1205,8 +1251,8 @@ func (x *CollectionResourceReference) GetCollectionResourceReference() string {
 }
 
 func (x *CollectionResourceReference) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
@@ -1265,27 +1311,15 @@ type Cue struct {
 	// @gotags: xml:"CueVocalType"
 	CueVocalType *CueVocalType `protobuf:"bytes,3,opt,name=cue_vocal_type,json=cueVocalType,proto3" json:"cue_vocal_type,omitempty" xml:"CueVocalType"`
 	// @gotags: xml:"IsDance"
-	IsDance bool `protobuf:"varint,4,opt,name=is_dance,json=isDance,proto3" json:"is_dance,omitempty" xml:"IsDance"`
+	IsDance *bool `protobuf:"varint,4,opt,name=is_dance,json=isDance,proto3,oneof" json:"is_dance,omitempty" xml:"IsDance"`
 	// @gotags: xml:"CueVisualPerceptionType"
 	CueVisualPerceptionType *CueVisualPerceptionType `protobuf:"bytes,5,opt,name=cue_visual_perception_type,json=cueVisualPerceptionType,proto3" json:"cue_visual_perception_type,omitempty" xml:"CueVisualPerceptionType"`
 	// @gotags: xml:"CueOrigin"
 	CueOrigin *CueOrigin `protobuf:"bytes,6,opt,name=cue_origin,json=cueOrigin,proto3" json:"cue_origin,omitempty" xml:"CueOrigin"`
-	// @gotags: xml:"HasMusicalContent"
-	HasMusicalContent bool `protobuf:"varint,7,opt,name=has_musical_content,json=hasMusicalContent,proto3" json:"has_musical_content,omitempty" xml:"HasMusicalContent"`
-	// @gotags: xml:"StartTime"
-	StartTime string `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" xml:"StartTime"`
-	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,9,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
-	// @gotags: xml:"EndTime"
-	EndTime string `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" xml:"EndTime"`
-	// @gotags: xml:"PLine"
-	PLine []*PLine `protobuf:"bytes,11,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
-	// @gotags: xml:"CLine"
-	CLine []*CLine `protobuf:"bytes,12,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty" xml:"CLine"`
 	// @gotags: xml:"CueCreationReference"
 	CueCreationReference []*CueCreationReference `protobuf:"bytes,13,rep,name=cue_creation_reference,json=cueCreationReference,proto3" json:"cue_creation_reference,omitempty" xml:"CueCreationReference"`
-	// @gotags: xml:"ReferencedCreationType"
-	ReferencedCreationType string `protobuf:"bytes,14,opt,name=referenced_creation_type,json=referencedCreationType,proto3" json:"referenced_creation_type,omitempty" xml:"ReferencedCreationType"`
+	// @gotags: xml:"ReferencedCreationType" avs:"CreationType"
+	ReferencedCreationType *string `protobuf:"bytes,14,opt,name=referenced_creation_type,json=referencedCreationType,proto3,oneof" json:"referenced_creation_type,omitempty" xml:"ReferencedCreationType" avs:"CreationType"`
 	// @gotags: xml:"ReferencedCreationId"
 	ReferencedCreationId *CreationId `protobuf:"bytes,15,opt,name=referenced_creation_id,json=referencedCreationId,proto3" json:"referenced_creation_id,omitempty" xml:"ReferencedCreationId"`
 	// @gotags: xml:"ReferencedCreationTitle"
@@ -1296,8 +1330,20 @@ type Cue struct {
 	ReferencedIndirectCreationContributor []*MusicalWorkContributor `protobuf:"bytes,18,rep,name=referenced_indirect_creation_contributor,json=referencedIndirectCreationContributor,proto3" json:"referenced_indirect_creation_contributor,omitempty" xml:"ReferencedIndirectCreationContributor"`
 	// @gotags: xml:"ReferencedCreationCharacter"
 	ReferencedCreationCharacter []*Character `protobuf:"bytes,19,rep,name=referenced_creation_character,json=referencedCreationCharacter,proto3" json:"referenced_creation_character,omitempty" xml:"ReferencedCreationCharacter"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+	// @gotags: xml:"HasMusicalContent"
+	HasMusicalContent *bool `protobuf:"varint,7,opt,name=has_musical_content,json=hasMusicalContent,proto3,oneof" json:"has_musical_content,omitempty" xml:"HasMusicalContent"`
+	// @gotags: xml:"StartTime"
+	StartTime *string `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty" xml:"StartTime"`
+	// @gotags: xml:"Duration"
+	Duration *string `protobuf:"bytes,9,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
+	// @gotags: xml:"EndTime"
+	EndTime *string `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty" xml:"EndTime"`
+	// @gotags: xml:"PLine"
+	PLine []*PLine `protobuf:"bytes,11,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
+	// @gotags: xml:"CLine"
+	CLine         []*CLine `protobuf:"bytes,12,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty" xml:"CLine"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Cue) Reset() {
@@ -1352,8 +1398,8 @@ func (x *Cue) GetCueVocalType() *CueVocalType {
 }
 
 func (x *Cue) GetIsDance() bool {
-	if x != nil {
-		return x.IsDance
+	if x != nil && x.IsDance != nil {
+		return *x.IsDance
 	}
 	return false
 }
@@ -1372,93 +1418,93 @@ func (x *Cue) GetCueOrigin() *CueOrigin {
 	return nil
 }
 
-func (x *Cue) GetHasMusicalContent() bool {
+func (x *Cue) GetCueCreationReference() []*CueCreationReference {
 	if x != nil {
-		return x.HasMusicalContent
+		return x.CueCreationReference
 	}
-	return false
+	return nil
 }
 
-func (x *Cue) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
+func (x *Cue) GetReferencedCreationType() string {
+	if x != nil && x.ReferencedCreationType != nil {
+		return *x.ReferencedCreationType
 	}
 	return ""
 }
 
-func (x *Cue) GetDuration() string {
+func (x *Cue) GetReferencedCreationId() *CreationId {
 	if x != nil {
-		return x.Duration
+		return x.ReferencedCreationId
 	}
-	return ""
+	return nil
 }
 
-func (x *Cue) GetEndTime() string {
+func (x *Cue) GetReferencedCreationTitle() []*Title {
 	if x != nil {
-		return x.EndTime
+		return x.ReferencedCreationTitle
 	}
-	return ""
+	return nil
 }
 
-func (x *Cue) GetPLine() []*PLine {
+func (x *Cue) GetReferencedCreationContributor() []*DetailedResourceContributor {
 	if x != nil {
-		return x.PLine
+		return x.ReferencedCreationContributor
 	}
 	return nil
 }
 
-func (x *Cue) GetCLine() []*CLine {
+func (x *Cue) GetReferencedIndirectCreationContributor() []*MusicalWorkContributor {
 	if x != nil {
-		return x.CLine
+		return x.ReferencedIndirectCreationContributor
 	}
 	return nil
 }
 
-func (x *Cue) GetCueCreationReference() []*CueCreationReference {
+func (x *Cue) GetReferencedCreationCharacter() []*Character {
 	if x != nil {
-		return x.CueCreationReference
+		return x.ReferencedCreationCharacter
 	}
 	return nil
 }
 
-func (x *Cue) GetReferencedCreationType() string {
-	if x != nil {
-		return x.ReferencedCreationType
+func (x *Cue) GetHasMusicalContent() bool {
+	if x != nil && x.HasMusicalContent != nil {
+		return *x.HasMusicalContent
 	}
-	return ""
+	return false
 }
 
-func (x *Cue) GetReferencedCreationId() *CreationId {
-	if x != nil {
-		return x.ReferencedCreationId
+func (x *Cue) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
-	return nil
+	return ""
 }
 
-func (x *Cue) GetReferencedCreationTitle() []*Title {
-	if x != nil {
-		return x.ReferencedCreationTitle
+func (x *Cue) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
-	return nil
+	return ""
 }
 
-func (x *Cue) GetReferencedCreationContributor() []*DetailedResourceContributor {
-	if x != nil {
-		return x.ReferencedCreationContributor
+func (x *Cue) GetEndTime() string {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
-	return nil
+	return ""
 }
 
-func (x *Cue) GetReferencedIndirectCreationContributor() []*MusicalWorkContributor {
+func (x *Cue) GetPLine() []*PLine {
 	if x != nil {
-		return x.ReferencedIndirectCreationContributor
+		return x.PLine
 	}
 	return nil
 }
 
-func (x *Cue) GetReferencedCreationCharacter() []*Character {
+func (x *Cue) GetCLine() []*CLine {
 	if x != nil {
-		return x.ReferencedCreationCharacter
+		return x.CLine
 	}
 	return nil
 }
@@ -1593,7 +1639,7 @@ type Deal struct {
 	// @gotags: xml:"DistributionChannelPage"
 	DistributionChannelPage []*WebPage `protobuf:"bytes,5,rep,name=distribution_channel_page,json=distributionChannelPage,proto3" json:"distribution_channel_page,omitempty" xml:"DistributionChannelPage"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -1664,8 +1710,8 @@ func (x *Deal) GetDistributionChannelPage() []*WebPage {
 }
 
 func (x *Deal) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -1675,7 +1721,7 @@ type DealList struct {
 	// @gotags: xml:"ReleaseDeal"
 	ReleaseDeal []*ReleaseDeal `protobuf:"bytes,1,rep,name=release_deal,json=releaseDeal,proto3" json:"release_deal,omitempty" xml:"ReleaseDeal"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -1718,8 +1764,8 @@ func (x *DealList) GetReleaseDeal() []*ReleaseDeal {
 }
 
 func (x *DealList) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -1826,33 +1872,9 @@ func (x *DealTechnicalResourceDetailsReferenceList) GetDealTechnicalResourceDeta
 type DealTerms struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"IsPreOrderDeal"
-	IsPreOrderDeal bool `protobuf:"varint,1,opt,name=is_pre_order_deal,json=isPreOrderDeal,proto3" json:"is_pre_order_deal,omitempty" xml:"IsPreOrderDeal"`
+	IsPreOrderDeal *bool `protobuf:"varint,1,opt,name=is_pre_order_deal,json=isPreOrderDeal,proto3,oneof" json:"is_pre_order_deal,omitempty" xml:"IsPreOrderDeal"`
 	// @gotags: xml:"CommercialModelType"
 	CommercialModelType []*CommercialModelType `protobuf:"bytes,2,rep,name=commercial_model_type,json=commercialModelType,proto3" json:"commercial_model_type,omitempty" xml:"CommercialModelType"`
-	// @gotags: xml:"PriceInformation"
-	PriceInformation []*PriceInformation `protobuf:"bytes,3,rep,name=price_information,json=priceInformation,proto3" json:"price_information,omitempty" xml:"PriceInformation"`
-	// @gotags: xml:"ValidityPeriod"
-	ValidityPeriod []*Period `protobuf:"bytes,4,rep,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
-	// @gotags: xml:"ConsumerRentalPeriod"
-	ConsumerRentalPeriod *ConsumerRentalPeriod `protobuf:"bytes,5,opt,name=consumer_rental_period,json=consumerRentalPeriod,proto3" json:"consumer_rental_period,omitempty" xml:"ConsumerRentalPeriod"`
-	// @gotags: xml:"PreOrderReleaseDate"
-	PreOrderReleaseDate *EventDate `protobuf:"bytes,6,opt,name=pre_order_release_date,json=preOrderReleaseDate,proto3" json:"pre_order_release_date,omitempty" xml:"PreOrderReleaseDate"`
-	// @gotags: xml:"PreOrderIncentiveResourceList"
-	PreOrderIncentiveResourceList *DealResourceReferenceList `protobuf:"bytes,7,opt,name=pre_order_incentive_resource_list,json=preOrderIncentiveResourceList,proto3" json:"pre_order_incentive_resource_list,omitempty" xml:"PreOrderIncentiveResourceList"`
-	// @gotags: xml:"InstantGratificationResourceList"
-	InstantGratificationResourceList *DealResourceReferenceList `protobuf:"bytes,8,opt,name=instant_gratification_resource_list,json=instantGratificationResourceList,proto3" json:"instant_gratification_resource_list,omitempty" xml:"InstantGratificationResourceList"`
-	// @gotags: xml:"IsExclusive"
-	IsExclusive bool `protobuf:"varint,9,opt,name=is_exclusive,json=isExclusive,proto3" json:"is_exclusive,omitempty" xml:"IsExclusive"`
-	// @gotags: xml:"RelatedReleaseOfferSet"
-	RelatedReleaseOfferSet []*RelatedReleaseOfferSet `protobuf:"bytes,10,rep,name=related_release_offer_set,json=relatedReleaseOfferSet,proto3" json:"related_release_offer_set,omitempty" xml:"RelatedReleaseOfferSet"`
-	// @gotags: xml:"PhysicalReturns"
-	PhysicalReturns *PhysicalReturns `protobuf:"bytes,11,opt,name=physical_returns,json=physicalReturns,proto3" json:"physical_returns,omitempty" xml:"PhysicalReturns"`
-	// @gotags: xml:"NumberOfProductsPerCarton"
-	NumberOfProductsPerCarton int32 `protobuf:"varint,12,opt,name=number_of_products_per_carton,json=numberOfProductsPerCarton,proto3" json:"number_of_products_per_carton,omitempty" xml:"NumberOfProductsPerCarton"`
-	// @gotags: xml:"RightsClaimPolicy"
-	RightsClaimPolicy []*RightsClaimPolicy `protobuf:"bytes,13,rep,name=rights_claim_policy,json=rightsClaimPolicy,proto3" json:"rights_claim_policy,omitempty" xml:"RightsClaimPolicy"`
-	// @gotags: xml:"WebPolicy"
-	WebPolicy []*WebPolicy `protobuf:"bytes,14,rep,name=web_policy,json=webPolicy,proto3" json:"web_policy,omitempty" xml:"WebPolicy"`
 	// @gotags: xml:"Usage"
 	Usage []*Usage `protobuf:"bytes,15,rep,name=usage,proto3" json:"usage,omitempty" xml:"Usage"`
 	// @gotags: xml:"AllDealsCancelled"
@@ -1867,10 +1889,18 @@ type DealTerms struct {
 	DistributionChannel []*DSP `protobuf:"bytes,20,rep,name=distribution_channel,json=distributionChannel,proto3" json:"distribution_channel,omitempty" xml:"DistributionChannel"`
 	// @gotags: xml:"ExcludedDistributionChannel"
 	ExcludedDistributionChannel []*DSP `protobuf:"bytes,21,rep,name=excluded_distribution_channel,json=excludedDistributionChannel,proto3" json:"excluded_distribution_channel,omitempty" xml:"ExcludedDistributionChannel"`
+	// @gotags: xml:"PriceInformation"
+	PriceInformation []*PriceInformation `protobuf:"bytes,3,rep,name=price_information,json=priceInformation,proto3" json:"price_information,omitempty" xml:"PriceInformation"`
 	// @gotags: xml:"IsPromotional"
 	IsPromotional bool `protobuf:"varint,22,opt,name=is_promotional,json=isPromotional,proto3" json:"is_promotional,omitempty" xml:"IsPromotional"`
 	// @gotags: xml:"PromotionalCode"
 	PromotionalCode *PromotionalCode `protobuf:"bytes,23,opt,name=promotional_code,json=promotionalCode,proto3" json:"promotional_code,omitempty" xml:"PromotionalCode"`
+	// @gotags: xml:"ValidityPeriod"
+	ValidityPeriod []*Period `protobuf:"bytes,4,rep,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
+	// @gotags: xml:"ConsumerRentalPeriod"
+	ConsumerRentalPeriod *ConsumerRentalPeriod `protobuf:"bytes,5,opt,name=consumer_rental_period,json=consumerRentalPeriod,proto3" json:"consumer_rental_period,omitempty" xml:"ConsumerRentalPeriod"`
+	// @gotags: xml:"PreOrderReleaseDate"
+	PreOrderReleaseDate *EventDate `protobuf:"bytes,6,opt,name=pre_order_release_date,json=preOrderReleaseDate,proto3" json:"pre_order_release_date,omitempty" xml:"PreOrderReleaseDate"`
 	// @gotags: xml:"PreOrderPreviewDate"
 	PreOrderPreviewDate *EventDate `protobuf:"bytes,24,opt,name=pre_order_preview_date,json=preOrderPreviewDate,proto3" json:"pre_order_preview_date,omitempty" xml:"PreOrderPreviewDate"`
 	// @gotags: xml:"PreOrderPreviewDateTime"
@@ -1891,8 +1921,24 @@ type DealTerms struct {
 	CoverArtPreviewStartDateTime string `protobuf:"bytes,32,opt,name=cover_art_preview_start_date_time,json=coverArtPreviewStartDateTime,proto3" json:"cover_art_preview_start_date_time,omitempty" xml:"CoverArtPreviewStartDateTime"`
 	// @gotags: xml:"ClipPreviewStartDateTime"
 	ClipPreviewStartDateTime string `protobuf:"bytes,33,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
+	// @gotags: xml:"PreOrderIncentiveResourceList"
+	PreOrderIncentiveResourceList *DealResourceReferenceList `protobuf:"bytes,7,opt,name=pre_order_incentive_resource_list,json=preOrderIncentiveResourceList,proto3" json:"pre_order_incentive_resource_list,omitempty" xml:"PreOrderIncentiveResourceList"`
+	// @gotags: xml:"InstantGratificationResourceList"
+	InstantGratificationResourceList *DealResourceReferenceList `protobuf:"bytes,8,opt,name=instant_gratification_resource_list,json=instantGratificationResourceList,proto3" json:"instant_gratification_resource_list,omitempty" xml:"InstantGratificationResourceList"`
+	// @gotags: xml:"IsExclusive"
+	IsExclusive *bool `protobuf:"varint,9,opt,name=is_exclusive,json=isExclusive,proto3,oneof" json:"is_exclusive,omitempty" xml:"IsExclusive"`
+	// @gotags: xml:"RelatedReleaseOfferSet"
+	RelatedReleaseOfferSet []*RelatedReleaseOfferSet `protobuf:"bytes,10,rep,name=related_release_offer_set,json=relatedReleaseOfferSet,proto3" json:"related_release_offer_set,omitempty" xml:"RelatedReleaseOfferSet"`
+	// @gotags: xml:"PhysicalReturns"
+	PhysicalReturns *PhysicalReturns `protobuf:"bytes,11,opt,name=physical_returns,json=physicalReturns,proto3" json:"physical_returns,omitempty" xml:"PhysicalReturns"`
+	// @gotags: xml:"NumberOfProductsPerCarton"
+	NumberOfProductsPerCarton *int32 `protobuf:"varint,12,opt,name=number_of_products_per_carton,json=numberOfProductsPerCarton,proto3,oneof" json:"number_of_products_per_carton,omitempty" xml:"NumberOfProductsPerCarton"`
+	// @gotags: xml:"RightsClaimPolicy"
+	RightsClaimPolicy []*RightsClaimPolicy `protobuf:"bytes,13,rep,name=rights_claim_policy,json=rightsClaimPolicy,proto3" json:"rights_claim_policy,omitempty" xml:"RightsClaimPolicy"`
+	// @gotags: xml:"WebPolicy"
+	WebPolicy []*WebPolicy `protobuf:"bytes,14,rep,name=web_policy,json=webPolicy,proto3" json:"web_policy,omitempty" xml:"WebPolicy"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,34,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,34,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -1928,8 +1974,8 @@ func (*DealTerms) Descriptor() ([]byte, []int) {
 }
 
 func (x *DealTerms) GetIsPreOrderDeal() bool {
-	if x != nil {
-		return x.IsPreOrderDeal
+	if x != nil && x.IsPreOrderDeal != nil {
+		return *x.IsPreOrderDeal
 	}
 	return false
 }
@@ -1941,149 +1987,93 @@ func (x *DealTerms) GetCommercialModelType() []*CommercialModelType {
 	return nil
 }
 
-func (x *DealTerms) GetPriceInformation() []*PriceInformation {
+func (x *DealTerms) GetUsage() []*Usage {
 	if x != nil {
-		return x.PriceInformation
+		return x.Usage
 	}
 	return nil
 }
 
-func (x *DealTerms) GetValidityPeriod() []*Period {
+func (x *DealTerms) GetAllDealsCancelled() bool {
 	if x != nil {
-		return x.ValidityPeriod
+		return x.AllDealsCancelled
 	}
-	return nil
+	return false
 }
 
-func (x *DealTerms) GetConsumerRentalPeriod() *ConsumerRentalPeriod {
-	if x != nil {
-		return x.ConsumerRentalPeriod
-	}
-	return nil
-}
-
-func (x *DealTerms) GetPreOrderReleaseDate() *EventDate {
-	if x != nil {
-		return x.PreOrderReleaseDate
-	}
-	return nil
-}
-
-func (x *DealTerms) GetPreOrderIncentiveResourceList() *DealResourceReferenceList {
-	if x != nil {
-		return x.PreOrderIncentiveResourceList
-	}
-	return nil
-}
-
-func (x *DealTerms) GetInstantGratificationResourceList() *DealResourceReferenceList {
-	if x != nil {
-		return x.InstantGratificationResourceList
-	}
-	return nil
-}
-
-func (x *DealTerms) GetIsExclusive() bool {
+func (x *DealTerms) GetTakeDown() bool {
 	if x != nil {
-		return x.IsExclusive
+		return x.TakeDown
 	}
 	return false
 }
 
-func (x *DealTerms) GetRelatedReleaseOfferSet() []*RelatedReleaseOfferSet {
+func (x *DealTerms) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.RelatedReleaseOfferSet
+		return x.TerritoryCode
 	}
 	return nil
 }
 
-func (x *DealTerms) GetPhysicalReturns() *PhysicalReturns {
+func (x *DealTerms) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.PhysicalReturns
+		return x.ExcludedTerritoryCode
 	}
 	return nil
 }
 
-func (x *DealTerms) GetNumberOfProductsPerCarton() int32 {
-	if x != nil {
-		return x.NumberOfProductsPerCarton
-	}
-	return 0
-}
-
-func (x *DealTerms) GetRightsClaimPolicy() []*RightsClaimPolicy {
+func (x *DealTerms) GetDistributionChannel() []*DSP {
 	if x != nil {
-		return x.RightsClaimPolicy
+		return x.DistributionChannel
 	}
 	return nil
 }
 
-func (x *DealTerms) GetWebPolicy() []*WebPolicy {
+func (x *DealTerms) GetExcludedDistributionChannel() []*DSP {
 	if x != nil {
-		return x.WebPolicy
+		return x.ExcludedDistributionChannel
 	}
 	return nil
 }
 
-func (x *DealTerms) GetUsage() []*Usage {
+func (x *DealTerms) GetPriceInformation() []*PriceInformation {
 	if x != nil {
-		return x.Usage
+		return x.PriceInformation
 	}
 	return nil
 }
 
-func (x *DealTerms) GetAllDealsCancelled() bool {
-	if x != nil {
-		return x.AllDealsCancelled
-	}
-	return false
-}
-
-func (x *DealTerms) GetTakeDown() bool {
+func (x *DealTerms) GetIsPromotional() bool {
 	if x != nil {
-		return x.TakeDown
+		return x.IsPromotional
 	}
 	return false
 }
 
-func (x *DealTerms) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *DealTerms) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+func (x *DealTerms) GetPromotionalCode() *PromotionalCode {
 	if x != nil {
-		return x.ExcludedTerritoryCode
+		return x.PromotionalCode
 	}
 	return nil
 }
 
-func (x *DealTerms) GetDistributionChannel() []*DSP {
+func (x *DealTerms) GetValidityPeriod() []*Period {
 	if x != nil {
-		return x.DistributionChannel
+		return x.ValidityPeriod
 	}
 	return nil
 }
 
-func (x *DealTerms) GetExcludedDistributionChannel() []*DSP {
+func (x *DealTerms) GetConsumerRentalPeriod() *ConsumerRentalPeriod {
 	if x != nil {
-		return x.ExcludedDistributionChannel
+		return x.ConsumerRentalPeriod
 	}
 	return nil
 }
 
-func (x *DealTerms) GetIsPromotional() bool {
-	if x != nil {
-		return x.IsPromotional
-	}
-	return false
-}
-
-func (x *DealTerms) GetPromotionalCode() *PromotionalCode {
+func (x *DealTerms) GetPreOrderReleaseDate() *EventDate {
 	if x != nil {
-		return x.PromotionalCode
+		return x.PreOrderReleaseDate
 	}
 	return nil
 }
@@ -2158,9 +2148,65 @@ func (x *DealTerms) GetClipPreviewStartDateTime() string {
 	return ""
 }
 
-func (x *DealTerms) GetLanguageAndScriptCode() string {
+func (x *DealTerms) GetPreOrderIncentiveResourceList() *DealResourceReferenceList {
+	if x != nil {
+		return x.PreOrderIncentiveResourceList
+	}
+	return nil
+}
+
+func (x *DealTerms) GetInstantGratificationResourceList() *DealResourceReferenceList {
+	if x != nil {
+		return x.InstantGratificationResourceList
+	}
+	return nil
+}
+
+func (x *DealTerms) GetIsExclusive() bool {
+	if x != nil && x.IsExclusive != nil {
+		return *x.IsExclusive
+	}
+	return false
+}
+
+func (x *DealTerms) GetRelatedReleaseOfferSet() []*RelatedReleaseOfferSet {
+	if x != nil {
+		return x.RelatedReleaseOfferSet
+	}
+	return nil
+}
+
+func (x *DealTerms) GetPhysicalReturns() *PhysicalReturns {
+	if x != nil {
+		return x.PhysicalReturns
+	}
+	return nil
+}
+
+func (x *DealTerms) GetNumberOfProductsPerCarton() int32 {
+	if x != nil && x.NumberOfProductsPerCarton != nil {
+		return *x.NumberOfProductsPerCarton
+	}
+	return 0
+}
+
+func (x *DealTerms) GetRightsClaimPolicy() []*RightsClaimPolicy {
+	if x != nil {
+		return x.RightsClaimPolicy
+	}
+	return nil
+}
+
+func (x *DealTerms) GetWebPolicy() []*WebPolicy {
 	if x != nil {
-		return x.LanguageAndScriptCode
+		return x.WebPolicy
+	}
+	return nil
+}
+
+func (x *DealTerms) GetLanguageAndScriptCode() string {
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -2172,11 +2218,11 @@ type Fingerprint struct {
 	// @gotags: xml:"FingerprintAlgorithmType"
 	FingerprintAlgorithmType *FingerprintAlgorithmType `protobuf:"bytes,2,opt,name=fingerprint_algorithm_type,json=fingerprintAlgorithmType,proto3" json:"fingerprint_algorithm_type,omitempty" xml:"FingerprintAlgorithmType"`
 	// @gotags: xml:"FingerprintAlgorithmVersion"
-	FingerprintAlgorithmVersion string `protobuf:"bytes,3,opt,name=fingerprint_algorithm_version,json=fingerprintAlgorithmVersion,proto3" json:"fingerprint_algorithm_version,omitempty" xml:"FingerprintAlgorithmVersion"`
+	FingerprintAlgorithmVersion *string `protobuf:"bytes,3,opt,name=fingerprint_algorithm_version,json=fingerprintAlgorithmVersion,proto3,oneof" json:"fingerprint_algorithm_version,omitempty" xml:"FingerprintAlgorithmVersion"`
 	// @gotags: xml:"FingerprintAlgorithmParameter"
-	FingerprintAlgorithmParameter string `protobuf:"bytes,4,opt,name=fingerprint_algorithm_parameter,json=fingerprintAlgorithmParameter,proto3" json:"fingerprint_algorithm_parameter,omitempty" xml:"FingerprintAlgorithmParameter"`
-	// @gotags: xml:"FingerprintDataType"
-	FingerprintDataType string `protobuf:"bytes,5,opt,name=fingerprint_data_type,json=fingerprintDataType,proto3" json:"fingerprint_data_type,omitempty" xml:"FingerprintDataType"`
+	FingerprintAlgorithmParameter *string `protobuf:"bytes,4,opt,name=fingerprint_algorithm_parameter,json=fingerprintAlgorithmParameter,proto3,oneof" json:"fingerprint_algorithm_parameter,omitempty" xml:"FingerprintAlgorithmParameter"`
+	// @gotags: xml:"FingerprintDataType" avs:"BinaryDataType"
+	FingerprintDataType *string `protobuf:"bytes,5,opt,name=fingerprint_data_type,json=fingerprintDataType,proto3,oneof" json:"fingerprint_data_type,omitempty" xml:"FingerprintDataType" avs:"BinaryDataType"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -2226,22 +2272,22 @@ func (x *Fingerprint) GetFingerprintAlgorithmType() *FingerprintAlgorithmType {
 }
 
 func (x *Fingerprint) GetFingerprintAlgorithmVersion() string {
-	if x != nil {
-		return x.FingerprintAlgorithmVersion
+	if x != nil && x.FingerprintAlgorithmVersion != nil {
+		return *x.FingerprintAlgorithmVersion
 	}
 	return ""
 }
 
 func (x *Fingerprint) GetFingerprintAlgorithmParameter() string {
-	if x != nil {
-		return x.FingerprintAlgorithmParameter
+	if x != nil && x.FingerprintAlgorithmParameter != nil {
+		return *x.FingerprintAlgorithmParameter
 	}
 	return ""
 }
 
 func (x *Fingerprint) GetFingerprintDataType() string {
-	if x != nil {
-		return x.FingerprintDataType
+	if x != nil && x.FingerprintDataType != nil {
+		return *x.FingerprintDataType
 	}
 	return ""
 }
@@ -2251,7 +2297,7 @@ type Image struct {
 	// @gotags: xml:"ImageType"
 	ImageType *ImageType `protobuf:"bytes,1,opt,name=image_type,json=imageType,proto3" json:"image_type,omitempty" xml:"ImageType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"ImageId"
 	ImageId []*ResourceProprietaryId `protobuf:"bytes,3,rep,name=image_id,json=imageId,proto3" json:"image_id,omitempty" xml:"ImageId"`
 	// @gotags: xml:"ResourceReference"
@@ -2263,9 +2309,9 @@ type Image struct {
 	// @gotags: xml:"ImageDetailsByTerritory"
 	ImageDetailsByTerritory []*ImageDetailsByTerritory `protobuf:"bytes,7,rep,name=image_details_by_territory,json=imageDetailsByTerritory,proto3" json:"image_details_by_territory,omitempty" xml:"ImageDetailsByTerritory"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,8,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,8,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -2308,8 +2354,8 @@ func (x *Image) GetImageType() *ImageType {
 }
 
 func (x *Image) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -2350,21 +2396,25 @@ func (x *Image) GetImageDetailsByTerritory() []*ImageDetailsByTerritory {
 }
 
 func (x *Image) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *Image) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type ImageDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"ResourceContributor"
@@ -2395,12 +2445,8 @@ type ImageDetailsByTerritory struct {
 	ParentalWarningType []*ParentalWarningType `protobuf:"bytes,14,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty" xml:"ParentalWarningType"`
 	// @gotags: xml:"TechnicalImageDetails"
 	TechnicalImageDetails []*TechnicalImageDetails `protobuf:"bytes,15,rep,name=technical_image_details,json=technicalImageDetails,proto3" json:"technical_image_details,omitempty" xml:"TechnicalImageDetails"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,18,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,18,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -2435,6 +2481,20 @@ func (*ImageDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{21}
 }
 
+func (x *ImageDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *ImageDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *ImageDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
 		return x.Title
@@ -2540,23 +2600,9 @@ func (x *ImageDetailsByTerritory) GetTechnicalImageDetails() []*TechnicalImageDe
 	return nil
 }
 
-func (x *ImageDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *ImageDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *ImageDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -2566,7 +2612,7 @@ type MIDI struct {
 	// @gotags: xml:"MidiType"
 	MidiType *MidiType `protobuf:"bytes,1,opt,name=midi_type,json=midiType,proto3" json:"midi_type,omitempty" xml:"MidiType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"MidiId"
 	MidiId []*ResourceProprietaryId `protobuf:"bytes,3,rep,name=midi_id,json=midiId,proto3" json:"midi_id,omitempty" xml:"MidiId"`
 	// @gotags: xml:"IndirectMidiId"
@@ -2578,27 +2624,27 @@ type MIDI struct {
 	// @gotags: xml:"InstrumentationDescription"
 	InstrumentationDescription *Description `protobuf:"bytes,7,opt,name=instrumentation_description,json=instrumentationDescription,proto3" json:"instrumentation_description,omitempty" xml:"InstrumentationDescription"`
 	// @gotags: xml:"IsMedley"
-	IsMedley bool `protobuf:"varint,8,opt,name=is_medley,json=isMedley,proto3" json:"is_medley,omitempty" xml:"IsMedley"`
+	IsMedley *bool `protobuf:"varint,8,opt,name=is_medley,json=isMedley,proto3,oneof" json:"is_medley,omitempty" xml:"IsMedley"`
 	// @gotags: xml:"IsPotpourri"
-	IsPotpourri bool `protobuf:"varint,9,opt,name=is_potpourri,json=isPotpourri,proto3" json:"is_potpourri,omitempty" xml:"IsPotpourri"`
+	IsPotpourri *bool `protobuf:"varint,9,opt,name=is_potpourri,json=isPotpourri,proto3,oneof" json:"is_potpourri,omitempty" xml:"IsPotpourri"`
 	// @gotags: xml:"IsInstrumental"
-	IsInstrumental bool `protobuf:"varint,10,opt,name=is_instrumental,json=isInstrumental,proto3" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
+	IsInstrumental *bool `protobuf:"varint,10,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
 	// @gotags: xml:"IsBackground"
-	IsBackground bool `protobuf:"varint,11,opt,name=is_background,json=isBackground,proto3" json:"is_background,omitempty" xml:"IsBackground"`
+	IsBackground *bool `protobuf:"varint,11,opt,name=is_background,json=isBackground,proto3,oneof" json:"is_background,omitempty" xml:"IsBackground"`
 	// @gotags: xml:"IsHiddenResource"
-	IsHiddenResource bool `protobuf:"varint,12,opt,name=is_hidden_resource,json=isHiddenResource,proto3" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
+	IsHiddenResource *bool `protobuf:"varint,12,opt,name=is_hidden_resource,json=isHiddenResource,proto3,oneof" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
 	// @gotags: xml:"IsBonusResource"
-	IsBonusResource bool `protobuf:"varint,13,opt,name=is_bonus_resource,json=isBonusResource,proto3" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
+	IsBonusResource *bool `protobuf:"varint,13,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
 	// @gotags: xml:"IsComputerGenerated"
-	IsComputerGenerated bool `protobuf:"varint,14,opt,name=is_computer_generated,json=isComputerGenerated,proto3" json:"is_computer_generated,omitempty" xml:"IsComputerGenerated"`
+	IsComputerGenerated *bool `protobuf:"varint,14,opt,name=is_computer_generated,json=isComputerGenerated,proto3,oneof" json:"is_computer_generated,omitempty" xml:"IsComputerGenerated"`
 	// @gotags: xml:"NoSilenceBefore"
-	NoSilenceBefore bool `protobuf:"varint,15,opt,name=no_silence_before,json=noSilenceBefore,proto3" json:"no_silence_before,omitempty" xml:"NoSilenceBefore"`
+	NoSilenceBefore *bool `protobuf:"varint,15,opt,name=no_silence_before,json=noSilenceBefore,proto3,oneof" json:"no_silence_before,omitempty" xml:"NoSilenceBefore"`
 	// @gotags: xml:"NoSilenceAfter"
-	NoSilenceAfter bool `protobuf:"varint,16,opt,name=no_silence_after,json=noSilenceAfter,proto3" json:"no_silence_after,omitempty" xml:"NoSilenceAfter"`
+	NoSilenceAfter *bool `protobuf:"varint,16,opt,name=no_silence_after,json=noSilenceAfter,proto3,oneof" json:"no_silence_after,omitempty" xml:"NoSilenceAfter"`
 	// @gotags: xml:"PerformerInformationRequired"
-	PerformerInformationRequired bool `protobuf:"varint,17,opt,name=performer_information_required,json=performerInformationRequired,proto3" json:"performer_information_required,omitempty" xml:"PerformerInformationRequired"`
-	// @gotags: xml:"LanguageOfPerformance"
-	LanguageOfPerformance string `protobuf:"bytes,18,opt,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance"`
+	PerformerInformationRequired *bool `protobuf:"varint,17,opt,name=performer_information_required,json=performerInformationRequired,proto3,oneof" json:"performer_information_required,omitempty" xml:"PerformerInformationRequired"`
+	// @gotags: xml:"LanguageOfPerformance" avs:"IsoLanguageCode"
+	LanguageOfPerformance *string `protobuf:"bytes,18,opt,name=language_of_performance,json=languageOfPerformance,proto3,oneof" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance" avs:"IsoLanguageCode"`
 	// @gotags: xml:"Duration"
 	Duration string `protobuf:"bytes,19,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"RightsAgreementId"
@@ -2616,9 +2662,9 @@ type MIDI struct {
 	// @gotags: xml:"MidiDetailsByTerritory"
 	MidiDetailsByTerritory []*MidiDetailsByTerritory `protobuf:"bytes,26,rep,name=midi_details_by_territory,json=midiDetailsByTerritory,proto3" json:"midi_details_by_territory,omitempty" xml:"MidiDetailsByTerritory"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,27,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,27,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,28,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,28,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -2661,8 +2707,8 @@ func (x *MIDI) GetMidiType() *MidiType {
 }
 
 func (x *MIDI) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -2703,78 +2749,78 @@ func (x *MIDI) GetInstrumentationDescription() *Description {
 }
 
 func (x *MIDI) GetIsMedley() bool {
-	if x != nil {
-		return x.IsMedley
+	if x != nil && x.IsMedley != nil {
+		return *x.IsMedley
 	}
 	return false
 }
 
 func (x *MIDI) GetIsPotpourri() bool {
-	if x != nil {
-		return x.IsPotpourri
+	if x != nil && x.IsPotpourri != nil {
+		return *x.IsPotpourri
 	}
 	return false
 }
 
 func (x *MIDI) GetIsInstrumental() bool {
-	if x != nil {
-		return x.IsInstrumental
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
 	}
 	return false
 }
 
 func (x *MIDI) GetIsBackground() bool {
-	if x != nil {
-		return x.IsBackground
+	if x != nil && x.IsBackground != nil {
+		return *x.IsBackground
 	}
 	return false
 }
 
 func (x *MIDI) GetIsHiddenResource() bool {
-	if x != nil {
-		return x.IsHiddenResource
+	if x != nil && x.IsHiddenResource != nil {
+		return *x.IsHiddenResource
 	}
 	return false
 }
 
 func (x *MIDI) GetIsBonusResource() bool {
-	if x != nil {
-		return x.IsBonusResource
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
 	}
 	return false
 }
 
 func (x *MIDI) GetIsComputerGenerated() bool {
-	if x != nil {
-		return x.IsComputerGenerated
+	if x != nil && x.IsComputerGenerated != nil {
+		return *x.IsComputerGenerated
 	}
 	return false
 }
 
 func (x *MIDI) GetNoSilenceBefore() bool {
-	if x != nil {
-		return x.NoSilenceBefore
+	if x != nil && x.NoSilenceBefore != nil {
+		return *x.NoSilenceBefore
 	}
 	return false
 }
 
 func (x *MIDI) GetNoSilenceAfter() bool {
-	if x != nil {
-		return x.NoSilenceAfter
+	if x != nil && x.NoSilenceAfter != nil {
+		return *x.NoSilenceAfter
 	}
 	return false
 }
 
 func (x *MIDI) GetPerformerInformationRequired() bool {
-	if x != nil {
-		return x.PerformerInformationRequired
+	if x != nil && x.PerformerInformationRequired != nil {
+		return *x.PerformerInformationRequired
 	}
 	return false
 }
 
 func (x *MIDI) GetLanguageOfPerformance() string {
-	if x != nil {
-		return x.LanguageOfPerformance
+	if x != nil && x.LanguageOfPerformance != nil {
+		return *x.LanguageOfPerformance
 	}
 	return ""
 }
@@ -2836,21 +2882,25 @@ func (x *MIDI) GetMidiDetailsByTerritory() []*MidiDetailsByTerritory {
 }
 
 func (x *MIDI) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *MIDI) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type MidiDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,23,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,24,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"DisplayArtist"
@@ -2878,7 +2928,7 @@ type MidiDetailsByTerritory struct {
 	// @gotags: xml:"CourtesyLine"
 	CourtesyLine *CourtesyLine `protobuf:"bytes,13,opt,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty" xml:"CourtesyLine"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,14,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,14,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"HostSoundCarrier"
 	HostSoundCarrier []*HostSoundCarrier `protobuf:"bytes,15,rep,name=host_sound_carrier,json=hostSoundCarrier,proto3" json:"host_sound_carrier,omitempty" xml:"HostSoundCarrier"`
 	// @gotags: xml:"MarketingComment"
@@ -2895,12 +2945,8 @@ type MidiDetailsByTerritory struct {
 	Synopsis *Synopsis `protobuf:"bytes,21,opt,name=synopsis,proto3" json:"synopsis,omitempty" xml:"Synopsis"`
 	// @gotags: xml:"TechnicalMidiDetails"
 	TechnicalMidiDetails []*TechnicalMidiDetails `protobuf:"bytes,22,rep,name=technical_midi_details,json=technicalMidiDetails,proto3" json:"technical_midi_details,omitempty" xml:"TechnicalMidiDetails"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,23,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,24,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -2935,6 +2981,20 @@ func (*MidiDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{23}
 }
 
+func (x *MidiDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *MidiDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *MidiDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
 		return x.Title
@@ -3027,8 +3087,8 @@ func (x *MidiDetailsByTerritory) GetCourtesyLine() *CourtesyLine {
 }
 
 func (x *MidiDetailsByTerritory) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -3089,23 +3149,9 @@ func (x *MidiDetailsByTerritory) GetTechnicalMidiDetails() []*TechnicalMidiDetai
 	return nil
 }
 
-func (x *MidiDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *MidiDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *MidiDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3115,7 +3161,7 @@ type PhysicalReturns struct {
 	// @gotags: xml:"PhysicalReturnsAllowed"
 	PhysicalReturnsAllowed bool `protobuf:"varint,1,opt,name=physical_returns_allowed,json=physicalReturnsAllowed,proto3" json:"physical_returns_allowed,omitempty" xml:"PhysicalReturnsAllowed"`
 	// @gotags: xml:"LatestDateForPhysicalReturns"
-	LatestDateForPhysicalReturns string `protobuf:"bytes,2,opt,name=latest_date_for_physical_returns,json=latestDateForPhysicalReturns,proto3" json:"latest_date_for_physical_returns,omitempty" xml:"LatestDateForPhysicalReturns"`
+	LatestDateForPhysicalReturns *string `protobuf:"bytes,2,opt,name=latest_date_for_physical_returns,json=latestDateForPhysicalReturns,proto3,oneof" json:"latest_date_for_physical_returns,omitempty" xml:"LatestDateForPhysicalReturns"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -3158,8 +3204,8 @@ func (x *PhysicalReturns) GetPhysicalReturnsAllowed() bool {
 }
 
 func (x *PhysicalReturns) GetLatestDateForPhysicalReturns() string {
-	if x != nil {
-		return x.LatestDateForPhysicalReturns
+	if x != nil && x.LatestDateForPhysicalReturns != nil {
+		return *x.LatestDateForPhysicalReturns
 	}
 	return ""
 }
@@ -3169,11 +3215,11 @@ type PreviewDetails struct {
 	// @gotags: xml:"PartType"
 	PartType *Description `protobuf:"bytes,1,opt,name=part_type,json=partType,proto3" json:"part_type,omitempty" xml:"PartType"`
 	// @gotags: xml:"TopLeftCorner"
-	TopLeftCorner string `protobuf:"bytes,2,opt,name=top_left_corner,json=topLeftCorner,proto3" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
+	TopLeftCorner *string `protobuf:"bytes,2,opt,name=top_left_corner,json=topLeftCorner,proto3,oneof" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
 	// @gotags: xml:"BottomRightCorner"
-	BottomRightCorner string `protobuf:"bytes,3,opt,name=bottom_right_corner,json=bottomRightCorner,proto3" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	BottomRightCorner *string `protobuf:"bytes,3,opt,name=bottom_right_corner,json=bottomRightCorner,proto3,oneof" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3216,15 +3262,15 @@ func (x *PreviewDetails) GetPartType() *Description {
 }
 
 func (x *PreviewDetails) GetTopLeftCorner() string {
-	if x != nil {
-		return x.TopLeftCorner
+	if x != nil && x.TopLeftCorner != nil {
+		return *x.TopLeftCorner
 	}
 	return ""
 }
 
 func (x *PreviewDetails) GetBottomRightCorner() string {
-	if x != nil {
-		return x.BottomRightCorner
+	if x != nil && x.BottomRightCorner != nil {
+		return *x.BottomRightCorner
 	}
 	return ""
 }
@@ -3250,8 +3296,8 @@ type PriceInformation struct {
 	BulkOrderWholesalePricePerUnit *Price `protobuf:"bytes,5,opt,name=bulk_order_wholesale_price_per_unit,json=bulkOrderWholesalePricePerUnit,proto3" json:"bulk_order_wholesale_price_per_unit,omitempty" xml:"BulkOrderWholesalePricePerUnit"`
 	// @gotags: xml:"SuggestedRetailPrice"
 	SuggestedRetailPrice *Price `protobuf:"bytes,6,opt,name=suggested_retail_price,json=suggestedRetailPrice,proto3" json:"suggested_retail_price,omitempty" xml:"SuggestedRetailPrice"`
-	// @gotags: xml:"PriceType,attr"
-	PriceType_1   string `protobuf:"bytes,7,opt,name=price_type_1,json=priceType1,proto3" json:"price_type_1,omitempty" xml:"PriceType,attr"`
+	// @gotags: xml:"PriceType,attr" avs:"PriceInformationType"
+	PriceTypeAttr *string `protobuf:"bytes,7,opt,name=price_type_attr,json=priceTypeAttr,proto3,oneof" json:"price_type_attr,omitempty" xml:"PriceType,attr" avs:"PriceInformationType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3328,9 +3374,9 @@ func (x *PriceInformation) GetSuggestedRetailPrice() *Price {
 	return nil
 }
 
-func (x *PriceInformation) GetPriceType_1() string {
-	if x != nil {
-		return x.PriceType_1
+func (x *PriceInformation) GetPriceTypeAttr() string {
+	if x != nil && x.PriceTypeAttr != nil {
+		return *x.PriceTypeAttr
 	}
 	return ""
 }
@@ -3400,14 +3446,14 @@ func (x *PurgedRelease) GetResourceContributor() []*DetailedResourceContributor
 
 type RelatedReleaseOfferSet struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Deal"
-	Deal []*Deal `protobuf:"bytes,1,rep,name=deal,proto3" json:"deal,omitempty" xml:"Deal"`
 	// @gotags: xml:"ReleaseId"
 	ReleaseId []*ReleaseId `protobuf:"bytes,2,rep,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ReleaseDescription"
 	ReleaseDescription *Description `protobuf:"bytes,3,opt,name=release_description,json=releaseDescription,proto3" json:"release_description,omitempty" xml:"ReleaseDescription"`
+	// @gotags: xml:"Deal"
+	Deal []*Deal `protobuf:"bytes,1,rep,name=deal,proto3" json:"deal,omitempty" xml:"Deal"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3442,30 +3488,30 @@ func (*RelatedReleaseOfferSet) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RelatedReleaseOfferSet) GetDeal() []*Deal {
+func (x *RelatedReleaseOfferSet) GetReleaseId() []*ReleaseId {
 	if x != nil {
-		return x.Deal
+		return x.ReleaseId
 	}
 	return nil
 }
 
-func (x *RelatedReleaseOfferSet) GetReleaseId() []*ReleaseId {
+func (x *RelatedReleaseOfferSet) GetReleaseDescription() *Description {
 	if x != nil {
-		return x.ReleaseId
+		return x.ReleaseDescription
 	}
 	return nil
 }
 
-func (x *RelatedReleaseOfferSet) GetReleaseDescription() *Description {
+func (x *RelatedReleaseOfferSet) GetDeal() []*Deal {
 	if x != nil {
-		return x.ReleaseDescription
+		return x.Deal
 	}
 	return nil
 }
 
 func (x *RelatedReleaseOfferSet) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3482,20 +3528,24 @@ type Release struct {
 	SalesReportingProxyReleaseId []*SalesReportingProxyReleaseId `protobuf:"bytes,4,rep,name=sales_reporting_proxy_release_id,json=salesReportingProxyReleaseId,proto3" json:"sales_reporting_proxy_release_id,omitempty" xml:"SalesReportingProxyReleaseId"`
 	// @gotags: xml:"ReferenceTitle"
 	ReferenceTitle *ReferenceTitle `protobuf:"bytes,5,opt,name=reference_title,json=referenceTitle,proto3" json:"reference_title,omitempty" xml:"ReferenceTitle"`
+	// @gotags: xml:"ReleaseResourceReferenceList"
+	ReleaseResourceReferenceList *ReleaseResourceReferenceList `protobuf:"bytes,19,opt,name=release_resource_reference_list,json=releaseResourceReferenceList,proto3" json:"release_resource_reference_list,omitempty" xml:"ReleaseResourceReferenceList"`
+	// @gotags: xml:"ResourceOmissionReason"
+	ResourceOmissionReason *ResourceOmissionReason `protobuf:"bytes,20,opt,name=resource_omission_reason,json=resourceOmissionReason,proto3" json:"resource_omission_reason,omitempty" xml:"ResourceOmissionReason"`
 	// @gotags: xml:"ReleaseCollectionReferenceList"
 	ReleaseCollectionReferenceList *ReleaseCollectionReferenceList `protobuf:"bytes,6,opt,name=release_collection_reference_list,json=releaseCollectionReferenceList,proto3" json:"release_collection_reference_list,omitempty" xml:"ReleaseCollectionReferenceList"`
 	// @gotags: xml:"ReleaseType"
 	ReleaseType []*ReleaseType `protobuf:"bytes,7,rep,name=release_type,json=releaseType,proto3" json:"release_type,omitempty" xml:"ReleaseType"`
 	// @gotags: xml:"ReleaseDetailsByTerritory"
 	ReleaseDetailsByTerritory []*ReleaseDetailsByTerritory `protobuf:"bytes,8,rep,name=release_details_by_territory,json=releaseDetailsByTerritory,proto3" json:"release_details_by_territory,omitempty" xml:"ReleaseDetailsByTerritory"`
-	// @gotags: xml:"LanguageOfPerformance"
-	LanguageOfPerformance []string `protobuf:"bytes,9,rep,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance"`
-	// @gotags: xml:"LanguageOfDubbing"
-	LanguageOfDubbing []string `protobuf:"bytes,10,rep,name=language_of_dubbing,json=languageOfDubbing,proto3" json:"language_of_dubbing,omitempty" xml:"LanguageOfDubbing"`
-	// @gotags: xml:"SubTitleLanguage"
-	SubTitleLanguage []string `protobuf:"bytes,11,rep,name=sub_title_language,json=subTitleLanguage,proto3" json:"sub_title_language,omitempty" xml:"SubTitleLanguage"`
+	// @gotags: xml:"LanguageOfPerformance" avs:"IsoLanguageCode"
+	LanguageOfPerformance []string `protobuf:"bytes,9,rep,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance" avs:"IsoLanguageCode"`
+	// @gotags: xml:"LanguageOfDubbing" avs:"IsoLanguageCode"
+	LanguageOfDubbing []string `protobuf:"bytes,10,rep,name=language_of_dubbing,json=languageOfDubbing,proto3" json:"language_of_dubbing,omitempty" xml:"LanguageOfDubbing" avs:"IsoLanguageCode"`
+	// @gotags: xml:"SubTitleLanguage" avs:"IsoLanguageCode"
+	SubTitleLanguage []string `protobuf:"bytes,11,rep,name=sub_title_language,json=subTitleLanguage,proto3" json:"sub_title_language,omitempty" xml:"SubTitleLanguage" avs:"IsoLanguageCode"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,12,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,12,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"RightsAgreementId"
 	RightsAgreementId *RightsAgreementId `protobuf:"bytes,13,opt,name=rights_agreement_id,json=rightsAgreementId,proto3" json:"rights_agreement_id,omitempty" xml:"RightsAgreementId"`
 	// @gotags: xml:"PLine"
@@ -3508,14 +3558,10 @@ type Release struct {
 	GlobalReleaseDate *EventDate `protobuf:"bytes,17,opt,name=global_release_date,json=globalReleaseDate,proto3" json:"global_release_date,omitempty" xml:"GlobalReleaseDate"`
 	// @gotags: xml:"GlobalOriginalReleaseDate"
 	GlobalOriginalReleaseDate *EventDate `protobuf:"bytes,18,opt,name=global_original_release_date,json=globalOriginalReleaseDate,proto3" json:"global_original_release_date,omitempty" xml:"GlobalOriginalReleaseDate"`
-	// @gotags: xml:"ReleaseResourceReferenceList"
-	ReleaseResourceReferenceList *ReleaseResourceReferenceList `protobuf:"bytes,19,opt,name=release_resource_reference_list,json=releaseResourceReferenceList,proto3" json:"release_resource_reference_list,omitempty" xml:"ReleaseResourceReferenceList"`
-	// @gotags: xml:"ResourceOmissionReason"
-	ResourceOmissionReason *ResourceOmissionReason `protobuf:"bytes,20,opt,name=resource_omission_reason,json=resourceOmissionReason,proto3" json:"resource_omission_reason,omitempty" xml:"ResourceOmissionReason"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,21,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,21,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsMainRelease,attr"
-	IsMainRelease bool `protobuf:"varint,22,opt,name=is_main_release,json=isMainRelease,proto3" json:"is_main_release,omitempty" xml:"IsMainRelease,attr"`
+	IsMainRelease *bool `protobuf:"varint,22,opt,name=is_main_release,json=isMainRelease,proto3,oneof" json:"is_main_release,omitempty" xml:"IsMainRelease,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3585,6 +3631,20 @@ func (x *Release) GetReferenceTitle() *ReferenceTitle {
 	return nil
 }
 
+func (x *Release) GetReleaseResourceReferenceList() *ReleaseResourceReferenceList {
+	if x != nil {
+		return x.ReleaseResourceReferenceList
+	}
+	return nil
+}
+
+func (x *Release) GetResourceOmissionReason() *ResourceOmissionReason {
+	if x != nil {
+		return x.ResourceOmissionReason
+	}
+	return nil
+}
+
 func (x *Release) GetReleaseCollectionReferenceList() *ReleaseCollectionReferenceList {
 	if x != nil {
 		return x.ReleaseCollectionReferenceList
@@ -3628,8 +3688,8 @@ func (x *Release) GetSubTitleLanguage() []string {
 }
 
 func (x *Release) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
@@ -3676,30 +3736,16 @@ func (x *Release) GetGlobalOriginalReleaseDate() *EventDate {
 	return nil
 }
 
-func (x *Release) GetReleaseResourceReferenceList() *ReleaseResourceReferenceList {
-	if x != nil {
-		return x.ReleaseResourceReferenceList
-	}
-	return nil
-}
-
-func (x *Release) GetResourceOmissionReason() *ResourceOmissionReason {
-	if x != nil {
-		return x.ResourceOmissionReason
-	}
-	return nil
-}
-
 func (x *Release) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Release) GetIsMainRelease() bool {
-	if x != nil {
-		return x.IsMainRelease
+	if x != nil && x.IsMainRelease != nil {
+		return *x.IsMainRelease
 	}
 	return false
 }
@@ -3711,9 +3757,9 @@ type ReleaseDeal struct {
 	// @gotags: xml:"Deal"
 	Deal []*Deal `protobuf:"bytes,2,rep,name=deal,proto3" json:"deal,omitempty" xml:"Deal"`
 	// @gotags: xml:"EffectiveDate"
-	EffectiveDate string `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty" xml:"EffectiveDate"`
+	EffectiveDate *string `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3,oneof" json:"effective_date,omitempty" xml:"EffectiveDate"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3763,21 +3809,25 @@ func (x *ReleaseDeal) GetDeal() []*Deal {
 }
 
 func (x *ReleaseDeal) GetEffectiveDate() string {
-	if x != nil {
-		return x.EffectiveDate
+	if x != nil && x.EffectiveDate != nil {
+		return *x.EffectiveDate
 	}
 	return ""
 }
 
 func (x *ReleaseDeal) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type ReleaseDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,25,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,26,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"DisplayArtistName"
 	DisplayArtistName []*Name `protobuf:"bytes,1,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty" xml:"DisplayArtistName"`
 	// @gotags: xml:"LabelName"
@@ -3789,7 +3839,7 @@ type ReleaseDetailsByTerritory struct {
 	// @gotags: xml:"DisplayArtist"
 	DisplayArtist []*Artist `protobuf:"bytes,5,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty" xml:"DisplayArtist"`
 	// @gotags: xml:"IsMultiArtistCompilation"
-	IsMultiArtistCompilation bool `protobuf:"varint,6,opt,name=is_multi_artist_compilation,json=isMultiArtistCompilation,proto3" json:"is_multi_artist_compilation,omitempty" xml:"IsMultiArtistCompilation"`
+	IsMultiArtistCompilation *bool `protobuf:"varint,6,opt,name=is_multi_artist_compilation,json=isMultiArtistCompilation,proto3,oneof" json:"is_multi_artist_compilation,omitempty" xml:"IsMultiArtistCompilation"`
 	// @gotags: xml:"AdministratingRecordCompany"
 	AdministratingRecordCompany []*AdministratingRecordCompany `protobuf:"bytes,7,rep,name=administrating_record_company,json=administratingRecordCompany,proto3" json:"administrating_record_company,omitempty" xml:"AdministratingRecordCompany"`
 	// @gotags: xml:"ReleaseType"
@@ -3816,6 +3866,10 @@ type ReleaseDetailsByTerritory struct {
 	OriginalReleaseDate *EventDate `protobuf:"bytes,18,opt,name=original_release_date,json=originalReleaseDate,proto3" json:"original_release_date,omitempty" xml:"OriginalReleaseDate"`
 	// @gotags: xml:"OriginalDigitalReleaseDate"
 	OriginalDigitalReleaseDate *EventDate `protobuf:"bytes,19,opt,name=original_digital_release_date,json=originalDigitalReleaseDate,proto3" json:"original_digital_release_date,omitempty" xml:"OriginalDigitalReleaseDate"`
+	// @gotags: xml:"FileAvailabilityDescription"
+	FileAvailabilityDescription []*Description `protobuf:"bytes,27,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
+	// @gotags: xml:"File"
+	File []*File `protobuf:"bytes,28,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"Keywords"
 	Keywords []*Keywords `protobuf:"bytes,20,rep,name=keywords,proto3" json:"keywords,omitempty" xml:"Keywords"`
 	// @gotags: xml:"Synopsis"
@@ -3823,19 +3877,11 @@ type ReleaseDetailsByTerritory struct {
 	// @gotags: xml:"Character"
 	Character []*Character `protobuf:"bytes,22,rep,name=character,proto3" json:"character,omitempty" xml:"Character"`
 	// @gotags: xml:"NumberOfUnitsPerPhysicalRelease"
-	NumberOfUnitsPerPhysicalRelease int32 `protobuf:"varint,23,opt,name=number_of_units_per_physical_release,json=numberOfUnitsPerPhysicalRelease,proto3" json:"number_of_units_per_physical_release,omitempty" xml:"NumberOfUnitsPerPhysicalRelease"`
+	NumberOfUnitsPerPhysicalRelease *int32 `protobuf:"varint,23,opt,name=number_of_units_per_physical_release,json=numberOfUnitsPerPhysicalRelease,proto3,oneof" json:"number_of_units_per_physical_release,omitempty" xml:"NumberOfUnitsPerPhysicalRelease"`
 	// @gotags: xml:"DisplayConductor"
 	DisplayConductor []*Artist `protobuf:"bytes,24,rep,name=display_conductor,json=displayConductor,proto3" json:"display_conductor,omitempty" xml:"DisplayConductor"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,25,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,26,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	// @gotags: xml:"FileAvailabilityDescription"
-	FileAvailabilityDescription []*Description `protobuf:"bytes,27,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
-	// @gotags: xml:"File"
-	File []*File `protobuf:"bytes,28,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,29,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,29,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3870,6 +3916,20 @@ func (*ReleaseDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{31}
 }
 
+func (x *ReleaseDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *ReleaseDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *ReleaseDetailsByTerritory) GetDisplayArtistName() []*Name {
 	if x != nil {
 		return x.DisplayArtistName
@@ -3906,8 +3966,8 @@ func (x *ReleaseDetailsByTerritory) GetDisplayArtist() []*Artist {
 }
 
 func (x *ReleaseDetailsByTerritory) GetIsMultiArtistCompilation() bool {
-	if x != nil {
-		return x.IsMultiArtistCompilation
+	if x != nil && x.IsMultiArtistCompilation != nil {
+		return *x.IsMultiArtistCompilation
 	}
 	return false
 }
@@ -4003,72 +4063,58 @@ func (x *ReleaseDetailsByTerritory) GetOriginalDigitalReleaseDate() *EventDate {
 	return nil
 }
 
-func (x *ReleaseDetailsByTerritory) GetKeywords() []*Keywords {
-	if x != nil {
-		return x.Keywords
-	}
-	return nil
-}
-
-func (x *ReleaseDetailsByTerritory) GetSynopsis() *Synopsis {
+func (x *ReleaseDetailsByTerritory) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Synopsis
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *ReleaseDetailsByTerritory) GetCharacter() []*Character {
+func (x *ReleaseDetailsByTerritory) GetFile() []*File {
 	if x != nil {
-		return x.Character
+		return x.File
 	}
 	return nil
 }
 
-func (x *ReleaseDetailsByTerritory) GetNumberOfUnitsPerPhysicalRelease() int32 {
-	if x != nil {
-		return x.NumberOfUnitsPerPhysicalRelease
-	}
-	return 0
-}
-
-func (x *ReleaseDetailsByTerritory) GetDisplayConductor() []*Artist {
+func (x *ReleaseDetailsByTerritory) GetKeywords() []*Keywords {
 	if x != nil {
-		return x.DisplayConductor
+		return x.Keywords
 	}
 	return nil
 }
 
-func (x *ReleaseDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *ReleaseDetailsByTerritory) GetSynopsis() *Synopsis {
 	if x != nil {
-		return x.TerritoryCode
+		return x.Synopsis
 	}
 	return nil
 }
 
-func (x *ReleaseDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+func (x *ReleaseDetailsByTerritory) GetCharacter() []*Character {
 	if x != nil {
-		return x.ExcludedTerritoryCode
+		return x.Character
 	}
 	return nil
 }
 
-func (x *ReleaseDetailsByTerritory) GetFileAvailabilityDescription() []*Description {
-	if x != nil {
-		return x.FileAvailabilityDescription
+func (x *ReleaseDetailsByTerritory) GetNumberOfUnitsPerPhysicalRelease() int32 {
+	if x != nil && x.NumberOfUnitsPerPhysicalRelease != nil {
+		return *x.NumberOfUnitsPerPhysicalRelease
 	}
-	return nil
+	return 0
 }
 
-func (x *ReleaseDetailsByTerritory) GetFile() []*File {
+func (x *ReleaseDetailsByTerritory) GetDisplayConductor() []*Artist {
 	if x != nil {
-		return x.File
+		return x.DisplayConductor
 	}
 	return nil
 }
 
 func (x *ReleaseDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -4078,7 +4124,7 @@ type ReleaseList struct {
 	// @gotags: xml:"Release"
 	Release []*Release `protobuf:"bytes,1,rep,name=release,proto3" json:"release,omitempty" xml:"Release"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -4121,8 +4167,8 @@ func (x *ReleaseList) GetRelease() []*Release {
 }
 
 func (x *ReleaseList) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -4132,7 +4178,7 @@ type ResourceGroup struct {
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"DisplayArtist"
 	DisplayArtist []*Artist `protobuf:"bytes,3,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty" xml:"DisplayArtist"`
 	// @gotags: xml:"DisplayConductor"
@@ -4156,7 +4202,7 @@ type ResourceGroup struct {
 	// @gotags: xml:"ReleaseId"
 	ReleaseId *ReleaseId `protobuf:"bytes,13,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -4199,8 +4245,8 @@ func (x *ResourceGroup) GetTitle() []*Title {
 }
 
 func (x *ResourceGroup) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -4283,8 +4329,8 @@ func (x *ResourceGroup) GetReleaseId() *ReleaseId {
 }
 
 func (x *ResourceGroup) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -4308,7 +4354,7 @@ type ResourceList struct {
 	// @gotags: xml:"UserDefinedResource"
 	UserDefinedResource []*UserDefinedResource `protobuf:"bytes,8,rep,name=user_defined_resource,json=userDefinedResource,proto3" json:"user_defined_resource,omitempty" xml:"UserDefinedResource"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -4400,8 +4446,8 @@ func (x *ResourceList) GetUserDefinedResource() []*UserDefinedResource {
 }
 
 func (x *ResourceList) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -4465,15 +4511,15 @@ type SheetMusic struct {
 	// @gotags: xml:"SheetMusicType"
 	SheetMusicType *SheetMusicType `protobuf:"bytes,1,opt,name=sheet_music_type,json=sheetMusicType,proto3" json:"sheet_music_type,omitempty" xml:"SheetMusicType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"SheetMusicId"
 	SheetMusicId []*SheetMusicId `protobuf:"bytes,3,rep,name=sheet_music_id,json=sheetMusicId,proto3" json:"sheet_music_id,omitempty" xml:"SheetMusicId"`
 	// @gotags: xml:"IndirectSheetMusicId"
 	IndirectSheetMusicId []*MusicalWorkId `protobuf:"bytes,4,rep,name=indirect_sheet_music_id,json=indirectSheetMusicId,proto3" json:"indirect_sheet_music_id,omitempty" xml:"IndirectSheetMusicId"`
 	// @gotags: xml:"ResourceReference"
 	ResourceReference string `protobuf:"bytes,5,opt,name=resource_reference,json=resourceReference,proto3" json:"resource_reference,omitempty" xml:"ResourceReference"`
-	// @gotags: xml:"LanguageOfLyrics"
-	LanguageOfLyrics string `protobuf:"bytes,6,opt,name=language_of_lyrics,json=languageOfLyrics,proto3" json:"language_of_lyrics,omitempty" xml:"LanguageOfLyrics"`
+	// @gotags: xml:"LanguageOfLyrics" avs:"IsoLanguageCode"
+	LanguageOfLyrics *string `protobuf:"bytes,6,opt,name=language_of_lyrics,json=languageOfLyrics,proto3,oneof" json:"language_of_lyrics,omitempty" xml:"LanguageOfLyrics" avs:"IsoLanguageCode"`
 	// @gotags: xml:"RightsAgreementId"
 	RightsAgreementId *RightsAgreementId `protobuf:"bytes,7,opt,name=rights_agreement_id,json=rightsAgreementId,proto3" json:"rights_agreement_id,omitempty" xml:"RightsAgreementId"`
 	// @gotags: xml:"ResourceMusicalWorkReferenceList"
@@ -4487,9 +4533,9 @@ type SheetMusic struct {
 	// @gotags: xml:"SheetMusicDetailsByTerritory"
 	SheetMusicDetailsByTerritory []*SheetMusicDetailsByTerritory `protobuf:"bytes,12,rep,name=sheet_music_details_by_territory,json=sheetMusicDetailsByTerritory,proto3" json:"sheet_music_details_by_territory,omitempty" xml:"SheetMusicDetailsByTerritory"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,13,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,13,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -4532,8 +4578,8 @@ func (x *SheetMusic) GetSheetMusicType() *SheetMusicType {
 }
 
 func (x *SheetMusic) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -4560,8 +4606,8 @@ func (x *SheetMusic) GetResourceReference() string {
 }
 
 func (x *SheetMusic) GetLanguageOfLyrics() string {
-	if x != nil {
-		return x.LanguageOfLyrics
+	if x != nil && x.LanguageOfLyrics != nil {
+		return *x.LanguageOfLyrics
 	}
 	return ""
 }
@@ -4609,21 +4655,25 @@ func (x *SheetMusic) GetSheetMusicDetailsByTerritory() []*SheetMusicDetailsByTer
 }
 
 func (x *SheetMusic) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *SheetMusic) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type SheetMusicDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,13,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,14,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"ResourceContributor"
@@ -4648,12 +4698,8 @@ type SheetMusicDetailsByTerritory struct {
 	ParentalWarningType []*ParentalWarningType `protobuf:"bytes,11,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty" xml:"ParentalWarningType"`
 	// @gotags: xml:"TechnicalSheetMusicDetails"
 	TechnicalSheetMusicDetails []*TechnicalSheetMusicDetails `protobuf:"bytes,12,rep,name=technical_sheet_music_details,json=technicalSheetMusicDetails,proto3" json:"technical_sheet_music_details,omitempty" xml:"TechnicalSheetMusicDetails"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,13,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,14,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,15,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,15,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -4688,6 +4734,20 @@ func (*SheetMusicDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{37}
 }
 
+func (x *SheetMusicDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *SheetMusicDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *SheetMusicDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
 		return x.Title
@@ -4772,23 +4832,9 @@ func (x *SheetMusicDetailsByTerritory) GetTechnicalSheetMusicDetails() []*Techni
 	return nil
 }
 
-func (x *SheetMusicDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *SheetMusicDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *SheetMusicDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -4798,7 +4844,7 @@ type Software struct {
 	// @gotags: xml:"SoftwareType"
 	SoftwareType *SoftwareType `protobuf:"bytes,1,opt,name=software_type,json=softwareType,proto3" json:"software_type,omitempty" xml:"SoftwareType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"SoftwareId"
 	SoftwareId []*ResourceProprietaryId `protobuf:"bytes,3,rep,name=software_id,json=softwareId,proto3" json:"software_id,omitempty" xml:"SoftwareId"`
 	// @gotags: xml:"IndirectSoftwareId"
@@ -4816,9 +4862,9 @@ type Software struct {
 	// @gotags: xml:"SoftwareDetailsByTerritory"
 	SoftwareDetailsByTerritory []*SoftwareDetailsByTerritory `protobuf:"bytes,10,rep,name=software_details_by_territory,json=softwareDetailsByTerritory,proto3" json:"software_details_by_territory,omitempty" xml:"SoftwareDetailsByTerritory"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,11,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,11,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -4861,8 +4907,8 @@ func (x *Software) GetSoftwareType() *SoftwareType {
 }
 
 func (x *Software) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -4924,21 +4970,25 @@ func (x *Software) GetSoftwareDetailsByTerritory() []*SoftwareDetailsByTerritory
 }
 
 func (x *Software) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *Software) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type SoftwareDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"ResourceContributor"
@@ -4969,12 +5019,8 @@ type SoftwareDetailsByTerritory struct {
 	ParentalWarningType []*ParentalWarningType `protobuf:"bytes,14,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty" xml:"ParentalWarningType"`
 	// @gotags: xml:"TechnicalSoftwareDetails"
 	TechnicalSoftwareDetails []*TechnicalSoftwareDetails `protobuf:"bytes,15,rep,name=technical_software_details,json=technicalSoftwareDetails,proto3" json:"technical_software_details,omitempty" xml:"TechnicalSoftwareDetails"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,18,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,18,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5009,6 +5055,20 @@ func (*SoftwareDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{39}
 }
 
+func (x *SoftwareDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *SoftwareDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *SoftwareDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
 		return x.Title
@@ -5114,23 +5174,9 @@ func (x *SoftwareDetailsByTerritory) GetTechnicalSoftwareDetails() []*TechnicalS
 	return nil
 }
 
-func (x *SoftwareDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *SoftwareDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *SoftwareDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -5140,7 +5186,7 @@ type SoundRecording struct {
 	// @gotags: xml:"SoundRecordingType"
 	SoundRecordingType *SoundRecordingType `protobuf:"bytes,1,opt,name=sound_recording_type,json=soundRecordingType,proto3" json:"sound_recording_type,omitempty" xml:"SoundRecordingType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"SoundRecordingId"
 	SoundRecordingId []*SoundRecordingId `protobuf:"bytes,3,rep,name=sound_recording_id,json=soundRecordingId,proto3" json:"sound_recording_id,omitempty" xml:"SoundRecordingId"`
 	// @gotags: xml:"IndirectSoundRecordingId"
@@ -5152,31 +5198,31 @@ type SoundRecording struct {
 	// @gotags: xml:"InstrumentationDescription"
 	InstrumentationDescription *Description `protobuf:"bytes,7,opt,name=instrumentation_description,json=instrumentationDescription,proto3" json:"instrumentation_description,omitempty" xml:"InstrumentationDescription"`
 	// @gotags: xml:"IsMedley"
-	IsMedley bool `protobuf:"varint,8,opt,name=is_medley,json=isMedley,proto3" json:"is_medley,omitempty" xml:"IsMedley"`
+	IsMedley *bool `protobuf:"varint,8,opt,name=is_medley,json=isMedley,proto3,oneof" json:"is_medley,omitempty" xml:"IsMedley"`
 	// @gotags: xml:"IsPotpourri"
-	IsPotpourri bool `protobuf:"varint,9,opt,name=is_potpourri,json=isPotpourri,proto3" json:"is_potpourri,omitempty" xml:"IsPotpourri"`
+	IsPotpourri *bool `protobuf:"varint,9,opt,name=is_potpourri,json=isPotpourri,proto3,oneof" json:"is_potpourri,omitempty" xml:"IsPotpourri"`
 	// @gotags: xml:"IsInstrumental"
-	IsInstrumental bool `protobuf:"varint,10,opt,name=is_instrumental,json=isInstrumental,proto3" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
+	IsInstrumental *bool `protobuf:"varint,10,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
 	// @gotags: xml:"IsBackground"
-	IsBackground bool `protobuf:"varint,11,opt,name=is_background,json=isBackground,proto3" json:"is_background,omitempty" xml:"IsBackground"`
+	IsBackground *bool `protobuf:"varint,11,opt,name=is_background,json=isBackground,proto3,oneof" json:"is_background,omitempty" xml:"IsBackground"`
 	// @gotags: xml:"IsHiddenResource"
-	IsHiddenResource bool `protobuf:"varint,12,opt,name=is_hidden_resource,json=isHiddenResource,proto3" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
+	IsHiddenResource *bool `protobuf:"varint,12,opt,name=is_hidden_resource,json=isHiddenResource,proto3,oneof" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
 	// @gotags: xml:"IsBonusResource"
-	IsBonusResource bool `protobuf:"varint,13,opt,name=is_bonus_resource,json=isBonusResource,proto3" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
+	IsBonusResource *bool `protobuf:"varint,13,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
 	// @gotags: xml:"HasPreOrderFulfillment"
-	HasPreOrderFulfillment bool `protobuf:"varint,14,opt,name=has_pre_order_fulfillment,json=hasPreOrderFulfillment,proto3" json:"has_pre_order_fulfillment,omitempty" xml:"HasPreOrderFulfillment"`
+	HasPreOrderFulfillment *bool `protobuf:"varint,14,opt,name=has_pre_order_fulfillment,json=hasPreOrderFulfillment,proto3,oneof" json:"has_pre_order_fulfillment,omitempty" xml:"HasPreOrderFulfillment"`
 	// @gotags: xml:"IsComputerGenerated"
-	IsComputerGenerated bool `protobuf:"varint,15,opt,name=is_computer_generated,json=isComputerGenerated,proto3" json:"is_computer_generated,omitempty" xml:"IsComputerGenerated"`
+	IsComputerGenerated *bool `protobuf:"varint,15,opt,name=is_computer_generated,json=isComputerGenerated,proto3,oneof" json:"is_computer_generated,omitempty" xml:"IsComputerGenerated"`
 	// @gotags: xml:"IsRemastered"
-	IsRemastered bool `protobuf:"varint,16,opt,name=is_remastered,json=isRemastered,proto3" json:"is_remastered,omitempty" xml:"IsRemastered"`
+	IsRemastered *bool `protobuf:"varint,16,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty" xml:"IsRemastered"`
 	// @gotags: xml:"NoSilenceBefore"
-	NoSilenceBefore bool `protobuf:"varint,17,opt,name=no_silence_before,json=noSilenceBefore,proto3" json:"no_silence_before,omitempty" xml:"NoSilenceBefore"`
+	NoSilenceBefore *bool `protobuf:"varint,17,opt,name=no_silence_before,json=noSilenceBefore,proto3,oneof" json:"no_silence_before,omitempty" xml:"NoSilenceBefore"`
 	// @gotags: xml:"NoSilenceAfter"
-	NoSilenceAfter bool `protobuf:"varint,18,opt,name=no_silence_after,json=noSilenceAfter,proto3" json:"no_silence_after,omitempty" xml:"NoSilenceAfter"`
+	NoSilenceAfter *bool `protobuf:"varint,18,opt,name=no_silence_after,json=noSilenceAfter,proto3,oneof" json:"no_silence_after,omitempty" xml:"NoSilenceAfter"`
 	// @gotags: xml:"PerformerInformationRequired"
-	PerformerInformationRequired bool `protobuf:"varint,19,opt,name=performer_information_required,json=performerInformationRequired,proto3" json:"performer_information_required,omitempty" xml:"PerformerInformationRequired"`
-	// @gotags: xml:"LanguageOfPerformance"
-	LanguageOfPerformance string `protobuf:"bytes,20,opt,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance"`
+	PerformerInformationRequired *bool `protobuf:"varint,19,opt,name=performer_information_required,json=performerInformationRequired,proto3,oneof" json:"performer_information_required,omitempty" xml:"PerformerInformationRequired"`
+	// @gotags: xml:"LanguageOfPerformance" avs:"IsoLanguageCode"
+	LanguageOfPerformance *string `protobuf:"bytes,20,opt,name=language_of_performance,json=languageOfPerformance,proto3,oneof" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance" avs:"IsoLanguageCode"`
 	// @gotags: xml:"Duration"
 	Duration string `protobuf:"bytes,21,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"RightsAgreementId"
@@ -5198,17 +5244,17 @@ type SoundRecording struct {
 	// @gotags: xml:"TerritoryOfCommissioning"
 	TerritoryOfCommissioning *AllTerritoryCode `protobuf:"bytes,30,opt,name=territory_of_commissioning,json=territoryOfCommissioning,proto3" json:"territory_of_commissioning,omitempty" xml:"TerritoryOfCommissioning"`
 	// @gotags: xml:"NumberOfFeaturedArtists"
-	NumberOfFeaturedArtists int32 `protobuf:"varint,31,opt,name=number_of_featured_artists,json=numberOfFeaturedArtists,proto3" json:"number_of_featured_artists,omitempty" xml:"NumberOfFeaturedArtists"`
+	NumberOfFeaturedArtists *int32 `protobuf:"varint,31,opt,name=number_of_featured_artists,json=numberOfFeaturedArtists,proto3,oneof" json:"number_of_featured_artists,omitempty" xml:"NumberOfFeaturedArtists"`
 	// @gotags: xml:"NumberOfNonFeaturedArtists"
-	NumberOfNonFeaturedArtists int32 `protobuf:"varint,32,opt,name=number_of_non_featured_artists,json=numberOfNonFeaturedArtists,proto3" json:"number_of_non_featured_artists,omitempty" xml:"NumberOfNonFeaturedArtists"`
+	NumberOfNonFeaturedArtists *int32 `protobuf:"varint,32,opt,name=number_of_non_featured_artists,json=numberOfNonFeaturedArtists,proto3,oneof" json:"number_of_non_featured_artists,omitempty" xml:"NumberOfNonFeaturedArtists"`
 	// @gotags: xml:"NumberOfContractedArtists"
-	NumberOfContractedArtists int32 `protobuf:"varint,33,opt,name=number_of_contracted_artists,json=numberOfContractedArtists,proto3" json:"number_of_contracted_artists,omitempty" xml:"NumberOfContractedArtists"`
+	NumberOfContractedArtists *int32 `protobuf:"varint,33,opt,name=number_of_contracted_artists,json=numberOfContractedArtists,proto3,oneof" json:"number_of_contracted_artists,omitempty" xml:"NumberOfContractedArtists"`
 	// @gotags: xml:"NumberOfNonContractedArtists"
-	NumberOfNonContractedArtists int32 `protobuf:"varint,34,opt,name=number_of_non_contracted_artists,json=numberOfNonContractedArtists,proto3" json:"number_of_non_contracted_artists,omitempty" xml:"NumberOfNonContractedArtists"`
+	NumberOfNonContractedArtists *int32 `protobuf:"varint,34,opt,name=number_of_non_contracted_artists,json=numberOfNonContractedArtists,proto3,oneof" json:"number_of_non_contracted_artists,omitempty" xml:"NumberOfNonContractedArtists"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,35,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,35,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,36,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,36,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5251,8 +5297,8 @@ func (x *SoundRecording) GetSoundRecordingType() *SoundRecordingType {
 }
 
 func (x *SoundRecording) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -5293,92 +5339,92 @@ func (x *SoundRecording) GetInstrumentationDescription() *Description {
 }
 
 func (x *SoundRecording) GetIsMedley() bool {
-	if x != nil {
-		return x.IsMedley
+	if x != nil && x.IsMedley != nil {
+		return *x.IsMedley
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsPotpourri() bool {
-	if x != nil {
-		return x.IsPotpourri
+	if x != nil && x.IsPotpourri != nil {
+		return *x.IsPotpourri
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsInstrumental() bool {
-	if x != nil {
-		return x.IsInstrumental
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsBackground() bool {
-	if x != nil {
-		return x.IsBackground
+	if x != nil && x.IsBackground != nil {
+		return *x.IsBackground
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsHiddenResource() bool {
-	if x != nil {
-		return x.IsHiddenResource
+	if x != nil && x.IsHiddenResource != nil {
+		return *x.IsHiddenResource
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsBonusResource() bool {
-	if x != nil {
-		return x.IsBonusResource
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
 	}
 	return false
 }
 
 func (x *SoundRecording) GetHasPreOrderFulfillment() bool {
-	if x != nil {
-		return x.HasPreOrderFulfillment
+	if x != nil && x.HasPreOrderFulfillment != nil {
+		return *x.HasPreOrderFulfillment
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsComputerGenerated() bool {
-	if x != nil {
-		return x.IsComputerGenerated
+	if x != nil && x.IsComputerGenerated != nil {
+		return *x.IsComputerGenerated
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsRemastered() bool {
-	if x != nil {
-		return x.IsRemastered
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
 	}
 	return false
 }
 
 func (x *SoundRecording) GetNoSilenceBefore() bool {
-	if x != nil {
-		return x.NoSilenceBefore
+	if x != nil && x.NoSilenceBefore != nil {
+		return *x.NoSilenceBefore
 	}
 	return false
 }
 
 func (x *SoundRecording) GetNoSilenceAfter() bool {
-	if x != nil {
-		return x.NoSilenceAfter
+	if x != nil && x.NoSilenceAfter != nil {
+		return *x.NoSilenceAfter
 	}
 	return false
 }
 
 func (x *SoundRecording) GetPerformerInformationRequired() bool {
-	if x != nil {
-		return x.PerformerInformationRequired
+	if x != nil && x.PerformerInformationRequired != nil {
+		return *x.PerformerInformationRequired
 	}
 	return false
 }
 
 func (x *SoundRecording) GetLanguageOfPerformance() string {
-	if x != nil {
-		return x.LanguageOfPerformance
+	if x != nil && x.LanguageOfPerformance != nil {
+		return *x.LanguageOfPerformance
 	}
 	return ""
 }
@@ -5454,49 +5500,53 @@ func (x *SoundRecording) GetTerritoryOfCommissioning() *AllTerritoryCode {
 }
 
 func (x *SoundRecording) GetNumberOfFeaturedArtists() int32 {
-	if x != nil {
-		return x.NumberOfFeaturedArtists
+	if x != nil && x.NumberOfFeaturedArtists != nil {
+		return *x.NumberOfFeaturedArtists
 	}
 	return 0
 }
 
 func (x *SoundRecording) GetNumberOfNonFeaturedArtists() int32 {
-	if x != nil {
-		return x.NumberOfNonFeaturedArtists
+	if x != nil && x.NumberOfNonFeaturedArtists != nil {
+		return *x.NumberOfNonFeaturedArtists
 	}
 	return 0
 }
 
 func (x *SoundRecording) GetNumberOfContractedArtists() int32 {
-	if x != nil {
-		return x.NumberOfContractedArtists
+	if x != nil && x.NumberOfContractedArtists != nil {
+		return *x.NumberOfContractedArtists
 	}
 	return 0
 }
 
 func (x *SoundRecording) GetNumberOfNonContractedArtists() int32 {
-	if x != nil {
-		return x.NumberOfNonContractedArtists
+	if x != nil && x.NumberOfNonContractedArtists != nil {
+		return *x.NumberOfNonContractedArtists
 	}
 	return 0
 }
 
 func (x *SoundRecording) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *SoundRecording) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type SoundRecordingDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,25,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,26,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"DisplayArtist"
@@ -5526,7 +5576,7 @@ type SoundRecordingDetailsByTerritory struct {
 	// @gotags: xml:"CourtesyLine"
 	CourtesyLine *CourtesyLine `protobuf:"bytes,14,opt,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty" xml:"CourtesyLine"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,15,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,15,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"HostSoundCarrier"
 	HostSoundCarrier []*HostSoundCarrier `protobuf:"bytes,16,rep,name=host_sound_carrier,json=hostSoundCarrier,proto3" json:"host_sound_carrier,omitempty" xml:"HostSoundCarrier"`
 	// @gotags: xml:"MarketingComment"
@@ -5545,12 +5595,8 @@ type SoundRecordingDetailsByTerritory struct {
 	Keywords []*Keywords `protobuf:"bytes,23,rep,name=keywords,proto3" json:"keywords,omitempty" xml:"Keywords"`
 	// @gotags: xml:"Synopsis"
 	Synopsis *Synopsis `protobuf:"bytes,24,opt,name=synopsis,proto3" json:"synopsis,omitempty" xml:"Synopsis"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,25,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,26,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,27,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,27,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5585,28 +5631,42 @@ func (*SoundRecordingDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SoundRecordingDetailsByTerritory) GetTitle() []*Title {
+func (x *SoundRecordingDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.Title
+		return x.TerritoryCode
 	}
 	return nil
 }
 
-func (x *SoundRecordingDetailsByTerritory) GetDisplayArtist() []*Artist {
+func (x *SoundRecordingDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.DisplayArtist
+		return x.ExcludedTerritoryCode
 	}
 	return nil
 }
 
-func (x *SoundRecordingDetailsByTerritory) GetDisplayConductor() []*Artist {
+func (x *SoundRecordingDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
-		return x.DisplayConductor
+		return x.Title
 	}
 	return nil
 }
 
-func (x *SoundRecordingDetailsByTerritory) GetResourceContributor() []*DetailedResourceContributor {
+func (x *SoundRecordingDetailsByTerritory) GetDisplayArtist() []*Artist {
+	if x != nil {
+		return x.DisplayArtist
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetDisplayConductor() []*Artist {
+	if x != nil {
+		return x.DisplayConductor
+	}
+	return nil
+}
+
+func (x *SoundRecordingDetailsByTerritory) GetResourceContributor() []*DetailedResourceContributor {
 	if x != nil {
 		return x.ResourceContributor
 	}
@@ -5684,8 +5744,8 @@ func (x *SoundRecordingDetailsByTerritory) GetCourtesyLine() *CourtesyLine {
 }
 
 func (x *SoundRecordingDetailsByTerritory) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -5753,23 +5813,9 @@ func (x *SoundRecordingDetailsByTerritory) GetSynopsis() *Synopsis {
 	return nil
 }
 
-func (x *SoundRecordingDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *SoundRecordingDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *SoundRecordingDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -5779,17 +5825,17 @@ type SoundRecordingPreviewDetails struct {
 	// @gotags: xml:"PartType"
 	PartType *Description `protobuf:"bytes,1,opt,name=part_type,json=partType,proto3" json:"part_type,omitempty" xml:"PartType"`
 	// @gotags: xml:"StartPoint"
-	StartPoint string `protobuf:"bytes,2,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
+	StartPoint *string `protobuf:"bytes,2,opt,name=start_point,json=startPoint,proto3,oneof" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"EndPoint"
-	EndPoint string `protobuf:"bytes,3,opt,name=end_point,json=endPoint,proto3" json:"end_point,omitempty" xml:"EndPoint"`
+	EndPoint *string `protobuf:"bytes,3,opt,name=end_point,json=endPoint,proto3,oneof" json:"end_point,omitempty" xml:"EndPoint"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,4,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"TopLeftCorner"
-	TopLeftCorner string `protobuf:"bytes,5,opt,name=top_left_corner,json=topLeftCorner,proto3" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
+	TopLeftCorner *string `protobuf:"bytes,5,opt,name=top_left_corner,json=topLeftCorner,proto3,oneof" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
 	// @gotags: xml:"BottomRightCorner"
-	BottomRightCorner string `protobuf:"bytes,6,opt,name=bottom_right_corner,json=bottomRightCorner,proto3" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,7,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	BottomRightCorner *string `protobuf:"bytes,6,opt,name=bottom_right_corner,json=bottomRightCorner,proto3,oneof" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,7,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -5832,36 +5878,36 @@ func (x *SoundRecordingPreviewDetails) GetPartType() *Description {
 }
 
 func (x *SoundRecordingPreviewDetails) GetStartPoint() string {
-	if x != nil {
-		return x.StartPoint
+	if x != nil && x.StartPoint != nil {
+		return *x.StartPoint
 	}
 	return ""
 }
 
 func (x *SoundRecordingPreviewDetails) GetEndPoint() string {
-	if x != nil {
-		return x.EndPoint
+	if x != nil && x.EndPoint != nil {
+		return *x.EndPoint
 	}
 	return ""
 }
 
 func (x *SoundRecordingPreviewDetails) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *SoundRecordingPreviewDetails) GetTopLeftCorner() string {
-	if x != nil {
-		return x.TopLeftCorner
+	if x != nil && x.TopLeftCorner != nil {
+		return *x.TopLeftCorner
 	}
 	return ""
 }
 
 func (x *SoundRecordingPreviewDetails) GetBottomRightCorner() string {
-	if x != nil {
-		return x.BottomRightCorner
+	if x != nil && x.BottomRightCorner != nil {
+		return *x.BottomRightCorner
 	}
 	return ""
 }
@@ -5890,25 +5936,25 @@ type TechnicalImageDetails struct {
 	// @gotags: xml:"AspectRatio"
 	AspectRatio *AspectRatio `protobuf:"bytes,7,opt,name=aspect_ratio,json=aspectRatio,proto3" json:"aspect_ratio,omitempty" xml:"AspectRatio"`
 	// @gotags: xml:"ColorDepth"
-	ColorDepth int32 `protobuf:"varint,8,opt,name=color_depth,json=colorDepth,proto3" json:"color_depth,omitempty" xml:"ColorDepth"`
+	ColorDepth *int32 `protobuf:"varint,8,opt,name=color_depth,json=colorDepth,proto3,oneof" json:"color_depth,omitempty" xml:"ColorDepth"`
 	// @gotags: xml:"ImageResolution"
-	ImageResolution int32 `protobuf:"varint,9,opt,name=image_resolution,json=imageResolution,proto3" json:"image_resolution,omitempty" xml:"ImageResolution"`
+	ImageResolution *int32 `protobuf:"varint,9,opt,name=image_resolution,json=imageResolution,proto3,oneof" json:"image_resolution,omitempty" xml:"ImageResolution"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,10,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,10,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *PreviewDetails `protobuf:"bytes,11,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,12,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,13,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
-	// @gotags: xml:"Fingerprint"
-	Fingerprint []*Fingerprint `protobuf:"bytes,14,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"FileAvailabilityDescription"
 	FileAvailabilityDescription []*Description `protobuf:"bytes,15,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
 	// @gotags: xml:"File"
 	File []*File `protobuf:"bytes,16,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
+	// @gotags: xml:"Fingerprint"
+	Fingerprint []*Fingerprint `protobuf:"bytes,14,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,17,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,17,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5993,22 +6039,22 @@ func (x *TechnicalImageDetails) GetAspectRatio() *AspectRatio {
 }
 
 func (x *TechnicalImageDetails) GetColorDepth() int32 {
-	if x != nil {
-		return x.ColorDepth
+	if x != nil && x.ColorDepth != nil {
+		return *x.ColorDepth
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetImageResolution() int32 {
-	if x != nil {
-		return x.ImageResolution
+	if x != nil && x.ImageResolution != nil {
+		return *x.ImageResolution
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -6034,30 +6080,30 @@ func (x *TechnicalImageDetails) GetConsumerFulfillmentDate() *FulfillmentDate {
 	return nil
 }
 
-func (x *TechnicalImageDetails) GetFingerprint() []*Fingerprint {
+func (x *TechnicalImageDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Fingerprint
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *TechnicalImageDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalImageDetails) GetFile() []*File {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalImageDetails) GetFile() []*File {
+func (x *TechnicalImageDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalImageDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -6067,31 +6113,31 @@ type TechnicalMidiDetails struct {
 	// @gotags: xml:"TechnicalResourceDetailsReference"
 	TechnicalResourceDetailsReference string `protobuf:"bytes,1,opt,name=technical_resource_details_reference,json=technicalResourceDetailsReference,proto3" json:"technical_resource_details_reference,omitempty" xml:"TechnicalResourceDetailsReference"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,2,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"ResourceProcessingRequired"
-	ResourceProcessingRequired bool `protobuf:"varint,3,opt,name=resource_processing_required,json=resourceProcessingRequired,proto3" json:"resource_processing_required,omitempty" xml:"ResourceProcessingRequired"`
+	ResourceProcessingRequired *bool `protobuf:"varint,3,opt,name=resource_processing_required,json=resourceProcessingRequired,proto3,oneof" json:"resource_processing_required,omitempty" xml:"ResourceProcessingRequired"`
 	// @gotags: xml:"UsableResourceDuration"
-	UsableResourceDuration string `protobuf:"bytes,4,opt,name=usable_resource_duration,json=usableResourceDuration,proto3" json:"usable_resource_duration,omitempty" xml:"UsableResourceDuration"`
+	UsableResourceDuration *string `protobuf:"bytes,4,opt,name=usable_resource_duration,json=usableResourceDuration,proto3,oneof" json:"usable_resource_duration,omitempty" xml:"UsableResourceDuration"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,5,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,5,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *SoundRecordingPreviewDetails `protobuf:"bytes,6,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,7,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,8,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
+	// @gotags: xml:"FileAvailabilityDescription"
+	FileAvailabilityDescription []*Description `protobuf:"bytes,12,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
+	// @gotags: xml:"File"
+	File []*File `protobuf:"bytes,13,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"NumberOfVoices"
-	NumberOfVoices int32 `protobuf:"varint,9,opt,name=number_of_voices,json=numberOfVoices,proto3" json:"number_of_voices,omitempty" xml:"NumberOfVoices"`
+	NumberOfVoices *int32 `protobuf:"varint,9,opt,name=number_of_voices,json=numberOfVoices,proto3,oneof" json:"number_of_voices,omitempty" xml:"NumberOfVoices"`
 	// @gotags: xml:"SoundProcessorType"
 	SoundProcessorType *SoundProcessorType `protobuf:"bytes,10,opt,name=sound_processor_type,json=soundProcessorType,proto3" json:"sound_processor_type,omitempty" xml:"SoundProcessorType"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,11,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
-	// @gotags: xml:"FileAvailabilityDescription"
-	FileAvailabilityDescription []*Description `protobuf:"bytes,12,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
-	// @gotags: xml:"File"
-	File []*File `protobuf:"bytes,13,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -6134,29 +6180,29 @@ func (x *TechnicalMidiDetails) GetTechnicalResourceDetailsReference() string {
 }
 
 func (x *TechnicalMidiDetails) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *TechnicalMidiDetails) GetResourceProcessingRequired() bool {
-	if x != nil {
-		return x.ResourceProcessingRequired
+	if x != nil && x.ResourceProcessingRequired != nil {
+		return *x.ResourceProcessingRequired
 	}
 	return false
 }
 
 func (x *TechnicalMidiDetails) GetUsableResourceDuration() string {
-	if x != nil {
-		return x.UsableResourceDuration
+	if x != nil && x.UsableResourceDuration != nil {
+		return *x.UsableResourceDuration
 	}
 	return ""
 }
 
 func (x *TechnicalMidiDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -6182,44 +6228,44 @@ func (x *TechnicalMidiDetails) GetConsumerFulfillmentDate() *FulfillmentDate {
 	return nil
 }
 
-func (x *TechnicalMidiDetails) GetNumberOfVoices() int32 {
+func (x *TechnicalMidiDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.NumberOfVoices
+		return x.FileAvailabilityDescription
 	}
-	return 0
+	return nil
 }
 
-func (x *TechnicalMidiDetails) GetSoundProcessorType() *SoundProcessorType {
+func (x *TechnicalMidiDetails) GetFile() []*File {
 	if x != nil {
-		return x.SoundProcessorType
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalMidiDetails) GetFingerprint() []*Fingerprint {
-	if x != nil {
-		return x.Fingerprint
+func (x *TechnicalMidiDetails) GetNumberOfVoices() int32 {
+	if x != nil && x.NumberOfVoices != nil {
+		return *x.NumberOfVoices
 	}
-	return nil
+	return 0
 }
 
-func (x *TechnicalMidiDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalMidiDetails) GetSoundProcessorType() *SoundProcessorType {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.SoundProcessorType
 	}
 	return nil
 }
 
-func (x *TechnicalMidiDetails) GetFile() []*File {
+func (x *TechnicalMidiDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalMidiDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -6235,21 +6281,21 @@ type TechnicalSheetMusicDetails struct {
 	// @gotags: xml:"SheetMusicCodecType"
 	SheetMusicCodecType *SheetMusicCodecType `protobuf:"bytes,4,opt,name=sheet_music_codec_type,json=sheetMusicCodecType,proto3" json:"sheet_music_codec_type,omitempty" xml:"SheetMusicCodecType"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,5,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,5,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *PreviewDetails `protobuf:"bytes,6,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,7,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,8,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
-	// @gotags: xml:"Fingerprint"
-	Fingerprint []*Fingerprint `protobuf:"bytes,9,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"FileAvailabilityDescription"
 	FileAvailabilityDescription []*Description `protobuf:"bytes,10,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
 	// @gotags: xml:"File"
 	File []*File `protobuf:"bytes,11,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
+	// @gotags: xml:"Fingerprint"
+	Fingerprint []*Fingerprint `protobuf:"bytes,9,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -6313,8 +6359,8 @@ func (x *TechnicalSheetMusicDetails) GetSheetMusicCodecType() *SheetMusicCodecTy
 }
 
 func (x *TechnicalSheetMusicDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -6340,30 +6386,30 @@ func (x *TechnicalSheetMusicDetails) GetConsumerFulfillmentDate() *FulfillmentDa
 	return nil
 }
 
-func (x *TechnicalSheetMusicDetails) GetFingerprint() []*Fingerprint {
+func (x *TechnicalSheetMusicDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Fingerprint
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *TechnicalSheetMusicDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalSheetMusicDetails) GetFile() []*File {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalSheetMusicDetails) GetFile() []*File {
+func (x *TechnicalSheetMusicDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalSheetMusicDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -6377,21 +6423,21 @@ type TechnicalSoftwareDetails struct {
 	// @gotags: xml:"OperatingSystemType"
 	OperatingSystemType *OperatingSystemType `protobuf:"bytes,3,opt,name=operating_system_type,json=operatingSystemType,proto3" json:"operating_system_type,omitempty" xml:"OperatingSystemType"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,4,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,4,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *PreviewDetails `protobuf:"bytes,5,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,6,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,7,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
-	// @gotags: xml:"Fingerprint"
-	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"FileAvailabilityDescription"
 	FileAvailabilityDescription []*Description `protobuf:"bytes,9,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
 	// @gotags: xml:"File"
 	File []*File `protobuf:"bytes,10,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
+	// @gotags: xml:"Fingerprint"
+	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,11,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,11,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -6448,8 +6494,8 @@ func (x *TechnicalSoftwareDetails) GetOperatingSystemType() *OperatingSystemType
 }
 
 func (x *TechnicalSoftwareDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -6475,30 +6521,30 @@ func (x *TechnicalSoftwareDetails) GetConsumerFulfillmentDate() *FulfillmentDate
 	return nil
 }
 
-func (x *TechnicalSoftwareDetails) GetFingerprint() []*Fingerprint {
+func (x *TechnicalSoftwareDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Fingerprint
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *TechnicalSoftwareDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalSoftwareDetails) GetFile() []*File {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalSoftwareDetails) GetFile() []*File {
+func (x *TechnicalSoftwareDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalSoftwareDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -6516,33 +6562,33 @@ type TechnicalSoundRecordingDetails struct {
 	// @gotags: xml:"BitRate"
 	BitRate *BitRate `protobuf:"bytes,5,opt,name=bit_rate,json=bitRate,proto3" json:"bit_rate,omitempty" xml:"BitRate"`
 	// @gotags: xml:"NumberOfChannels"
-	NumberOfChannels int32 `protobuf:"varint,6,opt,name=number_of_channels,json=numberOfChannels,proto3" json:"number_of_channels,omitempty" xml:"NumberOfChannels"`
+	NumberOfChannels *int32 `protobuf:"varint,6,opt,name=number_of_channels,json=numberOfChannels,proto3,oneof" json:"number_of_channels,omitempty" xml:"NumberOfChannels"`
 	// @gotags: xml:"SamplingRate"
 	SamplingRate *SamplingRate `protobuf:"bytes,7,opt,name=sampling_rate,json=samplingRate,proto3" json:"sampling_rate,omitempty" xml:"SamplingRate"`
 	// @gotags: xml:"BitsPerSample"
-	BitsPerSample int32 `protobuf:"varint,8,opt,name=bits_per_sample,json=bitsPerSample,proto3" json:"bits_per_sample,omitempty" xml:"BitsPerSample"`
+	BitsPerSample *int32 `protobuf:"varint,8,opt,name=bits_per_sample,json=bitsPerSample,proto3,oneof" json:"bits_per_sample,omitempty" xml:"BitsPerSample"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,9,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,9,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"ResourceProcessingRequired"
-	ResourceProcessingRequired bool `protobuf:"varint,10,opt,name=resource_processing_required,json=resourceProcessingRequired,proto3" json:"resource_processing_required,omitempty" xml:"ResourceProcessingRequired"`
+	ResourceProcessingRequired *bool `protobuf:"varint,10,opt,name=resource_processing_required,json=resourceProcessingRequired,proto3,oneof" json:"resource_processing_required,omitempty" xml:"ResourceProcessingRequired"`
 	// @gotags: xml:"UsableResourceDuration"
-	UsableResourceDuration string `protobuf:"bytes,11,opt,name=usable_resource_duration,json=usableResourceDuration,proto3" json:"usable_resource_duration,omitempty" xml:"UsableResourceDuration"`
+	UsableResourceDuration *string `protobuf:"bytes,11,opt,name=usable_resource_duration,json=usableResourceDuration,proto3,oneof" json:"usable_resource_duration,omitempty" xml:"UsableResourceDuration"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,12,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,12,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *SoundRecordingPreviewDetails `protobuf:"bytes,13,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,14,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,15,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
-	// @gotags: xml:"Fingerprint"
-	Fingerprint []*Fingerprint `protobuf:"bytes,16,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"FileAvailabilityDescription"
 	FileAvailabilityDescription []*Description `protobuf:"bytes,17,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
 	// @gotags: xml:"File"
 	File []*File `protobuf:"bytes,18,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
+	// @gotags: xml:"Fingerprint"
+	Fingerprint []*Fingerprint `protobuf:"bytes,16,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,19,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,19,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -6613,8 +6659,8 @@ func (x *TechnicalSoundRecordingDetails) GetBitRate() *BitRate {
 }
 
 func (x *TechnicalSoundRecordingDetails) GetNumberOfChannels() int32 {
-	if x != nil {
-		return x.NumberOfChannels
+	if x != nil && x.NumberOfChannels != nil {
+		return *x.NumberOfChannels
 	}
 	return 0
 }
@@ -6627,36 +6673,36 @@ func (x *TechnicalSoundRecordingDetails) GetSamplingRate() *SamplingRate {
 }
 
 func (x *TechnicalSoundRecordingDetails) GetBitsPerSample() int32 {
-	if x != nil {
-		return x.BitsPerSample
+	if x != nil && x.BitsPerSample != nil {
+		return *x.BitsPerSample
 	}
 	return 0
 }
 
 func (x *TechnicalSoundRecordingDetails) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *TechnicalSoundRecordingDetails) GetResourceProcessingRequired() bool {
-	if x != nil {
-		return x.ResourceProcessingRequired
+	if x != nil && x.ResourceProcessingRequired != nil {
+		return *x.ResourceProcessingRequired
 	}
 	return false
 }
 
 func (x *TechnicalSoundRecordingDetails) GetUsableResourceDuration() string {
-	if x != nil {
-		return x.UsableResourceDuration
+	if x != nil && x.UsableResourceDuration != nil {
+		return *x.UsableResourceDuration
 	}
 	return ""
 }
 
 func (x *TechnicalSoundRecordingDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -6682,30 +6728,30 @@ func (x *TechnicalSoundRecordingDetails) GetConsumerFulfillmentDate() *Fulfillme
 	return nil
 }
 
-func (x *TechnicalSoundRecordingDetails) GetFingerprint() []*Fingerprint {
+func (x *TechnicalSoundRecordingDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Fingerprint
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *TechnicalSoundRecordingDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalSoundRecordingDetails) GetFile() []*File {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalSoundRecordingDetails) GetFile() []*File {
+func (x *TechnicalSoundRecordingDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalSoundRecordingDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -6721,21 +6767,21 @@ type TechnicalTextDetails struct {
 	// @gotags: xml:"TextCodecType"
 	TextCodecType *TextCodecType `protobuf:"bytes,4,opt,name=text_codec_type,json=textCodecType,proto3" json:"text_codec_type,omitempty" xml:"TextCodecType"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,5,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,5,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *PreviewDetails `protobuf:"bytes,6,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,7,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,8,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
-	// @gotags: xml:"Fingerprint"
-	Fingerprint []*Fingerprint `protobuf:"bytes,9,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"FileAvailabilityDescription"
 	FileAvailabilityDescription []*Description `protobuf:"bytes,10,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
 	// @gotags: xml:"File"
 	File []*File `protobuf:"bytes,11,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
+	// @gotags: xml:"Fingerprint"
+	Fingerprint []*Fingerprint `protobuf:"bytes,9,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -6799,8 +6845,8 @@ func (x *TechnicalTextDetails) GetTextCodecType() *TextCodecType {
 }
 
 func (x *TechnicalTextDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -6826,30 +6872,30 @@ func (x *TechnicalTextDetails) GetConsumerFulfillmentDate() *FulfillmentDate {
 	return nil
 }
 
-func (x *TechnicalTextDetails) GetFingerprint() []*Fingerprint {
+func (x *TechnicalTextDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Fingerprint
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *TechnicalTextDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalTextDetails) GetFile() []*File {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalTextDetails) GetFile() []*File {
+func (x *TechnicalTextDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalTextDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -6861,21 +6907,21 @@ type TechnicalUserDefinedResourceDetails struct {
 	// @gotags: xml:"UserDefinedValue"
 	UserDefinedValue []*UserDefinedValue `protobuf:"bytes,2,rep,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,3,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,3,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *PreviewDetails `protobuf:"bytes,4,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,5,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,6,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
-	// @gotags: xml:"Fingerprint"
-	Fingerprint []*Fingerprint `protobuf:"bytes,7,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"FileAvailabilityDescription"
 	FileAvailabilityDescription []*Description `protobuf:"bytes,8,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
 	// @gotags: xml:"File"
 	File []*File `protobuf:"bytes,9,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
+	// @gotags: xml:"Fingerprint"
+	Fingerprint []*Fingerprint `protobuf:"bytes,7,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,10,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,10,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -6925,8 +6971,8 @@ func (x *TechnicalUserDefinedResourceDetails) GetUserDefinedValue() []*UserDefin
 }
 
 func (x *TechnicalUserDefinedResourceDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -6952,30 +6998,30 @@ func (x *TechnicalUserDefinedResourceDetails) GetConsumerFulfillmentDate() *Fulf
 	return nil
 }
 
-func (x *TechnicalUserDefinedResourceDetails) GetFingerprint() []*Fingerprint {
+func (x *TechnicalUserDefinedResourceDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Fingerprint
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *TechnicalUserDefinedResourceDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalUserDefinedResourceDetails) GetFile() []*File {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalUserDefinedResourceDetails) GetFile() []*File {
+func (x *TechnicalUserDefinedResourceDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalUserDefinedResourceDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -7003,41 +7049,41 @@ type TechnicalVideoDetails struct {
 	// @gotags: xml:"AspectRatio"
 	AspectRatio *AspectRatio `protobuf:"bytes,10,opt,name=aspect_ratio,json=aspectRatio,proto3" json:"aspect_ratio,omitempty" xml:"AspectRatio"`
 	// @gotags: xml:"ColorDepth"
-	ColorDepth int32 `protobuf:"varint,11,opt,name=color_depth,json=colorDepth,proto3" json:"color_depth,omitempty" xml:"ColorDepth"`
-	// @gotags: xml:"VideoDefinitionType"
-	VideoDefinitionType string `protobuf:"bytes,12,opt,name=video_definition_type,json=videoDefinitionType,proto3" json:"video_definition_type,omitempty" xml:"VideoDefinitionType"`
+	ColorDepth *int32 `protobuf:"varint,11,opt,name=color_depth,json=colorDepth,proto3,oneof" json:"color_depth,omitempty" xml:"ColorDepth"`
+	// @gotags: xml:"VideoDefinitionType" avs:"VideoDefinitionType"
+	VideoDefinitionType *string `protobuf:"bytes,12,opt,name=video_definition_type,json=videoDefinitionType,proto3,oneof" json:"video_definition_type,omitempty" xml:"VideoDefinitionType" avs:"VideoDefinitionType"`
 	// @gotags: xml:"AudioCodecType"
 	AudioCodecType *AudioCodecType `protobuf:"bytes,13,opt,name=audio_codec_type,json=audioCodecType,proto3" json:"audio_codec_type,omitempty" xml:"AudioCodecType"`
 	// @gotags: xml:"AudioBitRate"
 	AudioBitRate *BitRate `protobuf:"bytes,14,opt,name=audio_bit_rate,json=audioBitRate,proto3" json:"audio_bit_rate,omitempty" xml:"AudioBitRate"`
 	// @gotags: xml:"NumberOfAudioChannels"
-	NumberOfAudioChannels int32 `protobuf:"varint,15,opt,name=number_of_audio_channels,json=numberOfAudioChannels,proto3" json:"number_of_audio_channels,omitempty" xml:"NumberOfAudioChannels"`
+	NumberOfAudioChannels *int32 `protobuf:"varint,15,opt,name=number_of_audio_channels,json=numberOfAudioChannels,proto3,oneof" json:"number_of_audio_channels,omitempty" xml:"NumberOfAudioChannels"`
 	// @gotags: xml:"AudioSamplingRate"
 	AudioSamplingRate *SamplingRate `protobuf:"bytes,16,opt,name=audio_sampling_rate,json=audioSamplingRate,proto3" json:"audio_sampling_rate,omitempty" xml:"AudioSamplingRate"`
 	// @gotags: xml:"AudioBitsPerSample"
-	AudioBitsPerSample int32 `protobuf:"varint,17,opt,name=audio_bits_per_sample,json=audioBitsPerSample,proto3" json:"audio_bits_per_sample,omitempty" xml:"AudioBitsPerSample"`
+	AudioBitsPerSample *int32 `protobuf:"varint,17,opt,name=audio_bits_per_sample,json=audioBitsPerSample,proto3,oneof" json:"audio_bits_per_sample,omitempty" xml:"AudioBitsPerSample"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,18,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,18,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"ResourceProcessingRequired"
-	ResourceProcessingRequired bool `protobuf:"varint,19,opt,name=resource_processing_required,json=resourceProcessingRequired,proto3" json:"resource_processing_required,omitempty" xml:"ResourceProcessingRequired"`
+	ResourceProcessingRequired *bool `protobuf:"varint,19,opt,name=resource_processing_required,json=resourceProcessingRequired,proto3,oneof" json:"resource_processing_required,omitempty" xml:"ResourceProcessingRequired"`
 	// @gotags: xml:"UsableResourceDuration"
-	UsableResourceDuration string `protobuf:"bytes,20,opt,name=usable_resource_duration,json=usableResourceDuration,proto3" json:"usable_resource_duration,omitempty" xml:"UsableResourceDuration"`
+	UsableResourceDuration *string `protobuf:"bytes,20,opt,name=usable_resource_duration,json=usableResourceDuration,proto3,oneof" json:"usable_resource_duration,omitempty" xml:"UsableResourceDuration"`
 	// @gotags: xml:"IsPreview"
-	IsPreview bool `protobuf:"varint,21,opt,name=is_preview,json=isPreview,proto3" json:"is_preview,omitempty" xml:"IsPreview"`
+	IsPreview *bool `protobuf:"varint,21,opt,name=is_preview,json=isPreview,proto3,oneof" json:"is_preview,omitempty" xml:"IsPreview"`
 	// @gotags: xml:"PreviewDetails"
 	PreviewDetails *SoundRecordingPreviewDetails `protobuf:"bytes,22,opt,name=preview_details,json=previewDetails,proto3" json:"preview_details,omitempty" xml:"PreviewDetails"`
 	// @gotags: xml:"FulfillmentDate"
 	FulfillmentDate *FulfillmentDate `protobuf:"bytes,23,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ConsumerFulfillmentDate"
 	ConsumerFulfillmentDate *FulfillmentDate `protobuf:"bytes,24,opt,name=consumer_fulfillment_date,json=consumerFulfillmentDate,proto3" json:"consumer_fulfillment_date,omitempty" xml:"ConsumerFulfillmentDate"`
-	// @gotags: xml:"Fingerprint"
-	Fingerprint []*Fingerprint `protobuf:"bytes,25,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"FileAvailabilityDescription"
 	FileAvailabilityDescription []*Description `protobuf:"bytes,26,rep,name=file_availability_description,json=fileAvailabilityDescription,proto3" json:"file_availability_description,omitempty" xml:"FileAvailabilityDescription"`
 	// @gotags: xml:"File"
 	File []*File `protobuf:"bytes,27,rep,name=file,proto3" json:"file,omitempty" xml:"File"`
+	// @gotags: xml:"Fingerprint"
+	Fingerprint []*Fingerprint `protobuf:"bytes,25,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,28,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,28,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7143,15 +7189,15 @@ func (x *TechnicalVideoDetails) GetAspectRatio() *AspectRatio {
 }
 
 func (x *TechnicalVideoDetails) GetColorDepth() int32 {
-	if x != nil {
-		return x.ColorDepth
+	if x != nil && x.ColorDepth != nil {
+		return *x.ColorDepth
 	}
 	return 0
 }
 
 func (x *TechnicalVideoDetails) GetVideoDefinitionType() string {
-	if x != nil {
-		return x.VideoDefinitionType
+	if x != nil && x.VideoDefinitionType != nil {
+		return *x.VideoDefinitionType
 	}
 	return ""
 }
@@ -7171,8 +7217,8 @@ func (x *TechnicalVideoDetails) GetAudioBitRate() *BitRate {
 }
 
 func (x *TechnicalVideoDetails) GetNumberOfAudioChannels() int32 {
-	if x != nil {
-		return x.NumberOfAudioChannels
+	if x != nil && x.NumberOfAudioChannels != nil {
+		return *x.NumberOfAudioChannels
 	}
 	return 0
 }
@@ -7185,36 +7231,36 @@ func (x *TechnicalVideoDetails) GetAudioSamplingRate() *SamplingRate {
 }
 
 func (x *TechnicalVideoDetails) GetAudioBitsPerSample() int32 {
-	if x != nil {
-		return x.AudioBitsPerSample
+	if x != nil && x.AudioBitsPerSample != nil {
+		return *x.AudioBitsPerSample
 	}
 	return 0
 }
 
 func (x *TechnicalVideoDetails) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *TechnicalVideoDetails) GetResourceProcessingRequired() bool {
-	if x != nil {
-		return x.ResourceProcessingRequired
+	if x != nil && x.ResourceProcessingRequired != nil {
+		return *x.ResourceProcessingRequired
 	}
 	return false
 }
 
 func (x *TechnicalVideoDetails) GetUsableResourceDuration() string {
-	if x != nil {
-		return x.UsableResourceDuration
+	if x != nil && x.UsableResourceDuration != nil {
+		return *x.UsableResourceDuration
 	}
 	return ""
 }
 
 func (x *TechnicalVideoDetails) GetIsPreview() bool {
-	if x != nil {
-		return x.IsPreview
+	if x != nil && x.IsPreview != nil {
+		return *x.IsPreview
 	}
 	return false
 }
@@ -7240,30 +7286,30 @@ func (x *TechnicalVideoDetails) GetConsumerFulfillmentDate() *FulfillmentDate {
 	return nil
 }
 
-func (x *TechnicalVideoDetails) GetFingerprint() []*Fingerprint {
+func (x *TechnicalVideoDetails) GetFileAvailabilityDescription() []*Description {
 	if x != nil {
-		return x.Fingerprint
+		return x.FileAvailabilityDescription
 	}
 	return nil
 }
 
-func (x *TechnicalVideoDetails) GetFileAvailabilityDescription() []*Description {
+func (x *TechnicalVideoDetails) GetFile() []*File {
 	if x != nil {
-		return x.FileAvailabilityDescription
+		return x.File
 	}
 	return nil
 }
 
-func (x *TechnicalVideoDetails) GetFile() []*File {
+func (x *TechnicalVideoDetails) GetFingerprint() []*Fingerprint {
 	if x != nil {
-		return x.File
+		return x.Fingerprint
 	}
 	return nil
 }
 
 func (x *TechnicalVideoDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -7273,7 +7319,7 @@ type Text struct {
 	// @gotags: xml:"TextType"
 	TextType *TextType `protobuf:"bytes,1,opt,name=text_type,json=textType,proto3" json:"text_type,omitempty" xml:"TextType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"TextId"
 	TextId []*TextId `protobuf:"bytes,3,rep,name=text_id,json=textId,proto3" json:"text_id,omitempty" xml:"TextId"`
 	// @gotags: xml:"IndirectTextId"
@@ -7291,9 +7337,9 @@ type Text struct {
 	// @gotags: xml:"TextDetailsByTerritory"
 	TextDetailsByTerritory []*TextDetailsByTerritory `protobuf:"bytes,10,rep,name=text_details_by_territory,json=textDetailsByTerritory,proto3" json:"text_details_by_territory,omitempty" xml:"TextDetailsByTerritory"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,11,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,11,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,12,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7336,8 +7382,8 @@ func (x *Text) GetTextType() *TextType {
 }
 
 func (x *Text) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -7399,21 +7445,25 @@ func (x *Text) GetTextDetailsByTerritory() []*TextDetailsByTerritory {
 }
 
 func (x *Text) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *Text) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type TextDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,15,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"ResourceContributor"
@@ -7442,12 +7492,8 @@ type TextDetailsByTerritory struct {
 	ParentalWarningType []*ParentalWarningType `protobuf:"bytes,13,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty" xml:"ParentalWarningType"`
 	// @gotags: xml:"TechnicalTextDetails"
 	TechnicalTextDetails []*TechnicalTextDetails `protobuf:"bytes,14,rep,name=technical_text_details,json=technicalTextDetails,proto3" json:"technical_text_details,omitempty" xml:"TechnicalTextDetails"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,15,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,17,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,17,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7482,6 +7528,20 @@ func (*TextDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{52}
 }
 
+func (x *TextDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *TextDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *TextDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
 		return x.Title
@@ -7580,49 +7640,35 @@ func (x *TextDetailsByTerritory) GetTechnicalTextDetails() []*TechnicalTextDetai
 	return nil
 }
 
-func (x *TextDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *TextDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *TextDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type TypedRightsController struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"RightsControllerRole"
-	RightsControllerRole []string `protobuf:"bytes,1,rep,name=rights_controller_role,json=rightsControllerRole,proto3" json:"rights_controller_role,omitempty" xml:"RightsControllerRole"`
-	// @gotags: xml:"RightsControllerType"
-	RightsControllerType string `protobuf:"bytes,2,opt,name=rights_controller_type,json=rightsControllerType,proto3" json:"rights_controller_type,omitempty" xml:"RightsControllerType"`
-	// @gotags: xml:"TerritoryOfRegistration"
-	TerritoryOfRegistration *AllTerritoryCode `protobuf:"bytes,3,opt,name=territory_of_registration,json=territoryOfRegistration,proto3" json:"territory_of_registration,omitempty" xml:"TerritoryOfRegistration"`
-	// @gotags: xml:"StartDate"
-	StartDate string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty" xml:"StartDate"`
-	// @gotags: xml:"EndDate"
-	EndDate string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
 	// @gotags: xml:"PartyId"
 	PartyId []*PartyId `protobuf:"bytes,6,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,7,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"RightsControllerRole" avs:"RightsControllerRole"
+	RightsControllerRole []string `protobuf:"bytes,1,rep,name=rights_controller_role,json=rightsControllerRole,proto3" json:"rights_controller_role,omitempty" xml:"RightsControllerRole" avs:"RightsControllerRole"`
 	// @gotags: xml:"RightShareUnknown"
 	RightShareUnknown bool `protobuf:"varint,8,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty" xml:"RightShareUnknown"`
 	// @gotags: xml:"RightSharePercentage"
 	RightSharePercentage *Percentage `protobuf:"bytes,9,opt,name=right_share_percentage,json=rightSharePercentage,proto3" json:"right_share_percentage,omitempty" xml:"RightSharePercentage"`
+	// @gotags: xml:"RightsControllerType" avs:"RightsControllerType"
+	RightsControllerType *string `protobuf:"bytes,2,opt,name=rights_controller_type,json=rightsControllerType,proto3,oneof" json:"rights_controller_type,omitempty" xml:"RightsControllerType" avs:"RightsControllerType"`
+	// @gotags: xml:"TerritoryOfRegistration"
+	TerritoryOfRegistration *AllTerritoryCode `protobuf:"bytes,3,opt,name=territory_of_registration,json=territoryOfRegistration,proto3" json:"territory_of_registration,omitempty" xml:"TerritoryOfRegistration"`
+	// @gotags: xml:"StartDate"
+	StartDate *string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty" xml:"StartDate"`
+	// @gotags: xml:"EndDate"
+	EndDate *string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty" xml:"EndDate"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,10,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,10,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7657,72 +7703,72 @@ func (*TypedRightsController) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *TypedRightsController) GetRightsControllerRole() []string {
+func (x *TypedRightsController) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.RightsControllerRole
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *TypedRightsController) GetRightsControllerType() string {
+func (x *TypedRightsController) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.RightsControllerType
+		return x.PartyName
 	}
-	return ""
+	return nil
 }
 
-func (x *TypedRightsController) GetTerritoryOfRegistration() *AllTerritoryCode {
+func (x *TypedRightsController) GetRightsControllerRole() []string {
 	if x != nil {
-		return x.TerritoryOfRegistration
+		return x.RightsControllerRole
 	}
 	return nil
 }
 
-func (x *TypedRightsController) GetStartDate() string {
+func (x *TypedRightsController) GetRightShareUnknown() bool {
 	if x != nil {
-		return x.StartDate
+		return x.RightShareUnknown
 	}
-	return ""
+	return false
 }
 
-func (x *TypedRightsController) GetEndDate() string {
+func (x *TypedRightsController) GetRightSharePercentage() *Percentage {
 	if x != nil {
-		return x.EndDate
+		return x.RightSharePercentage
 	}
-	return ""
+	return nil
 }
 
-func (x *TypedRightsController) GetPartyId() []*PartyId {
-	if x != nil {
-		return x.PartyId
+func (x *TypedRightsController) GetRightsControllerType() string {
+	if x != nil && x.RightsControllerType != nil {
+		return *x.RightsControllerType
 	}
-	return nil
+	return ""
 }
 
-func (x *TypedRightsController) GetPartyName() []*PartyName {
+func (x *TypedRightsController) GetTerritoryOfRegistration() *AllTerritoryCode {
 	if x != nil {
-		return x.PartyName
+		return x.TerritoryOfRegistration
 	}
 	return nil
 }
 
-func (x *TypedRightsController) GetRightShareUnknown() bool {
-	if x != nil {
-		return x.RightShareUnknown
+func (x *TypedRightsController) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
-	return false
+	return ""
 }
 
-func (x *TypedRightsController) GetRightSharePercentage() *Percentage {
-	if x != nil {
-		return x.RightSharePercentage
+func (x *TypedRightsController) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
-	return nil
+	return ""
 }
 
 func (x *TypedRightsController) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -7732,7 +7778,7 @@ type UserDefinedResource struct {
 	// @gotags: xml:"UserDefinedResourceType"
 	UserDefinedResourceType *UserDefinedResourceType `protobuf:"bytes,1,opt,name=user_defined_resource_type,json=userDefinedResourceType,proto3" json:"user_defined_resource_type,omitempty" xml:"UserDefinedResourceType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"UserDefinedResourceId"
 	UserDefinedResourceId []*ResourceProprietaryId `protobuf:"bytes,3,rep,name=user_defined_resource_id,json=userDefinedResourceId,proto3" json:"user_defined_resource_id,omitempty" xml:"UserDefinedResourceId"`
 	// @gotags: xml:"IndirectUserDefinedResourceId"
@@ -7752,9 +7798,9 @@ type UserDefinedResource struct {
 	// @gotags: xml:"UserDefinedResourceDetailsByTerritory"
 	UserDefinedResourceDetailsByTerritory []*UserDefinedResourceDetailsByTerritory `protobuf:"bytes,11,rep,name=user_defined_resource_details_by_territory,json=userDefinedResourceDetailsByTerritory,proto3" json:"user_defined_resource_details_by_territory,omitempty" xml:"UserDefinedResourceDetailsByTerritory"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,12,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,12,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,13,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,13,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7797,8 +7843,8 @@ func (x *UserDefinedResource) GetUserDefinedResourceType() *UserDefinedResourceT
 }
 
 func (x *UserDefinedResource) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -7867,21 +7913,25 @@ func (x *UserDefinedResource) GetUserDefinedResourceDetailsByTerritory() []*User
 }
 
 func (x *UserDefinedResource) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *UserDefinedResource) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type UserDefinedResourceDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"ResourceContributor"
@@ -7912,12 +7962,8 @@ type UserDefinedResourceDetailsByTerritory struct {
 	ParentalWarningType []*ParentalWarningType `protobuf:"bytes,14,rep,name=parental_warning_type,json=parentalWarningType,proto3" json:"parental_warning_type,omitempty" xml:"ParentalWarningType"`
 	// @gotags: xml:"TechnicalUserDefinedResourceDetails"
 	TechnicalUserDefinedResourceDetails []*TechnicalUserDefinedResourceDetails `protobuf:"bytes,15,rep,name=technical_user_defined_resource_details,json=technicalUserDefinedResourceDetails,proto3" json:"technical_user_defined_resource_details,omitempty" xml:"TechnicalUserDefinedResourceDetails"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,18,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,18,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7952,6 +7998,20 @@ func (*UserDefinedResourceDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{55}
 }
 
+func (x *UserDefinedResourceDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *UserDefinedResourceDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *UserDefinedResourceDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
 		return x.Title
@@ -8057,23 +8117,9 @@ func (x *UserDefinedResourceDetailsByTerritory) GetTechnicalUserDefinedResourceD
 	return nil
 }
 
-func (x *UserDefinedResourceDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *UserDefinedResourceDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *UserDefinedResourceDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -8083,13 +8129,17 @@ type Video struct {
 	// @gotags: xml:"VideoType"
 	VideoType *VideoType `protobuf:"bytes,1,opt,name=video_type,json=videoType,proto3" json:"video_type,omitempty" xml:"VideoType"`
 	// @gotags: xml:"IsArtistRelated"
-	IsArtistRelated bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
+	IsArtistRelated *bool `protobuf:"varint,2,opt,name=is_artist_related,json=isArtistRelated,proto3,oneof" json:"is_artist_related,omitempty" xml:"IsArtistRelated"`
 	// @gotags: xml:"VideoId"
 	VideoId []*VideoId `protobuf:"bytes,3,rep,name=video_id,json=videoId,proto3" json:"video_id,omitempty" xml:"VideoId"`
 	// @gotags: xml:"IndirectVideoId"
 	IndirectVideoId []*MusicalWorkId `protobuf:"bytes,4,rep,name=indirect_video_id,json=indirectVideoId,proto3" json:"indirect_video_id,omitempty" xml:"IndirectVideoId"`
 	// @gotags: xml:"ResourceReference"
 	ResourceReference string `protobuf:"bytes,5,opt,name=resource_reference,json=resourceReference,proto3" json:"resource_reference,omitempty" xml:"ResourceReference"`
+	// @gotags: xml:"VideoCueSheetReference"
+	VideoCueSheetReference []*VideoCueSheetReference `protobuf:"bytes,37,rep,name=video_cue_sheet_reference,json=videoCueSheetReference,proto3" json:"video_cue_sheet_reference,omitempty" xml:"VideoCueSheetReference"`
+	// @gotags: xml:"ReasonForCueSheetAbsence"
+	ReasonForCueSheetAbsence *Reason `protobuf:"bytes,38,opt,name=reason_for_cue_sheet_absence,json=reasonForCueSheetAbsence,proto3" json:"reason_for_cue_sheet_absence,omitempty" xml:"ReasonForCueSheetAbsence"`
 	// @gotags: xml:"ReferenceTitle"
 	ReferenceTitle *ReferenceTitle `protobuf:"bytes,6,opt,name=reference_title,json=referenceTitle,proto3" json:"reference_title,omitempty" xml:"ReferenceTitle"`
 	// @gotags: xml:"Title"
@@ -8097,33 +8147,33 @@ type Video struct {
 	// @gotags: xml:"InstrumentationDescription"
 	InstrumentationDescription *Description `protobuf:"bytes,8,opt,name=instrumentation_description,json=instrumentationDescription,proto3" json:"instrumentation_description,omitempty" xml:"InstrumentationDescription"`
 	// @gotags: xml:"IsMedley"
-	IsMedley bool `protobuf:"varint,9,opt,name=is_medley,json=isMedley,proto3" json:"is_medley,omitempty" xml:"IsMedley"`
+	IsMedley *bool `protobuf:"varint,9,opt,name=is_medley,json=isMedley,proto3,oneof" json:"is_medley,omitempty" xml:"IsMedley"`
 	// @gotags: xml:"IsPotpourri"
-	IsPotpourri bool `protobuf:"varint,10,opt,name=is_potpourri,json=isPotpourri,proto3" json:"is_potpourri,omitempty" xml:"IsPotpourri"`
+	IsPotpourri *bool `protobuf:"varint,10,opt,name=is_potpourri,json=isPotpourri,proto3,oneof" json:"is_potpourri,omitempty" xml:"IsPotpourri"`
 	// @gotags: xml:"IsInstrumental"
-	IsInstrumental bool `protobuf:"varint,11,opt,name=is_instrumental,json=isInstrumental,proto3" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
+	IsInstrumental *bool `protobuf:"varint,11,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
 	// @gotags: xml:"IsBackground"
-	IsBackground bool `protobuf:"varint,12,opt,name=is_background,json=isBackground,proto3" json:"is_background,omitempty" xml:"IsBackground"`
+	IsBackground *bool `protobuf:"varint,12,opt,name=is_background,json=isBackground,proto3,oneof" json:"is_background,omitempty" xml:"IsBackground"`
 	// @gotags: xml:"IsHiddenResource"
-	IsHiddenResource bool `protobuf:"varint,13,opt,name=is_hidden_resource,json=isHiddenResource,proto3" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
+	IsHiddenResource *bool `protobuf:"varint,13,opt,name=is_hidden_resource,json=isHiddenResource,proto3,oneof" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
 	// @gotags: xml:"IsBonusResource"
-	IsBonusResource bool `protobuf:"varint,14,opt,name=is_bonus_resource,json=isBonusResource,proto3" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
+	IsBonusResource *bool `protobuf:"varint,14,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
 	// @gotags: xml:"HasPreOrderFulfillment"
-	HasPreOrderFulfillment bool `protobuf:"varint,15,opt,name=has_pre_order_fulfillment,json=hasPreOrderFulfillment,proto3" json:"has_pre_order_fulfillment,omitempty" xml:"HasPreOrderFulfillment"`
+	HasPreOrderFulfillment *bool `protobuf:"varint,15,opt,name=has_pre_order_fulfillment,json=hasPreOrderFulfillment,proto3,oneof" json:"has_pre_order_fulfillment,omitempty" xml:"HasPreOrderFulfillment"`
 	// @gotags: xml:"IsRemastered"
-	IsRemastered bool `protobuf:"varint,16,opt,name=is_remastered,json=isRemastered,proto3" json:"is_remastered,omitempty" xml:"IsRemastered"`
+	IsRemastered *bool `protobuf:"varint,16,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty" xml:"IsRemastered"`
 	// @gotags: xml:"NoSilenceBefore"
-	NoSilenceBefore bool `protobuf:"varint,17,opt,name=no_silence_before,json=noSilenceBefore,proto3" json:"no_silence_before,omitempty" xml:"NoSilenceBefore"`
+	NoSilenceBefore *bool `protobuf:"varint,17,opt,name=no_silence_before,json=noSilenceBefore,proto3,oneof" json:"no_silence_before,omitempty" xml:"NoSilenceBefore"`
 	// @gotags: xml:"NoSilenceAfter"
-	NoSilenceAfter bool `protobuf:"varint,18,opt,name=no_silence_after,json=noSilenceAfter,proto3" json:"no_silence_after,omitempty" xml:"NoSilenceAfter"`
+	NoSilenceAfter *bool `protobuf:"varint,18,opt,name=no_silence_after,json=noSilenceAfter,proto3,oneof" json:"no_silence_after,omitempty" xml:"NoSilenceAfter"`
 	// @gotags: xml:"PerformerInformationRequired"
-	PerformerInformationRequired bool `protobuf:"varint,19,opt,name=performer_information_required,json=performerInformationRequired,proto3" json:"performer_information_required,omitempty" xml:"PerformerInformationRequired"`
-	// @gotags: xml:"LanguageOfPerformance"
-	LanguageOfPerformance []string `protobuf:"bytes,20,rep,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance"`
-	// @gotags: xml:"LanguageOfDubbing"
-	LanguageOfDubbing []string `protobuf:"bytes,21,rep,name=language_of_dubbing,json=languageOfDubbing,proto3" json:"language_of_dubbing,omitempty" xml:"LanguageOfDubbing"`
-	// @gotags: xml:"SubTitleLanguage"
-	SubTitleLanguage []string `protobuf:"bytes,22,rep,name=sub_title_language,json=subTitleLanguage,proto3" json:"sub_title_language,omitempty" xml:"SubTitleLanguage"`
+	PerformerInformationRequired *bool `protobuf:"varint,19,opt,name=performer_information_required,json=performerInformationRequired,proto3,oneof" json:"performer_information_required,omitempty" xml:"PerformerInformationRequired"`
+	// @gotags: xml:"LanguageOfPerformance" avs:"IsoLanguageCode"
+	LanguageOfPerformance []string `protobuf:"bytes,20,rep,name=language_of_performance,json=languageOfPerformance,proto3" json:"language_of_performance,omitempty" xml:"LanguageOfPerformance" avs:"IsoLanguageCode"`
+	// @gotags: xml:"LanguageOfDubbing" avs:"IsoLanguageCode"
+	LanguageOfDubbing []string `protobuf:"bytes,21,rep,name=language_of_dubbing,json=languageOfDubbing,proto3" json:"language_of_dubbing,omitempty" xml:"LanguageOfDubbing" avs:"IsoLanguageCode"`
+	// @gotags: xml:"SubTitleLanguage" avs:"IsoLanguageCode"
+	SubTitleLanguage []string `protobuf:"bytes,22,rep,name=sub_title_language,json=subTitleLanguage,proto3" json:"sub_title_language,omitempty" xml:"SubTitleLanguage" avs:"IsoLanguageCode"`
 	// @gotags: xml:"Duration"
 	Duration string `protobuf:"bytes,23,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"RightsAgreementId"
@@ -8145,21 +8195,17 @@ type Video struct {
 	// @gotags: xml:"TerritoryOfCommissioning"
 	TerritoryOfCommissioning *AllTerritoryCode `protobuf:"bytes,32,opt,name=territory_of_commissioning,json=territoryOfCommissioning,proto3" json:"territory_of_commissioning,omitempty" xml:"TerritoryOfCommissioning"`
 	// @gotags: xml:"NumberOfFeaturedArtists"
-	NumberOfFeaturedArtists int32 `protobuf:"varint,33,opt,name=number_of_featured_artists,json=numberOfFeaturedArtists,proto3" json:"number_of_featured_artists,omitempty" xml:"NumberOfFeaturedArtists"`
+	NumberOfFeaturedArtists *int32 `protobuf:"varint,33,opt,name=number_of_featured_artists,json=numberOfFeaturedArtists,proto3,oneof" json:"number_of_featured_artists,omitempty" xml:"NumberOfFeaturedArtists"`
 	// @gotags: xml:"NumberOfNonFeaturedArtists"
-	NumberOfNonFeaturedArtists int32 `protobuf:"varint,34,opt,name=number_of_non_featured_artists,json=numberOfNonFeaturedArtists,proto3" json:"number_of_non_featured_artists,omitempty" xml:"NumberOfNonFeaturedArtists"`
+	NumberOfNonFeaturedArtists *int32 `protobuf:"varint,34,opt,name=number_of_non_featured_artists,json=numberOfNonFeaturedArtists,proto3,oneof" json:"number_of_non_featured_artists,omitempty" xml:"NumberOfNonFeaturedArtists"`
 	// @gotags: xml:"NumberOfContractedArtists"
-	NumberOfContractedArtists int32 `protobuf:"varint,35,opt,name=number_of_contracted_artists,json=numberOfContractedArtists,proto3" json:"number_of_contracted_artists,omitempty" xml:"NumberOfContractedArtists"`
+	NumberOfContractedArtists *int32 `protobuf:"varint,35,opt,name=number_of_contracted_artists,json=numberOfContractedArtists,proto3,oneof" json:"number_of_contracted_artists,omitempty" xml:"NumberOfContractedArtists"`
 	// @gotags: xml:"NumberOfNonContractedArtists"
-	NumberOfNonContractedArtists int32 `protobuf:"varint,36,opt,name=number_of_non_contracted_artists,json=numberOfNonContractedArtists,proto3" json:"number_of_non_contracted_artists,omitempty" xml:"NumberOfNonContractedArtists"`
-	// @gotags: xml:"VideoCueSheetReference"
-	VideoCueSheetReference []*VideoCueSheetReference `protobuf:"bytes,37,rep,name=video_cue_sheet_reference,json=videoCueSheetReference,proto3" json:"video_cue_sheet_reference,omitempty" xml:"VideoCueSheetReference"`
-	// @gotags: xml:"ReasonForCueSheetAbsence"
-	ReasonForCueSheetAbsence *Reason `protobuf:"bytes,38,opt,name=reason_for_cue_sheet_absence,json=reasonForCueSheetAbsence,proto3" json:"reason_for_cue_sheet_absence,omitempty" xml:"ReasonForCueSheetAbsence"`
+	NumberOfNonContractedArtists *int32 `protobuf:"varint,36,opt,name=number_of_non_contracted_artists,json=numberOfNonContractedArtists,proto3,oneof" json:"number_of_non_contracted_artists,omitempty" xml:"NumberOfNonContractedArtists"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,39,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,39,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,40,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,40,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -8202,8 +8248,8 @@ func (x *Video) GetVideoType() *VideoType {
 }
 
 func (x *Video) GetIsArtistRelated() bool {
-	if x != nil {
-		return x.IsArtistRelated
+	if x != nil && x.IsArtistRelated != nil {
+		return *x.IsArtistRelated
 	}
 	return false
 }
@@ -8229,6 +8275,20 @@ func (x *Video) GetResourceReference() string {
 	return ""
 }
 
+func (x *Video) GetVideoCueSheetReference() []*VideoCueSheetReference {
+	if x != nil {
+		return x.VideoCueSheetReference
+	}
+	return nil
+}
+
+func (x *Video) GetReasonForCueSheetAbsence() *Reason {
+	if x != nil {
+		return x.ReasonForCueSheetAbsence
+	}
+	return nil
+}
+
 func (x *Video) GetReferenceTitle() *ReferenceTitle {
 	if x != nil {
 		return x.ReferenceTitle
@@ -8251,78 +8311,78 @@ func (x *Video) GetInstrumentationDescription() *Description {
 }
 
 func (x *Video) GetIsMedley() bool {
-	if x != nil {
-		return x.IsMedley
+	if x != nil && x.IsMedley != nil {
+		return *x.IsMedley
 	}
 	return false
 }
 
 func (x *Video) GetIsPotpourri() bool {
-	if x != nil {
-		return x.IsPotpourri
+	if x != nil && x.IsPotpourri != nil {
+		return *x.IsPotpourri
 	}
 	return false
 }
 
 func (x *Video) GetIsInstrumental() bool {
-	if x != nil {
-		return x.IsInstrumental
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
 	}
 	return false
 }
 
 func (x *Video) GetIsBackground() bool {
-	if x != nil {
-		return x.IsBackground
+	if x != nil && x.IsBackground != nil {
+		return *x.IsBackground
 	}
 	return false
 }
 
 func (x *Video) GetIsHiddenResource() bool {
-	if x != nil {
-		return x.IsHiddenResource
+	if x != nil && x.IsHiddenResource != nil {
+		return *x.IsHiddenResource
 	}
 	return false
 }
 
 func (x *Video) GetIsBonusResource() bool {
-	if x != nil {
-		return x.IsBonusResource
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
 	}
 	return false
 }
 
 func (x *Video) GetHasPreOrderFulfillment() bool {
-	if x != nil {
-		return x.HasPreOrderFulfillment
+	if x != nil && x.HasPreOrderFulfillment != nil {
+		return *x.HasPreOrderFulfillment
 	}
 	return false
 }
 
 func (x *Video) GetIsRemastered() bool {
-	if x != nil {
-		return x.IsRemastered
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
 	}
 	return false
 }
 
 func (x *Video) GetNoSilenceBefore() bool {
-	if x != nil {
-		return x.NoSilenceBefore
+	if x != nil && x.NoSilenceBefore != nil {
+		return *x.NoSilenceBefore
 	}
 	return false
 }
 
 func (x *Video) GetNoSilenceAfter() bool {
-	if x != nil {
-		return x.NoSilenceAfter
+	if x != nil && x.NoSilenceAfter != nil {
+		return *x.NoSilenceAfter
 	}
 	return false
 }
 
 func (x *Video) GetPerformerInformationRequired() bool {
-	if x != nil {
-		return x.PerformerInformationRequired
+	if x != nil && x.PerformerInformationRequired != nil {
+		return *x.PerformerInformationRequired
 	}
 	return false
 }
@@ -8419,63 +8479,53 @@ func (x *Video) GetTerritoryOfCommissioning() *AllTerritoryCode {
 }
 
 func (x *Video) GetNumberOfFeaturedArtists() int32 {
-	if x != nil {
-		return x.NumberOfFeaturedArtists
+	if x != nil && x.NumberOfFeaturedArtists != nil {
+		return *x.NumberOfFeaturedArtists
 	}
 	return 0
 }
 
 func (x *Video) GetNumberOfNonFeaturedArtists() int32 {
-	if x != nil {
-		return x.NumberOfNonFeaturedArtists
+	if x != nil && x.NumberOfNonFeaturedArtists != nil {
+		return *x.NumberOfNonFeaturedArtists
 	}
 	return 0
 }
 
 func (x *Video) GetNumberOfContractedArtists() int32 {
-	if x != nil {
-		return x.NumberOfContractedArtists
+	if x != nil && x.NumberOfContractedArtists != nil {
+		return *x.NumberOfContractedArtists
 	}
 	return 0
 }
 
 func (x *Video) GetNumberOfNonContractedArtists() int32 {
-	if x != nil {
-		return x.NumberOfNonContractedArtists
+	if x != nil && x.NumberOfNonContractedArtists != nil {
+		return *x.NumberOfNonContractedArtists
 	}
 	return 0
 }
 
-func (x *Video) GetVideoCueSheetReference() []*VideoCueSheetReference {
-	if x != nil {
-		return x.VideoCueSheetReference
-	}
-	return nil
-}
-
-func (x *Video) GetReasonForCueSheetAbsence() *Reason {
-	if x != nil {
-		return x.ReasonForCueSheetAbsence
-	}
-	return nil
-}
-
 func (x *Video) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *Video) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type VideoDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,27,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,28,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"Title"
 	Title []*Title `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"DisplayArtist"
@@ -8505,7 +8555,7 @@ type VideoDetailsByTerritory struct {
 	// @gotags: xml:"CourtesyLine"
 	CourtesyLine *CourtesyLine `protobuf:"bytes,14,opt,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty" xml:"CourtesyLine"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,15,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,15,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"HostSoundCarrier"
 	HostSoundCarrier []*HostSoundCarrier `protobuf:"bytes,16,rep,name=host_sound_carrier,json=hostSoundCarrier,proto3" json:"host_sound_carrier,omitempty" xml:"HostSoundCarrier"`
 	// @gotags: xml:"MarketingComment"
@@ -8528,12 +8578,8 @@ type VideoDetailsByTerritory struct {
 	TechnicalVideoDetails []*TechnicalVideoDetails `protobuf:"bytes,25,rep,name=technical_video_details,json=technicalVideoDetails,proto3" json:"technical_video_details,omitempty" xml:"TechnicalVideoDetails"`
 	// @gotags: xml:"Character"
 	Character []*Character `protobuf:"bytes,26,rep,name=character,proto3" json:"character,omitempty" xml:"Character"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,27,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,28,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,29,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,29,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -8568,6 +8614,20 @@ func (*VideoDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{57}
 }
 
+func (x *VideoDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *VideoDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *VideoDetailsByTerritory) GetTitle() []*Title {
 	if x != nil {
 		return x.Title
@@ -8667,8 +8727,8 @@ func (x *VideoDetailsByTerritory) GetCourtesyLine() *CourtesyLine {
 }
 
 func (x *VideoDetailsByTerritory) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -8750,23 +8810,9 @@ func (x *VideoDetailsByTerritory) GetCharacter() []*Character {
 	return nil
 }
 
-func (x *VideoDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *VideoDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *VideoDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -8777,18 +8823,18 @@ type WebPolicy struct {
 	Condition *Condition `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty" xml:"Condition"`
 	// @gotags: xml:"AccessBlockingRequested"
 	AccessBlockingRequested bool `protobuf:"varint,2,opt,name=access_blocking_requested,json=accessBlockingRequested,proto3" json:"access_blocking_requested,omitempty" xml:"AccessBlockingRequested"`
-	// @gotags: xml:"AccessLimitation"
-	AccessLimitation string `protobuf:"bytes,3,opt,name=access_limitation,json=accessLimitation,proto3" json:"access_limitation,omitempty" xml:"AccessLimitation"`
+	// @gotags: xml:"AccessLimitation" avs:"AccessLimitation"
+	AccessLimitation *string `protobuf:"bytes,3,opt,name=access_limitation,json=accessLimitation,proto3,oneof" json:"access_limitation,omitempty" xml:"AccessLimitation" avs:"AccessLimitation"`
 	// @gotags: xml:"EmbeddingAllowed"
-	EmbeddingAllowed bool `protobuf:"varint,4,opt,name=embedding_allowed,json=embeddingAllowed,proto3" json:"embedding_allowed,omitempty" xml:"EmbeddingAllowed"`
+	EmbeddingAllowed *bool `protobuf:"varint,4,opt,name=embedding_allowed,json=embeddingAllowed,proto3,oneof" json:"embedding_allowed,omitempty" xml:"EmbeddingAllowed"`
 	// @gotags: xml:"UserRatingAllowed"
-	UserRatingAllowed bool `protobuf:"varint,5,opt,name=user_rating_allowed,json=userRatingAllowed,proto3" json:"user_rating_allowed,omitempty" xml:"UserRatingAllowed"`
+	UserRatingAllowed *bool `protobuf:"varint,5,opt,name=user_rating_allowed,json=userRatingAllowed,proto3,oneof" json:"user_rating_allowed,omitempty" xml:"UserRatingAllowed"`
 	// @gotags: xml:"UserCommentAllowed"
-	UserCommentAllowed bool `protobuf:"varint,6,opt,name=user_comment_allowed,json=userCommentAllowed,proto3" json:"user_comment_allowed,omitempty" xml:"UserCommentAllowed"`
+	UserCommentAllowed *bool `protobuf:"varint,6,opt,name=user_comment_allowed,json=userCommentAllowed,proto3,oneof" json:"user_comment_allowed,omitempty" xml:"UserCommentAllowed"`
 	// @gotags: xml:"UserResponsesAllowed"
-	UserResponsesAllowed bool `protobuf:"varint,7,opt,name=user_responses_allowed,json=userResponsesAllowed,proto3" json:"user_responses_allowed,omitempty" xml:"UserResponsesAllowed"`
+	UserResponsesAllowed *bool `protobuf:"varint,7,opt,name=user_responses_allowed,json=userResponsesAllowed,proto3,oneof" json:"user_responses_allowed,omitempty" xml:"UserResponsesAllowed"`
 	// @gotags: xml:"SyndicationAllowed"
-	SyndicationAllowed bool `protobuf:"varint,8,opt,name=syndication_allowed,json=syndicationAllowed,proto3" json:"syndication_allowed,omitempty" xml:"SyndicationAllowed"`
+	SyndicationAllowed *bool `protobuf:"varint,8,opt,name=syndication_allowed,json=syndicationAllowed,proto3,oneof" json:"syndication_allowed,omitempty" xml:"SyndicationAllowed"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -8838,43 +8884,43 @@ func (x *WebPolicy) GetAccessBlockingRequested() bool {
 }
 
 func (x *WebPolicy) GetAccessLimitation() string {
-	if x != nil {
-		return x.AccessLimitation
+	if x != nil && x.AccessLimitation != nil {
+		return *x.AccessLimitation
 	}
 	return ""
 }
 
 func (x *WebPolicy) GetEmbeddingAllowed() bool {
-	if x != nil {
-		return x.EmbeddingAllowed
+	if x != nil && x.EmbeddingAllowed != nil {
+		return *x.EmbeddingAllowed
 	}
 	return false
 }
 
 func (x *WebPolicy) GetUserRatingAllowed() bool {
-	if x != nil {
-		return x.UserRatingAllowed
+	if x != nil && x.UserRatingAllowed != nil {
+		return *x.UserRatingAllowed
 	}
 	return false
 }
 
 func (x *WebPolicy) GetUserCommentAllowed() bool {
-	if x != nil {
-		return x.UserCommentAllowed
+	if x != nil && x.UserCommentAllowed != nil {
+		return *x.UserCommentAllowed
 	}
 	return false
 }
 
 func (x *WebPolicy) GetUserResponsesAllowed() bool {
-	if x != nil {
-		return x.UserResponsesAllowed
+	if x != nil && x.UserResponsesAllowed != nil {
+		return *x.UserResponsesAllowed
 	}
 	return false
 }
 
 func (x *WebPolicy) GetSyndicationAllowed() bool {
-	if x != nil {
-		return x.SyndicationAllowed
+	if x != nil && x.SyndicationAllowed != nil {
+		return *x.SyndicationAllowed
 	}
 	return false
 }
@@ -8886,11 +8932,11 @@ type AdministratingRecordCompany struct {
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,2,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
-	// @gotags: xml:"Role,attr"
-	Role          string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty" xml:"Role,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	// @gotags: xml:"Role,attr" avs:"AdministratingRecordCompanyRole"
+	Role          string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty" xml:"Role,attr" avs:"AdministratingRecordCompanyRole"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8940,15 +8986,15 @@ func (x *AdministratingRecordCompany) GetPartyName() []*PartyName {
 }
 
 func (x *AdministratingRecordCompany) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AdministratingRecordCompany) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8962,10 +9008,10 @@ func (x *AdministratingRecordCompany) GetRole() string {
 
 type AllTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"AllTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AllTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -9008,24 +9054,24 @@ func (x *AllTerritoryCode) GetValue() string {
 }
 
 func (x *AllTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
 
 type Artist struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"ArtistRole"
-	ArtistRole []*ArtistRole `protobuf:"bytes,1,rep,name=artist_role,json=artistRole,proto3" json:"artist_role,omitempty" xml:"ArtistRole"`
-	// @gotags: xml:"Nationality"
-	Nationality []*AllTerritoryCode `protobuf:"bytes,2,rep,name=nationality,proto3" json:"nationality,omitempty" xml:"Nationality"`
 	// @gotags: xml:"PartyId"
 	PartyId []*PartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"ArtistRole"
+	ArtistRole []*ArtistRole `protobuf:"bytes,1,rep,name=artist_role,json=artistRole,proto3" json:"artist_role,omitempty" xml:"ArtistRole"`
+	// @gotags: xml:"Nationality"
+	Nationality []*AllTerritoryCode `protobuf:"bytes,2,rep,name=nationality,proto3" json:"nationality,omitempty" xml:"Nationality"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -9060,37 +9106,37 @@ func (*Artist) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Artist) GetArtistRole() []*ArtistRole {
+func (x *Artist) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.ArtistRole
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *Artist) GetNationality() []*AllTerritoryCode {
+func (x *Artist) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.Nationality
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *Artist) GetPartyId() []*PartyId {
+func (x *Artist) GetArtistRole() []*ArtistRole {
 	if x != nil {
-		return x.PartyId
+		return x.ArtistRole
 	}
 	return nil
 }
 
-func (x *Artist) GetPartyName() []*PartyName {
+func (x *Artist) GetNationality() []*AllTerritoryCode {
 	if x != nil {
-		return x.PartyName
+		return x.Nationality
 	}
 	return nil
 }
 
 func (x *Artist) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -9105,8 +9151,8 @@ type ArtistDelegatedUsageRights struct {
 	PeriodOfRightsDelegation *Period `protobuf:"bytes,3,opt,name=period_of_rights_delegation,json=periodOfRightsDelegation,proto3" json:"period_of_rights_delegation,omitempty" xml:"PeriodOfRightsDelegation"`
 	// @gotags: xml:"TerritoryOfRightsDelegation"
 	TerritoryOfRightsDelegation []*AllTerritoryCode `protobuf:"bytes,4,rep,name=territory_of_rights_delegation,json=territoryOfRightsDelegation,proto3" json:"territory_of_rights_delegation,omitempty" xml:"TerritoryOfRightsDelegation"`
-	// @gotags: xml:"MembershipType"
-	MembershipType string `protobuf:"bytes,5,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty" xml:"MembershipType"`
+	// @gotags: xml:"MembershipType" avs:"MembershipType"
+	MembershipType string `protobuf:"bytes,5,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty" xml:"MembershipType" avs:"MembershipType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -9178,12 +9224,12 @@ func (x *ArtistDelegatedUsageRights) GetMembershipType() string {
 
 type ArtistRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ArtistRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ArtistRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -9226,15 +9272,15 @@ func (x *ArtistRole) GetValue() string {
 }
 
 func (x *ArtistRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ArtistRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -9243,8 +9289,8 @@ type AspectRatio struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"AspectRatioType,attr"
-	AspectRatioType string `protobuf:"bytes,2,opt,name=aspect_ratio_type,json=aspectRatioType,proto3" json:"aspect_ratio_type,omitempty" xml:"AspectRatioType,attr"`
+	// @gotags: xml:"AspectRatioType,attr" avs:"UnitOfFrameRate"
+	AspectRatioType *string `protobuf:"bytes,2,opt,name=aspect_ratio_type,json=aspectRatioType,proto3,oneof" json:"aspect_ratio_type,omitempty" xml:"AspectRatioType,attr" avs:"UnitOfFrameRate"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -9287,22 +9333,22 @@ func (x *AspectRatio) GetValue() string {
 }
 
 func (x *AspectRatio) GetAspectRatioType() string {
-	if x != nil {
-		return x.AspectRatioType
+	if x != nil && x.AspectRatioType != nil {
+		return *x.AspectRatioType
 	}
 	return ""
 }
 
 type AudioCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"AudioCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AudioCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -9345,22 +9391,22 @@ func (x *AudioCodecType) GetValue() string {
 }
 
 func (x *AudioCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *AudioCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AudioCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -9432,8 +9478,8 @@ type BitRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfBitRate"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfBitRate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9476,8 +9522,8 @@ func (x *BitRate) GetValue() string {
 }
 
 func (x *BitRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
@@ -9485,13 +9531,13 @@ func (x *BitRate) GetUnitOfMeasure() string {
 type CLine struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"CLineCompany"
-	CLineCompany string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3" json:"c_line_company,omitempty" xml:"CLineCompany"`
+	CLineCompany *string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3,oneof" json:"c_line_company,omitempty" xml:"CLineCompany"`
 	// @gotags: xml:"CLineText"
 	CLineText string `protobuf:"bytes,3,opt,name=c_line_text,json=cLineText,proto3" json:"c_line_text,omitempty" xml:"CLineText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -9527,15 +9573,15 @@ func (*CLine) Descriptor() ([]byte, []int) {
 }
 
 func (x *CLine) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *CLine) GetCLineCompany() string {
-	if x != nil {
-		return x.CLineCompany
+	if x != nil && x.CLineCompany != nil {
+		return *x.CLineCompany
 	}
 	return ""
 }
@@ -9548,20 +9594,20 @@ func (x *CLine) GetCLineText() string {
 }
 
 func (x *CLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type CarrierType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CarrierType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CarrierType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -9604,15 +9650,15 @@ func (x *CarrierType) GetValue() string {
 }
 
 func (x *CarrierType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CarrierType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -9673,14 +9719,14 @@ func (x *CatalogNumber) GetNamespace() string {
 
 type Character struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"ResourceContributor"
-	ResourceContributor *DetailedResourceContributor `protobuf:"bytes,1,opt,name=resource_contributor,json=resourceContributor,proto3" json:"resource_contributor,omitempty" xml:"ResourceContributor"`
 	// @gotags: xml:"PartyId"
 	PartyId []*PartyId `protobuf:"bytes,2,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,3,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"ResourceContributor"
+	ResourceContributor *DetailedResourceContributor `protobuf:"bytes,1,opt,name=resource_contributor,json=resourceContributor,proto3" json:"resource_contributor,omitempty" xml:"ResourceContributor"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -9715,30 +9761,30 @@ func (*Character) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Character) GetResourceContributor() *DetailedResourceContributor {
+func (x *Character) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.ResourceContributor
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *Character) GetPartyId() []*PartyId {
+func (x *Character) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.PartyId
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *Character) GetPartyName() []*PartyName {
+func (x *Character) GetResourceContributor() *DetailedResourceContributor {
 	if x != nil {
-		return x.PartyName
+		return x.ResourceContributor
 	}
 	return nil
 }
 
 func (x *Character) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -9746,17 +9792,17 @@ func (x *Character) GetSequenceNumber() int32 {
 type CollectionCollectionReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"CollectionCollectionReference"
 	CollectionCollectionReference string `protobuf:"bytes,2,opt,name=collection_collection_reference,json=collectionCollectionReference,proto3" json:"collection_collection_reference,omitempty" xml:"CollectionCollectionReference"`
 	// @gotags: xml:"StartTime"
-	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" xml:"StartTime"`
+	StartTime *string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty" xml:"StartTime"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,4,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"EndTime"
-	EndTime string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" xml:"EndTime"`
+	EndTime *string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty" xml:"EndTime"`
 	// @gotags: xml:"InclusionDate"
-	InclusionDate string `protobuf:"bytes,6,opt,name=inclusion_date,json=inclusionDate,proto3" json:"inclusion_date,omitempty" xml:"InclusionDate"`
+	InclusionDate *string `protobuf:"bytes,6,opt,name=inclusion_date,json=inclusionDate,proto3,oneof" json:"inclusion_date,omitempty" xml:"InclusionDate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9792,8 +9838,8 @@ func (*CollectionCollectionReference) Descriptor() ([]byte, []int) {
 }
 
 func (x *CollectionCollectionReference) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -9806,29 +9852,29 @@ func (x *CollectionCollectionReference) GetCollectionCollectionReference() strin
 }
 
 func (x *CollectionCollectionReference) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
 	return ""
 }
 
 func (x *CollectionCollectionReference) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *CollectionCollectionReference) GetEndTime() string {
-	if x != nil {
-		return x.EndTime
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
 	return ""
 }
 
 func (x *CollectionCollectionReference) GetInclusionDate() string {
-	if x != nil {
-		return x.InclusionDate
+	if x != nil && x.InclusionDate != nil {
+		return *x.InclusionDate
 	}
 	return ""
 }
@@ -9836,7 +9882,7 @@ func (x *CollectionCollectionReference) GetInclusionDate() string {
 type CollectionCollectionReferenceList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"NumberOfCollections"
-	NumberOfCollections int32 `protobuf:"varint,1,opt,name=number_of_collections,json=numberOfCollections,proto3" json:"number_of_collections,omitempty" xml:"NumberOfCollections"`
+	NumberOfCollections *int32 `protobuf:"varint,1,opt,name=number_of_collections,json=numberOfCollections,proto3,oneof" json:"number_of_collections,omitempty" xml:"NumberOfCollections"`
 	// @gotags: xml:"CollectionCollectionReference"
 	CollectionCollectionReference []*CollectionCollectionReference `protobuf:"bytes,2,rep,name=collection_collection_reference,json=collectionCollectionReference,proto3" json:"collection_collection_reference,omitempty" xml:"CollectionCollectionReference"`
 	unknownFields                 protoimpl.UnknownFields
@@ -9874,8 +9920,8 @@ func (*CollectionCollectionReferenceList) Descriptor() ([]byte, []int) {
 }
 
 func (x *CollectionCollectionReferenceList) GetNumberOfCollections() int32 {
-	if x != nil {
-		return x.NumberOfCollections
+	if x != nil && x.NumberOfCollections != nil {
+		return *x.NumberOfCollections
 	}
 	return 0
 }
@@ -9890,13 +9936,13 @@ func (x *CollectionCollectionReferenceList) GetCollectionCollectionReference() [
 type CollectionId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"ICPN"
 	ICPN *ICPN `protobuf:"bytes,5,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"CatalogNumber"
@@ -9904,7 +9950,7 @@ type CollectionId struct {
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,7,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,8,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,8,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9940,29 +9986,29 @@ func (*CollectionId) Descriptor() ([]byte, []int) {
 }
 
 func (x *CollectionId) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *CollectionId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *CollectionId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *CollectionId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
@@ -9989,20 +10035,20 @@ func (x *CollectionId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *CollectionId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type CollectionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CollectionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CollectionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10045,15 +10091,15 @@ func (x *CollectionType) GetValue() string {
 }
 
 func (x *CollectionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CollectionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10063,7 +10109,7 @@ type CollectionWorkReference struct {
 	// @gotags: xml:"CollectionWorkReference"
 	CollectionWorkReference string `protobuf:"bytes,1,opt,name=collection_work_reference,json=collectionWorkReference,proto3" json:"collection_work_reference,omitempty" xml:"CollectionWorkReference"`
 	// @gotags: xml:"Duration"
-	Duration      string `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration      *string `protobuf:"bytes,2,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -10106,8 +10152,8 @@ func (x *CollectionWorkReference) GetCollectionWorkReference() string {
 }
 
 func (x *CollectionWorkReference) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
@@ -10162,7 +10208,7 @@ type Comment struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -10205,20 +10251,20 @@ func (x *Comment) GetValue() string {
 }
 
 func (x *Comment) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type CommercialModelType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CommercialModelType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CommercialModelType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10261,15 +10307,15 @@ func (x *CommercialModelType) GetValue() string {
 }
 
 func (x *CommercialModelType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CommercialModelType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10278,12 +10324,12 @@ type Condition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Value"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
-	// @gotags: xml:"Unit"
-	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit"`
-	// @gotags: xml:"ReferenceCreation"
-	ReferenceCreation string `protobuf:"bytes,3,opt,name=reference_creation,json=referenceCreation,proto3" json:"reference_creation,omitempty" xml:"ReferenceCreation"`
-	// @gotags: xml:"RelationalRelator"
-	RelationalRelator string `protobuf:"bytes,4,opt,name=relational_relator,json=relationalRelator,proto3" json:"relational_relator,omitempty" xml:"RelationalRelator"`
+	// @gotags: xml:"Unit" avs:"UnitOfConditionValue"
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit" avs:"UnitOfConditionValue"`
+	// @gotags: xml:"ReferenceCreation" avs:"ReferenceCreation"
+	ReferenceCreation *string `protobuf:"bytes,3,opt,name=reference_creation,json=referenceCreation,proto3,oneof" json:"reference_creation,omitempty" xml:"ReferenceCreation" avs:"ReferenceCreation"`
+	// @gotags: xml:"RelationalRelator" avs:"RelationalRelator"
+	RelationalRelator string `protobuf:"bytes,4,opt,name=relational_relator,json=relationalRelator,proto3" json:"relational_relator,omitempty" xml:"RelationalRelator" avs:"RelationalRelator"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -10333,8 +10379,8 @@ func (x *Condition) GetUnit() string {
 }
 
 func (x *Condition) GetReferenceCreation() string {
-	if x != nil {
-		return x.ReferenceCreation
+	if x != nil && x.ReferenceCreation != nil {
+		return *x.ReferenceCreation
 	}
 	return ""
 }
@@ -10351,7 +10397,7 @@ type ConsumerRentalPeriod struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsExtensible,attr"
-	IsExtensible  bool `protobuf:"varint,2,opt,name=is_extensible,json=isExtensible,proto3" json:"is_extensible,omitempty" xml:"IsExtensible,attr"`
+	IsExtensible  *bool `protobuf:"varint,2,opt,name=is_extensible,json=isExtensible,proto3,oneof" json:"is_extensible,omitempty" xml:"IsExtensible,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -10394,8 +10440,8 @@ func (x *ConsumerRentalPeriod) GetValue() string {
 }
 
 func (x *ConsumerRentalPeriod) GetIsExtensible() bool {
-	if x != nil {
-		return x.IsExtensible
+	if x != nil && x.IsExtensible != nil {
+		return *x.IsExtensible
 	}
 	return false
 }
@@ -10465,12 +10511,12 @@ func (x *ContactId) GetFaxNumber() []string {
 
 type ContainerFormat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ContainerFormat"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ContainerFormat"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10513,15 +10559,15 @@ func (x *ContainerFormat) GetValue() string {
 }
 
 func (x *ContainerFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ContainerFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10531,7 +10577,7 @@ type CourtesyLine struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -10574,8 +10620,8 @@ func (x *CourtesyLine) GetValue() string {
 }
 
 func (x *CourtesyLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -10583,25 +10629,25 @@ func (x *CourtesyLine) GetLanguageAndScriptCode() string {
 type CreationId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"OpusNumber"
-	OpusNumber string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3" json:"opus_number,omitempty" xml:"OpusNumber"`
+	OpusNumber *string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3,oneof" json:"opus_number,omitempty" xml:"OpusNumber"`
 	// @gotags: xml:"ComposerCatalogNumber"
 	ComposerCatalogNumber []string `protobuf:"bytes,3,rep,name=composer_catalog_number,json=composerCatalogNumber,proto3" json:"composer_catalog_number,omitempty" xml:"ComposerCatalogNumber"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,4,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,4,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,5,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,5,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,6,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,6,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,7,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,7,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,8,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,8,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,9,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,9,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,10,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,10,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,11,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -10641,15 +10687,15 @@ func (*CreationId) Descriptor() ([]byte, []int) {
 }
 
 func (x *CreationId) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *CreationId) GetOpusNumber() string {
-	if x != nil {
-		return x.OpusNumber
+	if x != nil && x.OpusNumber != nil {
+		return *x.OpusNumber
 	}
 	return ""
 }
@@ -10662,50 +10708,50 @@ func (x *CreationId) GetComposerCatalogNumber() []string {
 }
 
 func (x *CreationId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *CreationId) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
 
 func (x *CreationId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *CreationId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
 
 func (x *CreationId) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *CreationId) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *CreationId) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -10780,12 +10826,12 @@ func (x *CueCreationReference) GetCueResourceReference() string {
 
 type CueOrigin struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueOrigin"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueOrigin"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10828,27 +10874,27 @@ func (x *CueOrigin) GetValue() string {
 }
 
 func (x *CueOrigin) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueOrigin) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueSheetType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueSheetType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueSheetType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10891,27 +10937,27 @@ func (x *CueSheetType) GetValue() string {
 }
 
 func (x *CueSheetType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueSheetType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueThemeType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ThemeType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ThemeType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10954,27 +11000,27 @@ func (x *CueThemeType) GetValue() string {
 }
 
 func (x *CueThemeType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueThemeType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueUseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueUseType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueUseType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11017,27 +11063,27 @@ func (x *CueUseType) GetValue() string {
 }
 
 func (x *CueUseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueUseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueVisualPerceptionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VisualPerceptionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VisualPerceptionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11080,27 +11126,27 @@ func (x *CueVisualPerceptionType) GetValue() string {
 }
 
 func (x *CueVisualPerceptionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueVisualPerceptionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueVocalType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VocalType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VocalType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11143,25 +11189,25 @@ func (x *CueVocalType) GetValue() string {
 }
 
 func (x *CueVocalType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueVocalType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CurrentTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"CurrentTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeType"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -11204,26 +11250,26 @@ func (x *CurrentTerritoryCode) GetValue() string {
 }
 
 func (x *CurrentTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
 
 type DSP struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"PartyId"
+	PartyId []*PartyId `protobuf:"bytes,4,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyName `protobuf:"bytes,5,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"TradingName"
 	TradingName *Name `protobuf:"bytes,1,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
 	// @gotags: xml:"URL"
 	URL []string `protobuf:"bytes,2,rep,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode *CurrentTerritoryCode `protobuf:"bytes,3,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"PartyId"
-	PartyId []*PartyId `protobuf:"bytes,4,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName []*PartyName `protobuf:"bytes,5,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11258,44 +11304,44 @@ func (*DSP) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *DSP) GetTradingName() *Name {
+func (x *DSP) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.TradingName
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *DSP) GetURL() []string {
+func (x *DSP) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.URL
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *DSP) GetTerritoryCode() *CurrentTerritoryCode {
+func (x *DSP) GetTradingName() *Name {
 	if x != nil {
-		return x.TerritoryCode
+		return x.TradingName
 	}
 	return nil
 }
 
-func (x *DSP) GetPartyId() []*PartyId {
+func (x *DSP) GetURL() []string {
 	if x != nil {
-		return x.PartyId
+		return x.URL
 	}
 	return nil
 }
 
-func (x *DSP) GetPartyName() []*PartyName {
+func (x *DSP) GetTerritoryCode() *CurrentTerritoryCode {
 	if x != nil {
-		return x.PartyName
+		return x.TerritoryCode
 	}
 	return nil
 }
 
 func (x *DSP) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -11305,7 +11351,7 @@ type DealReference struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11348,8 +11394,8 @@ func (x *DealReference) GetValue() string {
 }
 
 func (x *DealReference) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -11359,7 +11405,7 @@ type Description struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11402,26 +11448,30 @@ func (x *Description) GetValue() string {
 }
 
 func (x *Description) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type DetailedResourceContributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"PartyId"
+	PartyId []*PartyId `protobuf:"bytes,20,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyName `protobuf:"bytes,21,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"ResourceContributorRole"
 	ResourceContributorRole []*ResourceContributorRole `protobuf:"bytes,1,rep,name=resource_contributor_role,json=resourceContributorRole,proto3" json:"resource_contributor_role,omitempty" xml:"ResourceContributorRole"`
 	// @gotags: xml:"IsFeaturedArtist"
-	IsFeaturedArtist bool `protobuf:"varint,2,opt,name=is_featured_artist,json=isFeaturedArtist,proto3" json:"is_featured_artist,omitempty" xml:"IsFeaturedArtist"`
+	IsFeaturedArtist *bool `protobuf:"varint,2,opt,name=is_featured_artist,json=isFeaturedArtist,proto3,oneof" json:"is_featured_artist,omitempty" xml:"IsFeaturedArtist"`
 	// @gotags: xml:"IsContractedArtist"
-	IsContractedArtist bool `protobuf:"varint,3,opt,name=is_contracted_artist,json=isContractedArtist,proto3" json:"is_contracted_artist,omitempty" xml:"IsContractedArtist"`
+	IsContractedArtist *bool `protobuf:"varint,3,opt,name=is_contracted_artist,json=isContractedArtist,proto3,oneof" json:"is_contracted_artist,omitempty" xml:"IsContractedArtist"`
 	// @gotags: xml:"InstrumentType"
 	InstrumentType []string `protobuf:"bytes,4,rep,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty" xml:"InstrumentType"`
 	// @gotags: xml:"ArtistDelegatedUsageRights"
 	ArtistDelegatedUsageRights *ArtistDelegatedUsageRights `protobuf:"bytes,5,opt,name=artist_delegated_usage_rights,json=artistDelegatedUsageRights,proto3" json:"artist_delegated_usage_rights,omitempty" xml:"ArtistDelegatedUsageRights"`
-	// @gotags: xml:"Sex"
-	Sex string `protobuf:"bytes,6,opt,name=sex,proto3" json:"sex,omitempty" xml:"Sex"`
+	// @gotags: xml:"Sex" avs:"Sex"
+	Sex *string `protobuf:"bytes,6,opt,name=sex,proto3,oneof" json:"sex,omitempty" xml:"Sex" avs:"Sex"`
 	// @gotags: xml:"Nationality"
 	Nationality []*AllTerritoryCode `protobuf:"bytes,7,rep,name=nationality,proto3" json:"nationality,omitempty" xml:"Nationality"`
 	// @gotags: xml:"DateAndPlaceOfBirth"
@@ -11433,7 +11483,7 @@ type DetailedResourceContributor struct {
 	// @gotags: xml:"Performance"
 	Performance []*Performance `protobuf:"bytes,11,rep,name=performance,proto3" json:"performance,omitempty" xml:"Performance"`
 	// @gotags: xml:"PrimaryInstrumentType"
-	PrimaryInstrumentType string `protobuf:"bytes,12,opt,name=primary_instrument_type,json=primaryInstrumentType,proto3" json:"primary_instrument_type,omitempty" xml:"PrimaryInstrumentType"`
+	PrimaryInstrumentType *string `protobuf:"bytes,12,opt,name=primary_instrument_type,json=primaryInstrumentType,proto3,oneof" json:"primary_instrument_type,omitempty" xml:"PrimaryInstrumentType"`
 	// @gotags: xml:"GoverningAgreementType"
 	GoverningAgreementType *GoverningAgreementType `protobuf:"bytes,13,opt,name=governing_agreement_type,json=governingAgreementType,proto3" json:"governing_agreement_type,omitempty" xml:"GoverningAgreementType"`
 	// @gotags: xml:"ContactInformation"
@@ -11448,12 +11498,8 @@ type DetailedResourceContributor struct {
 	Genre []*Genre `protobuf:"bytes,18,rep,name=genre,proto3" json:"genre,omitempty" xml:"Genre"`
 	// @gotags: xml:"Membership"
 	Membership []*Membership `protobuf:"bytes,19,rep,name=membership,proto3" json:"membership,omitempty" xml:"Membership"`
-	// @gotags: xml:"PartyId"
-	PartyId []*PartyId `protobuf:"bytes,20,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName []*PartyName `protobuf:"bytes,21,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,22,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,22,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -11488,6 +11534,20 @@ func (*DetailedResourceContributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{97}
 }
 
+func (x *DetailedResourceContributor) GetPartyId() []*PartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *DetailedResourceContributor) GetPartyName() []*PartyName {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
 func (x *DetailedResourceContributor) GetResourceContributorRole() []*ResourceContributorRole {
 	if x != nil {
 		return x.ResourceContributorRole
@@ -11496,15 +11556,15 @@ func (x *DetailedResourceContributor) GetResourceContributorRole() []*ResourceCo
 }
 
 func (x *DetailedResourceContributor) GetIsFeaturedArtist() bool {
-	if x != nil {
-		return x.IsFeaturedArtist
+	if x != nil && x.IsFeaturedArtist != nil {
+		return *x.IsFeaturedArtist
 	}
 	return false
 }
 
 func (x *DetailedResourceContributor) GetIsContractedArtist() bool {
-	if x != nil {
-		return x.IsContractedArtist
+	if x != nil && x.IsContractedArtist != nil {
+		return *x.IsContractedArtist
 	}
 	return false
 }
@@ -11524,8 +11584,8 @@ func (x *DetailedResourceContributor) GetArtistDelegatedUsageRights() *ArtistDel
 }
 
 func (x *DetailedResourceContributor) GetSex() string {
-	if x != nil {
-		return x.Sex
+	if x != nil && x.Sex != nil {
+		return *x.Sex
 	}
 	return ""
 }
@@ -11566,8 +11626,8 @@ func (x *DetailedResourceContributor) GetPerformance() []*Performance {
 }
 
 func (x *DetailedResourceContributor) GetPrimaryInstrumentType() string {
-	if x != nil {
-		return x.PrimaryInstrumentType
+	if x != nil && x.PrimaryInstrumentType != nil {
+		return *x.PrimaryInstrumentType
 	}
 	return ""
 }
@@ -11621,35 +11681,21 @@ func (x *DetailedResourceContributor) GetMembership() []*Membership {
 	return nil
 }
 
-func (x *DetailedResourceContributor) GetPartyId() []*PartyId {
-	if x != nil {
-		return x.PartyId
-	}
-	return nil
-}
-
-func (x *DetailedResourceContributor) GetPartyName() []*PartyName {
-	if x != nil {
-		return x.PartyName
-	}
-	return nil
-}
-
 func (x *DetailedResourceContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 type DistributionChannelType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"DistributionChannelType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"DistributionChannelType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11692,29 +11738,29 @@ func (x *DistributionChannelType) GetValue() string {
 }
 
 func (x *DistributionChannelType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DistributionChannelType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type DrmPlatformType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"DrmPlatformType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"DrmPlatformType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11757,22 +11803,22 @@ func (x *DrmPlatformType) GetValue() string {
 }
 
 func (x *DrmPlatformType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *DrmPlatformType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DrmPlatformType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11782,17 +11828,17 @@ type EventDate struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"TerritoryCode,attr"
-	TerritoryCode string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"TerritoryCode,attr" avs:"AllTerritoryCode"
+	TerritoryCode *string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3,oneof" json:"territory_code,omitempty" xml:"TerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11835,43 +11881,43 @@ func (x *EventDate) GetValue() string {
 }
 
 func (x *EventDate) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDate) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDate) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDate) GetTerritoryCode() string {
-	if x != nil {
-		return x.TerritoryCode
+	if x != nil && x.TerritoryCode != nil {
+		return *x.TerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDate) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDate) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -11881,17 +11927,17 @@ type EventDateTime struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
 	// @gotags: xml:"TerritoryCode,attr"
-	TerritoryCode string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode,attr"`
+	TerritoryCode *string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3,oneof" json:"territory_code,omitempty" xml:"TerritoryCode,attr"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11934,43 +11980,43 @@ func (x *EventDateTime) GetValue() string {
 }
 
 func (x *EventDateTime) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDateTime) GetTerritoryCode() string {
-	if x != nil {
-		return x.TerritoryCode
+	if x != nil && x.TerritoryCode != nil {
+		return *x.TerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -11978,31 +12024,31 @@ func (x *EventDateTime) GetLanguageAndScriptCode() string {
 type ExtendedResourceGroupContentItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"SequenceSubNumber"
-	SequenceSubNumber int32 `protobuf:"varint,2,opt,name=sequence_sub_number,json=sequenceSubNumber,proto3" json:"sequence_sub_number,omitempty" xml:"SequenceSubNumber"`
+	SequenceSubNumber *int32 `protobuf:"varint,2,opt,name=sequence_sub_number,json=sequenceSubNumber,proto3,oneof" json:"sequence_sub_number,omitempty" xml:"SequenceSubNumber"`
 	// @gotags: xml:"ResourceType"
 	ResourceType []*ResourceType `protobuf:"bytes,3,rep,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty" xml:"ResourceType"`
 	// @gotags: xml:"ReleaseResourceReference"
 	ReleaseResourceReference *ReleaseResourceReference `protobuf:"bytes,4,opt,name=release_resource_reference,json=releaseResourceReference,proto3" json:"release_resource_reference,omitempty" xml:"ReleaseResourceReference"`
 	// @gotags: xml:"LinkedReleaseResourceReference"
 	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,5,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty" xml:"LinkedReleaseResourceReference"`
+	// @gotags: xml:"ResourceGroupContentItemReleaseReference"
+	ResourceGroupContentItemReleaseReference string `protobuf:"bytes,11,opt,name=resource_group_content_item_release_reference,json=resourceGroupContentItemReleaseReference,proto3" json:"resource_group_content_item_release_reference,omitempty" xml:"ResourceGroupContentItemReleaseReference"`
+	// @gotags: xml:"ReleaseId"
+	ReleaseId *ReleaseId `protobuf:"bytes,12,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,6,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"IsHiddenResource"
-	IsHiddenResource bool `protobuf:"varint,7,opt,name=is_hidden_resource,json=isHiddenResource,proto3" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
+	IsHiddenResource *bool `protobuf:"varint,7,opt,name=is_hidden_resource,json=isHiddenResource,proto3,oneof" json:"is_hidden_resource,omitempty" xml:"IsHiddenResource"`
 	// @gotags: xml:"IsBonusResource"
-	IsBonusResource bool `protobuf:"varint,8,opt,name=is_bonus_resource,json=isBonusResource,proto3" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
+	IsBonusResource *bool `protobuf:"varint,8,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
 	// @gotags: xml:"IsInstantGratificationResource"
-	IsInstantGratificationResource bool `protobuf:"varint,9,opt,name=is_instant_gratification_resource,json=isInstantGratificationResource,proto3" json:"is_instant_gratification_resource,omitempty" xml:"IsInstantGratificationResource"`
+	IsInstantGratificationResource *bool `protobuf:"varint,9,opt,name=is_instant_gratification_resource,json=isInstantGratificationResource,proto3,oneof" json:"is_instant_gratification_resource,omitempty" xml:"IsInstantGratificationResource"`
 	// @gotags: xml:"IsPreOrderIncentiveResource"
-	IsPreOrderIncentiveResource bool `protobuf:"varint,10,opt,name=is_pre_order_incentive_resource,json=isPreOrderIncentiveResource,proto3" json:"is_pre_order_incentive_resource,omitempty" xml:"IsPreOrderIncentiveResource"`
-	// @gotags: xml:"ResourceGroupContentItemReleaseReference"
-	ResourceGroupContentItemReleaseReference string `protobuf:"bytes,11,opt,name=resource_group_content_item_release_reference,json=resourceGroupContentItemReleaseReference,proto3" json:"resource_group_content_item_release_reference,omitempty" xml:"ResourceGroupContentItemReleaseReference"`
-	// @gotags: xml:"ReleaseId"
-	ReleaseId     *ReleaseId `protobuf:"bytes,12,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	IsPreOrderIncentiveResource *bool `protobuf:"varint,10,opt,name=is_pre_order_incentive_resource,json=isPreOrderIncentiveResource,proto3,oneof" json:"is_pre_order_incentive_resource,omitempty" xml:"IsPreOrderIncentiveResource"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *ExtendedResourceGroupContentItem) Reset() {
@@ -12036,15 +12082,15 @@ func (*ExtendedResourceGroupContentItem) Descriptor() ([]byte, []int) {
 }
 
 func (x *ExtendedResourceGroupContentItem) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *ExtendedResourceGroupContentItem) GetSequenceSubNumber() int32 {
-	if x != nil {
-		return x.SequenceSubNumber
+	if x != nil && x.SequenceSubNumber != nil {
+		return *x.SequenceSubNumber
 	}
 	return 0
 }
@@ -12070,61 +12116,61 @@ func (x *ExtendedResourceGroupContentItem) GetLinkedReleaseResourceReference() [
 	return nil
 }
 
-func (x *ExtendedResourceGroupContentItem) GetDuration() string {
+func (x *ExtendedResourceGroupContentItem) GetResourceGroupContentItemReleaseReference() string {
 	if x != nil {
-		return x.Duration
+		return x.ResourceGroupContentItemReleaseReference
 	}
 	return ""
 }
 
-func (x *ExtendedResourceGroupContentItem) GetIsHiddenResource() bool {
+func (x *ExtendedResourceGroupContentItem) GetReleaseId() *ReleaseId {
 	if x != nil {
-		return x.IsHiddenResource
+		return x.ReleaseId
 	}
-	return false
+	return nil
 }
 
-func (x *ExtendedResourceGroupContentItem) GetIsBonusResource() bool {
-	if x != nil {
-		return x.IsBonusResource
+func (x *ExtendedResourceGroupContentItem) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
-	return false
+	return ""
 }
 
-func (x *ExtendedResourceGroupContentItem) GetIsInstantGratificationResource() bool {
-	if x != nil {
-		return x.IsInstantGratificationResource
+func (x *ExtendedResourceGroupContentItem) GetIsHiddenResource() bool {
+	if x != nil && x.IsHiddenResource != nil {
+		return *x.IsHiddenResource
 	}
 	return false
 }
 
-func (x *ExtendedResourceGroupContentItem) GetIsPreOrderIncentiveResource() bool {
-	if x != nil {
-		return x.IsPreOrderIncentiveResource
+func (x *ExtendedResourceGroupContentItem) GetIsBonusResource() bool {
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
 	}
 	return false
 }
 
-func (x *ExtendedResourceGroupContentItem) GetResourceGroupContentItemReleaseReference() string {
-	if x != nil {
-		return x.ResourceGroupContentItemReleaseReference
+func (x *ExtendedResourceGroupContentItem) GetIsInstantGratificationResource() bool {
+	if x != nil && x.IsInstantGratificationResource != nil {
+		return *x.IsInstantGratificationResource
 	}
-	return ""
+	return false
 }
 
-func (x *ExtendedResourceGroupContentItem) GetReleaseId() *ReleaseId {
-	if x != nil {
-		return x.ReleaseId
+func (x *ExtendedResourceGroupContentItem) GetIsPreOrderIncentiveResource() bool {
+	if x != nil && x.IsPreOrderIncentiveResource != nil {
+		return *x.IsPreOrderIncentiveResource
 	}
-	return nil
+	return false
 }
 
 type Extent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfExtent"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfExtent"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12167,8 +12213,8 @@ func (x *Extent) GetValue() string {
 }
 
 func (x *Extent) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
@@ -12180,11 +12226,11 @@ type ExternalResourceLink struct {
 	// @gotags: xml:"ValidityPeriod"
 	ValidityPeriod *Period `protobuf:"bytes,2,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
 	// @gotags: xml:"ExternalLink"
-	ExternalLink string `protobuf:"bytes,3,opt,name=external_link,json=externalLink,proto3" json:"external_link,omitempty" xml:"ExternalLink"`
+	ExternalLink *string `protobuf:"bytes,3,opt,name=external_link,json=externalLink,proto3,oneof" json:"external_link,omitempty" xml:"ExternalLink"`
 	// @gotags: xml:"ExternallyLinkedResourceType"
 	ExternallyLinkedResourceType []*ExternallyLinkedResourceType `protobuf:"bytes,4,rep,name=externally_linked_resource_type,json=externallyLinkedResourceType,proto3" json:"externally_linked_resource_type,omitempty" xml:"ExternallyLinkedResourceType"`
 	// @gotags: xml:"FileFormat"
-	FileFormat    string `protobuf:"bytes,5,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty" xml:"FileFormat"`
+	FileFormat    *string `protobuf:"bytes,5,opt,name=file_format,json=fileFormat,proto3,oneof" json:"file_format,omitempty" xml:"FileFormat"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12234,8 +12280,8 @@ func (x *ExternalResourceLink) GetValidityPeriod() *Period {
 }
 
 func (x *ExternalResourceLink) GetExternalLink() string {
-	if x != nil {
-		return x.ExternalLink
+	if x != nil && x.ExternalLink != nil {
+		return *x.ExternalLink
 	}
 	return ""
 }
@@ -12248,20 +12294,20 @@ func (x *ExternalResourceLink) GetExternallyLinkedResourceType() []*ExternallyLi
 }
 
 func (x *ExternalResourceLink) GetFileFormat() string {
-	if x != nil {
-		return x.FileFormat
+	if x != nil && x.FileFormat != nil {
+		return *x.FileFormat
 	}
 	return ""
 }
 
 type ExternallyLinkedResourceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ExternallyLinkedResourceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ExternallyLinkedResourceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12304,29 +12350,29 @@ func (x *ExternallyLinkedResourceType) GetValue() string {
 }
 
 func (x *ExternallyLinkedResourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ExternallyLinkedResourceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type File struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"HashSum"
-	HashSum *HashSum `protobuf:"bytes,1,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty" xml:"HashSum"`
 	// @gotags: xml:"URL"
 	URL string `protobuf:"bytes,2,opt,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"FileName"
 	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty" xml:"FileName"`
 	// @gotags: xml:"FilePath"
-	FilePath      string `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty" xml:"FilePath"`
+	FilePath *string `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3,oneof" json:"file_path,omitempty" xml:"FilePath"`
+	// @gotags: xml:"HashSum"
+	HashSum       *HashSum `protobuf:"bytes,1,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty" xml:"HashSum"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12361,42 +12407,42 @@ func (*File) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *File) GetHashSum() *HashSum {
+func (x *File) GetURL() string {
 	if x != nil {
-		return x.HashSum
+		return x.URL
 	}
-	return nil
+	return ""
 }
 
-func (x *File) GetURL() string {
+func (x *File) GetFileName() string {
 	if x != nil {
-		return x.URL
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *File) GetFileName() string {
-	if x != nil {
-		return x.FileName
+func (x *File) GetFilePath() string {
+	if x != nil && x.FilePath != nil {
+		return *x.FilePath
 	}
 	return ""
 }
 
-func (x *File) GetFilePath() string {
+func (x *File) GetHashSum() *HashSum {
 	if x != nil {
-		return x.FilePath
+		return x.HashSum
 	}
-	return ""
+	return nil
 }
 
 type FingerprintAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"FingerprintAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"FingerprintAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12439,15 +12485,15 @@ func (x *FingerprintAlgorithmType) GetValue() string {
 }
 
 func (x *FingerprintAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *FingerprintAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -12456,8 +12502,8 @@ type FrameRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfFrameRate"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfFrameRate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12500,8 +12546,8 @@ func (x *FrameRate) GetValue() string {
 }
 
 func (x *FrameRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
@@ -12567,7 +12613,7 @@ type Genre struct {
 	// @gotags: xml:"SubGenre"
 	SubGenre *Description `protobuf:"bytes,2,opt,name=sub_genre,json=subGenre,proto3" json:"sub_genre,omitempty" xml:"SubGenre"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -12617,20 +12663,20 @@ func (x *Genre) GetSubGenre() *Description {
 }
 
 func (x *Genre) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type GoverningAgreementType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"GoverningAgreementType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"GoverningAgreementType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12673,15 +12719,15 @@ func (x *GoverningAgreementType) GetValue() string {
 }
 
 func (x *GoverningAgreementType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *GoverningAgreementType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -12692,8 +12738,8 @@ type HashSum struct {
 	HashSum string `protobuf:"bytes,1,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty" xml:"HashSum"`
 	// @gotags: xml:"HashSumAlgorithmType"
 	HashSumAlgorithmType *HashSumAlgorithmType `protobuf:"bytes,2,opt,name=hash_sum_algorithm_type,json=hashSumAlgorithmType,proto3" json:"hash_sum_algorithm_type,omitempty" xml:"HashSumAlgorithmType"`
-	// @gotags: xml:"HashSumDataType"
-	HashSumDataType string `protobuf:"bytes,3,opt,name=hash_sum_data_type,json=hashSumDataType,proto3" json:"hash_sum_data_type,omitempty" xml:"HashSumDataType"`
+	// @gotags: xml:"HashSumDataType" avs:"BinaryDataType"
+	HashSumDataType *string `protobuf:"bytes,3,opt,name=hash_sum_data_type,json=hashSumDataType,proto3,oneof" json:"hash_sum_data_type,omitempty" xml:"HashSumDataType" avs:"BinaryDataType"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -12743,20 +12789,20 @@ func (x *HashSum) GetHashSumAlgorithmType() *HashSumAlgorithmType {
 }
 
 func (x *HashSum) GetHashSumDataType() string {
-	if x != nil {
-		return x.HashSumDataType
+	if x != nil && x.HashSumDataType != nil {
+		return *x.HashSumDataType
 	}
 	return ""
 }
 
 type HashSumAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"HashSumAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"HashSumAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12799,15 +12845,15 @@ func (x *HashSumAlgorithmType) GetValue() string {
 }
 
 func (x *HashSumAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *HashSumAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -12825,9 +12871,9 @@ type HostSoundCarrier struct {
 	// @gotags: xml:"AdministratingRecordCompany"
 	AdministratingRecordCompany []*AdministratingRecordCompany `protobuf:"bytes,5,rep,name=administrating_record_company,json=administratingRecordCompany,proto3" json:"administrating_record_company,omitempty" xml:"AdministratingRecordCompany"`
 	// @gotags: xml:"TrackNumber"
-	TrackNumber string `protobuf:"bytes,6,opt,name=track_number,json=trackNumber,proto3" json:"track_number,omitempty" xml:"TrackNumber"`
+	TrackNumber *string `protobuf:"bytes,6,opt,name=track_number,json=trackNumber,proto3,oneof" json:"track_number,omitempty" xml:"TrackNumber"`
 	// @gotags: xml:"VolumeNumberInSet"
-	VolumeNumberInSet string `protobuf:"bytes,7,opt,name=volume_number_in_set,json=volumeNumberInSet,proto3" json:"volume_number_in_set,omitempty" xml:"VolumeNumberInSet"`
+	VolumeNumberInSet *string `protobuf:"bytes,7,opt,name=volume_number_in_set,json=volumeNumberInSet,proto3,oneof" json:"volume_number_in_set,omitempty" xml:"VolumeNumberInSet"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -12898,15 +12944,15 @@ func (x *HostSoundCarrier) GetAdministratingRecordCompany() []*AdministratingRec
 }
 
 func (x *HostSoundCarrier) GetTrackNumber() string {
-	if x != nil {
-		return x.TrackNumber
+	if x != nil && x.TrackNumber != nil {
+		return *x.TrackNumber
 	}
 	return ""
 }
 
 func (x *HostSoundCarrier) GetVolumeNumberInSet() string {
-	if x != nil {
-		return x.VolumeNumberInSet
+	if x != nil && x.VolumeNumberInSet != nil {
+		return *x.VolumeNumberInSet
 	}
 	return ""
 }
@@ -12916,7 +12962,7 @@ type ICPN struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsEan,attr"
-	IsEan         bool `protobuf:"varint,2,opt,name=is_ean,json=isEan,proto3" json:"is_ean,omitempty" xml:"IsEan,attr"`
+	IsEan         *bool `protobuf:"varint,2,opt,name=is_ean,json=isEan,proto3,oneof" json:"is_ean,omitempty" xml:"IsEan,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12959,22 +13005,22 @@ func (x *ICPN) GetValue() string {
 }
 
 func (x *ICPN) GetIsEan() bool {
-	if x != nil {
-		return x.IsEan
+	if x != nil && x.IsEan != nil {
+		return *x.IsEan
 	}
 	return false
 }
 
 type ImageCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13017,34 +13063,34 @@ func (x *ImageCodecType) GetValue() string {
 }
 
 func (x *ImageCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *ImageCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ImageType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13087,31 +13133,31 @@ func (x *ImageType) GetValue() string {
 }
 
 func (x *ImageType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type IndirectResourceContributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"IndirectResourceContributorRole"
-	IndirectResourceContributorRole []*MusicalWorkContributorRole `protobuf:"bytes,1,rep,name=indirect_resource_contributor_role,json=indirectResourceContributorRole,proto3" json:"indirect_resource_contributor_role,omitempty" xml:"IndirectResourceContributorRole"`
-	// @gotags: xml:"Nationality"
-	Nationality []DdexCCurrentTerritoryCode `protobuf:"varint,2,rep,packed,name=nationality,proto3,enum=ddex.ern.v383.DdexCCurrentTerritoryCode" json:"nationality,omitempty" xml:"Nationality"`
 	// @gotags: xml:"PartyId"
 	PartyId []*PartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"IndirectResourceContributorRole"
+	IndirectResourceContributorRole []*MusicalWorkContributorRole `protobuf:"bytes,1,rep,name=indirect_resource_contributor_role,json=indirectResourceContributorRole,proto3" json:"indirect_resource_contributor_role,omitempty" xml:"IndirectResourceContributorRole"`
+	// @gotags: xml:"Nationality"
+	Nationality []DdexCCurrentTerritoryCode `protobuf:"varint,2,rep,packed,name=nationality,proto3,enum=ddex.ern.v383.DdexCCurrentTerritoryCode" json:"nationality,omitempty" xml:"Nationality"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -13146,37 +13192,37 @@ func (*IndirectResourceContributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *IndirectResourceContributor) GetIndirectResourceContributorRole() []*MusicalWorkContributorRole {
+func (x *IndirectResourceContributor) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.IndirectResourceContributorRole
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *IndirectResourceContributor) GetNationality() []DdexCCurrentTerritoryCode {
+func (x *IndirectResourceContributor) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.Nationality
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *IndirectResourceContributor) GetPartyId() []*PartyId {
+func (x *IndirectResourceContributor) GetIndirectResourceContributorRole() []*MusicalWorkContributorRole {
 	if x != nil {
-		return x.PartyId
+		return x.IndirectResourceContributorRole
 	}
 	return nil
 }
 
-func (x *IndirectResourceContributor) GetPartyName() []*PartyName {
+func (x *IndirectResourceContributor) GetNationality() []DdexCCurrentTerritoryCode {
 	if x != nil {
-		return x.PartyName
+		return x.Nationality
 	}
 	return nil
 }
 
 func (x *IndirectResourceContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -13186,7 +13232,7 @@ type Keywords struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13229,8 +13275,8 @@ func (x *Keywords) GetValue() string {
 }
 
 func (x *Keywords) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13240,13 +13286,13 @@ type LabelName struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"LabelNameType,attr"
-	LabelNameType string `protobuf:"bytes,3,opt,name=label_name_type,json=labelNameType,proto3" json:"label_name_type,omitempty" xml:"LabelNameType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"LabelNameType,attr" avs:"LabelNameType"
+	LabelNameType *string `protobuf:"bytes,3,opt,name=label_name_type,json=labelNameType,proto3,oneof" json:"label_name_type,omitempty" xml:"LabelNameType,attr" avs:"LabelNameType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,5,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,5,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13289,29 +13335,29 @@ func (x *LabelName) GetValue() string {
 }
 
 func (x *LabelName) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *LabelName) GetLabelNameType() string {
-	if x != nil {
-		return x.LabelNameType
+	if x != nil && x.LabelNameType != nil {
+		return *x.LabelNameType
 	}
 	return ""
 }
 
 func (x *LabelName) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *LabelName) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13321,9 +13367,9 @@ type LinkedReleaseResourceReference struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LinkDescription,attr"
-	LinkDescription string `protobuf:"bytes,2,opt,name=link_description,json=linkDescription,proto3" json:"link_description,omitempty" xml:"LinkDescription,attr"`
+	LinkDescription *string `protobuf:"bytes,2,opt,name=link_description,json=linkDescription,proto3,oneof" json:"link_description,omitempty" xml:"LinkDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13366,15 +13412,15 @@ func (x *LinkedReleaseResourceReference) GetValue() string {
 }
 
 func (x *LinkedReleaseResourceReference) GetLinkDescription() string {
-	if x != nil {
-		return x.LinkDescription
+	if x != nil && x.LinkDescription != nil {
+		return *x.LinkDescription
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13383,12 +13429,12 @@ type Membership struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Organization"
 	Organization *PartyDescriptor `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty" xml:"Organization"`
-	// @gotags: xml:"MembershipType"
-	MembershipType string `protobuf:"bytes,2,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty" xml:"MembershipType"`
+	// @gotags: xml:"MembershipType" avs:"MembershipType"
+	MembershipType string `protobuf:"bytes,2,opt,name=membership_type,json=membershipType,proto3" json:"membership_type,omitempty" xml:"MembershipType" avs:"MembershipType"`
 	// @gotags: xml:"StartDate"
-	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty" xml:"StartDate"`
+	StartDate *string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty" xml:"StartDate"`
 	// @gotags: xml:"EndDate"
-	EndDate       string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
+	EndDate       *string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty" xml:"EndDate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13438,15 +13484,15 @@ func (x *Membership) GetMembershipType() string {
 }
 
 func (x *Membership) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
 	return ""
 }
 
 func (x *Membership) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
@@ -13456,7 +13502,7 @@ type MessageAuditTrail struct {
 	// @gotags: xml:"MessageAuditTrailEvent"
 	MessageAuditTrailEvent []*MessageAuditTrailEvent `protobuf:"bytes,1,rep,name=message_audit_trail_event,json=messageAuditTrailEvent,proto3" json:"message_audit_trail_event,omitempty" xml:"MessageAuditTrailEvent"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13499,8 +13545,8 @@ func (x *MessageAuditTrail) GetMessageAuditTrailEvent() []*MessageAuditTrailEven
 }
 
 func (x *MessageAuditTrail) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13562,11 +13608,11 @@ func (x *MessageAuditTrailEvent) GetDateTime() string {
 type MessageHeader struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageThreadId"
-	MessageThreadId string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
+	MessageThreadId *string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3,oneof" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
 	// @gotags: xml:"MessageId"
 	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty" xml:"MessageId"`
 	// @gotags: xml:"MessageFileName"
-	MessageFileName string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3" json:"message_file_name,omitempty" xml:"MessageFileName"`
+	MessageFileName *string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3,oneof" json:"message_file_name,omitempty" xml:"MessageFileName"`
 	// @gotags: xml:"MessageSender"
 	MessageSender *MessagingParty `protobuf:"bytes,4,opt,name=message_sender,json=messageSender,proto3" json:"message_sender,omitempty" xml:"MessageSender"`
 	// @gotags: xml:"SentOnBehalfOf"
@@ -13579,10 +13625,10 @@ type MessageHeader struct {
 	MessageAuditTrail *MessageAuditTrail `protobuf:"bytes,8,opt,name=message_audit_trail,json=messageAuditTrail,proto3" json:"message_audit_trail,omitempty" xml:"MessageAuditTrail"`
 	// @gotags: xml:"Comment"
 	Comment *Comment `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty" xml:"Comment"`
-	// @gotags: xml:"MessageControlType"
-	MessageControlType string `protobuf:"bytes,10,opt,name=message_control_type,json=messageControlType,proto3" json:"message_control_type,omitempty" xml:"MessageControlType"`
+	// @gotags: xml:"MessageControlType" avs:"MessageControlType"
+	MessageControlType *string `protobuf:"bytes,10,opt,name=message_control_type,json=messageControlType,proto3,oneof" json:"message_control_type,omitempty" xml:"MessageControlType" avs:"MessageControlType"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,11,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,11,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13618,8 +13664,8 @@ func (*MessageHeader) Descriptor() ([]byte, []int) {
 }
 
 func (x *MessageHeader) GetMessageThreadId() string {
-	if x != nil {
-		return x.MessageThreadId
+	if x != nil && x.MessageThreadId != nil {
+		return *x.MessageThreadId
 	}
 	return ""
 }
@@ -13632,8 +13678,8 @@ func (x *MessageHeader) GetMessageId() string {
 }
 
 func (x *MessageHeader) GetMessageFileName() string {
-	if x != nil {
-		return x.MessageFileName
+	if x != nil && x.MessageFileName != nil {
+		return *x.MessageFileName
 	}
 	return ""
 }
@@ -13681,15 +13727,15 @@ func (x *MessageHeader) GetComment() *Comment {
 }
 
 func (x *MessageHeader) GetMessageControlType() string {
-	if x != nil {
-		return x.MessageControlType
+	if x != nil && x.MessageControlType != nil {
+		return *x.MessageControlType
 	}
 	return ""
 }
 
 func (x *MessageHeader) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13703,7 +13749,7 @@ type MessagingParty struct {
 	// @gotags: xml:"TradingName"
 	TradingName *Name `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13760,20 +13806,20 @@ func (x *MessagingParty) GetTradingName() *Name {
 }
 
 func (x *MessagingParty) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type MidiType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"MidiType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"MidiType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13816,15 +13862,15 @@ func (x *MidiType) GetValue() string {
 }
 
 func (x *MidiType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *MidiType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13848,9 +13894,9 @@ type MusicalWork struct {
 	// @gotags: xml:"MusicalWorkDetailsByTerritory"
 	MusicalWorkDetailsByTerritory []*MusicalWorkDetailsByTerritory `protobuf:"bytes,8,rep,name=musical_work_details_by_territory,json=musicalWorkDetailsByTerritory,proto3" json:"musical_work_details_by_territory,omitempty" xml:"MusicalWorkDetailsByTerritory"`
 	// @gotags: xml:"IsUpdated,attr"
-	IsUpdated bool `protobuf:"varint,9,opt,name=is_updated,json=isUpdated,proto3" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
+	IsUpdated *bool `protobuf:"varint,9,opt,name=is_updated,json=isUpdated,proto3,oneof" json:"is_updated,omitempty" xml:"IsUpdated,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,10,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,10,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13942,31 +13988,31 @@ func (x *MusicalWork) GetMusicalWorkDetailsByTerritory() []*MusicalWorkDetailsBy
 }
 
 func (x *MusicalWork) GetIsUpdated() bool {
-	if x != nil {
-		return x.IsUpdated
+	if x != nil && x.IsUpdated != nil {
+		return *x.IsUpdated
 	}
 	return false
 }
 
 func (x *MusicalWork) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type MusicalWorkContributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"MusicalWorkContributorRole"
-	MusicalWorkContributorRole []*MusicalWorkContributorRole `protobuf:"bytes,1,rep,name=musical_work_contributor_role,json=musicalWorkContributorRole,proto3" json:"musical_work_contributor_role,omitempty" xml:"MusicalWorkContributorRole"`
-	// @gotags: xml:"SocietyAffiliation"
-	SocietyAffiliation []*SocietyAffiliation `protobuf:"bytes,2,rep,name=society_affiliation,json=societyAffiliation,proto3" json:"society_affiliation,omitempty" xml:"SocietyAffiliation"`
 	// @gotags: xml:"PartyId"
 	PartyId []*PartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"MusicalWorkContributorRole"
+	MusicalWorkContributorRole []*MusicalWorkContributorRole `protobuf:"bytes,1,rep,name=musical_work_contributor_role,json=musicalWorkContributorRole,proto3" json:"musical_work_contributor_role,omitempty" xml:"MusicalWorkContributorRole"`
+	// @gotags: xml:"SocietyAffiliation"
+	SocietyAffiliation []*SocietyAffiliation `protobuf:"bytes,2,rep,name=society_affiliation,json=societyAffiliation,proto3" json:"society_affiliation,omitempty" xml:"SocietyAffiliation"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -14001,49 +14047,49 @@ func (*MusicalWorkContributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *MusicalWorkContributor) GetMusicalWorkContributorRole() []*MusicalWorkContributorRole {
+func (x *MusicalWorkContributor) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.MusicalWorkContributorRole
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *MusicalWorkContributor) GetSocietyAffiliation() []*SocietyAffiliation {
+func (x *MusicalWorkContributor) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.SocietyAffiliation
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *MusicalWorkContributor) GetPartyId() []*PartyId {
+func (x *MusicalWorkContributor) GetMusicalWorkContributorRole() []*MusicalWorkContributorRole {
 	if x != nil {
-		return x.PartyId
+		return x.MusicalWorkContributorRole
 	}
 	return nil
 }
 
-func (x *MusicalWorkContributor) GetPartyName() []*PartyName {
+func (x *MusicalWorkContributor) GetSocietyAffiliation() []*SocietyAffiliation {
 	if x != nil {
-		return x.PartyName
+		return x.SocietyAffiliation
 	}
 	return nil
 }
 
 func (x *MusicalWorkContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 type MusicalWorkContributorRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"MusicalWorkContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"MusicalWorkContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14086,31 +14132,31 @@ func (x *MusicalWorkContributorRole) GetValue() string {
 }
 
 func (x *MusicalWorkContributorRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *MusicalWorkContributorRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type MusicalWorkDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"MusicalWorkContributor"
-	MusicalWorkContributor []*MusicalWorkContributor `protobuf:"bytes,1,rep,name=musical_work_contributor,json=musicalWorkContributor,proto3" json:"musical_work_contributor,omitempty" xml:"MusicalWorkContributor"`
-	// @gotags: xml:"DisplayArtistName"
-	DisplayArtistName []*Name `protobuf:"bytes,2,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty" xml:"DisplayArtistName"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,3,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,4,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
+	// @gotags: xml:"MusicalWorkContributor"
+	MusicalWorkContributor []*MusicalWorkContributor `protobuf:"bytes,1,rep,name=musical_work_contributor,json=musicalWorkContributor,proto3" json:"musical_work_contributor,omitempty" xml:"MusicalWorkContributor"`
+	// @gotags: xml:"DisplayArtistName"
+	DisplayArtistName []*Name `protobuf:"bytes,2,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty" xml:"DisplayArtistName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -14145,37 +14191,37 @@ func (*MusicalWorkDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *MusicalWorkDetailsByTerritory) GetMusicalWorkContributor() []*MusicalWorkContributor {
+func (x *MusicalWorkDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.MusicalWorkContributor
+		return x.TerritoryCode
 	}
 	return nil
 }
 
-func (x *MusicalWorkDetailsByTerritory) GetDisplayArtistName() []*Name {
+func (x *MusicalWorkDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.DisplayArtistName
+		return x.ExcludedTerritoryCode
 	}
 	return nil
 }
 
-func (x *MusicalWorkDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *MusicalWorkDetailsByTerritory) GetMusicalWorkContributor() []*MusicalWorkContributor {
 	if x != nil {
-		return x.TerritoryCode
+		return x.MusicalWorkContributor
 	}
 	return nil
 }
 
-func (x *MusicalWorkDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+func (x *MusicalWorkDetailsByTerritory) GetDisplayArtistName() []*Name {
 	if x != nil {
-		return x.ExcludedTerritoryCode
+		return x.DisplayArtistName
 	}
 	return nil
 }
 
 func (x *MusicalWorkDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -14183,15 +14229,15 @@ func (x *MusicalWorkDetailsByTerritory) GetLanguageAndScriptCode() string {
 type MusicalWorkId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"OpusNumber"
-	OpusNumber string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3" json:"opus_number,omitempty" xml:"OpusNumber"`
+	OpusNumber *string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3,oneof" json:"opus_number,omitempty" xml:"OpusNumber"`
 	// @gotags: xml:"ComposerCatalogNumber"
 	ComposerCatalogNumber []string `protobuf:"bytes,3,rep,name=composer_catalog_number,json=composerCatalogNumber,proto3" json:"composer_catalog_number,omitempty" xml:"ComposerCatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14227,15 +14273,15 @@ func (*MusicalWorkId) Descriptor() ([]byte, []int) {
 }
 
 func (x *MusicalWorkId) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *MusicalWorkId) GetOpusNumber() string {
-	if x != nil {
-		return x.OpusNumber
+	if x != nil && x.OpusNumber != nil {
+		return *x.OpusNumber
 	}
 	return ""
 }
@@ -14255,20 +14301,20 @@ func (x *MusicalWorkId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *MusicalWorkId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type MusicalWorkType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"MusicalWorkType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"MusicalWorkType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14311,15 +14357,15 @@ func (x *MusicalWorkType) GetValue() string {
 }
 
 func (x *MusicalWorkType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *MusicalWorkType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -14329,7 +14375,7 @@ type Name struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -14372,22 +14418,22 @@ func (x *Name) GetValue() string {
 }
 
 func (x *Name) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type OperatingSystemType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"OperatingSystemType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"OperatingSystemType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14430,22 +14476,22 @@ func (x *OperatingSystemType) GetValue() string {
 }
 
 func (x *OperatingSystemType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *OperatingSystemType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *OperatingSystemType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -14453,15 +14499,15 @@ func (x *OperatingSystemType) GetUserDefinedValue() string {
 type PLine struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"PLineCompany"
-	PLineCompany string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3" json:"p_line_company,omitempty" xml:"PLineCompany"`
+	PLineCompany *string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3,oneof" json:"p_line_company,omitempty" xml:"PLineCompany"`
 	// @gotags: xml:"PLineText"
 	PLineText string `protobuf:"bytes,3,opt,name=p_line_text,json=pLineText,proto3" json:"p_line_text,omitempty" xml:"PLineText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"PLineType,attr"
-	PLineType     string `protobuf:"bytes,5,opt,name=p_line_type,json=pLineType,proto3" json:"p_line_type,omitempty" xml:"PLineType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"PLineType,attr" avs:"PLineType"
+	PLineType     *string `protobuf:"bytes,5,opt,name=p_line_type,json=pLineType,proto3,oneof" json:"p_line_type,omitempty" xml:"PLineType,attr" avs:"PLineType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14497,15 +14543,15 @@ func (*PLine) Descriptor() ([]byte, []int) {
 }
 
 func (x *PLine) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *PLine) GetPLineCompany() string {
-	if x != nil {
-		return x.PLineCompany
+	if x != nil && x.PLineCompany != nil {
+		return *x.PLineCompany
 	}
 	return ""
 }
@@ -14518,27 +14564,27 @@ func (x *PLine) GetPLineText() string {
 }
 
 func (x *PLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PLine) GetPLineType() string {
-	if x != nil {
-		return x.PLineType
+	if x != nil && x.PLineType != nil {
+		return *x.PLineType
 	}
 	return ""
 }
 
 type ParentalWarningType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ParentalWarningType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ParentalWarningType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14581,15 +14627,15 @@ func (x *ParentalWarningType) GetValue() string {
 }
 
 func (x *ParentalWarningType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ParentalWarningType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -14653,11 +14699,11 @@ type PartyId struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"IsDPID,attr"
-	IsDPID bool `protobuf:"varint,3,opt,name=is_d_p_i_d,json=isDPID,proto3" json:"is_d_p_i_d,omitempty" xml:"IsDPID,attr"`
+	IsDPID *bool `protobuf:"varint,3,opt,name=is_d_p_i_d,json=isDPID,proto3,oneof" json:"is_d_p_i_d,omitempty" xml:"IsDPID,attr"`
 	// @gotags: xml:"IsISNI,attr"
-	IsISNI        bool `protobuf:"varint,4,opt,name=is_i_s_n_i,json=isISNI,proto3" json:"is_i_s_n_i,omitempty" xml:"IsISNI,attr"`
+	IsISNI        *bool `protobuf:"varint,4,opt,name=is_i_s_n_i,json=isISNI,proto3,oneof" json:"is_i_s_n_i,omitempty" xml:"IsISNI,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14700,22 +14746,22 @@ func (x *PartyId) GetValue() string {
 }
 
 func (x *PartyId) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyId) GetIsDPID() bool {
-	if x != nil {
-		return x.IsDPID
+	if x != nil && x.IsDPID != nil {
+		return *x.IsDPID
 	}
 	return false
 }
 
 func (x *PartyId) GetIsISNI() bool {
-	if x != nil {
-		return x.IsISNI
+	if x != nil && x.IsISNI != nil {
+		return *x.IsISNI
 	}
 	return false
 }
@@ -14725,7 +14771,7 @@ type PartyName struct {
 	// @gotags: xml:"FullName"
 	FullName *Name `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
 	FullNameIndexed *Name `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
@@ -14737,7 +14783,7 @@ type PartyName struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *Name `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -14780,8 +14826,8 @@ func (x *PartyName) GetFullName() *Name {
 }
 
 func (x *PartyName) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
@@ -14822,8 +14868,8 @@ func (x *PartyName) GetAbbreviatedName() *Name {
 }
 
 func (x *PartyName) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -14833,7 +14879,7 @@ type Percentage struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"HasMaxValueOfOne,attr"
-	HasMaxValueOfOne bool `protobuf:"varint,2,opt,name=has_max_value_of_one,json=hasMaxValueOfOne,proto3" json:"has_max_value_of_one,omitempty" xml:"HasMaxValueOfOne,attr"`
+	HasMaxValueOfOne *bool `protobuf:"varint,2,opt,name=has_max_value_of_one,json=hasMaxValueOfOne,proto3,oneof" json:"has_max_value_of_one,omitempty" xml:"HasMaxValueOfOne,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14876,8 +14922,8 @@ func (x *Percentage) GetValue() string {
 }
 
 func (x *Percentage) GetHasMaxValueOfOne() bool {
-	if x != nil {
-		return x.HasMaxValueOfOne
+	if x != nil && x.HasMaxValueOfOne != nil {
+		return *x.HasMaxValueOfOne
 	}
 	return false
 }
@@ -15012,8 +15058,8 @@ type Price struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"CurrencyCode,attr"
-	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty" xml:"CurrencyCode,attr"`
+	// @gotags: xml:"CurrencyCode,attr" avs:"CurrencyCode"
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty" xml:"CurrencyCode,attr" avs:"CurrencyCode"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15064,8 +15110,8 @@ func (x *Price) GetCurrencyCode() string {
 
 type PriceRangeType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PriceRangeType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PriceRangeType"`
 	// @gotags: xml:"Namespace,attr"
 	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
 	unknownFields protoimpl.UnknownFields
@@ -15118,8 +15164,8 @@ func (x *PriceRangeType) GetNamespace() string {
 
 type PriceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PriceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PriceType"`
 	// @gotags: xml:"Namespace,attr"
 	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
 	unknownFields protoimpl.UnknownFields
@@ -15175,7 +15221,7 @@ type PromotionalCode struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace     *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15218,8 +15264,8 @@ func (x *PromotionalCode) GetValue() string {
 }
 
 func (x *PromotionalCode) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
@@ -15280,12 +15326,12 @@ func (x *ProprietaryId) GetNamespace() string {
 
 type Purpose struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Purpose"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Purpose"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15328,27 +15374,27 @@ func (x *Purpose) GetValue() string {
 }
 
 func (x *Purpose) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *Purpose) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RatingAgency struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RatingAgency"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RatingAgency"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15391,15 +15437,15 @@ func (x *RatingAgency) GetValue() string {
 }
 
 func (x *RatingAgency) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RatingAgency) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -15409,7 +15455,7 @@ type Reason struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15452,20 +15498,20 @@ func (x *Reason) GetValue() string {
 }
 
 func (x *Reason) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type ReasonType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReasonType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReasonType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15508,15 +15554,15 @@ func (x *ReasonType) GetValue() string {
 }
 
 func (x *ReasonType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReasonType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -15528,7 +15574,7 @@ type ReferenceTitle struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle *SubTitle `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15578,8 +15624,8 @@ func (x *ReferenceTitle) GetSubTitle() *SubTitle {
 }
 
 func (x *ReferenceTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -15601,7 +15647,7 @@ type RelatedRelease struct {
 	// @gotags: xml:"OriginalReleaseDate"
 	OriginalReleaseDate *EventDate `protobuf:"bytes,7,opt,name=original_release_date,json=originalReleaseDate,proto3" json:"original_release_date,omitempty" xml:"OriginalReleaseDate"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15686,8 +15732,8 @@ func (x *RelatedRelease) GetOriginalReleaseDate() *EventDate {
 }
 
 func (x *RelatedRelease) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -15696,8 +15742,8 @@ type ReleaseCollectionReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ReleaseResourceType,attr"
-	ReleaseResourceType string `protobuf:"bytes,2,opt,name=release_resource_type,json=releaseResourceType,proto3" json:"release_resource_type,omitempty" xml:"ReleaseResourceType,attr"`
+	// @gotags: xml:"ReleaseResourceType,attr" avs:"ReleaseResourceType"
+	ReleaseResourceType *string `protobuf:"bytes,2,opt,name=release_resource_type,json=releaseResourceType,proto3,oneof" json:"release_resource_type,omitempty" xml:"ReleaseResourceType,attr" avs:"ReleaseResourceType"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -15740,8 +15786,8 @@ func (x *ReleaseCollectionReference) GetValue() string {
 }
 
 func (x *ReleaseCollectionReference) GetReleaseResourceType() string {
-	if x != nil {
-		return x.ReleaseResourceType
+	if x != nil && x.ReleaseResourceType != nil {
+		return *x.ReleaseResourceType
 	}
 	return ""
 }
@@ -15749,7 +15795,7 @@ func (x *ReleaseCollectionReference) GetReleaseResourceType() string {
 type ReleaseCollectionReferenceList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"NumberOfCollections"
-	NumberOfCollections int32 `protobuf:"varint,1,opt,name=number_of_collections,json=numberOfCollections,proto3" json:"number_of_collections,omitempty" xml:"NumberOfCollections"`
+	NumberOfCollections *int32 `protobuf:"varint,1,opt,name=number_of_collections,json=numberOfCollections,proto3,oneof" json:"number_of_collections,omitempty" xml:"NumberOfCollections"`
 	// @gotags: xml:"ReleaseCollectionReference"
 	ReleaseCollectionReference []*ReleaseCollectionReference `protobuf:"bytes,2,rep,name=release_collection_reference,json=releaseCollectionReference,proto3" json:"release_collection_reference,omitempty" xml:"ReleaseCollectionReference"`
 	unknownFields              protoimpl.UnknownFields
@@ -15787,8 +15833,8 @@ func (*ReleaseCollectionReferenceList) Descriptor() ([]byte, []int) {
 }
 
 func (x *ReleaseCollectionReferenceList) GetNumberOfCollections() int32 {
-	if x != nil {
-		return x.NumberOfCollections
+	if x != nil && x.NumberOfCollections != nil {
+		return *x.NumberOfCollections
 	}
 	return 0
 }
@@ -15803,9 +15849,9 @@ func (x *ReleaseCollectionReferenceList) GetReleaseCollectionReference() []*Rele
 type ReleaseId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ICPN"
 	ICPN *ICPN `protobuf:"bytes,3,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"CatalogNumber"
@@ -15813,7 +15859,7 @@ type ReleaseId struct {
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,5,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,6,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,6,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15849,15 +15895,15 @@ func (*ReleaseId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ReleaseId) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *ReleaseId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
@@ -15884,20 +15930,20 @@ func (x *ReleaseId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *ReleaseId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type ReleaseRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReleaseRelationshipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReleaseRelationshipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15940,15 +15986,15 @@ func (x *ReleaseRelationshipType) GetValue() string {
 }
 
 func (x *ReleaseRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -15957,8 +16003,8 @@ type ReleaseResourceReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ReleaseResourceType,attr"
-	ReleaseResourceType string `protobuf:"bytes,2,opt,name=release_resource_type,json=releaseResourceType,proto3" json:"release_resource_type,omitempty" xml:"ReleaseResourceType,attr"`
+	// @gotags: xml:"ReleaseResourceType,attr" avs:"ReleaseResourceType"
+	ReleaseResourceType *string `protobuf:"bytes,2,opt,name=release_resource_type,json=releaseResourceType,proto3,oneof" json:"release_resource_type,omitempty" xml:"ReleaseResourceType,attr" avs:"ReleaseResourceType"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -16001,8 +16047,8 @@ func (x *ReleaseResourceReference) GetValue() string {
 }
 
 func (x *ReleaseResourceReference) GetReleaseResourceType() string {
-	if x != nil {
-		return x.ReleaseResourceType
+	if x != nil && x.ReleaseResourceType != nil {
+		return *x.ReleaseResourceType
 	}
 	return ""
 }
@@ -16054,18 +16100,18 @@ func (x *ReleaseResourceReferenceList) GetReleaseResourceReference() []*ReleaseR
 
 type ReleaseSummaryDetailsByTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,4,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,5,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"DisplayArtistName"
 	DisplayArtistName []*Name `protobuf:"bytes,1,rep,name=display_artist_name,json=displayArtistName,proto3" json:"display_artist_name,omitempty" xml:"DisplayArtistName"`
 	// @gotags: xml:"LabelName"
 	LabelName []*LabelName `protobuf:"bytes,2,rep,name=label_name,json=labelName,proto3" json:"label_name,omitempty" xml:"LabelName"`
 	// @gotags: xml:"RightsAgreementId"
 	RightsAgreementId *RightsAgreementId `protobuf:"bytes,3,opt,name=rights_agreement_id,json=rightsAgreementId,proto3" json:"rights_agreement_id,omitempty" xml:"RightsAgreementId"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,4,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,5,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -16100,6 +16146,20 @@ func (*ReleaseSummaryDetailsByTerritory) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{161}
 }
 
+func (x *ReleaseSummaryDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *ReleaseSummaryDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *ReleaseSummaryDetailsByTerritory) GetDisplayArtistName() []*Name {
 	if x != nil {
 		return x.DisplayArtistName
@@ -16121,35 +16181,21 @@ func (x *ReleaseSummaryDetailsByTerritory) GetRightsAgreementId() *RightsAgreeme
 	return nil
 }
 
-func (x *ReleaseSummaryDetailsByTerritory) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
-	}
-	return nil
-}
-
-func (x *ReleaseSummaryDetailsByTerritory) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
-	}
-	return nil
-}
-
 func (x *ReleaseSummaryDetailsByTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type ReleaseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReleaseType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReleaseType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16192,15 +16238,15 @@ func (x *ReleaseType) GetValue() string {
 }
 
 func (x *ReleaseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16210,9 +16256,9 @@ type ResourceContainedResourceReference struct {
 	// @gotags: xml:"ResourceContainedResourceReference"
 	ResourceContainedResourceReference string `protobuf:"bytes,1,opt,name=resource_contained_resource_reference,json=resourceContainedResourceReference,proto3" json:"resource_contained_resource_reference,omitempty" xml:"ResourceContainedResourceReference"`
 	// @gotags: xml:"DurationUsed"
-	DurationUsed string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty" xml:"DurationUsed"`
+	DurationUsed *string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3,oneof" json:"duration_used,omitempty" xml:"DurationUsed"`
 	// @gotags: xml:"StartPoint"
-	StartPoint string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
+	StartPoint *string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3,oneof" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"Purpose"
 	Purpose       *Purpose `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty" xml:"Purpose"`
 	unknownFields protoimpl.UnknownFields
@@ -16257,15 +16303,15 @@ func (x *ResourceContainedResourceReference) GetResourceContainedResourceReferen
 }
 
 func (x *ResourceContainedResourceReference) GetDurationUsed() string {
-	if x != nil {
-		return x.DurationUsed
+	if x != nil && x.DurationUsed != nil {
+		return *x.DurationUsed
 	}
 	return ""
 }
 
 func (x *ResourceContainedResourceReference) GetStartPoint() string {
-	if x != nil {
-		return x.StartPoint
+	if x != nil && x.StartPoint != nil {
+		return *x.StartPoint
 	}
 	return ""
 }
@@ -16324,14 +16370,14 @@ func (x *ResourceContainedResourceReferenceList) GetResourceContainedResourceRef
 
 type ResourceContributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"ResourceContributorRole"
-	ResourceContributorRole []*ResourceContributorRole `protobuf:"bytes,1,rep,name=resource_contributor_role,json=resourceContributorRole,proto3" json:"resource_contributor_role,omitempty" xml:"ResourceContributorRole"`
 	// @gotags: xml:"PartyId"
 	PartyId []*PartyId `protobuf:"bytes,2,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,3,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"ResourceContributorRole"
+	ResourceContributorRole []*ResourceContributorRole `protobuf:"bytes,1,rep,name=resource_contributor_role,json=resourceContributorRole,proto3" json:"resource_contributor_role,omitempty" xml:"ResourceContributorRole"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -16366,42 +16412,42 @@ func (*ResourceContributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *ResourceContributor) GetResourceContributorRole() []*ResourceContributorRole {
+func (x *ResourceContributor) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.ResourceContributorRole
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *ResourceContributor) GetPartyId() []*PartyId {
+func (x *ResourceContributor) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.PartyId
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *ResourceContributor) GetPartyName() []*PartyName {
+func (x *ResourceContributor) GetResourceContributorRole() []*ResourceContributorRole {
 	if x != nil {
-		return x.PartyName
+		return x.ResourceContributorRole
 	}
 	return nil
 }
 
 func (x *ResourceContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 type ResourceContributorRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ResourceContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ResourceContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16444,15 +16490,15 @@ func (x *ResourceContributorRole) GetValue() string {
 }
 
 func (x *ResourceContributorRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ResourceContributorRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16505,11 +16551,11 @@ func (x *ResourceGroupResourceReferenceList) GetResourceGroupResourceReference()
 type ResourceMusicalWorkReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"DurationUsed"
-	DurationUsed string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty" xml:"DurationUsed"`
+	DurationUsed *string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3,oneof" json:"duration_used,omitempty" xml:"DurationUsed"`
 	// @gotags: xml:"IsFragment"
-	IsFragment bool `protobuf:"varint,3,opt,name=is_fragment,json=isFragment,proto3" json:"is_fragment,omitempty" xml:"IsFragment"`
+	IsFragment *bool `protobuf:"varint,3,opt,name=is_fragment,json=isFragment,proto3,oneof" json:"is_fragment,omitempty" xml:"IsFragment"`
 	// @gotags: xml:"ResourceMusicalWorkReference"
 	ResourceMusicalWorkReference string `protobuf:"bytes,4,opt,name=resource_musical_work_reference,json=resourceMusicalWorkReference,proto3" json:"resource_musical_work_reference,omitempty" xml:"ResourceMusicalWorkReference"`
 	unknownFields                protoimpl.UnknownFields
@@ -16547,22 +16593,22 @@ func (*ResourceMusicalWorkReference) Descriptor() ([]byte, []int) {
 }
 
 func (x *ResourceMusicalWorkReference) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *ResourceMusicalWorkReference) GetDurationUsed() string {
-	if x != nil {
-		return x.DurationUsed
+	if x != nil && x.DurationUsed != nil {
+		return *x.DurationUsed
 	}
 	return ""
 }
 
 func (x *ResourceMusicalWorkReference) GetIsFragment() bool {
-	if x != nil {
-		return x.IsFragment
+	if x != nil && x.IsFragment != nil {
+		return *x.IsFragment
 	}
 	return false
 }
@@ -16621,12 +16667,12 @@ func (x *ResourceMusicalWorkReferenceList) GetResourceMusicalWorkReference() []*
 
 type ResourceOmissionReason struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ResourceOmissionReason"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ResourceOmissionReason"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16669,15 +16715,15 @@ func (x *ResourceOmissionReason) GetValue() string {
 }
 
 func (x *ResourceOmissionReason) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ResourceOmissionReason) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16687,7 +16733,7 @@ type ResourceProprietaryId struct {
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,1,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,2,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,2,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16730,20 +16776,20 @@ func (x *ResourceProprietaryId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *ResourceProprietaryId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type ResourceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ResourceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ResourceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16786,15 +16832,15 @@ func (x *ResourceType) GetValue() string {
 }
 
 func (x *ResourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ResourceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16807,6 +16853,10 @@ type RightShare struct {
 	RightShareReference string `protobuf:"bytes,2,opt,name=right_share_reference,json=rightShareReference,proto3" json:"right_share_reference,omitempty" xml:"RightShareReference"`
 	// @gotags: xml:"RightShareCreationReferenceList"
 	RightShareCreationReferenceList *RightShareCreationReferenceList `protobuf:"bytes,3,opt,name=right_share_creation_reference_list,json=rightShareCreationReferenceList,proto3" json:"right_share_creation_reference_list,omitempty" xml:"RightShareCreationReferenceList"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*AllTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*AllTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"RightsType"
 	RightsType []*RightsType `protobuf:"bytes,4,rep,name=rights_type,json=rightsType,proto3" json:"rights_type,omitempty" xml:"RightsType"`
 	// @gotags: xml:"UseType"
@@ -16819,28 +16869,24 @@ type RightShare struct {
 	CarrierType []*CarrierType `protobuf:"bytes,8,rep,name=carrier_type,json=carrierType,proto3" json:"carrier_type,omitempty" xml:"CarrierType"`
 	// @gotags: xml:"CommercialModelType"
 	CommercialModelType []*CommercialModelType `protobuf:"bytes,9,rep,name=commercial_model_type,json=commercialModelType,proto3" json:"commercial_model_type,omitempty" xml:"CommercialModelType"`
-	// @gotags: xml:"MusicalWorkRightsClaimType"
-	MusicalWorkRightsClaimType []string `protobuf:"bytes,10,rep,name=musical_work_rights_claim_type,json=musicalWorkRightsClaimType,proto3" json:"musical_work_rights_claim_type,omitempty" xml:"MusicalWorkRightsClaimType"`
+	// @gotags: xml:"MusicalWorkRightsClaimType" avs:"MusicalWorkRightsClaimType"
+	MusicalWorkRightsClaimType []string `protobuf:"bytes,10,rep,name=musical_work_rights_claim_type,json=musicalWorkRightsClaimType,proto3" json:"musical_work_rights_claim_type,omitempty" xml:"MusicalWorkRightsClaimType" avs:"MusicalWorkRightsClaimType"`
 	// @gotags: xml:"RightsController"
 	RightsController []*RightsController `protobuf:"bytes,11,rep,name=rights_controller,json=rightsController,proto3" json:"rights_controller,omitempty" xml:"RightsController"`
 	// @gotags: xml:"ValidityPeriod"
 	ValidityPeriod *Period `protobuf:"bytes,12,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
-	// @gotags: xml:"TariffReference"
-	TariffReference *TariffReference `protobuf:"bytes,13,opt,name=tariff_reference,json=tariffReference,proto3" json:"tariff_reference,omitempty" xml:"TariffReference"`
-	// @gotags: xml:"LicenseStatus"
-	LicenseStatus string `protobuf:"bytes,14,opt,name=license_status,json=licenseStatus,proto3" json:"license_status,omitempty" xml:"LicenseStatus"`
-	// @gotags: xml:"HasFirstLicenseRefusal"
-	HasFirstLicenseRefusal bool `protobuf:"varint,15,opt,name=has_first_license_refusal,json=hasFirstLicenseRefusal,proto3" json:"has_first_license_refusal,omitempty" xml:"HasFirstLicenseRefusal"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*AllTerritoryCode `protobuf:"bytes,16,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*AllTerritoryCode `protobuf:"bytes,17,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"RightShareUnknown"
 	RightShareUnknown bool `protobuf:"varint,18,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty" xml:"RightShareUnknown"`
 	// @gotags: xml:"RightSharePercentage"
 	RightSharePercentage *Percentage `protobuf:"bytes,19,opt,name=right_share_percentage,json=rightSharePercentage,proto3" json:"right_share_percentage,omitempty" xml:"RightSharePercentage"`
+	// @gotags: xml:"TariffReference"
+	TariffReference *TariffReference `protobuf:"bytes,13,opt,name=tariff_reference,json=tariffReference,proto3" json:"tariff_reference,omitempty" xml:"TariffReference"`
+	// @gotags: xml:"LicenseStatus" avs:"LicenseStatus"
+	LicenseStatus *string `protobuf:"bytes,14,opt,name=license_status,json=licenseStatus,proto3,oneof" json:"license_status,omitempty" xml:"LicenseStatus" avs:"LicenseStatus"`
+	// @gotags: xml:"HasFirstLicenseRefusal"
+	HasFirstLicenseRefusal *bool `protobuf:"varint,15,opt,name=has_first_license_refusal,json=hasFirstLicenseRefusal,proto3,oneof" json:"has_first_license_refusal,omitempty" xml:"HasFirstLicenseRefusal"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,20,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,20,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -16896,6 +16942,20 @@ func (x *RightShare) GetRightShareCreationReferenceList() *RightShareCreationRef
 	return nil
 }
 
+func (x *RightShare) GetTerritoryCode() []*AllTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *RightShare) GetExcludedTerritoryCode() []*AllTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *RightShare) GetRightsType() []*RightsType {
 	if x != nil {
 		return x.RightsType
@@ -16959,58 +17019,44 @@ func (x *RightShare) GetValidityPeriod() *Period {
 	return nil
 }
 
-func (x *RightShare) GetTariffReference() *TariffReference {
-	if x != nil {
-		return x.TariffReference
-	}
-	return nil
-}
-
-func (x *RightShare) GetLicenseStatus() string {
-	if x != nil {
-		return x.LicenseStatus
-	}
-	return ""
-}
-
-func (x *RightShare) GetHasFirstLicenseRefusal() bool {
+func (x *RightShare) GetRightShareUnknown() bool {
 	if x != nil {
-		return x.HasFirstLicenseRefusal
+		return x.RightShareUnknown
 	}
 	return false
 }
 
-func (x *RightShare) GetTerritoryCode() []*AllTerritoryCode {
+func (x *RightShare) GetRightSharePercentage() *Percentage {
 	if x != nil {
-		return x.TerritoryCode
+		return x.RightSharePercentage
 	}
 	return nil
 }
 
-func (x *RightShare) GetExcludedTerritoryCode() []*AllTerritoryCode {
+func (x *RightShare) GetTariffReference() *TariffReference {
 	if x != nil {
-		return x.ExcludedTerritoryCode
+		return x.TariffReference
 	}
 	return nil
 }
 
-func (x *RightShare) GetRightShareUnknown() bool {
-	if x != nil {
-		return x.RightShareUnknown
+func (x *RightShare) GetLicenseStatus() string {
+	if x != nil && x.LicenseStatus != nil {
+		return *x.LicenseStatus
 	}
-	return false
+	return ""
 }
 
-func (x *RightShare) GetRightSharePercentage() *Percentage {
-	if x != nil {
-		return x.RightSharePercentage
+func (x *RightShare) GetHasFirstLicenseRefusal() bool {
+	if x != nil && x.HasFirstLicenseRefusal != nil {
+		return *x.HasFirstLicenseRefusal
 	}
-	return nil
+	return false
 }
 
 func (x *RightShare) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -17136,8 +17182,8 @@ type RightsClaimPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Condition"
 	Condition []*Condition `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty" xml:"Condition"`
-	// @gotags: xml:"RightsClaimPolicyType"
-	RightsClaimPolicyType string `protobuf:"bytes,2,opt,name=rights_claim_policy_type,json=rightsClaimPolicyType,proto3" json:"rights_claim_policy_type,omitempty" xml:"RightsClaimPolicyType"`
+	// @gotags: xml:"RightsClaimPolicyType" avs:"RightsClaimPolicyType"
+	RightsClaimPolicyType string `protobuf:"bytes,2,opt,name=rights_claim_policy_type,json=rightsClaimPolicyType,proto3" json:"rights_claim_policy_type,omitempty" xml:"RightsClaimPolicyType" avs:"RightsClaimPolicyType"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -17188,20 +17234,20 @@ func (x *RightsClaimPolicy) GetRightsClaimPolicyType() string {
 
 type RightsController struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"RightsControllerRole"
-	RightsControllerRole []string `protobuf:"bytes,1,rep,name=rights_controller_role,json=rightsControllerRole,proto3" json:"rights_controller_role,omitempty" xml:"RightsControllerRole"`
-	// @gotags: xml:"RightsControllerType"
-	RightsControllerType string `protobuf:"bytes,2,opt,name=rights_controller_type,json=rightsControllerType,proto3" json:"rights_controller_type,omitempty" xml:"RightsControllerType"`
 	// @gotags: xml:"PartyId"
 	PartyId []*PartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
 	PartyName []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"RightsControllerRole" avs:"RightsControllerRole"
+	RightsControllerRole []string `protobuf:"bytes,1,rep,name=rights_controller_role,json=rightsControllerRole,proto3" json:"rights_controller_role,omitempty" xml:"RightsControllerRole" avs:"RightsControllerRole"`
 	// @gotags: xml:"RightShareUnknown"
 	RightShareUnknown bool `protobuf:"varint,5,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty" xml:"RightShareUnknown"`
 	// @gotags: xml:"RightSharePercentage"
 	RightSharePercentage *Percentage `protobuf:"bytes,6,opt,name=right_share_percentage,json=rightSharePercentage,proto3" json:"right_share_percentage,omitempty" xml:"RightSharePercentage"`
+	// @gotags: xml:"RightsControllerType" avs:"RightsControllerType"
+	RightsControllerType *string `protobuf:"bytes,2,opt,name=rights_controller_type,json=rightsControllerType,proto3,oneof" json:"rights_controller_type,omitempty" xml:"RightsControllerType" avs:"RightsControllerType"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -17236,30 +17282,23 @@ func (*RightsController) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *RightsController) GetRightsControllerRole() []string {
+func (x *RightsController) GetPartyId() []*PartyId {
 	if x != nil {
-		return x.RightsControllerRole
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *RightsController) GetRightsControllerType() string {
-	if x != nil {
-		return x.RightsControllerType
-	}
-	return ""
-}
-
-func (x *RightsController) GetPartyId() []*PartyId {
+func (x *RightsController) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.PartyId
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *RightsController) GetPartyName() []*PartyName {
+func (x *RightsController) GetRightsControllerRole() []string {
 	if x != nil {
-		return x.PartyName
+		return x.RightsControllerRole
 	}
 	return nil
 }
@@ -17278,23 +17317,30 @@ func (x *RightsController) GetRightSharePercentage() *Percentage {
 	return nil
 }
 
+func (x *RightsController) GetRightsControllerType() string {
+	if x != nil && x.RightsControllerType != nil {
+		return *x.RightsControllerType
+	}
+	return ""
+}
+
 func (x *RightsController) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 type RightsType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RightsCoverage"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RightsCoverage"`
 	// @gotags: xml:"TerritoryCode,attr"
 	TerritoryCode string `protobuf:"bytes,2,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17344,15 +17390,15 @@ func (x *RightsType) GetTerritoryCode() string {
 }
 
 func (x *RightsType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RightsType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17424,8 +17470,8 @@ type SamplingRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfFrequency"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfFrequency"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17468,22 +17514,22 @@ func (x *SamplingRate) GetValue() string {
 }
 
 func (x *SamplingRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
 
 type SheetMusicCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SheetMusicCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SheetMusicCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17526,22 +17572,22 @@ func (x *SheetMusicCodecType) GetValue() string {
 }
 
 func (x *SheetMusicCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *SheetMusicCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SheetMusicCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17549,11 +17595,11 @@ func (x *SheetMusicCodecType) GetUserDefinedValue() string {
 type SheetMusicId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,1,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,1,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,2,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,3,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,3,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17589,8 +17635,8 @@ func (*SheetMusicId) Descriptor() ([]byte, []int) {
 }
 
 func (x *SheetMusicId) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
@@ -17603,20 +17649,20 @@ func (x *SheetMusicId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *SheetMusicId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type SheetMusicType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SheetMusicType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SheetMusicType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17659,29 +17705,29 @@ func (x *SheetMusicType) GetValue() string {
 }
 
 func (x *SheetMusicType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SheetMusicType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SocietyAffiliation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"MusicRightsSociety"
-	MusicRightsSociety *PartyDescriptor `protobuf:"bytes,1,opt,name=music_rights_society,json=musicRightsSociety,proto3" json:"music_rights_society,omitempty" xml:"MusicRightsSociety"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*AllTerritoryCode `protobuf:"bytes,2,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*AllTerritoryCode `protobuf:"bytes,3,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// @gotags: xml:"MusicRightsSociety"
+	MusicRightsSociety *PartyDescriptor `protobuf:"bytes,1,opt,name=music_rights_society,json=musicRightsSociety,proto3" json:"music_rights_society,omitempty" xml:"MusicRightsSociety"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SocietyAffiliation) Reset() {
@@ -17714,35 +17760,35 @@ func (*SocietyAffiliation) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v383_v383_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *SocietyAffiliation) GetMusicRightsSociety() *PartyDescriptor {
+func (x *SocietyAffiliation) GetTerritoryCode() []*AllTerritoryCode {
 	if x != nil {
-		return x.MusicRightsSociety
+		return x.TerritoryCode
 	}
 	return nil
 }
 
-func (x *SocietyAffiliation) GetTerritoryCode() []*AllTerritoryCode {
+func (x *SocietyAffiliation) GetExcludedTerritoryCode() []*AllTerritoryCode {
 	if x != nil {
-		return x.TerritoryCode
+		return x.ExcludedTerritoryCode
 	}
 	return nil
 }
 
-func (x *SocietyAffiliation) GetExcludedTerritoryCode() []*AllTerritoryCode {
+func (x *SocietyAffiliation) GetMusicRightsSociety() *PartyDescriptor {
 	if x != nil {
-		return x.ExcludedTerritoryCode
+		return x.MusicRightsSociety
 	}
 	return nil
 }
 
 type SoftwareType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SoftwareType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SoftwareType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17785,29 +17831,29 @@ func (x *SoftwareType) GetValue() string {
 }
 
 func (x *SoftwareType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SoftwareType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SoundProcessorType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SoundProcessorType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SoundProcessorType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17850,22 +17896,22 @@ func (x *SoundProcessorType) GetValue() string {
 }
 
 func (x *SoundProcessorType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *SoundProcessorType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SoundProcessorType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17873,17 +17919,17 @@ func (x *SoundProcessorType) GetUserDefinedValue() string {
 type SoundRecordingCollectionReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"SoundRecordingCollectionReference"
 	SoundRecordingCollectionReference string `protobuf:"bytes,2,opt,name=sound_recording_collection_reference,json=soundRecordingCollectionReference,proto3" json:"sound_recording_collection_reference,omitempty" xml:"SoundRecordingCollectionReference"`
 	// @gotags: xml:"StartTime"
-	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" xml:"StartTime"`
+	StartTime *string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty" xml:"StartTime"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,4,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"EndTime"
-	EndTime string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" xml:"EndTime"`
-	// @gotags: xml:"ReleaseResourceType"
-	ReleaseResourceType string `protobuf:"bytes,6,opt,name=release_resource_type,json=releaseResourceType,proto3" json:"release_resource_type,omitempty" xml:"ReleaseResourceType"`
+	EndTime *string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty" xml:"EndTime"`
+	// @gotags: xml:"ReleaseResourceType" avs:"ReleaseResourceType"
+	ReleaseResourceType *string `protobuf:"bytes,6,opt,name=release_resource_type,json=releaseResourceType,proto3,oneof" json:"release_resource_type,omitempty" xml:"ReleaseResourceType" avs:"ReleaseResourceType"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
@@ -17919,8 +17965,8 @@ func (*SoundRecordingCollectionReference) Descriptor() ([]byte, []int) {
 }
 
 func (x *SoundRecordingCollectionReference) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -17933,29 +17979,29 @@ func (x *SoundRecordingCollectionReference) GetSoundRecordingCollectionReference
 }
 
 func (x *SoundRecordingCollectionReference) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
 	return ""
 }
 
 func (x *SoundRecordingCollectionReference) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *SoundRecordingCollectionReference) GetEndTime() string {
-	if x != nil {
-		return x.EndTime
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
 	return ""
 }
 
 func (x *SoundRecordingCollectionReference) GetReleaseResourceType() string {
-	if x != nil {
-		return x.ReleaseResourceType
+	if x != nil && x.ReleaseResourceType != nil {
+		return *x.ReleaseResourceType
 	}
 	return ""
 }
@@ -17963,7 +18009,7 @@ func (x *SoundRecordingCollectionReference) GetReleaseResourceType() string {
 type SoundRecordingCollectionReferenceList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"NumberOfCollections"
-	NumberOfCollections int32 `protobuf:"varint,1,opt,name=number_of_collections,json=numberOfCollections,proto3" json:"number_of_collections,omitempty" xml:"NumberOfCollections"`
+	NumberOfCollections *int32 `protobuf:"varint,1,opt,name=number_of_collections,json=numberOfCollections,proto3,oneof" json:"number_of_collections,omitempty" xml:"NumberOfCollections"`
 	// @gotags: xml:"SoundRecordingCollectionReference"
 	SoundRecordingCollectionReference []*SoundRecordingCollectionReference `protobuf:"bytes,2,rep,name=sound_recording_collection_reference,json=soundRecordingCollectionReference,proto3" json:"sound_recording_collection_reference,omitempty" xml:"SoundRecordingCollectionReference"`
 	unknownFields                     protoimpl.UnknownFields
@@ -18001,8 +18047,8 @@ func (*SoundRecordingCollectionReferenceList) Descriptor() ([]byte, []int) {
 }
 
 func (x *SoundRecordingCollectionReferenceList) GetNumberOfCollections() int32 {
-	if x != nil {
-		return x.NumberOfCollections
+	if x != nil && x.NumberOfCollections != nil {
+		return *x.NumberOfCollections
 	}
 	return 0
 }
@@ -18017,13 +18063,13 @@ func (x *SoundRecordingCollectionReferenceList) GetSoundRecordingCollectionRefer
 type SoundRecordingId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,3,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,4,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,4,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18059,8 +18105,8 @@ func (*SoundRecordingId) Descriptor() ([]byte, []int) {
 }
 
 func (x *SoundRecordingId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
@@ -18080,20 +18126,20 @@ func (x *SoundRecordingId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *SoundRecordingId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type SoundRecordingType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SoundRecordingType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SoundRecordingType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18136,15 +18182,15 @@ func (x *SoundRecordingType) GetValue() string {
 }
 
 func (x *SoundRecordingType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SoundRecordingType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -18154,7 +18200,7 @@ type SubTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -18197,8 +18243,8 @@ func (x *SubTitle) GetValue() string {
 }
 
 func (x *SubTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -18208,7 +18254,7 @@ type Synopsis struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -18251,8 +18297,8 @@ func (x *Synopsis) GetValue() string {
 }
 
 func (x *Synopsis) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -18262,9 +18308,9 @@ type TariffReference struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"TariffSubReference,attr"
-	TariffSubReference string `protobuf:"bytes,3,opt,name=tariff_sub_reference,json=tariffSubReference,proto3" json:"tariff_sub_reference,omitempty" xml:"TariffSubReference,attr"`
+	TariffSubReference *string `protobuf:"bytes,3,opt,name=tariff_sub_reference,json=tariffSubReference,proto3,oneof" json:"tariff_sub_reference,omitempty" xml:"TariffSubReference,attr"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -18307,27 +18353,27 @@ func (x *TariffReference) GetValue() string {
 }
 
 func (x *TariffReference) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TariffReference) GetTariffSubReference() string {
-	if x != nil {
-		return x.TariffSubReference
+	if x != nil && x.TariffSubReference != nil {
+		return *x.TariffSubReference
 	}
 	return ""
 }
 
 type TechnicalInstantiation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"DrmEnforcementType"
-	DrmEnforcementType string `protobuf:"bytes,1,opt,name=drm_enforcement_type,json=drmEnforcementType,proto3" json:"drm_enforcement_type,omitempty" xml:"DrmEnforcementType"`
-	// @gotags: xml:"VideoDefinitionType"
-	VideoDefinitionType string `protobuf:"bytes,2,opt,name=video_definition_type,json=videoDefinitionType,proto3" json:"video_definition_type,omitempty" xml:"VideoDefinitionType"`
-	// @gotags: xml:"CodingType"
-	CodingType string `protobuf:"bytes,3,opt,name=coding_type,json=codingType,proto3" json:"coding_type,omitempty" xml:"CodingType"`
+	// @gotags: xml:"DrmEnforcementType" avs:"DrmEnforcementType"
+	DrmEnforcementType *string `protobuf:"bytes,1,opt,name=drm_enforcement_type,json=drmEnforcementType,proto3,oneof" json:"drm_enforcement_type,omitempty" xml:"DrmEnforcementType" avs:"DrmEnforcementType"`
+	// @gotags: xml:"VideoDefinitionType" avs:"VideoDefinitionType"
+	VideoDefinitionType *string `protobuf:"bytes,2,opt,name=video_definition_type,json=videoDefinitionType,proto3,oneof" json:"video_definition_type,omitempty" xml:"VideoDefinitionType" avs:"VideoDefinitionType"`
+	// @gotags: xml:"CodingType" avs:"CodingType"
+	CodingType *string `protobuf:"bytes,3,opt,name=coding_type,json=codingType,proto3,oneof" json:"coding_type,omitempty" xml:"CodingType" avs:"CodingType"`
 	// @gotags: xml:"BitRate"
 	BitRate       *BitRate `protobuf:"bytes,4,opt,name=bit_rate,json=bitRate,proto3" json:"bit_rate,omitempty" xml:"BitRate"`
 	unknownFields protoimpl.UnknownFields
@@ -18365,22 +18411,22 @@ func (*TechnicalInstantiation) Descriptor() ([]byte, []int) {
 }
 
 func (x *TechnicalInstantiation) GetDrmEnforcementType() string {
-	if x != nil {
-		return x.DrmEnforcementType
+	if x != nil && x.DrmEnforcementType != nil {
+		return *x.DrmEnforcementType
 	}
 	return ""
 }
 
 func (x *TechnicalInstantiation) GetVideoDefinitionType() string {
-	if x != nil {
-		return x.VideoDefinitionType
+	if x != nil && x.VideoDefinitionType != nil {
+		return *x.VideoDefinitionType
 	}
 	return ""
 }
 
 func (x *TechnicalInstantiation) GetCodingType() string {
-	if x != nil {
-		return x.CodingType
+	if x != nil && x.CodingType != nil {
+		return *x.CodingType
 	}
 	return ""
 }
@@ -18394,14 +18440,14 @@ func (x *TechnicalInstantiation) GetBitRate() *BitRate {
 
 type TextCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"TextCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"TextCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18444,22 +18490,22 @@ func (x *TextCodecType) GetValue() string {
 }
 
 func (x *TextCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *TextCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -18467,15 +18513,15 @@ func (x *TextCodecType) GetUserDefinedValue() string {
 type TextId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,1,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,1,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,2,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,2,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,3,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,3,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18511,22 +18557,22 @@ func (*TextId) Descriptor() ([]byte, []int) {
 }
 
 func (x *TextId) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *TextId) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *TextId) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -18539,20 +18585,20 @@ func (x *TextId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *TextId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type TextType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"TextType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"TextType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18595,15 +18641,15 @@ func (x *TextType) GetValue() string {
 }
 
 func (x *TextType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -18615,9 +18661,9 @@ type Title struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle []*TypedSubTitle `protobuf:"bytes,2,rep,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType     string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"TitleType,attr" avs:"TitleType"
+	TitleType     *string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"TitleType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18667,15 +18713,15 @@ func (x *Title) GetSubTitle() []*TypedSubTitle {
 }
 
 func (x *Title) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Title) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
@@ -18685,7 +18731,7 @@ type TitleText struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -18728,8 +18774,8 @@ func (x *TitleText) GetValue() string {
 }
 
 func (x *TitleText) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -18739,9 +18785,9 @@ type TypedSubTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"SubTitleType,attr"
-	SubTitleType  string `protobuf:"bytes,3,opt,name=sub_title_type,json=subTitleType,proto3" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
+	SubTitleType  *string `protobuf:"bytes,3,opt,name=sub_title_type,json=subTitleType,proto3,oneof" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18784,15 +18830,15 @@ func (x *TypedSubTitle) GetValue() string {
 }
 
 func (x *TypedSubTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TypedSubTitle) GetSubTitleType() string {
-	if x != nil {
-		return x.SubTitleType
+	if x != nil && x.SubTitleType != nil {
+		return *x.SubTitleType
 	}
 	return ""
 }
@@ -18810,7 +18856,7 @@ type Usage struct {
 	// @gotags: xml:"TechnicalInstantiation"
 	TechnicalInstantiation *TechnicalInstantiation `protobuf:"bytes,5,opt,name=technical_instantiation,json=technicalInstantiation,proto3" json:"technical_instantiation,omitempty" xml:"TechnicalInstantiation"`
 	// @gotags: xml:"NumberOfUsages"
-	NumberOfUsages int32 `protobuf:"varint,6,opt,name=number_of_usages,json=numberOfUsages,proto3" json:"number_of_usages,omitempty" xml:"NumberOfUsages"`
+	NumberOfUsages *int32 `protobuf:"varint,6,opt,name=number_of_usages,json=numberOfUsages,proto3,oneof" json:"number_of_usages,omitempty" xml:"NumberOfUsages"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -18881,20 +18927,20 @@ func (x *Usage) GetTechnicalInstantiation() *TechnicalInstantiation {
 }
 
 func (x *Usage) GetNumberOfUsages() int32 {
-	if x != nil {
-		return x.NumberOfUsages
+	if x != nil && x.NumberOfUsages != nil {
+		return *x.NumberOfUsages
 	}
 	return 0
 }
 
 type UseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UseType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UseType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18937,15 +18983,15 @@ func (x *UseType) GetValue() string {
 }
 
 func (x *UseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *UseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -18955,7 +19001,7 @@ type UserDefinedResourceType struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace     *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18998,8 +19044,8 @@ func (x *UserDefinedResourceType) GetValue() string {
 }
 
 func (x *UserDefinedResourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
@@ -19009,11 +19055,11 @@ type UserDefinedValue struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"Description,attr"
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty" xml:"Description,attr"`
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty" xml:"Description,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -19056,34 +19102,34 @@ func (x *UserDefinedValue) GetValue() string {
 }
 
 func (x *UserDefinedValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *UserDefinedValue) GetDescription() string {
-	if x != nil {
-		return x.Description
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
 	return ""
 }
 
 func (x *UserDefinedValue) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type UserInterfaceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UserInterfaceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UserInterfaceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -19126,29 +19172,29 @@ func (x *UserInterfaceType) GetValue() string {
 }
 
 func (x *UserInterfaceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *UserInterfaceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type VideoCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -19191,22 +19237,22 @@ func (x *VideoCodecType) GetValue() string {
 }
 
 func (x *VideoCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *VideoCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -19259,11 +19305,11 @@ func (x *VideoCueSheetReference) GetVideoCueSheetReference() string {
 type VideoId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,4,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -19271,7 +19317,7 @@ type VideoId struct {
 	// @gotags: xml:"EIDR"
 	EIDR []string `protobuf:"bytes,6,rep,name=e_i_d_r,json=eIDR,proto3" json:"e_i_d_r,omitempty" xml:"EIDR"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,7,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,7,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -19307,22 +19353,22 @@ func (*VideoId) Descriptor() ([]byte, []int) {
 }
 
 func (x *VideoId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *VideoId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *VideoId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
@@ -19349,20 +19395,20 @@ func (x *VideoId) GetEIDR() []string {
 }
 
 func (x *VideoId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type VideoType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -19405,15 +19451,15 @@ func (x *VideoType) GetValue() string {
 }
 
 func (x *VideoType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -19427,11 +19473,11 @@ type WebPage struct {
 	// @gotags: xml:"PageName"
 	PageName *Name `protobuf:"bytes,3,opt,name=page_name,json=pageName,proto3" json:"page_name,omitempty" xml:"PageName"`
 	// @gotags: xml:"URL"
-	URL string `protobuf:"bytes,4,opt,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
+	URL *string `protobuf:"bytes,4,opt,name=u_r_l,json=uRL,proto3,oneof" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"UserName"
-	UserName string `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty" xml:"UserName"`
+	UserName *string `protobuf:"bytes,5,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty" xml:"UserName"`
 	// @gotags: xml:"Password"
-	Password      string `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty" xml:"Password"`
+	Password      *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty" xml:"Password"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -19488,22 +19534,22 @@ func (x *WebPage) GetPageName() *Name {
 }
 
 func (x *WebPage) GetURL() string {
-	if x != nil {
-		return x.URL
+	if x != nil && x.URL != nil {
+		return *x.URL
 	}
 	return ""
 }
 
 func (x *WebPage) GetUserName() string {
-	if x != nil {
-		return x.UserName
+	if x != nil && x.UserName != nil {
+		return *x.UserName
 	}
 	return ""
 }
 
 func (x *WebPage) GetPassword() string {
-	if x != nil {
-		return x.Password
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
@@ -19513,7 +19559,7 @@ type WorkList struct {
 	// @gotags: xml:"MusicalWork"
 	MusicalWork []*MusicalWork `protobuf:"bytes,1,rep,name=musical_work,json=musicalWork,proto3" json:"musical_work,omitempty" xml:"MusicalWork"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -19556,8 +19602,8 @@ func (x *WorkList) GetMusicalWork() []*MusicalWork {
 }
 
 func (x *WorkList) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -19566,12 +19612,12 @@ var File_ddex_ern_v383_v383_proto protoreflect.FileDescriptor
 
 const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\n" +
-	"\x18ddex/ern/v383/v383.proto\x12\rddex.ern.v383\x1a\"ddex/avs/v20200108/v20200108.proto\"\xc1\a\n" +
+	"\x18ddex/ern/v383/v383.proto\x12\rddex.ern.v383\x1a\"ddex/avs/v20200108/v20200108.proto\"\x84\t\n" +
 	"\x11NewReleaseMessage\x12C\n" +
-	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.ern.v383.MessageHeaderR\rmessageHeader\x12)\n" +
-	"\x10update_indicator\x18\x02 \x01(\tR\x0fupdateIndicator\x12\x1f\n" +
-	"\vis_backfill\x18\x03 \x01(\bR\n" +
-	"isBackfill\x12I\n" +
+	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.ern.v383.MessageHeaderR\rmessageHeader\x12.\n" +
+	"\x10update_indicator\x18\x02 \x01(\tH\x00R\x0fupdateIndicator\x88\x01\x01\x12$\n" +
+	"\vis_backfill\x18\x03 \x01(\bH\x01R\n" +
+	"isBackfill\x88\x01\x01\x12I\n" +
 	"\x10catalog_transfer\x18\x04 \x01(\v2\x1e.ddex.ern.v383.CatalogTransferR\x0fcatalogTransfer\x124\n" +
 	"\twork_list\x18\x05 \x01(\v2\x17.ddex.ern.v383.WorkListR\bworkList\x12A\n" +
 	"\x0ecue_sheet_list\x18\x06 \x01(\v2\x1b.ddex.ern.v383.CueSheetListR\fcueSheetList\x12@\n" +
@@ -19580,33 +19626,48 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\frelease_list\x18\t \x01(\v2\x1a.ddex.ern.v383.ReleaseListR\vreleaseList\x124\n" +
 	"\tdeal_list\x18\n" +
 	" \x01(\v2\x17.ddex.ern.v383.DealListR\bdealList\x129\n" +
-	"\x19message_schema_version_id\x18\v \x01(\tR\x16messageSchemaVersionId\x12=\n" +
-	"\x1bbusiness_profile_version_id\x18\f \x01(\tR\x18businessProfileVersionId\x12;\n" +
-	"\x1arelease_profile_version_id\x18\r \x01(\tR\x17releaseProfileVersionId\x127\n" +
-	"\x18language_and_script_code\x18\x0e \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
+	"\x19message_schema_version_id\x18\v \x01(\tR\x16messageSchemaVersionId\x12B\n" +
+	"\x1bbusiness_profile_version_id\x18\f \x01(\tH\x02R\x18businessProfileVersionId\x88\x01\x01\x12@\n" +
+	"\x1arelease_profile_version_id\x18\r \x01(\tH\x03R\x17releaseProfileVersionId\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x0e \x01(\tH\x04R\x15languageAndScriptCode\x88\x01\x01\x12\x1b\n" +
 	"\txmlns_ern\x18\x0f \x01(\tR\bxmlnsErn\x12\x1b\n" +
 	"\txmlns_xsi\x18\x10 \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\x11 \x01(\tR\x11xsiSchemaLocation\"\x9d\x04\n" +
+	"\x13xsi_schema_location\x18\x11 \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\x12 \x01(\tR\n" +
+	"rootPrefixB\x13\n" +
+	"\x11_update_indicatorB\x0e\n" +
+	"\f_is_backfillB\x1e\n" +
+	"\x1c_business_profile_version_idB\x1d\n" +
+	"\x1b_release_profile_version_idB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb1\x05\n" +
 	"\x12CatalogListMessage\x12C\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.ern.v383.MessageHeaderR\rmessageHeader\x12)\n" +
 	"\x10publication_date\x18\x02 \x01(\tR\x0fpublicationDate\x12=\n" +
 	"\fcatalog_item\x18\x03 \x03(\v2\x1a.ddex.ern.v383.CatalogItemR\vcatalogItem\x129\n" +
-	"\x19message_schema_version_id\x18\x04 \x01(\tR\x16messageSchemaVersionId\x12=\n" +
-	"\x1bbusiness_profile_version_id\x18\x05 \x01(\tR\x18businessProfileVersionId\x12;\n" +
-	"\x1arelease_profile_version_id\x18\x06 \x01(\tR\x17releaseProfileVersionId\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
+	"\x19message_schema_version_id\x18\x04 \x01(\tR\x16messageSchemaVersionId\x12B\n" +
+	"\x1bbusiness_profile_version_id\x18\x05 \x01(\tH\x00R\x18businessProfileVersionId\x88\x01\x01\x12@\n" +
+	"\x1arelease_profile_version_id\x18\x06 \x01(\tH\x01R\x17releaseProfileVersionId\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12\x1b\n" +
 	"\txmlns_ern\x18\b \x01(\tR\bxmlnsErn\x12\x1b\n" +
 	"\txmlns_xsi\x18\t \x01(\tR\bxmlnsXsi\x12.\n" +
 	"\x13xsi_schema_location\x18\n" +
-	" \x01(\tR\x11xsiSchemaLocation\"\xfd\x02\n" +
+	" \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\v \x01(\tR\n" +
+	"rootPrefixB\x1e\n" +
+	"\x1c_business_profile_version_idB\x1d\n" +
+	"\x1b_release_profile_version_idB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc8\x03\n" +
 	"\x13PurgeReleaseMessage\x12C\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.ern.v383.MessageHeaderR\rmessageHeader\x12C\n" +
 	"\x0epurged_release\x18\x02 \x01(\v2\x1c.ddex.ern.v383.PurgedReleaseR\rpurgedRelease\x129\n" +
-	"\x19message_schema_version_id\x18\x03 \x01(\tR\x16messageSchemaVersionId\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
+	"\x19message_schema_version_id\x18\x03 \x01(\tR\x16messageSchemaVersionId\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\x1b\n" +
 	"\txmlns_ern\x18\x05 \x01(\tR\bxmlnsErn\x12\x1b\n" +
 	"\txmlns_xsi\x18\x06 \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\"\xff\x04\n" +
+	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\b \x01(\tR\n" +
+	"rootPrefixB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x87\x05\n" +
 	"\vCatalogItem\x12F\n" +
 	"\x0eterritory_code\x18\x01 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\rterritoryCode\x127\n" +
 	"\n" +
@@ -19621,137 +19682,148 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x06p_line\x18\t \x03(\v2\x14.ddex.ern.v383.PLineR\x05pLine\x12+\n" +
 	"\x06c_line\x18\n" +
 	" \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x12;\n" +
-	"\frelease_date\x18\v \x01(\v2\x18.ddex.ern.v383.EventDateR\vreleaseDate\"Y\n" +
+	"\frelease_date\x18\v \x01(\v2\x18.ddex.ern.v383.EventDateR\vreleaseDateJ\x06\b\xa8F\x10\x90N\"a\n" +
 	"\x1bCatalogReleaseReferenceList\x12:\n" +
-	"\x19catalog_release_reference\x18\x01 \x03(\tR\x17catalogReleaseReference\"\xc9\x04\n" +
+	"\x19catalog_release_reference\x18\x01 \x03(\tR\x17catalogReleaseReferenceJ\x06\b\xa8F\x10\x90N\"\xd1\x04\n" +
 	"\x0fCatalogTransfer\x12<\n" +
 	"\x1acatalog_transfer_completed\x18\x01 \x01(\bR\x18catalogTransferCompleted\x12P\n" +
 	"\x17effective_transfer_date\x18\x02 \x01(\v2\x18.ddex.ern.v383.EventDateR\x15effectiveTransferDate\x12o\n" +
-	"\x1ecatalog_release_reference_list\x18\x03 \x01(\v2*.ddex.ern.v383.CatalogReleaseReferenceListR\x1bcatalogReleaseReferenceList\x12K\n" +
-	"\x11transferring_from\x18\x04 \x01(\v2\x1e.ddex.ern.v383.PartyDescriptorR\x10transferringFrom\x12G\n" +
-	"\x0ftransferring_to\x18\x05 \x01(\v2\x1e.ddex.ern.v383.PartyDescriptorR\x0etransferringTo\x12F\n" +
+	"\x1ecatalog_release_reference_list\x18\x03 \x01(\v2*.ddex.ern.v383.CatalogReleaseReferenceListR\x1bcatalogReleaseReferenceList\x12F\n" +
 	"\x0eterritory_code\x18\x06 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\rterritoryCode\x12W\n" +
-	"\x17excluded_territory_code\x18\a \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x15excludedTerritoryCode\"\x98\f\n" +
+	"\x17excluded_territory_code\x18\a \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x15excludedTerritoryCode\x12K\n" +
+	"\x11transferring_from\x18\x04 \x01(\v2\x1e.ddex.ern.v383.PartyDescriptorR\x10transferringFrom\x12G\n" +
+	"\x0ftransferring_to\x18\x05 \x01(\v2\x1e.ddex.ern.v383.PartyDescriptorR\x0etransferringToJ\x06\b\xa8F\x10\x90N\"\x90\x0e\n" +
 	"\n" +
 	"Collection\x12@\n" +
 	"\rcollection_id\x18\x01 \x03(\v2\x1b.ddex.ern.v383.CollectionIdR\fcollectionId\x12F\n" +
 	"\x0fcollection_type\x18\x02 \x03(\v2\x1d.ddex.ern.v383.CollectionTypeR\x0ecollectionType\x121\n" +
-	"\x14collection_reference\x18\x03 \x01(\tR\x13collectionReference\x12@\n" +
-	"\x1cequivalent_release_reference\x18\x04 \x01(\tR\x1aequivalentReleaseReference\x12*\n" +
-	"\x05title\x18\x05 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\x12L\n" +
+	"\x14collection_reference\x18\x03 \x01(\tR\x13collectionReference\x12E\n" +
+	"\x1cequivalent_release_reference\x18\x04 \x01(\tH\x00R\x1aequivalentReleaseReference\x88\x01\x01\x12*\n" +
+	"\x05title\x18\x05 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x01R\x0esequenceNumber\x88\x01\x01\x12L\n" +
 	"\vcontributor\x18\a \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\vcontributor\x126\n" +
 	"\tcharacter\x18\b \x03(\v2\x18.ddex.ern.v383.CharacterR\tcharacter\x12\x81\x01\n" +
-	"$collection_collection_reference_list\x18\t \x01(\v20.ddex.ern.v383.CollectionCollectionReferenceListR!collectionCollectionReferenceList\x12\x1f\n" +
+	"$collection_collection_reference_list\x18\t \x01(\v20.ddex.ern.v383.CollectionCollectionReferenceListR!collectionCollectionReferenceList\x12$\n" +
 	"\vis_complete\x18\n" +
-	" \x01(\bR\n" +
-	"isComplete\x12\x1a\n" +
-	"\bduration\x18\v \x01(\tR\bduration\x12=\n" +
-	"\x1bduration_of_musical_content\x18\f \x01(\tR\x18durationOfMusicalContent\x12=\n" +
+	" \x01(\bH\x02R\n" +
+	"isComplete\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\v \x01(\tH\x03R\bduration\x88\x01\x01\x12B\n" +
+	"\x1bduration_of_musical_content\x18\f \x01(\tH\x04R\x18durationOfMusicalContent\x88\x01\x01\x12=\n" +
 	"\rcreation_date\x18\r \x01(\v2\x18.ddex.ern.v383.EventDateR\fcreationDate\x12;\n" +
 	"\frelease_date\x18\x0e \x01(\v2\x18.ddex.ern.v383.EventDateR\vreleaseDate\x12L\n" +
-	"\x15original_release_date\x18\x0f \x01(\v2\x18.ddex.ern.v383.EventDateR\x13originalReleaseDate\x12+\n" +
-	"\x11original_language\x18\x10 \x01(\tR\x10originalLanguage\x12r\n" +
+	"\x15original_release_date\x18\x0f \x01(\v2\x18.ddex.ern.v383.EventDateR\x13originalReleaseDate\x120\n" +
+	"\x11original_language\x18\x10 \x01(\tH\x05R\x10originalLanguage\x88\x01\x01\x12r\n" +
 	"\x1fcollection_details_by_territory\x18\x11 \x03(\v2+.ddex.ern.v383.CollectionDetailsByTerritoryR\x1ccollectionDetailsByTerritory\x12{\n" +
 	"\"collection_resource_reference_list\x18\x12 \x01(\v2..ddex.ern.v383.CollectionResourceReferenceListR\x1fcollectionResourceReferenceList\x12o\n" +
-	"\x1ecollection_work_reference_list\x18\x13 \x01(\v2*.ddex.ern.v383.CollectionWorkReferenceListR\x1bcollectionWorkReferenceList\x12D\n" +
-	"\x1erepresentative_image_reference\x18\x14 \x01(\tR\x1crepresentativeImageReference\x12+\n" +
+	"\x1ecollection_work_reference_list\x18\x13 \x01(\v2*.ddex.ern.v383.CollectionWorkReferenceListR\x1bcollectionWorkReferenceList\x12I\n" +
+	"\x1erepresentative_image_reference\x18\x14 \x01(\tH\x06R\x1crepresentativeImageReference\x88\x01\x01\x12+\n" +
 	"\x06p_line\x18\x15 \x03(\v2\x14.ddex.ern.v383.PLineR\x05pLine\x12+\n" +
-	"\x06c_line\x18\x16 \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x127\n" +
-	"\x18language_and_script_code\x18\x17 \x01(\tR\x15languageAndScriptCode\"\x9a\x03\n" +
-	"\x1cCollectionDetailsByTerritory\x12*\n" +
-	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12L\n" +
-	"\vcontributor\x18\x02 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\vcontributor\x12\x1f\n" +
-	"\vis_complete\x18\x03 \x01(\bR\n" +
-	"isComplete\x126\n" +
-	"\tcharacter\x18\x04 \x03(\v2\x18.ddex.ern.v383.CharacterR\tcharacter\x12J\n" +
+	"\x06c_line\x18\x16 \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x12<\n" +
+	"\x18language_and_script_code\x18\x17 \x01(\tH\aR\x15languageAndScriptCode\x88\x01\x01B\x1f\n" +
+	"\x1d_equivalent_release_referenceB\x12\n" +
+	"\x10_sequence_numberB\x0e\n" +
+	"\f_is_completeB\v\n" +
+	"\t_durationB\x1e\n" +
+	"\x1c_duration_of_musical_contentB\x14\n" +
+	"\x12_original_languageB!\n" +
+	"\x1f_representative_image_referenceB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb7\x03\n" +
+	"\x1cCollectionDetailsByTerritory\x12J\n" +
 	"\x0eterritory_code\x18\x05 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x06 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\"\x84\x01\n" +
+	"\x17excluded_territory_code\x18\x06 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
+	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12L\n" +
+	"\vcontributor\x18\x02 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\vcontributor\x12$\n" +
+	"\vis_complete\x18\x03 \x01(\bH\x00R\n" +
+	"isComplete\x88\x01\x01\x126\n" +
+	"\tcharacter\x18\x04 \x03(\v2\x18.ddex.ern.v383.CharacterR\tcharacterB\x0e\n" +
+	"\f_is_completeJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x0eCollectionList\x129\n" +
 	"\n" +
 	"collection\x18\x01 \x03(\v2\x19.ddex.ern.v383.CollectionR\n" +
-	"collection\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xa6\x01\n" +
-	"\x1bCollectionResourceReference\x12'\n" +
-	"\x0fsequence_number\x18\x01 \x01(\x05R\x0esequenceNumber\x12B\n" +
-	"\x1dcollection_resource_reference\x18\x02 \x01(\tR\x1bcollectionResourceReference\x12\x1a\n" +
-	"\bduration\x18\x03 \x01(\tR\bduration\"\x91\x01\n" +
+	"collection\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd9\x01\n" +
+	"\x1bCollectionResourceReference\x12,\n" +
+	"\x0fsequence_number\x18\x01 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12B\n" +
+	"\x1dcollection_resource_reference\x18\x02 \x01(\tR\x1bcollectionResourceReference\x12\x1f\n" +
+	"\bduration\x18\x03 \x01(\tH\x01R\bduration\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\v\n" +
+	"\t_durationJ\x06\b\xa8F\x10\x90N\"\x99\x01\n" +
 	"\x1fCollectionResourceReferenceList\x12n\n" +
-	"\x1dcollection_resource_reference\x18\x01 \x03(\v2*.ddex.ern.v383.CollectionResourceReferenceR\x1bcollectionResourceReference\"\xeb\t\n" +
+	"\x1dcollection_resource_reference\x18\x01 \x03(\v2*.ddex.ern.v383.CollectionResourceReferenceR\x1bcollectionResourceReferenceJ\x06\b\xa8F\x10\x90N\"\xfc\n" +
+	"\n" +
 	"\x03Cue\x12;\n" +
 	"\fcue_use_type\x18\x01 \x01(\v2\x19.ddex.ern.v383.CueUseTypeR\n" +
 	"cueUseType\x12A\n" +
 	"\x0ecue_theme_type\x18\x02 \x01(\v2\x1b.ddex.ern.v383.CueThemeTypeR\fcueThemeType\x12A\n" +
-	"\x0ecue_vocal_type\x18\x03 \x01(\v2\x1b.ddex.ern.v383.CueVocalTypeR\fcueVocalType\x12\x19\n" +
-	"\bis_dance\x18\x04 \x01(\bR\aisDance\x12c\n" +
+	"\x0ecue_vocal_type\x18\x03 \x01(\v2\x1b.ddex.ern.v383.CueVocalTypeR\fcueVocalType\x12\x1e\n" +
+	"\bis_dance\x18\x04 \x01(\bH\x00R\aisDance\x88\x01\x01\x12c\n" +
 	"\x1acue_visual_perception_type\x18\x05 \x01(\v2&.ddex.ern.v383.CueVisualPerceptionTypeR\x17cueVisualPerceptionType\x127\n" +
 	"\n" +
-	"cue_origin\x18\x06 \x01(\v2\x18.ddex.ern.v383.CueOriginR\tcueOrigin\x12.\n" +
-	"\x13has_musical_content\x18\a \x01(\bR\x11hasMusicalContent\x12\x1d\n" +
-	"\n" +
-	"start_time\x18\b \x01(\tR\tstartTime\x12\x1a\n" +
-	"\bduration\x18\t \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\n" +
-	" \x01(\tR\aendTime\x12+\n" +
-	"\x06p_line\x18\v \x03(\v2\x14.ddex.ern.v383.PLineR\x05pLine\x12+\n" +
-	"\x06c_line\x18\f \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x12Y\n" +
-	"\x16cue_creation_reference\x18\r \x03(\v2#.ddex.ern.v383.CueCreationReferenceR\x14cueCreationReference\x128\n" +
-	"\x18referenced_creation_type\x18\x0e \x01(\tR\x16referencedCreationType\x12O\n" +
+	"cue_origin\x18\x06 \x01(\v2\x18.ddex.ern.v383.CueOriginR\tcueOrigin\x12Y\n" +
+	"\x16cue_creation_reference\x18\r \x03(\v2#.ddex.ern.v383.CueCreationReferenceR\x14cueCreationReference\x12=\n" +
+	"\x18referenced_creation_type\x18\x0e \x01(\tH\x01R\x16referencedCreationType\x88\x01\x01\x12O\n" +
 	"\x16referenced_creation_id\x18\x0f \x01(\v2\x19.ddex.ern.v383.CreationIdR\x14referencedCreationId\x12P\n" +
 	"\x19referenced_creation_title\x18\x10 \x03(\v2\x14.ddex.ern.v383.TitleR\x17referencedCreationTitle\x12r\n" +
 	"\x1freferenced_creation_contributor\x18\x11 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x1dreferencedCreationContributor\x12~\n" +
 	"(referenced_indirect_creation_contributor\x18\x12 \x03(\v2%.ddex.ern.v383.MusicalWorkContributorR%referencedIndirectCreationContributor\x12\\\n" +
-	"\x1dreferenced_creation_character\x18\x13 \x03(\v2\x18.ddex.ern.v383.CharacterR\x1breferencedCreationCharacter\"\xe3\x01\n" +
+	"\x1dreferenced_creation_character\x18\x13 \x03(\v2\x18.ddex.ern.v383.CharacterR\x1breferencedCreationCharacter\x123\n" +
+	"\x13has_musical_content\x18\a \x01(\bH\x02R\x11hasMusicalContent\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\b \x01(\tH\x03R\tstartTime\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\t \x01(\tH\x04R\bduration\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\n" +
+	" \x01(\tH\x05R\aendTime\x88\x01\x01\x12+\n" +
+	"\x06p_line\x18\v \x03(\v2\x14.ddex.ern.v383.PLineR\x05pLine\x12+\n" +
+	"\x06c_line\x18\f \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLineB\v\n" +
+	"\t_is_danceB\x1b\n" +
+	"\x19_referenced_creation_typeB\x16\n" +
+	"\x14_has_musical_contentB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_durationB\v\n" +
+	"\t_end_timeJ\x06\b\xa8F\x10\x90N\"\xeb\x01\n" +
 	"\bCueSheet\x12>\n" +
 	"\fcue_sheet_id\x18\x01 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\n" +
 	"cueSheetId\x12.\n" +
 	"\x13cue_sheet_reference\x18\x02 \x01(\tR\x11cueSheetReference\x12A\n" +
 	"\x0ecue_sheet_type\x18\x03 \x01(\v2\x1b.ddex.ern.v383.CueSheetTypeR\fcueSheetType\x12$\n" +
-	"\x03cue\x18\x04 \x03(\v2\x12.ddex.ern.v383.CueR\x03cue\"D\n" +
+	"\x03cue\x18\x04 \x03(\v2\x12.ddex.ern.v383.CueR\x03cueJ\x06\b\xa8F\x10\x90N\"L\n" +
 	"\fCueSheetList\x124\n" +
-	"\tcue_sheet\x18\x01 \x03(\v2\x17.ddex.ern.v383.CueSheetR\bcueSheet\"\xf4\x03\n" +
+	"\tcue_sheet\x18\x01 \x03(\v2\x17.ddex.ern.v383.CueSheetR\bcueSheetJ\x06\b\xa8F\x10\x90N\"\x9e\x04\n" +
 	"\x04Deal\x12C\n" +
 	"\x0edeal_reference\x18\x01 \x03(\v2\x1c.ddex.ern.v383.DealReferenceR\rdealReference\x127\n" +
 	"\n" +
 	"deal_terms\x18\x02 \x01(\v2\x18.ddex.ern.v383.DealTermsR\tdealTerms\x12C\n" +
 	"\x0eresource_usage\x18\x03 \x01(\v2\x1c.ddex.ern.v383.ResourceUsageR\rresourceUsage\x12\x9b\x01\n" +
 	".deal_technical_resource_details_reference_list\x18\x04 \x01(\v28.ddex.ern.v383.DealTechnicalResourceDetailsReferenceListR)dealTechnicalResourceDetailsReferenceList\x12R\n" +
-	"\x19distribution_channel_page\x18\x05 \x03(\v2\x16.ddex.ern.v383.WebPageR\x17distributionChannelPage\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\"\x82\x01\n" +
+	"\x19distribution_channel_page\x18\x05 \x03(\v2\x16.ddex.ern.v383.WebPageR\x17distributionChannelPage\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\bDealList\x12=\n" +
-	"\frelease_deal\x18\x01 \x03(\v2\x1a.ddex.ern.v383.ReleaseDealR\vreleaseDeal\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x82\x01\n" +
+	"\frelease_deal\x18\x01 \x03(\v2\x1a.ddex.ern.v383.ReleaseDealR\vreleaseDeal\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x8a\x01\n" +
 	"\x19DealResourceReferenceList\x126\n" +
 	"\x17deal_resource_reference\x18\x01 \x03(\tR\x15dealResourceReference\x12-\n" +
-	"\x06period\x18\x02 \x01(\v2\x15.ddex.ern.v383.PeriodR\x06period\"\x85\x01\n" +
+	"\x06period\x18\x02 \x01(\v2\x15.ddex.ern.v383.PeriodR\x06periodJ\x06\b\xa8F\x10\x90N\"\x8d\x01\n" +
 	")DealTechnicalResourceDetailsReferenceList\x12X\n" +
-	")deal_technical_resource_details_reference\x18\x01 \x03(\tR%dealTechnicalResourceDetailsReference\"\xe5\x12\n" +
-	"\tDealTerms\x12)\n" +
-	"\x11is_pre_order_deal\x18\x01 \x01(\bR\x0eisPreOrderDeal\x12V\n" +
-	"\x15commercial_model_type\x18\x02 \x03(\v2\".ddex.ern.v383.CommercialModelTypeR\x13commercialModelType\x12L\n" +
-	"\x11price_information\x18\x03 \x03(\v2\x1f.ddex.ern.v383.PriceInformationR\x10priceInformation\x12>\n" +
-	"\x0fvalidity_period\x18\x04 \x03(\v2\x15.ddex.ern.v383.PeriodR\x0evalidityPeriod\x12Y\n" +
-	"\x16consumer_rental_period\x18\x05 \x01(\v2#.ddex.ern.v383.ConsumerRentalPeriodR\x14consumerRentalPeriod\x12M\n" +
-	"\x16pre_order_release_date\x18\x06 \x01(\v2\x18.ddex.ern.v383.EventDateR\x13preOrderReleaseDate\x12r\n" +
-	"!pre_order_incentive_resource_list\x18\a \x01(\v2(.ddex.ern.v383.DealResourceReferenceListR\x1dpreOrderIncentiveResourceList\x12w\n" +
-	"#instant_gratification_resource_list\x18\b \x01(\v2(.ddex.ern.v383.DealResourceReferenceListR instantGratificationResourceList\x12!\n" +
-	"\fis_exclusive\x18\t \x01(\bR\visExclusive\x12`\n" +
-	"\x19related_release_offer_set\x18\n" +
-	" \x03(\v2%.ddex.ern.v383.RelatedReleaseOfferSetR\x16relatedReleaseOfferSet\x12I\n" +
-	"\x10physical_returns\x18\v \x01(\v2\x1e.ddex.ern.v383.PhysicalReturnsR\x0fphysicalReturns\x12@\n" +
-	"\x1dnumber_of_products_per_carton\x18\f \x01(\x05R\x19numberOfProductsPerCarton\x12P\n" +
-	"\x13rights_claim_policy\x18\r \x03(\v2 .ddex.ern.v383.RightsClaimPolicyR\x11rightsClaimPolicy\x127\n" +
-	"\n" +
-	"web_policy\x18\x0e \x03(\v2\x18.ddex.ern.v383.WebPolicyR\twebPolicy\x12*\n" +
+	")deal_technical_resource_details_reference\x18\x01 \x03(\tR%dealTechnicalResourceDetailsReferenceJ\x06\b\xa8F\x10\x90N\"\xe7\x13\n" +
+	"\tDealTerms\x12.\n" +
+	"\x11is_pre_order_deal\x18\x01 \x01(\bH\x00R\x0eisPreOrderDeal\x88\x01\x01\x12V\n" +
+	"\x15commercial_model_type\x18\x02 \x03(\v2\".ddex.ern.v383.CommercialModelTypeR\x13commercialModelType\x12*\n" +
 	"\x05usage\x18\x0f \x03(\v2\x14.ddex.ern.v383.UsageR\x05usage\x12.\n" +
 	"\x13all_deals_cancelled\x18\x10 \x01(\bR\x11allDealsCancelled\x12\x1b\n" +
 	"\ttake_down\x18\x11 \x01(\bR\btakeDown\x12J\n" +
 	"\x0eterritory_code\x18\x12 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
 	"\x17excluded_territory_code\x18\x13 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12E\n" +
 	"\x14distribution_channel\x18\x14 \x03(\v2\x12.ddex.ern.v383.DSPR\x13distributionChannel\x12V\n" +
-	"\x1dexcluded_distribution_channel\x18\x15 \x03(\v2\x12.ddex.ern.v383.DSPR\x1bexcludedDistributionChannel\x12%\n" +
+	"\x1dexcluded_distribution_channel\x18\x15 \x03(\v2\x12.ddex.ern.v383.DSPR\x1bexcludedDistributionChannel\x12L\n" +
+	"\x11price_information\x18\x03 \x03(\v2\x1f.ddex.ern.v383.PriceInformationR\x10priceInformation\x12%\n" +
 	"\x0eis_promotional\x18\x16 \x01(\bR\risPromotional\x12I\n" +
-	"\x10promotional_code\x18\x17 \x01(\v2\x1e.ddex.ern.v383.PromotionalCodeR\x0fpromotionalCode\x12M\n" +
+	"\x10promotional_code\x18\x17 \x01(\v2\x1e.ddex.ern.v383.PromotionalCodeR\x0fpromotionalCode\x12>\n" +
+	"\x0fvalidity_period\x18\x04 \x03(\v2\x15.ddex.ern.v383.PeriodR\x0evalidityPeriod\x12Y\n" +
+	"\x16consumer_rental_period\x18\x05 \x01(\v2#.ddex.ern.v383.ConsumerRentalPeriodR\x14consumerRentalPeriod\x12M\n" +
+	"\x16pre_order_release_date\x18\x06 \x01(\v2\x18.ddex.ern.v383.EventDateR\x13preOrderReleaseDate\x12M\n" +
 	"\x16pre_order_preview_date\x18\x18 \x01(\v2\x18.ddex.ern.v383.EventDateR\x13preOrderPreviewDate\x12<\n" +
 	"\x1bpre_order_preview_date_time\x18\x19 \x01(\tR\x17preOrderPreviewDateTime\x12;\n" +
 	"\x1arelease_display_start_date\x18\x1a \x01(\tR\x17releaseDisplayStartDate\x12F\n" +
@@ -19761,28 +19833,50 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x1frelease_display_start_date_time\x18\x1e \x01(\tR\x1breleaseDisplayStartDateTime\x12O\n" +
 	"%track_listing_preview_start_date_time\x18\x1f \x01(\tR trackListingPreviewStartDateTime\x12G\n" +
 	"!cover_art_preview_start_date_time\x18  \x01(\tR\x1ccoverArtPreviewStartDateTime\x12>\n" +
-	"\x1cclip_preview_start_date_time\x18! \x01(\tR\x18clipPreviewStartDateTime\x127\n" +
-	"\x18language_and_script_code\x18\" \x01(\tR\x15languageAndScriptCode\"\xd6\x02\n" +
+	"\x1cclip_preview_start_date_time\x18! \x01(\tR\x18clipPreviewStartDateTime\x12r\n" +
+	"!pre_order_incentive_resource_list\x18\a \x01(\v2(.ddex.ern.v383.DealResourceReferenceListR\x1dpreOrderIncentiveResourceList\x12w\n" +
+	"#instant_gratification_resource_list\x18\b \x01(\v2(.ddex.ern.v383.DealResourceReferenceListR instantGratificationResourceList\x12&\n" +
+	"\fis_exclusive\x18\t \x01(\bH\x01R\visExclusive\x88\x01\x01\x12`\n" +
+	"\x19related_release_offer_set\x18\n" +
+	" \x03(\v2%.ddex.ern.v383.RelatedReleaseOfferSetR\x16relatedReleaseOfferSet\x12I\n" +
+	"\x10physical_returns\x18\v \x01(\v2\x1e.ddex.ern.v383.PhysicalReturnsR\x0fphysicalReturns\x12E\n" +
+	"\x1dnumber_of_products_per_carton\x18\f \x01(\x05H\x02R\x19numberOfProductsPerCarton\x88\x01\x01\x12P\n" +
+	"\x13rights_claim_policy\x18\r \x03(\v2 .ddex.ern.v383.RightsClaimPolicyR\x11rightsClaimPolicy\x127\n" +
+	"\n" +
+	"web_policy\x18\x0e \x03(\v2\x18.ddex.ern.v383.WebPolicyR\twebPolicy\x12<\n" +
+	"\x18language_and_script_code\x18\" \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_pre_order_dealB\x0f\n" +
+	"\r_is_exclusiveB \n" +
+	"\x1e_number_of_products_per_cartonB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xcd\x03\n" +
 	"\vFingerprint\x12 \n" +
 	"\vfingerprint\x18\x01 \x01(\tR\vfingerprint\x12e\n" +
-	"\x1afingerprint_algorithm_type\x18\x02 \x01(\v2'.ddex.ern.v383.FingerprintAlgorithmTypeR\x18fingerprintAlgorithmType\x12B\n" +
-	"\x1dfingerprint_algorithm_version\x18\x03 \x01(\tR\x1bfingerprintAlgorithmVersion\x12F\n" +
-	"\x1ffingerprint_algorithm_parameter\x18\x04 \x01(\tR\x1dfingerprintAlgorithmParameter\x122\n" +
-	"\x15fingerprint_data_type\x18\x05 \x01(\tR\x13fingerprintDataType\"\x84\x04\n" +
+	"\x1afingerprint_algorithm_type\x18\x02 \x01(\v2'.ddex.ern.v383.FingerprintAlgorithmTypeR\x18fingerprintAlgorithmType\x12G\n" +
+	"\x1dfingerprint_algorithm_version\x18\x03 \x01(\tH\x00R\x1bfingerprintAlgorithmVersion\x88\x01\x01\x12K\n" +
+	"\x1ffingerprint_algorithm_parameter\x18\x04 \x01(\tH\x01R\x1dfingerprintAlgorithmParameter\x88\x01\x01\x127\n" +
+	"\x15fingerprint_data_type\x18\x05 \x01(\tH\x02R\x13fingerprintDataType\x88\x01\x01B \n" +
+	"\x1e_fingerprint_algorithm_versionB\"\n" +
+	" _fingerprint_algorithm_parameterB\x18\n" +
+	"\x16_fingerprint_data_typeJ\x06\b\xa8F\x10\x90N\"\xdd\x04\n" +
 	"\x05Image\x127\n" +
 	"\n" +
-	"image_type\x18\x01 \x01(\v2\x18.ddex.ern.v383.ImageTypeR\timageType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x12?\n" +
+	"image_type\x18\x01 \x01(\v2\x18.ddex.ern.v383.ImageTypeR\timageType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x12?\n" +
 	"\bimage_id\x18\x03 \x03(\v2$.ddex.ern.v383.ResourceProprietaryIdR\aimageId\x12-\n" +
 	"\x12resource_reference\x18\x04 \x01(\tR\x11resourceReference\x12*\n" +
 	"\x05title\x18\x05 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12=\n" +
 	"\rcreation_date\x18\x06 \x01(\v2\x18.ddex.ern.v383.EventDateR\fcreationDate\x12c\n" +
-	"\x1aimage_details_by_territory\x18\a \x03(\v2&.ddex.ern.v383.ImageDetailsByTerritoryR\x17imageDetailsByTerritory\x12\x1d\n" +
+	"\x1aimage_details_by_territory\x18\a \x03(\v2&.ddex.ern.v383.ImageDetailsByTerritoryR\x17imageDetailsByTerritory\x12\"\n" +
 	"\n" +
-	"is_updated\x18\b \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\"\xac\n" +
+	"is_updated\x18\b \x01(\bH\x01R\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd6\n" +
 	"\n" +
-	"\x17ImageDetailsByTerritory\x12*\n" +
+	"\x17ImageDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x11 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12]\n" +
 	"\x14resource_contributor\x18\x02 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x12n\n" +
 	"\x1dindirect_resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.IndirectResourceContributorR\x1bindirectResourceContributor\x12C\n" +
@@ -19798,30 +19892,30 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\bsynopsis\x18\f \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12*\n" +
 	"\x05genre\x18\r \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
 	"\x15parental_warning_type\x18\x0e \x03(\v2\".ddex.ern.v383.ParentalWarningTypeR\x13parentalWarningType\x12\\\n" +
-	"\x17technical_image_details\x18\x0f \x03(\v2$.ddex.ern.v383.TechnicalImageDetailsR\x15technicalImageDetails\x12J\n" +
-	"\x0eterritory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x11 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x12 \x01(\tR\x15languageAndScriptCode\"\xb1\r\n" +
+	"\x17technical_image_details\x18\x0f \x03(\v2$.ddex.ern.v383.TechnicalImageDetailsR\x15technicalImageDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x12 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb7\x10\n" +
 	"\x04MIDI\x124\n" +
-	"\tmidi_type\x18\x01 \x01(\v2\x17.ddex.ern.v383.MidiTypeR\bmidiType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x12=\n" +
+	"\tmidi_type\x18\x01 \x01(\v2\x17.ddex.ern.v383.MidiTypeR\bmidiType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x12=\n" +
 	"\amidi_id\x18\x03 \x03(\v2$.ddex.ern.v383.ResourceProprietaryIdR\x06midiId\x12F\n" +
 	"\x10indirect_midi_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\x0eindirectMidiId\x12-\n" +
 	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x12F\n" +
 	"\x0freference_title\x18\x06 \x01(\v2\x1d.ddex.ern.v383.ReferenceTitleR\x0ereferenceTitle\x12[\n" +
-	"\x1binstrumentation_description\x18\a \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x1ainstrumentationDescription\x12\x1b\n" +
-	"\tis_medley\x18\b \x01(\bR\bisMedley\x12!\n" +
-	"\fis_potpourri\x18\t \x01(\bR\visPotpourri\x12'\n" +
+	"\x1binstrumentation_description\x18\a \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x1ainstrumentationDescription\x12 \n" +
+	"\tis_medley\x18\b \x01(\bH\x01R\bisMedley\x88\x01\x01\x12&\n" +
+	"\fis_potpourri\x18\t \x01(\bH\x02R\visPotpourri\x88\x01\x01\x12,\n" +
 	"\x0fis_instrumental\x18\n" +
-	" \x01(\bR\x0eisInstrumental\x12#\n" +
-	"\ris_background\x18\v \x01(\bR\fisBackground\x12,\n" +
-	"\x12is_hidden_resource\x18\f \x01(\bR\x10isHiddenResource\x12*\n" +
-	"\x11is_bonus_resource\x18\r \x01(\bR\x0fisBonusResource\x122\n" +
-	"\x15is_computer_generated\x18\x0e \x01(\bR\x13isComputerGenerated\x12*\n" +
-	"\x11no_silence_before\x18\x0f \x01(\bR\x0fnoSilenceBefore\x12(\n" +
-	"\x10no_silence_after\x18\x10 \x01(\bR\x0enoSilenceAfter\x12D\n" +
-	"\x1eperformer_information_required\x18\x11 \x01(\bR\x1cperformerInformationRequired\x126\n" +
-	"\x17language_of_performance\x18\x12 \x01(\tR\x15languageOfPerformance\x12\x1a\n" +
+	" \x01(\bH\x03R\x0eisInstrumental\x88\x01\x01\x12(\n" +
+	"\ris_background\x18\v \x01(\bH\x04R\fisBackground\x88\x01\x01\x121\n" +
+	"\x12is_hidden_resource\x18\f \x01(\bH\x05R\x10isHiddenResource\x88\x01\x01\x12/\n" +
+	"\x11is_bonus_resource\x18\r \x01(\bH\x06R\x0fisBonusResource\x88\x01\x01\x127\n" +
+	"\x15is_computer_generated\x18\x0e \x01(\bH\aR\x13isComputerGenerated\x88\x01\x01\x12/\n" +
+	"\x11no_silence_before\x18\x0f \x01(\bH\bR\x0fnoSilenceBefore\x88\x01\x01\x12-\n" +
+	"\x10no_silence_after\x18\x10 \x01(\bH\tR\x0enoSilenceAfter\x88\x01\x01\x12I\n" +
+	"\x1eperformer_information_required\x18\x11 \x01(\bH\n" +
+	"R\x1cperformerInformationRequired\x88\x01\x01\x12;\n" +
+	"\x17language_of_performance\x18\x12 \x01(\tH\vR\x15languageOfPerformance\x88\x01\x01\x12\x1a\n" +
 	"\bduration\x18\x13 \x01(\tR\bduration\x12P\n" +
 	"\x13rights_agreement_id\x18\x14 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12\x7f\n" +
 	"$resource_musical_work_reference_list\x18\x15 \x01(\v2/.ddex.ern.v383.ResourceMusicalWorkReferenceListR resourceMusicalWorkReferenceList\x12\x91\x01\n" +
@@ -19829,11 +19923,28 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\rcreation_date\x18\x17 \x01(\v2\x18.ddex.ern.v383.EventDateR\fcreationDate\x12=\n" +
 	"\rmastered_date\x18\x18 \x01(\v2\x18.ddex.ern.v383.EventDateR\fmasteredDate\x12A\n" +
 	"\x0fremastered_date\x18\x19 \x01(\v2\x18.ddex.ern.v383.EventDateR\x0eremasteredDate\x12`\n" +
-	"\x19midi_details_by_territory\x18\x1a \x03(\v2%.ddex.ern.v383.MidiDetailsByTerritoryR\x16midiDetailsByTerritory\x12\x1d\n" +
+	"\x19midi_details_by_territory\x18\x1a \x03(\v2%.ddex.ern.v383.MidiDetailsByTerritoryR\x16midiDetailsByTerritory\x12\"\n" +
+	"\n" +
+	"is_updated\x18\x1b \x01(\bH\fR\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x1c \x01(\tH\rR\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\f\n" +
 	"\n" +
-	"is_updated\x18\x1b \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18\x1c \x01(\tR\x15languageAndScriptCode\"\x86\x0e\n" +
-	"\x16MidiDetailsByTerritory\x12*\n" +
+	"_is_medleyB\x0f\n" +
+	"\r_is_potpourriB\x12\n" +
+	"\x10_is_instrumentalB\x10\n" +
+	"\x0e_is_backgroundB\x15\n" +
+	"\x13_is_hidden_resourceB\x14\n" +
+	"\x12_is_bonus_resourceB\x18\n" +
+	"\x16_is_computer_generatedB\x14\n" +
+	"\x12_no_silence_beforeB\x13\n" +
+	"\x11_no_silence_afterB!\n" +
+	"\x1f_performer_information_requiredB\x1a\n" +
+	"\x18_language_of_performanceB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc9\x0e\n" +
+	"\x16MidiDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x17 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x18 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12<\n" +
 	"\x0edisplay_artist\x18\x02 \x03(\v2\x15.ddex.ern.v383.ArtistR\rdisplayArtist\x12]\n" +
 	"\x14resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x12n\n" +
@@ -19848,8 +19959,8 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	" \x01(\v2\x18.ddex.ern.v383.EventDateR\x13resourceReleaseDate\x12]\n" +
 	"\x1eoriginal_resource_release_date\x18\v \x01(\v2\x18.ddex.ern.v383.EventDateR\x1boriginalResourceReleaseDate\x12+\n" +
 	"\x06c_line\x18\f \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x12@\n" +
-	"\rcourtesy_line\x18\r \x01(\v2\x1b.ddex.ern.v383.CourtesyLineR\fcourtesyLine\x12'\n" +
-	"\x0fsequence_number\x18\x0e \x01(\x05R\x0esequenceNumber\x12M\n" +
+	"\rcourtesy_line\x18\r \x01(\v2\x1b.ddex.ern.v383.CourtesyLineR\fcourtesyLine\x12,\n" +
+	"\x0fsequence_number\x18\x0e \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12M\n" +
 	"\x12host_sound_carrier\x18\x0f \x03(\v2\x1f.ddex.ern.v383.HostSoundCarrierR\x10hostSoundCarrier\x12C\n" +
 	"\x11marketing_comment\x18\x10 \x01(\v2\x16.ddex.ern.v383.CommentR\x10marketingComment\x12*\n" +
 	"\x05genre\x18\x11 \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
@@ -19857,18 +19968,21 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x10fulfillment_date\x18\x13 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x123\n" +
 	"\bkeywords\x18\x14 \x03(\v2\x17.ddex.ern.v383.KeywordsR\bkeywords\x123\n" +
 	"\bsynopsis\x18\x15 \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12Y\n" +
-	"\x16technical_midi_details\x18\x16 \x03(\v2#.ddex.ern.v383.TechnicalMidiDetailsR\x14technicalMidiDetails\x12J\n" +
-	"\x0eterritory_code\x18\x17 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x18 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x19 \x01(\tR\x15languageAndScriptCode\"\x93\x01\n" +
+	"\x16technical_midi_details\x18\x16 \x03(\v2#.ddex.ern.v383.TechnicalMidiDetailsR\x14technicalMidiDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x19 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc5\x01\n" +
 	"\x0fPhysicalReturns\x128\n" +
-	"\x18physical_returns_allowed\x18\x01 \x01(\bR\x16physicalReturnsAllowed\x12F\n" +
-	" latest_date_for_physical_returns\x18\x02 \x01(\tR\x1clatestDateForPhysicalReturns\"\xca\x01\n" +
+	"\x18physical_returns_allowed\x18\x01 \x01(\bR\x16physicalReturnsAllowed\x12K\n" +
+	" latest_date_for_physical_returns\x18\x02 \x01(\tH\x00R\x1clatestDateForPhysicalReturns\x88\x01\x01B#\n" +
+	"!_latest_date_for_physical_returnsJ\x06\b\xa8F\x10\x90N\"\x88\x02\n" +
 	"\x0ePreviewDetails\x127\n" +
-	"\tpart_type\x18\x01 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\bpartType\x12&\n" +
-	"\x0ftop_left_corner\x18\x02 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x03 \x01(\tR\x11bottomRightCorner\x12'\n" +
-	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionType\"\xf2\x03\n" +
+	"\tpart_type\x18\x01 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\bpartType\x12+\n" +
+	"\x0ftop_left_corner\x18\x02 \x01(\tH\x00R\rtopLeftCorner\x88\x01\x01\x123\n" +
+	"\x13bottom_right_corner\x18\x03 \x01(\tH\x01R\x11bottomRightCorner\x88\x01\x01\x12'\n" +
+	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionTypeB\x12\n" +
+	"\x10_top_left_cornerB\x16\n" +
+	"\x14_bottom_right_cornerJ\x06\b\xa8F\x10\x90N\"\xa7\x04\n" +
 	"\x10PriceInformation\x12<\n" +
 	"\vdescription\x18\x01 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\vdescription\x12G\n" +
 	"\x10price_range_type\x18\x02 \x01(\v2\x1d.ddex.ern.v383.PriceRangeTypeR\x0epriceRangeType\x127\n" +
@@ -19876,58 +19990,66 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"price_type\x18\x03 \x01(\v2\x18.ddex.ern.v383.PriceTypeR\tpriceType\x12M\n" +
 	"\x18wholesale_price_per_unit\x18\x04 \x01(\v2\x14.ddex.ern.v383.PriceR\x15wholesalePricePerUnit\x12a\n" +
 	"#bulk_order_wholesale_price_per_unit\x18\x05 \x01(\v2\x14.ddex.ern.v383.PriceR\x1ebulkOrderWholesalePricePerUnit\x12J\n" +
-	"\x16suggested_retail_price\x18\x06 \x01(\v2\x14.ddex.ern.v383.PriceR\x14suggestedRetailPrice\x12 \n" +
-	"\fprice_type_1\x18\a \x01(\tR\n" +
-	"priceType1\"\xd3\x01\n" +
+	"\x16suggested_retail_price\x18\x06 \x01(\v2\x14.ddex.ern.v383.PriceR\x14suggestedRetailPrice\x12+\n" +
+	"\x0fprice_type_attr\x18\a \x01(\tH\x00R\rpriceTypeAttr\x88\x01\x01B\x12\n" +
+	"\x10_price_type_attrJ\x06\b\xa8F\x10\x90NR\fprice_type_1\"\xdb\x01\n" +
 	"\rPurgedRelease\x127\n" +
 	"\n" +
 	"release_id\x18\x01 \x01(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12*\n" +
 	"\x05title\x18\x02 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12]\n" +
-	"\x14resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\"\x80\x02\n" +
-	"\x16RelatedReleaseOfferSet\x12'\n" +
-	"\x04deal\x18\x01 \x03(\v2\x13.ddex.ern.v383.DealR\x04deal\x127\n" +
+	"\x14resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributorJ\x06\b\xa8F\x10\x90N\"\xaa\x02\n" +
+	"\x16RelatedReleaseOfferSet\x127\n" +
 	"\n" +
 	"release_id\x18\x02 \x03(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12K\n" +
-	"\x13release_description\x18\x03 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x12releaseDescription\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\"\xac\f\n" +
+	"\x13release_description\x18\x03 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x12releaseDescription\x12'\n" +
+	"\x04deal\x18\x01 \x03(\v2\x13.ddex.ern.v383.DealR\x04deal\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x81\r\n" +
 	"\aRelease\x127\n" +
 	"\n" +
 	"release_id\x18\x01 \x03(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12+\n" +
 	"\x11release_reference\x18\x02 \x03(\tR\x10releaseReference\x12Y\n" +
 	"\x16external_resource_link\x18\x03 \x03(\v2#.ddex.ern.v383.ExternalResourceLinkR\x14externalResourceLink\x12s\n" +
 	" sales_reporting_proxy_release_id\x18\x04 \x03(\v2+.ddex.ern.v383.SalesReportingProxyReleaseIdR\x1csalesReportingProxyReleaseId\x12F\n" +
-	"\x0freference_title\x18\x05 \x01(\v2\x1d.ddex.ern.v383.ReferenceTitleR\x0ereferenceTitle\x12x\n" +
+	"\x0freference_title\x18\x05 \x01(\v2\x1d.ddex.ern.v383.ReferenceTitleR\x0ereferenceTitle\x12r\n" +
+	"\x1frelease_resource_reference_list\x18\x13 \x01(\v2+.ddex.ern.v383.ReleaseResourceReferenceListR\x1creleaseResourceReferenceList\x12_\n" +
+	"\x18resource_omission_reason\x18\x14 \x01(\v2%.ddex.ern.v383.ResourceOmissionReasonR\x16resourceOmissionReason\x12x\n" +
 	"!release_collection_reference_list\x18\x06 \x01(\v2-.ddex.ern.v383.ReleaseCollectionReferenceListR\x1ereleaseCollectionReferenceList\x12=\n" +
 	"\frelease_type\x18\a \x03(\v2\x1a.ddex.ern.v383.ReleaseTypeR\vreleaseType\x12i\n" +
 	"\x1crelease_details_by_territory\x18\b \x03(\v2(.ddex.ern.v383.ReleaseDetailsByTerritoryR\x19releaseDetailsByTerritory\x126\n" +
 	"\x17language_of_performance\x18\t \x03(\tR\x15languageOfPerformance\x12.\n" +
 	"\x13language_of_dubbing\x18\n" +
 	" \x03(\tR\x11languageOfDubbing\x12,\n" +
-	"\x12sub_title_language\x18\v \x03(\tR\x10subTitleLanguage\x12\x1a\n" +
-	"\bduration\x18\f \x01(\tR\bduration\x12P\n" +
+	"\x12sub_title_language\x18\v \x03(\tR\x10subTitleLanguage\x12\x1f\n" +
+	"\bduration\x18\f \x01(\tH\x00R\bduration\x88\x01\x01\x12P\n" +
 	"\x13rights_agreement_id\x18\r \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12+\n" +
 	"\x06p_line\x18\x0e \x03(\v2\x14.ddex.ern.v383.PLineR\x05pLine\x12+\n" +
 	"\x06c_line\x18\x0f \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x12F\n" +
 	"\x13artist_profile_page\x18\x10 \x03(\v2\x16.ddex.ern.v383.WebPageR\x11artistProfilePage\x12H\n" +
 	"\x13global_release_date\x18\x11 \x01(\v2\x18.ddex.ern.v383.EventDateR\x11globalReleaseDate\x12Y\n" +
-	"\x1cglobal_original_release_date\x18\x12 \x01(\v2\x18.ddex.ern.v383.EventDateR\x19globalOriginalReleaseDate\x12r\n" +
-	"\x1frelease_resource_reference_list\x18\x13 \x01(\v2+.ddex.ern.v383.ReleaseResourceReferenceListR\x1creleaseResourceReferenceList\x12_\n" +
-	"\x18resource_omission_reason\x18\x14 \x01(\v2%.ddex.ern.v383.ResourceOmissionReasonR\x16resourceOmissionReason\x127\n" +
-	"\x18language_and_script_code\x18\x15 \x01(\tR\x15languageAndScriptCode\x12&\n" +
-	"\x0fis_main_release\x18\x16 \x01(\bR\risMainRelease\"\xcc\x01\n" +
+	"\x1cglobal_original_release_date\x18\x12 \x01(\v2\x18.ddex.ern.v383.EventDateR\x19globalOriginalReleaseDate\x12<\n" +
+	"\x18language_and_script_code\x18\x15 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12+\n" +
+	"\x0fis_main_release\x18\x16 \x01(\bH\x02R\risMainRelease\x88\x01\x01B\v\n" +
+	"\t_durationB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_main_releaseJ\x06\b\xa8F\x10\x90N\"\x8e\x02\n" +
 	"\vReleaseDeal\x124\n" +
 	"\x16deal_release_reference\x18\x01 \x03(\tR\x14dealReleaseReference\x12'\n" +
-	"\x04deal\x18\x02 \x03(\v2\x13.ddex.ern.v383.DealR\x04deal\x12%\n" +
-	"\x0eeffective_date\x18\x03 \x01(\tR\reffectiveDate\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\"\xb1\x0f\n" +
-	"\x19ReleaseDetailsByTerritory\x12C\n" +
+	"\x04deal\x18\x02 \x03(\v2\x13.ddex.ern.v383.DealR\x04deal\x12*\n" +
+	"\x0eeffective_date\x18\x03 \x01(\tH\x00R\reffectiveDate\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_effective_dateB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xae\x10\n" +
+	"\x19ReleaseDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x19 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x1a \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12C\n" +
 	"\x13display_artist_name\x18\x01 \x03(\v2\x13.ddex.ern.v383.NameR\x11displayArtistName\x127\n" +
 	"\n" +
 	"label_name\x18\x02 \x03(\v2\x18.ddex.ern.v383.LabelNameR\tlabelName\x12P\n" +
 	"\x13rights_agreement_id\x18\x03 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12*\n" +
 	"\x05title\x18\x04 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12<\n" +
-	"\x0edisplay_artist\x18\x05 \x03(\v2\x15.ddex.ern.v383.ArtistR\rdisplayArtist\x12=\n" +
-	"\x1bis_multi_artist_compilation\x18\x06 \x01(\bR\x18isMultiArtistCompilation\x12n\n" +
+	"\x0edisplay_artist\x18\x05 \x03(\v2\x15.ddex.ern.v383.ArtistR\rdisplayArtist\x12B\n" +
+	"\x1bis_multi_artist_compilation\x18\x06 \x01(\bH\x00R\x18isMultiArtistCompilation\x88\x01\x01\x12n\n" +
 	"\x1dadministrating_record_company\x18\a \x03(\v2*.ddex.ern.v383.AdministratingRecordCompanyR\x1badministratingRecordCompany\x12=\n" +
 	"\frelease_type\x18\b \x03(\v2\x1a.ddex.ern.v383.ReleaseTypeR\vreleaseType\x12F\n" +
 	"\x0frelated_release\x18\t \x03(\v2\x1d.ddex.ern.v383.RelatedReleaseR\x0erelatedRelease\x12V\n" +
@@ -19941,23 +20063,25 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x06c_line\x18\x10 \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x12;\n" +
 	"\frelease_date\x18\x11 \x01(\v2\x18.ddex.ern.v383.EventDateR\vreleaseDate\x12L\n" +
 	"\x15original_release_date\x18\x12 \x01(\v2\x18.ddex.ern.v383.EventDateR\x13originalReleaseDate\x12[\n" +
-	"\x1doriginal_digital_release_date\x18\x13 \x01(\v2\x18.ddex.ern.v383.EventDateR\x1aoriginalDigitalReleaseDate\x123\n" +
+	"\x1doriginal_digital_release_date\x18\x13 \x01(\v2\x18.ddex.ern.v383.EventDateR\x1aoriginalDigitalReleaseDate\x12^\n" +
+	"\x1dfile_availability_description\x18\x1b \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
+	"\x04file\x18\x1c \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x123\n" +
 	"\bkeywords\x18\x14 \x03(\v2\x17.ddex.ern.v383.KeywordsR\bkeywords\x123\n" +
 	"\bsynopsis\x18\x15 \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x126\n" +
-	"\tcharacter\x18\x16 \x03(\v2\x18.ddex.ern.v383.CharacterR\tcharacter\x12M\n" +
-	"$number_of_units_per_physical_release\x18\x17 \x01(\x05R\x1fnumberOfUnitsPerPhysicalRelease\x12B\n" +
-	"\x11display_conductor\x18\x18 \x03(\v2\x15.ddex.ern.v383.ArtistR\x10displayConductor\x12J\n" +
-	"\x0eterritory_code\x18\x19 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x1a \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12^\n" +
-	"\x1dfile_availability_description\x18\x1b \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\x1c \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\x1d \x01(\tR\x15languageAndScriptCode\"x\n" +
+	"\tcharacter\x18\x16 \x03(\v2\x18.ddex.ern.v383.CharacterR\tcharacter\x12R\n" +
+	"$number_of_units_per_physical_release\x18\x17 \x01(\x05H\x01R\x1fnumberOfUnitsPerPhysicalRelease\x88\x01\x01\x12B\n" +
+	"\x11display_conductor\x18\x18 \x03(\v2\x15.ddex.ern.v383.ArtistR\x10displayConductor\x12<\n" +
+	"\x18language_and_script_code\x18\x1d \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\x1e\n" +
+	"\x1c_is_multi_artist_compilationB'\n" +
+	"%_number_of_units_per_physical_releaseB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\vReleaseList\x120\n" +
-	"\arelease\x18\x01 \x03(\v2\x16.ddex.ern.v383.ReleaseR\arelease\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xae\b\n" +
+	"\arelease\x18\x01 \x03(\v2\x16.ddex.ern.v383.ReleaseR\arelease\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xf1\b\n" +
 	"\rResourceGroup\x12*\n" +
-	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12'\n" +
-	"\x0fsequence_number\x18\x02 \x01(\x05R\x0esequenceNumber\x12<\n" +
+	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12,\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12<\n" +
 	"\x0edisplay_artist\x18\x03 \x03(\v2\x15.ddex.ern.v383.ArtistR\rdisplayArtist\x12B\n" +
 	"\x11display_conductor\x18\x04 \x03(\v2\x15.ddex.ern.v383.ArtistR\x10displayConductor\x12@\n" +
 	"\x10display_composer\x18\x05 \x03(\v2\x15.ddex.ern.v383.ArtistR\x0fdisplayComposer\x12]\n" +
@@ -19970,8 +20094,10 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"&resource_group_resource_reference_list\x18\v \x01(\v21.ddex.ern.v383.ResourceGroupResourceReferenceListR\"resourceGroupResourceReferenceList\x12G\n" +
 	" resource_group_release_reference\x18\f \x01(\tR\x1dresourceGroupReleaseReference\x127\n" +
 	"\n" +
-	"release_id\x18\r \x01(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x127\n" +
-	"\x18language_and_script_code\x18\x0e \x01(\tR\x15languageAndScriptCode\"\x85\x04\n" +
+	"release_id\x18\r \x01(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12<\n" +
+	"\x18language_and_script_code\x18\x0e \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xaf\x04\n" +
 	"\fResourceList\x12F\n" +
 	"\x0fsound_recording\x18\x01 \x03(\v2\x1d.ddex.ern.v383.SoundRecordingR\x0esoundRecording\x12*\n" +
 	"\am_i_d_i\x18\x02 \x03(\v2\x13.ddex.ern.v383.MIDIR\x04mIDI\x12*\n" +
@@ -19981,30 +20107,37 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\vsheet_music\x18\x06 \x03(\v2\x19.ddex.ern.v383.SheetMusicR\n" +
 	"sheetMusic\x123\n" +
 	"\bsoftware\x18\a \x03(\v2\x17.ddex.ern.v383.SoftwareR\bsoftware\x12V\n" +
-	"\x15user_defined_resource\x18\b \x03(\v2\".ddex.ern.v383.UserDefinedResourceR\x13userDefinedResource\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\"s\n" +
+	"\x15user_defined_resource\x18\b \x03(\v2\".ddex.ern.v383.UserDefinedResourceR\x13userDefinedResource\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"{\n" +
 	"\rResourceUsage\x126\n" +
 	"\x17deal_resource_reference\x18\x01 \x03(\tR\x15dealResourceReference\x12*\n" +
-	"\x05usage\x18\x02 \x03(\v2\x14.ddex.ern.v383.UsageR\x05usage\"\xb1\b\n" +
+	"\x05usage\x18\x02 \x03(\v2\x14.ddex.ern.v383.UsageR\x05usageJ\x06\b\xa8F\x10\x90N\"\xa6\t\n" +
 	"\n" +
 	"SheetMusic\x12G\n" +
-	"\x10sheet_music_type\x18\x01 \x01(\v2\x1d.ddex.ern.v383.SheetMusicTypeR\x0esheetMusicType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x12A\n" +
+	"\x10sheet_music_type\x18\x01 \x01(\v2\x1d.ddex.ern.v383.SheetMusicTypeR\x0esheetMusicType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x12A\n" +
 	"\x0esheet_music_id\x18\x03 \x03(\v2\x1b.ddex.ern.v383.SheetMusicIdR\fsheetMusicId\x12S\n" +
 	"\x17indirect_sheet_music_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\x14indirectSheetMusicId\x12-\n" +
-	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x12,\n" +
-	"\x12language_of_lyrics\x18\x06 \x01(\tR\x10languageOfLyrics\x12P\n" +
+	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x121\n" +
+	"\x12language_of_lyrics\x18\x06 \x01(\tH\x01R\x10languageOfLyrics\x88\x01\x01\x12P\n" +
 	"\x13rights_agreement_id\x18\a \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12\x7f\n" +
 	"$resource_musical_work_reference_list\x18\b \x01(\v2/.ddex.ern.v383.ResourceMusicalWorkReferenceListR resourceMusicalWorkReferenceList\x12\x91\x01\n" +
 	"*resource_contained_resource_reference_list\x18\t \x01(\v25.ddex.ern.v383.ResourceContainedResourceReferenceListR&resourceContainedResourceReferenceList\x12F\n" +
 	"\x0freference_title\x18\n" +
 	" \x01(\v2\x1d.ddex.ern.v383.ReferenceTitleR\x0ereferenceTitle\x12=\n" +
 	"\rcreation_date\x18\v \x01(\v2\x18.ddex.ern.v383.EventDateR\fcreationDate\x12s\n" +
-	" sheet_music_details_by_territory\x18\f \x03(\v2+.ddex.ern.v383.SheetMusicDetailsByTerritoryR\x1csheetMusicDetailsByTerritory\x12\x1d\n" +
+	" sheet_music_details_by_territory\x18\f \x03(\v2+.ddex.ern.v383.SheetMusicDetailsByTerritoryR\x1csheetMusicDetailsByTerritory\x12\"\n" +
 	"\n" +
-	"is_updated\x18\r \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18\x0e \x01(\tR\x15languageAndScriptCode\"\x99\t\n" +
-	"\x1cSheetMusicDetailsByTerritory\x12*\n" +
+	"is_updated\x18\r \x01(\bH\x02R\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x0e \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\x15\n" +
+	"\x13_language_of_lyricsB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc3\t\n" +
+	"\x1cSheetMusicDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\r \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x0e \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12]\n" +
 	"\x14resource_contributor\x18\x02 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x12n\n" +
 	"\x1dindirect_resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.IndirectResourceContributorR\x1bindirectResourceContributor\x12C\n" +
@@ -20017,13 +20150,12 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x05genre\x18\n" +
 	" \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
 	"\x15parental_warning_type\x18\v \x03(\v2\".ddex.ern.v383.ParentalWarningTypeR\x13parentalWarningType\x12l\n" +
-	"\x1dtechnical_sheet_music_details\x18\f \x03(\v2).ddex.ern.v383.TechnicalSheetMusicDetailsR\x1atechnicalSheetMusicDetails\x12J\n" +
-	"\x0eterritory_code\x18\r \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x0e \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x0f \x01(\tR\x15languageAndScriptCode\"\x84\a\n" +
+	"\x1dtechnical_sheet_music_details\x18\f \x03(\v2).ddex.ern.v383.TechnicalSheetMusicDetailsR\x1atechnicalSheetMusicDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x0f \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xdd\a\n" +
 	"\bSoftware\x12@\n" +
-	"\rsoftware_type\x18\x01 \x01(\v2\x1b.ddex.ern.v383.SoftwareTypeR\fsoftwareType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x12E\n" +
+	"\rsoftware_type\x18\x01 \x01(\v2\x1b.ddex.ern.v383.SoftwareTypeR\fsoftwareType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x12E\n" +
 	"\vsoftware_id\x18\x03 \x03(\v2$.ddex.ern.v383.ResourceProprietaryIdR\n" +
 	"softwareId\x12N\n" +
 	"\x14indirect_software_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\x12indirectSoftwareId\x12-\n" +
@@ -20033,12 +20165,17 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x05title\x18\b \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12=\n" +
 	"\rcreation_date\x18\t \x01(\v2\x18.ddex.ern.v383.EventDateR\fcreationDate\x12l\n" +
 	"\x1dsoftware_details_by_territory\x18\n" +
-	" \x03(\v2).ddex.ern.v383.SoftwareDetailsByTerritoryR\x1asoftwareDetailsByTerritory\x12\x1d\n" +
+	" \x03(\v2).ddex.ern.v383.SoftwareDetailsByTerritoryR\x1asoftwareDetailsByTerritory\x12\"\n" +
 	"\n" +
-	"is_updated\x18\v \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18\f \x01(\tR\x15languageAndScriptCode\"\xa7\n" +
+	"is_updated\x18\v \x01(\bH\x01R\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\f \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd1\n" +
 	"\n" +
-	"\x1aSoftwareDetailsByTerritory\x12*\n" +
+	"\x1aSoftwareDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x11 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12]\n" +
 	"\x14resource_contributor\x18\x02 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x12n\n" +
 	"\x1dindirect_resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.IndirectResourceContributorR\x1bindirectResourceContributor\x12C\n" +
@@ -20054,32 +20191,32 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\bsynopsis\x18\f \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12*\n" +
 	"\x05genre\x18\r \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
 	"\x15parental_warning_type\x18\x0e \x03(\v2\".ddex.ern.v383.ParentalWarningTypeR\x13parentalWarningType\x12e\n" +
-	"\x1atechnical_software_details\x18\x0f \x03(\v2'.ddex.ern.v383.TechnicalSoftwareDetailsR\x18technicalSoftwareDetails\x12J\n" +
-	"\x0eterritory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x11 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x12 \x01(\tR\x15languageAndScriptCode\"\xf8\x12\n" +
+	"\x1atechnical_software_details\x18\x0f \x03(\v2'.ddex.ern.v383.TechnicalSoftwareDetailsR\x18technicalSoftwareDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x12 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd4\x17\n" +
 	"\x0eSoundRecording\x12S\n" +
-	"\x14sound_recording_type\x18\x01 \x01(\v2!.ddex.ern.v383.SoundRecordingTypeR\x12soundRecordingType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x12M\n" +
+	"\x14sound_recording_type\x18\x01 \x01(\v2!.ddex.ern.v383.SoundRecordingTypeR\x12soundRecordingType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x12M\n" +
 	"\x12sound_recording_id\x18\x03 \x03(\v2\x1f.ddex.ern.v383.SoundRecordingIdR\x10soundRecordingId\x12[\n" +
 	"\x1bindirect_sound_recording_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\x18indirectSoundRecordingId\x12-\n" +
 	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x12F\n" +
 	"\x0freference_title\x18\x06 \x01(\v2\x1d.ddex.ern.v383.ReferenceTitleR\x0ereferenceTitle\x12[\n" +
-	"\x1binstrumentation_description\x18\a \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x1ainstrumentationDescription\x12\x1b\n" +
-	"\tis_medley\x18\b \x01(\bR\bisMedley\x12!\n" +
-	"\fis_potpourri\x18\t \x01(\bR\visPotpourri\x12'\n" +
+	"\x1binstrumentation_description\x18\a \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x1ainstrumentationDescription\x12 \n" +
+	"\tis_medley\x18\b \x01(\bH\x01R\bisMedley\x88\x01\x01\x12&\n" +
+	"\fis_potpourri\x18\t \x01(\bH\x02R\visPotpourri\x88\x01\x01\x12,\n" +
 	"\x0fis_instrumental\x18\n" +
-	" \x01(\bR\x0eisInstrumental\x12#\n" +
-	"\ris_background\x18\v \x01(\bR\fisBackground\x12,\n" +
-	"\x12is_hidden_resource\x18\f \x01(\bR\x10isHiddenResource\x12*\n" +
-	"\x11is_bonus_resource\x18\r \x01(\bR\x0fisBonusResource\x129\n" +
-	"\x19has_pre_order_fulfillment\x18\x0e \x01(\bR\x16hasPreOrderFulfillment\x122\n" +
-	"\x15is_computer_generated\x18\x0f \x01(\bR\x13isComputerGenerated\x12#\n" +
-	"\ris_remastered\x18\x10 \x01(\bR\fisRemastered\x12*\n" +
-	"\x11no_silence_before\x18\x11 \x01(\bR\x0fnoSilenceBefore\x12(\n" +
-	"\x10no_silence_after\x18\x12 \x01(\bR\x0enoSilenceAfter\x12D\n" +
-	"\x1eperformer_information_required\x18\x13 \x01(\bR\x1cperformerInformationRequired\x126\n" +
-	"\x17language_of_performance\x18\x14 \x01(\tR\x15languageOfPerformance\x12\x1a\n" +
+	" \x01(\bH\x03R\x0eisInstrumental\x88\x01\x01\x12(\n" +
+	"\ris_background\x18\v \x01(\bH\x04R\fisBackground\x88\x01\x01\x121\n" +
+	"\x12is_hidden_resource\x18\f \x01(\bH\x05R\x10isHiddenResource\x88\x01\x01\x12/\n" +
+	"\x11is_bonus_resource\x18\r \x01(\bH\x06R\x0fisBonusResource\x88\x01\x01\x12>\n" +
+	"\x19has_pre_order_fulfillment\x18\x0e \x01(\bH\aR\x16hasPreOrderFulfillment\x88\x01\x01\x127\n" +
+	"\x15is_computer_generated\x18\x0f \x01(\bH\bR\x13isComputerGenerated\x88\x01\x01\x12(\n" +
+	"\ris_remastered\x18\x10 \x01(\bH\tR\fisRemastered\x88\x01\x01\x12/\n" +
+	"\x11no_silence_before\x18\x11 \x01(\bH\n" +
+	"R\x0fnoSilenceBefore\x88\x01\x01\x12-\n" +
+	"\x10no_silence_after\x18\x12 \x01(\bH\vR\x0enoSilenceAfter\x88\x01\x01\x12I\n" +
+	"\x1eperformer_information_required\x18\x13 \x01(\bH\fR\x1cperformerInformationRequired\x88\x01\x01\x12;\n" +
+	"\x17language_of_performance\x18\x14 \x01(\tH\rR\x15languageOfPerformance\x88\x01\x01\x12\x1a\n" +
 	"\bduration\x18\x15 \x01(\tR\bduration\x12P\n" +
 	"\x13rights_agreement_id\x18\x16 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12\x8e\x01\n" +
 	")sound_recording_collection_reference_list\x18\x17 \x01(\v24.ddex.ern.v383.SoundRecordingCollectionReferenceListR%soundRecordingCollectionReferenceList\x12\x7f\n" +
@@ -20089,15 +20226,38 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\rmastered_date\x18\x1b \x01(\v2\x18.ddex.ern.v383.EventDateR\fmasteredDate\x12A\n" +
 	"\x0fremastered_date\x18\x1c \x01(\v2\x18.ddex.ern.v383.EventDateR\x0eremasteredDate\x12\x7f\n" +
 	"$sound_recording_details_by_territory\x18\x1d \x03(\v2/.ddex.ern.v383.SoundRecordingDetailsByTerritoryR soundRecordingDetailsByTerritory\x12]\n" +
-	"\x1aterritory_of_commissioning\x18\x1e \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x18territoryOfCommissioning\x12;\n" +
-	"\x1anumber_of_featured_artists\x18\x1f \x01(\x05R\x17numberOfFeaturedArtists\x12B\n" +
-	"\x1enumber_of_non_featured_artists\x18  \x01(\x05R\x1anumberOfNonFeaturedArtists\x12?\n" +
-	"\x1cnumber_of_contracted_artists\x18! \x01(\x05R\x19numberOfContractedArtists\x12F\n" +
-	" number_of_non_contracted_artists\x18\" \x01(\x05R\x1cnumberOfNonContractedArtists\x12\x1d\n" +
+	"\x1aterritory_of_commissioning\x18\x1e \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x18territoryOfCommissioning\x12@\n" +
+	"\x1anumber_of_featured_artists\x18\x1f \x01(\x05H\x0eR\x17numberOfFeaturedArtists\x88\x01\x01\x12G\n" +
+	"\x1enumber_of_non_featured_artists\x18  \x01(\x05H\x0fR\x1anumberOfNonFeaturedArtists\x88\x01\x01\x12D\n" +
+	"\x1cnumber_of_contracted_artists\x18! \x01(\x05H\x10R\x19numberOfContractedArtists\x88\x01\x01\x12K\n" +
+	" number_of_non_contracted_artists\x18\" \x01(\x05H\x11R\x1cnumberOfNonContractedArtists\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_updated\x18# \x01(\bH\x12R\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18$ \x01(\tH\x13R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\f\n" +
 	"\n" +
-	"is_updated\x18# \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18$ \x01(\tR\x15languageAndScriptCode\"\xa9\x0f\n" +
-	" SoundRecordingDetailsByTerritory\x12*\n" +
+	"_is_medleyB\x0f\n" +
+	"\r_is_potpourriB\x12\n" +
+	"\x10_is_instrumentalB\x10\n" +
+	"\x0e_is_backgroundB\x15\n" +
+	"\x13_is_hidden_resourceB\x14\n" +
+	"\x12_is_bonus_resourceB\x1c\n" +
+	"\x1a_has_pre_order_fulfillmentB\x18\n" +
+	"\x16_is_computer_generatedB\x10\n" +
+	"\x0e_is_remasteredB\x14\n" +
+	"\x12_no_silence_beforeB\x13\n" +
+	"\x11_no_silence_afterB!\n" +
+	"\x1f_performer_information_requiredB\x1a\n" +
+	"\x18_language_of_performanceB\x1d\n" +
+	"\x1b_number_of_featured_artistsB!\n" +
+	"\x1f_number_of_non_featured_artistsB\x1f\n" +
+	"\x1d_number_of_contracted_artistsB#\n" +
+	"!_number_of_non_contracted_artistsB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xec\x0f\n" +
+	" SoundRecordingDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x19 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x1a \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12<\n" +
 	"\x0edisplay_artist\x18\x02 \x03(\v2\x15.ddex.ern.v383.ArtistR\rdisplayArtist\x12B\n" +
 	"\x11display_conductor\x18\x03 \x03(\v2\x15.ddex.ern.v383.ArtistR\x10displayConductor\x12]\n" +
@@ -20113,8 +20273,8 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x15resource_release_date\x18\v \x01(\v2\x18.ddex.ern.v383.EventDateR\x13resourceReleaseDate\x12]\n" +
 	"\x1eoriginal_resource_release_date\x18\f \x01(\v2\x18.ddex.ern.v383.EventDateR\x1boriginalResourceReleaseDate\x12+\n" +
 	"\x06p_line\x18\r \x03(\v2\x14.ddex.ern.v383.PLineR\x05pLine\x12@\n" +
-	"\rcourtesy_line\x18\x0e \x01(\v2\x1b.ddex.ern.v383.CourtesyLineR\fcourtesyLine\x12'\n" +
-	"\x0fsequence_number\x18\x0f \x01(\x05R\x0esequenceNumber\x12M\n" +
+	"\rcourtesy_line\x18\x0e \x01(\v2\x1b.ddex.ern.v383.CourtesyLineR\fcourtesyLine\x12,\n" +
+	"\x0fsequence_number\x18\x0f \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12M\n" +
 	"\x12host_sound_carrier\x18\x10 \x03(\v2\x1f.ddex.ern.v383.HostSoundCarrierR\x10hostSoundCarrier\x12C\n" +
 	"\x11marketing_comment\x18\x11 \x01(\v2\x16.ddex.ern.v383.CommentR\x10marketingComment\x12*\n" +
 	"\x05genre\x18\x12 \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
@@ -20123,19 +20283,25 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"!technical_sound_recording_details\x18\x15 \x03(\v2-.ddex.ern.v383.TechnicalSoundRecordingDetailsR\x1etechnicalSoundRecordingDetails\x12I\n" +
 	"\x10fulfillment_date\x18\x16 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x123\n" +
 	"\bkeywords\x18\x17 \x03(\v2\x17.ddex.ern.v383.KeywordsR\bkeywords\x123\n" +
-	"\bsynopsis\x18\x18 \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12J\n" +
-	"\x0eterritory_code\x18\x19 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x1a \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x1b \x01(\tR\x15languageAndScriptCode\"\xb2\x02\n" +
+	"\bsynopsis\x18\x18 \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12<\n" +
+	"\x18language_and_script_code\x18\x1b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xaa\x03\n" +
 	"\x1cSoundRecordingPreviewDetails\x127\n" +
-	"\tpart_type\x18\x01 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\bpartType\x12\x1f\n" +
-	"\vstart_point\x18\x02 \x01(\tR\n" +
-	"startPoint\x12\x1b\n" +
-	"\tend_point\x18\x03 \x01(\tR\bendPoint\x12\x1a\n" +
-	"\bduration\x18\x04 \x01(\tR\bduration\x12&\n" +
-	"\x0ftop_left_corner\x18\x05 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x06 \x01(\tR\x11bottomRightCorner\x12'\n" +
-	"\x0fexpression_type\x18\a \x01(\tR\x0eexpressionType\"\xd3\b\n" +
+	"\tpart_type\x18\x01 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\bpartType\x12$\n" +
+	"\vstart_point\x18\x02 \x01(\tH\x00R\n" +
+	"startPoint\x88\x01\x01\x12 \n" +
+	"\tend_point\x18\x03 \x01(\tH\x01R\bendPoint\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x04 \x01(\tH\x02R\bduration\x88\x01\x01\x12+\n" +
+	"\x0ftop_left_corner\x18\x05 \x01(\tH\x03R\rtopLeftCorner\x88\x01\x01\x123\n" +
+	"\x13bottom_right_corner\x18\x06 \x01(\tH\x04R\x11bottomRightCorner\x88\x01\x01\x12'\n" +
+	"\x0fexpression_type\x18\a \x01(\tR\x0eexpressionTypeB\x0e\n" +
+	"\f_start_pointB\f\n" +
+	"\n" +
+	"_end_pointB\v\n" +
+	"\t_durationB\x12\n" +
+	"\x10_top_left_cornerB\x16\n" +
+	"\x14_bottom_right_cornerJ\x06\b\xa8F\x10\x90N\"\xc0\t\n" +
 	"\x15TechnicalImageDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12J\n" +
 	"\x11drm_platform_type\x18\x02 \x01(\v2\x1e.ddex.ern.v383.DrmPlatformTypeR\x0fdrmPlatformType\x12I\n" +
@@ -20144,116 +20310,141 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\fimage_height\x18\x05 \x01(\v2\x15.ddex.ern.v383.ExtentR\vimageHeight\x126\n" +
 	"\vimage_width\x18\x06 \x01(\v2\x15.ddex.ern.v383.ExtentR\n" +
 	"imageWidth\x12=\n" +
-	"\faspect_ratio\x18\a \x01(\v2\x1a.ddex.ern.v383.AspectRatioR\vaspectRatio\x12\x1f\n" +
-	"\vcolor_depth\x18\b \x01(\x05R\n" +
-	"colorDepth\x12)\n" +
-	"\x10image_resolution\x18\t \x01(\x05R\x0fimageResolution\x12\x1d\n" +
+	"\faspect_ratio\x18\a \x01(\v2\x1a.ddex.ern.v383.AspectRatioR\vaspectRatio\x12$\n" +
+	"\vcolor_depth\x18\b \x01(\x05H\x00R\n" +
+	"colorDepth\x88\x01\x01\x12.\n" +
+	"\x10image_resolution\x18\t \x01(\x05H\x01R\x0fimageResolution\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"is_preview\x18\n" +
-	" \x01(\bR\tisPreview\x12F\n" +
+	" \x01(\bH\x02R\tisPreview\x88\x01\x01\x12F\n" +
 	"\x0fpreview_details\x18\v \x01(\v2\x1d.ddex.ern.v383.PreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\f \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\r \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12<\n" +
-	"\vfingerprint\x18\x0e \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
+	"\x19consumer_fulfillment_date\x18\r \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
 	"\x1dfile_availability_description\x18\x0f \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\x10 \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\x11 \x01(\tR\x15languageAndScriptCode\"\x9a\a\n" +
+	"\x04file\x18\x10 \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12<\n" +
+	"\vfingerprint\x18\x0e \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\x11 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x0e\n" +
+	"\f_color_depthB\x13\n" +
+	"\x11_image_resolutionB\r\n" +
+	"\v_is_previewB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xcc\b\n" +
 	"\x14TechnicalMidiDetails\x12O\n" +
-	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12\x1a\n" +
-	"\bduration\x18\x02 \x01(\tR\bduration\x12@\n" +
-	"\x1cresource_processing_required\x18\x03 \x01(\bR\x1aresourceProcessingRequired\x128\n" +
-	"\x18usable_resource_duration\x18\x04 \x01(\tR\x16usableResourceDuration\x12\x1d\n" +
+	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12\x1f\n" +
+	"\bduration\x18\x02 \x01(\tH\x00R\bduration\x88\x01\x01\x12E\n" +
+	"\x1cresource_processing_required\x18\x03 \x01(\bH\x01R\x1aresourceProcessingRequired\x88\x01\x01\x12=\n" +
+	"\x18usable_resource_duration\x18\x04 \x01(\tH\x02R\x16usableResourceDuration\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_preview\x18\x05 \x01(\bR\tisPreview\x12T\n" +
+	"is_preview\x18\x05 \x01(\bH\x03R\tisPreview\x88\x01\x01\x12T\n" +
 	"\x0fpreview_details\x18\x06 \x01(\v2+.ddex.ern.v383.SoundRecordingPreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\a \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\b \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12(\n" +
-	"\x10number_of_voices\x18\t \x01(\x05R\x0enumberOfVoices\x12S\n" +
+	"\x19consumer_fulfillment_date\x18\b \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
+	"\x1dfile_availability_description\x18\f \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
+	"\x04file\x18\r \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12-\n" +
+	"\x10number_of_voices\x18\t \x01(\x05H\x04R\x0enumberOfVoices\x88\x01\x01\x12S\n" +
 	"\x14sound_processor_type\x18\n" +
 	" \x01(\v2!.ddex.ern.v383.SoundProcessorTypeR\x12soundProcessorType\x12<\n" +
-	"\vfingerprint\x18\v \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
-	"\x1dfile_availability_description\x18\f \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\r \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\x0e \x01(\tR\x15languageAndScriptCode\"\xeb\x06\n" +
+	"\vfingerprint\x18\v \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\x0e \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\v\n" +
+	"\t_durationB\x1f\n" +
+	"\x1d_resource_processing_requiredB\x1b\n" +
+	"\x19_usable_resource_durationB\r\n" +
+	"\v_is_previewB\x13\n" +
+	"\x11_number_of_voicesB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa9\a\n" +
 	"\x1aTechnicalSheetMusicDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12J\n" +
 	"\x11drm_platform_type\x18\x02 \x01(\v2\x1e.ddex.ern.v383.DrmPlatformTypeR\x0fdrmPlatformType\x12I\n" +
 	"\x10container_format\x18\x03 \x01(\v2\x1e.ddex.ern.v383.ContainerFormatR\x0fcontainerFormat\x12W\n" +
-	"\x16sheet_music_codec_type\x18\x04 \x01(\v2\".ddex.ern.v383.SheetMusicCodecTypeR\x13sheetMusicCodecType\x12\x1d\n" +
+	"\x16sheet_music_codec_type\x18\x04 \x01(\v2\".ddex.ern.v383.SheetMusicCodecTypeR\x13sheetMusicCodecType\x12\"\n" +
 	"\n" +
-	"is_preview\x18\x05 \x01(\bR\tisPreview\x12F\n" +
+	"is_preview\x18\x05 \x01(\bH\x00R\tisPreview\x88\x01\x01\x12F\n" +
 	"\x0fpreview_details\x18\x06 \x01(\v2\x1d.ddex.ern.v383.PreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\a \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\b \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12<\n" +
-	"\vfingerprint\x18\t \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
+	"\x19consumer_fulfillment_date\x18\b \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
 	"\x1dfile_availability_description\x18\n" +
 	" \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\v \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\f \x01(\tR\x15languageAndScriptCode\"\x9d\x06\n" +
+	"\x04file\x18\v \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12<\n" +
+	"\vfingerprint\x18\t \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\f \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\r\n" +
+	"\v_is_previewB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xdb\x06\n" +
 	"\x18TechnicalSoftwareDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12J\n" +
 	"\x11drm_platform_type\x18\x02 \x01(\v2\x1e.ddex.ern.v383.DrmPlatformTypeR\x0fdrmPlatformType\x12V\n" +
-	"\x15operating_system_type\x18\x03 \x01(\v2\".ddex.ern.v383.OperatingSystemTypeR\x13operatingSystemType\x12\x1d\n" +
+	"\x15operating_system_type\x18\x03 \x01(\v2\".ddex.ern.v383.OperatingSystemTypeR\x13operatingSystemType\x12\"\n" +
 	"\n" +
-	"is_preview\x18\x04 \x01(\bR\tisPreview\x12F\n" +
+	"is_preview\x18\x04 \x01(\bH\x00R\tisPreview\x88\x01\x01\x12F\n" +
 	"\x0fpreview_details\x18\x05 \x01(\v2\x1d.ddex.ern.v383.PreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\x06 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\a \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12<\n" +
-	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
+	"\x19consumer_fulfillment_date\x18\a \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
 	"\x1dfile_availability_description\x18\t \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
 	"\x04file\x18\n" +
-	" \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\v \x01(\tR\x15languageAndScriptCode\"\xd0\t\n" +
+	" \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12<\n" +
+	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\v \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\r\n" +
+	"\v_is_previewB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x9d\v\n" +
 	"\x1eTechnicalSoundRecordingDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12J\n" +
 	"\x11drm_platform_type\x18\x02 \x01(\v2\x1e.ddex.ern.v383.DrmPlatformTypeR\x0fdrmPlatformType\x12I\n" +
 	"\x10container_format\x18\x03 \x01(\v2\x1e.ddex.ern.v383.ContainerFormatR\x0fcontainerFormat\x12G\n" +
 	"\x10audio_codec_type\x18\x04 \x01(\v2\x1d.ddex.ern.v383.AudioCodecTypeR\x0eaudioCodecType\x121\n" +
-	"\bbit_rate\x18\x05 \x01(\v2\x16.ddex.ern.v383.BitRateR\abitRate\x12,\n" +
-	"\x12number_of_channels\x18\x06 \x01(\x05R\x10numberOfChannels\x12@\n" +
-	"\rsampling_rate\x18\a \x01(\v2\x1b.ddex.ern.v383.SamplingRateR\fsamplingRate\x12&\n" +
-	"\x0fbits_per_sample\x18\b \x01(\x05R\rbitsPerSample\x12\x1a\n" +
-	"\bduration\x18\t \x01(\tR\bduration\x12@\n" +
+	"\bbit_rate\x18\x05 \x01(\v2\x16.ddex.ern.v383.BitRateR\abitRate\x121\n" +
+	"\x12number_of_channels\x18\x06 \x01(\x05H\x00R\x10numberOfChannels\x88\x01\x01\x12@\n" +
+	"\rsampling_rate\x18\a \x01(\v2\x1b.ddex.ern.v383.SamplingRateR\fsamplingRate\x12+\n" +
+	"\x0fbits_per_sample\x18\b \x01(\x05H\x01R\rbitsPerSample\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\t \x01(\tH\x02R\bduration\x88\x01\x01\x12E\n" +
 	"\x1cresource_processing_required\x18\n" +
-	" \x01(\bR\x1aresourceProcessingRequired\x128\n" +
-	"\x18usable_resource_duration\x18\v \x01(\tR\x16usableResourceDuration\x12\x1d\n" +
+	" \x01(\bH\x03R\x1aresourceProcessingRequired\x88\x01\x01\x12=\n" +
+	"\x18usable_resource_duration\x18\v \x01(\tH\x04R\x16usableResourceDuration\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_preview\x18\f \x01(\bR\tisPreview\x12T\n" +
+	"is_preview\x18\f \x01(\bH\x05R\tisPreview\x88\x01\x01\x12T\n" +
 	"\x0fpreview_details\x18\r \x01(\v2+.ddex.ern.v383.SoundRecordingPreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\x0e \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\x0f \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12<\n" +
-	"\vfingerprint\x18\x10 \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
+	"\x19consumer_fulfillment_date\x18\x0f \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
 	"\x1dfile_availability_description\x18\x11 \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\x12 \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\x13 \x01(\tR\x15languageAndScriptCode\"\xd2\x06\n" +
+	"\x04file\x18\x12 \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12<\n" +
+	"\vfingerprint\x18\x10 \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\x13 \x01(\tH\x06R\x15languageAndScriptCode\x88\x01\x01B\x15\n" +
+	"\x13_number_of_channelsB\x12\n" +
+	"\x10_bits_per_sampleB\v\n" +
+	"\t_durationB\x1f\n" +
+	"\x1d_resource_processing_requiredB\x1b\n" +
+	"\x19_usable_resource_durationB\r\n" +
+	"\v_is_previewB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x90\a\n" +
 	"\x14TechnicalTextDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12J\n" +
 	"\x11drm_platform_type\x18\x02 \x01(\v2\x1e.ddex.ern.v383.DrmPlatformTypeR\x0fdrmPlatformType\x12I\n" +
 	"\x10container_format\x18\x03 \x01(\v2\x1e.ddex.ern.v383.ContainerFormatR\x0fcontainerFormat\x12D\n" +
-	"\x0ftext_codec_type\x18\x04 \x01(\v2\x1c.ddex.ern.v383.TextCodecTypeR\rtextCodecType\x12\x1d\n" +
+	"\x0ftext_codec_type\x18\x04 \x01(\v2\x1c.ddex.ern.v383.TextCodecTypeR\rtextCodecType\x12\"\n" +
 	"\n" +
-	"is_preview\x18\x05 \x01(\bR\tisPreview\x12F\n" +
+	"is_preview\x18\x05 \x01(\bH\x00R\tisPreview\x88\x01\x01\x12F\n" +
 	"\x0fpreview_details\x18\x06 \x01(\v2\x1d.ddex.ern.v383.PreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\a \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\b \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12<\n" +
-	"\vfingerprint\x18\t \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
+	"\x19consumer_fulfillment_date\x18\b \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
 	"\x1dfile_availability_description\x18\n" +
 	" \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\v \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\f \x01(\tR\x15languageAndScriptCode\"\xd3\x05\n" +
+	"\x04file\x18\v \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12<\n" +
+	"\vfingerprint\x18\t \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\f \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\r\n" +
+	"\v_is_previewB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x91\x06\n" +
 	"#TechnicalUserDefinedResourceDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12M\n" +
-	"\x12user_defined_value\x18\x02 \x03(\v2\x1f.ddex.ern.v383.UserDefinedValueR\x10userDefinedValue\x12\x1d\n" +
+	"\x12user_defined_value\x18\x02 \x03(\v2\x1f.ddex.ern.v383.UserDefinedValueR\x10userDefinedValue\x12\"\n" +
 	"\n" +
-	"is_preview\x18\x03 \x01(\bR\tisPreview\x12F\n" +
+	"is_preview\x18\x03 \x01(\bH\x00R\tisPreview\x88\x01\x01\x12F\n" +
 	"\x0fpreview_details\x18\x04 \x01(\v2\x1d.ddex.ern.v383.PreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\x05 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\x06 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12<\n" +
-	"\vfingerprint\x18\a \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
+	"\x19consumer_fulfillment_date\x18\x06 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
 	"\x1dfile_availability_description\x18\b \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\t \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
+	"\x04file\x18\t \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12<\n" +
+	"\vfingerprint\x18\a \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
 	"\x18language_and_script_code\x18\n" +
-	" \x01(\tR\x15languageAndScriptCode\"\xfb\r\n" +
+	" \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\r\n" +
+	"\v_is_previewB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x88\x10\n" +
 	"\x15TechnicalVideoDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12J\n" +
 	"\x11drm_platform_type\x18\x02 \x01(\v2\x1e.ddex.ern.v383.DrmPlatformTypeR\x0fdrmPlatformType\x12@\n" +
@@ -20267,30 +20458,39 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\vimage_width\x18\t \x01(\v2\x15.ddex.ern.v383.ExtentR\n" +
 	"imageWidth\x12=\n" +
 	"\faspect_ratio\x18\n" +
-	" \x01(\v2\x1a.ddex.ern.v383.AspectRatioR\vaspectRatio\x12\x1f\n" +
-	"\vcolor_depth\x18\v \x01(\x05R\n" +
-	"colorDepth\x122\n" +
-	"\x15video_definition_type\x18\f \x01(\tR\x13videoDefinitionType\x12G\n" +
+	" \x01(\v2\x1a.ddex.ern.v383.AspectRatioR\vaspectRatio\x12$\n" +
+	"\vcolor_depth\x18\v \x01(\x05H\x00R\n" +
+	"colorDepth\x88\x01\x01\x127\n" +
+	"\x15video_definition_type\x18\f \x01(\tH\x01R\x13videoDefinitionType\x88\x01\x01\x12G\n" +
 	"\x10audio_codec_type\x18\r \x01(\v2\x1d.ddex.ern.v383.AudioCodecTypeR\x0eaudioCodecType\x12<\n" +
-	"\x0eaudio_bit_rate\x18\x0e \x01(\v2\x16.ddex.ern.v383.BitRateR\faudioBitRate\x127\n" +
-	"\x18number_of_audio_channels\x18\x0f \x01(\x05R\x15numberOfAudioChannels\x12K\n" +
-	"\x13audio_sampling_rate\x18\x10 \x01(\v2\x1b.ddex.ern.v383.SamplingRateR\x11audioSamplingRate\x121\n" +
-	"\x15audio_bits_per_sample\x18\x11 \x01(\x05R\x12audioBitsPerSample\x12\x1a\n" +
-	"\bduration\x18\x12 \x01(\tR\bduration\x12@\n" +
-	"\x1cresource_processing_required\x18\x13 \x01(\bR\x1aresourceProcessingRequired\x128\n" +
-	"\x18usable_resource_duration\x18\x14 \x01(\tR\x16usableResourceDuration\x12\x1d\n" +
+	"\x0eaudio_bit_rate\x18\x0e \x01(\v2\x16.ddex.ern.v383.BitRateR\faudioBitRate\x12<\n" +
+	"\x18number_of_audio_channels\x18\x0f \x01(\x05H\x02R\x15numberOfAudioChannels\x88\x01\x01\x12K\n" +
+	"\x13audio_sampling_rate\x18\x10 \x01(\v2\x1b.ddex.ern.v383.SamplingRateR\x11audioSamplingRate\x126\n" +
+	"\x15audio_bits_per_sample\x18\x11 \x01(\x05H\x03R\x12audioBitsPerSample\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x12 \x01(\tH\x04R\bduration\x88\x01\x01\x12E\n" +
+	"\x1cresource_processing_required\x18\x13 \x01(\bH\x05R\x1aresourceProcessingRequired\x88\x01\x01\x12=\n" +
+	"\x18usable_resource_duration\x18\x14 \x01(\tH\x06R\x16usableResourceDuration\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_preview\x18\x15 \x01(\bR\tisPreview\x12T\n" +
+	"is_preview\x18\x15 \x01(\bH\aR\tisPreview\x88\x01\x01\x12T\n" +
 	"\x0fpreview_details\x18\x16 \x01(\v2+.ddex.ern.v383.SoundRecordingPreviewDetailsR\x0epreviewDetails\x12I\n" +
 	"\x10fulfillment_date\x18\x17 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x0ffulfillmentDate\x12Z\n" +
-	"\x19consumer_fulfillment_date\x18\x18 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12<\n" +
-	"\vfingerprint\x18\x19 \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12^\n" +
+	"\x19consumer_fulfillment_date\x18\x18 \x01(\v2\x1e.ddex.ern.v383.FulfillmentDateR\x17consumerFulfillmentDate\x12^\n" +
 	"\x1dfile_availability_description\x18\x1a \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x1bfileAvailabilityDescription\x12'\n" +
-	"\x04file\x18\x1b \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x127\n" +
-	"\x18language_and_script_code\x18\x1c \x01(\tR\x15languageAndScriptCode\"\xc9\x06\n" +
+	"\x04file\x18\x1b \x03(\v2\x13.ddex.ern.v383.FileR\x04file\x12<\n" +
+	"\vfingerprint\x18\x19 \x03(\v2\x1a.ddex.ern.v383.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\x1c \x01(\tH\bR\x15languageAndScriptCode\x88\x01\x01B\x0e\n" +
+	"\f_color_depthB\x18\n" +
+	"\x16_video_definition_typeB\x1b\n" +
+	"\x19_number_of_audio_channelsB\x18\n" +
+	"\x16_audio_bits_per_sampleB\v\n" +
+	"\t_durationB\x1f\n" +
+	"\x1d_resource_processing_requiredB\x1b\n" +
+	"\x19_usable_resource_durationB\r\n" +
+	"\v_is_previewB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa2\a\n" +
 	"\x04Text\x124\n" +
-	"\ttext_type\x18\x01 \x01(\v2\x17.ddex.ern.v383.TextTypeR\btextType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x12.\n" +
+	"\ttext_type\x18\x01 \x01(\v2\x17.ddex.ern.v383.TextTypeR\btextType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x12.\n" +
 	"\atext_id\x18\x03 \x03(\v2\x15.ddex.ern.v383.TextIdR\x06textId\x12F\n" +
 	"\x10indirect_text_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\x0eindirectTextId\x12-\n" +
 	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x12\x7f\n" +
@@ -20299,11 +20499,17 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x05title\x18\b \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12=\n" +
 	"\rcreation_date\x18\t \x01(\v2\x18.ddex.ern.v383.EventDateR\fcreationDate\x12`\n" +
 	"\x19text_details_by_territory\x18\n" +
-	" \x03(\v2%.ddex.ern.v383.TextDetailsByTerritoryR\x16textDetailsByTerritory\x12\x1d\n" +
+	" \x03(\v2%.ddex.ern.v383.TextDetailsByTerritoryR\x16textDetailsByTerritory\x12\"\n" +
+	"\n" +
+	"is_updated\x18\v \x01(\bH\x01R\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\f \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x94\n" +
 	"\n" +
-	"is_updated\x18\v \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18\f \x01(\tR\x15languageAndScriptCode\"\xea\t\n" +
-	"\x16TextDetailsByTerritory\x12*\n" +
+	"\x16TextDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x0f \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12]\n" +
 	"\x14resource_contributor\x18\x02 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x12n\n" +
 	"\x1dindirect_resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.IndirectResourceContributorR\x1bindirectResourceContributor\x12C\n" +
@@ -20318,27 +20524,30 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\bsynopsis\x18\v \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12*\n" +
 	"\x05genre\x18\f \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
 	"\x15parental_warning_type\x18\r \x03(\v2\".ddex.ern.v383.ParentalWarningTypeR\x13parentalWarningType\x12Y\n" +
-	"\x16technical_text_details\x18\x0e \x03(\v2#.ddex.ern.v383.TechnicalTextDetailsR\x14technicalTextDetails\x12J\n" +
-	"\x0eterritory_code\x18\x0f \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x11 \x01(\tR\x15languageAndScriptCode\"\xb0\x04\n" +
-	"\x15TypedRightsController\x124\n" +
-	"\x16rights_controller_role\x18\x01 \x03(\tR\x14rightsControllerRole\x124\n" +
-	"\x16rights_controller_type\x18\x02 \x01(\tR\x14rightsControllerType\x12[\n" +
-	"\x19territory_of_registration\x18\x03 \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x17territoryOfRegistration\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x05 \x01(\tR\aendDate\x121\n" +
+	"\x16technical_text_details\x18\x0e \x03(\v2#.ddex.ern.v383.TechnicalTextDetailsR\x14technicalTextDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x11 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x97\x05\n" +
+	"\x15TypedRightsController\x121\n" +
 	"\bparty_id\x18\x06 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\a \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12.\n" +
+	"party_name\x18\a \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x124\n" +
+	"\x16rights_controller_role\x18\x01 \x03(\tR\x14rightsControllerRole\x12.\n" +
 	"\x13right_share_unknown\x18\b \x01(\bR\x11rightShareUnknown\x12O\n" +
-	"\x16right_share_percentage\x18\t \x01(\v2\x19.ddex.ern.v383.PercentageR\x14rightSharePercentage\x12'\n" +
+	"\x16right_share_percentage\x18\t \x01(\v2\x19.ddex.ern.v383.PercentageR\x14rightSharePercentage\x129\n" +
+	"\x16rights_controller_type\x18\x02 \x01(\tH\x00R\x14rightsControllerType\x88\x01\x01\x12[\n" +
+	"\x19territory_of_registration\x18\x03 \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x17territoryOfRegistration\x12\"\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x05 \x01(\tH\x02R\aendDate\x88\x01\x01\x12,\n" +
 	"\x0fsequence_number\x18\n" +
-	" \x01(\x05R\x0esequenceNumber\"\xd5\b\n" +
+	" \x01(\x05H\x03R\x0esequenceNumber\x88\x01\x01B\x19\n" +
+	"\x17_rights_controller_typeB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xae\t\n" +
 	"\x13UserDefinedResource\x12c\n" +
-	"\x1auser_defined_resource_type\x18\x01 \x01(\v2&.ddex.ern.v383.UserDefinedResourceTypeR\x17userDefinedResourceType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x12]\n" +
+	"\x1auser_defined_resource_type\x18\x01 \x01(\v2&.ddex.ern.v383.UserDefinedResourceTypeR\x17userDefinedResourceType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x12]\n" +
 	"\x18user_defined_resource_id\x18\x03 \x03(\v2$.ddex.ern.v383.ResourceProprietaryIdR\x15userDefinedResourceId\x12f\n" +
 	"!indirect_user_defined_resource_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\x1dindirectUserDefinedResourceId\x12-\n" +
 	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x12\x7f\n" +
@@ -20348,12 +20557,16 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x12user_defined_value\x18\t \x03(\v2\x1f.ddex.ern.v383.UserDefinedValueR\x10userDefinedValue\x12=\n" +
 	"\rcreation_date\x18\n" +
 	" \x01(\v2\x18.ddex.ern.v383.EventDateR\fcreationDate\x12\x8f\x01\n" +
-	"*user_defined_resource_details_by_territory\x18\v \x03(\v24.ddex.ern.v383.UserDefinedResourceDetailsByTerritoryR%userDefinedResourceDetailsByTerritory\x12\x1d\n" +
-	"\n" +
-	"is_updated\x18\f \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18\r \x01(\tR\x15languageAndScriptCode\"\xe3\n" +
+	"*user_defined_resource_details_by_territory\x18\v \x03(\v24.ddex.ern.v383.UserDefinedResourceDetailsByTerritoryR%userDefinedResourceDetailsByTerritory\x12\"\n" +
 	"\n" +
-	"%UserDefinedResourceDetailsByTerritory\x12*\n" +
+	"is_updated\x18\f \x01(\bH\x01R\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\r \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x8d\v\n" +
+	"%UserDefinedResourceDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x11 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12]\n" +
 	"\x14resource_contributor\x18\x02 \x03(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x12n\n" +
 	"\x1dindirect_resource_contributor\x18\x03 \x03(\v2*.ddex.ern.v383.IndirectResourceContributorR\x1bindirectResourceContributor\x12C\n" +
@@ -20369,32 +20582,34 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\bsynopsis\x18\f \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12*\n" +
 	"\x05genre\x18\r \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
 	"\x15parental_warning_type\x18\x0e \x03(\v2\".ddex.ern.v383.ParentalWarningTypeR\x13parentalWarningType\x12\x88\x01\n" +
-	"'technical_user_defined_resource_details\x18\x0f \x03(\v22.ddex.ern.v383.TechnicalUserDefinedResourceDetailsR#technicalUserDefinedResourceDetails\x12J\n" +
-	"\x0eterritory_code\x18\x10 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x11 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x12 \x01(\tR\x15languageAndScriptCode\"\x83\x14\n" +
+	"'technical_user_defined_resource_details\x18\x0f \x03(\v22.ddex.ern.v383.TechnicalUserDefinedResourceDetailsR#technicalUserDefinedResourceDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x12 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x9f\x18\n" +
 	"\x05Video\x127\n" +
 	"\n" +
-	"video_type\x18\x01 \x01(\v2\x18.ddex.ern.v383.VideoTypeR\tvideoType\x12*\n" +
-	"\x11is_artist_related\x18\x02 \x01(\bR\x0fisArtistRelated\x121\n" +
+	"video_type\x18\x01 \x01(\v2\x18.ddex.ern.v383.VideoTypeR\tvideoType\x12/\n" +
+	"\x11is_artist_related\x18\x02 \x01(\bH\x00R\x0fisArtistRelated\x88\x01\x01\x121\n" +
 	"\bvideo_id\x18\x03 \x03(\v2\x16.ddex.ern.v383.VideoIdR\avideoId\x12H\n" +
 	"\x11indirect_video_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\x0findirectVideoId\x12-\n" +
-	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x12F\n" +
+	"\x12resource_reference\x18\x05 \x01(\tR\x11resourceReference\x12`\n" +
+	"\x19video_cue_sheet_reference\x18% \x03(\v2%.ddex.ern.v383.VideoCueSheetReferenceR\x16videoCueSheetReference\x12U\n" +
+	"\x1creason_for_cue_sheet_absence\x18& \x01(\v2\x15.ddex.ern.v383.ReasonR\x18reasonForCueSheetAbsence\x12F\n" +
 	"\x0freference_title\x18\x06 \x01(\v2\x1d.ddex.ern.v383.ReferenceTitleR\x0ereferenceTitle\x12*\n" +
 	"\x05title\x18\a \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12[\n" +
-	"\x1binstrumentation_description\x18\b \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x1ainstrumentationDescription\x12\x1b\n" +
-	"\tis_medley\x18\t \x01(\bR\bisMedley\x12!\n" +
+	"\x1binstrumentation_description\x18\b \x01(\v2\x1a.ddex.ern.v383.DescriptionR\x1ainstrumentationDescription\x12 \n" +
+	"\tis_medley\x18\t \x01(\bH\x01R\bisMedley\x88\x01\x01\x12&\n" +
 	"\fis_potpourri\x18\n" +
-	" \x01(\bR\visPotpourri\x12'\n" +
-	"\x0fis_instrumental\x18\v \x01(\bR\x0eisInstrumental\x12#\n" +
-	"\ris_background\x18\f \x01(\bR\fisBackground\x12,\n" +
-	"\x12is_hidden_resource\x18\r \x01(\bR\x10isHiddenResource\x12*\n" +
-	"\x11is_bonus_resource\x18\x0e \x01(\bR\x0fisBonusResource\x129\n" +
-	"\x19has_pre_order_fulfillment\x18\x0f \x01(\bR\x16hasPreOrderFulfillment\x12#\n" +
-	"\ris_remastered\x18\x10 \x01(\bR\fisRemastered\x12*\n" +
-	"\x11no_silence_before\x18\x11 \x01(\bR\x0fnoSilenceBefore\x12(\n" +
-	"\x10no_silence_after\x18\x12 \x01(\bR\x0enoSilenceAfter\x12D\n" +
-	"\x1eperformer_information_required\x18\x13 \x01(\bR\x1cperformerInformationRequired\x126\n" +
+	" \x01(\bH\x02R\visPotpourri\x88\x01\x01\x12,\n" +
+	"\x0fis_instrumental\x18\v \x01(\bH\x03R\x0eisInstrumental\x88\x01\x01\x12(\n" +
+	"\ris_background\x18\f \x01(\bH\x04R\fisBackground\x88\x01\x01\x121\n" +
+	"\x12is_hidden_resource\x18\r \x01(\bH\x05R\x10isHiddenResource\x88\x01\x01\x12/\n" +
+	"\x11is_bonus_resource\x18\x0e \x01(\bH\x06R\x0fisBonusResource\x88\x01\x01\x12>\n" +
+	"\x19has_pre_order_fulfillment\x18\x0f \x01(\bH\aR\x16hasPreOrderFulfillment\x88\x01\x01\x12(\n" +
+	"\ris_remastered\x18\x10 \x01(\bH\bR\fisRemastered\x88\x01\x01\x12/\n" +
+	"\x11no_silence_before\x18\x11 \x01(\bH\tR\x0fnoSilenceBefore\x88\x01\x01\x12-\n" +
+	"\x10no_silence_after\x18\x12 \x01(\bH\n" +
+	"R\x0enoSilenceAfter\x88\x01\x01\x12I\n" +
+	"\x1eperformer_information_required\x18\x13 \x01(\bH\vR\x1cperformerInformationRequired\x88\x01\x01\x126\n" +
 	"\x17language_of_performance\x18\x14 \x03(\tR\x15languageOfPerformance\x12.\n" +
 	"\x13language_of_dubbing\x18\x15 \x03(\tR\x11languageOfDubbing\x12,\n" +
 	"\x12sub_title_language\x18\x16 \x03(\tR\x10subTitleLanguage\x12\x1a\n" +
@@ -20407,17 +20622,36 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\rmastered_date\x18\x1d \x01(\v2\x18.ddex.ern.v383.EventDateR\fmasteredDate\x12A\n" +
 	"\x0fremastered_date\x18\x1e \x01(\v2\x18.ddex.ern.v383.EventDateR\x0eremasteredDate\x12c\n" +
 	"\x1avideo_details_by_territory\x18\x1f \x03(\v2&.ddex.ern.v383.VideoDetailsByTerritoryR\x17videoDetailsByTerritory\x12]\n" +
-	"\x1aterritory_of_commissioning\x18  \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x18territoryOfCommissioning\x12;\n" +
-	"\x1anumber_of_featured_artists\x18! \x01(\x05R\x17numberOfFeaturedArtists\x12B\n" +
-	"\x1enumber_of_non_featured_artists\x18\" \x01(\x05R\x1anumberOfNonFeaturedArtists\x12?\n" +
-	"\x1cnumber_of_contracted_artists\x18# \x01(\x05R\x19numberOfContractedArtists\x12F\n" +
-	" number_of_non_contracted_artists\x18$ \x01(\x05R\x1cnumberOfNonContractedArtists\x12`\n" +
-	"\x19video_cue_sheet_reference\x18% \x03(\v2%.ddex.ern.v383.VideoCueSheetReferenceR\x16videoCueSheetReference\x12U\n" +
-	"\x1creason_for_cue_sheet_absence\x18& \x01(\v2\x15.ddex.ern.v383.ReasonR\x18reasonForCueSheetAbsence\x12\x1d\n" +
+	"\x1aterritory_of_commissioning\x18  \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x18territoryOfCommissioning\x12@\n" +
+	"\x1anumber_of_featured_artists\x18! \x01(\x05H\fR\x17numberOfFeaturedArtists\x88\x01\x01\x12G\n" +
+	"\x1enumber_of_non_featured_artists\x18\" \x01(\x05H\rR\x1anumberOfNonFeaturedArtists\x88\x01\x01\x12D\n" +
+	"\x1cnumber_of_contracted_artists\x18# \x01(\x05H\x0eR\x19numberOfContractedArtists\x88\x01\x01\x12K\n" +
+	" number_of_non_contracted_artists\x18$ \x01(\x05H\x0fR\x1cnumberOfNonContractedArtists\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_updated\x18' \x01(\bH\x10R\tisUpdated\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18( \x01(\tH\x11R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_is_artist_relatedB\f\n" +
 	"\n" +
-	"is_updated\x18' \x01(\bR\tisUpdated\x127\n" +
-	"\x18language_and_script_code\x18( \x01(\tR\x15languageAndScriptCode\"\xe9\x0f\n" +
-	"\x17VideoDetailsByTerritory\x12*\n" +
+	"_is_medleyB\x0f\n" +
+	"\r_is_potpourriB\x12\n" +
+	"\x10_is_instrumentalB\x10\n" +
+	"\x0e_is_backgroundB\x15\n" +
+	"\x13_is_hidden_resourceB\x14\n" +
+	"\x12_is_bonus_resourceB\x1c\n" +
+	"\x1a_has_pre_order_fulfillmentB\x10\n" +
+	"\x0e_is_remasteredB\x14\n" +
+	"\x12_no_silence_beforeB\x13\n" +
+	"\x11_no_silence_afterB!\n" +
+	"\x1f_performer_information_requiredB\x1d\n" +
+	"\x1b_number_of_featured_artistsB!\n" +
+	"\x1f_number_of_non_featured_artistsB\x1f\n" +
+	"\x1d_number_of_contracted_artistsB#\n" +
+	"!_number_of_non_contracted_artistsB\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xac\x10\n" +
+	"\x17VideoDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x1b \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x1c \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12*\n" +
 	"\x05title\x18\x01 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12<\n" +
 	"\x0edisplay_artist\x18\x02 \x03(\v2\x15.ddex.ern.v383.ArtistR\rdisplayArtist\x12B\n" +
 	"\x11display_conductor\x18\x03 \x03(\v2\x15.ddex.ern.v383.ArtistR\x10displayConductor\x12]\n" +
@@ -20433,8 +20667,8 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x15resource_release_date\x18\v \x01(\v2\x18.ddex.ern.v383.EventDateR\x13resourceReleaseDate\x12]\n" +
 	"\x1eoriginal_resource_release_date\x18\f \x01(\v2\x18.ddex.ern.v383.EventDateR\x1boriginalResourceReleaseDate\x12+\n" +
 	"\x06p_line\x18\r \x03(\v2\x14.ddex.ern.v383.PLineR\x05pLine\x12@\n" +
-	"\rcourtesy_line\x18\x0e \x01(\v2\x1b.ddex.ern.v383.CourtesyLineR\fcourtesyLine\x12'\n" +
-	"\x0fsequence_number\x18\x0f \x01(\x05R\x0esequenceNumber\x12M\n" +
+	"\rcourtesy_line\x18\x0e \x01(\v2\x1b.ddex.ern.v383.CourtesyLineR\fcourtesyLine\x12,\n" +
+	"\x0fsequence_number\x18\x0f \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12M\n" +
 	"\x12host_sound_carrier\x18\x10 \x03(\v2\x1f.ddex.ern.v383.HostSoundCarrierR\x10hostSoundCarrier\x12C\n" +
 	"\x11marketing_comment\x18\x11 \x01(\v2\x16.ddex.ern.v383.CommentR\x10marketingComment\x12*\n" +
 	"\x05genre\x18\x12 \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x12V\n" +
@@ -20445,214 +20679,312 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\bsynopsis\x18\x17 \x01(\v2\x17.ddex.ern.v383.SynopsisR\bsynopsis\x12+\n" +
 	"\x06c_line\x18\x18 \x03(\v2\x14.ddex.ern.v383.CLineR\x05cLine\x12\\\n" +
 	"\x17technical_video_details\x18\x19 \x03(\v2$.ddex.ern.v383.TechnicalVideoDetailsR\x15technicalVideoDetails\x126\n" +
-	"\tcharacter\x18\x1a \x03(\v2\x18.ddex.ern.v383.CharacterR\tcharacter\x12J\n" +
-	"\x0eterritory_code\x18\x1b \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x1c \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x1d \x01(\tR\x15languageAndScriptCode\"\xa2\x03\n" +
+	"\tcharacter\x18\x1a \x03(\v2\x18.ddex.ern.v383.CharacterR\tcharacter\x12<\n" +
+	"\x18language_and_script_code\x18\x1d \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd8\x04\n" +
 	"\tWebPolicy\x126\n" +
 	"\tcondition\x18\x01 \x01(\v2\x18.ddex.ern.v383.ConditionR\tcondition\x12:\n" +
-	"\x19access_blocking_requested\x18\x02 \x01(\bR\x17accessBlockingRequested\x12+\n" +
-	"\x11access_limitation\x18\x03 \x01(\tR\x10accessLimitation\x12+\n" +
-	"\x11embedding_allowed\x18\x04 \x01(\bR\x10embeddingAllowed\x12.\n" +
-	"\x13user_rating_allowed\x18\x05 \x01(\bR\x11userRatingAllowed\x120\n" +
-	"\x14user_comment_allowed\x18\x06 \x01(\bR\x12userCommentAllowed\x124\n" +
-	"\x16user_responses_allowed\x18\a \x01(\bR\x14userResponsesAllowed\x12/\n" +
-	"\x13syndication_allowed\x18\b \x01(\bR\x12syndicationAllowed\"\xe9\x01\n" +
+	"\x19access_blocking_requested\x18\x02 \x01(\bR\x17accessBlockingRequested\x120\n" +
+	"\x11access_limitation\x18\x03 \x01(\tH\x00R\x10accessLimitation\x88\x01\x01\x120\n" +
+	"\x11embedding_allowed\x18\x04 \x01(\bH\x01R\x10embeddingAllowed\x88\x01\x01\x123\n" +
+	"\x13user_rating_allowed\x18\x05 \x01(\bH\x02R\x11userRatingAllowed\x88\x01\x01\x125\n" +
+	"\x14user_comment_allowed\x18\x06 \x01(\bH\x03R\x12userCommentAllowed\x88\x01\x01\x129\n" +
+	"\x16user_responses_allowed\x18\a \x01(\bH\x04R\x14userResponsesAllowed\x88\x01\x01\x124\n" +
+	"\x13syndication_allowed\x18\b \x01(\bH\x05R\x12syndicationAllowed\x88\x01\x01B\x14\n" +
+	"\x12_access_limitationB\x14\n" +
+	"\x12_embedding_allowedB\x16\n" +
+	"\x14_user_rating_allowedB\x17\n" +
+	"\x15_user_comment_allowedB\x19\n" +
+	"\x17_user_responses_allowedB\x16\n" +
+	"\x14_syndication_allowedJ\x06\b\xa8F\x10\x90N\"\xa0\x02\n" +
 	"\x1bAdministratingRecordCompany\x121\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x02 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\x12\x12\n" +
-	"\x04role\x18\x05 \x01(\tR\x04role\"Q\n" +
+	"party_name\x18\x02 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04roleB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"r\n" +
 	"\x10AllTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"\x9c\x02\n" +
-	"\x06Artist\x12:\n" +
-	"\vartist_role\x18\x01 \x03(\v2\x19.ddex.ern.v383.ArtistRoleR\n" +
-	"artistRole\x12A\n" +
-	"\vnationality\x18\x02 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\vnationality\x121\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xbd\x02\n" +
+	"\x06Artist\x121\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\x05 \x01(\x05R\x0esequenceNumber\"\x86\x03\n" +
+	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12:\n" +
+	"\vartist_role\x18\x01 \x03(\v2\x19.ddex.ern.v383.ArtistRoleR\n" +
+	"artistRole\x12A\n" +
+	"\vnationality\x18\x02 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\vnationality\x12,\n" +
+	"\x0fsequence_number\x18\x05 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x8e\x03\n" +
 	"\x1aArtistDelegatedUsageRights\x121\n" +
 	"\buse_type\x18\x01 \x03(\v2\x16.ddex.ern.v383.UseTypeR\auseType\x12P\n" +
 	"\x13user_interface_type\x18\x02 \x03(\v2 .ddex.ern.v383.UserInterfaceTypeR\x11userInterfaceType\x12T\n" +
 	"\x1bperiod_of_rights_delegation\x18\x03 \x01(\v2\x15.ddex.ern.v383.PeriodR\x18periodOfRightsDelegation\x12d\n" +
 	"\x1eterritory_of_rights_delegation\x18\x04 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x1bterritoryOfRightsDelegation\x12'\n" +
-	"\x0fmembership_type\x18\x05 \x01(\tR\x0emembershipType\"n\n" +
+	"\x0fmembership_type\x18\x05 \x01(\tR\x0emembershipTypeJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"ArtistRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"O\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"r\n" +
 	"\vAspectRatio\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
-	"\x11aspect_ratio_type\x18\x02 \x01(\tR\x0faspectRatioType\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12/\n" +
+	"\x11aspect_ratio_type\x18\x02 \x01(\tH\x00R\x0faspectRatioType\x88\x01\x01B\x14\n" +
+	"\x12_aspect_ratio_typeJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eAudioCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xc5\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xcd\x01\n" +
 	"\bAvRating\x12\x1f\n" +
 	"\vrating_text\x18\x01 \x01(\tR\n" +
 	"ratingText\x12@\n" +
 	"\rrating_agency\x18\x02 \x01(\v2\x1b.ddex.ern.v383.RatingAgencyR\fratingAgency\x12V\n" +
-	"\x19rating_scheme_description\x18\x03 \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x17ratingSchemeDescription\"G\n" +
+	"\x19rating_scheme_description\x18\x03 \x03(\v2\x1a.ddex.ern.v383.DescriptionR\x17ratingSchemeDescriptionJ\x06\b\xa8F\x10\x90N\"h\n" +
 	"\aBitRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\x9a\x01\n" +
-	"\x05CLine\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ec_line_company\x18\x02 \x01(\tR\fcLineCompany\x12\x1e\n" +
-	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\"o\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xea\x01\n" +
+	"\x05CLine\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ec_line_company\x18\x02 \x01(\tH\x01R\fcLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_c_line_companyB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vCarrierType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rCatalogNumber\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xff\x01\n" +
-	"\tCharacter\x12]\n" +
-	"\x14resource_contributor\x18\x01 \x01(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x121\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xa0\x02\n" +
+	"\tCharacter\x121\n" +
 	"\bparty_id\x18\x02 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x03 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\"\x8d\x02\n" +
-	"\x1dCollectionCollectionReference\x12'\n" +
-	"\x0fsequence_number\x18\x01 \x01(\x05R\x0esequenceNumber\x12F\n" +
-	"\x1fcollection_collection_reference\x18\x02 \x01(\tR\x1dcollectionCollectionReference\x12\x1d\n" +
+	"party_name\x18\x03 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12]\n" +
+	"\x14resource_contributor\x18\x01 \x01(\v2*.ddex.ern.v383.DetailedResourceContributorR\x13resourceContributor\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xfe\x02\n" +
+	"\x1dCollectionCollectionReference\x12,\n" +
+	"\x0fsequence_number\x18\x01 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12F\n" +
+	"\x1fcollection_collection_reference\x18\x02 \x01(\tR\x1dcollectionCollectionReference\x12\"\n" +
 	"\n" +
-	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x1a\n" +
-	"\bduration\x18\x04 \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\x05 \x01(\tR\aendTime\x12%\n" +
-	"\x0einclusion_date\x18\x06 \x01(\tR\rinclusionDate\"\xcd\x01\n" +
-	"!CollectionCollectionReferenceList\x122\n" +
-	"\x15number_of_collections\x18\x01 \x01(\x05R\x13numberOfCollections\x12t\n" +
-	"\x1fcollection_collection_reference\x18\x02 \x03(\v2,.ddex.ern.v383.CollectionCollectionReferenceR\x1dcollectionCollectionReference\"\xc2\x02\n" +
-	"\fCollectionId\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_s_r_c\x18\x02 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_a_n\x18\x03 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x04 \x01(\tR\x05vISAN\x12*\n" +
+	"start_time\x18\x03 \x01(\tH\x01R\tstartTime\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x04 \x01(\tH\x02R\bduration\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x05 \x01(\tH\x03R\aendTime\x88\x01\x01\x12*\n" +
+	"\x0einclusion_date\x18\x06 \x01(\tH\x04R\rinclusionDate\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_durationB\v\n" +
+	"\t_end_timeB\x11\n" +
+	"\x0f_inclusion_dateJ\x06\b\xa8F\x10\x90N\"\xf4\x01\n" +
+	"!CollectionCollectionReferenceList\x127\n" +
+	"\x15number_of_collections\x18\x01 \x01(\x05H\x00R\x13numberOfCollections\x88\x01\x01\x12t\n" +
+	"\x1fcollection_collection_reference\x18\x02 \x03(\v2,.ddex.ern.v383.CollectionCollectionReferenceR\x1dcollectionCollectionReferenceB\x18\n" +
+	"\x16_number_of_collectionsJ\x06\b\xa8F\x10\x90N\"\xa3\x03\n" +
+	"\fCollectionId\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_r_c\x18\x02 \x01(\tH\x01R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x03 \x01(\tH\x02R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x04 \x01(\tH\x03R\x05vISAN\x88\x01\x01\x12*\n" +
 	"\ai_c_p_n\x18\x05 \x01(\v2\x13.ddex.ern.v383.ICPNR\x04iCPN\x12C\n" +
 	"\x0ecatalog_number\x18\x06 \x01(\v2\x1c.ddex.ern.v383.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\a \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\b \x01(\bR\n" +
-	"isReplaced\"r\n" +
+	"\x0eproprietary_id\x18\a \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\b \x01(\bH\x04R\n" +
+	"isReplaced\x88\x01\x01B\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0eCollectionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"q\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8b\x01\n" +
 	"\x17CollectionWorkReference\x12:\n" +
-	"\x19collection_work_reference\x18\x01 \x01(\tR\x17collectionWorkReference\x12\x1a\n" +
-	"\bduration\x18\x02 \x01(\tR\bduration\"\x81\x01\n" +
+	"\x19collection_work_reference\x18\x01 \x01(\tR\x17collectionWorkReference\x12\x1f\n" +
+	"\bduration\x18\x02 \x01(\tH\x00R\bduration\x88\x01\x01B\v\n" +
+	"\t_durationJ\x06\b\xa8F\x10\x90N\"\x89\x01\n" +
 	"\x1bCollectionWorkReferenceList\x12b\n" +
-	"\x19collection_work_reference\x18\x01 \x03(\v2&.ddex.ern.v383.CollectionWorkReferenceR\x17collectionWorkReference\"X\n" +
+	"\x19collection_work_reference\x18\x01 \x03(\v2&.ddex.ern.v383.CollectionWorkReferenceR\x17collectionWorkReferenceJ\x06\b\xa8F\x10\x90N\"\x82\x01\n" +
 	"\aComment\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"w\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13CommercialModelType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x93\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\tCondition\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x12\n" +
-	"\x04unit\x18\x02 \x01(\tR\x04unit\x12-\n" +
-	"\x12reference_creation\x18\x03 \x01(\tR\x11referenceCreation\x12-\n" +
-	"\x12relational_relator\x18\x04 \x01(\tR\x11relationalRelator\"Q\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x122\n" +
+	"\x12reference_creation\x18\x03 \x01(\tH\x00R\x11referenceCreation\x88\x01\x01\x12-\n" +
+	"\x12relational_relator\x18\x04 \x01(\tR\x11relationalRelatorB\x15\n" +
+	"\x13_reference_creationJ\x06\b\xa8F\x10\x90N\"p\n" +
 	"\x14ConsumerRentalPeriod\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12#\n" +
-	"\ris_extensible\x18\x02 \x01(\bR\fisExtensible\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12(\n" +
+	"\ris_extensible\x18\x02 \x01(\bH\x00R\fisExtensible\x88\x01\x01B\x10\n" +
+	"\x0e_is_extensibleJ\x06\b\xa8F\x10\x90N\"z\n" +
 	"\tContactId\x12#\n" +
 	"\remail_address\x18\x01 \x03(\tR\femailAddress\x12!\n" +
 	"\fphone_number\x18\x02 \x03(\tR\vphoneNumber\x12\x1d\n" +
 	"\n" +
-	"fax_number\x18\x03 \x03(\tR\tfaxNumber\"s\n" +
+	"fax_number\x18\x03 \x03(\tR\tfaxNumberJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fContainerFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"]\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x87\x01\n" +
 	"\fCourtesyLine\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xaa\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd1\x04\n" +
 	"\n" +
-	"CreationId\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12\x1f\n" +
-	"\vopus_number\x18\x02 \x01(\tR\n" +
-	"opusNumber\x126\n" +
-	"\x17composer_catalog_number\x18\x03 \x03(\tR\x15composerCatalogNumber\x12\x15\n" +
-	"\ai_s_r_c\x18\x04 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_m_n\x18\x05 \x01(\tR\x04iSMN\x12\x15\n" +
-	"\ai_s_a_n\x18\x06 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\a \x01(\tR\x05vISAN\x12\x15\n" +
-	"\ai_s_b_n\x18\b \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\t \x01(\tR\x04iSSN\x12\x15\n" +
+	"CreationId\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x12$\n" +
+	"\vopus_number\x18\x02 \x01(\tH\x01R\n" +
+	"opusNumber\x88\x01\x01\x126\n" +
+	"\x17composer_catalog_number\x18\x03 \x03(\tR\x15composerCatalogNumber\x12\x1a\n" +
+	"\ai_s_r_c\x18\x04 \x01(\tH\x02R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_m_n\x18\x05 \x01(\tH\x03R\x04iSMN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x06 \x01(\tH\x04R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\a \x01(\tH\x05R\x05vISAN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_b_n\x18\b \x01(\tH\x06R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\t \x01(\tH\aR\x04iSSN\x88\x01\x01\x12\x1a\n" +
 	"\as_i_c_i\x18\n" +
-	" \x01(\tR\x04sICI\x12C\n" +
+	" \x01(\tH\bR\x04sICI\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\v \x01(\v2\x1c.ddex.ern.v383.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\f \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\"z\n" +
+	"\x0eproprietary_id\x18\f \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_w_cB\x0e\n" +
+	"\f_opus_numberB\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_m_nB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iJ\x06\b\xa8F\x10\x90N\"\x82\x01\n" +
 	"\x14CueCreationReference\x12,\n" +
 	"\x12cue_work_reference\x18\x01 \x01(\tR\x10cueWorkReference\x124\n" +
-	"\x16cue_resource_reference\x18\x02 \x01(\tR\x14cueResourceReference\"m\n" +
+	"\x16cue_resource_reference\x18\x02 \x01(\tR\x14cueResourceReferenceJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tCueOrigin\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueSheetType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueThemeType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"n\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"CueUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"{\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17CueVisualPerceptionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueVocalType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"U\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"v\n" +
 	"\x14CurrentTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"\xc2\x02\n" +
-	"\x03DSP\x126\n" +
-	"\ftrading_name\x18\x01 \x01(\v2\x13.ddex.ern.v383.NameR\vtradingName\x12\x12\n" +
-	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x12J\n" +
-	"\x0eterritory_code\x18\x03 \x01(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x121\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xec\x02\n" +
+	"\x03DSP\x121\n" +
 	"\bparty_id\x18\x04 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x05 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\"^\n" +
+	"party_name\x18\x05 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x126\n" +
+	"\ftrading_name\x18\x01 \x01(\v2\x13.ddex.ern.v383.NameR\vtradingName\x12\x12\n" +
+	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x12J\n" +
+	"\x0eterritory_code\x18\x03 \x01(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x88\x01\n" +
 	"\rDealReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\\\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x86\x01\n" +
 	"\vDescription\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xa9\v\n" +
-	"\x1bDetailedResourceContributor\x12b\n" +
-	"\x19resource_contributor_role\x18\x01 \x03(\v2&.ddex.ern.v383.ResourceContributorRoleR\x17resourceContributorRole\x12,\n" +
-	"\x12is_featured_artist\x18\x02 \x01(\bR\x10isFeaturedArtist\x120\n" +
-	"\x14is_contracted_artist\x18\x03 \x01(\bR\x12isContractedArtist\x12'\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb2\f\n" +
+	"\x1bDetailedResourceContributor\x121\n" +
+	"\bparty_id\x18\x14 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
+	"\n" +
+	"party_name\x18\x15 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12b\n" +
+	"\x19resource_contributor_role\x18\x01 \x03(\v2&.ddex.ern.v383.ResourceContributorRoleR\x17resourceContributorRole\x121\n" +
+	"\x12is_featured_artist\x18\x02 \x01(\bH\x00R\x10isFeaturedArtist\x88\x01\x01\x125\n" +
+	"\x14is_contracted_artist\x18\x03 \x01(\bH\x01R\x12isContractedArtist\x88\x01\x01\x12'\n" +
 	"\x0finstrument_type\x18\x04 \x03(\tR\x0einstrumentType\x12l\n" +
-	"\x1dartist_delegated_usage_rights\x18\x05 \x01(\v2).ddex.ern.v383.ArtistDelegatedUsageRightsR\x1aartistDelegatedUsageRights\x12\x10\n" +
-	"\x03sex\x18\x06 \x01(\tR\x03sex\x12A\n" +
+	"\x1dartist_delegated_usage_rights\x18\x05 \x01(\v2).ddex.ern.v383.ArtistDelegatedUsageRightsR\x1aartistDelegatedUsageRights\x12\x15\n" +
+	"\x03sex\x18\x06 \x01(\tH\x02R\x03sex\x88\x01\x01\x12A\n" +
 	"\vnationality\x18\a \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\vnationality\x12N\n" +
 	"\x17date_and_place_of_birth\x18\b \x01(\v2\x18.ddex.ern.v383.EventDateR\x13dateAndPlaceOfBirth\x12N\n" +
 	"\x17date_and_place_of_death\x18\t \x01(\v2\x18.ddex.ern.v383.EventDateR\x13dateAndPlaceOfDeath\x12<\n" +
 	"\fprimary_role\x18\n" +
 	" \x01(\v2\x19.ddex.ern.v383.ArtistRoleR\vprimaryRole\x12<\n" +
-	"\vperformance\x18\v \x03(\v2\x1a.ddex.ern.v383.PerformanceR\vperformance\x126\n" +
-	"\x17primary_instrument_type\x18\f \x01(\tR\x15primaryInstrumentType\x12_\n" +
+	"\vperformance\x18\v \x03(\v2\x1a.ddex.ern.v383.PerformanceR\vperformance\x12;\n" +
+	"\x17primary_instrument_type\x18\f \x01(\tH\x03R\x15primaryInstrumentType\x88\x01\x01\x12_\n" +
 	"\x18governing_agreement_type\x18\r \x01(\v2%.ddex.ern.v383.GoverningAgreementTypeR\x16governingAgreementType\x12I\n" +
 	"\x13contact_information\x18\x0e \x01(\v2\x18.ddex.ern.v383.ContactIdR\x12contactInformation\x12U\n" +
 	"\x16territory_of_residency\x18\x0f \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x14territoryOfResidency\x12A\n" +
@@ -20661,175 +20993,257 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x05genre\x18\x12 \x03(\v2\x14.ddex.ern.v383.GenreR\x05genre\x129\n" +
 	"\n" +
 	"membership\x18\x13 \x03(\v2\x19.ddex.ern.v383.MembershipR\n" +
-	"membership\x121\n" +
-	"\bparty_id\x18\x14 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
-	"\n" +
-	"party_name\x18\x15 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\x16 \x01(\x05R\x0esequenceNumber\"{\n" +
+	"membership\x12,\n" +
+	"\x0fsequence_number\x18\x16 \x01(\x05H\x04R\x0esequenceNumber\x88\x01\x01B\x15\n" +
+	"\x13_is_featured_artistB\x17\n" +
+	"\x15_is_contracted_artistB\x06\n" +
+	"\x04_sexB\x1a\n" +
+	"\x18_primary_instrument_typeB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17DistributionChannelType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8d\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd5\x01\n" +
 	"\x0fDrmPlatformType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\x93\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb0\x03\n" +
 	"\tEventDate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12%\n" +
-	"\x0eterritory_code\x18\x05 \x01(\tR\rterritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"\x97\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12*\n" +
+	"\x0eterritory_code\x18\x05 \x01(\tH\x03R\rterritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x11\n" +
+	"\x0f_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb4\x03\n" +
 	"\rEventDateTime\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12%\n" +
-	"\x0eterritory_code\x18\x05 \x01(\tR\rterritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"\xbf\x06\n" +
-	" ExtendedResourceGroupContentItem\x12'\n" +
-	"\x0fsequence_number\x18\x01 \x01(\x05R\x0esequenceNumber\x12.\n" +
-	"\x13sequence_sub_number\x18\x02 \x01(\x05R\x11sequenceSubNumber\x12@\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12*\n" +
+	"\x0eterritory_code\x18\x05 \x01(\tH\x03R\rterritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x11\n" +
+	"\x0f_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x9a\b\n" +
+	" ExtendedResourceGroupContentItem\x12,\n" +
+	"\x0fsequence_number\x18\x01 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x123\n" +
+	"\x13sequence_sub_number\x18\x02 \x01(\x05H\x01R\x11sequenceSubNumber\x88\x01\x01\x12@\n" +
 	"\rresource_type\x18\x03 \x03(\v2\x1b.ddex.ern.v383.ResourceTypeR\fresourceType\x12e\n" +
 	"\x1arelease_resource_reference\x18\x04 \x01(\v2'.ddex.ern.v383.ReleaseResourceReferenceR\x18releaseResourceReference\x12x\n" +
-	"!linked_release_resource_reference\x18\x05 \x03(\v2-.ddex.ern.v383.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12\x1a\n" +
-	"\bduration\x18\x06 \x01(\tR\bduration\x12,\n" +
-	"\x12is_hidden_resource\x18\a \x01(\bR\x10isHiddenResource\x12*\n" +
-	"\x11is_bonus_resource\x18\b \x01(\bR\x0fisBonusResource\x12I\n" +
-	"!is_instant_gratification_resource\x18\t \x01(\bR\x1eisInstantGratificationResource\x12D\n" +
-	"\x1fis_pre_order_incentive_resource\x18\n" +
-	" \x01(\bR\x1bisPreOrderIncentiveResource\x12_\n" +
+	"!linked_release_resource_reference\x18\x05 \x03(\v2-.ddex.ern.v383.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12_\n" +
 	"-resource_group_content_item_release_reference\x18\v \x01(\tR(resourceGroupContentItemReleaseReference\x127\n" +
 	"\n" +
-	"release_id\x18\f \x01(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\"F\n" +
+	"release_id\x18\f \x01(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12\x1f\n" +
+	"\bduration\x18\x06 \x01(\tH\x02R\bduration\x88\x01\x01\x121\n" +
+	"\x12is_hidden_resource\x18\a \x01(\bH\x03R\x10isHiddenResource\x88\x01\x01\x12/\n" +
+	"\x11is_bonus_resource\x18\b \x01(\bH\x04R\x0fisBonusResource\x88\x01\x01\x12N\n" +
+	"!is_instant_gratification_resource\x18\t \x01(\bH\x05R\x1eisInstantGratificationResource\x88\x01\x01\x12I\n" +
+	"\x1fis_pre_order_incentive_resource\x18\n" +
+	" \x01(\bH\x06R\x1bisPreOrderIncentiveResource\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x16\n" +
+	"\x14_sequence_sub_numberB\v\n" +
+	"\t_durationB\x15\n" +
+	"\x13_is_hidden_resourceB\x14\n" +
+	"\x12_is_bonus_resourceB$\n" +
+	"\"_is_instant_gratification_resourceB\"\n" +
+	" _is_pre_order_incentive_resourceJ\x06\b\xa8F\x10\x90N\"g\n" +
 	"\x06Extent\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\xa4\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xd8\x02\n" +
 	"\x14ExternalResourceLink\x12\x12\n" +
 	"\x05u_r_l\x18\x01 \x03(\tR\x03uRL\x12>\n" +
-	"\x0fvalidity_period\x18\x02 \x01(\v2\x15.ddex.ern.v383.PeriodR\x0evalidityPeriod\x12#\n" +
-	"\rexternal_link\x18\x03 \x01(\tR\fexternalLink\x12r\n" +
-	"\x1fexternally_linked_resource_type\x18\x04 \x03(\v2+.ddex.ern.v383.ExternallyLinkedResourceTypeR\x1cexternallyLinkedResourceType\x12\x1f\n" +
-	"\vfile_format\x18\x05 \x01(\tR\n" +
-	"fileFormat\"\x80\x01\n" +
+	"\x0fvalidity_period\x18\x02 \x01(\v2\x15.ddex.ern.v383.PeriodR\x0evalidityPeriod\x12(\n" +
+	"\rexternal_link\x18\x03 \x01(\tH\x00R\fexternalLink\x88\x01\x01\x12r\n" +
+	"\x1fexternally_linked_resource_type\x18\x04 \x03(\v2+.ddex.ern.v383.ExternallyLinkedResourceTypeR\x1cexternallyLinkedResourceType\x12$\n" +
+	"\vfile_format\x18\x05 \x01(\tH\x01R\n" +
+	"fileFormat\x88\x01\x01B\x10\n" +
+	"\x0e_external_linkB\x0e\n" +
+	"\f_file_formatJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\x1cExternallyLinkedResourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x87\x01\n" +
-	"\x04File\x121\n" +
-	"\bhash_sum\x18\x01 \x01(\v2\x16.ddex.ern.v383.HashSumR\ahashSum\x12\x12\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
+	"\x04File\x12\x12\n" +
 	"\x05u_r_l\x18\x02 \x01(\tR\x03uRL\x12\x1b\n" +
-	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x1b\n" +
-	"\tfile_path\x18\x04 \x01(\tR\bfilePath\"|\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12 \n" +
+	"\tfile_path\x18\x04 \x01(\tH\x00R\bfilePath\x88\x01\x01\x121\n" +
+	"\bhash_sum\x18\x01 \x01(\v2\x16.ddex.ern.v383.HashSumR\ahashSumB\f\n" +
+	"\n" +
+	"_file_pathJ\x06\b\xa8F\x10\x90N\"\xb3\x01\n" +
 	"\x18FingerprintAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"I\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"j\n" +
 	"\tFrameRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"z\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\x82\x01\n" +
 	"\x0fFulfillmentDate\x12)\n" +
 	"\x10fulfillment_date\x18\x01 \x01(\tR\x0ffulfillmentDate\x12<\n" +
-	"\x1aresource_release_reference\x18\x02 \x03(\tR\x18resourceReleaseReference\"\xb4\x01\n" +
+	"\x1aresource_release_reference\x18\x02 \x03(\tR\x18resourceReleaseReferenceJ\x06\b\xa8F\x10\x90N\"\xde\x01\n" +
 	"\x05Genre\x129\n" +
 	"\n" +
 	"genre_text\x18\x01 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\tgenreText\x127\n" +
-	"\tsub_genre\x18\x02 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\bsubGenre\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\"z\n" +
+	"\tsub_genre\x18\x02 \x01(\v2\x1a.ddex.ern.v383.DescriptionR\bsubGenre\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb1\x01\n" +
 	"\x16GoverningAgreementType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xad\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd1\x01\n" +
 	"\aHashSum\x12\x19\n" +
 	"\bhash_sum\x18\x01 \x01(\tR\ahashSum\x12Z\n" +
-	"\x17hash_sum_algorithm_type\x18\x02 \x01(\v2#.ddex.ern.v383.HashSumAlgorithmTypeR\x14hashSumAlgorithmType\x12+\n" +
-	"\x12hash_sum_data_type\x18\x03 \x01(\tR\x0fhashSumDataType\"x\n" +
+	"\x17hash_sum_algorithm_type\x18\x02 \x01(\v2#.ddex.ern.v383.HashSumAlgorithmTypeR\x14hashSumAlgorithmType\x120\n" +
+	"\x12hash_sum_data_type\x18\x03 \x01(\tH\x00R\x0fhashSumDataType\x88\x01\x01B\x15\n" +
+	"\x13_hash_sum_data_typeJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\x14HashSumAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xcb\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x87\x04\n" +
 	"\x10HostSoundCarrier\x127\n" +
 	"\n" +
 	"release_id\x18\x01 \x03(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12P\n" +
 	"\x13rights_agreement_id\x18\x02 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12*\n" +
 	"\x05title\x18\x03 \x03(\v2\x14.ddex.ern.v383.TitleR\x05title\x12<\n" +
 	"\x0edisplay_artist\x18\x04 \x03(\v2\x15.ddex.ern.v383.ArtistR\rdisplayArtist\x12n\n" +
-	"\x1dadministrating_record_company\x18\x05 \x03(\v2*.ddex.ern.v383.AdministratingRecordCompanyR\x1badministratingRecordCompany\x12!\n" +
-	"\ftrack_number\x18\x06 \x01(\tR\vtrackNumber\x12/\n" +
-	"\x14volume_number_in_set\x18\a \x01(\tR\x11volumeNumberInSet\"3\n" +
+	"\x1dadministrating_record_company\x18\x05 \x03(\v2*.ddex.ern.v383.AdministratingRecordCompanyR\x1badministratingRecordCompany\x12&\n" +
+	"\ftrack_number\x18\x06 \x01(\tH\x00R\vtrackNumber\x88\x01\x01\x124\n" +
+	"\x14volume_number_in_set\x18\a \x01(\tH\x01R\x11volumeNumberInSet\x88\x01\x01B\x0f\n" +
+	"\r_track_numberB\x17\n" +
+	"\x15_volume_number_in_setJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\x04ICPN\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x15\n" +
-	"\x06is_ean\x18\x02 \x01(\bR\x05isEan\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1a\n" +
+	"\x06is_ean\x18\x02 \x01(\bH\x00R\x05isEan\x88\x01\x01B\t\n" +
+	"\a_is_eanJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eImageCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"m\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tImageType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xf6\x02\n" +
-	"\x1bIndirectResourceContributor\x12v\n" +
-	"\"indirect_resource_contributor_role\x18\x01 \x03(\v2).ddex.ern.v383.MusicalWorkContributorRoleR\x1findirectResourceContributorRole\x12J\n" +
-	"\vnationality\x18\x02 \x03(\x0e2(.ddex.ern.v383.DdexCCurrentTerritoryCodeR\vnationality\x121\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x97\x03\n" +
+	"\x1bIndirectResourceContributor\x121\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\x05 \x01(\x05R\x0esequenceNumber\"Y\n" +
+	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12v\n" +
+	"\"indirect_resource_contributor_role\x18\x01 \x03(\v2).ddex.ern.v383.MusicalWorkContributorRoleR\x1findirectResourceContributorRole\x12J\n" +
+	"\vnationality\x18\x02 \x03(\x0e2(.ddex.ern.v383.DdexCCurrentTerritoryCodeR\vnationality\x12,\n" +
+	"\x0fsequence_number\x18\x05 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x83\x01\n" +
 	"\bKeywords\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xce\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc0\x02\n" +
 	"\tLabelName\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12&\n" +
-	"\x0flabel_name_type\x18\x03 \x01(\tR\rlabelNameType\x12\x1c\n" +
-	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x05 \x01(\tR\x10userDefinedValue\"\x9a\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12+\n" +
+	"\x0flabel_name_type\x18\x03 \x01(\tH\x01R\rlabelNameType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x05 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_label_name_typeB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xde\x01\n" +
 	"\x1eLinkedReleaseResourceReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12)\n" +
-	"\x10link_description\x18\x02 \x01(\tR\x0flinkDescription\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\"\xb3\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
+	"\x10link_description\x18\x02 \x01(\tH\x00R\x0flinkDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x13\n" +
+	"\x11_link_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xe1\x01\n" +
 	"\n" +
 	"Membership\x12B\n" +
 	"\forganization\x18\x01 \x01(\v2\x1e.ddex.ern.v383.PartyDescriptorR\forganization\x12'\n" +
-	"\x0fmembership_type\x18\x02 \x01(\tR\x0emembershipType\x12\x1d\n" +
+	"\x0fmembership_type\x18\x02 \x01(\tR\x0emembershipType\x12\"\n" +
 	"\n" +
-	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x04 \x01(\tR\aendDate\"\xae\x01\n" +
+	"start_date\x18\x03 \x01(\tH\x00R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x04 \x01(\tH\x01R\aendDate\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateJ\x06\b\xa8F\x10\x90N\"\xd8\x01\n" +
 	"\x11MessageAuditTrail\x12`\n" +
-	"\x19message_audit_trail_event\x18\x01 \x03(\v2%.ddex.ern.v383.MessageAuditTrailEventR\x16messageAuditTrailEvent\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x92\x01\n" +
+	"\x19message_audit_trail_event\x18\x01 \x03(\v2%.ddex.ern.v383.MessageAuditTrailEventR\x16messageAuditTrailEvent\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x9a\x01\n" +
 	"\x16MessageAuditTrailEvent\x12[\n" +
 	"\x1amessaging_party_descriptor\x18\x01 \x01(\v2\x1d.ddex.ern.v383.MessagingPartyR\x18messagingPartyDescriptor\x12\x1b\n" +
-	"\tdate_time\x18\x02 \x01(\tR\bdateTime\"\x8c\x05\n" +
-	"\rMessageHeader\x12*\n" +
-	"\x11message_thread_id\x18\x01 \x01(\tR\x0fmessageThreadId\x12\x1d\n" +
+	"\tdate_time\x18\x02 \x01(\tR\bdateTimeJ\x06\b\xa8F\x10\x90N\"\x8a\x06\n" +
+	"\rMessageHeader\x12/\n" +
+	"\x11message_thread_id\x18\x01 \x01(\tH\x00R\x0fmessageThreadId\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId\x12*\n" +
-	"\x11message_file_name\x18\x03 \x01(\tR\x0fmessageFileName\x12D\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12/\n" +
+	"\x11message_file_name\x18\x03 \x01(\tH\x01R\x0fmessageFileName\x88\x01\x01\x12D\n" +
 	"\x0emessage_sender\x18\x04 \x01(\v2\x1d.ddex.ern.v383.MessagingPartyR\rmessageSender\x12H\n" +
 	"\x11sent_on_behalf_of\x18\x05 \x01(\v2\x1d.ddex.ern.v383.MessagingPartyR\x0esentOnBehalfOf\x12J\n" +
 	"\x11message_recipient\x18\x06 \x03(\v2\x1d.ddex.ern.v383.MessagingPartyR\x10messageRecipient\x129\n" +
 	"\x19message_created_date_time\x18\a \x01(\tR\x16messageCreatedDateTime\x12P\n" +
 	"\x13message_audit_trail\x18\b \x01(\v2 .ddex.ern.v383.MessageAuditTrailR\x11messageAuditTrail\x120\n" +
-	"\acomment\x18\t \x01(\v2\x16.ddex.ern.v383.CommentR\acomment\x120\n" +
+	"\acomment\x18\t \x01(\v2\x16.ddex.ern.v383.CommentR\acomment\x125\n" +
 	"\x14message_control_type\x18\n" +
-	" \x01(\tR\x12messageControlType\x127\n" +
-	"\x18language_and_script_code\x18\v \x01(\tR\x15languageAndScriptCode\"\xed\x01\n" +
+	" \x01(\tH\x02R\x12messageControlType\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\v \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x14\n" +
+	"\x12_message_thread_idB\x14\n" +
+	"\x12_message_file_nameB\x17\n" +
+	"\x15_message_control_typeB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x97\x02\n" +
 	"\x0eMessagingParty\x121\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
 	"party_name\x18\x02 \x01(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x126\n" +
-	"\ftrading_name\x18\x03 \x01(\v2\x13.ddex.ern.v383.NameR\vtradingName\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\"l\n" +
+	"\ftrading_name\x18\x03 \x01(\v2\x13.ddex.ern.v383.NameR\vtradingName\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa3\x01\n" +
 	"\bMidiType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xdc\x05\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9a\x06\n" +
 	"\vMusicalWork\x12D\n" +
 	"\x0fmusical_work_id\x18\x01 \x03(\v2\x1c.ddex.ern.v383.MusicalWorkIdR\rmusicalWorkId\x124\n" +
 	"\x16musical_work_reference\x18\x02 \x01(\tR\x14musicalWorkReference\x12F\n" +
@@ -20839,127 +21253,174 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x11musical_work_type\x18\x06 \x03(\v2\x1e.ddex.ern.v383.MusicalWorkTypeR\x0fmusicalWorkType\x12:\n" +
 	"\vright_share\x18\a \x03(\v2\x19.ddex.ern.v383.RightShareR\n" +
 	"rightShare\x12v\n" +
-	"!musical_work_details_by_territory\x18\b \x03(\v2,.ddex.ern.v383.MusicalWorkDetailsByTerritoryR\x1dmusicalWorkDetailsByTerritory\x12\x1d\n" +
+	"!musical_work_details_by_territory\x18\b \x03(\v2,.ddex.ern.v383.MusicalWorkDetailsByTerritoryR\x1dmusicalWorkDetailsByTerritory\x12\"\n" +
 	"\n" +
-	"is_updated\x18\t \x01(\bR\tisUpdated\x127\n" +
+	"is_updated\x18\t \x01(\bH\x00R\tisUpdated\x88\x01\x01\x12<\n" +
 	"\x18language_and_script_code\x18\n" +
-	" \x01(\tR\x15languageAndScriptCode\"\xef\x02\n" +
-	"\x16MusicalWorkContributor\x12l\n" +
-	"\x1dmusical_work_contributor_role\x18\x01 \x03(\v2).ddex.ern.v383.MusicalWorkContributorRoleR\x1amusicalWorkContributorRole\x12R\n" +
-	"\x13society_affiliation\x18\x02 \x03(\v2!.ddex.ern.v383.SocietyAffiliationR\x12societyAffiliation\x121\n" +
+	" \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\r\n" +
+	"\v_is_updatedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x90\x03\n" +
+	"\x16MusicalWorkContributor\x121\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\x05 \x01(\x05R\x0esequenceNumber\"~\n" +
+	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12l\n" +
+	"\x1dmusical_work_contributor_role\x18\x01 \x03(\v2).ddex.ern.v383.MusicalWorkContributorRoleR\x1amusicalWorkContributorRole\x12R\n" +
+	"\x13society_affiliation\x18\x02 \x03(\v2!.ddex.ern.v383.SocietyAffiliationR\x12societyAffiliation\x12,\n" +
+	"\x0fsequence_number\x18\x05 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xb5\x01\n" +
 	"\x1aMusicalWorkContributorRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xa7\x03\n" +
-	"\x1dMusicalWorkDetailsByTerritory\x12_\n" +
-	"\x18musical_work_contributor\x18\x01 \x03(\v2%.ddex.ern.v383.MusicalWorkContributorR\x16musicalWorkContributor\x12C\n" +
-	"\x13display_artist_name\x18\x02 \x03(\v2\x13.ddex.ern.v383.NameR\x11displayArtistName\x12J\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd1\x03\n" +
+	"\x1dMusicalWorkDetailsByTerritory\x12J\n" +
 	"\x0eterritory_code\x18\x03 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x04 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"\xe5\x01\n" +
-	"\rMusicalWorkId\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12\x1f\n" +
-	"\vopus_number\x18\x02 \x01(\tR\n" +
-	"opusNumber\x126\n" +
+	"\x17excluded_territory_code\x18\x04 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12_\n" +
+	"\x18musical_work_contributor\x18\x01 \x03(\v2%.ddex.ern.v383.MusicalWorkContributorR\x16musicalWorkContributor\x12C\n" +
+	"\x13display_artist_name\x18\x02 \x03(\v2\x13.ddex.ern.v383.NameR\x11displayArtistName\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa8\x02\n" +
+	"\rMusicalWorkId\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x12$\n" +
+	"\vopus_number\x18\x02 \x01(\tH\x01R\n" +
+	"opusNumber\x88\x01\x01\x126\n" +
 	"\x17composer_catalog_number\x18\x03 \x03(\tR\x15composerCatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x05 \x01(\bR\n" +
-	"isReplaced\"s\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x05 \x01(\bH\x02R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_w_cB\x0e\n" +
+	"\f_opus_numberB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fMusicalWorkType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"U\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x7f\n" +
 	"\x04Name\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x91\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd9\x01\n" +
 	"\x13OperatingSystemType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xba\x01\n" +
-	"\x05PLine\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ep_line_company\x18\x02 \x01(\tR\fpLineCompany\x12\x1e\n" +
-	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12\x1e\n" +
-	"\vp_line_type\x18\x05 \x01(\tR\tpLineType\"w\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9f\x02\n" +
+	"\x05PLine\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ep_line_company\x18\x02 \x01(\tH\x01R\fpLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12#\n" +
+	"\vp_line_type\x18\x05 \x01(\tH\x03R\tpLineType\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_p_line_companyB\x1b\n" +
+	"\x19_language_and_script_codeB\x0e\n" +
+	"\f_p_line_typeJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13ParentalWarningType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"}\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x85\x01\n" +
 	"\x0fPartyDescriptor\x121\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x02 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\"u\n" +
+	"party_name\x18\x02 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyNameJ\x06\b\xa8F\x10\x90N\"\xb8\x01\n" +
 	"\aPartyId\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1a\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12\x1f\n" +
 	"\n" +
-	"is_d_p_i_d\x18\x03 \x01(\bR\x06isDPID\x12\x1a\n" +
+	"is_d_p_i_d\x18\x03 \x01(\bH\x01R\x06isDPID\x88\x01\x01\x12\x1f\n" +
 	"\n" +
-	"is_i_s_n_i\x18\x04 \x01(\bR\x06isISNI\"\xf4\x03\n" +
+	"is_i_s_n_i\x18\x04 \x01(\bH\x02R\x06isISNI\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\r\n" +
+	"\v_is_d_p_i_dB\r\n" +
+	"\v_is_i_s_n_iJ\x06\b\xa8F\x10\x90N\"\xc3\x04\n" +
 	"\tPartyName\x120\n" +
-	"\tfull_name\x18\x01 \x01(\v2\x13.ddex.ern.v383.NameR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12?\n" +
+	"\tfull_name\x18\x01 \x01(\v2\x13.ddex.ern.v383.NameR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12?\n" +
 	"\x11full_name_indexed\x18\x03 \x01(\v2\x13.ddex.ern.v383.NameR\x0ffullNameIndexed\x12F\n" +
 	"\x15names_before_key_name\x18\x04 \x01(\v2\x13.ddex.ern.v383.NameR\x12namesBeforeKeyName\x12.\n" +
 	"\bkey_name\x18\x05 \x01(\v2\x13.ddex.ern.v383.NameR\akeyName\x12D\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v2\x13.ddex.ern.v383.NameR\x11namesAfterKeyName\x12>\n" +
-	"\x10abbreviated_name\x18\a \x01(\v2\x13.ddex.ern.v383.NameR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\"R\n" +
+	"\x10abbreviated_name\x18\a \x01(\v2\x13.ddex.ern.v383.NameR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"x\n" +
 	"\n" +
 	"Percentage\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
-	"\x14has_max_value_of_one\x18\x02 \x01(\bR\x10hasMaxValueOfOne\"z\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x123\n" +
+	"\x14has_max_value_of_one\x18\x02 \x01(\bH\x00R\x10hasMaxValueOfOne\x88\x01\x01B\x17\n" +
+	"\x15_has_max_value_of_oneJ\x06\b\xa8F\x10\x90N\"\x82\x01\n" +
 	"\vPerformance\x12=\n" +
 	"\tterritory\x18\x01 \x01(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\tterritory\x12,\n" +
-	"\x04date\x18\x02 \x01(\v2\x18.ddex.ern.v383.EventDateR\x04date\"\xfe\x01\n" +
+	"\x04date\x18\x02 \x01(\v2\x18.ddex.ern.v383.EventDateR\x04dateJ\x06\b\xa8F\x10\x90N\"\x86\x02\n" +
 	"\x06Period\x127\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x18.ddex.ern.v383.EventDateR\tstartDate\x123\n" +
 	"\bend_date\x18\x02 \x01(\v2\x18.ddex.ern.v383.EventDateR\aendDate\x12D\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2\x1c.ddex.ern.v383.EventDateTimeR\rstartDateTime\x12@\n" +
-	"\rend_date_time\x18\x04 \x01(\v2\x1c.ddex.ern.v383.EventDateTimeR\vendDateTime\"B\n" +
+	"\rend_date_time\x18\x04 \x01(\v2\x1c.ddex.ern.v383.EventDateTimeR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"J\n" +
 	"\x05Price\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12#\n" +
-	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"D\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCodeJ\x06\b\xa8F\x10\x90N\"L\n" +
 	"\x0ePriceRangeType\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"?\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"G\n" +
 	"\tPriceType\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"E\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"`\n" +
 	"\x0fPromotionalCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rProprietaryId\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"k\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\aPurpose\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fRatingAgency\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"W\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x81\x01\n" +
 	"\x06Reason\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"n\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"ReasonType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xb8\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xe2\x01\n" +
 	"\x0eReferenceTitle\x127\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\v2\x18.ddex.ern.v383.TitleTextR\ttitleText\x124\n" +
-	"\tsub_title\x18\x02 \x01(\v2\x17.ddex.ern.v383.SubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\"\x8c\x05\n" +
+	"\tsub_title\x18\x02 \x01(\v2\x17.ddex.ern.v383.SubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb6\x05\n" +
 	"\x0eRelatedRelease\x127\n" +
 	"\n" +
 	"release_id\x18\x01 \x03(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12F\n" +
@@ -20968,88 +21429,121 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x13rights_agreement_id\x18\x04 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12b\n" +
 	"\x19release_relationship_type\x18\x05 \x01(\v2&.ddex.ern.v383.ReleaseRelationshipTypeR\x17releaseRelationshipType\x12;\n" +
 	"\frelease_date\x18\x06 \x01(\v2\x18.ddex.ern.v383.EventDateR\vreleaseDate\x12L\n" +
-	"\x15original_release_date\x18\a \x01(\v2\x18.ddex.ern.v383.EventDateR\x13originalReleaseDate\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\"f\n" +
+	"\x15original_release_date\x18\a \x01(\v2\x18.ddex.ern.v383.EventDateR\x13originalReleaseDate\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x8d\x01\n" +
 	"\x1aReleaseCollectionReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x122\n" +
-	"\x15release_resource_type\x18\x02 \x01(\tR\x13releaseResourceType\"\xc1\x01\n" +
-	"\x1eReleaseCollectionReferenceList\x122\n" +
-	"\x15number_of_collections\x18\x01 \x01(\x05R\x13numberOfCollections\x12k\n" +
-	"\x1crelease_collection_reference\x18\x02 \x03(\v2).ddex.ern.v383.ReleaseCollectionReferenceR\x1areleaseCollectionReference\"\x8e\x02\n" +
-	"\tReleaseId\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_s_r_c\x18\x02 \x01(\tR\x04iSRC\x12*\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
+	"\x15release_resource_type\x18\x02 \x01(\tH\x00R\x13releaseResourceType\x88\x01\x01B\x18\n" +
+	"\x16_release_resource_typeJ\x06\b\xa8F\x10\x90N\"\xe8\x01\n" +
+	"\x1eReleaseCollectionReferenceList\x127\n" +
+	"\x15number_of_collections\x18\x01 \x01(\x05H\x00R\x13numberOfCollections\x88\x01\x01\x12k\n" +
+	"\x1crelease_collection_reference\x18\x02 \x03(\v2).ddex.ern.v383.ReleaseCollectionReferenceR\x1areleaseCollectionReferenceB\x18\n" +
+	"\x16_number_of_collectionsJ\x06\b\xa8F\x10\x90N\"\xcb\x02\n" +
+	"\tReleaseId\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_r_c\x18\x02 \x01(\tH\x01R\x04iSRC\x88\x01\x01\x12*\n" +
 	"\ai_c_p_n\x18\x03 \x01(\v2\x13.ddex.ern.v383.ICPNR\x04iCPN\x12C\n" +
 	"\x0ecatalog_number\x18\x04 \x01(\v2\x1c.ddex.ern.v383.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x06 \x01(\bR\n" +
-	"isReplaced\"{\n" +
+	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x06 \x01(\bH\x02R\n" +
+	"isReplaced\x88\x01\x01B\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_s_r_cB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17ReleaseRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8b\x01\n" +
 	"\x18ReleaseResourceReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x122\n" +
-	"\x15release_resource_type\x18\x02 \x01(\tR\x13releaseResourceType\"\x85\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
+	"\x15release_resource_type\x18\x02 \x01(\tH\x00R\x13releaseResourceType\x88\x01\x01B\x18\n" +
+	"\x16_release_resource_typeJ\x06\b\xa8F\x10\x90N\"\x8d\x01\n" +
 	"\x1cReleaseResourceReferenceList\x12e\n" +
-	"\x1arelease_resource_reference\x18\x01 \x03(\v2'.ddex.ern.v383.ReleaseResourceReferenceR\x18releaseResourceReference\"\xd4\x03\n" +
-	" ReleaseSummaryDetailsByTerritory\x12C\n" +
+	"\x1arelease_resource_reference\x18\x01 \x03(\v2'.ddex.ern.v383.ReleaseResourceReferenceR\x18releaseResourceReferenceJ\x06\b\xa8F\x10\x90N\"\xfe\x03\n" +
+	" ReleaseSummaryDetailsByTerritory\x12J\n" +
+	"\x0eterritory_code\x18\x04 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x05 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12C\n" +
 	"\x13display_artist_name\x18\x01 \x03(\v2\x13.ddex.ern.v383.NameR\x11displayArtistName\x127\n" +
 	"\n" +
 	"label_name\x18\x02 \x03(\v2\x18.ddex.ern.v383.LabelNameR\tlabelName\x12P\n" +
-	"\x13rights_agreement_id\x18\x03 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12J\n" +
-	"\x0eterritory_code\x18\x04 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x05 \x03(\v2#.ddex.ern.v383.CurrentTerritoryCodeR\x15excludedTerritoryCode\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\"o\n" +
+	"\x13rights_agreement_id\x18\x03 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\x11rightsAgreementId\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vReleaseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xef\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa3\x02\n" +
 	"\"ResourceContainedResourceReference\x12Q\n" +
-	"%resource_contained_resource_reference\x18\x01 \x01(\tR\"resourceContainedResourceReference\x12#\n" +
-	"\rduration_used\x18\x02 \x01(\tR\fdurationUsed\x12\x1f\n" +
-	"\vstart_point\x18\x03 \x01(\tR\n" +
-	"startPoint\x120\n" +
-	"\apurpose\x18\x04 \x01(\v2\x16.ddex.ern.v383.PurposeR\apurpose\"\xaf\x01\n" +
+	"%resource_contained_resource_reference\x18\x01 \x01(\tR\"resourceContainedResourceReference\x12(\n" +
+	"\rduration_used\x18\x02 \x01(\tH\x00R\fdurationUsed\x88\x01\x01\x12$\n" +
+	"\vstart_point\x18\x03 \x01(\tH\x01R\n" +
+	"startPoint\x88\x01\x01\x120\n" +
+	"\apurpose\x18\x04 \x01(\v2\x16.ddex.ern.v383.PurposeR\apurposeB\x10\n" +
+	"\x0e_duration_usedB\x0e\n" +
+	"\f_start_pointJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"&ResourceContainedResourceReferenceList\x12\x84\x01\n" +
-	"%resource_contained_resource_reference\x18\x01 \x03(\v21.ddex.ern.v383.ResourceContainedResourceReferenceR\"resourceContainedResourceReference\"\x8e\x02\n" +
-	"\x13ResourceContributor\x12b\n" +
-	"\x19resource_contributor_role\x18\x01 \x03(\v2&.ddex.ern.v383.ResourceContributorRoleR\x17resourceContributorRole\x121\n" +
+	"%resource_contained_resource_reference\x18\x01 \x03(\v21.ddex.ern.v383.ResourceContainedResourceReferenceR\"resourceContainedResourceReferenceJ\x06\b\xa8F\x10\x90N\"\xaf\x02\n" +
+	"\x13ResourceContributor\x121\n" +
 	"\bparty_id\x18\x02 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x03 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\"{\n" +
+	"party_name\x18\x03 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12b\n" +
+	"\x19resource_contributor_role\x18\x01 \x03(\v2&.ddex.ern.v383.ResourceContributorRoleR\x17resourceContributorRole\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17ResourceContributorRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"o\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"w\n" +
 	"\"ResourceGroupResourceReferenceList\x12I\n" +
-	"!resource_group_resource_reference\x18\x01 \x03(\tR\x1eresourceGroupResourceReference\"\xd4\x01\n" +
-	"\x1cResourceMusicalWorkReference\x12'\n" +
-	"\x0fsequence_number\x18\x01 \x01(\x05R\x0esequenceNumber\x12#\n" +
-	"\rduration_used\x18\x02 \x01(\tR\fdurationUsed\x12\x1f\n" +
-	"\vis_fragment\x18\x03 \x01(\bR\n" +
-	"isFragment\x12E\n" +
-	"\x1fresource_musical_work_reference\x18\x04 \x01(\tR\x1cresourceMusicalWorkReference\"\x96\x01\n" +
+	"!resource_group_resource_reference\x18\x01 \x03(\tR\x1eresourceGroupResourceReferenceJ\x06\b\xa8F\x10\x90N\"\xa1\x02\n" +
+	"\x1cResourceMusicalWorkReference\x12,\n" +
+	"\x0fsequence_number\x18\x01 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12(\n" +
+	"\rduration_used\x18\x02 \x01(\tH\x01R\fdurationUsed\x88\x01\x01\x12$\n" +
+	"\vis_fragment\x18\x03 \x01(\bH\x02R\n" +
+	"isFragment\x88\x01\x01\x12E\n" +
+	"\x1fresource_musical_work_reference\x18\x04 \x01(\tR\x1cresourceMusicalWorkReferenceB\x12\n" +
+	"\x10_sequence_numberB\x10\n" +
+	"\x0e_duration_usedB\x0e\n" +
+	"\f_is_fragmentJ\x06\b\xa8F\x10\x90N\"\x9e\x01\n" +
 	" ResourceMusicalWorkReferenceList\x12r\n" +
-	"\x1fresource_musical_work_reference\x18\x01 \x03(\v2+.ddex.ern.v383.ResourceMusicalWorkReferenceR\x1cresourceMusicalWorkReference\"z\n" +
+	"\x1fresource_musical_work_reference\x18\x01 \x03(\v2+.ddex.ern.v383.ResourceMusicalWorkReferenceR\x1cresourceMusicalWorkReferenceJ\x06\b\xa8F\x10\x90N\"\xb1\x01\n" +
 	"\x16ResourceOmissionReason\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"}\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9a\x01\n" +
 	"\x15ResourceProprietaryId\x12C\n" +
-	"\x0eproprietary_id\x18\x01 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x02 \x01(\bR\n" +
-	"isReplaced\"p\n" +
+	"\x0eproprietary_id\x18\x01 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x02 \x01(\bH\x00R\n" +
+	"isReplaced\x88\x01\x01B\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fResourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x9c\v\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x81\f\n" +
 	"\n" +
 	"RightShare\x12F\n" +
 	"\x0eright_share_id\x18\x01 \x01(\v2 .ddex.ern.v383.RightsAgreementIdR\frightShareId\x122\n" +
 	"\x15right_share_reference\x18\x02 \x01(\tR\x13rightShareReference\x12|\n" +
-	"#right_share_creation_reference_list\x18\x03 \x01(\v2..ddex.ern.v383.RightShareCreationReferenceListR\x1frightShareCreationReferenceList\x12:\n" +
+	"#right_share_creation_reference_list\x18\x03 \x01(\v2..ddex.ern.v383.RightShareCreationReferenceListR\x1frightShareCreationReferenceList\x12F\n" +
+	"\x0eterritory_code\x18\x10 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\rterritoryCode\x12W\n" +
+	"\x17excluded_territory_code\x18\x11 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x15excludedTerritoryCode\x12:\n" +
 	"\vrights_type\x18\x04 \x03(\v2\x19.ddex.ern.v383.RightsTypeR\n" +
 	"rightsType\x121\n" +
 	"\buse_type\x18\x05 \x03(\v2\x16.ddex.ern.v383.UseTypeR\auseType\x12P\n" +
@@ -21060,197 +21554,295 @@ const file_ddex_ern_v383_v383_proto_rawDesc = "" +
 	"\x1emusical_work_rights_claim_type\x18\n" +
 	" \x03(\tR\x1amusicalWorkRightsClaimType\x12L\n" +
 	"\x11rights_controller\x18\v \x03(\v2\x1f.ddex.ern.v383.RightsControllerR\x10rightsController\x12>\n" +
-	"\x0fvalidity_period\x18\f \x01(\v2\x15.ddex.ern.v383.PeriodR\x0evalidityPeriod\x12I\n" +
-	"\x10tariff_reference\x18\r \x01(\v2\x1e.ddex.ern.v383.TariffReferenceR\x0ftariffReference\x12%\n" +
-	"\x0elicense_status\x18\x0e \x01(\tR\rlicenseStatus\x129\n" +
-	"\x19has_first_license_refusal\x18\x0f \x01(\bR\x16hasFirstLicenseRefusal\x12F\n" +
-	"\x0eterritory_code\x18\x10 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\rterritoryCode\x12W\n" +
-	"\x17excluded_territory_code\x18\x11 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x15excludedTerritoryCode\x12.\n" +
+	"\x0fvalidity_period\x18\f \x01(\v2\x15.ddex.ern.v383.PeriodR\x0evalidityPeriod\x12.\n" +
 	"\x13right_share_unknown\x18\x12 \x01(\bR\x11rightShareUnknown\x12O\n" +
-	"\x16right_share_percentage\x18\x13 \x01(\v2\x19.ddex.ern.v383.PercentageR\x14rightSharePercentage\x127\n" +
-	"\x18language_and_script_code\x18\x14 \x01(\tR\x15languageAndScriptCode\"\xe6\x01\n" +
+	"\x16right_share_percentage\x18\x13 \x01(\v2\x19.ddex.ern.v383.PercentageR\x14rightSharePercentage\x12I\n" +
+	"\x10tariff_reference\x18\r \x01(\v2\x1e.ddex.ern.v383.TariffReferenceR\x0ftariffReference\x12*\n" +
+	"\x0elicense_status\x18\x0e \x01(\tH\x00R\rlicenseStatus\x88\x01\x01\x12>\n" +
+	"\x19has_first_license_refusal\x18\x0f \x01(\bH\x01R\x16hasFirstLicenseRefusal\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x14 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_license_statusB\x1c\n" +
+	"\x1a_has_first_license_refusalB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xee\x01\n" +
 	"\x1fRightShareCreationReferenceList\x12;\n" +
 	"\x1aright_share_work_reference\x18\x01 \x03(\tR\x17rightShareWorkReference\x12C\n" +
 	"\x1eright_share_resource_reference\x18\x02 \x03(\tR\x1brightShareResourceReference\x12A\n" +
-	"\x1dright_share_release_reference\x18\x03 \x03(\tR\x1arightShareReleaseReference\"o\n" +
+	"\x1dright_share_release_reference\x18\x03 \x03(\tR\x1arightShareReleaseReferenceJ\x06\b\xa8F\x10\x90N\"w\n" +
 	"\x11RightsAgreementId\x12\x15\n" +
 	"\am_w_l_i\x18\x01 \x03(\tR\x04mWLI\x12C\n" +
-	"\x0eproprietary_id\x18\x02 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\"\x84\x01\n" +
+	"\x0eproprietary_id\x18\x02 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryIdJ\x06\b\xa8F\x10\x90N\"\x8c\x01\n" +
 	"\x11RightsClaimPolicy\x126\n" +
 	"\tcondition\x18\x01 \x03(\v2\x18.ddex.ern.v383.ConditionR\tcondition\x127\n" +
-	"\x18rights_claim_policy_type\x18\x02 \x01(\tR\x15rightsClaimPolicyType\"\x94\x03\n" +
-	"\x10RightsController\x124\n" +
-	"\x16rights_controller_role\x18\x01 \x03(\tR\x14rightsControllerRole\x124\n" +
-	"\x16rights_controller_type\x18\x02 \x01(\tR\x14rightsControllerType\x121\n" +
+	"\x18rights_claim_policy_type\x18\x02 \x01(\tR\x15rightsClaimPolicyTypeJ\x06\b\xa8F\x10\x90N\"\xd5\x03\n" +
+	"\x10RightsController\x121\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x12.\n" +
+	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v383.PartyNameR\tpartyName\x124\n" +
+	"\x16rights_controller_role\x18\x01 \x03(\tR\x14rightsControllerRole\x12.\n" +
 	"\x13right_share_unknown\x18\x05 \x01(\bR\x11rightShareUnknown\x12O\n" +
-	"\x16right_share_percentage\x18\x06 \x01(\v2\x19.ddex.ern.v383.PercentageR\x14rightSharePercentage\x12'\n" +
-	"\x0fsequence_number\x18\a \x01(\x05R\x0esequenceNumber\"\x95\x01\n" +
+	"\x16right_share_percentage\x18\x06 \x01(\v2\x19.ddex.ern.v383.PercentageR\x14rightSharePercentage\x129\n" +
+	"\x16rights_controller_type\x18\x02 \x01(\tH\x00R\x14rightsControllerType\x88\x01\x01\x12,\n" +
+	"\x0fsequence_number\x18\a \x01(\x05H\x01R\x0esequenceNumber\x88\x01\x01B\x19\n" +
+	"\x17_rights_controller_typeB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xcc\x01\n" +
 	"\n" +
 	"RightsType\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eterritory_code\x18\x02 \x01(\tR\rterritoryCode\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xc2\x01\n" +
+	"\x0eterritory_code\x18\x02 \x01(\tR\rterritoryCode\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xca\x01\n" +
 	"\x1cSalesReportingProxyReleaseId\x127\n" +
 	"\n" +
 	"release_id\x18\x01 \x01(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x12-\n" +
 	"\x06reason\x18\x02 \x01(\v2\x15.ddex.ern.v383.ReasonR\x06reason\x12:\n" +
 	"\vreason_type\x18\x03 \x01(\v2\x19.ddex.ern.v383.ReasonTypeR\n" +
-	"reasonType\"L\n" +
+	"reasonTypeJ\x06\b\xa8F\x10\x90N\"m\n" +
 	"\fSamplingRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\x91\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xd9\x01\n" +
 	"\x13SheetMusicCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\x8b\x01\n" +
-	"\fSheetMusicId\x12\x15\n" +
-	"\ai_s_m_n\x18\x01 \x01(\tR\x04iSMN\x12C\n" +
-	"\x0eproprietary_id\x18\x02 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x03 \x01(\bR\n" +
-	"isReplaced\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb9\x01\n" +
+	"\fSheetMusicId\x12\x1a\n" +
+	"\ai_s_m_n\x18\x01 \x01(\tH\x00R\x04iSMN\x88\x01\x01\x12C\n" +
+	"\x0eproprietary_id\x18\x02 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x03 \x01(\bH\x01R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_m_nB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0eSheetMusicType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x87\x02\n" +
-	"\x12SocietyAffiliation\x12P\n" +
-	"\x14music_rights_society\x18\x01 \x01(\v2\x1e.ddex.ern.v383.PartyDescriptorR\x12musicRightsSociety\x12F\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8f\x02\n" +
+	"\x12SocietyAffiliation\x12F\n" +
 	"\x0eterritory_code\x18\x02 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\rterritoryCode\x12W\n" +
-	"\x17excluded_territory_code\x18\x03 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x15excludedTerritoryCode\"p\n" +
+	"\x17excluded_territory_code\x18\x03 \x03(\v2\x1f.ddex.ern.v383.AllTerritoryCodeR\x15excludedTerritoryCode\x12P\n" +
+	"\x14music_rights_society\x18\x01 \x01(\v2\x1e.ddex.ern.v383.PartyDescriptorR\x12musicRightsSocietyJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fSoftwareType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x90\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd8\x01\n" +
 	"\x12SoundProcessorType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xa7\x02\n" +
-	"!SoundRecordingCollectionReference\x12'\n" +
-	"\x0fsequence_number\x18\x01 \x01(\x05R\x0esequenceNumber\x12O\n" +
-	"$sound_recording_collection_reference\x18\x02 \x01(\tR!soundRecordingCollectionReference\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
 	"\n" +
-	"start_time\x18\x03 \x01(\tR\tstartTime\x12\x1a\n" +
-	"\bduration\x18\x04 \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\x05 \x01(\tR\aendTime\x122\n" +
-	"\x15release_resource_type\x18\x06 \x01(\tR\x13releaseResourceType\"\xdf\x01\n" +
-	"%SoundRecordingCollectionReferenceList\x122\n" +
-	"\x15number_of_collections\x18\x01 \x01(\x05R\x13numberOfCollections\x12\x81\x01\n" +
-	"$sound_recording_collection_reference\x18\x02 \x03(\v20.ddex.ern.v383.SoundRecordingCollectionReferenceR!soundRecordingCollectionReference\"\xd4\x01\n" +
-	"\x10SoundRecordingId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12C\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9f\x03\n" +
+	"!SoundRecordingCollectionReference\x12,\n" +
+	"\x0fsequence_number\x18\x01 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12O\n" +
+	"$sound_recording_collection_reference\x18\x02 \x01(\tR!soundRecordingCollectionReference\x12\"\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tH\x01R\tstartTime\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x04 \x01(\tH\x02R\bduration\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x05 \x01(\tH\x03R\aendTime\x88\x01\x01\x127\n" +
+	"\x15release_resource_type\x18\x06 \x01(\tH\x04R\x13releaseResourceType\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_durationB\v\n" +
+	"\t_end_timeB\x18\n" +
+	"\x16_release_resource_typeJ\x06\b\xa8F\x10\x90N\"\x86\x02\n" +
+	"%SoundRecordingCollectionReferenceList\x127\n" +
+	"\x15number_of_collections\x18\x01 \x01(\x05H\x00R\x13numberOfCollections\x88\x01\x01\x12\x81\x01\n" +
+	"$sound_recording_collection_reference\x18\x02 \x03(\v20.ddex.ern.v383.SoundRecordingCollectionReferenceR!soundRecordingCollectionReferenceB\x18\n" +
+	"\x16_number_of_collectionsJ\x06\b\xa8F\x10\x90N\"\x82\x02\n" +
+	"\x10SoundRecordingId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\x02 \x01(\v2\x1c.ddex.ern.v383.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x03 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x04 \x01(\bR\n" +
-	"isReplaced\"v\n" +
+	"\x0eproprietary_id\x18\x03 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x04 \x01(\bH\x01R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12SoundRecordingType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"Y\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x83\x01\n" +
 	"\bSubTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"Y\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x83\x01\n" +
 	"\bSynopsis\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x92\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xda\x01\n" +
 	"\x0fTariffReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x120\n" +
-	"\x14tariff_sub_reference\x18\x03 \x01(\tR\x12tariffSubReference\"\xd2\x01\n" +
-	"\x16TechnicalInstantiation\x120\n" +
-	"\x14drm_enforcement_type\x18\x01 \x01(\tR\x12drmEnforcementType\x122\n" +
-	"\x15video_definition_type\x18\x02 \x01(\tR\x13videoDefinitionType\x12\x1f\n" +
-	"\vcoding_type\x18\x03 \x01(\tR\n" +
-	"codingType\x121\n" +
-	"\bbit_rate\x18\x04 \x01(\v2\x16.ddex.ern.v383.BitRateR\abitRate\"\x8b\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x125\n" +
+	"\x14tariff_sub_reference\x18\x03 \x01(\tH\x01R\x12tariffSubReference\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x17\n" +
+	"\x15_tariff_sub_referenceJ\x06\b\xa8F\x10\x90N\"\xac\x02\n" +
+	"\x16TechnicalInstantiation\x125\n" +
+	"\x14drm_enforcement_type\x18\x01 \x01(\tH\x00R\x12drmEnforcementType\x88\x01\x01\x127\n" +
+	"\x15video_definition_type\x18\x02 \x01(\tH\x01R\x13videoDefinitionType\x88\x01\x01\x12$\n" +
+	"\vcoding_type\x18\x03 \x01(\tH\x02R\n" +
+	"codingType\x88\x01\x01\x121\n" +
+	"\bbit_rate\x18\x04 \x01(\v2\x16.ddex.ern.v383.BitRateR\abitRateB\x17\n" +
+	"\x15_drm_enforcement_typeB\x18\n" +
+	"\x16_video_definition_typeB\x0e\n" +
+	"\f_coding_typeJ\x06\b\xa8F\x10\x90N\"\xd3\x01\n" +
 	"\rTextCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xb3\x01\n" +
-	"\x06TextId\x12\x15\n" +
-	"\ai_s_b_n\x18\x01 \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\x02 \x01(\tR\x04iSSN\x12\x15\n" +
-	"\as_i_c_i\x18\x03 \x01(\tR\x04sICI\x12C\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x05 \x01(\bR\n" +
-	"isReplaced\"l\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x83\x02\n" +
+	"\x06TextId\x12\x1a\n" +
+	"\ai_s_b_n\x18\x01 \x01(\tH\x00R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\x02 \x01(\tH\x01R\x04iSSN\x88\x01\x01\x12\x1a\n" +
+	"\as_i_c_i\x18\x03 \x01(\tH\x02R\x04sICI\x88\x01\x01\x12C\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x05 \x01(\bH\x03R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa3\x01\n" +
 	"\bTextType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd3\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x91\x02\n" +
 	"\x05Title\x127\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\v2\x18.ddex.ern.v383.TitleTextR\ttitleText\x129\n" +
-	"\tsub_title\x18\x02 \x03(\v2\x1c.ddex.ern.v383.TypedSubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x03(\v2\x1c.ddex.ern.v383.TypedSubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"title_type\x18\x04 \x01(\tR\ttitleType\"Z\n" +
+	"title_type\x18\x04 \x01(\tH\x01R\ttitleType\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_title_typeJ\x06\b\xa8F\x10\x90N\"\x84\x01\n" +
 	"\tTitleText\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x84\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc6\x01\n" +
 	"\rTypedSubTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12$\n" +
-	"\x0esub_title_type\x18\x03 \x01(\tR\fsubTitleType\"\xb9\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12)\n" +
+	"\x0esub_title_type\x18\x03 \x01(\tH\x01R\fsubTitleType\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x11\n" +
+	"\x0f_sub_title_typeJ\x06\b\xa8F\x10\x90N\"\xdb\x03\n" +
 	"\x05Usage\x121\n" +
 	"\buse_type\x18\x01 \x03(\v2\x16.ddex.ern.v383.UseTypeR\auseType\x12P\n" +
 	"\x13user_interface_type\x18\x02 \x03(\v2 .ddex.ern.v383.UserInterfaceTypeR\x11userInterfaceType\x12b\n" +
 	"\x19distribution_channel_type\x18\x03 \x03(\v2&.ddex.ern.v383.DistributionChannelTypeR\x17distributionChannelType\x12=\n" +
 	"\fcarrier_type\x18\x04 \x03(\v2\x1a.ddex.ern.v383.CarrierTypeR\vcarrierType\x12^\n" +
-	"\x17technical_instantiation\x18\x05 \x01(\v2%.ddex.ern.v383.TechnicalInstantiationR\x16technicalInstantiation\x12(\n" +
-	"\x10number_of_usages\x18\x06 \x01(\x05R\x0enumberOfUsages\"k\n" +
+	"\x17technical_instantiation\x18\x05 \x01(\v2%.ddex.ern.v383.TechnicalInstantiationR\x16technicalInstantiation\x12-\n" +
+	"\x10number_of_usages\x18\x06 \x01(\x05H\x00R\x0enumberOfUsages\x88\x01\x01B\x13\n" +
+	"\x11_number_of_usagesJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\aUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"M\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"h\n" +
 	"\x17UserDefinedResourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xa1\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceJ\x06\b\xa8F\x10\x90N\"\xf3\x01\n" +
 	"\x10UserDefinedValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\"u\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x0e\n" +
+	"\f_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\x11UserInterfaceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eVideoCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"S\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"[\n" +
 	"\x16VideoCueSheetReference\x129\n" +
-	"\x19video_cue_sheet_reference\x18\x01 \x01(\tR\x16videoCueSheetReference\"\x93\x02\n" +
-	"\aVideoId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_a_n\x18\x02 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x03 \x01(\tR\x05vISAN\x12C\n" +
+	"\x19video_cue_sheet_reference\x18\x01 \x01(\tR\x16videoCueSheetReferenceJ\x06\b\xa8F\x10\x90N\"\xe5\x02\n" +
+	"\aVideoId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x02 \x01(\tH\x01R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x03 \x01(\tH\x02R\x05vISAN\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\x04 \x01(\v2\x1c.ddex.ern.v383.CatalogNumberR\rcatalogNumber\x12C\n" +
 	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.ern.v383.ProprietaryIdR\rproprietaryId\x12\x15\n" +
-	"\ae_i_d_r\x18\x06 \x03(\tR\x04eIDR\x12\x1f\n" +
-	"\vis_replaced\x18\a \x01(\bR\n" +
-	"isReplaced\"m\n" +
+	"\ae_i_d_r\x18\x06 \x03(\tR\x04eIDR\x12$\n" +
+	"\vis_replaced\x18\a \x01(\bH\x03R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tVideoType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xf4\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb0\x02\n" +
 	"\aWebPage\x121\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x16.ddex.ern.v383.PartyIdR\apartyId\x127\n" +
 	"\n" +
 	"release_id\x18\x02 \x03(\v2\x18.ddex.ern.v383.ReleaseIdR\treleaseId\x120\n" +
-	"\tpage_name\x18\x03 \x01(\v2\x13.ddex.ern.v383.NameR\bpageName\x12\x12\n" +
-	"\x05u_r_l\x18\x04 \x01(\tR\x03uRL\x12\x1b\n" +
-	"\tuser_name\x18\x05 \x01(\tR\buserName\x12\x1a\n" +
-	"\bpassword\x18\x06 \x01(\tR\bpassword\"\x82\x01\n" +
+	"\tpage_name\x18\x03 \x01(\v2\x13.ddex.ern.v383.NameR\bpageName\x12\x17\n" +
+	"\x05u_r_l\x18\x04 \x01(\tH\x00R\x03uRL\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x05 \x01(\tH\x01R\buserName\x88\x01\x01\x12\x1f\n" +
+	"\bpassword\x18\x06 \x01(\tH\x02R\bpassword\x88\x01\x01B\b\n" +
+	"\x06_u_r_lB\f\n" +
+	"\n" +
+	"_user_nameB\v\n" +
+	"\t_passwordJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\bWorkList\x12=\n" +
-	"\fmusical_work\x18\x01 \x03(\v2\x1a.ddex.ern.v383.MusicalWorkR\vmusicalWork\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode*|\n" +
+	"\fmusical_work\x18\x01 \x03(\v2\x1a.ddex.ern.v383.MusicalWorkR\vmusicalWork\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N*|\n" +
 	"\x19DdexCCurrentTerritoryCode\x12-\n" +
 	")DDEX_C_CURRENT_TERRITORY_CODE_UNSPECIFIED\x10\x00\x120\n" +
 	",DDEX_C_CURRENT_TERRITORY_CODE_IDENTIFIERTYPE\x10\x01B\xa4\x01\n" +
@@ -21511,10 +22103,10 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	101, // 22: ddex.ern.v383.CatalogItem.release_date:type_name -> ddex.ern.v383.EventDate
 	101, // 23: ddex.ern.v383.CatalogTransfer.effective_transfer_date:type_name -> ddex.ern.v383.EventDate
 	5,   // 24: ddex.ern.v383.CatalogTransfer.catalog_release_reference_list:type_name -> ddex.ern.v383.CatalogReleaseReferenceList
-	139, // 25: ddex.ern.v383.CatalogTransfer.transferring_from:type_name -> ddex.ern.v383.PartyDescriptor
-	139, // 26: ddex.ern.v383.CatalogTransfer.transferring_to:type_name -> ddex.ern.v383.PartyDescriptor
-	61,  // 27: ddex.ern.v383.CatalogTransfer.territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
-	61,  // 28: ddex.ern.v383.CatalogTransfer.excluded_territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	61,  // 25: ddex.ern.v383.CatalogTransfer.territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	61,  // 26: ddex.ern.v383.CatalogTransfer.excluded_territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	139, // 27: ddex.ern.v383.CatalogTransfer.transferring_from:type_name -> ddex.ern.v383.PartyDescriptor
+	139, // 28: ddex.ern.v383.CatalogTransfer.transferring_to:type_name -> ddex.ern.v383.PartyDescriptor
 	75,  // 29: ddex.ern.v383.Collection.collection_id:type_name -> ddex.ern.v383.CollectionId
 	76,  // 30: ddex.ern.v383.Collection.collection_type:type_name -> ddex.ern.v383.CollectionType
 	199, // 31: ddex.ern.v383.Collection.title:type_name -> ddex.ern.v383.Title
@@ -21529,11 +22121,11 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	78,  // 40: ddex.ern.v383.Collection.collection_work_reference_list:type_name -> ddex.ern.v383.CollectionWorkReferenceList
 	137, // 41: ddex.ern.v383.Collection.p_line:type_name -> ddex.ern.v383.PLine
 	69,  // 42: ddex.ern.v383.Collection.c_line:type_name -> ddex.ern.v383.CLine
-	199, // 43: ddex.ern.v383.CollectionDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	98,  // 44: ddex.ern.v383.CollectionDetailsByTerritory.contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	72,  // 45: ddex.ern.v383.CollectionDetailsByTerritory.character:type_name -> ddex.ern.v383.Character
-	94,  // 46: ddex.ern.v383.CollectionDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 47: ddex.ern.v383.CollectionDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 43: ddex.ern.v383.CollectionDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 44: ddex.ern.v383.CollectionDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 45: ddex.ern.v383.CollectionDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	98,  // 46: ddex.ern.v383.CollectionDetailsByTerritory.contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	72,  // 47: ddex.ern.v383.CollectionDetailsByTerritory.character:type_name -> ddex.ern.v383.Character
 	7,   // 48: ddex.ern.v383.CollectionList.collection:type_name -> ddex.ern.v383.Collection
 	10,  // 49: ddex.ern.v383.CollectionResourceReferenceList.collection_resource_reference:type_name -> ddex.ern.v383.CollectionResourceReference
 	91,  // 50: ddex.ern.v383.Cue.cue_use_type:type_name -> ddex.ern.v383.CueUseType
@@ -21541,14 +22133,14 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	93,  // 52: ddex.ern.v383.Cue.cue_vocal_type:type_name -> ddex.ern.v383.CueVocalType
 	92,  // 53: ddex.ern.v383.Cue.cue_visual_perception_type:type_name -> ddex.ern.v383.CueVisualPerceptionType
 	88,  // 54: ddex.ern.v383.Cue.cue_origin:type_name -> ddex.ern.v383.CueOrigin
-	137, // 55: ddex.ern.v383.Cue.p_line:type_name -> ddex.ern.v383.PLine
-	69,  // 56: ddex.ern.v383.Cue.c_line:type_name -> ddex.ern.v383.CLine
-	87,  // 57: ddex.ern.v383.Cue.cue_creation_reference:type_name -> ddex.ern.v383.CueCreationReference
-	86,  // 58: ddex.ern.v383.Cue.referenced_creation_id:type_name -> ddex.ern.v383.CreationId
-	199, // 59: ddex.ern.v383.Cue.referenced_creation_title:type_name -> ddex.ern.v383.Title
-	98,  // 60: ddex.ern.v383.Cue.referenced_creation_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	130, // 61: ddex.ern.v383.Cue.referenced_indirect_creation_contributor:type_name -> ddex.ern.v383.MusicalWorkContributor
-	72,  // 62: ddex.ern.v383.Cue.referenced_creation_character:type_name -> ddex.ern.v383.Character
+	87,  // 55: ddex.ern.v383.Cue.cue_creation_reference:type_name -> ddex.ern.v383.CueCreationReference
+	86,  // 56: ddex.ern.v383.Cue.referenced_creation_id:type_name -> ddex.ern.v383.CreationId
+	199, // 57: ddex.ern.v383.Cue.referenced_creation_title:type_name -> ddex.ern.v383.Title
+	98,  // 58: ddex.ern.v383.Cue.referenced_creation_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	130, // 59: ddex.ern.v383.Cue.referenced_indirect_creation_contributor:type_name -> ddex.ern.v383.MusicalWorkContributor
+	72,  // 60: ddex.ern.v383.Cue.referenced_creation_character:type_name -> ddex.ern.v383.Character
+	137, // 61: ddex.ern.v383.Cue.p_line:type_name -> ddex.ern.v383.PLine
+	69,  // 62: ddex.ern.v383.Cue.c_line:type_name -> ddex.ern.v383.CLine
 	149, // 63: ddex.ern.v383.CueSheet.cue_sheet_id:type_name -> ddex.ern.v383.ProprietaryId
 	89,  // 64: ddex.ern.v383.CueSheet.cue_sheet_type:type_name -> ddex.ern.v383.CueSheetType
 	12,  // 65: ddex.ern.v383.CueSheet.cue:type_name -> ddex.ern.v383.Cue
@@ -21561,46 +22153,46 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	31,  // 72: ddex.ern.v383.DealList.release_deal:type_name -> ddex.ern.v383.ReleaseDeal
 	144, // 73: ddex.ern.v383.DealResourceReferenceList.period:type_name -> ddex.ern.v383.Period
 	80,  // 74: ddex.ern.v383.DealTerms.commercial_model_type:type_name -> ddex.ern.v383.CommercialModelType
-	27,  // 75: ddex.ern.v383.DealTerms.price_information:type_name -> ddex.ern.v383.PriceInformation
-	144, // 76: ddex.ern.v383.DealTerms.validity_period:type_name -> ddex.ern.v383.Period
-	82,  // 77: ddex.ern.v383.DealTerms.consumer_rental_period:type_name -> ddex.ern.v383.ConsumerRentalPeriod
-	101, // 78: ddex.ern.v383.DealTerms.pre_order_release_date:type_name -> ddex.ern.v383.EventDate
-	17,  // 79: ddex.ern.v383.DealTerms.pre_order_incentive_resource_list:type_name -> ddex.ern.v383.DealResourceReferenceList
-	17,  // 80: ddex.ern.v383.DealTerms.instant_gratification_resource_list:type_name -> ddex.ern.v383.DealResourceReferenceList
-	29,  // 81: ddex.ern.v383.DealTerms.related_release_offer_set:type_name -> ddex.ern.v383.RelatedReleaseOfferSet
-	25,  // 82: ddex.ern.v383.DealTerms.physical_returns:type_name -> ddex.ern.v383.PhysicalReturns
-	177, // 83: ddex.ern.v383.DealTerms.rights_claim_policy:type_name -> ddex.ern.v383.RightsClaimPolicy
-	59,  // 84: ddex.ern.v383.DealTerms.web_policy:type_name -> ddex.ern.v383.WebPolicy
-	202, // 85: ddex.ern.v383.DealTerms.usage:type_name -> ddex.ern.v383.Usage
-	94,  // 86: ddex.ern.v383.DealTerms.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 87: ddex.ern.v383.DealTerms.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	95,  // 88: ddex.ern.v383.DealTerms.distribution_channel:type_name -> ddex.ern.v383.DSP
-	95,  // 89: ddex.ern.v383.DealTerms.excluded_distribution_channel:type_name -> ddex.ern.v383.DSP
-	148, // 90: ddex.ern.v383.DealTerms.promotional_code:type_name -> ddex.ern.v383.PromotionalCode
-	101, // 91: ddex.ern.v383.DealTerms.pre_order_preview_date:type_name -> ddex.ern.v383.EventDate
+	202, // 75: ddex.ern.v383.DealTerms.usage:type_name -> ddex.ern.v383.Usage
+	94,  // 76: ddex.ern.v383.DealTerms.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 77: ddex.ern.v383.DealTerms.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	95,  // 78: ddex.ern.v383.DealTerms.distribution_channel:type_name -> ddex.ern.v383.DSP
+	95,  // 79: ddex.ern.v383.DealTerms.excluded_distribution_channel:type_name -> ddex.ern.v383.DSP
+	27,  // 80: ddex.ern.v383.DealTerms.price_information:type_name -> ddex.ern.v383.PriceInformation
+	148, // 81: ddex.ern.v383.DealTerms.promotional_code:type_name -> ddex.ern.v383.PromotionalCode
+	144, // 82: ddex.ern.v383.DealTerms.validity_period:type_name -> ddex.ern.v383.Period
+	82,  // 83: ddex.ern.v383.DealTerms.consumer_rental_period:type_name -> ddex.ern.v383.ConsumerRentalPeriod
+	101, // 84: ddex.ern.v383.DealTerms.pre_order_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 85: ddex.ern.v383.DealTerms.pre_order_preview_date:type_name -> ddex.ern.v383.EventDate
+	17,  // 86: ddex.ern.v383.DealTerms.pre_order_incentive_resource_list:type_name -> ddex.ern.v383.DealResourceReferenceList
+	17,  // 87: ddex.ern.v383.DealTerms.instant_gratification_resource_list:type_name -> ddex.ern.v383.DealResourceReferenceList
+	29,  // 88: ddex.ern.v383.DealTerms.related_release_offer_set:type_name -> ddex.ern.v383.RelatedReleaseOfferSet
+	25,  // 89: ddex.ern.v383.DealTerms.physical_returns:type_name -> ddex.ern.v383.PhysicalReturns
+	177, // 90: ddex.ern.v383.DealTerms.rights_claim_policy:type_name -> ddex.ern.v383.RightsClaimPolicy
+	59,  // 91: ddex.ern.v383.DealTerms.web_policy:type_name -> ddex.ern.v383.WebPolicy
 	108, // 92: ddex.ern.v383.Fingerprint.fingerprint_algorithm_type:type_name -> ddex.ern.v383.FingerprintAlgorithmType
 	118, // 93: ddex.ern.v383.Image.image_type:type_name -> ddex.ern.v383.ImageType
 	172, // 94: ddex.ern.v383.Image.image_id:type_name -> ddex.ern.v383.ResourceProprietaryId
 	199, // 95: ddex.ern.v383.Image.title:type_name -> ddex.ern.v383.Title
 	101, // 96: ddex.ern.v383.Image.creation_date:type_name -> ddex.ern.v383.EventDate
 	22,  // 97: ddex.ern.v383.Image.image_details_by_territory:type_name -> ddex.ern.v383.ImageDetailsByTerritory
-	199, // 98: ddex.ern.v383.ImageDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	98,  // 99: ddex.ern.v383.ImageDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 100: ddex.ern.v383.ImageDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	135, // 101: ddex.ern.v383.ImageDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	69,  // 102: ddex.ern.v383.ImageDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	97,  // 103: ddex.ern.v383.ImageDetailsByTerritory.description:type_name -> ddex.ern.v383.Description
-	85,  // 104: ddex.ern.v383.ImageDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
-	101, // 105: ddex.ern.v383.ImageDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 106: ddex.ern.v383.ImageDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	110, // 107: ddex.ern.v383.ImageDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	120, // 108: ddex.ern.v383.ImageDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 109: ddex.ern.v383.ImageDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	111, // 110: ddex.ern.v383.ImageDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 111: ddex.ern.v383.ImageDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	44,  // 112: ddex.ern.v383.ImageDetailsByTerritory.technical_image_details:type_name -> ddex.ern.v383.TechnicalImageDetails
-	94,  // 113: ddex.ern.v383.ImageDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 114: ddex.ern.v383.ImageDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 98: ddex.ern.v383.ImageDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 99: ddex.ern.v383.ImageDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 100: ddex.ern.v383.ImageDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	98,  // 101: ddex.ern.v383.ImageDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 102: ddex.ern.v383.ImageDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	135, // 103: ddex.ern.v383.ImageDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	69,  // 104: ddex.ern.v383.ImageDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	97,  // 105: ddex.ern.v383.ImageDetailsByTerritory.description:type_name -> ddex.ern.v383.Description
+	85,  // 106: ddex.ern.v383.ImageDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
+	101, // 107: ddex.ern.v383.ImageDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 108: ddex.ern.v383.ImageDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	110, // 109: ddex.ern.v383.ImageDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	120, // 110: ddex.ern.v383.ImageDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 111: ddex.ern.v383.ImageDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
+	111, // 112: ddex.ern.v383.ImageDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 113: ddex.ern.v383.ImageDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	44,  // 114: ddex.ern.v383.ImageDetailsByTerritory.technical_image_details:type_name -> ddex.ern.v383.TechnicalImageDetails
 	128, // 115: ddex.ern.v383.MIDI.midi_type:type_name -> ddex.ern.v383.MidiType
 	172, // 116: ddex.ern.v383.MIDI.midi_id:type_name -> ddex.ern.v383.ResourceProprietaryId
 	133, // 117: ddex.ern.v383.MIDI.indirect_midi_id:type_name -> ddex.ern.v383.MusicalWorkId
@@ -21613,29 +22205,29 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	101, // 124: ddex.ern.v383.MIDI.mastered_date:type_name -> ddex.ern.v383.EventDate
 	101, // 125: ddex.ern.v383.MIDI.remastered_date:type_name -> ddex.ern.v383.EventDate
 	24,  // 126: ddex.ern.v383.MIDI.midi_details_by_territory:type_name -> ddex.ern.v383.MidiDetailsByTerritory
-	199, // 127: ddex.ern.v383.MidiDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	62,  // 128: ddex.ern.v383.MidiDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
-	98,  // 129: ddex.ern.v383.MidiDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 130: ddex.ern.v383.MidiDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	176, // 131: ddex.ern.v383.MidiDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
-	135, // 132: ddex.ern.v383.MidiDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	121, // 133: ddex.ern.v383.MidiDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
-	54,  // 134: ddex.ern.v383.MidiDetailsByTerritory.rights_controller:type_name -> ddex.ern.v383.TypedRightsController
-	101, // 135: ddex.ern.v383.MidiDetailsByTerritory.remastered_date:type_name -> ddex.ern.v383.EventDate
-	101, // 136: ddex.ern.v383.MidiDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 137: ddex.ern.v383.MidiDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	69,  // 138: ddex.ern.v383.MidiDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	85,  // 139: ddex.ern.v383.MidiDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
-	115, // 140: ddex.ern.v383.MidiDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern.v383.HostSoundCarrier
-	79,  // 141: ddex.ern.v383.MidiDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
-	111, // 142: ddex.ern.v383.MidiDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 143: ddex.ern.v383.MidiDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	110, // 144: ddex.ern.v383.MidiDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	120, // 145: ddex.ern.v383.MidiDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 146: ddex.ern.v383.MidiDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	45,  // 147: ddex.ern.v383.MidiDetailsByTerritory.technical_midi_details:type_name -> ddex.ern.v383.TechnicalMidiDetails
-	94,  // 148: ddex.ern.v383.MidiDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 149: ddex.ern.v383.MidiDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 127: ddex.ern.v383.MidiDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 128: ddex.ern.v383.MidiDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 129: ddex.ern.v383.MidiDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	62,  // 130: ddex.ern.v383.MidiDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
+	98,  // 131: ddex.ern.v383.MidiDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 132: ddex.ern.v383.MidiDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	176, // 133: ddex.ern.v383.MidiDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
+	135, // 134: ddex.ern.v383.MidiDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	121, // 135: ddex.ern.v383.MidiDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
+	54,  // 136: ddex.ern.v383.MidiDetailsByTerritory.rights_controller:type_name -> ddex.ern.v383.TypedRightsController
+	101, // 137: ddex.ern.v383.MidiDetailsByTerritory.remastered_date:type_name -> ddex.ern.v383.EventDate
+	101, // 138: ddex.ern.v383.MidiDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 139: ddex.ern.v383.MidiDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	69,  // 140: ddex.ern.v383.MidiDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	85,  // 141: ddex.ern.v383.MidiDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
+	115, // 142: ddex.ern.v383.MidiDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern.v383.HostSoundCarrier
+	79,  // 143: ddex.ern.v383.MidiDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
+	111, // 144: ddex.ern.v383.MidiDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 145: ddex.ern.v383.MidiDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	110, // 146: ddex.ern.v383.MidiDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	120, // 147: ddex.ern.v383.MidiDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 148: ddex.ern.v383.MidiDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
+	45,  // 149: ddex.ern.v383.MidiDetailsByTerritory.technical_midi_details:type_name -> ddex.ern.v383.TechnicalMidiDetails
 	97,  // 150: ddex.ern.v383.PreviewDetails.part_type:type_name -> ddex.ern.v383.Description
 	97,  // 151: ddex.ern.v383.PriceInformation.description:type_name -> ddex.ern.v383.Description
 	146, // 152: ddex.ern.v383.PriceInformation.price_range_type:type_name -> ddex.ern.v383.PriceRangeType
@@ -21646,51 +22238,51 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	158, // 157: ddex.ern.v383.PurgedRelease.release_id:type_name -> ddex.ern.v383.ReleaseId
 	199, // 158: ddex.ern.v383.PurgedRelease.title:type_name -> ddex.ern.v383.Title
 	98,  // 159: ddex.ern.v383.PurgedRelease.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	15,  // 160: ddex.ern.v383.RelatedReleaseOfferSet.deal:type_name -> ddex.ern.v383.Deal
-	158, // 161: ddex.ern.v383.RelatedReleaseOfferSet.release_id:type_name -> ddex.ern.v383.ReleaseId
-	97,  // 162: ddex.ern.v383.RelatedReleaseOfferSet.release_description:type_name -> ddex.ern.v383.Description
+	158, // 160: ddex.ern.v383.RelatedReleaseOfferSet.release_id:type_name -> ddex.ern.v383.ReleaseId
+	97,  // 161: ddex.ern.v383.RelatedReleaseOfferSet.release_description:type_name -> ddex.ern.v383.Description
+	15,  // 162: ddex.ern.v383.RelatedReleaseOfferSet.deal:type_name -> ddex.ern.v383.Deal
 	158, // 163: ddex.ern.v383.Release.release_id:type_name -> ddex.ern.v383.ReleaseId
 	105, // 164: ddex.ern.v383.Release.external_resource_link:type_name -> ddex.ern.v383.ExternalResourceLink
 	180, // 165: ddex.ern.v383.Release.sales_reporting_proxy_release_id:type_name -> ddex.ern.v383.SalesReportingProxyReleaseId
 	154, // 166: ddex.ern.v383.Release.reference_title:type_name -> ddex.ern.v383.ReferenceTitle
-	157, // 167: ddex.ern.v383.Release.release_collection_reference_list:type_name -> ddex.ern.v383.ReleaseCollectionReferenceList
-	163, // 168: ddex.ern.v383.Release.release_type:type_name -> ddex.ern.v383.ReleaseType
-	32,  // 169: ddex.ern.v383.Release.release_details_by_territory:type_name -> ddex.ern.v383.ReleaseDetailsByTerritory
-	176, // 170: ddex.ern.v383.Release.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
-	137, // 171: ddex.ern.v383.Release.p_line:type_name -> ddex.ern.v383.PLine
-	69,  // 172: ddex.ern.v383.Release.c_line:type_name -> ddex.ern.v383.CLine
-	211, // 173: ddex.ern.v383.Release.artist_profile_page:type_name -> ddex.ern.v383.WebPage
-	101, // 174: ddex.ern.v383.Release.global_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 175: ddex.ern.v383.Release.global_original_release_date:type_name -> ddex.ern.v383.EventDate
-	161, // 176: ddex.ern.v383.Release.release_resource_reference_list:type_name -> ddex.ern.v383.ReleaseResourceReferenceList
-	171, // 177: ddex.ern.v383.Release.resource_omission_reason:type_name -> ddex.ern.v383.ResourceOmissionReason
+	161, // 167: ddex.ern.v383.Release.release_resource_reference_list:type_name -> ddex.ern.v383.ReleaseResourceReferenceList
+	171, // 168: ddex.ern.v383.Release.resource_omission_reason:type_name -> ddex.ern.v383.ResourceOmissionReason
+	157, // 169: ddex.ern.v383.Release.release_collection_reference_list:type_name -> ddex.ern.v383.ReleaseCollectionReferenceList
+	163, // 170: ddex.ern.v383.Release.release_type:type_name -> ddex.ern.v383.ReleaseType
+	32,  // 171: ddex.ern.v383.Release.release_details_by_territory:type_name -> ddex.ern.v383.ReleaseDetailsByTerritory
+	176, // 172: ddex.ern.v383.Release.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
+	137, // 173: ddex.ern.v383.Release.p_line:type_name -> ddex.ern.v383.PLine
+	69,  // 174: ddex.ern.v383.Release.c_line:type_name -> ddex.ern.v383.CLine
+	211, // 175: ddex.ern.v383.Release.artist_profile_page:type_name -> ddex.ern.v383.WebPage
+	101, // 176: ddex.ern.v383.Release.global_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 177: ddex.ern.v383.Release.global_original_release_date:type_name -> ddex.ern.v383.EventDate
 	15,  // 178: ddex.ern.v383.ReleaseDeal.deal:type_name -> ddex.ern.v383.Deal
-	135, // 179: ddex.ern.v383.ReleaseDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	121, // 180: ddex.ern.v383.ReleaseDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
-	176, // 181: ddex.ern.v383.ReleaseDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
-	199, // 182: ddex.ern.v383.ReleaseDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	62,  // 183: ddex.ern.v383.ReleaseDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
-	60,  // 184: ddex.ern.v383.ReleaseDetailsByTerritory.administrating_record_company:type_name -> ddex.ern.v383.AdministratingRecordCompany
-	163, // 185: ddex.ern.v383.ReleaseDetailsByTerritory.release_type:type_name -> ddex.ern.v383.ReleaseType
-	155, // 186: ddex.ern.v383.ReleaseDetailsByTerritory.related_release:type_name -> ddex.ern.v383.RelatedRelease
-	138, // 187: ddex.ern.v383.ReleaseDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	67,  // 188: ddex.ern.v383.ReleaseDetailsByTerritory.av_rating:type_name -> ddex.ern.v383.AvRating
-	79,  // 189: ddex.ern.v383.ReleaseDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
-	34,  // 190: ddex.ern.v383.ReleaseDetailsByTerritory.resource_group:type_name -> ddex.ern.v383.ResourceGroup
-	111, // 191: ddex.ern.v383.ReleaseDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	137, // 192: ddex.ern.v383.ReleaseDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
-	69,  // 193: ddex.ern.v383.ReleaseDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	101, // 194: ddex.ern.v383.ReleaseDetailsByTerritory.release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 195: ddex.ern.v383.ReleaseDetailsByTerritory.original_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 196: ddex.ern.v383.ReleaseDetailsByTerritory.original_digital_release_date:type_name -> ddex.ern.v383.EventDate
-	120, // 197: ddex.ern.v383.ReleaseDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 198: ddex.ern.v383.ReleaseDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	72,  // 199: ddex.ern.v383.ReleaseDetailsByTerritory.character:type_name -> ddex.ern.v383.Character
-	62,  // 200: ddex.ern.v383.ReleaseDetailsByTerritory.display_conductor:type_name -> ddex.ern.v383.Artist
-	94,  // 201: ddex.ern.v383.ReleaseDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 202: ddex.ern.v383.ReleaseDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	97,  // 203: ddex.ern.v383.ReleaseDetailsByTerritory.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 204: ddex.ern.v383.ReleaseDetailsByTerritory.file:type_name -> ddex.ern.v383.File
+	94,  // 179: ddex.ern.v383.ReleaseDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 180: ddex.ern.v383.ReleaseDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	135, // 181: ddex.ern.v383.ReleaseDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	121, // 182: ddex.ern.v383.ReleaseDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
+	176, // 183: ddex.ern.v383.ReleaseDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
+	199, // 184: ddex.ern.v383.ReleaseDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	62,  // 185: ddex.ern.v383.ReleaseDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
+	60,  // 186: ddex.ern.v383.ReleaseDetailsByTerritory.administrating_record_company:type_name -> ddex.ern.v383.AdministratingRecordCompany
+	163, // 187: ddex.ern.v383.ReleaseDetailsByTerritory.release_type:type_name -> ddex.ern.v383.ReleaseType
+	155, // 188: ddex.ern.v383.ReleaseDetailsByTerritory.related_release:type_name -> ddex.ern.v383.RelatedRelease
+	138, // 189: ddex.ern.v383.ReleaseDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	67,  // 190: ddex.ern.v383.ReleaseDetailsByTerritory.av_rating:type_name -> ddex.ern.v383.AvRating
+	79,  // 191: ddex.ern.v383.ReleaseDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
+	34,  // 192: ddex.ern.v383.ReleaseDetailsByTerritory.resource_group:type_name -> ddex.ern.v383.ResourceGroup
+	111, // 193: ddex.ern.v383.ReleaseDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	137, // 194: ddex.ern.v383.ReleaseDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
+	69,  // 195: ddex.ern.v383.ReleaseDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	101, // 196: ddex.ern.v383.ReleaseDetailsByTerritory.release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 197: ddex.ern.v383.ReleaseDetailsByTerritory.original_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 198: ddex.ern.v383.ReleaseDetailsByTerritory.original_digital_release_date:type_name -> ddex.ern.v383.EventDate
+	97,  // 199: ddex.ern.v383.ReleaseDetailsByTerritory.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 200: ddex.ern.v383.ReleaseDetailsByTerritory.file:type_name -> ddex.ern.v383.File
+	120, // 201: ddex.ern.v383.ReleaseDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 202: ddex.ern.v383.ReleaseDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
+	72,  // 203: ddex.ern.v383.ReleaseDetailsByTerritory.character:type_name -> ddex.ern.v383.Character
+	62,  // 204: ddex.ern.v383.ReleaseDetailsByTerritory.display_conductor:type_name -> ddex.ern.v383.Artist
 	30,  // 205: ddex.ern.v383.ReleaseList.release:type_name -> ddex.ern.v383.Release
 	199, // 206: ddex.ern.v383.ResourceGroup.title:type_name -> ddex.ern.v383.Title
 	62,  // 207: ddex.ern.v383.ResourceGroup.display_artist:type_name -> ddex.ern.v383.Artist
@@ -21721,20 +22313,20 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	154, // 232: ddex.ern.v383.SheetMusic.reference_title:type_name -> ddex.ern.v383.ReferenceTitle
 	101, // 233: ddex.ern.v383.SheetMusic.creation_date:type_name -> ddex.ern.v383.EventDate
 	38,  // 234: ddex.ern.v383.SheetMusic.sheet_music_details_by_territory:type_name -> ddex.ern.v383.SheetMusicDetailsByTerritory
-	199, // 235: ddex.ern.v383.SheetMusicDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	98,  // 236: ddex.ern.v383.SheetMusicDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 237: ddex.ern.v383.SheetMusicDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	135, // 238: ddex.ern.v383.SheetMusicDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	69,  // 239: ddex.ern.v383.SheetMusicDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	85,  // 240: ddex.ern.v383.SheetMusicDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
-	101, // 241: ddex.ern.v383.SheetMusicDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 242: ddex.ern.v383.SheetMusicDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	110, // 243: ddex.ern.v383.SheetMusicDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	111, // 244: ddex.ern.v383.SheetMusicDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 245: ddex.ern.v383.SheetMusicDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	46,  // 246: ddex.ern.v383.SheetMusicDetailsByTerritory.technical_sheet_music_details:type_name -> ddex.ern.v383.TechnicalSheetMusicDetails
-	94,  // 247: ddex.ern.v383.SheetMusicDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 248: ddex.ern.v383.SheetMusicDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 235: ddex.ern.v383.SheetMusicDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 236: ddex.ern.v383.SheetMusicDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 237: ddex.ern.v383.SheetMusicDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	98,  // 238: ddex.ern.v383.SheetMusicDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 239: ddex.ern.v383.SheetMusicDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	135, // 240: ddex.ern.v383.SheetMusicDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	69,  // 241: ddex.ern.v383.SheetMusicDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	85,  // 242: ddex.ern.v383.SheetMusicDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
+	101, // 243: ddex.ern.v383.SheetMusicDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 244: ddex.ern.v383.SheetMusicDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	110, // 245: ddex.ern.v383.SheetMusicDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	111, // 246: ddex.ern.v383.SheetMusicDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 247: ddex.ern.v383.SheetMusicDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	46,  // 248: ddex.ern.v383.SheetMusicDetailsByTerritory.technical_sheet_music_details:type_name -> ddex.ern.v383.TechnicalSheetMusicDetails
 	186, // 249: ddex.ern.v383.Software.software_type:type_name -> ddex.ern.v383.SoftwareType
 	172, // 250: ddex.ern.v383.Software.software_id:type_name -> ddex.ern.v383.ResourceProprietaryId
 	133, // 251: ddex.ern.v383.Software.indirect_software_id:type_name -> ddex.ern.v383.MusicalWorkId
@@ -21743,23 +22335,23 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	199, // 254: ddex.ern.v383.Software.title:type_name -> ddex.ern.v383.Title
 	101, // 255: ddex.ern.v383.Software.creation_date:type_name -> ddex.ern.v383.EventDate
 	40,  // 256: ddex.ern.v383.Software.software_details_by_territory:type_name -> ddex.ern.v383.SoftwareDetailsByTerritory
-	199, // 257: ddex.ern.v383.SoftwareDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	98,  // 258: ddex.ern.v383.SoftwareDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 259: ddex.ern.v383.SoftwareDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	135, // 260: ddex.ern.v383.SoftwareDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	137, // 261: ddex.ern.v383.SoftwareDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
-	69,  // 262: ddex.ern.v383.SoftwareDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	85,  // 263: ddex.ern.v383.SoftwareDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
-	101, // 264: ddex.ern.v383.SoftwareDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 265: ddex.ern.v383.SoftwareDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	110, // 266: ddex.ern.v383.SoftwareDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	120, // 267: ddex.ern.v383.SoftwareDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 268: ddex.ern.v383.SoftwareDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	111, // 269: ddex.ern.v383.SoftwareDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 270: ddex.ern.v383.SoftwareDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	47,  // 271: ddex.ern.v383.SoftwareDetailsByTerritory.technical_software_details:type_name -> ddex.ern.v383.TechnicalSoftwareDetails
-	94,  // 272: ddex.ern.v383.SoftwareDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 273: ddex.ern.v383.SoftwareDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 257: ddex.ern.v383.SoftwareDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 258: ddex.ern.v383.SoftwareDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 259: ddex.ern.v383.SoftwareDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	98,  // 260: ddex.ern.v383.SoftwareDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 261: ddex.ern.v383.SoftwareDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	135, // 262: ddex.ern.v383.SoftwareDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	137, // 263: ddex.ern.v383.SoftwareDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
+	69,  // 264: ddex.ern.v383.SoftwareDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	85,  // 265: ddex.ern.v383.SoftwareDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
+	101, // 266: ddex.ern.v383.SoftwareDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 267: ddex.ern.v383.SoftwareDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	110, // 268: ddex.ern.v383.SoftwareDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	120, // 269: ddex.ern.v383.SoftwareDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 270: ddex.ern.v383.SoftwareDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
+	111, // 271: ddex.ern.v383.SoftwareDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 272: ddex.ern.v383.SoftwareDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	47,  // 273: ddex.ern.v383.SoftwareDetailsByTerritory.technical_software_details:type_name -> ddex.ern.v383.TechnicalSoftwareDetails
 	191, // 274: ddex.ern.v383.SoundRecording.sound_recording_type:type_name -> ddex.ern.v383.SoundRecordingType
 	190, // 275: ddex.ern.v383.SoundRecording.sound_recording_id:type_name -> ddex.ern.v383.SoundRecordingId
 	133, // 276: ddex.ern.v383.SoundRecording.indirect_sound_recording_id:type_name -> ddex.ern.v383.MusicalWorkId
@@ -21774,31 +22366,31 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	101, // 285: ddex.ern.v383.SoundRecording.remastered_date:type_name -> ddex.ern.v383.EventDate
 	42,  // 286: ddex.ern.v383.SoundRecording.sound_recording_details_by_territory:type_name -> ddex.ern.v383.SoundRecordingDetailsByTerritory
 	61,  // 287: ddex.ern.v383.SoundRecording.territory_of_commissioning:type_name -> ddex.ern.v383.AllTerritoryCode
-	199, // 288: ddex.ern.v383.SoundRecordingDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	62,  // 289: ddex.ern.v383.SoundRecordingDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
-	62,  // 290: ddex.ern.v383.SoundRecordingDetailsByTerritory.display_conductor:type_name -> ddex.ern.v383.Artist
-	98,  // 291: ddex.ern.v383.SoundRecordingDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 292: ddex.ern.v383.SoundRecordingDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	176, // 293: ddex.ern.v383.SoundRecordingDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
-	135, // 294: ddex.ern.v383.SoundRecordingDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	121, // 295: ddex.ern.v383.SoundRecordingDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
-	54,  // 296: ddex.ern.v383.SoundRecordingDetailsByTerritory.rights_controller:type_name -> ddex.ern.v383.TypedRightsController
-	101, // 297: ddex.ern.v383.SoundRecordingDetailsByTerritory.remastered_date:type_name -> ddex.ern.v383.EventDate
-	101, // 298: ddex.ern.v383.SoundRecordingDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 299: ddex.ern.v383.SoundRecordingDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	137, // 300: ddex.ern.v383.SoundRecordingDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
-	85,  // 301: ddex.ern.v383.SoundRecordingDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
-	115, // 302: ddex.ern.v383.SoundRecordingDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern.v383.HostSoundCarrier
-	79,  // 303: ddex.ern.v383.SoundRecordingDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
-	111, // 304: ddex.ern.v383.SoundRecordingDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 305: ddex.ern.v383.SoundRecordingDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	67,  // 306: ddex.ern.v383.SoundRecordingDetailsByTerritory.av_rating:type_name -> ddex.ern.v383.AvRating
-	48,  // 307: ddex.ern.v383.SoundRecordingDetailsByTerritory.technical_sound_recording_details:type_name -> ddex.ern.v383.TechnicalSoundRecordingDetails
-	110, // 308: ddex.ern.v383.SoundRecordingDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	120, // 309: ddex.ern.v383.SoundRecordingDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 310: ddex.ern.v383.SoundRecordingDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	94,  // 311: ddex.ern.v383.SoundRecordingDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 312: ddex.ern.v383.SoundRecordingDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 288: ddex.ern.v383.SoundRecordingDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 289: ddex.ern.v383.SoundRecordingDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 290: ddex.ern.v383.SoundRecordingDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	62,  // 291: ddex.ern.v383.SoundRecordingDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
+	62,  // 292: ddex.ern.v383.SoundRecordingDetailsByTerritory.display_conductor:type_name -> ddex.ern.v383.Artist
+	98,  // 293: ddex.ern.v383.SoundRecordingDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 294: ddex.ern.v383.SoundRecordingDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	176, // 295: ddex.ern.v383.SoundRecordingDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
+	135, // 296: ddex.ern.v383.SoundRecordingDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	121, // 297: ddex.ern.v383.SoundRecordingDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
+	54,  // 298: ddex.ern.v383.SoundRecordingDetailsByTerritory.rights_controller:type_name -> ddex.ern.v383.TypedRightsController
+	101, // 299: ddex.ern.v383.SoundRecordingDetailsByTerritory.remastered_date:type_name -> ddex.ern.v383.EventDate
+	101, // 300: ddex.ern.v383.SoundRecordingDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 301: ddex.ern.v383.SoundRecordingDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	137, // 302: ddex.ern.v383.SoundRecordingDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
+	85,  // 303: ddex.ern.v383.SoundRecordingDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
+	115, // 304: ddex.ern.v383.SoundRecordingDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern.v383.HostSoundCarrier
+	79,  // 305: ddex.ern.v383.SoundRecordingDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
+	111, // 306: ddex.ern.v383.SoundRecordingDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 307: ddex.ern.v383.SoundRecordingDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	67,  // 308: ddex.ern.v383.SoundRecordingDetailsByTerritory.av_rating:type_name -> ddex.ern.v383.AvRating
+	48,  // 309: ddex.ern.v383.SoundRecordingDetailsByTerritory.technical_sound_recording_details:type_name -> ddex.ern.v383.TechnicalSoundRecordingDetails
+	110, // 310: ddex.ern.v383.SoundRecordingDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	120, // 311: ddex.ern.v383.SoundRecordingDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 312: ddex.ern.v383.SoundRecordingDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
 	97,  // 313: ddex.ern.v383.SoundRecordingPreviewDetails.part_type:type_name -> ddex.ern.v383.Description
 	100, // 314: ddex.ern.v383.TechnicalImageDetails.drm_platform_type:type_name -> ddex.ern.v383.DrmPlatformType
 	84,  // 315: ddex.ern.v383.TechnicalImageDetails.container_format:type_name -> ddex.ern.v383.ContainerFormat
@@ -21809,33 +22401,33 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	26,  // 320: ddex.ern.v383.TechnicalImageDetails.preview_details:type_name -> ddex.ern.v383.PreviewDetails
 	110, // 321: ddex.ern.v383.TechnicalImageDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 322: ddex.ern.v383.TechnicalImageDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	20,  // 323: ddex.ern.v383.TechnicalImageDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 324: ddex.ern.v383.TechnicalImageDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 325: ddex.ern.v383.TechnicalImageDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 323: ddex.ern.v383.TechnicalImageDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 324: ddex.ern.v383.TechnicalImageDetails.file:type_name -> ddex.ern.v383.File
+	20,  // 325: ddex.ern.v383.TechnicalImageDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	43,  // 326: ddex.ern.v383.TechnicalMidiDetails.preview_details:type_name -> ddex.ern.v383.SoundRecordingPreviewDetails
 	110, // 327: ddex.ern.v383.TechnicalMidiDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 328: ddex.ern.v383.TechnicalMidiDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	187, // 329: ddex.ern.v383.TechnicalMidiDetails.sound_processor_type:type_name -> ddex.ern.v383.SoundProcessorType
-	20,  // 330: ddex.ern.v383.TechnicalMidiDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 331: ddex.ern.v383.TechnicalMidiDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 332: ddex.ern.v383.TechnicalMidiDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 329: ddex.ern.v383.TechnicalMidiDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 330: ddex.ern.v383.TechnicalMidiDetails.file:type_name -> ddex.ern.v383.File
+	187, // 331: ddex.ern.v383.TechnicalMidiDetails.sound_processor_type:type_name -> ddex.ern.v383.SoundProcessorType
+	20,  // 332: ddex.ern.v383.TechnicalMidiDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	100, // 333: ddex.ern.v383.TechnicalSheetMusicDetails.drm_platform_type:type_name -> ddex.ern.v383.DrmPlatformType
 	84,  // 334: ddex.ern.v383.TechnicalSheetMusicDetails.container_format:type_name -> ddex.ern.v383.ContainerFormat
 	182, // 335: ddex.ern.v383.TechnicalSheetMusicDetails.sheet_music_codec_type:type_name -> ddex.ern.v383.SheetMusicCodecType
 	26,  // 336: ddex.ern.v383.TechnicalSheetMusicDetails.preview_details:type_name -> ddex.ern.v383.PreviewDetails
 	110, // 337: ddex.ern.v383.TechnicalSheetMusicDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 338: ddex.ern.v383.TechnicalSheetMusicDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	20,  // 339: ddex.ern.v383.TechnicalSheetMusicDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 340: ddex.ern.v383.TechnicalSheetMusicDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 341: ddex.ern.v383.TechnicalSheetMusicDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 339: ddex.ern.v383.TechnicalSheetMusicDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 340: ddex.ern.v383.TechnicalSheetMusicDetails.file:type_name -> ddex.ern.v383.File
+	20,  // 341: ddex.ern.v383.TechnicalSheetMusicDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	100, // 342: ddex.ern.v383.TechnicalSoftwareDetails.drm_platform_type:type_name -> ddex.ern.v383.DrmPlatformType
 	136, // 343: ddex.ern.v383.TechnicalSoftwareDetails.operating_system_type:type_name -> ddex.ern.v383.OperatingSystemType
 	26,  // 344: ddex.ern.v383.TechnicalSoftwareDetails.preview_details:type_name -> ddex.ern.v383.PreviewDetails
 	110, // 345: ddex.ern.v383.TechnicalSoftwareDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 346: ddex.ern.v383.TechnicalSoftwareDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	20,  // 347: ddex.ern.v383.TechnicalSoftwareDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 348: ddex.ern.v383.TechnicalSoftwareDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 349: ddex.ern.v383.TechnicalSoftwareDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 347: ddex.ern.v383.TechnicalSoftwareDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 348: ddex.ern.v383.TechnicalSoftwareDetails.file:type_name -> ddex.ern.v383.File
+	20,  // 349: ddex.ern.v383.TechnicalSoftwareDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	100, // 350: ddex.ern.v383.TechnicalSoundRecordingDetails.drm_platform_type:type_name -> ddex.ern.v383.DrmPlatformType
 	84,  // 351: ddex.ern.v383.TechnicalSoundRecordingDetails.container_format:type_name -> ddex.ern.v383.ContainerFormat
 	66,  // 352: ddex.ern.v383.TechnicalSoundRecordingDetails.audio_codec_type:type_name -> ddex.ern.v383.AudioCodecType
@@ -21844,25 +22436,25 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	43,  // 355: ddex.ern.v383.TechnicalSoundRecordingDetails.preview_details:type_name -> ddex.ern.v383.SoundRecordingPreviewDetails
 	110, // 356: ddex.ern.v383.TechnicalSoundRecordingDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 357: ddex.ern.v383.TechnicalSoundRecordingDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	20,  // 358: ddex.ern.v383.TechnicalSoundRecordingDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 359: ddex.ern.v383.TechnicalSoundRecordingDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 360: ddex.ern.v383.TechnicalSoundRecordingDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 358: ddex.ern.v383.TechnicalSoundRecordingDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 359: ddex.ern.v383.TechnicalSoundRecordingDetails.file:type_name -> ddex.ern.v383.File
+	20,  // 360: ddex.ern.v383.TechnicalSoundRecordingDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	100, // 361: ddex.ern.v383.TechnicalTextDetails.drm_platform_type:type_name -> ddex.ern.v383.DrmPlatformType
 	84,  // 362: ddex.ern.v383.TechnicalTextDetails.container_format:type_name -> ddex.ern.v383.ContainerFormat
 	196, // 363: ddex.ern.v383.TechnicalTextDetails.text_codec_type:type_name -> ddex.ern.v383.TextCodecType
 	26,  // 364: ddex.ern.v383.TechnicalTextDetails.preview_details:type_name -> ddex.ern.v383.PreviewDetails
 	110, // 365: ddex.ern.v383.TechnicalTextDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 366: ddex.ern.v383.TechnicalTextDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	20,  // 367: ddex.ern.v383.TechnicalTextDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 368: ddex.ern.v383.TechnicalTextDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 369: ddex.ern.v383.TechnicalTextDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 367: ddex.ern.v383.TechnicalTextDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 368: ddex.ern.v383.TechnicalTextDetails.file:type_name -> ddex.ern.v383.File
+	20,  // 369: ddex.ern.v383.TechnicalTextDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	205, // 370: ddex.ern.v383.TechnicalUserDefinedResourceDetails.user_defined_value:type_name -> ddex.ern.v383.UserDefinedValue
 	26,  // 371: ddex.ern.v383.TechnicalUserDefinedResourceDetails.preview_details:type_name -> ddex.ern.v383.PreviewDetails
 	110, // 372: ddex.ern.v383.TechnicalUserDefinedResourceDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 373: ddex.ern.v383.TechnicalUserDefinedResourceDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	20,  // 374: ddex.ern.v383.TechnicalUserDefinedResourceDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 375: ddex.ern.v383.TechnicalUserDefinedResourceDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 376: ddex.ern.v383.TechnicalUserDefinedResourceDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 374: ddex.ern.v383.TechnicalUserDefinedResourceDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 375: ddex.ern.v383.TechnicalUserDefinedResourceDetails.file:type_name -> ddex.ern.v383.File
+	20,  // 376: ddex.ern.v383.TechnicalUserDefinedResourceDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	100, // 377: ddex.ern.v383.TechnicalVideoDetails.drm_platform_type:type_name -> ddex.ern.v383.DrmPlatformType
 	68,  // 378: ddex.ern.v383.TechnicalVideoDetails.overall_bit_rate:type_name -> ddex.ern.v383.BitRate
 	84,  // 379: ddex.ern.v383.TechnicalVideoDetails.container_format:type_name -> ddex.ern.v383.ContainerFormat
@@ -21878,9 +22470,9 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	43,  // 389: ddex.ern.v383.TechnicalVideoDetails.preview_details:type_name -> ddex.ern.v383.SoundRecordingPreviewDetails
 	110, // 390: ddex.ern.v383.TechnicalVideoDetails.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
 	110, // 391: ddex.ern.v383.TechnicalVideoDetails.consumer_fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	20,  // 392: ddex.ern.v383.TechnicalVideoDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
-	97,  // 393: ddex.ern.v383.TechnicalVideoDetails.file_availability_description:type_name -> ddex.ern.v383.Description
-	107, // 394: ddex.ern.v383.TechnicalVideoDetails.file:type_name -> ddex.ern.v383.File
+	97,  // 392: ddex.ern.v383.TechnicalVideoDetails.file_availability_description:type_name -> ddex.ern.v383.Description
+	107, // 393: ddex.ern.v383.TechnicalVideoDetails.file:type_name -> ddex.ern.v383.File
+	20,  // 394: ddex.ern.v383.TechnicalVideoDetails.fingerprint:type_name -> ddex.ern.v383.Fingerprint
 	198, // 395: ddex.ern.v383.Text.text_type:type_name -> ddex.ern.v383.TextType
 	197, // 396: ddex.ern.v383.Text.text_id:type_name -> ddex.ern.v383.TextId
 	133, // 397: ddex.ern.v383.Text.indirect_text_id:type_name -> ddex.ern.v383.MusicalWorkId
@@ -21889,26 +22481,26 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	199, // 400: ddex.ern.v383.Text.title:type_name -> ddex.ern.v383.Title
 	101, // 401: ddex.ern.v383.Text.creation_date:type_name -> ddex.ern.v383.EventDate
 	53,  // 402: ddex.ern.v383.Text.text_details_by_territory:type_name -> ddex.ern.v383.TextDetailsByTerritory
-	199, // 403: ddex.ern.v383.TextDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	98,  // 404: ddex.ern.v383.TextDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 405: ddex.ern.v383.TextDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	135, // 406: ddex.ern.v383.TextDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	69,  // 407: ddex.ern.v383.TextDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	85,  // 408: ddex.ern.v383.TextDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
-	101, // 409: ddex.ern.v383.TextDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 410: ddex.ern.v383.TextDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	110, // 411: ddex.ern.v383.TextDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	120, // 412: ddex.ern.v383.TextDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 413: ddex.ern.v383.TextDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	111, // 414: ddex.ern.v383.TextDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 415: ddex.ern.v383.TextDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	49,  // 416: ddex.ern.v383.TextDetailsByTerritory.technical_text_details:type_name -> ddex.ern.v383.TechnicalTextDetails
-	94,  // 417: ddex.ern.v383.TextDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 418: ddex.ern.v383.TextDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	61,  // 419: ddex.ern.v383.TypedRightsController.territory_of_registration:type_name -> ddex.ern.v383.AllTerritoryCode
-	140, // 420: ddex.ern.v383.TypedRightsController.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 421: ddex.ern.v383.TypedRightsController.party_name:type_name -> ddex.ern.v383.PartyName
-	142, // 422: ddex.ern.v383.TypedRightsController.right_share_percentage:type_name -> ddex.ern.v383.Percentage
+	94,  // 403: ddex.ern.v383.TextDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 404: ddex.ern.v383.TextDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 405: ddex.ern.v383.TextDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	98,  // 406: ddex.ern.v383.TextDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 407: ddex.ern.v383.TextDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	135, // 408: ddex.ern.v383.TextDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	69,  // 409: ddex.ern.v383.TextDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	85,  // 410: ddex.ern.v383.TextDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
+	101, // 411: ddex.ern.v383.TextDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 412: ddex.ern.v383.TextDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	110, // 413: ddex.ern.v383.TextDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	120, // 414: ddex.ern.v383.TextDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 415: ddex.ern.v383.TextDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
+	111, // 416: ddex.ern.v383.TextDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 417: ddex.ern.v383.TextDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	49,  // 418: ddex.ern.v383.TextDetailsByTerritory.technical_text_details:type_name -> ddex.ern.v383.TechnicalTextDetails
+	140, // 419: ddex.ern.v383.TypedRightsController.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 420: ddex.ern.v383.TypedRightsController.party_name:type_name -> ddex.ern.v383.PartyName
+	142, // 421: ddex.ern.v383.TypedRightsController.right_share_percentage:type_name -> ddex.ern.v383.Percentage
+	61,  // 422: ddex.ern.v383.TypedRightsController.territory_of_registration:type_name -> ddex.ern.v383.AllTerritoryCode
 	204, // 423: ddex.ern.v383.UserDefinedResource.user_defined_resource_type:type_name -> ddex.ern.v383.UserDefinedResourceType
 	172, // 424: ddex.ern.v383.UserDefinedResource.user_defined_resource_id:type_name -> ddex.ern.v383.ResourceProprietaryId
 	133, // 425: ddex.ern.v383.UserDefinedResource.indirect_user_defined_resource_id:type_name -> ddex.ern.v383.MusicalWorkId
@@ -21918,83 +22510,83 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	205, // 429: ddex.ern.v383.UserDefinedResource.user_defined_value:type_name -> ddex.ern.v383.UserDefinedValue
 	101, // 430: ddex.ern.v383.UserDefinedResource.creation_date:type_name -> ddex.ern.v383.EventDate
 	56,  // 431: ddex.ern.v383.UserDefinedResource.user_defined_resource_details_by_territory:type_name -> ddex.ern.v383.UserDefinedResourceDetailsByTerritory
-	199, // 432: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	98,  // 433: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 434: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	135, // 435: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	205, // 436: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.user_defined_value:type_name -> ddex.ern.v383.UserDefinedValue
-	137, // 437: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
-	69,  // 438: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	101, // 439: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 440: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	110, // 441: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	120, // 442: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 443: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	111, // 444: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 445: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	50,  // 446: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.technical_user_defined_resource_details:type_name -> ddex.ern.v383.TechnicalUserDefinedResourceDetails
-	94,  // 447: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 448: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 432: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 433: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 434: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	98,  // 435: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 436: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	135, // 437: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	205, // 438: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.user_defined_value:type_name -> ddex.ern.v383.UserDefinedValue
+	137, // 439: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
+	69,  // 440: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	101, // 441: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 442: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	110, // 443: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	120, // 444: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 445: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
+	111, // 446: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 447: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	50,  // 448: ddex.ern.v383.UserDefinedResourceDetailsByTerritory.technical_user_defined_resource_details:type_name -> ddex.ern.v383.TechnicalUserDefinedResourceDetails
 	210, // 449: ddex.ern.v383.Video.video_type:type_name -> ddex.ern.v383.VideoType
 	209, // 450: ddex.ern.v383.Video.video_id:type_name -> ddex.ern.v383.VideoId
 	133, // 451: ddex.ern.v383.Video.indirect_video_id:type_name -> ddex.ern.v383.MusicalWorkId
-	154, // 452: ddex.ern.v383.Video.reference_title:type_name -> ddex.ern.v383.ReferenceTitle
-	199, // 453: ddex.ern.v383.Video.title:type_name -> ddex.ern.v383.Title
-	97,  // 454: ddex.ern.v383.Video.instrumentation_description:type_name -> ddex.ern.v383.Description
-	176, // 455: ddex.ern.v383.Video.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
-	189, // 456: ddex.ern.v383.Video.video_collection_reference_list:type_name -> ddex.ern.v383.SoundRecordingCollectionReferenceList
-	170, // 457: ddex.ern.v383.Video.resource_musical_work_reference_list:type_name -> ddex.ern.v383.ResourceMusicalWorkReferenceList
-	165, // 458: ddex.ern.v383.Video.resource_contained_resource_reference_list:type_name -> ddex.ern.v383.ResourceContainedResourceReferenceList
-	101, // 459: ddex.ern.v383.Video.creation_date:type_name -> ddex.ern.v383.EventDate
-	101, // 460: ddex.ern.v383.Video.mastered_date:type_name -> ddex.ern.v383.EventDate
-	101, // 461: ddex.ern.v383.Video.remastered_date:type_name -> ddex.ern.v383.EventDate
-	58,  // 462: ddex.ern.v383.Video.video_details_by_territory:type_name -> ddex.ern.v383.VideoDetailsByTerritory
-	61,  // 463: ddex.ern.v383.Video.territory_of_commissioning:type_name -> ddex.ern.v383.AllTerritoryCode
-	208, // 464: ddex.ern.v383.Video.video_cue_sheet_reference:type_name -> ddex.ern.v383.VideoCueSheetReference
-	152, // 465: ddex.ern.v383.Video.reason_for_cue_sheet_absence:type_name -> ddex.ern.v383.Reason
-	199, // 466: ddex.ern.v383.VideoDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
-	62,  // 467: ddex.ern.v383.VideoDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
-	62,  // 468: ddex.ern.v383.VideoDetailsByTerritory.display_conductor:type_name -> ddex.ern.v383.Artist
-	98,  // 469: ddex.ern.v383.VideoDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	119, // 470: ddex.ern.v383.VideoDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
-	176, // 471: ddex.ern.v383.VideoDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
-	135, // 472: ddex.ern.v383.VideoDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	121, // 473: ddex.ern.v383.VideoDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
-	54,  // 474: ddex.ern.v383.VideoDetailsByTerritory.rights_controller:type_name -> ddex.ern.v383.TypedRightsController
-	101, // 475: ddex.ern.v383.VideoDetailsByTerritory.remastered_date:type_name -> ddex.ern.v383.EventDate
-	101, // 476: ddex.ern.v383.VideoDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
-	101, // 477: ddex.ern.v383.VideoDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
-	137, // 478: ddex.ern.v383.VideoDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
-	85,  // 479: ddex.ern.v383.VideoDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
-	115, // 480: ddex.ern.v383.VideoDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern.v383.HostSoundCarrier
-	79,  // 481: ddex.ern.v383.VideoDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
-	111, // 482: ddex.ern.v383.VideoDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
-	138, // 483: ddex.ern.v383.VideoDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
-	67,  // 484: ddex.ern.v383.VideoDetailsByTerritory.av_rating:type_name -> ddex.ern.v383.AvRating
-	110, // 485: ddex.ern.v383.VideoDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
-	120, // 486: ddex.ern.v383.VideoDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
-	193, // 487: ddex.ern.v383.VideoDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
-	69,  // 488: ddex.ern.v383.VideoDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
-	51,  // 489: ddex.ern.v383.VideoDetailsByTerritory.technical_video_details:type_name -> ddex.ern.v383.TechnicalVideoDetails
-	72,  // 490: ddex.ern.v383.VideoDetailsByTerritory.character:type_name -> ddex.ern.v383.Character
-	94,  // 491: ddex.ern.v383.VideoDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 492: ddex.ern.v383.VideoDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	208, // 452: ddex.ern.v383.Video.video_cue_sheet_reference:type_name -> ddex.ern.v383.VideoCueSheetReference
+	152, // 453: ddex.ern.v383.Video.reason_for_cue_sheet_absence:type_name -> ddex.ern.v383.Reason
+	154, // 454: ddex.ern.v383.Video.reference_title:type_name -> ddex.ern.v383.ReferenceTitle
+	199, // 455: ddex.ern.v383.Video.title:type_name -> ddex.ern.v383.Title
+	97,  // 456: ddex.ern.v383.Video.instrumentation_description:type_name -> ddex.ern.v383.Description
+	176, // 457: ddex.ern.v383.Video.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
+	189, // 458: ddex.ern.v383.Video.video_collection_reference_list:type_name -> ddex.ern.v383.SoundRecordingCollectionReferenceList
+	170, // 459: ddex.ern.v383.Video.resource_musical_work_reference_list:type_name -> ddex.ern.v383.ResourceMusicalWorkReferenceList
+	165, // 460: ddex.ern.v383.Video.resource_contained_resource_reference_list:type_name -> ddex.ern.v383.ResourceContainedResourceReferenceList
+	101, // 461: ddex.ern.v383.Video.creation_date:type_name -> ddex.ern.v383.EventDate
+	101, // 462: ddex.ern.v383.Video.mastered_date:type_name -> ddex.ern.v383.EventDate
+	101, // 463: ddex.ern.v383.Video.remastered_date:type_name -> ddex.ern.v383.EventDate
+	58,  // 464: ddex.ern.v383.Video.video_details_by_territory:type_name -> ddex.ern.v383.VideoDetailsByTerritory
+	61,  // 465: ddex.ern.v383.Video.territory_of_commissioning:type_name -> ddex.ern.v383.AllTerritoryCode
+	94,  // 466: ddex.ern.v383.VideoDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 467: ddex.ern.v383.VideoDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	199, // 468: ddex.ern.v383.VideoDetailsByTerritory.title:type_name -> ddex.ern.v383.Title
+	62,  // 469: ddex.ern.v383.VideoDetailsByTerritory.display_artist:type_name -> ddex.ern.v383.Artist
+	62,  // 470: ddex.ern.v383.VideoDetailsByTerritory.display_conductor:type_name -> ddex.ern.v383.Artist
+	98,  // 471: ddex.ern.v383.VideoDetailsByTerritory.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
+	119, // 472: ddex.ern.v383.VideoDetailsByTerritory.indirect_resource_contributor:type_name -> ddex.ern.v383.IndirectResourceContributor
+	176, // 473: ddex.ern.v383.VideoDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
+	135, // 474: ddex.ern.v383.VideoDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	121, // 475: ddex.ern.v383.VideoDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
+	54,  // 476: ddex.ern.v383.VideoDetailsByTerritory.rights_controller:type_name -> ddex.ern.v383.TypedRightsController
+	101, // 477: ddex.ern.v383.VideoDetailsByTerritory.remastered_date:type_name -> ddex.ern.v383.EventDate
+	101, // 478: ddex.ern.v383.VideoDetailsByTerritory.resource_release_date:type_name -> ddex.ern.v383.EventDate
+	101, // 479: ddex.ern.v383.VideoDetailsByTerritory.original_resource_release_date:type_name -> ddex.ern.v383.EventDate
+	137, // 480: ddex.ern.v383.VideoDetailsByTerritory.p_line:type_name -> ddex.ern.v383.PLine
+	85,  // 481: ddex.ern.v383.VideoDetailsByTerritory.courtesy_line:type_name -> ddex.ern.v383.CourtesyLine
+	115, // 482: ddex.ern.v383.VideoDetailsByTerritory.host_sound_carrier:type_name -> ddex.ern.v383.HostSoundCarrier
+	79,  // 483: ddex.ern.v383.VideoDetailsByTerritory.marketing_comment:type_name -> ddex.ern.v383.Comment
+	111, // 484: ddex.ern.v383.VideoDetailsByTerritory.genre:type_name -> ddex.ern.v383.Genre
+	138, // 485: ddex.ern.v383.VideoDetailsByTerritory.parental_warning_type:type_name -> ddex.ern.v383.ParentalWarningType
+	67,  // 486: ddex.ern.v383.VideoDetailsByTerritory.av_rating:type_name -> ddex.ern.v383.AvRating
+	110, // 487: ddex.ern.v383.VideoDetailsByTerritory.fulfillment_date:type_name -> ddex.ern.v383.FulfillmentDate
+	120, // 488: ddex.ern.v383.VideoDetailsByTerritory.keywords:type_name -> ddex.ern.v383.Keywords
+	193, // 489: ddex.ern.v383.VideoDetailsByTerritory.synopsis:type_name -> ddex.ern.v383.Synopsis
+	69,  // 490: ddex.ern.v383.VideoDetailsByTerritory.c_line:type_name -> ddex.ern.v383.CLine
+	51,  // 491: ddex.ern.v383.VideoDetailsByTerritory.technical_video_details:type_name -> ddex.ern.v383.TechnicalVideoDetails
+	72,  // 492: ddex.ern.v383.VideoDetailsByTerritory.character:type_name -> ddex.ern.v383.Character
 	81,  // 493: ddex.ern.v383.WebPolicy.condition:type_name -> ddex.ern.v383.Condition
 	140, // 494: ddex.ern.v383.AdministratingRecordCompany.party_id:type_name -> ddex.ern.v383.PartyId
 	141, // 495: ddex.ern.v383.AdministratingRecordCompany.party_name:type_name -> ddex.ern.v383.PartyName
-	64,  // 496: ddex.ern.v383.Artist.artist_role:type_name -> ddex.ern.v383.ArtistRole
-	61,  // 497: ddex.ern.v383.Artist.nationality:type_name -> ddex.ern.v383.AllTerritoryCode
-	140, // 498: ddex.ern.v383.Artist.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 499: ddex.ern.v383.Artist.party_name:type_name -> ddex.ern.v383.PartyName
+	140, // 496: ddex.ern.v383.Artist.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 497: ddex.ern.v383.Artist.party_name:type_name -> ddex.ern.v383.PartyName
+	64,  // 498: ddex.ern.v383.Artist.artist_role:type_name -> ddex.ern.v383.ArtistRole
+	61,  // 499: ddex.ern.v383.Artist.nationality:type_name -> ddex.ern.v383.AllTerritoryCode
 	203, // 500: ddex.ern.v383.ArtistDelegatedUsageRights.use_type:type_name -> ddex.ern.v383.UseType
 	206, // 501: ddex.ern.v383.ArtistDelegatedUsageRights.user_interface_type:type_name -> ddex.ern.v383.UserInterfaceType
 	144, // 502: ddex.ern.v383.ArtistDelegatedUsageRights.period_of_rights_delegation:type_name -> ddex.ern.v383.Period
 	61,  // 503: ddex.ern.v383.ArtistDelegatedUsageRights.territory_of_rights_delegation:type_name -> ddex.ern.v383.AllTerritoryCode
 	151, // 504: ddex.ern.v383.AvRating.rating_agency:type_name -> ddex.ern.v383.RatingAgency
 	97,  // 505: ddex.ern.v383.AvRating.rating_scheme_description:type_name -> ddex.ern.v383.Description
-	98,  // 506: ddex.ern.v383.Character.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
-	140, // 507: ddex.ern.v383.Character.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 508: ddex.ern.v383.Character.party_name:type_name -> ddex.ern.v383.PartyName
+	140, // 506: ddex.ern.v383.Character.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 507: ddex.ern.v383.Character.party_name:type_name -> ddex.ern.v383.PartyName
+	98,  // 508: ddex.ern.v383.Character.resource_contributor:type_name -> ddex.ern.v383.DetailedResourceContributor
 	73,  // 509: ddex.ern.v383.CollectionCollectionReferenceList.collection_collection_reference:type_name -> ddex.ern.v383.CollectionCollectionReference
 	116, // 510: ddex.ern.v383.CollectionId.i_c_p_n:type_name -> ddex.ern.v383.ICPN
 	71,  // 511: ddex.ern.v383.CollectionId.catalog_number:type_name -> ddex.ern.v383.CatalogNumber
@@ -22002,26 +22594,26 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	77,  // 513: ddex.ern.v383.CollectionWorkReferenceList.collection_work_reference:type_name -> ddex.ern.v383.CollectionWorkReference
 	71,  // 514: ddex.ern.v383.CreationId.catalog_number:type_name -> ddex.ern.v383.CatalogNumber
 	149, // 515: ddex.ern.v383.CreationId.proprietary_id:type_name -> ddex.ern.v383.ProprietaryId
-	135, // 516: ddex.ern.v383.DSP.trading_name:type_name -> ddex.ern.v383.Name
-	94,  // 517: ddex.ern.v383.DSP.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	140, // 518: ddex.ern.v383.DSP.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 519: ddex.ern.v383.DSP.party_name:type_name -> ddex.ern.v383.PartyName
-	167, // 520: ddex.ern.v383.DetailedResourceContributor.resource_contributor_role:type_name -> ddex.ern.v383.ResourceContributorRole
-	63,  // 521: ddex.ern.v383.DetailedResourceContributor.artist_delegated_usage_rights:type_name -> ddex.ern.v383.ArtistDelegatedUsageRights
-	61,  // 522: ddex.ern.v383.DetailedResourceContributor.nationality:type_name -> ddex.ern.v383.AllTerritoryCode
-	101, // 523: ddex.ern.v383.DetailedResourceContributor.date_and_place_of_birth:type_name -> ddex.ern.v383.EventDate
-	101, // 524: ddex.ern.v383.DetailedResourceContributor.date_and_place_of_death:type_name -> ddex.ern.v383.EventDate
-	64,  // 525: ddex.ern.v383.DetailedResourceContributor.primary_role:type_name -> ddex.ern.v383.ArtistRole
-	143, // 526: ddex.ern.v383.DetailedResourceContributor.performance:type_name -> ddex.ern.v383.Performance
-	112, // 527: ddex.ern.v383.DetailedResourceContributor.governing_agreement_type:type_name -> ddex.ern.v383.GoverningAgreementType
-	83,  // 528: ddex.ern.v383.DetailedResourceContributor.contact_information:type_name -> ddex.ern.v383.ContactId
-	61,  // 529: ddex.ern.v383.DetailedResourceContributor.territory_of_residency:type_name -> ddex.ern.v383.AllTerritoryCode
-	61,  // 530: ddex.ern.v383.DetailedResourceContributor.citizenship:type_name -> ddex.ern.v383.AllTerritoryCode
-	64,  // 531: ddex.ern.v383.DetailedResourceContributor.additional_roles:type_name -> ddex.ern.v383.ArtistRole
-	111, // 532: ddex.ern.v383.DetailedResourceContributor.genre:type_name -> ddex.ern.v383.Genre
-	123, // 533: ddex.ern.v383.DetailedResourceContributor.membership:type_name -> ddex.ern.v383.Membership
-	140, // 534: ddex.ern.v383.DetailedResourceContributor.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 535: ddex.ern.v383.DetailedResourceContributor.party_name:type_name -> ddex.ern.v383.PartyName
+	140, // 516: ddex.ern.v383.DSP.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 517: ddex.ern.v383.DSP.party_name:type_name -> ddex.ern.v383.PartyName
+	135, // 518: ddex.ern.v383.DSP.trading_name:type_name -> ddex.ern.v383.Name
+	94,  // 519: ddex.ern.v383.DSP.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	140, // 520: ddex.ern.v383.DetailedResourceContributor.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 521: ddex.ern.v383.DetailedResourceContributor.party_name:type_name -> ddex.ern.v383.PartyName
+	167, // 522: ddex.ern.v383.DetailedResourceContributor.resource_contributor_role:type_name -> ddex.ern.v383.ResourceContributorRole
+	63,  // 523: ddex.ern.v383.DetailedResourceContributor.artist_delegated_usage_rights:type_name -> ddex.ern.v383.ArtistDelegatedUsageRights
+	61,  // 524: ddex.ern.v383.DetailedResourceContributor.nationality:type_name -> ddex.ern.v383.AllTerritoryCode
+	101, // 525: ddex.ern.v383.DetailedResourceContributor.date_and_place_of_birth:type_name -> ddex.ern.v383.EventDate
+	101, // 526: ddex.ern.v383.DetailedResourceContributor.date_and_place_of_death:type_name -> ddex.ern.v383.EventDate
+	64,  // 527: ddex.ern.v383.DetailedResourceContributor.primary_role:type_name -> ddex.ern.v383.ArtistRole
+	143, // 528: ddex.ern.v383.DetailedResourceContributor.performance:type_name -> ddex.ern.v383.Performance
+	112, // 529: ddex.ern.v383.DetailedResourceContributor.governing_agreement_type:type_name -> ddex.ern.v383.GoverningAgreementType
+	83,  // 530: ddex.ern.v383.DetailedResourceContributor.contact_information:type_name -> ddex.ern.v383.ContactId
+	61,  // 531: ddex.ern.v383.DetailedResourceContributor.territory_of_residency:type_name -> ddex.ern.v383.AllTerritoryCode
+	61,  // 532: ddex.ern.v383.DetailedResourceContributor.citizenship:type_name -> ddex.ern.v383.AllTerritoryCode
+	64,  // 533: ddex.ern.v383.DetailedResourceContributor.additional_roles:type_name -> ddex.ern.v383.ArtistRole
+	111, // 534: ddex.ern.v383.DetailedResourceContributor.genre:type_name -> ddex.ern.v383.Genre
+	123, // 535: ddex.ern.v383.DetailedResourceContributor.membership:type_name -> ddex.ern.v383.Membership
 	173, // 536: ddex.ern.v383.ExtendedResourceGroupContentItem.resource_type:type_name -> ddex.ern.v383.ResourceType
 	160, // 537: ddex.ern.v383.ExtendedResourceGroupContentItem.release_resource_reference:type_name -> ddex.ern.v383.ReleaseResourceReference
 	122, // 538: ddex.ern.v383.ExtendedResourceGroupContentItem.linked_release_resource_reference:type_name -> ddex.ern.v383.LinkedReleaseResourceReference
@@ -22037,10 +22629,10 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	199, // 548: ddex.ern.v383.HostSoundCarrier.title:type_name -> ddex.ern.v383.Title
 	62,  // 549: ddex.ern.v383.HostSoundCarrier.display_artist:type_name -> ddex.ern.v383.Artist
 	60,  // 550: ddex.ern.v383.HostSoundCarrier.administrating_record_company:type_name -> ddex.ern.v383.AdministratingRecordCompany
-	131, // 551: ddex.ern.v383.IndirectResourceContributor.indirect_resource_contributor_role:type_name -> ddex.ern.v383.MusicalWorkContributorRole
-	0,   // 552: ddex.ern.v383.IndirectResourceContributor.nationality:type_name -> ddex.ern.v383.DdexCCurrentTerritoryCode
-	140, // 553: ddex.ern.v383.IndirectResourceContributor.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 554: ddex.ern.v383.IndirectResourceContributor.party_name:type_name -> ddex.ern.v383.PartyName
+	140, // 551: ddex.ern.v383.IndirectResourceContributor.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 552: ddex.ern.v383.IndirectResourceContributor.party_name:type_name -> ddex.ern.v383.PartyName
+	131, // 553: ddex.ern.v383.IndirectResourceContributor.indirect_resource_contributor_role:type_name -> ddex.ern.v383.MusicalWorkContributorRole
+	0,   // 554: ddex.ern.v383.IndirectResourceContributor.nationality:type_name -> ddex.ern.v383.DdexCCurrentTerritoryCode
 	139, // 555: ddex.ern.v383.Membership.organization:type_name -> ddex.ern.v383.PartyDescriptor
 	125, // 556: ddex.ern.v383.MessageAuditTrail.message_audit_trail_event:type_name -> ddex.ern.v383.MessageAuditTrailEvent
 	127, // 557: ddex.ern.v383.MessageAuditTrailEvent.messaging_party_descriptor:type_name -> ddex.ern.v383.MessagingParty
@@ -22059,14 +22651,14 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	134, // 570: ddex.ern.v383.MusicalWork.musical_work_type:type_name -> ddex.ern.v383.MusicalWorkType
 	174, // 571: ddex.ern.v383.MusicalWork.right_share:type_name -> ddex.ern.v383.RightShare
 	132, // 572: ddex.ern.v383.MusicalWork.musical_work_details_by_territory:type_name -> ddex.ern.v383.MusicalWorkDetailsByTerritory
-	131, // 573: ddex.ern.v383.MusicalWorkContributor.musical_work_contributor_role:type_name -> ddex.ern.v383.MusicalWorkContributorRole
-	185, // 574: ddex.ern.v383.MusicalWorkContributor.society_affiliation:type_name -> ddex.ern.v383.SocietyAffiliation
-	140, // 575: ddex.ern.v383.MusicalWorkContributor.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 576: ddex.ern.v383.MusicalWorkContributor.party_name:type_name -> ddex.ern.v383.PartyName
-	130, // 577: ddex.ern.v383.MusicalWorkDetailsByTerritory.musical_work_contributor:type_name -> ddex.ern.v383.MusicalWorkContributor
-	135, // 578: ddex.ern.v383.MusicalWorkDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	94,  // 579: ddex.ern.v383.MusicalWorkDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 580: ddex.ern.v383.MusicalWorkDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	140, // 573: ddex.ern.v383.MusicalWorkContributor.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 574: ddex.ern.v383.MusicalWorkContributor.party_name:type_name -> ddex.ern.v383.PartyName
+	131, // 575: ddex.ern.v383.MusicalWorkContributor.musical_work_contributor_role:type_name -> ddex.ern.v383.MusicalWorkContributorRole
+	185, // 576: ddex.ern.v383.MusicalWorkContributor.society_affiliation:type_name -> ddex.ern.v383.SocietyAffiliation
+	94,  // 577: ddex.ern.v383.MusicalWorkDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 578: ddex.ern.v383.MusicalWorkDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	130, // 579: ddex.ern.v383.MusicalWorkDetailsByTerritory.musical_work_contributor:type_name -> ddex.ern.v383.MusicalWorkContributor
+	135, // 580: ddex.ern.v383.MusicalWorkDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
 	149, // 581: ddex.ern.v383.MusicalWorkId.proprietary_id:type_name -> ddex.ern.v383.ProprietaryId
 	140, // 582: ddex.ern.v383.PartyDescriptor.party_id:type_name -> ddex.ern.v383.PartyId
 	141, // 583: ddex.ern.v383.PartyDescriptor.party_name:type_name -> ddex.ern.v383.PartyName
@@ -22096,32 +22688,32 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	71,  // 607: ddex.ern.v383.ReleaseId.catalog_number:type_name -> ddex.ern.v383.CatalogNumber
 	149, // 608: ddex.ern.v383.ReleaseId.proprietary_id:type_name -> ddex.ern.v383.ProprietaryId
 	160, // 609: ddex.ern.v383.ReleaseResourceReferenceList.release_resource_reference:type_name -> ddex.ern.v383.ReleaseResourceReference
-	135, // 610: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
-	121, // 611: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
-	176, // 612: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
-	94,  // 613: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
-	94,  // 614: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 610: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	94,  // 611: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.excluded_territory_code:type_name -> ddex.ern.v383.CurrentTerritoryCode
+	135, // 612: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.display_artist_name:type_name -> ddex.ern.v383.Name
+	121, // 613: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.label_name:type_name -> ddex.ern.v383.LabelName
+	176, // 614: ddex.ern.v383.ReleaseSummaryDetailsByTerritory.rights_agreement_id:type_name -> ddex.ern.v383.RightsAgreementId
 	150, // 615: ddex.ern.v383.ResourceContainedResourceReference.purpose:type_name -> ddex.ern.v383.Purpose
 	164, // 616: ddex.ern.v383.ResourceContainedResourceReferenceList.resource_contained_resource_reference:type_name -> ddex.ern.v383.ResourceContainedResourceReference
-	167, // 617: ddex.ern.v383.ResourceContributor.resource_contributor_role:type_name -> ddex.ern.v383.ResourceContributorRole
-	140, // 618: ddex.ern.v383.ResourceContributor.party_id:type_name -> ddex.ern.v383.PartyId
-	141, // 619: ddex.ern.v383.ResourceContributor.party_name:type_name -> ddex.ern.v383.PartyName
+	140, // 617: ddex.ern.v383.ResourceContributor.party_id:type_name -> ddex.ern.v383.PartyId
+	141, // 618: ddex.ern.v383.ResourceContributor.party_name:type_name -> ddex.ern.v383.PartyName
+	167, // 619: ddex.ern.v383.ResourceContributor.resource_contributor_role:type_name -> ddex.ern.v383.ResourceContributorRole
 	169, // 620: ddex.ern.v383.ResourceMusicalWorkReferenceList.resource_musical_work_reference:type_name -> ddex.ern.v383.ResourceMusicalWorkReference
 	149, // 621: ddex.ern.v383.ResourceProprietaryId.proprietary_id:type_name -> ddex.ern.v383.ProprietaryId
 	176, // 622: ddex.ern.v383.RightShare.right_share_id:type_name -> ddex.ern.v383.RightsAgreementId
 	175, // 623: ddex.ern.v383.RightShare.right_share_creation_reference_list:type_name -> ddex.ern.v383.RightShareCreationReferenceList
-	179, // 624: ddex.ern.v383.RightShare.rights_type:type_name -> ddex.ern.v383.RightsType
-	203, // 625: ddex.ern.v383.RightShare.use_type:type_name -> ddex.ern.v383.UseType
-	206, // 626: ddex.ern.v383.RightShare.user_interface_type:type_name -> ddex.ern.v383.UserInterfaceType
-	99,  // 627: ddex.ern.v383.RightShare.distribution_channel_type:type_name -> ddex.ern.v383.DistributionChannelType
-	70,  // 628: ddex.ern.v383.RightShare.carrier_type:type_name -> ddex.ern.v383.CarrierType
-	80,  // 629: ddex.ern.v383.RightShare.commercial_model_type:type_name -> ddex.ern.v383.CommercialModelType
-	178, // 630: ddex.ern.v383.RightShare.rights_controller:type_name -> ddex.ern.v383.RightsController
-	144, // 631: ddex.ern.v383.RightShare.validity_period:type_name -> ddex.ern.v383.Period
-	194, // 632: ddex.ern.v383.RightShare.tariff_reference:type_name -> ddex.ern.v383.TariffReference
-	61,  // 633: ddex.ern.v383.RightShare.territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
-	61,  // 634: ddex.ern.v383.RightShare.excluded_territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
-	142, // 635: ddex.ern.v383.RightShare.right_share_percentage:type_name -> ddex.ern.v383.Percentage
+	61,  // 624: ddex.ern.v383.RightShare.territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	61,  // 625: ddex.ern.v383.RightShare.excluded_territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	179, // 626: ddex.ern.v383.RightShare.rights_type:type_name -> ddex.ern.v383.RightsType
+	203, // 627: ddex.ern.v383.RightShare.use_type:type_name -> ddex.ern.v383.UseType
+	206, // 628: ddex.ern.v383.RightShare.user_interface_type:type_name -> ddex.ern.v383.UserInterfaceType
+	99,  // 629: ddex.ern.v383.RightShare.distribution_channel_type:type_name -> ddex.ern.v383.DistributionChannelType
+	70,  // 630: ddex.ern.v383.RightShare.carrier_type:type_name -> ddex.ern.v383.CarrierType
+	80,  // 631: ddex.ern.v383.RightShare.commercial_model_type:type_name -> ddex.ern.v383.CommercialModelType
+	178, // 632: ddex.ern.v383.RightShare.rights_controller:type_name -> ddex.ern.v383.RightsController
+	144, // 633: ddex.ern.v383.RightShare.validity_period:type_name -> ddex.ern.v383.Period
+	142, // 634: ddex.ern.v383.RightShare.right_share_percentage:type_name -> ddex.ern.v383.Percentage
+	194, // 635: ddex.ern.v383.RightShare.tariff_reference:type_name -> ddex.ern.v383.TariffReference
 	149, // 636: ddex.ern.v383.RightsAgreementId.proprietary_id:type_name -> ddex.ern.v383.ProprietaryId
 	81,  // 637: ddex.ern.v383.RightsClaimPolicy.condition:type_name -> ddex.ern.v383.Condition
 	140, // 638: ddex.ern.v383.RightsController.party_id:type_name -> ddex.ern.v383.PartyId
@@ -22131,9 +22723,9 @@ var file_ddex_ern_v383_v383_proto_depIdxs = []int32{
 	152, // 642: ddex.ern.v383.SalesReportingProxyReleaseId.reason:type_name -> ddex.ern.v383.Reason
 	153, // 643: ddex.ern.v383.SalesReportingProxyReleaseId.reason_type:type_name -> ddex.ern.v383.ReasonType
 	149, // 644: ddex.ern.v383.SheetMusicId.proprietary_id:type_name -> ddex.ern.v383.ProprietaryId
-	139, // 645: ddex.ern.v383.SocietyAffiliation.music_rights_society:type_name -> ddex.ern.v383.PartyDescriptor
-	61,  // 646: ddex.ern.v383.SocietyAffiliation.territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
-	61,  // 647: ddex.ern.v383.SocietyAffiliation.excluded_territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	61,  // 645: ddex.ern.v383.SocietyAffiliation.territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	61,  // 646: ddex.ern.v383.SocietyAffiliation.excluded_territory_code:type_name -> ddex.ern.v383.AllTerritoryCode
+	139, // 647: ddex.ern.v383.SocietyAffiliation.music_rights_society:type_name -> ddex.ern.v383.PartyDescriptor
 	188, // 648: ddex.ern.v383.SoundRecordingCollectionReferenceList.sound_recording_collection_reference:type_name -> ddex.ern.v383.SoundRecordingCollectionReference
 	71,  // 649: ddex.ern.v383.SoundRecordingId.catalog_number:type_name -> ddex.ern.v383.CatalogNumber
 	149, // 650: ddex.ern.v383.SoundRecordingId.proprietary_id:type_name -> ddex.ern.v383.ProprietaryId
@@ -22164,6 +22756,183 @@ func file_ddex_ern_v383_v383_proto_init() {
 	if File_ddex_ern_v383_v383_proto != nil {
 		return
 	}
+	file_ddex_ern_v383_v383_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[1].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[2].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[6].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[7].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[8].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[9].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[11].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[14].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[15].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[18].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[19].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[20].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[21].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[22].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[23].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[24].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[25].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[26].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[28].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[29].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[31].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[32].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[34].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[36].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[37].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[38].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[39].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[40].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[41].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[42].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[43].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[44].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[45].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[46].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[47].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[48].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[49].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[50].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[51].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[52].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[53].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[54].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[55].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[56].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[57].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[58].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[59].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[60].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[61].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[63].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[64].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[65].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[67].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[68].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[69].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[71].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[72].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[73].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[74].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[75].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[76].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[78].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[79].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[80].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[81].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[83].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[84].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[85].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[87].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[88].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[89].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[90].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[91].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[92].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[93].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[94].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[95].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[96].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[97].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[98].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[99].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[100].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[101].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[102].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[103].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[104].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[105].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[106].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[107].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[108].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[110].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[111].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[112].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[113].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[114].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[115].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[116].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[117].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[118].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[119].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[120].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[121].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[122].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[123].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[125].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[126].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[127].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[128].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[129].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[130].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[131].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[132].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[133].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[134].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[135].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[136].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[137].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[139].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[140].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[141].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[147].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[149].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[150].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[151].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[152].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[153].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[154].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[155].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[156].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[157].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[158].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[159].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[161].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[162].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[163].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[165].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[166].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[168].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[170].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[171].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[172].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[173].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[177].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[178].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[180].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[181].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[182].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[183].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[185].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[186].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[187].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[188].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[189].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[190].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[191].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[192].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[193].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[194].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[195].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[196].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[197].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[198].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[199].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[200].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[201].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[202].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[203].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[204].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[205].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[206].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[208].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[209].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[210].OneofWrappers = []any{}
+	file_ddex_ern_v383_v383_proto_msgTypes[211].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -1,3 +1,6 @@
+// Generated by xsd2proto devel on 2026-10-15
+// Schema: ernv43/release-notification.xsd (SCOW Version v0.8.71 220823, sha256 212df060960d)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.9
@@ -42,22 +45,29 @@ type NewReleaseMessage struct {
 	DealList *DealList `protobuf:"bytes,8,opt,name=deal_list,json=dealList,proto3" json:"deal_list,omitempty" xml:"DealList"`
 	// @gotags: xml:"SupplementalDocumentList"
 	SupplementalDocumentList *SupplementalDocumentList `protobuf:"bytes,9,opt,name=supplemental_document_list,json=supplementalDocumentList,proto3" json:"supplemental_document_list,omitempty" xml:"SupplementalDocumentList"`
-	// @gotags: xml:"ReleaseProfileVersionId,attr"
-	ReleaseProfileVersionId string `protobuf:"bytes,10,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr"`
-	// @gotags: xml:"ReleaseProfileVariantVersionId,attr"
-	ReleaseProfileVariantVersionId string `protobuf:"bytes,11,opt,name=release_profile_variant_version_id,json=releaseProfileVariantVersionId,proto3" json:"release_profile_variant_version_id,omitempty" xml:"ReleaseProfileVariantVersionId,attr"`
+	// @gotags: xml:"ReleaseProfileVersionId,attr" avs:"ReleaseProfileVersionId"
+	ReleaseProfileVersionId *string `protobuf:"bytes,10,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3,oneof" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr" avs:"ReleaseProfileVersionId"`
+	// @gotags: xml:"ReleaseProfileVariantVersionId,attr" avs:"ReleaseProfileVariantVersionId"
+	ReleaseProfileVariantVersionId *string `protobuf:"bytes,11,opt,name=release_profile_variant_version_id,json=releaseProfileVariantVersionId,proto3,oneof" json:"release_profile_variant_version_id,omitempty" xml:"ReleaseProfileVariantVersionId,attr" avs:"ReleaseProfileVariantVersionId"`
 	// @gotags: xml:"AvsVersionId,attr"
 	AvsVersionId string `protobuf:"bytes,12,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
 	LanguageAndScriptCode string `protobuf:"bytes,13,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:ern,attr"
-	XmlnsErn string `protobuf:"bytes,14,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr"`
+	// @gotags: xml:"xmlns:ern,attr,omitempty"
+	XmlnsErn string `protobuf:"bytes,14,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,15,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,16,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,17,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NewReleaseMessage) Reset() {
@@ -154,15 +164,15 @@ func (x *NewReleaseMessage) GetSupplementalDocumentList() *SupplementalDocumentL
 }
 
 func (x *NewReleaseMessage) GetReleaseProfileVersionId() string {
-	if x != nil {
-		return x.ReleaseProfileVersionId
+	if x != nil && x.ReleaseProfileVersionId != nil {
+		return *x.ReleaseProfileVersionId
 	}
 	return ""
 }
 
 func (x *NewReleaseMessage) GetReleaseProfileVariantVersionId() string {
-	if x != nil {
-		return x.ReleaseProfileVariantVersionId
+	if x != nil && x.ReleaseProfileVariantVersionId != nil {
+		return *x.ReleaseProfileVariantVersionId
 	}
 	return ""
 }
@@ -202,6 +212,13 @@ func (x *NewReleaseMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *NewReleaseMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type PurgeReleaseMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageHeader"
@@ -212,14 +229,21 @@ type PurgeReleaseMessage struct {
 	AvsVersionId string `protobuf:"bytes,3,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
 	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:ern,attr"
-	XmlnsErn string `protobuf:"bytes,5,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr"`
+	// @gotags: xml:"xmlns:ern,attr,omitempty"
+	XmlnsErn string `protobuf:"bytes,5,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,6,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,7,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,8,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PurgeReleaseMessage) Reset() {
@@ -301,6 +325,13 @@ func (x *PurgeReleaseMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *PurgeReleaseMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type AdditionalTitle struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"TitleText"
@@ -308,19 +339,19 @@ type AdditionalTitle struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle []*DisplaySubTitle `protobuf:"bytes,2,rep,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType string `protobuf:"bytes,5,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"TitleType,attr" avs:"AdditionalTitleType"
+	TitleType *string `protobuf:"bytes,5,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"AdditionalTitleType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,9,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,9,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -370,50 +401,50 @@ func (x *AdditionalTitle) GetSubTitle() []*DisplaySubTitle {
 }
 
 func (x *AdditionalTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *AdditionalTitle) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *AdditionalTitle) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
 
 func (x *AdditionalTitle) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AdditionalTitle) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *AdditionalTitle) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *AdditionalTitle) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
@@ -485,25 +516,25 @@ type AudioDeliveryFile struct {
 	// @gotags: xml:"OriginalBitRate"
 	OriginalBitRate *BitRate `protobuf:"bytes,5,opt,name=original_bit_rate,json=originalBitRate,proto3" json:"original_bit_rate,omitempty" xml:"OriginalBitRate"`
 	// @gotags: xml:"NumberOfChannels"
-	NumberOfChannels string `protobuf:"bytes,6,opt,name=number_of_channels,json=numberOfChannels,proto3" json:"number_of_channels,omitempty" xml:"NumberOfChannels"`
+	NumberOfChannels *string `protobuf:"bytes,6,opt,name=number_of_channels,json=numberOfChannels,proto3,oneof" json:"number_of_channels,omitempty" xml:"NumberOfChannels"`
 	// @gotags: xml:"NumberOfAudioObjects"
-	NumberOfAudioObjects int32 `protobuf:"varint,7,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
+	NumberOfAudioObjects *int32 `protobuf:"varint,7,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3,oneof" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
 	// @gotags: xml:"SamplingRate"
 	SamplingRate *SamplingRate `protobuf:"bytes,8,opt,name=sampling_rate,json=samplingRate,proto3" json:"sampling_rate,omitempty" xml:"SamplingRate"`
 	// @gotags: xml:"OriginalSamplingRate"
 	OriginalSamplingRate *SamplingRate `protobuf:"bytes,9,opt,name=original_sampling_rate,json=originalSamplingRate,proto3" json:"original_sampling_rate,omitempty" xml:"OriginalSamplingRate"`
 	// @gotags: xml:"BitsPerSample"
-	BitsPerSample int32 `protobuf:"varint,10,opt,name=bits_per_sample,json=bitsPerSample,proto3" json:"bits_per_sample,omitempty" xml:"BitsPerSample"`
+	BitsPerSample *int32 `protobuf:"varint,10,opt,name=bits_per_sample,json=bitsPerSample,proto3,oneof" json:"bits_per_sample,omitempty" xml:"BitsPerSample"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,11,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,12,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,12,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,13,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,14,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,15,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,15,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -574,15 +605,15 @@ func (x *AudioDeliveryFile) GetOriginalBitRate() *BitRate {
 }
 
 func (x *AudioDeliveryFile) GetNumberOfChannels() string {
-	if x != nil {
-		return x.NumberOfChannels
+	if x != nil && x.NumberOfChannels != nil {
+		return *x.NumberOfChannels
 	}
 	return ""
 }
 
 func (x *AudioDeliveryFile) GetNumberOfAudioObjects() int32 {
-	if x != nil {
-		return x.NumberOfAudioObjects
+	if x != nil && x.NumberOfAudioObjects != nil {
+		return *x.NumberOfAudioObjects
 	}
 	return 0
 }
@@ -602,22 +633,22 @@ func (x *AudioDeliveryFile) GetOriginalSamplingRate() *SamplingRate {
 }
 
 func (x *AudioDeliveryFile) GetBitsPerSample() int32 {
-	if x != nil {
-		return x.BitsPerSample
+	if x != nil && x.BitsPerSample != nil {
+		return *x.BitsPerSample
 	}
 	return 0
 }
 
 func (x *AudioDeliveryFile) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *AudioDeliveryFile) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
@@ -637,8 +668,8 @@ func (x *AudioDeliveryFile) GetFingerprint() []*Fingerprint {
 }
 
 func (x *AudioDeliveryFile) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -651,10 +682,10 @@ type AvRating struct {
 	Agency *RatingAgency `protobuf:"bytes,2,opt,name=agency,proto3" json:"agency,omitempty" xml:"Agency"`
 	// @gotags: xml:"Reason"
 	Reason *RatingReason `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" xml:"Reason"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -711,15 +742,15 @@ func (x *AvRating) GetReason() *RatingReason {
 }
 
 func (x *AvRating) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *AvRating) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -727,17 +758,17 @@ func (x *AvRating) GetIsDefault() bool {
 type CLineWithDefault struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"CLineCompany"
-	CLineCompany string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3" json:"c_line_company,omitempty" xml:"CLineCompany"`
+	CLineCompany *string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3,oneof" json:"c_line_company,omitempty" xml:"CLineCompany"`
 	// @gotags: xml:"CLineText"
 	CLineText string `protobuf:"bytes,3,opt,name=c_line_text,json=cLineText,proto3" json:"c_line_text,omitempty" xml:"CLineText"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -773,15 +804,15 @@ func (*CLineWithDefault) Descriptor() ([]byte, []int) {
 }
 
 func (x *CLineWithDefault) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *CLineWithDefault) GetCLineCompany() string {
-	if x != nil {
-		return x.CLineCompany
+	if x != nil && x.CLineCompany != nil {
+		return *x.CLineCompany
 	}
 	return ""
 }
@@ -794,22 +825,22 @@ func (x *CLineWithDefault) GetCLineText() string {
 }
 
 func (x *CLineWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *CLineWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *CLineWithDefault) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -881,21 +912,21 @@ type Chapter struct {
 	// @gotags: xml:"AdditionalTitle"
 	AdditionalTitle []*AdditionalTitle `protobuf:"bytes,5,rep,name=additional_title,json=additionalTitle,proto3" json:"additional_title,omitempty" xml:"AdditionalTitle"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"Contributor"
 	Contributor []*Contributor `protobuf:"bytes,7,rep,name=contributor,proto3" json:"contributor,omitempty" xml:"Contributor"`
 	// @gotags: xml:"Character"
 	Character []*Character `protobuf:"bytes,8,rep,name=character,proto3" json:"character,omitempty" xml:"Character"`
 	// @gotags: xml:"RepresentativeImageReference"
-	RepresentativeImageReference string `protobuf:"bytes,9,opt,name=representative_image_reference,json=representativeImageReference,proto3" json:"representative_image_reference,omitempty" xml:"RepresentativeImageReference"`
+	RepresentativeImageReference *string `protobuf:"bytes,9,opt,name=representative_image_reference,json=representativeImageReference,proto3,oneof" json:"representative_image_reference,omitempty" xml:"RepresentativeImageReference"`
 	// @gotags: xml:"StartTime"
-	StartTime string `protobuf:"bytes,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" xml:"StartTime"`
+	StartTime *string `protobuf:"bytes,10,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty" xml:"StartTime"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,11,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"EndTime"
-	EndTime string `protobuf:"bytes,12,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" xml:"EndTime"`
+	EndTime *string `protobuf:"bytes,12,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty" xml:"EndTime"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,13,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,13,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -966,8 +997,8 @@ func (x *Chapter) GetAdditionalTitle() []*AdditionalTitle {
 }
 
 func (x *Chapter) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -987,36 +1018,36 @@ func (x *Chapter) GetCharacter() []*Character {
 }
 
 func (x *Chapter) GetRepresentativeImageReference() string {
-	if x != nil {
-		return x.RepresentativeImageReference
+	if x != nil && x.RepresentativeImageReference != nil {
+		return *x.RepresentativeImageReference
 	}
 	return ""
 }
 
 func (x *Chapter) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
 	return ""
 }
 
 func (x *Chapter) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *Chapter) GetEndTime() string {
-	if x != nil {
-		return x.EndTime
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
 	return ""
 }
 
 func (x *Chapter) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -1026,7 +1057,7 @@ type ChapterList struct {
 	// @gotags: xml:"Chapter"
 	Chapter []*Chapter `protobuf:"bytes,1,rep,name=chapter,proto3" json:"chapter,omitempty" xml:"Chapter"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -1069,8 +1100,8 @@ func (x *ChapterList) GetChapter() []*Chapter {
 }
 
 func (x *ChapterList) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -1082,7 +1113,7 @@ type Character struct {
 	// @gotags: xml:"Performer"
 	Performer *Contributor `protobuf:"bytes,2,opt,name=performer,proto3" json:"performer,omitempty" xml:"Performer"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1132,8 +1163,8 @@ func (x *Character) GetPerformer() *Contributor {
 }
 
 func (x *Character) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -1143,11 +1174,11 @@ type ClipDetails struct {
 	// @gotags: xml:"ClipType"
 	ClipType *ClipType `protobuf:"bytes,1,opt,name=clip_type,json=clipType,proto3" json:"clip_type,omitempty" xml:"ClipType"`
 	// @gotags: xml:"TopLeftCorner"
-	TopLeftCorner string `protobuf:"bytes,2,opt,name=top_left_corner,json=topLeftCorner,proto3" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
+	TopLeftCorner *string `protobuf:"bytes,2,opt,name=top_left_corner,json=topLeftCorner,proto3,oneof" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
 	// @gotags: xml:"BottomRightCorner"
-	BottomRightCorner string `protobuf:"bytes,3,opt,name=bottom_right_corner,json=bottomRightCorner,proto3" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	BottomRightCorner *string `protobuf:"bytes,3,opt,name=bottom_right_corner,json=bottomRightCorner,proto3,oneof" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1190,15 +1221,15 @@ func (x *ClipDetails) GetClipType() *ClipType {
 }
 
 func (x *ClipDetails) GetTopLeftCorner() string {
-	if x != nil {
-		return x.TopLeftCorner
+	if x != nil && x.TopLeftCorner != nil {
+		return *x.TopLeftCorner
 	}
 	return ""
 }
 
 func (x *ClipDetails) GetBottomRightCorner() string {
-	if x != nil {
-		return x.BottomRightCorner
+	if x != nil && x.BottomRightCorner != nil {
+		return *x.BottomRightCorner
 	}
 	return ""
 }
@@ -1329,12 +1360,12 @@ func (x *ClipRelease) GetRelatedRelease() []*RelatedRelease {
 
 type CommercialModelType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CommercialModelTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CommercialModelTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1377,15 +1408,15 @@ func (x *CommercialModelType) GetValue() string {
 }
 
 func (x *CommercialModelType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CommercialModelType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -1394,14 +1425,14 @@ type ConditionForRightsClaimPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Value"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
-	// @gotags: xml:"Unit"
-	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit"`
-	// @gotags: xml:"ReferenceCreation"
-	ReferenceCreation string `protobuf:"bytes,3,opt,name=reference_creation,json=referenceCreation,proto3" json:"reference_creation,omitempty" xml:"ReferenceCreation"`
-	// @gotags: xml:"RelationalRelator"
-	RelationalRelator string `protobuf:"bytes,4,opt,name=relational_relator,json=relationalRelator,proto3" json:"relational_relator,omitempty" xml:"RelationalRelator"`
-	// @gotags: xml:"MeasurementType"
-	MeasurementType string `protobuf:"bytes,5,opt,name=measurement_type,json=measurementType,proto3" json:"measurement_type,omitempty" xml:"MeasurementType"`
+	// @gotags: xml:"Unit" avs:"UnitOfConditionValue"
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit" avs:"UnitOfConditionValue"`
+	// @gotags: xml:"ReferenceCreation" avs:"ReferenceCreation"
+	ReferenceCreation *string `protobuf:"bytes,3,opt,name=reference_creation,json=referenceCreation,proto3,oneof" json:"reference_creation,omitempty" xml:"ReferenceCreation" avs:"ReferenceCreation"`
+	// @gotags: xml:"RelationalRelator" avs:"RelationalRelator"
+	RelationalRelator string `protobuf:"bytes,4,opt,name=relational_relator,json=relationalRelator,proto3" json:"relational_relator,omitempty" xml:"RelationalRelator" avs:"RelationalRelator"`
+	// @gotags: xml:"MeasurementType" avs:"MeasurementType"
+	MeasurementType *string `protobuf:"bytes,5,opt,name=measurement_type,json=measurementType,proto3,oneof" json:"measurement_type,omitempty" xml:"MeasurementType" avs:"MeasurementType"`
 	// @gotags: xml:"Segment"
 	Segment []*Segment `protobuf:"bytes,6,rep,name=segment,proto3" json:"segment,omitempty" xml:"Segment"`
 	// @gotags: xml:"ServiceException"
@@ -1455,8 +1486,8 @@ func (x *ConditionForRightsClaimPolicy) GetUnit() string {
 }
 
 func (x *ConditionForRightsClaimPolicy) GetReferenceCreation() string {
-	if x != nil {
-		return x.ReferenceCreation
+	if x != nil && x.ReferenceCreation != nil {
+		return *x.ReferenceCreation
 	}
 	return ""
 }
@@ -1469,8 +1500,8 @@ func (x *ConditionForRightsClaimPolicy) GetRelationalRelator() string {
 }
 
 func (x *ConditionForRightsClaimPolicy) GetMeasurementType() string {
-	if x != nil {
-		return x.MeasurementType
+	if x != nil && x.MeasurementType != nil {
+		return *x.MeasurementType
 	}
 	return ""
 }
@@ -1498,15 +1529,15 @@ type Contributor struct {
 	// @gotags: xml:"InstrumentType"
 	InstrumentType []*InstrumentType `protobuf:"bytes,3,rep,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty" xml:"InstrumentType"`
 	// @gotags: xml:"HasMadeFeaturedContribution"
-	HasMadeFeaturedContribution bool `protobuf:"varint,4,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
+	HasMadeFeaturedContribution *bool `protobuf:"varint,4,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
 	// @gotags: xml:"HasMadeContractedContribution"
-	HasMadeContractedContribution bool `protobuf:"varint,5,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
+	HasMadeContractedContribution *bool `protobuf:"varint,5,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
 	// @gotags: xml:"IsCredited"
 	IsCredited *IsCredited `protobuf:"bytes,6,opt,name=is_credited,json=isCredited,proto3" json:"is_credited,omitempty" xml:"IsCredited"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,7,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1563,15 +1594,15 @@ func (x *Contributor) GetInstrumentType() []*InstrumentType {
 }
 
 func (x *Contributor) GetHasMadeFeaturedContribution() bool {
-	if x != nil {
-		return x.HasMadeFeaturedContribution
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
 	}
 	return false
 }
 
 func (x *Contributor) GetHasMadeContractedContribution() bool {
-	if x != nil {
-		return x.HasMadeContractedContribution
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
 	}
 	return false
 }
@@ -1591,8 +1622,8 @@ func (x *Contributor) GetDisplayCredits() []*DisplayCredits {
 }
 
 func (x *Contributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -1656,11 +1687,11 @@ type CourtesyLineWithDefault struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1703,22 +1734,22 @@ func (x *CourtesyLineWithDefault) GetValue() string {
 }
 
 func (x *CourtesyLineWithDefault) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *CourtesyLineWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *CourtesyLineWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -1735,6 +1766,10 @@ type Cue struct {
 	CueVisualPerceptionType *CueVisualPerceptionType `protobuf:"bytes,4,opt,name=cue_visual_perception_type,json=cueVisualPerceptionType,proto3" json:"cue_visual_perception_type,omitempty" xml:"CueVisualPerceptionType"`
 	// @gotags: xml:"CueOrigin"
 	CueOrigin *CueOrigin `protobuf:"bytes,5,opt,name=cue_origin,json=cueOrigin,proto3" json:"cue_origin,omitempty" xml:"CueOrigin"`
+	// @gotags: xml:"ResourceId"
+	ResourceId *ResourceId `protobuf:"bytes,17,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
+	// @gotags: xml:"WorkId"
+	WorkId *MusicalWorkId `protobuf:"bytes,18,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty" xml:"WorkId"`
 	// @gotags: xml:"DisplayTitleText"
 	DisplayTitleText []*DisplayTitleText `protobuf:"bytes,6,rep,name=display_title_text,json=displayTitleText,proto3" json:"display_title_text,omitempty" xml:"DisplayTitleText"`
 	// @gotags: xml:"DisplayTitle"
@@ -1744,23 +1779,19 @@ type Cue struct {
 	// @gotags: xml:"Contributor"
 	Contributor []*Contributor `protobuf:"bytes,9,rep,name=contributor,proto3" json:"contributor,omitempty" xml:"Contributor"`
 	// @gotags: xml:"IsDance"
-	IsDance bool `protobuf:"varint,10,opt,name=is_dance,json=isDance,proto3" json:"is_dance,omitempty" xml:"IsDance"`
+	IsDance *bool `protobuf:"varint,10,opt,name=is_dance,json=isDance,proto3,oneof" json:"is_dance,omitempty" xml:"IsDance"`
 	// @gotags: xml:"HasMusicalContent"
-	HasMusicalContent bool `protobuf:"varint,11,opt,name=has_musical_content,json=hasMusicalContent,proto3" json:"has_musical_content,omitempty" xml:"HasMusicalContent"`
+	HasMusicalContent *bool `protobuf:"varint,11,opt,name=has_musical_content,json=hasMusicalContent,proto3,oneof" json:"has_musical_content,omitempty" xml:"HasMusicalContent"`
 	// @gotags: xml:"PLine"
 	PLine []*PLine `protobuf:"bytes,12,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
 	// @gotags: xml:"CLine"
 	CLine []*CLine `protobuf:"bytes,13,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty" xml:"CLine"`
 	// @gotags: xml:"StartTime"
-	StartTime string `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" xml:"StartTime"`
+	StartTime *string `protobuf:"bytes,14,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty" xml:"StartTime"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,15,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,15,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"EndTime"
-	EndTime string `protobuf:"bytes,16,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" xml:"EndTime"`
-	// @gotags: xml:"ResourceId"
-	ResourceId *ResourceId `protobuf:"bytes,17,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
-	// @gotags: xml:"WorkId"
-	WorkId        *MusicalWorkId `protobuf:"bytes,18,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty" xml:"WorkId"`
+	EndTime       *string `protobuf:"bytes,16,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty" xml:"EndTime"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1830,6 +1861,20 @@ func (x *Cue) GetCueOrigin() *CueOrigin {
 	return nil
 }
 
+func (x *Cue) GetResourceId() *ResourceId {
+	if x != nil {
+		return x.ResourceId
+	}
+	return nil
+}
+
+func (x *Cue) GetWorkId() *MusicalWorkId {
+	if x != nil {
+		return x.WorkId
+	}
+	return nil
+}
+
 func (x *Cue) GetDisplayTitleText() []*DisplayTitleText {
 	if x != nil {
 		return x.DisplayTitleText
@@ -1859,15 +1904,15 @@ func (x *Cue) GetContributor() []*Contributor {
 }
 
 func (x *Cue) GetIsDance() bool {
-	if x != nil {
-		return x.IsDance
+	if x != nil && x.IsDance != nil {
+		return *x.IsDance
 	}
 	return false
 }
 
 func (x *Cue) GetHasMusicalContent() bool {
-	if x != nil {
-		return x.HasMusicalContent
+	if x != nil && x.HasMusicalContent != nil {
+		return *x.HasMusicalContent
 	}
 	return false
 }
@@ -1887,40 +1932,26 @@ func (x *Cue) GetCLine() []*CLine {
 }
 
 func (x *Cue) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
 	return ""
 }
 
 func (x *Cue) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *Cue) GetEndTime() string {
-	if x != nil {
-		return x.EndTime
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
 	return ""
 }
 
-func (x *Cue) GetResourceId() *ResourceId {
-	if x != nil {
-		return x.ResourceId
-	}
-	return nil
-}
-
-func (x *Cue) GetWorkId() *MusicalWorkId {
-	if x != nil {
-		return x.WorkId
-	}
-	return nil
-}
-
 type CueSheet struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"CueSheetId"
@@ -2043,7 +2074,7 @@ type Deal struct {
 	// @gotags: xml:"DealReference"
 	DealReference []string `protobuf:"bytes,1,rep,name=deal_reference,json=dealReference,proto3" json:"deal_reference,omitempty" xml:"DealReference"`
 	// @gotags: xml:"IsCommunicatedOutOfBand"
-	IsCommunicatedOutOfBand bool `protobuf:"varint,2,opt,name=is_communicated_out_of_band,json=isCommunicatedOutOfBand,proto3" json:"is_communicated_out_of_band,omitempty" xml:"IsCommunicatedOutOfBand"`
+	IsCommunicatedOutOfBand *bool `protobuf:"varint,2,opt,name=is_communicated_out_of_band,json=isCommunicatedOutOfBand,proto3,oneof" json:"is_communicated_out_of_band,omitempty" xml:"IsCommunicatedOutOfBand"`
 	// @gotags: xml:"DealTerms"
 	DealTerms *DealTerms `protobuf:"bytes,3,opt,name=deal_terms,json=dealTerms,proto3" json:"deal_terms,omitempty" xml:"DealTerms"`
 	// @gotags: xml:"DealTechnicalResourceDetailsReferenceList"
@@ -2092,8 +2123,8 @@ func (x *Deal) GetDealReference() []string {
 }
 
 func (x *Deal) GetIsCommunicatedOutOfBand() bool {
-	if x != nil {
-		return x.IsCommunicatedOutOfBand
+	if x != nil && x.IsCommunicatedOutOfBand != nil {
+		return *x.IsCommunicatedOutOfBand
 	}
 	return false
 }
@@ -2274,6 +2305,10 @@ func (x *DealTechnicalResourceDetailsReferenceList) GetDealTechnicalResourceDeta
 
 type DealTerms struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,14,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,15,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"ValidityPeriod"
 	ValidityPeriod []*PeriodWithStartDate `protobuf:"bytes,1,rep,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
 	// @gotags: xml:"CommercialModelType"
@@ -2287,33 +2322,29 @@ type DealTerms struct {
 	// @gotags: xml:"TechnicalInstantiation"
 	TechnicalInstantiation *DealTermsTechnicalInstantiation `protobuf:"bytes,6,opt,name=technical_instantiation,json=technicalInstantiation,proto3" json:"technical_instantiation,omitempty" xml:"TechnicalInstantiation"`
 	// @gotags: xml:"NumberOfUsages"
-	NumberOfUsages int32 `protobuf:"varint,7,opt,name=number_of_usages,json=numberOfUsages,proto3" json:"number_of_usages,omitempty" xml:"NumberOfUsages"`
+	NumberOfUsages *int32 `protobuf:"varint,7,opt,name=number_of_usages,json=numberOfUsages,proto3,oneof" json:"number_of_usages,omitempty" xml:"NumberOfUsages"`
+	// @gotags: xml:"DistributionChannel"
+	DistributionChannel []*DSP `protobuf:"bytes,16,rep,name=distribution_channel,json=distributionChannel,proto3" json:"distribution_channel,omitempty" xml:"DistributionChannel"`
+	// @gotags: xml:"ExcludedDistributionChannel"
+	ExcludedDistributionChannel []*DSP `protobuf:"bytes,17,rep,name=excluded_distribution_channel,json=excludedDistributionChannel,proto3" json:"excluded_distribution_channel,omitempty" xml:"ExcludedDistributionChannel"`
 	// @gotags: xml:"RightsClaimPolicy"
 	RightsClaimPolicy []*RightsClaimPolicy `protobuf:"bytes,8,rep,name=rights_claim_policy,json=rightsClaimPolicy,proto3" json:"rights_claim_policy,omitempty" xml:"RightsClaimPolicy"`
 	// @gotags: xml:"PriceInformation"
 	PriceInformation []*PriceInformationWithType `protobuf:"bytes,9,rep,name=price_information,json=priceInformation,proto3" json:"price_information,omitempty" xml:"PriceInformation"`
+	// @gotags: xml:"IsPromotional"
+	IsPromotional bool `protobuf:"varint,18,opt,name=is_promotional,json=isPromotional,proto3" json:"is_promotional,omitempty" xml:"IsPromotional"`
+	// @gotags: xml:"PromotionalCode"
+	PromotionalCode *PromotionalCode `protobuf:"bytes,19,opt,name=promotional_code,json=promotionalCode,proto3" json:"promotional_code,omitempty" xml:"PromotionalCode"`
 	// @gotags: xml:"IsPreOrderDeal"
-	IsPreOrderDeal bool `protobuf:"varint,10,opt,name=is_pre_order_deal,json=isPreOrderDeal,proto3" json:"is_pre_order_deal,omitempty" xml:"IsPreOrderDeal"`
+	IsPreOrderDeal *bool `protobuf:"varint,10,opt,name=is_pre_order_deal,json=isPreOrderDeal,proto3,oneof" json:"is_pre_order_deal,omitempty" xml:"IsPreOrderDeal"`
 	// @gotags: xml:"InstantGratificationResourceList"
 	InstantGratificationResourceList *DealResourceReferenceList `protobuf:"bytes,11,opt,name=instant_gratification_resource_list,json=instantGratificationResourceList,proto3" json:"instant_gratification_resource_list,omitempty" xml:"InstantGratificationResourceList"`
 	// @gotags: xml:"PhysicalReturns"
 	PhysicalReturns *PhysicalReturns `protobuf:"bytes,12,opt,name=physical_returns,json=physicalReturns,proto3" json:"physical_returns,omitempty" xml:"PhysicalReturns"`
 	// @gotags: xml:"NumberOfProductsPerCarton"
-	NumberOfProductsPerCarton int32 `protobuf:"varint,13,opt,name=number_of_products_per_carton,json=numberOfProductsPerCarton,proto3" json:"number_of_products_per_carton,omitempty" xml:"NumberOfProductsPerCarton"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,14,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,15,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	// @gotags: xml:"DistributionChannel"
-	DistributionChannel []*DSP `protobuf:"bytes,16,rep,name=distribution_channel,json=distributionChannel,proto3" json:"distribution_channel,omitempty" xml:"DistributionChannel"`
-	// @gotags: xml:"ExcludedDistributionChannel"
-	ExcludedDistributionChannel []*DSP `protobuf:"bytes,17,rep,name=excluded_distribution_channel,json=excludedDistributionChannel,proto3" json:"excluded_distribution_channel,omitempty" xml:"ExcludedDistributionChannel"`
-	// @gotags: xml:"IsPromotional"
-	IsPromotional bool `protobuf:"varint,18,opt,name=is_promotional,json=isPromotional,proto3" json:"is_promotional,omitempty" xml:"IsPromotional"`
-	// @gotags: xml:"PromotionalCode"
-	PromotionalCode *PromotionalCode `protobuf:"bytes,19,opt,name=promotional_code,json=promotionalCode,proto3" json:"promotional_code,omitempty" xml:"PromotionalCode"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	NumberOfProductsPerCarton *int32 `protobuf:"varint,13,opt,name=number_of_products_per_carton,json=numberOfProductsPerCarton,proto3,oneof" json:"number_of_products_per_carton,omitempty" xml:"NumberOfProductsPerCarton"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *DealTerms) Reset() {
@@ -2346,6 +2377,20 @@ func (*DealTerms) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v43_v43_proto_rawDescGZIP(), []int{25}
 }
 
+func (x *DealTerms) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *DealTerms) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *DealTerms) GetValidityPeriod() []*PeriodWithStartDate {
 	if x != nil {
 		return x.ValidityPeriod
@@ -2389,102 +2434,88 @@ func (x *DealTerms) GetTechnicalInstantiation() *DealTermsTechnicalInstantiation
 }
 
 func (x *DealTerms) GetNumberOfUsages() int32 {
-	if x != nil {
-		return x.NumberOfUsages
+	if x != nil && x.NumberOfUsages != nil {
+		return *x.NumberOfUsages
 	}
 	return 0
 }
 
-func (x *DealTerms) GetRightsClaimPolicy() []*RightsClaimPolicy {
+func (x *DealTerms) GetDistributionChannel() []*DSP {
 	if x != nil {
-		return x.RightsClaimPolicy
+		return x.DistributionChannel
 	}
 	return nil
 }
 
-func (x *DealTerms) GetPriceInformation() []*PriceInformationWithType {
+func (x *DealTerms) GetExcludedDistributionChannel() []*DSP {
 	if x != nil {
-		return x.PriceInformation
+		return x.ExcludedDistributionChannel
 	}
 	return nil
 }
 
-func (x *DealTerms) GetIsPreOrderDeal() bool {
-	if x != nil {
-		return x.IsPreOrderDeal
-	}
-	return false
-}
-
-func (x *DealTerms) GetInstantGratificationResourceList() *DealResourceReferenceList {
+func (x *DealTerms) GetRightsClaimPolicy() []*RightsClaimPolicy {
 	if x != nil {
-		return x.InstantGratificationResourceList
+		return x.RightsClaimPolicy
 	}
 	return nil
 }
 
-func (x *DealTerms) GetPhysicalReturns() *PhysicalReturns {
+func (x *DealTerms) GetPriceInformation() []*PriceInformationWithType {
 	if x != nil {
-		return x.PhysicalReturns
+		return x.PriceInformation
 	}
 	return nil
 }
 
-func (x *DealTerms) GetNumberOfProductsPerCarton() int32 {
+func (x *DealTerms) GetIsPromotional() bool {
 	if x != nil {
-		return x.NumberOfProductsPerCarton
+		return x.IsPromotional
 	}
-	return 0
+	return false
 }
 
-func (x *DealTerms) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *DealTerms) GetPromotionalCode() *PromotionalCode {
 	if x != nil {
-		return x.TerritoryCode
+		return x.PromotionalCode
 	}
 	return nil
 }
 
-func (x *DealTerms) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *DealTerms) GetIsPreOrderDeal() bool {
+	if x != nil && x.IsPreOrderDeal != nil {
+		return *x.IsPreOrderDeal
 	}
-	return nil
+	return false
 }
 
-func (x *DealTerms) GetDistributionChannel() []*DSP {
+func (x *DealTerms) GetInstantGratificationResourceList() *DealResourceReferenceList {
 	if x != nil {
-		return x.DistributionChannel
+		return x.InstantGratificationResourceList
 	}
 	return nil
 }
 
-func (x *DealTerms) GetExcludedDistributionChannel() []*DSP {
+func (x *DealTerms) GetPhysicalReturns() *PhysicalReturns {
 	if x != nil {
-		return x.ExcludedDistributionChannel
+		return x.PhysicalReturns
 	}
 	return nil
 }
 
-func (x *DealTerms) GetIsPromotional() bool {
-	if x != nil {
-		return x.IsPromotional
-	}
-	return false
-}
-
-func (x *DealTerms) GetPromotionalCode() *PromotionalCode {
-	if x != nil {
-		return x.PromotionalCode
+func (x *DealTerms) GetNumberOfProductsPerCarton() int32 {
+	if x != nil && x.NumberOfProductsPerCarton != nil {
+		return *x.NumberOfProductsPerCarton
 	}
-	return nil
+	return 0
 }
 
 type DealTermsTechnicalInstantiation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VideoDefinitionType"
 	VideoDefinitionType *VideoDefinitionType `protobuf:"bytes,1,opt,name=video_definition_type,json=videoDefinitionType,proto3" json:"video_definition_type,omitempty" xml:"VideoDefinitionType"`
-	// @gotags: xml:"CodingType"
-	CodingType string `protobuf:"bytes,2,opt,name=coding_type,json=codingType,proto3" json:"coding_type,omitempty" xml:"CodingType"`
+	// @gotags: xml:"CodingType" avs:"CodingType"
+	CodingType *string `protobuf:"bytes,2,opt,name=coding_type,json=codingType,proto3,oneof" json:"coding_type,omitempty" xml:"CodingType" avs:"CodingType"`
 	// @gotags: xml:"BitRate"
 	BitRate       *BitRate `protobuf:"bytes,3,opt,name=bit_rate,json=bitRate,proto3" json:"bit_rate,omitempty" xml:"BitRate"`
 	unknownFields protoimpl.UnknownFields
@@ -2529,8 +2560,8 @@ func (x *DealTermsTechnicalInstantiation) GetVideoDefinitionType() *VideoDefinit
 }
 
 func (x *DealTermsTechnicalInstantiation) GetCodingType() string {
-	if x != nil {
-		return x.CodingType
+	if x != nil && x.CodingType != nil {
+		return *x.CodingType
 	}
 	return ""
 }
@@ -2546,10 +2577,10 @@ type Deity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2592,15 +2623,15 @@ func (x *Deity) GetValue() string {
 }
 
 func (x *Deity) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *Deity) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -2673,11 +2704,11 @@ type DescriptionWithTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2720,44 +2751,44 @@ func (x *DescriptionWithTerritory) GetValue() string {
 }
 
 func (x *DescriptionWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DescriptionWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DescriptionWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type DetailedResourceContributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"PartyId"
+	PartyId []*DetailedPartyId `protobuf:"bytes,6,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyName `protobuf:"bytes,7,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"Role"
 	Role []*ContributorRole `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" xml:"Role"`
 	// @gotags: xml:"InstrumentType"
 	InstrumentType []*InstrumentType `protobuf:"bytes,2,rep,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty" xml:"InstrumentType"`
 	// @gotags: xml:"HasMadeFeaturedContribution"
-	HasMadeFeaturedContribution bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
+	HasMadeFeaturedContribution *bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
 	// @gotags: xml:"HasMadeContractedContribution"
-	HasMadeContractedContribution bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
+	HasMadeContractedContribution *bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,5,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
-	// @gotags: xml:"PartyId"
-	PartyId []*DetailedPartyId `protobuf:"bytes,6,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName []*PartyName `protobuf:"bytes,7,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2792,6 +2823,20 @@ func (*DetailedResourceContributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v43_v43_proto_rawDescGZIP(), []int{30}
 }
 
+func (x *DetailedResourceContributor) GetPartyId() []*DetailedPartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *DetailedResourceContributor) GetPartyName() []*PartyName {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
 func (x *DetailedResourceContributor) GetRole() []*ContributorRole {
 	if x != nil {
 		return x.Role
@@ -2807,15 +2852,15 @@ func (x *DetailedResourceContributor) GetInstrumentType() []*InstrumentType {
 }
 
 func (x *DetailedResourceContributor) GetHasMadeFeaturedContribution() bool {
-	if x != nil {
-		return x.HasMadeFeaturedContribution
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
 	}
 	return false
 }
 
 func (x *DetailedResourceContributor) GetHasMadeContractedContribution() bool {
-	if x != nil {
-		return x.HasMadeContractedContribution
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
 	}
 	return false
 }
@@ -2827,37 +2872,23 @@ func (x *DetailedResourceContributor) GetDisplayCredits() []*DisplayCredits {
 	return nil
 }
 
-func (x *DetailedResourceContributor) GetPartyId() []*DetailedPartyId {
-	if x != nil {
-		return x.PartyId
+func (x *DetailedResourceContributor) GetSequenceNumber() int32 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
-	return nil
-}
-
-func (x *DetailedResourceContributor) GetPartyName() []*PartyName {
-	if x != nil {
-		return x.PartyName
-	}
-	return nil
-}
-
-func (x *DetailedResourceContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
-	}
-	return 0
+	return 0
 }
 
 type DiscoverableUseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UseTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UseTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"IsDiscoverable,attr"
-	IsDiscoverable bool `protobuf:"varint,3,opt,name=is_discoverable,json=isDiscoverable,proto3" json:"is_discoverable,omitempty" xml:"IsDiscoverable,attr"`
+	IsDiscoverable *bool `protobuf:"varint,3,opt,name=is_discoverable,json=isDiscoverable,proto3,oneof" json:"is_discoverable,omitempty" xml:"IsDiscoverable,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2900,22 +2931,22 @@ func (x *DiscoverableUseType) GetValue() string {
 }
 
 func (x *DiscoverableUseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DiscoverableUseType) GetIsDiscoverable() bool {
-	if x != nil {
-		return x.IsDiscoverable
+	if x != nil && x.IsDiscoverable != nil {
+		return *x.IsDiscoverable
 	}
 	return false
 }
 
 func (x *DiscoverableUseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -2931,7 +2962,7 @@ type DisplayArtist struct {
 	// @gotags: xml:"TitleDisplayInformation"
 	TitleDisplayInformation []*TitleDisplayInformation `protobuf:"bytes,4,rep,name=title_display_information,json=titleDisplayInformation,proto3" json:"title_display_information,omitempty" xml:"TitleDisplayInformation"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2995,8 +3026,8 @@ func (x *DisplayArtist) GetTitleDisplayInformation() []*TitleDisplayInformation
 }
 
 func (x *DisplayArtist) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -3006,13 +3037,13 @@ type DisplayArtistNameWithDefault struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,3,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,3,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3055,29 +3086,29 @@ func (x *DisplayArtistNameWithDefault) GetValue() string {
 }
 
 func (x *DisplayArtistNameWithDefault) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithDefault) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
 
 func (x *DisplayArtistNameWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -3087,11 +3118,11 @@ type DisplaySubTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	// @gotags: xml:"IsDisplayedInTitle,attr"
-	IsDisplayedInTitle bool `protobuf:"varint,3,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
-	// @gotags: xml:"SubTitleType,attr"
-	SubTitleType  string `protobuf:"bytes,4,opt,name=sub_title_type,json=subTitleType,proto3" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
+	IsDisplayedInTitle *bool `protobuf:"varint,3,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3,oneof" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
+	// @gotags: xml:"SubTitleType,attr" avs:"SubTitleType"
+	SubTitleType  *string `protobuf:"bytes,4,opt,name=sub_title_type,json=subTitleType,proto3,oneof" json:"sub_title_type,omitempty" xml:"SubTitleType,attr" avs:"SubTitleType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3134,22 +3165,22 @@ func (x *DisplaySubTitle) GetValue() string {
 }
 
 func (x *DisplaySubTitle) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *DisplaySubTitle) GetIsDisplayedInTitle() bool {
-	if x != nil {
-		return x.IsDisplayedInTitle
+	if x != nil && x.IsDisplayedInTitle != nil {
+		return *x.IsDisplayedInTitle
 	}
 	return false
 }
 
 func (x *DisplaySubTitle) GetSubTitleType() string {
-	if x != nil {
-		return x.SubTitleType
+	if x != nil && x.SubTitleType != nil {
+		return *x.SubTitleType
 	}
 	return ""
 }
@@ -3161,13 +3192,13 @@ type DisplayTitle struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle []*DisplaySubTitle `protobuf:"bytes,2,rep,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,6,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,6,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -3217,29 +3248,29 @@ func (x *DisplayTitle) GetSubTitle() []*DisplaySubTitle {
 }
 
 func (x *DisplayTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *DisplayTitle) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
@@ -3249,13 +3280,13 @@ type DisplayTitleText struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,5,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,5,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -3298,29 +3329,29 @@ func (x *DisplayTitleText) GetValue() string {
 }
 
 func (x *DisplayTitleText) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayTitleText) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayTitleText) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *DisplayTitleText) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
@@ -3332,9 +3363,9 @@ type DistributionChannelPage struct {
 	// @gotags: xml:"PageName"
 	PageName *Name `protobuf:"bytes,2,opt,name=page_name,json=pageName,proto3" json:"page_name,omitempty" xml:"PageName"`
 	// @gotags: xml:"URL"
-	URL string `protobuf:"bytes,3,opt,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
+	URL *string `protobuf:"bytes,3,opt,name=u_r_l,json=uRL,proto3,oneof" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"UserName"
-	UserName      string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty" xml:"UserName"`
+	UserName      *string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty" xml:"UserName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3384,15 +3415,15 @@ func (x *DistributionChannelPage) GetPageName() *Name {
 }
 
 func (x *DistributionChannelPage) GetURL() string {
-	if x != nil {
-		return x.URL
+	if x != nil && x.URL != nil {
+		return *x.URL
 	}
 	return ""
 }
 
 func (x *DistributionChannelPage) GetUserName() string {
-	if x != nil {
-		return x.UserName
+	if x != nil && x.UserName != nil {
+		return *x.UserName
 	}
 	return ""
 }
@@ -3404,15 +3435,15 @@ type EditionContributor struct {
 	// @gotags: xml:"Role"
 	Role []*ContributorRole `protobuf:"bytes,2,rep,name=role,proto3" json:"role,omitempty" xml:"Role"`
 	// @gotags: xml:"HasMadeFeaturedContribution"
-	HasMadeFeaturedContribution bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
+	HasMadeFeaturedContribution *bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
 	// @gotags: xml:"HasMadeContractedContribution"
-	HasMadeContractedContribution bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
+	HasMadeContractedContribution *bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
 	// @gotags: xml:"IsCredited"
 	IsCredited *IsCredited `protobuf:"bytes,5,opt,name=is_credited,json=isCredited,proto3" json:"is_credited,omitempty" xml:"IsCredited"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,6,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3462,15 +3493,15 @@ func (x *EditionContributor) GetRole() []*ContributorRole {
 }
 
 func (x *EditionContributor) GetHasMadeFeaturedContribution() bool {
-	if x != nil {
-		return x.HasMadeFeaturedContribution
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
 	}
 	return false
 }
 
 func (x *EditionContributor) GetHasMadeContractedContribution() bool {
-	if x != nil {
-		return x.HasMadeContractedContribution
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
 	}
 	return false
 }
@@ -3490,8 +3521,8 @@ func (x *EditionContributor) GetDisplayCredits() []*DisplayCredits {
 }
 
 func (x *EditionContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -3501,13 +3532,13 @@ type EventDateTimeWithoutFlags struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3550,29 +3581,29 @@ func (x *EventDateTimeWithoutFlags) GetValue() string {
 }
 
 func (x *EventDateTimeWithoutFlags) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateTimeWithoutFlags) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateTimeWithoutFlags) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateTimeWithoutFlags) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3582,13 +3613,13 @@ type EventDateWithCurrentTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3631,29 +3662,29 @@ func (x *EventDateWithCurrentTerritory) GetValue() string {
 }
 
 func (x *EventDateWithCurrentTerritory) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateWithCurrentTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateWithCurrentTerritory) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateWithCurrentTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3663,11 +3694,11 @@ type EventDateWithDefault struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3710,22 +3741,22 @@ func (x *EventDateWithDefault) GetValue() string {
 }
 
 func (x *EventDateWithDefault) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -3735,13 +3766,13 @@ type EventDateWithoutFlags struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3784,29 +3815,29 @@ func (x *EventDateWithoutFlags) GetValue() string {
 }
 
 func (x *EventDateWithoutFlags) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateWithoutFlags) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateWithoutFlags) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateWithoutFlags) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3818,11 +3849,11 @@ type ExternalResourceLink struct {
 	// @gotags: xml:"ValidityPeriod"
 	ValidityPeriod *PeriodWithoutFlags `protobuf:"bytes,2,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
 	// @gotags: xml:"ExternalLink"
-	ExternalLink string `protobuf:"bytes,3,opt,name=external_link,json=externalLink,proto3" json:"external_link,omitempty" xml:"ExternalLink"`
+	ExternalLink *string `protobuf:"bytes,3,opt,name=external_link,json=externalLink,proto3,oneof" json:"external_link,omitempty" xml:"ExternalLink"`
 	// @gotags: xml:"ExternallyLinkedResourceType"
 	ExternallyLinkedResourceType []*ExternallyLinkedResourceType `protobuf:"bytes,4,rep,name=externally_linked_resource_type,json=externallyLinkedResourceType,proto3" json:"externally_linked_resource_type,omitempty" xml:"ExternallyLinkedResourceType"`
 	// @gotags: xml:"FileFormat"
-	FileFormat    string `protobuf:"bytes,5,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty" xml:"FileFormat"`
+	FileFormat    *string `protobuf:"bytes,5,opt,name=file_format,json=fileFormat,proto3,oneof" json:"file_format,omitempty" xml:"FileFormat"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3872,8 +3903,8 @@ func (x *ExternalResourceLink) GetValidityPeriod() *PeriodWithoutFlags {
 }
 
 func (x *ExternalResourceLink) GetExternalLink() string {
-	if x != nil {
-		return x.ExternalLink
+	if x != nil && x.ExternalLink != nil {
+		return *x.ExternalLink
 	}
 	return ""
 }
@@ -3886,8 +3917,8 @@ func (x *ExternalResourceLink) GetExternallyLinkedResourceType() []*ExternallyLi
 }
 
 func (x *ExternalResourceLink) GetFileFormat() string {
-	if x != nil {
-		return x.FileFormat
+	if x != nil && x.FileFormat != nil {
+		return *x.FileFormat
 	}
 	return ""
 }
@@ -3897,13 +3928,13 @@ type Fingerprint struct {
 	// @gotags: xml:"Algorithm"
 	Algorithm *FingerprintAlgorithmType `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" xml:"Algorithm"`
 	// @gotags: xml:"Version"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version"`
 	// @gotags: xml:"Parameter"
-	Parameter string `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty" xml:"Parameter"`
+	Parameter *string `protobuf:"bytes,3,opt,name=parameter,proto3,oneof" json:"parameter,omitempty" xml:"Parameter"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
-	// @gotags: xml:"DataType"
-	DataType string `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType"`
+	// @gotags: xml:"DataType" avs:"BinaryDataType"
+	DataType string `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType" avs:"BinaryDataType"`
 	// @gotags: xml:"FingerprintValue"
 	FingerprintValue string `protobuf:"bytes,6,opt,name=fingerprint_value,json=fingerprintValue,proto3" json:"fingerprint_value,omitempty" xml:"FingerprintValue"`
 	unknownFields    protoimpl.UnknownFields
@@ -3948,15 +3979,15 @@ func (x *Fingerprint) GetAlgorithm() *FingerprintAlgorithmType {
 }
 
 func (x *Fingerprint) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *Fingerprint) GetParameter() string {
-	if x != nil {
-		return x.Parameter
+	if x != nil && x.Parameter != nil {
+		return *x.Parameter
 	}
 	return ""
 }
@@ -3984,12 +4015,12 @@ func (x *Fingerprint) GetFingerprintValue() string {
 
 type HdrVideoDynamicMetadataType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"HdrVideoDynamicMetadataType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"HdrVideoDynamicMetadataType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"SdrDerivationPermitted,attr"
-	SdrDerivationPermitted bool `protobuf:"varint,3,opt,name=sdr_derivation_permitted,json=sdrDerivationPermitted,proto3" json:"sdr_derivation_permitted,omitempty" xml:"SdrDerivationPermitted,attr"`
+	SdrDerivationPermitted *bool `protobuf:"varint,3,opt,name=sdr_derivation_permitted,json=sdrDerivationPermitted,proto3,oneof" json:"sdr_derivation_permitted,omitempty" xml:"SdrDerivationPermitted,attr"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -4032,15 +4063,15 @@ func (x *HdrVideoDynamicMetadataType) GetValue() string {
 }
 
 func (x *HdrVideoDynamicMetadataType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *HdrVideoDynamicMetadataType) GetSdrDerivationPermitted() bool {
-	if x != nil {
-		return x.SdrDerivationPermitted
+	if x != nil && x.SdrDerivationPermitted != nil {
+		return *x.SdrDerivationPermitted
 	}
 	return false
 }
@@ -4086,15 +4117,15 @@ type Image struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,19,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,20,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,20,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"Description"
 	Description []*DescriptionWithTerritory `protobuf:"bytes,21,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalImageDetails `protobuf:"bytes,22,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,23,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,23,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,24,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,24,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -4263,8 +4294,8 @@ func (x *Image) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Image) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
@@ -4284,15 +4315,15 @@ func (x *Image) GetTechnicalDetails() []*TechnicalImageDetails {
 }
 
 func (x *Image) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Image) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -4302,7 +4333,7 @@ type IsCredited struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"MayBeShared,attr"
-	MayBeShared   bool `protobuf:"varint,2,opt,name=may_be_shared,json=mayBeShared,proto3" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
+	MayBeShared   *bool `protobuf:"varint,2,opt,name=may_be_shared,json=mayBeShared,proto3,oneof" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4345,8 +4376,8 @@ func (x *IsCredited) GetValue() string {
 }
 
 func (x *IsCredited) GetMayBeShared() bool {
-	if x != nil {
-		return x.MayBeShared
+	if x != nil && x.MayBeShared != nil {
+		return *x.MayBeShared
 	}
 	return false
 }
@@ -4355,18 +4386,18 @@ type LinkedReleaseResourceReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"LinkDescription,attr"
-	LinkDescription string `protobuf:"bytes,2,opt,name=link_description,json=linkDescription,proto3" json:"link_description,omitempty" xml:"LinkDescription,attr"`
+	// @gotags: xml:"LinkDescription,attr" avs:"LinkDescription"
+	LinkDescription *string `protobuf:"bytes,2,opt,name=link_description,json=linkDescription,proto3,oneof" json:"link_description,omitempty" xml:"LinkDescription,attr" avs:"LinkDescription"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,5,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,5,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	// @gotags: xml:"IsMultiFile,attr"
-	IsMultiFile   bool `protobuf:"varint,7,opt,name=is_multi_file,json=isMultiFile,proto3" json:"is_multi_file,omitempty" xml:"IsMultiFile,attr"`
+	IsMultiFile   *bool `protobuf:"varint,7,opt,name=is_multi_file,json=isMultiFile,proto3,oneof" json:"is_multi_file,omitempty" xml:"IsMultiFile,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4409,43 +4440,43 @@ func (x *LinkedReleaseResourceReference) GetValue() string {
 }
 
 func (x *LinkedReleaseResourceReference) GetLinkDescription() string {
-	if x != nil {
-		return x.LinkDescription
+	if x != nil && x.LinkDescription != nil {
+		return *x.LinkDescription
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *LinkedReleaseResourceReference) GetIsMultiFile() bool {
-	if x != nil {
-		return x.IsMultiFile
+	if x != nil && x.IsMultiFile != nil {
+		return *x.IsMultiFile
 	}
 	return false
 }
@@ -4535,18 +4566,18 @@ type Party struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"PartyReference"
 	PartyReference string `protobuf:"bytes,1,opt,name=party_reference,json=partyReference,proto3" json:"party_reference,omitempty" xml:"PartyReference"`
+	// @gotags: xml:"PartyId"
+	PartyId []*DetailedPartyId `protobuf:"bytes,5,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyNameWithTerritory `protobuf:"bytes,6,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"Affiliation"
 	Affiliation []*Affiliation `protobuf:"bytes,2,rep,name=affiliation,proto3" json:"affiliation,omitempty" xml:"Affiliation"`
 	// @gotags: xml:"RelatedParty"
 	RelatedParty []*RelatedParty `protobuf:"bytes,3,rep,name=related_party,json=relatedParty,proto3" json:"related_party,omitempty" xml:"RelatedParty"`
 	// @gotags: xml:"ArtistProfilePage"
 	ArtistProfilePage []string `protobuf:"bytes,4,rep,name=artist_profile_page,json=artistProfilePage,proto3" json:"artist_profile_page,omitempty" xml:"ArtistProfilePage"`
-	// @gotags: xml:"PartyId"
-	PartyId []*DetailedPartyId `protobuf:"bytes,5,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName     []*PartyNameWithTerritory `protobuf:"bytes,6,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Party) Reset() {
@@ -4586,37 +4617,37 @@ func (x *Party) GetPartyReference() string {
 	return ""
 }
 
-func (x *Party) GetAffiliation() []*Affiliation {
+func (x *Party) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.Affiliation
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *Party) GetRelatedParty() []*RelatedParty {
+func (x *Party) GetPartyName() []*PartyNameWithTerritory {
 	if x != nil {
-		return x.RelatedParty
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *Party) GetArtistProfilePage() []string {
+func (x *Party) GetAffiliation() []*Affiliation {
 	if x != nil {
-		return x.ArtistProfilePage
+		return x.Affiliation
 	}
 	return nil
 }
 
-func (x *Party) GetPartyId() []*DetailedPartyId {
+func (x *Party) GetRelatedParty() []*RelatedParty {
 	if x != nil {
-		return x.PartyId
+		return x.RelatedParty
 	}
 	return nil
 }
 
-func (x *Party) GetPartyName() []*PartyNameWithTerritory {
+func (x *Party) GetArtistProfilePage() []string {
 	if x != nil {
-		return x.PartyName
+		return x.ArtistProfilePage
 	}
 	return nil
 }
@@ -4671,7 +4702,7 @@ type PartyNameWithTerritory struct {
 	// @gotags: xml:"FullName"
 	FullName *Name `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
 	FullNameIndexed *Name `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
@@ -4683,19 +4714,19 @@ type PartyNameWithTerritory struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *Name `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsNickname,attr"
-	IsNickname bool `protobuf:"varint,9,opt,name=is_nickname,json=isNickname,proto3" json:"is_nickname,omitempty" xml:"IsNickname,attr"`
+	IsNickname *bool `protobuf:"varint,9,opt,name=is_nickname,json=isNickname,proto3,oneof" json:"is_nickname,omitempty" xml:"IsNickname,attr"`
 	// @gotags: xml:"IsStageName,attr"
-	IsStageName bool `protobuf:"varint,10,opt,name=is_stage_name,json=isStageName,proto3" json:"is_stage_name,omitempty" xml:"IsStageName,attr"`
+	IsStageName *bool `protobuf:"varint,10,opt,name=is_stage_name,json=isStageName,proto3,oneof" json:"is_stage_name,omitempty" xml:"IsStageName,attr"`
 	// @gotags: xml:"IsLegalName,attr"
-	IsLegalName bool `protobuf:"varint,11,opt,name=is_legal_name,json=isLegalName,proto3" json:"is_legal_name,omitempty" xml:"IsLegalName,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,12,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsLegalName *bool `protobuf:"varint,11,opt,name=is_legal_name,json=isLegalName,proto3,oneof" json:"is_legal_name,omitempty" xml:"IsLegalName,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,12,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,13,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,13,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,14,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,14,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -4738,8 +4769,8 @@ func (x *PartyNameWithTerritory) GetFullName() *Name {
 }
 
 func (x *PartyNameWithTerritory) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
@@ -4780,50 +4811,50 @@ func (x *PartyNameWithTerritory) GetAbbreviatedName() *Name {
 }
 
 func (x *PartyNameWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PartyNameWithTerritory) GetIsNickname() bool {
-	if x != nil {
-		return x.IsNickname
+	if x != nil && x.IsNickname != nil {
+		return *x.IsNickname
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetIsStageName() bool {
-	if x != nil {
-		return x.IsStageName
+	if x != nil && x.IsStageName != nil {
+		return *x.IsStageName
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetIsLegalName() bool {
-	if x != nil {
-		return x.IsLegalName
+	if x != nil && x.IsLegalName != nil {
+		return *x.IsLegalName
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *PartyNameWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
@@ -4831,13 +4862,13 @@ func (x *PartyNameWithTerritory) GetIsInOriginalLanguage() bool {
 type PartyWithRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,5,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"PartyName"
@@ -4879,29 +4910,29 @@ func (*PartyWithRole) Descriptor() ([]byte, []int) {
 }
 
 func (x *PartyWithRole) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *PartyWithRole) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *PartyWithRole) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *PartyWithRole) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
@@ -5076,7 +5107,7 @@ type PhysicalReturns struct {
 	// @gotags: xml:"PhysicalReturnsAllowed"
 	PhysicalReturnsAllowed bool `protobuf:"varint,1,opt,name=physical_returns_allowed,json=physicalReturnsAllowed,proto3" json:"physical_returns_allowed,omitempty" xml:"PhysicalReturnsAllowed"`
 	// @gotags: xml:"LatestDateForPhysicalReturns"
-	LatestDateForPhysicalReturns string `protobuf:"bytes,2,opt,name=latest_date_for_physical_returns,json=latestDateForPhysicalReturns,proto3" json:"latest_date_for_physical_returns,omitempty" xml:"LatestDateForPhysicalReturns"`
+	LatestDateForPhysicalReturns *string `protobuf:"bytes,2,opt,name=latest_date_for_physical_returns,json=latestDateForPhysicalReturns,proto3,oneof" json:"latest_date_for_physical_returns,omitempty" xml:"LatestDateForPhysicalReturns"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -5119,8 +5150,8 @@ func (x *PhysicalReturns) GetPhysicalReturnsAllowed() bool {
 }
 
 func (x *PhysicalReturns) GetLatestDateForPhysicalReturns() string {
-	if x != nil {
-		return x.LatestDateForPhysicalReturns
+	if x != nil && x.LatestDateForPhysicalReturns != nil {
+		return *x.LatestDateForPhysicalReturns
 	}
 	return ""
 }
@@ -5135,12 +5166,12 @@ type PriceInformationWithType struct {
 	BulkOrderWholesalePricePerUnit *Price `protobuf:"bytes,3,opt,name=bulk_order_wholesale_price_per_unit,json=bulkOrderWholesalePricePerUnit,proto3" json:"bulk_order_wholesale_price_per_unit,omitempty" xml:"BulkOrderWholesalePricePerUnit"`
 	// @gotags: xml:"SuggestedRetailPrice"
 	SuggestedRetailPrice *Price `protobuf:"bytes,4,opt,name=suggested_retail_price,json=suggestedRetailPrice,proto3" json:"suggested_retail_price,omitempty" xml:"SuggestedRetailPrice"`
-	// @gotags: xml:"PriceType,attr"
-	PriceType string `protobuf:"bytes,5,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty" xml:"PriceType,attr"`
+	// @gotags: xml:"PriceType,attr" avs:"PriceInformationType"
+	PriceType *string `protobuf:"bytes,5,opt,name=price_type,json=priceType,proto3,oneof" json:"price_type,omitempty" xml:"PriceType,attr" avs:"PriceInformationType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -5204,22 +5235,22 @@ func (x *PriceInformationWithType) GetSuggestedRetailPrice() *Price {
 }
 
 func (x *PriceInformationWithType) GetPriceType() string {
-	if x != nil {
-		return x.PriceType
+	if x != nil && x.PriceType != nil {
+		return *x.PriceType
 	}
 	return ""
 }
 
 func (x *PriceInformationWithType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PriceInformationWithType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -5291,10 +5322,10 @@ type Raga struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5337,27 +5368,27 @@ func (x *Raga) GetValue() string {
 }
 
 func (x *Raga) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *Raga) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type RecordingFormat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RecordingFormat"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RecordingFormat"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -5400,15 +5431,15 @@ func (x *RecordingFormat) GetValue() string {
 }
 
 func (x *RecordingFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RecordingFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -5532,14 +5563,14 @@ func (x *RelatedRelease) GetOriginalReleaseDate() *EventDateWithoutFlags {
 
 type RelatedResource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"ResourceRelationshipType"
-	ResourceRelationshipType string `protobuf:"bytes,1,opt,name=resource_relationship_type,json=resourceRelationshipType,proto3" json:"resource_relationship_type,omitempty" xml:"ResourceRelationshipType"`
-	// @gotags: xml:"Timing"
-	Timing []*Timing `protobuf:"bytes,2,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
+	// @gotags: xml:"ResourceRelationshipType" avs:"ResourceRelationshipType"
+	ResourceRelationshipType string `protobuf:"bytes,1,opt,name=resource_relationship_type,json=resourceRelationshipType,proto3" json:"resource_relationship_type,omitempty" xml:"ResourceRelationshipType" avs:"ResourceRelationshipType"`
 	// @gotags: xml:"ResourceRelatedResourceReference"
 	ResourceRelatedResourceReference string `protobuf:"bytes,3,opt,name=resource_related_resource_reference,json=resourceRelatedResourceReference,proto3" json:"resource_related_resource_reference,omitempty" xml:"ResourceRelatedResourceReference"`
 	// @gotags: xml:"ResourceId"
-	ResourceId    *ResourceId `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
+	ResourceId *ResourceId `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
+	// @gotags: xml:"Timing"
+	Timing        []*Timing `protobuf:"bytes,2,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5581,13 +5612,6 @@ func (x *RelatedResource) GetResourceRelationshipType() string {
 	return ""
 }
 
-func (x *RelatedResource) GetTiming() []*Timing {
-	if x != nil {
-		return x.Timing
-	}
-	return nil
-}
-
 func (x *RelatedResource) GetResourceRelatedResourceReference() string {
 	if x != nil {
 		return x.ResourceRelatedResourceReference
@@ -5602,6 +5626,13 @@ func (x *RelatedResource) GetResourceId() *ResourceId {
 	return nil
 }
 
+func (x *RelatedResource) GetTiming() []*Timing {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
 type Release struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ReleaseReference"
@@ -5633,7 +5664,7 @@ type Release struct {
 	// @gotags: xml:"CourtesyLine"
 	CourtesyLine []*CourtesyLineWithDefault `protobuf:"bytes,14,rep,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty" xml:"CourtesyLine"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,15,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,15,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"Genre"
 	Genre []*GenreWithTerritory `protobuf:"bytes,16,rep,name=genre,proto3" json:"genre,omitempty" xml:"Genre"`
 	// @gotags: xml:"ReleaseDate"
@@ -5650,12 +5681,16 @@ type Release struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,22,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,23,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
+	// @gotags: xml:"IsSingleArtistCompilation"
+	IsSingleArtistCompilation bool `protobuf:"varint,36,opt,name=is_single_artist_compilation,json=isSingleArtistCompilation,proto3" json:"is_single_artist_compilation,omitempty" xml:"IsSingleArtistCompilation"`
+	// @gotags: xml:"IsMultiArtistCompilation"
+	IsMultiArtistCompilation bool `protobuf:"varint,37,opt,name=is_multi_artist_compilation,json=isMultiArtistCompilation,proto3" json:"is_multi_artist_compilation,omitempty" xml:"IsMultiArtistCompilation"`
 	// @gotags: xml:"ResourceGroup"
 	ResourceGroup *ResourceGroup `protobuf:"bytes,24,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty" xml:"ResourceGroup"`
 	// @gotags: xml:"ExternalResourceLink"
 	ExternalResourceLink []*ExternalResourceLink `protobuf:"bytes,25,rep,name=external_resource_link,json=externalResourceLink,proto3" json:"external_resource_link,omitempty" xml:"ExternalResourceLink"`
 	// @gotags: xml:"TargetURL"
-	TargetURL string `protobuf:"bytes,26,opt,name=target_u_r_l,json=targetURL,proto3" json:"target_u_r_l,omitempty" xml:"TargetURL"`
+	TargetURL *string `protobuf:"bytes,26,opt,name=target_u_r_l,json=targetURL,proto3,oneof" json:"target_u_r_l,omitempty" xml:"TargetURL"`
 	// @gotags: xml:"Keywords"
 	Keywords []*KeywordsWithTerritory `protobuf:"bytes,27,rep,name=keywords,proto3" json:"keywords,omitempty" xml:"Keywords"`
 	// @gotags: xml:"Synopsis"
@@ -5667,19 +5702,15 @@ type Release struct {
 	// @gotags: xml:"Deity"
 	Deity []*Deity `protobuf:"bytes,31,rep,name=deity,proto3" json:"deity,omitempty" xml:"Deity"`
 	// @gotags: xml:"HiResMusicDescription"
-	HiResMusicDescription string `protobuf:"bytes,32,opt,name=hi_res_music_description,json=hiResMusicDescription,proto3" json:"hi_res_music_description,omitempty" xml:"HiResMusicDescription"`
+	HiResMusicDescription *string `protobuf:"bytes,32,opt,name=hi_res_music_description,json=hiResMusicDescription,proto3,oneof" json:"hi_res_music_description,omitempty" xml:"HiResMusicDescription"`
 	// @gotags: xml:"IsSoundtrack"
-	IsSoundtrack bool `protobuf:"varint,33,opt,name=is_soundtrack,json=isSoundtrack,proto3" json:"is_soundtrack,omitempty" xml:"IsSoundtrack"`
+	IsSoundtrack *bool `protobuf:"varint,33,opt,name=is_soundtrack,json=isSoundtrack,proto3,oneof" json:"is_soundtrack,omitempty" xml:"IsSoundtrack"`
 	// @gotags: xml:"IsHiResMusic"
-	IsHiResMusic bool `protobuf:"varint,34,opt,name=is_hi_res_music,json=isHiResMusic,proto3" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
+	IsHiResMusic *bool `protobuf:"varint,34,opt,name=is_hi_res_music,json=isHiResMusic,proto3,oneof" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
 	// @gotags: xml:"MarketingComment"
 	MarketingComment []*MarketingComment `protobuf:"bytes,35,rep,name=marketing_comment,json=marketingComment,proto3" json:"marketing_comment,omitempty" xml:"MarketingComment"`
-	// @gotags: xml:"IsSingleArtistCompilation"
-	IsSingleArtistCompilation bool `protobuf:"varint,36,opt,name=is_single_artist_compilation,json=isSingleArtistCompilation,proto3" json:"is_single_artist_compilation,omitempty" xml:"IsSingleArtistCompilation"`
-	// @gotags: xml:"IsMultiArtistCompilation"
-	IsMultiArtistCompilation bool `protobuf:"varint,37,opt,name=is_multi_artist_compilation,json=isMultiArtistCompilation,proto3" json:"is_multi_artist_compilation,omitempty" xml:"IsMultiArtistCompilation"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,38,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,38,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5813,8 +5844,8 @@ func (x *Release) GetCourtesyLine() []*CourtesyLineWithDefault {
 }
 
 func (x *Release) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
@@ -5875,6 +5906,20 @@ func (x *Release) GetRelatedResource() []*RelatedResource {
 	return nil
 }
 
+func (x *Release) GetIsSingleArtistCompilation() bool {
+	if x != nil {
+		return x.IsSingleArtistCompilation
+	}
+	return false
+}
+
+func (x *Release) GetIsMultiArtistCompilation() bool {
+	if x != nil {
+		return x.IsMultiArtistCompilation
+	}
+	return false
+}
+
 func (x *Release) GetResourceGroup() *ResourceGroup {
 	if x != nil {
 		return x.ResourceGroup
@@ -5890,8 +5935,8 @@ func (x *Release) GetExternalResourceLink() []*ExternalResourceLink {
 }
 
 func (x *Release) GetTargetURL() string {
-	if x != nil {
-		return x.TargetURL
+	if x != nil && x.TargetURL != nil {
+		return *x.TargetURL
 	}
 	return ""
 }
@@ -5932,22 +5977,22 @@ func (x *Release) GetDeity() []*Deity {
 }
 
 func (x *Release) GetHiResMusicDescription() string {
-	if x != nil {
-		return x.HiResMusicDescription
+	if x != nil && x.HiResMusicDescription != nil {
+		return *x.HiResMusicDescription
 	}
 	return ""
 }
 
 func (x *Release) GetIsSoundtrack() bool {
-	if x != nil {
-		return x.IsSoundtrack
+	if x != nil && x.IsSoundtrack != nil {
+		return *x.IsSoundtrack
 	}
 	return false
 }
 
 func (x *Release) GetIsHiResMusic() bool {
-	if x != nil {
-		return x.IsHiResMusic
+	if x != nil && x.IsHiResMusic != nil {
+		return *x.IsHiResMusic
 	}
 	return false
 }
@@ -5959,23 +6004,9 @@ func (x *Release) GetMarketingComment() []*MarketingComment {
 	return nil
 }
 
-func (x *Release) GetIsSingleArtistCompilation() bool {
-	if x != nil {
-		return x.IsSingleArtistCompilation
-	}
-	return false
-}
-
-func (x *Release) GetIsMultiArtistCompilation() bool {
-	if x != nil {
-		return x.IsMultiArtistCompilation
-	}
-	return false
-}
-
 func (x *Release) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -5985,7 +6016,7 @@ type ReleaseAdmin struct {
 	// @gotags: xml:"ReleaseAdminId"
 	ReleaseAdminId string `protobuf:"bytes,1,opt,name=release_admin_id,json=releaseAdminId,proto3" json:"release_admin_id,omitempty" xml:"ReleaseAdminId"`
 	// @gotags: xml:"PersonnelDescription"
-	PersonnelDescription string `protobuf:"bytes,2,opt,name=personnel_description,json=personnelDescription,proto3" json:"personnel_description,omitempty" xml:"PersonnelDescription"`
+	PersonnelDescription *string `protobuf:"bytes,2,opt,name=personnel_description,json=personnelDescription,proto3,oneof" json:"personnel_description,omitempty" xml:"PersonnelDescription"`
 	// @gotags: xml:"SystemDescription"
 	SystemDescription []string `protobuf:"bytes,3,rep,name=system_description,json=systemDescription,proto3" json:"system_description,omitempty" xml:"SystemDescription"`
 	unknownFields     protoimpl.UnknownFields
@@ -6030,8 +6061,8 @@ func (x *ReleaseAdmin) GetReleaseAdminId() string {
 }
 
 func (x *ReleaseAdmin) GetPersonnelDescription() string {
-	if x != nil {
-		return x.PersonnelDescription
+	if x != nil && x.PersonnelDescription != nil {
+		return *x.PersonnelDescription
 	}
 	return ""
 }
@@ -6100,9 +6131,9 @@ func (x *ReleaseDeal) GetDeal() []*Deal {
 type ReleaseId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ICPN"
-	ICPN string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
+	ICPN *string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3,oneof" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,3,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -6142,15 +6173,15 @@ func (*ReleaseId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ReleaseId) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *ReleaseId) GetICPN() string {
-	if x != nil {
-		return x.ICPN
+	if x != nil && x.ICPN != nil {
+		return *x.ICPN
 	}
 	return ""
 }
@@ -6174,17 +6205,17 @@ type ReleaseLabelReference struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"LabelType,attr"
-	LabelType string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3" json:"label_type,omitempty" xml:"LabelType,attr"`
+	IsDefault *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"LabelType,attr" avs:"LabelType"
+	LabelType *string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3,oneof" json:"label_type,omitempty" xml:"LabelType,attr" avs:"LabelType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -6227,43 +6258,43 @@ func (x *ReleaseLabelReference) GetValue() string {
 }
 
 func (x *ReleaseLabelReference) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *ReleaseLabelReference) GetLabelType() string {
-	if x != nil {
-		return x.LabelType
+	if x != nil && x.LabelType != nil {
+		return *x.LabelType
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -6273,19 +6304,19 @@ type ReleaseLabelReferenceWithParty struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"LabelType,attr"
-	LabelType string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3" json:"label_type,omitempty" xml:"LabelType,attr"`
+	IsDefault *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"LabelType,attr" avs:"LabelType"
+	LabelType *string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3,oneof" json:"label_type,omitempty" xml:"LabelType,attr" avs:"LabelType"`
 	// @gotags: xml:"AccessControlParty,attr"
-	AccessControlParty string `protobuf:"bytes,5,opt,name=access_control_party,json=accessControlParty,proto3" json:"access_control_party,omitempty" xml:"AccessControlParty,attr"`
+	AccessControlParty *string `protobuf:"bytes,5,opt,name=access_control_party,json=accessControlParty,proto3,oneof" json:"access_control_party,omitempty" xml:"AccessControlParty,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,8,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,8,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -6328,50 +6359,50 @@ func (x *ReleaseLabelReferenceWithParty) GetValue() string {
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetLabelType() string {
-	if x != nil {
-		return x.LabelType
+	if x != nil && x.LabelType != nil {
+		return *x.LabelType
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetAccessControlParty() string {
-	if x != nil {
-		return x.AccessControlParty
+	if x != nil && x.AccessControlParty != nil {
+		return *x.AccessControlParty
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -6443,20 +6474,20 @@ type ReleaseVisibility struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VisibilityReference"
 	VisibilityReference string `protobuf:"bytes,1,opt,name=visibility_reference,json=visibilityReference,proto3" json:"visibility_reference,omitempty" xml:"VisibilityReference"`
-	// @gotags: xml:"ReleaseDisplayStartDateTime"
-	ReleaseDisplayStartDateTime string `protobuf:"bytes,2,opt,name=release_display_start_date_time,json=releaseDisplayStartDateTime,proto3" json:"release_display_start_date_time,omitempty" xml:"ReleaseDisplayStartDateTime"`
-	// @gotags: xml:"CoverArtPreviewStartDateTime"
-	CoverArtPreviewStartDateTime string `protobuf:"bytes,3,opt,name=cover_art_preview_start_date_time,json=coverArtPreviewStartDateTime,proto3" json:"cover_art_preview_start_date_time,omitempty" xml:"CoverArtPreviewStartDateTime"`
-	// @gotags: xml:"FullTrackListingPreviewStartDateTime"
-	FullTrackListingPreviewStartDateTime string `protobuf:"bytes,4,opt,name=full_track_listing_preview_start_date_time,json=fullTrackListingPreviewStartDateTime,proto3" json:"full_track_listing_preview_start_date_time,omitempty" xml:"FullTrackListingPreviewStartDateTime"`
-	// @gotags: xml:"ClipPreviewStartDateTime"
-	ClipPreviewStartDateTime string `protobuf:"bytes,5,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,6,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,7,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
+	// @gotags: xml:"ReleaseDisplayStartDateTime"
+	ReleaseDisplayStartDateTime *string `protobuf:"bytes,2,opt,name=release_display_start_date_time,json=releaseDisplayStartDateTime,proto3,oneof" json:"release_display_start_date_time,omitempty" xml:"ReleaseDisplayStartDateTime"`
+	// @gotags: xml:"CoverArtPreviewStartDateTime"
+	CoverArtPreviewStartDateTime *string `protobuf:"bytes,3,opt,name=cover_art_preview_start_date_time,json=coverArtPreviewStartDateTime,proto3,oneof" json:"cover_art_preview_start_date_time,omitempty" xml:"CoverArtPreviewStartDateTime"`
+	// @gotags: xml:"FullTrackListingPreviewStartDateTime"
+	FullTrackListingPreviewStartDateTime *string `protobuf:"bytes,4,opt,name=full_track_listing_preview_start_date_time,json=fullTrackListingPreviewStartDateTime,proto3,oneof" json:"full_track_listing_preview_start_date_time,omitempty" xml:"FullTrackListingPreviewStartDateTime"`
+	// @gotags: xml:"ClipPreviewStartDateTime"
+	ClipPreviewStartDateTime *string `protobuf:"bytes,5,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3,oneof" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"DoNotDisplayDates,attr"
-	DoNotDisplayDates bool `protobuf:"varint,8,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
+	DoNotDisplayDates *bool `protobuf:"varint,8,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3,oneof" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -6498,51 +6529,51 @@ func (x *ReleaseVisibility) GetVisibilityReference() string {
 	return ""
 }
 
-func (x *ReleaseVisibility) GetReleaseDisplayStartDateTime() string {
+func (x *ReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.ReleaseDisplayStartDateTime
+		return x.TerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *ReleaseVisibility) GetCoverArtPreviewStartDateTime() string {
+func (x *ReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.CoverArtPreviewStartDateTime
+		return x.ExcludedTerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *ReleaseVisibility) GetFullTrackListingPreviewStartDateTime() string {
-	if x != nil {
-		return x.FullTrackListingPreviewStartDateTime
+func (x *ReleaseVisibility) GetReleaseDisplayStartDateTime() string {
+	if x != nil && x.ReleaseDisplayStartDateTime != nil {
+		return *x.ReleaseDisplayStartDateTime
 	}
 	return ""
 }
 
-func (x *ReleaseVisibility) GetClipPreviewStartDateTime() string {
-	if x != nil {
-		return x.ClipPreviewStartDateTime
+func (x *ReleaseVisibility) GetCoverArtPreviewStartDateTime() string {
+	if x != nil && x.CoverArtPreviewStartDateTime != nil {
+		return *x.CoverArtPreviewStartDateTime
 	}
 	return ""
 }
 
-func (x *ReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
+func (x *ReleaseVisibility) GetFullTrackListingPreviewStartDateTime() string {
+	if x != nil && x.FullTrackListingPreviewStartDateTime != nil {
+		return *x.FullTrackListingPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
-func (x *ReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *ReleaseVisibility) GetClipPreviewStartDateTime() string {
+	if x != nil && x.ClipPreviewStartDateTime != nil {
+		return *x.ClipPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
 func (x *ReleaseVisibility) GetDoNotDisplayDates() bool {
-	if x != nil {
-		return x.DoNotDisplayDates
+	if x != nil && x.DoNotDisplayDates != nil {
+		return *x.DoNotDisplayDates
 	}
 	return false
 }
@@ -6556,29 +6587,29 @@ type ResourceGroup struct {
 	// @gotags: xml:"AdditionalTitle"
 	AdditionalTitle []*AdditionalTitle `protobuf:"bytes,3,rep,name=additional_title,json=additionalTitle,proto3" json:"additional_title,omitempty" xml:"AdditionalTitle"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	// @gotags: xml:"NoDisplaySequence"
+	NoDisplaySequence bool `protobuf:"varint,11,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
+	// @gotags: xml:"DisplaySequence"
+	DisplaySequence string `protobuf:"bytes,12,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
 	// @gotags: xml:"DisplayArtist"
 	DisplayArtist []*DisplayArtist `protobuf:"bytes,5,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty" xml:"DisplayArtist"`
 	// @gotags: xml:"CarrierType"
 	CarrierType []*CarrierType `protobuf:"bytes,6,rep,name=carrier_type,json=carrierType,proto3" json:"carrier_type,omitempty" xml:"CarrierType"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,7,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
+	// @gotags: xml:"ResourceGroupReleaseReference"
+	ResourceGroupReleaseReference string `protobuf:"bytes,13,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
+	// @gotags: xml:"ReleaseId"
+	ReleaseId *ReleaseId `protobuf:"bytes,14,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ResourceGroup"
 	ResourceGroup []*ResourceSubGroup `protobuf:"bytes,8,rep,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty" xml:"ResourceGroup"`
 	// @gotags: xml:"ResourceGroupContentItem"
 	ResourceGroupContentItem []*ResourceGroupContentItem `protobuf:"bytes,9,rep,name=resource_group_content_item,json=resourceGroupContentItem,proto3" json:"resource_group_content_item,omitempty" xml:"ResourceGroupContentItem"`
 	// @gotags: xml:"LinkedReleaseResourceReference"
 	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,10,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty" xml:"LinkedReleaseResourceReference"`
-	// @gotags: xml:"NoDisplaySequence"
-	NoDisplaySequence bool `protobuf:"varint,11,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
-	// @gotags: xml:"DisplaySequence"
-	DisplaySequence string `protobuf:"bytes,12,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
-	// @gotags: xml:"ResourceGroupReleaseReference"
-	ResourceGroupReleaseReference string `protobuf:"bytes,13,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
-	// @gotags: xml:"ReleaseId"
-	ReleaseId     *ReleaseId `protobuf:"bytes,14,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
 }
 
 func (x *ResourceGroup) Reset() {
@@ -6633,12 +6664,26 @@ func (x *ResourceGroup) GetAdditionalTitle() []*AdditionalTitle {
 }
 
 func (x *ResourceGroup) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
+func (x *ResourceGroup) GetNoDisplaySequence() bool {
+	if x != nil {
+		return x.NoDisplaySequence
+	}
+	return false
+}
+
+func (x *ResourceGroup) GetDisplaySequence() string {
+	if x != nil {
+		return x.DisplaySequence
+	}
+	return ""
+}
+
 func (x *ResourceGroup) GetDisplayArtist() []*DisplayArtist {
 	if x != nil {
 		return x.DisplayArtist
@@ -6654,57 +6699,43 @@ func (x *ResourceGroup) GetCarrierType() []*CarrierType {
 }
 
 func (x *ResourceGroup) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
-func (x *ResourceGroup) GetResourceGroup() []*ResourceSubGroup {
+func (x *ResourceGroup) GetResourceGroupReleaseReference() string {
 	if x != nil {
-		return x.ResourceGroup
+		return x.ResourceGroupReleaseReference
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
+func (x *ResourceGroup) GetReleaseId() *ReleaseId {
 	if x != nil {
-		return x.ResourceGroupContentItem
+		return x.ReleaseId
 	}
 	return nil
 }
 
-func (x *ResourceGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
+func (x *ResourceGroup) GetResourceGroup() []*ResourceSubGroup {
 	if x != nil {
-		return x.LinkedReleaseResourceReference
+		return x.ResourceGroup
 	}
 	return nil
 }
 
-func (x *ResourceGroup) GetNoDisplaySequence() bool {
-	if x != nil {
-		return x.NoDisplaySequence
-	}
-	return false
-}
-
-func (x *ResourceGroup) GetDisplaySequence() string {
-	if x != nil {
-		return x.DisplaySequence
-	}
-	return ""
-}
-
-func (x *ResourceGroup) GetResourceGroupReleaseReference() string {
+func (x *ResourceGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
 	if x != nil {
-		return x.ResourceGroupReleaseReference
+		return x.ResourceGroupContentItem
 	}
-	return ""
+	return nil
 }
 
-func (x *ResourceGroup) GetReleaseId() *ReleaseId {
+func (x *ResourceGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
 	if x != nil {
-		return x.ReleaseId
+		return x.LinkedReleaseResourceReference
 	}
 	return nil
 }
@@ -6712,23 +6743,23 @@ func (x *ResourceGroup) GetReleaseId() *ReleaseId {
 type ResourceGroupContentItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	// @gotags: xml:"NoDisplaySequence"
+	NoDisplaySequence bool `protobuf:"varint,7,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
+	// @gotags: xml:"DisplaySequence"
+	DisplaySequence string `protobuf:"bytes,8,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
 	// @gotags: xml:"ReleaseResourceReference"
 	ReleaseResourceReference string `protobuf:"bytes,2,opt,name=release_resource_reference,json=releaseResourceReference,proto3" json:"release_resource_reference,omitempty" xml:"ReleaseResourceReference"`
 	// @gotags: xml:"LinkedReleaseResourceReference"
 	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,3,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty" xml:"LinkedReleaseResourceReference"`
 	// @gotags: xml:"IsBonusResource"
-	IsBonusResource bool `protobuf:"varint,4,opt,name=is_bonus_resource,json=isBonusResource,proto3" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
+	IsBonusResource *bool `protobuf:"varint,4,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
 	// @gotags: xml:"IsInstantGratificationResource"
-	IsInstantGratificationResource bool `protobuf:"varint,5,opt,name=is_instant_gratification_resource,json=isInstantGratificationResource,proto3" json:"is_instant_gratification_resource,omitempty" xml:"IsInstantGratificationResource"`
+	IsInstantGratificationResource *bool `protobuf:"varint,5,opt,name=is_instant_gratification_resource,json=isInstantGratificationResource,proto3,oneof" json:"is_instant_gratification_resource,omitempty" xml:"IsInstantGratificationResource"`
 	// @gotags: xml:"IsPreOrderIncentiveResource"
-	IsPreOrderIncentiveResource bool `protobuf:"varint,6,opt,name=is_pre_order_incentive_resource,json=isPreOrderIncentiveResource,proto3" json:"is_pre_order_incentive_resource,omitempty" xml:"IsPreOrderIncentiveResource"`
-	// @gotags: xml:"NoDisplaySequence"
-	NoDisplaySequence bool `protobuf:"varint,7,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
-	// @gotags: xml:"DisplaySequence"
-	DisplaySequence string `protobuf:"bytes,8,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	IsPreOrderIncentiveResource *bool `protobuf:"varint,6,opt,name=is_pre_order_incentive_resource,json=isPreOrderIncentiveResource,proto3,oneof" json:"is_pre_order_incentive_resource,omitempty" xml:"IsPreOrderIncentiveResource"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *ResourceGroupContentItem) Reset() {
@@ -6762,12 +6793,26 @@ func (*ResourceGroupContentItem) Descriptor() ([]byte, []int) {
 }
 
 func (x *ResourceGroupContentItem) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
+func (x *ResourceGroupContentItem) GetNoDisplaySequence() bool {
+	if x != nil {
+		return x.NoDisplaySequence
+	}
+	return false
+}
+
+func (x *ResourceGroupContentItem) GetDisplaySequence() string {
+	if x != nil {
+		return x.DisplaySequence
+	}
+	return ""
+}
+
 func (x *ResourceGroupContentItem) GetReleaseResourceReference() string {
 	if x != nil {
 		return x.ReleaseResourceReference
@@ -6783,40 +6828,26 @@ func (x *ResourceGroupContentItem) GetLinkedReleaseResourceReference() []*Linked
 }
 
 func (x *ResourceGroupContentItem) GetIsBonusResource() bool {
-	if x != nil {
-		return x.IsBonusResource
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
 	}
 	return false
 }
 
 func (x *ResourceGroupContentItem) GetIsInstantGratificationResource() bool {
-	if x != nil {
-		return x.IsInstantGratificationResource
+	if x != nil && x.IsInstantGratificationResource != nil {
+		return *x.IsInstantGratificationResource
 	}
 	return false
 }
 
 func (x *ResourceGroupContentItem) GetIsPreOrderIncentiveResource() bool {
-	if x != nil {
-		return x.IsPreOrderIncentiveResource
-	}
-	return false
-}
-
-func (x *ResourceGroupContentItem) GetNoDisplaySequence() bool {
-	if x != nil {
-		return x.NoDisplaySequence
+	if x != nil && x.IsPreOrderIncentiveResource != nil {
+		return *x.IsPreOrderIncentiveResource
 	}
 	return false
 }
 
-func (x *ResourceGroupContentItem) GetDisplaySequence() string {
-	if x != nil {
-		return x.DisplaySequence
-	}
-	return ""
-}
-
 type ResourceList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SoundRecording"
@@ -6911,16 +6942,16 @@ type ResourceRightsController struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"RightsControllerPartyReference"
 	RightsControllerPartyReference string `protobuf:"bytes,1,opt,name=rights_controller_party_reference,json=rightsControllerPartyReference,proto3" json:"rights_controller_party_reference,omitempty" xml:"RightsControllerPartyReference"`
-	// @gotags: xml:"RightsControlType"
-	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType"`
-	// @gotags: xml:"DelegatedUsageRights"
-	DelegatedUsageRights []*DelegatedUsageRights `protobuf:"bytes,3,rep,name=delegated_usage_rights,json=delegatedUsageRights,proto3" json:"delegated_usage_rights,omitempty" xml:"DelegatedUsageRights"`
+	// @gotags: xml:"RightsControlType" avs:"RightsControllerRole"
+	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType" avs:"RightsControllerRole"`
 	// @gotags: xml:"RightShareUnknown"
 	RightShareUnknown bool `protobuf:"varint,4,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty" xml:"RightShareUnknown"`
 	// @gotags: xml:"RightSharePercentage"
 	RightSharePercentage *Percentage `protobuf:"bytes,5,opt,name=right_share_percentage,json=rightSharePercentage,proto3" json:"right_share_percentage,omitempty" xml:"RightSharePercentage"`
+	// @gotags: xml:"DelegatedUsageRights"
+	DelegatedUsageRights []*DelegatedUsageRights `protobuf:"bytes,3,rep,name=delegated_usage_rights,json=delegatedUsageRights,proto3" json:"delegated_usage_rights,omitempty" xml:"DelegatedUsageRights"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6969,13 +7000,6 @@ func (x *ResourceRightsController) GetRightsControlType() []string {
 	return nil
 }
 
-func (x *ResourceRightsController) GetDelegatedUsageRights() []*DelegatedUsageRights {
-	if x != nil {
-		return x.DelegatedUsageRights
-	}
-	return nil
-}
-
 func (x *ResourceRightsController) GetRightShareUnknown() bool {
 	if x != nil {
 		return x.RightShareUnknown
@@ -6990,9 +7014,16 @@ func (x *ResourceRightsController) GetRightSharePercentage() *Percentage {
 	return nil
 }
 
-func (x *ResourceRightsController) GetSequenceNumber() int32 {
+func (x *ResourceRightsController) GetDelegatedUsageRights() []*DelegatedUsageRights {
 	if x != nil {
-		return x.SequenceNumber
+		return x.DelegatedUsageRights
+	}
+	return nil
+}
+
+func (x *ResourceRightsController) GetSequenceNumber() int32 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -7006,29 +7037,29 @@ type ResourceSubGroup struct {
 	// @gotags: xml:"AdditionalTitle"
 	AdditionalTitle []*AdditionalTitle `protobuf:"bytes,3,rep,name=additional_title,json=additionalTitle,proto3" json:"additional_title,omitempty" xml:"AdditionalTitle"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	// @gotags: xml:"NoDisplaySequence"
+	NoDisplaySequence bool `protobuf:"varint,11,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
+	// @gotags: xml:"DisplaySequence"
+	DisplaySequence string `protobuf:"bytes,12,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
 	// @gotags: xml:"DisplayArtist"
 	DisplayArtist []*DisplayArtist `protobuf:"bytes,5,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty" xml:"DisplayArtist"`
 	// @gotags: xml:"CarrierType"
 	CarrierType []*CarrierType `protobuf:"bytes,6,rep,name=carrier_type,json=carrierType,proto3" json:"carrier_type,omitempty" xml:"CarrierType"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,7,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
+	// @gotags: xml:"ResourceGroupReleaseReference"
+	ResourceGroupReleaseReference string `protobuf:"bytes,13,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
+	// @gotags: xml:"ReleaseId"
+	ReleaseId *ReleaseId `protobuf:"bytes,14,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ResourceGroup"
 	ResourceGroup []*ResourceSubGroup `protobuf:"bytes,8,rep,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty" xml:"ResourceGroup"`
 	// @gotags: xml:"ResourceGroupContentItem"
 	ResourceGroupContentItem []*ResourceGroupContentItem `protobuf:"bytes,9,rep,name=resource_group_content_item,json=resourceGroupContentItem,proto3" json:"resource_group_content_item,omitempty" xml:"ResourceGroupContentItem"`
 	// @gotags: xml:"LinkedReleaseResourceReference"
 	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,10,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty" xml:"LinkedReleaseResourceReference"`
-	// @gotags: xml:"NoDisplaySequence"
-	NoDisplaySequence bool `protobuf:"varint,11,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
-	// @gotags: xml:"DisplaySequence"
-	DisplaySequence string `protobuf:"bytes,12,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
-	// @gotags: xml:"ResourceGroupReleaseReference"
-	ResourceGroupReleaseReference string `protobuf:"bytes,13,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
-	// @gotags: xml:"ReleaseId"
-	ReleaseId *ReleaseId `protobuf:"bytes,14,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
-	// @gotags: xml:"ResourceGroupType,attr"
-	ResourceGroupType string `protobuf:"bytes,15,opt,name=resource_group_type,json=resourceGroupType,proto3" json:"resource_group_type,omitempty" xml:"ResourceGroupType,attr"`
+	// @gotags: xml:"ResourceGroupType,attr" avs:"ResourceGroupType"
+	ResourceGroupType string `protobuf:"bytes,15,opt,name=resource_group_type,json=resourceGroupType,proto3" json:"resource_group_type,omitempty" xml:"ResourceGroupType,attr" avs:"ResourceGroupType"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -7085,12 +7116,26 @@ func (x *ResourceSubGroup) GetAdditionalTitle() []*AdditionalTitle {
 }
 
 func (x *ResourceSubGroup) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
+func (x *ResourceSubGroup) GetNoDisplaySequence() bool {
+	if x != nil {
+		return x.NoDisplaySequence
+	}
+	return false
+}
+
+func (x *ResourceSubGroup) GetDisplaySequence() string {
+	if x != nil {
+		return x.DisplaySequence
+	}
+	return ""
+}
+
 func (x *ResourceSubGroup) GetDisplayArtist() []*DisplayArtist {
 	if x != nil {
 		return x.DisplayArtist
@@ -7106,57 +7151,43 @@ func (x *ResourceSubGroup) GetCarrierType() []*CarrierType {
 }
 
 func (x *ResourceSubGroup) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
-func (x *ResourceSubGroup) GetResourceGroup() []*ResourceSubGroup {
+func (x *ResourceSubGroup) GetResourceGroupReleaseReference() string {
 	if x != nil {
-		return x.ResourceGroup
+		return x.ResourceGroupReleaseReference
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceSubGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
+func (x *ResourceSubGroup) GetReleaseId() *ReleaseId {
 	if x != nil {
-		return x.ResourceGroupContentItem
+		return x.ReleaseId
 	}
 	return nil
 }
 
-func (x *ResourceSubGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
+func (x *ResourceSubGroup) GetResourceGroup() []*ResourceSubGroup {
 	if x != nil {
-		return x.LinkedReleaseResourceReference
+		return x.ResourceGroup
 	}
 	return nil
 }
 
-func (x *ResourceSubGroup) GetNoDisplaySequence() bool {
-	if x != nil {
-		return x.NoDisplaySequence
-	}
-	return false
-}
-
-func (x *ResourceSubGroup) GetDisplaySequence() string {
-	if x != nil {
-		return x.DisplaySequence
-	}
-	return ""
-}
-
-func (x *ResourceSubGroup) GetResourceGroupReleaseReference() string {
+func (x *ResourceSubGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
 	if x != nil {
-		return x.ResourceGroupReleaseReference
+		return x.ResourceGroupContentItem
 	}
-	return ""
+	return nil
 }
 
-func (x *ResourceSubGroup) GetReleaseId() *ReleaseId {
+func (x *ResourceSubGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
 	if x != nil {
-		return x.ReleaseId
+		return x.LinkedReleaseResourceReference
 	}
 	return nil
 }
@@ -7172,8 +7203,8 @@ type RightsClaimPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Condition"
 	Condition []*ConditionForRightsClaimPolicy `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty" xml:"Condition"`
-	// @gotags: xml:"RightsClaimPolicyType"
-	RightsClaimPolicyType string `protobuf:"bytes,2,opt,name=rights_claim_policy_type,json=rightsClaimPolicyType,proto3" json:"rights_claim_policy_type,omitempty" xml:"RightsClaimPolicyType"`
+	// @gotags: xml:"RightsClaimPolicyType" avs:"RightsClaimPolicyType"
+	RightsClaimPolicyType string `protobuf:"bytes,2,opt,name=rights_claim_policy_type,json=rightsClaimPolicyType,proto3" json:"rights_claim_policy_type,omitempty" xml:"RightsClaimPolicyType" avs:"RightsClaimPolicyType"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7287,16 +7318,16 @@ func (x *Segment) GetEndTime() string {
 
 type ServiceException struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"PartyId"
+	PartyId []*DetailedPartyId `protobuf:"bytes,4,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyName `protobuf:"bytes,5,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"TradingName"
 	TradingName *Name `protobuf:"bytes,1,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
 	// @gotags: xml:"URL"
 	URL []string `protobuf:"bytes,2,rep,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"Channel"
-	Channel []*Channel `protobuf:"bytes,3,rep,name=channel,proto3" json:"channel,omitempty" xml:"Channel"`
-	// @gotags: xml:"PartyId"
-	PartyId []*DetailedPartyId `protobuf:"bytes,4,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName     []*PartyName `protobuf:"bytes,5,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	Channel       []*Channel `protobuf:"bytes,3,rep,name=channel,proto3" json:"channel,omitempty" xml:"Channel"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7331,37 +7362,37 @@ func (*ServiceException) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v43_v43_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ServiceException) GetTradingName() *Name {
+func (x *ServiceException) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.TradingName
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *ServiceException) GetURL() []string {
+func (x *ServiceException) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.URL
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *ServiceException) GetChannel() []*Channel {
+func (x *ServiceException) GetTradingName() *Name {
 	if x != nil {
-		return x.Channel
+		return x.TradingName
 	}
 	return nil
 }
 
-func (x *ServiceException) GetPartyId() []*DetailedPartyId {
+func (x *ServiceException) GetURL() []string {
 	if x != nil {
-		return x.PartyId
+		return x.URL
 	}
 	return nil
 }
 
-func (x *ServiceException) GetPartyName() []*PartyName {
+func (x *ServiceException) GetChannel() []*Channel {
 	if x != nil {
-		return x.PartyName
+		return x.Channel
 	}
 	return nil
 }
@@ -7409,17 +7440,17 @@ type SheetMusic struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,20,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,21,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,21,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"LanguageOfLyrics"
-	LanguageOfLyrics string `protobuf:"bytes,22,opt,name=language_of_lyrics,json=languageOfLyrics,proto3" json:"language_of_lyrics,omitempty" xml:"LanguageOfLyrics"`
+	LanguageOfLyrics *string `protobuf:"bytes,22,opt,name=language_of_lyrics,json=languageOfLyrics,proto3,oneof" json:"language_of_lyrics,omitempty" xml:"LanguageOfLyrics"`
 	// @gotags: xml:"ResourceContainedResourceReferenceList"
 	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,23,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty" xml:"ResourceContainedResourceReferenceList"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalSheetMusicDetails `protobuf:"bytes,24,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7595,15 +7626,15 @@ func (x *SheetMusic) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *SheetMusic) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
 
 func (x *SheetMusic) GetLanguageOfLyrics() string {
-	if x != nil {
-		return x.LanguageOfLyrics
+	if x != nil && x.LanguageOfLyrics != nil {
+		return *x.LanguageOfLyrics
 	}
 	return ""
 }
@@ -7623,15 +7654,15 @@ func (x *SheetMusic) GetTechnicalDetails() []*TechnicalSheetMusicDetails {
 }
 
 func (x *SheetMusic) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *SheetMusic) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -7681,15 +7712,15 @@ type Software struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,21,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,22,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,22,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"ResourceContainedResourceReferenceList"
 	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,23,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty" xml:"ResourceContainedResourceReferenceList"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalSoftwareDetails `protobuf:"bytes,24,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7872,8 +7903,8 @@ func (x *Software) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Software) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
@@ -7893,15 +7924,15 @@ func (x *Software) GetTechnicalDetails() []*TechnicalSoftwareDetails {
 }
 
 func (x *Software) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Software) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -7958,26 +7989,26 @@ type SoundRecording struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,24,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,25,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
-	// @gotags: xml:"CompositeMusicalWorkType"
-	CompositeMusicalWorkType string `protobuf:"bytes,26,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType"`
+	// @gotags: xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"
+	CompositeMusicalWorkType *string `protobuf:"bytes,26,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3,oneof" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"`
 	// @gotags: xml:"IsCover"
-	IsCover bool `protobuf:"varint,27,opt,name=is_cover,json=isCover,proto3" json:"is_cover,omitempty" xml:"IsCover"`
+	IsCover *bool `protobuf:"varint,27,opt,name=is_cover,json=isCover,proto3,oneof" json:"is_cover,omitempty" xml:"IsCover"`
 	// @gotags: xml:"HasVocalPerformance"
-	HasVocalPerformance bool `protobuf:"varint,28,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
+	HasVocalPerformance *bool `protobuf:"varint,28,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3,oneof" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
 	// @gotags: xml:"HasForegroundVocalPerformance"
-	HasForegroundVocalPerformance bool `protobuf:"varint,29,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
+	HasForegroundVocalPerformance *bool `protobuf:"varint,29,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3,oneof" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
 	// @gotags: xml:"IsInstrumental"
-	IsInstrumental bool `protobuf:"varint,30,opt,name=is_instrumental,json=isInstrumental,proto3" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
+	IsInstrumental *bool `protobuf:"varint,30,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,31,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,31,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"IsRemastered"
-	IsRemastered bool `protobuf:"varint,32,opt,name=is_remastered,json=isRemastered,proto3" json:"is_remastered,omitempty" xml:"IsRemastered"`
+	IsRemastered *bool `protobuf:"varint,32,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty" xml:"IsRemastered"`
 	// @gotags: xml:"IsHiResMusic"
-	IsHiResMusic bool `protobuf:"varint,33,opt,name=is_hi_res_music,json=isHiResMusic,proto3" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
+	IsHiResMusic *bool `protobuf:"varint,33,opt,name=is_hi_res_music,json=isHiResMusic,proto3,oneof" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
 	// @gotags: xml:"DisableCrossfade"
-	DisableCrossfade bool `protobuf:"varint,34,opt,name=disable_crossfade,json=disableCrossfade,proto3" json:"disable_crossfade,omitempty" xml:"DisableCrossfade"`
+	DisableCrossfade *bool `protobuf:"varint,34,opt,name=disable_crossfade,json=disableCrossfade,proto3,oneof" json:"disable_crossfade,omitempty" xml:"DisableCrossfade"`
 	// @gotags: xml:"DisableSearch"
-	DisableSearch bool `protobuf:"varint,35,opt,name=disable_search,json=disableSearch,proto3" json:"disable_search,omitempty" xml:"DisableSearch"`
+	DisableSearch *bool `protobuf:"varint,35,opt,name=disable_search,json=disableSearch,proto3,oneof" json:"disable_search,omitempty" xml:"DisableSearch"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,36,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
 	// @gotags: xml:"LanguageOfPerformance"
@@ -7991,11 +8022,11 @@ type SoundRecording struct {
 	// @gotags: xml:"AudioChapterReference"
 	AudioChapterReference []string `protobuf:"bytes,41,rep,name=audio_chapter_reference,json=audioChapterReference,proto3" json:"audio_chapter_reference,omitempty" xml:"AudioChapterReference"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,42,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,42,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,43,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,43,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	// @gotags: xml:"ApplyClassicalProfileVariant,attr"
-	ApplyClassicalProfileVariant bool `protobuf:"varint,44,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
+	ApplyClassicalProfileVariant *bool `protobuf:"varint,44,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3,oneof" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -8206,71 +8237,71 @@ func (x *SoundRecording) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *SoundRecording) GetCompositeMusicalWorkType() string {
-	if x != nil {
-		return x.CompositeMusicalWorkType
+	if x != nil && x.CompositeMusicalWorkType != nil {
+		return *x.CompositeMusicalWorkType
 	}
 	return ""
 }
 
 func (x *SoundRecording) GetIsCover() bool {
-	if x != nil {
-		return x.IsCover
+	if x != nil && x.IsCover != nil {
+		return *x.IsCover
 	}
 	return false
 }
 
 func (x *SoundRecording) GetHasVocalPerformance() bool {
-	if x != nil {
-		return x.HasVocalPerformance
+	if x != nil && x.HasVocalPerformance != nil {
+		return *x.HasVocalPerformance
 	}
 	return false
 }
 
 func (x *SoundRecording) GetHasForegroundVocalPerformance() bool {
-	if x != nil {
-		return x.HasForegroundVocalPerformance
+	if x != nil && x.HasForegroundVocalPerformance != nil {
+		return *x.HasForegroundVocalPerformance
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsInstrumental() bool {
-	if x != nil {
-		return x.IsInstrumental
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
 	}
 	return false
 }
 
 func (x *SoundRecording) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsRemastered() bool {
-	if x != nil {
-		return x.IsRemastered
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsHiResMusic() bool {
-	if x != nil {
-		return x.IsHiResMusic
+	if x != nil && x.IsHiResMusic != nil {
+		return *x.IsHiResMusic
 	}
 	return false
 }
 
 func (x *SoundRecording) GetDisableCrossfade() bool {
-	if x != nil {
-		return x.DisableCrossfade
+	if x != nil && x.DisableCrossfade != nil {
+		return *x.DisableCrossfade
 	}
 	return false
 }
 
 func (x *SoundRecording) GetDisableSearch() bool {
-	if x != nil {
-		return x.DisableSearch
+	if x != nil && x.DisableSearch != nil {
+		return *x.DisableSearch
 	}
 	return false
 }
@@ -8318,22 +8349,22 @@ func (x *SoundRecording) GetAudioChapterReference() []string {
 }
 
 func (x *SoundRecording) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *SoundRecording) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
 
 func (x *SoundRecording) GetApplyClassicalProfileVariant() bool {
-	if x != nil {
-		return x.ApplyClassicalProfileVariant
+	if x != nil && x.ApplyClassicalProfileVariant != nil {
+		return *x.ApplyClassicalProfileVariant
 	}
 	return false
 }
@@ -8346,8 +8377,8 @@ type SoundRecordingClipDetails struct {
 	ClipType *ClipType `protobuf:"bytes,2,opt,name=clip_type,json=clipType,proto3" json:"clip_type,omitempty" xml:"ClipType"`
 	// @gotags: xml:"Timing"
 	Timing []*Timing `protobuf:"bytes,3,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile  []*AudioDeliveryFile `protobuf:"bytes,5,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	unknownFields protoimpl.UnknownFields
@@ -8421,16 +8452,16 @@ func (x *SoundRecordingClipDetails) GetDeliveryFile() []*AudioDeliveryFile {
 
 type SoundRecordingEdition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
+	// @gotags: xml:"Type" avs:"EditionType"
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"Type" avs:"EditionType"`
 	// @gotags: xml:"ResourceId"
 	ResourceId []*SoundRecordingId `protobuf:"bytes,2,rep,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
 	// @gotags: xml:"EditionContributor"
 	EditionContributor []*EditionContributor `protobuf:"bytes,3,rep,name=edition_contributor,json=editionContributor,proto3" json:"edition_contributor,omitempty" xml:"EditionContributor"`
 	// @gotags: xml:"PLine"
 	PLine []*PLineWithDefault `protobuf:"bytes,4,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
-	// @gotags: xml:"RecordingMode"
-	RecordingMode string `protobuf:"bytes,5,opt,name=recording_mode,json=recordingMode,proto3" json:"recording_mode,omitempty" xml:"RecordingMode"`
+	// @gotags: xml:"RecordingMode" avs:"RecordingMode"
+	RecordingMode *string `protobuf:"bytes,5,opt,name=recording_mode,json=recordingMode,proto3,oneof" json:"recording_mode,omitempty" xml:"RecordingMode" avs:"RecordingMode"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalSoundRecordingDetails `protobuf:"bytes,6,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	unknownFields    protoimpl.UnknownFields
@@ -8468,8 +8499,8 @@ func (*SoundRecordingEdition) Descriptor() ([]byte, []int) {
 }
 
 func (x *SoundRecordingEdition) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
@@ -8496,8 +8527,8 @@ func (x *SoundRecordingEdition) GetPLine() []*PLineWithDefault {
 }
 
 func (x *SoundRecordingEdition) GetRecordingMode() string {
-	if x != nil {
-		return x.RecordingMode
+	if x != nil && x.RecordingMode != nil {
+		return *x.RecordingMode
 	}
 	return ""
 }
@@ -8559,13 +8590,13 @@ type SynopsisWithTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsShortSynopsis,attr"
-	IsShortSynopsis bool `protobuf:"varint,5,opt,name=is_short_synopsis,json=isShortSynopsis,proto3" json:"is_short_synopsis,omitempty" xml:"IsShortSynopsis,attr"`
+	IsShortSynopsis *bool `protobuf:"varint,5,opt,name=is_short_synopsis,json=isShortSynopsis,proto3,oneof" json:"is_short_synopsis,omitempty" xml:"IsShortSynopsis,attr"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -8608,29 +8639,29 @@ func (x *SynopsisWithTerritory) GetValue() string {
 }
 
 func (x *SynopsisWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *SynopsisWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *SynopsisWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *SynopsisWithTerritory) GetIsShortSynopsis() bool {
-	if x != nil {
-		return x.IsShortSynopsis
+	if x != nil && x.IsShortSynopsis != nil {
+		return *x.IsShortSynopsis
 	}
 	return false
 }
@@ -8639,10 +8670,10 @@ type Tala struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8685,15 +8716,15 @@ func (x *Tala) GetValue() string {
 }
 
 func (x *Tala) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *Tala) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8711,27 +8742,27 @@ type TechnicalImageDetails struct {
 	// @gotags: xml:"AspectRatio"
 	AspectRatio []*AspectRatio `protobuf:"bytes,5,rep,name=aspect_ratio,json=aspectRatio,proto3" json:"aspect_ratio,omitempty" xml:"AspectRatio"`
 	// @gotags: xml:"ColorDepth"
-	ColorDepth int32 `protobuf:"varint,6,opt,name=color_depth,json=colorDepth,proto3" json:"color_depth,omitempty" xml:"ColorDepth"`
+	ColorDepth *int32 `protobuf:"varint,6,opt,name=color_depth,json=colorDepth,proto3,oneof" json:"color_depth,omitempty" xml:"ColorDepth"`
 	// @gotags: xml:"ImageResolution"
-	ImageResolution int32 `protobuf:"varint,7,opt,name=image_resolution,json=imageResolution,proto3" json:"image_resolution,omitempty" xml:"ImageResolution"`
+	ImageResolution *int32 `protobuf:"varint,7,opt,name=image_resolution,json=imageResolution,proto3,oneof" json:"image_resolution,omitempty" xml:"ImageResolution"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,8,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,8,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,9,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,9,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,10,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,11,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,12,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,12,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,13,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,15,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,15,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,16,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,16,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8802,29 +8833,29 @@ func (x *TechnicalImageDetails) GetAspectRatio() []*AspectRatio {
 }
 
 func (x *TechnicalImageDetails) GetColorDepth() int32 {
-	if x != nil {
-		return x.ColorDepth
+	if x != nil && x.ColorDepth != nil {
+		return *x.ColorDepth
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetImageResolution() int32 {
-	if x != nil {
-		return x.ImageResolution
+	if x != nil && x.ImageResolution != nil {
+		return *x.ImageResolution
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8844,8 +8875,8 @@ func (x *TechnicalImageDetails) GetFile() *File {
 }
 
 func (x *TechnicalImageDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -8858,22 +8889,22 @@ func (x *TechnicalImageDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalImageDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalImageDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalImageDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8885,23 +8916,23 @@ type TechnicalSheetMusicDetails struct {
 	// @gotags: xml:"SheetMusicCodecType"
 	SheetMusicCodecType *SheetMusicCodecType `protobuf:"bytes,2,opt,name=sheet_music_codec_type,json=sheetMusicCodecType,proto3" json:"sheet_music_codec_type,omitempty" xml:"SheetMusicCodecType"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8951,15 +8982,15 @@ func (x *TechnicalSheetMusicDetails) GetSheetMusicCodecType() *SheetMusicCodecTy
 }
 
 func (x *TechnicalSheetMusicDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalSheetMusicDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8979,8 +9010,8 @@ func (x *TechnicalSheetMusicDetails) GetFile() *File {
 }
 
 func (x *TechnicalSheetMusicDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -8993,22 +9024,22 @@ func (x *TechnicalSheetMusicDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalSheetMusicDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalSheetMusicDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalSheetMusicDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -9020,23 +9051,23 @@ type TechnicalSoftwareDetails struct {
 	// @gotags: xml:"OperatingSystemType"
 	OperatingSystemType *OperatingSystemType `protobuf:"bytes,2,opt,name=operating_system_type,json=operatingSystemType,proto3" json:"operating_system_type,omitempty" xml:"OperatingSystemType"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9086,15 +9117,15 @@ func (x *TechnicalSoftwareDetails) GetOperatingSystemType() *OperatingSystemType
 }
 
 func (x *TechnicalSoftwareDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalSoftwareDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -9114,8 +9145,8 @@ func (x *TechnicalSoftwareDetails) GetFile() *File {
 }
 
 func (x *TechnicalSoftwareDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -9128,22 +9159,22 @@ func (x *TechnicalSoftwareDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalSoftwareDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoftwareDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoftwareDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -9155,17 +9186,17 @@ type TechnicalSoundRecordingDetails struct {
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile []*AudioDeliveryFile `protobuf:"bytes,2,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	// @gotags: xml:"HasImmersiveAudioMetadata"
-	HasImmersiveAudioMetadata bool `protobuf:"varint,3,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
+	HasImmersiveAudioMetadata *bool `protobuf:"varint,3,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3,oneof" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*SoundRecordingClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9215,15 +9246,15 @@ func (x *TechnicalSoundRecordingDetails) GetDeliveryFile() []*AudioDeliveryFile
 }
 
 func (x *TechnicalSoundRecordingDetails) GetHasImmersiveAudioMetadata() bool {
-	if x != nil {
-		return x.HasImmersiveAudioMetadata
+	if x != nil && x.HasImmersiveAudioMetadata != nil {
+		return *x.HasImmersiveAudioMetadata
 	}
 	return false
 }
 
 func (x *TechnicalSoundRecordingDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -9236,22 +9267,22 @@ func (x *TechnicalSoundRecordingDetails) GetClipDetails() []*SoundRecordingClipD
 }
 
 func (x *TechnicalSoundRecordingDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoundRecordingDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoundRecordingDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -9263,23 +9294,23 @@ type TechnicalTextDetails struct {
 	// @gotags: xml:"TextCodecType"
 	TextCodecType *TextCodecType `protobuf:"bytes,2,opt,name=text_codec_type,json=textCodecType,proto3" json:"text_codec_type,omitempty" xml:"TextCodecType"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9329,15 +9360,15 @@ func (x *TechnicalTextDetails) GetTextCodecType() *TextCodecType {
 }
 
 func (x *TechnicalTextDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalTextDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -9357,8 +9388,8 @@ func (x *TechnicalTextDetails) GetFile() *File {
 }
 
 func (x *TechnicalTextDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -9371,22 +9402,22 @@ func (x *TechnicalTextDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalTextDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalTextDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalTextDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -9400,15 +9431,15 @@ type TechnicalVideoDetails struct {
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile []*VideoDeliveryFile `protobuf:"bytes,3,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*VideoClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9465,8 +9496,8 @@ func (x *TechnicalVideoDetails) GetDeliveryFile() []*VideoDeliveryFile {
 }
 
 func (x *TechnicalVideoDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -9479,22 +9510,22 @@ func (x *TechnicalVideoDetails) GetClipDetails() []*VideoClipDetails {
 }
 
 func (x *TechnicalVideoDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalVideoDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalVideoDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -9542,7 +9573,7 @@ type Text struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,20,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,21,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,21,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"ResourceContainedResourceReferenceList"
 	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,22,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty" xml:"ResourceContainedResourceReferenceList"`
 	// @gotags: xml:"TechnicalDetails"
@@ -9550,9 +9581,9 @@ type Text struct {
 	// @gotags: xml:"LanguageOfText"
 	LanguageOfText []*Language `protobuf:"bytes,24,rep,name=language_of_text,json=languageOfText,proto3" json:"language_of_text,omitempty" xml:"LanguageOfText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -9728,8 +9759,8 @@ func (x *Text) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Text) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
@@ -9756,15 +9787,15 @@ func (x *Text) GetLanguageOfText() []*Language {
 }
 
 func (x *Text) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Text) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -9774,7 +9805,7 @@ type Timing struct {
 	// @gotags: xml:"StartPoint"
 	StartPoint string `protobuf:"bytes,1,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"EndPoint"
-	EndPoint string `protobuf:"bytes,2,opt,name=end_point,json=endPoint,proto3" json:"end_point,omitempty" xml:"EndPoint"`
+	EndPoint *string `protobuf:"bytes,2,opt,name=end_point,json=endPoint,proto3,oneof" json:"end_point,omitempty" xml:"EndPoint"`
 	// @gotags: xml:"DurationUsed"
 	DurationUsed  []string `protobuf:"bytes,3,rep,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty" xml:"DurationUsed"`
 	unknownFields protoimpl.UnknownFields
@@ -9819,8 +9850,8 @@ func (x *Timing) GetStartPoint() string {
 }
 
 func (x *Timing) GetEndPoint() string {
-	if x != nil {
-		return x.EndPoint
+	if x != nil && x.EndPoint != nil {
+		return *x.EndPoint
 	}
 	return ""
 }
@@ -9837,11 +9868,11 @@ type Title struct {
 	// @gotags: xml:"TitleText"
 	TitleText string `protobuf:"bytes,1,opt,name=title_text,json=titleText,proto3" json:"title_text,omitempty" xml:"TitleText"`
 	// @gotags: xml:"SubTitle"
-	SubTitle string `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
+	SubTitle *string `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3,oneof" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType     string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"TitleType,attr" avs:"AdditionalTitleType"
+	TitleType     *string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"AdditionalTitleType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9884,22 +9915,22 @@ func (x *Title) GetTitleText() string {
 }
 
 func (x *Title) GetSubTitle() string {
-	if x != nil {
-		return x.SubTitle
+	if x != nil && x.SubTitle != nil {
+		return *x.SubTitle
 	}
 	return ""
 }
 
 func (x *Title) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Title) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
@@ -9931,7 +9962,7 @@ type TrackRelease struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,12,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"TargetURL"
-	TargetURL string `protobuf:"bytes,13,opt,name=target_u_r_l,json=targetURL,proto3" json:"target_u_r_l,omitempty" xml:"TargetURL"`
+	TargetURL *string `protobuf:"bytes,13,opt,name=target_u_r_l,json=targetURL,proto3,oneof" json:"target_u_r_l,omitempty" xml:"TargetURL"`
 	// @gotags: xml:"Keywords"
 	Keywords []*KeywordsWithTerritory `protobuf:"bytes,14,rep,name=keywords,proto3" json:"keywords,omitempty" xml:"Keywords"`
 	// @gotags: xml:"Synopsis"
@@ -9939,7 +9970,7 @@ type TrackRelease struct {
 	// @gotags: xml:"MarketingComment"
 	MarketingComment []*MarketingComment `protobuf:"bytes,16,rep,name=marketing_comment,json=marketingComment,proto3" json:"marketing_comment,omitempty" xml:"MarketingComment"`
 	// @gotags: xml:"IsMainRelease,attr"
-	IsMainRelease bool `protobuf:"varint,17,opt,name=is_main_release,json=isMainRelease,proto3" json:"is_main_release,omitempty" xml:"IsMainRelease,attr"`
+	IsMainRelease *bool `protobuf:"varint,17,opt,name=is_main_release,json=isMainRelease,proto3,oneof" json:"is_main_release,omitempty" xml:"IsMainRelease,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -10059,8 +10090,8 @@ func (x *TrackRelease) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *TrackRelease) GetTargetURL() string {
-	if x != nil {
-		return x.TargetURL
+	if x != nil && x.TargetURL != nil {
+		return *x.TargetURL
 	}
 	return ""
 }
@@ -10087,8 +10118,8 @@ func (x *TrackRelease) GetMarketingComment() []*MarketingComment {
 }
 
 func (x *TrackRelease) GetIsMainRelease() bool {
-	if x != nil {
-		return x.IsMainRelease
+	if x != nil && x.IsMainRelease != nil {
+		return *x.IsMainRelease
 	}
 	return false
 }
@@ -10097,16 +10128,16 @@ type TrackReleaseVisibility struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VisibilityReference"
 	VisibilityReference string `protobuf:"bytes,1,opt,name=visibility_reference,json=visibilityReference,proto3" json:"visibility_reference,omitempty" xml:"VisibilityReference"`
-	// @gotags: xml:"TrackListingPreviewStartDateTime"
-	TrackListingPreviewStartDateTime string `protobuf:"bytes,2,opt,name=track_listing_preview_start_date_time,json=trackListingPreviewStartDateTime,proto3" json:"track_listing_preview_start_date_time,omitempty" xml:"TrackListingPreviewStartDateTime"`
-	// @gotags: xml:"ClipPreviewStartDateTime"
-	ClipPreviewStartDateTime string `protobuf:"bytes,3,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,4,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,5,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
+	// @gotags: xml:"TrackListingPreviewStartDateTime"
+	TrackListingPreviewStartDateTime string `protobuf:"bytes,2,opt,name=track_listing_preview_start_date_time,json=trackListingPreviewStartDateTime,proto3" json:"track_listing_preview_start_date_time,omitempty" xml:"TrackListingPreviewStartDateTime"`
+	// @gotags: xml:"ClipPreviewStartDateTime"
+	ClipPreviewStartDateTime *string `protobuf:"bytes,3,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3,oneof" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"DoNotDisplayDates,attr"
-	DoNotDisplayDates bool `protobuf:"varint,6,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
+	DoNotDisplayDates *bool `protobuf:"varint,6,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3,oneof" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -10148,49 +10179,49 @@ func (x *TrackReleaseVisibility) GetVisibilityReference() string {
 	return ""
 }
 
-func (x *TrackReleaseVisibility) GetTrackListingPreviewStartDateTime() string {
+func (x *TrackReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.TrackListingPreviewStartDateTime
+		return x.TerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *TrackReleaseVisibility) GetClipPreviewStartDateTime() string {
+func (x *TrackReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.ClipPreviewStartDateTime
+		return x.ExcludedTerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *TrackReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *TrackReleaseVisibility) GetTrackListingPreviewStartDateTime() string {
 	if x != nil {
-		return x.TerritoryCode
+		return x.TrackListingPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
-func (x *TrackReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *TrackReleaseVisibility) GetClipPreviewStartDateTime() string {
+	if x != nil && x.ClipPreviewStartDateTime != nil {
+		return *x.ClipPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
 func (x *TrackReleaseVisibility) GetDoNotDisplayDates() bool {
-	if x != nil {
-		return x.DoNotDisplayDates
+	if x != nil && x.DoNotDisplayDates != nil {
+		return *x.DoNotDisplayDates
 	}
 	return false
 }
 
 type UseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UseTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UseTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10233,27 +10264,27 @@ func (x *UseType) GetValue() string {
 }
 
 func (x *UseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *UseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type UserInterfaceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UserInterfaceTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UserInterfaceTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10296,15 +10327,15 @@ func (x *UserInterfaceType) GetValue() string {
 }
 
 func (x *UserInterfaceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *UserInterfaceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10361,20 +10392,24 @@ type Video struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,24,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,25,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
-	// @gotags: xml:"CompositeMusicalWorkType"
-	CompositeMusicalWorkType string `protobuf:"bytes,26,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType"`
+	// @gotags: xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"
+	CompositeMusicalWorkType *string `protobuf:"bytes,26,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3,oneof" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"`
+	// @gotags: xml:"VideoCueSheetReference"
+	VideoCueSheetReference []string `protobuf:"bytes,42,rep,name=video_cue_sheet_reference,json=videoCueSheetReference,proto3" json:"video_cue_sheet_reference,omitempty" xml:"VideoCueSheetReference"`
+	// @gotags: xml:"ReasonForCueSheetAbsence"
+	ReasonForCueSheetAbsence *Reason `protobuf:"bytes,43,opt,name=reason_for_cue_sheet_absence,json=reasonForCueSheetAbsence,proto3" json:"reason_for_cue_sheet_absence,omitempty" xml:"ReasonForCueSheetAbsence"`
 	// @gotags: xml:"IsCover"
-	IsCover bool `protobuf:"varint,27,opt,name=is_cover,json=isCover,proto3" json:"is_cover,omitempty" xml:"IsCover"`
+	IsCover *bool `protobuf:"varint,27,opt,name=is_cover,json=isCover,proto3,oneof" json:"is_cover,omitempty" xml:"IsCover"`
 	// @gotags: xml:"HasVocalPerformance"
-	HasVocalPerformance bool `protobuf:"varint,28,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
+	HasVocalPerformance *bool `protobuf:"varint,28,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3,oneof" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
 	// @gotags: xml:"HasForegroundVocalPerformance"
-	HasForegroundVocalPerformance bool `protobuf:"varint,29,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
+	HasForegroundVocalPerformance *bool `protobuf:"varint,29,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3,oneof" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
 	// @gotags: xml:"IsInstrumental"
-	IsInstrumental bool `protobuf:"varint,30,opt,name=is_instrumental,json=isInstrumental,proto3" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
+	IsInstrumental *bool `protobuf:"varint,30,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,31,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,31,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"IsRemastered"
-	IsRemastered bool `protobuf:"varint,32,opt,name=is_remastered,json=isRemastered,proto3" json:"is_remastered,omitempty" xml:"IsRemastered"`
+	IsRemastered *bool `protobuf:"varint,32,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty" xml:"IsRemastered"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,33,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
 	// @gotags: xml:"LanguageOfPerformance"
@@ -10393,16 +10428,12 @@ type Video struct {
 	Deity []string `protobuf:"bytes,40,rep,name=deity,proto3" json:"deity,omitempty" xml:"Deity"`
 	// @gotags: xml:"VideoChapterReference"
 	VideoChapterReference []string `protobuf:"bytes,41,rep,name=video_chapter_reference,json=videoChapterReference,proto3" json:"video_chapter_reference,omitempty" xml:"VideoChapterReference"`
-	// @gotags: xml:"VideoCueSheetReference"
-	VideoCueSheetReference []string `protobuf:"bytes,42,rep,name=video_cue_sheet_reference,json=videoCueSheetReference,proto3" json:"video_cue_sheet_reference,omitempty" xml:"VideoCueSheetReference"`
-	// @gotags: xml:"ReasonForCueSheetAbsence"
-	ReasonForCueSheetAbsence *Reason `protobuf:"bytes,43,opt,name=reason_for_cue_sheet_absence,json=reasonForCueSheetAbsence,proto3" json:"reason_for_cue_sheet_absence,omitempty" xml:"ReasonForCueSheetAbsence"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,44,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,44,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,45,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,45,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	// @gotags: xml:"ApplyClassicalProfileVariant,attr"
-	ApplyClassicalProfileVariant bool `protobuf:"varint,46,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
+	ApplyClassicalProfileVariant *bool `protobuf:"varint,46,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3,oneof" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -10613,50 +10644,64 @@ func (x *Video) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Video) GetCompositeMusicalWorkType() string {
-	if x != nil {
-		return x.CompositeMusicalWorkType
+	if x != nil && x.CompositeMusicalWorkType != nil {
+		return *x.CompositeMusicalWorkType
 	}
 	return ""
 }
 
-func (x *Video) GetIsCover() bool {
+func (x *Video) GetVideoCueSheetReference() []string {
+	if x != nil {
+		return x.VideoCueSheetReference
+	}
+	return nil
+}
+
+func (x *Video) GetReasonForCueSheetAbsence() *Reason {
 	if x != nil {
-		return x.IsCover
+		return x.ReasonForCueSheetAbsence
+	}
+	return nil
+}
+
+func (x *Video) GetIsCover() bool {
+	if x != nil && x.IsCover != nil {
+		return *x.IsCover
 	}
 	return false
 }
 
 func (x *Video) GetHasVocalPerformance() bool {
-	if x != nil {
-		return x.HasVocalPerformance
+	if x != nil && x.HasVocalPerformance != nil {
+		return *x.HasVocalPerformance
 	}
 	return false
 }
 
 func (x *Video) GetHasForegroundVocalPerformance() bool {
-	if x != nil {
-		return x.HasForegroundVocalPerformance
+	if x != nil && x.HasForegroundVocalPerformance != nil {
+		return *x.HasForegroundVocalPerformance
 	}
 	return false
 }
 
 func (x *Video) GetIsInstrumental() bool {
-	if x != nil {
-		return x.IsInstrumental
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
 	}
 	return false
 }
 
 func (x *Video) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
 
 func (x *Video) GetIsRemastered() bool {
-	if x != nil {
-		return x.IsRemastered
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
 	}
 	return false
 }
@@ -10724,37 +10769,23 @@ func (x *Video) GetVideoChapterReference() []string {
 	return nil
 }
 
-func (x *Video) GetVideoCueSheetReference() []string {
-	if x != nil {
-		return x.VideoCueSheetReference
-	}
-	return nil
-}
-
-func (x *Video) GetReasonForCueSheetAbsence() *Reason {
-	if x != nil {
-		return x.ReasonForCueSheetAbsence
-	}
-	return nil
-}
-
 func (x *Video) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Video) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
 
 func (x *Video) GetApplyClassicalProfileVariant() bool {
-	if x != nil {
-		return x.ApplyClassicalProfileVariant
+	if x != nil && x.ApplyClassicalProfileVariant != nil {
+		return *x.ApplyClassicalProfileVariant
 	}
 	return false
 }
@@ -10768,11 +10799,11 @@ type VideoClipDetails struct {
 	// @gotags: xml:"Timing"
 	Timing []*Timing `protobuf:"bytes,3,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
 	// @gotags: xml:"TopLeftCorner"
-	TopLeftCorner string `protobuf:"bytes,4,opt,name=top_left_corner,json=topLeftCorner,proto3" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
+	TopLeftCorner *string `protobuf:"bytes,4,opt,name=top_left_corner,json=topLeftCorner,proto3,oneof" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
 	// @gotags: xml:"BottomRightCorner"
-	BottomRightCorner string `protobuf:"bytes,5,opt,name=bottom_right_corner,json=bottomRightCorner,proto3" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,6,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	BottomRightCorner *string `protobuf:"bytes,5,opt,name=bottom_right_corner,json=bottomRightCorner,proto3,oneof" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,6,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile  []*VideoDeliveryFile `protobuf:"bytes,7,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	unknownFields protoimpl.UnknownFields
@@ -10831,15 +10862,15 @@ func (x *VideoClipDetails) GetTiming() []*Timing {
 }
 
 func (x *VideoClipDetails) GetTopLeftCorner() string {
-	if x != nil {
-		return x.TopLeftCorner
+	if x != nil && x.TopLeftCorner != nil {
+		return *x.TopLeftCorner
 	}
 	return ""
 }
 
 func (x *VideoClipDetails) GetBottomRightCorner() string {
-	if x != nil {
-		return x.BottomRightCorner
+	if x != nil && x.BottomRightCorner != nil {
+		return *x.BottomRightCorner
 	}
 	return ""
 }
@@ -10860,8 +10891,8 @@ func (x *VideoClipDetails) GetDeliveryFile() []*VideoDeliveryFile {
 
 type VideoDeliveryFile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
+	// @gotags: xml:"Type" avs:"DeliveryFileType"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type" avs:"DeliveryFileType"`
 	// @gotags: xml:"ContainerFormat"
 	ContainerFormat *ContainerFormat `protobuf:"bytes,2,opt,name=container_format,json=containerFormat,proto3" json:"container_format,omitempty" xml:"ContainerFormat"`
 	// @gotags: xml:"VideoCodecType"
@@ -10879,41 +10910,41 @@ type VideoDeliveryFile struct {
 	// @gotags: xml:"CoreArea"
 	CoreArea *CoreArea `protobuf:"bytes,9,opt,name=core_area,json=coreArea,proto3" json:"core_area,omitempty" xml:"CoreArea"`
 	// @gotags: xml:"ColorDepth"
-	ColorDepth int32 `protobuf:"varint,10,opt,name=color_depth,json=colorDepth,proto3" json:"color_depth,omitempty" xml:"ColorDepth"`
+	ColorDepth *int32 `protobuf:"varint,10,opt,name=color_depth,json=colorDepth,proto3,oneof" json:"color_depth,omitempty" xml:"ColorDepth"`
 	// @gotags: xml:"VideoDefinitionType"
 	VideoDefinitionType *VideoDefinitionType `protobuf:"bytes,11,opt,name=video_definition_type,json=videoDefinitionType,proto3" json:"video_definition_type,omitempty" xml:"VideoDefinitionType"`
 	// @gotags: xml:"AudioCodecType"
 	AudioCodecType *AudioCodecType `protobuf:"bytes,12,opt,name=audio_codec_type,json=audioCodecType,proto3" json:"audio_codec_type,omitempty" xml:"AudioCodecType"`
 	// @gotags: xml:"HasImmersiveAudioMetadata"
-	HasImmersiveAudioMetadata bool `protobuf:"varint,13,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
-	// @gotags: xml:"ElectroOpticalTransferFunctionType"
-	ElectroOpticalTransferFunctionType string `protobuf:"bytes,14,opt,name=electro_optical_transfer_function_type,json=electroOpticalTransferFunctionType,proto3" json:"electro_optical_transfer_function_type,omitempty" xml:"ElectroOpticalTransferFunctionType"`
-	// @gotags: xml:"PrimaryColorType"
-	PrimaryColorType string `protobuf:"bytes,15,opt,name=primary_color_type,json=primaryColorType,proto3" json:"primary_color_type,omitempty" xml:"PrimaryColorType"`
+	HasImmersiveAudioMetadata *bool `protobuf:"varint,13,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3,oneof" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
+	// @gotags: xml:"ElectroOpticalTransferFunctionType" avs:"ElectroOpticalTransferFunctionType"
+	ElectroOpticalTransferFunctionType *string `protobuf:"bytes,14,opt,name=electro_optical_transfer_function_type,json=electroOpticalTransferFunctionType,proto3,oneof" json:"electro_optical_transfer_function_type,omitempty" xml:"ElectroOpticalTransferFunctionType" avs:"ElectroOpticalTransferFunctionType"`
+	// @gotags: xml:"PrimaryColorType" avs:"PrimaryColorType"
+	PrimaryColorType *string `protobuf:"bytes,15,opt,name=primary_color_type,json=primaryColorType,proto3,oneof" json:"primary_color_type,omitempty" xml:"PrimaryColorType" avs:"PrimaryColorType"`
 	// @gotags: xml:"HdrVideoDynamicMetadataType"
 	HdrVideoDynamicMetadataType *HdrVideoDynamicMetadataType `protobuf:"bytes,16,opt,name=hdr_video_dynamic_metadata_type,json=hdrVideoDynamicMetadataType,proto3" json:"hdr_video_dynamic_metadata_type,omitempty" xml:"HdrVideoDynamicMetadataType"`
-	// @gotags: xml:"HdrVideoStaticMetadataType"
-	HdrVideoStaticMetadataType string `protobuf:"bytes,17,opt,name=hdr_video_static_metadata_type,json=hdrVideoStaticMetadataType,proto3" json:"hdr_video_static_metadata_type,omitempty" xml:"HdrVideoStaticMetadataType"`
+	// @gotags: xml:"HdrVideoStaticMetadataType" avs:"HdrVideoStaticMetadataType"
+	HdrVideoStaticMetadataType *string `protobuf:"bytes,17,opt,name=hdr_video_static_metadata_type,json=hdrVideoStaticMetadataType,proto3,oneof" json:"hdr_video_static_metadata_type,omitempty" xml:"HdrVideoStaticMetadataType" avs:"HdrVideoStaticMetadataType"`
 	// @gotags: xml:"AudioBitRate"
 	AudioBitRate *BitRate `protobuf:"bytes,18,opt,name=audio_bit_rate,json=audioBitRate,proto3" json:"audio_bit_rate,omitempty" xml:"AudioBitRate"`
 	// @gotags: xml:"NumberOfAudioChannels"
-	NumberOfAudioChannels int32 `protobuf:"varint,19,opt,name=number_of_audio_channels,json=numberOfAudioChannels,proto3" json:"number_of_audio_channels,omitempty" xml:"NumberOfAudioChannels"`
+	NumberOfAudioChannels *int32 `protobuf:"varint,19,opt,name=number_of_audio_channels,json=numberOfAudioChannels,proto3,oneof" json:"number_of_audio_channels,omitempty" xml:"NumberOfAudioChannels"`
 	// @gotags: xml:"NumberOfAudioObjects"
-	NumberOfAudioObjects int32 `protobuf:"varint,20,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
+	NumberOfAudioObjects *int32 `protobuf:"varint,20,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3,oneof" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
 	// @gotags: xml:"AudioSamplingRate"
 	AudioSamplingRate *SamplingRate `protobuf:"bytes,21,opt,name=audio_sampling_rate,json=audioSamplingRate,proto3" json:"audio_sampling_rate,omitempty" xml:"AudioSamplingRate"`
 	// @gotags: xml:"AudioBitsPerSample"
-	AudioBitsPerSample int32 `protobuf:"varint,22,opt,name=audio_bits_per_sample,json=audioBitsPerSample,proto3" json:"audio_bits_per_sample,omitempty" xml:"AudioBitsPerSample"`
+	AudioBitsPerSample *int32 `protobuf:"varint,22,opt,name=audio_bits_per_sample,json=audioBitsPerSample,proto3,oneof" json:"audio_bits_per_sample,omitempty" xml:"AudioBitsPerSample"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,23,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,23,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,24,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,24,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,25,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,26,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,27,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,27,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -11012,8 +11043,8 @@ func (x *VideoDeliveryFile) GetCoreArea() *CoreArea {
 }
 
 func (x *VideoDeliveryFile) GetColorDepth() int32 {
-	if x != nil {
-		return x.ColorDepth
+	if x != nil && x.ColorDepth != nil {
+		return *x.ColorDepth
 	}
 	return 0
 }
@@ -11033,22 +11064,22 @@ func (x *VideoDeliveryFile) GetAudioCodecType() *AudioCodecType {
 }
 
 func (x *VideoDeliveryFile) GetHasImmersiveAudioMetadata() bool {
-	if x != nil {
-		return x.HasImmersiveAudioMetadata
+	if x != nil && x.HasImmersiveAudioMetadata != nil {
+		return *x.HasImmersiveAudioMetadata
 	}
 	return false
 }
 
 func (x *VideoDeliveryFile) GetElectroOpticalTransferFunctionType() string {
-	if x != nil {
-		return x.ElectroOpticalTransferFunctionType
+	if x != nil && x.ElectroOpticalTransferFunctionType != nil {
+		return *x.ElectroOpticalTransferFunctionType
 	}
 	return ""
 }
 
 func (x *VideoDeliveryFile) GetPrimaryColorType() string {
-	if x != nil {
-		return x.PrimaryColorType
+	if x != nil && x.PrimaryColorType != nil {
+		return *x.PrimaryColorType
 	}
 	return ""
 }
@@ -11061,8 +11092,8 @@ func (x *VideoDeliveryFile) GetHdrVideoDynamicMetadataType() *HdrVideoDynamicMet
 }
 
 func (x *VideoDeliveryFile) GetHdrVideoStaticMetadataType() string {
-	if x != nil {
-		return x.HdrVideoStaticMetadataType
+	if x != nil && x.HdrVideoStaticMetadataType != nil {
+		return *x.HdrVideoStaticMetadataType
 	}
 	return ""
 }
@@ -11075,15 +11106,15 @@ func (x *VideoDeliveryFile) GetAudioBitRate() *BitRate {
 }
 
 func (x *VideoDeliveryFile) GetNumberOfAudioChannels() int32 {
-	if x != nil {
-		return x.NumberOfAudioChannels
+	if x != nil && x.NumberOfAudioChannels != nil {
+		return *x.NumberOfAudioChannels
 	}
 	return 0
 }
 
 func (x *VideoDeliveryFile) GetNumberOfAudioObjects() int32 {
-	if x != nil {
-		return x.NumberOfAudioObjects
+	if x != nil && x.NumberOfAudioObjects != nil {
+		return *x.NumberOfAudioObjects
 	}
 	return 0
 }
@@ -11096,22 +11127,22 @@ func (x *VideoDeliveryFile) GetAudioSamplingRate() *SamplingRate {
 }
 
 func (x *VideoDeliveryFile) GetAudioBitsPerSample() int32 {
-	if x != nil {
-		return x.AudioBitsPerSample
+	if x != nil && x.AudioBitsPerSample != nil {
+		return *x.AudioBitsPerSample
 	}
 	return 0
 }
 
 func (x *VideoDeliveryFile) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *VideoDeliveryFile) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
@@ -11131,16 +11162,16 @@ func (x *VideoDeliveryFile) GetFingerprint() []*Fingerprint {
 }
 
 func (x *VideoDeliveryFile) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
 
 type VideoEdition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
+	// @gotags: xml:"Type" avs:"EditionType"
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"Type" avs:"EditionType"`
 	// @gotags: xml:"ResourceId"
 	ResourceId []*VideoId `protobuf:"bytes,2,rep,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
 	// @gotags: xml:"EditionContributor"
@@ -11149,8 +11180,8 @@ type VideoEdition struct {
 	PLine []*PLineWithDefault `protobuf:"bytes,4,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
 	// @gotags: xml:"CLine"
 	CLine []*CLineWithDefault `protobuf:"bytes,5,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty" xml:"CLine"`
-	// @gotags: xml:"RecordingMode"
-	RecordingMode string `protobuf:"bytes,6,opt,name=recording_mode,json=recordingMode,proto3" json:"recording_mode,omitempty" xml:"RecordingMode"`
+	// @gotags: xml:"RecordingMode" avs:"RecordingMode"
+	RecordingMode *string `protobuf:"bytes,6,opt,name=recording_mode,json=recordingMode,proto3,oneof" json:"recording_mode,omitempty" xml:"RecordingMode" avs:"RecordingMode"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalVideoDetails `protobuf:"bytes,7,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	unknownFields    protoimpl.UnknownFields
@@ -11188,8 +11219,8 @@ func (*VideoEdition) Descriptor() ([]byte, []int) {
 }
 
 func (x *VideoEdition) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
@@ -11223,8 +11254,8 @@ func (x *VideoEdition) GetCLine() []*CLineWithDefault {
 }
 
 func (x *VideoEdition) GetRecordingMode() string {
-	if x != nil {
-		return x.RecordingMode
+	if x != nil && x.RecordingMode != nil {
+		return *x.RecordingMode
 	}
 	return ""
 }
@@ -11238,12 +11269,12 @@ func (x *VideoEdition) GetTechnicalDetails() []*TechnicalVideoDetails {
 
 type VideoType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoTypeERN43"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoTypeERN43"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11286,15 +11317,15 @@ func (x *VideoType) GetValue() string {
 }
 
 func (x *VideoType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11303,22 +11334,22 @@ type WorkRightsController struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"RightsControllerPartyReference"
 	RightsControllerPartyReference string `protobuf:"bytes,1,opt,name=rights_controller_party_reference,json=rightsControllerPartyReference,proto3" json:"rights_controller_party_reference,omitempty" xml:"RightsControllerPartyReference"`
-	// @gotags: xml:"RightsControlType"
-	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType"`
-	// @gotags: xml:"RightsControllerType"
-	RightsControllerType string `protobuf:"bytes,3,opt,name=rights_controller_type,json=rightsControllerType,proto3" json:"rights_controller_type,omitempty" xml:"RightsControllerType"`
-	// @gotags: xml:"Territory"
-	Territory []*AllTerritoryCode `protobuf:"bytes,4,rep,name=territory,proto3" json:"territory,omitempty" xml:"Territory"`
-	// @gotags: xml:"StartDate"
-	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty" xml:"StartDate"`
-	// @gotags: xml:"EndDate"
-	EndDate string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
+	// @gotags: xml:"RightsControlType" avs:"RightsControllerRole"
+	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType" avs:"RightsControllerRole"`
+	// @gotags: xml:"RightsControllerType" avs:"RightsControllerType"
+	RightsControllerType *string `protobuf:"bytes,3,opt,name=rights_controller_type,json=rightsControllerType,proto3,oneof" json:"rights_controller_type,omitempty" xml:"RightsControllerType" avs:"RightsControllerType"`
 	// @gotags: xml:"RightShareUnknown"
 	RightShareUnknown bool `protobuf:"varint,7,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty" xml:"RightShareUnknown"`
 	// @gotags: xml:"RightSharePercentage"
 	RightSharePercentage string `protobuf:"bytes,8,opt,name=right_share_percentage,json=rightSharePercentage,proto3" json:"right_share_percentage,omitempty" xml:"RightSharePercentage"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// @gotags: xml:"Territory"
+	Territory []*AllTerritoryCode `protobuf:"bytes,4,rep,name=territory,proto3" json:"territory,omitempty" xml:"Territory"`
+	// @gotags: xml:"StartDate"
+	StartDate *string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty" xml:"StartDate"`
+	// @gotags: xml:"EndDate"
+	EndDate       *string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty" xml:"EndDate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkRightsController) Reset() {
@@ -11366,55 +11397,55 @@ func (x *WorkRightsController) GetRightsControlType() []string {
 }
 
 func (x *WorkRightsController) GetRightsControllerType() string {
-	if x != nil {
-		return x.RightsControllerType
+	if x != nil && x.RightsControllerType != nil {
+		return *x.RightsControllerType
 	}
 	return ""
 }
 
-func (x *WorkRightsController) GetTerritory() []*AllTerritoryCode {
+func (x *WorkRightsController) GetRightShareUnknown() bool {
 	if x != nil {
-		return x.Territory
+		return x.RightShareUnknown
 	}
-	return nil
+	return false
 }
 
-func (x *WorkRightsController) GetStartDate() string {
+func (x *WorkRightsController) GetRightSharePercentage() string {
 	if x != nil {
-		return x.StartDate
+		return x.RightSharePercentage
 	}
 	return ""
 }
 
-func (x *WorkRightsController) GetEndDate() string {
+func (x *WorkRightsController) GetTerritory() []*AllTerritoryCode {
 	if x != nil {
-		return x.EndDate
+		return x.Territory
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkRightsController) GetRightShareUnknown() bool {
-	if x != nil {
-		return x.RightShareUnknown
+func (x *WorkRightsController) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
-	return false
+	return ""
 }
 
-func (x *WorkRightsController) GetRightSharePercentage() string {
-	if x != nil {
-		return x.RightSharePercentage
+func (x *WorkRightsController) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
 
 type AdministratingRecordCompanyRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"AdministratingRecordCompanyRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AdministratingRecordCompanyRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11457,39 +11488,39 @@ func (x *AdministratingRecordCompanyRole) GetValue() string {
 }
 
 func (x *AdministratingRecordCompanyRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AdministratingRecordCompanyRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type Affiliation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
-	// @gotags: xml:"ValidityPeriod"
-	ValidityPeriod *ValidityPeriod `protobuf:"bytes,2,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
-	// @gotags: xml:"RightsType"
-	RightsType []*RightsType `protobuf:"bytes,3,rep,name=rights_type,json=rightsType,proto3" json:"rights_type,omitempty" xml:"RightsType"`
-	// @gotags: xml:"PercentageOfRightsAssignment"
-	PercentageOfRightsAssignment string `protobuf:"bytes,4,opt,name=percentage_of_rights_assignment,json=percentageOfRightsAssignment,proto3" json:"percentage_of_rights_assignment,omitempty" xml:"PercentageOfRightsAssignment"`
 	// @gotags: xml:"CompanyName"
 	CompanyName string `protobuf:"bytes,5,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty" xml:"CompanyName"`
 	// @gotags: xml:"PartyAffiliateReference"
 	PartyAffiliateReference string `protobuf:"bytes,6,opt,name=party_affiliate_reference,json=partyAffiliateReference,proto3" json:"party_affiliate_reference,omitempty" xml:"PartyAffiliateReference"`
+	// @gotags: xml:"Type" avs:"AffiliationType"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type" avs:"AffiliationType"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,7,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,8,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// @gotags: xml:"ValidityPeriod"
+	ValidityPeriod *ValidityPeriod `protobuf:"bytes,2,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
+	// @gotags: xml:"RightsType"
+	RightsType []*RightsType `protobuf:"bytes,3,rep,name=rights_type,json=rightsType,proto3" json:"rights_type,omitempty" xml:"RightsType"`
+	// @gotags: xml:"PercentageOfRightsAssignment"
+	PercentageOfRightsAssignment *string `protobuf:"bytes,4,opt,name=percentage_of_rights_assignment,json=percentageOfRightsAssignment,proto3,oneof" json:"percentage_of_rights_assignment,omitempty" xml:"PercentageOfRightsAssignment"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *Affiliation) Reset() {
@@ -11522,68 +11553,68 @@ func (*Affiliation) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v43_v43_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *Affiliation) GetType() string {
+func (x *Affiliation) GetCompanyName() string {
 	if x != nil {
-		return x.Type
+		return x.CompanyName
 	}
 	return ""
 }
 
-func (x *Affiliation) GetValidityPeriod() *ValidityPeriod {
+func (x *Affiliation) GetPartyAffiliateReference() string {
 	if x != nil {
-		return x.ValidityPeriod
+		return x.PartyAffiliateReference
 	}
-	return nil
+	return ""
 }
 
-func (x *Affiliation) GetRightsType() []*RightsType {
+func (x *Affiliation) GetType() string {
 	if x != nil {
-		return x.RightsType
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *Affiliation) GetPercentageOfRightsAssignment() string {
+func (x *Affiliation) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.PercentageOfRightsAssignment
+		return x.TerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *Affiliation) GetCompanyName() string {
+func (x *Affiliation) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.CompanyName
+		return x.ExcludedTerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *Affiliation) GetPartyAffiliateReference() string {
+func (x *Affiliation) GetValidityPeriod() *ValidityPeriod {
 	if x != nil {
-		return x.PartyAffiliateReference
+		return x.ValidityPeriod
 	}
-	return ""
+	return nil
 }
 
-func (x *Affiliation) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *Affiliation) GetRightsType() []*RightsType {
 	if x != nil {
-		return x.TerritoryCode
+		return x.RightsType
 	}
 	return nil
 }
 
-func (x *Affiliation) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *Affiliation) GetPercentageOfRightsAssignment() string {
+	if x != nil && x.PercentageOfRightsAssignment != nil {
+		return *x.PercentageOfRightsAssignment
 	}
-	return nil
+	return ""
 }
 
 type AllTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"AllTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AllTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -11626,8 +11657,8 @@ func (x *AllTerritoryCode) GetValue() string {
 }
 
 func (x *AllTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
@@ -11636,10 +11667,10 @@ type AspectRatio struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"AspectRatioType,attr"
-	AspectRatioType string `protobuf:"bytes,2,opt,name=aspect_ratio_type,json=aspectRatioType,proto3" json:"aspect_ratio_type,omitempty" xml:"AspectRatioType,attr"`
+	// @gotags: xml:"AspectRatioType,attr" avs:"AspectRatioType"
+	AspectRatioType *string `protobuf:"bytes,2,opt,name=aspect_ratio_type,json=aspectRatioType,proto3,oneof" json:"aspect_ratio_type,omitempty" xml:"AspectRatioType,attr" avs:"AspectRatioType"`
 	// @gotags: xml:"AppliesToCroppedResource,attr"
-	AppliesToCroppedResource bool `protobuf:"varint,3,opt,name=applies_to_cropped_resource,json=appliesToCroppedResource,proto3" json:"applies_to_cropped_resource,omitempty" xml:"AppliesToCroppedResource,attr"`
+	AppliesToCroppedResource *bool `protobuf:"varint,3,opt,name=applies_to_cropped_resource,json=appliesToCroppedResource,proto3,oneof" json:"applies_to_cropped_resource,omitempty" xml:"AppliesToCroppedResource,attr"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -11682,29 +11713,29 @@ func (x *AspectRatio) GetValue() string {
 }
 
 func (x *AspectRatio) GetAspectRatioType() string {
-	if x != nil {
-		return x.AspectRatioType
+	if x != nil && x.AspectRatioType != nil {
+		return *x.AspectRatioType
 	}
 	return ""
 }
 
 func (x *AspectRatio) GetAppliesToCroppedResource() bool {
-	if x != nil {
-		return x.AppliesToCroppedResource
+	if x != nil && x.AppliesToCroppedResource != nil {
+		return *x.AppliesToCroppedResource
 	}
 	return false
 }
 
 type AudioCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"AudioCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AudioCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11747,22 +11778,22 @@ func (x *AudioCodecType) GetValue() string {
 }
 
 func (x *AudioCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *AudioCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AudioCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11771,8 +11802,8 @@ type BitRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfBitRate"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfBitRate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -11815,8 +11846,8 @@ func (x *BitRate) GetValue() string {
 }
 
 func (x *BitRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
@@ -11824,13 +11855,13 @@ func (x *BitRate) GetUnitOfMeasure() string {
 type CLine struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"CLineCompany"
-	CLineCompany string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3" json:"c_line_company,omitempty" xml:"CLineCompany"`
+	CLineCompany *string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3,oneof" json:"c_line_company,omitempty" xml:"CLineCompany"`
 	// @gotags: xml:"CLineText"
 	CLineText string `protobuf:"bytes,3,opt,name=c_line_text,json=cLineText,proto3" json:"c_line_text,omitempty" xml:"CLineText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11866,15 +11897,15 @@ func (*CLine) Descriptor() ([]byte, []int) {
 }
 
 func (x *CLine) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *CLine) GetCLineCompany() string {
-	if x != nil {
-		return x.CLineCompany
+	if x != nil && x.CLineCompany != nil {
+		return *x.CLineCompany
 	}
 	return ""
 }
@@ -11887,20 +11918,20 @@ func (x *CLine) GetCLineText() string {
 }
 
 func (x *CLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type CarrierType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CarrierType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CarrierType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11943,15 +11974,15 @@ func (x *CarrierType) GetValue() string {
 }
 
 func (x *CarrierType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CarrierType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -12012,12 +12043,12 @@ func (x *CatalogNumber) GetNamespace() string {
 
 type ClipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ClipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ClipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12060,27 +12091,27 @@ func (x *ClipType) GetValue() string {
 }
 
 func (x *ClipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ClipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ContainerFormat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ContainerFormat"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ContainerFormat"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12123,27 +12154,27 @@ func (x *ContainerFormat) GetValue() string {
 }
 
 func (x *ContainerFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ContainerFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ContributorRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12186,27 +12217,27 @@ func (x *ContributorRole) GetValue() string {
 }
 
 func (x *ContributorRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ContributorRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueOrigin struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueOrigin"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueOrigin"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12249,27 +12280,27 @@ func (x *CueOrigin) GetValue() string {
 }
 
 func (x *CueOrigin) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueOrigin) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueSheetType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueSheetType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueSheetType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12312,27 +12343,27 @@ func (x *CueSheetType) GetValue() string {
 }
 
 func (x *CueSheetType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueSheetType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueThemeType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ThemeType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ThemeType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12375,27 +12406,27 @@ func (x *CueThemeType) GetValue() string {
 }
 
 func (x *CueThemeType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueThemeType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueUseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueUseType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueUseType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12438,27 +12469,27 @@ func (x *CueUseType) GetValue() string {
 }
 
 func (x *CueUseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueUseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueVisualPerceptionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VisualPerceptionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VisualPerceptionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12501,27 +12532,27 @@ func (x *CueVisualPerceptionType) GetValue() string {
 }
 
 func (x *CueVisualPerceptionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueVisualPerceptionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueVocalType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VocalType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VocalType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12564,25 +12595,25 @@ func (x *CueVocalType) GetValue() string {
 }
 
 func (x *CueVocalType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueVocalType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CurrentTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"CurrentTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeType"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -12625,22 +12656,22 @@ func (x *CurrentTerritoryCode) GetValue() string {
 }
 
 func (x *CurrentTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
 
 type DSP struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"TradingName"
-	TradingName *Name `protobuf:"bytes,1,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
-	// @gotags: xml:"URL"
-	URL []string `protobuf:"bytes,2,rep,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"PartyId"
 	PartyId []*DetailedPartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
-	PartyName     []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	PartyName []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"TradingName"
+	TradingName *Name `protobuf:"bytes,1,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
+	// @gotags: xml:"URL"
+	URL           []string `protobuf:"bytes,2,rep,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12675,30 +12706,30 @@ func (*DSP) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v43_v43_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *DSP) GetTradingName() *Name {
+func (x *DSP) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.TradingName
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *DSP) GetURL() []string {
+func (x *DSP) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.URL
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *DSP) GetPartyId() []*DetailedPartyId {
+func (x *DSP) GetTradingName() *Name {
 	if x != nil {
-		return x.PartyId
+		return x.TradingName
 	}
 	return nil
 }
 
-func (x *DSP) GetPartyName() []*PartyName {
+func (x *DSP) GetURL() []string {
 	if x != nil {
-		return x.PartyName
+		return x.URL
 	}
 	return nil
 }
@@ -12708,11 +12739,11 @@ type DetailedHashSum struct {
 	// @gotags: xml:"Algorithm"
 	Algorithm *HashSumAlgorithmType `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" xml:"Algorithm"`
 	// @gotags: xml:"Version"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version"`
 	// @gotags: xml:"Parameter"
-	Parameter string `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty" xml:"Parameter"`
-	// @gotags: xml:"DataType"
-	DataType string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType"`
+	Parameter *string `protobuf:"bytes,3,opt,name=parameter,proto3,oneof" json:"parameter,omitempty" xml:"Parameter"`
+	// @gotags: xml:"DataType" avs:"BinaryDataType"
+	DataType *string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3,oneof" json:"data_type,omitempty" xml:"DataType" avs:"BinaryDataType"`
 	// @gotags: xml:"HashSumValue"
 	HashSumValue  string `protobuf:"bytes,5,opt,name=hash_sum_value,json=hashSumValue,proto3" json:"hash_sum_value,omitempty" xml:"HashSumValue"`
 	unknownFields protoimpl.UnknownFields
@@ -12757,22 +12788,22 @@ func (x *DetailedHashSum) GetAlgorithm() *HashSumAlgorithmType {
 }
 
 func (x *DetailedHashSum) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetParameter() string {
-	if x != nil {
-		return x.Parameter
+	if x != nil && x.Parameter != nil {
+		return *x.Parameter
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetDataType() string {
-	if x != nil {
-		return x.DataType
+	if x != nil && x.DataType != nil {
+		return *x.DataType
 	}
 	return ""
 }
@@ -12787,15 +12818,15 @@ func (x *DetailedHashSum) GetHashSumValue() string {
 type DetailedPartyId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"CisacSocietyId"
-	CisacSocietyId string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
+	CisacSocietyId *string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3,oneof" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,6,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	unknownFields protoimpl.UnknownFields
@@ -12833,36 +12864,36 @@ func (*DetailedPartyId) Descriptor() ([]byte, []int) {
 }
 
 func (x *DetailedPartyId) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetCisacSocietyId() string {
-	if x != nil {
-		return x.CisacSocietyId
+	if x != nil && x.CisacSocietyId != nil {
+		return *x.CisacSocietyId
 	}
 	return ""
 }
@@ -12876,12 +12907,12 @@ func (x *DetailedPartyId) GetProprietaryId() []*ProprietaryId {
 
 type DisplayArtistRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"DisplayArtistRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"DisplayArtistRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12924,15 +12955,15 @@ func (x *DisplayArtistRole) GetValue() string {
 }
 
 func (x *DisplayArtistRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DisplayArtistRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -12941,12 +12972,16 @@ type DisplayCredits struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"DisplayCreditText"
 	DisplayCreditText string `protobuf:"bytes,1,opt,name=display_credit_text,json=displayCreditText,proto3" json:"display_credit_text,omitempty" xml:"DisplayCreditText"`
+	// @gotags: xml:"DisplayCreditParty"
+	DisplayCreditParty string `protobuf:"bytes,2,opt,name=display_credit_party,json=displayCreditParty,proto3" json:"display_credit_party,omitempty" xml:"DisplayCreditParty"`
+	// @gotags: xml:"NameUsedInDisplayCredit"
+	NameUsedInDisplayCredit *string `protobuf:"bytes,3,opt,name=name_used_in_display_credit,json=nameUsedInDisplayCredit,proto3,oneof" json:"name_used_in_display_credit,omitempty" xml:"NameUsedInDisplayCredit"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12988,23 +13023,37 @@ func (x *DisplayCredits) GetDisplayCreditText() string {
 	return ""
 }
 
-func (x *DisplayCredits) GetLanguageAndScriptCode() string {
+func (x *DisplayCredits) GetDisplayCreditParty() string {
 	if x != nil {
-		return x.LanguageAndScriptCode
+		return x.DisplayCreditParty
+	}
+	return ""
+}
+
+func (x *DisplayCredits) GetNameUsedInDisplayCredit() string {
+	if x != nil && x.NameUsedInDisplayCredit != nil {
+		return *x.NameUsedInDisplayCredit
+	}
+	return ""
+}
+
+func (x *DisplayCredits) GetLanguageAndScriptCode() string {
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayCredits) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayCredits) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -13014,17 +13063,17 @@ type EventDate struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13067,43 +13116,43 @@ func (x *EventDate) GetValue() string {
 }
 
 func (x *EventDate) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDate) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDate) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDate) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDate) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDate) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13113,17 +13162,17 @@ type EventDateTime struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"TerritoryCode,attr"
-	TerritoryCode string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"TerritoryCode,attr" avs:"AllTerritoryCode"
+	TerritoryCode *string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3,oneof" json:"territory_code,omitempty" xml:"TerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13166,43 +13215,43 @@ func (x *EventDateTime) GetValue() string {
 }
 
 func (x *EventDateTime) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDateTime) GetTerritoryCode() string {
-	if x != nil {
-		return x.TerritoryCode
+	if x != nil && x.TerritoryCode != nil {
+		return *x.TerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13211,8 +13260,8 @@ type Extent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfExtent"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfExtent"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13255,20 +13304,20 @@ func (x *Extent) GetValue() string {
 }
 
 func (x *Extent) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
 
 type ExternallyLinkedResourceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ExternallyLinkedResourceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ExternallyLinkedResourceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13311,15 +13360,15 @@ func (x *ExternallyLinkedResourceType) GetValue() string {
 }
 
 func (x *ExternallyLinkedResourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ExternallyLinkedResourceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13331,7 +13380,7 @@ type File struct {
 	// @gotags: xml:"HashSum"
 	HashSum *DetailedHashSum `protobuf:"bytes,2,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty" xml:"HashSum"`
 	// @gotags: xml:"FileSize"
-	FileSize      string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty" xml:"FileSize"`
+	FileSize      *string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty" xml:"FileSize"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13381,20 +13430,20 @@ func (x *File) GetHashSum() *DetailedHashSum {
 }
 
 func (x *File) GetFileSize() string {
-	if x != nil {
-		return x.FileSize
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
 	}
 	return ""
 }
 
 type FingerprintAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"FingerprintAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"FingerprintAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13437,15 +13486,15 @@ func (x *FingerprintAlgorithmType) GetValue() string {
 }
 
 func (x *FingerprintAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *FingerprintAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13454,10 +13503,10 @@ type FirstPublicationDate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13500,15 +13549,15 @@ func (x *FirstPublicationDate) GetValue() string {
 }
 
 func (x *FirstPublicationDate) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *FirstPublicationDate) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -13517,8 +13566,8 @@ type FrameRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfFrameRate"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfFrameRate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13561,8 +13610,8 @@ func (x *FrameRate) GetValue() string {
 }
 
 func (x *FrameRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
@@ -13573,10 +13622,10 @@ type FulfillmentDateWithTerritory struct {
 	FulfillmentDate string `protobuf:"bytes,1,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ResourceReleaseReference"
 	ResourceReleaseReference []string `protobuf:"bytes,2,rep,name=resource_release_reference,json=resourceReleaseReference,proto3" json:"resource_release_reference,omitempty" xml:"ResourceReleaseReference"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13626,15 +13675,15 @@ func (x *FulfillmentDateWithTerritory) GetResourceReleaseReference() []string {
 }
 
 func (x *FulfillmentDateWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *FulfillmentDateWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -13645,8 +13694,8 @@ type GenreCategory struct {
 	Value *GenreCategoryValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	// @gotags: xml:"Description"
 	Description []*TextWithoutTerritory `protobuf:"bytes,2,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -13696,22 +13745,22 @@ func (x *GenreCategory) GetDescription() []*TextWithoutTerritory {
 }
 
 func (x *GenreCategory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 type GenreCategoryValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ClassifiedGenre"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ClassifiedGenre"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13754,22 +13803,22 @@ func (x *GenreCategoryValue) GetValue() string {
 }
 
 func (x *GenreCategoryValue) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *GenreCategoryValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *GenreCategoryValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13779,17 +13828,17 @@ type GenreWithTerritory struct {
 	// @gotags: xml:"GenreText"
 	GenreText string `protobuf:"bytes,1,opt,name=genre_text,json=genreText,proto3" json:"genre_text,omitempty" xml:"GenreText"`
 	// @gotags: xml:"SubGenre"
-	SubGenre string `protobuf:"bytes,2,opt,name=sub_genre,json=subGenre,proto3" json:"sub_genre,omitempty" xml:"SubGenre"`
+	SubGenre *string `protobuf:"bytes,2,opt,name=sub_genre,json=subGenre,proto3,oneof" json:"sub_genre,omitempty" xml:"SubGenre"`
 	// @gotags: xml:"GenreCategory"
 	GenreCategory []*GenreCategory `protobuf:"bytes,3,rep,name=genre_category,json=genreCategory,proto3" json:"genre_category,omitempty" xml:"GenreCategory"`
 	// @gotags: xml:"SubGenreCategory"
 	SubGenreCategory []*SubGenreCategory `protobuf:"bytes,4,rep,name=sub_genre_category,json=subGenreCategory,proto3" json:"sub_genre_category,omitempty" xml:"SubGenreCategory"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,6,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,6,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13832,8 +13881,8 @@ func (x *GenreWithTerritory) GetGenreText() string {
 }
 
 func (x *GenreWithTerritory) GetSubGenre() string {
-	if x != nil {
-		return x.SubGenre
+	if x != nil && x.SubGenre != nil {
+		return *x.SubGenre
 	}
 	return ""
 }
@@ -13853,34 +13902,34 @@ func (x *GenreWithTerritory) GetSubGenreCategory() []*SubGenreCategory {
 }
 
 func (x *GenreWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *GenreWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *GenreWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type HashSumAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"HashSumAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"HashSumAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13923,29 +13972,29 @@ func (x *HashSumAlgorithmType) GetValue() string {
 }
 
 func (x *HashSumAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *HashSumAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ImageCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13988,34 +14037,34 @@ func (x *ImageCodecType) GetValue() string {
 }
 
 func (x *ImageCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *ImageCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ImageType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14058,27 +14107,27 @@ func (x *ImageType) GetValue() string {
 }
 
 func (x *ImageType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type InstrumentType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"InstrumentType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"InstrumentType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14121,15 +14170,15 @@ func (x *InstrumentType) GetValue() string {
 }
 
 func (x *InstrumentType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *InstrumentType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -14139,11 +14188,11 @@ type KeywordsWithTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14186,22 +14235,22 @@ func (x *KeywordsWithTerritory) GetValue() string {
 }
 
 func (x *KeywordsWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *KeywordsWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *KeywordsWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -14211,7 +14260,7 @@ type Language struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsMainLanguage,attr"
-	IsMainLanguage bool `protobuf:"varint,2,opt,name=is_main_language,json=isMainLanguage,proto3" json:"is_main_language,omitempty" xml:"IsMainLanguage,attr"`
+	IsMainLanguage *bool `protobuf:"varint,2,opt,name=is_main_language,json=isMainLanguage,proto3,oneof" json:"is_main_language,omitempty" xml:"IsMainLanguage,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -14254,8 +14303,8 @@ func (x *Language) GetValue() string {
 }
 
 func (x *Language) GetIsMainLanguage() bool {
-	if x != nil {
-		return x.IsMainLanguage
+	if x != nil && x.IsMainLanguage != nil {
+		return *x.IsMainLanguage
 	}
 	return false
 }
@@ -14265,11 +14314,11 @@ type MarketingComment struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14312,22 +14361,22 @@ func (x *MarketingComment) GetValue() string {
 }
 
 func (x *MarketingComment) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *MarketingComment) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *MarketingComment) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -14434,11 +14483,11 @@ func (x *MessageAuditTrailEvent) GetDateTime() string {
 type MessageHeader struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageThreadId"
-	MessageThreadId string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
+	MessageThreadId *string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3,oneof" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
 	// @gotags: xml:"MessageId"
 	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty" xml:"MessageId"`
 	// @gotags: xml:"MessageFileName"
-	MessageFileName string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3" json:"message_file_name,omitempty" xml:"MessageFileName"`
+	MessageFileName *string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3,oneof" json:"message_file_name,omitempty" xml:"MessageFileName"`
 	// @gotags: xml:"MessageSender"
 	MessageSender *MessagingPartyWithoutCode `protobuf:"bytes,4,opt,name=message_sender,json=messageSender,proto3" json:"message_sender,omitempty" xml:"MessageSender"`
 	// @gotags: xml:"SentOnBehalfOf"
@@ -14449,8 +14498,8 @@ type MessageHeader struct {
 	MessageCreatedDateTime string `protobuf:"bytes,7,opt,name=message_created_date_time,json=messageCreatedDateTime,proto3" json:"message_created_date_time,omitempty" xml:"MessageCreatedDateTime"`
 	// @gotags: xml:"MessageAuditTrail"
 	MessageAuditTrail *MessageAuditTrail `protobuf:"bytes,8,opt,name=message_audit_trail,json=messageAuditTrail,proto3" json:"message_audit_trail,omitempty" xml:"MessageAuditTrail"`
-	// @gotags: xml:"MessageControlType"
-	MessageControlType string `protobuf:"bytes,9,opt,name=message_control_type,json=messageControlType,proto3" json:"message_control_type,omitempty" xml:"MessageControlType"`
+	// @gotags: xml:"MessageControlType" avs:"MessageControlType"
+	MessageControlType *string `protobuf:"bytes,9,opt,name=message_control_type,json=messageControlType,proto3,oneof" json:"message_control_type,omitempty" xml:"MessageControlType" avs:"MessageControlType"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -14486,8 +14535,8 @@ func (*MessageHeader) Descriptor() ([]byte, []int) {
 }
 
 func (x *MessageHeader) GetMessageThreadId() string {
-	if x != nil {
-		return x.MessageThreadId
+	if x != nil && x.MessageThreadId != nil {
+		return *x.MessageThreadId
 	}
 	return ""
 }
@@ -14500,8 +14549,8 @@ func (x *MessageHeader) GetMessageId() string {
 }
 
 func (x *MessageHeader) GetMessageFileName() string {
-	if x != nil {
-		return x.MessageFileName
+	if x != nil && x.MessageFileName != nil {
+		return *x.MessageFileName
 	}
 	return ""
 }
@@ -14542,8 +14591,8 @@ func (x *MessageHeader) GetMessageAuditTrail() *MessageAuditTrail {
 }
 
 func (x *MessageHeader) GetMessageControlType() string {
-	if x != nil {
-		return x.MessageControlType
+	if x != nil && x.MessageControlType != nil {
+		return *x.MessageControlType
 	}
 	return ""
 }
@@ -14555,7 +14604,7 @@ type MessagingPartyWithoutCode struct {
 	// @gotags: xml:"PartyName"
 	PartyName *PartyNameWithoutCode `protobuf:"bytes,2,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"TradingName"
-	TradingName   string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
+	TradingName   *string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3,oneof" json:"trading_name,omitempty" xml:"TradingName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14605,8 +14654,8 @@ func (x *MessagingPartyWithoutCode) GetPartyName() *PartyNameWithoutCode {
 }
 
 func (x *MessagingPartyWithoutCode) GetTradingName() string {
-	if x != nil {
-		return x.TradingName
+	if x != nil && x.TradingName != nil {
+		return *x.TradingName
 	}
 	return ""
 }
@@ -14614,15 +14663,15 @@ func (x *MessagingPartyWithoutCode) GetTradingName() string {
 type MusicalWorkId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"OpusNumber"
-	OpusNumber string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3" json:"opus_number,omitempty" xml:"OpusNumber"`
+	OpusNumber *string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3,oneof" json:"opus_number,omitempty" xml:"OpusNumber"`
 	// @gotags: xml:"ComposerCatalogNumber"
 	ComposerCatalogNumber []string `protobuf:"bytes,3,rep,name=composer_catalog_number,json=composerCatalogNumber,proto3" json:"composer_catalog_number,omitempty" xml:"ComposerCatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14658,15 +14707,15 @@ func (*MusicalWorkId) Descriptor() ([]byte, []int) {
 }
 
 func (x *MusicalWorkId) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *MusicalWorkId) GetOpusNumber() string {
-	if x != nil {
-		return x.OpusNumber
+	if x != nil && x.OpusNumber != nil {
+		return *x.OpusNumber
 	}
 	return ""
 }
@@ -14686,8 +14735,8 @@ func (x *MusicalWorkId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *MusicalWorkId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
@@ -14697,7 +14746,7 @@ type Name struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -14740,22 +14789,22 @@ func (x *Name) GetValue() string {
 }
 
 func (x *Name) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type OperatingSystemType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"OperatingSystemType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"OperatingSystemType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14798,22 +14847,22 @@ func (x *OperatingSystemType) GetValue() string {
 }
 
 func (x *OperatingSystemType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *OperatingSystemType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *OperatingSystemType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -14821,15 +14870,15 @@ func (x *OperatingSystemType) GetUserDefinedValue() string {
 type PLine struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"PLineCompany"
-	PLineCompany string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3" json:"p_line_company,omitempty" xml:"PLineCompany"`
+	PLineCompany *string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3,oneof" json:"p_line_company,omitempty" xml:"PLineCompany"`
 	// @gotags: xml:"PLineText"
 	PLineText string `protobuf:"bytes,3,opt,name=p_line_text,json=pLineText,proto3" json:"p_line_text,omitempty" xml:"PLineText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"PLineType,attr"
-	PLineType     string `protobuf:"bytes,5,opt,name=p_line_type,json=pLineType,proto3" json:"p_line_type,omitempty" xml:"PLineType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"PLineType,attr" avs:"PLineType"
+	PLineType     *string `protobuf:"bytes,5,opt,name=p_line_type,json=pLineType,proto3,oneof" json:"p_line_type,omitempty" xml:"PLineType,attr" avs:"PLineType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14865,15 +14914,15 @@ func (*PLine) Descriptor() ([]byte, []int) {
 }
 
 func (x *PLine) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *PLine) GetPLineCompany() string {
-	if x != nil {
-		return x.PLineCompany
+	if x != nil && x.PLineCompany != nil {
+		return *x.PLineCompany
 	}
 	return ""
 }
@@ -14886,15 +14935,15 @@ func (x *PLine) GetPLineText() string {
 }
 
 func (x *PLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PLine) GetPLineType() string {
-	if x != nil {
-		return x.PLineType
+	if x != nil && x.PLineType != nil {
+		return *x.PLineType
 	}
 	return ""
 }
@@ -14902,17 +14951,17 @@ func (x *PLine) GetPLineType() string {
 type PLineWithDefault struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"PLineCompany"
-	PLineCompany string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3" json:"p_line_company,omitempty" xml:"PLineCompany"`
+	PLineCompany *string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3,oneof" json:"p_line_company,omitempty" xml:"PLineCompany"`
 	// @gotags: xml:"PLineText"
 	PLineText string `protobuf:"bytes,3,opt,name=p_line_text,json=pLineText,proto3" json:"p_line_text,omitempty" xml:"PLineText"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -14948,15 +14997,15 @@ func (*PLineWithDefault) Descriptor() ([]byte, []int) {
 }
 
 func (x *PLineWithDefault) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *PLineWithDefault) GetPLineCompany() string {
-	if x != nil {
-		return x.PLineCompany
+	if x != nil && x.PLineCompany != nil {
+		return *x.PLineCompany
 	}
 	return ""
 }
@@ -14969,38 +15018,38 @@ func (x *PLineWithDefault) GetPLineText() string {
 }
 
 func (x *PLineWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *PLineWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *PLineWithDefault) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type ParentalWarningTypeWithTerritory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ParentalWarningType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ParentalWarningType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15043,29 +15092,29 @@ func (x *ParentalWarningTypeWithTerritory) GetValue() string {
 }
 
 func (x *ParentalWarningTypeWithTerritory) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithTerritory) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -15075,7 +15124,7 @@ type PartyName struct {
 	// @gotags: xml:"FullName"
 	FullName *Name `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
 	FullNameIndexed *Name `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
@@ -15087,7 +15136,7 @@ type PartyName struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *Name `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15130,8 +15179,8 @@ func (x *PartyName) GetFullName() *Name {
 }
 
 func (x *PartyName) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
@@ -15172,8 +15221,8 @@ func (x *PartyName) GetAbbreviatedName() *Name {
 }
 
 func (x *PartyName) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -15183,17 +15232,17 @@ type PartyNameWithoutCode struct {
 	// @gotags: xml:"FullName"
 	FullName string `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
-	FullNameIndexed string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
+	FullNameIndexed *string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3,oneof" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
-	NamesBeforeKeyName string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
+	NamesBeforeKeyName *string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3,oneof" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
 	// @gotags: xml:"KeyName"
-	KeyName string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty" xml:"KeyName"`
+	KeyName *string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3,oneof" json:"key_name,omitempty" xml:"KeyName"`
 	// @gotags: xml:"NamesAfterKeyName"
-	NamesAfterKeyName string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
+	NamesAfterKeyName *string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3,oneof" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
 	// @gotags: xml:"AbbreviatedName"
-	AbbreviatedName string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
+	AbbreviatedName *string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3,oneof" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -15236,57 +15285,57 @@ func (x *PartyNameWithoutCode) GetFullName() string {
 }
 
 func (x *PartyNameWithoutCode) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetFullNameIndexed() string {
-	if x != nil {
-		return x.FullNameIndexed
+	if x != nil && x.FullNameIndexed != nil {
+		return *x.FullNameIndexed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesBeforeKeyName() string {
-	if x != nil {
-		return x.NamesBeforeKeyName
+	if x != nil && x.NamesBeforeKeyName != nil {
+		return *x.NamesBeforeKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetKeyName() string {
-	if x != nil {
-		return x.KeyName
+	if x != nil && x.KeyName != nil {
+		return *x.KeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesAfterKeyName() string {
-	if x != nil {
-		return x.NamesAfterKeyName
+	if x != nil && x.NamesAfterKeyName != nil {
+		return *x.NamesAfterKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetAbbreviatedName() string {
-	if x != nil {
-		return x.AbbreviatedName
+	if x != nil && x.AbbreviatedName != nil {
+		return *x.AbbreviatedName
 	}
 	return ""
 }
 
 type PartyRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PartyRelationshipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PartyRelationshipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"MayBeShared,attr"
-	MayBeShared   bool `protobuf:"varint,4,opt,name=may_be_shared,json=mayBeShared,proto3" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
+	MayBeShared   *bool `protobuf:"varint,4,opt,name=may_be_shared,json=mayBeShared,proto3,oneof" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15329,22 +15378,22 @@ func (x *PartyRelationshipType) GetValue() string {
 }
 
 func (x *PartyRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *PartyRelationshipType) GetMayBeShared() bool {
-	if x != nil {
-		return x.MayBeShared
+	if x != nil && x.MayBeShared != nil {
+		return *x.MayBeShared
 	}
 	return false
 }
@@ -15354,7 +15403,7 @@ type Percentage struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"HasMaxValueOfOne,attr"
-	HasMaxValueOfOne bool `protobuf:"varint,2,opt,name=has_max_value_of_one,json=hasMaxValueOfOne,proto3" json:"has_max_value_of_one,omitempty" xml:"HasMaxValueOfOne,attr"`
+	HasMaxValueOfOne *bool `protobuf:"varint,2,opt,name=has_max_value_of_one,json=hasMaxValueOfOne,proto3,oneof" json:"has_max_value_of_one,omitempty" xml:"HasMaxValueOfOne,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15397,8 +15446,8 @@ func (x *Percentage) GetValue() string {
 }
 
 func (x *Percentage) GetHasMaxValueOfOne() bool {
-	if x != nil {
-		return x.HasMaxValueOfOne
+	if x != nil && x.HasMaxValueOfOne != nil {
+		return *x.HasMaxValueOfOne
 	}
 	return false
 }
@@ -15480,7 +15529,7 @@ type Prefix struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15523,8 +15572,8 @@ func (x *Prefix) GetValue() string {
 }
 
 func (x *Prefix) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -15533,8 +15582,8 @@ type Price struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"CurrencyCode,attr"
-	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty" xml:"CurrencyCode,attr"`
+	// @gotags: xml:"CurrencyCode,attr" avs:"CurrencyCode"
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty" xml:"CurrencyCode,attr" avs:"CurrencyCode"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15642,7 +15691,7 @@ type PromotionalCode struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace     *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15685,8 +15734,8 @@ func (x *PromotionalCode) GetValue() string {
 }
 
 func (x *PromotionalCode) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
@@ -15747,12 +15796,12 @@ func (x *ProprietaryId) GetNamespace() string {
 
 type Purpose struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Purpose"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Purpose"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15795,27 +15844,27 @@ func (x *Purpose) GetValue() string {
 }
 
 func (x *Purpose) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *Purpose) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RatingAgency struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RatingAgency"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RatingAgency"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15858,27 +15907,27 @@ func (x *RatingAgency) GetValue() string {
 }
 
 func (x *RatingAgency) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RatingAgency) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RatingReason struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RatingReason"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RatingReason"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15921,15 +15970,15 @@ func (x *RatingReason) GetValue() string {
 }
 
 func (x *RatingReason) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RatingReason) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -15939,7 +15988,7 @@ type Reason struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15982,8 +16031,8 @@ func (x *Reason) GetValue() string {
 }
 
 func (x *Reason) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -16044,12 +16093,12 @@ func (x *RelatedParty) GetPartyRelationshipType() *PartyRelationshipType {
 
 type ReleaseRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReleaseRelationshipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReleaseRelationshipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16092,27 +16141,27 @@ func (x *ReleaseRelationshipType) GetValue() string {
 }
 
 func (x *ReleaseRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ReleaseTypeForReleaseNotification struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReleaseTypeERN4"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReleaseTypeERN4"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16155,15 +16204,15 @@ func (x *ReleaseTypeForReleaseNotification) GetValue() string {
 }
 
 func (x *ReleaseTypeForReleaseNotification) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseTypeForReleaseNotification) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16173,9 +16222,9 @@ type ResourceContainedResourceReference struct {
 	// @gotags: xml:"ResourceContainedResourceReference"
 	ResourceContainedResourceReference string `protobuf:"bytes,1,opt,name=resource_contained_resource_reference,json=resourceContainedResourceReference,proto3" json:"resource_contained_resource_reference,omitempty" xml:"ResourceContainedResourceReference"`
 	// @gotags: xml:"DurationUsed"
-	DurationUsed string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty" xml:"DurationUsed"`
+	DurationUsed *string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3,oneof" json:"duration_used,omitempty" xml:"DurationUsed"`
 	// @gotags: xml:"StartPoint"
-	StartPoint string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
+	StartPoint *string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3,oneof" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"Purpose"
 	Purpose       *Purpose `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty" xml:"Purpose"`
 	unknownFields protoimpl.UnknownFields
@@ -16220,15 +16269,15 @@ func (x *ResourceContainedResourceReference) GetResourceContainedResourceReferen
 }
 
 func (x *ResourceContainedResourceReference) GetDurationUsed() string {
-	if x != nil {
-		return x.DurationUsed
+	if x != nil && x.DurationUsed != nil {
+		return *x.DurationUsed
 	}
 	return ""
 }
 
 func (x *ResourceContainedResourceReference) GetStartPoint() string {
-	if x != nil {
-		return x.StartPoint
+	if x != nil && x.StartPoint != nil {
+		return *x.StartPoint
 	}
 	return ""
 }
@@ -16287,12 +16336,12 @@ func (x *ResourceContainedResourceReferenceList) GetResourceContainedResourceRef
 
 type ResourceContributorRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ResourceContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ResourceContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16335,15 +16384,15 @@ func (x *ResourceContributorRole) GetValue() string {
 }
 
 func (x *ResourceContributorRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ResourceContributorRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16351,25 +16400,25 @@ func (x *ResourceContributorRole) GetUserDefinedValue() string {
 type ResourceId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,8,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,9,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,10,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,10,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16405,50 +16454,50 @@ func (*ResourceId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ResourceId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -16468,8 +16517,8 @@ func (x *ResourceId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *ResourceId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
@@ -16479,7 +16528,7 @@ type ResourceProprietaryId struct {
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,1,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,2,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,2,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16522,20 +16571,20 @@ func (x *ResourceProprietaryId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *ResourceProprietaryId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type RightsType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RightsCoverage"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RightsCoverage"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16578,15 +16627,15 @@ func (x *RightsType) GetValue() string {
 }
 
 func (x *RightsType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RightsType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16595,8 +16644,8 @@ type SamplingRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfFrequency"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfFrequency"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16639,20 +16688,20 @@ func (x *SamplingRate) GetValue() string {
 }
 
 func (x *SamplingRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
 
 type SessionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SessionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SessionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16695,29 +16744,29 @@ func (x *SessionType) GetValue() string {
 }
 
 func (x *SessionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SessionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SheetMusicCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SheetMusicCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SheetMusicCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16760,22 +16809,22 @@ func (x *SheetMusicCodecType) GetValue() string {
 }
 
 func (x *SheetMusicCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *SheetMusicCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SheetMusicCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16783,11 +16832,11 @@ func (x *SheetMusicCodecType) GetUserDefinedValue() string {
 type SheetMusicId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,1,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,1,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,2,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,3,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,3,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16823,8 +16872,8 @@ func (*SheetMusicId) Descriptor() ([]byte, []int) {
 }
 
 func (x *SheetMusicId) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
@@ -16837,20 +16886,20 @@ func (x *SheetMusicId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *SheetMusicId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type SheetMusicType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SheetMusicType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SheetMusicType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16893,27 +16942,27 @@ func (x *SheetMusicType) GetValue() string {
 }
 
 func (x *SheetMusicType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SheetMusicType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SoftwareType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SoftwareType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SoftwareType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16956,15 +17005,15 @@ func (x *SoftwareType) GetValue() string {
 }
 
 func (x *SoftwareType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SoftwareType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16972,13 +17021,13 @@ func (x *SoftwareType) GetUserDefinedValue() string {
 type SoundRecordingId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,3,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,4,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,4,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17014,8 +17063,8 @@ func (*SoundRecordingId) Descriptor() ([]byte, []int) {
 }
 
 func (x *SoundRecordingId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
@@ -17035,20 +17084,20 @@ func (x *SoundRecordingId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *SoundRecordingId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type SoundRecordingType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SoundRecordingType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SoundRecordingType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17091,15 +17140,15 @@ func (x *SoundRecordingType) GetValue() string {
 }
 
 func (x *SoundRecordingType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SoundRecordingType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17151,12 +17200,12 @@ func (x *SubGenreCategory) GetValue() []*SubGenreCategoryValue {
 
 type SubGenreCategoryValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SubGenre"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SubGenre"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17199,29 +17248,29 @@ func (x *SubGenreCategoryValue) GetValue() string {
 }
 
 func (x *SubGenreCategoryValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SubGenreCategoryValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type TextCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"TextCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"TextCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17264,22 +17313,22 @@ func (x *TextCodecType) GetValue() string {
 }
 
 func (x *TextCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *TextCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17287,15 +17336,15 @@ func (x *TextCodecType) GetUserDefinedValue() string {
 type TextId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,1,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,1,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,2,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,2,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,3,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,3,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17331,22 +17380,22 @@ func (*TextId) Descriptor() ([]byte, []int) {
 }
 
 func (x *TextId) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *TextId) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *TextId) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -17359,20 +17408,20 @@ func (x *TextId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *TextId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type TextType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"TextType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"TextType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17415,15 +17464,15 @@ func (x *TextType) GetValue() string {
 }
 
 func (x *TextType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17433,17 +17482,17 @@ type TextWithFormat struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17486,43 +17535,43 @@ func (x *TextWithFormat) GetValue() string {
 }
 
 func (x *TextWithFormat) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithFormat) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17532,17 +17581,17 @@ type TextWithoutTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17585,43 +17634,43 @@ func (x *TextWithoutTerritory) GetValue() string {
 }
 
 func (x *TextWithoutTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithoutTerritory) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17633,9 +17682,9 @@ type TitleDisplayInformation struct {
 	// @gotags: xml:"Prefix"
 	Prefix []*Prefix `protobuf:"bytes,2,rep,name=prefix,proto3" json:"prefix,omitempty" xml:"Prefix"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -17685,15 +17734,15 @@ func (x *TitleDisplayInformation) GetPrefix() []*Prefix {
 }
 
 func (x *TitleDisplayInformation) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TitleDisplayInformation) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -17755,15 +17804,15 @@ func (x *ValidityPeriod) GetEndDate() *EventDate {
 type Venue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VenueName"
-	VenueName string `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3" json:"venue_name,omitempty" xml:"VenueName"`
+	VenueName *string `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3,oneof" json:"venue_name,omitempty" xml:"VenueName"`
 	// @gotags: xml:"VenueAddress"
-	VenueAddress string `protobuf:"bytes,2,opt,name=venue_address,json=venueAddress,proto3" json:"venue_address,omitempty" xml:"VenueAddress"`
+	VenueAddress *string `protobuf:"bytes,2,opt,name=venue_address,json=venueAddress,proto3,oneof" json:"venue_address,omitempty" xml:"VenueAddress"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode *AllTerritoryCode `protobuf:"bytes,3,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"LocationCode"
-	LocationCode string `protobuf:"bytes,4,opt,name=location_code,json=locationCode,proto3" json:"location_code,omitempty" xml:"LocationCode"`
+	LocationCode *string `protobuf:"bytes,4,opt,name=location_code,json=locationCode,proto3,oneof" json:"location_code,omitempty" xml:"LocationCode"`
 	// @gotags: xml:"VenueRoom"
-	VenueRoom     string `protobuf:"bytes,5,opt,name=venue_room,json=venueRoom,proto3" json:"venue_room,omitempty" xml:"VenueRoom"`
+	VenueRoom     *string `protobuf:"bytes,5,opt,name=venue_room,json=venueRoom,proto3,oneof" json:"venue_room,omitempty" xml:"VenueRoom"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17799,15 +17848,15 @@ func (*Venue) Descriptor() ([]byte, []int) {
 }
 
 func (x *Venue) GetVenueName() string {
-	if x != nil {
-		return x.VenueName
+	if x != nil && x.VenueName != nil {
+		return *x.VenueName
 	}
 	return ""
 }
 
 func (x *Venue) GetVenueAddress() string {
-	if x != nil {
-		return x.VenueAddress
+	if x != nil && x.VenueAddress != nil {
+		return *x.VenueAddress
 	}
 	return ""
 }
@@ -17820,27 +17869,27 @@ func (x *Venue) GetTerritoryCode() *AllTerritoryCode {
 }
 
 func (x *Venue) GetLocationCode() string {
-	if x != nil {
-		return x.LocationCode
+	if x != nil && x.LocationCode != nil {
+		return *x.LocationCode
 	}
 	return ""
 }
 
 func (x *Venue) GetVenueRoom() string {
-	if x != nil {
-		return x.VenueRoom
+	if x != nil && x.VenueRoom != nil {
+		return *x.VenueRoom
 	}
 	return ""
 }
 
 type VersionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VersionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VersionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17883,29 +17932,29 @@ func (x *VersionType) GetValue() string {
 }
 
 func (x *VersionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VersionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type VideoCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17948,34 +17997,34 @@ func (x *VideoCodecType) GetValue() string {
 }
 
 func (x *VideoCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *VideoCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type VideoDefinitionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoDefinitionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoDefinitionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18018,15 +18067,15 @@ func (x *VideoDefinitionType) GetValue() string {
 }
 
 func (x *VideoDefinitionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoDefinitionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -18034,11 +18083,11 @@ func (x *VideoDefinitionType) GetUserDefinedValue() string {
 type VideoId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,4,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -18046,7 +18095,7 @@ type VideoId struct {
 	// @gotags: xml:"EIDR"
 	EIDR []string `protobuf:"bytes,6,rep,name=e_i_d_r,json=eIDR,proto3" json:"e_i_d_r,omitempty" xml:"EIDR"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,7,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,7,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18082,22 +18131,22 @@ func (*VideoId) Descriptor() ([]byte, []int) {
 }
 
 func (x *VideoId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *VideoId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *VideoId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
@@ -18124,8 +18173,8 @@ func (x *VideoId) GetEIDR() []string {
 }
 
 func (x *VideoId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
@@ -18134,7 +18183,7 @@ var File_ddex_ern_v43_v43_proto protoreflect.FileDescriptor
 
 const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\n" +
-	"\x16ddex/ern/v43/v43.proto\x12\fddex.ern.v43\x1a\x1eddex/avs/vlatest/vlatest.proto\"\xbc\a\n" +
+	"\x16ddex/ern/v43/v43.proto\x12\fddex.ern.v43\x1a\x1eddex/avs/vlatest/vlatest.proto\"\xb5\b\n" +
 	"\x11NewReleaseMessage\x12B\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1b.ddex.ern.v43.MessageHeaderR\rmessageHeader\x12?\n" +
 	"\rrelease_admin\x18\x02 \x03(\v2\x1a.ddex.ern.v43.ReleaseAdminR\freleaseAdmin\x126\n" +
@@ -18145,15 +18194,19 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\fchapter_list\x18\x06 \x01(\v2\x19.ddex.ern.v43.ChapterListR\vchapterList\x12<\n" +
 	"\frelease_list\x18\a \x01(\v2\x19.ddex.ern.v43.ReleaseListR\vreleaseList\x123\n" +
 	"\tdeal_list\x18\b \x01(\v2\x16.ddex.ern.v43.DealListR\bdealList\x12d\n" +
-	"\x1asupplemental_document_list\x18\t \x01(\v2&.ddex.ern.v43.SupplementalDocumentListR\x18supplementalDocumentList\x12;\n" +
+	"\x1asupplemental_document_list\x18\t \x01(\v2&.ddex.ern.v43.SupplementalDocumentListR\x18supplementalDocumentList\x12@\n" +
 	"\x1arelease_profile_version_id\x18\n" +
-	" \x01(\tR\x17releaseProfileVersionId\x12J\n" +
-	"\"release_profile_variant_version_id\x18\v \x01(\tR\x1ereleaseProfileVariantVersionId\x12$\n" +
+	" \x01(\tH\x00R\x17releaseProfileVersionId\x88\x01\x01\x12O\n" +
+	"\"release_profile_variant_version_id\x18\v \x01(\tH\x01R\x1ereleaseProfileVariantVersionId\x88\x01\x01\x12$\n" +
 	"\x0eavs_version_id\x18\f \x01(\tR\favsVersionId\x127\n" +
 	"\x18language_and_script_code\x18\r \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
 	"\txmlns_ern\x18\x0e \x01(\tR\bxmlnsErn\x12\x1b\n" +
 	"\txmlns_xsi\x18\x0f \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\"\xe6\x02\n" +
+	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\x11 \x01(\tR\n" +
+	"rootPrefixB\x1d\n" +
+	"\x1b_release_profile_version_idB%\n" +
+	"#_release_profile_variant_version_idJ\x06\b\xa8F\x10\x90N\"\x8f\x03\n" +
 	"\x13PurgeReleaseMessage\x12B\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1b.ddex.ern.v43.MessageHeaderR\rmessageHeader\x12B\n" +
 	"\x0epurged_release\x18\x02 \x01(\v2\x1b.ddex.ern.v43.PurgedReleaseR\rpurgedRelease\x12$\n" +
@@ -18161,87 +18214,121 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
 	"\txmlns_ern\x18\x05 \x01(\tR\bxmlnsErn\x12\x1b\n" +
 	"\txmlns_xsi\x18\x06 \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\"\xa2\x03\n" +
+	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\b \x01(\tR\n" +
+	"rootPrefixJ\x06\b\xa8F\x10\x90N\"\xe7\x04\n" +
 	"\x0fAdditionalTitle\x12\x1d\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\tR\ttitleText\x12:\n" +
-	"\tsub_title\x18\x02 \x03(\v2\x1d.ddex.ern.v43.DisplaySubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x03(\v2\x1d.ddex.ern.v43.DisplaySubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"title_type\x18\x05 \x01(\tH\x02R\ttitleType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x03R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x04R\x10userDefinedValue\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"title_type\x18\x05 \x01(\tR\ttitleType\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\x12\x1d\n" +
+	"is_default\x18\b \x01(\bH\x05R\tisDefault\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\t \x01(\bH\x06R\x14isInOriginalLanguage\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_title_typeB\f\n" +
 	"\n" +
-	"is_default\x18\b \x01(\bR\tisDefault\x125\n" +
-	"\x17is_in_original_language\x18\t \x01(\bR\x14isInOriginalLanguage\"\xb2\x01\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\r\n" +
+	"\v_is_defaultB\x1a\n" +
+	"\x18_is_in_original_languageJ\x06\b\xa8F\x10\x90N\"\xba\x01\n" +
 	"(AdministratingRecordCompanyWithReference\x12C\n" +
 	"\x1erecord_company_party_reference\x18\x01 \x01(\tR\x1brecordCompanyPartyReference\x12A\n" +
-	"\x04role\x18\x02 \x01(\v2-.ddex.ern.v43.AdministratingRecordCompanyRoleR\x04role\"\xa3\x06\n" +
+	"\x04role\x18\x02 \x01(\v2-.ddex.ern.v43.AdministratingRecordCompanyRoleR\x04roleJ\x06\b\xa8F\x10\x90N\"\xc7\a\n" +
 	"\x11AudioDeliveryFile\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12H\n" +
 	"\x10container_format\x18\x02 \x01(\v2\x1d.ddex.ern.v43.ContainerFormatR\x0fcontainerFormat\x12F\n" +
 	"\x10audio_codec_type\x18\x03 \x01(\v2\x1c.ddex.ern.v43.AudioCodecTypeR\x0eaudioCodecType\x120\n" +
 	"\bbit_rate\x18\x04 \x01(\v2\x15.ddex.ern.v43.BitRateR\abitRate\x12A\n" +
-	"\x11original_bit_rate\x18\x05 \x01(\v2\x15.ddex.ern.v43.BitRateR\x0foriginalBitRate\x12,\n" +
-	"\x12number_of_channels\x18\x06 \x01(\tR\x10numberOfChannels\x125\n" +
-	"\x17number_of_audio_objects\x18\a \x01(\x05R\x14numberOfAudioObjects\x12?\n" +
+	"\x11original_bit_rate\x18\x05 \x01(\v2\x15.ddex.ern.v43.BitRateR\x0foriginalBitRate\x121\n" +
+	"\x12number_of_channels\x18\x06 \x01(\tH\x00R\x10numberOfChannels\x88\x01\x01\x12:\n" +
+	"\x17number_of_audio_objects\x18\a \x01(\x05H\x01R\x14numberOfAudioObjects\x88\x01\x01\x12?\n" +
 	"\rsampling_rate\x18\b \x01(\v2\x1a.ddex.ern.v43.SamplingRateR\fsamplingRate\x12P\n" +
-	"\x16original_sampling_rate\x18\t \x01(\v2\x1a.ddex.ern.v43.SamplingRateR\x14originalSamplingRate\x12&\n" +
+	"\x16original_sampling_rate\x18\t \x01(\v2\x1a.ddex.ern.v43.SamplingRateR\x14originalSamplingRate\x12+\n" +
 	"\x0fbits_per_sample\x18\n" +
-	" \x01(\x05R\rbitsPerSample\x12\x1a\n" +
-	"\bduration\x18\v \x01(\tR\bduration\x12\x1b\n" +
-	"\tbit_depth\x18\f \x01(\x05R\bbitDepth\x12&\n" +
+	" \x01(\x05H\x02R\rbitsPerSample\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\v \x01(\tH\x03R\bduration\x88\x01\x01\x12 \n" +
+	"\tbit_depth\x18\f \x01(\x05H\x04R\bbitDepth\x88\x01\x01\x12&\n" +
 	"\x04file\x18\r \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x12;\n" +
-	"\vfingerprint\x18\x0e \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x125\n" +
-	"\x17is_provided_in_delivery\x18\x0f \x01(\bR\x14isProvidedInDelivery\"\xe5\x01\n" +
+	"\vfingerprint\x18\x0e \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x12:\n" +
+	"\x17is_provided_in_delivery\x18\x0f \x01(\bH\x05R\x14isProvidedInDelivery\x88\x01\x01B\x15\n" +
+	"\x13_number_of_channelsB\x1a\n" +
+	"\x18_number_of_audio_objectsB\x12\n" +
+	"\x10_bits_per_sampleB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_bit_depthB\x1a\n" +
+	"\x18_is_provided_in_deliveryJ\x06\b\xa8F\x10\x90N\"\xa4\x02\n" +
 	"\bAvRating\x12\x16\n" +
 	"\x06rating\x18\x01 \x01(\tR\x06rating\x122\n" +
 	"\x06agency\x18\x02 \x01(\v2\x1a.ddex.ern.v43.RatingAgencyR\x06agency\x122\n" +
-	"\x06reason\x18\x03 \x01(\v2\x1a.ddex.ern.v43.RatingReasonR\x06reason\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x06reason\x18\x03 \x01(\v2\x1a.ddex.ern.v43.RatingReasonR\x06reason\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\"\x80\x02\n" +
-	"\x10CLineWithDefault\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ec_line_company\x18\x02 \x01(\tR\fcLineCompany\x12\x1e\n" +
-	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"is_default\x18\x05 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x87\x03\n" +
+	"\x10CLineWithDefault\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ec_line_company\x18\x02 \x01(\tH\x01R\fcLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\"a\n" +
+	"is_default\x18\x05 \x01(\bH\x03R\tisDefault\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x04R\x15languageAndScriptCode\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_c_line_companyB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"i\n" +
 	"\aChannel\x12B\n" +
 	"\x0eproprietary_id\x18\x01 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x12\n" +
-	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\"\xbd\x05\n" +
+	"\x05u_r_l\x18\x02 \x03(\tR\x03uRLJ\x06\b\xa8F\x10\x90N\"\xe0\x06\n" +
 	"\aChapter\x12+\n" +
 	"\x11chapter_reference\x18\x01 \x01(\tR\x10chapterReference\x12:\n" +
 	"\n" +
 	"chapter_id\x18\x02 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\tchapterId\x12L\n" +
 	"\x12display_title_text\x18\x03 \x03(\v2\x1e.ddex.ern.v43.DisplayTitleTextR\x10displayTitleText\x12?\n" +
 	"\rdisplay_title\x18\x04 \x03(\v2\x1a.ddex.ern.v43.DisplayTitleR\fdisplayTitle\x12H\n" +
-	"\x10additional_title\x18\x05 \x03(\v2\x1d.ddex.ern.v43.AdditionalTitleR\x0fadditionalTitle\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\x12;\n" +
+	"\x10additional_title\x18\x05 \x03(\v2\x1d.ddex.ern.v43.AdditionalTitleR\x0fadditionalTitle\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12;\n" +
 	"\vcontributor\x18\a \x03(\v2\x19.ddex.ern.v43.ContributorR\vcontributor\x125\n" +
-	"\tcharacter\x18\b \x03(\v2\x17.ddex.ern.v43.CharacterR\tcharacter\x12D\n" +
-	"\x1erepresentative_image_reference\x18\t \x01(\tR\x1crepresentativeImageReference\x12\x1d\n" +
+	"\tcharacter\x18\b \x03(\v2\x17.ddex.ern.v43.CharacterR\tcharacter\x12I\n" +
+	"\x1erepresentative_image_reference\x18\t \x01(\tH\x01R\x1crepresentativeImageReference\x88\x01\x01\x12\"\n" +
 	"\n" +
 	"start_time\x18\n" +
-	" \x01(\tR\tstartTime\x12\x1a\n" +
-	"\bduration\x18\v \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\f \x01(\tR\aendTime\x127\n" +
-	"\x18language_and_script_code\x18\r \x01(\tR\x15languageAndScriptCode\"w\n" +
+	" \x01(\tH\x02R\tstartTime\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\v \x01(\tH\x03R\bduration\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\f \x01(\tH\x04R\aendTime\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\r \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB!\n" +
+	"\x1f_representative_image_referenceB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_durationB\v\n" +
+	"\t_end_timeB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa1\x01\n" +
 	"\vChapterList\x12/\n" +
-	"\achapter\x18\x01 \x03(\v2\x15.ddex.ern.v43.ChapterR\achapter\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xa9\x01\n" +
+	"\achapter\x18\x01 \x03(\v2\x15.ddex.ern.v43.ChapterR\achapter\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xca\x01\n" +
 	"\tCharacter\x12:\n" +
 	"\x19character_party_reference\x18\x01 \x01(\tR\x17characterPartyReference\x127\n" +
-	"\tperformer\x18\x02 \x01(\v2\x19.ddex.ern.v43.ContributorR\tperformer\x12'\n" +
-	"\x0fsequence_number\x18\x03 \x01(\x05R\x0esequenceNumber\"\xc3\x01\n" +
+	"\tperformer\x18\x02 \x01(\v2\x19.ddex.ern.v43.ContributorR\tperformer\x12,\n" +
+	"\x0fsequence_number\x18\x03 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x81\x02\n" +
 	"\vClipDetails\x123\n" +
-	"\tclip_type\x18\x01 \x01(\v2\x16.ddex.ern.v43.ClipTypeR\bclipType\x12&\n" +
-	"\x0ftop_left_corner\x18\x02 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x03 \x01(\tR\x11bottomRightCorner\x12'\n" +
-	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionType\"\xee\x04\n" +
+	"\tclip_type\x18\x01 \x01(\v2\x16.ddex.ern.v43.ClipTypeR\bclipType\x12+\n" +
+	"\x0ftop_left_corner\x18\x02 \x01(\tH\x00R\rtopLeftCorner\x88\x01\x01\x123\n" +
+	"\x13bottom_right_corner\x18\x03 \x01(\tH\x01R\x11bottomRightCorner\x88\x01\x01\x12'\n" +
+	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionTypeB\x12\n" +
+	"\x10_top_left_cornerB\x16\n" +
+	"\x14_bottom_right_cornerJ\x06\b\xa8F\x10\x90N\"\xf6\x04\n" +
 	"\vClipRelease\x12+\n" +
 	"\x11release_reference\x18\x01 \x01(\tR\x10releaseReference\x126\n" +
 	"\n" +
@@ -18252,38 +18339,49 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x1arelease_resource_reference\x18\x06 \x01(\tR\x18releaseResourceReference\x12d\n" +
 	"\x17release_label_reference\x18\a \x03(\v2,.ddex.ern.v43.ReleaseLabelReferenceWithPartyR\x15releaseLabelReference\x126\n" +
 	"\x05genre\x18\b \x03(\v2 .ddex.ern.v43.GenreWithTerritoryR\x05genre\x12E\n" +
-	"\x0frelated_release\x18\t \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\"w\n" +
+	"\x0frelated_release\x18\t \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedReleaseJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13CommercialModelType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd0\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8e\x03\n" +
 	"\x1dConditionForRightsClaimPolicy\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x12\n" +
-	"\x04unit\x18\x02 \x01(\tR\x04unit\x12-\n" +
-	"\x12reference_creation\x18\x03 \x01(\tR\x11referenceCreation\x12-\n" +
-	"\x12relational_relator\x18\x04 \x01(\tR\x11relationalRelator\x12)\n" +
-	"\x10measurement_type\x18\x05 \x01(\tR\x0fmeasurementType\x12/\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x122\n" +
+	"\x12reference_creation\x18\x03 \x01(\tH\x00R\x11referenceCreation\x88\x01\x01\x12-\n" +
+	"\x12relational_relator\x18\x04 \x01(\tR\x11relationalRelator\x12.\n" +
+	"\x10measurement_type\x18\x05 \x01(\tH\x01R\x0fmeasurementType\x88\x01\x01\x12/\n" +
 	"\asegment\x18\x06 \x03(\v2\x15.ddex.ern.v43.SegmentR\asegment\x12K\n" +
-	"\x11service_exception\x18\a \x03(\v2\x1e.ddex.ern.v43.ServiceExceptionR\x10serviceException\"\x80\x04\n" +
+	"\x11service_exception\x18\a \x03(\v2\x1e.ddex.ern.v43.ServiceExceptionR\x10serviceExceptionB\x15\n" +
+	"\x13_reference_creationB\x13\n" +
+	"\x11_measurement_typeJ\x06\b\xa8F\x10\x90N\"\xf3\x04\n" +
 	"\vContributor\x12>\n" +
 	"\x1bcontributor_party_reference\x18\x01 \x01(\tR\x19contributorPartyReference\x121\n" +
 	"\x04role\x18\x02 \x03(\v2\x1d.ddex.ern.v43.ContributorRoleR\x04role\x12E\n" +
-	"\x0finstrument_type\x18\x03 \x03(\v2\x1c.ddex.ern.v43.InstrumentTypeR\x0einstrumentType\x12C\n" +
-	"\x1ehas_made_featured_contribution\x18\x04 \x01(\bR\x1bhasMadeFeaturedContribution\x12G\n" +
-	" has_made_contracted_contribution\x18\x05 \x01(\bR\x1dhasMadeContractedContribution\x129\n" +
+	"\x0finstrument_type\x18\x03 \x03(\v2\x1c.ddex.ern.v43.InstrumentTypeR\x0einstrumentType\x12H\n" +
+	"\x1ehas_made_featured_contribution\x18\x04 \x01(\bH\x00R\x1bhasMadeFeaturedContribution\x88\x01\x01\x12L\n" +
+	" has_made_contracted_contribution\x18\x05 \x01(\bH\x01R\x1dhasMadeContractedContribution\x88\x01\x01\x129\n" +
 	"\vis_credited\x18\x06 \x01(\v2\x18.ddex.ern.v43.IsCreditedR\n" +
 	"isCredited\x12E\n" +
-	"\x0fdisplay_credits\x18\a \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x12'\n" +
-	"\x0fsequence_number\x18\b \x01(\x05R\x0esequenceNumber\"b\n" +
+	"\x0fdisplay_credits\x18\a \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x12,\n" +
+	"\x0fsequence_number\x18\b \x01(\x05H\x02R\x0esequenceNumber\x88\x01\x01B!\n" +
+	"\x1f_has_made_featured_contributionB#\n" +
+	"!_has_made_contracted_contributionB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"j\n" +
 	"\bCoreArea\x12&\n" +
 	"\x0ftop_left_corner\x18\x01 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x02 \x01(\tR\x11bottomRightCorner\"\xc3\x01\n" +
+	"\x13bottom_right_corner\x18\x02 \x01(\tR\x11bottomRightCornerJ\x06\b\xa8F\x10\x90N\"\xa4\x02\n" +
 	"\x17CourtesyLineWithDefault\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xe1\a\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xd0\b\n" +
 	"\x03Cue\x12:\n" +
 	"\fcue_use_type\x18\x01 \x01(\v2\x18.ddex.ern.v43.CueUseTypeR\n" +
 	"cueUseType\x12@\n" +
@@ -18291,192 +18389,260 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x0ecue_vocal_type\x18\x03 \x01(\v2\x1a.ddex.ern.v43.CueVocalTypeR\fcueVocalType\x12b\n" +
 	"\x1acue_visual_perception_type\x18\x04 \x01(\v2%.ddex.ern.v43.CueVisualPerceptionTypeR\x17cueVisualPerceptionType\x126\n" +
 	"\n" +
-	"cue_origin\x18\x05 \x01(\v2\x17.ddex.ern.v43.CueOriginR\tcueOrigin\x12L\n" +
+	"cue_origin\x18\x05 \x01(\v2\x17.ddex.ern.v43.CueOriginR\tcueOrigin\x129\n" +
+	"\vresource_id\x18\x11 \x01(\v2\x18.ddex.ern.v43.ResourceIdR\n" +
+	"resourceId\x124\n" +
+	"\awork_id\x18\x12 \x01(\v2\x1b.ddex.ern.v43.MusicalWorkIdR\x06workId\x12L\n" +
 	"\x12display_title_text\x18\x06 \x03(\v2\x1e.ddex.ern.v43.DisplayTitleTextR\x10displayTitleText\x12?\n" +
 	"\rdisplay_title\x18\a \x03(\v2\x1a.ddex.ern.v43.DisplayTitleR\fdisplayTitle\x12H\n" +
 	"\x10additional_title\x18\b \x03(\v2\x1d.ddex.ern.v43.AdditionalTitleR\x0fadditionalTitle\x12;\n" +
-	"\vcontributor\x18\t \x03(\v2\x19.ddex.ern.v43.ContributorR\vcontributor\x12\x19\n" +
+	"\vcontributor\x18\t \x03(\v2\x19.ddex.ern.v43.ContributorR\vcontributor\x12\x1e\n" +
 	"\bis_dance\x18\n" +
-	" \x01(\bR\aisDance\x12.\n" +
-	"\x13has_musical_content\x18\v \x01(\bR\x11hasMusicalContent\x12*\n" +
+	" \x01(\bH\x00R\aisDance\x88\x01\x01\x123\n" +
+	"\x13has_musical_content\x18\v \x01(\bH\x01R\x11hasMusicalContent\x88\x01\x01\x12*\n" +
 	"\x06p_line\x18\f \x03(\v2\x13.ddex.ern.v43.PLineR\x05pLine\x12*\n" +
-	"\x06c_line\x18\r \x03(\v2\x13.ddex.ern.v43.CLineR\x05cLine\x12\x1d\n" +
+	"\x06c_line\x18\r \x03(\v2\x13.ddex.ern.v43.CLineR\x05cLine\x12\"\n" +
 	"\n" +
-	"start_time\x18\x0e \x01(\tR\tstartTime\x12\x1a\n" +
-	"\bduration\x18\x0f \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\x10 \x01(\tR\aendTime\x129\n" +
-	"\vresource_id\x18\x11 \x01(\v2\x18.ddex.ern.v43.ResourceIdR\n" +
-	"resourceId\x124\n" +
-	"\awork_id\x18\x12 \x01(\v2\x1b.ddex.ern.v43.MusicalWorkIdR\x06workId\"\xe0\x01\n" +
+	"start_time\x18\x0e \x01(\tH\x02R\tstartTime\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x0f \x01(\tH\x03R\bduration\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x10 \x01(\tH\x04R\aendTime\x88\x01\x01B\v\n" +
+	"\t_is_danceB\x16\n" +
+	"\x14_has_musical_contentB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_durationB\v\n" +
+	"\t_end_timeJ\x06\b\xa8F\x10\x90N\"\xe8\x01\n" +
 	"\bCueSheet\x12=\n" +
 	"\fcue_sheet_id\x18\x01 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\n" +
 	"cueSheetId\x12.\n" +
 	"\x13cue_sheet_reference\x18\x02 \x01(\tR\x11cueSheetReference\x12@\n" +
 	"\x0ecue_sheet_type\x18\x03 \x01(\v2\x1a.ddex.ern.v43.CueSheetTypeR\fcueSheetType\x12#\n" +
-	"\x03cue\x18\x04 \x03(\v2\x11.ddex.ern.v43.CueR\x03cue\"C\n" +
+	"\x03cue\x18\x04 \x03(\v2\x11.ddex.ern.v43.CueR\x03cueJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\fCueSheetList\x123\n" +
-	"\tcue_sheet\x18\x01 \x03(\v2\x16.ddex.ern.v43.CueSheetR\bcueSheet\"\xa3\x03\n" +
+	"\tcue_sheet\x18\x01 \x03(\v2\x16.ddex.ern.v43.CueSheetR\bcueSheetJ\x06\b\xa8F\x10\x90N\"\xd0\x03\n" +
 	"\x04Deal\x12%\n" +
-	"\x0edeal_reference\x18\x01 \x03(\tR\rdealReference\x12<\n" +
-	"\x1bis_communicated_out_of_band\x18\x02 \x01(\bR\x17isCommunicatedOutOfBand\x126\n" +
+	"\x0edeal_reference\x18\x01 \x03(\tR\rdealReference\x12A\n" +
+	"\x1bis_communicated_out_of_band\x18\x02 \x01(\bH\x00R\x17isCommunicatedOutOfBand\x88\x01\x01\x126\n" +
 	"\n" +
 	"deal_terms\x18\x03 \x01(\v2\x17.ddex.ern.v43.DealTermsR\tdealTerms\x12\x9a\x01\n" +
 	".deal_technical_resource_details_reference_list\x18\x04 \x01(\v27.ddex.ern.v43.DealTechnicalResourceDetailsReferenceListR)dealTechnicalResourceDetailsReferenceList\x12a\n" +
-	"\x19distribution_channel_page\x18\x05 \x03(\v2%.ddex.ern.v43.DistributionChannelPageR\x17distributionChannelPage\"\xf8\x01\n" +
+	"\x19distribution_channel_page\x18\x05 \x03(\v2%.ddex.ern.v43.DistributionChannelPageR\x17distributionChannelPageB\x1e\n" +
+	"\x1c_is_communicated_out_of_bandJ\x06\b\xa8F\x10\x90N\"\x80\x02\n" +
 	"\bDealList\x12<\n" +
 	"\frelease_deal\x18\x01 \x03(\v2\x19.ddex.ern.v43.ReleaseDealR\vreleaseDeal\x12N\n" +
 	"\x12release_visibility\x18\x02 \x03(\v2\x1f.ddex.ern.v43.ReleaseVisibilityR\x11releaseVisibility\x12^\n" +
-	"\x18track_release_visibility\x18\x03 \x03(\v2$.ddex.ern.v43.TrackReleaseVisibilityR\x16trackReleaseVisibility\"S\n" +
+	"\x18track_release_visibility\x18\x03 \x03(\v2$.ddex.ern.v43.TrackReleaseVisibilityR\x16trackReleaseVisibilityJ\x06\b\xa8F\x10\x90N\"[\n" +
 	"\x19DealResourceReferenceList\x126\n" +
-	"\x17deal_resource_reference\x18\x01 \x03(\tR\x15dealResourceReference\"\x85\x01\n" +
+	"\x17deal_resource_reference\x18\x01 \x03(\tR\x15dealResourceReferenceJ\x06\b\xa8F\x10\x90N\"\x8d\x01\n" +
 	")DealTechnicalResourceDetailsReferenceList\x12X\n" +
-	")deal_technical_resource_details_reference\x18\x01 \x03(\tR%dealTechnicalResourceDetailsReference\"\x97\v\n" +
-	"\tDealTerms\x12J\n" +
+	")deal_technical_resource_details_reference\x18\x01 \x03(\tR%dealTechnicalResourceDetailsReferenceJ\x06\b\xa8F\x10\x90N\"\xfb\v\n" +
+	"\tDealTerms\x12I\n" +
+	"\x0eterritory_code\x18\x0e \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\rterritoryCode\x12Z\n" +
+	"\x17excluded_territory_code\x18\x0f \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12J\n" +
 	"\x0fvalidity_period\x18\x01 \x03(\v2!.ddex.ern.v43.PeriodWithStartDateR\x0evalidityPeriod\x12U\n" +
 	"\x15commercial_model_type\x18\x02 \x03(\v2!.ddex.ern.v43.CommercialModelTypeR\x13commercialModelType\x12<\n" +
 	"\buse_type\x18\x03 \x03(\v2!.ddex.ern.v43.DiscoverableUseTypeR\auseType\x12O\n" +
 	"\x13user_interface_type\x18\x04 \x03(\v2\x1f.ddex.ern.v43.UserInterfaceTypeR\x11userInterfaceType\x12<\n" +
 	"\fcarrier_type\x18\x05 \x03(\v2\x19.ddex.ern.v43.CarrierTypeR\vcarrierType\x12f\n" +
-	"\x17technical_instantiation\x18\x06 \x01(\v2-.ddex.ern.v43.DealTermsTechnicalInstantiationR\x16technicalInstantiation\x12(\n" +
-	"\x10number_of_usages\x18\a \x01(\x05R\x0enumberOfUsages\x12O\n" +
+	"\x17technical_instantiation\x18\x06 \x01(\v2-.ddex.ern.v43.DealTermsTechnicalInstantiationR\x16technicalInstantiation\x12-\n" +
+	"\x10number_of_usages\x18\a \x01(\x05H\x00R\x0enumberOfUsages\x88\x01\x01\x12D\n" +
+	"\x14distribution_channel\x18\x10 \x03(\v2\x11.ddex.ern.v43.DSPR\x13distributionChannel\x12U\n" +
+	"\x1dexcluded_distribution_channel\x18\x11 \x03(\v2\x11.ddex.ern.v43.DSPR\x1bexcludedDistributionChannel\x12O\n" +
 	"\x13rights_claim_policy\x18\b \x03(\v2\x1f.ddex.ern.v43.RightsClaimPolicyR\x11rightsClaimPolicy\x12S\n" +
-	"\x11price_information\x18\t \x03(\v2&.ddex.ern.v43.PriceInformationWithTypeR\x10priceInformation\x12)\n" +
+	"\x11price_information\x18\t \x03(\v2&.ddex.ern.v43.PriceInformationWithTypeR\x10priceInformation\x12%\n" +
+	"\x0eis_promotional\x18\x12 \x01(\bR\risPromotional\x12H\n" +
+	"\x10promotional_code\x18\x13 \x01(\v2\x1d.ddex.ern.v43.PromotionalCodeR\x0fpromotionalCode\x12.\n" +
 	"\x11is_pre_order_deal\x18\n" +
-	" \x01(\bR\x0eisPreOrderDeal\x12v\n" +
+	" \x01(\bH\x01R\x0eisPreOrderDeal\x88\x01\x01\x12v\n" +
 	"#instant_gratification_resource_list\x18\v \x01(\v2'.ddex.ern.v43.DealResourceReferenceListR instantGratificationResourceList\x12H\n" +
-	"\x10physical_returns\x18\f \x01(\v2\x1d.ddex.ern.v43.PhysicalReturnsR\x0fphysicalReturns\x12@\n" +
-	"\x1dnumber_of_products_per_carton\x18\r \x01(\x05R\x19numberOfProductsPerCarton\x12I\n" +
-	"\x0eterritory_code\x18\x0e \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\rterritoryCode\x12Z\n" +
-	"\x17excluded_territory_code\x18\x0f \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12D\n" +
-	"\x14distribution_channel\x18\x10 \x03(\v2\x11.ddex.ern.v43.DSPR\x13distributionChannel\x12U\n" +
-	"\x1dexcluded_distribution_channel\x18\x11 \x03(\v2\x11.ddex.ern.v43.DSPR\x1bexcludedDistributionChannel\x12%\n" +
-	"\x0eis_promotional\x18\x12 \x01(\bR\risPromotional\x12H\n" +
-	"\x10promotional_code\x18\x13 \x01(\v2\x1d.ddex.ern.v43.PromotionalCodeR\x0fpromotionalCode\"\xcb\x01\n" +
+	"\x10physical_returns\x18\f \x01(\v2\x1d.ddex.ern.v43.PhysicalReturnsR\x0fphysicalReturns\x12E\n" +
+	"\x1dnumber_of_products_per_carton\x18\r \x01(\x05H\x02R\x19numberOfProductsPerCarton\x88\x01\x01B\x13\n" +
+	"\x11_number_of_usagesB\x14\n" +
+	"\x12_is_pre_order_dealB \n" +
+	"\x1e_number_of_products_per_cartonJ\x06\b\xa8F\x10\x90N\"\xe8\x01\n" +
 	"\x1fDealTermsTechnicalInstantiation\x12U\n" +
-	"\x15video_definition_type\x18\x01 \x01(\v2!.ddex.ern.v43.VideoDefinitionTypeR\x13videoDefinitionType\x12\x1f\n" +
-	"\vcoding_type\x18\x02 \x01(\tR\n" +
-	"codingType\x120\n" +
-	"\bbit_rate\x18\x03 \x01(\v2\x15.ddex.ern.v43.BitRateR\abitRate\"x\n" +
+	"\x15video_definition_type\x18\x01 \x01(\v2!.ddex.ern.v43.VideoDefinitionTypeR\x13videoDefinitionType\x12$\n" +
+	"\vcoding_type\x18\x02 \x01(\tH\x00R\n" +
+	"codingType\x88\x01\x01\x120\n" +
+	"\bbit_rate\x18\x03 \x01(\v2\x15.ddex.ern.v43.BitRateR\abitRateB\x0e\n" +
+	"\f_coding_typeJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\x05Deity\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"\x82\x02\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x8a\x02\n" +
 	"\x14DelegatedUsageRights\x120\n" +
 	"\buse_type\x18\x01 \x03(\v2\x15.ddex.ern.v43.UseTypeR\auseType\x12S\n" +
 	"\x1bperiod_of_rights_delegation\x18\x02 \x01(\v2\x14.ddex.ern.v43.PeriodR\x18periodOfRightsDelegation\x12c\n" +
-	"\x1eterritory_of_rights_delegation\x18\x03 \x03(\v2\x1e.ddex.ern.v43.AllTerritoryCodeR\x1bterritoryOfRightsDelegation\"\xc4\x01\n" +
+	"\x1eterritory_of_rights_delegation\x18\x03 \x03(\v2\x1e.ddex.ern.v43.AllTerritoryCodeR\x1bterritoryOfRightsDelegationJ\x06\b\xa8F\x10\x90N\"\xa5\x02\n" +
 	"\x18DescriptionWithTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\x87\x04\n" +
-	"\x1bDetailedResourceContributor\x121\n" +
-	"\x04role\x18\x01 \x03(\v2\x1d.ddex.ern.v43.ContributorRoleR\x04role\x12E\n" +
-	"\x0finstrument_type\x18\x02 \x03(\v2\x1c.ddex.ern.v43.InstrumentTypeR\x0einstrumentType\x12C\n" +
-	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bR\x1bhasMadeFeaturedContribution\x12G\n" +
-	" has_made_contracted_contribution\x18\x04 \x01(\bR\x1dhasMadeContractedContribution\x12E\n" +
-	"\x0fdisplay_credits\x18\x05 \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x128\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xfa\x04\n" +
+	"\x1bDetailedResourceContributor\x128\n" +
 	"\bparty_id\x18\x06 \x03(\v2\x1d.ddex.ern.v43.DetailedPartyIdR\apartyId\x126\n" +
 	"\n" +
-	"party_name\x18\a \x03(\v2\x17.ddex.ern.v43.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\b \x01(\x05R\x0esequenceNumber\"\xa0\x01\n" +
+	"party_name\x18\a \x03(\v2\x17.ddex.ern.v43.PartyNameR\tpartyName\x121\n" +
+	"\x04role\x18\x01 \x03(\v2\x1d.ddex.ern.v43.ContributorRoleR\x04role\x12E\n" +
+	"\x0finstrument_type\x18\x02 \x03(\v2\x1c.ddex.ern.v43.InstrumentTypeR\x0einstrumentType\x12H\n" +
+	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bH\x00R\x1bhasMadeFeaturedContribution\x88\x01\x01\x12L\n" +
+	" has_made_contracted_contribution\x18\x04 \x01(\bH\x01R\x1dhasMadeContractedContribution\x88\x01\x01\x12E\n" +
+	"\x0fdisplay_credits\x18\x05 \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x12,\n" +
+	"\x0fsequence_number\x18\b \x01(\x05H\x02R\x0esequenceNumber\x88\x01\x01B!\n" +
+	"\x1f_has_made_featured_contributionB#\n" +
+	"!_has_made_contracted_contributionB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xf0\x01\n" +
 	"\x13DiscoverableUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12'\n" +
-	"\x0fis_discoverable\x18\x03 \x01(\bR\x0eisDiscoverable\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xe6\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12,\n" +
+	"\x0fis_discoverable\x18\x03 \x01(\bH\x01R\x0eisDiscoverable\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x12\n" +
+	"\x10_is_discoverableB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x87\x03\n" +
 	"\rDisplayArtist\x124\n" +
 	"\x16artist_party_reference\x18\x01 \x01(\tR\x14artistPartyReference\x12O\n" +
 	"\x13display_artist_role\x18\x02 \x01(\v2\x1f.ddex.ern.v43.DisplayArtistRoleR\x11displayArtistRole\x12B\n" +
 	"\rartistic_role\x18\x03 \x03(\v2\x1d.ddex.ern.v43.ContributorRoleR\fartisticRole\x12a\n" +
-	"\x19title_display_information\x18\x04 \x03(\v2%.ddex.ern.v43.TitleDisplayInformationR\x17titleDisplayInformation\x12'\n" +
-	"\x0fsequence_number\x18\x05 \x01(\x05R\x0esequenceNumber\"\xff\x01\n" +
+	"\x19title_display_information\x18\x04 \x03(\v2%.ddex.ern.v43.TitleDisplayInformationR\x17titleDisplayInformation\x12,\n" +
+	"\x0fsequence_number\x18\x05 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x81\x03\n" +
 	"\x1cDisplayArtistNameWithDefault\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x125\n" +
-	"\x17is_in_original_language\x18\x03 \x01(\bR\x14isInOriginalLanguage\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x03 \x01(\bH\x01R\x14isInOriginalLanguage\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\"\xa9\x01\n" +
+	"is_default\x18\x05 \x01(\bH\x03R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1a\n" +
+	"\x18_is_in_original_languageB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x81\x02\n" +
 	"\x0fDisplaySubTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fsequence_number\x18\x02 \x01(\x05R\x0esequenceNumber\x121\n" +
-	"\x15is_displayed_in_title\x18\x03 \x01(\bR\x12isDisplayedInTitle\x12$\n" +
-	"\x0esub_title_type\x18\x04 \x01(\tR\fsubTitleType\"\xb4\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x126\n" +
+	"\x15is_displayed_in_title\x18\x03 \x01(\bH\x01R\x12isDisplayedInTitle\x88\x01\x01\x12)\n" +
+	"\x0esub_title_type\x18\x04 \x01(\tH\x02R\fsubTitleType\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x18\n" +
+	"\x16_is_displayed_in_titleB\x11\n" +
+	"\x0f_sub_title_typeJ\x06\b\xa8F\x10\x90N\"\xb6\x03\n" +
 	"\fDisplayTitle\x12\x1d\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\tR\ttitleText\x12:\n" +
-	"\tsub_title\x18\x02 \x03(\v2\x1d.ddex.ern.v43.DisplaySubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x03(\v2\x1d.ddex.ern.v43.DisplaySubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\x125\n" +
-	"\x17is_in_original_language\x18\x06 \x01(\bR\x14isInOriginalLanguage\"\xf3\x01\n" +
+	"is_default\x18\x05 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x06 \x01(\bH\x03R\x14isInOriginalLanguage\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1a\n" +
+	"\x18_is_in_original_languageJ\x06\b\xa8F\x10\x90N\"\xf5\x02\n" +
 	"\x10DisplayTitleText\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x125\n" +
-	"\x17is_in_original_language\x18\x05 \x01(\bR\x14isInOriginalLanguage\"\xb5\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x05 \x01(\bH\x03R\x14isInOriginalLanguage\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1a\n" +
+	"\x18_is_in_original_languageJ\x06\b\xa8F\x10\x90N\"\xdf\x01\n" +
 	"\x17DistributionChannelPage\x128\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x1d.ddex.ern.v43.DetailedPartyIdR\apartyId\x12/\n" +
-	"\tpage_name\x18\x02 \x01(\v2\x12.ddex.ern.v43.NameR\bpageName\x12\x12\n" +
-	"\x05u_r_l\x18\x03 \x01(\tR\x03uRL\x12\x1b\n" +
-	"\tuser_name\x18\x04 \x01(\tR\buserName\"\xc0\x03\n" +
+	"\tpage_name\x18\x02 \x01(\v2\x12.ddex.ern.v43.NameR\bpageName\x12\x17\n" +
+	"\x05u_r_l\x18\x03 \x01(\tH\x00R\x03uRL\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x04 \x01(\tH\x01R\buserName\x88\x01\x01B\b\n" +
+	"\x06_u_r_lB\f\n" +
+	"\n" +
+	"_user_nameJ\x06\b\xa8F\x10\x90N\"\xb3\x04\n" +
 	"\x12EditionContributor\x12>\n" +
 	"\x1bcontributor_party_reference\x18\x01 \x01(\tR\x19contributorPartyReference\x121\n" +
-	"\x04role\x18\x02 \x03(\v2\x1d.ddex.ern.v43.ContributorRoleR\x04role\x12C\n" +
-	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bR\x1bhasMadeFeaturedContribution\x12G\n" +
-	" has_made_contracted_contribution\x18\x04 \x01(\bR\x1dhasMadeContractedContribution\x129\n" +
+	"\x04role\x18\x02 \x03(\v2\x1d.ddex.ern.v43.ContributorRoleR\x04role\x12H\n" +
+	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bH\x00R\x1bhasMadeFeaturedContribution\x88\x01\x01\x12L\n" +
+	" has_made_contracted_contribution\x18\x04 \x01(\bH\x01R\x1dhasMadeContractedContribution\x88\x01\x01\x129\n" +
 	"\vis_credited\x18\x05 \x01(\v2\x18.ddex.ern.v43.IsCreditedR\n" +
 	"isCredited\x12E\n" +
-	"\x0fdisplay_credits\x18\x06 \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x12'\n" +
-	"\x0fsequence_number\x18\a \x01(\x05R\x0esequenceNumber\"\x80\x02\n" +
+	"\x0fdisplay_credits\x18\x06 \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x12,\n" +
+	"\x0fsequence_number\x18\a \x01(\x05H\x02R\x0esequenceNumber\x88\x01\x01B!\n" +
+	"\x1f_has_made_featured_contributionB#\n" +
+	"!_has_made_contracted_contributionB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x83\x03\n" +
 	"\x19EventDateTimeWithoutFlags\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x04 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"\x84\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x04 \x01(\tH\x02R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x87\x03\n" +
 	"\x1dEventDateWithCurrentTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x04 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"\xae\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x04 \x01(\tH\x02R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x85\x02\n" +
 	"\x14EventDateWithDefault\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xfc\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xff\x02\n" +
 	"\x15EventDateWithoutFlags\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x04 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"\xae\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x04 \x01(\tH\x02R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xe2\x02\n" +
 	"\x14ExternalResourceLink\x12\x12\n" +
 	"\x05u_r_l\x18\x01 \x03(\tR\x03uRL\x12I\n" +
-	"\x0fvalidity_period\x18\x02 \x01(\v2 .ddex.ern.v43.PeriodWithoutFlagsR\x0evalidityPeriod\x12#\n" +
-	"\rexternal_link\x18\x03 \x01(\tR\fexternalLink\x12q\n" +
-	"\x1fexternally_linked_resource_type\x18\x04 \x03(\v2*.ddex.ern.v43.ExternallyLinkedResourceTypeR\x1cexternallyLinkedResourceType\x12\x1f\n" +
-	"\vfile_format\x18\x05 \x01(\tR\n" +
-	"fileFormat\"\xfd\x01\n" +
+	"\x0fvalidity_period\x18\x02 \x01(\v2 .ddex.ern.v43.PeriodWithoutFlagsR\x0evalidityPeriod\x12(\n" +
+	"\rexternal_link\x18\x03 \x01(\tH\x00R\fexternalLink\x88\x01\x01\x12q\n" +
+	"\x1fexternally_linked_resource_type\x18\x04 \x03(\v2*.ddex.ern.v43.ExternallyLinkedResourceTypeR\x1cexternallyLinkedResourceType\x12$\n" +
+	"\vfile_format\x18\x05 \x01(\tH\x01R\n" +
+	"fileFormat\x88\x01\x01B\x10\n" +
+	"\x0e_external_linkB\x0e\n" +
+	"\f_file_formatJ\x06\b\xa8F\x10\x90N\"\xa9\x02\n" +
 	"\vFingerprint\x12D\n" +
-	"\talgorithm\x18\x01 \x01(\v2&.ddex.ern.v43.FingerprintAlgorithmTypeR\talgorithm\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tparameter\x18\x03 \x01(\tR\tparameter\x12&\n" +
+	"\talgorithm\x18\x01 \x01(\v2&.ddex.ern.v43.FingerprintAlgorithmTypeR\talgorithm\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tparameter\x18\x03 \x01(\tH\x01R\tparameter\x88\x01\x01\x12&\n" +
 	"\x04file\x18\x04 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x12\x1b\n" +
 	"\tdata_type\x18\x05 \x01(\tR\bdataType\x12+\n" +
-	"\x11fingerprint_value\x18\x06 \x01(\tR\x10fingerprintValue\"\x87\x01\n" +
+	"\x11fingerprint_value\x18\x06 \x01(\tR\x10fingerprintValueB\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_parameterJ\x06\b\xa8F\x10\x90N\"\xc2\x01\n" +
 	"\x1bHdrVideoDynamicMetadataType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x128\n" +
-	"\x18sdr_derivation_permitted\x18\x03 \x01(\bR\x16sdrDerivationPermitted\"\xb7\r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12=\n" +
+	"\x18sdr_derivation_permitted\x18\x03 \x01(\bH\x01R\x16sdrDerivationPermitted\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\x1b\n" +
+	"\x19_sdr_derivation_permittedJ\x06\b\xa8F\x10\x90N\"\x9b\x0e\n" +
 	"\x05Image\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12+\n" +
 	"\x04type\x18\x02 \x01(\v2\x17.ddex.ern.v43.ImageTypeR\x04type\x12D\n" +
@@ -18498,106 +18664,141 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x10 \x03(\v2*.ddex.ern.v43.FulfillmentDateWithTerritoryR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x11 \x03(\v2..ddex.ern.v43.ParentalWarningTypeWithTerritoryR\x13parentalWarningType\x12E\n" +
 	"\x0frelated_release\x18\x12 \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\x13 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x126\n" +
-	"\x17contains_hidden_content\x18\x14 \x01(\bR\x15containsHiddenContent\x12H\n" +
+	"\x10related_resource\x18\x13 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12;\n" +
+	"\x17contains_hidden_content\x18\x14 \x01(\bH\x00R\x15containsHiddenContent\x88\x01\x01\x12H\n" +
 	"\vdescription\x18\x15 \x03(\v2&.ddex.ern.v43.DescriptionWithTerritoryR\vdescription\x12P\n" +
-	"\x11technical_details\x18\x16 \x03(\v2#.ddex.ern.v43.TechnicalImageDetailsR\x10technicalDetails\x127\n" +
-	"\x18language_and_script_code\x18\x17 \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x18 \x01(\bR\x0eisSupplemental\"F\n" +
+	"\x11technical_details\x18\x16 \x03(\v2#.ddex.ern.v43.TechnicalImageDetailsR\x10technicalDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x17 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x18 \x01(\bH\x02R\x0eisSupplemental\x88\x01\x01B\x1a\n" +
+	"\x18_contains_hidden_contentB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"e\n" +
 	"\n" +
 	"IsCredited\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\"\n" +
-	"\rmay_be_shared\x18\x02 \x01(\bR\vmayBeShared\"\xb3\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
+	"\rmay_be_shared\x18\x02 \x01(\bH\x00R\vmayBeShared\x88\x01\x01B\x10\n" +
+	"\x0e_may_be_sharedJ\x06\b\xa8F\x10\x90N\"\xd6\x03\n" +
 	"\x1eLinkedReleaseResourceReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12)\n" +
-	"\x10link_description\x18\x02 \x01(\tR\x0flinkDescription\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1c\n" +
-	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x05 \x01(\tR\x10userDefinedValue\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\x12\"\n" +
-	"\ris_multi_file\x18\a \x01(\bR\visMultiFile\"\xa8\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
+	"\x10link_description\x18\x02 \x01(\tH\x00R\x0flinkDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x05 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x04R\x0esequenceNumber\x88\x01\x01\x12'\n" +
+	"\ris_multi_file\x18\a \x01(\bH\x05R\visMultiFile\x88\x01\x01B\x13\n" +
+	"\x11_link_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x12\n" +
+	"\x10_sequence_numberB\x10\n" +
+	"\x0e_is_multi_fileJ\x06\b\xa8F\x10\x90N\"\xb0\x02\n" +
 	"\x18LocationAndDateOfSession\x12<\n" +
 	"\fsession_type\x18\x01 \x03(\v2\x19.ddex.ern.v43.SessionTypeR\vsessionType\x12,\n" +
 	"\x06period\x18\x02 \x01(\v2\x14.ddex.ern.v43.PeriodR\x06period\x12)\n" +
 	"\x05venue\x18\x03 \x03(\v2\x13.ddex.ern.v43.VenueR\x05venue\x126\n" +
 	"\acomment\x18\x04 \x01(\v2\x1c.ddex.ern.v43.TextWithFormatR\acomment\x12=\n" +
-	"\vcontributor\x18\x05 \x03(\v2\x1b.ddex.ern.v43.PartyWithRoleR\vcontributor\"\xdd\x02\n" +
+	"\vcontributor\x18\x05 \x03(\v2\x1b.ddex.ern.v43.PartyWithRoleR\vcontributorJ\x06\b\xa8F\x10\x90N\"\xe5\x02\n" +
 	"\x05Party\x12'\n" +
-	"\x0fparty_reference\x18\x01 \x01(\tR\x0epartyReference\x12;\n" +
-	"\vaffiliation\x18\x02 \x03(\v2\x19.ddex.ern.v43.AffiliationR\vaffiliation\x12?\n" +
-	"\rrelated_party\x18\x03 \x03(\v2\x1a.ddex.ern.v43.RelatedPartyR\frelatedParty\x12.\n" +
-	"\x13artist_profile_page\x18\x04 \x03(\tR\x11artistProfilePage\x128\n" +
+	"\x0fparty_reference\x18\x01 \x01(\tR\x0epartyReference\x128\n" +
 	"\bparty_id\x18\x05 \x03(\v2\x1d.ddex.ern.v43.DetailedPartyIdR\apartyId\x12C\n" +
 	"\n" +
-	"party_name\x18\x06 \x03(\v2$.ddex.ern.v43.PartyNameWithTerritoryR\tpartyName\"6\n" +
+	"party_name\x18\x06 \x03(\v2$.ddex.ern.v43.PartyNameWithTerritoryR\tpartyName\x12;\n" +
+	"\vaffiliation\x18\x02 \x03(\v2\x19.ddex.ern.v43.AffiliationR\vaffiliation\x12?\n" +
+	"\rrelated_party\x18\x03 \x03(\v2\x1a.ddex.ern.v43.RelatedPartyR\frelatedParty\x12.\n" +
+	"\x13artist_profile_page\x18\x04 \x03(\tR\x11artistProfilePageJ\x06\b\xa8F\x10\x90N\">\n" +
 	"\tPartyList\x12)\n" +
-	"\x05party\x18\x01 \x03(\v2\x13.ddex.ern.v43.PartyR\x05party\"\xf6\x05\n" +
+	"\x05party\x18\x01 \x03(\v2\x13.ddex.ern.v43.PartyR\x05partyJ\x06\b\xa8F\x10\x90N\"\xe0\a\n" +
 	"\x16PartyNameWithTerritory\x12/\n" +
-	"\tfull_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12>\n" +
+	"\tfull_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12>\n" +
 	"\x11full_name_indexed\x18\x03 \x01(\v2\x12.ddex.ern.v43.NameR\x0ffullNameIndexed\x12E\n" +
 	"\x15names_before_key_name\x18\x04 \x01(\v2\x12.ddex.ern.v43.NameR\x12namesBeforeKeyName\x12-\n" +
 	"\bkey_name\x18\x05 \x01(\v2\x12.ddex.ern.v43.NameR\akeyName\x12C\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v2\x12.ddex.ern.v43.NameR\x11namesAfterKeyName\x12=\n" +
-	"\x10abbreviated_name\x18\a \x01(\v2\x12.ddex.ern.v43.NameR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\x12\x1f\n" +
-	"\vis_nickname\x18\t \x01(\bR\n" +
-	"isNickname\x12\"\n" +
+	"\x10abbreviated_name\x18\a \x01(\v2\x12.ddex.ern.v43.NameR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12$\n" +
+	"\vis_nickname\x18\t \x01(\bH\x02R\n" +
+	"isNickname\x88\x01\x01\x12'\n" +
 	"\ris_stage_name\x18\n" +
-	" \x01(\bR\visStageName\x12\"\n" +
-	"\ris_legal_name\x18\v \x01(\bR\visLegalName\x12:\n" +
-	"\x19applicable_territory_code\x18\f \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\bH\x03R\visStageName\x88\x01\x01\x12'\n" +
+	"\ris_legal_name\x18\v \x01(\bH\x04R\visLegalName\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\f \x01(\tH\x05R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\r \x01(\bR\tisDefault\x125\n" +
-	"\x17is_in_original_language\x18\x0e \x01(\bR\x14isInOriginalLanguage\"\xbd\x02\n" +
-	"\rPartyWithRole\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12B\n" +
+	"is_default\x18\r \x01(\bH\x06R\tisDefault\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x0e \x01(\bH\aR\x14isInOriginalLanguage\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x1b\n" +
+	"\x19_language_and_script_codeB\x0e\n" +
+	"\f_is_nicknameB\x10\n" +
+	"\x0e_is_stage_nameB\x10\n" +
+	"\x0e_is_legal_nameB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1a\n" +
+	"\x18_is_in_original_languageJ\x06\b\xa8F\x10\x90N\"\x8f\x03\n" +
+	"\rPartyWithRole\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12B\n" +
 	"\x0eproprietary_id\x18\x05 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12C\n" +
 	"\n" +
 	"party_name\x18\x06 \x01(\v2$.ddex.ern.v43.PartyNameWithTerritoryR\tpartyName\x129\n" +
-	"\x04role\x18\a \x01(\v2%.ddex.ern.v43.ResourceContributorRoleR\x04role\"\xc7\x02\n" +
+	"\x04role\x18\a \x01(\v2%.ddex.ern.v43.ResourceContributorRoleR\x04roleB\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
+	"\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nJ\x06\b\xa8F\x10\x90N\"\xcf\x02\n" +
 	"\x13PeriodWithStartDate\x12J\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2+.ddex.ern.v43.EventDateWithCurrentTerritoryR\tstartDate\x12F\n" +
 	"\bend_date\x18\x02 \x01(\v2+.ddex.ern.v43.EventDateWithCurrentTerritoryR\aendDate\x12O\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2'.ddex.ern.v43.EventDateTimeWithoutFlagsR\rstartDateTime\x12K\n" +
-	"\rend_date_time\x18\x04 \x01(\v2'.ddex.ern.v43.EventDateTimeWithoutFlagsR\vendDateTime\"\xc6\x02\n" +
+	"\rend_date_time\x18\x04 \x01(\v2'.ddex.ern.v43.EventDateTimeWithoutFlagsR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"\xce\x02\n" +
 	"\x12PeriodWithoutFlags\x12J\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2+.ddex.ern.v43.EventDateWithCurrentTerritoryR\tstartDate\x12F\n" +
 	"\bend_date\x18\x02 \x01(\v2+.ddex.ern.v43.EventDateWithCurrentTerritoryR\aendDate\x12O\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2'.ddex.ern.v43.EventDateTimeWithoutFlagsR\rstartDateTime\x12K\n" +
-	"\rend_date_time\x18\x04 \x01(\v2'.ddex.ern.v43.EventDateTimeWithoutFlagsR\vendDateTime\"\x93\x01\n" +
+	"\rend_date_time\x18\x04 \x01(\v2'.ddex.ern.v43.EventDateTimeWithoutFlagsR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"\xc5\x01\n" +
 	"\x0fPhysicalReturns\x128\n" +
-	"\x18physical_returns_allowed\x18\x01 \x01(\bR\x16physicalReturnsAllowed\x12F\n" +
-	" latest_date_for_physical_returns\x18\x02 \x01(\tR\x1clatestDateForPhysicalReturns\"\xb8\x03\n" +
+	"\x18physical_returns_allowed\x18\x01 \x01(\bR\x16physicalReturnsAllowed\x12K\n" +
+	" latest_date_for_physical_returns\x18\x02 \x01(\tH\x00R\x1clatestDateForPhysicalReturns\x88\x01\x01B#\n" +
+	"!_latest_date_for_physical_returnsJ\x06\b\xa8F\x10\x90N\"\x83\x04\n" +
 	"\x18PriceInformationWithType\x126\n" +
 	"\n" +
 	"price_code\x18\x01 \x01(\v2\x17.ddex.ern.v43.PriceTypeR\tpriceCode\x12L\n" +
 	"\x18wholesale_price_per_unit\x18\x02 \x01(\v2\x13.ddex.ern.v43.PriceR\x15wholesalePricePerUnit\x12`\n" +
 	"#bulk_order_wholesale_price_per_unit\x18\x03 \x01(\v2\x13.ddex.ern.v43.PriceR\x1ebulkOrderWholesalePricePerUnit\x12I\n" +
-	"\x16suggested_retail_price\x18\x04 \x01(\v2\x13.ddex.ern.v43.PriceR\x14suggestedRetailPrice\x12\x1d\n" +
+	"\x16suggested_retail_price\x18\x04 \x01(\v2\x13.ddex.ern.v43.PriceR\x14suggestedRetailPrice\x12\"\n" +
+	"\n" +
+	"price_type\x18\x05 \x01(\tH\x00R\tpriceType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\r\n" +
+	"\v_price_typeB\f\n" +
 	"\n" +
-	"price_type\x18\x05 \x01(\tR\tpriceType\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xbf\x01\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc7\x01\n" +
 	"\rPurgedRelease\x126\n" +
 	"\n" +
 	"release_id\x18\x01 \x01(\v2\x17.ddex.ern.v43.ReleaseIdR\treleaseId\x12)\n" +
 	"\x05title\x18\x02 \x03(\v2\x13.ddex.ern.v43.TitleR\x05title\x12K\n" +
-	"\vcontributor\x18\x03 \x03(\v2).ddex.ern.v43.DetailedResourceContributorR\vcontributor\"w\n" +
+	"\vcontributor\x18\x03 \x03(\v2).ddex.ern.v43.DetailedResourceContributorR\vcontributorJ\x06\b\xa8F\x10\x90N\"\xb6\x01\n" +
 	"\x04Raga\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"s\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fRecordingFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xde\x05\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xe6\x05\n" +
 	"\x0eRelatedRelease\x12a\n" +
 	"\x19release_relationship_type\x18\x01 \x01(\v2%.ddex.ern.v43.ReleaseRelationshipTypeR\x17releaseRelationshipType\x126\n" +
 	"\n" +
@@ -18608,13 +18809,13 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x13display_artist_name\x18\x06 \x03(\v2*.ddex.ern.v43.DisplayArtistNameWithDefaultR\x11displayArtistName\x12[\n" +
 	"\x17release_label_reference\x18\a \x03(\v2#.ddex.ern.v43.ReleaseLabelReferenceR\x15releaseLabelReference\x12F\n" +
 	"\frelease_date\x18\b \x01(\v2#.ddex.ern.v43.EventDateWithoutFlagsR\vreleaseDate\x12W\n" +
-	"\x15original_release_date\x18\t \x01(\v2#.ddex.ern.v43.EventDateWithoutFlagsR\x13originalReleaseDate\"\x87\x02\n" +
+	"\x15original_release_date\x18\t \x01(\v2#.ddex.ern.v43.EventDateWithoutFlagsR\x13originalReleaseDateJ\x06\b\xa8F\x10\x90N\"\x8f\x02\n" +
 	"\x0fRelatedResource\x12<\n" +
-	"\x1aresource_relationship_type\x18\x01 \x01(\tR\x18resourceRelationshipType\x12,\n" +
-	"\x06timing\x18\x02 \x03(\v2\x14.ddex.ern.v43.TimingR\x06timing\x12M\n" +
+	"\x1aresource_relationship_type\x18\x01 \x01(\tR\x18resourceRelationshipType\x12M\n" +
 	"#resource_related_resource_reference\x18\x03 \x01(\tR resourceRelatedResourceReference\x129\n" +
 	"\vresource_id\x18\x04 \x01(\v2\x18.ddex.ern.v43.ResourceIdR\n" +
-	"resourceId\"\xbb\x13\n" +
+	"resourceId\x12,\n" +
+	"\x06timing\x18\x02 \x03(\v2\x14.ddex.ern.v43.TimingR\x06timingJ\x06\b\xa8F\x10\x90N\"\xdf\x14\n" +
 	"\aRelease\x12+\n" +
 	"\x11release_reference\x18\x01 \x01(\tR\x10releaseReference\x12R\n" +
 	"\frelease_type\x18\x02 \x03(\v2/.ddex.ern.v43.ReleaseTypeForReleaseNotificationR\vreleaseType\x126\n" +
@@ -18631,8 +18832,8 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x1dadministrating_record_company\x18\v \x03(\v26.ddex.ern.v43.AdministratingRecordCompanyWithReferenceR\x1badministratingRecordCompany\x125\n" +
 	"\x06p_line\x18\f \x03(\v2\x1e.ddex.ern.v43.PLineWithDefaultR\x05pLine\x125\n" +
 	"\x06c_line\x18\r \x03(\v2\x1e.ddex.ern.v43.CLineWithDefaultR\x05cLine\x12J\n" +
-	"\rcourtesy_line\x18\x0e \x03(\v2%.ddex.ern.v43.CourtesyLineWithDefaultR\fcourtesyLine\x12\x1a\n" +
-	"\bduration\x18\x0f \x01(\tR\bduration\x126\n" +
+	"\rcourtesy_line\x18\x0e \x03(\v2%.ddex.ern.v43.CourtesyLineWithDefaultR\fcourtesyLine\x12\x1f\n" +
+	"\bduration\x18\x0f \x01(\tH\x00R\bduration\x88\x01\x01\x126\n" +
 	"\x05genre\x18\x10 \x03(\v2 .ddex.ern.v43.GenreWithTerritoryR\x05genre\x12E\n" +
 	"\frelease_date\x18\x11 \x03(\v2\".ddex.ern.v43.EventDateWithDefaultR\vreleaseDate\x12V\n" +
 	"\x15original_release_date\x18\x12 \x03(\v2\".ddex.ern.v43.EventDateWithDefaultR\x13originalReleaseDate\x12@\n" +
@@ -18640,94 +18841,130 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x15parental_warning_type\x18\x14 \x03(\v2..ddex.ern.v43.ParentalWarningTypeWithTerritoryR\x13parentalWarningType\x123\n" +
 	"\tav_rating\x18\x15 \x03(\v2\x16.ddex.ern.v43.AvRatingR\bavRating\x12E\n" +
 	"\x0frelated_release\x18\x16 \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\x17 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12B\n" +
+	"\x10related_resource\x18\x17 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12?\n" +
+	"\x1cis_single_artist_compilation\x18$ \x01(\bR\x19isSingleArtistCompilation\x12=\n" +
+	"\x1bis_multi_artist_compilation\x18% \x01(\bR\x18isMultiArtistCompilation\x12B\n" +
 	"\x0eresource_group\x18\x18 \x01(\v2\x1b.ddex.ern.v43.ResourceGroupR\rresourceGroup\x12X\n" +
-	"\x16external_resource_link\x18\x19 \x03(\v2\".ddex.ern.v43.ExternalResourceLinkR\x14externalResourceLink\x12\x1f\n" +
-	"\ftarget_u_r_l\x18\x1a \x01(\tR\ttargetURL\x12?\n" +
+	"\x16external_resource_link\x18\x19 \x03(\v2\".ddex.ern.v43.ExternalResourceLinkR\x14externalResourceLink\x12$\n" +
+	"\ftarget_u_r_l\x18\x1a \x01(\tH\x01R\ttargetURL\x88\x01\x01\x12?\n" +
 	"\bkeywords\x18\x1b \x03(\v2#.ddex.ern.v43.KeywordsWithTerritoryR\bkeywords\x12?\n" +
 	"\bsynopsis\x18\x1c \x03(\v2#.ddex.ern.v43.SynopsisWithTerritoryR\bsynopsis\x12&\n" +
 	"\x04raga\x18\x1d \x03(\v2\x12.ddex.ern.v43.RagaR\x04raga\x12&\n" +
 	"\x04tala\x18\x1e \x03(\v2\x12.ddex.ern.v43.TalaR\x04tala\x12)\n" +
-	"\x05deity\x18\x1f \x03(\v2\x13.ddex.ern.v43.DeityR\x05deity\x127\n" +
-	"\x18hi_res_music_description\x18  \x01(\tR\x15hiResMusicDescription\x12#\n" +
-	"\ris_soundtrack\x18! \x01(\bR\fisSoundtrack\x12%\n" +
-	"\x0fis_hi_res_music\x18\" \x01(\bR\fisHiResMusic\x12K\n" +
-	"\x11marketing_comment\x18# \x03(\v2\x1e.ddex.ern.v43.MarketingCommentR\x10marketingComment\x12?\n" +
-	"\x1cis_single_artist_compilation\x18$ \x01(\bR\x19isSingleArtistCompilation\x12=\n" +
-	"\x1bis_multi_artist_compilation\x18% \x01(\bR\x18isMultiArtistCompilation\x127\n" +
-	"\x18language_and_script_code\x18& \x01(\tR\x15languageAndScriptCode\"\x9c\x01\n" +
+	"\x05deity\x18\x1f \x03(\v2\x13.ddex.ern.v43.DeityR\x05deity\x12<\n" +
+	"\x18hi_res_music_description\x18  \x01(\tH\x02R\x15hiResMusicDescription\x88\x01\x01\x12(\n" +
+	"\ris_soundtrack\x18! \x01(\bH\x03R\fisSoundtrack\x88\x01\x01\x12*\n" +
+	"\x0fis_hi_res_music\x18\" \x01(\bH\x04R\fisHiResMusic\x88\x01\x01\x12K\n" +
+	"\x11marketing_comment\x18# \x03(\v2\x1e.ddex.ern.v43.MarketingCommentR\x10marketingComment\x12<\n" +
+	"\x18language_and_script_code\x18& \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\v\n" +
+	"\t_durationB\x0f\n" +
+	"\r_target_u_r_lB\x1b\n" +
+	"\x19_hi_res_music_descriptionB\x10\n" +
+	"\x0e_is_soundtrackB\x12\n" +
+	"\x10_is_hi_res_musicB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc3\x01\n" +
 	"\fReleaseAdmin\x12(\n" +
-	"\x10release_admin_id\x18\x01 \x01(\tR\x0ereleaseAdminId\x123\n" +
-	"\x15personnel_description\x18\x02 \x01(\tR\x14personnelDescription\x12-\n" +
-	"\x12system_description\x18\x03 \x03(\tR\x11systemDescription\"k\n" +
+	"\x10release_admin_id\x18\x01 \x01(\tR\x0ereleaseAdminId\x128\n" +
+	"\x15personnel_description\x18\x02 \x01(\tH\x00R\x14personnelDescription\x88\x01\x01\x12-\n" +
+	"\x12system_description\x18\x03 \x03(\tR\x11systemDescriptionB\x18\n" +
+	"\x16_personnel_descriptionJ\x06\b\xa8F\x10\x90N\"s\n" +
 	"\vReleaseDeal\x124\n" +
 	"\x16deal_release_reference\x18\x01 \x03(\tR\x14dealReleaseReference\x12&\n" +
-	"\x04deal\x18\x02 \x03(\v2\x12.ddex.ern.v43.DealR\x04deal\"\xbf\x01\n" +
-	"\tReleaseId\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_c_p_n\x18\x02 \x01(\tR\x04iCPN\x12B\n" +
+	"\x04deal\x18\x02 \x03(\v2\x12.ddex.ern.v43.DealR\x04dealJ\x06\b\xa8F\x10\x90N\"\xe7\x01\n" +
+	"\tReleaseId\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_c_p_n\x18\x02 \x01(\tH\x01R\x04iCPN\x88\x01\x01\x12B\n" +
 	"\x0ecatalog_number\x18\x03 \x01(\v2\x1b.ddex.ern.v43.CatalogNumberR\rcatalogNumber\x12B\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\"\xac\x02\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryIdB\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_c_p_nJ\x06\b\xa8F\x10\x90N\"\xd0\x03\n" +
 	"\x15ReleaseLabelReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\x12\x1d\n" +
+	"label_type\x18\x04 \x01(\tH\x02R\tlabelType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x03R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x04R\x10userDefinedValue\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\a \x01(\tH\x05R\x17applicableTerritoryCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_is_defaultB\r\n" +
+	"\v_label_typeB\f\n" +
 	"\n" +
-	"label_type\x18\x04 \x01(\tR\tlabelType\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\x12:\n" +
-	"\x19applicable_territory_code\x18\a \x01(\tR\x17applicableTerritoryCode\"\xe7\x02\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\xa9\x04\n" +
 	"\x1eReleaseLabelReferenceWithParty\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\x12\x1d\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"label_type\x18\x04 \x01(\tR\tlabelType\x120\n" +
-	"\x14access_control_party\x18\x05 \x01(\tR\x12accessControlParty\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\x12:\n" +
-	"\x19applicable_territory_code\x18\b \x01(\tR\x17applicableTerritoryCode\"\xbd\x01\n" +
+	"label_type\x18\x04 \x01(\tH\x02R\tlabelType\x88\x01\x01\x125\n" +
+	"\x14access_control_party\x18\x05 \x01(\tH\x03R\x12accessControlParty\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\b \x01(\tH\x06R\x17applicableTerritoryCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_is_defaultB\r\n" +
+	"\v_label_typeB\x17\n" +
+	"\x15_access_control_partyB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\xc5\x01\n" +
 	"\vReleaseList\x12/\n" +
 	"\arelease\x18\x01 \x01(\v2\x15.ddex.ern.v43.ReleaseR\arelease\x12?\n" +
 	"\rtrack_release\x18\x02 \x03(\v2\x1a.ddex.ern.v43.TrackReleaseR\ftrackRelease\x12<\n" +
-	"\fclip_release\x18\x03 \x03(\v2\x19.ddex.ern.v43.ClipReleaseR\vclipRelease\"\xc7\x04\n" +
+	"\fclip_release\x18\x03 \x03(\v2\x19.ddex.ern.v43.ClipReleaseR\vclipReleaseJ\x06\b\xa8F\x10\x90N\"\x9b\x06\n" +
 	"\x11ReleaseVisibility\x121\n" +
-	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12D\n" +
-	"\x1frelease_display_start_date_time\x18\x02 \x01(\tR\x1breleaseDisplayStartDateTime\x12G\n" +
-	"!cover_art_preview_start_date_time\x18\x03 \x01(\tR\x1ccoverArtPreviewStartDateTime\x12X\n" +
-	"*full_track_listing_preview_start_date_time\x18\x04 \x01(\tR$fullTrackListingPreviewStartDateTime\x12>\n" +
-	"\x1cclip_preview_start_date_time\x18\x05 \x01(\tR\x18clipPreviewStartDateTime\x12I\n" +
+	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12I\n" +
 	"\x0eterritory_code\x18\x06 \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\rterritoryCode\x12Z\n" +
-	"\x17excluded_territory_code\x18\a \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12/\n" +
-	"\x14do_not_display_dates\x18\b \x01(\bR\x11doNotDisplayDates\"\xb2\a\n" +
+	"\x17excluded_territory_code\x18\a \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12I\n" +
+	"\x1frelease_display_start_date_time\x18\x02 \x01(\tH\x00R\x1breleaseDisplayStartDateTime\x88\x01\x01\x12L\n" +
+	"!cover_art_preview_start_date_time\x18\x03 \x01(\tH\x01R\x1ccoverArtPreviewStartDateTime\x88\x01\x01\x12]\n" +
+	"*full_track_listing_preview_start_date_time\x18\x04 \x01(\tH\x02R$fullTrackListingPreviewStartDateTime\x88\x01\x01\x12C\n" +
+	"\x1cclip_preview_start_date_time\x18\x05 \x01(\tH\x03R\x18clipPreviewStartDateTime\x88\x01\x01\x124\n" +
+	"\x14do_not_display_dates\x18\b \x01(\bH\x04R\x11doNotDisplayDates\x88\x01\x01B\"\n" +
+	" _release_display_start_date_timeB$\n" +
+	"\"_cover_art_preview_start_date_timeB-\n" +
+	"+_full_track_listing_preview_start_date_timeB\x1f\n" +
+	"\x1d_clip_preview_start_date_timeB\x17\n" +
+	"\x15_do_not_display_datesJ\x06\b\xa8F\x10\x90N\"\xe5\a\n" +
 	"\rResourceGroup\x12L\n" +
 	"\x12display_title_text\x18\x01 \x03(\v2\x1e.ddex.ern.v43.DisplayTitleTextR\x10displayTitleText\x12?\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1a.ddex.ern.v43.DisplayTitleR\fdisplayTitle\x12H\n" +
-	"\x10additional_title\x18\x03 \x03(\v2\x1d.ddex.ern.v43.AdditionalTitleR\x0fadditionalTitle\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\x12B\n" +
+	"\x10additional_title\x18\x03 \x03(\v2\x1d.ddex.ern.v43.AdditionalTitleR\x0fadditionalTitle\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12.\n" +
+	"\x13no_display_sequence\x18\v \x01(\bR\x11noDisplaySequence\x12)\n" +
+	"\x10display_sequence\x18\f \x01(\tR\x0fdisplaySequence\x12B\n" +
 	"\x0edisplay_artist\x18\x05 \x03(\v2\x1b.ddex.ern.v43.DisplayArtistR\rdisplayArtist\x12<\n" +
-	"\fcarrier_type\x18\x06 \x03(\v2\x19.ddex.ern.v43.CarrierTypeR\vcarrierType\x12\x1a\n" +
-	"\bduration\x18\a \x01(\tR\bduration\x12E\n" +
+	"\fcarrier_type\x18\x06 \x03(\v2\x19.ddex.ern.v43.CarrierTypeR\vcarrierType\x12\x1f\n" +
+	"\bduration\x18\a \x01(\tH\x01R\bduration\x88\x01\x01\x12G\n" +
+	" resource_group_release_reference\x18\r \x01(\tR\x1dresourceGroupReleaseReference\x126\n" +
+	"\n" +
+	"release_id\x18\x0e \x01(\v2\x17.ddex.ern.v43.ReleaseIdR\treleaseId\x12E\n" +
 	"\x0eresource_group\x18\b \x03(\v2\x1e.ddex.ern.v43.ResourceSubGroupR\rresourceGroup\x12e\n" +
 	"\x1bresource_group_content_item\x18\t \x03(\v2&.ddex.ern.v43.ResourceGroupContentItemR\x18resourceGroupContentItem\x12w\n" +
 	"!linked_release_resource_reference\x18\n" +
-	" \x03(\v2,.ddex.ern.v43.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12.\n" +
-	"\x13no_display_sequence\x18\v \x01(\bR\x11noDisplaySequence\x12)\n" +
-	"\x10display_sequence\x18\f \x01(\tR\x0fdisplaySequence\x12G\n" +
-	" resource_group_release_reference\x18\r \x01(\tR\x1dresourceGroupReleaseReference\x126\n" +
-	"\n" +
-	"release_id\x18\x0e \x01(\v2\x17.ddex.ern.v43.ReleaseIdR\treleaseId\"\x92\x04\n" +
-	"\x18ResourceGroupContentItem\x12'\n" +
-	"\x0fsequence_number\x18\x01 \x01(\x05R\x0esequenceNumber\x12<\n" +
-	"\x1arelease_resource_reference\x18\x02 \x01(\tR\x18releaseResourceReference\x12w\n" +
-	"!linked_release_resource_reference\x18\x03 \x03(\v2,.ddex.ern.v43.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12*\n" +
-	"\x11is_bonus_resource\x18\x04 \x01(\bR\x0fisBonusResource\x12I\n" +
-	"!is_instant_gratification_resource\x18\x05 \x01(\bR\x1eisInstantGratificationResource\x12D\n" +
-	"\x1fis_pre_order_incentive_resource\x18\x06 \x01(\bR\x1bisPreOrderIncentiveResource\x12.\n" +
+	" \x03(\v2,.ddex.ern.v43.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReferenceB\x12\n" +
+	"\x10_sequence_numberB\v\n" +
+	"\t_durationJ\x06\b\xa8F\x10\x90N\"\xa2\x05\n" +
+	"\x18ResourceGroupContentItem\x12,\n" +
+	"\x0fsequence_number\x18\x01 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12.\n" +
 	"\x13no_display_sequence\x18\a \x01(\bR\x11noDisplaySequence\x12)\n" +
-	"\x10display_sequence\x18\b \x01(\tR\x0fdisplaySequence\"\xc2\x02\n" +
+	"\x10display_sequence\x18\b \x01(\tR\x0fdisplaySequence\x12<\n" +
+	"\x1arelease_resource_reference\x18\x02 \x01(\tR\x18releaseResourceReference\x12w\n" +
+	"!linked_release_resource_reference\x18\x03 \x03(\v2,.ddex.ern.v43.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12/\n" +
+	"\x11is_bonus_resource\x18\x04 \x01(\bH\x01R\x0fisBonusResource\x88\x01\x01\x12N\n" +
+	"!is_instant_gratification_resource\x18\x05 \x01(\bH\x02R\x1eisInstantGratificationResource\x88\x01\x01\x12I\n" +
+	"\x1fis_pre_order_incentive_resource\x18\x06 \x01(\bH\x03R\x1bisPreOrderIncentiveResource\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x14\n" +
+	"\x12_is_bonus_resourceB$\n" +
+	"\"_is_instant_gratification_resourceB\"\n" +
+	" _is_pre_order_incentive_resourceJ\x06\b\xa8F\x10\x90N\"\xca\x02\n" +
 	"\fResourceList\x12E\n" +
 	"\x0fsound_recording\x18\x01 \x03(\v2\x1c.ddex.ern.v43.SoundRecordingR\x0esoundRecording\x12)\n" +
 	"\x05video\x18\x02 \x03(\v2\x13.ddex.ern.v43.VideoR\x05video\x12)\n" +
@@ -18735,47 +18972,50 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x04text\x18\x04 \x03(\v2\x12.ddex.ern.v43.TextR\x04text\x129\n" +
 	"\vsheet_music\x18\x05 \x03(\v2\x18.ddex.ern.v43.SheetMusicR\n" +
 	"sheetMusic\x122\n" +
-	"\bsoftware\x18\x06 \x03(\v2\x16.ddex.ern.v43.SoftwareR\bsoftware\"\x98\x03\n" +
+	"\bsoftware\x18\x06 \x03(\v2\x16.ddex.ern.v43.SoftwareR\bsoftwareJ\x06\b\xa8F\x10\x90N\"\xb9\x03\n" +
 	"\x18ResourceRightsController\x12I\n" +
 	"!rights_controller_party_reference\x18\x01 \x01(\tR\x1erightsControllerPartyReference\x12.\n" +
-	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x12X\n" +
-	"\x16delegated_usage_rights\x18\x03 \x03(\v2\".ddex.ern.v43.DelegatedUsageRightsR\x14delegatedUsageRights\x12.\n" +
+	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x12.\n" +
 	"\x13right_share_unknown\x18\x04 \x01(\bR\x11rightShareUnknown\x12N\n" +
-	"\x16right_share_percentage\x18\x05 \x01(\v2\x18.ddex.ern.v43.PercentageR\x14rightSharePercentage\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\"\xe5\a\n" +
+	"\x16right_share_percentage\x18\x05 \x01(\v2\x18.ddex.ern.v43.PercentageR\x14rightSharePercentage\x12X\n" +
+	"\x16delegated_usage_rights\x18\x03 \x03(\v2\".ddex.ern.v43.DelegatedUsageRightsR\x14delegatedUsageRights\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x98\b\n" +
 	"\x10ResourceSubGroup\x12L\n" +
 	"\x12display_title_text\x18\x01 \x03(\v2\x1e.ddex.ern.v43.DisplayTitleTextR\x10displayTitleText\x12?\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1a.ddex.ern.v43.DisplayTitleR\fdisplayTitle\x12H\n" +
-	"\x10additional_title\x18\x03 \x03(\v2\x1d.ddex.ern.v43.AdditionalTitleR\x0fadditionalTitle\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\x12B\n" +
+	"\x10additional_title\x18\x03 \x03(\v2\x1d.ddex.ern.v43.AdditionalTitleR\x0fadditionalTitle\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12.\n" +
+	"\x13no_display_sequence\x18\v \x01(\bR\x11noDisplaySequence\x12)\n" +
+	"\x10display_sequence\x18\f \x01(\tR\x0fdisplaySequence\x12B\n" +
 	"\x0edisplay_artist\x18\x05 \x03(\v2\x1b.ddex.ern.v43.DisplayArtistR\rdisplayArtist\x12<\n" +
-	"\fcarrier_type\x18\x06 \x03(\v2\x19.ddex.ern.v43.CarrierTypeR\vcarrierType\x12\x1a\n" +
-	"\bduration\x18\a \x01(\tR\bduration\x12E\n" +
+	"\fcarrier_type\x18\x06 \x03(\v2\x19.ddex.ern.v43.CarrierTypeR\vcarrierType\x12\x1f\n" +
+	"\bduration\x18\a \x01(\tH\x01R\bduration\x88\x01\x01\x12G\n" +
+	" resource_group_release_reference\x18\r \x01(\tR\x1dresourceGroupReleaseReference\x126\n" +
+	"\n" +
+	"release_id\x18\x0e \x01(\v2\x17.ddex.ern.v43.ReleaseIdR\treleaseId\x12E\n" +
 	"\x0eresource_group\x18\b \x03(\v2\x1e.ddex.ern.v43.ResourceSubGroupR\rresourceGroup\x12e\n" +
 	"\x1bresource_group_content_item\x18\t \x03(\v2&.ddex.ern.v43.ResourceGroupContentItemR\x18resourceGroupContentItem\x12w\n" +
 	"!linked_release_resource_reference\x18\n" +
 	" \x03(\v2,.ddex.ern.v43.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12.\n" +
-	"\x13no_display_sequence\x18\v \x01(\bR\x11noDisplaySequence\x12)\n" +
-	"\x10display_sequence\x18\f \x01(\tR\x0fdisplaySequence\x12G\n" +
-	" resource_group_release_reference\x18\r \x01(\tR\x1dresourceGroupReleaseReference\x126\n" +
-	"\n" +
-	"release_id\x18\x0e \x01(\v2\x17.ddex.ern.v43.ReleaseIdR\treleaseId\x12.\n" +
-	"\x13resource_group_type\x18\x0f \x01(\tR\x11resourceGroupType\"\x97\x01\n" +
+	"\x13resource_group_type\x18\x0f \x01(\tR\x11resourceGroupTypeB\x12\n" +
+	"\x10_sequence_numberB\v\n" +
+	"\t_durationJ\x06\b\xa8F\x10\x90N\"\x9f\x01\n" +
 	"\x11RightsClaimPolicy\x12I\n" +
 	"\tcondition\x18\x01 \x03(\v2+.ddex.ern.v43.ConditionForRightsClaimPolicyR\tcondition\x127\n" +
-	"\x18rights_claim_policy_type\x18\x02 \x01(\tR\x15rightsClaimPolicyType\"_\n" +
+	"\x18rights_claim_policy_type\x18\x02 \x01(\tR\x15rightsClaimPolicyTypeJ\x06\b\xa8F\x10\x90N\"g\n" +
 	"\aSegment\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x1a\n" +
 	"\bduration\x18\x02 \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x80\x02\n" +
-	"\x10ServiceException\x125\n" +
-	"\ftrading_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\vtradingName\x12\x12\n" +
-	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x12/\n" +
-	"\achannel\x18\x03 \x03(\v2\x15.ddex.ern.v43.ChannelR\achannel\x128\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTimeJ\x06\b\xa8F\x10\x90N\"\x88\x02\n" +
+	"\x10ServiceException\x128\n" +
 	"\bparty_id\x18\x04 \x03(\v2\x1d.ddex.ern.v43.DetailedPartyIdR\apartyId\x126\n" +
 	"\n" +
-	"party_name\x18\x05 \x03(\v2\x17.ddex.ern.v43.PartyNameR\tpartyName\"\xea\x0e\n" +
+	"party_name\x18\x05 \x03(\v2\x17.ddex.ern.v43.PartyNameR\tpartyName\x125\n" +
+	"\ftrading_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\vtradingName\x12\x12\n" +
+	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x12/\n" +
+	"\achannel\x18\x03 \x03(\v2\x15.ddex.ern.v43.ChannelR\achannelJ\x06\b\xa8F\x10\x90N\"\xea\x0f\n" +
 	"\n" +
 	"SheetMusic\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x120\n" +
@@ -18799,13 +19039,17 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x11 \x03(\v2*.ddex.ern.v43.FulfillmentDateWithTerritoryR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x12 \x03(\v2..ddex.ern.v43.ParentalWarningTypeWithTerritoryR\x13parentalWarningType\x12E\n" +
 	"\x0frelated_release\x18\x13 \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\x14 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x126\n" +
-	"\x17contains_hidden_content\x18\x15 \x01(\bR\x15containsHiddenContent\x12,\n" +
-	"\x12language_of_lyrics\x18\x16 \x01(\tR\x10languageOfLyrics\x12\x90\x01\n" +
+	"\x10related_resource\x18\x14 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12;\n" +
+	"\x17contains_hidden_content\x18\x15 \x01(\bH\x00R\x15containsHiddenContent\x88\x01\x01\x121\n" +
+	"\x12language_of_lyrics\x18\x16 \x01(\tH\x01R\x10languageOfLyrics\x88\x01\x01\x12\x90\x01\n" +
 	"*resource_contained_resource_reference_list\x18\x17 \x01(\v24.ddex.ern.v43.ResourceContainedResourceReferenceListR&resourceContainedResourceReferenceList\x12U\n" +
-	"\x11technical_details\x18\x18 \x03(\v2(.ddex.ern.v43.TechnicalSheetMusicDetailsR\x10technicalDetails\x127\n" +
-	"\x18language_and_script_code\x18\x19 \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x1a \x01(\bR\x0eisSupplemental\"\xf6\x0e\n" +
+	"\x11technical_details\x18\x18 \x03(\v2(.ddex.ern.v43.TechnicalSheetMusicDetailsR\x10technicalDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x19 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x1a \x01(\bH\x03R\x0eisSupplemental\x88\x01\x01B\x1a\n" +
+	"\x18_contains_hidden_contentB\x15\n" +
+	"\x13_language_of_lyricsB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"\xda\x0f\n" +
 	"\bSoftware\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12.\n" +
 	"\x04type\x18\x02 \x01(\v2\x1a.ddex.ern.v43.SoftwareTypeR\x04type\x12D\n" +
@@ -18829,12 +19073,15 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x12 \x03(\v2*.ddex.ern.v43.FulfillmentDateWithTerritoryR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x13 \x03(\v2..ddex.ern.v43.ParentalWarningTypeWithTerritoryR\x13parentalWarningType\x12E\n" +
 	"\x0frelated_release\x18\x14 \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\x15 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x126\n" +
-	"\x17contains_hidden_content\x18\x16 \x01(\bR\x15containsHiddenContent\x12\x90\x01\n" +
+	"\x10related_resource\x18\x15 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12;\n" +
+	"\x17contains_hidden_content\x18\x16 \x01(\bH\x00R\x15containsHiddenContent\x88\x01\x01\x12\x90\x01\n" +
 	"*resource_contained_resource_reference_list\x18\x17 \x01(\v24.ddex.ern.v43.ResourceContainedResourceReferenceListR&resourceContainedResourceReferenceList\x12S\n" +
-	"\x11technical_details\x18\x18 \x03(\v2&.ddex.ern.v43.TechnicalSoftwareDetailsR\x10technicalDetails\x127\n" +
-	"\x18language_and_script_code\x18\x19 \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x1a \x01(\bR\x0eisSupplemental\"\x89\x16\n" +
+	"\x11technical_details\x18\x18 \x03(\v2&.ddex.ern.v43.TechnicalSoftwareDetailsR\x10technicalDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x19 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x1a \x01(\bH\x02R\x0eisSupplemental\x88\x01\x01B\x1a\n" +
+	"\x18_contains_hidden_contentB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"\x92\x19\n" +
 	"\x0eSoundRecording\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x124\n" +
 	"\x04type\x18\x02 \x01(\v2 .ddex.ern.v43.SoundRecordingTypeR\x04type\x12[\n" +
@@ -18861,137 +19108,204 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x1clocation_and_date_of_session\x18\x16 \x03(\v2&.ddex.ern.v43.LocationAndDateOfSessionR\x18locationAndDateOfSession\x12b\n" +
 	"\x15parental_warning_type\x18\x17 \x03(\v2..ddex.ern.v43.ParentalWarningTypeWithTerritoryR\x13parentalWarningType\x12E\n" +
 	"\x0frelated_release\x18\x18 \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\x19 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12=\n" +
-	"\x1bcomposite_musical_work_type\x18\x1a \x01(\tR\x18compositeMusicalWorkType\x12\x19\n" +
-	"\bis_cover\x18\x1b \x01(\bR\aisCover\x122\n" +
-	"\x15has_vocal_performance\x18\x1c \x01(\bR\x13hasVocalPerformance\x12G\n" +
-	" has_foreground_vocal_performance\x18\x1d \x01(\bR\x1dhasForegroundVocalPerformance\x12'\n" +
-	"\x0fis_instrumental\x18\x1e \x01(\bR\x0eisInstrumental\x126\n" +
-	"\x17contains_hidden_content\x18\x1f \x01(\bR\x15containsHiddenContent\x12#\n" +
-	"\ris_remastered\x18  \x01(\bR\fisRemastered\x12%\n" +
-	"\x0fis_hi_res_music\x18! \x01(\bR\fisHiResMusic\x12+\n" +
-	"\x11disable_crossfade\x18\" \x01(\bR\x10disableCrossfade\x12%\n" +
-	"\x0edisable_search\x18# \x01(\bR\rdisableSearch\x12E\n" +
+	"\x10related_resource\x18\x19 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12B\n" +
+	"\x1bcomposite_musical_work_type\x18\x1a \x01(\tH\x00R\x18compositeMusicalWorkType\x88\x01\x01\x12\x1e\n" +
+	"\bis_cover\x18\x1b \x01(\bH\x01R\aisCover\x88\x01\x01\x127\n" +
+	"\x15has_vocal_performance\x18\x1c \x01(\bH\x02R\x13hasVocalPerformance\x88\x01\x01\x12L\n" +
+	" has_foreground_vocal_performance\x18\x1d \x01(\bH\x03R\x1dhasForegroundVocalPerformance\x88\x01\x01\x12,\n" +
+	"\x0fis_instrumental\x18\x1e \x01(\bH\x04R\x0eisInstrumental\x88\x01\x01\x12;\n" +
+	"\x17contains_hidden_content\x18\x1f \x01(\bH\x05R\x15containsHiddenContent\x88\x01\x01\x12(\n" +
+	"\ris_remastered\x18  \x01(\bH\x06R\fisRemastered\x88\x01\x01\x12*\n" +
+	"\x0fis_hi_res_music\x18! \x01(\bH\aR\fisHiResMusic\x88\x01\x01\x120\n" +
+	"\x11disable_crossfade\x18\" \x01(\bH\bR\x10disableCrossfade\x88\x01\x01\x12*\n" +
+	"\x0edisable_search\x18# \x01(\bH\tR\rdisableSearch\x88\x01\x01\x12E\n" +
 	"\x0fdisplay_credits\x18$ \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x12N\n" +
 	"\x17language_of_performance\x18% \x03(\v2\x16.ddex.ern.v43.LanguageR\x15languageOfPerformance\x12&\n" +
 	"\x04raga\x18& \x03(\v2\x12.ddex.ern.v43.RagaR\x04raga\x12&\n" +
 	"\x04tala\x18' \x03(\v2\x12.ddex.ern.v43.TalaR\x04tala\x12)\n" +
 	"\x05deity\x18( \x03(\v2\x13.ddex.ern.v43.DeityR\x05deity\x126\n" +
-	"\x17audio_chapter_reference\x18) \x03(\tR\x15audioChapterReference\x127\n" +
-	"\x18language_and_script_code\x18* \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18+ \x01(\bR\x0eisSupplemental\x12E\n" +
-	"\x1fapply_classical_profile_variant\x18, \x01(\bR\x1capplyClassicalProfileVariant\"\xbe\x02\n" +
+	"\x17audio_chapter_reference\x18) \x03(\tR\x15audioChapterReference\x12<\n" +
+	"\x18language_and_script_code\x18* \x01(\tH\n" +
+	"R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18+ \x01(\bH\vR\x0eisSupplemental\x88\x01\x01\x12J\n" +
+	"\x1fapply_classical_profile_variant\x18, \x01(\bH\fR\x1capplyClassicalProfileVariant\x88\x01\x01B\x1e\n" +
+	"\x1c_composite_musical_work_typeB\v\n" +
+	"\t_is_coverB\x18\n" +
+	"\x16_has_vocal_performanceB#\n" +
+	"!_has_foreground_vocal_performanceB\x12\n" +
+	"\x10_is_instrumentalB\x1a\n" +
+	"\x18_contains_hidden_contentB\x10\n" +
+	"\x0e_is_remasteredB\x12\n" +
+	"\x10_is_hi_res_musicB\x14\n" +
+	"\x12_disable_crossfadeB\x11\n" +
+	"\x0f_disable_searchB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalB\"\n" +
+	" _apply_classical_profile_variantJ\x06\b\xa8F\x10\x90N\"\xc6\x02\n" +
 	"\x19SoundRecordingClipDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x123\n" +
 	"\tclip_type\x18\x02 \x01(\v2\x16.ddex.ern.v43.ClipTypeR\bclipType\x12,\n" +
 	"\x06timing\x18\x03 \x03(\v2\x14.ddex.ern.v43.TimingR\x06timing\x12'\n" +
 	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionType\x12D\n" +
-	"\rdelivery_file\x18\x05 \x03(\v2\x1f.ddex.ern.v43.AudioDeliveryFileR\fdeliveryFile\"\xf8\x02\n" +
-	"\x15SoundRecordingEdition\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12?\n" +
+	"\rdelivery_file\x18\x05 \x03(\v2\x1f.ddex.ern.v43.AudioDeliveryFileR\fdeliveryFileJ\x06\b\xa8F\x10\x90N\"\xa6\x03\n" +
+	"\x15SoundRecordingEdition\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12?\n" +
 	"\vresource_id\x18\x02 \x03(\v2\x1e.ddex.ern.v43.SoundRecordingIdR\n" +
 	"resourceId\x12Q\n" +
 	"\x13edition_contributor\x18\x03 \x03(\v2 .ddex.ern.v43.EditionContributorR\x12editionContributor\x125\n" +
-	"\x06p_line\x18\x04 \x03(\v2\x1e.ddex.ern.v43.PLineWithDefaultR\x05pLine\x12%\n" +
-	"\x0erecording_mode\x18\x05 \x01(\tR\rrecordingMode\x12Y\n" +
-	"\x11technical_details\x18\x06 \x03(\v2,.ddex.ern.v43.TechnicalSoundRecordingDetailsR\x10technicalDetails\"c\n" +
+	"\x06p_line\x18\x04 \x03(\v2\x1e.ddex.ern.v43.PLineWithDefaultR\x05pLine\x12*\n" +
+	"\x0erecording_mode\x18\x05 \x01(\tH\x01R\rrecordingMode\x88\x01\x01\x12Y\n" +
+	"\x11technical_details\x18\x06 \x03(\v2,.ddex.ern.v43.TechnicalSoundRecordingDetailsR\x10technicalDetailsB\a\n" +
+	"\x05_typeB\x11\n" +
+	"\x0f_recording_modeJ\x06\b\xa8F\x10\x90N\"k\n" +
 	"\x18SupplementalDocumentList\x12G\n" +
-	"\x15supplemental_document\x18\x01 \x03(\v2\x12.ddex.ern.v43.FileR\x14supplementalDocument\"\xed\x01\n" +
+	"\x15supplemental_document\x18\x01 \x03(\v2\x12.ddex.ern.v43.FileR\x14supplementalDocumentJ\x06\b\xa8F\x10\x90N\"\xe9\x02\n" +
 	"\x15SynopsisWithTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12*\n" +
-	"\x11is_short_synopsis\x18\x05 \x01(\bR\x0fisShortSynopsis\"w\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12/\n" +
+	"\x11is_short_synopsis\x18\x05 \x01(\bH\x03R\x0fisShortSynopsis\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x14\n" +
+	"\x12_is_short_synopsisJ\x06\b\xa8F\x10\x90N\"\xb6\x01\n" +
 	"\x04Tala\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"\xce\x06\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xa3\b\n" +
 	"\x15TechnicalImageDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12F\n" +
 	"\x10image_codec_type\x18\x02 \x01(\v2\x1c.ddex.ern.v43.ImageCodecTypeR\x0eimageCodecType\x127\n" +
 	"\fimage_height\x18\x03 \x01(\v2\x14.ddex.ern.v43.ExtentR\vimageHeight\x125\n" +
 	"\vimage_width\x18\x04 \x01(\v2\x14.ddex.ern.v43.ExtentR\n" +
 	"imageWidth\x12<\n" +
-	"\faspect_ratio\x18\x05 \x03(\v2\x19.ddex.ern.v43.AspectRatioR\vaspectRatio\x12\x1f\n" +
-	"\vcolor_depth\x18\x06 \x01(\x05R\n" +
-	"colorDepth\x12)\n" +
-	"\x10image_resolution\x18\a \x01(\x05R\x0fimageResolution\x12\x1b\n" +
-	"\tbit_depth\x18\b \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\t \x01(\bR\x06isClip\x12<\n" +
+	"\faspect_ratio\x18\x05 \x03(\v2\x19.ddex.ern.v43.AspectRatioR\vaspectRatio\x12$\n" +
+	"\vcolor_depth\x18\x06 \x01(\x05H\x00R\n" +
+	"colorDepth\x88\x01\x01\x12.\n" +
+	"\x10image_resolution\x18\a \x01(\x05H\x01R\x0fimageResolution\x88\x01\x01\x12 \n" +
+	"\tbit_depth\x18\b \x01(\x05H\x02R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\t \x01(\bH\x03R\x06isClip\x88\x01\x01\x12<\n" +
 	"\fclip_details\x18\n" +
 	" \x03(\v2\x19.ddex.ern.v43.ClipDetailsR\vclipDetails\x12&\n" +
-	"\x04file\x18\v \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\f \x01(\bR\x14isProvidedInDelivery\x12;\n" +
-	"\vfingerprint\x18\r \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\x0e \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x0f \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x04file\x18\v \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\f \x01(\bH\x04R\x14isProvidedInDelivery\x88\x01\x01\x12;\n" +
+	"\vfingerprint\x18\r \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\x0e \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x0f \x01(\tH\x06R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x10 \x01(\bH\aR\tisDefault\x88\x01\x01B\x0e\n" +
+	"\f_color_depthB\x13\n" +
+	"\x11_image_resolutionB\f\n" +
 	"\n" +
-	"is_default\x18\x10 \x01(\bR\tisDefault\"\xe9\x04\n" +
+	"_bit_depthB\n" +
+	"\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x8f\x06\n" +
 	"\x1aTechnicalSheetMusicDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12V\n" +
-	"\x16sheet_music_codec_type\x18\x02 \x01(\v2!.ddex.ern.v43.SheetMusicCodecTypeR\x13sheetMusicCodecType\x12\x1b\n" +
-	"\tbit_depth\x18\x03 \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12<\n" +
+	"\x16sheet_music_codec_type\x18\x02 \x01(\v2!.ddex.ern.v43.SheetMusicCodecTypeR\x13sheetMusicCodecType\x12 \n" +
+	"\tbit_depth\x18\x03 \x01(\x05H\x00R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12<\n" +
 	"\fclip_details\x18\x05 \x03(\v2\x19.ddex.ern.v43.ClipDetailsR\vclipDetails\x12&\n" +
-	"\x04file\x18\x06 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\a \x01(\bR\x14isProvidedInDelivery\x12;\n" +
-	"\vfingerprint\x18\b \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\x12:\n" +
+	"\x04file\x18\x06 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\a \x01(\bH\x02R\x14isProvidedInDelivery\x88\x01\x01\x12;\n" +
+	"\vfingerprint\x18\b \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
 	"\x19applicable_territory_code\x18\n" +
-	" \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\tH\x04R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\v \x01(\bH\x05R\tisDefault\x88\x01\x01B\f\n" +
+	"\n" +
+	"_bit_depthB\n" +
 	"\n" +
-	"is_default\x18\v \x01(\bR\tisDefault\"\xe6\x04\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x8c\x06\n" +
 	"\x18TechnicalSoftwareDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12U\n" +
-	"\x15operating_system_type\x18\x02 \x01(\v2!.ddex.ern.v43.OperatingSystemTypeR\x13operatingSystemType\x12\x1b\n" +
-	"\tbit_depth\x18\x03 \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12<\n" +
+	"\x15operating_system_type\x18\x02 \x01(\v2!.ddex.ern.v43.OperatingSystemTypeR\x13operatingSystemType\x12 \n" +
+	"\tbit_depth\x18\x03 \x01(\x05H\x00R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12<\n" +
 	"\fclip_details\x18\x05 \x03(\v2\x19.ddex.ern.v43.ClipDetailsR\vclipDetails\x12&\n" +
-	"\x04file\x18\x06 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\a \x01(\bR\x14isProvidedInDelivery\x12;\n" +
-	"\vfingerprint\x18\b \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\x12:\n" +
+	"\x04file\x18\x06 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\a \x01(\bH\x02R\x14isProvidedInDelivery\x88\x01\x01\x12;\n" +
+	"\vfingerprint\x18\b \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
 	"\x19applicable_territory_code\x18\n" +
-	" \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\tH\x04R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\v \x01(\bR\tisDefault\"\xf1\x03\n" +
+	"is_default\x18\v \x01(\bH\x05R\tisDefault\x88\x01\x01B\f\n" +
+	"\n" +
+	"_bit_depthB\n" +
+	"\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x89\x05\n" +
 	"\x1eTechnicalSoundRecordingDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12D\n" +
-	"\rdelivery_file\x18\x02 \x03(\v2\x1f.ddex.ern.v43.AudioDeliveryFileR\fdeliveryFile\x12?\n" +
-	"\x1chas_immersive_audio_metadata\x18\x03 \x01(\bR\x19hasImmersiveAudioMetadata\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12J\n" +
-	"\fclip_details\x18\x05 \x03(\v2'.ddex.ern.v43.SoundRecordingClipDetailsR\vclipDetails\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\a \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\rdelivery_file\x18\x02 \x03(\v2\x1f.ddex.ern.v43.AudioDeliveryFileR\fdeliveryFile\x12D\n" +
+	"\x1chas_immersive_audio_metadata\x18\x03 \x01(\bH\x00R\x19hasImmersiveAudioMetadata\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12J\n" +
+	"\fclip_details\x18\x05 \x03(\v2'.ddex.ern.v43.SoundRecordingClipDetailsR\vclipDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\a \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bH\x04R\tisDefault\x88\x01\x01B\x1f\n" +
+	"\x1d_has_immersive_audio_metadataB\n" +
 	"\n" +
-	"is_default\x18\b \x01(\bR\tisDefault\"\xd0\x04\n" +
+	"\b_is_clipB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xf6\x05\n" +
 	"\x14TechnicalTextDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12C\n" +
-	"\x0ftext_codec_type\x18\x02 \x01(\v2\x1b.ddex.ern.v43.TextCodecTypeR\rtextCodecType\x12\x1b\n" +
-	"\tbit_depth\x18\x03 \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12<\n" +
+	"\x0ftext_codec_type\x18\x02 \x01(\v2\x1b.ddex.ern.v43.TextCodecTypeR\rtextCodecType\x12 \n" +
+	"\tbit_depth\x18\x03 \x01(\x05H\x00R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12<\n" +
 	"\fclip_details\x18\x05 \x03(\v2\x19.ddex.ern.v43.ClipDetailsR\vclipDetails\x12&\n" +
-	"\x04file\x18\x06 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\a \x01(\bR\x14isProvidedInDelivery\x12;\n" +
-	"\vfingerprint\x18\b \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\x12:\n" +
+	"\x04file\x18\x06 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\a \x01(\bH\x02R\x14isProvidedInDelivery\x88\x01\x01\x12;\n" +
+	"\vfingerprint\x18\b \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
 	"\x19applicable_territory_code\x18\n" +
-	" \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\tH\x04R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\v \x01(\bR\tisDefault\"\xdf\x03\n" +
+	"is_default\x18\v \x01(\bH\x05R\tisDefault\x88\x01\x01B\f\n" +
+	"\n" +
+	"_bit_depthB\n" +
+	"\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xd1\x04\n" +
 	"\x15TechnicalVideoDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12?\n" +
 	"\x10overall_bit_rate\x18\x02 \x01(\v2\x15.ddex.ern.v43.BitRateR\x0eoverallBitRate\x12D\n" +
-	"\rdelivery_file\x18\x03 \x03(\v2\x1f.ddex.ern.v43.VideoDeliveryFileR\fdeliveryFile\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12A\n" +
-	"\fclip_details\x18\x05 \x03(\v2\x1e.ddex.ern.v43.VideoClipDetailsR\vclipDetails\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\a \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\rdelivery_file\x18\x03 \x03(\v2\x1f.ddex.ern.v43.VideoDeliveryFileR\fdeliveryFile\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x00R\x06isClip\x88\x01\x01\x12A\n" +
+	"\fclip_details\x18\x05 \x03(\v2\x1e.ddex.ern.v43.VideoClipDetailsR\vclipDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\a \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bH\x03R\tisDefault\x88\x01\x01B\n" +
 	"\n" +
-	"is_default\x18\b \x01(\bR\tisDefault\"\xe6\x0e\n" +
+	"\b_is_clipB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xca\x0f\n" +
 	"\x04Text\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12*\n" +
 	"\x04type\x18\x02 \x01(\v2\x16.ddex.ern.v43.TextTypeR\x04type\x125\n" +
@@ -19014,25 +19328,34 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x11 \x03(\v2*.ddex.ern.v43.FulfillmentDateWithTerritoryR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x12 \x03(\v2..ddex.ern.v43.ParentalWarningTypeWithTerritoryR\x13parentalWarningType\x12E\n" +
 	"\x0frelated_release\x18\x13 \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\x14 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x126\n" +
-	"\x17contains_hidden_content\x18\x15 \x01(\bR\x15containsHiddenContent\x12\x90\x01\n" +
+	"\x10related_resource\x18\x14 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12;\n" +
+	"\x17contains_hidden_content\x18\x15 \x01(\bH\x00R\x15containsHiddenContent\x88\x01\x01\x12\x90\x01\n" +
 	"*resource_contained_resource_reference_list\x18\x16 \x01(\v24.ddex.ern.v43.ResourceContainedResourceReferenceListR&resourceContainedResourceReferenceList\x12O\n" +
 	"\x11technical_details\x18\x17 \x03(\v2\".ddex.ern.v43.TechnicalTextDetailsR\x10technicalDetails\x12@\n" +
-	"\x10language_of_text\x18\x18 \x03(\v2\x16.ddex.ern.v43.LanguageR\x0elanguageOfText\x127\n" +
-	"\x18language_and_script_code\x18\x19 \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x1a \x01(\bR\x0eisSupplemental\"k\n" +
+	"\x10language_of_text\x18\x18 \x03(\v2\x16.ddex.ern.v43.LanguageR\x0elanguageOfText\x12<\n" +
+	"\x18language_and_script_code\x18\x19 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x1a \x01(\bH\x02R\x0eisSupplemental\x88\x01\x01B\x1a\n" +
+	"\x18_contains_hidden_contentB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"\x86\x01\n" +
 	"\x06Timing\x12\x1f\n" +
 	"\vstart_point\x18\x01 \x01(\tR\n" +
-	"startPoint\x12\x1b\n" +
-	"\tend_point\x18\x02 \x01(\tR\bendPoint\x12#\n" +
-	"\rduration_used\x18\x03 \x03(\tR\fdurationUsed\"\x9b\x01\n" +
+	"startPoint\x12 \n" +
+	"\tend_point\x18\x02 \x01(\tH\x00R\bendPoint\x88\x01\x01\x12#\n" +
+	"\rduration_used\x18\x03 \x03(\tR\fdurationUsedB\f\n" +
+	"\n" +
+	"_end_pointJ\x06\b\xa8F\x10\x90N\"\xec\x01\n" +
 	"\x05Title\x12\x1d\n" +
 	"\n" +
-	"title_text\x18\x01 \x01(\tR\ttitleText\x12\x1b\n" +
-	"\tsub_title\x18\x02 \x01(\tR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"title_text\x18\x01 \x01(\tR\ttitleText\x12 \n" +
+	"\tsub_title\x18\x02 \x01(\tH\x00R\bsubTitle\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"title_type\x18\x04 \x01(\tH\x02R\ttitleType\x88\x01\x01B\f\n" +
 	"\n" +
-	"title_type\x18\x04 \x01(\tR\ttitleType\"\x8c\t\n" +
+	"_sub_titleB\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_title_typeJ\x06\b\xa8F\x10\x90N\"\xc3\t\n" +
 	"\fTrackRelease\x12+\n" +
 	"\x11release_reference\x18\x01 \x01(\tR\x10releaseReference\x126\n" +
 	"\n" +
@@ -19047,27 +19370,37 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x1crelease_visibility_reference\x18\n" +
 	" \x03(\tR\x1areleaseVisibilityReference\x12E\n" +
 	"\x0frelated_release\x18\v \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\f \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12\x1f\n" +
-	"\ftarget_u_r_l\x18\r \x01(\tR\ttargetURL\x12?\n" +
+	"\x10related_resource\x18\f \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12$\n" +
+	"\ftarget_u_r_l\x18\r \x01(\tH\x00R\ttargetURL\x88\x01\x01\x12?\n" +
 	"\bkeywords\x18\x0e \x03(\v2#.ddex.ern.v43.KeywordsWithTerritoryR\bkeywords\x12?\n" +
 	"\bsynopsis\x18\x0f \x03(\v2#.ddex.ern.v43.SynopsisWithTerritoryR\bsynopsis\x12K\n" +
-	"\x11marketing_comment\x18\x10 \x03(\v2\x1e.ddex.ern.v43.MarketingCommentR\x10marketingComment\x12&\n" +
-	"\x0fis_main_release\x18\x11 \x01(\bR\risMainRelease\"\xb4\x03\n" +
+	"\x11marketing_comment\x18\x10 \x03(\v2\x1e.ddex.ern.v43.MarketingCommentR\x10marketingComment\x12+\n" +
+	"\x0fis_main_release\x18\x11 \x01(\bH\x01R\risMainRelease\x88\x01\x01B\x0f\n" +
+	"\r_target_u_r_lB\x12\n" +
+	"\x10_is_main_releaseJ\x06\b\xa8F\x10\x90N\"\x80\x04\n" +
 	"\x16TrackReleaseVisibility\x121\n" +
-	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12O\n" +
-	"%track_listing_preview_start_date_time\x18\x02 \x01(\tR trackListingPreviewStartDateTime\x12>\n" +
-	"\x1cclip_preview_start_date_time\x18\x03 \x01(\tR\x18clipPreviewStartDateTime\x12I\n" +
+	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12I\n" +
 	"\x0eterritory_code\x18\x04 \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\rterritoryCode\x12Z\n" +
-	"\x17excluded_territory_code\x18\x05 \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12/\n" +
-	"\x14do_not_display_dates\x18\x06 \x01(\bR\x11doNotDisplayDates\"k\n" +
+	"\x17excluded_territory_code\x18\x05 \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12O\n" +
+	"%track_listing_preview_start_date_time\x18\x02 \x01(\tR trackListingPreviewStartDateTime\x12C\n" +
+	"\x1cclip_preview_start_date_time\x18\x03 \x01(\tH\x00R\x18clipPreviewStartDateTime\x88\x01\x01\x124\n" +
+	"\x14do_not_display_dates\x18\x06 \x01(\bH\x01R\x11doNotDisplayDates\x88\x01\x01B\x1f\n" +
+	"\x1d_clip_preview_start_date_timeB\x17\n" +
+	"\x15_do_not_display_datesJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\aUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"u\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\x11UserInterfaceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xfa\x16\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb7\x19\n" +
 	"\x05Video\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12+\n" +
 	"\x04type\x18\x02 \x01(\v2\x17.ddex.ern.v43.VideoTypeR\x04type\x12?\n" +
@@ -19094,14 +19427,16 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x15parental_warning_type\x18\x16 \x03(\v2..ddex.ern.v43.ParentalWarningTypeWithTerritoryR\x13parentalWarningType\x123\n" +
 	"\tav_rating\x18\x17 \x03(\v2\x16.ddex.ern.v43.AvRatingR\bavRating\x12E\n" +
 	"\x0frelated_release\x18\x18 \x03(\v2\x1c.ddex.ern.v43.RelatedReleaseR\x0erelatedRelease\x12H\n" +
-	"\x10related_resource\x18\x19 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12=\n" +
-	"\x1bcomposite_musical_work_type\x18\x1a \x01(\tR\x18compositeMusicalWorkType\x12\x19\n" +
-	"\bis_cover\x18\x1b \x01(\bR\aisCover\x122\n" +
-	"\x15has_vocal_performance\x18\x1c \x01(\bR\x13hasVocalPerformance\x12G\n" +
-	" has_foreground_vocal_performance\x18\x1d \x01(\bR\x1dhasForegroundVocalPerformance\x12'\n" +
-	"\x0fis_instrumental\x18\x1e \x01(\bR\x0eisInstrumental\x126\n" +
-	"\x17contains_hidden_content\x18\x1f \x01(\bR\x15containsHiddenContent\x12#\n" +
-	"\ris_remastered\x18  \x01(\bR\fisRemastered\x12E\n" +
+	"\x10related_resource\x18\x19 \x03(\v2\x1d.ddex.ern.v43.RelatedResourceR\x0frelatedResource\x12B\n" +
+	"\x1bcomposite_musical_work_type\x18\x1a \x01(\tH\x00R\x18compositeMusicalWorkType\x88\x01\x01\x129\n" +
+	"\x19video_cue_sheet_reference\x18* \x03(\tR\x16videoCueSheetReference\x12T\n" +
+	"\x1creason_for_cue_sheet_absence\x18+ \x01(\v2\x14.ddex.ern.v43.ReasonR\x18reasonForCueSheetAbsence\x12\x1e\n" +
+	"\bis_cover\x18\x1b \x01(\bH\x01R\aisCover\x88\x01\x01\x127\n" +
+	"\x15has_vocal_performance\x18\x1c \x01(\bH\x02R\x13hasVocalPerformance\x88\x01\x01\x12L\n" +
+	" has_foreground_vocal_performance\x18\x1d \x01(\bH\x03R\x1dhasForegroundVocalPerformance\x88\x01\x01\x12,\n" +
+	"\x0fis_instrumental\x18\x1e \x01(\bH\x04R\x0eisInstrumental\x88\x01\x01\x12;\n" +
+	"\x17contains_hidden_content\x18\x1f \x01(\bH\x05R\x15containsHiddenContent\x88\x01\x01\x12(\n" +
+	"\ris_remastered\x18  \x01(\bH\x06R\fisRemastered\x88\x01\x01\x12E\n" +
 	"\x0fdisplay_credits\x18! \x03(\v2\x1c.ddex.ern.v43.DisplayCreditsR\x0edisplayCredits\x12N\n" +
 	"\x17language_of_performance\x18\" \x03(\v2\x16.ddex.ern.v43.LanguageR\x15languageOfPerformance\x12.\n" +
 	"\x13language_of_dubbing\x18# \x03(\tR\x11languageOfDubbing\x12,\n" +
@@ -19110,20 +19445,30 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\x04raga\x18& \x03(\tR\x04raga\x12\x12\n" +
 	"\x04tala\x18' \x03(\tR\x04tala\x12\x14\n" +
 	"\x05deity\x18( \x03(\tR\x05deity\x126\n" +
-	"\x17video_chapter_reference\x18) \x03(\tR\x15videoChapterReference\x129\n" +
-	"\x19video_cue_sheet_reference\x18* \x03(\tR\x16videoCueSheetReference\x12T\n" +
-	"\x1creason_for_cue_sheet_absence\x18+ \x01(\v2\x14.ddex.ern.v43.ReasonR\x18reasonForCueSheetAbsence\x127\n" +
-	"\x18language_and_script_code\x18, \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18- \x01(\bR\x0eisSupplemental\x12E\n" +
-	"\x1fapply_classical_profile_variant\x18. \x01(\bR\x1capplyClassicalProfileVariant\"\x8d\x03\n" +
+	"\x17video_chapter_reference\x18) \x03(\tR\x15videoChapterReference\x12<\n" +
+	"\x18language_and_script_code\x18, \x01(\tH\aR\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18- \x01(\bH\bR\x0eisSupplemental\x88\x01\x01\x12J\n" +
+	"\x1fapply_classical_profile_variant\x18. \x01(\bH\tR\x1capplyClassicalProfileVariant\x88\x01\x01B\x1e\n" +
+	"\x1c_composite_musical_work_typeB\v\n" +
+	"\t_is_coverB\x18\n" +
+	"\x16_has_vocal_performanceB#\n" +
+	"!_has_foreground_vocal_performanceB\x12\n" +
+	"\x10_is_instrumentalB\x1a\n" +
+	"\x18_contains_hidden_contentB\x10\n" +
+	"\x0e_is_remasteredB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalB\"\n" +
+	" _apply_classical_profile_variantJ\x06\b\xa8F\x10\x90N\"\xcb\x03\n" +
 	"\x10VideoClipDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x123\n" +
 	"\tclip_type\x18\x02 \x01(\v2\x16.ddex.ern.v43.ClipTypeR\bclipType\x12,\n" +
-	"\x06timing\x18\x03 \x03(\v2\x14.ddex.ern.v43.TimingR\x06timing\x12&\n" +
-	"\x0ftop_left_corner\x18\x04 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x05 \x01(\tR\x11bottomRightCorner\x12'\n" +
+	"\x06timing\x18\x03 \x03(\v2\x14.ddex.ern.v43.TimingR\x06timing\x12+\n" +
+	"\x0ftop_left_corner\x18\x04 \x01(\tH\x00R\rtopLeftCorner\x88\x01\x01\x123\n" +
+	"\x13bottom_right_corner\x18\x05 \x01(\tH\x01R\x11bottomRightCorner\x88\x01\x01\x12'\n" +
 	"\x0fexpression_type\x18\x06 \x01(\tR\x0eexpressionType\x12D\n" +
-	"\rdelivery_file\x18\a \x03(\v2\x1f.ddex.ern.v43.VideoDeliveryFileR\fdeliveryFile\"\xca\f\n" +
+	"\rdelivery_file\x18\a \x03(\v2\x1f.ddex.ern.v43.VideoDeliveryFileR\fdeliveryFileB\x12\n" +
+	"\x10_top_left_cornerB\x16\n" +
+	"\x14_bottom_right_cornerJ\x06\b\xa8F\x10\x90N\"\xa9\x0f\n" +
 	"\x11VideoDeliveryFile\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12H\n" +
 	"\x10container_format\x18\x02 \x01(\v2\x1d.ddex.ern.v43.ContainerFormatR\x0fcontainerFormat\x12F\n" +
@@ -19135,536 +19480,849 @@ const file_ddex_ern_v43_v43_proto_rawDesc = "" +
 	"\vimage_width\x18\a \x01(\v2\x14.ddex.ern.v43.ExtentR\n" +
 	"imageWidth\x12<\n" +
 	"\faspect_ratio\x18\b \x03(\v2\x19.ddex.ern.v43.AspectRatioR\vaspectRatio\x123\n" +
-	"\tcore_area\x18\t \x01(\v2\x16.ddex.ern.v43.CoreAreaR\bcoreArea\x12\x1f\n" +
+	"\tcore_area\x18\t \x01(\v2\x16.ddex.ern.v43.CoreAreaR\bcoreArea\x12$\n" +
 	"\vcolor_depth\x18\n" +
-	" \x01(\x05R\n" +
-	"colorDepth\x12U\n" +
+	" \x01(\x05H\x00R\n" +
+	"colorDepth\x88\x01\x01\x12U\n" +
 	"\x15video_definition_type\x18\v \x01(\v2!.ddex.ern.v43.VideoDefinitionTypeR\x13videoDefinitionType\x12F\n" +
-	"\x10audio_codec_type\x18\f \x01(\v2\x1c.ddex.ern.v43.AudioCodecTypeR\x0eaudioCodecType\x12?\n" +
-	"\x1chas_immersive_audio_metadata\x18\r \x01(\bR\x19hasImmersiveAudioMetadata\x12R\n" +
-	"&electro_optical_transfer_function_type\x18\x0e \x01(\tR\"electroOpticalTransferFunctionType\x12,\n" +
-	"\x12primary_color_type\x18\x0f \x01(\tR\x10primaryColorType\x12o\n" +
-	"\x1fhdr_video_dynamic_metadata_type\x18\x10 \x01(\v2).ddex.ern.v43.HdrVideoDynamicMetadataTypeR\x1bhdrVideoDynamicMetadataType\x12B\n" +
-	"\x1ehdr_video_static_metadata_type\x18\x11 \x01(\tR\x1ahdrVideoStaticMetadataType\x12;\n" +
-	"\x0eaudio_bit_rate\x18\x12 \x01(\v2\x15.ddex.ern.v43.BitRateR\faudioBitRate\x127\n" +
-	"\x18number_of_audio_channels\x18\x13 \x01(\x05R\x15numberOfAudioChannels\x125\n" +
-	"\x17number_of_audio_objects\x18\x14 \x01(\x05R\x14numberOfAudioObjects\x12J\n" +
-	"\x13audio_sampling_rate\x18\x15 \x01(\v2\x1a.ddex.ern.v43.SamplingRateR\x11audioSamplingRate\x121\n" +
-	"\x15audio_bits_per_sample\x18\x16 \x01(\x05R\x12audioBitsPerSample\x12\x1a\n" +
-	"\bduration\x18\x17 \x01(\tR\bduration\x12\x1b\n" +
-	"\tbit_depth\x18\x18 \x01(\x05R\bbitDepth\x12&\n" +
+	"\x10audio_codec_type\x18\f \x01(\v2\x1c.ddex.ern.v43.AudioCodecTypeR\x0eaudioCodecType\x12D\n" +
+	"\x1chas_immersive_audio_metadata\x18\r \x01(\bH\x01R\x19hasImmersiveAudioMetadata\x88\x01\x01\x12W\n" +
+	"&electro_optical_transfer_function_type\x18\x0e \x01(\tH\x02R\"electroOpticalTransferFunctionType\x88\x01\x01\x121\n" +
+	"\x12primary_color_type\x18\x0f \x01(\tH\x03R\x10primaryColorType\x88\x01\x01\x12o\n" +
+	"\x1fhdr_video_dynamic_metadata_type\x18\x10 \x01(\v2).ddex.ern.v43.HdrVideoDynamicMetadataTypeR\x1bhdrVideoDynamicMetadataType\x12G\n" +
+	"\x1ehdr_video_static_metadata_type\x18\x11 \x01(\tH\x04R\x1ahdrVideoStaticMetadataType\x88\x01\x01\x12;\n" +
+	"\x0eaudio_bit_rate\x18\x12 \x01(\v2\x15.ddex.ern.v43.BitRateR\faudioBitRate\x12<\n" +
+	"\x18number_of_audio_channels\x18\x13 \x01(\x05H\x05R\x15numberOfAudioChannels\x88\x01\x01\x12:\n" +
+	"\x17number_of_audio_objects\x18\x14 \x01(\x05H\x06R\x14numberOfAudioObjects\x88\x01\x01\x12J\n" +
+	"\x13audio_sampling_rate\x18\x15 \x01(\v2\x1a.ddex.ern.v43.SamplingRateR\x11audioSamplingRate\x126\n" +
+	"\x15audio_bits_per_sample\x18\x16 \x01(\x05H\aR\x12audioBitsPerSample\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x17 \x01(\tH\bR\bduration\x88\x01\x01\x12 \n" +
+	"\tbit_depth\x18\x18 \x01(\x05H\tR\bbitDepth\x88\x01\x01\x12&\n" +
 	"\x04file\x18\x19 \x01(\v2\x12.ddex.ern.v43.FileR\x04file\x12;\n" +
-	"\vfingerprint\x18\x1a \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x125\n" +
-	"\x17is_provided_in_delivery\x18\x1b \x01(\bR\x14isProvidedInDelivery\"\x94\x03\n" +
-	"\fVideoEdition\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x126\n" +
+	"\vfingerprint\x18\x1a \x03(\v2\x19.ddex.ern.v43.FingerprintR\vfingerprint\x12:\n" +
+	"\x17is_provided_in_delivery\x18\x1b \x01(\bH\n" +
+	"R\x14isProvidedInDelivery\x88\x01\x01B\x0e\n" +
+	"\f_color_depthB\x1f\n" +
+	"\x1d_has_immersive_audio_metadataB)\n" +
+	"'_electro_optical_transfer_function_typeB\x15\n" +
+	"\x13_primary_color_typeB!\n" +
+	"\x1f_hdr_video_static_metadata_typeB\x1b\n" +
+	"\x19_number_of_audio_channelsB\x1a\n" +
+	"\x18_number_of_audio_objectsB\x18\n" +
+	"\x16_audio_bits_per_sampleB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_bit_depthB\x1a\n" +
+	"\x18_is_provided_in_deliveryJ\x06\b\xa8F\x10\x90N\"\xc2\x03\n" +
+	"\fVideoEdition\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x126\n" +
 	"\vresource_id\x18\x02 \x03(\v2\x15.ddex.ern.v43.VideoIdR\n" +
 	"resourceId\x12Q\n" +
 	"\x13edition_contributor\x18\x03 \x03(\v2 .ddex.ern.v43.EditionContributorR\x12editionContributor\x125\n" +
 	"\x06p_line\x18\x04 \x03(\v2\x1e.ddex.ern.v43.PLineWithDefaultR\x05pLine\x125\n" +
-	"\x06c_line\x18\x05 \x03(\v2\x1e.ddex.ern.v43.CLineWithDefaultR\x05cLine\x12%\n" +
-	"\x0erecording_mode\x18\x06 \x01(\tR\rrecordingMode\x12P\n" +
-	"\x11technical_details\x18\a \x03(\v2#.ddex.ern.v43.TechnicalVideoDetailsR\x10technicalDetails\"m\n" +
+	"\x06c_line\x18\x05 \x03(\v2\x1e.ddex.ern.v43.CLineWithDefaultR\x05cLine\x12*\n" +
+	"\x0erecording_mode\x18\x06 \x01(\tH\x01R\rrecordingMode\x88\x01\x01\x12P\n" +
+	"\x11technical_details\x18\a \x03(\v2#.ddex.ern.v43.TechnicalVideoDetailsR\x10technicalDetailsB\a\n" +
+	"\x05_typeB\x11\n" +
+	"\x0f_recording_modeJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tVideoType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xa5\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xf3\x03\n" +
 	"\x14WorkRightsController\x12I\n" +
 	"!rights_controller_party_reference\x18\x01 \x01(\tR\x1erightsControllerPartyReference\x12.\n" +
-	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x124\n" +
-	"\x16rights_controller_type\x18\x03 \x01(\tR\x14rightsControllerType\x12<\n" +
-	"\tterritory\x18\x04 \x03(\v2\x1e.ddex.ern.v43.AllTerritoryCodeR\tterritory\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x06 \x01(\tR\aendDate\x12.\n" +
+	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x129\n" +
+	"\x16rights_controller_type\x18\x03 \x01(\tH\x00R\x14rightsControllerType\x88\x01\x01\x12.\n" +
 	"\x13right_share_unknown\x18\a \x01(\bR\x11rightShareUnknown\x124\n" +
-	"\x16right_share_percentage\x18\b \x01(\tR\x14rightSharePercentage\"\x83\x01\n" +
+	"\x16right_share_percentage\x18\b \x01(\tR\x14rightSharePercentage\x12<\n" +
+	"\tterritory\x18\x04 \x03(\v2\x1e.ddex.ern.v43.AllTerritoryCodeR\tterritory\x12\"\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x06 \x01(\tH\x02R\aendDate\x88\x01\x01B\x19\n" +
+	"\x17_rights_controller_typeB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateJ\x06\b\xa8F\x10\x90N\"\xba\x01\n" +
 	"\x1fAdministratingRecordCompanyRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xf0\x03\n" +
-	"\vAffiliation\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12E\n" +
-	"\x0fvalidity_period\x18\x02 \x01(\v2\x1c.ddex.ern.v43.ValidityPeriodR\x0evalidityPeriod\x129\n" +
-	"\vrights_type\x18\x03 \x03(\v2\x18.ddex.ern.v43.RightsTypeR\n" +
-	"rightsType\x12E\n" +
-	"\x1fpercentage_of_rights_assignment\x18\x04 \x01(\tR\x1cpercentageOfRightsAssignment\x12!\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa1\x04\n" +
+	"\vAffiliation\x12!\n" +
 	"\fcompany_name\x18\x05 \x01(\tR\vcompanyName\x12:\n" +
-	"\x19party_affiliate_reference\x18\x06 \x01(\tR\x17partyAffiliateReference\x12I\n" +
+	"\x19party_affiliate_reference\x18\x06 \x01(\tR\x17partyAffiliateReference\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12I\n" +
 	"\x0eterritory_code\x18\a \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\rterritoryCode\x12Z\n" +
-	"\x17excluded_territory_code\x18\b \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\"Q\n" +
+	"\x17excluded_territory_code\x18\b \x03(\v2\".ddex.ern.v43.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12E\n" +
+	"\x0fvalidity_period\x18\x02 \x01(\v2\x1c.ddex.ern.v43.ValidityPeriodR\x0evalidityPeriod\x129\n" +
+	"\vrights_type\x18\x03 \x03(\v2\x18.ddex.ern.v43.RightsTypeR\n" +
+	"rightsType\x12J\n" +
+	"\x1fpercentage_of_rights_assignment\x18\x04 \x01(\tH\x00R\x1cpercentageOfRightsAssignment\x88\x01\x01B\"\n" +
+	" _percentage_of_rights_assignmentJ\x06\b\xa8F\x10\x90N\"r\n" +
 	"\x10AllTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"\x8e\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xd6\x01\n" +
 	"\vAspectRatio\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
-	"\x11aspect_ratio_type\x18\x02 \x01(\tR\x0faspectRatioType\x12=\n" +
-	"\x1bapplies_to_cropped_resource\x18\x03 \x01(\bR\x18appliesToCroppedResource\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12/\n" +
+	"\x11aspect_ratio_type\x18\x02 \x01(\tH\x00R\x0faspectRatioType\x88\x01\x01\x12B\n" +
+	"\x1bapplies_to_cropped_resource\x18\x03 \x01(\bH\x01R\x18appliesToCroppedResource\x88\x01\x01B\x14\n" +
+	"\x12_aspect_ratio_typeB\x1e\n" +
+	"\x1c_applies_to_cropped_resourceJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eAudioCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"G\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"h\n" +
 	"\aBitRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\x9a\x01\n" +
-	"\x05CLine\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ec_line_company\x18\x02 \x01(\tR\fcLineCompany\x12\x1e\n" +
-	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\"o\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xea\x01\n" +
+	"\x05CLine\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ec_line_company\x18\x02 \x01(\tH\x01R\fcLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_c_line_companyB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vCarrierType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rCatalogNumber\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"l\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xa3\x01\n" +
 	"\bClipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"s\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fContainerFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"s\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fContributorRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"m\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tCueOrigin\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueSheetType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueThemeType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"n\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"CueUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"{\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17CueVisualPerceptionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueVocalType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"U\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"v\n" +
 	"\x14CurrentTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"\xc2\x01\n" +
-	"\x03DSP\x125\n" +
-	"\ftrading_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\vtradingName\x12\x12\n" +
-	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x128\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xca\x01\n" +
+	"\x03DSP\x128\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x1d.ddex.ern.v43.DetailedPartyIdR\apartyId\x126\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2\x17.ddex.ern.v43.PartyNameR\tpartyName\"\xce\x01\n" +
+	"party_name\x18\x04 \x03(\v2\x17.ddex.ern.v43.PartyNameR\tpartyName\x125\n" +
+	"\ftrading_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\vtradingName\x12\x12\n" +
+	"\x05u_r_l\x18\x02 \x03(\tR\x03uRLJ\x06\b\xa8F\x10\x90N\"\x8d\x02\n" +
 	"\x0fDetailedHashSum\x12@\n" +
-	"\talgorithm\x18\x01 \x01(\v2\".ddex.ern.v43.HashSumAlgorithmTypeR\talgorithm\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tparameter\x18\x03 \x01(\tR\tparameter\x12\x1b\n" +
-	"\tdata_type\x18\x04 \x01(\tR\bdataType\x12$\n" +
-	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValue\"\xe9\x01\n" +
-	"\x0fDetailedPartyId\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12(\n" +
-	"\x10cisac_society_id\x18\x05 \x01(\tR\x0ecisacSocietyId\x12B\n" +
-	"\x0eproprietary_id\x18\x06 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\"u\n" +
+	"\talgorithm\x18\x01 \x01(\v2\".ddex.ern.v43.HashSumAlgorithmTypeR\talgorithm\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tparameter\x18\x03 \x01(\tH\x01R\tparameter\x88\x01\x01\x12 \n" +
+	"\tdata_type\x18\x04 \x01(\tH\x02R\bdataType\x88\x01\x01\x12$\n" +
+	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValueB\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_parameterB\f\n" +
+	"\n" +
+	"_data_typeJ\x06\b\xa8F\x10\x90N\"\xd5\x02\n" +
+	"\x0fDetailedPartyId\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12-\n" +
+	"\x10cisac_society_id\x18\x05 \x01(\tH\x04R\x0ecisacSocietyId\x88\x01\x01\x12B\n" +
+	"\x0eproprietary_id\x18\x06 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
+	"\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nB\x13\n" +
+	"\x11_cisac_society_idJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\x11DisplayArtistRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd4\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xca\x03\n" +
 	"\x0eDisplayCredits\x12.\n" +
-	"\x13display_credit_text\x18\x01 \x01(\tR\x11displayCreditText\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x13display_credit_text\x18\x01 \x01(\tR\x11displayCreditText\x120\n" +
+	"\x14display_credit_party\x18\x02 \x01(\tR\x12displayCreditParty\x12A\n" +
+	"\x1bname_used_in_display_credit\x18\x03 \x01(\tH\x00R\x17nameUsedInDisplayCredit\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xa8\x02\n" +
+	"is_default\x18\x06 \x01(\bH\x03R\tisDefault\x88\x01\x01B\x1e\n" +
+	"\x1c_name_used_in_display_creditB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xd0\x03\n" +
 	"\tEventDate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12:\n" +
-	"\x19applicable_territory_code\x18\x05 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"\x97\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb4\x03\n" +
 	"\rEventDateTime\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12%\n" +
-	"\x0eterritory_code\x18\x05 \x01(\tR\rterritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"F\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12*\n" +
+	"\x0eterritory_code\x18\x05 \x01(\tH\x03R\rterritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x11\n" +
+	"\x0f_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"g\n" +
 	"\x06Extent\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\x80\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\x1cExternallyLinkedResourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"q\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8c\x01\n" +
 	"\x04File\x12\x12\n" +
 	"\x05u_r_i\x18\x01 \x01(\tR\x03uRI\x128\n" +
-	"\bhash_sum\x18\x02 \x01(\v2\x1d.ddex.ern.v43.DetailedHashSumR\ahashSum\x12\x1b\n" +
-	"\tfile_size\x18\x03 \x01(\tR\bfileSize\"|\n" +
+	"\bhash_sum\x18\x02 \x01(\v2\x1d.ddex.ern.v43.DetailedHashSumR\ahashSum\x12 \n" +
+	"\tfile_size\x18\x03 \x01(\tH\x00R\bfileSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_file_sizeJ\x06\b\xa8F\x10\x90N\"\xb3\x01\n" +
 	"\x18FingerprintAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x87\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc6\x01\n" +
 	"\x14FirstPublicationDate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"I\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"j\n" +
 	"\tFrameRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\xe2\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xa1\x02\n" +
 	"\x1cFulfillmentDateWithTerritory\x12)\n" +
 	"\x10fulfillment_date\x18\x01 \x01(\tR\x0ffulfillmentDate\x12<\n" +
-	"\x1aresource_release_reference\x18\x02 \x03(\tR\x18resourceReleaseReference\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x1aresource_release_reference\x18\x02 \x03(\tR\x18resourceReleaseReference\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xc9\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xf4\x01\n" +
 	"\rGenreCategory\x126\n" +
 	"\x05value\x18\x01 \x01(\v2 .ddex.ern.v43.GenreCategoryValueR\x05value\x12D\n" +
-	"\vdescription\x18\x02 \x03(\v2\".ddex.ern.v43.TextWithoutTerritoryR\vdescription\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\"\xaf\x01\n" +
+	"\vdescription\x18\x02 \x03(\v2\".ddex.ern.v43.TextWithoutTerritoryR\vdescription\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\x88\x02\n" +
 	"\x12GenreCategoryValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xf6\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xea\x03\n" +
 	"\x12GenreWithTerritory\x12\x1d\n" +
 	"\n" +
-	"genre_text\x18\x01 \x01(\tR\tgenreText\x12\x1b\n" +
-	"\tsub_genre\x18\x02 \x01(\tR\bsubGenre\x12B\n" +
+	"genre_text\x18\x01 \x01(\tR\tgenreText\x12 \n" +
+	"\tsub_genre\x18\x02 \x01(\tH\x00R\bsubGenre\x88\x01\x01\x12B\n" +
 	"\x0egenre_category\x18\x03 \x03(\v2\x1b.ddex.ern.v43.GenreCategoryR\rgenreCategory\x12L\n" +
-	"\x12sub_genre_category\x18\x04 \x03(\v2\x1e.ddex.ern.v43.SubGenreCategoryR\x10subGenreCategory\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x06 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x12sub_genre_category\x18\x04 \x03(\v2\x1e.ddex.ern.v43.SubGenreCategoryR\x10subGenreCategory\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x06 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\a \x01(\bH\x03R\tisDefault\x88\x01\x01B\f\n" +
 	"\n" +
-	"is_default\x18\a \x01(\bR\tisDefault\"x\n" +
+	"_sub_genreB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\x14HashSumAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eImageCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"m\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tImageType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0eInstrumentType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xc1\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa2\x02\n" +
 	"\x15KeywordsWithTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"J\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"l\n" +
 	"\bLanguage\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12(\n" +
-	"\x10is_main_language\x18\x02 \x01(\bR\x0eisMainLanguage\"\xbc\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12-\n" +
+	"\x10is_main_language\x18\x02 \x01(\bH\x00R\x0eisMainLanguage\x88\x01\x01B\x13\n" +
+	"\x11_is_main_languageJ\x06\b\xa8F\x10\x90N\"\x9d\x02\n" +
 	"\x10MarketingComment\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"t\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"|\n" +
 	"\x11MessageAuditTrail\x12_\n" +
-	"\x19message_audit_trail_event\x18\x01 \x03(\v2$.ddex.ern.v43.MessageAuditTrailEventR\x16messageAuditTrailEvent\"\x9c\x01\n" +
+	"\x19message_audit_trail_event\x18\x01 \x03(\v2$.ddex.ern.v43.MessageAuditTrailEventR\x16messageAuditTrailEventJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\x16MessageAuditTrailEvent\x12e\n" +
 	"\x1amessaging_party_descriptor\x18\x01 \x01(\v2'.ddex.ern.v43.MessagingPartyWithoutCodeR\x18messagingPartyDescriptor\x12\x1b\n" +
-	"\tdate_time\x18\x02 \x01(\tR\bdateTime\"\xbe\x04\n" +
-	"\rMessageHeader\x12*\n" +
-	"\x11message_thread_id\x18\x01 \x01(\tR\x0fmessageThreadId\x12\x1d\n" +
+	"\tdate_time\x18\x02 \x01(\tR\bdateTimeJ\x06\b\xa8F\x10\x90N\"\x9a\x05\n" +
+	"\rMessageHeader\x12/\n" +
+	"\x11message_thread_id\x18\x01 \x01(\tH\x00R\x0fmessageThreadId\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId\x12*\n" +
-	"\x11message_file_name\x18\x03 \x01(\tR\x0fmessageFileName\x12N\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12/\n" +
+	"\x11message_file_name\x18\x03 \x01(\tH\x01R\x0fmessageFileName\x88\x01\x01\x12N\n" +
 	"\x0emessage_sender\x18\x04 \x01(\v2'.ddex.ern.v43.MessagingPartyWithoutCodeR\rmessageSender\x12R\n" +
 	"\x11sent_on_behalf_of\x18\x05 \x01(\v2'.ddex.ern.v43.MessagingPartyWithoutCodeR\x0esentOnBehalfOf\x12T\n" +
 	"\x11message_recipient\x18\x06 \x03(\v2'.ddex.ern.v43.MessagingPartyWithoutCodeR\x10messageRecipient\x129\n" +
 	"\x19message_created_date_time\x18\a \x01(\tR\x16messageCreatedDateTime\x12O\n" +
-	"\x13message_audit_trail\x18\b \x01(\v2\x1f.ddex.ern.v43.MessageAuditTrailR\x11messageAuditTrail\x120\n" +
-	"\x14message_control_type\x18\t \x01(\tR\x12messageControlType\"\x9c\x01\n" +
+	"\x13message_audit_trail\x18\b \x01(\v2\x1f.ddex.ern.v43.MessageAuditTrailR\x11messageAuditTrail\x125\n" +
+	"\x14message_control_type\x18\t \x01(\tH\x02R\x12messageControlType\x88\x01\x01B\x14\n" +
+	"\x12_message_thread_idB\x14\n" +
+	"\x12_message_file_nameB\x17\n" +
+	"\x15_message_control_typeJ\x06\b\xa8F\x10\x90N\"\xba\x01\n" +
 	"\x19MessagingPartyWithoutCode\x12\x19\n" +
 	"\bparty_id\x18\x01 \x01(\tR\apartyId\x12A\n" +
 	"\n" +
-	"party_name\x18\x02 \x01(\v2\".ddex.ern.v43.PartyNameWithoutCodeR\tpartyName\x12!\n" +
-	"\ftrading_name\x18\x03 \x01(\tR\vtradingName\"\xe4\x01\n" +
-	"\rMusicalWorkId\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12\x1f\n" +
-	"\vopus_number\x18\x02 \x01(\tR\n" +
-	"opusNumber\x126\n" +
+	"party_name\x18\x02 \x01(\v2\".ddex.ern.v43.PartyNameWithoutCodeR\tpartyName\x12&\n" +
+	"\ftrading_name\x18\x03 \x01(\tH\x00R\vtradingName\x88\x01\x01B\x0f\n" +
+	"\r_trading_nameJ\x06\b\xa8F\x10\x90N\"\xa7\x02\n" +
+	"\rMusicalWorkId\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x12$\n" +
+	"\vopus_number\x18\x02 \x01(\tH\x01R\n" +
+	"opusNumber\x88\x01\x01\x126\n" +
 	"\x17composer_catalog_number\x18\x03 \x03(\tR\x15composerCatalogNumber\x12B\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x05 \x01(\bR\n" +
-	"isReplaced\"U\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x05 \x01(\bH\x02R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_w_cB\x0e\n" +
+	"\f_opus_numberB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\x7f\n" +
 	"\x04Name\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x91\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd9\x01\n" +
 	"\x13OperatingSystemType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xba\x01\n" +
-	"\x05PLine\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ep_line_company\x18\x02 \x01(\tR\fpLineCompany\x12\x1e\n" +
-	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12\x1e\n" +
-	"\vp_line_type\x18\x05 \x01(\tR\tpLineType\"\x80\x02\n" +
-	"\x10PLineWithDefault\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ep_line_company\x18\x02 \x01(\tR\fpLineCompany\x12\x1e\n" +
-	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\"\xdf\x01\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9f\x02\n" +
+	"\x05PLine\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ep_line_company\x18\x02 \x01(\tH\x01R\fpLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12#\n" +
+	"\vp_line_type\x18\x05 \x01(\tH\x03R\tpLineType\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_p_line_companyB\x1b\n" +
+	"\x19_language_and_script_codeB\x0e\n" +
+	"\f_p_line_typeJ\x06\b\xa8F\x10\x90N\"\x87\x03\n" +
+	"\x10PLineWithDefault\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ep_line_company\x18\x02 \x01(\tH\x01R\fpLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bH\x03R\tisDefault\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x04R\x15languageAndScriptCode\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_p_line_companyB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xcd\x02\n" +
 	" ParentalWarningTypeWithTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x05 \x01(\bH\x03R\tisDefault\x88\x01\x01B\f\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\"\xee\x03\n" +
+	"_namespaceB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x15\n" +
+	"\x13_user_defined_valueB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xbd\x04\n" +
 	"\tPartyName\x12/\n" +
-	"\tfull_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12>\n" +
+	"\tfull_name\x18\x01 \x01(\v2\x12.ddex.ern.v43.NameR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12>\n" +
 	"\x11full_name_indexed\x18\x03 \x01(\v2\x12.ddex.ern.v43.NameR\x0ffullNameIndexed\x12E\n" +
 	"\x15names_before_key_name\x18\x04 \x01(\v2\x12.ddex.ern.v43.NameR\x12namesBeforeKeyName\x12-\n" +
 	"\bkey_name\x18\x05 \x01(\v2\x12.ddex.ern.v43.NameR\akeyName\x12C\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v2\x12.ddex.ern.v43.NameR\x11namesAfterKeyName\x12=\n" +
-	"\x10abbreviated_name\x18\a \x01(\v2\x12.ddex.ern.v43.NameR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\"\xc8\x02\n" +
+	"\x10abbreviated_name\x18\a \x01(\v2\x12.ddex.ern.v43.NameR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xf9\x03\n" +
 	"\x14PartyNameWithoutCode\x12\x1b\n" +
-	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12*\n" +
-	"\x11full_name_indexed\x18\x03 \x01(\tR\x0ffullNameIndexed\x121\n" +
-	"\x15names_before_key_name\x18\x04 \x01(\tR\x12namesBeforeKeyName\x12\x19\n" +
-	"\bkey_name\x18\x05 \x01(\tR\akeyName\x12/\n" +
-	"\x14names_after_key_name\x18\x06 \x01(\tR\x11namesAfterKeyName\x12)\n" +
-	"\x10abbreviated_name\x18\a \x01(\tR\x0fabbreviatedName\"\x9d\x01\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12/\n" +
+	"\x11full_name_indexed\x18\x03 \x01(\tH\x01R\x0ffullNameIndexed\x88\x01\x01\x126\n" +
+	"\x15names_before_key_name\x18\x04 \x01(\tH\x02R\x12namesBeforeKeyName\x88\x01\x01\x12\x1e\n" +
+	"\bkey_name\x18\x05 \x01(\tH\x03R\akeyName\x88\x01\x01\x124\n" +
+	"\x14names_after_key_name\x18\x06 \x01(\tH\x04R\x11namesAfterKeyName\x88\x01\x01\x12.\n" +
+	"\x10abbreviated_name\x18\a \x01(\tH\x05R\x0fabbreviatedName\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x14\n" +
+	"\x12_full_name_indexedB\x18\n" +
+	"\x16_names_before_key_nameB\v\n" +
+	"\t_key_nameB\x17\n" +
+	"\x15_names_after_key_nameB\x13\n" +
+	"\x11_abbreviated_nameJ\x06\b\xa8F\x10\x90N\"\xeb\x01\n" +
 	"\x15PartyRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\x12\"\n" +
-	"\rmay_be_shared\x18\x04 \x01(\bR\vmayBeShared\"R\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01\x12'\n" +
+	"\rmay_be_shared\x18\x04 \x01(\bH\x02R\vmayBeShared\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x10\n" +
+	"\x0e_may_be_sharedJ\x06\b\xa8F\x10\x90N\"x\n" +
 	"\n" +
 	"Percentage\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
-	"\x14has_max_value_of_one\x18\x02 \x01(\bR\x10hasMaxValueOfOne\"\xfa\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x123\n" +
+	"\x14has_max_value_of_one\x18\x02 \x01(\bH\x00R\x10hasMaxValueOfOne\x88\x01\x01B\x17\n" +
+	"\x15_has_max_value_of_oneJ\x06\b\xa8F\x10\x90N\"\x82\x02\n" +
 	"\x06Period\x126\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x17.ddex.ern.v43.EventDateR\tstartDate\x122\n" +
 	"\bend_date\x18\x02 \x01(\v2\x17.ddex.ern.v43.EventDateR\aendDate\x12C\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2\x1b.ddex.ern.v43.EventDateTimeR\rstartDateTime\x12?\n" +
-	"\rend_date_time\x18\x04 \x01(\v2\x1b.ddex.ern.v43.EventDateTimeR\vendDateTime\"W\n" +
+	"\rend_date_time\x18\x04 \x01(\v2\x1b.ddex.ern.v43.EventDateTimeR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"\x81\x01\n" +
 	"\x06Prefix\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"B\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"J\n" +
 	"\x05Price\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12#\n" +
-	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"?\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCodeJ\x06\b\xa8F\x10\x90N\"G\n" +
 	"\tPriceType\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"E\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"`\n" +
 	"\x0fPromotionalCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rProprietaryId\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"k\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\aPurpose\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fRatingAgency\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fRatingReason\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"W\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x81\x01\n" +
 	"\x06Reason\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xae\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb6\x01\n" +
 	"\fRelatedParty\x12A\n" +
 	"\x1dparty_related_party_reference\x18\x01 \x01(\tR\x1apartyRelatedPartyReference\x12[\n" +
-	"\x17party_relationship_type\x18\x02 \x01(\v2#.ddex.ern.v43.PartyRelationshipTypeR\x15partyRelationshipType\"{\n" +
+	"\x17party_relationship_type\x18\x02 \x01(\v2#.ddex.ern.v43.PartyRelationshipTypeR\x15partyRelationshipTypeJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17ReleaseRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x85\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xbc\x01\n" +
 	"!ReleaseTypeForReleaseNotification\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xee\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa2\x02\n" +
 	"\"ResourceContainedResourceReference\x12Q\n" +
-	"%resource_contained_resource_reference\x18\x01 \x01(\tR\"resourceContainedResourceReference\x12#\n" +
-	"\rduration_used\x18\x02 \x01(\tR\fdurationUsed\x12\x1f\n" +
-	"\vstart_point\x18\x03 \x01(\tR\n" +
-	"startPoint\x12/\n" +
-	"\apurpose\x18\x04 \x01(\v2\x15.ddex.ern.v43.PurposeR\apurpose\"\xae\x01\n" +
+	"%resource_contained_resource_reference\x18\x01 \x01(\tR\"resourceContainedResourceReference\x12(\n" +
+	"\rduration_used\x18\x02 \x01(\tH\x00R\fdurationUsed\x88\x01\x01\x12$\n" +
+	"\vstart_point\x18\x03 \x01(\tH\x01R\n" +
+	"startPoint\x88\x01\x01\x12/\n" +
+	"\apurpose\x18\x04 \x01(\v2\x15.ddex.ern.v43.PurposeR\apurposeB\x10\n" +
+	"\x0e_duration_usedB\x0e\n" +
+	"\f_start_pointJ\x06\b\xa8F\x10\x90N\"\xb6\x01\n" +
 	"&ResourceContainedResourceReferenceList\x12\x83\x01\n" +
-	"%resource_contained_resource_reference\x18\x01 \x03(\v20.ddex.ern.v43.ResourceContainedResourceReferenceR\"resourceContainedResourceReference\"{\n" +
+	"%resource_contained_resource_reference\x18\x01 \x03(\v20.ddex.ern.v43.ResourceContainedResourceReferenceR\"resourceContainedResourceReferenceJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17ResourceContributorRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd9\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xef\x03\n" +
 	"\n" +
-	"ResourceId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_m_n\x18\x02 \x01(\tR\x04iSMN\x12\x15\n" +
-	"\ai_s_a_n\x18\x03 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x04 \x01(\tR\x05vISAN\x12\x15\n" +
-	"\ai_s_b_n\x18\x05 \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\x06 \x01(\tR\x04iSSN\x12\x15\n" +
-	"\as_i_c_i\x18\a \x01(\tR\x04sICI\x12B\n" +
+	"ResourceId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_m_n\x18\x02 \x01(\tH\x01R\x04iSMN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x03 \x01(\tH\x02R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x04 \x01(\tH\x03R\x05vISAN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_b_n\x18\x05 \x01(\tH\x04R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\x06 \x01(\tH\x05R\x04iSSN\x88\x01\x01\x12\x1a\n" +
+	"\as_i_c_i\x18\a \x01(\tH\x06R\x04sICI\x88\x01\x01\x12B\n" +
 	"\x0ecatalog_number\x18\b \x01(\v2\x1b.ddex.ern.v43.CatalogNumberR\rcatalogNumber\x12B\n" +
-	"\x0eproprietary_id\x18\t \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
+	"\x0eproprietary_id\x18\t \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12$\n" +
 	"\vis_replaced\x18\n" +
-	" \x01(\bR\n" +
-	"isReplaced\"|\n" +
+	" \x01(\bH\aR\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_m_nB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\x99\x01\n" +
 	"\x15ResourceProprietaryId\x12B\n" +
-	"\x0eproprietary_id\x18\x01 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x02 \x01(\bR\n" +
-	"isReplaced\"n\n" +
+	"\x0eproprietary_id\x18\x01 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x02 \x01(\bH\x00R\n" +
+	"isReplaced\x88\x01\x01B\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"RightsType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"L\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"m\n" +
 	"\fSamplingRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"o\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vSessionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x91\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd9\x01\n" +
 	"\x13SheetMusicCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\x8a\x01\n" +
-	"\fSheetMusicId\x12\x15\n" +
-	"\ai_s_m_n\x18\x01 \x01(\tR\x04iSMN\x12B\n" +
-	"\x0eproprietary_id\x18\x02 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x03 \x01(\bR\n" +
-	"isReplaced\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb8\x01\n" +
+	"\fSheetMusicId\x12\x1a\n" +
+	"\ai_s_m_n\x18\x01 \x01(\tH\x00R\x04iSMN\x88\x01\x01\x12B\n" +
+	"\x0eproprietary_id\x18\x02 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x03 \x01(\bH\x01R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_m_nB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0eSheetMusicType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fSoftwareType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd2\x01\n" +
-	"\x10SoundRecordingId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12B\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x80\x02\n" +
+	"\x10SoundRecordingId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12B\n" +
 	"\x0ecatalog_number\x18\x02 \x01(\v2\x1b.ddex.ern.v43.CatalogNumberR\rcatalogNumber\x12B\n" +
-	"\x0eproprietary_id\x18\x03 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x04 \x01(\bR\n" +
-	"isReplaced\"v\n" +
+	"\x0eproprietary_id\x18\x03 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x04 \x01(\bH\x01R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12SoundRecordingType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"M\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"U\n" +
 	"\x10SubGenreCategory\x129\n" +
-	"\x05value\x18\x01 \x03(\v2#.ddex.ern.v43.SubGenreCategoryValueR\x05value\"y\n" +
+	"\x05value\x18\x01 \x03(\v2#.ddex.ern.v43.SubGenreCategoryValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xb0\x01\n" +
 	"\x15SubGenreCategoryValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8b\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd3\x01\n" +
 	"\rTextCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xb2\x01\n" +
-	"\x06TextId\x12\x15\n" +
-	"\ai_s_b_n\x18\x01 \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\x02 \x01(\tR\x04iSSN\x12\x15\n" +
-	"\as_i_c_i\x18\x03 \x01(\tR\x04sICI\x12B\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x05 \x01(\bR\n" +
-	"isReplaced\"l\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x82\x02\n" +
+	"\x06TextId\x12\x1a\n" +
+	"\ai_s_b_n\x18\x01 \x01(\tH\x00R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\x02 \x01(\tH\x01R\x04iSSN\x88\x01\x01\x12\x1a\n" +
+	"\as_i_c_i\x18\x03 \x01(\tH\x02R\x04sICI\x88\x01\x01\x12B\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x05 \x01(\bH\x03R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa3\x01\n" +
 	"\bTextType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x9e\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xbe\x03\n" +
 	"\x0eTextWithFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xa4\x02\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc4\x03\n" +
 	"\x14TextWithoutTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xdc\x01\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9f\x02\n" +
 	"\x17TitleDisplayInformation\x121\n" +
 	"\x15is_displayed_in_title\x18\x01 \x01(\bR\x12isDisplayedInTitle\x12,\n" +
-	"\x06prefix\x18\x02 \x03(\v2\x14.ddex.ern.v43.PrefixR\x06prefix\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\"|\n" +
+	"\x06prefix\x18\x02 \x03(\v2\x14.ddex.ern.v43.PrefixR\x06prefix\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x01R\x0esequenceNumber\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x84\x01\n" +
 	"\x0eValidityPeriod\x126\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x17.ddex.ern.v43.EventDateR\tstartDate\x122\n" +
-	"\bend_date\x18\x02 \x01(\v2\x17.ddex.ern.v43.EventDateR\aendDate\"\xd6\x01\n" +
-	"\x05Venue\x12\x1d\n" +
+	"\bend_date\x18\x02 \x01(\v2\x17.ddex.ern.v43.EventDateR\aendDateJ\x06\b\xa8F\x10\x90N\"\xb4\x02\n" +
+	"\x05Venue\x12\"\n" +
 	"\n" +
-	"venue_name\x18\x01 \x01(\tR\tvenueName\x12#\n" +
-	"\rvenue_address\x18\x02 \x01(\tR\fvenueAddress\x12E\n" +
-	"\x0eterritory_code\x18\x03 \x01(\v2\x1e.ddex.ern.v43.AllTerritoryCodeR\rterritoryCode\x12#\n" +
-	"\rlocation_code\x18\x04 \x01(\tR\flocationCode\x12\x1d\n" +
+	"venue_name\x18\x01 \x01(\tH\x00R\tvenueName\x88\x01\x01\x12(\n" +
+	"\rvenue_address\x18\x02 \x01(\tH\x01R\fvenueAddress\x88\x01\x01\x12E\n" +
+	"\x0eterritory_code\x18\x03 \x01(\v2\x1e.ddex.ern.v43.AllTerritoryCodeR\rterritoryCode\x12(\n" +
+	"\rlocation_code\x18\x04 \x01(\tH\x02R\flocationCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"venue_room\x18\x05 \x01(\tR\tvenueRoom\"o\n" +
+	"venue_room\x18\x05 \x01(\tH\x03R\tvenueRoom\x88\x01\x01B\r\n" +
+	"\v_venue_nameB\x10\n" +
+	"\x0e_venue_addressB\x10\n" +
+	"\x0e_location_codeB\r\n" +
+	"\v_venue_roomJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vVersionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eVideoCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"w\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13VideoDefinitionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x91\x02\n" +
-	"\aVideoId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_a_n\x18\x02 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x03 \x01(\tR\x05vISAN\x12B\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xe3\x02\n" +
+	"\aVideoId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x02 \x01(\tH\x01R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x03 \x01(\tH\x02R\x05vISAN\x88\x01\x01\x12B\n" +
 	"\x0ecatalog_number\x18\x04 \x01(\v2\x1b.ddex.ern.v43.CatalogNumberR\rcatalogNumber\x12B\n" +
 	"\x0eproprietary_id\x18\x05 \x03(\v2\x1b.ddex.ern.v43.ProprietaryIdR\rproprietaryId\x12\x15\n" +
-	"\ae_i_d_r\x18\x06 \x03(\tR\x04eIDR\x12\x1f\n" +
-	"\vis_replaced\x18\a \x01(\bR\n" +
-	"isReplacedB\x9d\x01\n" +
+	"\ae_i_d_r\x18\x06 \x03(\tR\x04eIDR\x12$\n" +
+	"\vis_replaced\x18\a \x01(\bH\x03R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90NB\x9d\x01\n" +
 	"\x10com.ddex.ern.v43B\bV43ProtoP\x01Z-github.com/alecsavvy/ddex-go/gen/ddex/ern/v43\xa2\x02\x03DEX\xaa\x02\fDdex.Ern.V43\xca\x02\fDdex\\Ern\\V43\xe2\x02\x18Ddex\\Ern\\V43\\GPBMetadata\xea\x02\x0eDdex::Ern::V43b\x06proto3"
 
 var (
@@ -19938,14 +20596,14 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	123, // 48: ddex.ern.v43.Cue.cue_vocal_type:type_name -> ddex.ern.v43.CueVocalType
 	122, // 49: ddex.ern.v43.Cue.cue_visual_perception_type:type_name -> ddex.ern.v43.CueVisualPerceptionType
 	118, // 50: ddex.ern.v43.Cue.cue_origin:type_name -> ddex.ern.v43.CueOrigin
-	36,  // 51: ddex.ern.v43.Cue.display_title_text:type_name -> ddex.ern.v43.DisplayTitleText
-	35,  // 52: ddex.ern.v43.Cue.display_title:type_name -> ddex.ern.v43.DisplayTitle
-	2,   // 53: ddex.ern.v43.Cue.additional_title:type_name -> ddex.ern.v43.AdditionalTitle
-	15,  // 54: ddex.ern.v43.Cue.contributor:type_name -> ddex.ern.v43.Contributor
-	156, // 55: ddex.ern.v43.Cue.p_line:type_name -> ddex.ern.v43.PLine
-	112, // 56: ddex.ern.v43.Cue.c_line:type_name -> ddex.ern.v43.CLine
-	179, // 57: ddex.ern.v43.Cue.resource_id:type_name -> ddex.ern.v43.ResourceId
-	153, // 58: ddex.ern.v43.Cue.work_id:type_name -> ddex.ern.v43.MusicalWorkId
+	179, // 51: ddex.ern.v43.Cue.resource_id:type_name -> ddex.ern.v43.ResourceId
+	153, // 52: ddex.ern.v43.Cue.work_id:type_name -> ddex.ern.v43.MusicalWorkId
+	36,  // 53: ddex.ern.v43.Cue.display_title_text:type_name -> ddex.ern.v43.DisplayTitleText
+	35,  // 54: ddex.ern.v43.Cue.display_title:type_name -> ddex.ern.v43.DisplayTitle
+	2,   // 55: ddex.ern.v43.Cue.additional_title:type_name -> ddex.ern.v43.AdditionalTitle
+	15,  // 56: ddex.ern.v43.Cue.contributor:type_name -> ddex.ern.v43.Contributor
+	156, // 57: ddex.ern.v43.Cue.p_line:type_name -> ddex.ern.v43.PLine
+	112, // 58: ddex.ern.v43.Cue.c_line:type_name -> ddex.ern.v43.CLine
 	168, // 59: ddex.ern.v43.CueSheet.cue_sheet_id:type_name -> ddex.ern.v43.ProprietaryId
 	119, // 60: ddex.ern.v43.CueSheet.cue_sheet_type:type_name -> ddex.ern.v43.CueSheetType
 	18,  // 61: ddex.ern.v43.CueSheet.cue:type_name -> ddex.ern.v43.Cue
@@ -19956,31 +20614,31 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	65,  // 66: ddex.ern.v43.DealList.release_deal:type_name -> ddex.ern.v43.ReleaseDeal
 	70,  // 67: ddex.ern.v43.DealList.release_visibility:type_name -> ddex.ern.v43.ReleaseVisibility
 	97,  // 68: ddex.ern.v43.DealList.track_release_visibility:type_name -> ddex.ern.v43.TrackReleaseVisibility
-	54,  // 69: ddex.ern.v43.DealTerms.validity_period:type_name -> ddex.ern.v43.PeriodWithStartDate
-	13,  // 70: ddex.ern.v43.DealTerms.commercial_model_type:type_name -> ddex.ern.v43.CommercialModelType
-	31,  // 71: ddex.ern.v43.DealTerms.use_type:type_name -> ddex.ern.v43.DiscoverableUseType
-	99,  // 72: ddex.ern.v43.DealTerms.user_interface_type:type_name -> ddex.ern.v43.UserInterfaceType
-	113, // 73: ddex.ern.v43.DealTerms.carrier_type:type_name -> ddex.ern.v43.CarrierType
-	26,  // 74: ddex.ern.v43.DealTerms.technical_instantiation:type_name -> ddex.ern.v43.DealTermsTechnicalInstantiation
-	76,  // 75: ddex.ern.v43.DealTerms.rights_claim_policy:type_name -> ddex.ern.v43.RightsClaimPolicy
-	57,  // 76: ddex.ern.v43.DealTerms.price_information:type_name -> ddex.ern.v43.PriceInformationWithType
-	23,  // 77: ddex.ern.v43.DealTerms.instant_gratification_resource_list:type_name -> ddex.ern.v43.DealResourceReferenceList
-	56,  // 78: ddex.ern.v43.DealTerms.physical_returns:type_name -> ddex.ern.v43.PhysicalReturns
-	124, // 79: ddex.ern.v43.DealTerms.territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
-	124, // 80: ddex.ern.v43.DealTerms.excluded_territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
-	125, // 81: ddex.ern.v43.DealTerms.distribution_channel:type_name -> ddex.ern.v43.DSP
-	125, // 82: ddex.ern.v43.DealTerms.excluded_distribution_channel:type_name -> ddex.ern.v43.DSP
-	167, // 83: ddex.ern.v43.DealTerms.promotional_code:type_name -> ddex.ern.v43.PromotionalCode
+	124, // 69: ddex.ern.v43.DealTerms.territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
+	124, // 70: ddex.ern.v43.DealTerms.excluded_territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
+	54,  // 71: ddex.ern.v43.DealTerms.validity_period:type_name -> ddex.ern.v43.PeriodWithStartDate
+	13,  // 72: ddex.ern.v43.DealTerms.commercial_model_type:type_name -> ddex.ern.v43.CommercialModelType
+	31,  // 73: ddex.ern.v43.DealTerms.use_type:type_name -> ddex.ern.v43.DiscoverableUseType
+	99,  // 74: ddex.ern.v43.DealTerms.user_interface_type:type_name -> ddex.ern.v43.UserInterfaceType
+	113, // 75: ddex.ern.v43.DealTerms.carrier_type:type_name -> ddex.ern.v43.CarrierType
+	26,  // 76: ddex.ern.v43.DealTerms.technical_instantiation:type_name -> ddex.ern.v43.DealTermsTechnicalInstantiation
+	125, // 77: ddex.ern.v43.DealTerms.distribution_channel:type_name -> ddex.ern.v43.DSP
+	125, // 78: ddex.ern.v43.DealTerms.excluded_distribution_channel:type_name -> ddex.ern.v43.DSP
+	76,  // 79: ddex.ern.v43.DealTerms.rights_claim_policy:type_name -> ddex.ern.v43.RightsClaimPolicy
+	57,  // 80: ddex.ern.v43.DealTerms.price_information:type_name -> ddex.ern.v43.PriceInformationWithType
+	167, // 81: ddex.ern.v43.DealTerms.promotional_code:type_name -> ddex.ern.v43.PromotionalCode
+	23,  // 82: ddex.ern.v43.DealTerms.instant_gratification_resource_list:type_name -> ddex.ern.v43.DealResourceReferenceList
+	56,  // 83: ddex.ern.v43.DealTerms.physical_returns:type_name -> ddex.ern.v43.PhysicalReturns
 	202, // 84: ddex.ern.v43.DealTermsTechnicalInstantiation.video_definition_type:type_name -> ddex.ern.v43.VideoDefinitionType
 	111, // 85: ddex.ern.v43.DealTermsTechnicalInstantiation.bit_rate:type_name -> ddex.ern.v43.BitRate
 	98,  // 86: ddex.ern.v43.DelegatedUsageRights.use_type:type_name -> ddex.ern.v43.UseType
 	163, // 87: ddex.ern.v43.DelegatedUsageRights.period_of_rights_delegation:type_name -> ddex.ern.v43.Period
 	108, // 88: ddex.ern.v43.DelegatedUsageRights.territory_of_rights_delegation:type_name -> ddex.ern.v43.AllTerritoryCode
-	117, // 89: ddex.ern.v43.DetailedResourceContributor.role:type_name -> ddex.ern.v43.ContributorRole
-	145, // 90: ddex.ern.v43.DetailedResourceContributor.instrument_type:type_name -> ddex.ern.v43.InstrumentType
-	129, // 91: ddex.ern.v43.DetailedResourceContributor.display_credits:type_name -> ddex.ern.v43.DisplayCredits
-	127, // 92: ddex.ern.v43.DetailedResourceContributor.party_id:type_name -> ddex.ern.v43.DetailedPartyId
-	159, // 93: ddex.ern.v43.DetailedResourceContributor.party_name:type_name -> ddex.ern.v43.PartyName
+	127, // 89: ddex.ern.v43.DetailedResourceContributor.party_id:type_name -> ddex.ern.v43.DetailedPartyId
+	159, // 90: ddex.ern.v43.DetailedResourceContributor.party_name:type_name -> ddex.ern.v43.PartyName
+	117, // 91: ddex.ern.v43.DetailedResourceContributor.role:type_name -> ddex.ern.v43.ContributorRole
+	145, // 92: ddex.ern.v43.DetailedResourceContributor.instrument_type:type_name -> ddex.ern.v43.InstrumentType
+	129, // 93: ddex.ern.v43.DetailedResourceContributor.display_credits:type_name -> ddex.ern.v43.DisplayCredits
 	128, // 94: ddex.ern.v43.DisplayArtist.display_artist_role:type_name -> ddex.ern.v43.DisplayArtistRole
 	117, // 95: ddex.ern.v43.DisplayArtist.artistic_role:type_name -> ddex.ern.v43.ContributorRole
 	197, // 96: ddex.ern.v43.DisplayArtist.title_display_information:type_name -> ddex.ern.v43.TitleDisplayInformation
@@ -20019,10 +20677,10 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	199, // 129: ddex.ern.v43.LocationAndDateOfSession.venue:type_name -> ddex.ern.v43.Venue
 	195, // 130: ddex.ern.v43.LocationAndDateOfSession.comment:type_name -> ddex.ern.v43.TextWithFormat
 	53,  // 131: ddex.ern.v43.LocationAndDateOfSession.contributor:type_name -> ddex.ern.v43.PartyWithRole
-	107, // 132: ddex.ern.v43.Party.affiliation:type_name -> ddex.ern.v43.Affiliation
-	173, // 133: ddex.ern.v43.Party.related_party:type_name -> ddex.ern.v43.RelatedParty
-	127, // 134: ddex.ern.v43.Party.party_id:type_name -> ddex.ern.v43.DetailedPartyId
-	52,  // 135: ddex.ern.v43.Party.party_name:type_name -> ddex.ern.v43.PartyNameWithTerritory
+	127, // 132: ddex.ern.v43.Party.party_id:type_name -> ddex.ern.v43.DetailedPartyId
+	52,  // 133: ddex.ern.v43.Party.party_name:type_name -> ddex.ern.v43.PartyNameWithTerritory
+	107, // 134: ddex.ern.v43.Party.affiliation:type_name -> ddex.ern.v43.Affiliation
+	173, // 135: ddex.ern.v43.Party.related_party:type_name -> ddex.ern.v43.RelatedParty
 	50,  // 136: ddex.ern.v43.PartyList.party:type_name -> ddex.ern.v43.Party
 	154, // 137: ddex.ern.v43.PartyNameWithTerritory.full_name:type_name -> ddex.ern.v43.Name
 	154, // 138: ddex.ern.v43.PartyNameWithTerritory.full_name_indexed:type_name -> ddex.ern.v43.Name
@@ -20057,8 +20715,8 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	67,  // 167: ddex.ern.v43.RelatedRelease.release_label_reference:type_name -> ddex.ern.v43.ReleaseLabelReference
 	42,  // 168: ddex.ern.v43.RelatedRelease.release_date:type_name -> ddex.ern.v43.EventDateWithoutFlags
 	42,  // 169: ddex.ern.v43.RelatedRelease.original_release_date:type_name -> ddex.ern.v43.EventDateWithoutFlags
-	94,  // 170: ddex.ern.v43.RelatedResource.timing:type_name -> ddex.ern.v43.Timing
-	179, // 171: ddex.ern.v43.RelatedResource.resource_id:type_name -> ddex.ern.v43.ResourceId
+	179, // 170: ddex.ern.v43.RelatedResource.resource_id:type_name -> ddex.ern.v43.ResourceId
+	94,  // 171: ddex.ern.v43.RelatedResource.timing:type_name -> ddex.ern.v43.Timing
 	175, // 172: ddex.ern.v43.Release.release_type:type_name -> ddex.ern.v43.ReleaseTypeForReleaseNotification
 	66,  // 173: ddex.ern.v43.Release.release_id:type_name -> ddex.ern.v43.ReleaseId
 	36,  // 174: ddex.ern.v43.Release.display_title_text:type_name -> ddex.ern.v43.DisplayTitleText
@@ -20100,10 +20758,10 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	2,   // 210: ddex.ern.v43.ResourceGroup.additional_title:type_name -> ddex.ern.v43.AdditionalTitle
 	32,  // 211: ddex.ern.v43.ResourceGroup.display_artist:type_name -> ddex.ern.v43.DisplayArtist
 	113, // 212: ddex.ern.v43.ResourceGroup.carrier_type:type_name -> ddex.ern.v43.CarrierType
-	75,  // 213: ddex.ern.v43.ResourceGroup.resource_group:type_name -> ddex.ern.v43.ResourceSubGroup
-	72,  // 214: ddex.ern.v43.ResourceGroup.resource_group_content_item:type_name -> ddex.ern.v43.ResourceGroupContentItem
-	48,  // 215: ddex.ern.v43.ResourceGroup.linked_release_resource_reference:type_name -> ddex.ern.v43.LinkedReleaseResourceReference
-	66,  // 216: ddex.ern.v43.ResourceGroup.release_id:type_name -> ddex.ern.v43.ReleaseId
+	66,  // 213: ddex.ern.v43.ResourceGroup.release_id:type_name -> ddex.ern.v43.ReleaseId
+	75,  // 214: ddex.ern.v43.ResourceGroup.resource_group:type_name -> ddex.ern.v43.ResourceSubGroup
+	72,  // 215: ddex.ern.v43.ResourceGroup.resource_group_content_item:type_name -> ddex.ern.v43.ResourceGroupContentItem
+	48,  // 216: ddex.ern.v43.ResourceGroup.linked_release_resource_reference:type_name -> ddex.ern.v43.LinkedReleaseResourceReference
 	48,  // 217: ddex.ern.v43.ResourceGroupContentItem.linked_release_resource_reference:type_name -> ddex.ern.v43.LinkedReleaseResourceReference
 	81,  // 218: ddex.ern.v43.ResourceList.sound_recording:type_name -> ddex.ern.v43.SoundRecording
 	100, // 219: ddex.ern.v43.ResourceList.video:type_name -> ddex.ern.v43.Video
@@ -20111,22 +20769,22 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	93,  // 221: ddex.ern.v43.ResourceList.text:type_name -> ddex.ern.v43.Text
 	79,  // 222: ddex.ern.v43.ResourceList.sheet_music:type_name -> ddex.ern.v43.SheetMusic
 	80,  // 223: ddex.ern.v43.ResourceList.software:type_name -> ddex.ern.v43.Software
-	28,  // 224: ddex.ern.v43.ResourceRightsController.delegated_usage_rights:type_name -> ddex.ern.v43.DelegatedUsageRights
-	162, // 225: ddex.ern.v43.ResourceRightsController.right_share_percentage:type_name -> ddex.ern.v43.Percentage
+	162, // 224: ddex.ern.v43.ResourceRightsController.right_share_percentage:type_name -> ddex.ern.v43.Percentage
+	28,  // 225: ddex.ern.v43.ResourceRightsController.delegated_usage_rights:type_name -> ddex.ern.v43.DelegatedUsageRights
 	36,  // 226: ddex.ern.v43.ResourceSubGroup.display_title_text:type_name -> ddex.ern.v43.DisplayTitleText
 	35,  // 227: ddex.ern.v43.ResourceSubGroup.display_title:type_name -> ddex.ern.v43.DisplayTitle
 	2,   // 228: ddex.ern.v43.ResourceSubGroup.additional_title:type_name -> ddex.ern.v43.AdditionalTitle
 	32,  // 229: ddex.ern.v43.ResourceSubGroup.display_artist:type_name -> ddex.ern.v43.DisplayArtist
 	113, // 230: ddex.ern.v43.ResourceSubGroup.carrier_type:type_name -> ddex.ern.v43.CarrierType
-	75,  // 231: ddex.ern.v43.ResourceSubGroup.resource_group:type_name -> ddex.ern.v43.ResourceSubGroup
-	72,  // 232: ddex.ern.v43.ResourceSubGroup.resource_group_content_item:type_name -> ddex.ern.v43.ResourceGroupContentItem
-	48,  // 233: ddex.ern.v43.ResourceSubGroup.linked_release_resource_reference:type_name -> ddex.ern.v43.LinkedReleaseResourceReference
-	66,  // 234: ddex.ern.v43.ResourceSubGroup.release_id:type_name -> ddex.ern.v43.ReleaseId
+	66,  // 231: ddex.ern.v43.ResourceSubGroup.release_id:type_name -> ddex.ern.v43.ReleaseId
+	75,  // 232: ddex.ern.v43.ResourceSubGroup.resource_group:type_name -> ddex.ern.v43.ResourceSubGroup
+	72,  // 233: ddex.ern.v43.ResourceSubGroup.resource_group_content_item:type_name -> ddex.ern.v43.ResourceGroupContentItem
+	48,  // 234: ddex.ern.v43.ResourceSubGroup.linked_release_resource_reference:type_name -> ddex.ern.v43.LinkedReleaseResourceReference
 	14,  // 235: ddex.ern.v43.RightsClaimPolicy.condition:type_name -> ddex.ern.v43.ConditionForRightsClaimPolicy
-	154, // 236: ddex.ern.v43.ServiceException.trading_name:type_name -> ddex.ern.v43.Name
-	7,   // 237: ddex.ern.v43.ServiceException.channel:type_name -> ddex.ern.v43.Channel
-	127, // 238: ddex.ern.v43.ServiceException.party_id:type_name -> ddex.ern.v43.DetailedPartyId
-	159, // 239: ddex.ern.v43.ServiceException.party_name:type_name -> ddex.ern.v43.PartyName
+	127, // 236: ddex.ern.v43.ServiceException.party_id:type_name -> ddex.ern.v43.DetailedPartyId
+	159, // 237: ddex.ern.v43.ServiceException.party_name:type_name -> ddex.ern.v43.PartyName
+	154, // 238: ddex.ern.v43.ServiceException.trading_name:type_name -> ddex.ern.v43.Name
+	7,   // 239: ddex.ern.v43.ServiceException.channel:type_name -> ddex.ern.v43.Channel
 	186, // 240: ddex.ern.v43.SheetMusic.type:type_name -> ddex.ern.v43.SheetMusicType
 	185, // 241: ddex.ern.v43.SheetMusic.resource_id:type_name -> ddex.ern.v43.SheetMusicId
 	153, // 242: ddex.ern.v43.SheetMusic.work_id:type_name -> ddex.ern.v43.MusicalWorkId
@@ -20289,10 +20947,10 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	5,   // 399: ddex.ern.v43.Video.av_rating:type_name -> ddex.ern.v43.AvRating
 	61,  // 400: ddex.ern.v43.Video.related_release:type_name -> ddex.ern.v43.RelatedRelease
 	62,  // 401: ddex.ern.v43.Video.related_resource:type_name -> ddex.ern.v43.RelatedResource
-	129, // 402: ddex.ern.v43.Video.display_credits:type_name -> ddex.ern.v43.DisplayCredits
-	147, // 403: ddex.ern.v43.Video.language_of_performance:type_name -> ddex.ern.v43.Language
-	177, // 404: ddex.ern.v43.Video.resource_contained_resource_reference_list:type_name -> ddex.ern.v43.ResourceContainedResourceReferenceList
-	172, // 405: ddex.ern.v43.Video.reason_for_cue_sheet_absence:type_name -> ddex.ern.v43.Reason
+	172, // 402: ddex.ern.v43.Video.reason_for_cue_sheet_absence:type_name -> ddex.ern.v43.Reason
+	129, // 403: ddex.ern.v43.Video.display_credits:type_name -> ddex.ern.v43.DisplayCredits
+	147, // 404: ddex.ern.v43.Video.language_of_performance:type_name -> ddex.ern.v43.Language
+	177, // 405: ddex.ern.v43.Video.resource_contained_resource_reference_list:type_name -> ddex.ern.v43.ResourceContainedResourceReferenceList
 	115, // 406: ddex.ern.v43.VideoClipDetails.clip_type:type_name -> ddex.ern.v43.ClipType
 	94,  // 407: ddex.ern.v43.VideoClipDetails.timing:type_name -> ddex.ern.v43.Timing
 	102, // 408: ddex.ern.v43.VideoClipDetails.delivery_file:type_name -> ddex.ern.v43.VideoDeliveryFile
@@ -20317,13 +20975,13 @@ var file_ddex_ern_v43_v43_proto_depIdxs = []int32{
 	6,   // 427: ddex.ern.v43.VideoEdition.c_line:type_name -> ddex.ern.v43.CLineWithDefault
 	92,  // 428: ddex.ern.v43.VideoEdition.technical_details:type_name -> ddex.ern.v43.TechnicalVideoDetails
 	108, // 429: ddex.ern.v43.WorkRightsController.territory:type_name -> ddex.ern.v43.AllTerritoryCode
-	198, // 430: ddex.ern.v43.Affiliation.validity_period:type_name -> ddex.ern.v43.ValidityPeriod
-	181, // 431: ddex.ern.v43.Affiliation.rights_type:type_name -> ddex.ern.v43.RightsType
-	124, // 432: ddex.ern.v43.Affiliation.territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
-	124, // 433: ddex.ern.v43.Affiliation.excluded_territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
-	154, // 434: ddex.ern.v43.DSP.trading_name:type_name -> ddex.ern.v43.Name
-	127, // 435: ddex.ern.v43.DSP.party_id:type_name -> ddex.ern.v43.DetailedPartyId
-	159, // 436: ddex.ern.v43.DSP.party_name:type_name -> ddex.ern.v43.PartyName
+	124, // 430: ddex.ern.v43.Affiliation.territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
+	124, // 431: ddex.ern.v43.Affiliation.excluded_territory_code:type_name -> ddex.ern.v43.CurrentTerritoryCode
+	198, // 432: ddex.ern.v43.Affiliation.validity_period:type_name -> ddex.ern.v43.ValidityPeriod
+	181, // 433: ddex.ern.v43.Affiliation.rights_type:type_name -> ddex.ern.v43.RightsType
+	127, // 434: ddex.ern.v43.DSP.party_id:type_name -> ddex.ern.v43.DetailedPartyId
+	159, // 435: ddex.ern.v43.DSP.party_name:type_name -> ddex.ern.v43.PartyName
+	154, // 436: ddex.ern.v43.DSP.trading_name:type_name -> ddex.ern.v43.Name
 	142, // 437: ddex.ern.v43.DetailedHashSum.algorithm:type_name -> ddex.ern.v43.HashSumAlgorithmType
 	168, // 438: ddex.ern.v43.DetailedPartyId.proprietary_id:type_name -> ddex.ern.v43.ProprietaryId
 	126, // 439: ddex.ern.v43.File.hash_sum:type_name -> ddex.ern.v43.DetailedHashSum
@@ -20378,6 +21036,171 @@ func file_ddex_ern_v43_v43_proto_init() {
 	if File_ddex_ern_v43_v43_proto != nil {
 		return
 	}
+	file_ddex_ern_v43_v43_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[2].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[4].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[5].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[6].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[8].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[9].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[10].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[11].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[13].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[14].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[15].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[17].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[18].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[21].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[25].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[26].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[27].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[29].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[31].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[32].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[34].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[35].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[36].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[37].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[38].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[39].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[40].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[41].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[42].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[43].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[44].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[45].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[46].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[47].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[48].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[52].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[53].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[56].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[57].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[59].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[60].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[63].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[64].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[66].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[67].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[68].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[70].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[71].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[72].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[74].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[75].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[79].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[80].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[81].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[83].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[85].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[86].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[87].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[88].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[89].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[90].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[91].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[92].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[93].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[94].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[95].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[96].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[97].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[98].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[99].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[100].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[101].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[102].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[103].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[104].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[105].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[106].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[107].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[108].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[109].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[110].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[111].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[112].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[113].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[115].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[116].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[117].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[118].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[119].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[120].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[121].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[122].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[123].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[124].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[126].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[127].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[128].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[129].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[130].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[131].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[132].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[133].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[134].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[135].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[136].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[137].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[138].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[139].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[140].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[141].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[142].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[143].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[144].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[145].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[146].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[147].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[148].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[151].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[152].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[153].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[154].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[155].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[156].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[157].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[158].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[159].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[160].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[161].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[162].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[164].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[167].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[169].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[170].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[171].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[172].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[174].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[175].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[176].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[178].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[179].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[180].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[181].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[182].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[183].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[184].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[185].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[186].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[187].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[188].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[189].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[191].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[192].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[193].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[194].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[195].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[196].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[197].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[199].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[200].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[201].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[202].OneofWrappers = []any{}
+	file_ddex_ern_v43_v43_proto_msgTypes[203].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -1,3 +1,6 @@
+// Generated by xsd2proto devel on 2026-10-15
+// Schema: ernv432/release-notification.xsd (SCOW Version v0.9.11 250618, sha256 d808da301b6d)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.9
@@ -42,22 +45,29 @@ type NewReleaseMessage struct {
 	DealList *DealList `protobuf:"bytes,8,opt,name=deal_list,json=dealList,proto3" json:"deal_list,omitempty" xml:"DealList"`
 	// @gotags: xml:"SupplementalDocumentList"
 	SupplementalDocumentList *SupplementalDocumentList `protobuf:"bytes,9,opt,name=supplemental_document_list,json=supplementalDocumentList,proto3" json:"supplemental_document_list,omitempty" xml:"SupplementalDocumentList"`
-	// @gotags: xml:"ReleaseProfileVersionId,attr"
-	ReleaseProfileVersionId string `protobuf:"bytes,10,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr"`
-	// @gotags: xml:"ReleaseProfileVariantVersionId,attr"
-	ReleaseProfileVariantVersionId string `protobuf:"bytes,11,opt,name=release_profile_variant_version_id,json=releaseProfileVariantVersionId,proto3" json:"release_profile_variant_version_id,omitempty" xml:"ReleaseProfileVariantVersionId,attr"`
+	// @gotags: xml:"ReleaseProfileVersionId,attr" avs:"ReleaseProfileVersionId"
+	ReleaseProfileVersionId *string `protobuf:"bytes,10,opt,name=release_profile_version_id,json=releaseProfileVersionId,proto3,oneof" json:"release_profile_version_id,omitempty" xml:"ReleaseProfileVersionId,attr" avs:"ReleaseProfileVersionId"`
+	// @gotags: xml:"ReleaseProfileVariantVersionId,attr" avs:"ReleaseProfileVariantVersionId"
+	ReleaseProfileVariantVersionId *string `protobuf:"bytes,11,opt,name=release_profile_variant_version_id,json=releaseProfileVariantVersionId,proto3,oneof" json:"release_profile_variant_version_id,omitempty" xml:"ReleaseProfileVariantVersionId,attr" avs:"ReleaseProfileVariantVersionId"`
 	// @gotags: xml:"AvsVersionId,attr"
 	AvsVersionId string `protobuf:"bytes,12,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
 	LanguageAndScriptCode string `protobuf:"bytes,13,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:ern,attr"
-	XmlnsErn string `protobuf:"bytes,14,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr"`
+	// @gotags: xml:"xmlns:ern,attr,omitempty"
+	XmlnsErn string `protobuf:"bytes,14,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,15,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,16,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,17,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NewReleaseMessage) Reset() {
@@ -154,15 +164,15 @@ func (x *NewReleaseMessage) GetSupplementalDocumentList() *SupplementalDocumentL
 }
 
 func (x *NewReleaseMessage) GetReleaseProfileVersionId() string {
-	if x != nil {
-		return x.ReleaseProfileVersionId
+	if x != nil && x.ReleaseProfileVersionId != nil {
+		return *x.ReleaseProfileVersionId
 	}
 	return ""
 }
 
 func (x *NewReleaseMessage) GetReleaseProfileVariantVersionId() string {
-	if x != nil {
-		return x.ReleaseProfileVariantVersionId
+	if x != nil && x.ReleaseProfileVariantVersionId != nil {
+		return *x.ReleaseProfileVariantVersionId
 	}
 	return ""
 }
@@ -202,6 +212,13 @@ func (x *NewReleaseMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *NewReleaseMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type PurgeReleaseMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageHeader"
@@ -212,14 +229,21 @@ type PurgeReleaseMessage struct {
 	AvsVersionId string `protobuf:"bytes,3,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
 	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:ern,attr"
-	XmlnsErn string `protobuf:"bytes,5,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr"`
+	// @gotags: xml:"xmlns:ern,attr,omitempty"
+	XmlnsErn string `protobuf:"bytes,5,opt,name=xmlns_ern,json=xmlnsErn,proto3" json:"xmlns_ern,omitempty" xml:"xmlns:ern,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,6,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,7,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,8,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PurgeReleaseMessage) Reset() {
@@ -301,6 +325,13 @@ func (x *PurgeReleaseMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *PurgeReleaseMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type AdministratingRecordCompany struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"RecordCompanyPartyReference"
@@ -368,25 +399,25 @@ type AudioDeliveryFile struct {
 	// @gotags: xml:"OriginalBitRate"
 	OriginalBitRate *BitRate `protobuf:"bytes,5,opt,name=original_bit_rate,json=originalBitRate,proto3" json:"original_bit_rate,omitempty" xml:"OriginalBitRate"`
 	// @gotags: xml:"NumberOfChannels"
-	NumberOfChannels string `protobuf:"bytes,6,opt,name=number_of_channels,json=numberOfChannels,proto3" json:"number_of_channels,omitempty" xml:"NumberOfChannels"`
+	NumberOfChannels *string `protobuf:"bytes,6,opt,name=number_of_channels,json=numberOfChannels,proto3,oneof" json:"number_of_channels,omitempty" xml:"NumberOfChannels"`
 	// @gotags: xml:"NumberOfAudioObjects"
-	NumberOfAudioObjects int32 `protobuf:"varint,7,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
+	NumberOfAudioObjects *int32 `protobuf:"varint,7,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3,oneof" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
 	// @gotags: xml:"SamplingRate"
 	SamplingRate *SamplingRate `protobuf:"bytes,8,opt,name=sampling_rate,json=samplingRate,proto3" json:"sampling_rate,omitempty" xml:"SamplingRate"`
 	// @gotags: xml:"OriginalSamplingRate"
 	OriginalSamplingRate *SamplingRate `protobuf:"bytes,9,opt,name=original_sampling_rate,json=originalSamplingRate,proto3" json:"original_sampling_rate,omitempty" xml:"OriginalSamplingRate"`
 	// @gotags: xml:"BitsPerSample"
-	BitsPerSample int32 `protobuf:"varint,10,opt,name=bits_per_sample,json=bitsPerSample,proto3" json:"bits_per_sample,omitempty" xml:"BitsPerSample"`
+	BitsPerSample *int32 `protobuf:"varint,10,opt,name=bits_per_sample,json=bitsPerSample,proto3,oneof" json:"bits_per_sample,omitempty" xml:"BitsPerSample"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,11,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,11,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,12,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,12,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,13,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,14,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,15,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,15,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -457,15 +488,15 @@ func (x *AudioDeliveryFile) GetOriginalBitRate() *BitRate {
 }
 
 func (x *AudioDeliveryFile) GetNumberOfChannels() string {
-	if x != nil {
-		return x.NumberOfChannels
+	if x != nil && x.NumberOfChannels != nil {
+		return *x.NumberOfChannels
 	}
 	return ""
 }
 
 func (x *AudioDeliveryFile) GetNumberOfAudioObjects() int32 {
-	if x != nil {
-		return x.NumberOfAudioObjects
+	if x != nil && x.NumberOfAudioObjects != nil {
+		return *x.NumberOfAudioObjects
 	}
 	return 0
 }
@@ -485,22 +516,22 @@ func (x *AudioDeliveryFile) GetOriginalSamplingRate() *SamplingRate {
 }
 
 func (x *AudioDeliveryFile) GetBitsPerSample() int32 {
-	if x != nil {
-		return x.BitsPerSample
+	if x != nil && x.BitsPerSample != nil {
+		return *x.BitsPerSample
 	}
 	return 0
 }
 
 func (x *AudioDeliveryFile) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *AudioDeliveryFile) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
@@ -520,8 +551,8 @@ func (x *AudioDeliveryFile) GetFingerprint() []*Fingerprint {
 }
 
 func (x *AudioDeliveryFile) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -534,10 +565,10 @@ type AvRating struct {
 	Agency *RatingAgency `protobuf:"bytes,2,opt,name=agency,proto3" json:"agency,omitempty" xml:"Agency"`
 	// @gotags: xml:"Reason"
 	Reason *RatingReason `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty" xml:"Reason"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -594,15 +625,15 @@ func (x *AvRating) GetReason() *RatingReason {
 }
 
 func (x *AvRating) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *AvRating) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -739,21 +770,21 @@ type Chapter struct {
 	// @gotags: xml:"GroupingTitle"
 	GroupingTitle []*DisplayTitle `protobuf:"bytes,6,rep,name=grouping_title,json=groupingTitle,proto3" json:"grouping_title,omitempty" xml:"GroupingTitle"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"Contributor"
 	Contributor []*Contributor `protobuf:"bytes,8,rep,name=contributor,proto3" json:"contributor,omitempty" xml:"Contributor"`
 	// @gotags: xml:"Character"
 	Character []*Character `protobuf:"bytes,9,rep,name=character,proto3" json:"character,omitempty" xml:"Character"`
 	// @gotags: xml:"RepresentativeImageReference"
-	RepresentativeImageReference string `protobuf:"bytes,10,opt,name=representative_image_reference,json=representativeImageReference,proto3" json:"representative_image_reference,omitempty" xml:"RepresentativeImageReference"`
+	RepresentativeImageReference *string `protobuf:"bytes,10,opt,name=representative_image_reference,json=representativeImageReference,proto3,oneof" json:"representative_image_reference,omitempty" xml:"RepresentativeImageReference"`
 	// @gotags: xml:"StartTime"
-	StartTime string `protobuf:"bytes,11,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" xml:"StartTime"`
+	StartTime *string `protobuf:"bytes,11,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty" xml:"StartTime"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,12,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,12,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"EndTime"
-	EndTime string `protobuf:"bytes,13,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" xml:"EndTime"`
+	EndTime *string `protobuf:"bytes,13,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty" xml:"EndTime"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -831,8 +862,8 @@ func (x *Chapter) GetGroupingTitle() []*DisplayTitle {
 }
 
 func (x *Chapter) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -852,36 +883,36 @@ func (x *Chapter) GetCharacter() []*Character {
 }
 
 func (x *Chapter) GetRepresentativeImageReference() string {
-	if x != nil {
-		return x.RepresentativeImageReference
+	if x != nil && x.RepresentativeImageReference != nil {
+		return *x.RepresentativeImageReference
 	}
 	return ""
 }
 
 func (x *Chapter) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
 	return ""
 }
 
 func (x *Chapter) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *Chapter) GetEndTime() string {
-	if x != nil {
-		return x.EndTime
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
 	return ""
 }
 
 func (x *Chapter) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -891,7 +922,7 @@ type ChapterList struct {
 	// @gotags: xml:"Chapter"
 	Chapter []*Chapter `protobuf:"bytes,1,rep,name=chapter,proto3" json:"chapter,omitempty" xml:"Chapter"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -934,8 +965,8 @@ func (x *ChapterList) GetChapter() []*Chapter {
 }
 
 func (x *ChapterList) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -947,7 +978,7 @@ type Character struct {
 	// @gotags: xml:"Performer"
 	Performer *Contributor `protobuf:"bytes,2,opt,name=performer,proto3" json:"performer,omitempty" xml:"Performer"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -997,8 +1028,8 @@ func (x *Character) GetPerformer() *Contributor {
 }
 
 func (x *Character) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -1008,11 +1039,11 @@ type ClipDetails struct {
 	// @gotags: xml:"ClipType"
 	ClipType *ClipType `protobuf:"bytes,1,opt,name=clip_type,json=clipType,proto3" json:"clip_type,omitempty" xml:"ClipType"`
 	// @gotags: xml:"TopLeftCorner"
-	TopLeftCorner string `protobuf:"bytes,2,opt,name=top_left_corner,json=topLeftCorner,proto3" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
+	TopLeftCorner *string `protobuf:"bytes,2,opt,name=top_left_corner,json=topLeftCorner,proto3,oneof" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
 	// @gotags: xml:"BottomRightCorner"
-	BottomRightCorner string `protobuf:"bytes,3,opt,name=bottom_right_corner,json=bottomRightCorner,proto3" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	BottomRightCorner *string `protobuf:"bytes,3,opt,name=bottom_right_corner,json=bottomRightCorner,proto3,oneof" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1055,15 +1086,15 @@ func (x *ClipDetails) GetClipType() *ClipType {
 }
 
 func (x *ClipDetails) GetTopLeftCorner() string {
-	if x != nil {
-		return x.TopLeftCorner
+	if x != nil && x.TopLeftCorner != nil {
+		return *x.TopLeftCorner
 	}
 	return ""
 }
 
 func (x *ClipDetails) GetBottomRightCorner() string {
-	if x != nil {
-		return x.BottomRightCorner
+	if x != nil && x.BottomRightCorner != nil {
+		return *x.BottomRightCorner
 	}
 	return ""
 }
@@ -1203,12 +1234,12 @@ func (x *ClipRelease) GetRelatedRelease() []*RelatedRelease {
 
 type CommercialModelType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CommercialModelTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CommercialModelTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1251,15 +1282,15 @@ func (x *CommercialModelType) GetValue() string {
 }
 
 func (x *CommercialModelType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CommercialModelType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -1268,14 +1299,14 @@ type ConditionForRightsClaimPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Value"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
-	// @gotags: xml:"Unit"
-	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit"`
-	// @gotags: xml:"ReferenceCreation"
-	ReferenceCreation string `protobuf:"bytes,3,opt,name=reference_creation,json=referenceCreation,proto3" json:"reference_creation,omitempty" xml:"ReferenceCreation"`
-	// @gotags: xml:"RelationalRelator"
-	RelationalRelator string `protobuf:"bytes,4,opt,name=relational_relator,json=relationalRelator,proto3" json:"relational_relator,omitempty" xml:"RelationalRelator"`
-	// @gotags: xml:"MeasurementType"
-	MeasurementType string `protobuf:"bytes,5,opt,name=measurement_type,json=measurementType,proto3" json:"measurement_type,omitempty" xml:"MeasurementType"`
+	// @gotags: xml:"Unit" avs:"UnitOfConditionValue"
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit" avs:"UnitOfConditionValue"`
+	// @gotags: xml:"ReferenceCreation" avs:"ReferenceCreation"
+	ReferenceCreation *string `protobuf:"bytes,3,opt,name=reference_creation,json=referenceCreation,proto3,oneof" json:"reference_creation,omitempty" xml:"ReferenceCreation" avs:"ReferenceCreation"`
+	// @gotags: xml:"RelationalRelator" avs:"RelationalRelator"
+	RelationalRelator string `protobuf:"bytes,4,opt,name=relational_relator,json=relationalRelator,proto3" json:"relational_relator,omitempty" xml:"RelationalRelator" avs:"RelationalRelator"`
+	// @gotags: xml:"MeasurementType" avs:"MeasurementType"
+	MeasurementType *string `protobuf:"bytes,5,opt,name=measurement_type,json=measurementType,proto3,oneof" json:"measurement_type,omitempty" xml:"MeasurementType" avs:"MeasurementType"`
 	// @gotags: xml:"Segment"
 	Segment []*Segment `protobuf:"bytes,6,rep,name=segment,proto3" json:"segment,omitempty" xml:"Segment"`
 	// @gotags: xml:"ServiceException"
@@ -1329,8 +1360,8 @@ func (x *ConditionForRightsClaimPolicy) GetUnit() string {
 }
 
 func (x *ConditionForRightsClaimPolicy) GetReferenceCreation() string {
-	if x != nil {
-		return x.ReferenceCreation
+	if x != nil && x.ReferenceCreation != nil {
+		return *x.ReferenceCreation
 	}
 	return ""
 }
@@ -1343,8 +1374,8 @@ func (x *ConditionForRightsClaimPolicy) GetRelationalRelator() string {
 }
 
 func (x *ConditionForRightsClaimPolicy) GetMeasurementType() string {
-	if x != nil {
-		return x.MeasurementType
+	if x != nil && x.MeasurementType != nil {
+		return *x.MeasurementType
 	}
 	return ""
 }
@@ -1429,6 +1460,10 @@ type Cue struct {
 	CueVisualPerceptionType *CueVisualPerceptionType `protobuf:"bytes,4,opt,name=cue_visual_perception_type,json=cueVisualPerceptionType,proto3" json:"cue_visual_perception_type,omitempty" xml:"CueVisualPerceptionType"`
 	// @gotags: xml:"CueOrigin"
 	CueOrigin *CueOrigin `protobuf:"bytes,5,opt,name=cue_origin,json=cueOrigin,proto3" json:"cue_origin,omitempty" xml:"CueOrigin"`
+	// @gotags: xml:"ResourceId"
+	ResourceId *ResourceId `protobuf:"bytes,18,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
+	// @gotags: xml:"WorkId"
+	WorkId *MusicalWorkId `protobuf:"bytes,19,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty" xml:"WorkId"`
 	// @gotags: xml:"DisplayTitleText"
 	DisplayTitleText []*DisplayTitleText `protobuf:"bytes,6,rep,name=display_title_text,json=displayTitleText,proto3" json:"display_title_text,omitempty" xml:"DisplayTitleText"`
 	// @gotags: xml:"DisplayTitle"
@@ -1440,23 +1475,19 @@ type Cue struct {
 	// @gotags: xml:"Contributor"
 	Contributor []*Contributor `protobuf:"bytes,10,rep,name=contributor,proto3" json:"contributor,omitempty" xml:"Contributor"`
 	// @gotags: xml:"IsDance"
-	IsDance bool `protobuf:"varint,11,opt,name=is_dance,json=isDance,proto3" json:"is_dance,omitempty" xml:"IsDance"`
+	IsDance *bool `protobuf:"varint,11,opt,name=is_dance,json=isDance,proto3,oneof" json:"is_dance,omitempty" xml:"IsDance"`
 	// @gotags: xml:"HasMusicalContent"
-	HasMusicalContent bool `protobuf:"varint,12,opt,name=has_musical_content,json=hasMusicalContent,proto3" json:"has_musical_content,omitempty" xml:"HasMusicalContent"`
+	HasMusicalContent *bool `protobuf:"varint,12,opt,name=has_musical_content,json=hasMusicalContent,proto3,oneof" json:"has_musical_content,omitempty" xml:"HasMusicalContent"`
 	// @gotags: xml:"PLine"
 	PLine []*PLine `protobuf:"bytes,13,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
 	// @gotags: xml:"CLine"
 	CLine []*CLine `protobuf:"bytes,14,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty" xml:"CLine"`
 	// @gotags: xml:"StartTime"
-	StartTime string `protobuf:"bytes,15,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" xml:"StartTime"`
+	StartTime *string `protobuf:"bytes,15,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty" xml:"StartTime"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,16,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,16,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"EndTime"
-	EndTime string `protobuf:"bytes,17,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" xml:"EndTime"`
-	// @gotags: xml:"ResourceId"
-	ResourceId *ResourceId `protobuf:"bytes,18,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
-	// @gotags: xml:"WorkId"
-	WorkId        *MusicalWorkId `protobuf:"bytes,19,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty" xml:"WorkId"`
+	EndTime       *string `protobuf:"bytes,17,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty" xml:"EndTime"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1526,6 +1557,20 @@ func (x *Cue) GetCueOrigin() *CueOrigin {
 	return nil
 }
 
+func (x *Cue) GetResourceId() *ResourceId {
+	if x != nil {
+		return x.ResourceId
+	}
+	return nil
+}
+
+func (x *Cue) GetWorkId() *MusicalWorkId {
+	if x != nil {
+		return x.WorkId
+	}
+	return nil
+}
+
 func (x *Cue) GetDisplayTitleText() []*DisplayTitleText {
 	if x != nil {
 		return x.DisplayTitleText
@@ -1562,15 +1607,15 @@ func (x *Cue) GetContributor() []*Contributor {
 }
 
 func (x *Cue) GetIsDance() bool {
-	if x != nil {
-		return x.IsDance
+	if x != nil && x.IsDance != nil {
+		return *x.IsDance
 	}
 	return false
 }
 
 func (x *Cue) GetHasMusicalContent() bool {
-	if x != nil {
-		return x.HasMusicalContent
+	if x != nil && x.HasMusicalContent != nil {
+		return *x.HasMusicalContent
 	}
 	return false
 }
@@ -1590,40 +1635,26 @@ func (x *Cue) GetCLine() []*CLine {
 }
 
 func (x *Cue) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
 	}
 	return ""
 }
 
 func (x *Cue) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *Cue) GetEndTime() string {
-	if x != nil {
-		return x.EndTime
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
 	}
 	return ""
 }
 
-func (x *Cue) GetResourceId() *ResourceId {
-	if x != nil {
-		return x.ResourceId
-	}
-	return nil
-}
-
-func (x *Cue) GetWorkId() *MusicalWorkId {
-	if x != nil {
-		return x.WorkId
-	}
-	return nil
-}
-
 type CueSheet struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"CueSheetId"
@@ -1746,7 +1777,7 @@ type Deal struct {
 	// @gotags: xml:"DealReference"
 	DealReference []string `protobuf:"bytes,1,rep,name=deal_reference,json=dealReference,proto3" json:"deal_reference,omitempty" xml:"DealReference"`
 	// @gotags: xml:"IsCommunicatedOutOfBand"
-	IsCommunicatedOutOfBand bool `protobuf:"varint,2,opt,name=is_communicated_out_of_band,json=isCommunicatedOutOfBand,proto3" json:"is_communicated_out_of_band,omitempty" xml:"IsCommunicatedOutOfBand"`
+	IsCommunicatedOutOfBand *bool `protobuf:"varint,2,opt,name=is_communicated_out_of_band,json=isCommunicatedOutOfBand,proto3,oneof" json:"is_communicated_out_of_band,omitempty" xml:"IsCommunicatedOutOfBand"`
 	// @gotags: xml:"DealTerms"
 	DealTerms *DealTerms `protobuf:"bytes,3,opt,name=deal_terms,json=dealTerms,proto3" json:"deal_terms,omitempty" xml:"DealTerms"`
 	// @gotags: xml:"DealTechnicalResourceDetailsReferenceList"
@@ -1795,8 +1826,8 @@ func (x *Deal) GetDealReference() []string {
 }
 
 func (x *Deal) GetIsCommunicatedOutOfBand() bool {
-	if x != nil {
-		return x.IsCommunicatedOutOfBand
+	if x != nil && x.IsCommunicatedOutOfBand != nil {
+		return *x.IsCommunicatedOutOfBand
 	}
 	return false
 }
@@ -1977,6 +2008,10 @@ func (x *DealTechnicalResourceDetailsReferenceList) GetDealTechnicalResourceDeta
 
 type DealTerms struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"TerritoryCode"
+	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,14,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
+	// @gotags: xml:"ExcludedTerritoryCode"
+	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,15,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
 	// @gotags: xml:"ValidityPeriod"
 	ValidityPeriod []*PeriodWithStartDate `protobuf:"bytes,1,rep,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
 	// @gotags: xml:"CommercialModelType"
@@ -1990,33 +2025,29 @@ type DealTerms struct {
 	// @gotags: xml:"TechnicalInstantiation"
 	TechnicalInstantiation *DealTermsTechnicalInstantiation `protobuf:"bytes,6,opt,name=technical_instantiation,json=technicalInstantiation,proto3" json:"technical_instantiation,omitempty" xml:"TechnicalInstantiation"`
 	// @gotags: xml:"NumberOfUsages"
-	NumberOfUsages int32 `protobuf:"varint,7,opt,name=number_of_usages,json=numberOfUsages,proto3" json:"number_of_usages,omitempty" xml:"NumberOfUsages"`
+	NumberOfUsages *int32 `protobuf:"varint,7,opt,name=number_of_usages,json=numberOfUsages,proto3,oneof" json:"number_of_usages,omitempty" xml:"NumberOfUsages"`
+	// @gotags: xml:"DistributionChannel"
+	DistributionChannel []*DSP `protobuf:"bytes,16,rep,name=distribution_channel,json=distributionChannel,proto3" json:"distribution_channel,omitempty" xml:"DistributionChannel"`
+	// @gotags: xml:"ExcludedDistributionChannel"
+	ExcludedDistributionChannel []*DSP `protobuf:"bytes,17,rep,name=excluded_distribution_channel,json=excludedDistributionChannel,proto3" json:"excluded_distribution_channel,omitempty" xml:"ExcludedDistributionChannel"`
 	// @gotags: xml:"RightsClaimPolicy"
 	RightsClaimPolicy []*RightsClaimPolicy `protobuf:"bytes,8,rep,name=rights_claim_policy,json=rightsClaimPolicy,proto3" json:"rights_claim_policy,omitempty" xml:"RightsClaimPolicy"`
 	// @gotags: xml:"PriceInformation"
 	PriceInformation []*PriceInformation `protobuf:"bytes,9,rep,name=price_information,json=priceInformation,proto3" json:"price_information,omitempty" xml:"PriceInformation"`
+	// @gotags: xml:"IsPromotional"
+	IsPromotional bool `protobuf:"varint,18,opt,name=is_promotional,json=isPromotional,proto3" json:"is_promotional,omitempty" xml:"IsPromotional"`
+	// @gotags: xml:"PromotionalCode"
+	PromotionalCode *PromotionalCode `protobuf:"bytes,19,opt,name=promotional_code,json=promotionalCode,proto3" json:"promotional_code,omitempty" xml:"PromotionalCode"`
 	// @gotags: xml:"IsPreOrderDeal"
-	IsPreOrderDeal bool `protobuf:"varint,10,opt,name=is_pre_order_deal,json=isPreOrderDeal,proto3" json:"is_pre_order_deal,omitempty" xml:"IsPreOrderDeal"`
+	IsPreOrderDeal *bool `protobuf:"varint,10,opt,name=is_pre_order_deal,json=isPreOrderDeal,proto3,oneof" json:"is_pre_order_deal,omitempty" xml:"IsPreOrderDeal"`
 	// @gotags: xml:"InstantGratificationResourceList"
 	InstantGratificationResourceList *DealResourceReferenceList `protobuf:"bytes,11,opt,name=instant_gratification_resource_list,json=instantGratificationResourceList,proto3" json:"instant_gratification_resource_list,omitempty" xml:"InstantGratificationResourceList"`
 	// @gotags: xml:"PhysicalReturns"
 	PhysicalReturns *PhysicalReturns `protobuf:"bytes,12,opt,name=physical_returns,json=physicalReturns,proto3" json:"physical_returns,omitempty" xml:"PhysicalReturns"`
 	// @gotags: xml:"NumberOfProductsPerCarton"
-	NumberOfProductsPerCarton int32 `protobuf:"varint,13,opt,name=number_of_products_per_carton,json=numberOfProductsPerCarton,proto3" json:"number_of_products_per_carton,omitempty" xml:"NumberOfProductsPerCarton"`
-	// @gotags: xml:"TerritoryCode"
-	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,14,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
-	// @gotags: xml:"ExcludedTerritoryCode"
-	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,15,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	// @gotags: xml:"DistributionChannel"
-	DistributionChannel []*DSP `protobuf:"bytes,16,rep,name=distribution_channel,json=distributionChannel,proto3" json:"distribution_channel,omitempty" xml:"DistributionChannel"`
-	// @gotags: xml:"ExcludedDistributionChannel"
-	ExcludedDistributionChannel []*DSP `protobuf:"bytes,17,rep,name=excluded_distribution_channel,json=excludedDistributionChannel,proto3" json:"excluded_distribution_channel,omitempty" xml:"ExcludedDistributionChannel"`
-	// @gotags: xml:"IsPromotional"
-	IsPromotional bool `protobuf:"varint,18,opt,name=is_promotional,json=isPromotional,proto3" json:"is_promotional,omitempty" xml:"IsPromotional"`
-	// @gotags: xml:"PromotionalCode"
-	PromotionalCode *PromotionalCode `protobuf:"bytes,19,opt,name=promotional_code,json=promotionalCode,proto3" json:"promotional_code,omitempty" xml:"PromotionalCode"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	NumberOfProductsPerCarton *int32 `protobuf:"varint,13,opt,name=number_of_products_per_carton,json=numberOfProductsPerCarton,proto3,oneof" json:"number_of_products_per_carton,omitempty" xml:"NumberOfProductsPerCarton"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *DealTerms) Reset() {
@@ -2049,6 +2080,20 @@ func (*DealTerms) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{22}
 }
 
+func (x *DealTerms) GetTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.TerritoryCode
+	}
+	return nil
+}
+
+func (x *DealTerms) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
+	if x != nil {
+		return x.ExcludedTerritoryCode
+	}
+	return nil
+}
+
 func (x *DealTerms) GetValidityPeriod() []*PeriodWithStartDate {
 	if x != nil {
 		return x.ValidityPeriod
@@ -2092,102 +2137,88 @@ func (x *DealTerms) GetTechnicalInstantiation() *DealTermsTechnicalInstantiation
 }
 
 func (x *DealTerms) GetNumberOfUsages() int32 {
-	if x != nil {
-		return x.NumberOfUsages
+	if x != nil && x.NumberOfUsages != nil {
+		return *x.NumberOfUsages
 	}
 	return 0
 }
 
-func (x *DealTerms) GetRightsClaimPolicy() []*RightsClaimPolicy {
+func (x *DealTerms) GetDistributionChannel() []*DSP {
 	if x != nil {
-		return x.RightsClaimPolicy
+		return x.DistributionChannel
 	}
 	return nil
 }
 
-func (x *DealTerms) GetPriceInformation() []*PriceInformation {
+func (x *DealTerms) GetExcludedDistributionChannel() []*DSP {
 	if x != nil {
-		return x.PriceInformation
+		return x.ExcludedDistributionChannel
 	}
 	return nil
 }
 
-func (x *DealTerms) GetIsPreOrderDeal() bool {
-	if x != nil {
-		return x.IsPreOrderDeal
-	}
-	return false
-}
-
-func (x *DealTerms) GetInstantGratificationResourceList() *DealResourceReferenceList {
+func (x *DealTerms) GetRightsClaimPolicy() []*RightsClaimPolicy {
 	if x != nil {
-		return x.InstantGratificationResourceList
+		return x.RightsClaimPolicy
 	}
 	return nil
 }
 
-func (x *DealTerms) GetPhysicalReturns() *PhysicalReturns {
+func (x *DealTerms) GetPriceInformation() []*PriceInformation {
 	if x != nil {
-		return x.PhysicalReturns
+		return x.PriceInformation
 	}
 	return nil
 }
 
-func (x *DealTerms) GetNumberOfProductsPerCarton() int32 {
+func (x *DealTerms) GetIsPromotional() bool {
 	if x != nil {
-		return x.NumberOfProductsPerCarton
+		return x.IsPromotional
 	}
-	return 0
+	return false
 }
 
-func (x *DealTerms) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *DealTerms) GetPromotionalCode() *PromotionalCode {
 	if x != nil {
-		return x.TerritoryCode
+		return x.PromotionalCode
 	}
 	return nil
 }
 
-func (x *DealTerms) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *DealTerms) GetIsPreOrderDeal() bool {
+	if x != nil && x.IsPreOrderDeal != nil {
+		return *x.IsPreOrderDeal
 	}
-	return nil
+	return false
 }
 
-func (x *DealTerms) GetDistributionChannel() []*DSP {
+func (x *DealTerms) GetInstantGratificationResourceList() *DealResourceReferenceList {
 	if x != nil {
-		return x.DistributionChannel
+		return x.InstantGratificationResourceList
 	}
 	return nil
 }
 
-func (x *DealTerms) GetExcludedDistributionChannel() []*DSP {
+func (x *DealTerms) GetPhysicalReturns() *PhysicalReturns {
 	if x != nil {
-		return x.ExcludedDistributionChannel
+		return x.PhysicalReturns
 	}
 	return nil
 }
 
-func (x *DealTerms) GetIsPromotional() bool {
-	if x != nil {
-		return x.IsPromotional
-	}
-	return false
-}
-
-func (x *DealTerms) GetPromotionalCode() *PromotionalCode {
-	if x != nil {
-		return x.PromotionalCode
+func (x *DealTerms) GetNumberOfProductsPerCarton() int32 {
+	if x != nil && x.NumberOfProductsPerCarton != nil {
+		return *x.NumberOfProductsPerCarton
 	}
-	return nil
+	return 0
 }
 
 type DealTermsTechnicalInstantiation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VideoDefinitionType"
 	VideoDefinitionType *VideoDefinitionType `protobuf:"bytes,1,opt,name=video_definition_type,json=videoDefinitionType,proto3" json:"video_definition_type,omitempty" xml:"VideoDefinitionType"`
-	// @gotags: xml:"CodingType"
-	CodingType string `protobuf:"bytes,2,opt,name=coding_type,json=codingType,proto3" json:"coding_type,omitempty" xml:"CodingType"`
+	// @gotags: xml:"CodingType" avs:"CodingType"
+	CodingType *string `protobuf:"bytes,2,opt,name=coding_type,json=codingType,proto3,oneof" json:"coding_type,omitempty" xml:"CodingType" avs:"CodingType"`
 	// @gotags: xml:"BitRate"
 	BitRate       *BitRate `protobuf:"bytes,3,opt,name=bit_rate,json=bitRate,proto3" json:"bit_rate,omitempty" xml:"BitRate"`
 	unknownFields protoimpl.UnknownFields
@@ -2232,8 +2263,8 @@ func (x *DealTermsTechnicalInstantiation) GetVideoDefinitionType() *VideoDefinit
 }
 
 func (x *DealTermsTechnicalInstantiation) GetCodingType() string {
-	if x != nil {
-		return x.CodingType
+	if x != nil && x.CodingType != nil {
+		return *x.CodingType
 	}
 	return ""
 }
@@ -2249,10 +2280,10 @@ type Deity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2295,15 +2326,15 @@ func (x *Deity) GetValue() string {
 }
 
 func (x *Deity) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *Deity) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -2376,11 +2407,11 @@ type DescriptionWithTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2423,44 +2454,44 @@ func (x *DescriptionWithTerritory) GetValue() string {
 }
 
 func (x *DescriptionWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DescriptionWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DescriptionWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type DetailedResourceContributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"PartyId"
+	PartyId []*DetailedPartyId `protobuf:"bytes,6,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyName `protobuf:"bytes,7,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"Role"
 	Role []*ContributorRole `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" xml:"Role"`
 	// @gotags: xml:"InstrumentType"
 	InstrumentType []*InstrumentType `protobuf:"bytes,2,rep,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty" xml:"InstrumentType"`
 	// @gotags: xml:"HasMadeFeaturedContribution"
-	HasMadeFeaturedContribution bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
+	HasMadeFeaturedContribution *bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
 	// @gotags: xml:"HasMadeContractedContribution"
-	HasMadeContractedContribution bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
+	HasMadeContractedContribution *bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,5,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
-	// @gotags: xml:"PartyId"
-	PartyId []*DetailedPartyId `protobuf:"bytes,6,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName []*PartyName `protobuf:"bytes,7,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2495,6 +2526,20 @@ func (*DetailedResourceContributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{27}
 }
 
+func (x *DetailedResourceContributor) GetPartyId() []*DetailedPartyId {
+	if x != nil {
+		return x.PartyId
+	}
+	return nil
+}
+
+func (x *DetailedResourceContributor) GetPartyName() []*PartyName {
+	if x != nil {
+		return x.PartyName
+	}
+	return nil
+}
+
 func (x *DetailedResourceContributor) GetRole() []*ContributorRole {
 	if x != nil {
 		return x.Role
@@ -2510,15 +2555,15 @@ func (x *DetailedResourceContributor) GetInstrumentType() []*InstrumentType {
 }
 
 func (x *DetailedResourceContributor) GetHasMadeFeaturedContribution() bool {
-	if x != nil {
-		return x.HasMadeFeaturedContribution
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
 	}
 	return false
 }
 
 func (x *DetailedResourceContributor) GetHasMadeContractedContribution() bool {
-	if x != nil {
-		return x.HasMadeContractedContribution
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
 	}
 	return false
 }
@@ -2530,37 +2575,23 @@ func (x *DetailedResourceContributor) GetDisplayCredits() []*DisplayCredits {
 	return nil
 }
 
-func (x *DetailedResourceContributor) GetPartyId() []*DetailedPartyId {
-	if x != nil {
-		return x.PartyId
-	}
-	return nil
-}
-
-func (x *DetailedResourceContributor) GetPartyName() []*PartyName {
-	if x != nil {
-		return x.PartyName
-	}
-	return nil
-}
-
 func (x *DetailedResourceContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 type DiscoverableUseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UseTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UseTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"IsDiscoverable,attr"
-	IsDiscoverable bool `protobuf:"varint,3,opt,name=is_discoverable,json=isDiscoverable,proto3" json:"is_discoverable,omitempty" xml:"IsDiscoverable,attr"`
+	IsDiscoverable *bool `protobuf:"varint,3,opt,name=is_discoverable,json=isDiscoverable,proto3,oneof" json:"is_discoverable,omitempty" xml:"IsDiscoverable,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2603,43 +2634,43 @@ func (x *DiscoverableUseType) GetValue() string {
 }
 
 func (x *DiscoverableUseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DiscoverableUseType) GetIsDiscoverable() bool {
-	if x != nil {
-		return x.IsDiscoverable
+	if x != nil && x.IsDiscoverable != nil {
+		return *x.IsDiscoverable
 	}
 	return false
 }
 
 func (x *DiscoverableUseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type DisplayArtist struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"ArtisticRole"
-	ArtisticRole []*ContributorRole `protobuf:"bytes,1,rep,name=artistic_role,json=artisticRole,proto3" json:"artistic_role,omitempty" xml:"ArtisticRole"`
-	// @gotags: xml:"TitleDisplayInformation"
-	TitleDisplayInformation []*TitleDisplayInformation `protobuf:"bytes,2,rep,name=title_display_information,json=titleDisplayInformation,proto3" json:"title_display_information,omitempty" xml:"TitleDisplayInformation"`
-	// @gotags: xml:"DisplayCredits"
-	DisplayCredits []*DisplayCredits `protobuf:"bytes,3,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
 	// @gotags: xml:"SpecialDisplayArtist"
 	SpecialDisplayArtist *SpecialContributorType `protobuf:"bytes,4,opt,name=special_display_artist,json=specialDisplayArtist,proto3" json:"special_display_artist,omitempty" xml:"SpecialDisplayArtist"`
 	// @gotags: xml:"ArtistPartyReference"
 	ArtistPartyReference string `protobuf:"bytes,5,opt,name=artist_party_reference,json=artistPartyReference,proto3" json:"artist_party_reference,omitempty" xml:"ArtistPartyReference"`
 	// @gotags: xml:"DisplayArtistRole"
 	DisplayArtistRole *DisplayArtistRole `protobuf:"bytes,6,opt,name=display_artist_role,json=displayArtistRole,proto3" json:"display_artist_role,omitempty" xml:"DisplayArtistRole"`
-	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
-	unknownFields  protoimpl.UnknownFields
+	// @gotags: xml:"ArtisticRole"
+	ArtisticRole []*ContributorRole `protobuf:"bytes,1,rep,name=artistic_role,json=artisticRole,proto3" json:"artistic_role,omitempty" xml:"ArtisticRole"`
+	// @gotags: xml:"TitleDisplayInformation"
+	TitleDisplayInformation []*TitleDisplayInformation `protobuf:"bytes,2,rep,name=title_display_information,json=titleDisplayInformation,proto3" json:"title_display_information,omitempty" xml:"TitleDisplayInformation"`
+	// @gotags: xml:"DisplayCredits"
+	DisplayCredits []*DisplayCredits `protobuf:"bytes,3,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
+	// @gotags: xml:"SequenceNumber,attr"
+	SequenceNumber *int32 `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
@@ -2673,51 +2704,51 @@ func (*DisplayArtist) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DisplayArtist) GetArtisticRole() []*ContributorRole {
+func (x *DisplayArtist) GetSpecialDisplayArtist() *SpecialContributorType {
 	if x != nil {
-		return x.ArtisticRole
+		return x.SpecialDisplayArtist
 	}
 	return nil
 }
 
-func (x *DisplayArtist) GetTitleDisplayInformation() []*TitleDisplayInformation {
+func (x *DisplayArtist) GetArtistPartyReference() string {
 	if x != nil {
-		return x.TitleDisplayInformation
+		return x.ArtistPartyReference
 	}
-	return nil
+	return ""
 }
 
-func (x *DisplayArtist) GetDisplayCredits() []*DisplayCredits {
+func (x *DisplayArtist) GetDisplayArtistRole() *DisplayArtistRole {
 	if x != nil {
-		return x.DisplayCredits
+		return x.DisplayArtistRole
 	}
 	return nil
 }
 
-func (x *DisplayArtist) GetSpecialDisplayArtist() *SpecialContributorType {
+func (x *DisplayArtist) GetArtisticRole() []*ContributorRole {
 	if x != nil {
-		return x.SpecialDisplayArtist
+		return x.ArtisticRole
 	}
 	return nil
 }
 
-func (x *DisplayArtist) GetArtistPartyReference() string {
+func (x *DisplayArtist) GetTitleDisplayInformation() []*TitleDisplayInformation {
 	if x != nil {
-		return x.ArtistPartyReference
+		return x.TitleDisplayInformation
 	}
-	return ""
+	return nil
 }
 
-func (x *DisplayArtist) GetDisplayArtistRole() *DisplayArtistRole {
+func (x *DisplayArtist) GetDisplayCredits() []*DisplayCredits {
 	if x != nil {
-		return x.DisplayArtistRole
+		return x.DisplayCredits
 	}
 	return nil
 }
 
 func (x *DisplayArtist) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -2727,13 +2758,13 @@ type DisplayTitleText struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,5,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,5,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -2776,29 +2807,29 @@ func (x *DisplayTitleText) GetValue() string {
 }
 
 func (x *DisplayTitleText) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayTitleText) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayTitleText) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *DisplayTitleText) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
@@ -2810,9 +2841,9 @@ type DistributionChannelPage struct {
 	// @gotags: xml:"PageName"
 	PageName *Name `protobuf:"bytes,2,opt,name=page_name,json=pageName,proto3" json:"page_name,omitempty" xml:"PageName"`
 	// @gotags: xml:"URL"
-	URL string `protobuf:"bytes,3,opt,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
+	URL *string `protobuf:"bytes,3,opt,name=u_r_l,json=uRL,proto3,oneof" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"UserName"
-	UserName      string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty" xml:"UserName"`
+	UserName      *string `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3,oneof" json:"user_name,omitempty" xml:"UserName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2862,39 +2893,39 @@ func (x *DistributionChannelPage) GetPageName() *Name {
 }
 
 func (x *DistributionChannelPage) GetURL() string {
-	if x != nil {
-		return x.URL
+	if x != nil && x.URL != nil {
+		return *x.URL
 	}
 	return ""
 }
 
 func (x *DistributionChannelPage) GetUserName() string {
-	if x != nil {
-		return x.UserName
+	if x != nil && x.UserName != nil {
+		return *x.UserName
 	}
 	return ""
 }
 
 type EditionContributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"ContributorPartyReference"
+	ContributorPartyReference string `protobuf:"bytes,7,opt,name=contributor_party_reference,json=contributorPartyReference,proto3" json:"contributor_party_reference,omitempty" xml:"ContributorPartyReference"`
+	// @gotags: xml:"SpecialContributor"
+	SpecialContributor *SpecialContributorType `protobuf:"bytes,8,opt,name=special_contributor,json=specialContributor,proto3" json:"special_contributor,omitempty" xml:"SpecialContributor"`
 	// @gotags: xml:"Role"
 	Role []*ContributorRole `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" xml:"Role"`
-	// @gotags: xml:"AiContribution"
-	AiContribution string `protobuf:"bytes,2,opt,name=ai_contribution,json=aiContribution,proto3" json:"ai_contribution,omitempty" xml:"AiContribution"`
+	// @gotags: xml:"AiContribution" avs:"ContainsAI"
+	AiContribution *string `protobuf:"bytes,2,opt,name=ai_contribution,json=aiContribution,proto3,oneof" json:"ai_contribution,omitempty" xml:"AiContribution" avs:"ContainsAI"`
 	// @gotags: xml:"HasMadeFeaturedContribution"
-	HasMadeFeaturedContribution bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
+	HasMadeFeaturedContribution *bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
 	// @gotags: xml:"HasMadeContractedContribution"
-	HasMadeContractedContribution bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
+	HasMadeContractedContribution *bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
 	// @gotags: xml:"IsCredited"
 	IsCredited *IsCredited `protobuf:"bytes,5,opt,name=is_credited,json=isCredited,proto3" json:"is_credited,omitempty" xml:"IsCredited"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,6,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
-	// @gotags: xml:"ContributorPartyReference"
-	ContributorPartyReference string `protobuf:"bytes,7,opt,name=contributor_party_reference,json=contributorPartyReference,proto3" json:"contributor_party_reference,omitempty" xml:"ContributorPartyReference"`
-	// @gotags: xml:"SpecialContributor"
-	SpecialContributor *SpecialContributorType `protobuf:"bytes,8,opt,name=special_contributor,json=specialContributor,proto3" json:"special_contributor,omitempty" xml:"SpecialContributor"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,9,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,9,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2929,6 +2960,20 @@ func (*EditionContributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{32}
 }
 
+func (x *EditionContributor) GetContributorPartyReference() string {
+	if x != nil {
+		return x.ContributorPartyReference
+	}
+	return ""
+}
+
+func (x *EditionContributor) GetSpecialContributor() *SpecialContributorType {
+	if x != nil {
+		return x.SpecialContributor
+	}
+	return nil
+}
+
 func (x *EditionContributor) GetRole() []*ContributorRole {
 	if x != nil {
 		return x.Role
@@ -2937,22 +2982,22 @@ func (x *EditionContributor) GetRole() []*ContributorRole {
 }
 
 func (x *EditionContributor) GetAiContribution() string {
-	if x != nil {
-		return x.AiContribution
+	if x != nil && x.AiContribution != nil {
+		return *x.AiContribution
 	}
 	return ""
 }
 
 func (x *EditionContributor) GetHasMadeFeaturedContribution() bool {
-	if x != nil {
-		return x.HasMadeFeaturedContribution
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
 	}
 	return false
 }
 
 func (x *EditionContributor) GetHasMadeContractedContribution() bool {
-	if x != nil {
-		return x.HasMadeContractedContribution
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
 	}
 	return false
 }
@@ -2971,23 +3016,9 @@ func (x *EditionContributor) GetDisplayCredits() []*DisplayCredits {
 	return nil
 }
 
-func (x *EditionContributor) GetContributorPartyReference() string {
-	if x != nil {
-		return x.ContributorPartyReference
-	}
-	return ""
-}
-
-func (x *EditionContributor) GetSpecialContributor() *SpecialContributorType {
-	if x != nil {
-		return x.SpecialContributor
-	}
-	return nil
-}
-
 func (x *EditionContributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -2997,13 +3028,13 @@ type EventDateTimeWithoutFlags struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3046,29 +3077,29 @@ func (x *EventDateTimeWithoutFlags) GetValue() string {
 }
 
 func (x *EventDateTimeWithoutFlags) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateTimeWithoutFlags) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateTimeWithoutFlags) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateTimeWithoutFlags) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3078,13 +3109,13 @@ type EventDateWithCurrentTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3127,29 +3158,29 @@ func (x *EventDateWithCurrentTerritory) GetValue() string {
 }
 
 func (x *EventDateWithCurrentTerritory) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateWithCurrentTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateWithCurrentTerritory) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateWithCurrentTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3159,11 +3190,11 @@ type EventDateWithDefault struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3206,22 +3237,22 @@ func (x *EventDateWithDefault) GetValue() string {
 }
 
 func (x *EventDateWithDefault) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -3231,13 +3262,13 @@ type EventDateWithoutFlags struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -3280,29 +3311,29 @@ func (x *EventDateWithoutFlags) GetValue() string {
 }
 
 func (x *EventDateWithoutFlags) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateWithoutFlags) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateWithoutFlags) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateWithoutFlags) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -3314,11 +3345,11 @@ type ExternalResourceLink struct {
 	// @gotags: xml:"ValidityPeriod"
 	ValidityPeriod *PeriodWithoutFlags `protobuf:"bytes,2,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
 	// @gotags: xml:"ExternalLink"
-	ExternalLink string `protobuf:"bytes,3,opt,name=external_link,json=externalLink,proto3" json:"external_link,omitempty" xml:"ExternalLink"`
+	ExternalLink *string `protobuf:"bytes,3,opt,name=external_link,json=externalLink,proto3,oneof" json:"external_link,omitempty" xml:"ExternalLink"`
 	// @gotags: xml:"ExternallyLinkedResourceType"
 	ExternallyLinkedResourceType []*ExternallyLinkedResourceType `protobuf:"bytes,4,rep,name=externally_linked_resource_type,json=externallyLinkedResourceType,proto3" json:"externally_linked_resource_type,omitempty" xml:"ExternallyLinkedResourceType"`
 	// @gotags: xml:"FileFormat"
-	FileFormat    string `protobuf:"bytes,5,opt,name=file_format,json=fileFormat,proto3" json:"file_format,omitempty" xml:"FileFormat"`
+	FileFormat    *string `protobuf:"bytes,5,opt,name=file_format,json=fileFormat,proto3,oneof" json:"file_format,omitempty" xml:"FileFormat"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3368,8 +3399,8 @@ func (x *ExternalResourceLink) GetValidityPeriod() *PeriodWithoutFlags {
 }
 
 func (x *ExternalResourceLink) GetExternalLink() string {
-	if x != nil {
-		return x.ExternalLink
+	if x != nil && x.ExternalLink != nil {
+		return *x.ExternalLink
 	}
 	return ""
 }
@@ -3382,20 +3413,20 @@ func (x *ExternalResourceLink) GetExternallyLinkedResourceType() []*ExternallyLi
 }
 
 func (x *ExternalResourceLink) GetFileFormat() string {
-	if x != nil {
-		return x.FileFormat
+	if x != nil && x.FileFormat != nil {
+		return *x.FileFormat
 	}
 	return ""
 }
 
 type HdrVideoDynamicMetadataType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"HdrVideoDynamicMetadataType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"HdrVideoDynamicMetadataType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"SdrDerivationPermitted,attr"
-	SdrDerivationPermitted bool `protobuf:"varint,3,opt,name=sdr_derivation_permitted,json=sdrDerivationPermitted,proto3" json:"sdr_derivation_permitted,omitempty" xml:"SdrDerivationPermitted,attr"`
+	SdrDerivationPermitted *bool `protobuf:"varint,3,opt,name=sdr_derivation_permitted,json=sdrDerivationPermitted,proto3,oneof" json:"sdr_derivation_permitted,omitempty" xml:"SdrDerivationPermitted,attr"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -3438,15 +3469,15 @@ func (x *HdrVideoDynamicMetadataType) GetValue() string {
 }
 
 func (x *HdrVideoDynamicMetadataType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *HdrVideoDynamicMetadataType) GetSdrDerivationPermitted() bool {
-	if x != nil {
-		return x.SdrDerivationPermitted
+	if x != nil && x.SdrDerivationPermitted != nil {
+		return *x.SdrDerivationPermitted
 	}
 	return false
 }
@@ -3493,18 +3524,18 @@ type Image struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,19,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,20,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
-	// @gotags: xml:"ContainsAI"
-	ContainsAI string `protobuf:"bytes,21,opt,name=contains_a_i,json=containsAI,proto3" json:"contains_a_i,omitempty" xml:"ContainsAI"`
+	// @gotags: xml:"ContainsAI" avs:"ContainsAI"
+	ContainsAI *string `protobuf:"bytes,21,opt,name=contains_a_i,json=containsAI,proto3,oneof" json:"contains_a_i,omitempty" xml:"ContainsAI" avs:"ContainsAI"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,22,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,22,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"Description"
 	Description []*DescriptionWithTerritory `protobuf:"bytes,23,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalImageDetails `protobuf:"bytes,24,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,25,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,26,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3680,15 +3711,15 @@ func (x *Image) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Image) GetContainsAI() string {
-	if x != nil {
-		return x.ContainsAI
+	if x != nil && x.ContainsAI != nil {
+		return *x.ContainsAI
 	}
 	return ""
 }
 
 func (x *Image) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
@@ -3708,15 +3739,15 @@ func (x *Image) GetTechnicalDetails() []*TechnicalImageDetails {
 }
 
 func (x *Image) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Image) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -3725,18 +3756,18 @@ type LinkedReleaseResourceReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"LinkDescription,attr"
-	LinkDescription string `protobuf:"bytes,2,opt,name=link_description,json=linkDescription,proto3" json:"link_description,omitempty" xml:"LinkDescription,attr"`
+	// @gotags: xml:"LinkDescription,attr" avs:"LinkDescription"
+	LinkDescription *string `protobuf:"bytes,2,opt,name=link_description,json=linkDescription,proto3,oneof" json:"link_description,omitempty" xml:"LinkDescription,attr" avs:"LinkDescription"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,4,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,5,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,5,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	// @gotags: xml:"IsMultiFile,attr"
-	IsMultiFile   bool `protobuf:"varint,7,opt,name=is_multi_file,json=isMultiFile,proto3" json:"is_multi_file,omitempty" xml:"IsMultiFile,attr"`
+	IsMultiFile   *bool `protobuf:"varint,7,opt,name=is_multi_file,json=isMultiFile,proto3,oneof" json:"is_multi_file,omitempty" xml:"IsMultiFile,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3779,43 +3810,43 @@ func (x *LinkedReleaseResourceReference) GetValue() string {
 }
 
 func (x *LinkedReleaseResourceReference) GetLinkDescription() string {
-	if x != nil {
-		return x.LinkDescription
+	if x != nil && x.LinkDescription != nil {
+		return *x.LinkDescription
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *LinkedReleaseResourceReference) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *LinkedReleaseResourceReference) GetIsMultiFile() bool {
-	if x != nil {
-		return x.IsMultiFile
+	if x != nil && x.IsMultiFile != nil {
+		return *x.IsMultiFile
 	}
 	return false
 }
@@ -3905,18 +3936,18 @@ type Party struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"PartyReference"
 	PartyReference string `protobuf:"bytes,1,opt,name=party_reference,json=partyReference,proto3" json:"party_reference,omitempty" xml:"PartyReference"`
+	// @gotags: xml:"PartyId"
+	PartyId []*DetailedPartyId `protobuf:"bytes,5,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyNameWithTerritory `protobuf:"bytes,6,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"Affiliation"
 	Affiliation []*Affiliation `protobuf:"bytes,2,rep,name=affiliation,proto3" json:"affiliation,omitempty" xml:"Affiliation"`
 	// @gotags: xml:"RelatedParty"
 	RelatedParty []*RelatedParty `protobuf:"bytes,3,rep,name=related_party,json=relatedParty,proto3" json:"related_party,omitempty" xml:"RelatedParty"`
 	// @gotags: xml:"ArtistProfilePage"
 	ArtistProfilePage []string `protobuf:"bytes,4,rep,name=artist_profile_page,json=artistProfilePage,proto3" json:"artist_profile_page,omitempty" xml:"ArtistProfilePage"`
-	// @gotags: xml:"PartyId"
-	PartyId []*DetailedPartyId `protobuf:"bytes,5,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName     []*PartyNameWithTerritory `protobuf:"bytes,6,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Party) Reset() {
@@ -3956,37 +3987,37 @@ func (x *Party) GetPartyReference() string {
 	return ""
 }
 
-func (x *Party) GetAffiliation() []*Affiliation {
+func (x *Party) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.Affiliation
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *Party) GetRelatedParty() []*RelatedParty {
+func (x *Party) GetPartyName() []*PartyNameWithTerritory {
 	if x != nil {
-		return x.RelatedParty
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *Party) GetArtistProfilePage() []string {
+func (x *Party) GetAffiliation() []*Affiliation {
 	if x != nil {
-		return x.ArtistProfilePage
+		return x.Affiliation
 	}
 	return nil
 }
 
-func (x *Party) GetPartyId() []*DetailedPartyId {
+func (x *Party) GetRelatedParty() []*RelatedParty {
 	if x != nil {
-		return x.PartyId
+		return x.RelatedParty
 	}
 	return nil
 }
 
-func (x *Party) GetPartyName() []*PartyNameWithTerritory {
+func (x *Party) GetArtistProfilePage() []string {
 	if x != nil {
-		return x.PartyName
+		return x.ArtistProfilePage
 	}
 	return nil
 }
@@ -4050,7 +4081,7 @@ type PartyNameWithTerritory struct {
 	// @gotags: xml:"FullName"
 	FullName *Name `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
 	FullNameIndexed *Name `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
@@ -4062,19 +4093,19 @@ type PartyNameWithTerritory struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *Name `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsNickname,attr"
-	IsNickname bool `protobuf:"varint,9,opt,name=is_nickname,json=isNickname,proto3" json:"is_nickname,omitempty" xml:"IsNickname,attr"`
+	IsNickname *bool `protobuf:"varint,9,opt,name=is_nickname,json=isNickname,proto3,oneof" json:"is_nickname,omitempty" xml:"IsNickname,attr"`
 	// @gotags: xml:"IsStageName,attr"
-	IsStageName bool `protobuf:"varint,10,opt,name=is_stage_name,json=isStageName,proto3" json:"is_stage_name,omitempty" xml:"IsStageName,attr"`
+	IsStageName *bool `protobuf:"varint,10,opt,name=is_stage_name,json=isStageName,proto3,oneof" json:"is_stage_name,omitempty" xml:"IsStageName,attr"`
 	// @gotags: xml:"IsLegalName,attr"
-	IsLegalName bool `protobuf:"varint,11,opt,name=is_legal_name,json=isLegalName,proto3" json:"is_legal_name,omitempty" xml:"IsLegalName,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,12,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsLegalName *bool `protobuf:"varint,11,opt,name=is_legal_name,json=isLegalName,proto3,oneof" json:"is_legal_name,omitempty" xml:"IsLegalName,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,12,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,13,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,13,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,14,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,14,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -4117,8 +4148,8 @@ func (x *PartyNameWithTerritory) GetFullName() *Name {
 }
 
 func (x *PartyNameWithTerritory) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
@@ -4159,50 +4190,50 @@ func (x *PartyNameWithTerritory) GetAbbreviatedName() *Name {
 }
 
 func (x *PartyNameWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PartyNameWithTerritory) GetIsNickname() bool {
-	if x != nil {
-		return x.IsNickname
+	if x != nil && x.IsNickname != nil {
+		return *x.IsNickname
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetIsStageName() bool {
-	if x != nil {
-		return x.IsStageName
+	if x != nil && x.IsStageName != nil {
+		return *x.IsStageName
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetIsLegalName() bool {
-	if x != nil {
-		return x.IsLegalName
+	if x != nil && x.IsLegalName != nil {
+		return *x.IsLegalName
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *PartyNameWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *PartyNameWithTerritory) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
@@ -4210,13 +4241,13 @@ func (x *PartyNameWithTerritory) GetIsInOriginalLanguage() bool {
 type PartyWithRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,5,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"PartyName"
@@ -4258,29 +4289,29 @@ func (*PartyWithRole) Descriptor() ([]byte, []int) {
 }
 
 func (x *PartyWithRole) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *PartyWithRole) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *PartyWithRole) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *PartyWithRole) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
@@ -4455,7 +4486,7 @@ type PhysicalReturns struct {
 	// @gotags: xml:"PhysicalReturnsAllowed"
 	PhysicalReturnsAllowed bool `protobuf:"varint,1,opt,name=physical_returns_allowed,json=physicalReturnsAllowed,proto3" json:"physical_returns_allowed,omitempty" xml:"PhysicalReturnsAllowed"`
 	// @gotags: xml:"LatestDateForPhysicalReturns"
-	LatestDateForPhysicalReturns string `protobuf:"bytes,2,opt,name=latest_date_for_physical_returns,json=latestDateForPhysicalReturns,proto3" json:"latest_date_for_physical_returns,omitempty" xml:"LatestDateForPhysicalReturns"`
+	LatestDateForPhysicalReturns *string `protobuf:"bytes,2,opt,name=latest_date_for_physical_returns,json=latestDateForPhysicalReturns,proto3,oneof" json:"latest_date_for_physical_returns,omitempty" xml:"LatestDateForPhysicalReturns"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -4498,8 +4529,8 @@ func (x *PhysicalReturns) GetPhysicalReturnsAllowed() bool {
 }
 
 func (x *PhysicalReturns) GetLatestDateForPhysicalReturns() string {
-	if x != nil {
-		return x.LatestDateForPhysicalReturns
+	if x != nil && x.LatestDateForPhysicalReturns != nil {
+		return *x.LatestDateForPhysicalReturns
 	}
 	return ""
 }
@@ -4514,12 +4545,12 @@ type PriceInformation struct {
 	BulkOrderWholesalePricePerUnit *Price `protobuf:"bytes,3,opt,name=bulk_order_wholesale_price_per_unit,json=bulkOrderWholesalePricePerUnit,proto3" json:"bulk_order_wholesale_price_per_unit,omitempty" xml:"BulkOrderWholesalePricePerUnit"`
 	// @gotags: xml:"SuggestedRetailPrice"
 	SuggestedRetailPrice *Price `protobuf:"bytes,4,opt,name=suggested_retail_price,json=suggestedRetailPrice,proto3" json:"suggested_retail_price,omitempty" xml:"SuggestedRetailPrice"`
-	// @gotags: xml:"PriceType,attr"
-	PriceType string `protobuf:"bytes,5,opt,name=price_type,json=priceType,proto3" json:"price_type,omitempty" xml:"PriceType,attr"`
+	// @gotags: xml:"PriceType,attr" avs:"PriceInformationType"
+	PriceType *string `protobuf:"bytes,5,opt,name=price_type,json=priceType,proto3,oneof" json:"price_type,omitempty" xml:"PriceType,attr" avs:"PriceInformationType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4583,22 +4614,22 @@ func (x *PriceInformation) GetSuggestedRetailPrice() *Price {
 }
 
 func (x *PriceInformation) GetPriceType() string {
-	if x != nil {
-		return x.PriceType
+	if x != nil && x.PriceType != nil {
+		return *x.PriceType
 	}
 	return ""
 }
 
 func (x *PriceInformation) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PriceInformation) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -4670,10 +4701,10 @@ type Raga struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4716,27 +4747,27 @@ func (x *Raga) GetValue() string {
 }
 
 func (x *Raga) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *Raga) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type RecordingFormat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RecordingFormat"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RecordingFormat"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4779,15 +4810,15 @@ func (x *RecordingFormat) GetValue() string {
 }
 
 func (x *RecordingFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RecordingFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -4920,14 +4951,14 @@ func (x *RelatedRelease) GetOriginalReleaseDate() *EventDateWithoutFlags {
 
 type RelatedResource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"ResourceRelationshipType"
-	ResourceRelationshipType string `protobuf:"bytes,1,opt,name=resource_relationship_type,json=resourceRelationshipType,proto3" json:"resource_relationship_type,omitempty" xml:"ResourceRelationshipType"`
-	// @gotags: xml:"Timing"
-	Timing []*Timing `protobuf:"bytes,2,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
+	// @gotags: xml:"ResourceRelationshipType" avs:"ResourceRelationshipType"
+	ResourceRelationshipType string `protobuf:"bytes,1,opt,name=resource_relationship_type,json=resourceRelationshipType,proto3" json:"resource_relationship_type,omitempty" xml:"ResourceRelationshipType" avs:"ResourceRelationshipType"`
 	// @gotags: xml:"ResourceRelatedResourceReference"
 	ResourceRelatedResourceReference string `protobuf:"bytes,3,opt,name=resource_related_resource_reference,json=resourceRelatedResourceReference,proto3" json:"resource_related_resource_reference,omitempty" xml:"ResourceRelatedResourceReference"`
 	// @gotags: xml:"ResourceId"
-	ResourceId    *ResourceId `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
+	ResourceId *ResourceId `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
+	// @gotags: xml:"Timing"
+	Timing        []*Timing `protobuf:"bytes,2,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4969,13 +5000,6 @@ func (x *RelatedResource) GetResourceRelationshipType() string {
 	return ""
 }
 
-func (x *RelatedResource) GetTiming() []*Timing {
-	if x != nil {
-		return x.Timing
-	}
-	return nil
-}
-
 func (x *RelatedResource) GetResourceRelatedResourceReference() string {
 	if x != nil {
 		return x.ResourceRelatedResourceReference
@@ -4990,6 +5014,13 @@ func (x *RelatedResource) GetResourceId() *ResourceId {
 	return nil
 }
 
+func (x *RelatedResource) GetTiming() []*Timing {
+	if x != nil {
+		return x.Timing
+	}
+	return nil
+}
+
 type Release struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ReleaseReference"
@@ -5023,7 +5054,7 @@ type Release struct {
 	// @gotags: xml:"CourtesyLine"
 	CourtesyLine []*CourtesyLine `protobuf:"bytes,15,rep,name=courtesy_line,json=courtesyLine,proto3" json:"courtesy_line,omitempty" xml:"CourtesyLine"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,16,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,16,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"DisplayGenre"
 	DisplayGenre []*GenreWithTerritory `protobuf:"bytes,17,rep,name=display_genre,json=displayGenre,proto3" json:"display_genre,omitempty" xml:"DisplayGenre"`
 	// @gotags: xml:"ReleaseDate"
@@ -5040,12 +5071,16 @@ type Release struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,23,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,24,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
+	// @gotags: xml:"IsSingleArtistCompilation"
+	IsSingleArtistCompilation bool `protobuf:"varint,38,opt,name=is_single_artist_compilation,json=isSingleArtistCompilation,proto3" json:"is_single_artist_compilation,omitempty" xml:"IsSingleArtistCompilation"`
+	// @gotags: xml:"IsMultiArtistCompilation"
+	IsMultiArtistCompilation bool `protobuf:"varint,39,opt,name=is_multi_artist_compilation,json=isMultiArtistCompilation,proto3" json:"is_multi_artist_compilation,omitempty" xml:"IsMultiArtistCompilation"`
 	// @gotags: xml:"ResourceGroup"
 	ResourceGroup *ResourceGroup `protobuf:"bytes,25,opt,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty" xml:"ResourceGroup"`
 	// @gotags: xml:"ExternalResourceLink"
 	ExternalResourceLink []*ExternalResourceLink `protobuf:"bytes,26,rep,name=external_resource_link,json=externalResourceLink,proto3" json:"external_resource_link,omitempty" xml:"ExternalResourceLink"`
 	// @gotags: xml:"TargetURL"
-	TargetURL string `protobuf:"bytes,27,opt,name=target_u_r_l,json=targetURL,proto3" json:"target_u_r_l,omitempty" xml:"TargetURL"`
+	TargetURL *string `protobuf:"bytes,27,opt,name=target_u_r_l,json=targetURL,proto3,oneof" json:"target_u_r_l,omitempty" xml:"TargetURL"`
 	// @gotags: xml:"Keywords"
 	Keywords []*KeywordsWithTerritory `protobuf:"bytes,28,rep,name=keywords,proto3" json:"keywords,omitempty" xml:"Keywords"`
 	// @gotags: xml:"Synopsis"
@@ -5057,21 +5092,17 @@ type Release struct {
 	// @gotags: xml:"Deity"
 	Deity []*Deity `protobuf:"bytes,32,rep,name=deity,proto3" json:"deity,omitempty" xml:"Deity"`
 	// @gotags: xml:"HiResMusicDescription"
-	HiResMusicDescription string `protobuf:"bytes,33,opt,name=hi_res_music_description,json=hiResMusicDescription,proto3" json:"hi_res_music_description,omitempty" xml:"HiResMusicDescription"`
-	// @gotags: xml:"ContainsAI"
-	ContainsAI string `protobuf:"bytes,34,opt,name=contains_a_i,json=containsAI,proto3" json:"contains_a_i,omitempty" xml:"ContainsAI"`
+	HiResMusicDescription *string `protobuf:"bytes,33,opt,name=hi_res_music_description,json=hiResMusicDescription,proto3,oneof" json:"hi_res_music_description,omitempty" xml:"HiResMusicDescription"`
+	// @gotags: xml:"ContainsAI" avs:"ContainsAI"
+	ContainsAI *string `protobuf:"bytes,34,opt,name=contains_a_i,json=containsAI,proto3,oneof" json:"contains_a_i,omitempty" xml:"ContainsAI" avs:"ContainsAI"`
 	// @gotags: xml:"IsSoundtrack"
-	IsSoundtrack bool `protobuf:"varint,35,opt,name=is_soundtrack,json=isSoundtrack,proto3" json:"is_soundtrack,omitempty" xml:"IsSoundtrack"`
+	IsSoundtrack *bool `protobuf:"varint,35,opt,name=is_soundtrack,json=isSoundtrack,proto3,oneof" json:"is_soundtrack,omitempty" xml:"IsSoundtrack"`
 	// @gotags: xml:"IsHiResMusic"
-	IsHiResMusic bool `protobuf:"varint,36,opt,name=is_hi_res_music,json=isHiResMusic,proto3" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
+	IsHiResMusic *bool `protobuf:"varint,36,opt,name=is_hi_res_music,json=isHiResMusic,proto3,oneof" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
 	// @gotags: xml:"MarketingComment"
 	MarketingComment []*MarketingComment `protobuf:"bytes,37,rep,name=marketing_comment,json=marketingComment,proto3" json:"marketing_comment,omitempty" xml:"MarketingComment"`
-	// @gotags: xml:"IsSingleArtistCompilation"
-	IsSingleArtistCompilation bool `protobuf:"varint,38,opt,name=is_single_artist_compilation,json=isSingleArtistCompilation,proto3" json:"is_single_artist_compilation,omitempty" xml:"IsSingleArtistCompilation"`
-	// @gotags: xml:"IsMultiArtistCompilation"
-	IsMultiArtistCompilation bool `protobuf:"varint,39,opt,name=is_multi_artist_compilation,json=isMultiArtistCompilation,proto3" json:"is_multi_artist_compilation,omitempty" xml:"IsMultiArtistCompilation"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,40,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,40,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5212,8 +5243,8 @@ func (x *Release) GetCourtesyLine() []*CourtesyLine {
 }
 
 func (x *Release) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
@@ -5274,6 +5305,20 @@ func (x *Release) GetRelatedResource() []*RelatedResource {
 	return nil
 }
 
+func (x *Release) GetIsSingleArtistCompilation() bool {
+	if x != nil {
+		return x.IsSingleArtistCompilation
+	}
+	return false
+}
+
+func (x *Release) GetIsMultiArtistCompilation() bool {
+	if x != nil {
+		return x.IsMultiArtistCompilation
+	}
+	return false
+}
+
 func (x *Release) GetResourceGroup() *ResourceGroup {
 	if x != nil {
 		return x.ResourceGroup
@@ -5289,8 +5334,8 @@ func (x *Release) GetExternalResourceLink() []*ExternalResourceLink {
 }
 
 func (x *Release) GetTargetURL() string {
-	if x != nil {
-		return x.TargetURL
+	if x != nil && x.TargetURL != nil {
+		return *x.TargetURL
 	}
 	return ""
 }
@@ -5331,29 +5376,29 @@ func (x *Release) GetDeity() []*Deity {
 }
 
 func (x *Release) GetHiResMusicDescription() string {
-	if x != nil {
-		return x.HiResMusicDescription
+	if x != nil && x.HiResMusicDescription != nil {
+		return *x.HiResMusicDescription
 	}
 	return ""
 }
 
 func (x *Release) GetContainsAI() string {
-	if x != nil {
-		return x.ContainsAI
+	if x != nil && x.ContainsAI != nil {
+		return *x.ContainsAI
 	}
 	return ""
 }
 
 func (x *Release) GetIsSoundtrack() bool {
-	if x != nil {
-		return x.IsSoundtrack
+	if x != nil && x.IsSoundtrack != nil {
+		return *x.IsSoundtrack
 	}
 	return false
 }
 
 func (x *Release) GetIsHiResMusic() bool {
-	if x != nil {
-		return x.IsHiResMusic
+	if x != nil && x.IsHiResMusic != nil {
+		return *x.IsHiResMusic
 	}
 	return false
 }
@@ -5365,23 +5410,9 @@ func (x *Release) GetMarketingComment() []*MarketingComment {
 	return nil
 }
 
-func (x *Release) GetIsSingleArtistCompilation() bool {
-	if x != nil {
-		return x.IsSingleArtistCompilation
-	}
-	return false
-}
-
-func (x *Release) GetIsMultiArtistCompilation() bool {
-	if x != nil {
-		return x.IsMultiArtistCompilation
-	}
-	return false
-}
-
 func (x *Release) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -5391,7 +5422,7 @@ type ReleaseAdmin struct {
 	// @gotags: xml:"ReleaseAdminId"
 	ReleaseAdminId string `protobuf:"bytes,1,opt,name=release_admin_id,json=releaseAdminId,proto3" json:"release_admin_id,omitempty" xml:"ReleaseAdminId"`
 	// @gotags: xml:"PersonnelDescription"
-	PersonnelDescription string `protobuf:"bytes,2,opt,name=personnel_description,json=personnelDescription,proto3" json:"personnel_description,omitempty" xml:"PersonnelDescription"`
+	PersonnelDescription *string `protobuf:"bytes,2,opt,name=personnel_description,json=personnelDescription,proto3,oneof" json:"personnel_description,omitempty" xml:"PersonnelDescription"`
 	// @gotags: xml:"SystemDescription"
 	SystemDescription []string `protobuf:"bytes,3,rep,name=system_description,json=systemDescription,proto3" json:"system_description,omitempty" xml:"SystemDescription"`
 	unknownFields     protoimpl.UnknownFields
@@ -5436,8 +5467,8 @@ func (x *ReleaseAdmin) GetReleaseAdminId() string {
 }
 
 func (x *ReleaseAdmin) GetPersonnelDescription() string {
-	if x != nil {
-		return x.PersonnelDescription
+	if x != nil && x.PersonnelDescription != nil {
+		return *x.PersonnelDescription
 	}
 	return ""
 }
@@ -5506,9 +5537,9 @@ func (x *ReleaseDeal) GetDeal() []*Deal {
 type ReleaseId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ICPN"
-	ICPN string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
+	ICPN *string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3,oneof" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,3,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -5548,15 +5579,15 @@ func (*ReleaseId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ReleaseId) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *ReleaseId) GetICPN() string {
-	if x != nil {
-		return x.ICPN
+	if x != nil && x.ICPN != nil {
+		return *x.ICPN
 	}
 	return ""
 }
@@ -5580,17 +5611,17 @@ type ReleaseLabelReference struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"LabelType,attr"
-	LabelType string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3" json:"label_type,omitempty" xml:"LabelType,attr"`
+	IsDefault *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"LabelType,attr" avs:"LabelType"
+	LabelType *string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3,oneof" json:"label_type,omitempty" xml:"LabelType,attr" avs:"LabelType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -5633,43 +5664,43 @@ func (x *ReleaseLabelReference) GetValue() string {
 }
 
 func (x *ReleaseLabelReference) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *ReleaseLabelReference) GetLabelType() string {
-	if x != nil {
-		return x.LabelType
+	if x != nil && x.LabelType != nil {
+		return *x.LabelType
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReference) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -5679,19 +5710,19 @@ type ReleaseLabelReferenceWithParty struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"LabelType,attr"
-	LabelType string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3" json:"label_type,omitempty" xml:"LabelType,attr"`
+	IsDefault *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"LabelType,attr" avs:"LabelType"
+	LabelType *string `protobuf:"bytes,4,opt,name=label_type,json=labelType,proto3,oneof" json:"label_type,omitempty" xml:"LabelType,attr" avs:"LabelType"`
 	// @gotags: xml:"AccessControlParty,attr"
-	AccessControlParty string `protobuf:"bytes,5,opt,name=access_control_party,json=accessControlParty,proto3" json:"access_control_party,omitempty" xml:"AccessControlParty,attr"`
+	AccessControlParty *string `protobuf:"bytes,5,opt,name=access_control_party,json=accessControlParty,proto3,oneof" json:"access_control_party,omitempty" xml:"AccessControlParty,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,8,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,8,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -5734,50 +5765,50 @@ func (x *ReleaseLabelReferenceWithParty) GetValue() string {
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetLabelType() string {
-	if x != nil {
-		return x.LabelType
+	if x != nil && x.LabelType != nil {
+		return *x.LabelType
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetAccessControlParty() string {
-	if x != nil {
-		return x.AccessControlParty
+	if x != nil && x.AccessControlParty != nil {
+		return *x.AccessControlParty
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *ReleaseLabelReferenceWithParty) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -5849,20 +5880,20 @@ type ReleaseVisibility struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VisibilityReference"
 	VisibilityReference string `protobuf:"bytes,1,opt,name=visibility_reference,json=visibilityReference,proto3" json:"visibility_reference,omitempty" xml:"VisibilityReference"`
-	// @gotags: xml:"ReleaseDisplayStartDateTime"
-	ReleaseDisplayStartDateTime string `protobuf:"bytes,2,opt,name=release_display_start_date_time,json=releaseDisplayStartDateTime,proto3" json:"release_display_start_date_time,omitempty" xml:"ReleaseDisplayStartDateTime"`
-	// @gotags: xml:"CoverArtPreviewStartDateTime"
-	CoverArtPreviewStartDateTime string `protobuf:"bytes,3,opt,name=cover_art_preview_start_date_time,json=coverArtPreviewStartDateTime,proto3" json:"cover_art_preview_start_date_time,omitempty" xml:"CoverArtPreviewStartDateTime"`
-	// @gotags: xml:"FullTrackListingPreviewStartDateTime"
-	FullTrackListingPreviewStartDateTime string `protobuf:"bytes,4,opt,name=full_track_listing_preview_start_date_time,json=fullTrackListingPreviewStartDateTime,proto3" json:"full_track_listing_preview_start_date_time,omitempty" xml:"FullTrackListingPreviewStartDateTime"`
-	// @gotags: xml:"ClipPreviewStartDateTime"
-	ClipPreviewStartDateTime string `protobuf:"bytes,5,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,6,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,7,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
+	// @gotags: xml:"ReleaseDisplayStartDateTime"
+	ReleaseDisplayStartDateTime *string `protobuf:"bytes,2,opt,name=release_display_start_date_time,json=releaseDisplayStartDateTime,proto3,oneof" json:"release_display_start_date_time,omitempty" xml:"ReleaseDisplayStartDateTime"`
+	// @gotags: xml:"CoverArtPreviewStartDateTime"
+	CoverArtPreviewStartDateTime *string `protobuf:"bytes,3,opt,name=cover_art_preview_start_date_time,json=coverArtPreviewStartDateTime,proto3,oneof" json:"cover_art_preview_start_date_time,omitempty" xml:"CoverArtPreviewStartDateTime"`
+	// @gotags: xml:"FullTrackListingPreviewStartDateTime"
+	FullTrackListingPreviewStartDateTime *string `protobuf:"bytes,4,opt,name=full_track_listing_preview_start_date_time,json=fullTrackListingPreviewStartDateTime,proto3,oneof" json:"full_track_listing_preview_start_date_time,omitempty" xml:"FullTrackListingPreviewStartDateTime"`
+	// @gotags: xml:"ClipPreviewStartDateTime"
+	ClipPreviewStartDateTime *string `protobuf:"bytes,5,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3,oneof" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"DoNotDisplayDates,attr"
-	DoNotDisplayDates bool `protobuf:"varint,8,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
+	DoNotDisplayDates *bool `protobuf:"varint,8,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3,oneof" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -5904,51 +5935,51 @@ func (x *ReleaseVisibility) GetVisibilityReference() string {
 	return ""
 }
 
-func (x *ReleaseVisibility) GetReleaseDisplayStartDateTime() string {
+func (x *ReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.ReleaseDisplayStartDateTime
+		return x.TerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *ReleaseVisibility) GetCoverArtPreviewStartDateTime() string {
+func (x *ReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.CoverArtPreviewStartDateTime
+		return x.ExcludedTerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *ReleaseVisibility) GetFullTrackListingPreviewStartDateTime() string {
-	if x != nil {
-		return x.FullTrackListingPreviewStartDateTime
+func (x *ReleaseVisibility) GetReleaseDisplayStartDateTime() string {
+	if x != nil && x.ReleaseDisplayStartDateTime != nil {
+		return *x.ReleaseDisplayStartDateTime
 	}
 	return ""
 }
 
-func (x *ReleaseVisibility) GetClipPreviewStartDateTime() string {
-	if x != nil {
-		return x.ClipPreviewStartDateTime
+func (x *ReleaseVisibility) GetCoverArtPreviewStartDateTime() string {
+	if x != nil && x.CoverArtPreviewStartDateTime != nil {
+		return *x.CoverArtPreviewStartDateTime
 	}
 	return ""
 }
 
-func (x *ReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.TerritoryCode
+func (x *ReleaseVisibility) GetFullTrackListingPreviewStartDateTime() string {
+	if x != nil && x.FullTrackListingPreviewStartDateTime != nil {
+		return *x.FullTrackListingPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
-func (x *ReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *ReleaseVisibility) GetClipPreviewStartDateTime() string {
+	if x != nil && x.ClipPreviewStartDateTime != nil {
+		return *x.ClipPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
 func (x *ReleaseVisibility) GetDoNotDisplayDates() bool {
-	if x != nil {
-		return x.DoNotDisplayDates
+	if x != nil && x.DoNotDisplayDates != nil {
+		return *x.DoNotDisplayDates
 	}
 	return false
 }
@@ -5964,29 +5995,29 @@ type ResourceGroup struct {
 	// @gotags: xml:"GroupingTitle"
 	GroupingTitle []*DisplayTitle `protobuf:"bytes,4,rep,name=grouping_title,json=groupingTitle,proto3" json:"grouping_title,omitempty" xml:"GroupingTitle"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	// @gotags: xml:"NoDisplaySequence"
+	NoDisplaySequence bool `protobuf:"varint,12,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
+	// @gotags: xml:"DisplaySequence"
+	DisplaySequence string `protobuf:"bytes,13,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
 	// @gotags: xml:"DisplayArtist"
 	DisplayArtist []*DisplayArtist `protobuf:"bytes,6,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty" xml:"DisplayArtist"`
 	// @gotags: xml:"CarrierType"
 	CarrierType []*CarrierType `protobuf:"bytes,7,rep,name=carrier_type,json=carrierType,proto3" json:"carrier_type,omitempty" xml:"CarrierType"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,8,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
+	// @gotags: xml:"ResourceGroupReleaseReference"
+	ResourceGroupReleaseReference string `protobuf:"bytes,14,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
+	// @gotags: xml:"ReleaseId"
+	ReleaseId *ReleaseId `protobuf:"bytes,15,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ResourceGroup"
 	ResourceGroup []*ResourceSubGroup `protobuf:"bytes,9,rep,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty" xml:"ResourceGroup"`
 	// @gotags: xml:"ResourceGroupContentItem"
 	ResourceGroupContentItem []*ResourceGroupContentItem `protobuf:"bytes,10,rep,name=resource_group_content_item,json=resourceGroupContentItem,proto3" json:"resource_group_content_item,omitempty" xml:"ResourceGroupContentItem"`
 	// @gotags: xml:"LinkedReleaseResourceReference"
 	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,11,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty" xml:"LinkedReleaseResourceReference"`
-	// @gotags: xml:"NoDisplaySequence"
-	NoDisplaySequence bool `protobuf:"varint,12,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
-	// @gotags: xml:"DisplaySequence"
-	DisplaySequence string `protobuf:"bytes,13,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
-	// @gotags: xml:"ResourceGroupReleaseReference"
-	ResourceGroupReleaseReference string `protobuf:"bytes,14,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
-	// @gotags: xml:"ReleaseId"
-	ReleaseId     *ReleaseId `protobuf:"bytes,15,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
 }
 
 func (x *ResourceGroup) Reset() {
@@ -6048,12 +6079,26 @@ func (x *ResourceGroup) GetGroupingTitle() []*DisplayTitle {
 }
 
 func (x *ResourceGroup) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
+func (x *ResourceGroup) GetNoDisplaySequence() bool {
+	if x != nil {
+		return x.NoDisplaySequence
+	}
+	return false
+}
+
+func (x *ResourceGroup) GetDisplaySequence() string {
+	if x != nil {
+		return x.DisplaySequence
+	}
+	return ""
+}
+
 func (x *ResourceGroup) GetDisplayArtist() []*DisplayArtist {
 	if x != nil {
 		return x.DisplayArtist
@@ -6069,57 +6114,43 @@ func (x *ResourceGroup) GetCarrierType() []*CarrierType {
 }
 
 func (x *ResourceGroup) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
-func (x *ResourceGroup) GetResourceGroup() []*ResourceSubGroup {
+func (x *ResourceGroup) GetResourceGroupReleaseReference() string {
 	if x != nil {
-		return x.ResourceGroup
+		return x.ResourceGroupReleaseReference
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
+func (x *ResourceGroup) GetReleaseId() *ReleaseId {
 	if x != nil {
-		return x.ResourceGroupContentItem
+		return x.ReleaseId
 	}
 	return nil
 }
 
-func (x *ResourceGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
+func (x *ResourceGroup) GetResourceGroup() []*ResourceSubGroup {
 	if x != nil {
-		return x.LinkedReleaseResourceReference
+		return x.ResourceGroup
 	}
 	return nil
 }
 
-func (x *ResourceGroup) GetNoDisplaySequence() bool {
-	if x != nil {
-		return x.NoDisplaySequence
-	}
-	return false
-}
-
-func (x *ResourceGroup) GetDisplaySequence() string {
-	if x != nil {
-		return x.DisplaySequence
-	}
-	return ""
-}
-
-func (x *ResourceGroup) GetResourceGroupReleaseReference() string {
+func (x *ResourceGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
 	if x != nil {
-		return x.ResourceGroupReleaseReference
+		return x.ResourceGroupContentItem
 	}
-	return ""
+	return nil
 }
 
-func (x *ResourceGroup) GetReleaseId() *ReleaseId {
+func (x *ResourceGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
 	if x != nil {
-		return x.ReleaseId
+		return x.LinkedReleaseResourceReference
 	}
 	return nil
 }
@@ -6127,23 +6158,23 @@ func (x *ResourceGroup) GetReleaseId() *ReleaseId {
 type ResourceGroupContentItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,1,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	// @gotags: xml:"NoDisplaySequence"
+	NoDisplaySequence bool `protobuf:"varint,7,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
+	// @gotags: xml:"DisplaySequence"
+	DisplaySequence string `protobuf:"bytes,8,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
 	// @gotags: xml:"ReleaseResourceReference"
 	ReleaseResourceReference string `protobuf:"bytes,2,opt,name=release_resource_reference,json=releaseResourceReference,proto3" json:"release_resource_reference,omitempty" xml:"ReleaseResourceReference"`
 	// @gotags: xml:"LinkedReleaseResourceReference"
 	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,3,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty" xml:"LinkedReleaseResourceReference"`
 	// @gotags: xml:"IsBonusResource"
-	IsBonusResource bool `protobuf:"varint,4,opt,name=is_bonus_resource,json=isBonusResource,proto3" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
+	IsBonusResource *bool `protobuf:"varint,4,opt,name=is_bonus_resource,json=isBonusResource,proto3,oneof" json:"is_bonus_resource,omitempty" xml:"IsBonusResource"`
 	// @gotags: xml:"IsInstantGratificationResource"
-	IsInstantGratificationResource bool `protobuf:"varint,5,opt,name=is_instant_gratification_resource,json=isInstantGratificationResource,proto3" json:"is_instant_gratification_resource,omitempty" xml:"IsInstantGratificationResource"`
+	IsInstantGratificationResource *bool `protobuf:"varint,5,opt,name=is_instant_gratification_resource,json=isInstantGratificationResource,proto3,oneof" json:"is_instant_gratification_resource,omitempty" xml:"IsInstantGratificationResource"`
 	// @gotags: xml:"IsPreOrderIncentiveResource"
-	IsPreOrderIncentiveResource bool `protobuf:"varint,6,opt,name=is_pre_order_incentive_resource,json=isPreOrderIncentiveResource,proto3" json:"is_pre_order_incentive_resource,omitempty" xml:"IsPreOrderIncentiveResource"`
-	// @gotags: xml:"NoDisplaySequence"
-	NoDisplaySequence bool `protobuf:"varint,7,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
-	// @gotags: xml:"DisplaySequence"
-	DisplaySequence string `protobuf:"bytes,8,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	IsPreOrderIncentiveResource *bool `protobuf:"varint,6,opt,name=is_pre_order_incentive_resource,json=isPreOrderIncentiveResource,proto3,oneof" json:"is_pre_order_incentive_resource,omitempty" xml:"IsPreOrderIncentiveResource"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *ResourceGroupContentItem) Reset() {
@@ -6177,12 +6208,26 @@ func (*ResourceGroupContentItem) Descriptor() ([]byte, []int) {
 }
 
 func (x *ResourceGroupContentItem) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
+func (x *ResourceGroupContentItem) GetNoDisplaySequence() bool {
+	if x != nil {
+		return x.NoDisplaySequence
+	}
+	return false
+}
+
+func (x *ResourceGroupContentItem) GetDisplaySequence() string {
+	if x != nil {
+		return x.DisplaySequence
+	}
+	return ""
+}
+
 func (x *ResourceGroupContentItem) GetReleaseResourceReference() string {
 	if x != nil {
 		return x.ReleaseResourceReference
@@ -6198,40 +6243,26 @@ func (x *ResourceGroupContentItem) GetLinkedReleaseResourceReference() []*Linked
 }
 
 func (x *ResourceGroupContentItem) GetIsBonusResource() bool {
-	if x != nil {
-		return x.IsBonusResource
+	if x != nil && x.IsBonusResource != nil {
+		return *x.IsBonusResource
 	}
 	return false
 }
 
 func (x *ResourceGroupContentItem) GetIsInstantGratificationResource() bool {
-	if x != nil {
-		return x.IsInstantGratificationResource
+	if x != nil && x.IsInstantGratificationResource != nil {
+		return *x.IsInstantGratificationResource
 	}
 	return false
 }
 
 func (x *ResourceGroupContentItem) GetIsPreOrderIncentiveResource() bool {
-	if x != nil {
-		return x.IsPreOrderIncentiveResource
-	}
-	return false
-}
-
-func (x *ResourceGroupContentItem) GetNoDisplaySequence() bool {
-	if x != nil {
-		return x.NoDisplaySequence
+	if x != nil && x.IsPreOrderIncentiveResource != nil {
+		return *x.IsPreOrderIncentiveResource
 	}
 	return false
 }
 
-func (x *ResourceGroupContentItem) GetDisplaySequence() string {
-	if x != nil {
-		return x.DisplaySequence
-	}
-	return ""
-}
-
 type ResourceList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SoundRecording"
@@ -6326,16 +6357,16 @@ type ResourceRightsController struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"RightsControllerPartyReference"
 	RightsControllerPartyReference string `protobuf:"bytes,1,opt,name=rights_controller_party_reference,json=rightsControllerPartyReference,proto3" json:"rights_controller_party_reference,omitempty" xml:"RightsControllerPartyReference"`
-	// @gotags: xml:"RightsControlType"
-	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType"`
-	// @gotags: xml:"DelegatedUsageRights"
-	DelegatedUsageRights []*DelegatedUsageRights `protobuf:"bytes,3,rep,name=delegated_usage_rights,json=delegatedUsageRights,proto3" json:"delegated_usage_rights,omitempty" xml:"DelegatedUsageRights"`
+	// @gotags: xml:"RightsControlType" avs:"RightsControllerRole"
+	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType" avs:"RightsControllerRole"`
 	// @gotags: xml:"RightShareUnknown"
 	RightShareUnknown bool `protobuf:"varint,4,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty" xml:"RightShareUnknown"`
 	// @gotags: xml:"RightSharePercentage"
 	RightSharePercentage *Percentage `protobuf:"bytes,5,opt,name=right_share_percentage,json=rightSharePercentage,proto3" json:"right_share_percentage,omitempty" xml:"RightSharePercentage"`
+	// @gotags: xml:"DelegatedUsageRights"
+	DelegatedUsageRights []*DelegatedUsageRights `protobuf:"bytes,3,rep,name=delegated_usage_rights,json=delegatedUsageRights,proto3" json:"delegated_usage_rights,omitempty" xml:"DelegatedUsageRights"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6384,13 +6415,6 @@ func (x *ResourceRightsController) GetRightsControlType() []string {
 	return nil
 }
 
-func (x *ResourceRightsController) GetDelegatedUsageRights() []*DelegatedUsageRights {
-	if x != nil {
-		return x.DelegatedUsageRights
-	}
-	return nil
-}
-
 func (x *ResourceRightsController) GetRightShareUnknown() bool {
 	if x != nil {
 		return x.RightShareUnknown
@@ -6405,9 +6429,16 @@ func (x *ResourceRightsController) GetRightSharePercentage() *Percentage {
 	return nil
 }
 
-func (x *ResourceRightsController) GetSequenceNumber() int32 {
+func (x *ResourceRightsController) GetDelegatedUsageRights() []*DelegatedUsageRights {
 	if x != nil {
-		return x.SequenceNumber
+		return x.DelegatedUsageRights
+	}
+	return nil
+}
+
+func (x *ResourceRightsController) GetSequenceNumber() int32 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -6423,29 +6454,29 @@ type ResourceSubGroup struct {
 	// @gotags: xml:"GroupingTitle"
 	GroupingTitle []*DisplayTitle `protobuf:"bytes,4,rep,name=grouping_title,json=groupingTitle,proto3" json:"grouping_title,omitempty" xml:"GroupingTitle"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,5,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	// @gotags: xml:"NoDisplaySequence"
+	NoDisplaySequence bool `protobuf:"varint,12,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
+	// @gotags: xml:"DisplaySequence"
+	DisplaySequence string `protobuf:"bytes,13,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
 	// @gotags: xml:"DisplayArtist"
 	DisplayArtist []*DisplayArtist `protobuf:"bytes,6,rep,name=display_artist,json=displayArtist,proto3" json:"display_artist,omitempty" xml:"DisplayArtist"`
 	// @gotags: xml:"CarrierType"
 	CarrierType []*CarrierType `protobuf:"bytes,7,rep,name=carrier_type,json=carrierType,proto3" json:"carrier_type,omitempty" xml:"CarrierType"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,8,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,8,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
+	// @gotags: xml:"ResourceGroupReleaseReference"
+	ResourceGroupReleaseReference string `protobuf:"bytes,14,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
+	// @gotags: xml:"ReleaseId"
+	ReleaseId *ReleaseId `protobuf:"bytes,15,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ResourceGroup"
 	ResourceGroup []*ResourceSubGroup `protobuf:"bytes,9,rep,name=resource_group,json=resourceGroup,proto3" json:"resource_group,omitempty" xml:"ResourceGroup"`
 	// @gotags: xml:"ResourceGroupContentItem"
 	ResourceGroupContentItem []*ResourceGroupContentItem `protobuf:"bytes,10,rep,name=resource_group_content_item,json=resourceGroupContentItem,proto3" json:"resource_group_content_item,omitempty" xml:"ResourceGroupContentItem"`
 	// @gotags: xml:"LinkedReleaseResourceReference"
 	LinkedReleaseResourceReference []*LinkedReleaseResourceReference `protobuf:"bytes,11,rep,name=linked_release_resource_reference,json=linkedReleaseResourceReference,proto3" json:"linked_release_resource_reference,omitempty" xml:"LinkedReleaseResourceReference"`
-	// @gotags: xml:"NoDisplaySequence"
-	NoDisplaySequence bool `protobuf:"varint,12,opt,name=no_display_sequence,json=noDisplaySequence,proto3" json:"no_display_sequence,omitempty" xml:"NoDisplaySequence"`
-	// @gotags: xml:"DisplaySequence"
-	DisplaySequence string `protobuf:"bytes,13,opt,name=display_sequence,json=displaySequence,proto3" json:"display_sequence,omitempty" xml:"DisplaySequence"`
-	// @gotags: xml:"ResourceGroupReleaseReference"
-	ResourceGroupReleaseReference string `protobuf:"bytes,14,opt,name=resource_group_release_reference,json=resourceGroupReleaseReference,proto3" json:"resource_group_release_reference,omitempty" xml:"ResourceGroupReleaseReference"`
-	// @gotags: xml:"ReleaseId"
-	ReleaseId *ReleaseId `protobuf:"bytes,15,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
-	// @gotags: xml:"ResourceGroupType,attr"
-	ResourceGroupType string `protobuf:"bytes,16,opt,name=resource_group_type,json=resourceGroupType,proto3" json:"resource_group_type,omitempty" xml:"ResourceGroupType,attr"`
+	// @gotags: xml:"ResourceGroupType,attr" avs:"ResourceGroupType"
+	ResourceGroupType string `protobuf:"bytes,16,opt,name=resource_group_type,json=resourceGroupType,proto3" json:"resource_group_type,omitempty" xml:"ResourceGroupType,attr" avs:"ResourceGroupType"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -6509,12 +6540,26 @@ func (x *ResourceSubGroup) GetGroupingTitle() []*DisplayTitle {
 }
 
 func (x *ResourceSubGroup) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
+func (x *ResourceSubGroup) GetNoDisplaySequence() bool {
+	if x != nil {
+		return x.NoDisplaySequence
+	}
+	return false
+}
+
+func (x *ResourceSubGroup) GetDisplaySequence() string {
+	if x != nil {
+		return x.DisplaySequence
+	}
+	return ""
+}
+
 func (x *ResourceSubGroup) GetDisplayArtist() []*DisplayArtist {
 	if x != nil {
 		return x.DisplayArtist
@@ -6530,57 +6575,43 @@ func (x *ResourceSubGroup) GetCarrierType() []*CarrierType {
 }
 
 func (x *ResourceSubGroup) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
-func (x *ResourceSubGroup) GetResourceGroup() []*ResourceSubGroup {
+func (x *ResourceSubGroup) GetResourceGroupReleaseReference() string {
 	if x != nil {
-		return x.ResourceGroup
+		return x.ResourceGroupReleaseReference
 	}
-	return nil
+	return ""
 }
 
-func (x *ResourceSubGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
+func (x *ResourceSubGroup) GetReleaseId() *ReleaseId {
 	if x != nil {
-		return x.ResourceGroupContentItem
+		return x.ReleaseId
 	}
 	return nil
 }
 
-func (x *ResourceSubGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
+func (x *ResourceSubGroup) GetResourceGroup() []*ResourceSubGroup {
 	if x != nil {
-		return x.LinkedReleaseResourceReference
+		return x.ResourceGroup
 	}
 	return nil
 }
 
-func (x *ResourceSubGroup) GetNoDisplaySequence() bool {
-	if x != nil {
-		return x.NoDisplaySequence
-	}
-	return false
-}
-
-func (x *ResourceSubGroup) GetDisplaySequence() string {
-	if x != nil {
-		return x.DisplaySequence
-	}
-	return ""
-}
-
-func (x *ResourceSubGroup) GetResourceGroupReleaseReference() string {
+func (x *ResourceSubGroup) GetResourceGroupContentItem() []*ResourceGroupContentItem {
 	if x != nil {
-		return x.ResourceGroupReleaseReference
+		return x.ResourceGroupContentItem
 	}
-	return ""
+	return nil
 }
 
-func (x *ResourceSubGroup) GetReleaseId() *ReleaseId {
+func (x *ResourceSubGroup) GetLinkedReleaseResourceReference() []*LinkedReleaseResourceReference {
 	if x != nil {
-		return x.ReleaseId
+		return x.LinkedReleaseResourceReference
 	}
 	return nil
 }
@@ -6596,8 +6627,8 @@ type RightsClaimPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Condition"
 	Condition []*ConditionForRightsClaimPolicy `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition,omitempty" xml:"Condition"`
-	// @gotags: xml:"RightsClaimPolicyType"
-	RightsClaimPolicyType string `protobuf:"bytes,2,opt,name=rights_claim_policy_type,json=rightsClaimPolicyType,proto3" json:"rights_claim_policy_type,omitempty" xml:"RightsClaimPolicyType"`
+	// @gotags: xml:"RightsClaimPolicyType" avs:"RightsClaimPolicyType"
+	RightsClaimPolicyType string `protobuf:"bytes,2,opt,name=rights_claim_policy_type,json=rightsClaimPolicyType,proto3" json:"rights_claim_policy_type,omitempty" xml:"RightsClaimPolicyType" avs:"RightsClaimPolicyType"`
 	// @gotags: xml:"RightsClaimPolicyReason"
 	RightsClaimPolicyReason *RightsClaimPolicyReason `protobuf:"bytes,3,opt,name=rights_claim_policy_reason,json=rightsClaimPolicyReason,proto3" json:"rights_claim_policy_reason,omitempty" xml:"RightsClaimPolicyReason"`
 	unknownFields           protoimpl.UnknownFields
@@ -6720,16 +6751,16 @@ func (x *Segment) GetEndTime() string {
 
 type ServiceException struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"PartyId"
+	PartyId []*DetailedPartyId `protobuf:"bytes,4,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName []*PartyName `protobuf:"bytes,5,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"TradingName"
 	TradingName *Name `protobuf:"bytes,1,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
 	// @gotags: xml:"URL"
 	URL []string `protobuf:"bytes,2,rep,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"Channel"
-	Channel []*Channel `protobuf:"bytes,3,rep,name=channel,proto3" json:"channel,omitempty" xml:"Channel"`
-	// @gotags: xml:"PartyId"
-	PartyId []*DetailedPartyId `protobuf:"bytes,4,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName     []*PartyName `protobuf:"bytes,5,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	Channel       []*Channel `protobuf:"bytes,3,rep,name=channel,proto3" json:"channel,omitempty" xml:"Channel"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6764,37 +6795,37 @@ func (*ServiceException) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ServiceException) GetTradingName() *Name {
+func (x *ServiceException) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.TradingName
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *ServiceException) GetURL() []string {
+func (x *ServiceException) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.URL
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *ServiceException) GetChannel() []*Channel {
+func (x *ServiceException) GetTradingName() *Name {
 	if x != nil {
-		return x.Channel
+		return x.TradingName
 	}
 	return nil
 }
 
-func (x *ServiceException) GetPartyId() []*DetailedPartyId {
+func (x *ServiceException) GetURL() []string {
 	if x != nil {
-		return x.PartyId
+		return x.URL
 	}
 	return nil
 }
 
-func (x *ServiceException) GetPartyName() []*PartyName {
+func (x *ServiceException) GetChannel() []*Channel {
 	if x != nil {
-		return x.PartyName
+		return x.Channel
 	}
 	return nil
 }
@@ -6844,17 +6875,17 @@ type SheetMusic struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,21,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,22,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,22,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"LanguageOfLyrics"
-	LanguageOfLyrics string `protobuf:"bytes,23,opt,name=language_of_lyrics,json=languageOfLyrics,proto3" json:"language_of_lyrics,omitempty" xml:"LanguageOfLyrics"`
+	LanguageOfLyrics *string `protobuf:"bytes,23,opt,name=language_of_lyrics,json=languageOfLyrics,proto3,oneof" json:"language_of_lyrics,omitempty" xml:"LanguageOfLyrics"`
 	// @gotags: xml:"ResourceContainedResourceReferenceList"
 	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,24,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty" xml:"ResourceContainedResourceReferenceList"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalSheetMusicDetails `protobuf:"bytes,25,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,26,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,26,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,27,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,27,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7037,15 +7068,15 @@ func (x *SheetMusic) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *SheetMusic) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
 
 func (x *SheetMusic) GetLanguageOfLyrics() string {
-	if x != nil {
-		return x.LanguageOfLyrics
+	if x != nil && x.LanguageOfLyrics != nil {
+		return *x.LanguageOfLyrics
 	}
 	return ""
 }
@@ -7065,15 +7096,15 @@ func (x *SheetMusic) GetTechnicalDetails() []*TechnicalSheetMusicDetails {
 }
 
 func (x *SheetMusic) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *SheetMusic) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -7125,15 +7156,15 @@ type Software struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,22,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,23,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,23,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"ResourceContainedResourceReferenceList"
 	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,24,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty" xml:"ResourceContainedResourceReferenceList"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalSoftwareDetails `protobuf:"bytes,25,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,26,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,26,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,27,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,27,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7323,8 +7354,8 @@ func (x *Software) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Software) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
@@ -7344,15 +7375,15 @@ func (x *Software) GetTechnicalDetails() []*TechnicalSoftwareDetails {
 }
 
 func (x *Software) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Software) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -7411,28 +7442,28 @@ type SoundRecording struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,25,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,26,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
-	// @gotags: xml:"CompositeMusicalWorkType"
-	CompositeMusicalWorkType string `protobuf:"bytes,27,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType"`
-	// @gotags: xml:"ContainsAI"
-	ContainsAI string `protobuf:"bytes,28,opt,name=contains_a_i,json=containsAI,proto3" json:"contains_a_i,omitempty" xml:"ContainsAI"`
+	// @gotags: xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"
+	CompositeMusicalWorkType *string `protobuf:"bytes,27,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3,oneof" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"`
+	// @gotags: xml:"ContainsAI" avs:"ContainsAI"
+	ContainsAI *string `protobuf:"bytes,28,opt,name=contains_a_i,json=containsAI,proto3,oneof" json:"contains_a_i,omitempty" xml:"ContainsAI" avs:"ContainsAI"`
 	// @gotags: xml:"IsCover"
-	IsCover bool `protobuf:"varint,29,opt,name=is_cover,json=isCover,proto3" json:"is_cover,omitempty" xml:"IsCover"`
+	IsCover *bool `protobuf:"varint,29,opt,name=is_cover,json=isCover,proto3,oneof" json:"is_cover,omitempty" xml:"IsCover"`
 	// @gotags: xml:"HasVocalPerformance"
-	HasVocalPerformance bool `protobuf:"varint,30,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
+	HasVocalPerformance *bool `protobuf:"varint,30,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3,oneof" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
 	// @gotags: xml:"HasForegroundVocalPerformance"
-	HasForegroundVocalPerformance bool `protobuf:"varint,31,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
+	HasForegroundVocalPerformance *bool `protobuf:"varint,31,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3,oneof" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
 	// @gotags: xml:"IsInstrumental"
-	IsInstrumental bool `protobuf:"varint,32,opt,name=is_instrumental,json=isInstrumental,proto3" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
+	IsInstrumental *bool `protobuf:"varint,32,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,33,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,33,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"IsRemastered"
-	IsRemastered bool `protobuf:"varint,34,opt,name=is_remastered,json=isRemastered,proto3" json:"is_remastered,omitempty" xml:"IsRemastered"`
+	IsRemastered *bool `protobuf:"varint,34,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty" xml:"IsRemastered"`
 	// @gotags: xml:"IsHiResMusic"
-	IsHiResMusic bool `protobuf:"varint,35,opt,name=is_hi_res_music,json=isHiResMusic,proto3" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
+	IsHiResMusic *bool `protobuf:"varint,35,opt,name=is_hi_res_music,json=isHiResMusic,proto3,oneof" json:"is_hi_res_music,omitempty" xml:"IsHiResMusic"`
 	// @gotags: xml:"DisableCrossfade"
-	DisableCrossfade bool `protobuf:"varint,36,opt,name=disable_crossfade,json=disableCrossfade,proto3" json:"disable_crossfade,omitempty" xml:"DisableCrossfade"`
+	DisableCrossfade *bool `protobuf:"varint,36,opt,name=disable_crossfade,json=disableCrossfade,proto3,oneof" json:"disable_crossfade,omitempty" xml:"DisableCrossfade"`
 	// @gotags: xml:"DisableSearch"
-	DisableSearch bool `protobuf:"varint,37,opt,name=disable_search,json=disableSearch,proto3" json:"disable_search,omitempty" xml:"DisableSearch"`
+	DisableSearch *bool `protobuf:"varint,37,opt,name=disable_search,json=disableSearch,proto3,oneof" json:"disable_search,omitempty" xml:"DisableSearch"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,38,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
 	// @gotags: xml:"LanguageOfPerformance"
@@ -7446,11 +7477,11 @@ type SoundRecording struct {
 	// @gotags: xml:"AudioChapterReference"
 	AudioChapterReference []string `protobuf:"bytes,43,rep,name=audio_chapter_reference,json=audioChapterReference,proto3" json:"audio_chapter_reference,omitempty" xml:"AudioChapterReference"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,44,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,44,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,45,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,45,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	// @gotags: xml:"ApplyClassicalProfileVariant,attr"
-	ApplyClassicalProfileVariant bool `protobuf:"varint,46,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
+	ApplyClassicalProfileVariant *bool `protobuf:"varint,46,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3,oneof" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -7668,78 +7699,78 @@ func (x *SoundRecording) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *SoundRecording) GetCompositeMusicalWorkType() string {
-	if x != nil {
-		return x.CompositeMusicalWorkType
+	if x != nil && x.CompositeMusicalWorkType != nil {
+		return *x.CompositeMusicalWorkType
 	}
 	return ""
 }
 
 func (x *SoundRecording) GetContainsAI() string {
-	if x != nil {
-		return x.ContainsAI
+	if x != nil && x.ContainsAI != nil {
+		return *x.ContainsAI
 	}
 	return ""
 }
 
 func (x *SoundRecording) GetIsCover() bool {
-	if x != nil {
-		return x.IsCover
+	if x != nil && x.IsCover != nil {
+		return *x.IsCover
 	}
 	return false
 }
 
 func (x *SoundRecording) GetHasVocalPerformance() bool {
-	if x != nil {
-		return x.HasVocalPerformance
+	if x != nil && x.HasVocalPerformance != nil {
+		return *x.HasVocalPerformance
 	}
 	return false
 }
 
 func (x *SoundRecording) GetHasForegroundVocalPerformance() bool {
-	if x != nil {
-		return x.HasForegroundVocalPerformance
+	if x != nil && x.HasForegroundVocalPerformance != nil {
+		return *x.HasForegroundVocalPerformance
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsInstrumental() bool {
-	if x != nil {
-		return x.IsInstrumental
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
 	}
 	return false
 }
 
 func (x *SoundRecording) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsRemastered() bool {
-	if x != nil {
-		return x.IsRemastered
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
 	}
 	return false
 }
 
 func (x *SoundRecording) GetIsHiResMusic() bool {
-	if x != nil {
-		return x.IsHiResMusic
+	if x != nil && x.IsHiResMusic != nil {
+		return *x.IsHiResMusic
 	}
 	return false
 }
 
 func (x *SoundRecording) GetDisableCrossfade() bool {
-	if x != nil {
-		return x.DisableCrossfade
+	if x != nil && x.DisableCrossfade != nil {
+		return *x.DisableCrossfade
 	}
 	return false
 }
 
 func (x *SoundRecording) GetDisableSearch() bool {
-	if x != nil {
-		return x.DisableSearch
+	if x != nil && x.DisableSearch != nil {
+		return *x.DisableSearch
 	}
 	return false
 }
@@ -7787,22 +7818,22 @@ func (x *SoundRecording) GetAudioChapterReference() []string {
 }
 
 func (x *SoundRecording) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *SoundRecording) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
 
 func (x *SoundRecording) GetApplyClassicalProfileVariant() bool {
-	if x != nil {
-		return x.ApplyClassicalProfileVariant
+	if x != nil && x.ApplyClassicalProfileVariant != nil {
+		return *x.ApplyClassicalProfileVariant
 	}
 	return false
 }
@@ -7815,8 +7846,8 @@ type SoundRecordingClipDetails struct {
 	ClipType *ClipType `protobuf:"bytes,2,opt,name=clip_type,json=clipType,proto3" json:"clip_type,omitempty" xml:"ClipType"`
 	// @gotags: xml:"Timing"
 	Timing []*Timing `protobuf:"bytes,3,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,4,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile  []*AudioDeliveryFile `protobuf:"bytes,5,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	unknownFields protoimpl.UnknownFields
@@ -7890,16 +7921,16 @@ func (x *SoundRecordingClipDetails) GetDeliveryFile() []*AudioDeliveryFile {
 
 type SoundRecordingEdition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
+	// @gotags: xml:"Type" avs:"EditionType"
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"Type" avs:"EditionType"`
 	// @gotags: xml:"ResourceId"
 	ResourceId []*SoundRecordingId `protobuf:"bytes,2,rep,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
 	// @gotags: xml:"EditionContributor"
 	EditionContributor []*EditionContributor `protobuf:"bytes,3,rep,name=edition_contributor,json=editionContributor,proto3" json:"edition_contributor,omitempty" xml:"EditionContributor"`
 	// @gotags: xml:"PLine"
 	PLine []*PLine `protobuf:"bytes,4,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
-	// @gotags: xml:"RecordingMode"
-	RecordingMode string `protobuf:"bytes,5,opt,name=recording_mode,json=recordingMode,proto3" json:"recording_mode,omitempty" xml:"RecordingMode"`
+	// @gotags: xml:"RecordingMode" avs:"RecordingMode"
+	RecordingMode *string `protobuf:"bytes,5,opt,name=recording_mode,json=recordingMode,proto3,oneof" json:"recording_mode,omitempty" xml:"RecordingMode" avs:"RecordingMode"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalSoundRecordingDetails `protobuf:"bytes,6,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	unknownFields    protoimpl.UnknownFields
@@ -7937,8 +7968,8 @@ func (*SoundRecordingEdition) Descriptor() ([]byte, []int) {
 }
 
 func (x *SoundRecordingEdition) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
@@ -7965,8 +7996,8 @@ func (x *SoundRecordingEdition) GetPLine() []*PLine {
 }
 
 func (x *SoundRecordingEdition) GetRecordingMode() string {
-	if x != nil {
-		return x.RecordingMode
+	if x != nil && x.RecordingMode != nil {
+		return *x.RecordingMode
 	}
 	return ""
 }
@@ -8028,13 +8059,13 @@ type SynopsisWithTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsShortSynopsis,attr"
-	IsShortSynopsis bool `protobuf:"varint,5,opt,name=is_short_synopsis,json=isShortSynopsis,proto3" json:"is_short_synopsis,omitempty" xml:"IsShortSynopsis,attr"`
+	IsShortSynopsis *bool `protobuf:"varint,5,opt,name=is_short_synopsis,json=isShortSynopsis,proto3,oneof" json:"is_short_synopsis,omitempty" xml:"IsShortSynopsis,attr"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -8077,29 +8108,29 @@ func (x *SynopsisWithTerritory) GetValue() string {
 }
 
 func (x *SynopsisWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *SynopsisWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *SynopsisWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *SynopsisWithTerritory) GetIsShortSynopsis() bool {
-	if x != nil {
-		return x.IsShortSynopsis
+	if x != nil && x.IsShortSynopsis != nil {
+		return *x.IsShortSynopsis
 	}
 	return false
 }
@@ -8108,10 +8139,10 @@ type Tala struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8154,15 +8185,15 @@ func (x *Tala) GetValue() string {
 }
 
 func (x *Tala) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *Tala) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8180,27 +8211,27 @@ type TechnicalImageDetails struct {
 	// @gotags: xml:"AspectRatio"
 	AspectRatio []*AspectRatio `protobuf:"bytes,5,rep,name=aspect_ratio,json=aspectRatio,proto3" json:"aspect_ratio,omitempty" xml:"AspectRatio"`
 	// @gotags: xml:"ColorDepth"
-	ColorDepth int32 `protobuf:"varint,6,opt,name=color_depth,json=colorDepth,proto3" json:"color_depth,omitempty" xml:"ColorDepth"`
+	ColorDepth *int32 `protobuf:"varint,6,opt,name=color_depth,json=colorDepth,proto3,oneof" json:"color_depth,omitempty" xml:"ColorDepth"`
 	// @gotags: xml:"ImageResolution"
-	ImageResolution int32 `protobuf:"varint,7,opt,name=image_resolution,json=imageResolution,proto3" json:"image_resolution,omitempty" xml:"ImageResolution"`
+	ImageResolution *int32 `protobuf:"varint,7,opt,name=image_resolution,json=imageResolution,proto3,oneof" json:"image_resolution,omitempty" xml:"ImageResolution"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,8,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,8,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,9,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,9,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,10,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,11,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,12,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,12,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,13,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,15,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,14,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,15,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,16,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,16,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8271,29 +8302,29 @@ func (x *TechnicalImageDetails) GetAspectRatio() []*AspectRatio {
 }
 
 func (x *TechnicalImageDetails) GetColorDepth() int32 {
-	if x != nil {
-		return x.ColorDepth
+	if x != nil && x.ColorDepth != nil {
+		return *x.ColorDepth
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetImageResolution() int32 {
-	if x != nil {
-		return x.ImageResolution
+	if x != nil && x.ImageResolution != nil {
+		return *x.ImageResolution
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalImageDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8313,8 +8344,8 @@ func (x *TechnicalImageDetails) GetFile() *File {
 }
 
 func (x *TechnicalImageDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -8327,22 +8358,22 @@ func (x *TechnicalImageDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalImageDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalImageDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalImageDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8354,23 +8385,23 @@ type TechnicalSheetMusicDetails struct {
 	// @gotags: xml:"SheetMusicCodecType"
 	SheetMusicCodecType *SheetMusicCodecType `protobuf:"bytes,2,opt,name=sheet_music_codec_type,json=sheetMusicCodecType,proto3" json:"sheet_music_codec_type,omitempty" xml:"SheetMusicCodecType"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8420,15 +8451,15 @@ func (x *TechnicalSheetMusicDetails) GetSheetMusicCodecType() *SheetMusicCodecTy
 }
 
 func (x *TechnicalSheetMusicDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalSheetMusicDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8448,8 +8479,8 @@ func (x *TechnicalSheetMusicDetails) GetFile() *File {
 }
 
 func (x *TechnicalSheetMusicDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -8462,22 +8493,22 @@ func (x *TechnicalSheetMusicDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalSheetMusicDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalSheetMusicDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalSheetMusicDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8489,23 +8520,23 @@ type TechnicalSoftwareDetails struct {
 	// @gotags: xml:"OperatingSystemType"
 	OperatingSystemType *OperatingSystemType `protobuf:"bytes,2,opt,name=operating_system_type,json=operatingSystemType,proto3" json:"operating_system_type,omitempty" xml:"OperatingSystemType"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8555,15 +8586,15 @@ func (x *TechnicalSoftwareDetails) GetOperatingSystemType() *OperatingSystemType
 }
 
 func (x *TechnicalSoftwareDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalSoftwareDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8583,8 +8614,8 @@ func (x *TechnicalSoftwareDetails) GetFile() *File {
 }
 
 func (x *TechnicalSoftwareDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -8597,22 +8628,22 @@ func (x *TechnicalSoftwareDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalSoftwareDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoftwareDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoftwareDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8624,17 +8655,17 @@ type TechnicalSoundRecordingDetails struct {
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile []*AudioDeliveryFile `protobuf:"bytes,2,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	// @gotags: xml:"HasImmersiveAudioMetadata"
-	HasImmersiveAudioMetadata bool `protobuf:"varint,3,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
+	HasImmersiveAudioMetadata *bool `protobuf:"varint,3,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3,oneof" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*SoundRecordingClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8684,15 +8715,15 @@ func (x *TechnicalSoundRecordingDetails) GetDeliveryFile() []*AudioDeliveryFile
 }
 
 func (x *TechnicalSoundRecordingDetails) GetHasImmersiveAudioMetadata() bool {
-	if x != nil {
-		return x.HasImmersiveAudioMetadata
+	if x != nil && x.HasImmersiveAudioMetadata != nil {
+		return *x.HasImmersiveAudioMetadata
 	}
 	return false
 }
 
 func (x *TechnicalSoundRecordingDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8705,22 +8736,22 @@ func (x *TechnicalSoundRecordingDetails) GetClipDetails() []*SoundRecordingClipD
 }
 
 func (x *TechnicalSoundRecordingDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoundRecordingDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalSoundRecordingDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8732,23 +8763,23 @@ type TechnicalTextDetails struct {
 	// @gotags: xml:"TextCodecType"
 	TextCodecType *TextCodecType `protobuf:"bytes,2,opt,name=text_codec_type,json=textCodecType,proto3" json:"text_codec_type,omitempty" xml:"TextCodecType"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,3,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*ClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,7,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,8,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,9,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,10,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8798,15 +8829,15 @@ func (x *TechnicalTextDetails) GetTextCodecType() *TextCodecType {
 }
 
 func (x *TechnicalTextDetails) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
 
 func (x *TechnicalTextDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8826,8 +8857,8 @@ func (x *TechnicalTextDetails) GetFile() *File {
 }
 
 func (x *TechnicalTextDetails) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
@@ -8840,22 +8871,22 @@ func (x *TechnicalTextDetails) GetFingerprint() []*Fingerprint {
 }
 
 func (x *TechnicalTextDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalTextDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalTextDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -8869,15 +8900,15 @@ type TechnicalVideoDetails struct {
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile []*VideoDeliveryFile `protobuf:"bytes,3,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	// @gotags: xml:"IsClip"
-	IsClip bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3" json:"is_clip,omitempty" xml:"IsClip"`
+	IsClip *bool `protobuf:"varint,4,opt,name=is_clip,json=isClip,proto3,oneof" json:"is_clip,omitempty" xml:"IsClip"`
 	// @gotags: xml:"ClipDetails"
 	ClipDetails []*VideoClipDetails `protobuf:"bytes,5,rep,name=clip_details,json=clipDetails,proto3" json:"clip_details,omitempty" xml:"ClipDetails"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,6,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,7,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8934,8 +8965,8 @@ func (x *TechnicalVideoDetails) GetDeliveryFile() []*VideoDeliveryFile {
 }
 
 func (x *TechnicalVideoDetails) GetIsClip() bool {
-	if x != nil {
-		return x.IsClip
+	if x != nil && x.IsClip != nil {
+		return *x.IsClip
 	}
 	return false
 }
@@ -8948,22 +8979,22 @@ func (x *TechnicalVideoDetails) GetClipDetails() []*VideoClipDetails {
 }
 
 func (x *TechnicalVideoDetails) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TechnicalVideoDetails) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TechnicalVideoDetails) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -9012,10 +9043,10 @@ type Text struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,20,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,21,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
-	// @gotags: xml:"ContainsAI"
-	ContainsAI string `protobuf:"bytes,22,opt,name=contains_a_i,json=containsAI,proto3" json:"contains_a_i,omitempty" xml:"ContainsAI"`
+	// @gotags: xml:"ContainsAI" avs:"ContainsAI"
+	ContainsAI *string `protobuf:"bytes,22,opt,name=contains_a_i,json=containsAI,proto3,oneof" json:"contains_a_i,omitempty" xml:"ContainsAI" avs:"ContainsAI"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,23,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,23,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"ResourceContainedResourceReferenceList"
 	ResourceContainedResourceReferenceList *ResourceContainedResourceReferenceList `protobuf:"bytes,24,opt,name=resource_contained_resource_reference_list,json=resourceContainedResourceReferenceList,proto3" json:"resource_contained_resource_reference_list,omitempty" xml:"ResourceContainedResourceReferenceList"`
 	// @gotags: xml:"TechnicalDetails"
@@ -9023,9 +9054,9 @@ type Text struct {
 	// @gotags: xml:"LanguageOfText"
 	LanguageOfText []*Language `protobuf:"bytes,26,rep,name=language_of_text,json=languageOfText,proto3" json:"language_of_text,omitempty" xml:"LanguageOfText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,27,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,27,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,28,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,28,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -9208,15 +9239,15 @@ func (x *Text) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Text) GetContainsAI() string {
-	if x != nil {
-		return x.ContainsAI
+	if x != nil && x.ContainsAI != nil {
+		return *x.ContainsAI
 	}
 	return ""
 }
 
 func (x *Text) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
@@ -9243,15 +9274,15 @@ func (x *Text) GetLanguageOfText() []*Language {
 }
 
 func (x *Text) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Text) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
@@ -9261,7 +9292,7 @@ type Timing struct {
 	// @gotags: xml:"StartPoint"
 	StartPoint string `protobuf:"bytes,1,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"EndPoint"
-	EndPoint string `protobuf:"bytes,2,opt,name=end_point,json=endPoint,proto3" json:"end_point,omitempty" xml:"EndPoint"`
+	EndPoint *string `protobuf:"bytes,2,opt,name=end_point,json=endPoint,proto3,oneof" json:"end_point,omitempty" xml:"EndPoint"`
 	// @gotags: xml:"DurationUsed"
 	DurationUsed  []string `protobuf:"bytes,3,rep,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty" xml:"DurationUsed"`
 	unknownFields protoimpl.UnknownFields
@@ -9306,8 +9337,8 @@ func (x *Timing) GetStartPoint() string {
 }
 
 func (x *Timing) GetEndPoint() string {
-	if x != nil {
-		return x.EndPoint
+	if x != nil && x.EndPoint != nil {
+		return *x.EndPoint
 	}
 	return ""
 }
@@ -9324,11 +9355,11 @@ type Title struct {
 	// @gotags: xml:"TitleText"
 	TitleText string `protobuf:"bytes,1,opt,name=title_text,json=titleText,proto3" json:"title_text,omitempty" xml:"TitleText"`
 	// @gotags: xml:"SubTitle"
-	SubTitle string `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
+	SubTitle *string `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3,oneof" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType     string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"TitleType,attr" avs:"AdditionalTitleType"
+	TitleType     *string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"AdditionalTitleType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9371,22 +9402,22 @@ func (x *Title) GetTitleText() string {
 }
 
 func (x *Title) GetSubTitle() string {
-	if x != nil {
-		return x.SubTitle
+	if x != nil && x.SubTitle != nil {
+		return *x.SubTitle
 	}
 	return ""
 }
 
 func (x *Title) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Title) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
@@ -9420,7 +9451,7 @@ type TrackRelease struct {
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,13,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"TargetURL"
-	TargetURL string `protobuf:"bytes,14,opt,name=target_u_r_l,json=targetURL,proto3" json:"target_u_r_l,omitempty" xml:"TargetURL"`
+	TargetURL *string `protobuf:"bytes,14,opt,name=target_u_r_l,json=targetURL,proto3,oneof" json:"target_u_r_l,omitempty" xml:"TargetURL"`
 	// @gotags: xml:"Keywords"
 	Keywords []*KeywordsWithTerritory `protobuf:"bytes,15,rep,name=keywords,proto3" json:"keywords,omitempty" xml:"Keywords"`
 	// @gotags: xml:"Synopsis"
@@ -9428,7 +9459,7 @@ type TrackRelease struct {
 	// @gotags: xml:"MarketingComment"
 	MarketingComment []*MarketingComment `protobuf:"bytes,17,rep,name=marketing_comment,json=marketingComment,proto3" json:"marketing_comment,omitempty" xml:"MarketingComment"`
 	// @gotags: xml:"IsMainRelease,attr"
-	IsMainRelease bool `protobuf:"varint,18,opt,name=is_main_release,json=isMainRelease,proto3" json:"is_main_release,omitempty" xml:"IsMainRelease,attr"`
+	IsMainRelease *bool `protobuf:"varint,18,opt,name=is_main_release,json=isMainRelease,proto3,oneof" json:"is_main_release,omitempty" xml:"IsMainRelease,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9555,8 +9586,8 @@ func (x *TrackRelease) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *TrackRelease) GetTargetURL() string {
-	if x != nil {
-		return x.TargetURL
+	if x != nil && x.TargetURL != nil {
+		return *x.TargetURL
 	}
 	return ""
 }
@@ -9583,8 +9614,8 @@ func (x *TrackRelease) GetMarketingComment() []*MarketingComment {
 }
 
 func (x *TrackRelease) GetIsMainRelease() bool {
-	if x != nil {
-		return x.IsMainRelease
+	if x != nil && x.IsMainRelease != nil {
+		return *x.IsMainRelease
 	}
 	return false
 }
@@ -9593,16 +9624,16 @@ type TrackReleaseVisibility struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VisibilityReference"
 	VisibilityReference string `protobuf:"bytes,1,opt,name=visibility_reference,json=visibilityReference,proto3" json:"visibility_reference,omitempty" xml:"VisibilityReference"`
-	// @gotags: xml:"TrackListingPreviewStartDateTime"
-	TrackListingPreviewStartDateTime string `protobuf:"bytes,2,opt,name=track_listing_preview_start_date_time,json=trackListingPreviewStartDateTime,proto3" json:"track_listing_preview_start_date_time,omitempty" xml:"TrackListingPreviewStartDateTime"`
-	// @gotags: xml:"ClipPreviewStartDateTime"
-	ClipPreviewStartDateTime string `protobuf:"bytes,3,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,4,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,5,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
+	// @gotags: xml:"TrackListingPreviewStartDateTime"
+	TrackListingPreviewStartDateTime string `protobuf:"bytes,2,opt,name=track_listing_preview_start_date_time,json=trackListingPreviewStartDateTime,proto3" json:"track_listing_preview_start_date_time,omitempty" xml:"TrackListingPreviewStartDateTime"`
+	// @gotags: xml:"ClipPreviewStartDateTime"
+	ClipPreviewStartDateTime *string `protobuf:"bytes,3,opt,name=clip_preview_start_date_time,json=clipPreviewStartDateTime,proto3,oneof" json:"clip_preview_start_date_time,omitempty" xml:"ClipPreviewStartDateTime"`
 	// @gotags: xml:"DoNotDisplayDates,attr"
-	DoNotDisplayDates bool `protobuf:"varint,6,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
+	DoNotDisplayDates *bool `protobuf:"varint,6,opt,name=do_not_display_dates,json=doNotDisplayDates,proto3,oneof" json:"do_not_display_dates,omitempty" xml:"DoNotDisplayDates,attr"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -9644,49 +9675,49 @@ func (x *TrackReleaseVisibility) GetVisibilityReference() string {
 	return ""
 }
 
-func (x *TrackReleaseVisibility) GetTrackListingPreviewStartDateTime() string {
+func (x *TrackReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.TrackListingPreviewStartDateTime
+		return x.TerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *TrackReleaseVisibility) GetClipPreviewStartDateTime() string {
+func (x *TrackReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.ClipPreviewStartDateTime
+		return x.ExcludedTerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *TrackReleaseVisibility) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *TrackReleaseVisibility) GetTrackListingPreviewStartDateTime() string {
 	if x != nil {
-		return x.TerritoryCode
+		return x.TrackListingPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
-func (x *TrackReleaseVisibility) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *TrackReleaseVisibility) GetClipPreviewStartDateTime() string {
+	if x != nil && x.ClipPreviewStartDateTime != nil {
+		return *x.ClipPreviewStartDateTime
 	}
-	return nil
+	return ""
 }
 
 func (x *TrackReleaseVisibility) GetDoNotDisplayDates() bool {
-	if x != nil {
-		return x.DoNotDisplayDates
+	if x != nil && x.DoNotDisplayDates != nil {
+		return *x.DoNotDisplayDates
 	}
 	return false
 }
 
 type UseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UseTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UseTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -9729,27 +9760,27 @@ func (x *UseType) GetValue() string {
 }
 
 func (x *UseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *UseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type UserInterfaceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"UserInterfaceTypeERN"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"UserInterfaceTypeERN"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -9792,15 +9823,15 @@ func (x *UserInterfaceType) GetValue() string {
 }
 
 func (x *UserInterfaceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *UserInterfaceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -9859,22 +9890,26 @@ type Video struct {
 	RelatedRelease []*RelatedRelease `protobuf:"bytes,25,rep,name=related_release,json=relatedRelease,proto3" json:"related_release,omitempty" xml:"RelatedRelease"`
 	// @gotags: xml:"RelatedResource"
 	RelatedResource []*RelatedResource `protobuf:"bytes,26,rep,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
-	// @gotags: xml:"CompositeMusicalWorkType"
-	CompositeMusicalWorkType string `protobuf:"bytes,27,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType"`
-	// @gotags: xml:"ContainsAI"
-	ContainsAI string `protobuf:"bytes,28,opt,name=contains_a_i,json=containsAI,proto3" json:"contains_a_i,omitempty" xml:"ContainsAI"`
+	// @gotags: xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"
+	CompositeMusicalWorkType *string `protobuf:"bytes,27,opt,name=composite_musical_work_type,json=compositeMusicalWorkType,proto3,oneof" json:"composite_musical_work_type,omitempty" xml:"CompositeMusicalWorkType" avs:"CompositeMusicalWorkType"`
+	// @gotags: xml:"VideoCueSheetReference"
+	VideoCueSheetReference []string `protobuf:"bytes,44,rep,name=video_cue_sheet_reference,json=videoCueSheetReference,proto3" json:"video_cue_sheet_reference,omitempty" xml:"VideoCueSheetReference"`
+	// @gotags: xml:"ReasonForCueSheetAbsence"
+	ReasonForCueSheetAbsence *Reason `protobuf:"bytes,45,opt,name=reason_for_cue_sheet_absence,json=reasonForCueSheetAbsence,proto3" json:"reason_for_cue_sheet_absence,omitempty" xml:"ReasonForCueSheetAbsence"`
+	// @gotags: xml:"ContainsAI" avs:"ContainsAI"
+	ContainsAI *string `protobuf:"bytes,28,opt,name=contains_a_i,json=containsAI,proto3,oneof" json:"contains_a_i,omitempty" xml:"ContainsAI" avs:"ContainsAI"`
 	// @gotags: xml:"IsCover"
-	IsCover bool `protobuf:"varint,29,opt,name=is_cover,json=isCover,proto3" json:"is_cover,omitempty" xml:"IsCover"`
+	IsCover *bool `protobuf:"varint,29,opt,name=is_cover,json=isCover,proto3,oneof" json:"is_cover,omitempty" xml:"IsCover"`
 	// @gotags: xml:"HasVocalPerformance"
-	HasVocalPerformance bool `protobuf:"varint,30,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
+	HasVocalPerformance *bool `protobuf:"varint,30,opt,name=has_vocal_performance,json=hasVocalPerformance,proto3,oneof" json:"has_vocal_performance,omitempty" xml:"HasVocalPerformance"`
 	// @gotags: xml:"HasForegroundVocalPerformance"
-	HasForegroundVocalPerformance bool `protobuf:"varint,31,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
+	HasForegroundVocalPerformance *bool `protobuf:"varint,31,opt,name=has_foreground_vocal_performance,json=hasForegroundVocalPerformance,proto3,oneof" json:"has_foreground_vocal_performance,omitempty" xml:"HasForegroundVocalPerformance"`
 	// @gotags: xml:"IsInstrumental"
-	IsInstrumental bool `protobuf:"varint,32,opt,name=is_instrumental,json=isInstrumental,proto3" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
+	IsInstrumental *bool `protobuf:"varint,32,opt,name=is_instrumental,json=isInstrumental,proto3,oneof" json:"is_instrumental,omitempty" xml:"IsInstrumental"`
 	// @gotags: xml:"ContainsHiddenContent"
-	ContainsHiddenContent bool `protobuf:"varint,33,opt,name=contains_hidden_content,json=containsHiddenContent,proto3" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
+	ContainsHiddenContent *bool `protobuf:"varint,33,opt,name=contains_hidden_content,json=containsHiddenContent,proto3,oneof" json:"contains_hidden_content,omitempty" xml:"ContainsHiddenContent"`
 	// @gotags: xml:"IsRemastered"
-	IsRemastered bool `protobuf:"varint,34,opt,name=is_remastered,json=isRemastered,proto3" json:"is_remastered,omitempty" xml:"IsRemastered"`
+	IsRemastered *bool `protobuf:"varint,34,opt,name=is_remastered,json=isRemastered,proto3,oneof" json:"is_remastered,omitempty" xml:"IsRemastered"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,35,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
 	// @gotags: xml:"LanguageOfPerformance"
@@ -9893,16 +9928,12 @@ type Video struct {
 	Deity []string `protobuf:"bytes,42,rep,name=deity,proto3" json:"deity,omitempty" xml:"Deity"`
 	// @gotags: xml:"VideoChapterReference"
 	VideoChapterReference []string `protobuf:"bytes,43,rep,name=video_chapter_reference,json=videoChapterReference,proto3" json:"video_chapter_reference,omitempty" xml:"VideoChapterReference"`
-	// @gotags: xml:"VideoCueSheetReference"
-	VideoCueSheetReference []string `protobuf:"bytes,44,rep,name=video_cue_sheet_reference,json=videoCueSheetReference,proto3" json:"video_cue_sheet_reference,omitempty" xml:"VideoCueSheetReference"`
-	// @gotags: xml:"ReasonForCueSheetAbsence"
-	ReasonForCueSheetAbsence *Reason `protobuf:"bytes,45,opt,name=reason_for_cue_sheet_absence,json=reasonForCueSheetAbsence,proto3" json:"reason_for_cue_sheet_absence,omitempty" xml:"ReasonForCueSheetAbsence"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,46,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,46,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsSupplemental,attr"
-	IsSupplemental bool `protobuf:"varint,47,opt,name=is_supplemental,json=isSupplemental,proto3" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
+	IsSupplemental *bool `protobuf:"varint,47,opt,name=is_supplemental,json=isSupplemental,proto3,oneof" json:"is_supplemental,omitempty" xml:"IsSupplemental,attr"`
 	// @gotags: xml:"ApplyClassicalProfileVariant,attr"
-	ApplyClassicalProfileVariant bool `protobuf:"varint,48,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
+	ApplyClassicalProfileVariant *bool `protobuf:"varint,48,opt,name=apply_classical_profile_variant,json=applyClassicalProfileVariant,proto3,oneof" json:"apply_classical_profile_variant,omitempty" xml:"ApplyClassicalProfileVariant,attr"`
 	unknownFields                protoimpl.UnknownFields
 	sizeCache                    protoimpl.SizeCache
 }
@@ -10120,57 +10151,71 @@ func (x *Video) GetRelatedResource() []*RelatedResource {
 }
 
 func (x *Video) GetCompositeMusicalWorkType() string {
-	if x != nil {
-		return x.CompositeMusicalWorkType
+	if x != nil && x.CompositeMusicalWorkType != nil {
+		return *x.CompositeMusicalWorkType
 	}
 	return ""
 }
 
-func (x *Video) GetContainsAI() string {
+func (x *Video) GetVideoCueSheetReference() []string {
+	if x != nil {
+		return x.VideoCueSheetReference
+	}
+	return nil
+}
+
+func (x *Video) GetReasonForCueSheetAbsence() *Reason {
 	if x != nil {
-		return x.ContainsAI
+		return x.ReasonForCueSheetAbsence
+	}
+	return nil
+}
+
+func (x *Video) GetContainsAI() string {
+	if x != nil && x.ContainsAI != nil {
+		return *x.ContainsAI
 	}
 	return ""
 }
 
 func (x *Video) GetIsCover() bool {
-	if x != nil {
-		return x.IsCover
+	if x != nil && x.IsCover != nil {
+		return *x.IsCover
 	}
 	return false
 }
 
 func (x *Video) GetHasVocalPerformance() bool {
-	if x != nil {
-		return x.HasVocalPerformance
+	if x != nil && x.HasVocalPerformance != nil {
+		return *x.HasVocalPerformance
 	}
 	return false
 }
 
 func (x *Video) GetHasForegroundVocalPerformance() bool {
-	if x != nil {
-		return x.HasForegroundVocalPerformance
+	if x != nil && x.HasForegroundVocalPerformance != nil {
+		return *x.HasForegroundVocalPerformance
 	}
 	return false
 }
 
 func (x *Video) GetIsInstrumental() bool {
-	if x != nil {
-		return x.IsInstrumental
+	if x != nil && x.IsInstrumental != nil {
+		return *x.IsInstrumental
 	}
 	return false
 }
 
 func (x *Video) GetContainsHiddenContent() bool {
-	if x != nil {
-		return x.ContainsHiddenContent
+	if x != nil && x.ContainsHiddenContent != nil {
+		return *x.ContainsHiddenContent
 	}
 	return false
 }
 
 func (x *Video) GetIsRemastered() bool {
-	if x != nil {
-		return x.IsRemastered
+	if x != nil && x.IsRemastered != nil {
+		return *x.IsRemastered
 	}
 	return false
 }
@@ -10238,37 +10283,23 @@ func (x *Video) GetVideoChapterReference() []string {
 	return nil
 }
 
-func (x *Video) GetVideoCueSheetReference() []string {
-	if x != nil {
-		return x.VideoCueSheetReference
-	}
-	return nil
-}
-
-func (x *Video) GetReasonForCueSheetAbsence() *Reason {
-	if x != nil {
-		return x.ReasonForCueSheetAbsence
-	}
-	return nil
-}
-
 func (x *Video) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *Video) GetIsSupplemental() bool {
-	if x != nil {
-		return x.IsSupplemental
+	if x != nil && x.IsSupplemental != nil {
+		return *x.IsSupplemental
 	}
 	return false
 }
 
 func (x *Video) GetApplyClassicalProfileVariant() bool {
-	if x != nil {
-		return x.ApplyClassicalProfileVariant
+	if x != nil && x.ApplyClassicalProfileVariant != nil {
+		return *x.ApplyClassicalProfileVariant
 	}
 	return false
 }
@@ -10282,11 +10313,11 @@ type VideoClipDetails struct {
 	// @gotags: xml:"Timing"
 	Timing []*Timing `protobuf:"bytes,3,rep,name=timing,proto3" json:"timing,omitempty" xml:"Timing"`
 	// @gotags: xml:"TopLeftCorner"
-	TopLeftCorner string `protobuf:"bytes,4,opt,name=top_left_corner,json=topLeftCorner,proto3" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
+	TopLeftCorner *string `protobuf:"bytes,4,opt,name=top_left_corner,json=topLeftCorner,proto3,oneof" json:"top_left_corner,omitempty" xml:"TopLeftCorner"`
 	// @gotags: xml:"BottomRightCorner"
-	BottomRightCorner string `protobuf:"bytes,5,opt,name=bottom_right_corner,json=bottomRightCorner,proto3" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
-	// @gotags: xml:"ExpressionType"
-	ExpressionType string `protobuf:"bytes,6,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType"`
+	BottomRightCorner *string `protobuf:"bytes,5,opt,name=bottom_right_corner,json=bottomRightCorner,proto3,oneof" json:"bottom_right_corner,omitempty" xml:"BottomRightCorner"`
+	// @gotags: xml:"ExpressionType" avs:"ExpressionType"
+	ExpressionType string `protobuf:"bytes,6,opt,name=expression_type,json=expressionType,proto3" json:"expression_type,omitempty" xml:"ExpressionType" avs:"ExpressionType"`
 	// @gotags: xml:"DeliveryFile"
 	DeliveryFile  []*VideoDeliveryFile `protobuf:"bytes,7,rep,name=delivery_file,json=deliveryFile,proto3" json:"delivery_file,omitempty" xml:"DeliveryFile"`
 	unknownFields protoimpl.UnknownFields
@@ -10345,15 +10376,15 @@ func (x *VideoClipDetails) GetTiming() []*Timing {
 }
 
 func (x *VideoClipDetails) GetTopLeftCorner() string {
-	if x != nil {
-		return x.TopLeftCorner
+	if x != nil && x.TopLeftCorner != nil {
+		return *x.TopLeftCorner
 	}
 	return ""
 }
 
 func (x *VideoClipDetails) GetBottomRightCorner() string {
-	if x != nil {
-		return x.BottomRightCorner
+	if x != nil && x.BottomRightCorner != nil {
+		return *x.BottomRightCorner
 	}
 	return ""
 }
@@ -10374,8 +10405,8 @@ func (x *VideoClipDetails) GetDeliveryFile() []*VideoDeliveryFile {
 
 type VideoDeliveryFile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
+	// @gotags: xml:"Type" avs:"DeliveryFileType"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type" avs:"DeliveryFileType"`
 	// @gotags: xml:"ContainerFormat"
 	ContainerFormat *ContainerFormat `protobuf:"bytes,2,opt,name=container_format,json=containerFormat,proto3" json:"container_format,omitempty" xml:"ContainerFormat"`
 	// @gotags: xml:"VideoCodecType"
@@ -10393,41 +10424,41 @@ type VideoDeliveryFile struct {
 	// @gotags: xml:"CoreArea"
 	CoreArea *CoreArea `protobuf:"bytes,9,opt,name=core_area,json=coreArea,proto3" json:"core_area,omitempty" xml:"CoreArea"`
 	// @gotags: xml:"ColorDepth"
-	ColorDepth int32 `protobuf:"varint,10,opt,name=color_depth,json=colorDepth,proto3" json:"color_depth,omitempty" xml:"ColorDepth"`
+	ColorDepth *int32 `protobuf:"varint,10,opt,name=color_depth,json=colorDepth,proto3,oneof" json:"color_depth,omitempty" xml:"ColorDepth"`
 	// @gotags: xml:"VideoDefinitionType"
 	VideoDefinitionType *VideoDefinitionType `protobuf:"bytes,11,opt,name=video_definition_type,json=videoDefinitionType,proto3" json:"video_definition_type,omitempty" xml:"VideoDefinitionType"`
 	// @gotags: xml:"AudioCodecType"
 	AudioCodecType *AudioCodecType `protobuf:"bytes,12,opt,name=audio_codec_type,json=audioCodecType,proto3" json:"audio_codec_type,omitempty" xml:"AudioCodecType"`
 	// @gotags: xml:"HasImmersiveAudioMetadata"
-	HasImmersiveAudioMetadata bool `protobuf:"varint,13,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
-	// @gotags: xml:"ElectroOpticalTransferFunctionType"
-	ElectroOpticalTransferFunctionType string `protobuf:"bytes,14,opt,name=electro_optical_transfer_function_type,json=electroOpticalTransferFunctionType,proto3" json:"electro_optical_transfer_function_type,omitempty" xml:"ElectroOpticalTransferFunctionType"`
-	// @gotags: xml:"PrimaryColorType"
-	PrimaryColorType string `protobuf:"bytes,15,opt,name=primary_color_type,json=primaryColorType,proto3" json:"primary_color_type,omitempty" xml:"PrimaryColorType"`
+	HasImmersiveAudioMetadata *bool `protobuf:"varint,13,opt,name=has_immersive_audio_metadata,json=hasImmersiveAudioMetadata,proto3,oneof" json:"has_immersive_audio_metadata,omitempty" xml:"HasImmersiveAudioMetadata"`
+	// @gotags: xml:"ElectroOpticalTransferFunctionType" avs:"ElectroOpticalTransferFunctionType"
+	ElectroOpticalTransferFunctionType *string `protobuf:"bytes,14,opt,name=electro_optical_transfer_function_type,json=electroOpticalTransferFunctionType,proto3,oneof" json:"electro_optical_transfer_function_type,omitempty" xml:"ElectroOpticalTransferFunctionType" avs:"ElectroOpticalTransferFunctionType"`
+	// @gotags: xml:"PrimaryColorType" avs:"PrimaryColorType"
+	PrimaryColorType *string `protobuf:"bytes,15,opt,name=primary_color_type,json=primaryColorType,proto3,oneof" json:"primary_color_type,omitempty" xml:"PrimaryColorType" avs:"PrimaryColorType"`
 	// @gotags: xml:"HdrVideoDynamicMetadataType"
 	HdrVideoDynamicMetadataType *HdrVideoDynamicMetadataType `protobuf:"bytes,16,opt,name=hdr_video_dynamic_metadata_type,json=hdrVideoDynamicMetadataType,proto3" json:"hdr_video_dynamic_metadata_type,omitempty" xml:"HdrVideoDynamicMetadataType"`
-	// @gotags: xml:"HdrVideoStaticMetadataType"
-	HdrVideoStaticMetadataType string `protobuf:"bytes,17,opt,name=hdr_video_static_metadata_type,json=hdrVideoStaticMetadataType,proto3" json:"hdr_video_static_metadata_type,omitempty" xml:"HdrVideoStaticMetadataType"`
+	// @gotags: xml:"HdrVideoStaticMetadataType" avs:"HdrVideoStaticMetadataType"
+	HdrVideoStaticMetadataType *string `protobuf:"bytes,17,opt,name=hdr_video_static_metadata_type,json=hdrVideoStaticMetadataType,proto3,oneof" json:"hdr_video_static_metadata_type,omitempty" xml:"HdrVideoStaticMetadataType" avs:"HdrVideoStaticMetadataType"`
 	// @gotags: xml:"AudioBitRate"
 	AudioBitRate *BitRate `protobuf:"bytes,18,opt,name=audio_bit_rate,json=audioBitRate,proto3" json:"audio_bit_rate,omitempty" xml:"AudioBitRate"`
 	// @gotags: xml:"NumberOfAudioChannels"
-	NumberOfAudioChannels int32 `protobuf:"varint,19,opt,name=number_of_audio_channels,json=numberOfAudioChannels,proto3" json:"number_of_audio_channels,omitempty" xml:"NumberOfAudioChannels"`
+	NumberOfAudioChannels *int32 `protobuf:"varint,19,opt,name=number_of_audio_channels,json=numberOfAudioChannels,proto3,oneof" json:"number_of_audio_channels,omitempty" xml:"NumberOfAudioChannels"`
 	// @gotags: xml:"NumberOfAudioObjects"
-	NumberOfAudioObjects int32 `protobuf:"varint,20,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
+	NumberOfAudioObjects *int32 `protobuf:"varint,20,opt,name=number_of_audio_objects,json=numberOfAudioObjects,proto3,oneof" json:"number_of_audio_objects,omitempty" xml:"NumberOfAudioObjects"`
 	// @gotags: xml:"AudioSamplingRate"
 	AudioSamplingRate *SamplingRate `protobuf:"bytes,21,opt,name=audio_sampling_rate,json=audioSamplingRate,proto3" json:"audio_sampling_rate,omitempty" xml:"AudioSamplingRate"`
 	// @gotags: xml:"AudioBitsPerSample"
-	AudioBitsPerSample int32 `protobuf:"varint,22,opt,name=audio_bits_per_sample,json=audioBitsPerSample,proto3" json:"audio_bits_per_sample,omitempty" xml:"AudioBitsPerSample"`
+	AudioBitsPerSample *int32 `protobuf:"varint,22,opt,name=audio_bits_per_sample,json=audioBitsPerSample,proto3,oneof" json:"audio_bits_per_sample,omitempty" xml:"AudioBitsPerSample"`
 	// @gotags: xml:"Duration"
-	Duration string `protobuf:"bytes,23,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
+	Duration *string `protobuf:"bytes,23,opt,name=duration,proto3,oneof" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"BitDepth"
-	BitDepth int32 `protobuf:"varint,24,opt,name=bit_depth,json=bitDepth,proto3" json:"bit_depth,omitempty" xml:"BitDepth"`
+	BitDepth *int32 `protobuf:"varint,24,opt,name=bit_depth,json=bitDepth,proto3,oneof" json:"bit_depth,omitempty" xml:"BitDepth"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,25,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
 	// @gotags: xml:"Fingerprint"
 	Fingerprint []*Fingerprint `protobuf:"bytes,26,rep,name=fingerprint,proto3" json:"fingerprint,omitempty" xml:"Fingerprint"`
 	// @gotags: xml:"IsProvidedInDelivery"
-	IsProvidedInDelivery bool `protobuf:"varint,27,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
+	IsProvidedInDelivery *bool `protobuf:"varint,27,opt,name=is_provided_in_delivery,json=isProvidedInDelivery,proto3,oneof" json:"is_provided_in_delivery,omitempty" xml:"IsProvidedInDelivery"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -10526,8 +10557,8 @@ func (x *VideoDeliveryFile) GetCoreArea() *CoreArea {
 }
 
 func (x *VideoDeliveryFile) GetColorDepth() int32 {
-	if x != nil {
-		return x.ColorDepth
+	if x != nil && x.ColorDepth != nil {
+		return *x.ColorDepth
 	}
 	return 0
 }
@@ -10547,22 +10578,22 @@ func (x *VideoDeliveryFile) GetAudioCodecType() *AudioCodecType {
 }
 
 func (x *VideoDeliveryFile) GetHasImmersiveAudioMetadata() bool {
-	if x != nil {
-		return x.HasImmersiveAudioMetadata
+	if x != nil && x.HasImmersiveAudioMetadata != nil {
+		return *x.HasImmersiveAudioMetadata
 	}
 	return false
 }
 
 func (x *VideoDeliveryFile) GetElectroOpticalTransferFunctionType() string {
-	if x != nil {
-		return x.ElectroOpticalTransferFunctionType
+	if x != nil && x.ElectroOpticalTransferFunctionType != nil {
+		return *x.ElectroOpticalTransferFunctionType
 	}
 	return ""
 }
 
 func (x *VideoDeliveryFile) GetPrimaryColorType() string {
-	if x != nil {
-		return x.PrimaryColorType
+	if x != nil && x.PrimaryColorType != nil {
+		return *x.PrimaryColorType
 	}
 	return ""
 }
@@ -10575,8 +10606,8 @@ func (x *VideoDeliveryFile) GetHdrVideoDynamicMetadataType() *HdrVideoDynamicMet
 }
 
 func (x *VideoDeliveryFile) GetHdrVideoStaticMetadataType() string {
-	if x != nil {
-		return x.HdrVideoStaticMetadataType
+	if x != nil && x.HdrVideoStaticMetadataType != nil {
+		return *x.HdrVideoStaticMetadataType
 	}
 	return ""
 }
@@ -10589,15 +10620,15 @@ func (x *VideoDeliveryFile) GetAudioBitRate() *BitRate {
 }
 
 func (x *VideoDeliveryFile) GetNumberOfAudioChannels() int32 {
-	if x != nil {
-		return x.NumberOfAudioChannels
+	if x != nil && x.NumberOfAudioChannels != nil {
+		return *x.NumberOfAudioChannels
 	}
 	return 0
 }
 
 func (x *VideoDeliveryFile) GetNumberOfAudioObjects() int32 {
-	if x != nil {
-		return x.NumberOfAudioObjects
+	if x != nil && x.NumberOfAudioObjects != nil {
+		return *x.NumberOfAudioObjects
 	}
 	return 0
 }
@@ -10610,22 +10641,22 @@ func (x *VideoDeliveryFile) GetAudioSamplingRate() *SamplingRate {
 }
 
 func (x *VideoDeliveryFile) GetAudioBitsPerSample() int32 {
-	if x != nil {
-		return x.AudioBitsPerSample
+	if x != nil && x.AudioBitsPerSample != nil {
+		return *x.AudioBitsPerSample
 	}
 	return 0
 }
 
 func (x *VideoDeliveryFile) GetDuration() string {
-	if x != nil {
-		return x.Duration
+	if x != nil && x.Duration != nil {
+		return *x.Duration
 	}
 	return ""
 }
 
 func (x *VideoDeliveryFile) GetBitDepth() int32 {
-	if x != nil {
-		return x.BitDepth
+	if x != nil && x.BitDepth != nil {
+		return *x.BitDepth
 	}
 	return 0
 }
@@ -10645,16 +10676,16 @@ func (x *VideoDeliveryFile) GetFingerprint() []*Fingerprint {
 }
 
 func (x *VideoDeliveryFile) GetIsProvidedInDelivery() bool {
-	if x != nil {
-		return x.IsProvidedInDelivery
+	if x != nil && x.IsProvidedInDelivery != nil {
+		return *x.IsProvidedInDelivery
 	}
 	return false
 }
 
 type VideoEdition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
+	// @gotags: xml:"Type" avs:"EditionType"
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"Type" avs:"EditionType"`
 	// @gotags: xml:"ResourceId"
 	ResourceId []*VideoId `protobuf:"bytes,2,rep,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
 	// @gotags: xml:"EditionContributor"
@@ -10663,8 +10694,8 @@ type VideoEdition struct {
 	PLine []*PLine `protobuf:"bytes,4,rep,name=p_line,json=pLine,proto3" json:"p_line,omitempty" xml:"PLine"`
 	// @gotags: xml:"CLine"
 	CLine []*CLine `protobuf:"bytes,5,rep,name=c_line,json=cLine,proto3" json:"c_line,omitempty" xml:"CLine"`
-	// @gotags: xml:"RecordingMode"
-	RecordingMode string `protobuf:"bytes,6,opt,name=recording_mode,json=recordingMode,proto3" json:"recording_mode,omitempty" xml:"RecordingMode"`
+	// @gotags: xml:"RecordingMode" avs:"RecordingMode"
+	RecordingMode *string `protobuf:"bytes,6,opt,name=recording_mode,json=recordingMode,proto3,oneof" json:"recording_mode,omitempty" xml:"RecordingMode" avs:"RecordingMode"`
 	// @gotags: xml:"TechnicalDetails"
 	TechnicalDetails []*TechnicalVideoDetails `protobuf:"bytes,7,rep,name=technical_details,json=technicalDetails,proto3" json:"technical_details,omitempty" xml:"TechnicalDetails"`
 	unknownFields    protoimpl.UnknownFields
@@ -10702,8 +10733,8 @@ func (*VideoEdition) Descriptor() ([]byte, []int) {
 }
 
 func (x *VideoEdition) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
@@ -10737,8 +10768,8 @@ func (x *VideoEdition) GetCLine() []*CLine {
 }
 
 func (x *VideoEdition) GetRecordingMode() string {
-	if x != nil {
-		return x.RecordingMode
+	if x != nil && x.RecordingMode != nil {
+		return *x.RecordingMode
 	}
 	return ""
 }
@@ -10752,12 +10783,12 @@ func (x *VideoEdition) GetTechnicalDetails() []*TechnicalVideoDetails {
 
 type VideoType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoTypeERN43"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoTypeERN43"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10800,15 +10831,15 @@ func (x *VideoType) GetValue() string {
 }
 
 func (x *VideoType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10817,22 +10848,22 @@ type WorkRightsController struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"RightsControllerPartyReference"
 	RightsControllerPartyReference string `protobuf:"bytes,1,opt,name=rights_controller_party_reference,json=rightsControllerPartyReference,proto3" json:"rights_controller_party_reference,omitempty" xml:"RightsControllerPartyReference"`
-	// @gotags: xml:"RightsControlType"
-	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType"`
-	// @gotags: xml:"RightsControllerType"
-	RightsControllerType string `protobuf:"bytes,3,opt,name=rights_controller_type,json=rightsControllerType,proto3" json:"rights_controller_type,omitempty" xml:"RightsControllerType"`
-	// @gotags: xml:"Territory"
-	Territory []*AllTerritoryCode `protobuf:"bytes,4,rep,name=territory,proto3" json:"territory,omitempty" xml:"Territory"`
-	// @gotags: xml:"StartDate"
-	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty" xml:"StartDate"`
-	// @gotags: xml:"EndDate"
-	EndDate string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
+	// @gotags: xml:"RightsControlType" avs:"RightsControllerRole"
+	RightsControlType []string `protobuf:"bytes,2,rep,name=rights_control_type,json=rightsControlType,proto3" json:"rights_control_type,omitempty" xml:"RightsControlType" avs:"RightsControllerRole"`
+	// @gotags: xml:"RightsControllerType" avs:"RightsControllerType"
+	RightsControllerType *string `protobuf:"bytes,3,opt,name=rights_controller_type,json=rightsControllerType,proto3,oneof" json:"rights_controller_type,omitempty" xml:"RightsControllerType" avs:"RightsControllerType"`
 	// @gotags: xml:"RightShareUnknown"
 	RightShareUnknown bool `protobuf:"varint,7,opt,name=right_share_unknown,json=rightShareUnknown,proto3" json:"right_share_unknown,omitempty" xml:"RightShareUnknown"`
 	// @gotags: xml:"RightSharePercentage"
 	RightSharePercentage string `protobuf:"bytes,8,opt,name=right_share_percentage,json=rightSharePercentage,proto3" json:"right_share_percentage,omitempty" xml:"RightSharePercentage"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// @gotags: xml:"Territory"
+	Territory []*AllTerritoryCode `protobuf:"bytes,4,rep,name=territory,proto3" json:"territory,omitempty" xml:"Territory"`
+	// @gotags: xml:"StartDate"
+	StartDate *string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty" xml:"StartDate"`
+	// @gotags: xml:"EndDate"
+	EndDate       *string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty" xml:"EndDate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkRightsController) Reset() {
@@ -10880,55 +10911,55 @@ func (x *WorkRightsController) GetRightsControlType() []string {
 }
 
 func (x *WorkRightsController) GetRightsControllerType() string {
-	if x != nil {
-		return x.RightsControllerType
+	if x != nil && x.RightsControllerType != nil {
+		return *x.RightsControllerType
 	}
 	return ""
 }
 
-func (x *WorkRightsController) GetTerritory() []*AllTerritoryCode {
+func (x *WorkRightsController) GetRightShareUnknown() bool {
 	if x != nil {
-		return x.Territory
+		return x.RightShareUnknown
 	}
-	return nil
+	return false
 }
 
-func (x *WorkRightsController) GetStartDate() string {
+func (x *WorkRightsController) GetRightSharePercentage() string {
 	if x != nil {
-		return x.StartDate
+		return x.RightSharePercentage
 	}
 	return ""
 }
 
-func (x *WorkRightsController) GetEndDate() string {
+func (x *WorkRightsController) GetTerritory() []*AllTerritoryCode {
 	if x != nil {
-		return x.EndDate
+		return x.Territory
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkRightsController) GetRightShareUnknown() bool {
-	if x != nil {
-		return x.RightShareUnknown
+func (x *WorkRightsController) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
 	}
-	return false
+	return ""
 }
 
-func (x *WorkRightsController) GetRightSharePercentage() string {
-	if x != nil {
-		return x.RightSharePercentage
+func (x *WorkRightsController) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
 	}
 	return ""
 }
 
 type AdministratingRecordCompanyRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"AdministratingRecordCompanyRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AdministratingRecordCompanyRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10971,39 +11002,39 @@ func (x *AdministratingRecordCompanyRole) GetValue() string {
 }
 
 func (x *AdministratingRecordCompanyRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AdministratingRecordCompanyRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type Affiliation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Type"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type"`
-	// @gotags: xml:"ValidityPeriod"
-	ValidityPeriod *ValidityPeriod `protobuf:"bytes,2,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
-	// @gotags: xml:"RightsType"
-	RightsType []*RightsType `protobuf:"bytes,3,rep,name=rights_type,json=rightsType,proto3" json:"rights_type,omitempty" xml:"RightsType"`
-	// @gotags: xml:"PercentageOfRightsAssignment"
-	PercentageOfRightsAssignment string `protobuf:"bytes,4,opt,name=percentage_of_rights_assignment,json=percentageOfRightsAssignment,proto3" json:"percentage_of_rights_assignment,omitempty" xml:"PercentageOfRightsAssignment"`
 	// @gotags: xml:"CompanyName"
 	CompanyName string `protobuf:"bytes,5,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty" xml:"CompanyName"`
 	// @gotags: xml:"PartyAffiliateReference"
 	PartyAffiliateReference string `protobuf:"bytes,6,opt,name=party_affiliate_reference,json=partyAffiliateReference,proto3" json:"party_affiliate_reference,omitempty" xml:"PartyAffiliateReference"`
+	// @gotags: xml:"Type" avs:"AffiliationType"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"Type" avs:"AffiliationType"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,7,rep,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"ExcludedTerritoryCode"
 	ExcludedTerritoryCode []*CurrentTerritoryCode `protobuf:"bytes,8,rep,name=excluded_territory_code,json=excludedTerritoryCode,proto3" json:"excluded_territory_code,omitempty" xml:"ExcludedTerritoryCode"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// @gotags: xml:"ValidityPeriod"
+	ValidityPeriod *ValidityPeriod `protobuf:"bytes,2,opt,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
+	// @gotags: xml:"RightsType"
+	RightsType []*RightsType `protobuf:"bytes,3,rep,name=rights_type,json=rightsType,proto3" json:"rights_type,omitempty" xml:"RightsType"`
+	// @gotags: xml:"PercentageOfRightsAssignment"
+	PercentageOfRightsAssignment *string `protobuf:"bytes,4,opt,name=percentage_of_rights_assignment,json=percentageOfRightsAssignment,proto3,oneof" json:"percentage_of_rights_assignment,omitempty" xml:"PercentageOfRightsAssignment"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
 }
 
 func (x *Affiliation) Reset() {
@@ -11036,68 +11067,68 @@ func (*Affiliation) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *Affiliation) GetType() string {
+func (x *Affiliation) GetCompanyName() string {
 	if x != nil {
-		return x.Type
+		return x.CompanyName
 	}
 	return ""
 }
 
-func (x *Affiliation) GetValidityPeriod() *ValidityPeriod {
+func (x *Affiliation) GetPartyAffiliateReference() string {
 	if x != nil {
-		return x.ValidityPeriod
+		return x.PartyAffiliateReference
 	}
-	return nil
+	return ""
 }
 
-func (x *Affiliation) GetRightsType() []*RightsType {
+func (x *Affiliation) GetType() string {
 	if x != nil {
-		return x.RightsType
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *Affiliation) GetPercentageOfRightsAssignment() string {
+func (x *Affiliation) GetTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.PercentageOfRightsAssignment
+		return x.TerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *Affiliation) GetCompanyName() string {
+func (x *Affiliation) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
 	if x != nil {
-		return x.CompanyName
+		return x.ExcludedTerritoryCode
 	}
-	return ""
+	return nil
 }
 
-func (x *Affiliation) GetPartyAffiliateReference() string {
+func (x *Affiliation) GetValidityPeriod() *ValidityPeriod {
 	if x != nil {
-		return x.PartyAffiliateReference
+		return x.ValidityPeriod
 	}
-	return ""
+	return nil
 }
 
-func (x *Affiliation) GetTerritoryCode() []*CurrentTerritoryCode {
+func (x *Affiliation) GetRightsType() []*RightsType {
 	if x != nil {
-		return x.TerritoryCode
+		return x.RightsType
 	}
 	return nil
 }
 
-func (x *Affiliation) GetExcludedTerritoryCode() []*CurrentTerritoryCode {
-	if x != nil {
-		return x.ExcludedTerritoryCode
+func (x *Affiliation) GetPercentageOfRightsAssignment() string {
+	if x != nil && x.PercentageOfRightsAssignment != nil {
+		return *x.PercentageOfRightsAssignment
 	}
-	return nil
+	return ""
 }
 
 type AllTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"AllTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AllTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -11140,8 +11171,8 @@ func (x *AllTerritoryCode) GetValue() string {
 }
 
 func (x *AllTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
@@ -11150,10 +11181,10 @@ type AspectRatio struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"AspectRatioType,attr"
-	AspectRatioType string `protobuf:"bytes,2,opt,name=aspect_ratio_type,json=aspectRatioType,proto3" json:"aspect_ratio_type,omitempty" xml:"AspectRatioType,attr"`
+	// @gotags: xml:"AspectRatioType,attr" avs:"AspectRatioType"
+	AspectRatioType *string `protobuf:"bytes,2,opt,name=aspect_ratio_type,json=aspectRatioType,proto3,oneof" json:"aspect_ratio_type,omitempty" xml:"AspectRatioType,attr" avs:"AspectRatioType"`
 	// @gotags: xml:"AppliesToCroppedResource,attr"
-	AppliesToCroppedResource bool `protobuf:"varint,3,opt,name=applies_to_cropped_resource,json=appliesToCroppedResource,proto3" json:"applies_to_cropped_resource,omitempty" xml:"AppliesToCroppedResource,attr"`
+	AppliesToCroppedResource *bool `protobuf:"varint,3,opt,name=applies_to_cropped_resource,json=appliesToCroppedResource,proto3,oneof" json:"applies_to_cropped_resource,omitempty" xml:"AppliesToCroppedResource,attr"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -11196,29 +11227,29 @@ func (x *AspectRatio) GetValue() string {
 }
 
 func (x *AspectRatio) GetAspectRatioType() string {
-	if x != nil {
-		return x.AspectRatioType
+	if x != nil && x.AspectRatioType != nil {
+		return *x.AspectRatioType
 	}
 	return ""
 }
 
 func (x *AspectRatio) GetAppliesToCroppedResource() bool {
-	if x != nil {
-		return x.AppliesToCroppedResource
+	if x != nil && x.AppliesToCroppedResource != nil {
+		return *x.AppliesToCroppedResource
 	}
 	return false
 }
 
 type AudioCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"AudioCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AudioCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11261,22 +11292,22 @@ func (x *AudioCodecType) GetValue() string {
 }
 
 func (x *AudioCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *AudioCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AudioCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11285,8 +11316,8 @@ type BitRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfBitRate"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfBitRate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -11329,8 +11360,8 @@ func (x *BitRate) GetValue() string {
 }
 
 func (x *BitRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
@@ -11338,17 +11369,17 @@ func (x *BitRate) GetUnitOfMeasure() string {
 type CLine struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"CLineCompany"
-	CLineCompany string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3" json:"c_line_company,omitempty" xml:"CLineCompany"`
+	CLineCompany *string `protobuf:"bytes,2,opt,name=c_line_company,json=cLineCompany,proto3,oneof" json:"c_line_company,omitempty" xml:"CLineCompany"`
 	// @gotags: xml:"CLineText"
 	CLineText string `protobuf:"bytes,3,opt,name=c_line_text,json=cLineText,proto3" json:"c_line_text,omitempty" xml:"CLineText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -11384,15 +11415,15 @@ func (*CLine) Descriptor() ([]byte, []int) {
 }
 
 func (x *CLine) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *CLine) GetCLineCompany() string {
-	if x != nil {
-		return x.CLineCompany
+	if x != nil && x.CLineCompany != nil {
+		return *x.CLineCompany
 	}
 	return ""
 }
@@ -11405,34 +11436,34 @@ func (x *CLine) GetCLineText() string {
 }
 
 func (x *CLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *CLine) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *CLine) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type CarrierType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CarrierType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CarrierType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11475,15 +11506,15 @@ func (x *CarrierType) GetValue() string {
 }
 
 func (x *CarrierType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CarrierType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11545,15 +11576,15 @@ func (x *CatalogNumber) GetNamespace() string {
 type ChapterId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"EIDR"
 	EIDR []string `protobuf:"bytes,4,rep,name=e_i_d_r,json=eIDR,proto3" json:"e_i_d_r,omitempty" xml:"EIDR"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,5,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,5,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,6,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -11593,22 +11624,22 @@ func (*ChapterId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ChapterId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *ChapterId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *ChapterId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
@@ -11621,8 +11652,8 @@ func (x *ChapterId) GetEIDR() []string {
 }
 
 func (x *ChapterId) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
@@ -11643,12 +11674,12 @@ func (x *ChapterId) GetProprietaryId() []*ProprietaryId {
 
 type ClipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ClipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ClipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11691,27 +11722,27 @@ func (x *ClipType) GetValue() string {
 }
 
 func (x *ClipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ClipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ContainerFormat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ContainerFormat"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ContainerFormat"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11754,41 +11785,41 @@ func (x *ContainerFormat) GetValue() string {
 }
 
 func (x *ContainerFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ContainerFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type Contributor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"ContributorPartyReference"
+	ContributorPartyReference string `protobuf:"bytes,8,opt,name=contributor_party_reference,json=contributorPartyReference,proto3" json:"contributor_party_reference,omitempty" xml:"ContributorPartyReference"`
+	// @gotags: xml:"SpecialContributor"
+	SpecialContributor *SpecialContributorType `protobuf:"bytes,9,opt,name=special_contributor,json=specialContributor,proto3" json:"special_contributor,omitempty" xml:"SpecialContributor"`
 	// @gotags: xml:"Role"
 	Role []*ContributorRole `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" xml:"Role"`
 	// @gotags: xml:"InstrumentType"
 	InstrumentType []*InstrumentType `protobuf:"bytes,2,rep,name=instrument_type,json=instrumentType,proto3" json:"instrument_type,omitempty" xml:"InstrumentType"`
-	// @gotags: xml:"AiContribution"
-	AiContribution string `protobuf:"bytes,3,opt,name=ai_contribution,json=aiContribution,proto3" json:"ai_contribution,omitempty" xml:"AiContribution"`
+	// @gotags: xml:"AiContribution" avs:"ContainsAI"
+	AiContribution *string `protobuf:"bytes,3,opt,name=ai_contribution,json=aiContribution,proto3,oneof" json:"ai_contribution,omitempty" xml:"AiContribution" avs:"ContainsAI"`
 	// @gotags: xml:"HasMadeFeaturedContribution"
-	HasMadeFeaturedContribution bool `protobuf:"varint,4,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
+	HasMadeFeaturedContribution *bool `protobuf:"varint,4,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
 	// @gotags: xml:"HasMadeContractedContribution"
-	HasMadeContractedContribution bool `protobuf:"varint,5,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
+	HasMadeContractedContribution *bool `protobuf:"varint,5,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
 	// @gotags: xml:"IsCredited"
 	IsCredited *IsCredited `protobuf:"bytes,6,opt,name=is_credited,json=isCredited,proto3" json:"is_credited,omitempty" xml:"IsCredited"`
 	// @gotags: xml:"DisplayCredits"
 	DisplayCredits []*DisplayCredits `protobuf:"bytes,7,rep,name=display_credits,json=displayCredits,proto3" json:"display_credits,omitempty" xml:"DisplayCredits"`
-	// @gotags: xml:"ContributorPartyReference"
-	ContributorPartyReference string `protobuf:"bytes,8,opt,name=contributor_party_reference,json=contributorPartyReference,proto3" json:"contributor_party_reference,omitempty" xml:"ContributorPartyReference"`
-	// @gotags: xml:"SpecialContributor"
-	SpecialContributor *SpecialContributorType `protobuf:"bytes,9,opt,name=special_contributor,json=specialContributor,proto3" json:"special_contributor,omitempty" xml:"SpecialContributor"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,10,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,10,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -11823,6 +11854,20 @@ func (*Contributor) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{110}
 }
 
+func (x *Contributor) GetContributorPartyReference() string {
+	if x != nil {
+		return x.ContributorPartyReference
+	}
+	return ""
+}
+
+func (x *Contributor) GetSpecialContributor() *SpecialContributorType {
+	if x != nil {
+		return x.SpecialContributor
+	}
+	return nil
+}
+
 func (x *Contributor) GetRole() []*ContributorRole {
 	if x != nil {
 		return x.Role
@@ -11838,22 +11883,22 @@ func (x *Contributor) GetInstrumentType() []*InstrumentType {
 }
 
 func (x *Contributor) GetAiContribution() string {
-	if x != nil {
-		return x.AiContribution
+	if x != nil && x.AiContribution != nil {
+		return *x.AiContribution
 	}
 	return ""
 }
 
 func (x *Contributor) GetHasMadeFeaturedContribution() bool {
-	if x != nil {
-		return x.HasMadeFeaturedContribution
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
 	}
 	return false
 }
 
 func (x *Contributor) GetHasMadeContractedContribution() bool {
-	if x != nil {
-		return x.HasMadeContractedContribution
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
 	}
 	return false
 }
@@ -11872,23 +11917,9 @@ func (x *Contributor) GetDisplayCredits() []*DisplayCredits {
 	return nil
 }
 
-func (x *Contributor) GetContributorPartyReference() string {
-	if x != nil {
-		return x.ContributorPartyReference
-	}
-	return ""
-}
-
-func (x *Contributor) GetSpecialContributor() *SpecialContributorType {
-	if x != nil {
-		return x.SpecialContributor
-	}
-	return nil
-}
-
 func (x *Contributor) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -11949,12 +11980,12 @@ func (x *ContributorRole) GetInstrumentType() []*InstrumentType {
 
 type ContributorRoleValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11997,15 +12028,15 @@ func (x *ContributorRoleValue) GetValue() string {
 }
 
 func (x *ContributorRoleValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ContributorRoleValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -12015,11 +12046,11 @@ type CourtesyLine struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12062,34 +12093,34 @@ func (x *CourtesyLine) GetValue() string {
 }
 
 func (x *CourtesyLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *CourtesyLine) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *CourtesyLine) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type CueOrigin struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueOrigin"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueOrigin"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12132,27 +12163,27 @@ func (x *CueOrigin) GetValue() string {
 }
 
 func (x *CueOrigin) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueOrigin) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueSheetType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueSheetType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueSheetType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12195,27 +12226,27 @@ func (x *CueSheetType) GetValue() string {
 }
 
 func (x *CueSheetType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueSheetType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueThemeType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ThemeType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ThemeType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12258,27 +12289,27 @@ func (x *CueThemeType) GetValue() string {
 }
 
 func (x *CueThemeType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueThemeType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueUseType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CueUseType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CueUseType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12321,27 +12352,27 @@ func (x *CueUseType) GetValue() string {
 }
 
 func (x *CueUseType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueUseType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueVisualPerceptionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VisualPerceptionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VisualPerceptionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12384,27 +12415,27 @@ func (x *CueVisualPerceptionType) GetValue() string {
 }
 
 func (x *CueVisualPerceptionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueVisualPerceptionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CueVocalType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VocalType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VocalType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12447,25 +12478,25 @@ func (x *CueVocalType) GetValue() string {
 }
 
 func (x *CueVocalType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CueVocalType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CurrentTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"CurrentTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeType"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -12508,22 +12539,22 @@ func (x *CurrentTerritoryCode) GetValue() string {
 }
 
 func (x *CurrentTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
 
 type DSP struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"TradingName"
-	TradingName *Name `protobuf:"bytes,1,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
-	// @gotags: xml:"URL"
-	URL []string `protobuf:"bytes,2,rep,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	// @gotags: xml:"PartyId"
 	PartyId []*DetailedPartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
-	PartyName     []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	PartyName []*PartyName `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"TradingName"
+	TradingName *Name `protobuf:"bytes,1,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
+	// @gotags: xml:"URL"
+	URL           []string `protobuf:"bytes,2,rep,name=u_r_l,json=uRL,proto3" json:"u_r_l,omitempty" xml:"URL"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12558,30 +12589,30 @@ func (*DSP) Descriptor() ([]byte, []int) {
 	return file_ddex_ern_v432_v432_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *DSP) GetTradingName() *Name {
+func (x *DSP) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.TradingName
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *DSP) GetURL() []string {
+func (x *DSP) GetPartyName() []*PartyName {
 	if x != nil {
-		return x.URL
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *DSP) GetPartyId() []*DetailedPartyId {
+func (x *DSP) GetTradingName() *Name {
 	if x != nil {
-		return x.PartyId
+		return x.TradingName
 	}
 	return nil
 }
 
-func (x *DSP) GetPartyName() []*PartyName {
+func (x *DSP) GetURL() []string {
 	if x != nil {
-		return x.PartyName
+		return x.URL
 	}
 	return nil
 }
@@ -12591,11 +12622,11 @@ type DetailedHashSum struct {
 	// @gotags: xml:"Algorithm"
 	Algorithm *HashSumAlgorithmType `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" xml:"Algorithm"`
 	// @gotags: xml:"Version"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version"`
 	// @gotags: xml:"Parameter"
-	Parameter string `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty" xml:"Parameter"`
-	// @gotags: xml:"DataType"
-	DataType string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType"`
+	Parameter *string `protobuf:"bytes,3,opt,name=parameter,proto3,oneof" json:"parameter,omitempty" xml:"Parameter"`
+	// @gotags: xml:"DataType" avs:"BinaryDataType"
+	DataType *string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3,oneof" json:"data_type,omitempty" xml:"DataType" avs:"BinaryDataType"`
 	// @gotags: xml:"HashSumValue"
 	HashSumValue  string `protobuf:"bytes,5,opt,name=hash_sum_value,json=hashSumValue,proto3" json:"hash_sum_value,omitempty" xml:"HashSumValue"`
 	unknownFields protoimpl.UnknownFields
@@ -12640,22 +12671,22 @@ func (x *DetailedHashSum) GetAlgorithm() *HashSumAlgorithmType {
 }
 
 func (x *DetailedHashSum) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetParameter() string {
-	if x != nil {
-		return x.Parameter
+	if x != nil && x.Parameter != nil {
+		return *x.Parameter
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetDataType() string {
-	if x != nil {
-		return x.DataType
+	if x != nil && x.DataType != nil {
+		return *x.DataType
 	}
 	return ""
 }
@@ -12670,15 +12701,15 @@ func (x *DetailedHashSum) GetHashSumValue() string {
 type DetailedPartyId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"CisacSocietyId"
-	CisacSocietyId string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
+	CisacSocietyId *string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3,oneof" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,6,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	unknownFields protoimpl.UnknownFields
@@ -12716,36 +12747,36 @@ func (*DetailedPartyId) Descriptor() ([]byte, []int) {
 }
 
 func (x *DetailedPartyId) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetCisacSocietyId() string {
-	if x != nil {
-		return x.CisacSocietyId
+	if x != nil && x.CisacSocietyId != nil {
+		return *x.CisacSocietyId
 	}
 	return ""
 }
@@ -12762,13 +12793,13 @@ type DisplayArtistNameWithOriginalLanguage struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,3,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,3,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12811,41 +12842,41 @@ func (x *DisplayArtistNameWithOriginalLanguage) GetValue() string {
 }
 
 func (x *DisplayArtistNameWithOriginalLanguage) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithOriginalLanguage) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
 
 func (x *DisplayArtistNameWithOriginalLanguage) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithOriginalLanguage) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type DisplayArtistRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"DisplayArtistRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"DisplayArtistRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -12888,15 +12919,15 @@ func (x *DisplayArtistRole) GetValue() string {
 }
 
 func (x *DisplayArtistRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DisplayArtistRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -12905,12 +12936,16 @@ type DisplayCredits struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"DisplayCreditText"
 	DisplayCreditText string `protobuf:"bytes,1,opt,name=display_credit_text,json=displayCreditText,proto3" json:"display_credit_text,omitempty" xml:"DisplayCreditText"`
+	// @gotags: xml:"DisplayCreditParty"
+	DisplayCreditParty string `protobuf:"bytes,2,opt,name=display_credit_party,json=displayCreditParty,proto3" json:"display_credit_party,omitempty" xml:"DisplayCreditParty"`
+	// @gotags: xml:"NameUsedInDisplayCredit"
+	NameUsedInDisplayCredit *string `protobuf:"bytes,3,opt,name=name_used_in_display_credit,json=nameUsedInDisplayCredit,proto3,oneof" json:"name_used_in_display_credit,omitempty" xml:"NameUsedInDisplayCredit"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -12952,23 +12987,37 @@ func (x *DisplayCredits) GetDisplayCreditText() string {
 	return ""
 }
 
-func (x *DisplayCredits) GetLanguageAndScriptCode() string {
+func (x *DisplayCredits) GetDisplayCreditParty() string {
 	if x != nil {
-		return x.LanguageAndScriptCode
+		return x.DisplayCreditParty
+	}
+	return ""
+}
+
+func (x *DisplayCredits) GetNameUsedInDisplayCredit() string {
+	if x != nil && x.NameUsedInDisplayCredit != nil {
+		return *x.NameUsedInDisplayCredit
+	}
+	return ""
+}
+
+func (x *DisplayCredits) GetLanguageAndScriptCode() string {
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayCredits) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayCredits) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -12978,11 +13027,11 @@ type DisplaySubTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	// @gotags: xml:"IsDisplayedInTitle,attr"
-	IsDisplayedInTitle bool `protobuf:"varint,3,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
-	// @gotags: xml:"SubTitleType,attr"
-	SubTitleType  string `protobuf:"bytes,4,opt,name=sub_title_type,json=subTitleType,proto3" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
+	IsDisplayedInTitle *bool `protobuf:"varint,3,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3,oneof" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
+	// @gotags: xml:"SubTitleType,attr" avs:"SubTitleType"
+	SubTitleType  *string `protobuf:"bytes,4,opt,name=sub_title_type,json=subTitleType,proto3,oneof" json:"sub_title_type,omitempty" xml:"SubTitleType,attr" avs:"SubTitleType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13025,22 +13074,22 @@ func (x *DisplaySubTitle) GetValue() string {
 }
 
 func (x *DisplaySubTitle) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *DisplaySubTitle) GetIsDisplayedInTitle() bool {
-	if x != nil {
-		return x.IsDisplayedInTitle
+	if x != nil && x.IsDisplayedInTitle != nil {
+		return *x.IsDisplayedInTitle
 	}
 	return false
 }
 
 func (x *DisplaySubTitle) GetSubTitleType() string {
-	if x != nil {
-		return x.SubTitleType
+	if x != nil && x.SubTitleType != nil {
+		return *x.SubTitleType
 	}
 	return ""
 }
@@ -13052,13 +13101,13 @@ type DisplayTitle struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle []*DisplaySubTitle `protobuf:"bytes,2,rep,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsInOriginalLanguage,attr"
-	IsInOriginalLanguage bool `protobuf:"varint,6,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
+	IsInOriginalLanguage *bool `protobuf:"varint,6,opt,name=is_in_original_language,json=isInOriginalLanguage,proto3,oneof" json:"is_in_original_language,omitempty" xml:"IsInOriginalLanguage,attr"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -13108,29 +13157,29 @@ func (x *DisplayTitle) GetSubTitle() []*DisplaySubTitle {
 }
 
 func (x *DisplayTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *DisplayTitle) GetIsInOriginalLanguage() bool {
-	if x != nil {
-		return x.IsInOriginalLanguage
+	if x != nil && x.IsInOriginalLanguage != nil {
+		return *x.IsInOriginalLanguage
 	}
 	return false
 }
@@ -13140,17 +13189,17 @@ type EventDate struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13193,43 +13242,43 @@ func (x *EventDate) GetValue() string {
 }
 
 func (x *EventDate) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDate) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDate) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDate) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDate) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDate) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13239,17 +13288,17 @@ type EventDateTime struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"TerritoryCode,attr"
-	TerritoryCode string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"TerritoryCode,attr" avs:"AllTerritoryCode"
+	TerritoryCode *string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3,oneof" json:"territory_code,omitempty" xml:"TerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -13292,43 +13341,43 @@ func (x *EventDateTime) GetValue() string {
 }
 
 func (x *EventDateTime) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDateTime) GetTerritoryCode() string {
-	if x != nil {
-		return x.TerritoryCode
+	if x != nil && x.TerritoryCode != nil {
+		return *x.TerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -13337,8 +13386,8 @@ type Extent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfExtent"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfExtent"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13381,20 +13430,20 @@ func (x *Extent) GetValue() string {
 }
 
 func (x *Extent) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
 
 type ExternallyLinkedResourceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ExternallyLinkedResourceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ExternallyLinkedResourceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13437,15 +13486,15 @@ func (x *ExternallyLinkedResourceType) GetValue() string {
 }
 
 func (x *ExternallyLinkedResourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ExternallyLinkedResourceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13457,7 +13506,7 @@ type File struct {
 	// @gotags: xml:"HashSum"
 	HashSum *DetailedHashSum `protobuf:"bytes,2,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty" xml:"HashSum"`
 	// @gotags: xml:"FileSize"
-	FileSize      string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty" xml:"FileSize"`
+	FileSize      *string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty" xml:"FileSize"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13507,8 +13556,8 @@ func (x *File) GetHashSum() *DetailedHashSum {
 }
 
 func (x *File) GetFileSize() string {
-	if x != nil {
-		return x.FileSize
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
 	}
 	return ""
 }
@@ -13518,13 +13567,13 @@ type Fingerprint struct {
 	// @gotags: xml:"Algorithm"
 	Algorithm *FingerprintAlgorithmType `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" xml:"Algorithm"`
 	// @gotags: xml:"Version"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version"`
 	// @gotags: xml:"Parameter"
-	Parameter string `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty" xml:"Parameter"`
+	Parameter *string `protobuf:"bytes,3,opt,name=parameter,proto3,oneof" json:"parameter,omitempty" xml:"Parameter"`
 	// @gotags: xml:"File"
 	File *File `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty" xml:"File"`
-	// @gotags: xml:"DataType"
-	DataType string `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType"`
+	// @gotags: xml:"DataType" avs:"BinaryDataType"
+	DataType string `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType" avs:"BinaryDataType"`
 	// @gotags: xml:"FingerprintValue"
 	FingerprintValue string `protobuf:"bytes,6,opt,name=fingerprint_value,json=fingerprintValue,proto3" json:"fingerprint_value,omitempty" xml:"FingerprintValue"`
 	unknownFields    protoimpl.UnknownFields
@@ -13569,15 +13618,15 @@ func (x *Fingerprint) GetAlgorithm() *FingerprintAlgorithmType {
 }
 
 func (x *Fingerprint) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *Fingerprint) GetParameter() string {
-	if x != nil {
-		return x.Parameter
+	if x != nil && x.Parameter != nil {
+		return *x.Parameter
 	}
 	return ""
 }
@@ -13605,12 +13654,12 @@ func (x *Fingerprint) GetFingerprintValue() string {
 
 type FingerprintAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"FingerprintAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"FingerprintAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13653,15 +13702,15 @@ func (x *FingerprintAlgorithmType) GetValue() string {
 }
 
 func (x *FingerprintAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *FingerprintAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13670,10 +13719,10 @@ type FirstPublicationDate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13716,15 +13765,15 @@ func (x *FirstPublicationDate) GetValue() string {
 }
 
 func (x *FirstPublicationDate) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *FirstPublicationDate) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -13733,8 +13782,8 @@ type FrameRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfFrameRate"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfFrameRate"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13777,8 +13826,8 @@ func (x *FrameRate) GetValue() string {
 }
 
 func (x *FrameRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
@@ -13789,10 +13838,10 @@ type FulfillmentDate struct {
 	FulfillmentDate string `protobuf:"bytes,1,opt,name=fulfillment_date,json=fulfillmentDate,proto3" json:"fulfillment_date,omitempty" xml:"FulfillmentDate"`
 	// @gotags: xml:"ResourceReleaseReference"
 	ResourceReleaseReference []string `protobuf:"bytes,2,rep,name=resource_release_reference,json=resourceReleaseReference,proto3" json:"resource_release_reference,omitempty" xml:"ResourceReleaseReference"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -13842,15 +13891,15 @@ func (x *FulfillmentDate) GetResourceReleaseReference() []string {
 }
 
 func (x *FulfillmentDate) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *FulfillmentDate) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -13861,8 +13910,8 @@ type GenreCategory struct {
 	Value *GenreCategoryValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	// @gotags: xml:"Description"
 	Description []*TextWithoutTerritory `protobuf:"bytes,2,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -13912,22 +13961,22 @@ func (x *GenreCategory) GetDescription() []*TextWithoutTerritory {
 }
 
 func (x *GenreCategory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 type GenreCategoryValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ClassifiedGenre"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ClassifiedGenre"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -13970,22 +14019,22 @@ func (x *GenreCategoryValue) GetValue() string {
 }
 
 func (x *GenreCategoryValue) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *GenreCategoryValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *GenreCategoryValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -13995,17 +14044,17 @@ type GenreWithTerritory struct {
 	// @gotags: xml:"GenreText"
 	GenreText string `protobuf:"bytes,1,opt,name=genre_text,json=genreText,proto3" json:"genre_text,omitempty" xml:"GenreText"`
 	// @gotags: xml:"SubGenre"
-	SubGenre string `protobuf:"bytes,2,opt,name=sub_genre,json=subGenre,proto3" json:"sub_genre,omitempty" xml:"SubGenre"`
+	SubGenre *string `protobuf:"bytes,2,opt,name=sub_genre,json=subGenre,proto3,oneof" json:"sub_genre,omitempty" xml:"SubGenre"`
 	// @gotags: xml:"GenreCategory"
 	GenreCategory []*GenreCategory `protobuf:"bytes,3,rep,name=genre_category,json=genreCategory,proto3" json:"genre_category,omitempty" xml:"GenreCategory"`
 	// @gotags: xml:"SubGenreCategory"
 	SubGenreCategory []*SubGenreCategory `protobuf:"bytes,4,rep,name=sub_genre_category,json=subGenreCategory,proto3" json:"sub_genre_category,omitempty" xml:"SubGenreCategory"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,6,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,6,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14048,8 +14097,8 @@ func (x *GenreWithTerritory) GetGenreText() string {
 }
 
 func (x *GenreWithTerritory) GetSubGenre() string {
-	if x != nil {
-		return x.SubGenre
+	if x != nil && x.SubGenre != nil {
+		return *x.SubGenre
 	}
 	return ""
 }
@@ -14069,34 +14118,34 @@ func (x *GenreWithTerritory) GetSubGenreCategory() []*SubGenreCategory {
 }
 
 func (x *GenreWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *GenreWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *GenreWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type HashSumAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"HashSumAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"HashSumAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14139,29 +14188,29 @@ func (x *HashSumAlgorithmType) GetValue() string {
 }
 
 func (x *HashSumAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *HashSumAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ImageCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14204,34 +14253,34 @@ func (x *ImageCodecType) GetValue() string {
 }
 
 func (x *ImageCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *ImageCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ImageType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14274,27 +14323,27 @@ func (x *ImageType) GetValue() string {
 }
 
 func (x *ImageType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type InstrumentType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"InstrumentType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"InstrumentType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -14337,15 +14386,15 @@ func (x *InstrumentType) GetValue() string {
 }
 
 func (x *InstrumentType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *InstrumentType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -14355,7 +14404,7 @@ type IsCredited struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"MayBeShared,attr"
-	MayBeShared   bool `protobuf:"varint,2,opt,name=may_be_shared,json=mayBeShared,proto3" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
+	MayBeShared   *bool `protobuf:"varint,2,opt,name=may_be_shared,json=mayBeShared,proto3,oneof" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14398,8 +14447,8 @@ func (x *IsCredited) GetValue() string {
 }
 
 func (x *IsCredited) GetMayBeShared() bool {
-	if x != nil {
-		return x.MayBeShared
+	if x != nil && x.MayBeShared != nil {
+		return *x.MayBeShared
 	}
 	return false
 }
@@ -14409,11 +14458,11 @@ type KeywordsWithTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14456,22 +14505,22 @@ func (x *KeywordsWithTerritory) GetValue() string {
 }
 
 func (x *KeywordsWithTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *KeywordsWithTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *KeywordsWithTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -14481,7 +14530,7 @@ type Language struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsMainLanguage,attr"
-	IsMainLanguage bool `protobuf:"varint,2,opt,name=is_main_language,json=isMainLanguage,proto3" json:"is_main_language,omitempty" xml:"IsMainLanguage,attr"`
+	IsMainLanguage *bool `protobuf:"varint,2,opt,name=is_main_language,json=isMainLanguage,proto3,oneof" json:"is_main_language,omitempty" xml:"IsMainLanguage,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -14524,8 +14573,8 @@ func (x *Language) GetValue() string {
 }
 
 func (x *Language) GetIsMainLanguage() bool {
-	if x != nil {
-		return x.IsMainLanguage
+	if x != nil && x.IsMainLanguage != nil {
+		return *x.IsMainLanguage
 	}
 	return false
 }
@@ -14535,11 +14584,11 @@ type MarketingComment struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14582,22 +14631,22 @@ func (x *MarketingComment) GetValue() string {
 }
 
 func (x *MarketingComment) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *MarketingComment) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *MarketingComment) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -14704,11 +14753,11 @@ func (x *MessageAuditTrailEvent) GetDateTime() string {
 type MessageHeader struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageThreadId"
-	MessageThreadId string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
+	MessageThreadId *string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3,oneof" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
 	// @gotags: xml:"MessageId"
 	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty" xml:"MessageId"`
 	// @gotags: xml:"MessageFileName"
-	MessageFileName string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3" json:"message_file_name,omitempty" xml:"MessageFileName"`
+	MessageFileName *string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3,oneof" json:"message_file_name,omitempty" xml:"MessageFileName"`
 	// @gotags: xml:"MessageSender"
 	MessageSender *MessagingPartyWithoutCode `protobuf:"bytes,4,opt,name=message_sender,json=messageSender,proto3" json:"message_sender,omitempty" xml:"MessageSender"`
 	// @gotags: xml:"SentOnBehalfOf"
@@ -14721,8 +14770,8 @@ type MessageHeader struct {
 	MessageCreatedDateTime string `protobuf:"bytes,8,opt,name=message_created_date_time,json=messageCreatedDateTime,proto3" json:"message_created_date_time,omitempty" xml:"MessageCreatedDateTime"`
 	// @gotags: xml:"MessageAuditTrail"
 	MessageAuditTrail *MessageAuditTrail `protobuf:"bytes,9,opt,name=message_audit_trail,json=messageAuditTrail,proto3" json:"message_audit_trail,omitempty" xml:"MessageAuditTrail"`
-	// @gotags: xml:"MessageControlType"
-	MessageControlType string `protobuf:"bytes,10,opt,name=message_control_type,json=messageControlType,proto3" json:"message_control_type,omitempty" xml:"MessageControlType"`
+	// @gotags: xml:"MessageControlType" avs:"MessageControlType"
+	MessageControlType *string `protobuf:"bytes,10,opt,name=message_control_type,json=messageControlType,proto3,oneof" json:"message_control_type,omitempty" xml:"MessageControlType" avs:"MessageControlType"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -14758,8 +14807,8 @@ func (*MessageHeader) Descriptor() ([]byte, []int) {
 }
 
 func (x *MessageHeader) GetMessageThreadId() string {
-	if x != nil {
-		return x.MessageThreadId
+	if x != nil && x.MessageThreadId != nil {
+		return *x.MessageThreadId
 	}
 	return ""
 }
@@ -14772,8 +14821,8 @@ func (x *MessageHeader) GetMessageId() string {
 }
 
 func (x *MessageHeader) GetMessageFileName() string {
-	if x != nil {
-		return x.MessageFileName
+	if x != nil && x.MessageFileName != nil {
+		return *x.MessageFileName
 	}
 	return ""
 }
@@ -14821,8 +14870,8 @@ func (x *MessageHeader) GetMessageAuditTrail() *MessageAuditTrail {
 }
 
 func (x *MessageHeader) GetMessageControlType() string {
-	if x != nil {
-		return x.MessageControlType
+	if x != nil && x.MessageControlType != nil {
+		return *x.MessageControlType
 	}
 	return ""
 }
@@ -14834,7 +14883,7 @@ type MessagingPartyWithoutCode struct {
 	// @gotags: xml:"PartyName"
 	PartyName *PartyNameWithoutCode `protobuf:"bytes,2,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"TradingName"
-	TradingName   string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
+	TradingName   *string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3,oneof" json:"trading_name,omitempty" xml:"TradingName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14884,8 +14933,8 @@ func (x *MessagingPartyWithoutCode) GetPartyName() *PartyNameWithoutCode {
 }
 
 func (x *MessagingPartyWithoutCode) GetTradingName() string {
-	if x != nil {
-		return x.TradingName
+	if x != nil && x.TradingName != nil {
+		return *x.TradingName
 	}
 	return ""
 }
@@ -14893,15 +14942,15 @@ func (x *MessagingPartyWithoutCode) GetTradingName() string {
 type MusicalWorkId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"OpusNumber"
-	OpusNumber string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3" json:"opus_number,omitempty" xml:"OpusNumber"`
+	OpusNumber *string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3,oneof" json:"opus_number,omitempty" xml:"OpusNumber"`
 	// @gotags: xml:"ComposerCatalogNumber"
 	ComposerCatalogNumber []string `protobuf:"bytes,3,rep,name=composer_catalog_number,json=composerCatalogNumber,proto3" json:"composer_catalog_number,omitempty" xml:"ComposerCatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -14937,15 +14986,15 @@ func (*MusicalWorkId) Descriptor() ([]byte, []int) {
 }
 
 func (x *MusicalWorkId) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *MusicalWorkId) GetOpusNumber() string {
-	if x != nil {
-		return x.OpusNumber
+	if x != nil && x.OpusNumber != nil {
+		return *x.OpusNumber
 	}
 	return ""
 }
@@ -14965,8 +15014,8 @@ func (x *MusicalWorkId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *MusicalWorkId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
@@ -14976,7 +15025,7 @@ type Name struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15019,22 +15068,22 @@ func (x *Name) GetValue() string {
 }
 
 func (x *Name) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type OperatingSystemType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"OperatingSystemType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"OperatingSystemType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15077,22 +15126,22 @@ func (x *OperatingSystemType) GetValue() string {
 }
 
 func (x *OperatingSystemType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *OperatingSystemType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *OperatingSystemType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -15100,17 +15149,17 @@ func (x *OperatingSystemType) GetUserDefinedValue() string {
 type PLine struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Year"
-	Year string `protobuf:"bytes,1,opt,name=year,proto3" json:"year,omitempty" xml:"Year"`
+	Year *string `protobuf:"bytes,1,opt,name=year,proto3,oneof" json:"year,omitempty" xml:"Year"`
 	// @gotags: xml:"PLineCompany"
-	PLineCompany string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3" json:"p_line_company,omitempty" xml:"PLineCompany"`
+	PLineCompany *string `protobuf:"bytes,2,opt,name=p_line_company,json=pLineCompany,proto3,oneof" json:"p_line_company,omitempty" xml:"PLineCompany"`
 	// @gotags: xml:"PLineText"
 	PLineText string `protobuf:"bytes,3,opt,name=p_line_text,json=pLineText,proto3" json:"p_line_text,omitempty" xml:"PLineText"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15146,15 +15195,15 @@ func (*PLine) Descriptor() ([]byte, []int) {
 }
 
 func (x *PLine) GetYear() string {
-	if x != nil {
-		return x.Year
+	if x != nil && x.Year != nil {
+		return *x.Year
 	}
 	return ""
 }
 
 func (x *PLine) GetPLineCompany() string {
-	if x != nil {
-		return x.PLineCompany
+	if x != nil && x.PLineCompany != nil {
+		return *x.PLineCompany
 	}
 	return ""
 }
@@ -15167,44 +15216,44 @@ func (x *PLine) GetPLineText() string {
 }
 
 func (x *PLine) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PLine) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *PLine) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type ParentalWarningTypeWithStandard struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	// @gotags: xml:",chardata" avs:"ParentalWarningType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ParentalWarningType"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,2,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"TypeNamespace,attr"
-	TypeNamespace string `protobuf:"bytes,4,opt,name=type_namespace,json=typeNamespace,proto3" json:"type_namespace,omitempty" xml:"TypeNamespace,attr"`
+	TypeNamespace *string `protobuf:"bytes,4,opt,name=type_namespace,json=typeNamespace,proto3,oneof" json:"type_namespace,omitempty" xml:"TypeNamespace,attr"`
 	// @gotags: xml:"TypeUserDefinedValue,attr"
-	TypeUserDefinedValue string `protobuf:"bytes,5,opt,name=type_user_defined_value,json=typeUserDefinedValue,proto3" json:"type_user_defined_value,omitempty" xml:"TypeUserDefinedValue,attr"`
-	// @gotags: xml:"ParentalWarningStandard,attr"
-	ParentalWarningStandard string `protobuf:"bytes,6,opt,name=parental_warning_standard,json=parentalWarningStandard,proto3" json:"parental_warning_standard,omitempty" xml:"ParentalWarningStandard,attr"`
+	TypeUserDefinedValue *string `protobuf:"bytes,5,opt,name=type_user_defined_value,json=typeUserDefinedValue,proto3,oneof" json:"type_user_defined_value,omitempty" xml:"TypeUserDefinedValue,attr"`
+	// @gotags: xml:"ParentalWarningStandard,attr" avs:"ParentalWarningStandard"
+	ParentalWarningStandard *string `protobuf:"bytes,6,opt,name=parental_warning_standard,json=parentalWarningStandard,proto3,oneof" json:"parental_warning_standard,omitempty" xml:"ParentalWarningStandard,attr" avs:"ParentalWarningStandard"`
 	// @gotags: xml:"StandardNamespace,attr"
-	StandardNamespace string `protobuf:"bytes,7,opt,name=standard_namespace,json=standardNamespace,proto3" json:"standard_namespace,omitempty" xml:"StandardNamespace,attr"`
+	StandardNamespace *string `protobuf:"bytes,7,opt,name=standard_namespace,json=standardNamespace,proto3,oneof" json:"standard_namespace,omitempty" xml:"StandardNamespace,attr"`
 	// @gotags: xml:"StandardUserDefinedValue,attr"
-	StandardUserDefinedValue string `protobuf:"bytes,8,opt,name=standard_user_defined_value,json=standardUserDefinedValue,proto3" json:"standard_user_defined_value,omitempty" xml:"StandardUserDefinedValue,attr"`
+	StandardUserDefinedValue *string `protobuf:"bytes,8,opt,name=standard_user_defined_value,json=standardUserDefinedValue,proto3,oneof" json:"standard_user_defined_value,omitempty" xml:"StandardUserDefinedValue,attr"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -15247,50 +15296,50 @@ func (x *ParentalWarningTypeWithStandard) GetValue() string {
 }
 
 func (x *ParentalWarningTypeWithStandard) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithStandard) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *ParentalWarningTypeWithStandard) GetTypeNamespace() string {
-	if x != nil {
-		return x.TypeNamespace
+	if x != nil && x.TypeNamespace != nil {
+		return *x.TypeNamespace
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithStandard) GetTypeUserDefinedValue() string {
-	if x != nil {
-		return x.TypeUserDefinedValue
+	if x != nil && x.TypeUserDefinedValue != nil {
+		return *x.TypeUserDefinedValue
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithStandard) GetParentalWarningStandard() string {
-	if x != nil {
-		return x.ParentalWarningStandard
+	if x != nil && x.ParentalWarningStandard != nil {
+		return *x.ParentalWarningStandard
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithStandard) GetStandardNamespace() string {
-	if x != nil {
-		return x.StandardNamespace
+	if x != nil && x.StandardNamespace != nil {
+		return *x.StandardNamespace
 	}
 	return ""
 }
 
 func (x *ParentalWarningTypeWithStandard) GetStandardUserDefinedValue() string {
-	if x != nil {
-		return x.StandardUserDefinedValue
+	if x != nil && x.StandardUserDefinedValue != nil {
+		return *x.StandardUserDefinedValue
 	}
 	return ""
 }
@@ -15300,7 +15349,7 @@ type PartyName struct {
 	// @gotags: xml:"FullName"
 	FullName *Name `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
 	FullNameIndexed *Name `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
@@ -15312,7 +15361,7 @@ type PartyName struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *Name `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15355,8 +15404,8 @@ func (x *PartyName) GetFullName() *Name {
 }
 
 func (x *PartyName) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
@@ -15397,8 +15446,8 @@ func (x *PartyName) GetAbbreviatedName() *Name {
 }
 
 func (x *PartyName) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -15408,17 +15457,17 @@ type PartyNameWithoutCode struct {
 	// @gotags: xml:"FullName"
 	FullName string `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
-	FullNameIndexed string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
+	FullNameIndexed *string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3,oneof" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
-	NamesBeforeKeyName string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
+	NamesBeforeKeyName *string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3,oneof" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
 	// @gotags: xml:"KeyName"
-	KeyName string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty" xml:"KeyName"`
+	KeyName *string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3,oneof" json:"key_name,omitempty" xml:"KeyName"`
 	// @gotags: xml:"NamesAfterKeyName"
-	NamesAfterKeyName string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
+	NamesAfterKeyName *string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3,oneof" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
 	// @gotags: xml:"AbbreviatedName"
-	AbbreviatedName string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
+	AbbreviatedName *string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3,oneof" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -15461,57 +15510,57 @@ func (x *PartyNameWithoutCode) GetFullName() string {
 }
 
 func (x *PartyNameWithoutCode) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetFullNameIndexed() string {
-	if x != nil {
-		return x.FullNameIndexed
+	if x != nil && x.FullNameIndexed != nil {
+		return *x.FullNameIndexed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesBeforeKeyName() string {
-	if x != nil {
-		return x.NamesBeforeKeyName
+	if x != nil && x.NamesBeforeKeyName != nil {
+		return *x.NamesBeforeKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetKeyName() string {
-	if x != nil {
-		return x.KeyName
+	if x != nil && x.KeyName != nil {
+		return *x.KeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesAfterKeyName() string {
-	if x != nil {
-		return x.NamesAfterKeyName
+	if x != nil && x.NamesAfterKeyName != nil {
+		return *x.NamesAfterKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetAbbreviatedName() string {
-	if x != nil {
-		return x.AbbreviatedName
+	if x != nil && x.AbbreviatedName != nil {
+		return *x.AbbreviatedName
 	}
 	return ""
 }
 
 type PartyRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PartyRelationshipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PartyRelationshipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"MayBeShared,attr"
-	MayBeShared   bool `protobuf:"varint,4,opt,name=may_be_shared,json=mayBeShared,proto3" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
+	MayBeShared   *bool `protobuf:"varint,4,opt,name=may_be_shared,json=mayBeShared,proto3,oneof" json:"may_be_shared,omitempty" xml:"MayBeShared,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15554,22 +15603,22 @@ func (x *PartyRelationshipType) GetValue() string {
 }
 
 func (x *PartyRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *PartyRelationshipType) GetMayBeShared() bool {
-	if x != nil {
-		return x.MayBeShared
+	if x != nil && x.MayBeShared != nil {
+		return *x.MayBeShared
 	}
 	return false
 }
@@ -15579,7 +15628,7 @@ type Percentage struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"HasMaxValueOfOne,attr"
-	HasMaxValueOfOne bool `protobuf:"varint,2,opt,name=has_max_value_of_one,json=hasMaxValueOfOne,proto3" json:"has_max_value_of_one,omitempty" xml:"HasMaxValueOfOne,attr"`
+	HasMaxValueOfOne *bool `protobuf:"varint,2,opt,name=has_max_value_of_one,json=hasMaxValueOfOne,proto3,oneof" json:"has_max_value_of_one,omitempty" xml:"HasMaxValueOfOne,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -15622,8 +15671,8 @@ func (x *Percentage) GetValue() string {
 }
 
 func (x *Percentage) GetHasMaxValueOfOne() bool {
-	if x != nil {
-		return x.HasMaxValueOfOne
+	if x != nil && x.HasMaxValueOfOne != nil {
+		return *x.HasMaxValueOfOne
 	}
 	return false
 }
@@ -15705,7 +15754,7 @@ type Prefix struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -15748,8 +15797,8 @@ func (x *Prefix) GetValue() string {
 }
 
 func (x *Prefix) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -15758,8 +15807,8 @@ type Price struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"CurrencyCode,attr"
-	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty" xml:"CurrencyCode,attr"`
+	// @gotags: xml:"CurrencyCode,attr" avs:"CurrencyCode"
+	CurrencyCode  string `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty" xml:"CurrencyCode,attr" avs:"CurrencyCode"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15867,7 +15916,7 @@ type PromotionalCode struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace     *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -15910,8 +15959,8 @@ func (x *PromotionalCode) GetValue() string {
 }
 
 func (x *PromotionalCode) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
@@ -15972,12 +16021,12 @@ func (x *ProprietaryId) GetNamespace() string {
 
 type Purpose struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Purpose"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Purpose"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16020,27 +16069,27 @@ func (x *Purpose) GetValue() string {
 }
 
 func (x *Purpose) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *Purpose) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RatingAgency struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RatingAgency"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RatingAgency"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16083,27 +16132,27 @@ func (x *RatingAgency) GetValue() string {
 }
 
 func (x *RatingAgency) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RatingAgency) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RatingReason struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RatingReason"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RatingReason"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16146,15 +16195,15 @@ func (x *RatingReason) GetValue() string {
 }
 
 func (x *RatingReason) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RatingReason) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16164,7 +16213,7 @@ type Reason struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -16207,8 +16256,8 @@ func (x *Reason) GetValue() string {
 }
 
 func (x *Reason) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -16269,12 +16318,12 @@ func (x *RelatedParty) GetPartyRelationshipType() *PartyRelationshipType {
 
 type ReleaseRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReleaseRelationshipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReleaseRelationshipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16317,27 +16366,27 @@ func (x *ReleaseRelationshipType) GetValue() string {
 }
 
 func (x *ReleaseRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ReleaseTypeForReleaseNotification struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReleaseTypeERN4"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReleaseTypeERN4"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16380,15 +16429,15 @@ func (x *ReleaseTypeForReleaseNotification) GetValue() string {
 }
 
 func (x *ReleaseTypeForReleaseNotification) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReleaseTypeForReleaseNotification) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16398,9 +16447,9 @@ type ResourceContainedResourceReference struct {
 	// @gotags: xml:"ResourceContainedResourceReference"
 	ResourceContainedResourceReference string `protobuf:"bytes,1,opt,name=resource_contained_resource_reference,json=resourceContainedResourceReference,proto3" json:"resource_contained_resource_reference,omitempty" xml:"ResourceContainedResourceReference"`
 	// @gotags: xml:"DurationUsed"
-	DurationUsed string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty" xml:"DurationUsed"`
+	DurationUsed *string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3,oneof" json:"duration_used,omitempty" xml:"DurationUsed"`
 	// @gotags: xml:"StartPoint"
-	StartPoint string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
+	StartPoint *string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3,oneof" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"Purpose"
 	Purpose       *Purpose `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty" xml:"Purpose"`
 	unknownFields protoimpl.UnknownFields
@@ -16445,15 +16494,15 @@ func (x *ResourceContainedResourceReference) GetResourceContainedResourceReferen
 }
 
 func (x *ResourceContainedResourceReference) GetDurationUsed() string {
-	if x != nil {
-		return x.DurationUsed
+	if x != nil && x.DurationUsed != nil {
+		return *x.DurationUsed
 	}
 	return ""
 }
 
 func (x *ResourceContainedResourceReference) GetStartPoint() string {
-	if x != nil {
-		return x.StartPoint
+	if x != nil && x.StartPoint != nil {
+		return *x.StartPoint
 	}
 	return ""
 }
@@ -16512,12 +16561,12 @@ func (x *ResourceContainedResourceReferenceList) GetResourceContainedResourceRef
 
 type ResourceContributorRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ResourceContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ResourceContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16560,15 +16609,15 @@ func (x *ResourceContributorRole) GetValue() string {
 }
 
 func (x *ResourceContributorRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ResourceContributorRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16576,25 +16625,25 @@ func (x *ResourceContributorRole) GetUserDefinedValue() string {
 type ResourceId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,8,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,9,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,10,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,10,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16630,50 +16679,50 @@ func (*ResourceId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ResourceId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *ResourceId) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -16693,8 +16742,8 @@ func (x *ResourceId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *ResourceId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
@@ -16704,7 +16753,7 @@ type ResourceProprietaryId struct {
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,1,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,2,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,2,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16747,20 +16796,20 @@ func (x *ResourceProprietaryId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *ResourceProprietaryId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type RightsClaimPolicyReason struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RightsClaimPolicyReason"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RightsClaimPolicyReason"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16803,27 +16852,27 @@ func (x *RightsClaimPolicyReason) GetValue() string {
 }
 
 func (x *RightsClaimPolicyReason) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RightsClaimPolicyReason) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RightsType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RightsCoverage"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RightsCoverage"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16866,15 +16915,15 @@ func (x *RightsType) GetValue() string {
 }
 
 func (x *RightsType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RightsType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -16883,8 +16932,8 @@ type SamplingRate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfMeasure,attr"
-	UnitOfMeasure string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr"`
+	// @gotags: xml:"UnitOfMeasure,attr" avs:"UnitOfFrequency"
+	UnitOfMeasure *string `protobuf:"bytes,2,opt,name=unit_of_measure,json=unitOfMeasure,proto3,oneof" json:"unit_of_measure,omitempty" xml:"UnitOfMeasure,attr" avs:"UnitOfFrequency"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -16927,20 +16976,20 @@ func (x *SamplingRate) GetValue() string {
 }
 
 func (x *SamplingRate) GetUnitOfMeasure() string {
-	if x != nil {
-		return x.UnitOfMeasure
+	if x != nil && x.UnitOfMeasure != nil {
+		return *x.UnitOfMeasure
 	}
 	return ""
 }
 
 type SessionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SessionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SessionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -16983,29 +17032,29 @@ func (x *SessionType) GetValue() string {
 }
 
 func (x *SessionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SessionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SheetMusicCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SheetMusicCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SheetMusicCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17048,22 +17097,22 @@ func (x *SheetMusicCodecType) GetValue() string {
 }
 
 func (x *SheetMusicCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *SheetMusicCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SheetMusicCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17071,11 +17120,11 @@ func (x *SheetMusicCodecType) GetUserDefinedValue() string {
 type SheetMusicId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,1,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,1,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,2,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,3,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,3,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17111,8 +17160,8 @@ func (*SheetMusicId) Descriptor() ([]byte, []int) {
 }
 
 func (x *SheetMusicId) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
@@ -17125,20 +17174,20 @@ func (x *SheetMusicId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *SheetMusicId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type SheetMusicType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SheetMusicType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SheetMusicType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17181,27 +17230,27 @@ func (x *SheetMusicType) GetValue() string {
 }
 
 func (x *SheetMusicType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SheetMusicType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SoftwareType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SoftwareType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SoftwareType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17244,15 +17293,15 @@ func (x *SoftwareType) GetValue() string {
 }
 
 func (x *SoftwareType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SoftwareType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17260,13 +17309,13 @@ func (x *SoftwareType) GetUserDefinedValue() string {
 type SoundRecordingId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,3,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,4,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,4,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17302,8 +17351,8 @@ func (*SoundRecordingId) Descriptor() ([]byte, []int) {
 }
 
 func (x *SoundRecordingId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
@@ -17323,20 +17372,20 @@ func (x *SoundRecordingId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *SoundRecordingId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type SoundRecordingType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SoundRecordingType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SoundRecordingType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17379,27 +17428,27 @@ func (x *SoundRecordingType) GetValue() string {
 }
 
 func (x *SoundRecordingType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SoundRecordingType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SpecialContributorType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SpecialContributorType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SpecialContributorType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17442,15 +17491,15 @@ func (x *SpecialContributorType) GetValue() string {
 }
 
 func (x *SpecialContributorType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SpecialContributorType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17511,12 +17560,12 @@ func (x *SubGenreCategory) GetDescription() []*TextWithoutTerritory {
 
 type SubGenreCategoryValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SubGenre"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SubGenre"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17559,29 +17608,29 @@ func (x *SubGenreCategoryValue) GetValue() string {
 }
 
 func (x *SubGenreCategoryValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SubGenreCategoryValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type TextCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"TextCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"TextCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17624,22 +17673,22 @@ func (x *TextCodecType) GetValue() string {
 }
 
 func (x *TextCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *TextCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17647,15 +17696,15 @@ func (x *TextCodecType) GetUserDefinedValue() string {
 type TextId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,1,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,1,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,2,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,2,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,3,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,3,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,5,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -17691,22 +17740,22 @@ func (*TextId) Descriptor() ([]byte, []int) {
 }
 
 func (x *TextId) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *TextId) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *TextId) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -17719,20 +17768,20 @@ func (x *TextId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *TextId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
 
 type TextType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"TextType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"TextType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17775,15 +17824,15 @@ func (x *TextType) GetValue() string {
 }
 
 func (x *TextType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17793,17 +17842,17 @@ type TextWithFormat struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17846,43 +17895,43 @@ func (x *TextWithFormat) GetValue() string {
 }
 
 func (x *TextWithFormat) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithFormat) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17892,17 +17941,17 @@ type TextWithoutTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -17944,44 +17993,44 @@ func (x *TextWithoutTerritory) GetValue() string {
 	return ""
 }
 
-func (x *TextWithoutTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+func (x *TextWithoutTerritory) GetLanguageAndScriptCode() string {
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithoutTerritory) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -17993,9 +18042,9 @@ type TitleDisplayInformation struct {
 	// @gotags: xml:"Prefix"
 	Prefix []*Prefix `protobuf:"bytes,2,rep,name=prefix,proto3" json:"prefix,omitempty" xml:"Prefix"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -18045,15 +18094,15 @@ func (x *TitleDisplayInformation) GetPrefix() []*Prefix {
 }
 
 func (x *TitleDisplayInformation) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TitleDisplayInformation) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -18115,15 +18164,15 @@ func (x *ValidityPeriod) GetEndDate() *EventDate {
 type Venue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VenueName"
-	VenueName string `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3" json:"venue_name,omitempty" xml:"VenueName"`
+	VenueName *string `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3,oneof" json:"venue_name,omitempty" xml:"VenueName"`
 	// @gotags: xml:"VenueAddress"
-	VenueAddress string `protobuf:"bytes,2,opt,name=venue_address,json=venueAddress,proto3" json:"venue_address,omitempty" xml:"VenueAddress"`
+	VenueAddress *string `protobuf:"bytes,2,opt,name=venue_address,json=venueAddress,proto3,oneof" json:"venue_address,omitempty" xml:"VenueAddress"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode *AllTerritoryCode `protobuf:"bytes,3,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"LocationCode"
-	LocationCode string `protobuf:"bytes,4,opt,name=location_code,json=locationCode,proto3" json:"location_code,omitempty" xml:"LocationCode"`
+	LocationCode *string `protobuf:"bytes,4,opt,name=location_code,json=locationCode,proto3,oneof" json:"location_code,omitempty" xml:"LocationCode"`
 	// @gotags: xml:"VenueRoom"
-	VenueRoom     string `protobuf:"bytes,5,opt,name=venue_room,json=venueRoom,proto3" json:"venue_room,omitempty" xml:"VenueRoom"`
+	VenueRoom     *string `protobuf:"bytes,5,opt,name=venue_room,json=venueRoom,proto3,oneof" json:"venue_room,omitempty" xml:"VenueRoom"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18159,15 +18208,15 @@ func (*Venue) Descriptor() ([]byte, []int) {
 }
 
 func (x *Venue) GetVenueName() string {
-	if x != nil {
-		return x.VenueName
+	if x != nil && x.VenueName != nil {
+		return *x.VenueName
 	}
 	return ""
 }
 
 func (x *Venue) GetVenueAddress() string {
-	if x != nil {
-		return x.VenueAddress
+	if x != nil && x.VenueAddress != nil {
+		return *x.VenueAddress
 	}
 	return ""
 }
@@ -18180,27 +18229,27 @@ func (x *Venue) GetTerritoryCode() *AllTerritoryCode {
 }
 
 func (x *Venue) GetLocationCode() string {
-	if x != nil {
-		return x.LocationCode
+	if x != nil && x.LocationCode != nil {
+		return *x.LocationCode
 	}
 	return ""
 }
 
 func (x *Venue) GetVenueRoom() string {
-	if x != nil {
-		return x.VenueRoom
+	if x != nil && x.VenueRoom != nil {
+		return *x.VenueRoom
 	}
 	return ""
 }
 
 type VersionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VersionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VersionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18243,29 +18292,29 @@ func (x *VersionType) GetValue() string {
 }
 
 func (x *VersionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VersionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type VideoCodecType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoCodecType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoCodecType"`
 	// @gotags: xml:"Version,attr"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version,attr"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18308,34 +18357,34 @@ func (x *VideoCodecType) GetValue() string {
 }
 
 func (x *VideoCodecType) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *VideoCodecType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoCodecType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type VideoDefinitionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VideoDefinitionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VideoDefinitionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -18378,15 +18427,15 @@ func (x *VideoDefinitionType) GetValue() string {
 }
 
 func (x *VideoDefinitionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VideoDefinitionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -18394,11 +18443,11 @@ func (x *VideoDefinitionType) GetUserDefinedValue() string {
 type VideoId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,2,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,3,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,4,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -18406,7 +18455,7 @@ type VideoId struct {
 	// @gotags: xml:"EIDR"
 	EIDR []string `protobuf:"bytes,6,rep,name=e_i_d_r,json=eIDR,proto3" json:"e_i_d_r,omitempty" xml:"EIDR"`
 	// @gotags: xml:"IsReplaced,attr"
-	IsReplaced    bool `protobuf:"varint,7,opt,name=is_replaced,json=isReplaced,proto3" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
+	IsReplaced    *bool `protobuf:"varint,7,opt,name=is_replaced,json=isReplaced,proto3,oneof" json:"is_replaced,omitempty" xml:"IsReplaced,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -18442,22 +18491,22 @@ func (*VideoId) Descriptor() ([]byte, []int) {
 }
 
 func (x *VideoId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *VideoId) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *VideoId) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
@@ -18484,8 +18533,8 @@ func (x *VideoId) GetEIDR() []string {
 }
 
 func (x *VideoId) GetIsReplaced() bool {
-	if x != nil {
-		return x.IsReplaced
+	if x != nil && x.IsReplaced != nil {
+		return *x.IsReplaced
 	}
 	return false
 }
@@ -18494,7 +18543,7 @@ var File_ddex_ern_v432_v432_proto protoreflect.FileDescriptor
 
 const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\n" +
-	"\x18ddex/ern/v432/v432.proto\x12\rddex.ern.v432\x1a\x1eddex/avs/vlatest/vlatest.proto\"\xc5\a\n" +
+	"\x18ddex/ern/v432/v432.proto\x12\rddex.ern.v432\x1a\x1eddex/avs/vlatest/vlatest.proto\"\xbe\b\n" +
 	"\x11NewReleaseMessage\x12C\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.ern.v432.MessageHeaderR\rmessageHeader\x12@\n" +
 	"\rrelease_admin\x18\x02 \x03(\v2\x1b.ddex.ern.v432.ReleaseAdminR\freleaseAdmin\x127\n" +
@@ -18505,15 +18554,19 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\fchapter_list\x18\x06 \x01(\v2\x1a.ddex.ern.v432.ChapterListR\vchapterList\x12=\n" +
 	"\frelease_list\x18\a \x01(\v2\x1a.ddex.ern.v432.ReleaseListR\vreleaseList\x124\n" +
 	"\tdeal_list\x18\b \x01(\v2\x17.ddex.ern.v432.DealListR\bdealList\x12e\n" +
-	"\x1asupplemental_document_list\x18\t \x01(\v2'.ddex.ern.v432.SupplementalDocumentListR\x18supplementalDocumentList\x12;\n" +
+	"\x1asupplemental_document_list\x18\t \x01(\v2'.ddex.ern.v432.SupplementalDocumentListR\x18supplementalDocumentList\x12@\n" +
 	"\x1arelease_profile_version_id\x18\n" +
-	" \x01(\tR\x17releaseProfileVersionId\x12J\n" +
-	"\"release_profile_variant_version_id\x18\v \x01(\tR\x1ereleaseProfileVariantVersionId\x12$\n" +
+	" \x01(\tH\x00R\x17releaseProfileVersionId\x88\x01\x01\x12O\n" +
+	"\"release_profile_variant_version_id\x18\v \x01(\tH\x01R\x1ereleaseProfileVariantVersionId\x88\x01\x01\x12$\n" +
 	"\x0eavs_version_id\x18\f \x01(\tR\favsVersionId\x127\n" +
 	"\x18language_and_script_code\x18\r \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
 	"\txmlns_ern\x18\x0e \x01(\tR\bxmlnsErn\x12\x1b\n" +
 	"\txmlns_xsi\x18\x0f \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\"\xe8\x02\n" +
+	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\x11 \x01(\tR\n" +
+	"rootPrefixB\x1d\n" +
+	"\x1b_release_profile_version_idB%\n" +
+	"#_release_profile_variant_version_idJ\x06\b\xa8F\x10\x90N\"\x91\x03\n" +
 	"\x13PurgeReleaseMessage\x12C\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.ern.v432.MessageHeaderR\rmessageHeader\x12C\n" +
 	"\x0epurged_release\x18\x02 \x01(\v2\x1c.ddex.ern.v432.PurgedReleaseR\rpurgedRelease\x12$\n" +
@@ -18521,42 +18574,53 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
 	"\txmlns_ern\x18\x05 \x01(\tR\bxmlnsErn\x12\x1b\n" +
 	"\txmlns_xsi\x18\x06 \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\"\xa6\x01\n" +
+	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\b \x01(\tR\n" +
+	"rootPrefixJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x1bAdministratingRecordCompany\x12C\n" +
 	"\x1erecord_company_party_reference\x18\x01 \x01(\tR\x1brecordCompanyPartyReference\x12B\n" +
-	"\x04role\x18\x02 \x01(\v2..ddex.ern.v432.AdministratingRecordCompanyRoleR\x04role\"\xab\x06\n" +
+	"\x04role\x18\x02 \x01(\v2..ddex.ern.v432.AdministratingRecordCompanyRoleR\x04roleJ\x06\b\xa8F\x10\x90N\"\xcf\a\n" +
 	"\x11AudioDeliveryFile\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12I\n" +
 	"\x10container_format\x18\x02 \x01(\v2\x1e.ddex.ern.v432.ContainerFormatR\x0fcontainerFormat\x12G\n" +
 	"\x10audio_codec_type\x18\x03 \x01(\v2\x1d.ddex.ern.v432.AudioCodecTypeR\x0eaudioCodecType\x121\n" +
 	"\bbit_rate\x18\x04 \x01(\v2\x16.ddex.ern.v432.BitRateR\abitRate\x12B\n" +
-	"\x11original_bit_rate\x18\x05 \x01(\v2\x16.ddex.ern.v432.BitRateR\x0foriginalBitRate\x12,\n" +
-	"\x12number_of_channels\x18\x06 \x01(\tR\x10numberOfChannels\x125\n" +
-	"\x17number_of_audio_objects\x18\a \x01(\x05R\x14numberOfAudioObjects\x12@\n" +
+	"\x11original_bit_rate\x18\x05 \x01(\v2\x16.ddex.ern.v432.BitRateR\x0foriginalBitRate\x121\n" +
+	"\x12number_of_channels\x18\x06 \x01(\tH\x00R\x10numberOfChannels\x88\x01\x01\x12:\n" +
+	"\x17number_of_audio_objects\x18\a \x01(\x05H\x01R\x14numberOfAudioObjects\x88\x01\x01\x12@\n" +
 	"\rsampling_rate\x18\b \x01(\v2\x1b.ddex.ern.v432.SamplingRateR\fsamplingRate\x12Q\n" +
-	"\x16original_sampling_rate\x18\t \x01(\v2\x1b.ddex.ern.v432.SamplingRateR\x14originalSamplingRate\x12&\n" +
+	"\x16original_sampling_rate\x18\t \x01(\v2\x1b.ddex.ern.v432.SamplingRateR\x14originalSamplingRate\x12+\n" +
 	"\x0fbits_per_sample\x18\n" +
-	" \x01(\x05R\rbitsPerSample\x12\x1a\n" +
-	"\bduration\x18\v \x01(\tR\bduration\x12\x1b\n" +
-	"\tbit_depth\x18\f \x01(\x05R\bbitDepth\x12'\n" +
+	" \x01(\x05H\x02R\rbitsPerSample\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\v \x01(\tH\x03R\bduration\x88\x01\x01\x12 \n" +
+	"\tbit_depth\x18\f \x01(\x05H\x04R\bbitDepth\x88\x01\x01\x12'\n" +
 	"\x04file\x18\r \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x12<\n" +
-	"\vfingerprint\x18\x0e \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x125\n" +
-	"\x17is_provided_in_delivery\x18\x0f \x01(\bR\x14isProvidedInDelivery\"\xe7\x01\n" +
+	"\vfingerprint\x18\x0e \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x12:\n" +
+	"\x17is_provided_in_delivery\x18\x0f \x01(\bH\x05R\x14isProvidedInDelivery\x88\x01\x01B\x15\n" +
+	"\x13_number_of_channelsB\x1a\n" +
+	"\x18_number_of_audio_objectsB\x12\n" +
+	"\x10_bits_per_sampleB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_bit_depthB\x1a\n" +
+	"\x18_is_provided_in_deliveryJ\x06\b\xa8F\x10\x90N\"\xa6\x02\n" +
 	"\bAvRating\x12\x16\n" +
 	"\x06rating\x18\x01 \x01(\tR\x06rating\x123\n" +
 	"\x06agency\x18\x02 \x01(\v2\x1b.ddex.ern.v432.RatingAgencyR\x06agency\x123\n" +
-	"\x06reason\x18\x03 \x01(\v2\x1b.ddex.ern.v432.RatingReasonR\x06reason\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x06reason\x18\x03 \x01(\v2\x1b.ddex.ern.v432.RatingReasonR\x06reason\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\"\xaf\x01\n" +
+	"is_default\x18\x05 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\x05Brand\x12'\n" +
 	"\x0fbrand_reference\x18\x01 \x01(\tR\x0ebrandReference\x127\n" +
 	"\bbrand_id\x18\x02 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\abrandId\x12D\n" +
 	"\n" +
-	"brand_name\x18\x03 \x03(\v2%.ddex.ern.v432.PartyNameWithTerritoryR\tbrandName\"b\n" +
+	"brand_name\x18\x03 \x03(\v2%.ddex.ern.v432.PartyNameWithTerritoryR\tbrandNameJ\x06\b\xa8F\x10\x90N\"j\n" +
 	"\aChannel\x12C\n" +
 	"\x0eproprietary_id\x18\x01 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x12\n" +
-	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\"\xf8\x05\n" +
+	"\x05u_r_l\x18\x02 \x03(\tR\x03uRLJ\x06\b\xa8F\x10\x90N\"\x9b\a\n" +
 	"\aChapter\x12+\n" +
 	"\x11chapter_reference\x18\x01 \x01(\tR\x10chapterReference\x127\n" +
 	"\n" +
@@ -18564,29 +18628,39 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x12display_title_text\x18\x03 \x03(\v2\x1f.ddex.ern.v432.DisplayTitleTextR\x10displayTitleText\x12@\n" +
 	"\rdisplay_title\x18\x04 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\fdisplayTitle\x12>\n" +
 	"\fformal_title\x18\x05 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\vformalTitle\x12B\n" +
-	"\x0egrouping_title\x18\x06 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\rgroupingTitle\x12'\n" +
-	"\x0fsequence_number\x18\a \x01(\x05R\x0esequenceNumber\x12<\n" +
+	"\x0egrouping_title\x18\x06 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\rgroupingTitle\x12,\n" +
+	"\x0fsequence_number\x18\a \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12<\n" +
 	"\vcontributor\x18\b \x03(\v2\x1a.ddex.ern.v432.ContributorR\vcontributor\x126\n" +
-	"\tcharacter\x18\t \x03(\v2\x18.ddex.ern.v432.CharacterR\tcharacter\x12D\n" +
+	"\tcharacter\x18\t \x03(\v2\x18.ddex.ern.v432.CharacterR\tcharacter\x12I\n" +
 	"\x1erepresentative_image_reference\x18\n" +
-	" \x01(\tR\x1crepresentativeImageReference\x12\x1d\n" +
+	" \x01(\tH\x01R\x1crepresentativeImageReference\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"start_time\x18\v \x01(\tR\tstartTime\x12\x1a\n" +
-	"\bduration\x18\f \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\r \x01(\tR\aendTime\x127\n" +
-	"\x18language_and_script_code\x18\x0e \x01(\tR\x15languageAndScriptCode\"x\n" +
+	"start_time\x18\v \x01(\tH\x02R\tstartTime\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\f \x01(\tH\x03R\bduration\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\r \x01(\tH\x04R\aendTime\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x0e \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB!\n" +
+	"\x1f_representative_image_referenceB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_durationB\v\n" +
+	"\t_end_timeB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\vChapterList\x120\n" +
-	"\achapter\x18\x01 \x03(\v2\x16.ddex.ern.v432.ChapterR\achapter\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xaa\x01\n" +
+	"\achapter\x18\x01 \x03(\v2\x16.ddex.ern.v432.ChapterR\achapter\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xcb\x01\n" +
 	"\tCharacter\x12:\n" +
 	"\x19character_party_reference\x18\x01 \x01(\tR\x17characterPartyReference\x128\n" +
-	"\tperformer\x18\x02 \x01(\v2\x1a.ddex.ern.v432.ContributorR\tperformer\x12'\n" +
-	"\x0fsequence_number\x18\x03 \x01(\x05R\x0esequenceNumber\"\xc4\x01\n" +
+	"\tperformer\x18\x02 \x01(\v2\x1a.ddex.ern.v432.ContributorR\tperformer\x12,\n" +
+	"\x0fsequence_number\x18\x03 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x82\x02\n" +
 	"\vClipDetails\x124\n" +
-	"\tclip_type\x18\x01 \x01(\v2\x17.ddex.ern.v432.ClipTypeR\bclipType\x12&\n" +
-	"\x0ftop_left_corner\x18\x02 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x03 \x01(\tR\x11bottomRightCorner\x12'\n" +
-	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionType\"\xbd\x05\n" +
+	"\tclip_type\x18\x01 \x01(\v2\x17.ddex.ern.v432.ClipTypeR\bclipType\x12+\n" +
+	"\x0ftop_left_corner\x18\x02 \x01(\tH\x00R\rtopLeftCorner\x88\x01\x01\x123\n" +
+	"\x13bottom_right_corner\x18\x03 \x01(\tH\x01R\x11bottomRightCorner\x88\x01\x01\x12'\n" +
+	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionTypeB\x12\n" +
+	"\x10_top_left_cornerB\x16\n" +
+	"\x14_bottom_right_cornerJ\x06\b\xa8F\x10\x90N\"\xc5\x05\n" +
 	"\vClipRelease\x12+\n" +
 	"\x11release_reference\x18\x01 \x01(\tR\x10releaseReference\x127\n" +
 	"\n" +
@@ -18599,22 +18673,27 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x17release_label_reference\x18\b \x03(\v2-.ddex.ern.v432.ReleaseLabelReferenceWithPartyR\x15releaseLabelReference\x12F\n" +
 	"\rdisplay_genre\x18\t \x03(\v2!.ddex.ern.v432.GenreWithTerritoryR\fdisplayGenre\x12F\n" +
 	"\x0frelated_release\x18\n" +
-	" \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\"w\n" +
+	" \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedReleaseJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13CommercialModelType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd2\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x90\x03\n" +
 	"\x1dConditionForRightsClaimPolicy\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x12\n" +
-	"\x04unit\x18\x02 \x01(\tR\x04unit\x12-\n" +
-	"\x12reference_creation\x18\x03 \x01(\tR\x11referenceCreation\x12-\n" +
-	"\x12relational_relator\x18\x04 \x01(\tR\x11relationalRelator\x12)\n" +
-	"\x10measurement_type\x18\x05 \x01(\tR\x0fmeasurementType\x120\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x122\n" +
+	"\x12reference_creation\x18\x03 \x01(\tH\x00R\x11referenceCreation\x88\x01\x01\x12-\n" +
+	"\x12relational_relator\x18\x04 \x01(\tR\x11relationalRelator\x12.\n" +
+	"\x10measurement_type\x18\x05 \x01(\tH\x01R\x0fmeasurementType\x88\x01\x01\x120\n" +
 	"\asegment\x18\x06 \x03(\v2\x16.ddex.ern.v432.SegmentR\asegment\x12L\n" +
-	"\x11service_exception\x18\a \x03(\v2\x1f.ddex.ern.v432.ServiceExceptionR\x10serviceException\"b\n" +
+	"\x11service_exception\x18\a \x03(\v2\x1f.ddex.ern.v432.ServiceExceptionR\x10serviceExceptionB\x15\n" +
+	"\x13_reference_creationB\x13\n" +
+	"\x11_measurement_typeJ\x06\b\xa8F\x10\x90N\"j\n" +
 	"\bCoreArea\x12&\n" +
 	"\x0ftop_left_corner\x18\x01 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x02 \x01(\tR\x11bottomRightCorner\"\xa7\b\n" +
+	"\x13bottom_right_corner\x18\x02 \x01(\tR\x11bottomRightCornerJ\x06\b\xa8F\x10\x90N\"\x96\t\n" +
 	"\x03Cue\x12;\n" +
 	"\fcue_use_type\x18\x01 \x01(\v2\x19.ddex.ern.v432.CueUseTypeR\n" +
 	"cueUseType\x12A\n" +
@@ -18622,169 +18701,223 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x0ecue_vocal_type\x18\x03 \x01(\v2\x1b.ddex.ern.v432.CueVocalTypeR\fcueVocalType\x12c\n" +
 	"\x1acue_visual_perception_type\x18\x04 \x01(\v2&.ddex.ern.v432.CueVisualPerceptionTypeR\x17cueVisualPerceptionType\x127\n" +
 	"\n" +
-	"cue_origin\x18\x05 \x01(\v2\x18.ddex.ern.v432.CueOriginR\tcueOrigin\x12M\n" +
+	"cue_origin\x18\x05 \x01(\v2\x18.ddex.ern.v432.CueOriginR\tcueOrigin\x12:\n" +
+	"\vresource_id\x18\x12 \x01(\v2\x19.ddex.ern.v432.ResourceIdR\n" +
+	"resourceId\x125\n" +
+	"\awork_id\x18\x13 \x01(\v2\x1c.ddex.ern.v432.MusicalWorkIdR\x06workId\x12M\n" +
 	"\x12display_title_text\x18\x06 \x03(\v2\x1f.ddex.ern.v432.DisplayTitleTextR\x10displayTitleText\x12@\n" +
 	"\rdisplay_title\x18\a \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\fdisplayTitle\x12>\n" +
 	"\fformal_title\x18\b \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\vformalTitle\x12B\n" +
 	"\x0egrouping_title\x18\t \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\rgroupingTitle\x12<\n" +
 	"\vcontributor\x18\n" +
-	" \x03(\v2\x1a.ddex.ern.v432.ContributorR\vcontributor\x12\x19\n" +
-	"\bis_dance\x18\v \x01(\bR\aisDance\x12.\n" +
-	"\x13has_musical_content\x18\f \x01(\bR\x11hasMusicalContent\x12+\n" +
+	" \x03(\v2\x1a.ddex.ern.v432.ContributorR\vcontributor\x12\x1e\n" +
+	"\bis_dance\x18\v \x01(\bH\x00R\aisDance\x88\x01\x01\x123\n" +
+	"\x13has_musical_content\x18\f \x01(\bH\x01R\x11hasMusicalContent\x88\x01\x01\x12+\n" +
 	"\x06p_line\x18\r \x03(\v2\x14.ddex.ern.v432.PLineR\x05pLine\x12+\n" +
-	"\x06c_line\x18\x0e \x03(\v2\x14.ddex.ern.v432.CLineR\x05cLine\x12\x1d\n" +
+	"\x06c_line\x18\x0e \x03(\v2\x14.ddex.ern.v432.CLineR\x05cLine\x12\"\n" +
 	"\n" +
-	"start_time\x18\x0f \x01(\tR\tstartTime\x12\x1a\n" +
-	"\bduration\x18\x10 \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\x11 \x01(\tR\aendTime\x12:\n" +
-	"\vresource_id\x18\x12 \x01(\v2\x19.ddex.ern.v432.ResourceIdR\n" +
-	"resourceId\x125\n" +
-	"\awork_id\x18\x13 \x01(\v2\x1c.ddex.ern.v432.MusicalWorkIdR\x06workId\"\xe3\x01\n" +
+	"start_time\x18\x0f \x01(\tH\x02R\tstartTime\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x10 \x01(\tH\x03R\bduration\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x11 \x01(\tH\x04R\aendTime\x88\x01\x01B\v\n" +
+	"\t_is_danceB\x16\n" +
+	"\x14_has_musical_contentB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_durationB\v\n" +
+	"\t_end_timeJ\x06\b\xa8F\x10\x90N\"\xeb\x01\n" +
 	"\bCueSheet\x12>\n" +
 	"\fcue_sheet_id\x18\x01 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\n" +
 	"cueSheetId\x12.\n" +
 	"\x13cue_sheet_reference\x18\x02 \x01(\tR\x11cueSheetReference\x12A\n" +
 	"\x0ecue_sheet_type\x18\x03 \x01(\v2\x1b.ddex.ern.v432.CueSheetTypeR\fcueSheetType\x12$\n" +
-	"\x03cue\x18\x04 \x03(\v2\x12.ddex.ern.v432.CueR\x03cue\"D\n" +
+	"\x03cue\x18\x04 \x03(\v2\x12.ddex.ern.v432.CueR\x03cueJ\x06\b\xa8F\x10\x90N\"L\n" +
 	"\fCueSheetList\x124\n" +
-	"\tcue_sheet\x18\x01 \x03(\v2\x17.ddex.ern.v432.CueSheetR\bcueSheet\"\xa6\x03\n" +
+	"\tcue_sheet\x18\x01 \x03(\v2\x17.ddex.ern.v432.CueSheetR\bcueSheetJ\x06\b\xa8F\x10\x90N\"\xd3\x03\n" +
 	"\x04Deal\x12%\n" +
-	"\x0edeal_reference\x18\x01 \x03(\tR\rdealReference\x12<\n" +
-	"\x1bis_communicated_out_of_band\x18\x02 \x01(\bR\x17isCommunicatedOutOfBand\x127\n" +
+	"\x0edeal_reference\x18\x01 \x03(\tR\rdealReference\x12A\n" +
+	"\x1bis_communicated_out_of_band\x18\x02 \x01(\bH\x00R\x17isCommunicatedOutOfBand\x88\x01\x01\x127\n" +
 	"\n" +
 	"deal_terms\x18\x03 \x01(\v2\x18.ddex.ern.v432.DealTermsR\tdealTerms\x12\x9b\x01\n" +
 	".deal_technical_resource_details_reference_list\x18\x04 \x01(\v28.ddex.ern.v432.DealTechnicalResourceDetailsReferenceListR)dealTechnicalResourceDetailsReferenceList\x12b\n" +
-	"\x19distribution_channel_page\x18\x05 \x03(\v2&.ddex.ern.v432.DistributionChannelPageR\x17distributionChannelPage\"\xfb\x01\n" +
+	"\x19distribution_channel_page\x18\x05 \x03(\v2&.ddex.ern.v432.DistributionChannelPageR\x17distributionChannelPageB\x1e\n" +
+	"\x1c_is_communicated_out_of_bandJ\x06\b\xa8F\x10\x90N\"\x83\x02\n" +
 	"\bDealList\x12=\n" +
 	"\frelease_deal\x18\x01 \x03(\v2\x1a.ddex.ern.v432.ReleaseDealR\vreleaseDeal\x12O\n" +
 	"\x12release_visibility\x18\x02 \x03(\v2 .ddex.ern.v432.ReleaseVisibilityR\x11releaseVisibility\x12_\n" +
-	"\x18track_release_visibility\x18\x03 \x03(\v2%.ddex.ern.v432.TrackReleaseVisibilityR\x16trackReleaseVisibility\"S\n" +
+	"\x18track_release_visibility\x18\x03 \x03(\v2%.ddex.ern.v432.TrackReleaseVisibilityR\x16trackReleaseVisibilityJ\x06\b\xa8F\x10\x90N\"[\n" +
 	"\x19DealResourceReferenceList\x126\n" +
-	"\x17deal_resource_reference\x18\x01 \x03(\tR\x15dealResourceReference\"\x85\x01\n" +
+	"\x17deal_resource_reference\x18\x01 \x03(\tR\x15dealResourceReferenceJ\x06\b\xa8F\x10\x90N\"\x8d\x01\n" +
 	")DealTechnicalResourceDetailsReferenceList\x12X\n" +
-	")deal_technical_resource_details_reference\x18\x01 \x03(\tR%dealTechnicalResourceDetailsReference\"\x9e\v\n" +
-	"\tDealTerms\x12K\n" +
+	")deal_technical_resource_details_reference\x18\x01 \x03(\tR%dealTechnicalResourceDetailsReferenceJ\x06\b\xa8F\x10\x90N\"\x82\f\n" +
+	"\tDealTerms\x12J\n" +
+	"\x0eterritory_code\x18\x0e \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
+	"\x17excluded_territory_code\x18\x0f \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12K\n" +
 	"\x0fvalidity_period\x18\x01 \x03(\v2\".ddex.ern.v432.PeriodWithStartDateR\x0evalidityPeriod\x12V\n" +
 	"\x15commercial_model_type\x18\x02 \x03(\v2\".ddex.ern.v432.CommercialModelTypeR\x13commercialModelType\x12=\n" +
 	"\buse_type\x18\x03 \x03(\v2\".ddex.ern.v432.DiscoverableUseTypeR\auseType\x12P\n" +
 	"\x13user_interface_type\x18\x04 \x03(\v2 .ddex.ern.v432.UserInterfaceTypeR\x11userInterfaceType\x12=\n" +
 	"\fcarrier_type\x18\x05 \x03(\v2\x1a.ddex.ern.v432.CarrierTypeR\vcarrierType\x12g\n" +
-	"\x17technical_instantiation\x18\x06 \x01(\v2..ddex.ern.v432.DealTermsTechnicalInstantiationR\x16technicalInstantiation\x12(\n" +
-	"\x10number_of_usages\x18\a \x01(\x05R\x0enumberOfUsages\x12P\n" +
+	"\x17technical_instantiation\x18\x06 \x01(\v2..ddex.ern.v432.DealTermsTechnicalInstantiationR\x16technicalInstantiation\x12-\n" +
+	"\x10number_of_usages\x18\a \x01(\x05H\x00R\x0enumberOfUsages\x88\x01\x01\x12E\n" +
+	"\x14distribution_channel\x18\x10 \x03(\v2\x12.ddex.ern.v432.DSPR\x13distributionChannel\x12V\n" +
+	"\x1dexcluded_distribution_channel\x18\x11 \x03(\v2\x12.ddex.ern.v432.DSPR\x1bexcludedDistributionChannel\x12P\n" +
 	"\x13rights_claim_policy\x18\b \x03(\v2 .ddex.ern.v432.RightsClaimPolicyR\x11rightsClaimPolicy\x12L\n" +
-	"\x11price_information\x18\t \x03(\v2\x1f.ddex.ern.v432.PriceInformationR\x10priceInformation\x12)\n" +
+	"\x11price_information\x18\t \x03(\v2\x1f.ddex.ern.v432.PriceInformationR\x10priceInformation\x12%\n" +
+	"\x0eis_promotional\x18\x12 \x01(\bR\risPromotional\x12I\n" +
+	"\x10promotional_code\x18\x13 \x01(\v2\x1e.ddex.ern.v432.PromotionalCodeR\x0fpromotionalCode\x12.\n" +
 	"\x11is_pre_order_deal\x18\n" +
-	" \x01(\bR\x0eisPreOrderDeal\x12w\n" +
+	" \x01(\bH\x01R\x0eisPreOrderDeal\x88\x01\x01\x12w\n" +
 	"#instant_gratification_resource_list\x18\v \x01(\v2(.ddex.ern.v432.DealResourceReferenceListR instantGratificationResourceList\x12I\n" +
-	"\x10physical_returns\x18\f \x01(\v2\x1e.ddex.ern.v432.PhysicalReturnsR\x0fphysicalReturns\x12@\n" +
-	"\x1dnumber_of_products_per_carton\x18\r \x01(\x05R\x19numberOfProductsPerCarton\x12J\n" +
-	"\x0eterritory_code\x18\x0e \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x0f \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12E\n" +
-	"\x14distribution_channel\x18\x10 \x03(\v2\x12.ddex.ern.v432.DSPR\x13distributionChannel\x12V\n" +
-	"\x1dexcluded_distribution_channel\x18\x11 \x03(\v2\x12.ddex.ern.v432.DSPR\x1bexcludedDistributionChannel\x12%\n" +
-	"\x0eis_promotional\x18\x12 \x01(\bR\risPromotional\x12I\n" +
-	"\x10promotional_code\x18\x13 \x01(\v2\x1e.ddex.ern.v432.PromotionalCodeR\x0fpromotionalCode\"\xcd\x01\n" +
+	"\x10physical_returns\x18\f \x01(\v2\x1e.ddex.ern.v432.PhysicalReturnsR\x0fphysicalReturns\x12E\n" +
+	"\x1dnumber_of_products_per_carton\x18\r \x01(\x05H\x02R\x19numberOfProductsPerCarton\x88\x01\x01B\x13\n" +
+	"\x11_number_of_usagesB\x14\n" +
+	"\x12_is_pre_order_dealB \n" +
+	"\x1e_number_of_products_per_cartonJ\x06\b\xa8F\x10\x90N\"\xea\x01\n" +
 	"\x1fDealTermsTechnicalInstantiation\x12V\n" +
-	"\x15video_definition_type\x18\x01 \x01(\v2\".ddex.ern.v432.VideoDefinitionTypeR\x13videoDefinitionType\x12\x1f\n" +
-	"\vcoding_type\x18\x02 \x01(\tR\n" +
-	"codingType\x121\n" +
-	"\bbit_rate\x18\x03 \x01(\v2\x16.ddex.ern.v432.BitRateR\abitRate\"x\n" +
+	"\x15video_definition_type\x18\x01 \x01(\v2\".ddex.ern.v432.VideoDefinitionTypeR\x13videoDefinitionType\x12$\n" +
+	"\vcoding_type\x18\x02 \x01(\tH\x00R\n" +
+	"codingType\x88\x01\x01\x121\n" +
+	"\bbit_rate\x18\x03 \x01(\v2\x16.ddex.ern.v432.BitRateR\abitRateB\x0e\n" +
+	"\f_coding_typeJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\x05Deity\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"\x85\x02\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x8d\x02\n" +
 	"\x14DelegatedUsageRights\x121\n" +
 	"\buse_type\x18\x01 \x03(\v2\x16.ddex.ern.v432.UseTypeR\auseType\x12T\n" +
 	"\x1bperiod_of_rights_delegation\x18\x02 \x01(\v2\x15.ddex.ern.v432.PeriodR\x18periodOfRightsDelegation\x12d\n" +
-	"\x1eterritory_of_rights_delegation\x18\x03 \x03(\v2\x1f.ddex.ern.v432.AllTerritoryCodeR\x1bterritoryOfRightsDelegation\"\xc4\x01\n" +
+	"\x1eterritory_of_rights_delegation\x18\x03 \x03(\v2\x1f.ddex.ern.v432.AllTerritoryCodeR\x1bterritoryOfRightsDelegationJ\x06\b\xa8F\x10\x90N\"\xa5\x02\n" +
 	"\x18DescriptionWithTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\x8c\x04\n" +
-	"\x1bDetailedResourceContributor\x122\n" +
-	"\x04role\x18\x01 \x03(\v2\x1e.ddex.ern.v432.ContributorRoleR\x04role\x12F\n" +
-	"\x0finstrument_type\x18\x02 \x03(\v2\x1d.ddex.ern.v432.InstrumentTypeR\x0einstrumentType\x12C\n" +
-	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bR\x1bhasMadeFeaturedContribution\x12G\n" +
-	" has_made_contracted_contribution\x18\x04 \x01(\bR\x1dhasMadeContractedContribution\x12F\n" +
-	"\x0fdisplay_credits\x18\x05 \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x129\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xff\x04\n" +
+	"\x1bDetailedResourceContributor\x129\n" +
 	"\bparty_id\x18\x06 \x03(\v2\x1e.ddex.ern.v432.DetailedPartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\a \x03(\v2\x18.ddex.ern.v432.PartyNameR\tpartyName\x12'\n" +
-	"\x0fsequence_number\x18\b \x01(\x05R\x0esequenceNumber\"\xa0\x01\n" +
+	"party_name\x18\a \x03(\v2\x18.ddex.ern.v432.PartyNameR\tpartyName\x122\n" +
+	"\x04role\x18\x01 \x03(\v2\x1e.ddex.ern.v432.ContributorRoleR\x04role\x12F\n" +
+	"\x0finstrument_type\x18\x02 \x03(\v2\x1d.ddex.ern.v432.InstrumentTypeR\x0einstrumentType\x12H\n" +
+	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bH\x00R\x1bhasMadeFeaturedContribution\x88\x01\x01\x12L\n" +
+	" has_made_contracted_contribution\x18\x04 \x01(\bH\x01R\x1dhasMadeContractedContribution\x88\x01\x01\x12F\n" +
+	"\x0fdisplay_credits\x18\x05 \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12,\n" +
+	"\x0fsequence_number\x18\b \x01(\x05H\x02R\x0esequenceNumber\x88\x01\x01B!\n" +
+	"\x1f_has_made_featured_contributionB#\n" +
+	"!_has_made_contracted_contributionB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xf0\x01\n" +
 	"\x13DiscoverableUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12'\n" +
-	"\x0fis_discoverable\x18\x03 \x01(\bR\x0eisDiscoverable\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\x8e\x04\n" +
-	"\rDisplayArtist\x12C\n" +
-	"\rartistic_role\x18\x01 \x03(\v2\x1e.ddex.ern.v432.ContributorRoleR\fartisticRole\x12b\n" +
-	"\x19title_display_information\x18\x02 \x03(\v2&.ddex.ern.v432.TitleDisplayInformationR\x17titleDisplayInformation\x12F\n" +
-	"\x0fdisplay_credits\x18\x03 \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12[\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x12,\n" +
+	"\x0fis_discoverable\x18\x03 \x01(\bH\x01R\x0eisDiscoverable\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x12\n" +
+	"\x10_is_discoverableB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaf\x04\n" +
+	"\rDisplayArtist\x12[\n" +
 	"\x16special_display_artist\x18\x04 \x01(\v2%.ddex.ern.v432.SpecialContributorTypeR\x14specialDisplayArtist\x124\n" +
 	"\x16artist_party_reference\x18\x05 \x01(\tR\x14artistPartyReference\x12P\n" +
-	"\x13display_artist_role\x18\x06 \x01(\v2 .ddex.ern.v432.DisplayArtistRoleR\x11displayArtistRole\x12'\n" +
-	"\x0fsequence_number\x18\a \x01(\x05R\x0esequenceNumber\"\xf3\x01\n" +
+	"\x13display_artist_role\x18\x06 \x01(\v2 .ddex.ern.v432.DisplayArtistRoleR\x11displayArtistRole\x12C\n" +
+	"\rartistic_role\x18\x01 \x03(\v2\x1e.ddex.ern.v432.ContributorRoleR\fartisticRole\x12b\n" +
+	"\x19title_display_information\x18\x02 \x03(\v2&.ddex.ern.v432.TitleDisplayInformationR\x17titleDisplayInformation\x12F\n" +
+	"\x0fdisplay_credits\x18\x03 \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12,\n" +
+	"\x0fsequence_number\x18\a \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xf5\x02\n" +
 	"\x10DisplayTitleText\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x125\n" +
-	"\x17is_in_original_language\x18\x05 \x01(\bR\x14isInOriginalLanguage\"\xb7\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x05 \x01(\bH\x03R\x14isInOriginalLanguage\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1a\n" +
+	"\x18_is_in_original_languageJ\x06\b\xa8F\x10\x90N\"\xe1\x01\n" +
 	"\x17DistributionChannelPage\x129\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x1e.ddex.ern.v432.DetailedPartyIdR\apartyId\x120\n" +
-	"\tpage_name\x18\x02 \x01(\v2\x13.ddex.ern.v432.NameR\bpageName\x12\x12\n" +
-	"\x05u_r_l\x18\x03 \x01(\tR\x03uRL\x12\x1b\n" +
-	"\tuser_name\x18\x04 \x01(\tR\buserName\"\xc4\x04\n" +
-	"\x12EditionContributor\x122\n" +
-	"\x04role\x18\x01 \x03(\v2\x1e.ddex.ern.v432.ContributorRoleR\x04role\x12'\n" +
-	"\x0fai_contribution\x18\x02 \x01(\tR\x0eaiContribution\x12C\n" +
-	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bR\x1bhasMadeFeaturedContribution\x12G\n" +
-	" has_made_contracted_contribution\x18\x04 \x01(\bR\x1dhasMadeContractedContribution\x12:\n" +
+	"\tpage_name\x18\x02 \x01(\v2\x13.ddex.ern.v432.NameR\bpageName\x12\x17\n" +
+	"\x05u_r_l\x18\x03 \x01(\tH\x00R\x03uRL\x88\x01\x01\x12 \n" +
+	"\tuser_name\x18\x04 \x01(\tH\x01R\buserName\x88\x01\x01B\b\n" +
+	"\x06_u_r_lB\f\n" +
+	"\n" +
+	"_user_nameJ\x06\b\xa8F\x10\x90N\"\xd0\x05\n" +
+	"\x12EditionContributor\x12>\n" +
+	"\x1bcontributor_party_reference\x18\a \x01(\tR\x19contributorPartyReference\x12V\n" +
+	"\x13special_contributor\x18\b \x01(\v2%.ddex.ern.v432.SpecialContributorTypeR\x12specialContributor\x122\n" +
+	"\x04role\x18\x01 \x03(\v2\x1e.ddex.ern.v432.ContributorRoleR\x04role\x12,\n" +
+	"\x0fai_contribution\x18\x02 \x01(\tH\x00R\x0eaiContribution\x88\x01\x01\x12H\n" +
+	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bH\x01R\x1bhasMadeFeaturedContribution\x88\x01\x01\x12L\n" +
+	" has_made_contracted_contribution\x18\x04 \x01(\bH\x02R\x1dhasMadeContractedContribution\x88\x01\x01\x12:\n" +
 	"\vis_credited\x18\x05 \x01(\v2\x19.ddex.ern.v432.IsCreditedR\n" +
 	"isCredited\x12F\n" +
-	"\x0fdisplay_credits\x18\x06 \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12>\n" +
-	"\x1bcontributor_party_reference\x18\a \x01(\tR\x19contributorPartyReference\x12V\n" +
-	"\x13special_contributor\x18\b \x01(\v2%.ddex.ern.v432.SpecialContributorTypeR\x12specialContributor\x12'\n" +
-	"\x0fsequence_number\x18\t \x01(\x05R\x0esequenceNumber\"\x80\x02\n" +
+	"\x0fdisplay_credits\x18\x06 \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12,\n" +
+	"\x0fsequence_number\x18\t \x01(\x05H\x03R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_ai_contributionB!\n" +
+	"\x1f_has_made_featured_contributionB#\n" +
+	"!_has_made_contracted_contributionB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x83\x03\n" +
 	"\x19EventDateTimeWithoutFlags\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x04 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"\x84\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x04 \x01(\tH\x02R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x87\x03\n" +
 	"\x1dEventDateWithCurrentTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x04 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"\xae\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x04 \x01(\tH\x02R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x85\x02\n" +
 	"\x14EventDateWithDefault\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xfc\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xff\x02\n" +
 	"\x15EventDateWithoutFlags\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x04 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"\xb0\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x04 \x01(\tH\x02R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xe4\x02\n" +
 	"\x14ExternalResourceLink\x12\x12\n" +
 	"\x05u_r_l\x18\x01 \x03(\tR\x03uRL\x12J\n" +
-	"\x0fvalidity_period\x18\x02 \x01(\v2!.ddex.ern.v432.PeriodWithoutFlagsR\x0evalidityPeriod\x12#\n" +
-	"\rexternal_link\x18\x03 \x01(\tR\fexternalLink\x12r\n" +
-	"\x1fexternally_linked_resource_type\x18\x04 \x03(\v2+.ddex.ern.v432.ExternallyLinkedResourceTypeR\x1cexternallyLinkedResourceType\x12\x1f\n" +
-	"\vfile_format\x18\x05 \x01(\tR\n" +
-	"fileFormat\"\x87\x01\n" +
+	"\x0fvalidity_period\x18\x02 \x01(\v2!.ddex.ern.v432.PeriodWithoutFlagsR\x0evalidityPeriod\x12(\n" +
+	"\rexternal_link\x18\x03 \x01(\tH\x00R\fexternalLink\x88\x01\x01\x12r\n" +
+	"\x1fexternally_linked_resource_type\x18\x04 \x03(\v2+.ddex.ern.v432.ExternallyLinkedResourceTypeR\x1cexternallyLinkedResourceType\x12$\n" +
+	"\vfile_format\x18\x05 \x01(\tH\x01R\n" +
+	"fileFormat\x88\x01\x01B\x10\n" +
+	"\x0e_external_linkB\x0e\n" +
+	"\f_file_formatJ\x06\b\xa8F\x10\x90N\"\xc2\x01\n" +
 	"\x1bHdrVideoDynamicMetadataType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x128\n" +
-	"\x18sdr_derivation_permitted\x18\x03 \x01(\bR\x16sdrDerivationPermitted\"\x8b\x0e\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12=\n" +
+	"\x18sdr_derivation_permitted\x18\x03 \x01(\bH\x01R\x16sdrDerivationPermitted\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\x1b\n" +
+	"\x19_sdr_derivation_permittedJ\x06\b\xa8F\x10\x90N\"\x85\x0f\n" +
 	"\x05Image\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12,\n" +
 	"\x04type\x18\x02 \x01(\v2\x18.ddex.ern.v432.ImageTypeR\x04type\x12E\n" +
@@ -18807,105 +18940,140 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x11 \x03(\v2\x1e.ddex.ern.v432.FulfillmentDateR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x12 \x03(\v2..ddex.ern.v432.ParentalWarningTypeWithStandardR\x13parentalWarningType\x12F\n" +
 	"\x0frelated_release\x18\x13 \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\x14 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12 \n" +
-	"\fcontains_a_i\x18\x15 \x01(\tR\n" +
-	"containsAI\x126\n" +
-	"\x17contains_hidden_content\x18\x16 \x01(\bR\x15containsHiddenContent\x12I\n" +
+	"\x10related_resource\x18\x14 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12%\n" +
+	"\fcontains_a_i\x18\x15 \x01(\tH\x00R\n" +
+	"containsAI\x88\x01\x01\x12;\n" +
+	"\x17contains_hidden_content\x18\x16 \x01(\bH\x01R\x15containsHiddenContent\x88\x01\x01\x12I\n" +
 	"\vdescription\x18\x17 \x03(\v2'.ddex.ern.v432.DescriptionWithTerritoryR\vdescription\x12Q\n" +
-	"\x11technical_details\x18\x18 \x03(\v2$.ddex.ern.v432.TechnicalImageDetailsR\x10technicalDetails\x127\n" +
-	"\x18language_and_script_code\x18\x19 \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x1a \x01(\bR\x0eisSupplemental\"\xb3\x02\n" +
+	"\x11technical_details\x18\x18 \x03(\v2$.ddex.ern.v432.TechnicalImageDetailsR\x10technicalDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x19 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x1a \x01(\bH\x03R\x0eisSupplemental\x88\x01\x01B\x0f\n" +
+	"\r_contains_a_iB\x1a\n" +
+	"\x18_contains_hidden_contentB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"\xd6\x03\n" +
 	"\x1eLinkedReleaseResourceReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12)\n" +
-	"\x10link_description\x18\x02 \x01(\tR\x0flinkDescription\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1c\n" +
-	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x05 \x01(\tR\x10userDefinedValue\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\x12\"\n" +
-	"\ris_multi_file\x18\a \x01(\bR\visMultiFile\"\xad\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
+	"\x10link_description\x18\x02 \x01(\tH\x00R\x0flinkDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x04 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x05 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x04R\x0esequenceNumber\x88\x01\x01\x12'\n" +
+	"\ris_multi_file\x18\a \x01(\bH\x05R\visMultiFile\x88\x01\x01B\x13\n" +
+	"\x11_link_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x12\n" +
+	"\x10_sequence_numberB\x10\n" +
+	"\x0e_is_multi_fileJ\x06\b\xa8F\x10\x90N\"\xb5\x02\n" +
 	"\x18LocationAndDateOfSession\x12=\n" +
 	"\fsession_type\x18\x01 \x03(\v2\x1a.ddex.ern.v432.SessionTypeR\vsessionType\x12-\n" +
 	"\x06period\x18\x02 \x01(\v2\x15.ddex.ern.v432.PeriodR\x06period\x12*\n" +
 	"\x05venue\x18\x03 \x03(\v2\x14.ddex.ern.v432.VenueR\x05venue\x127\n" +
 	"\acomment\x18\x04 \x01(\v2\x1d.ddex.ern.v432.TextWithFormatR\acomment\x12>\n" +
-	"\vcontributor\x18\x05 \x03(\v2\x1c.ddex.ern.v432.PartyWithRoleR\vcontributor\"\xe1\x02\n" +
+	"\vcontributor\x18\x05 \x03(\v2\x1c.ddex.ern.v432.PartyWithRoleR\vcontributorJ\x06\b\xa8F\x10\x90N\"\xe9\x02\n" +
 	"\x05Party\x12'\n" +
-	"\x0fparty_reference\x18\x01 \x01(\tR\x0epartyReference\x12<\n" +
-	"\vaffiliation\x18\x02 \x03(\v2\x1a.ddex.ern.v432.AffiliationR\vaffiliation\x12@\n" +
-	"\rrelated_party\x18\x03 \x03(\v2\x1b.ddex.ern.v432.RelatedPartyR\frelatedParty\x12.\n" +
-	"\x13artist_profile_page\x18\x04 \x03(\tR\x11artistProfilePage\x129\n" +
+	"\x0fparty_reference\x18\x01 \x01(\tR\x0epartyReference\x129\n" +
 	"\bparty_id\x18\x05 \x03(\v2\x1e.ddex.ern.v432.DetailedPartyIdR\apartyId\x12D\n" +
 	"\n" +
-	"party_name\x18\x06 \x03(\v2%.ddex.ern.v432.PartyNameWithTerritoryR\tpartyName\"c\n" +
+	"party_name\x18\x06 \x03(\v2%.ddex.ern.v432.PartyNameWithTerritoryR\tpartyName\x12<\n" +
+	"\vaffiliation\x18\x02 \x03(\v2\x1a.ddex.ern.v432.AffiliationR\vaffiliation\x12@\n" +
+	"\rrelated_party\x18\x03 \x03(\v2\x1b.ddex.ern.v432.RelatedPartyR\frelatedParty\x12.\n" +
+	"\x13artist_profile_page\x18\x04 \x03(\tR\x11artistProfilePageJ\x06\b\xa8F\x10\x90N\"k\n" +
 	"\tPartyList\x12*\n" +
 	"\x05party\x18\x01 \x03(\v2\x14.ddex.ern.v432.PartyR\x05party\x12*\n" +
-	"\x05brand\x18\x02 \x03(\v2\x14.ddex.ern.v432.BrandR\x05brand\"\xfc\x05\n" +
+	"\x05brand\x18\x02 \x03(\v2\x14.ddex.ern.v432.BrandR\x05brandJ\x06\b\xa8F\x10\x90N\"\xe6\a\n" +
 	"\x16PartyNameWithTerritory\x120\n" +
-	"\tfull_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12?\n" +
+	"\tfull_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12?\n" +
 	"\x11full_name_indexed\x18\x03 \x01(\v2\x13.ddex.ern.v432.NameR\x0ffullNameIndexed\x12F\n" +
 	"\x15names_before_key_name\x18\x04 \x01(\v2\x13.ddex.ern.v432.NameR\x12namesBeforeKeyName\x12.\n" +
 	"\bkey_name\x18\x05 \x01(\v2\x13.ddex.ern.v432.NameR\akeyName\x12D\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v2\x13.ddex.ern.v432.NameR\x11namesAfterKeyName\x12>\n" +
-	"\x10abbreviated_name\x18\a \x01(\v2\x13.ddex.ern.v432.NameR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\x12\x1f\n" +
-	"\vis_nickname\x18\t \x01(\bR\n" +
-	"isNickname\x12\"\n" +
+	"\x10abbreviated_name\x18\a \x01(\v2\x13.ddex.ern.v432.NameR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12$\n" +
+	"\vis_nickname\x18\t \x01(\bH\x02R\n" +
+	"isNickname\x88\x01\x01\x12'\n" +
 	"\ris_stage_name\x18\n" +
-	" \x01(\bR\visStageName\x12\"\n" +
-	"\ris_legal_name\x18\v \x01(\bR\visLegalName\x12:\n" +
-	"\x19applicable_territory_code\x18\f \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\bH\x03R\visStageName\x88\x01\x01\x12'\n" +
+	"\ris_legal_name\x18\v \x01(\bH\x04R\visLegalName\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\f \x01(\tH\x05R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\r \x01(\bR\tisDefault\x125\n" +
-	"\x17is_in_original_language\x18\x0e \x01(\bR\x14isInOriginalLanguage\"\xc0\x02\n" +
-	"\rPartyWithRole\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12C\n" +
+	"is_default\x18\r \x01(\bH\x06R\tisDefault\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x0e \x01(\bH\aR\x14isInOriginalLanguage\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x1b\n" +
+	"\x19_language_and_script_codeB\x0e\n" +
+	"\f_is_nicknameB\x10\n" +
+	"\x0e_is_stage_nameB\x10\n" +
+	"\x0e_is_legal_nameB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1a\n" +
+	"\x18_is_in_original_languageJ\x06\b\xa8F\x10\x90N\"\x92\x03\n" +
+	"\rPartyWithRole\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12C\n" +
 	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12D\n" +
 	"\n" +
 	"party_name\x18\x06 \x01(\v2%.ddex.ern.v432.PartyNameWithTerritoryR\tpartyName\x12:\n" +
-	"\x04role\x18\a \x01(\v2&.ddex.ern.v432.ResourceContributorRoleR\x04role\"\xcb\x02\n" +
+	"\x04role\x18\a \x01(\v2&.ddex.ern.v432.ResourceContributorRoleR\x04roleB\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
+	"\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nJ\x06\b\xa8F\x10\x90N\"\xd3\x02\n" +
 	"\x13PeriodWithStartDate\x12K\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2,.ddex.ern.v432.EventDateWithCurrentTerritoryR\tstartDate\x12G\n" +
 	"\bend_date\x18\x02 \x01(\v2,.ddex.ern.v432.EventDateWithCurrentTerritoryR\aendDate\x12P\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2(.ddex.ern.v432.EventDateTimeWithoutFlagsR\rstartDateTime\x12L\n" +
-	"\rend_date_time\x18\x04 \x01(\v2(.ddex.ern.v432.EventDateTimeWithoutFlagsR\vendDateTime\"\xca\x02\n" +
+	"\rend_date_time\x18\x04 \x01(\v2(.ddex.ern.v432.EventDateTimeWithoutFlagsR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"\xd2\x02\n" +
 	"\x12PeriodWithoutFlags\x12K\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2,.ddex.ern.v432.EventDateWithCurrentTerritoryR\tstartDate\x12G\n" +
 	"\bend_date\x18\x02 \x01(\v2,.ddex.ern.v432.EventDateWithCurrentTerritoryR\aendDate\x12P\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2(.ddex.ern.v432.EventDateTimeWithoutFlagsR\rstartDateTime\x12L\n" +
-	"\rend_date_time\x18\x04 \x01(\v2(.ddex.ern.v432.EventDateTimeWithoutFlagsR\vendDateTime\"\x93\x01\n" +
+	"\rend_date_time\x18\x04 \x01(\v2(.ddex.ern.v432.EventDateTimeWithoutFlagsR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"\xc5\x01\n" +
 	"\x0fPhysicalReturns\x128\n" +
-	"\x18physical_returns_allowed\x18\x01 \x01(\bR\x16physicalReturnsAllowed\x12F\n" +
-	" latest_date_for_physical_returns\x18\x02 \x01(\tR\x1clatestDateForPhysicalReturns\"\xb4\x03\n" +
+	"\x18physical_returns_allowed\x18\x01 \x01(\bR\x16physicalReturnsAllowed\x12K\n" +
+	" latest_date_for_physical_returns\x18\x02 \x01(\tH\x00R\x1clatestDateForPhysicalReturns\x88\x01\x01B#\n" +
+	"!_latest_date_for_physical_returnsJ\x06\b\xa8F\x10\x90N\"\xff\x03\n" +
 	"\x10PriceInformation\x127\n" +
 	"\n" +
 	"price_code\x18\x01 \x01(\v2\x18.ddex.ern.v432.PriceTypeR\tpriceCode\x12M\n" +
 	"\x18wholesale_price_per_unit\x18\x02 \x01(\v2\x14.ddex.ern.v432.PriceR\x15wholesalePricePerUnit\x12a\n" +
 	"#bulk_order_wholesale_price_per_unit\x18\x03 \x01(\v2\x14.ddex.ern.v432.PriceR\x1ebulkOrderWholesalePricePerUnit\x12J\n" +
-	"\x16suggested_retail_price\x18\x04 \x01(\v2\x14.ddex.ern.v432.PriceR\x14suggestedRetailPrice\x12\x1d\n" +
+	"\x16suggested_retail_price\x18\x04 \x01(\v2\x14.ddex.ern.v432.PriceR\x14suggestedRetailPrice\x12\"\n" +
+	"\n" +
+	"price_type\x18\x05 \x01(\tH\x00R\tpriceType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\r\n" +
+	"\v_price_typeB\f\n" +
 	"\n" +
-	"price_type\x18\x05 \x01(\tR\tpriceType\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xc2\x01\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xca\x01\n" +
 	"\rPurgedRelease\x127\n" +
 	"\n" +
 	"release_id\x18\x01 \x01(\v2\x18.ddex.ern.v432.ReleaseIdR\treleaseId\x12*\n" +
 	"\x05title\x18\x02 \x03(\v2\x14.ddex.ern.v432.TitleR\x05title\x12L\n" +
-	"\vcontributor\x18\x03 \x03(\v2*.ddex.ern.v432.DetailedResourceContributorR\vcontributor\"w\n" +
+	"\vcontributor\x18\x03 \x03(\v2*.ddex.ern.v432.DetailedResourceContributorR\vcontributorJ\x06\b\xa8F\x10\x90N\"\xb6\x01\n" +
 	"\x04Raga\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"s\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fRecordingFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xa9\x06\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb1\x06\n" +
 	"\x0eRelatedRelease\x12b\n" +
 	"\x19release_relationship_type\x18\x01 \x01(\v2&.ddex.ern.v432.ReleaseRelationshipTypeR\x17releaseRelationshipType\x127\n" +
 	"\n" +
@@ -18918,13 +19086,13 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x17release_label_reference\x18\b \x03(\v2$.ddex.ern.v432.ReleaseLabelReferenceR\x15releaseLabelReference\x12G\n" +
 	"\frelease_date\x18\t \x01(\v2$.ddex.ern.v432.EventDateWithoutFlagsR\vreleaseDate\x12X\n" +
 	"\x15original_release_date\x18\n" +
-	" \x01(\v2$.ddex.ern.v432.EventDateWithoutFlagsR\x13originalReleaseDate\"\x89\x02\n" +
+	" \x01(\v2$.ddex.ern.v432.EventDateWithoutFlagsR\x13originalReleaseDateJ\x06\b\xa8F\x10\x90N\"\x91\x02\n" +
 	"\x0fRelatedResource\x12<\n" +
-	"\x1aresource_relationship_type\x18\x01 \x01(\tR\x18resourceRelationshipType\x12-\n" +
-	"\x06timing\x18\x02 \x03(\v2\x15.ddex.ern.v432.TimingR\x06timing\x12M\n" +
+	"\x1aresource_relationship_type\x18\x01 \x01(\tR\x18resourceRelationshipType\x12M\n" +
 	"#resource_related_resource_reference\x18\x03 \x01(\tR resourceRelatedResourceReference\x12:\n" +
 	"\vresource_id\x18\x04 \x01(\v2\x19.ddex.ern.v432.ResourceIdR\n" +
-	"resourceId\"\x9b\x14\n" +
+	"resourceId\x12-\n" +
+	"\x06timing\x18\x02 \x03(\v2\x15.ddex.ern.v432.TimingR\x06timingJ\x06\b\xa8F\x10\x90N\"\xd5\x15\n" +
 	"\aRelease\x12+\n" +
 	"\x11release_reference\x18\x01 \x01(\tR\x10releaseReference\x12S\n" +
 	"\frelease_type\x18\x02 \x03(\v20.ddex.ern.v432.ReleaseTypeForReleaseNotificationR\vreleaseType\x127\n" +
@@ -18942,8 +19110,8 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x1dadministrating_record_company\x18\f \x03(\v2*.ddex.ern.v432.AdministratingRecordCompanyR\x1badministratingRecordCompany\x12+\n" +
 	"\x06p_line\x18\r \x03(\v2\x14.ddex.ern.v432.PLineR\x05pLine\x12+\n" +
 	"\x06c_line\x18\x0e \x03(\v2\x14.ddex.ern.v432.CLineR\x05cLine\x12@\n" +
-	"\rcourtesy_line\x18\x0f \x03(\v2\x1b.ddex.ern.v432.CourtesyLineR\fcourtesyLine\x12\x1a\n" +
-	"\bduration\x18\x10 \x01(\tR\bduration\x12F\n" +
+	"\rcourtesy_line\x18\x0f \x03(\v2\x1b.ddex.ern.v432.CourtesyLineR\fcourtesyLine\x12\x1f\n" +
+	"\bduration\x18\x10 \x01(\tH\x00R\bduration\x88\x01\x01\x12F\n" +
 	"\rdisplay_genre\x18\x11 \x03(\v2!.ddex.ern.v432.GenreWithTerritoryR\fdisplayGenre\x12F\n" +
 	"\frelease_date\x18\x12 \x03(\v2#.ddex.ern.v432.EventDateWithDefaultR\vreleaseDate\x12W\n" +
 	"\x15original_release_date\x18\x13 \x03(\v2#.ddex.ern.v432.EventDateWithDefaultR\x13originalReleaseDate\x12@\n" +
@@ -18951,97 +19119,134 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x15parental_warning_type\x18\x15 \x03(\v2..ddex.ern.v432.ParentalWarningTypeWithStandardR\x13parentalWarningType\x124\n" +
 	"\tav_rating\x18\x16 \x03(\v2\x17.ddex.ern.v432.AvRatingR\bavRating\x12F\n" +
 	"\x0frelated_release\x18\x17 \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\x18 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12C\n" +
+	"\x10related_resource\x18\x18 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12?\n" +
+	"\x1cis_single_artist_compilation\x18& \x01(\bR\x19isSingleArtistCompilation\x12=\n" +
+	"\x1bis_multi_artist_compilation\x18' \x01(\bR\x18isMultiArtistCompilation\x12C\n" +
 	"\x0eresource_group\x18\x19 \x01(\v2\x1c.ddex.ern.v432.ResourceGroupR\rresourceGroup\x12Y\n" +
-	"\x16external_resource_link\x18\x1a \x03(\v2#.ddex.ern.v432.ExternalResourceLinkR\x14externalResourceLink\x12\x1f\n" +
-	"\ftarget_u_r_l\x18\x1b \x01(\tR\ttargetURL\x12@\n" +
+	"\x16external_resource_link\x18\x1a \x03(\v2#.ddex.ern.v432.ExternalResourceLinkR\x14externalResourceLink\x12$\n" +
+	"\ftarget_u_r_l\x18\x1b \x01(\tH\x01R\ttargetURL\x88\x01\x01\x12@\n" +
 	"\bkeywords\x18\x1c \x03(\v2$.ddex.ern.v432.KeywordsWithTerritoryR\bkeywords\x12@\n" +
 	"\bsynopsis\x18\x1d \x03(\v2$.ddex.ern.v432.SynopsisWithTerritoryR\bsynopsis\x12'\n" +
 	"\x04raga\x18\x1e \x03(\v2\x13.ddex.ern.v432.RagaR\x04raga\x12'\n" +
 	"\x04tala\x18\x1f \x03(\v2\x13.ddex.ern.v432.TalaR\x04tala\x12*\n" +
-	"\x05deity\x18  \x03(\v2\x14.ddex.ern.v432.DeityR\x05deity\x127\n" +
-	"\x18hi_res_music_description\x18! \x01(\tR\x15hiResMusicDescription\x12 \n" +
-	"\fcontains_a_i\x18\" \x01(\tR\n" +
-	"containsAI\x12#\n" +
-	"\ris_soundtrack\x18# \x01(\bR\fisSoundtrack\x12%\n" +
-	"\x0fis_hi_res_music\x18$ \x01(\bR\fisHiResMusic\x12L\n" +
-	"\x11marketing_comment\x18% \x03(\v2\x1f.ddex.ern.v432.MarketingCommentR\x10marketingComment\x12?\n" +
-	"\x1cis_single_artist_compilation\x18& \x01(\bR\x19isSingleArtistCompilation\x12=\n" +
-	"\x1bis_multi_artist_compilation\x18' \x01(\bR\x18isMultiArtistCompilation\x127\n" +
-	"\x18language_and_script_code\x18( \x01(\tR\x15languageAndScriptCode\"\x9c\x01\n" +
+	"\x05deity\x18  \x03(\v2\x14.ddex.ern.v432.DeityR\x05deity\x12<\n" +
+	"\x18hi_res_music_description\x18! \x01(\tH\x02R\x15hiResMusicDescription\x88\x01\x01\x12%\n" +
+	"\fcontains_a_i\x18\" \x01(\tH\x03R\n" +
+	"containsAI\x88\x01\x01\x12(\n" +
+	"\ris_soundtrack\x18# \x01(\bH\x04R\fisSoundtrack\x88\x01\x01\x12*\n" +
+	"\x0fis_hi_res_music\x18$ \x01(\bH\x05R\fisHiResMusic\x88\x01\x01\x12L\n" +
+	"\x11marketing_comment\x18% \x03(\v2\x1f.ddex.ern.v432.MarketingCommentR\x10marketingComment\x12<\n" +
+	"\x18language_and_script_code\x18( \x01(\tH\x06R\x15languageAndScriptCode\x88\x01\x01B\v\n" +
+	"\t_durationB\x0f\n" +
+	"\r_target_u_r_lB\x1b\n" +
+	"\x19_hi_res_music_descriptionB\x0f\n" +
+	"\r_contains_a_iB\x10\n" +
+	"\x0e_is_soundtrackB\x12\n" +
+	"\x10_is_hi_res_musicB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xc3\x01\n" +
 	"\fReleaseAdmin\x12(\n" +
-	"\x10release_admin_id\x18\x01 \x01(\tR\x0ereleaseAdminId\x123\n" +
-	"\x15personnel_description\x18\x02 \x01(\tR\x14personnelDescription\x12-\n" +
-	"\x12system_description\x18\x03 \x03(\tR\x11systemDescription\"l\n" +
+	"\x10release_admin_id\x18\x01 \x01(\tR\x0ereleaseAdminId\x128\n" +
+	"\x15personnel_description\x18\x02 \x01(\tH\x00R\x14personnelDescription\x88\x01\x01\x12-\n" +
+	"\x12system_description\x18\x03 \x03(\tR\x11systemDescriptionB\x18\n" +
+	"\x16_personnel_descriptionJ\x06\b\xa8F\x10\x90N\"t\n" +
 	"\vReleaseDeal\x124\n" +
 	"\x16deal_release_reference\x18\x01 \x03(\tR\x14dealReleaseReference\x12'\n" +
-	"\x04deal\x18\x02 \x03(\v2\x13.ddex.ern.v432.DealR\x04deal\"\xc1\x01\n" +
-	"\tReleaseId\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_c_p_n\x18\x02 \x01(\tR\x04iCPN\x12C\n" +
+	"\x04deal\x18\x02 \x03(\v2\x13.ddex.ern.v432.DealR\x04dealJ\x06\b\xa8F\x10\x90N\"\xe9\x01\n" +
+	"\tReleaseId\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_c_p_n\x18\x02 \x01(\tH\x01R\x04iCPN\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\x03 \x01(\v2\x1c.ddex.ern.v432.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\"\xac\x02\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryIdB\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_c_p_nJ\x06\b\xa8F\x10\x90N\"\xd0\x03\n" +
 	"\x15ReleaseLabelReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\x12\x1d\n" +
+	"label_type\x18\x04 \x01(\tH\x02R\tlabelType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x03R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x04R\x10userDefinedValue\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\a \x01(\tH\x05R\x17applicableTerritoryCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_is_defaultB\r\n" +
+	"\v_label_typeB\f\n" +
 	"\n" +
-	"label_type\x18\x04 \x01(\tR\tlabelType\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\x12:\n" +
-	"\x19applicable_territory_code\x18\a \x01(\tR\x17applicableTerritoryCode\"\xe7\x02\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\xa9\x04\n" +
 	"\x1eReleaseLabelReferenceWithParty\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\x12\x1d\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"label_type\x18\x04 \x01(\tR\tlabelType\x120\n" +
-	"\x14access_control_party\x18\x05 \x01(\tR\x12accessControlParty\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\x12:\n" +
-	"\x19applicable_territory_code\x18\b \x01(\tR\x17applicableTerritoryCode\"\xc0\x01\n" +
+	"label_type\x18\x04 \x01(\tH\x02R\tlabelType\x88\x01\x01\x125\n" +
+	"\x14access_control_party\x18\x05 \x01(\tH\x03R\x12accessControlParty\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\b \x01(\tH\x06R\x17applicableTerritoryCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_is_defaultB\r\n" +
+	"\v_label_typeB\x17\n" +
+	"\x15_access_control_partyB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\xc8\x01\n" +
 	"\vReleaseList\x120\n" +
 	"\arelease\x18\x01 \x01(\v2\x16.ddex.ern.v432.ReleaseR\arelease\x12@\n" +
 	"\rtrack_release\x18\x02 \x03(\v2\x1b.ddex.ern.v432.TrackReleaseR\ftrackRelease\x12=\n" +
-	"\fclip_release\x18\x03 \x03(\v2\x1a.ddex.ern.v432.ClipReleaseR\vclipRelease\"\xc9\x04\n" +
+	"\fclip_release\x18\x03 \x03(\v2\x1a.ddex.ern.v432.ClipReleaseR\vclipReleaseJ\x06\b\xa8F\x10\x90N\"\x9d\x06\n" +
 	"\x11ReleaseVisibility\x121\n" +
-	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12D\n" +
-	"\x1frelease_display_start_date_time\x18\x02 \x01(\tR\x1breleaseDisplayStartDateTime\x12G\n" +
-	"!cover_art_preview_start_date_time\x18\x03 \x01(\tR\x1ccoverArtPreviewStartDateTime\x12X\n" +
-	"*full_track_listing_preview_start_date_time\x18\x04 \x01(\tR$fullTrackListingPreviewStartDateTime\x12>\n" +
-	"\x1cclip_preview_start_date_time\x18\x05 \x01(\tR\x18clipPreviewStartDateTime\x12J\n" +
+	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12J\n" +
 	"\x0eterritory_code\x18\x06 \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\a \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12/\n" +
-	"\x14do_not_display_dates\x18\b \x01(\bR\x11doNotDisplayDates\"\xf4\a\n" +
+	"\x17excluded_territory_code\x18\a \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12I\n" +
+	"\x1frelease_display_start_date_time\x18\x02 \x01(\tH\x00R\x1breleaseDisplayStartDateTime\x88\x01\x01\x12L\n" +
+	"!cover_art_preview_start_date_time\x18\x03 \x01(\tH\x01R\x1ccoverArtPreviewStartDateTime\x88\x01\x01\x12]\n" +
+	"*full_track_listing_preview_start_date_time\x18\x04 \x01(\tH\x02R$fullTrackListingPreviewStartDateTime\x88\x01\x01\x12C\n" +
+	"\x1cclip_preview_start_date_time\x18\x05 \x01(\tH\x03R\x18clipPreviewStartDateTime\x88\x01\x01\x124\n" +
+	"\x14do_not_display_dates\x18\b \x01(\bH\x04R\x11doNotDisplayDates\x88\x01\x01B\"\n" +
+	" _release_display_start_date_timeB$\n" +
+	"\"_cover_art_preview_start_date_timeB-\n" +
+	"+_full_track_listing_preview_start_date_timeB\x1f\n" +
+	"\x1d_clip_preview_start_date_timeB\x17\n" +
+	"\x15_do_not_display_datesJ\x06\b\xa8F\x10\x90N\"\xa7\b\n" +
 	"\rResourceGroup\x12M\n" +
 	"\x12display_title_text\x18\x01 \x03(\v2\x1f.ddex.ern.v432.DisplayTitleTextR\x10displayTitleText\x12@\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\fdisplayTitle\x12>\n" +
 	"\fformal_title\x18\x03 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\vformalTitle\x12B\n" +
-	"\x0egrouping_title\x18\x04 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\rgroupingTitle\x12'\n" +
-	"\x0fsequence_number\x18\x05 \x01(\x05R\x0esequenceNumber\x12C\n" +
+	"\x0egrouping_title\x18\x04 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\rgroupingTitle\x12,\n" +
+	"\x0fsequence_number\x18\x05 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12.\n" +
+	"\x13no_display_sequence\x18\f \x01(\bR\x11noDisplaySequence\x12)\n" +
+	"\x10display_sequence\x18\r \x01(\tR\x0fdisplaySequence\x12C\n" +
 	"\x0edisplay_artist\x18\x06 \x03(\v2\x1c.ddex.ern.v432.DisplayArtistR\rdisplayArtist\x12=\n" +
-	"\fcarrier_type\x18\a \x03(\v2\x1a.ddex.ern.v432.CarrierTypeR\vcarrierType\x12\x1a\n" +
-	"\bduration\x18\b \x01(\tR\bduration\x12F\n" +
+	"\fcarrier_type\x18\a \x03(\v2\x1a.ddex.ern.v432.CarrierTypeR\vcarrierType\x12\x1f\n" +
+	"\bduration\x18\b \x01(\tH\x01R\bduration\x88\x01\x01\x12G\n" +
+	" resource_group_release_reference\x18\x0e \x01(\tR\x1dresourceGroupReleaseReference\x127\n" +
+	"\n" +
+	"release_id\x18\x0f \x01(\v2\x18.ddex.ern.v432.ReleaseIdR\treleaseId\x12F\n" +
 	"\x0eresource_group\x18\t \x03(\v2\x1f.ddex.ern.v432.ResourceSubGroupR\rresourceGroup\x12f\n" +
 	"\x1bresource_group_content_item\x18\n" +
 	" \x03(\v2'.ddex.ern.v432.ResourceGroupContentItemR\x18resourceGroupContentItem\x12x\n" +
-	"!linked_release_resource_reference\x18\v \x03(\v2-.ddex.ern.v432.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12.\n" +
-	"\x13no_display_sequence\x18\f \x01(\bR\x11noDisplaySequence\x12)\n" +
-	"\x10display_sequence\x18\r \x01(\tR\x0fdisplaySequence\x12G\n" +
-	" resource_group_release_reference\x18\x0e \x01(\tR\x1dresourceGroupReleaseReference\x127\n" +
-	"\n" +
-	"release_id\x18\x0f \x01(\v2\x18.ddex.ern.v432.ReleaseIdR\treleaseId\"\x93\x04\n" +
-	"\x18ResourceGroupContentItem\x12'\n" +
-	"\x0fsequence_number\x18\x01 \x01(\x05R\x0esequenceNumber\x12<\n" +
-	"\x1arelease_resource_reference\x18\x02 \x01(\tR\x18releaseResourceReference\x12x\n" +
-	"!linked_release_resource_reference\x18\x03 \x03(\v2-.ddex.ern.v432.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12*\n" +
-	"\x11is_bonus_resource\x18\x04 \x01(\bR\x0fisBonusResource\x12I\n" +
-	"!is_instant_gratification_resource\x18\x05 \x01(\bR\x1eisInstantGratificationResource\x12D\n" +
-	"\x1fis_pre_order_incentive_resource\x18\x06 \x01(\bR\x1bisPreOrderIncentiveResource\x12.\n" +
+	"!linked_release_resource_reference\x18\v \x03(\v2-.ddex.ern.v432.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReferenceB\x12\n" +
+	"\x10_sequence_numberB\v\n" +
+	"\t_durationJ\x06\b\xa8F\x10\x90N\"\xa3\x05\n" +
+	"\x18ResourceGroupContentItem\x12,\n" +
+	"\x0fsequence_number\x18\x01 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12.\n" +
 	"\x13no_display_sequence\x18\a \x01(\bR\x11noDisplaySequence\x12)\n" +
-	"\x10display_sequence\x18\b \x01(\tR\x0fdisplaySequence\"\xc8\x02\n" +
+	"\x10display_sequence\x18\b \x01(\tR\x0fdisplaySequence\x12<\n" +
+	"\x1arelease_resource_reference\x18\x02 \x01(\tR\x18releaseResourceReference\x12x\n" +
+	"!linked_release_resource_reference\x18\x03 \x03(\v2-.ddex.ern.v432.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12/\n" +
+	"\x11is_bonus_resource\x18\x04 \x01(\bH\x01R\x0fisBonusResource\x88\x01\x01\x12N\n" +
+	"!is_instant_gratification_resource\x18\x05 \x01(\bH\x02R\x1eisInstantGratificationResource\x88\x01\x01\x12I\n" +
+	"\x1fis_pre_order_incentive_resource\x18\x06 \x01(\bH\x03R\x1bisPreOrderIncentiveResource\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x14\n" +
+	"\x12_is_bonus_resourceB$\n" +
+	"\"_is_instant_gratification_resourceB\"\n" +
+	" _is_pre_order_incentive_resourceJ\x06\b\xa8F\x10\x90N\"\xd0\x02\n" +
 	"\fResourceList\x12F\n" +
 	"\x0fsound_recording\x18\x01 \x03(\v2\x1d.ddex.ern.v432.SoundRecordingR\x0esoundRecording\x12*\n" +
 	"\x05video\x18\x02 \x03(\v2\x14.ddex.ern.v432.VideoR\x05video\x12*\n" +
@@ -19049,49 +19254,52 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x04text\x18\x04 \x03(\v2\x13.ddex.ern.v432.TextR\x04text\x12:\n" +
 	"\vsheet_music\x18\x05 \x03(\v2\x19.ddex.ern.v432.SheetMusicR\n" +
 	"sheetMusic\x123\n" +
-	"\bsoftware\x18\x06 \x03(\v2\x17.ddex.ern.v432.SoftwareR\bsoftware\"\x9a\x03\n" +
+	"\bsoftware\x18\x06 \x03(\v2\x17.ddex.ern.v432.SoftwareR\bsoftwareJ\x06\b\xa8F\x10\x90N\"\xbb\x03\n" +
 	"\x18ResourceRightsController\x12I\n" +
 	"!rights_controller_party_reference\x18\x01 \x01(\tR\x1erightsControllerPartyReference\x12.\n" +
-	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x12Y\n" +
-	"\x16delegated_usage_rights\x18\x03 \x03(\v2#.ddex.ern.v432.DelegatedUsageRightsR\x14delegatedUsageRights\x12.\n" +
+	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x12.\n" +
 	"\x13right_share_unknown\x18\x04 \x01(\bR\x11rightShareUnknown\x12O\n" +
-	"\x16right_share_percentage\x18\x05 \x01(\v2\x19.ddex.ern.v432.PercentageR\x14rightSharePercentage\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\"\xa7\b\n" +
+	"\x16right_share_percentage\x18\x05 \x01(\v2\x19.ddex.ern.v432.PercentageR\x14rightSharePercentage\x12Y\n" +
+	"\x16delegated_usage_rights\x18\x03 \x03(\v2#.ddex.ern.v432.DelegatedUsageRightsR\x14delegatedUsageRights\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xda\b\n" +
 	"\x10ResourceSubGroup\x12M\n" +
 	"\x12display_title_text\x18\x01 \x03(\v2\x1f.ddex.ern.v432.DisplayTitleTextR\x10displayTitleText\x12@\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\fdisplayTitle\x12>\n" +
 	"\fformal_title\x18\x03 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\vformalTitle\x12B\n" +
-	"\x0egrouping_title\x18\x04 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\rgroupingTitle\x12'\n" +
-	"\x0fsequence_number\x18\x05 \x01(\x05R\x0esequenceNumber\x12C\n" +
+	"\x0egrouping_title\x18\x04 \x03(\v2\x1b.ddex.ern.v432.DisplayTitleR\rgroupingTitle\x12,\n" +
+	"\x0fsequence_number\x18\x05 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12.\n" +
+	"\x13no_display_sequence\x18\f \x01(\bR\x11noDisplaySequence\x12)\n" +
+	"\x10display_sequence\x18\r \x01(\tR\x0fdisplaySequence\x12C\n" +
 	"\x0edisplay_artist\x18\x06 \x03(\v2\x1c.ddex.ern.v432.DisplayArtistR\rdisplayArtist\x12=\n" +
-	"\fcarrier_type\x18\a \x03(\v2\x1a.ddex.ern.v432.CarrierTypeR\vcarrierType\x12\x1a\n" +
-	"\bduration\x18\b \x01(\tR\bduration\x12F\n" +
+	"\fcarrier_type\x18\a \x03(\v2\x1a.ddex.ern.v432.CarrierTypeR\vcarrierType\x12\x1f\n" +
+	"\bduration\x18\b \x01(\tH\x01R\bduration\x88\x01\x01\x12G\n" +
+	" resource_group_release_reference\x18\x0e \x01(\tR\x1dresourceGroupReleaseReference\x127\n" +
+	"\n" +
+	"release_id\x18\x0f \x01(\v2\x18.ddex.ern.v432.ReleaseIdR\treleaseId\x12F\n" +
 	"\x0eresource_group\x18\t \x03(\v2\x1f.ddex.ern.v432.ResourceSubGroupR\rresourceGroup\x12f\n" +
 	"\x1bresource_group_content_item\x18\n" +
 	" \x03(\v2'.ddex.ern.v432.ResourceGroupContentItemR\x18resourceGroupContentItem\x12x\n" +
 	"!linked_release_resource_reference\x18\v \x03(\v2-.ddex.ern.v432.LinkedReleaseResourceReferenceR\x1elinkedReleaseResourceReference\x12.\n" +
-	"\x13no_display_sequence\x18\f \x01(\bR\x11noDisplaySequence\x12)\n" +
-	"\x10display_sequence\x18\r \x01(\tR\x0fdisplaySequence\x12G\n" +
-	" resource_group_release_reference\x18\x0e \x01(\tR\x1dresourceGroupReleaseReference\x127\n" +
-	"\n" +
-	"release_id\x18\x0f \x01(\v2\x18.ddex.ern.v432.ReleaseIdR\treleaseId\x12.\n" +
-	"\x13resource_group_type\x18\x10 \x01(\tR\x11resourceGroupType\"\xfd\x01\n" +
+	"\x13resource_group_type\x18\x10 \x01(\tR\x11resourceGroupTypeB\x12\n" +
+	"\x10_sequence_numberB\v\n" +
+	"\t_durationJ\x06\b\xa8F\x10\x90N\"\x85\x02\n" +
 	"\x11RightsClaimPolicy\x12J\n" +
 	"\tcondition\x18\x01 \x03(\v2,.ddex.ern.v432.ConditionForRightsClaimPolicyR\tcondition\x127\n" +
 	"\x18rights_claim_policy_type\x18\x02 \x01(\tR\x15rightsClaimPolicyType\x12c\n" +
-	"\x1arights_claim_policy_reason\x18\x03 \x01(\v2&.ddex.ern.v432.RightsClaimPolicyReasonR\x17rightsClaimPolicyReason\"_\n" +
+	"\x1arights_claim_policy_reason\x18\x03 \x01(\v2&.ddex.ern.v432.RightsClaimPolicyReasonR\x17rightsClaimPolicyReasonJ\x06\b\xa8F\x10\x90N\"g\n" +
 	"\aSegment\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x1a\n" +
 	"\bduration\x18\x02 \x01(\tR\bduration\x12\x19\n" +
-	"\bend_time\x18\x03 \x01(\tR\aendTime\"\x84\x02\n" +
-	"\x10ServiceException\x126\n" +
-	"\ftrading_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\vtradingName\x12\x12\n" +
-	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x120\n" +
-	"\achannel\x18\x03 \x03(\v2\x16.ddex.ern.v432.ChannelR\achannel\x129\n" +
+	"\bend_time\x18\x03 \x01(\tR\aendTimeJ\x06\b\xa8F\x10\x90N\"\x8c\x02\n" +
+	"\x10ServiceException\x129\n" +
 	"\bparty_id\x18\x04 \x03(\v2\x1e.ddex.ern.v432.DetailedPartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x05 \x03(\v2\x18.ddex.ern.v432.PartyNameR\tpartyName\"\x9d\x0f\n" +
+	"party_name\x18\x05 \x03(\v2\x18.ddex.ern.v432.PartyNameR\tpartyName\x126\n" +
+	"\ftrading_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\vtradingName\x12\x12\n" +
+	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x120\n" +
+	"\achannel\x18\x03 \x03(\v2\x16.ddex.ern.v432.ChannelR\achannelJ\x06\b\xa8F\x10\x90N\"\x9d\x10\n" +
 	"\n" +
 	"SheetMusic\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x121\n" +
@@ -19116,13 +19324,17 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x12 \x03(\v2\x1e.ddex.ern.v432.FulfillmentDateR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x13 \x03(\v2..ddex.ern.v432.ParentalWarningTypeWithStandardR\x13parentalWarningType\x12F\n" +
 	"\x0frelated_release\x18\x14 \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\x15 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x126\n" +
-	"\x17contains_hidden_content\x18\x16 \x01(\bR\x15containsHiddenContent\x12,\n" +
-	"\x12language_of_lyrics\x18\x17 \x01(\tR\x10languageOfLyrics\x12\x91\x01\n" +
+	"\x10related_resource\x18\x15 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12;\n" +
+	"\x17contains_hidden_content\x18\x16 \x01(\bH\x00R\x15containsHiddenContent\x88\x01\x01\x121\n" +
+	"\x12language_of_lyrics\x18\x17 \x01(\tH\x01R\x10languageOfLyrics\x88\x01\x01\x12\x91\x01\n" +
 	"*resource_contained_resource_reference_list\x18\x18 \x01(\v25.ddex.ern.v432.ResourceContainedResourceReferenceListR&resourceContainedResourceReferenceList\x12V\n" +
-	"\x11technical_details\x18\x19 \x03(\v2).ddex.ern.v432.TechnicalSheetMusicDetailsR\x10technicalDetails\x127\n" +
-	"\x18language_and_script_code\x18\x1a \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x1b \x01(\bR\x0eisSupplemental\"\x9f\x0f\n" +
+	"\x11technical_details\x18\x19 \x03(\v2).ddex.ern.v432.TechnicalSheetMusicDetailsR\x10technicalDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x1a \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x1b \x01(\bH\x03R\x0eisSupplemental\x88\x01\x01B\x1a\n" +
+	"\x18_contains_hidden_contentB\x15\n" +
+	"\x13_language_of_lyricsB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"\x83\x10\n" +
 	"\bSoftware\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12/\n" +
 	"\x04type\x18\x02 \x01(\v2\x1b.ddex.ern.v432.SoftwareTypeR\x04type\x12E\n" +
@@ -19147,12 +19359,15 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x13 \x03(\v2\x1e.ddex.ern.v432.FulfillmentDateR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x14 \x03(\v2..ddex.ern.v432.ParentalWarningTypeWithStandardR\x13parentalWarningType\x12F\n" +
 	"\x0frelated_release\x18\x15 \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\x16 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x126\n" +
-	"\x17contains_hidden_content\x18\x17 \x01(\bR\x15containsHiddenContent\x12\x91\x01\n" +
+	"\x10related_resource\x18\x16 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12;\n" +
+	"\x17contains_hidden_content\x18\x17 \x01(\bH\x00R\x15containsHiddenContent\x88\x01\x01\x12\x91\x01\n" +
 	"*resource_contained_resource_reference_list\x18\x18 \x01(\v25.ddex.ern.v432.ResourceContainedResourceReferenceListR&resourceContainedResourceReferenceList\x12T\n" +
-	"\x11technical_details\x18\x19 \x03(\v2'.ddex.ern.v432.TechnicalSoftwareDetailsR\x10technicalDetails\x127\n" +
-	"\x18language_and_script_code\x18\x1a \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x1b \x01(\bR\x0eisSupplemental\"\xfd\x16\n" +
+	"\x11technical_details\x18\x19 \x03(\v2'.ddex.ern.v432.TechnicalSoftwareDetailsR\x10technicalDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x1a \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x1b \x01(\bH\x02R\x0eisSupplemental\x88\x01\x01B\x1a\n" +
+	"\x18_contains_hidden_contentB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"\x9c\x1a\n" +
 	"\x0eSoundRecording\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x125\n" +
 	"\x04type\x18\x02 \x01(\v2!.ddex.ern.v432.SoundRecordingTypeR\x04type\x12\\\n" +
@@ -19180,139 +19395,207 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x1clocation_and_date_of_session\x18\x17 \x03(\v2'.ddex.ern.v432.LocationAndDateOfSessionR\x18locationAndDateOfSession\x12b\n" +
 	"\x15parental_warning_type\x18\x18 \x03(\v2..ddex.ern.v432.ParentalWarningTypeWithStandardR\x13parentalWarningType\x12F\n" +
 	"\x0frelated_release\x18\x19 \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\x1a \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12=\n" +
-	"\x1bcomposite_musical_work_type\x18\x1b \x01(\tR\x18compositeMusicalWorkType\x12 \n" +
-	"\fcontains_a_i\x18\x1c \x01(\tR\n" +
-	"containsAI\x12\x19\n" +
-	"\bis_cover\x18\x1d \x01(\bR\aisCover\x122\n" +
-	"\x15has_vocal_performance\x18\x1e \x01(\bR\x13hasVocalPerformance\x12G\n" +
-	" has_foreground_vocal_performance\x18\x1f \x01(\bR\x1dhasForegroundVocalPerformance\x12'\n" +
-	"\x0fis_instrumental\x18  \x01(\bR\x0eisInstrumental\x126\n" +
-	"\x17contains_hidden_content\x18! \x01(\bR\x15containsHiddenContent\x12#\n" +
-	"\ris_remastered\x18\" \x01(\bR\fisRemastered\x12%\n" +
-	"\x0fis_hi_res_music\x18# \x01(\bR\fisHiResMusic\x12+\n" +
-	"\x11disable_crossfade\x18$ \x01(\bR\x10disableCrossfade\x12%\n" +
-	"\x0edisable_search\x18% \x01(\bR\rdisableSearch\x12F\n" +
+	"\x10related_resource\x18\x1a \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12B\n" +
+	"\x1bcomposite_musical_work_type\x18\x1b \x01(\tH\x00R\x18compositeMusicalWorkType\x88\x01\x01\x12%\n" +
+	"\fcontains_a_i\x18\x1c \x01(\tH\x01R\n" +
+	"containsAI\x88\x01\x01\x12\x1e\n" +
+	"\bis_cover\x18\x1d \x01(\bH\x02R\aisCover\x88\x01\x01\x127\n" +
+	"\x15has_vocal_performance\x18\x1e \x01(\bH\x03R\x13hasVocalPerformance\x88\x01\x01\x12L\n" +
+	" has_foreground_vocal_performance\x18\x1f \x01(\bH\x04R\x1dhasForegroundVocalPerformance\x88\x01\x01\x12,\n" +
+	"\x0fis_instrumental\x18  \x01(\bH\x05R\x0eisInstrumental\x88\x01\x01\x12;\n" +
+	"\x17contains_hidden_content\x18! \x01(\bH\x06R\x15containsHiddenContent\x88\x01\x01\x12(\n" +
+	"\ris_remastered\x18\" \x01(\bH\aR\fisRemastered\x88\x01\x01\x12*\n" +
+	"\x0fis_hi_res_music\x18# \x01(\bH\bR\fisHiResMusic\x88\x01\x01\x120\n" +
+	"\x11disable_crossfade\x18$ \x01(\bH\tR\x10disableCrossfade\x88\x01\x01\x12*\n" +
+	"\x0edisable_search\x18% \x01(\bH\n" +
+	"R\rdisableSearch\x88\x01\x01\x12F\n" +
 	"\x0fdisplay_credits\x18& \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12O\n" +
 	"\x17language_of_performance\x18' \x03(\v2\x17.ddex.ern.v432.LanguageR\x15languageOfPerformance\x12'\n" +
 	"\x04raga\x18( \x03(\v2\x13.ddex.ern.v432.RagaR\x04raga\x12'\n" +
 	"\x04tala\x18) \x03(\v2\x13.ddex.ern.v432.TalaR\x04tala\x12*\n" +
 	"\x05deity\x18* \x03(\v2\x14.ddex.ern.v432.DeityR\x05deity\x126\n" +
-	"\x17audio_chapter_reference\x18+ \x03(\tR\x15audioChapterReference\x127\n" +
-	"\x18language_and_script_code\x18, \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18- \x01(\bR\x0eisSupplemental\x12E\n" +
-	"\x1fapply_classical_profile_variant\x18. \x01(\bR\x1capplyClassicalProfileVariant\"\xc1\x02\n" +
+	"\x17audio_chapter_reference\x18+ \x03(\tR\x15audioChapterReference\x12<\n" +
+	"\x18language_and_script_code\x18, \x01(\tH\vR\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18- \x01(\bH\fR\x0eisSupplemental\x88\x01\x01\x12J\n" +
+	"\x1fapply_classical_profile_variant\x18. \x01(\bH\rR\x1capplyClassicalProfileVariant\x88\x01\x01B\x1e\n" +
+	"\x1c_composite_musical_work_typeB\x0f\n" +
+	"\r_contains_a_iB\v\n" +
+	"\t_is_coverB\x18\n" +
+	"\x16_has_vocal_performanceB#\n" +
+	"!_has_foreground_vocal_performanceB\x12\n" +
+	"\x10_is_instrumentalB\x1a\n" +
+	"\x18_contains_hidden_contentB\x10\n" +
+	"\x0e_is_remasteredB\x12\n" +
+	"\x10_is_hi_res_musicB\x14\n" +
+	"\x12_disable_crossfadeB\x11\n" +
+	"\x0f_disable_searchB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalB\"\n" +
+	" _apply_classical_profile_variantJ\x06\b\xa8F\x10\x90N\"\xc9\x02\n" +
 	"\x19SoundRecordingClipDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x124\n" +
 	"\tclip_type\x18\x02 \x01(\v2\x17.ddex.ern.v432.ClipTypeR\bclipType\x12-\n" +
 	"\x06timing\x18\x03 \x03(\v2\x15.ddex.ern.v432.TimingR\x06timing\x12'\n" +
 	"\x0fexpression_type\x18\x04 \x01(\tR\x0eexpressionType\x12E\n" +
-	"\rdelivery_file\x18\x05 \x03(\v2 .ddex.ern.v432.AudioDeliveryFileR\fdeliveryFile\"\xf1\x02\n" +
-	"\x15SoundRecordingEdition\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12@\n" +
+	"\rdelivery_file\x18\x05 \x03(\v2 .ddex.ern.v432.AudioDeliveryFileR\fdeliveryFileJ\x06\b\xa8F\x10\x90N\"\x9f\x03\n" +
+	"\x15SoundRecordingEdition\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12@\n" +
 	"\vresource_id\x18\x02 \x03(\v2\x1f.ddex.ern.v432.SoundRecordingIdR\n" +
 	"resourceId\x12R\n" +
 	"\x13edition_contributor\x18\x03 \x03(\v2!.ddex.ern.v432.EditionContributorR\x12editionContributor\x12+\n" +
-	"\x06p_line\x18\x04 \x03(\v2\x14.ddex.ern.v432.PLineR\x05pLine\x12%\n" +
-	"\x0erecording_mode\x18\x05 \x01(\tR\rrecordingMode\x12Z\n" +
-	"\x11technical_details\x18\x06 \x03(\v2-.ddex.ern.v432.TechnicalSoundRecordingDetailsR\x10technicalDetails\"d\n" +
+	"\x06p_line\x18\x04 \x03(\v2\x14.ddex.ern.v432.PLineR\x05pLine\x12*\n" +
+	"\x0erecording_mode\x18\x05 \x01(\tH\x01R\rrecordingMode\x88\x01\x01\x12Z\n" +
+	"\x11technical_details\x18\x06 \x03(\v2-.ddex.ern.v432.TechnicalSoundRecordingDetailsR\x10technicalDetailsB\a\n" +
+	"\x05_typeB\x11\n" +
+	"\x0f_recording_modeJ\x06\b\xa8F\x10\x90N\"l\n" +
 	"\x18SupplementalDocumentList\x12H\n" +
-	"\x15supplemental_document\x18\x01 \x03(\v2\x13.ddex.ern.v432.FileR\x14supplementalDocument\"\xed\x01\n" +
+	"\x15supplemental_document\x18\x01 \x03(\v2\x13.ddex.ern.v432.FileR\x14supplementalDocumentJ\x06\b\xa8F\x10\x90N\"\xe9\x02\n" +
 	"\x15SynopsisWithTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12*\n" +
-	"\x11is_short_synopsis\x18\x05 \x01(\bR\x0fisShortSynopsis\"w\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12/\n" +
+	"\x11is_short_synopsis\x18\x05 \x01(\bH\x03R\x0fisShortSynopsis\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x14\n" +
+	"\x12_is_short_synopsisJ\x06\b\xa8F\x10\x90N\"\xb6\x01\n" +
 	"\x04Tala\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"\xd5\x06\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xaa\b\n" +
 	"\x15TechnicalImageDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12G\n" +
 	"\x10image_codec_type\x18\x02 \x01(\v2\x1d.ddex.ern.v432.ImageCodecTypeR\x0eimageCodecType\x128\n" +
 	"\fimage_height\x18\x03 \x01(\v2\x15.ddex.ern.v432.ExtentR\vimageHeight\x126\n" +
 	"\vimage_width\x18\x04 \x01(\v2\x15.ddex.ern.v432.ExtentR\n" +
 	"imageWidth\x12=\n" +
-	"\faspect_ratio\x18\x05 \x03(\v2\x1a.ddex.ern.v432.AspectRatioR\vaspectRatio\x12\x1f\n" +
-	"\vcolor_depth\x18\x06 \x01(\x05R\n" +
-	"colorDepth\x12)\n" +
-	"\x10image_resolution\x18\a \x01(\x05R\x0fimageResolution\x12\x1b\n" +
-	"\tbit_depth\x18\b \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\t \x01(\bR\x06isClip\x12=\n" +
+	"\faspect_ratio\x18\x05 \x03(\v2\x1a.ddex.ern.v432.AspectRatioR\vaspectRatio\x12$\n" +
+	"\vcolor_depth\x18\x06 \x01(\x05H\x00R\n" +
+	"colorDepth\x88\x01\x01\x12.\n" +
+	"\x10image_resolution\x18\a \x01(\x05H\x01R\x0fimageResolution\x88\x01\x01\x12 \n" +
+	"\tbit_depth\x18\b \x01(\x05H\x02R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\t \x01(\bH\x03R\x06isClip\x88\x01\x01\x12=\n" +
 	"\fclip_details\x18\n" +
 	" \x03(\v2\x1a.ddex.ern.v432.ClipDetailsR\vclipDetails\x12'\n" +
-	"\x04file\x18\v \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\f \x01(\bR\x14isProvidedInDelivery\x12<\n" +
-	"\vfingerprint\x18\r \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\x0e \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x0f \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x04file\x18\v \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\f \x01(\bH\x04R\x14isProvidedInDelivery\x88\x01\x01\x12<\n" +
+	"\vfingerprint\x18\r \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\x0e \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x0f \x01(\tH\x06R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x10 \x01(\bH\aR\tisDefault\x88\x01\x01B\x0e\n" +
+	"\f_color_depthB\x13\n" +
+	"\x11_image_resolutionB\f\n" +
+	"\n" +
+	"_bit_depthB\n" +
 	"\n" +
-	"is_default\x18\x10 \x01(\bR\tisDefault\"\xed\x04\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x93\x06\n" +
 	"\x1aTechnicalSheetMusicDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12W\n" +
-	"\x16sheet_music_codec_type\x18\x02 \x01(\v2\".ddex.ern.v432.SheetMusicCodecTypeR\x13sheetMusicCodecType\x12\x1b\n" +
-	"\tbit_depth\x18\x03 \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12=\n" +
+	"\x16sheet_music_codec_type\x18\x02 \x01(\v2\".ddex.ern.v432.SheetMusicCodecTypeR\x13sheetMusicCodecType\x12 \n" +
+	"\tbit_depth\x18\x03 \x01(\x05H\x00R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12=\n" +
 	"\fclip_details\x18\x05 \x03(\v2\x1a.ddex.ern.v432.ClipDetailsR\vclipDetails\x12'\n" +
-	"\x04file\x18\x06 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\a \x01(\bR\x14isProvidedInDelivery\x12<\n" +
-	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\x12:\n" +
+	"\x04file\x18\x06 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\a \x01(\bH\x02R\x14isProvidedInDelivery\x88\x01\x01\x12<\n" +
+	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
 	"\x19applicable_territory_code\x18\n" +
-	" \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\tH\x04R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\v \x01(\bR\tisDefault\"\xea\x04\n" +
+	"is_default\x18\v \x01(\bH\x05R\tisDefault\x88\x01\x01B\f\n" +
+	"\n" +
+	"_bit_depthB\n" +
+	"\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x90\x06\n" +
 	"\x18TechnicalSoftwareDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12V\n" +
-	"\x15operating_system_type\x18\x02 \x01(\v2\".ddex.ern.v432.OperatingSystemTypeR\x13operatingSystemType\x12\x1b\n" +
-	"\tbit_depth\x18\x03 \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12=\n" +
+	"\x15operating_system_type\x18\x02 \x01(\v2\".ddex.ern.v432.OperatingSystemTypeR\x13operatingSystemType\x12 \n" +
+	"\tbit_depth\x18\x03 \x01(\x05H\x00R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12=\n" +
 	"\fclip_details\x18\x05 \x03(\v2\x1a.ddex.ern.v432.ClipDetailsR\vclipDetails\x12'\n" +
-	"\x04file\x18\x06 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\a \x01(\bR\x14isProvidedInDelivery\x12<\n" +
-	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\x12:\n" +
+	"\x04file\x18\x06 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\a \x01(\bH\x02R\x14isProvidedInDelivery\x88\x01\x01\x12<\n" +
+	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
 	"\x19applicable_territory_code\x18\n" +
-	" \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\tH\x04R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\v \x01(\bH\x05R\tisDefault\x88\x01\x01B\f\n" +
+	"\n" +
+	"_bit_depthB\n" +
 	"\n" +
-	"is_default\x18\v \x01(\bR\tisDefault\"\xf3\x03\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x8b\x05\n" +
 	"\x1eTechnicalSoundRecordingDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12E\n" +
-	"\rdelivery_file\x18\x02 \x03(\v2 .ddex.ern.v432.AudioDeliveryFileR\fdeliveryFile\x12?\n" +
-	"\x1chas_immersive_audio_metadata\x18\x03 \x01(\bR\x19hasImmersiveAudioMetadata\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12K\n" +
-	"\fclip_details\x18\x05 \x03(\v2(.ddex.ern.v432.SoundRecordingClipDetailsR\vclipDetails\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\a \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\rdelivery_file\x18\x02 \x03(\v2 .ddex.ern.v432.AudioDeliveryFileR\fdeliveryFile\x12D\n" +
+	"\x1chas_immersive_audio_metadata\x18\x03 \x01(\bH\x00R\x19hasImmersiveAudioMetadata\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12K\n" +
+	"\fclip_details\x18\x05 \x03(\v2(.ddex.ern.v432.SoundRecordingClipDetailsR\vclipDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\a \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\b \x01(\bR\tisDefault\"\xd4\x04\n" +
+	"is_default\x18\b \x01(\bH\x04R\tisDefault\x88\x01\x01B\x1f\n" +
+	"\x1d_has_immersive_audio_metadataB\n" +
+	"\n" +
+	"\b_is_clipB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xfa\x05\n" +
 	"\x14TechnicalTextDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12D\n" +
-	"\x0ftext_codec_type\x18\x02 \x01(\v2\x1c.ddex.ern.v432.TextCodecTypeR\rtextCodecType\x12\x1b\n" +
-	"\tbit_depth\x18\x03 \x01(\x05R\bbitDepth\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12=\n" +
+	"\x0ftext_codec_type\x18\x02 \x01(\v2\x1c.ddex.ern.v432.TextCodecTypeR\rtextCodecType\x12 \n" +
+	"\tbit_depth\x18\x03 \x01(\x05H\x00R\bbitDepth\x88\x01\x01\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x01R\x06isClip\x88\x01\x01\x12=\n" +
 	"\fclip_details\x18\x05 \x03(\v2\x1a.ddex.ern.v432.ClipDetailsR\vclipDetails\x12'\n" +
-	"\x04file\x18\x06 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x125\n" +
-	"\x17is_provided_in_delivery\x18\a \x01(\bR\x14isProvidedInDelivery\x12<\n" +
-	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x127\n" +
-	"\x18language_and_script_code\x18\t \x01(\tR\x15languageAndScriptCode\x12:\n" +
+	"\x04file\x18\x06 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x12:\n" +
+	"\x17is_provided_in_delivery\x18\a \x01(\bH\x02R\x14isProvidedInDelivery\x88\x01\x01\x12<\n" +
+	"\vfingerprint\x18\b \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x12<\n" +
+	"\x18language_and_script_code\x18\t \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
 	"\x19applicable_territory_code\x18\n" +
-	" \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	" \x01(\tH\x04R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\v \x01(\bH\x05R\tisDefault\x88\x01\x01B\f\n" +
 	"\n" +
-	"is_default\x18\v \x01(\bR\tisDefault\"\xe2\x03\n" +
+	"_bit_depthB\n" +
+	"\n" +
+	"\b_is_clipB\x1a\n" +
+	"\x18_is_provided_in_deliveryB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xd4\x04\n" +
 	"\x15TechnicalVideoDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x12@\n" +
 	"\x10overall_bit_rate\x18\x02 \x01(\v2\x16.ddex.ern.v432.BitRateR\x0eoverallBitRate\x12E\n" +
-	"\rdelivery_file\x18\x03 \x03(\v2 .ddex.ern.v432.VideoDeliveryFileR\fdeliveryFile\x12\x17\n" +
-	"\ais_clip\x18\x04 \x01(\bR\x06isClip\x12B\n" +
-	"\fclip_details\x18\x05 \x03(\v2\x1f.ddex.ern.v432.VideoClipDetailsR\vclipDetails\x127\n" +
-	"\x18language_and_script_code\x18\x06 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\a \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\rdelivery_file\x18\x03 \x03(\v2 .ddex.ern.v432.VideoDeliveryFileR\fdeliveryFile\x12\x1c\n" +
+	"\ais_clip\x18\x04 \x01(\bH\x00R\x06isClip\x88\x01\x01\x12B\n" +
+	"\fclip_details\x18\x05 \x03(\v2\x1f.ddex.ern.v432.VideoClipDetailsR\vclipDetails\x12<\n" +
+	"\x18language_and_script_code\x18\x06 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\a \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bH\x03R\tisDefault\x88\x01\x01B\n" +
 	"\n" +
-	"is_default\x18\b \x01(\bR\tisDefault\"\xbc\x0f\n" +
+	"\b_is_clipB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xb6\x10\n" +
 	"\x04Text\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12+\n" +
 	"\x04type\x18\x02 \x01(\v2\x17.ddex.ern.v432.TextTypeR\x04type\x126\n" +
@@ -19336,27 +19619,38 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x16first_publication_date\x18\x12 \x03(\v2\x1e.ddex.ern.v432.FulfillmentDateR\x14firstPublicationDate\x12b\n" +
 	"\x15parental_warning_type\x18\x13 \x03(\v2..ddex.ern.v432.ParentalWarningTypeWithStandardR\x13parentalWarningType\x12F\n" +
 	"\x0frelated_release\x18\x14 \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\x15 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12 \n" +
-	"\fcontains_a_i\x18\x16 \x01(\tR\n" +
-	"containsAI\x126\n" +
-	"\x17contains_hidden_content\x18\x17 \x01(\bR\x15containsHiddenContent\x12\x91\x01\n" +
+	"\x10related_resource\x18\x15 \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12%\n" +
+	"\fcontains_a_i\x18\x16 \x01(\tH\x00R\n" +
+	"containsAI\x88\x01\x01\x12;\n" +
+	"\x17contains_hidden_content\x18\x17 \x01(\bH\x01R\x15containsHiddenContent\x88\x01\x01\x12\x91\x01\n" +
 	"*resource_contained_resource_reference_list\x18\x18 \x01(\v25.ddex.ern.v432.ResourceContainedResourceReferenceListR&resourceContainedResourceReferenceList\x12P\n" +
 	"\x11technical_details\x18\x19 \x03(\v2#.ddex.ern.v432.TechnicalTextDetailsR\x10technicalDetails\x12A\n" +
-	"\x10language_of_text\x18\x1a \x03(\v2\x17.ddex.ern.v432.LanguageR\x0elanguageOfText\x127\n" +
-	"\x18language_and_script_code\x18\x1b \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18\x1c \x01(\bR\x0eisSupplemental\"k\n" +
+	"\x10language_of_text\x18\x1a \x03(\v2\x17.ddex.ern.v432.LanguageR\x0elanguageOfText\x12<\n" +
+	"\x18language_and_script_code\x18\x1b \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18\x1c \x01(\bH\x03R\x0eisSupplemental\x88\x01\x01B\x0f\n" +
+	"\r_contains_a_iB\x1a\n" +
+	"\x18_contains_hidden_contentB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalJ\x06\b\xa8F\x10\x90N\"\x86\x01\n" +
 	"\x06Timing\x12\x1f\n" +
 	"\vstart_point\x18\x01 \x01(\tR\n" +
-	"startPoint\x12\x1b\n" +
-	"\tend_point\x18\x02 \x01(\tR\bendPoint\x12#\n" +
-	"\rduration_used\x18\x03 \x03(\tR\fdurationUsed\"\x9b\x01\n" +
+	"startPoint\x12 \n" +
+	"\tend_point\x18\x02 \x01(\tH\x00R\bendPoint\x88\x01\x01\x12#\n" +
+	"\rduration_used\x18\x03 \x03(\tR\fdurationUsedB\f\n" +
+	"\n" +
+	"_end_pointJ\x06\b\xa8F\x10\x90N\"\xec\x01\n" +
 	"\x05Title\x12\x1d\n" +
 	"\n" +
-	"title_text\x18\x01 \x01(\tR\ttitleText\x12\x1b\n" +
-	"\tsub_title\x18\x02 \x01(\tR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"title_text\x18\x01 \x01(\tR\ttitleText\x12 \n" +
+	"\tsub_title\x18\x02 \x01(\tH\x00R\bsubTitle\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"title_type\x18\x04 \x01(\tH\x02R\ttitleType\x88\x01\x01B\f\n" +
+	"\n" +
+	"_sub_titleB\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_title_typeJ\x06\b\xa8F\x10\x90N\"\x97\n" +
 	"\n" +
-	"title_type\x18\x04 \x01(\tR\ttitleType\"\xe0\t\n" +
 	"\fTrackRelease\x12+\n" +
 	"\x11release_reference\x18\x01 \x01(\tR\x10releaseReference\x127\n" +
 	"\n" +
@@ -19372,27 +19666,37 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	" \x03(\v2!.ddex.ern.v432.GenreWithTerritoryR\fdisplayGenre\x12@\n" +
 	"\x1crelease_visibility_reference\x18\v \x03(\tR\x1areleaseVisibilityReference\x12F\n" +
 	"\x0frelated_release\x18\f \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\r \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12\x1f\n" +
-	"\ftarget_u_r_l\x18\x0e \x01(\tR\ttargetURL\x12@\n" +
+	"\x10related_resource\x18\r \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12$\n" +
+	"\ftarget_u_r_l\x18\x0e \x01(\tH\x00R\ttargetURL\x88\x01\x01\x12@\n" +
 	"\bkeywords\x18\x0f \x03(\v2$.ddex.ern.v432.KeywordsWithTerritoryR\bkeywords\x12@\n" +
 	"\bsynopsis\x18\x10 \x03(\v2$.ddex.ern.v432.SynopsisWithTerritoryR\bsynopsis\x12L\n" +
-	"\x11marketing_comment\x18\x11 \x03(\v2\x1f.ddex.ern.v432.MarketingCommentR\x10marketingComment\x12&\n" +
-	"\x0fis_main_release\x18\x12 \x01(\bR\risMainRelease\"\xb6\x03\n" +
+	"\x11marketing_comment\x18\x11 \x03(\v2\x1f.ddex.ern.v432.MarketingCommentR\x10marketingComment\x12+\n" +
+	"\x0fis_main_release\x18\x12 \x01(\bH\x01R\risMainRelease\x88\x01\x01B\x0f\n" +
+	"\r_target_u_r_lB\x12\n" +
+	"\x10_is_main_releaseJ\x06\b\xa8F\x10\x90N\"\x82\x04\n" +
 	"\x16TrackReleaseVisibility\x121\n" +
-	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12O\n" +
-	"%track_listing_preview_start_date_time\x18\x02 \x01(\tR trackListingPreviewStartDateTime\x12>\n" +
-	"\x1cclip_preview_start_date_time\x18\x03 \x01(\tR\x18clipPreviewStartDateTime\x12J\n" +
+	"\x14visibility_reference\x18\x01 \x01(\tR\x13visibilityReference\x12J\n" +
 	"\x0eterritory_code\x18\x04 \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\x05 \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12/\n" +
-	"\x14do_not_display_dates\x18\x06 \x01(\bR\x11doNotDisplayDates\"k\n" +
+	"\x17excluded_territory_code\x18\x05 \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12O\n" +
+	"%track_listing_preview_start_date_time\x18\x02 \x01(\tR trackListingPreviewStartDateTime\x12C\n" +
+	"\x1cclip_preview_start_date_time\x18\x03 \x01(\tH\x00R\x18clipPreviewStartDateTime\x88\x01\x01\x124\n" +
+	"\x14do_not_display_dates\x18\x06 \x01(\bH\x01R\x11doNotDisplayDates\x88\x01\x01B\x1f\n" +
+	"\x1d_clip_preview_start_date_timeB\x17\n" +
+	"\x15_do_not_display_datesJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\aUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"u\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\x11UserInterfaceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xe0\x17\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb3\x1a\n" +
 	"\x05Video\x12-\n" +
 	"\x12resource_reference\x18\x01 \x01(\tR\x11resourceReference\x12,\n" +
 	"\x04type\x18\x02 \x01(\v2\x18.ddex.ern.v432.VideoTypeR\x04type\x12@\n" +
@@ -19420,16 +19724,18 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x15parental_warning_type\x18\x17 \x03(\v2..ddex.ern.v432.ParentalWarningTypeWithStandardR\x13parentalWarningType\x124\n" +
 	"\tav_rating\x18\x18 \x03(\v2\x17.ddex.ern.v432.AvRatingR\bavRating\x12F\n" +
 	"\x0frelated_release\x18\x19 \x03(\v2\x1d.ddex.ern.v432.RelatedReleaseR\x0erelatedRelease\x12I\n" +
-	"\x10related_resource\x18\x1a \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12=\n" +
-	"\x1bcomposite_musical_work_type\x18\x1b \x01(\tR\x18compositeMusicalWorkType\x12 \n" +
-	"\fcontains_a_i\x18\x1c \x01(\tR\n" +
-	"containsAI\x12\x19\n" +
-	"\bis_cover\x18\x1d \x01(\bR\aisCover\x122\n" +
-	"\x15has_vocal_performance\x18\x1e \x01(\bR\x13hasVocalPerformance\x12G\n" +
-	" has_foreground_vocal_performance\x18\x1f \x01(\bR\x1dhasForegroundVocalPerformance\x12'\n" +
-	"\x0fis_instrumental\x18  \x01(\bR\x0eisInstrumental\x126\n" +
-	"\x17contains_hidden_content\x18! \x01(\bR\x15containsHiddenContent\x12#\n" +
-	"\ris_remastered\x18\" \x01(\bR\fisRemastered\x12F\n" +
+	"\x10related_resource\x18\x1a \x03(\v2\x1e.ddex.ern.v432.RelatedResourceR\x0frelatedResource\x12B\n" +
+	"\x1bcomposite_musical_work_type\x18\x1b \x01(\tH\x00R\x18compositeMusicalWorkType\x88\x01\x01\x129\n" +
+	"\x19video_cue_sheet_reference\x18, \x03(\tR\x16videoCueSheetReference\x12U\n" +
+	"\x1creason_for_cue_sheet_absence\x18- \x01(\v2\x15.ddex.ern.v432.ReasonR\x18reasonForCueSheetAbsence\x12%\n" +
+	"\fcontains_a_i\x18\x1c \x01(\tH\x01R\n" +
+	"containsAI\x88\x01\x01\x12\x1e\n" +
+	"\bis_cover\x18\x1d \x01(\bH\x02R\aisCover\x88\x01\x01\x127\n" +
+	"\x15has_vocal_performance\x18\x1e \x01(\bH\x03R\x13hasVocalPerformance\x88\x01\x01\x12L\n" +
+	" has_foreground_vocal_performance\x18\x1f \x01(\bH\x04R\x1dhasForegroundVocalPerformance\x88\x01\x01\x12,\n" +
+	"\x0fis_instrumental\x18  \x01(\bH\x05R\x0eisInstrumental\x88\x01\x01\x12;\n" +
+	"\x17contains_hidden_content\x18! \x01(\bH\x06R\x15containsHiddenContent\x88\x01\x01\x12(\n" +
+	"\ris_remastered\x18\" \x01(\bH\aR\fisRemastered\x88\x01\x01\x12F\n" +
 	"\x0fdisplay_credits\x18# \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12O\n" +
 	"\x17language_of_performance\x18$ \x03(\v2\x17.ddex.ern.v432.LanguageR\x15languageOfPerformance\x12.\n" +
 	"\x13language_of_dubbing\x18% \x03(\tR\x11languageOfDubbing\x12,\n" +
@@ -19438,20 +19744,32 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\x04raga\x18( \x03(\tR\x04raga\x12\x12\n" +
 	"\x04tala\x18) \x03(\tR\x04tala\x12\x14\n" +
 	"\x05deity\x18* \x03(\tR\x05deity\x126\n" +
-	"\x17video_chapter_reference\x18+ \x03(\tR\x15videoChapterReference\x129\n" +
-	"\x19video_cue_sheet_reference\x18, \x03(\tR\x16videoCueSheetReference\x12U\n" +
-	"\x1creason_for_cue_sheet_absence\x18- \x01(\v2\x15.ddex.ern.v432.ReasonR\x18reasonForCueSheetAbsence\x127\n" +
-	"\x18language_and_script_code\x18. \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fis_supplemental\x18/ \x01(\bR\x0eisSupplemental\x12E\n" +
-	"\x1fapply_classical_profile_variant\x180 \x01(\bR\x1capplyClassicalProfileVariant\"\x90\x03\n" +
+	"\x17video_chapter_reference\x18+ \x03(\tR\x15videoChapterReference\x12<\n" +
+	"\x18language_and_script_code\x18. \x01(\tH\bR\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fis_supplemental\x18/ \x01(\bH\tR\x0eisSupplemental\x88\x01\x01\x12J\n" +
+	"\x1fapply_classical_profile_variant\x180 \x01(\bH\n" +
+	"R\x1capplyClassicalProfileVariant\x88\x01\x01B\x1e\n" +
+	"\x1c_composite_musical_work_typeB\x0f\n" +
+	"\r_contains_a_iB\v\n" +
+	"\t_is_coverB\x18\n" +
+	"\x16_has_vocal_performanceB#\n" +
+	"!_has_foreground_vocal_performanceB\x12\n" +
+	"\x10_is_instrumentalB\x1a\n" +
+	"\x18_contains_hidden_contentB\x10\n" +
+	"\x0e_is_remasteredB\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_is_supplementalB\"\n" +
+	" _apply_classical_profile_variantJ\x06\b\xa8F\x10\x90N\"\xce\x03\n" +
 	"\x10VideoClipDetails\x12O\n" +
 	"$technical_resource_details_reference\x18\x01 \x01(\tR!technicalResourceDetailsReference\x124\n" +
 	"\tclip_type\x18\x02 \x01(\v2\x17.ddex.ern.v432.ClipTypeR\bclipType\x12-\n" +
-	"\x06timing\x18\x03 \x03(\v2\x15.ddex.ern.v432.TimingR\x06timing\x12&\n" +
-	"\x0ftop_left_corner\x18\x04 \x01(\tR\rtopLeftCorner\x12.\n" +
-	"\x13bottom_right_corner\x18\x05 \x01(\tR\x11bottomRightCorner\x12'\n" +
+	"\x06timing\x18\x03 \x03(\v2\x15.ddex.ern.v432.TimingR\x06timing\x12+\n" +
+	"\x0ftop_left_corner\x18\x04 \x01(\tH\x00R\rtopLeftCorner\x88\x01\x01\x123\n" +
+	"\x13bottom_right_corner\x18\x05 \x01(\tH\x01R\x11bottomRightCorner\x88\x01\x01\x12'\n" +
 	"\x0fexpression_type\x18\x06 \x01(\tR\x0eexpressionType\x12E\n" +
-	"\rdelivery_file\x18\a \x03(\v2 .ddex.ern.v432.VideoDeliveryFileR\fdeliveryFile\"\xd9\f\n" +
+	"\rdelivery_file\x18\a \x03(\v2 .ddex.ern.v432.VideoDeliveryFileR\fdeliveryFileB\x12\n" +
+	"\x10_top_left_cornerB\x16\n" +
+	"\x14_bottom_right_cornerJ\x06\b\xa8F\x10\x90N\"\xb8\x0f\n" +
 	"\x11VideoDeliveryFile\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12I\n" +
 	"\x10container_format\x18\x02 \x01(\v2\x1e.ddex.ern.v432.ContainerFormatR\x0fcontainerFormat\x12G\n" +
@@ -19463,609 +19781,959 @@ const file_ddex_ern_v432_v432_proto_rawDesc = "" +
 	"\vimage_width\x18\a \x01(\v2\x15.ddex.ern.v432.ExtentR\n" +
 	"imageWidth\x12=\n" +
 	"\faspect_ratio\x18\b \x03(\v2\x1a.ddex.ern.v432.AspectRatioR\vaspectRatio\x124\n" +
-	"\tcore_area\x18\t \x01(\v2\x17.ddex.ern.v432.CoreAreaR\bcoreArea\x12\x1f\n" +
+	"\tcore_area\x18\t \x01(\v2\x17.ddex.ern.v432.CoreAreaR\bcoreArea\x12$\n" +
 	"\vcolor_depth\x18\n" +
-	" \x01(\x05R\n" +
-	"colorDepth\x12V\n" +
+	" \x01(\x05H\x00R\n" +
+	"colorDepth\x88\x01\x01\x12V\n" +
 	"\x15video_definition_type\x18\v \x01(\v2\".ddex.ern.v432.VideoDefinitionTypeR\x13videoDefinitionType\x12G\n" +
-	"\x10audio_codec_type\x18\f \x01(\v2\x1d.ddex.ern.v432.AudioCodecTypeR\x0eaudioCodecType\x12?\n" +
-	"\x1chas_immersive_audio_metadata\x18\r \x01(\bR\x19hasImmersiveAudioMetadata\x12R\n" +
-	"&electro_optical_transfer_function_type\x18\x0e \x01(\tR\"electroOpticalTransferFunctionType\x12,\n" +
-	"\x12primary_color_type\x18\x0f \x01(\tR\x10primaryColorType\x12p\n" +
-	"\x1fhdr_video_dynamic_metadata_type\x18\x10 \x01(\v2*.ddex.ern.v432.HdrVideoDynamicMetadataTypeR\x1bhdrVideoDynamicMetadataType\x12B\n" +
-	"\x1ehdr_video_static_metadata_type\x18\x11 \x01(\tR\x1ahdrVideoStaticMetadataType\x12<\n" +
-	"\x0eaudio_bit_rate\x18\x12 \x01(\v2\x16.ddex.ern.v432.BitRateR\faudioBitRate\x127\n" +
-	"\x18number_of_audio_channels\x18\x13 \x01(\x05R\x15numberOfAudioChannels\x125\n" +
-	"\x17number_of_audio_objects\x18\x14 \x01(\x05R\x14numberOfAudioObjects\x12K\n" +
-	"\x13audio_sampling_rate\x18\x15 \x01(\v2\x1b.ddex.ern.v432.SamplingRateR\x11audioSamplingRate\x121\n" +
-	"\x15audio_bits_per_sample\x18\x16 \x01(\x05R\x12audioBitsPerSample\x12\x1a\n" +
-	"\bduration\x18\x17 \x01(\tR\bduration\x12\x1b\n" +
-	"\tbit_depth\x18\x18 \x01(\x05R\bbitDepth\x12'\n" +
+	"\x10audio_codec_type\x18\f \x01(\v2\x1d.ddex.ern.v432.AudioCodecTypeR\x0eaudioCodecType\x12D\n" +
+	"\x1chas_immersive_audio_metadata\x18\r \x01(\bH\x01R\x19hasImmersiveAudioMetadata\x88\x01\x01\x12W\n" +
+	"&electro_optical_transfer_function_type\x18\x0e \x01(\tH\x02R\"electroOpticalTransferFunctionType\x88\x01\x01\x121\n" +
+	"\x12primary_color_type\x18\x0f \x01(\tH\x03R\x10primaryColorType\x88\x01\x01\x12p\n" +
+	"\x1fhdr_video_dynamic_metadata_type\x18\x10 \x01(\v2*.ddex.ern.v432.HdrVideoDynamicMetadataTypeR\x1bhdrVideoDynamicMetadataType\x12G\n" +
+	"\x1ehdr_video_static_metadata_type\x18\x11 \x01(\tH\x04R\x1ahdrVideoStaticMetadataType\x88\x01\x01\x12<\n" +
+	"\x0eaudio_bit_rate\x18\x12 \x01(\v2\x16.ddex.ern.v432.BitRateR\faudioBitRate\x12<\n" +
+	"\x18number_of_audio_channels\x18\x13 \x01(\x05H\x05R\x15numberOfAudioChannels\x88\x01\x01\x12:\n" +
+	"\x17number_of_audio_objects\x18\x14 \x01(\x05H\x06R\x14numberOfAudioObjects\x88\x01\x01\x12K\n" +
+	"\x13audio_sampling_rate\x18\x15 \x01(\v2\x1b.ddex.ern.v432.SamplingRateR\x11audioSamplingRate\x126\n" +
+	"\x15audio_bits_per_sample\x18\x16 \x01(\x05H\aR\x12audioBitsPerSample\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x17 \x01(\tH\bR\bduration\x88\x01\x01\x12 \n" +
+	"\tbit_depth\x18\x18 \x01(\x05H\tR\bbitDepth\x88\x01\x01\x12'\n" +
 	"\x04file\x18\x19 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x12<\n" +
-	"\vfingerprint\x18\x1a \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x125\n" +
-	"\x17is_provided_in_delivery\x18\x1b \x01(\bR\x14isProvidedInDelivery\"\x83\x03\n" +
-	"\fVideoEdition\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x127\n" +
+	"\vfingerprint\x18\x1a \x03(\v2\x1a.ddex.ern.v432.FingerprintR\vfingerprint\x12:\n" +
+	"\x17is_provided_in_delivery\x18\x1b \x01(\bH\n" +
+	"R\x14isProvidedInDelivery\x88\x01\x01B\x0e\n" +
+	"\f_color_depthB\x1f\n" +
+	"\x1d_has_immersive_audio_metadataB)\n" +
+	"'_electro_optical_transfer_function_typeB\x15\n" +
+	"\x13_primary_color_typeB!\n" +
+	"\x1f_hdr_video_static_metadata_typeB\x1b\n" +
+	"\x19_number_of_audio_channelsB\x1a\n" +
+	"\x18_number_of_audio_objectsB\x18\n" +
+	"\x16_audio_bits_per_sampleB\v\n" +
+	"\t_durationB\f\n" +
+	"\n" +
+	"_bit_depthB\x1a\n" +
+	"\x18_is_provided_in_deliveryJ\x06\b\xa8F\x10\x90N\"\xb1\x03\n" +
+	"\fVideoEdition\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x127\n" +
 	"\vresource_id\x18\x02 \x03(\v2\x16.ddex.ern.v432.VideoIdR\n" +
 	"resourceId\x12R\n" +
 	"\x13edition_contributor\x18\x03 \x03(\v2!.ddex.ern.v432.EditionContributorR\x12editionContributor\x12+\n" +
 	"\x06p_line\x18\x04 \x03(\v2\x14.ddex.ern.v432.PLineR\x05pLine\x12+\n" +
-	"\x06c_line\x18\x05 \x03(\v2\x14.ddex.ern.v432.CLineR\x05cLine\x12%\n" +
-	"\x0erecording_mode\x18\x06 \x01(\tR\rrecordingMode\x12Q\n" +
-	"\x11technical_details\x18\a \x03(\v2$.ddex.ern.v432.TechnicalVideoDetailsR\x10technicalDetails\"m\n" +
+	"\x06c_line\x18\x05 \x03(\v2\x14.ddex.ern.v432.CLineR\x05cLine\x12*\n" +
+	"\x0erecording_mode\x18\x06 \x01(\tH\x01R\rrecordingMode\x88\x01\x01\x12Q\n" +
+	"\x11technical_details\x18\a \x03(\v2$.ddex.ern.v432.TechnicalVideoDetailsR\x10technicalDetailsB\a\n" +
+	"\x05_typeB\x11\n" +
+	"\x0f_recording_modeJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tVideoType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xa6\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xf4\x03\n" +
 	"\x14WorkRightsController\x12I\n" +
 	"!rights_controller_party_reference\x18\x01 \x01(\tR\x1erightsControllerPartyReference\x12.\n" +
-	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x124\n" +
-	"\x16rights_controller_type\x18\x03 \x01(\tR\x14rightsControllerType\x12=\n" +
-	"\tterritory\x18\x04 \x03(\v2\x1f.ddex.ern.v432.AllTerritoryCodeR\tterritory\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x06 \x01(\tR\aendDate\x12.\n" +
+	"\x13rights_control_type\x18\x02 \x03(\tR\x11rightsControlType\x129\n" +
+	"\x16rights_controller_type\x18\x03 \x01(\tH\x00R\x14rightsControllerType\x88\x01\x01\x12.\n" +
 	"\x13right_share_unknown\x18\a \x01(\bR\x11rightShareUnknown\x124\n" +
-	"\x16right_share_percentage\x18\b \x01(\tR\x14rightSharePercentage\"\x83\x01\n" +
+	"\x16right_share_percentage\x18\b \x01(\tR\x14rightSharePercentage\x12=\n" +
+	"\tterritory\x18\x04 \x03(\v2\x1f.ddex.ern.v432.AllTerritoryCodeR\tterritory\x12\"\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tH\x01R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\x06 \x01(\tH\x02R\aendDate\x88\x01\x01B\x19\n" +
+	"\x17_rights_controller_typeB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateJ\x06\b\xa8F\x10\x90N\"\xba\x01\n" +
 	"\x1fAdministratingRecordCompanyRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xf4\x03\n" +
-	"\vAffiliation\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12F\n" +
-	"\x0fvalidity_period\x18\x02 \x01(\v2\x1d.ddex.ern.v432.ValidityPeriodR\x0evalidityPeriod\x12:\n" +
-	"\vrights_type\x18\x03 \x03(\v2\x19.ddex.ern.v432.RightsTypeR\n" +
-	"rightsType\x12E\n" +
-	"\x1fpercentage_of_rights_assignment\x18\x04 \x01(\tR\x1cpercentageOfRightsAssignment\x12!\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa5\x04\n" +
+	"\vAffiliation\x12!\n" +
 	"\fcompany_name\x18\x05 \x01(\tR\vcompanyName\x12:\n" +
-	"\x19party_affiliate_reference\x18\x06 \x01(\tR\x17partyAffiliateReference\x12J\n" +
+	"\x19party_affiliate_reference\x18\x06 \x01(\tR\x17partyAffiliateReference\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12J\n" +
 	"\x0eterritory_code\x18\a \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\rterritoryCode\x12[\n" +
-	"\x17excluded_territory_code\x18\b \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\"Q\n" +
+	"\x17excluded_territory_code\x18\b \x03(\v2#.ddex.ern.v432.CurrentTerritoryCodeR\x15excludedTerritoryCode\x12F\n" +
+	"\x0fvalidity_period\x18\x02 \x01(\v2\x1d.ddex.ern.v432.ValidityPeriodR\x0evalidityPeriod\x12:\n" +
+	"\vrights_type\x18\x03 \x03(\v2\x19.ddex.ern.v432.RightsTypeR\n" +
+	"rightsType\x12J\n" +
+	"\x1fpercentage_of_rights_assignment\x18\x04 \x01(\tH\x00R\x1cpercentageOfRightsAssignment\x88\x01\x01B\"\n" +
+	" _percentage_of_rights_assignmentJ\x06\b\xa8F\x10\x90N\"r\n" +
 	"\x10AllTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"\x8e\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xd6\x01\n" +
 	"\vAspectRatio\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
-	"\x11aspect_ratio_type\x18\x02 \x01(\tR\x0faspectRatioType\x12=\n" +
-	"\x1bapplies_to_cropped_resource\x18\x03 \x01(\bR\x18appliesToCroppedResource\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12/\n" +
+	"\x11aspect_ratio_type\x18\x02 \x01(\tH\x00R\x0faspectRatioType\x88\x01\x01\x12B\n" +
+	"\x1bapplies_to_cropped_resource\x18\x03 \x01(\bH\x01R\x18appliesToCroppedResource\x88\x01\x01B\x14\n" +
+	"\x12_aspect_ratio_typeB\x1e\n" +
+	"\x1c_applies_to_cropped_resourceJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eAudioCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"G\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"h\n" +
 	"\aBitRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\xf5\x01\n" +
-	"\x05CLine\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ec_line_company\x18\x02 \x01(\tR\fcLineCompany\x12\x1e\n" +
-	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x05 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xfc\x02\n" +
+	"\x05CLine\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ec_line_company\x18\x02 \x01(\tH\x01R\fcLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vc_line_text\x18\x03 \x01(\tR\tcLineText\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x06 \x01(\bR\tisDefault\"o\n" +
+	"is_default\x18\x06 \x01(\bH\x04R\tisDefault\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_c_line_companyB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vCarrierType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rCatalogNumber\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\x8b\x02\n" +
-	"\tChapterId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_a_n\x18\x02 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x03 \x01(\tR\x05vISAN\x12\x15\n" +
-	"\ae_i_d_r\x18\x04 \x03(\tR\x04eIDR\x12\x15\n" +
-	"\ai_s_w_c\x18\x05 \x01(\tR\x04iSWC\x12C\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xd9\x02\n" +
+	"\tChapterId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x02 \x01(\tH\x01R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x03 \x01(\tH\x02R\x05vISAN\x88\x01\x01\x12\x15\n" +
+	"\ae_i_d_r\x18\x04 \x03(\tR\x04eIDR\x12\x1a\n" +
+	"\ai_s_w_c\x18\x05 \x01(\tH\x03R\x04iSWC\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\x06 \x01(\v2\x1c.ddex.ern.v432.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\a \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\"l\n" +
+	"\x0eproprietary_id\x18\a \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\n" +
+	"\n" +
+	"\b_i_s_w_cJ\x06\b\xa8F\x10\x90N\"\xa3\x01\n" +
 	"\bClipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"s\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fContainerFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x85\x05\n" +
-	"\vContributor\x122\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x91\x06\n" +
+	"\vContributor\x12>\n" +
+	"\x1bcontributor_party_reference\x18\b \x01(\tR\x19contributorPartyReference\x12V\n" +
+	"\x13special_contributor\x18\t \x01(\v2%.ddex.ern.v432.SpecialContributorTypeR\x12specialContributor\x122\n" +
 	"\x04role\x18\x01 \x03(\v2\x1e.ddex.ern.v432.ContributorRoleR\x04role\x12F\n" +
-	"\x0finstrument_type\x18\x02 \x03(\v2\x1d.ddex.ern.v432.InstrumentTypeR\x0einstrumentType\x12'\n" +
-	"\x0fai_contribution\x18\x03 \x01(\tR\x0eaiContribution\x12C\n" +
-	"\x1ehas_made_featured_contribution\x18\x04 \x01(\bR\x1bhasMadeFeaturedContribution\x12G\n" +
-	" has_made_contracted_contribution\x18\x05 \x01(\bR\x1dhasMadeContractedContribution\x12:\n" +
+	"\x0finstrument_type\x18\x02 \x03(\v2\x1d.ddex.ern.v432.InstrumentTypeR\x0einstrumentType\x12,\n" +
+	"\x0fai_contribution\x18\x03 \x01(\tH\x00R\x0eaiContribution\x88\x01\x01\x12H\n" +
+	"\x1ehas_made_featured_contribution\x18\x04 \x01(\bH\x01R\x1bhasMadeFeaturedContribution\x88\x01\x01\x12L\n" +
+	" has_made_contracted_contribution\x18\x05 \x01(\bH\x02R\x1dhasMadeContractedContribution\x88\x01\x01\x12:\n" +
 	"\vis_credited\x18\x06 \x01(\v2\x19.ddex.ern.v432.IsCreditedR\n" +
 	"isCredited\x12F\n" +
-	"\x0fdisplay_credits\x18\a \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12>\n" +
-	"\x1bcontributor_party_reference\x18\b \x01(\tR\x19contributorPartyReference\x12V\n" +
-	"\x13special_contributor\x18\t \x01(\v2%.ddex.ern.v432.SpecialContributorTypeR\x12specialContributor\x12'\n" +
+	"\x0fdisplay_credits\x18\a \x03(\v2\x1d.ddex.ern.v432.DisplayCreditsR\x0edisplayCredits\x12,\n" +
 	"\x0fsequence_number\x18\n" +
-	" \x01(\x05R\x0esequenceNumber\"\x94\x01\n" +
+	" \x01(\x05H\x03R\x0esequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_ai_contributionB!\n" +
+	"\x1f_has_made_featured_contributionB#\n" +
+	"!_has_made_contracted_contributionB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x9c\x01\n" +
 	"\x0fContributorRole\x129\n" +
 	"\x05value\x18\x01 \x01(\v2#.ddex.ern.v432.ContributorRoleValueR\x05value\x12F\n" +
-	"\x0finstrument_type\x18\x02 \x03(\v2\x1d.ddex.ern.v432.InstrumentTypeR\x0einstrumentType\"x\n" +
+	"\x0finstrument_type\x18\x02 \x03(\v2\x1d.ddex.ern.v432.InstrumentTypeR\x0einstrumentTypeJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\x14ContributorRoleValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xb8\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x99\x02\n" +
 	"\fCourtesyLine\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"m\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tCueOrigin\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueSheetType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueThemeType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"n\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"CueUseType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"{\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17CueVisualPerceptionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fCueVocalType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"U\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"v\n" +
 	"\x14CurrentTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"\xc5\x01\n" +
-	"\x03DSP\x126\n" +
-	"\ftrading_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\vtradingName\x12\x12\n" +
-	"\x05u_r_l\x18\x02 \x03(\tR\x03uRL\x129\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xcd\x01\n" +
+	"\x03DSP\x129\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x1e.ddex.ern.v432.DetailedPartyIdR\apartyId\x127\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v432.PartyNameR\tpartyName\"\xcf\x01\n" +
+	"party_name\x18\x04 \x03(\v2\x18.ddex.ern.v432.PartyNameR\tpartyName\x126\n" +
+	"\ftrading_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\vtradingName\x12\x12\n" +
+	"\x05u_r_l\x18\x02 \x03(\tR\x03uRLJ\x06\b\xa8F\x10\x90N\"\x8e\x02\n" +
 	"\x0fDetailedHashSum\x12A\n" +
-	"\talgorithm\x18\x01 \x01(\v2#.ddex.ern.v432.HashSumAlgorithmTypeR\talgorithm\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tparameter\x18\x03 \x01(\tR\tparameter\x12\x1b\n" +
-	"\tdata_type\x18\x04 \x01(\tR\bdataType\x12$\n" +
-	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValue\"\xea\x01\n" +
-	"\x0fDetailedPartyId\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12(\n" +
-	"\x10cisac_society_id\x18\x05 \x01(\tR\x0ecisacSocietyId\x12C\n" +
-	"\x0eproprietary_id\x18\x06 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\"\x88\x02\n" +
+	"\talgorithm\x18\x01 \x01(\v2#.ddex.ern.v432.HashSumAlgorithmTypeR\talgorithm\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tparameter\x18\x03 \x01(\tH\x01R\tparameter\x88\x01\x01\x12 \n" +
+	"\tdata_type\x18\x04 \x01(\tH\x02R\bdataType\x88\x01\x01\x12$\n" +
+	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValueB\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_parameterB\f\n" +
+	"\n" +
+	"_data_typeJ\x06\b\xa8F\x10\x90N\"\xd6\x02\n" +
+	"\x0fDetailedPartyId\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12-\n" +
+	"\x10cisac_society_id\x18\x05 \x01(\tH\x04R\x0ecisacSocietyId\x88\x01\x01\x12C\n" +
+	"\x0eproprietary_id\x18\x06 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
+	"\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nB\x13\n" +
+	"\x11_cisac_society_idJ\x06\b\xa8F\x10\x90N\"\x8a\x03\n" +
 	"%DisplayArtistNameWithOriginalLanguage\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x125\n" +
-	"\x17is_in_original_language\x18\x03 \x01(\bR\x14isInOriginalLanguage\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x03 \x01(\bH\x01R\x14isInOriginalLanguage\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\"u\n" +
+	"is_default\x18\x05 \x01(\bH\x03R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1a\n" +
+	"\x18_is_in_original_languageB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\x11DisplayArtistRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd4\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xca\x03\n" +
 	"\x0eDisplayCredits\x12.\n" +
-	"\x13display_credit_text\x18\x01 \x01(\tR\x11displayCreditText\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x13display_credit_text\x18\x01 \x01(\tR\x11displayCreditText\x120\n" +
+	"\x14display_credit_party\x18\x02 \x01(\tR\x12displayCreditParty\x12A\n" +
+	"\x1bname_used_in_display_credit\x18\x03 \x01(\tH\x00R\x17nameUsedInDisplayCredit\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xa9\x01\n" +
+	"is_default\x18\x06 \x01(\bH\x03R\tisDefault\x88\x01\x01B\x1e\n" +
+	"\x1c_name_used_in_display_creditB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x81\x02\n" +
 	"\x0fDisplaySubTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fsequence_number\x18\x02 \x01(\x05R\x0esequenceNumber\x121\n" +
-	"\x15is_displayed_in_title\x18\x03 \x01(\bR\x12isDisplayedInTitle\x12$\n" +
-	"\x0esub_title_type\x18\x04 \x01(\tR\fsubTitleType\"\xb5\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fsequence_number\x18\x02 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x126\n" +
+	"\x15is_displayed_in_title\x18\x03 \x01(\bH\x01R\x12isDisplayedInTitle\x88\x01\x01\x12)\n" +
+	"\x0esub_title_type\x18\x04 \x01(\tH\x02R\fsubTitleType\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x18\n" +
+	"\x16_is_displayed_in_titleB\x11\n" +
+	"\x0f_sub_title_typeJ\x06\b\xa8F\x10\x90N\"\xb7\x03\n" +
 	"\fDisplayTitle\x12\x1d\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\tR\ttitleText\x12;\n" +
-	"\tsub_title\x18\x02 \x03(\v2\x1e.ddex.ern.v432.DisplaySubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x03(\v2\x1e.ddex.ern.v432.DisplaySubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\x125\n" +
-	"\x17is_in_original_language\x18\x06 \x01(\bR\x14isInOriginalLanguage\"\xa8\x02\n" +
+	"is_default\x18\x05 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12:\n" +
+	"\x17is_in_original_language\x18\x06 \x01(\bH\x03R\x14isInOriginalLanguage\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x1a\n" +
+	"\x18_is_in_original_languageJ\x06\b\xa8F\x10\x90N\"\xd0\x03\n" +
 	"\tEventDate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12:\n" +
-	"\x19applicable_territory_code\x18\x05 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"\x97\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb4\x03\n" +
 	"\rEventDateTime\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12%\n" +
-	"\x0eterritory_code\x18\x05 \x01(\tR\rterritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"F\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12*\n" +
+	"\x0eterritory_code\x18\x05 \x01(\tH\x03R\rterritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x11\n" +
+	"\x0f_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"g\n" +
 	"\x06Extent\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\x80\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\x1cExternallyLinkedResourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8d\x01\n" +
 	"\x04File\x12\x12\n" +
 	"\x05u_r_i\x18\x01 \x01(\tR\x03uRI\x129\n" +
-	"\bhash_sum\x18\x02 \x01(\v2\x1e.ddex.ern.v432.DetailedHashSumR\ahashSum\x12\x1b\n" +
-	"\tfile_size\x18\x03 \x01(\tR\bfileSize\"\xff\x01\n" +
+	"\bhash_sum\x18\x02 \x01(\v2\x1e.ddex.ern.v432.DetailedHashSumR\ahashSum\x12 \n" +
+	"\tfile_size\x18\x03 \x01(\tH\x00R\bfileSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_file_sizeJ\x06\b\xa8F\x10\x90N\"\xab\x02\n" +
 	"\vFingerprint\x12E\n" +
-	"\talgorithm\x18\x01 \x01(\v2'.ddex.ern.v432.FingerprintAlgorithmTypeR\talgorithm\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tparameter\x18\x03 \x01(\tR\tparameter\x12'\n" +
+	"\talgorithm\x18\x01 \x01(\v2'.ddex.ern.v432.FingerprintAlgorithmTypeR\talgorithm\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tparameter\x18\x03 \x01(\tH\x01R\tparameter\x88\x01\x01\x12'\n" +
 	"\x04file\x18\x04 \x01(\v2\x13.ddex.ern.v432.FileR\x04file\x12\x1b\n" +
 	"\tdata_type\x18\x05 \x01(\tR\bdataType\x12+\n" +
-	"\x11fingerprint_value\x18\x06 \x01(\tR\x10fingerprintValue\"|\n" +
+	"\x11fingerprint_value\x18\x06 \x01(\tR\x10fingerprintValueB\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_parameterJ\x06\b\xa8F\x10\x90N\"\xb3\x01\n" +
 	"\x18FingerprintAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x87\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc6\x01\n" +
 	"\x14FirstPublicationDate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\"I\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"j\n" +
 	"\tFrameRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"\xd5\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\x94\x02\n" +
 	"\x0fFulfillmentDate\x12)\n" +
 	"\x10fulfillment_date\x18\x01 \x01(\tR\x0ffulfillmentDate\x12<\n" +
-	"\x1aresource_release_reference\x18\x02 \x03(\tR\x18resourceReleaseReference\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x1aresource_release_reference\x18\x02 \x03(\tR\x18resourceReleaseReference\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xcb\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x01R\tisDefault\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xf6\x01\n" +
 	"\rGenreCategory\x127\n" +
 	"\x05value\x18\x01 \x01(\v2!.ddex.ern.v432.GenreCategoryValueR\x05value\x12E\n" +
-	"\vdescription\x18\x02 \x03(\v2#.ddex.ern.v432.TextWithoutTerritoryR\vdescription\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\"\xaf\x01\n" +
+	"\vdescription\x18\x02 \x03(\v2#.ddex.ern.v432.TextWithoutTerritoryR\vdescription\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\x88\x02\n" +
 	"\x12GenreCategoryValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xf8\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xec\x03\n" +
 	"\x12GenreWithTerritory\x12\x1d\n" +
 	"\n" +
-	"genre_text\x18\x01 \x01(\tR\tgenreText\x12\x1b\n" +
-	"\tsub_genre\x18\x02 \x01(\tR\bsubGenre\x12C\n" +
+	"genre_text\x18\x01 \x01(\tR\tgenreText\x12 \n" +
+	"\tsub_genre\x18\x02 \x01(\tH\x00R\bsubGenre\x88\x01\x01\x12C\n" +
 	"\x0egenre_category\x18\x03 \x03(\v2\x1c.ddex.ern.v432.GenreCategoryR\rgenreCategory\x12M\n" +
-	"\x12sub_genre_category\x18\x04 \x03(\v2\x1f.ddex.ern.v432.SubGenreCategoryR\x10subGenreCategory\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x06 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x12sub_genre_category\x18\x04 \x03(\v2\x1f.ddex.ern.v432.SubGenreCategoryR\x10subGenreCategory\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x06 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\a \x01(\bH\x03R\tisDefault\x88\x01\x01B\f\n" +
 	"\n" +
-	"is_default\x18\a \x01(\bR\tisDefault\"x\n" +
+	"_sub_genreB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\x14HashSumAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eImageCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"m\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tImageType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0eInstrumentType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"F\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"e\n" +
 	"\n" +
 	"IsCredited\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\"\n" +
-	"\rmay_be_shared\x18\x02 \x01(\bR\vmayBeShared\"\xc1\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
+	"\rmay_be_shared\x18\x02 \x01(\bH\x00R\vmayBeShared\x88\x01\x01B\x10\n" +
+	"\x0e_may_be_sharedJ\x06\b\xa8F\x10\x90N\"\xa2\x02\n" +
 	"\x15KeywordsWithTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"J\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"l\n" +
 	"\bLanguage\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12(\n" +
-	"\x10is_main_language\x18\x02 \x01(\bR\x0eisMainLanguage\"\xbc\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12-\n" +
+	"\x10is_main_language\x18\x02 \x01(\bH\x00R\x0eisMainLanguage\x88\x01\x01B\x13\n" +
+	"\x11_is_main_languageJ\x06\b\xa8F\x10\x90N\"\x9d\x02\n" +
 	"\x10MarketingComment\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"u\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"}\n" +
 	"\x11MessageAuditTrail\x12`\n" +
-	"\x19message_audit_trail_event\x18\x01 \x03(\v2%.ddex.ern.v432.MessageAuditTrailEventR\x16messageAuditTrailEvent\"\x9d\x01\n" +
+	"\x19message_audit_trail_event\x18\x01 \x03(\v2%.ddex.ern.v432.MessageAuditTrailEventR\x16messageAuditTrailEventJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\x16MessageAuditTrailEvent\x12f\n" +
 	"\x1amessaging_party_descriptor\x18\x01 \x01(\v2(.ddex.ern.v432.MessagingPartyWithoutCodeR\x18messagingPartyDescriptor\x12\x1b\n" +
-	"\tdate_time\x18\x02 \x01(\tR\bdateTime\"\x9d\x05\n" +
-	"\rMessageHeader\x12*\n" +
-	"\x11message_thread_id\x18\x01 \x01(\tR\x0fmessageThreadId\x12\x1d\n" +
+	"\tdate_time\x18\x02 \x01(\tR\bdateTimeJ\x06\b\xa8F\x10\x90N\"\xf9\x05\n" +
+	"\rMessageHeader\x12/\n" +
+	"\x11message_thread_id\x18\x01 \x01(\tH\x00R\x0fmessageThreadId\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId\x12*\n" +
-	"\x11message_file_name\x18\x03 \x01(\tR\x0fmessageFileName\x12O\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12/\n" +
+	"\x11message_file_name\x18\x03 \x01(\tH\x01R\x0fmessageFileName\x88\x01\x01\x12O\n" +
 	"\x0emessage_sender\x18\x04 \x01(\v2(.ddex.ern.v432.MessagingPartyWithoutCodeR\rmessageSender\x12S\n" +
 	"\x11sent_on_behalf_of\x18\x05 \x01(\v2(.ddex.ern.v432.MessagingPartyWithoutCodeR\x0esentOnBehalfOf\x12U\n" +
 	"\x11message_recipient\x18\x06 \x03(\v2(.ddex.ern.v432.MessagingPartyWithoutCodeR\x10messageRecipient\x12Y\n" +
 	"\x14sent_as_requested_by\x18\a \x01(\v2(.ddex.ern.v432.MessagingPartyWithoutCodeR\x11sentAsRequestedBy\x129\n" +
 	"\x19message_created_date_time\x18\b \x01(\tR\x16messageCreatedDateTime\x12P\n" +
-	"\x13message_audit_trail\x18\t \x01(\v2 .ddex.ern.v432.MessageAuditTrailR\x11messageAuditTrail\x120\n" +
+	"\x13message_audit_trail\x18\t \x01(\v2 .ddex.ern.v432.MessageAuditTrailR\x11messageAuditTrail\x125\n" +
 	"\x14message_control_type\x18\n" +
-	" \x01(\tR\x12messageControlType\"\x9d\x01\n" +
+	" \x01(\tH\x02R\x12messageControlType\x88\x01\x01B\x14\n" +
+	"\x12_message_thread_idB\x14\n" +
+	"\x12_message_file_nameB\x17\n" +
+	"\x15_message_control_typeJ\x06\b\xa8F\x10\x90N\"\xbb\x01\n" +
 	"\x19MessagingPartyWithoutCode\x12\x19\n" +
 	"\bparty_id\x18\x01 \x01(\tR\apartyId\x12B\n" +
 	"\n" +
-	"party_name\x18\x02 \x01(\v2#.ddex.ern.v432.PartyNameWithoutCodeR\tpartyName\x12!\n" +
-	"\ftrading_name\x18\x03 \x01(\tR\vtradingName\"\xe5\x01\n" +
-	"\rMusicalWorkId\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12\x1f\n" +
-	"\vopus_number\x18\x02 \x01(\tR\n" +
-	"opusNumber\x126\n" +
+	"party_name\x18\x02 \x01(\v2#.ddex.ern.v432.PartyNameWithoutCodeR\tpartyName\x12&\n" +
+	"\ftrading_name\x18\x03 \x01(\tH\x00R\vtradingName\x88\x01\x01B\x0f\n" +
+	"\r_trading_nameJ\x06\b\xa8F\x10\x90N\"\xa8\x02\n" +
+	"\rMusicalWorkId\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x12$\n" +
+	"\vopus_number\x18\x02 \x01(\tH\x01R\n" +
+	"opusNumber\x88\x01\x01\x126\n" +
 	"\x17composer_catalog_number\x18\x03 \x03(\tR\x15composerCatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x05 \x01(\bR\n" +
-	"isReplaced\"U\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x05 \x01(\bH\x02R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_w_cB\x0e\n" +
+	"\f_opus_numberB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\x7f\n" +
 	"\x04Name\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x91\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd9\x01\n" +
 	"\x13OperatingSystemType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xf5\x01\n" +
-	"\x05PLine\x12\x12\n" +
-	"\x04year\x18\x01 \x01(\tR\x04year\x12$\n" +
-	"\x0ep_line_company\x18\x02 \x01(\tR\fpLineCompany\x12\x1e\n" +
-	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x127\n" +
-	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x05 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
 	"\n" +
-	"is_default\x18\x06 \x01(\bR\tisDefault\"\x9a\x03\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xfc\x02\n" +
+	"\x05PLine\x12\x17\n" +
+	"\x04year\x18\x01 \x01(\tH\x00R\x04year\x88\x01\x01\x12)\n" +
+	"\x0ep_line_company\x18\x02 \x01(\tH\x01R\fpLineCompany\x88\x01\x01\x12\x1e\n" +
+	"\vp_line_text\x18\x03 \x01(\tR\tpLineText\x12<\n" +
+	"\x18language_and_script_code\x18\x04 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bH\x04R\tisDefault\x88\x01\x01B\a\n" +
+	"\x05_yearB\x11\n" +
+	"\x0f_p_line_companyB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xf6\x04\n" +
 	"\x1fParentalWarningTypeWithStandard\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12:\n" +
-	"\x19applicable_territory_code\x18\x02 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12?\n" +
+	"\x19applicable_territory_code\x18\x02 \x01(\tH\x00R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x03 \x01(\bR\tisDefault\x12%\n" +
-	"\x0etype_namespace\x18\x04 \x01(\tR\rtypeNamespace\x125\n" +
-	"\x17type_user_defined_value\x18\x05 \x01(\tR\x14typeUserDefinedValue\x12:\n" +
-	"\x19parental_warning_standard\x18\x06 \x01(\tR\x17parentalWarningStandard\x12-\n" +
-	"\x12standard_namespace\x18\a \x01(\tR\x11standardNamespace\x12=\n" +
-	"\x1bstandard_user_defined_value\x18\b \x01(\tR\x18standardUserDefinedValue\"\xf4\x03\n" +
+	"is_default\x18\x03 \x01(\bH\x01R\tisDefault\x88\x01\x01\x12*\n" +
+	"\x0etype_namespace\x18\x04 \x01(\tH\x02R\rtypeNamespace\x88\x01\x01\x12:\n" +
+	"\x17type_user_defined_value\x18\x05 \x01(\tH\x03R\x14typeUserDefinedValue\x88\x01\x01\x12?\n" +
+	"\x19parental_warning_standard\x18\x06 \x01(\tH\x04R\x17parentalWarningStandard\x88\x01\x01\x122\n" +
+	"\x12standard_namespace\x18\a \x01(\tH\x05R\x11standardNamespace\x88\x01\x01\x12B\n" +
+	"\x1bstandard_user_defined_value\x18\b \x01(\tH\x06R\x18standardUserDefinedValue\x88\x01\x01B\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x11\n" +
+	"\x0f_type_namespaceB\x1a\n" +
+	"\x18_type_user_defined_valueB\x1c\n" +
+	"\x1a_parental_warning_standardB\x15\n" +
+	"\x13_standard_namespaceB\x1e\n" +
+	"\x1c_standard_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc3\x04\n" +
 	"\tPartyName\x120\n" +
-	"\tfull_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12?\n" +
+	"\tfull_name\x18\x01 \x01(\v2\x13.ddex.ern.v432.NameR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12?\n" +
 	"\x11full_name_indexed\x18\x03 \x01(\v2\x13.ddex.ern.v432.NameR\x0ffullNameIndexed\x12F\n" +
 	"\x15names_before_key_name\x18\x04 \x01(\v2\x13.ddex.ern.v432.NameR\x12namesBeforeKeyName\x12.\n" +
 	"\bkey_name\x18\x05 \x01(\v2\x13.ddex.ern.v432.NameR\akeyName\x12D\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v2\x13.ddex.ern.v432.NameR\x11namesAfterKeyName\x12>\n" +
-	"\x10abbreviated_name\x18\a \x01(\v2\x13.ddex.ern.v432.NameR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\"\xc8\x02\n" +
+	"\x10abbreviated_name\x18\a \x01(\v2\x13.ddex.ern.v432.NameR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xf9\x03\n" +
 	"\x14PartyNameWithoutCode\x12\x1b\n" +
-	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12*\n" +
-	"\x11full_name_indexed\x18\x03 \x01(\tR\x0ffullNameIndexed\x121\n" +
-	"\x15names_before_key_name\x18\x04 \x01(\tR\x12namesBeforeKeyName\x12\x19\n" +
-	"\bkey_name\x18\x05 \x01(\tR\akeyName\x12/\n" +
-	"\x14names_after_key_name\x18\x06 \x01(\tR\x11namesAfterKeyName\x12)\n" +
-	"\x10abbreviated_name\x18\a \x01(\tR\x0fabbreviatedName\"\x9d\x01\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12/\n" +
+	"\x11full_name_indexed\x18\x03 \x01(\tH\x01R\x0ffullNameIndexed\x88\x01\x01\x126\n" +
+	"\x15names_before_key_name\x18\x04 \x01(\tH\x02R\x12namesBeforeKeyName\x88\x01\x01\x12\x1e\n" +
+	"\bkey_name\x18\x05 \x01(\tH\x03R\akeyName\x88\x01\x01\x124\n" +
+	"\x14names_after_key_name\x18\x06 \x01(\tH\x04R\x11namesAfterKeyName\x88\x01\x01\x12.\n" +
+	"\x10abbreviated_name\x18\a \x01(\tH\x05R\x0fabbreviatedName\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x14\n" +
+	"\x12_full_name_indexedB\x18\n" +
+	"\x16_names_before_key_nameB\v\n" +
+	"\t_key_nameB\x17\n" +
+	"\x15_names_after_key_nameB\x13\n" +
+	"\x11_abbreviated_nameJ\x06\b\xa8F\x10\x90N\"\xeb\x01\n" +
 	"\x15PartyRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\x12\"\n" +
-	"\rmay_be_shared\x18\x04 \x01(\bR\vmayBeShared\"R\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01\x12'\n" +
+	"\rmay_be_shared\x18\x04 \x01(\bH\x02R\vmayBeShared\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x10\n" +
+	"\x0e_may_be_sharedJ\x06\b\xa8F\x10\x90N\"x\n" +
 	"\n" +
 	"Percentage\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
-	"\x14has_max_value_of_one\x18\x02 \x01(\bR\x10hasMaxValueOfOne\"\xfe\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x123\n" +
+	"\x14has_max_value_of_one\x18\x02 \x01(\bH\x00R\x10hasMaxValueOfOne\x88\x01\x01B\x17\n" +
+	"\x15_has_max_value_of_oneJ\x06\b\xa8F\x10\x90N\"\x86\x02\n" +
 	"\x06Period\x127\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x18.ddex.ern.v432.EventDateR\tstartDate\x123\n" +
 	"\bend_date\x18\x02 \x01(\v2\x18.ddex.ern.v432.EventDateR\aendDate\x12D\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2\x1c.ddex.ern.v432.EventDateTimeR\rstartDateTime\x12@\n" +
-	"\rend_date_time\x18\x04 \x01(\v2\x1c.ddex.ern.v432.EventDateTimeR\vendDateTime\"W\n" +
+	"\rend_date_time\x18\x04 \x01(\v2\x1c.ddex.ern.v432.EventDateTimeR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"\x81\x01\n" +
 	"\x06Prefix\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"B\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"J\n" +
 	"\x05Price\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12#\n" +
-	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\"?\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCodeJ\x06\b\xa8F\x10\x90N\"G\n" +
 	"\tPriceType\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"E\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"`\n" +
 	"\x0fPromotionalCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rProprietaryId\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"k\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\aPurpose\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fRatingAgency\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fRatingReason\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"W\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x81\x01\n" +
 	"\x06Reason\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xaf\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"\fRelatedParty\x12A\n" +
 	"\x1dparty_related_party_reference\x18\x01 \x01(\tR\x1apartyRelatedPartyReference\x12\\\n" +
-	"\x17party_relationship_type\x18\x02 \x01(\v2$.ddex.ern.v432.PartyRelationshipTypeR\x15partyRelationshipType\"{\n" +
+	"\x17party_relationship_type\x18\x02 \x01(\v2$.ddex.ern.v432.PartyRelationshipTypeR\x15partyRelationshipTypeJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17ReleaseRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x85\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xbc\x01\n" +
 	"!ReleaseTypeForReleaseNotification\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xef\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa3\x02\n" +
 	"\"ResourceContainedResourceReference\x12Q\n" +
-	"%resource_contained_resource_reference\x18\x01 \x01(\tR\"resourceContainedResourceReference\x12#\n" +
-	"\rduration_used\x18\x02 \x01(\tR\fdurationUsed\x12\x1f\n" +
-	"\vstart_point\x18\x03 \x01(\tR\n" +
-	"startPoint\x120\n" +
-	"\apurpose\x18\x04 \x01(\v2\x16.ddex.ern.v432.PurposeR\apurpose\"\xaf\x01\n" +
+	"%resource_contained_resource_reference\x18\x01 \x01(\tR\"resourceContainedResourceReference\x12(\n" +
+	"\rduration_used\x18\x02 \x01(\tH\x00R\fdurationUsed\x88\x01\x01\x12$\n" +
+	"\vstart_point\x18\x03 \x01(\tH\x01R\n" +
+	"startPoint\x88\x01\x01\x120\n" +
+	"\apurpose\x18\x04 \x01(\v2\x16.ddex.ern.v432.PurposeR\apurposeB\x10\n" +
+	"\x0e_duration_usedB\x0e\n" +
+	"\f_start_pointJ\x06\b\xa8F\x10\x90N\"\xb7\x01\n" +
 	"&ResourceContainedResourceReferenceList\x12\x84\x01\n" +
-	"%resource_contained_resource_reference\x18\x01 \x03(\v21.ddex.ern.v432.ResourceContainedResourceReferenceR\"resourceContainedResourceReference\"{\n" +
+	"%resource_contained_resource_reference\x18\x01 \x03(\v21.ddex.ern.v432.ResourceContainedResourceReferenceR\"resourceContainedResourceReferenceJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17ResourceContributorRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xdb\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
 	"\n" +
-	"ResourceId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_m_n\x18\x02 \x01(\tR\x04iSMN\x12\x15\n" +
-	"\ai_s_a_n\x18\x03 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x04 \x01(\tR\x05vISAN\x12\x15\n" +
-	"\ai_s_b_n\x18\x05 \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\x06 \x01(\tR\x04iSSN\x12\x15\n" +
-	"\as_i_c_i\x18\a \x01(\tR\x04sICI\x12C\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xf1\x03\n" +
+	"\n" +
+	"ResourceId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_m_n\x18\x02 \x01(\tH\x01R\x04iSMN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x03 \x01(\tH\x02R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x04 \x01(\tH\x03R\x05vISAN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_b_n\x18\x05 \x01(\tH\x04R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\x06 \x01(\tH\x05R\x04iSSN\x88\x01\x01\x12\x1a\n" +
+	"\as_i_c_i\x18\a \x01(\tH\x06R\x04sICI\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\b \x01(\v2\x1c.ddex.ern.v432.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\t \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
+	"\x0eproprietary_id\x18\t \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12$\n" +
 	"\vis_replaced\x18\n" +
-	" \x01(\bR\n" +
-	"isReplaced\"}\n" +
+	" \x01(\bH\aR\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_m_nB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\x9a\x01\n" +
 	"\x15ResourceProprietaryId\x12C\n" +
-	"\x0eproprietary_id\x18\x01 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x02 \x01(\bR\n" +
-	"isReplaced\"{\n" +
+	"\x0eproprietary_id\x18\x01 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x02 \x01(\bH\x00R\n" +
+	"isReplaced\x88\x01\x01B\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17RightsClaimPolicyReason\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"n\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"RightsType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"L\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"m\n" +
 	"\fSamplingRate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
-	"\x0funit_of_measure\x18\x02 \x01(\tR\runitOfMeasure\"o\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12+\n" +
+	"\x0funit_of_measure\x18\x02 \x01(\tH\x00R\runitOfMeasure\x88\x01\x01B\x12\n" +
+	"\x10_unit_of_measureJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vSessionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x91\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd9\x01\n" +
 	"\x13SheetMusicCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\x8b\x01\n" +
-	"\fSheetMusicId\x12\x15\n" +
-	"\ai_s_m_n\x18\x01 \x01(\tR\x04iSMN\x12C\n" +
-	"\x0eproprietary_id\x18\x02 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x03 \x01(\bR\n" +
-	"isReplaced\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb9\x01\n" +
+	"\fSheetMusicId\x12\x1a\n" +
+	"\ai_s_m_n\x18\x01 \x01(\tH\x00R\x04iSMN\x88\x01\x01\x12C\n" +
+	"\x0eproprietary_id\x18\x02 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x03 \x01(\bH\x01R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_m_nB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0eSheetMusicType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"p\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x01\n" +
 	"\fSoftwareType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd4\x01\n" +
-	"\x10SoundRecordingId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x82\x02\n" +
+	"\x10SoundRecordingId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\x02 \x01(\v2\x1c.ddex.ern.v432.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x03 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x04 \x01(\bR\n" +
-	"isReplaced\"v\n" +
+	"\x0eproprietary_id\x18\x03 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x04 \x01(\bH\x01R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12SoundRecordingType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"z\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb1\x01\n" +
 	"\x16SpecialContributorType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x95\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9d\x01\n" +
 	"\x10SubGenreCategory\x12:\n" +
 	"\x05value\x18\x01 \x03(\v2$.ddex.ern.v432.SubGenreCategoryValueR\x05value\x12E\n" +
-	"\vdescription\x18\x02 \x03(\v2#.ddex.ern.v432.TextWithoutTerritoryR\vdescription\"y\n" +
+	"\vdescription\x18\x02 \x03(\v2#.ddex.ern.v432.TextWithoutTerritoryR\vdescriptionJ\x06\b\xa8F\x10\x90N\"\xb0\x01\n" +
 	"\x15SubGenreCategoryValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8b\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd3\x01\n" +
 	"\rTextCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xb3\x01\n" +
-	"\x06TextId\x12\x15\n" +
-	"\ai_s_b_n\x18\x01 \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\x02 \x01(\tR\x04iSSN\x12\x15\n" +
-	"\as_i_c_i\x18\x03 \x01(\tR\x04sICI\x12C\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x1f\n" +
-	"\vis_replaced\x18\x05 \x01(\bR\n" +
-	"isReplaced\"l\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x83\x02\n" +
+	"\x06TextId\x12\x1a\n" +
+	"\ai_s_b_n\x18\x01 \x01(\tH\x00R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\x02 \x01(\tH\x01R\x04iSSN\x88\x01\x01\x12\x1a\n" +
+	"\as_i_c_i\x18\x03 \x01(\tH\x02R\x04sICI\x88\x01\x01\x12C\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12$\n" +
+	"\vis_replaced\x18\x05 \x01(\bH\x03R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90N\"\xa3\x01\n" +
 	"\bTextType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x9e\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xbe\x03\n" +
 	"\x0eTextWithFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xa4\x02\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc4\x03\n" +
 	"\x14TextWithoutTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xdd\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa0\x02\n" +
 	"\x17TitleDisplayInformation\x121\n" +
 	"\x15is_displayed_in_title\x18\x01 \x01(\bR\x12isDisplayedInTitle\x12-\n" +
-	"\x06prefix\x18\x02 \x03(\v2\x15.ddex.ern.v432.PrefixR\x06prefix\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\"~\n" +
+	"\x06prefix\x18\x02 \x03(\v2\x15.ddex.ern.v432.PrefixR\x06prefix\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x01R\x0esequenceNumber\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x86\x01\n" +
 	"\x0eValidityPeriod\x127\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x18.ddex.ern.v432.EventDateR\tstartDate\x123\n" +
-	"\bend_date\x18\x02 \x01(\v2\x18.ddex.ern.v432.EventDateR\aendDate\"\xd7\x01\n" +
-	"\x05Venue\x12\x1d\n" +
+	"\bend_date\x18\x02 \x01(\v2\x18.ddex.ern.v432.EventDateR\aendDateJ\x06\b\xa8F\x10\x90N\"\xb5\x02\n" +
+	"\x05Venue\x12\"\n" +
 	"\n" +
-	"venue_name\x18\x01 \x01(\tR\tvenueName\x12#\n" +
-	"\rvenue_address\x18\x02 \x01(\tR\fvenueAddress\x12F\n" +
-	"\x0eterritory_code\x18\x03 \x01(\v2\x1f.ddex.ern.v432.AllTerritoryCodeR\rterritoryCode\x12#\n" +
-	"\rlocation_code\x18\x04 \x01(\tR\flocationCode\x12\x1d\n" +
+	"venue_name\x18\x01 \x01(\tH\x00R\tvenueName\x88\x01\x01\x12(\n" +
+	"\rvenue_address\x18\x02 \x01(\tH\x01R\fvenueAddress\x88\x01\x01\x12F\n" +
+	"\x0eterritory_code\x18\x03 \x01(\v2\x1f.ddex.ern.v432.AllTerritoryCodeR\rterritoryCode\x12(\n" +
+	"\rlocation_code\x18\x04 \x01(\tH\x02R\flocationCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"venue_room\x18\x05 \x01(\tR\tvenueRoom\"o\n" +
+	"venue_room\x18\x05 \x01(\tH\x03R\tvenueRoom\x88\x01\x01B\r\n" +
+	"\v_venue_nameB\x10\n" +
+	"\x0e_venue_addressB\x10\n" +
+	"\x0e_location_codeB\r\n" +
+	"\v_venue_roomJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vVersionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8c\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x0eVideoCodecType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"w\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13VideoDefinitionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x93\x02\n" +
-	"\aVideoId\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_a_n\x18\x02 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x03 \x01(\tR\x05vISAN\x12C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xe5\x02\n" +
+	"\aVideoId\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x02 \x01(\tH\x01R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x03 \x01(\tH\x02R\x05vISAN\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\x04 \x01(\v2\x1c.ddex.ern.v432.CatalogNumberR\rcatalogNumber\x12C\n" +
 	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.ern.v432.ProprietaryIdR\rproprietaryId\x12\x15\n" +
-	"\ae_i_d_r\x18\x06 \x03(\tR\x04eIDR\x12\x1f\n" +
-	"\vis_replaced\x18\a \x01(\bR\n" +
-	"isReplacedB\xa4\x01\n" +
+	"\ae_i_d_r\x18\x06 \x03(\tR\x04eIDR\x12$\n" +
+	"\vis_replaced\x18\a \x01(\bH\x03R\n" +
+	"isReplaced\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\x0e\n" +
+	"\f_is_replacedJ\x06\b\xa8F\x10\x90NB\xa4\x01\n" +
 	"\x11com.ddex.ern.v432B\tV432ProtoP\x01Z.github.com/alecsavvy/ddex-go/gen/ddex/ern/v432\xa2\x02\x03DEX\xaa\x02\rDdex.Ern.V432\xca\x02\rDdex\\Ern\\V432\xe2\x02\x19Ddex\\Ern\\V432\\GPBMetadata\xea\x02\x0fDdex::Ern::V432b\x06proto3"
 
 var (
@@ -20082,212 +20750,212 @@ func file_ddex_ern_v432_v432_proto_rawDescGZIP() []byte {
 
 var file_ddex_ern_v432_v432_proto_msgTypes = make([]protoimpl.MessageInfo, 206)
 var file_ddex_ern_v432_v432_proto_goTypes = []any{
-	(*NewReleaseMessage)(nil),             // 0: ddex.ern.v432.NewReleaseMessage
-	(*PurgeReleaseMessage)(nil),           // 1: ddex.ern.v432.PurgeReleaseMessage
-	(*AdministratingRecordCompany)(nil),   // 2: ddex.ern.v432.AdministratingRecordCompany
-	(*AudioDeliveryFile)(nil),             // 3: ddex.ern.v432.AudioDeliveryFile
-	(*AvRating)(nil),                      // 4: ddex.ern.v432.AvRating
-	(*Brand)(nil),                         // 5: ddex.ern.v432.Brand
-	(*Channel)(nil),                       // 6: ddex.ern.v432.Channel
-	(*Chapter)(nil),                       // 7: ddex.ern.v432.Chapter
-	(*ChapterList)(nil),                   // 8: ddex.ern.v432.ChapterList
-	(*Character)(nil),                     // 9: ddex.ern.v432.Character
-	(*ClipDetails)(nil),                   // 10: ddex.ern.v432.ClipDetails
-	(*ClipRelease)(nil),                   // 11: ddex.ern.v432.ClipRelease
-	(*CommercialModelType)(nil),           // 12: ddex.ern.v432.CommercialModelType
-	(*ConditionForRightsClaimPolicy)(nil), // 13: ddex.ern.v432.ConditionForRightsClaimPolicy
-	(*CoreArea)(nil),                      // 14: ddex.ern.v432.CoreArea
-	(*Cue)(nil),                           // 15: ddex.ern.v432.Cue
-	(*CueSheet)(nil),                      // 16: ddex.ern.v432.CueSheet
-	(*CueSheetList)(nil),                  // 17: ddex.ern.v432.CueSheetList
-	(*Deal)(nil),                          // 18: ddex.ern.v432.Deal
-	(*DealList)(nil),                      // 19: ddex.ern.v432.DealList
-	(*DealResourceReferenceList)(nil),     // 20: ddex.ern.v432.DealResourceReferenceList
+	(*NewReleaseMessage)(nil),                         // 0: ddex.ern.v432.NewReleaseMessage
+	(*PurgeReleaseMessage)(nil),                       // 1: ddex.ern.v432.PurgeReleaseMessage
+	(*AdministratingRecordCompany)(nil),               // 2: ddex.ern.v432.AdministratingRecordCompany
+	(*AudioDeliveryFile)(nil),                         // 3: ddex.ern.v432.AudioDeliveryFile
+	(*AvRating)(nil),                                  // 4: ddex.ern.v432.AvRating
+	(*Brand)(nil),                                     // 5: ddex.ern.v432.Brand
+	(*Channel)(nil),                                   // 6: ddex.ern.v432.Channel
+	(*Chapter)(nil),                                   // 7: ddex.ern.v432.Chapter
+	(*ChapterList)(nil),                               // 8: ddex.ern.v432.ChapterList
+	(*Character)(nil),                                 // 9: ddex.ern.v432.Character
+	(*ClipDetails)(nil),                               // 10: ddex.ern.v432.ClipDetails
+	(*ClipRelease)(nil),                               // 11: ddex.ern.v432.ClipRelease
+	(*CommercialModelType)(nil),                       // 12: ddex.ern.v432.CommercialModelType
+	(*ConditionForRightsClaimPolicy)(nil),             // 13: ddex.ern.v432.ConditionForRightsClaimPolicy
+	(*CoreArea)(nil),                                  // 14: ddex.ern.v432.CoreArea
+	(*Cue)(nil),                                       // 15: ddex.ern.v432.Cue
+	(*CueSheet)(nil),                                  // 16: ddex.ern.v432.CueSheet
+	(*CueSheetList)(nil),                              // 17: ddex.ern.v432.CueSheetList
+	(*Deal)(nil),                                      // 18: ddex.ern.v432.Deal
+	(*DealList)(nil),                                  // 19: ddex.ern.v432.DealList
+	(*DealResourceReferenceList)(nil),                 // 20: ddex.ern.v432.DealResourceReferenceList
 	(*DealTechnicalResourceDetailsReferenceList)(nil), // 21: ddex.ern.v432.DealTechnicalResourceDetailsReferenceList
-	(*DealTerms)(nil),                              // 22: ddex.ern.v432.DealTerms
-	(*DealTermsTechnicalInstantiation)(nil),        // 23: ddex.ern.v432.DealTermsTechnicalInstantiation
-	(*Deity)(nil),                                  // 24: ddex.ern.v432.Deity
-	(*DelegatedUsageRights)(nil),                   // 25: ddex.ern.v432.DelegatedUsageRights
-	(*DescriptionWithTerritory)(nil),               // 26: ddex.ern.v432.DescriptionWithTerritory
-	(*DetailedResourceContributor)(nil),            // 27: ddex.ern.v432.DetailedResourceContributor
-	(*DiscoverableUseType)(nil),                    // 28: ddex.ern.v432.DiscoverableUseType
-	(*DisplayArtist)(nil),                          // 29: ddex.ern.v432.DisplayArtist
-	(*DisplayTitleText)(nil),                       // 30: ddex.ern.v432.DisplayTitleText
-	(*DistributionChannelPage)(nil),                // 31: ddex.ern.v432.DistributionChannelPage
-	(*EditionContributor)(nil),                     // 32: ddex.ern.v432.EditionContributor
-	(*EventDateTimeWithoutFlags)(nil),              // 33: ddex.ern.v432.EventDateTimeWithoutFlags
-	(*EventDateWithCurrentTerritory)(nil),          // 34: ddex.ern.v432.EventDateWithCurrentTerritory
-	(*EventDateWithDefault)(nil),                   // 35: ddex.ern.v432.EventDateWithDefault
-	(*EventDateWithoutFlags)(nil),                  // 36: ddex.ern.v432.EventDateWithoutFlags
-	(*ExternalResourceLink)(nil),                   // 37: ddex.ern.v432.ExternalResourceLink
-	(*HdrVideoDynamicMetadataType)(nil),            // 38: ddex.ern.v432.HdrVideoDynamicMetadataType
-	(*Image)(nil),                                  // 39: ddex.ern.v432.Image
-	(*LinkedReleaseResourceReference)(nil),         // 40: ddex.ern.v432.LinkedReleaseResourceReference
-	(*LocationAndDateOfSession)(nil),               // 41: ddex.ern.v432.LocationAndDateOfSession
-	(*Party)(nil),                                  // 42: ddex.ern.v432.Party
-	(*PartyList)(nil),                              // 43: ddex.ern.v432.PartyList
-	(*PartyNameWithTerritory)(nil),                 // 44: ddex.ern.v432.PartyNameWithTerritory
-	(*PartyWithRole)(nil),                          // 45: ddex.ern.v432.PartyWithRole
-	(*PeriodWithStartDate)(nil),                    // 46: ddex.ern.v432.PeriodWithStartDate
-	(*PeriodWithoutFlags)(nil),                     // 47: ddex.ern.v432.PeriodWithoutFlags
-	(*PhysicalReturns)(nil),                        // 48: ddex.ern.v432.PhysicalReturns
-	(*PriceInformation)(nil),                       // 49: ddex.ern.v432.PriceInformation
-	(*PurgedRelease)(nil),                          // 50: ddex.ern.v432.PurgedRelease
-	(*Raga)(nil),                                   // 51: ddex.ern.v432.Raga
-	(*RecordingFormat)(nil),                        // 52: ddex.ern.v432.RecordingFormat
-	(*RelatedRelease)(nil),                         // 53: ddex.ern.v432.RelatedRelease
-	(*RelatedResource)(nil),                        // 54: ddex.ern.v432.RelatedResource
-	(*Release)(nil),                                // 55: ddex.ern.v432.Release
-	(*ReleaseAdmin)(nil),                           // 56: ddex.ern.v432.ReleaseAdmin
-	(*ReleaseDeal)(nil),                            // 57: ddex.ern.v432.ReleaseDeal
-	(*ReleaseId)(nil),                              // 58: ddex.ern.v432.ReleaseId
-	(*ReleaseLabelReference)(nil),                  // 59: ddex.ern.v432.ReleaseLabelReference
-	(*ReleaseLabelReferenceWithParty)(nil),         // 60: ddex.ern.v432.ReleaseLabelReferenceWithParty
-	(*ReleaseList)(nil),                            // 61: ddex.ern.v432.ReleaseList
-	(*ReleaseVisibility)(nil),                      // 62: ddex.ern.v432.ReleaseVisibility
-	(*ResourceGroup)(nil),                          // 63: ddex.ern.v432.ResourceGroup
-	(*ResourceGroupContentItem)(nil),               // 64: ddex.ern.v432.ResourceGroupContentItem
-	(*ResourceList)(nil),                           // 65: ddex.ern.v432.ResourceList
-	(*ResourceRightsController)(nil),               // 66: ddex.ern.v432.ResourceRightsController
-	(*ResourceSubGroup)(nil),                       // 67: ddex.ern.v432.ResourceSubGroup
-	(*RightsClaimPolicy)(nil),                      // 68: ddex.ern.v432.RightsClaimPolicy
-	(*Segment)(nil),                                // 69: ddex.ern.v432.Segment
-	(*ServiceException)(nil),                       // 70: ddex.ern.v432.ServiceException
-	(*SheetMusic)(nil),                             // 71: ddex.ern.v432.SheetMusic
-	(*Software)(nil),                               // 72: ddex.ern.v432.Software
-	(*SoundRecording)(nil),                         // 73: ddex.ern.v432.SoundRecording
-	(*SoundRecordingClipDetails)(nil),              // 74: ddex.ern.v432.SoundRecordingClipDetails
-	(*SoundRecordingEdition)(nil),                  // 75: ddex.ern.v432.SoundRecordingEdition
-	(*SupplementalDocumentList)(nil),               // 76: ddex.ern.v432.SupplementalDocumentList
-	(*SynopsisWithTerritory)(nil),                  // 77: ddex.ern.v432.SynopsisWithTerritory
-	(*Tala)(nil),                                   // 78: ddex.ern.v432.Tala
-	(*TechnicalImageDetails)(nil),                  // 79: ddex.ern.v432.TechnicalImageDetails
-	(*TechnicalSheetMusicDetails)(nil),             // 80: ddex.ern.v432.TechnicalSheetMusicDetails
-	(*TechnicalSoftwareDetails)(nil),               // 81: ddex.ern.v432.TechnicalSoftwareDetails
-	(*TechnicalSoundRecordingDetails)(nil),         // 82: ddex.ern.v432.TechnicalSoundRecordingDetails
-	(*TechnicalTextDetails)(nil),                   // 83: ddex.ern.v432.TechnicalTextDetails
-	(*TechnicalVideoDetails)(nil),                  // 84: ddex.ern.v432.TechnicalVideoDetails
-	(*Text)(nil),                                   // 85: ddex.ern.v432.Text
-	(*Timing)(nil),                                 // 86: ddex.ern.v432.Timing
-	(*Title)(nil),                                  // 87: ddex.ern.v432.Title
-	(*TrackRelease)(nil),                           // 88: ddex.ern.v432.TrackRelease
-	(*TrackReleaseVisibility)(nil),                 // 89: ddex.ern.v432.TrackReleaseVisibility
-	(*UseType)(nil),                                // 90: ddex.ern.v432.UseType
-	(*UserInterfaceType)(nil),                      // 91: ddex.ern.v432.UserInterfaceType
-	(*Video)(nil),                                  // 92: ddex.ern.v432.Video
-	(*VideoClipDetails)(nil),                       // 93: ddex.ern.v432.VideoClipDetails
-	(*VideoDeliveryFile)(nil),                      // 94: ddex.ern.v432.VideoDeliveryFile
-	(*VideoEdition)(nil),                           // 95: ddex.ern.v432.VideoEdition
-	(*VideoType)(nil),                              // 96: ddex.ern.v432.VideoType
-	(*WorkRightsController)(nil),                   // 97: ddex.ern.v432.WorkRightsController
-	(*AdministratingRecordCompanyRole)(nil),        // 98: ddex.ern.v432.AdministratingRecordCompanyRole
-	(*Affiliation)(nil),                            // 99: ddex.ern.v432.Affiliation
-	(*AllTerritoryCode)(nil),                       // 100: ddex.ern.v432.AllTerritoryCode
-	(*AspectRatio)(nil),                            // 101: ddex.ern.v432.AspectRatio
-	(*AudioCodecType)(nil),                         // 102: ddex.ern.v432.AudioCodecType
-	(*BitRate)(nil),                                // 103: ddex.ern.v432.BitRate
-	(*CLine)(nil),                                  // 104: ddex.ern.v432.CLine
-	(*CarrierType)(nil),                            // 105: ddex.ern.v432.CarrierType
-	(*CatalogNumber)(nil),                          // 106: ddex.ern.v432.CatalogNumber
-	(*ChapterId)(nil),                              // 107: ddex.ern.v432.ChapterId
-	(*ClipType)(nil),                               // 108: ddex.ern.v432.ClipType
-	(*ContainerFormat)(nil),                        // 109: ddex.ern.v432.ContainerFormat
-	(*Contributor)(nil),                            // 110: ddex.ern.v432.Contributor
-	(*ContributorRole)(nil),                        // 111: ddex.ern.v432.ContributorRole
-	(*ContributorRoleValue)(nil),                   // 112: ddex.ern.v432.ContributorRoleValue
-	(*CourtesyLine)(nil),                           // 113: ddex.ern.v432.CourtesyLine
-	(*CueOrigin)(nil),                              // 114: ddex.ern.v432.CueOrigin
-	(*CueSheetType)(nil),                           // 115: ddex.ern.v432.CueSheetType
-	(*CueThemeType)(nil),                           // 116: ddex.ern.v432.CueThemeType
-	(*CueUseType)(nil),                             // 117: ddex.ern.v432.CueUseType
-	(*CueVisualPerceptionType)(nil),                // 118: ddex.ern.v432.CueVisualPerceptionType
-	(*CueVocalType)(nil),                           // 119: ddex.ern.v432.CueVocalType
-	(*CurrentTerritoryCode)(nil),                   // 120: ddex.ern.v432.CurrentTerritoryCode
-	(*DSP)(nil),                                    // 121: ddex.ern.v432.DSP
-	(*DetailedHashSum)(nil),                        // 122: ddex.ern.v432.DetailedHashSum
-	(*DetailedPartyId)(nil),                        // 123: ddex.ern.v432.DetailedPartyId
-	(*DisplayArtistNameWithOriginalLanguage)(nil),  // 124: ddex.ern.v432.DisplayArtistNameWithOriginalLanguage
-	(*DisplayArtistRole)(nil),                      // 125: ddex.ern.v432.DisplayArtistRole
-	(*DisplayCredits)(nil),                         // 126: ddex.ern.v432.DisplayCredits
-	(*DisplaySubTitle)(nil),                        // 127: ddex.ern.v432.DisplaySubTitle
-	(*DisplayTitle)(nil),                           // 128: ddex.ern.v432.DisplayTitle
-	(*EventDate)(nil),                              // 129: ddex.ern.v432.EventDate
-	(*EventDateTime)(nil),                          // 130: ddex.ern.v432.EventDateTime
-	(*Extent)(nil),                                 // 131: ddex.ern.v432.Extent
-	(*ExternallyLinkedResourceType)(nil),           // 132: ddex.ern.v432.ExternallyLinkedResourceType
-	(*File)(nil),                                   // 133: ddex.ern.v432.File
-	(*Fingerprint)(nil),                            // 134: ddex.ern.v432.Fingerprint
-	(*FingerprintAlgorithmType)(nil),               // 135: ddex.ern.v432.FingerprintAlgorithmType
-	(*FirstPublicationDate)(nil),                   // 136: ddex.ern.v432.FirstPublicationDate
-	(*FrameRate)(nil),                              // 137: ddex.ern.v432.FrameRate
-	(*FulfillmentDate)(nil),                        // 138: ddex.ern.v432.FulfillmentDate
-	(*GenreCategory)(nil),                          // 139: ddex.ern.v432.GenreCategory
-	(*GenreCategoryValue)(nil),                     // 140: ddex.ern.v432.GenreCategoryValue
-	(*GenreWithTerritory)(nil),                     // 141: ddex.ern.v432.GenreWithTerritory
-	(*HashSumAlgorithmType)(nil),                   // 142: ddex.ern.v432.HashSumAlgorithmType
-	(*ImageCodecType)(nil),                         // 143: ddex.ern.v432.ImageCodecType
-	(*ImageType)(nil),                              // 144: ddex.ern.v432.ImageType
-	(*InstrumentType)(nil),                         // 145: ddex.ern.v432.InstrumentType
-	(*IsCredited)(nil),                             // 146: ddex.ern.v432.IsCredited
-	(*KeywordsWithTerritory)(nil),                  // 147: ddex.ern.v432.KeywordsWithTerritory
-	(*Language)(nil),                               // 148: ddex.ern.v432.Language
-	(*MarketingComment)(nil),                       // 149: ddex.ern.v432.MarketingComment
-	(*MessageAuditTrail)(nil),                      // 150: ddex.ern.v432.MessageAuditTrail
-	(*MessageAuditTrailEvent)(nil),                 // 151: ddex.ern.v432.MessageAuditTrailEvent
-	(*MessageHeader)(nil),                          // 152: ddex.ern.v432.MessageHeader
-	(*MessagingPartyWithoutCode)(nil),              // 153: ddex.ern.v432.MessagingPartyWithoutCode
-	(*MusicalWorkId)(nil),                          // 154: ddex.ern.v432.MusicalWorkId
-	(*Name)(nil),                                   // 155: ddex.ern.v432.Name
-	(*OperatingSystemType)(nil),                    // 156: ddex.ern.v432.OperatingSystemType
-	(*PLine)(nil),                                  // 157: ddex.ern.v432.PLine
-	(*ParentalWarningTypeWithStandard)(nil),        // 158: ddex.ern.v432.ParentalWarningTypeWithStandard
-	(*PartyName)(nil),                              // 159: ddex.ern.v432.PartyName
-	(*PartyNameWithoutCode)(nil),                   // 160: ddex.ern.v432.PartyNameWithoutCode
-	(*PartyRelationshipType)(nil),                  // 161: ddex.ern.v432.PartyRelationshipType
-	(*Percentage)(nil),                             // 162: ddex.ern.v432.Percentage
-	(*Period)(nil),                                 // 163: ddex.ern.v432.Period
-	(*Prefix)(nil),                                 // 164: ddex.ern.v432.Prefix
-	(*Price)(nil),                                  // 165: ddex.ern.v432.Price
-	(*PriceType)(nil),                              // 166: ddex.ern.v432.PriceType
-	(*PromotionalCode)(nil),                        // 167: ddex.ern.v432.PromotionalCode
-	(*ProprietaryId)(nil),                          // 168: ddex.ern.v432.ProprietaryId
-	(*Purpose)(nil),                                // 169: ddex.ern.v432.Purpose
-	(*RatingAgency)(nil),                           // 170: ddex.ern.v432.RatingAgency
-	(*RatingReason)(nil),                           // 171: ddex.ern.v432.RatingReason
-	(*Reason)(nil),                                 // 172: ddex.ern.v432.Reason
-	(*RelatedParty)(nil),                           // 173: ddex.ern.v432.RelatedParty
-	(*ReleaseRelationshipType)(nil),                // 174: ddex.ern.v432.ReleaseRelationshipType
-	(*ReleaseTypeForReleaseNotification)(nil),      // 175: ddex.ern.v432.ReleaseTypeForReleaseNotification
-	(*ResourceContainedResourceReference)(nil),     // 176: ddex.ern.v432.ResourceContainedResourceReference
-	(*ResourceContainedResourceReferenceList)(nil), // 177: ddex.ern.v432.ResourceContainedResourceReferenceList
-	(*ResourceContributorRole)(nil),                // 178: ddex.ern.v432.ResourceContributorRole
-	(*ResourceId)(nil),                             // 179: ddex.ern.v432.ResourceId
-	(*ResourceProprietaryId)(nil),                  // 180: ddex.ern.v432.ResourceProprietaryId
-	(*RightsClaimPolicyReason)(nil),                // 181: ddex.ern.v432.RightsClaimPolicyReason
-	(*RightsType)(nil),                             // 182: ddex.ern.v432.RightsType
-	(*SamplingRate)(nil),                           // 183: ddex.ern.v432.SamplingRate
-	(*SessionType)(nil),                            // 184: ddex.ern.v432.SessionType
-	(*SheetMusicCodecType)(nil),                    // 185: ddex.ern.v432.SheetMusicCodecType
-	(*SheetMusicId)(nil),                           // 186: ddex.ern.v432.SheetMusicId
-	(*SheetMusicType)(nil),                         // 187: ddex.ern.v432.SheetMusicType
-	(*SoftwareType)(nil),                           // 188: ddex.ern.v432.SoftwareType
-	(*SoundRecordingId)(nil),                       // 189: ddex.ern.v432.SoundRecordingId
-	(*SoundRecordingType)(nil),                     // 190: ddex.ern.v432.SoundRecordingType
-	(*SpecialContributorType)(nil),                 // 191: ddex.ern.v432.SpecialContributorType
-	(*SubGenreCategory)(nil),                       // 192: ddex.ern.v432.SubGenreCategory
-	(*SubGenreCategoryValue)(nil),                  // 193: ddex.ern.v432.SubGenreCategoryValue
-	(*TextCodecType)(nil),                          // 194: ddex.ern.v432.TextCodecType
-	(*TextId)(nil),                                 // 195: ddex.ern.v432.TextId
-	(*TextType)(nil),                               // 196: ddex.ern.v432.TextType
-	(*TextWithFormat)(nil),                         // 197: ddex.ern.v432.TextWithFormat
-	(*TextWithoutTerritory)(nil),                   // 198: ddex.ern.v432.TextWithoutTerritory
-	(*TitleDisplayInformation)(nil),                // 199: ddex.ern.v432.TitleDisplayInformation
-	(*ValidityPeriod)(nil),                         // 200: ddex.ern.v432.ValidityPeriod
-	(*Venue)(nil),                                  // 201: ddex.ern.v432.Venue
-	(*VersionType)(nil),                            // 202: ddex.ern.v432.VersionType
-	(*VideoCodecType)(nil),                         // 203: ddex.ern.v432.VideoCodecType
-	(*VideoDefinitionType)(nil),                    // 204: ddex.ern.v432.VideoDefinitionType
-	(*VideoId)(nil),                                // 205: ddex.ern.v432.VideoId
+	(*DealTerms)(nil),                                 // 22: ddex.ern.v432.DealTerms
+	(*DealTermsTechnicalInstantiation)(nil),           // 23: ddex.ern.v432.DealTermsTechnicalInstantiation
+	(*Deity)(nil),                                     // 24: ddex.ern.v432.Deity
+	(*DelegatedUsageRights)(nil),                      // 25: ddex.ern.v432.DelegatedUsageRights
+	(*DescriptionWithTerritory)(nil),                  // 26: ddex.ern.v432.DescriptionWithTerritory
+	(*DetailedResourceContributor)(nil),               // 27: ddex.ern.v432.DetailedResourceContributor
+	(*DiscoverableUseType)(nil),                       // 28: ddex.ern.v432.DiscoverableUseType
+	(*DisplayArtist)(nil),                             // 29: ddex.ern.v432.DisplayArtist
+	(*DisplayTitleText)(nil),                          // 30: ddex.ern.v432.DisplayTitleText
+	(*DistributionChannelPage)(nil),                   // 31: ddex.ern.v432.DistributionChannelPage
+	(*EditionContributor)(nil),                        // 32: ddex.ern.v432.EditionContributor
+	(*EventDateTimeWithoutFlags)(nil),                 // 33: ddex.ern.v432.EventDateTimeWithoutFlags
+	(*EventDateWithCurrentTerritory)(nil),             // 34: ddex.ern.v432.EventDateWithCurrentTerritory
+	(*EventDateWithDefault)(nil),                      // 35: ddex.ern.v432.EventDateWithDefault
+	(*EventDateWithoutFlags)(nil),                     // 36: ddex.ern.v432.EventDateWithoutFlags
+	(*ExternalResourceLink)(nil),                      // 37: ddex.ern.v432.ExternalResourceLink
+	(*HdrVideoDynamicMetadataType)(nil),               // 38: ddex.ern.v432.HdrVideoDynamicMetadataType
+	(*Image)(nil),                                     // 39: ddex.ern.v432.Image
+	(*LinkedReleaseResourceReference)(nil),            // 40: ddex.ern.v432.LinkedReleaseResourceReference
+	(*LocationAndDateOfSession)(nil),                  // 41: ddex.ern.v432.LocationAndDateOfSession
+	(*Party)(nil),                                     // 42: ddex.ern.v432.Party
+	(*PartyList)(nil),                                 // 43: ddex.ern.v432.PartyList
+	(*PartyNameWithTerritory)(nil),                    // 44: ddex.ern.v432.PartyNameWithTerritory
+	(*PartyWithRole)(nil),                             // 45: ddex.ern.v432.PartyWithRole
+	(*PeriodWithStartDate)(nil),                       // 46: ddex.ern.v432.PeriodWithStartDate
+	(*PeriodWithoutFlags)(nil),                        // 47: ddex.ern.v432.PeriodWithoutFlags
+	(*PhysicalReturns)(nil),                           // 48: ddex.ern.v432.PhysicalReturns
+	(*PriceInformation)(nil),                          // 49: ddex.ern.v432.PriceInformation
+	(*PurgedRelease)(nil),                             // 50: ddex.ern.v432.PurgedRelease
+	(*Raga)(nil),                                      // 51: ddex.ern.v432.Raga
+	(*RecordingFormat)(nil),                           // 52: ddex.ern.v432.RecordingFormat
+	(*RelatedRelease)(nil),                            // 53: ddex.ern.v432.RelatedRelease
+	(*RelatedResource)(nil),                           // 54: ddex.ern.v432.RelatedResource
+	(*Release)(nil),                                   // 55: ddex.ern.v432.Release
+	(*ReleaseAdmin)(nil),                              // 56: ddex.ern.v432.ReleaseAdmin
+	(*ReleaseDeal)(nil),                               // 57: ddex.ern.v432.ReleaseDeal
+	(*ReleaseId)(nil),                                 // 58: ddex.ern.v432.ReleaseId
+	(*ReleaseLabelReference)(nil),                     // 59: ddex.ern.v432.ReleaseLabelReference
+	(*ReleaseLabelReferenceWithParty)(nil),            // 60: ddex.ern.v432.ReleaseLabelReferenceWithParty
+	(*ReleaseList)(nil),                               // 61: ddex.ern.v432.ReleaseList
+	(*ReleaseVisibility)(nil),                         // 62: ddex.ern.v432.ReleaseVisibility
+	(*ResourceGroup)(nil),                             // 63: ddex.ern.v432.ResourceGroup
+	(*ResourceGroupContentItem)(nil),                  // 64: ddex.ern.v432.ResourceGroupContentItem
+	(*ResourceList)(nil),                              // 65: ddex.ern.v432.ResourceList
+	(*ResourceRightsController)(nil),                  // 66: ddex.ern.v432.ResourceRightsController
+	(*ResourceSubGroup)(nil),                          // 67: ddex.ern.v432.ResourceSubGroup
+	(*RightsClaimPolicy)(nil),                         // 68: ddex.ern.v432.RightsClaimPolicy
+	(*Segment)(nil),                                   // 69: ddex.ern.v432.Segment
+	(*ServiceException)(nil),                          // 70: ddex.ern.v432.ServiceException
+	(*SheetMusic)(nil),                                // 71: ddex.ern.v432.SheetMusic
+	(*Software)(nil),                                  // 72: ddex.ern.v432.Software
+	(*SoundRecording)(nil),                            // 73: ddex.ern.v432.SoundRecording
+	(*SoundRecordingClipDetails)(nil),                 // 74: ddex.ern.v432.SoundRecordingClipDetails
+	(*SoundRecordingEdition)(nil),                     // 75: ddex.ern.v432.SoundRecordingEdition
+	(*SupplementalDocumentList)(nil),                  // 76: ddex.ern.v432.SupplementalDocumentList
+	(*SynopsisWithTerritory)(nil),                     // 77: ddex.ern.v432.SynopsisWithTerritory
+	(*Tala)(nil),                                      // 78: ddex.ern.v432.Tala
+	(*TechnicalImageDetails)(nil),                     // 79: ddex.ern.v432.TechnicalImageDetails
+	(*TechnicalSheetMusicDetails)(nil),                // 80: ddex.ern.v432.TechnicalSheetMusicDetails
+	(*TechnicalSoftwareDetails)(nil),                  // 81: ddex.ern.v432.TechnicalSoftwareDetails
+	(*TechnicalSoundRecordingDetails)(nil),            // 82: ddex.ern.v432.TechnicalSoundRecordingDetails
+	(*TechnicalTextDetails)(nil),                      // 83: ddex.ern.v432.TechnicalTextDetails
+	(*TechnicalVideoDetails)(nil),                     // 84: ddex.ern.v432.TechnicalVideoDetails
+	(*Text)(nil),                                      // 85: ddex.ern.v432.Text
+	(*Timing)(nil),                                    // 86: ddex.ern.v432.Timing
+	(*Title)(nil),                                     // 87: ddex.ern.v432.Title
+	(*TrackRelease)(nil),                              // 88: ddex.ern.v432.TrackRelease
+	(*TrackReleaseVisibility)(nil),                    // 89: ddex.ern.v432.TrackReleaseVisibility
+	(*UseType)(nil),                                   // 90: ddex.ern.v432.UseType
+	(*UserInterfaceType)(nil),                         // 91: ddex.ern.v432.UserInterfaceType
+	(*Video)(nil),                                     // 92: ddex.ern.v432.Video
+	(*VideoClipDetails)(nil),                          // 93: ddex.ern.v432.VideoClipDetails
+	(*VideoDeliveryFile)(nil),                         // 94: ddex.ern.v432.VideoDeliveryFile
+	(*VideoEdition)(nil),                              // 95: ddex.ern.v432.VideoEdition
+	(*VideoType)(nil),                                 // 96: ddex.ern.v432.VideoType
+	(*WorkRightsController)(nil),                      // 97: ddex.ern.v432.WorkRightsController
+	(*AdministratingRecordCompanyRole)(nil),           // 98: ddex.ern.v432.AdministratingRecordCompanyRole
+	(*Affiliation)(nil),                               // 99: ddex.ern.v432.Affiliation
+	(*AllTerritoryCode)(nil),                          // 100: ddex.ern.v432.AllTerritoryCode
+	(*AspectRatio)(nil),                               // 101: ddex.ern.v432.AspectRatio
+	(*AudioCodecType)(nil),                            // 102: ddex.ern.v432.AudioCodecType
+	(*BitRate)(nil),                                   // 103: ddex.ern.v432.BitRate
+	(*CLine)(nil),                                     // 104: ddex.ern.v432.CLine
+	(*CarrierType)(nil),                               // 105: ddex.ern.v432.CarrierType
+	(*CatalogNumber)(nil),                             // 106: ddex.ern.v432.CatalogNumber
+	(*ChapterId)(nil),                                 // 107: ddex.ern.v432.ChapterId
+	(*ClipType)(nil),                                  // 108: ddex.ern.v432.ClipType
+	(*ContainerFormat)(nil),                           // 109: ddex.ern.v432.ContainerFormat
+	(*Contributor)(nil),                               // 110: ddex.ern.v432.Contributor
+	(*ContributorRole)(nil),                           // 111: ddex.ern.v432.ContributorRole
+	(*ContributorRoleValue)(nil),                      // 112: ddex.ern.v432.ContributorRoleValue
+	(*CourtesyLine)(nil),                              // 113: ddex.ern.v432.CourtesyLine
+	(*CueOrigin)(nil),                                 // 114: ddex.ern.v432.CueOrigin
+	(*CueSheetType)(nil),                              // 115: ddex.ern.v432.CueSheetType
+	(*CueThemeType)(nil),                              // 116: ddex.ern.v432.CueThemeType
+	(*CueUseType)(nil),                                // 117: ddex.ern.v432.CueUseType
+	(*CueVisualPerceptionType)(nil),                   // 118: ddex.ern.v432.CueVisualPerceptionType
+	(*CueVocalType)(nil),                              // 119: ddex.ern.v432.CueVocalType
+	(*CurrentTerritoryCode)(nil),                      // 120: ddex.ern.v432.CurrentTerritoryCode
+	(*DSP)(nil),                                       // 121: ddex.ern.v432.DSP
+	(*DetailedHashSum)(nil),                           // 122: ddex.ern.v432.DetailedHashSum
+	(*DetailedPartyId)(nil),                           // 123: ddex.ern.v432.DetailedPartyId
+	(*DisplayArtistNameWithOriginalLanguage)(nil),     // 124: ddex.ern.v432.DisplayArtistNameWithOriginalLanguage
+	(*DisplayArtistRole)(nil),                         // 125: ddex.ern.v432.DisplayArtistRole
+	(*DisplayCredits)(nil),                            // 126: ddex.ern.v432.DisplayCredits
+	(*DisplaySubTitle)(nil),                           // 127: ddex.ern.v432.DisplaySubTitle
+	(*DisplayTitle)(nil),                              // 128: ddex.ern.v432.DisplayTitle
+	(*EventDate)(nil),                                 // 129: ddex.ern.v432.EventDate
+	(*EventDateTime)(nil),                             // 130: ddex.ern.v432.EventDateTime
+	(*Extent)(nil),                                    // 131: ddex.ern.v432.Extent
+	(*ExternallyLinkedResourceType)(nil),              // 132: ddex.ern.v432.ExternallyLinkedResourceType
+	(*File)(nil),                                      // 133: ddex.ern.v432.File
+	(*Fingerprint)(nil),                               // 134: ddex.ern.v432.Fingerprint
+	(*FingerprintAlgorithmType)(nil),                  // 135: ddex.ern.v432.FingerprintAlgorithmType
+	(*FirstPublicationDate)(nil),                      // 136: ddex.ern.v432.FirstPublicationDate
+	(*FrameRate)(nil),                                 // 137: ddex.ern.v432.FrameRate
+	(*FulfillmentDate)(nil),                           // 138: ddex.ern.v432.FulfillmentDate
+	(*GenreCategory)(nil),                             // 139: ddex.ern.v432.GenreCategory
+	(*GenreCategoryValue)(nil),                        // 140: ddex.ern.v432.GenreCategoryValue
+	(*GenreWithTerritory)(nil),                        // 141: ddex.ern.v432.GenreWithTerritory
+	(*HashSumAlgorithmType)(nil),                      // 142: ddex.ern.v432.HashSumAlgorithmType
+	(*ImageCodecType)(nil),                            // 143: ddex.ern.v432.ImageCodecType
+	(*ImageType)(nil),                                 // 144: ddex.ern.v432.ImageType
+	(*InstrumentType)(nil),                            // 145: ddex.ern.v432.InstrumentType
+	(*IsCredited)(nil),                                // 146: ddex.ern.v432.IsCredited
+	(*KeywordsWithTerritory)(nil),                     // 147: ddex.ern.v432.KeywordsWithTerritory
+	(*Language)(nil),                                  // 148: ddex.ern.v432.Language
+	(*MarketingComment)(nil),                          // 149: ddex.ern.v432.MarketingComment
+	(*MessageAuditTrail)(nil),                         // 150: ddex.ern.v432.MessageAuditTrail
+	(*MessageAuditTrailEvent)(nil),                    // 151: ddex.ern.v432.MessageAuditTrailEvent
+	(*MessageHeader)(nil),                             // 152: ddex.ern.v432.MessageHeader
+	(*MessagingPartyWithoutCode)(nil),                 // 153: ddex.ern.v432.MessagingPartyWithoutCode
+	(*MusicalWorkId)(nil),                             // 154: ddex.ern.v432.MusicalWorkId
+	(*Name)(nil),                                      // 155: ddex.ern.v432.Name
+	(*OperatingSystemType)(nil),                       // 156: ddex.ern.v432.OperatingSystemType
+	(*PLine)(nil),                                     // 157: ddex.ern.v432.PLine
+	(*ParentalWarningTypeWithStandard)(nil),           // 158: ddex.ern.v432.ParentalWarningTypeWithStandard
+	(*PartyName)(nil),                                 // 159: ddex.ern.v432.PartyName
+	(*PartyNameWithoutCode)(nil),                      // 160: ddex.ern.v432.PartyNameWithoutCode
+	(*PartyRelationshipType)(nil),                     // 161: ddex.ern.v432.PartyRelationshipType
+	(*Percentage)(nil),                                // 162: ddex.ern.v432.Percentage
+	(*Period)(nil),                                    // 163: ddex.ern.v432.Period
+	(*Prefix)(nil),                                    // 164: ddex.ern.v432.Prefix
+	(*Price)(nil),                                     // 165: ddex.ern.v432.Price
+	(*PriceType)(nil),                                 // 166: ddex.ern.v432.PriceType
+	(*PromotionalCode)(nil),                           // 167: ddex.ern.v432.PromotionalCode
+	(*ProprietaryId)(nil),                             // 168: ddex.ern.v432.ProprietaryId
+	(*Purpose)(nil),                                   // 169: ddex.ern.v432.Purpose
+	(*RatingAgency)(nil),                              // 170: ddex.ern.v432.RatingAgency
+	(*RatingReason)(nil),                              // 171: ddex.ern.v432.RatingReason
+	(*Reason)(nil),                                    // 172: ddex.ern.v432.Reason
+	(*RelatedParty)(nil),                              // 173: ddex.ern.v432.RelatedParty
+	(*ReleaseRelationshipType)(nil),                   // 174: ddex.ern.v432.ReleaseRelationshipType
+	(*ReleaseTypeForReleaseNotification)(nil),         // 175: ddex.ern.v432.ReleaseTypeForReleaseNotification
+	(*ResourceContainedResourceReference)(nil),        // 176: ddex.ern.v432.ResourceContainedResourceReference
+	(*ResourceContainedResourceReferenceList)(nil),    // 177: ddex.ern.v432.ResourceContainedResourceReferenceList
+	(*ResourceContributorRole)(nil),                   // 178: ddex.ern.v432.ResourceContributorRole
+	(*ResourceId)(nil),                                // 179: ddex.ern.v432.ResourceId
+	(*ResourceProprietaryId)(nil),                     // 180: ddex.ern.v432.ResourceProprietaryId
+	(*RightsClaimPolicyReason)(nil),                   // 181: ddex.ern.v432.RightsClaimPolicyReason
+	(*RightsType)(nil),                                // 182: ddex.ern.v432.RightsType
+	(*SamplingRate)(nil),                              // 183: ddex.ern.v432.SamplingRate
+	(*SessionType)(nil),                               // 184: ddex.ern.v432.SessionType
+	(*SheetMusicCodecType)(nil),                       // 185: ddex.ern.v432.SheetMusicCodecType
+	(*SheetMusicId)(nil),                              // 186: ddex.ern.v432.SheetMusicId
+	(*SheetMusicType)(nil),                            // 187: ddex.ern.v432.SheetMusicType
+	(*SoftwareType)(nil),                              // 188: ddex.ern.v432.SoftwareType
+	(*SoundRecordingId)(nil),                          // 189: ddex.ern.v432.SoundRecordingId
+	(*SoundRecordingType)(nil),                        // 190: ddex.ern.v432.SoundRecordingType
+	(*SpecialContributorType)(nil),                    // 191: ddex.ern.v432.SpecialContributorType
+	(*SubGenreCategory)(nil),                          // 192: ddex.ern.v432.SubGenreCategory
+	(*SubGenreCategoryValue)(nil),                     // 193: ddex.ern.v432.SubGenreCategoryValue
+	(*TextCodecType)(nil),                             // 194: ddex.ern.v432.TextCodecType
+	(*TextId)(nil),                                    // 195: ddex.ern.v432.TextId
+	(*TextType)(nil),                                  // 196: ddex.ern.v432.TextType
+	(*TextWithFormat)(nil),                            // 197: ddex.ern.v432.TextWithFormat
+	(*TextWithoutTerritory)(nil),                      // 198: ddex.ern.v432.TextWithoutTerritory
+	(*TitleDisplayInformation)(nil),                   // 199: ddex.ern.v432.TitleDisplayInformation
+	(*ValidityPeriod)(nil),                            // 200: ddex.ern.v432.ValidityPeriod
+	(*Venue)(nil),                                     // 201: ddex.ern.v432.Venue
+	(*VersionType)(nil),                               // 202: ddex.ern.v432.VersionType
+	(*VideoCodecType)(nil),                            // 203: ddex.ern.v432.VideoCodecType
+	(*VideoDefinitionType)(nil),                       // 204: ddex.ern.v432.VideoDefinitionType
+	(*VideoId)(nil),                                   // 205: ddex.ern.v432.VideoId
 }
 var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	152, // 0: ddex.ern.v432.NewReleaseMessage.message_header:type_name -> ddex.ern.v432.MessageHeader
@@ -20340,15 +21008,15 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	119, // 47: ddex.ern.v432.Cue.cue_vocal_type:type_name -> ddex.ern.v432.CueVocalType
 	118, // 48: ddex.ern.v432.Cue.cue_visual_perception_type:type_name -> ddex.ern.v432.CueVisualPerceptionType
 	114, // 49: ddex.ern.v432.Cue.cue_origin:type_name -> ddex.ern.v432.CueOrigin
-	30,  // 50: ddex.ern.v432.Cue.display_title_text:type_name -> ddex.ern.v432.DisplayTitleText
-	128, // 51: ddex.ern.v432.Cue.display_title:type_name -> ddex.ern.v432.DisplayTitle
-	128, // 52: ddex.ern.v432.Cue.formal_title:type_name -> ddex.ern.v432.DisplayTitle
-	128, // 53: ddex.ern.v432.Cue.grouping_title:type_name -> ddex.ern.v432.DisplayTitle
-	110, // 54: ddex.ern.v432.Cue.contributor:type_name -> ddex.ern.v432.Contributor
-	157, // 55: ddex.ern.v432.Cue.p_line:type_name -> ddex.ern.v432.PLine
-	104, // 56: ddex.ern.v432.Cue.c_line:type_name -> ddex.ern.v432.CLine
-	179, // 57: ddex.ern.v432.Cue.resource_id:type_name -> ddex.ern.v432.ResourceId
-	154, // 58: ddex.ern.v432.Cue.work_id:type_name -> ddex.ern.v432.MusicalWorkId
+	179, // 50: ddex.ern.v432.Cue.resource_id:type_name -> ddex.ern.v432.ResourceId
+	154, // 51: ddex.ern.v432.Cue.work_id:type_name -> ddex.ern.v432.MusicalWorkId
+	30,  // 52: ddex.ern.v432.Cue.display_title_text:type_name -> ddex.ern.v432.DisplayTitleText
+	128, // 53: ddex.ern.v432.Cue.display_title:type_name -> ddex.ern.v432.DisplayTitle
+	128, // 54: ddex.ern.v432.Cue.formal_title:type_name -> ddex.ern.v432.DisplayTitle
+	128, // 55: ddex.ern.v432.Cue.grouping_title:type_name -> ddex.ern.v432.DisplayTitle
+	110, // 56: ddex.ern.v432.Cue.contributor:type_name -> ddex.ern.v432.Contributor
+	157, // 57: ddex.ern.v432.Cue.p_line:type_name -> ddex.ern.v432.PLine
+	104, // 58: ddex.ern.v432.Cue.c_line:type_name -> ddex.ern.v432.CLine
 	168, // 59: ddex.ern.v432.CueSheet.cue_sheet_id:type_name -> ddex.ern.v432.ProprietaryId
 	115, // 60: ddex.ern.v432.CueSheet.cue_sheet_type:type_name -> ddex.ern.v432.CueSheetType
 	15,  // 61: ddex.ern.v432.CueSheet.cue:type_name -> ddex.ern.v432.Cue
@@ -20359,42 +21027,42 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	57,  // 66: ddex.ern.v432.DealList.release_deal:type_name -> ddex.ern.v432.ReleaseDeal
 	62,  // 67: ddex.ern.v432.DealList.release_visibility:type_name -> ddex.ern.v432.ReleaseVisibility
 	89,  // 68: ddex.ern.v432.DealList.track_release_visibility:type_name -> ddex.ern.v432.TrackReleaseVisibility
-	46,  // 69: ddex.ern.v432.DealTerms.validity_period:type_name -> ddex.ern.v432.PeriodWithStartDate
-	12,  // 70: ddex.ern.v432.DealTerms.commercial_model_type:type_name -> ddex.ern.v432.CommercialModelType
-	28,  // 71: ddex.ern.v432.DealTerms.use_type:type_name -> ddex.ern.v432.DiscoverableUseType
-	91,  // 72: ddex.ern.v432.DealTerms.user_interface_type:type_name -> ddex.ern.v432.UserInterfaceType
-	105, // 73: ddex.ern.v432.DealTerms.carrier_type:type_name -> ddex.ern.v432.CarrierType
-	23,  // 74: ddex.ern.v432.DealTerms.technical_instantiation:type_name -> ddex.ern.v432.DealTermsTechnicalInstantiation
-	68,  // 75: ddex.ern.v432.DealTerms.rights_claim_policy:type_name -> ddex.ern.v432.RightsClaimPolicy
-	49,  // 76: ddex.ern.v432.DealTerms.price_information:type_name -> ddex.ern.v432.PriceInformation
-	20,  // 77: ddex.ern.v432.DealTerms.instant_gratification_resource_list:type_name -> ddex.ern.v432.DealResourceReferenceList
-	48,  // 78: ddex.ern.v432.DealTerms.physical_returns:type_name -> ddex.ern.v432.PhysicalReturns
-	120, // 79: ddex.ern.v432.DealTerms.territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
-	120, // 80: ddex.ern.v432.DealTerms.excluded_territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
-	121, // 81: ddex.ern.v432.DealTerms.distribution_channel:type_name -> ddex.ern.v432.DSP
-	121, // 82: ddex.ern.v432.DealTerms.excluded_distribution_channel:type_name -> ddex.ern.v432.DSP
-	167, // 83: ddex.ern.v432.DealTerms.promotional_code:type_name -> ddex.ern.v432.PromotionalCode
+	120, // 69: ddex.ern.v432.DealTerms.territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
+	120, // 70: ddex.ern.v432.DealTerms.excluded_territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
+	46,  // 71: ddex.ern.v432.DealTerms.validity_period:type_name -> ddex.ern.v432.PeriodWithStartDate
+	12,  // 72: ddex.ern.v432.DealTerms.commercial_model_type:type_name -> ddex.ern.v432.CommercialModelType
+	28,  // 73: ddex.ern.v432.DealTerms.use_type:type_name -> ddex.ern.v432.DiscoverableUseType
+	91,  // 74: ddex.ern.v432.DealTerms.user_interface_type:type_name -> ddex.ern.v432.UserInterfaceType
+	105, // 75: ddex.ern.v432.DealTerms.carrier_type:type_name -> ddex.ern.v432.CarrierType
+	23,  // 76: ddex.ern.v432.DealTerms.technical_instantiation:type_name -> ddex.ern.v432.DealTermsTechnicalInstantiation
+	121, // 77: ddex.ern.v432.DealTerms.distribution_channel:type_name -> ddex.ern.v432.DSP
+	121, // 78: ddex.ern.v432.DealTerms.excluded_distribution_channel:type_name -> ddex.ern.v432.DSP
+	68,  // 79: ddex.ern.v432.DealTerms.rights_claim_policy:type_name -> ddex.ern.v432.RightsClaimPolicy
+	49,  // 80: ddex.ern.v432.DealTerms.price_information:type_name -> ddex.ern.v432.PriceInformation
+	167, // 81: ddex.ern.v432.DealTerms.promotional_code:type_name -> ddex.ern.v432.PromotionalCode
+	20,  // 82: ddex.ern.v432.DealTerms.instant_gratification_resource_list:type_name -> ddex.ern.v432.DealResourceReferenceList
+	48,  // 83: ddex.ern.v432.DealTerms.physical_returns:type_name -> ddex.ern.v432.PhysicalReturns
 	204, // 84: ddex.ern.v432.DealTermsTechnicalInstantiation.video_definition_type:type_name -> ddex.ern.v432.VideoDefinitionType
 	103, // 85: ddex.ern.v432.DealTermsTechnicalInstantiation.bit_rate:type_name -> ddex.ern.v432.BitRate
 	90,  // 86: ddex.ern.v432.DelegatedUsageRights.use_type:type_name -> ddex.ern.v432.UseType
 	163, // 87: ddex.ern.v432.DelegatedUsageRights.period_of_rights_delegation:type_name -> ddex.ern.v432.Period
 	100, // 88: ddex.ern.v432.DelegatedUsageRights.territory_of_rights_delegation:type_name -> ddex.ern.v432.AllTerritoryCode
-	111, // 89: ddex.ern.v432.DetailedResourceContributor.role:type_name -> ddex.ern.v432.ContributorRole
-	145, // 90: ddex.ern.v432.DetailedResourceContributor.instrument_type:type_name -> ddex.ern.v432.InstrumentType
-	126, // 91: ddex.ern.v432.DetailedResourceContributor.display_credits:type_name -> ddex.ern.v432.DisplayCredits
-	123, // 92: ddex.ern.v432.DetailedResourceContributor.party_id:type_name -> ddex.ern.v432.DetailedPartyId
-	159, // 93: ddex.ern.v432.DetailedResourceContributor.party_name:type_name -> ddex.ern.v432.PartyName
-	111, // 94: ddex.ern.v432.DisplayArtist.artistic_role:type_name -> ddex.ern.v432.ContributorRole
-	199, // 95: ddex.ern.v432.DisplayArtist.title_display_information:type_name -> ddex.ern.v432.TitleDisplayInformation
-	126, // 96: ddex.ern.v432.DisplayArtist.display_credits:type_name -> ddex.ern.v432.DisplayCredits
-	191, // 97: ddex.ern.v432.DisplayArtist.special_display_artist:type_name -> ddex.ern.v432.SpecialContributorType
-	125, // 98: ddex.ern.v432.DisplayArtist.display_artist_role:type_name -> ddex.ern.v432.DisplayArtistRole
+	123, // 89: ddex.ern.v432.DetailedResourceContributor.party_id:type_name -> ddex.ern.v432.DetailedPartyId
+	159, // 90: ddex.ern.v432.DetailedResourceContributor.party_name:type_name -> ddex.ern.v432.PartyName
+	111, // 91: ddex.ern.v432.DetailedResourceContributor.role:type_name -> ddex.ern.v432.ContributorRole
+	145, // 92: ddex.ern.v432.DetailedResourceContributor.instrument_type:type_name -> ddex.ern.v432.InstrumentType
+	126, // 93: ddex.ern.v432.DetailedResourceContributor.display_credits:type_name -> ddex.ern.v432.DisplayCredits
+	191, // 94: ddex.ern.v432.DisplayArtist.special_display_artist:type_name -> ddex.ern.v432.SpecialContributorType
+	125, // 95: ddex.ern.v432.DisplayArtist.display_artist_role:type_name -> ddex.ern.v432.DisplayArtistRole
+	111, // 96: ddex.ern.v432.DisplayArtist.artistic_role:type_name -> ddex.ern.v432.ContributorRole
+	199, // 97: ddex.ern.v432.DisplayArtist.title_display_information:type_name -> ddex.ern.v432.TitleDisplayInformation
+	126, // 98: ddex.ern.v432.DisplayArtist.display_credits:type_name -> ddex.ern.v432.DisplayCredits
 	123, // 99: ddex.ern.v432.DistributionChannelPage.party_id:type_name -> ddex.ern.v432.DetailedPartyId
 	155, // 100: ddex.ern.v432.DistributionChannelPage.page_name:type_name -> ddex.ern.v432.Name
-	111, // 101: ddex.ern.v432.EditionContributor.role:type_name -> ddex.ern.v432.ContributorRole
-	146, // 102: ddex.ern.v432.EditionContributor.is_credited:type_name -> ddex.ern.v432.IsCredited
-	126, // 103: ddex.ern.v432.EditionContributor.display_credits:type_name -> ddex.ern.v432.DisplayCredits
-	191, // 104: ddex.ern.v432.EditionContributor.special_contributor:type_name -> ddex.ern.v432.SpecialContributorType
+	191, // 101: ddex.ern.v432.EditionContributor.special_contributor:type_name -> ddex.ern.v432.SpecialContributorType
+	111, // 102: ddex.ern.v432.EditionContributor.role:type_name -> ddex.ern.v432.ContributorRole
+	146, // 103: ddex.ern.v432.EditionContributor.is_credited:type_name -> ddex.ern.v432.IsCredited
+	126, // 104: ddex.ern.v432.EditionContributor.display_credits:type_name -> ddex.ern.v432.DisplayCredits
 	47,  // 105: ddex.ern.v432.ExternalResourceLink.validity_period:type_name -> ddex.ern.v432.PeriodWithoutFlags
 	132, // 106: ddex.ern.v432.ExternalResourceLink.externally_linked_resource_type:type_name -> ddex.ern.v432.ExternallyLinkedResourceType
 	144, // 107: ddex.ern.v432.Image.type:type_name -> ddex.ern.v432.ImageType
@@ -20423,10 +21091,10 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	201, // 130: ddex.ern.v432.LocationAndDateOfSession.venue:type_name -> ddex.ern.v432.Venue
 	197, // 131: ddex.ern.v432.LocationAndDateOfSession.comment:type_name -> ddex.ern.v432.TextWithFormat
 	45,  // 132: ddex.ern.v432.LocationAndDateOfSession.contributor:type_name -> ddex.ern.v432.PartyWithRole
-	99,  // 133: ddex.ern.v432.Party.affiliation:type_name -> ddex.ern.v432.Affiliation
-	173, // 134: ddex.ern.v432.Party.related_party:type_name -> ddex.ern.v432.RelatedParty
-	123, // 135: ddex.ern.v432.Party.party_id:type_name -> ddex.ern.v432.DetailedPartyId
-	44,  // 136: ddex.ern.v432.Party.party_name:type_name -> ddex.ern.v432.PartyNameWithTerritory
+	123, // 133: ddex.ern.v432.Party.party_id:type_name -> ddex.ern.v432.DetailedPartyId
+	44,  // 134: ddex.ern.v432.Party.party_name:type_name -> ddex.ern.v432.PartyNameWithTerritory
+	99,  // 135: ddex.ern.v432.Party.affiliation:type_name -> ddex.ern.v432.Affiliation
+	173, // 136: ddex.ern.v432.Party.related_party:type_name -> ddex.ern.v432.RelatedParty
 	42,  // 137: ddex.ern.v432.PartyList.party:type_name -> ddex.ern.v432.Party
 	5,   // 138: ddex.ern.v432.PartyList.brand:type_name -> ddex.ern.v432.Brand
 	155, // 139: ddex.ern.v432.PartyNameWithTerritory.full_name:type_name -> ddex.ern.v432.Name
@@ -20463,8 +21131,8 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	59,  // 170: ddex.ern.v432.RelatedRelease.release_label_reference:type_name -> ddex.ern.v432.ReleaseLabelReference
 	36,  // 171: ddex.ern.v432.RelatedRelease.release_date:type_name -> ddex.ern.v432.EventDateWithoutFlags
 	36,  // 172: ddex.ern.v432.RelatedRelease.original_release_date:type_name -> ddex.ern.v432.EventDateWithoutFlags
-	86,  // 173: ddex.ern.v432.RelatedResource.timing:type_name -> ddex.ern.v432.Timing
-	179, // 174: ddex.ern.v432.RelatedResource.resource_id:type_name -> ddex.ern.v432.ResourceId
+	179, // 173: ddex.ern.v432.RelatedResource.resource_id:type_name -> ddex.ern.v432.ResourceId
+	86,  // 174: ddex.ern.v432.RelatedResource.timing:type_name -> ddex.ern.v432.Timing
 	175, // 175: ddex.ern.v432.Release.release_type:type_name -> ddex.ern.v432.ReleaseTypeForReleaseNotification
 	58,  // 176: ddex.ern.v432.Release.release_id:type_name -> ddex.ern.v432.ReleaseId
 	30,  // 177: ddex.ern.v432.Release.display_title_text:type_name -> ddex.ern.v432.DisplayTitleText
@@ -20508,10 +21176,10 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	128, // 215: ddex.ern.v432.ResourceGroup.grouping_title:type_name -> ddex.ern.v432.DisplayTitle
 	29,  // 216: ddex.ern.v432.ResourceGroup.display_artist:type_name -> ddex.ern.v432.DisplayArtist
 	105, // 217: ddex.ern.v432.ResourceGroup.carrier_type:type_name -> ddex.ern.v432.CarrierType
-	67,  // 218: ddex.ern.v432.ResourceGroup.resource_group:type_name -> ddex.ern.v432.ResourceSubGroup
-	64,  // 219: ddex.ern.v432.ResourceGroup.resource_group_content_item:type_name -> ddex.ern.v432.ResourceGroupContentItem
-	40,  // 220: ddex.ern.v432.ResourceGroup.linked_release_resource_reference:type_name -> ddex.ern.v432.LinkedReleaseResourceReference
-	58,  // 221: ddex.ern.v432.ResourceGroup.release_id:type_name -> ddex.ern.v432.ReleaseId
+	58,  // 218: ddex.ern.v432.ResourceGroup.release_id:type_name -> ddex.ern.v432.ReleaseId
+	67,  // 219: ddex.ern.v432.ResourceGroup.resource_group:type_name -> ddex.ern.v432.ResourceSubGroup
+	64,  // 220: ddex.ern.v432.ResourceGroup.resource_group_content_item:type_name -> ddex.ern.v432.ResourceGroupContentItem
+	40,  // 221: ddex.ern.v432.ResourceGroup.linked_release_resource_reference:type_name -> ddex.ern.v432.LinkedReleaseResourceReference
 	40,  // 222: ddex.ern.v432.ResourceGroupContentItem.linked_release_resource_reference:type_name -> ddex.ern.v432.LinkedReleaseResourceReference
 	73,  // 223: ddex.ern.v432.ResourceList.sound_recording:type_name -> ddex.ern.v432.SoundRecording
 	92,  // 224: ddex.ern.v432.ResourceList.video:type_name -> ddex.ern.v432.Video
@@ -20519,24 +21187,24 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	85,  // 226: ddex.ern.v432.ResourceList.text:type_name -> ddex.ern.v432.Text
 	71,  // 227: ddex.ern.v432.ResourceList.sheet_music:type_name -> ddex.ern.v432.SheetMusic
 	72,  // 228: ddex.ern.v432.ResourceList.software:type_name -> ddex.ern.v432.Software
-	25,  // 229: ddex.ern.v432.ResourceRightsController.delegated_usage_rights:type_name -> ddex.ern.v432.DelegatedUsageRights
-	162, // 230: ddex.ern.v432.ResourceRightsController.right_share_percentage:type_name -> ddex.ern.v432.Percentage
+	162, // 229: ddex.ern.v432.ResourceRightsController.right_share_percentage:type_name -> ddex.ern.v432.Percentage
+	25,  // 230: ddex.ern.v432.ResourceRightsController.delegated_usage_rights:type_name -> ddex.ern.v432.DelegatedUsageRights
 	30,  // 231: ddex.ern.v432.ResourceSubGroup.display_title_text:type_name -> ddex.ern.v432.DisplayTitleText
 	128, // 232: ddex.ern.v432.ResourceSubGroup.display_title:type_name -> ddex.ern.v432.DisplayTitle
 	128, // 233: ddex.ern.v432.ResourceSubGroup.formal_title:type_name -> ddex.ern.v432.DisplayTitle
 	128, // 234: ddex.ern.v432.ResourceSubGroup.grouping_title:type_name -> ddex.ern.v432.DisplayTitle
 	29,  // 235: ddex.ern.v432.ResourceSubGroup.display_artist:type_name -> ddex.ern.v432.DisplayArtist
 	105, // 236: ddex.ern.v432.ResourceSubGroup.carrier_type:type_name -> ddex.ern.v432.CarrierType
-	67,  // 237: ddex.ern.v432.ResourceSubGroup.resource_group:type_name -> ddex.ern.v432.ResourceSubGroup
-	64,  // 238: ddex.ern.v432.ResourceSubGroup.resource_group_content_item:type_name -> ddex.ern.v432.ResourceGroupContentItem
-	40,  // 239: ddex.ern.v432.ResourceSubGroup.linked_release_resource_reference:type_name -> ddex.ern.v432.LinkedReleaseResourceReference
-	58,  // 240: ddex.ern.v432.ResourceSubGroup.release_id:type_name -> ddex.ern.v432.ReleaseId
+	58,  // 237: ddex.ern.v432.ResourceSubGroup.release_id:type_name -> ddex.ern.v432.ReleaseId
+	67,  // 238: ddex.ern.v432.ResourceSubGroup.resource_group:type_name -> ddex.ern.v432.ResourceSubGroup
+	64,  // 239: ddex.ern.v432.ResourceSubGroup.resource_group_content_item:type_name -> ddex.ern.v432.ResourceGroupContentItem
+	40,  // 240: ddex.ern.v432.ResourceSubGroup.linked_release_resource_reference:type_name -> ddex.ern.v432.LinkedReleaseResourceReference
 	13,  // 241: ddex.ern.v432.RightsClaimPolicy.condition:type_name -> ddex.ern.v432.ConditionForRightsClaimPolicy
 	181, // 242: ddex.ern.v432.RightsClaimPolicy.rights_claim_policy_reason:type_name -> ddex.ern.v432.RightsClaimPolicyReason
-	155, // 243: ddex.ern.v432.ServiceException.trading_name:type_name -> ddex.ern.v432.Name
-	6,   // 244: ddex.ern.v432.ServiceException.channel:type_name -> ddex.ern.v432.Channel
-	123, // 245: ddex.ern.v432.ServiceException.party_id:type_name -> ddex.ern.v432.DetailedPartyId
-	159, // 246: ddex.ern.v432.ServiceException.party_name:type_name -> ddex.ern.v432.PartyName
+	123, // 243: ddex.ern.v432.ServiceException.party_id:type_name -> ddex.ern.v432.DetailedPartyId
+	159, // 244: ddex.ern.v432.ServiceException.party_name:type_name -> ddex.ern.v432.PartyName
+	155, // 245: ddex.ern.v432.ServiceException.trading_name:type_name -> ddex.ern.v432.Name
+	6,   // 246: ddex.ern.v432.ServiceException.channel:type_name -> ddex.ern.v432.Channel
 	187, // 247: ddex.ern.v432.SheetMusic.type:type_name -> ddex.ern.v432.SheetMusicType
 	186, // 248: ddex.ern.v432.SheetMusic.resource_id:type_name -> ddex.ern.v432.SheetMusicId
 	154, // 249: ddex.ern.v432.SheetMusic.work_id:type_name -> ddex.ern.v432.MusicalWorkId
@@ -20705,10 +21373,10 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	4,   // 412: ddex.ern.v432.Video.av_rating:type_name -> ddex.ern.v432.AvRating
 	53,  // 413: ddex.ern.v432.Video.related_release:type_name -> ddex.ern.v432.RelatedRelease
 	54,  // 414: ddex.ern.v432.Video.related_resource:type_name -> ddex.ern.v432.RelatedResource
-	126, // 415: ddex.ern.v432.Video.display_credits:type_name -> ddex.ern.v432.DisplayCredits
-	148, // 416: ddex.ern.v432.Video.language_of_performance:type_name -> ddex.ern.v432.Language
-	177, // 417: ddex.ern.v432.Video.resource_contained_resource_reference_list:type_name -> ddex.ern.v432.ResourceContainedResourceReferenceList
-	172, // 418: ddex.ern.v432.Video.reason_for_cue_sheet_absence:type_name -> ddex.ern.v432.Reason
+	172, // 415: ddex.ern.v432.Video.reason_for_cue_sheet_absence:type_name -> ddex.ern.v432.Reason
+	126, // 416: ddex.ern.v432.Video.display_credits:type_name -> ddex.ern.v432.DisplayCredits
+	148, // 417: ddex.ern.v432.Video.language_of_performance:type_name -> ddex.ern.v432.Language
+	177, // 418: ddex.ern.v432.Video.resource_contained_resource_reference_list:type_name -> ddex.ern.v432.ResourceContainedResourceReferenceList
 	108, // 419: ddex.ern.v432.VideoClipDetails.clip_type:type_name -> ddex.ern.v432.ClipType
 	86,  // 420: ddex.ern.v432.VideoClipDetails.timing:type_name -> ddex.ern.v432.Timing
 	94,  // 421: ddex.ern.v432.VideoClipDetails.delivery_file:type_name -> ddex.ern.v432.VideoDeliveryFile
@@ -20733,22 +21401,22 @@ var file_ddex_ern_v432_v432_proto_depIdxs = []int32{
 	104, // 440: ddex.ern.v432.VideoEdition.c_line:type_name -> ddex.ern.v432.CLine
 	84,  // 441: ddex.ern.v432.VideoEdition.technical_details:type_name -> ddex.ern.v432.TechnicalVideoDetails
 	100, // 442: ddex.ern.v432.WorkRightsController.territory:type_name -> ddex.ern.v432.AllTerritoryCode
-	200, // 443: ddex.ern.v432.Affiliation.validity_period:type_name -> ddex.ern.v432.ValidityPeriod
-	182, // 444: ddex.ern.v432.Affiliation.rights_type:type_name -> ddex.ern.v432.RightsType
-	120, // 445: ddex.ern.v432.Affiliation.territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
-	120, // 446: ddex.ern.v432.Affiliation.excluded_territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
+	120, // 443: ddex.ern.v432.Affiliation.territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
+	120, // 444: ddex.ern.v432.Affiliation.excluded_territory_code:type_name -> ddex.ern.v432.CurrentTerritoryCode
+	200, // 445: ddex.ern.v432.Affiliation.validity_period:type_name -> ddex.ern.v432.ValidityPeriod
+	182, // 446: ddex.ern.v432.Affiliation.rights_type:type_name -> ddex.ern.v432.RightsType
 	106, // 447: ddex.ern.v432.ChapterId.catalog_number:type_name -> ddex.ern.v432.CatalogNumber
 	168, // 448: ddex.ern.v432.ChapterId.proprietary_id:type_name -> ddex.ern.v432.ProprietaryId
-	111, // 449: ddex.ern.v432.Contributor.role:type_name -> ddex.ern.v432.ContributorRole
-	145, // 450: ddex.ern.v432.Contributor.instrument_type:type_name -> ddex.ern.v432.InstrumentType
-	146, // 451: ddex.ern.v432.Contributor.is_credited:type_name -> ddex.ern.v432.IsCredited
-	126, // 452: ddex.ern.v432.Contributor.display_credits:type_name -> ddex.ern.v432.DisplayCredits
-	191, // 453: ddex.ern.v432.Contributor.special_contributor:type_name -> ddex.ern.v432.SpecialContributorType
+	191, // 449: ddex.ern.v432.Contributor.special_contributor:type_name -> ddex.ern.v432.SpecialContributorType
+	111, // 450: ddex.ern.v432.Contributor.role:type_name -> ddex.ern.v432.ContributorRole
+	145, // 451: ddex.ern.v432.Contributor.instrument_type:type_name -> ddex.ern.v432.InstrumentType
+	146, // 452: ddex.ern.v432.Contributor.is_credited:type_name -> ddex.ern.v432.IsCredited
+	126, // 453: ddex.ern.v432.Contributor.display_credits:type_name -> ddex.ern.v432.DisplayCredits
 	112, // 454: ddex.ern.v432.ContributorRole.value:type_name -> ddex.ern.v432.ContributorRoleValue
 	145, // 455: ddex.ern.v432.ContributorRole.instrument_type:type_name -> ddex.ern.v432.InstrumentType
-	155, // 456: ddex.ern.v432.DSP.trading_name:type_name -> ddex.ern.v432.Name
-	123, // 457: ddex.ern.v432.DSP.party_id:type_name -> ddex.ern.v432.DetailedPartyId
-	159, // 458: ddex.ern.v432.DSP.party_name:type_name -> ddex.ern.v432.PartyName
+	123, // 456: ddex.ern.v432.DSP.party_id:type_name -> ddex.ern.v432.DetailedPartyId
+	159, // 457: ddex.ern.v432.DSP.party_name:type_name -> ddex.ern.v432.PartyName
+	155, // 458: ddex.ern.v432.DSP.trading_name:type_name -> ddex.ern.v432.Name
 	142, // 459: ddex.ern.v432.DetailedHashSum.algorithm:type_name -> ddex.ern.v432.HashSumAlgorithmType
 	168, // 460: ddex.ern.v432.DetailedPartyId.proprietary_id:type_name -> ddex.ern.v432.ProprietaryId
 	127, // 461: ddex.ern.v432.DisplayTitle.sub_title:type_name -> ddex.ern.v432.DisplaySubTitle
@@ -20808,6 +21476,171 @@ func file_ddex_ern_v432_v432_proto_init() {
 	if File_ddex_ern_v432_v432_proto != nil {
 		return
 	}
+	file_ddex_ern_v432_v432_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[4].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[7].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[8].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[9].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[10].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[12].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[13].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[15].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[18].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[22].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[23].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[24].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[26].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[27].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[28].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[29].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[31].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[32].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[34].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[35].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[36].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[37].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[38].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[39].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[40].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[44].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[45].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[48].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[49].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[51].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[52].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[55].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[56].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[58].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[59].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[60].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[62].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[63].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[64].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[66].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[67].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[71].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[72].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[73].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[75].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[77].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[78].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[79].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[80].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[81].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[82].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[83].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[84].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[85].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[86].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[87].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[88].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[89].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[90].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[91].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[92].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[93].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[94].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[95].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[96].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[97].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[98].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[99].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[100].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[101].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[102].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[103].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[104].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[105].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[107].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[108].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[109].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[110].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[112].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[113].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[114].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[115].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[116].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[117].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[118].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[119].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[120].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[122].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[123].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[124].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[125].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[126].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[127].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[128].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[129].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[130].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[131].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[132].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[133].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[134].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[135].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[136].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[137].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[138].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[139].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[140].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[141].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[142].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[143].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[144].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[145].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[146].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[147].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[148].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[149].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[152].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[153].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[154].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[155].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[156].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[157].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[158].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[159].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[160].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[161].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[162].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[164].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[167].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[169].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[170].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[171].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[172].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[174].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[175].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[176].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[178].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[179].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[180].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[181].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[182].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[183].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[184].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[185].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[186].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[187].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[188].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[189].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[190].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[191].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[193].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[194].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[195].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[196].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[197].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[198].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[199].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[201].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[202].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[203].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[204].OneofWrappers = []any{}
+	file_ddex_ern_v432_v432_proto_msgTypes[205].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
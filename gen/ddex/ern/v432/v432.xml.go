@@ -1,8 +1,18 @@
 // Code generated by generate-go-extensions. DO NOT EDIT.
+// Source: v432.pb.go, from protos generated by xsd2proto devel on 2026-10-15
+// Schema: ernv432/release-notification.xsd (SCOW Version v0.9.11 250618, sha256 d808da301b6d)
 
 package v432
 
-import "encoding/xml"
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
 
 // Package-level namespace constants
 const (
@@ -12,51 +22,247 @@ const (
 	NamespaceXSI    = "http://www.w3.org/2001/XMLSchema-instance"
 )
 
-// MarshalXML implements xml.Marshaler for NewReleaseMessage
-func (m *NewReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	// Set default namespace values if empty
-	if m.XmlnsErn == "" {
-		m.XmlnsErn = Namespace
+// Unmarshal decodes a document whose root is any root message of this
+// package. The root element must be in Namespace.
+func Unmarshal(data []byte) (proto.Message, error) {
+	d := newDecoder(data)
+	start, err := rootElement(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg interface {
+		proto.Message
+		xml.Unmarshaler
+	}
+	switch start.Name.Local {
+	case "NewReleaseMessage":
+		msg = &NewReleaseMessage{}
+	case "PurgeReleaseMessage":
+		msg = &PurgeReleaseMessage{}
+	default:
+		return nil, fmt.Errorf("unknown root element %s", start.Name.Local)
+	}
+	if err := d.DecodeElement(msg, &start); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// UnmarshalNewReleaseMessage decodes a NewReleaseMessage document. The root element must
+// be in Namespace.
+func UnmarshalNewReleaseMessage(data []byte) (*NewReleaseMessage, error) {
+	d := newDecoder(data)
+	start, err := rootElement(d)
+	if err != nil {
+		return nil, err
+	}
+	if start.Name.Local != "NewReleaseMessage" {
+		return nil, fmt.Errorf("root element %s is not NewReleaseMessage", start.Name.Local)
+	}
+	msg := &NewReleaseMessage{}
+	if err := d.DecodeElement(msg, &start); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// UnmarshalPurgeReleaseMessage decodes a PurgeReleaseMessage document. The root element must
+// be in Namespace.
+func UnmarshalPurgeReleaseMessage(data []byte) (*PurgeReleaseMessage, error) {
+	d := newDecoder(data)
+	start, err := rootElement(d)
+	if err != nil {
+		return nil, err
+	}
+	if start.Name.Local != "PurgeReleaseMessage" {
+		return nil, fmt.Errorf("root element %s is not PurgeReleaseMessage", start.Name.Local)
+	}
+	msg := &PurgeReleaseMessage{}
+	if err := d.DecodeElement(msg, &start); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// rootElement reads up to the root element and checks it is in Namespace
+func rootElement(d *xml.Decoder) (xml.StartElement, error) {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return xml.StartElement{}, fmt.Errorf("could not find root element: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Space != Namespace {
+			return xml.StartElement{}, fmt.Errorf("root element %s is in namespace %q, expected %q", start.Name.Local, start.Name.Space, Namespace)
+		}
+		return start, nil
+	}
+}
+
+// newDecoder returns a decoder over data that reads the charsets DDEX
+// documents are delivered in
+func newDecoder(data []byte) *xml.Decoder {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.CharsetReader = charsetReader
+	return d
+}
+
+// charsetReader converts the non-UTF-8 encodings DDEX documents are delivered in
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "us-ascii", "ascii":
+		return input, nil
+	case "iso-8859-1", "latin1", "latin-1":
+		data, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return strings.NewReader(string(runes)), nil
+	default:
+		return nil, fmt.Errorf("unsupported charset: %s", charset)
 	}
-	if m.XmlnsXsi == "" {
-		m.XmlnsXsi = NamespaceXSI
+}
+
+// rootPrefix returns the prefix start declares Namespace under, or "" when
+// it uses NamespacePrefix or declares none
+func rootPrefix(start xml.StartElement) string {
+	prefix := ""
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "xmlns" || attr.Value != Namespace {
+			continue
+		}
+		if attr.Name.Local == NamespacePrefix {
+			return ""
+		}
+		if prefix == "" {
+			prefix = attr.Name.Local
+		}
 	}
-	if m.XsiSchemaLocation == "" {
-		m.XsiSchemaLocation = SchemaLocation
+	return prefix
+}
+
+// NewNewReleaseMessage returns a NewReleaseMessage with its namespace attributes populated
+func NewNewReleaseMessage() *NewReleaseMessage {
+	return &NewReleaseMessage{
+		XmlnsErn:          Namespace,
+		XmlnsXsi:          NamespaceXSI,
+		XsiSchemaLocation: SchemaLocation,
 	}
+}
 
+// MarshalXML implements xml.Marshaler for NewReleaseMessage, filling in empty namespace
+// attributes on a shallow copy so m is never modified
+func (m *NewReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Create an alias type to avoid infinite recursion
 	type alias NewReleaseMessage
-	return e.EncodeElement((*alias)(m), start)
+	out := &alias{
+		MessageHeader:                  m.MessageHeader,
+		ReleaseAdmin:                   m.ReleaseAdmin,
+		PartyList:                      m.PartyList,
+		CueSheetList:                   m.CueSheetList,
+		ResourceList:                   m.ResourceList,
+		ChapterList:                    m.ChapterList,
+		ReleaseList:                    m.ReleaseList,
+		DealList:                       m.DealList,
+		SupplementalDocumentList:       m.SupplementalDocumentList,
+		ReleaseProfileVersionId:        m.ReleaseProfileVersionId,
+		ReleaseProfileVariantVersionId: m.ReleaseProfileVariantVersionId,
+		AvsVersionId:                   m.AvsVersionId,
+		LanguageAndScriptCode:          m.LanguageAndScriptCode,
+		XmlnsErn:                       m.XmlnsErn,
+		XmlnsXsi:                       m.XmlnsXsi,
+		XsiSchemaLocation:              m.XsiSchemaLocation,
+		RootPrefix:                     m.RootPrefix,
+	}
+
+	// Declare the namespace under the prefix the parsed document used, if any
+	if out.RootPrefix != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + out.RootPrefix}, Value: Namespace})
+		if out.XmlnsErn == Namespace {
+			out.XmlnsErn = ""
+		}
+	} else if out.XmlnsErn == "" {
+		out.XmlnsErn = Namespace
+	}
+	if out.XmlnsXsi == "" {
+		out.XmlnsXsi = NamespaceXSI
+	}
+	if out.XsiSchemaLocation == "" {
+		out.XsiSchemaLocation = SchemaLocation
+	}
+	return e.EncodeElement(out, start)
 }
 
 // UnmarshalXML implements xml.Unmarshaler for NewReleaseMessage
 func (m *NewReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "NewReleaseMessage" {
+		return fmt.Errorf("expected root element NewReleaseMessage, got %s", start.Name.Local)
+	}
+	m.RootPrefix = rootPrefix(start)
+
 	// Create an alias type to avoid infinite recursion
 	type alias NewReleaseMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
 
-// MarshalXML implements xml.Marshaler for PurgeReleaseMessage
-func (m *PurgeReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	// Set default namespace values if empty
-	if m.XmlnsErn == "" {
-		m.XmlnsErn = Namespace
-	}
-	if m.XmlnsXsi == "" {
-		m.XmlnsXsi = NamespaceXSI
-	}
-	if m.XsiSchemaLocation == "" {
-		m.XsiSchemaLocation = SchemaLocation
+// NewPurgeReleaseMessage returns a PurgeReleaseMessage with its namespace attributes populated
+func NewPurgeReleaseMessage() *PurgeReleaseMessage {
+	return &PurgeReleaseMessage{
+		XmlnsErn:          Namespace,
+		XmlnsXsi:          NamespaceXSI,
+		XsiSchemaLocation: SchemaLocation,
 	}
+}
 
+// MarshalXML implements xml.Marshaler for PurgeReleaseMessage, filling in empty namespace
+// attributes on a shallow copy so m is never modified
+func (m *PurgeReleaseMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Create an alias type to avoid infinite recursion
 	type alias PurgeReleaseMessage
-	return e.EncodeElement((*alias)(m), start)
+	out := &alias{
+		MessageHeader:         m.MessageHeader,
+		PurgedRelease:         m.PurgedRelease,
+		AvsVersionId:          m.AvsVersionId,
+		LanguageAndScriptCode: m.LanguageAndScriptCode,
+		XmlnsErn:              m.XmlnsErn,
+		XmlnsXsi:              m.XmlnsXsi,
+		XsiSchemaLocation:     m.XsiSchemaLocation,
+		RootPrefix:            m.RootPrefix,
+	}
+
+	// Declare the namespace under the prefix the parsed document used, if any
+	if out.RootPrefix != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + out.RootPrefix}, Value: Namespace})
+		if out.XmlnsErn == Namespace {
+			out.XmlnsErn = ""
+		}
+	} else if out.XmlnsErn == "" {
+		out.XmlnsErn = Namespace
+	}
+	if out.XmlnsXsi == "" {
+		out.XmlnsXsi = NamespaceXSI
+	}
+	if out.XsiSchemaLocation == "" {
+		out.XsiSchemaLocation = SchemaLocation
+	}
+	return e.EncodeElement(out, start)
 }
 
 // UnmarshalXML implements xml.Unmarshaler for PurgeReleaseMessage
 func (m *PurgeReleaseMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "PurgeReleaseMessage" {
+		return fmt.Errorf("expected root element PurgeReleaseMessage, got %s", start.Name.Local)
+	}
+	m.RootPrefix = rootPrefix(start)
+
 	// Create an alias type to avoid infinite recursion
 	type alias PurgeReleaseMessage
 	return d.DecodeElement((*alias)(m), &start)
@@ -1,3 +1,6 @@
+// Generated by xsd2proto devel on 2026-10-15
+// Schema: meadv11/media-enrichment-and-description.xsd (SCOW Version v0.8.67 210603, sha256 17f2fe5149ac)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.9
@@ -27,7 +30,7 @@ type MeadMessage struct {
 	// @gotags: xml:"MessageHeader"
 	MessageHeader *MessageHeader `protobuf:"bytes,1,opt,name=message_header,json=messageHeader,proto3" json:"message_header,omitempty" xml:"MessageHeader"`
 	// @gotags: xml:"SubscriptionId"
-	SubscriptionId string `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty" xml:"SubscriptionId"`
+	SubscriptionId *string `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty" xml:"SubscriptionId"`
 	// @gotags: xml:"MetadataSourceList"
 	MetadataSourceList *MetadataSourceList `protobuf:"bytes,3,opt,name=metadata_source_list,json=metadataSourceList,proto3" json:"metadata_source_list,omitempty" xml:"MetadataSourceList"`
 	// @gotags: xml:"WorkInformationList"
@@ -39,15 +42,22 @@ type MeadMessage struct {
 	// @gotags: xml:"AvsVersionId,attr"
 	AvsVersionId string `protobuf:"bytes,7,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:mead,attr"
-	XmlnsMead string `protobuf:"bytes,9,opt,name=xmlns_mead,json=xmlnsMead,proto3" json:"xmlns_mead,omitempty" xml:"xmlns:mead,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"xmlns:mead,attr,omitempty"
+	XmlnsMead string `protobuf:"bytes,9,opt,name=xmlns_mead,json=xmlnsMead,proto3" json:"xmlns_mead,omitempty" xml:"xmlns:mead,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,10,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,11,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,12,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MeadMessage) Reset() {
@@ -88,8 +98,8 @@ func (x *MeadMessage) GetMessageHeader() *MessageHeader {
 }
 
 func (x *MeadMessage) GetSubscriptionId() string {
-	if x != nil {
-		return x.SubscriptionId
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
 	}
 	return ""
 }
@@ -130,8 +140,8 @@ func (x *MeadMessage) GetAvsVersionId() string {
 }
 
 func (x *MeadMessage) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -157,6 +167,13 @@ func (x *MeadMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *MeadMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type Feed struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"author"
@@ -185,14 +202,23 @@ type Feed struct {
 	Updated *DateTime `protobuf:"bytes,12,opt,name=updated,proto3" json:"updated,omitempty" xml:"updated"`
 	// @gotags: xml:"entry"
 	Entry []*Entry `protobuf:"bytes,13,rep,name=entry,proto3" json:"entry,omitempty" xml:"entry"`
-	// @gotags: xml:"xmlns:mead,attr"
-	XmlnsMead string `protobuf:"bytes,14,opt,name=xmlns_mead,json=xmlnsMead,proto3" json:"xmlns_mead,omitempty" xml:"xmlns:mead,attr"`
+	// @gotags: xml:"xmlns:mead,attr,omitempty"
+	XmlnsMead string `protobuf:"bytes,14,opt,name=xmlns_mead,json=xmlnsMead,proto3" json:"xmlns_mead,omitempty" xml:"xmlns:mead,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,15,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,16,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// @gotags: xml:",any"
+	AnyElement []*AnyElement `protobuf:"bytes,17,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,18,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Feed) Reset() {
@@ -337,6 +363,20 @@ func (x *Feed) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *Feed) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
+func (x *Feed) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type AbsolutePitch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
@@ -410,8 +450,8 @@ type Activity struct {
 	Description []*TextWithoutTerritory `protobuf:"bytes,3,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"LanguageAndScriptOfActivity"
 	LanguageAndScriptOfActivity []string `protobuf:"bytes,4,rep,name=language_and_script_of_activity,json=languageAndScriptOfActivity,proto3" json:"language_and_script_of_activity,omitempty" xml:"LanguageAndScriptOfActivity"`
-	// @gotags: xml:"TerritoryOfActivityDescription"
-	TerritoryOfActivityDescription []string `protobuf:"bytes,5,rep,name=territory_of_activity_description,json=territoryOfActivityDescription,proto3" json:"territory_of_activity_description,omitempty" xml:"TerritoryOfActivityDescription"`
+	// @gotags: xml:"TerritoryOfActivityDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfActivityDescription []string `protobuf:"bytes,5,rep,name=territory_of_activity_description,json=territoryOfActivityDescription,proto3" json:"territory_of_activity_description,omitempty" xml:"TerritoryOfActivityDescription" avs:"CurrentTerritoryCode"`
 	unknownFields                  protoimpl.UnknownFields
 	sizeCache                      protoimpl.SizeCache
 }
@@ -483,12 +523,12 @@ func (x *Activity) GetTerritoryOfActivityDescription() []string {
 
 type ActivityValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Activity"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Activity"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -531,15 +571,15 @@ func (x *ActivityValue) GetValue() string {
 }
 
 func (x *ActivityValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ActivityValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -554,12 +594,12 @@ type AlternativeTitle struct {
 	SubTitle *TitleText `protobuf:"bytes,3,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptOfTitle"
 	LanguageAndScriptOfTitle []string `protobuf:"bytes,4,rep,name=language_and_script_of_title,json=languageAndScriptOfTitle,proto3" json:"language_and_script_of_title,omitempty" xml:"LanguageAndScriptOfTitle"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType string `protobuf:"bytes,5,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	// @gotags: xml:"TitleType,attr" avs:"TitleType"
+	TitleType *string `protobuf:"bytes,5,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"TitleType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -623,22 +663,22 @@ func (x *AlternativeTitle) GetLanguageAndScriptOfTitle() []string {
 }
 
 func (x *AlternativeTitle) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
 
 func (x *AlternativeTitle) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *AlternativeTitle) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -703,8 +743,8 @@ type ArtisticStyle struct {
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
 	// @gotags: xml:"Value"
 	Value *ArtistTypeValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
-	// @gotags: xml:"TerritoryOfArtisticStyleDescription"
-	TerritoryOfArtisticStyleDescription []string `protobuf:"bytes,3,rep,name=territory_of_artistic_style_description,json=territoryOfArtisticStyleDescription,proto3" json:"territory_of_artistic_style_description,omitempty" xml:"TerritoryOfArtisticStyleDescription"`
+	// @gotags: xml:"TerritoryOfArtisticStyleDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfArtisticStyleDescription []string `protobuf:"bytes,3,rep,name=territory_of_artistic_style_description,json=territoryOfArtisticStyleDescription,proto3" json:"territory_of_artistic_style_description,omitempty" xml:"TerritoryOfArtisticStyleDescription" avs:"CurrentTerritoryCode"`
 	unknownFields                       protoimpl.UnknownFields
 	sizeCache                           protoimpl.SizeCache
 }
@@ -828,9 +868,9 @@ type ChildWorkHierarchy struct {
 	// @gotags: xml:"IsDescribedElement"
 	IsDescribedElement bool `protobuf:"varint,1,opt,name=is_described_element,json=isDescribedElement,proto3" json:"is_described_element,omitempty" xml:"IsDescribedElement"`
 	// @gotags: xml:"IsComplete"
-	IsComplete bool `protobuf:"varint,2,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty" xml:"IsComplete"`
+	IsComplete *bool `protobuf:"varint,2,opt,name=is_complete,json=isComplete,proto3,oneof" json:"is_complete,omitempty" xml:"IsComplete"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"WorkId"
 	WorkId *MusicalWorkIdWithoutFlag `protobuf:"bytes,4,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty" xml:"WorkId"`
 	// @gotags: xml:"WorkTitle"
@@ -881,15 +921,15 @@ func (x *ChildWorkHierarchy) GetIsDescribedElement() bool {
 }
 
 func (x *ChildWorkHierarchy) GetIsComplete() bool {
-	if x != nil {
-		return x.IsComplete
+	if x != nil && x.IsComplete != nil {
+		return *x.IsComplete
 	}
 	return false
 }
 
 func (x *ChildWorkHierarchy) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -995,8 +1035,8 @@ type DanceStyle struct {
 	Description []*TextWithoutTerritory `protobuf:"bytes,3,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"LanguageAndScriptOfDanceStyle"
 	LanguageAndScriptOfDanceStyle []string `protobuf:"bytes,4,rep,name=language_and_script_of_dance_style,json=languageAndScriptOfDanceStyle,proto3" json:"language_and_script_of_dance_style,omitempty" xml:"LanguageAndScriptOfDanceStyle"`
-	// @gotags: xml:"TerritoryOfDanceStyleDescription"
-	TerritoryOfDanceStyleDescription []string `protobuf:"bytes,5,rep,name=territory_of_dance_style_description,json=territoryOfDanceStyleDescription,proto3" json:"territory_of_dance_style_description,omitempty" xml:"TerritoryOfDanceStyleDescription"`
+	// @gotags: xml:"TerritoryOfDanceStyleDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfDanceStyleDescription []string `protobuf:"bytes,5,rep,name=territory_of_dance_style_description,json=territoryOfDanceStyleDescription,proto3" json:"territory_of_dance_style_description,omitempty" xml:"TerritoryOfDanceStyleDescription" avs:"CurrentTerritoryCode"`
 	unknownFields                    protoimpl.UnknownFields
 	sizeCache                        protoimpl.SizeCache
 }
@@ -1068,12 +1108,12 @@ func (x *DanceStyle) GetTerritoryOfDanceStyleDescription() []string {
 
 type DanceStyleValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"DanceStyle"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"DanceStyle"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1116,15 +1156,15 @@ func (x *DanceStyleValue) GetValue() string {
 }
 
 func (x *DanceStyleValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DanceStyleValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -1226,15 +1266,15 @@ type DisplaySubTitle struct {
 	// @gotags: xml:"Pronunciation"
 	Pronunciation []*Pronunciation `protobuf:"bytes,2,rep,name=pronunciation,proto3" json:"pronunciation,omitempty" xml:"Pronunciation"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	// @gotags: xml:"IsDisplayedInTitle,attr"
-	IsDisplayedInTitle bool `protobuf:"varint,4,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
+	IsDisplayedInTitle *bool `protobuf:"varint,4,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3,oneof" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"SubTitleType,attr"
-	SubTitleType  string `protobuf:"bytes,7,opt,name=sub_title_type,json=subTitleType,proto3" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
+	SubTitleType  *string `protobuf:"bytes,7,opt,name=sub_title_type,json=subTitleType,proto3,oneof" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1284,36 +1324,36 @@ func (x *DisplaySubTitle) GetPronunciation() []*Pronunciation {
 }
 
 func (x *DisplaySubTitle) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *DisplaySubTitle) GetIsDisplayedInTitle() bool {
-	if x != nil {
-		return x.IsDisplayedInTitle
+	if x != nil && x.IsDisplayedInTitle != nil {
+		return *x.IsDisplayedInTitle
 	}
 	return false
 }
 
 func (x *DisplaySubTitle) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DisplaySubTitle) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *DisplaySubTitle) GetSubTitleType() string {
-	if x != nil {
-		return x.SubTitleType
+	if x != nil && x.SubTitleType != nil {
+		return *x.SubTitleType
 	}
 	return ""
 }
@@ -1325,11 +1365,11 @@ type DisplayTitle struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle []*DisplaySubTitle `protobuf:"bytes,2,rep,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1379,22 +1419,22 @@ func (x *DisplayTitle) GetSubTitle() []*DisplaySubTitle {
 }
 
 func (x *DisplayTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -1427,8 +1467,16 @@ type Entry struct {
 	Updated *DateTime `protobuf:"bytes,12,opt,name=updated,proto3" json:"updated,omitempty" xml:"updated"`
 	// @gotags: xml:"SubscriptionId"
 	SubscriptionId string `protobuf:"bytes,13,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty" xml:"SubscriptionId"`
+	// @gotags: xml:"Work"
+	Work *Work `protobuf:"bytes,14,opt,name=work,proto3" json:"work,omitempty" xml:"Work"`
+	// @gotags: xml:"Resource"
+	Resource *Resource `protobuf:"bytes,15,opt,name=resource,proto3" json:"resource,omitempty" xml:"Resource"`
+	// @gotags: xml:"Release"
+	Release *Release `protobuf:"bytes,16,opt,name=release,proto3" json:"release,omitempty" xml:"Release"`
+	// @gotags: xml:"Party"
+	Party *Party `protobuf:"bytes,17,opt,name=party,proto3" json:"party,omitempty" xml:"Party"`
 	// @gotags: xml:"AvsVersionId,attr"
-	AvsVersionId  string `protobuf:"bytes,14,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
+	AvsVersionId  string `protobuf:"bytes,18,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1554,6 +1602,34 @@ func (x *Entry) GetSubscriptionId() string {
 	return ""
 }
 
+func (x *Entry) GetWork() *Work {
+	if x != nil {
+		return x.Work
+	}
+	return nil
+}
+
+func (x *Entry) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+func (x *Entry) GetRelease() *Release {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *Entry) GetParty() *Party {
+	if x != nil {
+		return x.Party
+	}
+	return nil
+}
+
 func (x *Entry) GetAvsVersionId() string {
 	if x != nil {
 		return x.AvsVersionId
@@ -1671,12 +1747,12 @@ func (x *Form) GetValue() *FormValue {
 
 type FormValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Form"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Form"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1719,15 +1795,15 @@ func (x *FormValue) GetValue() string {
 }
 
 func (x *FormValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *FormValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -1736,8 +1812,8 @@ type GenreCategory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
-	// @gotags: xml:"TerritoryOfGenreCategoryDescription"
-	TerritoryOfGenreCategoryDescription []string `protobuf:"bytes,2,rep,name=territory_of_genre_category_description,json=territoryOfGenreCategoryDescription,proto3" json:"territory_of_genre_category_description,omitempty" xml:"TerritoryOfGenreCategoryDescription"`
+	// @gotags: xml:"TerritoryOfGenreCategoryDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfGenreCategoryDescription []string `protobuf:"bytes,2,rep,name=territory_of_genre_category_description,json=territoryOfGenreCategoryDescription,proto3" json:"territory_of_genre_category_description,omitempty" xml:"TerritoryOfGenreCategoryDescription" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"Value"
 	Value *GenreCategoryValue `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	// @gotags: xml:"Description"
@@ -1887,20 +1963,20 @@ func (x *Harmony) GetModulation() []*HarmonyModulation {
 
 type HarmonyModulation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"RootChordNote"
-	RootChordNote *RootChordNote `protobuf:"bytes,1,opt,name=root_chord_note,json=rootChordNote,proto3" json:"root_chord_note,omitempty" xml:"RootChordNote"`
-	// @gotags: xml:"RootChordQuality"
-	RootChordQuality *RootChordQuality `protobuf:"bytes,2,opt,name=root_chord_quality,json=rootChordQuality,proto3" json:"root_chord_quality,omitempty" xml:"RootChordQuality"`
-	// @gotags: xml:"Mode"
-	Mode *Mode `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty" xml:"Mode"`
 	// @gotags: xml:"StartPoint"
 	StartPoint string `protobuf:"bytes,4,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"EndPoint"
-	EndPoint string `protobuf:"bytes,5,opt,name=end_point,json=endPoint,proto3" json:"end_point,omitempty" xml:"EndPoint"`
+	EndPoint *string `protobuf:"bytes,5,opt,name=end_point,json=endPoint,proto3,oneof" json:"end_point,omitempty" xml:"EndPoint"`
 	// @gotags: xml:"StartBar"
 	StartBar int32 `protobuf:"varint,6,opt,name=start_bar,json=startBar,proto3" json:"start_bar,omitempty" xml:"StartBar"`
 	// @gotags: xml:"EndBar"
-	EndBar        int32 `protobuf:"varint,7,opt,name=end_bar,json=endBar,proto3" json:"end_bar,omitempty" xml:"EndBar"`
+	EndBar *int32 `protobuf:"varint,7,opt,name=end_bar,json=endBar,proto3,oneof" json:"end_bar,omitempty" xml:"EndBar"`
+	// @gotags: xml:"RootChordNote"
+	RootChordNote *RootChordNote `protobuf:"bytes,1,opt,name=root_chord_note,json=rootChordNote,proto3" json:"root_chord_note,omitempty" xml:"RootChordNote"`
+	// @gotags: xml:"RootChordQuality"
+	RootChordQuality *RootChordQuality `protobuf:"bytes,2,opt,name=root_chord_quality,json=rootChordQuality,proto3" json:"root_chord_quality,omitempty" xml:"RootChordQuality"`
+	// @gotags: xml:"Mode"
+	Mode          *Mode `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty" xml:"Mode"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1935,53 +2011,53 @@ func (*HarmonyModulation) Descriptor() ([]byte, []int) {
 	return file_ddex_mead_v11_v11_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *HarmonyModulation) GetRootChordNote() *RootChordNote {
+func (x *HarmonyModulation) GetStartPoint() string {
 	if x != nil {
-		return x.RootChordNote
+		return x.StartPoint
 	}
-	return nil
+	return ""
 }
 
-func (x *HarmonyModulation) GetRootChordQuality() *RootChordQuality {
-	if x != nil {
-		return x.RootChordQuality
+func (x *HarmonyModulation) GetEndPoint() string {
+	if x != nil && x.EndPoint != nil {
+		return *x.EndPoint
 	}
-	return nil
+	return ""
 }
 
-func (x *HarmonyModulation) GetMode() *Mode {
+func (x *HarmonyModulation) GetStartBar() int32 {
 	if x != nil {
-		return x.Mode
+		return x.StartBar
 	}
-	return nil
+	return 0
 }
 
-func (x *HarmonyModulation) GetStartPoint() string {
-	if x != nil {
-		return x.StartPoint
+func (x *HarmonyModulation) GetEndBar() int32 {
+	if x != nil && x.EndBar != nil {
+		return *x.EndBar
 	}
-	return ""
+	return 0
 }
 
-func (x *HarmonyModulation) GetEndPoint() string {
+func (x *HarmonyModulation) GetRootChordNote() *RootChordNote {
 	if x != nil {
-		return x.EndPoint
+		return x.RootChordNote
 	}
-	return ""
+	return nil
 }
 
-func (x *HarmonyModulation) GetStartBar() int32 {
+func (x *HarmonyModulation) GetRootChordQuality() *RootChordQuality {
 	if x != nil {
-		return x.StartBar
+		return x.RootChordQuality
 	}
-	return 0
+	return nil
 }
 
-func (x *HarmonyModulation) GetEndBar() int32 {
+func (x *HarmonyModulation) GetMode() *Mode {
 	if x != nil {
-		return x.EndBar
+		return x.Mode
 	}
-	return 0
+	return nil
 }
 
 type ImpactDate struct {
@@ -2099,7 +2175,7 @@ type InstrumentUsed struct {
 	// @gotags: xml:"Value"
 	Value *InstrumentValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	// @gotags: xml:"IsFeatured,attr"
-	IsFeatured    bool `protobuf:"varint,3,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty" xml:"IsFeatured,attr"`
+	IsFeatured    *bool `protobuf:"varint,3,opt,name=is_featured,json=isFeatured,proto3,oneof" json:"is_featured,omitempty" xml:"IsFeatured,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2149,20 +2225,20 @@ func (x *InstrumentUsed) GetValue() *InstrumentValue {
 }
 
 func (x *InstrumentUsed) GetIsFeatured() bool {
-	if x != nil {
-		return x.IsFeatured
+	if x != nil && x.IsFeatured != nil {
+		return *x.IsFeatured
 	}
 	return false
 }
 
 type InstrumentValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"InstrumentType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"InstrumentType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2205,15 +2281,15 @@ func (x *InstrumentValue) GetValue() string {
 }
 
 func (x *InstrumentValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *InstrumentValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -2274,12 +2350,12 @@ func (x *Intensity) GetValue() *IntensityValue {
 
 type IntensityValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Intensity"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Intensity"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2322,15 +2398,15 @@ func (x *IntensityValue) GetValue() string {
 }
 
 func (x *IntensityValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *IntensityValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -2433,20 +2509,20 @@ type Lyrics struct {
 	Text *LyricsText `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty" xml:"Text"`
 	// @gotags: xml:"LanguageAndScriptOfLyrics"
 	LanguageAndScriptOfLyrics []string `protobuf:"bytes,3,rep,name=language_and_script_of_lyrics,json=languageAndScriptOfLyrics,proto3" json:"language_and_script_of_lyrics,omitempty" xml:"LanguageAndScriptOfLyrics"`
-	// @gotags: xml:"TerritoryOfLyricsDescription"
-	TerritoryOfLyricsDescription []string `protobuf:"bytes,4,rep,name=territory_of_lyrics_description,json=territoryOfLyricsDescription,proto3" json:"territory_of_lyrics_description,omitempty" xml:"TerritoryOfLyricsDescription"`
+	// @gotags: xml:"TerritoryOfLyricsDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfLyricsDescription []string `protobuf:"bytes,4,rep,name=territory_of_lyrics_description,json=territoryOfLyricsDescription,proto3" json:"territory_of_lyrics_description,omitempty" xml:"TerritoryOfLyricsDescription" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"Pronunciation"
 	Pronunciation []*Pronunciation `protobuf:"bytes,5,rep,name=pronunciation,proto3" json:"pronunciation,omitempty" xml:"Pronunciation"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
-	// @gotags: xml:"LyricsType,attr"
-	LyricsType string `protobuf:"bytes,7,opt,name=lyrics_type,json=lyricsType,proto3" json:"lyrics_type,omitempty" xml:"LyricsType,attr"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	// @gotags: xml:"LyricsType,attr" avs:"LyricsType"
+	LyricsType *string `protobuf:"bytes,7,opt,name=lyrics_type,json=lyricsType,proto3,oneof" json:"lyrics_type,omitempty" xml:"LyricsType,attr" avs:"LyricsType"`
 	// @gotags: xml:"LyricsTypeNamespace,attr"
-	LyricsTypeNamespace string `protobuf:"bytes,8,opt,name=lyrics_type_namespace,json=lyricsTypeNamespace,proto3" json:"lyrics_type_namespace,omitempty" xml:"LyricsTypeNamespace,attr"`
+	LyricsTypeNamespace *string `protobuf:"bytes,8,opt,name=lyrics_type_namespace,json=lyricsTypeNamespace,proto3,oneof" json:"lyrics_type_namespace,omitempty" xml:"LyricsTypeNamespace,attr"`
 	// @gotags: xml:"LyricsTypeUserDefinedValue,attr"
-	LyricsTypeUserDefinedValue string `protobuf:"bytes,9,opt,name=lyrics_type_user_defined_value,json=lyricsTypeUserDefinedValue,proto3" json:"lyrics_type_user_defined_value,omitempty" xml:"LyricsTypeUserDefinedValue,attr"`
+	LyricsTypeUserDefinedValue *string `protobuf:"bytes,9,opt,name=lyrics_type_user_defined_value,json=lyricsTypeUserDefinedValue,proto3,oneof" json:"lyrics_type_user_defined_value,omitempty" xml:"LyricsTypeUserDefinedValue,attr"`
 	// @gotags: xml:"IsMisquoted,attr"
-	IsMisquoted   bool `protobuf:"varint,10,opt,name=is_misquoted,json=isMisquoted,proto3" json:"is_misquoted,omitempty" xml:"IsMisquoted,attr"`
+	IsMisquoted   *bool `protobuf:"varint,10,opt,name=is_misquoted,json=isMisquoted,proto3,oneof" json:"is_misquoted,omitempty" xml:"IsMisquoted,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2517,36 +2593,36 @@ func (x *Lyrics) GetPronunciation() []*Pronunciation {
 }
 
 func (x *Lyrics) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *Lyrics) GetLyricsType() string {
-	if x != nil {
-		return x.LyricsType
+	if x != nil && x.LyricsType != nil {
+		return *x.LyricsType
 	}
 	return ""
 }
 
 func (x *Lyrics) GetLyricsTypeNamespace() string {
-	if x != nil {
-		return x.LyricsTypeNamespace
+	if x != nil && x.LyricsTypeNamespace != nil {
+		return *x.LyricsTypeNamespace
 	}
 	return ""
 }
 
 func (x *Lyrics) GetLyricsTypeUserDefinedValue() string {
-	if x != nil {
-		return x.LyricsTypeUserDefinedValue
+	if x != nil && x.LyricsTypeUserDefinedValue != nil {
+		return *x.LyricsTypeUserDefinedValue
 	}
 	return ""
 }
 
 func (x *Lyrics) GetIsMisquoted() bool {
-	if x != nil {
-		return x.IsMisquoted
+	if x != nil && x.IsMisquoted != nil {
+		return *x.IsMisquoted
 	}
 	return false
 }
@@ -2555,14 +2631,14 @@ type LyricsText struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"FormatNamespace,attr"
-	FormatNamespace string `protobuf:"bytes,3,opt,name=format_namespace,json=formatNamespace,proto3" json:"format_namespace,omitempty" xml:"FormatNamespace,attr"`
+	FormatNamespace *string `protobuf:"bytes,3,opt,name=format_namespace,json=formatNamespace,proto3,oneof" json:"format_namespace,omitempty" xml:"FormatNamespace,attr"`
 	// @gotags: xml:"FormatUserDefinedValue,attr"
-	FormatUserDefinedValue string `protobuf:"bytes,4,opt,name=format_user_defined_value,json=formatUserDefinedValue,proto3" json:"format_user_defined_value,omitempty" xml:"FormatUserDefinedValue,attr"`
+	FormatUserDefinedValue *string `protobuf:"bytes,4,opt,name=format_user_defined_value,json=formatUserDefinedValue,proto3,oneof" json:"format_user_defined_value,omitempty" xml:"FormatUserDefinedValue,attr"`
 	// @gotags: xml:"IsComplete,attr"
-	IsComplete    bool `protobuf:"varint,5,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty" xml:"IsComplete,attr"`
+	IsComplete    *bool `protobuf:"varint,5,opt,name=is_complete,json=isComplete,proto3,oneof" json:"is_complete,omitempty" xml:"IsComplete,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2612,22 +2688,22 @@ func (x *LyricsText) GetFormat() string {
 }
 
 func (x *LyricsText) GetFormatNamespace() string {
-	if x != nil {
-		return x.FormatNamespace
+	if x != nil && x.FormatNamespace != nil {
+		return *x.FormatNamespace
 	}
 	return ""
 }
 
 func (x *LyricsText) GetFormatUserDefinedValue() string {
-	if x != nil {
-		return x.FormatUserDefinedValue
+	if x != nil && x.FormatUserDefinedValue != nil {
+		return *x.FormatUserDefinedValue
 	}
 	return ""
 }
 
 func (x *LyricsText) GetIsComplete() bool {
-	if x != nil {
-		return x.IsComplete
+	if x != nil && x.IsComplete != nil {
+		return *x.IsComplete
 	}
 	return false
 }
@@ -2688,12 +2764,12 @@ func (x *Meter) GetNoteEquivalentToBeat() int32 {
 
 type Mode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Mode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Mode"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2736,31 +2812,31 @@ func (x *Mode) GetValue() string {
 }
 
 func (x *Mode) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *Mode) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type Modulation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Value"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	// @gotags: xml:"StartPoint"
 	StartPoint string `protobuf:"bytes,2,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"EndPoint"
-	EndPoint string `protobuf:"bytes,3,opt,name=end_point,json=endPoint,proto3" json:"end_point,omitempty" xml:"EndPoint"`
+	EndPoint *string `protobuf:"bytes,3,opt,name=end_point,json=endPoint,proto3,oneof" json:"end_point,omitempty" xml:"EndPoint"`
 	// @gotags: xml:"StartBar"
 	StartBar int32 `protobuf:"varint,4,opt,name=start_bar,json=startBar,proto3" json:"start_bar,omitempty" xml:"StartBar"`
 	// @gotags: xml:"EndBar"
-	EndBar        int32 `protobuf:"varint,5,opt,name=end_bar,json=endBar,proto3" json:"end_bar,omitempty" xml:"EndBar"`
+	EndBar *int32 `protobuf:"varint,5,opt,name=end_bar,json=endBar,proto3,oneof" json:"end_bar,omitempty" xml:"EndBar"`
+	// @gotags: xml:"Value"
+	Value         string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2795,13 +2871,6 @@ func (*Modulation) Descriptor() ([]byte, []int) {
 	return file_ddex_mead_v11_v11_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Modulation) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
 func (x *Modulation) GetStartPoint() string {
 	if x != nil {
 		return x.StartPoint
@@ -2810,8 +2879,8 @@ func (x *Modulation) GetStartPoint() string {
 }
 
 func (x *Modulation) GetEndPoint() string {
-	if x != nil {
-		return x.EndPoint
+	if x != nil && x.EndPoint != nil {
+		return *x.EndPoint
 	}
 	return ""
 }
@@ -2824,12 +2893,19 @@ func (x *Modulation) GetStartBar() int32 {
 }
 
 func (x *Modulation) GetEndBar() int32 {
-	if x != nil {
-		return x.EndBar
+	if x != nil && x.EndBar != nil {
+		return *x.EndBar
 	}
 	return 0
 }
 
+func (x *Modulation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Mood struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
@@ -2840,10 +2916,10 @@ type Mood struct {
 	Description []*TextWithoutTerritory `protobuf:"bytes,3,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"LanguageAndScriptOfMood"
 	LanguageAndScriptOfMood []string `protobuf:"bytes,4,rep,name=language_and_script_of_mood,json=languageAndScriptOfMood,proto3" json:"language_and_script_of_mood,omitempty" xml:"LanguageAndScriptOfMood"`
-	// @gotags: xml:"TerritoryOfMoodDescription"
-	TerritoryOfMoodDescription []string `protobuf:"bytes,5,rep,name=territory_of_mood_description,json=territoryOfMoodDescription,proto3" json:"territory_of_mood_description,omitempty" xml:"TerritoryOfMoodDescription"`
-	// @gotags: xml:"MoodType,attr"
-	MoodType      string `protobuf:"bytes,6,opt,name=mood_type,json=moodType,proto3" json:"mood_type,omitempty" xml:"MoodType,attr"`
+	// @gotags: xml:"TerritoryOfMoodDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfMoodDescription []string `protobuf:"bytes,5,rep,name=territory_of_mood_description,json=territoryOfMoodDescription,proto3" json:"territory_of_mood_description,omitempty" xml:"TerritoryOfMoodDescription" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"MoodType,attr" avs:"MoodOrThemeType"
+	MoodType      *string `protobuf:"bytes,6,opt,name=mood_type,json=moodType,proto3,oneof" json:"mood_type,omitempty" xml:"MoodType,attr" avs:"MoodOrThemeType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2914,24 +2990,24 @@ func (x *Mood) GetTerritoryOfMoodDescription() []string {
 }
 
 func (x *Mood) GetMoodType() string {
-	if x != nil {
-		return x.MoodType
+	if x != nil && x.MoodType != nil {
+		return *x.MoodType
 	}
 	return ""
 }
 
 type MoodValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Mood"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Mood"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"AppliesToComposition,attr"
-	AppliesToComposition bool `protobuf:"varint,4,opt,name=applies_to_composition,json=appliesToComposition,proto3" json:"applies_to_composition,omitempty" xml:"AppliesToComposition,attr"`
+	AppliesToComposition *bool `protobuf:"varint,4,opt,name=applies_to_composition,json=appliesToComposition,proto3,oneof" json:"applies_to_composition,omitempty" xml:"AppliesToComposition,attr"`
 	// @gotags: xml:"AppliesToLyrics,attr"
-	AppliesToLyrics bool `protobuf:"varint,5,opt,name=applies_to_lyrics,json=appliesToLyrics,proto3" json:"applies_to_lyrics,omitempty" xml:"AppliesToLyrics,attr"`
+	AppliesToLyrics *bool `protobuf:"varint,5,opt,name=applies_to_lyrics,json=appliesToLyrics,proto3,oneof" json:"applies_to_lyrics,omitempty" xml:"AppliesToLyrics,attr"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2974,29 +3050,29 @@ func (x *MoodValue) GetValue() string {
 }
 
 func (x *MoodValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *MoodValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *MoodValue) GetAppliesToComposition() bool {
-	if x != nil {
-		return x.AppliesToComposition
+	if x != nil && x.AppliesToComposition != nil {
+		return *x.AppliesToComposition
 	}
 	return false
 }
 
 func (x *MoodValue) GetAppliesToLyrics() bool {
-	if x != nil {
-		return x.AppliesToLyrics
+	if x != nil && x.AppliesToLyrics != nil {
+		return *x.AppliesToLyrics
 	}
 	return false
 }
@@ -3004,17 +3080,17 @@ func (x *MoodValue) GetAppliesToLyrics() bool {
 type Party struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,5,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"PartyName"
-	PartyName     string `protobuf:"bytes,6,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	PartyName     *string `protobuf:"bytes,6,opt,name=party_name,json=partyName,proto3,oneof" json:"party_name,omitempty" xml:"PartyName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3050,29 +3126,29 @@ func (*Party) Descriptor() ([]byte, []int) {
 }
 
 func (x *Party) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *Party) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *Party) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *Party) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
@@ -3085,8 +3161,8 @@ func (x *Party) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *Party) GetPartyName() string {
-	if x != nil {
-		return x.PartyName
+	if x != nil && x.PartyName != nil {
+		return *x.PartyName
 	}
 	return ""
 }
@@ -3095,12 +3171,12 @@ type RecordingPart struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
-	// @gotags: xml:"Unit"
-	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit"`
+	// @gotags: xml:"Unit" avs:"UnitOfCuePoints"
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty" xml:"Unit" avs:"UnitOfCuePoints"`
 	// @gotags: xml:"StartPoint"
-	StartPoint string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
+	StartPoint *string `protobuf:"bytes,3,opt,name=start_point,json=startPoint,proto3,oneof" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"EndPoint"
-	EndPoint string `protobuf:"bytes,4,opt,name=end_point,json=endPoint,proto3" json:"end_point,omitempty" xml:"EndPoint"`
+	EndPoint *string `protobuf:"bytes,4,opt,name=end_point,json=endPoint,proto3,oneof" json:"end_point,omitempty" xml:"EndPoint"`
 	// @gotags: xml:"RecordingPartType"
 	RecordingPartType *RecordingPartType `protobuf:"bytes,5,opt,name=recording_part_type,json=recordingPartType,proto3" json:"recording_part_type,omitempty" xml:"RecordingPartType"`
 	// @gotags: xml:"Comment"
@@ -3108,7 +3184,7 @@ type RecordingPart struct {
 	// @gotags: xml:"UsageInformation"
 	UsageInformation []*TextWithFormat `protobuf:"bytes,7,rep,name=usage_information,json=usageInformation,proto3" json:"usage_information,omitempty" xml:"UsageInformation"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3158,15 +3234,15 @@ func (x *RecordingPart) GetUnit() string {
 }
 
 func (x *RecordingPart) GetStartPoint() string {
-	if x != nil {
-		return x.StartPoint
+	if x != nil && x.StartPoint != nil {
+		return *x.StartPoint
 	}
 	return ""
 }
 
 func (x *RecordingPart) GetEndPoint() string {
-	if x != nil {
-		return x.EndPoint
+	if x != nil && x.EndPoint != nil {
+		return *x.EndPoint
 	}
 	return ""
 }
@@ -3193,8 +3269,8 @@ func (x *RecordingPart) GetUsageInformation() []*TextWithFormat {
 }
 
 func (x *RecordingPart) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -3315,13 +3391,15 @@ type ReleaseInformation struct {
 	// @gotags: xml:"Image"
 	Image []*Image `protobuf:"bytes,16,rep,name=image,proto3" json:"image,omitempty" xml:"Image"`
 	// @gotags: xml:"PriorityPeriodStartDate,attr"
-	PriorityPeriodStartDate string `protobuf:"bytes,17,opt,name=priority_period_start_date,json=priorityPeriodStartDate,proto3" json:"priority_period_start_date,omitempty" xml:"PriorityPeriodStartDate,attr"`
+	PriorityPeriodStartDate *string `protobuf:"bytes,17,opt,name=priority_period_start_date,json=priorityPeriodStartDate,proto3,oneof" json:"priority_period_start_date,omitempty" xml:"PriorityPeriodStartDate,attr"`
 	// @gotags: xml:"PriorityPeriodEndDate,attr"
-	PriorityPeriodEndDate string `protobuf:"bytes,18,opt,name=priority_period_end_date,json=priorityPeriodEndDate,proto3" json:"priority_period_end_date,omitempty" xml:"PriorityPeriodEndDate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,19,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	PriorityPeriodEndDate *string `protobuf:"bytes,18,opt,name=priority_period_end_date,json=priorityPeriodEndDate,proto3,oneof" json:"priority_period_end_date,omitempty" xml:"PriorityPeriodEndDate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,19,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,20,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReleaseInformation) Reset() {
@@ -3467,26 +3545,33 @@ func (x *ReleaseInformation) GetImage() []*Image {
 }
 
 func (x *ReleaseInformation) GetPriorityPeriodStartDate() string {
-	if x != nil {
-		return x.PriorityPeriodStartDate
+	if x != nil && x.PriorityPeriodStartDate != nil {
+		return *x.PriorityPeriodStartDate
 	}
 	return ""
 }
 
 func (x *ReleaseInformation) GetPriorityPeriodEndDate() string {
-	if x != nil {
-		return x.PriorityPeriodEndDate
+	if x != nil && x.PriorityPeriodEndDate != nil {
+		return *x.PriorityPeriodEndDate
 	}
 	return ""
 }
 
 func (x *ReleaseInformation) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
+func (x *ReleaseInformation) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type ReleaseInformationList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ReleaseInformation"
@@ -3686,6 +3771,10 @@ type ResourceInformation struct {
 	InstrumentUsed []*InstrumentUsed `protobuf:"bytes,12,rep,name=instrument_used,json=instrumentUsed,proto3" json:"instrument_used,omitempty" xml:"InstrumentUsed"`
 	// @gotags: xml:"Harmony"
 	Harmony []*Harmony `protobuf:"bytes,13,rep,name=harmony,proto3" json:"harmony,omitempty" xml:"Harmony"`
+	// @gotags: xml:"IsOriginal"
+	IsOriginal *Flag `protobuf:"bytes,37,opt,name=is_original,json=isOriginal,proto3" json:"is_original,omitempty" xml:"IsOriginal"`
+	// @gotags: xml:"IsCover"
+	IsCover *Flag `protobuf:"bytes,38,opt,name=is_cover,json=isCover,proto3" json:"is_cover,omitempty" xml:"IsCover"`
 	// @gotags: xml:"Mood"
 	Mood []*Mood `protobuf:"bytes,14,rep,name=mood,proto3" json:"mood,omitempty" xml:"Mood"`
 	// @gotags: xml:"DanceStyle"
@@ -3732,18 +3821,16 @@ type ResourceInformation struct {
 	AlternativeTitle []*AlternativeTitle `protobuf:"bytes,35,rep,name=alternative_title,json=alternativeTitle,proto3" json:"alternative_title,omitempty" xml:"AlternativeTitle"`
 	// @gotags: xml:"Image"
 	Image []*Image `protobuf:"bytes,36,rep,name=image,proto3" json:"image,omitempty" xml:"Image"`
-	// @gotags: xml:"IsOriginal"
-	IsOriginal *Flag `protobuf:"bytes,37,opt,name=is_original,json=isOriginal,proto3" json:"is_original,omitempty" xml:"IsOriginal"`
-	// @gotags: xml:"IsCover"
-	IsCover *Flag `protobuf:"bytes,38,opt,name=is_cover,json=isCover,proto3" json:"is_cover,omitempty" xml:"IsCover"`
 	// @gotags: xml:"PriorityPeriodStartDate,attr"
-	PriorityPeriodStartDate string `protobuf:"bytes,39,opt,name=priority_period_start_date,json=priorityPeriodStartDate,proto3" json:"priority_period_start_date,omitempty" xml:"PriorityPeriodStartDate,attr"`
+	PriorityPeriodStartDate *string `protobuf:"bytes,39,opt,name=priority_period_start_date,json=priorityPeriodStartDate,proto3,oneof" json:"priority_period_start_date,omitempty" xml:"PriorityPeriodStartDate,attr"`
 	// @gotags: xml:"PriorityPeriodEndDate,attr"
-	PriorityPeriodEndDate string `protobuf:"bytes,40,opt,name=priority_period_end_date,json=priorityPeriodEndDate,proto3" json:"priority_period_end_date,omitempty" xml:"PriorityPeriodEndDate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,41,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	PriorityPeriodEndDate *string `protobuf:"bytes,40,opt,name=priority_period_end_date,json=priorityPeriodEndDate,proto3,oneof" json:"priority_period_end_date,omitempty" xml:"PriorityPeriodEndDate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,41,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,42,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResourceInformation) Reset() {
@@ -3867,6 +3954,20 @@ func (x *ResourceInformation) GetHarmony() []*Harmony {
 	return nil
 }
 
+func (x *ResourceInformation) GetIsOriginal() *Flag {
+	if x != nil {
+		return x.IsOriginal
+	}
+	return nil
+}
+
+func (x *ResourceInformation) GetIsCover() *Flag {
+	if x != nil {
+		return x.IsCover
+	}
+	return nil
+}
+
 func (x *ResourceInformation) GetMood() []*Mood {
 	if x != nil {
 		return x.Mood
@@ -4028,41 +4129,34 @@ func (x *ResourceInformation) GetImage() []*Image {
 	return nil
 }
 
-func (x *ResourceInformation) GetIsOriginal() *Flag {
-	if x != nil {
-		return x.IsOriginal
-	}
-	return nil
-}
-
-func (x *ResourceInformation) GetIsCover() *Flag {
-	if x != nil {
-		return x.IsCover
-	}
-	return nil
-}
-
 func (x *ResourceInformation) GetPriorityPeriodStartDate() string {
-	if x != nil {
-		return x.PriorityPeriodStartDate
+	if x != nil && x.PriorityPeriodStartDate != nil {
+		return *x.PriorityPeriodStartDate
 	}
 	return ""
 }
 
 func (x *ResourceInformation) GetPriorityPeriodEndDate() string {
-	if x != nil {
-		return x.PriorityPeriodEndDate
+	if x != nil && x.PriorityPeriodEndDate != nil {
+		return *x.PriorityPeriodEndDate
 	}
 	return ""
 }
 
 func (x *ResourceInformation) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
+func (x *ResourceInformation) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type ResourceInformationList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ResourceInformation"
@@ -4280,8 +4374,8 @@ type RhythmStyle struct {
 	Description []*TextWithoutTerritory `protobuf:"bytes,3,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"LanguageAndScriptOfRhythmStyle"
 	LanguageAndScriptOfRhythmStyle []string `protobuf:"bytes,4,rep,name=language_and_script_of_rhythm_style,json=languageAndScriptOfRhythmStyle,proto3" json:"language_and_script_of_rhythm_style,omitempty" xml:"LanguageAndScriptOfRhythmStyle"`
-	// @gotags: xml:"TerritoryOfRhythmStyleDescription"
-	TerritoryOfRhythmStyleDescription []string `protobuf:"bytes,5,rep,name=territory_of_rhythm_style_description,json=territoryOfRhythmStyleDescription,proto3" json:"territory_of_rhythm_style_description,omitempty" xml:"TerritoryOfRhythmStyleDescription"`
+	// @gotags: xml:"TerritoryOfRhythmStyleDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfRhythmStyleDescription []string `protobuf:"bytes,5,rep,name=territory_of_rhythm_style_description,json=territoryOfRhythmStyleDescription,proto3" json:"territory_of_rhythm_style_description,omitempty" xml:"TerritoryOfRhythmStyleDescription" avs:"CurrentTerritoryCode"`
 	unknownFields                     protoimpl.UnknownFields
 	sizeCache                         protoimpl.SizeCache
 }
@@ -4353,12 +4447,12 @@ func (x *RhythmStyle) GetTerritoryOfRhythmStyleDescription() []string {
 
 type RhythmStyleValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RhythmStyle"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RhythmStyle"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4401,27 +4495,27 @@ func (x *RhythmStyleValue) GetValue() string {
 }
 
 func (x *RhythmStyleValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RhythmStyleValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RootChordNote struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RootChordNote"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RootChordNote"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4464,27 +4558,27 @@ func (x *RootChordNote) GetValue() string {
 }
 
 func (x *RootChordNote) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RootChordNote) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RootChordQuality struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RootChordQuality"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RootChordQuality"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4527,15 +4621,15 @@ func (x *RootChordQuality) GetValue() string {
 }
 
 func (x *RootChordQuality) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RootChordQuality) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -4548,8 +4642,6 @@ type Sample struct {
 	RelatedResource *ResourceSummary `protobuf:"bytes,2,opt,name=related_resource,json=relatedResource,proto3" json:"related_resource,omitempty" xml:"RelatedResource"`
 	// @gotags: xml:"SampleFeature"
 	SampleFeature []*SampleFeature `protobuf:"bytes,3,rep,name=sample_feature,json=sampleFeature,proto3" json:"sample_feature,omitempty" xml:"SampleFeature"`
-	// @gotags: xml:"Description"
-	Description []*TextWithFormat `protobuf:"bytes,4,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"ContainsSamples"
 	ContainsSamples bool `protobuf:"varint,5,opt,name=contains_samples,json=containsSamples,proto3" json:"contains_samples,omitempty" xml:"ContainsSamples"`
 	// @gotags: xml:"IsContainedInSample"
@@ -4557,7 +4649,9 @@ type Sample struct {
 	// @gotags: xml:"HostTiming"
 	HostTiming []*Timing `protobuf:"bytes,7,rep,name=host_timing,json=hostTiming,proto3" json:"host_timing,omitempty" xml:"HostTiming"`
 	// @gotags: xml:"SampleTiming"
-	SampleTiming  []*Timing `protobuf:"bytes,8,rep,name=sample_timing,json=sampleTiming,proto3" json:"sample_timing,omitempty" xml:"SampleTiming"`
+	SampleTiming []*Timing `protobuf:"bytes,8,rep,name=sample_timing,json=sampleTiming,proto3" json:"sample_timing,omitempty" xml:"SampleTiming"`
+	// @gotags: xml:"Description"
+	Description   []*TextWithFormat `protobuf:"bytes,4,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4613,13 +4707,6 @@ func (x *Sample) GetSampleFeature() []*SampleFeature {
 	return nil
 }
 
-func (x *Sample) GetDescription() []*TextWithFormat {
-	if x != nil {
-		return x.Description
-	}
-	return nil
-}
-
 func (x *Sample) GetContainsSamples() bool {
 	if x != nil {
 		return x.ContainsSamples
@@ -4648,12 +4735,19 @@ func (x *Sample) GetSampleTiming() []*Timing {
 	return nil
 }
 
+func (x *Sample) GetDescription() []*TextWithFormat {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
 type SampleFeature struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -4696,8 +4790,8 @@ func (x *SampleFeature) GetValue() string {
 }
 
 func (x *SampleFeature) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -4947,12 +5041,12 @@ func (x *SubGenreCategory) GetValue() []*SubGenreCategoryValue {
 
 type TempoValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Tempo"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Tempo"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4995,15 +5089,15 @@ func (x *TempoValue) GetValue() string {
 }
 
 func (x *TempoValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TempoValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -5018,10 +5112,10 @@ type Theme struct {
 	Description []*TextWithoutTerritory `protobuf:"bytes,3,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"LanguageAndScriptOfTheme"
 	LanguageAndScriptOfTheme []string `protobuf:"bytes,4,rep,name=language_and_script_of_theme,json=languageAndScriptOfTheme,proto3" json:"language_and_script_of_theme,omitempty" xml:"LanguageAndScriptOfTheme"`
-	// @gotags: xml:"TerritoryOfThemeDescription"
-	TerritoryOfThemeDescription []string `protobuf:"bytes,5,rep,name=territory_of_theme_description,json=territoryOfThemeDescription,proto3" json:"territory_of_theme_description,omitempty" xml:"TerritoryOfThemeDescription"`
-	// @gotags: xml:"ThemeType,attr"
-	ThemeType     string `protobuf:"bytes,6,opt,name=theme_type,json=themeType,proto3" json:"theme_type,omitempty" xml:"ThemeType,attr"`
+	// @gotags: xml:"TerritoryOfThemeDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfThemeDescription []string `protobuf:"bytes,5,rep,name=territory_of_theme_description,json=territoryOfThemeDescription,proto3" json:"territory_of_theme_description,omitempty" xml:"TerritoryOfThemeDescription" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"ThemeType,attr" avs:"MoodOrThemeType"
+	ThemeType     *string `protobuf:"bytes,6,opt,name=theme_type,json=themeType,proto3,oneof" json:"theme_type,omitempty" xml:"ThemeType,attr" avs:"MoodOrThemeType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5092,20 +5186,20 @@ func (x *Theme) GetTerritoryOfThemeDescription() []string {
 }
 
 func (x *Theme) GetThemeType() string {
-	if x != nil {
-		return x.ThemeType
+	if x != nil && x.ThemeType != nil {
+		return *x.ThemeType
 	}
 	return ""
 }
 
 type ThemeValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Theme"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Theme"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -5148,15 +5242,15 @@ func (x *ThemeValue) GetValue() string {
 }
 
 func (x *ThemeValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ThemeValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -5165,14 +5259,14 @@ type TimeSignature struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
-	// @gotags: xml:"Modulation"
-	Modulation []*TimeSignatureModulation `protobuf:"bytes,2,rep,name=modulation,proto3" json:"modulation,omitempty" xml:"Modulation"`
 	// @gotags: xml:"Meter"
 	Meter *Meter `protobuf:"bytes,3,opt,name=meter,proto3" json:"meter,omitempty" xml:"Meter"`
 	// @gotags: xml:"NoMeterAvailable"
 	NoMeterAvailable bool `protobuf:"varint,4,opt,name=no_meter_available,json=noMeterAvailable,proto3" json:"no_meter_available,omitempty" xml:"NoMeterAvailable"`
 	// @gotags: xml:"TooManyTempi"
-	TooManyTempi  bool `protobuf:"varint,5,opt,name=too_many_tempi,json=tooManyTempi,proto3" json:"too_many_tempi,omitempty" xml:"TooManyTempi"`
+	TooManyTempi bool `protobuf:"varint,5,opt,name=too_many_tempi,json=tooManyTempi,proto3" json:"too_many_tempi,omitempty" xml:"TooManyTempi"`
+	// @gotags: xml:"Modulation"
+	Modulation    []*TimeSignatureModulation `protobuf:"bytes,2,rep,name=modulation,proto3" json:"modulation,omitempty" xml:"Modulation"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5214,13 +5308,6 @@ func (x *TimeSignature) GetMetadataSourceReference() []*MetadataSourceReference
 	return nil
 }
 
-func (x *TimeSignature) GetModulation() []*TimeSignatureModulation {
-	if x != nil {
-		return x.Modulation
-	}
-	return nil
-}
-
 func (x *TimeSignature) GetMeter() *Meter {
 	if x != nil {
 		return x.Meter
@@ -5242,24 +5329,31 @@ func (x *TimeSignature) GetTooManyTempi() bool {
 	return false
 }
 
+func (x *TimeSignature) GetModulation() []*TimeSignatureModulation {
+	if x != nil {
+		return x.Modulation
+	}
+	return nil
+}
+
 type TimeSignatureModulation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Value"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	// @gotags: xml:"StartPoint"
 	StartPoint string `protobuf:"bytes,2,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"EndPoint"
-	EndPoint string `protobuf:"bytes,3,opt,name=end_point,json=endPoint,proto3" json:"end_point,omitempty" xml:"EndPoint"`
+	EndPoint *string `protobuf:"bytes,3,opt,name=end_point,json=endPoint,proto3,oneof" json:"end_point,omitempty" xml:"EndPoint"`
 	// @gotags: xml:"StartBar"
 	StartBar int32 `protobuf:"varint,4,opt,name=start_bar,json=startBar,proto3" json:"start_bar,omitempty" xml:"StartBar"`
 	// @gotags: xml:"EndBar"
-	EndBar int32 `protobuf:"varint,5,opt,name=end_bar,json=endBar,proto3" json:"end_bar,omitempty" xml:"EndBar"`
+	EndBar *int32 `protobuf:"varint,5,opt,name=end_bar,json=endBar,proto3,oneof" json:"end_bar,omitempty" xml:"EndBar"`
 	// @gotags: xml:"Meter"
 	Meter *Meter `protobuf:"bytes,6,opt,name=meter,proto3" json:"meter,omitempty" xml:"Meter"`
 	// @gotags: xml:"NoMeterAvailable"
 	NoMeterAvailable bool `protobuf:"varint,7,opt,name=no_meter_available,json=noMeterAvailable,proto3" json:"no_meter_available,omitempty" xml:"NoMeterAvailable"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// @gotags: xml:"Value"
+	Value         string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TimeSignatureModulation) Reset() {
@@ -5292,13 +5386,6 @@ func (*TimeSignatureModulation) Descriptor() ([]byte, []int) {
 	return file_ddex_mead_v11_v11_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *TimeSignatureModulation) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
 func (x *TimeSignatureModulation) GetStartPoint() string {
 	if x != nil {
 		return x.StartPoint
@@ -5307,8 +5394,8 @@ func (x *TimeSignatureModulation) GetStartPoint() string {
 }
 
 func (x *TimeSignatureModulation) GetEndPoint() string {
-	if x != nil {
-		return x.EndPoint
+	if x != nil && x.EndPoint != nil {
+		return *x.EndPoint
 	}
 	return ""
 }
@@ -5321,8 +5408,8 @@ func (x *TimeSignatureModulation) GetStartBar() int32 {
 }
 
 func (x *TimeSignatureModulation) GetEndBar() int32 {
-	if x != nil {
-		return x.EndBar
+	if x != nil && x.EndBar != nil {
+		return *x.EndBar
 	}
 	return 0
 }
@@ -5341,24 +5428,31 @@ func (x *TimeSignatureModulation) GetNoMeterAvailable() bool {
 	return false
 }
 
+func (x *TimeSignatureModulation) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Usage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
+	// @gotags: xml:"UsageDate"
+	UsageDate []*EventDate `protobuf:"bytes,6,rep,name=usage_date,json=usageDate,proto3" json:"usage_date,omitempty" xml:"UsageDate"`
+	// @gotags: xml:"UsagePeriod"
+	UsagePeriod []*UsagePeriod `protobuf:"bytes,7,rep,name=usage_period,json=usagePeriod,proto3" json:"usage_period,omitempty" xml:"UsagePeriod"`
 	// @gotags: xml:"Description"
 	Description []*TextWithFormat `protobuf:"bytes,2,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
-	// @gotags: xml:"TerritoryOfUsageDescription"
-	TerritoryOfUsageDescription []string `protobuf:"bytes,3,rep,name=territory_of_usage_description,json=territoryOfUsageDescription,proto3" json:"territory_of_usage_description,omitempty" xml:"TerritoryOfUsageDescription"`
+	// @gotags: xml:"TerritoryOfUsageDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfUsageDescription []string `protobuf:"bytes,3,rep,name=territory_of_usage_description,json=territoryOfUsageDescription,proto3" json:"territory_of_usage_description,omitempty" xml:"TerritoryOfUsageDescription" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"RelevantResource"
 	RelevantResource []*RelevantResource `protobuf:"bytes,5,rep,name=relevant_resource,json=relevantResource,proto3" json:"relevant_resource,omitempty" xml:"RelevantResource"`
-	// @gotags: xml:"UsageDate"
-	UsageDate []*EventDate `protobuf:"bytes,6,rep,name=usage_date,json=usageDate,proto3" json:"usage_date,omitempty" xml:"UsageDate"`
-	// @gotags: xml:"UsagePeriod"
-	UsagePeriod   []*UsagePeriod `protobuf:"bytes,7,rep,name=usage_period,json=usagePeriod,proto3" json:"usage_period,omitempty" xml:"UsagePeriod"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Usage) Reset() {
@@ -5398,44 +5492,44 @@ func (x *Usage) GetMetadataSourceReference() []*MetadataSourceReference {
 	return nil
 }
 
-func (x *Usage) GetDescription() []*TextWithFormat {
+func (x *Usage) GetUsageDate() []*EventDate {
 	if x != nil {
-		return x.Description
+		return x.UsageDate
 	}
 	return nil
 }
 
-func (x *Usage) GetTerritoryOfUsageDescription() []string {
+func (x *Usage) GetUsagePeriod() []*UsagePeriod {
 	if x != nil {
-		return x.TerritoryOfUsageDescription
+		return x.UsagePeriod
 	}
 	return nil
 }
 
-func (x *Usage) GetSequenceNumber() int32 {
+func (x *Usage) GetDescription() []*TextWithFormat {
 	if x != nil {
-		return x.SequenceNumber
+		return x.Description
 	}
-	return 0
+	return nil
 }
 
-func (x *Usage) GetRelevantResource() []*RelevantResource {
+func (x *Usage) GetTerritoryOfUsageDescription() []string {
 	if x != nil {
-		return x.RelevantResource
+		return x.TerritoryOfUsageDescription
 	}
 	return nil
 }
 
-func (x *Usage) GetUsageDate() []*EventDate {
-	if x != nil {
-		return x.UsageDate
+func (x *Usage) GetSequenceNumber() int32 {
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
-	return nil
+	return 0
 }
 
-func (x *Usage) GetUsagePeriod() []*UsagePeriod {
+func (x *Usage) GetRelevantResource() []*RelevantResource {
 	if x != nil {
-		return x.UsagePeriod
+		return x.RelevantResource
 	}
 	return nil
 }
@@ -5555,9 +5649,9 @@ type WorkHierarchy struct {
 	// @gotags: xml:"IsDescribedElement"
 	IsDescribedElement bool `protobuf:"varint,2,opt,name=is_described_element,json=isDescribedElement,proto3" json:"is_described_element,omitempty" xml:"IsDescribedElement"`
 	// @gotags: xml:"IsComplete"
-	IsComplete bool `protobuf:"varint,3,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty" xml:"IsComplete"`
+	IsComplete *bool `protobuf:"varint,3,opt,name=is_complete,json=isComplete,proto3,oneof" json:"is_complete,omitempty" xml:"IsComplete"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"WorkId"
 	WorkId *MusicalWorkIdWithoutFlag `protobuf:"bytes,5,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty" xml:"WorkId"`
 	// @gotags: xml:"WorkTitle"
@@ -5615,15 +5709,15 @@ func (x *WorkHierarchy) GetIsDescribedElement() bool {
 }
 
 func (x *WorkHierarchy) GetIsComplete() bool {
-	if x != nil {
-		return x.IsComplete
+	if x != nil && x.IsComplete != nil {
+		return *x.IsComplete
 	}
 	return false
 }
 
 func (x *WorkHierarchy) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -5659,7 +5753,7 @@ func (x *WorkHierarchy) GetForm() *Form {
 type WorkInformation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MusicalWorkReference"
-	MusicalWorkReference string `protobuf:"bytes,1,opt,name=musical_work_reference,json=musicalWorkReference,proto3" json:"musical_work_reference,omitempty" xml:"MusicalWorkReference"`
+	MusicalWorkReference *string `protobuf:"bytes,1,opt,name=musical_work_reference,json=musicalWorkReference,proto3,oneof" json:"musical_work_reference,omitempty" xml:"MusicalWorkReference"`
 	// @gotags: xml:"WorkSummary"
 	WorkSummary *WorkSummary `protobuf:"bytes,2,opt,name=work_summary,json=workSummary,proto3" json:"work_summary,omitempty" xml:"WorkSummary"`
 	// @gotags: xml:"GenreCategory"
@@ -5712,8 +5806,10 @@ type WorkInformation struct {
 	Award []*Award `protobuf:"bytes,26,rep,name=award,proto3" json:"award,omitempty" xml:"Award"`
 	// @gotags: xml:"AlternativeTitle"
 	AlternativeTitle []*AlternativeTitle `protobuf:"bytes,27,rep,name=alternative_title,json=alternativeTitle,proto3" json:"alternative_title,omitempty" xml:"AlternativeTitle"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,28,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkInformation) Reset() {
@@ -5747,8 +5843,8 @@ func (*WorkInformation) Descriptor() ([]byte, []int) {
 }
 
 func (x *WorkInformation) GetMusicalWorkReference() string {
-	if x != nil {
-		return x.MusicalWorkReference
+	if x != nil && x.MusicalWorkReference != nil {
+		return *x.MusicalWorkReference
 	}
 	return ""
 }
@@ -5935,6 +6031,13 @@ func (x *WorkInformation) GetAlternativeTitle() []*AlternativeTitle {
 	return nil
 }
 
+func (x *WorkInformation) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type WorkInformationList struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"WorkInformation"
@@ -6048,9 +6151,9 @@ type Category struct {
 	// @gotags: xml:"term,attr"
 	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty" xml:"term,attr"`
 	// @gotags: xml:"scheme,attr"
-	Scheme string `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty" xml:"scheme,attr"`
+	Scheme *string `protobuf:"bytes,2,opt,name=scheme,proto3,oneof" json:"scheme,omitempty" xml:"scheme,attr"`
 	// @gotags: xml:"label,attr"
-	Label         string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty" xml:"label,attr"`
+	Label         *string `protobuf:"bytes,3,opt,name=label,proto3,oneof" json:"label,omitempty" xml:"label,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6093,15 +6196,15 @@ func (x *Category) GetTerm() string {
 }
 
 func (x *Category) GetScheme() string {
-	if x != nil {
-		return x.Scheme
+	if x != nil && x.Scheme != nil {
+		return *x.Scheme
 	}
 	return ""
 }
 
 func (x *Category) GetLabel() string {
-	if x != nil {
-		return x.Label
+	if x != nil && x.Label != nil {
+		return *x.Label
 	}
 	return ""
 }
@@ -6109,9 +6212,13 @@ func (x *Category) GetLabel() string {
 type Content struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"type,attr"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"type,attr"`
 	// @gotags: xml:"src,attr"
-	Src           string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty" xml:"src,attr"`
+	Src *string `protobuf:"bytes,2,opt,name=src,proto3,oneof" json:"src,omitempty" xml:"src,attr"`
+	// @gotags: xml:",any"
+	AnyElement []*AnyElement `protobuf:"bytes,3,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	// @gotags: xml:",chardata"
+	Value         string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6147,15 +6254,29 @@ func (*Content) Descriptor() ([]byte, []int) {
 }
 
 func (x *Content) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
 func (x *Content) GetSrc() string {
+	if x != nil && x.Src != nil {
+		return *x.Src
+	}
+	return ""
+}
+
+func (x *Content) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
+func (x *Content) GetValue() string {
 	if x != nil {
-		return x.Src
+		return x.Value
 	}
 	return ""
 }
@@ -6210,9 +6331,9 @@ type Generator struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"uri,attr"
-	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty" xml:"uri,attr"`
+	Uri *string `protobuf:"bytes,2,opt,name=uri,proto3,oneof" json:"uri,omitempty" xml:"uri,attr"`
 	// @gotags: xml:"version,attr"
-	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty" xml:"version,attr"`
+	Version       *string `protobuf:"bytes,3,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"version,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6255,15 +6376,15 @@ func (x *Generator) GetValue() string {
 }
 
 func (x *Generator) GetUri() string {
-	if x != nil {
-		return x.Uri
+	if x != nil && x.Uri != nil {
+		return *x.Uri
 	}
 	return ""
 }
 
 func (x *Generator) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
@@ -6363,15 +6484,17 @@ type Link struct {
 	// @gotags: xml:"href,attr"
 	Href string `protobuf:"bytes,1,opt,name=href,proto3" json:"href,omitempty" xml:"href,attr"`
 	// @gotags: xml:"rel,attr"
-	Rel string `protobuf:"bytes,2,opt,name=rel,proto3" json:"rel,omitempty" xml:"rel,attr"`
+	Rel *string `protobuf:"bytes,2,opt,name=rel,proto3,oneof" json:"rel,omitempty" xml:"rel,attr"`
 	// @gotags: xml:"type,attr"
-	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	Type *string `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"type,attr"`
 	// @gotags: xml:"hreflang,attr"
-	Hreflang string `protobuf:"bytes,4,opt,name=hreflang,proto3" json:"hreflang,omitempty" xml:"hreflang,attr"`
+	Hreflang *string `protobuf:"bytes,4,opt,name=hreflang,proto3,oneof" json:"hreflang,omitempty" xml:"hreflang,attr"`
 	// @gotags: xml:"title,attr"
-	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty" xml:"title,attr"`
+	Title *string `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title,omitempty" xml:"title,attr"`
 	// @gotags: xml:"length,attr"
-	Length        int32 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty" xml:"length,attr"`
+	Length *int32 `protobuf:"varint,6,opt,name=length,proto3,oneof" json:"length,omitempty" xml:"length,attr"`
+	// @gotags: xml:",chardata"
+	Value         string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6414,40 +6537,47 @@ func (x *Link) GetHref() string {
 }
 
 func (x *Link) GetRel() string {
-	if x != nil {
-		return x.Rel
+	if x != nil && x.Rel != nil {
+		return *x.Rel
 	}
 	return ""
 }
 
 func (x *Link) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
 func (x *Link) GetHreflang() string {
-	if x != nil {
-		return x.Hreflang
+	if x != nil && x.Hreflang != nil {
+		return *x.Hreflang
 	}
 	return ""
 }
 
 func (x *Link) GetTitle() string {
-	if x != nil {
-		return x.Title
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
 func (x *Link) GetLength() int32 {
-	if x != nil {
-		return x.Length
+	if x != nil && x.Length != nil {
+		return *x.Length
 	}
 	return 0
 }
 
+func (x *Link) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Logo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -6500,7 +6630,9 @@ type Person struct {
 	// @gotags: xml:"uri"
 	Uri *URI `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty" xml:"uri"`
 	// @gotags: xml:"email"
-	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty" xml:"email"`
+	Email *string `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty" xml:"email"`
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,4,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6550,12 +6682,19 @@ func (x *Person) GetUri() *URI {
 }
 
 func (x *Person) GetEmail() string {
-	if x != nil {
-		return x.Email
+	if x != nil && x.Email != nil {
+		return *x.Email
 	}
 	return ""
 }
 
+func (x *Person) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type Source struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"author"
@@ -6581,7 +6720,9 @@ type Source struct {
 	// @gotags: xml:"title"
 	Title *Text `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty" xml:"title"`
 	// @gotags: xml:"updated"
-	Updated       *DateTime `protobuf:"bytes,12,opt,name=updated,proto3" json:"updated,omitempty" xml:"updated"`
+	Updated *DateTime `protobuf:"bytes,12,opt,name=updated,proto3" json:"updated,omitempty" xml:"updated"`
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,13,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6700,10 +6841,21 @@ func (x *Source) GetUpdated() *DateTime {
 	return nil
 }
 
+func (x *Source) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type Text struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"type,attr"
-	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	// @gotags: xml:"type,attr" avs:"TextTypeATOM"
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"type,attr" avs:"TextTypeATOM"`
+	// @gotags: xml:",any"
+	AnyElement []*AnyElement `protobuf:"bytes,2,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	// @gotags: xml:",chardata"
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6739,8 +6891,22 @@ func (*Text) Descriptor() ([]byte, []int) {
 }
 
 func (x *Text) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *Text) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
+func (x *Text) GetValue() string {
 	if x != nil {
-		return x.Type
+		return x.Value
 	}
 	return ""
 }
@@ -6792,10 +6958,10 @@ func (x *URI) GetValue() string {
 
 type AllTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"AllTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AllTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -6838,20 +7004,20 @@ func (x *AllTerritoryCode) GetValue() string {
 }
 
 func (x *AllTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
 
 type ArtistTypeValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ArtistType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ArtistType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -6894,15 +7060,15 @@ func (x *ArtistTypeValue) GetValue() string {
 }
 
 func (x *ArtistTypeValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ArtistTypeValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -6922,9 +7088,9 @@ type ArtisticInfluence struct {
 	// @gotags: xml:"Description"
 	Description []*TextWithFormat `protobuf:"bytes,6,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"IsInfluenced"
-	IsInfluenced bool `protobuf:"varint,7,opt,name=is_influenced,json=isInfluenced,proto3" json:"is_influenced,omitempty" xml:"IsInfluenced"`
+	IsInfluenced *bool `protobuf:"varint,7,opt,name=is_influenced,json=isInfluenced,proto3,oneof" json:"is_influenced,omitempty" xml:"IsInfluenced"`
 	// @gotags: xml:"IsInfluencer"
-	IsInfluencer  bool `protobuf:"varint,8,opt,name=is_influencer,json=isInfluencer,proto3" json:"is_influencer,omitempty" xml:"IsInfluencer"`
+	IsInfluencer  *bool `protobuf:"varint,8,opt,name=is_influencer,json=isInfluencer,proto3,oneof" json:"is_influencer,omitempty" xml:"IsInfluencer"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7002,15 +7168,15 @@ func (x *ArtisticInfluence) GetDescription() []*TextWithFormat {
 }
 
 func (x *ArtisticInfluence) GetIsInfluenced() bool {
-	if x != nil {
-		return x.IsInfluenced
+	if x != nil && x.IsInfluenced != nil {
+		return *x.IsInfluenced
 	}
 	return false
 }
 
 func (x *ArtisticInfluence) GetIsInfluencer() bool {
-	if x != nil {
-		return x.IsInfluencer
+	if x != nil && x.IsInfluencer != nil {
+		return *x.IsInfluencer
 	}
 	return false
 }
@@ -7171,9 +7337,9 @@ func (x *CatalogNumber) GetNamespace() string {
 type ChartEntry struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Position"
-	Position int32 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty" xml:"Position"`
+	Position *int32 `protobuf:"varint,1,opt,name=position,proto3,oneof" json:"position,omitempty" xml:"Position"`
 	// @gotags: xml:"Date"
-	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty" xml:"Date"`
+	Date *string `protobuf:"bytes,2,opt,name=date,proto3,oneof" json:"date,omitempty" xml:"Date"`
 	// @gotags: xml:"Duration"
 	Duration *Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty" xml:"Duration"`
 	// @gotags: xml:"Comment"
@@ -7213,15 +7379,15 @@ func (*ChartEntry) Descriptor() ([]byte, []int) {
 }
 
 func (x *ChartEntry) GetPosition() int32 {
-	if x != nil {
-		return x.Position
+	if x != nil && x.Position != nil {
+		return *x.Position
 	}
 	return 0
 }
 
 func (x *ChartEntry) GetDate() string {
-	if x != nil {
-		return x.Date
+	if x != nil && x.Date != nil {
+		return *x.Date
 	}
 	return ""
 }
@@ -7248,8 +7414,8 @@ type ClassicalPeriod struct {
 	Name *PeriodValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" xml:"Name"`
 	// @gotags: xml:"LanguageAndScriptOfClassicalPeriod"
 	LanguageAndScriptOfClassicalPeriod []string `protobuf:"bytes,3,rep,name=language_and_script_of_classical_period,json=languageAndScriptOfClassicalPeriod,proto3" json:"language_and_script_of_classical_period,omitempty" xml:"LanguageAndScriptOfClassicalPeriod"`
-	// @gotags: xml:"TerritoryOfClassicalPeriodDescription"
-	TerritoryOfClassicalPeriodDescription []string `protobuf:"bytes,4,rep,name=territory_of_classical_period_description,json=territoryOfClassicalPeriodDescription,proto3" json:"territory_of_classical_period_description,omitempty" xml:"TerritoryOfClassicalPeriodDescription"`
+	// @gotags: xml:"TerritoryOfClassicalPeriodDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfClassicalPeriodDescription []string `protobuf:"bytes,4,rep,name=territory_of_classical_period_description,json=territoryOfClassicalPeriodDescription,proto3" json:"territory_of_classical_period_description,omitempty" xml:"TerritoryOfClassicalPeriodDescription" avs:"CurrentTerritoryCode"`
 	unknownFields                         protoimpl.UnknownFields
 	sizeCache                             protoimpl.SizeCache
 }
@@ -7322,8 +7488,8 @@ type CommentaryNote struct {
 	CommentaryNoteType []*CommentaryNoteType `protobuf:"bytes,3,rep,name=commentary_note_type,json=commentaryNoteType,proto3" json:"commentary_note_type,omitempty" xml:"CommentaryNoteType"`
 	// @gotags: xml:"LanguageAndScriptOfCommentaryNote"
 	LanguageAndScriptOfCommentaryNote []string `protobuf:"bytes,4,rep,name=language_and_script_of_commentary_note,json=languageAndScriptOfCommentaryNote,proto3" json:"language_and_script_of_commentary_note,omitempty" xml:"LanguageAndScriptOfCommentaryNote"`
-	// @gotags: xml:"TerritoryOfCommentaryNoteDescription"
-	TerritoryOfCommentaryNoteDescription []string `protobuf:"bytes,5,rep,name=territory_of_commentary_note_description,json=territoryOfCommentaryNoteDescription,proto3" json:"territory_of_commentary_note_description,omitempty" xml:"TerritoryOfCommentaryNoteDescription"`
+	// @gotags: xml:"TerritoryOfCommentaryNoteDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfCommentaryNoteDescription []string `protobuf:"bytes,5,rep,name=territory_of_commentary_note_description,json=territoryOfCommentaryNoteDescription,proto3" json:"territory_of_commentary_note_description,omitempty" xml:"TerritoryOfCommentaryNoteDescription" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"Author"
 	Author        []*PartyDescriptorWithPronunciation `protobuf:"bytes,6,rep,name=author,proto3" json:"author,omitempty" xml:"Author"`
 	unknownFields protoimpl.UnknownFields
@@ -7404,12 +7570,12 @@ func (x *CommentaryNote) GetAuthor() []*PartyDescriptorWithPronunciation {
 
 type CommentaryNoteType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CommentaryNoteType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CommentaryNoteType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -7452,25 +7618,25 @@ func (x *CommentaryNoteType) GetValue() string {
 }
 
 func (x *CommentaryNoteType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CommentaryNoteType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type CurrentTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"CurrentTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeType"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeType"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -7513,8 +7679,8 @@ func (x *CurrentTerritoryCode) GetValue() string {
 }
 
 func (x *CurrentTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
@@ -7524,7 +7690,7 @@ type Date struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7567,8 +7733,8 @@ func (x *Date) GetValue() string {
 }
 
 func (x *Date) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
@@ -7578,11 +7744,11 @@ type DetailedHashSum struct {
 	// @gotags: xml:"Algorithm"
 	Algorithm *HashSumAlgorithmType `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" xml:"Algorithm"`
 	// @gotags: xml:"Version"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version"`
 	// @gotags: xml:"Parameter"
-	Parameter string `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty" xml:"Parameter"`
-	// @gotags: xml:"DataType"
-	DataType string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType"`
+	Parameter *string `protobuf:"bytes,3,opt,name=parameter,proto3,oneof" json:"parameter,omitempty" xml:"Parameter"`
+	// @gotags: xml:"DataType" avs:"BinaryDataType"
+	DataType *string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3,oneof" json:"data_type,omitempty" xml:"DataType" avs:"BinaryDataType"`
 	// @gotags: xml:"HashSumValue"
 	HashSumValue  string `protobuf:"bytes,5,opt,name=hash_sum_value,json=hashSumValue,proto3" json:"hash_sum_value,omitempty" xml:"HashSumValue"`
 	unknownFields protoimpl.UnknownFields
@@ -7627,22 +7793,22 @@ func (x *DetailedHashSum) GetAlgorithm() *HashSumAlgorithmType {
 }
 
 func (x *DetailedHashSum) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetParameter() string {
-	if x != nil {
-		return x.Parameter
+	if x != nil && x.Parameter != nil {
+		return *x.Parameter
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetDataType() string {
-	if x != nil {
-		return x.DataType
+	if x != nil && x.DataType != nil {
+		return *x.DataType
 	}
 	return ""
 }
@@ -7657,15 +7823,15 @@ func (x *DetailedHashSum) GetHashSumValue() string {
 type DetailedPartyId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"CisacSocietyId"
-	CisacSocietyId string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
+	CisacSocietyId *string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3,oneof" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,6,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	unknownFields protoimpl.UnknownFields
@@ -7703,36 +7869,36 @@ func (*DetailedPartyId) Descriptor() ([]byte, []int) {
 }
 
 func (x *DetailedPartyId) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetCisacSocietyId() string {
-	if x != nil {
-		return x.CisacSocietyId
+	if x != nil && x.CisacSocietyId != nil {
+		return *x.CisacSocietyId
 	}
 	return ""
 }
@@ -7749,11 +7915,11 @@ type DisplayArtistNameWithDefault struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7796,22 +7962,22 @@ func (x *DisplayArtistNameWithDefault) GetValue() string {
 }
 
 func (x *DisplayArtistNameWithDefault) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -7874,12 +8040,12 @@ type Duration struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"UnitOfDuration,attr"
-	UnitOfDuration string `protobuf:"bytes,2,opt,name=unit_of_duration,json=unitOfDuration,proto3" json:"unit_of_duration,omitempty" xml:"UnitOfDuration,attr"`
+	// @gotags: xml:"UnitOfDuration,attr" avs:"UnitOfDuration"
+	UnitOfDuration string `protobuf:"bytes,2,opt,name=unit_of_duration,json=unitOfDuration,proto3" json:"unit_of_duration,omitempty" xml:"UnitOfDuration,attr" avs:"UnitOfDuration"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -7929,15 +8095,15 @@ func (x *Duration) GetUnitOfDuration() string {
 }
 
 func (x *Duration) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *Duration) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -7958,8 +8124,8 @@ type Epoch struct {
 	EndDate *Date `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
 	// @gotags: xml:"LanguageAndScriptOfEpoch"
 	LanguageAndScriptOfEpoch []string `protobuf:"bytes,7,rep,name=language_and_script_of_epoch,json=languageAndScriptOfEpoch,proto3" json:"language_and_script_of_epoch,omitempty" xml:"LanguageAndScriptOfEpoch"`
-	// @gotags: xml:"TerritoryOfEpochDescription"
-	TerritoryOfEpochDescription []string `protobuf:"bytes,8,rep,name=territory_of_epoch_description,json=territoryOfEpochDescription,proto3" json:"territory_of_epoch_description,omitempty" xml:"TerritoryOfEpochDescription"`
+	// @gotags: xml:"TerritoryOfEpochDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfEpochDescription []string `protobuf:"bytes,8,rep,name=territory_of_epoch_description,json=territoryOfEpochDescription,proto3" json:"territory_of_epoch_description,omitempty" xml:"TerritoryOfEpochDescription" avs:"CurrentTerritoryCode"`
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
@@ -8055,17 +8221,17 @@ type EventDate struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -8108,43 +8274,43 @@ func (x *EventDate) GetValue() string {
 }
 
 func (x *EventDate) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDate) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDate) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDate) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDate) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDate) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -8154,17 +8320,17 @@ type EventDateTime struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"TerritoryCode,attr"
-	TerritoryCode string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"TerritoryCode,attr" avs:"AllTerritoryCode"
+	TerritoryCode *string `protobuf:"bytes,5,opt,name=territory_code,json=territoryCode,proto3,oneof" json:"territory_code,omitempty" xml:"TerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -8207,43 +8373,43 @@ func (x *EventDateTime) GetValue() string {
 }
 
 func (x *EventDateTime) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDateTime) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDateTime) GetTerritoryCode() string {
-	if x != nil {
-		return x.TerritoryCode
+	if x != nil && x.TerritoryCode != nil {
+		return *x.TerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateTime) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -8255,7 +8421,7 @@ type File struct {
 	// @gotags: xml:"HashSum"
 	HashSum *DetailedHashSum `protobuf:"bytes,2,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty" xml:"HashSum"`
 	// @gotags: xml:"FileSize"
-	FileSize      string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty" xml:"FileSize"`
+	FileSize      *string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty" xml:"FileSize"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8305,8 +8471,8 @@ func (x *File) GetHashSum() *DetailedHashSum {
 }
 
 func (x *File) GetFileSize() string {
-	if x != nil {
-		return x.FileSize
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
 	}
 	return ""
 }
@@ -8324,15 +8490,15 @@ type Focus struct {
 	// @gotags: xml:"Writer"
 	Writer []*PartyDescriptorWithPronunciation `protobuf:"bytes,5,rep,name=writer,proto3" json:"writer,omitempty" xml:"Writer"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"PeriodOfBeingFocus"
 	PeriodOfBeingFocus []*PeriodWithTime `protobuf:"bytes,7,rep,name=period_of_being_focus,json=periodOfBeingFocus,proto3" json:"period_of_being_focus,omitempty" xml:"PeriodOfBeingFocus"`
-	// @gotags: xml:"TerritoryOfBeingFocusTrackDescription"
-	TerritoryOfBeingFocusTrackDescription []string `protobuf:"bytes,8,rep,name=territory_of_being_focus_track_description,json=territoryOfBeingFocusTrackDescription,proto3" json:"territory_of_being_focus_track_description,omitempty" xml:"TerritoryOfBeingFocusTrackDescription"`
+	// @gotags: xml:"TerritoryOfBeingFocusTrackDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfBeingFocusTrackDescription []string `protobuf:"bytes,8,rep,name=territory_of_being_focus_track_description,json=territoryOfBeingFocusTrackDescription,proto3" json:"territory_of_being_focus_track_description,omitempty" xml:"TerritoryOfBeingFocusTrackDescription" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"Comment"
 	Comment []*TextWithoutTerritory `protobuf:"bytes,9,rep,name=comment,proto3" json:"comment,omitempty" xml:"Comment"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8403,8 +8569,8 @@ func (x *Focus) GetWriter() []*PartyDescriptorWithPronunciation {
 }
 
 func (x *Focus) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -8431,22 +8597,22 @@ func (x *Focus) GetComment() []*TextWithoutTerritory {
 }
 
 func (x *Focus) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 type GenreCategoryValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ClassifiedGenre"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ClassifiedGenre"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,3,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,4,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8489,34 +8655,34 @@ func (x *GenreCategoryValue) GetValue() string {
 }
 
 func (x *GenreCategoryValue) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *GenreCategoryValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *GenreCategoryValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type HashSumAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"HashSumAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"HashSumAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8559,15 +8725,15 @@ func (x *HashSumAlgorithmType) GetValue() string {
 }
 
 func (x *HashSumAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *HashSumAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8583,7 +8749,7 @@ type HistoricChartingInformation struct {
 	// @gotags: xml:"DurationInCharts"
 	DurationInCharts *Duration `protobuf:"bytes,4,opt,name=duration_in_charts,json=durationInCharts,proto3" json:"duration_in_charts,omitempty" xml:"DurationInCharts"`
 	// @gotags: xml:"TopPosition"
-	TopPosition int32 `protobuf:"varint,5,opt,name=top_position,json=topPosition,proto3" json:"top_position,omitempty" xml:"TopPosition"`
+	TopPosition *int32 `protobuf:"varint,5,opt,name=top_position,json=topPosition,proto3,oneof" json:"top_position,omitempty" xml:"TopPosition"`
 	// @gotags: xml:"ChartEntry"
 	ChartEntry []*ChartEntry `protobuf:"bytes,6,rep,name=chart_entry,json=chartEntry,proto3" json:"chart_entry,omitempty" xml:"ChartEntry"`
 	// @gotags: xml:"Comment"
@@ -8651,8 +8817,8 @@ func (x *HistoricChartingInformation) GetDurationInCharts() *Duration {
 }
 
 func (x *HistoricChartingInformation) GetTopPosition() int32 {
-	if x != nil {
-		return x.TopPosition
+	if x != nil && x.TopPosition != nil {
+		return *x.TopPosition
 	}
 	return 0
 }
@@ -8736,12 +8902,12 @@ func (x *Image) GetImageType() []*ImageType {
 
 type ImageType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8784,15 +8950,15 @@ func (x *ImageType) GetValue() string {
 }
 
 func (x *ImageType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8899,11 +9065,11 @@ func (x *MessageAuditTrailEvent) GetDateTime() string {
 type MessageHeader struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageThreadId"
-	MessageThreadId string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
+	MessageThreadId *string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3,oneof" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
 	// @gotags: xml:"MessageId"
 	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty" xml:"MessageId"`
 	// @gotags: xml:"MessageFileName"
-	MessageFileName string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3" json:"message_file_name,omitempty" xml:"MessageFileName"`
+	MessageFileName *string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3,oneof" json:"message_file_name,omitempty" xml:"MessageFileName"`
 	// @gotags: xml:"MessageSender"
 	MessageSender *MessagingPartyWithoutCode `protobuf:"bytes,4,opt,name=message_sender,json=messageSender,proto3" json:"message_sender,omitempty" xml:"MessageSender"`
 	// @gotags: xml:"SentOnBehalfOf"
@@ -8914,8 +9080,8 @@ type MessageHeader struct {
 	MessageCreatedDateTime string `protobuf:"bytes,7,opt,name=message_created_date_time,json=messageCreatedDateTime,proto3" json:"message_created_date_time,omitempty" xml:"MessageCreatedDateTime"`
 	// @gotags: xml:"MessageAuditTrail"
 	MessageAuditTrail *MessageAuditTrail `protobuf:"bytes,8,opt,name=message_audit_trail,json=messageAuditTrail,proto3" json:"message_audit_trail,omitempty" xml:"MessageAuditTrail"`
-	// @gotags: xml:"MessageControlType"
-	MessageControlType string `protobuf:"bytes,9,opt,name=message_control_type,json=messageControlType,proto3" json:"message_control_type,omitempty" xml:"MessageControlType"`
+	// @gotags: xml:"MessageControlType" avs:"MessageControlType"
+	MessageControlType *string `protobuf:"bytes,9,opt,name=message_control_type,json=messageControlType,proto3,oneof" json:"message_control_type,omitempty" xml:"MessageControlType" avs:"MessageControlType"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -8951,8 +9117,8 @@ func (*MessageHeader) Descriptor() ([]byte, []int) {
 }
 
 func (x *MessageHeader) GetMessageThreadId() string {
-	if x != nil {
-		return x.MessageThreadId
+	if x != nil && x.MessageThreadId != nil {
+		return *x.MessageThreadId
 	}
 	return ""
 }
@@ -8965,8 +9131,8 @@ func (x *MessageHeader) GetMessageId() string {
 }
 
 func (x *MessageHeader) GetMessageFileName() string {
-	if x != nil {
-		return x.MessageFileName
+	if x != nil && x.MessageFileName != nil {
+		return *x.MessageFileName
 	}
 	return ""
 }
@@ -9007,8 +9173,8 @@ func (x *MessageHeader) GetMessageAuditTrail() *MessageAuditTrail {
 }
 
 func (x *MessageHeader) GetMessageControlType() string {
-	if x != nil {
-		return x.MessageControlType
+	if x != nil && x.MessageControlType != nil {
+		return *x.MessageControlType
 	}
 	return ""
 }
@@ -9020,7 +9186,7 @@ type MessagingPartyWithoutCode struct {
 	// @gotags: xml:"PartyName"
 	PartyName *PartyNameWithoutCode `protobuf:"bytes,2,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"TradingName"
-	TradingName   string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
+	TradingName   *string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3,oneof" json:"trading_name,omitempty" xml:"TradingName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9070,8 +9236,8 @@ func (x *MessagingPartyWithoutCode) GetPartyName() *PartyNameWithoutCode {
 }
 
 func (x *MessagingPartyWithoutCode) GetTradingName() string {
-	if x != nil {
-		return x.TradingName
+	if x != nil && x.TradingName != nil {
+		return *x.TradingName
 	}
 	return ""
 }
@@ -9080,14 +9246,14 @@ type MetadataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SourceReference"
 	SourceReference string `protobuf:"bytes,1,opt,name=source_reference,json=sourceReference,proto3" json:"source_reference,omitempty" xml:"SourceReference"`
-	// @gotags: xml:"MetadataSourceType"
-	MetadataSourceType *MetadataSourceType `protobuf:"bytes,2,opt,name=metadata_source_type,json=metadataSourceType,proto3" json:"metadata_source_type,omitempty" xml:"MetadataSourceType"`
 	// @gotags: xml:"PartyId"
 	PartyId []*DetailedPartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
-	PartyName     []*PartyNameWithPronunciation `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PartyName []*PartyNameWithPronunciation `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"MetadataSourceType"
+	MetadataSourceType *MetadataSourceType `protobuf:"bytes,2,opt,name=metadata_source_type,json=metadataSourceType,proto3" json:"metadata_source_type,omitempty" xml:"MetadataSourceType"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *MetadataSource) Reset() {
@@ -9127,23 +9293,23 @@ func (x *MetadataSource) GetSourceReference() string {
 	return ""
 }
 
-func (x *MetadataSource) GetMetadataSourceType() *MetadataSourceType {
+func (x *MetadataSource) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.MetadataSourceType
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *MetadataSource) GetPartyId() []*DetailedPartyId {
+func (x *MetadataSource) GetPartyName() []*PartyNameWithPronunciation {
 	if x != nil {
-		return x.PartyId
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *MetadataSource) GetPartyName() []*PartyNameWithPronunciation {
+func (x *MetadataSource) GetMetadataSourceType() *MetadataSourceType {
 	if x != nil {
-		return x.PartyName
+		return x.MetadataSourceType
 	}
 	return nil
 }
@@ -9198,11 +9364,11 @@ type MetadataSourceReference struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"AssertionDateTime,attr"
-	AssertionDateTime string `protobuf:"bytes,2,opt,name=assertion_date_time,json=assertionDateTime,proto3" json:"assertion_date_time,omitempty" xml:"AssertionDateTime,attr"`
-	// @gotags: xml:"Status,attr"
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty" xml:"Status,attr"`
+	AssertionDateTime *string `protobuf:"bytes,2,opt,name=assertion_date_time,json=assertionDateTime,proto3,oneof" json:"assertion_date_time,omitempty" xml:"AssertionDateTime,attr"`
+	// @gotags: xml:"Status,attr" avs:"AssertionStatus"
+	Status *string `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty" xml:"Status,attr" avs:"AssertionStatus"`
 	// @gotags: xml:"Weight,attr"
-	Weight        string `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty" xml:"Weight,attr"`
+	Weight        *string `protobuf:"bytes,4,opt,name=weight,proto3,oneof" json:"weight,omitempty" xml:"Weight,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9245,34 +9411,34 @@ func (x *MetadataSourceReference) GetValue() string {
 }
 
 func (x *MetadataSourceReference) GetAssertionDateTime() string {
-	if x != nil {
-		return x.AssertionDateTime
+	if x != nil && x.AssertionDateTime != nil {
+		return *x.AssertionDateTime
 	}
 	return ""
 }
 
 func (x *MetadataSourceReference) GetStatus() string {
-	if x != nil {
-		return x.Status
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return ""
 }
 
 func (x *MetadataSourceReference) GetWeight() string {
-	if x != nil {
-		return x.Weight
+	if x != nil && x.Weight != nil {
+		return *x.Weight
 	}
 	return ""
 }
 
 type MetadataSourceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"MetadataSourceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"MetadataSourceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -9315,15 +9481,15 @@ func (x *MetadataSourceType) GetValue() string {
 }
 
 func (x *MetadataSourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *MetadataSourceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -9331,9 +9497,9 @@ func (x *MetadataSourceType) GetUserDefinedValue() string {
 type MusicalWorkIdWithoutFlag struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"OpusNumber"
-	OpusNumber string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3" json:"opus_number,omitempty" xml:"OpusNumber"`
+	OpusNumber *string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3,oneof" json:"opus_number,omitempty" xml:"OpusNumber"`
 	// @gotags: xml:"ComposerCatalogNumber"
 	ComposerCatalogNumber []string `protobuf:"bytes,3,rep,name=composer_catalog_number,json=composerCatalogNumber,proto3" json:"composer_catalog_number,omitempty" xml:"ComposerCatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -9373,15 +9539,15 @@ func (*MusicalWorkIdWithoutFlag) Descriptor() ([]byte, []int) {
 }
 
 func (x *MusicalWorkIdWithoutFlag) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *MusicalWorkIdWithoutFlag) GetOpusNumber() string {
-	if x != nil {
-		return x.OpusNumber
+	if x != nil && x.OpusNumber != nil {
+		return *x.OpusNumber
 	}
 	return ""
 }
@@ -9405,7 +9571,7 @@ type Name struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -9448,8 +9614,8 @@ func (x *Name) GetValue() string {
 }
 
 func (x *Name) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -9579,9 +9745,9 @@ type PartyNameWithPronunciation struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *NameWithPronunciationAndScriptCode `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,9,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,9,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -9666,15 +9832,15 @@ func (x *PartyNameWithPronunciation) GetAbbreviatedName() *NameWithPronunciation
 }
 
 func (x *PartyNameWithPronunciation) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PartyNameWithPronunciation) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -9684,17 +9850,17 @@ type PartyNameWithoutCode struct {
 	// @gotags: xml:"FullName"
 	FullName string `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
-	FullNameIndexed string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
+	FullNameIndexed *string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3,oneof" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
-	NamesBeforeKeyName string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
+	NamesBeforeKeyName *string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3,oneof" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
 	// @gotags: xml:"KeyName"
-	KeyName string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty" xml:"KeyName"`
+	KeyName *string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3,oneof" json:"key_name,omitempty" xml:"KeyName"`
 	// @gotags: xml:"NamesAfterKeyName"
-	NamesAfterKeyName string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
+	NamesAfterKeyName *string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3,oneof" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
 	// @gotags: xml:"AbbreviatedName"
-	AbbreviatedName string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
+	AbbreviatedName *string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3,oneof" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -9737,43 +9903,43 @@ func (x *PartyNameWithoutCode) GetFullName() string {
 }
 
 func (x *PartyNameWithoutCode) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetFullNameIndexed() string {
-	if x != nil {
-		return x.FullNameIndexed
+	if x != nil && x.FullNameIndexed != nil {
+		return *x.FullNameIndexed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesBeforeKeyName() string {
-	if x != nil {
-		return x.NamesBeforeKeyName
+	if x != nil && x.NamesBeforeKeyName != nil {
+		return *x.NamesBeforeKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetKeyName() string {
-	if x != nil {
-		return x.KeyName
+	if x != nil && x.KeyName != nil {
+		return *x.KeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesAfterKeyName() string {
-	if x != nil {
-		return x.NamesAfterKeyName
+	if x != nil && x.NamesAfterKeyName != nil {
+		return *x.NamesAfterKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetAbbreviatedName() string {
-	if x != nil {
-		return x.AbbreviatedName
+	if x != nil && x.AbbreviatedName != nil {
+		return *x.AbbreviatedName
 	}
 	return ""
 }
@@ -9852,12 +10018,12 @@ func (x *Period) GetEndDateTime() *EventDateTime {
 
 type PeriodValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Period"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Period"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -9900,15 +10066,15 @@ func (x *PeriodValue) GetValue() string {
 }
 
 func (x *PeriodValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PeriodValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -9916,9 +10082,9 @@ func (x *PeriodValue) GetUserDefinedValue() string {
 type PeriodWithTime struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"StartDateTime"
-	StartDateTime string `protobuf:"bytes,1,opt,name=start_date_time,json=startDateTime,proto3" json:"start_date_time,omitempty" xml:"StartDateTime"`
+	StartDateTime *string `protobuf:"bytes,1,opt,name=start_date_time,json=startDateTime,proto3,oneof" json:"start_date_time,omitempty" xml:"StartDateTime"`
 	// @gotags: xml:"EndDateTime"
-	EndDateTime   string `protobuf:"bytes,2,opt,name=end_date_time,json=endDateTime,proto3" json:"end_date_time,omitempty" xml:"EndDateTime"`
+	EndDateTime   *string `protobuf:"bytes,2,opt,name=end_date_time,json=endDateTime,proto3,oneof" json:"end_date_time,omitempty" xml:"EndDateTime"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -9954,15 +10120,15 @@ func (*PeriodWithTime) Descriptor() ([]byte, []int) {
 }
 
 func (x *PeriodWithTime) GetStartDateTime() string {
-	if x != nil {
-		return x.StartDateTime
+	if x != nil && x.StartDateTime != nil {
+		return *x.StartDateTime
 	}
 	return ""
 }
 
 func (x *PeriodWithTime) GetEndDateTime() string {
-	if x != nil {
-		return x.EndDateTime
+	if x != nil && x.EndDateTime != nil {
+		return *x.EndDateTime
 	}
 	return ""
 }
@@ -9972,15 +10138,15 @@ type Pronunciation struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsCanonical,attr"
-	IsCanonical bool `protobuf:"varint,2,opt,name=is_canonical,json=isCanonical,proto3" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
+	IsCanonical *bool `protobuf:"varint,2,opt,name=is_canonical,json=isCanonical,proto3,oneof" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
 	// @gotags: xml:"LanguageCode,attr"
-	LanguageCode string `protobuf:"bytes,3,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty" xml:"LanguageCode,attr"`
-	// @gotags: xml:"EncodingType,attr"
-	EncodingType string `protobuf:"bytes,4,opt,name=encoding_type,json=encodingType,proto3" json:"encoding_type,omitempty" xml:"EncodingType,attr"`
+	LanguageCode *string `protobuf:"bytes,3,opt,name=language_code,json=languageCode,proto3,oneof" json:"language_code,omitempty" xml:"LanguageCode,attr"`
+	// @gotags: xml:"EncodingType,attr" avs:"EncodingType"
+	EncodingType *string `protobuf:"bytes,4,opt,name=encoding_type,json=encodingType,proto3,oneof" json:"encoding_type,omitempty" xml:"EncodingType,attr" avs:"EncodingType"`
 	// @gotags: xml:"EncodingNamespace,attr"
-	EncodingNamespace string `protobuf:"bytes,5,opt,name=encoding_namespace,json=encodingNamespace,proto3" json:"encoding_namespace,omitempty" xml:"EncodingNamespace,attr"`
+	EncodingNamespace *string `protobuf:"bytes,5,opt,name=encoding_namespace,json=encodingNamespace,proto3,oneof" json:"encoding_namespace,omitempty" xml:"EncodingNamespace,attr"`
 	// @gotags: xml:"EncodingUserDefinedValue,attr"
-	EncodingUserDefinedValue string `protobuf:"bytes,6,opt,name=encoding_user_defined_value,json=encodingUserDefinedValue,proto3" json:"encoding_user_defined_value,omitempty" xml:"EncodingUserDefinedValue,attr"`
+	EncodingUserDefinedValue *string `protobuf:"bytes,6,opt,name=encoding_user_defined_value,json=encodingUserDefinedValue,proto3,oneof" json:"encoding_user_defined_value,omitempty" xml:"EncodingUserDefinedValue,attr"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -10023,36 +10189,36 @@ func (x *Pronunciation) GetValue() string {
 }
 
 func (x *Pronunciation) GetIsCanonical() bool {
-	if x != nil {
-		return x.IsCanonical
+	if x != nil && x.IsCanonical != nil {
+		return *x.IsCanonical
 	}
 	return false
 }
 
 func (x *Pronunciation) GetLanguageCode() string {
-	if x != nil {
-		return x.LanguageCode
+	if x != nil && x.LanguageCode != nil {
+		return *x.LanguageCode
 	}
 	return ""
 }
 
 func (x *Pronunciation) GetEncodingType() string {
-	if x != nil {
-		return x.EncodingType
+	if x != nil && x.EncodingType != nil {
+		return *x.EncodingType
 	}
 	return ""
 }
 
 func (x *Pronunciation) GetEncodingNamespace() string {
-	if x != nil {
-		return x.EncodingNamespace
+	if x != nil && x.EncodingNamespace != nil {
+		return *x.EncodingNamespace
 	}
 	return ""
 }
 
 func (x *Pronunciation) GetEncodingUserDefinedValue() string {
-	if x != nil {
-		return x.EncodingUserDefinedValue
+	if x != nil && x.EncodingUserDefinedValue != nil {
+		return *x.EncodingUserDefinedValue
 	}
 	return ""
 }
@@ -10113,12 +10279,12 @@ func (x *ProprietaryId) GetNamespace() string {
 
 type RecordingPartType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RecordingPartType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RecordingPartType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10161,29 +10327,29 @@ func (x *RecordingPartType) GetValue() string {
 }
 
 func (x *RecordingPartType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RecordingPartType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type RelatedCreation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Title"
-	Title *TitleWithPronunciation `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"ReleaseId"
 	ReleaseId *ReleaseId `protobuf:"bytes,2,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ResourceId"
 	ResourceId *ResourceIdWithoutFlag `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
 	// @gotags: xml:"MusicalWorkId"
 	MusicalWorkId *MusicalWorkIdWithoutFlag `protobuf:"bytes,4,opt,name=musical_work_id,json=musicalWorkId,proto3" json:"musical_work_id,omitempty" xml:"MusicalWorkId"`
+	// @gotags: xml:"Title"
+	Title         *TitleWithPronunciation `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -10218,13 +10384,6 @@ func (*RelatedCreation) Descriptor() ([]byte, []int) {
 	return file_ddex_mead_v11_v11_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *RelatedCreation) GetTitle() *TitleWithPronunciation {
-	if x != nil {
-		return x.Title
-	}
-	return nil
-}
-
 func (x *RelatedCreation) GetReleaseId() *ReleaseId {
 	if x != nil {
 		return x.ReleaseId
@@ -10246,14 +10405,21 @@ func (x *RelatedCreation) GetMusicalWorkId() *MusicalWorkIdWithoutFlag {
 	return nil
 }
 
+func (x *RelatedCreation) GetTitle() *TitleWithPronunciation {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
 type RelatedResourceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"RelatedResourceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"RelatedResourceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10296,15 +10462,15 @@ func (x *RelatedResourceType) GetValue() string {
 }
 
 func (x *RelatedResourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *RelatedResourceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10312,11 +10478,11 @@ func (x *RelatedResourceType) GetUserDefinedValue() string {
 type Release struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ICPN"
-	ICPN string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
+	ICPN *string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3,oneof" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"ProprietaryReleaseId"
-	ProprietaryReleaseId string `protobuf:"bytes,3,opt,name=proprietary_release_id,json=proprietaryReleaseId,proto3" json:"proprietary_release_id,omitempty" xml:"ProprietaryReleaseId"`
+	ProprietaryReleaseId *string `protobuf:"bytes,3,opt,name=proprietary_release_id,json=proprietaryReleaseId,proto3,oneof" json:"proprietary_release_id,omitempty" xml:"ProprietaryReleaseId"`
 	// @gotags: xml:"ReleaseTitle"
 	ReleaseTitle []*ReleaseTitle `protobuf:"bytes,4,rep,name=release_title,json=releaseTitle,proto3" json:"release_title,omitempty" xml:"ReleaseTitle"`
 	// @gotags: xml:"DisplayArtistName"
@@ -10358,22 +10524,22 @@ func (*Release) Descriptor() ([]byte, []int) {
 }
 
 func (x *Release) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *Release) GetICPN() string {
-	if x != nil {
-		return x.ICPN
+	if x != nil && x.ICPN != nil {
+		return *x.ICPN
 	}
 	return ""
 }
 
 func (x *Release) GetProprietaryReleaseId() string {
-	if x != nil {
-		return x.ProprietaryReleaseId
+	if x != nil && x.ProprietaryReleaseId != nil {
+		return *x.ProprietaryReleaseId
 	}
 	return ""
 }
@@ -10402,11 +10568,11 @@ func (x *Release) GetDisplayArtist() []*PartyDescriptorWithPronunciation {
 type ReleaseId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ICPN"
-	ICPN string `protobuf:"bytes,3,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
+	ICPN *string `protobuf:"bytes,3,opt,name=i_c_p_n,json=iCPN,proto3,oneof" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,4,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -10446,22 +10612,22 @@ func (*ReleaseId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ReleaseId) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *ReleaseId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *ReleaseId) GetICPN() string {
-	if x != nil {
-		return x.ICPN
+	if x != nil && x.ICPN != nil {
+		return *x.ICPN
 	}
 	return ""
 }
@@ -10485,7 +10651,7 @@ type ReleaseTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -10528,8 +10694,8 @@ func (x *ReleaseTitle) GetValue() string {
 }
 
 func (x *ReleaseTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -10537,11 +10703,11 @@ func (x *ReleaseTitle) GetLanguageAndScriptCode() string {
 type Resource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"CatalogNumber"
-	CatalogNumber string `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
+	CatalogNumber *string `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3,oneof" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryResourceId"
-	ProprietaryResourceId string `protobuf:"bytes,3,opt,name=proprietary_resource_id,json=proprietaryResourceId,proto3" json:"proprietary_resource_id,omitempty" xml:"ProprietaryResourceId"`
+	ProprietaryResourceId *string `protobuf:"bytes,3,opt,name=proprietary_resource_id,json=proprietaryResourceId,proto3,oneof" json:"proprietary_resource_id,omitempty" xml:"ProprietaryResourceId"`
 	// @gotags: xml:"ResourceTitle"
 	ResourceTitle []*ResourceTitle `protobuf:"bytes,4,rep,name=resource_title,json=resourceTitle,proto3" json:"resource_title,omitempty" xml:"ResourceTitle"`
 	// @gotags: xml:"DisplayArtistName"
@@ -10583,22 +10749,22 @@ func (*Resource) Descriptor() ([]byte, []int) {
 }
 
 func (x *Resource) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *Resource) GetCatalogNumber() string {
-	if x != nil {
-		return x.CatalogNumber
+	if x != nil && x.CatalogNumber != nil {
+		return *x.CatalogNumber
 	}
 	return ""
 }
 
 func (x *Resource) GetProprietaryResourceId() string {
-	if x != nil {
-		return x.ProprietaryResourceId
+	if x != nil && x.ProprietaryResourceId != nil {
+		return *x.ProprietaryResourceId
 	}
 	return ""
 }
@@ -10626,12 +10792,12 @@ func (x *Resource) GetDisplayArtist() []*PartyDescriptorWithPronunciation {
 
 type ResourceContributorRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ResourceContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ResourceContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10674,15 +10840,15 @@ func (x *ResourceContributorRole) GetValue() string {
 }
 
 func (x *ResourceContributorRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ResourceContributorRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10690,19 +10856,19 @@ func (x *ResourceContributorRole) GetUserDefinedValue() string {
 type ResourceIdWithoutFlag struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,8,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -10742,50 +10908,50 @@ func (*ResourceIdWithoutFlag) Descriptor() ([]byte, []int) {
 }
 
 func (x *ResourceIdWithoutFlag) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -10806,12 +10972,12 @@ func (x *ResourceIdWithoutFlag) GetProprietaryId() []*ProprietaryId {
 
 type ResourceRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ResourceRelationshipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ResourceRelationshipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10854,15 +11020,15 @@ func (x *ResourceRelationshipType) GetValue() string {
 }
 
 func (x *ResourceRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ResourceRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -10872,7 +11038,7 @@ type ResourceTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -10915,20 +11081,20 @@ func (x *ResourceTitle) GetValue() string {
 }
 
 func (x *ResourceTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type SessionType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SessionType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SessionType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -10971,27 +11137,27 @@ func (x *SessionType) GetValue() string {
 }
 
 func (x *SessionType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SessionType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type SubGenreCategoryValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"SubGenre"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"SubGenre"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11034,15 +11200,15 @@ func (x *SubGenreCategoryValue) GetValue() string {
 }
 
 func (x *SubGenreCategoryValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *SubGenreCategoryValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11052,17 +11218,17 @@ type TextWithFormat struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11105,43 +11271,43 @@ func (x *TextWithFormat) GetValue() string {
 }
 
 func (x *TextWithFormat) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithFormat) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11151,17 +11317,17 @@ type TextWithoutTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11204,43 +11370,43 @@ func (x *TextWithoutTerritory) GetValue() string {
 }
 
 func (x *TextWithoutTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithoutTerritory) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11306,13 +11472,13 @@ type TitleWithPronunciation struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle *TitleText `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"TitleType,attr" avs:"TitleType"
+	TitleType *string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"TitleType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11362,29 +11528,29 @@ func (x *TitleWithPronunciation) GetSubTitle() *TitleText {
 }
 
 func (x *TitleWithPronunciation) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TitleWithPronunciation) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
 
 func (x *TitleWithPronunciation) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TitleWithPronunciation) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11392,15 +11558,15 @@ func (x *TitleWithPronunciation) GetUserDefinedValue() string {
 type Venue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"VenueName"
-	VenueName string `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3" json:"venue_name,omitempty" xml:"VenueName"`
+	VenueName *string `protobuf:"bytes,1,opt,name=venue_name,json=venueName,proto3,oneof" json:"venue_name,omitempty" xml:"VenueName"`
 	// @gotags: xml:"VenueAddress"
-	VenueAddress string `protobuf:"bytes,2,opt,name=venue_address,json=venueAddress,proto3" json:"venue_address,omitempty" xml:"VenueAddress"`
+	VenueAddress *string `protobuf:"bytes,2,opt,name=venue_address,json=venueAddress,proto3,oneof" json:"venue_address,omitempty" xml:"VenueAddress"`
 	// @gotags: xml:"TerritoryCode"
 	TerritoryCode *AllTerritoryCode `protobuf:"bytes,3,opt,name=territory_code,json=territoryCode,proto3" json:"territory_code,omitempty" xml:"TerritoryCode"`
 	// @gotags: xml:"LocationCode"
-	LocationCode string `protobuf:"bytes,4,opt,name=location_code,json=locationCode,proto3" json:"location_code,omitempty" xml:"LocationCode"`
+	LocationCode *string `protobuf:"bytes,4,opt,name=location_code,json=locationCode,proto3,oneof" json:"location_code,omitempty" xml:"LocationCode"`
 	// @gotags: xml:"VenueRoom"
-	VenueRoom     string `protobuf:"bytes,5,opt,name=venue_room,json=venueRoom,proto3" json:"venue_room,omitempty" xml:"VenueRoom"`
+	VenueRoom     *string `protobuf:"bytes,5,opt,name=venue_room,json=venueRoom,proto3,oneof" json:"venue_room,omitempty" xml:"VenueRoom"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -11436,15 +11602,15 @@ func (*Venue) Descriptor() ([]byte, []int) {
 }
 
 func (x *Venue) GetVenueName() string {
-	if x != nil {
-		return x.VenueName
+	if x != nil && x.VenueName != nil {
+		return *x.VenueName
 	}
 	return ""
 }
 
 func (x *Venue) GetVenueAddress() string {
-	if x != nil {
-		return x.VenueAddress
+	if x != nil && x.VenueAddress != nil {
+		return *x.VenueAddress
 	}
 	return ""
 }
@@ -11457,15 +11623,15 @@ func (x *Venue) GetTerritoryCode() *AllTerritoryCode {
 }
 
 func (x *Venue) GetLocationCode() string {
-	if x != nil {
-		return x.LocationCode
+	if x != nil && x.LocationCode != nil {
+		return *x.LocationCode
 	}
 	return ""
 }
 
 func (x *Venue) GetVenueRoom() string {
-	if x != nil {
-		return x.VenueRoom
+	if x != nil && x.VenueRoom != nil {
+		return *x.VenueRoom
 	}
 	return ""
 }
@@ -11526,12 +11692,12 @@ func (x *VocalRegister) GetValue() *VocalRegisterValue {
 
 type VocalRegisterValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VocalRegister"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VocalRegister"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11574,15 +11740,15 @@ func (x *VocalRegisterValue) GetValue() string {
 }
 
 func (x *VocalRegisterValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VocalRegisterValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11590,9 +11756,9 @@ func (x *VocalRegisterValue) GetUserDefinedValue() string {
 type Work struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"ProprietaryWorkId"
-	ProprietaryWorkId string `protobuf:"bytes,2,opt,name=proprietary_work_id,json=proprietaryWorkId,proto3" json:"proprietary_work_id,omitempty" xml:"ProprietaryWorkId"`
+	ProprietaryWorkId *string `protobuf:"bytes,2,opt,name=proprietary_work_id,json=proprietaryWorkId,proto3,oneof" json:"proprietary_work_id,omitempty" xml:"ProprietaryWorkId"`
 	// @gotags: xml:"WorkTitle"
 	WorkTitle []*WorkTitle `protobuf:"bytes,3,rep,name=work_title,json=workTitle,proto3" json:"work_title,omitempty" xml:"WorkTitle"`
 	// @gotags: xml:"Writer"
@@ -11632,15 +11798,15 @@ func (*Work) Descriptor() ([]byte, []int) {
 }
 
 func (x *Work) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *Work) GetProprietaryWorkId() string {
-	if x != nil {
-		return x.ProprietaryWorkId
+	if x != nil && x.ProprietaryWorkId != nil {
+		return *x.ProprietaryWorkId
 	}
 	return ""
 }
@@ -11661,12 +11827,12 @@ func (x *Work) GetWriter() []*PartyDescriptorWithPronunciation {
 
 type WorkRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"WorkRelationshipType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"WorkRelationshipType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -11709,15 +11875,15 @@ func (x *WorkRelationshipType) GetValue() string {
 }
 
 func (x *WorkRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *WorkRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -11727,7 +11893,7 @@ type WorkTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11770,8 +11936,8 @@ func (x *WorkTitle) GetValue() string {
 }
 
 func (x *WorkTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -11781,13 +11947,13 @@ type EventDateWithoutFlags struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,4,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -11830,29 +11996,29 @@ func (x *EventDateWithoutFlags) GetValue() string {
 }
 
 func (x *EventDateWithoutFlags) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDateWithoutFlags) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDateWithoutFlags) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDateWithoutFlags) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -11860,9 +12026,9 @@ func (x *EventDateWithoutFlags) GetLanguageAndScriptCode() string {
 type Timing struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"StartPoint"
-	StartPoint string `protobuf:"bytes,1,opt,name=start_point,json=startPoint,proto3" json:"start_point,omitempty" xml:"StartPoint"`
+	StartPoint *string `protobuf:"bytes,1,opt,name=start_point,json=startPoint,proto3,oneof" json:"start_point,omitempty" xml:"StartPoint"`
 	// @gotags: xml:"DurationUsed"
-	DurationUsed  string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3" json:"duration_used,omitempty" xml:"DurationUsed"`
+	DurationUsed  *string `protobuf:"bytes,2,opt,name=duration_used,json=durationUsed,proto3,oneof" json:"duration_used,omitempty" xml:"DurationUsed"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -11898,15 +12064,60 @@ func (*Timing) Descriptor() ([]byte, []int) {
 }
 
 func (x *Timing) GetStartPoint() string {
-	if x != nil {
-		return x.StartPoint
+	if x != nil && x.StartPoint != nil {
+		return *x.StartPoint
 	}
 	return ""
 }
 
 func (x *Timing) GetDurationUsed() string {
+	if x != nil && x.DurationUsed != nil {
+		return *x.DurationUsed
+	}
+	return ""
+}
+
+type AnyElement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"-"
+	RawXml        string `protobuf:"bytes,1,opt,name=raw_xml,json=rawXml,proto3" json:"raw_xml,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnyElement) Reset() {
+	*x = AnyElement{}
+	mi := &file_ddex_mead_v11_v11_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnyElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyElement) ProtoMessage() {}
+
+func (x *AnyElement) ProtoReflect() protoreflect.Message {
+	mi := &file_ddex_mead_v11_v11_proto_msgTypes[152]
 	if x != nil {
-		return x.DurationUsed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyElement.ProtoReflect.Descriptor instead.
+func (*AnyElement) Descriptor() ([]byte, []int) {
+	return file_ddex_mead_v11_v11_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *AnyElement) GetRawXml() string {
+	if x != nil {
+		return x.RawXml
 	}
 	return ""
 }
@@ -11915,21 +12126,25 @@ var File_ddex_mead_v11_v11_proto protoreflect.FileDescriptor
 
 const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\n" +
-	"\x17ddex/mead/v11/v11.proto\x12\rddex.mead.v11\x1a\x1eddex/avs/vlatest/vlatest.proto\"\xb8\x05\n" +
+	"\x17ddex/mead/v11/v11.proto\x12\rddex.mead.v11\x1a\x1eddex/avs/vlatest/vlatest.proto\"\x9c\x06\n" +
 	"\vMeadMessage\x12C\n" +
-	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.mead.v11.MessageHeaderR\rmessageHeader\x12'\n" +
-	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12S\n" +
+	"\x0emessage_header\x18\x01 \x01(\v2\x1c.ddex.mead.v11.MessageHeaderR\rmessageHeader\x12,\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tH\x00R\x0esubscriptionId\x88\x01\x01\x12S\n" +
 	"\x14metadata_source_list\x18\x03 \x01(\v2!.ddex.mead.v11.MetadataSourceListR\x12metadataSourceList\x12V\n" +
 	"\x15work_information_list\x18\x04 \x01(\v2\".ddex.mead.v11.WorkInformationListR\x13workInformationList\x12b\n" +
 	"\x19resource_information_list\x18\x05 \x01(\v2&.ddex.mead.v11.ResourceInformationListR\x17resourceInformationList\x12_\n" +
 	"\x18release_information_list\x18\x06 \x01(\v2%.ddex.mead.v11.ReleaseInformationListR\x16releaseInformationList\x12$\n" +
-	"\x0eavs_version_id\x18\a \x01(\tR\favsVersionId\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\x0eavs_version_id\x18\a \x01(\tR\favsVersionId\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01\x12\x1d\n" +
 	"\n" +
 	"xmlns_mead\x18\t \x01(\tR\txmlnsMead\x12\x1b\n" +
 	"\txmlns_xsi\x18\n" +
 	" \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\v \x01(\tR\x11xsiSchemaLocation\"\xcd\x05\n" +
+	"\x13xsi_schema_location\x18\v \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\f \x01(\tR\n" +
+	"rootPrefixB\x12\n" +
+	"\x10_subscription_idB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb2\x06\n" +
 	"\x04Feed\x12-\n" +
 	"\x06author\x18\x01 \x03(\v2\x15.ddex.mead.v11.PersonR\x06author\x123\n" +
 	"\bcategory\x18\x02 \x03(\v2\x17.ddex.mead.v11.CategoryR\bcategory\x127\n" +
@@ -11948,74 +12163,90 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\n" +
 	"xmlns_mead\x18\x0e \x01(\tR\txmlnsMead\x12\x1b\n" +
 	"\txmlns_xsi\x18\x0f \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\"\xc4\x01\n" +
+	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\x12:\n" +
+	"\vany_element\x18\x11 \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElement\x12\x1f\n" +
+	"\vroot_prefix\x18\x12 \x01(\tR\n" +
+	"rootPrefixJ\x06\b\xa8F\x10\x90N\"\xcc\x01\n" +
 	"\rAbsolutePitch\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\x129\n" +
 	"\n" +
 	"modulation\x18\x03 \x03(\v2\x19.ddex.mead.v11.ModulationR\n" +
-	"modulation\"\xfa\x02\n" +
+	"modulationJ\x06\b\xa8F\x10\x90N\"\x82\x03\n" +
 	"\bActivity\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x122\n" +
 	"\x05value\x18\x02 \x01(\v2\x1c.ddex.mead.v11.ActivityValueR\x05value\x12E\n" +
 	"\vdescription\x18\x03 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\vdescription\x12D\n" +
 	"\x1flanguage_and_script_of_activity\x18\x04 \x03(\tR\x1blanguageAndScriptOfActivity\x12I\n" +
-	"!territory_of_activity_description\x18\x05 \x03(\tR\x1eterritoryOfActivityDescription\"q\n" +
+	"!territory_of_activity_description\x18\x05 \x03(\tR\x1eterritoryOfActivityDescriptionJ\x06\b\xa8F\x10\x90N\"\xa8\x01\n" +
 	"\rActivityValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x91\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xdc\x03\n" +
 	"\x10AlternativeTitle\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x127\n" +
 	"\n" +
 	"title_text\x18\x02 \x01(\v2\x18.ddex.mead.v11.TitleTextR\ttitleText\x125\n" +
 	"\tsub_title\x18\x03 \x01(\v2\x18.ddex.mead.v11.TitleTextR\bsubTitle\x12>\n" +
-	"\x1clanguage_and_script_of_title\x18\x04 \x03(\tR\x18languageAndScriptOfTitle\x12\x1d\n" +
+	"\x1clanguage_and_script_of_title\x18\x04 \x03(\tR\x18languageAndScriptOfTitle\x12\"\n" +
+	"\n" +
+	"title_type\x18\x05 \x01(\tH\x00R\ttitleType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\r\n" +
+	"\v_title_typeB\f\n" +
 	"\n" +
-	"title_type\x18\x05 \x01(\tR\ttitleType\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xa3\x01\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xab\x01\n" +
 	"\n" +
 	"Annotation\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x121\n" +
-	"\x04text\x18\x02 \x01(\v2\x1d.ddex.mead.v11.TextWithFormatR\x04text\"\xff\x01\n" +
+	"\x04text\x18\x02 \x01(\v2\x1d.ddex.mead.v11.TextWithFormatR\x04textJ\x06\b\xa8F\x10\x90N\"\x87\x02\n" +
 	"\rArtisticStyle\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x124\n" +
 	"\x05value\x18\x02 \x01(\v2\x1e.ddex.mead.v11.ArtistTypeValueR\x05value\x12T\n" +
-	"'territory_of_artistic_style_description\x18\x03 \x03(\tR#territoryOfArtisticStyleDescription\"\xc5\x01\n" +
+	"'territory_of_artistic_style_description\x18\x03 \x03(\tR#territoryOfArtisticStyleDescriptionJ\x06\b\xa8F\x10\x90N\"\xcd\x01\n" +
 	"\x0eBeatsPerMinute\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\x129\n" +
 	"\n" +
 	"modulation\x18\x03 \x03(\v2\x19.ddex.mead.v11.ModulationR\n" +
-	"modulation\"\xed\x02\n" +
+	"modulationJ\x06\b\xa8F\x10\x90N\"\xa3\x03\n" +
 	"\x12ChildWorkHierarchy\x120\n" +
-	"\x14is_described_element\x18\x01 \x01(\bR\x12isDescribedElement\x12\x1f\n" +
-	"\vis_complete\x18\x02 \x01(\bR\n" +
-	"isComplete\x12'\n" +
-	"\x0fsequence_number\x18\x03 \x01(\x05R\x0esequenceNumber\x12@\n" +
+	"\x14is_described_element\x18\x01 \x01(\bR\x12isDescribedElement\x12$\n" +
+	"\vis_complete\x18\x02 \x01(\bH\x00R\n" +
+	"isComplete\x88\x01\x01\x12,\n" +
+	"\x0fsequence_number\x18\x03 \x01(\x05H\x01R\x0esequenceNumber\x88\x01\x01\x12@\n" +
 	"\awork_id\x18\x04 \x01(\v2'.ddex.mead.v11.MusicalWorkIdWithoutFlagR\x06workId\x127\n" +
 	"\n" +
 	"work_title\x18\x05 \x03(\v2\x18.ddex.mead.v11.WorkTitleR\tworkTitle\x127\n" +
 	"\x05child\x18\x06 \x03(\v2!.ddex.mead.v11.ChildWorkHierarchyR\x05child\x12'\n" +
-	"\x04form\x18\a \x01(\v2\x13.ddex.mead.v11.FormR\x04form\"\xc8\x01\n" +
+	"\x04form\x18\a \x01(\v2\x13.ddex.mead.v11.FormR\x04formB\x0e\n" +
+	"\f_is_completeB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xd0\x01\n" +
 	"\vContributor\x12>\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\v2\x1e.ddex.mead.v11.DetailedPartyIdR\n" +
 	"identifier\x12=\n" +
 	"\x04name\x18\x02 \x01(\v2).ddex.mead.v11.PartyNameWithPronunciationR\x04name\x12:\n" +
-	"\x04role\x18\x03 \x03(\v2&.ddex.mead.v11.ResourceContributorRoleR\x04role\"\x88\x03\n" +
+	"\x04role\x18\x03 \x03(\v2&.ddex.mead.v11.ResourceContributorRoleR\x04roleJ\x06\b\xa8F\x10\x90N\"\x90\x03\n" +
 	"\n" +
 	"DanceStyle\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x124\n" +
 	"\x05value\x18\x02 \x01(\v2\x1e.ddex.mead.v11.DanceStyleValueR\x05value\x12E\n" +
 	"\vdescription\x18\x03 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\vdescription\x12I\n" +
 	"\"language_and_script_of_dance_style\x18\x04 \x03(\tR\x1dlanguageAndScriptOfDanceStyle\x12N\n" +
-	"$territory_of_dance_style_description\x18\x05 \x03(\tR territoryOfDanceStyleDescription\"s\n" +
+	"$territory_of_dance_style_description\x18\x05 \x03(\tR territoryOfDanceStyleDescriptionJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fDanceStyleValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8d\x04\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x95\x04\n" +
 	"\x10DerivedRecording\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12E\n" +
 	"\vresource_id\x18\x02 \x01(\v2$.ddex.mead.v11.ResourceIdWithoutFlagR\n" +
@@ -12023,23 +12254,32 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\x15related_resource_type\x18\x03 \x01(\v2\".ddex.mead.v11.RelatedResourceTypeR\x13relatedResourceType\x12;\n" +
 	"\x05title\x18\x04 \x03(\v2%.ddex.mead.v11.TitleWithPronunciationR\x05title\x12a\n" +
 	"\x13display_artist_name\x18\x05 \x01(\v21.ddex.mead.v11.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12V\n" +
-	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtist\"\xb9\x02\n" +
+	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtistJ\x06\b\xa8F\x10\x90N\"\xc0\x03\n" +
 	"\x0fDisplaySubTitle\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12B\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciation\x12'\n" +
-	"\x0fsequence_number\x18\x03 \x01(\x05R\x0esequenceNumber\x121\n" +
-	"\x15is_displayed_in_title\x18\x04 \x01(\bR\x12isDisplayedInTitle\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\x12$\n" +
-	"\x0esub_title_type\x18\a \x01(\tR\fsubTitleType\"\x98\x02\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciation\x12,\n" +
+	"\x0fsequence_number\x18\x03 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x126\n" +
+	"\x15is_displayed_in_title\x18\x04 \x01(\bH\x01R\x12isDisplayedInTitle\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01\x12)\n" +
+	"\x0esub_title_type\x18\a \x01(\tH\x04R\fsubTitleType\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x18\n" +
+	"\x16_is_displayed_in_titleB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x11\n" +
+	"\x0f_sub_title_typeJ\x06\b\xa8F\x10\x90N\"\xf9\x02\n" +
 	"\fDisplayTitle\x127\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\v2\x18.ddex.mead.v11.TitleTextR\ttitleText\x12;\n" +
-	"\tsub_title\x18\x02 \x03(\v2\x1e.ddex.mead.v11.DisplaySubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x03(\v2\x1e.ddex.mead.v11.DisplaySubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\"\x91\x05\n" +
+	"is_default\x18\x05 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xd5\x06\n" +
 	"\x05Entry\x12-\n" +
 	"\x06author\x18\x01 \x03(\v2\x15.ddex.mead.v11.PersonR\x06author\x123\n" +
 	"\bcategory\x18\x02 \x03(\v2\x17.ddex.mead.v11.CategoryR\bcategory\x120\n" +
@@ -12054,23 +12294,30 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	" \x01(\v2\x13.ddex.mead.v11.TextR\asummary\x12)\n" +
 	"\x05title\x18\v \x01(\v2\x13.ddex.mead.v11.TextR\x05title\x121\n" +
 	"\aupdated\x18\f \x01(\v2\x17.ddex.mead.v11.DateTimeR\aupdated\x12'\n" +
-	"\x0fsubscription_id\x18\r \x01(\tR\x0esubscriptionId\x12$\n" +
-	"\x0eavs_version_id\x18\x0e \x01(\tR\favsVersionId\"\x80\x01\n" +
+	"\x0fsubscription_id\x18\r \x01(\tR\x0esubscriptionId\x12'\n" +
+	"\x04work\x18\x0e \x01(\v2\x13.ddex.mead.v11.WorkR\x04work\x123\n" +
+	"\bresource\x18\x0f \x01(\v2\x17.ddex.mead.v11.ResourceR\bresource\x120\n" +
+	"\arelease\x18\x10 \x01(\v2\x16.ddex.mead.v11.ReleaseR\arelease\x12*\n" +
+	"\x05party\x18\x11 \x01(\v2\x14.ddex.mead.v11.PartyR\x05party\x12$\n" +
+	"\x0eavs_version_id\x18\x12 \x01(\tR\favsVersionIdJ\x06\b\xa8F\x10\x90N\"\x88\x01\n" +
 	"\x04Flag\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\bR\x05value\"\x9a\x01\n" +
+	"\x05value\x18\x02 \x01(\bR\x05valueJ\x06\b\xa8F\x10\x90N\"\xa2\x01\n" +
 	"\x04Form\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12.\n" +
-	"\x05value\x18\x02 \x01(\v2\x18.ddex.mead.v11.FormValueR\x05value\"m\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.ddex.mead.v11.FormValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tFormValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xc9\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd1\x02\n" +
 	"\rGenreCategory\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12T\n" +
 	"'territory_of_genre_category_description\x18\x02 \x03(\tR#territoryOfGenreCategoryDescription\x127\n" +
 	"\x05value\x18\x03 \x01(\v2!.ddex.mead.v11.GenreCategoryValueR\x05value\x12E\n" +
-	"\vdescription\x18\x04 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\vdescription\"\xed\x02\n" +
+	"\vdescription\x18\x04 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\vdescriptionJ\x06\b\xa8F\x10\x90N\"\xf5\x02\n" +
 	"\aHarmony\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12D\n" +
 	"\x0froot_chord_note\x18\x02 \x01(\v2\x1c.ddex.mead.v11.RootChordNoteR\rrootChordNote\x12M\n" +
@@ -12078,121 +12325,166 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\x04mode\x18\x04 \x01(\v2\x13.ddex.mead.v11.ModeR\x04mode\x12@\n" +
 	"\n" +
 	"modulation\x18\x05 \x03(\v2 .ddex.mead.v11.HarmonyModulationR\n" +
-	"modulation\"\xc5\x02\n" +
-	"\x11HarmonyModulation\x12D\n" +
+	"modulationJ\x06\b\xa8F\x10\x90N\"\xf1\x02\n" +
+	"\x11HarmonyModulation\x12\x1f\n" +
+	"\vstart_point\x18\x04 \x01(\tR\n" +
+	"startPoint\x12 \n" +
+	"\tend_point\x18\x05 \x01(\tH\x00R\bendPoint\x88\x01\x01\x12\x1b\n" +
+	"\tstart_bar\x18\x06 \x01(\x05R\bstartBar\x12\x1c\n" +
+	"\aend_bar\x18\a \x01(\x05H\x01R\x06endBar\x88\x01\x01\x12D\n" +
 	"\x0froot_chord_note\x18\x01 \x01(\v2\x1c.ddex.mead.v11.RootChordNoteR\rrootChordNote\x12M\n" +
 	"\x12root_chord_quality\x18\x02 \x01(\v2\x1f.ddex.mead.v11.RootChordQualityR\x10rootChordQuality\x12'\n" +
-	"\x04mode\x18\x03 \x01(\v2\x13.ddex.mead.v11.ModeR\x04mode\x12\x1f\n" +
-	"\vstart_point\x18\x04 \x01(\tR\n" +
-	"startPoint\x12\x1b\n" +
-	"\tend_point\x18\x05 \x01(\tR\bendPoint\x12\x1b\n" +
-	"\tstart_bar\x18\x06 \x01(\x05R\bstartBar\x12\x17\n" +
-	"\aend_bar\x18\a \x01(\x05R\x06endBar\"l\n" +
+	"\x04mode\x18\x03 \x01(\v2\x13.ddex.mead.v11.ModeR\x04modeB\f\n" +
+	"\n" +
+	"_end_pointB\n" +
+	"\n" +
+	"\b_end_barJ\x06\b\xa8F\x10\x90N\"t\n" +
 	"\n" +
 	"ImpactDate\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12J\n" +
-	"\x0eterritory_code\x18\x02 \x03(\v2#.ddex.mead.v11.CurrentTerritoryCodeR\rterritoryCode\"\xa6\x01\n" +
+	"\x0eterritory_code\x18\x02 \x03(\v2#.ddex.mead.v11.CurrentTerritoryCodeR\rterritoryCodeJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\n" +
 	"Instrument\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x124\n" +
-	"\x05value\x18\x02 \x01(\v2\x1e.ddex.mead.v11.InstrumentValueR\x05value\"\xcb\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.ddex.mead.v11.InstrumentValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xe8\x01\n" +
 	"\x0eInstrumentUsed\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x124\n" +
-	"\x05value\x18\x02 \x01(\v2\x1e.ddex.mead.v11.InstrumentValueR\x05value\x12\x1f\n" +
-	"\vis_featured\x18\x03 \x01(\bR\n" +
-	"isFeatured\"s\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.ddex.mead.v11.InstrumentValueR\x05value\x12$\n" +
+	"\vis_featured\x18\x03 \x01(\bH\x00R\n" +
+	"isFeatured\x88\x01\x01B\x0e\n" +
+	"\f_is_featuredJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fInstrumentValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xa4\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\tIntensity\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x123\n" +
-	"\x05value\x18\x02 \x01(\v2\x1d.ddex.mead.v11.IntensityValueR\x05value\"r\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.ddex.mead.v11.IntensityValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0eIntensityValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x8f\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x97\x03\n" +
 	"\x18LocationAndDateOfSession\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12=\n" +
 	"\fsession_type\x18\x02 \x03(\v2\x1a.ddex.mead.v11.SessionTypeR\vsessionType\x12-\n" +
 	"\x06period\x18\x03 \x01(\v2\x15.ddex.mead.v11.PeriodR\x06period\x12*\n" +
 	"\x05venue\x18\x04 \x03(\v2\x14.ddex.mead.v11.VenueR\x05venue\x127\n" +
 	"\acomment\x18\x05 \x01(\v2\x1d.ddex.mead.v11.TextWithFormatR\acomment\x12<\n" +
-	"\vcontributor\x18\x06 \x03(\v2\x1a.ddex.mead.v11.ContributorR\vcontributor\"\xcd\x04\n" +
+	"\vcontributor\x18\x06 \x03(\v2\x1a.ddex.mead.v11.ContributorR\vcontributorJ\x06\b\xa8F\x10\x90N\"\xe0\x05\n" +
 	"\x06Lyrics\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12-\n" +
 	"\x04text\x18\x02 \x01(\v2\x19.ddex.mead.v11.LyricsTextR\x04text\x12@\n" +
 	"\x1dlanguage_and_script_of_lyrics\x18\x03 \x03(\tR\x19languageAndScriptOfLyrics\x12E\n" +
 	"\x1fterritory_of_lyrics_description\x18\x04 \x03(\tR\x1cterritoryOfLyricsDescription\x12B\n" +
-	"\rpronunciation\x18\x05 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciation\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\x12\x1f\n" +
-	"\vlyrics_type\x18\a \x01(\tR\n" +
-	"lyricsType\x122\n" +
-	"\x15lyrics_type_namespace\x18\b \x01(\tR\x13lyricsTypeNamespace\x12B\n" +
-	"\x1elyrics_type_user_defined_value\x18\t \x01(\tR\x1alyricsTypeUserDefinedValue\x12!\n" +
+	"\rpronunciation\x18\x05 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciation\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12$\n" +
+	"\vlyrics_type\x18\a \x01(\tH\x01R\n" +
+	"lyricsType\x88\x01\x01\x127\n" +
+	"\x15lyrics_type_namespace\x18\b \x01(\tH\x02R\x13lyricsTypeNamespace\x88\x01\x01\x12G\n" +
+	"\x1elyrics_type_user_defined_value\x18\t \x01(\tH\x03R\x1alyricsTypeUserDefinedValue\x88\x01\x01\x12&\n" +
 	"\fis_misquoted\x18\n" +
-	" \x01(\bR\visMisquoted\"\xc1\x01\n" +
+	" \x01(\bH\x04R\visMisquoted\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x0e\n" +
+	"\f_lyrics_typeB\x18\n" +
+	"\x16_lyrics_type_namespaceB!\n" +
+	"\x1f_lyrics_type_user_defined_valueB\x0f\n" +
+	"\r_is_misquotedJ\x06\b\xa8F\x10\x90N\"\x9b\x02\n" +
 	"\n" +
 	"LyricsText\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x16\n" +
-	"\x06format\x18\x02 \x01(\tR\x06format\x12)\n" +
-	"\x10format_namespace\x18\x03 \x01(\tR\x0fformatNamespace\x129\n" +
-	"\x19format_user_defined_value\x18\x04 \x01(\tR\x16formatUserDefinedValue\x12\x1f\n" +
-	"\vis_complete\x18\x05 \x01(\bR\n" +
-	"isComplete\"r\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12.\n" +
+	"\x10format_namespace\x18\x03 \x01(\tH\x00R\x0fformatNamespace\x88\x01\x01\x12>\n" +
+	"\x19format_user_defined_value\x18\x04 \x01(\tH\x01R\x16formatUserDefinedValue\x88\x01\x01\x12$\n" +
+	"\vis_complete\x18\x05 \x01(\bH\x02R\n" +
+	"isComplete\x88\x01\x01B\x13\n" +
+	"\x11_format_namespaceB\x1c\n" +
+	"\x1a_format_user_defined_valueB\x0e\n" +
+	"\f_is_completeJ\x06\b\xa8F\x10\x90N\"z\n" +
 	"\x05Meter\x122\n" +
 	"\x16number_of_beats_in_bar\x18\x01 \x01(\x05R\x12numberOfBeatsInBar\x125\n" +
-	"\x17note_equivalent_to_beat\x18\x02 \x01(\x05R\x14noteEquivalentToBeat\"h\n" +
+	"\x17note_equivalent_to_beat\x18\x02 \x01(\x05R\x14noteEquivalentToBeatJ\x06\b\xa8F\x10\x90N\"\x9f\x01\n" +
 	"\x04Mode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x96\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc2\x01\n" +
 	"\n" +
-	"Modulation\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1f\n" +
+	"Modulation\x12\x1f\n" +
 	"\vstart_point\x18\x02 \x01(\tR\n" +
-	"startPoint\x12\x1b\n" +
-	"\tend_point\x18\x03 \x01(\tR\bendPoint\x12\x1b\n" +
-	"\tstart_bar\x18\x04 \x01(\x05R\bstartBar\x12\x17\n" +
-	"\aend_bar\x18\x05 \x01(\x05R\x06endBar\"\xff\x02\n" +
+	"startPoint\x12 \n" +
+	"\tend_point\x18\x03 \x01(\tH\x00R\bendPoint\x88\x01\x01\x12\x1b\n" +
+	"\tstart_bar\x18\x04 \x01(\x05R\bstartBar\x12\x1c\n" +
+	"\aend_bar\x18\x05 \x01(\x05H\x01R\x06endBar\x88\x01\x01\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueB\f\n" +
+	"\n" +
+	"_end_pointB\n" +
+	"\n" +
+	"\b_end_barJ\x06\b\xa8F\x10\x90N\"\x9a\x03\n" +
 	"\x04Mood\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12.\n" +
 	"\x05value\x18\x02 \x01(\v2\x18.ddex.mead.v11.MoodValueR\x05value\x12E\n" +
 	"\vdescription\x18\x03 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\vdescription\x12<\n" +
 	"\x1blanguage_and_script_of_mood\x18\x04 \x03(\tR\x17languageAndScriptOfMood\x12A\n" +
-	"\x1dterritory_of_mood_description\x18\x05 \x03(\tR\x1aterritoryOfMoodDescription\x12\x1b\n" +
-	"\tmood_type\x18\x06 \x01(\tR\bmoodType\"\xcf\x01\n" +
+	"\x1dterritory_of_mood_description\x18\x05 \x03(\tR\x1aterritoryOfMoodDescription\x12 \n" +
+	"\tmood_type\x18\x06 \x01(\tH\x00R\bmoodType\x88\x01\x01B\f\n" +
+	"\n" +
+	"_mood_typeJ\x06\b\xa8F\x10\x90N\"\xc1\x02\n" +
 	"\tMoodValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\x124\n" +
-	"\x16applies_to_composition\x18\x04 \x01(\bR\x14appliesToComposition\x12*\n" +
-	"\x11applies_to_lyrics\x18\x05 \x01(\bR\x0fappliesToLyrics\"\xd5\x01\n" +
-	"\x05Party\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12C\n" +
-	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryId\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01\x129\n" +
+	"\x16applies_to_composition\x18\x04 \x01(\bH\x02R\x14appliesToComposition\x88\x01\x01\x12/\n" +
+	"\x11applies_to_lyrics\x18\x05 \x01(\bH\x03R\x0fappliesToLyrics\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x19\n" +
+	"\x17_applies_to_compositionB\x14\n" +
+	"\x12_applies_to_lyricsJ\x06\b\xa8F\x10\x90N\"\xbb\x02\n" +
+	"\x05Party\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12C\n" +
+	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryId\x12\"\n" +
+	"\n" +
+	"party_name\x18\x06 \x01(\tH\x04R\tpartyName\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
 	"\n" +
-	"party_name\x18\x06 \x01(\tR\tpartyName\"\xc1\x03\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nB\r\n" +
+	"\v_party_nameJ\x06\b\xa8F\x10\x90N\"\x8a\x04\n" +
 	"\rRecordingPart\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12\x12\n" +
-	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x1f\n" +
-	"\vstart_point\x18\x03 \x01(\tR\n" +
-	"startPoint\x12\x1b\n" +
-	"\tend_point\x18\x04 \x01(\tR\bendPoint\x12P\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12$\n" +
+	"\vstart_point\x18\x03 \x01(\tH\x00R\n" +
+	"startPoint\x88\x01\x01\x12 \n" +
+	"\tend_point\x18\x04 \x01(\tH\x01R\bendPoint\x88\x01\x01\x12P\n" +
 	"\x13recording_part_type\x18\x05 \x01(\v2 .ddex.mead.v11.RecordingPartTypeR\x11recordingPartType\x123\n" +
 	"\acomment\x18\x06 \x03(\v2\x19.ddex.mead.v11.AnnotationR\acomment\x12J\n" +
-	"\x11usage_information\x18\a \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\x10usageInformation\x12'\n" +
-	"\x0fsequence_number\x18\b \x01(\x05R\x0esequenceNumber\"\x90\x03\n" +
+	"\x11usage_information\x18\a \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\x10usageInformation\x12,\n" +
+	"\x0fsequence_number\x18\b \x01(\x05H\x02R\x0esequenceNumber\x88\x01\x01B\x0e\n" +
+	"\f_start_pointB\f\n" +
+	"\n" +
+	"_end_pointB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x98\x03\n" +
 	"\vRelatedWork\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12@\n" +
 	"\awork_id\x18\x02 \x01(\v2'.ddex.mead.v11.MusicalWorkIdWithoutFlagR\x06workId\x127\n" +
 	"\n" +
 	"work_title\x18\x03 \x03(\v2\x18.ddex.mead.v11.WorkTitleR\tworkTitle\x12Y\n" +
 	"\x16work_relationship_type\x18\x04 \x01(\v2#.ddex.mead.v11.WorkRelationshipTypeR\x14workRelationshipType\x12G\n" +
-	"\x06writer\x18\x05 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writer\"\xb6\t\n" +
+	"\x06writer\x18\x05 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writerJ\x06\b\xa8F\x10\x90N\"\xe3\n" +
+	"\n" +
 	"\x12ReleaseInformation\x12F\n" +
 	"\x0frelease_summary\x18\x01 \x01(\v2\x1d.ddex.mead.v11.ReleaseSummaryR\x0ereleaseSummary\x12C\n" +
 	"\x0egenre_category\x18\x02 \x03(\v2\x1c.ddex.mead.v11.GenreCategoryR\rgenreCategory\x12M\n" +
@@ -12211,22 +12503,27 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\x1dhistoric_charting_information\x18\r \x03(\v2*.ddex.mead.v11.HistoricChartingInformationR\x1bhistoricChartingInformation\x12*\n" +
 	"\x05award\x18\x0e \x03(\v2\x14.ddex.mead.v11.AwardR\x05award\x12L\n" +
 	"\x11alternative_title\x18\x0f \x03(\v2\x1f.ddex.mead.v11.AlternativeTitleR\x10alternativeTitle\x12*\n" +
-	"\x05image\x18\x10 \x03(\v2\x14.ddex.mead.v11.ImageR\x05image\x12;\n" +
-	"\x1apriority_period_start_date\x18\x11 \x01(\tR\x17priorityPeriodStartDate\x127\n" +
-	"\x18priority_period_end_date\x18\x12 \x01(\tR\x15priorityPeriodEndDate\x12:\n" +
-	"\x19applicable_territory_code\x18\x13 \x01(\tR\x17applicableTerritoryCode\"l\n" +
+	"\x05image\x18\x10 \x03(\v2\x14.ddex.mead.v11.ImageR\x05image\x12@\n" +
+	"\x1apriority_period_start_date\x18\x11 \x01(\tH\x00R\x17priorityPeriodStartDate\x88\x01\x01\x12<\n" +
+	"\x18priority_period_end_date\x18\x12 \x01(\tH\x01R\x15priorityPeriodEndDate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x13 \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12:\n" +
+	"\vany_element\x18\x14 \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElementB\x1d\n" +
+	"\x1b_priority_period_start_dateB\x1b\n" +
+	"\x19_priority_period_end_dateB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"t\n" +
 	"\x16ReleaseInformationList\x12R\n" +
-	"\x13release_information\x18\x01 \x03(\v2!.ddex.mead.v11.ReleaseInformationR\x12releaseInformation\"\xc6\x02\n" +
+	"\x13release_information\x18\x01 \x03(\v2!.ddex.mead.v11.ReleaseInformationR\x12releaseInformationJ\x06\b\xa8F\x10\x90N\"\xce\x02\n" +
 	"\x0eReleaseSummary\x127\n" +
 	"\n" +
 	"release_id\x18\x01 \x01(\v2\x18.ddex.mead.v11.ReleaseIdR\treleaseId\x12@\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1b.ddex.mead.v11.DisplayTitleR\fdisplayTitle\x12a\n" +
 	"\x13display_artist_name\x18\x03 \x03(\v21.ddex.mead.v11.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12V\n" +
-	"\x0edisplay_artist\x18\x04 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtist\"\xc0\x01\n" +
+	"\x0edisplay_artist\x18\x04 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtistJ\x06\b\xa8F\x10\x90N\"\xc8\x01\n" +
 	"\x10RelevantResource\x12E\n" +
 	"\vresource_id\x18\x01 \x01(\v2$.ddex.mead.v11.ResourceIdWithoutFlagR\n" +
 	"resourceId\x12e\n" +
-	"\x1aresource_relationship_type\x18\x02 \x01(\v2'.ddex.mead.v11.ResourceRelationshipTypeR\x18resourceRelationshipType\"\x9b\x14\n" +
+	"\x1aresource_relationship_type\x18\x02 \x01(\v2'.ddex.mead.v11.ResourceRelationshipTypeR\x18resourceRelationshipTypeJ\x06\b\xa8F\x10\x90N\"\xc8\x15\n" +
 	"\x13ResourceInformation\x12I\n" +
 	"\x10resource_summary\x18\x01 \x01(\v2\x1e.ddex.mead.v11.ResourceSummaryR\x0fresourceSummary\x12C\n" +
 	"\x0egenre_category\x18\x02 \x03(\v2\x1c.ddex.mead.v11.GenreCategoryR\rgenreCategory\x12M\n" +
@@ -12241,7 +12538,10 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	" \x03(\v2\x1d.ddex.mead.v11.BeatsPerMinuteR\x0ebeatsPerMinute\x126\n" +
 	"\tintensity\x18\v \x03(\v2\x18.ddex.mead.v11.IntensityR\tintensity\x12F\n" +
 	"\x0finstrument_used\x18\f \x03(\v2\x1d.ddex.mead.v11.InstrumentUsedR\x0einstrumentUsed\x120\n" +
-	"\aharmony\x18\r \x03(\v2\x16.ddex.mead.v11.HarmonyR\aharmony\x12'\n" +
+	"\aharmony\x18\r \x03(\v2\x16.ddex.mead.v11.HarmonyR\aharmony\x124\n" +
+	"\vis_original\x18% \x01(\v2\x13.ddex.mead.v11.FlagR\n" +
+	"isOriginal\x12.\n" +
+	"\bis_cover\x18& \x01(\v2\x13.ddex.mead.v11.FlagR\aisCover\x12'\n" +
 	"\x04mood\x18\x0e \x03(\v2\x13.ddex.mead.v11.MoodR\x04mood\x12:\n" +
 	"\vdance_style\x18\x0f \x03(\v2\x19.ddex.mead.v11.DanceStyleR\n" +
 	"danceStyle\x12=\n" +
@@ -12267,15 +12567,17 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\x05award\x18! \x03(\v2\x14.ddex.mead.v11.AwardR\x05award\x12g\n" +
 	"\x1clocation_and_date_of_session\x18\" \x03(\v2'.ddex.mead.v11.LocationAndDateOfSessionR\x18locationAndDateOfSession\x12L\n" +
 	"\x11alternative_title\x18# \x03(\v2\x1f.ddex.mead.v11.AlternativeTitleR\x10alternativeTitle\x12*\n" +
-	"\x05image\x18$ \x03(\v2\x14.ddex.mead.v11.ImageR\x05image\x124\n" +
-	"\vis_original\x18% \x01(\v2\x13.ddex.mead.v11.FlagR\n" +
-	"isOriginal\x12.\n" +
-	"\bis_cover\x18& \x01(\v2\x13.ddex.mead.v11.FlagR\aisCover\x12;\n" +
-	"\x1apriority_period_start_date\x18' \x01(\tR\x17priorityPeriodStartDate\x127\n" +
-	"\x18priority_period_end_date\x18( \x01(\tR\x15priorityPeriodEndDate\x12:\n" +
-	"\x19applicable_territory_code\x18) \x01(\tR\x17applicableTerritoryCode\"p\n" +
+	"\x05image\x18$ \x03(\v2\x14.ddex.mead.v11.ImageR\x05image\x12@\n" +
+	"\x1apriority_period_start_date\x18' \x01(\tH\x00R\x17priorityPeriodStartDate\x88\x01\x01\x12<\n" +
+	"\x18priority_period_end_date\x18( \x01(\tH\x01R\x15priorityPeriodEndDate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18) \x01(\tH\x02R\x17applicableTerritoryCode\x88\x01\x01\x12:\n" +
+	"\vany_element\x18* \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElementB\x1d\n" +
+	"\x1b_priority_period_start_dateB\x1b\n" +
+	"\x19_priority_period_end_dateB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"x\n" +
 	"\x17ResourceInformationList\x12U\n" +
-	"\x14resource_information\x18\x01 \x03(\v2\".ddex.mead.v11.ResourceInformationR\x13resourceInformation\"\x91\x04\n" +
+	"\x14resource_information\x18\x01 \x03(\v2\".ddex.mead.v11.ResourceInformationR\x13resourceInformationJ\x06\b\xa8F\x10\x90N\"\x99\x04\n" +
 	"\x14ResourceRelationship\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12E\n" +
 	"\vresource_id\x18\x02 \x01(\v2$.ddex.mead.v11.ResourceIdWithoutFlagR\n" +
@@ -12283,123 +12585,147 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\x15related_resource_type\x18\x03 \x01(\v2\".ddex.mead.v11.RelatedResourceTypeR\x13relatedResourceType\x12;\n" +
 	"\x05title\x18\x04 \x03(\v2%.ddex.mead.v11.TitleWithPronunciationR\x05title\x12a\n" +
 	"\x13display_artist_name\x18\x05 \x01(\v21.ddex.mead.v11.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12V\n" +
-	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtist\"\xd5\x02\n" +
+	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtistJ\x06\b\xa8F\x10\x90N\"\xdd\x02\n" +
 	"\x0fResourceSummary\x12E\n" +
 	"\vresource_id\x18\x01 \x01(\v2$.ddex.mead.v11.ResourceIdWithoutFlagR\n" +
 	"resourceId\x12@\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1b.ddex.mead.v11.DisplayTitleR\fdisplayTitle\x12a\n" +
 	"\x13display_artist_name\x18\x03 \x03(\v21.ddex.mead.v11.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12V\n" +
-	"\x0edisplay_artist\x18\x04 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtist\"\x8e\x03\n" +
+	"\x0edisplay_artist\x18\x04 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtistJ\x06\b\xa8F\x10\x90N\"\x96\x03\n" +
 	"\vRhythmStyle\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x125\n" +
 	"\x05value\x18\x02 \x01(\v2\x1f.ddex.mead.v11.RhythmStyleValueR\x05value\x12E\n" +
 	"\vdescription\x18\x03 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\vdescription\x12K\n" +
 	"#language_and_script_of_rhythm_style\x18\x04 \x03(\tR\x1elanguageAndScriptOfRhythmStyle\x12P\n" +
-	"%territory_of_rhythm_style_description\x18\x05 \x03(\tR!territoryOfRhythmStyleDescription\"t\n" +
+	"%territory_of_rhythm_style_description\x18\x05 \x03(\tR!territoryOfRhythmStyleDescriptionJ\x06\b\xa8F\x10\x90N\"\xab\x01\n" +
 	"\x10RhythmStyleValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"q\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa8\x01\n" +
 	"\rRootChordNote\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"t\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xab\x01\n" +
 	"\x10RootChordQuality\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x91\x04\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x99\x04\n" +
 	"\x06Sample\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12I\n" +
 	"\x10related_resource\x18\x02 \x01(\v2\x1e.ddex.mead.v11.ResourceSummaryR\x0frelatedResource\x12C\n" +
-	"\x0esample_feature\x18\x03 \x03(\v2\x1c.ddex.mead.v11.SampleFeatureR\rsampleFeature\x12?\n" +
-	"\vdescription\x18\x04 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\vdescription\x12)\n" +
+	"\x0esample_feature\x18\x03 \x03(\v2\x1c.ddex.mead.v11.SampleFeatureR\rsampleFeature\x12)\n" +
 	"\x10contains_samples\x18\x05 \x01(\bR\x0fcontainsSamples\x123\n" +
 	"\x16is_contained_in_sample\x18\x06 \x01(\bR\x13isContainedInSample\x126\n" +
 	"\vhost_timing\x18\a \x03(\v2\x15.ddex.mead.v11.TimingR\n" +
 	"hostTiming\x12:\n" +
-	"\rsample_timing\x18\b \x03(\v2\x15.ddex.mead.v11.TimingR\fsampleTiming\"^\n" +
+	"\rsample_timing\x18\b \x03(\v2\x15.ddex.mead.v11.TimingR\fsampleTiming\x12?\n" +
+	"\vdescription\x18\x04 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\vdescriptionJ\x06\b\xa8F\x10\x90N\"\x88\x01\n" +
 	"\rSampleFeature\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xe3\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xeb\x01\n" +
 	"\x0eSimilarRelease\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x120\n" +
 	"\arelease\x18\x02 \x01(\v2\x16.ddex.mead.v11.ReleaseR\arelease\x12;\n" +
-	"\vdescription\x18\x03 \x03(\v2\x19.ddex.mead.v11.AnnotationR\vdescription\"\xe7\x01\n" +
+	"\vdescription\x18\x03 \x03(\v2\x19.ddex.mead.v11.AnnotationR\vdescriptionJ\x06\b\xa8F\x10\x90N\"\xef\x01\n" +
 	"\x0fSimilarResource\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x123\n" +
 	"\bresource\x18\x02 \x01(\v2\x17.ddex.mead.v11.ResourceR\bresource\x12;\n" +
-	"\vdescription\x18\x03 \x03(\v2\x19.ddex.mead.v11.AnnotationR\vdescription\"\xd7\x01\n" +
+	"\vdescription\x18\x03 \x03(\v2\x19.ddex.mead.v11.AnnotationR\vdescriptionJ\x06\b\xa8F\x10\x90N\"\xdf\x01\n" +
 	"\vSimilarWork\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12'\n" +
 	"\x04work\x18\x02 \x01(\v2\x13.ddex.mead.v11.WorkR\x04work\x12;\n" +
-	"\vdescription\x18\x03 \x03(\v2\x19.ddex.mead.v11.AnnotationR\vdescription\"\xb2\x01\n" +
+	"\vdescription\x18\x03 \x03(\v2\x19.ddex.mead.v11.AnnotationR\vdescriptionJ\x06\b\xa8F\x10\x90N\"\xba\x01\n" +
 	"\x10SubGenreCategory\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12:\n" +
-	"\x05value\x18\x02 \x03(\v2$.ddex.mead.v11.SubGenreCategoryValueR\x05value\"n\n" +
+	"\x05value\x18\x02 \x03(\v2$.ddex.mead.v11.SubGenreCategoryValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"TempoValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x87\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa3\x03\n" +
 	"\x05Theme\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12/\n" +
 	"\x05value\x18\x02 \x01(\v2\x19.ddex.mead.v11.ThemeValueR\x05value\x12E\n" +
 	"\vdescription\x18\x03 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\vdescription\x12>\n" +
 	"\x1clanguage_and_script_of_theme\x18\x04 \x03(\tR\x18languageAndScriptOfTheme\x12C\n" +
-	"\x1eterritory_of_theme_description\x18\x05 \x03(\tR\x1bterritoryOfThemeDescription\x12\x1d\n" +
+	"\x1eterritory_of_theme_description\x18\x05 \x03(\tR\x1bterritoryOfThemeDescription\x12\"\n" +
 	"\n" +
-	"theme_type\x18\x06 \x01(\tR\tthemeType\"n\n" +
+	"theme_type\x18\x06 \x01(\tH\x00R\tthemeType\x88\x01\x01B\r\n" +
+	"\v_theme_typeJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\n" +
 	"ThemeValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xbb\x02\n" +
-	"\rTimeSignature\x12b\n" +
-	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12F\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
 	"\n" +
-	"modulation\x18\x02 \x03(\v2&.ddex.mead.v11.TimeSignatureModulationR\n" +
-	"modulation\x12*\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc3\x02\n" +
+	"\rTimeSignature\x12b\n" +
+	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12*\n" +
 	"\x05meter\x18\x03 \x01(\v2\x14.ddex.mead.v11.MeterR\x05meter\x12,\n" +
 	"\x12no_meter_available\x18\x04 \x01(\bR\x10noMeterAvailable\x12$\n" +
-	"\x0etoo_many_tempi\x18\x05 \x01(\bR\ftooManyTempi\"\xfd\x01\n" +
-	"\x17TimeSignatureModulation\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1f\n" +
+	"\x0etoo_many_tempi\x18\x05 \x01(\bR\ftooManyTempi\x12F\n" +
+	"\n" +
+	"modulation\x18\x02 \x03(\v2&.ddex.mead.v11.TimeSignatureModulationR\n" +
+	"modulationJ\x06\b\xa8F\x10\x90N\"\xa9\x02\n" +
+	"\x17TimeSignatureModulation\x12\x1f\n" +
 	"\vstart_point\x18\x02 \x01(\tR\n" +
-	"startPoint\x12\x1b\n" +
-	"\tend_point\x18\x03 \x01(\tR\bendPoint\x12\x1b\n" +
-	"\tstart_bar\x18\x04 \x01(\x05R\bstartBar\x12\x17\n" +
-	"\aend_bar\x18\x05 \x01(\x05R\x06endBar\x12*\n" +
+	"startPoint\x12 \n" +
+	"\tend_point\x18\x03 \x01(\tH\x00R\bendPoint\x88\x01\x01\x12\x1b\n" +
+	"\tstart_bar\x18\x04 \x01(\x05R\bstartBar\x12\x1c\n" +
+	"\aend_bar\x18\x05 \x01(\x05H\x01R\x06endBar\x88\x01\x01\x12*\n" +
 	"\x05meter\x18\x06 \x01(\v2\x14.ddex.mead.v11.MeterR\x05meter\x12,\n" +
-	"\x12no_meter_available\x18\a \x01(\bR\x10noMeterAvailable\"\xe0\x03\n" +
+	"\x12no_meter_available\x18\a \x01(\bR\x10noMeterAvailable\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueB\f\n" +
+	"\n" +
+	"_end_pointB\n" +
+	"\n" +
+	"\b_end_barJ\x06\b\xa8F\x10\x90N\"\x81\x04\n" +
 	"\x05Usage\x12b\n" +
-	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12?\n" +
-	"\vdescription\x18\x02 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\vdescription\x12C\n" +
-	"\x1eterritory_of_usage_description\x18\x03 \x03(\tR\x1bterritoryOfUsageDescription\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\x12L\n" +
-	"\x11relevant_resource\x18\x05 \x03(\v2\x1f.ddex.mead.v11.RelevantResourceR\x10relevantResource\x127\n" +
+	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x127\n" +
 	"\n" +
 	"usage_date\x18\x06 \x03(\v2\x18.ddex.mead.v11.EventDateR\tusageDate\x12=\n" +
-	"\fusage_period\x18\a \x03(\v2\x1a.ddex.mead.v11.UsagePeriodR\vusagePeriod\"\x93\x01\n" +
+	"\fusage_period\x18\a \x03(\v2\x1a.ddex.mead.v11.UsagePeriodR\vusagePeriod\x12?\n" +
+	"\vdescription\x18\x02 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\vdescription\x12C\n" +
+	"\x1eterritory_of_usage_description\x18\x03 \x03(\tR\x1bterritoryOfUsageDescription\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12L\n" +
+	"\x11relevant_resource\x18\x05 \x03(\v2\x1f.ddex.mead.v11.RelevantResourceR\x10relevantResourceB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\x9b\x01\n" +
 	"\vUsagePeriod\x12C\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2$.ddex.mead.v11.EventDateWithoutFlagsR\tstartDate\x12?\n" +
-	"\bend_date\x18\x02 \x01(\v2$.ddex.mead.v11.EventDateWithoutFlagsR\aendDate\"\xbc\x01\n" +
+	"\bend_date\x18\x02 \x01(\v2$.ddex.mead.v11.EventDateWithoutFlagsR\aendDateJ\x06\b\xa8F\x10\x90N\"\xc4\x01\n" +
 	"\x0fUsedMusicalWork\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12E\n" +
-	"\x1fresource_musical_work_reference\x18\x02 \x03(\tR\x1cresourceMusicalWorkReference\"\xcc\x03\n" +
+	"\x1fresource_musical_work_reference\x18\x02 \x03(\tR\x1cresourceMusicalWorkReferenceJ\x06\b\xa8F\x10\x90N\"\x82\x04\n" +
 	"\rWorkHierarchy\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x120\n" +
-	"\x14is_described_element\x18\x02 \x01(\bR\x12isDescribedElement\x12\x1f\n" +
-	"\vis_complete\x18\x03 \x01(\bR\n" +
-	"isComplete\x12'\n" +
-	"\x0fsequence_number\x18\x04 \x01(\x05R\x0esequenceNumber\x12@\n" +
+	"\x14is_described_element\x18\x02 \x01(\bR\x12isDescribedElement\x12$\n" +
+	"\vis_complete\x18\x03 \x01(\bH\x00R\n" +
+	"isComplete\x88\x01\x01\x12,\n" +
+	"\x0fsequence_number\x18\x04 \x01(\x05H\x01R\x0esequenceNumber\x88\x01\x01\x12@\n" +
 	"\awork_id\x18\x05 \x01(\v2'.ddex.mead.v11.MusicalWorkIdWithoutFlagR\x06workId\x127\n" +
 	"\n" +
 	"work_title\x18\x06 \x03(\v2\x18.ddex.mead.v11.WorkTitleR\tworkTitle\x127\n" +
 	"\x05child\x18\a \x03(\v2!.ddex.mead.v11.ChildWorkHierarchyR\x05child\x12'\n" +
-	"\x04form\x18\b \x01(\v2\x13.ddex.mead.v11.FormR\x04form\"\xef\f\n" +
-	"\x0fWorkInformation\x124\n" +
-	"\x16musical_work_reference\x18\x01 \x01(\tR\x14musicalWorkReference\x12=\n" +
+	"\x04form\x18\b \x01(\v2\x13.ddex.mead.v11.FormR\x04formB\x0e\n" +
+	"\f_is_completeB\x12\n" +
+	"\x10_sequence_numberJ\x06\b\xa8F\x10\x90N\"\xd3\r\n" +
+	"\x0fWorkInformation\x129\n" +
+	"\x16musical_work_reference\x18\x01 \x01(\tH\x00R\x14musicalWorkReference\x88\x01\x01\x12=\n" +
 	"\fwork_summary\x18\x02 \x01(\v2\x1a.ddex.mead.v11.WorkSummaryR\vworkSummary\x12C\n" +
 	"\x0egenre_category\x18\x03 \x03(\v2\x1c.ddex.mead.v11.GenreCategoryR\rgenreCategory\x12M\n" +
 	"\x12sub_genre_category\x18\x04 \x03(\v2\x1f.ddex.mead.v11.SubGenreCategoryR\x10subGenreCategory\x12'\n" +
@@ -12428,44 +12754,66 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\n" +
 	"is_similar\x18\x19 \x03(\v2\x1a.ddex.mead.v11.SimilarWorkR\tisSimilar\x12*\n" +
 	"\x05award\x18\x1a \x03(\v2\x14.ddex.mead.v11.AwardR\x05award\x12L\n" +
-	"\x11alternative_title\x18\x1b \x03(\v2\x1f.ddex.mead.v11.AlternativeTitleR\x10alternativeTitle\"`\n" +
+	"\x11alternative_title\x18\x1b \x03(\v2\x1f.ddex.mead.v11.AlternativeTitleR\x10alternativeTitle\x12:\n" +
+	"\vany_element\x18\x1c \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElementB\x19\n" +
+	"\x17_musical_work_referenceJ\x06\b\xa8F\x10\x90N\"h\n" +
 	"\x13WorkInformationList\x12I\n" +
-	"\x10work_information\x18\x01 \x03(\v2\x1e.ddex.mead.v11.WorkInformationR\x0fworkInformation\"\xed\x01\n" +
+	"\x10work_information\x18\x01 \x03(\v2\x1e.ddex.mead.v11.WorkInformationR\x0fworkInformationJ\x06\b\xa8F\x10\x90N\"\xf5\x01\n" +
 	"\vWorkSummary\x12O\n" +
 	"\x0fmusical_work_id\x18\x01 \x01(\v2'.ddex.mead.v11.MusicalWorkIdWithoutFlagR\rmusicalWorkId\x12D\n" +
 	"\n" +
 	"work_title\x18\x02 \x03(\v2%.ddex.mead.v11.TitleWithPronunciationR\tworkTitle\x12G\n" +
-	"\x06writer\x18\x03 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writer\"L\n" +
+	"\x06writer\x18\x03 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writerJ\x06\b\xa8F\x10\x90N\"s\n" +
 	"\bCategory\x12\x12\n" +
-	"\x04term\x18\x01 \x01(\tR\x04term\x12\x16\n" +
-	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x14\n" +
-	"\x05label\x18\x03 \x01(\tR\x05label\"/\n" +
-	"\aContent\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
-	"\x03src\x18\x02 \x01(\tR\x03src\" \n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x1b\n" +
+	"\x06scheme\x18\x02 \x01(\tH\x00R\x06scheme\x88\x01\x01\x12\x19\n" +
+	"\x05label\x18\x03 \x01(\tH\x01R\x05label\x88\x01\x01B\t\n" +
+	"\a_schemeB\b\n" +
+	"\x06_labelJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
+	"\aContent\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x15\n" +
+	"\x03src\x18\x02 \x01(\tH\x01R\x03src\x88\x01\x01\x12:\n" +
+	"\vany_element\x18\x03 \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElement\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05valueB\a\n" +
+	"\x05_typeB\x06\n" +
+	"\x04_srcJ\x06\b\xa8F\x10\x90N\"(\n" +
 	"\bDateTime\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"M\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"s\n" +
 	"\tGenerator\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x10\n" +
-	"\x03uri\x18\x02 \x01(\tR\x03uri\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\tR\aversion\"\x1c\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x15\n" +
+	"\x03uri\x18\x02 \x01(\tH\x00R\x03uri\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\tH\x01R\aversion\x88\x01\x01B\x06\n" +
+	"\x04_uriB\n" +
+	"\n" +
+	"\b_versionJ\x06\b\xa8F\x10\x90N\"$\n" +
 	"\x04Icon\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"\x1a\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"\"\n" +
 	"\x02Id\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"\x8a\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"\xf4\x01\n" +
 	"\x04Link\x12\x12\n" +
-	"\x04href\x18\x01 \x01(\tR\x04href\x12\x10\n" +
-	"\x03rel\x18\x02 \x01(\tR\x03rel\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
-	"\bhreflang\x18\x04 \x01(\tR\bhreflang\x12\x14\n" +
-	"\x05title\x18\x05 \x01(\tR\x05title\x12\x16\n" +
-	"\x06length\x18\x06 \x01(\x05R\x06length\"\x1c\n" +
+	"\x04href\x18\x01 \x01(\tR\x04href\x12\x15\n" +
+	"\x03rel\x18\x02 \x01(\tH\x00R\x03rel\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1f\n" +
+	"\bhreflang\x18\x04 \x01(\tH\x02R\bhreflang\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x05 \x01(\tH\x03R\x05title\x88\x01\x01\x12\x1b\n" +
+	"\x06length\x18\x06 \x01(\x05H\x04R\x06length\x88\x01\x01\x12\x14\n" +
+	"\x05value\x18\a \x01(\tR\x05valueB\x06\n" +
+	"\x04_relB\a\n" +
+	"\x05_typeB\v\n" +
+	"\t_hreflangB\b\n" +
+	"\x06_titleB\t\n" +
+	"\a_lengthJ\x06\b\xa8F\x10\x90N\"$\n" +
 	"\x04Logo\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"X\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"\xab\x01\n" +
 	"\x06Person\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12$\n" +
-	"\x03uri\x18\x02 \x01(\v2\x12.ddex.mead.v11.URIR\x03uri\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"\xb7\x04\n" +
+	"\x03uri\x18\x02 \x01(\v2\x12.ddex.mead.v11.URIR\x03uri\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x00R\x05email\x88\x01\x01\x12:\n" +
+	"\vany_element\x18\x04 \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElementB\b\n" +
+	"\x06_emailJ\x06\b\xa8F\x10\x90N\"\xfb\x04\n" +
 	"\x06Source\x12-\n" +
 	"\x06author\x18\x01 \x03(\v2\x15.ddex.mead.v11.PersonR\x06author\x123\n" +
 	"\bcategory\x18\x02 \x03(\v2\x17.ddex.mead.v11.CategoryR\bcategory\x127\n" +
@@ -12479,27 +12827,39 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\bsubtitle\x18\n" +
 	" \x01(\v2\x13.ddex.mead.v11.TextR\bsubtitle\x12)\n" +
 	"\x05title\x18\v \x01(\v2\x13.ddex.mead.v11.TextR\x05title\x121\n" +
-	"\aupdated\x18\f \x01(\v2\x17.ddex.mead.v11.DateTimeR\aupdated\"\x1a\n" +
-	"\x04Text\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"\x1b\n" +
+	"\aupdated\x18\f \x01(\v2\x17.ddex.mead.v11.DateTimeR\aupdated\x12:\n" +
+	"\vany_element\x18\r \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElementJ\x06\b\xa8F\x10\x90N\"\x82\x01\n" +
+	"\x04Text\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12:\n" +
+	"\vany_element\x18\x02 \x03(\v2\x19.ddex.mead.v11.AnyElementR\n" +
+	"anyElement\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05valueB\a\n" +
+	"\x05_typeJ\x06\b\xa8F\x10\x90N\"#\n" +
 	"\x03URI\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"Q\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"r\n" +
 	"\x10AllTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"s\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fArtistTypeValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd9\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8f\x04\n" +
 	"\x11ArtisticInfluence\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12E\n" +
 	"\x05party\x18\x02 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x05party\x12'\n" +
 	"\x04work\x18\x03 \x03(\v2\x13.ddex.mead.v11.WorkR\x04work\x123\n" +
 	"\bresource\x18\x04 \x03(\v2\x17.ddex.mead.v11.ResourceR\bresource\x120\n" +
 	"\arelease\x18\x05 \x03(\v2\x16.ddex.mead.v11.ReleaseR\arelease\x12?\n" +
-	"\vdescription\x18\x06 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\vdescription\x12#\n" +
-	"\ris_influenced\x18\a \x01(\bR\fisInfluenced\x12#\n" +
-	"\ris_influencer\x18\b \x01(\bR\fisInfluencer\"\xed\x03\n" +
+	"\vdescription\x18\x06 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\vdescription\x12(\n" +
+	"\ris_influenced\x18\a \x01(\bH\x00R\fisInfluenced\x88\x01\x01\x12(\n" +
+	"\ris_influencer\x18\b \x01(\bH\x01R\fisInfluencer\x88\x01\x01B\x10\n" +
+	"\x0e_is_influencedB\x10\n" +
+	"\x0e_is_influencerJ\x06\b\xa8F\x10\x90N\"\xf5\x03\n" +
 	"\x05Award\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12T\n" +
 	"\rawarding_body\x18\x02 \x01(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\fawardingBody\x12T\n" +
@@ -12508,65 +12868,91 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"award_name\x18\x04 \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\tawardName\x12,\n" +
 	"\x04date\x18\x05 \x01(\v2\x18.ddex.mead.v11.EventDateR\x04date\x12\x1b\n" +
 	"\tis_winner\x18\x06 \x01(\bR\bisWinner\x127\n" +
-	"\acomment\x18\a \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\acomment\"C\n" +
+	"\acomment\x18\a \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\acommentJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rCatalogNumber\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xaa\x01\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xd2\x01\n" +
 	"\n" +
-	"ChartEntry\x12\x1a\n" +
-	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x12\n" +
-	"\x04date\x18\x02 \x01(\tR\x04date\x123\n" +
+	"ChartEntry\x12\x1f\n" +
+	"\bposition\x18\x01 \x01(\x05H\x00R\bposition\x88\x01\x01\x12\x17\n" +
+	"\x04date\x18\x02 \x01(\tH\x01R\x04date\x88\x01\x01\x123\n" +
 	"\bduration\x18\x03 \x01(\v2\x17.ddex.mead.v11.DurationR\bduration\x127\n" +
-	"\acomment\x18\x04 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\acomment\"\xd4\x02\n" +
+	"\acomment\x18\x04 \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\acommentB\v\n" +
+	"\t_positionB\a\n" +
+	"\x05_dateJ\x06\b\xa8F\x10\x90N\"\xdc\x02\n" +
 	"\x0fClassicalPeriod\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12.\n" +
 	"\x04name\x18\x02 \x01(\v2\x1a.ddex.mead.v11.PeriodValueR\x04name\x12S\n" +
 	"'language_and_script_of_classical_period\x18\x03 \x03(\tR\"languageAndScriptOfClassicalPeriod\x12X\n" +
-	")territory_of_classical_period_description\x18\x04 \x03(\tR%territoryOfClassicalPeriodDescription\"\xf6\x03\n" +
+	")territory_of_classical_period_description\x18\x04 \x03(\tR%territoryOfClassicalPeriodDescriptionJ\x06\b\xa8F\x10\x90N\"\xfe\x03\n" +
 	"\x0eCommentaryNote\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x127\n" +
 	"\x04text\x18\x02 \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\x04text\x12S\n" +
 	"\x14commentary_note_type\x18\x03 \x03(\v2!.ddex.mead.v11.CommentaryNoteTypeR\x12commentaryNoteType\x12Q\n" +
 	"&language_and_script_of_commentary_note\x18\x04 \x03(\tR!languageAndScriptOfCommentaryNote\x12V\n" +
 	"(territory_of_commentary_note_description\x18\x05 \x03(\tR$territoryOfCommentaryNoteDescription\x12G\n" +
-	"\x06author\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06author\"v\n" +
+	"\x06author\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06authorJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12CommentaryNoteType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"U\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"v\n" +
 	"\x14CurrentTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"c\n" +
 	"\x04Date\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\"\xcf\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateJ\x06\b\xa8F\x10\x90N\"\x8e\x02\n" +
 	"\x0fDetailedHashSum\x12A\n" +
-	"\talgorithm\x18\x01 \x01(\v2#.ddex.mead.v11.HashSumAlgorithmTypeR\talgorithm\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tparameter\x18\x03 \x01(\tR\tparameter\x12\x1b\n" +
-	"\tdata_type\x18\x04 \x01(\tR\bdataType\x12$\n" +
-	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValue\"\xea\x01\n" +
-	"\x0fDetailedPartyId\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12(\n" +
-	"\x10cisac_society_id\x18\x05 \x01(\tR\x0ecisacSocietyId\x12C\n" +
-	"\x0eproprietary_id\x18\x06 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryId\"\xc8\x01\n" +
+	"\talgorithm\x18\x01 \x01(\v2#.ddex.mead.v11.HashSumAlgorithmTypeR\talgorithm\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tparameter\x18\x03 \x01(\tH\x01R\tparameter\x88\x01\x01\x12 \n" +
+	"\tdata_type\x18\x04 \x01(\tH\x02R\bdataType\x88\x01\x01\x12$\n" +
+	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValueB\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_parameterB\f\n" +
+	"\n" +
+	"_data_typeJ\x06\b\xa8F\x10\x90N\"\xd6\x02\n" +
+	"\x0fDetailedPartyId\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12-\n" +
+	"\x10cisac_society_id\x18\x05 \x01(\tH\x04R\x0ecisacSocietyId\x88\x01\x01\x12C\n" +
+	"\x0eproprietary_id\x18\x06 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
+	"\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nB\x13\n" +
+	"\x11_cisac_society_idJ\x06\b\xa8F\x10\x90N\"\xa9\x02\n" +
 	"\x1cDisplayArtistNameWithDefault\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xa9\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xb1\x01\n" +
 	"\"DisplayArtistNameWithPronunciation\x12?\n" +
 	"\x04name\x18\x01 \x01(\v2+.ddex.mead.v11.DisplayArtistNameWithDefaultR\x04name\x12B\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciation\"\x96\x01\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\xcd\x01\n" +
 	"\bDuration\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12(\n" +
-	"\x10unit_of_duration\x18\x02 \x01(\tR\x0eunitOfDuration\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"\xa2\x04\n" +
+	"\x10unit_of_duration\x18\x02 \x01(\tR\x0eunitOfDuration\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaa\x04\n" +
 	"\x05Epoch\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12)\n" +
 	"\x05value\x18\x02 \x03(\v2\x13.ddex.mead.v11.NameR\x05value\x12V\n" +
@@ -12576,122 +12962,165 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"start_date\x18\x05 \x01(\v2\x13.ddex.mead.v11.DateR\tstartDate\x12.\n" +
 	"\bend_date\x18\x06 \x01(\v2\x13.ddex.mead.v11.DateR\aendDate\x12>\n" +
 	"\x1clanguage_and_script_of_epoch\x18\a \x03(\tR\x18languageAndScriptOfEpoch\x12C\n" +
-	"\x1eterritory_of_epoch_description\x18\b \x03(\tR\x1bterritoryOfEpochDescription\"\xa8\x02\n" +
+	"\x1eterritory_of_epoch_description\x18\b \x03(\tR\x1bterritoryOfEpochDescriptionJ\x06\b\xa8F\x10\x90N\"\xd0\x03\n" +
 	"\tEventDate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12:\n" +
-	"\x19applicable_territory_code\x18\x05 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"\x97\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xb4\x03\n" +
 	"\rEventDateTime\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12%\n" +
-	"\x0eterritory_code\x18\x05 \x01(\tR\rterritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"r\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12*\n" +
+	"\x0eterritory_code\x18\x05 \x01(\tH\x03R\rterritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x11\n" +
+	"\x0f_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x8d\x01\n" +
 	"\x04File\x12\x12\n" +
 	"\x05u_r_i\x18\x01 \x01(\tR\x03uRI\x129\n" +
-	"\bhash_sum\x18\x02 \x01(\v2\x1e.ddex.mead.v11.DetailedHashSumR\ahashSum\x12\x1b\n" +
-	"\tfile_size\x18\x03 \x01(\tR\bfileSize\"\xea\x05\n" +
+	"\bhash_sum\x18\x02 \x01(\v2\x1e.ddex.mead.v11.DetailedHashSumR\ahashSum\x12 \n" +
+	"\tfile_size\x18\x03 \x01(\tH\x00R\bfileSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_file_sizeJ\x06\b\xa8F\x10\x90N\"\x9f\x06\n" +
 	"\x05Focus\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12E\n" +
 	"\x05party\x18\x02 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x05party\x12a\n" +
 	"\x13display_artist_name\x18\x03 \x03(\v21.ddex.mead.v11.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12V\n" +
 	"\x0edisplay_artist\x18\x04 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtist\x12G\n" +
-	"\x06writer\x18\x05 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writer\x12'\n" +
-	"\x0fsequence_number\x18\x06 \x01(\x05R\x0esequenceNumber\x12P\n" +
+	"\x06writer\x18\x05 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writer\x12,\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12P\n" +
 	"\x15period_of_being_focus\x18\a \x03(\v2\x1d.ddex.mead.v11.PeriodWithTimeR\x12periodOfBeingFocus\x12Y\n" +
 	"*territory_of_being_focus_track_description\x18\b \x03(\tR%territoryOfBeingFocusTrackDescription\x12=\n" +
-	"\acomment\x18\t \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\acomment\x12\x1d\n" +
+	"\acomment\x18\t \x03(\v2#.ddex.mead.v11.TextWithoutTerritoryR\acomment\x12\"\n" +
 	"\n" +
 	"is_default\x18\n" +
-	" \x01(\bR\tisDefault\"\xaf\x01\n" +
+	" \x01(\bH\x01R\tisDefault\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\x88\x02\n" +
 	"\x12GenreCategoryValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x04 \x01(\tR\x10userDefinedValue\"x\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x03 \x01(\tH\x01R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x04 \x01(\tH\x02R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\x14HashSumAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xfe\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x9c\x04\n" +
 	"\x1bHistoricChartingInformation\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12J\n" +
 	"\x0eterritory_code\x18\x02 \x01(\v2#.ddex.mead.v11.CurrentTerritoryCodeR\rterritoryCode\x12P\n" +
 	"\n" +
 	"chart_name\x18\x03 \x03(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\tchartName\x12E\n" +
-	"\x12duration_in_charts\x18\x04 \x01(\v2\x17.ddex.mead.v11.DurationR\x10durationInCharts\x12!\n" +
-	"\ftop_position\x18\x05 \x01(\x05R\vtopPosition\x12:\n" +
+	"\x12duration_in_charts\x18\x04 \x01(\v2\x17.ddex.mead.v11.DurationR\x10durationInCharts\x12&\n" +
+	"\ftop_position\x18\x05 \x01(\x05H\x00R\vtopPosition\x88\x01\x01\x12:\n" +
 	"\vchart_entry\x18\x06 \x03(\v2\x19.ddex.mead.v11.ChartEntryR\n" +
 	"chartEntry\x127\n" +
-	"\acomment\x18\a \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\acomment\"\xcd\x01\n" +
+	"\acomment\x18\a \x03(\v2\x1d.ddex.mead.v11.TextWithFormatR\acommentB\x0f\n" +
+	"\r_top_positionJ\x06\b\xa8F\x10\x90N\"\xd5\x01\n" +
 	"\x05Image\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x12'\n" +
 	"\x04file\x18\x02 \x01(\v2\x13.ddex.mead.v11.FileR\x04file\x127\n" +
 	"\n" +
-	"image_type\x18\x03 \x03(\v2\x18.ddex.mead.v11.ImageTypeR\timageType\"m\n" +
+	"image_type\x18\x03 \x03(\v2\x18.ddex.mead.v11.ImageTypeR\timageTypeJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tImageType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"u\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"}\n" +
 	"\x11MessageAuditTrail\x12`\n" +
-	"\x19message_audit_trail_event\x18\x01 \x03(\v2%.ddex.mead.v11.MessageAuditTrailEventR\x16messageAuditTrailEvent\"\x9d\x01\n" +
+	"\x19message_audit_trail_event\x18\x01 \x03(\v2%.ddex.mead.v11.MessageAuditTrailEventR\x16messageAuditTrailEventJ\x06\b\xa8F\x10\x90N\"\xa5\x01\n" +
 	"\x16MessageAuditTrailEvent\x12f\n" +
 	"\x1amessaging_party_descriptor\x18\x01 \x01(\v2(.ddex.mead.v11.MessagingPartyWithoutCodeR\x18messagingPartyDescriptor\x12\x1b\n" +
-	"\tdate_time\x18\x02 \x01(\tR\bdateTime\"\xc2\x04\n" +
-	"\rMessageHeader\x12*\n" +
-	"\x11message_thread_id\x18\x01 \x01(\tR\x0fmessageThreadId\x12\x1d\n" +
+	"\tdate_time\x18\x02 \x01(\tR\bdateTimeJ\x06\b\xa8F\x10\x90N\"\x9e\x05\n" +
+	"\rMessageHeader\x12/\n" +
+	"\x11message_thread_id\x18\x01 \x01(\tH\x00R\x0fmessageThreadId\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId\x12*\n" +
-	"\x11message_file_name\x18\x03 \x01(\tR\x0fmessageFileName\x12O\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12/\n" +
+	"\x11message_file_name\x18\x03 \x01(\tH\x01R\x0fmessageFileName\x88\x01\x01\x12O\n" +
 	"\x0emessage_sender\x18\x04 \x01(\v2(.ddex.mead.v11.MessagingPartyWithoutCodeR\rmessageSender\x12S\n" +
 	"\x11sent_on_behalf_of\x18\x05 \x01(\v2(.ddex.mead.v11.MessagingPartyWithoutCodeR\x0esentOnBehalfOf\x12U\n" +
 	"\x11message_recipient\x18\x06 \x03(\v2(.ddex.mead.v11.MessagingPartyWithoutCodeR\x10messageRecipient\x129\n" +
 	"\x19message_created_date_time\x18\a \x01(\tR\x16messageCreatedDateTime\x12P\n" +
-	"\x13message_audit_trail\x18\b \x01(\v2 .ddex.mead.v11.MessageAuditTrailR\x11messageAuditTrail\x120\n" +
-	"\x14message_control_type\x18\t \x01(\tR\x12messageControlType\"\x9d\x01\n" +
+	"\x13message_audit_trail\x18\b \x01(\v2 .ddex.mead.v11.MessageAuditTrailR\x11messageAuditTrail\x125\n" +
+	"\x14message_control_type\x18\t \x01(\tH\x02R\x12messageControlType\x88\x01\x01B\x14\n" +
+	"\x12_message_thread_idB\x14\n" +
+	"\x12_message_file_nameB\x17\n" +
+	"\x15_message_control_typeJ\x06\b\xa8F\x10\x90N\"\xbb\x01\n" +
 	"\x19MessagingPartyWithoutCode\x12\x19\n" +
 	"\bparty_id\x18\x01 \x01(\tR\apartyId\x12B\n" +
 	"\n" +
-	"party_name\x18\x02 \x01(\v2#.ddex.mead.v11.PartyNameWithoutCodeR\tpartyName\x12!\n" +
-	"\ftrading_name\x18\x03 \x01(\tR\vtradingName\"\x95\x02\n" +
+	"party_name\x18\x02 \x01(\v2#.ddex.mead.v11.PartyNameWithoutCodeR\tpartyName\x12&\n" +
+	"\ftrading_name\x18\x03 \x01(\tH\x00R\vtradingName\x88\x01\x01B\x0f\n" +
+	"\r_trading_nameJ\x06\b\xa8F\x10\x90N\"\x9d\x02\n" +
 	"\x0eMetadataSource\x12)\n" +
-	"\x10source_reference\x18\x01 \x01(\tR\x0fsourceReference\x12S\n" +
-	"\x14metadata_source_type\x18\x02 \x01(\v2!.ddex.mead.v11.MetadataSourceTypeR\x12metadataSourceType\x129\n" +
+	"\x10source_reference\x18\x01 \x01(\tR\x0fsourceReference\x129\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x1e.ddex.mead.v11.DetailedPartyIdR\apartyId\x12H\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2).ddex.mead.v11.PartyNameWithPronunciationR\tpartyName\"\\\n" +
+	"party_name\x18\x04 \x03(\v2).ddex.mead.v11.PartyNameWithPronunciationR\tpartyName\x12S\n" +
+	"\x14metadata_source_type\x18\x02 \x01(\v2!.ddex.mead.v11.MetadataSourceTypeR\x12metadataSourceTypeJ\x06\b\xa8F\x10\x90N\"d\n" +
 	"\x12MetadataSourceList\x12F\n" +
-	"\x0fmetadata_source\x18\x01 \x03(\v2\x1d.ddex.mead.v11.MetadataSourceR\x0emetadataSource\"\x8f\x01\n" +
+	"\x0fmetadata_source\x18\x01 \x03(\v2\x1d.ddex.mead.v11.MetadataSourceR\x0emetadataSourceJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x17MetadataSourceReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
-	"\x13assertion_date_time\x18\x02 \x01(\tR\x11assertionDateTime\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\tR\x06weight\"v\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x123\n" +
+	"\x13assertion_date_time\x18\x02 \x01(\tH\x00R\x11assertionDateTime\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x04 \x01(\tH\x02R\x06weight\x88\x01\x01B\x16\n" +
+	"\x14_assertion_date_timeB\t\n" +
+	"\a_statusB\t\n" +
+	"\a_weightJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12MetadataSourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xcf\x01\n" +
-	"\x18MusicalWorkIdWithoutFlag\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12\x1f\n" +
-	"\vopus_number\x18\x02 \x01(\tR\n" +
-	"opusNumber\x126\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xfd\x01\n" +
+	"\x18MusicalWorkIdWithoutFlag\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x12$\n" +
+	"\vopus_number\x18\x02 \x01(\tH\x01R\n" +
+	"opusNumber\x88\x01\x01\x126\n" +
 	"\x17composer_catalog_number\x18\x03 \x03(\tR\x15composerCatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryId\"U\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_w_cB\x0e\n" +
+	"\f_opus_numberJ\x06\b\xa8F\x10\x90N\"\x7f\n" +
 	"\x04Name\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x91\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x99\x01\n" +
 	"\"NameWithPronunciationAndScriptCode\x12'\n" +
 	"\x04name\x18\x01 \x01(\v2\x13.ddex.mead.v11.NameR\x04name\x12B\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciation\"\xa7\x01\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	" PartyDescriptorWithPronunciation\x129\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x1e.ddex.mead.v11.DetailedPartyIdR\apartyId\x12H\n" +
 	"\n" +
-	"party_name\x18\x02 \x03(\v2).ddex.mead.v11.PartyNameWithPronunciationR\tpartyName\"\xa8\x06\n" +
+	"party_name\x18\x02 \x03(\v2).ddex.mead.v11.PartyNameWithPronunciationR\tpartyNameJ\x06\b\xa8F\x10\x90N\"\xf5\x06\n" +
 	"\x1aPartyNameWithPronunciation\x12N\n" +
 	"\tfull_name\x18\x01 \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\bfullName\x12p\n" +
 	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\x18fullNameAsciiTranscribed\x12]\n" +
@@ -12699,175 +13128,282 @@ const file_ddex_mead_v11_v11_proto_rawDesc = "" +
 	"\x15names_before_key_name\x18\x04 \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\x12namesBeforeKeyName\x12L\n" +
 	"\bkey_name\x18\x05 \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\akeyName\x12b\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\x11namesAfterKeyName\x12\\\n" +
-	"\x10abbreviated_name\x18\a \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\t \x01(\tR\x17applicableTerritoryCode\"\xc8\x02\n" +
+	"\x10abbreviated_name\x18\a \x01(\v21.ddex.mead.v11.NameWithPronunciationAndScriptCodeR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\t \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\xf9\x03\n" +
 	"\x14PartyNameWithoutCode\x12\x1b\n" +
-	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12*\n" +
-	"\x11full_name_indexed\x18\x03 \x01(\tR\x0ffullNameIndexed\x121\n" +
-	"\x15names_before_key_name\x18\x04 \x01(\tR\x12namesBeforeKeyName\x12\x19\n" +
-	"\bkey_name\x18\x05 \x01(\tR\akeyName\x12/\n" +
-	"\x14names_after_key_name\x18\x06 \x01(\tR\x11namesAfterKeyName\x12)\n" +
-	"\x10abbreviated_name\x18\a \x01(\tR\x0fabbreviatedName\"\xfe\x01\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12/\n" +
+	"\x11full_name_indexed\x18\x03 \x01(\tH\x01R\x0ffullNameIndexed\x88\x01\x01\x126\n" +
+	"\x15names_before_key_name\x18\x04 \x01(\tH\x02R\x12namesBeforeKeyName\x88\x01\x01\x12\x1e\n" +
+	"\bkey_name\x18\x05 \x01(\tH\x03R\akeyName\x88\x01\x01\x124\n" +
+	"\x14names_after_key_name\x18\x06 \x01(\tH\x04R\x11namesAfterKeyName\x88\x01\x01\x12.\n" +
+	"\x10abbreviated_name\x18\a \x01(\tH\x05R\x0fabbreviatedName\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x14\n" +
+	"\x12_full_name_indexedB\x18\n" +
+	"\x16_names_before_key_nameB\v\n" +
+	"\t_key_nameB\x17\n" +
+	"\x15_names_after_key_nameB\x13\n" +
+	"\x11_abbreviated_nameJ\x06\b\xa8F\x10\x90N\"\x86\x02\n" +
 	"\x06Period\x127\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x18.ddex.mead.v11.EventDateR\tstartDate\x123\n" +
 	"\bend_date\x18\x02 \x01(\v2\x18.ddex.mead.v11.EventDateR\aendDate\x12D\n" +
 	"\x0fstart_date_time\x18\x03 \x01(\v2\x1c.ddex.mead.v11.EventDateTimeR\rstartDateTime\x12@\n" +
-	"\rend_date_time\x18\x04 \x01(\v2\x1c.ddex.mead.v11.EventDateTimeR\vendDateTime\"o\n" +
+	"\rend_date_time\x18\x04 \x01(\v2\x1c.ddex.mead.v11.EventDateTimeR\vendDateTimeJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vPeriodValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\\\n" +
-	"\x0ePeriodWithTime\x12&\n" +
-	"\x0fstart_date_time\x18\x01 \x01(\tR\rstartDateTime\x12\"\n" +
-	"\rend_date_time\x18\x02 \x01(\tR\vendDateTime\"\x80\x02\n" +
-	"\rPronunciation\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
-	"\fis_canonical\x18\x02 \x01(\bR\visCanonical\x12#\n" +
-	"\rlanguage_code\x18\x03 \x01(\tR\flanguageCode\x12#\n" +
-	"\rencoding_type\x18\x04 \x01(\tR\fencodingType\x12-\n" +
-	"\x12encoding_namespace\x18\x05 \x01(\tR\x11encodingNamespace\x12=\n" +
-	"\x1bencoding_user_defined_value\x18\x06 \x01(\tR\x18encodingUserDefinedValue\"C\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x94\x01\n" +
+	"\x0ePeriodWithTime\x12+\n" +
+	"\x0fstart_date_time\x18\x01 \x01(\tH\x00R\rstartDateTime\x88\x01\x01\x12'\n" +
+	"\rend_date_time\x18\x02 \x01(\tH\x01R\vendDateTime\x88\x01\x01B\x12\n" +
+	"\x10_start_date_timeB\x10\n" +
+	"\x0e_end_date_timeJ\x06\b\xa8F\x10\x90N\"\x8d\x03\n" +
+	"\rPronunciation\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
+	"\fis_canonical\x18\x02 \x01(\bH\x00R\visCanonical\x88\x01\x01\x12(\n" +
+	"\rlanguage_code\x18\x03 \x01(\tH\x01R\flanguageCode\x88\x01\x01\x12(\n" +
+	"\rencoding_type\x18\x04 \x01(\tH\x02R\fencodingType\x88\x01\x01\x122\n" +
+	"\x12encoding_namespace\x18\x05 \x01(\tH\x03R\x11encodingNamespace\x88\x01\x01\x12B\n" +
+	"\x1bencoding_user_defined_value\x18\x06 \x01(\tH\x04R\x18encodingUserDefinedValue\x88\x01\x01B\x0f\n" +
+	"\r_is_canonicalB\x10\n" +
+	"\x0e_language_codeB\x10\n" +
+	"\x0e_encoding_typeB\x15\n" +
+	"\x13_encoding_namespaceB\x1e\n" +
+	"\x1c_encoding_user_defined_valueJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rProprietaryId\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"u\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xac\x01\n" +
 	"\x11RecordingPartType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x9f\x02\n" +
-	"\x0fRelatedCreation\x12;\n" +
-	"\x05title\x18\x01 \x01(\v2%.ddex.mead.v11.TitleWithPronunciationR\x05title\x127\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa7\x02\n" +
+	"\x0fRelatedCreation\x127\n" +
 	"\n" +
 	"release_id\x18\x02 \x01(\v2\x18.ddex.mead.v11.ReleaseIdR\treleaseId\x12E\n" +
 	"\vresource_id\x18\x03 \x01(\v2$.ddex.mead.v11.ResourceIdWithoutFlagR\n" +
 	"resourceId\x12O\n" +
-	"\x0fmusical_work_id\x18\x04 \x01(\v2'.ddex.mead.v11.MusicalWorkIdWithoutFlagR\rmusicalWorkId\"w\n" +
+	"\x0fmusical_work_id\x18\x04 \x01(\v2'.ddex.mead.v11.MusicalWorkIdWithoutFlagR\rmusicalWorkId\x12;\n" +
+	"\x05title\x18\x01 \x01(\v2%.ddex.mead.v11.TitleWithPronunciationR\x05titleJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13RelatedResourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xe8\x02\n" +
-	"\aRelease\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_c_p_n\x18\x02 \x01(\tR\x04iCPN\x124\n" +
-	"\x16proprietary_release_id\x18\x03 \x01(\tR\x14proprietaryReleaseId\x12@\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb0\x03\n" +
+	"\aRelease\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_c_p_n\x18\x02 \x01(\tH\x01R\x04iCPN\x88\x01\x01\x129\n" +
+	"\x16proprietary_release_id\x18\x03 \x01(\tH\x02R\x14proprietaryReleaseId\x88\x01\x01\x12@\n" +
 	"\rrelease_title\x18\x04 \x03(\v2\x1b.ddex.mead.v11.ReleaseTitleR\freleaseTitle\x12a\n" +
 	"\x13display_artist_name\x18\x05 \x03(\v21.ddex.mead.v11.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12V\n" +
-	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtist\"\xd8\x01\n" +
-	"\tReleaseId\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_s_r_c\x18\x02 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_c_p_n\x18\x03 \x01(\tR\x04iCPN\x12C\n" +
+	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtistB\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_c_p_nB\x19\n" +
+	"\x17_proprietary_release_idJ\x06\b\xa8F\x10\x90N\"\x91\x02\n" +
+	"\tReleaseId\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_r_c\x18\x02 \x01(\tH\x01R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_c_p_n\x18\x03 \x01(\tH\x02R\x04iCPN\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\x04 \x01(\v2\x1c.ddex.mead.v11.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryId\"]\n" +
+	"\x0eproprietary_id\x18\x05 \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryIdB\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_c_p_nJ\x06\b\xa8F\x10\x90N\"\x87\x01\n" +
 	"\fReleaseTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\x80\x03\n" +
-	"\bResource\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12%\n" +
-	"\x0ecatalog_number\x18\x02 \x01(\tR\rcatalogNumber\x126\n" +
-	"\x17proprietary_resource_id\x18\x03 \x01(\tR\x15proprietaryResourceId\x12C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xd2\x03\n" +
+	"\bResource\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12*\n" +
+	"\x0ecatalog_number\x18\x02 \x01(\tH\x01R\rcatalogNumber\x88\x01\x01\x12;\n" +
+	"\x17proprietary_resource_id\x18\x03 \x01(\tH\x02R\x15proprietaryResourceId\x88\x01\x01\x12C\n" +
 	"\x0eresource_title\x18\x04 \x03(\v2\x1c.ddex.mead.v11.ResourceTitleR\rresourceTitle\x12a\n" +
 	"\x13display_artist_name\x18\x05 \x03(\v21.ddex.mead.v11.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12V\n" +
-	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtist\"{\n" +
+	"\x0edisplay_artist\x18\x06 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\rdisplayArtistB\n" +
+	"\n" +
+	"\b_i_s_r_cB\x11\n" +
+	"\x0f_catalog_numberB\x1a\n" +
+	"\x18_proprietary_resource_idJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\x17ResourceContributorRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xc5\x02\n" +
-	"\x15ResourceIdWithoutFlag\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_m_n\x18\x02 \x01(\tR\x04iSMN\x12\x15\n" +
-	"\ai_s_a_n\x18\x03 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x04 \x01(\tR\x05vISAN\x12\x15\n" +
-	"\ai_s_b_n\x18\x05 \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\x06 \x01(\tR\x04iSSN\x12\x15\n" +
-	"\as_i_c_i\x18\a \x01(\tR\x04sICI\x12C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc6\x03\n" +
+	"\x15ResourceIdWithoutFlag\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_m_n\x18\x02 \x01(\tH\x01R\x04iSMN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x03 \x01(\tH\x02R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x04 \x01(\tH\x03R\x05vISAN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_b_n\x18\x05 \x01(\tH\x04R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\x06 \x01(\tH\x05R\x04iSSN\x88\x01\x01\x12\x1a\n" +
+	"\as_i_c_i\x18\a \x01(\tH\x06R\x04sICI\x88\x01\x01\x12C\n" +
 	"\x0ecatalog_number\x18\b \x01(\v2\x1c.ddex.mead.v11.CatalogNumberR\rcatalogNumber\x12C\n" +
-	"\x0eproprietary_id\x18\t \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryId\"|\n" +
+	"\x0eproprietary_id\x18\t \x03(\v2\x1c.ddex.mead.v11.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_m_nB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iJ\x06\b\xa8F\x10\x90N\"\xb3\x01\n" +
 	"\x18ResourceRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"^\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x88\x01\n" +
 	"\rResourceTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"o\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vSessionType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"y\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb0\x01\n" +
 	"\x15SubGenreCategoryValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x9e\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xbe\x03\n" +
 	"\x0eTextWithFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xa4\x02\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc4\x03\n" +
 	"\x14TextWithoutTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"e\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"m\n" +
 	"\tTitleText\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12B\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciation\"\xac\x02\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1c.ddex.mead.v11.PronunciationR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\x99\x03\n" +
 	"\x16TitleWithPronunciation\x127\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\v2\x18.ddex.mead.v11.TitleTextR\ttitleText\x125\n" +
-	"\tsub_title\x18\x02 \x01(\v2\x18.ddex.mead.v11.TitleTextR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x01(\v2\x18.ddex.mead.v11.TitleTextR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"title_type\x18\x04 \x01(\tH\x01R\ttitleType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_title_typeB\f\n" +
 	"\n" +
-	"title_type\x18\x04 \x01(\tR\ttitleType\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\"\xd7\x01\n" +
-	"\x05Venue\x12\x1d\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb5\x02\n" +
+	"\x05Venue\x12\"\n" +
 	"\n" +
-	"venue_name\x18\x01 \x01(\tR\tvenueName\x12#\n" +
-	"\rvenue_address\x18\x02 \x01(\tR\fvenueAddress\x12F\n" +
-	"\x0eterritory_code\x18\x03 \x01(\v2\x1f.ddex.mead.v11.AllTerritoryCodeR\rterritoryCode\x12#\n" +
-	"\rlocation_code\x18\x04 \x01(\tR\flocationCode\x12\x1d\n" +
+	"venue_name\x18\x01 \x01(\tH\x00R\tvenueName\x88\x01\x01\x12(\n" +
+	"\rvenue_address\x18\x02 \x01(\tH\x01R\fvenueAddress\x88\x01\x01\x12F\n" +
+	"\x0eterritory_code\x18\x03 \x01(\v2\x1f.ddex.mead.v11.AllTerritoryCodeR\rterritoryCode\x12(\n" +
+	"\rlocation_code\x18\x04 \x01(\tH\x02R\flocationCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"venue_room\x18\x05 \x01(\tR\tvenueRoom\"\xac\x01\n" +
+	"venue_room\x18\x05 \x01(\tH\x03R\tvenueRoom\x88\x01\x01B\r\n" +
+	"\v_venue_nameB\x10\n" +
+	"\x0e_venue_addressB\x10\n" +
+	"\x0e_location_codeB\r\n" +
+	"\v_venue_roomJ\x06\b\xa8F\x10\x90N\"\xb4\x01\n" +
 	"\rVocalRegister\x12b\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2&.ddex.mead.v11.MetadataSourceReferenceR\x17metadataSourceReference\x127\n" +
-	"\x05value\x18\x02 \x01(\v2!.ddex.mead.v11.VocalRegisterValueR\x05value\"v\n" +
+	"\x05value\x18\x02 \x01(\v2!.ddex.mead.v11.VocalRegisterValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12VocalRegisterValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xcf\x01\n" +
-	"\x04Work\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12.\n" +
-	"\x13proprietary_work_id\x18\x02 \x01(\tR\x11proprietaryWorkId\x127\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x85\x02\n" +
+	"\x04Work\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x123\n" +
+	"\x13proprietary_work_id\x18\x02 \x01(\tH\x01R\x11proprietaryWorkId\x88\x01\x01\x127\n" +
 	"\n" +
 	"work_title\x18\x03 \x03(\v2\x18.ddex.mead.v11.WorkTitleR\tworkTitle\x12G\n" +
-	"\x06writer\x18\x04 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writer\"x\n" +
+	"\x06writer\x18\x04 \x03(\v2/.ddex.mead.v11.PartyDescriptorWithPronunciationR\x06writerB\n" +
+	"\n" +
+	"\b_i_s_w_cB\x16\n" +
+	"\x14_proprietary_work_idJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\x14WorkRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"Z\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x84\x01\n" +
 	"\tWorkTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xfc\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xff\x02\n" +
 	"\x15EventDateWithoutFlags\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x04 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\"N\n" +
-	"\x06Timing\x12\x1f\n" +
-	"\vstart_point\x18\x01 \x01(\tR\n" +
-	"startPoint\x12#\n" +
-	"\rduration_used\x18\x02 \x01(\tR\fdurationUsedB\xa3\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x04 \x01(\tH\x02R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\x05 \x01(\tH\x03R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x82\x01\n" +
+	"\x06Timing\x12$\n" +
+	"\vstart_point\x18\x01 \x01(\tH\x00R\n" +
+	"startPoint\x88\x01\x01\x12(\n" +
+	"\rduration_used\x18\x02 \x01(\tH\x01R\fdurationUsed\x88\x01\x01B\x0e\n" +
+	"\f_start_pointB\x10\n" +
+	"\x0e_duration_usedJ\x06\b\xa8F\x10\x90N\"-\n" +
+	"\n" +
+	"AnyElement\x12\x17\n" +
+	"\araw_xml\x18\x01 \x01(\tR\x06rawXmlJ\x06\b\xa8F\x10\x90NB\xa3\x01\n" +
 	"\x11com.ddex.mead.v11B\bV11ProtoP\x01Z.github.com/alecsavvy/ddex-go/gen/ddex/mead/v11\xa2\x02\x03DMX\xaa\x02\rDdex.Mead.V11\xca\x02\rDdex\\Mead\\V11\xe2\x02\x19Ddex\\Mead\\V11\\GPBMetadata\xea\x02\x0fDdex::Mead::V11b\x06proto3"
 
 var (
@@ -12882,7 +13418,7 @@ func file_ddex_mead_v11_v11_proto_rawDescGZIP() []byte {
 	return file_ddex_mead_v11_v11_proto_rawDescData
 }
 
-var file_ddex_mead_v11_v11_proto_msgTypes = make([]protoimpl.MessageInfo, 152)
+var file_ddex_mead_v11_v11_proto_msgTypes = make([]protoimpl.MessageInfo, 153)
 var file_ddex_mead_v11_v11_proto_goTypes = []any{
 	(*MeadMessage)(nil),                        // 0: ddex.mead.v11.MeadMessage
 	(*Feed)(nil),                               // 1: ddex.mead.v11.Feed
@@ -13036,6 +13572,7 @@ var file_ddex_mead_v11_v11_proto_goTypes = []any{
 	(*WorkTitle)(nil),                          // 149: ddex.mead.v11.WorkTitle
 	(*EventDateWithoutFlags)(nil),              // 150: ddex.mead.v11.EventDateWithoutFlags
 	(*Timing)(nil),                             // 151: ddex.mead.v11.Timing
+	(*AnyElement)(nil),                         // 152: ddex.mead.v11.AnyElement
 }
 var file_ddex_mead_v11_v11_proto_depIdxs = []int32{
 	110, // 0: ddex.mead.v11.MeadMessage.message_header:type_name -> ddex.mead.v11.MessageHeader
@@ -13056,350 +13593,362 @@ var file_ddex_mead_v11_v11_proto_depIdxs = []int32{
 	80,  // 15: ddex.mead.v11.Feed.title:type_name -> ddex.mead.v11.Text
 	72,  // 16: ddex.mead.v11.Feed.updated:type_name -> ddex.mead.v11.DateTime
 	16,  // 17: ddex.mead.v11.Feed.entry:type_name -> ddex.mead.v11.Entry
-	114, // 18: ddex.mead.v11.AbsolutePitch.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	34,  // 19: ddex.mead.v11.AbsolutePitch.modulation:type_name -> ddex.mead.v11.Modulation
-	114, // 20: ddex.mead.v11.Activity.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	4,   // 21: ddex.mead.v11.Activity.value:type_name -> ddex.mead.v11.ActivityValue
-	141, // 22: ddex.mead.v11.Activity.description:type_name -> ddex.mead.v11.TextWithoutTerritory
-	114, // 23: ddex.mead.v11.AlternativeTitle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	142, // 24: ddex.mead.v11.AlternativeTitle.title_text:type_name -> ddex.mead.v11.TitleText
-	142, // 25: ddex.mead.v11.AlternativeTitle.sub_title:type_name -> ddex.mead.v11.TitleText
-	114, // 26: ddex.mead.v11.Annotation.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	140, // 27: ddex.mead.v11.Annotation.text:type_name -> ddex.mead.v11.TextWithFormat
-	114, // 28: ddex.mead.v11.ArtisticStyle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	83,  // 29: ddex.mead.v11.ArtisticStyle.value:type_name -> ddex.mead.v11.ArtistTypeValue
-	114, // 30: ddex.mead.v11.BeatsPerMinute.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	34,  // 31: ddex.mead.v11.BeatsPerMinute.modulation:type_name -> ddex.mead.v11.Modulation
-	116, // 32: ddex.mead.v11.ChildWorkHierarchy.work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
-	149, // 33: ddex.mead.v11.ChildWorkHierarchy.work_title:type_name -> ddex.mead.v11.WorkTitle
-	9,   // 34: ddex.mead.v11.ChildWorkHierarchy.child:type_name -> ddex.mead.v11.ChildWorkHierarchy
-	18,  // 35: ddex.mead.v11.ChildWorkHierarchy.form:type_name -> ddex.mead.v11.Form
-	94,  // 36: ddex.mead.v11.Contributor.identifier:type_name -> ddex.mead.v11.DetailedPartyId
-	120, // 37: ddex.mead.v11.Contributor.name:type_name -> ddex.mead.v11.PartyNameWithPronunciation
-	134, // 38: ddex.mead.v11.Contributor.role:type_name -> ddex.mead.v11.ResourceContributorRole
-	114, // 39: ddex.mead.v11.DanceStyle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	12,  // 40: ddex.mead.v11.DanceStyle.value:type_name -> ddex.mead.v11.DanceStyleValue
-	141, // 41: ddex.mead.v11.DanceStyle.description:type_name -> ddex.mead.v11.TextWithoutTerritory
-	114, // 42: ddex.mead.v11.DerivedRecording.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	135, // 43: ddex.mead.v11.DerivedRecording.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
-	129, // 44: ddex.mead.v11.DerivedRecording.related_resource_type:type_name -> ddex.mead.v11.RelatedResourceType
-	143, // 45: ddex.mead.v11.DerivedRecording.title:type_name -> ddex.mead.v11.TitleWithPronunciation
-	96,  // 46: ddex.mead.v11.DerivedRecording.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
-	119, // 47: ddex.mead.v11.DerivedRecording.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	125, // 48: ddex.mead.v11.DisplaySubTitle.pronunciation:type_name -> ddex.mead.v11.Pronunciation
-	142, // 49: ddex.mead.v11.DisplayTitle.title_text:type_name -> ddex.mead.v11.TitleText
-	14,  // 50: ddex.mead.v11.DisplayTitle.sub_title:type_name -> ddex.mead.v11.DisplaySubTitle
-	78,  // 51: ddex.mead.v11.Entry.author:type_name -> ddex.mead.v11.Person
-	70,  // 52: ddex.mead.v11.Entry.category:type_name -> ddex.mead.v11.Category
-	71,  // 53: ddex.mead.v11.Entry.content:type_name -> ddex.mead.v11.Content
-	78,  // 54: ddex.mead.v11.Entry.contributor:type_name -> ddex.mead.v11.Person
-	75,  // 55: ddex.mead.v11.Entry.id:type_name -> ddex.mead.v11.Id
-	76,  // 56: ddex.mead.v11.Entry.link:type_name -> ddex.mead.v11.Link
-	72,  // 57: ddex.mead.v11.Entry.published:type_name -> ddex.mead.v11.DateTime
-	80,  // 58: ddex.mead.v11.Entry.rights:type_name -> ddex.mead.v11.Text
-	79,  // 59: ddex.mead.v11.Entry.source:type_name -> ddex.mead.v11.Source
-	80,  // 60: ddex.mead.v11.Entry.summary:type_name -> ddex.mead.v11.Text
-	80,  // 61: ddex.mead.v11.Entry.title:type_name -> ddex.mead.v11.Text
-	72,  // 62: ddex.mead.v11.Entry.updated:type_name -> ddex.mead.v11.DateTime
-	114, // 63: ddex.mead.v11.Flag.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	114, // 64: ddex.mead.v11.Form.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	19,  // 65: ddex.mead.v11.Form.value:type_name -> ddex.mead.v11.FormValue
-	114, // 66: ddex.mead.v11.GenreCategory.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	103, // 67: ddex.mead.v11.GenreCategory.value:type_name -> ddex.mead.v11.GenreCategoryValue
-	141, // 68: ddex.mead.v11.GenreCategory.description:type_name -> ddex.mead.v11.TextWithoutTerritory
-	114, // 69: ddex.mead.v11.Harmony.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	50,  // 70: ddex.mead.v11.Harmony.root_chord_note:type_name -> ddex.mead.v11.RootChordNote
-	51,  // 71: ddex.mead.v11.Harmony.root_chord_quality:type_name -> ddex.mead.v11.RootChordQuality
-	33,  // 72: ddex.mead.v11.Harmony.mode:type_name -> ddex.mead.v11.Mode
-	22,  // 73: ddex.mead.v11.Harmony.modulation:type_name -> ddex.mead.v11.HarmonyModulation
-	50,  // 74: ddex.mead.v11.HarmonyModulation.root_chord_note:type_name -> ddex.mead.v11.RootChordNote
-	51,  // 75: ddex.mead.v11.HarmonyModulation.root_chord_quality:type_name -> ddex.mead.v11.RootChordQuality
-	33,  // 76: ddex.mead.v11.HarmonyModulation.mode:type_name -> ddex.mead.v11.Mode
-	91,  // 77: ddex.mead.v11.ImpactDate.territory_code:type_name -> ddex.mead.v11.CurrentTerritoryCode
-	114, // 78: ddex.mead.v11.Instrument.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	26,  // 79: ddex.mead.v11.Instrument.value:type_name -> ddex.mead.v11.InstrumentValue
-	114, // 80: ddex.mead.v11.InstrumentUsed.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	26,  // 81: ddex.mead.v11.InstrumentUsed.value:type_name -> ddex.mead.v11.InstrumentValue
-	114, // 82: ddex.mead.v11.Intensity.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	28,  // 83: ddex.mead.v11.Intensity.value:type_name -> ddex.mead.v11.IntensityValue
-	114, // 84: ddex.mead.v11.LocationAndDateOfSession.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	138, // 85: ddex.mead.v11.LocationAndDateOfSession.session_type:type_name -> ddex.mead.v11.SessionType
-	122, // 86: ddex.mead.v11.LocationAndDateOfSession.period:type_name -> ddex.mead.v11.Period
-	144, // 87: ddex.mead.v11.LocationAndDateOfSession.venue:type_name -> ddex.mead.v11.Venue
-	140, // 88: ddex.mead.v11.LocationAndDateOfSession.comment:type_name -> ddex.mead.v11.TextWithFormat
-	10,  // 89: ddex.mead.v11.LocationAndDateOfSession.contributor:type_name -> ddex.mead.v11.Contributor
-	114, // 90: ddex.mead.v11.Lyrics.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	31,  // 91: ddex.mead.v11.Lyrics.text:type_name -> ddex.mead.v11.LyricsText
-	125, // 92: ddex.mead.v11.Lyrics.pronunciation:type_name -> ddex.mead.v11.Pronunciation
-	114, // 93: ddex.mead.v11.Mood.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	36,  // 94: ddex.mead.v11.Mood.value:type_name -> ddex.mead.v11.MoodValue
-	141, // 95: ddex.mead.v11.Mood.description:type_name -> ddex.mead.v11.TextWithoutTerritory
-	126, // 96: ddex.mead.v11.Party.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
-	114, // 97: ddex.mead.v11.RecordingPart.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	127, // 98: ddex.mead.v11.RecordingPart.recording_part_type:type_name -> ddex.mead.v11.RecordingPartType
-	6,   // 99: ddex.mead.v11.RecordingPart.comment:type_name -> ddex.mead.v11.Annotation
-	140, // 100: ddex.mead.v11.RecordingPart.usage_information:type_name -> ddex.mead.v11.TextWithFormat
-	114, // 101: ddex.mead.v11.RelatedWork.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	116, // 102: ddex.mead.v11.RelatedWork.work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
-	149, // 103: ddex.mead.v11.RelatedWork.work_title:type_name -> ddex.mead.v11.WorkTitle
-	148, // 104: ddex.mead.v11.RelatedWork.work_relationship_type:type_name -> ddex.mead.v11.WorkRelationshipType
-	119, // 105: ddex.mead.v11.RelatedWork.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	42,  // 106: ddex.mead.v11.ReleaseInformation.release_summary:type_name -> ddex.mead.v11.ReleaseSummary
-	20,  // 107: ddex.mead.v11.ReleaseInformation.genre_category:type_name -> ddex.mead.v11.GenreCategory
-	57,  // 108: ddex.mead.v11.ReleaseInformation.sub_genre_category:type_name -> ddex.mead.v11.SubGenreCategory
-	102, // 109: ddex.mead.v11.ReleaseInformation.focus:type_name -> ddex.mead.v11.Focus
-	35,  // 110: ddex.mead.v11.ReleaseInformation.mood:type_name -> ddex.mead.v11.Mood
-	7,   // 111: ddex.mead.v11.ReleaseInformation.artistic_style:type_name -> ddex.mead.v11.ArtisticStyle
-	59,  // 112: ddex.mead.v11.ReleaseInformation.theme:type_name -> ddex.mead.v11.Theme
-	3,   // 113: ddex.mead.v11.ReleaseInformation.activity:type_name -> ddex.mead.v11.Activity
-	89,  // 114: ddex.mead.v11.ReleaseInformation.commentary_note:type_name -> ddex.mead.v11.CommentaryNote
-	98,  // 115: ddex.mead.v11.ReleaseInformation.epoch:type_name -> ddex.mead.v11.Epoch
-	84,  // 116: ddex.mead.v11.ReleaseInformation.artistic_influence:type_name -> ddex.mead.v11.ArtisticInfluence
-	54,  // 117: ddex.mead.v11.ReleaseInformation.is_similar:type_name -> ddex.mead.v11.SimilarRelease
-	105, // 118: ddex.mead.v11.ReleaseInformation.historic_charting_information:type_name -> ddex.mead.v11.HistoricChartingInformation
-	85,  // 119: ddex.mead.v11.ReleaseInformation.award:type_name -> ddex.mead.v11.Award
-	5,   // 120: ddex.mead.v11.ReleaseInformation.alternative_title:type_name -> ddex.mead.v11.AlternativeTitle
-	106, // 121: ddex.mead.v11.ReleaseInformation.image:type_name -> ddex.mead.v11.Image
-	40,  // 122: ddex.mead.v11.ReleaseInformationList.release_information:type_name -> ddex.mead.v11.ReleaseInformation
-	131, // 123: ddex.mead.v11.ReleaseSummary.release_id:type_name -> ddex.mead.v11.ReleaseId
-	15,  // 124: ddex.mead.v11.ReleaseSummary.display_title:type_name -> ddex.mead.v11.DisplayTitle
-	96,  // 125: ddex.mead.v11.ReleaseSummary.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
-	119, // 126: ddex.mead.v11.ReleaseSummary.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	135, // 127: ddex.mead.v11.RelevantResource.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
-	136, // 128: ddex.mead.v11.RelevantResource.resource_relationship_type:type_name -> ddex.mead.v11.ResourceRelationshipType
-	47,  // 129: ddex.mead.v11.ResourceInformation.resource_summary:type_name -> ddex.mead.v11.ResourceSummary
-	20,  // 130: ddex.mead.v11.ResourceInformation.genre_category:type_name -> ddex.mead.v11.GenreCategory
-	57,  // 131: ddex.mead.v11.ResourceInformation.sub_genre_category:type_name -> ddex.mead.v11.SubGenreCategory
-	18,  // 132: ddex.mead.v11.ResourceInformation.form:type_name -> ddex.mead.v11.Form
-	145, // 133: ddex.mead.v11.ResourceInformation.vocal_register:type_name -> ddex.mead.v11.VocalRegister
-	102, // 134: ddex.mead.v11.ResourceInformation.focus:type_name -> ddex.mead.v11.Focus
-	2,   // 135: ddex.mead.v11.ResourceInformation.absolute_pitch:type_name -> ddex.mead.v11.AbsolutePitch
-	61,  // 136: ddex.mead.v11.ResourceInformation.time_signature:type_name -> ddex.mead.v11.TimeSignature
-	58,  // 137: ddex.mead.v11.ResourceInformation.tempo:type_name -> ddex.mead.v11.TempoValue
-	8,   // 138: ddex.mead.v11.ResourceInformation.beats_per_minute:type_name -> ddex.mead.v11.BeatsPerMinute
-	27,  // 139: ddex.mead.v11.ResourceInformation.intensity:type_name -> ddex.mead.v11.Intensity
-	25,  // 140: ddex.mead.v11.ResourceInformation.instrument_used:type_name -> ddex.mead.v11.InstrumentUsed
-	21,  // 141: ddex.mead.v11.ResourceInformation.harmony:type_name -> ddex.mead.v11.Harmony
-	35,  // 142: ddex.mead.v11.ResourceInformation.mood:type_name -> ddex.mead.v11.Mood
-	11,  // 143: ddex.mead.v11.ResourceInformation.dance_style:type_name -> ddex.mead.v11.DanceStyle
-	48,  // 144: ddex.mead.v11.ResourceInformation.rhythm_style:type_name -> ddex.mead.v11.RhythmStyle
-	7,   // 145: ddex.mead.v11.ResourceInformation.artistic_style:type_name -> ddex.mead.v11.ArtisticStyle
-	59,  // 146: ddex.mead.v11.ResourceInformation.theme:type_name -> ddex.mead.v11.Theme
-	3,   // 147: ddex.mead.v11.ResourceInformation.activity:type_name -> ddex.mead.v11.Activity
-	65,  // 148: ddex.mead.v11.ResourceInformation.used_musical_work:type_name -> ddex.mead.v11.UsedMusicalWork
-	46,  // 149: ddex.mead.v11.ResourceInformation.related_resource:type_name -> ddex.mead.v11.ResourceRelationship
-	30,  // 150: ddex.mead.v11.ResourceInformation.lyrics:type_name -> ddex.mead.v11.Lyrics
-	89,  // 151: ddex.mead.v11.ResourceInformation.commentary_note:type_name -> ddex.mead.v11.CommentaryNote
-	52,  // 152: ddex.mead.v11.ResourceInformation.sample:type_name -> ddex.mead.v11.Sample
-	38,  // 153: ddex.mead.v11.ResourceInformation.recording_part:type_name -> ddex.mead.v11.RecordingPart
-	63,  // 154: ddex.mead.v11.ResourceInformation.usage:type_name -> ddex.mead.v11.Usage
-	23,  // 155: ddex.mead.v11.ResourceInformation.impact_date:type_name -> ddex.mead.v11.ImpactDate
-	88,  // 156: ddex.mead.v11.ResourceInformation.classical_period:type_name -> ddex.mead.v11.ClassicalPeriod
-	98,  // 157: ddex.mead.v11.ResourceInformation.epoch:type_name -> ddex.mead.v11.Epoch
-	84,  // 158: ddex.mead.v11.ResourceInformation.artistic_influence:type_name -> ddex.mead.v11.ArtisticInfluence
-	55,  // 159: ddex.mead.v11.ResourceInformation.is_similar:type_name -> ddex.mead.v11.SimilarResource
-	105, // 160: ddex.mead.v11.ResourceInformation.historic_charting_information:type_name -> ddex.mead.v11.HistoricChartingInformation
-	85,  // 161: ddex.mead.v11.ResourceInformation.award:type_name -> ddex.mead.v11.Award
-	29,  // 162: ddex.mead.v11.ResourceInformation.location_and_date_of_session:type_name -> ddex.mead.v11.LocationAndDateOfSession
-	5,   // 163: ddex.mead.v11.ResourceInformation.alternative_title:type_name -> ddex.mead.v11.AlternativeTitle
-	106, // 164: ddex.mead.v11.ResourceInformation.image:type_name -> ddex.mead.v11.Image
-	17,  // 165: ddex.mead.v11.ResourceInformation.is_original:type_name -> ddex.mead.v11.Flag
-	17,  // 166: ddex.mead.v11.ResourceInformation.is_cover:type_name -> ddex.mead.v11.Flag
-	44,  // 167: ddex.mead.v11.ResourceInformationList.resource_information:type_name -> ddex.mead.v11.ResourceInformation
-	114, // 168: ddex.mead.v11.ResourceRelationship.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	135, // 169: ddex.mead.v11.ResourceRelationship.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
-	129, // 170: ddex.mead.v11.ResourceRelationship.related_resource_type:type_name -> ddex.mead.v11.RelatedResourceType
-	143, // 171: ddex.mead.v11.ResourceRelationship.title:type_name -> ddex.mead.v11.TitleWithPronunciation
-	96,  // 172: ddex.mead.v11.ResourceRelationship.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
-	119, // 173: ddex.mead.v11.ResourceRelationship.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	135, // 174: ddex.mead.v11.ResourceSummary.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
-	15,  // 175: ddex.mead.v11.ResourceSummary.display_title:type_name -> ddex.mead.v11.DisplayTitle
-	96,  // 176: ddex.mead.v11.ResourceSummary.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
-	119, // 177: ddex.mead.v11.ResourceSummary.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	114, // 178: ddex.mead.v11.RhythmStyle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	49,  // 179: ddex.mead.v11.RhythmStyle.value:type_name -> ddex.mead.v11.RhythmStyleValue
-	141, // 180: ddex.mead.v11.RhythmStyle.description:type_name -> ddex.mead.v11.TextWithoutTerritory
-	114, // 181: ddex.mead.v11.Sample.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	47,  // 182: ddex.mead.v11.Sample.related_resource:type_name -> ddex.mead.v11.ResourceSummary
-	53,  // 183: ddex.mead.v11.Sample.sample_feature:type_name -> ddex.mead.v11.SampleFeature
-	140, // 184: ddex.mead.v11.Sample.description:type_name -> ddex.mead.v11.TextWithFormat
-	151, // 185: ddex.mead.v11.Sample.host_timing:type_name -> ddex.mead.v11.Timing
-	151, // 186: ddex.mead.v11.Sample.sample_timing:type_name -> ddex.mead.v11.Timing
-	114, // 187: ddex.mead.v11.SimilarRelease.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	130, // 188: ddex.mead.v11.SimilarRelease.release:type_name -> ddex.mead.v11.Release
-	6,   // 189: ddex.mead.v11.SimilarRelease.description:type_name -> ddex.mead.v11.Annotation
-	114, // 190: ddex.mead.v11.SimilarResource.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	133, // 191: ddex.mead.v11.SimilarResource.resource:type_name -> ddex.mead.v11.Resource
-	6,   // 192: ddex.mead.v11.SimilarResource.description:type_name -> ddex.mead.v11.Annotation
-	114, // 193: ddex.mead.v11.SimilarWork.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	147, // 194: ddex.mead.v11.SimilarWork.work:type_name -> ddex.mead.v11.Work
-	6,   // 195: ddex.mead.v11.SimilarWork.description:type_name -> ddex.mead.v11.Annotation
-	114, // 196: ddex.mead.v11.SubGenreCategory.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	139, // 197: ddex.mead.v11.SubGenreCategory.value:type_name -> ddex.mead.v11.SubGenreCategoryValue
-	114, // 198: ddex.mead.v11.Theme.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	60,  // 199: ddex.mead.v11.Theme.value:type_name -> ddex.mead.v11.ThemeValue
-	141, // 200: ddex.mead.v11.Theme.description:type_name -> ddex.mead.v11.TextWithoutTerritory
-	114, // 201: ddex.mead.v11.TimeSignature.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	62,  // 202: ddex.mead.v11.TimeSignature.modulation:type_name -> ddex.mead.v11.TimeSignatureModulation
-	32,  // 203: ddex.mead.v11.TimeSignature.meter:type_name -> ddex.mead.v11.Meter
-	32,  // 204: ddex.mead.v11.TimeSignatureModulation.meter:type_name -> ddex.mead.v11.Meter
-	114, // 205: ddex.mead.v11.Usage.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	140, // 206: ddex.mead.v11.Usage.description:type_name -> ddex.mead.v11.TextWithFormat
-	43,  // 207: ddex.mead.v11.Usage.relevant_resource:type_name -> ddex.mead.v11.RelevantResource
-	99,  // 208: ddex.mead.v11.Usage.usage_date:type_name -> ddex.mead.v11.EventDate
-	64,  // 209: ddex.mead.v11.Usage.usage_period:type_name -> ddex.mead.v11.UsagePeriod
-	150, // 210: ddex.mead.v11.UsagePeriod.start_date:type_name -> ddex.mead.v11.EventDateWithoutFlags
-	150, // 211: ddex.mead.v11.UsagePeriod.end_date:type_name -> ddex.mead.v11.EventDateWithoutFlags
-	114, // 212: ddex.mead.v11.UsedMusicalWork.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	114, // 213: ddex.mead.v11.WorkHierarchy.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	116, // 214: ddex.mead.v11.WorkHierarchy.work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
-	149, // 215: ddex.mead.v11.WorkHierarchy.work_title:type_name -> ddex.mead.v11.WorkTitle
-	9,   // 216: ddex.mead.v11.WorkHierarchy.child:type_name -> ddex.mead.v11.ChildWorkHierarchy
-	18,  // 217: ddex.mead.v11.WorkHierarchy.form:type_name -> ddex.mead.v11.Form
-	69,  // 218: ddex.mead.v11.WorkInformation.work_summary:type_name -> ddex.mead.v11.WorkSummary
-	20,  // 219: ddex.mead.v11.WorkInformation.genre_category:type_name -> ddex.mead.v11.GenreCategory
-	57,  // 220: ddex.mead.v11.WorkInformation.sub_genre_category:type_name -> ddex.mead.v11.SubGenreCategory
-	18,  // 221: ddex.mead.v11.WorkInformation.form:type_name -> ddex.mead.v11.Form
-	145, // 222: ddex.mead.v11.WorkInformation.vocal_register:type_name -> ddex.mead.v11.VocalRegister
-	102, // 223: ddex.mead.v11.WorkInformation.focus:type_name -> ddex.mead.v11.Focus
-	61,  // 224: ddex.mead.v11.WorkInformation.time_signature:type_name -> ddex.mead.v11.TimeSignature
-	58,  // 225: ddex.mead.v11.WorkInformation.tempo:type_name -> ddex.mead.v11.TempoValue
-	24,  // 226: ddex.mead.v11.WorkInformation.target_instrument:type_name -> ddex.mead.v11.Instrument
-	21,  // 227: ddex.mead.v11.WorkInformation.harmony:type_name -> ddex.mead.v11.Harmony
-	35,  // 228: ddex.mead.v11.WorkInformation.mood:type_name -> ddex.mead.v11.Mood
-	11,  // 229: ddex.mead.v11.WorkInformation.dance_style:type_name -> ddex.mead.v11.DanceStyle
-	48,  // 230: ddex.mead.v11.WorkInformation.rhythm_style:type_name -> ddex.mead.v11.RhythmStyle
-	59,  // 231: ddex.mead.v11.WorkInformation.theme:type_name -> ddex.mead.v11.Theme
-	3,   // 232: ddex.mead.v11.WorkInformation.activity:type_name -> ddex.mead.v11.Activity
-	66,  // 233: ddex.mead.v11.WorkInformation.work_hierarchy:type_name -> ddex.mead.v11.WorkHierarchy
-	39,  // 234: ddex.mead.v11.WorkInformation.related_work:type_name -> ddex.mead.v11.RelatedWork
-	13,  // 235: ddex.mead.v11.WorkInformation.derived_recording:type_name -> ddex.mead.v11.DerivedRecording
-	30,  // 236: ddex.mead.v11.WorkInformation.lyrics:type_name -> ddex.mead.v11.Lyrics
-	89,  // 237: ddex.mead.v11.WorkInformation.commentary_note:type_name -> ddex.mead.v11.CommentaryNote
-	88,  // 238: ddex.mead.v11.WorkInformation.classical_period:type_name -> ddex.mead.v11.ClassicalPeriod
-	98,  // 239: ddex.mead.v11.WorkInformation.epoch:type_name -> ddex.mead.v11.Epoch
-	84,  // 240: ddex.mead.v11.WorkInformation.artistic_influence:type_name -> ddex.mead.v11.ArtisticInfluence
-	56,  // 241: ddex.mead.v11.WorkInformation.is_similar:type_name -> ddex.mead.v11.SimilarWork
-	85,  // 242: ddex.mead.v11.WorkInformation.award:type_name -> ddex.mead.v11.Award
-	5,   // 243: ddex.mead.v11.WorkInformation.alternative_title:type_name -> ddex.mead.v11.AlternativeTitle
-	67,  // 244: ddex.mead.v11.WorkInformationList.work_information:type_name -> ddex.mead.v11.WorkInformation
-	116, // 245: ddex.mead.v11.WorkSummary.musical_work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
-	143, // 246: ddex.mead.v11.WorkSummary.work_title:type_name -> ddex.mead.v11.TitleWithPronunciation
-	119, // 247: ddex.mead.v11.WorkSummary.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	81,  // 248: ddex.mead.v11.Person.uri:type_name -> ddex.mead.v11.URI
-	78,  // 249: ddex.mead.v11.Source.author:type_name -> ddex.mead.v11.Person
-	70,  // 250: ddex.mead.v11.Source.category:type_name -> ddex.mead.v11.Category
-	78,  // 251: ddex.mead.v11.Source.contributor:type_name -> ddex.mead.v11.Person
-	73,  // 252: ddex.mead.v11.Source.generator:type_name -> ddex.mead.v11.Generator
-	74,  // 253: ddex.mead.v11.Source.icon:type_name -> ddex.mead.v11.Icon
-	75,  // 254: ddex.mead.v11.Source.id:type_name -> ddex.mead.v11.Id
-	76,  // 255: ddex.mead.v11.Source.link:type_name -> ddex.mead.v11.Link
-	77,  // 256: ddex.mead.v11.Source.logo:type_name -> ddex.mead.v11.Logo
-	80,  // 257: ddex.mead.v11.Source.rights:type_name -> ddex.mead.v11.Text
-	80,  // 258: ddex.mead.v11.Source.subtitle:type_name -> ddex.mead.v11.Text
-	80,  // 259: ddex.mead.v11.Source.title:type_name -> ddex.mead.v11.Text
-	72,  // 260: ddex.mead.v11.Source.updated:type_name -> ddex.mead.v11.DateTime
-	114, // 261: ddex.mead.v11.ArtisticInfluence.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	119, // 262: ddex.mead.v11.ArtisticInfluence.party:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	147, // 263: ddex.mead.v11.ArtisticInfluence.work:type_name -> ddex.mead.v11.Work
-	133, // 264: ddex.mead.v11.ArtisticInfluence.resource:type_name -> ddex.mead.v11.Resource
-	130, // 265: ddex.mead.v11.ArtisticInfluence.release:type_name -> ddex.mead.v11.Release
-	140, // 266: ddex.mead.v11.ArtisticInfluence.description:type_name -> ddex.mead.v11.TextWithFormat
-	114, // 267: ddex.mead.v11.Award.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	119, // 268: ddex.mead.v11.Award.awarding_body:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	119, // 269: ddex.mead.v11.Award.awarded_party:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	118, // 270: ddex.mead.v11.Award.award_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	99,  // 271: ddex.mead.v11.Award.date:type_name -> ddex.mead.v11.EventDate
-	140, // 272: ddex.mead.v11.Award.comment:type_name -> ddex.mead.v11.TextWithFormat
-	97,  // 273: ddex.mead.v11.ChartEntry.duration:type_name -> ddex.mead.v11.Duration
-	140, // 274: ddex.mead.v11.ChartEntry.comment:type_name -> ddex.mead.v11.TextWithFormat
-	114, // 275: ddex.mead.v11.ClassicalPeriod.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	123, // 276: ddex.mead.v11.ClassicalPeriod.name:type_name -> ddex.mead.v11.PeriodValue
-	114, // 277: ddex.mead.v11.CommentaryNote.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	141, // 278: ddex.mead.v11.CommentaryNote.text:type_name -> ddex.mead.v11.TextWithoutTerritory
-	90,  // 279: ddex.mead.v11.CommentaryNote.commentary_note_type:type_name -> ddex.mead.v11.CommentaryNoteType
-	119, // 280: ddex.mead.v11.CommentaryNote.author:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	104, // 281: ddex.mead.v11.DetailedHashSum.algorithm:type_name -> ddex.mead.v11.HashSumAlgorithmType
-	126, // 282: ddex.mead.v11.DetailedPartyId.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
-	95,  // 283: ddex.mead.v11.DisplayArtistNameWithPronunciation.name:type_name -> ddex.mead.v11.DisplayArtistNameWithDefault
-	125, // 284: ddex.mead.v11.DisplayArtistNameWithPronunciation.pronunciation:type_name -> ddex.mead.v11.Pronunciation
-	114, // 285: ddex.mead.v11.Epoch.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	117, // 286: ddex.mead.v11.Epoch.value:type_name -> ddex.mead.v11.Name
-	119, // 287: ddex.mead.v11.Epoch.related_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	128, // 288: ddex.mead.v11.Epoch.related_creation:type_name -> ddex.mead.v11.RelatedCreation
-	92,  // 289: ddex.mead.v11.Epoch.start_date:type_name -> ddex.mead.v11.Date
-	92,  // 290: ddex.mead.v11.Epoch.end_date:type_name -> ddex.mead.v11.Date
-	93,  // 291: ddex.mead.v11.File.hash_sum:type_name -> ddex.mead.v11.DetailedHashSum
-	114, // 292: ddex.mead.v11.Focus.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	119, // 293: ddex.mead.v11.Focus.party:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	96,  // 294: ddex.mead.v11.Focus.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
-	119, // 295: ddex.mead.v11.Focus.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	119, // 296: ddex.mead.v11.Focus.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	124, // 297: ddex.mead.v11.Focus.period_of_being_focus:type_name -> ddex.mead.v11.PeriodWithTime
-	141, // 298: ddex.mead.v11.Focus.comment:type_name -> ddex.mead.v11.TextWithoutTerritory
-	114, // 299: ddex.mead.v11.HistoricChartingInformation.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	91,  // 300: ddex.mead.v11.HistoricChartingInformation.territory_code:type_name -> ddex.mead.v11.CurrentTerritoryCode
-	118, // 301: ddex.mead.v11.HistoricChartingInformation.chart_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	97,  // 302: ddex.mead.v11.HistoricChartingInformation.duration_in_charts:type_name -> ddex.mead.v11.Duration
-	87,  // 303: ddex.mead.v11.HistoricChartingInformation.chart_entry:type_name -> ddex.mead.v11.ChartEntry
-	140, // 304: ddex.mead.v11.HistoricChartingInformation.comment:type_name -> ddex.mead.v11.TextWithFormat
-	114, // 305: ddex.mead.v11.Image.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	101, // 306: ddex.mead.v11.Image.file:type_name -> ddex.mead.v11.File
-	107, // 307: ddex.mead.v11.Image.image_type:type_name -> ddex.mead.v11.ImageType
-	109, // 308: ddex.mead.v11.MessageAuditTrail.message_audit_trail_event:type_name -> ddex.mead.v11.MessageAuditTrailEvent
-	111, // 309: ddex.mead.v11.MessageAuditTrailEvent.messaging_party_descriptor:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
-	111, // 310: ddex.mead.v11.MessageHeader.message_sender:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
-	111, // 311: ddex.mead.v11.MessageHeader.sent_on_behalf_of:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
-	111, // 312: ddex.mead.v11.MessageHeader.message_recipient:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
-	108, // 313: ddex.mead.v11.MessageHeader.message_audit_trail:type_name -> ddex.mead.v11.MessageAuditTrail
-	121, // 314: ddex.mead.v11.MessagingPartyWithoutCode.party_name:type_name -> ddex.mead.v11.PartyNameWithoutCode
-	115, // 315: ddex.mead.v11.MetadataSource.metadata_source_type:type_name -> ddex.mead.v11.MetadataSourceType
-	94,  // 316: ddex.mead.v11.MetadataSource.party_id:type_name -> ddex.mead.v11.DetailedPartyId
-	120, // 317: ddex.mead.v11.MetadataSource.party_name:type_name -> ddex.mead.v11.PartyNameWithPronunciation
-	112, // 318: ddex.mead.v11.MetadataSourceList.metadata_source:type_name -> ddex.mead.v11.MetadataSource
-	126, // 319: ddex.mead.v11.MusicalWorkIdWithoutFlag.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
-	117, // 320: ddex.mead.v11.NameWithPronunciationAndScriptCode.name:type_name -> ddex.mead.v11.Name
-	125, // 321: ddex.mead.v11.NameWithPronunciationAndScriptCode.pronunciation:type_name -> ddex.mead.v11.Pronunciation
-	94,  // 322: ddex.mead.v11.PartyDescriptorWithPronunciation.party_id:type_name -> ddex.mead.v11.DetailedPartyId
-	120, // 323: ddex.mead.v11.PartyDescriptorWithPronunciation.party_name:type_name -> ddex.mead.v11.PartyNameWithPronunciation
-	118, // 324: ddex.mead.v11.PartyNameWithPronunciation.full_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	118, // 325: ddex.mead.v11.PartyNameWithPronunciation.full_name_ascii_transcribed:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	118, // 326: ddex.mead.v11.PartyNameWithPronunciation.full_name_indexed:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	118, // 327: ddex.mead.v11.PartyNameWithPronunciation.names_before_key_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	118, // 328: ddex.mead.v11.PartyNameWithPronunciation.key_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	118, // 329: ddex.mead.v11.PartyNameWithPronunciation.names_after_key_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	118, // 330: ddex.mead.v11.PartyNameWithPronunciation.abbreviated_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
-	99,  // 331: ddex.mead.v11.Period.start_date:type_name -> ddex.mead.v11.EventDate
-	99,  // 332: ddex.mead.v11.Period.end_date:type_name -> ddex.mead.v11.EventDate
-	100, // 333: ddex.mead.v11.Period.start_date_time:type_name -> ddex.mead.v11.EventDateTime
-	100, // 334: ddex.mead.v11.Period.end_date_time:type_name -> ddex.mead.v11.EventDateTime
-	143, // 335: ddex.mead.v11.RelatedCreation.title:type_name -> ddex.mead.v11.TitleWithPronunciation
-	131, // 336: ddex.mead.v11.RelatedCreation.release_id:type_name -> ddex.mead.v11.ReleaseId
-	135, // 337: ddex.mead.v11.RelatedCreation.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
-	116, // 338: ddex.mead.v11.RelatedCreation.musical_work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
-	132, // 339: ddex.mead.v11.Release.release_title:type_name -> ddex.mead.v11.ReleaseTitle
-	96,  // 340: ddex.mead.v11.Release.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
-	119, // 341: ddex.mead.v11.Release.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	86,  // 342: ddex.mead.v11.ReleaseId.catalog_number:type_name -> ddex.mead.v11.CatalogNumber
-	126, // 343: ddex.mead.v11.ReleaseId.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
-	137, // 344: ddex.mead.v11.Resource.resource_title:type_name -> ddex.mead.v11.ResourceTitle
-	96,  // 345: ddex.mead.v11.Resource.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
-	119, // 346: ddex.mead.v11.Resource.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	86,  // 347: ddex.mead.v11.ResourceIdWithoutFlag.catalog_number:type_name -> ddex.mead.v11.CatalogNumber
-	126, // 348: ddex.mead.v11.ResourceIdWithoutFlag.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
-	125, // 349: ddex.mead.v11.TitleText.pronunciation:type_name -> ddex.mead.v11.Pronunciation
-	142, // 350: ddex.mead.v11.TitleWithPronunciation.title_text:type_name -> ddex.mead.v11.TitleText
-	142, // 351: ddex.mead.v11.TitleWithPronunciation.sub_title:type_name -> ddex.mead.v11.TitleText
-	82,  // 352: ddex.mead.v11.Venue.territory_code:type_name -> ddex.mead.v11.AllTerritoryCode
-	114, // 353: ddex.mead.v11.VocalRegister.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
-	146, // 354: ddex.mead.v11.VocalRegister.value:type_name -> ddex.mead.v11.VocalRegisterValue
-	149, // 355: ddex.mead.v11.Work.work_title:type_name -> ddex.mead.v11.WorkTitle
-	119, // 356: ddex.mead.v11.Work.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
-	357, // [357:357] is the sub-list for method output_type
-	357, // [357:357] is the sub-list for method input_type
-	357, // [357:357] is the sub-list for extension type_name
-	357, // [357:357] is the sub-list for extension extendee
-	0,   // [0:357] is the sub-list for field type_name
+	152, // 18: ddex.mead.v11.Feed.any_element:type_name -> ddex.mead.v11.AnyElement
+	114, // 19: ddex.mead.v11.AbsolutePitch.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	34,  // 20: ddex.mead.v11.AbsolutePitch.modulation:type_name -> ddex.mead.v11.Modulation
+	114, // 21: ddex.mead.v11.Activity.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	4,   // 22: ddex.mead.v11.Activity.value:type_name -> ddex.mead.v11.ActivityValue
+	141, // 23: ddex.mead.v11.Activity.description:type_name -> ddex.mead.v11.TextWithoutTerritory
+	114, // 24: ddex.mead.v11.AlternativeTitle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	142, // 25: ddex.mead.v11.AlternativeTitle.title_text:type_name -> ddex.mead.v11.TitleText
+	142, // 26: ddex.mead.v11.AlternativeTitle.sub_title:type_name -> ddex.mead.v11.TitleText
+	114, // 27: ddex.mead.v11.Annotation.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	140, // 28: ddex.mead.v11.Annotation.text:type_name -> ddex.mead.v11.TextWithFormat
+	114, // 29: ddex.mead.v11.ArtisticStyle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	83,  // 30: ddex.mead.v11.ArtisticStyle.value:type_name -> ddex.mead.v11.ArtistTypeValue
+	114, // 31: ddex.mead.v11.BeatsPerMinute.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	34,  // 32: ddex.mead.v11.BeatsPerMinute.modulation:type_name -> ddex.mead.v11.Modulation
+	116, // 33: ddex.mead.v11.ChildWorkHierarchy.work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
+	149, // 34: ddex.mead.v11.ChildWorkHierarchy.work_title:type_name -> ddex.mead.v11.WorkTitle
+	9,   // 35: ddex.mead.v11.ChildWorkHierarchy.child:type_name -> ddex.mead.v11.ChildWorkHierarchy
+	18,  // 36: ddex.mead.v11.ChildWorkHierarchy.form:type_name -> ddex.mead.v11.Form
+	94,  // 37: ddex.mead.v11.Contributor.identifier:type_name -> ddex.mead.v11.DetailedPartyId
+	120, // 38: ddex.mead.v11.Contributor.name:type_name -> ddex.mead.v11.PartyNameWithPronunciation
+	134, // 39: ddex.mead.v11.Contributor.role:type_name -> ddex.mead.v11.ResourceContributorRole
+	114, // 40: ddex.mead.v11.DanceStyle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	12,  // 41: ddex.mead.v11.DanceStyle.value:type_name -> ddex.mead.v11.DanceStyleValue
+	141, // 42: ddex.mead.v11.DanceStyle.description:type_name -> ddex.mead.v11.TextWithoutTerritory
+	114, // 43: ddex.mead.v11.DerivedRecording.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	135, // 44: ddex.mead.v11.DerivedRecording.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
+	129, // 45: ddex.mead.v11.DerivedRecording.related_resource_type:type_name -> ddex.mead.v11.RelatedResourceType
+	143, // 46: ddex.mead.v11.DerivedRecording.title:type_name -> ddex.mead.v11.TitleWithPronunciation
+	96,  // 47: ddex.mead.v11.DerivedRecording.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
+	119, // 48: ddex.mead.v11.DerivedRecording.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	125, // 49: ddex.mead.v11.DisplaySubTitle.pronunciation:type_name -> ddex.mead.v11.Pronunciation
+	142, // 50: ddex.mead.v11.DisplayTitle.title_text:type_name -> ddex.mead.v11.TitleText
+	14,  // 51: ddex.mead.v11.DisplayTitle.sub_title:type_name -> ddex.mead.v11.DisplaySubTitle
+	78,  // 52: ddex.mead.v11.Entry.author:type_name -> ddex.mead.v11.Person
+	70,  // 53: ddex.mead.v11.Entry.category:type_name -> ddex.mead.v11.Category
+	71,  // 54: ddex.mead.v11.Entry.content:type_name -> ddex.mead.v11.Content
+	78,  // 55: ddex.mead.v11.Entry.contributor:type_name -> ddex.mead.v11.Person
+	75,  // 56: ddex.mead.v11.Entry.id:type_name -> ddex.mead.v11.Id
+	76,  // 57: ddex.mead.v11.Entry.link:type_name -> ddex.mead.v11.Link
+	72,  // 58: ddex.mead.v11.Entry.published:type_name -> ddex.mead.v11.DateTime
+	80,  // 59: ddex.mead.v11.Entry.rights:type_name -> ddex.mead.v11.Text
+	79,  // 60: ddex.mead.v11.Entry.source:type_name -> ddex.mead.v11.Source
+	80,  // 61: ddex.mead.v11.Entry.summary:type_name -> ddex.mead.v11.Text
+	80,  // 62: ddex.mead.v11.Entry.title:type_name -> ddex.mead.v11.Text
+	72,  // 63: ddex.mead.v11.Entry.updated:type_name -> ddex.mead.v11.DateTime
+	147, // 64: ddex.mead.v11.Entry.work:type_name -> ddex.mead.v11.Work
+	133, // 65: ddex.mead.v11.Entry.resource:type_name -> ddex.mead.v11.Resource
+	130, // 66: ddex.mead.v11.Entry.release:type_name -> ddex.mead.v11.Release
+	37,  // 67: ddex.mead.v11.Entry.party:type_name -> ddex.mead.v11.Party
+	114, // 68: ddex.mead.v11.Flag.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	114, // 69: ddex.mead.v11.Form.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	19,  // 70: ddex.mead.v11.Form.value:type_name -> ddex.mead.v11.FormValue
+	114, // 71: ddex.mead.v11.GenreCategory.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	103, // 72: ddex.mead.v11.GenreCategory.value:type_name -> ddex.mead.v11.GenreCategoryValue
+	141, // 73: ddex.mead.v11.GenreCategory.description:type_name -> ddex.mead.v11.TextWithoutTerritory
+	114, // 74: ddex.mead.v11.Harmony.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	50,  // 75: ddex.mead.v11.Harmony.root_chord_note:type_name -> ddex.mead.v11.RootChordNote
+	51,  // 76: ddex.mead.v11.Harmony.root_chord_quality:type_name -> ddex.mead.v11.RootChordQuality
+	33,  // 77: ddex.mead.v11.Harmony.mode:type_name -> ddex.mead.v11.Mode
+	22,  // 78: ddex.mead.v11.Harmony.modulation:type_name -> ddex.mead.v11.HarmonyModulation
+	50,  // 79: ddex.mead.v11.HarmonyModulation.root_chord_note:type_name -> ddex.mead.v11.RootChordNote
+	51,  // 80: ddex.mead.v11.HarmonyModulation.root_chord_quality:type_name -> ddex.mead.v11.RootChordQuality
+	33,  // 81: ddex.mead.v11.HarmonyModulation.mode:type_name -> ddex.mead.v11.Mode
+	91,  // 82: ddex.mead.v11.ImpactDate.territory_code:type_name -> ddex.mead.v11.CurrentTerritoryCode
+	114, // 83: ddex.mead.v11.Instrument.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	26,  // 84: ddex.mead.v11.Instrument.value:type_name -> ddex.mead.v11.InstrumentValue
+	114, // 85: ddex.mead.v11.InstrumentUsed.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	26,  // 86: ddex.mead.v11.InstrumentUsed.value:type_name -> ddex.mead.v11.InstrumentValue
+	114, // 87: ddex.mead.v11.Intensity.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	28,  // 88: ddex.mead.v11.Intensity.value:type_name -> ddex.mead.v11.IntensityValue
+	114, // 89: ddex.mead.v11.LocationAndDateOfSession.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	138, // 90: ddex.mead.v11.LocationAndDateOfSession.session_type:type_name -> ddex.mead.v11.SessionType
+	122, // 91: ddex.mead.v11.LocationAndDateOfSession.period:type_name -> ddex.mead.v11.Period
+	144, // 92: ddex.mead.v11.LocationAndDateOfSession.venue:type_name -> ddex.mead.v11.Venue
+	140, // 93: ddex.mead.v11.LocationAndDateOfSession.comment:type_name -> ddex.mead.v11.TextWithFormat
+	10,  // 94: ddex.mead.v11.LocationAndDateOfSession.contributor:type_name -> ddex.mead.v11.Contributor
+	114, // 95: ddex.mead.v11.Lyrics.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	31,  // 96: ddex.mead.v11.Lyrics.text:type_name -> ddex.mead.v11.LyricsText
+	125, // 97: ddex.mead.v11.Lyrics.pronunciation:type_name -> ddex.mead.v11.Pronunciation
+	114, // 98: ddex.mead.v11.Mood.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	36,  // 99: ddex.mead.v11.Mood.value:type_name -> ddex.mead.v11.MoodValue
+	141, // 100: ddex.mead.v11.Mood.description:type_name -> ddex.mead.v11.TextWithoutTerritory
+	126, // 101: ddex.mead.v11.Party.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
+	114, // 102: ddex.mead.v11.RecordingPart.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	127, // 103: ddex.mead.v11.RecordingPart.recording_part_type:type_name -> ddex.mead.v11.RecordingPartType
+	6,   // 104: ddex.mead.v11.RecordingPart.comment:type_name -> ddex.mead.v11.Annotation
+	140, // 105: ddex.mead.v11.RecordingPart.usage_information:type_name -> ddex.mead.v11.TextWithFormat
+	114, // 106: ddex.mead.v11.RelatedWork.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	116, // 107: ddex.mead.v11.RelatedWork.work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
+	149, // 108: ddex.mead.v11.RelatedWork.work_title:type_name -> ddex.mead.v11.WorkTitle
+	148, // 109: ddex.mead.v11.RelatedWork.work_relationship_type:type_name -> ddex.mead.v11.WorkRelationshipType
+	119, // 110: ddex.mead.v11.RelatedWork.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	42,  // 111: ddex.mead.v11.ReleaseInformation.release_summary:type_name -> ddex.mead.v11.ReleaseSummary
+	20,  // 112: ddex.mead.v11.ReleaseInformation.genre_category:type_name -> ddex.mead.v11.GenreCategory
+	57,  // 113: ddex.mead.v11.ReleaseInformation.sub_genre_category:type_name -> ddex.mead.v11.SubGenreCategory
+	102, // 114: ddex.mead.v11.ReleaseInformation.focus:type_name -> ddex.mead.v11.Focus
+	35,  // 115: ddex.mead.v11.ReleaseInformation.mood:type_name -> ddex.mead.v11.Mood
+	7,   // 116: ddex.mead.v11.ReleaseInformation.artistic_style:type_name -> ddex.mead.v11.ArtisticStyle
+	59,  // 117: ddex.mead.v11.ReleaseInformation.theme:type_name -> ddex.mead.v11.Theme
+	3,   // 118: ddex.mead.v11.ReleaseInformation.activity:type_name -> ddex.mead.v11.Activity
+	89,  // 119: ddex.mead.v11.ReleaseInformation.commentary_note:type_name -> ddex.mead.v11.CommentaryNote
+	98,  // 120: ddex.mead.v11.ReleaseInformation.epoch:type_name -> ddex.mead.v11.Epoch
+	84,  // 121: ddex.mead.v11.ReleaseInformation.artistic_influence:type_name -> ddex.mead.v11.ArtisticInfluence
+	54,  // 122: ddex.mead.v11.ReleaseInformation.is_similar:type_name -> ddex.mead.v11.SimilarRelease
+	105, // 123: ddex.mead.v11.ReleaseInformation.historic_charting_information:type_name -> ddex.mead.v11.HistoricChartingInformation
+	85,  // 124: ddex.mead.v11.ReleaseInformation.award:type_name -> ddex.mead.v11.Award
+	5,   // 125: ddex.mead.v11.ReleaseInformation.alternative_title:type_name -> ddex.mead.v11.AlternativeTitle
+	106, // 126: ddex.mead.v11.ReleaseInformation.image:type_name -> ddex.mead.v11.Image
+	152, // 127: ddex.mead.v11.ReleaseInformation.any_element:type_name -> ddex.mead.v11.AnyElement
+	40,  // 128: ddex.mead.v11.ReleaseInformationList.release_information:type_name -> ddex.mead.v11.ReleaseInformation
+	131, // 129: ddex.mead.v11.ReleaseSummary.release_id:type_name -> ddex.mead.v11.ReleaseId
+	15,  // 130: ddex.mead.v11.ReleaseSummary.display_title:type_name -> ddex.mead.v11.DisplayTitle
+	96,  // 131: ddex.mead.v11.ReleaseSummary.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
+	119, // 132: ddex.mead.v11.ReleaseSummary.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	135, // 133: ddex.mead.v11.RelevantResource.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
+	136, // 134: ddex.mead.v11.RelevantResource.resource_relationship_type:type_name -> ddex.mead.v11.ResourceRelationshipType
+	47,  // 135: ddex.mead.v11.ResourceInformation.resource_summary:type_name -> ddex.mead.v11.ResourceSummary
+	20,  // 136: ddex.mead.v11.ResourceInformation.genre_category:type_name -> ddex.mead.v11.GenreCategory
+	57,  // 137: ddex.mead.v11.ResourceInformation.sub_genre_category:type_name -> ddex.mead.v11.SubGenreCategory
+	18,  // 138: ddex.mead.v11.ResourceInformation.form:type_name -> ddex.mead.v11.Form
+	145, // 139: ddex.mead.v11.ResourceInformation.vocal_register:type_name -> ddex.mead.v11.VocalRegister
+	102, // 140: ddex.mead.v11.ResourceInformation.focus:type_name -> ddex.mead.v11.Focus
+	2,   // 141: ddex.mead.v11.ResourceInformation.absolute_pitch:type_name -> ddex.mead.v11.AbsolutePitch
+	61,  // 142: ddex.mead.v11.ResourceInformation.time_signature:type_name -> ddex.mead.v11.TimeSignature
+	58,  // 143: ddex.mead.v11.ResourceInformation.tempo:type_name -> ddex.mead.v11.TempoValue
+	8,   // 144: ddex.mead.v11.ResourceInformation.beats_per_minute:type_name -> ddex.mead.v11.BeatsPerMinute
+	27,  // 145: ddex.mead.v11.ResourceInformation.intensity:type_name -> ddex.mead.v11.Intensity
+	25,  // 146: ddex.mead.v11.ResourceInformation.instrument_used:type_name -> ddex.mead.v11.InstrumentUsed
+	21,  // 147: ddex.mead.v11.ResourceInformation.harmony:type_name -> ddex.mead.v11.Harmony
+	17,  // 148: ddex.mead.v11.ResourceInformation.is_original:type_name -> ddex.mead.v11.Flag
+	17,  // 149: ddex.mead.v11.ResourceInformation.is_cover:type_name -> ddex.mead.v11.Flag
+	35,  // 150: ddex.mead.v11.ResourceInformation.mood:type_name -> ddex.mead.v11.Mood
+	11,  // 151: ddex.mead.v11.ResourceInformation.dance_style:type_name -> ddex.mead.v11.DanceStyle
+	48,  // 152: ddex.mead.v11.ResourceInformation.rhythm_style:type_name -> ddex.mead.v11.RhythmStyle
+	7,   // 153: ddex.mead.v11.ResourceInformation.artistic_style:type_name -> ddex.mead.v11.ArtisticStyle
+	59,  // 154: ddex.mead.v11.ResourceInformation.theme:type_name -> ddex.mead.v11.Theme
+	3,   // 155: ddex.mead.v11.ResourceInformation.activity:type_name -> ddex.mead.v11.Activity
+	65,  // 156: ddex.mead.v11.ResourceInformation.used_musical_work:type_name -> ddex.mead.v11.UsedMusicalWork
+	46,  // 157: ddex.mead.v11.ResourceInformation.related_resource:type_name -> ddex.mead.v11.ResourceRelationship
+	30,  // 158: ddex.mead.v11.ResourceInformation.lyrics:type_name -> ddex.mead.v11.Lyrics
+	89,  // 159: ddex.mead.v11.ResourceInformation.commentary_note:type_name -> ddex.mead.v11.CommentaryNote
+	52,  // 160: ddex.mead.v11.ResourceInformation.sample:type_name -> ddex.mead.v11.Sample
+	38,  // 161: ddex.mead.v11.ResourceInformation.recording_part:type_name -> ddex.mead.v11.RecordingPart
+	63,  // 162: ddex.mead.v11.ResourceInformation.usage:type_name -> ddex.mead.v11.Usage
+	23,  // 163: ddex.mead.v11.ResourceInformation.impact_date:type_name -> ddex.mead.v11.ImpactDate
+	88,  // 164: ddex.mead.v11.ResourceInformation.classical_period:type_name -> ddex.mead.v11.ClassicalPeriod
+	98,  // 165: ddex.mead.v11.ResourceInformation.epoch:type_name -> ddex.mead.v11.Epoch
+	84,  // 166: ddex.mead.v11.ResourceInformation.artistic_influence:type_name -> ddex.mead.v11.ArtisticInfluence
+	55,  // 167: ddex.mead.v11.ResourceInformation.is_similar:type_name -> ddex.mead.v11.SimilarResource
+	105, // 168: ddex.mead.v11.ResourceInformation.historic_charting_information:type_name -> ddex.mead.v11.HistoricChartingInformation
+	85,  // 169: ddex.mead.v11.ResourceInformation.award:type_name -> ddex.mead.v11.Award
+	29,  // 170: ddex.mead.v11.ResourceInformation.location_and_date_of_session:type_name -> ddex.mead.v11.LocationAndDateOfSession
+	5,   // 171: ddex.mead.v11.ResourceInformation.alternative_title:type_name -> ddex.mead.v11.AlternativeTitle
+	106, // 172: ddex.mead.v11.ResourceInformation.image:type_name -> ddex.mead.v11.Image
+	152, // 173: ddex.mead.v11.ResourceInformation.any_element:type_name -> ddex.mead.v11.AnyElement
+	44,  // 174: ddex.mead.v11.ResourceInformationList.resource_information:type_name -> ddex.mead.v11.ResourceInformation
+	114, // 175: ddex.mead.v11.ResourceRelationship.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	135, // 176: ddex.mead.v11.ResourceRelationship.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
+	129, // 177: ddex.mead.v11.ResourceRelationship.related_resource_type:type_name -> ddex.mead.v11.RelatedResourceType
+	143, // 178: ddex.mead.v11.ResourceRelationship.title:type_name -> ddex.mead.v11.TitleWithPronunciation
+	96,  // 179: ddex.mead.v11.ResourceRelationship.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
+	119, // 180: ddex.mead.v11.ResourceRelationship.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	135, // 181: ddex.mead.v11.ResourceSummary.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
+	15,  // 182: ddex.mead.v11.ResourceSummary.display_title:type_name -> ddex.mead.v11.DisplayTitle
+	96,  // 183: ddex.mead.v11.ResourceSummary.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
+	119, // 184: ddex.mead.v11.ResourceSummary.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	114, // 185: ddex.mead.v11.RhythmStyle.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	49,  // 186: ddex.mead.v11.RhythmStyle.value:type_name -> ddex.mead.v11.RhythmStyleValue
+	141, // 187: ddex.mead.v11.RhythmStyle.description:type_name -> ddex.mead.v11.TextWithoutTerritory
+	114, // 188: ddex.mead.v11.Sample.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	47,  // 189: ddex.mead.v11.Sample.related_resource:type_name -> ddex.mead.v11.ResourceSummary
+	53,  // 190: ddex.mead.v11.Sample.sample_feature:type_name -> ddex.mead.v11.SampleFeature
+	151, // 191: ddex.mead.v11.Sample.host_timing:type_name -> ddex.mead.v11.Timing
+	151, // 192: ddex.mead.v11.Sample.sample_timing:type_name -> ddex.mead.v11.Timing
+	140, // 193: ddex.mead.v11.Sample.description:type_name -> ddex.mead.v11.TextWithFormat
+	114, // 194: ddex.mead.v11.SimilarRelease.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	130, // 195: ddex.mead.v11.SimilarRelease.release:type_name -> ddex.mead.v11.Release
+	6,   // 196: ddex.mead.v11.SimilarRelease.description:type_name -> ddex.mead.v11.Annotation
+	114, // 197: ddex.mead.v11.SimilarResource.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	133, // 198: ddex.mead.v11.SimilarResource.resource:type_name -> ddex.mead.v11.Resource
+	6,   // 199: ddex.mead.v11.SimilarResource.description:type_name -> ddex.mead.v11.Annotation
+	114, // 200: ddex.mead.v11.SimilarWork.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	147, // 201: ddex.mead.v11.SimilarWork.work:type_name -> ddex.mead.v11.Work
+	6,   // 202: ddex.mead.v11.SimilarWork.description:type_name -> ddex.mead.v11.Annotation
+	114, // 203: ddex.mead.v11.SubGenreCategory.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	139, // 204: ddex.mead.v11.SubGenreCategory.value:type_name -> ddex.mead.v11.SubGenreCategoryValue
+	114, // 205: ddex.mead.v11.Theme.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	60,  // 206: ddex.mead.v11.Theme.value:type_name -> ddex.mead.v11.ThemeValue
+	141, // 207: ddex.mead.v11.Theme.description:type_name -> ddex.mead.v11.TextWithoutTerritory
+	114, // 208: ddex.mead.v11.TimeSignature.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	32,  // 209: ddex.mead.v11.TimeSignature.meter:type_name -> ddex.mead.v11.Meter
+	62,  // 210: ddex.mead.v11.TimeSignature.modulation:type_name -> ddex.mead.v11.TimeSignatureModulation
+	32,  // 211: ddex.mead.v11.TimeSignatureModulation.meter:type_name -> ddex.mead.v11.Meter
+	114, // 212: ddex.mead.v11.Usage.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	99,  // 213: ddex.mead.v11.Usage.usage_date:type_name -> ddex.mead.v11.EventDate
+	64,  // 214: ddex.mead.v11.Usage.usage_period:type_name -> ddex.mead.v11.UsagePeriod
+	140, // 215: ddex.mead.v11.Usage.description:type_name -> ddex.mead.v11.TextWithFormat
+	43,  // 216: ddex.mead.v11.Usage.relevant_resource:type_name -> ddex.mead.v11.RelevantResource
+	150, // 217: ddex.mead.v11.UsagePeriod.start_date:type_name -> ddex.mead.v11.EventDateWithoutFlags
+	150, // 218: ddex.mead.v11.UsagePeriod.end_date:type_name -> ddex.mead.v11.EventDateWithoutFlags
+	114, // 219: ddex.mead.v11.UsedMusicalWork.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	114, // 220: ddex.mead.v11.WorkHierarchy.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	116, // 221: ddex.mead.v11.WorkHierarchy.work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
+	149, // 222: ddex.mead.v11.WorkHierarchy.work_title:type_name -> ddex.mead.v11.WorkTitle
+	9,   // 223: ddex.mead.v11.WorkHierarchy.child:type_name -> ddex.mead.v11.ChildWorkHierarchy
+	18,  // 224: ddex.mead.v11.WorkHierarchy.form:type_name -> ddex.mead.v11.Form
+	69,  // 225: ddex.mead.v11.WorkInformation.work_summary:type_name -> ddex.mead.v11.WorkSummary
+	20,  // 226: ddex.mead.v11.WorkInformation.genre_category:type_name -> ddex.mead.v11.GenreCategory
+	57,  // 227: ddex.mead.v11.WorkInformation.sub_genre_category:type_name -> ddex.mead.v11.SubGenreCategory
+	18,  // 228: ddex.mead.v11.WorkInformation.form:type_name -> ddex.mead.v11.Form
+	145, // 229: ddex.mead.v11.WorkInformation.vocal_register:type_name -> ddex.mead.v11.VocalRegister
+	102, // 230: ddex.mead.v11.WorkInformation.focus:type_name -> ddex.mead.v11.Focus
+	61,  // 231: ddex.mead.v11.WorkInformation.time_signature:type_name -> ddex.mead.v11.TimeSignature
+	58,  // 232: ddex.mead.v11.WorkInformation.tempo:type_name -> ddex.mead.v11.TempoValue
+	24,  // 233: ddex.mead.v11.WorkInformation.target_instrument:type_name -> ddex.mead.v11.Instrument
+	21,  // 234: ddex.mead.v11.WorkInformation.harmony:type_name -> ddex.mead.v11.Harmony
+	35,  // 235: ddex.mead.v11.WorkInformation.mood:type_name -> ddex.mead.v11.Mood
+	11,  // 236: ddex.mead.v11.WorkInformation.dance_style:type_name -> ddex.mead.v11.DanceStyle
+	48,  // 237: ddex.mead.v11.WorkInformation.rhythm_style:type_name -> ddex.mead.v11.RhythmStyle
+	59,  // 238: ddex.mead.v11.WorkInformation.theme:type_name -> ddex.mead.v11.Theme
+	3,   // 239: ddex.mead.v11.WorkInformation.activity:type_name -> ddex.mead.v11.Activity
+	66,  // 240: ddex.mead.v11.WorkInformation.work_hierarchy:type_name -> ddex.mead.v11.WorkHierarchy
+	39,  // 241: ddex.mead.v11.WorkInformation.related_work:type_name -> ddex.mead.v11.RelatedWork
+	13,  // 242: ddex.mead.v11.WorkInformation.derived_recording:type_name -> ddex.mead.v11.DerivedRecording
+	30,  // 243: ddex.mead.v11.WorkInformation.lyrics:type_name -> ddex.mead.v11.Lyrics
+	89,  // 244: ddex.mead.v11.WorkInformation.commentary_note:type_name -> ddex.mead.v11.CommentaryNote
+	88,  // 245: ddex.mead.v11.WorkInformation.classical_period:type_name -> ddex.mead.v11.ClassicalPeriod
+	98,  // 246: ddex.mead.v11.WorkInformation.epoch:type_name -> ddex.mead.v11.Epoch
+	84,  // 247: ddex.mead.v11.WorkInformation.artistic_influence:type_name -> ddex.mead.v11.ArtisticInfluence
+	56,  // 248: ddex.mead.v11.WorkInformation.is_similar:type_name -> ddex.mead.v11.SimilarWork
+	85,  // 249: ddex.mead.v11.WorkInformation.award:type_name -> ddex.mead.v11.Award
+	5,   // 250: ddex.mead.v11.WorkInformation.alternative_title:type_name -> ddex.mead.v11.AlternativeTitle
+	152, // 251: ddex.mead.v11.WorkInformation.any_element:type_name -> ddex.mead.v11.AnyElement
+	67,  // 252: ddex.mead.v11.WorkInformationList.work_information:type_name -> ddex.mead.v11.WorkInformation
+	116, // 253: ddex.mead.v11.WorkSummary.musical_work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
+	143, // 254: ddex.mead.v11.WorkSummary.work_title:type_name -> ddex.mead.v11.TitleWithPronunciation
+	119, // 255: ddex.mead.v11.WorkSummary.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	152, // 256: ddex.mead.v11.Content.any_element:type_name -> ddex.mead.v11.AnyElement
+	81,  // 257: ddex.mead.v11.Person.uri:type_name -> ddex.mead.v11.URI
+	152, // 258: ddex.mead.v11.Person.any_element:type_name -> ddex.mead.v11.AnyElement
+	78,  // 259: ddex.mead.v11.Source.author:type_name -> ddex.mead.v11.Person
+	70,  // 260: ddex.mead.v11.Source.category:type_name -> ddex.mead.v11.Category
+	78,  // 261: ddex.mead.v11.Source.contributor:type_name -> ddex.mead.v11.Person
+	73,  // 262: ddex.mead.v11.Source.generator:type_name -> ddex.mead.v11.Generator
+	74,  // 263: ddex.mead.v11.Source.icon:type_name -> ddex.mead.v11.Icon
+	75,  // 264: ddex.mead.v11.Source.id:type_name -> ddex.mead.v11.Id
+	76,  // 265: ddex.mead.v11.Source.link:type_name -> ddex.mead.v11.Link
+	77,  // 266: ddex.mead.v11.Source.logo:type_name -> ddex.mead.v11.Logo
+	80,  // 267: ddex.mead.v11.Source.rights:type_name -> ddex.mead.v11.Text
+	80,  // 268: ddex.mead.v11.Source.subtitle:type_name -> ddex.mead.v11.Text
+	80,  // 269: ddex.mead.v11.Source.title:type_name -> ddex.mead.v11.Text
+	72,  // 270: ddex.mead.v11.Source.updated:type_name -> ddex.mead.v11.DateTime
+	152, // 271: ddex.mead.v11.Source.any_element:type_name -> ddex.mead.v11.AnyElement
+	152, // 272: ddex.mead.v11.Text.any_element:type_name -> ddex.mead.v11.AnyElement
+	114, // 273: ddex.mead.v11.ArtisticInfluence.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	119, // 274: ddex.mead.v11.ArtisticInfluence.party:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	147, // 275: ddex.mead.v11.ArtisticInfluence.work:type_name -> ddex.mead.v11.Work
+	133, // 276: ddex.mead.v11.ArtisticInfluence.resource:type_name -> ddex.mead.v11.Resource
+	130, // 277: ddex.mead.v11.ArtisticInfluence.release:type_name -> ddex.mead.v11.Release
+	140, // 278: ddex.mead.v11.ArtisticInfluence.description:type_name -> ddex.mead.v11.TextWithFormat
+	114, // 279: ddex.mead.v11.Award.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	119, // 280: ddex.mead.v11.Award.awarding_body:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	119, // 281: ddex.mead.v11.Award.awarded_party:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	118, // 282: ddex.mead.v11.Award.award_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	99,  // 283: ddex.mead.v11.Award.date:type_name -> ddex.mead.v11.EventDate
+	140, // 284: ddex.mead.v11.Award.comment:type_name -> ddex.mead.v11.TextWithFormat
+	97,  // 285: ddex.mead.v11.ChartEntry.duration:type_name -> ddex.mead.v11.Duration
+	140, // 286: ddex.mead.v11.ChartEntry.comment:type_name -> ddex.mead.v11.TextWithFormat
+	114, // 287: ddex.mead.v11.ClassicalPeriod.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	123, // 288: ddex.mead.v11.ClassicalPeriod.name:type_name -> ddex.mead.v11.PeriodValue
+	114, // 289: ddex.mead.v11.CommentaryNote.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	141, // 290: ddex.mead.v11.CommentaryNote.text:type_name -> ddex.mead.v11.TextWithoutTerritory
+	90,  // 291: ddex.mead.v11.CommentaryNote.commentary_note_type:type_name -> ddex.mead.v11.CommentaryNoteType
+	119, // 292: ddex.mead.v11.CommentaryNote.author:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	104, // 293: ddex.mead.v11.DetailedHashSum.algorithm:type_name -> ddex.mead.v11.HashSumAlgorithmType
+	126, // 294: ddex.mead.v11.DetailedPartyId.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
+	95,  // 295: ddex.mead.v11.DisplayArtistNameWithPronunciation.name:type_name -> ddex.mead.v11.DisplayArtistNameWithDefault
+	125, // 296: ddex.mead.v11.DisplayArtistNameWithPronunciation.pronunciation:type_name -> ddex.mead.v11.Pronunciation
+	114, // 297: ddex.mead.v11.Epoch.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	117, // 298: ddex.mead.v11.Epoch.value:type_name -> ddex.mead.v11.Name
+	119, // 299: ddex.mead.v11.Epoch.related_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	128, // 300: ddex.mead.v11.Epoch.related_creation:type_name -> ddex.mead.v11.RelatedCreation
+	92,  // 301: ddex.mead.v11.Epoch.start_date:type_name -> ddex.mead.v11.Date
+	92,  // 302: ddex.mead.v11.Epoch.end_date:type_name -> ddex.mead.v11.Date
+	93,  // 303: ddex.mead.v11.File.hash_sum:type_name -> ddex.mead.v11.DetailedHashSum
+	114, // 304: ddex.mead.v11.Focus.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	119, // 305: ddex.mead.v11.Focus.party:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	96,  // 306: ddex.mead.v11.Focus.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
+	119, // 307: ddex.mead.v11.Focus.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	119, // 308: ddex.mead.v11.Focus.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	124, // 309: ddex.mead.v11.Focus.period_of_being_focus:type_name -> ddex.mead.v11.PeriodWithTime
+	141, // 310: ddex.mead.v11.Focus.comment:type_name -> ddex.mead.v11.TextWithoutTerritory
+	114, // 311: ddex.mead.v11.HistoricChartingInformation.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	91,  // 312: ddex.mead.v11.HistoricChartingInformation.territory_code:type_name -> ddex.mead.v11.CurrentTerritoryCode
+	118, // 313: ddex.mead.v11.HistoricChartingInformation.chart_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	97,  // 314: ddex.mead.v11.HistoricChartingInformation.duration_in_charts:type_name -> ddex.mead.v11.Duration
+	87,  // 315: ddex.mead.v11.HistoricChartingInformation.chart_entry:type_name -> ddex.mead.v11.ChartEntry
+	140, // 316: ddex.mead.v11.HistoricChartingInformation.comment:type_name -> ddex.mead.v11.TextWithFormat
+	114, // 317: ddex.mead.v11.Image.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	101, // 318: ddex.mead.v11.Image.file:type_name -> ddex.mead.v11.File
+	107, // 319: ddex.mead.v11.Image.image_type:type_name -> ddex.mead.v11.ImageType
+	109, // 320: ddex.mead.v11.MessageAuditTrail.message_audit_trail_event:type_name -> ddex.mead.v11.MessageAuditTrailEvent
+	111, // 321: ddex.mead.v11.MessageAuditTrailEvent.messaging_party_descriptor:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
+	111, // 322: ddex.mead.v11.MessageHeader.message_sender:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
+	111, // 323: ddex.mead.v11.MessageHeader.sent_on_behalf_of:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
+	111, // 324: ddex.mead.v11.MessageHeader.message_recipient:type_name -> ddex.mead.v11.MessagingPartyWithoutCode
+	108, // 325: ddex.mead.v11.MessageHeader.message_audit_trail:type_name -> ddex.mead.v11.MessageAuditTrail
+	121, // 326: ddex.mead.v11.MessagingPartyWithoutCode.party_name:type_name -> ddex.mead.v11.PartyNameWithoutCode
+	94,  // 327: ddex.mead.v11.MetadataSource.party_id:type_name -> ddex.mead.v11.DetailedPartyId
+	120, // 328: ddex.mead.v11.MetadataSource.party_name:type_name -> ddex.mead.v11.PartyNameWithPronunciation
+	115, // 329: ddex.mead.v11.MetadataSource.metadata_source_type:type_name -> ddex.mead.v11.MetadataSourceType
+	112, // 330: ddex.mead.v11.MetadataSourceList.metadata_source:type_name -> ddex.mead.v11.MetadataSource
+	126, // 331: ddex.mead.v11.MusicalWorkIdWithoutFlag.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
+	117, // 332: ddex.mead.v11.NameWithPronunciationAndScriptCode.name:type_name -> ddex.mead.v11.Name
+	125, // 333: ddex.mead.v11.NameWithPronunciationAndScriptCode.pronunciation:type_name -> ddex.mead.v11.Pronunciation
+	94,  // 334: ddex.mead.v11.PartyDescriptorWithPronunciation.party_id:type_name -> ddex.mead.v11.DetailedPartyId
+	120, // 335: ddex.mead.v11.PartyDescriptorWithPronunciation.party_name:type_name -> ddex.mead.v11.PartyNameWithPronunciation
+	118, // 336: ddex.mead.v11.PartyNameWithPronunciation.full_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	118, // 337: ddex.mead.v11.PartyNameWithPronunciation.full_name_ascii_transcribed:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	118, // 338: ddex.mead.v11.PartyNameWithPronunciation.full_name_indexed:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	118, // 339: ddex.mead.v11.PartyNameWithPronunciation.names_before_key_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	118, // 340: ddex.mead.v11.PartyNameWithPronunciation.key_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	118, // 341: ddex.mead.v11.PartyNameWithPronunciation.names_after_key_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	118, // 342: ddex.mead.v11.PartyNameWithPronunciation.abbreviated_name:type_name -> ddex.mead.v11.NameWithPronunciationAndScriptCode
+	99,  // 343: ddex.mead.v11.Period.start_date:type_name -> ddex.mead.v11.EventDate
+	99,  // 344: ddex.mead.v11.Period.end_date:type_name -> ddex.mead.v11.EventDate
+	100, // 345: ddex.mead.v11.Period.start_date_time:type_name -> ddex.mead.v11.EventDateTime
+	100, // 346: ddex.mead.v11.Period.end_date_time:type_name -> ddex.mead.v11.EventDateTime
+	131, // 347: ddex.mead.v11.RelatedCreation.release_id:type_name -> ddex.mead.v11.ReleaseId
+	135, // 348: ddex.mead.v11.RelatedCreation.resource_id:type_name -> ddex.mead.v11.ResourceIdWithoutFlag
+	116, // 349: ddex.mead.v11.RelatedCreation.musical_work_id:type_name -> ddex.mead.v11.MusicalWorkIdWithoutFlag
+	143, // 350: ddex.mead.v11.RelatedCreation.title:type_name -> ddex.mead.v11.TitleWithPronunciation
+	132, // 351: ddex.mead.v11.Release.release_title:type_name -> ddex.mead.v11.ReleaseTitle
+	96,  // 352: ddex.mead.v11.Release.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
+	119, // 353: ddex.mead.v11.Release.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	86,  // 354: ddex.mead.v11.ReleaseId.catalog_number:type_name -> ddex.mead.v11.CatalogNumber
+	126, // 355: ddex.mead.v11.ReleaseId.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
+	137, // 356: ddex.mead.v11.Resource.resource_title:type_name -> ddex.mead.v11.ResourceTitle
+	96,  // 357: ddex.mead.v11.Resource.display_artist_name:type_name -> ddex.mead.v11.DisplayArtistNameWithPronunciation
+	119, // 358: ddex.mead.v11.Resource.display_artist:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	86,  // 359: ddex.mead.v11.ResourceIdWithoutFlag.catalog_number:type_name -> ddex.mead.v11.CatalogNumber
+	126, // 360: ddex.mead.v11.ResourceIdWithoutFlag.proprietary_id:type_name -> ddex.mead.v11.ProprietaryId
+	125, // 361: ddex.mead.v11.TitleText.pronunciation:type_name -> ddex.mead.v11.Pronunciation
+	142, // 362: ddex.mead.v11.TitleWithPronunciation.title_text:type_name -> ddex.mead.v11.TitleText
+	142, // 363: ddex.mead.v11.TitleWithPronunciation.sub_title:type_name -> ddex.mead.v11.TitleText
+	82,  // 364: ddex.mead.v11.Venue.territory_code:type_name -> ddex.mead.v11.AllTerritoryCode
+	114, // 365: ddex.mead.v11.VocalRegister.metadata_source_reference:type_name -> ddex.mead.v11.MetadataSourceReference
+	146, // 366: ddex.mead.v11.VocalRegister.value:type_name -> ddex.mead.v11.VocalRegisterValue
+	149, // 367: ddex.mead.v11.Work.work_title:type_name -> ddex.mead.v11.WorkTitle
+	119, // 368: ddex.mead.v11.Work.writer:type_name -> ddex.mead.v11.PartyDescriptorWithPronunciation
+	369, // [369:369] is the sub-list for method output_type
+	369, // [369:369] is the sub-list for method input_type
+	369, // [369:369] is the sub-list for extension type_name
+	369, // [369:369] is the sub-list for extension extendee
+	0,   // [0:369] is the sub-list for field type_name
 }
 
 func init() { file_ddex_mead_v11_v11_proto_init() }
@@ -13407,13 +13956,104 @@ func file_ddex_mead_v11_v11_proto_init() {
 	if File_ddex_mead_v11_v11_proto != nil {
 		return
 	}
+	file_ddex_mead_v11_v11_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[4].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[5].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[9].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[12].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[14].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[15].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[19].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[22].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[25].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[26].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[28].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[31].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[34].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[35].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[36].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[37].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[38].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[40].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[44].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[49].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[50].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[51].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[53].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[58].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[59].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[60].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[62].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[63].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[66].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[67].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[70].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[71].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[73].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[76].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[78].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[80].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[82].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[83].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[84].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[87].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[90].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[91].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[92].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[93].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[94].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[95].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[97].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[99].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[100].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[101].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[102].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[103].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[104].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[105].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[107].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[110].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[111].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[114].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[115].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[116].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[117].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[120].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[121].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[123].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[124].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[125].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[127].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[129].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[130].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[131].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[132].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[133].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[134].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[135].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[136].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[137].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[138].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[139].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[140].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[141].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[143].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[144].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[146].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[147].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[148].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[149].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[150].OneofWrappers = []any{}
+	file_ddex_mead_v11_v11_proto_msgTypes[151].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ddex_mead_v11_v11_proto_rawDesc), len(file_ddex_mead_v11_v11_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   152,
+			NumMessages:   153,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
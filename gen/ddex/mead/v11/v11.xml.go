@@ -1,8 +1,18 @@
 // Code generated by generate-go-extensions. DO NOT EDIT.
+// Source: v11.pb.go, from protos generated by xsd2proto devel on 2026-10-15
+// Schema: meadv11/media-enrichment-and-description.xsd (SCOW Version v0.8.67 210603, sha256 17f2fe5149ac)
 
 package v11
 
-import "encoding/xml"
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
 
 // Package-level namespace constants
 const (
@@ -12,27 +22,315 @@ const (
 	NamespaceXSI    = "http://www.w3.org/2001/XMLSchema-instance"
 )
 
-// MarshalXML implements xml.Marshaler for MeadMessage
-func (m *MeadMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	// Set default namespace values if empty
-	if m.XmlnsMead == "" {
-		m.XmlnsMead = Namespace
+// Unmarshal decodes a document whose root is any root message of this
+// package. The root element must be in Namespace.
+func Unmarshal(data []byte) (proto.Message, error) {
+	d := newDecoder(data)
+	start, err := rootElement(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg interface {
+		proto.Message
+		xml.Unmarshaler
+	}
+	switch start.Name.Local {
+	case "MeadMessage":
+		msg = &MeadMessage{}
+	case "Feed":
+		msg = &Feed{}
+	default:
+		return nil, fmt.Errorf("unknown root element %s", start.Name.Local)
+	}
+	if err := d.DecodeElement(msg, &start); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// UnmarshalMeadMessage decodes a MeadMessage document. The root element must
+// be in Namespace.
+func UnmarshalMeadMessage(data []byte) (*MeadMessage, error) {
+	d := newDecoder(data)
+	start, err := rootElement(d)
+	if err != nil {
+		return nil, err
+	}
+	if start.Name.Local != "MeadMessage" {
+		return nil, fmt.Errorf("root element %s is not MeadMessage", start.Name.Local)
+	}
+	msg := &MeadMessage{}
+	if err := d.DecodeElement(msg, &start); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// UnmarshalFeed decodes a Feed document. The root element must
+// be in Namespace.
+func UnmarshalFeed(data []byte) (*Feed, error) {
+	d := newDecoder(data)
+	start, err := rootElement(d)
+	if err != nil {
+		return nil, err
+	}
+	if start.Name.Local != "Feed" {
+		return nil, fmt.Errorf("root element %s is not Feed", start.Name.Local)
+	}
+	msg := &Feed{}
+	if err := d.DecodeElement(msg, &start); err != nil {
+		return nil, err
 	}
-	if m.XmlnsXsi == "" {
-		m.XmlnsXsi = NamespaceXSI
+	return msg, nil
+}
+
+// rootElement reads up to the root element and checks it is in Namespace
+func rootElement(d *xml.Decoder) (xml.StartElement, error) {
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return xml.StartElement{}, fmt.Errorf("could not find root element: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Space != Namespace {
+			return xml.StartElement{}, fmt.Errorf("root element %s is in namespace %q, expected %q", start.Name.Local, start.Name.Space, Namespace)
+		}
+		return start, nil
 	}
-	if m.XsiSchemaLocation == "" {
-		m.XsiSchemaLocation = SchemaLocation
+}
+
+// newDecoder returns a decoder over data that reads the charsets DDEX
+// documents are delivered in
+func newDecoder(data []byte) *xml.Decoder {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.CharsetReader = charsetReader
+	return d
+}
+
+// charsetReader converts the non-UTF-8 encodings DDEX documents are delivered in
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "us-ascii", "ascii":
+		return input, nil
+	case "iso-8859-1", "latin1", "latin-1":
+		data, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return strings.NewReader(string(runes)), nil
+	default:
+		return nil, fmt.Errorf("unsupported charset: %s", charset)
 	}
+}
 
+// rootPrefix returns the prefix start declares Namespace under, or "" when
+// it uses NamespacePrefix or declares none
+func rootPrefix(start xml.StartElement) string {
+	prefix := ""
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "xmlns" || attr.Value != Namespace {
+			continue
+		}
+		if attr.Name.Local == NamespacePrefix {
+			return ""
+		}
+		if prefix == "" {
+			prefix = attr.Name.Local
+		}
+	}
+	return prefix
+}
+
+// NewMeadMessage returns a MeadMessage with its namespace attributes populated
+func NewMeadMessage() *MeadMessage {
+	return &MeadMessage{
+		XmlnsMead:         Namespace,
+		XmlnsXsi:          NamespaceXSI,
+		XsiSchemaLocation: SchemaLocation,
+	}
+}
+
+// MarshalXML implements xml.Marshaler for MeadMessage, filling in empty namespace
+// attributes on a shallow copy so m is never modified
+func (m *MeadMessage) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	// Create an alias type to avoid infinite recursion
 	type alias MeadMessage
-	return e.EncodeElement((*alias)(m), start)
+	out := &alias{
+		MessageHeader:           m.MessageHeader,
+		SubscriptionId:          m.SubscriptionId,
+		MetadataSourceList:      m.MetadataSourceList,
+		WorkInformationList:     m.WorkInformationList,
+		ResourceInformationList: m.ResourceInformationList,
+		ReleaseInformationList:  m.ReleaseInformationList,
+		AvsVersionId:            m.AvsVersionId,
+		LanguageAndScriptCode:   m.LanguageAndScriptCode,
+		XmlnsMead:               m.XmlnsMead,
+		XmlnsXsi:                m.XmlnsXsi,
+		XsiSchemaLocation:       m.XsiSchemaLocation,
+		RootPrefix:              m.RootPrefix,
+	}
+
+	// Declare the namespace under the prefix the parsed document used, if any
+	if out.RootPrefix != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + out.RootPrefix}, Value: Namespace})
+		if out.XmlnsMead == Namespace {
+			out.XmlnsMead = ""
+		}
+	} else if out.XmlnsMead == "" {
+		out.XmlnsMead = Namespace
+	}
+	if out.XmlnsXsi == "" {
+		out.XmlnsXsi = NamespaceXSI
+	}
+	if out.XsiSchemaLocation == "" {
+		out.XsiSchemaLocation = SchemaLocation
+	}
+	return e.EncodeElement(out, start)
 }
 
 // UnmarshalXML implements xml.Unmarshaler for MeadMessage
 func (m *MeadMessage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "MeadMessage" {
+		return fmt.Errorf("expected root element MeadMessage, got %s", start.Name.Local)
+	}
+	m.RootPrefix = rootPrefix(start)
+
 	// Create an alias type to avoid infinite recursion
 	type alias MeadMessage
 	return d.DecodeElement((*alias)(m), &start)
 }
+
+// NewFeed returns a Feed with its namespace attributes populated
+func NewFeed() *Feed {
+	return &Feed{
+		XmlnsMead:         Namespace,
+		XmlnsXsi:          NamespaceXSI,
+		XsiSchemaLocation: SchemaLocation,
+	}
+}
+
+// MarshalXML implements xml.Marshaler for Feed, filling in empty namespace
+// attributes on a shallow copy so m is never modified
+func (m *Feed) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	// Create an alias type to avoid infinite recursion
+	type alias Feed
+	out := &alias{
+		Author:            m.Author,
+		Category:          m.Category,
+		Contributor:       m.Contributor,
+		Generator:         m.Generator,
+		Icon:              m.Icon,
+		Id:                m.Id,
+		Link:              m.Link,
+		Logo:              m.Logo,
+		Rights:            m.Rights,
+		Subtitle:          m.Subtitle,
+		Title:             m.Title,
+		Updated:           m.Updated,
+		Entry:             m.Entry,
+		XmlnsMead:         m.XmlnsMead,
+		XmlnsXsi:          m.XmlnsXsi,
+		XsiSchemaLocation: m.XsiSchemaLocation,
+		AnyElement:        m.AnyElement,
+		RootPrefix:        m.RootPrefix,
+	}
+
+	// Declare the namespace under the prefix the parsed document used, if any
+	if out.RootPrefix != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + out.RootPrefix}, Value: Namespace})
+		if out.XmlnsMead == Namespace {
+			out.XmlnsMead = ""
+		}
+	} else if out.XmlnsMead == "" {
+		out.XmlnsMead = Namespace
+	}
+	if out.XmlnsXsi == "" {
+		out.XmlnsXsi = NamespaceXSI
+	}
+	if out.XsiSchemaLocation == "" {
+		out.XsiSchemaLocation = SchemaLocation
+	}
+	return e.EncodeElement(out, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler for Feed
+func (m *Feed) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if start.Name.Local != "Feed" {
+		return fmt.Errorf("expected root element Feed, got %s", start.Name.Local)
+	}
+	m.RootPrefix = rootPrefix(start)
+
+	// Create an alias type to avoid infinite recursion
+	type alias Feed
+	return d.DecodeElement((*alias)(m), &start)
+}
+
+// MarshalXML implements xml.Marshaler for AnyElement, writing RawXml in place of start
+func (m *AnyElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	d := xml.NewDecoder(strings.NewReader(m.RawXml))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid AnyElement: %w", err)
+		}
+		if err := e.EncodeToken(withoutNamespaceDecls(tok)); err != nil {
+			return err
+		}
+	}
+}
+
+// UnmarshalXML implements xml.Unmarshaler for AnyElement, capturing the whole element as RawXml
+func (m *AnyElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var buf bytes.Buffer
+	e := xml.NewEncoder(&buf)
+	if err := e.EncodeToken(withoutNamespaceDecls(start)); err != nil {
+		return err
+	}
+	for depth := 1; depth > 0; {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+		if err := e.EncodeToken(withoutNamespaceDecls(tok)); err != nil {
+			return err
+		}
+	}
+	if err := e.Flush(); err != nil {
+		return err
+	}
+	m.RawXml = buf.String()
+	return nil
+}
+
+// withoutNamespaceDecls strips xmlns attributes from a start element
+func withoutNamespaceDecls(tok xml.Token) xml.Token {
+	start, ok := tok.(xml.StartElement)
+	if !ok {
+		return tok
+	}
+	attrs := make([]xml.Attr, 0, len(start.Attr))
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "xmlns" && !(attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			attrs = append(attrs, attr)
+		}
+	}
+	start.Attr = attrs
+	return start
+}
@@ -1,3 +1,6 @@
+// Generated by xsd2proto devel on 2026-10-15
+// Schema: piev10/party-identification-and-enrichment.xsd (SCOW Version v0.8.67 210603, sha256 c0e12d5ef3ac)
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.9
@@ -34,14 +37,21 @@ type PieMessage struct {
 	AvsVersionId string `protobuf:"bytes,4,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
 	LanguageAndScriptCode string `protobuf:"bytes,5,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:pie,attr"
-	XmlnsPie string `protobuf:"bytes,6,opt,name=xmlns_pie,json=xmlnsPie,proto3" json:"xmlns_pie,omitempty" xml:"xmlns:pie,attr"`
+	// @gotags: xml:"xmlns:pie,attr,omitempty"
+	XmlnsPie string `protobuf:"bytes,6,opt,name=xmlns_pie,json=xmlnsPie,proto3" json:"xmlns_pie,omitempty" xml:"xmlns:pie,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,7,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,8,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,9,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PieMessage) Reset() {
@@ -130,6 +140,13 @@ func (x *PieMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *PieMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type PieRequestMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageHeader"
@@ -140,14 +157,21 @@ type PieRequestMessage struct {
 	AvsVersionId string `protobuf:"bytes,3,opt,name=avs_version_id,json=avsVersionId,proto3" json:"avs_version_id,omitempty" xml:"AvsVersionId,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
 	LanguageAndScriptCode string `protobuf:"bytes,4,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"xmlns:pie,attr"
-	XmlnsPie string `protobuf:"bytes,5,opt,name=xmlns_pie,json=xmlnsPie,proto3" json:"xmlns_pie,omitempty" xml:"xmlns:pie,attr"`
+	// @gotags: xml:"xmlns:pie,attr,omitempty"
+	XmlnsPie string `protobuf:"bytes,5,opt,name=xmlns_pie,json=xmlnsPie,proto3" json:"xmlns_pie,omitempty" xml:"xmlns:pie,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,6,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,7,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,8,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PieRequestMessage) Reset() {
@@ -229,6 +253,13 @@ func (x *PieRequestMessage) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *PieRequestMessage) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type Feed struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"author"
@@ -257,14 +288,23 @@ type Feed struct {
 	Updated *DateTime `protobuf:"bytes,12,opt,name=updated,proto3" json:"updated,omitempty" xml:"updated"`
 	// @gotags: xml:"entry"
 	Entry []*Entry `protobuf:"bytes,13,rep,name=entry,proto3" json:"entry,omitempty" xml:"entry"`
-	// @gotags: xml:"xmlns:pie,attr"
-	XmlnsPie string `protobuf:"bytes,14,opt,name=xmlns_pie,json=xmlnsPie,proto3" json:"xmlns_pie,omitempty" xml:"xmlns:pie,attr"`
+	// @gotags: xml:"xmlns:pie,attr,omitempty"
+	XmlnsPie string `protobuf:"bytes,14,opt,name=xmlns_pie,json=xmlnsPie,proto3" json:"xmlns_pie,omitempty" xml:"xmlns:pie,attr,omitempty"`
 	// @gotags: xml:"xmlns:xsi,attr"
 	XmlnsXsi string `protobuf:"bytes,15,opt,name=xmlns_xsi,json=xmlnsXsi,proto3" json:"xmlns_xsi,omitempty" xml:"xmlns:xsi,attr"`
 	// @gotags: xml:"xsi:schemaLocation,attr"
 	XsiSchemaLocation string `protobuf:"bytes,16,opt,name=xsi_schema_location,json=xsiSchemaLocation,proto3" json:"xsi_schema_location,omitempty" xml:"xsi:schemaLocation,attr"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// @gotags: xml:",any"
+	AnyElement []*AnyElement `protobuf:"bytes,17,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	// Prefix the parsed document bound the root namespace to, if not the
+	// default one, so that marshaling declares it again. Like the xmlns_*
+	// fields it records how the document was serialized rather than content:
+	// it is carried in the wire format and compared by proto.Equal, while
+	// ddex.EqualIgnoringNamespaces ignores it.
+	// @gotags: xml:"-"
+	RootPrefix    string `protobuf:"bytes,18,opt,name=root_prefix,json=rootPrefix,proto3" json:"root_prefix,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Feed) Reset() {
@@ -409,16 +449,30 @@ func (x *Feed) GetXsiSchemaLocation() string {
 	return ""
 }
 
+func (x *Feed) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
+func (x *Feed) GetRootPrefix() string {
+	if x != nil {
+		return x.RootPrefix
+	}
+	return ""
+}
+
 type Contribution struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"Role"
 	Role []*ContributorRole `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" xml:"Role"`
 	// @gotags: xml:"IsPrimaryRole"
-	IsPrimaryRole bool `protobuf:"varint,2,opt,name=is_primary_role,json=isPrimaryRole,proto3" json:"is_primary_role,omitempty" xml:"IsPrimaryRole"`
+	IsPrimaryRole *bool `protobuf:"varint,2,opt,name=is_primary_role,json=isPrimaryRole,proto3,oneof" json:"is_primary_role,omitempty" xml:"IsPrimaryRole"`
 	// @gotags: xml:"HasMadeFeaturedContribution"
-	HasMadeFeaturedContribution bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
+	HasMadeFeaturedContribution *bool `protobuf:"varint,3,opt,name=has_made_featured_contribution,json=hasMadeFeaturedContribution,proto3,oneof" json:"has_made_featured_contribution,omitempty" xml:"HasMadeFeaturedContribution"`
 	// @gotags: xml:"HasMadeContractedContribution"
-	HasMadeContractedContribution bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
+	HasMadeContractedContribution *bool `protobuf:"varint,4,opt,name=has_made_contracted_contribution,json=hasMadeContractedContribution,proto3,oneof" json:"has_made_contracted_contribution,omitempty" xml:"HasMadeContractedContribution"`
 	// @gotags: xml:"Event"
 	Event         *EventDate `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty" xml:"Event"`
 	unknownFields protoimpl.UnknownFields
@@ -463,22 +517,22 @@ func (x *Contribution) GetRole() []*ContributorRole {
 }
 
 func (x *Contribution) GetIsPrimaryRole() bool {
-	if x != nil {
-		return x.IsPrimaryRole
+	if x != nil && x.IsPrimaryRole != nil {
+		return *x.IsPrimaryRole
 	}
 	return false
 }
 
 func (x *Contribution) GetHasMadeFeaturedContribution() bool {
-	if x != nil {
-		return x.HasMadeFeaturedContribution
+	if x != nil && x.HasMadeFeaturedContribution != nil {
+		return *x.HasMadeFeaturedContribution
 	}
 	return false
 }
 
 func (x *Contribution) GetHasMadeContractedContribution() bool {
-	if x != nil {
-		return x.HasMadeContractedContribution
+	if x != nil && x.HasMadeContractedContribution != nil {
+		return *x.HasMadeContractedContribution
 	}
 	return false
 }
@@ -556,19 +610,19 @@ func (x *CreationDescription) GetPublicationDate() *EventDate {
 type DetailedPartyIdForParty struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"CisacSocietyId"
-	CisacSocietyId string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
+	CisacSocietyId *string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3,oneof" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,6,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsDeprecated,attr"
-	IsDeprecated  bool `protobuf:"varint,7,opt,name=is_deprecated,json=isDeprecated,proto3" json:"is_deprecated,omitempty" xml:"IsDeprecated,attr"`
+	IsDeprecated  *bool `protobuf:"varint,7,opt,name=is_deprecated,json=isDeprecated,proto3,oneof" json:"is_deprecated,omitempty" xml:"IsDeprecated,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -604,36 +658,36 @@ func (*DetailedPartyIdForParty) Descriptor() ([]byte, []int) {
 }
 
 func (x *DetailedPartyIdForParty) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *DetailedPartyIdForParty) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *DetailedPartyIdForParty) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *DetailedPartyIdForParty) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
 
 func (x *DetailedPartyIdForParty) GetCisacSocietyId() string {
-	if x != nil {
-		return x.CisacSocietyId
+	if x != nil && x.CisacSocietyId != nil {
+		return *x.CisacSocietyId
 	}
 	return ""
 }
@@ -646,8 +700,8 @@ func (x *DetailedPartyIdForParty) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *DetailedPartyIdForParty) GetIsDeprecated() bool {
-	if x != nil {
-		return x.IsDeprecated
+	if x != nil && x.IsDeprecated != nil {
+		return *x.IsDeprecated
 	}
 	return false
 }
@@ -827,18 +881,18 @@ type Event struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
-	// @gotags: xml:"EventType"
-	EventType *EventType `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty" xml:"EventType"`
-	// @gotags: xml:"EventDescription"
-	EventDescription []*Description `protobuf:"bytes,3,rep,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty" xml:"EventDescription"`
 	// @gotags: xml:"Date"
 	Date *EventDate `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty" xml:"Date"`
 	// @gotags: xml:"StartDate"
 	StartDate *EventDate `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty" xml:"StartDate"`
 	// @gotags: xml:"EndDate"
-	EndDate       *EventDate `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	EndDate *EventDate `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
+	// @gotags: xml:"EventType"
+	EventType *EventType `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty" xml:"EventType"`
+	// @gotags: xml:"EventDescription"
+	EventDescription []*Description `protobuf:"bytes,3,rep,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty" xml:"EventDescription"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -878,49 +932,49 @@ func (x *Event) GetMetadataSourceReference() []*MetadataSourceReference {
 	return nil
 }
 
-func (x *Event) GetEventType() *EventType {
+func (x *Event) GetDate() *EventDate {
 	if x != nil {
-		return x.EventType
+		return x.Date
 	}
 	return nil
 }
 
-func (x *Event) GetEventDescription() []*Description {
+func (x *Event) GetStartDate() *EventDate {
 	if x != nil {
-		return x.EventDescription
+		return x.StartDate
 	}
 	return nil
 }
 
-func (x *Event) GetDate() *EventDate {
+func (x *Event) GetEndDate() *EventDate {
 	if x != nil {
-		return x.Date
+		return x.EndDate
 	}
 	return nil
 }
 
-func (x *Event) GetStartDate() *EventDate {
+func (x *Event) GetEventType() *EventType {
 	if x != nil {
-		return x.StartDate
+		return x.EventType
 	}
 	return nil
 }
 
-func (x *Event) GetEndDate() *EventDate {
+func (x *Event) GetEventDescription() []*Description {
 	if x != nil {
-		return x.EndDate
+		return x.EventDescription
 	}
 	return nil
 }
 
 type EventType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"EventType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"EventType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -963,15 +1017,15 @@ func (x *EventType) GetValue() string {
 }
 
 func (x *EventType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *EventType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -993,15 +1047,15 @@ type Focus struct {
 	// @gotags: xml:"Writer"
 	Writer []*PartyDescriptorWithPronunciation `protobuf:"bytes,7,rep,name=writer,proto3" json:"writer,omitempty" xml:"Writer"`
 	// @gotags: xml:"SequenceNumber"
-	SequenceNumber int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber"`
+	SequenceNumber *int32 `protobuf:"varint,8,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber"`
 	// @gotags: xml:"PeriodOfBeingFocus"
 	PeriodOfBeingFocus []*PeriodWithTime `protobuf:"bytes,9,rep,name=period_of_being_focus,json=periodOfBeingFocus,proto3" json:"period_of_being_focus,omitempty" xml:"PeriodOfBeingFocus"`
-	// @gotags: xml:"TerritoryOfBeingFocusTrackDescription"
-	TerritoryOfBeingFocusTrackDescription []string `protobuf:"bytes,10,rep,name=territory_of_being_focus_track_description,json=territoryOfBeingFocusTrackDescription,proto3" json:"territory_of_being_focus_track_description,omitempty" xml:"TerritoryOfBeingFocusTrackDescription"`
+	// @gotags: xml:"TerritoryOfBeingFocusTrackDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfBeingFocusTrackDescription []string `protobuf:"bytes,10,rep,name=territory_of_being_focus_track_description,json=territoryOfBeingFocusTrackDescription,proto3" json:"territory_of_being_focus_track_description,omitempty" xml:"TerritoryOfBeingFocusTrackDescription" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"Comment"
 	Comment []*TextWithoutTerritory `protobuf:"bytes,11,rep,name=comment,proto3" json:"comment,omitempty" xml:"Comment"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,12,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,12,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1086,8 +1140,8 @@ func (x *Focus) GetWriter() []*PartyDescriptorWithPronunciation {
 }
 
 func (x *Focus) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
@@ -1114,8 +1168,8 @@ func (x *Focus) GetComment() []*TextWithoutTerritory {
 }
 
 func (x *Focus) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -1127,7 +1181,7 @@ type Gender struct {
 	// @gotags: xml:"Value"
 	Value *GenderValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
 	// @gotags: xml:"DoNotDisplay,attr"
-	DoNotDisplay  bool `protobuf:"varint,3,opt,name=do_not_display,json=doNotDisplay,proto3" json:"do_not_display,omitempty" xml:"DoNotDisplay,attr"`
+	DoNotDisplay  *bool `protobuf:"varint,3,opt,name=do_not_display,json=doNotDisplay,proto3,oneof" json:"do_not_display,omitempty" xml:"DoNotDisplay,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1177,20 +1231,20 @@ func (x *Gender) GetValue() *GenderValue {
 }
 
 func (x *Gender) GetDoNotDisplay() bool {
-	if x != nil {
-		return x.DoNotDisplay
+	if x != nil && x.DoNotDisplay != nil {
+		return *x.DoNotDisplay
 	}
 	return false
 }
 
 type GenderValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"GenderPIE"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"GenderPIE"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -1233,15 +1287,15 @@ func (x *GenderValue) GetValue() string {
 }
 
 func (x *GenderValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *GenderValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -1453,9 +1507,11 @@ type Party struct {
 	// @gotags: xml:"CommentaryNote"
 	CommentaryNote []*CommentaryNote `protobuf:"bytes,21,rep,name=commentary_note,json=commentaryNote,proto3" json:"commentary_note,omitempty" xml:"CommentaryNote"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,22,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	LanguageAndScriptCode *string `protobuf:"bytes,22,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,23,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Party) Reset() {
@@ -1636,18 +1692,25 @@ func (x *Party) GetCommentaryNote() []*CommentaryNote {
 }
 
 func (x *Party) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
+func (x *Party) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type PartyDescriptorForEntry struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"PartyId"
 	PartyId *DetailedPartyId `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
-	PartyName     string `protobuf:"bytes,2,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	PartyName     *string `protobuf:"bytes,2,opt,name=party_name,json=partyName,proto3,oneof" json:"party_name,omitempty" xml:"PartyName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1690,8 +1753,8 @@ func (x *PartyDescriptorForEntry) GetPartyId() *DetailedPartyId {
 }
 
 func (x *PartyDescriptorForEntry) GetPartyName() string {
-	if x != nil {
-		return x.PartyName
+	if x != nil && x.PartyName != nil {
+		return *x.PartyName
 	}
 	return ""
 }
@@ -1762,7 +1825,7 @@ type PartyName struct {
 	// @gotags: xml:"FullNameIndexed"
 	FullNameIndexed *NameWithScriptCode `protobuf:"bytes,9,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"TitlesBeforeNames"
-	TitlesBeforeNames string `protobuf:"bytes,10,opt,name=titles_before_names,json=titlesBeforeNames,proto3" json:"titles_before_names,omitempty" xml:"TitlesBeforeNames"`
+	TitlesBeforeNames *string `protobuf:"bytes,10,opt,name=titles_before_names,json=titlesBeforeNames,proto3,oneof" json:"titles_before_names,omitempty" xml:"TitlesBeforeNames"`
 	// @gotags: xml:"NamesBeforeKeyName"
 	NamesBeforeKeyName *NameWithScriptCode `protobuf:"bytes,11,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
 	// @gotags: xml:"KeyName"
@@ -1770,7 +1833,7 @@ type PartyName struct {
 	// @gotags: xml:"NamesAfterKeyName"
 	NamesAfterKeyName *NameWithScriptCode `protobuf:"bytes,13,opt,name=names_after_key_name,json=namesAfterKeyName,proto3" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
 	// @gotags: xml:"TitlesAfterNames"
-	TitlesAfterNames string `protobuf:"bytes,14,opt,name=titles_after_names,json=titlesAfterNames,proto3" json:"titles_after_names,omitempty" xml:"TitlesAfterNames"`
+	TitlesAfterNames *string `protobuf:"bytes,14,opt,name=titles_after_names,json=titlesAfterNames,proto3,oneof" json:"titles_after_names,omitempty" xml:"TitlesAfterNames"`
 	// @gotags: xml:"ShortName"
 	ShortName []*NameWithScriptCode `protobuf:"bytes,15,rep,name=short_name,json=shortName,proto3" json:"short_name,omitempty" xml:"ShortName"`
 	// @gotags: xml:"AbbreviatedName"
@@ -1780,13 +1843,13 @@ type PartyName struct {
 	// @gotags: xml:"RelatedCreation"
 	RelatedCreation []*RelatedCreationForParty `protobuf:"bytes,18,rep,name=related_creation,json=relatedCreation,proto3" json:"related_creation,omitempty" xml:"RelatedCreation"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,19,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,20,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,19,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,20,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,21,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault *bool `protobuf:"varint,21,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	// @gotags: xml:"IsCanonical,attr"
-	IsCanonical   bool `protobuf:"varint,22,opt,name=is_canonical,json=isCanonical,proto3" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
+	IsCanonical   *bool `protobuf:"varint,22,opt,name=is_canonical,json=isCanonical,proto3,oneof" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1885,8 +1948,8 @@ func (x *PartyName) GetFullNameIndexed() *NameWithScriptCode {
 }
 
 func (x *PartyName) GetTitlesBeforeNames() string {
-	if x != nil {
-		return x.TitlesBeforeNames
+	if x != nil && x.TitlesBeforeNames != nil {
+		return *x.TitlesBeforeNames
 	}
 	return ""
 }
@@ -1913,8 +1976,8 @@ func (x *PartyName) GetNamesAfterKeyName() *NameWithScriptCode {
 }
 
 func (x *PartyName) GetTitlesAfterNames() string {
-	if x != nil {
-		return x.TitlesAfterNames
+	if x != nil && x.TitlesAfterNames != nil {
+		return *x.TitlesAfterNames
 	}
 	return ""
 }
@@ -1948,29 +2011,29 @@ func (x *PartyName) GetRelatedCreation() []*RelatedCreationForParty {
 }
 
 func (x *PartyName) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PartyName) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *PartyName) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *PartyName) GetIsCanonical() bool {
-	if x != nil {
-		return x.IsCanonical
+	if x != nil && x.IsCanonical != nil {
+		return *x.IsCanonical
 	}
 	return false
 }
@@ -1980,7 +2043,7 @@ type PartyNameForRequest struct {
 	// @gotags: xml:"FullName"
 	FullName *Name `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
 	FullNameIndexed *Name `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
@@ -1992,7 +2055,7 @@ type PartyNameForRequest struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *Name `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -2035,8 +2098,8 @@ func (x *PartyNameForRequest) GetFullName() *Name {
 }
 
 func (x *PartyNameForRequest) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
@@ -2077,20 +2140,20 @@ func (x *PartyNameForRequest) GetAbbreviatedName() *Name {
 }
 
 func (x *PartyNameForRequest) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 type PartyNameFormat struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PartyNameFormat"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PartyNameFormat"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2133,27 +2196,27 @@ func (x *PartyNameFormat) GetValue() string {
 }
 
 func (x *PartyNameFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyNameFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type PartyNamePurpose struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PartyNamePurpose"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PartyNamePurpose"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2196,27 +2259,27 @@ func (x *PartyNamePurpose) GetValue() string {
 }
 
 func (x *PartyNamePurpose) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyNamePurpose) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type PartyNameType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PartyNameType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PartyNameType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2259,27 +2322,27 @@ func (x *PartyNameType) GetValue() string {
 }
 
 func (x *PartyNameType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyNameType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type PartyRelationshipType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PartyRelationshipTypePIE"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PartyRelationshipTypePIE"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2322,15 +2385,15 @@ func (x *PartyRelationshipType) GetValue() string {
 }
 
 func (x *PartyRelationshipType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyRelationshipType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -2391,12 +2454,12 @@ func (x *PartyType) GetValue() *PartyTypeValue {
 
 type PartyTypeValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"PartyType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"PartyType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2439,15 +2502,15 @@ func (x *PartyTypeValue) GetValue() string {
 }
 
 func (x *PartyTypeValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PartyTypeValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -2511,17 +2574,17 @@ type PronunciationForParty struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsCanonical,attr"
-	IsCanonical bool `protobuf:"varint,2,opt,name=is_canonical,json=isCanonical,proto3" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
+	IsCanonical *bool `protobuf:"varint,2,opt,name=is_canonical,json=isCanonical,proto3,oneof" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
 	// @gotags: xml:"IsMispronounced,attr"
-	IsMispronounced bool `protobuf:"varint,3,opt,name=is_mispronounced,json=isMispronounced,proto3" json:"is_mispronounced,omitempty" xml:"IsMispronounced,attr"`
+	IsMispronounced *bool `protobuf:"varint,3,opt,name=is_mispronounced,json=isMispronounced,proto3,oneof" json:"is_mispronounced,omitempty" xml:"IsMispronounced,attr"`
 	// @gotags: xml:"LanguageCode,attr"
-	LanguageCode string `protobuf:"bytes,4,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty" xml:"LanguageCode,attr"`
-	// @gotags: xml:"EncodingType,attr"
-	EncodingType string `protobuf:"bytes,5,opt,name=encoding_type,json=encodingType,proto3" json:"encoding_type,omitempty" xml:"EncodingType,attr"`
+	LanguageCode *string `protobuf:"bytes,4,opt,name=language_code,json=languageCode,proto3,oneof" json:"language_code,omitempty" xml:"LanguageCode,attr"`
+	// @gotags: xml:"EncodingType,attr" avs:"EncodingType"
+	EncodingType *string `protobuf:"bytes,5,opt,name=encoding_type,json=encodingType,proto3,oneof" json:"encoding_type,omitempty" xml:"EncodingType,attr" avs:"EncodingType"`
 	// @gotags: xml:"EncodingNamespace,attr"
-	EncodingNamespace string `protobuf:"bytes,6,opt,name=encoding_namespace,json=encodingNamespace,proto3" json:"encoding_namespace,omitempty" xml:"EncodingNamespace,attr"`
+	EncodingNamespace *string `protobuf:"bytes,6,opt,name=encoding_namespace,json=encodingNamespace,proto3,oneof" json:"encoding_namespace,omitempty" xml:"EncodingNamespace,attr"`
 	// @gotags: xml:"EncodingUserDefinedValue,attr"
-	EncodingUserDefinedValue string `protobuf:"bytes,7,opt,name=encoding_user_defined_value,json=encodingUserDefinedValue,proto3" json:"encoding_user_defined_value,omitempty" xml:"EncodingUserDefinedValue,attr"`
+	EncodingUserDefinedValue *string `protobuf:"bytes,7,opt,name=encoding_user_defined_value,json=encodingUserDefinedValue,proto3,oneof" json:"encoding_user_defined_value,omitempty" xml:"EncodingUserDefinedValue,attr"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -2564,55 +2627,55 @@ func (x *PronunciationForParty) GetValue() string {
 }
 
 func (x *PronunciationForParty) GetIsCanonical() bool {
-	if x != nil {
-		return x.IsCanonical
+	if x != nil && x.IsCanonical != nil {
+		return *x.IsCanonical
 	}
 	return false
 }
 
 func (x *PronunciationForParty) GetIsMispronounced() bool {
-	if x != nil {
-		return x.IsMispronounced
+	if x != nil && x.IsMispronounced != nil {
+		return *x.IsMispronounced
 	}
 	return false
 }
 
 func (x *PronunciationForParty) GetLanguageCode() string {
-	if x != nil {
-		return x.LanguageCode
+	if x != nil && x.LanguageCode != nil {
+		return *x.LanguageCode
 	}
 	return ""
 }
 
 func (x *PronunciationForParty) GetEncodingType() string {
-	if x != nil {
-		return x.EncodingType
+	if x != nil && x.EncodingType != nil {
+		return *x.EncodingType
 	}
 	return ""
 }
 
 func (x *PronunciationForParty) GetEncodingNamespace() string {
-	if x != nil {
-		return x.EncodingNamespace
+	if x != nil && x.EncodingNamespace != nil {
+		return *x.EncodingNamespace
 	}
 	return ""
 }
 
 func (x *PronunciationForParty) GetEncodingUserDefinedValue() string {
-	if x != nil {
-		return x.EncodingUserDefinedValue
+	if x != nil && x.EncodingUserDefinedValue != nil {
+		return *x.EncodingUserDefinedValue
 	}
 	return ""
 }
 
 type ReasonForNameChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ReasonForNameChange"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ReasonForNameChange"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -2655,15 +2718,15 @@ func (x *ReasonForNameChange) GetValue() string {
 }
 
 func (x *ReasonForNameChange) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ReasonForNameChange) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -2672,14 +2735,10 @@ type RelatedCreationForParty struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MetadataSourceReference"
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
-	// @gotags: xml:"CreationType"
-	CreationType string `protobuf:"bytes,2,opt,name=creation_type,json=creationType,proto3" json:"creation_type,omitempty" xml:"CreationType"`
+	// @gotags: xml:"CreationType" avs:"CreationType"
+	CreationType string `protobuf:"bytes,2,opt,name=creation_type,json=creationType,proto3" json:"creation_type,omitempty" xml:"CreationType" avs:"CreationType"`
 	// @gotags: xml:"Contribution"
 	Contribution *Contribution `protobuf:"bytes,3,opt,name=contribution,proto3" json:"contribution,omitempty" xml:"Contribution"`
-	// @gotags: xml:"RelationshipDescription"
-	RelationshipDescription []*Description `protobuf:"bytes,4,rep,name=relationship_description,json=relationshipDescription,proto3" json:"relationship_description,omitempty" xml:"RelationshipDescription"`
-	// @gotags: xml:"Contract"
-	Contract string `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty" xml:"Contract"`
 	// @gotags: xml:"ReleaseId"
 	ReleaseId *ReleaseId `protobuf:"bytes,6,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ResourceId"
@@ -2688,14 +2747,18 @@ type RelatedCreationForParty struct {
 	MusicalWorkId *MusicalWorkIdWithoutFlag `protobuf:"bytes,8,opt,name=musical_work_id,json=musicalWorkId,proto3" json:"musical_work_id,omitempty" xml:"MusicalWorkId"`
 	// @gotags: xml:"CreationDescription"
 	CreationDescription *CreationDescription `protobuf:"bytes,9,opt,name=creation_description,json=creationDescription,proto3" json:"creation_description,omitempty" xml:"CreationDescription"`
+	// @gotags: xml:"RelationshipDescription"
+	RelationshipDescription []*Description `protobuf:"bytes,4,rep,name=relationship_description,json=relationshipDescription,proto3" json:"relationship_description,omitempty" xml:"RelationshipDescription"`
+	// @gotags: xml:"Contract"
+	Contract *string `protobuf:"bytes,5,opt,name=contract,proto3,oneof" json:"contract,omitempty" xml:"Contract"`
 	// @gotags: xml:"IsFalse,attr"
-	IsFalse bool `protobuf:"varint,10,opt,name=is_false,json=isFalse,proto3" json:"is_false,omitempty" xml:"IsFalse,attr"`
+	IsFalse *bool `protobuf:"varint,10,opt,name=is_false,json=isFalse,proto3,oneof" json:"is_false,omitempty" xml:"IsFalse,attr"`
 	// @gotags: xml:"IsConfidential,attr"
-	IsConfidential bool `protobuf:"varint,11,opt,name=is_confidential,json=isConfidential,proto3" json:"is_confidential,omitempty" xml:"IsConfidential,attr"`
+	IsConfidential *bool `protobuf:"varint,11,opt,name=is_confidential,json=isConfidential,proto3,oneof" json:"is_confidential,omitempty" xml:"IsConfidential,attr"`
 	// @gotags: xml:"IsFirstCreation,attr"
-	IsFirstCreation bool `protobuf:"varint,12,opt,name=is_first_creation,json=isFirstCreation,proto3" json:"is_first_creation,omitempty" xml:"IsFirstCreation,attr"`
+	IsFirstCreation *bool `protobuf:"varint,12,opt,name=is_first_creation,json=isFirstCreation,proto3,oneof" json:"is_first_creation,omitempty" xml:"IsFirstCreation,attr"`
 	// @gotags: xml:"IsLastCreation,attr"
-	IsLastCreation bool `protobuf:"varint,13,opt,name=is_last_creation,json=isLastCreation,proto3" json:"is_last_creation,omitempty" xml:"IsLastCreation,attr"`
+	IsLastCreation *bool `protobuf:"varint,13,opt,name=is_last_creation,json=isLastCreation,proto3,oneof" json:"is_last_creation,omitempty" xml:"IsLastCreation,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2751,20 +2814,6 @@ func (x *RelatedCreationForParty) GetContribution() *Contribution {
 	return nil
 }
 
-func (x *RelatedCreationForParty) GetRelationshipDescription() []*Description {
-	if x != nil {
-		return x.RelationshipDescription
-	}
-	return nil
-}
-
-func (x *RelatedCreationForParty) GetContract() string {
-	if x != nil {
-		return x.Contract
-	}
-	return ""
-}
-
 func (x *RelatedCreationForParty) GetReleaseId() *ReleaseId {
 	if x != nil {
 		return x.ReleaseId
@@ -2793,30 +2842,44 @@ func (x *RelatedCreationForParty) GetCreationDescription() *CreationDescription
 	return nil
 }
 
-func (x *RelatedCreationForParty) GetIsFalse() bool {
+func (x *RelatedCreationForParty) GetRelationshipDescription() []*Description {
 	if x != nil {
-		return x.IsFalse
+		return x.RelationshipDescription
+	}
+	return nil
+}
+
+func (x *RelatedCreationForParty) GetContract() string {
+	if x != nil && x.Contract != nil {
+		return *x.Contract
+	}
+	return ""
+}
+
+func (x *RelatedCreationForParty) GetIsFalse() bool {
+	if x != nil && x.IsFalse != nil {
+		return *x.IsFalse
 	}
 	return false
 }
 
 func (x *RelatedCreationForParty) GetIsConfidential() bool {
-	if x != nil {
-		return x.IsConfidential
+	if x != nil && x.IsConfidential != nil {
+		return *x.IsConfidential
 	}
 	return false
 }
 
 func (x *RelatedCreationForParty) GetIsFirstCreation() bool {
-	if x != nil {
-		return x.IsFirstCreation
+	if x != nil && x.IsFirstCreation != nil {
+		return *x.IsFirstCreation
 	}
 	return false
 }
 
 func (x *RelatedCreationForParty) GetIsLastCreation() bool {
-	if x != nil {
-		return x.IsLastCreation
+	if x != nil && x.IsLastCreation != nil {
+		return *x.IsLastCreation
 	}
 	return false
 }
@@ -2828,25 +2891,25 @@ type RelatedParty struct {
 	// @gotags: xml:"PartyRelationshipType"
 	PartyRelationshipType *PartyRelationshipType `protobuf:"bytes,2,opt,name=party_relationship_type,json=partyRelationshipType,proto3" json:"party_relationship_type,omitempty" xml:"PartyRelationshipType"`
 	// @gotags: xml:"BusinessPurpose"
-	BusinessPurpose string `protobuf:"bytes,3,opt,name=business_purpose,json=businessPurpose,proto3" json:"business_purpose,omitempty" xml:"BusinessPurpose"`
+	BusinessPurpose *string `protobuf:"bytes,3,opt,name=business_purpose,json=businessPurpose,proto3,oneof" json:"business_purpose,omitempty" xml:"BusinessPurpose"`
+	// @gotags: xml:"PartyRelatedPartyReference"
+	PartyRelatedPartyReference string `protobuf:"bytes,8,opt,name=party_related_party_reference,json=partyRelatedPartyReference,proto3" json:"party_related_party_reference,omitempty" xml:"PartyRelatedPartyReference"`
+	// @gotags: xml:"PartyId"
+	PartyId *DetailedPartyIdForParty `protobuf:"bytes,9,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName *PartyName `protobuf:"bytes,10,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"Description"
 	Description []*Description `protobuf:"bytes,4,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"Contract"
-	Contract string `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty" xml:"Contract"`
+	Contract *string `protobuf:"bytes,5,opt,name=contract,proto3,oneof" json:"contract,omitempty" xml:"Contract"`
 	// @gotags: xml:"ValidityPeriod"
 	ValidityPeriod []*ValidityPeriod `protobuf:"bytes,6,rep,name=validity_period,json=validityPeriod,proto3" json:"validity_period,omitempty" xml:"ValidityPeriod"`
 	// @gotags: xml:"RelatedCreation"
 	RelatedCreation []*RelatedCreationForParty `protobuf:"bytes,7,rep,name=related_creation,json=relatedCreation,proto3" json:"related_creation,omitempty" xml:"RelatedCreation"`
-	// @gotags: xml:"PartyRelatedPartyReference"
-	PartyRelatedPartyReference string `protobuf:"bytes,8,opt,name=party_related_party_reference,json=partyRelatedPartyReference,proto3" json:"party_related_party_reference,omitempty" xml:"PartyRelatedPartyReference"`
-	// @gotags: xml:"PartyId"
-	PartyId *DetailedPartyIdForParty `protobuf:"bytes,9,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName *PartyName `protobuf:"bytes,10,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"IsFalse,attr"
-	IsFalse bool `protobuf:"varint,11,opt,name=is_false,json=isFalse,proto3" json:"is_false,omitempty" xml:"IsFalse,attr"`
+	IsFalse *bool `protobuf:"varint,11,opt,name=is_false,json=isFalse,proto3,oneof" json:"is_false,omitempty" xml:"IsFalse,attr"`
 	// @gotags: xml:"IsConfidential,attr"
-	IsConfidential bool `protobuf:"varint,12,opt,name=is_confidential,json=isConfidential,proto3" json:"is_confidential,omitempty" xml:"IsConfidential,attr"`
+	IsConfidential *bool `protobuf:"varint,12,opt,name=is_confidential,json=isConfidential,proto3,oneof" json:"is_confidential,omitempty" xml:"IsConfidential,attr"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2896,71 +2959,71 @@ func (x *RelatedParty) GetPartyRelationshipType() *PartyRelationshipType {
 }
 
 func (x *RelatedParty) GetBusinessPurpose() string {
-	if x != nil {
-		return x.BusinessPurpose
+	if x != nil && x.BusinessPurpose != nil {
+		return *x.BusinessPurpose
 	}
 	return ""
 }
 
-func (x *RelatedParty) GetDescription() []*Description {
+func (x *RelatedParty) GetPartyRelatedPartyReference() string {
 	if x != nil {
-		return x.Description
+		return x.PartyRelatedPartyReference
 	}
-	return nil
+	return ""
 }
 
-func (x *RelatedParty) GetContract() string {
+func (x *RelatedParty) GetPartyId() *DetailedPartyIdForParty {
 	if x != nil {
-		return x.Contract
+		return x.PartyId
 	}
-	return ""
+	return nil
 }
 
-func (x *RelatedParty) GetValidityPeriod() []*ValidityPeriod {
+func (x *RelatedParty) GetPartyName() *PartyName {
 	if x != nil {
-		return x.ValidityPeriod
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *RelatedParty) GetRelatedCreation() []*RelatedCreationForParty {
+func (x *RelatedParty) GetDescription() []*Description {
 	if x != nil {
-		return x.RelatedCreation
+		return x.Description
 	}
 	return nil
 }
 
-func (x *RelatedParty) GetPartyRelatedPartyReference() string {
-	if x != nil {
-		return x.PartyRelatedPartyReference
+func (x *RelatedParty) GetContract() string {
+	if x != nil && x.Contract != nil {
+		return *x.Contract
 	}
 	return ""
 }
 
-func (x *RelatedParty) GetPartyId() *DetailedPartyIdForParty {
+func (x *RelatedParty) GetValidityPeriod() []*ValidityPeriod {
 	if x != nil {
-		return x.PartyId
+		return x.ValidityPeriod
 	}
 	return nil
 }
 
-func (x *RelatedParty) GetPartyName() *PartyName {
+func (x *RelatedParty) GetRelatedCreation() []*RelatedCreationForParty {
 	if x != nil {
-		return x.PartyName
+		return x.RelatedCreation
 	}
 	return nil
 }
 
 func (x *RelatedParty) GetIsFalse() bool {
-	if x != nil {
-		return x.IsFalse
+	if x != nil && x.IsFalse != nil {
+		return *x.IsFalse
 	}
 	return false
 }
 
 func (x *RelatedParty) GetIsConfidential() bool {
-	if x != nil {
-		return x.IsConfidential
+	if x != nil && x.IsConfidential != nil {
+		return *x.IsConfidential
 	}
 	return false
 }
@@ -3021,6 +3084,10 @@ func (x *ReleaseForRequest) GetReleaseTitle() *ReleaseTitle {
 
 type RequestedParty struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"PartyId"
+	PartyId *DetailedPartyId `protobuf:"bytes,5,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
+	// @gotags: xml:"PartyName"
+	PartyName *PartyNameForRequest `protobuf:"bytes,6,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"Role"
 	Role []*ContributorRole `protobuf:"bytes,1,rep,name=role,proto3" json:"role,omitempty" xml:"Role"`
 	// @gotags: xml:"Release"
@@ -3028,11 +3095,7 @@ type RequestedParty struct {
 	// @gotags: xml:"Resource"
 	Resource []*ResourceForRequest `protobuf:"bytes,3,rep,name=resource,proto3" json:"resource,omitempty" xml:"Resource"`
 	// @gotags: xml:"Work"
-	Work []*WorkForRequest `protobuf:"bytes,4,rep,name=work,proto3" json:"work,omitempty" xml:"Work"`
-	// @gotags: xml:"PartyId"
-	PartyId *DetailedPartyId `protobuf:"bytes,5,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
-	// @gotags: xml:"PartyName"
-	PartyName     *PartyNameForRequest `protobuf:"bytes,6,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	Work          []*WorkForRequest `protobuf:"bytes,4,rep,name=work,proto3" json:"work,omitempty" xml:"Work"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3067,44 +3130,44 @@ func (*RequestedParty) Descriptor() ([]byte, []int) {
 	return file_ddex_pie_v10_v10_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RequestedParty) GetRole() []*ContributorRole {
+func (x *RequestedParty) GetPartyId() *DetailedPartyId {
 	if x != nil {
-		return x.Role
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *RequestedParty) GetRelease() []*ReleaseForRequest {
+func (x *RequestedParty) GetPartyName() *PartyNameForRequest {
 	if x != nil {
-		return x.Release
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *RequestedParty) GetResource() []*ResourceForRequest {
+func (x *RequestedParty) GetRole() []*ContributorRole {
 	if x != nil {
-		return x.Resource
+		return x.Role
 	}
 	return nil
 }
 
-func (x *RequestedParty) GetWork() []*WorkForRequest {
+func (x *RequestedParty) GetRelease() []*ReleaseForRequest {
 	if x != nil {
-		return x.Work
+		return x.Release
 	}
 	return nil
 }
 
-func (x *RequestedParty) GetPartyId() *DetailedPartyId {
+func (x *RequestedParty) GetResource() []*ResourceForRequest {
 	if x != nil {
-		return x.PartyId
+		return x.Resource
 	}
 	return nil
 }
 
-func (x *RequestedParty) GetPartyName() *PartyNameForRequest {
+func (x *RequestedParty) GetWork() []*WorkForRequest {
 	if x != nil {
-		return x.PartyName
+		return x.Work
 	}
 	return nil
 }
@@ -3115,8 +3178,8 @@ type ResourceForRequest struct {
 	ResourceId *ResourceIdWithoutFlag `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
 	// @gotags: xml:"ResourceTitle"
 	ResourceTitle *ResourceTitle `protobuf:"bytes,2,opt,name=resource_title,json=resourceTitle,proto3" json:"resource_title,omitempty" xml:"ResourceTitle"`
-	// @gotags: xml:"ResourceType"
-	ResourceType  string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty" xml:"ResourceType"`
+	// @gotags: xml:"ResourceType" avs:"ResourceType"
+	ResourceType  *string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3,oneof" json:"resource_type,omitempty" xml:"ResourceType" avs:"ResourceType"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3166,8 +3229,8 @@ func (x *ResourceForRequest) GetResourceTitle() *ResourceTitle {
 }
 
 func (x *ResourceForRequest) GetResourceType() string {
-	if x != nil {
-		return x.ResourceType
+	if x != nil && x.ResourceType != nil {
+		return *x.ResourceType
 	}
 	return ""
 }
@@ -3177,9 +3240,9 @@ type SocialMediaURL struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageCode,attr"
-	LanguageCode string `protobuf:"bytes,2,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty" xml:"LanguageCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageCode *string `protobuf:"bytes,2,opt,name=language_code,json=languageCode,proto3,oneof" json:"language_code,omitempty" xml:"LanguageCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -3222,15 +3285,15 @@ func (x *SocialMediaURL) GetValue() string {
 }
 
 func (x *SocialMediaURL) GetLanguageCode() string {
-	if x != nil {
-		return x.LanguageCode
+	if x != nil && x.LanguageCode != nil {
+		return *x.LanguageCode
 	}
 	return ""
 }
 
 func (x *SocialMediaURL) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -3294,9 +3357,9 @@ type Category struct {
 	// @gotags: xml:"term,attr"
 	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty" xml:"term,attr"`
 	// @gotags: xml:"scheme,attr"
-	Scheme string `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty" xml:"scheme,attr"`
+	Scheme *string `protobuf:"bytes,2,opt,name=scheme,proto3,oneof" json:"scheme,omitempty" xml:"scheme,attr"`
 	// @gotags: xml:"label,attr"
-	Label         string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty" xml:"label,attr"`
+	Label         *string `protobuf:"bytes,3,opt,name=label,proto3,oneof" json:"label,omitempty" xml:"label,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3339,15 +3402,15 @@ func (x *Category) GetTerm() string {
 }
 
 func (x *Category) GetScheme() string {
-	if x != nil {
-		return x.Scheme
+	if x != nil && x.Scheme != nil {
+		return *x.Scheme
 	}
 	return ""
 }
 
 func (x *Category) GetLabel() string {
-	if x != nil {
-		return x.Label
+	if x != nil && x.Label != nil {
+		return *x.Label
 	}
 	return ""
 }
@@ -3355,9 +3418,13 @@ func (x *Category) GetLabel() string {
 type Content struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"type,attr"
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"type,attr"`
 	// @gotags: xml:"src,attr"
-	Src           string `protobuf:"bytes,2,opt,name=src,proto3" json:"src,omitempty" xml:"src,attr"`
+	Src *string `protobuf:"bytes,2,opt,name=src,proto3,oneof" json:"src,omitempty" xml:"src,attr"`
+	// @gotags: xml:",any"
+	AnyElement []*AnyElement `protobuf:"bytes,3,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	// @gotags: xml:",chardata"
+	Value         string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3393,15 +3460,29 @@ func (*Content) Descriptor() ([]byte, []int) {
 }
 
 func (x *Content) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
 func (x *Content) GetSrc() string {
+	if x != nil && x.Src != nil {
+		return *x.Src
+	}
+	return ""
+}
+
+func (x *Content) GetAnyElement() []*AnyElement {
 	if x != nil {
-		return x.Src
+		return x.AnyElement
+	}
+	return nil
+}
+
+func (x *Content) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
@@ -3456,9 +3537,9 @@ type Generator struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"uri,attr"
-	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty" xml:"uri,attr"`
+	Uri *string `protobuf:"bytes,2,opt,name=uri,proto3,oneof" json:"uri,omitempty" xml:"uri,attr"`
 	// @gotags: xml:"version,attr"
-	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty" xml:"version,attr"`
+	Version       *string `protobuf:"bytes,3,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"version,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3501,15 +3582,15 @@ func (x *Generator) GetValue() string {
 }
 
 func (x *Generator) GetUri() string {
-	if x != nil {
-		return x.Uri
+	if x != nil && x.Uri != nil {
+		return *x.Uri
 	}
 	return ""
 }
 
 func (x *Generator) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
@@ -3609,15 +3690,17 @@ type Link struct {
 	// @gotags: xml:"href,attr"
 	Href string `protobuf:"bytes,1,opt,name=href,proto3" json:"href,omitempty" xml:"href,attr"`
 	// @gotags: xml:"rel,attr"
-	Rel string `protobuf:"bytes,2,opt,name=rel,proto3" json:"rel,omitempty" xml:"rel,attr"`
+	Rel *string `protobuf:"bytes,2,opt,name=rel,proto3,oneof" json:"rel,omitempty" xml:"rel,attr"`
 	// @gotags: xml:"type,attr"
-	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	Type *string `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"type,attr"`
 	// @gotags: xml:"hreflang,attr"
-	Hreflang string `protobuf:"bytes,4,opt,name=hreflang,proto3" json:"hreflang,omitempty" xml:"hreflang,attr"`
+	Hreflang *string `protobuf:"bytes,4,opt,name=hreflang,proto3,oneof" json:"hreflang,omitempty" xml:"hreflang,attr"`
 	// @gotags: xml:"title,attr"
-	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty" xml:"title,attr"`
+	Title *string `protobuf:"bytes,5,opt,name=title,proto3,oneof" json:"title,omitempty" xml:"title,attr"`
 	// @gotags: xml:"length,attr"
-	Length        int32 `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty" xml:"length,attr"`
+	Length *int32 `protobuf:"varint,6,opt,name=length,proto3,oneof" json:"length,omitempty" xml:"length,attr"`
+	// @gotags: xml:",chardata"
+	Value         string `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3660,40 +3743,47 @@ func (x *Link) GetHref() string {
 }
 
 func (x *Link) GetRel() string {
-	if x != nil {
-		return x.Rel
+	if x != nil && x.Rel != nil {
+		return *x.Rel
 	}
 	return ""
 }
 
 func (x *Link) GetType() string {
-	if x != nil {
-		return x.Type
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
 func (x *Link) GetHreflang() string {
-	if x != nil {
-		return x.Hreflang
+	if x != nil && x.Hreflang != nil {
+		return *x.Hreflang
 	}
 	return ""
 }
 
 func (x *Link) GetTitle() string {
-	if x != nil {
-		return x.Title
+	if x != nil && x.Title != nil {
+		return *x.Title
 	}
 	return ""
 }
 
 func (x *Link) GetLength() int32 {
-	if x != nil {
-		return x.Length
+	if x != nil && x.Length != nil {
+		return *x.Length
 	}
 	return 0
 }
 
+func (x *Link) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Logo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:",chardata"
@@ -3746,7 +3836,9 @@ type Person struct {
 	// @gotags: xml:"uri"
 	Uri *URI `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty" xml:"uri"`
 	// @gotags: xml:"email"
-	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty" xml:"email"`
+	Email *string `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty" xml:"email"`
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,4,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3796,12 +3888,19 @@ func (x *Person) GetUri() *URI {
 }
 
 func (x *Person) GetEmail() string {
-	if x != nil {
-		return x.Email
+	if x != nil && x.Email != nil {
+		return *x.Email
 	}
 	return ""
 }
 
+func (x *Person) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type Source struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"author"
@@ -3827,7 +3926,9 @@ type Source struct {
 	// @gotags: xml:"title"
 	Title *Text `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty" xml:"title"`
 	// @gotags: xml:"updated"
-	Updated       *DateTime `protobuf:"bytes,12,opt,name=updated,proto3" json:"updated,omitempty" xml:"updated"`
+	Updated *DateTime `protobuf:"bytes,12,opt,name=updated,proto3" json:"updated,omitempty" xml:"updated"`
+	// @gotags: xml:",any"
+	AnyElement    []*AnyElement `protobuf:"bytes,13,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3946,10 +4047,21 @@ func (x *Source) GetUpdated() *DateTime {
 	return nil
 }
 
+func (x *Source) GetAnyElement() []*AnyElement {
+	if x != nil {
+		return x.AnyElement
+	}
+	return nil
+}
+
 type Text struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"type,attr"
-	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" xml:"type,attr"`
+	// @gotags: xml:"type,attr" avs:"TextTypeATOM"
+	Type *string `protobuf:"bytes,1,opt,name=type,proto3,oneof" json:"type,omitempty" xml:"type,attr" avs:"TextTypeATOM"`
+	// @gotags: xml:",any"
+	AnyElement []*AnyElement `protobuf:"bytes,2,rep,name=any_element,json=anyElement,proto3" json:"any_element,omitempty" xml:",any"`
+	// @gotags: xml:",chardata"
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3985,8 +4097,22 @@ func (*Text) Descriptor() ([]byte, []int) {
 }
 
 func (x *Text) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *Text) GetAnyElement() []*AnyElement {
 	if x != nil {
-		return x.Type
+		return x.AnyElement
+	}
+	return nil
+}
+
+func (x *Text) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
@@ -4038,10 +4164,10 @@ func (x *URI) GetValue() string {
 
 type AllTerritoryCode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
-	// @gotags: xml:"IdentifierType,attr"
-	IdentifierType string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3" json:"identifier_type,omitempty" xml:"IdentifierType,attr"`
+	// @gotags: xml:",chardata" avs:"AllTerritoryCode"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"AllTerritoryCode"`
+	// @gotags: xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"
+	IdentifierType *string `protobuf:"bytes,2,opt,name=identifier_type,json=identifierType,proto3,oneof" json:"identifier_type,omitempty" xml:"IdentifierType,attr" avs:"TerritoryCodeTypeIncludingDeprecatedCodes"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -4084,8 +4210,8 @@ func (x *AllTerritoryCode) GetValue() string {
 }
 
 func (x *AllTerritoryCode) GetIdentifierType() string {
-	if x != nil {
-		return x.IdentifierType
+	if x != nil && x.IdentifierType != nil {
+		return *x.IdentifierType
 	}
 	return ""
 }
@@ -4096,8 +4222,8 @@ type ArtistType struct {
 	MetadataSourceReference []*MetadataSourceReference `protobuf:"bytes,1,rep,name=metadata_source_reference,json=metadataSourceReference,proto3" json:"metadata_source_reference,omitempty" xml:"MetadataSourceReference"`
 	// @gotags: xml:"Value"
 	Value *ArtistTypeValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" xml:"Value"`
-	// @gotags: xml:"TerritoryOfArtistTypeDescription"
-	TerritoryOfArtistTypeDescription []string `protobuf:"bytes,3,rep,name=territory_of_artist_type_description,json=territoryOfArtistTypeDescription,proto3" json:"territory_of_artist_type_description,omitempty" xml:"TerritoryOfArtistTypeDescription"`
+	// @gotags: xml:"TerritoryOfArtistTypeDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfArtistTypeDescription []string `protobuf:"bytes,3,rep,name=territory_of_artist_type_description,json=territoryOfArtistTypeDescription,proto3" json:"territory_of_artist_type_description,omitempty" xml:"TerritoryOfArtistTypeDescription" avs:"CurrentTerritoryCode"`
 	unknownFields                    protoimpl.UnknownFields
 	sizeCache                        protoimpl.SizeCache
 }
@@ -4155,12 +4281,12 @@ func (x *ArtistType) GetTerritoryOfArtistTypeDescription() []string {
 
 type ArtistTypeValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ArtistType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ArtistType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4203,15 +4329,15 @@ func (x *ArtistTypeValue) GetValue() string {
 }
 
 func (x *ArtistTypeValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ArtistTypeValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -4231,9 +4357,9 @@ type ArtisticInfluence struct {
 	// @gotags: xml:"Description"
 	Description []*TextWithFormat `protobuf:"bytes,6,rep,name=description,proto3" json:"description,omitempty" xml:"Description"`
 	// @gotags: xml:"IsInfluenced"
-	IsInfluenced bool `protobuf:"varint,7,opt,name=is_influenced,json=isInfluenced,proto3" json:"is_influenced,omitempty" xml:"IsInfluenced"`
+	IsInfluenced *bool `protobuf:"varint,7,opt,name=is_influenced,json=isInfluenced,proto3,oneof" json:"is_influenced,omitempty" xml:"IsInfluenced"`
 	// @gotags: xml:"IsInfluencer"
-	IsInfluencer  bool `protobuf:"varint,8,opt,name=is_influencer,json=isInfluencer,proto3" json:"is_influencer,omitempty" xml:"IsInfluencer"`
+	IsInfluencer  *bool `protobuf:"varint,8,opt,name=is_influencer,json=isInfluencer,proto3,oneof" json:"is_influencer,omitempty" xml:"IsInfluencer"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4311,15 +4437,15 @@ func (x *ArtisticInfluence) GetDescription() []*TextWithFormat {
 }
 
 func (x *ArtisticInfluence) GetIsInfluenced() bool {
-	if x != nil {
-		return x.IsInfluenced
+	if x != nil && x.IsInfluenced != nil {
+		return *x.IsInfluenced
 	}
 	return false
 }
 
 func (x *ArtisticInfluence) GetIsInfluencer() bool {
-	if x != nil {
-		return x.IsInfluencer
+	if x != nil && x.IsInfluencer != nil {
+		return *x.IsInfluencer
 	}
 	return false
 }
@@ -4491,15 +4617,15 @@ type BiographyText struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,4,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4542,36 +4668,36 @@ func (x *BiographyText) GetValue() string {
 }
 
 func (x *BiographyText) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *BiographyText) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *BiographyText) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *BiographyText) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *BiographyText) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -4638,8 +4764,8 @@ type ClassicalPeriod struct {
 	Name *PeriodValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" xml:"Name"`
 	// @gotags: xml:"LanguageAndScriptOfClassicalPeriod"
 	LanguageAndScriptOfClassicalPeriod []string `protobuf:"bytes,3,rep,name=language_and_script_of_classical_period,json=languageAndScriptOfClassicalPeriod,proto3" json:"language_and_script_of_classical_period,omitempty" xml:"LanguageAndScriptOfClassicalPeriod"`
-	// @gotags: xml:"TerritoryOfClassicalPeriodDescription"
-	TerritoryOfClassicalPeriodDescription []string `protobuf:"bytes,4,rep,name=territory_of_classical_period_description,json=territoryOfClassicalPeriodDescription,proto3" json:"territory_of_classical_period_description,omitempty" xml:"TerritoryOfClassicalPeriodDescription"`
+	// @gotags: xml:"TerritoryOfClassicalPeriodDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfClassicalPeriodDescription []string `protobuf:"bytes,4,rep,name=territory_of_classical_period_description,json=territoryOfClassicalPeriodDescription,proto3" json:"territory_of_classical_period_description,omitempty" xml:"TerritoryOfClassicalPeriodDescription" avs:"CurrentTerritoryCode"`
 	unknownFields                         protoimpl.UnknownFields
 	sizeCache                             protoimpl.SizeCache
 }
@@ -4712,8 +4838,8 @@ type CommentaryNote struct {
 	CommentaryNoteType []*CommentaryNoteType `protobuf:"bytes,3,rep,name=commentary_note_type,json=commentaryNoteType,proto3" json:"commentary_note_type,omitempty" xml:"CommentaryNoteType"`
 	// @gotags: xml:"LanguageAndScriptOfCommentaryNote"
 	LanguageAndScriptOfCommentaryNote []string `protobuf:"bytes,4,rep,name=language_and_script_of_commentary_note,json=languageAndScriptOfCommentaryNote,proto3" json:"language_and_script_of_commentary_note,omitempty" xml:"LanguageAndScriptOfCommentaryNote"`
-	// @gotags: xml:"TerritoryOfCommentaryNoteDescription"
-	TerritoryOfCommentaryNoteDescription []string `protobuf:"bytes,5,rep,name=territory_of_commentary_note_description,json=territoryOfCommentaryNoteDescription,proto3" json:"territory_of_commentary_note_description,omitempty" xml:"TerritoryOfCommentaryNoteDescription"`
+	// @gotags: xml:"TerritoryOfCommentaryNoteDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfCommentaryNoteDescription []string `protobuf:"bytes,5,rep,name=territory_of_commentary_note_description,json=territoryOfCommentaryNoteDescription,proto3" json:"territory_of_commentary_note_description,omitempty" xml:"TerritoryOfCommentaryNoteDescription" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"Author"
 	Author        []*PartyDescriptorWithPronunciation `protobuf:"bytes,6,rep,name=author,proto3" json:"author,omitempty" xml:"Author"`
 	unknownFields protoimpl.UnknownFields
@@ -4794,12 +4920,12 @@ func (x *CommentaryNote) GetAuthor() []*PartyDescriptorWithPronunciation {
 
 type CommentaryNoteType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"CommentaryNoteType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"CommentaryNoteType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4842,27 +4968,27 @@ func (x *CommentaryNoteType) GetValue() string {
 }
 
 func (x *CommentaryNoteType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *CommentaryNoteType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 type ContributorRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ContributorRole"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ContributorRole"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -4905,15 +5031,15 @@ func (x *ContributorRole) GetValue() string {
 }
 
 func (x *ContributorRole) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ContributorRole) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -4923,7 +5049,7 @@ type Date struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -4966,8 +5092,8 @@ func (x *Date) GetValue() string {
 }
 
 func (x *Date) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
@@ -4977,7 +5103,7 @@ type Description struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5020,8 +5146,8 @@ func (x *Description) GetValue() string {
 }
 
 func (x *Description) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -5031,11 +5157,11 @@ type DetailedHashSum struct {
 	// @gotags: xml:"Algorithm"
 	Algorithm *HashSumAlgorithmType `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" xml:"Algorithm"`
 	// @gotags: xml:"Version"
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty" xml:"Version"`
+	Version *string `protobuf:"bytes,2,opt,name=version,proto3,oneof" json:"version,omitempty" xml:"Version"`
 	// @gotags: xml:"Parameter"
-	Parameter string `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty" xml:"Parameter"`
-	// @gotags: xml:"DataType"
-	DataType string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty" xml:"DataType"`
+	Parameter *string `protobuf:"bytes,3,opt,name=parameter,proto3,oneof" json:"parameter,omitempty" xml:"Parameter"`
+	// @gotags: xml:"DataType" avs:"BinaryDataType"
+	DataType *string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3,oneof" json:"data_type,omitempty" xml:"DataType" avs:"BinaryDataType"`
 	// @gotags: xml:"HashSumValue"
 	HashSumValue  string `protobuf:"bytes,5,opt,name=hash_sum_value,json=hashSumValue,proto3" json:"hash_sum_value,omitempty" xml:"HashSumValue"`
 	unknownFields protoimpl.UnknownFields
@@ -5080,22 +5206,22 @@ func (x *DetailedHashSum) GetAlgorithm() *HashSumAlgorithmType {
 }
 
 func (x *DetailedHashSum) GetVersion() string {
-	if x != nil {
-		return x.Version
+	if x != nil && x.Version != nil {
+		return *x.Version
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetParameter() string {
-	if x != nil {
-		return x.Parameter
+	if x != nil && x.Parameter != nil {
+		return *x.Parameter
 	}
 	return ""
 }
 
 func (x *DetailedHashSum) GetDataType() string {
-	if x != nil {
-		return x.DataType
+	if x != nil && x.DataType != nil {
+		return *x.DataType
 	}
 	return ""
 }
@@ -5110,15 +5236,15 @@ func (x *DetailedHashSum) GetHashSumValue() string {
 type DetailedPartyId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"DPID"
-	DPID string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3" json:"d_p_i_d,omitempty" xml:"DPID"`
+	DPID *string `protobuf:"bytes,2,opt,name=d_p_i_d,json=dPID,proto3,oneof" json:"d_p_i_d,omitempty" xml:"DPID"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,3,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,4,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"CisacSocietyId"
-	CisacSocietyId string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
+	CisacSocietyId *string `protobuf:"bytes,5,opt,name=cisac_society_id,json=cisacSocietyId,proto3,oneof" json:"cisac_society_id,omitempty" xml:"CisacSocietyId"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,6,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	unknownFields protoimpl.UnknownFields
@@ -5156,36 +5282,36 @@ func (*DetailedPartyId) Descriptor() ([]byte, []int) {
 }
 
 func (x *DetailedPartyId) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetDPID() string {
-	if x != nil {
-		return x.DPID
+	if x != nil && x.DPID != nil {
+		return *x.DPID
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
 
 func (x *DetailedPartyId) GetCisacSocietyId() string {
-	if x != nil {
-		return x.CisacSocietyId
+	if x != nil && x.CisacSocietyId != nil {
+		return *x.CisacSocietyId
 	}
 	return ""
 }
@@ -5202,9 +5328,9 @@ type DisplayArtistName struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -5247,15 +5373,15 @@ func (x *DisplayArtistName) GetValue() string {
 }
 
 func (x *DisplayArtistName) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistName) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -5265,11 +5391,11 @@ type DisplayArtistNameWithDefault struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5312,22 +5438,22 @@ func (x *DisplayArtistNameWithDefault) GetValue() string {
 }
 
 func (x *DisplayArtistNameWithDefault) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithDefault) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayArtistNameWithDefault) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -5393,15 +5519,15 @@ type DisplaySubTitle struct {
 	// @gotags: xml:"Pronunciation"
 	Pronunciation []*Pronunciation `protobuf:"bytes,2,rep,name=pronunciation,proto3" json:"pronunciation,omitempty" xml:"Pronunciation"`
 	// @gotags: xml:"SequenceNumber,attr"
-	SequenceNumber int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
+	SequenceNumber *int32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3,oneof" json:"sequence_number,omitempty" xml:"SequenceNumber,attr"`
 	// @gotags: xml:"IsDisplayedInTitle,attr"
-	IsDisplayedInTitle bool `protobuf:"varint,4,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
+	IsDisplayedInTitle *bool `protobuf:"varint,4,opt,name=is_displayed_in_title,json=isDisplayedInTitle,proto3,oneof" json:"is_displayed_in_title,omitempty" xml:"IsDisplayedInTitle,attr"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	// @gotags: xml:"SubTitleType,attr"
-	SubTitleType  string `protobuf:"bytes,7,opt,name=sub_title_type,json=subTitleType,proto3" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
+	SubTitleType  *string `protobuf:"bytes,7,opt,name=sub_title_type,json=subTitleType,proto3,oneof" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5451,36 +5577,36 @@ func (x *DisplaySubTitle) GetPronunciation() []*Pronunciation {
 }
 
 func (x *DisplaySubTitle) GetSequenceNumber() int32 {
-	if x != nil {
-		return x.SequenceNumber
+	if x != nil && x.SequenceNumber != nil {
+		return *x.SequenceNumber
 	}
 	return 0
 }
 
 func (x *DisplaySubTitle) GetIsDisplayedInTitle() bool {
-	if x != nil {
-		return x.IsDisplayedInTitle
+	if x != nil && x.IsDisplayedInTitle != nil {
+		return *x.IsDisplayedInTitle
 	}
 	return false
 }
 
 func (x *DisplaySubTitle) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *DisplaySubTitle) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
 
 func (x *DisplaySubTitle) GetSubTitleType() string {
-	if x != nil {
-		return x.SubTitleType
+	if x != nil && x.SubTitleType != nil {
+		return *x.SubTitleType
 	}
 	return ""
 }
@@ -5492,11 +5618,11 @@ type DisplayTitle struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle []*DisplaySubTitle `protobuf:"bytes,2,rep,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,4,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault     bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	IsDefault     *bool `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5546,22 +5672,22 @@ func (x *DisplayTitle) GetSubTitle() []*DisplaySubTitle {
 }
 
 func (x *DisplayTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *DisplayTitle) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
@@ -5582,8 +5708,8 @@ type Epoch struct {
 	EndDate *Date `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty" xml:"EndDate"`
 	// @gotags: xml:"LanguageAndScriptOfEpoch"
 	LanguageAndScriptOfEpoch []string `protobuf:"bytes,7,rep,name=language_and_script_of_epoch,json=languageAndScriptOfEpoch,proto3" json:"language_and_script_of_epoch,omitempty" xml:"LanguageAndScriptOfEpoch"`
-	// @gotags: xml:"TerritoryOfEpochDescription"
-	TerritoryOfEpochDescription []string `protobuf:"bytes,8,rep,name=territory_of_epoch_description,json=territoryOfEpochDescription,proto3" json:"territory_of_epoch_description,omitempty" xml:"TerritoryOfEpochDescription"`
+	// @gotags: xml:"TerritoryOfEpochDescription" avs:"CurrentTerritoryCode"
+	TerritoryOfEpochDescription []string `protobuf:"bytes,8,rep,name=territory_of_epoch_description,json=territoryOfEpochDescription,proto3" json:"territory_of_epoch_description,omitempty" xml:"TerritoryOfEpochDescription" avs:"CurrentTerritoryCode"`
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
@@ -5679,17 +5805,17 @@ type EventDate struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsApproximate,attr"
-	IsApproximate bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
+	IsApproximate *bool `protobuf:"varint,2,opt,name=is_approximate,json=isApproximate,proto3,oneof" json:"is_approximate,omitempty" xml:"IsApproximate,attr"`
 	// @gotags: xml:"IsBefore,attr"
-	IsBefore bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3" json:"is_before,omitempty" xml:"IsBefore,attr"`
+	IsBefore *bool `protobuf:"varint,3,opt,name=is_before,json=isBefore,proto3,oneof" json:"is_before,omitempty" xml:"IsBefore,attr"`
 	// @gotags: xml:"IsAfter,attr"
-	IsAfter bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3" json:"is_after,omitempty" xml:"IsAfter,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	IsAfter *bool `protobuf:"varint,4,opt,name=is_after,json=isAfter,proto3,oneof" json:"is_after,omitempty" xml:"IsAfter,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,5,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"AllTerritoryCode"`
 	// @gotags: xml:"LocationDescription,attr"
-	LocationDescription string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3" json:"location_description,omitempty" xml:"LocationDescription,attr"`
+	LocationDescription *string `protobuf:"bytes,6,opt,name=location_description,json=locationDescription,proto3,oneof" json:"location_description,omitempty" xml:"LocationDescription,attr"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,7,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -5732,43 +5858,43 @@ func (x *EventDate) GetValue() string {
 }
 
 func (x *EventDate) GetIsApproximate() bool {
-	if x != nil {
-		return x.IsApproximate
+	if x != nil && x.IsApproximate != nil {
+		return *x.IsApproximate
 	}
 	return false
 }
 
 func (x *EventDate) GetIsBefore() bool {
-	if x != nil {
-		return x.IsBefore
+	if x != nil && x.IsBefore != nil {
+		return *x.IsBefore
 	}
 	return false
 }
 
 func (x *EventDate) GetIsAfter() bool {
-	if x != nil {
-		return x.IsAfter
+	if x != nil && x.IsAfter != nil {
+		return *x.IsAfter
 	}
 	return false
 }
 
 func (x *EventDate) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *EventDate) GetLocationDescription() string {
-	if x != nil {
-		return x.LocationDescription
+	if x != nil && x.LocationDescription != nil {
+		return *x.LocationDescription
 	}
 	return ""
 }
 
 func (x *EventDate) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -5780,7 +5906,7 @@ type File struct {
 	// @gotags: xml:"HashSum"
 	HashSum *DetailedHashSum `protobuf:"bytes,2,opt,name=hash_sum,json=hashSum,proto3" json:"hash_sum,omitempty" xml:"HashSum"`
 	// @gotags: xml:"FileSize"
-	FileSize      string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty" xml:"FileSize"`
+	FileSize      *string `protobuf:"bytes,3,opt,name=file_size,json=fileSize,proto3,oneof" json:"file_size,omitempty" xml:"FileSize"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -5830,20 +5956,20 @@ func (x *File) GetHashSum() *DetailedHashSum {
 }
 
 func (x *File) GetFileSize() string {
-	if x != nil {
-		return x.FileSize
+	if x != nil && x.FileSize != nil {
+		return *x.FileSize
 	}
 	return ""
 }
 
 type HashSumAlgorithmType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"HashSumAlgorithmType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"HashSumAlgorithmType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -5886,15 +6012,15 @@ func (x *HashSumAlgorithmType) GetValue() string {
 }
 
 func (x *HashSumAlgorithmType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *HashSumAlgorithmType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -5964,12 +6090,12 @@ func (x *Image) GetImageType() []*ImageType {
 
 type ImageType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"ImageType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"ImageType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -6012,15 +6138,15 @@ func (x *ImageType) GetValue() string {
 }
 
 func (x *ImageType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *ImageType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -6127,11 +6253,11 @@ func (x *MessageAuditTrailEvent) GetDateTime() string {
 type MessageHeader struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"MessageThreadId"
-	MessageThreadId string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
+	MessageThreadId *string `protobuf:"bytes,1,opt,name=message_thread_id,json=messageThreadId,proto3,oneof" json:"message_thread_id,omitempty" xml:"MessageThreadId"`
 	// @gotags: xml:"MessageId"
 	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty" xml:"MessageId"`
 	// @gotags: xml:"MessageFileName"
-	MessageFileName string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3" json:"message_file_name,omitempty" xml:"MessageFileName"`
+	MessageFileName *string `protobuf:"bytes,3,opt,name=message_file_name,json=messageFileName,proto3,oneof" json:"message_file_name,omitempty" xml:"MessageFileName"`
 	// @gotags: xml:"MessageSender"
 	MessageSender *MessagingPartyWithoutCode `protobuf:"bytes,4,opt,name=message_sender,json=messageSender,proto3" json:"message_sender,omitempty" xml:"MessageSender"`
 	// @gotags: xml:"SentOnBehalfOf"
@@ -6142,8 +6268,8 @@ type MessageHeader struct {
 	MessageCreatedDateTime string `protobuf:"bytes,7,opt,name=message_created_date_time,json=messageCreatedDateTime,proto3" json:"message_created_date_time,omitempty" xml:"MessageCreatedDateTime"`
 	// @gotags: xml:"MessageAuditTrail"
 	MessageAuditTrail *MessageAuditTrail `protobuf:"bytes,8,opt,name=message_audit_trail,json=messageAuditTrail,proto3" json:"message_audit_trail,omitempty" xml:"MessageAuditTrail"`
-	// @gotags: xml:"MessageControlType"
-	MessageControlType string `protobuf:"bytes,9,opt,name=message_control_type,json=messageControlType,proto3" json:"message_control_type,omitempty" xml:"MessageControlType"`
+	// @gotags: xml:"MessageControlType" avs:"MessageControlType"
+	MessageControlType *string `protobuf:"bytes,9,opt,name=message_control_type,json=messageControlType,proto3,oneof" json:"message_control_type,omitempty" xml:"MessageControlType" avs:"MessageControlType"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
@@ -6179,8 +6305,8 @@ func (*MessageHeader) Descriptor() ([]byte, []int) {
 }
 
 func (x *MessageHeader) GetMessageThreadId() string {
-	if x != nil {
-		return x.MessageThreadId
+	if x != nil && x.MessageThreadId != nil {
+		return *x.MessageThreadId
 	}
 	return ""
 }
@@ -6193,8 +6319,8 @@ func (x *MessageHeader) GetMessageId() string {
 }
 
 func (x *MessageHeader) GetMessageFileName() string {
-	if x != nil {
-		return x.MessageFileName
+	if x != nil && x.MessageFileName != nil {
+		return *x.MessageFileName
 	}
 	return ""
 }
@@ -6235,8 +6361,8 @@ func (x *MessageHeader) GetMessageAuditTrail() *MessageAuditTrail {
 }
 
 func (x *MessageHeader) GetMessageControlType() string {
-	if x != nil {
-		return x.MessageControlType
+	if x != nil && x.MessageControlType != nil {
+		return *x.MessageControlType
 	}
 	return ""
 }
@@ -6248,7 +6374,7 @@ type MessagingPartyWithoutCode struct {
 	// @gotags: xml:"PartyName"
 	PartyName *PartyNameWithoutCode `protobuf:"bytes,2,opt,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
 	// @gotags: xml:"TradingName"
-	TradingName   string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3" json:"trading_name,omitempty" xml:"TradingName"`
+	TradingName   *string `protobuf:"bytes,3,opt,name=trading_name,json=tradingName,proto3,oneof" json:"trading_name,omitempty" xml:"TradingName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6298,8 +6424,8 @@ func (x *MessagingPartyWithoutCode) GetPartyName() *PartyNameWithoutCode {
 }
 
 func (x *MessagingPartyWithoutCode) GetTradingName() string {
-	if x != nil {
-		return x.TradingName
+	if x != nil && x.TradingName != nil {
+		return *x.TradingName
 	}
 	return ""
 }
@@ -6308,14 +6434,14 @@ type MetadataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"SourceReference"
 	SourceReference string `protobuf:"bytes,1,opt,name=source_reference,json=sourceReference,proto3" json:"source_reference,omitempty" xml:"SourceReference"`
-	// @gotags: xml:"MetadataSourceType"
-	MetadataSourceType *MetadataSourceType `protobuf:"bytes,2,opt,name=metadata_source_type,json=metadataSourceType,proto3" json:"metadata_source_type,omitempty" xml:"MetadataSourceType"`
 	// @gotags: xml:"PartyId"
 	PartyId []*DetailedPartyId `protobuf:"bytes,3,rep,name=party_id,json=partyId,proto3" json:"party_id,omitempty" xml:"PartyId"`
 	// @gotags: xml:"PartyName"
-	PartyName     []*PartyNameWithPronunciation `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PartyName []*PartyNameWithPronunciation `protobuf:"bytes,4,rep,name=party_name,json=partyName,proto3" json:"party_name,omitempty" xml:"PartyName"`
+	// @gotags: xml:"MetadataSourceType"
+	MetadataSourceType *MetadataSourceType `protobuf:"bytes,2,opt,name=metadata_source_type,json=metadataSourceType,proto3" json:"metadata_source_type,omitempty" xml:"MetadataSourceType"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *MetadataSource) Reset() {
@@ -6355,23 +6481,23 @@ func (x *MetadataSource) GetSourceReference() string {
 	return ""
 }
 
-func (x *MetadataSource) GetMetadataSourceType() *MetadataSourceType {
+func (x *MetadataSource) GetPartyId() []*DetailedPartyId {
 	if x != nil {
-		return x.MetadataSourceType
+		return x.PartyId
 	}
 	return nil
 }
 
-func (x *MetadataSource) GetPartyId() []*DetailedPartyId {
+func (x *MetadataSource) GetPartyName() []*PartyNameWithPronunciation {
 	if x != nil {
-		return x.PartyId
+		return x.PartyName
 	}
 	return nil
 }
 
-func (x *MetadataSource) GetPartyName() []*PartyNameWithPronunciation {
+func (x *MetadataSource) GetMetadataSourceType() *MetadataSourceType {
 	if x != nil {
-		return x.PartyName
+		return x.MetadataSourceType
 	}
 	return nil
 }
@@ -6426,11 +6552,11 @@ type MetadataSourceReference struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"AssertionDateTime,attr"
-	AssertionDateTime string `protobuf:"bytes,2,opt,name=assertion_date_time,json=assertionDateTime,proto3" json:"assertion_date_time,omitempty" xml:"AssertionDateTime,attr"`
-	// @gotags: xml:"Status,attr"
-	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty" xml:"Status,attr"`
+	AssertionDateTime *string `protobuf:"bytes,2,opt,name=assertion_date_time,json=assertionDateTime,proto3,oneof" json:"assertion_date_time,omitempty" xml:"AssertionDateTime,attr"`
+	// @gotags: xml:"Status,attr" avs:"AssertionStatus"
+	Status *string `protobuf:"bytes,3,opt,name=status,proto3,oneof" json:"status,omitempty" xml:"Status,attr" avs:"AssertionStatus"`
 	// @gotags: xml:"Weight,attr"
-	Weight        string `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty" xml:"Weight,attr"`
+	Weight        *string `protobuf:"bytes,4,opt,name=weight,proto3,oneof" json:"weight,omitempty" xml:"Weight,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6473,34 +6599,34 @@ func (x *MetadataSourceReference) GetValue() string {
 }
 
 func (x *MetadataSourceReference) GetAssertionDateTime() string {
-	if x != nil {
-		return x.AssertionDateTime
+	if x != nil && x.AssertionDateTime != nil {
+		return *x.AssertionDateTime
 	}
 	return ""
 }
 
 func (x *MetadataSourceReference) GetStatus() string {
-	if x != nil {
-		return x.Status
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return ""
 }
 
 func (x *MetadataSourceReference) GetWeight() string {
-	if x != nil {
-		return x.Weight
+	if x != nil && x.Weight != nil {
+		return *x.Weight
 	}
 	return ""
 }
 
 type MetadataSourceType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"MetadataSourceType"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"MetadataSourceType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -6543,15 +6669,15 @@ func (x *MetadataSourceType) GetValue() string {
 }
 
 func (x *MetadataSourceType) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *MetadataSourceType) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -6559,9 +6685,9 @@ func (x *MetadataSourceType) GetUserDefinedValue() string {
 type MusicalWorkIdWithoutFlag struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"OpusNumber"
-	OpusNumber string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3" json:"opus_number,omitempty" xml:"OpusNumber"`
+	OpusNumber *string `protobuf:"bytes,2,opt,name=opus_number,json=opusNumber,proto3,oneof" json:"opus_number,omitempty" xml:"OpusNumber"`
 	// @gotags: xml:"ComposerCatalogNumber"
 	ComposerCatalogNumber []string `protobuf:"bytes,3,rep,name=composer_catalog_number,json=composerCatalogNumber,proto3" json:"composer_catalog_number,omitempty" xml:"ComposerCatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -6601,15 +6727,15 @@ func (*MusicalWorkIdWithoutFlag) Descriptor() ([]byte, []int) {
 }
 
 func (x *MusicalWorkIdWithoutFlag) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *MusicalWorkIdWithoutFlag) GetOpusNumber() string {
-	if x != nil {
-		return x.OpusNumber
+	if x != nil && x.OpusNumber != nil {
+		return *x.OpusNumber
 	}
 	return ""
 }
@@ -6633,7 +6759,7 @@ type Name struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -6676,8 +6802,8 @@ func (x *Name) GetValue() string {
 }
 
 func (x *Name) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -6685,15 +6811,15 @@ func (x *Name) GetLanguageAndScriptCode() string {
 type NameId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISNI"
-	ISNI string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3" json:"i_s_n_i,omitempty" xml:"ISNI"`
+	ISNI *string `protobuf:"bytes,1,opt,name=i_s_n_i,json=iSNI,proto3,oneof" json:"i_s_n_i,omitempty" xml:"ISNI"`
 	// @gotags: xml:"IpiNameNumber"
-	IpiNameNumber string `protobuf:"bytes,2,opt,name=ipi_name_number,json=ipiNameNumber,proto3" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
+	IpiNameNumber *string `protobuf:"bytes,2,opt,name=ipi_name_number,json=ipiNameNumber,proto3,oneof" json:"ipi_name_number,omitempty" xml:"IpiNameNumber"`
 	// @gotags: xml:"IPN"
-	IPN string `protobuf:"bytes,3,opt,name=i_p_n,json=iPN,proto3" json:"i_p_n,omitempty" xml:"IPN"`
+	IPN *string `protobuf:"bytes,3,opt,name=i_p_n,json=iPN,proto3,oneof" json:"i_p_n,omitempty" xml:"IPN"`
 	// @gotags: xml:"ProprietaryId"
 	ProprietaryId []*ProprietaryId `protobuf:"bytes,4,rep,name=proprietary_id,json=proprietaryId,proto3" json:"proprietary_id,omitempty" xml:"ProprietaryId"`
 	// @gotags: xml:"IsDeprecated,attr"
-	IsDeprecated  bool `protobuf:"varint,5,opt,name=is_deprecated,json=isDeprecated,proto3" json:"is_deprecated,omitempty" xml:"IsDeprecated,attr"`
+	IsDeprecated  *bool `protobuf:"varint,5,opt,name=is_deprecated,json=isDeprecated,proto3,oneof" json:"is_deprecated,omitempty" xml:"IsDeprecated,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6729,22 +6855,22 @@ func (*NameId) Descriptor() ([]byte, []int) {
 }
 
 func (x *NameId) GetISNI() string {
-	if x != nil {
-		return x.ISNI
+	if x != nil && x.ISNI != nil {
+		return *x.ISNI
 	}
 	return ""
 }
 
 func (x *NameId) GetIpiNameNumber() string {
-	if x != nil {
-		return x.IpiNameNumber
+	if x != nil && x.IpiNameNumber != nil {
+		return *x.IpiNameNumber
 	}
 	return ""
 }
 
 func (x *NameId) GetIPN() string {
-	if x != nil {
-		return x.IPN
+	if x != nil && x.IPN != nil {
+		return *x.IPN
 	}
 	return ""
 }
@@ -6757,8 +6883,8 @@ func (x *NameId) GetProprietaryId() []*ProprietaryId {
 }
 
 func (x *NameId) GetIsDeprecated() bool {
-	if x != nil {
-		return x.IsDeprecated
+	if x != nil && x.IsDeprecated != nil {
+		return *x.IsDeprecated
 	}
 	return false
 }
@@ -6888,9 +7014,9 @@ type PartyNameWithPronunciation struct {
 	// @gotags: xml:"AbbreviatedName"
 	AbbreviatedName *NameWithPronunciationAndScriptCode `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,9,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,8,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,9,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
@@ -6975,15 +7101,15 @@ func (x *PartyNameWithPronunciation) GetAbbreviatedName() *NameWithPronunciation
 }
 
 func (x *PartyNameWithPronunciation) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *PartyNameWithPronunciation) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
@@ -6993,17 +7119,17 @@ type PartyNameWithoutCode struct {
 	// @gotags: xml:"FullName"
 	FullName string `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty" xml:"FullName"`
 	// @gotags: xml:"FullNameAsciiTranscribed"
-	FullNameAsciiTranscribed string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
+	FullNameAsciiTranscribed *string `protobuf:"bytes,2,opt,name=full_name_ascii_transcribed,json=fullNameAsciiTranscribed,proto3,oneof" json:"full_name_ascii_transcribed,omitempty" xml:"FullNameAsciiTranscribed"`
 	// @gotags: xml:"FullNameIndexed"
-	FullNameIndexed string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
+	FullNameIndexed *string `protobuf:"bytes,3,opt,name=full_name_indexed,json=fullNameIndexed,proto3,oneof" json:"full_name_indexed,omitempty" xml:"FullNameIndexed"`
 	// @gotags: xml:"NamesBeforeKeyName"
-	NamesBeforeKeyName string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
+	NamesBeforeKeyName *string `protobuf:"bytes,4,opt,name=names_before_key_name,json=namesBeforeKeyName,proto3,oneof" json:"names_before_key_name,omitempty" xml:"NamesBeforeKeyName"`
 	// @gotags: xml:"KeyName"
-	KeyName string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3" json:"key_name,omitempty" xml:"KeyName"`
+	KeyName *string `protobuf:"bytes,5,opt,name=key_name,json=keyName,proto3,oneof" json:"key_name,omitempty" xml:"KeyName"`
 	// @gotags: xml:"NamesAfterKeyName"
-	NamesAfterKeyName string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
+	NamesAfterKeyName *string `protobuf:"bytes,6,opt,name=names_after_key_name,json=namesAfterKeyName,proto3,oneof" json:"names_after_key_name,omitempty" xml:"NamesAfterKeyName"`
 	// @gotags: xml:"AbbreviatedName"
-	AbbreviatedName string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
+	AbbreviatedName *string `protobuf:"bytes,7,opt,name=abbreviated_name,json=abbreviatedName,proto3,oneof" json:"abbreviated_name,omitempty" xml:"AbbreviatedName"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -7046,55 +7172,55 @@ func (x *PartyNameWithoutCode) GetFullName() string {
 }
 
 func (x *PartyNameWithoutCode) GetFullNameAsciiTranscribed() string {
-	if x != nil {
-		return x.FullNameAsciiTranscribed
+	if x != nil && x.FullNameAsciiTranscribed != nil {
+		return *x.FullNameAsciiTranscribed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetFullNameIndexed() string {
-	if x != nil {
-		return x.FullNameIndexed
+	if x != nil && x.FullNameIndexed != nil {
+		return *x.FullNameIndexed
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesBeforeKeyName() string {
-	if x != nil {
-		return x.NamesBeforeKeyName
+	if x != nil && x.NamesBeforeKeyName != nil {
+		return *x.NamesBeforeKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetKeyName() string {
-	if x != nil {
-		return x.KeyName
+	if x != nil && x.KeyName != nil {
+		return *x.KeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetNamesAfterKeyName() string {
-	if x != nil {
-		return x.NamesAfterKeyName
+	if x != nil && x.NamesAfterKeyName != nil {
+		return *x.NamesAfterKeyName
 	}
 	return ""
 }
 
 func (x *PartyNameWithoutCode) GetAbbreviatedName() string {
-	if x != nil {
-		return x.AbbreviatedName
+	if x != nil && x.AbbreviatedName != nil {
+		return *x.AbbreviatedName
 	}
 	return ""
 }
 
 type PeriodValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"Period"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"Period"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -7137,15 +7263,15 @@ func (x *PeriodValue) GetValue() string {
 }
 
 func (x *PeriodValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *PeriodValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -7153,9 +7279,9 @@ func (x *PeriodValue) GetUserDefinedValue() string {
 type PeriodWithTime struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"StartDateTime"
-	StartDateTime string `protobuf:"bytes,1,opt,name=start_date_time,json=startDateTime,proto3" json:"start_date_time,omitempty" xml:"StartDateTime"`
+	StartDateTime *string `protobuf:"bytes,1,opt,name=start_date_time,json=startDateTime,proto3,oneof" json:"start_date_time,omitempty" xml:"StartDateTime"`
 	// @gotags: xml:"EndDateTime"
-	EndDateTime   string `protobuf:"bytes,2,opt,name=end_date_time,json=endDateTime,proto3" json:"end_date_time,omitempty" xml:"EndDateTime"`
+	EndDateTime   *string `protobuf:"bytes,2,opt,name=end_date_time,json=endDateTime,proto3,oneof" json:"end_date_time,omitempty" xml:"EndDateTime"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7191,15 +7317,15 @@ func (*PeriodWithTime) Descriptor() ([]byte, []int) {
 }
 
 func (x *PeriodWithTime) GetStartDateTime() string {
-	if x != nil {
-		return x.StartDateTime
+	if x != nil && x.StartDateTime != nil {
+		return *x.StartDateTime
 	}
 	return ""
 }
 
 func (x *PeriodWithTime) GetEndDateTime() string {
-	if x != nil {
-		return x.EndDateTime
+	if x != nil && x.EndDateTime != nil {
+		return *x.EndDateTime
 	}
 	return ""
 }
@@ -7209,15 +7335,15 @@ type Pronunciation struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"IsCanonical,attr"
-	IsCanonical bool `protobuf:"varint,2,opt,name=is_canonical,json=isCanonical,proto3" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
+	IsCanonical *bool `protobuf:"varint,2,opt,name=is_canonical,json=isCanonical,proto3,oneof" json:"is_canonical,omitempty" xml:"IsCanonical,attr"`
 	// @gotags: xml:"LanguageCode,attr"
-	LanguageCode string `protobuf:"bytes,3,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty" xml:"LanguageCode,attr"`
-	// @gotags: xml:"EncodingType,attr"
-	EncodingType string `protobuf:"bytes,4,opt,name=encoding_type,json=encodingType,proto3" json:"encoding_type,omitempty" xml:"EncodingType,attr"`
+	LanguageCode *string `protobuf:"bytes,3,opt,name=language_code,json=languageCode,proto3,oneof" json:"language_code,omitempty" xml:"LanguageCode,attr"`
+	// @gotags: xml:"EncodingType,attr" avs:"EncodingType"
+	EncodingType *string `protobuf:"bytes,4,opt,name=encoding_type,json=encodingType,proto3,oneof" json:"encoding_type,omitempty" xml:"EncodingType,attr" avs:"EncodingType"`
 	// @gotags: xml:"EncodingNamespace,attr"
-	EncodingNamespace string `protobuf:"bytes,5,opt,name=encoding_namespace,json=encodingNamespace,proto3" json:"encoding_namespace,omitempty" xml:"EncodingNamespace,attr"`
+	EncodingNamespace *string `protobuf:"bytes,5,opt,name=encoding_namespace,json=encodingNamespace,proto3,oneof" json:"encoding_namespace,omitempty" xml:"EncodingNamespace,attr"`
 	// @gotags: xml:"EncodingUserDefinedValue,attr"
-	EncodingUserDefinedValue string `protobuf:"bytes,6,opt,name=encoding_user_defined_value,json=encodingUserDefinedValue,proto3" json:"encoding_user_defined_value,omitempty" xml:"EncodingUserDefinedValue,attr"`
+	EncodingUserDefinedValue *string `protobuf:"bytes,6,opt,name=encoding_user_defined_value,json=encodingUserDefinedValue,proto3,oneof" json:"encoding_user_defined_value,omitempty" xml:"EncodingUserDefinedValue,attr"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -7260,36 +7386,36 @@ func (x *Pronunciation) GetValue() string {
 }
 
 func (x *Pronunciation) GetIsCanonical() bool {
-	if x != nil {
-		return x.IsCanonical
+	if x != nil && x.IsCanonical != nil {
+		return *x.IsCanonical
 	}
 	return false
 }
 
 func (x *Pronunciation) GetLanguageCode() string {
-	if x != nil {
-		return x.LanguageCode
+	if x != nil && x.LanguageCode != nil {
+		return *x.LanguageCode
 	}
 	return ""
 }
 
 func (x *Pronunciation) GetEncodingType() string {
-	if x != nil {
-		return x.EncodingType
+	if x != nil && x.EncodingType != nil {
+		return *x.EncodingType
 	}
 	return ""
 }
 
 func (x *Pronunciation) GetEncodingNamespace() string {
-	if x != nil {
-		return x.EncodingNamespace
+	if x != nil && x.EncodingNamespace != nil {
+		return *x.EncodingNamespace
 	}
 	return ""
 }
 
 func (x *Pronunciation) GetEncodingUserDefinedValue() string {
-	if x != nil {
-		return x.EncodingUserDefinedValue
+	if x != nil && x.EncodingUserDefinedValue != nil {
+		return *x.EncodingUserDefinedValue
 	}
 	return ""
 }
@@ -7350,14 +7476,14 @@ func (x *ProprietaryId) GetNamespace() string {
 
 type RelatedCreation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:"Title"
-	Title *TitleWithPronunciation `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	// @gotags: xml:"ReleaseId"
 	ReleaseId *ReleaseId `protobuf:"bytes,2,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty" xml:"ReleaseId"`
 	// @gotags: xml:"ResourceId"
 	ResourceId *ResourceIdWithoutFlag `protobuf:"bytes,3,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty" xml:"ResourceId"`
 	// @gotags: xml:"MusicalWorkId"
 	MusicalWorkId *MusicalWorkIdWithoutFlag `protobuf:"bytes,4,opt,name=musical_work_id,json=musicalWorkId,proto3" json:"musical_work_id,omitempty" xml:"MusicalWorkId"`
+	// @gotags: xml:"Title"
+	Title         *TitleWithPronunciation `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" xml:"Title"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -7392,13 +7518,6 @@ func (*RelatedCreation) Descriptor() ([]byte, []int) {
 	return file_ddex_pie_v10_v10_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *RelatedCreation) GetTitle() *TitleWithPronunciation {
-	if x != nil {
-		return x.Title
-	}
-	return nil
-}
-
 func (x *RelatedCreation) GetReleaseId() *ReleaseId {
 	if x != nil {
 		return x.ReleaseId
@@ -7420,14 +7539,21 @@ func (x *RelatedCreation) GetMusicalWorkId() *MusicalWorkIdWithoutFlag {
 	return nil
 }
 
+func (x *RelatedCreation) GetTitle() *TitleWithPronunciation {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
 type Release struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ICPN"
-	ICPN string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
+	ICPN *string `protobuf:"bytes,2,opt,name=i_c_p_n,json=iCPN,proto3,oneof" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"ProprietaryReleaseId"
-	ProprietaryReleaseId string `protobuf:"bytes,3,opt,name=proprietary_release_id,json=proprietaryReleaseId,proto3" json:"proprietary_release_id,omitempty" xml:"ProprietaryReleaseId"`
+	ProprietaryReleaseId *string `protobuf:"bytes,3,opt,name=proprietary_release_id,json=proprietaryReleaseId,proto3,oneof" json:"proprietary_release_id,omitempty" xml:"ProprietaryReleaseId"`
 	// @gotags: xml:"ReleaseTitle"
 	ReleaseTitle []*ReleaseTitle `protobuf:"bytes,4,rep,name=release_title,json=releaseTitle,proto3" json:"release_title,omitempty" xml:"ReleaseTitle"`
 	// @gotags: xml:"DisplayArtistName"
@@ -7469,22 +7595,22 @@ func (*Release) Descriptor() ([]byte, []int) {
 }
 
 func (x *Release) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *Release) GetICPN() string {
-	if x != nil {
-		return x.ICPN
+	if x != nil && x.ICPN != nil {
+		return *x.ICPN
 	}
 	return ""
 }
 
 func (x *Release) GetProprietaryReleaseId() string {
-	if x != nil {
-		return x.ProprietaryReleaseId
+	if x != nil && x.ProprietaryReleaseId != nil {
+		return *x.ProprietaryReleaseId
 	}
 	return ""
 }
@@ -7513,11 +7639,11 @@ func (x *Release) GetDisplayArtist() []*PartyDescriptorWithPronunciation {
 type ReleaseId struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"GRid"
-	GRid string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3" json:"g_rid,omitempty" xml:"GRid"`
+	GRid *string `protobuf:"bytes,1,opt,name=g_rid,json=gRid,proto3,oneof" json:"g_rid,omitempty" xml:"GRid"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,2,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ICPN"
-	ICPN string `protobuf:"bytes,3,opt,name=i_c_p_n,json=iCPN,proto3" json:"i_c_p_n,omitempty" xml:"ICPN"`
+	ICPN *string `protobuf:"bytes,3,opt,name=i_c_p_n,json=iCPN,proto3,oneof" json:"i_c_p_n,omitempty" xml:"ICPN"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,4,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -7557,22 +7683,22 @@ func (*ReleaseId) Descriptor() ([]byte, []int) {
 }
 
 func (x *ReleaseId) GetGRid() string {
-	if x != nil {
-		return x.GRid
+	if x != nil && x.GRid != nil {
+		return *x.GRid
 	}
 	return ""
 }
 
 func (x *ReleaseId) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *ReleaseId) GetICPN() string {
-	if x != nil {
-		return x.ICPN
+	if x != nil && x.ICPN != nil {
+		return *x.ICPN
 	}
 	return ""
 }
@@ -7668,7 +7794,7 @@ type ReleaseTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7711,8 +7837,8 @@ func (x *ReleaseTitle) GetValue() string {
 }
 
 func (x *ReleaseTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -7720,11 +7846,11 @@ func (x *ReleaseTitle) GetLanguageAndScriptCode() string {
 type Resource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"CatalogNumber"
-	CatalogNumber string `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
+	CatalogNumber *string `protobuf:"bytes,2,opt,name=catalog_number,json=catalogNumber,proto3,oneof" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryResourceId"
-	ProprietaryResourceId string `protobuf:"bytes,3,opt,name=proprietary_resource_id,json=proprietaryResourceId,proto3" json:"proprietary_resource_id,omitempty" xml:"ProprietaryResourceId"`
+	ProprietaryResourceId *string `protobuf:"bytes,3,opt,name=proprietary_resource_id,json=proprietaryResourceId,proto3,oneof" json:"proprietary_resource_id,omitempty" xml:"ProprietaryResourceId"`
 	// @gotags: xml:"ResourceTitle"
 	ResourceTitle []*ResourceTitle `protobuf:"bytes,4,rep,name=resource_title,json=resourceTitle,proto3" json:"resource_title,omitempty" xml:"ResourceTitle"`
 	// @gotags: xml:"DisplayArtistName"
@@ -7766,22 +7892,22 @@ func (*Resource) Descriptor() ([]byte, []int) {
 }
 
 func (x *Resource) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *Resource) GetCatalogNumber() string {
-	if x != nil {
-		return x.CatalogNumber
+	if x != nil && x.CatalogNumber != nil {
+		return *x.CatalogNumber
 	}
 	return ""
 }
 
 func (x *Resource) GetProprietaryResourceId() string {
-	if x != nil {
-		return x.ProprietaryResourceId
+	if x != nil && x.ProprietaryResourceId != nil {
+		return *x.ProprietaryResourceId
 	}
 	return ""
 }
@@ -7810,19 +7936,19 @@ func (x *Resource) GetDisplayArtist() []*PartyDescriptorWithPronunciation {
 type ResourceIdWithoutFlag struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISRC"
-	ISRC string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3" json:"i_s_r_c,omitempty" xml:"ISRC"`
+	ISRC *string `protobuf:"bytes,1,opt,name=i_s_r_c,json=iSRC,proto3,oneof" json:"i_s_r_c,omitempty" xml:"ISRC"`
 	// @gotags: xml:"ISMN"
-	ISMN string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3" json:"i_s_m_n,omitempty" xml:"ISMN"`
+	ISMN *string `protobuf:"bytes,2,opt,name=i_s_m_n,json=iSMN,proto3,oneof" json:"i_s_m_n,omitempty" xml:"ISMN"`
 	// @gotags: xml:"ISAN"
-	ISAN string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3" json:"i_s_a_n,omitempty" xml:"ISAN"`
+	ISAN *string `protobuf:"bytes,3,opt,name=i_s_a_n,json=iSAN,proto3,oneof" json:"i_s_a_n,omitempty" xml:"ISAN"`
 	// @gotags: xml:"VISAN"
-	VISAN string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
+	VISAN *string `protobuf:"bytes,4,opt,name=v_i_s_a_n,json=vISAN,proto3,oneof" json:"v_i_s_a_n,omitempty" xml:"VISAN"`
 	// @gotags: xml:"ISBN"
-	ISBN string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3" json:"i_s_b_n,omitempty" xml:"ISBN"`
+	ISBN *string `protobuf:"bytes,5,opt,name=i_s_b_n,json=iSBN,proto3,oneof" json:"i_s_b_n,omitempty" xml:"ISBN"`
 	// @gotags: xml:"ISSN"
-	ISSN string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3" json:"i_s_s_n,omitempty" xml:"ISSN"`
+	ISSN *string `protobuf:"bytes,6,opt,name=i_s_s_n,json=iSSN,proto3,oneof" json:"i_s_s_n,omitempty" xml:"ISSN"`
 	// @gotags: xml:"SICI"
-	SICI string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3" json:"s_i_c_i,omitempty" xml:"SICI"`
+	SICI *string `protobuf:"bytes,7,opt,name=s_i_c_i,json=sICI,proto3,oneof" json:"s_i_c_i,omitempty" xml:"SICI"`
 	// @gotags: xml:"CatalogNumber"
 	CatalogNumber *CatalogNumber `protobuf:"bytes,8,opt,name=catalog_number,json=catalogNumber,proto3" json:"catalog_number,omitempty" xml:"CatalogNumber"`
 	// @gotags: xml:"ProprietaryId"
@@ -7862,50 +7988,50 @@ func (*ResourceIdWithoutFlag) Descriptor() ([]byte, []int) {
 }
 
 func (x *ResourceIdWithoutFlag) GetISRC() string {
-	if x != nil {
-		return x.ISRC
+	if x != nil && x.ISRC != nil {
+		return *x.ISRC
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISMN() string {
-	if x != nil {
-		return x.ISMN
+	if x != nil && x.ISMN != nil {
+		return *x.ISMN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISAN() string {
-	if x != nil {
-		return x.ISAN
+	if x != nil && x.ISAN != nil {
+		return *x.ISAN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetVISAN() string {
-	if x != nil {
-		return x.VISAN
+	if x != nil && x.VISAN != nil {
+		return *x.VISAN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISBN() string {
-	if x != nil {
-		return x.ISBN
+	if x != nil && x.ISBN != nil {
+		return *x.ISBN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetISSN() string {
-	if x != nil {
-		return x.ISSN
+	if x != nil && x.ISSN != nil {
+		return *x.ISSN
 	}
 	return ""
 }
 
 func (x *ResourceIdWithoutFlag) GetSICI() string {
-	if x != nil {
-		return x.SICI
+	if x != nil && x.SICI != nil {
+		return *x.SICI
 	}
 	return ""
 }
@@ -8001,7 +8127,7 @@ type ResourceTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -8044,8 +8170,8 @@ func (x *ResourceTitle) GetValue() string {
 }
 
 func (x *ResourceTitle) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
@@ -8055,7 +8181,7 @@ type SubTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"SubTitleType,attr"
-	SubTitleType  string `protobuf:"bytes,2,opt,name=sub_title_type,json=subTitleType,proto3" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
+	SubTitleType  *string `protobuf:"bytes,2,opt,name=sub_title_type,json=subTitleType,proto3,oneof" json:"sub_title_type,omitempty" xml:"SubTitleType,attr"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -8098,8 +8224,8 @@ func (x *SubTitle) GetValue() string {
 }
 
 func (x *SubTitle) GetSubTitleType() string {
-	if x != nil {
-		return x.SubTitleType
+	if x != nil && x.SubTitleType != nil {
+		return *x.SubTitleType
 	}
 	return ""
 }
@@ -8109,17 +8235,17 @@ type TextWithFormat struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8162,43 +8288,43 @@ func (x *TextWithFormat) GetValue() string {
 }
 
 func (x *TextWithFormat) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithFormat) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithFormat) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8208,17 +8334,17 @@ type TextWithoutTerritory struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"ApplicableTerritoryCode,attr"
-	ApplicableTerritoryCode string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"
+	ApplicableTerritoryCode *string `protobuf:"bytes,3,opt,name=applicable_territory_code,json=applicableTerritoryCode,proto3,oneof" json:"applicable_territory_code,omitempty" xml:"ApplicableTerritoryCode,attr" avs:"CurrentTerritoryCode"`
 	// @gotags: xml:"IsDefault,attr"
-	IsDefault bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty" xml:"IsDefault,attr"`
-	// @gotags: xml:"Format,attr"
-	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty" xml:"Format,attr"`
+	IsDefault *bool `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty" xml:"IsDefault,attr"`
+	// @gotags: xml:"Format,attr" avs:"TextCodecType"
+	Format *string `protobuf:"bytes,5,opt,name=format,proto3,oneof" json:"format,omitempty" xml:"Format,attr" avs:"TextCodecType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,6,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,7,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8261,43 +8387,43 @@ func (x *TextWithoutTerritory) GetValue() string {
 }
 
 func (x *TextWithoutTerritory) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetApplicableTerritoryCode() string {
-	if x != nil {
-		return x.ApplicableTerritoryCode
+	if x != nil && x.ApplicableTerritoryCode != nil {
+		return *x.ApplicableTerritoryCode
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
 	}
 	return false
 }
 
 func (x *TextWithoutTerritory) GetFormat() string {
-	if x != nil {
-		return x.Format
+	if x != nil && x.Format != nil {
+		return *x.Format
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TextWithoutTerritory) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8363,13 +8489,13 @@ type TitleWithPronunciation struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle *TitleText `protobuf:"bytes,2,opt,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"TitleType,attr" avs:"TitleType"
+	TitleType *string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"TitleType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8419,29 +8545,29 @@ func (x *TitleWithPronunciation) GetSubTitle() *TitleText {
 }
 
 func (x *TitleWithPronunciation) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TitleWithPronunciation) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
 
 func (x *TitleWithPronunciation) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TitleWithPronunciation) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8453,13 +8579,13 @@ type TitleWithUDV struct {
 	// @gotags: xml:"SubTitle"
 	SubTitle []*SubTitle `protobuf:"bytes,2,rep,name=sub_title,json=subTitle,proto3" json:"sub_title,omitempty" xml:"SubTitle"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
-	// @gotags: xml:"TitleType,attr"
-	TitleType string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3" json:"title_type,omitempty" xml:"TitleType,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,3,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	// @gotags: xml:"TitleType,attr" avs:"TitleType"
+	TitleType *string `protobuf:"bytes,4,opt,name=title_type,json=titleType,proto3,oneof" json:"title_type,omitempty" xml:"TitleType,attr" avs:"TitleType"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,5,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,6,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8509,29 +8635,29 @@ func (x *TitleWithUDV) GetSubTitle() []*SubTitle {
 }
 
 func (x *TitleWithUDV) GetLanguageAndScriptCode() string {
-	if x != nil {
-		return x.LanguageAndScriptCode
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
 	}
 	return ""
 }
 
 func (x *TitleWithUDV) GetTitleType() string {
-	if x != nil {
-		return x.TitleType
+	if x != nil && x.TitleType != nil {
+		return *x.TitleType
 	}
 	return ""
 }
 
 func (x *TitleWithUDV) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *TitleWithUDV) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8646,12 +8772,12 @@ func (x *VocalRegister) GetValue() *VocalRegisterValue {
 
 type VocalRegisterValue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// @gotags: xml:",chardata"
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
+	// @gotags: xml:",chardata" avs:"VocalRegister"
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata" avs:"VocalRegister"`
 	// @gotags: xml:"Namespace,attr"
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty" xml:"Namespace,attr"`
+	Namespace *string `protobuf:"bytes,2,opt,name=namespace,proto3,oneof" json:"namespace,omitempty" xml:"Namespace,attr"`
 	// @gotags: xml:"UserDefinedValue,attr"
-	UserDefinedValue string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
+	UserDefinedValue *string `protobuf:"bytes,3,opt,name=user_defined_value,json=userDefinedValue,proto3,oneof" json:"user_defined_value,omitempty" xml:"UserDefinedValue,attr"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -8694,15 +8820,15 @@ func (x *VocalRegisterValue) GetValue() string {
 }
 
 func (x *VocalRegisterValue) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
 	}
 	return ""
 }
 
 func (x *VocalRegisterValue) GetUserDefinedValue() string {
-	if x != nil {
-		return x.UserDefinedValue
+	if x != nil && x.UserDefinedValue != nil {
+		return *x.UserDefinedValue
 	}
 	return ""
 }
@@ -8710,9 +8836,9 @@ func (x *VocalRegisterValue) GetUserDefinedValue() string {
 type Work struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// @gotags: xml:"ISWC"
-	ISWC string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3" json:"i_s_w_c,omitempty" xml:"ISWC"`
+	ISWC *string `protobuf:"bytes,1,opt,name=i_s_w_c,json=iSWC,proto3,oneof" json:"i_s_w_c,omitempty" xml:"ISWC"`
 	// @gotags: xml:"ProprietaryWorkId"
-	ProprietaryWorkId string `protobuf:"bytes,2,opt,name=proprietary_work_id,json=proprietaryWorkId,proto3" json:"proprietary_work_id,omitempty" xml:"ProprietaryWorkId"`
+	ProprietaryWorkId *string `protobuf:"bytes,2,opt,name=proprietary_work_id,json=proprietaryWorkId,proto3,oneof" json:"proprietary_work_id,omitempty" xml:"ProprietaryWorkId"`
 	// @gotags: xml:"WorkTitle"
 	WorkTitle []*WorkTitle `protobuf:"bytes,3,rep,name=work_title,json=workTitle,proto3" json:"work_title,omitempty" xml:"WorkTitle"`
 	// @gotags: xml:"Writer"
@@ -8752,15 +8878,15 @@ func (*Work) Descriptor() ([]byte, []int) {
 }
 
 func (x *Work) GetISWC() string {
-	if x != nil {
-		return x.ISWC
+	if x != nil && x.ISWC != nil {
+		return *x.ISWC
 	}
 	return ""
 }
 
 func (x *Work) GetProprietaryWorkId() string {
-	if x != nil {
-		return x.ProprietaryWorkId
+	if x != nil && x.ProprietaryWorkId != nil {
+		return *x.ProprietaryWorkId
 	}
 	return ""
 }
@@ -8847,7 +8973,7 @@ type WorkTitle struct {
 	// @gotags: xml:",chardata"
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty" xml:",chardata"`
 	// @gotags: xml:"LanguageAndScriptCode,attr"
-	LanguageAndScriptCode string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
+	LanguageAndScriptCode *string `protobuf:"bytes,2,opt,name=language_and_script_code,json=languageAndScriptCode,proto3,oneof" json:"language_and_script_code,omitempty" xml:"LanguageAndScriptCode,attr"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -8890,8 +9016,53 @@ func (x *WorkTitle) GetValue() string {
 }
 
 func (x *WorkTitle) GetLanguageAndScriptCode() string {
+	if x != nil && x.LanguageAndScriptCode != nil {
+		return *x.LanguageAndScriptCode
+	}
+	return ""
+}
+
+type AnyElement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// @gotags: xml:"-"
+	RawXml        string `protobuf:"bytes,1,opt,name=raw_xml,json=rawXml,proto3" json:"raw_xml,omitempty" xml:"-"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnyElement) Reset() {
+	*x = AnyElement{}
+	mi := &file_ddex_pie_v10_v10_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnyElement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyElement) ProtoMessage() {}
+
+func (x *AnyElement) ProtoReflect() protoreflect.Message {
+	mi := &file_ddex_pie_v10_v10_proto_msgTypes[115]
 	if x != nil {
-		return x.LanguageAndScriptCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyElement.ProtoReflect.Descriptor instead.
+func (*AnyElement) Descriptor() ([]byte, []int) {
+	return file_ddex_pie_v10_v10_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AnyElement) GetRawXml() string {
+	if x != nil {
+		return x.RawXml
 	}
 	return ""
 }
@@ -8900,7 +9071,7 @@ var File_ddex_pie_v10_v10_proto protoreflect.FileDescriptor
 
 const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\n" +
-	"\x16ddex/pie/v10/v10.proto\x12\fddex.pie.v10\x1a\x1eddex/avs/vlatest/vlatest.proto\"\xa5\x03\n" +
+	"\x16ddex/pie/v10/v10.proto\x12\fddex.pie.v10\x1a\x1eddex/avs/vlatest/vlatest.proto\"\xce\x03\n" +
 	"\n" +
 	"PieMessage\x12B\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1b.ddex.pie.v10.MessageHeaderR\rmessageHeader\x12R\n" +
@@ -8911,7 +9082,9 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\x18language_and_script_code\x18\x05 \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
 	"\txmlns_pie\x18\x06 \x01(\tR\bxmlnsPie\x12\x1b\n" +
 	"\txmlns_xsi\x18\a \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\b \x01(\tR\x11xsiSchemaLocation\"\xe7\x02\n" +
+	"\x13xsi_schema_location\x18\b \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\t \x01(\tR\n" +
+	"rootPrefixJ\x06\b\xa8F\x10\x90N\"\x90\x03\n" +
 	"\x11PieRequestMessage\x12B\n" +
 	"\x0emessage_header\x18\x01 \x01(\v2\x1b.ddex.pie.v10.MessageHeaderR\rmessageHeader\x12E\n" +
 	"\x0frequested_party\x18\x02 \x03(\v2\x1c.ddex.pie.v10.RequestedPartyR\x0erequestedParty\x12$\n" +
@@ -8919,7 +9092,9 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\x18language_and_script_code\x18\x04 \x01(\tR\x15languageAndScriptCode\x12\x1b\n" +
 	"\txmlns_pie\x18\x05 \x01(\tR\bxmlnsPie\x12\x1b\n" +
 	"\txmlns_xsi\x18\x06 \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\"\xbe\x05\n" +
+	"\x13xsi_schema_location\x18\a \x01(\tR\x11xsiSchemaLocation\x12\x1f\n" +
+	"\vroot_prefix\x18\b \x01(\tR\n" +
+	"rootPrefixJ\x06\b\xa8F\x10\x90N\"\xa2\x06\n" +
 	"\x04Feed\x12,\n" +
 	"\x06author\x18\x01 \x03(\v2\x14.ddex.pie.v10.PersonR\x06author\x122\n" +
 	"\bcategory\x18\x02 \x03(\v2\x16.ddex.pie.v10.CategoryR\bcategory\x126\n" +
@@ -8937,25 +9112,40 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\x05entry\x18\r \x03(\v2\x13.ddex.pie.v10.EntryR\x05entry\x12\x1b\n" +
 	"\txmlns_pie\x18\x0e \x01(\tR\bxmlnsPie\x12\x1b\n" +
 	"\txmlns_xsi\x18\x0f \x01(\tR\bxmlnsXsi\x12.\n" +
-	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\"\xa6\x02\n" +
+	"\x13xsi_schema_location\x18\x10 \x01(\tR\x11xsiSchemaLocation\x129\n" +
+	"\vany_element\x18\x11 \x03(\v2\x18.ddex.pie.v10.AnyElementR\n" +
+	"anyElement\x12\x1f\n" +
+	"\vroot_prefix\x18\x12 \x01(\tR\n" +
+	"rootPrefixJ\x06\b\xa8F\x10\x90N\"\x99\x03\n" +
 	"\fContribution\x121\n" +
-	"\x04role\x18\x01 \x03(\v2\x1d.ddex.pie.v10.ContributorRoleR\x04role\x12&\n" +
-	"\x0fis_primary_role\x18\x02 \x01(\bR\risPrimaryRole\x12C\n" +
-	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bR\x1bhasMadeFeaturedContribution\x12G\n" +
-	" has_made_contracted_contribution\x18\x04 \x01(\bR\x1dhasMadeContractedContribution\x12-\n" +
-	"\x05event\x18\x05 \x01(\v2\x17.ddex.pie.v10.EventDateR\x05event\"\xdc\x01\n" +
+	"\x04role\x18\x01 \x03(\v2\x1d.ddex.pie.v10.ContributorRoleR\x04role\x12+\n" +
+	"\x0fis_primary_role\x18\x02 \x01(\bH\x00R\risPrimaryRole\x88\x01\x01\x12H\n" +
+	"\x1ehas_made_featured_contribution\x18\x03 \x01(\bH\x01R\x1bhasMadeFeaturedContribution\x88\x01\x01\x12L\n" +
+	" has_made_contracted_contribution\x18\x04 \x01(\bH\x02R\x1dhasMadeContractedContribution\x88\x01\x01\x12-\n" +
+	"\x05event\x18\x05 \x01(\v2\x17.ddex.pie.v10.EventDateR\x05eventB\x12\n" +
+	"\x10_is_primary_roleB!\n" +
+	"\x1f_has_made_featured_contributionB#\n" +
+	"!_has_made_contracted_contributionJ\x06\b\xa8F\x10\x90N\"\xe4\x01\n" +
 	"\x13CreationDescription\x120\n" +
 	"\x05title\x18\x01 \x03(\v2\x1a.ddex.pie.v10.TitleWithUDVR\x05title\x12O\n" +
 	"\x13display_artist_name\x18\x02 \x01(\v2\x1f.ddex.pie.v10.DisplayArtistNameR\x11displayArtistName\x12B\n" +
-	"\x10publication_date\x18\x03 \x01(\v2\x17.ddex.pie.v10.EventDateR\x0fpublicationDate\"\x96\x02\n" +
-	"\x17DetailedPartyIdForParty\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12(\n" +
-	"\x10cisac_society_id\x18\x05 \x01(\tR\x0ecisacSocietyId\x12B\n" +
-	"\x0eproprietary_id\x18\x06 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\x12#\n" +
-	"\ris_deprecated\x18\a \x01(\bR\fisDeprecated\"\xc2\x05\n" +
+	"\x10publication_date\x18\x03 \x01(\v2\x17.ddex.pie.v10.EventDateR\x0fpublicationDateJ\x06\b\xa8F\x10\x90N\"\x99\x03\n" +
+	"\x17DetailedPartyIdForParty\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12-\n" +
+	"\x10cisac_society_id\x18\x05 \x01(\tH\x04R\x0ecisacSocietyId\x88\x01\x01\x12B\n" +
+	"\x0eproprietary_id\x18\x06 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\x12(\n" +
+	"\ris_deprecated\x18\a \x01(\bH\x05R\fisDeprecated\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
+	"\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nB\x13\n" +
+	"\x11_cisac_society_idB\x10\n" +
+	"\x0e_is_deprecatedJ\x06\b\xa8F\x10\x90N\"\xca\x05\n" +
 	"\x05Entry\x12,\n" +
 	"\x06author\x18\x01 \x03(\v2\x14.ddex.pie.v10.PersonR\x06author\x122\n" +
 	"\bcategory\x18\x02 \x03(\v2\x16.ddex.pie.v10.CategoryR\bcategory\x12/\n" +
@@ -8972,20 +9162,23 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\aupdated\x18\f \x01(\v2\x16.ddex.pie.v10.DateTimeR\aupdated\x12'\n" +
 	"\x0fsubscription_id\x18\r \x01(\tR\x0esubscriptionId\x12;\n" +
 	"\x05party\x18\x0e \x01(\v2%.ddex.pie.v10.PartyDescriptorForEntryR\x05party\x12$\n" +
-	"\x0eavs_version_id\x18\x0f \x01(\tR\favsVersionId\"\x83\x03\n" +
+	"\x0eavs_version_id\x18\x0f \x01(\tR\favsVersionIdJ\x06\b\xa8F\x10\x90N\"\x8b\x03\n" +
 	"\x05Event\x12a\n" +
-	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x126\n" +
-	"\n" +
-	"event_type\x18\x02 \x01(\v2\x17.ddex.pie.v10.EventTypeR\teventType\x12F\n" +
-	"\x11event_description\x18\x03 \x03(\v2\x19.ddex.pie.v10.DescriptionR\x10eventDescription\x12+\n" +
+	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12+\n" +
 	"\x04date\x18\x04 \x01(\v2\x17.ddex.pie.v10.EventDateR\x04date\x126\n" +
 	"\n" +
 	"start_date\x18\x05 \x01(\v2\x17.ddex.pie.v10.EventDateR\tstartDate\x122\n" +
-	"\bend_date\x18\x06 \x01(\v2\x17.ddex.pie.v10.EventDateR\aendDate\"m\n" +
+	"\bend_date\x18\x06 \x01(\v2\x17.ddex.pie.v10.EventDateR\aendDate\x126\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\v2\x17.ddex.pie.v10.EventTypeR\teventType\x12F\n" +
+	"\x11event_description\x18\x03 \x03(\v2\x19.ddex.pie.v10.DescriptionR\x10eventDescriptionJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tEventType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xda\x06\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8f\a\n" +
 	"\x05Focus\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12>\n" +
 	"\vfocus_track\x18\x02 \x01(\v2\x1d.ddex.pie.v10.ResourceSummaryR\n" +
@@ -8995,31 +9188,37 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"focus_work\x18\x04 \x01(\v2\x19.ddex.pie.v10.WorkSummaryR\tfocusWork\x12`\n" +
 	"\x13display_artist_name\x18\x05 \x03(\v20.ddex.pie.v10.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12U\n" +
 	"\x0edisplay_artist\x18\x06 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtist\x12F\n" +
-	"\x06writer\x18\a \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06writer\x12'\n" +
-	"\x0fsequence_number\x18\b \x01(\x05R\x0esequenceNumber\x12O\n" +
+	"\x06writer\x18\a \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06writer\x12,\n" +
+	"\x0fsequence_number\x18\b \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x12O\n" +
 	"\x15period_of_being_focus\x18\t \x03(\v2\x1c.ddex.pie.v10.PeriodWithTimeR\x12periodOfBeingFocus\x12Y\n" +
 	"*territory_of_being_focus_track_description\x18\n" +
 	" \x03(\tR%territoryOfBeingFocusTrackDescription\x12<\n" +
-	"\acomment\x18\v \x03(\v2\".ddex.pie.v10.TextWithoutTerritoryR\acomment\x12\x1d\n" +
+	"\acomment\x18\v \x03(\v2\".ddex.pie.v10.TextWithoutTerritoryR\acomment\x12\"\n" +
 	"\n" +
-	"is_default\x18\f \x01(\bR\tisDefault\"\xc2\x01\n" +
+	"is_default\x18\f \x01(\bH\x01R\tisDefault\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xe2\x01\n" +
 	"\x06Gender\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.ddex.pie.v10.GenderValueR\x05value\x12$\n" +
-	"\x0edo_not_display\x18\x03 \x01(\bR\fdoNotDisplay\"o\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.ddex.pie.v10.GenderValueR\x05value\x12)\n" +
+	"\x0edo_not_display\x18\x03 \x01(\bH\x00R\fdoNotDisplay\x88\x01\x01B\x11\n" +
+	"\x0f_do_not_displayJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vGenderValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"v\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"~\n" +
 	"\x15NameWithPronunciation\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12I\n" +
-	"\rpronunciation\x18\x02 \x03(\v2#.ddex.pie.v10.PronunciationForPartyR\rpronunciation\"\x87\x01\n" +
+	"\rpronunciation\x18\x02 \x03(\v2#.ddex.pie.v10.PronunciationForPartyR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\x8f\x01\n" +
 	"\x12NameWithScriptCode\x12&\n" +
 	"\x04name\x18\x01 \x01(\v2\x12.ddex.pie.v10.NameR\x04name\x12I\n" +
-	"\rpronunciation\x18\x02 \x03(\v2#.ddex.pie.v10.PronunciationForPartyR\rpronunciation\"\xa6\x01\n" +
+	"\rpronunciation\x18\x02 \x03(\v2#.ddex.pie.v10.PronunciationForPartyR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\vNationality\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x124\n" +
-	"\x05value\x18\x02 \x01(\v2\x1e.ddex.pie.v10.AllTerritoryCodeR\x05value\"\x8f\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.ddex.pie.v10.AllTerritoryCodeR\x05valueJ\x06\b\xa8F\x10\x90N\"\xf4\n" +
 	"\n" +
 	"\x05Party\x12'\n" +
 	"\x0fparty_reference\x18\x01 \x01(\tR\x0epartyReference\x12@\n" +
@@ -9046,14 +9245,18 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\tbiography\x18\x12 \x03(\v2\x17.ddex.pie.v10.BiographyR\tbiography\x12)\n" +
 	"\x05image\x18\x13 \x03(\v2\x13.ddex.pie.v10.ImageR\x05image\x12H\n" +
 	"\x12social_media_u_r_l\x18\x14 \x01(\v2\x1c.ddex.pie.v10.SocialMediaURLR\x0esocialMediaURL\x12E\n" +
-	"\x0fcommentary_note\x18\x15 \x03(\v2\x1c.ddex.pie.v10.CommentaryNoteR\x0ecommentaryNote\x127\n" +
-	"\x18language_and_script_code\x18\x16 \x01(\tR\x15languageAndScriptCode\"r\n" +
+	"\x0fcommentary_note\x18\x15 \x03(\v2\x1c.ddex.pie.v10.CommentaryNoteR\x0ecommentaryNote\x12<\n" +
+	"\x18language_and_script_code\x18\x16 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x129\n" +
+	"\vany_element\x18\x17 \x03(\v2\x18.ddex.pie.v10.AnyElementR\n" +
+	"anyElementB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x8e\x01\n" +
 	"\x17PartyDescriptorForEntry\x128\n" +
-	"\bparty_id\x18\x01 \x01(\v2\x1d.ddex.pie.v10.DetailedPartyIdR\apartyId\x12\x1d\n" +
+	"\bparty_id\x18\x01 \x01(\v2\x1d.ddex.pie.v10.DetailedPartyIdR\apartyId\x12\"\n" +
 	"\n" +
-	"party_name\x18\x02 \x01(\tR\tpartyName\"6\n" +
+	"party_name\x18\x02 \x01(\tH\x00R\tpartyName\x88\x01\x01B\r\n" +
+	"\v_party_nameJ\x06\b\xa8F\x10\x90N\">\n" +
 	"\tPartyList\x12)\n" +
-	"\x05party\x18\x01 \x03(\v2\x13.ddex.pie.v10.PartyR\x05party\"\xe5\v\n" +
+	"\x05party\x18\x01 \x03(\v2\x13.ddex.pie.v10.PartyR\x05partyJ\x06\b\xa8F\x10\x90N\"\x95\r\n" +
 	"\tPartyName\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12-\n" +
 	"\aname_id\x18\x02 \x03(\v2\x14.ddex.pie.v10.NameIdR\x06nameId\x12C\n" +
@@ -9063,157 +9266,220 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\x11party_name_format\x18\x06 \x03(\v2\x1d.ddex.pie.v10.PartyNameFormatR\x0fpartyNameFormat\x12=\n" +
 	"\tfull_name\x18\a \x01(\v2 .ddex.pie.v10.NameWithScriptCodeR\bfullName\x12b\n" +
 	"\x1bfull_name_ascii_transcribed\x18\b \x01(\v2#.ddex.pie.v10.NameWithPronunciationR\x18fullNameAsciiTranscribed\x12L\n" +
-	"\x11full_name_indexed\x18\t \x01(\v2 .ddex.pie.v10.NameWithScriptCodeR\x0ffullNameIndexed\x12.\n" +
+	"\x11full_name_indexed\x18\t \x01(\v2 .ddex.pie.v10.NameWithScriptCodeR\x0ffullNameIndexed\x123\n" +
 	"\x13titles_before_names\x18\n" +
-	" \x01(\tR\x11titlesBeforeNames\x12S\n" +
+	" \x01(\tH\x00R\x11titlesBeforeNames\x88\x01\x01\x12S\n" +
 	"\x15names_before_key_name\x18\v \x01(\v2 .ddex.pie.v10.NameWithScriptCodeR\x12namesBeforeKeyName\x12;\n" +
 	"\bkey_name\x18\f \x01(\v2 .ddex.pie.v10.NameWithScriptCodeR\akeyName\x12Q\n" +
-	"\x14names_after_key_name\x18\r \x01(\v2 .ddex.pie.v10.NameWithScriptCodeR\x11namesAfterKeyName\x12,\n" +
-	"\x12titles_after_names\x18\x0e \x01(\tR\x10titlesAfterNames\x12?\n" +
+	"\x14names_after_key_name\x18\r \x01(\v2 .ddex.pie.v10.NameWithScriptCodeR\x11namesAfterKeyName\x121\n" +
+	"\x12titles_after_names\x18\x0e \x01(\tH\x01R\x10titlesAfterNames\x88\x01\x01\x12?\n" +
 	"\n" +
 	"short_name\x18\x0f \x03(\v2 .ddex.pie.v10.NameWithScriptCodeR\tshortName\x12K\n" +
 	"\x10abbreviated_name\x18\x10 \x03(\v2 .ddex.pie.v10.NameWithScriptCodeR\x0fabbreviatedName\x12E\n" +
 	"\x0fvalidity_period\x18\x11 \x03(\v2\x1c.ddex.pie.v10.ValidityPeriodR\x0evalidityPeriod\x12P\n" +
-	"\x10related_creation\x18\x12 \x03(\v2%.ddex.pie.v10.RelatedCreationForPartyR\x0frelatedCreation\x127\n" +
-	"\x18language_and_script_code\x18\x13 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x14 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x10related_creation\x18\x12 \x03(\v2%.ddex.pie.v10.RelatedCreationForPartyR\x0frelatedCreation\x12<\n" +
+	"\x18language_and_script_code\x18\x13 \x01(\tH\x02R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x14 \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x15 \x01(\bR\tisDefault\x12!\n" +
-	"\fis_canonical\x18\x16 \x01(\bR\visCanonical\"\xf8\x03\n" +
+	"is_default\x18\x15 \x01(\bH\x04R\tisDefault\x88\x01\x01\x12&\n" +
+	"\fis_canonical\x18\x16 \x01(\bH\x05R\visCanonical\x88\x01\x01B\x16\n" +
+	"\x14_titles_before_namesB\x15\n" +
+	"\x13_titles_after_namesB\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\x0f\n" +
+	"\r_is_canonicalJ\x06\b\xa8F\x10\x90N\"\xc7\x04\n" +
 	"\x13PartyNameForRequest\x12/\n" +
-	"\tfull_name\x18\x01 \x01(\v2\x12.ddex.pie.v10.NameR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12>\n" +
+	"\tfull_name\x18\x01 \x01(\v2\x12.ddex.pie.v10.NameR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12>\n" +
 	"\x11full_name_indexed\x18\x03 \x01(\v2\x12.ddex.pie.v10.NameR\x0ffullNameIndexed\x12E\n" +
 	"\x15names_before_key_name\x18\x04 \x01(\v2\x12.ddex.pie.v10.NameR\x12namesBeforeKeyName\x12-\n" +
 	"\bkey_name\x18\x05 \x01(\v2\x12.ddex.pie.v10.NameR\akeyName\x12C\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v2\x12.ddex.pie.v10.NameR\x11namesAfterKeyName\x12=\n" +
-	"\x10abbreviated_name\x18\a \x01(\v2\x12.ddex.pie.v10.NameR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\"s\n" +
+	"\x10abbreviated_name\x18\a \x01(\v2\x12.ddex.pie.v10.NameR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x01R\x15languageAndScriptCode\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fPartyNameFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"t\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xab\x01\n" +
 	"\x10PartyNamePurpose\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"q\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xa8\x01\n" +
 	"\rPartyNameType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"y\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xb0\x01\n" +
 	"\x15PartyRelationshipType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xa2\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\tPartyType\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x122\n" +
-	"\x05value\x18\x02 \x01(\v2\x1c.ddex.pie.v10.PartyTypeValueR\x05value\"r\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.ddex.pie.v10.PartyTypeValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x0ePartyTypeValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xa5\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\vPrimaryRole\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x123\n" +
-	"\x05value\x18\x02 \x01(\v2\x1d.ddex.pie.v10.ContributorRoleR\x05value\"\xb3\x02\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.ddex.pie.v10.ContributorRoleR\x05valueJ\x06\b\xa8F\x10\x90N\"\xda\x03\n" +
 	"\x15PronunciationForParty\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
-	"\fis_canonical\x18\x02 \x01(\bR\visCanonical\x12)\n" +
-	"\x10is_mispronounced\x18\x03 \x01(\bR\x0fisMispronounced\x12#\n" +
-	"\rlanguage_code\x18\x04 \x01(\tR\flanguageCode\x12#\n" +
-	"\rencoding_type\x18\x05 \x01(\tR\fencodingType\x12-\n" +
-	"\x12encoding_namespace\x18\x06 \x01(\tR\x11encodingNamespace\x12=\n" +
-	"\x1bencoding_user_defined_value\x18\a \x01(\tR\x18encodingUserDefinedValue\"w\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
+	"\fis_canonical\x18\x02 \x01(\bH\x00R\visCanonical\x88\x01\x01\x12.\n" +
+	"\x10is_mispronounced\x18\x03 \x01(\bH\x01R\x0fisMispronounced\x88\x01\x01\x12(\n" +
+	"\rlanguage_code\x18\x04 \x01(\tH\x02R\flanguageCode\x88\x01\x01\x12(\n" +
+	"\rencoding_type\x18\x05 \x01(\tH\x03R\fencodingType\x88\x01\x01\x122\n" +
+	"\x12encoding_namespace\x18\x06 \x01(\tH\x04R\x11encodingNamespace\x88\x01\x01\x12B\n" +
+	"\x1bencoding_user_defined_value\x18\a \x01(\tH\x05R\x18encodingUserDefinedValue\x88\x01\x01B\x0f\n" +
+	"\r_is_canonicalB\x13\n" +
+	"\x11_is_mispronouncedB\x10\n" +
+	"\x0e_language_codeB\x10\n" +
+	"\x0e_encoding_typeB\x15\n" +
+	"\x13_encoding_namespaceB\x1e\n" +
+	"\x1c_encoding_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xae\x01\n" +
 	"\x13ReasonForNameChange\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\x91\x06\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x8b\a\n" +
 	"\x17RelatedCreationForParty\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12#\n" +
 	"\rcreation_type\x18\x02 \x01(\tR\fcreationType\x12>\n" +
-	"\fcontribution\x18\x03 \x01(\v2\x1a.ddex.pie.v10.ContributionR\fcontribution\x12T\n" +
-	"\x18relationship_description\x18\x04 \x03(\v2\x19.ddex.pie.v10.DescriptionR\x17relationshipDescription\x12\x1a\n" +
-	"\bcontract\x18\x05 \x01(\tR\bcontract\x126\n" +
+	"\fcontribution\x18\x03 \x01(\v2\x1a.ddex.pie.v10.ContributionR\fcontribution\x126\n" +
 	"\n" +
 	"release_id\x18\x06 \x01(\v2\x17.ddex.pie.v10.ReleaseIdR\treleaseId\x12D\n" +
 	"\vresource_id\x18\a \x01(\v2#.ddex.pie.v10.ResourceIdWithoutFlagR\n" +
 	"resourceId\x12N\n" +
 	"\x0fmusical_work_id\x18\b \x01(\v2&.ddex.pie.v10.MusicalWorkIdWithoutFlagR\rmusicalWorkId\x12T\n" +
-	"\x14creation_description\x18\t \x01(\v2!.ddex.pie.v10.CreationDescriptionR\x13creationDescription\x12\x19\n" +
+	"\x14creation_description\x18\t \x01(\v2!.ddex.pie.v10.CreationDescriptionR\x13creationDescription\x12T\n" +
+	"\x18relationship_description\x18\x04 \x03(\v2\x19.ddex.pie.v10.DescriptionR\x17relationshipDescription\x12\x1f\n" +
+	"\bcontract\x18\x05 \x01(\tH\x00R\bcontract\x88\x01\x01\x12\x1e\n" +
 	"\bis_false\x18\n" +
-	" \x01(\bR\aisFalse\x12'\n" +
-	"\x0fis_confidential\x18\v \x01(\bR\x0eisConfidential\x12*\n" +
-	"\x11is_first_creation\x18\f \x01(\bR\x0fisFirstCreation\x12(\n" +
-	"\x10is_last_creation\x18\r \x01(\bR\x0eisLastCreation\"\xec\x05\n" +
+	" \x01(\bH\x01R\aisFalse\x88\x01\x01\x12,\n" +
+	"\x0fis_confidential\x18\v \x01(\bH\x02R\x0eisConfidential\x88\x01\x01\x12/\n" +
+	"\x11is_first_creation\x18\f \x01(\bH\x03R\x0fisFirstCreation\x88\x01\x01\x12-\n" +
+	"\x10is_last_creation\x18\r \x01(\bH\x04R\x0eisLastCreation\x88\x01\x01B\v\n" +
+	"\t_contractB\v\n" +
+	"\t_is_falseB\x12\n" +
+	"\x10_is_confidentialB\x14\n" +
+	"\x12_is_first_creationB\x13\n" +
+	"\x11_is_last_creationJ\x06\b\xa8F\x10\x90N\"\xcb\x06\n" +
 	"\fRelatedParty\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12[\n" +
-	"\x17party_relationship_type\x18\x02 \x01(\v2#.ddex.pie.v10.PartyRelationshipTypeR\x15partyRelationshipType\x12)\n" +
-	"\x10business_purpose\x18\x03 \x01(\tR\x0fbusinessPurpose\x12;\n" +
-	"\vdescription\x18\x04 \x03(\v2\x19.ddex.pie.v10.DescriptionR\vdescription\x12\x1a\n" +
-	"\bcontract\x18\x05 \x01(\tR\bcontract\x12E\n" +
-	"\x0fvalidity_period\x18\x06 \x03(\v2\x1c.ddex.pie.v10.ValidityPeriodR\x0evalidityPeriod\x12P\n" +
-	"\x10related_creation\x18\a \x03(\v2%.ddex.pie.v10.RelatedCreationForPartyR\x0frelatedCreation\x12A\n" +
+	"\x17party_relationship_type\x18\x02 \x01(\v2#.ddex.pie.v10.PartyRelationshipTypeR\x15partyRelationshipType\x12.\n" +
+	"\x10business_purpose\x18\x03 \x01(\tH\x00R\x0fbusinessPurpose\x88\x01\x01\x12A\n" +
 	"\x1dparty_related_party_reference\x18\b \x01(\tR\x1apartyRelatedPartyReference\x12@\n" +
 	"\bparty_id\x18\t \x01(\v2%.ddex.pie.v10.DetailedPartyIdForPartyR\apartyId\x126\n" +
 	"\n" +
 	"party_name\x18\n" +
-	" \x01(\v2\x17.ddex.pie.v10.PartyNameR\tpartyName\x12\x19\n" +
-	"\bis_false\x18\v \x01(\bR\aisFalse\x12'\n" +
-	"\x0fis_confidential\x18\f \x01(\bR\x0eisConfidential\"\x8c\x01\n" +
+	" \x01(\v2\x17.ddex.pie.v10.PartyNameR\tpartyName\x12;\n" +
+	"\vdescription\x18\x04 \x03(\v2\x19.ddex.pie.v10.DescriptionR\vdescription\x12\x1f\n" +
+	"\bcontract\x18\x05 \x01(\tH\x01R\bcontract\x88\x01\x01\x12E\n" +
+	"\x0fvalidity_period\x18\x06 \x03(\v2\x1c.ddex.pie.v10.ValidityPeriodR\x0evalidityPeriod\x12P\n" +
+	"\x10related_creation\x18\a \x03(\v2%.ddex.pie.v10.RelatedCreationForPartyR\x0frelatedCreation\x12\x1e\n" +
+	"\bis_false\x18\v \x01(\bH\x02R\aisFalse\x88\x01\x01\x12,\n" +
+	"\x0fis_confidential\x18\f \x01(\bH\x03R\x0eisConfidential\x88\x01\x01B\x13\n" +
+	"\x11_business_purposeB\v\n" +
+	"\t_contractB\v\n" +
+	"\t_is_falseB\x12\n" +
+	"\x10_is_confidentialJ\x06\b\xa8F\x10\x90N\"\x94\x01\n" +
 	"\x11ReleaseForRequest\x126\n" +
 	"\n" +
 	"release_id\x18\x01 \x01(\v2\x17.ddex.pie.v10.ReleaseIdR\treleaseId\x12?\n" +
-	"\rrelease_title\x18\x02 \x01(\v2\x1a.ddex.pie.v10.ReleaseTitleR\freleaseTitle\"\xea\x02\n" +
-	"\x0eRequestedParty\x121\n" +
+	"\rrelease_title\x18\x02 \x01(\v2\x1a.ddex.pie.v10.ReleaseTitleR\freleaseTitleJ\x06\b\xa8F\x10\x90N\"\xf2\x02\n" +
+	"\x0eRequestedParty\x128\n" +
+	"\bparty_id\x18\x05 \x01(\v2\x1d.ddex.pie.v10.DetailedPartyIdR\apartyId\x12@\n" +
+	"\n" +
+	"party_name\x18\x06 \x01(\v2!.ddex.pie.v10.PartyNameForRequestR\tpartyName\x121\n" +
 	"\x04role\x18\x01 \x03(\v2\x1d.ddex.pie.v10.ContributorRoleR\x04role\x129\n" +
 	"\arelease\x18\x02 \x03(\v2\x1f.ddex.pie.v10.ReleaseForRequestR\arelease\x12<\n" +
 	"\bresource\x18\x03 \x03(\v2 .ddex.pie.v10.ResourceForRequestR\bresource\x120\n" +
-	"\x04work\x18\x04 \x03(\v2\x1c.ddex.pie.v10.WorkForRequestR\x04work\x128\n" +
-	"\bparty_id\x18\x05 \x01(\v2\x1d.ddex.pie.v10.DetailedPartyIdR\apartyId\x12@\n" +
-	"\n" +
-	"party_name\x18\x06 \x01(\v2!.ddex.pie.v10.PartyNameForRequestR\tpartyName\"\xc3\x01\n" +
+	"\x04work\x18\x04 \x03(\v2\x1c.ddex.pie.v10.WorkForRequestR\x04workJ\x06\b\xa8F\x10\x90N\"\xe2\x01\n" +
 	"\x12ResourceForRequest\x12D\n" +
 	"\vresource_id\x18\x01 \x01(\v2#.ddex.pie.v10.ResourceIdWithoutFlagR\n" +
 	"resourceId\x12B\n" +
-	"\x0eresource_title\x18\x02 \x01(\v2\x1b.ddex.pie.v10.ResourceTitleR\rresourceTitle\x12#\n" +
-	"\rresource_type\x18\x03 \x01(\tR\fresourceType\"\x87\x01\n" +
+	"\x0eresource_title\x18\x02 \x01(\v2\x1b.ddex.pie.v10.ResourceTitleR\rresourceTitle\x12(\n" +
+	"\rresource_type\x18\x03 \x01(\tH\x00R\fresourceType\x88\x01\x01B\x10\n" +
+	"\x0e_resource_typeJ\x06\b\xa8F\x10\x90N\"\xc9\x01\n" +
 	"\x0eSocialMediaURL\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12#\n" +
-	"\rlanguage_code\x18\x02 \x01(\tR\flanguageCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\"\x89\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12(\n" +
+	"\rlanguage_code\x18\x02 \x01(\tH\x00R\flanguageCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01B\x10\n" +
+	"\x0e_language_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\x91\x01\n" +
 	"\x0eWorkForRequest\x12?\n" +
 	"\awork_id\x18\x01 \x01(\v2&.ddex.pie.v10.MusicalWorkIdWithoutFlagR\x06workId\x126\n" +
 	"\n" +
-	"work_title\x18\x02 \x01(\v2\x17.ddex.pie.v10.WorkTitleR\tworkTitle\"L\n" +
+	"work_title\x18\x02 \x01(\v2\x17.ddex.pie.v10.WorkTitleR\tworkTitleJ\x06\b\xa8F\x10\x90N\"s\n" +
 	"\bCategory\x12\x12\n" +
-	"\x04term\x18\x01 \x01(\tR\x04term\x12\x16\n" +
-	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x14\n" +
-	"\x05label\x18\x03 \x01(\tR\x05label\"/\n" +
-	"\aContent\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
-	"\x03src\x18\x02 \x01(\tR\x03src\" \n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x1b\n" +
+	"\x06scheme\x18\x02 \x01(\tH\x00R\x06scheme\x88\x01\x01\x12\x19\n" +
+	"\x05label\x18\x03 \x01(\tH\x01R\x05label\x88\x01\x01B\t\n" +
+	"\a_schemeB\b\n" +
+	"\x06_labelJ\x06\b\xa8F\x10\x90N\"\xa3\x01\n" +
+	"\aContent\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x15\n" +
+	"\x03src\x18\x02 \x01(\tH\x01R\x03src\x88\x01\x01\x129\n" +
+	"\vany_element\x18\x03 \x03(\v2\x18.ddex.pie.v10.AnyElementR\n" +
+	"anyElement\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05valueB\a\n" +
+	"\x05_typeB\x06\n" +
+	"\x04_srcJ\x06\b\xa8F\x10\x90N\"(\n" +
 	"\bDateTime\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"M\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"s\n" +
 	"\tGenerator\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x10\n" +
-	"\x03uri\x18\x02 \x01(\tR\x03uri\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\tR\aversion\"\x1c\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x15\n" +
+	"\x03uri\x18\x02 \x01(\tH\x00R\x03uri\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\tH\x01R\aversion\x88\x01\x01B\x06\n" +
+	"\x04_uriB\n" +
+	"\n" +
+	"\b_versionJ\x06\b\xa8F\x10\x90N\"$\n" +
 	"\x04Icon\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"\x1a\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"\"\n" +
 	"\x02Id\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"\x8a\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"\xf4\x01\n" +
 	"\x04Link\x12\x12\n" +
-	"\x04href\x18\x01 \x01(\tR\x04href\x12\x10\n" +
-	"\x03rel\x18\x02 \x01(\tR\x03rel\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
-	"\bhreflang\x18\x04 \x01(\tR\bhreflang\x12\x14\n" +
-	"\x05title\x18\x05 \x01(\tR\x05title\x12\x16\n" +
-	"\x06length\x18\x06 \x01(\x05R\x06length\"\x1c\n" +
+	"\x04href\x18\x01 \x01(\tR\x04href\x12\x15\n" +
+	"\x03rel\x18\x02 \x01(\tH\x00R\x03rel\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1f\n" +
+	"\bhreflang\x18\x04 \x01(\tH\x02R\bhreflang\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x05 \x01(\tH\x03R\x05title\x88\x01\x01\x12\x1b\n" +
+	"\x06length\x18\x06 \x01(\x05H\x04R\x06length\x88\x01\x01\x12\x14\n" +
+	"\x05value\x18\a \x01(\tR\x05valueB\x06\n" +
+	"\x04_relB\a\n" +
+	"\x05_typeB\v\n" +
+	"\t_hreflangB\b\n" +
+	"\x06_titleB\t\n" +
+	"\a_lengthJ\x06\b\xa8F\x10\x90N\"$\n" +
 	"\x04Logo\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"W\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"\xa9\x01\n" +
 	"\x06Person\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
-	"\x03uri\x18\x02 \x01(\v2\x11.ddex.pie.v10.URIR\x03uri\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"\xab\x04\n" +
+	"\x03uri\x18\x02 \x01(\v2\x11.ddex.pie.v10.URIR\x03uri\x12\x19\n" +
+	"\x05email\x18\x03 \x01(\tH\x00R\x05email\x88\x01\x01\x129\n" +
+	"\vany_element\x18\x04 \x03(\v2\x18.ddex.pie.v10.AnyElementR\n" +
+	"anyElementB\b\n" +
+	"\x06_emailJ\x06\b\xa8F\x10\x90N\"\xee\x04\n" +
 	"\x06Source\x12,\n" +
 	"\x06author\x18\x01 \x03(\v2\x14.ddex.pie.v10.PersonR\x06author\x122\n" +
 	"\bcategory\x18\x02 \x03(\v2\x16.ddex.pie.v10.CategoryR\bcategory\x126\n" +
@@ -9227,32 +9493,44 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\bsubtitle\x18\n" +
 	" \x01(\v2\x12.ddex.pie.v10.TextR\bsubtitle\x12(\n" +
 	"\x05title\x18\v \x01(\v2\x12.ddex.pie.v10.TextR\x05title\x120\n" +
-	"\aupdated\x18\f \x01(\v2\x16.ddex.pie.v10.DateTimeR\aupdated\"\x1a\n" +
-	"\x04Text\x12\x12\n" +
-	"\x04type\x18\x01 \x01(\tR\x04type\"\x1b\n" +
+	"\aupdated\x18\f \x01(\v2\x16.ddex.pie.v10.DateTimeR\aupdated\x129\n" +
+	"\vany_element\x18\r \x03(\v2\x18.ddex.pie.v10.AnyElementR\n" +
+	"anyElementJ\x06\b\xa8F\x10\x90N\"\x81\x01\n" +
+	"\x04Text\x12\x17\n" +
+	"\x04type\x18\x01 \x01(\tH\x00R\x04type\x88\x01\x01\x129\n" +
+	"\vany_element\x18\x02 \x03(\v2\x18.ddex.pie.v10.AnyElementR\n" +
+	"anyElement\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05valueB\a\n" +
+	"\x05_typeJ\x06\b\xa8F\x10\x90N\"#\n" +
 	"\x03URI\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"Q\n" +
+	"\x05value\x18\x01 \x01(\tR\x05valueJ\x06\b\xa8F\x10\x90N\"r\n" +
 	"\x10AllTerritoryCode\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12'\n" +
-	"\x0fidentifier_type\x18\x02 \x01(\tR\x0eidentifierType\"\xf4\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12,\n" +
+	"\x0fidentifier_type\x18\x02 \x01(\tH\x00R\x0eidentifierType\x88\x01\x01B\x12\n" +
+	"\x10_identifier_typeJ\x06\b\xa8F\x10\x90N\"\xfc\x01\n" +
 	"\n" +
 	"ArtistType\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x123\n" +
 	"\x05value\x18\x02 \x01(\v2\x1d.ddex.pie.v10.ArtistTypeValueR\x05value\x12N\n" +
-	"$territory_of_artist_type_description\x18\x03 \x03(\tR territoryOfArtistTypeDescription\"s\n" +
+	"$territory_of_artist_type_description\x18\x03 \x03(\tR territoryOfArtistTypeDescriptionJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fArtistTypeValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xd3\x03\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x89\x04\n" +
 	"\x11ArtisticInfluence\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12D\n" +
 	"\x05party\x18\x02 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x05party\x12&\n" +
 	"\x04work\x18\x03 \x03(\v2\x12.ddex.pie.v10.WorkR\x04work\x122\n" +
 	"\bresource\x18\x04 \x03(\v2\x16.ddex.pie.v10.ResourceR\bresource\x12/\n" +
 	"\arelease\x18\x05 \x03(\v2\x15.ddex.pie.v10.ReleaseR\arelease\x12>\n" +
-	"\vdescription\x18\x06 \x03(\v2\x1c.ddex.pie.v10.TextWithFormatR\vdescription\x12#\n" +
-	"\ris_influenced\x18\a \x01(\bR\fisInfluenced\x12#\n" +
-	"\ris_influencer\x18\b \x01(\bR\fisInfluencer\"\xe7\x03\n" +
+	"\vdescription\x18\x06 \x03(\v2\x1c.ddex.pie.v10.TextWithFormatR\vdescription\x12(\n" +
+	"\ris_influenced\x18\a \x01(\bH\x00R\fisInfluenced\x88\x01\x01\x12(\n" +
+	"\ris_influencer\x18\b \x01(\bH\x01R\fisInfluencer\x88\x01\x01B\x10\n" +
+	"\x0e_is_influencedB\x10\n" +
+	"\x0e_is_influencerJ\x06\b\xa8F\x10\x90N\"\xef\x03\n" +
 	"\x05Award\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12S\n" +
 	"\rawarding_body\x18\x02 \x01(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\fawardingBody\x12S\n" +
@@ -9261,89 +9539,130 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"award_name\x18\x04 \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\tawardName\x12+\n" +
 	"\x04date\x18\x05 \x01(\v2\x17.ddex.pie.v10.EventDateR\x04date\x12\x1b\n" +
 	"\tis_winner\x18\x06 \x01(\bR\bisWinner\x126\n" +
-	"\acomment\x18\a \x03(\v2\x1c.ddex.pie.v10.TextWithFormatR\acomment\"\xe7\x01\n" +
+	"\acomment\x18\a \x03(\v2\x1c.ddex.pie.v10.TextWithFormatR\acommentJ\x06\b\xa8F\x10\x90N\"\xef\x01\n" +
 	"\tBiography\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12/\n" +
 	"\x04text\x18\x02 \x03(\v2\x1b.ddex.pie.v10.BiographyTextR\x04text\x12F\n" +
-	"\x06author\x18\x03 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06author\"\xfe\x01\n" +
+	"\x06author\x18\x03 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06authorJ\x06\b\xa8F\x10\x90N\"\x8a\x03\n" +
 	"\rBiographyText\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x16\n" +
-	"\x06format\x18\x04 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x04 \x01(\tH\x02R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x03R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x04R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\t\n" +
+	"\a_formatB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rCatalogNumber\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\xd2\x02\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xda\x02\n" +
 	"\x0fClassicalPeriod\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12-\n" +
 	"\x04name\x18\x02 \x01(\v2\x19.ddex.pie.v10.PeriodValueR\x04name\x12S\n" +
 	"'language_and_script_of_classical_period\x18\x03 \x03(\tR\"languageAndScriptOfClassicalPeriod\x12X\n" +
-	")territory_of_classical_period_description\x18\x04 \x03(\tR%territoryOfClassicalPeriodDescription\"\xf2\x03\n" +
+	")territory_of_classical_period_description\x18\x04 \x03(\tR%territoryOfClassicalPeriodDescriptionJ\x06\b\xa8F\x10\x90N\"\xfa\x03\n" +
 	"\x0eCommentaryNote\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x126\n" +
 	"\x04text\x18\x02 \x03(\v2\".ddex.pie.v10.TextWithoutTerritoryR\x04text\x12R\n" +
 	"\x14commentary_note_type\x18\x03 \x03(\v2 .ddex.pie.v10.CommentaryNoteTypeR\x12commentaryNoteType\x12Q\n" +
 	"&language_and_script_of_commentary_note\x18\x04 \x03(\tR!languageAndScriptOfCommentaryNote\x12V\n" +
 	"(territory_of_commentary_note_description\x18\x05 \x03(\tR$territoryOfCommentaryNoteDescription\x12F\n" +
-	"\x06author\x18\x06 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06author\"v\n" +
+	"\x06author\x18\x06 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06authorJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12CommentaryNoteType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"s\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xaa\x01\n" +
 	"\x0fContributorRole\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"C\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"c\n" +
 	"\x04Date\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\"\\\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateJ\x06\b\xa8F\x10\x90N\"\x86\x01\n" +
 	"\vDescription\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xce\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x8d\x02\n" +
 	"\x0fDetailedHashSum\x12@\n" +
-	"\talgorithm\x18\x01 \x01(\v2\".ddex.pie.v10.HashSumAlgorithmTypeR\talgorithm\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1c\n" +
-	"\tparameter\x18\x03 \x01(\tR\tparameter\x12\x1b\n" +
-	"\tdata_type\x18\x04 \x01(\tR\bdataType\x12$\n" +
-	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValue\"\xe9\x01\n" +
-	"\x0fDetailedPartyId\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12\x15\n" +
-	"\ad_p_i_d\x18\x02 \x01(\tR\x04dPID\x12&\n" +
-	"\x0fipi_name_number\x18\x03 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x04 \x01(\tR\x03iPN\x12(\n" +
-	"\x10cisac_society_id\x18\x05 \x01(\tR\x0ecisacSocietyId\x12B\n" +
-	"\x0eproprietary_id\x18\x06 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\"\x9e\x01\n" +
+	"\talgorithm\x18\x01 \x01(\v2\".ddex.pie.v10.HashSumAlgorithmTypeR\talgorithm\x12\x1d\n" +
+	"\aversion\x18\x02 \x01(\tH\x00R\aversion\x88\x01\x01\x12!\n" +
+	"\tparameter\x18\x03 \x01(\tH\x01R\tparameter\x88\x01\x01\x12 \n" +
+	"\tdata_type\x18\x04 \x01(\tH\x02R\bdataType\x88\x01\x01\x12$\n" +
+	"\x0ehash_sum_value\x18\x05 \x01(\tR\fhashSumValueB\n" +
+	"\n" +
+	"\b_versionB\f\n" +
+	"\n" +
+	"_parameterB\f\n" +
+	"\n" +
+	"_data_typeJ\x06\b\xa8F\x10\x90N\"\xd5\x02\n" +
+	"\x0fDetailedPartyId\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12\x1a\n" +
+	"\ad_p_i_d\x18\x02 \x01(\tH\x01R\x04dPID\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x03 \x01(\tH\x02R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x04 \x01(\tH\x03R\x03iPN\x88\x01\x01\x12-\n" +
+	"\x10cisac_society_id\x18\x05 \x01(\tH\x04R\x0ecisacSocietyId\x88\x01\x01\x12B\n" +
+	"\x0eproprietary_id\x18\x06 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_n_iB\n" +
+	"\n" +
+	"\b_d_p_i_dB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nB\x13\n" +
+	"\x11_cisac_society_idJ\x06\b\xa8F\x10\x90N\"\xeb\x01\n" +
 	"\x11DisplayArtistName\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\"\xc8\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\xa9\x02\n" +
 	"\x1cDisplayArtistNameWithDefault\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\"\xa7\x01\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\"DisplayArtistNameWithPronunciation\x12>\n" +
 	"\x04name\x18\x01 \x01(\v2*.ddex.pie.v10.DisplayArtistNameWithDefaultR\x04name\x12A\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciation\"\xb8\x02\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\xbf\x03\n" +
 	"\x0fDisplaySubTitle\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12A\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciation\x12'\n" +
-	"\x0fsequence_number\x18\x03 \x01(\x05R\x0esequenceNumber\x121\n" +
-	"\x15is_displayed_in_title\x18\x04 \x01(\bR\x12isDisplayedInTitle\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\x12$\n" +
-	"\x0esub_title_type\x18\a \x01(\tR\fsubTitleType\"\x96\x02\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciation\x12,\n" +
+	"\x0fsequence_number\x18\x03 \x01(\x05H\x00R\x0esequenceNumber\x88\x01\x01\x126\n" +
+	"\x15is_displayed_in_title\x18\x04 \x01(\bH\x01R\x12isDisplayedInTitle\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01\x12)\n" +
+	"\x0esub_title_type\x18\a \x01(\tH\x04R\fsubTitleType\x88\x01\x01B\x12\n" +
+	"\x10_sequence_numberB\x18\n" +
+	"\x16_is_displayed_in_titleB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueB\x11\n" +
+	"\x0f_sub_title_typeJ\x06\b\xa8F\x10\x90N\"\xf7\x02\n" +
 	"\fDisplayTitle\x126\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\v2\x17.ddex.pie.v10.TitleTextR\ttitleText\x12:\n" +
-	"\tsub_title\x18\x02 \x03(\v2\x1d.ddex.pie.v10.DisplaySubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x04 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x03(\v2\x1d.ddex.pie.v10.DisplaySubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x04 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x05 \x01(\bR\tisDefault\"\x9c\x04\n" +
+	"is_default\x18\x05 \x01(\bH\x02R\tisDefault\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultJ\x06\b\xa8F\x10\x90N\"\xa4\x04\n" +
 	"\x05Epoch\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12(\n" +
 	"\x05value\x18\x02 \x03(\v2\x12.ddex.pie.v10.NameR\x05value\x12U\n" +
@@ -9353,92 +9672,126 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"start_date\x18\x05 \x01(\v2\x12.ddex.pie.v10.DateR\tstartDate\x12-\n" +
 	"\bend_date\x18\x06 \x01(\v2\x12.ddex.pie.v10.DateR\aendDate\x12>\n" +
 	"\x1clanguage_and_script_of_epoch\x18\a \x03(\tR\x18languageAndScriptOfEpoch\x12C\n" +
-	"\x1eterritory_of_epoch_description\x18\b \x03(\tR\x1bterritoryOfEpochDescription\"\xa8\x02\n" +
+	"\x1eterritory_of_epoch_description\x18\b \x03(\tR\x1bterritoryOfEpochDescriptionJ\x06\b\xa8F\x10\x90N\"\xd0\x03\n" +
 	"\tEventDate\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12%\n" +
-	"\x0eis_approximate\x18\x02 \x01(\bR\risApproximate\x12\x1b\n" +
-	"\tis_before\x18\x03 \x01(\bR\bisBefore\x12\x19\n" +
-	"\bis_after\x18\x04 \x01(\bR\aisAfter\x12:\n" +
-	"\x19applicable_territory_code\x18\x05 \x01(\tR\x17applicableTerritoryCode\x121\n" +
-	"\x14location_description\x18\x06 \x01(\tR\x13locationDescription\x127\n" +
-	"\x18language_and_script_code\x18\a \x01(\tR\x15languageAndScriptCode\"q\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12*\n" +
+	"\x0eis_approximate\x18\x02 \x01(\bH\x00R\risApproximate\x88\x01\x01\x12 \n" +
+	"\tis_before\x18\x03 \x01(\bH\x01R\bisBefore\x88\x01\x01\x12\x1e\n" +
+	"\bis_after\x18\x04 \x01(\bH\x02R\aisAfter\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x05 \x01(\tH\x03R\x17applicableTerritoryCode\x88\x01\x01\x126\n" +
+	"\x14location_description\x18\x06 \x01(\tH\x04R\x13locationDescription\x88\x01\x01\x12<\n" +
+	"\x18language_and_script_code\x18\a \x01(\tH\x05R\x15languageAndScriptCode\x88\x01\x01B\x11\n" +
+	"\x0f_is_approximateB\f\n" +
+	"\n" +
+	"_is_beforeB\v\n" +
+	"\t_is_afterB\x1c\n" +
+	"\x1a_applicable_territory_codeB\x17\n" +
+	"\x15_location_descriptionB\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x8c\x01\n" +
 	"\x04File\x12\x12\n" +
 	"\x05u_r_i\x18\x01 \x01(\tR\x03uRI\x128\n" +
-	"\bhash_sum\x18\x02 \x01(\v2\x1d.ddex.pie.v10.DetailedHashSumR\ahashSum\x12\x1b\n" +
-	"\tfile_size\x18\x03 \x01(\tR\bfileSize\"x\n" +
+	"\bhash_sum\x18\x02 \x01(\v2\x1d.ddex.pie.v10.DetailedHashSumR\ahashSum\x12 \n" +
+	"\tfile_size\x18\x03 \x01(\tH\x00R\bfileSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_file_sizeJ\x06\b\xa8F\x10\x90N\"\xaf\x01\n" +
 	"\x14HashSumAlgorithmType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xca\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xd2\x01\n" +
 	"\x05Image\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x12&\n" +
 	"\x04file\x18\x02 \x01(\v2\x12.ddex.pie.v10.FileR\x04file\x126\n" +
 	"\n" +
-	"image_type\x18\x03 \x03(\v2\x17.ddex.pie.v10.ImageTypeR\timageType\"m\n" +
+	"image_type\x18\x03 \x03(\v2\x17.ddex.pie.v10.ImageTypeR\timageTypeJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\tImageType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"t\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"|\n" +
 	"\x11MessageAuditTrail\x12_\n" +
-	"\x19message_audit_trail_event\x18\x01 \x03(\v2$.ddex.pie.v10.MessageAuditTrailEventR\x16messageAuditTrailEvent\"\x9c\x01\n" +
+	"\x19message_audit_trail_event\x18\x01 \x03(\v2$.ddex.pie.v10.MessageAuditTrailEventR\x16messageAuditTrailEventJ\x06\b\xa8F\x10\x90N\"\xa4\x01\n" +
 	"\x16MessageAuditTrailEvent\x12e\n" +
 	"\x1amessaging_party_descriptor\x18\x01 \x01(\v2'.ddex.pie.v10.MessagingPartyWithoutCodeR\x18messagingPartyDescriptor\x12\x1b\n" +
-	"\tdate_time\x18\x02 \x01(\tR\bdateTime\"\xbe\x04\n" +
-	"\rMessageHeader\x12*\n" +
-	"\x11message_thread_id\x18\x01 \x01(\tR\x0fmessageThreadId\x12\x1d\n" +
+	"\tdate_time\x18\x02 \x01(\tR\bdateTimeJ\x06\b\xa8F\x10\x90N\"\x9a\x05\n" +
+	"\rMessageHeader\x12/\n" +
+	"\x11message_thread_id\x18\x01 \x01(\tH\x00R\x0fmessageThreadId\x88\x01\x01\x12\x1d\n" +
 	"\n" +
-	"message_id\x18\x02 \x01(\tR\tmessageId\x12*\n" +
-	"\x11message_file_name\x18\x03 \x01(\tR\x0fmessageFileName\x12N\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12/\n" +
+	"\x11message_file_name\x18\x03 \x01(\tH\x01R\x0fmessageFileName\x88\x01\x01\x12N\n" +
 	"\x0emessage_sender\x18\x04 \x01(\v2'.ddex.pie.v10.MessagingPartyWithoutCodeR\rmessageSender\x12R\n" +
 	"\x11sent_on_behalf_of\x18\x05 \x01(\v2'.ddex.pie.v10.MessagingPartyWithoutCodeR\x0esentOnBehalfOf\x12T\n" +
 	"\x11message_recipient\x18\x06 \x03(\v2'.ddex.pie.v10.MessagingPartyWithoutCodeR\x10messageRecipient\x129\n" +
 	"\x19message_created_date_time\x18\a \x01(\tR\x16messageCreatedDateTime\x12O\n" +
-	"\x13message_audit_trail\x18\b \x01(\v2\x1f.ddex.pie.v10.MessageAuditTrailR\x11messageAuditTrail\x120\n" +
-	"\x14message_control_type\x18\t \x01(\tR\x12messageControlType\"\x9c\x01\n" +
+	"\x13message_audit_trail\x18\b \x01(\v2\x1f.ddex.pie.v10.MessageAuditTrailR\x11messageAuditTrail\x125\n" +
+	"\x14message_control_type\x18\t \x01(\tH\x02R\x12messageControlType\x88\x01\x01B\x14\n" +
+	"\x12_message_thread_idB\x14\n" +
+	"\x12_message_file_nameB\x17\n" +
+	"\x15_message_control_typeJ\x06\b\xa8F\x10\x90N\"\xba\x01\n" +
 	"\x19MessagingPartyWithoutCode\x12\x19\n" +
 	"\bparty_id\x18\x01 \x01(\tR\apartyId\x12A\n" +
 	"\n" +
-	"party_name\x18\x02 \x01(\v2\".ddex.pie.v10.PartyNameWithoutCodeR\tpartyName\x12!\n" +
-	"\ftrading_name\x18\x03 \x01(\tR\vtradingName\"\x92\x02\n" +
+	"party_name\x18\x02 \x01(\v2\".ddex.pie.v10.PartyNameWithoutCodeR\tpartyName\x12&\n" +
+	"\ftrading_name\x18\x03 \x01(\tH\x00R\vtradingName\x88\x01\x01B\x0f\n" +
+	"\r_trading_nameJ\x06\b\xa8F\x10\x90N\"\x9a\x02\n" +
 	"\x0eMetadataSource\x12)\n" +
-	"\x10source_reference\x18\x01 \x01(\tR\x0fsourceReference\x12R\n" +
-	"\x14metadata_source_type\x18\x02 \x01(\v2 .ddex.pie.v10.MetadataSourceTypeR\x12metadataSourceType\x128\n" +
+	"\x10source_reference\x18\x01 \x01(\tR\x0fsourceReference\x128\n" +
 	"\bparty_id\x18\x03 \x03(\v2\x1d.ddex.pie.v10.DetailedPartyIdR\apartyId\x12G\n" +
 	"\n" +
-	"party_name\x18\x04 \x03(\v2(.ddex.pie.v10.PartyNameWithPronunciationR\tpartyName\"[\n" +
+	"party_name\x18\x04 \x03(\v2(.ddex.pie.v10.PartyNameWithPronunciationR\tpartyName\x12R\n" +
+	"\x14metadata_source_type\x18\x02 \x01(\v2 .ddex.pie.v10.MetadataSourceTypeR\x12metadataSourceTypeJ\x06\b\xa8F\x10\x90N\"c\n" +
 	"\x12MetadataSourceList\x12E\n" +
-	"\x0fmetadata_source\x18\x01 \x03(\v2\x1c.ddex.pie.v10.MetadataSourceR\x0emetadataSource\"\x8f\x01\n" +
+	"\x0fmetadata_source\x18\x01 \x03(\v2\x1c.ddex.pie.v10.MetadataSourceR\x0emetadataSourceJ\x06\b\xa8F\x10\x90N\"\xd4\x01\n" +
 	"\x17MetadataSourceReference\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12.\n" +
-	"\x13assertion_date_time\x18\x02 \x01(\tR\x11assertionDateTime\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\tR\x06weight\"v\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x123\n" +
+	"\x13assertion_date_time\x18\x02 \x01(\tH\x00R\x11assertionDateTime\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x03 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x1b\n" +
+	"\x06weight\x18\x04 \x01(\tH\x02R\x06weight\x88\x01\x01B\x16\n" +
+	"\x14_assertion_date_timeB\t\n" +
+	"\a_statusB\t\n" +
+	"\a_weightJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12MetadataSourceType\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xce\x01\n" +
-	"\x18MusicalWorkIdWithoutFlag\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12\x1f\n" +
-	"\vopus_number\x18\x02 \x01(\tR\n" +
-	"opusNumber\x126\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xfc\x01\n" +
+	"\x18MusicalWorkIdWithoutFlag\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x12$\n" +
+	"\vopus_number\x18\x02 \x01(\tH\x01R\n" +
+	"opusNumber\x88\x01\x01\x126\n" +
 	"\x17composer_catalog_number\x18\x03 \x03(\tR\x15composerCatalogNumber\x12B\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\"U\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_w_cB\x0e\n" +
+	"\f_opus_numberJ\x06\b\xa8F\x10\x90N\"\x7f\n" +
 	"\x04Name\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xc4\x01\n" +
-	"\x06NameId\x12\x15\n" +
-	"\ai_s_n_i\x18\x01 \x01(\tR\x04iSNI\x12&\n" +
-	"\x0fipi_name_number\x18\x02 \x01(\tR\ripiNameNumber\x12\x12\n" +
-	"\x05i_p_n\x18\x03 \x01(\tR\x03iPN\x12B\n" +
-	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\x12#\n" +
-	"\ris_deprecated\x18\x05 \x01(\bR\fisDeprecated\"\x8f\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\x9c\x02\n" +
+	"\x06NameId\x12\x1a\n" +
+	"\ai_s_n_i\x18\x01 \x01(\tH\x00R\x04iSNI\x88\x01\x01\x12+\n" +
+	"\x0fipi_name_number\x18\x02 \x01(\tH\x01R\ripiNameNumber\x88\x01\x01\x12\x17\n" +
+	"\x05i_p_n\x18\x03 \x01(\tH\x02R\x03iPN\x88\x01\x01\x12B\n" +
+	"\x0eproprietary_id\x18\x04 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\x12(\n" +
+	"\ris_deprecated\x18\x05 \x01(\bH\x03R\fisDeprecated\x88\x01\x01B\n" +
+	"\n" +
+	"\b_i_s_n_iB\x12\n" +
+	"\x10_ipi_name_numberB\b\n" +
+	"\x06_i_p_nB\x10\n" +
+	"\x0e_is_deprecatedJ\x06\b\xa8F\x10\x90N\"\x97\x01\n" +
 	"\"NameWithPronunciationAndScriptCode\x12&\n" +
 	"\x04name\x18\x01 \x01(\v2\x12.ddex.pie.v10.NameR\x04name\x12A\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciation\"\xa5\x01\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	" PartyDescriptorWithPronunciation\x128\n" +
 	"\bparty_id\x18\x01 \x03(\v2\x1d.ddex.pie.v10.DetailedPartyIdR\apartyId\x12G\n" +
 	"\n" +
-	"party_name\x18\x02 \x03(\v2(.ddex.pie.v10.PartyNameWithPronunciationR\tpartyName\"\xa1\x06\n" +
+	"party_name\x18\x02 \x03(\v2(.ddex.pie.v10.PartyNameWithPronunciationR\tpartyNameJ\x06\b\xa8F\x10\x90N\"\xee\x06\n" +
 	"\x1aPartyNameWithPronunciation\x12M\n" +
 	"\tfull_name\x18\x01 \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\bfullName\x12o\n" +
 	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\x18fullNameAsciiTranscribed\x12\\\n" +
@@ -9446,156 +9799,238 @@ const file_ddex_pie_v10_v10_proto_rawDesc = "" +
 	"\x15names_before_key_name\x18\x04 \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\x12namesBeforeKeyName\x12K\n" +
 	"\bkey_name\x18\x05 \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\akeyName\x12a\n" +
 	"\x14names_after_key_name\x18\x06 \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\x11namesAfterKeyName\x12[\n" +
-	"\x10abbreviated_name\x18\a \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\x0fabbreviatedName\x127\n" +
-	"\x18language_and_script_code\x18\b \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\t \x01(\tR\x17applicableTerritoryCode\"\xc8\x02\n" +
+	"\x10abbreviated_name\x18\a \x01(\v20.ddex.pie.v10.NameWithPronunciationAndScriptCodeR\x0fabbreviatedName\x12<\n" +
+	"\x18language_and_script_code\x18\b \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\t \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeJ\x06\b\xa8F\x10\x90N\"\xf9\x03\n" +
 	"\x14PartyNameWithoutCode\x12\x1b\n" +
-	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12=\n" +
-	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tR\x18fullNameAsciiTranscribed\x12*\n" +
-	"\x11full_name_indexed\x18\x03 \x01(\tR\x0ffullNameIndexed\x121\n" +
-	"\x15names_before_key_name\x18\x04 \x01(\tR\x12namesBeforeKeyName\x12\x19\n" +
-	"\bkey_name\x18\x05 \x01(\tR\akeyName\x12/\n" +
-	"\x14names_after_key_name\x18\x06 \x01(\tR\x11namesAfterKeyName\x12)\n" +
-	"\x10abbreviated_name\x18\a \x01(\tR\x0fabbreviatedName\"o\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12B\n" +
+	"\x1bfull_name_ascii_transcribed\x18\x02 \x01(\tH\x00R\x18fullNameAsciiTranscribed\x88\x01\x01\x12/\n" +
+	"\x11full_name_indexed\x18\x03 \x01(\tH\x01R\x0ffullNameIndexed\x88\x01\x01\x126\n" +
+	"\x15names_before_key_name\x18\x04 \x01(\tH\x02R\x12namesBeforeKeyName\x88\x01\x01\x12\x1e\n" +
+	"\bkey_name\x18\x05 \x01(\tH\x03R\akeyName\x88\x01\x01\x124\n" +
+	"\x14names_after_key_name\x18\x06 \x01(\tH\x04R\x11namesAfterKeyName\x88\x01\x01\x12.\n" +
+	"\x10abbreviated_name\x18\a \x01(\tH\x05R\x0fabbreviatedName\x88\x01\x01B\x1e\n" +
+	"\x1c_full_name_ascii_transcribedB\x14\n" +
+	"\x12_full_name_indexedB\x18\n" +
+	"\x16_names_before_key_nameB\v\n" +
+	"\t_key_nameB\x17\n" +
+	"\x15_names_after_key_nameB\x13\n" +
+	"\x11_abbreviated_nameJ\x06\b\xa8F\x10\x90N\"\xa6\x01\n" +
 	"\vPeriodValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\\\n" +
-	"\x0ePeriodWithTime\x12&\n" +
-	"\x0fstart_date_time\x18\x01 \x01(\tR\rstartDateTime\x12\"\n" +
-	"\rend_date_time\x18\x02 \x01(\tR\vendDateTime\"\x80\x02\n" +
-	"\rPronunciation\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
-	"\fis_canonical\x18\x02 \x01(\bR\visCanonical\x12#\n" +
-	"\rlanguage_code\x18\x03 \x01(\tR\flanguageCode\x12#\n" +
-	"\rencoding_type\x18\x04 \x01(\tR\fencodingType\x12-\n" +
-	"\x12encoding_namespace\x18\x05 \x01(\tR\x11encodingNamespace\x12=\n" +
-	"\x1bencoding_user_defined_value\x18\x06 \x01(\tR\x18encodingUserDefinedValue\"C\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x94\x01\n" +
+	"\x0ePeriodWithTime\x12+\n" +
+	"\x0fstart_date_time\x18\x01 \x01(\tH\x00R\rstartDateTime\x88\x01\x01\x12'\n" +
+	"\rend_date_time\x18\x02 \x01(\tH\x01R\vendDateTime\x88\x01\x01B\x12\n" +
+	"\x10_start_date_timeB\x10\n" +
+	"\x0e_end_date_timeJ\x06\b\xa8F\x10\x90N\"\x8d\x03\n" +
+	"\rPronunciation\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12&\n" +
+	"\fis_canonical\x18\x02 \x01(\bH\x00R\visCanonical\x88\x01\x01\x12(\n" +
+	"\rlanguage_code\x18\x03 \x01(\tH\x01R\flanguageCode\x88\x01\x01\x12(\n" +
+	"\rencoding_type\x18\x04 \x01(\tH\x02R\fencodingType\x88\x01\x01\x122\n" +
+	"\x12encoding_namespace\x18\x05 \x01(\tH\x03R\x11encodingNamespace\x88\x01\x01\x12B\n" +
+	"\x1bencoding_user_defined_value\x18\x06 \x01(\tH\x04R\x18encodingUserDefinedValue\x88\x01\x01B\x0f\n" +
+	"\r_is_canonicalB\x10\n" +
+	"\x0e_language_codeB\x10\n" +
+	"\x0e_encoding_typeB\x15\n" +
+	"\x13_encoding_namespaceB\x1e\n" +
+	"\x1c_encoding_user_defined_valueJ\x06\b\xa8F\x10\x90N\"K\n" +
 	"\rProprietaryId\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"\x9b\x02\n" +
-	"\x0fRelatedCreation\x12:\n" +
-	"\x05title\x18\x01 \x01(\v2$.ddex.pie.v10.TitleWithPronunciationR\x05title\x126\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespaceJ\x06\b\xa8F\x10\x90N\"\xa3\x02\n" +
+	"\x0fRelatedCreation\x126\n" +
 	"\n" +
 	"release_id\x18\x02 \x01(\v2\x17.ddex.pie.v10.ReleaseIdR\treleaseId\x12D\n" +
 	"\vresource_id\x18\x03 \x01(\v2#.ddex.pie.v10.ResourceIdWithoutFlagR\n" +
 	"resourceId\x12N\n" +
-	"\x0fmusical_work_id\x18\x04 \x01(\v2&.ddex.pie.v10.MusicalWorkIdWithoutFlagR\rmusicalWorkId\"\xe5\x02\n" +
-	"\aRelease\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_c_p_n\x18\x02 \x01(\tR\x04iCPN\x124\n" +
-	"\x16proprietary_release_id\x18\x03 \x01(\tR\x14proprietaryReleaseId\x12?\n" +
+	"\x0fmusical_work_id\x18\x04 \x01(\v2&.ddex.pie.v10.MusicalWorkIdWithoutFlagR\rmusicalWorkId\x12:\n" +
+	"\x05title\x18\x01 \x01(\v2$.ddex.pie.v10.TitleWithPronunciationR\x05titleJ\x06\b\xa8F\x10\x90N\"\xad\x03\n" +
+	"\aRelease\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_c_p_n\x18\x02 \x01(\tH\x01R\x04iCPN\x88\x01\x01\x129\n" +
+	"\x16proprietary_release_id\x18\x03 \x01(\tH\x02R\x14proprietaryReleaseId\x88\x01\x01\x12?\n" +
 	"\rrelease_title\x18\x04 \x03(\v2\x1a.ddex.pie.v10.ReleaseTitleR\freleaseTitle\x12`\n" +
 	"\x13display_artist_name\x18\x05 \x03(\v20.ddex.pie.v10.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12U\n" +
-	"\x0edisplay_artist\x18\x06 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtist\"\xd6\x01\n" +
-	"\tReleaseId\x12\x13\n" +
-	"\x05g_rid\x18\x01 \x01(\tR\x04gRid\x12\x15\n" +
-	"\ai_s_r_c\x18\x02 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_c_p_n\x18\x03 \x01(\tR\x04iCPN\x12B\n" +
+	"\x0edisplay_artist\x18\x06 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtistB\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_c_p_nB\x19\n" +
+	"\x17_proprietary_release_idJ\x06\b\xa8F\x10\x90N\"\x8f\x02\n" +
+	"\tReleaseId\x12\x18\n" +
+	"\x05g_rid\x18\x01 \x01(\tH\x00R\x04gRid\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_r_c\x18\x02 \x01(\tH\x01R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_c_p_n\x18\x03 \x01(\tH\x02R\x04iCPN\x88\x01\x01\x12B\n" +
 	"\x0ecatalog_number\x18\x04 \x01(\v2\x1b.ddex.pie.v10.CatalogNumberR\rcatalogNumber\x12B\n" +
-	"\x0eproprietary_id\x18\x05 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\"\xc2\x02\n" +
+	"\x0eproprietary_id\x18\x05 \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryIdB\b\n" +
+	"\x06_g_ridB\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_c_p_nJ\x06\b\xa8F\x10\x90N\"\xca\x02\n" +
 	"\x0eReleaseSummary\x126\n" +
 	"\n" +
 	"release_id\x18\x01 \x01(\v2\x17.ddex.pie.v10.ReleaseIdR\treleaseId\x12?\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1a.ddex.pie.v10.DisplayTitleR\fdisplayTitle\x12`\n" +
 	"\x13display_artist_name\x18\x03 \x03(\v20.ddex.pie.v10.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12U\n" +
-	"\x0edisplay_artist\x18\x04 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtist\"]\n" +
+	"\x0edisplay_artist\x18\x04 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtistJ\x06\b\xa8F\x10\x90N\"\x87\x01\n" +
 	"\fReleaseTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"\xfd\x02\n" +
-	"\bResource\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12%\n" +
-	"\x0ecatalog_number\x18\x02 \x01(\tR\rcatalogNumber\x126\n" +
-	"\x17proprietary_resource_id\x18\x03 \x01(\tR\x15proprietaryResourceId\x12B\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"\xcf\x03\n" +
+	"\bResource\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12*\n" +
+	"\x0ecatalog_number\x18\x02 \x01(\tH\x01R\rcatalogNumber\x88\x01\x01\x12;\n" +
+	"\x17proprietary_resource_id\x18\x03 \x01(\tH\x02R\x15proprietaryResourceId\x88\x01\x01\x12B\n" +
 	"\x0eresource_title\x18\x04 \x03(\v2\x1b.ddex.pie.v10.ResourceTitleR\rresourceTitle\x12`\n" +
 	"\x13display_artist_name\x18\x05 \x03(\v20.ddex.pie.v10.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12U\n" +
-	"\x0edisplay_artist\x18\x06 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtist\"\xc3\x02\n" +
-	"\x15ResourceIdWithoutFlag\x12\x15\n" +
-	"\ai_s_r_c\x18\x01 \x01(\tR\x04iSRC\x12\x15\n" +
-	"\ai_s_m_n\x18\x02 \x01(\tR\x04iSMN\x12\x15\n" +
-	"\ai_s_a_n\x18\x03 \x01(\tR\x04iSAN\x12\x18\n" +
-	"\tv_i_s_a_n\x18\x04 \x01(\tR\x05vISAN\x12\x15\n" +
-	"\ai_s_b_n\x18\x05 \x01(\tR\x04iSBN\x12\x15\n" +
-	"\ai_s_s_n\x18\x06 \x01(\tR\x04iSSN\x12\x15\n" +
-	"\as_i_c_i\x18\a \x01(\tR\x04sICI\x12B\n" +
+	"\x0edisplay_artist\x18\x06 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtistB\n" +
+	"\n" +
+	"\b_i_s_r_cB\x11\n" +
+	"\x0f_catalog_numberB\x1a\n" +
+	"\x18_proprietary_resource_idJ\x06\b\xa8F\x10\x90N\"\xc4\x03\n" +
+	"\x15ResourceIdWithoutFlag\x12\x1a\n" +
+	"\ai_s_r_c\x18\x01 \x01(\tH\x00R\x04iSRC\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_m_n\x18\x02 \x01(\tH\x01R\x04iSMN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_a_n\x18\x03 \x01(\tH\x02R\x04iSAN\x88\x01\x01\x12\x1d\n" +
+	"\tv_i_s_a_n\x18\x04 \x01(\tH\x03R\x05vISAN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_b_n\x18\x05 \x01(\tH\x04R\x04iSBN\x88\x01\x01\x12\x1a\n" +
+	"\ai_s_s_n\x18\x06 \x01(\tH\x05R\x04iSSN\x88\x01\x01\x12\x1a\n" +
+	"\as_i_c_i\x18\a \x01(\tH\x06R\x04sICI\x88\x01\x01\x12B\n" +
 	"\x0ecatalog_number\x18\b \x01(\v2\x1b.ddex.pie.v10.CatalogNumberR\rcatalogNumber\x12B\n" +
-	"\x0eproprietary_id\x18\t \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryId\"\xd1\x02\n" +
+	"\x0eproprietary_id\x18\t \x03(\v2\x1b.ddex.pie.v10.ProprietaryIdR\rproprietaryIdB\n" +
+	"\n" +
+	"\b_i_s_r_cB\n" +
+	"\n" +
+	"\b_i_s_m_nB\n" +
+	"\n" +
+	"\b_i_s_a_nB\f\n" +
+	"\n" +
+	"_v_i_s_a_nB\n" +
+	"\n" +
+	"\b_i_s_b_nB\n" +
+	"\n" +
+	"\b_i_s_s_nB\n" +
+	"\n" +
+	"\b_s_i_c_iJ\x06\b\xa8F\x10\x90N\"\xd9\x02\n" +
 	"\x0fResourceSummary\x12D\n" +
 	"\vresource_id\x18\x01 \x01(\v2#.ddex.pie.v10.ResourceIdWithoutFlagR\n" +
 	"resourceId\x12?\n" +
 	"\rdisplay_title\x18\x02 \x03(\v2\x1a.ddex.pie.v10.DisplayTitleR\fdisplayTitle\x12`\n" +
 	"\x13display_artist_name\x18\x03 \x03(\v20.ddex.pie.v10.DisplayArtistNameWithPronunciationR\x11displayArtistName\x12U\n" +
-	"\x0edisplay_artist\x18\x04 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtist\"^\n" +
+	"\x0edisplay_artist\x18\x04 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\rdisplayArtistJ\x06\b\xa8F\x10\x90N\"\x88\x01\n" +
 	"\rResourceTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\"F\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"f\n" +
 	"\bSubTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12$\n" +
-	"\x0esub_title_type\x18\x02 \x01(\tR\fsubTitleType\"\x9e\x02\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12)\n" +
+	"\x0esub_title_type\x18\x02 \x01(\tH\x00R\fsubTitleType\x88\x01\x01B\x11\n" +
+	"\x0f_sub_title_typeJ\x06\b\xa8F\x10\x90N\"\xbe\x03\n" +
 	"\x0eTextWithFormat\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"\xa4\x02\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xc4\x03\n" +
 	"\x14TextWithoutTerritory\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCode\x12:\n" +
-	"\x19applicable_territory_code\x18\x03 \x01(\tR\x17applicableTerritoryCode\x12\x1d\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12?\n" +
+	"\x19applicable_territory_code\x18\x03 \x01(\tH\x01R\x17applicableTerritoryCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bH\x02R\tisDefault\x88\x01\x01\x12\x1b\n" +
+	"\x06format\x18\x05 \x01(\tH\x03R\x06format\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x06 \x01(\tH\x04R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\a \x01(\tH\x05R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\x1c\n" +
+	"\x1a_applicable_territory_codeB\r\n" +
+	"\v_is_defaultB\t\n" +
+	"\a_formatB\f\n" +
 	"\n" +
-	"is_default\x18\x04 \x01(\bR\tisDefault\x12\x16\n" +
-	"\x06format\x18\x05 \x01(\tR\x06format\x12\x1c\n" +
-	"\tnamespace\x18\x06 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\a \x01(\tR\x10userDefinedValue\"d\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"l\n" +
 	"\tTitleText\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12A\n" +
-	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciation\"\xaa\x02\n" +
+	"\rpronunciation\x18\x02 \x03(\v2\x1b.ddex.pie.v10.PronunciationR\rpronunciationJ\x06\b\xa8F\x10\x90N\"\x97\x03\n" +
 	"\x16TitleWithPronunciation\x126\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\v2\x17.ddex.pie.v10.TitleTextR\ttitleText\x124\n" +
-	"\tsub_title\x18\x02 \x01(\v2\x17.ddex.pie.v10.TitleTextR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x01(\v2\x17.ddex.pie.v10.TitleTextR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"title_type\x18\x04 \x01(\tH\x01R\ttitleType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_title_typeB\f\n" +
 	"\n" +
-	"title_type\x18\x04 \x01(\tR\ttitleType\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\"\x86\x02\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\xf3\x02\n" +
 	"\fTitleWithUDV\x12\x1d\n" +
 	"\n" +
 	"title_text\x18\x01 \x01(\tR\ttitleText\x123\n" +
-	"\tsub_title\x18\x02 \x03(\v2\x16.ddex.pie.v10.SubTitleR\bsubTitle\x127\n" +
-	"\x18language_and_script_code\x18\x03 \x01(\tR\x15languageAndScriptCode\x12\x1d\n" +
+	"\tsub_title\x18\x02 \x03(\v2\x16.ddex.pie.v10.SubTitleR\bsubTitle\x12<\n" +
+	"\x18language_and_script_code\x18\x03 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"title_type\x18\x04 \x01(\tR\ttitleType\x12\x1c\n" +
-	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x06 \x01(\tR\x10userDefinedValue\"|\n" +
+	"title_type\x18\x04 \x01(\tH\x01R\ttitleType\x88\x01\x01\x12!\n" +
+	"\tnamespace\x18\x05 \x01(\tH\x02R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x06 \x01(\tH\x03R\x10userDefinedValue\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeB\r\n" +
+	"\v_title_typeB\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x84\x01\n" +
 	"\x0eValidityPeriod\x126\n" +
 	"\n" +
 	"start_date\x18\x01 \x01(\v2\x17.ddex.pie.v10.EventDateR\tstartDate\x122\n" +
-	"\bend_date\x18\x02 \x01(\v2\x17.ddex.pie.v10.EventDateR\aendDate\"\xaa\x01\n" +
+	"\bend_date\x18\x02 \x01(\v2\x17.ddex.pie.v10.EventDateR\aendDateJ\x06\b\xa8F\x10\x90N\"\xb2\x01\n" +
 	"\rVocalRegister\x12a\n" +
 	"\x19metadata_source_reference\x18\x01 \x03(\v2%.ddex.pie.v10.MetadataSourceReferenceR\x17metadataSourceReference\x126\n" +
-	"\x05value\x18\x02 \x01(\v2 .ddex.pie.v10.VocalRegisterValueR\x05value\"v\n" +
+	"\x05value\x18\x02 \x01(\v2 .ddex.pie.v10.VocalRegisterValueR\x05valueJ\x06\b\xa8F\x10\x90N\"\xad\x01\n" +
 	"\x12VocalRegisterValue\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x12\x1c\n" +
-	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12,\n" +
-	"\x12user_defined_value\x18\x03 \x01(\tR\x10userDefinedValue\"\xcd\x01\n" +
-	"\x04Work\x12\x15\n" +
-	"\ai_s_w_c\x18\x01 \x01(\tR\x04iSWC\x12.\n" +
-	"\x13proprietary_work_id\x18\x02 \x01(\tR\x11proprietaryWorkId\x126\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12!\n" +
+	"\tnamespace\x18\x02 \x01(\tH\x00R\tnamespace\x88\x01\x01\x121\n" +
+	"\x12user_defined_value\x18\x03 \x01(\tH\x01R\x10userDefinedValue\x88\x01\x01B\f\n" +
+	"\n" +
+	"_namespaceB\x15\n" +
+	"\x13_user_defined_valueJ\x06\b\xa8F\x10\x90N\"\x83\x02\n" +
+	"\x04Work\x12\x1a\n" +
+	"\ai_s_w_c\x18\x01 \x01(\tH\x00R\x04iSWC\x88\x01\x01\x123\n" +
+	"\x13proprietary_work_id\x18\x02 \x01(\tH\x01R\x11proprietaryWorkId\x88\x01\x01\x126\n" +
 	"\n" +
 	"work_title\x18\x03 \x03(\v2\x17.ddex.pie.v10.WorkTitleR\tworkTitle\x12F\n" +
-	"\x06writer\x18\x04 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06writer\"\xea\x01\n" +
+	"\x06writer\x18\x04 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06writerB\n" +
+	"\n" +
+	"\b_i_s_w_cB\x16\n" +
+	"\x14_proprietary_work_idJ\x06\b\xa8F\x10\x90N\"\xf2\x01\n" +
 	"\vWorkSummary\x12N\n" +
 	"\x0fmusical_work_id\x18\x01 \x01(\v2&.ddex.pie.v10.MusicalWorkIdWithoutFlagR\rmusicalWorkId\x12C\n" +
 	"\n" +
 	"work_title\x18\x02 \x03(\v2$.ddex.pie.v10.TitleWithPronunciationR\tworkTitle\x12F\n" +
-	"\x06writer\x18\x03 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06writer\"Z\n" +
+	"\x06writer\x18\x03 \x03(\v2..ddex.pie.v10.PartyDescriptorWithPronunciationR\x06writerJ\x06\b\xa8F\x10\x90N\"\x84\x01\n" +
 	"\tWorkTitle\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\x127\n" +
-	"\x18language_and_script_code\x18\x02 \x01(\tR\x15languageAndScriptCodeB\x9d\x01\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12<\n" +
+	"\x18language_and_script_code\x18\x02 \x01(\tH\x00R\x15languageAndScriptCode\x88\x01\x01B\x1b\n" +
+	"\x19_language_and_script_codeJ\x06\b\xa8F\x10\x90N\"-\n" +
+	"\n" +
+	"AnyElement\x12\x17\n" +
+	"\araw_xml\x18\x01 \x01(\tR\x06rawXmlJ\x06\b\xa8F\x10\x90NB\x9d\x01\n" +
 	"\x10com.ddex.pie.v10B\bV10ProtoP\x01Z-github.com/alecsavvy/ddex-go/gen/ddex/pie/v10\xa2\x02\x03DPX\xaa\x02\fDdex.Pie.V10\xca\x02\fDdex\\Pie\\V10\xe2\x02\x18Ddex\\Pie\\V10\\GPBMetadata\xea\x02\x0eDdex::Pie::V10b\x06proto3"
 
 var (
@@ -9610,7 +10045,7 @@ func file_ddex_pie_v10_v10_proto_rawDescGZIP() []byte {
 	return file_ddex_pie_v10_v10_proto_rawDescData
 }
 
-var file_ddex_pie_v10_v10_proto_msgTypes = make([]protoimpl.MessageInfo, 115)
+var file_ddex_pie_v10_v10_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
 var file_ddex_pie_v10_v10_proto_goTypes = []any{
 	(*PieMessage)(nil),                         // 0: ddex.pie.v10.PieMessage
 	(*PieRequestMessage)(nil),                  // 1: ddex.pie.v10.PieRequestMessage
@@ -9727,6 +10162,7 @@ var file_ddex_pie_v10_v10_proto_goTypes = []any{
 	(*Work)(nil),                               // 112: ddex.pie.v10.Work
 	(*WorkSummary)(nil),                        // 113: ddex.pie.v10.WorkSummary
 	(*WorkTitle)(nil),                          // 114: ddex.pie.v10.WorkTitle
+	(*AnyElement)(nil),                         // 115: ddex.pie.v10.AnyElement
 }
 var file_ddex_pie_v10_v10_proto_depIdxs = []int32{
 	77,  // 0: ddex.pie.v10.PieMessage.message_header:type_name -> ddex.pie.v10.MessageHeader
@@ -9747,238 +10183,244 @@ var file_ddex_pie_v10_v10_proto_depIdxs = []int32{
 	46,  // 15: ddex.pie.v10.Feed.title:type_name -> ddex.pie.v10.Text
 	38,  // 16: ddex.pie.v10.Feed.updated:type_name -> ddex.pie.v10.DateTime
 	6,   // 17: ddex.pie.v10.Feed.entry:type_name -> ddex.pie.v10.Entry
-	59,  // 18: ddex.pie.v10.Contribution.role:type_name -> ddex.pie.v10.ContributorRole
-	70,  // 19: ddex.pie.v10.Contribution.event:type_name -> ddex.pie.v10.EventDate
-	108, // 20: ddex.pie.v10.CreationDescription.title:type_name -> ddex.pie.v10.TitleWithUDV
-	64,  // 21: ddex.pie.v10.CreationDescription.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistName
-	70,  // 22: ddex.pie.v10.CreationDescription.publication_date:type_name -> ddex.pie.v10.EventDate
-	93,  // 23: ddex.pie.v10.DetailedPartyIdForParty.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
-	44,  // 24: ddex.pie.v10.Entry.author:type_name -> ddex.pie.v10.Person
-	36,  // 25: ddex.pie.v10.Entry.category:type_name -> ddex.pie.v10.Category
-	37,  // 26: ddex.pie.v10.Entry.content:type_name -> ddex.pie.v10.Content
-	44,  // 27: ddex.pie.v10.Entry.contributor:type_name -> ddex.pie.v10.Person
-	41,  // 28: ddex.pie.v10.Entry.id:type_name -> ddex.pie.v10.Id
-	42,  // 29: ddex.pie.v10.Entry.link:type_name -> ddex.pie.v10.Link
-	38,  // 30: ddex.pie.v10.Entry.published:type_name -> ddex.pie.v10.DateTime
-	46,  // 31: ddex.pie.v10.Entry.rights:type_name -> ddex.pie.v10.Text
-	45,  // 32: ddex.pie.v10.Entry.source:type_name -> ddex.pie.v10.Source
-	46,  // 33: ddex.pie.v10.Entry.summary:type_name -> ddex.pie.v10.Text
-	46,  // 34: ddex.pie.v10.Entry.title:type_name -> ddex.pie.v10.Text
-	38,  // 35: ddex.pie.v10.Entry.updated:type_name -> ddex.pie.v10.DateTime
-	16,  // 36: ddex.pie.v10.Entry.party:type_name -> ddex.pie.v10.PartyDescriptorForEntry
-	81,  // 37: ddex.pie.v10.Event.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	8,   // 38: ddex.pie.v10.Event.event_type:type_name -> ddex.pie.v10.EventType
-	61,  // 39: ddex.pie.v10.Event.event_description:type_name -> ddex.pie.v10.Description
-	70,  // 40: ddex.pie.v10.Event.date:type_name -> ddex.pie.v10.EventDate
-	70,  // 41: ddex.pie.v10.Event.start_date:type_name -> ddex.pie.v10.EventDate
-	70,  // 42: ddex.pie.v10.Event.end_date:type_name -> ddex.pie.v10.EventDate
-	81,  // 43: ddex.pie.v10.Focus.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	101, // 44: ddex.pie.v10.Focus.focus_track:type_name -> ddex.pie.v10.ResourceSummary
-	97,  // 45: ddex.pie.v10.Focus.focus_release:type_name -> ddex.pie.v10.ReleaseSummary
-	113, // 46: ddex.pie.v10.Focus.focus_work:type_name -> ddex.pie.v10.WorkSummary
-	66,  // 47: ddex.pie.v10.Focus.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
-	87,  // 48: ddex.pie.v10.Focus.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	87,  // 49: ddex.pie.v10.Focus.writer:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	91,  // 50: ddex.pie.v10.Focus.period_of_being_focus:type_name -> ddex.pie.v10.PeriodWithTime
-	105, // 51: ddex.pie.v10.Focus.comment:type_name -> ddex.pie.v10.TextWithoutTerritory
-	81,  // 52: ddex.pie.v10.Gender.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	11,  // 53: ddex.pie.v10.Gender.value:type_name -> ddex.pie.v10.GenderValue
-	27,  // 54: ddex.pie.v10.NameWithPronunciation.pronunciation:type_name -> ddex.pie.v10.PronunciationForParty
-	84,  // 55: ddex.pie.v10.NameWithScriptCode.name:type_name -> ddex.pie.v10.Name
-	27,  // 56: ddex.pie.v10.NameWithScriptCode.pronunciation:type_name -> ddex.pie.v10.PronunciationForParty
-	81,  // 57: ddex.pie.v10.Nationality.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	48,  // 58: ddex.pie.v10.Nationality.value:type_name -> ddex.pie.v10.AllTerritoryCode
-	5,   // 59: ddex.pie.v10.Party.party_id:type_name -> ddex.pie.v10.DetailedPartyIdForParty
-	18,  // 60: ddex.pie.v10.Party.party_name:type_name -> ddex.pie.v10.PartyName
-	24,  // 61: ddex.pie.v10.Party.party_type:type_name -> ddex.pie.v10.PartyType
-	7,   // 62: ddex.pie.v10.Party.event:type_name -> ddex.pie.v10.Event
-	30,  // 63: ddex.pie.v10.Party.related_party:type_name -> ddex.pie.v10.RelatedParty
-	29,  // 64: ddex.pie.v10.Party.related_creation:type_name -> ddex.pie.v10.RelatedCreationForParty
-	10,  // 65: ddex.pie.v10.Party.gender:type_name -> ddex.pie.v10.Gender
-	14,  // 66: ddex.pie.v10.Party.nationality:type_name -> ddex.pie.v10.Nationality
-	26,  // 67: ddex.pie.v10.Party.primary_role:type_name -> ddex.pie.v10.PrimaryRole
-	110, // 68: ddex.pie.v10.Party.vocal_register:type_name -> ddex.pie.v10.VocalRegister
-	9,   // 69: ddex.pie.v10.Party.focus:type_name -> ddex.pie.v10.Focus
-	49,  // 70: ddex.pie.v10.Party.artist_type:type_name -> ddex.pie.v10.ArtistType
-	56,  // 71: ddex.pie.v10.Party.classical_period:type_name -> ddex.pie.v10.ClassicalPeriod
-	69,  // 72: ddex.pie.v10.Party.epoch:type_name -> ddex.pie.v10.Epoch
-	51,  // 73: ddex.pie.v10.Party.artistic_influence:type_name -> ddex.pie.v10.ArtisticInfluence
-	52,  // 74: ddex.pie.v10.Party.award:type_name -> ddex.pie.v10.Award
-	53,  // 75: ddex.pie.v10.Party.biography:type_name -> ddex.pie.v10.Biography
-	73,  // 76: ddex.pie.v10.Party.image:type_name -> ddex.pie.v10.Image
-	34,  // 77: ddex.pie.v10.Party.social_media_u_r_l:type_name -> ddex.pie.v10.SocialMediaURL
-	57,  // 78: ddex.pie.v10.Party.commentary_note:type_name -> ddex.pie.v10.CommentaryNote
-	63,  // 79: ddex.pie.v10.PartyDescriptorForEntry.party_id:type_name -> ddex.pie.v10.DetailedPartyId
-	15,  // 80: ddex.pie.v10.PartyList.party:type_name -> ddex.pie.v10.Party
-	81,  // 81: ddex.pie.v10.PartyName.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	85,  // 82: ddex.pie.v10.PartyName.name_id:type_name -> ddex.pie.v10.NameId
-	22,  // 83: ddex.pie.v10.PartyName.party_name_type:type_name -> ddex.pie.v10.PartyNameType
-	28,  // 84: ddex.pie.v10.PartyName.reason_for_name_change:type_name -> ddex.pie.v10.ReasonForNameChange
-	21,  // 85: ddex.pie.v10.PartyName.party_name_purpose:type_name -> ddex.pie.v10.PartyNamePurpose
-	20,  // 86: ddex.pie.v10.PartyName.party_name_format:type_name -> ddex.pie.v10.PartyNameFormat
-	13,  // 87: ddex.pie.v10.PartyName.full_name:type_name -> ddex.pie.v10.NameWithScriptCode
-	12,  // 88: ddex.pie.v10.PartyName.full_name_ascii_transcribed:type_name -> ddex.pie.v10.NameWithPronunciation
-	13,  // 89: ddex.pie.v10.PartyName.full_name_indexed:type_name -> ddex.pie.v10.NameWithScriptCode
-	13,  // 90: ddex.pie.v10.PartyName.names_before_key_name:type_name -> ddex.pie.v10.NameWithScriptCode
-	13,  // 91: ddex.pie.v10.PartyName.key_name:type_name -> ddex.pie.v10.NameWithScriptCode
-	13,  // 92: ddex.pie.v10.PartyName.names_after_key_name:type_name -> ddex.pie.v10.NameWithScriptCode
-	13,  // 93: ddex.pie.v10.PartyName.short_name:type_name -> ddex.pie.v10.NameWithScriptCode
-	13,  // 94: ddex.pie.v10.PartyName.abbreviated_name:type_name -> ddex.pie.v10.NameWithScriptCode
-	109, // 95: ddex.pie.v10.PartyName.validity_period:type_name -> ddex.pie.v10.ValidityPeriod
-	29,  // 96: ddex.pie.v10.PartyName.related_creation:type_name -> ddex.pie.v10.RelatedCreationForParty
-	84,  // 97: ddex.pie.v10.PartyNameForRequest.full_name:type_name -> ddex.pie.v10.Name
-	84,  // 98: ddex.pie.v10.PartyNameForRequest.full_name_indexed:type_name -> ddex.pie.v10.Name
-	84,  // 99: ddex.pie.v10.PartyNameForRequest.names_before_key_name:type_name -> ddex.pie.v10.Name
-	84,  // 100: ddex.pie.v10.PartyNameForRequest.key_name:type_name -> ddex.pie.v10.Name
-	84,  // 101: ddex.pie.v10.PartyNameForRequest.names_after_key_name:type_name -> ddex.pie.v10.Name
-	84,  // 102: ddex.pie.v10.PartyNameForRequest.abbreviated_name:type_name -> ddex.pie.v10.Name
-	81,  // 103: ddex.pie.v10.PartyType.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	25,  // 104: ddex.pie.v10.PartyType.value:type_name -> ddex.pie.v10.PartyTypeValue
-	81,  // 105: ddex.pie.v10.PrimaryRole.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	59,  // 106: ddex.pie.v10.PrimaryRole.value:type_name -> ddex.pie.v10.ContributorRole
-	81,  // 107: ddex.pie.v10.RelatedCreationForParty.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	3,   // 108: ddex.pie.v10.RelatedCreationForParty.contribution:type_name -> ddex.pie.v10.Contribution
-	61,  // 109: ddex.pie.v10.RelatedCreationForParty.relationship_description:type_name -> ddex.pie.v10.Description
-	96,  // 110: ddex.pie.v10.RelatedCreationForParty.release_id:type_name -> ddex.pie.v10.ReleaseId
-	100, // 111: ddex.pie.v10.RelatedCreationForParty.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
-	83,  // 112: ddex.pie.v10.RelatedCreationForParty.musical_work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
-	4,   // 113: ddex.pie.v10.RelatedCreationForParty.creation_description:type_name -> ddex.pie.v10.CreationDescription
-	81,  // 114: ddex.pie.v10.RelatedParty.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	23,  // 115: ddex.pie.v10.RelatedParty.party_relationship_type:type_name -> ddex.pie.v10.PartyRelationshipType
-	61,  // 116: ddex.pie.v10.RelatedParty.description:type_name -> ddex.pie.v10.Description
-	109, // 117: ddex.pie.v10.RelatedParty.validity_period:type_name -> ddex.pie.v10.ValidityPeriod
-	29,  // 118: ddex.pie.v10.RelatedParty.related_creation:type_name -> ddex.pie.v10.RelatedCreationForParty
-	5,   // 119: ddex.pie.v10.RelatedParty.party_id:type_name -> ddex.pie.v10.DetailedPartyIdForParty
-	18,  // 120: ddex.pie.v10.RelatedParty.party_name:type_name -> ddex.pie.v10.PartyName
-	96,  // 121: ddex.pie.v10.ReleaseForRequest.release_id:type_name -> ddex.pie.v10.ReleaseId
-	98,  // 122: ddex.pie.v10.ReleaseForRequest.release_title:type_name -> ddex.pie.v10.ReleaseTitle
-	59,  // 123: ddex.pie.v10.RequestedParty.role:type_name -> ddex.pie.v10.ContributorRole
-	31,  // 124: ddex.pie.v10.RequestedParty.release:type_name -> ddex.pie.v10.ReleaseForRequest
-	33,  // 125: ddex.pie.v10.RequestedParty.resource:type_name -> ddex.pie.v10.ResourceForRequest
-	35,  // 126: ddex.pie.v10.RequestedParty.work:type_name -> ddex.pie.v10.WorkForRequest
-	63,  // 127: ddex.pie.v10.RequestedParty.party_id:type_name -> ddex.pie.v10.DetailedPartyId
-	19,  // 128: ddex.pie.v10.RequestedParty.party_name:type_name -> ddex.pie.v10.PartyNameForRequest
-	100, // 129: ddex.pie.v10.ResourceForRequest.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
-	102, // 130: ddex.pie.v10.ResourceForRequest.resource_title:type_name -> ddex.pie.v10.ResourceTitle
-	83,  // 131: ddex.pie.v10.WorkForRequest.work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
-	114, // 132: ddex.pie.v10.WorkForRequest.work_title:type_name -> ddex.pie.v10.WorkTitle
-	47,  // 133: ddex.pie.v10.Person.uri:type_name -> ddex.pie.v10.URI
-	44,  // 134: ddex.pie.v10.Source.author:type_name -> ddex.pie.v10.Person
-	36,  // 135: ddex.pie.v10.Source.category:type_name -> ddex.pie.v10.Category
-	44,  // 136: ddex.pie.v10.Source.contributor:type_name -> ddex.pie.v10.Person
-	39,  // 137: ddex.pie.v10.Source.generator:type_name -> ddex.pie.v10.Generator
-	40,  // 138: ddex.pie.v10.Source.icon:type_name -> ddex.pie.v10.Icon
-	41,  // 139: ddex.pie.v10.Source.id:type_name -> ddex.pie.v10.Id
-	42,  // 140: ddex.pie.v10.Source.link:type_name -> ddex.pie.v10.Link
-	43,  // 141: ddex.pie.v10.Source.logo:type_name -> ddex.pie.v10.Logo
-	46,  // 142: ddex.pie.v10.Source.rights:type_name -> ddex.pie.v10.Text
-	46,  // 143: ddex.pie.v10.Source.subtitle:type_name -> ddex.pie.v10.Text
-	46,  // 144: ddex.pie.v10.Source.title:type_name -> ddex.pie.v10.Text
-	38,  // 145: ddex.pie.v10.Source.updated:type_name -> ddex.pie.v10.DateTime
-	81,  // 146: ddex.pie.v10.ArtistType.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	50,  // 147: ddex.pie.v10.ArtistType.value:type_name -> ddex.pie.v10.ArtistTypeValue
-	81,  // 148: ddex.pie.v10.ArtisticInfluence.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	87,  // 149: ddex.pie.v10.ArtisticInfluence.party:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	112, // 150: ddex.pie.v10.ArtisticInfluence.work:type_name -> ddex.pie.v10.Work
-	99,  // 151: ddex.pie.v10.ArtisticInfluence.resource:type_name -> ddex.pie.v10.Resource
-	95,  // 152: ddex.pie.v10.ArtisticInfluence.release:type_name -> ddex.pie.v10.Release
-	104, // 153: ddex.pie.v10.ArtisticInfluence.description:type_name -> ddex.pie.v10.TextWithFormat
-	81,  // 154: ddex.pie.v10.Award.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	87,  // 155: ddex.pie.v10.Award.awarding_body:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	87,  // 156: ddex.pie.v10.Award.awarded_party:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	86,  // 157: ddex.pie.v10.Award.award_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	70,  // 158: ddex.pie.v10.Award.date:type_name -> ddex.pie.v10.EventDate
-	104, // 159: ddex.pie.v10.Award.comment:type_name -> ddex.pie.v10.TextWithFormat
-	81,  // 160: ddex.pie.v10.Biography.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	54,  // 161: ddex.pie.v10.Biography.text:type_name -> ddex.pie.v10.BiographyText
-	87,  // 162: ddex.pie.v10.Biography.author:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	81,  // 163: ddex.pie.v10.ClassicalPeriod.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	90,  // 164: ddex.pie.v10.ClassicalPeriod.name:type_name -> ddex.pie.v10.PeriodValue
-	81,  // 165: ddex.pie.v10.CommentaryNote.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	105, // 166: ddex.pie.v10.CommentaryNote.text:type_name -> ddex.pie.v10.TextWithoutTerritory
-	58,  // 167: ddex.pie.v10.CommentaryNote.commentary_note_type:type_name -> ddex.pie.v10.CommentaryNoteType
-	87,  // 168: ddex.pie.v10.CommentaryNote.author:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	72,  // 169: ddex.pie.v10.DetailedHashSum.algorithm:type_name -> ddex.pie.v10.HashSumAlgorithmType
-	93,  // 170: ddex.pie.v10.DetailedPartyId.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
-	65,  // 171: ddex.pie.v10.DisplayArtistNameWithPronunciation.name:type_name -> ddex.pie.v10.DisplayArtistNameWithDefault
-	92,  // 172: ddex.pie.v10.DisplayArtistNameWithPronunciation.pronunciation:type_name -> ddex.pie.v10.Pronunciation
-	92,  // 173: ddex.pie.v10.DisplaySubTitle.pronunciation:type_name -> ddex.pie.v10.Pronunciation
-	106, // 174: ddex.pie.v10.DisplayTitle.title_text:type_name -> ddex.pie.v10.TitleText
-	67,  // 175: ddex.pie.v10.DisplayTitle.sub_title:type_name -> ddex.pie.v10.DisplaySubTitle
-	81,  // 176: ddex.pie.v10.Epoch.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	84,  // 177: ddex.pie.v10.Epoch.value:type_name -> ddex.pie.v10.Name
-	87,  // 178: ddex.pie.v10.Epoch.related_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	94,  // 179: ddex.pie.v10.Epoch.related_creation:type_name -> ddex.pie.v10.RelatedCreation
-	60,  // 180: ddex.pie.v10.Epoch.start_date:type_name -> ddex.pie.v10.Date
-	60,  // 181: ddex.pie.v10.Epoch.end_date:type_name -> ddex.pie.v10.Date
-	62,  // 182: ddex.pie.v10.File.hash_sum:type_name -> ddex.pie.v10.DetailedHashSum
-	81,  // 183: ddex.pie.v10.Image.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	71,  // 184: ddex.pie.v10.Image.file:type_name -> ddex.pie.v10.File
-	74,  // 185: ddex.pie.v10.Image.image_type:type_name -> ddex.pie.v10.ImageType
-	76,  // 186: ddex.pie.v10.MessageAuditTrail.message_audit_trail_event:type_name -> ddex.pie.v10.MessageAuditTrailEvent
-	78,  // 187: ddex.pie.v10.MessageAuditTrailEvent.messaging_party_descriptor:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
-	78,  // 188: ddex.pie.v10.MessageHeader.message_sender:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
-	78,  // 189: ddex.pie.v10.MessageHeader.sent_on_behalf_of:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
-	78,  // 190: ddex.pie.v10.MessageHeader.message_recipient:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
-	75,  // 191: ddex.pie.v10.MessageHeader.message_audit_trail:type_name -> ddex.pie.v10.MessageAuditTrail
-	89,  // 192: ddex.pie.v10.MessagingPartyWithoutCode.party_name:type_name -> ddex.pie.v10.PartyNameWithoutCode
-	82,  // 193: ddex.pie.v10.MetadataSource.metadata_source_type:type_name -> ddex.pie.v10.MetadataSourceType
-	63,  // 194: ddex.pie.v10.MetadataSource.party_id:type_name -> ddex.pie.v10.DetailedPartyId
-	88,  // 195: ddex.pie.v10.MetadataSource.party_name:type_name -> ddex.pie.v10.PartyNameWithPronunciation
-	79,  // 196: ddex.pie.v10.MetadataSourceList.metadata_source:type_name -> ddex.pie.v10.MetadataSource
-	93,  // 197: ddex.pie.v10.MusicalWorkIdWithoutFlag.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
-	93,  // 198: ddex.pie.v10.NameId.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
-	84,  // 199: ddex.pie.v10.NameWithPronunciationAndScriptCode.name:type_name -> ddex.pie.v10.Name
-	92,  // 200: ddex.pie.v10.NameWithPronunciationAndScriptCode.pronunciation:type_name -> ddex.pie.v10.Pronunciation
-	63,  // 201: ddex.pie.v10.PartyDescriptorWithPronunciation.party_id:type_name -> ddex.pie.v10.DetailedPartyId
-	88,  // 202: ddex.pie.v10.PartyDescriptorWithPronunciation.party_name:type_name -> ddex.pie.v10.PartyNameWithPronunciation
-	86,  // 203: ddex.pie.v10.PartyNameWithPronunciation.full_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	86,  // 204: ddex.pie.v10.PartyNameWithPronunciation.full_name_ascii_transcribed:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	86,  // 205: ddex.pie.v10.PartyNameWithPronunciation.full_name_indexed:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	86,  // 206: ddex.pie.v10.PartyNameWithPronunciation.names_before_key_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	86,  // 207: ddex.pie.v10.PartyNameWithPronunciation.key_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	86,  // 208: ddex.pie.v10.PartyNameWithPronunciation.names_after_key_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	86,  // 209: ddex.pie.v10.PartyNameWithPronunciation.abbreviated_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
-	107, // 210: ddex.pie.v10.RelatedCreation.title:type_name -> ddex.pie.v10.TitleWithPronunciation
-	96,  // 211: ddex.pie.v10.RelatedCreation.release_id:type_name -> ddex.pie.v10.ReleaseId
-	100, // 212: ddex.pie.v10.RelatedCreation.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
-	83,  // 213: ddex.pie.v10.RelatedCreation.musical_work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
-	98,  // 214: ddex.pie.v10.Release.release_title:type_name -> ddex.pie.v10.ReleaseTitle
-	66,  // 215: ddex.pie.v10.Release.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
-	87,  // 216: ddex.pie.v10.Release.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	55,  // 217: ddex.pie.v10.ReleaseId.catalog_number:type_name -> ddex.pie.v10.CatalogNumber
-	93,  // 218: ddex.pie.v10.ReleaseId.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
-	96,  // 219: ddex.pie.v10.ReleaseSummary.release_id:type_name -> ddex.pie.v10.ReleaseId
-	68,  // 220: ddex.pie.v10.ReleaseSummary.display_title:type_name -> ddex.pie.v10.DisplayTitle
-	66,  // 221: ddex.pie.v10.ReleaseSummary.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
-	87,  // 222: ddex.pie.v10.ReleaseSummary.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	102, // 223: ddex.pie.v10.Resource.resource_title:type_name -> ddex.pie.v10.ResourceTitle
-	66,  // 224: ddex.pie.v10.Resource.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
-	87,  // 225: ddex.pie.v10.Resource.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	55,  // 226: ddex.pie.v10.ResourceIdWithoutFlag.catalog_number:type_name -> ddex.pie.v10.CatalogNumber
-	93,  // 227: ddex.pie.v10.ResourceIdWithoutFlag.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
-	100, // 228: ddex.pie.v10.ResourceSummary.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
-	68,  // 229: ddex.pie.v10.ResourceSummary.display_title:type_name -> ddex.pie.v10.DisplayTitle
-	66,  // 230: ddex.pie.v10.ResourceSummary.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
-	87,  // 231: ddex.pie.v10.ResourceSummary.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	92,  // 232: ddex.pie.v10.TitleText.pronunciation:type_name -> ddex.pie.v10.Pronunciation
-	106, // 233: ddex.pie.v10.TitleWithPronunciation.title_text:type_name -> ddex.pie.v10.TitleText
-	106, // 234: ddex.pie.v10.TitleWithPronunciation.sub_title:type_name -> ddex.pie.v10.TitleText
-	103, // 235: ddex.pie.v10.TitleWithUDV.sub_title:type_name -> ddex.pie.v10.SubTitle
-	70,  // 236: ddex.pie.v10.ValidityPeriod.start_date:type_name -> ddex.pie.v10.EventDate
-	70,  // 237: ddex.pie.v10.ValidityPeriod.end_date:type_name -> ddex.pie.v10.EventDate
-	81,  // 238: ddex.pie.v10.VocalRegister.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
-	111, // 239: ddex.pie.v10.VocalRegister.value:type_name -> ddex.pie.v10.VocalRegisterValue
-	114, // 240: ddex.pie.v10.Work.work_title:type_name -> ddex.pie.v10.WorkTitle
-	87,  // 241: ddex.pie.v10.Work.writer:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	83,  // 242: ddex.pie.v10.WorkSummary.musical_work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
-	107, // 243: ddex.pie.v10.WorkSummary.work_title:type_name -> ddex.pie.v10.TitleWithPronunciation
-	87,  // 244: ddex.pie.v10.WorkSummary.writer:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
-	245, // [245:245] is the sub-list for method output_type
-	245, // [245:245] is the sub-list for method input_type
-	245, // [245:245] is the sub-list for extension type_name
-	245, // [245:245] is the sub-list for extension extendee
-	0,   // [0:245] is the sub-list for field type_name
+	115, // 18: ddex.pie.v10.Feed.any_element:type_name -> ddex.pie.v10.AnyElement
+	59,  // 19: ddex.pie.v10.Contribution.role:type_name -> ddex.pie.v10.ContributorRole
+	70,  // 20: ddex.pie.v10.Contribution.event:type_name -> ddex.pie.v10.EventDate
+	108, // 21: ddex.pie.v10.CreationDescription.title:type_name -> ddex.pie.v10.TitleWithUDV
+	64,  // 22: ddex.pie.v10.CreationDescription.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistName
+	70,  // 23: ddex.pie.v10.CreationDescription.publication_date:type_name -> ddex.pie.v10.EventDate
+	93,  // 24: ddex.pie.v10.DetailedPartyIdForParty.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
+	44,  // 25: ddex.pie.v10.Entry.author:type_name -> ddex.pie.v10.Person
+	36,  // 26: ddex.pie.v10.Entry.category:type_name -> ddex.pie.v10.Category
+	37,  // 27: ddex.pie.v10.Entry.content:type_name -> ddex.pie.v10.Content
+	44,  // 28: ddex.pie.v10.Entry.contributor:type_name -> ddex.pie.v10.Person
+	41,  // 29: ddex.pie.v10.Entry.id:type_name -> ddex.pie.v10.Id
+	42,  // 30: ddex.pie.v10.Entry.link:type_name -> ddex.pie.v10.Link
+	38,  // 31: ddex.pie.v10.Entry.published:type_name -> ddex.pie.v10.DateTime
+	46,  // 32: ddex.pie.v10.Entry.rights:type_name -> ddex.pie.v10.Text
+	45,  // 33: ddex.pie.v10.Entry.source:type_name -> ddex.pie.v10.Source
+	46,  // 34: ddex.pie.v10.Entry.summary:type_name -> ddex.pie.v10.Text
+	46,  // 35: ddex.pie.v10.Entry.title:type_name -> ddex.pie.v10.Text
+	38,  // 36: ddex.pie.v10.Entry.updated:type_name -> ddex.pie.v10.DateTime
+	16,  // 37: ddex.pie.v10.Entry.party:type_name -> ddex.pie.v10.PartyDescriptorForEntry
+	81,  // 38: ddex.pie.v10.Event.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	70,  // 39: ddex.pie.v10.Event.date:type_name -> ddex.pie.v10.EventDate
+	70,  // 40: ddex.pie.v10.Event.start_date:type_name -> ddex.pie.v10.EventDate
+	70,  // 41: ddex.pie.v10.Event.end_date:type_name -> ddex.pie.v10.EventDate
+	8,   // 42: ddex.pie.v10.Event.event_type:type_name -> ddex.pie.v10.EventType
+	61,  // 43: ddex.pie.v10.Event.event_description:type_name -> ddex.pie.v10.Description
+	81,  // 44: ddex.pie.v10.Focus.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	101, // 45: ddex.pie.v10.Focus.focus_track:type_name -> ddex.pie.v10.ResourceSummary
+	97,  // 46: ddex.pie.v10.Focus.focus_release:type_name -> ddex.pie.v10.ReleaseSummary
+	113, // 47: ddex.pie.v10.Focus.focus_work:type_name -> ddex.pie.v10.WorkSummary
+	66,  // 48: ddex.pie.v10.Focus.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
+	87,  // 49: ddex.pie.v10.Focus.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	87,  // 50: ddex.pie.v10.Focus.writer:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	91,  // 51: ddex.pie.v10.Focus.period_of_being_focus:type_name -> ddex.pie.v10.PeriodWithTime
+	105, // 52: ddex.pie.v10.Focus.comment:type_name -> ddex.pie.v10.TextWithoutTerritory
+	81,  // 53: ddex.pie.v10.Gender.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	11,  // 54: ddex.pie.v10.Gender.value:type_name -> ddex.pie.v10.GenderValue
+	27,  // 55: ddex.pie.v10.NameWithPronunciation.pronunciation:type_name -> ddex.pie.v10.PronunciationForParty
+	84,  // 56: ddex.pie.v10.NameWithScriptCode.name:type_name -> ddex.pie.v10.Name
+	27,  // 57: ddex.pie.v10.NameWithScriptCode.pronunciation:type_name -> ddex.pie.v10.PronunciationForParty
+	81,  // 58: ddex.pie.v10.Nationality.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	48,  // 59: ddex.pie.v10.Nationality.value:type_name -> ddex.pie.v10.AllTerritoryCode
+	5,   // 60: ddex.pie.v10.Party.party_id:type_name -> ddex.pie.v10.DetailedPartyIdForParty
+	18,  // 61: ddex.pie.v10.Party.party_name:type_name -> ddex.pie.v10.PartyName
+	24,  // 62: ddex.pie.v10.Party.party_type:type_name -> ddex.pie.v10.PartyType
+	7,   // 63: ddex.pie.v10.Party.event:type_name -> ddex.pie.v10.Event
+	30,  // 64: ddex.pie.v10.Party.related_party:type_name -> ddex.pie.v10.RelatedParty
+	29,  // 65: ddex.pie.v10.Party.related_creation:type_name -> ddex.pie.v10.RelatedCreationForParty
+	10,  // 66: ddex.pie.v10.Party.gender:type_name -> ddex.pie.v10.Gender
+	14,  // 67: ddex.pie.v10.Party.nationality:type_name -> ddex.pie.v10.Nationality
+	26,  // 68: ddex.pie.v10.Party.primary_role:type_name -> ddex.pie.v10.PrimaryRole
+	110, // 69: ddex.pie.v10.Party.vocal_register:type_name -> ddex.pie.v10.VocalRegister
+	9,   // 70: ddex.pie.v10.Party.focus:type_name -> ddex.pie.v10.Focus
+	49,  // 71: ddex.pie.v10.Party.artist_type:type_name -> ddex.pie.v10.ArtistType
+	56,  // 72: ddex.pie.v10.Party.classical_period:type_name -> ddex.pie.v10.ClassicalPeriod
+	69,  // 73: ddex.pie.v10.Party.epoch:type_name -> ddex.pie.v10.Epoch
+	51,  // 74: ddex.pie.v10.Party.artistic_influence:type_name -> ddex.pie.v10.ArtisticInfluence
+	52,  // 75: ddex.pie.v10.Party.award:type_name -> ddex.pie.v10.Award
+	53,  // 76: ddex.pie.v10.Party.biography:type_name -> ddex.pie.v10.Biography
+	73,  // 77: ddex.pie.v10.Party.image:type_name -> ddex.pie.v10.Image
+	34,  // 78: ddex.pie.v10.Party.social_media_u_r_l:type_name -> ddex.pie.v10.SocialMediaURL
+	57,  // 79: ddex.pie.v10.Party.commentary_note:type_name -> ddex.pie.v10.CommentaryNote
+	115, // 80: ddex.pie.v10.Party.any_element:type_name -> ddex.pie.v10.AnyElement
+	63,  // 81: ddex.pie.v10.PartyDescriptorForEntry.party_id:type_name -> ddex.pie.v10.DetailedPartyId
+	15,  // 82: ddex.pie.v10.PartyList.party:type_name -> ddex.pie.v10.Party
+	81,  // 83: ddex.pie.v10.PartyName.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	85,  // 84: ddex.pie.v10.PartyName.name_id:type_name -> ddex.pie.v10.NameId
+	22,  // 85: ddex.pie.v10.PartyName.party_name_type:type_name -> ddex.pie.v10.PartyNameType
+	28,  // 86: ddex.pie.v10.PartyName.reason_for_name_change:type_name -> ddex.pie.v10.ReasonForNameChange
+	21,  // 87: ddex.pie.v10.PartyName.party_name_purpose:type_name -> ddex.pie.v10.PartyNamePurpose
+	20,  // 88: ddex.pie.v10.PartyName.party_name_format:type_name -> ddex.pie.v10.PartyNameFormat
+	13,  // 89: ddex.pie.v10.PartyName.full_name:type_name -> ddex.pie.v10.NameWithScriptCode
+	12,  // 90: ddex.pie.v10.PartyName.full_name_ascii_transcribed:type_name -> ddex.pie.v10.NameWithPronunciation
+	13,  // 91: ddex.pie.v10.PartyName.full_name_indexed:type_name -> ddex.pie.v10.NameWithScriptCode
+	13,  // 92: ddex.pie.v10.PartyName.names_before_key_name:type_name -> ddex.pie.v10.NameWithScriptCode
+	13,  // 93: ddex.pie.v10.PartyName.key_name:type_name -> ddex.pie.v10.NameWithScriptCode
+	13,  // 94: ddex.pie.v10.PartyName.names_after_key_name:type_name -> ddex.pie.v10.NameWithScriptCode
+	13,  // 95: ddex.pie.v10.PartyName.short_name:type_name -> ddex.pie.v10.NameWithScriptCode
+	13,  // 96: ddex.pie.v10.PartyName.abbreviated_name:type_name -> ddex.pie.v10.NameWithScriptCode
+	109, // 97: ddex.pie.v10.PartyName.validity_period:type_name -> ddex.pie.v10.ValidityPeriod
+	29,  // 98: ddex.pie.v10.PartyName.related_creation:type_name -> ddex.pie.v10.RelatedCreationForParty
+	84,  // 99: ddex.pie.v10.PartyNameForRequest.full_name:type_name -> ddex.pie.v10.Name
+	84,  // 100: ddex.pie.v10.PartyNameForRequest.full_name_indexed:type_name -> ddex.pie.v10.Name
+	84,  // 101: ddex.pie.v10.PartyNameForRequest.names_before_key_name:type_name -> ddex.pie.v10.Name
+	84,  // 102: ddex.pie.v10.PartyNameForRequest.key_name:type_name -> ddex.pie.v10.Name
+	84,  // 103: ddex.pie.v10.PartyNameForRequest.names_after_key_name:type_name -> ddex.pie.v10.Name
+	84,  // 104: ddex.pie.v10.PartyNameForRequest.abbreviated_name:type_name -> ddex.pie.v10.Name
+	81,  // 105: ddex.pie.v10.PartyType.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	25,  // 106: ddex.pie.v10.PartyType.value:type_name -> ddex.pie.v10.PartyTypeValue
+	81,  // 107: ddex.pie.v10.PrimaryRole.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	59,  // 108: ddex.pie.v10.PrimaryRole.value:type_name -> ddex.pie.v10.ContributorRole
+	81,  // 109: ddex.pie.v10.RelatedCreationForParty.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	3,   // 110: ddex.pie.v10.RelatedCreationForParty.contribution:type_name -> ddex.pie.v10.Contribution
+	96,  // 111: ddex.pie.v10.RelatedCreationForParty.release_id:type_name -> ddex.pie.v10.ReleaseId
+	100, // 112: ddex.pie.v10.RelatedCreationForParty.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
+	83,  // 113: ddex.pie.v10.RelatedCreationForParty.musical_work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
+	4,   // 114: ddex.pie.v10.RelatedCreationForParty.creation_description:type_name -> ddex.pie.v10.CreationDescription
+	61,  // 115: ddex.pie.v10.RelatedCreationForParty.relationship_description:type_name -> ddex.pie.v10.Description
+	81,  // 116: ddex.pie.v10.RelatedParty.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	23,  // 117: ddex.pie.v10.RelatedParty.party_relationship_type:type_name -> ddex.pie.v10.PartyRelationshipType
+	5,   // 118: ddex.pie.v10.RelatedParty.party_id:type_name -> ddex.pie.v10.DetailedPartyIdForParty
+	18,  // 119: ddex.pie.v10.RelatedParty.party_name:type_name -> ddex.pie.v10.PartyName
+	61,  // 120: ddex.pie.v10.RelatedParty.description:type_name -> ddex.pie.v10.Description
+	109, // 121: ddex.pie.v10.RelatedParty.validity_period:type_name -> ddex.pie.v10.ValidityPeriod
+	29,  // 122: ddex.pie.v10.RelatedParty.related_creation:type_name -> ddex.pie.v10.RelatedCreationForParty
+	96,  // 123: ddex.pie.v10.ReleaseForRequest.release_id:type_name -> ddex.pie.v10.ReleaseId
+	98,  // 124: ddex.pie.v10.ReleaseForRequest.release_title:type_name -> ddex.pie.v10.ReleaseTitle
+	63,  // 125: ddex.pie.v10.RequestedParty.party_id:type_name -> ddex.pie.v10.DetailedPartyId
+	19,  // 126: ddex.pie.v10.RequestedParty.party_name:type_name -> ddex.pie.v10.PartyNameForRequest
+	59,  // 127: ddex.pie.v10.RequestedParty.role:type_name -> ddex.pie.v10.ContributorRole
+	31,  // 128: ddex.pie.v10.RequestedParty.release:type_name -> ddex.pie.v10.ReleaseForRequest
+	33,  // 129: ddex.pie.v10.RequestedParty.resource:type_name -> ddex.pie.v10.ResourceForRequest
+	35,  // 130: ddex.pie.v10.RequestedParty.work:type_name -> ddex.pie.v10.WorkForRequest
+	100, // 131: ddex.pie.v10.ResourceForRequest.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
+	102, // 132: ddex.pie.v10.ResourceForRequest.resource_title:type_name -> ddex.pie.v10.ResourceTitle
+	83,  // 133: ddex.pie.v10.WorkForRequest.work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
+	114, // 134: ddex.pie.v10.WorkForRequest.work_title:type_name -> ddex.pie.v10.WorkTitle
+	115, // 135: ddex.pie.v10.Content.any_element:type_name -> ddex.pie.v10.AnyElement
+	47,  // 136: ddex.pie.v10.Person.uri:type_name -> ddex.pie.v10.URI
+	115, // 137: ddex.pie.v10.Person.any_element:type_name -> ddex.pie.v10.AnyElement
+	44,  // 138: ddex.pie.v10.Source.author:type_name -> ddex.pie.v10.Person
+	36,  // 139: ddex.pie.v10.Source.category:type_name -> ddex.pie.v10.Category
+	44,  // 140: ddex.pie.v10.Source.contributor:type_name -> ddex.pie.v10.Person
+	39,  // 141: ddex.pie.v10.Source.generator:type_name -> ddex.pie.v10.Generator
+	40,  // 142: ddex.pie.v10.Source.icon:type_name -> ddex.pie.v10.Icon
+	41,  // 143: ddex.pie.v10.Source.id:type_name -> ddex.pie.v10.Id
+	42,  // 144: ddex.pie.v10.Source.link:type_name -> ddex.pie.v10.Link
+	43,  // 145: ddex.pie.v10.Source.logo:type_name -> ddex.pie.v10.Logo
+	46,  // 146: ddex.pie.v10.Source.rights:type_name -> ddex.pie.v10.Text
+	46,  // 147: ddex.pie.v10.Source.subtitle:type_name -> ddex.pie.v10.Text
+	46,  // 148: ddex.pie.v10.Source.title:type_name -> ddex.pie.v10.Text
+	38,  // 149: ddex.pie.v10.Source.updated:type_name -> ddex.pie.v10.DateTime
+	115, // 150: ddex.pie.v10.Source.any_element:type_name -> ddex.pie.v10.AnyElement
+	115, // 151: ddex.pie.v10.Text.any_element:type_name -> ddex.pie.v10.AnyElement
+	81,  // 152: ddex.pie.v10.ArtistType.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	50,  // 153: ddex.pie.v10.ArtistType.value:type_name -> ddex.pie.v10.ArtistTypeValue
+	81,  // 154: ddex.pie.v10.ArtisticInfluence.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	87,  // 155: ddex.pie.v10.ArtisticInfluence.party:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	112, // 156: ddex.pie.v10.ArtisticInfluence.work:type_name -> ddex.pie.v10.Work
+	99,  // 157: ddex.pie.v10.ArtisticInfluence.resource:type_name -> ddex.pie.v10.Resource
+	95,  // 158: ddex.pie.v10.ArtisticInfluence.release:type_name -> ddex.pie.v10.Release
+	104, // 159: ddex.pie.v10.ArtisticInfluence.description:type_name -> ddex.pie.v10.TextWithFormat
+	81,  // 160: ddex.pie.v10.Award.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	87,  // 161: ddex.pie.v10.Award.awarding_body:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	87,  // 162: ddex.pie.v10.Award.awarded_party:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	86,  // 163: ddex.pie.v10.Award.award_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	70,  // 164: ddex.pie.v10.Award.date:type_name -> ddex.pie.v10.EventDate
+	104, // 165: ddex.pie.v10.Award.comment:type_name -> ddex.pie.v10.TextWithFormat
+	81,  // 166: ddex.pie.v10.Biography.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	54,  // 167: ddex.pie.v10.Biography.text:type_name -> ddex.pie.v10.BiographyText
+	87,  // 168: ddex.pie.v10.Biography.author:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	81,  // 169: ddex.pie.v10.ClassicalPeriod.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	90,  // 170: ddex.pie.v10.ClassicalPeriod.name:type_name -> ddex.pie.v10.PeriodValue
+	81,  // 171: ddex.pie.v10.CommentaryNote.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	105, // 172: ddex.pie.v10.CommentaryNote.text:type_name -> ddex.pie.v10.TextWithoutTerritory
+	58,  // 173: ddex.pie.v10.CommentaryNote.commentary_note_type:type_name -> ddex.pie.v10.CommentaryNoteType
+	87,  // 174: ddex.pie.v10.CommentaryNote.author:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	72,  // 175: ddex.pie.v10.DetailedHashSum.algorithm:type_name -> ddex.pie.v10.HashSumAlgorithmType
+	93,  // 176: ddex.pie.v10.DetailedPartyId.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
+	65,  // 177: ddex.pie.v10.DisplayArtistNameWithPronunciation.name:type_name -> ddex.pie.v10.DisplayArtistNameWithDefault
+	92,  // 178: ddex.pie.v10.DisplayArtistNameWithPronunciation.pronunciation:type_name -> ddex.pie.v10.Pronunciation
+	92,  // 179: ddex.pie.v10.DisplaySubTitle.pronunciation:type_name -> ddex.pie.v10.Pronunciation
+	106, // 180: ddex.pie.v10.DisplayTitle.title_text:type_name -> ddex.pie.v10.TitleText
+	67,  // 181: ddex.pie.v10.DisplayTitle.sub_title:type_name -> ddex.pie.v10.DisplaySubTitle
+	81,  // 182: ddex.pie.v10.Epoch.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	84,  // 183: ddex.pie.v10.Epoch.value:type_name -> ddex.pie.v10.Name
+	87,  // 184: ddex.pie.v10.Epoch.related_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	94,  // 185: ddex.pie.v10.Epoch.related_creation:type_name -> ddex.pie.v10.RelatedCreation
+	60,  // 186: ddex.pie.v10.Epoch.start_date:type_name -> ddex.pie.v10.Date
+	60,  // 187: ddex.pie.v10.Epoch.end_date:type_name -> ddex.pie.v10.Date
+	62,  // 188: ddex.pie.v10.File.hash_sum:type_name -> ddex.pie.v10.DetailedHashSum
+	81,  // 189: ddex.pie.v10.Image.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	71,  // 190: ddex.pie.v10.Image.file:type_name -> ddex.pie.v10.File
+	74,  // 191: ddex.pie.v10.Image.image_type:type_name -> ddex.pie.v10.ImageType
+	76,  // 192: ddex.pie.v10.MessageAuditTrail.message_audit_trail_event:type_name -> ddex.pie.v10.MessageAuditTrailEvent
+	78,  // 193: ddex.pie.v10.MessageAuditTrailEvent.messaging_party_descriptor:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
+	78,  // 194: ddex.pie.v10.MessageHeader.message_sender:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
+	78,  // 195: ddex.pie.v10.MessageHeader.sent_on_behalf_of:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
+	78,  // 196: ddex.pie.v10.MessageHeader.message_recipient:type_name -> ddex.pie.v10.MessagingPartyWithoutCode
+	75,  // 197: ddex.pie.v10.MessageHeader.message_audit_trail:type_name -> ddex.pie.v10.MessageAuditTrail
+	89,  // 198: ddex.pie.v10.MessagingPartyWithoutCode.party_name:type_name -> ddex.pie.v10.PartyNameWithoutCode
+	63,  // 199: ddex.pie.v10.MetadataSource.party_id:type_name -> ddex.pie.v10.DetailedPartyId
+	88,  // 200: ddex.pie.v10.MetadataSource.party_name:type_name -> ddex.pie.v10.PartyNameWithPronunciation
+	82,  // 201: ddex.pie.v10.MetadataSource.metadata_source_type:type_name -> ddex.pie.v10.MetadataSourceType
+	79,  // 202: ddex.pie.v10.MetadataSourceList.metadata_source:type_name -> ddex.pie.v10.MetadataSource
+	93,  // 203: ddex.pie.v10.MusicalWorkIdWithoutFlag.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
+	93,  // 204: ddex.pie.v10.NameId.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
+	84,  // 205: ddex.pie.v10.NameWithPronunciationAndScriptCode.name:type_name -> ddex.pie.v10.Name
+	92,  // 206: ddex.pie.v10.NameWithPronunciationAndScriptCode.pronunciation:type_name -> ddex.pie.v10.Pronunciation
+	63,  // 207: ddex.pie.v10.PartyDescriptorWithPronunciation.party_id:type_name -> ddex.pie.v10.DetailedPartyId
+	88,  // 208: ddex.pie.v10.PartyDescriptorWithPronunciation.party_name:type_name -> ddex.pie.v10.PartyNameWithPronunciation
+	86,  // 209: ddex.pie.v10.PartyNameWithPronunciation.full_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	86,  // 210: ddex.pie.v10.PartyNameWithPronunciation.full_name_ascii_transcribed:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	86,  // 211: ddex.pie.v10.PartyNameWithPronunciation.full_name_indexed:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	86,  // 212: ddex.pie.v10.PartyNameWithPronunciation.names_before_key_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	86,  // 213: ddex.pie.v10.PartyNameWithPronunciation.key_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	86,  // 214: ddex.pie.v10.PartyNameWithPronunciation.names_after_key_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	86,  // 215: ddex.pie.v10.PartyNameWithPronunciation.abbreviated_name:type_name -> ddex.pie.v10.NameWithPronunciationAndScriptCode
+	96,  // 216: ddex.pie.v10.RelatedCreation.release_id:type_name -> ddex.pie.v10.ReleaseId
+	100, // 217: ddex.pie.v10.RelatedCreation.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
+	83,  // 218: ddex.pie.v10.RelatedCreation.musical_work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
+	107, // 219: ddex.pie.v10.RelatedCreation.title:type_name -> ddex.pie.v10.TitleWithPronunciation
+	98,  // 220: ddex.pie.v10.Release.release_title:type_name -> ddex.pie.v10.ReleaseTitle
+	66,  // 221: ddex.pie.v10.Release.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
+	87,  // 222: ddex.pie.v10.Release.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	55,  // 223: ddex.pie.v10.ReleaseId.catalog_number:type_name -> ddex.pie.v10.CatalogNumber
+	93,  // 224: ddex.pie.v10.ReleaseId.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
+	96,  // 225: ddex.pie.v10.ReleaseSummary.release_id:type_name -> ddex.pie.v10.ReleaseId
+	68,  // 226: ddex.pie.v10.ReleaseSummary.display_title:type_name -> ddex.pie.v10.DisplayTitle
+	66,  // 227: ddex.pie.v10.ReleaseSummary.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
+	87,  // 228: ddex.pie.v10.ReleaseSummary.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	102, // 229: ddex.pie.v10.Resource.resource_title:type_name -> ddex.pie.v10.ResourceTitle
+	66,  // 230: ddex.pie.v10.Resource.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
+	87,  // 231: ddex.pie.v10.Resource.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	55,  // 232: ddex.pie.v10.ResourceIdWithoutFlag.catalog_number:type_name -> ddex.pie.v10.CatalogNumber
+	93,  // 233: ddex.pie.v10.ResourceIdWithoutFlag.proprietary_id:type_name -> ddex.pie.v10.ProprietaryId
+	100, // 234: ddex.pie.v10.ResourceSummary.resource_id:type_name -> ddex.pie.v10.ResourceIdWithoutFlag
+	68,  // 235: ddex.pie.v10.ResourceSummary.display_title:type_name -> ddex.pie.v10.DisplayTitle
+	66,  // 236: ddex.pie.v10.ResourceSummary.display_artist_name:type_name -> ddex.pie.v10.DisplayArtistNameWithPronunciation
+	87,  // 237: ddex.pie.v10.ResourceSummary.display_artist:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	92,  // 238: ddex.pie.v10.TitleText.pronunciation:type_name -> ddex.pie.v10.Pronunciation
+	106, // 239: ddex.pie.v10.TitleWithPronunciation.title_text:type_name -> ddex.pie.v10.TitleText
+	106, // 240: ddex.pie.v10.TitleWithPronunciation.sub_title:type_name -> ddex.pie.v10.TitleText
+	103, // 241: ddex.pie.v10.TitleWithUDV.sub_title:type_name -> ddex.pie.v10.SubTitle
+	70,  // 242: ddex.pie.v10.ValidityPeriod.start_date:type_name -> ddex.pie.v10.EventDate
+	70,  // 243: ddex.pie.v10.ValidityPeriod.end_date:type_name -> ddex.pie.v10.EventDate
+	81,  // 244: ddex.pie.v10.VocalRegister.metadata_source_reference:type_name -> ddex.pie.v10.MetadataSourceReference
+	111, // 245: ddex.pie.v10.VocalRegister.value:type_name -> ddex.pie.v10.VocalRegisterValue
+	114, // 246: ddex.pie.v10.Work.work_title:type_name -> ddex.pie.v10.WorkTitle
+	87,  // 247: ddex.pie.v10.Work.writer:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	83,  // 248: ddex.pie.v10.WorkSummary.musical_work_id:type_name -> ddex.pie.v10.MusicalWorkIdWithoutFlag
+	107, // 249: ddex.pie.v10.WorkSummary.work_title:type_name -> ddex.pie.v10.TitleWithPronunciation
+	87,  // 250: ddex.pie.v10.WorkSummary.writer:type_name -> ddex.pie.v10.PartyDescriptorWithPronunciation
+	251, // [251:251] is the sub-list for method output_type
+	251, // [251:251] is the sub-list for method input_type
+	251, // [251:251] is the sub-list for extension type_name
+	251, // [251:251] is the sub-list for extension extendee
+	0,   // [0:251] is the sub-list for field type_name
 }
 
 func init() { file_ddex_pie_v10_v10_proto_init() }
@@ -9986,13 +10428,84 @@ func file_ddex_pie_v10_v10_proto_init() {
 	if File_ddex_pie_v10_v10_proto != nil {
 		return
 	}
+	file_ddex_pie_v10_v10_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[5].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[8].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[9].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[10].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[11].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[15].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[16].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[18].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[19].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[20].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[21].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[22].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[23].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[25].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[27].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[28].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[29].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[30].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[33].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[34].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[36].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[37].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[39].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[42].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[44].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[46].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[48].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[50].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[51].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[54].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[58].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[59].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[60].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[61].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[62].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[63].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[64].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[65].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[67].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[68].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[70].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[71].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[72].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[74].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[77].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[78].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[81].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[82].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[83].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[84].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[85].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[88].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[89].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[90].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[91].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[92].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[95].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[96].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[98].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[99].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[100].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[102].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[103].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[104].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[105].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[107].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[108].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[111].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[112].OneofWrappers = []any{}
+	file_ddex_pie_v10_v10_proto_msgTypes[114].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ddex_pie_v10_v10_proto_rawDesc), len(file_ddex_pie_v10_v10_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   115,
+			NumMessages:   116,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,75 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+)
+
+// TestNilSafeGetters checks that getter chains through unset messages read
+// zero values instead of panicking, so callers needn't guard every level
+func TestNilSafeGetters(t *testing.T) {
+	t.Run("Zero Value Message", func(t *testing.T) {
+		msg := &ernv43.NewReleaseMessage{}
+		if got := msg.GetReleaseList().GetRelease().GetReleaseId().GetGRid(); got != "" {
+			t.Errorf("GRid = %q, want empty", got)
+		}
+		if got := msg.GetMessageHeader().GetMessageSender().GetPartyId(); got != "" {
+			t.Errorf("PartyId = %q, want empty", got)
+		}
+		if got := msg.GetMessageHeader().GetMessageId(); got != "" {
+			t.Errorf("MessageId = %q, want empty", got)
+		}
+		if got := msg.GetResourceList().GetSoundRecording(); len(got) != 0 {
+			t.Errorf("SoundRecording = %v, want none", got)
+		}
+		if got := msg.GetReleaseList().GetRelease().GetDisplayTitleText(); got != nil {
+			t.Errorf("DisplayTitleText = %v, want nil", got)
+		}
+	})
+
+	t.Run("Nil Message", func(t *testing.T) {
+		var msg *ernv43.NewReleaseMessage
+		if got := msg.GetReleaseList().GetRelease().GetReleaseId().GetGRid(); got != "" {
+			t.Errorf("GRid = %q, want empty", got)
+		}
+		if got := msg.GetDealList().GetReleaseDeal(); got != nil {
+			t.Errorf("ReleaseDeal = %v, want nil", got)
+		}
+
+		var mead *meadv11.MeadMessage
+		if got := mead.GetMessageHeader().GetMessageId(); got != "" {
+			t.Errorf("MEAD MessageId = %q, want empty", got)
+		}
+	})
+
+	t.Run("Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, _, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		release, ok := msg.(*ernv43.NewReleaseMessage)
+		if !ok {
+			t.Fatalf("Expected *ernv43.NewReleaseMessage, got %T", msg)
+		}
+
+		if got := release.GetMessageHeader().GetMessageId(); got == "" || got != release.MessageHeader.MessageId {
+			t.Errorf("MessageId = %q, want %q", got, release.MessageHeader.MessageId)
+		}
+		want := release.ReleaseList.Release.ReleaseId
+		if got := release.GetReleaseList().GetRelease().GetReleaseId(); got != want {
+			t.Errorf("ReleaseId = %v, want %v", got, want)
+		}
+		if want.GRid != nil && release.GetReleaseList().GetRelease().GetReleaseId().GetGRid() != *want.GRid {
+			t.Errorf("GRid differs from the field")
+		}
+	})
+}
@@ -7,4 +7,9 @@ require (
 	google.golang.org/protobuf v1.36.9
 )
 
-require github.com/beevik/etree v1.6.0
+require (
+	github.com/beevik/etree v1.6.0
+	github.com/bufbuild/protocompile v0.14.1
+)
+
+require golang.org/x/sync v0.8.0 // indirect
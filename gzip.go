@@ -0,0 +1,70 @@
+package ddex
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// gzipMagic opens every gzip stream (RFC 1952)
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompress gunzips data starting with the gzip magic bytes, so .xml.gz
+// deliveries parse like plain XML. Other input is returned as is.
+func decompress(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, gzipMagic) {
+		return data, nil
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress gzip input: %w", err)
+	}
+	defer zr.Close()
+
+	out, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress gzip input: %w", err)
+	}
+	return out, nil
+}
+
+// decompressReader wraps r in a gzip reader when it starts with the gzip
+// magic bytes, so streamed .xml.gz deliveries decode like plain XML
+func decompressReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(gzipMagic))
+	if !bytes.Equal(magic, gzipMagic) {
+		return br, nil
+	}
+
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress gzip input: %w", err)
+	}
+	return zr, nil
+}
+
+// ParseERNReader reads r to the end and parses it like ParseERN. Gzipped
+// input is decompressed transparently.
+func ParseERNReader(r io.Reader) (ERNMessage, ERNVersion, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read input: %w", err)
+	}
+	return ParseERN(data)
+}
+
+// ParseDDEXReader reads r to the end and parses it like ParseDDEX. Gzipped
+// input is decompressed transparently.
+func ParseDDEXReader(r io.Reader) (proto.Message, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	return ParseDDEX(data)
+}
@@ -0,0 +1,78 @@
+package ddex
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TestParseGzip validates that gzip-compressed input parses exactly like the
+// plain XML it wraps, through both the byte and reader entry points
+func TestParseGzip(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(xmlData); err != nil {
+		t.Fatalf("Failed to gzip sample: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Failed to gzip sample: %v", err)
+	}
+	gzipped := buf.Bytes()
+
+	want, version, err := ParseERN(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse plain XML: %v", err)
+	}
+
+	t.Run("Plain XML", func(t *testing.T) {
+		msg, gotVersion, err := ParseERNReader(bytes.NewReader(xmlData))
+		if err != nil {
+			t.Fatalf("ParseERNReader failed: %v", err)
+		}
+		if gotVersion != version || !proto.Equal(msg, want) {
+			t.Errorf("ParseERNReader differs from ParseERN (version %s, want %s)", gotVersion, version)
+		}
+
+		ddexMsg, err := ParseDDEXReader(bytes.NewReader(xmlData))
+		if err != nil {
+			t.Fatalf("ParseDDEXReader failed: %v", err)
+		}
+		if !proto.Equal(ddexMsg, want) {
+			t.Error("ParseDDEXReader differs from ParseERN")
+		}
+	})
+
+	t.Run("Gzipped XML", func(t *testing.T) {
+		msg, gotVersion, err := ParseERNReader(bytes.NewReader(gzipped))
+		if err != nil {
+			t.Fatalf("ParseERNReader failed: %v", err)
+		}
+		if gotVersion != version || !proto.Equal(msg, want) {
+			t.Errorf("Gzipped ParseERNReader differs from plain XML (version %s, want %s)", gotVersion, version)
+		}
+
+		ddexMsg, err := ParseDDEX(gzipped)
+		if err != nil {
+			t.Fatalf("ParseDDEX failed: %v", err)
+		}
+		if !proto.Equal(ddexMsg, want) {
+			t.Error("Gzipped ParseDDEX differs from plain XML")
+		}
+	})
+
+	t.Run("Truncated Gzip", func(t *testing.T) {
+		if _, err := ParseDDEX(gzipped[:len(gzipped)/2]); err == nil {
+			t.Error("Expected error for truncated gzip input")
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// ParseStats describes a single call to one of the parse functions
+type ParseStats struct {
+	Func      string        // entry point, e.g. ParseDDEX or ParseERN
+	Namespace string        // namespace of the root element, empty if none was read
+	Root      string        // root element name, e.g. NewReleaseMessage
+	Version   string        // last segment of Namespace, e.g. 432 as in ERNVersion
+	Size      int           // input length in bytes, compressed size for gzipped input
+	Duration  time.Duration // wall time of the call, including decompression
+	Err       error         // error returned to the caller, if any
+}
+
+// ParseHook receives the stats of every parse once it has finished. It is
+// called synchronously on the parsing goroutine, so keep it cheap.
+type ParseHook func(ParseStats)
+
+// parseHook holds the installed hook; a nil pointer means none
+var parseHook atomic.Pointer[ParseHook]
+
+// SetParseHook installs hook to observe ParseERN, ParseERNWithVersion and
+// ParseDDEX, e.g. to record OpenTelemetry spans or metrics. Passing nil removes it. Without a hook, parsing costs a single
+// atomic load more than before.
+func SetParseHook(hook ParseHook) {
+	if hook == nil {
+		parseHook.Store(nil)
+		return
+	}
+	parseHook.Store(&hook)
+}
+
+// observeParse starts timing a parse for the installed hook and returns the
+// func reporting it, or nil when no hook is installed
+func observeParse(fn string, size int) func(root xml.Name, err error) {
+	hook := parseHook.Load()
+	if hook == nil {
+		return nil
+	}
+
+	began := time.Now()
+	return func(root xml.Name, err error) {
+		stats := ParseStats{
+			Func:      fn,
+			Namespace: root.Space,
+			Root:      root.Local,
+			Size:      size,
+			Duration:  time.Since(began),
+			Err:       err,
+		}
+		if i := strings.LastIndex(strings.TrimRight(root.Space, "/"), "/"); i >= 0 {
+			stats.Version = strings.TrimRight(root.Space, "/")[i+1:]
+		}
+		(*hook)(stats)
+	}
+}
@@ -0,0 +1,72 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseHook validates that an installed ParseHook observes each parse
+// exactly once with its entry point, root element, version and outcome
+func TestParseHook(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	var stats []ParseStats
+	SetParseHook(func(s ParseStats) { stats = append(stats, s) })
+	t.Cleanup(func() { SetParseHook(nil) })
+
+	t.Run("Entry Points", func(t *testing.T) {
+		stats = nil
+		if _, _, err := ParseERN(xmlData); err != nil {
+			t.Fatalf("ParseERN failed: %v", err)
+		}
+		if _, err := ParseERNWithVersion(xmlData, ERNv43); err != nil {
+			t.Fatalf("ParseERNWithVersion failed: %v", err)
+		}
+		if _, err := ParseDDEX(xmlData); err != nil {
+			t.Fatalf("ParseDDEX failed: %v", err)
+		}
+
+		want := []string{"ParseERN", "ParseERNWithVersion", "ParseDDEX"}
+		if len(stats) != len(want) {
+			t.Fatalf("Hook fired %d times, want %d: %v", len(stats), len(want), stats)
+		}
+		for i, s := range stats {
+			if s.Func != want[i] {
+				t.Errorf("stats[%d].Func = %q, want %q", i, s.Func, want[i])
+			}
+			if s.Namespace != "http://ddex.net/xml/ern/43" || s.Root != "NewReleaseMessage" || s.Version != "43" {
+				t.Errorf("%s reported %s %s version %q", s.Func, s.Namespace, s.Root, s.Version)
+			}
+			if s.Size != len(xmlData) || s.Duration <= 0 || s.Err != nil {
+				t.Errorf("%s reported size %d, duration %v, err %v", s.Func, s.Size, s.Duration, s.Err)
+			}
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		stats = nil
+		_, err := ParseDDEX([]byte(`<Unknown xmlns="http://example.com/unknown"/>`))
+		if err == nil {
+			t.Fatal("Expected error for an unregistered message")
+		}
+		if len(stats) != 1 || stats[0].Err != err || stats[0].Root != "Unknown" || stats[0].Version != "unknown" {
+			t.Errorf("Stats = %+v", stats)
+		}
+	})
+
+	t.Run("Removed Hook", func(t *testing.T) {
+		stats = nil
+		SetParseHook(nil)
+		if _, err := ParseDDEX(xmlData); err != nil {
+			t.Fatalf("ParseDDEX failed: %v", err)
+		}
+		if len(stats) != 0 {
+			t.Errorf("Removed hook still fired: %v", stats)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package ddex
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Identifier is a standard or proprietary identifier found in a message
+type Identifier struct {
+	Type      string // XML element name, e.g. ISRC, GRid, ProprietaryId
+	Value     string
+	Namespace string // Namespace attribute of proprietary identifiers and catalog numbers
+	Path      string // as reported by Walk, e.g. ResourceList.SoundRecording[0].SoundRecordingEdition[0].ResourceId[0].ISRC
+}
+
+// identifierElements are the XML elements holding an identifier. Proprietary
+// identifiers and catalog numbers carry their value as chardata next to a
+// Namespace attribute; the others are plain text.
+var identifierElements = map[string]bool{
+	"ISRC": true, "ISWC": true, "ISNI": true, "GRid": true, "ICPN": true,
+	"ISAN": true, "VISAN": true, "EIDR": true, "ISBN": true, "ISMN": true,
+	"ISSN": true, "SICI": true, "ISTC": true, "DOI": true,
+	"DPID": true, "IpiNameNumber": true, "IPN": true, "CisacSocietyId": true,
+	"ProprietaryId": true, "ProprietaryWorkId": true, "ProprietaryResourceId": true, "ProprietaryReleaseId": true,
+	"CatalogNumber": true,
+}
+
+// CollectIdentifiers returns every identifier in any message type, in document
+// order, via proto reflection. A PartyId given as plain text (as in a
+// MessageSender) is reported too; structured PartyIds are searched for the
+// identifiers they hold. Empty values are skipped.
+func CollectIdentifiers(msg proto.Message) []Identifier {
+	var ids []Identifier
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		mapping := xmlFields(parent)[fd.Number()]
+		if mapping.Attr || mapping.Chardata {
+			return nil
+		}
+
+		switch {
+		case fd.Kind() == protoreflect.StringKind && (identifierElements[mapping.Name] || mapping.Name == "PartyId"):
+			if v.String() != "" {
+				ids = append(ids, Identifier{Type: mapping.Name, Value: v.String(), Path: path})
+			}
+		case fd.Kind() == protoreflect.MessageKind && identifierElements[mapping.Name]:
+			if id := namespacedIdentifier(v.Message()); id.Value != "" {
+				id.Type, id.Path = mapping.Name, path
+				ids = append(ids, id)
+			}
+			return SkipChildren
+		}
+		return nil
+	})
+	return ids
+}
+
+// namespacedIdentifier reads the chardata value and Namespace attribute of an
+// identifier element
+func namespacedIdentifier(m protoreflect.Message) Identifier {
+	var id Identifier
+	fields := m.Descriptor().Fields()
+	for number, mapping := range xmlFields(m) {
+		fd := fields.ByNumber(number)
+		if fd == nil || fd.Kind() != protoreflect.StringKind {
+			continue
+		}
+		switch {
+		case mapping.Chardata:
+			id.Value = m.Get(fd).String()
+		case mapping.Attr && mapping.Name == "Namespace":
+			id.Namespace = m.Get(fd).String()
+		}
+	}
+	return id
+}
@@ -0,0 +1,69 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+)
+
+// TestCollectIdentifiers validates identifier extraction from a DDEX sample
+func TestCollectIdentifiers(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	ids := CollectIdentifiers(msg)
+
+	t.Run("Known Identifiers", func(t *testing.T) {
+		want := []Identifier{
+			{Type: "PartyId", Value: "PADPIDA2007050901U", Path: "MessageHeader.MessageSender.PartyId"},
+			{Type: "ProprietaryId", Value: "1981969", Namespace: "PADPIDA2007050901U"},
+			{Type: "ISRC", Value: "GBAYC1700598"},
+			{Type: "GRid", Value: "A10302B0003989564F"},
+			{Type: "ICPN", Value: "190295810726"},
+		}
+		for _, w := range want {
+			if !containsIdentifier(ids, w) {
+				t.Errorf("Expected %+v among %+v", w, ids)
+			}
+		}
+	})
+
+	t.Run("Document Order", func(t *testing.T) {
+		if len(ids) == 0 || ids[0].Path != "MessageHeader.MessageSender.PartyId" {
+			t.Errorf("Expected the sender's PartyId first, got %+v", ids)
+		}
+		for _, id := range ids {
+			if id.Value == "" || id.Type == "" || id.Path == "" {
+				t.Errorf("Incomplete identifier %+v", id)
+			}
+		}
+	})
+
+	t.Run("No Identifiers", func(t *testing.T) {
+		if ids := CollectIdentifiers(&meadv11.MeadMessage{}); len(ids) != 0 {
+			t.Errorf("Expected no identifiers, got %+v", ids)
+		}
+		if ids := CollectIdentifiers(nil); len(ids) != 0 {
+			t.Errorf("Expected no identifiers for nil, got %+v", ids)
+		}
+	})
+}
+
+// containsIdentifier reports whether ids holds want, matching Path only when set
+func containsIdentifier(ids []Identifier, want Identifier) bool {
+	for _, id := range ids {
+		if id.Type == want.Type && id.Value == want.Value && id.Namespace == want.Namespace && (want.Path == "" || id.Path == want.Path) {
+			return true
+		}
+	}
+	return false
+}
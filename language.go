@@ -0,0 +1,106 @@
+package ddex
+
+import (
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+)
+
+// LocalizedText is text tagged with the language it is written in. Every
+// generated message holding a Value and a LanguageAndScriptCode implements
+// it (DisplayTitleText, DisplayArtistName, TitleText, Synopsis, ...), so
+// helpers like SelectLocalized work across all of them.
+type LocalizedText interface {
+	GetValue() string
+	GetLanguageAndScriptCode() string
+}
+
+// defaultMarked is implemented by the localized texts that carry an
+// IsDefault attribute
+type defaultMarked interface {
+	GetIsDefault() bool
+}
+
+// languageTag is a parsed LanguageAndScriptCode such as "ja-Latn" or "en-US"
+type languageTag struct {
+	language string
+	script   string
+	region   string
+}
+
+// parseLanguageTag splits a BCP 47 style code into its language, script and
+// region subtags, lowercased for comparison
+func parseLanguageTag(code string) languageTag {
+	parts := strings.Split(strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "_", "-")), "-")
+
+	tag := languageTag{language: parts[0]}
+	for _, part := range parts[1:] {
+		switch {
+		case len(part) == 4 && tag.script == "":
+			tag.script = part
+		case (len(part) == 2 || len(part) == 3) && tag.region == "":
+			tag.region = part
+		}
+	}
+	return tag
+}
+
+// matchScore rates how well an available tag satisfies a preferred one:
+// 3 for an exact match, 2 when language and any requested script agree,
+// 1 for the same language only, 0 otherwise
+func (want languageTag) matchScore(have languageTag) int {
+	switch {
+	case want.language == "" || want.language != have.language:
+		return 0
+	case want == have:
+		return 3
+	case want.script == "" || want.script == have.script:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// SelectByLanguage returns the title best matching the preferred language
+// codes, tried in order. Without a match it falls back to the default title,
+// then to one without a language, then to the first. It returns nil only if
+// titles is empty.
+func SelectByLanguage(titles []*ernv432.DisplayTitleText, prefer []string) *ernv432.DisplayTitleText {
+	return SelectLocalized(titles, prefer)
+}
+
+// SelectLocalized is SelectByLanguage for any LocalizedText, e.g. the
+// DisplayArtistNames of a release. Types without an IsDefault attribute skip
+// the default fallback.
+func SelectLocalized[T LocalizedText](titles []T, prefer []string) T {
+	if len(titles) == 0 {
+		var zero T
+		return zero
+	}
+
+	for _, code := range prefer {
+		want := parseLanguageTag(code)
+
+		best, bestScore := -1, 0
+		for i, title := range titles {
+			if score := want.matchScore(parseLanguageTag(title.GetLanguageAndScriptCode())); score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best >= 0 {
+			return titles[best]
+		}
+	}
+
+	for _, title := range titles {
+		if d, ok := any(title).(defaultMarked); ok && d.GetIsDefault() {
+			return title
+		}
+	}
+	for _, title := range titles {
+		if title.GetLanguageAndScriptCode() == "" {
+			return title
+		}
+	}
+	return titles[0]
+}
@@ -0,0 +1,104 @@
+package ddex
+
+import (
+	"strings"
+	"testing"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// TestSelectByLanguage validates language and script matching with fallbacks
+func TestSelectByLanguage(t *testing.T) {
+	titles := []*ernv432.DisplayTitleText{
+		{Value: "Kaze", LanguageAndScriptCode: proto.String("ja-Latn")},
+		{Value: "風", LanguageAndScriptCode: proto.String("ja-Jpan"), IsDefault: proto.Bool(true)},
+		{Value: "Veter", LanguageAndScriptCode: proto.String("ru-Latn")},
+		{Value: "Ветер", LanguageAndScriptCode: proto.String("ru-Cyrl")},
+		{Value: "Wind", LanguageAndScriptCode: proto.String("en")},
+	}
+
+	testCases := []struct {
+		name   string
+		prefer []string
+		want   string
+	}{
+		{"Exact Script", []string{"ja-Latn"}, "Kaze"},
+		{"Script Case Insensitive", []string{"RU_cyrl"}, "Ветер"},
+		{"Language Only", []string{"en-GB"}, "Wind"},
+		{"Language Without Script", []string{"ru"}, "Veter"},
+		{"Ordered Preferences", []string{"fr", "ru-Cyrl", "en"}, "Ветер"},
+		{"Default Fallback", []string{"fr"}, "風"},
+		{"No Preferences", nil, "風"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := SelectByLanguage(titles, tc.prefer)
+			if got == nil || got.Value != tc.want {
+				t.Errorf("SelectByLanguage(%v) = %v, want %q", tc.prefer, got, tc.want)
+			}
+		})
+	}
+
+	if got := SelectByLanguage(nil, []string{"en"}); got != nil {
+		t.Errorf("Expected nil for empty titles, got %v", got)
+	}
+}
+
+// TestLocalizedText validates that every generated message with a value and
+// a language implements LocalizedText, and that SelectLocalized works across them
+func TestLocalizedText(t *testing.T) {
+	t.Run("Generated Types", func(t *testing.T) {
+		count := 0
+		protoregistry.GlobalTypes.RangeMessages(func(mt protoreflect.MessageType) bool {
+			desc := mt.Descriptor()
+			if !strings.HasPrefix(string(desc.FullName()), "ddex.") {
+				return true
+			}
+			value := desc.Fields().ByName("value")
+			lang := desc.Fields().ByName("language_and_script_code")
+			if value == nil || lang == nil || value.Kind() != protoreflect.StringKind || lang.Kind() != protoreflect.StringKind {
+				return true
+			}
+
+			count++
+			if _, ok := mt.New().Interface().(LocalizedText); !ok {
+				t.Errorf("%s does not implement LocalizedText", desc.FullName())
+			}
+			return true
+		})
+		if count == 0 {
+			t.Error("Expected generated localized text types")
+		}
+	})
+
+	t.Run("Display Artist Names", func(t *testing.T) {
+		names := []*ernv432.DisplayArtistNameWithOriginalLanguage{
+			{Value: "Pyotr Tchaikovsky", LanguageAndScriptCode: proto.String("en"), IsDefault: proto.Bool(true)},
+			{Value: "Пётр Чайковский", LanguageAndScriptCode: proto.String("ru-Cyrl")},
+		}
+		if got := SelectLocalized(names, []string{"ru"}); got.GetValue() != "Пётр Чайковский" {
+			t.Errorf("Expected the Russian name, got %v", got)
+		}
+		if got := SelectLocalized(names, []string{"de"}); got.GetValue() != "Pyotr Tchaikovsky" {
+			t.Errorf("Expected the default name, got %v", got)
+		}
+	})
+
+	t.Run("Without IsDefault", func(t *testing.T) {
+		names := []*ernv383.Name{
+			{Value: "Wind", LanguageAndScriptCode: proto.String("en")},
+			{Value: "Kaze"},
+		}
+		if got := SelectLocalized(names, []string{"fr"}); got.GetValue() != "Kaze" {
+			t.Errorf("Expected the name without a language, got %v", got)
+		}
+		if got := SelectLocalized([]*ernv383.Name{}, nil); got != nil {
+			t.Errorf("Expected nil for empty names, got %v", got)
+		}
+	})
+}
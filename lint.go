@@ -0,0 +1,155 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Severity grades a Diagnostic
+type Severity int
+
+const (
+	// SeverityWarning marks content the XSD allows but recipients commonly reject
+	SeverityWarning Severity = iota
+	// SeverityError marks a problem Validate reports
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Diagnostic is a single finding of Lint
+type Diagnostic struct {
+	Path     string
+	Severity Severity
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s: %s", d.Severity, d.Path, d.Message)
+}
+
+// releaseReferencePath matches the ReleaseReference of a Release in the
+// ReleaseList, singular in ERN 4 and repeated in ERN 3
+var releaseReferencePath = regexp.MustCompile(`^(ReleaseList\.Release(\[\d+\])?)\.ReleaseReference(\[\d+\])?$`)
+
+// Lint reports the problems Validate finds as errors, plus warnings for
+// content that is probably wrong even where the XSD allows it: a header
+// without recipients, where its schema does not already require one, or
+// with a recipient lacking a PartyId, and Release entries no deal in the
+// DealList references. TrackReleases need no deal of
+// their own, and messages without a DealList are not checked for
+// unreferenced releases, since deals may be delivered separately.
+func Lint(msg proto.Message) []Diagnostic {
+	var diags []Diagnostic
+	for _, err := range Validate(msg) {
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			diags = append(diags, Diagnostic{Path: verr.Path, Severity: SeverityError, Message: verr.Message})
+		} else {
+			diags = append(diags, Diagnostic{Severity: SeverityError, Message: err.Error()})
+		}
+	}
+	if msg == nil {
+		return diags
+	}
+
+	schema, _ := SchemaOf(msg)
+	diags = append(diags, lintRecipients(schema, msg.ProtoReflect())...)
+
+	type release struct{ path, reference string }
+	var releases []release
+	dealt := make(map[string]bool)
+
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		field := xmlFields(parent)[fd.Number()]
+		if fd.Kind() != protoreflect.StringKind || field.Attr {
+			return nil
+		}
+		value := strings.TrimSpace(v.String())
+		if m := releaseReferencePath.FindStringSubmatch(path); m != nil {
+			releases = append(releases, release{path: m[1], reference: value})
+		}
+		if field.Name == "DealReleaseReference" && strings.HasPrefix(path, "DealList.") {
+			dealt[value] = true
+		}
+		return nil
+	})
+
+	if dealList := msg.ProtoReflect().Descriptor().Fields().ByName("deal_list"); dealList != nil && msg.ProtoReflect().Has(dealList) {
+		for _, r := range releases {
+			if !dealt[r.reference] {
+				diags = append(diags, Diagnostic{
+					Path:     r.path,
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("release %s is not referenced by any deal", r.reference),
+				})
+			}
+		}
+	}
+
+	return diags
+}
+
+// schemaRequires reports whether the schema of m declares the named element
+// mandatory, in which case Validate already reports it missing
+func schemaRequires(schema *Schema, m protoreflect.Message, name string) bool {
+	if schema == nil {
+		return false
+	}
+	typ, ok := schema.Type(string(m.Descriptor().Name()))
+	if !ok {
+		return false
+	}
+	field, ok := typ.Field(name)
+	return ok && field.MinOccurs > 0
+}
+
+// lintRecipients warns about a MessageHeader without MessageRecipients, or
+// with recipients whose PartyId is empty
+func lintRecipients(schema *Schema, m protoreflect.Message) []Diagnostic {
+	headerField := m.Descriptor().Fields().ByName("message_header")
+	if headerField == nil || !m.Has(headerField) {
+		return nil // not a root message, or already reported by Validate
+	}
+	header := m.Get(headerField).Message()
+	recipientField := header.Descriptor().Fields().ByName("message_recipient")
+	if recipientField == nil || !recipientField.IsList() {
+		return nil
+	}
+
+	path := joinPath("MessageHeader", xmlFields(header)[recipientField.Number()].Name)
+	recipients := header.Get(recipientField).List()
+	if recipients.Len() == 0 {
+		if schemaRequires(schema, header, "MessageRecipient") {
+			return nil // already reported by Validate
+		}
+		return []Diagnostic{{Path: path, Severity: SeverityWarning, Message: "message has no recipients"}}
+	}
+
+	var diags []Diagnostic
+	for i := 0; i < recipients.Len(); i++ {
+		recipient := recipients.Get(i).Message()
+		partyID := recipient.Descriptor().Fields().ByName("party_id")
+		if partyID == nil || partyID.Kind() != protoreflect.StringKind || partyID.IsList() {
+			continue
+		}
+		if strings.TrimSpace(recipient.Get(partyID).String()) == "" {
+			diags = append(diags, Diagnostic{
+				Path:     fmt.Sprintf("%s[%d]", path, i),
+				Severity: SeverityWarning,
+				Message:  "recipient has no PartyId",
+			})
+		}
+	}
+	return diags
+}
@@ -0,0 +1,113 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+)
+
+// TestLint checks the warnings Lint reports on constructed messages and that
+// the well-formed sample files lint clean
+func TestLint(t *testing.T) {
+	t.Run("Samples", func(t *testing.T) {
+		for testName, filename := range ernTestFiles {
+			if filename == "8 DjMix.xml" {
+				continue // has no MessageId
+			}
+			t.Run(testName, func(t *testing.T) {
+				xmlPath := filepath.Join("testdata", "ernv43", "Samples43", filename)
+				xmlData, err := os.ReadFile(xmlPath)
+				if err != nil {
+					t.Skipf("Sample file not found: %s", xmlPath)
+				}
+
+				msg, _, err := ParseERN(xmlData)
+				if err != nil {
+					t.Fatalf("Failed to parse: %v", err)
+				}
+				if diags := Lint(msg); len(diags) != 0 {
+					t.Errorf("Expected no diagnostics, got %v", diags)
+				}
+			})
+		}
+	})
+
+	newMessage := func() *ernv43.NewReleaseMessage {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, err := ernv43.UnmarshalNewReleaseMessage(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal: %v", err)
+		}
+		return msg
+	}
+
+	t.Run("Clean Message", func(t *testing.T) {
+		if diags := Lint(newMessage()); len(diags) != 0 {
+			t.Errorf("Expected no diagnostics, got %v", diags)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		modify func(*ernv43.NewReleaseMessage)
+		want   Diagnostic
+	}{
+		{
+			"No Recipients",
+			func(m *ernv43.NewReleaseMessage) { m.MessageHeader.MessageRecipient = nil },
+			Diagnostic{Path: "MessageHeader.MessageRecipient", Severity: SeverityError, Message: "required element occurs 0 times, at least 1 expected"},
+		},
+		{
+			"Empty Recipient",
+			func(m *ernv43.NewReleaseMessage) { m.MessageHeader.MessageRecipient[0].PartyId = " " },
+			Diagnostic{Path: "MessageHeader.MessageRecipient[0]", Severity: SeverityWarning, Message: "recipient has no PartyId"},
+		},
+		{
+			"Missing DisplayTitle",
+			func(m *ernv43.NewReleaseMessage) { m.ResourceList.SoundRecording[0].DisplayTitle = nil },
+			Diagnostic{Path: "ResourceList.SoundRecording[0].DisplayTitle", Severity: SeverityError, Message: "required element occurs 0 times, at least 1 expected"},
+		},
+		{
+			"Release Without Deal",
+			func(m *ernv43.NewReleaseMessage) { m.DealList.ReleaseDeal[1].DealReleaseReference = []string{"R1"} },
+			Diagnostic{Path: "ReleaseList.Release", Severity: SeverityWarning, Message: "release R0 is not referenced by any deal"},
+		},
+		{
+			"Validation Error",
+			func(m *ernv43.NewReleaseMessage) { m.MessageHeader.MessageId = "" },
+			Diagnostic{Path: "MessageHeader.MessageId", Severity: SeverityError, Message: "required element is missing or empty"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := newMessage()
+			tt.modify(msg)
+
+			diags := Lint(msg)
+			if len(diags) != 1 || diags[0] != tt.want {
+				t.Errorf("Expected [%v], got %v", tt.want, diags)
+			}
+		})
+	}
+
+	t.Run("No Deal List", func(t *testing.T) {
+		msg := newMessage()
+		msg.DealList = nil
+		if diags := Lint(msg); len(diags) != 0 {
+			t.Errorf("Expected releases to go unchecked without a DealList, got %v", diags)
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		d := Diagnostic{Path: "MessageHeader.MessageRecipient", Severity: SeverityWarning, Message: "message has no recipients"}
+		if got := d.String(); got != "warning: MessageHeader.MessageRecipient: message has no recipients" {
+			t.Errorf("Unexpected string %q", got)
+		}
+	})
+}
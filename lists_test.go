@@ -0,0 +1,171 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+)
+
+// TestListAccessors checks the generated accessors reaching through the list
+// wrappers of root messages
+func TestListAccessors(t *testing.T) {
+	t.Run("Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, _, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		release, ok := msg.(*ernv43.NewReleaseMessage)
+		if !ok {
+			t.Fatalf("Expected *ernv43.NewReleaseMessage, got %T", msg)
+		}
+
+		if got, want := len(release.Parties()), len(release.GetPartyList().GetParty()); got != want || got == 0 {
+			t.Errorf("Parties() has %d entries, want %d", got, want)
+		}
+		if got, want := len(release.SoundRecordings()), len(release.GetResourceList().GetSoundRecording()); got != want || got == 0 {
+			t.Errorf("SoundRecordings() has %d entries, want %d", got, want)
+		}
+		if got, want := len(release.TrackReleases()), len(release.GetReleaseList().GetTrackRelease()); got != want {
+			t.Errorf("TrackReleases() has %d entries, want %d", got, want)
+		}
+		if got, want := len(release.ReleaseDeals()), len(release.GetDealList().GetReleaseDeal()); got != want || got == 0 {
+			t.Errorf("ReleaseDeals() has %d entries, want %d", got, want)
+		}
+
+		// ERN 4 has a single main Release, returned as a slice of one
+		releases := release.Releases()
+		if len(releases) != 1 || releases[0] != release.GetReleaseList().GetRelease() {
+			t.Errorf("Expected the main release, got %v", releases)
+		}
+	})
+
+	t.Run("Missing Wrapper", func(t *testing.T) {
+		msg := &ernv43.NewReleaseMessage{}
+		if msg.Parties() != nil || msg.SoundRecordings() != nil || msg.Releases() != nil || msg.ReleaseDeals() != nil {
+			t.Error("Expected nil entries without list wrappers")
+		}
+
+		var nilMsg *ernv43.NewReleaseMessage
+		if nilMsg.Videos() != nil {
+			t.Error("Expected nil entries for a nil message")
+		}
+	})
+
+	t.Run("ERN 3", func(t *testing.T) {
+		msg := &ernv383.NewReleaseMessage{
+			ReleaseList: &ernv383.ReleaseList{Release: []*ernv383.Release{{}, {}}},
+		}
+		if len(msg.Releases()) != 2 {
+			t.Errorf("Expected 2 releases, got %d", len(msg.Releases()))
+		}
+	})
+}
+
+// TestListIndexes checks the generated indexes of list entries by reference
+func TestListIndexes(t *testing.T) {
+	t.Run("Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, _, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		release, ok := msg.(*ernv43.NewReleaseMessage)
+		if !ok {
+			t.Fatalf("Expected *ernv43.NewReleaseMessage, got %T", msg)
+		}
+
+		resources := release.GetResourceList()
+		recordings := resources.SoundRecordingIndex()
+		if len(recordings) != len(resources.GetSoundRecording()) {
+			t.Errorf("SoundRecordingIndex() has %d entries, want %d", len(recordings), len(resources.GetSoundRecording()))
+		}
+		if got := recordings["A2"]; got == nil || got.GetResourceReference() != "A2" {
+			t.Errorf("Expected SoundRecording A2, got %v", got)
+		}
+		if got := resources.ImageIndex()["A22"]; got == nil || got.GetResourceReference() != "A22" {
+			t.Errorf("Expected Image A22, got %v", got)
+		}
+
+		all := resources.Index()
+		if _, ok := all["A1"].(*ernv43.SoundRecording); !ok {
+			t.Errorf("Expected A1 to index a SoundRecording, got %T", all["A1"])
+		}
+		if _, ok := all["A22"].(*ernv43.Image); !ok {
+			t.Errorf("Expected A22 to index an Image, got %T", all["A22"])
+		}
+
+		releases := release.GetReleaseList().Index()
+		if _, ok := releases["R0"].(*ernv43.Release); !ok {
+			t.Errorf("Expected R0 to index the main Release, got %T", releases["R0"])
+		}
+		if _, ok := releases["R1"].(*ernv43.TrackRelease); !ok {
+			t.Errorf("Expected R1 to index a TrackRelease, got %T", releases["R1"])
+		}
+
+		if party := release.GetPartyList().PartyIndex()["PEMI"]; party == nil {
+			t.Error("Expected party PEMI")
+		}
+		deals := release.GetDealList().ReleaseDealIndex()
+		if len(deals["R0"]) == 0 {
+			t.Error("Expected deals for release R0")
+		}
+	})
+
+	t.Run("Missing Wrapper", func(t *testing.T) {
+		msg := &ernv43.NewReleaseMessage{}
+		if len(msg.GetResourceList().SoundRecordingIndex()) != 0 || len(msg.GetResourceList().Index()) != 0 {
+			t.Error("Expected empty indexes without a ResourceList")
+		}
+		if msg.GetResourceList().ImageIndex()["A1"] != nil {
+			t.Error("Expected no entry in an empty index")
+		}
+	})
+
+	t.Run("Duplicate References", func(t *testing.T) {
+		first := &ernv43.Image{ResourceReference: "A1"}
+		list := &ernv43.ResourceList{
+			SoundRecording: []*ernv43.SoundRecording{{ResourceReference: "A1"}, {}},
+			Image:          []*ernv43.Image{first, {ResourceReference: "A1"}},
+		}
+		if got := list.ImageIndex()["A1"]; got != first {
+			t.Error("Expected the first Image to win")
+		}
+		if len(list.SoundRecordingIndex()) != 1 {
+			t.Errorf("Expected entries without a reference to be skipped, got %v", list.SoundRecordingIndex())
+		}
+		if _, ok := list.Index()["A1"].(*ernv43.SoundRecording); !ok {
+			t.Errorf("Expected the SoundRecording, declared first, to win, got %T", list.Index()["A1"])
+		}
+	})
+
+	t.Run("ERN 3", func(t *testing.T) {
+		list := &ernv383.ReleaseList{Release: []*ernv383.Release{
+			{ReleaseReference: []string{"R0", "R0a"}},
+			{ReleaseReference: []string{"R1"}},
+		}}
+		index := list.ReleaseIndex()
+		if len(index) != 3 || index["R0a"] != index["R0"] || index["R1"] == nil {
+			t.Errorf("Expected every ReleaseReference indexed, got %v", index)
+		}
+
+		deals := (&ernv383.DealList{ReleaseDeal: []*ernv383.ReleaseDeal{
+			{DealReleaseReference: []string{"R0", "R1"}},
+			{DealReleaseReference: []string{"R1"}},
+		}}).ReleaseDealIndex()
+		if len(deals["R0"]) != 1 || len(deals["R1"]) != 2 {
+			t.Errorf("Expected deals grouped by release, got %v", deals)
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/beevik/etree"
+	"google.golang.org/protobuf/proto"
+)
+
+// MarshalOptions configures Marshal
+type MarshalOptions struct {
+	// Indent, when set, is repeated once per nesting level
+	Indent string
+
+	// FixNamespacePrefixes qualifies the root element with the prefix it
+	// declares for its registered namespace and gives each foreign (xs:any)
+	// namespace a single prefix declared on the root, in place of per-element
+	// default namespace declarations. Schema elements below the root stay
+	// unprefixed, as every DDEX schema uses elementFormDefault="unqualified".
+	FixNamespacePrefixes bool
+}
+
+// Marshal encodes any supported message to XML according to opts
+func Marshal(msg proto.Message, opts MarshalOptions) ([]byte, error) {
+	if msg == nil {
+		return nil, errors.New("message is nil")
+	}
+
+	var data []byte
+	var err error
+	if opts.Indent != "" {
+		data, err = xml.MarshalIndent(msg, "", opts.Indent)
+	} else {
+		data, err = xml.Marshal(msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	if !opts.FixNamespacePrefixes {
+		return data, nil
+	}
+	return fixNamespacePrefixes(data)
+}
+
+// MarshalOption configures MarshalTo
+type MarshalOption func(*MarshalOptions)
+
+// WithIndent sets the string repeated once per nesting level; "" writes the
+// document on one line
+func WithIndent(indent string) MarshalOption {
+	return func(opts *MarshalOptions) {
+		opts.Indent = indent
+	}
+}
+
+// WithFixNamespacePrefixes sets MarshalOptions.FixNamespacePrefixes
+func WithFixNamespacePrefixes() MarshalOption {
+	return func(opts *MarshalOptions) {
+		opts.FixNamespacePrefixes = true
+	}
+}
+
+// MarshalTo writes the XML header and any supported message to w, indented
+// by two spaces unless WithIndent says otherwise, streaming through an
+// xml.Encoder rather than buffering the whole document. Root namespaces are
+// populated by the message's MarshalXML as with Marshal.
+// WithFixNamespacePrefixes rewrites the finished document, so with it the
+// output is buffered before being written.
+func MarshalTo(w io.Writer, msg proto.Message, options ...MarshalOption) error {
+	if msg == nil {
+		return errors.New("message is nil")
+	}
+	opts := MarshalOptions{Indent: "  "}
+	for _, option := range options {
+		option(&opts)
+	}
+
+	if opts.FixNamespacePrefixes {
+		data, err := Marshal(msg, opts)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, xml.Header); err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", opts.Indent)
+	if err := encoder.Encode(msg); err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+	return encoder.Close()
+}
+
+// fixNamespacePrefixes rewrites marshaled XML so every namespaced element
+// and attribute uses a single prefix per namespace, declared on the root
+func fixNamespacePrefixes(data []byte) ([]byte, error) {
+	start, err := detectRootElement(data)
+	if err != nil {
+		return nil, err
+	}
+	rootName, _, ok := DefaultRegistry.resolve(start)
+	if !ok {
+		return nil, fmt.Errorf("unsupported DDEX message: %s", rootName.Local)
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, fmt.Errorf("failed to read marshaled XML: %w", err)
+	}
+
+	root := doc.Root()
+	restoreRootPrefix(root, rootName.Space)
+	if root.Space == "" {
+		return nil, fmt.Errorf("%s does not declare a prefix for %s", rootName.Local, rootName.Space)
+	}
+
+	// Resolve every name before rewriting, as rewriting drops the declarations
+	// the resolution depends on
+	var names []qualifiedName
+	collectNames(root, &names)
+
+	prefixes := make(map[string]string) // namespace URI to prefix
+	used := make(map[string]bool)
+	for _, attr := range root.Attr {
+		if attr.Space == "xmlns" {
+			prefixes[attr.Value] = attr.Key
+			used[attr.Key] = true
+		}
+	}
+
+	for _, name := range names {
+		if name.uri == "" || name.uri == xmlNamespace {
+			continue
+		}
+		prefix, ok := prefixes[name.uri]
+		if !ok {
+			prefix = newPrefix(name.uri, used)
+			prefixes[name.uri] = prefix
+			used[prefix] = true
+			root.CreateAttr("xmlns:"+prefix, name.uri)
+		}
+		*name.space = prefix
+	}
+
+	for _, child := range root.ChildElements() {
+		dropNamespaceDecls(child)
+	}
+	return doc.WriteToBytes()
+}
+
+// xmlNamespace is bound to the reserved xml prefix
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// qualifiedName points at the prefix of an element or attribute name
+// together with the namespace URI it resolves to
+type qualifiedName struct {
+	space *string
+	uri   string
+}
+
+// collectNames resolves the prefixed and default-namespaced names below e
+func collectNames(e *etree.Element, names *[]qualifiedName) {
+	for _, child := range e.ChildElements() {
+		*names = append(*names, qualifiedName{space: &child.Space, uri: child.NamespaceURI()})
+		for i := range child.Attr {
+			if attr := &child.Attr[i]; attr.Space != "" && !isNamespaceDecl(*attr) {
+				*names = append(*names, qualifiedName{space: &attr.Space, uri: attr.NamespaceURI()})
+			}
+		}
+		collectNames(child, names)
+	}
+}
+
+// dropNamespaceDecls removes the namespace declarations of e and its
+// descendants once their names have been rewritten against the root
+func dropNamespaceDecls(e *etree.Element) {
+	e.Attr = slices.DeleteFunc(e.Attr, isNamespaceDecl)
+	for _, child := range e.ChildElements() {
+		dropNamespaceDecls(child)
+	}
+}
+
+// newPrefix derives an unused prefix from the last segment of a namespace
+// URI, as encoding/xml does for attributes, falling back to ns1, ns2, ...
+func newPrefix(uri string, used map[string]bool) string {
+	prefix := strings.TrimRight(uri, "/")
+	if i := strings.LastIndexAny(prefix, "/:#"); i >= 0 {
+		prefix = prefix[i+1:]
+	}
+	if prefix == "" || !isNCName(prefix) || strings.HasPrefix(strings.ToLower(prefix), "xml") || used[prefix] {
+		for n := 1; ; n++ {
+			if prefix = fmt.Sprintf("ns%d", n); !used[prefix] {
+				break
+			}
+		}
+	}
+	return prefix
+}
+
+func isNCName(s string) bool {
+	for i, r := range s {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r) && r != '-' && r != '.') {
+			return false
+		}
+	}
+	return true
+}
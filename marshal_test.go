@@ -0,0 +1,199 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestMarshalNamespacePrefixes validates prefix correction on a document mixing two namespaces
+func TestMarshalNamespacePrefixes(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "piev10", "pie_extension_example.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	out, err := Marshal(msg, MarshalOptions{FixNamespacePrefixes: true})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	t.Run("Prefixes", func(t *testing.T) {
+		for _, want := range []string{
+			`<pie:PieMessage `,
+			`</pie:PieMessage>`,
+			`<MessageHeader>`,
+			`<PartyReference>P1</PartyReference>`,
+			`<extension:FanClub extension:source="label">`,
+			`<extension:Motto>Come away with me.</extension:Motto>`,
+			`<extension:Motto>The finest in jazz.</extension:Motto>`,
+		} {
+			if !bytes.Contains(out, []byte(want)) {
+				t.Errorf("Expected output to contain %s", want)
+			}
+		}
+		if bytes.Contains(out, []byte(`xmlns="`)) {
+			t.Error("Expected no default namespace declarations")
+		}
+		if n := bytes.Count(out, []byte(`xmlns:extension="http://example.com/ddex/extension"`)); n != 1 {
+			t.Errorf("Expected the extension namespace declared once on the root, got %d", n)
+		}
+	})
+
+	t.Run("Reparse", func(t *testing.T) {
+		reparsed, err := ParseDDEX(out)
+		if err != nil {
+			t.Fatalf("Failed to parse marshaled XML: %v", err)
+		}
+		if !proto.Equal(msg, reparsed) {
+			t.Error("Reparsed message differs from original")
+		}
+	})
+
+	t.Run("Default Output", func(t *testing.T) {
+		plain, err := Marshal(msg, MarshalOptions{})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if !bytes.HasPrefix(plain, []byte("<PieMessage ")) {
+			t.Errorf("Expected unprefixed root without FixNamespacePrefixes, got %.40s", plain)
+		}
+	})
+
+	t.Run("Indented", func(t *testing.T) {
+		out, err := Marshal(msg, MarshalOptions{Indent: "  ", FixNamespacePrefixes: true})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if !bytes.Contains(out, []byte("\n  <MessageHeader>")) {
+			t.Error("Expected indented output")
+		}
+		if _, err := ParseDDEX(out); err != nil {
+			t.Errorf("Failed to parse indented XML: %v", err)
+		}
+	})
+
+	t.Run("Derived Prefix", func(t *testing.T) {
+		data := []byte(`<pie:PieMessage xmlns:pie="http://ddex.net/xml/pie/10"><PartyList><Party><PartyReference>P1</PartyReference><Note xmlns="http://example.com/other">x</Note></Party></PartyList></pie:PieMessage>`)
+		msg, err := ParseDDEX(data)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+
+		out, err := Marshal(msg, MarshalOptions{FixNamespacePrefixes: true})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		for _, want := range []string{`xmlns:other="http://example.com/other"`, `<other:Note>x</other:Note>`} {
+			if !bytes.Contains(out, []byte(want)) {
+				t.Errorf("Expected output to contain %s, got %s", want, out)
+			}
+		}
+	})
+}
+
+// TestMarshalTo validates streaming output against Marshal
+func TestMarshalTo(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "piev10", "pie_extension_example.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	testCases := []struct {
+		name    string
+		options []MarshalOption
+		want    MarshalOptions
+	}{
+		{"Default", nil, MarshalOptions{Indent: "  "}},
+		{"Unindented", []MarshalOption{WithIndent("")}, MarshalOptions{}},
+		{"Fixed Prefixes", []MarshalOption{WithIndent(""), WithFixNamespacePrefixes()}, MarshalOptions{FixNamespacePrefixes: true}},
+		{"Indented Prefix", []MarshalOption{WithIndent("\t"), WithFixNamespacePrefixes()}, MarshalOptions{Indent: "\t", FixNamespacePrefixes: true}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want, err := Marshal(msg, tc.want)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := MarshalTo(&buf, msg, tc.options...); err != nil {
+				t.Fatalf("MarshalTo failed: %v", err)
+			}
+			if got := buf.Bytes(); !bytes.Equal(got, append([]byte(xml.Header), want...)) {
+				t.Errorf("MarshalTo output differs from Marshal:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+
+	t.Run("Namespaces", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := MarshalTo(&buf, &ernv432.NewReleaseMessage{}); err != nil {
+			t.Fatalf("MarshalTo failed: %v", err)
+		}
+		if !bytes.Contains(buf.Bytes(), []byte(`xmlns:ern="`+ernv432.Namespace+`"`)) {
+			t.Errorf("Expected the ERN namespace on a zero-value root, got %s", buf.Bytes())
+		}
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		if err := MarshalTo(io.Discard, nil); err == nil {
+			t.Error("Expected error for nil message")
+		}
+	})
+}
+
+// BenchmarkMarshalTo compares streaming to io.Discard with buffering via xml.MarshalIndent
+func BenchmarkMarshalTo(b *testing.B) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		b.Skip("Sample file not found")
+	}
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		b.Fatalf("Failed to parse: %v", err)
+	}
+
+	b.Run("MarshalTo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := MarshalTo(io.Discard, msg); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("MarshalIndent", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			data, err := xml.MarshalIndent(msg, "", "  ")
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := io.Discard.Write(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package ddex
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CaseMode controls how NormalizeProprietaryIDs rewrites letter case
+type CaseMode int
+
+const (
+	CaseUnchanged CaseMode = iota
+	CaseUpper
+	CaseLower
+)
+
+// ProprietaryIDRules configures NormalizeProprietaryIDs. Trimming and case
+// folding run first; NamespaceAliases is then looked up with the resulting
+// namespace, so alias keys should already be in the normalized form.
+type ProprietaryIDRules struct {
+	TrimSpace        bool
+	ValueCase        CaseMode
+	NamespaceCase    CaseMode
+	NamespaceAliases map[string]string
+}
+
+// NormalizeProprietaryIDs rewrites every ProprietaryId in msg in place
+// according to rules, so IDs that differ only in formatting compare equal
+func NormalizeProprietaryIDs(msg proto.Message, rules ProprietaryIDRules) {
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() != protoreflect.MessageKind || fd.Message().Name() != "ProprietaryId" {
+			return nil
+		}
+
+		id := v.Message()
+		fields := id.Descriptor().Fields()
+		if value := fields.ByName("value"); value != nil {
+			normalized := rules.apply(id.Get(value).String(), rules.ValueCase)
+			id.Set(value, protoreflect.ValueOfString(normalized))
+		}
+		if namespace := fields.ByName("namespace"); namespace != nil {
+			normalized := rules.apply(id.Get(namespace).String(), rules.NamespaceCase)
+			if alias, ok := rules.NamespaceAliases[normalized]; ok {
+				normalized = alias
+			}
+			id.Set(namespace, protoreflect.ValueOfString(normalized))
+		}
+		return SkipChildren
+	})
+}
+
+func (r ProprietaryIDRules) apply(s string, mode CaseMode) string {
+	if r.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+	switch mode {
+	case CaseUpper:
+		s = strings.ToUpper(s)
+	case CaseLower:
+		s = strings.ToLower(s)
+	}
+	return s
+}
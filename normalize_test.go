@@ -0,0 +1,40 @@
+package ddex
+
+import (
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+)
+
+// TestNormalizeProprietaryIDs validates that differently formatted IDs normalize to the same value
+func TestNormalizeProprietaryIDs(t *testing.T) {
+	first := &ernv43.ProprietaryId{Value: "  abc-123 ", Namespace: " padpida111111111"}
+	second := &ernv43.ProprietaryId{Value: "ABC-123", Namespace: "LabelCo"}
+
+	msg := ernv43.NewNewReleaseMessage()
+	msg.ResourceList = &ernv43.ResourceList{
+		SoundRecording: []*ernv43.SoundRecording{{
+			SoundRecordingEdition: []*ernv43.SoundRecordingEdition{{
+				ResourceId: []*ernv43.SoundRecordingId{{ProprietaryId: []*ernv43.ProprietaryId{first}}},
+			}},
+		}},
+		Image: []*ernv43.Image{{
+			ResourceId: []*ernv43.ResourceProprietaryId{{ProprietaryId: []*ernv43.ProprietaryId{second}}},
+		}},
+	}
+
+	NormalizeProprietaryIDs(msg, ProprietaryIDRules{
+		TrimSpace:        true,
+		ValueCase:        CaseUpper,
+		NamespaceCase:    CaseUpper,
+		NamespaceAliases: map[string]string{"LABELCO": "PADPIDA111111111"},
+	})
+
+	if first.Value != "ABC-123" || first.Namespace != "PADPIDA111111111" {
+		t.Errorf("First ID normalized to %q/%q", first.Namespace, first.Value)
+	}
+	if first.Value != second.Value || first.Namespace != second.Namespace {
+		t.Errorf("IDs differ after normalization: %q/%q vs %q/%q",
+			first.Namespace, first.Value, second.Namespace, second.Value)
+	}
+}
@@ -0,0 +1,49 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"unicode/utf8"
+)
+
+// parseErrorContext is how many bytes of input on either side of a failure
+// a ParseError quotes
+const parseErrorContext = 40
+
+// ParseError locates a failure to decode a document in its input, so a
+// rejected file can be traced to the offending markup. It wraps the decoding
+// error, which errors.As can still reach, e.g. an *xml.SyntaxError.
+type ParseError struct {
+	Offset  int64  // byte offset the decoder had reached
+	Line    int    // 1-based line of Offset
+	Column  int    // 1-based column of Offset, in bytes
+	Snippet string // the input around Offset
+	Err     error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d (offset %d): %v; near %q", e.Line, e.Column, e.Offset, e.Err, e.Snippet)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// newParseError wraps err, returned by decoder while reading xmlData, in a
+// *ParseError at the decoder's position
+func newParseError(decoder *xml.Decoder, xmlData []byte, err error) error {
+	offset := min(decoder.InputOffset(), int64(len(xmlData)))
+	line, column := decoder.InputPos()
+
+	// Widen the snippet to whole runes rather than quote split ones
+	start := max(offset-parseErrorContext, 0)
+	for start > 0 && !utf8.RuneStart(xmlData[start]) {
+		start--
+	}
+	end := min(offset+parseErrorContext, int64(len(xmlData)))
+	for end < int64(len(xmlData)) && !utf8.RuneStart(xmlData[end]) {
+		end++
+	}
+
+	return &ParseError{Offset: offset, Line: line, Column: column, Snippet: string(xmlData[start:end]), Err: err}
+}
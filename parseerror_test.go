@@ -0,0 +1,70 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestParseError validates that malformed documents fail with the line and
+// surrounding markup of the problem
+func TestParseError(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	original, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	testCases := []struct {
+		name, old, new string
+		line           int
+		snippet        string
+	}{
+		{"Mismatched Tag", "<ISRC>GBAYC1700598</ISRC>", "<ISRC>GBAYC1700598</Isrc>", 53, "GBAYC1700598</Isrc>"},
+		{"Invalid Boolean", `ApplicableTerritoryCode="Worldwide" IsDefault="true"`, `ApplicableTerritoryCode="Worldwide" IsDefault="maybe"`, 134, `IsDefault="maybe"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !bytes.Contains(original, []byte(tc.old)) {
+				t.Fatalf("Sample does not contain %q", tc.old)
+			}
+			xmlData := bytes.Replace(original, []byte(tc.old), []byte(tc.new), 1)
+
+			_, _, ernErr := ParseERN(xmlData)
+			_, ddexErr := ParseDDEX(xmlData)
+			for _, err := range []error{ernErr, ddexErr} {
+				var parseErr *ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("Expected a *ParseError, got %v", err)
+				}
+				// The decoder stops after the token in error, so a start tag
+				// spanning two lines is reported on the second
+				if parseErr.Line < tc.line || parseErr.Line > tc.line+1 {
+					t.Errorf("Line = %d, want %d", parseErr.Line, tc.line)
+				}
+				if !strings.Contains(parseErr.Snippet, tc.snippet) {
+					t.Errorf("Snippet %q does not contain %q", parseErr.Snippet, tc.snippet)
+				}
+				if !strings.Contains(err.Error(), "line ") || parseErr.Unwrap() == nil {
+					t.Errorf("Expected the line and cause in %q", err)
+				}
+			}
+		})
+	}
+
+	t.Run("Syntax Error Cause", func(t *testing.T) {
+		_, err := ParseDDEX([]byte("<ern:NewReleaseMessage xmlns:ern=\"http://ddex.net/xml/ern/43\">\n<MessageHeader>\n</ern:NewReleaseMessage>"))
+		var syntaxErr *xml.SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Fatalf("Expected the *xml.SyntaxError to be wrapped, got %v", err)
+		}
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) || parseErr.Line != 3 {
+			t.Errorf("Expected line 3, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	avs "github.com/alecsavvy/ddex-go/gen/ddex/avs/vlatest"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrUnknownProfile is returned by ProfileVersion, alongside the parsed name
+// and version, when the profile is not one the library knows about. Callers
+// may treat it as a warning.
+var ErrUnknownProfile = errors.New("unknown release profile")
+
+// knownProfileVersions lists the versioned ERN 3 release profiles, such as
+// CommonReleaseTypes/14/AudioAlbumMusicOnly, and their published versions
+var knownProfileVersions = map[string][]int{
+	"CommonReleaseTypes":      {13, 14},
+	"CommonReleaseTypesTypes": {13, 14}, // misspelling used by the ERN 3 profile standard itself
+}
+
+// ProfileVersion parses the ReleaseProfileVersionId attribute of a root
+// message. ERN 3 values take the form Name/Version[/Variant]; ERN 4 values are
+// the unversioned AVS profile names (e.g. Audio, SimpleAudioSingle) and report
+// version 0. An unrecognised profile or version is returned together with an
+// error wrapping ErrUnknownProfile.
+func ProfileVersion(msg proto.Message) (name string, version int, err error) {
+	if msg == nil {
+		return "", 0, errors.New("message is nil")
+	}
+
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("release_profile_version_id")
+	if fd == nil {
+		return "", 0, fmt.Errorf("%s has no ReleaseProfileVersionId", m.Descriptor().Name())
+	}
+	value := m.Get(fd).String()
+	if value == "" {
+		return "", 0, errors.New("ReleaseProfileVersionId is not set")
+	}
+
+	parts := strings.Split(value, "/")
+	name = parts[0]
+
+	if len(parts) == 1 {
+		if _, ok := avs.ParseReleaseProfileVersionIdString(name); !ok {
+			return name, 0, fmt.Errorf("%w %q", ErrUnknownProfile, name)
+		}
+		return name, 0, nil
+	}
+
+	version, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return name, 0, fmt.Errorf("invalid ReleaseProfileVersionId version %q: %w", parts[1], err)
+	}
+
+	versions, ok := knownProfileVersions[name]
+	if !ok {
+		return name, version, fmt.Errorf("%w %q", ErrUnknownProfile, name)
+	}
+	for _, v := range versions {
+		if v == version {
+			return name, version, nil
+		}
+	}
+	return name, version, fmt.Errorf("%w version %s/%d", ErrUnknownProfile, name, version)
+}
+
+// releaseProfiles holds the checks each supported ERN 4 release profile adds
+// on top of the schema
+var releaseProfiles = map[string]func(*ernv432.NewReleaseMessage) []error{
+	"SimpleAudioSingle": validateSimpleAudioSingle,
+	"SimpleVideoSingle": validateSimpleVideoSingle,
+}
+
+// ValidateProfile checks an ERN 4.3.2 NewReleaseMessage against the
+// cardinality rules of a release profile, such as the single sound recording
+// and front cover image of SimpleAudioSingle, which the XSD cannot express.
+// SimpleAudioSingle and SimpleVideoSingle are supported; any other profile
+// returns an error wrapping ErrUnknownProfile. Violations are returned joined,
+// each as a *ValidationError.
+func ValidateProfile(msg proto.Message, profileId string) error {
+	if msg == nil {
+		return errors.New("message is nil")
+	}
+	release, ok := msg.(*ernv432.NewReleaseMessage)
+	if !ok {
+		return fmt.Errorf("profile validation is not supported for %s", msg.ProtoReflect().Descriptor().FullName())
+	}
+	validate, ok := releaseProfiles[profileId]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownProfile, profileId)
+	}
+
+	var errs []error
+	if declared := release.GetReleaseProfileVersionId(); declared != "" && declared != profileId {
+		errs = append(errs, &ValidationError{
+			Path:    "ReleaseProfileVersionId",
+			Message: fmt.Sprintf("message declares profile %s, not %s", declared, profileId),
+		})
+	}
+	errs = append(errs, validate(release)...)
+	return errors.Join(errs...)
+}
+
+// validateSimpleAudioSingle requires exactly one sound recording, no videos
+// and a front cover image
+func validateSimpleAudioSingle(msg *ernv432.NewReleaseMessage) []error {
+	errs := validateSingleResourceRelease(msg)
+	resources := msg.GetResourceList()
+
+	if n := len(resources.GetSoundRecording()); n != 1 {
+		errs = append(errs, &ValidationError{
+			Path:    "ResourceList.SoundRecording",
+			Message: fmt.Sprintf("profile requires exactly one SoundRecording, found %d", n),
+		})
+	}
+	for i, sr := range resources.GetSoundRecording() {
+		errs = append(errs, validatePrimaryResource(fmt.Sprintf("ResourceList.SoundRecording[%d]", i), soundRecordingTrack(sr))...)
+	}
+	if len(resources.GetVideo()) > 0 {
+		errs = append(errs, &ValidationError{Path: "ResourceList.Video", Message: "not allowed in this profile"})
+	}
+
+	hasFrontCover := false
+	for _, image := range resources.GetImage() {
+		hasFrontCover = hasFrontCover || image.GetType().GetValue() == "FrontCoverImage"
+	}
+	if !hasFrontCover {
+		errs = append(errs, &ValidationError{Path: "ResourceList.Image", Message: "profile requires a FrontCoverImage"})
+	}
+
+	return errs
+}
+
+// validateSimpleVideoSingle requires exactly one video and no sound recordings
+func validateSimpleVideoSingle(msg *ernv432.NewReleaseMessage) []error {
+	errs := validateSingleResourceRelease(msg)
+	resources := msg.GetResourceList()
+
+	if n := len(resources.GetVideo()); n != 1 {
+		errs = append(errs, &ValidationError{
+			Path:    "ResourceList.Video",
+			Message: fmt.Sprintf("profile requires exactly one Video, found %d", n),
+		})
+	}
+	for i, video := range resources.GetVideo() {
+		errs = append(errs, validatePrimaryResource(fmt.Sprintf("ResourceList.Video[%d]", i), videoTrack(video))...)
+	}
+	if len(resources.GetSoundRecording()) > 0 {
+		errs = append(errs, &ValidationError{Path: "ResourceList.SoundRecording", Message: "not allowed in this profile"})
+	}
+
+	return errs
+}
+
+// validateSingleResourceRelease checks the release and deal rules shared by
+// the simple single profiles: an identified main release holding a single
+// resource, no track releases and at least one release deal
+func validateSingleResourceRelease(msg *ernv432.NewReleaseMessage) []error {
+	var errs []error
+
+	release := msg.GetReleaseList().GetRelease()
+	if release == nil {
+		errs = append(errs, &ValidationError{Path: "ReleaseList.Release", Message: "required element is missing"})
+	} else {
+		if release.GetReleaseId().GetGRid() == "" && release.GetReleaseId().GetICPN() == "" {
+			errs = append(errs, &ValidationError{Path: "ReleaseList.Release.ReleaseId", Message: "profile requires a GRid or ICPN"})
+		}
+		group := release.GetResourceGroup()
+		if n := len(group.GetResourceGroupContentItem()); n != 1 || len(group.GetResourceGroup()) > 0 {
+			errs = append(errs, &ValidationError{
+				Path:    "ReleaseList.Release.ResourceGroup",
+				Message: fmt.Sprintf("profile requires exactly one ResourceGroupContentItem and no sub-groups, found %d items and %d sub-groups", n, len(group.GetResourceGroup())),
+			})
+		}
+	}
+
+	if n := len(msg.GetReleaseList().GetTrackRelease()); n > 0 {
+		errs = append(errs, &ValidationError{
+			Path:    "ReleaseList.TrackRelease",
+			Message: fmt.Sprintf("not allowed in this profile, found %d", n),
+		})
+	}
+	if len(msg.GetDealList().GetReleaseDeal()) == 0 {
+		errs = append(errs, &ValidationError{Path: "DealList.ReleaseDeal", Message: "profile requires at least one ReleaseDeal"})
+	}
+
+	return errs
+}
+
+// validatePrimaryResource requires the identification a DSP needs to list
+// the single's only playable resource
+func validatePrimaryResource(path string, track Track) []error {
+	var errs []error
+	for _, field := range []struct{ name, value string }{
+		{"ISRC", track.ISRC},
+		{"DisplayTitleText", track.Title},
+		{"DisplayArtistName", track.Artist},
+		{"Duration", track.Duration},
+	} {
+		if field.value == "" {
+			errs = append(errs, &ValidationError{Path: path, Message: fmt.Sprintf("profile requires %s", field.name)})
+		}
+	}
+	return errs
+}
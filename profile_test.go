@@ -0,0 +1,142 @@
+package ddex
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestProfileVersion validates parsing of ReleaseProfileVersionId across ERN versions
+func TestProfileVersion(t *testing.T) {
+	t.Run("Common Release Types", func(t *testing.T) {
+		msg := &ernv383.NewReleaseMessage{ReleaseProfileVersionId: proto.String("CommonReleaseTypes/14/AudioAlbumMusicOnly")}
+
+		name, version, err := ProfileVersion(msg)
+		if err != nil {
+			t.Fatalf("ProfileVersion failed: %v", err)
+		}
+		if name != "CommonReleaseTypes" || version != 14 {
+			t.Errorf("Expected CommonReleaseTypes 14, got %s %d", name, version)
+		}
+	})
+
+	t.Run("ERN 4 Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		msg, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+
+		name, version, err := ProfileVersion(msg)
+		if err != nil {
+			t.Fatalf("ProfileVersion failed: %v", err)
+		}
+		if name != "Audio" || version != 0 {
+			t.Errorf("Expected Audio 0, got %s %d", name, version)
+		}
+	})
+
+	t.Run("Unknown Profile", func(t *testing.T) {
+		testCases := []string{"CommonReleaseTypes/99", "ExoticReleaseTypes/1", "Hologram"}
+		for _, value := range testCases {
+			msg := &ernv432.NewReleaseMessage{ReleaseProfileVersionId: proto.String(value)}
+			if _, _, err := ProfileVersion(msg); !errors.Is(err, ErrUnknownProfile) {
+				t.Errorf("Expected ErrUnknownProfile for %q, got %v", value, err)
+			}
+		}
+	})
+
+	t.Run("Invalid Input", func(t *testing.T) {
+		testCases := map[string]*ernv432.NewReleaseMessage{
+			"Missing":     {},
+			"Bad Version": {ReleaseProfileVersionId: proto.String("CommonReleaseTypes/x")},
+		}
+		for name, msg := range testCases {
+			if _, _, err := ProfileVersion(msg); err == nil || errors.Is(err, ErrUnknownProfile) {
+				t.Errorf("%s: expected a parse error, got %v", name, err)
+			}
+		}
+
+		if _, _, err := ProfileVersion(&meadv11.MeadMessage{}); err == nil {
+			t.Error("Expected error for message without ReleaseProfileVersionId")
+		}
+	})
+}
+
+// TestValidateProfile validates the simple single release profiles against the DDEX samples
+func TestValidateProfile(t *testing.T) {
+	samplesDir := filepath.Join("testdata", "ernv432", "Samples43")
+	audioPath := filepath.Join(samplesDir, "4 SimpleAudioSingle.xml")
+	videoPath := filepath.Join(samplesDir, "5 SimpleVideoSingle.xml")
+
+	t.Run("Conforming Samples", func(t *testing.T) {
+		testCases := map[string]string{
+			"SimpleAudioSingle": audioPath,
+			"SimpleVideoSingle": videoPath,
+		}
+		for profile, path := range testCases {
+			msg := loadNewReleaseMessage(t, path)
+			if err := ValidateProfile(msg, profile); err != nil {
+				t.Errorf("%s: expected no violations, got %v", profile, err)
+			}
+		}
+	})
+
+	t.Run("Wrong Profile", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, audioPath)
+
+		err := ValidateProfile(msg, "SimpleVideoSingle")
+		if err == nil {
+			t.Fatal("Expected violations validating an audio single as a video single")
+		}
+		for _, want := range []string{"ReleaseProfileVersionId", "ResourceList.Video", "ResourceList.SoundRecording"} {
+			if !strings.Contains(err.Error(), want+":") {
+				t.Errorf("Expected a violation at %s, got %v", want, err)
+			}
+		}
+	})
+
+	t.Run("Missing Required Elements", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, audioPath)
+		msg.ResourceList.SoundRecording[0].SoundRecordingEdition = nil
+		msg.ResourceList.Image = nil
+
+		err := ValidateProfile(msg, "SimpleAudioSingle")
+		want := []string{
+			"ResourceList.SoundRecording[0]: profile requires ISRC",
+			"ResourceList.Image: profile requires a FrontCoverImage",
+		}
+		for _, w := range want {
+			if err == nil || !strings.Contains(err.Error(), w) {
+				t.Errorf("Expected %q, got %v", w, err)
+			}
+		}
+
+		var verr *ValidationError
+		if !errors.As(err, &verr) {
+			t.Errorf("Expected *ValidationError violations, got %T", err)
+		}
+	})
+
+	t.Run("Unsupported Profile", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, audioPath)
+		if err := ValidateProfile(msg, "Audio"); !errors.Is(err, ErrUnknownProfile) {
+			t.Errorf("Expected ErrUnknownProfile, got %v", err)
+		}
+		if err := ValidateProfile(&meadv11.MeadMessage{}, "SimpleAudioSingle"); err == nil {
+			t.Error("Expected error for a non-ERN message")
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package ddex
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// anchorKinds maps the elements declaring a message-local anchor (e.g. the
+// ResourceReference of a SoundRecording) to the kind of thing they name
+var anchorKinds = map[string]string{
+	"PartyReference":                    "Party",
+	"BrandReference":                    "Party",
+	"ResourceReference":                 "Resource",
+	"ReleaseReference":                  "Release",
+	"ChapterReference":                  "Chapter",
+	"CueSheetReference":                 "CueSheet",
+	"TechnicalResourceDetailsReference": "TechnicalResourceDetails",
+	"VisibilityReference":               "Visibility",
+	"CollectionReference":               "Collection",  // ERN 3
+	"MusicalWorkReference":              "MusicalWork", // ERN 3
+}
+
+// referenceKinds maps the elements pointing at an anchor to the kind of
+// anchor they must resolve to, as documented in the ERN schemas
+var referenceKinds = map[string]string{
+	"ArtistPartyReference":           "Party",
+	"CharacterPartyReference":        "Party",
+	"ContributorPartyReference":      "Party",
+	"PartyAffiliateReference":        "Party",
+	"PartyRelatedPartyReference":     "Party",
+	"RecordCompanyPartyReference":    "Party",
+	"ReleaseLabelReference":          "Party",
+	"RightsControllerPartyReference": "Party",
+
+	"DealResourceReference":              "Resource",
+	"LinkedReleaseResourceReference":     "Resource",
+	"ReleaseResourceReference":           "Resource",
+	"RepresentativeImageReference":       "Resource",
+	"ResourceContainedResourceReference": "Resource",
+	"ResourceRelatedResourceReference":   "Resource",
+	"CollectionResourceReference":        "Resource", // ERN 3
+	"CueResourceReference":               "Resource", // ERN 3
+	"ResourceGroupResourceReference":     "Resource", // ERN 3
+	"RightShareResourceReference":        "Resource", // ERN 3
+
+	"DealReleaseReference":                     "Release",
+	"ResourceGroupReleaseReference":            "Release",
+	"ResourceReleaseReference":                 "Release",
+	"ResourceGroupContentItemReleaseReference": "Release", // ERN 3
+	"RightShareReleaseReference":               "Release", // ERN 3
+
+	"AudioChapterReference":                 "Chapter",
+	"VideoChapterReference":                 "Chapter",
+	"VideoCueSheetReference":                "CueSheet",
+	"DealTechnicalResourceDetailsReference": "TechnicalResourceDetails",
+	"ReleaseVisibilityReference":            "Visibility",
+
+	"CollectionCollectionReference":     "Collection",  // ERN 3
+	"ReleaseCollectionReference":        "Collection",  // ERN 3
+	"SoundRecordingCollectionReference": "Collection",  // ERN 3
+	"CollectionWorkReference":           "MusicalWork", // ERN 3
+	"CueWorkReference":                  "MusicalWork", // ERN 3
+	"ResourceMusicalWorkReference":      "MusicalWork", // ERN 3
+	"RightShareWorkReference":           "MusicalWork", // ERN 3
+}
+
+// DanglingReference is a reference to an anchor the message does not declare
+type DanglingReference struct {
+	Path  string // e.g. DealList.ReleaseDeal[0].DealReleaseReference[0]
+	Kind  string // kind of anchor expected, e.g. Release
+	Value string
+}
+
+// ReferenceError lists the references CheckReferences found no anchor for,
+// in document order
+type ReferenceError struct {
+	Dangling []DanglingReference
+}
+
+func (e *ReferenceError) Error() string {
+	refs := make([]string, len(e.Dangling))
+	for i, ref := range e.Dangling {
+		refs[i] = fmt.Sprintf("%s=%s (no %s)", ref.Path, ref.Value, ref.Kind)
+	}
+	return fmt.Sprintf("%d dangling references: %s", len(e.Dangling), strings.Join(refs, ", "))
+}
+
+// CheckReferences verifies that every reference in msg (DealReleaseReference,
+// ArtistPartyReference, ReleaseResourceReference, ...) names an anchor of the
+// right kind declared within msg, returning a *ReferenceError listing those
+// that don't
+func CheckReferences(msg proto.Message) error {
+	anchors := make(map[string]map[string]bool) // kind to declared anchors
+	var refs []DanglingReference
+
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() != protoreflect.StringKind {
+			return nil
+		}
+		field := xmlFields(parent)[fd.Number()]
+		if field.Attr {
+			return nil
+		}
+
+		// Chardata shares the path of its element
+		name := path[strings.LastIndex(path, ".")+1:]
+		if i := strings.IndexByte(name, '['); i >= 0 {
+			name = name[:i]
+		}
+		value := strings.TrimSpace(v.String())
+
+		if kind, ok := anchorKinds[name]; ok {
+			if anchors[kind] == nil {
+				anchors[kind] = make(map[string]bool)
+			}
+			anchors[kind][value] = true
+		}
+		if kind, ok := referenceKinds[name]; ok {
+			refs = append(refs, DanglingReference{Path: path, Kind: kind, Value: value})
+		}
+		return nil
+	})
+
+	var dangling []DanglingReference
+	for _, ref := range refs {
+		if !anchors[ref.Kind][ref.Value] {
+			dangling = append(dangling, ref)
+		}
+	}
+	if len(dangling) > 0 {
+		return &ReferenceError{Dangling: dangling}
+	}
+	return nil
+}
+
+// ParseERNValidated parses like ParseERN, then checks that every reference
+// resolves within the message. On a *ReferenceError the parsed message and
+// version are still returned.
+func ParseERNValidated(xmlData []byte) (ERNMessage, ERNVersion, error) {
+	msg, version, err := ParseERN(xmlData)
+	if err != nil {
+		return msg, version, err
+	}
+	return msg, version, CheckReferences(msg)
+}
@@ -0,0 +1,97 @@
+package ddex
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+)
+
+// TestCheckReferences checks the sample files and messages with references
+// to anchors they don't declare
+func TestCheckReferences(t *testing.T) {
+	t.Run("Samples", func(t *testing.T) {
+		for testName, filename := range ernTestFiles {
+			t.Run(testName, func(t *testing.T) {
+				xmlPath := filepath.Join("testdata", "ernv432", "Samples43", filename)
+				xmlData, err := os.ReadFile(xmlPath)
+				if err != nil {
+					t.Skipf("Sample file not found: %s", xmlPath)
+				}
+
+				if _, _, err := ParseERNValidated(xmlData); err != nil {
+					t.Errorf("Expected all references to resolve, got %v", err)
+				}
+			})
+		}
+	})
+
+	t.Run("Dangling References", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		xmlData = bytes.Replace(xmlData, []byte("<DealReleaseReference>R0<"), []byte("<DealReleaseReference>R9<"), 1)
+
+		msg, version, err := ParseERNValidated(xmlData)
+		var refErr *ReferenceError
+		if !errors.As(err, &refErr) {
+			t.Fatalf("Expected *ReferenceError, got %v", err)
+		}
+		if msg == nil || version != ERNv43 {
+			t.Errorf("Expected the parsed message alongside the error, got %T %s", msg, version)
+		}
+
+		want := DanglingReference{Path: "DealList.ReleaseDeal[0].DealReleaseReference[0]", Kind: "Release", Value: "R9"}
+		if len(refErr.Dangling) != 1 || refErr.Dangling[0] != want {
+			t.Errorf("Expected %+v, got %+v", want, refErr.Dangling)
+		}
+	})
+
+	t.Run("Wrong Kind", func(t *testing.T) {
+		// A release reference can't resolve to a resource of the same name
+		msg := &ernv43.NewReleaseMessage{
+			ResourceList: &ernv43.ResourceList{
+				SoundRecording: []*ernv43.SoundRecording{{ResourceReference: "A1"}},
+			},
+			DealList: &ernv43.DealList{
+				ReleaseDeal: []*ernv43.ReleaseDeal{{DealReleaseReference: []string{"A1"}}},
+			},
+		}
+
+		var refErr *ReferenceError
+		if err := CheckReferences(msg); !errors.As(err, &refErr) || len(refErr.Dangling) != 1 {
+			t.Fatalf("Expected 1 dangling reference, got %v", err)
+		}
+		if got := refErr.Error(); got != "1 dangling references: DealList.ReleaseDeal[0].DealReleaseReference[0]=A1 (no Release)" {
+			t.Errorf("Unexpected message %q", got)
+		}
+	})
+
+	t.Run("Chardata Reference", func(t *testing.T) {
+		msg := &ernv43.NewReleaseMessage{
+			ResourceList: &ernv43.ResourceList{
+				SoundRecording: []*ernv43.SoundRecording{{ResourceReference: "A1"}},
+			},
+			ReleaseList: &ernv43.ReleaseList{
+				TrackRelease: []*ernv43.TrackRelease{{
+					ReleaseReference:               "R1",
+					ReleaseResourceReference:       "A1",
+					LinkedReleaseResourceReference: []*ernv43.LinkedReleaseResourceReference{{Value: "A2"}},
+				}},
+			},
+		}
+
+		var refErr *ReferenceError
+		if err := CheckReferences(msg); !errors.As(err, &refErr) || len(refErr.Dangling) != 1 {
+			t.Fatalf("Expected 1 dangling reference, got %v", err)
+		}
+		if ref := refErr.Dangling[0]; ref.Path != "ReleaseList.TrackRelease[0].LinkedReleaseResourceReference[0]" || ref.Value != "A2" {
+			t.Errorf("Unexpected dangling reference %+v", ref)
+		}
+	})
+}
@@ -0,0 +1,169 @@
+package ddex
+
+import (
+	"cmp"
+	"encoding/xml"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MessageFactory returns a new root message ready to be unmarshaled into
+type MessageFactory func() proto.Message
+
+// Registry maps a DDEX namespace and root element name to a message factory
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[xml.Name]MessageFactory
+}
+
+// NewRegistry returns an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[xml.Name]MessageFactory)}
+}
+
+// DefaultRegistry holds every generated root message (see registry_gen.go) and
+// is consulted by ParseDDEX and the ERN version detection. Register additional
+// versions here to make them parseable.
+var DefaultRegistry = NewRegistry()
+
+// Register adds or replaces the factory for a root element in a namespace
+func (r *Registry) Register(namespace, root string, factory MessageFactory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[xml.Name{Space: namespace, Local: root}] = factory
+}
+
+// Lookup returns the factory registered for a root element in a namespace
+func (r *Registry) Lookup(namespace, root string) (MessageFactory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok := r.factories[xml.Name{Space: namespace, Local: root}]
+	return factory, ok
+}
+
+// HasNamespace reports whether any root element is registered for namespace
+func (r *Registry) HasNamespace(namespace string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for name := range r.factories {
+		if name.Space == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// Namespaces returns the sorted set of registered namespaces
+func (r *Registry) Namespaces() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	var namespaces []string
+	for name := range r.factories {
+		if !seen[name.Space] {
+			seen[name.Space] = true
+			namespaces = append(namespaces, name.Space)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// Version describes one registered version of a DDEX standard
+type Version struct {
+	Family    string   // ern, mead or pie
+	Version   string   // e.g. 432, as in ERNVersion
+	Namespace string   // e.g. http://ddex.net/xml/ern/432
+	Messages  []string // sorted root element names, e.g. NewReleaseMessage
+}
+
+// Versions returns every registered version sorted by family and version,
+// reading both from the last two path segments of the namespace, as in
+// http://ddex.net/xml/<family>/<version>
+func (r *Registry) Versions() []Version {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	byNamespace := make(map[string]*Version)
+	for name := range r.factories {
+		v, ok := byNamespace[name.Space]
+		if !ok {
+			v = &Version{Namespace: name.Space}
+			segments := strings.Split(strings.TrimRight(name.Space, "/"), "/")
+			if n := len(segments); n >= 2 {
+				v.Family, v.Version = segments[n-2], segments[n-1]
+			}
+			byNamespace[name.Space] = v
+		}
+		v.Messages = append(v.Messages, name.Local)
+	}
+
+	versions := make([]Version, 0, len(byNamespace))
+	for _, v := range byNamespace {
+		sort.Strings(v.Messages)
+		versions = append(versions, *v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		if versions[i].Family != versions[j].Family {
+			return versions[i].Family < versions[j].Family
+		}
+		return versions[i].Version < versions[j].Version
+	})
+	return versions
+}
+
+// Versions returns the versions compiled into DefaultRegistry
+func Versions() []Version {
+	return DefaultRegistry.Versions()
+}
+
+// SupportedMessage describes a root message compiled into the library
+type SupportedMessage struct {
+	Family    string `json:"family"`    // ern, mead or pie
+	Version   string `json:"version"`   // e.g. 432, as in ERNVersion
+	Namespace string `json:"namespace"` // e.g. http://ddex.net/xml/ern/432
+	Root      string `json:"root"`      // root element name, e.g. NewReleaseMessage
+	GoType    string `json:"goType"`    // e.g. github.com/alecsavvy/ddex-go/gen/ddex/ern/v432.NewReleaseMessage
+
+	factory MessageFactory
+}
+
+// Supported returns every root message generated from the gen/ packages (see
+// registry_gen.go), sorted by family, version and root element, so tools can
+// discover what the library handles. DefaultRegistry is populated from the
+// same list; messages registered later show up in Versions but not here.
+func Supported() []SupportedMessage {
+	supported := slices.Clone(supportedMessages)
+	for i := range supported {
+		supported[i].factory = nil
+	}
+	slices.SortFunc(supported, func(a, b SupportedMessage) int {
+		return cmp.Or(cmp.Compare(a.Family, b.Family), cmp.Compare(a.Version, b.Version), cmp.Compare(a.Root, b.Root))
+	})
+	return supported
+}
+
+// resolve finds the factory for a document's root element. Documents marshaled
+// by this library declare the namespace without prefixing the root element,
+// so an unqualified root is matched against its xmlns:* declarations.
+func (r *Registry) resolve(start xml.StartElement) (xml.Name, MessageFactory, bool) {
+	if start.Name.Space != "" {
+		factory, ok := r.Lookup(start.Name.Space, start.Name.Local)
+		return start.Name, factory, ok
+	}
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "xmlns" {
+			continue
+		}
+		if factory, ok := r.Lookup(attr.Value, start.Name.Local); ok {
+			return xml.Name{Space: attr.Value, Local: start.Name.Local}, factory, true
+		}
+	}
+	return start.Name, nil, false
+}
@@ -0,0 +1,228 @@
+package ddex
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	piev10 "github.com/alecsavvy/ddex-go/gen/ddex/pie/v10"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// TestRegistry validates generated registrations and user-registered versions
+func TestRegistry(t *testing.T) {
+	t.Run("Generated", func(t *testing.T) {
+		factory, ok := DefaultRegistry.Lookup(ernv432.Namespace, "NewReleaseMessage")
+		if !ok {
+			t.Fatal("ERN v4.3.2 NewReleaseMessage is not registered")
+		}
+		msg, ok := factory().(*ernv432.NewReleaseMessage)
+		if !ok {
+			t.Fatalf("Factory returned %T", factory())
+		}
+		if msg.XmlnsErn != ernv432.Namespace {
+			t.Errorf("Factory should return a message with namespaces populated")
+		}
+
+		if !DefaultRegistry.HasNamespace(piev10.Namespace) {
+			t.Error("PIE namespace is not registered")
+		}
+	})
+
+	t.Run("Custom Version", func(t *testing.T) {
+		const namespace = "http://ddex.net/xml/ern/99"
+		registry := DefaultRegistry
+		registry.Register(namespace, "NewReleaseMessage", func() proto.Message { return &ernv432.NewReleaseMessage{} })
+		defer func() {
+			registry.mu.Lock()
+			defer registry.mu.Unlock()
+			for name := range registry.factories {
+				if name.Space == namespace {
+					delete(registry.factories, name)
+				}
+			}
+		}()
+
+		xmlData := []byte(`<ern:NewReleaseMessage xmlns:ern="` + namespace + `"><MessageHeader><MessageId>1</MessageId></MessageHeader></ern:NewReleaseMessage>`)
+
+		version, err := DetectERNVersion(xmlData)
+		if err != nil || version != "99" {
+			t.Fatalf("DetectERNVersion = %q, %v, want 99", version, err)
+		}
+
+		msg, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("ParseDDEX failed: %v", err)
+		}
+		if got := msg.(*ernv432.NewReleaseMessage).GetMessageHeader().GetMessageId(); got != "1" {
+			t.Errorf("MessageId = %q, want 1", got)
+		}
+	})
+
+	t.Run("Unregistered", func(t *testing.T) {
+		if _, err := ParseDDEX([]byte(`<x:Catalog xmlns:x="http://example.com/catalog"/>`)); err == nil {
+			t.Error("Expected error for unregistered namespace")
+		}
+	})
+}
+
+// TestVersions validates the listing of compiled-in versions and that each
+// ERN root message can be used through ERNMessage
+func TestVersions(t *testing.T) {
+	t.Run("Compiled In", func(t *testing.T) {
+		var got []string
+		for _, v := range Versions() {
+			got = append(got, v.Family+"/"+v.Version)
+		}
+		want := []string{"ern/383", "ern/43", "ern/432", "mead/11", "pie/10"}
+		if !slices.Equal(got, want) {
+			t.Errorf("Versions = %v, want %v", got, want)
+		}
+
+		for _, v := range Versions() {
+			if v.Namespace != ernv432.Namespace {
+				continue
+			}
+			if !slices.Equal(v.Messages, []string{"NewReleaseMessage", "PurgeReleaseMessage"}) {
+				t.Errorf("ERN 432 messages = %v", v.Messages)
+			}
+		}
+	})
+
+	t.Run("ERN Messages", func(t *testing.T) {
+		for _, v := range Versions() {
+			if v.Family != "ern" {
+				continue
+			}
+			for _, root := range v.Messages {
+				factory, _ := DefaultRegistry.Lookup(v.Namespace, root)
+				msg, ok := factory().(ERNMessage)
+				if !ok {
+					t.Errorf("%s %s does not implement ERNMessage", v.Version, root)
+					continue
+				}
+				if msg.GetXmlnsErn() != v.Namespace {
+					t.Errorf("%s %s: XmlnsErn = %q, want %q", v.Version, root, msg.GetXmlnsErn(), v.Namespace)
+				}
+			}
+		}
+	})
+}
+
+// TestSupported validates the manifest of compiled-in root messages against
+// DefaultRegistry and the Go types its factories return
+func TestSupported(t *testing.T) {
+	supported := Supported()
+
+	t.Run("Matches Registry", func(t *testing.T) {
+		var messages int
+		for _, v := range Versions() {
+			messages += len(v.Messages)
+		}
+		if len(supported) != messages {
+			t.Errorf("Supported lists %d messages, DefaultRegistry %d", len(supported), messages)
+		}
+
+		for _, m := range supported {
+			factory, ok := DefaultRegistry.Lookup(m.Namespace, m.Root)
+			if !ok {
+				t.Errorf("%s %s is not registered", m.Namespace, m.Root)
+				continue
+			}
+			typ := reflect.TypeOf(factory()).Elem()
+			if got := typ.PkgPath() + "." + typ.Name(); got != m.GoType {
+				t.Errorf("%s %s: GoType = %s, factory returns %s", m.Namespace, m.Root, m.GoType, got)
+			}
+			if want := "http://ddex.net/xml/" + m.Family + "/" + m.Version; m.Namespace != want {
+				t.Errorf("%s %s: Namespace = %s, want %s", m.Family, m.Version, m.Namespace, want)
+			}
+		}
+	})
+
+	t.Run("Sorted", func(t *testing.T) {
+		want := SupportedMessage{
+			Family:    "ern",
+			Version:   "383",
+			Namespace: "http://ddex.net/xml/ern/383",
+			Root:      "CatalogListMessage",
+			GoType:    "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383.CatalogListMessage",
+		}
+		if len(supported) == 0 || !reflect.DeepEqual(supported[0], want) {
+			t.Errorf("First message = %+v, want %+v", supported[0], want)
+		}
+		if last := supported[len(supported)-1]; last.Family != "pie" || last.Root != "PieRequestMessage" {
+			t.Errorf("Last message = %+v", last)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(supported[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `{"family":"ern","version":"383","namespace":"http://ddex.net/xml/ern/383","root":"CatalogListMessage","goType":"github.com/alecsavvy/ddex-go/gen/ddex/ern/v383.CatalogListMessage"}`
+		if string(data) != want {
+			t.Errorf("JSON = %s, want %s", data, want)
+		}
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		supported[0].Root = "Changed"
+		if Supported()[0].Root == "Changed" {
+			t.Error("Supported returned shared state")
+		}
+	})
+}
+
+// TestRootMessagesGenerated validates that every root message, found by the
+// xmlns:xsi attribute xsd2proto gives each top-level element, has generated
+// XML methods and a registration, whatever its name
+func TestRootMessagesGenerated(t *testing.T) {
+	registered := make(map[protoreflect.FullName]bool)
+	for _, v := range Versions() {
+		for _, root := range v.Messages {
+			factory, _ := DefaultRegistry.Lookup(v.Namespace, root)
+			registered[factory().ProtoReflect().Descriptor().FullName()] = true
+		}
+	}
+
+	var roots []protoreflect.FullName
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(string(fd.Package()), "ddex.") {
+			return true
+		}
+		for i := 0; i < fd.Messages().Len(); i++ {
+			if md := fd.Messages().Get(i); md.Fields().ByName("xmlns_xsi") != nil {
+				roots = append(roots, md.FullName())
+			}
+		}
+		return true
+	})
+	if !slices.Contains(roots, "ddex.mead.v11.Feed") {
+		t.Errorf("Expected non-Message roots such as MEAD Feed, got %v", roots)
+	}
+
+	for _, name := range roots {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		msg := mt.New().Interface()
+		if _, ok := msg.(xml.Marshaler); !ok {
+			t.Errorf("%s has no generated MarshalXML", name)
+		}
+		if _, ok := msg.(xml.Unmarshaler); !ok {
+			t.Errorf("%s has no generated UnmarshalXML", name)
+		}
+		if !registered[name] {
+			t.Errorf("%s is not registered with DefaultRegistry", name)
+		}
+	}
+}
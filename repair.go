@@ -0,0 +1,251 @@
+package ddex
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/beevik/etree"
+)
+
+// xsiNamespace is the XML Schema instance namespace of xsi:schemaLocation
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// Fix describes one change Repair made to a document
+type Fix struct {
+	Path    string // e.g. MessageHeader.MessageCreatedDateTime, empty for the root element
+	Message string
+}
+
+func (f Fix) String() string {
+	if f.Path == "" {
+		return f.Message
+	}
+	return fmt.Sprintf("%s: %s", f.Path, f.Message)
+}
+
+// Repair fixes common non-conformance in almost-valid DDEX documents so they
+// can be ingested, returning the repaired XML and every change made. The
+// input is read leniently and these fixes are applied:
+//
+//   - a missing or misspelt namespace declaration for the root element is
+//     added or corrected, inferred from xsi:schemaLocation or an ERN 3
+//     MessageSchemaVersionId, and a missing xmlns:xsi is declared
+//   - dates and date-times are rewritten to the ISO 8601 form the schema
+//     expects, e.g. 2024/01/31 becomes 2024-01-31 and "2024-01-31 10:00"
+//     becomes 2024-01-31T10:00:00
+//   - elements the schema marks optional that are empty are dropped
+//
+// Documents needing no fixes are returned unchanged. Repair fails if the
+// message type can't be determined or the repaired document still doesn't
+// parse.
+func Repair(xmlData []byte) ([]byte, []Fix, error) {
+	doc := etree.NewDocument()
+	doc.ReadSettings.Permissive = true
+	if err := doc.ReadFromBytes(xmlData); err != nil {
+		return nil, nil, fmt.Errorf("failed to read XML: %w", err)
+	}
+	root := doc.Root()
+	if root == nil {
+		return nil, nil, fmt.Errorf("could not find root element")
+	}
+
+	namespace, fixes, err := repairNamespaces(root)
+	if err != nil {
+		return nil, fixes, err
+	}
+
+	for _, schema := range Schemas() {
+		if schema.Namespace != namespace {
+			continue
+		}
+		if typ, ok := schema.Type(root.Tag); ok {
+			r := repairer{schema: schema}
+			r.element(root, typ, "")
+			fixes = append(fixes, r.fixes...)
+		}
+	}
+
+	if len(fixes) == 0 {
+		return xmlData, nil, nil
+	}
+
+	out, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, fixes, err
+	}
+	if _, err := ParseDDEX(out); err != nil {
+		return nil, fixes, fmt.Errorf("repaired document does not parse: %w", err)
+	}
+	return out, fixes, nil
+}
+
+// repairNamespaces makes the root element declare a registered namespace and
+// the xsi prefix it uses, returning the namespace
+func repairNamespaces(root *etree.Element) (string, []Fix, error) {
+	var fixes []Fix
+
+	namespace := root.NamespaceURI()
+	if namespace != "" && !DefaultRegistry.HasNamespace(namespace) {
+		if normalized := normalizeDDEXNamespace(namespace); DefaultRegistry.HasNamespace(normalized) {
+			for i, attr := range root.Attr {
+				if attr.Value == namespace && (attr.Space == "xmlns" && attr.Key == root.Space || attr.Space == "" && attr.Key == "xmlns" && root.Space == "") {
+					root.Attr[i].Value = normalized
+				}
+			}
+			fixes = append(fixes, Fix{Message: fmt.Sprintf("corrected namespace %s to %s", namespace, normalized)})
+			namespace = normalized
+		}
+	}
+
+	if namespace == "" {
+		namespace = inferNamespace(root)
+		if namespace == "" {
+			return "", nil, fmt.Errorf("cannot determine the namespace of %s", root.Tag)
+		}
+		if root.Space == "" {
+			root.Space = namespacePrefix(namespace)
+		}
+		root.CreateAttr("xmlns:"+root.Space, namespace)
+		fixes = append(fixes, Fix{Message: fmt.Sprintf("declared missing namespace xmlns:%s=%q", root.Space, namespace)})
+	}
+
+	if _, ok := DefaultRegistry.Lookup(namespace, root.Tag); !ok {
+		return "", fixes, fmt.Errorf("unsupported DDEX message: %s (namespace %q)", root.Tag, namespace)
+	}
+
+	usesXSI := false
+	for _, attr := range root.Attr {
+		usesXSI = usesXSI || attr.Space == "xsi"
+	}
+	if usesXSI && root.SelectAttr("xmlns:xsi") == nil {
+		root.CreateAttr("xmlns:xsi", xsiNamespace)
+		fixes = append(fixes, Fix{Message: fmt.Sprintf("declared missing namespace xmlns:xsi=%q", xsiNamespace)})
+	}
+
+	return namespace, fixes, nil
+}
+
+// inferNamespace guesses the namespace of an undeclared root element from
+// the first namespace in its xsi:schemaLocation, or from the
+// MessageSchemaVersionId ERN 3 messages carry (e.g. ern/383)
+func inferNamespace(root *etree.Element) string {
+	var candidates []string
+	if attr := root.SelectAttr("xsi:schemaLocation"); attr != nil {
+		if fields := strings.Fields(attr.Value); len(fields) > 0 {
+			candidates = append(candidates, fields[0])
+		}
+	}
+	if attr := root.SelectAttr("MessageSchemaVersionId"); attr != nil {
+		candidates = append(candidates, "http://ddex.net/xml/"+strings.Trim(attr.Value, "/ "))
+	}
+
+	for _, candidate := range candidates {
+		namespace := normalizeDDEXNamespace(candidate)
+		if _, ok := DefaultRegistry.Lookup(namespace, root.Tag); ok {
+			return namespace
+		}
+	}
+	return ""
+}
+
+// namespacePrefix returns the conventional prefix of a DDEX namespace, e.g.
+// ern for http://ddex.net/xml/ern/43
+func namespacePrefix(namespace string) string {
+	parts := strings.Split(strings.TrimPrefix(namespace, "http://ddex.net/xml/"), "/")
+	if parts[0] == "" || strings.Contains(parts[0], ":") {
+		return "ns"
+	}
+	return parts[0]
+}
+
+// repairer applies the schema-driven fixes to an element tree
+type repairer struct {
+	schema *Schema
+	fixes  []Fix
+}
+
+// element repairs the children of e, an element of type typ at path
+func (r *repairer) element(e *etree.Element, typ *SchemaType, path string) {
+	seen := make(map[string]int)
+	for _, child := range e.ChildElements() {
+		field, ok := typ.Field(child.Tag)
+		if !ok || field.Kind != "element" {
+			continue // extension content or an unknown element, left as is
+		}
+
+		childPath := joinPath(path, child.Tag)
+		if field.Repeated() {
+			childPath = fmt.Sprintf("%s[%d]", childPath, seen[child.Tag])
+		}
+		seen[child.Tag]++
+
+		textType := field.Type
+		if childType, ok := r.schema.Type(localTypeName(field.Type)); ok && !strings.HasPrefix(field.Type, "xs:") {
+			r.element(child, childType, childPath)
+			textType = ""
+			for _, f := range childType.Fields {
+				if f.Kind == "text" {
+					textType = f.Type
+				}
+			}
+		}
+		if textType != "" && len(child.ChildElements()) == 0 {
+			r.date(child, textType, childPath)
+		}
+
+		if field.MinOccurs == 0 && len(child.Attr) == 0 && len(child.ChildElements()) == 0 && strings.TrimSpace(child.Text()) == "" {
+			e.RemoveChild(child)
+			r.fixes = append(r.fixes, Fix{Path: childPath, Message: "dropped empty optional element"})
+		}
+	}
+}
+
+// datePattern matches the date and date-time spellings Repair understands:
+// year, month and day separated by -, / or . or not at all, optionally
+// followed by a time after a T or a space and a zone offset
+var datePattern = regexp.MustCompile(`^(\d{4})[-/.]?(\d{2})[-/.]?(\d{2})(?:[T ](\d{2}):(\d{2})(?::(\d{2}(?:\.\d+)?))?\s*(Z|[+-]\d{2}:?\d{2})?)?$`)
+
+// date rewrites the text of e to the form of an xs:date, ddex:IsoDate or
+// xs:dateTime, leaving text it can't read or that is already valid alone
+func (r *repairer) date(e *etree.Element, typ string, path string) {
+	name := localTypeName(typ)
+	isDateTime := typ == "xs:dateTime"
+	if typ != "xs:date" && !isDateTime && !strings.HasSuffix(name, "IsoDate") {
+		return
+	}
+
+	text := strings.TrimSpace(e.Text())
+	m := datePattern.FindStringSubmatch(text)
+	if m == nil || m[2] < "01" || m[2] > "12" || m[3] < "01" || m[3] > "31" {
+		return
+	}
+
+	fixed := m[1] + "-" + m[2] + "-" + m[3]
+	if isDateTime {
+		hour, minute, second := m[4], m[5], m[6]
+		if hour == "" {
+			hour, minute = "00", "00"
+		}
+		if second == "" {
+			second = "00"
+		}
+		fixed += "T" + hour + ":" + minute + ":" + second
+		if zone := m[7]; len(zone) == 5 {
+			fixed += zone[:3] + ":" + zone[3:]
+		} else {
+			fixed += zone
+		}
+	}
+
+	if fixed != text {
+		e.SetText(fixed)
+		r.fixes = append(r.fixes, Fix{Path: path, Message: fmt.Sprintf("normalized date %q to %q", text, fixed)})
+	}
+}
+
+// localTypeName strips the namespace prefix from an XSD type, e.g.
+// ern:EventDate to EventDate
+func localTypeName(typ string) string {
+	return typ[strings.IndexByte(typ, ':')+1:]
+}
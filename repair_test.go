@@ -0,0 +1,148 @@
+package ddex
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	"github.com/beevik/etree"
+)
+
+// TestRepair validates the fixes Repair applies to almost-valid documents
+func TestRepair(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	original, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	// repair mangles the sample, repairs it and checks the expected fix was made
+	repair := func(t *testing.T, old, new string, want Fix) *ernv43.NewReleaseMessage {
+		t.Helper()
+		if !bytes.Contains(original, []byte(old)) {
+			t.Fatalf("Sample does not contain %q", old)
+		}
+		xmlData := bytes.Replace(original, []byte(old), []byte(new), 1)
+
+		repaired, fixes, err := Repair(xmlData)
+		if err != nil {
+			t.Fatalf("Repair failed: %v", err)
+		}
+		if len(fixes) != 1 || fixes[0] != want {
+			t.Fatalf("Expected [%v], got %v", want, fixes)
+		}
+
+		msg, err := ParseDDEX(repaired)
+		if err != nil {
+			t.Fatalf("Failed to parse repaired XML: %v", err)
+		}
+		release, ok := msg.(*ernv43.NewReleaseMessage)
+		if !ok {
+			t.Fatalf("Expected *ernv43.NewReleaseMessage, got %T", msg)
+		}
+		return release
+	}
+
+	t.Run("Conformant Sample", func(t *testing.T) {
+		repaired, fixes, err := Repair(original)
+		if err != nil {
+			t.Fatalf("Repair failed: %v", err)
+		}
+		if len(fixes) != 0 || !bytes.Equal(repaired, original) {
+			t.Errorf("Expected the sample unchanged, got fixes %v", fixes)
+		}
+	})
+
+	t.Run("Missing Namespace", func(t *testing.T) {
+		repair(t, `xmlns:ern="http://ddex.net/xml/ern/43"`, ``,
+			Fix{Message: `declared missing namespace xmlns:ern="http://ddex.net/xml/ern/43"`})
+	})
+
+	t.Run("Misspelt Namespace", func(t *testing.T) {
+		repair(t, `xmlns:ern="http://ddex.net/xml/ern/43"`, `xmlns:ern="https://www.ddex.net/xml/ern/43/"`,
+			Fix{Message: "corrected namespace https://www.ddex.net/xml/ern/43/ to http://ddex.net/xml/ern/43"})
+	})
+
+	t.Run("Missing XSI Namespace", func(t *testing.T) {
+		repair(t, `xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`, ``,
+			Fix{Message: `declared missing namespace xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"`})
+	})
+
+	t.Run("Date Time", func(t *testing.T) {
+		msg := repair(t, `2017-04-25T15:00:29.947Z`, `2017-04-25 15:00:29.947Z`,
+			Fix{Path: "MessageHeader.MessageCreatedDateTime", Message: `normalized date "2017-04-25 15:00:29.947Z" to "2017-04-25T15:00:29.947Z"`})
+		if got := msg.GetMessageHeader().GetMessageCreatedDateTime(); got != "2017-04-25T15:00:29.947Z" {
+			t.Errorf("MessageCreatedDateTime = %s", got)
+		}
+	})
+
+	t.Run("Date", func(t *testing.T) {
+		xmlData := bytes.Replace(original, []byte(`<StartDate>2017-04-25</StartDate>`), []byte(`<StartDate>2017/04/25</StartDate>`), 1)
+		repaired, fixes, err := Repair(xmlData)
+		if err != nil {
+			t.Fatalf("Repair failed: %v", err)
+		}
+		want := Fix{Path: "DealList.ReleaseDeal[0].Deal[0].DealTerms.ValidityPeriod[0].StartDate", Message: `normalized date "2017/04/25" to "2017-04-25"`}
+		if len(fixes) != 1 || fixes[0] != want {
+			t.Fatalf("Expected [%v], got %v", want, fixes)
+		}
+		if bytes.Contains(repaired, []byte("2017/04/25")) {
+			t.Error("Expected the date to be rewritten")
+		}
+	})
+
+	t.Run("Empty Optional Element", func(t *testing.T) {
+		msg := repair(t, `<MessageThreadId>W83814161</MessageThreadId>`, `<MessageThreadId> </MessageThreadId>`,
+			Fix{Path: "MessageHeader.MessageThreadId", Message: "dropped empty optional element"})
+		if msg.GetMessageHeader().MessageThreadId != nil {
+			t.Error("Expected MessageThreadId to be unset")
+		}
+	})
+
+	t.Run("Empty Required Element Kept", func(t *testing.T) {
+		xmlData := bytes.Replace(original, []byte(`<MessageId>W83814161</MessageId>`), []byte(`<MessageId></MessageId>`), 1)
+		if _, fixes, err := Repair(xmlData); err != nil || len(fixes) != 0 {
+			t.Errorf("Expected no fixes, got %v, %v", fixes, err)
+		}
+	})
+
+	t.Run("Date Formats", func(t *testing.T) {
+		tests := []struct {
+			typ, text, want string
+		}{
+			{"xs:date", "2024/01/31", "2024-01-31"},
+			{"xs:date", "2024.01.31", "2024-01-31"},
+			{"xs:date", "20240131", "2024-01-31"},
+			{"xs:date", "2024-01-31T10:00:00Z", "2024-01-31"},
+			{"ern:ddex_IsoDate", "2024/01/31", "2024-01-31"},
+			{"ern:ddex_IsoDate", "2024-01", "2024-01"},
+			{"xs:dateTime", "2024-01-31", "2024-01-31T00:00:00"},
+			{"xs:dateTime", "2024-01-31 10:00", "2024-01-31T10:00:00"},
+			{"xs:dateTime", "2024/01/31 10:00:05+0100", "2024-01-31T10:00:05+01:00"},
+			{"xs:dateTime", "2024-01-31T10:00:05.5-05:00", "2024-01-31T10:00:05.5-05:00"},
+			{"xs:date", "31/01/2024", "31/01/2024"},
+			{"xs:date", "2024-13-01", "2024-13-01"},
+			{"xs:string", "2024/01/31", "2024/01/31"},
+		}
+		for _, tt := range tests {
+			e := etree.NewElement("Date")
+			e.SetText(tt.text)
+			r := repairer{}
+			r.date(e, tt.typ, "Date")
+			if got := e.Text(); got != tt.want {
+				t.Errorf("%s %q = %q, want %q", tt.typ, tt.text, got, tt.want)
+			}
+			if changed := len(r.fixes) > 0; changed != (tt.text != tt.want) {
+				t.Errorf("%s %q reported fixes %v", tt.typ, tt.text, r.fixes)
+			}
+		}
+	})
+
+	t.Run("Unknown Message", func(t *testing.T) {
+		if _, _, err := Repair([]byte(`<Catalog/>`)); err == nil {
+			t.Error("Expected error for a message without a namespace to infer")
+		}
+	})
+}
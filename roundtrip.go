@@ -0,0 +1,276 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+)
+
+// RoundTripResult holds the comparison between a document and its
+// XML → Proto → XML round-trip
+type RoundTripResult struct {
+	ElementsOriginal    int
+	ElementsMarshaled   int
+	AttributesOriginal  int
+	AttributesMarshaled int
+	MissingElements     []string
+	MissingAttributes   []string
+	ValueMismatches     []string
+	ExtraElements       []string
+	MarshaledParseable  bool // Can the marshaled XML be parsed back successfully
+	Success             bool
+}
+
+// RoundTripOptions configures RoundTripReportWithOptions
+type RoundTripOptions struct {
+	// CompareDatesByInstant compares values that parse as an xs:dateTime or
+	// xs:date by the instant they denote rather than lexically, so
+	// 2023-03-01T12:00:00.000Z matches 2023-03-01T12:00:00Z and
+	// 2023-03-01T13:00:00+01:00. Values without a timezone only match other
+	// values without one.
+	CompareDatesByInstant bool
+}
+
+// RoundTripReport parses any supported message, marshals it back to XML and
+// compares the two documents, so callers can verify the library preserves
+// their specific files. Extra elements in the output (e.g. empty defaults)
+// are reported but do not fail the round-trip, whereas attributes only the
+// output has and text lost to child elements are value mismatches. Values
+// are compared lexically after whitespace normalization.
+func RoundTripReport(xmlData []byte) (*RoundTripResult, error) {
+	return RoundTripReportWithOptions(xmlData, RoundTripOptions{})
+}
+
+// RoundTripReportWithOptions is RoundTripReport with the value comparison
+// configured by opts
+func RoundTripReportWithOptions(xmlData []byte, opts RoundTripOptions) (*RoundTripResult, error) {
+	originalDoc := etree.NewDocument()
+	if err := originalDoc.ReadFromBytes(xmlData); err != nil {
+		return nil, fmt.Errorf("failed to read original XML: %w", err)
+	}
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaledXML, err := xml.MarshalIndent(msg, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", msg.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	marshaledDoc := etree.NewDocument()
+	if err := marshaledDoc.ReadFromBytes(marshaledXML); err != nil {
+		return nil, fmt.Errorf("failed to read marshaled XML: %w", err)
+	}
+
+	result := &RoundTripResult{
+		MissingElements:   []string{},
+		MissingAttributes: []string{},
+		ValueMismatches:   []string{},
+		ExtraElements:     []string{},
+	}
+	compareDOMTrees(originalDoc.Root(), marshaledDoc.Root(), "", result, opts)
+
+	// What actually matters downstream: can the marshaled XML be parsed back?
+	_, err = ParseDDEX(marshaledXML)
+	result.MarshaledParseable = err == nil
+
+	result.Success = len(result.MissingElements) == 0 &&
+		len(result.MissingAttributes) == 0 &&
+		len(result.ValueMismatches) == 0 &&
+		result.MarshaledParseable
+
+	return result, nil
+}
+
+// compareDOMTrees recursively compares two XML DOM trees
+func compareDOMTrees(original, marshaled *etree.Element, path string, comp *RoundTripResult, opts RoundTripOptions) {
+	if original == nil && marshaled == nil {
+		return
+	}
+
+	// Build current path
+	currentPath := path
+	if original != nil {
+		currentPath = path + "/" + original.Tag
+	} else if marshaled != nil {
+		currentPath = path + "/" + marshaled.Tag
+	}
+
+	// Check if elements exist in both
+	if original == nil {
+		comp.ExtraElements = append(comp.ExtraElements, currentPath)
+		return
+	}
+	if marshaled == nil {
+		comp.MissingElements = append(comp.MissingElements, currentPath)
+		return
+	}
+
+	// Count elements
+	comp.ElementsOriginal++
+	comp.ElementsMarshaled++
+
+	// Compare attributes
+	origAttrs := make(map[string]string)
+	for _, attr := range original.Attr {
+		origAttrs[attr.Key] = attr.Value
+		comp.AttributesOriginal++
+	}
+
+	marshaledAttrs := make(map[string]string)
+	for _, attr := range marshaled.Attr {
+		marshaledAttrs[attr.Key] = attr.Value
+		comp.AttributesMarshaled++
+	}
+
+	// Check for missing attributes (ignore namespace declarations)
+	for key, origValue := range origAttrs {
+		if strings.HasPrefix(key, "xmlns") {
+			continue // Skip namespace declarations
+		}
+
+		marshaledValue, exists := marshaledAttrs[key]
+		if !exists {
+			comp.MissingAttributes = append(comp.MissingAttributes,
+				fmt.Sprintf("%s@%s", currentPath, key))
+		} else if !valuesEqual(origValue, marshaledValue, opts) {
+			comp.ValueMismatches = append(comp.ValueMismatches,
+				fmt.Sprintf("%s@%s: '%s' != '%s'",
+					currentPath, key, origValue, marshaledValue))
+		}
+	}
+
+	// Attributes only the marshaled side has were invented by the round-trip,
+	// e.g. a default written back for an absent optional attribute
+	for _, attr := range marshaled.Attr {
+		if attr.Space == "xmlns" || attr.Key == "xmlns" {
+			continue // Skip namespace declarations
+		}
+		if _, exists := origAttrs[attr.Key]; !exists {
+			comp.ValueMismatches = append(comp.ValueMismatches,
+				fmt.Sprintf("%s@%s: unexpected attribute '%s'", currentPath, attr.Key, attr.Value))
+		}
+	}
+
+	// Compare text content whenever either side has some, so text replaced
+	// by child elements is caught. Whitespace is normalized first, so <X/>,
+	// <X></X> and <X> </X> all compare as empty
+	origText := normalizeValue(original.Text())
+	marshaledText := normalizeValue(marshaled.Text())
+	if (origText != "" || marshaledText != "") && !valuesEqual(origText, marshaledText, opts) {
+		comp.ValueMismatches = append(comp.ValueMismatches,
+			fmt.Sprintf("%s: '%s' != '%s'", currentPath, origText, marshaledText))
+	}
+
+	// Build maps of child elements by tag
+	origChildren := groupElementsByTag(original.ChildElements())
+	marshaledChildren := groupElementsByTag(marshaled.ChildElements())
+
+	// Compare child elements
+	allTags := make(map[string]bool)
+	for tag := range origChildren {
+		allTags[tag] = true
+	}
+	for tag := range marshaledChildren {
+		allTags[tag] = true
+	}
+
+	for tag := range allTags {
+		origList := origChildren[tag]
+		marshaledList := marshaledChildren[tag]
+
+		// For repeated elements, compare them in order
+		maxLen := max(len(origList), len(marshaledList))
+		for i := 0; i < maxLen; i++ {
+			var origChild, marshaledChild *etree.Element
+
+			if i < len(origList) {
+				origChild = origList[i]
+			}
+			if i < len(marshaledList) {
+				marshaledChild = marshaledList[i]
+			}
+
+			// If counts don't match, we'll catch it in the recursive call
+			if origChild != nil || marshaledChild != nil {
+				childPath := currentPath
+				if i > 0 {
+					childPath = fmt.Sprintf("%s[%d]", currentPath, i+1)
+				}
+				compareDOMTrees(origChild, marshaledChild, childPath, comp, opts)
+			}
+		}
+	}
+}
+
+// groupElementsByTag groups a list of elements by their tag name
+func groupElementsByTag(elements []*etree.Element) map[string][]*etree.Element {
+	grouped := make(map[string][]*etree.Element)
+	for _, elem := range elements {
+		grouped[elem.Tag] = append(grouped[elem.Tag], elem)
+	}
+	return grouped
+}
+
+// normalizeValue normalizes string values for comparison. Only whitespace is
+// touched, as XSD's whiteSpace="collapse" would, so xs:anyURI values keep
+// their percent-encoding, query string and fragment and must match exactly.
+func normalizeValue(s string) string {
+	// Trim whitespace
+	s = strings.TrimSpace(s)
+	// Normalize line endings
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	// Collapse multiple spaces
+	s = strings.Join(strings.Fields(s), " ")
+	return s
+}
+
+// valuesEqual compares two values after normalization, as instants when
+// opts asks for it and both are dates or date-times
+func valuesEqual(a, b string, opts RoundTripOptions) bool {
+	a, b = normalizeValue(a), normalizeValue(b)
+	if a == b {
+		return true
+	}
+	if !opts.CompareDatesByInstant {
+		return false
+	}
+	ta, okA := parseTemporal(a)
+	tb, okB := parseTemporal(b)
+	return okA && okB && ta.date == tb.date && ta.zoned == tb.zoned && ta.t.Equal(tb.t)
+}
+
+// temporal is a parsed xs:dateTime or xs:date value
+type temporal struct {
+	t     time.Time
+	date  bool // an xs:date rather than an xs:dateTime
+	zoned bool // carries a timezone, Z or an offset
+}
+
+// temporalLayouts are the lexical forms of xs:dateTime and xs:date, with and
+// without a timezone. time.Parse accepts fractional seconds after the
+// seconds field of any of them.
+var temporalLayouts = []struct {
+	layout      string
+	date, zoned bool
+}{
+	{"2006-01-02T15:04:05Z07:00", false, true},
+	{"2006-01-02T15:04:05", false, false},
+	{"2006-01-02Z07:00", true, true},
+	{"2006-01-02", true, false},
+}
+
+// parseTemporal parses s as an xs:dateTime or xs:date
+func parseTemporal(s string) (temporal, bool) {
+	for _, l := range temporalLayouts {
+		if t, err := time.Parse(l.layout, s); err == nil {
+			return temporal{t: t, date: l.date, zoned: l.zoned}, true
+		}
+	}
+	return temporal{}, false
+}
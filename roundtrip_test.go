@@ -0,0 +1,603 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	piev10 "github.com/alecsavvy/ddex-go/gen/ddex/pie/v10"
+	"github.com/beevik/etree"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestRoundTripReport validates that a known-good sample round-trips without differences
+func TestRoundTripReport(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	result, err := RoundTripReport(xmlData)
+	if err != nil {
+		t.Fatalf("RoundTripReport failed: %v", err)
+	}
+
+	if !result.Success {
+		t.Errorf("Expected successful round-trip, got %+v", result)
+	}
+	if n := len(result.MissingElements) + len(result.MissingAttributes) + len(result.ValueMismatches); n != 0 {
+		t.Errorf("Expected zero differences, got %d", n)
+	}
+	if result.ElementsOriginal == 0 {
+		t.Error("Expected elements to be compared")
+	}
+
+	if _, err := RoundTripReport([]byte("<Catalog/>")); err == nil {
+		t.Error("Expected error for unsupported message")
+	}
+}
+
+// TestRoundTripReportDetectsLoss validates that text replaced by child
+// elements and attributes invented by marshaling fail the comparison
+func TestRoundTripReportDetectsLoss(t *testing.T) {
+	t.Run("Text Replaced By Child", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "meadv11", "mead_award_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		// MEAD titles belong in a Title child, so text directly inside
+		// TitleText is dropped on parse and comes back as an empty Title
+		titleText := regexp.MustCompile(`<TitleText>\s*<Title>Come Away With Me</Title>\s*</TitleText>`)
+		data := titleText.ReplaceAll(xmlData, []byte("<TitleText>Come Away With Me</TitleText>"))
+
+		result, err := RoundTripReport(data)
+		if err != nil {
+			t.Fatalf("RoundTripReport failed: %v", err)
+		}
+		if result.Success {
+			t.Error("Expected the dropped title text to fail the round-trip")
+		}
+		want := "/MeadMessage/ReleaseInformationList/ReleaseInformation/ReleaseSummary/DisplayTitle/TitleText: 'Come Away With Me' != ''"
+		if !slices.Contains(result.ValueMismatches, want) {
+			t.Errorf("Expected mismatch %q, got %v", want, result.ValueMismatches)
+		}
+	})
+
+	t.Run("Extra Attribute", func(t *testing.T) {
+		original, marshaled := etree.NewDocument(), etree.NewDocument()
+		if err := original.ReadFromString(`<Title>X</Title>`); err != nil {
+			t.Fatal(err)
+		}
+		if err := marshaled.ReadFromString(`<Title IsDefault="false" xmlns:ern="x">X</Title>`); err != nil {
+			t.Fatal(err)
+		}
+
+		result := &RoundTripResult{}
+		compareDOMTrees(original.Root(), marshaled.Root(), "", result, RoundTripOptions{})
+		if want := []string{"/Title@IsDefault: unexpected attribute 'false'"}; !slices.Equal(result.ValueMismatches, want) {
+			t.Errorf("ValueMismatches = %v, want %v", result.ValueMismatches, want)
+		}
+	})
+}
+
+// TestRoundTripExtensionContent validates that foreign xs:any content survives a round-trip
+func TestRoundTripExtensionContent(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "piev10", "pie_award_example.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	extension := `<ext:Note xmlns:ext="http://example.com/ext" ext:level="1">Kept <ext:Em>verbatim</ext:Em></ext:Note>`
+	xmlData = bytes.Replace(xmlData, []byte("<PartyReference>P1</PartyReference>"), []byte("<PartyReference>P1</PartyReference>"+extension), 1)
+
+	msg, err := ParseDDEX(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	party := msg.(*piev10.PieMessage).PartyList.Party[0]
+	if len(party.AnyElement) != 1 {
+		t.Fatalf("Expected 1 extension element, got %d", len(party.AnyElement))
+	}
+	if raw := party.AnyElement[0].RawXml; !strings.Contains(raw, "Note") || !strings.Contains(raw, "verbatim") {
+		t.Errorf("Extension content not captured: %s", raw)
+	}
+
+	result, err := RoundTripReport(xmlData)
+	if err != nil {
+		t.Fatalf("RoundTripReport failed: %v", err)
+	}
+	for _, missing := range result.MissingElements {
+		if strings.Contains(missing, "Note") || strings.Contains(missing, "Em") {
+			t.Errorf("Extension element lost on round-trip: %s", missing)
+		}
+	}
+	for _, missing := range result.MissingAttributes {
+		if strings.Contains(missing, "level") {
+			t.Errorf("Extension attribute lost on round-trip: %s", missing)
+		}
+	}
+}
+
+// TestRoundTripNamespacePrefix validates that a root prefix other than the
+// default survives parse and marshal
+func TestRoundTripNamespacePrefix(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+	renamed := strings.NewReplacer("<ern:", "<ernm:", "</ern:", "</ernm:", "xmlns:ern=", "xmlns:ernm=").Replace(string(xmlData))
+
+	t.Run("Custom Prefix", func(t *testing.T) {
+		msg, err := ParseDDEX([]byte(renamed))
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		if prefix := msg.(*ernv43.NewReleaseMessage).RootPrefix; prefix != "ernm" {
+			t.Errorf("Expected RootPrefix ernm, got %q", prefix)
+		}
+
+		out, err := Marshal(msg, MarshalOptions{FixNamespacePrefixes: true})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if !bytes.Contains(out, []byte("<ernm:NewReleaseMessage")) || !bytes.Contains(out, []byte(`xmlns:ernm="`+ernv43.Namespace+`"`)) {
+			t.Errorf("Expected the ernm prefix to be kept, got %s", out[:200])
+		}
+		if bytes.Contains(out, []byte("xmlns:ern=")) {
+			t.Error("Expected no declaration of the default prefix")
+		}
+
+		result, err := RoundTripReport([]byte(renamed))
+		if err != nil {
+			t.Fatalf("RoundTripReport failed: %v", err)
+		}
+		if !result.Success {
+			t.Errorf("Expected successful round-trip, got %+v", result)
+		}
+	})
+
+	t.Run("Default Prefix", func(t *testing.T) {
+		msg, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		if prefix := msg.(*ernv43.NewReleaseMessage).RootPrefix; prefix != "" {
+			t.Errorf("Expected no RootPrefix, got %q", prefix)
+		}
+		out, err := Marshal(msg, MarshalOptions{FixNamespacePrefixes: true})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		if !bytes.Contains(out, []byte("<ern:NewReleaseMessage")) {
+			t.Errorf("Expected the ern prefix, got %s", out[:200])
+		}
+	})
+
+	t.Run("Concurrent Marshal", func(t *testing.T) {
+		parsed, err := ParseDDEX([]byte(renamed))
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		msg := parsed.(*ernv43.NewReleaseMessage)
+		xmlnsErn, xmlnsXsi, schemaLocation := msg.XmlnsErn, msg.XmlnsXsi, msg.XsiSchemaLocation
+
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				out, err := xml.Marshal(msg)
+				if err != nil {
+					t.Errorf("Marshal failed: %v", err)
+					return
+				}
+				if !bytes.Contains(out, []byte(`xmlns:ernm="`+ernv43.Namespace+`"`)) {
+					t.Errorf("Expected the ernm declaration, got %s", out[:200])
+				}
+			}()
+		}
+		wg.Wait()
+
+		if msg.XmlnsErn != xmlnsErn || msg.XmlnsXsi != xmlnsXsi || msg.XsiSchemaLocation != schemaLocation || msg.RootPrefix != "ernm" {
+			t.Error("Expected marshaling to leave the message unchanged")
+		}
+	})
+
+	t.Run("Equality", func(t *testing.T) {
+		custom, err := ParseDDEX([]byte(renamed))
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		plain, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		if !EqualIgnoringNamespaces(custom, plain) {
+			t.Error("Expected messages differing only in prefix to be equal")
+		}
+	})
+}
+
+// TestRoundTripOptionalPresence validates that an absent optional element stays
+// absent and an explicitly empty one stays empty through parse and marshal
+func TestRoundTripOptionalPresence(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	testCases := map[string]struct {
+		controlType string
+		present     bool
+	}{
+		"Absent": {controlType: "", present: false},
+		"Empty":  {controlType: "<MessageControlType></MessageControlType>", present: true},
+		"Set":    {controlType: "<MessageControlType>LiveMessage</MessageControlType>", present: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := bytes.Replace(xmlData, []byte("<MessageControlType>LiveMessage</MessageControlType>"), []byte(tc.controlType), 1)
+
+			msg, err := ParseDDEX(data)
+			if err != nil {
+				t.Fatalf("Failed to parse: %v", err)
+			}
+			header := msg.(*ernv43.NewReleaseMessage).MessageHeader
+			if got := header.MessageControlType != nil; got != tc.present {
+				t.Fatalf("MessageControlType present = %v, want %v", got, tc.present)
+			}
+
+			out, err := Marshal(msg, MarshalOptions{})
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if got := bytes.Contains(out, []byte("<MessageControlType>")); got != tc.present {
+				t.Errorf("Marshaled MessageControlType present = %v, want %v", got, tc.present)
+			}
+			if tc.present && !bytes.Contains(out, []byte(tc.controlType)) {
+				t.Errorf("Expected %s in marshaled output", tc.controlType)
+			}
+		})
+	}
+}
+
+// TestRoundTripOptionalAttributes validates that an absent optional attribute
+// is not marshaled back with its zero value, while an explicit zero is kept
+func TestRoundTripOptionalAttributes(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	testCases := map[string]struct {
+		displayArtist string
+		present       bool
+	}{
+		"Absent": {displayArtist: "<DisplayArtist>", present: false},
+		"Zero":   {displayArtist: `<DisplayArtist SequenceNumber="0">`, present: true},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := bytes.Replace(xmlData, []byte(`<DisplayArtist SequenceNumber="1">`), []byte(tc.displayArtist), 1)
+
+			msg, err := ParseDDEX(data)
+			if err != nil {
+				t.Fatalf("Failed to parse: %v", err)
+			}
+			artist := msg.(*ernv43.NewReleaseMessage).ResourceList.SoundRecording[0].DisplayArtist[0]
+			if got := artist.SequenceNumber != nil; got != tc.present {
+				t.Fatalf("SequenceNumber present = %v, want %v", got, tc.present)
+			}
+
+			out, err := Marshal(msg, MarshalOptions{})
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if got := bytes.Contains(out, []byte(`SequenceNumber="0"`)); got != tc.present {
+				t.Errorf("Marshaled SequenceNumber=\"0\" present = %v, want %v", got, tc.present)
+			}
+			for _, zero := range []string{`IsDefault="false"`, `=""`} {
+				if bytes.Contains(out, []byte(zero)) {
+					t.Errorf("Expected no %s for absent attributes", zero)
+				}
+			}
+		})
+	}
+}
+
+// TestRoundTripURIs validates that xs:anyURI values keep their query string,
+// fragment and percent-encoding byte for byte through parse, marshal and parse
+func TestRoundTripURIs(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	const uri = "https://cdn.example.com/audio/a%20b%2Fc.flac?sig=x%2By%3D&expires=1700000000#t=30,60"
+	data := bytes.Replace(xmlData,
+		[]byte("<URI>resources/190295810726_00001_LL.flac</URI>"),
+		[]byte("<URI>https://cdn.example.com/audio/a%20b%2Fc.flac?sig=x%2By%3D&amp;expires=1700000000#t=30,60</URI>"), 1)
+
+	uris := func(t *testing.T, data []byte) []string {
+		t.Helper()
+		msg, err := ParseDDEX(data)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		var found []string
+		_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+			if strings.HasSuffix(path, ".URI") {
+				found = append(found, v.String())
+			}
+			return nil
+		})
+		return found
+	}
+
+	parsed := uris(t, data)
+	if len(parsed) != 1 || parsed[0] != uri {
+		t.Fatalf("Parsed URIs = %q, want [%q]", parsed, uri)
+	}
+
+	msg, err := ParseDDEX(data)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	out, err := Marshal(msg, MarshalOptions{})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if !bytes.Contains(out, []byte("<URI>https://cdn.example.com/audio/a%20b%2Fc.flac?sig=x%2By%3D&amp;expires=1700000000#t=30,60</URI>")) {
+		t.Error("Marshaled URI differs from its escaped original")
+	}
+	if reparsed := uris(t, out); len(reparsed) != 1 || reparsed[0] != uri {
+		t.Errorf("Reparsed URIs = %q, want [%q]", reparsed, uri)
+	}
+
+	result, err := RoundTripReport(data)
+	if err != nil {
+		t.Fatalf("RoundTripReport failed: %v", err)
+	}
+	if len(result.ValueMismatches) != 0 {
+		t.Errorf("Expected no value mismatches, got %v", result.ValueMismatches)
+	}
+}
+
+// TestRoundTripEmptyElements validates that self-closing, empty and
+// whitespace-only elements round-trip to their canonical forms
+func TestRoundTripEmptyElements(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	testCases := []struct {
+		name, old, new, want string
+	}{
+		{"Self Closing", "<MessageId>W83814161</MessageId>", "<MessageId/>", "<MessageId></MessageId>"},
+		{"Empty", "<MessageId>W83814161</MessageId>", "<MessageId></MessageId>", "<MessageId></MessageId>"},
+		{"Whitespace", "<MessageId>W83814161</MessageId>", "<MessageId> </MessageId>", "<MessageId> </MessageId>"},
+		{"Self Closing Chardata", `<DisplayArtistName ApplicableTerritoryCode="Worldwide">RIOPY</DisplayArtistName>`, `<DisplayArtistName ApplicableTerritoryCode="Worldwide"/>`, `"></DisplayArtistName>`},
+		{"Whitespace Chardata", `<DisplayArtistName ApplicableTerritoryCode="Worldwide">RIOPY</DisplayArtistName>`, `<DisplayArtistName ApplicableTerritoryCode="Worldwide"> </DisplayArtistName>`, `"> </DisplayArtistName>`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !bytes.Contains(xmlData, []byte(tc.old)) {
+				t.Fatalf("Sample does not contain %q", tc.old)
+			}
+			data := bytes.Replace(xmlData, []byte(tc.old), []byte(tc.new), 1)
+
+			result, err := RoundTripReport(data)
+			if err != nil {
+				t.Fatalf("RoundTripReport failed: %v", err)
+			}
+			if !result.Success || len(result.ValueMismatches) != 0 {
+				t.Errorf("Expected successful round-trip, got %+v", result)
+			}
+
+			msg, err := ParseDDEX(data)
+			if err != nil {
+				t.Fatalf("Failed to parse: %v", err)
+			}
+			out, err := Marshal(msg, MarshalOptions{})
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if !bytes.Contains(out, []byte(tc.want)) {
+				t.Errorf("Expected %s in marshaled output", tc.want)
+			}
+
+			canonical, err := Canonicalize(data)
+			if err != nil {
+				t.Fatalf("Failed to canonicalize: %v", err)
+			}
+			if !bytes.Contains(canonical, []byte(tc.want)) {
+				t.Errorf("Expected %s in canonical output", tc.want)
+			}
+			again, err := Canonicalize(canonical)
+			if err != nil {
+				t.Fatalf("Failed to canonicalize canonical output: %v", err)
+			}
+			if !bytes.Equal(canonical, again) {
+				t.Error("Canonicalize is not idempotent")
+			}
+		})
+	}
+
+	t.Run("Text Added To Empty Element", func(t *testing.T) {
+		original := etree.NewElement("MessageId")
+		marshaled := etree.NewElement("MessageId")
+		marshaled.SetText("M1")
+		var result RoundTripResult
+		compareDOMTrees(original, marshaled, "", &result, RoundTripOptions{})
+		if len(result.ValueMismatches) != 1 {
+			t.Errorf("Expected 1 value mismatch, got %v", result.ValueMismatches)
+		}
+	})
+}
+
+// TestRoundTripDates validates that dates and date-times compare lexically
+// by default and by instant when CompareDatesByInstant is set
+func TestRoundTripDates(t *testing.T) {
+	testCases := []struct {
+		name, original, marshaled string
+		byInstant                 bool
+	}{
+		{"Fractional Seconds", "2023-03-01T12:00:00.000Z", "2023-03-01T12:00:00Z", true},
+		{"Equivalent Offset", "2023-03-01T13:00:00+01:00", "2023-03-01T12:00:00Z", true},
+		{"Unzoned Fractional Seconds", "2023-03-01T12:00:00.5", "2023-03-01T12:00:00.500", true},
+		{"Date With Offset", "2023-03-01Z", "2023-03-01+00:00", true},
+		{"Different Instant", "2023-03-01T12:00:00Z", "2023-03-01T12:00:01Z", false},
+		{"Zoned And Unzoned", "2023-03-01T12:00:00Z", "2023-03-01T12:00:00", false},
+		{"Date And DateTime", "2023-03-01", "2023-03-01T00:00:00", false},
+		{"Not A Date", "W83814161", "W83814161.0", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := etree.NewElement("MessageCreatedDateTime")
+			original.SetText(tc.original)
+			original.CreateAttr("StartDate", tc.original)
+			marshaled := etree.NewElement("MessageCreatedDateTime")
+			marshaled.SetText(tc.marshaled)
+			marshaled.CreateAttr("StartDate", tc.marshaled)
+
+			var lexical RoundTripResult
+			compareDOMTrees(original, marshaled, "", &lexical, RoundTripOptions{})
+			if len(lexical.ValueMismatches) != 2 {
+				t.Errorf("Expected text and attribute mismatches by default, got %v", lexical.ValueMismatches)
+			}
+
+			var instant RoundTripResult
+			compareDOMTrees(original, marshaled, "", &instant, RoundTripOptions{CompareDatesByInstant: true})
+			if tc.byInstant && len(instant.ValueMismatches) != 0 {
+				t.Errorf("Expected no mismatches by instant, got %v", instant.ValueMismatches)
+			}
+			if !tc.byInstant && len(instant.ValueMismatches) != 2 {
+				t.Errorf("Expected text and attribute mismatches by instant, got %v", instant.ValueMismatches)
+			}
+		})
+	}
+
+	t.Run("Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		result, err := RoundTripReportWithOptions(xmlData, RoundTripOptions{CompareDatesByInstant: true})
+		if err != nil {
+			t.Fatalf("RoundTripReportWithOptions failed: %v", err)
+		}
+		if !result.Success {
+			t.Errorf("Expected successful round-trip, got %+v", result)
+		}
+	})
+}
+
+// TestMarshalSchemaOrder validates that marshaled child elements follow the
+// order of their type's sequence in the schema, including elements declared
+// after a choice
+func TestMarshalSchemaOrder(t *testing.T) {
+	files, _ := filepath.Glob(filepath.Join("testdata", "*", "*.xml"))
+	samples, _ := filepath.Glob(filepath.Join("testdata", "ernv432", "Samples43", "*.xml"))
+	files = append(files, samples...)
+	if len(files) == 0 {
+		t.Skip("No sample files found in testdata")
+	}
+
+	for _, xmlPath := range files {
+		t.Run(filepath.Base(xmlPath), func(t *testing.T) {
+			xmlData, err := os.ReadFile(xmlPath)
+			if err != nil {
+				t.Skipf("Sample file not found: %s", xmlPath)
+			}
+			msg, err := ParseDDEX(xmlData)
+			if err != nil {
+				t.Fatalf("Failed to parse: %v", err)
+			}
+			schema, ok := SchemaOf(msg)
+			if !ok {
+				t.Fatalf("No schema for %s", msg.ProtoReflect().Descriptor().FullName())
+			}
+			marshaled, err := xml.Marshal(msg)
+			if err != nil {
+				t.Fatalf("Failed to marshal: %v", err)
+			}
+			doc := etree.NewDocument()
+			if err := doc.ReadFromBytes(marshaled); err != nil {
+				t.Fatalf("Failed to read marshaled XML: %v", err)
+			}
+
+			root := doc.Root()
+			checkSchemaOrder(t, schema, root.Tag, root, root.Tag)
+		})
+	}
+
+	t.Run("Choice Before Elements", func(t *testing.T) {
+		terms := &ernv43.DealTerms{
+			ValidityPeriod:      []*ernv43.PeriodWithStartDate{{StartDate: &ernv43.EventDateWithCurrentTerritory{Value: "2024-01-01"}}},
+			CommercialModelType: []*ernv43.CommercialModelType{{Value: "SubscriptionModel"}},
+			TerritoryCode:       []*ernv43.CurrentTerritoryCode{{Value: "Worldwide"}},
+		}
+		out, err := xml.Marshal(terms)
+		if err != nil {
+			t.Fatalf("Failed to marshal: %v", err)
+		}
+		territory := bytes.Index(out, []byte("<TerritoryCode"))
+		validity := bytes.Index(out, []byte("<ValidityPeriod"))
+		if territory < 0 || validity < 0 || territory > validity {
+			t.Errorf("Expected TerritoryCode before ValidityPeriod, got %s", out)
+		}
+	})
+}
+
+// checkSchemaOrder reports children of e appearing before a sibling the
+// schema type declares earlier, then checks the children in turn
+func checkSchemaOrder(t *testing.T, schema *Schema, typeName string, e *etree.Element, path string) {
+	t.Helper()
+	typ, ok := schema.Type(typeName)
+	if !ok {
+		return
+	}
+	position := make(map[string]int)
+	for i, field := range typ.Fields {
+		if _, seen := position[field.Name]; field.Kind == "element" && !seen {
+			position[field.Name] = i
+		}
+	}
+
+	last, lastTag := -1, ""
+	for _, child := range e.ChildElements() {
+		i, ok := position[child.Tag]
+		if !ok {
+			continue // extension content
+		}
+		if i < last {
+			t.Errorf("%s: %s marshaled after %s, which the schema declares later", path, child.Tag, lastTag)
+		}
+		last, lastTag = i, child.Tag
+
+		field, _ := typ.Field(child.Tag)
+		_, childType, _ := strings.Cut(field.Type, ":")
+		if !strings.Contains(field.Type, ":") {
+			childType = field.Type
+		}
+		checkSchemaOrder(t, schema, childType, child, path+"/"+child.Tag)
+	}
+}
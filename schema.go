@@ -0,0 +1,151 @@
+package ddex
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// schemaFiles holds the schema metadata xsd2proto writes beside each .proto
+//
+//go:embed proto/ddex/*/*/*.schema.json
+var schemaFiles embed.FS
+
+// Schema describes the XSD a generated package was converted from: its
+// types with their elements, attributes and cardinalities, and the values
+// of its enumerations, for editors, validators and other tooling
+type Schema struct {
+	Namespace string       `json:"namespace"`
+	Package   string       `json:"package"` // proto package, e.g. ddex.ern.v432
+	Types     []SchemaType `json:"types"`
+	Enums     []SchemaEnum `json:"enums"`
+	types     map[string]*SchemaType
+	enums     map[string]*SchemaEnum
+}
+
+// SchemaType is a named complexType, or a top-level element declaring its
+// type inline (a root message)
+type SchemaType struct {
+	Name   string        `json:"name"`
+	Root   bool          `json:"root"`
+	Fields []SchemaField `json:"fields"`
+}
+
+// SchemaField is an element, attribute, text content or xs:any wildcard of a
+// type, in the order the generated message declares them
+type SchemaField struct {
+	Name      string `json:"name"` // empty for text and any
+	Type      string `json:"type"` // XSD type as written, e.g. xs:string, ern:Release, avs:ReleaseType
+	Kind      string `json:"kind"` // element, attribute, text or any
+	MinOccurs int    `json:"minOccurs"`
+	MaxOccurs int    `json:"maxOccurs"` // -1 for unbounded
+}
+
+// SchemaEnum is a simpleType restricted to an enumeration
+type SchemaEnum struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"` // exactly as in the XSD
+}
+
+// Type returns the named type
+func (s *Schema) Type(name string) (*SchemaType, bool) {
+	t, ok := s.types[name]
+	return t, ok
+}
+
+// Enum returns the named enumeration
+func (s *Schema) Enum(name string) (*SchemaEnum, bool) {
+	e, ok := s.enums[name]
+	return e, ok
+}
+
+// Field returns the element or attribute with the given name
+func (t *SchemaType) Field(name string) (*SchemaField, bool) {
+	for i := range t.Fields {
+		if t.Fields[i].Name == name {
+			return &t.Fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// Repeated reports whether the field may occur more than once
+func (f *SchemaField) Repeated() bool {
+	return f.MaxOccurs < 0 || f.MaxOccurs > 1
+}
+
+var (
+	schemasOnce sync.Once
+	schemas     map[string]*Schema // proto package to schema
+	schemasErr  error
+)
+
+// loadSchemas decodes the embedded schema metadata on first use
+func loadSchemas() (map[string]*Schema, error) {
+	schemasOnce.Do(func() {
+		schemas = make(map[string]*Schema)
+		schemasErr = fs.WalkDir(schemaFiles, "proto", func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := schemaFiles.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			schema := &Schema{}
+			if err := json.Unmarshal(data, schema); err != nil {
+				return fmt.Errorf("failed to decode %s: %w", path, err)
+			}
+			schema.types = make(map[string]*SchemaType, len(schema.Types))
+			for i := range schema.Types {
+				schema.types[schema.Types[i].Name] = &schema.Types[i]
+			}
+			schema.enums = make(map[string]*SchemaEnum, len(schema.Enums))
+			for i := range schema.Enums {
+				schema.enums[schema.Enums[i].Name] = &schema.Enums[i]
+			}
+			schemas[schema.Package] = schema
+			return nil
+		})
+	})
+	return schemas, schemasErr
+}
+
+// LookupSchema returns the schema of a generated proto package, e.g.
+// ddex.ern.v432 or ddex.avs.vlatest
+func LookupSchema(pkg string) (*Schema, bool) {
+	all, err := loadSchemas()
+	if err != nil {
+		return nil, false
+	}
+	schema, ok := all[pkg]
+	return schema, ok
+}
+
+// SchemaOf returns the schema of the package msg was generated in
+func SchemaOf(msg proto.Message) (*Schema, bool) {
+	if msg == nil {
+		return nil, false
+	}
+	return LookupSchema(string(msg.ProtoReflect().Descriptor().ParentFile().Package()))
+}
+
+// Schemas returns every embedded schema, sorted by package
+func Schemas() []*Schema {
+	all, err := loadSchemas()
+	if err != nil {
+		return nil
+	}
+	list := make([]*Schema, 0, len(all))
+	for _, schema := range all {
+		list = append(list, schema)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Package < list[j].Package })
+	return list
+}
@@ -0,0 +1,108 @@
+package ddex
+
+import (
+	"testing"
+
+	avsvlatest "github.com/alecsavvy/ddex-go/gen/ddex/avs/vlatest"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+)
+
+// TestSchema validates the embedded schema metadata against the generated code
+func TestSchema(t *testing.T) {
+	t.Run("All Packages", func(t *testing.T) {
+		var packages []string
+		for _, schema := range Schemas() {
+			packages = append(packages, schema.Package)
+		}
+		want := []string{"ddex.avs.v20200108", "ddex.avs.vlatest", "ddex.ern.v383", "ddex.ern.v43", "ddex.ern.v432", "ddex.mead.v11", "ddex.pie.v10"}
+		if len(packages) != len(want) {
+			t.Fatalf("Schemas() = %v, want %v", packages, want)
+		}
+		for i := range want {
+			if packages[i] != want[i] {
+				t.Errorf("Schemas()[%d] = %s, want %s", i, packages[i], want[i])
+			}
+		}
+	})
+
+	t.Run("Root Fields", func(t *testing.T) {
+		schema, ok := SchemaOf(&ernv432.NewReleaseMessage{})
+		if !ok {
+			t.Fatal("no schema for ddex.ern.v432")
+		}
+		if schema.Namespace != "http://ddex.net/xml/ern/432" {
+			t.Errorf("Namespace = %s", schema.Namespace)
+		}
+
+		root, ok := schema.Type("NewReleaseMessage")
+		if !ok {
+			t.Fatal("NewReleaseMessage not found")
+		}
+		if !root.Root {
+			t.Error("NewReleaseMessage is not marked as a root")
+		}
+
+		tests := []struct {
+			name     string
+			typ      string
+			kind     string
+			min, max int
+			repeated bool
+		}{
+			{"MessageHeader", "ern:MessageHeader", "element", 1, 1, false},
+			{"ReleaseAdmin", "ern:ReleaseAdmin", "element", 0, -1, true},
+			{"DealList", "ern:DealList", "element", 0, 1, false},
+			{"AvsVersionId", "xs:string", "attribute", 1, 1, false},
+		}
+		for _, tt := range tests {
+			field, ok := root.Field(tt.name)
+			if !ok {
+				t.Errorf("%s not found", tt.name)
+				continue
+			}
+			if field.Type != tt.typ || field.Kind != tt.kind || field.MinOccurs != tt.min || field.MaxOccurs != tt.max {
+				t.Errorf("%s = %+v", tt.name, *field)
+			}
+			if field.Repeated() != tt.repeated {
+				t.Errorf("%s Repeated() = %v, want %v", tt.name, field.Repeated(), tt.repeated)
+			}
+		}
+	})
+
+	t.Run("Enum Values", func(t *testing.T) {
+		schema, ok := LookupSchema("ddex.avs.vlatest")
+		if !ok {
+			t.Fatal("no schema for ddex.avs.vlatest")
+		}
+		enum, ok := schema.Enum("Activity")
+		if !ok {
+			t.Fatal("Activity not found")
+		}
+		// Every XSD value maps onto a generated constant
+		for _, value := range enum.Values {
+			if _, ok := avsvlatest.ParseActivityString(value); !ok {
+				t.Errorf("ParseActivityString(%q) failed", value)
+			}
+		}
+	})
+
+	t.Run("Unknown Package", func(t *testing.T) {
+		if _, ok := LookupSchema("ddex.ern.v999"); ok {
+			t.Error("expected no schema for ddex.ern.v999")
+		}
+		if _, ok := SchemaOf(nil); ok {
+			t.Error("expected no schema for nil message")
+		}
+	})
+
+	t.Run("MEAD", func(t *testing.T) {
+		schema, ok := SchemaOf(&meadv11.MeadMessage{})
+		if !ok {
+			t.Fatal("no schema for ddex.mead.v11")
+		}
+		if _, ok := schema.Type("MeadMessage"); !ok {
+			t.Error("MeadMessage not found")
+		}
+	})
+}
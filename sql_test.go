@@ -0,0 +1,122 @@
+package ddex
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+	piev10 "github.com/alecsavvy/ddex-go/gen/ddex/pie/v10"
+	"google.golang.org/protobuf/proto"
+)
+
+// Compile-time checks that generated root messages plug into database/sql
+var (
+	_ sql.Scanner   = (*ernv43.NewReleaseMessage)(nil)
+	_ driver.Valuer = (*ernv43.NewReleaseMessage)(nil)
+	_ sql.Scanner   = (*ernv383.CatalogListMessage)(nil)
+	_ driver.Valuer = (*ernv432.PurgeReleaseMessage)(nil)
+	_ sql.Scanner   = (*meadv11.MeadMessage)(nil)
+	_ driver.Valuer = (*piev10.PieMessage)(nil)
+)
+
+// TestMessageSQL validates storing root messages as XML or JSON column
+// values and scanning them back
+func TestMessageSQL(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+	parsed, _, err := ParseERN(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	want := parsed.(*ernv43.NewReleaseMessage)
+
+	t.Run("Scan From Bytes And String", func(t *testing.T) {
+		for _, src := range []any{xmlData, string(xmlData)} {
+			var msg ernv43.NewReleaseMessage
+			if err := msg.Scan(src); err != nil {
+				t.Fatalf("Scan(%T) failed: %v", src, err)
+			}
+			if !proto.Equal(&msg, want) {
+				t.Errorf("Scan(%T) decoded a different message", src)
+			}
+		}
+	})
+
+	t.Run("XML Value", func(t *testing.T) {
+		value, err := want.Value()
+		if err != nil {
+			t.Fatalf("Value failed: %v", err)
+		}
+		s, ok := value.(string)
+		if !ok || !strings.HasPrefix(s, "<NewReleaseMessage") {
+			t.Fatalf("Expected an XML document, got %T", value)
+		}
+
+		var msg ernv43.NewReleaseMessage
+		if err := msg.Scan([]byte(s)); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if !proto.Equal(&msg, want) {
+			t.Error("Expected the stored XML to scan back to the same message")
+		}
+	})
+
+	t.Run("JSON Value", func(t *testing.T) {
+		ernv43.SQLJSON = true
+		defer func() { ernv43.SQLJSON = false }()
+
+		value, err := want.Value()
+		if err != nil {
+			t.Fatalf("Value failed: %v", err)
+		}
+		s, ok := value.(string)
+		if !ok || !strings.HasPrefix(s, "{") {
+			t.Fatalf("Expected a JSON document, got %v", value)
+		}
+
+		for _, src := range []any{s, []byte(" \n" + s)} {
+			var msg ernv43.NewReleaseMessage
+			if err := msg.Scan(src); err != nil {
+				t.Fatalf("Scan(%T) failed: %v", src, err)
+			}
+			if !proto.Equal(&msg, want) {
+				t.Errorf("Scan(%T) decoded a different message", src)
+			}
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		msg := proto.Clone(want).(*ernv43.NewReleaseMessage)
+		if err := msg.Scan(nil); err != nil {
+			t.Fatalf("Scan(nil) failed: %v", err)
+		}
+		if msg.GetMessageHeader() != nil {
+			t.Error("Expected Scan(nil) to reset the message")
+		}
+
+		var nilMsg *ernv43.NewReleaseMessage
+		if value, err := nilMsg.Value(); value != nil || err != nil {
+			t.Errorf("Value of nil = %v, %v, want nil", value, err)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		var msg ernv43.NewReleaseMessage
+		if err := msg.Scan(42); err == nil {
+			t.Error("Expected an error scanning an int")
+		}
+		if err := msg.Scan("<PurgeReleaseMessage/>"); err == nil {
+			t.Error("Expected an error scanning another root element")
+		}
+	})
+}
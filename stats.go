@@ -0,0 +1,55 @@
+package ddex
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageStats holds size and complexity counts for a message
+type MessageStats struct {
+	Releases  int // entries of ReleaseList or ReleaseInformationList
+	Resources int // entries of ResourceList or ResourceInformationList
+	Deals     int // Deal elements, including those nested in ReleaseDeal
+	Parties   int // entries of PartyList
+	Awards    int // Award elements
+	Elements  int // populated XML elements, including the root
+}
+
+// Stats computes size and complexity counts for any message type via proto reflection
+func Stats(msg proto.Message) MessageStats {
+	if msg == nil {
+		return MessageStats{}
+	}
+
+	stats := MessageStats{Elements: 1}
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		mapping := xmlFields(parent)[fd.Number()]
+		if mapping.Attr || mapping.Chardata {
+			return nil
+		}
+		stats.Elements++
+
+		if fd.Kind() != protoreflect.MessageKind {
+			return nil
+		}
+
+		switch parent.Descriptor().Name() {
+		case "ReleaseList", "ReleaseInformationList":
+			stats.Releases++
+		case "ResourceList", "ResourceInformationList":
+			stats.Resources++
+		case "PartyList":
+			stats.Parties++
+		}
+
+		switch fd.Message().Name() {
+		case "Deal":
+			stats.Deals++
+		case "Award":
+			stats.Awards++
+		}
+		return nil
+	})
+
+	return stats
+}
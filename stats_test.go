@@ -0,0 +1,46 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestStats validates message counts against known sample contents
+func TestStats(t *testing.T) {
+	testCases := []struct {
+		name     string
+		xmlPath  string
+		expected MessageStats
+	}{
+		{"ERN Audio Album", filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml"),
+			MessageStats{Releases: 22, Resources: 22, Deals: 3, Parties: 2}},
+		{"MEAD Award", filepath.Join("testdata", "meadv11", "mead_award_example.xml"),
+			MessageStats{Releases: 1}},
+		{"PIE Award", filepath.Join("testdata", "piev10", "pie_award_example.xml"),
+			MessageStats{Parties: 1, Awards: 3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			xmlData, err := os.ReadFile(tc.xmlPath)
+			if err != nil {
+				t.Skipf("Sample file not found: %s", tc.xmlPath)
+			}
+
+			msg, err := ParseDDEX(xmlData)
+			if err != nil {
+				t.Fatalf("Failed to parse %s: %v", tc.xmlPath, err)
+			}
+
+			stats := Stats(msg)
+			if stats.Elements == 0 {
+				t.Error("Elements should count at least the root")
+			}
+			stats.Elements = 0
+			if stats != tc.expected {
+				t.Errorf("Stats = %+v, want %+v", stats, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// StreamFunc is called by StreamResources with each list entry as soon as
+// it is decoded. path locates the entry like a Walk path, e.g.
+// "ResourceList.SoundRecording[3]". Returning an error stops the stream.
+type StreamFunc func(path string, entry proto.Message) error
+
+// StreamResources decodes the ERN message read from r one list entry at a
+// time: every resource, release, party, deal and other entry of a *List
+// wrapper is handed to fn and then dropped, so memory is bounded by the
+// largest entry rather than the delivery. It returns the root message with
+// everything else (the header, attributes and empty list wrappers) and the
+// ERN version its root element declares. Gzipped input is decompressed
+// transparently.
+func StreamResources(r io.Reader, fn StreamFunc) (ERNMessage, ERNVersion, error) {
+	r, err := decompressReader(r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	decoder := xml.NewDecoder(r)
+	start, err := nextStartElement(decoder)
+	if err != nil {
+		return nil, "", err
+	}
+	version, ok := rootERNVersion(start)
+	if !ok {
+		return nil, "", fmt.Errorf("could not detect ERN version from root element %s", start.Name.Local)
+	}
+	space := ernNamespacePrefix + string(version)
+	if !DefaultRegistry.HasNamespace(space) {
+		return nil, "", fmt.Errorf("unsupported ERN version: %s", version)
+	}
+	factory, ok := DefaultRegistry.Lookup(space, start.Name.Local)
+	if !ok {
+		return nil, version, fmt.Errorf("unknown ERN message type: %s", start.Name.Local)
+	}
+	msg, ok := factory().(ERNMessage)
+	if !ok {
+		return nil, version, fmt.Errorf("registered ERN %s %s does not implement ERNMessage", version, start.Name.Local)
+	}
+
+	// The root start element is replayed through the token decoder, which
+	// must have read it itself before DecodeElement
+	stream := &listStreamer{decoder: decoder, root: reflect.TypeOf(msg), fn: fn, pending: []xml.Token{start}}
+	tokens := xml.NewTokenDecoder(stream)
+	if start, err = nextStartElement(tokens); err != nil {
+		return nil, version, err
+	}
+	if err := tokens.DecodeElement(msg, &start); err != nil {
+		if stream.err != nil {
+			err = stream.err
+		}
+		return msg, version, err
+	}
+	return msg, version, nil
+}
+
+// listStreamer feeds the root element to its decoder token by token,
+// decoding the children of each *List wrapper itself and passing them to fn
+// instead, so the root is left with empty wrappers
+type listStreamer struct {
+	decoder *xml.Decoder
+	root    reflect.Type
+	fn      StreamFunc
+	depth   int
+	pending []xml.Token // tokens to return before reading on, e.g. the end of a streamed wrapper
+	err     error       // the error that stopped the stream, kept unwrapped
+}
+
+func (s *listStreamer) Token() (xml.Token, error) {
+	var token xml.Token
+	if len(s.pending) > 0 {
+		token, s.pending = s.pending[0], s.pending[1:]
+	} else {
+		var err error
+		if token, err = s.decoder.Token(); err != nil {
+			return nil, err
+		}
+	}
+
+	switch t := token.(type) {
+	case xml.StartElement:
+		s.depth++
+		if s.depth != 2 || !strings.HasSuffix(t.Name.Local, "List") {
+			break
+		}
+		wrapper, ok := strictFieldsOf(s.root).elements[t.Name.Local]
+		if !ok || wrapper == nil {
+			break
+		}
+		end, err := s.streamEntries(t, wrapper)
+		if err != nil {
+			s.err = err
+			return nil, err
+		}
+		s.pending = append(s.pending, end)
+	case xml.EndElement:
+		s.depth--
+	}
+	return token, nil
+}
+
+// streamEntries decodes the children of the wrapper opened by start, up to
+// and including its end element, and returns that end element
+func (s *listStreamer) streamEntries(start xml.StartElement, wrapper reflect.Type) (xml.EndElement, error) {
+	fields := strictFieldsOf(wrapper)
+	counts := make(map[string]int)
+	for {
+		token, err := s.decoder.Token()
+		if err != nil {
+			return xml.EndElement{}, fmt.Errorf("failed to read %s: %w", start.Name.Local, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			path := start.Name.Local + "." + t.Name.Local
+			typ, ok := fields.elements[t.Name.Local]
+			entry, isMessage := newEntry(typ)
+			if !ok || !isMessage {
+				if err := s.decoder.Skip(); err != nil {
+					return xml.EndElement{}, fmt.Errorf("failed to read %s: %w", path, err)
+				}
+				continue
+			}
+			if typ.Kind() == reflect.Slice {
+				path = fmt.Sprintf("%s[%d]", path, counts[t.Name.Local])
+				counts[t.Name.Local]++
+			}
+			if err := s.decoder.DecodeElement(entry, &t); err != nil {
+				return xml.EndElement{}, fmt.Errorf("failed to decode %s: %w", path, err)
+			}
+			if err := s.fn(path, entry); err != nil {
+				return xml.EndElement{}, err
+			}
+		case xml.EndElement:
+			return t, nil
+		}
+	}
+}
+
+// newEntry returns a new message of the entry type of a wrapper field
+func newEntry(t reflect.Type) (proto.Message, bool) {
+	if t == nil {
+		return nil, false
+	}
+	t = elementType(t)
+	if t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	msg, ok := reflect.New(t).Interface().(proto.Message)
+	return msg, ok
+}
+
+// StreamProgress reports how far ValidateStream has got
+type StreamProgress struct {
+	Path    string // the entry just validated
+	Entries int    // list entries validated so far
+	Errors  int    // problems found so far
+	Offset  int64  // bytes of input read so far, compressed if gzipped
+}
+
+// ValidateStream runs the checks of Validate over the ERN message read from
+// r while it is decoded with StreamResources, so multi-gigabyte deliveries
+// can be validated without holding the whole message. Problems are reported
+// with the same paths Validate would use. progress, if not nil, is called
+// after each entry. The error is non-nil only when the document can't be
+// decoded; the problems found up to that point are returned with it.
+func ValidateStream(r io.Reader, progress func(StreamProgress)) ([]error, error) {
+	var errs []error
+	counter := &countingReader{r: r}
+	entries := 0
+	streamed := make(map[string]map[string]int) // entries of each wrapper by element name
+	root, _, err := StreamResources(counter, func(path string, entry proto.Message) error {
+		wrapper, name, _ := strings.Cut(path, ".")
+		name, _, _ = strings.Cut(name, "[")
+		if streamed[wrapper] == nil {
+			streamed[wrapper] = make(map[string]int)
+		}
+		streamed[wrapper][name]++
+
+		errs = append(errs, validateRequired(path, entry, true)...)
+		errs = append(errs, validateIdentifiers(path, entry)...)
+		entries++
+		if progress != nil {
+			progress(StreamProgress{Path: path, Entries: entries, Errors: len(errs), Offset: counter.n})
+		}
+		return nil
+	})
+	if err != nil {
+		return errs, err
+	}
+
+	errs = append(errs, validateRequired("", root, false)...)
+	errs = append(errs, missingWrapperFields(root, streamed)...)
+	errs = append(errs, validateIdentifiers("", root)...)
+	return errs, nil
+}
+
+// missingWrapperFields reports the required children the top-level *List
+// wrappers of root lack, counting the entries streamed out of each since
+// root keeps none of them
+func missingWrapperFields(root proto.Message, streamed map[string]map[string]int) []error {
+	schema, ok := SchemaOf(root)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	_ = Walk(root, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() == protoreflect.MessageKind && strings.HasSuffix(path, "List") {
+			errs = append(errs, missingFields(schema, path, v.Message(), streamed[path])...)
+		}
+		return SkipChildren
+	})
+	return errs
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
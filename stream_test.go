@@ -0,0 +1,208 @@
+package ddex
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestStreamResources checks that streamed entries add up to the message
+// ParseERN decodes
+func TestStreamResources(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+	want, _, err := ParseERN(xmlData)
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	full := want.(*ernv43.NewReleaseMessage)
+
+	t.Run("Entries", func(t *testing.T) {
+		var paths []string
+		recordings := make(map[string]*ernv43.SoundRecording)
+		msg, version, err := StreamResources(bytes.NewReader(xmlData), func(path string, entry proto.Message) error {
+			paths = append(paths, path)
+			if recording, ok := entry.(*ernv43.SoundRecording); ok {
+				recordings[path] = recording
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("StreamResources failed: %v", err)
+		}
+		if version != ERNv43 {
+			t.Errorf("Expected version %s, got %s", ERNv43, version)
+		}
+
+		root, ok := msg.(*ernv43.NewReleaseMessage)
+		if !ok {
+			t.Fatalf("Expected *ernv43.NewReleaseMessage, got %T", msg)
+		}
+		if root.GetMessageId() != full.GetMessageId() || root.GetXmlnsErn() != full.GetXmlnsErn() {
+			t.Errorf("Expected the header and attributes on the root, got %s", root.Summary())
+		}
+		if len(root.SoundRecordings()) != 0 || root.GetReleaseList().GetRelease() != nil {
+			t.Error("Expected the list entries to be streamed, not kept on the root")
+		}
+
+		if len(recordings) != len(full.SoundRecordings()) {
+			t.Fatalf("Streamed %d sound recordings, want %d", len(recordings), len(full.SoundRecordings()))
+		}
+		if !proto.Equal(recordings["ResourceList.SoundRecording[1]"], full.SoundRecordings()[1]) {
+			t.Error("Expected the streamed sound recording to equal the parsed one")
+		}
+		if !slices.Contains(paths, "ReleaseList.Release") || !slices.Contains(paths, "DealList.ReleaseDeal[0]") {
+			t.Errorf("Expected paths of releases and deals, got %v", paths)
+		}
+		if !slices.Contains(paths, "PartyList.Party[0]") || !slices.Contains(paths, "ResourceList.Image[0]") {
+			t.Errorf("Expected paths of parties and images, got %v", paths)
+		}
+	})
+
+	t.Run("Stop", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		_, _, err := StreamResources(bytes.NewReader(xmlData), func(path string, entry proto.Message) error {
+			calls++
+			return stop
+		})
+		if !errors.Is(err, stop) || calls != 1 {
+			t.Errorf("Expected the stream to stop after one entry, got %d calls and %v", calls, err)
+		}
+	})
+
+	t.Run("Not ERN", func(t *testing.T) {
+		_, _, err := StreamResources(strings.NewReader(`<Foo xmlns="http://example.com"/>`), func(string, proto.Message) error { return nil })
+		if err == nil {
+			t.Error("Expected an error for a non-ERN document")
+		}
+	})
+}
+
+// TestValidateStream checks that streaming validation finds what Validate
+// finds, with the same paths
+func TestValidateStream(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+	broken := bytes.Replace(xmlData, []byte("<ISRC>JPTO09404910</ISRC>"), []byte("<ISRC>bad</ISRC>"), 1)
+	broken = bytes.Replace(broken, []byte("<MessageId>"), []byte("<MessageId>x"), 1)
+
+	t.Run("Sample", func(t *testing.T) {
+		var last StreamProgress
+		calls := 0
+		errs, err := ValidateStream(bytes.NewReader(xmlData), func(p StreamProgress) {
+			calls++
+			if p.Entries != calls || p.Offset < last.Offset {
+				t.Errorf("Expected progress to advance, got %+v after %+v", p, last)
+			}
+			last = p
+		})
+		if err != nil {
+			t.Fatalf("ValidateStream failed: %v", err)
+		}
+		if len(errs) != 0 {
+			t.Errorf("Expected no problems, got %v", errs)
+		}
+		if calls == 0 || last.Offset == 0 {
+			t.Errorf("Expected progress callbacks, got %d ending at %+v", calls, last)
+		}
+	})
+
+	t.Run("Matches Validate", func(t *testing.T) {
+		msg, err := ParseDDEX(broken)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		want := errorStrings(Validate(msg))
+		if len(want) == 0 {
+			t.Fatal("Expected the broken sample to have problems")
+		}
+
+		errs, err := ValidateStream(bytes.NewReader(broken), nil)
+		if err != nil {
+			t.Fatalf("ValidateStream failed: %v", err)
+		}
+		got := errorStrings(errs)
+		slices.Sort(want)
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("ValidateStream found %v, Validate found %v", got, want)
+		}
+	})
+
+	t.Run("Empty Wrapper", func(t *testing.T) {
+		// ReleaseList may be empty in ERN 4.3, but DealList needs a ReleaseDeal
+		start := bytes.Index(xmlData, []byte("<DealList>"))
+		end := bytes.Index(xmlData, []byte("</DealList>"))
+		empty := slices.Concat(xmlData[:start], []byte("<DealList/>"), xmlData[end+len("</DealList>"):])
+
+		msg, err := ParseDDEX(empty)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		want := errorStrings(Validate(msg))
+		if !slices.ContainsFunc(want, func(s string) bool { return strings.HasPrefix(s, "DealList.ReleaseDeal") }) {
+			t.Fatalf("Expected Validate to require a ReleaseDeal, got %v", want)
+		}
+
+		errs, err := ValidateStream(bytes.NewReader(empty), nil)
+		if err != nil {
+			t.Fatalf("ValidateStream failed: %v", err)
+		}
+		got := errorStrings(errs)
+		slices.Sort(want)
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("ValidateStream found %v, Validate found %v", got, want)
+		}
+	})
+
+	t.Run("Gzip", func(t *testing.T) {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		zw.Write(broken)
+		zw.Close()
+
+		errs, err := ValidateStream(&buf, nil)
+		if err != nil {
+			t.Fatalf("ValidateStream failed: %v", err)
+		}
+		if len(errs) != 1 || !strings.Contains(errs[0].Error(), "ResourceList.SoundRecording[1]") {
+			t.Errorf("Expected the bad ISRC of the second recording, got %v", errs)
+		}
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		cut := bytes.Index(broken, []byte("<ReleaseList>"))
+		errs, err := ValidateStream(bytes.NewReader(broken[:cut+200]), nil)
+		if err == nil {
+			t.Fatal("Expected an error for a truncated document")
+		}
+		if len(errs) != 1 {
+			t.Errorf("Expected the problems found before the cut, got %v", errs)
+		}
+	})
+}
+
+// errorStrings returns the messages of errs
+func errorStrings(errs []error) []string {
+	var out []string
+	for _, err := range errs {
+		out = append(out, err.Error())
+	}
+	return out
+}
@@ -0,0 +1,181 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// UnknownFieldsError lists the elements and attributes UnmarshalStrict found
+// no struct field for, as paths like /Root/Child@Attribute in document order.
+// Repeated elements are listed once.
+type UnknownFieldsError struct {
+	Paths []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("%d unknown elements or attributes: %s", len(e.Paths), strings.Join(e.Paths, ", "))
+}
+
+// UnmarshalStrict decodes XML into v like xml.Unmarshal, then walks the
+// document token by token against v's struct tags and returns an
+// *UnknownFieldsError listing every element or attribute that was dropped
+// because no field maps to it. v is fully populated even when an
+// *UnknownFieldsError is returned. Content captured by an xs:any field and
+// namespace declarations are not reported.
+func UnmarshalStrict(data []byte, v interface{}) error {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return errors.New("UnmarshalStrict requires a non-nil pointer")
+	}
+
+	decoder := newDecoder(data)
+	start, err := nextStartElement(decoder)
+	if err != nil {
+		return err
+	}
+	if err := decoder.DecodeElement(v, &start); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", start.Name.Local, err)
+	}
+
+	checker := newDecoder(data)
+	start, err = nextStartElement(checker)
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	if err := checkKnownFields(checker, start, t, "/"+start.Name.Local, &unknown); err != nil {
+		return err
+	}
+	if len(unknown) > 0 {
+		seen := make(map[string]bool)
+		paths := unknown[:0]
+		for _, p := range unknown {
+			if !seen[p] {
+				seen[p] = true
+				paths = append(paths, p)
+			}
+		}
+		return &UnknownFieldsError{Paths: paths}
+	}
+	return nil
+}
+
+// checkKnownFields consumes the element opened by start, recording the paths
+// of attributes and child elements that t has no field for
+func checkKnownFields(d *xml.Decoder, start xml.StartElement, t reflect.Type, path string, unknown *[]string) error {
+	fields := strictFieldsOf(t)
+
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		if !fields.attrs[attr.Name.Local] && !fields.anyAttr {
+			*unknown = append(*unknown, path+"@"+attr.Name.Local)
+		}
+	}
+
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			childPath := path + "/" + token.Name.Local
+			childType, ok := fields.elements[token.Name.Local]
+			switch {
+			case ok && childType != nil:
+				if err := checkKnownFields(d, token, childType, childPath, unknown); err != nil {
+					return err
+				}
+				continue
+			case !ok && !fields.anyElement:
+				*unknown = append(*unknown, childPath)
+			}
+			if err := d.Skip(); err != nil {
+				return fmt.Errorf("failed to read %s: %w", childPath, err)
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// strictFields describes which XML names a struct type accepts
+type strictFields struct {
+	elements   map[string]reflect.Type // child element name to its type, nil when not checked further
+	attrs      map[string]bool
+	anyElement bool
+	anyAttr    bool
+}
+
+// strictFieldsCache maps a type to its strictFields
+var strictFieldsCache sync.Map
+
+// strictFieldsOf reads the xml struct tags of t, following pointers and
+// slices. Non-struct types accept no attributes or child elements.
+func strictFieldsOf(t reflect.Type) *strictFields {
+	t = elementType(t)
+	if cached, ok := strictFieldsCache.Load(t); ok {
+		return cached.(*strictFields)
+	}
+
+	fields := &strictFields{elements: make(map[string]reflect.Type), attrs: make(map[string]bool)}
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			tag := field.Tag.Get("xml")
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			if i := strings.LastIndexAny(name, " :"); i >= 0 {
+				name = name[i+1:] // drop the namespace or prefix
+			}
+			attr := strings.Contains(","+opts+",", ",attr,")
+			anyOpt := strings.Contains(","+opts+",", ",any,")
+
+			switch {
+			case attr && anyOpt:
+				fields.anyAttr = true
+			case attr:
+				if name == "" {
+					name = field.Name
+				}
+				fields.attrs[name] = true
+			case anyOpt:
+				fields.anyElement = true
+			case opts != "" && name == "":
+				// chardata, innerxml and comment fields hold no elements
+			case name == "":
+				fields.elements[field.Name] = field.Type
+			case strings.Contains(name, ">"):
+				parent, _, _ := strings.Cut(name, ">")
+				fields.elements[parent] = nil
+			default:
+				fields.elements[name] = field.Type
+			}
+		}
+	}
+
+	strictFieldsCache.Store(t, fields)
+	return fields
+}
+
+// elementType strips the pointers and slices around a field's element type
+func elementType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		t = t.Elem()
+	}
+	return t
+}
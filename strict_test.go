@@ -0,0 +1,96 @@
+package ddex
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+	piev10 "github.com/alecsavvy/ddex-go/gen/ddex/pie/v10"
+)
+
+// TestUnmarshalStrict validates detection of elements and attributes the structs cannot represent
+func TestUnmarshalStrict(t *testing.T) {
+	// The ERN 4.3.2 samples are published in the ern/43 namespace and use its elements
+	samplePath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	sample, err := os.ReadFile(samplePath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", samplePath)
+	}
+
+	t.Run("Fully Mapped Sample", func(t *testing.T) {
+		msg := &ernv43.NewReleaseMessage{}
+		if err := UnmarshalStrict(sample, msg); err != nil {
+			t.Fatalf("Expected no unknown fields, got %v", err)
+		}
+		if msg.GetMessageHeader().GetMessageId() == "" {
+			t.Error("Expected message to be decoded")
+		}
+	})
+
+	t.Run("Unknown Element And Attribute", func(t *testing.T) {
+		data := bytes.Replace(sample, []byte("<ReleaseType>"), []byte("<ReleaseTyep>"), 1)
+		data = bytes.Replace(data, []byte("</ReleaseType>"), []byte("</ReleaseTyep>"), 1)
+		data = bytes.Replace(data, []byte("<MessageId>"), []byte(`<MessageId Checksum="abc">`), 1)
+
+		msg := &ernv43.NewReleaseMessage{}
+		err := UnmarshalStrict(data, msg)
+
+		var unknownErr *UnknownFieldsError
+		if !errors.As(err, &unknownErr) {
+			t.Fatalf("Expected *UnknownFieldsError, got %v", err)
+		}
+		want := []string{
+			"/NewReleaseMessage/MessageHeader/MessageId@Checksum",
+			"/NewReleaseMessage/ReleaseList/Release/ReleaseTyep",
+		}
+		if !slices.Equal(unknownErr.Paths, want) {
+			t.Errorf("Unknown paths = %v, want %v", unknownErr.Paths, want)
+		}
+		if msg.GetMessageHeader().GetMessageId() == "" {
+			t.Error("Expected message to be decoded despite unknown fields")
+		}
+	})
+
+	t.Run("Extension Content", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "piev10", "pie_extension_example.xml")
+		data, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		if err := UnmarshalStrict(data, &piev10.PieMessage{}); err != nil {
+			t.Errorf("Expected xs:any content to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("MEAD Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "meadv11", "mead_award_example.xml")
+		data, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		if err := UnmarshalStrict(data, &meadv11.MeadMessage{}); err != nil {
+			t.Errorf("Expected no unknown fields, got %v", err)
+		}
+
+		// MainArtist is not part of the MEAD 1.1 ReleaseSummary
+		data = bytes.Replace(data, []byte("<ReleaseSummary>"), []byte("<ReleaseSummary><MainArtist/>"), 1)
+		err = UnmarshalStrict(data, &meadv11.MeadMessage{})
+		var unknownErr *UnknownFieldsError
+		if !errors.As(err, &unknownErr) || !slices.Contains(unknownErr.Paths, "/MeadMessage/ReleaseInformationList/ReleaseInformation/ReleaseSummary/MainArtist") {
+			t.Errorf("Expected MainArtist to be reported, got %v", err)
+		}
+	})
+
+	t.Run("Invalid Target", func(t *testing.T) {
+		if err := UnmarshalStrict(sample, ernv43.NewReleaseMessage{}); err == nil {
+			t.Error("Expected error for non-pointer target")
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+)
+
+// TestSummary validates the generated one-line Summary of root messages
+func TestSummary(t *testing.T) {
+	t.Run("Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+
+		msg, err := ParseDDEX(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		summarizer, ok := msg.(interface{ Summary() string })
+		if !ok {
+			t.Fatalf("%T has no Summary method", msg)
+		}
+		want := "NewReleaseMessage MessageId=W83814161 Sender=PADPIDA2007050901U Recipients=1 PartyList=3 ResourceList=2 ReleaseList=1 DealList=2"
+		if got := summarizer.Summary(); got != want {
+			t.Errorf("Summary() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("Fields", func(t *testing.T) {
+		msg := &ernv432.NewReleaseMessage{
+			MessageHeader: &ernv432.MessageHeader{
+				MessageId:        "M1",
+				MessageSender:    &ernv432.MessagingPartyWithoutCode{PartyId: "PADPIDA2007050901U"},
+				MessageRecipient: []*ernv432.MessagingPartyWithoutCode{{PartyId: "PADPIDA1"}, {PartyId: "PADPIDA2"}},
+			},
+			ReleaseList: &ernv432.ReleaseList{
+				Release:      &ernv432.Release{},
+				TrackRelease: []*ernv432.TrackRelease{{}, {}},
+			},
+			DealList: &ernv432.DealList{},
+		}
+
+		want := "NewReleaseMessage MessageId=M1 Sender=PADPIDA2007050901U Recipients=2 ReleaseList=3"
+		if got := msg.Summary(); got != want {
+			t.Errorf("Summary() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("Repeated Sender PartyId", func(t *testing.T) {
+		msg := &ernv383.PurgeReleaseMessage{
+			MessageHeader: &ernv383.MessageHeader{
+				MessageId:     "M2",
+				MessageSender: &ernv383.MessagingParty{PartyId: []*ernv383.PartyId{{Value: "PADPIDA2007050901U"}}},
+			},
+		}
+
+		want := "PurgeReleaseMessage MessageId=M2 Sender=PADPIDA2007050901U Recipients=0"
+		if got := msg.Summary(); got != want {
+			t.Errorf("Summary() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		if got := (&meadv11.Feed{}).Summary(); got != "Feed" {
+			t.Errorf("Summary() = %q, want Feed", got)
+		}
+		if got := (*meadv11.MeadMessage)(nil).Summary(); got != "MeadMessage" {
+			t.Errorf("Summary() of nil = %q, want MeadMessage", got)
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
 	"go/ast"
 	"go/parser"
@@ -8,12 +10,23 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode"
 )
 
 func main() {
+	modulePath, err := readModulePath("go.mod")
+	if err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+
+	var registry []RegistryEntry
+
 	// Find all generated protobuf packages
-	err := filepath.Walk("gen", func(path string, info os.FileInfo, err error) error {
+	err = filepath.Walk("gen", func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -42,6 +55,75 @@ func main() {
 				log.Printf("Generated enum_strings.go for package %s with %d enums", packageName, len(enums))
 			}
 
+			// Generate value constants and a value lookup for the AllowedValueSets packages
+			if len(enums) > 0 && filepath.Base(filepath.Dir(packageDir)) == "avs" {
+				err = generateValuesFile(packageDir, packageName, enums)
+				if err != nil {
+					return fmt.Errorf("generating values file for %s: %w", packageDir, err)
+				}
+				log.Printf("Generated values.go for package %s with %d value sets", packageName, len(enums))
+			}
+
+			// Generate typed Clone methods for every message in the package
+			cloneTypes, err := findCloneTypes(path)
+			if err != nil {
+				return fmt.Errorf("parsing messages %s: %w", path, err)
+			}
+			if len(cloneTypes) > 0 {
+				err = generateCloneFile(packageDir, packageName, cloneTypes)
+				if err != nil {
+					return fmt.Errorf("generating clone file for %s: %w", packageDir, err)
+				}
+				log.Printf("Generated clone.go for package %s with %d messages", packageName, len(cloneTypes))
+			}
+
+			// Generate message header accessors and one-line Summary methods for the root messages
+			roots, err := findRootTypes(path)
+			if err != nil {
+				return fmt.Errorf("parsing messages %s: %w", path, err)
+			}
+			if slices.ContainsFunc(roots, func(root RootInfo) bool { return root.Header }) {
+				err = generateHeaderFile(packageDir, packageName, roots)
+				if err != nil {
+					return fmt.Errorf("generating header file for %s: %w", packageDir, err)
+				}
+				log.Printf("Generated header.go for package %s", packageName)
+			}
+			if slices.ContainsFunc(roots, func(root RootInfo) bool { return len(root.Lists) > 0 || len(root.Indexes) > 0 }) {
+				err = generateListsFile(packageDir, packageName, roots)
+				if err != nil {
+					return fmt.Errorf("generating lists file for %s: %w", packageDir, err)
+				}
+				log.Printf("Generated lists.go for package %s", packageName)
+			}
+			if len(roots) > 0 {
+				err = generateSummaryFile(packageDir, packageName, roots)
+				if err != nil {
+					return fmt.Errorf("generating summary file for %s: %w", packageDir, err)
+				}
+				log.Printf("Generated summary.go for package %s with %d messages", packageName, len(roots))
+
+				err = generateSQLFile(packageDir, packageName, roots)
+				if err != nil {
+					return fmt.Errorf("generating sql file for %s: %w", packageDir, err)
+				}
+				log.Printf("Generated sql.go for package %s with %d messages", packageName, len(roots))
+			}
+
+			// Generate time.Duration accessors for the xs:duration elements the
+			// schema metadata lists
+			durations, err := findDurationFields(path, schemaMetadataPath(packageDir, packageName))
+			if err != nil {
+				return fmt.Errorf("parsing durations %s: %w", path, err)
+			}
+			if len(durations) > 0 {
+				err = generateDurationFile(packageDir, packageName, durations)
+				if err != nil {
+					return fmt.Errorf("generating duration file for %s: %w", packageDir, err)
+				}
+				log.Printf("Generated duration.go for package %s with %d fields", packageName, len(durations))
+			}
+
 			// Generate single XML file for all messages in the package
 			if len(messages) > 0 {
 				err = generatePackageXMLFile(packageDir, packageName, messages)
@@ -50,6 +132,21 @@ func main() {
 				}
 				log.Printf("Generated %s.xml.go for package %s with %d messages", packageName, packageName, len(messages))
 			}
+
+			// Collect root messages for the top-level registry
+			if nsInfo := deriveNamespaceInfo(packageDir); nsInfo != nil {
+				for _, message := range messages {
+					if message.Root {
+						registry = append(registry, RegistryEntry{
+							ImportPath: modulePath + "/" + filepath.ToSlash(packageDir),
+							Alias:      nsInfo.NamespacePrefix + packageName,
+							Family:     nsInfo.NamespacePrefix,
+							Version:    strings.TrimPrefix(packageName, "v"),
+							Message:    message.Name,
+						})
+					}
+				}
+			}
 		}
 
 		return nil
@@ -58,6 +155,114 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error: %v", err)
 	}
+
+	if err := os.WriteFile("registry_gen.go", []byte(generateRegistryContent(registry)), 0644); err != nil {
+		log.Fatalf("Error: %v", err)
+	}
+	log.Printf("Generated registry_gen.go with %d root messages", len(registry))
+}
+
+// writeGeneratedFile writes a file generated for the package in its
+// directory, adding the provenance of the package's .pb.go below the first
+// line so the file records which schema snapshot it came from
+func writeGeneratedFile(path, content string) error {
+	pbPath := filepath.Join(filepath.Dir(path), filepath.Base(filepath.Dir(path))+".pb.go")
+	provenance, err := readProvenance(pbPath)
+	if err != nil {
+		return err
+	}
+	header, rest, _ := strings.Cut(content, "\n")
+	return os.WriteFile(path, []byte(header+"\n"+provenance+rest), 0644)
+}
+
+// readProvenance returns the comment lines recording the source of a .pb.go
+// file: its name, the xsd2proto run that produced its .proto and the schema
+// files that fed it, as found in the header xsd2proto writes above the
+// protoc-gen-go one
+func readProvenance(pbPath string) (string, error) {
+	f, err := os.Open(pbPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	source := "// Source: " + filepath.Base(pbPath)
+	var schemas strings.Builder
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if rest, ok := strings.CutPrefix(line, "// Generated by "); ok {
+			source += ", from protos generated by " + rest
+		} else if strings.HasPrefix(line, "// Schema: ") {
+			schemas.WriteString(line + "\n")
+		} else if line != "" {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("reading %s: %w", pbPath, err)
+	}
+	return source + "\n" + schemas.String(), nil
+}
+
+// RegistryEntry is a root message registered with the top-level ddex registry
+type RegistryEntry struct {
+	ImportPath string // e.g. github.com/alecsavvy/ddex-go/gen/ddex/ern/v432
+	Alias      string // e.g. ernv432
+	Family     string // e.g. ern
+	Version    string // e.g. 432
+	Message    string // e.g. NewReleaseMessage
+}
+
+// readModulePath returns the module path declared in go.mod
+func readModulePath(goModPath string) (string, error) {
+	data, err := os.ReadFile(goModPath)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.TrimSpace(rest), nil
+		}
+	}
+	return "", fmt.Errorf("no module directive in %s", goModPath)
+}
+
+// generateRegistryContent creates registry_gen.go, which lists every root
+// message for ddex.Supported and registers their factories with the ddex
+// package's DefaultRegistry
+func generateRegistryContent(entries []RegistryEntry) string {
+	var sb strings.Builder
+
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString("package ddex\n\n")
+
+	sb.WriteString("import (\n")
+	imported := make(map[string]bool)
+	for _, entry := range entries {
+		if !imported[entry.ImportPath] {
+			sb.WriteString(fmt.Sprintf("\t%s \"%s\"\n", entry.Alias, entry.ImportPath))
+			imported[entry.ImportPath] = true
+		}
+	}
+	sb.WriteString("\n\t\"google.golang.org/protobuf/proto\"\n")
+	sb.WriteString(")\n\n")
+
+	sb.WriteString("// supportedMessages lists the root message of every generated package\n")
+	sb.WriteString("var supportedMessages = []SupportedMessage{\n")
+	for _, entry := range entries {
+		sb.WriteString(fmt.Sprintf("\t{Family: %q, Version: %q, Namespace: %s.Namespace, Root: %q, GoType: %q, factory: func() proto.Message { return %s.New%s() }},\n",
+			entry.Family, entry.Version, entry.Alias, entry.Message, entry.ImportPath+"."+entry.Message, entry.Alias, entry.Message))
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("func init() {\n")
+	sb.WriteString("\tfor _, m := range supportedMessages {\n")
+	sb.WriteString("\t\tDefaultRegistry.Register(m.Namespace, m.Root, m.factory)\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("}\n")
+
+	return sb.String()
 }
 
 // findEnumTypes parses a .pb.go file and extracts enum type information
@@ -80,11 +285,12 @@ func findEnumTypes(filename string) ([]EnumInfo, error) {
 						if ident, ok := ts.Type.(*ast.Ident); ok && ident.Name == "int32" {
 							// Found an enum type - now find its constants
 							enumName := ts.Name.Name
-							constants := findEnumConstants(node, enumName)
+							constants, values := findEnumConstants(node, enumName)
 							if len(constants) > 0 {
 								enums = append(enums, EnumInfo{
 									Name:      enumName,
 									Constants: constants,
+									Values:    values,
 								})
 							}
 						}
@@ -97,9 +303,11 @@ func findEnumTypes(filename string) ([]EnumInfo, error) {
 	return enums, nil
 }
 
-// findEnumConstants finds all constants for a given enum type
-func findEnumConstants(node *ast.File, enumTypeName string) []string {
+// findEnumConstants finds all constants for a given enum type, along with the
+// XSD value each one was generated from as recorded in its @xml comment
+func findEnumConstants(node *ast.File, enumTypeName string) ([]string, map[string]string) {
 	var constants []string
+	values := make(map[string]string)
 
 	for _, decl := range node.Decls {
 		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.CONST {
@@ -109,6 +317,9 @@ func findEnumConstants(node *ast.File, enumTypeName string) []string {
 					if ident, ok := valueSpec.Type.(*ast.Ident); ok && ident.Name == enumTypeName {
 						for _, name := range valueSpec.Names {
 							constants = append(constants, name.Name)
+							if value, ok := xmlValueComment(valueSpec.Doc); ok {
+								values[name.Name] = value
+							}
 						}
 					}
 				}
@@ -116,16 +327,34 @@ func findEnumConstants(node *ast.File, enumTypeName string) []string {
 		}
 	}
 
-	return constants
+	return constants, values
+}
+
+// xmlValueComment reads the quoted XSD value from an "@xml:" comment
+func xmlValueComment(doc *ast.CommentGroup) (string, bool) {
+	if doc == nil {
+		return "", false
+	}
+	for _, c := range doc.List {
+		if quoted, ok := strings.CutPrefix(c.Text, "// @xml: "); ok {
+			value, err := strconv.Unquote(quoted)
+			return value, err == nil
+		}
+	}
+	return "", false
 }
 
 type EnumInfo struct {
 	Name      string
 	Constants []string
+	Values    map[string]string // constant to XSD value
 }
 
 type MessageInfo struct {
-	Name string
+	Name     string
+	Root     bool     // root element carrying namespace attributes (xmlns:*, xsi:schemaLocation)
+	Wildcard bool     // xs:any container holding a foreign element as raw XML
+	Fields   []string // exported fields of a root, copied by its MarshalXML
 }
 
 // findMessageTypes parses a .pb.go file and extracts main message types
@@ -138,7 +367,8 @@ func findMessageTypes(filename string) ([]MessageInfo, error) {
 
 	var messages []MessageInfo
 
-	// Look for main message type definitions (ones ending with "Message")
+	// Look for root message types, marked by the xmlns:xsi field xsd2proto adds
+	// to every top-level element whatever its name (NewReleaseMessage, Feed)
 	for _, decl := range node.Decls {
 		switch d := decl.(type) {
 		case *ast.GenDecl:
@@ -146,12 +376,11 @@ func findMessageTypes(filename string) ([]MessageInfo, error) {
 				for _, spec := range d.Specs {
 					if ts, ok := spec.(*ast.TypeSpec); ok {
 						if _, ok := ts.Type.(*ast.StructType); ok {
-							// Found a struct type - check if it's a main message type
 							messageName := ts.Name.Name
-							if strings.HasSuffix(messageName, "Message") {
-								messages = append(messages, MessageInfo{
-									Name: messageName,
-								})
+							if hasField(ts.Type.(*ast.StructType), "XmlnsXsi") {
+								messages = append(messages, MessageInfo{Name: messageName, Root: true, Fields: exportedFields(ts.Type.(*ast.StructType))})
+							} else if messageName == "AnyElement" && hasField(ts.Type.(*ast.StructType), "RawXml") {
+								messages = append(messages, MessageInfo{Name: messageName, Wildcard: true})
 							}
 						}
 					}
@@ -163,167 +392,976 @@ func findMessageTypes(filename string) ([]MessageInfo, error) {
 	return messages, nil
 }
 
-// generateEnumStringsFile creates an enum_strings.go file with String() methods and parsers
-func generateEnumStringsFile(packageDir, packageName string, enums []EnumInfo) error {
-	content := generateEnumStringsContent(packageName, enums)
+// findCloneTypes parses a .pb.go file and returns the names of all generated
+// message structs, identified by protoimpl's state field
+func findCloneTypes(filename string) ([]string, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
 
-	enumStringsPath := filepath.Join(packageDir, "enum_strings.go")
-	return os.WriteFile(enumStringsPath, []byte(content), 0644)
+	var names []string
+	for _, decl := range node.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+			for _, spec := range genDecl.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					if st, ok := ts.Type.(*ast.StructType); ok && hasField(st, "state") {
+						names = append(names, ts.Name.Name)
+					}
+				}
+			}
+		}
+	}
+
+	return names, nil
 }
 
-// generatePackageXMLFile creates a single XML file for all messages in a package
-func generatePackageXMLFile(packageDir, packageName string, messages []MessageInfo) error {
-	content := generatePackageXMLContent(packageDir, packageName, messages)
+// generateCloneFile creates a clone.go file with a Clone method per message
+func generateCloneFile(packageDir, packageName string, names []string) error {
+	content := generateCloneContent(packageName, names)
 
-	xmlFileName := packageName + ".xml.go"
-	xmlPath := filepath.Join(packageDir, xmlFileName)
-	return os.WriteFile(xmlPath, []byte(content), 0644)
+	clonePath := filepath.Join(packageDir, "clone.go")
+	return writeGeneratedFile(clonePath, content)
 }
 
-// generateEnumStringsContent creates the content for enum_strings.go
-func generateEnumStringsContent(packageName string, enums []EnumInfo) string {
+// generateCloneContent creates the content for clone.go. proto.Clone already
+// copies nested messages and repeated fields deeply; the generated methods
+// spare callers the type assertion.
+func generateCloneContent(packageName string, names []string) string {
 	var sb strings.Builder
 
-	// Package header
-	sb.WriteString(fmt.Sprintf("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n"))
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
 	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	sb.WriteString("import \"google.golang.org/protobuf/proto\"\n")
 
-	if len(enums) > 0 {
-		sb.WriteString("import \"strings\"\n\n")
-	}
-
-	// Generate String() methods and parsers for each enum
-	// These allow developers to use type-safe enum constants with string fields
-	for _, enum := range enums {
-		sb.WriteString(generateEnumStringMethod(enum))
-		sb.WriteString("\n\n")
-		sb.WriteString(generateEnumParser(enum))
-		sb.WriteString("\n\n")
+	for _, name := range names {
+		sb.WriteString("\n// Clone returns a deep copy of m, sharing no pointers or slices with it\n")
+		sb.WriteString(fmt.Sprintf("func (m *%s) Clone() *%s {\n", name, name))
+		sb.WriteString("\tif m == nil {\n")
+		sb.WriteString("\t\treturn nil\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString(fmt.Sprintf("\treturn proto.Clone(m).(*%s)\n", name))
+		sb.WriteString("}\n")
 	}
 
 	return sb.String()
 }
 
-// NamespaceInfo holds namespace configuration for a package
-type NamespaceInfo struct {
-	Namespace       string
-	NamespacePrefix string
-	SchemaFile      string
+// RootInfo describes the fields of a root message that its header accessors
+// and Summary read
+type RootInfo struct {
+	Name      string
+	Header    bool           // has a MessageHeader
+	SenderIDs bool           // the sender's PartyId is repeated (ERN 3)
+	Counts    []SummaryCount // populated lists, in field order
+	Lists     []ListAccessor // entries of the *List wrappers
+	Indexes   []ListIndex    // reference indexes of the *List wrappers
 }
 
-// deriveNamespaceInfo extracts namespace info from package directory path
-func deriveNamespaceInfo(packageDir string) *NamespaceInfo {
-	// packageDir is something like "gen/ddex/ern/v432"
-	// We want to extract: ddex type (ern/mead/pie), version (432/43/11/10)
+// ListAccessor is an entry field of a *List wrapper on a root message,
+// e.g. the SoundRecording of a ResourceList
+type ListAccessor struct {
+	Method   string // e.g. SoundRecordings
+	Wrapper  string // e.g. ResourceList
+	Field    string // e.g. SoundRecording
+	Type     string // e.g. SoundRecording
+	Singular bool   // a single entry rather than a repeated field
+}
 
-	parts := strings.Split(filepath.Clean(packageDir), string(filepath.Separator))
-	if len(parts) < 4 {
-		return nil // Not a DDEX package
+// ListIndex is a *List wrapper type whose entries can be indexed by the
+// reference naming them, e.g. the ResourceList by ResourceReference
+type ListIndex struct {
+	Wrapper string // wrapper type, e.g. ResourceList
+	Entries []IndexEntry
+	All     bool // several entry kinds share one key, so the wrapper gets an Index of all of them
+}
+
+// IndexEntry is an entry field of a list wrapper and the reference it is
+// keyed by
+type IndexEntry struct {
+	Method   string // e.g. SoundRecordingIndex
+	Field    string // e.g. SoundRecording
+	Type     string // e.g. SoundRecording
+	Key      string // e.g. ResourceReference
+	Singular bool   // a single entry rather than a repeated field
+	KeyList  bool   // the key is repeated (ERN 3 ReleaseReference)
+	Grouped  bool   // the key points at another entry, so several entries share it
+}
+
+// indexKeys are the references declaring the anchor of a list entry besides
+// <Type>Reference, in order of preference
+var indexKeys = []string{"ResourceReference", "ReleaseReference", "PartyReference", "VisibilityReference"}
+
+// groupKeys are the references an entry is indexed by when it declares no
+// anchor of its own, e.g. the ReleaseDeals of a release
+var groupKeys = []string{"DealReleaseReference"}
+
+// SummaryCount is a root field whose size Summary reports
+type SummaryCount struct {
+	Field     string
+	Container bool // a *List wrapper counted by its entries rather than a repeated field
+}
+
+// findRootTypes parses a .pb.go file and describes the header and list
+// fields of each root message (marked by XmlnsXsi)
+func findRootTypes(filename string) ([]RootInfo, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	// Look for the ddex directory and extract type/version
-	ddexIndex := -1
-	for i, part := range parts {
-		if part == "ddex" {
-			ddexIndex = i
-			break
+	structs := make(map[string]*ast.StructType)
+	var order []string
+	for _, decl := range node.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+			for _, spec := range genDecl.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					if st, ok := ts.Type.(*ast.StructType); ok {
+						structs[ts.Name.Name] = st
+						order = append(order, ts.Name.Name)
+					}
+				}
+			}
 		}
 	}
 
-	if ddexIndex == -1 || ddexIndex+2 >= len(parts) {
-		return nil // Not found or not enough parts
-	}
+	var roots []RootInfo
+	for _, name := range order {
+		st := structs[name]
+		if !hasField(st, "XmlnsXsi") {
+			continue
+		}
 
-	messageType := parts[ddexIndex+1] // ern, mead, pie
-	version := parts[ddexIndex+2]     // v432, v43, v11, etc.
+		root := RootInfo{Name: name}
+		for _, field := range st.Fields.List {
+			if len(field.Names) != 1 || !field.Names[0].IsExported() {
+				continue
+			}
+			fieldName := field.Names[0].Name
+			switch t := field.Type.(type) {
+			case *ast.StarExpr:
+				ident, ok := t.X.(*ast.Ident)
+				if !ok {
+					continue
+				}
+				if fieldName == "MessageHeader" {
+					root.Header = true
+					root.SenderIDs = senderHasPartyIDs(structs, ident.Name)
+				} else if strings.HasSuffix(fieldName, "List") {
+					root.Counts = append(root.Counts, SummaryCount{Field: fieldName, Container: true})
+					root.Lists = append(root.Lists, listAccessors(structs[ident.Name], fieldName)...)
+					if index := listIndex(structs, ident.Name); index != nil {
+						root.Indexes = append(root.Indexes, *index)
+					}
+				}
+			case *ast.ArrayType:
+				if _, ok := t.Elt.(*ast.StarExpr); ok && fieldName != "AnyElement" {
+					root.Counts = append(root.Counts, SummaryCount{Field: fieldName})
+				}
+			}
+		}
+		// An accessor is dropped when its name is taken by a field of the
+		// root or an entry of another wrapper
+		methods := make(map[string]int)
+		for _, list := range root.Lists {
+			methods[list.Method]++
+		}
+		root.Lists = slices.DeleteFunc(root.Lists, func(list ListAccessor) bool {
+			return methods[list.Method] > 1 || hasField(st, list.Method)
+		})
+		roots = append(roots, root)
+	}
 
-	// Remove 'v' prefix from version
-	versionNumber := strings.TrimPrefix(version, "v")
+	return roots, nil
+}
 
-	info := &NamespaceInfo{
-		NamespacePrefix: messageType,
+// listAccessors returns an accessor per message field of a list wrapper
+func listAccessors(st *ast.StructType, wrapper string) []ListAccessor {
+	if st == nil {
+		return nil
+	}
+	var lists []ListAccessor
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 1 || !field.Names[0].IsExported() || field.Names[0].Name == "AnyElement" {
+			continue
+		}
+		name := field.Names[0].Name
+		expr, singular := field.Type, true
+		if array, ok := expr.(*ast.ArrayType); ok {
+			expr, singular = array.Elt, false
+		}
+		star, ok := expr.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		// Optional attributes are pointers to predeclared scalar types
+		if ident, ok := star.X.(*ast.Ident); ok && ident.IsExported() {
+			lists = append(lists, ListAccessor{Method: plural(name), Wrapper: wrapper, Field: name, Type: ident.Name, Singular: singular})
+		}
 	}
+	return lists
+}
 
-	// Set namespace and schema file based on type
-	switch messageType {
-	case "ern":
-		info.Namespace = fmt.Sprintf("http://ddex.net/xml/ern/%s", versionNumber)
-		info.SchemaFile = "release-notification.xsd"
-	case "mead":
-		info.Namespace = fmt.Sprintf("http://ddex.net/xml/mead/%s", versionNumber)
-		info.SchemaFile = "media-enrichment-and-description.xsd"
-	case "pie":
-		info.Namespace = fmt.Sprintf("http://ddex.net/xml/pie/%s", versionNumber)
-		info.SchemaFile = "party-identification-and-enrichment.xsd"
-	default:
+// listIndex describes the indexable entries of a list wrapper type, or nil
+// when none declares a reference. An index whose name is taken by a field
+// of the wrapper is dropped.
+func listIndex(structs map[string]*ast.StructType, wrapper string) *ListIndex {
+	st := structs[wrapper]
+	index := &ListIndex{Wrapper: wrapper, All: true}
+	for _, list := range listAccessors(st, wrapper) {
+		entry := IndexEntry{Method: list.Field + "Index", Field: list.Field, Type: list.Type, Singular: list.Singular}
+		if hasField(st, entry.Method) {
+			continue
+		}
+		candidates := append([]string{list.Type + "Reference"}, indexKeys...)
+		for _, key := range append(candidates, groupKeys...) {
+			switch t := fieldType(structs[list.Type], key).(type) {
+			case *ast.Ident:
+				if t.Name == "string" {
+					entry.Key = key
+				}
+			case *ast.ArrayType:
+				if ident, ok := t.Elt.(*ast.Ident); ok && ident.Name == "string" {
+					entry.Key, entry.KeyList = key, true
+				}
+			}
+			if entry.Key != "" {
+				entry.Grouped = slices.Contains(groupKeys, key)
+				break
+			}
+		}
+		if entry.Key == "" {
+			continue
+		}
+		index.All = index.All && !entry.Grouped && (len(index.Entries) == 0 || index.Entries[0].Key == entry.Key)
+		index.Entries = append(index.Entries, entry)
+	}
+	if len(index.Entries) == 0 {
 		return nil
 	}
-
-	return info
+	index.All = index.All && len(index.Entries) > 1 && !hasField(st, "Index")
+	return index
 }
 
-// generatePackageXMLContent creates the content for a package XML file
-func generatePackageXMLContent(packageDir, packageName string, messages []MessageInfo) string {
-	var sb strings.Builder
-
-	// Package header
-	sb.WriteString(fmt.Sprintf("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n"))
-	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
-	sb.WriteString("import \"encoding/xml\"\n\n")
+// uncountable are the endings of entry names read as plurals already, e.g.
+// SheetMusic or ReleaseInformation
+var uncountable = []string{"Information", "MIDI", "Music", "Software"}
+
+// plural returns the plural of an entry name, e.g. Parties for Party
+func plural(name string) string {
+	switch {
+	case slices.ContainsFunc(uncountable, func(suffix string) bool { return strings.HasSuffix(name, suffix) }):
+		return name
+	case strings.HasSuffix(name, "y") && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(name, "s"), strings.HasSuffix(name, "x"), strings.HasSuffix(name, "ch"), strings.HasSuffix(name, "sh"):
+		return name + "es"
+	}
+	return name + "s"
+}
 
-	// Derive namespace info from package path
-	nsInfo := deriveNamespaceInfo(packageDir)
-	if nsInfo != nil {
-		sb.WriteString("// Package-level namespace constants\n")
-		sb.WriteString("const (\n")
-		sb.WriteString(fmt.Sprintf("\tNamespace = \"%s\"\n", nsInfo.Namespace))
-		sb.WriteString(fmt.Sprintf("\tNamespacePrefix = \"%s\"\n", nsInfo.NamespacePrefix))
-		sb.WriteString(fmt.Sprintf("\tSchemaLocation = \"%s %s/%s\"\n", nsInfo.Namespace, nsInfo.Namespace, nsInfo.SchemaFile))
-		sb.WriteString("\tNamespaceXSI = \"http://www.w3.org/2001/XMLSchema-instance\"\n")
-		sb.WriteString(")\n\n")
+// senderHasPartyIDs reports whether the MessageSender of the named header
+// type holds a repeated PartyId rather than a single string
+func senderHasPartyIDs(structs map[string]*ast.StructType, header string) bool {
+	sender := fieldType(structs[header], "MessageSender")
+	star, ok := sender.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	if !ok {
+		return false
 	}
+	_, ok = fieldType(structs[ident.Name], "PartyId").(*ast.ArrayType)
+	return ok
+}
 
-	// Generate XML marshaling methods for all messages in the package
-	for i, message := range messages {
-		if i > 0 {
-			sb.WriteString("\n\n")
+// fieldType returns the type of the named field, or nil
+func fieldType(st *ast.StructType, name string) ast.Expr {
+	if st == nil {
+		return nil
+	}
+	for _, field := range st.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return field.Type
+			}
 		}
-		sb.WriteString(generateXMLMarshalingMethods(message, nsInfo))
 	}
+	return nil
+}
 
-	return sb.String()
+// generateHeaderFile creates a header.go file with message header accessors
+// per root message
+func generateHeaderFile(packageDir, packageName string, roots []RootInfo) error {
+	content := generateHeaderContent(packageName, roots)
+
+	headerPath := filepath.Join(packageDir, "header.go")
+	return writeGeneratedFile(headerPath, content)
 }
 
-// generateEnumStringMethod creates a String() method for the enum type
-func generateEnumStringMethod(enum EnumInfo) string {
+// generateHeaderContent creates the content for header.go. The accessors
+// return plain strings, as each version's MessageHeader is a distinct type,
+// so generic code can read the envelope through ddex.Header.
+func generateHeaderContent(packageName string, roots []RootInfo) string {
 	var sb strings.Builder
 
-	sb.WriteString(fmt.Sprintf("// XMLString returns the XML string representation of %s\n", enum.Name))
-	sb.WriteString(fmt.Sprintf("func (e %s) XMLString() string {\n", enum.Name))
-	sb.WriteString("\tswitch e {\n")
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString(fmt.Sprintf("package %s\n", packageName))
 
-	// Generate cases for each constant
-	for _, constant := range enum.Constants {
-		if strings.HasSuffix(constant, "_UNSPECIFIED") {
-			continue // Skip UNSPECIFIED values
+	senderIDs := false
+	for _, root := range roots {
+		if !root.Header {
+			continue
 		}
+		senderIDs = senderIDs || root.SenderIDs
 
-		// Extract the meaningful part of the constant name
-		upperName := strings.ToUpper(enum.Name)
-		idx := strings.LastIndex(constant, upperName+"_")
-		if idx >= 0 {
-			afterPrefix := constant[idx+len(upperName)+1:]
-			if afterPrefix != "" && afterPrefix != "UNSPECIFIED" {
-				sb.WriteString(fmt.Sprintf("\tcase %s:\n", constant))
-				sb.WriteString(fmt.Sprintf("\t\treturn \"%s\"\n", afterPrefix))
-			}
+		sender := "m.GetMessageHeader().GetMessageSender().GetPartyId()"
+		if root.SenderIDs {
+			sender = "firstPartyId(m.GetMessageHeader().GetMessageSender().GetPartyId())"
 		}
-	}
 
-	sb.WriteString("\tdefault:\n")
-	sb.WriteString("\t\treturn \"\"\n")
-	sb.WriteString("\t}\n")
-	sb.WriteString("}")
+		sb.WriteString("\n// GetMessageId returns the MessageId of the message header\n")
+		sb.WriteString(fmt.Sprintf("func (m *%s) GetMessageId() string {\n", root.Name))
+		sb.WriteString("\treturn m.GetMessageHeader().GetMessageId()\n")
+		sb.WriteString("}\n")
+		sb.WriteString("\n// GetMessageSender returns the PartyId of the message sender\n")
+		sb.WriteString(fmt.Sprintf("func (m *%s) GetMessageSender() string {\n", root.Name))
+		sb.WriteString(fmt.Sprintf("\treturn %s\n", sender))
+		sb.WriteString("}\n")
+		sb.WriteString("\n// GetCreatedDateTime returns the MessageCreatedDateTime of the message header\n")
+		sb.WriteString(fmt.Sprintf("func (m *%s) GetCreatedDateTime() string {\n", root.Name))
+		sb.WriteString("\treturn m.GetMessageHeader().GetMessageCreatedDateTime()\n")
+		sb.WriteString("}\n")
+	}
+
+	if senderIDs {
+		sb.WriteString("\n// firstPartyId returns the value of the first PartyId, or \"\"\n")
+		sb.WriteString("func firstPartyId(ids []*PartyId) string {\n")
+		sb.WriteString("\tif len(ids) == 0 {\n")
+		sb.WriteString("\t\treturn \"\"\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString("\treturn ids[0].GetValue()\n")
+		sb.WriteString("}\n")
+	}
+
+	return sb.String()
+}
+
+// generateListsFile creates a lists.go file with accessors per entry of the
+// list wrappers of each root message
+func generateListsFile(packageDir, packageName string, roots []RootInfo) error {
+	content := generateListsContent(packageName, roots)
+
+	listsPath := filepath.Join(packageDir, "lists.go")
+	return writeGeneratedFile(listsPath, content)
+}
+
+// generateListsContent creates the content for lists.go. Each accessor
+// reaches through the wrapper, so msg.SoundRecordings() stands in for
+// msg.GetResourceList().GetSoundRecording() and is nil when the wrapper is
+// absent. A singular entry is returned as a slice of at most one.
+//
+// Each list wrapper also gets an index per entry kind keyed by its
+// reference, e.g. msg.GetResourceList().ImageIndex()["A2"], so lookups in
+// hot loops avoid scanning the list.
+func generateListsContent(packageName string, roots []RootInfo) string {
+	var sb strings.Builder
+
+	var indexes []ListIndex
+	for _, root := range roots {
+		for _, index := range root.Indexes {
+			if !slices.ContainsFunc(indexes, func(seen ListIndex) bool { return seen.Wrapper == index.Wrapper }) {
+				indexes = append(indexes, index)
+			}
+		}
+	}
+
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString(fmt.Sprintf("package %s\n", packageName))
+	if slices.ContainsFunc(indexes, func(index ListIndex) bool { return index.All }) {
+		sb.WriteString("\nimport \"google.golang.org/protobuf/proto\"\n")
+	}
+
+	for _, root := range roots {
+		for _, list := range root.Lists {
+			sb.WriteString(fmt.Sprintf("\n// %s returns the %s entries of the %s, or nil\n", list.Method, list.Field, list.Wrapper))
+			sb.WriteString(fmt.Sprintf("func (m *%s) %s() []*%s {\n", root.Name, list.Method, list.Type))
+			if list.Singular {
+				sb.WriteString(fmt.Sprintf("\tif entry := m.Get%s().Get%s(); entry != nil {\n", list.Wrapper, list.Field))
+				sb.WriteString(fmt.Sprintf("\t\treturn []*%s{entry}\n", list.Type))
+				sb.WriteString("\t}\n")
+				sb.WriteString("\treturn nil\n")
+			} else {
+				sb.WriteString(fmt.Sprintf("\treturn m.Get%s().Get%s()\n", list.Wrapper, list.Field))
+			}
+			sb.WriteString("}\n")
+		}
+	}
+
+	for _, index := range indexes {
+		for _, entry := range index.Entries {
+			if entry.Grouped {
+				sb.WriteString(fmt.Sprintf("\n// %s returns the %s entries of the list under each of their %s\n", entry.Method, entry.Field, entry.Key))
+				sb.WriteString(fmt.Sprintf("func (l *%s) %s() map[string][]*%s {\n", index.Wrapper, entry.Method, entry.Type))
+				sb.WriteString(fmt.Sprintf("\tindex := make(map[string][]*%s)\n", entry.Type))
+				writeIndexLoop(&sb, entry, "index[key] = append(index[key], entry)", false)
+			} else {
+				sb.WriteString(fmt.Sprintf("\n// %s returns the %s entries of the list by %s.\n", entry.Method, entry.Field, entry.Key))
+				sb.WriteString("// The first entry wins when a reference is declared twice.\n")
+				sb.WriteString(fmt.Sprintf("func (l *%s) %s() map[string]*%s {\n", index.Wrapper, entry.Method, entry.Type))
+				if entry.Singular {
+					sb.WriteString(fmt.Sprintf("\tindex := make(map[string]*%s)\n", entry.Type))
+				} else {
+					sb.WriteString(fmt.Sprintf("\tindex := make(map[string]*%s, len(l.Get%s()))\n", entry.Type, entry.Field))
+				}
+				writeIndexLoop(&sb, entry, "index[key] = entry", true)
+			}
+			sb.WriteString("\treturn index\n")
+			sb.WriteString("}\n")
+		}
+
+		if index.All {
+			sb.WriteString(fmt.Sprintf("\n// Index returns every entry of the list by %s, whatever its kind.\n", index.Entries[0].Key))
+			sb.WriteString("// The first entry wins when a reference is declared twice.\n")
+			sb.WriteString(fmt.Sprintf("func (l *%s) Index() map[string]proto.Message {\n", index.Wrapper))
+			sb.WriteString("\tindex := make(map[string]proto.Message)\n")
+			for _, entry := range index.Entries {
+				writeIndexLoop(&sb, entry, "index[key] = entry", true)
+			}
+			sb.WriteString("\treturn index\n")
+			sb.WriteString("}\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// writeIndexLoop writes the loop adding the entries of a list to an index
+// under each of their non-empty keys, keeping the first entry per key when
+// first is set
+func writeIndexLoop(sb *strings.Builder, entry IndexEntry, assign string, first bool) {
+	if entry.Singular {
+		sb.WriteString(fmt.Sprintf("\tif entry := l.Get%s(); entry != nil {\n", entry.Field))
+	} else {
+		sb.WriteString(fmt.Sprintf("\tfor _, entry := range l.Get%s() {\n", entry.Field))
+	}
+	indent := "\t\t"
+	if entry.KeyList {
+		sb.WriteString(fmt.Sprintf("\t\tfor _, key := range entry.Get%s() {\n", entry.Key))
+		indent = "\t\t\t"
+	} else {
+		sb.WriteString(fmt.Sprintf("\t\tkey := entry.Get%s()\n", entry.Key))
+	}
+	if first {
+		sb.WriteString(fmt.Sprintf("%sif _, ok := index[key]; key != \"\" && !ok {\n", indent))
+	} else {
+		sb.WriteString(fmt.Sprintf("%sif key != \"\" {\n", indent))
+	}
+	sb.WriteString(fmt.Sprintf("%s\t%s\n", indent, assign))
+	sb.WriteString(fmt.Sprintf("%s}\n", indent))
+	if entry.KeyList {
+		sb.WriteString("\t\t}\n")
+	}
+	sb.WriteString("\t}\n")
+}
+
+// generateSQLFile creates a sql.go file with database/sql Scanner and
+// driver.Valuer implementations per root message
+func generateSQLFile(packageDir, packageName string, roots []RootInfo) error {
+	content := generateSQLContent(packageName, roots)
+
+	sqlPath := filepath.Join(packageDir, "sql.go")
+	return writeGeneratedFile(sqlPath, content)
+}
+
+// generateSQLContent creates the content for sql.go. Value stores a root
+// message as its XML document, or as protojson for a jsonb column when the
+// package's SQLJSON is set; Scan reads either, telling them apart by the
+// first non-space byte.
+func generateSQLContent(packageName string, roots []RootInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	sb.WriteString("import (\n")
+	sb.WriteString("\t\"bytes\"\n")
+	sb.WriteString("\t\"database/sql/driver\"\n")
+	sb.WriteString("\t\"encoding/xml\"\n")
+	sb.WriteString("\t\"fmt\"\n\n")
+	sb.WriteString("\t\"google.golang.org/protobuf/encoding/protojson\"\n")
+	sb.WriteString("\t\"google.golang.org/protobuf/proto\"\n")
+	sb.WriteString(")\n\n")
+	sb.WriteString("// SQLJSON makes Value store root messages as protojson, e.g. for a jsonb\n")
+	sb.WriteString("// column, instead of XML. Scan reads either.\n")
+	sb.WriteString("var SQLJSON bool\n")
+
+	for _, root := range roots {
+		sb.WriteString(fmt.Sprintf("\n// Scan implements sql.Scanner for %s, decoding an XML or protojson\n", root.Name))
+		sb.WriteString("// document. NULL resets the message.\n")
+		sb.WriteString(fmt.Sprintf("func (m *%s) Scan(src any) error {\n", root.Name))
+		sb.WriteString("\tvar data []byte\n")
+		sb.WriteString("\tswitch v := src.(type) {\n")
+		sb.WriteString("\tcase nil:\n")
+		sb.WriteString("\t\tproto.Reset(m)\n")
+		sb.WriteString("\t\treturn nil\n")
+		sb.WriteString("\tcase string:\n")
+		sb.WriteString("\t\tdata = []byte(v)\n")
+		sb.WriteString("\tcase []byte:\n")
+		sb.WriteString("\t\tdata = v\n")
+		sb.WriteString("\tdefault:\n")
+		sb.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"cannot scan %%T into %s\", src)\n", root.Name))
+		sb.WriteString("\t}\n\n")
+		sb.WriteString("\tproto.Reset(m)\n")
+		sb.WriteString("\tif bytes.HasPrefix(bytes.TrimSpace(data), []byte(\"{\")) {\n")
+		sb.WriteString("\t\treturn protojson.Unmarshal(data, m)\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString("\t// Start from the namespace attributes, as parsing does\n")
+		sb.WriteString(fmt.Sprintf("\tproto.Merge(m, New%s())\n", root.Name))
+		sb.WriteString("\treturn xml.Unmarshal(data, m)\n")
+		sb.WriteString("}\n")
+
+		sb.WriteString(fmt.Sprintf("\n// Value implements driver.Valuer for %s, storing the XML document,\n", root.Name))
+		sb.WriteString("// or protojson when SQLJSON is set. A nil message is stored as NULL.\n")
+		sb.WriteString(fmt.Sprintf("func (m *%s) Value() (driver.Value, error) {\n", root.Name))
+		sb.WriteString("\tif m == nil {\n")
+		sb.WriteString("\t\treturn nil, nil\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString("\tif SQLJSON {\n")
+		sb.WriteString("\t\tdata, err := protojson.Marshal(m)\n")
+		sb.WriteString("\t\treturn string(data), err\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString("\tdata, err := xml.Marshal(m)\n")
+		sb.WriteString("\treturn string(data), err\n")
+		sb.WriteString("}\n")
+	}
+
+	return sb.String()
+}
+
+// generateSummaryFile creates a summary.go file with a Summary method per root message
+func generateSummaryFile(packageDir, packageName string, roots []RootInfo) error {
+	content := generateSummaryContent(packageName, roots)
+
+	summaryPath := filepath.Join(packageDir, "summary.go")
+	return writeGeneratedFile(summaryPath, content)
+}
+
+// generateSummaryContent creates the content for summary.go. Each Summary
+// reports the message header and the size of every populated list as
+// key=value pairs, so a message can be logged without dumping it whole.
+func generateSummaryContent(packageName string, roots []RootInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	sb.WriteString("import (\n")
+	sb.WriteString("\t\"fmt\"\n")
+	sb.WriteString("\t\"strings\"\n\n")
+	sb.WriteString("\t\"google.golang.org/protobuf/proto\"\n")
+	sb.WriteString("\t\"google.golang.org/protobuf/reflect/protoreflect\"\n")
+	sb.WriteString(")\n")
+
+	for _, root := range roots {
+		sb.WriteString(fmt.Sprintf("\n// Summary describes the %s in one line, e.g. for logs\n", root.Name))
+		sb.WriteString(fmt.Sprintf("func (m *%s) Summary() string {\n", root.Name))
+		sb.WriteString("\tvar sb strings.Builder\n")
+		sb.WriteString(fmt.Sprintf("\tsb.WriteString(%q)\n", root.Name))
+		if root.Header {
+			sb.WriteString("\tif m.GetMessageHeader() != nil {\n")
+			sb.WriteString("\t\tfmt.Fprintf(&sb, \" MessageId=%s Sender=%s Recipients=%d\", m.GetMessageId(), m.GetMessageSender(), len(m.GetMessageHeader().GetMessageRecipient()))\n")
+			sb.WriteString("\t}\n")
+		}
+		for _, count := range root.Counts {
+			if count.Container {
+				sb.WriteString(fmt.Sprintf("\twriteCount(&sb, %q, countEntries(m.Get%s()))\n", count.Field, count.Field))
+			} else {
+				sb.WriteString(fmt.Sprintf("\twriteCount(&sb, %q, len(m.Get%s()))\n", count.Field, count.Field))
+			}
+		}
+		sb.WriteString("\treturn sb.String()\n")
+		sb.WriteString("}\n")
+	}
+
+	sb.WriteString("\n// writeCount appends name=n when n is positive\n")
+	sb.WriteString("func writeCount(sb *strings.Builder, name string, n int) {\n")
+	sb.WriteString("\tif n > 0 {\n")
+	sb.WriteString("\t\tfmt.Fprintf(sb, \" %s=%d\", name, n)\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("}\n")
+
+	sb.WriteString("\n// countEntries counts the entries of a list wrapper such as ReleaseList:\n")
+	sb.WriteString("// every item of its repeated message fields plus each populated singular one\n")
+	sb.WriteString("func countEntries(list proto.Message) int {\n")
+	sb.WriteString("\tcount := 0\n")
+	sb.WriteString("\tlist.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {\n")
+	sb.WriteString("\t\tswitch {\n")
+	sb.WriteString("\t\tcase fd.Kind() != protoreflect.MessageKind:\n")
+	sb.WriteString("\t\tcase fd.IsList():\n")
+	sb.WriteString("\t\t\tcount += v.List().Len()\n")
+	sb.WriteString("\t\tdefault:\n")
+	sb.WriteString("\t\t\tcount++\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\treturn true\n")
+	sb.WriteString("\t})\n")
+	sb.WriteString("\treturn count\n")
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// generateEnumStringsFile creates an enum_strings.go file with String() methods and parsers
+func generateEnumStringsFile(packageDir, packageName string, enums []EnumInfo) error {
+	content := generateEnumStringsContent(packageName, enums)
+
+	enumStringsPath := filepath.Join(packageDir, "enum_strings.go")
+	return writeGeneratedFile(enumStringsPath, content)
+}
+
+// generateValuesFile creates values.go, exposing each enum of an
+// AllowedValueSets package as its XML values
+func generateValuesFile(packageDir, packageName string, enums []EnumInfo) error {
+	content := generateValuesContent(packageName, enums)
+
+	valuesPath := filepath.Join(packageDir, "values.go")
+	return writeGeneratedFile(valuesPath, content)
+}
+
+// generateValuesContent creates the content for values.go: a constant group
+// per value set holding its XML values, named <Set><Value>, and Values,
+// which lists a set's values by name in schema order
+func generateValuesContent(packageName string, enums []EnumInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	sb.WriteString("import \"slices\"\n\n")
+
+	// Reserve the package's own type names so constants never shadow them
+	taken := make(map[string]bool)
+	for _, enum := range enums {
+		taken[enum.Name] = true
+	}
+
+	names := make(map[string][]string) // enum to constant names, in schema order
+	for _, enum := range enums {
+		var group strings.Builder
+		for _, constant := range enum.Constants {
+			value, ok := enumXMLValue(enum, constant)
+			if !ok {
+				continue
+			}
+			name := enum.Name + goIdentifier(value)
+			for i := 2; taken[name]; i++ {
+				name = fmt.Sprintf("%s%s%d", enum.Name, goIdentifier(value), i)
+			}
+			taken[name] = true
+			names[enum.Name] = append(names[enum.Name], name)
+			group.WriteString(fmt.Sprintf("\t%s = %q\n", name, value))
+		}
+		if group.Len() == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("// %s values as written in XML\n", enum.Name))
+		sb.WriteString("const (\n")
+		sb.WriteString(group.String())
+		sb.WriteString(")\n\n")
+	}
+
+	sb.WriteString("// values maps each value set to its values in schema order\n")
+	sb.WriteString("var values = map[string][]string{\n")
+	for _, enum := range enums {
+		if len(names[enum.Name]) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\t%q: {\n", enum.Name))
+		for _, name := range names[enum.Name] {
+			sb.WriteString(fmt.Sprintf("\t\t%s,\n", name))
+		}
+		sb.WriteString("\t},\n")
+	}
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// Values returns the XML values of the named value set in schema order, or\n")
+	sb.WriteString("// nil for an unknown set. The slice is a copy the caller may modify.\n")
+	sb.WriteString("func Values(set string) []string {\n")
+	sb.WriteString("\treturn slices.Clone(values[set])\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// ValueSets returns the names of every value set in the package, sorted\n")
+	sb.WriteString("func ValueSets() []string {\n")
+	sb.WriteString("\tsets := make([]string, 0, len(values))\n")
+	sb.WriteString("\tfor set := range values {\n")
+	sb.WriteString("\t\tsets = append(sets, set)\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tslices.Sort(sets)\n")
+	sb.WriteString("\treturn sets\n")
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// goIdentifier turns an XML value into the CamelCase tail of a Go
+// identifier, dropping characters Go doesn't allow, e.g. Pre-Order to
+// PreOrder. A + is spelled out so Dvd+R and DvdR stay distinct.
+func goIdentifier(value string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range value {
+		if r == '+' {
+			sb.WriteString("Plus")
+			upper = true
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) || r > unicode.MaxASCII {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// generatePackageXMLFile creates a single XML file for all messages in a package
+func generatePackageXMLFile(packageDir, packageName string, messages []MessageInfo) error {
+	content := generatePackageXMLContent(packageDir, packageName, messages)
+
+	xmlFileName := packageName + ".xml.go"
+	xmlPath := filepath.Join(packageDir, xmlFileName)
+	return writeGeneratedFile(xmlPath, content)
+}
+
+// generateEnumStringsContent creates the content for enum_strings.go
+func generateEnumStringsContent(packageName string, enums []EnumInfo) string {
+	var sb strings.Builder
+
+	// Package header
+	sb.WriteString(fmt.Sprintf("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n"))
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+
+	if len(enums) > 0 {
+		sb.WriteString("import (\n\t\"database/sql/driver\"\n\t\"encoding/xml\"\n\t\"fmt\"\n\t\"strings\"\n)\n\n")
+	}
+
+	// Generate String() methods and parsers for each enum
+	// These allow developers to use type-safe enum constants with string fields
+	for _, enum := range enums {
+		sb.WriteString(generateEnumStringMethod(enum))
+		sb.WriteString("\n\n")
+		sb.WriteString(generateEnumParser(enum))
+		sb.WriteString("\n\n")
+		sb.WriteString(generateEnumMustParser(enum))
+		sb.WriteString("\n\n")
+		sb.WriteString(generateEnumSQLMethods(enum))
+		sb.WriteString("\n\n")
+		sb.WriteString(generateEnumXMLMethods(enum))
+		sb.WriteString("\n\n")
+	}
+
+	if len(enums) > 0 {
+		sb.WriteString(generateEnumDispatcher(enums))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// generateEnumDispatcher creates ParseEnumString, which parses a value of any
+// enum in the package by the enum's name
+func generateEnumDispatcher(enums []EnumInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// ParseEnumString parses a string value of the named enum (case-insensitive),\n")
+	sb.WriteString("// reporting false for an unknown enum or value\n")
+	sb.WriteString("func ParseEnumString(enum, s string) (int32, bool) {\n")
+	sb.WriteString("\tswitch enum {\n")
+	for _, enum := range enums {
+		sb.WriteString(fmt.Sprintf("\tcase \"%s\":\n", enum.Name))
+		sb.WriteString(fmt.Sprintf("\t\tv, ok := Parse%sString(s)\n", enum.Name))
+		sb.WriteString("\t\treturn int32(v), ok\n")
+	}
+	sb.WriteString("\tdefault:\n")
+	sb.WriteString("\t\treturn 0, false\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// NamespaceInfo holds namespace configuration for a package
+type NamespaceInfo struct {
+	Namespace       string
+	NamespacePrefix string
+	SchemaFile      string
+}
+
+// deriveNamespaceInfo extracts namespace info from package directory path
+func deriveNamespaceInfo(packageDir string) *NamespaceInfo {
+	// packageDir is something like "gen/ddex/ern/v432"
+	// We want to extract: ddex type (ern/mead/pie), version (432/43/11/10)
+
+	parts := strings.Split(filepath.Clean(packageDir), string(filepath.Separator))
+	if len(parts) < 4 {
+		return nil // Not a DDEX package
+	}
+
+	// Look for the ddex directory and extract type/version
+	ddexIndex := -1
+	for i, part := range parts {
+		if part == "ddex" {
+			ddexIndex = i
+			break
+		}
+	}
+
+	if ddexIndex == -1 || ddexIndex+2 >= len(parts) {
+		return nil // Not found or not enough parts
+	}
+
+	messageType := parts[ddexIndex+1] // ern, mead, pie
+	version := parts[ddexIndex+2]     // v432, v43, v11, etc.
+
+	// Remove 'v' prefix from version
+	versionNumber := strings.TrimPrefix(version, "v")
+
+	info := &NamespaceInfo{
+		NamespacePrefix: messageType,
+	}
+
+	// Set namespace and schema file based on type
+	switch messageType {
+	case "ern":
+		info.Namespace = fmt.Sprintf("http://ddex.net/xml/ern/%s", versionNumber)
+		info.SchemaFile = "release-notification.xsd"
+	case "mead":
+		info.Namespace = fmt.Sprintf("http://ddex.net/xml/mead/%s", versionNumber)
+		info.SchemaFile = "media-enrichment-and-description.xsd"
+	case "pie":
+		info.Namespace = fmt.Sprintf("http://ddex.net/xml/pie/%s", versionNumber)
+		info.SchemaFile = "party-identification-and-enrichment.xsd"
+	default:
+		return nil
+	}
+
+	return info
+}
+
+// generatePackageXMLContent creates the content for a package XML file
+func generatePackageXMLContent(packageDir, packageName string, messages []MessageInfo) string {
+	var sb strings.Builder
+
+	// Package header
+	sb.WriteString(fmt.Sprintf("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n"))
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	hasRoot, hasWildcard := false, false
+	for _, message := range messages {
+		hasRoot = hasRoot || message.Root
+		hasWildcard = hasWildcard || message.Wildcard
+	}
+	imports := []string{"encoding/xml"}
+	switch {
+	case hasRoot:
+		imports = []string{"bytes", "encoding/xml", "fmt", "io", "strings", "", "google.golang.org/protobuf/proto"}
+	case hasWildcard:
+		imports = []string{"bytes", "encoding/xml", "fmt", "io", "strings"}
+	}
+	if len(imports) == 1 {
+		sb.WriteString(fmt.Sprintf("import \"%s\"\n\n", imports[0]))
+	} else {
+		sb.WriteString("import (\n")
+		for _, imp := range imports {
+			if imp == "" {
+				sb.WriteString("\n")
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("\t\"%s\"\n", imp))
+		}
+		sb.WriteString(")\n\n")
+	}
+
+	// Derive namespace info from package path
+	nsInfo := deriveNamespaceInfo(packageDir)
+	if nsInfo != nil {
+		sb.WriteString("// Package-level namespace constants\n")
+		sb.WriteString("const (\n")
+		sb.WriteString(fmt.Sprintf("\tNamespace = \"%s\"\n", nsInfo.Namespace))
+		sb.WriteString(fmt.Sprintf("\tNamespacePrefix = \"%s\"\n", nsInfo.NamespacePrefix))
+		sb.WriteString(fmt.Sprintf("\tSchemaLocation = \"%s %s/%s\"\n", nsInfo.Namespace, nsInfo.Namespace, nsInfo.SchemaFile))
+		sb.WriteString("\tNamespaceXSI = \"http://www.w3.org/2001/XMLSchema-instance\"\n")
+		sb.WriteString(")\n\n")
+	}
+
+	// Generate package-level Unmarshal helpers for the root messages
+	if hasRoot {
+		sb.WriteString(generateUnmarshalHelpers(messages))
+		if nsInfo != nil {
+			sb.WriteString(rootPrefixHelper)
+		}
+	}
+
+	// Generate XML marshaling methods for all messages in the package
+	for i, message := range messages {
+		if i > 0 {
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString(generateXMLMarshalingMethods(message, nsInfo))
+	}
+
+	return sb.String()
+}
+
+// rootPrefixHelper finds the prefix a parsed root element bound Namespace to,
+// so its MarshalXML can declare the same one
+const rootPrefixHelper = `// rootPrefix returns the prefix start declares Namespace under, or "" when
+// it uses NamespacePrefix or declares none
+func rootPrefix(start xml.StartElement) string {
+	prefix := ""
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "xmlns" || attr.Value != Namespace {
+			continue
+		}
+		if attr.Name.Local == NamespacePrefix {
+			return ""
+		}
+		if prefix == "" {
+			prefix = attr.Name.Local
+		}
+	}
+	return prefix
+}
+
+`
+
+// generateEnumStringMethod creates a String() method for the enum type
+func generateEnumStringMethod(enum EnumInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("// XMLString returns the XML string representation of %s\n", enum.Name))
+	sb.WriteString(fmt.Sprintf("func (e %s) XMLString() string {\n", enum.Name))
+	sb.WriteString("\tswitch e {\n")
+
+	// Generate cases for each constant
+	for _, constant := range enum.Constants {
+		if strings.HasSuffix(constant, "_UNSPECIFIED") {
+			continue // Skip UNSPECIFIED values
+		}
+
+		if value, ok := enumXMLValue(enum, constant); ok {
+			sb.WriteString(fmt.Sprintf("\tcase %s:\n", constant))
+			sb.WriteString(fmt.Sprintf("\t\treturn %s\n", strconv.Quote(value)))
+		}
+	}
+
+	sb.WriteString("\tdefault:\n")
+	sb.WriteString("\t\treturn \"\"\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("}")
 
 	return sb.String()
 }
@@ -337,27 +1375,35 @@ func generateEnumParser(enum EnumInfo) string {
 	sb.WriteString("\ts = strings.ToUpper(s)\n")
 	sb.WriteString("\tswitch s {\n")
 
-	// Generate cases for each constant
+	// Generate cases for each constant, matching the XSD value and, for
+	// values stored before XSD values were recorded, the constant's value name
+	seen := make(map[string]bool)
 	for _, constant := range enum.Constants {
 		if strings.HasSuffix(constant, "_UNSPECIFIED") {
 			continue // Skip UNSPECIFIED values
 		}
 
-		// Extract the meaningful part of the constant name
-		// Try to find the enum pattern: EnumName_ENUM_NAME_VALUE
-		// We'll look for the last occurrence of the enum name in uppercase
-		upperName := strings.ToUpper(enum.Name)
+		var labels []string
+		if value, ok := enumXMLValue(enum, constant); ok {
+			labels = append(labels, strings.ToUpper(value))
+		}
+		if name, ok := enumValueName(enum, constant); ok {
+			labels = append(labels, name)
+		}
+		labels = slices.DeleteFunc(labels, func(label string) bool {
+			defer func() { seen[label] = true }()
+			return seen[label]
+		})
+		if len(labels) == 0 {
+			continue
+		}
 
-		// Find the pattern EnumName_..._VALUE
-		idx := strings.LastIndex(constant, upperName+"_")
-		if idx >= 0 {
-			// Skip past "EnumName_..._" to get the value part
-			afterPrefix := constant[idx+len(upperName)+1:]
-			if afterPrefix != "" && afterPrefix != "UNSPECIFIED" {
-				sb.WriteString(fmt.Sprintf("\tcase \"%s\":\n", afterPrefix))
-				sb.WriteString(fmt.Sprintf("\t\treturn %s, true\n", constant))
-			}
+		quoted := make([]string, len(labels))
+		for i, label := range labels {
+			quoted[i] = strconv.Quote(label)
 		}
+		sb.WriteString(fmt.Sprintf("\tcase %s:\n", strings.Join(quoted, ", ")))
+		sb.WriteString(fmt.Sprintf("\t\treturn %s, true\n", constant))
 	}
 
 	sb.WriteString("\tdefault:\n")
@@ -368,41 +1414,318 @@ func generateEnumParser(enum EnumInfo) string {
 	return sb.String()
 }
 
-// generateXMLMarshalingMethods creates MarshalXML and UnmarshalXML methods for message types
-func generateXMLMarshalingMethods(message MessageInfo, nsInfo *NamespaceInfo) string {
+// generateEnumMustParser creates a MustParse function that panics on values
+// the enum does not define, for input already known to be valid (constants,
+// fixtures) where the zero value would otherwise be used silently
+func generateEnumMustParser(enum EnumInfo) string {
 	var sb strings.Builder
 
-	// Generate MarshalXML method
-	sb.WriteString(fmt.Sprintf("// MarshalXML implements xml.Marshaler for %s\n", message.Name))
-	sb.WriteString(fmt.Sprintf("func (m *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", message.Name))
+	sb.WriteString(fmt.Sprintf("// MustParse%sString is like Parse%sString but panics if s is not a %s value\n", enum.Name, enum.Name, enum.Name))
+	sb.WriteString(fmt.Sprintf("func MustParse%sString(s string) %s {\n", enum.Name, enum.Name))
+	sb.WriteString(fmt.Sprintf("\te, ok := Parse%sString(s)\n", enum.Name))
+	sb.WriteString("\tif !ok {\n")
+	sb.WriteString(fmt.Sprintf("\t\tpanic(fmt.Sprintf(\"invalid %s value %%q\", s))\n", enum.Name))
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn e\n")
+	sb.WriteString("}")
 
-	// Add namespace population for root message types if we have namespace info
-	if nsInfo != nil && isRootMessage(message.Name) {
-		sb.WriteString("\t// Set default namespace values if empty\n")
+	return sb.String()
+}
 
-		// Generate field name based on prefix (XmlnsErn, XmlnsMead, XmlnsPie)
-		fieldName := fmt.Sprintf("Xmlns%s", strings.Title(nsInfo.NamespacePrefix))
-		sb.WriteString(fmt.Sprintf("\tif m.%s == \"\" {\n", fieldName))
-		sb.WriteString("\t\tm." + fieldName + " = Namespace\n")
-		sb.WriteString("\t}\n")
+// generateEnumSQLMethods creates database/sql Scanner and driver.Valuer
+// implementations that store the enum as its DDEX string value
+func generateEnumSQLMethods(enum EnumInfo) string {
+	var sb strings.Builder
 
-		sb.WriteString("\tif m.XmlnsXsi == \"\" {\n")
-		sb.WriteString("\t\tm.XmlnsXsi = NamespaceXSI\n")
-		sb.WriteString("\t}\n")
+	sb.WriteString(fmt.Sprintf("// Scan implements sql.Scanner for %s, accepting the DDEX string value\n", enum.Name))
+	sb.WriteString(fmt.Sprintf("func (e *%s) Scan(src any) error {\n", enum.Name))
+	sb.WriteString("\tvar s string\n")
+	sb.WriteString("\tswitch v := src.(type) {\n")
+	sb.WriteString("\tcase nil:\n")
+	sb.WriteString(fmt.Sprintf("\t\t*e = %s(0)\n", enum.Name))
+	sb.WriteString("\t\treturn nil\n")
+	sb.WriteString("\tcase string:\n")
+	sb.WriteString("\t\ts = v\n")
+	sb.WriteString("\tcase []byte:\n")
+	sb.WriteString("\t\ts = string(v)\n")
+	sb.WriteString("\tdefault:\n")
+	sb.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"cannot scan %%T into %s\", src)\n", enum.Name))
+	sb.WriteString("\t}\n\n")
+	sb.WriteString(fmt.Sprintf("\tparsed, ok := Parse%sString(s)\n", enum.Name))
+	sb.WriteString("\tif !ok {\n")
+	sb.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"invalid %s value %%q\", s)\n", enum.Name))
+	sb.WriteString("\t}\n")
+	sb.WriteString("\t*e = parsed\n")
+	sb.WriteString("\treturn nil\n")
+	sb.WriteString("}\n\n")
 
-		sb.WriteString("\tif m.XsiSchemaLocation == \"\" {\n")
-		sb.WriteString("\t\tm.XsiSchemaLocation = SchemaLocation\n")
-		sb.WriteString("\t}\n\n")
+	sb.WriteString(fmt.Sprintf("// Value implements driver.Valuer for %s, storing the DDEX string value or NULL when unspecified\n", enum.Name))
+	sb.WriteString(fmt.Sprintf("func (e %s) Value() (driver.Value, error) {\n", enum.Name))
+	sb.WriteString("\tif e == 0 {\n")
+	sb.WriteString("\t\treturn nil, nil\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\ts := e.XMLString()\n")
+	sb.WriteString("\tif s == \"\" {\n")
+	sb.WriteString(fmt.Sprintf("\t\treturn nil, fmt.Errorf(\"invalid %s value %%d\", int32(e))\n", enum.Name))
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn s, nil\n")
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// generateEnumXMLMethods creates encoding/xml marshalers that write the enum
+// as its DDEX string value and omit the element or attribute entirely when
+// it is UNSPECIFIED, since DDEX has no empty value for an allowed-value set
+func generateEnumXMLMethods(enum EnumInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("// MarshalXML implements xml.Marshaler for %s, writing the DDEX string value and no element when unspecified\n", enum.Name))
+	sb.WriteString(fmt.Sprintf("func (e %s) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {\n", enum.Name))
+	sb.WriteString("\tif e == 0 {\n")
+	sb.WriteString("\t\treturn nil\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\ts := e.XMLString()\n")
+	sb.WriteString("\tif s == \"\" {\n")
+	sb.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"invalid %s value %%d\", int32(e))\n", enum.Name))
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn enc.EncodeElement(s, start)\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("// MarshalXMLAttr implements xml.MarshalerAttr for %s, writing the DDEX string value and no attribute when unspecified\n", enum.Name))
+	sb.WriteString(fmt.Sprintf("func (e %s) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {\n", enum.Name))
+	sb.WriteString("\tif e == 0 {\n")
+	sb.WriteString("\t\treturn xml.Attr{}, nil\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\ts := e.XMLString()\n")
+	sb.WriteString("\tif s == \"\" {\n")
+	sb.WriteString(fmt.Sprintf("\t\treturn xml.Attr{}, fmt.Errorf(\"invalid %s value %%d\", int32(e))\n", enum.Name))
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn xml.Attr{Name: name, Value: s}, nil\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalXML implements xml.Unmarshaler for %s, accepting the DDEX string value\n", enum.Name))
+	sb.WriteString(fmt.Sprintf("func (e *%s) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {\n", enum.Name))
+	sb.WriteString("\tvar s string\n")
+	sb.WriteString("\tif err := dec.DecodeElement(&s, &start); err != nil {\n")
+	sb.WriteString("\t\treturn err\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn e.UnmarshalXMLAttr(xml.Attr{Name: start.Name, Value: s})\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalXMLAttr implements xml.UnmarshalerAttr for %s, accepting the DDEX string value\n", enum.Name))
+	sb.WriteString(fmt.Sprintf("func (e *%s) UnmarshalXMLAttr(attr xml.Attr) error {\n", enum.Name))
+	sb.WriteString("\ts := strings.TrimSpace(attr.Value)\n")
+	sb.WriteString("\tif s == \"\" {\n")
+	sb.WriteString(fmt.Sprintf("\t\t*e = %s(0)\n", enum.Name))
+	sb.WriteString("\t\treturn nil\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString(fmt.Sprintf("\tparsed, ok := Parse%sString(s)\n", enum.Name))
+	sb.WriteString("\tif !ok {\n")
+	sb.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"invalid %s value %%q\", s)\n", enum.Name))
+	sb.WriteString("\t}\n")
+	sb.WriteString("\t*e = parsed\n")
+	sb.WriteString("\treturn nil\n")
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// enumValuePrefix returns the prefix shared by all constants of an enum, e.g.
+// "AllTerritoryCode_ALL_TERRITORY_CODE_". It is read off the _UNSPECIFIED
+// constant so it works for any value prefix strategy used by xsd2proto.
+func enumValuePrefix(enum EnumInfo) string {
+	for _, constant := range enum.Constants {
+		if strings.HasSuffix(constant, "_UNSPECIFIED") {
+			return strings.TrimSuffix(constant, "UNSPECIFIED")
+		}
 	}
+	// Fall back to the EnumName_ENUM_NAME_ convention
+	return enum.Name + "_" + strings.ToUpper(enum.Name) + "_"
+}
 
-	sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
-	sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
-	sb.WriteString("\treturn e.EncodeElement((*alias)(m), start)\n")
+// enumXMLValue returns the XSD value a constant was generated from, falling
+// back to its value name for protos without @xml comments
+func enumXMLValue(enum EnumInfo, constant string) (string, bool) {
+	if value, ok := enum.Values[constant]; ok {
+		return value, true
+	}
+	return enumValueName(enum, constant)
+}
+
+// enumValueName strips the enum prefix from a constant to get its value name
+func enumValueName(enum EnumInfo, constant string) (string, bool) {
+	value, ok := strings.CutPrefix(constant, enumValuePrefix(enum))
+	if !ok || value == "" || value == "UNSPECIFIED" {
+		return "", false
+	}
+	return value, true
+}
+
+// generateUnmarshalHelpers creates the package's Unmarshal entry points, which
+// require the root element to be in Namespace and accept ISO-8859-1 as well
+// as UTF-8 documents
+func generateUnmarshalHelpers(messages []MessageInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString("// Unmarshal decodes a document whose root is any root message of this\n")
+	sb.WriteString("// package. The root element must be in Namespace.\n")
+	sb.WriteString("func Unmarshal(data []byte) (proto.Message, error) {\n")
+	sb.WriteString("\td := newDecoder(data)\n")
+	sb.WriteString("\tstart, err := rootElement(d)\n")
+	sb.WriteString("\tif err != nil {\n")
+	sb.WriteString("\t\treturn nil, err\n")
+	sb.WriteString("\t}\n\n")
+	sb.WriteString("\tvar msg interface {\n")
+	sb.WriteString("\t\tproto.Message\n")
+	sb.WriteString("\t\txml.Unmarshaler\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tswitch start.Name.Local {\n")
+	for _, message := range messages {
+		if message.Root {
+			sb.WriteString(fmt.Sprintf("\tcase \"%s\":\n", message.Name))
+			sb.WriteString(fmt.Sprintf("\t\tmsg = &%s{}\n", message.Name))
+		}
+	}
+	sb.WriteString("\tdefault:\n")
+	sb.WriteString("\t\treturn nil, fmt.Errorf(\"unknown root element %s\", start.Name.Local)\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tif err := d.DecodeElement(msg, &start); err != nil {\n")
+	sb.WriteString("\t\treturn nil, err\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn msg, nil\n")
+	sb.WriteString("}\n\n")
+
+	for _, message := range messages {
+		if !message.Root {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("// Unmarshal%s decodes a %s document. The root element must\n", message.Name, message.Name))
+		sb.WriteString("// be in Namespace.\n")
+		sb.WriteString(fmt.Sprintf("func Unmarshal%s(data []byte) (*%s, error) {\n", message.Name, message.Name))
+		sb.WriteString("\td := newDecoder(data)\n")
+		sb.WriteString("\tstart, err := rootElement(d)\n")
+		sb.WriteString("\tif err != nil {\n")
+		sb.WriteString("\t\treturn nil, err\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString(fmt.Sprintf("\tif start.Name.Local != \"%s\" {\n", message.Name))
+		sb.WriteString(fmt.Sprintf("\t\treturn nil, fmt.Errorf(\"root element %%s is not %s\", start.Name.Local)\n", message.Name))
+		sb.WriteString("\t}\n")
+		sb.WriteString(fmt.Sprintf("\tmsg := &%s{}\n", message.Name))
+		sb.WriteString("\tif err := d.DecodeElement(msg, &start); err != nil {\n")
+		sb.WriteString("\t\treturn nil, err\n")
+		sb.WriteString("\t}\n")
+		sb.WriteString("\treturn msg, nil\n")
+		sb.WriteString("}\n\n")
+	}
+
+	sb.WriteString("// rootElement reads up to the root element and checks it is in Namespace\n")
+	sb.WriteString("func rootElement(d *xml.Decoder) (xml.StartElement, error) {\n")
+	sb.WriteString("\tfor {\n")
+	sb.WriteString("\t\ttok, err := d.Token()\n")
+	sb.WriteString("\t\tif err != nil {\n")
+	sb.WriteString("\t\t\treturn xml.StartElement{}, fmt.Errorf(\"could not find root element: %w\", err)\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\tstart, ok := tok.(xml.StartElement)\n")
+	sb.WriteString("\t\tif !ok {\n")
+	sb.WriteString("\t\t\tcontinue\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\tif start.Name.Space != Namespace {\n")
+	sb.WriteString("\t\t\treturn xml.StartElement{}, fmt.Errorf(\"root element %s is in namespace %q, expected %q\", start.Name.Local, start.Name.Space, Namespace)\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\treturn start, nil\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// newDecoder returns a decoder over data that reads the charsets DDEX\n")
+	sb.WriteString("// documents are delivered in\n")
+	sb.WriteString("func newDecoder(data []byte) *xml.Decoder {\n")
+	sb.WriteString("\td := xml.NewDecoder(bytes.NewReader(data))\n")
+	sb.WriteString("\td.CharsetReader = charsetReader\n")
+	sb.WriteString("\treturn d\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// charsetReader converts the non-UTF-8 encodings DDEX documents are delivered in\n")
+	sb.WriteString("func charsetReader(charset string, input io.Reader) (io.Reader, error) {\n")
+	sb.WriteString("\tswitch strings.ToLower(charset) {\n")
+	sb.WriteString("\tcase \"us-ascii\", \"ascii\":\n")
+	sb.WriteString("\t\treturn input, nil\n")
+	sb.WriteString("\tcase \"iso-8859-1\", \"latin1\", \"latin-1\":\n")
+	sb.WriteString("\t\tdata, err := io.ReadAll(input)\n")
+	sb.WriteString("\t\tif err != nil {\n")
+	sb.WriteString("\t\t\treturn nil, err\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\trunes := make([]rune, len(data))\n")
+	sb.WriteString("\t\tfor i, b := range data {\n")
+	sb.WriteString("\t\t\trunes[i] = rune(b)\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\treturn strings.NewReader(string(runes)), nil\n")
+	sb.WriteString("\tdefault:\n")
+	sb.WriteString("\t\treturn nil, fmt.Errorf(\"unsupported charset: %s\", charset)\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("}\n\n")
+
+	return sb.String()
+}
+
+// generateRootConstructor creates a New<Message> constructor that pre-populates
+// the namespace, xsi and schemaLocation attributes of a root message
+func generateRootConstructor(message MessageInfo, nsInfo *NamespaceInfo) string {
+	var sb strings.Builder
+
+	fieldName := fmt.Sprintf("Xmlns%s", strings.Title(nsInfo.NamespacePrefix))
+	sb.WriteString(fmt.Sprintf("// New%s returns a %s with its namespace attributes populated\n", message.Name, message.Name))
+	sb.WriteString(fmt.Sprintf("func New%s() *%s {\n", message.Name, message.Name))
+	sb.WriteString(fmt.Sprintf("\treturn &%s{\n", message.Name))
+	sb.WriteString(fmt.Sprintf("\t\t%s: Namespace,\n", fieldName))
+	sb.WriteString("\t\tXmlnsXsi: NamespaceXSI,\n")
+	sb.WriteString("\t\tXsiSchemaLocation: SchemaLocation,\n")
+	sb.WriteString("\t}\n")
 	sb.WriteString("}\n\n")
 
+	return sb.String()
+}
+
+// generateXMLMarshalingMethods creates MarshalXML and UnmarshalXML methods for message types
+func generateXMLMarshalingMethods(message MessageInfo, nsInfo *NamespaceInfo) string {
+	if message.Wildcard {
+		return generateWildcardMethods(message)
+	}
+
+	var sb strings.Builder
+
+	// Generate constructor for root message types so new messages start out conformant
+	if nsInfo != nil && message.Root {
+		sb.WriteString(generateRootConstructor(message, nsInfo))
+	}
+
+	// Generate MarshalXML method
+	if nsInfo != nil && message.Root {
+		sb.WriteString(generateRootMarshalXML(message, nsInfo))
+	} else {
+		sb.WriteString(fmt.Sprintf("// MarshalXML implements xml.Marshaler for %s\n", message.Name))
+		sb.WriteString(fmt.Sprintf("func (m *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", message.Name))
+		sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
+		sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
+		sb.WriteString("\treturn e.EncodeElement((*alias)(m), start)\n")
+		sb.WriteString("}\n\n")
+	}
+
 	// Generate UnmarshalXML method
 	sb.WriteString(fmt.Sprintf("// UnmarshalXML implements xml.Unmarshaler for %s\n", message.Name))
 	sb.WriteString(fmt.Sprintf("func (m *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {\n", message.Name))
+
+	// Reject documents whose root is a different message, which would otherwise decode partially
+	if message.Root {
+		sb.WriteString(fmt.Sprintf("\tif start.Name.Local != \"%s\" {\n", message.Name))
+		sb.WriteString(fmt.Sprintf("\t\treturn fmt.Errorf(\"expected root element %s, got %%s\", start.Name.Local)\n", message.Name))
+		sb.WriteString("\t}\n")
+		if nsInfo != nil {
+			sb.WriteString("\tm.RootPrefix = rootPrefix(start)\n")
+		}
+		sb.WriteString("\n")
+	}
+
 	sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
 	sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
 	sb.WriteString("\treturn d.DecodeElement((*alias)(m), &start)\n")
@@ -411,12 +1734,374 @@ func generateXMLMarshalingMethods(message MessageInfo, nsInfo *NamespaceInfo) st
 	return sb.String()
 }
 
-// isRootMessage determines if a message type is a root message that needs namespace handling
-func isRootMessage(messageName string) bool {
-	switch messageName {
-	case "NewReleaseMessage", "PurgeReleaseMessage", "MeadMessage", "PieMessage", "PieRequestMessage":
-		return true
-	default:
-		return false
+// generateRootMarshalXML creates the MarshalXML method of a root message. It
+// fills in empty namespace attributes on a shallow copy, so marshaling never
+// writes to the message and is safe alongside concurrent reads and marshals.
+func generateRootMarshalXML(message MessageInfo, nsInfo *NamespaceInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("// MarshalXML implements xml.Marshaler for %s, filling in empty namespace\n", message.Name))
+	sb.WriteString("// attributes on a shallow copy so m is never modified\n")
+	sb.WriteString(fmt.Sprintf("func (m *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", message.Name))
+	sb.WriteString("\t// Create an alias type to avoid infinite recursion\n")
+	sb.WriteString(fmt.Sprintf("\ttype alias %s\n", message.Name))
+	sb.WriteString("\tout := &alias{\n")
+	for _, field := range message.Fields {
+		sb.WriteString(fmt.Sprintf("\t\t%s: m.%s,\n", field, field))
+	}
+	sb.WriteString("\t}\n\n")
+
+	// Generate field name based on prefix (XmlnsErn, XmlnsMead, XmlnsPie)
+	fieldName := fmt.Sprintf("Xmlns%s", strings.Title(nsInfo.NamespacePrefix))
+	sb.WriteString("\t// Declare the namespace under the prefix the parsed document used, if any\n")
+	sb.WriteString("\tif out.RootPrefix != \"\" {\n")
+	sb.WriteString("\t\tstart.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: \"xmlns:\" + out.RootPrefix}, Value: Namespace})\n")
+	sb.WriteString(fmt.Sprintf("\t\tif out.%s == Namespace {\n", fieldName))
+	sb.WriteString(fmt.Sprintf("\t\t\tout.%s = \"\"\n", fieldName))
+	sb.WriteString("\t\t}\n")
+	sb.WriteString(fmt.Sprintf("\t} else if out.%s == \"\" {\n", fieldName))
+	sb.WriteString(fmt.Sprintf("\t\tout.%s = Namespace\n", fieldName))
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tif out.XmlnsXsi == \"\" {\n")
+	sb.WriteString("\t\tout.XmlnsXsi = NamespaceXSI\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tif out.XsiSchemaLocation == \"\" {\n")
+	sb.WriteString("\t\tout.XsiSchemaLocation = SchemaLocation\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\treturn e.EncodeElement(out, start)\n")
+	sb.WriteString("}\n\n")
+
+	return sb.String()
+}
+
+// generateWildcardMethods creates MarshalXML and UnmarshalXML methods that keep
+// an xs:any element, including its name and attributes, as raw XML. Namespace
+// declarations are dropped and re-derived by the encoder from element names.
+func generateWildcardMethods(message MessageInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("// MarshalXML implements xml.Marshaler for %s, writing RawXml in place of start\n", message.Name))
+	sb.WriteString(fmt.Sprintf("func (m *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", message.Name))
+	sb.WriteString("\td := xml.NewDecoder(strings.NewReader(m.RawXml))\n")
+	sb.WriteString("\tfor {\n")
+	sb.WriteString("\t\ttok, err := d.Token()\n")
+	sb.WriteString("\t\tif err == io.EOF {\n")
+	sb.WriteString("\t\t\treturn nil\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\tif err != nil {\n")
+	sb.WriteString(fmt.Sprintf("\t\t\treturn fmt.Errorf(\"invalid %s: %%w\", err)\n", message.Name))
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\tif err := e.EncodeToken(withoutNamespaceDecls(tok)); err != nil {\n")
+	sb.WriteString("\t\t\treturn err\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString(fmt.Sprintf("// UnmarshalXML implements xml.Unmarshaler for %s, capturing the whole element as RawXml\n", message.Name))
+	sb.WriteString(fmt.Sprintf("func (m *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {\n", message.Name))
+	sb.WriteString("\tvar buf bytes.Buffer\n")
+	sb.WriteString("\te := xml.NewEncoder(&buf)\n")
+	sb.WriteString("\tif err := e.EncodeToken(withoutNamespaceDecls(start)); err != nil {\n")
+	sb.WriteString("\t\treturn err\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tfor depth := 1; depth > 0; {\n")
+	sb.WriteString("\t\ttok, err := d.Token()\n")
+	sb.WriteString("\t\tif err != nil {\n")
+	sb.WriteString("\t\t\treturn err\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\tswitch tok.(type) {\n")
+	sb.WriteString("\t\tcase xml.StartElement:\n")
+	sb.WriteString("\t\t\tdepth++\n")
+	sb.WriteString("\t\tcase xml.EndElement:\n")
+	sb.WriteString("\t\t\tdepth--\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t\tif err := e.EncodeToken(withoutNamespaceDecls(tok)); err != nil {\n")
+	sb.WriteString("\t\t\treturn err\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tif err := e.Flush(); err != nil {\n")
+	sb.WriteString("\t\treturn err\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tm.RawXml = buf.String()\n")
+	sb.WriteString("\treturn nil\n")
+	sb.WriteString("}\n\n")
+
+	sb.WriteString("// withoutNamespaceDecls strips xmlns attributes from a start element\n")
+	sb.WriteString("func withoutNamespaceDecls(tok xml.Token) xml.Token {\n")
+	sb.WriteString("\tstart, ok := tok.(xml.StartElement)\n")
+	sb.WriteString("\tif !ok {\n")
+	sb.WriteString("\t\treturn tok\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tattrs := make([]xml.Attr, 0, len(start.Attr))\n")
+	sb.WriteString("\tfor _, attr := range start.Attr {\n")
+	sb.WriteString("\t\tif attr.Name.Space != \"xmlns\" && !(attr.Name.Space == \"\" && attr.Name.Local == \"xmlns\") {\n")
+	sb.WriteString("\t\t\tattrs = append(attrs, attr)\n")
+	sb.WriteString("\t\t}\n")
+	sb.WriteString("\t}\n")
+	sb.WriteString("\tstart.Attr = attrs\n")
+	sb.WriteString("\treturn start\n")
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// DurationField is a singular xs:duration element, held as a string
+type DurationField struct {
+	Message  string
+	Field    string
+	Optional bool // a *string, as minOccurs="0" scalars are
+}
+
+// schemaMetadataPath returns the schema metadata xsd2proto writes beside the
+// .proto a gen/ package is compiled from
+func schemaMetadataPath(packageDir, packageName string) string {
+	rel, err := filepath.Rel("gen", packageDir)
+	if err != nil {
+		rel = packageDir
+	}
+	return filepath.Join("proto", rel, packageName+".schema.json")
+}
+
+// findDurationFields returns the singular xs:duration elements listed in the
+// schema metadata at schemaPath, resolved to the struct fields of a .pb.go
+// file by their xml tags. A package without metadata has none.
+func findDurationFields(filename, schemaPath string) ([]DurationField, error) {
+	data, err := os.ReadFile(schemaPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var metadata struct {
+		Types []struct {
+			Name   string `json:"name"`
+			Fields []struct {
+				Name      string `json:"name"`
+				Type      string `json:"type"`
+				Kind      string `json:"kind"`
+				MaxOccurs int    `json:"maxOccurs"`
+			} `json:"fields"`
+		} `json:"types"`
+	}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", schemaPath, err)
+	}
+
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filename, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	structs := make(map[string]*ast.StructType)
+	for _, decl := range node.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+			for _, spec := range genDecl.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					if st, ok := ts.Type.(*ast.StructType); ok {
+						structs[ts.Name.Name] = st
+					}
+				}
+			}
+		}
+	}
+
+	var fields []DurationField
+	for _, typ := range metadata.Types {
+		st := structs[typ.Name]
+		if st == nil {
+			continue
+		}
+		for _, f := range typ.Fields {
+			if f.Kind != "element" || f.MaxOccurs != 1 || !strings.HasSuffix(f.Type, ":duration") {
+				continue
+			}
+			name, expr := xmlTaggedField(st, f.Name)
+			if name == "" || hasField(st, name+"Value") {
+				continue
+			}
+			switch t := expr.(type) {
+			case *ast.Ident:
+				if t.Name == "string" {
+					fields = append(fields, DurationField{Message: typ.Name, Field: name})
+				}
+			case *ast.StarExpr:
+				if ident, ok := t.X.(*ast.Ident); ok && ident.Name == "string" {
+					fields = append(fields, DurationField{Message: typ.Name, Field: name, Optional: true})
+				}
+			}
+		}
+	}
+	return fields, nil
+}
+
+// xmlTaggedField returns the name and type of the field whose xml tag names
+// the given element
+func xmlTaggedField(st *ast.StructType, element string) (string, ast.Expr) {
+	for _, field := range st.Fields.List {
+		if field.Tag == nil || len(field.Names) != 1 {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		name, _, _ := strings.Cut(reflect.StructTag(tag).Get("xml"), ",")
+		if name == element {
+			return field.Names[0].Name, field.Type
+		}
+	}
+	return "", nil
+}
+
+// generateDurationFile creates a duration.go file with time.Duration
+// accessors per xs:duration field
+func generateDurationFile(packageDir, packageName string, fields []DurationField) error {
+	content := generateDurationContent(packageName, fields)
+
+	durationPath := filepath.Join(packageDir, "duration.go")
+	return writeGeneratedFile(durationPath, content)
+}
+
+// generateDurationContent creates the content for duration.go. Each field
+// gets a <Field>Value getter parsing the ISO 8601 string and a
+// Set<Field>Value setter formatting it back, e.g. PT3M45S.
+func generateDurationContent(packageName string, fields []DurationField) string {
+	var sb strings.Builder
+
+	optional := slices.ContainsFunc(fields, func(f DurationField) bool { return f.Optional })
+
+	sb.WriteString("// Code generated by generate-go-extensions. DO NOT EDIT.\n\n")
+	sb.WriteString(fmt.Sprintf("package %s\n\n", packageName))
+	sb.WriteString("import (\n")
+	sb.WriteString("\t\"fmt\"\n")
+	sb.WriteString("\t\"math\"\n")
+	sb.WriteString("\t\"regexp\"\n")
+	sb.WriteString("\t\"strconv\"\n")
+	sb.WriteString("\t\"strings\"\n")
+	sb.WriteString("\t\"time\"\n")
+	if optional {
+		sb.WriteString("\n\t\"google.golang.org/protobuf/proto\"\n")
+	}
+	sb.WriteString(")\n")
+
+	for _, f := range fields {
+		sb.WriteString(fmt.Sprintf("\n// %sValue parses %s, an ISO 8601 duration such as PT3M45S\n", f.Field, f.Field))
+		sb.WriteString(fmt.Sprintf("func (m *%s) %sValue() (time.Duration, error) {\n", f.Message, f.Field))
+		sb.WriteString(fmt.Sprintf("\treturn parseDuration(m.Get%s())\n", f.Field))
+		sb.WriteString("}\n")
+		sb.WriteString(fmt.Sprintf("\n// Set%sValue sets %s to d in ISO 8601 form\n", f.Field, f.Field))
+		sb.WriteString(fmt.Sprintf("func (m *%s) Set%sValue(d time.Duration) {\n", f.Message, f.Field))
+		if f.Optional {
+			sb.WriteString(fmt.Sprintf("\tm.%s = proto.String(formatDuration(d))\n", f.Field))
+		} else {
+			sb.WriteString(fmt.Sprintf("\tm.%s = formatDuration(d)\n", f.Field))
+		}
+		sb.WriteString("}\n")
+	}
+
+	sb.WriteString(durationHelpers)
+	return sb.String()
+}
+
+// durationHelpers convert between xs:duration strings and time.Duration in
+// every package with duration fields
+const durationHelpers = `
+// durationPattern matches an xs:duration, e.g. PT3M45S, PT1H2M3.5S or P1DT2H
+var durationPattern = regexp.MustCompile(` + "`" + `^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:\.(\d+))?S)?)?$` + "`" + `)
+
+// parseDuration parses an xs:duration. Days count as 24 hours; years and
+// months have no fixed length, so only zero values are accepted for them.
+// Fractional seconds are kept to the nanosecond.
+func parseDuration(s string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "-P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	for _, calendar := range m[2:4] {
+		if strings.Trim(calendar, "0") != "" {
+			return 0, fmt.Errorf("duration %q has years or months, which have no fixed length", s)
+		}
+	}
+
+	var d time.Duration
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	for i, value := range m[4:8] {
+		if value == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n > int64((math.MaxInt64-d)/units[i]) {
+			return 0, fmt.Errorf("duration %q is out of range", s)
+		}
+		d += time.Duration(n) * units[i]
+	}
+	if fraction := m[8]; fraction != "" {
+		n, _ := strconv.ParseInt((fraction + "000000000")[:9], 10, 64)
+		if time.Duration(n) > math.MaxInt64-d {
+			return 0, fmt.Errorf("duration %q is out of range", s)
+		}
+		d += time.Duration(n)
+	}
+
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// formatDuration formats d as an xs:duration in hours, minutes and seconds,
+// e.g. PT3M45S, omitting zero parts
+func formatDuration(d time.Duration) string {
+	var sb strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		sb.WriteString("-")
+		u = uint64(-d)
+	}
+	sb.WriteString("PT")
+
+	hours, u := u/uint64(time.Hour), u%uint64(time.Hour)
+	minutes, u := u/uint64(time.Minute), u%uint64(time.Minute)
+	seconds, nanos := u/uint64(time.Second), u%uint64(time.Second)
+	if hours > 0 {
+		sb.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		sb.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if seconds > 0 || nanos > 0 || hours == 0 && minutes == 0 {
+		sb.WriteString(strconv.FormatUint(seconds, 10))
+		if nanos > 0 {
+			sb.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+		}
+		sb.WriteString("S")
+	}
+	return sb.String()
+}
+`
+
+// hasField reports whether a struct declares a field with the given name
+// exportedFields returns the names of the exported fields of st in order
+func exportedFields(st *ast.StructType) []string {
+	var names []string
+	for _, field := range st.Fields.List {
+		for _, ident := range field.Names {
+			if ident.IsExported() {
+				names = append(names, ident.Name)
+			}
+		}
+	}
+	return names
+}
+
+func hasField(st *ast.StructType, name string) bool {
+	for _, field := range st.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return true
+			}
+		}
 	}
+	return false
 }
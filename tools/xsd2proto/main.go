@@ -1,14 +1,34 @@
 package main
 
 import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"encoding/xml"
+	"errors"
+	"flag"
 	"fmt"
+	"go/format"
+	"io"
+	"io/fs"
 	"log"
+	"maps"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
+	"unicode"
 )
 
 //
@@ -33,6 +53,12 @@ var specs = []struct {
 	{"ern", "383", "release-notification.xsd"},
 }
 
+// extraEntries lists further entry schemas per spec, keyed name@version as in
+// -specs. They sit next to the main file and declare roots its includes and
+// imports never reach, such as separate request and response messages. PIE
+// 1.0 declares PieRequestMessage in its main schema, so no spec needs one yet.
+var extraEntries = map[string][]string{}
+
 //
 // =======================
 // XSD Models (extended)
@@ -66,10 +92,15 @@ type XSDElement struct {
 	MinOccurs   string          `xml:"minOccurs,attr"`
 	MaxOccurs   string          `xml:"maxOccurs,attr"`
 	ComplexType *XSDComplexType `xml:"complexType"`
+	SimpleType  *XSDSimpleType  `xml:"simpleType"`
+
+	// inlineMessage names the message hoisted from an anonymous ComplexType
+	inlineMessage string
 }
 
 type XSDComplexType struct {
 	Name          string            `xml:"name,attr"`
+	Mixed         bool              `xml:"mixed,attr"`
 	Sequence      *XSDSequence      `xml:"sequence"`
 	Choice        *XSDChoice        `xml:"choice"`
 	SimpleContent *XSDSimpleContent `xml:"simpleContent"`
@@ -77,8 +108,87 @@ type XSDComplexType struct {
 }
 
 type XSDSequence struct {
-	Elements []XSDElement `xml:"element"`
-	Choices  []XSDChoice  `xml:"choice"`
+	Elements  []XSDElement  `xml:"element"`
+	Choices   []XSDChoice   `xml:"choice"`
+	Sequences []XSDSequence `xml:"sequence"`
+	Any       []XSDAny      `xml:"any"`
+
+	// order lists the particles in document order, which the slices above
+	// lose when elements and choices interleave
+	order []xsdParticle
+}
+
+// xsdParticle is a child of a sequence: its kind (element, choice, sequence
+// or any) and index in the matching slice
+type xsdParticle struct {
+	kind  string
+	index int
+}
+
+// UnmarshalXML decodes a sequence, recording the order of its particles
+func (s *XSDSequence) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			particle := xsdParticle{kind: t.Name.Local}
+			switch t.Name.Local {
+			case "element":
+				particle.index = len(s.Elements)
+				s.Elements = append(s.Elements, XSDElement{})
+				err = d.DecodeElement(&s.Elements[particle.index], &t)
+			case "choice":
+				particle.index = len(s.Choices)
+				s.Choices = append(s.Choices, XSDChoice{})
+				err = d.DecodeElement(&s.Choices[particle.index], &t)
+			case "sequence":
+				particle.index = len(s.Sequences)
+				s.Sequences = append(s.Sequences, XSDSequence{})
+				err = d.DecodeElement(&s.Sequences[particle.index], &t)
+			case "any":
+				particle.index = len(s.Any)
+				s.Any = append(s.Any, XSDAny{})
+				err = d.DecodeElement(&s.Any[particle.index], &t)
+			default:
+				err = d.Skip()
+				particle.kind = ""
+			}
+			if err != nil {
+				return err
+			}
+			if particle.kind != "" {
+				s.order = append(s.order, particle)
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// particles returns the particles of the sequence in document order. A
+// sequence built in code rather than decoded lists its elements, then its
+// choices, nested sequences and wildcards.
+func (s *XSDSequence) particles() []xsdParticle {
+	if len(s.order) == len(s.Elements)+len(s.Choices)+len(s.Sequences)+len(s.Any) {
+		return s.order
+	}
+	var particles []xsdParticle
+	for i := range s.Elements {
+		particles = append(particles, xsdParticle{"element", i})
+	}
+	for i := range s.Choices {
+		particles = append(particles, xsdParticle{"choice", i})
+	}
+	for i := range s.Sequences {
+		particles = append(particles, xsdParticle{"sequence", i})
+	}
+	for i := range s.Any {
+		particles = append(particles, xsdParticle{"any", i})
+	}
+	return particles
 }
 
 type XSDChoice struct {
@@ -86,6 +196,16 @@ type XSDChoice struct {
 	MaxOccurs string        `xml:"maxOccurs,attr"`
 	Elements  []XSDElement  `xml:"element"`
 	Sequences []XSDSequence `xml:"sequence"`
+	Choices   []XSDChoice   `xml:"choice"`
+	Any       []XSDAny      `xml:"any"`
+}
+
+// XSDAny is an xs:any wildcard admitting foreign elements at an extension point
+type XSDAny struct {
+	Namespace       string `xml:"namespace,attr"`
+	ProcessContents string `xml:"processContents,attr"`
+	MinOccurs       string `xml:"minOccurs,attr"`
+	MaxOccurs       string `xml:"maxOccurs,attr"`
 }
 
 type XSDSimpleContent struct {
@@ -98,9 +218,10 @@ type XSDExtension struct {
 }
 
 type XSDAttribute struct {
-	Name string `xml:"name,attr"`
-	Type string `xml:"type,attr"`
-	Use  string `xml:"use,attr"`
+	Name       string         `xml:"name,attr"`
+	Type       string         `xml:"type,attr"`
+	Use        string         `xml:"use,attr"`
+	SimpleType *XSDSimpleType `xml:"simpleType"`
 }
 
 type XSDSimpleType struct {
@@ -111,12 +232,21 @@ type XSDSimpleType struct {
 type XSDRestriction struct {
 	Base         string           `xml:"base,attr"`
 	Enumerations []XSDEnumeration `xml:"enumeration"`
+	Patterns     []XSDFacet       `xml:"pattern"`
+	Length       *XSDFacet        `xml:"length"`
+	MinLength    *XSDFacet        `xml:"minLength"`
+	MaxLength    *XSDFacet        `xml:"maxLength"`
 }
 
 type XSDEnumeration struct {
 	Value string `xml:"value,attr"`
 }
 
+// XSDFacet is a constraining facet of a restriction, e.g. xs:pattern
+type XSDFacet struct {
+	Value string `xml:"value,attr"`
+}
+
 //
 // =======================
 // Aggregation by namespace
@@ -137,156 +267,1730 @@ type NamespaceBundle struct {
 
 	// Track AVS version context for this namespace
 	AVSVersion string // e.g. "20200108" or "" for current
+
+	// Schema files merged into the bundle, in load order
+	Sources []schemaSource
+}
+
+// schemaSource identifies a schema file a bundle was generated from
+type schemaSource struct {
+	Path    string // relative to the schema directory
+	Version string // the SCOW version comment DDEX stamps schemas with, if any
+	Sum     string // leading hex digits of the file's SHA-256
+	Patched bool   // read from the -schema-overlay directory
 }
 
 // Graph loader state
 type loadState struct {
-	visitedFiles map[string]struct{} // absolute paths visited
+	visitedFiles map[string]struct{} // absolute path#namespace visited
 	// Map of targetNamespace → bundle
 	nsBundles map[string]*NamespaceBundle
 	// file path → schema's ns (helpful for relative includes)
 	fileToNS map[string]string
 	// Track AVS version context per namespace
 	avsVersionContext map[string]string // ns -> avs version
+	// Schemas currently being loaded, outermost first
+	loadPath []loadFrame
+	// Import cycles among distinct namespaces, as "a -> b -> a"
+	cycles []string
+	// Directory of patched schemas standing in for those under schemaRoot,
+	// or "" for none
+	overlayDir string
+	schemaRoot string
+}
+
+// loadFrame is a schema on the load path
+type loadFrame struct {
+	key string // visitedFiles key
+	ns  string
+}
+
+// recordCycle reports a cycle when the schema at key is still being loaded,
+// i.e. it (transitively) imports itself. Cycles within one namespace, such as
+// mutual includes, are harmless and not reported.
+func (st *loadState) recordCycle(key string) {
+	start := slices.IndexFunc(st.loadPath, func(f loadFrame) bool { return f.key == key })
+	if start < 0 {
+		return // already loaded through another path, not a cycle
+	}
+
+	var namespaces []string
+	for _, frame := range append(st.loadPath[start:], st.loadPath[start]) {
+		if len(namespaces) == 0 || namespaces[len(namespaces)-1] != frame.ns {
+			namespaces = append(namespaces, frame.ns)
+		}
+	}
+	if len(namespaces) < 3 {
+		return
+	}
+
+	cycle := strings.Join(namespaces, " -> ")
+	log.Printf("Circular schema import: %s", cycle)
+	st.cycles = append(st.cycles, cycle)
+}
+
+func newLoadState() *loadState {
+	return &loadState{
+		visitedFiles:      make(map[string]struct{}),
+		nsBundles:         make(map[string]*NamespaceBundle),
+		fileToNS:          make(map[string]string),
+		avsVersionContext: make(map[string]string),
+	}
+}
+
+//
+// =======================
+// Entry
+// =======================
+//
+
+// 1) Put this near the top-level (package scope), not inside convertSpec:
+
+type protoPkgInfo struct {
+	pkgName   string
+	goPackage string
+	filePath  string // relative to proto root
+}
+
+// Enum value prefix strategies
+const (
+	enumPrefixFull   = "full"   // ALL_TERRITORY_CODE_AD
+	enumPrefixAbbrev = "abbrev" // ATC_AD
+)
+
+var enumPrefixStrategy = flag.String("enum-prefix", enumPrefixFull, "enum value prefix strategy: full or abbrev")
+
+// schemaDir holds the XSD snapshot to generate from; pointing it at another
+// directory pins a different schema set without touching the checked-in one
+var schemaDir = flag.String("schema-dir", "xsd", "directory holding the DDEX XSD schemas")
+
+// schemaOverlay holds locally patched schemas, e.g. for upstream errata: a
+// file at the same path relative to it as a schema under -schema-dir is
+// loaded in that schema's place
+var schemaOverlay = flag.String("schema-overlay", "", "directory of patched schemas loaded instead of those at the same path under -schema-dir")
+
+// emitService adds a gRPC ingestion service with REST mappings over the
+// generated root messages
+var emitService = flag.Bool("service", false, "also generate a DDEX ingestion gRPC service with google.api.http (grpc-gateway) annotations")
+
+// emitValidate annotates fields with the protovalidate constraints the XSD
+// facets imply, for validating messages with the standard runtime
+var emitValidate = flag.Bool("validate", false, "annotate fields with buf.validate (protovalidate) constraints derived from XSD facets")
+
+// strict turns warnings about suspect output, such as an enum left without
+// values, into errors
+var strict = flag.Bool("strict", false, "fail instead of warning when the generated output looks wrong, e.g. an enum with no values")
+
+// defaultGoPackageRoot is the import path the generated Go packages live
+// under in this module
+const defaultGoPackageRoot = "github.com/alecsavvy/ddex-go/gen"
+
+// goPackageRoot replaces defaultGoPackageRoot in go_package options, for forks
+// and modules vendoring the generator. DDEX_GO_PACKAGE_ROOT sets the default.
+var goPackageRoot = flag.String("go-package-root", cmp.Or(os.Getenv("DDEX_GO_PACKAGE_ROOT"), defaultGoPackageRoot), "Go import path the generated packages live under")
+
+// Generation backends
+const (
+	backendProto      = "proto"      // .proto files for buf, compiled into gen/
+	backendGo         = "go"         // plain Go structs with encoding/xml tags only
+	backendJSONSchema = "jsonschema" // JSON Schema of each root message's JSON form
+)
+
+// backend selects what the converter writes; the go backend skips protobuf
+// entirely for users who only want the XML data binding
+var backend = flag.String("backend", backendProto, "output to generate: proto (.proto files), go (plain Go structs without the protobuf runtime) or jsonschema (JSON Schema per root message)")
+
+// goOut is where -backend=go writes its package tree
+var goOut = flag.String("go-out", "gostructs", "output directory for -backend=go")
+
+// jsonSchemaOut is where -backend=jsonschema writes its schemas
+var jsonSchemaOut = flag.String("jsonschema-out", "jsonschema", "output directory for -backend=jsonschema")
+
+// Proto file layouts
+const (
+	layoutNested = "nested" // ddex/ern/v432/v432.proto
+	layoutFlat   = "flat"   // ddex_ern_v432.proto
+)
+
+var protoLayoutName = flag.String("proto-layout", layoutNested, "proto file layout: nested (a directory per package) or flat (every file in one directory)")
+
+// protoPrefix places the generated files in a subdirectory of proto/, for
+// trees that keep DDEX beside other protos
+var protoPrefix = flag.String("proto-prefix", "", "directory under proto/ to write the generated files to, e.g. vendor/ddex")
+
+// protoLayout maps a proto package and the base name of its file to the
+// file's path relative to the proto root
+type protoLayout func(pkg, name string) string
+
+// protoPath lays out every generated .proto file. Files are both written to
+// and imported by its paths, so replacing it (see newProtoLayout) changes the
+// directory structure without breaking imports.
+var protoPath protoLayout = nestedLayout
+
+// nestedLayout mirrors the dotted package as directories
+func nestedLayout(pkg, name string) string {
+	return filepath.Join(filepath.Join(strings.Split(pkg, ".")...), name+".proto")
+}
+
+// flatLayout names each file after its package, all in one directory
+func flatLayout(pkg, name string) string {
+	return strings.ReplaceAll(pkg, ".", "_") + ".proto"
+}
+
+// newProtoLayout returns the layout selected by -proto-layout, under prefix
+func newProtoLayout(name, prefix string) (protoLayout, error) {
+	var layout protoLayout
+	switch name {
+	case layoutNested:
+		layout = nestedLayout
+	case layoutFlat:
+		layout = flatLayout
+	default:
+		return nil, fmt.Errorf("unknown layout %q (want %s or %s)", name, layoutNested, layoutFlat)
+	}
+	if prefix == "" {
+		return layout, nil
+	}
+	if filepath.IsAbs(prefix) || !filepath.IsLocal(prefix) {
+		return nil, fmt.Errorf("prefix %q must be a relative path inside the proto root", prefix)
+	}
+	return func(pkg, name string) string {
+		return filepath.Join(prefix, layout(pkg, name))
+	}, nil
+}
+
+// specFilter lists the spec@version pairs given with -spec
+type specFilter []string
+
+func (f *specFilter) String() string { return strings.Join(*f, ",") }
+
+func (f *specFilter) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// onlySpecs restricts generation to the named specs; empty means all of them
+var onlySpecs specFilter
+
+func init() {
+	flag.Var(&onlySpecs, "spec", "generate only this spec@version, e.g. ern@432 (repeatable; default all)")
+}
+
+// selectSpecs returns the specs named by filter, in processing order, or every
+// spec when filter is empty. Names missing from the spec list are an error.
+func selectSpecs(filter []string) ([]struct{ name, version, mainFile string }, error) {
+	if len(filter) == 0 {
+		return specs, nil
+	}
+
+	wanted := make(map[string]bool)
+	for _, name := range filter {
+		wanted[name] = true
+	}
+
+	var selected []struct{ name, version, mainFile string }
+	for _, spec := range specs {
+		if key := spec.name + "@" + spec.version; wanted[key] {
+			selected = append(selected, spec)
+			delete(wanted, key)
+		}
+	}
+
+	if len(wanted) > 0 {
+		known := make([]string, len(specs))
+		for i, spec := range specs {
+			known[i] = spec.name + "@" + spec.version
+		}
+		var unknown []string
+		for _, name := range filter {
+			if wanted[name] {
+				unknown = append(unknown, name)
+			}
+		}
+		return nil, fmt.Errorf("unknown spec %s (known: %s)", strings.Join(unknown, ", "), strings.Join(known, ", "))
+	}
+	return selected, nil
+}
+
+func main() {
+	flag.Parse()
+	if *enumPrefixStrategy != enumPrefixFull && *enumPrefixStrategy != enumPrefixAbbrev {
+		log.Fatalf("Unknown -enum-prefix strategy %q (want %s or %s)", *enumPrefixStrategy, enumPrefixFull, enumPrefixAbbrev)
+	}
+
+	if *backend != backendProto && *backend != backendGo && *backend != backendJSONSchema {
+		log.Fatalf("Unknown -backend %q (want %s, %s or %s)", *backend, backendProto, backendGo, backendJSONSchema)
+	}
+
+	selected, err := selectSpecs(onlySpecs)
+	if err != nil {
+		log.Fatalf("Invalid -spec: %v", err)
+	}
+	if *emitService && len(onlySpecs) > 0 {
+		log.Fatalf("-service lists the roots of every spec and cannot be combined with -spec")
+	}
+	if *emitService && *backend != backendProto {
+		log.Fatalf("-service generates a gRPC service and cannot be combined with -backend=%s", *backend)
+	}
+	if *emitValidate && *backend != backendProto {
+		log.Fatalf("-validate annotates .proto fields and cannot be combined with -backend=%s", *backend)
+	}
+	layout, err := newProtoLayout(*protoLayoutName, *protoPrefix)
+	if err != nil {
+		log.Fatalf("Invalid -proto-layout: %v", err)
+	}
+	protoPath = layout
+
+	// Check every entry schema up front so an incomplete schema directory
+	// fails before any proto file is rewritten
+	for _, spec := range selected {
+		if err := validateSchemas(spec); err != nil {
+			log.Fatalf("Schema validation failed for %s v%s in %s: %v", spec.name, spec.version, *schemaDir, err)
+		}
+	}
+
+	if err := generate(selected); err != nil {
+		log.Fatalf("Generation failed: %v", err)
+	}
+}
+
+// generate writes the output of the selected specs for -backend while holding
+// the lock file, so concurrent runs take turns instead of interleaving writes
+func generate(selected []struct{ name, version, mainFile string }) error {
+	release, err := acquireLock(lockFileName, *lockTimeout)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	switch *backend {
+	case backendGo:
+		root := structsPackageRoot(*goOut)
+		for _, spec := range selected {
+			log.Printf("Converting %s v%s to Go structs (namespace-aware)...", spec.name, spec.version)
+			if err := convertSpecGo(spec, *goOut, root); err != nil {
+				return fmt.Errorf("convert %s v%s: %w", spec.name, spec.version, err)
+			}
+		}
+		return nil
+
+	case backendJSONSchema:
+		for _, spec := range selected {
+			log.Printf("Converting %s v%s to JSON Schema (namespace-aware)...", spec.name, spec.version)
+			if err := convertSpecJSONSchema(spec, *jsonSchemaOut); err != nil {
+				return fmt.Errorf("convert %s v%s: %w", spec.name, spec.version, err)
+			}
+		}
+		return nil
+	}
+
+	var roots []serviceRoot
+	for _, spec := range selected {
+		log.Printf("Converting %s v%s to protobuf (namespace-aware)...", spec.name, spec.version)
+
+		specRoots, err := convertSpec(spec, *goPackageRoot)
+		if err != nil {
+			return fmt.Errorf("convert %s v%s: %w", spec.name, spec.version, err)
+		}
+		roots = append(roots, specRoots...)
+	}
+
+	if *emitService {
+		outFile := filepath.Join("proto", protoPath(ingestServicePackage, "ingest"))
+		if err := writeOutput(outFile, []byte(generateIngestService(roots, *goPackageRoot))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+// =======================
+// Output locking
+// =======================
+//
+
+// dryRun runs the whole pipeline but reports what would change instead of
+// writing, so schema updates can be reviewed before regenerating
+var dryRun = flag.Bool("dry-run", false, "print per-namespace message and enum counts and a diff against the existing files instead of writing them")
+
+// dryRunOutput receives the -dry-run report
+var dryRunOutput io.Writer = os.Stdout
+
+// lockFileName is created in the working directory while a run writes its
+// output and holds the PID of the process running it
+const lockFileName = ".xsd2proto.lock"
+
+// lockTimeout bounds how long a run waits for another to finish
+var lockTimeout = flag.Duration("lock-timeout", 5*time.Minute, "how long to wait for a concurrent run to release "+lockFileName)
+
+// acquireLock creates the lock file at path, waiting up to timeout while
+// another run holds it, and returns a func removing it. A lock left behind by
+// a process that is no longer running, e.g. one killed mid-run, is taken over.
+func acquireLock(path string, timeout time.Duration) (func(), error) {
+	deadline := time.Now().Add(timeout)
+	waiting := false
+	for {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if err == nil {
+			_, err = fmt.Fprintf(f, "%d\n", os.Getpid())
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(path)
+				return nil, fmt.Errorf("write lock %s: %w", path, err)
+			}
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, fmt.Errorf("create lock %s: %w", path, err)
+		}
+
+		// An unreadable or empty lock is still being written, so only a
+		// PID that isn't running marks it stale
+		if data, err := os.ReadFile(path); err == nil {
+			if pid, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil && !processRunning(pid) {
+				log.Printf("Removing stale lock %s left by process %d", path, pid)
+				os.Remove(path)
+				continue
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%s still held after %s; remove it if no other run is in progress", path, timeout)
+		}
+		if !waiting {
+			log.Printf("Waiting for another run to release %s...", path)
+			waiting = true
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// processRunning reports whether a process with the given PID exists
+func processRunning(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false // Windows finds only running processes
+	}
+	defer p.Release()
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
+
+// provenancePrefix starts the header line naming the generator version and
+// the date of a run
+const provenancePrefix = "// Generated by xsd2proto "
+
+// generatorVersion is the version of xsd2proto recorded in the provenance
+// header: the module version, or the VCS revision of a development build
+var generatorVersion = sync.OnceValue(func() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+	version, dirty := "devel", ""
+	for _, setting := range info.Settings {
+		switch {
+		case setting.Key == "vcs.revision":
+			version = setting.Value[:min(12, len(setting.Value))]
+		case setting.Key == "vcs.modified" && setting.Value == "true":
+			dirty = "-dirty"
+		}
+	}
+	return version + dirty
+})
+
+// generationDate is the date recorded in the provenance header, taken from
+// SOURCE_DATE_EPOCH when set so builds can be reproduced
+func generationDate() string {
+	if epoch, err := strconv.ParseInt(os.Getenv("SOURCE_DATE_EPOCH"), 10, 64); err == nil {
+		return time.Unix(epoch, 0).UTC().Format(time.DateOnly)
+	}
+	return time.Now().UTC().Format(time.DateOnly)
+}
+
+// provenanceHeader returns the comment opening a generated .proto file,
+// recording what produced it and from which schema files
+func provenanceHeader(b *NamespaceBundle) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s%s on %s\n", provenancePrefix, generatorVersion(), generationDate()))
+	for _, src := range b.Sources {
+		details := []string{"sha256 " + src.Sum}
+		if src.Version != "" {
+			details = append([]string{src.Version}, details...)
+		}
+		if src.Patched {
+			details = append(details, "patched")
+		}
+		sb.WriteString(fmt.Sprintf("// Schema: %s (%s)\n", src.Path, strings.Join(details, ", ")))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// withoutProvenance drops the provenance line naming the generator version
+// and date, which change between runs without changing the output
+func withoutProvenance(data []byte) string {
+	var out strings.Builder
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if !strings.HasPrefix(line, provenancePrefix) {
+			out.WriteString(line)
+		}
+	}
+	return out.String()
+}
+
+// writeOutput writes a generated file, creating its directory, or under
+// -dry-run reports how data differs from the file on disk. A file differing
+// only in its provenance line is left as is, so regenerating an unchanged
+// schema leaves the tree clean.
+func writeOutput(name string, data []byte) error {
+	if *dryRun {
+		return reportChange(name, data)
+	}
+	if previous, err := os.ReadFile(name); err == nil && withoutProvenance(previous) == withoutProvenance(data) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(name, data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	log.Printf("Generated %s", name)
+	return nil
+}
+
+// reportChange prints whether name would be created, changed or left as is,
+// with a unified diff for changes
+func reportChange(name string, data []byte) error {
+	previous, err := os.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintf(dryRunOutput, "new file %s (%d lines)\n", name, len(splitLines(string(data))))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read %s: %w", name, err)
+	}
+	if withoutProvenance(previous) == withoutProvenance(data) {
+		fmt.Fprintf(dryRunOutput, "unchanged %s\n", name)
+		return nil
+	}
+
+	edits := diffLines(splitLines(string(previous)), splitLines(string(data)))
+	var added, removed int
+	for _, e := range edits {
+		switch e.op {
+		case '+':
+			added++
+		case '-':
+			removed++
+		}
+	}
+	fmt.Fprintf(dryRunOutput, "changed %s (+%d -%d lines)\n", name, added, removed)
+	fmt.Fprint(dryRunOutput, unifiedDiff(name, edits, 3))
+	return nil
+}
+
+// countLines counts the lines of content matching pattern
+func countLines(content string, pattern *regexp.Regexp) int {
+	n := 0
+	for _, line := range strings.Split(content, "\n") {
+		if pattern.MatchString(line) {
+			n++
+		}
+	}
+	return n
+}
+
+// splitLines splits content into lines without their terminating newline
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
+
+// lineEdit is one line of a diff: kept (' '), removed ('-') or added ('+')
+type lineEdit struct {
+	op   byte
+	line string
+}
+
+// maxDiffEdits bounds the edit distance diffLines searches, keeping memory in
+// check when a file is rewritten wholesale; past it the differing middle is
+// reported as removed and re-added
+const maxDiffEdits = 2000
+
+// diffLines returns a shortest edit script turning a into b, found with
+// Myers' O(ND) algorithm after trimming the common prefix and suffix
+func diffLines(a, b []string) []lineEdit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var edits []lineEdit
+	for _, line := range a[:prefix] {
+		edits = append(edits, lineEdit{' ', line})
+	}
+	edits = append(edits, myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		edits = append(edits, lineEdit{' ', line})
+	}
+	return edits
+}
+
+// myersDiff implements diffLines for inputs without a common prefix or suffix
+func myersDiff(a, b []string) []lineEdit {
+	n, m := len(a), len(b)
+
+	// trace[d][k+d] is the furthest x reached on diagonal k = x-y with d edits
+	var trace [][]int
+	for d := 0; d <= min(n+m, maxDiffEdits); d++ {
+		v := make([]int, 2*d+1)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			switch {
+			case d == 0:
+				x = 0
+			case k == -d || k != d && trace[d-1][k-1+d-1] < trace[d-1][k+1+d-1]:
+				x = trace[d-1][k+1+d-1] // insertion from diagonal k+1
+			default:
+				x = trace[d-1][k-1+d-1] + 1 // deletion from diagonal k-1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[k+d] = x
+			if x >= n && y >= m {
+				trace = append(trace, v)
+				return backtrack(a, b, trace)
+			}
+		}
+		trace = append(trace, v)
+	}
+
+	// Too many edits to search: replace the whole range
+	edits := make([]lineEdit, 0, n+m)
+	for _, line := range a {
+		edits = append(edits, lineEdit{'-', line})
+	}
+	for _, line := range b {
+		edits = append(edits, lineEdit{'+', line})
+	}
+	return edits
 }
 
-func newLoadState() *loadState {
-	return &loadState{
-		visitedFiles:      make(map[string]struct{}),
-		nsBundles:         make(map[string]*NamespaceBundle),
-		fileToNS:          make(map[string]string),
-		avsVersionContext: make(map[string]string),
+// backtrack walks the trace of myersDiff back from the end of both inputs
+func backtrack(a, b []string, trace [][]int) []lineEdit {
+	var edits []lineEdit
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d > 0; d-- {
+		k := x - y
+		prevK := k - 1
+		if k == -d || k != d && trace[d-1][k-1+d-1] < trace[d-1][k+1+d-1] {
+			prevK = k + 1
+		}
+		prevX := trace[d-1][prevK+d-1]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			edits = append(edits, lineEdit{' ', a[x]})
+		}
+		if prevK == k+1 {
+			y--
+			edits = append(edits, lineEdit{'+', b[y]})
+		} else {
+			x--
+			edits = append(edits, lineEdit{'-', a[x]})
+		}
+	}
+	for x > 0 {
+		x--
+		edits = append(edits, lineEdit{' ', a[x]})
+	}
+	slices.Reverse(edits)
+	return edits
+}
+
+// unifiedDiff renders edits as a unified diff of name with context lines
+// around each change
+func unifiedDiff(name string, edits []lineEdit, context int) string {
+	// oldLine[i] and newLine[i] count the lines before edits[i] on each side
+	oldLine, newLine := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for i, e := range edits {
+		oldLine[i+1], newLine[i+1] = oldLine[i], newLine[i]
+		if e.op != '+' {
+			oldLine[i+1]++
+		}
+		if e.op != '-' {
+			newLine[i+1]++
+		}
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", name, name)
+	for i := 0; i < len(edits); {
+		for i < len(edits) && edits[i].op == ' ' {
+			i++
+		}
+		if i == len(edits) {
+			break
+		}
+
+		// Extend the hunk over changes separated by at most 2*context kept lines
+		start, end := max(i-context, 0), i
+		for end < len(edits) {
+			if edits[end].op != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(edits) && edits[next].op == ' ' {
+				next++
+			}
+			if next == len(edits) || next-end > 2*context {
+				end = min(end+context, len(edits))
+				break
+			}
+			end = next
+		}
+
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(oldLine[start], oldLine[end]), hunkRange(newLine[start], newLine[end]))
+		for _, e := range edits[start:end] {
+			fmt.Fprintf(&out, "%c%s\n", e.op, e.line)
+		}
+		i = end
+	}
+	return out.String()
+}
+
+// hunkRange formats the lines from, to of one side of a hunk as start,count
+func hunkRange(from, to int) string {
+	if to == from {
+		return fmt.Sprintf("%d,0", from)
+	}
+	return fmt.Sprintf("%d,%d", from+1, to-from)
+}
+
+// writeFileAtomic writes data to a temporary file beside name and renames it
+// into place, so an interrupted run leaves the previous file rather than a
+// truncated one for the next run to read back
+func writeFileAtomic(name string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), name)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+func validateSchemas(spec struct{ name, version, mainFile string }) error {
+	var entry string
+
+	// Handle AVS specs differently - they're in the schema directory root
+	if spec.name == "avs" {
+		entry = filepath.Join(*schemaDir, spec.mainFile)
+	} else {
+		schemasDir := filepath.Join(*schemaDir, spec.name+"v"+spec.version)
+		if _, err := os.Stat(schemasDir); os.IsNotExist(err) {
+			return fmt.Errorf("schema directory %s does not exist", schemasDir)
+		}
+		entry = filepath.Join(schemasDir, spec.mainFile)
+	}
+
+	if _, err := os.Stat(entry); os.IsNotExist(err) {
+		if spec.name != "avs" {
+			alt := filepath.Join(filepath.Dir(entry), strings.ReplaceAll(spec.mainFile, "-", "_"))
+			if _, err2 := os.Stat(alt); os.IsNotExist(err2) {
+				return fmt.Errorf("main schema not found; tried %s and %s", entry, alt)
+			}
+		} else {
+			return fmt.Errorf("AVS schema not found: %s", entry)
+		}
+	}
+	for _, file := range extraEntries[spec.name+"@"+spec.version] {
+		extra := filepath.Join(filepath.Dir(entry), file)
+		if _, err := os.Stat(extra); os.IsNotExist(err) {
+			return fmt.Errorf("entry schema not found: %s", extra)
+		}
+	}
+	return nil
+}
+
+//
+// =======================
+// Conversion pipeline
+// =======================
+//
+
+// convertSpec writes the .proto files of a spec, with go_package options under
+// goRoot, and returns its root messages
+func convertSpec(spec struct{ name, version, mainFile string }, goRoot string) ([]serviceRoot, error) {
+	st, err := loadSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Output goes to proto/<spec or inferred>/*
+	outRoot := filepath.Join("proto")
+
+	namespaces, pkgs := planBundles(st, spec, goRoot)
+	contents, err := generateBundles(st, namespaces, pkgs)
+	if err != nil {
+		return nil, err
+	}
+	if *emitValidate {
+		resolver := newConstraintResolver(st)
+		for i, ns := range namespaces {
+			contents[i] = annotateConstraints(contents[i], st.nsBundles[ns], resolver)
+		}
+	}
+
+	// Write in namespace order so logs and output stay deterministic
+	for i, ns := range namespaces {
+		info := pkgs[ns]
+
+		outFile := filepath.Join(outRoot, info.filePath)
+		content := contents[i]
+		if previous, err := os.ReadFile(outFile); err == nil {
+			content = reserveRetiredFields(content, string(previous))
+		}
+		if *dryRun {
+			fmt.Fprintf(dryRunOutput, "%s -> %s: %d messages, %d enums\n", ns, outFile,
+				countLines(content, protoMessageLine), countLines(content, protoEnumPattern))
+		}
+		if err := writeOutput(outFile, []byte(content)); err != nil {
+			return nil, err
+		}
+
+		metadata, err := generateSchemaMetadata(st.nsBundles[ns], info.pkgName)
+		if err != nil {
+			return nil, fmt.Errorf("schema metadata for %s: %w", ns, err)
+		}
+		metadataFile := strings.TrimSuffix(outFile, ".proto") + schemaMetadataSuffix
+		if err := writeOutput(metadataFile, metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return rootMessages(st, spec, namespaces, pkgs), nil
+}
+
+// rootMessages returns the messages of a spec's top-level *Message elements,
+// the documents it defines
+func rootMessages(st *loadState, spec struct{ name, version, mainFile string }, namespaces []string, pkgs map[string]protoPkgInfo) []serviceRoot {
+	var roots []serviceRoot
+	if spec.name != "avs" {
+		for _, ns := range namespaces {
+			for _, el := range st.nsBundles[ns].Elements {
+				if el.ComplexType != nil && strings.HasSuffix(el.Name, "Message") {
+					roots = append(roots, serviceRoot{pkg: pkgs[ns], message: toProtoMessageName(el.Name)})
+				}
+			}
+		}
+	}
+	return roots
+}
+
+// ingestServicePackage is the package of the ingestion service -service
+// writes, to ingest.proto in the default layout
+const ingestServicePackage = "ddex.ingest.v1"
+
+// serviceRoot is a root message the ingestion service accepts
+type serviceRoot struct {
+	pkg     protoPkgInfo
+	message string
+}
+
+// generateIngestService renders a gRPC service with one Submit RPC per root
+// message, each mapped to a REST endpoint by a google.api.http annotation so
+// grpc-gateway can serve it, e.g. POST /v1/ern/v432/NewReleaseMessage
+func generateIngestService(roots []serviceRoot, goRoot string) string {
+	var sb strings.Builder
+	sb.WriteString("syntax = \"proto3\";\n\n")
+	sb.WriteString(fmt.Sprintf("package %s;\n\n", ingestServicePackage))
+	sb.WriteString(fmt.Sprintf("option go_package = \"%s/ddex/ingest/v1\";\n\n", strings.TrimSuffix(goRoot, "/")))
+
+	imports := []string{"google/api/annotations.proto"}
+	for _, root := range roots {
+		if !slices.Contains(imports, root.pkg.filePath) {
+			imports = append(imports, root.pkg.filePath)
+		}
+	}
+	for _, imp := range imports {
+		sb.WriteString(fmt.Sprintf("import \"%s\";\n", toPosixPath(imp)))
+	}
+
+	var messages strings.Builder
+	sb.WriteString("\n// DdexIngestionService accepts DDEX messages for ingestion\n")
+	sb.WriteString("service DdexIngestionService {\n")
+	for _, root := range roots {
+		// ddex.ern.v432 → ErnV432 and /ern/v432
+		parts := strings.Split(strings.TrimPrefix(root.pkg.pkgName, "ddex."), ".")
+		var prefix strings.Builder
+		for _, part := range parts {
+			prefix.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+		rpc := "Submit" + prefix.String() + root.message
+
+		sb.WriteString(fmt.Sprintf("  // %s submits a %s.%s\n", rpc, root.pkg.pkgName, root.message))
+		sb.WriteString(fmt.Sprintf("  rpc %s(%sRequest) returns (%sResponse) {\n", rpc, rpc, rpc))
+		sb.WriteString("    option (google.api.http) = {\n")
+		sb.WriteString(fmt.Sprintf("      post: \"/v1/%s/%s\"\n", strings.Join(parts, "/"), root.message))
+		sb.WriteString("      body: \"message\"\n")
+		sb.WriteString("    };\n")
+		sb.WriteString("  }\n")
+
+		messages.WriteString(fmt.Sprintf("\nmessage %sRequest {\n  %s.%s message = 1;\n}\n", rpc, root.pkg.pkgName, root.message))
+		messages.WriteString(fmt.Sprintf("\nmessage %sResponse {\n  // MessageId of the accepted message\n  string message_id = 1;\n}\n", rpc))
+	}
+	sb.WriteString("}\n")
+	sb.WriteString(messages.String())
+	return sb.String()
+}
+
+// messageLayout is the field numbering of a generated message
+type messageLayout struct {
+	fields          map[string]int // field name to number
+	names           map[int]string // field number to name
+	reservedNumbers []int          // excluding the extension range
+	reservedNames   []string
+}
+
+var (
+	protoMessageLine  = regexp.MustCompile(`^message (\w+) \{$`)
+	protoFieldLine    = regexp.MustCompile(`^  (?:repeated |optional )?[\w.]+ (\w+) = (\d+)(?: \[.*\])?;$`)
+	protoReservedLine = regexp.MustCompile(`^  reserved (.+);$`)
+)
+
+// parseMessageLayouts reads the top-level messages of generated .proto content
+func parseMessageLayouts(content string) map[string]*messageLayout {
+	layouts := make(map[string]*messageLayout)
+	var current *messageLayout
+
+	for _, line := range strings.Split(content, "\n") {
+		if m := protoMessageLine.FindStringSubmatch(line); m != nil {
+			current = &messageLayout{fields: make(map[string]int), names: make(map[int]string)}
+			layouts[m[1]] = current
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if line == "}" {
+			current = nil
+			continue
+		}
+
+		if m := protoFieldLine.FindStringSubmatch(line); m != nil {
+			num, _ := strconv.Atoi(m[2])
+			current.fields[m[1]] = num
+			current.names[num] = m[1]
+		} else if m := protoReservedLine.FindStringSubmatch(line); m != nil && line+"\n" != reservedExtensionRange {
+			for _, item := range strings.Split(m[1], ", ") {
+				if num, err := strconv.Atoi(item); err == nil {
+					current.reservedNumbers = append(current.reservedNumbers, num)
+				} else if name, err := strconv.Unquote(item); err == nil {
+					current.reservedNames = append(current.reservedNames, name)
+				}
+			}
+		}
+	}
+
+	return layouts
+}
+
+// reserveRetiredFields carries field numbers and names that the previous
+// generation of a file used, but content no longer does, into reserved
+// statements, so a later schema version can't silently reuse them. A retired
+// number already reassigned to another field is only logged, as reserving it
+// would make the message invalid.
+func reserveRetiredFields(content, previous string) string {
+	before := parseMessageLayouts(previous)
+	after := parseMessageLayouts(content)
+
+	retired := make(map[string]string) // message name to reserved statements
+	for name, layout := range after {
+		old, ok := before[name]
+		if !ok {
+			continue
+		}
+
+		numbers := old.reservedNumbers
+		names := old.reservedNames
+		for field, num := range old.fields {
+			if _, ok := layout.fields[field]; !ok {
+				numbers = append(numbers, num)
+				names = append(names, field)
+			}
+		}
+
+		var keep []int
+		for _, num := range numbers {
+			if field, ok := layout.names[num]; ok {
+				log.Printf("Warning: %s field number %d was retired but is reused by %s", name, num, field)
+				continue
+			}
+			keep = append(keep, num)
+		}
+		names = slices.DeleteFunc(names, func(field string) bool {
+			_, ok := layout.fields[field]
+			return ok
+		})
+		if len(keep) == 0 && len(names) == 0 {
+			continue
+		}
+
+		slices.Sort(keep)
+		slices.Sort(names)
+		var sb strings.Builder
+		if keep = slices.Compact(keep); len(keep) > 0 {
+			items := make([]string, len(keep))
+			for i, num := range keep {
+				items[i] = strconv.Itoa(num)
+			}
+			sb.WriteString(fmt.Sprintf("  reserved %s;\n", strings.Join(items, ", ")))
+		}
+		if names = slices.Compact(names); len(names) > 0 {
+			items := make([]string, len(names))
+			for i, field := range names {
+				items[i] = strconv.Quote(field)
+			}
+			sb.WriteString(fmt.Sprintf("  reserved %s;\n", strings.Join(items, ", ")))
+		}
+		retired[name] = sb.String()
+	}
+
+	if len(retired) == 0 {
+		return content
+	}
+
+	var out strings.Builder
+	current := ""
+	for _, line := range strings.SplitAfter(content, "\n") {
+		if m := protoMessageLine.FindStringSubmatch(strings.TrimSuffix(line, "\n")); m != nil {
+			current = m[1]
+		}
+		if line == reservedExtensionRange {
+			out.WriteString(retired[current])
+		}
+		out.WriteString(line)
+	}
+	return out.String()
+}
+
+// loadSpec loads the schema graph reachable from a spec's entry schemas, its
+// main file followed by any extraEntries
+func loadSpec(spec struct{ name, version, mainFile string }) (*loadState, error) {
+	var entryPath string
+
+	// Handle AVS specs differently - they're in the schema directory root
+	if spec.name == "avs" {
+		entryPath = filepath.Join(*schemaDir, spec.mainFile)
+	} else {
+		schemasDir := filepath.Join(*schemaDir, spec.name+"v"+spec.version)
+		entryPath = filepath.Join(schemasDir, spec.mainFile)
+		if _, err := os.Stat(entryPath); os.IsNotExist(err) {
+			entryPath = filepath.Join(schemasDir, strings.ReplaceAll(spec.mainFile, "-", "_"))
+		}
+	}
+
+	st := newLoadState()
+	st.overlayDir, st.schemaRoot = *schemaOverlay, *schemaDir
+	if err := loadSchemaGraph(st, entryPath, ""); err != nil {
+		return nil, fmt.Errorf("load graph: %w", err)
+	}
+	for _, file := range extraEntries[spec.name+"@"+spec.version] {
+		if err := loadSchemaGraph(st, filepath.Join(filepath.Dir(entryPath), file), ""); err != nil {
+			return nil, fmt.Errorf("load graph: %w", err)
+		}
+	}
+	for _, b := range st.nsBundles {
+		hoistInlineTypes(b)
+	}
+	return st, nil
+}
+
+// hoistInlineTypes turns the anonymous complexType of every element nested in
+// a sequence or choice into a named complex type of the bundle, named after
+// the enclosing type and the element (e.g. ReleaseDetails in Release), so it
+// gets its own message rather than falling back to a string field. A type
+// attribute takes precedence over an inline complexType.
+func hoistInlineTypes(b *NamespaceBundle) {
+	taken := make(map[string]bool)
+	for _, el := range b.Elements {
+		if el.ComplexType != nil {
+			taken[toProtoMessageName(el.Name)] = true
+		}
+	}
+	for _, ct := range b.ComplexTypes {
+		taken[toProtoMessageName(ct.Name)] = true
+	}
+
+	var hoisted []XSDComplexType
+	for _, el := range b.Elements {
+		if el.ComplexType != nil {
+			hoistFromComplexType(toProtoMessageName(el.Name), el.ComplexType, taken, &hoisted)
+		}
+	}
+	for i := range b.ComplexTypes {
+		hoistFromComplexType(toProtoMessageName(b.ComplexTypes[i].Name), &b.ComplexTypes[i], taken, &hoisted)
+	}
+	b.ComplexTypes = append(b.ComplexTypes, hoisted...)
+}
+
+func hoistFromComplexType(parent string, ct *XSDComplexType, taken map[string]bool, hoisted *[]XSDComplexType) {
+	if ct.Sequence != nil {
+		hoistFromSequence(parent, ct.Sequence, taken, hoisted)
+	}
+	if ct.Choice != nil {
+		hoistFromChoice(parent, ct.Choice, taken, hoisted)
+	}
+}
+
+func hoistFromSequence(parent string, sequence *XSDSequence, taken map[string]bool, hoisted *[]XSDComplexType) {
+	hoistFromElements(parent, sequence.Elements, taken, hoisted)
+	for i := range sequence.Choices {
+		hoistFromChoice(parent, &sequence.Choices[i], taken, hoisted)
+	}
+	for i := range sequence.Sequences {
+		hoistFromSequence(parent, &sequence.Sequences[i], taken, hoisted)
+	}
+}
+
+func hoistFromChoice(parent string, choice *XSDChoice, taken map[string]bool, hoisted *[]XSDComplexType) {
+	hoistFromElements(parent, choice.Elements, taken, hoisted)
+	for i := range choice.Sequences {
+		hoistFromSequence(parent, &choice.Sequences[i], taken, hoisted)
+	}
+	for i := range choice.Choices {
+		hoistFromChoice(parent, &choice.Choices[i], taken, hoisted)
+	}
+}
+
+func hoistFromElements(parent string, elements []XSDElement, taken map[string]bool, hoisted *[]XSDComplexType) {
+	for i := range elements {
+		el := &elements[i]
+		if el.ComplexType == nil {
+			continue
+		}
+		if el.Type != "" {
+			log.Printf("Element %s in %s has both type %s and an inline complexType; using the type", el.Name, parent, el.Type)
+			continue
+		}
+
+		name := parent + toProtoMessageName(el.Name)
+		for n := 2; taken[name]; n++ {
+			name = fmt.Sprintf("%s%s%d", parent, toProtoMessageName(el.Name), n)
+		}
+		taken[name] = true
+		el.inlineMessage = name
+
+		ct := *el.ComplexType
+		ct.Name = name
+		hoistFromComplexType(name, &ct, taken, hoisted)
+		*hoisted = append(*hoisted, ct)
+	}
+}
+
+// planBundles returns the sorted namespaces of a loaded spec along with the
+// package and file path each one is emitted to, with Go packages under goRoot
+func planBundles(st *loadState, spec struct{ name, version, mainFile string }, goRoot string) ([]string, map[string]protoPkgInfo) {
+	// Emit one .proto per namespace bundle.
+	// We need deterministic order for stable builds.
+	var namespaces []string
+	for ns := range st.nsBundles {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	// Pre-compute package + file paths for imports
+	pkgs := make(map[string]protoPkgInfo) // ns → info
+	for _, ns := range namespaces {
+		bundle := st.nsBundles[ns]
+		pkg := namespaceToProtoPackage(ns, bundle, spec)
+		goPkg := namespaceToGoPackage(ns, bundle, spec, goRoot)
+		path := packageToPath(pkg)
+		pkgs[ns] = protoPkgInfo{pkgName: pkg, goPackage: goPkg, filePath: path}
+	}
+
+	return namespaces, pkgs
+}
+
+// generateBundles renders the .proto content of every namespace bundle on a
+// bounded pool of workers. Bundles are independent once pkgs is computed, and
+// results are indexed like namespaces so output order doesn't depend on scheduling.
+func generateBundles(st *loadState, namespaces []string, pkgs map[string]protoPkgInfo) ([]string, error) {
+	contents := make([]string, len(namespaces))
+	errs := make([]error, len(namespaces))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(runtime.GOMAXPROCS(0), len(namespaces)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				ns := namespaces[i]
+				info := pkgs[ns]
+				content, err := generateProtoForBundle(st.nsBundles[ns], info.pkgName, info.goPackage, pkgs, st.avsVersionContext)
+				if err != nil {
+					errs[i] = fmt.Errorf("generate for ns %s: %w", ns, err)
+					continue
+				}
+				contents[i] = content
+			}
+		}()
+	}
+
+	for i := range namespaces {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+//
+// =======================
+// Schema metadata
+// =======================
+//
+
+// schemaMetadataSuffix names the JSON file written beside each .proto, which
+// the ddex package embeds and serves through LookupSchema
+const schemaMetadataSuffix = ".schema.json"
+
+// schemaMetadata is the machine-readable view of a namespace bundle: its
+// types as the XSD declares them, before any proto naming or numbering
+type schemaMetadata struct {
+	Namespace string       `json:"namespace"`
+	Package   string       `json:"package"`
+	Types     []schemaType `json:"types,omitempty"`
+	Enums     []schemaEnum `json:"enums,omitempty"`
+}
+
+type schemaType struct {
+	Name   string        `json:"name"`
+	Root   bool          `json:"root,omitempty"` // a top-level element rather than a named complexType
+	Fields []schemaField `json:"fields,omitempty"`
+}
+
+type schemaField struct {
+	Name      string `json:"name,omitempty"` // empty for text and any
+	Type      string `json:"type,omitempty"` // XSD type as written, e.g. xs:string, avs:ReleaseType
+	Kind      string `json:"kind"`           // element, attribute, text or any
+	MinOccurs int    `json:"minOccurs"`
+	MaxOccurs int    `json:"maxOccurs"` // -1 for unbounded
+
+	simpleType *XSDSimpleType // an anonymous simpleType declared in place of Type
+}
+
+type schemaEnum struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// generateSchemaMetadata describes the elements, attributes, cardinalities
+// and enumerations of a bundle as JSON, in schema order
+func generateSchemaMetadata(b *NamespaceBundle, pkgName string) ([]byte, error) {
+	metadata := schemaMetadata{Namespace: b.TargetNamespace, Package: pkgName}
+
+	seen := make(map[string]bool)
+	for _, el := range b.Elements {
+		if el.ComplexType != nil && !seen[el.Name] {
+			seen[el.Name] = true
+			metadata.Types = append(metadata.Types, schemaType{Name: el.Name, Root: true, Fields: complexTypeFields(el.ComplexType)})
+		}
+	}
+	for _, ct := range b.ComplexTypes {
+		if ct.Name != "" && !seen[ct.Name] {
+			seen[ct.Name] = true
+			metadata.Types = append(metadata.Types, schemaType{Name: ct.Name, Fields: complexTypeFields(&ct)})
+		}
+	}
+
+	seenEnums := make(map[string]bool)
+	for _, st := range b.SimpleTypes {
+		if st.Name == "" || st.Restriction == nil || len(st.Restriction.Enumerations) == 0 || seenEnums[st.Name] {
+			continue
+		}
+		seenEnums[st.Name] = true
+		enum := schemaEnum{Name: st.Name}
+		for _, e := range st.Restriction.Enumerations {
+			enum.Values = append(enum.Values, e.Value)
+		}
+		metadata.Enums = append(metadata.Enums, enum)
+	}
+
+	return json.Marshal(metadata)
+}
+
+// complexTypeFields lists the fields of a complex type in the order the
+// generated message declares them. Elements of a choice are optional, and
+// elements of a repeatable particle are unbounded.
+func complexTypeFields(ct *XSDComplexType) []schemaField {
+	var fields []schemaField
+	if ct.Sequence != nil {
+		sequenceSchemaFields(ct.Sequence, false, false, &fields)
+	}
+	if ct.Choice != nil {
+		choiceSchemaFields(ct.Choice, false, &fields)
+	}
+	if ct.SimpleContent != nil && ct.SimpleContent.Extension != nil {
+		fields = append(fields, schemaField{Type: ct.SimpleContent.Extension.Base, Kind: "text", MinOccurs: 1, MaxOccurs: 1})
+		for _, attr := range ct.SimpleContent.Extension.Attributes {
+			fields = append(fields, attributeSchemaField(attr))
+		}
+	}
+	for _, attr := range ct.Attributes {
+		fields = append(fields, attributeSchemaField(attr))
+	}
+	if ct.Mixed && ct.SimpleContent == nil {
+		fields = append(fields, schemaField{Type: "xs:string", Kind: "text", MaxOccurs: 1})
+	}
+	return fields
+}
+
+func sequenceSchemaFields(sequence *XSDSequence, optional, repeated bool, fields *[]schemaField) {
+	for _, particle := range sequence.particles() {
+		switch particle.kind {
+		case "element":
+			*fields = append(*fields, elementSchemaField(sequence.Elements[particle.index], optional, repeated))
+		case "choice":
+			choiceSchemaFields(&sequence.Choices[particle.index], repeated, fields)
+		case "sequence":
+			sequenceSchemaFields(&sequence.Sequences[particle.index], optional, repeated, fields)
+		case "any":
+			*fields = append(*fields, anySchemaField(sequence.Any[particle.index], optional, repeated))
+		}
+	}
+}
+
+func choiceSchemaFields(choice *XSDChoice, repeated bool, fields *[]schemaField) {
+	repeated = repeated || choice.MaxOccurs == "unbounded"
+	for _, el := range choice.Elements {
+		*fields = append(*fields, elementSchemaField(el, true, repeated))
+	}
+	for _, seq := range choice.Sequences {
+		sequenceSchemaFields(&seq, true, repeated, fields)
+	}
+	for _, nested := range choice.Choices {
+		choiceSchemaFields(&nested, repeated, fields)
+	}
+	for _, wildcard := range choice.Any {
+		*fields = append(*fields, anySchemaField(wildcard, true, repeated))
+	}
+}
+
+func elementSchemaField(el XSDElement, optional, repeated bool) schemaField {
+	lower, upper := occurs(el.MinOccurs, el.MaxOccurs, optional, repeated)
+	field := schemaField{Name: el.Name, Type: el.Type, Kind: "element", MinOccurs: lower, MaxOccurs: upper, simpleType: el.SimpleType}
+	if el.inlineMessage != "" {
+		field.Type = el.inlineMessage
+	}
+	return field
+}
+
+func anySchemaField(wildcard XSDAny, optional, repeated bool) schemaField {
+	lower, upper := occurs(wildcard.MinOccurs, wildcard.MaxOccurs, optional, repeated)
+	return schemaField{Kind: "any", MinOccurs: lower, MaxOccurs: upper}
+}
+
+func attributeSchemaField(attr XSDAttribute) schemaField {
+	field := schemaField{Name: attr.Name, Type: attr.Type, Kind: "attribute", MaxOccurs: 1, simpleType: attr.SimpleType}
+	if attr.Use == "required" {
+		field.MinOccurs = 1
+	}
+	return field
+}
+
+// occurs resolves minOccurs and maxOccurs (both default 1), with optional and
+// repeated carrying the cardinality of the enclosing particles
+func occurs(minOccurs, maxOccurs string, optional, repeated bool) (int, int) {
+	lower, upper := 1, 1
+	if n, err := strconv.Atoi(minOccurs); err == nil {
+		lower = n
+	}
+	switch n, err := strconv.Atoi(maxOccurs); {
+	case maxOccurs == "unbounded":
+		upper = -1
+	case err == nil:
+		upper = n
+	}
+	if optional {
+		lower = 0
+	}
+	if repeated {
+		upper = -1
+	}
+	return lower, upper
+}
+
+//
+// =======================
+// Validation constraints
+// =======================
+//
+
+// validateImport declares the buf.validate field options
+const validateImport = "buf/validate/validate.proto"
+
+var (
+	protoGotagsLine = regexp.MustCompile(`^  // @gotags: xml:"(\w*)(,attr|,chardata|,any)?"`)
+	protoFieldDecl  = regexp.MustCompile(`^  (repeated |optional )?([\w.]+) \w+ = \d+;$`)
+)
+
+// annotateConstraints attaches a (buf.validate.field) option to each field of
+// the bundle's messages that the XSD constrains: required elements and
+// attributes, minOccurs of repeated elements, and the pattern, length and
+// enumeration facets of their simple types. Fields of choice and sequence
+// wrappers are left as they are.
+func annotateConstraints(content string, b *NamespaceBundle, r *constraintResolver) string {
+	types := bundleSchemaFields(b)
+
+	lines := strings.Split(content, "\n")
+	var fields []schemaField
+	var used []bool
+	var tag []string // kind and XML name of the field the last @gotags described
+	annotated := false
+	for i, line := range lines {
+		if m := protoMessageLine.FindStringSubmatch(line); m != nil {
+			fields = types[m[1]]
+			used = make([]bool, len(fields))
+			continue
+		}
+		if line == "}" {
+			fields = nil
+			continue
+		}
+		if m := protoGotagsLine.FindStringSubmatch(line); m != nil {
+			kind := map[string]string{"": "element", ",attr": "attribute", ",chardata": "text", ",any": "any"}[m[2]]
+			tag = []string{kind, m[1]}
+			continue
+		}
+		m := protoFieldDecl.FindStringSubmatch(line)
+		if m == nil || tag == nil {
+			continue
+		}
+		// Fields repeat in the metadata when an element occurs twice, so
+		// each declaration takes the next unused match
+		for j, field := range fields {
+			if used[j] || field.Kind != tag[0] || field.Name != tag[1] {
+				continue
+			}
+			used[j] = true
+			if rules := r.fieldConstraints(field, m[1], m[2], b); len(rules) > 0 {
+				lines[i] = strings.TrimSuffix(line, ";") + fmt.Sprintf(" [(buf.validate.field) = {%s}];", strings.Join(rules, ", "))
+				annotated = true
+			}
+			break
+		}
+		tag = nil
+	}
+
+	if annotated {
+		lines = addProtoImport(lines, validateImport)
 	}
+	return strings.Join(lines, "\n")
 }
 
-//
-// =======================
-// Entry
-// =======================
-//
-
-// 1) Put this near the top-level (package scope), not inside convertSpec:
-
-type protoPkgInfo struct {
-	pkgName   string
-	goPackage string
-	filePath  string // relative to proto root
+// bundleSchemaFields returns the XSD fields of each message a bundle's root
+// elements and complex types generate, by message name
+func bundleSchemaFields(b *NamespaceBundle) map[string][]schemaField {
+	types := make(map[string][]schemaField)
+	for _, el := range b.Elements {
+		if name := toProtoMessageName(el.Name); el.ComplexType != nil && types[name] == nil {
+			types[name] = complexTypeFields(el.ComplexType)
+		}
+	}
+	for i := range b.ComplexTypes {
+		if name := toProtoMessageName(b.ComplexTypes[i].Name); name != "" && types[name] == nil {
+			types[name] = complexTypeFields(&b.ComplexTypes[i])
+		}
+	}
+	return types
 }
 
-func main() {
-	for _, spec := range specs {
-		log.Printf("Converting %s v%s to protobuf (namespace-aware)...", spec.name, spec.version)
+// addProtoImport adds an import to the sorted imports of generated .proto
+// lines, starting the block after the target namespace comment if there is none
+func addProtoImport(lines []string, file string) []string {
+	imp := fmt.Sprintf("import %q;", file)
+	start := slices.IndexFunc(lines, func(line string) bool { return strings.HasPrefix(line, "import ") })
+	if start == -1 {
+		at := slices.IndexFunc(lines, func(line string) bool { return strings.HasPrefix(line, "// Target namespace: ") }) + 2
+		return slices.Insert(lines, at, imp, "")
+	}
+	end := start
+	for end < len(lines) && strings.HasPrefix(lines[end], "import ") {
+		end++
+	}
+	lines = slices.Insert(lines, end, imp)
+	slices.Sort(lines[start : end+1])
+	return lines
+}
 
-		if err := validateSchemas(spec); err != nil {
-			log.Fatalf("Schema validation failed for %s v%s: %v", spec.name, spec.version, err)
-		}
+// constraintResolver looks up the simple types constraints derive from, in
+// the loaded bundles and in the AVS schema a bundle imports, which is loaded
+// on first use as AVS imports are generated as specs of their own
+type constraintResolver struct {
+	st  *loadState
+	avs map[string]*NamespaceBundle // AVS version to its bundle, nil if unavailable
+}
 
-		if err := convertSpec(spec); err != nil {
-			log.Fatalf("Failed to convert %s v%s: %v", spec.name, spec.version, err)
-		}
-	}
+func newConstraintResolver(st *loadState) *constraintResolver {
+	return &constraintResolver{st: st, avs: make(map[string]*NamespaceBundle)}
 }
 
-func validateSchemas(spec struct{ name, version, mainFile string }) error {
-	var entry string
+// simpleType returns the simple type a field of bundle b names, or nil for
+// built-in and unknown types
+func (r *constraintResolver) simpleType(xsdType string, b *NamespaceBundle) *XSDSimpleType {
+	prefix, name, found := strings.Cut(xsdType, ":")
+	if !found {
+		prefix, name = "", xsdType
+	}
 
-	// Handle AVS specs differently - they're in xsd/ root
-	if spec.name == "avs" {
-		entry = filepath.Join("xsd", spec.mainFile)
-	} else {
-		schemasDir := filepath.Join("xsd", spec.name+"v"+spec.version)
-		if _, err := os.Stat(schemasDir); os.IsNotExist(err) {
-			return fmt.Errorf("schema directory %s does not exist", schemasDir)
+	var candidates []*NamespaceBundle
+	switch prefix {
+	case "xs", "xsd":
+		return nil
+	case "avs":
+		candidates = append(candidates, r.avsBundle(r.st.avsVersionContext[b.TargetNamespace]))
+	default:
+		candidates = append(candidates, b)
+		for _, ns := range slices.Sorted(maps.Keys(r.st.nsBundles)) {
+			if prefix != "" && strings.Contains(strings.ToLower(ns), prefix) {
+				candidates = append(candidates, r.st.nsBundles[ns])
+			}
 		}
-		entry = filepath.Join(schemasDir, spec.mainFile)
 	}
 
-	if _, err := os.Stat(entry); os.IsNotExist(err) {
-		if spec.name != "avs" {
-			alt := filepath.Join(filepath.Dir(entry), strings.ReplaceAll(spec.mainFile, "-", "_"))
-			if _, err2 := os.Stat(alt); os.IsNotExist(err2) {
-				return fmt.Errorf("main schema not found; tried %s and %s", entry, alt)
+	for _, c := range candidates {
+		if c == nil {
+			continue
+		}
+		for i := range c.SimpleTypes {
+			if c.SimpleTypes[i].Name == name {
+				return &c.SimpleTypes[i]
 			}
-		} else {
-			return fmt.Errorf("AVS schema not found: %s", entry)
 		}
 	}
 	return nil
 }
 
-//
-// =======================
-// Conversion pipeline
-// =======================
-//
+// avsBundle loads the AVS spec of the given version ("" for latest)
+func (r *constraintResolver) avsBundle(version string) *NamespaceBundle {
+	version = cmp.Or(version, "latest")
+	if b, ok := r.avs[version]; ok {
+		return b
+	}
+	r.avs[version] = nil
+	for _, spec := range specs {
+		if spec.name != "avs" || spec.version != version {
+			continue
+		}
+		st, err := loadSpec(spec)
+		if err != nil {
+			log.Printf("AVS %s unavailable, omitting its enumeration constraints: %v", version, err)
+			break
+		}
+		for _, b := range st.nsBundles {
+			r.avs[version] = b
+		}
+	}
+	return r.avs[version]
+}
 
-func convertSpec(spec struct{ name, version, mainFile string }) error {
-	var entryPath string
+// facets are the constraining facets of a simple type, each taken from the
+// most derived restriction declaring it
+type facets struct {
+	enumerations []string
+	patterns     []string
+	length       string
+	minLength    string
+	maxLength    string
+}
 
-	// Handle AVS specs differently - they're in xsd/ root
-	if spec.name == "avs" {
-		entryPath = filepath.Join("xsd", spec.mainFile)
-	} else {
-		schemasDir := filepath.Join("xsd", spec.name+"v"+spec.version)
-		entryPath = filepath.Join(schemasDir, spec.mainFile)
-		if _, err := os.Stat(entryPath); os.IsNotExist(err) {
-			entryPath = filepath.Join(schemasDir, strings.ReplaceAll(spec.mainFile, "-", "_"))
+func (r *constraintResolver) facets(t *XSDSimpleType, b *NamespaceBundle) facets {
+	var f facets
+	// Bounded in case of a (schema-invalid) circular derivation
+	for depth := 0; t != nil && t.Restriction != nil && depth < 8; depth++ {
+		res := t.Restriction
+		if f.enumerations == nil {
+			for _, e := range res.Enumerations {
+				f.enumerations = append(f.enumerations, e.Value)
+			}
 		}
+		if f.patterns == nil {
+			for _, p := range res.Patterns {
+				f.patterns = append(f.patterns, p.Value)
+			}
+		}
+		f.length = cmp.Or(f.length, facetValue(res.Length))
+		f.minLength = cmp.Or(f.minLength, facetValue(res.MinLength))
+		f.maxLength = cmp.Or(f.maxLength, facetValue(res.MaxLength))
+		t = r.simpleType(res.Base, b)
 	}
+	return f
+}
 
-	st := newLoadState()
-	if err := loadSchemaGraph(st, entryPath); err != nil {
-		return fmt.Errorf("load graph: %w", err)
+func facetValue(f *XSDFacet) string {
+	if f == nil {
+		return ""
 	}
+	return f.Value
+}
 
-	// Create output dir: proto/<spec or inferred>/*
-	outRoot := filepath.Join("proto")
-	if err := os.MkdirAll(outRoot, 0755); err != nil {
-		return err
+// fieldConstraints returns the buf.validate rules, in text format, of a field
+// with the given proto label and type
+func (r *constraintResolver) fieldConstraints(field schemaField, label, fieldType string, b *NamespaceBundle) []string {
+	repeated := label == "repeated "
+
+	var rules []string
+	if field.Kind != "text" && !repeated && field.MinOccurs > 0 {
+		rules = append(rules, "required: true")
+	}
+
+	t := field.simpleType
+	if t == nil && field.Type != "" {
+		t = r.simpleType(field.Type, b)
+	}
+	var kind string
+	var value []string
+	if t != nil {
+		f := r.facets(t, b)
+		switch {
+		case !protoScalarTypes[fieldType]:
+			if len(f.enumerations) > 0 {
+				kind = "enum"
+				value = append(value, "defined_only: true")
+			}
+		case fieldType == "string":
+			kind = "string"
+			if pattern, ok := re2Pattern(f.patterns); ok {
+				value = append(value, "pattern: "+protoString(pattern))
+			}
+			for _, facet := range []struct{ rule, value string }{{"len", f.length}, {"min_len", f.minLength}, {"max_len", f.maxLength}} {
+				if _, err := strconv.ParseUint(facet.value, 10, 64); err == nil {
+					value = append(value, fmt.Sprintf("%s: %s", facet.rule, facet.value))
+				}
+			}
+			if len(f.enumerations) > 0 {
+				quoted := make([]string, len(f.enumerations))
+				for i, e := range f.enumerations {
+					quoted[i] = protoString(e)
+				}
+				value = append(value, fmt.Sprintf("in: [%s]", strings.Join(quoted, ", ")))
+			}
+		}
 	}
 
-	// Emit one .proto per namespace bundle.
-	// We need deterministic order for stable builds.
-	var namespaces []string
-	for ns := range st.nsBundles {
-		namespaces = append(namespaces, ns)
+	var items string
+	if len(value) > 0 {
+		items = fmt.Sprintf("%s: {%s}", kind, strings.Join(value, ", "))
 	}
-	sort.Strings(namespaces)
-
-	// Pre-compute package + file paths for imports
-	pkgs := make(map[string]protoPkgInfo) // ns → info
-	for _, ns := range namespaces {
-		bundle := st.nsBundles[ns]
-		pkg := namespaceToProtoPackage(ns, bundle, spec)
-		goPkg := namespaceToGoPackage(ns, bundle, spec)
-		path := packageToPath(pkg)
-		pkgs[ns] = protoPkgInfo{pkgName: pkg, goPackage: goPkg, filePath: path}
+	switch {
+	case repeated:
+		var repeatedRules []string
+		if field.MinOccurs > 0 {
+			repeatedRules = append(repeatedRules, fmt.Sprintf("min_items: %d", field.MinOccurs))
+		}
+		if items != "" {
+			repeatedRules = append(repeatedRules, fmt.Sprintf("items: {%s}", items))
+		}
+		if len(repeatedRules) > 0 {
+			rules = append(rules, fmt.Sprintf("repeated: {%s}", strings.Join(repeatedRules, ", ")))
+		}
+	case items != "":
+		// Without presence an absent optional value is the zero value,
+		// which the value rules would reject
+		if label == "" && field.MinOccurs == 0 {
+			rules = append(rules, "ignore: IGNORE_IF_ZERO_VALUE")
+		}
+		rules = append(rules, items)
 	}
+	return rules
+}
 
-	for _, ns := range namespaces {
-		b := st.nsBundles[ns]
-		info := pkgs[ns]
-
-		// Ensure directory exists
-		dir := filepath.Join(outRoot, filepath.Dir(info.filePath))
-		if err := os.MkdirAll(dir, 0755); err != nil {
-			return err
+// re2Pattern turns XSD pattern facets, any of which a value may match, into
+// one RE2 expression anchored like XSD patterns implicitly are. Patterns
+// using XSD-only syntax, such as the \i and \c name classes or character
+// class subtraction, have no RE2 equivalent and are dropped.
+func re2Pattern(patterns []string) (string, bool) {
+	if len(patterns) == 0 {
+		return "", false
+	}
+	alternatives := make([]string, len(patterns))
+	for i, p := range patterns {
+		if hasClassSubtraction(p) {
+			return "", false
 		}
-
-		// Build file content
-		content, err := generateProtoForBundle(b, info.pkgName, info.goPackage, pkgs, st.avsVersionContext)
-		if err != nil {
-			return fmt.Errorf("generate for ns %s: %w", ns, err)
+		alternatives[i] = p
+		if len(patterns) > 1 {
+			alternatives[i] = "(?:" + p + ")"
 		}
+	}
+	pattern := "^(?:" + strings.Join(alternatives, "|") + ")$"
+	if _, err := regexp.Compile(pattern); err != nil {
+		return "", false
+	}
+	return pattern, true
+}
 
-		outFile := filepath.Join(outRoot, info.filePath)
-		if err := os.WriteFile(outFile, []byte(content), 0644); err != nil {
-			return fmt.Errorf("write %s: %w", outFile, err)
+// hasClassSubtraction reports whether an XSD pattern subtracts from a
+// character class, as in [a-z-[aeiou]]
+func hasClassSubtraction(pattern string) bool {
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case c == '[' && !inClass:
+			inClass = true
+		case c == ']':
+			inClass = false
+		case c == '-' && inClass && i+1 < len(pattern) && pattern[i+1] == '[':
+			return true
 		}
-		log.Printf("Generated %s", outFile)
 	}
+	return false
+}
 
-	return nil
+// protoString quotes s as a text format string
+func protoString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
 }
 
 //
@@ -295,16 +1999,17 @@ func convertSpec(spec struct{ name, version, mainFile string }) error {
 // =======================
 //
 
-func loadSchemaGraph(st *loadState, filePath string) error {
+// loadSchemaGraph loads the schema at filePath into its namespace bundle and
+// follows its includes and imports. includingNS is the namespace of the
+// schema including this one, or "" for an entry point or import: an included
+// schema without a targetNamespace (a chameleon include) takes it on.
+func loadSchemaGraph(st *loadState, filePath, includingNS string) error {
 	abs, _ := filepath.Abs(filePath)
-	if _, ok := st.visitedFiles[abs]; ok {
-		return nil
-	}
-	st.visitedFiles[abs] = struct{}{}
 
-	data, err := os.ReadFile(abs)
+	patched := st.overlay(abs)
+	data, err := os.ReadFile(patched)
 	if err != nil {
-		return fmt.Errorf("read %s: %w", abs, err)
+		return fmt.Errorf("read %s: %w", patched, err)
 	}
 
 	var schema XSDSchema
@@ -313,10 +2018,24 @@ func loadSchemaGraph(st *loadState, filePath string) error {
 	}
 
 	if schema.TargetNamespace == "" {
-		return fmt.Errorf("schema %s missing targetNamespace", abs)
+		if includingNS == "" {
+			return fmt.Errorf("schema %s missing targetNamespace", abs)
+		}
+		schema.TargetNamespace = includingNS
+	}
+
+	// A chameleon schema is loaded once per namespace that includes it
+	visitKey := abs + "#" + schema.TargetNamespace
+	if _, ok := st.visitedFiles[visitKey]; ok {
+		st.recordCycle(visitKey)
+		return nil
 	}
+	st.visitedFiles[visitKey] = struct{}{}
+	st.loadPath = append(st.loadPath, loadFrame{key: visitKey, ns: schema.TargetNamespace})
+	defer func() { st.loadPath = st.loadPath[:len(st.loadPath)-1] }()
 
 	st.fileToNS[abs] = schema.TargetNamespace
+	source := st.source(abs, patched, data)
 
 	// Get or create namespace bundle
 	b := st.nsBundles[schema.TargetNamespace]
@@ -329,6 +2048,8 @@ func loadSchemaGraph(st *loadState, filePath string) error {
 		st.nsBundles[schema.TargetNamespace] = b
 	}
 
+	b.Sources = append(b.Sources, source)
+
 	// Merge components (includes naturally collapse here)
 	b.Elements = append(b.Elements, schema.Elements...)
 	b.ComplexTypes = append(b.ComplexTypes, schema.ComplexTypes...)
@@ -354,7 +2075,7 @@ func loadSchemaGraph(st *loadState, filePath string) error {
 			continue
 		}
 		next := filepath.Join(baseDir, inc.SchemaLocation)
-		if err := loadSchemaGraph(st, next); err != nil {
+		if err := loadSchemaGraph(st, next, schema.TargetNamespace); err != nil {
 			return err
 		}
 	}
@@ -372,7 +2093,7 @@ func loadSchemaGraph(st *loadState, filePath string) error {
 
 		next := filepath.Join(baseDir, imp.SchemaLocation)
 		// If the imported file has a different targetNamespace, it will get its own bundle.
-		if err := loadSchemaGraph(st, next); err != nil {
+		if err := loadSchemaGraph(st, next, ""); err != nil {
 			return err
 		}
 	}
@@ -380,6 +2101,50 @@ func loadSchemaGraph(st *loadState, filePath string) error {
 	return nil
 }
 
+// overlay returns the file to read for the schema at abs: its counterpart in
+// the overlay directory when there is one, otherwise abs itself. The schema
+// keeps its original path for resolving includes and imports, so a patched
+// file only needs to replace the schemas it fixes.
+func (st *loadState) overlay(abs string) string {
+	if st.overlayDir == "" {
+		return abs
+	}
+	root, _ := filepath.Abs(st.schemaRoot)
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || !filepath.IsLocal(rel) {
+		return abs
+	}
+	patched := filepath.Join(st.overlayDir, rel)
+	if info, err := os.Stat(patched); err != nil || !info.Mode().IsRegular() {
+		return abs
+	}
+	if _, seen := st.fileToNS[abs]; !seen {
+		log.Printf("Overriding schema %s with %s", rel, patched)
+	}
+	return patched
+}
+
+// scowVersionComment matches the version comment DDEX stamps its schemas
+// with, e.g. <!-- SCOW Version v0.9.11 250618 -->
+var scowVersionComment = regexp.MustCompile(`<!--\s*(SCOW Version [^<>]*?)\.?\s*-->`)
+
+// source describes the schema at abs, whose content was read from the file
+// at patched
+func (st *loadState) source(abs, patched string, data []byte) schemaSource {
+	root, _ := filepath.Abs(st.schemaRoot)
+	rel, err := filepath.Rel(root, abs)
+	if st.schemaRoot == "" || err != nil || !filepath.IsLocal(rel) {
+		rel = filepath.Base(abs)
+	}
+	src := schemaSource{Path: filepath.ToSlash(rel), Patched: patched != abs}
+	if m := scowVersionComment.FindSubmatch(data); m != nil {
+		src.Version = string(m[1])
+	}
+	sum := sha256.Sum256(data)
+	src.Sum = hex.EncodeToString(sum[:6])
+	return src
+}
+
 // detectAVSVersion extracts version from AVS schema location
 func detectAVSVersion(schemaLocation string) string {
 	// Look for patterns like "avs_20200108.xsd"
@@ -419,6 +2184,7 @@ func generateProtoForBundle(
 	var sb strings.Builder
 
 	// Header
+	sb.WriteString(provenanceHeader(b))
 	sb.WriteString(`syntax = "proto3";` + "\n\n")
 	sb.WriteString(fmt.Sprintf("package %s;\n\n", packageName))
 	sb.WriteString(fmt.Sprintf("option go_package = \"%s\";\n\n", goPackage))
@@ -441,9 +2207,8 @@ func generateProtoForBundle(
 				avsVersion = "latest"
 			}
 
-			// Construct the versioned import path directly
-			versionedPath := fmt.Sprintf("ddex/avs/v%s/v%s.proto", avsVersion, avsVersion)
-			deps = append(deps, versionedPath)
+			// The AVS spec is generated separately, so lay out its path here
+			deps = append(deps, packageToPath(fmt.Sprintf("ddex.avs.v%s", avsVersion)))
 		} else if info, ok := all[ns]; ok {
 			deps = append(deps, info.filePath)
 		}
@@ -538,7 +2303,22 @@ func generateProtoForBundle(
 		}
 	}
 
+	// Shared container for xs:any content
+	usesWildcard := false
+	for _, el := range b.Elements {
+		usesWildcard = usesWildcard || hasWildcard(el.ComplexType)
+	}
+	for _, ct := range b.ComplexTypes {
+		usesWildcard = usesWildcard || hasWildcard(&ct)
+	}
+	if _, exists := generated[anyElementMessage]; usesWildcard && !exists {
+		sb.WriteString(generateAnyElementMessage())
+		sb.WriteString("\n\n")
+		generated[anyElementMessage] = struct{}{}
+	}
+
 	// Simple types with enumerations → enum
+	prefixes := newEnumPrefixes(*enumPrefixStrategy)
 	for _, st := range b.SimpleTypes {
 		if st.Name == "" || st.Restriction == nil || len(st.Restriction.Enumerations) == 0 {
 			continue
@@ -547,7 +2327,14 @@ func generateProtoForBundle(
 		if _, exists := generated[en]; exists {
 			continue
 		}
-		sb.WriteString(generateEnum(st))
+		enum := generateEnum(st, prefixes)
+		if err := checkEnumValues(st, enum); err != nil {
+			if *strict {
+				return "", err
+			}
+			log.Printf("Warning: %v", err)
+		}
+		sb.WriteString(enum)
 		sb.WriteString("\n\n")
 		generated[en] = struct{}{}
 	}
@@ -585,30 +2372,14 @@ func generateComplexTypeMessageWithNamespace(name string, complexType *XSDComple
 
 	// sequence → fields
 	if complexType.Sequence != nil {
-		// Process regular elements with proper repeated field handling
-		for _, element := range complexType.Sequence.Elements {
-			field, err := generateFieldWithDedup(element, fieldNum, allPkgs, usedFieldNames)
-			if err != nil {
-				return "", nil, fmt.Errorf("failed to generate field for element %s: %v", element.Name, err)
-			}
-			// Only add field and increment field number if field was actually generated
-			if field != "" {
-				builder.WriteString(field + "\n")
-				fieldNum++
-			}
-		}
-
-		// Process choice elements within sequence - flatten them directly into the parent message
-		for _, choice := range complexType.Sequence.Choices {
-			err := generateChoiceFields(&choice, &fieldNum, allPkgs, usedFieldNames, &builder)
-			if err != nil {
-				return "", nil, fmt.Errorf("failed to generate choice fields: %v", err)
-			}
+		if err := generateSequenceFields(complexType.Sequence, &fieldNum, allPkgs, usedFieldNames, &builder); err != nil {
+			return "", nil, err
 		}
 	}
 
-	// choice → flatten choice elements directly into the parent message (for top-level choices)
-	if complexType.Choice != nil && complexType.Sequence == nil && (len(complexType.Choice.Elements) > 0 || len(complexType.Choice.Sequences) > 0) {
+	// choice → flatten choice elements directly into the parent message (for
+	// top-level choices, including one alongside a sequence)
+	if complexType.Choice != nil {
 		err := generateChoiceFields(complexType.Choice, &fieldNum, allPkgs, usedFieldNames, &builder)
 		if err != nil {
 			return "", nil, fmt.Errorf("failed to generate top-level choice fields: %v", err)
@@ -618,16 +2389,16 @@ func generateComplexTypeMessageWithNamespace(name string, complexType *XSDComple
 	// simpleContent extension → value + attributes
 	if complexType.SimpleContent != nil && complexType.SimpleContent.Extension != nil {
 		// chardata value
-		injectComment := "  // @gotags: xml:\",chardata\""
+		injectComment := "  // @gotags: xml:\",chardata\"" + avsTag(complexType.SimpleContent.Extension.Base)
 		fieldName := getUniqueFieldName("value", usedFieldNames)
 		builder.WriteString(fmt.Sprintf("%s\n  string %s = %d;\n", injectComment, fieldName, fieldNum))
-		fieldNum++
+		fieldNum = nextFieldNumber(fieldNum)
 
 		// attributes
 		for _, attr := range complexType.SimpleContent.Extension.Attributes {
 			field := generateAttributeFieldWithDedup(attr, fieldNum, allPkgs, usedFieldNames)
 			builder.WriteString(field + "\n")
-			fieldNum++
+			fieldNum = nextFieldNumber(fieldNum)
 		}
 	}
 
@@ -635,7 +2406,7 @@ func generateComplexTypeMessageWithNamespace(name string, complexType *XSDComple
 	for _, attr := range complexType.Attributes {
 		field := generateAttributeFieldWithDedup(attr, fieldNum, allPkgs, usedFieldNames)
 		builder.WriteString(field + "\n")
-		fieldNum++
+		fieldNum = nextFieldNumber(fieldNum)
 	}
 
 	// Add namespace attributes for root elements
@@ -646,29 +2417,149 @@ func generateComplexTypeMessageWithNamespace(name string, complexType *XSDComple
 
 		if namespacePrefix != "" {
 			// Add the namespace prefix attribute (e.g., xmlns:ern)
-			injectComment := fmt.Sprintf("  // @gotags: xml:\"xmlns:%s,attr\"", namespacePrefix)
+			injectComment := fmt.Sprintf("  // @gotags: xml:\"xmlns:%s,attr,omitempty\"", namespacePrefix)
 			field := fmt.Sprintf("%s\n  string xmlns_%s = %d;", injectComment, namespacePrefix, fieldNum)
 			builder.WriteString(field + "\n")
-			fieldNum++
+			fieldNum = nextFieldNumber(fieldNum)
 		}
 
 		// Add XSI namespace attribute
 		injectComment := fmt.Sprintf("  // @gotags: xml:\"xmlns:xsi,attr\"")
 		field := fmt.Sprintf("%s\n  string xmlns_xsi = %d;", injectComment, fieldNum)
 		builder.WriteString(field + "\n")
-		fieldNum++
+		fieldNum = nextFieldNumber(fieldNum)
 
 		// Add schema location attribute (this one needs xsi: prefix, not xmlns:)
 		injectComment = fmt.Sprintf("  // @gotags: xml:\"xsi:schemaLocation,attr\"")
 		field = fmt.Sprintf("%s\n  string xsi_schema_location = %d;", injectComment, fieldNum)
 		builder.WriteString(field + "\n")
-		fieldNum++
+		fieldNum = nextFieldNumber(fieldNum)
+	}
+
+	// xs:any → catch-all for foreign elements, kept as raw XML so they survive a round-trip.
+	// Appended last so existing field numbers stay stable.
+	if hasWildcard(complexType) {
+		injectComment := "  // @gotags: xml:\",any\""
+		fieldName := getUniqueFieldName("any_element", usedFieldNames)
+		builder.WriteString(fmt.Sprintf("%s\n  repeated %s %s = %d;\n", injectComment, anyElementMessage, fieldName, fieldNum))
+		fieldNum = nextFieldNumber(fieldNum)
+	}
+
+	// mixed content → text between child elements
+	if complexType.Mixed && complexType.SimpleContent == nil {
+		injectComment := "  // @gotags: xml:\",chardata\""
+		fieldName := getUniqueFieldName("value", usedFieldNames)
+		builder.WriteString(fmt.Sprintf("%s\n  string %s = %d;\n", injectComment, fieldName, fieldNum))
+		fieldNum = nextFieldNumber(fieldNum)
+	}
+
+	// The prefix a parsed document bound the root namespace to, when not the
+	// default, so marshaling can declare it again. Appended last so existing
+	// field numbers stay stable.
+	if isRootElement && targetNamespace != "" && extractNamespacePrefix(targetNamespace) != "" {
+		fieldName := getUniqueFieldName("root_prefix", usedFieldNames)
+		builder.WriteString(rootPrefixComment)
+		builder.WriteString(fmt.Sprintf("  // @gotags: xml:\"-\"\n  string %s = %d;\n", fieldName, fieldNum))
+		fieldNum = nextFieldNumber(fieldNum)
 	}
 
+	builder.WriteString(reservedExtensionRange)
 	builder.WriteString("}")
 	return builder.String(), wrapperTypes, nil
 }
 
+// rootPrefixComment documents the root_prefix field in the generated proto.
+// It is a proto field because the Go structs come from protoc-gen-go, which
+// has no way to add Go-only fields.
+const rootPrefixComment = `  // Prefix the parsed document bound the root namespace to, if not the
+  // default one, so that marshaling declares it again. Like the xmlns_*
+  // fields it records how the document was serialized rather than content:
+  // it is carried in the wire format and compared by proto.Equal, while
+  // ddex.EqualIgnoringNamespaces ignores it.
+`
+
+// reservedExtensionRange closes every generated message, keeping a block of
+// field numbers free for hand-added extension fields
+const reservedExtensionRange = "  reserved 9000 to 9999;\n"
+
+// Field numbers a message can't assign: the extension block of
+// reservedExtensionRange and the 19000–19999 range protobuf reserves for its
+// own implementation
+const (
+	extensionRangeStart     = 9000
+	extensionRangeEnd       = 9999
+	protoReservedRangeStart = 19000
+	protoReservedRangeEnd   = 19999
+)
+
+// nextFieldNumber returns the field number after n, skipping the reserved
+// ranges so very large types still compile
+func nextFieldNumber(n int) int {
+	n++
+	switch n {
+	case extensionRangeStart:
+		n = extensionRangeEnd + 1
+	case protoReservedRangeStart:
+		n = protoReservedRangeEnd + 1
+	}
+	return n
+}
+
+// anyElementMessage is the message generated for xs:any content. Its raw XML
+// is (un)marshaled by the methods generate-go-extensions adds for it.
+const anyElementMessage = "AnyElement"
+
+// generateAnyElementMessage returns the message holding a single foreign element
+func generateAnyElementMessage() string {
+	return fmt.Sprintf("message %s {\n  // @gotags: xml:\"-\"\n  string raw_xml = 1;\n%s}", anyElementMessage, reservedExtensionRange)
+}
+
+// hasWildcard reports whether a complex type admits xs:any elements, directly
+// or through a choice or sequence flattened into it
+func hasWildcard(complexType *XSDComplexType) bool {
+	if complexType == nil {
+		return false
+	}
+	if complexType.Sequence != nil && sequenceHasWildcard(complexType.Sequence) {
+		return true
+	}
+	return complexType.Choice != nil && choiceHasWildcard(complexType.Choice)
+}
+
+func sequenceHasWildcard(sequence *XSDSequence) bool {
+	if len(sequence.Any) > 0 {
+		return true
+	}
+	for _, choice := range sequence.Choices {
+		if choiceHasWildcard(&choice) {
+			return true
+		}
+	}
+	for _, nested := range sequence.Sequences {
+		if sequenceHasWildcard(&nested) {
+			return true
+		}
+	}
+	return false
+}
+
+func choiceHasWildcard(choice *XSDChoice) bool {
+	if len(choice.Any) > 0 {
+		return true
+	}
+	for _, seq := range choice.Sequences {
+		if sequenceHasWildcard(&seq) {
+			return true
+		}
+	}
+	for _, nested := range choice.Choices {
+		if choiceHasWildcard(&nested) {
+			return true
+		}
+	}
+	return false
+}
+
 // extractNamespacePrefix extracts the namespace prefix from a target namespace URL
 // e.g., "http://ddex.net/xml/ern/43" -> "ern"
 // e.g., "http://ddex.net/xml/mead/11" -> "mead"
@@ -722,66 +2613,98 @@ func generateFieldWithDedup(element XSDElement, fieldNum int, allPkgs map[string
 	}
 
 	// Type mapping
-	fieldType := "string" // default
+	fieldType := elementProtoType(element, allPkgs)
+
+	// Cardinality
+	repeated := fieldLabel(element, fieldType)
+
+	// gotags for xml element name
+	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"%s", element.Name, avsTag(element.Type))
+
+	return fmt.Sprintf("%s\n  %s%s %s = %d;", injectComment, repeated, fieldType, fieldName, fieldNum), nil
+}
+
+// elementProtoType maps an element's type, or the message hoisted from its
+// inline complexType, to a proto type
+func elementProtoType(element XSDElement, allPkgs map[string]protoPkgInfo) string {
+	if element.inlineMessage != "" {
+		return element.inlineMessage
+	}
 	if element.Type != "" {
-		fieldType = xsdTypeToProto(element.Type, allPkgs)
+		return xsdTypeToProto(element.Type, allPkgs)
 	}
+	return "string"
+}
 
-	// Cardinality
-	repeated := ""
+// fieldLabel returns the proto label of an element's field: repeated for
+// unbounded elements, and optional for minOccurs="0" scalars so an absent
+// element (nil) stays distinguishable from an empty one
+func fieldLabel(element XSDElement, fieldType string) string {
 	if element.MaxOccurs == "unbounded" {
-		repeated = "repeated "
+		return "repeated "
+	}
+	if element.MinOccurs == "0" && protoScalarTypes[fieldType] {
+		return "optional "
 	}
+	return ""
+}
 
-	// gotags for xml element name
-	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"", element.Name)
+// attributeLabel returns the proto label of an attribute's field: optional
+// for scalar attributes that are not use="required", so an absent attribute
+// is not marshaled back with its zero value
+func attributeLabel(attr XSDAttribute, fieldType string) string {
+	if attr.Use != "required" && protoScalarTypes[fieldType] {
+		return "optional "
+	}
+	return ""
+}
 
-	return fmt.Sprintf("%s\n  %s%s %s = %d;", injectComment, repeated, fieldType, fieldName, fieldNum), nil
+// protoScalarTypes are the proto types xsdTypeToProto maps built-in XSD types to
+var protoScalarTypes = map[string]bool{
+	"string": true, "int32": true, "int64": true, "uint32": true, "uint64": true,
+	"bool": true, "double": true, "bytes": true,
 }
 
 // generateChoiceFieldWithDedup generates a choice field with deduplication
 func generateChoiceFieldWithDedup(element XSDElement, fieldNum int, allPkgs map[string]protoPkgInfo, usedFieldNames map[string]int) (string, error) {
 	fieldName := getUniqueFieldName(toProtoFieldName(element.Name), usedFieldNames)
 
-	fieldType := "string"
-	if element.Type != "" {
-		fieldType = xsdTypeToProto(element.Type, allPkgs)
-	}
+	fieldType := elementProtoType(element, allPkgs)
 
-	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"", element.Name)
+	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"%s", element.Name, avsTag(element.Type))
 	return fmt.Sprintf("%s\n    %s %s = %d;", injectComment, fieldType, fieldName, fieldNum), nil
 }
 
-// generateAttributeFieldWithDedup generates an attribute field with deduplication
+// generateAttributeFieldWithDedup generates an attribute field with deduplication.
+// An attribute named like a child element (legal in XSD) becomes <name>_attr
+// rather than a numeric suffix, keeping its XML name in the field name.
 func generateAttributeFieldWithDedup(attr XSDAttribute, fieldNum int, allPkgs map[string]protoPkgInfo, usedFieldNames map[string]int) string {
-	fieldName := getUniqueFieldName(toProtoFieldName(attr.Name), usedFieldNames)
+	baseName := toProtoFieldName(attr.Name)
+	if _, exists := usedFieldNames[baseName]; exists {
+		baseName += "_attr"
+	}
+	fieldName := getUniqueFieldName(baseName, usedFieldNames)
 
 	fieldType := "string"
 	if attr.Type != "" {
 		fieldType = xsdTypeToProto(attr.Type, allPkgs)
 	}
 
-	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s,attr\"", attr.Name)
-	return fmt.Sprintf("%s\n  %s %s = %d;", injectComment, fieldType, fieldName, fieldNum)
+	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s,attr\"%s", attr.Name, avsTag(attr.Type))
+	return fmt.Sprintf("%s\n  %s%s %s = %d;", injectComment, attributeLabel(attr, fieldType), fieldType, fieldName, fieldNum)
 }
 
 func generateField(element XSDElement, fieldNum int, allPkgs map[string]protoPkgInfo) (string, error) {
 	fieldName := toProtoFieldName(element.Name)
 
 	// Type mapping
-	fieldType := "string" // default
-	if element.Type != "" {
-		fieldType = xsdTypeToProto(element.Type, allPkgs)
-	}
+	fieldType := elementProtoType(element, allPkgs)
 
 	// Cardinality
-	repeated := ""
-	if element.MaxOccurs == "unbounded" {
-		repeated = "repeated "
-	}
+	repeated := fieldLabel(element, fieldType)
 
 	// gotags for xml element name
-	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"", element.Name)
+	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"%s", element.Name, avsTag(element.Type))
 
 	return fmt.Sprintf("%s\n  %s%s %s = %d;", injectComment, repeated, fieldType, fieldName, fieldNum), nil
 }
@@ -789,12 +2712,9 @@ func generateField(element XSDElement, fieldNum int, allPkgs map[string]protoPkg
 func generateChoiceField(element XSDElement, fieldNum int, allPkgs map[string]protoPkgInfo) (string, error) {
 	fieldName := toProtoFieldName(element.Name)
 
-	fieldType := "string"
-	if element.Type != "" {
-		fieldType = xsdTypeToProto(element.Type, allPkgs)
-	}
+	fieldType := elementProtoType(element, allPkgs)
 
-	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"", element.Name)
+	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"%s", element.Name, avsTag(element.Type))
 	return fmt.Sprintf("%s\n    %s %s = %d;", injectComment, fieldType, fieldName, fieldNum), nil
 }
 
@@ -806,45 +2726,168 @@ func generateAttributeField(attr XSDAttribute, fieldNum int, allPkgs map[string]
 		fieldType = xsdTypeToProto(attr.Type, allPkgs)
 	}
 
-	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s,attr\"", attr.Name)
-	return fmt.Sprintf("%s\n  %s %s = %d;", injectComment, fieldType, fieldName, fieldNum)
+	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s,attr\"%s", attr.Name, avsTag(attr.Type))
+	return fmt.Sprintf("%s\n  %s%s %s = %d;", injectComment, attributeLabel(attr, fieldType), fieldType, fieldName, fieldNum)
+}
+
+// enumPrefixes hands out enum value prefixes for a single proto package.
+// Proto enum values share the package scope, so prefixes must be unique across
+// all enums in the package.
+type enumPrefixes struct {
+	strategy string
+	used     map[string]struct{}
+}
+
+func newEnumPrefixes(strategy string) *enumPrefixes {
+	return &enumPrefixes{strategy: strategy, used: make(map[string]struct{})}
+}
+
+// prefixFor returns the value prefix for the named enum
+func (p *enumPrefixes) prefixFor(name string) string {
+	// Use the full enum name as prefix to avoid collisions between different enums
+	full := strings.ToUpper(toProtoFieldName(name))
+	// Clean up any double underscores
+	for strings.Contains(full, "__") {
+		full = strings.ReplaceAll(full, "__", "_")
+	}
+	if p.strategy != enumPrefixAbbrev {
+		return full
+	}
+
+	// Abbreviate to the initial of each word, numbering repeats to keep them unique.
+	// Abbreviations contain no underscores, so PREFIX_VALUE can't collide across enums.
+	var abbrev strings.Builder
+	for _, word := range strings.Split(strings.Trim(full, "_"), "_") {
+		if word != "" {
+			abbrev.WriteByte(word[0])
+		}
+	}
+	prefix := abbrev.String()
+	for n := 2; ; n++ {
+		if _, taken := p.used[prefix]; !taken {
+			break
+		}
+		prefix = fmt.Sprintf("%s%d", abbrev.String(), n)
+	}
+	p.used[prefix] = struct{}{}
+	return prefix
 }
 
-func generateEnum(simpleType XSDSimpleType) string {
+func generateEnum(simpleType XSDSimpleType, prefixes *enumPrefixes) string {
 	var builder strings.Builder
 
-	enumName := strings.ReplaceAll(toProtoMessageName(simpleType.Name), "_", "")
-	builder.WriteString(fmt.Sprintf("enum %s {\n", enumName))
+	enumName := strings.ReplaceAll(toProtoMessageName(simpleType.Name), "_", "")
+	builder.WriteString(fmt.Sprintf("enum %s {\n", enumName))
+
+	enumPrefix := prefixes.prefixFor(simpleType.Name)
+
+	builder.WriteString(fmt.Sprintf("  %s_UNSPECIFIED = 0;\n", enumPrefix))
+
+	// Each value carries its exact XSD string in an @xml comment, as distinct
+	// values can normalize to the same constant name
+	seenValues := make(map[string]struct{}) // XSD values
+	usedNames := make(map[string]struct{})  // proto value names
+	var collided []string
+	valueIndex := 1
+
+	writeValue := func(enumValue, original string) {
+		usedNames[enumValue] = struct{}{}
+		builder.WriteString(fmt.Sprintf("  // @xml: %s\n", strconv.Quote(original)))
+		builder.WriteString(fmt.Sprintf("  %s = %d;\n", enumValue, valueIndex))
+		valueIndex++
+	}
+
+	for _, enum := range simpleType.Restriction.Enumerations {
+		// Skip values listed twice in the schema
+		if _, exists := seenValues[enum.Value]; exists {
+			continue
+		}
+		seenValues[enum.Value] = struct{}{}
+
+		enumValue := enumPrefix + "_" + toProtoEnumValue(enum.Value)
+		if _, exists := usedNames[enumValue]; exists {
+			collided = append(collided, enum.Value)
+			continue
+		}
+		writeValue(enumValue, enum.Value)
+	}
+
+	// Values colliding after normalization (A/B and A-B) get a numeric suffix.
+	// They're numbered last so the other values keep their numbers.
+	for _, original := range collided {
+		base := enumPrefix + "_" + toProtoEnumValue(original)
+		enumValue := base
+		for n := 2; ; n++ {
+			enumValue = fmt.Sprintf("%s_%d", base, n)
+			if _, exists := usedNames[enumValue]; !exists {
+				break
+			}
+		}
+		writeValue(enumValue, original)
+	}
+
+	builder.WriteString("}")
+	return builder.String()
+}
 
-	// Use the full enum name as prefix to avoid collisions between different enums
-	enumPrefix := strings.ToUpper(toProtoFieldName(simpleType.Name))
-	// Clean up any double underscores
-	for strings.Contains(enumPrefix, "__") {
-		enumPrefix = strings.ReplaceAll(enumPrefix, "__", "_")
+// enumValueDecl matches the name and number of a generated enum value
+var enumValueDecl = regexp.MustCompile(`(?m)^  (\w+) = (\d+);$`)
+
+// checkEnumValues reports an enum left with only its _UNSPECIFIED value
+// although the simple type lists enumerations, a sign its values all
+// normalized away. Values normalizing to nothing (e.g. "-" giving PREFIX_,
+// or PREFIX__2 on collision) don't count.
+func checkEnumValues(simpleType XSDSimpleType, enum string) error {
+	for _, m := range enumValueDecl.FindAllStringSubmatch(enum, -1) {
+		if m[2] != "0" && !strings.HasSuffix(m[1], "_") && !strings.Contains(m[1], "__") {
+			return nil
+		}
 	}
+	return fmt.Errorf("enum for simple type %s has no values besides _UNSPECIFIED, though it lists %d enumerations", simpleType.Name, len(simpleType.Restriction.Enumerations))
+}
 
-	builder.WriteString(fmt.Sprintf("  %s_UNSPECIFIED = 0;\n", enumPrefix))
-
-	// Deduplicate enum values to avoid conflicts within the same enum
-	seenValues := make(map[string]struct{})
-	valueIndex := 1
+// generateSequenceFields flattens a sequence into the parent message. Field
+// numbers go to its elements, then its choices, then any nested sequences,
+// so nested particles only ever append numbers, but the fields are declared
+// in the order the particles appear in the XSD: the generated struct, and so
+// the marshaled XML, follows the schema sequence.
+func generateSequenceFields(sequence *XSDSequence, fieldNum *int, allPkgs map[string]protoPkgInfo, usedFieldNames map[string]int, builder *strings.Builder) error {
+	fields := make(map[xsdParticle]string)
 
-	for _, enum := range simpleType.Restriction.Enumerations {
-		rawValue := toProtoEnumValue(enum.Value)
-		enumValue := enumPrefix + "_" + rawValue
+	// Process regular elements with proper repeated field handling
+	for i, element := range sequence.Elements {
+		field, err := generateFieldWithDedup(element, *fieldNum, allPkgs, usedFieldNames)
+		if err != nil {
+			return fmt.Errorf("failed to generate field for element %s: %v", element.Name, err)
+		}
+		// Only add field and increment field number if field was actually generated
+		if field != "" {
+			fields[xsdParticle{"element", i}] = field + "\n"
+			*fieldNum = nextFieldNumber(*fieldNum)
+		}
+	}
 
-		// Skip if we've already seen this enum value
-		if _, exists := seenValues[enumValue]; exists {
-			continue
+	// Process choice elements within sequence - flatten them directly into the parent message
+	for i, choice := range sequence.Choices {
+		var choiceBuilder strings.Builder
+		if err := generateChoiceFields(&choice, fieldNum, allPkgs, usedFieldNames, &choiceBuilder); err != nil {
+			return fmt.Errorf("failed to generate choice fields: %v", err)
 		}
-		seenValues[enumValue] = struct{}{}
+		fields[xsdParticle{"choice", i}] = choiceBuilder.String()
+	}
 
-		builder.WriteString(fmt.Sprintf("  %s = %d;\n", enumValue, valueIndex))
-		valueIndex++
+	for i, nested := range sequence.Sequences {
+		var nestedBuilder strings.Builder
+		if err := generateSequenceFields(&nested, fieldNum, allPkgs, usedFieldNames, &nestedBuilder); err != nil {
+			return err
+		}
+		fields[xsdParticle{"sequence", i}] = nestedBuilder.String()
 	}
 
-	builder.WriteString("}")
-	return builder.String()
+	for _, particle := range sequence.particles() {
+		builder.WriteString(fields[particle])
+	}
+	return nil
 }
 
 // generateChoiceFields flattens choice elements directly into the parent message
@@ -857,21 +2900,21 @@ func generateChoiceFields(choice *XSDChoice, fieldNum *int, allPkgs map[string]p
 		}
 		if field != "" {
 			builder.WriteString(field + "\n")
-			*fieldNum++
+			*fieldNum = nextFieldNumber(*fieldNum)
 		}
 	}
 
-	// Handle sequences within choice - flatten their elements into the parent message
+	// Handle sequences within choice - flatten them, with their own choices,
+	// into the parent message
 	for _, sequence := range choice.Sequences {
-		for _, element := range sequence.Elements {
-			field, err := generateFieldWithDedup(element, *fieldNum, allPkgs, usedFieldNames)
-			if err != nil {
-				return fmt.Errorf("failed to generate choice sequence element field for %s: %v", element.Name, err)
-			}
-			if field != "" {
-				builder.WriteString(field + "\n")
-				*fieldNum++
-			}
+		if err := generateSequenceFields(&sequence, fieldNum, allPkgs, usedFieldNames, builder); err != nil {
+			return fmt.Errorf("failed to generate choice sequence fields: %v", err)
+		}
+	}
+
+	for _, nested := range choice.Choices {
+		if err := generateChoiceFields(&nested, fieldNum, allPkgs, usedFieldNames, builder); err != nil {
+			return err
 		}
 	}
 
@@ -890,10 +2933,7 @@ func generateChoiceWrapper(wrapperName string, choice *XSDChoice, allPkgs map[st
 
 	// Handle direct elements in choice
 	for _, element := range choice.Elements {
-		fieldType := "string"
-		if element.Type != "" {
-			fieldType = xsdTypeToProto(element.Type, allPkgs)
-		}
+		fieldType := elementProtoType(element, allPkgs)
 
 		fieldName := toProtoFieldName(element.Name)
 
@@ -903,7 +2943,7 @@ func generateChoiceWrapper(wrapperName string, choice *XSDChoice, allPkgs map[st
 
 			// Generate a nested message for the repeated element
 			builder.WriteString(fmt.Sprintf("  message %s {\n", optionName))
-			injectComment := fmt.Sprintf("    // @gotags: xml:\"%s\"", element.Name)
+			injectComment := fmt.Sprintf("    // @gotags: xml:\"%s\"%s", element.Name, avsTag(element.Type))
 			field := fmt.Sprintf("%s\n    repeated %s %s = 1;", injectComment, fieldType, fieldName)
 			builder.WriteString(field + "\n")
 			builder.WriteString("  }\n\n")
@@ -914,11 +2954,11 @@ func generateChoiceWrapper(wrapperName string, choice *XSDChoice, allPkgs map[st
 			builder.WriteString(field + "\n")
 		} else {
 			// Add XML tag to make choice fields marshal directly as the element name
-			injectComment := fmt.Sprintf("    // @gotags: xml:\"%s\"", element.Name)
+			injectComment := fmt.Sprintf("    // @gotags: xml:\"%s\"%s", element.Name, avsTag(element.Type))
 			field := fmt.Sprintf("%s\n    %s %s = %d;", injectComment, fieldType, fieldName, oneofFieldNum)
 			builder.WriteString(field + "\n")
 		}
-		oneofFieldNum++
+		oneofFieldNum = nextFieldNumber(oneofFieldNum)
 	}
 
 	// Handle sequences in choice by creating inline fields for each sequence element
@@ -939,7 +2979,7 @@ func generateChoiceWrapper(wrapperName string, choice *XSDChoice, allPkgs map[st
 			}
 			if field != "" {
 				builder.WriteString("  " + field + "\n")
-				fieldNum++
+				fieldNum = nextFieldNumber(fieldNum)
 			}
 		}
 
@@ -950,10 +2990,11 @@ func generateChoiceWrapper(wrapperName string, choice *XSDChoice, allPkgs map[st
 		injectComment := "    // @gotags: xml:\",inline\""
 		field := fmt.Sprintf("%s\n    %s %s = %d;", injectComment, optionName, fieldName, oneofFieldNum)
 		builder.WriteString(field + "\n")
-		oneofFieldNum++
+		oneofFieldNum = nextFieldNumber(oneofFieldNum)
 	}
 
 	builder.WriteString("  }\n")
+	builder.WriteString(reservedExtensionRange)
 	builder.WriteString("}")
 	return builder.String(), nil
 }
@@ -979,19 +3020,13 @@ func generateFieldWithDedupForNested(element XSDElement, fieldNum int, allPkgs m
 	}
 
 	// Type mapping
-	fieldType := "string" // default
-	if element.Type != "" {
-		fieldType = xsdTypeToProto(element.Type, allPkgs)
-	}
+	fieldType := elementProtoType(element, allPkgs)
 
 	// Cardinality
-	repeated := ""
-	if element.MaxOccurs == "unbounded" {
-		repeated = "repeated "
-	}
+	repeated := fieldLabel(element, fieldType)
 
 	// gotags for xml element name
-	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"", element.Name)
+	injectComment := fmt.Sprintf("  // @gotags: xml:\"%s\"%s", element.Name, avsTag(element.Type))
 
 	return fmt.Sprintf("%s\n  %s%s %s = %d;", injectComment, repeated, fieldType, fieldName, fieldNum), nil
 }
@@ -1011,13 +3046,689 @@ func generateSequenceType(typeName string, sequence *XSDSequence, allPkgs map[st
 			return "", fmt.Errorf("failed to generate sequence field for element %s: %v", element.Name, err)
 		}
 		builder.WriteString(field + "\n")
-		fieldNum++
+		fieldNum = nextFieldNumber(fieldNum)
 	}
 
+	builder.WriteString(reservedExtensionRange)
 	builder.WriteString("}")
 	return builder.String(), nil
 }
 
+//
+// =======================
+// Plain Go struct backend (-backend=go)
+// =======================
+//
+
+// goStructsHeader opens every file the Go struct backend writes
+const goStructsHeader = "// Code generated by xsd2proto -backend=go. DO NOT EDIT.\n"
+
+// convertSpecGo writes a spec as plain Go structs with encoding/xml tags under
+// outRoot, one package per namespace with import paths under goRoot. The
+// structs are translated from the same messages the proto backend emits, so
+// field names, types and tags match the gen/ packages without their protobuf
+// runtime.
+func convertSpecGo(spec struct{ name, version, mainFile string }, outRoot, goRoot string) error {
+	st, err := loadSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	namespaces, pkgs := planBundles(st, spec, goRoot)
+	contents, err := generateBundles(st, namespaces, pkgs)
+	if err != nil {
+		return err
+	}
+
+	// Enum names per proto package, so fields of an enum type hold values
+	enums := make(map[string]map[string]bool)
+	for i, ns := range namespaces {
+		enums[pkgs[ns].pkgName] = protoEnumNames(contents[i])
+	}
+
+	for i, ns := range namespaces {
+		info := pkgs[ns]
+		source, err := generateGoStructs(contents[i], info, pkgs, enums, spec.mainFile)
+		if err != nil {
+			return fmt.Errorf("generate Go structs for ns %s: %w", ns, err)
+		}
+
+		// Go packages need a directory each, whatever the proto layout
+		parts := strings.Split(info.pkgName, ".")
+		path := nestedLayout(info.pkgName, parts[len(parts)-1])
+		outFile := filepath.Join(outRoot, strings.TrimSuffix(path, ".proto")+".go")
+		if err := writeOutput(outFile, source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structsPackageRoot returns the import path the -backend=go packages live
+// under: -go-package-root when given, otherwise outRoot within this module
+func structsPackageRoot(outRoot string) string {
+	set := os.Getenv("DDEX_GO_PACKAGE_ROOT") != ""
+	flag.Visit(func(f *flag.Flag) {
+		set = set || f.Name == "go-package-root"
+	})
+	if set {
+		return *goPackageRoot
+	}
+	return path.Join(path.Dir(defaultGoPackageRoot), filepath.ToSlash(filepath.Clean(outRoot)))
+}
+
+var (
+	protoEnumPattern    = regexp.MustCompile(`^enum (\w+) \{$`)
+	protoMessagePattern = regexp.MustCompile(`^message (\w+) \{$`)
+	protoFieldPattern   = regexp.MustCompile(`^  (repeated |optional )?([\w.]+) (\w+) = \d+;$`)
+	protoValuePattern   = regexp.MustCompile(`^  (\w+) = (\d+);$`)
+	protoXMLPattern     = regexp.MustCompile(`^  // @xml: (".*")$`)
+
+	protoNamespacePattern = regexp.MustCompile(`(?m)^// Target namespace: (.*)$`)
+)
+
+// goScalarTypes maps the proto scalar types the generator emits to Go
+var goScalarTypes = map[string]string{
+	"string": "string", "int32": "int32", "int64": "int64",
+	"uint32": "uint32", "uint64": "uint64",
+	"bool": "bool", "double": "float64", "bytes": "[]byte",
+}
+
+// protoEnumNames lists the enums declared in generated proto content
+func protoEnumNames(content string) map[string]bool {
+	names := make(map[string]bool)
+	for _, line := range strings.Split(content, "\n") {
+		if m := protoEnumPattern.FindStringSubmatch(line); m != nil {
+			names[m[1]] = true
+		}
+	}
+	return names
+}
+
+// generateGoStructs translates the proto content generateProtoForBundle
+// produced for a package into gofmt'd Go source: messages become structs
+// keeping their @gotags xml tags, enums become string types with a constant
+// per XSD value, and AnyElement keeps foreign elements as raw XML. Root
+// messages get a MarshalXML filling in their namespace attributes, with the
+// schema location pointing at schemaFile.
+func generateGoStructs(content string, info protoPkgInfo, pkgs map[string]protoPkgInfo, enums map[string]map[string]bool, schemaFile string) ([]byte, error) {
+	imports := make(map[string]string) // import path to alias
+	goPkgs := make(map[string]string)  // proto package to Go import path
+	for _, pkg := range pkgs {
+		goPkgs[pkg.pkgName] = pkg.goPackage
+	}
+
+	// goType maps a proto field type to its Go type, with the pointer or
+	// slice the label calls for
+	goType := func(protoType, label string) (string, error) {
+		base, ok := goScalarTypes[protoType]
+		if !ok {
+			pkg, name := info.pkgName, protoType
+			if i := strings.LastIndex(protoType, "."); i >= 0 {
+				pkg, name = protoType[:i], protoType[i+1:]
+			}
+			base = goCamelCase(name)
+			if pkg != info.pkgName {
+				importPath, ok := goPkgs[pkg]
+				if !ok {
+					return "", fmt.Errorf("unknown package %s of %s", pkg, protoType)
+				}
+				alias := strings.ReplaceAll(strings.TrimPrefix(pkg, "ddex."), ".", "")
+				imports[importPath] = alias
+				base = alias + "." + base
+			}
+			if !enums[pkg][name] {
+				base = "*" + base
+			}
+		}
+		switch {
+		case label == "repeated ":
+			return "[]" + base, nil
+		case label == "optional " && !strings.HasPrefix(base, "*") && base != "[]byte":
+			return "*" + base, nil
+		}
+		return base, nil
+	}
+
+	type rootMessage struct{ name, prefixField string }
+	var roots []rootMessage
+	var message string // Go name of the struct being written
+
+	var body strings.Builder
+	var getters strings.Builder // of the struct being written, following it
+	var tag, enumName string
+	var provenance strings.Builder // the proto's provenance header, carried over
+	for _, line := range strings.Split(content, "\n") {
+		switch {
+		case strings.HasPrefix(line, provenancePrefix), strings.HasPrefix(line, "// Schema: "):
+			provenance.WriteString(line + "\n")
+		case protoMessagePattern.MatchString(line):
+			message = goCamelCase(protoMessagePattern.FindStringSubmatch(line)[1])
+			body.WriteString(fmt.Sprintf("type %s struct {\n", message))
+			getters.Reset()
+			enumName = ""
+		case protoEnumPattern.MatchString(line):
+			enumName = goCamelCase(protoEnumPattern.FindStringSubmatch(line)[1])
+			body.WriteString(fmt.Sprintf("type %s string\n\nconst (\n", enumName))
+		case line == "}":
+			if enumName != "" {
+				body.WriteString(")\n\n")
+			} else {
+				body.WriteString("}\n\n")
+				body.WriteString(getters.String())
+			}
+			enumName = ""
+		case strings.HasPrefix(line, "  // @gotags: "):
+			tag = strings.TrimPrefix(line, "  // @gotags: ")
+		case enumName == "" && strings.HasPrefix(line, "  // "):
+			// Field documentation
+		case strings.HasPrefix(line, "  reserved "), line == "":
+		case enumName != "" && protoXMLPattern.MatchString(line):
+			tag = protoXMLPattern.FindStringSubmatch(line)[1]
+		case enumName != "" && protoValuePattern.MatchString(line):
+			m := protoValuePattern.FindStringSubmatch(line)
+			if m[2] != "0" {
+				body.WriteString(fmt.Sprintf("%s_%s %s = %s\n", enumName, m[1], enumName, tag))
+			}
+			tag = ""
+		case protoFieldPattern.MatchString(line):
+			m := protoFieldPattern.FindStringSubmatch(line)
+			fieldType, err := goType(m[2], m[1])
+			if err != nil {
+				return nil, err
+			}
+			// Keep only the xml tag; the avs tag serves the gen/ validators
+			xmlTag := tag
+			if i := strings.Index(xmlTag, `" `); i >= 0 {
+				xmlTag = xmlTag[:i+1]
+			}
+			body.WriteString(fmt.Sprintf("%s %s `%s`\n", goCamelCase(m[3]), fieldType, xmlTag))
+			getters.WriteString(goGetter(message, goCamelCase(m[3]), fieldType))
+			tag = ""
+
+			switch {
+			case m[3] == "xmlns_xsi":
+				roots = append(roots, rootMessage{name: message})
+			case strings.HasPrefix(m[3], "xmlns_"):
+				// The namespace prefix attribute precedes xmlns_xsi
+				roots = append(roots, rootMessage{name: message, prefixField: goCamelCase(m[3])})
+			}
+		case strings.HasPrefix(line, "syntax "), strings.HasPrefix(line, "package "), strings.HasPrefix(line, "option "), strings.HasPrefix(line, "import "), strings.HasPrefix(line, "// Target namespace: "):
+		default:
+			return nil, fmt.Errorf("unsupported proto line %q", line)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(goStructsHeader + provenance.String() + "\n")
+	sb.WriteString(fmt.Sprintf("package %s\n\n", path.Base(info.goPackage)))
+	usesAny := strings.Contains(content, "message "+anyElementMessage+" {")
+	if usesAny || len(roots) > 0 || len(imports) > 0 {
+		sb.WriteString("import (\n")
+		if usesAny {
+			sb.WriteString("\"bytes\"\n\"encoding/xml\"\n\"fmt\"\n\"io\"\n\"strings\"\n\n")
+		} else if len(roots) > 0 {
+			sb.WriteString("\"encoding/xml\"\n\n")
+		}
+		paths := slices.Sorted(maps.Keys(imports))
+		for _, importPath := range paths {
+			sb.WriteString(fmt.Sprintf("%s %q\n", imports[importPath], importPath))
+		}
+		sb.WriteString(")\n\n")
+	}
+	namespace := ""
+	if m := protoNamespacePattern.FindStringSubmatch(content); m != nil {
+		namespace = m[1]
+	}
+	if len(roots) > 0 {
+		sb.WriteString("// Package-level namespace constants\nconst (\n")
+		sb.WriteString(fmt.Sprintf("Namespace = %q\n", namespace))
+		sb.WriteString(fmt.Sprintf("SchemaLocation = %q\n", namespace+" "+namespace+"/"+schemaFile))
+		sb.WriteString("NamespaceXSI = \"http://www.w3.org/2001/XMLSchema-instance\"\n)\n\n")
+	} else {
+		sb.WriteString(fmt.Sprintf("// Namespace is the target namespace of this package\nconst Namespace = %q\n\n", namespace))
+	}
+	sb.WriteString(body.String())
+
+	// The prefix and xsi attributes of a root arrive as two entries; merge them
+	for i := 0; i < len(roots); i++ {
+		root := roots[i]
+		if i+1 < len(roots) && roots[i+1].name == root.name {
+			i++
+		}
+		sb.WriteString(fmt.Sprintf("// MarshalXML implements xml.Marshaler for %s, filling in empty namespace\n// attributes on a copy so m is never modified\n", root.name))
+		sb.WriteString(fmt.Sprintf("func (m *%s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n", root.name))
+		sb.WriteString(fmt.Sprintf("// Create an alias type to avoid infinite recursion\ntype alias %s\nout := alias(*m)\n", root.name))
+		if root.prefixField != "" {
+			sb.WriteString(fmt.Sprintf(goRootPrefixMarshal, root.prefixField))
+		}
+		sb.WriteString("if out.XmlnsXsi == \"\" {\nout.XmlnsXsi = NamespaceXSI\n}\n")
+		sb.WriteString("if out.XsiSchemaLocation == \"\" {\nout.XsiSchemaLocation = SchemaLocation\n}\n")
+		sb.WriteString("return e.EncodeElement(&out, start)\n}\n\n")
+	}
+	if usesAny {
+		sb.WriteString(goAnyElementMethods)
+	}
+
+	source, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return nil, fmt.Errorf("format Go source: %w", err)
+	}
+	return source, nil
+}
+
+// goRootPrefixMarshal declares a root's namespace under the RootPrefix a
+// parsed document used instead of the default prefix field it is given, or
+// else fills in that field
+const goRootPrefixMarshal = `if out.RootPrefix != "" {
+start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + out.RootPrefix}, Value: Namespace})
+if out.%[1]s == Namespace {
+out.%[1]s = ""
+}
+} else if out.%[1]s == "" {
+out.%[1]s = Namespace
+}
+`
+
+// goGetter renders the nil-safe getter protoc-gen-go would generate for a
+// field, so chains like GetReleaseList().GetRelease() read zero values
+// instead of panicking. Optional scalars are dereferenced.
+func goGetter(message, field, fieldType string) string {
+	getter := fmt.Sprintf("func (x *%s) Get%s() ", message, field)
+	if base, ok := strings.CutPrefix(fieldType, "*"); ok && slices.Contains(slices.Collect(maps.Values(goScalarTypes)), base) {
+		return getter + fmt.Sprintf("%s {\nif x != nil && x.%s != nil {\nreturn *x.%s\n}\nreturn %s\n}\n\n", base, field, field, goScalarZero(base))
+	}
+	return getter + fmt.Sprintf("%s {\nif x != nil {\nreturn x.%s\n}\nreturn %s\n}\n\n", fieldType, field, goScalarZero(fieldType))
+}
+
+// goScalarZero returns the zero value literal of a Go field type
+func goScalarZero(goType string) string {
+	switch goType {
+	case "string":
+		return `""`
+	case "bool":
+		return "false"
+	case "int32", "int64", "uint32", "uint64", "float64":
+		return "0"
+	}
+	if strings.HasPrefix(goType, "*") || strings.HasPrefix(goType, "[]") {
+		return "nil"
+	}
+	return `""` // enums are string types
+}
+
+// goAnyElementMethods round-trip xs:any content through AnyElement.RawXml,
+// as generate-go-extensions does for the gen/ packages
+const goAnyElementMethods = `// MarshalXML implements xml.Marshaler for AnyElement, writing RawXml in place of start
+func (m *AnyElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	d := xml.NewDecoder(strings.NewReader(m.RawXml))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("invalid AnyElement: %w", err)
+		}
+		if err := e.EncodeToken(withoutNamespaceDecls(tok)); err != nil {
+			return err
+		}
+	}
+}
+
+// UnmarshalXML implements xml.Unmarshaler for AnyElement, capturing the whole element as RawXml
+func (m *AnyElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var buf bytes.Buffer
+	e := xml.NewEncoder(&buf)
+	if err := e.EncodeToken(withoutNamespaceDecls(start)); err != nil {
+		return err
+	}
+	for depth := 1; depth > 0; {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+		if err := e.EncodeToken(withoutNamespaceDecls(tok)); err != nil {
+			return err
+		}
+	}
+	if err := e.Flush(); err != nil {
+		return err
+	}
+	m.RawXml = buf.String()
+	return nil
+}
+
+// withoutNamespaceDecls strips xmlns attributes from a start element
+func withoutNamespaceDecls(tok xml.Token) xml.Token {
+	start, ok := tok.(xml.StartElement)
+	if !ok {
+		return tok
+	}
+	attrs := make([]xml.Attr, 0, len(start.Attr))
+	for _, attr := range start.Attr {
+		if attr.Name.Space != "xmlns" && !(attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			attrs = append(attrs, attr)
+		}
+	}
+	start.Attr = attrs
+	return start
+}
+`
+
+// goCamelCase converts a proto name to the Go identifier protoc-gen-go gives
+// it, so the structs share field and type names with the gen/ packages
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && 'a' <= s[i+1] && s[i+1] <= 'z':
+			// Skip the underscore; the next letter is capitalized below
+		case '0' <= c && c <= '9':
+			b = append(b, c)
+		default:
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && 'a' <= s[i+1] && s[i+1] <= 'z'; i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+//
+// =======================
+// JSON Schema backend (-backend=jsonschema)
+// =======================
+//
+
+// jsonSchemaDialect is the draft the JSON Schema backend writes
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is the part of JSON Schema the backend uses. Properties are
+// named like the proto fields, which is how the gen/ types encode with
+// encoding/json, and definitions by full proto name.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	ContentEncoding      string                 `json:"contentEncoding,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	MinLength            int                    `json:"minLength,omitempty"`
+	MaxLength            int                    `json:"maxLength,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	MinItems             int                    `json:"minItems,omitempty"`
+	MaxItems             int                    `json:"maxItems,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// jsonScalarTypes maps proto scalar types to the JSON types they encode as
+var jsonScalarTypes = map[string]string{
+	"string": "string",
+	"bytes":  "string",
+	"bool":   "boolean",
+	"int32":  "integer",
+	"int64":  "integer",
+	"uint32": "integer",
+	"uint64": "integer",
+	"double": "number",
+}
+
+// convertSpecJSONSchema writes a JSON Schema for each root message of a spec
+// under outRoot, named after the message in the directory its package has in
+// the nested layout, e.g. ddex/ern/v432/NewReleaseMessage.json. Like the Go
+// struct backend it reads the messages the proto backend emits, so property
+// names and types match the gen/ packages, while the XSD fields behind them
+// supply cardinality and the facets of their simple types.
+func convertSpecJSONSchema(spec struct{ name, version, mainFile string }, outRoot string) error {
+	st, err := loadSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	namespaces, pkgs := planBundles(st, spec, *goPackageRoot)
+	contents, err := generateBundles(st, namespaces, pkgs)
+	if err != nil {
+		return err
+	}
+
+	g := &jsonSchemaGenerator{st: st, resolver: newConstraintResolver(st), pkgs: pkgs, defs: make(map[string]*jsonSchema)}
+	for i, ns := range namespaces {
+		g.addBundle(contents[i], st.nsBundles[ns], pkgs[ns].pkgName)
+	}
+
+	for _, root := range rootMessages(st, spec, namespaces, pkgs) {
+		data, err := json.MarshalIndent(g.document(root.pkg.pkgName+"."+root.message), "", "  ")
+		if err != nil {
+			return fmt.Errorf("JSON Schema for %s: %w", root.message, err)
+		}
+
+		parts := strings.Split(root.pkg.pkgName, ".")
+		dir := filepath.Join(outRoot, filepath.Dir(nestedLayout(root.pkg.pkgName, parts[len(parts)-1])))
+		outFile := filepath.Join(dir, root.message+".json")
+		if err := writeOutput(outFile, append(data, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonSchemaGenerator collects the definitions of a spec's messages, and of
+// the named simple types constraining their string fields, by full name
+type jsonSchemaGenerator struct {
+	st       *loadState
+	resolver *constraintResolver
+	pkgs     map[string]protoPkgInfo // namespace to package, as planned
+	defs     map[string]*jsonSchema
+	enums    map[string]bool // full names of the proto enums
+}
+
+// addBundle defines every message in the .proto content of bundle b. An
+// object accepts only its fields, and requires the message and repeated
+// fields the XSD requires; scalars aren't required, as encoding/json omits
+// them at their zero value and XSD allows a required element to be empty.
+func (g *jsonSchemaGenerator) addBundle(content string, b *NamespaceBundle, pkgName string) {
+	if g.enums == nil {
+		g.enums = make(map[string]bool)
+	}
+	for name := range protoEnumNames(content) {
+		g.enums[pkgName+"."+name] = true
+	}
+
+	types := bundleSchemaFields(b)
+	closed := false
+
+	var def *jsonSchema
+	var fields []schemaField
+	var used []bool
+	var tag []string // kind and XML name of the field the last @gotags described
+	for _, line := range strings.Split(content, "\n") {
+		if m := protoMessageLine.FindStringSubmatch(line); m != nil {
+			def = &jsonSchema{Type: "object", Properties: make(map[string]*jsonSchema), AdditionalProperties: &closed}
+			g.defs[pkgName+"."+m[1]] = def
+			fields = types[m[1]]
+			used = make([]bool, len(fields))
+			continue
+		}
+		if line == "}" {
+			def = nil
+			continue
+		}
+		if m := protoGotagsLine.FindStringSubmatch(line); m != nil {
+			kind := map[string]string{"": "element", ",attr": "attribute", ",chardata": "text", ",any": "any"}[m[2]]
+			tag = []string{kind, m[1]}
+			continue
+		}
+		m := protoFieldPattern.FindStringSubmatch(line)
+		if m == nil || def == nil {
+			continue
+		}
+
+		var field *schemaField
+		for j := range fields {
+			if tag != nil && !used[j] && fields[j].Kind == tag[0] && fields[j].Name == tag[1] {
+				used[j] = true
+				field = &fields[j]
+				break
+			}
+		}
+		tag = nil
+
+		label, fieldType, name := m[1], m[2], m[3]
+		def.Properties[name] = g.property(label, fieldType, field, b, pkgName)
+		if _, scalar := jsonScalarTypes[fieldType]; field != nil && field.Kind != "text" && field.MinOccurs > 0 && !scalar && !g.enums[qualifiedName(fieldType, pkgName)] {
+			def.Required = append(def.Required, name)
+		}
+	}
+}
+
+// property returns the schema of a field with the given proto label and type,
+// described by the XSD field if one matched
+func (g *jsonSchemaGenerator) property(label, fieldType string, field *schemaField, b *NamespaceBundle, pkgName string) *jsonSchema {
+	value := g.value(fieldType, field, b, pkgName)
+	if label != "repeated " {
+		return value
+	}
+	array := &jsonSchema{Type: "array", Items: value}
+	if field != nil {
+		array.MinItems = field.MinOccurs
+		array.MaxItems = max(field.MaxOccurs, 0)
+	}
+	return array
+}
+
+// value returns the schema of a single value of a field. Strings and enums
+// take the facets of their simple type; enums encode as their XSD values, as
+// in the Go struct backend.
+func (g *jsonSchemaGenerator) value(fieldType string, field *schemaField, b *NamespaceBundle, pkgName string) *jsonSchema {
+	switch fieldType {
+	case "string":
+		return g.stringValue(field, b)
+	case "bytes":
+		return &jsonSchema{Type: "string", ContentEncoding: "base64"}
+	}
+	if typ, ok := jsonScalarTypes[fieldType]; ok {
+		return &jsonSchema{Type: typ}
+	}
+
+	name := qualifiedName(fieldType, pkgName)
+	if g.enums[name] {
+		return g.stringValue(field, b)
+	}
+	return &jsonSchema{Ref: "#/$defs/" + name}
+}
+
+// qualifiedName returns the full name of a proto type named in package pkgName
+func qualifiedName(typ, pkgName string) string {
+	if strings.Contains(typ, ".") {
+		return typ
+	}
+	return pkgName + "." + typ
+}
+
+// stringValue returns the schema of a string constrained by the simple type
+// of field. Named simple types are defined once and referenced, as AVS sets
+// are shared by many fields.
+func (g *jsonSchemaGenerator) stringValue(field *schemaField, b *NamespaceBundle) *jsonSchema {
+	if field == nil {
+		return &jsonSchema{Type: "string"}
+	}
+	if field.simpleType != nil {
+		return g.facetSchema(field.simpleType, b)
+	}
+	t := g.resolver.simpleType(field.Type, b)
+	if t == nil {
+		return &jsonSchema{Type: "string"}
+	}
+
+	schema := g.facetSchema(t, b)
+	if schema.Enum == nil && schema.Pattern == "" && schema.MinLength == 0 && schema.MaxLength == 0 {
+		return schema
+	}
+	name := g.simpleTypeDef(t, b)
+	if _, ok := g.defs[name]; !ok {
+		g.defs[name] = schema
+	}
+	return &jsonSchema{Ref: "#/$defs/" + name}
+}
+
+// facetSchema translates the facets of a simple type as -validate does:
+// patterns anchored and dropped when XSD-only, lengths as string lengths
+func (g *jsonSchemaGenerator) facetSchema(t *XSDSimpleType, b *NamespaceBundle) *jsonSchema {
+	f := g.resolver.facets(t, b)
+	schema := &jsonSchema{Type: "string", Enum: f.enumerations}
+	if pattern, ok := re2Pattern(f.patterns); ok {
+		schema.Pattern = pattern
+	}
+	schema.MinLength, _ = strconv.Atoi(cmp.Or(f.length, f.minLength))
+	schema.MaxLength, _ = strconv.Atoi(cmp.Or(f.length, f.maxLength))
+	return schema
+}
+
+// simpleTypeDef names the definition of a named simple type like the proto
+// enum it generates, e.g. ddex.avs.vlatest.ReleaseType
+func (g *jsonSchemaGenerator) simpleTypeDef(t *XSDSimpleType, b *NamespaceBundle) string {
+	name := strings.ReplaceAll(toProtoMessageName(t.Name), "_", "")
+	for ns, bundle := range g.st.nsBundles {
+		for i := range bundle.SimpleTypes {
+			if &bundle.SimpleTypes[i] == t {
+				return g.pkgs[ns].pkgName + "." + name
+			}
+		}
+	}
+	return fmt.Sprintf("ddex.avs.v%s.%s", cmp.Or(g.st.avsVersionContext[b.TargetNamespace], "latest"), name)
+}
+
+// document returns the schema of the message with the given full name, with
+// the definitions it references
+func (g *jsonSchemaGenerator) document(message string) *jsonSchema {
+	doc := &jsonSchema{
+		Schema: jsonSchemaDialect,
+		Title:  message[strings.LastIndexByte(message, '.')+1:],
+		Ref:    "#/$defs/" + message,
+		Defs:   make(map[string]*jsonSchema),
+	}
+
+	var define func(ref string)
+	define = func(ref string) {
+		name, ok := strings.CutPrefix(ref, "#/$defs/")
+		if !ok || doc.Defs[name] != nil {
+			return
+		}
+		def, ok := g.defs[name]
+		if !ok {
+			log.Printf("No JSON Schema definition for %s, referenced from %s", name, message)
+			return
+		}
+		doc.Defs[name] = def
+		for _, property := range def.Properties {
+			if property.Items != nil {
+				property = property.Items
+			}
+			define(property.Ref)
+		}
+	}
+	define(doc.Ref)
+	return doc
+}
+
 //
 // =======================
 // Type/name helpers (kept + small improvements)
@@ -1052,6 +3763,16 @@ func xsdTypeToProto(xsdType string, allPkgs map[string]protoPkgInfo) string {
 	case "base64Binary":
 		return "bytes"
 	default:
+		// The remaining XML Schema built-ins; DDEX names its own types in
+		// PascalCase too (ern:Name), so only the xs prefix selects these
+		if prefix == "xs" || prefix == "xsd" {
+			if protoType, ok := xsdBuiltinTypes[xsdType]; ok {
+				return protoType
+			}
+			log.Printf("Unknown XSD built-in type %s -> treating as string", originalType)
+			return "string"
+		}
+
 		// Handle namespace prefixes for custom types
 		if prefix == "avs" {
 			// AVS contains only enum types, which we represent as strings in messages
@@ -1081,6 +3802,26 @@ func xsdTypeToProto(xsdType string, allPkgs map[string]protoPkgInfo) string {
 	}
 }
 
+// xsdBuiltinTypes maps the XML Schema built-in types xsdTypeToProto does
+// not handle for any prefix to proto scalars. Unsigned types get unsigned
+// fields wide enough for their value space; the unbounded integers take
+// 64 bits. Lists such as NMTOKENS stay whitespace-separated strings.
+var xsdBuiltinTypes = map[string]string{
+	"anySimpleType": "string", "language": "string", "Name": "string", "NCName": "string",
+	"ID": "string", "IDREF": "string", "IDREFS": "string", "ENTITY": "string",
+	"ENTITIES": "string", "NMTOKENS": "string", "QName": "string", "NOTATION": "string",
+
+	"short": "int32", "byte": "int32",
+	"nonPositiveInteger": "int64", "negativeInteger": "int64",
+	"nonNegativeInteger": "uint64", "unsignedLong": "uint64",
+	"unsignedInt": "uint32", "unsignedShort": "uint32", "unsignedByte": "uint32",
+
+	"gYearMonth": "string", "gMonthDay": "string", "gMonth": "string", "gDay": "string",
+	"dateTimeStamp": "string", "dayTimeDuration": "string", "yearMonthDuration": "string",
+
+	"hexBinary": "bytes",
+}
+
 func toProtoFieldName(name string) string {
 	var b strings.Builder
 	for i, r := range name {
@@ -1100,6 +3841,16 @@ func toProtoMessageName(name string) string {
 	return strings.ToUpper(name[:1]) + name[1:]
 }
 
+// avsTag returns the struct tag naming the AVS enum an avs-typed field
+// holds, so values can be checked against the AllowedValueSets at runtime
+func avsTag(xsdType string) string {
+	name, ok := strings.CutPrefix(xsdType, "avs:")
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf(" avs:%q", strings.ReplaceAll(toProtoMessageName(name), "_", ""))
+}
+
 func toProtoEnumName(name string) string {
 	if name == "" {
 		return ""
@@ -1122,8 +3873,11 @@ func toProtoEnumValue(value string) string {
 
 	var cleaned strings.Builder
 	for _, r := range result {
-		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+		switch {
+		case (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_':
 			cleaned.WriteRune(r)
+		case r > unicode.MaxASCII:
+			cleaned.WriteString(transliterate(r))
 		}
 	}
 	out := cleaned.String()
@@ -1139,6 +3893,35 @@ func toProtoEnumValue(value string) string {
 	return strings.Trim(out, "_")
 }
 
+// latinFolds maps accented uppercase Latin letters to their ASCII base letters
+var latinFolds = map[rune]string{
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Ā': "A", 'Ă': "A", 'Ą': "A", 'Æ': "AE",
+	'Ç': "C", 'Ć': "C", 'Ĉ': "C", 'Ċ': "C", 'Č': "C", 'Ď': "D", 'Đ': "D", 'Ð': "D",
+	'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ē': "E", 'Ĕ': "E", 'Ė': "E", 'Ę': "E", 'Ě': "E",
+	'Ĝ': "G", 'Ğ': "G", 'Ġ': "G", 'Ģ': "G", 'Ĥ': "H", 'Ħ': "H",
+	'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I", 'Ĩ': "I", 'Ī': "I", 'Ĭ': "I", 'Į': "I", 'İ': "I",
+	'Ĵ': "J", 'Ķ': "K", 'Ĺ': "L", 'Ļ': "L", 'Ľ': "L", 'Ŀ': "L", 'Ł': "L",
+	'Ñ': "N", 'Ń': "N", 'Ņ': "N", 'Ň': "N",
+	'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O", 'Ō': "O", 'Ŏ': "O", 'Ő': "O", 'Œ': "OE",
+	'Ŕ': "R", 'Ŗ': "R", 'Ř': "R", 'Ś': "S", 'Ŝ': "S", 'Ş': "S", 'Š': "S", 'ß': "SS",
+	'Ţ': "T", 'Ť': "T", 'Ŧ': "T", 'Þ': "TH",
+	'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ũ': "U", 'Ū': "U", 'Ŭ': "U", 'Ů': "U", 'Ű': "U", 'Ų': "U",
+	'Ŵ': "W", 'Ý': "Y", 'Ŷ': "Y", 'Ÿ': "Y", 'Ź': "Z", 'Ż': "Z", 'Ž': "Z",
+}
+
+// transliterate converts a non-ASCII rune of an enum value to ASCII: accented
+// Latin letters fold to their base letter, anything else (CJK, Cyrillic, ...)
+// becomes its code point, e.g. U4E2D, so distinct values stay distinct
+func transliterate(r rune) string {
+	if folded, ok := latinFolds[r]; ok {
+		return folded
+	}
+	if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+		return "_"
+	}
+	return fmt.Sprintf("_U%04X_", r)
+}
+
 func toPosixPath(p string) string {
 	return strings.ReplaceAll(p, string(os.PathSeparator), "/")
 }
@@ -1202,21 +3985,18 @@ func looksLikeEntry(ns string, spec struct{ name, version, mainFile string }) bo
 	return parts[1] == spec.name && isDigits(parts[2]) && parts[2] == stripLeadingV(spec.version)
 }
 
-func namespaceToGoPackage(ns string, bundle *NamespaceBundle, spec struct{ name, version, mainFile string }) string {
-	// Put Go package paths under your repo. Mirror the proto package path as directories.
+func namespaceToGoPackage(ns string, bundle *NamespaceBundle, spec struct{ name, version, mainFile string }, goRoot string) string {
+	// Put Go package paths under goRoot. Mirror the proto package path as directories.
 	pkg := namespaceToProtoPackage(ns, bundle, spec)
 	path := strings.ReplaceAll(pkg, ".", "/")
-	return "github.com/alecsavvy/ddex-go/gen/" + path
+	return strings.TrimSuffix(goRoot, "/") + "/" + path
 }
 
+// packageToPath returns the protoPath of a package's file, named after the
+// package's last element
 func packageToPath(pkg string) string {
 	parts := strings.Split(pkg, ".")
-	if len(parts) == 0 {
-		return "unknown.proto"
-	}
-	dir := strings.Join(parts, "/")
-	filename := parts[len(parts)-1] + ".proto"
-	return filepath.Join(dir, filename)
+	return protoPath(pkg, parts[len(parts)-1])
 }
 
 func splitNS(ns string) (host string, parts []string) {
@@ -0,0 +1,1812 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/linker"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// update rewrites the golden files from the current generator output:
+// go test ./tools/xsd2proto -run TestConvertSpecGolden -update
+var update = flag.Bool("update", false, "rewrite golden files in testdata/golden")
+
+// enumValueLine matches a generated "NAME = N;" enum value line
+var enumValueLine = regexp.MustCompile(`^\s+([A-Z0-9_]+) = (\d+);$`)
+
+func enumType(name string, values ...string) XSDSimpleType {
+	restriction := &XSDRestriction{Base: "xs:string"}
+	for _, v := range values {
+		restriction.Enumerations = append(restriction.Enumerations, XSDEnumeration{Value: v})
+	}
+	return XSDSimpleType{Name: name, Restriction: restriction}
+}
+
+// TestEnumPrefixStrategy validates that abbreviated prefixes stay unique, keep
+// the _UNSPECIFIED = 0 convention and are shorter than the full prefixes
+func TestEnumPrefixStrategy(t *testing.T) {
+	types := []XSDSimpleType{
+		enumType("AllTerritoryCode", "AD", "AE", "Worldwide"),
+		enumType("AudioCodecType", "FLAC", "MP3", "UserDefined"),
+		enumType("AdministratingCompanyType", "Label", "UserDefined"),
+	}
+
+	full := newEnumPrefixes(enumPrefixFull)
+	abbrev := newEnumPrefixes(enumPrefixAbbrev)
+	seen := make(map[string]string)
+
+	for _, st := range types {
+		fullValues := enumValues(t, generateEnum(st, full))
+		abbrevValues := enumValues(t, generateEnum(st, abbrev))
+
+		if len(abbrevValues) != len(fullValues) {
+			t.Fatalf("%s: abbrev produced %d values, full produced %d", st.Name, len(abbrevValues), len(fullValues))
+		}
+		if !strings.HasSuffix(abbrevValues[0], "_UNSPECIFIED") {
+			t.Errorf("%s: first value %s is not _UNSPECIFIED", st.Name, abbrevValues[0])
+		}
+
+		for i, value := range abbrevValues {
+			if len(value) >= len(fullValues[i]) {
+				t.Errorf("%s: %s is not shorter than %s", st.Name, value, fullValues[i])
+			}
+			if owner, dup := seen[value]; dup {
+				t.Errorf("%s: value %s already used by %s", st.Name, value, owner)
+			}
+			seen[value] = st.Name
+		}
+	}
+
+	// AudioCodecType and AdministratingCompanyType both abbreviate to ACT
+	if _, ok := seen["ACT2_LABEL"]; !ok {
+		t.Errorf("Expected colliding abbreviation to be numbered, got %v", seen)
+	}
+}
+
+// TestEnumCollisions validates that values normalizing to the same name get
+// distinct, numbered-last constants and keep their XSD values
+func TestEnumCollisions(t *testing.T) {
+	st := enumType("SeparatorType", "A/B", "A-B", "C", "A B", "C")
+	enum := generateEnum(st, newEnumPrefixes(enumPrefixFull))
+
+	want := []string{
+		"SEPARATOR_TYPE_UNSPECIFIED",
+		"SEPARATOR_TYPE_A_B",
+		"SEPARATOR_TYPE_C",
+		"SEPARATOR_TYPE_A_B_2",
+		"SEPARATOR_TYPE_A_B_3",
+	}
+	if got := enumValues(t, enum); !slices.Equal(got, want) {
+		t.Errorf("Expected values %v, got %v", want, got)
+	}
+
+	for _, comment := range []string{`// @xml: "A/B"`, `// @xml: "A-B"`, `// @xml: "A B"`} {
+		if !strings.Contains(enum, comment) {
+			t.Errorf("Expected %s in:\n%s", comment, enum)
+		}
+	}
+}
+
+// TestEnumUnicodeValues validates that accented, CJK and leading-digit values
+// produce distinct, stable constants rather than collapsing to UNKNOWN
+func TestEnumUnicodeValues(t *testing.T) {
+	testCases := map[string]string{
+		"Medellín":    "MEDELLIN",
+		"Société":     "SOCIETE",
+		"中文":          "U4E2D_U6587",
+		"日本語":         "U65E5_U672C_U8A9E",
+		"3D Audio":    "E_3D_AUDIO",
+		"¿Qué?":       "QUE",
+		"Straße":      "STRASSE",
+		"Ελληνικά":    "U0395_U039B_U039B_U0397_U039D_U0399_U039A_U0386",
+		"UserDefined": "USERDEFINED",
+	}
+	for value, want := range testCases {
+		if got := toProtoEnumValue(value); got != want {
+			t.Errorf("toProtoEnumValue(%q) = %s, want %s", value, got, want)
+		}
+	}
+
+	st := enumType("ScriptType", "中文", "日本語", "한국어", "Medellín")
+	enum := generateEnum(st, newEnumPrefixes(enumPrefixFull))
+	if !strings.Contains(enum, `// @xml: "中文"`) {
+		t.Errorf("Expected the original value in an @xml comment:\n%s", enum)
+	}
+	values := enumValues(t, enum)
+	if len(values) != 5 {
+		t.Fatalf("Expected 5 values, got %v", values)
+	}
+	seen := make(map[string]bool)
+	for _, value := range values {
+		if strings.Contains(value, "UNKNOWN") || seen[value] {
+			t.Errorf("Expected distinct named values, got %v", values)
+		}
+		seen[value] = true
+	}
+}
+
+// TestEmptyEnums validates that an enum whose values all normalize away is
+// reported, as a warning by default and as an error with -strict
+func TestEmptyEnums(t *testing.T) {
+	prefixes := newEnumPrefixes(enumPrefixFull)
+	tests := []struct {
+		name   string
+		values []string
+		empty  bool
+	}{
+		{"Punctuation Only", []string{"-", "/"}, true},
+		{"Named Values", []string{"A/B", "A-B"}, false},
+		{"One Named Value", []string{"-", "Album"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := enumType("SeparatorType", tt.values...)
+			err := checkEnumValues(st, generateEnum(st, prefixes))
+			if (err != nil) != tt.empty {
+				t.Errorf("checkEnumValues = %v, want empty %v", err, tt.empty)
+			}
+		})
+	}
+
+	t.Run("Strict", func(t *testing.T) {
+		b := &NamespaceBundle{
+			TargetNamespace: "http://example.com/xml/demo/1",
+			SimpleTypes:     []XSDSimpleType{enumType("SeparatorType", "-", "/")},
+		}
+		all := map[string]protoPkgInfo{b.TargetNamespace: {pkgName: "ddex.demo.v1"}}
+
+		content, err := generateProtoForBundle(b, "ddex.demo.v1", "example.com/demo/v1", all, nil)
+		if err != nil || !strings.Contains(content, "enum SeparatorType {") {
+			t.Errorf("Expected the enum with a warning, got %v", err)
+		}
+
+		previous := *strict
+		*strict = true
+		t.Cleanup(func() { *strict = previous })
+		if _, err := generateProtoForBundle(b, "ddex.demo.v1", "example.com/demo/v1", all, nil); err == nil || !strings.Contains(err.Error(), "SeparatorType") {
+			t.Errorf("Expected an error naming SeparatorType, got %v", err)
+		}
+	})
+}
+
+// enumValues returns the value names of a generated enum in order, checking
+// that they are numbered from 0
+func enumValues(t *testing.T, enum string) []string {
+	t.Helper()
+
+	var values []string
+	for _, line := range strings.Split(enum, "\n") {
+		m := enumValueLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		if want := len(values); m[2] != strconv.Itoa(want) {
+			t.Errorf("%s = %s, want %d", m[1], m[2], want)
+		}
+		values = append(values, m[1])
+	}
+	return values
+}
+
+// BenchmarkGenerateBundles measures proto generation over the full spec set,
+// with schema loading done up front
+func BenchmarkGenerateBundles(b *testing.B) {
+	b.Chdir(filepath.Join("..", ".."))
+
+	type loadedSpec struct {
+		st         *loadState
+		namespaces []string
+		pkgs       map[string]protoPkgInfo
+	}
+
+	var loaded []loadedSpec
+	for _, spec := range specs {
+		st, err := loadSpec(spec)
+		if err != nil {
+			b.Fatalf("Failed to load %s v%s: %v", spec.name, spec.version, err)
+		}
+		namespaces, pkgs := planBundles(st, spec, defaultGoPackageRoot)
+		loaded = append(loaded, loadedSpec{st: st, namespaces: namespaces, pkgs: pkgs})
+	}
+
+	for b.Loop() {
+		for _, l := range loaded {
+			if _, err := generateBundles(l.st, l.namespaces, l.pkgs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// TestNestedParticles validates that elements of choices and sequences nested
+// in one another, or of a choice next to a sequence, are flattened into the
+// message rather than dropped
+func TestNestedParticles(t *testing.T) {
+	const schema = `<xs:complexType name="Credit" xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:sequence>
+    <xs:element name="Role" type="xs:string"/>
+    <xs:choice>
+      <xs:element name="PartyReference" type="xs:string"/>
+      <xs:sequence>
+        <xs:element name="PartyName" type="xs:string"/>
+        <xs:choice>
+          <xs:element name="PartyId" type="xs:string"/>
+          <xs:element name="ProprietaryId" type="xs:string"/>
+        </xs:choice>
+      </xs:sequence>
+      <xs:choice>
+        <xs:element name="Anonymous" type="xs:boolean"/>
+      </xs:choice>
+    </xs:choice>
+    <xs:sequence>
+      <xs:element name="Note" type="xs:string" maxOccurs="unbounded"/>
+    </xs:sequence>
+  </xs:sequence>
+  <xs:attribute name="IsDefault" type="xs:boolean"/>
+</xs:complexType>`
+
+	var complexType XSDComplexType
+	if err := xml.Unmarshal([]byte(schema), &complexType); err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+
+	msg, _, err := generateComplexTypeMessage("Credit", &complexType, nil)
+	if err != nil {
+		t.Fatalf("generateComplexTypeMessage failed: %v", err)
+	}
+
+	layout := parseMessageLayouts(msg)["Credit"]
+	want := []string{"role", "party_reference", "party_name", "party_id", "proprietary_id", "anonymous", "note", "is_default"}
+	for i, field := range want {
+		if got := layout.fields[field]; got != i+1 {
+			t.Errorf("Expected %s = %d, got %d in:\n%s", field, i+1, got, msg)
+		}
+	}
+	if !strings.Contains(msg, "repeated string note = 7;") {
+		t.Errorf("Expected repeated note field, got:\n%s", msg)
+	}
+
+	t.Run("Choice Beside Sequence", func(t *testing.T) {
+		complexType := &XSDComplexType{
+			Sequence: &XSDSequence{Elements: []XSDElement{{Name: "Title", Type: "xs:string"}}},
+			Choice:   &XSDChoice{Elements: []XSDElement{{Name: "Duration", Type: "xs:string"}}},
+		}
+		msg, _, err := generateComplexTypeMessage("Track", complexType, nil)
+		if err != nil {
+			t.Fatalf("generateComplexTypeMessage failed: %v", err)
+		}
+		if !strings.Contains(msg, "string duration = 2;") {
+			t.Errorf("Expected choice element after the sequence, got:\n%s", msg)
+		}
+	})
+
+	t.Run("Nested Wildcard", func(t *testing.T) {
+		complexType := &XSDComplexType{
+			Sequence: &XSDSequence{Sequences: []XSDSequence{{Any: []XSDAny{{Namespace: "##other"}}}}},
+		}
+		if !hasWildcard(complexType) {
+			t.Error("Expected xs:any in a nested sequence to be found")
+		}
+	})
+}
+
+// TestSequenceOrder validates that fields are declared in the order their
+// particles appear in a sequence, so marshaled XML follows the schema, while
+// field numbers still go to elements before choices
+func TestSequenceOrder(t *testing.T) {
+	const schema = `<xs:complexType name="DealTerms" xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:sequence>
+    <xs:choice>
+      <xs:element name="TerritoryCode" type="xs:string" maxOccurs="unbounded"/>
+      <xs:element name="ExcludedTerritoryCode" type="xs:string" maxOccurs="unbounded"/>
+    </xs:choice>
+    <xs:element name="ValidityPeriod" type="xs:string"/>
+    <xs:sequence>
+      <xs:element name="IsPromotional" type="xs:boolean"/>
+    </xs:sequence>
+    <xs:element name="NumberOfUsages" type="xs:int"/>
+  </xs:sequence>
+</xs:complexType>`
+
+	var complexType XSDComplexType
+	if err := xml.Unmarshal([]byte(schema), &complexType); err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+
+	msg, _, err := generateComplexTypeMessage("DealTerms", &complexType, nil)
+	if err != nil {
+		t.Fatalf("generateComplexTypeMessage failed: %v", err)
+	}
+
+	// Declaration order follows the XSD, numbers the flattening
+	want := []string{
+		"repeated string territory_code = 3;",
+		"repeated string excluded_territory_code = 4;",
+		"string validity_period = 1;",
+		"bool is_promotional = 5;",
+		"int32 number_of_usages = 2;",
+	}
+	last := -1
+	for _, field := range want {
+		i := strings.Index(msg, field)
+		if i < 0 {
+			t.Fatalf("Expected %q in:\n%s", field, msg)
+		}
+		if i < last {
+			t.Errorf("Expected %q declared after the fields before it in the XSD, got:\n%s", field, msg)
+		}
+		last = i
+	}
+
+	var names []string
+	for _, field := range complexTypeFields(&complexType) {
+		names = append(names, field.Name)
+	}
+	if got := strings.Join(names, ","); got != "TerritoryCode,ExcludedTerritoryCode,ValidityPeriod,IsPromotional,NumberOfUsages" {
+		t.Errorf("Expected schema metadata in XSD order, got %s", got)
+	}
+}
+
+// TestInlineComplexTypes validates that anonymous complexTypes of nested
+// elements become messages named after the enclosing type and the element
+func TestInlineComplexTypes(t *testing.T) {
+	const schema = `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:demo="http://example.com/xml/demo/1" targetNamespace="http://example.com/xml/demo/1">
+  <xs:element name="ReleaseMessage">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element name="Header">
+          <xs:complexType>
+            <xs:sequence>
+              <xs:element name="MessageId" type="xs:string"/>
+            </xs:sequence>
+          </xs:complexType>
+        </xs:element>
+        <xs:element name="Release" type="demo:Release" maxOccurs="unbounded"/>
+      </xs:sequence>
+    </xs:complexType>
+  </xs:element>
+  <xs:complexType name="Release">
+    <xs:sequence>
+      <xs:element name="Details" minOccurs="0">
+        <xs:complexType>
+          <xs:sequence>
+            <xs:element name="Title" type="xs:string"/>
+            <xs:element name="Genre" maxOccurs="unbounded">
+              <xs:complexType>
+                <xs:attribute name="Code" type="xs:string"/>
+              </xs:complexType>
+            </xs:element>
+          </xs:sequence>
+        </xs:complexType>
+      </xs:element>
+      <xs:choice>
+        <xs:element name="Price">
+          <xs:complexType>
+            <xs:simpleContent>
+              <xs:extension base="xs:decimal">
+                <xs:attribute name="Currency" type="xs:string"/>
+              </xs:extension>
+            </xs:simpleContent>
+          </xs:complexType>
+        </xs:element>
+        <xs:element name="Free" type="xs:boolean"/>
+      </xs:choice>
+    </xs:sequence>
+  </xs:complexType>
+  <xs:complexType name="ReleasePrice"/>
+</xs:schema>`
+
+	path := filepath.Join(t.TempDir(), "demo.xsd")
+	if err := os.WriteFile(path, []byte(schema), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	st := newLoadState()
+	if err := loadSchemaGraph(st, path, ""); err != nil {
+		t.Fatalf("loadSchemaGraph failed: %v", err)
+	}
+	b := st.nsBundles["http://example.com/xml/demo/1"]
+	hoistInlineTypes(b)
+
+	all := map[string]protoPkgInfo{b.TargetNamespace: {pkgName: "ddex.demo.v1"}}
+	proto, err := generateProtoForBundle(b, "ddex.demo.v1", "example.com/demo/v1", all, nil)
+	if err != nil {
+		t.Fatalf("generateProtoForBundle failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"message ReleaseMessageHeader {",
+		"ReleaseMessageHeader header = 1;",
+		"message ReleaseDetails {",
+		"ReleaseDetails details = 1;",
+		"message ReleaseDetailsGenre {",
+		"repeated ReleaseDetailsGenre genre = 2;",
+		// ReleasePrice is already a named type
+		"message ReleasePrice2 {",
+		"ReleasePrice2 price = 2;",
+	} {
+		if !strings.Contains(proto, want) {
+			t.Errorf("Expected %q in:\n%s", want, proto)
+		}
+	}
+	if strings.Contains(proto, "string header = 1;") || strings.Contains(proto, "string details = 1;") {
+		t.Errorf("Expected no string fallback for inline complexTypes, got:\n%s", proto)
+	}
+
+	t.Run("Type Attribute Wins", func(t *testing.T) {
+		b := &NamespaceBundle{ComplexTypes: []XSDComplexType{{
+			Name: "Release",
+			Sequence: &XSDSequence{Elements: []XSDElement{{
+				Name:        "Details",
+				Type:        "xs:string",
+				ComplexType: &XSDComplexType{},
+			}}},
+		}}}
+		hoistInlineTypes(b)
+		if len(b.ComplexTypes) != 1 {
+			t.Errorf("Expected no hoisted type, got %d types", len(b.ComplexTypes))
+		}
+		if got := elementProtoType(b.ComplexTypes[0].Sequence.Elements[0], nil); got != "string" {
+			t.Errorf("Expected string, got %s", got)
+		}
+	})
+}
+
+// TestChameleonInclude validates that an included schema without a
+// targetNamespace is merged into the bundle of the schema including it
+func TestChameleonInclude(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"main.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/xml/main/1">
+  <xs:include schemaLocation="common.xsd"/>
+  <xs:import namespace="http://example.com/xml/other/1" schemaLocation="other.xsd"/>
+  <xs:complexType name="Release"/>
+</xs:schema>`,
+		"other.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/xml/other/1">
+  <xs:include schemaLocation="common.xsd"/>
+</xs:schema>`,
+		"common.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:complexType name="Money"/>
+</xs:schema>`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	st := newLoadState()
+	if err := loadSchemaGraph(st, filepath.Join(dir, "main.xsd"), ""); err != nil {
+		t.Fatalf("loadSchemaGraph failed: %v", err)
+	}
+
+	if len(st.nsBundles) != 2 {
+		t.Fatalf("Expected 2 bundles, got %d", len(st.nsBundles))
+	}
+	for ns, want := range map[string][]string{
+		"http://example.com/xml/main/1":  {"Money", "Release"},
+		"http://example.com/xml/other/1": {"Money"},
+	} {
+		b := st.nsBundles[ns]
+		if b == nil {
+			t.Errorf("Missing bundle %s", ns)
+			continue
+		}
+		var got []string
+		for _, ct := range b.ComplexTypes {
+			got = append(got, ct.Name)
+		}
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: expected types %v, got %v", ns, want, got)
+		}
+	}
+
+	t.Run("Entry Without Namespace", func(t *testing.T) {
+		if err := loadSchemaGraph(newLoadState(), filepath.Join(dir, "common.xsd"), ""); err == nil {
+			t.Error("Expected error loading a schema without targetNamespace directly")
+		}
+	})
+}
+
+// TestFlattenedSchema validates that a single merged XSD without includes
+// or imports loads into one bundle holding every type it defines
+func TestFlattenedSchema(t *testing.T) {
+	fixtures, err := filepath.Abs(filepath.Join("testdata", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := *schemaDir
+	*schemaDir = fixtures
+	t.Cleanup(func() { *schemaDir = previous })
+
+	st, err := loadSpec(struct{ name, version, mainFile string }{"flat", "1", "release-notification.xsd"})
+	if err != nil {
+		t.Fatalf("loadSpec failed: %v", err)
+	}
+
+	if len(st.nsBundles) != 1 {
+		t.Fatalf("Expected 1 bundle, got %d", len(st.nsBundles))
+	}
+	b := st.nsBundles["http://ddex.net/xml/flat/1"]
+	if b == nil {
+		t.Fatal("Missing bundle http://ddex.net/xml/flat/1")
+	}
+	if len(b.Imports) != 0 || len(st.avsVersionContext) != 0 {
+		t.Errorf("Expected no imports or AVS context, got %v and %v", b.Imports, st.avsVersionContext)
+	}
+	if len(b.Elements) != 1 || b.Elements[0].Name != "NewReleaseMessage" {
+		t.Errorf("Expected the NewReleaseMessage root element, got %v", b.Elements)
+	}
+
+	var complexTypes, simpleTypes []string
+	for _, ct := range b.ComplexTypes {
+		complexTypes = append(complexTypes, ct.Name)
+	}
+	for _, st := range b.SimpleTypes {
+		simpleTypes = append(simpleTypes, st.Name)
+	}
+	slices.Sort(complexTypes)
+	if want := []string{"DisplayTitleText", "MessageHeader", "ProprietaryId", "Release", "ReleaseId"}; !slices.Equal(complexTypes, want) {
+		t.Errorf("Expected complex types %v, got %v", want, complexTypes)
+	}
+	if want := []string{"ReleaseType"}; !slices.Equal(simpleTypes, want) {
+		t.Errorf("Expected simple types %v, got %v", want, simpleTypes)
+	}
+}
+
+// TestSchemaOverlay validates that a schema in the overlay directory is
+// loaded instead of the one at the same path under the schema directory,
+// while the schemas it includes still come from the schema directory
+func TestSchemaOverlay(t *testing.T) {
+	fixtures, err := filepath.Abs(filepath.Join("testdata", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlay := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(overlay, "demov1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	patched := `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" elementFormDefault="unqualified">
+  <xs:complexType name="ReleaseId">
+    <xs:sequence>
+      <xs:element name="GRid" type="xs:string" minOccurs="0"/>
+      <xs:element name="ICPN" type="xs:string" minOccurs="0"/>
+    </xs:sequence>
+  </xs:complexType>
+</xs:schema>`
+	if err := os.WriteFile(filepath.Join(overlay, "demov1", "common.xsd"), []byte(patched), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// A file that matches no schema is ignored
+	if err := os.WriteFile(filepath.Join(overlay, "demov1", "unused.xsd"), []byte("not a schema"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	previousDir, previousOverlay := *schemaDir, *schemaOverlay
+	*schemaDir = fixtures
+	t.Cleanup(func() { *schemaDir, *schemaOverlay = previousDir, previousOverlay })
+
+	var logs strings.Builder
+	log.SetOutput(&logs)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	spec := struct{ name, version, mainFile string }{"demo", "1", "release-notification.xsd"}
+	releaseID := func(t *testing.T) []string {
+		st, err := loadSpec(spec)
+		if err != nil {
+			t.Fatalf("loadSpec failed: %v", err)
+		}
+		var elements []string
+		for _, ct := range st.nsBundles["http://ddex.net/xml/demo/1"].ComplexTypes {
+			switch ct.Name {
+			case "ReleaseId":
+				for _, el := range ct.Sequence.Elements {
+					elements = append(elements, el.Name)
+				}
+			case "Release":
+				if ct.Sequence == nil {
+					t.Error("Expected Release from the schema directory")
+				}
+			}
+		}
+		return elements
+	}
+
+	t.Run("Without Overlay", func(t *testing.T) {
+		*schemaOverlay = ""
+		if got, want := releaseID(t), []string{"GRid", "ProprietaryId"}; !slices.Equal(got, want) {
+			t.Errorf("Expected ReleaseId elements %v, got %v", want, got)
+		}
+	})
+
+	t.Run("Patched", func(t *testing.T) {
+		*schemaOverlay = overlay
+		logs.Reset()
+		if got, want := releaseID(t), []string{"GRid", "ICPN"}; !slices.Equal(got, want) {
+			t.Errorf("Expected the patched ReleaseId elements %v, got %v", want, got)
+		}
+		if n := strings.Count(logs.String(), "Overriding schema"); n != 1 || !strings.Contains(logs.String(), filepath.Join("demov1", "common.xsd")) {
+			t.Errorf("Expected one override of demov1/common.xsd logged, got %q", logs.String())
+		}
+	})
+}
+
+// TestExtraEntries validates that roots declared in an extra entry schema,
+// unreachable from the main file, are loaded and become root messages
+func TestExtraEntries(t *testing.T) {
+	fixtures, err := filepath.Abs(filepath.Join("testdata", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := *schemaDir
+	*schemaDir = fixtures
+	t.Cleanup(func() { *schemaDir = previous })
+	t.Chdir(t.TempDir())
+
+	spec := struct{ name, version, mainFile string }{"demo", "1", "release-notification.xsd"}
+	roots, err := convertSpec(spec, defaultGoPackageRoot)
+	if err != nil {
+		t.Fatalf("convertSpec failed: %v", err)
+	}
+	if len(roots) != 1 || roots[0].message != "NewReleaseMessage" {
+		t.Fatalf("Expected only NewReleaseMessage without extra entries, got %v", roots)
+	}
+
+	extraEntries["demo@1"] = []string{"release-request.xsd"}
+	t.Cleanup(func() { delete(extraEntries, "demo@1") })
+
+	if err := validateSchemas(spec); err != nil {
+		t.Errorf("validateSchemas failed: %v", err)
+	}
+	roots, err = convertSpec(spec, defaultGoPackageRoot)
+	if err != nil {
+		t.Fatalf("convertSpec failed: %v", err)
+	}
+	var messages []string
+	for _, root := range roots {
+		messages = append(messages, root.message)
+	}
+	slices.Sort(messages)
+	if want := []string{"NewReleaseMessage", "ReleaseRequestMessage"}; !slices.Equal(messages, want) {
+		t.Errorf("Expected roots %v, got %v", want, messages)
+	}
+
+	extraEntries["demo@1"] = []string{"missing.xsd"}
+	if err := validateSchemas(spec); err == nil || !strings.Contains(err.Error(), "missing.xsd") {
+		t.Errorf("Expected an error naming the missing entry schema, got %v", err)
+	}
+}
+
+// TestImportCycles validates that import cycles among namespaces are reported
+// while same-namespace include cycles are not
+func TestImportCycles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/xml/a/1">
+  <xs:include schemaLocation="a-common.xsd"/>
+  <xs:import namespace="http://example.com/xml/b/1" schemaLocation="b.xsd"/>
+</xs:schema>`,
+		"a-common.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/xml/a/1">
+  <xs:include schemaLocation="a.xsd"/>
+</xs:schema>`,
+		"b.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/xml/b/1">
+  <xs:import namespace="http://example.com/xml/a/1" schemaLocation="a.xsd"/>
+  <xs:import namespace="http://example.com/xml/c/1" schemaLocation="c.xsd"/>
+</xs:schema>`,
+		"c.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://example.com/xml/c/1"/>`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	st := newLoadState()
+	if err := loadSchemaGraph(st, filepath.Join(dir, "a.xsd"), ""); err != nil {
+		t.Fatalf("loadSchemaGraph failed: %v", err)
+	}
+
+	want := []string{"http://example.com/xml/a/1 -> http://example.com/xml/b/1 -> http://example.com/xml/a/1"}
+	if !slices.Equal(st.cycles, want) {
+		t.Errorf("Expected cycles %q, got %q", want, st.cycles)
+	}
+	if len(st.loadPath) != 0 {
+		t.Errorf("Expected an empty load path after loading, got %d frames", len(st.loadPath))
+	}
+}
+
+// TestSelectSpecs validates the -spec filter against the known spec list
+func TestSelectSpecs(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		selected, err := selectSpecs(nil)
+		if err != nil || len(selected) != len(specs) {
+			t.Errorf("selectSpecs(nil) = %d specs, %v, want all %d", len(selected), err, len(specs))
+		}
+	})
+
+	t.Run("Subset", func(t *testing.T) {
+		selected, err := selectSpecs([]string{"mead@11", "ern@432"})
+		if err != nil {
+			t.Fatalf("selectSpecs failed: %v", err)
+		}
+		var got []string
+		for _, spec := range selected {
+			got = append(got, spec.name+"@"+spec.version)
+		}
+		if want := []string{"ern@432", "mead@11"}; !slices.Equal(got, want) {
+			t.Errorf("Expected %v in processing order, got %v", want, got)
+		}
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := selectSpecs([]string{"ern@432", "ern@4321"})
+		if err == nil || !strings.Contains(err.Error(), "ern@4321") {
+			t.Errorf("Expected error naming ern@4321, got %v", err)
+		}
+	})
+}
+
+// TestConvertSpecGolden runs convertSpec over the fixture schemas in
+// testdata/xsd and compares every emitted .proto file with testdata/golden
+func TestConvertSpecGolden(t *testing.T) {
+	fixtures, err := filepath.Abs(filepath.Join("testdata", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden, err := filepath.Abs(filepath.Join("testdata", "golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previous, previousVersion := *schemaDir, generatorVersion
+	*schemaDir = fixtures
+	generatorVersion = func() string { return "v1.0.0" }
+	t.Cleanup(func() { *schemaDir, generatorVersion = previous, previousVersion })
+	t.Setenv("SOURCE_DATE_EPOCH", "1735689600")
+	out := t.TempDir()
+	t.Chdir(out)
+
+	// demo spreads its types over includes and imports, flat is the same
+	// message merged into one self-contained file
+	for _, spec := range []struct{ name, version, mainFile string }{
+		{"demo", "1", "release-notification.xsd"},
+		{"flat", "1", "release-notification.xsd"},
+	} {
+		if _, err := convertSpec(spec, defaultGoPackageRoot); err != nil {
+			t.Fatalf("convertSpec %s failed: %v", spec.name, err)
+		}
+
+		if err := convertSpecGo(spec, "gostructs", "github.com/alecsavvy/ddex-go/gostructs"); err != nil {
+			t.Fatalf("convertSpecGo %s failed: %v", spec.name, err)
+		}
+
+		if err := convertSpecJSONSchema(spec, "jsonschema"); err != nil {
+			t.Fatalf("convertSpecJSONSchema %s failed: %v", spec.name, err)
+		}
+	}
+
+	// The Go backend's v1.go files and the JSON Schema backend's
+	// NewReleaseMessage.json sit beside the proto backend's v1.proto
+	generated := readTree(t, filepath.Join(out, "proto"))
+	maps.Copy(generated, readTree(t, filepath.Join(out, "gostructs")))
+	maps.Copy(generated, readTree(t, filepath.Join(out, "jsonschema")))
+	if *update {
+		if err := os.RemoveAll(golden); err != nil {
+			t.Fatal(err)
+		}
+		for name, content := range generated {
+			path := filepath.Join(golden, name+".golden")
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	want := readTree(t, golden)
+	for name, content := range generated {
+		expected, ok := want[name+".golden"]
+		if !ok {
+			t.Errorf("Unexpected file %s; run with -update if intended", name)
+			continue
+		}
+		if content != expected {
+			t.Errorf("%s differs from its golden file; run with -update if intended:\n%s", name, lineDiff(expected, content))
+		}
+	}
+	for name := range want {
+		if _, ok := generated[strings.TrimSuffix(name, ".golden")]; !ok {
+			t.Errorf("Missing file %s", strings.TrimSuffix(name, ".golden"))
+		}
+	}
+}
+
+// readTree returns the content of every file below root by slash-separated relative path
+func readTree(t *testing.T, root string) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(data)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", root, err)
+	}
+	return files
+}
+
+// lineDiff describes the first line where got departs from want
+func lineDiff(want, got string) string {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n  want: %s\n  got:  %s", i+1, w, g)
+		}
+	}
+	return ""
+}
+
+// TestDryRun validates that -dry-run reports counts and diffs against the
+// files on disk without touching them
+func TestDryRun(t *testing.T) {
+	fixtures, err := filepath.Abs(filepath.Join("testdata", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := *schemaDir
+	*schemaDir = fixtures
+	t.Cleanup(func() { *schemaDir = previous })
+	t.Chdir(t.TempDir())
+
+	spec := struct{ name, version, mainFile string }{"demo", "1", "release-notification.xsd"}
+	if _, err := convertSpec(spec, defaultGoPackageRoot); err != nil {
+		t.Fatalf("convertSpec failed: %v", err)
+	}
+
+	protoFile := filepath.Join("proto", "ddex", "demo", "v1", "v1.proto")
+	data, err := os.ReadFile(protoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := strings.Replace(string(data), "// Target namespace: http://ddex.net/xml/demo/1", "// Target namespace: stale", 1)
+	if err := os.WriteFile(protoFile, []byte(tampered), 0644); err != nil {
+		t.Fatal(err)
+	}
+	metadataFile := strings.TrimSuffix(protoFile, ".proto") + schemaMetadataSuffix
+	if err := os.Remove(metadataFile); err != nil {
+		t.Fatal(err)
+	}
+
+	var out strings.Builder
+	*dryRun, dryRunOutput = true, &out
+	t.Cleanup(func() { *dryRun, dryRunOutput = false, os.Stdout })
+	if _, err := convertSpec(spec, defaultGoPackageRoot); err != nil {
+		t.Fatalf("convertSpec with -dry-run failed: %v", err)
+	}
+	report := out.String()
+
+	for _, want := range []string{
+		"http://ddex.net/xml/demo/1 -> " + protoFile + ": 6 messages, 1 enums\n",
+		"changed " + protoFile + " (+1 -1 lines)\n",
+		"-// Target namespace: stale\n+// Target namespace: http://ddex.net/xml/demo/1\n",
+		"new file " + metadataFile,
+		"unchanged " + filepath.Join("proto", "ddex", "extra", "v1", "v1.proto") + "\n",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Report is missing %q:\n%s", want, report)
+		}
+	}
+
+	if got, err := os.ReadFile(protoFile); err != nil || string(got) != tampered {
+		t.Errorf("Dry run rewrote %s", protoFile)
+	}
+	if _, err := os.Stat(metadataFile); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Dry run created %s", metadataFile)
+	}
+}
+
+// TestProvenance validates the provenance header of generated files and
+// that a run changing nothing but its date leaves existing files alone
+func TestProvenance(t *testing.T) {
+	fixtures, err := filepath.Abs(filepath.Join("testdata", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous, previousOverlay := *schemaDir, *schemaOverlay
+	*schemaDir = fixtures
+	t.Cleanup(func() { *schemaDir, *schemaOverlay = previous, previousOverlay })
+	t.Chdir(t.TempDir())
+
+	spec := struct{ name, version, mainFile string }{"demo", "1", "release-notification.xsd"}
+	protoFile := filepath.Join("proto", "ddex", "demo", "v1", "v1.proto")
+	generate := func(t *testing.T, epoch string) string {
+		t.Helper()
+		t.Setenv("SOURCE_DATE_EPOCH", epoch)
+		if _, err := convertSpec(spec, defaultGoPackageRoot); err != nil {
+			t.Fatalf("convertSpec failed: %v", err)
+		}
+		data, err := os.ReadFile(protoFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	t.Run("Header", func(t *testing.T) {
+		content := generate(t, "1735689600")
+		header := provenancePrefix + generatorVersion() + " on 2025-01-01\n" +
+			"// Schema: demov1/release-notification.xsd (sha256 "
+		if !strings.HasPrefix(content, header) {
+			t.Errorf("Expected the file to open with %q, got:\n%s", header, content[:strings.Index(content, "syntax")])
+		}
+		if !strings.Contains(content, "// Schema: demov1/common.xsd (sha256 ") {
+			t.Error("Expected the included schema to be listed")
+		}
+	})
+
+	t.Run("Date Only Change", func(t *testing.T) {
+		first := generate(t, "1735689600")
+		var out strings.Builder
+		*dryRun, dryRunOutput = true, &out
+		generate(t, "1767225600")
+		*dryRun, dryRunOutput = false, os.Stdout
+		if !strings.Contains(out.String(), "unchanged "+protoFile) {
+			t.Errorf("Expected a dry run on another day to report no change:\n%s", out.String())
+		}
+		if again := generate(t, "1767225600"); again != first {
+			t.Error("Expected a run on another day to leave the file as is")
+		}
+	})
+
+	t.Run("Patched", func(t *testing.T) {
+		overlay := t.TempDir()
+		os.MkdirAll(filepath.Join(overlay, "demov1"), 0o755)
+		common, err := os.ReadFile(filepath.Join(fixtures, "demov1", "common.xsd"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		patched := strings.Replace(string(common), "?>", "?>\n<!-- SCOW Version v0.9.11 250618 -->", 1)
+		os.WriteFile(filepath.Join(overlay, "demov1", "common.xsd"), []byte(patched), 0o644)
+		*schemaOverlay = overlay
+		defer func() { *schemaOverlay = "" }()
+
+		if content := generate(t, "1735689600"); !regexp.MustCompile(`(?m)^// Schema: demov1/common\.xsd \(SCOW Version v0\.9\.11 250618, sha256 [0-9a-f]{12}, patched\)$`).MatchString(content) {
+			t.Errorf("Expected the patched schema with its version, got:\n%s", content[:strings.Index(content, "syntax")])
+		}
+	})
+}
+
+// TestUnifiedDiff validates the line diff -dry-run prints
+func TestUnifiedDiff(t *testing.T) {
+	old := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+	updated := []string{"a", "B", "c", "d", "e", "f", "g", "h", "i", "j", "k"}
+
+	want := `--- f
++++ f
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -8,3 +8,4 @@
+ h
+ i
+ j
++k
+`
+	if got := unifiedDiff("f", diffLines(old, updated), 3); got != want {
+		t.Errorf("unifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := unifiedDiff("f", diffLines(old, old), 3); got != "--- f\n+++ f\n" {
+		t.Errorf("Expected no hunks for equal input, got\n%s", got)
+	}
+}
+
+// TestGoPackageRoot validates that a custom module root replaces the default
+// in every go_package option
+func TestGoPackageRoot(t *testing.T) {
+	t.Chdir(filepath.Join("..", ".."))
+
+	const root = "example.com/fork/ddex/gen/"
+	spec := specs[slices.IndexFunc(specs, func(s struct{ name, version, mainFile string }) bool {
+		return s.name == "ern" && s.version == "432"
+	})]
+
+	st, err := loadSpec(spec)
+	if err != nil {
+		t.Skipf("Schemas not available: %v", err)
+	}
+	namespaces, pkgs := planBundles(st, spec, root)
+	contents, err := generateBundles(st, namespaces, pkgs)
+	if err != nil {
+		t.Fatalf("generateBundles failed: %v", err)
+	}
+
+	content := strings.Join(contents, "\n")
+	if want := `option go_package = "example.com/fork/ddex/gen/ddex/ern/v432";`; !strings.Contains(content, want) {
+		t.Errorf("Expected %s in generated proto", want)
+	}
+	if strings.Contains(content, defaultGoPackageRoot) {
+		t.Errorf("Expected no go_package under %s", defaultGoPackageRoot)
+	}
+
+	service := generateIngestService(nil, root)
+	if want := `option go_package = "example.com/fork/ddex/gen/ddex/ingest/v1";`; !strings.Contains(service, want) {
+		t.Errorf("Expected %s in the ingestion service", want)
+	}
+}
+
+// TestProtoLayout validates that generated files and the imports between
+// them follow the chosen layout, so the output still compiles
+func TestProtoLayout(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.CopyFS(dir, os.DirFS(filepath.Join("testdata", "xsd"))); err != nil {
+		t.Fatal(err)
+	}
+	avs := `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://ddex.net/xml/avs/avs">
+  <xs:simpleType name="ReleaseType">
+    <xs:restriction base="xs:string">
+      <xs:enumeration value="Album"/>
+    </xs:restriction>
+  </xs:simpleType>
+</xs:schema>`
+	if err := os.WriteFile(filepath.Join(dir, "allowed-value-sets.xsd"), []byte(avs), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	previous := *schemaDir
+	*schemaDir = dir
+	t.Cleanup(func() { *schemaDir = previous; protoPath = nestedLayout })
+
+	tests := []struct {
+		name, layout, prefix string
+		want                 []string
+	}{
+		{"Nested", layoutNested, "", []string{"ddex/avs/vlatest/vlatest.proto", "ddex/demo/v1/v1.proto", "ddex/extra/v1/v1.proto"}},
+		{"Flat", layoutFlat, "", []string{"ddex_avs_vlatest.proto", "ddex_demo_v1.proto", "ddex_extra_v1.proto"}},
+		{"Flat Under Prefix", layoutFlat, "vendor/ddex", []string{"vendor/ddex/ddex_avs_vlatest.proto", "vendor/ddex/ddex_demo_v1.proto", "vendor/ddex/ddex_extra_v1.proto"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout, err := newProtoLayout(tt.layout, tt.prefix)
+			if err != nil {
+				t.Fatalf("newProtoLayout failed: %v", err)
+			}
+			protoPath = layout
+			t.Chdir(t.TempDir())
+
+			for _, spec := range []struct{ name, version, mainFile string }{
+				{"avs", "latest", "allowed-value-sets.xsd"},
+				{"demo", "1", "release-notification.xsd"},
+			} {
+				if _, err := convertSpec(spec, defaultGoPackageRoot); err != nil {
+					t.Fatalf("convertSpec %s failed: %v", spec.name, err)
+				}
+			}
+
+			var files []string
+			for name := range readTree(t, "proto") {
+				if strings.HasSuffix(name, ".proto") {
+					files = append(files, name)
+				}
+			}
+			slices.Sort(files)
+			if !slices.Equal(files, tt.want) {
+				t.Fatalf("Expected files %v, got %v", tt.want, files)
+			}
+
+			// Every import must name a generated file relative to proto/
+			imports := 0
+			for _, file := range files {
+				content, err := os.ReadFile(filepath.Join("proto", file))
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, m := range regexp.MustCompile(`(?m)^import "([^"]+)";$`).FindAllStringSubmatch(string(content), -1) {
+					imports++
+					if !slices.Contains(files, m[1]) {
+						t.Errorf("%s imports %s, which was not generated", file, m[1])
+					}
+				}
+			}
+			if imports == 0 {
+				t.Error("Expected the demo spec to import the other files")
+			}
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, tt := range []struct{ layout, prefix string }{
+			{"tree", ""},
+			{layoutFlat, "../outside"},
+			{layoutNested, "/abs"},
+		} {
+			if _, err := newProtoLayout(tt.layout, tt.prefix); err == nil {
+				t.Errorf("Expected an error for layout %q under %q", tt.layout, tt.prefix)
+			}
+		}
+	})
+}
+
+// TestReservedFields validates the extension range on generated messages and
+// that fields dropped between generations are reserved rather than reused
+func TestReservedFields(t *testing.T) {
+	complexType := &XSDComplexType{
+		Sequence: &XSDSequence{Elements: []XSDElement{
+			{Name: "Title", Type: "xs:string"},
+			{Name: "Duration", Type: "xs:string"},
+		}},
+	}
+	msg, _, err := generateComplexTypeMessage("Track", complexType, nil)
+	if err != nil {
+		t.Fatalf("generateComplexTypeMessage failed: %v", err)
+	}
+	if !strings.HasSuffix(msg, reservedExtensionRange+"}") {
+		t.Errorf("Expected message to end with the extension range, got:\n%s", msg)
+	}
+
+	previous := `message Track {
+  string title = 1;
+  string duration = 2;
+  string isrc = 3;
+  reserved 7;
+  reserved "legacy";
+  reserved 9000 to 9999;
+}
+`
+
+	t.Run("Removed Field", func(t *testing.T) {
+		got := reserveRetiredFields(msg+"\n", previous)
+
+		layout := parseMessageLayouts(got)["Track"]
+		if !slices.Equal(layout.reservedNumbers, []int{3, 7}) {
+			t.Errorf("Expected reserved numbers [3 7], got %v", layout.reservedNumbers)
+		}
+		if !slices.Equal(layout.reservedNames, []string{"isrc", "legacy"}) {
+			t.Errorf("Expected reserved names [isrc legacy], got %v", layout.reservedNames)
+		}
+		if !strings.Contains(got, reservedExtensionRange) {
+			t.Error("Extension range dropped")
+		}
+	})
+
+	t.Run("Reused Number", func(t *testing.T) {
+		current := "message Track {\n  string title = 1;\n  string isrc = 2;\n" + reservedExtensionRange + "}\n"
+		got := reserveRetiredFields(current, previous)
+
+		layout := parseMessageLayouts(got)["Track"]
+		if slices.Contains(layout.reservedNumbers, 2) {
+			t.Error("Number 2 is in use and must not be reserved")
+		}
+		if !slices.Equal(layout.reservedNames, []string{"duration", "legacy"}) {
+			t.Errorf("Expected reserved names [duration legacy], got %v", layout.reservedNames)
+		}
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		current := strings.Replace(previous, "  reserved 7;\n  reserved \"legacy\";\n", "", 1)
+		if got := reserveRetiredFields(current, current); got != current {
+			t.Errorf("Expected unchanged content, got:\n%s", got)
+		}
+	})
+}
+
+// TestValidateConstraints validates the buf.validate options -validate
+// derives from cardinalities and simple type facets
+func TestValidateConstraints(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"allowed-value-sets.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://ddex.net/xml/avs/avs">
+  <xs:simpleType name="ReleaseType">
+    <xs:restriction base="xs:string">
+      <xs:enumeration value="Album"/>
+      <xs:enumeration value="Single"/>
+    </xs:restriction>
+  </xs:simpleType>
+</xs:schema>`,
+		"main.xsd": `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:demo="http://example.com/xml/demo/1" xmlns:avs="http://ddex.net/xml/avs/avs" targetNamespace="http://example.com/xml/demo/1">
+  <xs:import namespace="http://ddex.net/xml/avs/avs" schemaLocation="allowed-value-sets.xsd"/>
+  <xs:complexType name="Release">
+    <xs:sequence>
+      <xs:element name="ReleaseReference">
+        <xs:simpleType>
+          <xs:restriction base="xs:string">
+            <xs:pattern value="R[\d\-_a-zA-Z]+"/>
+          </xs:restriction>
+        </xs:simpleType>
+      </xs:element>
+      <xs:element name="Code" minOccurs="0">
+        <xs:simpleType>
+          <xs:restriction base="demo:ShortCode">
+            <xs:minLength value="2"/>
+          </xs:restriction>
+        </xs:simpleType>
+      </xs:element>
+      <xs:element name="Title" type="xs:string" maxOccurs="unbounded"/>
+      <xs:element name="Kind" type="demo:Kind" minOccurs="0"/>
+      <xs:element name="ReleaseType" type="avs:ReleaseType" minOccurs="0" maxOccurs="unbounded"/>
+      <xs:element name="Artist" type="demo:Artist"/>
+      <xs:choice>
+        <xs:element name="Price" type="xs:string"/>
+        <xs:element name="Free" type="xs:boolean"/>
+      </xs:choice>
+    </xs:sequence>
+    <xs:attribute name="LanguageAndScriptCode" use="required">
+      <xs:simpleType>
+        <xs:restriction base="xs:string">
+          <xs:pattern value="[a-z]{2}"/>
+          <xs:pattern value="[a-z]{2}-[A-Z]{2}"/>
+        </xs:restriction>
+      </xs:simpleType>
+    </xs:attribute>
+    <xs:attribute name="Name">
+      <xs:simpleType>
+        <xs:restriction base="xs:string">
+          <xs:pattern value="\i\c*"/>
+        </xs:restriction>
+      </xs:simpleType>
+    </xs:attribute>
+  </xs:complexType>
+  <xs:complexType name="Artist">
+    <xs:simpleContent>
+      <xs:extension base="demo:Code"/>
+    </xs:simpleContent>
+  </xs:complexType>
+  <xs:simpleType name="Code">
+    <xs:restriction base="demo:ShortCode">
+      <xs:minLength value="2"/>
+    </xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="ShortCode">
+    <xs:restriction base="xs:string">
+      <xs:maxLength value="8"/>
+    </xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="Kind">
+    <xs:restriction base="xs:string">
+      <xs:enumeration value="Physical"/>
+      <xs:enumeration value="Digital"/>
+    </xs:restriction>
+  </xs:simpleType>
+</xs:schema>`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	previous := *schemaDir
+	*schemaDir = dir
+	t.Cleanup(func() { *schemaDir = previous })
+
+	st := newLoadState()
+	if err := loadSchemaGraph(st, filepath.Join(dir, "main.xsd"), ""); err != nil {
+		t.Fatalf("loadSchemaGraph failed: %v", err)
+	}
+	b := st.nsBundles["http://example.com/xml/demo/1"]
+	all := map[string]protoPkgInfo{b.TargetNamespace: {pkgName: "ddex.demo.v1"}}
+	content, err := generateProtoForBundle(b, "ddex.demo.v1", "example.com/demo/v1", all, st.avsVersionContext)
+	if err != nil {
+		t.Fatalf("generateProtoForBundle failed: %v", err)
+	}
+	proto := annotateConstraints(content, b, newConstraintResolver(st))
+
+	for _, want := range []string{
+		"import \"buf/validate/validate.proto\";\nimport \"ddex/avs/vlatest/vlatest.proto\";\n",
+		`string release_reference = 1 [(buf.validate.field) = {required: true, string: {pattern: "^(?:R[\\d\\-_a-zA-Z]+)$"}}];`,
+		`optional string code = 2 [(buf.validate.field) = {string: {min_len: 2, max_len: 8}}];`,
+		`repeated string title = 3 [(buf.validate.field) = {repeated: {min_items: 1}}];`,
+		`Kind kind = 4 [(buf.validate.field) = {ignore: IGNORE_IF_ZERO_VALUE, enum: {defined_only: true}}];`,
+		`repeated string release_type = 5 [(buf.validate.field) = {repeated: {items: {string: {in: ["Album", "Single"]}}}}];`,
+		`Artist artist = 6 [(buf.validate.field) = {required: true}];`,
+		"string price = 7;\n",
+		"bool free = 8;\n",
+		`string language_and_script_code = 9 [(buf.validate.field) = {required: true, string: {pattern: "^(?:(?:[a-z]{2})|(?:[a-z]{2}-[A-Z]{2}))$"}}];`,
+		"string name = 10;\n",
+		`string value = 1 [(buf.validate.field) = {string: {min_len: 2, max_len: 8}}];`,
+	} {
+		if !strings.Contains(proto, want) {
+			t.Errorf("Expected %s in:\n%s", want, proto)
+		}
+	}
+
+	t.Run("Unconstrained", func(t *testing.T) {
+		content := "syntax = \"proto3\";\n\n// Target namespace: x\n\nmessage Empty {\n  reserved 9000 to 9999;\n}\n"
+		if got := annotateConstraints(content, &NamespaceBundle{}, newConstraintResolver(newLoadState())); got != content {
+			t.Errorf("Expected content unchanged, got:\n%s", got)
+		}
+	})
+
+	t.Run("Class Subtraction", func(t *testing.T) {
+		for pattern, want := range map[string]bool{
+			"[a-z-[aeiou]]": true,
+			"[a-z]-[0-9]":   false,
+			`\[a-[b]`:       false,
+			"(-[a-z]+)":     false,
+		} {
+			if got := hasClassSubtraction(pattern); got != want {
+				t.Errorf("hasClassSubtraction(%q) = %v, want %v", pattern, got, want)
+			}
+		}
+	})
+}
+
+// TestReservedFieldNumbers validates that field numbers of very large types
+// skip the extension block and the range reserved by protobuf
+func TestReservedFieldNumbers(t *testing.T) {
+	var elements []XSDElement
+	for i := 0; i < 19500; i++ {
+		elements = append(elements, XSDElement{Name: fmt.Sprintf("Field%d", i), Type: "xs:string"})
+	}
+	complexType := &XSDComplexType{
+		Sequence:   &XSDSequence{Elements: elements},
+		Attributes: []XSDAttribute{{Name: "Last", Type: "xs:string"}},
+	}
+	msg, _, err := generateComplexTypeMessage("Large", complexType, nil)
+	if err != nil {
+		t.Fatalf("generateComplexTypeMessage failed: %v", err)
+	}
+
+	numbers := regexp.MustCompile(`= (\d+);`).FindAllStringSubmatch(msg, -1)
+	if len(numbers) != len(elements)+1 {
+		t.Fatalf("Expected %d fields, got %d", len(elements)+1, len(numbers))
+	}
+	seen := make(map[int]bool)
+	for _, m := range numbers {
+		n, _ := strconv.Atoi(m[1])
+		if n >= extensionRangeStart && n <= extensionRangeEnd || n >= protoReservedRangeStart && n <= protoReservedRangeEnd {
+			t.Fatalf("Field number %d is in a reserved range", n)
+		}
+		if seen[n] {
+			t.Fatalf("Field number %d assigned twice", n)
+		}
+		seen[n] = true
+	}
+	if want := "string last = 21501;"; !strings.Contains(msg, want) {
+		t.Errorf("Expected %q after both reserved ranges", want)
+	}
+}
+
+// TestAttributeElementNameClash validates that an attribute named like a
+// child element keeps a distinct field carrying its XML name
+func TestAttributeElementNameClash(t *testing.T) {
+	complexType := &XSDComplexType{
+		Sequence: &XSDSequence{Elements: []XSDElement{
+			{Name: "PriceType", Type: "xs:string"},
+			{Name: "Tag", Type: "xs:string", MaxOccurs: "unbounded"},
+		}},
+		Attributes: []XSDAttribute{
+			{Name: "PriceType", Type: "xs:string"},
+			{Name: "Tag", Type: "xs:string"},
+		},
+	}
+	msg, _, err := generateComplexTypeMessage("Price", complexType, nil)
+	if err != nil {
+		t.Fatalf("generateComplexTypeMessage failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"// @gotags: xml:\"PriceType\"\n  string price_type = 1;",
+		"// @gotags: xml:\"Tag\"\n  repeated string tag = 2;",
+		"// @gotags: xml:\"PriceType,attr\"\n  optional string price_type_attr = 3;",
+		"// @gotags: xml:\"Tag,attr\"\n  optional string tag_attr = 4;",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected %q in:\n%s", want, msg)
+		}
+	}
+	if regexp.MustCompile(`_\d+ = `).MatchString(msg) {
+		t.Errorf("Expected no numeric-suffixed fields:\n%s", msg)
+	}
+}
+
+// TestBuiltinTypes validates that every XML Schema built-in type maps to a
+// proto scalar rather than a reference to a message that doesn't exist,
+// while prefixed DDEX types of the same name stay messages
+func TestBuiltinTypes(t *testing.T) {
+	builtins := map[string]string{
+		"string": "string", "normalizedString": "string", "token": "string", "language": "string",
+		"Name": "string", "NCName": "string", "ID": "string", "IDREF": "string", "IDREFS": "string",
+		"ENTITY": "string", "ENTITIES": "string", "NMTOKEN": "string", "NMTOKENS": "string",
+		"QName": "string", "NOTATION": "string", "anyURI": "string", "anySimpleType": "string",
+
+		"decimal": "string", "float": "string", "double": "double",
+		"integer": "int32", "int": "int32", "short": "int32", "byte": "int32", "positiveInteger": "int32",
+		"long": "int64", "nonPositiveInteger": "int64", "negativeInteger": "int64",
+		"nonNegativeInteger": "uint64", "unsignedLong": "uint64",
+		"unsignedInt": "uint32", "unsignedShort": "uint32", "unsignedByte": "uint32",
+		"boolean": "bool",
+
+		"duration": "string", "dateTime": "string", "time": "string", "date": "string",
+		"gYearMonth": "string", "gYear": "string", "gMonthDay": "string", "gDay": "string", "gMonth": "string",
+		"dateTimeStamp": "string", "dayTimeDuration": "string", "yearMonthDuration": "string",
+
+		"hexBinary": "bytes", "base64Binary": "bytes",
+	}
+	for name, want := range builtins {
+		t.Run(name, func(t *testing.T) {
+			for _, prefix := range []string{"xs:", "xsd:"} {
+				if got := xsdTypeToProto(prefix+name, nil); got != want {
+					t.Errorf("xsdTypeToProto(%q) = %q, want %q", prefix+name, got, want)
+				}
+			}
+			if !protoScalarTypes[want] {
+				t.Errorf("%s maps to %s, which is not a proto scalar", name, want)
+			}
+			if _, ok := goScalarTypes[want]; !ok {
+				t.Errorf("%s maps to %s, which has no Go type", name, want)
+			}
+		})
+	}
+
+	t.Run("Unknown Built-In", func(t *testing.T) {
+		if got := xsdTypeToProto("xs:unsignedInteger", nil); got != "string" {
+			t.Errorf("Expected an unknown xs type to map to string, got %q", got)
+		}
+	})
+
+	t.Run("Custom Types", func(t *testing.T) {
+		for xsdType, want := range map[string]string{"Name": "Name", "ern:Name": "Name", "ern:ID": "ID", "ReleaseId": "ReleaseId"} {
+			if got := xsdTypeToProto(xsdType, nil); got != want {
+				t.Errorf("xsdTypeToProto(%q) = %q, want the message %q", xsdType, got, want)
+			}
+		}
+	})
+
+	t.Run("Generated Fields", func(t *testing.T) {
+		complexType := &XSDComplexType{
+			Sequence: &XSDSequence{Elements: []XSDElement{
+				{Name: "TrackCount", Type: "xs:unsignedInt"},
+				{Name: "Checksum", Type: "xs:hexBinary", MinOccurs: "0"},
+				{Name: "Anniversary", Type: "xs:gMonthDay", MaxOccurs: "unbounded"},
+				{Name: "Plays", Type: "xs:nonNegativeInteger", MinOccurs: "0"},
+			}},
+		}
+		msg, _, err := generateComplexTypeMessage("Release", complexType, nil)
+		if err != nil {
+			t.Fatalf("generateComplexTypeMessage failed: %v", err)
+		}
+		for _, want := range []string{
+			"  uint32 track_count = 1;",
+			"  optional bytes checksum = 2;",
+			"  repeated string anniversary = 3;",
+			"  optional uint64 plays = 4;",
+		} {
+			if !strings.Contains(msg, want) {
+				t.Errorf("Expected %q in:\n%s", want, msg)
+			}
+		}
+
+		source, err := generateGoStructs(msg+"\n", protoPkgInfo{pkgName: "ddex.demo.v1", goPackage: "example.com/demo/v1"}, nil, nil, "release-notification.xsd")
+		if err != nil {
+			t.Fatalf("generateGoStructs failed: %v", err)
+		}
+		for _, want := range []string{"TrackCount  uint32", "Checksum    []byte", "Plays       *uint64", "return 0"} {
+			if !strings.Contains(string(source), want) {
+				t.Errorf("Expected %q in:\n%s", want, source)
+			}
+		}
+	})
+}
+
+// httpRule is the REST mapping of an RPC, read from its compiled google.api.http option
+type httpRule struct {
+	request, response, post, body string
+}
+
+// compileIngestService compiles the ingestion service at path, relative to
+// dir, against the generated protos and the googleapis annotations, and
+// returns the google.api.http rule of each RPC by name
+func compileIngestService(t *testing.T, dir, path string) map[string]httpRule {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: []string{dir, filepath.Join("..", "..", "proto"), filepath.Join("testdata", "googleapis")},
+		}),
+	}
+	files, err := compiler.Compile(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Failed to compile %s: %v", path, err)
+	}
+	file := files[0]
+
+	resolver := linker.ResolverFromFile(file)
+	httpOption, err := resolver.FindExtensionByName("google.api.http")
+	if err != nil {
+		t.Fatalf("google.api.http is not visible from %s: %v", path, err)
+	}
+	types := new(protoregistry.Types)
+	if err := types.RegisterExtension(httpOption); err != nil {
+		t.Fatal(err)
+	}
+
+	rules := make(map[string]httpRule)
+	services := file.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			method := methods.Get(j)
+			// Re-read the options with the extension registered so the
+			// rule is decoded rather than kept as unknown fields
+			raw, err := proto.Marshal(method.Options())
+			if err != nil {
+				t.Fatal(err)
+			}
+			options := &descriptorpb.MethodOptions{}
+			if err := (proto.UnmarshalOptions{Resolver: types}).Unmarshal(raw, options); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.HasExtension(options, httpOption) {
+				t.Errorf("%s has no google.api.http option", method.Name())
+				continue
+			}
+			rule := proto.GetExtension(options, httpOption).(protoreflect.Message)
+			fields := rule.Descriptor().Fields()
+			rules[string(method.Name())] = httpRule{
+				request:  string(method.Input().FullName()),
+				response: string(method.Output().FullName()),
+				post:     rule.Get(fields.ByName("post")).String(),
+				body:     rule.Get(fields.ByName("body")).String(),
+			}
+		}
+	}
+	return rules
+}
+
+// TestIngestService validates that every generated RPC carries a REST mapping
+func TestIngestService(t *testing.T) {
+	roots := []serviceRoot{
+		{pkg: protoPkgInfo{pkgName: "ddex.ern.v432", filePath: "ddex/ern/v432/v432.proto"}, message: "NewReleaseMessage"},
+		{pkg: protoPkgInfo{pkgName: "ddex.ern.v432", filePath: "ddex/ern/v432/v432.proto"}, message: "PurgeReleaseMessage"},
+		{pkg: protoPkgInfo{pkgName: "ddex.mead.v11", filePath: "ddex/mead/v11/v11.proto"}, message: "MeadMessage"},
+	}
+	dir := t.TempDir()
+	path := protoPath(ingestServicePackage, "ingest")
+	if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, path), []byte(generateIngestService(roots, defaultGoPackageRoot)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"SubmitErnV432NewReleaseMessage":   "/v1/ern/v432/NewReleaseMessage",
+		"SubmitErnV432PurgeReleaseMessage": "/v1/ern/v432/PurgeReleaseMessage",
+		"SubmitMeadV11MeadMessage":         "/v1/mead/v11/MeadMessage",
+	}
+	rules := compileIngestService(t, dir, path)
+	if len(rules) != len(want) {
+		t.Fatalf("Expected %d RPCs, got %v", len(want), rules)
+	}
+	for name, rule := range rules {
+		if rule.post != want[name] {
+			t.Errorf("%s: http post %q, want %q", name, rule.post, want[name])
+		}
+		if rule.body != "message" {
+			t.Errorf("%s: http body %q, want message", name, rule.body)
+		}
+		if rule.request != ingestServicePackage+"."+name+"Request" || rule.response != ingestServicePackage+"."+name+"Response" {
+			t.Errorf("%s: unexpected request/response types %s, %s", name, rule.request, rule.response)
+		}
+	}
+
+	t.Run("Checked In", func(t *testing.T) {
+		// Every root of the checked-in service is posted to /v1/<spec>/<version>/<message>
+		rules := compileIngestService(t, filepath.Join("..", "..", "proto"), path)
+		if len(rules) == 0 {
+			t.Fatal("Expected RPCs in the checked-in ingestion service")
+		}
+		for name, rule := range rules {
+			parts := strings.Split(strings.TrimPrefix(rule.post, "/v1/"), "/")
+			if len(parts) != 3 || name != "Submit"+strings.ToUpper(parts[0][:1])+parts[0][1:]+strings.ToUpper(parts[1][:1])+parts[1][1:]+parts[2] {
+				t.Errorf("%s: http post %q does not match the RPC", name, rule.post)
+			}
+			if rule.body != "message" {
+				t.Errorf("%s: http body %q, want message", name, rule.body)
+			}
+		}
+	})
+}
+
+// TestJSONSchema validates the -backend=jsonschema document of a root
+// message: its references resolve, and cardinality and simple types carry over
+func TestJSONSchema(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.CopyFS(dir, os.DirFS(filepath.Join("testdata", "xsd"))); err != nil {
+		t.Fatal(err)
+	}
+	avs := `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="http://ddex.net/xml/avs/avs">
+  <xs:simpleType name="ReleaseType">
+    <xs:restriction base="xs:string">
+      <xs:enumeration value="Album"/>
+      <xs:enumeration value="Single"/>
+    </xs:restriction>
+  </xs:simpleType>
+</xs:schema>`
+	if err := os.WriteFile(filepath.Join(dir, "allowed-value-sets.xsd"), []byte(avs), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	previous := *schemaDir
+	*schemaDir = dir
+	t.Cleanup(func() { *schemaDir = previous })
+	t.Chdir(t.TempDir())
+
+	if err := convertSpecJSONSchema(struct{ name, version, mainFile string }{"demo", "1", "release-notification.xsd"}, "jsonschema"); err != nil {
+		t.Fatalf("convertSpecJSONSchema failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join("jsonschema", "ddex", "demo", "v1", "NewReleaseMessage.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc jsonSchema
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Invalid JSON Schema: %v", err)
+	}
+
+	// Every reference must name a definition of the document
+	refs := []string{doc.Ref}
+	for _, def := range doc.Defs {
+		for _, property := range def.Properties {
+			if property.Items != nil {
+				property = property.Items
+			}
+			if property.Ref != "" {
+				refs = append(refs, property.Ref)
+			}
+		}
+	}
+	for _, ref := range refs {
+		if doc.Defs[strings.TrimPrefix(ref, "#/$defs/")] == nil {
+			t.Errorf("Reference %s has no definition", ref)
+		}
+	}
+
+	release := doc.Defs["ddex.demo.v1.Release"]
+	if release == nil {
+		t.Fatal("Expected a definition of ddex.demo.v1.Release")
+	}
+	if want := []string{"release_id", "display_title_text"}; !slices.Equal(release.Required, want) {
+		t.Errorf("Release requires %v, want %v", release.Required, want)
+	}
+	if titles := release.Properties["display_title_text"]; titles.Type != "array" || titles.MinItems != 1 {
+		t.Errorf("display_title_text = %+v, want an array of at least 1", titles)
+	}
+	if *release.AdditionalProperties {
+		t.Error("Expected Release to reject unknown properties")
+	}
+
+	for property, want := range map[string][]string{
+		"release_type":     {"Album", "Single"},
+		"parental_warning": {"Explicit", "NotExplicit", "Not-Explicit", "Médium"},
+	} {
+		def := doc.Defs[strings.TrimPrefix(release.Properties[property].Ref, "#/$defs/")]
+		if def == nil || def.Type != "string" || !slices.Equal(def.Enum, want) {
+			t.Errorf("%s = %+v, want a string in %v", property, def, want)
+		}
+	}
+	if got := release.Properties["release_type"].Ref; got != "#/$defs/ddex.avs.vlatest.ReleaseType" {
+		t.Errorf("release_type references %s, want the AVS set", got)
+	}
+}
+
+// TestConcurrentGeneration validates that runs sharing an output directory
+// take turns through the lock file and leave the same output as a single run
+func TestConcurrentGeneration(t *testing.T) {
+	fixtures, err := filepath.Abs(filepath.Join("testdata", "xsd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := *schemaDir
+	*schemaDir = fixtures
+	t.Cleanup(func() { *schemaDir = previous })
+	selected := []struct{ name, version, mainFile string }{
+		{"demo", "1", "release-notification.xsd"},
+		{"flat", "1", "release-notification.xsd"},
+	}
+
+	t.Chdir(t.TempDir())
+	if err := generate(selected); err != nil {
+		t.Fatalf("generate failed: %v", err)
+	}
+	want := readTree(t, "proto")
+
+	t.Run("Concurrent Runs", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i := range errs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = generate(selected)
+			}()
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				t.Fatalf("generate failed: %v", err)
+			}
+		}
+
+		got := readTree(t, "proto")
+		if !maps.Equal(got, want) {
+			t.Errorf("Concurrent runs wrote %d files differing from a single run's %d", len(got), len(want))
+		}
+		if _, err := os.Stat(lockFileName); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be removed, got %v", lockFileName, err)
+		}
+	})
+
+	t.Run("Stale Lock", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		// No process runs with a PID beyond the largest the kernel assigns
+		if err := os.WriteFile(lockFileName, []byte("99999999\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := generate(selected); err != nil {
+			t.Fatalf("generate failed: %v", err)
+		}
+		if got := readTree(t, "proto"); !maps.Equal(got, want) {
+			t.Error("Output after taking over a stale lock differs from a single run's")
+		}
+	})
+
+	t.Run("Held Lock", func(t *testing.T) {
+		t.Chdir(t.TempDir())
+		release, err := acquireLock(lockFileName, time.Second)
+		if err != nil {
+			t.Fatalf("acquireLock failed: %v", err)
+		}
+		defer release()
+		if _, err := acquireLock(lockFileName, 200*time.Millisecond); err == nil || !strings.Contains(err.Error(), "still held") {
+			t.Errorf("Expected a timeout while the lock is held, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package ddex
+
+import (
+	"cmp"
+	"slices"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+)
+
+// Track is a single entry of a release's track listing
+type Track struct {
+	SequenceNumber    int32  // SequenceNumber of the ResourceGroupContentItem
+	ResourceReference string // e.g. A1
+	ISRC              string
+	Title             string // default DisplayTitleText
+	Artist            string // default DisplayArtistName
+	Duration          string // ISO 8601, e.g. PT2M28S
+}
+
+// TrackListing returns the sound recordings and videos of the main release in
+// play order, following its ResourceGroup (and any sub-groups, e.g. discs) by
+// SequenceNumber and resolving each ReleaseResourceReference against the
+// ResourceList. Images, texts and other non-playable resources are skipped.
+func TrackListing(msg *ernv432.NewReleaseMessage) []Track {
+	release := msg.GetReleaseList().GetRelease()
+	if release == nil || release.ResourceGroup == nil {
+		return nil
+	}
+
+	resources := make(map[string]Track)
+	for _, sr := range msg.GetResourceList().GetSoundRecording() {
+		resources[sr.ResourceReference] = soundRecordingTrack(sr)
+	}
+	for _, video := range msg.GetResourceList().GetVideo() {
+		resources[video.ResourceReference] = videoTrack(video)
+	}
+
+	var tracks []Track
+	appendGroupTracks(&tracks, release.ResourceGroup.ResourceGroupContentItem, release.ResourceGroup.ResourceGroup, resources)
+	return tracks
+}
+
+// soundRecordingTrack describes a sound recording by its defaults and first ISRC
+func soundRecordingTrack(sr *ernv432.SoundRecording) Track {
+	track := Track{
+		ResourceReference: sr.ResourceReference,
+		Artist:            defaultArtistName(sr.DisplayArtistName),
+		Duration:          sr.Duration,
+	}
+	if title := SelectByLanguage(sr.DisplayTitleText, nil); title != nil {
+		track.Title = title.Value
+	}
+	for _, edition := range sr.SoundRecordingEdition {
+		for _, id := range edition.ResourceId {
+			if track.ISRC == "" {
+				track.ISRC = id.GetISRC()
+			}
+		}
+	}
+	return track
+}
+
+// videoTrack describes a video by its defaults and first ISRC
+func videoTrack(video *ernv432.Video) Track {
+	track := Track{
+		ResourceReference: video.ResourceReference,
+		Artist:            defaultArtistName(video.DisplayArtistName),
+		Duration:          video.Duration,
+	}
+	if title := SelectByLanguage(video.DisplayTitleText, nil); title != nil {
+		track.Title = title.Value
+	}
+	for _, edition := range video.VideoEdition {
+		for _, id := range edition.ResourceId {
+			if track.ISRC == "" {
+				track.ISRC = id.GetISRC()
+			}
+		}
+	}
+	return track
+}
+
+// appendGroupTracks appends the tracks of a resource group's items, then of
+// its sub-groups, each ordered by SequenceNumber
+func appendGroupTracks(tracks *[]Track, items []*ernv432.ResourceGroupContentItem, groups []*ernv432.ResourceSubGroup, resources map[string]Track) {
+	items = slices.Clone(items)
+	slices.SortStableFunc(items, func(a, b *ernv432.ResourceGroupContentItem) int {
+		return cmp.Compare(a.GetSequenceNumber(), b.GetSequenceNumber())
+	})
+	for _, item := range items {
+		if track, ok := resources[item.ReleaseResourceReference]; ok {
+			track.SequenceNumber = item.GetSequenceNumber()
+			*tracks = append(*tracks, track)
+		}
+	}
+
+	groups = slices.Clone(groups)
+	slices.SortStableFunc(groups, func(a, b *ernv432.ResourceSubGroup) int {
+		return cmp.Compare(a.GetSequenceNumber(), b.GetSequenceNumber())
+	})
+	for _, group := range groups {
+		appendGroupTracks(tracks, group.ResourceGroupContentItem, group.ResourceGroup, resources)
+	}
+}
+
+// defaultArtistName returns the default display artist name, or the first
+func defaultArtistName(names []*ernv432.DisplayArtistNameWithOriginalLanguage) string {
+	for _, name := range names {
+		if name.GetIsDefault() {
+			return name.Value
+		}
+	}
+	if len(names) > 0 {
+		return names[0].Value
+	}
+	return ""
+}
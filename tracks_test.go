@@ -0,0 +1,107 @@
+package ddex
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestTrackListing validates track order and reference resolution on real samples
+func TestTrackListing(t *testing.T) {
+	t.Run("Album", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml"))
+
+		tracks := TrackListing(msg)
+		if len(tracks) < 2 {
+			t.Fatalf("Expected at least 2 tracks, got %d", len(tracks))
+		}
+
+		want := []Track{
+			{SequenceNumber: 1, ResourceReference: "A1", ISRC: "JPTO09404900", Title: "Yume no Lullaby", Artist: "Saeko Shu", Duration: "PT2M28S"},
+			{SequenceNumber: 2, ResourceReference: "A2", ISRC: "JPTO09404910", Title: "Yume no Hajimari - Kaze no Okuri Mono (Introduction)", Artist: "Saeko Shu", Duration: "PT1M42S"},
+		}
+		for i, w := range want {
+			if tracks[i] != w {
+				t.Errorf("Track %d = %+v, want %+v", i+1, tracks[i], w)
+			}
+		}
+		for i := 1; i < len(tracks); i++ {
+			if tracks[i].SequenceNumber <= tracks[i-1].SequenceNumber {
+				t.Errorf("Tracks out of order at %d: %d after %d", i, tracks[i].SequenceNumber, tracks[i-1].SequenceNumber)
+			}
+		}
+	})
+
+	t.Run("Single", func(t *testing.T) {
+		msg := loadNewReleaseMessage(t, filepath.Join("testdata", "ernv432", "Samples43", "4 SimpleAudioSingle.xml"))
+
+		tracks := TrackListing(msg)
+		if len(tracks) != 1 {
+			t.Fatalf("Expected the cover image to be skipped leaving 1 track, got %d", len(tracks))
+		}
+		if tracks[0].ISRC != "GBAYC1700598" || tracks[0].Title != "RIOPY: I Love You" {
+			t.Errorf("Unexpected track %+v", tracks[0])
+		}
+	})
+
+	t.Run("Sub Groups", func(t *testing.T) {
+		msg := &ernv432.NewReleaseMessage{
+			ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{
+				{ResourceReference: "A1", DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "Disc 2 Track 1"}}},
+				{ResourceReference: "A2", DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "Disc 1 Track 2"}}},
+				{ResourceReference: "A3", DisplayTitleText: []*ernv432.DisplayTitleText{{Value: "Disc 1 Track 1"}}},
+			}},
+			ReleaseList: &ernv432.ReleaseList{Release: &ernv432.Release{
+				ResourceGroup: &ernv432.ResourceGroup{ResourceGroup: []*ernv432.ResourceSubGroup{
+					{SequenceNumber: proto.Int32(2), ResourceGroupContentItem: []*ernv432.ResourceGroupContentItem{
+						{SequenceNumber: proto.Int32(1), ReleaseResourceReference: "A1"},
+					}},
+					{SequenceNumber: proto.Int32(1), ResourceGroupContentItem: []*ernv432.ResourceGroupContentItem{
+						{SequenceNumber: proto.Int32(2), ReleaseResourceReference: "A2"},
+						{SequenceNumber: proto.Int32(1), ReleaseResourceReference: "A3"},
+					}},
+				}},
+			}},
+		}
+
+		tracks := TrackListing(msg)
+		want := []string{"Disc 1 Track 1", "Disc 1 Track 2", "Disc 2 Track 1"}
+		if len(tracks) != len(want) {
+			t.Fatalf("Expected %d tracks, got %d", len(want), len(tracks))
+		}
+		for i, title := range want {
+			if tracks[i].Title != title {
+				t.Errorf("Track %d = %q, want %q", i+1, tracks[i].Title, title)
+			}
+		}
+	})
+
+	if tracks := TrackListing(&ernv432.NewReleaseMessage{}); tracks != nil {
+		t.Errorf("Expected nil for message without a release, got %v", tracks)
+	}
+}
+
+func loadNewReleaseMessage(t *testing.T, xmlPath string) *ernv432.NewReleaseMessage {
+	t.Helper()
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	msg, err := ernv432.UnmarshalNewReleaseMessage(retargetERN432(xmlData))
+	if err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	return msg
+}
+
+// retargetERN432 moves an ERN 4.3 sample into the 4.3.2 namespace. The
+// Samples43 documents are 4.3 messages, which the 4.3.2 types also decode.
+func retargetERN432(xmlData []byte) []byte {
+	return bytes.ReplaceAll(xmlData, []byte(`"`+ernv43.Namespace+`"`), []byte(`"`+ernv432.Namespace+`"`))
+}
@@ -0,0 +1,353 @@
+package ddex
+
+import (
+	"errors"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrNoChanges is returned by BuildUpdate when base and desired are equivalent
+var ErrNoChanges = errors.New("no changes between base and desired message")
+
+// Removal names a keyed list entry that an update removes. ERN 4 has no
+// UpdateIndicator, so removals are kept beside the update message rather
+// than written into it.
+type Removal struct {
+	Path      string // the list the entry was in, e.g. "ResourceList.SoundRecording"
+	Reference string // the entry's *Reference key, e.g. "A2"
+}
+
+// BuildUpdate computes a minimal NewReleaseMessage carrying only what changed
+// from base to desired, and the removals of the keyed base entries missing
+// from desired. The header and root attributes are taken from desired; each
+// list (PartyList, ResourceList, ReleaseList, DealList, ...) keeps only the
+// entries that are new or differ from the base entry with the same *Reference
+// key. Entries without a key cannot be removed. Merge(base, update,
+// removed...) gives desired back as long as desired keeps the base entries in
+// their order and appends new ones.
+func BuildUpdate(base, desired *ernv432.NewReleaseMessage) (*ernv432.NewReleaseMessage, []Removal, error) {
+	if base == nil || desired == nil {
+		return nil, nil, errors.New("base and desired messages are required")
+	}
+
+	update := &ernv432.NewReleaseMessage{}
+	b, d, u := base.ProtoReflect(), desired.ProtoReflect(), update.ProtoReflect()
+	names := xmlFields(d)
+	changed := false
+	var removed []Removal
+
+	fields := d.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !d.Has(fd) && (fd.Kind() != protoreflect.MessageKind || fd.Name() == "message_header" || !b.Has(fd)) {
+			continue
+		}
+
+		path := names[fd.Number()].Name
+		switch {
+		case fd.Kind() != protoreflect.MessageKind:
+			u.Set(fd, d.Get(fd))
+		case fd.Name() == "message_header":
+			u.Set(fd, cloneValue(d.Get(fd)))
+		case fd.IsList():
+			removed = append(removed, diffEntries(path, b.Get(fd).List(), d.Get(fd).List(), u.Mutable(fd).List())...)
+			if u.Get(fd).List().Len() > 0 {
+				changed = true
+			} else {
+				u.Clear(fd)
+			}
+		default:
+			entriesChanged, listRemoved := diffList(path, b.Get(fd).Message(), d.Get(fd).Message(), u.Mutable(fd).Message())
+			removed = append(removed, listRemoved...)
+			if entriesChanged {
+				changed = true
+			} else {
+				u.Clear(fd)
+			}
+		}
+	}
+
+	if !changed && len(removed) == 0 {
+		return nil, nil, ErrNoChanges
+	}
+	return update, removed, nil
+}
+
+// diffList fills out with the entries of desired that are new or changed
+// relative to base, reporting whether any were found, and returns the
+// removals of the keyed entries missing from desired
+func diffList(path string, base, desired, out protoreflect.Message) (bool, []Removal) {
+	names := xmlFields(desired)
+	changed := false
+	var removed []Removal
+
+	fields := desired.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !desired.Has(fd) && (!fd.IsList() || !base.Has(fd)) {
+			continue
+		}
+
+		switch {
+		case fd.Kind() != protoreflect.MessageKind:
+			out.Set(fd, desired.Get(fd))
+		case fd.IsList():
+			removed = append(removed, diffEntries(joinPath(path, names[fd.Number()].Name), base.Get(fd).List(), desired.Get(fd).List(), out.Mutable(fd).List())...)
+			if out.Get(fd).List().Len() > 0 {
+				changed = true
+			} else {
+				out.Clear(fd)
+			}
+		case !proto.Equal(base.Get(fd).Message().Interface(), desired.Get(fd).Message().Interface()):
+			out.Set(fd, cloneValue(desired.Get(fd)))
+			changed = true
+		}
+	}
+
+	return changed, removed
+}
+
+// diffEntries appends to out every desired entry that has no equal counterpart
+// in base, matching entries by their reference key where they have one, and
+// returns the removals of the keyed base entries missing from desired
+func diffEntries(path string, base, desired, out protoreflect.List) []Removal {
+	byKey := make(map[string]proto.Message)
+	for i := 0; i < base.Len(); i++ {
+		entry := base.Get(i).Message()
+		if key := referenceKey(entry); key != "" {
+			byKey[key] = entry.Interface()
+		}
+	}
+
+	for i := 0; i < desired.Len(); i++ {
+		entry := desired.Get(i).Message()
+		if key := referenceKey(entry); key != "" {
+			kept, ok := byKey[key]
+			delete(byKey, key)
+			if ok && proto.Equal(kept, entry.Interface()) {
+				continue
+			}
+		} else if listContains(base, entry.Interface()) {
+			continue
+		}
+		out.Append(cloneValue(desired.Get(i)))
+	}
+
+	var removed []Removal
+	for i := 0; i < base.Len(); i++ {
+		key := referenceKey(base.Get(i).Message())
+		if key != "" && byKey[key] != nil {
+			removed = append(removed, Removal{Path: path, Reference: key})
+			delete(byKey, key)
+		}
+	}
+	return removed
+}
+
+// Merge applies update and then removed to base and returns the resulting
+// message, the current state a consumer holds after both deliveries; no
+// argument is modified. The update's present root attributes and its
+// MessageHeader replace those of base. List entries are matched by their
+// *Reference key, as BuildUpdate produces them: an entry with a new key is
+// appended and one with a known key replaces the base entry. Entries without
+// a key are appended unless base already holds an equal one, and other fields
+// of a list, such as the single Release of a ReleaseList, are replaced when
+// present in the update. Each removal then drops the entry with its reference
+// from the list at its path.
+func Merge(base, update *ernv432.NewReleaseMessage, removed ...Removal) *ernv432.NewReleaseMessage {
+	if base == nil {
+		base = &ernv432.NewReleaseMessage{}
+	}
+	merged := proto.Clone(base).(*ernv432.NewReleaseMessage)
+	m := merged.ProtoReflect()
+
+	if update != nil {
+		u := update.ProtoReflect()
+		fields := u.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if !u.Has(fd) {
+				continue
+			}
+
+			switch {
+			case fd.Kind() != protoreflect.MessageKind:
+				m.Set(fd, u.Get(fd))
+			case fd.Name() == "message_header":
+				m.Set(fd, cloneValue(u.Get(fd)))
+			case fd.IsList():
+				mergeEntries(m.Mutable(fd).List(), u.Get(fd).List())
+			default:
+				list := m.Mutable(fd).Message()
+				mergeList(list, u.Get(fd).Message())
+				if isEmpty(list) {
+					m.Clear(fd)
+				}
+			}
+		}
+	}
+
+	for _, removal := range removed {
+		removeEntry(m, removal)
+	}
+	return merged
+}
+
+// mergeList applies the fields of update to the list wrapper out
+func mergeList(out, update protoreflect.Message) {
+	fields := update.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !update.Has(fd) {
+			continue
+		}
+
+		switch {
+		case fd.Kind() != protoreflect.MessageKind:
+			out.Set(fd, update.Get(fd))
+		case fd.IsList():
+			mergeEntries(out.Mutable(fd).List(), update.Get(fd).List())
+		default:
+			out.Set(fd, cloneValue(update.Get(fd)))
+		}
+	}
+}
+
+// mergeEntries adds and replaces the entries of out named by the entries of
+// update, matching them by their reference key
+func mergeEntries(out, update protoreflect.List) {
+	byKey := make(map[string]int)
+	for i := 0; i < out.Len(); i++ {
+		if key := referenceKey(out.Get(i).Message()); key != "" {
+			byKey[key] = i
+		}
+	}
+
+	for i := 0; i < update.Len(); i++ {
+		entry := update.Get(i).Message()
+		key := referenceKey(entry)
+		if key == "" {
+			if !listContains(out, entry.Interface()) {
+				out.Append(cloneValue(update.Get(i)))
+			}
+			continue
+		}
+
+		if j, ok := byKey[key]; ok {
+			out.Set(j, cloneValue(update.Get(i)))
+		} else {
+			byKey[key] = out.Len()
+			out.Append(cloneValue(update.Get(i)))
+		}
+	}
+}
+
+// removeEntry drops the entry named by removal from m, clearing the list and
+// its wrapper once they are left empty
+func removeEntry(m protoreflect.Message, removal Removal) {
+	name, rest, nested := strings.Cut(removal.Path, ".")
+	fd := fieldByXMLName(m, name)
+	if fd == nil || fd.Kind() != protoreflect.MessageKind || !m.Has(fd) {
+		return
+	}
+
+	if nested {
+		if fd.IsList() {
+			return
+		}
+		wrapper := m.Mutable(fd).Message()
+		removeEntry(wrapper, Removal{Path: rest, Reference: removal.Reference})
+		if isEmpty(wrapper) {
+			m.Clear(fd)
+		}
+		return
+	}
+	if !fd.IsList() {
+		return
+	}
+
+	list := m.Mutable(fd).List()
+	kept := 0
+	for i := 0; i < list.Len(); i++ {
+		if referenceKey(list.Get(i).Message()) != removal.Reference {
+			list.Set(kept, list.Get(i))
+			kept++
+		}
+	}
+	list.Truncate(kept)
+	if kept == 0 {
+		m.Clear(fd)
+	}
+}
+
+// fieldByXMLName returns the field of m mapped to the XML element name, or
+// nil if it has none
+func fieldByXMLName(m protoreflect.Message, name string) protoreflect.FieldDescriptor {
+	names := xmlFields(m)
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); names[fd.Number()].Name == name && !names[fd.Number()].Attr {
+			return fd
+		}
+	}
+	return nil
+}
+
+// isEmpty reports whether m has no populated fields
+func isEmpty(m protoreflect.Message) bool {
+	empty := true
+	m.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		empty = false
+		return false
+	})
+	return empty
+}
+
+// referenceKey returns the value of the key field of m, e.g. the
+// ReleaseReference of a Release, or "" if it has none
+func referenceKey(m protoreflect.Message) string {
+	if fd := referenceField(m); fd != nil {
+		return m.Get(fd).String()
+	}
+	return ""
+}
+
+// referenceField returns the key field the schema declares for m: the
+// required anchor element of its type, such as the PartyReference of a Party
+// or the ResourceReference of a SoundRecording, or nil if it has none
+func referenceField(m protoreflect.Message) protoreflect.FieldDescriptor {
+	schema, ok := SchemaOf(m.Interface())
+	if !ok {
+		return nil
+	}
+	typ, ok := schema.Type(string(m.Descriptor().Name()))
+	if !ok {
+		return nil
+	}
+
+	for _, field := range typ.Fields {
+		if _, anchor := anchorKinds[field.Name]; !anchor || field.Kind != "element" || field.MinOccurs == 0 || field.Repeated() {
+			continue
+		}
+		if fd := fieldByXMLName(m, field.Name); fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() {
+			return fd
+		}
+	}
+	return nil
+}
+
+func listContains(list protoreflect.List, msg proto.Message) bool {
+	for i := 0; i < list.Len(); i++ {
+		if proto.Equal(list.Get(i).Message().Interface(), msg) {
+			return true
+		}
+	}
+	return false
+}
+
+func cloneValue(v protoreflect.Value) protoreflect.Value {
+	return protoreflect.ValueOfMessage(proto.Clone(v.Message().Interface()).ProtoReflect())
+}
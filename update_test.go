@@ -0,0 +1,234 @@
+package ddex
+
+import (
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestBuildUpdate validates that an update carries only the changed entries
+func TestBuildUpdate(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	var base ernv432.NewReleaseMessage
+	if err := xml.Unmarshal(xmlData, &base); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	t.Run("Title Change", func(t *testing.T) {
+		desired := proto.Clone(&base).(*ernv432.NewReleaseMessage)
+		desired.ReleaseList.Release.DisplayTitleText[0].Value = "New Title"
+
+		update, removed, err := BuildUpdate(&base, desired)
+		if err != nil {
+			t.Fatalf("BuildUpdate failed: %v", err)
+		}
+
+		if !proto.Equal(update.MessageHeader, desired.MessageHeader) {
+			t.Error("Update should carry the desired MessageHeader")
+		}
+		if update.PartyList != nil || update.ResourceList != nil || update.DealList != nil {
+			t.Error("Update should not carry unchanged lists")
+		}
+		if update.ReleaseList == nil || len(update.ReleaseList.TrackRelease) != 0 {
+			t.Fatal("Update should carry only the changed release")
+		}
+		if !proto.Equal(update.ReleaseList.Release, desired.ReleaseList.Release) {
+			t.Error("Update release differs from the desired one")
+		}
+		if len(removed) != 0 {
+			t.Errorf("Expected no removals, got %v", removed)
+		}
+	})
+
+	t.Run("Track Change", func(t *testing.T) {
+		desired := proto.Clone(&base).(*ernv432.NewReleaseMessage)
+		changedTrack := desired.ReleaseList.TrackRelease[1]
+		changedTrack.ReleaseResourceReference = "A99"
+
+		update, removed, err := BuildUpdate(&base, desired)
+		if err != nil {
+			t.Fatalf("BuildUpdate failed: %v", err)
+		}
+		if update.ReleaseList.Release != nil || len(update.ReleaseList.TrackRelease) != 1 {
+			t.Fatalf("Expected only 1 track release, got %+v", update.ReleaseList)
+		}
+		if !proto.Equal(update.ReleaseList.TrackRelease[0], changedTrack) {
+			t.Error("Update track release differs from the desired one")
+		}
+		if len(removed) != 0 {
+			t.Errorf("Expected no removals, got %v", removed)
+		}
+	})
+
+	t.Run("Removal", func(t *testing.T) {
+		desired := proto.Clone(&base).(*ernv432.NewReleaseMessage)
+		reference := desired.ResourceList.SoundRecording[1].ResourceReference
+		desired.ResourceList.SoundRecording = append(desired.ResourceList.SoundRecording[:1], desired.ResourceList.SoundRecording[2:]...)
+
+		update, removed, err := BuildUpdate(&base, desired)
+		if err != nil {
+			t.Fatalf("BuildUpdate failed: %v", err)
+		}
+		if update.ResourceList != nil {
+			t.Errorf("Expected no resources in the update, got %+v", update.ResourceList)
+		}
+		want := []Removal{{Path: "ResourceList.SoundRecording", Reference: reference}}
+		if !slices.Equal(removed, want) {
+			t.Errorf("Expected removals %v, got %v", want, removed)
+		}
+	})
+
+	t.Run("No Changes", func(t *testing.T) {
+		desired := proto.Clone(&base).(*ernv432.NewReleaseMessage)
+		if _, _, err := BuildUpdate(&base, desired); !errors.Is(err, ErrNoChanges) {
+			t.Errorf("Expected ErrNoChanges, got %v", err)
+		}
+	})
+}
+
+// TestMerge validates that an update is applied over its base by reference key
+func TestMerge(t *testing.T) {
+	xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+	xmlData, err := os.ReadFile(xmlPath)
+	if err != nil {
+		t.Skipf("Sample file not found: %s", xmlPath)
+	}
+
+	var base ernv432.NewReleaseMessage
+	if err := xml.Unmarshal(xmlData, &base); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	recordings := base.ResourceList.SoundRecording
+
+	t.Run("Added", func(t *testing.T) {
+		added := proto.Clone(recordings[0]).(*ernv432.SoundRecording)
+		added.ResourceReference = "A99"
+		update := &ernv432.NewReleaseMessage{
+			ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{added}},
+		}
+
+		merged := Merge(&base, update)
+		got := merged.ResourceList.SoundRecording
+		if len(got) != len(recordings)+1 || !proto.Equal(got[len(got)-1], added) {
+			t.Fatalf("Expected the new recording to be appended, got %d recordings", len(got))
+		}
+		if !proto.Equal(merged.ReleaseList, base.ReleaseList) || len(merged.ResourceList.Image) != len(base.ResourceList.Image) {
+			t.Error("Expected the rest of the base to be kept")
+		}
+	})
+
+	t.Run("Modified", func(t *testing.T) {
+		modified := proto.Clone(recordings[1]).(*ernv432.SoundRecording)
+		modified.DisplayTitleText[0].Value = "New Title"
+		update := &ernv432.NewReleaseMessage{
+			MessageHeader: &ernv432.MessageHeader{MessageId: "update-1"},
+			ResourceList:  &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{modified}},
+		}
+
+		merged := Merge(&base, update)
+		got := merged.ResourceList.SoundRecording
+		if len(got) != len(recordings) || !proto.Equal(got[1], modified) {
+			t.Fatalf("Expected recording A2 to be replaced in place, got %d recordings", len(got))
+		}
+		if merged.MessageHeader.MessageId != "update-1" {
+			t.Errorf("Expected the update's MessageHeader, got %s", merged.MessageHeader.MessageId)
+		}
+		if merged.LanguageAndScriptCode != base.LanguageAndScriptCode {
+			t.Error("Expected root attributes absent from the update to be kept")
+		}
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		merged := Merge(&base, nil, Removal{Path: "ResourceList.SoundRecording", Reference: "A2"})
+		got := merged.ResourceList.SoundRecording
+		if len(got) != len(recordings)-1 {
+			t.Fatalf("Expected %d recordings, got %d", len(recordings)-1, len(got))
+		}
+		for i, recording := range got {
+			if recording.ResourceReference == "A2" {
+				t.Errorf("Expected A2 to be removed, found at %d", i)
+			}
+		}
+		if got[1].ResourceReference != "A3" {
+			t.Errorf("Expected the order to be kept, got %s after A1", got[1].ResourceReference)
+		}
+
+		// An entry holding only its key is an ordinary replacement, not a removal
+		stub := &ernv432.SoundRecording{ResourceReference: "A2"}
+		update := &ernv432.NewReleaseMessage{ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{stub}}}
+		if got := Merge(&base, update).ResourceList.SoundRecording; len(got) != len(recordings) || !proto.Equal(got[1], stub) {
+			t.Errorf("Expected A2 to be replaced by the stub, got %d recordings", len(got))
+		}
+	})
+
+	t.Run("Build Update", func(t *testing.T) {
+		desired := proto.Clone(&base).(*ernv432.NewReleaseMessage)
+		desired.ReleaseList.Release.DisplayTitleText[0].Value = "New Title"
+		desired.ReleaseList.TrackRelease[1].ReleaseResourceReference = "A99"
+		added := proto.Clone(recordings[0]).(*ernv432.SoundRecording)
+		added.ResourceReference = "A99"
+		desired.ResourceList.SoundRecording = append(desired.ResourceList.SoundRecording[:1], desired.ResourceList.SoundRecording[2:]...)
+		desired.ResourceList.SoundRecording = append(desired.ResourceList.SoundRecording, added)
+
+		update, removed, err := BuildUpdate(&base, desired)
+		if err != nil {
+			t.Fatalf("BuildUpdate failed: %v", err)
+		}
+		if len(removed) != 1 {
+			t.Errorf("Expected the removal of A2, got %v", removed)
+		}
+		if merged := Merge(&base, update, removed...); !proto.Equal(merged, desired) {
+			t.Error("Expected merging a built update to give the desired message")
+		}
+	})
+
+	t.Run("Inputs Unchanged", func(t *testing.T) {
+		before := proto.Clone(&base)
+		update := &ernv432.NewReleaseMessage{
+			ResourceList: &ernv432.ResourceList{SoundRecording: []*ernv432.SoundRecording{{ResourceReference: "A1"}}},
+		}
+		Merge(&base, update, Removal{Path: "ResourceList.SoundRecording", Reference: "A2"})
+		if !proto.Equal(&base, before) {
+			t.Error("Merge modified its base")
+		}
+		if Merge(nil, nil) == nil {
+			t.Error("Expected an empty message for nil inputs")
+		}
+	})
+}
+
+// TestReferenceKey validates that list entries are keyed by the anchor their
+// schema type declares, not by any reference they hold
+func TestReferenceKey(t *testing.T) {
+	testCases := []struct {
+		name  string
+		entry proto.Message
+		want  string
+	}{
+		{"Party", &ernv432.Party{PartyReference: "P1"}, "P1"},
+		{"Brand", &ernv432.Brand{BrandReference: "P2"}, "P2"},
+		{"Sound Recording", &ernv432.SoundRecording{ResourceReference: "A1"}, "A1"},
+		{"Track Release", &ernv432.TrackRelease{ReleaseReference: "R1", ReleaseResourceReference: "A1"}, "R1"},
+		{"Display Artist", &ernv432.DisplayArtist{ArtistPartyReference: "P1"}, ""},
+		{"Release Deal", &ernv432.ReleaseDeal{DealReleaseReference: []string{"R1"}}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := referenceKey(tc.entry.ProtoReflect()); got != tc.want {
+				t.Errorf("referenceKey = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
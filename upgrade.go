@@ -0,0 +1,881 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Warning describes a value of the source message an upgrade could not
+// carry over
+type Warning struct {
+	Path    string // e.g. ResourceList.SoundRecording[0].IsArtistRelated
+	Message string
+}
+
+func (w Warning) String() string {
+	if w.Path == "" {
+		return w.Message
+	}
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// UpgradeERN383to432 converts an ERN 3.8.3 NewReleaseMessage to ERN 4.3.2.
+// Fields with an equivalent of the same name and shape are copied, and the
+// message is restructured where the schemas diverge:
+//
+//   - parties named inline as display artists, contributors and labels
+//     become PartyList entries, referenced by ArtistPartyReference,
+//     ContributorPartyReference and ReleaseLabelReference; identical parties
+//     share one entry
+//   - the DetailsByTerritory blocks of resources and releases are flattened
+//     onto the resource or release, their values tagged with the
+//     ApplicableTerritoryCode of a block for a single territory other than
+//     Worldwide
+//   - Titles become DisplayTitleText and DisplayTitle, FormalTitle or
+//     GroupingTitle by TitleType, falling back to the ReferenceTitle
+//   - the main release (IsMainRelease, or else the first release that is
+//     not a TrackRelease) becomes the Release, TrackReleases become
+//     TrackRelease entries and any other release is dropped
+//   - sound recording ids, PLines and technical details move to a
+//     SoundRecordingEdition, and deal Usages are folded into the DealTerms
+//
+// Every populated field of msg that is not carried over, including the
+// TerritoryCodes of blocks for several territories, is reported as a
+// Warning at its path in msg, so nothing is dropped silently. The versions
+// also draw on different AllowedValueSets; ValidateAVSConsistency on the
+// result reports values ERN 4.3.2 does not allow. msg is not modified.
+func UpgradeERN383to432(msg *ernv383.NewReleaseMessage) (*ernv432.NewReleaseMessage, []Warning, error) {
+	if msg == nil {
+		return nil, nil, errors.New("message is nil")
+	}
+	src := proto.Clone(msg).(*ernv383.NewReleaseMessage)
+	main := mainRelease(src.GetReleaseList().GetRelease())
+	if main < 0 {
+		return nil, nil, errors.New("message has no main release to upgrade")
+	}
+
+	u := &upgrader{}
+	out := ernv432.NewNewReleaseMessage()
+	out.AvsVersionId = AVSVersionLatest
+	out.LanguageAndScriptCode = takeValue(&src.LanguageAndScriptCode)
+	take(&src.MessageSchemaVersionId)
+	take(&src.XmlnsErn)
+	take(&src.XmlnsXsi)
+	take(&src.XsiSchemaLocation)
+	if src.GetUpdateIndicator() == "OriginalMessage" {
+		src.UpdateIndicator = nil // every ERN 4 NewReleaseMessage is an original
+	}
+
+	out.MessageHeader = u.header(src.MessageHeader)
+	if src.ResourceList != nil {
+		out.ResourceList = u.resourceList(src.ResourceList)
+	}
+	out.ReleaseList = u.releaseList(src.ReleaseList, main)
+	if src.DealList != nil {
+		out.DealList = dealList(src.DealList)
+	}
+	if len(u.parties) > 0 {
+		out.PartyList = &ernv432.PartyList{Party: u.parties}
+	}
+
+	warnings := u.warnings
+	_ = Walk(src, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() != protoreflect.MessageKind {
+			warnings = append(warnings, Warning{Path: path, Message: fmt.Sprintf("%v not carried over to ERN 4.3.2", v.Interface())})
+		}
+		return nil
+	})
+	return out, warnings, nil
+}
+
+// upgrader collects the parties and warnings of an upgrade
+type upgrader struct {
+	parties  []*ernv432.Party
+	warnings []Warning
+}
+
+// mainRelease returns the index of the release to upgrade to the ERN 4
+// Release, or -1 if there is none
+func mainRelease(releases []*ernv383.Release) int {
+	for i, r := range releases {
+		if r.GetIsMainRelease() {
+			return i
+		}
+	}
+	for i, r := range releases {
+		if !isTrackRelease(r) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isTrackRelease reports whether a release has the TrackRelease or
+// VideoTrackRelease type ERN 4 models as a TrackRelease
+func isTrackRelease(r *ernv383.Release) bool {
+	if slices.ContainsFunc(r.ReleaseType, isTrackReleaseType) {
+		return true
+	}
+	for _, d := range r.ReleaseDetailsByTerritory {
+		if slices.ContainsFunc(d.ReleaseType, isTrackReleaseType) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTrackReleaseType(t *ernv383.ReleaseType) bool {
+	return t.Value == "TrackRelease" || t.Value == "VideoTrackRelease"
+}
+
+func (u *upgrader) header(src *ernv383.MessageHeader) *ernv432.MessageHeader {
+	if src == nil {
+		return nil
+	}
+	dst := &ernv432.MessageHeader{
+		MessageSender:  messagingParty(src.MessageSender),
+		SentOnBehalfOf: messagingParty(src.SentOnBehalfOf),
+	}
+	for _, r := range src.MessageRecipient {
+		dst.MessageRecipient = append(dst.MessageRecipient, messagingParty(r))
+	}
+	carry(dst, src)
+	return dst
+}
+
+// messagingParty converts a header party, whose ERN 4 form has a single
+// PartyId and plain string names
+func messagingParty(src *ernv383.MessagingParty) *ernv432.MessagingPartyWithoutCode {
+	if src == nil {
+		return nil
+	}
+	dst := &ernv432.MessagingPartyWithoutCode{TradingName: optional(takeName(src.TradingName))}
+	if len(src.PartyId) > 0 {
+		dst.PartyId = take(&src.PartyId[0].Value)
+		take(&src.PartyId[0].IsDPID)
+	}
+	if n := src.PartyName; n != nil {
+		dst.PartyName = &ernv432.PartyNameWithoutCode{
+			FullName:                 takeName(n.FullName),
+			FullNameAsciiTranscribed: take(&n.FullNameAsciiTranscribed),
+			FullNameIndexed:          optional(takeName(n.FullNameIndexed)),
+			NamesBeforeKeyName:       optional(takeName(n.NamesBeforeKeyName)),
+			KeyName:                  optional(takeName(n.KeyName)),
+			NamesAfterKeyName:        optional(takeName(n.NamesAfterKeyName)),
+			AbbreviatedName:          optional(takeName(n.AbbreviatedName)),
+		}
+	}
+	return dst
+}
+
+// party returns the reference of the PartyList entry for a party named
+// inline in ERN 3, or empty if it has neither ids nor names. A PartyId
+// without a Namespace is a DPID.
+func (u *upgrader) party(ids []*ernv383.PartyId, names []*ernv383.PartyName) string {
+	p := &ernv432.Party{}
+	for _, id := range ids {
+		d := &ernv432.DetailedPartyId{}
+		switch value := take(&id.Value); {
+		case id.GetIsISNI():
+			take(&id.IsISNI)
+			d.ISNI = &value
+		case id.GetNamespace() != "":
+			d.ProprietaryId = []*ernv432.ProprietaryId{{Value: value, Namespace: takeValue(&id.Namespace)}}
+		default:
+			take(&id.IsDPID)
+			d.DPID = &value
+		}
+		p.PartyId = append(p.PartyId, d)
+	}
+	p.PartyName = carryAll(names, func() *ernv432.PartyNameWithTerritory { return &ernv432.PartyNameWithTerritory{} })
+	if len(p.PartyId) == 0 && len(p.PartyName) == 0 {
+		return ""
+	}
+	return u.addParty(p)
+}
+
+// addParty adds p to the PartyList under a new reference unless an
+// identical party is already listed, returning the reference
+func (u *upgrader) addParty(p *ernv432.Party) string {
+	for _, existing := range u.parties {
+		p.PartyReference = existing.PartyReference
+		if proto.Equal(p, existing) {
+			return existing.PartyReference
+		}
+	}
+	p.PartyReference = fmt.Sprintf("P%d", len(u.parties)+1)
+	u.parties = append(u.parties, p)
+	return p.PartyReference
+}
+
+func (u *upgrader) displayArtists(src []*ernv383.Artist) []*ernv432.DisplayArtist {
+	var dst []*ernv432.DisplayArtist
+	for _, a := range src {
+		d := &ernv432.DisplayArtist{
+			ArtistPartyReference: u.party(a.PartyId, a.PartyName),
+			SequenceNumber:       take(&a.SequenceNumber),
+		}
+		if len(a.ArtistRole) > 0 {
+			d.DisplayArtistRole = &ernv432.DisplayArtistRole{}
+			carry(d.DisplayArtistRole, a.ArtistRole[0])
+		}
+		dst = append(dst, d)
+	}
+	return dst
+}
+
+func (u *upgrader) contributors(src []*ernv383.DetailedResourceContributor) []*ernv432.Contributor {
+	var dst []*ernv432.Contributor
+	for _, c := range src {
+		d := &ernv432.Contributor{
+			ContributorPartyReference:     u.party(c.PartyId, c.PartyName),
+			HasMadeFeaturedContribution:   take(&c.IsFeaturedArtist),
+			HasMadeContractedContribution: take(&c.IsContractedArtist),
+			SequenceNumber:                take(&c.SequenceNumber),
+		}
+		for _, r := range c.ResourceContributorRole {
+			role := &ernv432.ContributorRoleValue{}
+			carry(role, r)
+			d.Role = append(d.Role, &ernv432.ContributorRole{Value: role})
+		}
+		dst = append(dst, d)
+	}
+	return dst
+}
+
+// labels turns LabelNames into references to label parties
+func (u *upgrader) labels(src []*ernv383.LabelName, territory *string) []*ernv432.ReleaseLabelReferenceWithParty {
+	var dst []*ernv432.ReleaseLabelReferenceWithParty
+	for _, l := range src {
+		name := &ernv432.Name{Value: take(&l.Value), LanguageAndScriptCode: take(&l.LanguageAndScriptCode)}
+		dst = append(dst, &ernv432.ReleaseLabelReferenceWithParty{
+			Value:                   u.addParty(&ernv432.Party{PartyName: []*ernv432.PartyNameWithTerritory{{FullName: name}}}),
+			LabelType:               take(&l.LabelNameType),
+			Namespace:               take(&l.Namespace),
+			UserDefinedValue:        take(&l.UserDefinedValue),
+			ApplicableTerritoryCode: territory,
+		})
+	}
+	return dst
+}
+
+// detailsTerritory returns the territory a DetailsByTerritory block applies to,
+// nil for Worldwide. The codes of blocks for several territories or with
+// exclusions are left in place and reported as not carried over.
+func detailsTerritory(codes []*ernv383.CurrentTerritoryCode, excluded []*ernv383.CurrentTerritoryCode) *string {
+	if len(codes) != 1 || len(excluded) > 0 || codes[0].GetIdentifierType() != "" {
+		return nil
+	}
+	if code := take(&codes[0].Value); code != "Worldwide" {
+		return &code
+	}
+	return nil
+}
+
+// titles gathers the ERN 4 forms of ERN 3 Titles
+type titles struct {
+	text                      []*ernv432.DisplayTitleText
+	display, formal, grouping []*ernv432.DisplayTitle
+}
+
+// add converts the display, formal and grouping titles of src, leaving other
+// title types to be reported
+func (t *titles) add(src []*ernv383.Title, territory *string) {
+	for _, title := range src {
+		var list *[]*ernv432.DisplayTitle
+		switch title.GetTitleType() {
+		case "", "DisplayTitle":
+			list = &t.display
+		case "FormalTitle":
+			list = &t.formal
+		case "GroupingTitle":
+			list = &t.grouping
+		}
+		if list == nil || title.TitleText == nil {
+			continue
+		}
+
+		take(&title.TitleType)
+		text, lsc := take(&title.TitleText.Value), first(take(&title.LanguageAndScriptCode), take(&title.TitleText.LanguageAndScriptCode))
+		*list = append(*list, &ernv432.DisplayTitle{
+			TitleText:               text,
+			SubTitle:                carryAll(title.SubTitle, func() *ernv432.DisplaySubTitle { return &ernv432.DisplaySubTitle{} }),
+			LanguageAndScriptCode:   lsc,
+			ApplicableTerritoryCode: territory,
+		})
+		if list == &t.display {
+			t.text = append(t.text, &ernv432.DisplayTitleText{Value: text, LanguageAndScriptCode: lsc, ApplicableTerritoryCode: territory})
+		}
+	}
+}
+
+// reference uses the ReferenceTitle as the display title when no Title gave
+// one, and otherwise drops it if it repeats the first display title
+func (t *titles) reference(ref *ernv383.ReferenceTitle) {
+	if ref.GetTitleText() == nil {
+		return
+	}
+	if len(t.display) > 0 {
+		if ref.TitleText.Value == t.display[0].TitleText {
+			take(&ref.TitleText.Value)
+		}
+		return
+	}
+
+	text, lsc := take(&ref.TitleText.Value), first(take(&ref.LanguageAndScriptCode), take(&ref.TitleText.LanguageAndScriptCode))
+	title := &ernv432.DisplayTitle{TitleText: text, LanguageAndScriptCode: lsc}
+	if s := ref.SubTitle; s != nil {
+		title.SubTitle = []*ernv432.DisplaySubTitle{{Value: take(&s.Value)}}
+	}
+	t.display = append(t.display, title)
+	t.text = append(t.text, &ernv432.DisplayTitleText{Value: text, LanguageAndScriptCode: lsc})
+}
+
+func genres(src []*ernv383.Genre, territory *string) []*ernv432.GenreWithTerritory {
+	var dst []*ernv432.GenreWithTerritory
+	for _, g := range src {
+		if g.GenreText == nil {
+			continue
+		}
+		d := &ernv432.GenreWithTerritory{
+			GenreText:               take(&g.GenreText.Value),
+			LanguageAndScriptCode:   take(&g.LanguageAndScriptCode),
+			ApplicableTerritoryCode: territory,
+		}
+		if g.SubGenre != nil {
+			d.SubGenre = optional(take(&g.SubGenre.Value))
+		}
+		dst = append(dst, d)
+	}
+	return dst
+}
+
+func parentalWarnings(src []*ernv383.ParentalWarningType, territory *string) []*ernv432.ParentalWarningTypeWithStandard {
+	var dst []*ernv432.ParentalWarningTypeWithStandard
+	for _, w := range src {
+		dst = append(dst, &ernv432.ParentalWarningTypeWithStandard{
+			Value:                   take(&w.Value),
+			TypeNamespace:           take(&w.Namespace),
+			TypeUserDefinedValue:    take(&w.UserDefinedValue),
+			ApplicableTerritoryCode: territory,
+		})
+	}
+	return dst
+}
+
+// appendDate appends a release date unless an identical one is listed
+func appendDate(dates []*ernv432.EventDateWithDefault, src *ernv383.EventDate, territory *string) []*ernv432.EventDateWithDefault {
+	if src == nil {
+		return dates
+	}
+	d := &ernv432.EventDateWithDefault{ApplicableTerritoryCode: territory}
+	carry(d, src)
+	if slices.ContainsFunc(dates, func(e *ernv432.EventDateWithDefault) bool { return proto.Equal(d, e) }) {
+		return dates
+	}
+	return append(dates, d)
+}
+
+func (u *upgrader) resourceList(src *ernv383.ResourceList) *ernv432.ResourceList {
+	dst := &ernv432.ResourceList{}
+	for _, sr := range src.SoundRecording {
+		dst.SoundRecording = append(dst.SoundRecording, u.soundRecording(sr))
+	}
+	for _, image := range src.Image {
+		dst.Image = append(dst.Image, u.image(image))
+	}
+
+	// the other resource types differ too much between the versions to map
+	m := src.ProtoReflect()
+	mapping := xmlFields(m)
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !fd.IsList() || fd.Name() == "sound_recording" || fd.Name() == "image" {
+			continue
+		}
+		name := mapping[fd.Number()].Name
+		for j := 0; j < m.Get(fd).List().Len(); j++ {
+			u.warnings = append(u.warnings, Warning{
+				Path:    fmt.Sprintf("ResourceList.%s[%d]", name, j),
+				Message: name + " resources are not upgraded",
+			})
+		}
+		m.Clear(fd)
+	}
+	return dst
+}
+
+func (u *upgrader) soundRecording(src *ernv383.SoundRecording) *ernv432.SoundRecording {
+	dst := &ernv432.SoundRecording{ContainsHiddenContent: take(&src.IsHiddenResource)}
+	if t := src.SoundRecordingType; t != nil {
+		dst.Type = &ernv432.SoundRecordingType{}
+		carry(dst.Type, t)
+	}
+	edition := &ernv432.SoundRecordingEdition{
+		ResourceId: carryAll(src.SoundRecordingId, func() *ernv432.SoundRecordingId { return &ernv432.SoundRecordingId{} }),
+	}
+
+	var t titles
+	for _, d := range src.SoundRecordingDetailsByTerritory {
+		territory := detailsTerritory(d.TerritoryCode, d.ExcludedTerritoryCode)
+		t.add(d.Title, territory)
+		dst.DisplayArtistName = append(dst.DisplayArtistName, carryAll(d.DisplayArtistName, func() *ernv432.DisplayArtistNameWithOriginalLanguage {
+			return &ernv432.DisplayArtistNameWithOriginalLanguage{ApplicableTerritoryCode: territory}
+		})...)
+		dst.DisplayArtist = append(dst.DisplayArtist, u.displayArtists(d.DisplayArtist)...)
+		dst.Contributor = append(dst.Contributor, u.contributors(d.ResourceContributor)...)
+		dst.ParentalWarningType = append(dst.ParentalWarningType, parentalWarnings(d.ParentalWarningType, territory)...)
+		edition.PLine = append(edition.PLine, carryAll(d.PLine, func() *ernv432.PLine {
+			return &ernv432.PLine{ApplicableTerritoryCode: territory}
+		})...)
+		for _, details := range d.TechnicalSoundRecordingDetails {
+			edition.TechnicalDetails = append(edition.TechnicalDetails, technicalSoundRecording(details, territory))
+		}
+	}
+	t.reference(src.ReferenceTitle)
+	dst.DisplayTitleText, dst.DisplayTitle, dst.FormalTitle, dst.GroupingTitle = t.text, t.display, t.formal, t.grouping
+
+	if populated(edition.ProtoReflect()) {
+		dst.SoundRecordingEdition = []*ernv432.SoundRecordingEdition{edition}
+	}
+	carry(dst, src)
+	return dst
+}
+
+// technicalSoundRecording moves the file details of an ERN 3 technical
+// details block to the AudioDeliveryFile ERN 4 nests them in
+func technicalSoundRecording(src *ernv383.TechnicalSoundRecordingDetails, territory *string) *ernv432.TechnicalSoundRecordingDetails {
+	file := &ernv432.AudioDeliveryFile{Type: "AudioFile", File: deliveryFile(src.File)}
+	if n := take(&src.NumberOfChannels); n != nil {
+		file.NumberOfChannels = optional(strconv.Itoa(int(*n)))
+	}
+	carry(file, src)
+
+	dst := &ernv432.TechnicalSoundRecordingDetails{
+		DeliveryFile:            []*ernv432.AudioDeliveryFile{file},
+		ApplicableTerritoryCode: territory,
+	}
+	carry(dst, src)
+	return dst
+}
+
+// deliveryFile turns the first File, given as a URL or a FilePath and
+// FileName, into the URI of an ERN 4 File
+func deliveryFile(files []*ernv383.File) *ernv432.File {
+	if len(files) == 0 {
+		return nil
+	}
+	f := files[0]
+	uri := take(&f.URL)
+	if uri == "" && f.FileName != "" {
+		uri = f.GetFilePath() + take(&f.FileName)
+		f.FilePath = nil
+	}
+	if uri == "" {
+		return nil
+	}
+	return &ernv432.File{URI: uri}
+}
+
+func (u *upgrader) image(src *ernv383.Image) *ernv432.Image {
+	dst := &ernv432.Image{
+		ResourceId: carryAll(src.ImageId, func() *ernv432.ResourceProprietaryId { return &ernv432.ResourceProprietaryId{} }),
+	}
+	if t := src.ImageType; t != nil {
+		dst.Type = &ernv432.ImageType{}
+		carry(dst.Type, t)
+	}
+
+	var t titles
+	t.add(src.Title, nil)
+	for _, d := range src.ImageDetailsByTerritory {
+		territory := detailsTerritory(d.TerritoryCode, d.ExcludedTerritoryCode)
+		t.add(d.Title, territory)
+		dst.DisplayArtistName = append(dst.DisplayArtistName, carryAll(d.DisplayArtistName, func() *ernv432.DisplayArtistNameWithOriginalLanguage {
+			return &ernv432.DisplayArtistNameWithOriginalLanguage{ApplicableTerritoryCode: territory}
+		})...)
+		dst.Contributor = append(dst.Contributor, u.contributors(d.ResourceContributor)...)
+		dst.CLine = append(dst.CLine, carryAll(d.CLine, func() *ernv432.CLine {
+			return &ernv432.CLine{ApplicableTerritoryCode: territory}
+		})...)
+		if desc := d.Description; desc != nil {
+			dst.Description = append(dst.Description, carryAll([]*ernv383.Description{desc}, func() *ernv432.DescriptionWithTerritory {
+				return &ernv432.DescriptionWithTerritory{ApplicableTerritoryCode: territory}
+			})...)
+		}
+		dst.ParentalWarningType = append(dst.ParentalWarningType, parentalWarnings(d.ParentalWarningType, territory)...)
+		for _, details := range d.TechnicalImageDetails {
+			td := &ernv432.TechnicalImageDetails{File: deliveryFile(details.File), ApplicableTerritoryCode: territory}
+			carry(td, details)
+			dst.TechnicalDetails = append(dst.TechnicalDetails, td)
+		}
+	}
+	dst.DisplayTitleText, dst.DisplayTitle, dst.FormalTitle, dst.GroupingTitle = t.text, t.display, t.formal, t.grouping
+
+	carry(dst, src)
+	return dst
+}
+
+// releaseList upgrades the main release and the track releases, dropping
+// any other release since ERN 4 carries one Release per message
+func (u *upgrader) releaseList(src *ernv383.ReleaseList, main int) *ernv432.ReleaseList {
+	dst := &ernv432.ReleaseList{}
+	for i, r := range src.Release {
+		switch {
+		case i == main:
+			dst.Release = u.release(r)
+		case isTrackRelease(r):
+			dst.TrackRelease = append(dst.TrackRelease, u.trackRelease(r))
+		default:
+			u.warnings = append(u.warnings, Warning{
+				Path:    fmt.Sprintf("ReleaseList.Release[%d]", i),
+				Message: "ERN 4.3.2 carries a single main release per message",
+			})
+			src.Release[i] = &ernv383.Release{}
+		}
+	}
+	return dst
+}
+
+func (u *upgrader) release(src *ernv383.Release) *ernv432.Release {
+	dst := &ernv432.Release{ReleaseReference: takeFirst(&src.ReleaseReference)}
+	if len(src.ReleaseId) > 0 {
+		dst.ReleaseId = releaseId(src.ReleaseId[0])
+	}
+	take(&src.IsMainRelease)
+
+	var t titles
+	var groups []*ernv383.ResourceGroup
+	for _, d := range src.ReleaseDetailsByTerritory {
+		territory := detailsTerritory(d.TerritoryCode, d.ExcludedTerritoryCode)
+		t.add(d.Title, territory)
+		dst.DisplayArtistName = append(dst.DisplayArtistName, carryAll(d.DisplayArtistName, func() *ernv432.DisplayArtistNameWithOriginalLanguage {
+			return &ernv432.DisplayArtistNameWithOriginalLanguage{ApplicableTerritoryCode: territory}
+		})...)
+		dst.DisplayArtist = append(dst.DisplayArtist, u.displayArtists(d.DisplayArtist)...)
+		dst.ReleaseLabelReference = append(dst.ReleaseLabelReference, u.labels(d.LabelName, territory)...)
+		if v := take(&d.IsMultiArtistCompilation); v != nil {
+			dst.IsMultiArtistCompilation = *v
+		}
+		dst.ReleaseType = append(dst.ReleaseType, carryAll(d.ReleaseType, func() *ernv432.ReleaseTypeForReleaseNotification {
+			return &ernv432.ReleaseTypeForReleaseNotification{}
+		})...)
+		dst.ParentalWarningType = append(dst.ParentalWarningType, parentalWarnings(d.ParentalWarningType, territory)...)
+		dst.DisplayGenre = append(dst.DisplayGenre, genres(d.Genre, territory)...)
+		dst.PLine = append(dst.PLine, carryAll(d.PLine, func() *ernv432.PLine {
+			return &ernv432.PLine{ApplicableTerritoryCode: territory}
+		})...)
+		dst.CLine = append(dst.CLine, carryAll(d.CLine, func() *ernv432.CLine {
+			return &ernv432.CLine{ApplicableTerritoryCode: territory}
+		})...)
+		dst.ReleaseDate = appendDate(dst.ReleaseDate, d.ReleaseDate, territory)
+		dst.OriginalReleaseDate = appendDate(dst.OriginalReleaseDate, d.OriginalReleaseDate, territory)
+		u.descriptive(&dst.Keywords, &dst.Synopsis, &dst.MarketingComment, d.Keywords, d.Synopsis, d.MarketingComment, territory)
+		if groups == nil {
+			groups = d.ResourceGroup
+		}
+	}
+	dst.ReleaseDate = appendDate(dst.ReleaseDate, src.GlobalReleaseDate, nil)
+	dst.OriginalReleaseDate = appendDate(dst.OriginalReleaseDate, src.GlobalOriginalReleaseDate, nil)
+	t.reference(src.ReferenceTitle)
+	dst.DisplayTitleText, dst.DisplayTitle, dst.FormalTitle, dst.GroupingTitle = t.text, t.display, t.formal, t.grouping
+
+	// ERN 4 lists the resources of a release only in its ResourceGroup
+	refs := src.GetReleaseResourceReferenceList().GetReleaseResourceReference()
+	switch {
+	case len(groups) == 1:
+		dst.ResourceGroup = &ernv432.ResourceGroup{}
+		carry(dst.ResourceGroup, u.resourceGroup(groups[0]))
+	case len(groups) > 1:
+		dst.ResourceGroup = &ernv432.ResourceGroup{}
+		for _, g := range groups {
+			dst.ResourceGroup.ResourceGroup = append(dst.ResourceGroup.ResourceGroup, u.resourceGroup(g))
+		}
+	case len(refs) > 0:
+		dst.ResourceGroup = &ernv432.ResourceGroup{}
+		for i, r := range refs {
+			dst.ResourceGroup.ResourceGroupContentItem = append(dst.ResourceGroup.ResourceGroupContentItem, &ernv432.ResourceGroupContentItem{
+				SequenceNumber:           proto.Int32(int32(i + 1)),
+				ReleaseResourceReference: r.Value,
+			})
+		}
+	}
+	for _, r := range refs {
+		take(&r.Value)
+		take(&r.ReleaseResourceType)
+	}
+
+	carry(dst, src)
+	return dst
+}
+
+func (u *upgrader) trackRelease(src *ernv383.Release) *ernv432.TrackRelease {
+	dst := &ernv432.TrackRelease{ReleaseReference: takeFirst(&src.ReleaseReference)}
+	if len(src.ReleaseId) > 0 {
+		dst.ReleaseId = releaseId(src.ReleaseId[0])
+	}
+	if refs := src.GetReleaseResourceReferenceList().GetReleaseResourceReference(); len(refs) > 0 {
+		dst.ReleaseResourceReference = take(&refs[0].Value)
+		take(&refs[0].ReleaseResourceType)
+	}
+	src.ReleaseType = slices.DeleteFunc(src.ReleaseType, isTrackReleaseType)
+
+	var t titles
+	for _, d := range src.ReleaseDetailsByTerritory {
+		territory := detailsTerritory(d.TerritoryCode, d.ExcludedTerritoryCode)
+		d.ReleaseType = slices.DeleteFunc(d.ReleaseType, isTrackReleaseType)
+		t.add(d.Title, territory)
+		dst.ReleaseLabelReference = append(dst.ReleaseLabelReference, u.labels(d.LabelName, territory)...)
+		dst.DisplayGenre = append(dst.DisplayGenre, genres(d.Genre, territory)...)
+		u.descriptive(&dst.Keywords, &dst.Synopsis, &dst.MarketingComment, d.Keywords, d.Synopsis, d.MarketingComment, territory)
+	}
+	t.reference(src.ReferenceTitle)
+	dst.DisplayTitleText, dst.DisplayTitle, dst.FormalTitle, dst.GroupingTitle = t.text, t.display, t.formal, t.grouping
+
+	carry(dst, src)
+	return dst
+}
+
+// descriptive converts the keywords, synopsis and marketing comment of a
+// release details block
+func (u *upgrader) descriptive(keywords *[]*ernv432.KeywordsWithTerritory, synopses *[]*ernv432.SynopsisWithTerritory, comments *[]*ernv432.MarketingComment,
+	srcKeywords []*ernv383.Keywords, synopsis *ernv383.Synopsis, comment *ernv383.Comment, territory *string) {
+	*keywords = append(*keywords, carryAll(srcKeywords, func() *ernv432.KeywordsWithTerritory {
+		return &ernv432.KeywordsWithTerritory{ApplicableTerritoryCode: territory}
+	})...)
+	if synopsis != nil {
+		*synopses = append(*synopses, carryAll([]*ernv383.Synopsis{synopsis}, func() *ernv432.SynopsisWithTerritory {
+			return &ernv432.SynopsisWithTerritory{ApplicableTerritoryCode: territory}
+		})...)
+	}
+	if comment != nil {
+		*comments = append(*comments, carryAll([]*ernv383.Comment{comment}, func() *ernv432.MarketingComment {
+			return &ernv432.MarketingComment{ApplicableTerritoryCode: territory}
+		})...)
+	}
+}
+
+// releaseId converts a release id, whose ICPN is a plain string in ERN 4
+func releaseId(src *ernv383.ReleaseId) *ernv432.ReleaseId {
+	dst := &ernv432.ReleaseId{}
+	if icpn := src.ICPN; icpn != nil {
+		dst.ICPN = optional(take(&icpn.Value))
+		take(&icpn.IsEan)
+	}
+	carry(dst, src)
+	return dst
+}
+
+func (u *upgrader) resourceGroup(src *ernv383.ResourceGroup) *ernv432.ResourceSubGroup {
+	dst := &ernv432.ResourceSubGroup{DisplayArtist: u.displayArtists(src.DisplayArtist)}
+	var t titles
+	t.add(src.Title, nil)
+	dst.DisplayTitleText, dst.DisplayTitle, dst.FormalTitle, dst.GroupingTitle = t.text, t.display, t.formal, t.grouping
+
+	for _, g := range src.ResourceGroup {
+		dst.ResourceGroup = append(dst.ResourceGroup, u.resourceGroup(g))
+	}
+	for _, item := range src.ResourceGroupContentItem {
+		d := &ernv432.ResourceGroupContentItem{}
+		if r := item.ReleaseResourceReference; r != nil {
+			d.ReleaseResourceReference = take(&r.Value)
+			take(&r.ReleaseResourceType)
+		}
+		item.ResourceType = nil // implied by the referenced resource in ERN 4
+		carry(d, item)
+		dst.ResourceGroupContentItem = append(dst.ResourceGroupContentItem, d)
+	}
+
+	carry(dst, src)
+	return dst
+}
+
+func dealList(src *ernv383.DealList) *ernv432.DealList {
+	dst := &ernv432.DealList{}
+	for _, rd := range src.ReleaseDeal {
+		d := &ernv432.ReleaseDeal{}
+		for _, deal := range rd.Deal {
+			d.Deal = append(d.Deal, upgradeDeal(deal))
+		}
+		carry(d, rd)
+		dst.ReleaseDeal = append(dst.ReleaseDeal, d)
+	}
+	carry(dst, src)
+	return dst
+}
+
+// upgradeDeal converts a deal, folding its Usages into the DealTerms
+func upgradeDeal(src *ernv383.Deal) *ernv432.Deal {
+	dst := &ernv432.Deal{}
+	for _, r := range src.DealReference {
+		dst.DealReference = append(dst.DealReference, take(&r.Value))
+	}
+	if terms := src.DealTerms; terms != nil {
+		dst.DealTerms = &ernv432.DealTerms{}
+		for _, usage := range terms.Usage {
+			carry(dst.DealTerms, usage)
+		}
+		for _, p := range terms.PriceInformation {
+			price := &ernv432.PriceInformation{PriceType: take(&p.PriceTypeAttr)}
+			if code := p.PriceType; code != nil {
+				price.PriceCode = &ernv432.PriceType{}
+				carry(price.PriceCode, code)
+			}
+			carry(price, p)
+			dst.DealTerms.PriceInformation = append(dst.DealTerms.PriceInformation, price)
+		}
+		carry(dst.DealTerms, terms)
+	}
+	carry(dst, src)
+	return dst
+}
+
+// carry moves every field of src that dst has under the same name and kind
+// into dst, recursing into messages, and clears it from src so whatever is
+// left can be reported. Scalars already set in dst are kept, lists are
+// appended to and messages merged.
+func carry(dst, src proto.Message) {
+	carryFields(dst.ProtoReflect(), src.ProtoReflect())
+}
+
+func carryFields(dst, src protoreflect.Message) {
+	fields := src.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		sfd := fields.Get(i)
+		dfd := dst.Descriptor().Fields().ByName(sfd.Name())
+		if dfd == nil || !src.Has(sfd) || dfd.Kind() != sfd.Kind() || sfd.IsList() && !dfd.IsList() {
+			continue
+		}
+
+		if sfd.Kind() != protoreflect.MessageKind {
+			switch {
+			case sfd.IsList():
+				list, out := src.Get(sfd).List(), dst.Mutable(dfd).List()
+				for j := 0; j < list.Len(); j++ {
+					out.Append(list.Get(j))
+				}
+			case dfd.IsList():
+				dst.Mutable(dfd).List().Append(src.Get(sfd))
+			case dst.Has(dfd):
+				continue
+			default:
+				dst.Set(dfd, src.Get(sfd))
+			}
+			src.Clear(sfd)
+			continue
+		}
+
+		var values []protoreflect.Message
+		if sfd.IsList() {
+			list := src.Get(sfd).List()
+			for j := 0; j < list.Len(); j++ {
+				values = append(values, list.Get(j).Message())
+			}
+		} else {
+			values = append(values, src.Mutable(sfd).Message())
+		}
+
+		if dfd.IsList() {
+			out := dst.Mutable(dfd).List()
+			for _, v := range values {
+				e := out.NewElement()
+				carryFields(e.Message(), v)
+				if populated(e.Message()) {
+					out.Append(e)
+				}
+			}
+			continue
+		}
+		had := dst.Has(dfd)
+		m := dst.Mutable(dfd).Message()
+		carryFields(m, values[0])
+		if !had && !populated(m) {
+			dst.Clear(dfd)
+		}
+	}
+}
+
+// carryAll carries each of src into a new element made by newElem,
+// skipping those nothing could be carried from
+func carryAll[D, S proto.Message](src []S, newElem func() D) []D {
+	var dst []D
+	for _, s := range src {
+		d := newElem()
+		carry(d, s)
+		if populated(d.ProtoReflect()) {
+			dst = append(dst, d)
+		}
+	}
+	return dst
+}
+
+// populated reports whether any field of m is set
+func populated(m protoreflect.Message) bool {
+	found := false
+	m.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// take returns *p and resets it to its zero value, marking a source field
+// as carried over
+func take[T any](p *T) T {
+	v := *p
+	var zero T
+	*p = zero
+	return v
+}
+
+// takeValue returns the value of an optional field, or the zero value if it
+// is unset, and clears it
+func takeValue[T any](p **T) T {
+	var v T
+	if *p != nil {
+		v = **p
+	}
+	*p = nil
+	return v
+}
+
+// takeFirst removes and returns the first entry of a list of scalars
+func takeFirst[T any](list *[]T) T {
+	var v T
+	if len(*list) > 0 {
+		v, *list = (*list)[0], (*list)[1:]
+	}
+	return v
+}
+
+func takeName(n *ernv383.Name) string {
+	if n == nil {
+		return ""
+	}
+	return take(&n.Value)
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// first returns the first of values that is set and not empty
+func first(values ...*string) *string {
+	for _, v := range values {
+		if v != nil && *v != "" {
+			return v
+		}
+	}
+	return nil
+}
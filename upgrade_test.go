@@ -0,0 +1,215 @@
+package ddex
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestUpgradeERN383to432 validates the conversion of ERN 3.8.3 release
+// messages to ERN 4.3.2 and the warnings for what can't be carried over
+func TestUpgradeERN383to432(t *testing.T) {
+	t.Run("Sample", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv383", "new_release_example.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		parsed, _, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		msg := parsed.(*ernv383.NewReleaseMessage)
+		original := proto.Clone(msg)
+
+		out, warnings, err := UpgradeERN383to432(msg)
+		if err != nil {
+			t.Fatalf("Upgrade failed: %v", err)
+		}
+		if !proto.Equal(msg, original) {
+			t.Error("Upgrade modified the source message")
+		}
+		if err := CheckReferences(out); err != nil {
+			t.Errorf("Upgraded message has broken references: %v", err)
+		}
+
+		if got := out.GetMessageHeader().GetMessageSender().GetPartyId(); got != "PADPIDA2007050901U" {
+			t.Errorf("MessageSender PartyId = %q", got)
+		}
+		if out.AvsVersionId != AVSVersionLatest || out.LanguageAndScriptCode != "en" {
+			t.Errorf("Root attributes = %q, %q", out.AvsVersionId, out.LanguageAndScriptCode)
+		}
+
+		parties := make(map[string]string)
+		for _, p := range out.GetPartyList().GetParty() {
+			parties[p.GetPartyName()[0].GetFullName().GetValue()] = p.PartyReference
+		}
+		if len(parties) != 4 {
+			t.Errorf("Expected 4 parties, got %v", parties)
+		}
+
+		recording := out.GetResourceList().GetSoundRecording()[0]
+		edition := recording.GetSoundRecordingEdition()[0]
+		if got := edition.GetResourceId()[0].GetISRC(); got != "USWB12400001" {
+			t.Errorf("ISRC = %q", got)
+		}
+		if got := edition.GetTechnicalDetails()[0].GetDeliveryFile()[0].GetFile().GetURI(); got != "resources/A1.flac" {
+			t.Errorf("File URI = %q", got)
+		}
+		if got := recording.GetDisplayArtist()[0].GetArtistPartyReference(); got != parties["The Example Band"] {
+			t.Errorf("ArtistPartyReference = %q", got)
+		}
+		if got := recording.GetContributor()[0]; got.GetContributorPartyReference() != parties["Jane Producer"] || got.GetRole()[0].GetValue().GetValue() != "Producer" {
+			t.Errorf("Contributor = %v", got)
+		}
+
+		release := out.GetReleaseList().GetRelease()
+		if release.GetReleaseReference() != "R0" || release.GetReleaseId().GetICPN() != "0123456789012" {
+			t.Errorf("Release = %s, ICPN %q", release.GetReleaseReference(), release.GetReleaseId().GetICPN())
+		}
+		if got := release.GetDisplayTitleText()[0].GetValue(); got != "Example Album" {
+			t.Errorf("DisplayTitleText = %q", got)
+		}
+		if got := release.GetReleaseLabelReference()[0].GetValue(); got != parties["Example Records"] {
+			t.Errorf("ReleaseLabelReference = %q", got)
+		}
+		if got := release.GetOriginalReleaseDate(); len(got) != 1 || got[0].GetValue() != "2024-03-15" {
+			t.Errorf("OriginalReleaseDate = %v, want one 2024-03-15", got)
+		}
+		var items []string
+		for _, item := range release.GetResourceGroup().GetResourceGroupContentItem() {
+			items = append(items, item.ReleaseResourceReference)
+		}
+		if !slices.Equal(items, []string{"A1", "A2"}) {
+			t.Errorf("ResourceGroup = %v", items)
+		}
+
+		tracks := out.GetReleaseList().GetTrackRelease()
+		if len(tracks) != 2 || tracks[0].GetReleaseResourceReference() != "A1" || tracks[1].GetReleaseId().GetGRid() != "A10302B0001234569Z" {
+			t.Errorf("TrackRelease = %v", tracks)
+		}
+
+		deals := out.GetDealList().GetReleaseDeal()[0].GetDeal()
+		if len(deals) != 2 || deals[0].GetDealTerms().GetUseType()[0].GetValue() != "OnDemandStream" {
+			t.Fatalf("Deals = %v", deals)
+		}
+		if got := deals[1].GetDealTerms(); len(got.GetTerritoryCode()) != 2 || got.GetPriceInformation()[0].GetPriceCode().GetValue() != "Mid" {
+			t.Errorf("DealTerms = %v", got)
+		}
+
+		var paths []string
+		for _, w := range warnings {
+			paths = append(paths, w.Path)
+		}
+		want := []string{
+			"ResourceList.SoundRecording[0].SoundRecordingDetailsByTerritory[0].LabelName[0]",
+			"ResourceList.SoundRecording[0].SoundRecordingDetailsByTerritory[0].Genre[0].GenreText",
+			"ResourceList.SoundRecording[1].IsArtistRelated",
+			"ResourceList.SoundRecording[1].SoundRecordingDetailsByTerritory[0].LabelName[0]",
+			"ResourceList.SoundRecording[1].SoundRecordingDetailsByTerritory[0].Genre[0].GenreText",
+			"ReleaseList.Release[1].ReleaseId[0].ISRC",
+			"ReleaseList.Release[1].ReleaseDetailsByTerritory[0].DisplayArtistName[0]",
+			"ReleaseList.Release[2].ReleaseId[0].ISRC",
+			"ReleaseList.Release[2].ReleaseDetailsByTerritory[0].DisplayArtistName[0]",
+		}
+		if !slices.Equal(paths, want) {
+			t.Errorf("Warnings = %v\nwant paths %v", warnings, want)
+		}
+
+		data, err := Marshal(out, MarshalOptions{})
+		if err != nil {
+			t.Fatalf("Marshal failed: %v", err)
+		}
+		reparsed, err := ParseDDEX(data)
+		if err != nil {
+			t.Fatalf("Failed to parse upgraded message: %v", err)
+		}
+		if !proto.Equal(reparsed, out) {
+			t.Error("Upgraded message does not survive a round trip")
+		}
+	})
+
+	t.Run("Territories", func(t *testing.T) {
+		details := func(title string, codes ...string) *ernv383.ReleaseDetailsByTerritory {
+			d := &ernv383.ReleaseDetailsByTerritory{Title: []*ernv383.Title{{TitleText: &ernv383.TitleText{Value: title}}}}
+			for _, code := range codes {
+				d.TerritoryCode = append(d.TerritoryCode, &ernv383.CurrentTerritoryCode{Value: code})
+			}
+			return d
+		}
+		msg := &ernv383.NewReleaseMessage{ReleaseList: &ernv383.ReleaseList{Release: []*ernv383.Release{{
+			ReleaseReference: []string{"R0"},
+			ReleaseDetailsByTerritory: []*ernv383.ReleaseDetailsByTerritory{
+				details("Worldwide Title", "Worldwide"),
+				details("German Title", "DE"),
+				details("North American Title", "US", "CA"),
+			},
+		}}}}
+
+		out, warnings, err := UpgradeERN383to432(msg)
+		if err != nil {
+			t.Fatalf("Upgrade failed: %v", err)
+		}
+		var got []string
+		for _, title := range out.GetReleaseList().GetRelease().GetDisplayTitleText() {
+			got = append(got, title.GetApplicableTerritoryCode()+":"+title.Value)
+		}
+		if want := []string{":Worldwide Title", "DE:German Title", ":North American Title"}; !slices.Equal(got, want) {
+			t.Errorf("DisplayTitleText = %v, want %v", got, want)
+		}
+		want := []Warning{
+			{Path: "ReleaseList.Release[0].ReleaseDetailsByTerritory[2].TerritoryCode[0]", Message: "US not carried over to ERN 4.3.2"},
+			{Path: "ReleaseList.Release[0].ReleaseDetailsByTerritory[2].TerritoryCode[1]", Message: "CA not carried over to ERN 4.3.2"},
+		}
+		if !slices.Equal(warnings, want) {
+			t.Errorf("Warnings = %v, want %v", warnings, want)
+		}
+	})
+
+	t.Run("Dropped Content", func(t *testing.T) {
+		msg := &ernv383.NewReleaseMessage{
+			UpdateIndicator: proto.String("UpdateMessage"),
+			ResourceList:    &ernv383.ResourceList{Video: []*ernv383.Video{{ResourceReference: "A1"}}},
+			ReleaseList: &ernv383.ReleaseList{Release: []*ernv383.Release{
+				{ReleaseReference: []string{"R0"}, ReleaseType: []*ernv383.ReleaseType{{Value: "TrackRelease"}}},
+				{ReleaseReference: []string{"R1"}, ReleaseType: []*ernv383.ReleaseType{{Value: "Album"}}},
+				{ReleaseReference: []string{"R2"}, ReleaseType: []*ernv383.ReleaseType{{Value: "Single"}}},
+			}},
+		}
+
+		out, warnings, err := UpgradeERN383to432(msg)
+		if err != nil {
+			t.Fatalf("Upgrade failed: %v", err)
+		}
+		if got := out.GetReleaseList().GetRelease().GetReleaseReference(); got != "R1" {
+			t.Errorf("Main release = %q, want R1", got)
+		}
+		if got := out.GetReleaseList().GetTrackRelease(); len(got) != 1 || got[0].ReleaseReference != "R0" {
+			t.Errorf("TrackRelease = %v", got)
+		}
+		want := []Warning{
+			{Path: "ResourceList.Video[0]", Message: "Video resources are not upgraded"},
+			{Path: "ReleaseList.Release[2]", Message: "ERN 4.3.2 carries a single main release per message"},
+			{Path: "UpdateIndicator", Message: "UpdateMessage not carried over to ERN 4.3.2"},
+		}
+		if !slices.Equal(warnings, want) {
+			t.Errorf("Warnings = %v, want %v", warnings, want)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		if _, _, err := UpgradeERN383to432(nil); err == nil {
+			t.Error("Expected error for a nil message")
+		}
+		msg := &ernv383.NewReleaseMessage{ReleaseList: &ernv383.ReleaseList{Release: []*ernv383.Release{
+			{ReleaseType: []*ernv383.ReleaseType{{Value: "TrackRelease"}}},
+		}}}
+		if _, _, err := UpgradeERN383to432(msg); err == nil {
+			t.Error("Expected error for a message without a main release")
+		}
+	})
+}
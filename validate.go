@@ -0,0 +1,163 @@
+package ddex
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValidationError describes a single problem found by Validate
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// identifierPatterns holds format checks for well-known identifiers, keyed by XML element name
+var identifierPatterns = map[string]*regexp.Regexp{
+	"ISRC": regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$`),
+	"GRid": regexp.MustCompile(`^A1[A-Z0-9]{16}$`),
+	"ICPN": regexp.MustCompile(`^[0-9]{12,14}$`),
+	"ISNI": regexp.MustCompile(`^[0-9]{15}[0-9X]$`),
+	"ISWC": regexp.MustCompile(`^T[0-9]{10}$`),
+	"DPID": regexp.MustCompile(`^PADPIDA[0-9A-Z]+$`),
+}
+
+// Validate checks a parsed message for missing required elements and
+// attributes, as declared by the minOccurs and use of its XSD, and for
+// malformed identifiers, returning every problem found
+func Validate(msg proto.Message) []error {
+	if msg == nil {
+		return []error{&ValidationError{Path: "", Message: "message is nil"}}
+	}
+
+	errs := validateRequired("", msg, true)
+	return append(errs, validateIdentifiers("", msg)...)
+}
+
+// validateIdentifiers checks the format of the well-known identifiers in
+// msg, reporting them under prefix, the path of msg in its message
+func validateIdentifiers(prefix string, msg proto.Message) []error {
+	var errs []error
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() != protoreflect.StringKind {
+			return nil
+		}
+
+		name := xmlFields(parent)[fd.Number()].Name
+		pattern, ok := identifierPatterns[name]
+		if ok && !pattern.MatchString(v.String()) {
+			errs = append(errs, &ValidationError{
+				Path:    joinPath(prefix, path),
+				Message: fmt.Sprintf("invalid %s %q", name, v.String()),
+			})
+		}
+		return nil
+	})
+	return errs
+}
+
+// validateRequired checks that msg and every message below it carry the
+// elements and attributes their schema requires, reporting them under
+// prefix, the path of msg in its message. With lists false the entries of
+// the root's *List wrappers are not checked, as ValidateStream decodes them
+// separately and leaves the wrappers empty.
+func validateRequired(prefix string, msg proto.Message, lists bool) []error {
+	schema, ok := SchemaOf(msg)
+	if !ok {
+		return nil
+	}
+
+	errs := missingFields(schema, prefix, msg.ProtoReflect(), nil)
+	_ = Walk(msg, func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+		if fd.Kind() != protoreflect.MessageKind {
+			return nil
+		}
+		if !lists && !strings.ContainsAny(path, ".[") && strings.HasSuffix(path, "List") {
+			return SkipChildren
+		}
+		errs = append(errs, missingFields(schema, joinPath(prefix, path), v.Message(), nil)...)
+		return nil
+	})
+	return errs
+}
+
+// missingFields reports the required elements and attributes of the schema
+// type of m that m lacks. streamed counts children decoded out of m by
+// element name, which count as present. Numeric and boolean fields without
+// presence can't tell an absent value from a zero one and are not checked.
+func missingFields(schema *Schema, path string, m protoreflect.Message, streamed map[string]int) []error {
+	typ, ok := schema.Type(string(m.Descriptor().Name()))
+	if !ok {
+		return nil
+	}
+
+	mapping := xmlFields(m)
+	byName := make(map[xmlField]protoreflect.FieldDescriptor)
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		info := mapping[fd.Number()]
+		byName[xmlField{Name: info.Name, Attr: info.Attr}] = fd
+	}
+
+	var errs []error
+	for _, field := range typ.Fields {
+		if field.MinOccurs == 0 || (field.Kind != "element" && field.Kind != "attribute") {
+			continue
+		}
+		fd, ok := byName[xmlField{Name: field.Name, Attr: field.Kind == "attribute"}]
+		if !ok {
+			continue
+		}
+
+		fieldPath := joinPath(path, field.Name)
+		switch {
+		case fd.IsList():
+			if n := m.Get(fd).List().Len() + streamed[field.Name]; n < field.MinOccurs {
+				errs = append(errs, &ValidationError{
+					Path:    fieldPath,
+					Message: fmt.Sprintf("required element occurs %d times, at least %d expected", n, field.MinOccurs),
+				})
+			}
+		case m.Has(fd) || streamed[field.Name] > 0:
+		case field.Kind == "attribute":
+			errs = append(errs, &ValidationError{Path: fieldPath, Message: "required attribute is missing"})
+		case fd.Kind() == protoreflect.MessageKind:
+			errs = append(errs, &ValidationError{Path: fieldPath, Message: "required element is missing"})
+		case fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.EnumKind || fd.HasPresence():
+			errs = append(errs, &ValidationError{Path: fieldPath, Message: "required element is missing or empty"})
+		}
+	}
+	return errs
+}
+
+// ValidatePurge checks that the release a PurgeReleaseMessage removes was
+// previously delivered, given the GRids of all delivered releases
+func ValidatePurge(purge *ernv432.PurgeReleaseMessage, delivered []string) []error {
+	if purge == nil || purge.PurgedRelease == nil {
+		return []error{&ValidationError{Path: "PurgedRelease", Message: "required element is missing"}}
+	}
+
+	grid := purge.PurgedRelease.GetReleaseId().GetGRid()
+	if grid == "" {
+		return []error{&ValidationError{Path: "PurgedRelease.ReleaseId.GRid", Message: "purged release has no GRid"}}
+	}
+
+	for _, d := range delivered {
+		if d == grid {
+			return nil
+		}
+	}
+	return []error{&ValidationError{
+		Path:    "PurgedRelease.ReleaseId.GRid",
+		Message: fmt.Sprintf("release %s was never delivered", grid),
+	}}
+}
@@ -0,0 +1,155 @@
+package ddex
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestValidate checks the sample files and a few hand-built broken messages
+func TestValidate(t *testing.T) {
+	t.Run("Samples", func(t *testing.T) {
+		for testName, filename := range ernTestFiles {
+			t.Run(testName, func(t *testing.T) {
+				xmlPath := filepath.Join("testdata", "ernv432", "Samples43", filename)
+				xmlData, err := os.ReadFile(xmlPath)
+				if err != nil {
+					t.Skipf("Sample file not found: %s", xmlPath)
+				}
+
+				msg, err := ParseDDEX(xmlData)
+				if err != nil {
+					t.Fatalf("Failed to parse %s: %v", filename, err)
+				}
+
+				errs := Validate(msg)
+				// The DJ Mix sample ships with an empty MessageId
+				if filename == "8 DjMix.xml" {
+					if len(errs) != 1 {
+						t.Fatalf("Expected 1 problem, got %v", errs)
+					}
+					return
+				}
+				if len(errs) != 0 {
+					t.Errorf("Expected no problems, got %v", errs)
+				}
+			})
+		}
+	})
+
+	newMessage := func(t *testing.T) *ernv43.NewReleaseMessage {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, err := ernv43.UnmarshalNewReleaseMessage(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to unmarshal: %v", err)
+		}
+		return msg
+	}
+
+	paths := func(errs []error) []string {
+		var got []string
+		for _, err := range errs {
+			var vErr *ValidationError
+			if errors.As(err, &vErr) {
+				got = append(got, vErr.Path)
+			}
+		}
+		return got
+	}
+
+	t.Run("Empty Message", func(t *testing.T) {
+		errs := Validate(ernv43.NewNewReleaseMessage())
+		want := []string{"MessageHeader", "PartyList", "ResourceList", "ReleaseList", "AvsVersionId", "LanguageAndScriptCode"}
+		if got := paths(errs); !slices.Equal(got, want) {
+			t.Errorf("Expected problems at %v, got %v", want, errs)
+		}
+	})
+
+	t.Run("Missing Lists", func(t *testing.T) {
+		msg := newMessage(t)
+		msg.ResourceList = nil
+		msg.ReleaseList = nil
+		msg.DealList = nil // minOccurs="0" in the XSD
+
+		errs := Validate(msg)
+		want := []string{"ResourceList", "ReleaseList"}
+		if got := paths(errs); !slices.Equal(got, want) {
+			t.Errorf("Expected problems at %v, got %v", want, errs)
+		}
+		for _, err := range errs {
+			if vErr := err.(*ValidationError); vErr.Message != "required element is missing" {
+				t.Errorf("Unexpected message %q", vErr.Message)
+			}
+		}
+	})
+
+	t.Run("Nested Requirements", func(t *testing.T) {
+		msg := newMessage(t)
+		msg.MessageHeader.MessageId = ""
+		msg.ReleaseList.Release.ReleaseType = nil
+		msg.ResourceList.SoundRecording[1].ResourceReference = ""
+
+		errs := Validate(msg)
+		want := []string{"MessageHeader.MessageId", "ResourceList.SoundRecording[1].ResourceReference", "ReleaseList.Release.ReleaseType"}
+		if got := paths(errs); !slices.Equal(got, want) {
+			t.Errorf("Expected problems at %v, got %v", want, errs)
+		}
+	})
+
+	t.Run("Bad Identifier", func(t *testing.T) {
+		msg := newMessage(t)
+		msg.ResourceList.SoundRecording[0].SoundRecordingEdition[0].ResourceId[0].ISRC = proto.String("NOT-AN-ISRC")
+
+		errs := Validate(msg)
+		if len(errs) != 1 {
+			t.Fatalf("Expected 1 problem, got %v", errs)
+		}
+		want := "ResourceList.SoundRecording[0].SoundRecordingEdition[0].ResourceId[0].ISRC"
+		if vErr := errs[0].(*ValidationError); vErr.Path != want {
+			t.Errorf("Expected path %s, got %s", want, vErr.Path)
+		}
+	})
+}
+
+// TestValidatePurge checks purges against a history of delivered GRids
+func TestValidatePurge(t *testing.T) {
+	delivered := []string{"A10302B0003989564F", "A10302B0003989565D"}
+
+	newPurge := func(grid string) *ernv432.PurgeReleaseMessage {
+		msg := ernv432.NewPurgeReleaseMessage()
+		msg.PurgedRelease = &ernv432.PurgedRelease{ReleaseId: &ernv432.ReleaseId{GRid: proto.String(grid)}}
+		return msg
+	}
+
+	t.Run("Delivered", func(t *testing.T) {
+		if errs := ValidatePurge(newPurge("A10302B0003989564F"), delivered); len(errs) != 0 {
+			t.Errorf("Expected no problems, got %v", errs)
+		}
+	})
+
+	t.Run("Undelivered", func(t *testing.T) {
+		errs := ValidatePurge(newPurge("A10302B0009999999Z"), delivered)
+		if len(errs) != 1 {
+			t.Fatalf("Expected 1 problem, got %v", errs)
+		}
+		if vErr := errs[0].(*ValidationError); vErr.Path != "PurgedRelease.ReleaseId.GRid" {
+			t.Errorf("Expected GRid error, got %v", vErr)
+		}
+	})
+
+	t.Run("Missing Release", func(t *testing.T) {
+		if errs := ValidatePurge(ernv432.NewPurgeReleaseMessage(), delivered); len(errs) != 1 {
+			t.Errorf("Expected 1 problem, got %v", errs)
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrAVSVersionMismatch is returned by ValidateVersionIds when a message
+// declares an AvsVersionId other than the one its package was generated from
+var ErrAVSVersionMismatch = errors.New("AvsVersionId does not match the compiled AllowedValueSets")
+
+// CompiledAVSVersion returns the AvsVersionId of the AllowedValueSets the
+// package of msg was generated against, e.g. AVSVersionLatest for ERN 4.3.
+// It reports false for packages using an AVS version without a known
+// AvsVersionId, such as the ERN 3.8.3 ones.
+func CompiledAVSVersion(msg proto.Message) (string, bool) {
+	if msg == nil {
+		return "", false
+	}
+	imports := msg.ProtoReflect().Descriptor().ParentFile().Imports()
+	for i := 0; i < imports.Len(); i++ {
+		pkg := imports.Get(i).Package()
+		if !strings.HasPrefix(string(pkg), "ddex.avs.") {
+			continue
+		}
+		for version, avs := range avsVersions {
+			if avs.pkg == pkg {
+				return version, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ValidateVersionIds checks the version attributes of a root message: that
+// AvsVersionId is set and matches CompiledAVSVersion, and that a
+// ReleaseProfileVersionId, where given, names a known release profile. A
+// mismatched AvsVersionId yields an error wrapping ErrAVSVersionMismatch and
+// an unknown profile one wrapping ErrUnknownProfile.
+func ValidateVersionIds(msg proto.Message) []error {
+	if msg == nil {
+		return []error{&ValidationError{Path: "", Message: "message is nil"}}
+	}
+
+	var errs []error
+	m := msg.ProtoReflect()
+
+	if fd := m.Descriptor().Fields().ByName("avs_version_id"); fd != nil {
+		declared := m.Get(fd).String()
+		compiled, ok := CompiledAVSVersion(msg)
+		switch {
+		case declared == "":
+			errs = append(errs, &ValidationError{Path: "AvsVersionId", Message: "required attribute is missing"})
+		case ok && declared != compiled:
+			errs = append(errs, fmt.Errorf("%w: declared %q, %s uses %q", ErrAVSVersionMismatch, declared, m.Descriptor().ParentFile().Package(), compiled))
+		}
+	}
+
+	if fd := m.Descriptor().Fields().ByName("release_profile_version_id"); fd != nil && m.Get(fd).String() != "" {
+		if _, _, err := ProfileVersion(msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,88 @@
+package ddex
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ernv383 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v383"
+	ernv43 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v43"
+	ernv432 "github.com/alecsavvy/ddex-go/gen/ddex/ern/v432"
+	meadv11 "github.com/alecsavvy/ddex-go/gen/ddex/mead/v11"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestValidateVersionIds validates the AvsVersionId and ReleaseProfileVersionId checks on root messages
+func TestValidateVersionIds(t *testing.T) {
+	t.Run("Compiled AVS Version", func(t *testing.T) {
+		for _, msg := range []proto.Message{&ernv43.NewReleaseMessage{}, &ernv432.PurgeReleaseMessage{}, &meadv11.MeadMessage{}} {
+			if version, ok := CompiledAVSVersion(msg); !ok || version != AVSVersionLatest {
+				t.Errorf("CompiledAVSVersion(%T) = %q, %v, want %q", msg, version, ok, AVSVersionLatest)
+			}
+		}
+		if version, ok := CompiledAVSVersion(&ernv383.NewReleaseMessage{}); ok {
+			t.Errorf("Expected no compiled AvsVersionId for ERN 3.8.3, got %q", version)
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		msg := &ernv43.NewReleaseMessage{AvsVersionId: AVSVersionLatest, ReleaseProfileVersionId: proto.String("Audio")}
+		if errs := ValidateVersionIds(msg); len(errs) != 0 {
+			t.Errorf("Expected no errors, got %v", errs)
+		}
+
+		// ReleaseProfileVersionId is optional
+		msg.ReleaseProfileVersionId = nil
+		if errs := ValidateVersionIds(msg); len(errs) != 0 {
+			t.Errorf("Expected no errors without a profile, got %v", errs)
+		}
+	})
+
+	t.Run("AVS Version Mismatch", func(t *testing.T) {
+		xmlPath := filepath.Join("testdata", "ernv432", "Samples43", "1 Audio.xml")
+		xmlData, err := os.ReadFile(xmlPath)
+		if err != nil {
+			t.Skipf("Sample file not found: %s", xmlPath)
+		}
+		msg, _, err := ParseERN(xmlData)
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+
+		// The sample declares AVS 3 and the known Audio profile
+		errs := ValidateVersionIds(msg)
+		if len(errs) != 1 || !errors.Is(errs[0], ErrAVSVersionMismatch) {
+			t.Errorf("Expected 1 ErrAVSVersionMismatch, got %v", errs)
+		}
+	})
+
+	t.Run("Missing AvsVersionId", func(t *testing.T) {
+		errs := ValidateVersionIds(&ernv432.NewReleaseMessage{})
+		var verr *ValidationError
+		if len(errs) != 1 || !errors.As(errs[0], &verr) || verr.Path != "AvsVersionId" {
+			t.Errorf("Expected 1 error for missing AvsVersionId, got %v", errs)
+		}
+	})
+
+	t.Run("Unknown Profile", func(t *testing.T) {
+		msg := &ernv43.NewReleaseMessage{AvsVersionId: AVSVersionLatest, ReleaseProfileVersionId: proto.String("Podcast")}
+		errs := ValidateVersionIds(msg)
+		if len(errs) != 1 || !errors.Is(errs[0], ErrUnknownProfile) {
+			t.Errorf("Expected 1 ErrUnknownProfile, got %v", errs)
+		}
+	})
+
+	t.Run("ERN 3", func(t *testing.T) {
+		msg := &ernv383.NewReleaseMessage{ReleaseProfileVersionId: proto.String("CommonReleaseTypes/14/AudioAlbumMusicOnly")}
+		if errs := ValidateVersionIds(msg); len(errs) != 0 {
+			t.Errorf("Expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("Nil Message", func(t *testing.T) {
+		if errs := ValidateVersionIds(nil); len(errs) != 1 {
+			t.Errorf("Expected 1 error for nil message, got %v", errs)
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package ddex
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SkipChildren can be returned by a WalkFunc to skip descending into the
+// message value currently being visited
+var SkipChildren = errors.New("skip children")
+
+// WalkFunc is called by Walk for every populated field. path is the
+// dot-separated chain of XML names leading to the value, with list indexes in
+// brackets (e.g. "ReleaseList.TrackRelease[0].ReleaseReference"). Chardata
+// values share the path of their enclosing element. parent is the message
+// holding the field, so callbacks may modify it in place.
+type WalkFunc func(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value) error
+
+// Walk traverses every populated field of msg depth-first in field order.
+// Fields tagged xml:"-", such as the RootPrefix of a root message, have no
+// place in the document and are skipped.
+func Walk(msg proto.Message, fn WalkFunc) error {
+	if msg == nil {
+		return nil
+	}
+	err := walkMessage("", msg.ProtoReflect(), fn)
+	if errors.Is(err, SkipChildren) {
+		return nil
+	}
+	return err
+}
+
+func walkMessage(path string, m protoreflect.Message, fn WalkFunc) error {
+	mapping := xmlFields(m)
+	fields := m.Descriptor().Fields()
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !m.Has(fd) || mapping[fd.Number()].Name == "-" {
+			continue
+		}
+
+		fieldPath := joinPath(path, mapping[fd.Number()].Name)
+		v := m.Get(fd)
+
+		if fd.IsList() {
+			list := v.List()
+			for j := 0; j < list.Len(); j++ {
+				if err := walkValue(fmt.Sprintf("%s[%d]", fieldPath, j), m, fd, list.Get(j), fn); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := walkValue(fieldPath, m, fd, v, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func walkValue(path string, parent protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value, fn WalkFunc) error {
+	err := fn(path, parent, fd, v)
+	if errors.Is(err, SkipChildren) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return walkMessage(path, v.Message(), fn)
+	}
+	return nil
+}
+
+func joinPath(path, name string) string {
+	switch {
+	case name == "":
+		return path
+	case path == "":
+		return name
+	default:
+		return path + "." + name
+	}
+}
+
+// xmlField describes how a proto field is mapped to XML by its injected struct tag
+type xmlField struct {
+	Name     string // element or attribute name, "" for chardata
+	Attr     bool
+	Chardata bool
+	AVS      string // AllowedValueSets enum the value is drawn from, if any
+}
+
+// xmlFieldCache maps a message's full name to its field number → xmlField table
+var xmlFieldCache sync.Map
+
+// xmlFields returns the XML mapping of each field of m, as declared by the
+// injected xml struct tags
+func xmlFields(m protoreflect.Message) map[protoreflect.FieldNumber]xmlField {
+	fullName := m.Descriptor().FullName()
+	if cached, ok := xmlFieldCache.Load(fullName); ok {
+		return cached.(map[protoreflect.FieldNumber]xmlField)
+	}
+
+	fields := make(map[protoreflect.FieldNumber]xmlField)
+	t := reflect.TypeOf(m.Interface())
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			protoTag := field.Tag.Get("protobuf")
+			if protoTag == "" {
+				continue
+			}
+			parts := strings.Split(protoTag, ",")
+			if len(parts) < 2 {
+				continue
+			}
+			num, err := strconv.Atoi(parts[1])
+			if err != nil {
+				continue
+			}
+
+			info := xmlField{Name: field.Name}
+			if xmlTag, ok := field.Tag.Lookup("xml"); ok {
+				tagParts := strings.Split(xmlTag, ",")
+				info.Name = tagParts[0]
+				for _, opt := range tagParts[1:] {
+					info.Attr = info.Attr || opt == "attr"
+					info.Chardata = info.Chardata || opt == "chardata"
+				}
+			}
+			info.AVS = field.Tag.Get("avs")
+			fields[protoreflect.FieldNumber(num)] = info
+		}
+	}
+
+	xmlFieldCache.Store(fullName, fields)
+	return fields
+}